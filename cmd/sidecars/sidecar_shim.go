@@ -21,6 +21,7 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -46,15 +47,20 @@ import (
 	hooksV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/v1alpha1"
 	hooksV1alpha2 "kubevirt.io/kubevirt/pkg/hooks/v1alpha2"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
 )
 
 const (
-	onDefineDomainLoggingMessage  = "OnDefineDomain method has been called"
-	preCloudInitIsoLoggingMessage = "PreCloudInitIso method has been called"
-	onShutdownMessage             = "Hook's Shutdown callback method has been called"
-
-	onDefineDomainBin  = "onDefineDomain"
-	preCloudInitIsoBin = "preCloudInitIso"
+	onDefineDomainLoggingMessage      = "OnDefineDomain method has been called"
+	preCloudInitIsoLoggingMessage     = "PreCloudInitIso method has been called"
+	postMigrationTargetLoggingMessage = "PostMigrationTarget method has been called"
+	preShutdownLoggingMessage         = "PreShutdown method has been called"
+	onShutdownMessage                 = "Hook's Shutdown callback method has been called"
+
+	onDefineDomainBin      = "onDefineDomain"
+	preCloudInitIsoBin     = "preCloudInitIso"
+	postMigrationTargetBin = "postMigrationTarget"
+	preShutdownBin         = "preShutdown"
 )
 
 type infoServer struct {
@@ -67,6 +73,10 @@ func (s infoServer) Info(ctx context.Context, params *hooksInfo.InfoParams) (*ho
 		hooksInfo.OnDefineDomainHookPointName:  onDefineDomainBin,
 		hooksInfo.PreCloudInitIsoHookPointName: preCloudInitIsoBin,
 	}
+	if s.Version == hooksV1alpha4.Version {
+		supportedHookPoints[hooksInfo.PostMigrationTargetHookPointName] = postMigrationTargetBin
+		supportedHookPoints[hooksInfo.PreShutdownHookPointName] = preShutdownBin
+	}
 	var hookPoints = []*hooksInfo.HookPoint{}
 
 	// Shutdown fixes proper termination of Sidecars. It isn't related to
@@ -106,6 +116,83 @@ type v1Alpha2Server struct{}
 type v1Alpha3Server struct {
 	done chan struct{}
 }
+type v1Alpha4Server struct {
+	done chan struct{}
+}
+
+func (s v1Alpha4Server) OnDefineDomain(params *hooksV1alpha4.OnDefineDomainParams, stream hooksV1alpha4.Callbacks_OnDefineDomainServer) error {
+	log.Log.Info(onDefineDomainLoggingMessage)
+	command, err := onDefineDomainCommand(params.GetVmi(), params.GetDomainXML())
+	if err != nil {
+		log.Log.Reason(err).Error("Failed OnDefineDomain")
+		return err
+	}
+	newDomainXML, err := runStreamingCommand(command, onDefineDomainBin, func(progress string) error {
+		return stream.Send(&hooksV1alpha4.OnDefineDomainResult{Progress: progress})
+	})
+	if err != nil {
+		log.Log.Reason(err).Error("Failed OnDefineDomain")
+		return err
+	}
+	return stream.Send(&hooksV1alpha4.OnDefineDomainResult{DomainXML: newDomainXML})
+}
+
+func (s v1Alpha4Server) PreCloudInitIso(params *hooksV1alpha4.PreCloudInitIsoParams, stream hooksV1alpha4.Callbacks_PreCloudInitIsoServer) error {
+	log.Log.Info(preCloudInitIsoLoggingMessage)
+	command, err := preCloudInitIsoCommand(params.GetVmi(), params.GetCloudInitData())
+	if err != nil {
+		log.Log.Reason(err).Error("Failed PreCloudInitIso")
+		return err
+	}
+	cloudInitData, err := runStreamingCommand(command, preCloudInitIsoBin, func(progress string) error {
+		return stream.Send(&hooksV1alpha4.PreCloudInitIsoResult{Progress: progress})
+	})
+	if err != nil {
+		log.Log.Reason(err).Error("Failed PreCloudInitIso")
+		return err
+	}
+	return stream.Send(&hooksV1alpha4.PreCloudInitIsoResult{CloudInitData: cloudInitData})
+}
+
+func (s v1Alpha4Server) PostMigrationTarget(params *hooksV1alpha4.PostMigrationTargetParams, stream hooksV1alpha4.Callbacks_PostMigrationTargetServer) error {
+	log.Log.Info(postMigrationTargetLoggingMessage)
+	command, err := vmiCommand(postMigrationTargetBin, params.GetVmi())
+	if err != nil {
+		log.Log.Reason(err).Error("Failed PostMigrationTarget")
+		return err
+	}
+	_, err = runStreamingCommand(command, postMigrationTargetBin, func(progress string) error {
+		return stream.Send(&hooksV1alpha4.PostMigrationTargetResult{Progress: progress})
+	})
+	if err != nil {
+		log.Log.Reason(err).Error("Failed PostMigrationTarget")
+		return err
+	}
+	return stream.Send(&hooksV1alpha4.PostMigrationTargetResult{})
+}
+
+func (s v1Alpha4Server) PreShutdown(params *hooksV1alpha4.PreShutdownParams, stream hooksV1alpha4.Callbacks_PreShutdownServer) error {
+	log.Log.Info(preShutdownLoggingMessage)
+	command, err := vmiCommand(preShutdownBin, params.GetVmi())
+	if err != nil {
+		log.Log.Reason(err).Error("Failed PreShutdown")
+		return err
+	}
+	_, err = runStreamingCommand(command, preShutdownBin, func(progress string) error {
+		return stream.Send(&hooksV1alpha4.PreShutdownResult{Progress: progress})
+	})
+	if err != nil {
+		log.Log.Reason(err).Error("Failed PreShutdown")
+		return err
+	}
+	return stream.Send(&hooksV1alpha4.PreShutdownResult{})
+}
+
+func (s v1Alpha4Server) Shutdown(_ context.Context, _ *hooksV1alpha4.ShutdownParams) (*hooksV1alpha4.ShutdownResult, error) {
+	log.Log.Info(onShutdownMessage)
+	s.done <- struct{}{}
+	return &hooksV1alpha4.ShutdownResult{}, nil
+}
 
 func (s v1Alpha3Server) OnDefineDomain(_ context.Context, params *hooksV1alpha3.OnDefineDomainParams) (*hooksV1alpha3.OnDefineDomainResult, error) {
 	log.Log.Info(onDefineDomainLoggingMessage)
@@ -174,6 +261,21 @@ func (s v1Alpha1Server) OnDefineDomain(ctx context.Context, params *hooksV1alpha
 }
 
 func runPreCloudInitIso(vmiJSON []byte, cloudInitDataJSON []byte) ([]byte, error) {
+	command, err := preCloudInitIsoCommand(vmiJSON, cloudInitDataJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Log.Infof("Executing %s", preCloudInitIsoBin)
+	if reader, err := command.StderrPipe(); err != nil {
+		log.Log.Reason(err).Infof("Could not pipe stderr")
+	} else {
+		go logStderr(reader, "cloudInitData")
+	}
+	return command.Output()
+}
+
+func preCloudInitIsoCommand(vmiJSON []byte, cloudInitDataJSON []byte) (*exec.Cmd, error) {
 	// Check binary exists
 	if _, err := exec.LookPath(preCloudInitIsoBin); err != nil {
 		return nil, fmt.Errorf("Failed in finding %s in $PATH: %v", preCloudInitIsoBin, err)
@@ -195,17 +297,25 @@ func runPreCloudInitIso(vmiJSON []byte, cloudInitDataJSON []byte) ([]byte, error
 		"--vmi", string(vmiJSON),
 		"--cloud-init", string(cloudInitDataJSON))
 
-	log.Log.Infof("Executing %s", preCloudInitIsoBin)
-	command := exec.Command(preCloudInitIsoBin, args...)
+	return exec.Command(preCloudInitIsoBin, args...), nil
+}
+
+func runOnDefineDomain(vmiJSON []byte, domainXML []byte) ([]byte, error) {
+	command, err := onDefineDomainCommand(vmiJSON, domainXML)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Log.Infof("Executing %s", onDefineDomainBin)
 	if reader, err := command.StderrPipe(); err != nil {
 		log.Log.Reason(err).Infof("Could not pipe stderr")
 	} else {
-		go logStderr(reader, "cloudInitData")
+		go logStderr(reader, "onDefineDomain")
 	}
 	return command.Output()
 }
 
-func runOnDefineDomain(vmiJSON []byte, domainXML []byte) ([]byte, error) {
+func onDefineDomainCommand(vmiJSON []byte, domainXML []byte) (*exec.Cmd, error) {
 	if _, err := exec.LookPath(onDefineDomainBin); err != nil {
 		return nil, fmt.Errorf("Failed in finding %s in $PATH due %v", onDefineDomainBin, err)
 	}
@@ -219,14 +329,68 @@ func runOnDefineDomain(vmiJSON []byte, domainXML []byte) ([]byte, error) {
 		"--vmi", string(vmiJSON),
 		"--domain", string(domainXML))
 
-	log.Log.Infof("Executing %s", onDefineDomainBin)
-	command := exec.Command(onDefineDomainBin, args...)
-	if reader, err := command.StderrPipe(); err != nil {
-		log.Log.Reason(err).Infof("Could not pipe stderr")
-	} else {
-		go logStderr(reader, "onDefineDomain")
+	return exec.Command(onDefineDomainBin, args...), nil
+}
+
+func vmiCommand(binName string, vmiJSON []byte) (*exec.Cmd, error) {
+	if _, err := exec.LookPath(binName); err != nil {
+		return nil, fmt.Errorf("Failed in finding %s in $PATH due %v", binName, err)
 	}
-	return command.Output()
+
+	vmiSpec := virtv1.VirtualMachineInstance{}
+	if err := json.Unmarshal(vmiJSON, &vmiSpec); err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal given VMI spec: %s due %v", vmiJSON, err)
+	}
+
+	return exec.Command(binName, "--vmi", string(vmiJSON)), nil
+}
+
+// runStreamingCommand runs the hook binary and reports every line it writes to stderr as progress of the hook
+func runStreamingCommand(command *exec.Cmd, hookName string, reportProgress func(string) error) ([]byte, error) {
+	log.Log.Infof("Executing %s", hookName)
+	progress := &progressWriter{hookName: hookName, report: reportProgress}
+	// Output only returns once the stderr of the command has been copied to the progress writer
+	command.Stderr = progress
+	output, err := command.Output()
+	if err != nil {
+		return nil, err
+	}
+	return output, progress.flush()
+}
+
+type progressWriter struct {
+	hookName string
+	report   func(string) error
+	buffer   []byte
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.buffer = append(w.buffer, p...)
+	for {
+		i := bytes.IndexByte(w.buffer, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		line := string(w.buffer[:i])
+		w.buffer = w.buffer[i+1:]
+		if err := w.reportLine(line); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (w *progressWriter) flush() error {
+	if len(w.buffer) == 0 {
+		return nil
+	}
+	line := string(w.buffer)
+	w.buffer = nil
+	return w.reportLine(line)
+}
+
+func (w *progressWriter) reportLine(line string) error {
+	log.Log.With("hook", w.hookName).Info(line)
+	return w.report(line)
 }
 
 func logStderr(reader io.Reader, hookName string) {
@@ -242,7 +406,7 @@ func logStderr(reader io.Reader, hookName string) {
 }
 
 func parseCommandLineArgs() (string, error) {
-	supportedVersions := []string{"v1alpha1", "v1alpha2", "v1alpha3"}
+	supportedVersions := []string{"v1alpha1", "v1alpha2", "v1alpha3", "v1alpha4"}
 	version := ""
 
 	pflag.StringVar(&version, "version", "", "hook version to use")
@@ -314,6 +478,7 @@ func main() {
 
 	shutdownChan := make(chan struct{})
 	hooksV1alpha3.RegisterCallbacksServer(server, v1Alpha3Server{done: shutdownChan})
+	hooksV1alpha4.RegisterCallbacksServer(server, v1Alpha4Server{done: shutdownChan})
 
 	// Handle signals to properly shutdown process
 	signalStopChan := make(chan os.Signal, 1)
@@ -119,8 +119,12 @@ func (app *synchronizationControllerApp) prepareCertManager() (err error) {
 	return
 }
 
-// Update synchronization controller log verbosity on relevant config changes
+// Update synchronization controller log format and verbosity on relevant config changes
 func (app *synchronizationControllerApp) shouldChangeLogVerbosity() {
+	if err := log.SetFormat(string(app.clusterConfig.GetLogFormat())); err != nil {
+		log.Log.Warningf("failed to update the log format: %v", err)
+	}
+	log.SetSubcomponentVerbosity(app.clusterConfig.GetSubcomponentVerbosity())
 	verbosity := app.clusterConfig.GetVirtSynchronizationControllerVerbosity()
 	if verbosity == 0 {
 		// If the verbosity gets set in kubevirt CR, this will not be 0, but it is otherwise.
@@ -20,15 +20,21 @@
 package main
 
 import (
+	"context"
 	"os"
 	"time"
 
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/service"
 
+	"kubevirt.io/kubevirt/pkg/storage/export/archive"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
+	"kubevirt.io/kubevirt/pkg/storage/export/replication"
 	exportServer "kubevirt.io/kubevirt/pkg/storage/export/virt-exportserver"
+	"kubevirt.io/kubevirt/pkg/storage/export/vmimport"
 )
 
 const (
@@ -37,6 +43,26 @@ const (
 
 func main() {
 	log.InitializeLogging("virt-exportserver-" + os.Getenv("POD_NAME"))
+	if len(os.Args) > 1 && os.Args[1] == archive.ArchiveCommand {
+		runArchiver()
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == replication.ReplicateCommand {
+		runReplicator()
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == export.ChecksumCommand {
+		runChecksum()
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == vmimport.DescribeCommand {
+		runDescriber()
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == vmimport.ServeDisksCommand {
+		runDiskServer()
+		return
+	}
 	log.Log.Info("Starting export server")
 
 	certFile, keyFile := getCert()
@@ -53,6 +79,104 @@ func main() {
 	server.Run()
 }
 
+// runArchiver uploads the volumes of the pod to an object storage instead of serving them
+func runArchiver() {
+	log.Log.Info("Starting volume archiver")
+
+	volumes, err := archiveVolumes()
+	if writeErr := archive.WriteResult(archive.TerminationMessagePath, volumes, err); writeErr != nil {
+		log.Log.Reason(writeErr).Error("Failed to write the archive result")
+	}
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to archive volumes")
+		os.Exit(1)
+	}
+}
+
+func archiveVolumes() ([]exportv1.ArchivedVolume, error) {
+	config, err := archive.UploaderConfigFromEnv(export.EnvironToMap())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), archive.UploadTimeout)
+	defer cancel()
+	return archive.Upload(ctx, config)
+}
+
+// runReplicator creates the exported virtual machine in a peer cluster instead of serving its volumes
+func runReplicator() {
+	log.Log.Info("Starting snapshot replicator")
+
+	volumes, err := replicateSnapshot()
+	if writeErr := replication.WriteResult(replication.TerminationMessagePath, volumes, err); writeErr != nil {
+		log.Log.Reason(writeErr).Error("Failed to write the replication result")
+	}
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to replicate snapshot")
+		os.Exit(1)
+	}
+}
+
+func replicateSnapshot() ([]exportv1.ReplicatedVolume, error) {
+	config, err := replication.ReplicatorConfigFromEnv(export.EnvironToMap())
+	if err != nil {
+		return nil, err
+	}
+	peerClient, err := kubecli.GetKubevirtClientFromFlags("", config.Kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), replication.ReplicateTimeout)
+	defer cancel()
+	return replication.Replicate(ctx, config, peerClient)
+}
+
+// runChecksum computes the checksums of the disk images before the export server serves them
+func runChecksum() {
+	log.Log.Info("Computing checksums of the disk images")
+
+	paths := export.CreateServerPaths(export.EnvironToMap())
+	checksums, err := exportServer.ComputeChecksums(paths.Volumes)
+	if writeErr := exportServer.WriteChecksums(export.ChecksumTerminationMessagePath, paths.ChecksumsFile, checksums, err); writeErr != nil {
+		log.Log.Reason(writeErr).Error("Failed to write the checksums")
+		os.Exit(1)
+	}
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to compute checksums")
+		os.Exit(1)
+	}
+}
+
+// runDescriber reads the description of an imported virtual machine instead of serving volumes
+func runDescriber() {
+	log.Log.Info("Reading the description of the imported virtual machine")
+
+	var description *vmimport.Description
+	config, err := vmimport.ImporterConfigFromEnv(export.EnvironToMap())
+	if err == nil {
+		description, err = vmimport.DescribeSource(config)
+	}
+	if writeErr := vmimport.WriteResult(vmimport.TerminationMessagePath, description, err); writeErr != nil {
+		log.Log.Reason(writeErr).Error("Failed to write the description")
+	}
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to read the description")
+		os.Exit(1)
+	}
+}
+
+// runDiskServer serves the disks of an imported OVA bundle to CDI instead of serving volumes
+func runDiskServer() {
+	log.Log.Info("Starting import disk server")
+
+	config, err := vmimport.ImporterConfigFromEnv(export.EnvironToMap())
+	if err == nil {
+		err = vmimport.ServeDisks(config)
+	}
+	log.Log.Reason(err).Error("Import disk server failed")
+	os.Exit(1)
+}
+
 func getTokenFile() string {
 	tokenFile := os.Getenv("TOKEN_FILE")
 	if tokenFile == "" {
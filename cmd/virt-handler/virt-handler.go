@@ -273,11 +273,23 @@ func (app *virtHandlerApp) Run() {
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeRateLimiter)
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldInstallKubevirtSeccompProfile)
 	go func() {
-		forceUpdateKSM := func() { virthandler.HandleKSMUpdate(app.HostOverride, app.virtCli.CoreV1(), app.clusterConfig, true) }
-		handleKSMUpdate := func() { virthandler.HandleKSMUpdate(app.HostOverride, app.virtCli.CoreV1(), app.clusterConfig, false) }
+		vmiStore := vmiSourceInformer.GetStore()
+		forceUpdateKSM := func() {
+			virthandler.HandleKSMUpdate(app.HostOverride, app.virtCli.CoreV1(), app.clusterConfig, vmiStore, true)
+		}
+		handleKSMUpdate := func() {
+			virthandler.HandleKSMUpdate(app.HostOverride, app.virtCli.CoreV1(), app.clusterConfig, vmiStore, false)
+		}
 
 		forceUpdateKSM()
 		app.clusterConfig.SetConfigModifiedCallback(handleKSMUpdate)
+
+		// Retune KSM as the memory pressure and the merge policies of the VMIs on the node change
+		ticker := time.NewTicker(virthandler.KSMUpdateInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			handleKSMUpdate()
+		}
 	}()
 
 	if err := app.setupTLS(factory); err != nil {
@@ -458,7 +470,7 @@ func (app *virtHandlerApp) Run() {
 		factory.KubeVirt().HasSynced,
 	)
 
-	if err := metrics.SetupMetrics(app.VirtShareDir, app.HostOverride, app.MaxRequestsInFlight, vmiSourceInformer, machines); err != nil {
+	if err := metrics.SetupMetrics(app.VirtShareDir, app.HostOverride, app.MaxRequestsInFlight, vmiSourceInformer, machines, app.clusterConfig); err != nil {
 		panic(err)
 	}
 
@@ -526,8 +538,12 @@ func (app *virtHandlerApp) Run() {
 	}
 }
 
-// Update virt-handler log verbosity on relevant config changes
+// Update virt-handler log format and verbosity on relevant config changes
 func (app *virtHandlerApp) shouldChangeLogVerbosity() {
+	if err := log.SetFormat(string(app.clusterConfig.GetLogFormat())); err != nil {
+		log.Log.Warningf("failed to update the log format: %v", err)
+	}
+	log.SetSubcomponentVerbosity(app.clusterConfig.GetSubcomponentVerbosity())
 	verbosity := app.clusterConfig.GetVirtHandlerVerbosity(app.HostOverride)
 	log.Log.SetVerbosityLevel(int(verbosity))
 	log.Log.V(2).Infof("set verbosity to %d", verbosity)
@@ -587,6 +603,7 @@ func (app *virtHandlerApp) runServer(errCh chan error, consoleHandler *rest.Cons
 	ws := new(restful.WebService)
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/console").To(consoleHandler.SerialHandler))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/vnc").To(consoleHandler.VNCHandler))
+	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/spice").To(consoleHandler.SPICEHandler))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/usbredir").To(consoleHandler.USBRedirHandler))
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/pause").To(lifecycleHandler.PauseHandler))
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/unpause").To(lifecycleHandler.UnpauseHandler))
@@ -597,7 +614,12 @@ func (app *virtHandlerApp) runServer(errCh chan error, consoleHandler *rest.Cons
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/guestosinfo").To(lifecycleHandler.GetGuestInfo).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestAgentInfo{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/userlist").To(lifecycleHandler.GetUsers).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestOSUserList{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/filesystemlist").To(lifecycleHandler.GetFilesystems).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
+	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/stats").To(lifecycleHandler.GetStats).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceStats{}))
+	ws.Route(ws.POST("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/guestosexec").To(lifecycleHandler.GuestOSExecHandler).Reads(v1.GuestOSExecOptions{}).Produces(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.GuestOSExecResult{}))
+	ws.Route(ws.POST("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/guestfileread").To(lifecycleHandler.GuestFileReadHandler).Reads(v1.GuestFileReadOptions{}).Produces(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.GuestFile{}))
+	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/guestfilewrite").To(lifecycleHandler.GuestFileWriteHandler).Reads(v1.GuestFile{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/vsock").Param(restful.QueryParameter("port", "Target VSOCK port")).To(consoleHandler.VSOCKHandler))
+	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/memorydump").To(consoleHandler.MemoryDumpStreamHandler))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/sev/fetchcertchain").To(lifecycleHandler.SEVFetchCertChainHandler).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.SEVPlatformInfo{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/sev/querylaunchmeasurement").To(lifecycleHandler.SEVQueryLaunchMeasurementHandler).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.SEVMeasurementInfo{}))
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/sev/injectlaunchsecret").To(lifecycleHandler.SEVInjectLaunchSecretHandler))
@@ -364,6 +364,13 @@ func main() {
 
 	log.InitializeLogging("virt-launcher")
 
+	// check if virt-launcher log format should be changed
+	if format, ok := os.LookupEnv("VIRT_LAUNCHER_LOG_FORMAT"); ok {
+		if err := log.SetFormat(format); err != nil {
+			log.Log.Warningf("failed to set log format: %v", err)
+		}
+	}
+
 	// check if virt-launcher verbosity should be changed
 	if verbosityStr, ok := os.LookupEnv("VIRT_LAUNCHER_LOG_VERBOSITY"); ok {
 		if verbosity, err := strconv.Atoi(verbosityStr); err == nil {
@@ -17,11 +17,14 @@ swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/instancetype/v1alpha
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/instancetype/v1alpha2/types.go
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/instancetype/v1beta1/types.go
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/pool/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/autoscaling/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/quota/v1alpha1/types.go
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/migrations/v1alpha1/types.go
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/export/v1alpha1/types.go
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/export/v1beta1/types.go
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/clone/v1alpha1/types.go
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/clone/v1beta1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/vmimport/v1beta1/types.go
 
 deepcopy-gen \
     --bounding-dirs kubevirt.io/api \
@@ -35,9 +38,12 @@ deepcopy-gen \
     kubevirt.io/api/instancetype/v1alpha2 \
     kubevirt.io/api/instancetype/v1beta1 \
     kubevirt.io/api/pool/v1alpha1 \
+    kubevirt.io/api/autoscaling/v1alpha1 \
+    kubevirt.io/api/quota/v1alpha1 \
     kubevirt.io/api/migrations/v1alpha1 \
     kubevirt.io/api/clone/v1alpha1 \
     kubevirt.io/api/clone/v1beta1 \
+    kubevirt.io/api/vmimport/v1beta1 \
     kubevirt.io/api/core/v1
 
 defaulter-gen \
@@ -57,6 +63,7 @@ openapi-gen \
     k8s.io/apimachinery/pkg/runtime \
     k8s.io/apimachinery/pkg/util/intstr \
     kubevirt.io/api/core/v1 \
+    kubevirt.io/api/autoscaling/v1alpha1 \
     kubevirt.io/api/clone/v1alpha1 \
     kubevirt.io/api/clone/v1beta1 \
     kubevirt.io/api/export/v1alpha1 \
@@ -66,8 +73,10 @@ openapi-gen \
     kubevirt.io/api/instancetype/v1beta1 \
     kubevirt.io/api/migrations/v1alpha1 \
     kubevirt.io/api/pool/v1alpha1 \
+    kubevirt.io/api/quota/v1alpha1 \
     kubevirt.io/api/snapshot/v1alpha1 \
     kubevirt.io/api/snapshot/v1beta1 \
+    kubevirt.io/api/vmimport/v1beta1 \
     kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1
 
 conversion-gen \
@@ -88,7 +97,7 @@ fi
 
 client-gen --clientset-name kubevirt \
     --input-base kubevirt.io/api \
-    --input core/v1,export/v1alpha1,export/v1beta1,snapshot/v1alpha1,snapshot/v1beta1,instancetype/v1alpha1,instancetype/v1alpha2,instancetype/v1beta1,pool/v1alpha1,migrations/v1alpha1,clone/v1alpha1,clone/v1beta1 \
+    --input core/v1,export/v1alpha1,export/v1beta1,snapshot/v1alpha1,snapshot/v1beta1,instancetype/v1alpha1,instancetype/v1alpha2,instancetype/v1beta1,pool/v1alpha1,autoscaling/v1alpha1,quota/v1alpha1,migrations/v1alpha1,clone/v1alpha1,clone/v1beta1,vmimport/v1beta1 \
     --output-dir ${KUBEVIRT_DIR}/staging/src/kubevirt.io/client-go \
     --output-pkg ${CLIENT_GEN_BASE} \
     --go-header-file ${KUBEVIRT_DIR}/hack/boilerplate/boilerplate.go.txt
@@ -152,6 +161,12 @@ deepcopy-gen \
     #include pool
     GOFLAGS= controller-gen crd paths=../api/pool/v1alpha1/
 
+    #include autoscaling
+    GOFLAGS= controller-gen crd paths=../api/autoscaling/v1alpha1/
+
+    #include quota
+    GOFLAGS= controller-gen crd paths=../api/quota/v1alpha1/
+
     #include migrations
     GOFLAGS= controller-gen crd paths=../api/migrations/v1alpha1/
 
@@ -159,6 +174,9 @@ deepcopy-gen \
     GOFLAGS= controller-gen crd paths=../api/clone/v1alpha1/
     GOFLAGS= controller-gen crd paths=../api/clone/v1beta1/
 
+    #include import
+    GOFLAGS= controller-gen crd paths=../api/vmimport/v1beta1/
+
     #remove some weird stuff from controller-gen
     cd config/crd
     for file in *; do
@@ -161,6 +161,7 @@ libguestfstools_extra="
 "
 
 exportserverbase_main="
+  qemu-img
   tar
 "
 
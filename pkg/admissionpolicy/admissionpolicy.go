@@ -0,0 +1,191 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admissionpolicy
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// The resources the rules can apply to
+const (
+	VirtualMachines         = "virtualmachines"
+	VirtualMachineInstances = "virtualmachineinstances"
+)
+
+var Resources = []string{VirtualMachines, VirtualMachineInstances}
+
+const (
+	objectVariable    = "object"
+	oldObjectVariable = "oldObject"
+
+	// costLimit bounds the evaluation of an expression, so that a costly rule can not stall the admission
+	costLimit = 1000000
+)
+
+var newEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable(objectVariable, cel.DynType),
+		cel.Variable(oldObjectVariable, cel.DynType),
+	)
+})
+
+// Compile compiles the expression of a rule, which must evaluate to a bool
+func Compile(expression string) (cel.Program, error) {
+	env, err := newEnv()
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if outputType := ast.OutputType(); outputType != cel.BoolType && outputType != cel.DynType {
+		return nil, fmt.Errorf("the expression must evaluate to a bool, not to a %s", outputType)
+	}
+	return env.Program(ast, cel.CostLimit(costLimit))
+}
+
+// programCache holds the compiled programs of the rules of the current policy, as the admitters are created for every
+// request. It is reset when the policy changes, so that the programs of edited or removed rules are released.
+type programCache struct {
+	lock     sync.Mutex
+	policy   *v1.AdmissionPolicyConfiguration
+	programs map[string]cel.Program
+}
+
+var programs = &programCache{}
+
+// Evaluate evaluates the rules of the policy for the resource against the object and its previous version, which is
+// nil on creations. It returns the causes of the enforced rules the object does not satisfy, and the warnings of the
+// rules in the Warn mode it does not satisfy.
+func Evaluate(policy *v1.AdmissionPolicyConfiguration, resource string, object, oldObject runtime.Object) ([]metav1.StatusCause, []string) {
+	if policy == nil {
+		return nil, nil
+	}
+
+	var activation map[string]interface{}
+	var causes []metav1.StatusCause
+	var warnings []string
+	for _, rule := range policy.Rules {
+		if !slices.Contains(rule.Resources, resource) {
+			continue
+		}
+		if activation == nil {
+			var err error
+			if activation, err = newActivation(object, oldObject); err != nil {
+				return []metav1.StatusCause{{
+					Type:    metav1.CauseTypeUnexpectedServerResponse,
+					Message: fmt.Sprintf("failed to evaluate the admission policy: %v", err),
+				}}, nil
+			}
+		}
+
+		satisfied, err := evaluate(policy, rule.Expression, activation)
+		if satisfied {
+			continue
+		}
+		message := ruleMessage(rule, err)
+		if rule.Mode == v1.AdmissionPolicyWarn {
+			warnings = append(warnings, message)
+			continue
+		}
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: message,
+		})
+	}
+	return causes, warnings
+}
+
+func evaluate(policy *v1.AdmissionPolicyConfiguration, expression string, activation map[string]interface{}) (bool, error) {
+	program, err := programs.get(policy, expression)
+	if err != nil {
+		return false, err
+	}
+	result, _, err := program.Eval(activation)
+	if err != nil {
+		return false, err
+	}
+	satisfied, ok := result.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("the expression evaluated to a %s instead of a bool", result.Type())
+	}
+	return satisfied, nil
+}
+
+func (c *programCache) get(policy *v1.AdmissionPolicyConfiguration, expression string) (cel.Program, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// the cluster config returns the same policy until the KubeVirt CR changes
+	if c.programs == nil || (c.policy != policy && !equality.Semantic.DeepEqual(c.policy, policy)) {
+		c.policy = policy
+		c.programs = map[string]cel.Program{}
+	}
+	if program, exists := c.programs[expression]; exists {
+		return program, nil
+	}
+	program, err := Compile(expression)
+	if err != nil {
+		return nil, err
+	}
+	c.programs[expression] = program
+	return program, nil
+}
+
+func newActivation(object, oldObject runtime.Object) (map[string]interface{}, error) {
+	unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, err
+	}
+	activation := map[string]interface{}{
+		objectVariable:    unstructuredObject,
+		oldObjectVariable: types.NullValue,
+	}
+	if oldObject != nil {
+		unstructuredOldObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(oldObject)
+		if err != nil {
+			return nil, err
+		}
+		activation[oldObjectVariable] = unstructuredOldObject
+	}
+	return activation, nil
+}
+
+func ruleMessage(rule v1.AdmissionPolicyRule, err error) string {
+	message := rule.Message
+	if message == "" {
+		message = fmt.Sprintf("the object does not satisfy the %s admission policy rule", rule.Name)
+	}
+	if err != nil {
+		message = fmt.Sprintf("%s: %v", message, err)
+	}
+	return message
+}
@@ -0,0 +1,147 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admissionpolicy_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/admissionpolicy"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("Admission policy", func() {
+	const (
+		requirePreference = "has(object.spec.preference)"
+		limitCPUs         = "object.metadata.namespace != 'small' || object.spec.domain.cpu.cores <= 2"
+	)
+
+	newPolicy := func(rules ...v1.AdmissionPolicyRule) *v1.AdmissionPolicyConfiguration {
+		return &v1.AdmissionPolicyConfiguration{Rules: rules}
+	}
+
+	newRule := func(name, expression string, mode v1.AdmissionPolicyMode, resources ...string) v1.AdmissionPolicyRule {
+		return v1.AdmissionPolicyRule{
+			Name:       name,
+			Resources:  resources,
+			Expression: expression,
+			Message:    name + " is not satisfied",
+			Mode:       mode,
+		}
+	}
+
+	It("should admit everything without a policy", func() {
+		causes, warnings := admissionpolicy.Evaluate(nil, admissionpolicy.VirtualMachines, libvmi.NewVirtualMachine(libvmi.New()), nil)
+		Expect(causes).To(BeEmpty())
+		Expect(warnings).To(BeEmpty())
+	})
+
+	It("should deny the objects which do not satisfy an enforced rule", func() {
+		policy := newPolicy(newRule("preference", requirePreference, v1.AdmissionPolicyEnforce, admissionpolicy.VirtualMachines))
+
+		causes, warnings := admissionpolicy.Evaluate(policy, admissionpolicy.VirtualMachines, libvmi.NewVirtualMachine(libvmi.New()), nil)
+		Expect(causes).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "preference is not satisfied",
+		}))
+		Expect(warnings).To(BeEmpty())
+
+		vm := libvmi.NewVirtualMachine(libvmi.New(), libvmi.WithPreference("fedora"))
+		causes, _ = admissionpolicy.Evaluate(policy, admissionpolicy.VirtualMachines, vm, nil)
+		Expect(causes).To(BeEmpty())
+	})
+
+	It("should deny the objects when no mode is set", func() {
+		policy := newPolicy(newRule("preference", requirePreference, "", admissionpolicy.VirtualMachines))
+		causes, _ := admissionpolicy.Evaluate(policy, admissionpolicy.VirtualMachines, libvmi.NewVirtualMachine(libvmi.New()), nil)
+		Expect(causes).To(HaveLen(1))
+	})
+
+	It("should only warn about the rules in the Warn mode", func() {
+		policy := newPolicy(newRule("preference", requirePreference, v1.AdmissionPolicyWarn, admissionpolicy.VirtualMachines))
+		causes, warnings := admissionpolicy.Evaluate(policy, admissionpolicy.VirtualMachines, libvmi.NewVirtualMachine(libvmi.New()), nil)
+		Expect(causes).To(BeEmpty())
+		Expect(warnings).To(ConsistOf("preference is not satisfied"))
+	})
+
+	It("should only evaluate the rules of the resource", func() {
+		policy := newPolicy(newRule("preference", requirePreference, v1.AdmissionPolicyEnforce, admissionpolicy.VirtualMachines))
+		causes, _ := admissionpolicy.Evaluate(policy, admissionpolicy.VirtualMachineInstances, libvmi.New(), nil)
+		Expect(causes).To(BeEmpty())
+	})
+
+	DescribeTable("should scope a rule to a namespace in its expression", func(namespace string, cores uint32, expectDenied bool) {
+		policy := newPolicy(newRule("cpus", limitCPUs, v1.AdmissionPolicyEnforce, admissionpolicy.VirtualMachineInstances))
+		vmi := libvmi.New(libvmi.WithNamespace(namespace), libvmi.WithCPUCount(cores, 1, 1))
+		causes, _ := admissionpolicy.Evaluate(policy, admissionpolicy.VirtualMachineInstances, vmi, nil)
+		if expectDenied {
+			Expect(causes).To(HaveLen(1))
+		} else {
+			Expect(causes).To(BeEmpty())
+		}
+	},
+		Entry("allowing few CPUs in the namespace", "small", uint32(2), false),
+		Entry("denying more CPUs in the namespace", "small", uint32(4), true),
+		Entry("allowing more CPUs in other namespaces", "large", uint32(4), false),
+	)
+
+	It("should use a default message and report the evaluation errors", func() {
+		rule := newRule("cpus", "object.spec.domain.cpu.cores <= 2", v1.AdmissionPolicyEnforce, admissionpolicy.VirtualMachineInstances)
+		rule.Message = ""
+		causes, _ := admissionpolicy.Evaluate(newPolicy(rule), admissionpolicy.VirtualMachineInstances, libvmi.New(), nil)
+		Expect(causes).To(ConsistOf(HaveField("Message", HavePrefix("the object does not satisfy the cpus admission policy rule: "))))
+	})
+
+	It("should evaluate the edited rules when the policy changes", func() {
+		vmi := libvmi.New(libvmi.WithCPUCount(4, 1, 1))
+		policy := newPolicy(newRule("cpus", "object.spec.domain.cpu.cores <= 2", v1.AdmissionPolicyEnforce, admissionpolicy.VirtualMachineInstances))
+		causes, _ := admissionpolicy.Evaluate(policy, admissionpolicy.VirtualMachineInstances, vmi, nil)
+		Expect(causes).To(HaveLen(1))
+
+		policy = newPolicy(newRule("cpus", "object.spec.domain.cpu.cores <= 4", v1.AdmissionPolicyEnforce, admissionpolicy.VirtualMachineInstances))
+		causes, _ = admissionpolicy.Evaluate(policy, admissionpolicy.VirtualMachineInstances, vmi, nil)
+		Expect(causes).To(BeEmpty())
+	})
+
+	It("should pass the previous version of the object on updates", func() {
+		const expression = "oldObject == null || object.spec.runStrategy == oldObject.spec.runStrategy"
+		policy := newPolicy(newRule("immutable", expression, v1.AdmissionPolicyEnforce, admissionpolicy.VirtualMachines))
+		oldVM := libvmi.NewVirtualMachine(libvmi.New(), libvmi.WithRunStrategy(v1.RunStrategyAlways))
+		vm := libvmi.NewVirtualMachine(libvmi.New(), libvmi.WithRunStrategy(v1.RunStrategyHalted))
+
+		causes, _ := admissionpolicy.Evaluate(policy, admissionpolicy.VirtualMachines, vm, nil)
+		Expect(causes).To(BeEmpty())
+		causes, _ = admissionpolicy.Evaluate(policy, admissionpolicy.VirtualMachines, vm, oldVM)
+		Expect(causes).To(HaveLen(1))
+	})
+
+	DescribeTable("should fail to compile", func(expression string) {
+		_, err := admissionpolicy.Compile(expression)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("an invalid expression", "object.spec.("),
+		Entry("an expression which does not evaluate to a bool", "'string'"),
+		Entry("an expression using an unknown variable", "vm.spec.running"),
+	)
+})
@@ -217,7 +217,8 @@ func resolveSSHPublicKeys(accessCredentials []v1.AccessCredential, secretSourceD
 
 // resolveNoCloudSecrets is looking for CloudInitNoCloud volumes with UserDataSecretRef
 // requests. It reads the `userdata` secret the corresponds to the given CloudInitNoCloud
-// volume and sets the UserData field on that volume.
+// volume and sets the UserData field on that volume. The userdata of the UserDataSources
+// of the volume is merged into it.
 //
 // Note: when using this function, make sure that your code can access the secret volumes.
 func resolveNoCloudSecrets(vmi *v1.VirtualMachineInstance, secretSourceDir string) (map[string]string, error) {
@@ -244,6 +245,19 @@ func resolveNoCloudSecrets(vmi *v1.VirtualMachineInstance, secretSourceDir strin
 		return keys, fmt.Errorf("no cloud-init data-source found at volume: %s", volume.Name)
 	}
 
+	if len(volume.CloudInitNoCloud.UserDataSources) > 0 {
+		if userData == "" {
+			userData, err = readRawOrBase64Data(volume.CloudInitNoCloud.UserData, volume.CloudInitNoCloud.UserDataBase64)
+			if err != nil {
+				return keys, err
+			}
+		}
+		userData, err = mergeUserDataSources(userData, volume.Name, volume.CloudInitNoCloud.UserDataSources, secretSourceDir)
+		if err != nil {
+			return keys, err
+		}
+		volume.CloudInitNoCloud.UserDataBase64 = ""
+	}
 	if userData != "" {
 		volume.CloudInitNoCloud.UserData = userData
 	}
@@ -256,7 +270,7 @@ func resolveNoCloudSecrets(vmi *v1.VirtualMachineInstance, secretSourceDir strin
 
 // resolveConfigDriveSecrets is looking for CloudInitConfigDriveSource volume source with
 // UserDataSecretRef and NetworkDataSecretRef and resolves the secret from the corresponding
-// VolumeMount.
+// VolumeMount. The userdata of the UserDataSources of the volume is merged into the userdata.
 //
 // Note: when using this function, make sure that your code can access the secret volumes.
 func resolveConfigDriveSecrets(vmi *v1.VirtualMachineInstance, secretSourceDir string) (map[string]string, error) {
@@ -282,6 +296,19 @@ func resolveConfigDriveSecrets(vmi *v1.VirtualMachineInstance, secretSourceDir s
 	if userDataError != nil && networkDataError != nil {
 		return keys, fmt.Errorf("no cloud-init data-source found at volume: %s", volume.Name)
 	}
+	if len(volume.CloudInitConfigDrive.UserDataSources) > 0 {
+		if userData == "" {
+			userData, err = readRawOrBase64Data(volume.CloudInitConfigDrive.UserData, volume.CloudInitConfigDrive.UserDataBase64)
+			if err != nil {
+				return keys, err
+			}
+		}
+		userData, err = mergeUserDataSources(userData, volume.Name, volume.CloudInitConfigDrive.UserDataSources, secretSourceDir)
+		if err != nil {
+			return keys, err
+		}
+		volume.CloudInitConfigDrive.UserDataBase64 = ""
+	}
 	if userData != "" {
 		volume.CloudInitConfigDrive.UserData = userData
 	}
@@ -293,14 +320,16 @@ func resolveConfigDriveSecrets(vmi *v1.VirtualMachineInstance, secretSourceDir s
 }
 
 // findCloudInitConfigDriveSecretVolume loops over a given list of volumes and return a pointer
-// to the first volume with a CloudInitConfigDrive source and UserDataSecretRef field set.
+// to the first volume with a CloudInitConfigDrive source and UserDataSecretRef, NetworkDataSecretRef
+// or UserDataSources field set.
 func findCloudInitConfigDriveSecretVolume(volumes []v1.Volume) *v1.Volume {
 	for _, volume := range volumes {
 		if volume.CloudInitConfigDrive == nil {
 			continue
 		}
 		if volume.CloudInitConfigDrive.UserDataSecretRef != nil ||
-			volume.CloudInitConfigDrive.NetworkDataSecretRef != nil {
+			volume.CloudInitConfigDrive.NetworkDataSecretRef != nil ||
+			len(volume.CloudInitConfigDrive.UserDataSources) > 0 {
 			return &volume
 		}
 	}
@@ -331,14 +360,16 @@ func readFileFromDir(basedir, file string) (string, error) {
 }
 
 // findCloudInitNoCloudSecretVolume loops over a given list of volumes and return a pointer
-// to the first CloudInitNoCloud volume with a UserDataSecretRef field set.
+// to the first CloudInitNoCloud volume with a UserDataSecretRef, NetworkDataSecretRef or
+// UserDataSources field set.
 func findCloudInitNoCloudSecretVolume(volumes []v1.Volume) *v1.Volume {
 	for _, volume := range volumes {
 		if volume.CloudInitNoCloud == nil {
 			continue
 		}
 		if volume.CloudInitNoCloud.UserDataSecretRef != nil ||
-			volume.CloudInitNoCloud.NetworkDataSecretRef != nil {
+			volume.CloudInitNoCloud.NetworkDataSecretRef != nil ||
+			len(volume.CloudInitNoCloud.UserDataSources) > 0 {
 			return &volume
 		}
 	}
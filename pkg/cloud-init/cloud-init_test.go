@@ -24,9 +24,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -456,6 +461,92 @@ var _ = Describe("CloudInit", func() {
 						Expect(err.Error()).To(Equal("no cloud-init data-source found at volume: test-volume"))
 					})
 				})
+
+				Context("with userDataSources", func() {
+					readParts := func(userData string) (contentTypes []string, bodies []string) {
+						msg, err := mail.ReadMessage(strings.NewReader(userData))
+						Expect(err).ToNot(HaveOccurred())
+						mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+						Expect(err).ToNot(HaveOccurred())
+						Expect(mediaType).To(Equal("multipart/mixed"))
+						reader := multipart.NewReader(msg.Body, params["boundary"])
+						for {
+							part, err := reader.NextPart()
+							if err == io.EOF {
+								break
+							}
+							Expect(err).ToNot(HaveOccurred())
+							body, err := io.ReadAll(part)
+							Expect(err).ToNot(HaveOccurred())
+							contentTypes = append(contentTypes, part.Header.Get("Content-Type"))
+							bodies = append(bodies, string(body))
+						}
+						return contentTypes, bodies
+					}
+
+					It("should merge the userdata of all sources in order", func() {
+						testVolume := &v1.Volume{
+							Name: "test-volume",
+							VolumeSource: v1.VolumeSource{
+								CloudInitNoCloud: &v1.CloudInitNoCloudSource{
+									UserDataBase64: base64.StdEncoding.EncodeToString([]byte("#cloud-config\nhostname: test\n")),
+									UserDataSources: []v1.CloudInitUserDataSource{
+										{SecretRef: &k8sv1.LocalObjectReference{Name: "users"}},
+										{ConfigMapRef: &k8sv1.LocalObjectReference{Name: "script"}},
+									},
+								},
+							},
+						}
+						vmi := createEmptyVMIWithVolumes([]v1.Volume{*testVolume})
+						fakeVolumeMountDir("test-volume-udata-0", map[string]string{
+							"userdata": "#cloud-config\nusers: []\n",
+						})
+						fakeVolumeMountDir("test-volume-udata-1", map[string]string{
+							"userData": "#!/bin/sh\necho hello\n",
+						})
+
+						_, err := resolveNoCloudSecrets(vmi, tmpDir)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(testVolume.CloudInitNoCloud.UserDataBase64).To(BeEmpty())
+
+						contentTypes, bodies := readParts(testVolume.CloudInitNoCloud.UserData)
+						Expect(contentTypes).To(Equal([]string{
+							`text/cloud-config; charset="utf-8"`,
+							`text/cloud-config; charset="utf-8"`,
+							`text/x-shellscript; charset="utf-8"`,
+						}))
+						Expect(bodies).To(Equal([]string{
+							"#cloud-config\nhostname: test\n",
+							"#cloud-config\nusers: []\n",
+							"#!/bin/sh\necho hello\n",
+						}))
+					})
+
+					It("should generate the same userdata for the same sources", func() {
+						parts := []string{"#cloud-config\n", "#!/bin/sh\n"}
+						first, err := buildMultipartUserData(parts)
+						Expect(err).ToNot(HaveOccurred())
+						second, err := buildMultipartUserData(parts)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(first).To(Equal(second))
+					})
+
+					It("should fail if the userdata of a source does not exist", func() {
+						testVolume := &v1.Volume{
+							Name: "test-volume",
+							VolumeSource: v1.VolumeSource{
+								CloudInitNoCloud: &v1.CloudInitNoCloudSource{
+									UserDataSources: []v1.CloudInitUserDataSource{
+										{SecretRef: &k8sv1.LocalObjectReference{Name: "users"}},
+									},
+								},
+							},
+						}
+						vmi := createEmptyVMIWithVolumes([]v1.Volume{*testVolume})
+						_, err := resolveNoCloudSecrets(vmi, tmpDir)
+						Expect(err).To(MatchError(ContainSubstring("no userdata found in user data source 0 of volume test-volume")))
+					})
+				})
 			})
 
 			Context("with CloudInitConfigDrive volume source", func() {
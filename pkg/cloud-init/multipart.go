@@ -0,0 +1,125 @@
+/*
+ * This file is part of the kubevirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cloudinit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"path/filepath"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	userDataSourceDirFmt = "%s-udata-%d"
+
+	cloudConfigContentType = "text/cloud-config"
+	// cloudConfigMergeType makes cloud-init append lists and recursively merge
+	// dictionaries of the cloud-config parts instead of replacing them.
+	cloudConfigMergeType = "list(append)+dict(recurse_array)+str()"
+)
+
+// userDataContentTypes maps the first line of a userdata part to its content type.
+// Longer prefixes must come before the prefixes they start with.
+var userDataContentTypes = []struct {
+	prefix      string
+	contentType string
+}{
+	{"#cloud-config-archive", "text/cloud-config-archive"},
+	{"#cloud-config", cloudConfigContentType},
+	{"#cloud-boothook", "text/cloud-boothook"},
+	{"#include", "text/x-include-url"},
+	{"#part-handler", "text/part-handler"},
+	{"## template: jinja", "text/jinja2"},
+	{"#!", "text/x-shellscript"},
+}
+
+// mergeUserDataSources reads the userdata of the given user data sources from the
+// directories their Secrets and ConfigMaps are mounted at and merges it, in order,
+// after the given userdata into a single multi-part MIME userdata.
+func mergeUserDataSources(userData, volumeName string, sources []v1.CloudInitUserDataSource, secretSourceDir string) (string, error) {
+	var parts []string
+	if userData != "" {
+		parts = append(parts, userData)
+	}
+	for i := range sources {
+		baseDir := filepath.Join(secretSourceDir, fmt.Sprintf(userDataSourceDirFmt, volumeName, i))
+		data, err := readFirstFoundFileFromDir(baseDir, []string{"userdata", "userData"})
+		if err != nil {
+			return "", fmt.Errorf("no userdata found in user data source %d of volume %s: %v", i, volumeName, err)
+		}
+		parts = append(parts, data)
+	}
+	return buildMultipartUserData(parts)
+}
+
+// buildMultipartUserData builds a multi-part MIME userdata out of the given parts.
+// The boundary is derived from the content of the parts to keep the result deterministic.
+func buildMultipartUserData(parts []string) (string, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if err := writer.SetBoundary(multipartBoundary(parts)); err != nil {
+		return "", err
+	}
+
+	for _, part := range parts {
+		contentType := userDataContentType(part)
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", fmt.Sprintf("%s; charset=\"utf-8\"", contentType))
+		header.Set("MIME-Version", "1.0")
+		if contentType == cloudConfigContentType {
+			header.Set("Merge-Type", cloudConfigMergeType)
+		}
+		partWriter, err := writer.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+		if _, err := partWriter.Write([]byte(part)); err != nil {
+			return "", err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Content-Type: multipart/mixed; boundary=\"%s\"\r\nMIME-Version: 1.0\r\n\r\n%s", writer.Boundary(), body.String()), nil
+}
+
+func multipartBoundary(parts []string) string {
+	hash := sha256.New()
+	for _, part := range parts {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	return fmt.Sprintf("kubevirt-%x", hash.Sum(nil)[:16])
+}
+
+func userDataContentType(userData string) string {
+	for _, t := range userDataContentTypes {
+		if strings.HasPrefix(userData, t.prefix) {
+			return t.contentType
+		}
+	}
+	return "text/plain"
+}
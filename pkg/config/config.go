@@ -61,6 +61,8 @@ var (
 	ConfigMapSourceDir = filepath.Join(mountBaseDir, "config-map")
 	// SysprepSourceDir represents a location where a Sysprep is attached to the pod
 	SysprepSourceDir = filepath.Join(mountBaseDir, "sysprep")
+	// SysprepParametersDir represents a location where the parameters of Sysprep templates are attached to the pod
+	SysprepParametersDir = filepath.Join(mountBaseDir, "sysprep-parameters")
 	// SecretSourceDir represents a location where Secrets is attached to the pod
 	SecretSourceDir = filepath.Join(mountBaseDir, "secret")
 	// DownwardAPISourceDir represents a location where downwardapi is attached to the pod
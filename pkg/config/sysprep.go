@@ -20,14 +20,17 @@
 package config
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"text/template"
 
 	v1 "kubevirt.io/api/core/v1"
 
 	ephemeraldiskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	"kubevirt.io/kubevirt/pkg/util/net/dns"
 )
 
 // Assuming windows does not care what's the exact label.
@@ -47,6 +50,115 @@ func sysprepVolumeHasContents(sysprepVolume *v1.SysprepSource) bool {
 	return sysprepVolume.ConfigMap != nil || sysprepVolume.Secret != nil
 }
 
+// SysprepNeedsCopy returns true when the Sysprep volume references a ConfigMap of another namespace.
+// Pods can only mount ConfigMaps of their own namespace, so virt-controller copies it to the
+// namespace of the VMI, under the name returned by GetSysprepConfigMapCopyName.
+func SysprepNeedsCopy(vmiNamespace string, sysprepVolume *v1.SysprepSource) bool {
+	return sysprepVolume.Namespace != "" && sysprepVolume.Namespace != vmiNamespace
+}
+
+// GetSysprepConfigMapCopyName returns the name of the copy of the ConfigMap referenced by a Sysprep volume
+func GetSysprepConfigMapCopyName(vmiName, volumeName string) string {
+	return fmt.Sprintf("%s-%s-sysprep", vmiName, volumeName)
+}
+
+// GetSysprepParametersPath returns a path to the parameters of the Sysprep templates mounted on a pod
+func GetSysprepParametersPath(volumeName string) string {
+	return filepath.Join(SysprepParametersDir, volumeName)
+}
+
+func getSysprepRenderedPath(volumeName string) string {
+	return filepath.Join(SysprepDisksDir, volumeName+"-rendered")
+}
+
+// SysprepTemplateData holds the values Sysprep answer file templates are rendered with
+type SysprepTemplateData struct {
+	Name       string
+	Namespace  string
+	Hostname   string
+	Parameters map[string]string
+}
+
+// NewSysprepTemplateData returns the values of the VMI and the given parameters to render the answer files with
+func NewSysprepTemplateData(vmi *v1.VirtualMachineInstance, parameters map[string]string) SysprepTemplateData {
+	return SysprepTemplateData{
+		Name:       vmi.Name,
+		Namespace:  vmi.Namespace,
+		Hostname:   dns.SanitizeHostname(vmi),
+		Parameters: parameters,
+	}
+}
+
+// RenderSysprepTemplates renders each of the answer files as a Go template.
+// Referencing a missing parameter is an error, so that no answer file is rendered with empty values.
+func RenderSysprepTemplates(files map[string][]byte, data SysprepTemplateData) (map[string][]byte, error) {
+	rendered := make(map[string][]byte, len(files))
+	for name, content := range files {
+		tmpl, err := template.New(name).Option("missingkey=error").Parse(string(content))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the Sysprep template %s: %w", name, err)
+		}
+		var out bytes.Buffer
+		if err := tmpl.Execute(&out, data); err != nil {
+			return nil, fmt.Errorf("failed to render the Sysprep template %s: %w", name, err)
+		}
+		rendered[name] = out.Bytes()
+	}
+	return rendered, nil
+}
+
+// readMountedFiles returns the content of the files of a mounted ConfigMap or Secret,
+// skipping the hidden directories kubelet uses to update them atomically.
+func readMountedFiles(dirPath string) (map[string][]byte, error) {
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	files := map[string][]byte{}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), "..") {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dirPath, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		files[entry.Name()] = content
+	}
+	return files, nil
+}
+
+// renderSysprepDisk renders the answer file templates of the volume and returns the directory holding the result
+func renderSysprepDisk(vmi *v1.VirtualMachineInstance, volumeName string) (string, error) {
+	files, err := readMountedFiles(GetSysprepSourcePath(volumeName))
+	if err != nil {
+		return "", err
+	}
+	parameters := map[string]string{}
+	mountedParameters, err := readMountedFiles(GetSysprepParametersPath(volumeName))
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+	for key, value := range mountedParameters {
+		parameters[key] = string(value)
+	}
+
+	rendered, err := RenderSysprepTemplates(files, NewSysprepTemplateData(vmi, parameters))
+	if err != nil {
+		return "", err
+	}
+	renderedPath := getSysprepRenderedPath(volumeName)
+	if err := os.MkdirAll(renderedPath, 0750); err != nil {
+		return "", err
+	}
+	for name, content := range rendered {
+		if err := os.WriteFile(filepath.Join(renderedPath, name), content, 0640); err != nil {
+			return "", err
+		}
+	}
+	return renderedPath, nil
+}
+
 // Explained here: https://docs.microsoft.com/en-us/windows-hardware/manufacture/desktop/windows-setup-automation-overview
 const autounattendFilename = "autounattend.xml"
 const unattendFilename = "unattend.xml"
@@ -74,7 +186,7 @@ func CreateSysprepDisks(vmi *v1.VirtualMachineInstance, emptyIso bool) error {
 		if err != nil {
 			return err
 		}
-		if err := createSysprepDisk(volume.Name, vmiIsoSize); err != nil {
+		if err := createSysprepDisk(vmi, volume, vmiIsoSize); err != nil {
 			return err
 		}
 	}
@@ -85,17 +197,24 @@ func shouldCreateSysprepDisk(volumeSysprep *v1.SysprepSource) bool {
 	return volumeSysprep != nil && sysprepVolumeHasContents(volumeSysprep)
 }
 
-func createSysprepDisk(volumeName string, size int64) error {
-	sysprepSourcePath := GetSysprepSourcePath(volumeName)
+func createSysprepDisk(vmi *v1.VirtualMachineInstance, volume v1.Volume, size int64) error {
+	sysprepSourcePath := GetSysprepSourcePath(volume.Name)
 	if err := validateUnattendPresence(sysprepSourcePath); err != nil {
 		return err
 	}
+	// an empty image only reserves the space of the disk of the migration source
+	if volume.Sysprep.Template != nil && size == 0 {
+		var err error
+		if sysprepSourcePath, err = renderSysprepDisk(vmi, volume.Name); err != nil {
+			return err
+		}
+	}
 	filesPath, err := getFilesLayout(sysprepSourcePath)
 	if err != nil {
 		return err
 	}
 
-	return createIsoImageAndSetFileOwnership(volumeName, filesPath, size)
+	return createIsoImageAndSetFileOwnership(volume.Name, filesPath, size)
 }
 
 func createIsoImageAndSetFileOwnership(volumeName string, filesPath []string, size int64) error {
@@ -95,3 +95,83 @@ var _ = Describe("SysprepConfigMap", func() {
 		Entry("Should fail when using a secret and finding incorrect filenames", vmiSecret, []string{"wrongname.xml", "foobar.xml"}),
 	)
 })
+
+var _ = Describe("Sysprep templates", func() {
+	DescribeTable("SysprepNeedsCopy", func(source *v1.SysprepSource, expected bool) {
+		Expect(SysprepNeedsCopy("vmi-ns", source)).To(Equal(expected))
+	},
+		Entry("not without namespace", &v1.SysprepSource{}, false),
+		Entry("not with the namespace of the VMI", &v1.SysprepSource{Namespace: "vmi-ns"}, false),
+		Entry("with another namespace", &v1.SysprepSource{Namespace: "golden"}, true),
+	)
+
+	It("should render the answer files with the values of the VMI", func() {
+		vmi := libvmi.New(libvmi.WithNamespace("default"), libvmi.WithHostname("win-host"))
+		vmi.Name = "win"
+		files := map[string][]byte{
+			"autounattend.xml": []byte("<ComputerName>{{ .Hostname }}</ComputerName><ProductKey>{{ .Parameters.key }}</ProductKey>"),
+			"unattend.xml":     []byte("{{ .Namespace }}/{{ .Name }}"),
+		}
+
+		rendered, err := RenderSysprepTemplates(files, NewSysprepTemplateData(vmi, map[string]string{"key": "AAAAA-BBBBB"}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rendered).To(Equal(map[string][]byte{
+			"autounattend.xml": []byte("<ComputerName>win-host</ComputerName><ProductKey>AAAAA-BBBBB</ProductKey>"),
+			"unattend.xml":     []byte("default/win"),
+		}))
+	})
+
+	It("should fail to render a template referencing a missing parameter", func() {
+		files := map[string][]byte{"autounattend.xml": []byte("{{ .Parameters.key }}")}
+		_, err := RenderSysprepTemplates(files, NewSysprepTemplateData(libvmi.New(), map[string]string{}))
+		Expect(err).To(MatchError(ContainSubstring("failed to render the Sysprep template autounattend.xml")))
+	})
+
+	It("should fail to render an invalid template", func() {
+		files := map[string][]byte{"autounattend.xml": []byte("{{ .Hostname ")}
+		_, err := RenderSysprepTemplates(files, NewSysprepTemplateData(libvmi.New(), nil))
+		Expect(err).To(MatchError(ContainSubstring("failed to parse the Sysprep template autounattend.xml")))
+	})
+
+	Context("with CreateSysprepDisks", func() {
+		BeforeEach(func() {
+			var err error
+			SysprepSourceDir, err = os.MkdirTemp("", "sysprep")
+			Expect(err).NotTo(HaveOccurred())
+			SysprepParametersDir, err = os.MkdirTemp("", "sysprep-parameters")
+			Expect(err).NotTo(HaveOccurred())
+			SysprepDisksDir, err = os.MkdirTemp("", "sysprep-disks")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(func() {
+				os.RemoveAll(SysprepSourceDir)
+				os.RemoveAll(SysprepParametersDir)
+				os.RemoveAll(SysprepDisksDir)
+			})
+
+			Expect(os.MkdirAll(filepath.Join(SysprepSourceDir, "sysprep-volume", "..data"), 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(SysprepSourceDir, "sysprep-volume", "autounattend.xml"),
+				[]byte("<ComputerName>{{ .Hostname }}</ComputerName><ProductKey>{{ .Parameters.key }}</ProductKey>"), 0644)).To(Succeed())
+			Expect(os.MkdirAll(filepath.Join(SysprepParametersDir, "sysprep-volume"), 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(SysprepParametersDir, "sysprep-volume", "key"), []byte("AAAAA-BBBBB"), 0644)).To(Succeed())
+		})
+
+		It("should create the ISO from the rendered answer files", func() {
+			vmi := libvmi.New(libvmi.WithHostname("win-host"), libvmi.WithSysprepConfigMap("sysprep-volume", "test-config"))
+			vmi.Spec.Volumes[0].Sysprep.Template = &v1.SysprepTemplate{}
+
+			Expect(CreateSysprepDisks(vmi, false)).To(Succeed())
+			_, err := os.Stat(filepath.Join(SysprepDisksDir, "sysprep-volume.iso"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.ReadFile(filepath.Join(SysprepDisksDir, "sysprep-volume-rendered", "autounattend.xml"))).To(
+				Equal([]byte("<ComputerName>win-host</ComputerName><ProductKey>AAAAA-BBBBB</ProductKey>")))
+		})
+
+		It("should not render the answer files without template", func() {
+			vmi := libvmi.New(libvmi.WithSysprepConfigMap("sysprep-volume", "test-config"))
+
+			Expect(CreateSysprepDisks(vmi, false)).To(Succeed())
+			_, err := os.Stat(filepath.Join(SysprepDisksDir, "sysprep-volume-rendered"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+})
@@ -69,6 +69,8 @@ const (
 	FailedBackendStorageProbeReason = "FailedBackendStorageProbe"
 	// BackendStorageNotReadyReason is added when the backend storage PVC is pending.
 	BackendStorageNotReadyReason = "BackendStorageNotReady"
+	// FailedSysprepCopyReason is added when copying the answer files of a Sysprep volume from another namespace fails.
+	FailedSysprepCopyReason = "FailedSysprepCopy"
 	// SuccessfulHandOverPodReason is added in an event
 	// when the pod ownership transfer from the controller to virt-hander succeeds.
 	SuccessfulHandOverPodReason = "SuccessfulHandOver"
@@ -93,6 +95,10 @@ const (
 	MigrationTargetPodUnschedulable = "migrationTargetPodUnschedulable"
 	// FailedAbortMigrationReason is added when an attempt to abort migration fails
 	FailedAbortMigrationReason = "FailedAbortMigration"
+	// SuccessfulCutoverMigrationReason is added when the cutover of a warm migration was signaled
+	SuccessfulCutoverMigrationReason = "SuccessfulCutoverMigration"
+	// FailedCutoverMigrationReason is added when signaling the cutover of a warm migration fails
+	FailedCutoverMigrationReason = "FailedCutoverMigration"
 	// MissingAttachmentPodReason is set when we have a hotplugged volume, but the attachment pod is missing
 	MissingAttachmentPodReason = "MissingAttachmentPod"
 	// PVCNotReadyReason is set when the PVC is not ready to be hot plugged.
@@ -287,6 +293,11 @@ func ApplyVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request
 				newDisk.Name = request.AddVolumeOptions.Name
 
 				vmiSpec.Domain.Devices.Disks = append(vmiSpec.Domain.Devices.Disks, *newDisk)
+			} else if request.AddVolumeOptions.Filesystem != nil {
+				newFilesystem := request.AddVolumeOptions.Filesystem.DeepCopy()
+				newFilesystem.Name = request.AddVolumeOptions.Name
+
+				vmiSpec.Domain.Devices.Filesystems = append(vmiSpec.Domain.Devices.Filesystems, *newFilesystem)
 			}
 		}
 
@@ -309,6 +320,14 @@ func ApplyVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request
 
 		vmiSpec.Volumes = newVolumesList
 		vmiSpec.Domain.Devices.Disks = newDisksList
+
+		for i, fs := range vmiSpec.Domain.Devices.Filesystems {
+			if fs.Name == request.RemoveVolumeOptions.Name {
+				newFilesystemsList := append([]v1.Filesystem{}, vmiSpec.Domain.Devices.Filesystems[:i]...)
+				vmiSpec.Domain.Devices.Filesystems = append(newFilesystemsList, vmiSpec.Domain.Devices.Filesystems[i+1:]...)
+				break
+			}
+		}
 	}
 
 	return vmiSpec
@@ -40,6 +40,7 @@ import (
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	resourcev1beta1 "k8s.io/api/resource/v1beta1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	extclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
@@ -52,6 +53,7 @@ import (
 	apiregv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	aggregatorclient "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset"
 
+	autoscalingv1 "kubevirt.io/api/autoscaling/v1alpha1"
 	clonebase "kubevirt.io/api/clone"
 	clone "kubevirt.io/api/clone/v1beta1"
 	"kubevirt.io/api/core"
@@ -62,8 +64,10 @@ import (
 	"kubevirt.io/api/migrations"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	quotav1 "kubevirt.io/api/quota/v1alpha1"
 	"kubevirt.io/api/snapshot"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	importv1 "kubevirt.io/api/vmimport/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
@@ -109,6 +113,15 @@ type KubeInformerFactory interface {
 	// Watches for VirtualMachinePool objects
 	VMPool() cache.SharedIndexInformer
 
+	// Watches for VirtualMachineAutoscalingPolicy objects
+	VMAutoscalingPolicy() cache.SharedIndexInformer
+
+	// Watches for VirtualMachineResourceQuota objects
+	VMResourceQuota() cache.SharedIndexInformer
+
+	// Watches for VirtualMachineDisruptionBudget objects
+	VMDisruptionBudget() cache.SharedIndexInformer
+
 	// Watches for VirtualMachineInstancePreset objects
 	VirtualMachinePreset() cache.SharedIndexInformer
 
@@ -127,6 +140,15 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineExport objects
 	VirtualMachineExport() cache.SharedIndexInformer
 
+	// Watches VirtualMachineSnapshotExport objects
+	VirtualMachineSnapshotExport() cache.SharedIndexInformer
+
+	// Watches VirtualMachineSnapshotReplication objects
+	VirtualMachineSnapshotReplication() cache.SharedIndexInformer
+
+	// Watches VirtualMachineImport objects
+	VirtualMachineImport() cache.SharedIndexInformer
+
 	// Watches VirtualMachineSnapshot objects
 	VirtualMachineSnapshot() cache.SharedIndexInformer
 
@@ -136,9 +158,27 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineRestore objects
 	VirtualMachineRestore() cache.SharedIndexInformer
 
+	// Watches VirtualMachineSnapshotSchedule objects
+	VirtualMachineSnapshotSchedule() cache.SharedIndexInformer
+
+	// Watches VirtualMachineSnapshotGroup objects
+	VirtualMachineSnapshotGroup() cache.SharedIndexInformer
+
+	// Watches VirtualMachineSnapshotGroupRestore objects
+	VirtualMachineSnapshotGroupRestore() cache.SharedIndexInformer
+
 	// Watches MigrationPolicy objects
 	MigrationPolicy() cache.SharedIndexInformer
 
+	// Watches VolumeMigration objects
+	VolumeMigration() cache.SharedIndexInformer
+
+	// Watches MigrationRetryBudget objects
+	MigrationRetryBudget() cache.SharedIndexInformer
+
+	// Watches MigrationRebalancePolicy objects
+	MigrationRebalancePolicy() cache.SharedIndexInformer
+
 	// Watches VirtualMachineClone objects
 	VirtualMachineClone() cache.SharedIndexInformer
 
@@ -154,6 +194,12 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineClusterPreference objects
 	VirtualMachineClusterPreference() cache.SharedIndexInformer
 
+	// Watches VirtualMachineDefault objects
+	VirtualMachineDefault() cache.SharedIndexInformer
+
+	// Watches VirtualMachineClusterDefault objects
+	VirtualMachineClusterDefault() cache.SharedIndexInformer
+
 	// Watches for k8s extensions api configmap
 	ApiAuthConfigMap() cache.SharedIndexInformer
 
@@ -178,6 +224,9 @@ type KubeInformerFactory interface {
 	// Watches for PersistentVolumeClaim objects
 	PersistentVolumeClaim() cache.SharedIndexInformer
 
+	// Watches ConfigMap objects in all namespaces
+	ConfigMap() cache.SharedIndexInformer
+
 	// Watches for ControllerRevision objects
 	ControllerRevision() cache.SharedIndexInformer
 
@@ -316,6 +365,9 @@ type KubeInformerFactory interface {
 	// PVC StorageClasses
 	StorageClass() cache.SharedIndexInformer
 
+	// Pod PriorityClasses
+	PriorityClass() cache.SharedIndexInformer
+
 	// Pod returns an informer for ALL Pods in the system
 	Pod() cache.SharedIndexInformer
 
@@ -431,6 +483,16 @@ func GetVMIInformerIndexers() cache.Indexers {
 		"node": func(obj interface{}) (strings []string, e error) {
 			return []string{obj.(*kubev1.VirtualMachineInstance).Status.NodeName}, nil
 		},
+		"evacuationSourceNode": func(obj interface{}) ([]string, error) {
+			vmi, ok := obj.(*kubev1.VirtualMachineInstance)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+			if sourceNode, exists := vmi.Annotations[kubev1.EvacuationSourceNodeAnnotation]; exists {
+				return []string{sourceNode}, nil
+			}
+			return nil, nil
+		},
 		"dv": func(obj interface{}) ([]string, error) {
 			vmi, ok := obj.(*kubev1.VirtualMachineInstance)
 			if !ok {
@@ -515,6 +577,27 @@ func (f *kubeInformerFactory) VMPool() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) VMAutoscalingPolicy() cache.SharedIndexInformer {
+	return f.getInformer("vmautoscalingpolicy", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().AutoscalingV1alpha1().RESTClient(), "virtualmachineautoscalingpolicies", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &autoscalingv1.VirtualMachineAutoscalingPolicy{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) VMResourceQuota() cache.SharedIndexInformer {
+	return f.getInformer("vmresourcequota", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().QuotaV1alpha1().RESTClient(), "virtualmachineresourcequotas", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &quotav1.VirtualMachineResourceQuota{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) VMDisruptionBudget() cache.SharedIndexInformer {
+	return f.getInformer("vmdisruptionbudget", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().MigrationsV1alpha1().RESTClient(), migrations.ResourceVMDisruptionBudgets, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &migrationsv1.VirtualMachineDisruptionBudget{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func (f *kubeInformerFactory) VirtualMachinePreset() cache.SharedIndexInformer {
 	return f.getInformer("vmiPresetInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.restClient, "virtualmachineinstancepresets", k8sv1.NamespaceAll, fields.Everything())
@@ -642,6 +725,53 @@ func (f *kubeInformerFactory) VirtualMachineExport() cache.SharedIndexInformer {
 	})
 }
 
+func GetVirtualMachineSnapshotExportInformerIndexers() cache.Indexers {
+	return cache.Indexers{
+		"vmsnapshot": func(obj interface{}) ([]string, error) {
+			snapshotExport, ok := obj.(*exportv1.VirtualMachineSnapshotExport)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			return []string{fmt.Sprintf("%s/%s", snapshotExport.Namespace, snapshotExport.Spec.VirtualMachineSnapshotName)}, nil
+		},
+	}
+}
+
+func (f *kubeInformerFactory) VirtualMachineSnapshotExport() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotExportInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().ExportV1beta1().RESTClient(), "virtualmachinesnapshotexports", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &exportv1.VirtualMachineSnapshotExport{}, f.defaultResync, GetVirtualMachineSnapshotExportInformerIndexers())
+	})
+}
+
+func GetVirtualMachineSnapshotReplicationInformerIndexers() cache.Indexers {
+	return cache.Indexers{
+		"vmsnapshot": func(obj interface{}) ([]string, error) {
+			replication, ok := obj.(*exportv1.VirtualMachineSnapshotReplication)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			return []string{fmt.Sprintf("%s/%s", replication.Namespace, replication.Spec.VirtualMachineSnapshotName)}, nil
+		},
+	}
+}
+
+func (f *kubeInformerFactory) VirtualMachineSnapshotReplication() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotReplicationInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().ExportV1beta1().RESTClient(), "virtualmachinesnapshotreplications", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &exportv1.VirtualMachineSnapshotReplication{}, f.defaultResync, GetVirtualMachineSnapshotReplicationInformerIndexers())
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineImport() cache.SharedIndexInformer {
+	return f.getInformer("vmImportInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().ImportV1beta1().RESTClient(), "virtualmachineimports", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &importv1.VirtualMachineImport{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func GetVirtualMachineSnapshotInformerIndexers() cache.Indexers {
 	return cache.Indexers{
 		"vm": func(obj interface{}) ([]string, error) {
@@ -721,6 +851,27 @@ func (f *kubeInformerFactory) VirtualMachineRestore() cache.SharedIndexInformer
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineSnapshotSchedule() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotScheduleInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1beta1().RESTClient(), "virtualmachinesnapshotschedules", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotSchedule{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineSnapshotGroup() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotGroupInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1beta1().RESTClient(), "virtualmachinesnapshotgroups", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotGroup{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineSnapshotGroupRestore() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotGroupRestoreInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1beta1().RESTClient(), "virtualmachinesnapshotgrouprestores", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotGroupRestore{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
 func (f *kubeInformerFactory) MigrationPolicy() cache.SharedIndexInformer {
 	return f.getInformer("migrationPolicyInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().MigrationsV1alpha1().RESTClient(), migrations.ResourceMigrationPolicies, k8sv1.NamespaceAll, fields.Everything())
@@ -728,6 +879,27 @@ func (f *kubeInformerFactory) MigrationPolicy() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) VolumeMigration() cache.SharedIndexInformer {
+	return f.getInformer("volumeMigrationInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().MigrationsV1alpha1().RESTClient(), migrations.ResourceVolumeMigrations, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &migrationsv1.VolumeMigration{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) MigrationRetryBudget() cache.SharedIndexInformer {
+	return f.getInformer("migrationRetryBudgetInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().MigrationsV1alpha1().RESTClient(), migrations.ResourceMigrationRetryBudgets, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &migrationsv1.MigrationRetryBudget{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) MigrationRebalancePolicy() cache.SharedIndexInformer {
+	return f.getInformer("migrationRebalancePolicyInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().MigrationsV1alpha1().RESTClient(), migrations.ResourceMigrationRebalancePolicies, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &migrationsv1.MigrationRebalancePolicy{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
 func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 	getkey := func(vmClone *clone.VirtualMachineClone, resourceName string) string {
 		return fmt.Sprintf("%s/%s", vmClone.Namespace, resourceName)
@@ -757,6 +929,9 @@ func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 
 			target := vmClone.Spec.Target
 			if target != nil && target.APIGroup != nil && *target.APIGroup == core.GroupName && target.Kind == "VirtualMachine" {
+				if vmClone.Spec.TargetNamespace != "" {
+					return []string{fmt.Sprintf("%s/%s", vmClone.Spec.TargetNamespace, target.Name)}, nil
+				}
 				return []string{getkey(vmClone, target.Name)}, nil
 			}
 
@@ -853,6 +1028,20 @@ func (f *kubeInformerFactory) VirtualMachineClusterPreference() cache.SharedInde
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineDefault() cache.SharedIndexInformer {
+	return f.getInformer("vmDefaultInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().InstancetypeV1beta1().RESTClient(), instancetypeapi.PluralDefaultResourceName, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &instancetypev1beta1.VirtualMachineDefault{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineClusterDefault() cache.SharedIndexInformer {
+	return f.getInformer("vmClusterDefaultInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().InstancetypeV1beta1().RESTClient(), instancetypeapi.ClusterPluralDefaultResourceName, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &instancetypev1beta1.VirtualMachineClusterDefault{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
 func (f *kubeInformerFactory) DataVolume() cache.SharedIndexInformer {
 	return f.getInformer("dataVolumeInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.CdiClient().CdiV1beta1().RESTClient(), "datavolumes", k8sv1.NamespaceAll, fields.Everything())
@@ -1000,6 +1189,14 @@ func (f *kubeInformerFactory) PersistentVolumeClaim() cache.SharedIndexInformer
 	})
 }
 
+func (f *kubeInformerFactory) ConfigMap() cache.SharedIndexInformer {
+	return f.getInformer("configMapInformer", func() cache.SharedIndexInformer {
+		restClient := f.clientSet.CoreV1().RESTClient()
+		lw := cache.NewListWatchFromClient(restClient, "configmaps", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &k8sv1.ConfigMap{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func GetControllerRevisionInformerIndexers() cache.Indexers {
 	return cache.Indexers{
 		"vm": func(obj interface{}) ([]string, error) {
@@ -1435,6 +1632,14 @@ func (f *kubeInformerFactory) StorageClass() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) PriorityClass() cache.SharedIndexInformer {
+	return f.getInformer("priorityClassInformer", func() cache.SharedIndexInformer {
+		restClient := f.clientSet.SchedulingV1().RESTClient()
+		lw := cache.NewListWatchFromClient(restClient, "priorityclasses", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &schedulingv1.PriorityClass{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
 func (f *kubeInformerFactory) Pod() cache.SharedIndexInformer {
 	return f.getInformer("podInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.CoreV1().RESTClient(), "pods", k8sv1.NamespaceAll, fields.Everything())
@@ -135,6 +135,11 @@ func setupHotplug(clusterConfig *virtconfig.ClusterConfig, vmi *v1.VirtualMachin
 }
 
 func setupCPUHotplug(clusterConfig *virtconfig.ClusterConfig, vmi *v1.VirtualMachineInstance) {
+	// The guest NUMA cells have to cover all the vCPUs, hotplugged ones included
+	if vmi.Spec.Domain.CPU.NUMA != nil && len(vmi.Spec.Domain.CPU.NUMA.GuestCells) > 0 {
+		return
+	}
+
 	if vmi.Spec.Domain.CPU.MaxSockets == 0 {
 		maxSockets := clusterConfig.GetMaximumCpuSockets()
 		if vmi.Spec.Domain.CPU.Sockets > maxSockets && maxSockets != 0 {
@@ -240,9 +245,11 @@ func SetDefaultVirtualMachineInstanceSpec(clusterConfig *virtconfig.ClusterConfi
 	setDefaultMachineType(clusterConfig, spec)
 	setDefaultResourceRequests(clusterConfig, spec)
 	setGuestMemory(spec)
+	setDefaultKSMMergePolicy(clusterConfig, spec)
 	SetDefaultGuestCPUTopology(clusterConfig, spec)
 	setDefaultPullPoliciesOnContainerDisks(spec)
 	setDefaultEvictionStrategy(clusterConfig, spec)
+	setDefaultPriorityClassName(clusterConfig, spec)
 	if err := vmispec.SetDefaultNetworkInterface(clusterConfig, spec); err != nil {
 		return err
 	}
@@ -256,6 +263,16 @@ func setDefaultEvictionStrategy(clusterConfig *virtconfig.ClusterConfig, spec *v
 	}
 }
 
+// setDefaultPriorityClassName gives the VMI the priority of its workload class
+func setDefaultPriorityClassName(clusterConfig *virtconfig.ClusterConfig, spec *v1.VirtualMachineInstanceSpec) {
+	if spec.WorkloadClass == "" || spec.PriorityClassName != "" {
+		return
+	}
+	if workloadClass := clusterConfig.GetWorkloadClass(spec.WorkloadClass); workloadClass != nil {
+		spec.PriorityClassName = workloadClass.PriorityClassName
+	}
+}
+
 func setDefaultMachineType(clusterConfig *virtconfig.ClusterConfig, spec *v1.VirtualMachineInstanceSpec) {
 	machineType := clusterConfig.GetMachineType(spec.Architecture)
 
@@ -304,6 +321,35 @@ func setGuestMemory(spec *v1.VirtualMachineInstanceSpec) {
 
 }
 
+func setDefaultKSMMergePolicy(clusterConfig *virtconfig.ClusterConfig, spec *v1.VirtualMachineInstanceSpec) {
+	if spec.Domain.Memory == nil || spec.Domain.Memory.KSMMergePolicy != "" {
+		return
+	}
+
+	// The memory overcommit class takes precedence over the cluster wide merge policy
+	switch spec.Domain.Memory.Overcommit {
+	case v1.MemoryOvercommitGuaranteed:
+		spec.Domain.Memory.KSMMergePolicy = v1.KSMMergePolicyDisabled
+		return
+	case v1.MemoryOvercommitBestEffort:
+		spec.Domain.Memory.KSMMergePolicy = v1.KSMMergePolicyAggressive
+		return
+	}
+
+	ksmConfig := clusterConfig.GetKSMConfiguration()
+	if ksmConfig == nil || ksmConfig.MergePolicy == "" {
+		return
+	}
+	spec.Domain.Memory.KSMMergePolicy = ksmConfig.MergePolicy
+}
+
+func memoryOvercommitClass(spec *v1.VirtualMachineInstanceSpec) v1.MemoryOvercommitClass {
+	if spec.Domain.Memory == nil {
+		return ""
+	}
+	return spec.Domain.Memory.Overcommit
+}
+
 func setDefaultResourceRequests(clusterConfig *virtconfig.ClusterConfig, spec *v1.VirtualMachineInstanceSpec) {
 	resources := &spec.Domain.Resources
 
@@ -321,7 +367,8 @@ func setDefaultResourceRequests(clusterConfig *virtconfig.ClusterConfig, spec *v
 		resources.Requests[k8sv1.ResourceMemory] = resources.Limits[k8sv1.ResourceMemory]
 	}
 
-	if _, exists := resources.Requests[k8sv1.ResourceMemory]; !exists {
+	// BestEffort VMIs don't request their guest memory, the pod only requests the memory overhead
+	if _, exists := resources.Requests[k8sv1.ResourceMemory]; !exists && memoryOvercommitClass(spec) != v1.MemoryOvercommitBestEffort {
 		var memory *resource.Quantity
 		if spec.Domain.Memory != nil && spec.Domain.Memory.Guest != nil {
 			memory = spec.Domain.Memory.Guest
@@ -337,6 +384,9 @@ func setDefaultResourceRequests(clusterConfig *virtconfig.ClusterConfig, spec *v
 				resources.Requests = k8sv1.ResourceList{}
 			}
 			overcommit := clusterConfig.GetMemoryOvercommit()
+			if memoryOvercommitClass(spec) == v1.MemoryOvercommitGuaranteed {
+				overcommit = 100
+			}
 			if overcommit == 100 {
 				resources.Requests[k8sv1.ResourceMemory] = *memory
 			} else {
@@ -73,9 +73,9 @@ func validateCreationDRA(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSp
 		}
 	}
 
-	// If a gpu is non-DRA, it must have only deviceName configured
+	// If a gpu is non-DRA, it must have only deviceName or a mediated device profile configured
 	for _, gpu := range nonDRAGPUs {
-		if gpu.DeviceName == "" {
+		if gpu.DeviceName == "" && gpu.Profile == "" {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
 				Message: "vmi.spec.domain.devices.gpus contains GPUs without deviceName",
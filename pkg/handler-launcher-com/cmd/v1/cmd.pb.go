@@ -42,6 +42,8 @@ It has these top-level messages:
 	LaunchMeasurementResponse
 	InjectLaunchSecretRequest
 	DirtyRateStatsResponse
+	GuestFileRequest
+	GuestFileResponse
 */
 package v1
 
@@ -745,6 +747,7 @@ type ExecResponse struct {
 	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
 	ExitCode int32     `protobuf:"varint,2,opt,name=exitCode" json:"exitCode,omitempty"`
 	StdOut   string    `protobuf:"bytes,3,opt,name=stdOut" json:"stdOut,omitempty"`
+	StdErr   string    `protobuf:"bytes,4,opt,name=stdErr" json:"stdErr,omitempty"`
 }
 
 func (m *ExecResponse) Reset()                    { *m = ExecResponse{} }
@@ -773,6 +776,13 @@ func (m *ExecResponse) GetStdOut() string {
 	return ""
 }
 
+func (m *ExecResponse) GetStdErr() string {
+	if m != nil {
+		return m.StdErr
+	}
+	return ""
+}
+
 type GuestPingRequest struct {
 	DomainName     string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
 	TimeoutSeconds int32  `protobuf:"varint,2,opt,name=timeoutSeconds" json:"timeoutSeconds,omitempty"`
@@ -840,6 +850,9 @@ func (m *FreezeRequest) GetUnfreezeTimeoutSeconds() int32 {
 type MemoryDumpRequest struct {
 	Vmi      *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
 	DumpPath string `protobuf:"bytes,2,opt,name=dumpPath" json:"dumpPath,omitempty"`
+	Format   string `protobuf:"bytes,3,opt,name=format" json:"format,omitempty"`
+	Content  string `protobuf:"bytes,4,opt,name=content" json:"content,omitempty"`
+	Stream   bool   `protobuf:"varint,5,opt,name=stream" json:"stream,omitempty"`
 }
 
 func (m *MemoryDumpRequest) Reset()                    { *m = MemoryDumpRequest{} }
@@ -861,6 +874,27 @@ func (m *MemoryDumpRequest) GetDumpPath() string {
 	return ""
 }
 
+func (m *MemoryDumpRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *MemoryDumpRequest) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *MemoryDumpRequest) GetStream() bool {
+	if m != nil {
+		return m.Stream
+	}
+	return false
+}
+
 type SEVInfoResponse struct {
 	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
 	SevInfo  []byte    `protobuf:"bytes,2,opt,name=sevInfo,proto3" json:"sevInfo,omitempty"`
@@ -957,6 +991,70 @@ func (m *DirtyRateStatsResponse) GetDirtyRateMbs() int64 {
 	return 0
 }
 
+type GuestFileRequest struct {
+	DomainName string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	Path       string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	Content    []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	MaxSize    int64  `protobuf:"varint,4,opt,name=maxSize" json:"maxSize,omitempty"`
+}
+
+func (m *GuestFileRequest) Reset()                    { *m = GuestFileRequest{} }
+func (m *GuestFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileRequest) ProtoMessage()               {}
+func (*GuestFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *GuestFileRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestFileRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *GuestFileRequest) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *GuestFileRequest) GetMaxSize() int64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+type GuestFileResponse struct {
+	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Content  []byte    `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (m *GuestFileResponse) Reset()                    { *m = GuestFileResponse{} }
+func (m *GuestFileResponse) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileResponse) ProtoMessage()               {}
+func (*GuestFileResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *GuestFileResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GuestFileResponse) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QemuVersionResponse)(nil), "kubevirt.cmd.v1.QemuVersionResponse")
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
@@ -990,6 +1088,8 @@ func init() {
 	proto.RegisterType((*LaunchMeasurementResponse)(nil), "kubevirt.cmd.v1.LaunchMeasurementResponse")
 	proto.RegisterType((*InjectLaunchSecretRequest)(nil), "kubevirt.cmd.v1.InjectLaunchSecretRequest")
 	proto.RegisterType((*DirtyRateStatsResponse)(nil), "kubevirt.cmd.v1.DirtyRateStatsResponse")
+	proto.RegisterType((*GuestFileRequest)(nil), "kubevirt.cmd.v1.GuestFileRequest")
+	proto.RegisterType((*GuestFileResponse)(nil), "kubevirt.cmd.v1.GuestFileResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1035,6 +1135,9 @@ type CmdClient interface {
 	GetLaunchMeasurement(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(ctx context.Context, in *InjectLaunchSecretRequest, opts ...grpc.CallOption) (*Response, error)
 	GetDomainDirtyRateStats(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DirtyRateStatsResponse, error)
+	GuestOSExec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error)
+	GuestFileRead(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error)
+	GuestFileWrite(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type cmdClient struct {
@@ -1333,6 +1436,33 @@ func (c *cmdClient) GetDomainDirtyRateStats(ctx context.Context, in *EmptyReques
 	return out, nil
 }
 
+func (c *cmdClient) GuestOSExec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error) {
+	out := new(ExecResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestOSExec", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestFileRead(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error) {
+	out := new(GuestFileResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestFileRead", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestFileWrite(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestFileWrite", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cmd service
 
 type CmdServer interface {
@@ -1368,6 +1498,9 @@ type CmdServer interface {
 	GetLaunchMeasurement(context.Context, *VMIRequest) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(context.Context, *InjectLaunchSecretRequest) (*Response, error)
 	GetDomainDirtyRateStats(context.Context, *EmptyRequest) (*DirtyRateStatsResponse, error)
+	GuestOSExec(context.Context, *ExecRequest) (*ExecResponse, error)
+	GuestFileRead(context.Context, *GuestFileRequest) (*GuestFileResponse, error)
+	GuestFileWrite(context.Context, *GuestFileRequest) (*Response, error)
 }
 
 func RegisterCmdServer(s *grpc.Server, srv CmdServer) {
@@ -1950,6 +2083,60 @@ func _Cmd_GetDomainDirtyRateStats_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_GuestOSExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestOSExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestOSExec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestOSExec(ctx, req.(*ExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestFileRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestFileRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestFileRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestFileRead(ctx, req.(*GuestFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestFileWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestFileWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestFileWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestFileWrite(ctx, req.(*GuestFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cmd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.cmd.v1.Cmd",
 	HandlerType: (*CmdServer)(nil),
@@ -2082,6 +2269,18 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetDomainDirtyRateStats",
 			Handler:    _Cmd_GetDomainDirtyRateStats_Handler,
 		},
+		{
+			MethodName: "GuestOSExec",
+			Handler:    _Cmd_GuestOSExec_Handler,
+		},
+		{
+			MethodName: "GuestFileRead",
+			Handler:    _Cmd_GuestFileRead_Handler,
+		},
+		{
+			MethodName: "GuestFileWrite",
+			Handler:    _Cmd_GuestFileWrite_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/handler-launcher-com/cmd/v1/cmd.proto",
@@ -2090,121 +2289,129 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1852 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x73, 0x1b, 0xb7,
-	0x11, 0x17, 0x45, 0x4a, 0x26, 0x57, 0x7f, 0x12, 0xc3, 0x92, 0x7c, 0x56, 0x6b, 0x5b, 0xc5, 0x74,
-	0x5c, 0xa5, 0x93, 0x48, 0xb5, 0xe3, 0x64, 0x3a, 0x9e, 0x4e, 0xc6, 0x11, 0x45, 0x29, 0x4a, 0x4c,
-	0x9b, 0x39, 0x4a, 0xf2, 0x34, 0x6d, 0x26, 0x03, 0xdd, 0x41, 0x14, 0xaa, 0x3b, 0x80, 0x39, 0xe0,
-	0x58, 0x33, 0x4f, 0x9d, 0x49, 0xa7, 0x0f, 0x9d, 0xe9, 0x57, 0xe9, 0xd7, 0xe9, 0x5b, 0xbf, 0x45,
-	0xdf, 0x3b, 0xc0, 0xe1, 0xa8, 0x23, 0xef, 0x4e, 0xb2, 0x86, 0x7c, 0xe2, 0x01, 0xd8, 0xfd, 0xed,
-	0x62, 0xb1, 0xbb, 0xf8, 0xdd, 0x11, 0x3e, 0xea, 0x5f, 0xf6, 0x76, 0x2f, 0x08, 0xf7, 0x03, 0x1a,
-	0x7d, 0x12, 0x90, 0x98, 0x7b, 0x17, 0x34, 0xfa, 0xc4, 0x13, 0xe1, 0xae, 0x17, 0xfa, 0xbb, 0x83,
-	0xa7, 0xfa, 0x67, 0xa7, 0x1f, 0x09, 0x25, 0xd0, 0x07, 0x97, 0xf1, 0x19, 0x1d, 0xb0, 0x48, 0xed,
-	0xe8, 0xb9, 0xc1, 0x53, 0x7c, 0x0e, 0xf7, 0xbe, 0xa5, 0x61, 0x7c, 0x4a, 0x23, 0xc9, 0x04, 0x77,
-	0xa9, 0xec, 0x0b, 0x2e, 0x29, 0xfa, 0x0c, 0xea, 0x91, 0x7d, 0x76, 0x2a, 0x5b, 0x95, 0xed, 0xa5,
-	0x67, 0x0f, 0x76, 0x26, 0x54, 0x77, 0x52, 0x61, 0x77, 0x24, 0x8a, 0x1c, 0xb8, 0x33, 0x48, 0x90,
-	0x9c, 0xf9, 0xad, 0xca, 0x76, 0xc3, 0x4d, 0x87, 0xf8, 0x31, 0x54, 0x4f, 0xdb, 0x47, 0x46, 0x20,
-	0x64, 0x5f, 0x4b, 0xc1, 0x0d, 0xec, 0xb2, 0x9b, 0x0e, 0xf1, 0x53, 0xa8, 0x36, 0x3b, 0x27, 0x68,
-	0x15, 0xe6, 0x99, 0x6f, 0xd6, 0x56, 0xdc, 0x79, 0xe6, 0xa3, 0x4d, 0xa8, 0x4b, 0x76, 0x16, 0x30,
-	0xde, 0x93, 0xce, 0xfc, 0x56, 0x75, 0x7b, 0xc5, 0x1d, 0x8d, 0xf1, 0x2e, 0xdc, 0xe9, 0x26, 0xcf,
-	0x39, 0xb5, 0x35, 0x58, 0x18, 0x90, 0x20, 0xa6, 0xc6, 0x8d, 0x9a, 0x9b, 0x0c, 0x70, 0x0b, 0x16,
-	0x3a, 0xa4, 0x47, 0xa5, 0x5e, 0xf6, 0x44, 0xcc, 0x95, 0xd1, 0xa8, 0xb9, 0xc9, 0x00, 0x21, 0xa8,
-	0xc5, 0x9c, 0x29, 0xeb, 0xba, 0x79, 0xd6, 0x73, 0x92, 0xfd, 0x44, 0x9d, 0xaa, 0x81, 0x36, 0xcf,
-	0xf8, 0x39, 0x2c, 0xb6, 0x69, 0x28, 0xa2, 0x21, 0xda, 0x80, 0x45, 0x12, 0x66, 0x80, 0xec, 0xa8,
-	0x08, 0x09, 0xff, 0xa7, 0x02, 0xb5, 0x26, 0x0d, 0x82, 0x9c, 0xaf, 0xbb, 0xb0, 0x18, 0x1a, 0x38,
-	0x23, 0xbe, 0xf4, 0xec, 0x7e, 0x2e, 0xd2, 0x89, 0x35, 0xd7, 0x8a, 0xa1, 0x8f, 0x61, 0xa1, 0xaf,
-	0xb7, 0xe1, 0x54, 0xb7, 0xaa, 0xdb, 0x4b, 0xcf, 0x36, 0x72, 0xf2, 0x66, 0x93, 0x6e, 0x22, 0x84,
-	0x3e, 0x87, 0x86, 0xcf, 0xa4, 0x22, 0xdc, 0xa3, 0xd2, 0xa9, 0x19, 0x0d, 0x27, 0xa7, 0x61, 0xe3,
-	0xe8, 0x5e, 0x89, 0xa2, 0x6d, 0xa8, 0x79, 0xfd, 0x58, 0x3a, 0x0b, 0x46, 0x65, 0x2d, 0xa7, 0xd2,
-	0xec, 0x9c, 0xb8, 0x46, 0x02, 0xbf, 0x84, 0xfa, 0xb1, 0xe8, 0x8b, 0x40, 0xf4, 0x86, 0xe8, 0x39,
-	0x00, 0x8f, 0x43, 0xf2, 0x83, 0x47, 0x83, 0x40, 0x3a, 0x15, 0xa3, 0xbb, 0x9e, 0xd7, 0xa5, 0x41,
-	0xe0, 0x36, 0xb4, 0xa0, 0x7e, 0x92, 0xf8, 0x9f, 0x15, 0x58, 0xec, 0xb6, 0xf7, 0x98, 0x90, 0x08,
-	0xc3, 0x72, 0x48, 0x78, 0x7c, 0x4e, 0x3c, 0x15, 0x47, 0x34, 0x32, 0x71, 0x6a, 0xb8, 0x63, 0x73,
-	0x3a, 0x8b, 0xfa, 0x91, 0xf0, 0x63, 0x2f, 0x8d, 0x70, 0x3a, 0xcc, 0x26, 0x60, 0x75, 0x2c, 0x01,
-	0xd1, 0x87, 0x50, 0x95, 0x97, 0xb1, 0x53, 0x33, 0xb3, 0xfa, 0x51, 0x1f, 0xde, 0x39, 0x09, 0x59,
-	0x30, 0x74, 0x16, 0xcc, 0xa4, 0x1d, 0xe1, 0x7f, 0x54, 0xa0, 0xbe, 0xcf, 0xe4, 0xe5, 0x11, 0x3f,
-	0x17, 0x46, 0x48, 0x44, 0x21, 0x51, 0xd6, 0x11, 0x3b, 0x42, 0x5b, 0xb0, 0x74, 0x46, 0xbc, 0x4b,
-	0xc6, 0x7b, 0x07, 0x2c, 0xa0, 0xd6, 0x8d, 0xec, 0x14, 0x7a, 0x04, 0xa0, 0xfd, 0x25, 0x41, 0x37,
-	0xcd, 0x9f, 0x9a, 0x9b, 0x99, 0xd1, 0x08, 0x3a, 0x24, 0xa9, 0x40, 0xcd, 0x08, 0x64, 0xa7, 0xf0,
-	0xff, 0x2a, 0xb0, 0xd2, 0x0c, 0x62, 0xa9, 0x68, 0xd4, 0x14, 0xfc, 0x9c, 0xf5, 0xd0, 0x0e, 0xa0,
-	0xd6, 0xbb, 0x3e, 0xe1, 0xbe, 0xf6, 0x4f, 0xb6, 0x38, 0x39, 0x0b, 0x68, 0x92, 0x4a, 0x75, 0xb7,
-	0x60, 0x05, 0xfd, 0x01, 0x1e, 0x1c, 0x44, 0x94, 0xea, 0x7c, 0x70, 0x69, 0x5f, 0x44, 0x8a, 0xf1,
-	0xde, 0x3e, 0x93, 0x89, 0xda, 0xbc, 0x51, 0x2b, 0x17, 0x40, 0x2f, 0xc0, 0xd9, 0x13, 0xde, 0x85,
-	0xdc, 0x67, 0xb2, 0x1f, 0x90, 0xe1, 0x81, 0x88, 0x5a, 0x07, 0x47, 0x87, 0x31, 0x95, 0x4a, 0x9a,
-	0xfd, 0xd4, 0xdd, 0xd2, 0x75, 0xad, 0xdb, 0xa5, 0x11, 0x23, 0x41, 0x53, 0x70, 0x29, 0x02, 0xfa,
-	0x4a, 0x5c, 0x19, 0xae, 0x25, 0xba, 0x65, 0xeb, 0xf8, 0x53, 0x78, 0x70, 0xc4, 0x15, 0x8d, 0xce,
-	0x89, 0x47, 0xf7, 0x18, 0xf7, 0x19, 0xef, 0xb5, 0x59, 0x2f, 0x22, 0x4a, 0x9f, 0xe3, 0x86, 0x2e,
-	0x3e, 0x75, 0x21, 0xfc, 0xf4, 0x40, 0x92, 0x11, 0xfe, 0xef, 0x1d, 0x58, 0x3f, 0x4d, 0x82, 0xd7,
-	0x26, 0xde, 0x05, 0xe3, 0xf4, 0x4d, 0x5f, 0x2b, 0x48, 0xf4, 0x0d, 0xac, 0x8d, 0x2f, 0x24, 0x99,
-	0x66, 0xfb, 0x5a, 0xbe, 0xda, 0x92, 0x65, 0xb7, 0x50, 0x09, 0x3d, 0x87, 0xf5, 0x36, 0x0d, 0xf7,
-	0x48, 0x10, 0x08, 0xc1, 0xbb, 0x8a, 0x28, 0xd9, 0xa1, 0x11, 0x13, 0x49, 0x34, 0x57, 0xdc, 0xe2,
-	0x45, 0xf4, 0x3b, 0xb8, 0xd7, 0x89, 0xa8, 0x9e, 0xf7, 0x88, 0xa2, 0xfe, 0xa9, 0x08, 0xe2, 0xd0,
-	0xd6, 0x6f, 0xc3, 0x2d, 0x5a, 0xd2, 0x0d, 0x58, 0xd9, 0x9a, 0x32, 0xf1, 0x2a, 0x6a, 0xc0, 0x69,
-	0xd1, 0xb9, 0x23, 0x51, 0xd4, 0x85, 0x86, 0x49, 0x00, 0x9d, 0xbb, 0xb6, 0x72, 0x3f, 0xcb, 0xe9,
-	0x15, 0x86, 0x69, 0x67, 0xa4, 0xd7, 0xe2, 0x2a, 0x1a, 0xba, 0x57, 0x38, 0x25, 0x59, 0xb7, 0x58,
-	0x9a, 0x75, 0xfb, 0xb0, 0xe2, 0x65, 0xd3, 0xd6, 0xb9, 0x63, 0x36, 0xf0, 0x28, 0xdf, 0x06, 0xb2,
-	0x52, 0xee, 0xb8, 0x12, 0xfa, 0xb9, 0x02, 0x0f, 0x58, 0x9a, 0x06, 0xfb, 0x22, 0x24, 0x8c, 0x7f,
-	0xa9, 0x14, 0xf1, 0x2e, 0x42, 0xca, 0x95, 0x53, 0x37, 0x7b, 0x6b, 0xbd, 0xe7, 0xde, 0x8e, 0xca,
-	0x70, 0x92, 0xbd, 0x96, 0xdb, 0x41, 0x1c, 0xd0, 0x68, 0x71, 0x94, 0x84, 0x4e, 0xc3, 0x58, 0xff,
-	0xe2, 0xb6, 0xd6, 0x47, 0x00, 0x89, 0xd9, 0x02, 0xe4, 0xcd, 0xb7, 0xb0, 0x3a, 0x7e, 0x10, 0xba,
-	0x71, 0x5d, 0xd2, 0xa1, 0xcd, 0x76, 0xfd, 0x88, 0x76, 0xb3, 0x97, 0x5b, 0x51, 0x62, 0xa4, 0xdd,
-	0xcb, 0xde, 0x7b, 0x2f, 0xe6, 0x7f, 0x5f, 0xd9, 0x7c, 0x05, 0x8f, 0xae, 0x8f, 0x42, 0x81, 0xa1,
-	0xb1, 0x5b, 0xb4, 0x91, 0x45, 0xfb, 0x11, 0xee, 0x97, 0xec, 0xaa, 0x00, 0xe6, 0xe5, 0xb8, 0xbf,
-	0xbf, 0xcd, 0xf9, 0x5b, 0x5a, 0xed, 0x19, 0x93, 0x78, 0x00, 0x70, 0xda, 0x3e, 0x72, 0xe9, 0x8f,
-	0xba, 0xc1, 0xa0, 0x27, 0x50, 0x1d, 0x84, 0xcc, 0xd6, 0x70, 0xfe, 0x72, 0xd2, 0x92, 0x5a, 0x00,
-	0xbd, 0x84, 0x3b, 0x22, 0x39, 0x06, 0x6b, 0xfd, 0xc9, 0xfb, 0x1d, 0x9a, 0x9b, 0xaa, 0xe1, 0x63,
-	0xf8, 0xf0, 0xca, 0x9f, 0x5b, 0x5a, 0x77, 0xc6, 0xad, 0x2f, 0x5f, 0xa1, 0xfe, 0x5c, 0x81, 0xa5,
-	0xd6, 0x3b, 0xea, 0xa5, 0x88, 0x8f, 0x00, 0x7c, 0x73, 0x2a, 0xaf, 0x49, 0x48, 0x6d, 0xf0, 0x32,
-	0x33, 0x1a, 0xa9, 0x29, 0xc2, 0x90, 0x70, 0x3f, 0xbd, 0xf2, 0xec, 0x50, 0x73, 0x8d, 0x2f, 0xa3,
-	0x5e, 0xda, 0x4c, 0xcc, 0x33, 0x7a, 0x02, 0xab, 0x8a, 0x85, 0x54, 0xc4, 0xaa, 0x4b, 0x3d, 0xc1,
-	0x7d, 0x69, 0x7a, 0xc8, 0x82, 0x3b, 0x31, 0x8b, 0x57, 0x61, 0xb9, 0x15, 0xf6, 0xd5, 0xd0, 0x7a,
-	0x81, 0xbf, 0x80, 0xba, 0x9b, 0xe1, 0x72, 0x32, 0xf6, 0x3c, 0x2a, 0xa5, 0xbd, 0x60, 0xd2, 0xa1,
-	0x5e, 0x09, 0xa9, 0x94, 0xa4, 0x97, 0x26, 0x46, 0x3a, 0xc4, 0x3f, 0xc0, 0x6a, 0x92, 0x5b, 0xd3,
-	0x12, 0xc9, 0x0d, 0x58, 0x4c, 0x36, 0x6f, 0x2d, 0xd8, 0x11, 0xe6, 0x70, 0x2f, 0x31, 0x60, 0xba,
-	0xeb, 0xb4, 0x56, 0xb6, 0x60, 0xc9, 0xbf, 0x42, 0x4b, 0x2f, 0xf1, 0xcc, 0x14, 0x7e, 0x07, 0x77,
-	0xcd, 0x85, 0x66, 0xaa, 0x69, 0x4a, 0x6b, 0x1f, 0xc3, 0xdd, 0xde, 0x24, 0x96, 0xb5, 0x99, 0x5f,
-	0xc0, 0x7f, 0xaf, 0xc0, 0xba, 0x31, 0x7d, 0x22, 0x69, 0xf4, 0x8a, 0x49, 0x35, 0xad, 0xf9, 0xe7,
-	0xb0, 0xde, 0x2b, 0xc2, 0xb3, 0x2e, 0x14, 0x2f, 0xe2, 0x7f, 0x55, 0xc0, 0x31, 0x6e, 0x68, 0x4e,
-	0x23, 0x87, 0x52, 0xd1, 0x70, 0xea, 0xb0, 0xbf, 0x00, 0xa7, 0x57, 0x02, 0x69, 0x9d, 0x29, 0x5d,
-	0xc7, 0x43, 0x58, 0x4e, 0xca, 0x66, 0x3a, 0x17, 0x36, 0xa1, 0x4e, 0xdf, 0x31, 0xd5, 0x14, 0x7e,
-	0x62, 0x72, 0xc1, 0x1d, 0x8d, 0x75, 0xee, 0x49, 0xe5, 0xbf, 0x89, 0x95, 0xa5, 0x90, 0x76, 0x84,
-	0xbf, 0x83, 0x0f, 0x4d, 0x24, 0x3a, 0x9a, 0x28, 0xbf, 0x67, 0xd9, 0xe6, 0x0b, 0x71, 0xbe, 0xb0,
-	0x10, 0xbf, 0xb6, 0x79, 0x96, 0x60, 0x4f, 0xb5, 0x37, 0x2c, 0x60, 0x45, 0x73, 0xba, 0x9f, 0xe8,
-	0x6d, 0xbb, 0xd5, 0xe7, 0xb0, 0x11, 0xf3, 0x73, 0xa3, 0x7a, 0x5c, 0xe4, 0x74, 0xc9, 0x2a, 0x7e,
-	0x0b, 0x77, 0x93, 0x37, 0x94, 0xfd, 0x38, 0xec, 0xdf, 0xd6, 0xe8, 0x26, 0xd4, 0xfd, 0x38, 0xec,
-	0x77, 0x88, 0xba, 0xb0, 0x87, 0x3f, 0x1a, 0xe3, 0x33, 0xf8, 0xa0, 0xdb, 0x3a, 0x9d, 0x45, 0xed,
-	0xe9, 0x66, 0x46, 0x07, 0x86, 0x15, 0xd9, 0x46, 0x6c, 0x87, 0xf8, 0x6f, 0x15, 0x78, 0xf0, 0xca,
-	0xbc, 0x33, 0xb7, 0x29, 0x91, 0x71, 0x44, 0xf5, 0x85, 0x38, 0x83, 0x52, 0x0f, 0x26, 0x31, 0xad,
-	0xe1, 0xfc, 0x02, 0xfe, 0x5e, 0xf3, 0xdd, 0xbf, 0x50, 0x4f, 0x25, 0x7e, 0x74, 0xa9, 0x17, 0x51,
-	0x35, 0xbb, 0xab, 0x46, 0xc2, 0xc6, 0x3e, 0x8b, 0xd4, 0xd0, 0x25, 0x8a, 0xce, 0xa4, 0x6d, 0x62,
-	0x58, 0xf6, 0x53, 0xc0, 0xf6, 0x59, 0x62, 0xaf, 0xea, 0x8e, 0xcd, 0x3d, 0xfb, 0xf7, 0x3a, 0x54,
-	0x9b, 0xa1, 0x8f, 0x5e, 0x03, 0xea, 0x0e, 0xb9, 0x37, 0x7e, 0xc7, 0xa2, 0x5f, 0x14, 0xee, 0x23,
-	0xd9, 0xf1, 0x66, 0xb9, 0x0f, 0x78, 0x0e, 0xbd, 0x81, 0x7b, 0x1d, 0x12, 0x4b, 0x3a, 0x33, 0xc0,
-	0x6f, 0x61, 0xfd, 0x84, 0xf7, 0x67, 0x0a, 0xd9, 0x85, 0xb5, 0xa4, 0x00, 0x27, 0x10, 0xf3, 0x04,
-	0x78, 0xac, 0x4e, 0xaf, 0x07, 0x75, 0x61, 0xe3, 0xc4, 0x96, 0xdf, 0x2c, 0x83, 0xe9, 0x52, 0x49,
-	0xd5, 0xcc, 0x00, 0x8f, 0xc1, 0xe9, 0x8a, 0x73, 0xe5, 0xd2, 0x33, 0x21, 0x66, 0x87, 0xea, 0xc2,
-	0x46, 0xf7, 0x22, 0x56, 0xbe, 0xf8, 0x2b, 0x9f, 0x19, 0xe6, 0x6b, 0x40, 0xdf, 0xb0, 0x20, 0x98,
-	0x19, 0x5e, 0x07, 0xd6, 0xf6, 0x69, 0x40, 0xd5, 0xec, 0x0e, 0xe7, 0x2d, 0xac, 0x27, 0xbc, 0x73,
-	0x12, 0xf2, 0x57, 0xf9, 0xef, 0x43, 0x13, 0xfc, 0xf4, 0xc6, 0x53, 0xd7, 0x25, 0x39, 0x52, 0x3a,
-	0x26, 0x51, 0x8f, 0xaa, 0x29, 0x3c, 0xfd, 0x23, 0x3c, 0x6c, 0x12, 0xee, 0xd1, 0x89, 0x68, 0x5e,
-	0xbd, 0xb3, 0x4f, 0x77, 0xf4, 0xac, 0xc7, 0x49, 0x90, 0x38, 0xd9, 0x11, 0x7e, 0x33, 0xa0, 0x84,
-	0xc7, 0xfd, 0x29, 0x30, 0xff, 0x04, 0x8f, 0x0f, 0x18, 0x27, 0x01, 0x9b, 0xac, 0xa4, 0x59, 0x38,
-	0xfc, 0x1a, 0xd0, 0x57, 0x42, 0xf5, 0x83, 0xb8, 0xf7, 0x95, 0x90, 0x6a, 0x9f, 0x0e, 0x98, 0x47,
-	0xe5, 0x14, 0x78, 0x6d, 0x68, 0x1c, 0x52, 0x95, 0x70, 0x5e, 0xf4, 0x30, 0x27, 0x99, 0x65, 0xef,
-	0x9b, 0x8f, 0xf3, 0x2f, 0x82, 0x63, 0x64, 0xdc, 0x24, 0xd5, 0xea, 0x08, 0xce, 0xdc, 0x05, 0x37,
-	0x61, 0xfe, 0xba, 0x04, 0x73, 0xec, 0x22, 0x31, 0x3d, 0x6f, 0xf9, 0x90, 0xaa, 0x11, 0x57, 0xbe,
-	0x09, 0x16, 0xe7, 0x96, 0x73, 0x34, 0xdb, 0x80, 0xd6, 0x0f, 0xa9, 0xe1, 0xa4, 0x37, 0xfa, 0xf9,
-	0xa4, 0x18, 0x30, 0xc7, 0x67, 0xe7, 0xd0, 0x9f, 0x4d, 0x08, 0x32, 0xdc, 0xf2, 0x26, 0xe8, 0x8f,
-	0x8a, 0xa1, 0x8b, 0xd8, 0xe9, 0x1c, 0xda, 0x83, 0x9a, 0xe6, 0x70, 0x37, 0x61, 0x5e, 0x7b, 0xe6,
-	0x2d, 0xa8, 0x69, 0x8e, 0x8b, 0x7e, 0x99, 0xc7, 0xb8, 0x7a, 0x63, 0xdc, 0x7c, 0x58, 0xb2, 0x9a,
-	0x69, 0xc6, 0x8d, 0x11, 0xa7, 0x2c, 0x68, 0x1a, 0x93, 0x5c, 0xb6, 0xec, 0x4c, 0xb2, 0x94, 0xd4,
-	0x54, 0x8f, 0x33, 0x51, 0x35, 0x23, 0xea, 0x87, 0x70, 0xc9, 0x97, 0xeb, 0x0c, 0x2f, 0xbc, 0xa9,
-	0xe7, 0xe9, 0xb3, 0xc9, 0xfc, 0x21, 0x71, 0xfb, 0xf4, 0x2c, 0xf8, 0x37, 0xc3, 0xf6, 0x91, 0x1c,
-	0x0d, 0x69, 0x76, 0x4e, 0xe4, 0x94, 0x97, 0x5d, 0x0e, 0xd3, 0xfe, 0x31, 0x30, 0xcd, 0x9d, 0x0c,
-	0x87, 0x54, 0x59, 0xda, 0x7b, 0xd3, 0xf6, 0xb7, 0xf2, 0x1f, 0x2f, 0xc7, 0xf9, 0x32, 0x9e, 0x43,
-	0x04, 0xd6, 0x0e, 0xa9, 0xca, 0x51, 0xdc, 0xeb, 0x5d, 0xcc, 0x7f, 0xa3, 0x29, 0xe5, 0xc8, 0x78,
-	0x0e, 0x7d, 0x0f, 0x28, 0x4f, 0x60, 0x51, 0xd1, 0x77, 0x9e, 0x12, 0x96, 0x7b, 0x7d, 0x48, 0x3c,
-	0xb8, 0x3f, 0x6a, 0x5a, 0xe3, 0x4c, 0xf6, 0xa6, 0xf8, 0xfc, 0xa6, 0xe0, 0xd3, 0x58, 0x11, 0x13,
-	0xc6, 0x73, 0x7b, 0xb5, 0xef, 0xe6, 0x07, 0x4f, 0xcf, 0x16, 0xcd, 0xdf, 0x64, 0x9f, 0xfe, 0x3f,
-	0x00, 0x00, 0xff, 0xff, 0xf4, 0x5f, 0x67, 0x81, 0x53, 0x1b, 0x00, 0x00,
+	// 1973 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x6f, 0x1b, 0xc7,
+	0x11, 0x17, 0x45, 0x4a, 0x22, 0x47, 0x7f, 0x62, 0xaf, 0x25, 0xf9, 0xcc, 0xd6, 0xb6, 0xba, 0x28,
+	0x5c, 0xa5, 0x48, 0xa4, 0xda, 0x71, 0x82, 0xc2, 0x28, 0x02, 0x47, 0x14, 0xa5, 0x28, 0x11, 0x6d,
+	0xe6, 0x28, 0xc9, 0x6d, 0xda, 0x20, 0x58, 0xdd, 0xad, 0xa8, 0xab, 0xee, 0x76, 0x99, 0xdb, 0x3d,
+	0x56, 0xf4, 0x53, 0x81, 0x14, 0x7d, 0x28, 0xd0, 0x87, 0x7e, 0x84, 0xbe, 0xf5, 0x1b, 0xf5, 0xad,
+	0xdf, 0xa2, 0xef, 0xc1, 0xee, 0xed, 0x51, 0x47, 0xde, 0x9d, 0xfe, 0x80, 0x7c, 0xd2, 0xcd, 0xce,
+	0xcc, 0x6f, 0x66, 0x67, 0x67, 0x66, 0x67, 0x29, 0xf8, 0xb0, 0x77, 0xd1, 0xdd, 0x3e, 0x27, 0xcc,
+	0xf5, 0x69, 0xf8, 0xb1, 0x4f, 0x22, 0xe6, 0x9c, 0xd3, 0xf0, 0x63, 0x87, 0x07, 0xdb, 0x4e, 0xe0,
+	0x6e, 0xf7, 0x9f, 0xab, 0x3f, 0x5b, 0xbd, 0x90, 0x4b, 0x8e, 0x3e, 0xb8, 0x88, 0x4e, 0x69, 0xdf,
+	0x0b, 0xe5, 0x96, 0x5a, 0xeb, 0x3f, 0xc7, 0x67, 0xf0, 0xe0, 0x1b, 0x1a, 0x44, 0x27, 0x34, 0x14,
+	0x1e, 0x67, 0x36, 0x15, 0x3d, 0xce, 0x04, 0x45, 0x9f, 0x42, 0x35, 0x34, 0xdf, 0x56, 0x69, 0xa3,
+	0xb4, 0xb9, 0xf8, 0xe2, 0xd1, 0xd6, 0x98, 0xea, 0x56, 0x22, 0x6c, 0x0f, 0x45, 0x91, 0x05, 0x0b,
+	0xfd, 0x18, 0xc9, 0x9a, 0xdd, 0x28, 0x6d, 0xd6, 0xec, 0x84, 0xc4, 0x4f, 0xa1, 0x7c, 0xd2, 0x3a,
+	0xd0, 0x02, 0x81, 0xf7, 0x95, 0xe0, 0x4c, 0xc3, 0x2e, 0xd9, 0x09, 0x89, 0x9f, 0x43, 0xb9, 0xd1,
+	0x3e, 0x46, 0x2b, 0x30, 0xeb, 0xb9, 0x9a, 0xb7, 0x6c, 0xcf, 0x7a, 0x2e, 0xaa, 0x43, 0x55, 0x78,
+	0xa7, 0xbe, 0xc7, 0xba, 0xc2, 0x9a, 0xdd, 0x28, 0x6f, 0x2e, 0xdb, 0x43, 0x1a, 0x6f, 0xc3, 0x42,
+	0x27, 0xfe, 0xce, 0xa8, 0xad, 0xc2, 0x5c, 0x9f, 0xf8, 0x11, 0xd5, 0x6e, 0x54, 0xec, 0x98, 0xc0,
+	0x4d, 0x98, 0x6b, 0x93, 0x2e, 0x15, 0x8a, 0xed, 0xf0, 0x88, 0x49, 0xad, 0x51, 0xb1, 0x63, 0x02,
+	0x21, 0xa8, 0x44, 0xcc, 0x93, 0xc6, 0x75, 0xfd, 0xad, 0xd6, 0x84, 0xf7, 0x9e, 0x5a, 0x65, 0x0d,
+	0xad, 0xbf, 0xf1, 0x4b, 0x98, 0x6f, 0xd1, 0x80, 0x87, 0x03, 0xb4, 0x0e, 0xf3, 0x24, 0x48, 0x01,
+	0x19, 0x2a, 0x0f, 0x09, 0xff, 0xb7, 0x04, 0x95, 0x06, 0xf5, 0xfd, 0x8c, 0xaf, 0xdb, 0x30, 0x1f,
+	0x68, 0x38, 0x2d, 0xbe, 0xf8, 0xe2, 0x61, 0x26, 0xd2, 0xb1, 0x35, 0xdb, 0x88, 0xa1, 0x8f, 0x60,
+	0xae, 0xa7, 0xb6, 0x61, 0x95, 0x37, 0xca, 0x9b, 0x8b, 0x2f, 0xd6, 0x33, 0xf2, 0x7a, 0x93, 0x76,
+	0x2c, 0x84, 0x3e, 0x83, 0x9a, 0xeb, 0x09, 0x49, 0x98, 0x43, 0x85, 0x55, 0xd1, 0x1a, 0x56, 0x46,
+	0xc3, 0xc4, 0xd1, 0xbe, 0x12, 0x45, 0x9b, 0x50, 0x71, 0x7a, 0x91, 0xb0, 0xe6, 0xb4, 0xca, 0x6a,
+	0x46, 0xa5, 0xd1, 0x3e, 0xb6, 0xb5, 0x04, 0x7e, 0x0d, 0xd5, 0x23, 0xde, 0xe3, 0x3e, 0xef, 0x0e,
+	0xd0, 0x4b, 0x00, 0x16, 0x05, 0xe4, 0x7b, 0x87, 0xfa, 0xbe, 0xb0, 0x4a, 0x5a, 0x77, 0x2d, 0xab,
+	0x4b, 0x7d, 0xdf, 0xae, 0x29, 0x41, 0xf5, 0x25, 0xf0, 0x3f, 0x4a, 0x30, 0xdf, 0x69, 0xed, 0x78,
+	0x5c, 0x20, 0x0c, 0x4b, 0x01, 0x61, 0xd1, 0x19, 0x71, 0x64, 0x14, 0xd2, 0x50, 0xc7, 0xa9, 0x66,
+	0x8f, 0xac, 0xa9, 0x2c, 0xea, 0x85, 0xdc, 0x8d, 0x9c, 0x24, 0xc2, 0x09, 0x99, 0x4e, 0xc0, 0xf2,
+	0x48, 0x02, 0xa2, 0x7b, 0x50, 0x16, 0x17, 0x91, 0x55, 0xd1, 0xab, 0xea, 0x53, 0x1d, 0xde, 0x19,
+	0x09, 0x3c, 0x7f, 0x60, 0xcd, 0xe9, 0x45, 0x43, 0xe1, 0xbf, 0x97, 0xa0, 0xba, 0xeb, 0x89, 0x8b,
+	0x03, 0x76, 0xc6, 0xb5, 0x10, 0x0f, 0x03, 0x22, 0x8d, 0x23, 0x86, 0x42, 0x1b, 0xb0, 0x78, 0x4a,
+	0x9c, 0x0b, 0x8f, 0x75, 0xf7, 0x3c, 0x9f, 0x1a, 0x37, 0xd2, 0x4b, 0xe8, 0x09, 0x80, 0xf2, 0x97,
+	0xf8, 0x9d, 0x24, 0x7f, 0x2a, 0x76, 0x6a, 0x45, 0x21, 0xa8, 0x90, 0x24, 0x02, 0x15, 0x2d, 0x90,
+	0x5e, 0xc2, 0xff, 0x2f, 0xc1, 0x72, 0xc3, 0x8f, 0x84, 0xa4, 0x61, 0x83, 0xb3, 0x33, 0xaf, 0x8b,
+	0xb6, 0x00, 0x35, 0x2f, 0x7b, 0x84, 0xb9, 0xca, 0x3f, 0xd1, 0x64, 0xe4, 0xd4, 0xa7, 0x71, 0x2a,
+	0x55, 0xed, 0x1c, 0x0e, 0xfa, 0x1d, 0x3c, 0xda, 0x0b, 0x29, 0x55, 0xf9, 0x60, 0xd3, 0x1e, 0x0f,
+	0xa5, 0xc7, 0xba, 0xbb, 0x9e, 0x88, 0xd5, 0x66, 0xb5, 0x5a, 0xb1, 0x00, 0x7a, 0x05, 0xd6, 0x0e,
+	0x77, 0xce, 0xc5, 0xae, 0x27, 0x7a, 0x3e, 0x19, 0xec, 0xf1, 0xb0, 0xb9, 0x77, 0xb0, 0x1f, 0x51,
+	0x21, 0x85, 0xde, 0x4f, 0xd5, 0x2e, 0xe4, 0x2b, 0xdd, 0x0e, 0x0d, 0x3d, 0xe2, 0x37, 0x38, 0x13,
+	0xdc, 0xa7, 0x87, 0xfc, 0xca, 0x70, 0x25, 0xd6, 0x2d, 0xe2, 0xe3, 0x4f, 0xe0, 0xd1, 0x01, 0x93,
+	0x34, 0x3c, 0x23, 0x0e, 0xdd, 0xf1, 0x98, 0xeb, 0xb1, 0x6e, 0xcb, 0xeb, 0x86, 0x44, 0xaa, 0x73,
+	0x5c, 0x57, 0xc5, 0x27, 0xcf, 0xb9, 0x9b, 0x1c, 0x48, 0x4c, 0xe1, 0xff, 0x2d, 0xc0, 0xda, 0x49,
+	0x1c, 0xbc, 0x16, 0x71, 0xce, 0x3d, 0x46, 0xdf, 0xf6, 0x94, 0x82, 0x40, 0x5f, 0xc3, 0xea, 0x28,
+	0x23, 0xce, 0x34, 0xab, 0x54, 0x50, 0x6d, 0x31, 0xdb, 0xce, 0x55, 0x42, 0x2f, 0x61, 0xad, 0x45,
+	0x83, 0x1d, 0xe2, 0xfb, 0x9c, 0xb3, 0x8e, 0x24, 0x52, 0xb4, 0x69, 0xe8, 0xf1, 0x38, 0x9a, 0xcb,
+	0x76, 0x3e, 0x13, 0xfd, 0x06, 0x1e, 0xb4, 0x43, 0xaa, 0xd6, 0x1d, 0x22, 0xa9, 0x7b, 0xc2, 0xfd,
+	0x28, 0x30, 0xf5, 0x5b, 0xb3, 0xf3, 0x58, 0xaa, 0x01, 0x4b, 0x53, 0x53, 0x56, 0xa5, 0xa0, 0x01,
+	0x27, 0x45, 0x67, 0x0f, 0x45, 0x51, 0x07, 0x6a, 0x3a, 0x01, 0x54, 0xee, 0x9a, 0xca, 0xfd, 0x34,
+	0xa3, 0x97, 0x1b, 0xa6, 0xad, 0xa1, 0x5e, 0x93, 0xc9, 0x70, 0x60, 0x5f, 0xe1, 0x14, 0x64, 0xdd,
+	0x7c, 0x61, 0xd6, 0xed, 0xc2, 0xb2, 0x93, 0x4e, 0x5b, 0x6b, 0x41, 0x6f, 0xe0, 0x49, 0xb6, 0x0d,
+	0xa4, 0xa5, 0xec, 0x51, 0x25, 0xf4, 0x63, 0x09, 0x1e, 0x79, 0x49, 0x1a, 0xec, 0xf2, 0x80, 0x78,
+	0xec, 0x0b, 0x29, 0x89, 0x73, 0x1e, 0x50, 0x26, 0xad, 0xaa, 0xde, 0x5b, 0xf3, 0x96, 0x7b, 0x3b,
+	0x28, 0xc2, 0x89, 0xf7, 0x5a, 0x6c, 0x07, 0x31, 0x40, 0x43, 0xe6, 0x30, 0x09, 0xad, 0x9a, 0xb6,
+	0xfe, 0xf9, 0x5d, 0xad, 0x0f, 0x01, 0x62, 0xb3, 0x39, 0xc8, 0xf5, 0x77, 0xb0, 0x32, 0x7a, 0x10,
+	0xaa, 0x71, 0x5d, 0xd0, 0x81, 0xc9, 0x76, 0xf5, 0x89, 0xb6, 0xd3, 0x97, 0x5b, 0x5e, 0x62, 0x24,
+	0xdd, 0xcb, 0xdc, 0x7b, 0xaf, 0x66, 0x7f, 0x5b, 0xaa, 0x1f, 0xc2, 0x93, 0xeb, 0xa3, 0x90, 0x63,
+	0x68, 0xe4, 0x16, 0xad, 0xa5, 0xd1, 0x7e, 0x80, 0x87, 0x05, 0xbb, 0xca, 0x81, 0x79, 0x3d, 0xea,
+	0xef, 0xaf, 0x33, 0xfe, 0x16, 0x56, 0x7b, 0xca, 0x24, 0xee, 0x03, 0x9c, 0xb4, 0x0e, 0x6c, 0xfa,
+	0x83, 0x6a, 0x30, 0xe8, 0x19, 0x94, 0xfb, 0x81, 0x67, 0x6a, 0x38, 0x7b, 0x39, 0x29, 0x49, 0x25,
+	0x80, 0x5e, 0xc3, 0x02, 0x8f, 0x8f, 0xc1, 0x58, 0x7f, 0x76, 0xbb, 0x43, 0xb3, 0x13, 0x35, 0x7c,
+	0x04, 0xf7, 0xae, 0xfc, 0xb9, 0xa3, 0x75, 0x6b, 0xd4, 0xfa, 0xd2, 0x15, 0xea, 0x8f, 0x25, 0x58,
+	0x6c, 0x5e, 0x52, 0x27, 0x41, 0x7c, 0x02, 0xe0, 0xea, 0x53, 0x79, 0x43, 0x02, 0x6a, 0x82, 0x97,
+	0x5a, 0x51, 0x48, 0x0d, 0x1e, 0x04, 0x84, 0xb9, 0xc9, 0x95, 0x67, 0x48, 0x35, 0x6b, 0x7c, 0x11,
+	0x76, 0x93, 0x66, 0xa2, 0xbf, 0xd1, 0x33, 0x58, 0x91, 0x5e, 0x40, 0x79, 0x24, 0x3b, 0xd4, 0xe1,
+	0xcc, 0x15, 0xba, 0x87, 0xcc, 0xd9, 0x63, 0xab, 0x78, 0x05, 0x96, 0x9a, 0x41, 0x4f, 0x0e, 0x8c,
+	0x17, 0xf8, 0x73, 0xa8, 0xda, 0xa9, 0x59, 0x4e, 0x44, 0x8e, 0x43, 0x85, 0x30, 0x17, 0x4c, 0x42,
+	0x2a, 0x4e, 0x40, 0x85, 0x20, 0xdd, 0x24, 0x31, 0x12, 0x12, 0x7f, 0x0f, 0x2b, 0x71, 0x6e, 0x4d,
+	0x3a, 0x48, 0xae, 0xc3, 0x7c, 0xbc, 0x79, 0x63, 0xc1, 0x50, 0x98, 0xc1, 0x83, 0xd8, 0x80, 0xee,
+	0xae, 0x93, 0x5a, 0xd9, 0x80, 0x45, 0xf7, 0x0a, 0x2d, 0xb9, 0xc4, 0x53, 0x4b, 0xf8, 0x12, 0xee,
+	0xeb, 0x0b, 0x4d, 0x57, 0xd3, 0x84, 0xd6, 0x3e, 0x82, 0xfb, 0xdd, 0x71, 0x2c, 0x63, 0x33, 0xcb,
+	0xc0, 0x7f, 0x2b, 0xc1, 0x9a, 0x36, 0x7d, 0x2c, 0x68, 0x78, 0xe8, 0x09, 0x39, 0xa9, 0xf9, 0x97,
+	0xb0, 0xd6, 0xcd, 0xc3, 0x33, 0x2e, 0xe4, 0x33, 0xf1, 0x3f, 0x4b, 0x60, 0x69, 0x37, 0xd4, 0x4c,
+	0x23, 0x06, 0x42, 0xd2, 0x60, 0xe2, 0xb0, 0xbf, 0x02, 0xab, 0x5b, 0x00, 0x69, 0x9c, 0x29, 0xe4,
+	0xe3, 0x7f, 0x95, 0x60, 0x29, 0xae, 0x9b, 0xc9, 0x7c, 0xa8, 0x43, 0x95, 0x5e, 0x7a, 0xb2, 0xc1,
+	0xdd, 0xd8, 0xe6, 0x9c, 0x3d, 0xa4, 0x55, 0xf2, 0x09, 0xe9, 0xbe, 0x8d, 0xa4, 0x99, 0x21, 0x0d,
+	0x65, 0xd6, 0x9b, 0x61, 0x68, 0xa6, 0x48, 0x43, 0xe1, 0x6f, 0xe1, 0x9e, 0x0e, 0x51, 0x5b, 0x4d,
+	0xd0, 0xb7, 0xac, 0xe7, 0x6c, 0x85, 0xce, 0xe6, 0x56, 0xe8, 0x57, 0x70, 0x3f, 0x85, 0x3d, 0xd1,
+	0x9e, 0x31, 0x87, 0x65, 0x35, 0xec, 0xbd, 0xa7, 0x77, 0x6d, 0x63, 0x9f, 0xc1, 0x7a, 0xc4, 0xce,
+	0xb4, 0xea, 0x51, 0x9e, 0xd3, 0x05, 0x5c, 0xfc, 0xef, 0x12, 0xdc, 0x8f, 0xdf, 0x2e, 0xbb, 0x51,
+	0xd0, 0xbb, 0xab, 0xd5, 0x3a, 0x54, 0xdd, 0x28, 0xe8, 0xb5, 0x89, 0x3c, 0x37, 0x69, 0x31, 0xa4,
+	0x53, 0x63, 0x79, 0x79, 0x64, 0x2c, 0xb7, 0x60, 0xc1, 0xe1, 0x4c, 0xaa, 0x09, 0x21, 0x3e, 0xa3,
+	0x84, 0x8c, 0x0f, 0x2f, 0xa4, 0x24, 0xd0, 0xd3, 0x7e, 0xd5, 0x36, 0x14, 0x3e, 0x85, 0x0f, 0x3a,
+	0xcd, 0x93, 0x69, 0xd4, 0xb7, 0x6a, 0x98, 0xb4, 0xaf, 0x27, 0x2f, 0xd3, 0xec, 0x0d, 0x89, 0xff,
+	0x5a, 0x82, 0x47, 0x87, 0xfa, 0x5d, 0xde, 0xa2, 0x44, 0x44, 0x21, 0x55, 0x97, 0xee, 0x14, 0xda,
+	0x89, 0x3f, 0x8e, 0x69, 0x0c, 0x67, 0x19, 0xf8, 0x3b, 0x35, 0x53, 0xff, 0x99, 0x3a, 0x32, 0xf6,
+	0xa3, 0x43, 0x9d, 0x90, 0xca, 0xe9, 0x5d, 0x67, 0x02, 0xd6, 0x77, 0xbd, 0x50, 0x0e, 0x6c, 0x22,
+	0xe9, 0x54, 0x5a, 0x33, 0x86, 0x25, 0x37, 0x01, 0x6c, 0x9d, 0xc6, 0xf6, 0xca, 0xf6, 0xc8, 0x1a,
+	0x7e, 0x6f, 0xea, 0x4e, 0xf5, 0x89, 0xdb, 0xd6, 0x1d, 0x82, 0x4a, 0xef, 0x2a, 0xa1, 0xf4, 0x77,
+	0x3a, 0x69, 0xca, 0xf1, 0xb6, 0x0c, 0xa9, 0x38, 0x01, 0xb9, 0x1c, 0xbe, 0xcf, 0xca, 0x76, 0x42,
+	0x62, 0x17, 0xee, 0xa7, 0x6c, 0x4f, 0x9c, 0x38, 0x89, 0xfd, 0xd9, 0x11, 0xfb, 0x2f, 0xfe, 0xf3,
+	0x10, 0xca, 0x8d, 0xc0, 0x45, 0x6f, 0x00, 0x75, 0x06, 0xcc, 0x19, 0x9d, 0x54, 0xd0, 0xcf, 0x72,
+	0x4f, 0x2a, 0x0e, 0x44, 0xbd, 0xd8, 0x32, 0x9e, 0x41, 0x6f, 0xe1, 0x41, 0x9b, 0x44, 0x82, 0x4e,
+	0x0d, 0xf0, 0x1b, 0x58, 0x3b, 0x66, 0xbd, 0xa9, 0x42, 0x76, 0x60, 0x35, 0xee, 0x56, 0x63, 0x88,
+	0xd9, 0x67, 0xc4, 0x48, 0x53, 0xbb, 0x1e, 0xd4, 0x86, 0xf5, 0x63, 0x76, 0x96, 0x07, 0x3b, 0x51,
+	0x30, 0x6d, 0x2a, 0xa8, 0x9c, 0x1a, 0xe0, 0x11, 0x58, 0x1d, 0x7e, 0x26, 0x6d, 0x7a, 0xca, 0xf9,
+	0xf4, 0x50, 0x6d, 0x58, 0xef, 0x9c, 0x47, 0xd2, 0xe5, 0x7f, 0x61, 0x53, 0xc3, 0x7c, 0x03, 0xe8,
+	0x6b, 0xcf, 0xf7, 0xa7, 0x86, 0xd7, 0x86, 0xd5, 0x5d, 0xea, 0x53, 0x39, 0xbd, 0xc3, 0x79, 0x07,
+	0x6b, 0xf1, 0xf4, 0x3e, 0x0e, 0xf9, 0x8b, 0x8c, 0xd6, 0xf8, 0x94, 0x7f, 0xe3, 0xa9, 0xab, 0x92,
+	0x1c, 0x2a, 0x1d, 0x91, 0xb0, 0x4b, 0xe5, 0x04, 0x9e, 0xfe, 0x01, 0x1e, 0x37, 0xd4, 0x2f, 0x6f,
+	0x63, 0xd1, 0x1c, 0x1a, 0x98, 0xf0, 0xe8, 0xbd, 0x2e, 0x23, 0x7e, 0xec, 0x64, 0x9b, 0xbb, 0x0d,
+	0x9f, 0x12, 0x16, 0xf5, 0x26, 0xc0, 0xfc, 0x23, 0x3c, 0xdd, 0xf3, 0x18, 0xf1, 0xbd, 0xf7, 0x74,
+	0xfa, 0x0e, 0xbf, 0x01, 0xf4, 0x25, 0x97, 0x3d, 0x3f, 0xea, 0x7e, 0xc9, 0x85, 0xdc, 0xa5, 0x7d,
+	0xcf, 0xa1, 0x62, 0x02, 0xbc, 0x16, 0xd4, 0xf6, 0xa9, 0x8c, 0x5f, 0x0e, 0xe8, 0x71, 0x46, 0x32,
+	0xfd, 0x06, 0xaa, 0x3f, 0xcd, 0x3e, 0xa7, 0x47, 0x9e, 0x34, 0x3a, 0xa9, 0x56, 0x86, 0x70, 0xfa,
+	0xb6, 0xbb, 0x09, 0xf3, 0x97, 0x05, 0x98, 0x23, 0x57, 0xa5, 0xee, 0x79, 0x4b, 0xfb, 0x54, 0x0e,
+	0x5f, 0x1c, 0x37, 0xc1, 0xe2, 0x0c, 0x3b, 0xf3, 0x58, 0xd1, 0xa0, 0xd5, 0x7d, 0xaa, 0x27, 0xfb,
+	0x1b, 0xfd, 0x7c, 0x96, 0x0f, 0x98, 0x79, 0x15, 0xcc, 0xa0, 0x3f, 0xe9, 0x10, 0xa4, 0x26, 0xf4,
+	0x9b, 0xa0, 0x3f, 0xcc, 0x87, 0xce, 0x9b, 0xf1, 0x67, 0xd0, 0x0e, 0x54, 0xd4, 0xc0, 0x7b, 0x13,
+	0xe6, 0xb5, 0x67, 0xde, 0x84, 0x8a, 0x7a, 0x28, 0xa0, 0x9f, 0x67, 0x31, 0xae, 0xde, 0xdd, 0xf5,
+	0xc7, 0x05, 0xdc, 0x54, 0x33, 0xae, 0x0d, 0x07, 0xf0, 0x9c, 0xa6, 0x31, 0x3e, 0xf8, 0xd7, 0xf1,
+	0x75, 0x22, 0xa9, 0xea, 0xb1, 0xc6, 0xaa, 0x66, 0x38, 0x26, 0x23, 0x5c, 0xf0, 0xfb, 0x7f, 0x6a,
+	0x86, 0xbe, 0xa9, 0xe7, 0xa9, 0xb3, 0x49, 0xfd, 0x5b, 0xe7, 0xee, 0xe9, 0x99, 0xf3, 0x3f, 0x21,
+	0xd3, 0x47, 0x32, 0x63, 0x48, 0xa3, 0x7d, 0x2c, 0x26, 0xbc, 0xec, 0x32, 0x98, 0xf1, 0x86, 0x27,
+	0xba, 0x93, 0x61, 0x9f, 0x4a, 0x33, 0xd8, 0xdf, 0xb4, 0xfd, 0x8d, 0x0c, 0x7b, 0xec, 0x45, 0x80,
+	0x67, 0x10, 0x81, 0xd5, 0x7d, 0x2a, 0x33, 0x43, 0xfc, 0xf5, 0x2e, 0x66, 0x7f, 0xe9, 0x2a, 0x7c,
+	0x05, 0xe0, 0x19, 0xf4, 0x1d, 0xa0, 0xec, 0x88, 0x8e, 0xf2, 0x7e, 0x2d, 0x2b, 0x98, 0xe3, 0xaf,
+	0x0f, 0x89, 0x03, 0x0f, 0x87, 0x4d, 0x6b, 0x74, 0x56, 0xbf, 0x29, 0x3e, 0xbf, 0xca, 0xf9, 0x81,
+	0x31, 0x6f, 0xd6, 0xc7, 0x33, 0xe8, 0x10, 0x16, 0x75, 0xba, 0xbf, 0xed, 0x4c, 0xa3, 0xf6, 0x7e,
+	0x0f, 0xcb, 0xa9, 0x21, 0x9b, 0xb8, 0x45, 0xf5, 0x97, 0x7a, 0x00, 0xd4, 0xf1, 0x75, 0x22, 0xa9,
+	0x41, 0x63, 0x65, 0xb8, 0xfc, 0x2e, 0xf4, 0x24, 0xbd, 0x0d, 0xf4, 0x75, 0xe1, 0xdd, 0xa9, 0x7c,
+	0x3b, 0xdb, 0x7f, 0x7e, 0x3a, 0xaf, 0xff, 0xcd, 0xfa, 0xc9, 0x4f, 0x03, 0x00, 0x22, 0x47, 0xe5,
+	0x4b, 0x93, 0x1d, 0x00, 0x00,
 }
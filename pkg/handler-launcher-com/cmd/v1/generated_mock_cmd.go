@@ -321,6 +321,66 @@ func (mr *MockCmdClientMockRecorder) GetUsers(ctx, in any, opts ...any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockCmdClient)(nil).GetUsers), varargs...)
 }
 
+// GuestFileRead mocks base method.
+func (m *MockCmdClient) GuestFileRead(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GuestFileRead", varargs...)
+	ret0, _ := ret[0].(*GuestFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileRead indicates an expected call of GuestFileRead.
+func (mr *MockCmdClientMockRecorder) GuestFileRead(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileRead", reflect.TypeOf((*MockCmdClient)(nil).GuestFileRead), varargs...)
+}
+
+// GuestFileWrite mocks base method.
+func (m *MockCmdClient) GuestFileWrite(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GuestFileWrite", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileWrite indicates an expected call of GuestFileWrite.
+func (mr *MockCmdClientMockRecorder) GuestFileWrite(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileWrite", reflect.TypeOf((*MockCmdClient)(nil).GuestFileWrite), varargs...)
+}
+
+// GuestOSExec mocks base method.
+func (m *MockCmdClient) GuestOSExec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GuestOSExec", varargs...)
+	ret0, _ := ret[0].(*ExecResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestOSExec indicates an expected call of GuestOSExec.
+func (mr *MockCmdClientMockRecorder) GuestOSExec(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestOSExec", reflect.TypeOf((*MockCmdClient)(nil).GuestOSExec), varargs...)
+}
+
 // GuestPing mocks base method.
 func (m *MockCmdClient) GuestPing(ctx context.Context, in *GuestPingRequest, opts ...grpc.CallOption) (*GuestPingResponse, error) {
 	m.ctrl.T.Helper()
@@ -915,6 +975,51 @@ func (mr *MockCmdServerMockRecorder) GetUsers(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockCmdServer)(nil).GetUsers), arg0, arg1)
 }
 
+// GuestFileRead mocks base method.
+func (m *MockCmdServer) GuestFileRead(arg0 context.Context, arg1 *GuestFileRequest) (*GuestFileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileRead", arg0, arg1)
+	ret0, _ := ret[0].(*GuestFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileRead indicates an expected call of GuestFileRead.
+func (mr *MockCmdServerMockRecorder) GuestFileRead(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileRead", reflect.TypeOf((*MockCmdServer)(nil).GuestFileRead), arg0, arg1)
+}
+
+// GuestFileWrite mocks base method.
+func (m *MockCmdServer) GuestFileWrite(arg0 context.Context, arg1 *GuestFileRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileWrite", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileWrite indicates an expected call of GuestFileWrite.
+func (mr *MockCmdServerMockRecorder) GuestFileWrite(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileWrite", reflect.TypeOf((*MockCmdServer)(nil).GuestFileWrite), arg0, arg1)
+}
+
+// GuestOSExec mocks base method.
+func (m *MockCmdServer) GuestOSExec(arg0 context.Context, arg1 *ExecRequest) (*ExecResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestOSExec", arg0, arg1)
+	ret0, _ := ret[0].(*ExecResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestOSExec indicates an expected call of GuestOSExec.
+func (mr *MockCmdServerMockRecorder) GuestOSExec(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestOSExec", reflect.TypeOf((*MockCmdServer)(nil).GuestOSExec), arg0, arg1)
+}
+
 // GuestPing mocks base method.
 func (m *MockCmdServer) GuestPing(arg0 context.Context, arg1 *GuestPingRequest) (*GuestPingResponse, error) {
 	m.ctrl.T.Helper()
@@ -73,6 +73,20 @@ func (mr *MockManagerMockRecorder) OnDefineDomain(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnDefineDomain", reflect.TypeOf((*MockManager)(nil).OnDefineDomain), arg0, arg1)
 }
 
+// PostMigrationTarget mocks base method.
+func (m *MockManager) PostMigrationTarget(arg0 *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostMigrationTarget", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostMigrationTarget indicates an expected call of PostMigrationTarget.
+func (mr *MockManagerMockRecorder) PostMigrationTarget(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostMigrationTarget", reflect.TypeOf((*MockManager)(nil).PostMigrationTarget), arg0)
+}
+
 // PreCloudInitIso mocks base method.
 func (m *MockManager) PreCloudInitIso(arg0 *v1.VirtualMachineInstance, arg1 *cloudinit.CloudInitData) (*cloudinit.CloudInitData, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +102,20 @@ func (mr *MockManagerMockRecorder) PreCloudInitIso(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreCloudInitIso", reflect.TypeOf((*MockManager)(nil).PreCloudInitIso), arg0, arg1)
 }
 
+// PreShutdown mocks base method.
+func (m *MockManager) PreShutdown(arg0 *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreShutdown", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PreShutdown indicates an expected call of PreShutdown.
+func (mr *MockManagerMockRecorder) PreShutdown(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreShutdown", reflect.TypeOf((*MockManager)(nil).PreShutdown), arg0)
+}
+
 // Shutdown mocks base method.
 func (m *MockManager) Shutdown() error {
 	m.ctrl.T.Helper()
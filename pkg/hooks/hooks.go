@@ -21,6 +21,7 @@ package hooks
 
 import (
 	"encoding/json"
+	"strings"
 
 	k8sv1 "k8s.io/api/core/v1"
 
@@ -32,6 +33,8 @@ const HookSocketsSharedDirectory = "/var/run/kubevirt-hooks"
 
 const ContainerNameEnvVar = "CONTAINER_NAME"
 
+const specHookContainerNamePrefix = "hook-"
+
 type HookSidecarList []HookSidecar
 
 type ConfigMap struct {
@@ -54,6 +57,8 @@ type HookSidecar struct {
 	ConfigMap       *ConfigMap                       `json:"configMap,omitempty"`
 	PVC             *PVC                             `json:"pvc,omitempty"`
 	DownwardAPI     v1.NetworkBindingDownwardAPIType `json:"-"`
+	// ContainerName is set for the hooks of the VMI spec, other sidecars are named after their index
+	ContainerName string `json:"-"`
 }
 
 func UnmarshalHookSidecarList(vmiObject *v1.VirtualMachineInstance) (HookSidecarList, error) {
@@ -67,3 +72,39 @@ func UnmarshalHookSidecarList(vmiObject *v1.VirtualMachineInstance) (HookSidecar
 
 	return hookSidecarList, nil
 }
+
+// SpecHookContainerName returns the name of the sidecar container serving the hook of the VMI spec
+func SpecHookContainerName(hookName string) string {
+	return specHookContainerNamePrefix + hookName
+}
+
+// IsReservedSpecHookName reports whether the sidecar container of the hook could clash with
+// the hook-sidecar-<index> containers of the other sidecars
+func IsReservedSpecHookName(hookName string) bool {
+	return strings.HasPrefix(hookName, "sidecar-")
+}
+
+func SpecHookSidecarList(vmi *v1.VirtualMachineInstance) HookSidecarList {
+	hookSidecarList := make(HookSidecarList, 0, len(vmi.Spec.Hooks))
+	for _, hook := range vmi.Spec.Hooks {
+		hookSidecarList = append(hookSidecarList, HookSidecar{
+			Image:           hook.Image,
+			ImagePullPolicy: hook.ImagePullPolicy,
+			Command:         hook.Command,
+			Args:            hook.Args,
+			ContainerName:   SpecHookContainerName(hook.Name),
+		})
+	}
+	return hookSidecarList
+}
+
+// LookupSpecHook returns the hook of the VMI spec served by the given sidecar container,
+// nil if the container serves a hook requested by the annotation or a network binding plugin
+func LookupSpecHook(vmi *v1.VirtualMachineInstance, containerName string) *v1.Hook {
+	for i := range vmi.Spec.Hooks {
+		if SpecHookContainerName(vmi.Spec.Hooks[i].Name) == containerName {
+			return &vmi.Spec.Hooks[i]
+		}
+	}
+	return nil
+}
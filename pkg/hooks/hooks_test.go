@@ -65,5 +65,27 @@ var _ = Describe("HooksAPI", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(equality.Semantic.DeepEqual(hookSidecarList, expectedHookSidecarList)).To(BeTrue())
 		})
+
+		It("by converting the hooks of the VMI spec", func() {
+			vmi := &v1.VirtualMachineInstance{
+				Spec: v1.VirtualMachineInstanceSpec{
+					Hooks: []v1.Hook{{
+						Name:            "smbios",
+						Image:           "smbios-hook:v1",
+						ImagePullPolicy: "Always",
+						Args:            []string{"--version", "v1alpha4"},
+						HookPoints:      []v1.HookPoint{v1.OnDefineDomainHookPoint},
+					}},
+				},
+			}
+			Expect(hooks.SpecHookSidecarList(vmi)).To(Equal(hooks.HookSidecarList{{
+				Image:           "smbios-hook:v1",
+				ImagePullPolicy: "Always",
+				Args:            []string{"--version", "v1alpha4"},
+				ContainerName:   "hook-smbios",
+			}}))
+			Expect(hooks.LookupSpecHook(vmi, "hook-smbios")).To(Equal(&vmi.Spec.Hooks[0]))
+			Expect(hooks.LookupSpecHook(vmi, "hook-sidecar-0")).To(BeNil())
+		})
 	})
 })
@@ -22,3 +22,5 @@ package info
 const OnDefineDomainHookPointName = "OnDefineDomain"
 const PreCloudInitIsoHookPointName = "PreCloudInitIso"
 const ShutdownHookPointName = "Shutdown"
+const PostMigrationTargetHookPointName = "PostMigrationTarget"
+const PreShutdownHookPointName = "PreShutdown"
@@ -23,9 +23,12 @@ import (
 	"context"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -39,6 +42,7 @@ import (
 	hooksV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/v1alpha1"
 	hooksV1alpha2 "kubevirt.io/kubevirt/pkg/hooks/v1alpha2"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
 	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
 	virtwrapApi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
@@ -51,8 +55,15 @@ type callBackClient struct {
 	SocketPath           string
 	Version              string
 	subscribedHookPoints []*hooksInfo.HookPoint
+	// containerName is the name of the sidecar container serving the socket
+	containerName string
+	// versions are the supported versions exposed by the hook
+	versions []string
 }
 
+// streamIdleTimeout is the time a v1alpha4 hook may take between two streamed results
+var streamIdleTimeout = time.Minute
+
 var manager Manager
 var once sync.Once
 
@@ -61,6 +72,8 @@ type (
 		Collect(uint, time.Duration) error
 		OnDefineDomain(*virtwrapApi.DomainSpec, *v1.VirtualMachineInstance) (string, error)
 		PreCloudInitIso(*v1.VirtualMachineInstance, *cloudinit.CloudInitData) (*cloudinit.CloudInitData, error)
+		PostMigrationTarget(*v1.VirtualMachineInstance) error
+		PreShutdown(*v1.VirtualMachineInstance) error
 		Shutdown() error
 	}
 	hookManager struct {
@@ -193,21 +206,29 @@ func processSideCarSocket(socketPath string) (*callBackClient, bool, error) {
 
 	// The order matters. We should match newer versions first.
 	supportedVersions := []string{
+		hooksV1alpha4.Version,
 		hooksV1alpha3.Version,
 		hooksV1alpha2.Version,
 		hooksV1alpha1.Version,
 	}
 
+	var exposedVersions []string
 	for _, version := range supportedVersions {
 		if _, found := versionsSet[version]; found {
-			return &callBackClient{
-				SocketPath:           socketPath,
-				Version:              version,
-				subscribedHookPoints: info.GetHookPoints(),
-			}, false, nil
+			exposedVersions = append(exposedVersions, version)
 		}
 	}
 
+	if len(exposedVersions) > 0 {
+		return &callBackClient{
+			SocketPath:           socketPath,
+			Version:              exposedVersions[0],
+			subscribedHookPoints: info.GetHookPoints(),
+			containerName:        filepath.Base(filepath.Dir(socketPath)),
+			versions:             exposedVersions,
+		}, false, nil
+	}
+
 	return nil, false,
 		fmt.Errorf("Hook sidecar does not expose a supported version. Exposed versions: %v, supported versions: %v",
 			info.GetVersions(), supportedVersions)
@@ -233,8 +254,11 @@ func (m *hookManager) OnDefineDomain(domainSpec *virtwrapApi.DomainSpec, vmi *v1
 		return "", fmt.Errorf("Failed to marshal domain spec: %v", domainSpec)
 	}
 
-	callbacks, found := m.CallbacksPerHookPoint[hooksInfo.OnDefineDomainHookPointName]
-	if !found {
+	callbacks, err := m.callbacksForVMI(hooksInfo.OnDefineDomainHookPointName, vmi)
+	if err != nil {
+		return "", err
+	}
+	if len(callbacks) == 0 {
 		return string(domainSpecXML), nil
 	}
 
@@ -298,6 +322,25 @@ func (m *hookManager) onDefineDomainCallback(callback *callBackClient, domainSpe
 			return nil, err
 		}
 		domainSpecXML = result.GetDomainXML()
+	case hooksV1alpha4.Version:
+		streamCtx, streamCancel := context.WithCancelCause(context.Background())
+		defer streamCancel(nil)
+
+		client := hooksV1alpha4.NewCallbacksClient(conn)
+		stream, err := client.OnDefineDomain(streamCtx, &hooksV1alpha4.OnDefineDomainParams{
+			DomainXML: domainSpecXML,
+			Vmi:       vmiJSON,
+		})
+		if err != nil {
+			log.Log.Reason(err).Error("Failed to call OnDefineDomain")
+			return nil, err
+		}
+		result, err := recvLastResult(streamCtx, streamCancel, callback.SocketPath, stream.Recv)
+		if err != nil {
+			log.Log.Reason(err).Error("Failed to call OnDefineDomain")
+			return nil, err
+		}
+		domainSpecXML = result.GetDomainXML()
 	default:
 		log.Log.Errorf("Unsupported callback version: %s", callback.Version)
 	}
@@ -305,6 +348,72 @@ func (m *hookManager) onDefineDomainCallback(callback *callBackClient, domainSpe
 	return domainSpecXML, nil
 }
 
+// callbacksForVMI returns the callbacks subscribed to the hook point. The hooks of the VMI spec
+// are only called on the hook points they request, using the version they request.
+func (m *hookManager) callbacksForVMI(hookPointName string, vmi *v1.VirtualMachineInstance) ([]*callBackClient, error) {
+	var callbacks []*callBackClient
+	for _, callback := range m.CallbacksPerHookPoint[hookPointName] {
+		hook := LookupSpecHook(vmi, callback.containerName)
+		switch {
+		case hook == nil:
+			callbacks = append(callbacks, callback)
+		case !slices.Contains(hook.HookPoints, v1.HookPoint(hookPointName)):
+			log.Log.Object(vmi).V(4).Infof("Hook %s is not requested on %s, skipping it", hook.Name, hookPointName)
+		case hook.Version == "":
+			callbacks = append(callbacks, callback)
+		case !slices.Contains(callback.versions, string(hook.Version)):
+			return nil, fmt.Errorf("hook %s does not expose the requested version %s, exposed versions: %v",
+				hook.Name, hook.Version, callback.versions)
+		default:
+			versionedCallback := *callback
+			versionedCallback.Version = string(hook.Version)
+			callbacks = append(callbacks, &versionedCallback)
+		}
+	}
+	return callbacks, nil
+}
+
+type streamedResult interface {
+	GetProgress() string
+}
+
+// recvLastResult receives the results streamed by a v1alpha4 hook until the hook closes the stream,
+// logs the progress they report and returns the last one. The call is cancelled when the hook
+// does not send a result within streamIdleTimeout.
+func recvLastResult[T streamedResult](ctx context.Context, cancel context.CancelCauseFunc, socketPath string, recv func() (T, error)) (T, error) {
+	var last T
+	received := false
+
+	idleTimer := time.AfterFunc(streamIdleTimeout, func() {
+		cancel(fmt.Errorf("hook %s did not send a result within %s", socketPath, streamIdleTimeout))
+	})
+	defer idleTimer.Stop()
+
+	for {
+		result, err := recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return last, context.Cause(ctx)
+			}
+			return last, err
+		}
+		idleTimer.Reset(streamIdleTimeout)
+
+		if progress := result.GetProgress(); progress != "" {
+			log.Log.Infof("Hook %s: %s", socketPath, progress)
+		}
+		last, received = result, true
+	}
+
+	if !received {
+		return last, fmt.Errorf("hook %s closed the stream without sending a result", socketPath)
+	}
+	return last, nil
+}
+
 func preCloudInitIsoDataToJSON(vmi *v1.VirtualMachineInstance, cloudInitData *cloudinit.CloudInitData) ([]byte, []byte, []byte, error) {
 	vmiJSON, err := json.Marshal(vmi)
 	if err != nil {
@@ -356,8 +465,11 @@ func preCloudInitIsoValidateResult(dataSource cloudinit.DataSourceType, initData
 }
 
 func (m *hookManager) PreCloudInitIso(vmi *v1.VirtualMachineInstance, cloudInitData *cloudinit.CloudInitData) (*cloudinit.CloudInitData, error) {
-	callbacks, found := m.CallbacksPerHookPoint[hooksInfo.PreCloudInitIsoHookPointName]
-	if !found {
+	callbacks, err := m.callbacksForVMI(hooksInfo.PreCloudInitIsoHookPointName, vmi)
+	if err != nil {
+		return cloudInitData, err
+	}
+	if len(callbacks) == 0 {
 		return cloudInitData, nil
 	}
 
@@ -413,6 +525,32 @@ func (m *hookManager) PreCloudInitIso(vmi *v1.VirtualMachineInstance, cloudInitD
 				return cloudInitData, err
 			}
 			return preCloudInitIsoValidateResult(cloudInitData.DataSource, result.GetCloudInitData(), result.GetCloudInitNoCloudSource())
+		case hooksV1alpha4.Version:
+			conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
+			if err != nil {
+				log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
+				return cloudInitData, err
+			}
+			defer conn.Close()
+
+			client := hooksV1alpha4.NewCallbacksClient(conn)
+			ctx, cancel := context.WithCancelCause(context.Background())
+			defer cancel(nil)
+
+			stream, err := client.PreCloudInitIso(ctx, &hooksV1alpha4.PreCloudInitIsoParams{
+				CloudInitData: cloudInitDataJSON,
+				Vmi:           vmiJSON,
+			})
+			if err != nil {
+				log.Log.Reason(err).Error("Failed to call PreCloudInitIso")
+				return cloudInitData, err
+			}
+			result, err := recvLastResult(ctx, cancel, callback.SocketPath, stream.Recv)
+			if err != nil {
+				log.Log.Reason(err).Error("Failed to call PreCloudInitIso")
+				return cloudInitData, err
+			}
+			return preCloudInitIsoValidateV1alpha4Result(result.GetCloudInitData())
 		default:
 			log.Log.Errorf("Unsupported callback version: %s", callback.Version)
 		}
@@ -420,6 +558,91 @@ func (m *hookManager) PreCloudInitIso(vmi *v1.VirtualMachineInstance, cloudInitD
 	return cloudInitData, nil
 }
 
+func preCloudInitIsoValidateV1alpha4Result(initData []byte) (*cloudinit.CloudInitData, error) {
+	var resultData *cloudinit.CloudInitData
+	if err := json.Unmarshal(initData, &resultData); err != nil {
+		log.Log.Reason(err).Error("Failed to unmarshal CloudInitData result")
+		return nil, err
+	}
+	if !cloudinit.IsValidCloudInitData(resultData) {
+		return nil, fmt.Errorf("hook returned invalid CloudInitData")
+	}
+	return resultData, nil
+}
+
+func (m *hookManager) PostMigrationTarget(vmi *v1.VirtualMachineInstance) error {
+	return m.notifyV1alpha4Callbacks(hooksInfo.PostMigrationTargetHookPointName, vmi,
+		func(ctx context.Context, client hooksV1alpha4.CallbacksClient, vmiJSON []byte) (func() (streamedResult, error), error) {
+			stream, err := client.PostMigrationTarget(ctx, &hooksV1alpha4.PostMigrationTargetParams{Vmi: vmiJSON})
+			if err != nil {
+				return nil, err
+			}
+			return func() (streamedResult, error) { return stream.Recv() }, nil
+		})
+}
+
+func (m *hookManager) PreShutdown(vmi *v1.VirtualMachineInstance) error {
+	return m.notifyV1alpha4Callbacks(hooksInfo.PreShutdownHookPointName, vmi,
+		func(ctx context.Context, client hooksV1alpha4.CallbacksClient, vmiJSON []byte) (func() (streamedResult, error), error) {
+			stream, err := client.PreShutdown(ctx, &hooksV1alpha4.PreShutdownParams{Vmi: vmiJSON})
+			if err != nil {
+				return nil, err
+			}
+			return func() (streamedResult, error) { return stream.Recv() }, nil
+		})
+}
+
+// openV1alpha4StreamFunc calls a v1alpha4 hook point with the given VMI and returns the receive function of the result stream
+type openV1alpha4StreamFunc func(ctx context.Context, client hooksV1alpha4.CallbacksClient, vmiJSON []byte) (func() (streamedResult, error), error)
+
+// notifyV1alpha4Callbacks calls the hooks subscribed to a hook point which only exists since v1alpha4
+// and which does not return anything but the progress of the hooks
+func (m *hookManager) notifyV1alpha4Callbacks(hookPointName string, vmi *v1.VirtualMachineInstance, openStream openV1alpha4StreamFunc) error {
+	callbacks, err := m.callbacksForVMI(hookPointName, vmi)
+	if err != nil {
+		return err
+	}
+	if len(callbacks) == 0 {
+		return nil
+	}
+
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VMI spec: %v, err: %v", vmi, err)
+	}
+
+	for _, callback := range callbacks {
+		if callback.Version != hooksV1alpha4.Version {
+			log.Log.Errorf("Unsupported callback version for %s: %s", hookPointName, callback.Version)
+			continue
+		}
+		if err := notifyV1alpha4Callback(callback, vmiJSON, openStream); err != nil {
+			log.Log.Reason(err).Errorf("Failed to call %s", hookPointName)
+			return err
+		}
+	}
+	return nil
+}
+
+func notifyV1alpha4Callback(callback *callBackClient, vmiJSON []byte, openStream openV1alpha4StreamFunc) error {
+	conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
+	if err != nil {
+		log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+
+	recv, err := openStream(ctx, hooksV1alpha4.NewCallbacksClient(conn), vmiJSON)
+	if err != nil {
+		return err
+	}
+	_, err = recvLastResult(ctx, cancel, callback.SocketPath, recv)
+	return err
+}
+
 func (m *hookManager) Shutdown() error {
 	callbacks, found := m.CallbacksPerHookPoint[hooksInfo.ShutdownHookPointName]
 	if !found {
@@ -443,6 +666,22 @@ func (m *hookManager) Shutdown() error {
 				log.Log.Reason(err).Error("Failed to run Shutdown")
 				return err
 			}
+		case hooksV1alpha4.Version:
+			conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
+			if err != nil {
+				log.Log.Reason(err).Error("Failed to run Shutdown")
+				return err
+			}
+			defer conn.Close()
+
+			client := hooksV1alpha4.NewCallbacksClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			if _, err := client.Shutdown(ctx, &hooksV1alpha4.ShutdownParams{}); err != nil {
+				log.Log.Reason(err).Error("Failed to run Shutdown")
+				return err
+			}
 		default:
 			log.Log.Errorf("Unsupported callback version: %s", callback.Version)
 		}
@@ -21,11 +21,13 @@ package hooks
 
 import (
 	"context"
+	"encoding/xml"
 	"fmt"
 	"net"
 	"os"
 	"path/filepath"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc"
@@ -33,8 +35,12 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	v1 "kubevirt.io/api/core/v1"
+
 	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
+	virtwrapApi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 type dynamicInfoServer struct {
@@ -79,6 +85,72 @@ func hookListenAndServe(socketPath string, hookName string, hookPointName string
 	return socket, nil
 }
 
+const streamedDomainXML = "<domain type=\"kvm\"></domain>"
+
+type v1alpha4InfoServer struct {
+	hookPointNames []string
+}
+
+func (s v1alpha4InfoServer) Info(_ context.Context, _ *hooksInfo.InfoParams) (*hooksInfo.InfoResult, error) {
+	result := &hooksInfo.InfoResult{
+		Name:     "streaming",
+		Versions: []string{hooksV1alpha4.Version, hooksV1alpha3.Version},
+	}
+	for _, hookPointName := range s.hookPointNames {
+		result.HookPoints = append(result.HookPoints, &hooksInfo.HookPoint{Name: hookPointName})
+	}
+	return result, nil
+}
+
+type v1alpha4CallbacksServer struct {
+	resultDelay time.Duration
+	calls       *atomic.Int32
+}
+
+func (s v1alpha4CallbacksServer) OnDefineDomain(_ *hooksV1alpha4.OnDefineDomainParams, stream hooksV1alpha4.Callbacks_OnDefineDomainServer) error {
+	s.calls.Add(1)
+	if err := stream.Send(&hooksV1alpha4.OnDefineDomainResult{Progress: "adjusting the domain"}); err != nil {
+		return err
+	}
+	time.Sleep(s.resultDelay)
+	return stream.Send(&hooksV1alpha4.OnDefineDomainResult{DomainXML: []byte(streamedDomainXML)})
+}
+
+func (s v1alpha4CallbacksServer) PreCloudInitIso(params *hooksV1alpha4.PreCloudInitIsoParams, stream hooksV1alpha4.Callbacks_PreCloudInitIsoServer) error {
+	s.calls.Add(1)
+	return stream.Send(&hooksV1alpha4.PreCloudInitIsoResult{CloudInitData: params.GetCloudInitData()})
+}
+
+func (s v1alpha4CallbacksServer) PostMigrationTarget(_ *hooksV1alpha4.PostMigrationTargetParams, stream hooksV1alpha4.Callbacks_PostMigrationTargetServer) error {
+	s.calls.Add(1)
+	return stream.Send(&hooksV1alpha4.PostMigrationTargetResult{Progress: "reconnected the agent"})
+}
+
+func (s v1alpha4CallbacksServer) PreShutdown(_ *hooksV1alpha4.PreShutdownParams, stream hooksV1alpha4.Callbacks_PreShutdownServer) error {
+	s.calls.Add(1)
+	time.Sleep(s.resultDelay)
+	return stream.Send(&hooksV1alpha4.PreShutdownResult{Progress: "flushed the caches"})
+}
+
+func (s v1alpha4CallbacksServer) Shutdown(_ context.Context, _ *hooksV1alpha4.ShutdownParams) (*hooksV1alpha4.ShutdownResult, error) {
+	return &hooksV1alpha4.ShutdownResult{}, nil
+}
+
+func v1alpha4HookListenAndServe(socketPath string, callbacksServer v1alpha4CallbacksServer, hookPointNames ...string) (net.Listener, error) {
+	socket, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	server := grpc.NewServer([]grpc.ServerOption{}...)
+	hooksInfo.RegisterInfoServer(server, v1alpha4InfoServer{hookPointNames: hookPointNames})
+	hooksV1alpha4.RegisterCallbacksServer(server, callbacksServer)
+	go func() {
+		server.Serve(socket)
+	}()
+	return socket, nil
+}
+
 var _ = Describe("HooksManager", func() {
 	Context("With existing sockets", func() {
 		var socketDir string
@@ -167,4 +239,98 @@ var _ = Describe("HooksManager", func() {
 			os.RemoveAll(socketDir)
 		})
 	})
+
+	Context("With a v1alpha4 hook", func() {
+		var (
+			socketDir string
+			calls     *atomic.Int32
+			vmi       *v1.VirtualMachineInstance
+		)
+
+		collectHook := func(containerName string, callbacksServer v1alpha4CallbacksServer, hookPointNames ...string) *hookManager {
+			hookPath := filepath.Join(socketDir, containerName)
+			Expect(os.MkdirAll(hookPath, os.ModePerm)).To(Succeed())
+			socket, err := v1alpha4HookListenAndServe(filepath.Join(hookPath, "hook.sock"), callbacksServer, hookPointNames...)
+			Expect(err).ToNot(HaveOccurred())
+			DeferCleanup(socket.Close)
+
+			manager := newManager(socketDir)
+			Expect(manager.Collect(1, 10*time.Second)).To(Succeed())
+			return manager
+		}
+
+		BeforeEach(func() {
+			var err error
+			socketDir, err = os.MkdirTemp("", "hooksocketdir")
+			Expect(err).ToNot(HaveOccurred())
+			DeferCleanup(os.RemoveAll, socketDir)
+
+			calls = &atomic.Int32{}
+			vmi = &v1.VirtualMachineInstance{}
+		})
+
+		It("should use the last result streamed by the hook", func() {
+			manager := collectHook("hook-sidecar-0", v1alpha4CallbacksServer{calls: calls}, hooksInfo.OnDefineDomainHookPointName)
+			Expect(manager.CallbacksPerHookPoint[hooksInfo.OnDefineDomainHookPointName][0].Version).To(Equal(hooksV1alpha4.Version))
+
+			domainXML, err := manager.OnDefineDomain(&virtwrapApi.DomainSpec{}, vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domainXML).To(Equal(streamedDomainXML))
+		})
+
+		It("should fail when the hook does not send a result within the idle timeout", func() {
+			originalTimeout := streamIdleTimeout
+			streamIdleTimeout = 100 * time.Millisecond
+			DeferCleanup(func() { streamIdleTimeout = originalTimeout })
+
+			manager := collectHook("hook-sidecar-0", v1alpha4CallbacksServer{calls: calls, resultDelay: time.Second}, hooksInfo.PreShutdownHookPointName)
+
+			err := manager.PreShutdown(vmi)
+			Expect(err).To(MatchError(ContainSubstring("did not send a result within 100ms")))
+		})
+
+		It("should call the hook on the hook points of v1alpha4", func() {
+			manager := collectHook("hook-sidecar-0", v1alpha4CallbacksServer{calls: calls},
+				hooksInfo.PostMigrationTargetHookPointName, hooksInfo.PreShutdownHookPointName)
+
+			Expect(manager.PostMigrationTarget(vmi)).To(Succeed())
+			Expect(manager.PreShutdown(vmi)).To(Succeed())
+			Expect(calls.Load()).To(Equal(int32(2)))
+		})
+
+		It("should only call a hook of the VMI spec on the hook points it requests", func() {
+			vmi.Spec.Hooks = []v1.Hook{{
+				Name:       "streaming",
+				Image:      "streaming-hook",
+				HookPoints: []v1.HookPoint{v1.PreShutdownHookPoint},
+			}}
+			manager := collectHook(SpecHookContainerName("streaming"), v1alpha4CallbacksServer{calls: calls},
+				hooksInfo.OnDefineDomainHookPointName, hooksInfo.PreShutdownHookPointName)
+
+			domainSpec := &virtwrapApi.DomainSpec{}
+			domainXML, err := manager.OnDefineDomain(domainSpec, vmi)
+			Expect(err).ToNot(HaveOccurred())
+			expectedDomainXML, err := xml.MarshalIndent(domainSpec, "", "\t")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domainXML).To(Equal(string(expectedDomainXML)))
+			Expect(calls.Load()).To(BeZero())
+
+			Expect(manager.PreShutdown(vmi)).To(Succeed())
+			Expect(calls.Load()).To(Equal(int32(1)))
+		})
+
+		It("should fail when a hook of the VMI spec requests a version the hook does not expose", func() {
+			vmi.Spec.Hooks = []v1.Hook{{
+				Name:       "streaming",
+				Image:      "streaming-hook",
+				Version:    v1.HookVersionV1Alpha2,
+				HookPoints: []v1.HookPoint{v1.OnDefineDomainHookPoint},
+			}}
+			manager := collectHook(SpecHookContainerName("streaming"), v1alpha4CallbacksServer{calls: calls}, hooksInfo.OnDefineDomainHookPointName)
+
+			_, err := manager.OnDefineDomain(&virtwrapApi.DomainSpec{}, vmi)
+			Expect(err).To(MatchError(ContainSubstring("hook streaming does not expose the requested version v1alpha2")))
+			Expect(calls.Load()).To(BeZero())
+		})
+	})
 })
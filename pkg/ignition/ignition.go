@@ -22,6 +22,8 @@ package ignition
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
@@ -35,9 +37,42 @@ var ignitionLocalDir = "/var/run/libvirt/ignition-dir"
 
 const IgnitionFile = "data.ign"
 
-func GetIgnitionSource(vmi *v1.VirtualMachineInstance) string {
+// GetIgnitionSource returns the Ignition config of the VMI, taken either from the
+// Ignition annotation or from the Ignition volume. The secret referenced by the
+// Ignition volume is read from its mount in secretSourceDir.
+func GetIgnitionSource(vmi *v1.VirtualMachineInstance, secretSourceDir string) (string, error) {
 	precond.MustNotBeNil(vmi)
-	return vmi.Annotations[v1.IgnitionAnnotation]
+	if data := vmi.Annotations[v1.IgnitionAnnotation]; data != "" {
+		return data, nil
+	}
+
+	volume := GetIgnitionVolume(vmi)
+	if volume == nil {
+		return "", nil
+	}
+	if volume.Ignition.SecretRef == nil {
+		return volume.Ignition.Data, nil
+	}
+
+	baseDir := filepath.Join(secretSourceDir, volume.Name)
+	for _, file := range []string{"userdata", "userData"} {
+		// #nosec No risk for path injection: secretSourceDir and file are static strings
+		data, err := os.ReadFile(filepath.Join(baseDir, file))
+		if err == nil {
+			return string(data), nil
+		}
+	}
+	return "", fmt.Errorf("no Ignition config found at volume: %s", volume.Name)
+}
+
+// GetIgnitionVolume returns the Ignition volume of the VMI, or nil if there is none.
+func GetIgnitionVolume(vmi *v1.VirtualMachineInstance) *v1.Volume {
+	for i := range vmi.Spec.Volumes {
+		if vmi.Spec.Volumes[i].Ignition != nil {
+			return &vmi.Spec.Volumes[i]
+		}
+	}
+	return nil
 }
 
 func SetLocalDirectory(dir string) error {
@@ -61,9 +96,8 @@ func GetDomainBasePath(domain string, namespace string) string {
 	return fmt.Sprintf("%s/%s/%s", ignitionLocalDir, namespace, domain)
 }
 
-func GenerateIgnitionLocalData(vmi *v1.VirtualMachineInstance, namespace string) error {
+func GenerateIgnitionLocalData(vmi *v1.VirtualMachineInstance, namespace string, data string) error {
 	precond.MustNotBeEmpty(vmi.Name)
-	precond.MustNotBeNil(vmi.Annotations[v1.IgnitionAnnotation])
 
 	domainBasePath := GetDomainBasePath(vmi.Name, namespace)
 	err := util.MkdirAllWithNosec(domainBasePath)
@@ -73,8 +107,7 @@ func GenerateIgnitionLocalData(vmi *v1.VirtualMachineInstance, namespace string)
 	}
 
 	ignitionFile := fmt.Sprintf("%s/%s", domainBasePath, IgnitionFile)
-	ignitionData := []byte(vmi.Annotations[v1.IgnitionAnnotation])
-	err = util.WriteFileWithNosec(ignitionFile, ignitionData)
+	err = util.WriteFileWithNosec(ignitionFile, []byte(data))
 	if err != nil {
 		return err
 	}
@@ -22,10 +22,13 @@ package ignition
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	k8sv1 "k8s.io/api/core/v1"
+
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
@@ -60,11 +63,50 @@ var _ = Describe("Ignition", func() {
 					libvmi.WithName(vmName),
 					libvmi.WithAnnotation(v1.IgnitionAnnotation, data),
 				)
-				err := GenerateIgnitionLocalData(vmi, namespace)
+				ignitionData, err := GetIgnitionSource(vmi, tmpDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ignitionData).To(Equal(data))
+				err = GenerateIgnitionLocalData(vmi, namespace, ignitionData)
 				Expect(err).ToNot(HaveOccurred())
 				_, err = os.Stat(fmt.Sprintf("%s/%s/%s/%s", tmpDir, namespace, vmName, IgnitionFile))
 				Expect(err).ToNot(HaveOccurred())
 			})
 		})
+
+		Context("with an Ignition volume", func() {
+			const data = `{"ignition":{"version":"3.4.0"}}`
+
+			newVMIWithIgnitionVolume := func(source *v1.IgnitionSource) *v1.VirtualMachineInstance {
+				vmi := libvmi.New(libvmi.WithName(vmName))
+				vmi.Spec.Volumes = []v1.Volume{{
+					Name:         "ignition",
+					VolumeSource: v1.VolumeSource{Ignition: source},
+				}}
+				return vmi
+			}
+
+			It("should return the inline config", func() {
+				vmi = newVMIWithIgnitionVolume(&v1.IgnitionSource{Data: data})
+				Expect(GetIgnitionSource(vmi, tmpDir)).To(Equal(data))
+			})
+
+			It("should read the config from the mounted secret", func() {
+				vmi = newVMIWithIgnitionVolume(&v1.IgnitionSource{
+					SecretRef: &k8sv1.LocalObjectReference{Name: "ignition-secret"},
+				})
+				secretDir := filepath.Join(tmpDir, "secrets", "ignition")
+				Expect(os.MkdirAll(secretDir, 0700)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(secretDir, "userData"), []byte(data), 0600)).To(Succeed())
+				Expect(GetIgnitionSource(vmi, filepath.Join(tmpDir, "secrets"))).To(Equal(data))
+			})
+
+			It("should fail if the mounted secret does not contain a config", func() {
+				vmi = newVMIWithIgnitionVolume(&v1.IgnitionSource{
+					SecretRef: &k8sv1.LocalObjectReference{Name: "ignition-secret"},
+				})
+				_, err := GetIgnitionSource(vmi, filepath.Join(tmpDir, "missing"))
+				Expect(err).To(MatchError("no Ignition config found at volume: ignition"))
+			})
+		})
 	})
 })
@@ -44,6 +44,7 @@ import (
 	preferenceapply "kubevirt.io/kubevirt/pkg/instancetype/preference/apply"
 	preferencefind "kubevirt.io/kubevirt/pkg/instancetype/preference/find"
 	"kubevirt.io/kubevirt/pkg/instancetype/revision"
+	"kubevirt.io/kubevirt/pkg/instancetype/update"
 	"kubevirt.io/kubevirt/pkg/instancetype/upgrade"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
@@ -73,11 +74,16 @@ type upgradeHandler interface {
 	Upgrade(*virtv1.VirtualMachine) error
 }
 
+type updateHandler interface {
+	Update(*virtv1.VirtualMachine) error
+}
+
 type controller struct {
 	applyVMHandler
 	storeHandler
 	expandHandler
 	upgradeHandler
+	updateHandler
 	instancetypeFindHandler
 	preferenceFindHandler
 
@@ -99,6 +105,7 @@ func New(
 		storeHandler:            revision.New(instancetypeStore, clusterInstancetypeStore, preferenceStore, clusterPreferenceStore, virtClient),
 		expandHandler:           expand.New(clusterConfig, finder, prefFinder),
 		upgradeHandler:          upgrade.New(revisionStore, virtClient),
+		updateHandler:           update.New(instancetypeStore, clusterInstancetypeStore, preferenceStore, clusterPreferenceStore, revisionStore, virtClient),
 		clientset:               virtClient,
 		clusterConfig:           clusterConfig,
 		recorder:                recorder,
@@ -108,6 +115,7 @@ func New(
 const (
 	storeControllerRevisionErrFmt   = "error encountered while storing instancetype.kubevirt.io controllerRevisions: %v"
 	upgradeControllerRevisionErrFmt = "error encountered while upgrading instancetype.kubevirt.io controllerRevisions: %v"
+	updateControllerRevisionErrFmt  = "error encountered while updating instancetype.kubevirt.io controllerRevisions: %v"
 	cleanControllerRevisionErrFmt   = "error encountered cleaning controllerRevision %s after successfully expanding VirtualMachine %s: %v"
 )
 
@@ -140,6 +148,13 @@ func (c *controller) Sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 		c.recorder.Eventf(vm, corev1.EventTypeWarning, common.FailedCreateVirtualMachineReason, upgradeControllerRevisionErrFmt, err)
 		return vm, common.NewSyncError(fmt.Errorf(upgradeControllerRevisionErrFmt, err), common.FailedCreateVirtualMachineReason)
 	}
+
+	// Publish any changes of the referenced resources not captured by the controllerRevisions and roll out the approved ones
+	if err := c.Update(vm); err != nil {
+		log.Log.Object(vm).Reason(err).Errorf(updateControllerRevisionErrFmt, err)
+		c.recorder.Eventf(vm, corev1.EventTypeWarning, common.FailedCreateVirtualMachineReason, updateControllerRevisionErrFmt, err)
+		return vm, common.NewSyncError(fmt.Errorf(updateControllerRevisionErrFmt, err), common.FailedCreateVirtualMachineReason)
+	}
 	return vm, nil
 }
 
@@ -0,0 +1,111 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package liveresize
+
+import (
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/revision"
+)
+
+// HasInstancetypeChanged returns whether the VM now references another instancetype, or another ControllerRevision of it,
+// than the one the VMI was started with
+func HasInstancetypeChanged(lastSeenVMSpec *virtv1.VirtualMachineSpec, vm *virtv1.VirtualMachine) bool {
+	lastSeen := lastSeenVMSpec.Instancetype
+	current := vm.Spec.Instancetype
+	if lastSeen == nil || current == nil {
+		return false
+	}
+	if lastSeen.Name != current.Name || !strings.EqualFold(lastSeen.Kind, current.Kind) {
+		return true
+	}
+	if lastSeen.RevisionName != "" && revision.HasControllerRevisionRef(vm.Status.InstancetypeRef) {
+		return lastSeen.RevisionName != vm.Status.InstancetypeRef.ControllerRevisionRef.Name
+	}
+	return false
+}
+
+// IgnoreHotpluggableChanges copies the CPU and memory fields changed by switching instancetype from the current to the
+// last seen expanded VMI spec, provided CPU and memory hotplug are able to apply them to the running VMI.
+// Anything left differing between both specs afterwards requires a restart of the VM.
+func IgnoreHotpluggableChanges(lastSeenSpec, currentSpec *virtv1.VirtualMachineInstanceSpec, vmi *virtv1.VirtualMachineInstance) {
+	if vmi == nil {
+		return
+	}
+	ignoreHotpluggableCPUChanges(lastSeenSpec, currentSpec, vmi)
+	ignoreHotpluggableMemoryChanges(lastSeenSpec, currentSpec, vmi)
+}
+
+func ignoreHotpluggableCPUChanges(lastSeenSpec, currentSpec *virtv1.VirtualMachineInstanceSpec, vmi *virtv1.VirtualMachineInstance) {
+	lastSeenCPU := lastSeenSpec.Domain.CPU
+	currentCPU := currentSpec.Domain.CPU
+	if lastSeenCPU == nil || currentCPU == nil || vmi.Spec.Domain.CPU == nil {
+		return
+	}
+
+	// CPU hotplug only adds or removes sockets, any other change of the topology requires a restart
+	if lastSeenCPU.Cores != currentCPU.Cores || lastSeenCPU.Threads != currentCPU.Threads {
+		return
+	}
+
+	// Sockets exceeding the MaxSockets the VMI was started with are handled by the CPU hotplug falling back to a restart
+	if vmi.Spec.Domain.CPU.MaxSockets == 0 {
+		return
+	}
+	lastSeenCPU.Sockets = currentCPU.Sockets
+	lastSeenCPU.MaxSockets = currentCPU.MaxSockets
+}
+
+func ignoreHotpluggableMemoryChanges(lastSeenSpec, currentSpec *virtv1.VirtualMachineInstanceSpec, vmi *virtv1.VirtualMachineInstance) {
+	lastSeenMemory := lastSeenSpec.Domain.Memory
+	currentMemory := currentSpec.Domain.Memory
+	if lastSeenMemory == nil || currentMemory == nil || currentMemory.Guest == nil ||
+		vmi.Spec.Domain.Memory == nil || vmi.Spec.Domain.Memory.MaxGuest == nil {
+		return
+	}
+
+	// Memory hotplug is unable to change the backing of the guest memory
+	if !equality.Semantic.DeepEqual(lastSeenMemory.Hugepages, currentMemory.Hugepages) {
+		return
+	}
+
+	lastSeenMemory.Guest = currentMemory.Guest
+	lastSeenMemory.MaxGuest = currentMemory.MaxGuest
+
+	// The memory requests and limits of the VMI are recalculated by the memory hotplug
+	copyMemoryResource(&lastSeenSpec.Domain.Resources.Requests, currentSpec.Domain.Resources.Requests)
+	copyMemoryResource(&lastSeenSpec.Domain.Resources.Limits, currentSpec.Domain.Resources.Limits)
+}
+
+func copyMemoryResource(lastSeen *k8sv1.ResourceList, current k8sv1.ResourceList) {
+	currentMemory, hasCurrentMemory := current[k8sv1.ResourceMemory]
+	if !hasCurrentMemory {
+		delete(*lastSeen, k8sv1.ResourceMemory)
+		return
+	}
+	if *lastSeen == nil {
+		*lastSeen = k8sv1.ResourceList{}
+	}
+	(*lastSeen)[k8sv1.ResourceMemory] = currentMemory
+}
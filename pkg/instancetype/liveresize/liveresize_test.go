@@ -0,0 +1,142 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package liveresize_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/liveresize"
+)
+
+var _ = Describe("Live resize", func() {
+	newSpec := func(sockets, cores uint32, guest, request string) *virtv1.VirtualMachineInstanceSpec {
+		guestMemory := resource.MustParse(guest)
+		return &virtv1.VirtualMachineInstanceSpec{
+			Domain: virtv1.DomainSpec{
+				CPU: &virtv1.CPU{
+					Sockets: sockets,
+					Cores:   cores,
+					Threads: 1,
+				},
+				Memory: &virtv1.Memory{
+					Guest: &guestMemory,
+				},
+				Resources: virtv1.ResourceRequirements{
+					Requests: k8sv1.ResourceList{
+						k8sv1.ResourceMemory: resource.MustParse(request),
+					},
+				},
+			},
+		}
+	}
+
+	newVMI := func(maxSockets uint32, maxGuest string) *virtv1.VirtualMachineInstance {
+		maxGuestMemory := resource.MustParse(maxGuest)
+		return &virtv1.VirtualMachineInstance{
+			Spec: virtv1.VirtualMachineInstanceSpec{
+				Domain: virtv1.DomainSpec{
+					CPU: &virtv1.CPU{
+						MaxSockets: maxSockets,
+					},
+					Memory: &virtv1.Memory{
+						MaxGuest: &maxGuestMemory,
+					},
+				},
+			},
+		}
+	}
+
+	Context("HasInstancetypeChanged", func() {
+		newVM := func(matcher *virtv1.InstancetypeMatcher, revisionName string) *virtv1.VirtualMachine {
+			vm := &virtv1.VirtualMachine{
+				Spec: virtv1.VirtualMachineSpec{
+					Instancetype: matcher,
+				},
+			}
+			if revisionName != "" {
+				vm.Status.InstancetypeRef = &virtv1.InstancetypeStatusRef{
+					ControllerRevisionRef: &virtv1.ControllerRevisionRef{
+						Name: revisionName,
+					},
+				}
+			}
+			return vm
+		}
+
+		DescribeTable("should return", func(lastSeen *virtv1.InstancetypeMatcher, vm *virtv1.VirtualMachine, expected bool) {
+			Expect(liveresize.HasInstancetypeChanged(&virtv1.VirtualMachineSpec{Instancetype: lastSeen}, vm)).To(Equal(expected))
+		},
+			Entry("false without instancetype", nil, newVM(nil, ""), false),
+			Entry("false when the instancetype is removed", &virtv1.InstancetypeMatcher{Name: "small"}, newVM(nil, ""), false),
+			Entry("false when the matcher and revision are unchanged",
+				&virtv1.InstancetypeMatcher{Name: "small", RevisionName: "small-1"},
+				newVM(&virtv1.InstancetypeMatcher{Name: "small"}, "small-1"), false),
+			Entry("true when the matcher points at another instancetype",
+				&virtv1.InstancetypeMatcher{Name: "small", RevisionName: "small-1"},
+				newVM(&virtv1.InstancetypeMatcher{Name: "large"}, "large-1"), true),
+			Entry("true when the matcher points at another kind",
+				&virtv1.InstancetypeMatcher{Name: "small", Kind: "virtualmachineinstancetype"},
+				newVM(&virtv1.InstancetypeMatcher{Name: "small", Kind: "virtualmachineclusterinstancetype"}, ""), true),
+			Entry("true when the VM references another revision",
+				&virtv1.InstancetypeMatcher{Name: "small", RevisionName: "small-1"},
+				newVM(&virtv1.InstancetypeMatcher{Name: "small"}, "small-2"), true),
+		)
+	})
+
+	Context("IgnoreHotpluggableChanges", func() {
+		It("should ignore CPU and memory changes covered by hotplug", func() {
+			lastSeen := newSpec(2, 1, "2Gi", "1Gi")
+			current := newSpec(4, 1, "4Gi", "2Gi")
+			liveresize.IgnoreHotpluggableChanges(lastSeen, current, newVMI(8, "8Gi"))
+			Expect(lastSeen).To(Equal(current))
+		})
+
+		It("should not ignore a change of cores", func() {
+			lastSeen := newSpec(1, 2, "2Gi", "2Gi")
+			current := newSpec(1, 4, "2Gi", "2Gi")
+			liveresize.IgnoreHotpluggableChanges(lastSeen, current, newVMI(8, "8Gi"))
+			Expect(lastSeen.Domain.CPU.Cores).To(Equal(uint32(2)))
+		})
+
+		It("should not ignore changes when the VMI does not support hotplug", func() {
+			lastSeen := newSpec(2, 1, "2Gi", "2Gi")
+			current := newSpec(4, 1, "4Gi", "4Gi")
+			vmi := newVMI(0, "8Gi")
+			vmi.Spec.Domain.Memory.MaxGuest = nil
+			liveresize.IgnoreHotpluggableChanges(lastSeen, current, vmi)
+			Expect(lastSeen.Domain.CPU.Sockets).To(Equal(uint32(2)))
+			Expect(lastSeen.Domain.Memory.Guest.String()).To(Equal("2Gi"))
+		})
+
+		It("should not ignore a change of hugepages", func() {
+			lastSeen := newSpec(2, 1, "2Gi", "2Gi")
+			current := newSpec(2, 1, "4Gi", "4Gi")
+			current.Domain.Memory.Hugepages = &virtv1.Hugepages{PageSize: "2Mi"}
+			liveresize.IgnoreHotpluggableChanges(lastSeen, current, newVMI(8, "8Gi"))
+			Expect(lastSeen.Domain.Memory.Guest.String()).To(Equal("2Gi"))
+			Expect(lastSeen.Domain.Memory.Hugepages).To(BeNil())
+		})
+	})
+})
@@ -68,6 +68,10 @@ func ApplyDevicePreferences(preferenceSpec *v1beta1.VirtualMachinePreferenceSpec
 		vmiSpec.Domain.Devices.AutoattachInputDevice = pointer.P(*preferenceSpec.Devices.PreferredAutoattachInputDevice)
 	}
 
+	if preferenceSpec.Devices.PreferredAutoattachVirtIODrivers != nil && vmiSpec.Domain.Devices.AutoattachVirtIODrivers == nil {
+		vmiSpec.Domain.Devices.AutoattachVirtIODrivers = pointer.P(*preferenceSpec.Devices.PreferredAutoattachVirtIODrivers)
+	}
+
 	// FIXME DisableHotplug isn't a pointer bool so we don't have a way to tell if a user has actually set it, for now override.
 	if preferenceSpec.Devices.PreferredDisableHotplug != nil {
 		vmiSpec.Domain.Devices.DisableHotplug = *preferenceSpec.Devices.PreferredDisableHotplug
@@ -85,6 +89,10 @@ func ApplyDevicePreferences(preferenceSpec *v1beta1.VirtualMachinePreferenceSpec
 		vmiSpec.Domain.Devices.TPM = preferenceSpec.Devices.PreferredTPM.DeepCopy()
 	}
 
+	if preferenceSpec.Devices.PreferredIOThreadsPolicy != nil && vmiSpec.Domain.IOThreadsPolicy == nil {
+		vmiSpec.Domain.IOThreadsPolicy = pointer.P(*preferenceSpec.Devices.PreferredIOThreadsPolicy)
+	}
+
 	applyDiskPreferences(preferenceSpec, vmiSpec)
 	applyInterfacePreferences(preferenceSpec, vmiSpec)
 	applyInputPreferences(preferenceSpec, vmiSpec)
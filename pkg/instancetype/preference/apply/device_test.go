@@ -121,6 +121,7 @@ var _ = Describe("Preference.Devices", func() {
 				PreferredAutoattachPodInterface:     pointer.P(true),
 				PreferredAutoattachSerialConsole:    pointer.P(true),
 				PreferredAutoattachInputDevice:      pointer.P(true),
+				PreferredAutoattachVirtIODrivers:    pointer.P(true),
 				PreferredDiskDedicatedIoThread:      pointer.P(true),
 				PreferredDisableHotplug:             pointer.P(true),
 				PreferredUseVirtioTransitional:      pointer.P(true),
@@ -195,6 +196,7 @@ var _ = Describe("Preference.Devices", func() {
 		// Assert that everything that isn't defined in the VM/VMI should use Preferences
 		Expect(vmi.Spec.Domain.Devices.AutoattachPodInterface).To(HaveValue(Equal(*preferenceSpec.Devices.PreferredAutoattachPodInterface)))
 		Expect(vmi.Spec.Domain.Devices.AutoattachSerialConsole).To(HaveValue(Equal(*preferenceSpec.Devices.PreferredAutoattachSerialConsole)))
+		Expect(vmi.Spec.Domain.Devices.AutoattachVirtIODrivers).To(HaveValue(Equal(*preferenceSpec.Devices.PreferredAutoattachVirtIODrivers)))
 		Expect(vmi.Spec.Domain.Devices.DisableHotplug).To(Equal(*preferenceSpec.Devices.PreferredDisableHotplug))
 		Expect(vmi.Spec.Domain.Devices.UseVirtioTransitional).To(HaveValue(Equal(*preferenceSpec.Devices.PreferredUseVirtioTransitional)))
 		Expect(vmi.Spec.Domain.Devices.Disks[1].Cache).To(Equal(preferenceSpec.Devices.PreferredDiskCache))
@@ -328,4 +330,48 @@ var _ = Describe("Preference.Devices", func() {
 			),
 		)
 	})
+
+	Context("PreferredIOThreadsPolicy", func() {
+		BeforeEach(func() {
+			preferenceSpec.Devices.PreferredIOThreadsPolicy = pointer.P(virtv1.IOThreadsPolicyAuto)
+		})
+
+		It("should apply when the VMI does not define an IOThreadsPolicy", func() {
+			Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+			Expect(vmi.Spec.Domain.IOThreadsPolicy).To(HaveValue(Equal(virtv1.IOThreadsPolicyAuto)))
+		})
+
+		It("should not override the IOThreadsPolicy defined in the VMI", func() {
+			vmi.Spec.Domain.IOThreadsPolicy = pointer.P(virtv1.IOThreadsPolicyShared)
+			Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+			Expect(vmi.Spec.Domain.IOThreadsPolicy).To(HaveValue(Equal(virtv1.IOThreadsPolicyShared)))
+		})
+	})
+
+	Context("PreferredInterfaceRxQueueSize and PreferredInterfaceTxQueueSize", func() {
+		const (
+			preferredRxQueueSize = uint32(1024)
+			preferredTxQueueSize = uint32(512)
+		)
+
+		BeforeEach(func() {
+			preferenceSpec.Devices.PreferredInterfaceRxQueueSize = pointer.P(preferredRxQueueSize)
+			preferenceSpec.Devices.PreferredInterfaceTxQueueSize = pointer.P(preferredTxQueueSize)
+		})
+
+		It("should only apply to virtio interfaces", func() {
+			Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+			Expect(vmi.Spec.Domain.Devices.Interfaces[0].RxQueueSize).To(BeNil())
+			Expect(vmi.Spec.Domain.Devices.Interfaces[0].TxQueueSize).To(BeNil())
+			Expect(vmi.Spec.Domain.Devices.Interfaces[1].RxQueueSize).To(HaveValue(Equal(preferredRxQueueSize)))
+			Expect(vmi.Spec.Domain.Devices.Interfaces[1].TxQueueSize).To(HaveValue(Equal(preferredTxQueueSize)))
+		})
+
+		It("should not override queue sizes defined in the VMI", func() {
+			vmi.Spec.Domain.Devices.Interfaces[1].RxQueueSize = pointer.P(uint32(256))
+			Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+			Expect(vmi.Spec.Domain.Devices.Interfaces[1].RxQueueSize).To(HaveValue(Equal(uint32(256))))
+			Expect(vmi.Spec.Domain.Devices.Interfaces[1].TxQueueSize).To(HaveValue(Equal(preferredTxQueueSize)))
+		})
+	})
 })
@@ -23,12 +23,18 @@ import (
 
 	virtv1 "kubevirt.io/api/core/v1"
 	v1beta1 "kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 func isInterfaceBindingUnset(iface *virtv1.Interface) bool {
 	return reflect.ValueOf(iface.InterfaceBindingMethod).IsZero() && iface.Binding == nil
 }
 
+func isInterfaceModelVirtio(iface *virtv1.Interface) bool {
+	return iface.Model == "" || iface.Model == virtv1.VirtIO
+}
+
 func isInterfaceOnPodNetwork(interfaceName string, vmiSpec *virtv1.VirtualMachineInstanceSpec) bool {
 	for _, network := range vmiSpec.Networks {
 		if network.Name == interfaceName {
@@ -49,5 +55,13 @@ func applyInterfacePreferences(preferenceSpec *v1beta1.VirtualMachinePreferenceS
 			isInterfaceOnPodNetwork(vmiIface.Name, vmiSpec) {
 			vmiIface.Masquerade = preferenceSpec.Devices.PreferredInterfaceMasquerade.DeepCopy()
 		}
+		if isInterfaceModelVirtio(vmiIface) {
+			if preferenceSpec.Devices.PreferredInterfaceRxQueueSize != nil && vmiIface.RxQueueSize == nil {
+				vmiIface.RxQueueSize = pointer.P(*preferenceSpec.Devices.PreferredInterfaceRxQueueSize)
+			}
+			if preferenceSpec.Devices.PreferredInterfaceTxQueueSize != nil && vmiIface.TxQueueSize == nil {
+				vmiIface.TxQueueSize = pointer.P(*preferenceSpec.Devices.PreferredInterfaceTxQueueSize)
+			}
+		}
 	}
 }
@@ -0,0 +1,75 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package update
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// ChangedFields returns the sorted paths of the fields differing between two specs, using their JSON field names
+func ChangedFields(oldSpec, newSpec interface{}) ([]string, error) {
+	oldFields, err := toUnstructured(oldSpec)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := toUnstructured(newSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	changedFields := diff("spec", oldFields, newFields)
+	sort.Strings(changedFields)
+	return changedFields, nil
+}
+
+func toUnstructured(spec interface{}) (interface{}, error) {
+	specBytes, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	var fields interface{}
+	if err := json.Unmarshal(specBytes, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func diff(path string, oldValue, newValue interface{}) []string {
+	oldMap, oldIsMap := oldValue.(map[string]interface{})
+	newMap, newIsMap := newValue.(map[string]interface{})
+	if !oldIsMap || !newIsMap {
+		if reflect.DeepEqual(oldValue, newValue) {
+			return nil
+		}
+		return []string{path}
+	}
+
+	var changedFields []string
+	for key, oldField := range oldMap {
+		changedFields = append(changedFields, diff(path+"."+key, oldField, newMap[key])...)
+	}
+	for key, newField := range newMap {
+		if _, exists := oldMap[key]; !exists {
+			changedFields = append(changedFields, diff(path+"."+key, nil, newField)...)
+		}
+	}
+	return changedFields
+}
@@ -0,0 +1,304 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package update
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	api "kubevirt.io/api/instancetype"
+	"kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/compatibility"
+	"kubevirt.io/kubevirt/pkg/instancetype/find"
+	preferencefind "kubevirt.io/kubevirt/pkg/instancetype/preference/find"
+	"kubevirt.io/kubevirt/pkg/instancetype/revision"
+)
+
+type controllerRevisionFinder interface {
+	Find(types.NamespacedName) (*appsv1.ControllerRevision, error)
+}
+
+type instancetypeFinder interface {
+	Find(*virtv1.VirtualMachine) (*v1beta1.VirtualMachineInstancetype, error)
+}
+
+type clusterInstancetypeFinder interface {
+	Find(*virtv1.VirtualMachine) (*v1beta1.VirtualMachineClusterInstancetype, error)
+}
+
+type preferenceFinder interface {
+	FindPreference(*virtv1.VirtualMachine) (*v1beta1.VirtualMachinePreference, error)
+}
+
+type clusterPreferenceFinder interface {
+	FindPreference(*virtv1.VirtualMachine) (*v1beta1.VirtualMachineClusterPreference, error)
+}
+
+type object interface {
+	runtime.Object
+	metav1.Object
+}
+
+type updater struct {
+	controllerRevisionFinder  controllerRevisionFinder
+	instancetypeFinder        instancetypeFinder
+	clusterInstancetypeFinder clusterInstancetypeFinder
+	preferenceFinder          preferenceFinder
+	clusterPreferenceFinder   clusterPreferenceFinder
+	virtClient                kubecli.KubevirtClient
+}
+
+func New(
+	instancetypeStore, clusterInstancetypeStore, preferenceStore, clusterPreferenceStore, revisionStore cache.Store,
+	virtClient kubecli.KubevirtClient,
+) *updater {
+	return &updater{
+		controllerRevisionFinder:  find.NewControllerRevisionFinder(revisionStore, virtClient),
+		instancetypeFinder:        find.NewInstancetypeFinder(instancetypeStore, virtClient),
+		clusterInstancetypeFinder: find.NewClusterInstancetypeFinder(clusterInstancetypeStore, virtClient),
+		preferenceFinder:          preferencefind.NewPreferenceFinder(preferenceStore, virtClient),
+		clusterPreferenceFinder:   preferencefind.NewClusterPreferenceFinder(clusterPreferenceStore, virtClient),
+		virtClient:                virtClient,
+	}
+}
+
+// Update publishes the changes of the instancetype and preference not yet captured by the ControllerRevisions referenced
+// by the VirtualMachine in its status. Once the current generation of the instancetype or preference is approved through
+// the ApprovedGenerationAnnotation, the VirtualMachine is moved to a new ControllerRevision of it.
+func (u *updater) Update(vm *virtv1.VirtualMachine) error {
+	if vm.Spec.Instancetype == nil && vm.Spec.Preference == nil {
+		return nil
+	}
+
+	instancetypeStatusRef, staleInstancetypeCR, err := u.updateInstancetype(vm)
+	if err != nil {
+		return err
+	}
+
+	preferenceStatusRef, stalePreferenceCR, err := u.updatePreference(vm)
+	if err != nil {
+		return err
+	}
+
+	patchPayload, err := revision.GeneratePatch(instancetypeStatusRef, preferenceStatusRef)
+	if err != nil || len(patchPayload) == 0 {
+		return err
+	}
+
+	if _, err := u.virtClient.VirtualMachine(vm.Namespace).PatchStatus(
+		context.Background(), vm.Name, types.JSONPatchType, patchPayload, metav1.PatchOptions{}); err != nil {
+		return err
+	}
+
+	if instancetypeStatusRef != nil {
+		vm.Status.InstancetypeRef = instancetypeStatusRef
+	}
+	if preferenceStatusRef != nil {
+		vm.Status.PreferenceRef = preferenceStatusRef
+	}
+
+	// The ControllerRevisions captured when the VirtualMachineInstance was started are still needed to work out
+	// whether the update can be applied live, they are garbage collected along with the VirtualMachine otherwise.
+	if vm.Status.Created {
+		return nil
+	}
+	for _, crName := range []string{staleInstancetypeCR, stalePreferenceCR} {
+		if crName == "" {
+			continue
+		}
+		if err := u.virtClient.AppsV1().ControllerRevisions(vm.Namespace).Delete(
+			context.Background(), crName, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			log.Log.Object(vm).Reason(err).Errorf("ignoring failure to delete stale ControllerRevision %s", crName)
+		}
+	}
+
+	return nil
+}
+
+func (u *updater) updateInstancetype(vm *virtv1.VirtualMachine) (*virtv1.InstancetypeStatusRef, string, error) {
+	// Any RevisionName provided by the matcher pins the VirtualMachine to a specific ControllerRevision
+	if vm.Spec.Instancetype == nil || vm.Spec.Instancetype.RevisionName != "" ||
+		!revision.HasControllerRevisionRef(vm.Status.InstancetypeRef) {
+		return nil, "", nil
+	}
+
+	var (
+		obj         object
+		currentSpec *v1beta1.VirtualMachineInstancetypeSpec
+	)
+	switch strings.ToLower(vm.Spec.Instancetype.Kind) {
+	case api.SingularResourceName, api.PluralResourceName:
+		instancetype, err := u.instancetypeFinder.Find(vm)
+		if err != nil {
+			return nil, "", err
+		}
+		obj, currentSpec = instancetype, &instancetype.Spec
+	case api.ClusterSingularResourceName, api.ClusterPluralResourceName, "":
+		clusterInstancetype, err := u.clusterInstancetypeFinder.Find(vm)
+		if err != nil {
+			return nil, "", err
+		}
+		obj, currentSpec = clusterInstancetype, &clusterInstancetype.Spec
+	default:
+		return nil, "", fmt.Errorf("got unexpected kind in InstancetypeMatcher: %s", vm.Spec.Instancetype.Kind)
+	}
+
+	cr, err := u.findControllerRevision(vm, vm.Status.InstancetypeRef)
+	if err != nil {
+		return nil, "", err
+	}
+	revisionSpec, err := compatibility.GetInstancetypeSpec(cr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	changedFields, err := ChangedFields(revisionSpec, currentSpec)
+	if err != nil {
+		return nil, "", err
+	}
+	return u.updateStatusRef(vm, vm.Status.InstancetypeRef, obj, changedFields)
+}
+
+func (u *updater) updatePreference(vm *virtv1.VirtualMachine) (*virtv1.InstancetypeStatusRef, string, error) {
+	// Any RevisionName provided by the matcher pins the VirtualMachine to a specific ControllerRevision
+	if vm.Spec.Preference == nil || vm.Spec.Preference.RevisionName != "" ||
+		!revision.HasControllerRevisionRef(vm.Status.PreferenceRef) {
+		return nil, "", nil
+	}
+
+	var (
+		obj         object
+		currentSpec *v1beta1.VirtualMachinePreferenceSpec
+	)
+	switch strings.ToLower(vm.Spec.Preference.Kind) {
+	case api.SingularPreferenceResourceName, api.PluralPreferenceResourceName:
+		preference, err := u.preferenceFinder.FindPreference(vm)
+		if err != nil {
+			return nil, "", err
+		}
+		obj, currentSpec = preference, &preference.Spec
+	case api.ClusterSingularPreferenceResourceName, api.ClusterPluralPreferenceResourceName, "":
+		clusterPreference, err := u.clusterPreferenceFinder.FindPreference(vm)
+		if err != nil {
+			return nil, "", err
+		}
+		obj, currentSpec = clusterPreference, &clusterPreference.Spec
+	default:
+		return nil, "", fmt.Errorf("got unexpected kind in PreferenceMatcher: %s", vm.Spec.Preference.Kind)
+	}
+
+	cr, err := u.findControllerRevision(vm, vm.Status.PreferenceRef)
+	if err != nil {
+		return nil, "", err
+	}
+	revisionSpec, err := compatibility.GetPreferenceSpec(cr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	changedFields, err := ChangedFields(revisionSpec, currentSpec)
+	if err != nil {
+		return nil, "", err
+	}
+	return u.updateStatusRef(vm, vm.Status.PreferenceRef, obj, changedFields)
+}
+
+func (u *updater) findControllerRevision(
+	vm *virtv1.VirtualMachine,
+	statusRef *virtv1.InstancetypeStatusRef,
+) (*appsv1.ControllerRevision, error) {
+	return u.controllerRevisionFinder.Find(types.NamespacedName{
+		Namespace: vm.Namespace,
+		Name:      statusRef.ControllerRevisionRef.Name,
+	})
+}
+
+// updateStatusRef returns the status reference to patch into the VirtualMachine, or nil if it is unchanged, along with
+// the name of the ControllerRevision the VirtualMachine was moved away from
+func (u *updater) updateStatusRef(
+	vm *virtv1.VirtualMachine,
+	statusRef *virtv1.InstancetypeStatusRef,
+	obj object,
+	changedFields []string,
+) (*virtv1.InstancetypeStatusRef, string, error) {
+	updatedStatusRef := statusRef.DeepCopy()
+
+	switch {
+	case len(changedFields) == 0:
+		updatedStatusRef.PendingUpdate = nil
+	case !IsApproved(obj):
+		updatedStatusRef.PendingUpdate = &virtv1.InstancetypeStatusPendingUpdate{
+			ObservedGeneration: obj.GetGeneration(),
+			ChangedFields:      changedFields,
+		}
+	default:
+		newCR, err := u.storeControllerRevision(vm, obj)
+		if err != nil {
+			return nil, "", err
+		}
+		log.Log.Object(vm).Infof("moving from ControllerRevision %s to approved ControllerRevision %s",
+			statusRef.ControllerRevisionRef.Name, newCR.Name)
+		updatedStatusRef.ControllerRevisionRef = &virtv1.ControllerRevisionRef{
+			Name: newCR.Name,
+		}
+		updatedStatusRef.PendingUpdate = nil
+		return updatedStatusRef, statusRef.ControllerRevisionRef.Name, nil
+	}
+
+	if equality.Semantic.DeepEqual(statusRef, updatedStatusRef) {
+		return nil, "", nil
+	}
+	return updatedStatusRef, "", nil
+}
+
+func (u *updater) storeControllerRevision(vm *virtv1.VirtualMachine, obj object) (*appsv1.ControllerRevision, error) {
+	cr, err := revision.CreateControllerRevision(vm, obj)
+	if err != nil {
+		return nil, err
+	}
+	createdCR, err := u.virtClient.AppsV1().ControllerRevisions(vm.Namespace).Create(context.Background(), cr, metav1.CreateOptions{})
+	if err != nil {
+		if !errors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("failed to create ControllerRevision: %w", err)
+		}
+		// The name of the ControllerRevision includes the generation of the object, an existing one captured the same spec
+		return u.virtClient.AppsV1().ControllerRevisions(vm.Namespace).Get(context.Background(), cr.Name, metav1.GetOptions{})
+	}
+	return createdCR, nil
+}
+
+// IsApproved returns whether the current generation of the instancetype or preference was approved to be rolled out
+func IsApproved(obj metav1.Object) bool {
+	approvedGeneration, ok := obj.GetAnnotations()[api.ApprovedGenerationAnnotation]
+	return ok && approvedGeneration == strconv.FormatInt(obj.GetGeneration(), 10)
+}
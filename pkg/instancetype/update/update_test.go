@@ -0,0 +1,259 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package update_test
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	instancetypeapi "kubevirt.io/api/instancetype"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	fakeclientset "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/revision"
+	"kubevirt.io/kubevirt/pkg/instancetype/update"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("ControllerRevision updates", func() {
+	type updater interface {
+		Update(vm *virtv1.VirtualMachine) error
+	}
+
+	var (
+		vm           *virtv1.VirtualMachine
+		instancetype *instancetypev1beta1.VirtualMachineInstancetype
+		originalCR   *appsv1.ControllerRevision
+
+		virtClient     *kubecli.MockKubevirtClient
+		k8sClient      *fake.Clientset
+		kubevirtClient *fakeclientset.Clientset
+
+		updateHandler     updater
+		instancetypeStore cache.Store
+	)
+
+	BeforeEach(func() {
+		instancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineInstancetype{})
+		instancetypeStore = instancetypeInformer.GetStore()
+		clusterInstancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterInstancetype{})
+		preferenceInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachinePreference{})
+		clusterPreferenceInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterPreference{})
+		controllerRevisionInformer, _ := testutils.NewFakeInformerFor(&appsv1.ControllerRevision{})
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		k8sClient = fake.NewSimpleClientset()
+		kubevirtClient = fakeclientset.NewSimpleClientset()
+
+		virtClient.EXPECT().AppsV1().Return(k8sClient.AppsV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(
+			kubevirtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+
+		instancetype = &instancetypev1beta1.VirtualMachineInstancetype{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "small",
+				Namespace:  metav1.NamespaceDefault,
+				UID:        "2d2d9b8c-4d9e-4b1e-9d11-3a6f40d3d8b0",
+				Generation: 1,
+			},
+			Spec: instancetypev1beta1.VirtualMachineInstancetypeSpec{
+				CPU: instancetypev1beta1.CPUInstancetype{
+					Guest: uint32(1),
+				},
+				Memory: instancetypev1beta1.MemoryInstancetype{
+					Guest: resource.MustParse("1Gi"),
+				},
+			},
+		}
+
+		vm = libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault)))
+		vm.Spec.Instancetype = &virtv1.InstancetypeMatcher{
+			Name: instancetype.Name,
+			Kind: instancetypeapi.SingularResourceName,
+		}
+
+		var err error
+		originalCR, err = revision.CreateControllerRevision(vm, instancetype)
+		Expect(err).ToNot(HaveOccurred())
+		originalCR.Data.Raw, err = json.Marshal(originalCR.Data.Object)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(controllerRevisionInformer.GetStore().Add(originalCR)).To(Succeed())
+		_, err = k8sClient.AppsV1().ControllerRevisions(vm.Namespace).Create(context.Background(), originalCR, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		vm.Status.InstancetypeRef = &virtv1.InstancetypeStatusRef{
+			Name: instancetype.Name,
+			Kind: instancetypeapi.SingularResourceName,
+			ControllerRevisionRef: &virtv1.ControllerRevisionRef{
+				Name: originalCR.Name,
+			},
+		}
+		vm, err = kubevirtClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		updateHandler = update.New(
+			instancetypeStore,
+			clusterInstancetypeInformer.GetStore(),
+			preferenceInformer.GetStore(),
+			clusterPreferenceInformer.GetStore(),
+			controllerRevisionInformer.GetStore(),
+			virtClient,
+		)
+	})
+
+	updateInstancetype := func(approve bool) {
+		instancetype.Generation = 2
+		instancetype.Spec.CPU.Guest = uint32(2)
+		if approve {
+			instancetype.Annotations = map[string]string{
+				instancetypeapi.ApprovedGenerationAnnotation: strconv.FormatInt(instancetype.Generation, 10),
+			}
+		}
+		Expect(instancetypeStore.Update(instancetype)).To(Succeed())
+	}
+
+	getVM := func() *virtv1.VirtualMachine {
+		updatedVM, err := kubevirtClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return updatedVM
+	}
+
+	It("should not publish a pending update when the instancetype is unchanged", func() {
+		Expect(instancetypeStore.Add(instancetype)).To(Succeed())
+		Expect(updateHandler.Update(vm)).To(Succeed())
+		Expect(getVM().Status.InstancetypeRef.PendingUpdate).To(BeNil())
+	})
+
+	It("should publish the changes of the instancetype as a pending update", func() {
+		Expect(instancetypeStore.Add(instancetype)).To(Succeed())
+		updateInstancetype(false)
+
+		Expect(updateHandler.Update(vm)).To(Succeed())
+
+		updatedVM := getVM()
+		Expect(updatedVM.Status.InstancetypeRef.ControllerRevisionRef.Name).To(Equal(originalCR.Name))
+		Expect(updatedVM.Status.InstancetypeRef.PendingUpdate).To(Equal(&virtv1.InstancetypeStatusPendingUpdate{
+			ObservedGeneration: 2,
+			ChangedFields:      []string{"spec.cpu.guest"},
+		}))
+	})
+
+	It("should move the VirtualMachine to a new ControllerRevision once the update is approved", func() {
+		Expect(instancetypeStore.Add(instancetype)).To(Succeed())
+		vm.Status.InstancetypeRef.PendingUpdate = &virtv1.InstancetypeStatusPendingUpdate{
+			ObservedGeneration: 2,
+			ChangedFields:      []string{"spec.cpu.guest"},
+		}
+		updateInstancetype(true)
+
+		Expect(updateHandler.Update(vm)).To(Succeed())
+
+		updatedVM := getVM()
+		Expect(updatedVM.Status.InstancetypeRef.PendingUpdate).To(BeNil())
+		newCRName := updatedVM.Status.InstancetypeRef.ControllerRevisionRef.Name
+		Expect(newCRName).ToNot(Equal(originalCR.Name))
+		Expect(vm.Status.InstancetypeRef.ControllerRevisionRef.Name).To(Equal(newCRName))
+
+		newCR, err := k8sClient.AppsV1().ControllerRevisions(vm.Namespace).Get(context.Background(), newCRName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(newCR.Labels).To(HaveKeyWithValue(instancetypeapi.ControllerRevisionObjectGenerationLabel, "2"))
+
+		_, err = k8sClient.AppsV1().ControllerRevisions(vm.Namespace).Get(context.Background(), originalCR.Name, metav1.GetOptions{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should keep the stale ControllerRevision while the VirtualMachineInstance is running", func() {
+		Expect(instancetypeStore.Add(instancetype)).To(Succeed())
+		vm.Status.Created = true
+		updateInstancetype(true)
+
+		Expect(updateHandler.Update(vm)).To(Succeed())
+
+		Expect(getVM().Status.InstancetypeRef.ControllerRevisionRef.Name).ToNot(Equal(originalCR.Name))
+		_, err := k8sClient.AppsV1().ControllerRevisions(vm.Namespace).Get(context.Background(), originalCR.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should not touch a VirtualMachine pinned to a ControllerRevision", func() {
+		Expect(instancetypeStore.Add(instancetype)).To(Succeed())
+		vm.Spec.Instancetype.RevisionName = originalCR.Name
+		updateInstancetype(true)
+
+		Expect(updateHandler.Update(vm)).To(Succeed())
+
+		updatedVM := getVM()
+		Expect(updatedVM.Status.InstancetypeRef.ControllerRevisionRef.Name).To(Equal(originalCR.Name))
+		Expect(updatedVM.Status.InstancetypeRef.PendingUpdate).To(BeNil())
+	})
+
+	DescribeTable("IsApproved should return", func(annotations map[string]string, expected bool) {
+		Expect(update.IsApproved(&metav1.ObjectMeta{Generation: 2, Annotations: annotations})).To(Equal(expected))
+	},
+		Entry("false without annotation", nil, false),
+		Entry("false when an older generation is approved",
+			map[string]string{instancetypeapi.ApprovedGenerationAnnotation: "1"}, false),
+		Entry("true when the current generation is approved",
+			map[string]string{instancetypeapi.ApprovedGenerationAnnotation: "2"}, true),
+	)
+
+	DescribeTable("ChangedFields should return", func(oldSpec, newSpec instancetypev1beta1.VirtualMachinePreferenceSpec, expected []string) {
+		changedFields, err := update.ChangedFields(&oldSpec, &newSpec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changedFields).To(Equal(expected))
+	},
+		Entry("nothing for equal specs",
+			instancetypev1beta1.VirtualMachinePreferenceSpec{},
+			instancetypev1beta1.VirtualMachinePreferenceSpec{},
+			nil,
+		),
+		Entry("added, removed and changed fields",
+			instancetypev1beta1.VirtualMachinePreferenceSpec{
+				PreferredTerminationGracePeriodSeconds: pointer.P(int64(30)),
+				Machine: &instancetypev1beta1.MachinePreferences{
+					PreferredMachineType: "pc",
+				},
+			},
+			instancetypev1beta1.VirtualMachinePreferenceSpec{
+				Machine: &instancetypev1beta1.MachinePreferences{
+					PreferredMachineType: "q35",
+				},
+				PreferredSubdomain: pointer.P("example"),
+			},
+			[]string{"spec.machine.preferredMachineType", "spec.preferredSubdomain", "spec.preferredTerminationGracePeriodSeconds"},
+		),
+	)
+})
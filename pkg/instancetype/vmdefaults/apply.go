@@ -0,0 +1,152 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package vmdefaults
+
+import (
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/instancetype/v1beta1"
+)
+
+// ApplyToVM applies the defaults to the attributes of the VirtualMachine that neither the VirtualMachine nor its preference set.
+// The first of the specs setting an attribute wins.
+func ApplyToVM(vm *virtv1.VirtualMachine, specs []v1beta1.VirtualMachineDefaultSpec, preferenceSpec *v1beta1.VirtualMachinePreferenceSpec) {
+	if preferenceSpec == nil {
+		preferenceSpec = &v1beta1.VirtualMachinePreferenceSpec{}
+	}
+	for i := range specs {
+		applyRunStrategy(vm, &specs[i])
+		applyPodNetworkBinding(vm, &specs[i], preferenceSpec)
+		applyMachineType(vm, &specs[i], preferenceSpec)
+		applyFirmware(vm, &specs[i], preferenceSpec)
+		applyDevices(vm, &specs[i], preferenceSpec)
+	}
+}
+
+func applyRunStrategy(vm *virtv1.VirtualMachine, spec *v1beta1.VirtualMachineDefaultSpec) {
+	if spec.RunStrategy == nil || vm.Spec.RunStrategy != nil || vm.Spec.Running != nil {
+		return
+	}
+	runStrategy := *spec.RunStrategy
+	vm.Spec.RunStrategy = &runStrategy
+}
+
+func applyPodNetworkBinding(vm *virtv1.VirtualMachine, spec *v1beta1.VirtualMachineDefaultSpec, preferenceSpec *v1beta1.VirtualMachinePreferenceSpec) {
+	if spec.PodNetworkBinding == "" {
+		return
+	}
+	vmiSpec := &vm.Spec.Template.Spec
+	if len(vmiSpec.Networks) != 0 || len(vmiSpec.Domain.Devices.Interfaces) != 0 {
+		return
+	}
+	autoattachPodInterface := vmiSpec.Domain.Devices.AutoattachPodInterface
+	if autoattachPodInterface == nil && preferenceSpec.Devices != nil {
+		autoattachPodInterface = preferenceSpec.Devices.PreferredAutoattachPodInterface
+	}
+	if autoattachPodInterface != nil && !*autoattachPodInterface {
+		return
+	}
+
+	var iface *virtv1.Interface
+	switch virtv1.NetworkInterfaceType(spec.PodNetworkBinding) {
+	case virtv1.BridgeInterface:
+		iface = virtv1.DefaultBridgeNetworkInterface()
+	case virtv1.MasqueradeInterface:
+		iface = virtv1.DefaultMasqueradeNetworkInterface()
+	default:
+		iface = &virtv1.Interface{
+			Name:    virtv1.DefaultPodNetwork().Name,
+			Binding: &virtv1.PluginBinding{Name: spec.PodNetworkBinding},
+		}
+	}
+	vmiSpec.Domain.Devices.Interfaces = []virtv1.Interface{*iface}
+	vmiSpec.Networks = []virtv1.Network{*virtv1.DefaultPodNetwork()}
+}
+
+func applyMachineType(vm *virtv1.VirtualMachine, spec *v1beta1.VirtualMachineDefaultSpec, preferenceSpec *v1beta1.VirtualMachinePreferenceSpec) {
+	if spec.MachineType == "" {
+		return
+	}
+	domain := &vm.Spec.Template.Spec.Domain
+	if domain.Machine != nil && domain.Machine.Type != "" {
+		return
+	}
+	if preferenceSpec.Machine != nil && preferenceSpec.Machine.PreferredMachineType != "" {
+		return
+	}
+	if domain.Machine == nil {
+		domain.Machine = &virtv1.Machine{}
+	}
+	domain.Machine.Type = spec.MachineType
+}
+
+func applyFirmware(vm *virtv1.VirtualMachine, spec *v1beta1.VirtualMachineDefaultSpec, preferenceSpec *v1beta1.VirtualMachinePreferenceSpec) {
+	if spec.Firmware == nil || spec.Firmware.Bootloader == nil {
+		return
+	}
+	domain := &vm.Spec.Template.Spec.Domain
+	if domain.Firmware != nil && domain.Firmware.Bootloader != nil {
+		return
+	}
+	if preferenceSetsBootloader(preferenceSpec) {
+		return
+	}
+	if domain.Firmware == nil {
+		domain.Firmware = &virtv1.Firmware{}
+	}
+	domain.Firmware.Bootloader = spec.Firmware.Bootloader.DeepCopy()
+}
+
+func preferenceSetsBootloader(preferenceSpec *v1beta1.VirtualMachinePreferenceSpec) bool {
+	firmware := preferenceSpec.Firmware
+	if firmware == nil {
+		return false
+	}
+	return firmware.PreferredUseBios != nil ||
+		firmware.PreferredUseBiosSerial != nil ||
+		firmware.PreferredEfi != nil ||
+		firmware.DeprecatedPreferredUseEfi != nil ||
+		firmware.DeprecatedPreferredUseSecureBoot != nil
+}
+
+func applyDevices(vm *virtv1.VirtualMachine, spec *v1beta1.VirtualMachineDefaultSpec, preferenceSpec *v1beta1.VirtualMachinePreferenceSpec) {
+	if spec.Devices == nil {
+		return
+	}
+	devices := &vm.Spec.Template.Spec.Domain.Devices
+	preferredDevices := preferenceSpec.Devices
+	if preferredDevices == nil {
+		preferredDevices = &v1beta1.DevicePreferences{}
+	}
+
+	if spec.Devices.Rng != nil && devices.Rng == nil && preferredDevices.PreferredRng == nil {
+		devices.Rng = spec.Devices.Rng.DeepCopy()
+	}
+	applyBool(&devices.AutoattachGraphicsDevice, spec.Devices.AutoattachGraphicsDevice, preferredDevices.PreferredAutoattachGraphicsDevice)
+	applyBool(&devices.AutoattachSerialConsole, spec.Devices.AutoattachSerialConsole, preferredDevices.PreferredAutoattachSerialConsole)
+	applyBool(&devices.AutoattachMemBalloon, spec.Devices.AutoattachMemBalloon, preferredDevices.PreferredAutoattachMemBalloon)
+	applyBool(&devices.AutoattachVSOCK, spec.Devices.AutoattachVSOCK, nil)
+}
+
+func applyBool(target **bool, value, preferredValue *bool) {
+	if value == nil || *target != nil || preferredValue != nil {
+		return
+	}
+	v := *value
+	*target = &v
+}
@@ -0,0 +1,169 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package vmdefaults_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/vmdefaults"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Apply VirtualMachineDefaults", func() {
+	var vm *virtv1.VirtualMachine
+
+	BeforeEach(func() {
+		vm = libvmi.NewVirtualMachine(libvmi.New())
+		vm.Spec.RunStrategy = nil
+	})
+
+	It("should apply all defaults to an empty VM", func() {
+		vmdefaults.ApplyToVM(vm, []v1beta1.VirtualMachineDefaultSpec{{
+			RunStrategy:       pointer.P(virtv1.RunStrategyManual),
+			PodNetworkBinding: string(virtv1.BridgeInterface),
+			MachineType:       "q35",
+			Firmware: &v1beta1.FirmwareDefaults{
+				Bootloader: &virtv1.Bootloader{EFI: &virtv1.EFI{}},
+			},
+			Devices: &v1beta1.DeviceDefaults{
+				Rng:                      &virtv1.Rng{},
+				AutoattachGraphicsDevice: pointer.P(false),
+				AutoattachSerialConsole:  pointer.P(false),
+				AutoattachMemBalloon:     pointer.P(false),
+				AutoattachVSOCK:          pointer.P(true),
+			},
+		}}, nil)
+
+		Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(virtv1.RunStrategyManual)))
+		vmiSpec := vm.Spec.Template.Spec
+		Expect(vmiSpec.Domain.Devices.Interfaces).To(ConsistOf(*virtv1.DefaultBridgeNetworkInterface()))
+		Expect(vmiSpec.Networks).To(ConsistOf(*virtv1.DefaultPodNetwork()))
+		Expect(vmiSpec.Domain.Machine).To(Equal(&virtv1.Machine{Type: "q35"}))
+		Expect(vmiSpec.Domain.Firmware.Bootloader).To(Equal(&virtv1.Bootloader{EFI: &virtv1.EFI{}}))
+		Expect(vmiSpec.Domain.Devices.Rng).To(Equal(&virtv1.Rng{}))
+		Expect(vmiSpec.Domain.Devices.AutoattachGraphicsDevice).To(HaveValue(BeFalse()))
+		Expect(vmiSpec.Domain.Devices.AutoattachSerialConsole).To(HaveValue(BeFalse()))
+		Expect(vmiSpec.Domain.Devices.AutoattachMemBalloon).To(HaveValue(BeFalse()))
+		Expect(vmiSpec.Domain.Devices.AutoattachVSOCK).To(HaveValue(BeTrue()))
+	})
+
+	It("should apply the first spec setting an attribute", func() {
+		vmdefaults.ApplyToVM(vm, []v1beta1.VirtualMachineDefaultSpec{{
+			MachineType: "first",
+		}, {
+			RunStrategy: pointer.P(virtv1.RunStrategyHalted),
+			MachineType: "second",
+		}}, nil)
+
+		Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(virtv1.RunStrategyHalted)))
+		Expect(vm.Spec.Template.Spec.Domain.Machine.Type).To(Equal("first"))
+	})
+
+	It("should use a network binding plugin for the pod network", func() {
+		vmdefaults.ApplyToVM(vm, []v1beta1.VirtualMachineDefaultSpec{{
+			PodNetworkBinding: "passt",
+		}}, nil)
+
+		Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces).To(ConsistOf(virtv1.Interface{
+			Name:    "default",
+			Binding: &virtv1.PluginBinding{Name: "passt"},
+		}))
+		Expect(vm.Spec.Template.Spec.Networks).To(ConsistOf(*virtv1.DefaultPodNetwork()))
+	})
+
+	It("should not override the attributes set by the VM", func() {
+		vm = libvmi.NewVirtualMachine(libvmi.New(
+			libvmi.WithInterface(*virtv1.DefaultMasqueradeNetworkInterface()),
+			libvmi.WithNetwork(virtv1.DefaultPodNetwork()),
+		), libvmi.WithRunStrategy(virtv1.RunStrategyAlways))
+		vm.Spec.Template.Spec.Domain.Machine = &virtv1.Machine{Type: "pc"}
+		vm.Spec.Template.Spec.Domain.Firmware = &virtv1.Firmware{Bootloader: &virtv1.Bootloader{BIOS: &virtv1.BIOS{}}}
+		vm.Spec.Template.Spec.Domain.Devices.AutoattachSerialConsole = pointer.P(true)
+		expectedSpec := vm.Spec.DeepCopy()
+
+		vmdefaults.ApplyToVM(vm, []v1beta1.VirtualMachineDefaultSpec{{
+			RunStrategy:       pointer.P(virtv1.RunStrategyHalted),
+			PodNetworkBinding: string(virtv1.BridgeInterface),
+			MachineType:       "q35",
+			Firmware: &v1beta1.FirmwareDefaults{
+				Bootloader: &virtv1.Bootloader{EFI: &virtv1.EFI{}},
+			},
+			Devices: &v1beta1.DeviceDefaults{
+				AutoattachSerialConsole: pointer.P(false),
+			},
+		}}, nil)
+
+		Expect(vm.Spec).To(Equal(*expectedSpec))
+	})
+
+	It("should not set the RunStrategy of a VM using running", func() {
+		vm.Spec.Running = pointer.P(true)
+		vmdefaults.ApplyToVM(vm, []v1beta1.VirtualMachineDefaultSpec{{
+			RunStrategy: pointer.P(virtv1.RunStrategyHalted),
+		}}, nil)
+		Expect(vm.Spec.RunStrategy).To(BeNil())
+	})
+
+	It("should not attach the pod network when the pod interface is not autoattached", func() {
+		vm.Spec.Template.Spec.Domain.Devices.AutoattachPodInterface = pointer.P(false)
+		vmdefaults.ApplyToVM(vm, []v1beta1.VirtualMachineDefaultSpec{{
+			PodNetworkBinding: string(virtv1.MasqueradeInterface),
+		}}, nil)
+		Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces).To(BeEmpty())
+		Expect(vm.Spec.Template.Spec.Networks).To(BeEmpty())
+	})
+
+	It("should not override the attributes set by the preference", func() {
+		vmdefaults.ApplyToVM(vm, []v1beta1.VirtualMachineDefaultSpec{{
+			PodNetworkBinding: string(virtv1.MasqueradeInterface),
+			MachineType:       "q35",
+			Firmware: &v1beta1.FirmwareDefaults{
+				Bootloader: &virtv1.Bootloader{EFI: &virtv1.EFI{}},
+			},
+			Devices: &v1beta1.DeviceDefaults{
+				Rng:                  &virtv1.Rng{},
+				AutoattachMemBalloon: pointer.P(false),
+			},
+		}}, &v1beta1.VirtualMachinePreferenceSpec{
+			Machine: &v1beta1.MachinePreferences{
+				PreferredMachineType: "pc",
+			},
+			Firmware: &v1beta1.FirmwarePreferences{
+				PreferredUseBios: pointer.P(true),
+			},
+			Devices: &v1beta1.DevicePreferences{
+				PreferredAutoattachPodInterface: pointer.P(false),
+				PreferredRng:                    &virtv1.Rng{},
+				PreferredAutoattachMemBalloon:   pointer.P(true),
+			},
+		})
+
+		vmiSpec := vm.Spec.Template.Spec
+		Expect(vmiSpec.Domain.Devices.Interfaces).To(BeEmpty())
+		Expect(vmiSpec.Domain.Machine).To(BeNil())
+		Expect(vmiSpec.Domain.Firmware).To(BeNil())
+		Expect(vmiSpec.Domain.Devices.Rng).To(BeNil())
+		Expect(vmiSpec.Domain.Devices.AutoattachMemBalloon).To(BeNil())
+	})
+})
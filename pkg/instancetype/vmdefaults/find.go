@@ -0,0 +1,91 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package vmdefaults
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/instancetype/v1beta1"
+)
+
+type finder struct {
+	defaultIndexer      cache.Indexer
+	clusterDefaultStore cache.Store
+}
+
+func NewFinder(defaultIndexer cache.Indexer, clusterDefaultStore cache.Store) *finder {
+	return &finder{
+		defaultIndexer:      defaultIndexer,
+		clusterDefaultStore: clusterDefaultStore,
+	}
+}
+
+// FindDefaults returns the specs of the VirtualMachineDefaults and VirtualMachineClusterDefaults selecting the VirtualMachine.
+// The namespaced defaults come first, then the cluster defaults, each of them ordered by name.
+func (f *finder) FindDefaults(vm *virtv1.VirtualMachine) ([]v1beta1.VirtualMachineDefaultSpec, error) {
+	objs, err := f.defaultIndexer.ByIndex(cache.NamespaceIndex, vm.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	defaults := make([]*v1beta1.VirtualMachineDefault, 0, len(objs))
+	for _, obj := range objs {
+		defaults = append(defaults, obj.(*v1beta1.VirtualMachineDefault))
+	}
+	clusterDefaults := make([]*v1beta1.VirtualMachineClusterDefault, 0, len(f.clusterDefaultStore.List()))
+	for _, obj := range f.clusterDefaultStore.List() {
+		clusterDefaults = append(clusterDefaults, obj.(*v1beta1.VirtualMachineClusterDefault))
+	}
+
+	sort.Slice(defaults, func(i, j int) bool {
+		return defaults[i].Name < defaults[j].Name
+	})
+	sort.Slice(clusterDefaults, func(i, j int) bool {
+		return clusterDefaults[i].Name < clusterDefaults[j].Name
+	})
+
+	// The specs are copied, as the defaults are shared with the informer cache
+	var specs []v1beta1.VirtualMachineDefaultSpec
+	for _, vmDefault := range defaults {
+		if selects(vmDefault.Spec.Selector, vm) {
+			specs = append(specs, *vmDefault.Spec.DeepCopy())
+		}
+	}
+	for _, clusterDefault := range clusterDefaults {
+		if selects(clusterDefault.Spec.Selector, vm) {
+			specs = append(specs, *clusterDefault.Spec.DeepCopy())
+		}
+	}
+	return specs, nil
+}
+func selects(selector *metav1.LabelSelector, vm *virtv1.VirtualMachine) bool {
+	if selector == nil {
+		return true
+	}
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		// Invalid selectors are rejected on admission, ignore the defaults if one slipped through
+		return false
+	}
+	return labelSelector.Matches(labels.Set(vm.Labels))
+}
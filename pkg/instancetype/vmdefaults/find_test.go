@@ -0,0 +1,105 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package vmdefaults_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/vmdefaults"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("Find VirtualMachineDefaults", func() {
+	var (
+		defaultIndexer      cache.Indexer
+		clusterDefaultStore cache.Store
+		vm                  *virtv1.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		defaultIndexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		clusterDefaultStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+
+		vm = libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault)))
+		vm.Labels = map[string]string{"os": "fedora"}
+	})
+
+	createDefault := func(namespace, name, machineType string, selector *metav1.LabelSelector) {
+		Expect(defaultIndexer.Add(&v1beta1.VirtualMachineDefault{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       v1beta1.VirtualMachineDefaultSpec{MachineType: machineType, Selector: selector},
+		})).To(Succeed())
+	}
+
+	createClusterDefault := func(name, machineType string, selector *metav1.LabelSelector) {
+		Expect(clusterDefaultStore.Add(&v1beta1.VirtualMachineClusterDefault{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       v1beta1.VirtualMachineDefaultSpec{MachineType: machineType, Selector: selector},
+		})).To(Succeed())
+	}
+
+	machineTypes := func(specs []v1beta1.VirtualMachineDefaultSpec) []string {
+		var result []string
+		for _, spec := range specs {
+			result = append(result, spec.MachineType)
+		}
+		return result
+	}
+
+	It("should return nothing without defaults", func() {
+		specs, err := vmdefaults.NewFinder(defaultIndexer, clusterDefaultStore).FindDefaults(vm)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(specs).To(BeEmpty())
+	})
+
+	It("should return the namespaced defaults before the cluster defaults, ordered by name", func() {
+		createClusterDefault("b", "cluster-b", nil)
+		createClusterDefault("a", "cluster-a", nil)
+		createDefault(vm.Namespace, "b", "namespaced-b", nil)
+		createDefault(vm.Namespace, "a", "namespaced-a", nil)
+		createDefault("other", "a", "other-namespace", nil)
+
+		specs, err := vmdefaults.NewFinder(defaultIndexer, clusterDefaultStore).FindDefaults(vm)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(machineTypes(specs)).To(Equal([]string{"namespaced-a", "namespaced-b", "cluster-a", "cluster-b"}))
+	})
+
+	It("should only return the defaults selecting the VM", func() {
+		createDefault(vm.Namespace, "fedora", "fedora", &metav1.LabelSelector{MatchLabels: map[string]string{"os": "fedora"}})
+		createDefault(vm.Namespace, "windows", "windows", &metav1.LabelSelector{MatchLabels: map[string]string{"os": "windows"}})
+		createClusterDefault("linux", "linux", &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key:      "os",
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   []string{"fedora", "centos"},
+			}},
+		})
+
+		specs, err := vmdefaults.NewFinder(defaultIndexer, clusterDefaultStore).FindDefaults(vm)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(machineTypes(specs)).To(Equal([]string{"fedora", "linux"}))
+	})
+})
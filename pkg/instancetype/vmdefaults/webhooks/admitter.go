@@ -0,0 +1,157 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	instancetypeapi "kubevirt.io/api/instancetype"
+	instancetypeapiv1beta1 "kubevirt.io/api/instancetype/v1beta1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+type DefaultAdmitter struct {
+	ClusterConfig *virtconfig.ClusterConfig
+}
+
+func NewDefaultAdmitter(clusterConfig *virtconfig.ClusterConfig) *DefaultAdmitter {
+	return &DefaultAdmitter{ClusterConfig: clusterConfig}
+}
+
+func (f *DefaultAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	return admitDefault(ar.Request, instancetypeapi.PluralDefaultResourceName, f.ClusterConfig)
+}
+
+type ClusterDefaultAdmitter struct {
+	ClusterConfig *virtconfig.ClusterConfig
+}
+
+func NewClusterDefaultAdmitter(clusterConfig *virtconfig.ClusterConfig) *ClusterDefaultAdmitter {
+	return &ClusterDefaultAdmitter{ClusterConfig: clusterConfig}
+}
+
+func (f *ClusterDefaultAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	return admitDefault(ar.Request, instancetypeapi.ClusterPluralDefaultResourceName, f.ClusterConfig)
+}
+
+var validRunStrategies = []virtv1.VirtualMachineRunStrategy{
+	virtv1.RunStrategyHalted,
+	virtv1.RunStrategyManual,
+	virtv1.RunStrategyAlways,
+	virtv1.RunStrategyRerunOnFailure,
+	virtv1.RunStrategyOnce,
+}
+
+const (
+	invalidSelectorErrFmt          = "invalid selector: %v"
+	invalidRunStrategyErrFmt       = "invalid runStrategy %s"
+	unknownPodNetworkBindingErrFmt = "podNetworkBinding %s is neither bridge, masquerade nor a network binding plugin registered in the KubeVirt CR"
+	multipleBootloadersErr         = "only one of bios and efi can be set"
+)
+
+func ValidateDefaultSpec(field *k8sfield.Path, spec *instancetypeapiv1beta1.VirtualMachineDefaultSpec, clusterConfig *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(spec.Selector); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf(invalidSelectorErrFmt, err),
+				Field:   field.Child("selector").String(),
+			})
+		}
+	}
+
+	if spec.RunStrategy != nil && !slices.Contains(validRunStrategies, *spec.RunStrategy) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(invalidRunStrategyErrFmt, *spec.RunStrategy),
+			Field:   field.Child("runStrategy").String(),
+		})
+	}
+
+	if spec.PodNetworkBinding != "" && !isPodNetworkBindingKnown(spec.PodNetworkBinding, clusterConfig) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf(unknownPodNetworkBindingErrFmt, spec.PodNetworkBinding),
+			Field:   field.Child("podNetworkBinding").String(),
+		})
+	}
+
+	if spec.Firmware != nil && spec.Firmware.Bootloader != nil &&
+		spec.Firmware.Bootloader.BIOS != nil && spec.Firmware.Bootloader.EFI != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: multipleBootloadersErr,
+			Field:   field.Child("firmware", "bootloader").String(),
+		})
+	}
+	return causes
+}
+
+func isPodNetworkBindingKnown(binding string, clusterConfig *virtconfig.ClusterConfig) bool {
+	switch virtv1.NetworkInterfaceType(binding) {
+	case virtv1.BridgeInterface, virtv1.MasqueradeInterface:
+		return true
+	}
+	_, exists := clusterConfig.GetNetworkBindings()[binding]
+	return exists
+}
+
+func admitDefault(request *admissionv1.AdmissionRequest, resource string, clusterConfig *virtconfig.ClusterConfig) *admissionv1.AdmissionResponse {
+	// Only handle create and update
+	if request.Operation != admissionv1.Create && request.Operation != admissionv1.Update {
+		return &admissionv1.AdmissionResponse{
+			Allowed: true,
+		}
+	}
+
+	gvk := schema.GroupVersionKind{
+		Group:   instancetypeapiv1beta1.SchemeGroupVersion.Group,
+		Kind:    resource,
+		Version: request.Resource.Version,
+	}
+	if resp := webhookutils.ValidateSchema(gvk, request.Object.Raw); resp != nil {
+		return resp
+	}
+
+	// VirtualMachineDefault and VirtualMachineClusterDefault share the same spec
+	obj := instancetypeapiv1beta1.VirtualMachineDefault{}
+	if err := json.Unmarshal(request.Object.Raw, &obj); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+	causes := ValidateDefaultSpec(k8sfield.NewPath("spec"), &obj.Spec, clusterConfig)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+	return &admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+}
@@ -0,0 +1,142 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package webhooks_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	apiinstancetype "kubevirt.io/api/instancetype"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/vmdefaults/webhooks"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Validating VirtualMachineDefault Admitter", func() {
+	var (
+		admitter        *webhooks.DefaultAdmitter
+		clusterAdmitter *webhooks.ClusterDefaultAdmitter
+	)
+
+	BeforeEach(func() {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{
+			NetworkConfiguration: &virtv1.NetworkConfiguration{
+				Binding: map[string]virtv1.InterfaceBindingPlugin{
+					"passt": {},
+				},
+			},
+		})
+		admitter = webhooks.NewDefaultAdmitter(clusterConfig)
+		clusterAdmitter = webhooks.NewClusterDefaultAdmitter(clusterConfig)
+	})
+
+	DescribeTable("should accept", func(spec instancetypev1beta1.VirtualMachineDefaultSpec) {
+		response := admitter.Admit(context.Background(), createDefaultAdmissionReview(spec, apiinstancetype.PluralDefaultResourceName))
+		Expect(response.Allowed).To(BeTrue())
+
+		response = clusterAdmitter.Admit(context.Background(), createDefaultAdmissionReview(spec, apiinstancetype.ClusterPluralDefaultResourceName))
+		Expect(response.Allowed).To(BeTrue())
+	},
+		Entry("an empty spec", instancetypev1beta1.VirtualMachineDefaultSpec{}),
+		Entry("a selector", instancetypev1beta1.VirtualMachineDefaultSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"os": "fedora"}},
+		}),
+		Entry("a run strategy", instancetypev1beta1.VirtualMachineDefaultSpec{
+			RunStrategy: pointer.P(virtv1.RunStrategyRerunOnFailure),
+		}),
+		Entry("the masquerade binding", instancetypev1beta1.VirtualMachineDefaultSpec{
+			PodNetworkBinding: string(virtv1.MasqueradeInterface),
+		}),
+		Entry("a registered network binding plugin", instancetypev1beta1.VirtualMachineDefaultSpec{
+			PodNetworkBinding: "passt",
+		}),
+		Entry("an EFI bootloader", instancetypev1beta1.VirtualMachineDefaultSpec{
+			Firmware: &instancetypev1beta1.FirmwareDefaults{
+				Bootloader: &virtv1.Bootloader{EFI: &virtv1.EFI{}},
+			},
+		}),
+	)
+
+	DescribeTable("should reject", func(spec instancetypev1beta1.VirtualMachineDefaultSpec, expectedField string) {
+		response := admitter.Admit(context.Background(), createDefaultAdmissionReview(spec, apiinstancetype.PluralDefaultResourceName))
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Details.Causes).To(HaveLen(1))
+		Expect(response.Result.Details.Causes[0].Field).To(Equal(expectedField))
+
+		response = clusterAdmitter.Admit(context.Background(), createDefaultAdmissionReview(spec, apiinstancetype.ClusterPluralDefaultResourceName))
+		Expect(response.Allowed).To(BeFalse())
+	},
+		Entry("an invalid selector", instancetypev1beta1.VirtualMachineDefaultSpec{
+			Selector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "os", Operator: "Unknown"}},
+			},
+		}, "spec.selector"),
+		Entry("an unknown run strategy", instancetypev1beta1.VirtualMachineDefaultSpec{
+			RunStrategy: pointer.P(virtv1.VirtualMachineRunStrategy("Sometimes")),
+		}, "spec.runStrategy"),
+		Entry("an unregistered network binding plugin", instancetypev1beta1.VirtualMachineDefaultSpec{
+			PodNetworkBinding: "unknown",
+		}, "spec.podNetworkBinding"),
+		Entry("both BIOS and EFI bootloaders", instancetypev1beta1.VirtualMachineDefaultSpec{
+			Firmware: &instancetypev1beta1.FirmwareDefaults{
+				Bootloader: &virtv1.Bootloader{BIOS: &virtv1.BIOS{}, EFI: &virtv1.EFI{}},
+			},
+		}, "spec.firmware.bootloader"),
+	)
+
+	It("should allow delete", func() {
+		ar := createDefaultAdmissionReview(instancetypev1beta1.VirtualMachineDefaultSpec{}, apiinstancetype.PluralDefaultResourceName)
+		ar.Request.Operation = admissionv1.Delete
+		Expect(admitter.Admit(context.Background(), ar).Allowed).To(BeTrue())
+	})
+})
+
+func createDefaultAdmissionReview(spec instancetypev1beta1.VirtualMachineDefaultSpec, resource string) *admissionv1.AdmissionReview {
+	bytes, err := json.Marshal(&instancetypev1beta1.VirtualMachineDefault{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-name",
+		},
+		Spec: spec,
+	})
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Resource: metav1.GroupVersionResource{
+				Group:    instancetypev1beta1.SchemeGroupVersion.Group,
+				Version:  instancetypev1beta1.SchemeGroupVersion.Version,
+				Resource: resource,
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+}
@@ -86,6 +86,15 @@ func WithNUMAGuestMappingPassthrough() Option {
 	}
 }
 
+func WithNUMAGuestCells(cells ...v1.NUMAGuestCell) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		if vmi.Spec.Domain.CPU == nil {
+			vmi.Spec.Domain.CPU = &v1.CPU{}
+		}
+		vmi.Spec.Domain.CPU.NUMA = &v1.NUMA{GuestCells: cells}
+	}
+}
+
 func WithArchitecture(arch string) Option {
 	return func(vmi *v1.VirtualMachineInstance) {
 		vmi.Spec.Architecture = arch
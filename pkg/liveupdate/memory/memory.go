@@ -63,6 +63,12 @@ func ValidateLiveUpdateMemory(vmSpec *v1.VirtualMachineInstanceSpec, maxGuest *r
 		return fmt.Errorf("Memory hotplug is not compatible with guest mapping passthrough")
 	}
 
+	if domain.CPU != nil &&
+		domain.CPU.NUMA != nil &&
+		len(domain.CPU.NUMA.GuestCells) > 0 {
+		return fmt.Errorf("Memory hotplug is not compatible with guest NUMA cells")
+	}
+
 	if domain.LaunchSecurity != nil {
 		return fmt.Errorf("Memory hotplug is not compatible with encrypted VMs")
 	}
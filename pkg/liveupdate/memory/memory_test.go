@@ -64,6 +64,10 @@ var _ = Describe("LiveUpdate Memory", func() {
 					libvmi.WithHugepages("2Mi"),
 					libvmi.WithGuestMemory("1Gi"),
 				),
+				Entry("guest NUMA cells are configured", "4Gi",
+					libvmi.WithNUMAGuestCells(v1.NUMAGuestCell{ID: 0, CPUs: "0", Memory: resource.MustParse("1Gi")}),
+					libvmi.WithGuestMemory("1Gi"),
+				),
 				Entry("guest memory is not set", "4Gi"),
 				Entry("guest memory is greater than maxGuest", "2Gi",
 					libvmi.WithGuestMemory("4Gi"),
@@ -31,6 +31,8 @@ var (
 		activeConsoleConnections,
 		activeUSBRedirConnections,
 		vmiLastConnectionTimestamp,
+		activeSubresourceConnections,
+		rejectedSubresourceConnections,
 	}
 
 	namespaceAndVMILabels = []string{"namespace", "vmi"}
@@ -67,6 +69,22 @@ var (
 		namespaceAndVMILabels,
 	)
 
+	activeSubresourceConnections = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_subresource_active_connections",
+			Help: "Amount of active connections to the limited streaming subresources of virt-api, broken down by subresource.",
+		},
+		[]string{"subresource"},
+	)
+
+	rejectedSubresourceConnections = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_subresource_rejected_connections_total",
+			Help: "Total number of connections to the streaming subresources of virt-api rejected by their limits, broken down by subresource, namespace and the limit which was reached.",
+		},
+		[]string{"subresource", "namespace", "reason"},
+	)
+
 	vmiLastConnectionTimestamp = operatormetrics.NewGaugeVec(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_last_api_connection_timestamp_seconds",
@@ -115,3 +133,16 @@ func NewActiveUSBRedirConnection(namespace, name string) Decrementer {
 func SetVMILastConnectionTimestamp(namespace, name string) {
 	vmiLastConnectionTimestamp.WithLabelValues(namespace, name).Set(float64(time.Now().Unix()))
 }
+
+// NewActiveSubresourceConnection increments the metric for active connections to a limited subresource by one
+// and returns a recorder for decrementing it once the connection is closed
+func NewActiveSubresourceConnection(subresource string) Decrementer {
+	recorder := activeSubresourceConnections.WithLabelValues(subresource)
+	recorder.Inc()
+	return recorder
+}
+
+// IncRejectedSubresourceConnections increments the metric for connections to a subresource rejected by a limit
+func IncRejectedSubresourceConnections(subresource, namespace, reason string) {
+	rejectedSubresourceConnections.WithLabelValues(subresource, namespace, reason).Inc()
+}
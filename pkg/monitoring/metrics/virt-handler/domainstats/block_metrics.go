@@ -20,8 +20,12 @@
 package domainstats
 
 import (
+	"strconv"
+
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
 var (
@@ -80,6 +84,27 @@ var (
 			Help: "Total time spent on cache flushing.",
 		},
 	)
+
+	storageReadLatencySecondsBucket = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_storage_read_latency_seconds_bucket",
+			Help: "Cumulative number of read operations with a latency lower than or equal to the `le` label in seconds, since the histogram was enabled.",
+		},
+	)
+
+	storageWriteLatencySecondsBucket = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_storage_write_latency_seconds_bucket",
+			Help: "Cumulative number of write operations with a latency lower than or equal to the `le` label in seconds, since the histogram was enabled.",
+		},
+	)
+
+	storageFlushLatencySecondsBucket = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_storage_flush_latency_seconds_bucket",
+			Help: "Cumulative number of flush operations with a latency lower than or equal to the `le` label in seconds, since the histogram was enabled.",
+		},
+	)
 )
 
 type blockMetrics struct{}
@@ -94,13 +119,16 @@ func (blockMetrics) Describe() []operatormetrics.Metric {
 		storageWriteTimesSeconds,
 		storageFlushRequests,
 		storageFlushTimesSeconds,
+		storageReadLatencySecondsBucket,
+		storageWriteLatencySecondsBucket,
+		storageFlushLatencySecondsBucket,
 	}
 }
 
 func (blockMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operatormetrics.CollectorResult {
 	var crs []operatormetrics.CollectorResult
 
-	if vmiReport.vmiStats.DomainStats == nil || vmiReport.vmiStats.DomainStats.Block == nil {
+	if vmiReport.vmiStats.DomainStats == nil || vmiReport.vmiStats.DomainStats.Block == nil || !vmiReport.deviceMetricsEnabled() {
 		return crs
 	}
 
@@ -146,6 +174,36 @@ func (blockMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operatorm
 		if block.FlTimesSet {
 			crs = append(crs, vmiReport.newCollectorResultWithLabels(storageFlushTimesSeconds, nanosecondsToSeconds(block.FlTimes), blkLabels))
 		}
+
+		crs = append(crs, vmiReport.newLatencyHistogramResults(storageReadLatencySecondsBucket, block.RdLatencyHistogram, blkLabels)...)
+		crs = append(crs, vmiReport.newLatencyHistogramResults(storageWriteLatencySecondsBucket, block.WrLatencyHistogram, blkLabels)...)
+		crs = append(crs, vmiReport.newLatencyHistogramResults(storageFlushLatencySecondsBucket, block.FlLatencyHistogram, blkLabels)...)
+	}
+
+	return crs
+}
+
+// newLatencyHistogramResults converts the bins of a latency histogram collected by QEMU to the cumulative
+// buckets of a Prometheus histogram
+func (vmiReport *VirtualMachineInstanceReport) newLatencyHistogramResults(metric operatormetrics.Metric, histogram *stats.DomainStatsLatencyHistogram, blkLabels map[string]string) []operatormetrics.CollectorResult {
+	if histogram == nil || len(histogram.Bins) != len(histogram.Boundaries)+1 {
+		return nil
+	}
+
+	var crs []operatormetrics.CollectorResult
+	var count uint64
+	for i, bin := range histogram.Bins {
+		count += bin
+
+		le := "+Inf"
+		if i < len(histogram.Boundaries) {
+			le = strconv.FormatFloat(nanosecondsToSeconds(histogram.Boundaries[i]), 'g', -1, 64)
+		}
+		labels := map[string]string{"le": le}
+		for k, v := range blkLabels {
+			labels[k] = v
+		}
+		crs = append(crs, vmiReport.newCollectorResultWithLabels(metric, float64(count), labels))
 	}
 
 	return crs
@@ -89,4 +89,53 @@ var _ = Describe("block metrics", func() {
 			Expect(crs).To(BeEmpty())
 		})
 	})
+
+	Context("on Collect with latency histograms", func() {
+		var vmi *k6tv1.VirtualMachineInstance
+
+		vmiStats := &VirtualMachineInstanceStats{
+			DomainStats: &stats.DomainStats{
+				Block: []stats.DomainStatsBlock{
+					{
+						NameSet: true,
+						Name:    "vda",
+						Alias:   "rootdisk",
+						RdLatencyHistogram: &stats.DomainStatsLatencyHistogram{
+							Boundaries: []uint64{100000, 1000000000},
+							Bins:       []uint64{3, 2, 1},
+						},
+					},
+				},
+			},
+		}
+
+		BeforeEach(func() {
+			vmi = &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-vmi-1",
+					Namespace: "test-ns-1",
+				},
+			}
+		})
+
+		It("should collect the cumulative buckets of the histograms", func() {
+			crs := blockMetrics{}.Collect(newVirtualMachineInstanceReport(vmi, vmiStats))
+
+			buckets := map[string]float64{}
+			for _, cr := range crs {
+				if cr.Metric == storageReadLatencySecondsBucket {
+					Expect(cr.ConstLabels).To(HaveKeyWithValue("drive", "rootdisk"))
+					buckets[cr.ConstLabels["le"]] = cr.Value
+				}
+			}
+			Expect(buckets).To(Equal(map[string]float64{"0.0001": 3, "1": 5, "+Inf": 6}))
+			Expect(crs).ToNot(ContainElement(HaveField("Metric", storageWriteLatencySecondsBucket)))
+		})
+
+		It("should not collect the metrics of VMIs opting out from the device metrics", func() {
+			vmi.Annotations = map[string]string{k6tv1.DeviceMetricsDisabledAnnotation: "true"}
+			crs := blockMetrics{}.Collect(newVirtualMachineInstanceReport(vmi, vmiStats))
+			Expect(crs).To(BeEmpty())
+		})
+	})
 })
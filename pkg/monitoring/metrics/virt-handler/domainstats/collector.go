@@ -19,12 +19,16 @@
 package domainstats
 
 import (
+	"sync"
+	"time"
+
 	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	"k8s.io/client-go/tools/cache"
 	k6tv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler/collector"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 const (
@@ -48,6 +52,8 @@ var (
 	}
 
 	settings *collectorSettings
+
+	lastCollection = &collectionCache{}
 )
 
 type resourceMetrics interface {
@@ -60,14 +66,37 @@ type collectorSettings struct {
 	nodeName            string
 	maxRequestsInFlight int
 	vmiInformer         cache.SharedIndexInformer
+	clusterConfig       *virtconfig.ClusterConfig
+}
+
+// collectionCache keeps the results of the last collection of the domain stats, which are served to the
+// scrapes within the collection interval
+type collectionCache struct {
+	lock      sync.Mutex
+	timestamp time.Time
+	results   []operatormetrics.CollectorResult
 }
 
-func SetupDomainStatsCollector(virtShareDir, nodeName string, maxRequestsInFlight int, vmiInformer cache.SharedIndexInformer) {
+func (c *collectionCache) get(interval time.Duration, collect func() []operatormetrics.CollectorResult) []operatormetrics.CollectorResult {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if interval > 0 && time.Since(c.timestamp) < interval {
+		return c.results
+	}
+
+	c.results = collect()
+	c.timestamp = time.Now()
+	return c.results
+}
+
+func SetupDomainStatsCollector(virtShareDir, nodeName string, maxRequestsInFlight int, vmiInformer cache.SharedIndexInformer, clusterConfig *virtconfig.ClusterConfig) {
 	settings = &collectorSettings{
 		virtShareDir:        virtShareDir,
 		nodeName:            nodeName,
 		maxRequestsInFlight: maxRequestsInFlight,
 		vmiInformer:         vmiInformer,
+		clusterConfig:       clusterConfig,
 	}
 }
 
@@ -82,6 +111,15 @@ func domainStatsMetrics(rms ...resourceMetrics) []operatormetrics.Metric {
 }
 
 func domainStatsCollectorCallback() []operatormetrics.CollectorResult {
+	var interval time.Duration
+	if settings.clusterConfig != nil {
+		interval = settings.clusterConfig.GetMetricsCollectionInterval()
+	}
+
+	return lastCollection.get(interval, collectDomainStats)
+}
+
+func collectDomainStats() []operatormetrics.CollectorResult {
 	cachedObjs := settings.vmiInformer.GetIndexer().List()
 	if len(cachedObjs) == 0 {
 		log.Log.V(4).Infof("No VMIs detected")
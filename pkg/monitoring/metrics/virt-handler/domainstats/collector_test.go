@@ -26,6 +26,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k6tv1 "kubevirt.io/api/core/v1"
 
@@ -81,6 +82,41 @@ var _ = Describe("domain stats collector", func() {
 			Expect(crs).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(memoryResident, kibibytesToBytes(2))))
 		})
 	})
+
+	Context("collection interval", func() {
+		var (
+			cache       *collectionCache
+			collections int
+		)
+
+		collect := func() []operatormetrics.CollectorResult {
+			collections++
+			return []operatormetrics.CollectorResult{{Metric: memoryResident, Value: float64(collections)}}
+		}
+
+		BeforeEach(func() {
+			cache = &collectionCache{}
+			collections = 0
+		})
+
+		It("should collect on every scrape without interval", func() {
+			cache.get(0, collect)
+			Expect(cache.get(0, collect)).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(memoryResident, 2)))
+			Expect(collections).To(Equal(2))
+		})
+
+		It("should serve the scrapes within the interval from the last collection", func() {
+			cache.get(time.Hour, collect)
+			Expect(cache.get(time.Hour, collect)).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(memoryResident, 1)))
+			Expect(collections).To(Equal(1))
+		})
+
+		It("should collect again once the interval elapsed", func() {
+			cache.get(time.Hour, collect)
+			cache.timestamp = time.Now().Add(-time.Hour)
+			Expect(cache.get(time.Hour, collect)).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(memoryResident, 2)))
+		})
+	})
 })
 
 type fakeCollector struct {
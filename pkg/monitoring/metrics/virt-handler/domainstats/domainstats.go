@@ -70,6 +70,12 @@ func (vmiReport *VirtualMachineInstanceReport) buildRuntimeLabels() {
 	}
 }
 
+// deviceMetricsEnabled returns false when the vmi opts out from the per-disk, per-interface and
+// per-filesystem metrics
+func (vmiReport *VirtualMachineInstanceReport) deviceMetricsEnabled() bool {
+	return vmiReport.vmi.Annotations[k6tv1.DeviceMetricsDisabledAnnotation] != "true"
+}
+
 func (vmiReport *VirtualMachineInstanceReport) newCollectorResult(metric operatormetrics.Metric, value float64) operatormetrics.CollectorResult {
 	return vmiReport.newCollectorResultWithLabels(metric, value, nil)
 }
@@ -49,6 +49,10 @@ func (filesystemMetrics) Describe() []operatormetrics.Metric {
 func (filesystemMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operatormetrics.CollectorResult {
 	var crs []operatormetrics.CollectorResult
 
+	if !vmiReport.deviceMetricsEnabled() {
+		return crs
+	}
+
 	for _, fsStat := range vmiReport.vmiStats.FsStats.Items {
 		fsLabels := map[string]string{
 			"disk_name":        fsStat.DiskName,
@@ -92,6 +92,13 @@ var (
 		},
 	)
 
+	memoryUsedBytes = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_memory_used_bytes",
+			Help: "The amount of memory used by the guest, which it cannot reclaim without pushing the guest system to swap, corresponds to 'MemTotal' - 'MemAvailable' in /proc/meminfo.",
+		},
+	)
+
 	memoryDomainBytes = operatormetrics.NewGauge(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_memory_domain_bytes",
@@ -114,6 +121,7 @@ func (memoryMetrics) Describe() []operatormetrics.Metric {
 		memoryPgminfaultTotal,
 		memoryActualBallon,
 		memoryUsableBytes,
+		memoryUsedBytes,
 		memoryDomainBytes,
 	}
 }
@@ -167,6 +175,10 @@ func (memoryMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operator
 		crs = append(crs, vmiReport.newCollectorResult(memoryUsableBytes, kibibytesToBytes(mem.Usable)))
 	}
 
+	if mem.AvailableSet && mem.UsableSet && mem.Available >= mem.Usable {
+		crs = append(crs, vmiReport.newCollectorResult(memoryUsedBytes, kibibytesToBytes(mem.Available-mem.Usable)))
+	}
+
 	if mem.TotalSet {
 		crs = append(crs, vmiReport.newCollectorResult(memoryDomainBytes, kibibytesToBytes(mem.Total)))
 	}
@@ -88,6 +88,26 @@ var _ = Describe("memory metrics", func() {
 			Entry("kubevirt_vmi_memory_domain_bytes", memoryDomainBytes, kibibytesToBytes(11)),
 		)
 
+		It("should collect the memory used by the guest", func() {
+			vmiReport := newVirtualMachineInstanceReport(vmi, &VirtualMachineInstanceStats{
+				DomainStats: &stats.DomainStats{
+					Memory: &stats.DomainStatsMemory{
+						AvailableSet: true,
+						Available:    8,
+						UsableSet:    true,
+						Usable:       3,
+					},
+				},
+			})
+			crs := memoryMetrics{}.Collect(vmiReport)
+			Expect(crs).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(memoryUsedBytes, kibibytesToBytes(5))))
+		})
+
+		It("should not collect the memory used by the guest if the usable memory exceeds the available memory", func() {
+			crs := memoryMetrics{}.Collect(vmiReport)
+			Expect(crs).ToNot(ContainElement(HaveField("Metric", memoryUsedBytes)))
+		})
+
 		It("result should be empty if stat not populated or set is false", func() {
 			vmiStats.DomainStats.Memory = &stats.DomainStatsMemory{
 				RSSSet:        false,
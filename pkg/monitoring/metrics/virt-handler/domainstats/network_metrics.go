@@ -105,7 +105,7 @@ func (networkMetrics) Describe() []operatormetrics.Metric {
 func (networkMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operatormetrics.CollectorResult {
 	var crs []operatormetrics.CollectorResult
 
-	if vmiReport.vmiStats.DomainStats == nil || vmiReport.vmiStats.DomainStats.Net == nil {
+	if vmiReport.vmiStats.DomainStats == nil || vmiReport.vmiStats.DomainStats.Net == nil || !vmiReport.deviceMetricsEnabled() {
 		return crs
 	}
 
@@ -83,6 +83,32 @@ var _ = Describe("network metrics", func() {
 			Entry("kubevirt_vmi_network_transmit_packets_dropped_total", networkTransmitPacketsDropped, 8.0),
 		)
 
+		It("should label the packets and drops with the name of the vNIC", func() {
+			vmiReport := newVirtualMachineInstanceReport(vmi, &VirtualMachineInstanceStats{
+				DomainStats: &stats.DomainStats{
+					Net: []stats.DomainStatsNet{
+						{NameSet: true, Name: "tap0", AliasSet: true, Alias: "default", RxPktsSet: true, RxPkts: 3, RxDropSet: true, RxDrop: 1},
+						{NameSet: true, Name: "tap1", AliasSet: true, Alias: "secondary", RxPktsSet: true, RxPkts: 5, RxDropSet: true, RxDrop: 2},
+					},
+				},
+			})
+
+			crs := networkMetrics{}.Collect(vmiReport)
+			Expect(crs).To(ContainElements(
+				And(HaveField("Metric", networkReceivePackets), HaveField("Value", 3.0), HaveField("ConstLabels", HaveKeyWithValue("interface", "default"))),
+				And(HaveField("Metric", networkReceivePacketsDropped), HaveField("Value", 1.0), HaveField("ConstLabels", HaveKeyWithValue("interface", "default"))),
+				And(HaveField("Metric", networkReceivePackets), HaveField("Value", 5.0), HaveField("ConstLabels", HaveKeyWithValue("interface", "secondary"))),
+				And(HaveField("Metric", networkReceivePacketsDropped), HaveField("Value", 2.0), HaveField("ConstLabels", HaveKeyWithValue("interface", "secondary"))),
+			))
+		})
+
+		It("should not collect the metrics of VMIs opting out from the device metrics", func() {
+			vmi := vmi.DeepCopy()
+			vmi.Annotations = map[string]string{k6tv1.DeviceMetricsDisabledAnnotation: "true"}
+			crs := networkMetrics{}.Collect(newVirtualMachineInstanceReport(vmi, vmiStats))
+			Expect(crs).To(BeEmpty())
+		})
+
 		It("result should be empty if stat not populated or set is false", func() {
 			vmiStats.DomainStats.Net[0].NameSet = false
 			crs := networkMetrics{}.Collect(vmiReport)
@@ -0,0 +1,74 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package virt_handler
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+var (
+	ksmMetrics = []operatormetrics.Metric{
+		ksmPagesShared,
+		ksmPagesSharing,
+		ksmSavedMemory,
+		ksmMergePolicy,
+	}
+
+	ksmPagesShared = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_ksm_pages_shared",
+			Help: "The number of shared pages KSM keeps in use on the node.",
+		},
+		[]string{"node"},
+	)
+
+	ksmPagesSharing = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_ksm_pages_sharing",
+			Help: "The number of pages KSM merged into the shared pages on the node.",
+		},
+		[]string{"node"},
+	)
+
+	ksmSavedMemory = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_ksm_saved_memory_bytes",
+			Help: "The amount of memory KSM saves on the node by merging identical pages.",
+		},
+		[]string{"node"},
+	)
+
+	ksmMergePolicy = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_node_ksm_merge_policy",
+			Help: "The KSM merge policy virt-handler tunes KSM with on the node, based on the policies of the VMIs running on it.",
+		},
+		[]string{"node", "policy"},
+	)
+)
+
+// ReportKSMStats reports the pages KSM merged on the node and the policy it is tuned with
+func ReportKSMStats(nodeName, policy string, pagesShared, pagesSharing, pageSize uint64) {
+	ksmPagesShared.WithLabelValues(nodeName).Set(float64(pagesShared))
+	ksmPagesSharing.WithLabelValues(nodeName).Set(float64(pagesSharing))
+	ksmSavedMemory.WithLabelValues(nodeName).Set(float64(pagesSharing * pageSize))
+
+	ksmMergePolicy.Reset()
+	ksmMergePolicy.WithLabelValues(nodeName, policy).Set(1)
+}
@@ -0,0 +1,59 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package virt_handler
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ioprometheusclient "github.com/prometheus/client_model/go"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+var _ = Describe("KSM metrics", func() {
+	gaugeValue := func(gauge prometheus.Gauge) float64 {
+		dto := &ioprometheusclient.Metric{}
+		Expect(gauge.Write(dto)).To(Succeed())
+		return dto.GetGauge().GetValue()
+	}
+
+	Context("ReportKSMStats", func() {
+		BeforeEach(func() {
+			operatormetrics.UnregisterMetrics(ksmMetrics)
+			Expect(operatormetrics.RegisterMetrics(ksmMetrics)).To(Succeed())
+		})
+
+		It("should report the memory saved by KSM", func() {
+			ReportKSMStats("test-node", "Balanced", 100, 400, 4096)
+
+			Expect(gaugeValue(ksmPagesShared.WithLabelValues("test-node"))).To(Equal(float64(100)))
+			Expect(gaugeValue(ksmPagesSharing.WithLabelValues("test-node"))).To(Equal(float64(400)))
+			Expect(gaugeValue(ksmSavedMemory.WithLabelValues("test-node"))).To(Equal(float64(400 * 4096)))
+		})
+
+		It("should only report the current merge policy", func() {
+			ReportKSMStats("test-node", "Balanced", 0, 0, 4096)
+			ReportKSMStats("test-node", "Aggressive", 0, 0, 4096)
+
+			Expect(ksmMergePolicy.DeleteLabelValues("test-node", "Balanced")).To(BeFalse())
+			Expect(gaugeValue(ksmMergePolicy.WithLabelValues("test-node", "Aggressive"))).To(Equal(float64(1)))
+		})
+	})
+})
@@ -34,6 +34,8 @@ var (
 			migrateVMIDataProcessed,
 			migrateVmiDirtyMemoryRate,
 			migrateVmiMemoryTransferRate,
+			migrateVmiExpectedDowntime,
+			migrateVmiMemoryIterations,
 		},
 		CollectCallback: migrationStatsCollectorCallback,
 	}
@@ -72,6 +74,20 @@ var (
 			Help: "The rate at which the memory is being transferred.",
 		},
 	)
+
+	migrateVmiExpectedDowntime = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_expected_downtime_seconds",
+			Help: "The downtime the Guest OS is expected to suffer when switching over to the new VM.",
+		},
+	)
+
+	migrateVmiMemoryIterations = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_migration_memory_iterations_total",
+			Help: "The number of passes over the Guest OS memory during the migration.",
+		},
+	)
 )
 
 func SetupMigrationStatsCollector(vmiInformer cache.SharedIndexInformer) error {
@@ -120,6 +136,14 @@ func parse(r *result) []operatormetrics.CollectorResult {
 		crs = append(crs, newCR(r, migrateVmiMemoryTransferRate, float64(jobInfo.MemoryBps)))
 	}
 
+	if jobInfo.DowntimeSet {
+		crs = append(crs, newCR(r, migrateVmiExpectedDowntime, float64(jobInfo.Downtime)/1000))
+	}
+
+	if jobInfo.MemIterationSet {
+		crs = append(crs, newCR(r, migrateVmiMemoryIterations, float64(jobInfo.MemIteration)))
+	}
+
 	return crs
 }
 
@@ -98,9 +98,44 @@ var _ = Describe("Validating VMI network spec", func() {
 	},
 		Entry("down is not supported for sriov", v1.InterfaceStateLinkDown, MatchRegexp("down.+SR-IOV")),
 		Entry("up is not supported for sriov", v1.InterfaceStateLinkUp, MatchRegexp("up.+SR-IOV")),
-		Entry("absent is not supported when bridge-binding is not used", v1.InterfaceStateAbsent, MatchRegexp("absent.+bridge")),
 	)
 
+	It("network interface state value of absent is supported for SR-IOV", func() {
+		vm := libvmi.New(
+			libvmi.WithInterface(v1.Interface{
+				Name:                   "foo",
+				State:                  v1.InterfaceStateAbsent,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+			}),
+			libvmi.WithNetwork(&v1.Network{
+				Name:          "foo",
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net"}},
+			}),
+		)
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vm.Spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	It("network interface state value of absent is not supported when bridge or SR-IOV binding is not used", func() {
+		vm := libvmi.New(
+			libvmi.WithInterface(v1.Interface{
+				Name:                   "foo",
+				State:                  v1.InterfaceStateAbsent,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			}),
+			libvmi.WithNetwork(&v1.Network{
+				Name:          "foo",
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net"}},
+			}),
+		)
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vm.Spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ContainElement(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "\"foo\" interface's state \"absent\" is supported only for bridge and SR-IOV bindings",
+			Field:   "fake.domain.devices.interfaces[0].state",
+		}))
+	})
+
 	It("network interface state value of absent is not supported on the default network", func() {
 		vm := libvmi.New(
 			libvmi.WithNetwork(&v1.Network{
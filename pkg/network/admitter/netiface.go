@@ -113,6 +113,8 @@ func validateInterfacesFields(field *k8sfield.Path, spec *v1.VirtualMachineInsta
 		causes = append(causes, validatePciAddress(field, idx, iface)...)
 		causes = append(causes, validatePortConfiguration(field, idx, iface, networksByName[iface.Name])...)
 		causes = append(causes, validateDHCPOptions(field, idx, iface)...)
+		causes = append(causes, validateQueueSize(field, idx, iface, iface.RxQueueSize, "rxQueueSize")...)
+		causes = append(causes, validateQueueSize(field, idx, iface, iface.TxQueueSize, "txQueueSize")...)
 	}
 	return causes
 }
@@ -156,6 +158,46 @@ func validateInterfaceModel(field *k8sfield.Path, idx int, iface v1.Interface) [
 	return nil
 }
 
+const (
+	minQueueSize = 256
+	maxQueueSize = 1024
+)
+
+func validateQueueSize(field *k8sfield.Path, idx int, iface v1.Interface, queueSize *uint32, fieldName string) []metav1.StatusCause {
+	if queueSize == nil {
+		return nil
+	}
+	ifaceField := field.Child("domain", "devices", "interfaces").Index(idx)
+	if iface.Model != "" && iface.Model != v1.VirtIO {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf(
+				"interface %s sets %s which is only supported by the %s model.",
+				ifaceField.Child("name").String(),
+				fieldName,
+				v1.VirtIO,
+			),
+			Field: ifaceField.Child(fieldName).String(),
+		}}
+	}
+	size := *queueSize
+	if size < minQueueSize || size > maxQueueSize || size&(size-1) != 0 {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(
+				"interface %s has invalid %s %d, it must be a power of 2 between %d and %d.",
+				ifaceField.Child("name").String(),
+				fieldName,
+				size,
+				minQueueSize,
+				maxQueueSize,
+			),
+			Field: ifaceField.Child(fieldName).String(),
+		}}
+	}
+	return nil
+}
+
 func validateMacAddress(field *k8sfield.Path, idx int, iface v1.Interface) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if err := link.ValidateMacAddress(iface.MacAddress); err != nil {
@@ -232,6 +232,53 @@ var _ = Describe("Validating VMI network spec", func() {
 		Entry("valid address B", "0001:02:00.0"),
 	)
 
+	DescribeTable("should reject invalid queue sizes", func(model string, rxQueueSize uint32, expectedCause metav1.StatusCause) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Domain.Devices.Interfaces[0].Model = model
+		spec.Domain.Devices.Interfaces[0].RxQueueSize = &rxQueueSize
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(expectedCause))
+	},
+		Entry("below the minimum", v1.VirtIO, uint32(128), metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface fake.domain.devices.interfaces[0].name has invalid rxQueueSize 128, it must be a power of 2 between 256 and 1024.",
+			Field:   "fake.domain.devices.interfaces[0].rxQueueSize",
+		}),
+		Entry("above the maximum", v1.VirtIO, uint32(2048), metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface fake.domain.devices.interfaces[0].name has invalid rxQueueSize 2048, it must be a power of 2 between 256 and 1024.",
+			Field:   "fake.domain.devices.interfaces[0].rxQueueSize",
+		}),
+		Entry("not a power of 2", v1.VirtIO, uint32(384), metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface fake.domain.devices.interfaces[0].name has invalid rxQueueSize 384, it must be a power of 2 between 256 and 1024.",
+			Field:   "fake.domain.devices.interfaces[0].rxQueueSize",
+		}),
+		Entry("non virtio model", "e1000", uint32(512), metav1.StatusCause{
+			Type:    "FieldValueNotSupported",
+			Message: "interface fake.domain.devices.interfaces[0].name sets rxQueueSize which is only supported by the virtio model.",
+			Field:   "fake.domain.devices.interfaces[0].rxQueueSize",
+		}),
+	)
+
+	DescribeTable("should accept valid queue sizes", func(rxQueueSize, txQueueSize uint32) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Domain.Devices.Interfaces[0].RxQueueSize = &rxQueueSize
+		spec.Domain.Devices.Interfaces[0].TxQueueSize = &txQueueSize
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+	},
+		Entry("minimum sizes", uint32(256), uint32(256)),
+		Entry("maximum sizes", uint32(1024), uint32(1024)),
+		Entry("mixed sizes", uint32(1024), uint32(512)),
+	)
+
 	When("the interface port is specified", func() {
 		DescribeTable("should reject interface port with", func(ports []v1.Port, expectedCauses []metav1.StatusCause) {
 			spec := &v1.VirtualMachineInstanceSpec{}
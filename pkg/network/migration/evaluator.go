@@ -104,6 +104,12 @@ func shouldVMIBeMarkedForAutoMigration(
 			return pendingMigration
 		}
 
+		// SR-IOV devices can't be released from a running pod, they are unplugged
+		// by migrating to a pod which doesn't request them.
+		if iface.State == v1.InterfaceStateAbsent && ifaceStatusExists && iface.SRIOV != nil {
+			return immediateMigration
+		}
+
 		if iface.State == v1.InterfaceStateAbsent &&
 			ifaceStatusExists &&
 			vmispec.ContainsInfoSource(ifaceStatus.InfoSource, vmispec.InfoSourceMultusStatus) &&
@@ -164,6 +164,35 @@ var _ = Describe("Evaluator", func() {
 		Expect(migration.NewEvaluator().Evaluate(vmi)).To(Equal(k8scorev1.ConditionTrue))
 	})
 
+	It("Should require an immediate migration when a secondary iface using SR-IOV binding is hot-unplugged", func() {
+		vmi := libvmi.New(
+			libvmi.WithInterface(*v1.DefaultBridgeNetworkInterface()),
+			libvmi.WithInterface(v1.Interface{
+				Name: secondaryNetworkName,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{
+					SRIOV: &v1.InterfaceSRIOV{},
+				},
+				State: v1.InterfaceStateAbsent,
+			}),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			libvmi.WithNetwork(libvmi.MultusNetwork(secondaryNetworkName, nadName)),
+			libvmistatus.WithStatus(
+				libvmistatus.New(
+					libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{
+						Name:       "default",
+						InfoSource: vmispec.InfoSourceDomain,
+					}),
+					libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{
+						Name:       secondaryNetworkName,
+						InfoSource: multusAndDomainInfoSource,
+					}),
+				),
+			),
+		)
+
+		Expect(migration.NewEvaluator().Evaluate(vmi)).To(Equal(k8scorev1.ConditionTrue))
+	})
+
 	Context("Time based scenarios", func() {
 		lastTransitionTime := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
 
@@ -31,6 +31,8 @@ import (
 
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/precond"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
 )
 
 func NetAttachDefNamespacedName(namespace, fullNetworkName string) types.NamespacedName {
@@ -52,10 +54,15 @@ func NetAttachDefNamespacedName(namespace, fullNetworkName string) types.Namespa
 func NetworkToResource(virtClient kubecli.KubevirtClient, vmi *v1.VirtualMachineInstance) (map[string]string, error) {
 	networkToResourceMap := map[string]string{}
 
+	ifacesByName := vmispec.IndexInterfaceSpecByName(vmi.Spec.Domain.Devices.Interfaces)
 	for _, network := range vmi.Spec.Networks {
 		if network.Multus == nil {
 			continue
 		}
+		// Resources of hot-unplugged interfaces are not requested by new pods.
+		if iface, exists := ifacesByName[network.Name]; exists && iface.State == v1.InterfaceStateAbsent {
+			continue
+		}
 
 		nadNamespacedName := NetAttachDefNamespacedName(vmi.Namespace, network.Multus.NetworkName)
 		netAttachDef, err := virtClient.NetworkClient().
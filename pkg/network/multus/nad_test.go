@@ -22,12 +22,19 @@ package multus_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	fakenetworkclient "kubevirt.io/client-go/networkattachmentdefinitionclient/fake"
 
+	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/network/multus"
 )
 
@@ -44,3 +51,55 @@ var _ = Describe("NetAttachDefNamespacedName", func() {
 		Expect(nadNamespacedName).To(Equal(types.NamespacedName{Namespace: "otherns", Name: "testnet"}))
 	})
 })
+
+var _ = Describe("NetworkToResource", func() {
+	const (
+		sriovNetworkName = "sriov"
+		sriovNADName     = "sriov-nad"
+		sriovResource    = "intel.com/sriov"
+	)
+
+	var virtClient *kubecli.MockKubevirtClient
+
+	BeforeEach(func() {
+		networkClient := fakenetworkclient.NewSimpleClientset()
+		gvr := schema.GroupVersionResource{
+			Group:    "k8s.cni.cncf.io",
+			Version:  "v1",
+			Resource: "network-attachment-definitions",
+		}
+		nad := &networkv1.NetworkAttachmentDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        sriovNADName,
+				Namespace:   metav1.NamespaceDefault,
+				Annotations: map[string]string{multus.ResourceNameAnnotation: sriovResource},
+			},
+		}
+		Expect(networkClient.Tracker().Create(gvr, nad, metav1.NamespaceDefault)).To(Succeed())
+
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClient.EXPECT().NetworkClient().Return(networkClient).AnyTimes()
+	})
+
+	It("should map the network to the resource of its network attachment definition", func() {
+		vmi := libvmi.New(
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithSRIOVBinding(sriovNetworkName)),
+			libvmi.WithNetwork(libvmi.MultusNetwork(sriovNetworkName, sriovNADName)),
+		)
+
+		Expect(multus.NetworkToResource(virtClient, vmi)).To(Equal(map[string]string{sriovNetworkName: sriovResource}))
+	})
+
+	It("should not map networks of hot-unplugged interfaces", func() {
+		sriovIface := libvmi.InterfaceDeviceWithSRIOVBinding(sriovNetworkName)
+		sriovIface.State = v1.InterfaceStateAbsent
+		vmi := libvmi.New(
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithInterface(sriovIface),
+			libvmi.WithNetwork(libvmi.MultusNetwork(sriovNetworkName, sriovNADName)),
+		)
+
+		Expect(multus.NetworkToResource(virtClient, vmi)).To(BeEmpty())
+	})
+})
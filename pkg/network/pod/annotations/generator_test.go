@@ -726,6 +726,36 @@ var _ = Describe("Annotations Generator", func() {
 			Expect(annotations[networkv1.NetworkAttachmentAnnot]).To(MatchJSON(expectedMultusNetAttach))
 		})
 
+		It("Should not update network attachment annotation when a secondary SR-IOV interface is hot unplugged", func() {
+			sriovIface := libvmi.InterfaceDeviceWithSRIOVBinding(network1Name)
+			sriovIface.State = v1.InterfaceStateAbsent
+			vmi := libvmi.New(
+				libvmi.WithNamespace(testNamespace),
+				libvmi.WithInterface(*v1.DefaultBridgeNetworkInterface()),
+				libvmi.WithInterface(sriovIface),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+				libvmi.WithNetwork(libvmi.MultusNetwork(network1Name, networkAttachmentDefinitionName1)),
+				libvmistatus.WithStatus(
+					libvmistatus.New(
+						libvmistatus.WithInterfaceStatus(v1.VirtualMachineInstanceNetworkInterface{
+							Name:       network1Name,
+							InfoSource: vmispec.InfoSourceMultusStatus,
+						}),
+					),
+				),
+			)
+
+			podAnnotations := map[string]string{
+				networkv1.NetworkAttachmentAnnot: multusNetworksAnnotation,
+				networkv1.NetworkStatusAnnot:     multusNetworkStatusWithPrimaryAndSecondaryNets,
+			}
+
+			generator := annotations.NewGenerator(clusterConfig)
+			annotations := generator.GenerateFromActivePod(vmi, newStubVirtLauncherPod(vmi, podAnnotations))
+
+			Expect(annotations).ToNot(HaveKey(networkv1.NetworkAttachmentAnnot))
+		})
+
 		It("Should remove the Multus network attachment annotation when the last secondary interface is hot unplugged", func() {
 			vmi := libvmi.New(
 				libvmi.WithNamespace(testNamespace),
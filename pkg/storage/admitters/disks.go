@@ -25,6 +25,7 @@ import (
 	"regexp"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
@@ -56,6 +57,7 @@ func ValidateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 		causes = append(causes, validateSerialNumLength(field, idx, disk)...)
 		causes = append(causes, validateCacheMode(field, idx, disk)...)
 		causes = append(causes, validateIOMode(field, idx, disk)...)
+		causes = append(causes, ValidateDiskIOLimits(field.Index(idx).Child("ioLimits"), disk.IOLimits)...)
 		causes = append(causes, validateErrorPolicy(field, idx, disk)...)
 		// Verify disk and volume name can be a valid container name since disk
 		// name can become a container name which will fail to schedule if invalid
@@ -76,6 +78,78 @@ func ValidateContainerDisks(field *k8sfield.Path, spec *v1.VirtualMachineInstanc
 	return causes
 }
 
+// ValidateVhostUserBlkVolumes validates the vhost-user-blk volumes and the disks using them.
+// The block layer settings of such disks are up to the vhost-user-blk target.
+func ValidateVhostUserBlkVolumes(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, volume := range spec.Volumes {
+		if volume.VhostUserBlk == nil {
+			continue
+		}
+		volumeField := field.Child("volumes").Index(idx).Child("vhostUserBlk")
+		causes = append(causes, validateVhostUserBlkSource(volumeField, volume.VhostUserBlk)...)
+
+		for diskIdx, disk := range spec.Domain.Devices.Disks {
+			if disk.Name == volume.Name {
+				diskField := field.Child("domain", "devices", "disks").Index(diskIdx)
+				causes = append(causes, validateVhostUserBlkDisk(diskField, disk)...)
+			}
+		}
+	}
+	return causes
+}
+
+func validateVhostUserBlkSource(field *k8sfield.Path, source *v1.VhostUserBlkVolumeSource) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if (source.SocketName == "") == (source.ResourceName == "") {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must have exactly one of socketName and resourceName set", field.String()),
+			Field:   field.String(),
+		})
+	}
+	if source.SocketName != "" && (strings.Contains(source.SocketName, "/") || source.SocketName == "." || source.SocketName == "..") {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be the name of a socket in the vhost-user-blk socket directory of the node", field.Child("socketName").String()),
+			Field:   field.Child("socketName").String(),
+		})
+	}
+	return causes
+}
+
+func validateVhostUserBlkDisk(field *k8sfield.Path, disk v1.Disk) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if disk.Disk == nil || (disk.Disk.Bus != "" && disk.Disk.Bus != v1.DiskBusVirtio) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s must be a disk with the virtio bus to use a vhost-user-blk volume", field.String()),
+			Field:   field.String(),
+		})
+	}
+	settings := []struct {
+		name string
+		set  bool
+	}{
+		{"cache", disk.Cache != ""},
+		{"io", disk.IO != ""},
+		{"ioLimits", disk.IOLimits != nil},
+		{"errorPolicy", disk.ErrorPolicy != nil},
+		{"blockSize", disk.BlockSize != nil},
+		{"serial", disk.Serial != ""},
+	}
+	for _, setting := range settings {
+		if setting.set {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is not supported for disks using a vhost-user-blk volume", field.Child(setting.name).String()),
+				Field:   field.Child(setting.name).String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateDiskName(field *k8sfield.Path, idx int, disks []v1.Disk) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	for otherIdx, disk := range disks {
@@ -208,10 +282,19 @@ func validateBusSupport(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.St
 				Field:   field.Index(idx).Child("disk", "bus").String(),
 			})
 		}
+	case v1.DiskBusNVMe:
+		// the emulated NVMe controller only exposes namespaces
+		if diskType != "disk" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Bus type %s is only supported for disk devices", bus),
+				Field:   field.Index(idx).Child(diskType, "bus").String(),
+			})
+		}
 	case v1.DiskBusSCSI, v1.DiskBusUSB:
 		break
 	default:
-		supportedBuses := []v1.DiskBus{v1.DiskBusVirtio, v1.DiskBusSCSI, v1.DiskBusSATA, v1.DiskBusUSB}
+		supportedBuses := []v1.DiskBus{v1.DiskBusVirtio, v1.DiskBusSCSI, v1.DiskBusSATA, v1.DiskBusUSB, v1.DiskBusNVMe}
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
 			Message: fmt.Sprintf("%s is set with an unrecognized bus %s, must be one of: %v", field.Index(idx).String(), bus, supportedBuses),
@@ -268,16 +351,112 @@ func validateCacheMode(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.Sta
 
 func validateIOMode(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
 	var causes []metav1.StatusCause
-	if disk.IO != "" && disk.IO != v1.IONative && disk.IO != v1.IOThreads {
+	if disk.IO != "" && disk.IO != v1.IONative && disk.IO != v1.IOThreads && disk.IO != v1.IOUring {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueNotSupported,
-			Message: fmt.Sprintf("Disk IO mode for %s is not supported. Supported modes are: native, threads.", field),
+			Message: fmt.Sprintf("Disk IO mode for %s is not supported. Supported modes are: native, threads, io_uring.", field),
 			Field:   field.Child("domain", "devices", "disks").Index(idx).Child("io").String(),
 		})
 	}
 	return causes
 }
 
+// ValidateDiskIOLimits validates the IO limits of a disk, which are also set through the iolimits subresource
+func ValidateDiskIOLimits(field *k8sfield.Path, limits *v1.DiskIOLimits) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if limits == nil {
+		return causes
+	}
+	causes = append(causes, validateIOPSLimit(field.Child("readIOPS"), limits.ReadIOPS)...)
+	causes = append(causes, validateIOPSLimit(field.Child("writeIOPS"), limits.WriteIOPS)...)
+	causes = append(causes, validateBandwidthLimit(field.Child("readBandwidth"), limits.ReadBandwidth)...)
+	causes = append(causes, validateBandwidthLimit(field.Child("writeBandwidth"), limits.WriteBandwidth)...)
+
+	burst := limits.Burst
+	if burst == nil {
+		return causes
+	}
+	burstField := field.Child("burst")
+	causes = append(causes, validateIOPSBurst(burstField.Child("readIOPS"), burst.ReadIOPS, limits.ReadIOPS)...)
+	causes = append(causes, validateIOPSBurst(burstField.Child("writeIOPS"), burst.WriteIOPS, limits.WriteIOPS)...)
+	causes = append(causes, validateBandwidthBurst(burstField.Child("readBandwidth"), burst.ReadBandwidth, limits.ReadBandwidth)...)
+	causes = append(causes, validateBandwidthBurst(burstField.Child("writeBandwidth"), burst.WriteBandwidth, limits.WriteBandwidth)...)
+	if burst.LengthSeconds != nil && *burst.LengthSeconds <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than 0", burstField.Child("lengthSeconds").String()),
+			Field:   burstField.Child("lengthSeconds").String(),
+		})
+	}
+	return causes
+}
+
+func validateIOPSLimit(field *k8sfield.Path, limit *int64) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if limit != nil && *limit < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be negative", field.String()),
+			Field:   field.String(),
+		})
+	}
+	return causes
+}
+
+func validateBandwidthLimit(field *k8sfield.Path, limit *resource.Quantity) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if limit != nil && limit.Sign() < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be negative", field.String()),
+			Field:   field.String(),
+		})
+	}
+	return causes
+}
+
+func validateIOPSBurst(field *k8sfield.Path, burst, limit *int64) []metav1.StatusCause {
+	if burst == nil {
+		return nil
+	}
+	if limit == nil || *limit == 0 {
+		return []metav1.StatusCause{burstWithoutLimitCause(field)}
+	}
+	if *burst <= *limit {
+		return []metav1.StatusCause{burstNotGreaterCause(field)}
+	}
+	return nil
+}
+
+func validateBandwidthBurst(field *k8sfield.Path, burst, limit *resource.Quantity) []metav1.StatusCause {
+	if burst == nil {
+		return nil
+	}
+	if limit == nil || limit.IsZero() {
+		return []metav1.StatusCause{burstWithoutLimitCause(field)}
+	}
+	if burst.Cmp(*limit) <= 0 {
+		return []metav1.StatusCause{burstNotGreaterCause(field)}
+	}
+	return nil
+}
+
+func burstWithoutLimitCause(field *k8sfield.Path) metav1.StatusCause {
+	return metav1.StatusCause{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("%s requires the matching limit to be set", field.String()),
+		Field:   field.String(),
+	}
+}
+
+func burstNotGreaterCause(field *k8sfield.Path) metav1.StatusCause {
+	return metav1.StatusCause{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("%s must be greater than the matching limit", field.String()),
+		Field:   field.String(),
+	}
+}
+
 func validateErrorPolicy(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if disk.ErrorPolicy != nil && *disk.ErrorPolicy != v1.DiskErrorPolicyStop && *disk.ErrorPolicy != v1.DiskErrorPolicyIgnore && *disk.ErrorPolicy != v1.DiskErrorPolicyReport && *disk.ErrorPolicy != v1.DiskErrorPolicyEnospace {
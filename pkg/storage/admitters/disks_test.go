@@ -26,6 +26,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -416,6 +417,94 @@ var _ = Describe("Disk Validation", func() {
 			Entry("SATA bus", v1.DiskBusSATA),
 			Entry("SCSI bus", v1.DiskBusSCSI),
 			Entry("USB bus", v1.DiskBusUSB),
+			Entry("NVMe bus", v1.DiskBusNVMe),
+		)
+
+		It("should accept disks with NVMe bus", func() {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{Bus: v1.DiskBusNVMe},
+				},
+			})
+			causes := ValidateDisks(k8sfield.NewPath("disks"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject NVMe bus for", func(device v1.DiskDevice, field string) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name:       "testdisk",
+				DiskDevice: device,
+			})
+			causes := ValidateDisks(k8sfield.NewPath("disks"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(field))
+			Expect(causes[0].Message).To(Equal("Bus type nvme is only supported for disk devices"))
+		},
+			Entry("LUN devices", v1.DiskDevice{LUN: &v1.LunTarget{Bus: v1.DiskBusNVMe}}, "disks[0].lun.bus"),
+			Entry("CD-ROM devices", v1.DiskDevice{CDRom: &v1.CDRomTarget{Bus: v1.DiskBusNVMe}}, "disks[0].cdrom.bus"),
+		)
+
+		DescribeTable("It should accept a disk with a valid IO mode", func(mode v1.DriverIO) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", IO: mode, DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{}}})
+
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(BeEmpty())
+		},
+			Entry("native", v1.IONative),
+			Entry("threads", v1.IOThreads),
+			Entry("io_uring", v1.IOUring),
+		)
+
+		DescribeTable("It should accept a disk with valid IO limits", func(limits *v1.DiskIOLimits) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", IOLimits: limits, DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{}}})
+
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(BeEmpty())
+		},
+			Entry("without burst", &v1.DiskIOLimits{
+				ReadIOPS:       pointer.P(int64(1000)),
+				WriteBandwidth: pointer.P(resource.MustParse("100M")),
+			}),
+			Entry("with burst", &v1.DiskIOLimits{
+				ReadIOPS:       pointer.P(int64(1000)),
+				WriteBandwidth: pointer.P(resource.MustParse("100M")),
+				Burst: &v1.DiskIOBurst{
+					ReadIOPS:       pointer.P(int64(2000)),
+					WriteBandwidth: pointer.P(resource.MustParse("200M")),
+					LengthSeconds:  pointer.P(int64(60)),
+				},
+			}),
+		)
+
+		DescribeTable("It should reject a disk with invalid IO limits", func(limits *v1.DiskIOLimits, field, message string) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", IOLimits: limits, DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{}}})
+
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(field))
+			Expect(causes[0].Message).To(Equal(fmt.Sprintf("%s %s", field, message)))
+		},
+			Entry("with negative IOPS", &v1.DiskIOLimits{WriteIOPS: pointer.P(int64(-1))},
+				"fake[0].ioLimits.writeIOPS", "must not be negative"),
+			Entry("with negative bandwidth", &v1.DiskIOLimits{ReadBandwidth: pointer.P(resource.MustParse("-1M"))},
+				"fake[0].ioLimits.readBandwidth", "must not be negative"),
+			Entry("with burst without limit", &v1.DiskIOLimits{Burst: &v1.DiskIOBurst{ReadIOPS: pointer.P(int64(100))}},
+				"fake[0].ioLimits.burst.readIOPS", "requires the matching limit to be set"),
+			Entry("with burst not greater than the limit", &v1.DiskIOLimits{
+				WriteBandwidth: pointer.P(resource.MustParse("100M")),
+				Burst:          &v1.DiskIOBurst{WriteBandwidth: pointer.P(resource.MustParse("100M"))},
+			}, "fake[0].ioLimits.burst.writeBandwidth", "must be greater than the matching limit"),
+			Entry("with burst length of 0", &v1.DiskIOLimits{
+				ReadIOPS: pointer.P(int64(100)),
+				Burst:    &v1.DiskIOBurst{ReadIOPS: pointer.P(int64(200)), LengthSeconds: pointer.P(int64(0))},
+			}, "fake[0].ioLimits.burst.lengthSeconds", "must be greater than 0"),
 		)
 
 		Context("With block size", func() {
@@ -530,4 +619,47 @@ var _ = Describe("Disk Validation", func() {
 		})
 	})
 
+	Context("with ValidateVhostUserBlkVolumes", func() {
+		newSpec := func(disk v1.Disk, source *v1.VhostUserBlkVolumeSource) *v1.VirtualMachineInstanceSpec {
+			disk.Name = "spdk-disk"
+			return &v1.VirtualMachineInstanceSpec{
+				Domain: v1.DomainSpec{Devices: v1.Devices{Disks: []v1.Disk{disk}}},
+				Volumes: []v1.Volume{{
+					Name:         "spdk-disk",
+					VolumeSource: v1.VolumeSource{VhostUserBlk: source},
+				}},
+			}
+		}
+		virtioDisk := v1.Disk{DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}}
+
+		DescribeTable("should accept a virtio disk connected to", func(source *v1.VhostUserBlkVolumeSource) {
+			Expect(ValidateVhostUserBlkVolumes(k8sfield.NewPath("fake"), newSpec(virtioDisk, source))).To(BeEmpty())
+		},
+			Entry("a socket of the node", &v1.VhostUserBlkVolumeSource{SocketName: "vhost.0"}),
+			Entry("a socket of a device plugin", &v1.VhostUserBlkVolumeSource{ResourceName: "spdk.io/vhost-blk"}),
+		)
+
+		DescribeTable("should reject", func(disk v1.Disk, source *v1.VhostUserBlkVolumeSource, field string) {
+			causes := ValidateVhostUserBlkVolumes(k8sfield.NewPath("fake"), newSpec(disk, source))
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(field))
+		},
+			Entry("a volume without socket", virtioDisk, &v1.VhostUserBlkVolumeSource{}, "fake.volumes[0].vhostUserBlk"),
+			Entry("a volume with both a socket name and a resource",
+				virtioDisk, &v1.VhostUserBlkVolumeSource{SocketName: "vhost.0", ResourceName: "spdk.io/vhost-blk"}, "fake.volumes[0].vhostUserBlk"),
+			Entry("a socket path", virtioDisk, &v1.VhostUserBlkVolumeSource{SocketName: "../vhost.0"}, "fake.volumes[0].vhostUserBlk.socketName"),
+			Entry("a SATA disk",
+				v1.Disk{DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSATA}}},
+				&v1.VhostUserBlkVolumeSource{SocketName: "vhost.0"}, "fake.domain.devices.disks[0]"),
+			Entry("a CD-ROM",
+				v1.Disk{DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{}}},
+				&v1.VhostUserBlkVolumeSource{SocketName: "vhost.0"}, "fake.domain.devices.disks[0]"),
+			Entry("a disk with a cache mode",
+				v1.Disk{DiskDevice: virtioDisk.DiskDevice, Cache: v1.CacheNone},
+				&v1.VhostUserBlkVolumeSource{SocketName: "vhost.0"}, "fake.domain.devices.disks[0].cache"),
+			Entry("a disk with IO limits",
+				v1.Disk{DiskDevice: virtioDisk.DiskDevice, IOLimits: &v1.DiskIOLimits{ReadIOPS: pointer.P(int64(100))}},
+				&v1.VhostUserBlkVolumeSource{SocketName: "vhost.0"}, "fake.domain.devices.disks[0].ioLimits"),
+		)
+	})
 })
@@ -32,9 +32,9 @@ import (
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
-// AdmitHotplugStorage compares the old and new volumes and disks, and ensures that they match and are valid.
-func AdmitHotplugStorage(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks []v1.Disk, volumeStatuses []v1.VolumeStatus, newVMI *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig) *admissionv1.AdmissionResponse {
-	if err := validateExpectedDisksAndFilesystems(newVolumes, newDisks, newVMI.Spec.Domain.Devices.Filesystems, config); err != nil {
+// AdmitHotplugStorage compares the old and new volumes, disks and filesystems, and ensures that they match and are valid.
+func AdmitHotplugStorage(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks []v1.Disk, newFilesystems, oldFilesystems []v1.Filesystem, volumeStatuses []v1.VolumeStatus, newVMI *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig) *admissionv1.AdmissionResponse {
+	if err := validateExpectedDisksAndFilesystems(newVolumes, newDisks, newFilesystems, config); err != nil {
 		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 			{
 				Type:    metav1.CauseTypeFieldValueInvalid,
@@ -50,13 +50,15 @@ func AdmitHotplugStorage(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks
 
 	newDiskMap := getDiskMap(newDisks)
 	oldDiskMap := getDiskMap(oldDisks)
+	newFilesystemMap := getFilesystemMap(newFilesystems)
+	oldFilesystemMap := getFilesystemMap(oldFilesystems)
 
 	permanentAr := verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap, newDiskMap, oldDiskMap, migratedVolumeMap)
 	if permanentAr != nil {
 		return permanentAr
 	}
 
-	hotplugAr := verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap, newDiskMap, oldDiskMap, migratedVolumeMap)
+	hotplugAr := verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap, newDiskMap, oldDiskMap, newFilesystemMap, oldFilesystemMap, migratedVolumeMap)
 	if hotplugAr != nil {
 		return hotplugAr
 	}
@@ -107,6 +109,22 @@ func ValidateHotplugDiskConfiguration(disk *v1.Disk, name, messagePrefix, field
 		}}
 	}
 
+	if disk.Serial != "" && (!isValidExpression(disk.Serial) || len([]rune(disk.Serial)) > maxStrLen) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s for [%s] requires the serial to be made up of the following characters [A-Za-z0-9_.+-] and to be less than or equal to %d in length.", messagePrefix, name, maxStrLen),
+			Field:   field,
+		}}
+	}
+
+	if disk.Cache != "" && disk.Cache != v1.CacheNone && disk.Cache != v1.CacheWriteThrough && disk.Cache != v1.CacheWriteBack {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s for [%s] requires cache to be '%s', '%s' or '%s'. [%s] is not permitted.", messagePrefix, name, v1.CacheNone, v1.CacheWriteThrough, v1.CacheWriteBack, disk.Cache),
+			Field:   field,
+		}}
+	}
+
 	// Validate boot order
 	if disk.BootOrder != nil {
 		order := *disk.BootOrder
@@ -122,6 +140,25 @@ func ValidateHotplugDiskConfiguration(disk *v1.Disk, name, messagePrefix, field
 	return nil
 }
 
+// ValidateHotplugFilesystemConfiguration validates the virtiofs filesystem a volume is hotplugged as.
+func ValidateHotplugFilesystemConfiguration(disk *v1.Disk, fs *v1.Filesystem, name, messagePrefix, field string) []metav1.StatusCause {
+	if disk != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s for [%s] requires either the disk or the filesystem field to be set, not both.", messagePrefix, name),
+			Field:   field,
+		}}
+	}
+	if fs.Virtiofs == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s for filesystem [%s] requires virtiofs to be set.", messagePrefix, name),
+			Field:   field,
+		}}
+	}
+	return nil
+}
+
 func validateExpectedDisksAndFilesystems(volumes []v1.Volume, disks []v1.Disk, filesystems []v1.Filesystem, config *virtconfig.ClusterConfig) error {
 	names := make(map[string]struct{})
 	for _, volume := range volumes {
@@ -149,7 +186,7 @@ func validateExpectedDisksAndFilesystems(volumes []v1.Volume, disks []v1.Disk, f
 }
 
 func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1.Volume, newDisks, oldDisks map[string]v1.Disk,
-	migratedVols map[string]bool) *admissionv1.AdmissionResponse {
+	newFilesystems, oldFilesystems map[string]v1.Filesystem, migratedVols map[string]bool) *admissionv1.AdmissionResponse {
 	for k, v := range newHotplugVolumeMap {
 		if _, ok := oldHotplugVolumeMap[k]; ok {
 			_, okMigVol := migratedVols[k]
@@ -162,7 +199,16 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 					},
 				})
 			}
-			if v.MemoryDump == nil {
+			if fs, isFilesystem := newFilesystems[k]; isFilesystem {
+				if !equality.Semantic.DeepEqual(fs, oldFilesystems[k]) {
+					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+						{
+							Type:    metav1.CauseTypeFieldValueInvalid,
+							Message: fmt.Sprintf("hotplug filesystem %s, changed", k),
+						},
+					})
+				}
+			} else if v.MemoryDump == nil {
 				if _, ok := newDisks[k]; !ok {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 						{
@@ -171,7 +217,7 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 						},
 					})
 				}
-				if !equality.Semantic.DeepEqual(newDisks[k], oldDisks[k]) {
+				if isDiskChanged(newDisks[k], oldDisks[k]) {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 						{
 							Type:    metav1.CauseTypeFieldValueInvalid,
@@ -190,7 +236,16 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 					},
 				})
 			}
-			if v.MemoryDump == nil {
+			if fs, isFilesystem := newFilesystems[k]; isFilesystem {
+				if fs.Virtiofs == nil {
+					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+						{
+							Type:    metav1.CauseTypeFieldValueInvalid,
+							Message: fmt.Sprintf("hotplug filesystem %s requires virtiofs", k),
+						},
+					})
+				}
+			} else if v.MemoryDump == nil {
 				// Also ensure the matching new disk exists and has a valid bus
 				if _, ok := newDisks[k]; !ok {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
@@ -255,7 +310,7 @@ func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[str
 				},
 			})
 		}
-		if !equality.Semantic.DeepEqual(newDisks[k], oldDisks[k]) {
+		if isDiskChanged(newDisks[k], oldDisks[k]) {
 			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 				{
 					Type:    metav1.CauseTypeFieldValueInvalid,
@@ -267,6 +322,13 @@ func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[str
 	return nil
 }
 
+// isDiskChanged ignores the IO limits, which are updated on running VMIs through the iolimits subresource
+func isDiskChanged(newDisk, oldDisk v1.Disk) bool {
+	newDisk.IOLimits = nil
+	oldDisk.IOLimits = nil
+	return !equality.Semantic.DeepEqual(newDisk, oldDisk)
+}
+
 func getDiskMap(disks []v1.Disk) map[string]v1.Disk {
 	newDiskMap := make(map[string]v1.Disk, 0)
 	for _, disk := range disks {
@@ -277,6 +339,14 @@ func getDiskMap(disks []v1.Disk) map[string]v1.Disk {
 	return newDiskMap
 }
 
+func getFilesystemMap(filesystems []v1.Filesystem) map[string]v1.Filesystem {
+	filesystemMap := make(map[string]v1.Filesystem, len(filesystems))
+	for _, fs := range filesystems {
+		filesystemMap[fs.Name] = fs
+	}
+	return filesystemMap
+}
+
 func getHotplugVolumes(volumes []v1.Volume, volumeStatuses []v1.VolumeStatus) map[string]v1.Volume {
 	permanentVolumesFromStatus := make(map[string]v1.Volume, 0)
 	for _, volume := range volumeStatuses {
@@ -278,6 +278,23 @@ var _ = Describe("Storage Hotplug Admitter", func() {
 		return res
 	}
 
+	makeDisksWithSerialAndCache := func(serial string, cache v1.DriverCache, indexes ...int) []v1.Disk {
+		res := makeDisksWithBus(v1.DiskBusVirtio, indexes...)
+		if len(res) > 0 {
+			res[len(res)-1].Serial = serial
+			res[len(res)-1].Cache = cache
+		}
+		return res
+	}
+
+	makeDisksWithIOLimits := func(indexes ...int) []v1.Disk {
+		res := makeDisks(indexes...)
+		for i := range res {
+			res[i].IOLimits = &v1.DiskIOLimits{ReadIOPS: pointer.P(int64(100))}
+		}
+		return res
+	}
+
 	makeDisksInvalidBootOrder := func(indexes ...int) []v1.Disk {
 		res := makeDisks(indexes...)
 		if len(res) > 0 {
@@ -337,7 +354,7 @@ var _ = Describe("Storage Hotplug Admitter", func() {
 		for _, featureGate := range featureGates {
 			enableFeatureGate(featureGate)
 		}
-		result := AdmitHotplugStorage(newVolumes, oldVolumes, newDisks, oldDisks, volumeStatuses, newVMI, config)
+		result := AdmitHotplugStorage(newVolumes, oldVolumes, newDisks, oldDisks, filesystems, filesystems, volumeStatuses, newVMI, config)
 		Expect(equality.Semantic.DeepEqual(result, expected)).To(BeTrue(), "result: %v and expected: %v do not match", result, expected)
 	}
 
@@ -382,6 +399,22 @@ var _ = Describe("Storage Hotplug Admitter", func() {
 			makeFilesystems(),
 			makeStatus(1, 0),
 			makeExpected("permanent disk volume-name-0, changed", "")),
+		Entry("Should accept if the IO limits of a permanent disk changed",
+			makeVolumes(0),
+			makeVolumes(0),
+			makeDisksWithIOLimits(0),
+			makeDisks(0),
+			makeFilesystems(),
+			makeStatus(1, 0),
+			nil),
+		Entry("Should accept if the IO limits of a hotplug disk changed",
+			makeVolumes(0, 1),
+			makeVolumes(0, 1),
+			makeDisksWithIOLimits(0, 1),
+			makeDisks(0, 1),
+			makeFilesystems(),
+			makeStatus(2, 1),
+			nil),
 		Entry("Should reject if a hotplug volume changed",
 			makeInvalidVolumes(2, 1),
 			makeVolumes(0, 1),
@@ -430,6 +463,30 @@ var _ = Describe("Storage Hotplug Admitter", func() {
 			makeFilesystems(),
 			makeStatus(1, 0),
 			nil),
+		Entry("Should accept if we hotplug a volume with serial and cache mode",
+			makeVolumes(0, 1),
+			makeVolumes(0),
+			makeDisksWithSerialAndCache("serial-1", v1.CacheWriteBack, 0, 1),
+			makeDisksWithBus(v1.DiskBusVirtio, 0),
+			makeFilesystems(),
+			makeStatus(1, 0),
+			nil),
+		Entry("Should reject if we hotplug a volume with an invalid serial",
+			makeVolumes(0, 1),
+			makeVolumes(0),
+			makeDisksWithSerialAndCache("serial/1", "", 0, 1),
+			makeDisksWithBus(v1.DiskBusVirtio, 0),
+			makeFilesystems(),
+			makeStatus(1, 0),
+			makeExpected("Hotplug configuration for [volume-name-1] requires the serial to be made up of the following characters [A-Za-z0-9_.+-] and to be less than or equal to 256 in length.", "")),
+		Entry("Should reject if we hotplug a volume with an invalid cache mode",
+			makeVolumes(0, 1),
+			makeVolumes(0),
+			makeDisksWithSerialAndCache("", "unsafe", 0, 1),
+			makeDisksWithBus(v1.DiskBusVirtio, 0),
+			makeFilesystems(),
+			makeStatus(1, 0),
+			makeExpected("Hotplug configuration for [volume-name-1] requires cache to be 'none', 'writethrough' or 'writeback'. [unsafe] is not permitted.", "")),
 		Entry("Should accept if we add LUN disk with valid SCSI bus",
 			makeVolumes(0, 1),
 			makeVolumes(0, 1),
@@ -536,7 +593,30 @@ var _ = Describe("Storage Hotplug Admitter", func() {
 				makeFilesystems(1),
 				makeStatus(2, 0),
 				makeExpected("mismatch between volumes declared (3) and required (2)", "")),
+			Entry("Should accept a hotplugged volume used by a filesystem",
+				makeVolumes(0, 1),
+				makeVolumes(0),
+				makeDisks(0),
+				makeDisks(0),
+				makeFilesystems(1),
+				makeStatus(2, 1),
+				nil),
 		)
+
+		It("Should reject if a hotplugged filesystem changes", func() {
+			volumes := makeVolumes(0, 1)
+			disks := makeDisks(0)
+			oldFilesystems := makeFilesystems(1)
+			newFilesystems := makeFilesystems(1)
+			newFilesystems[0].Virtiofs.Cache = v1.VirtiofsCacheNever
+			newVMI := api.NewMinimalVMI("testvmi")
+			newVMI.Spec.Volumes = volumes
+			newVMI.Spec.Domain.Devices.Disks = disks
+			newVMI.Spec.Domain.Devices.Filesystems = newFilesystems
+
+			result := AdmitHotplugStorage(volumes, volumes, disks, disks, newFilesystems, oldFilesystems, makeStatus(2, 1), newVMI, config)
+			Expect(result).To(Equal(makeExpected("hotplug filesystem volume-name-1, changed", "")))
+		})
 	})
 
 	DescribeTable("should allow change for a persistent volume if it is a migrated volume", func(hotpluggable bool) {
@@ -602,7 +682,7 @@ var _ = Describe("Storage Hotplug Admitter", func() {
 				DestinationPVCInfo: &v1.PersistentVolumeClaimInfo{ClaimName: "pvc1"},
 			},
 		}
-		Expect(AdmitHotplugStorage(newVols, oldVols, disks, disks, volumeStatuses, vmi, config)).To(BeNil())
+		Expect(AdmitHotplugStorage(newVols, oldVols, disks, disks, nil, nil, volumeStatuses, vmi, config)).To(BeNil())
 	},
 		Entry("and not hotpluggable", false),
 		Entry("and hotpluggable", true),
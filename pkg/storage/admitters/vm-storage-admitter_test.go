@@ -398,6 +398,19 @@ var _ = Describe("Validating VM Admitter", func() {
 				vm.Spec.Template.Spec.Volumes[0].VolumeSource = v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "fake"}}
 				return false
 			}),
+			Entry("accept adding a memory dump volume", func(vm *v1.VirtualMachine) bool {
+				vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, v1.Volume{
+					Name: "memory",
+					VolumeSource: v1.VolumeSource{MemoryDump: &v1.MemoryDumpVolumeSource{
+						PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "memory"},
+							Hotpluggable:                      true,
+						},
+						Format: v1.MemoryDumpFormatState,
+					}},
+				})
+				return true
+			}),
 			Entry("accept update to spec, that is not volumes or running state", func(vm *v1.VirtualMachine) bool {
 				vm.Spec.Template.Spec.Affinity = &k8sv1.Affinity{}
 				return true
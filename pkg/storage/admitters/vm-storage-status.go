@@ -71,7 +71,8 @@ func (a *Admitter) validateSnapshotStatus() []metav1.StatusCause {
 		}}
 	}
 
-	if !compareVolumes(oldVM.Spec.Template.Spec.Volumes, a.vm.Spec.Template.Spec.Volumes) {
+	// The memory dump volume of a snapshot including the memory is added and removed while it is in progress
+	if !compareVolumes(withoutMemoryDumpVolumes(oldVM.Spec.Template.Spec.Volumes), withoutMemoryDumpVolumes(a.vm.Spec.Template.Spec.Volumes)) {
 		return []metav1.StatusCause{{
 			Type:    metav1.CauseTypeFieldValueNotSupported,
 			Message: fmt.Sprintf("Cannot update vm disks or volumes until snapshot %q completes", *a.vm.Status.SnapshotInProgress),
@@ -103,6 +104,16 @@ func compareVolumes(old, new []v1.Volume) bool {
 	return true
 }
 
+func withoutMemoryDumpVolumes(volumes []v1.Volume) []v1.Volume {
+	var filtered []v1.Volume
+	for _, volume := range volumes {
+		if volume.MemoryDump == nil {
+			filtered = append(filtered, volume)
+		}
+	}
+	return filtered
+}
+
 func compareRunningSpec(old, new *v1.VirtualMachineSpec) bool {
 	if old == nil || new == nil {
 		// This should never happen, but just in case return false
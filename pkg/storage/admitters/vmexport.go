@@ -99,6 +99,18 @@ func (admitter *VMExportAdmitter) Admit(_ context.Context, ar *admissionv1.Admis
 			}
 		}
 
+		if vmExport.Spec.Destination != nil {
+			specField := k8sfield.NewPath("spec")
+			causes = append(causes, validateObjectStorageDestination(specField.Child("destination"), vmExport.Spec.Destination)...)
+			if len(vmExport.Spec.Formats) > 0 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueNotSupported,
+					Message: "formats are not supported when pushing the volumes to a destination",
+					Field:   specField.Child("formats").String(),
+				})
+			}
+		}
+
 	case admissionv1.Update:
 		prevObj := &exportv1.VirtualMachineExport{}
 		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
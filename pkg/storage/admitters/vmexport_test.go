@@ -257,6 +257,51 @@ var _ = Describe("Validating VirtualMachineExport Admitter", func() {
 			Entry("virtual machine snapshot", "invalid", vmSnapshotKind),
 			Entry("virtual machine", "invalid", vmKind),
 		)
+
+		DescribeTable("it should validate the destination", func(mutate func(*exportv1.VirtualMachineExportSpec), expectedField string) {
+			export := &exportv1.VirtualMachineExport{
+				Spec: exportv1.VirtualMachineExportSpec{
+					Source: corev1.TypedLocalObjectReference{
+						Kind: pvc,
+						Name: "test",
+					},
+					Destination: &exportv1.ObjectStorageDestination{
+						Provider:             exportv1.ObjectStorageS3,
+						Endpoint:             "https://s3.example.com",
+						Bucket:               "exports",
+						CredentialsSecretRef: "s3-credentials",
+					},
+				},
+			}
+			mutate(&export.Spec)
+
+			ar := createExportAdmissionReview(export)
+			resp := createTestVMExportAdmitter(config).Admit(context.Background(), ar)
+			if expectedField == "" {
+				Expect(resp.Allowed).To(BeTrue())
+				return
+			}
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("valid destination", func(*exportv1.VirtualMachineExportSpec) {}, ""),
+			Entry("unsupported provider", func(spec *exportv1.VirtualMachineExportSpec) {
+				spec.Destination.Provider = "FTP"
+			}, "spec.destination.provider"),
+			Entry("invalid endpoint", func(spec *exportv1.VirtualMachineExportSpec) {
+				spec.Destination.Endpoint = "s3.example.com"
+			}, "spec.destination.endpoint"),
+			Entry("missing bucket", func(spec *exportv1.VirtualMachineExportSpec) {
+				spec.Destination.Bucket = ""
+			}, "spec.destination.bucket"),
+			Entry("missing credentials", func(spec *exportv1.VirtualMachineExportSpec) {
+				spec.Destination.CredentialsSecretRef = ""
+			}, "spec.destination.credentialsSecretRef"),
+			Entry("formats", func(spec *exportv1.VirtualMachineExportSpec) {
+				spec.Formats = []exportv1.ExportVolumeFormat{exportv1.Qcow2}
+			}, "spec.formats"),
+		)
 	})
 })
 
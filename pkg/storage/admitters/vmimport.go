@@ -0,0 +1,222 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	importv1 "kubevirt.io/api/vmimport/v1beta1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMImportAdmitter validates VirtualMachineImports
+type VMImportAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMImportAdmitter creates a VMImportAdmitter
+func NewVMImportAdmitter(config *virtconfig.ClusterConfig) *VMImportAdmitter {
+	return &VMImportAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMImportAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != importv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachineimports" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.VMExportEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("vm export feature gate not enabled"))
+	}
+
+	vmImport := &importv1.VirtualMachineImport{}
+	err := json.Unmarshal(ar.Request.Object.Raw, vmImport)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case admissionv1.Create:
+		specField := k8sfield.NewPath("spec")
+		causes = append(causes, validateImportSource(specField.Child("source"), &vmImport.Spec.Source)...)
+		if vmImport.Spec.VirtualMachineName != "" {
+			if errs := validation.IsDNS1123Subdomain(vmImport.Spec.VirtualMachineName); len(errs) > 0 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("virtual machine name is invalid: %s", strings.Join(errs, ", ")),
+					Field:   specField.Child("virtualMachineName").String(),
+				})
+			}
+		}
+		causes = append(causes, validateImportNetworkMappings(specField.Child("networkMappings"), vmImport.Spec.NetworkMappings)...)
+		causes = append(causes, validateImportDiskMappings(specField.Child("diskMappings"), vmImport.Spec.DiskMappings)...)
+	case admissionv1.Update:
+		prevObj := &importv1.VirtualMachineImport{}
+		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !equality.Semantic.DeepEqual(prevObj.Spec, vmImport.Spec) {
+			causes = []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "spec in immutable after creation",
+					Field:   k8sfield.NewPath("spec").String(),
+				},
+			}
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func validateImportSource(field *k8sfield.Path, source *importv1.VirtualMachineImportSource) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	switch source.Format {
+	case importv1.ImportFormatOVA, importv1.ImportFormatOVF, importv1.ImportFormatLibvirt:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("format %q is not supported", source.Format),
+			Field:   field.Child("format").String(),
+		})
+	}
+
+	if (source.HTTP == nil) == (source.Upload == nil) {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "exactly one of http and upload must be set",
+			Field:   field.String(),
+		})
+	}
+
+	if source.HTTP != nil && source.HTTP.URL == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "url must not be empty",
+			Field:   field.Child("http", "url").String(),
+		})
+	}
+
+	if source.Upload != nil {
+		if source.Format == importv1.ImportFormatOVA {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "OVA bundles cannot be uploaded, upload the OVF descriptor instead",
+				Field:   field.Child("upload").String(),
+			})
+		}
+		if source.Upload.ConfigMapName == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "ConfigMap name must not be empty",
+				Field:   field.Child("upload", "configMapName").String(),
+			})
+		}
+		if source.Upload.Key == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "key must not be empty",
+				Field:   field.Child("upload", "key").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validateImportNetworkMappings(field *k8sfield.Path, mappings []importv1.ImportNetworkMapping) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	sources := map[string]struct{}{}
+	for i, mapping := range mappings {
+		if _, exists := sources[mapping.Source]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("network %q is mapped more than once", mapping.Source),
+				Field:   field.Index(i).Child("source").String(),
+			})
+		}
+		sources[mapping.Source] = struct{}{}
+
+		if (mapping.Pod == nil) == (mapping.MultusNetworkName == "") {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "exactly one of pod and multusNetworkName must be set",
+				Field:   field.Index(i).String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validateImportDiskMappings(field *k8sfield.Path, mappings []importv1.ImportDiskMapping) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	sources := map[string]struct{}{}
+	for i, mapping := range mappings {
+		if _, exists := sources[mapping.Source]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("disk %q is mapped more than once", mapping.Source),
+				Field:   field.Index(i).Child("source").String(),
+			})
+		}
+		sources[mapping.Source] = struct{}{}
+
+		if mapping.Size != nil && mapping.Size.Sign() <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "size must be positive",
+				Field:   field.Index(i).Child("size").String(),
+			})
+		}
+	}
+
+	return causes
+}
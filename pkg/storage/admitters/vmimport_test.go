@@ -0,0 +1,201 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	importv1 "kubevirt.io/api/vmimport/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Validating VirtualMachineImport Admitter", func() {
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newImport := func() *importv1.VirtualMachineImport {
+		return &importv1.VirtualMachineImport{
+			Spec: importv1.VirtualMachineImportSpec{
+				Source: importv1.VirtualMachineImportSource{
+					Format: importv1.ImportFormatOVA,
+					HTTP: &importv1.ImportSourceHTTP{
+						URL: "https://images.example.com/vm.ova",
+					},
+				},
+				VirtualMachineName: "imported",
+				NetworkMappings: []importv1.ImportNetworkMapping{
+					{Source: "VM Network", Pod: &importv1.ImportPodNetwork{}},
+					{Source: "storage", MultusNetworkName: "storage-net"},
+				},
+			},
+		}
+	}
+
+	Context("With feature gate disabled", func() {
+		It("should reject anything", func() {
+			ar := createImportAdmissionReview(newImport())
+			resp := NewVMImportAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("vm export feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{"VMExport"},
+						},
+					},
+				},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{})
+		})
+
+		It("should accept a valid import", func() {
+			ar := createImportAdmissionReview(newImport())
+			resp := NewVMImportAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should accept an uploaded libvirt domain", func() {
+			vmImport := newImport()
+			vmImport.Spec.Source = importv1.VirtualMachineImportSource{
+				Format: importv1.ImportFormatLibvirt,
+				Upload: &importv1.ImportSourceUpload{
+					ConfigMapName: "domain",
+					Key:           "domain.xml",
+				},
+			}
+
+			ar := createImportAdmissionReview(vmImport)
+			resp := NewVMImportAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject an invalid import", func(mutate func(*importv1.VirtualMachineImport), field string) {
+			vmImport := newImport()
+			mutate(vmImport)
+
+			ar := createImportAdmissionReview(vmImport)
+			resp := NewVMImportAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("with unsupported format", func(i *importv1.VirtualMachineImport) {
+				i.Spec.Source.Format = "vmx"
+			}, "spec.source.format"),
+			Entry("without source", func(i *importv1.VirtualMachineImport) {
+				i.Spec.Source.HTTP = nil
+			}, "spec.source"),
+			Entry("with both http and upload", func(i *importv1.VirtualMachineImport) {
+				i.Spec.Source.Upload = &importv1.ImportSourceUpload{ConfigMapName: "domain", Key: "domain.xml"}
+			}, "spec.source"),
+			Entry("without url", func(i *importv1.VirtualMachineImport) {
+				i.Spec.Source.HTTP.URL = ""
+			}, "spec.source.http.url"),
+			Entry("with uploaded OVA", func(i *importv1.VirtualMachineImport) {
+				i.Spec.Source.HTTP = nil
+				i.Spec.Source.Upload = &importv1.ImportSourceUpload{ConfigMapName: "vm", Key: "vm.ova"}
+			}, "spec.source.upload"),
+			Entry("without ConfigMap name", func(i *importv1.VirtualMachineImport) {
+				i.Spec.Source.Format = importv1.ImportFormatOVF
+				i.Spec.Source.HTTP = nil
+				i.Spec.Source.Upload = &importv1.ImportSourceUpload{Key: "vm.ovf"}
+			}, "spec.source.upload.configMapName"),
+			Entry("without ConfigMap key", func(i *importv1.VirtualMachineImport) {
+				i.Spec.Source.Format = importv1.ImportFormatOVF
+				i.Spec.Source.HTTP = nil
+				i.Spec.Source.Upload = &importv1.ImportSourceUpload{ConfigMapName: "vm"}
+			}, "spec.source.upload.key"),
+			Entry("with invalid virtual machine name", func(i *importv1.VirtualMachineImport) {
+				i.Spec.VirtualMachineName = "Imported_VM"
+			}, "spec.virtualMachineName"),
+			Entry("with network mapping without target", func(i *importv1.VirtualMachineImport) {
+				i.Spec.NetworkMappings[1].MultusNetworkName = ""
+			}, "spec.networkMappings[1]"),
+			Entry("with network mapping with both targets", func(i *importv1.VirtualMachineImport) {
+				i.Spec.NetworkMappings[0].MultusNetworkName = "other"
+			}, "spec.networkMappings[0]"),
+			Entry("with network mapped twice", func(i *importv1.VirtualMachineImport) {
+				i.Spec.NetworkMappings[1].Source = "VM Network"
+			}, "spec.networkMappings[1].source"),
+			Entry("with disk mapped twice", func(i *importv1.VirtualMachineImport) {
+				i.Spec.DiskMappings = []importv1.ImportDiskMapping{{Source: "vmdisk1"}, {Source: "vmdisk1"}}
+			}, "spec.diskMappings[1].source"),
+			Entry("with non positive disk size", func(i *importv1.VirtualMachineImport) {
+				size := resource.MustParse("0")
+				i.Spec.DiskMappings = []importv1.ImportDiskMapping{{Source: "vmdisk1", Size: &size}}
+			}, "spec.diskMappings[0].size"),
+		)
+
+		It("should reject spec update", func() {
+			oldImport := newImport()
+			newImport := newImport()
+			newImport.Spec.VirtualMachineName = "other"
+
+			oldBytes, _ := json.Marshal(oldImport)
+			newBytes, _ := json.Marshal(newImport)
+			ar := createImportAdmissionReview(newImport)
+			ar.Request.Operation = admissionv1.Update
+			ar.Request.Object = runtime.RawExtension{Raw: newBytes}
+			ar.Request.OldObject = runtime.RawExtension{Raw: oldBytes}
+
+			resp := NewVMImportAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+	})
+})
+
+func createImportAdmissionReview(vmImport *importv1.VirtualMachineImport) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(vmImport)
+
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "import.kubevirt.io",
+				Resource: "virtualmachineimports",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"strings"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -33,28 +34,33 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	"kubevirt.io/api/core"
-
+	v1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 
-	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
+// VMSpecValidator validates the spec of a VirtualMachine
+type VMSpecValidator func(field *k8sfield.Path, spec *v1.VirtualMachineSpec) []metav1.StatusCause
+
 // VMRestoreAdmitter validates VirtualMachineRestores
 type VMRestoreAdmitter struct {
 	Config            *virtconfig.ClusterConfig
 	Client            kubecli.KubevirtClient
 	VMRestoreInformer cache.SharedIndexInformer
+	ValidateVMSpec    VMSpecValidator
 }
 
 // NewVMRestoreAdmitter creates a VMRestoreAdmitter
-func NewVMRestoreAdmitter(config *virtconfig.ClusterConfig, client kubecli.KubevirtClient, vmRestoreInformer cache.SharedIndexInformer) *VMRestoreAdmitter {
+func NewVMRestoreAdmitter(config *virtconfig.ClusterConfig, client kubecli.KubevirtClient, vmRestoreInformer cache.SharedIndexInformer, validateVMSpec VMSpecValidator) *VMRestoreAdmitter {
 	return &VMRestoreAdmitter{
 		Config:            config,
 		Client:            client,
 		VMRestoreInformer: vmRestoreInformer,
+		ValidateVMSpec:    validateVMSpec,
 	}
 }
 
@@ -109,6 +115,15 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 					if newCauses != nil {
 						causes = append(causes, newCauses...)
 					}
+
+					causes = append(causes, validateRestoreRunStrategy(vmRestore)...)
+					causes = append(causes, validateTargetReadinessPolicy(vmRestore)...)
+
+					newCauses, err = admitter.validateVolumeSelection(ctx, vmRestore)
+					if err != nil {
+						return webhookutils.ToAdmissionResponseError(err)
+					}
+					causes = append(causes, newCauses...)
 				default:
 					causes = []metav1.StatusCause{
 						{
@@ -129,6 +144,11 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 			}
 		}
 
+		// A dry run restore never modifies the target, so it neither blocks nor is blocked by other restores
+		if isDryRunRestore(vmRestore) {
+			break
+		}
+
 		objects, err := admitter.VMRestoreInformer.GetIndexer().ByIndex(cache.NamespaceIndex, ar.Request.Namespace)
 		if err != nil {
 			return webhookutils.ToAdmissionResponseError(err)
@@ -136,6 +156,9 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 
 		for _, obj := range objects {
 			r := obj.(*snapshotv1.VirtualMachineRestore)
+			if isDryRunRestore(r) {
+				continue
+			}
 			if equality.Semantic.DeepEqual(r.Spec.Target, vmRestore.Spec.Target) &&
 				(r.Status == nil || r.Status.Complete == nil || !*r.Status.Complete) {
 				cause := metav1.StatusCause{
@@ -191,35 +214,28 @@ func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *
 		return nil, err
 	}
 
-	target, err := admitter.Client.VirtualMachine(namespace).Get(ctx, targetName, metav1.GetOptions{})
-	if err != nil && !errors.IsNotFound(err) {
-		return nil, err
+	if vmSnapshot.Spec.Source.Kind == poolv1.VirtualMachinePoolKind {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("VirtualMachineSnapshot %s is a snapshot of a VirtualMachinePool, restore the snapshots of its members instead", vmSnapshot.Name),
+			Field:   field.Child("virtualMachineSnapshotName").String(),
+		}), nil
 	}
 
-	sourceTargetVmsAreDifferent := errors.IsNotFound(err) || (vmSnapshot.Status.SourceUID != nil && target.UID != *vmSnapshot.Status.SourceUID)
-	if sourceTargetVmsAreDifferent {
-		contentName := vmSnapshot.Status.VirtualMachineSnapshotContentName
-		if contentName == nil {
-			return nil, fmt.Errorf("snapshot content name is nil in vmSnapshot status")
-		}
-
-		vmSnapshotContent, err := admitter.Client.VirtualMachineSnapshotContent(namespace).Get(ctx, *contentName, metav1.GetOptions{})
+	if len(causes) == 0 && len(vmRestore.Spec.Patches) > 0 {
+		patchCauses, err := admitter.validatePatchedVM(ctx, field.Child("patches"), vmSnapshot, vmRestore.Spec.Patches)
 		if err != nil {
 			return nil, err
 		}
+		causes = append(causes, patchCauses...)
+	}
 
-		snapshotVM := vmSnapshotContent.Spec.Source.VirtualMachine
-		if snapshotVM == nil {
-			return nil, fmt.Errorf("unexpected snapshot source")
-		}
-
-		if backendstorage.IsBackendStorageNeededForVMI(&snapshotVM.Spec.Template.Spec) {
-			causes = append(causes, metav1.StatusCause{
-				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: "Restore to a different VM not supported when using backend storage",
-				Field:   field.String(),
-			})
-		}
+	if vmRestore.Spec.TargetReset != nil && *vmRestore.Spec.TargetReset && targetName == vmSnapshot.Spec.Source.Name {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "TargetReset is only supported when restoring to a VirtualMachine other than the snapshot source",
+			Field:   field.Child("targetReset").String(),
+		})
 	}
 
 	return causes, nil
@@ -262,6 +278,70 @@ func (admitter *VMRestoreAdmitter) validatePatches(patches []string, field *k8sf
 	return causes
 }
 
+// validatePatchedVM applies the patches to the VM stored in the snapshot content
+// and validates the result, so that restores failing because of their patches
+// are rejected before they start
+func (admitter *VMRestoreAdmitter) validatePatchedVM(ctx context.Context, field *k8sfield.Path, vmSnapshot *snapshotv1.VirtualMachineSnapshot, patches []string) ([]metav1.StatusCause, error) {
+	// The patches can't be validated until the snapshot content is created
+	if vmSnapshot.Status == nil || vmSnapshot.Status.VirtualMachineSnapshotContentName == nil {
+		return nil, nil
+	}
+
+	vmSnapshotContent, err := admitter.Client.VirtualMachineSnapshotContent(vmSnapshot.Namespace).Get(ctx, *vmSnapshot.Status.VirtualMachineSnapshotContentName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	snapshotVM := vmSnapshotContent.Spec.Source.VirtualMachine
+	if snapshotVM == nil {
+		return nil, nil
+	}
+
+	patchedVM, err := applyRestorePatches(snapshotVM, patches)
+	if err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("patches cannot be applied to the VirtualMachine in VirtualMachineSnapshotContent %s: %v", vmSnapshotContent.Name, err),
+			Field:   field.String(),
+		}}, nil
+	}
+
+	if admitter.ValidateVMSpec == nil {
+		return nil, nil
+	}
+
+	return admitter.ValidateVMSpec(field, &patchedVM.Spec), nil
+}
+
+// applyRestorePatches applies the patches the same way the restore controller
+// does when creating the target VM
+func applyRestorePatches(snapshotVM *snapshotv1.VirtualMachine, patches []string) (*v1.VirtualMachine, error) {
+	marshalledVM, err := json.Marshal(snapshotVM)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := jsonpatch.DecodePatch([]byte("[\n" + strings.Join(patches, ",\n") + "\n]"))
+	if err != nil {
+		return nil, err
+	}
+
+	patchedVM, err := patch.Apply(marshalledVM)
+	if err != nil {
+		return nil, err
+	}
+
+	vm := &v1.VirtualMachine{}
+	if err := json.Unmarshal(patchedVM, vm); err != nil {
+		return nil, err
+	}
+
+	return vm, nil
+}
+
 func (admitter *VMRestoreAdmitter) validateVolumeOverrides(ctx context.Context, vmRestore *snapshotv1.VirtualMachineRestore) (causes []metav1.StatusCause) {
 	// Cancel if there's no volume override
 	if vmRestore.Spec.VolumeRestoreOverrides == nil {
@@ -318,3 +398,109 @@ func (admitter *VMRestoreAdmitter) validateVolumeRestorePolicy(ctx context.Conte
 
 	return causes
 }
+
+func validateRestoreRunStrategy(vmRestore *snapshotv1.VirtualMachineRestore) []metav1.StatusCause {
+	if vmRestore.Spec.RunStrategy == nil {
+		return nil
+	}
+
+	if vmRestore.Spec.StartVirtualMachine != nil && *vmRestore.Spec.StartVirtualMachine {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "run strategy cannot be combined with startVirtualMachine",
+			Field:   k8sfield.NewPath("spec", "runStrategy").String(),
+		}}
+	}
+
+	switch *vmRestore.Spec.RunStrategy {
+	case v1.RunStrategyHalted, v1.RunStrategyManual, v1.RunStrategyAlways, v1.RunStrategyRerunOnFailure, v1.RunStrategyOnce:
+		return nil
+	default:
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("run strategy \"%s\" is not supported", *vmRestore.Spec.RunStrategy),
+			Field:   k8sfield.NewPath("spec", "runStrategy").String(),
+		}}
+	}
+}
+
+func validateTargetReadinessPolicy(vmRestore *snapshotv1.VirtualMachineRestore) []metav1.StatusCause {
+	if vmRestore.Spec.TargetReadinessPolicy == nil {
+		return nil
+	}
+
+	switch *vmRestore.Spec.TargetReadinessPolicy {
+	case snapshotv1.VirtualMachineRestoreWaitGracePeriodAndFail,
+		snapshotv1.VirtualMachineRestoreStopTarget,
+		snapshotv1.VirtualMachineRestoreFailImmediate,
+		snapshotv1.VirtualMachineRestoreWaitEventually:
+		return nil
+	default:
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("target readiness policy \"%s\" is not supported", *vmRestore.Spec.TargetReadinessPolicy),
+			Field:   k8sfield.NewPath("spec", "targetReadinessPolicy").String(),
+		}}
+	}
+}
+
+func (admitter *VMRestoreAdmitter) validateVolumeSelection(ctx context.Context, vmRestore *snapshotv1.VirtualMachineRestore) ([]metav1.StatusCause, error) {
+	if len(vmRestore.Spec.Volumes) == 0 {
+		return nil, nil
+	}
+
+	field := k8sfield.NewPath("spec", "volumes")
+	namespace := vmRestore.Namespace
+
+	_, err := admitter.Client.VirtualMachine(namespace).Get(ctx, vmRestore.Spec.Target.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "restoring a subset of volumes requires an existing target",
+			Field:   field.String(),
+		}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	vmSnapshot, err := admitter.Client.VirtualMachineSnapshot(namespace).Get(ctx, vmRestore.Spec.VirtualMachineSnapshotName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// The volumes can't be validated until the snapshot content is created
+	if vmSnapshot.Status == nil || vmSnapshot.Status.VirtualMachineSnapshotContentName == nil {
+		return nil, nil
+	}
+
+	vmSnapshotContent, err := admitter.Client.VirtualMachineSnapshotContent(namespace).Get(ctx, *vmSnapshot.Status.VirtualMachineSnapshotContentName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	backups := make(map[string]struct{}, len(vmSnapshotContent.Spec.VolumeBackups))
+	for _, vb := range vmSnapshotContent.Spec.VolumeBackups {
+		backups[vb.VolumeName] = struct{}{}
+	}
+
+	var causes []metav1.StatusCause
+	for i, volumeName := range vmRestore.Spec.Volumes {
+		if _, ok := backups[volumeName]; !ok {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s is not part of VirtualMachineSnapshotContent %s", volumeName, vmSnapshotContent.Name),
+				Field:   field.Index(i).String(),
+			})
+		}
+	}
+
+	return causes, nil
+}
+
+func isDryRunRestore(vmRestore *snapshotv1.VirtualMachineRestore) bool {
+	return vmRestore.Spec.DryRun != nil && *vmRestore.Spec.DryRun
+}
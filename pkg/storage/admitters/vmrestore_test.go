@@ -35,8 +35,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/pool"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
@@ -403,6 +406,68 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.target"))
 			})
 
+			It("should accept dry run while another restore is in progress", func() {
+				restore := &snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "restore-dry-run",
+						Namespace: "default",
+					},
+					Spec: snapshotv1.VirtualMachineRestoreSpec{
+						Target: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						VirtualMachineSnapshotName: vmSnapshotName,
+						DryRun:                     pointer.P(true),
+					},
+				}
+
+				restoreInProcess := &snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "restore-in-process",
+						Namespace: "default",
+					},
+					Spec: snapshotv1.VirtualMachineRestoreSpec{
+						Target: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						VirtualMachineSnapshotName: vmSnapshotName,
+					},
+				}
+
+				ar := createRestoreAdmissionReview(restore)
+				resp := createTestVMRestoreAdmitter(config, vm, snapshot, restoreInProcess).Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(BeTrue())
+			})
+
+			It("should not consider a dry run restore as in progress", func() {
+				restore := &snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "restore",
+						Namespace: "default",
+					},
+					Spec: snapshotv1.VirtualMachineRestoreSpec{
+						Target: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						VirtualMachineSnapshotName: vmSnapshotName,
+					},
+				}
+
+				dryRunInProcess := restore.DeepCopy()
+				dryRunInProcess.Name = "restore-dry-run"
+				dryRunInProcess.Spec.DryRun = pointer.P(true)
+
+				ar := createRestoreAdmissionReview(restore)
+				resp := createTestVMRestoreAdmitter(config, vm, snapshot, dryRunInProcess).Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(BeTrue())
+			})
+
 			It("should accept when VM is not running", func() {
 				restore := &snapshotv1.VirtualMachineRestore{
 					ObjectMeta: metav1.ObjectMeta{
@@ -503,7 +568,167 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.volumeRestorePolicy"))
 			})
 
-			DescribeTable("Should reject restore when using backend storage and restoring to different VM", func(doesTargetExist bool) {
+			It("should reject invalid run strategy", func() {
+				restore := &snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "restore",
+						Namespace: "default",
+					},
+					Spec: snapshotv1.VirtualMachineRestoreSpec{
+						Target: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						VirtualMachineSnapshotName: vmSnapshotName,
+						RunStrategy:                pointer.P(v1.VirtualMachineRunStrategy("invalid")),
+					},
+				}
+
+				ar := createRestoreAdmissionReview(restore)
+				resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
+
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.runStrategy"))
+			})
+
+			It("should reject run strategy combined with startVirtualMachine", func() {
+				restore := &snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "restore",
+						Namespace: "default",
+					},
+					Spec: snapshotv1.VirtualMachineRestoreSpec{
+						Target: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						VirtualMachineSnapshotName: vmSnapshotName,
+						RunStrategy:                pointer.P(v1.RunStrategyAlways),
+						StartVirtualMachine:        pointer.P(true),
+					},
+				}
+
+				ar := createRestoreAdmissionReview(restore)
+				resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
+
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.runStrategy"))
+			})
+
+			DescribeTable("should accept target readiness policy", func(policy snapshotv1.TargetReadinessPolicy) {
+				restore := &snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "restore",
+						Namespace: "default",
+					},
+					Spec: snapshotv1.VirtualMachineRestoreSpec{
+						Target: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						VirtualMachineSnapshotName: vmSnapshotName,
+						TargetReadinessPolicy:      pointer.P(policy),
+					},
+				}
+
+				ar := createRestoreAdmissionReview(restore)
+				resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
+
+				Expect(resp.Allowed).To(BeTrue())
+			},
+				Entry("WaitGracePeriod", snapshotv1.VirtualMachineRestoreWaitGracePeriodAndFail),
+				Entry("StopTarget", snapshotv1.VirtualMachineRestoreStopTarget),
+				Entry("FailImmediate", snapshotv1.VirtualMachineRestoreFailImmediate),
+				Entry("WaitEventually", snapshotv1.VirtualMachineRestoreWaitEventually),
+			)
+
+			It("should reject invalid target readiness policy", func() {
+				restore := &snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "restore",
+						Namespace: "default",
+					},
+					Spec: snapshotv1.VirtualMachineRestoreSpec{
+						Target: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						VirtualMachineSnapshotName: vmSnapshotName,
+						TargetReadinessPolicy:      pointer.P(snapshotv1.TargetReadinessPolicy("invalid")),
+					},
+				}
+
+				ar := createRestoreAdmissionReview(restore)
+				resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
+
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Type).To(Equal(metav1.CauseTypeFieldValueNotSupported))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.targetReadinessPolicy"))
+			})
+
+			It("should reject targetReset when restoring to the snapshot source", func() {
+				restore := &snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "restore",
+						Namespace: "default",
+					},
+					Spec: snapshotv1.VirtualMachineRestoreSpec{
+						Target: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						VirtualMachineSnapshotName: vmSnapshotName,
+						TargetReset:                pointer.P(true),
+					},
+				}
+
+				ar := createRestoreAdmissionReview(restore)
+				resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
+
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.targetReset"))
+			})
+
+			It("should reject restoring a snapshot of a VirtualMachinePool", func() {
+				poolSnapshot := snapshot.DeepCopy()
+				poolSnapshot.Spec.Source = corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(pool.GroupName),
+					Kind:     poolv1.VirtualMachinePoolKind,
+					Name:     "pool",
+				}
+				restore := &snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "restore",
+						Namespace: "default",
+					},
+					Spec: snapshotv1.VirtualMachineRestoreSpec{
+						Target: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						VirtualMachineSnapshotName: vmSnapshotName,
+					},
+				}
+
+				ar := createRestoreAdmissionReview(restore)
+				resp := createTestVMRestoreAdmitter(config, vm, poolSnapshot).Admit(context.Background(), ar)
+
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.virtualMachineSnapshotName"))
+			})
+
+			DescribeTable("Should allow restore when using backend storage and restoring to different VM", func(doesTargetExist bool) {
 				const targetVMName = "new-test-vm"
 				targetVM := &v1.VirtualMachine{}
 
@@ -561,15 +786,89 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				ar := createRestoreAdmissionReview(restore)
 				resp := createTestVMRestoreAdmitter(config, snapshot, vmSnapshotContent, targetVM).Admit(context.Background(), ar)
 
-				Expect(resp.Allowed).To(BeFalse())
-				Expect(resp.Result.Details.Causes).To(HaveLen(1))
-				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
-				Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("Restore to a different VM not supported when using backend storage"))
+				Expect(resp.Allowed).To(BeTrue())
 			},
 				Entry("target doesn't exist", false),
 				Entry("target exists", true),
 			)
 
+			Context("when restoring a subset of volumes", func() {
+				var (
+					restore           *snapshotv1.VirtualMachineRestore
+					vmSnapshot        *snapshotv1.VirtualMachineSnapshot
+					vmSnapshotContent *snapshotv1.VirtualMachineSnapshotContent
+				)
+
+				BeforeEach(func() {
+					vmSnapshotContent = &snapshotv1.VirtualMachineSnapshotContent{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "snapshot-content",
+							Namespace: "default",
+						},
+						Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+							Source: snapshotv1.SourceSpec{
+								VirtualMachine: &snapshotv1.VirtualMachine{
+									ObjectMeta: vm.ObjectMeta,
+									Spec: v1.VirtualMachineSpec{
+										Template: &v1.VirtualMachineInstanceTemplateSpec{},
+									},
+								},
+							},
+							VolumeBackups: []snapshotv1.VolumeBackup{
+								{VolumeName: "rootdisk"},
+								{VolumeName: "datadisk"},
+							},
+						},
+					}
+
+					vmSnapshot = snapshot.DeepCopy()
+					vmSnapshot.Status.VirtualMachineSnapshotContentName = pointer.P(vmSnapshotContent.Name)
+
+					restore = &snapshotv1.VirtualMachineRestore{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "restore",
+							Namespace: "default",
+						},
+						Spec: snapshotv1.VirtualMachineRestoreSpec{
+							Target: corev1.TypedLocalObjectReference{
+								APIGroup: &apiGroup,
+								Kind:     "VirtualMachine",
+								Name:     vmName,
+							},
+							VirtualMachineSnapshotName: vmSnapshotName,
+						},
+					}
+				})
+
+				It("should accept volumes which are part of the snapshot", func() {
+					restore.Spec.Volumes = []string{"datadisk"}
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, vmSnapshot, vmSnapshotContent).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeTrue())
+				})
+
+				It("should reject volumes which are not part of the snapshot", func() {
+					restore.Spec.Volumes = []string{"datadisk", "nodisk"}
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, vmSnapshot, vmSnapshotContent).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.volumes[1]"))
+				})
+
+				It("should reject when the target does not exist", func() {
+					restore.Spec.Volumes = []string{"datadisk"}
+					restore.Spec.Target.Name = "new-vm"
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, vmSnapshot, vmSnapshotContent).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(ContainElement(HaveField("Field", "spec.volumes")))
+				})
+			})
+
 			Context("when using Patches", func() {
 
 				var restore *snapshotv1.VirtualMachineRestore
@@ -636,6 +935,80 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 					Expect(resp.Result.Details.Causes).To(HaveLen(1))
 					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.patches"))
 				})
+
+				Context("with a snapshot content", func() {
+					var (
+						vmSnapshot        *snapshotv1.VirtualMachineSnapshot
+						vmSnapshotContent *snapshotv1.VirtualMachineSnapshotContent
+					)
+
+					BeforeEach(func() {
+						vmSnapshotContent = &snapshotv1.VirtualMachineSnapshotContent{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "snapshot-content",
+								Namespace: "default",
+							},
+							Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+								Source: snapshotv1.SourceSpec{
+									VirtualMachine: &snapshotv1.VirtualMachine{
+										ObjectMeta: vm.ObjectMeta,
+										Spec: v1.VirtualMachineSpec{
+											RunStrategy: pointer.P(v1.RunStrategyHalted),
+											Template:    &v1.VirtualMachineInstanceTemplateSpec{},
+										},
+									},
+								},
+							},
+						}
+
+						vmSnapshot = snapshot.DeepCopy()
+						vmSnapshot.Status.VirtualMachineSnapshotContentName = pointer.P(vmSnapshotContent.Name)
+					})
+
+					It("should validate the patched VM spec", func() {
+						restore.Spec.Patches = []string{`{"op": "replace", "path": "/spec/runStrategy", "value": "Always"}`}
+
+						admitter := createTestVMRestoreAdmitter(config, vm, vmSnapshot, vmSnapshotContent)
+						admitter.ValidateVMSpec = func(field *k8sfield.Path, spec *v1.VirtualMachineSpec) []metav1.StatusCause {
+							Expect(spec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyAlways)))
+							return []metav1.StatusCause{{
+								Type:    metav1.CauseTypeFieldValueInvalid,
+								Message: "invalid run strategy",
+								Field:   field.Child("runStrategy").String(),
+							}}
+						}
+
+						ar := createRestoreAdmissionReview(restore)
+						resp := admitter.Admit(context.Background(), ar)
+						Expect(resp.Allowed).To(BeFalse())
+						Expect(resp.Result.Details.Causes).To(HaveLen(1))
+						Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.patches.runStrategy"))
+					})
+
+					It("should accept patches resulting in a valid VM spec", func() {
+						restore.Spec.Patches = []string{`{"op": "replace", "path": "/spec/runStrategy", "value": "Always"}`}
+
+						admitter := createTestVMRestoreAdmitter(config, vm, vmSnapshot, vmSnapshotContent)
+						admitter.ValidateVMSpec = func(*k8sfield.Path, *v1.VirtualMachineSpec) []metav1.StatusCause {
+							return nil
+						}
+
+						ar := createRestoreAdmissionReview(restore)
+						resp := admitter.Admit(context.Background(), ar)
+						Expect(resp.Allowed).To(BeTrue())
+					})
+
+					It("should reject patches which do not apply to the snapshotted VM", func() {
+						restore.Spec.Patches = []string{`{"op": "replace", "path": "/spec/template/spec/domain/devices/interfaces/0/macAddress", "value": "some-value"}`}
+
+						ar := createRestoreAdmissionReview(restore)
+						resp := createTestVMRestoreAdmitter(config, vm, vmSnapshot, vmSnapshotContent).Admit(context.Background(), ar)
+						Expect(resp.Allowed).To(BeFalse())
+						Expect(resp.Result.Details.Causes).To(HaveLen(1))
+						Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.patches"))
+						Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("cannot be applied"))
+					})
+				})
 			})
 
 		})
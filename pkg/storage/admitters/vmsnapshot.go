@@ -26,14 +26,19 @@ import (
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	"kubevirt.io/api/core"
+	"kubevirt.io/api/pool"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
 
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -71,6 +76,7 @@ func (admitter *VMSnapshotAdmitter) Admit(ctx context.Context, ar *admissionv1.A
 	}
 
 	var causes []metav1.StatusCause
+	var warnings []string
 
 	switch ar.Request.Operation {
 	case admissionv1.Create:
@@ -97,6 +103,21 @@ func (admitter *VMSnapshotAdmitter) Admit(ctx context.Context, ar *admissionv1.A
 						Field:   sourceField.Child("kind").String(),
 					},
 				}
+				break
+			}
+			warnings, err = admitter.persistentStateWarnings(ctx, ar.Request.Namespace, vmSnapshot.Spec.Source.Name)
+			if err != nil {
+				return webhookutils.ToAdmissionResponseError(err)
+			}
+		case pool.GroupName:
+			if vmSnapshot.Spec.Source.Kind != poolv1.VirtualMachinePoolKind {
+				causes = []metav1.StatusCause{
+					{
+						Type:    metav1.CauseTypeFieldValueInvalid,
+						Message: "invalid kind",
+						Field:   sourceField.Child("kind").String(),
+					},
+				}
 			}
 		default:
 			causes = []metav1.StatusCause{
@@ -108,6 +129,8 @@ func (admitter *VMSnapshotAdmitter) Admit(ctx context.Context, ar *admissionv1.A
 			}
 		}
 
+		causes = append(causes, admitter.validateIncludeMemory(vmSnapshot)...)
+
 	case admissionv1.Update:
 		prevObj := &snapshotv1.VirtualMachineSnapshot{}
 		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
@@ -128,12 +151,53 @@ func (admitter *VMSnapshotAdmitter) Admit(ctx context.Context, ar *admissionv1.A
 		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
 	}
 
+	causes = append(causes, validateRetentionPolicy(k8sfield.NewPath("spec", "retentionPolicy"), vmSnapshot.Spec.RetentionPolicy)...)
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
 	reviewResponse := admissionv1.AdmissionResponse{
-		Allowed: true,
+		Allowed:  true,
+		Warnings: warnings,
 	}
 	return &reviewResponse
 }
+
+// validateIncludeMemory makes sure the memory can be captured, it is dumped to a hotplugged volume
+func (admitter *VMSnapshotAdmitter) validateIncludeMemory(vmSnapshot *snapshotv1.VirtualMachineSnapshot) []metav1.StatusCause {
+	if vmSnapshot.Spec.IncludeMemory == nil || !*vmSnapshot.Spec.IncludeMemory {
+		return nil
+	}
+	if !admitter.Config.HotplugVolumesEnabled() && !admitter.Config.DeclarativeHotplugVolumesEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "including the memory requires volume hotplug to be enabled",
+			Field:   k8sfield.NewPath("spec", "includeMemory").String(),
+		}}
+	}
+	return nil
+}
+
+// persistentStateWarnings warns when the persistent vTPM and EFI state of the VM
+// is not snapshottable, the restored VM would start with a fresh state
+func (admitter *VMSnapshotAdmitter) persistentStateWarnings(ctx context.Context, namespace, vmName string) ([]string, error) {
+	vm, err := admitter.Client.VirtualMachine(namespace).Get(ctx, vmName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !backendstorage.IsBackendStorageNeededForVM(vm) {
+		return nil, nil
+	}
+
+	backendVolumeName := storageutils.BackendPVCVolumeName(vm.Name)
+	for _, status := range vm.Status.VolumeSnapshotStatuses {
+		if status.Name == backendVolumeName && !status.Enabled {
+			return []string{fmt.Sprintf("the persistent vTPM and EFI state of VirtualMachine %s will not be captured: %s", vm.Name, status.Reason)}, nil
+		}
+	}
+	return nil, nil
+}
@@ -22,6 +22,7 @@ package admitters
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -35,13 +36,17 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/pool"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
+	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 var _ = Describe("Validating VirtualMachineSnapshot Admitter", func() {
@@ -135,6 +140,28 @@ var _ = Describe("Validating VirtualMachineSnapshot Admitter", func() {
 			Expect(resp.Allowed).To(BeTrue())
 		})
 
+		DescribeTable("should reject invalid retention policy", func(retention *snapshotv1.SnapshotRetentionPolicy, field string) {
+			snapshot := &snapshotv1.VirtualMachineSnapshot{
+				Spec: snapshotv1.VirtualMachineSnapshotSpec{
+					Source: corev1.TypedLocalObjectReference{
+						APIGroup: &apiGroup,
+						Kind:     "VirtualMachine",
+						Name:     vmName,
+					},
+					RetentionPolicy: retention,
+				},
+			}
+
+			ar := createSnapshotAdmissionReview(snapshot)
+			resp := createTestVMSnapshotAdmitter(config, nil).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("with zero maxCount", &snapshotv1.SnapshotRetentionPolicy{MaxCount: pointer.P(int32(0))}, "spec.retentionPolicy.maxCount"),
+			Entry("with negative maxAge", &snapshotv1.SnapshotRetentionPolicy{MaxAge: &metav1.Duration{Duration: -time.Minute}}, "spec.retentionPolicy.maxAge"),
+		)
+
 		It("should reject spec update", func() {
 			snapshot := &snapshotv1.VirtualMachineSnapshot{
 				Spec: snapshotv1.VirtualMachineSnapshotSpec{
@@ -221,6 +248,104 @@ var _ = Describe("Validating VirtualMachineSnapshot Admitter", func() {
 				Expect(resp.Allowed).To(BeTrue())
 			})
 
+			Context("with includeMemory", func() {
+				var snapshot *snapshotv1.VirtualMachineSnapshot
+
+				BeforeEach(func() {
+					snapshot = &snapshotv1.VirtualMachineSnapshot{
+						Spec: snapshotv1.VirtualMachineSnapshotSpec{
+							Source: corev1.TypedLocalObjectReference{
+								APIGroup: &apiGroup,
+								Kind:     "VirtualMachine",
+								Name:     vmName,
+							},
+							IncludeMemory: pointer.P(true),
+						},
+					}
+					vm.Spec.RunStrategy = pointer.P(v1.RunStrategyAlways)
+				})
+
+				It("should reject when volume hotplug is disabled", func() {
+					ar := createSnapshotAdmissionReview(snapshot)
+					resp := createTestVMSnapshotAdmitter(config, vm).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.includeMemory"))
+				})
+
+				It("should accept when volume hotplug is enabled", func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								DeveloperConfiguration: &v1.DeveloperConfiguration{
+									FeatureGates: []string{"Snapshot", featuregate.HotplugVolumesGate},
+								},
+							},
+						},
+					})
+
+					ar := createSnapshotAdmissionReview(snapshot)
+					resp := createTestVMSnapshotAdmitter(config, vm).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeTrue())
+				})
+			})
+
+			Context("with persistent state", func() {
+				var snapshot *snapshotv1.VirtualMachineSnapshot
+
+				BeforeEach(func() {
+					snapshot = &snapshotv1.VirtualMachineSnapshot{
+						Spec: snapshotv1.VirtualMachineSnapshotSpec{
+							Source: corev1.TypedLocalObjectReference{
+								APIGroup: &apiGroup,
+								Kind:     "VirtualMachine",
+								Name:     vmName,
+							},
+						},
+					}
+					vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: v1.VirtualMachineInstanceSpec{
+							Domain: v1.DomainSpec{
+								Devices: v1.Devices{
+									TPM: &v1.TPMDevice{
+										Persistent: pointer.P(true),
+									},
+								},
+							},
+						},
+					}
+				})
+
+				It("should warn when the persistent state is not snapshottable", func() {
+					vm.Status.VolumeSnapshotStatuses = []v1.VolumeSnapshotStatus{
+						{
+							Name:    storageutils.BackendPVCVolumeName(vmName),
+							Enabled: false,
+							Reason:  "no VolumeSnapshotClass",
+						},
+					}
+
+					ar := createSnapshotAdmissionReview(snapshot)
+					resp := createTestVMSnapshotAdmitter(config, vm).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeTrue())
+					Expect(resp.Warnings).To(ConsistOf(ContainSubstring("persistent vTPM and EFI state of VirtualMachine vm will not be captured")))
+				})
+
+				It("should not warn when the persistent state is snapshottable", func() {
+					vm.Status.VolumeSnapshotStatuses = []v1.VolumeSnapshotStatus{
+						{
+							Name:    storageutils.BackendPVCVolumeName(vmName),
+							Enabled: true,
+						},
+					}
+
+					ar := createSnapshotAdmissionReview(snapshot)
+					resp := createTestVMSnapshotAdmitter(config, vm).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeTrue())
+					Expect(resp.Warnings).To(BeEmpty())
+				})
+			})
+
 			It("should reject invalid kind", func() {
 				snapshot := &snapshotv1.VirtualMachineSnapshot{
 					Spec: snapshotv1.VirtualMachineSnapshotSpec{
@@ -262,6 +387,29 @@ var _ = Describe("Validating VirtualMachineSnapshot Admitter", func() {
 				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source.apiGroup"))
 			})
 
+			DescribeTable("with a VirtualMachinePool source", func(kind string, allowed bool) {
+				snapshot := &snapshotv1.VirtualMachineSnapshot{
+					Spec: snapshotv1.VirtualMachineSnapshotSpec{
+						Source: corev1.TypedLocalObjectReference{
+							APIGroup: pointer.P(pool.GroupName),
+							Kind:     kind,
+							Name:     "pool",
+						},
+					},
+				}
+
+				ar := createSnapshotAdmissionReview(snapshot)
+				resp := createTestVMSnapshotAdmitter(config, vm).Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(Equal(allowed))
+				if !allowed {
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source.kind"))
+				}
+			},
+				Entry("should accept the VirtualMachinePool kind", poolv1.VirtualMachinePoolKind, true),
+				Entry("should reject an invalid kind", "VirtualMachine", false),
+			)
+
 			DescribeTable("should accept persistent storage with both offline and online snapshot", func(runStrategy v1.VirtualMachineRunStrategy) {
 				vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{
 					Spec: v1.VirtualMachineInstanceSpec{
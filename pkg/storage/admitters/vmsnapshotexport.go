@@ -0,0 +1,149 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	exportv1 "kubevirt.io/api/export/v1beta1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMSnapshotExportAdmitter validates VirtualMachineSnapshotExports
+type VMSnapshotExportAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMSnapshotExportAdmitter creates a VMSnapshotExportAdmitter
+func NewVMSnapshotExportAdmitter(config *virtconfig.ClusterConfig) *VMSnapshotExportAdmitter {
+	return &VMSnapshotExportAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMSnapshotExportAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != exportv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinesnapshotexports" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.VMExportEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("vm export feature gate not enabled"))
+	}
+
+	snapshotExport := &exportv1.VirtualMachineSnapshotExport{}
+	err := json.Unmarshal(ar.Request.Object.Raw, snapshotExport)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case admissionv1.Create:
+		specField := k8sfield.NewPath("spec")
+		if snapshotExport.Spec.VirtualMachineSnapshotName == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "VMSnapshot name must not be empty",
+				Field:   specField.Child("virtualMachineSnapshotName").String(),
+			})
+		}
+		causes = append(causes, validateObjectStorageDestination(specField.Child("destination"), &snapshotExport.Spec.Destination)...)
+	case admissionv1.Update:
+		prevObj := &exportv1.VirtualMachineSnapshotExport{}
+		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !equality.Semantic.DeepEqual(prevObj.Spec, snapshotExport.Spec) {
+			causes = []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "spec in immutable after creation",
+					Field:   k8sfield.NewPath("spec").String(),
+				},
+			}
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func validateObjectStorageDestination(field *k8sfield.Path, destination *exportv1.ObjectStorageDestination) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	switch destination.Provider {
+	case exportv1.ObjectStorageS3, exportv1.ObjectStorageGCS, exportv1.ObjectStorageAzureBlob:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("object storage provider %q is not supported", destination.Provider),
+			Field:   field.Child("provider").String(),
+		})
+	}
+
+	if endpoint, err := url.Parse(destination.Endpoint); err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "endpoint must be an http or https URL",
+			Field:   field.Child("endpoint").String(),
+		})
+	}
+
+	if destination.Bucket == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "bucket must not be empty",
+			Field:   field.Child("bucket").String(),
+		})
+	}
+
+	if destination.CredentialsSecretRef == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "credentials secret must not be empty",
+			Field:   field.Child("credentialsSecretRef").String(),
+		})
+	}
+
+	return causes
+}
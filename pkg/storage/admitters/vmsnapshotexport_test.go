@@ -0,0 +1,151 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Validating VirtualMachineSnapshotExport Admitter", func() {
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newSnapshotExport := func() *exportv1.VirtualMachineSnapshotExport {
+		return &exportv1.VirtualMachineSnapshotExport{
+			Spec: exportv1.VirtualMachineSnapshotExportSpec{
+				VirtualMachineSnapshotName: "snapshot",
+				Destination: exportv1.ObjectStorageDestination{
+					Provider:             exportv1.ObjectStorageS3,
+					Endpoint:             "https://s3.us-east-1.amazonaws.com",
+					Bucket:               "backups",
+					CredentialsSecretRef: "credentials",
+				},
+			},
+		}
+	}
+
+	Context("With feature gate disabled", func() {
+		It("should reject anything", func() {
+			ar := createSnapshotExportAdmissionReview(newSnapshotExport())
+			resp := NewVMSnapshotExportAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("vm export feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{"VMExport"},
+						},
+					},
+				},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{})
+		})
+
+		It("should accept a valid export", func() {
+			ar := createSnapshotExportAdmissionReview(newSnapshotExport())
+			resp := NewVMSnapshotExportAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject an invalid export", func(mutate func(*exportv1.VirtualMachineSnapshotExport), field string) {
+			snapshotExport := newSnapshotExport()
+			mutate(snapshotExport)
+
+			ar := createSnapshotExportAdmissionReview(snapshotExport)
+			resp := NewVMSnapshotExportAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("without snapshot name", func(e *exportv1.VirtualMachineSnapshotExport) {
+				e.Spec.VirtualMachineSnapshotName = ""
+			}, "spec.virtualMachineSnapshotName"),
+			Entry("with unknown provider", func(e *exportv1.VirtualMachineSnapshotExport) {
+				e.Spec.Destination.Provider = "FTP"
+			}, "spec.destination.provider"),
+			Entry("with endpoint not an http URL", func(e *exportv1.VirtualMachineSnapshotExport) {
+				e.Spec.Destination.Endpoint = "s3.us-east-1.amazonaws.com"
+			}, "spec.destination.endpoint"),
+			Entry("without bucket", func(e *exportv1.VirtualMachineSnapshotExport) {
+				e.Spec.Destination.Bucket = ""
+			}, "spec.destination.bucket"),
+			Entry("without credentials", func(e *exportv1.VirtualMachineSnapshotExport) {
+				e.Spec.Destination.CredentialsSecretRef = ""
+			}, "spec.destination.credentialsSecretRef"),
+		)
+
+		It("should reject spec update", func() {
+			oldExport := newSnapshotExport()
+			newExport := newSnapshotExport()
+			newExport.Spec.Destination.Bucket = "other"
+
+			oldBytes, _ := json.Marshal(oldExport)
+			newBytes, _ := json.Marshal(newExport)
+			ar := createSnapshotExportAdmissionReview(newExport)
+			ar.Request.Operation = admissionv1.Update
+			ar.Request.Object = runtime.RawExtension{Raw: newBytes}
+			ar.Request.OldObject = runtime.RawExtension{Raw: oldBytes}
+
+			resp := NewVMSnapshotExportAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+	})
+})
+
+func createSnapshotExportAdmissionReview(snapshotExport *exportv1.VirtualMachineSnapshotExport) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(snapshotExport)
+
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "export.kubevirt.io",
+				Resource: "virtualmachinesnapshotexports",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+}
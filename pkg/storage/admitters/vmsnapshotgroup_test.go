@@ -0,0 +1,177 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Validating VirtualMachineSnapshotGroup Admitter", func() {
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newGroup := func() *snapshotv1.VirtualMachineSnapshotGroup {
+		return &snapshotv1.VirtualMachineSnapshotGroup{
+			Spec: snapshotv1.VirtualMachineSnapshotGroupSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "db"},
+				},
+			},
+		}
+	}
+
+	newGroupRestore := func() *snapshotv1.VirtualMachineSnapshotGroupRestore {
+		return &snapshotv1.VirtualMachineSnapshotGroupRestore{
+			Spec: snapshotv1.VirtualMachineSnapshotGroupRestoreSpec{
+				VirtualMachineSnapshotGroupName: "group",
+			},
+		}
+	}
+
+	It("should reject anything without the feature gate", func() {
+		ar := createSnapshotGroupAdmissionReview("virtualmachinesnapshotgroups", nil, newGroup())
+		resp := NewVMSnapshotGroupAdmitter(config).Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+
+		ar = createSnapshotGroupAdmissionReview("virtualmachinesnapshotgrouprestores", nil, newGroupRestore())
+		resp = NewVMSnapshotGroupRestoreAdmitter(config).Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{featuregate.SnapshotGate},
+						},
+					},
+				},
+			})
+		})
+
+		It("should accept a valid group", func() {
+			ar := createSnapshotGroupAdmissionReview("virtualmachinesnapshotgroups", nil, newGroup())
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject a group with", func(selector metav1.LabelSelector) {
+			group := newGroup()
+			group.Spec.Selector = selector
+
+			ar := createSnapshotGroupAdmissionReview("virtualmachinesnapshotgroups", nil, group)
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.selector"))
+		},
+			Entry("an empty selector", metav1.LabelSelector{}),
+			Entry("an invalid selector", metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Unknown"}},
+			}),
+		)
+
+		It("should reject changing the group", func() {
+			oldGroup := newGroup()
+			group := newGroup()
+			group.Spec.Selector.MatchLabels["app"] = "web"
+
+			ar := createSnapshotGroupAdmissionReview("virtualmachinesnapshotgroups", oldGroup, group)
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+
+		It("should accept a valid group restore", func() {
+			ar := createSnapshotGroupAdmissionReview("virtualmachinesnapshotgrouprestores", nil, newGroupRestore())
+			resp := NewVMSnapshotGroupRestoreAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject a group restore without group", func() {
+			groupRestore := newGroupRestore()
+			groupRestore.Spec.VirtualMachineSnapshotGroupName = ""
+
+			ar := createSnapshotGroupAdmissionReview("virtualmachinesnapshotgrouprestores", nil, groupRestore)
+			resp := NewVMSnapshotGroupRestoreAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.virtualMachineSnapshotGroupName"))
+		})
+
+		It("should reject changing the group restore", func() {
+			oldGroupRestore := newGroupRestore()
+			groupRestore := newGroupRestore()
+			groupRestore.Spec.VirtualMachineSnapshotGroupName = "other"
+
+			ar := createSnapshotGroupAdmissionReview("virtualmachinesnapshotgrouprestores", oldGroupRestore, groupRestore)
+			resp := NewVMSnapshotGroupRestoreAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+	})
+})
+
+func createSnapshotGroupAdmissionReview(resource string, old, current interface{}) *admissionv1.AdmissionReview {
+	currentBytes, _ := json.Marshal(current)
+
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: resource,
+			},
+			Object: runtime.RawExtension{
+				Raw: currentBytes,
+			},
+		},
+	}
+
+	if old != nil {
+		oldBytes, _ := json.Marshal(old)
+		ar.Request.Operation = admissionv1.Update
+		ar.Request.OldObject = runtime.RawExtension{
+			Raw: oldBytes,
+		}
+	}
+
+	return ar
+}
@@ -0,0 +1,144 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	exportv1 "kubevirt.io/api/export/v1beta1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMSnapshotReplicationAdmitter validates VirtualMachineSnapshotReplications
+type VMSnapshotReplicationAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMSnapshotReplicationAdmitter creates a VMSnapshotReplicationAdmitter
+func NewVMSnapshotReplicationAdmitter(config *virtconfig.ClusterConfig) *VMSnapshotReplicationAdmitter {
+	return &VMSnapshotReplicationAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMSnapshotReplicationAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != exportv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinesnapshotreplications" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.VMExportEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("vm export feature gate not enabled"))
+	}
+
+	replication := &exportv1.VirtualMachineSnapshotReplication{}
+	err := json.Unmarshal(ar.Request.Object.Raw, replication)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case admissionv1.Create:
+		specField := k8sfield.NewPath("spec")
+		if replication.Spec.VirtualMachineSnapshotName == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "VMSnapshot name must not be empty",
+				Field:   specField.Child("virtualMachineSnapshotName").String(),
+			})
+		}
+		causes = append(causes, validatePeerClusterDestination(specField.Child("destination"), &replication.Spec.Destination)...)
+	case admissionv1.Update:
+		prevObj := &exportv1.VirtualMachineSnapshotReplication{}
+		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !equality.Semantic.DeepEqual(prevObj.Spec, replication.Spec) {
+			causes = []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "spec in immutable after creation",
+					Field:   k8sfield.NewPath("spec").String(),
+				},
+			}
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func validatePeerClusterDestination(field *k8sfield.Path, destination *exportv1.PeerClusterDestination) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if destination.KubeconfigSecretRef == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "kubeconfig secret must not be empty",
+			Field:   field.Child("kubeconfigSecretRef").String(),
+		})
+	}
+
+	if destination.Namespace != "" {
+		if errs := validation.IsDNS1123Label(destination.Namespace); len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("namespace is invalid: %s", strings.Join(errs, ", ")),
+				Field:   field.Child("namespace").String(),
+			})
+		}
+	}
+
+	if destination.VirtualMachineName != "" {
+		if errs := validation.IsDNS1123Subdomain(destination.VirtualMachineName); len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("virtual machine name is invalid: %s", strings.Join(errs, ", ")),
+				Field:   field.Child("virtualMachineName").String(),
+			})
+		}
+	}
+
+	return causes
+}
@@ -0,0 +1,157 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Validating VirtualMachineSnapshotReplication Admitter", func() {
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newReplication := func() *exportv1.VirtualMachineSnapshotReplication {
+		return &exportv1.VirtualMachineSnapshotReplication{
+			Spec: exportv1.VirtualMachineSnapshotReplicationSpec{
+				VirtualMachineSnapshotName: "snapshot",
+				Destination: exportv1.PeerClusterDestination{
+					KubeconfigSecretRef: "peer",
+					Namespace:           "standby",
+					VirtualMachineName:  "vm-standby",
+				},
+			},
+		}
+	}
+
+	Context("With feature gate disabled", func() {
+		It("should reject anything", func() {
+			ar := createSnapshotReplicationAdmissionReview(newReplication())
+			resp := NewVMSnapshotReplicationAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("vm export feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{"VMExport"},
+						},
+					},
+				},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{})
+		})
+
+		It("should accept a valid replication", func() {
+			ar := createSnapshotReplicationAdmissionReview(newReplication())
+			resp := NewVMSnapshotReplicationAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should accept a replication without peer namespace and virtual machine name", func() {
+			replication := newReplication()
+			replication.Spec.Destination.Namespace = ""
+			replication.Spec.Destination.VirtualMachineName = ""
+
+			ar := createSnapshotReplicationAdmissionReview(replication)
+			resp := NewVMSnapshotReplicationAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject an invalid replication", func(mutate func(*exportv1.VirtualMachineSnapshotReplication), field string) {
+			replication := newReplication()
+			mutate(replication)
+
+			ar := createSnapshotReplicationAdmissionReview(replication)
+			resp := NewVMSnapshotReplicationAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("without snapshot name", func(r *exportv1.VirtualMachineSnapshotReplication) {
+				r.Spec.VirtualMachineSnapshotName = ""
+			}, "spec.virtualMachineSnapshotName"),
+			Entry("without kubeconfig", func(r *exportv1.VirtualMachineSnapshotReplication) {
+				r.Spec.Destination.KubeconfigSecretRef = ""
+			}, "spec.destination.kubeconfigSecretRef"),
+			Entry("with invalid namespace", func(r *exportv1.VirtualMachineSnapshotReplication) {
+				r.Spec.Destination.Namespace = "Standby"
+			}, "spec.destination.namespace"),
+			Entry("with invalid virtual machine name", func(r *exportv1.VirtualMachineSnapshotReplication) {
+				r.Spec.Destination.VirtualMachineName = "vm_standby"
+			}, "spec.destination.virtualMachineName"),
+		)
+
+		It("should reject spec update", func() {
+			oldReplication := newReplication()
+			newReplication := newReplication()
+			newReplication.Spec.Destination.Namespace = "other"
+
+			oldBytes, _ := json.Marshal(oldReplication)
+			newBytes, _ := json.Marshal(newReplication)
+			ar := createSnapshotReplicationAdmissionReview(newReplication)
+			ar.Request.Operation = admissionv1.Update
+			ar.Request.Object = runtime.RawExtension{Raw: newBytes}
+			ar.Request.OldObject = runtime.RawExtension{Raw: oldBytes}
+
+			resp := NewVMSnapshotReplicationAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+	})
+})
+
+func createSnapshotReplicationAdmissionReview(replication *exportv1.VirtualMachineSnapshotReplication) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(replication)
+
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "export.kubevirt.io",
+				Resource: "virtualmachinesnapshotreplications",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+}
@@ -0,0 +1,182 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/robfig/cron/v3"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	"kubevirt.io/api/core"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMSnapshotScheduleAdmitter validates VirtualMachineSnapshotSchedules
+type VMSnapshotScheduleAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMSnapshotScheduleAdmitter creates a VMSnapshotScheduleAdmitter
+func NewVMSnapshotScheduleAdmitter(config *virtconfig.ClusterConfig) *VMSnapshotScheduleAdmitter {
+	return &VMSnapshotScheduleAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMSnapshotScheduleAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != snapshotv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinesnapshotschedules" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.SnapshotEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("snapshot feature gate not enabled"))
+	}
+
+	schedule := &snapshotv1.VirtualMachineSnapshotSchedule{}
+	// TODO ideally use UniversalDeserializer here
+	err := json.Unmarshal(ar.Request.Object.Raw, schedule)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case admissionv1.Create:
+		causes = validateSnapshotScheduleSource(schedule)
+	case admissionv1.Update:
+		prevObj := &snapshotv1.VirtualMachineSnapshotSchedule{}
+		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !equality.Semantic.DeepEqual(prevObj.Spec.Source, schedule.Spec.Source) {
+			causes = []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "source is immutable after creation",
+					Field:   k8sfield.NewPath("spec", "source").String(),
+				},
+			}
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	causes = append(causes, validateSnapshotScheduleSpec(schedule)...)
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func validateSnapshotScheduleSource(schedule *snapshotv1.VirtualMachineSnapshotSchedule) []metav1.StatusCause {
+	sourceField := k8sfield.NewPath("spec", "source")
+
+	if schedule.Spec.Source.APIGroup == nil {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueNotFound,
+				Message: "missing apiGroup",
+				Field:   sourceField.Child("apiGroup").String(),
+			},
+		}
+	}
+
+	if *schedule.Spec.Source.APIGroup != core.GroupName {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "invalid apiGroup",
+				Field:   sourceField.Child("apiGroup").String(),
+			},
+		}
+	}
+
+	if schedule.Spec.Source.Kind != "VirtualMachine" {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "invalid kind",
+				Field:   sourceField.Child("kind").String(),
+			},
+		}
+	}
+
+	return nil
+}
+
+func validateSnapshotScheduleSpec(schedule *snapshotv1.VirtualMachineSnapshotSchedule) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	specField := k8sfield.NewPath("spec")
+
+	if _, err := cron.ParseStandard(schedule.Spec.Schedule); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid schedule: %v", err),
+			Field:   specField.Child("schedule").String(),
+		})
+	}
+
+	causes = append(causes, validateRetentionPolicy(specField.Child("retention"), schedule.Spec.Retention)...)
+
+	return causes
+}
+
+func validateRetentionPolicy(field *k8sfield.Path, retention *snapshotv1.SnapshotRetentionPolicy) []metav1.StatusCause {
+	if retention == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	if retention.MaxCount != nil && *retention.MaxCount < 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxCount must be at least 1",
+			Field:   field.Child("maxCount").String(),
+		})
+	}
+	if retention.MaxAge != nil && retention.MaxAge.Duration <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxAge must be positive",
+			Field:   field.Child("maxAge").String(),
+		})
+	}
+
+	return causes
+}
@@ -0,0 +1,171 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Validating VirtualMachineSnapshotSchedule Admitter", func() {
+	apiGroup := "kubevirt.io"
+
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: &apiGroup,
+					Kind:     "VirtualMachine",
+					Name:     "vm",
+				},
+				Schedule: "0 */6 * * *",
+			},
+		}
+	}
+
+	It("should reject anything without the feature gate", func() {
+		ar := createSnapshotScheduleAdmissionReview(nil, newSchedule())
+		resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{featuregate.SnapshotGate},
+						},
+					},
+				},
+			})
+		})
+
+		It("should accept a valid schedule", func() {
+			schedule := newSchedule()
+			schedule.Spec.Retention = &snapshotv1.SnapshotRetentionPolicy{
+				MaxCount: pointer.P(int32(3)),
+				MaxAge:   &metav1.Duration{Duration: 24 * time.Hour},
+			}
+
+			ar := createSnapshotScheduleAdmissionReview(nil, schedule)
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject", func(mutate func(*snapshotv1.VirtualMachineSnapshotSchedule), field string) {
+			schedule := newSchedule()
+			mutate(schedule)
+
+			ar := createSnapshotScheduleAdmissionReview(nil, schedule)
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("a missing apiGroup", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Source.APIGroup = nil
+			}, "spec.source.apiGroup"),
+			Entry("an invalid kind", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Source.Kind = "VirtualMachineInstance"
+			}, "spec.source.kind"),
+			Entry("an invalid cron expression", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Schedule = "every day"
+			}, "spec.schedule"),
+			Entry("a zero maxCount", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention = &snapshotv1.SnapshotRetentionPolicy{MaxCount: pointer.P(int32(0))}
+			}, "spec.retention.maxCount"),
+			Entry("a negative maxAge", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention = &snapshotv1.SnapshotRetentionPolicy{MaxAge: &metav1.Duration{Duration: -time.Hour}}
+			}, "spec.retention.maxAge"),
+		)
+
+		It("should allow changing the schedule", func() {
+			oldSchedule := newSchedule()
+			schedule := newSchedule()
+			schedule.Spec.Schedule = "@daily"
+			schedule.Spec.Disabled = pointer.P(true)
+
+			ar := createSnapshotScheduleAdmissionReview(oldSchedule, schedule)
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject changing the source", func() {
+			oldSchedule := newSchedule()
+			schedule := newSchedule()
+			schedule.Spec.Source.Name = "other-vm"
+
+			ar := createSnapshotScheduleAdmissionReview(oldSchedule, schedule)
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source"))
+		})
+	})
+})
+
+func createSnapshotScheduleAdmissionReview(old, current *snapshotv1.VirtualMachineSnapshotSchedule) *admissionv1.AdmissionReview {
+	currentBytes, _ := json.Marshal(current)
+
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: "virtualmachinesnapshotschedules",
+			},
+			Object: runtime.RawExtension{
+				Raw: currentBytes,
+			},
+		},
+	}
+
+	if old != nil {
+		oldBytes, _ := json.Marshal(old)
+		ar.Request.Operation = admissionv1.Update
+		ar.Request.OldObject = runtime.RawExtension{
+			Raw: oldBytes,
+		}
+	}
+
+	return ar
+}
@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package archive
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+const azureAPIVersion = "2021-08-06"
+
+// azureWriter uploads objects as Azure block blobs, authorizing requests with a shared access signature
+type azureWriter struct {
+	client    *http.Client
+	endpoint  *url.URL
+	container string
+	sasToken  string
+}
+
+type blockList struct {
+	XMLName xml.Name `xml:"BlockList"`
+	Latest  []string `xml:"Latest"`
+}
+
+func newAzureWriter(client *http.Client, endpoint, container, sasToken string) (*azureWriter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &azureWriter{
+		client:    client,
+		endpoint:  u,
+		container: container,
+		sasToken:  strings.TrimPrefix(sasToken, "?"),
+	}, nil
+}
+
+func (w *azureWriter) writeObject(ctx context.Context, name string, r io.Reader, partSize int64) error {
+	blocks := blockList{}
+	buf := make([]byte, partSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		if n == 0 {
+			break
+		}
+		// block ids of a blob must all have the same length
+		blockID := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%010d", len(blocks.Latest))))
+		query := url.Values{
+			"comp":    {"block"},
+			"blockid": {blockID},
+		}
+		if err := w.do(ctx, name, query, buf[:n]); err != nil {
+			return err
+		}
+		blocks.Latest = append(blocks.Latest, blockID)
+		if n < len(buf) {
+			break
+		}
+	}
+
+	payload, err := xml.Marshal(blocks)
+	if err != nil {
+		return err
+	}
+	return w.do(ctx, name, url.Values{"comp": {"blocklist"}}, append([]byte(xml.Header), payload...))
+}
+
+func (w *azureWriter) do(ctx context.Context, name string, query url.Values, payload []byte) error {
+	u := *w.endpoint
+	u.Path = path.Join("/", u.Path, w.container, name)
+	u.RawQuery = query.Encode() + "&" + w.sasToken
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.String(), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("x-ms-version", azureAPIVersion)
+	_, err = send(w.client, req)
+	return err
+}
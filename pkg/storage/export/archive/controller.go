@@ -0,0 +1,580 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	validation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/snapshot"
+	"kubevirt.io/kubevirt/pkg/storage/types"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	// TerminationMessagePath is where the archiver container reports the archived volumes
+	TerminationMessagePath = "/dev/termination-log"
+
+	archiverPrefix = "virt-archiver"
+
+	blockVolumePath      = "/dev/archive-volumes"
+	fileSystemVolumePath = "/archive-volumes"
+	credentialsPath      = "/credentials"
+	credentialsVolume    = "credentials"
+
+	vmSnapshotIndex = "vmsnapshot"
+
+	// group id of the kvm user the volumes are owned by
+	kvm = 107
+
+	snapshotNotReadyReason = "SnapshotNotReady"
+	inProgressReason       = "InProgress"
+	succeededReason        = "Succeeded"
+	failedReason           = "Failed"
+
+	archiverPodCreatedEvent = "ArchiverPodCreated"
+	snapshotArchivedEvent   = "SnapshotArchived"
+	snapshotArchiveFailed   = "SnapshotArchiveFailed"
+)
+
+var snapshotExportGVK = exportv1.SchemeGroupVersion.WithKind("VirtualMachineSnapshotExport")
+
+var currentTime = func() *metav1.Time {
+	t := metav1.Now()
+	return &t
+}
+
+type manifestRenderer interface {
+	RenderSnapshotArchiverManifest(snapshotExport *exportv1.VirtualMachineSnapshotExport, namePrefix string) *corev1.Pod
+}
+
+// VMSnapshotExportController archives the volumes of VirtualMachineSnapshots to object storages
+type VMSnapshotExportController struct {
+	Client kubecli.KubevirtClient
+
+	ManifestRenderer manifestRenderer
+
+	VMSnapshotExportInformer  cache.SharedIndexInformer
+	VMSnapshotInformer        cache.SharedIndexInformer
+	VMSnapshotContentInformer cache.SharedIndexInformer
+	PVCInformer               cache.SharedIndexInformer
+	PodInformer               cache.SharedIndexInformer
+	VolumeSnapshotProvider    snapshot.VolumeSnapshotProvider
+
+	Recorder record.EventRecorder
+
+	snapshotExportQueue workqueue.TypedRateLimitingInterface[string]
+}
+
+// Init initializes the snapshot export controller
+func (ctrl *VMSnapshotExportController) Init() error {
+	ctrl.snapshotExportQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-export-vmsnapshotexport"},
+	)
+
+	_, err := ctrl.VMSnapshotExportInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleSnapshotExport,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleSnapshotExport(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+	_, err = ctrl.PodInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleOwnedObject,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleOwnedObject(newObj) },
+			DeleteFunc: ctrl.handleOwnedObject,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	_, err = ctrl.VMSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshot(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshot,
+		},
+	)
+	return err
+}
+
+// Run the controller
+func (ctrl *VMSnapshotExportController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.snapshotExportQueue.ShutDown()
+
+	log.Log.Info("Starting snapshot export controller.")
+	defer log.Log.Info("Shutting down snapshot export controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMSnapshotExportInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMSnapshotContentInformer.HasSynced,
+		ctrl.PVCInformer.HasSynced,
+		ctrl.PodInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.snapshotExportWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMSnapshotExportController) snapshotExportWorker() {
+	for ctrl.processSnapshotExportWorkItem() {
+	}
+}
+
+func (ctrl *VMSnapshotExportController) processSnapshotExportWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.snapshotExportQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmSnapshotExport worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMSnapshotExportInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		snapshotExport, ok := storeObj.(*exportv1.VirtualMachineSnapshotExport)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+
+		return 0, ctrl.updateSnapshotExport(snapshotExport.DeepCopy())
+	})
+}
+
+func (ctrl *VMSnapshotExportController) handleSnapshotExport(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if snapshotExport, ok := obj.(*exportv1.VirtualMachineSnapshotExport); ok {
+		key, err := cache.MetaNamespaceKeyFunc(snapshotExport)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", snapshotExport, err)
+			return
+		}
+		log.Log.V(3).Infof("enqueued %q for sync", key)
+		ctrl.snapshotExportQueue.Add(key)
+	}
+}
+
+func (ctrl *VMSnapshotExportController) handleOwnedObject(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	o, ok := obj.(metav1.Object)
+	if !ok {
+		return
+	}
+	ownerRef := metav1.GetControllerOf(o)
+	if ownerRef == nil || ownerRef.Kind != snapshotExportGVK.Kind || ownerRef.APIVersion != snapshotExportGVK.GroupVersion().String() {
+		return
+	}
+	key := controller.NamespacedKey(o.GetNamespace(), ownerRef.Name)
+	log.Log.V(3).Infof("Adding VMSnapshotExport due to owned object %s", key)
+	ctrl.snapshotExportQueue.Add(key)
+}
+
+func (ctrl *VMSnapshotExportController) handleVMSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+	if !ok {
+		return
+	}
+	keys, err := ctrl.VMSnapshotExportInformer.GetIndexer().IndexKeys(vmSnapshotIndex, controller.NamespacedKey(vmSnapshot.Namespace, vmSnapshot.Name))
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	for _, key := range keys {
+		log.Log.V(3).Infof("Adding VMSnapshotExport due to snapshot %s", key)
+		ctrl.snapshotExportQueue.Add(key)
+	}
+}
+
+func (ctrl *VMSnapshotExportController) updateSnapshotExport(snapshotExport *exportv1.VirtualMachineSnapshotExport) error {
+	log.Log.V(3).Infof("Updating VirtualMachineSnapshotExport %s/%s", snapshotExport.Namespace, snapshotExport.Name)
+
+	if snapshotExport.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if isFinished(snapshotExport) {
+		return ctrl.cleanup(snapshotExport)
+	}
+
+	snapshotExportCopy := snapshotExport.DeepCopy()
+	if snapshotExportCopy.Status == nil {
+		snapshotExportCopy.Status = &exportv1.VirtualMachineSnapshotExportStatus{
+			Phase: exportv1.SnapshotExportPending,
+		}
+	}
+
+	if err := ctrl.archive(snapshotExportCopy); err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(snapshotExport.Status, snapshotExportCopy.Status) {
+		return nil
+	}
+	_, err := ctrl.Client.VirtualMachineSnapshotExport(snapshotExportCopy.Namespace).UpdateStatus(context.Background(), snapshotExportCopy, metav1.UpdateOptions{})
+	return err
+}
+
+// archive progresses the archiving of the snapshot, recording it in the status of the export
+func (ctrl *VMSnapshotExportController) archive(snapshotExport *exportv1.VirtualMachineSnapshotExport) error {
+	status := snapshotExport.Status
+	vmSnapshot, content, err := ctrl.getReadySnapshot(snapshotExport)
+	if err != nil {
+		return err
+	}
+	if vmSnapshot == nil {
+		status.Phase = exportv1.SnapshotExportPending
+		status.Conditions = updateCondition(status.Conditions, newReadyCondition(corev1.ConditionFalse, snapshotNotReadyReason,
+			fmt.Sprintf("VirtualMachineSnapshot %s is not ready to use", snapshotExport.Spec.VirtualMachineSnapshotName)))
+		return nil
+	}
+	status.VirtualMachineName = pointer.P(vmSnapshot.Spec.Source.Name)
+
+	if len(content.Spec.VolumeBackups) == 0 {
+		ctrl.setFailed(snapshotExport, "VirtualMachineSnapshot has no volumes to archive")
+		return nil
+	}
+
+	pod, exists, err := ctrl.getArchiverPod(snapshotExport)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		pvcs, err := ctrl.getOrCreatePVCs(snapshotExport, content.Spec.VolumeBackups)
+		if err != nil {
+			return err
+		}
+		pod, err = ctrl.Client.CoreV1().Pods(snapshotExport.Namespace).Create(context.Background(), ctrl.createArchiverPodManifest(snapshotExport, content.Spec.VolumeBackups, pvcs), metav1.CreateOptions{})
+		if err != nil {
+			return err
+		}
+		ctrl.Recorder.Eventf(snapshotExport, corev1.EventTypeNormal, archiverPodCreatedEvent, "Created archiver pod %s/%s", pod.Namespace, pod.Name)
+	}
+
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		volumes, err := ArchivedVolumes(pod)
+		if err != nil {
+			ctrl.setFailed(snapshotExport, err.Error())
+			return nil
+		}
+		status.Volumes = volumes
+		status.CompletionTime = currentTime()
+		status.Phase = exportv1.SnapshotExportSucceeded
+		status.Conditions = updateCondition(status.Conditions, newReadyCondition(corev1.ConditionTrue, succeededReason, "Volumes archived"))
+		ctrl.Recorder.Eventf(snapshotExport, corev1.EventTypeNormal, snapshotArchivedEvent, "Archived %d volumes of VirtualMachineSnapshot %s", len(volumes), vmSnapshot.Name)
+	case corev1.PodFailed:
+		ctrl.setFailed(snapshotExport, TerminationMessage(pod))
+	default:
+		status.Phase = exportv1.SnapshotExportInProgress
+		status.Conditions = updateCondition(status.Conditions, newReadyCondition(corev1.ConditionFalse, inProgressReason, "Archiving volumes"))
+	}
+	return nil
+}
+
+func (ctrl *VMSnapshotExportController) setFailed(snapshotExport *exportv1.VirtualMachineSnapshotExport, message string) {
+	snapshotExport.Status.Phase = exportv1.SnapshotExportFailed
+	snapshotExport.Status.CompletionTime = currentTime()
+	snapshotExport.Status.Conditions = updateCondition(snapshotExport.Status.Conditions, newReadyCondition(corev1.ConditionFalse, failedReason, message))
+	ctrl.Recorder.Eventf(snapshotExport, corev1.EventTypeWarning, snapshotArchiveFailed, "Failed to archive VirtualMachineSnapshot %s: %s", snapshotExport.Spec.VirtualMachineSnapshotName, message)
+}
+
+// getReadySnapshot returns the snapshot to archive and its content once the snapshot is ready to use
+func (ctrl *VMSnapshotExportController) getReadySnapshot(snapshotExport *exportv1.VirtualMachineSnapshotExport) (*snapshotv1.VirtualMachineSnapshot, *snapshotv1.VirtualMachineSnapshotContent, error) {
+	obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(controller.NamespacedKey(snapshotExport.Namespace, snapshotExport.Spec.VirtualMachineSnapshotName))
+	if err != nil || !exists {
+		return nil, nil, err
+	}
+	vmSnapshot := obj.(*snapshotv1.VirtualMachineSnapshot)
+	if !snapshot.VmSnapshotReady(vmSnapshot) || vmSnapshot.Status.VirtualMachineSnapshotContentName == nil {
+		return nil, nil, nil
+	}
+
+	obj, exists, err = ctrl.VMSnapshotContentInformer.GetStore().GetByKey(controller.NamespacedKey(vmSnapshot.Namespace, *vmSnapshot.Status.VirtualMachineSnapshotContentName))
+	if err != nil || !exists {
+		return nil, nil, err
+	}
+	return vmSnapshot, obj.(*snapshotv1.VirtualMachineSnapshotContent), nil
+}
+
+func (ctrl *VMSnapshotExportController) getArchiverPod(snapshotExport *exportv1.VirtualMachineSnapshotExport) (*corev1.Pod, bool, error) {
+	obj, exists, err := ctrl.PodInformer.GetStore().GetByKey(controller.NamespacedKey(snapshotExport.Namespace, getArchiverPodName(snapshotExport)))
+	if err != nil || !exists {
+		return nil, exists, err
+	}
+	return obj.(*corev1.Pod), true, nil
+}
+
+// getOrCreatePVCs restores a PVC from the volume snapshot of every volume backup
+func (ctrl *VMSnapshotExportController) getOrCreatePVCs(snapshotExport *exportv1.VirtualMachineSnapshotExport, volumeBackups []snapshotv1.VolumeBackup) ([]*corev1.PersistentVolumeClaim, error) {
+	var pvcs []*corev1.PersistentVolumeClaim
+	for i := range volumeBackups {
+		volumeBackup := &volumeBackups[i]
+		pvcName := getRestorePVCName(snapshotExport, volumeBackup)
+		obj, exists, err := ctrl.PVCInformer.GetStore().GetByKey(controller.NamespacedKey(snapshotExport.Namespace, pvcName))
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			pvcs = append(pvcs, obj.(*corev1.PersistentVolumeClaim))
+			continue
+		}
+
+		if volumeBackup.VolumeSnapshotName == nil {
+			return nil, fmt.Errorf("missing VolumeSnapshot name of volume %s", volumeBackup.VolumeName)
+		}
+		volumeSnapshot, err := ctrl.VolumeSnapshotProvider.GetVolumeSnapshot(snapshotExport.Namespace, *volumeBackup.VolumeSnapshotName)
+		if err != nil {
+			return nil, err
+		}
+		pvc, err := snapshot.CreateRestorePVCDef(pvcName, volumeSnapshot, volumeBackup)
+		if err != nil {
+			return nil, err
+		}
+		pvc.SetOwnerReferences([]metav1.OwnerReference{
+			*metav1.NewControllerRef(snapshotExport, snapshotExportGVK),
+		})
+		pvc, err = ctrl.Client.CoreV1().PersistentVolumeClaims(snapshotExport.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+		if err != nil {
+			return nil, err
+		}
+		pvcs = append(pvcs, pvc)
+	}
+	return pvcs, nil
+}
+
+func (ctrl *VMSnapshotExportController) createArchiverPodManifest(snapshotExport *exportv1.VirtualMachineSnapshotExport, volumeBackups []snapshotv1.VolumeBackup, pvcs []*corev1.PersistentVolumeClaim) *corev1.Pod {
+	destination := snapshotExport.Spec.Destination
+	podManifest := ctrl.ManifestRenderer.RenderSnapshotArchiverManifest(snapshotExport, archiverPrefix)
+	podManifest.Spec.SecurityContext = &corev1.PodSecurityContext{
+		RunAsNonRoot:   pointer.P(true),
+		FSGroup:        pointer.P(int64(kvm)),
+		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+
+	container := &podManifest.Spec.Containers[0]
+	ConfigureArchiver(&podManifest.Spec, container, destination, path.Join(destination.Prefix, snapshotExport.Namespace, snapshotExport.Spec.VirtualMachineSnapshotName))
+
+	for i, pvc := range pvcs {
+		volumeName := volumeBackups[i].VolumeName
+		var mountPoint string
+		if types.IsPVCBlock(pvc.Spec.VolumeMode) {
+			mountPoint = path.Join(blockVolumePath, volumeName)
+			container.VolumeDevices = append(container.VolumeDevices, corev1.VolumeDevice{
+				Name:       volumeName,
+				DevicePath: mountPoint,
+			})
+		} else {
+			mountPoint = path.Join(fileSystemVolumePath, volumeName)
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      volumeName,
+				ReadOnly:  true,
+				MountPath: mountPoint,
+			})
+		}
+		podManifest.Spec.Volumes = append(podManifest.Spec.Volumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: pvc.Name,
+				},
+			},
+		})
+		AddArchivedVolume(container, i, volumeName, mountPoint)
+	}
+
+	return podManifest
+}
+
+// ConfigureArchiver makes the container of the exporter image upload volumes to the destination,
+// prefixing the names of the objects with prefix, and mounts the credentials of the destination
+func ConfigureArchiver(podSpec *corev1.PodSpec, container *corev1.Container, destination exportv1.ObjectStorageDestination, prefix string) {
+	container.Args = []string{ArchiveCommand}
+	container.TerminationMessagePath = TerminationMessagePath
+	container.TerminationMessagePolicy = corev1.TerminationMessageFallbackToLogsOnError
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  providerEnv,
+		Value: string(destination.Provider),
+	}, corev1.EnvVar{
+		Name:  endpointEnv,
+		Value: destination.Endpoint,
+	}, corev1.EnvVar{
+		Name:  bucketEnv,
+		Value: destination.Bucket,
+	}, corev1.EnvVar{
+		Name:  prefixEnv,
+		Value: prefix,
+	}, corev1.EnvVar{
+		Name:  regionEnv,
+		Value: destination.Region,
+	}, corev1.EnvVar{
+		Name:  credentialsDirEnv,
+		Value: credentialsPath,
+	})
+
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: credentialsVolume,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: destination.CredentialsSecretRef,
+			},
+		},
+	})
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      credentialsVolume,
+		ReadOnly:  true,
+		MountPath: credentialsPath,
+	})
+}
+
+// AddArchivedVolume makes the archiver container upload the volume found at volumePath as name
+func AddArchivedVolume(container *corev1.Container, index int, name, volumePath string) {
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  fmt.Sprintf("VOLUME%d%s", index, volumeNameEnvSuffix),
+		Value: name,
+	}, corev1.EnvVar{
+		Name:  fmt.Sprintf("VOLUME%d%s", index, volumePathEnvSuffix),
+		Value: volumePath,
+	})
+}
+
+// ArchivedVolumes returns the volumes the archiver container of the pod reports having uploaded
+func ArchivedVolumes(pod *corev1.Pod) ([]exportv1.ArchivedVolume, error) {
+	var volumes []exportv1.ArchivedVolume
+	if err := json.Unmarshal([]byte(TerminationMessage(pod)), &volumes); err != nil {
+		return nil, fmt.Errorf("failed to read the archived volumes: %v", err)
+	}
+	return volumes, nil
+}
+
+// cleanup deletes the archiver pod and the restored PVCs once the export finished
+func (ctrl *VMSnapshotExportController) cleanup(snapshotExport *exportv1.VirtualMachineSnapshotExport) error {
+	pod, exists, err := ctrl.getArchiverPod(snapshotExport)
+	if err != nil {
+		return err
+	}
+	if exists && pod.DeletionTimestamp == nil {
+		if err := ctrl.Client.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	objs, err := ctrl.PVCInformer.GetIndexer().ByIndex(cache.NamespaceIndex, snapshotExport.Namespace)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		pvc := obj.(*corev1.PersistentVolumeClaim)
+		if pvc.DeletionTimestamp != nil || !metav1.IsControlledBy(pvc, snapshotExport) {
+			continue
+		}
+		if err := ctrl.Client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Delete(context.Background(), pvc.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func isFinished(snapshotExport *exportv1.VirtualMachineSnapshotExport) bool {
+	return snapshotExport.Status != nil &&
+		(snapshotExport.Status.Phase == exportv1.SnapshotExportSucceeded || snapshotExport.Status.Phase == exportv1.SnapshotExportFailed)
+}
+
+func getArchiverPodName(snapshotExport *exportv1.VirtualMachineSnapshotExport) string {
+	return naming.GetName(archiverPrefix, snapshotExport.Name, validation.DNS1035LabelMaxLength)
+}
+
+func getRestorePVCName(snapshotExport *exportv1.VirtualMachineSnapshotExport, volumeBackup *snapshotv1.VolumeBackup) string {
+	return naming.GetName(snapshotExport.Name, volumeBackup.VolumeName, validation.DNS1123SubdomainMaxLength)
+}
+
+// TerminationMessage returns the message the archiver container terminated with
+func TerminationMessage(pod *corev1.Pod) string {
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.State.Terminated != nil {
+			return containerStatus.State.Terminated.Message
+		}
+	}
+	return ""
+}
+
+func newReadyCondition(status corev1.ConditionStatus, reason, message string) exportv1.Condition {
+	return exportv1.Condition{
+		Type:               exportv1.ConditionReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: *currentTime(),
+	}
+}
+
+func updateCondition(conditions []exportv1.Condition, c exportv1.Condition) []exportv1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == c.Type {
+			if conditions[i].Status != c.Status || conditions[i].Reason != c.Reason || conditions[i].Message != c.Message {
+				conditions[i] = c
+			}
+			return conditions
+		}
+	}
+	return append(conditions, c)
+}
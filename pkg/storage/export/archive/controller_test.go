@@ -0,0 +1,332 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package archive
+
+import (
+	"context"
+	"time"
+
+	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+)
+
+const (
+	testNamespace    = "default"
+	testExportName   = "archive"
+	testSnapshotName = "snapshot"
+	testContentName  = "snapshot-content"
+)
+
+type fakeVolumeSnapshotProvider struct{}
+
+func (fakeVolumeSnapshotProvider) GetVolumeSnapshot(namespace, name string) (*vsv1.VolumeSnapshot, error) {
+	return &vsv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+	}, nil
+}
+
+var _ = Describe("Snapshot export controller", func() {
+	var (
+		controller     *VMSnapshotExportController
+		k8sClient      *k8sfake.Clientset
+		kubevirtClient *kubevirtfake.Clientset
+		recorder       *record.FakeRecorder
+		timeStamp      = metav1.Now()
+	)
+
+	BeforeEach(func() {
+		currentTime = func() *metav1.Time {
+			return &timeStamp
+		}
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		snapshotExportInformer, _ := testutils.NewFakeInformerWithIndexersFor(&exportv1.VirtualMachineSnapshotExport{}, virtcontroller.GetVirtualMachineSnapshotExportInformerIndexers())
+		vmSnapshotInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+		vmSnapshotContentInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		podInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		rqInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
+		nsInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{})
+
+		k8sClient = k8sfake.NewSimpleClientset()
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		recorder = record.NewFakeRecorder(100)
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshotExport(testNamespace).
+			Return(kubevirtClient.ExportV1beta1().VirtualMachineSnapshotExports(testNamespace)).AnyTimes()
+
+		controller = &VMSnapshotExportController{
+			Client:                    virtClient,
+			ManifestRenderer:          services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, 107, "g", rqInformer.GetStore(), nsInformer.GetStore()),
+			VMSnapshotExportInformer:  snapshotExportInformer,
+			VMSnapshotInformer:        vmSnapshotInformer,
+			VMSnapshotContentInformer: vmSnapshotContentInformer,
+			PVCInformer:               pvcInformer,
+			PodInformer:               podInformer,
+			VolumeSnapshotProvider:    fakeVolumeSnapshotProvider{},
+			Recorder:                  recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		currentTime = func() *metav1.Time {
+			t := metav1.Now()
+			return &t
+		}
+	})
+
+	newSnapshotExport := func() *exportv1.VirtualMachineSnapshotExport {
+		return &exportv1.VirtualMachineSnapshotExport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testExportName,
+				Namespace: testNamespace,
+				UID:       "export-uid",
+			},
+			Spec: exportv1.VirtualMachineSnapshotExportSpec{
+				VirtualMachineSnapshotName: testSnapshotName,
+				Destination: exportv1.ObjectStorageDestination{
+					Provider:             exportv1.ObjectStorageS3,
+					Endpoint:             "https://s3.us-east-1.amazonaws.com",
+					Bucket:               "backups",
+					Prefix:               "cluster",
+					CredentialsSecretRef: "credentials",
+				},
+			},
+		}
+	}
+
+	addSnapshotExport := func(snapshotExport *exportv1.VirtualMachineSnapshotExport) {
+		Expect(controller.VMSnapshotExportInformer.GetStore().Add(snapshotExport)).To(Succeed())
+		_, err := kubevirtClient.ExportV1beta1().VirtualMachineSnapshotExports(testNamespace).Create(context.Background(), snapshotExport, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addReadySnapshot := func(volumeMode k8sv1.PersistentVolumeMode) {
+		Expect(controller.VMSnapshotInformer.GetStore().Add(&snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: testSnapshotName, Namespace: testNamespace},
+			Spec: snapshotv1.VirtualMachineSnapshotSpec{
+				Source: k8sv1.TypedLocalObjectReference{Kind: "VirtualMachine", Name: "vm"},
+			},
+			Status: &snapshotv1.VirtualMachineSnapshotStatus{
+				Phase:                             snapshotv1.Succeeded,
+				ReadyToUse:                        pointer.P(true),
+				VirtualMachineSnapshotContentName: pointer.P(testContentName),
+			},
+		})).To(Succeed())
+		Expect(controller.VMSnapshotContentInformer.GetStore().Add(&snapshotv1.VirtualMachineSnapshotContent{
+			ObjectMeta: metav1.ObjectMeta{Name: testContentName, Namespace: testNamespace},
+			Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+				VolumeBackups: []snapshotv1.VolumeBackup{{
+					VolumeName:         "rootdisk",
+					VolumeSnapshotName: pointer.P("vs-rootdisk"),
+					PersistentVolumeClaim: snapshotv1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{Name: "rootdisk-pvc"},
+						Spec: k8sv1.PersistentVolumeClaimSpec{
+							VolumeMode: pointer.P(volumeMode),
+							Resources: k8sv1.VolumeResourceRequirements{
+								Requests: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("1Gi")},
+							},
+						},
+					},
+				}},
+			},
+		})).To(Succeed())
+	}
+
+	getStatus := func() *exportv1.VirtualMachineSnapshotExportStatus {
+		snapshotExport, err := kubevirtClient.ExportV1beta1().VirtualMachineSnapshotExports(testNamespace).Get(context.Background(), testExportName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return snapshotExport.Status
+	}
+
+	terminatedPod := func(phase k8sv1.PodPhase, message string) *k8sv1.Pod {
+		return &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: getArchiverPodName(newSnapshotExport()), Namespace: testNamespace},
+			Status: k8sv1.PodStatus{
+				Phase: phase,
+				ContainerStatuses: []k8sv1.ContainerStatus{{
+					State: k8sv1.ContainerState{
+						Terminated: &k8sv1.ContainerStateTerminated{Message: message},
+					},
+				}},
+			},
+		}
+	}
+
+	It("should wait for the snapshot to be ready", func() {
+		snapshotExport := newSnapshotExport()
+		addSnapshotExport(snapshotExport)
+
+		Expect(controller.updateSnapshotExport(snapshotExport)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(exportv1.SnapshotExportPending))
+		Expect(status.Conditions).To(ConsistOf(newReadyCondition(k8sv1.ConditionFalse, snapshotNotReadyReason, "VirtualMachineSnapshot snapshot is not ready to use")))
+
+		pods, err := k8sClient.CoreV1().Pods(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pods.Items).To(BeEmpty())
+	})
+
+	DescribeTable("should restore the volumes and start the archiver pod", func(volumeMode k8sv1.PersistentVolumeMode, mountVolume func(*k8sv1.Container) string) {
+		snapshotExport := newSnapshotExport()
+		addSnapshotExport(snapshotExport)
+		addReadySnapshot(volumeMode)
+
+		Expect(controller.updateSnapshotExport(snapshotExport)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(exportv1.SnapshotExportInProgress))
+		Expect(status.VirtualMachineName).To(HaveValue(Equal("vm")))
+		testutils.ExpectEvent(recorder, archiverPodCreatedEvent)
+
+		pvcName := getRestorePVCName(snapshotExport, &snapshotv1.VolumeBackup{VolumeName: "rootdisk"})
+		pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).Get(context.Background(), pvcName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pvc.Spec.DataSource.Name).To(Equal("vs-rootdisk"))
+		Expect(metav1.IsControlledBy(pvc, snapshotExport)).To(BeTrue())
+
+		pod, err := k8sClient.CoreV1().Pods(testNamespace).Get(context.Background(), getArchiverPodName(snapshotExport), metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(metav1.IsControlledBy(pod, snapshotExport)).To(BeTrue())
+		container := pod.Spec.Containers[0]
+		Expect(container.Args).To(Equal([]string{ArchiveCommand}))
+		Expect(container.TerminationMessagePath).To(Equal(TerminationMessagePath))
+		Expect(container.Env).To(ContainElements(
+			k8sv1.EnvVar{Name: providerEnv, Value: "S3"},
+			k8sv1.EnvVar{Name: bucketEnv, Value: "backups"},
+			k8sv1.EnvVar{Name: prefixEnv, Value: "cluster/default/snapshot"},
+			k8sv1.EnvVar{Name: credentialsDirEnv, Value: credentialsPath},
+			k8sv1.EnvVar{Name: "VOLUME0" + volumeNameEnvSuffix, Value: "rootdisk"},
+			k8sv1.EnvVar{Name: "VOLUME0" + volumePathEnvSuffix, Value: mountVolume(&container)},
+		))
+		Expect(pod.Spec.Volumes).To(ContainElements(
+			k8sv1.Volume{Name: "rootdisk", VolumeSource: k8sv1.VolumeSource{
+				PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: pvcName},
+			}},
+			k8sv1.Volume{Name: credentialsVolume, VolumeSource: k8sv1.VolumeSource{
+				Secret: &k8sv1.SecretVolumeSource{SecretName: "credentials"},
+			}},
+		))
+	},
+		Entry("with block volume", k8sv1.PersistentVolumeBlock, func(container *k8sv1.Container) string {
+			Expect(container.VolumeDevices).To(ConsistOf(k8sv1.VolumeDevice{Name: "rootdisk", DevicePath: "/dev/archive-volumes/rootdisk"}))
+			return "/dev/archive-volumes/rootdisk"
+		}),
+		Entry("with filesystem volume", k8sv1.PersistentVolumeFilesystem, func(container *k8sv1.Container) string {
+			Expect(container.VolumeMounts).To(ContainElement(k8sv1.VolumeMount{Name: "rootdisk", ReadOnly: true, MountPath: "/archive-volumes/rootdisk"}))
+			return "/archive-volumes/rootdisk"
+		}),
+	)
+
+	It("should record the archived volumes when the archiver pod succeeds", func() {
+		snapshotExport := newSnapshotExport()
+		addSnapshotExport(snapshotExport)
+		addReadySnapshot(k8sv1.PersistentVolumeBlock)
+		Expect(controller.PodInformer.GetStore().Add(terminatedPod(k8sv1.PodSucceeded,
+			`[{"name":"rootdisk","objectName":"cluster/default/snapshot/rootdisk.img","size":1024,"checksum":"abc"}]`))).To(Succeed())
+
+		Expect(controller.updateSnapshotExport(snapshotExport)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(exportv1.SnapshotExportSucceeded))
+		Expect(status.CompletionTime).To(Equal(&timeStamp))
+		Expect(status.Volumes).To(Equal([]exportv1.ArchivedVolume{{
+			Name:       "rootdisk",
+			ObjectName: "cluster/default/snapshot/rootdisk.img",
+			Size:       1024,
+			Checksum:   "abc",
+		}}))
+		Expect(status.Conditions).To(ConsistOf(newReadyCondition(k8sv1.ConditionTrue, succeededReason, "Volumes archived")))
+		testutils.ExpectEvent(recorder, snapshotArchivedEvent)
+	})
+
+	It("should fail when the archiver pod fails", func() {
+		snapshotExport := newSnapshotExport()
+		addSnapshotExport(snapshotExport)
+		addReadySnapshot(k8sv1.PersistentVolumeBlock)
+		Expect(controller.PodInformer.GetStore().Add(terminatedPod(k8sv1.PodFailed, "access denied"))).To(Succeed())
+
+		Expect(controller.updateSnapshotExport(snapshotExport)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(exportv1.SnapshotExportFailed))
+		Expect(status.Conditions).To(ConsistOf(newReadyCondition(k8sv1.ConditionFalse, failedReason, "access denied")))
+		testutils.ExpectEvent(recorder, snapshotArchiveFailed)
+	})
+
+	It("should delete the archiver pod and the restored PVCs once finished", func() {
+		snapshotExport := newSnapshotExport()
+		snapshotExport.Status = &exportv1.VirtualMachineSnapshotExportStatus{Phase: exportv1.SnapshotExportSucceeded}
+		pod := terminatedPod(k8sv1.PodSucceeded, "")
+		ownerRef := *metav1.NewControllerRef(snapshotExport, snapshotExportGVK)
+		ownedPVC := &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "restored", Namespace: testNamespace, OwnerReferences: []metav1.OwnerReference{ownerRef}},
+		}
+		otherPVC := &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: testNamespace},
+		}
+		Expect(controller.PodInformer.GetStore().Add(pod)).To(Succeed())
+		_, err := k8sClient.CoreV1().Pods(testNamespace).Create(context.Background(), pod, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		for _, pvc := range []*k8sv1.PersistentVolumeClaim{ownedPVC, otherPVC} {
+			Expect(controller.PVCInformer.GetStore().Add(pvc)).To(Succeed())
+			_, err = k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		Expect(controller.updateSnapshotExport(snapshotExport)).To(Succeed())
+		pods, err := k8sClient.CoreV1().Pods(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pods.Items).To(BeEmpty())
+		pvcs, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pvcs.Items).To(HaveLen(1))
+		Expect(pvcs.Items[0].Name).To(Equal("other"))
+	})
+
+	It("should enqueue the exports of a snapshot", func() {
+		snapshotExport := newSnapshotExport()
+		Expect(controller.VMSnapshotExportInformer.GetStore().Add(snapshotExport)).To(Succeed())
+		controller.handleVMSnapshot(&snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: testSnapshotName, Namespace: testNamespace, UID: types.UID("snapshot-uid")},
+		})
+		Eventually(controller.snapshotExportQueue.Len, time.Second).Should(Equal(1))
+		key, _ := controller.snapshotExportQueue.Get()
+		Expect(key).To(Equal(testNamespace + "/" + testExportName))
+	})
+})
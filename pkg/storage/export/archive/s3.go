@@ -0,0 +1,257 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package archive
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	s3SigningAlgorithm = "AWS4-HMAC-SHA256"
+	s3SignedHeaders    = "host;x-amz-content-sha256;x-amz-date"
+	s3TimeFormat       = "20060102T150405Z"
+)
+
+// s3Writer uploads objects with the multipart upload API shared by S3 and the GCS XML API,
+// signing requests with AWS signature version 4
+type s3Writer struct {
+	client          *http.Client
+	endpoint        *url.URL
+	bucket          string
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+	now             func() time.Time
+}
+
+type initiateMultipartUploadResult struct {
+	UploadID string `xml:"UploadId"`
+}
+
+type completeMultipartUpload struct {
+	XMLName xml.Name       `xml:"CompleteMultipartUpload"`
+	Parts   []completePart `xml:"Part"`
+}
+
+type completePart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+type s3Error struct {
+	XMLName xml.Name `xml:"Error"`
+	Code    string   `xml:"Code"`
+	Message string   `xml:"Message"`
+}
+
+func newS3Writer(client *http.Client, endpoint, bucket, region, accessKeyID, secretAccessKey string) (*s3Writer, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &s3Writer{
+		client:          client,
+		endpoint:        u,
+		bucket:          bucket,
+		region:          region,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		now:             time.Now,
+	}, nil
+}
+
+func (w *s3Writer) writeObject(ctx context.Context, name string, r io.Reader, partSize int64) error {
+	body, err := w.do(ctx, http.MethodPost, name, url.Values{"uploads": {""}}, nil)
+	if err != nil {
+		return err
+	}
+	initiated := &initiateMultipartUploadResult{}
+	if err := xml.Unmarshal(body, initiated); err != nil {
+		return err
+	}
+
+	if err := w.uploadParts(ctx, name, initiated.UploadID, r, partSize); err != nil {
+		if _, abortErr := w.do(ctx, http.MethodDelete, name, url.Values{"uploadId": {initiated.UploadID}}, nil); abortErr != nil {
+			return fmt.Errorf("%v, failed to abort the upload: %v", err, abortErr)
+		}
+		return err
+	}
+	return nil
+}
+
+func (w *s3Writer) uploadParts(ctx context.Context, name, uploadID string, r io.Reader, partSize int64) error {
+	complete := completeMultipartUpload{}
+	buf := make([]byte, partSize)
+	for partNumber := 1; ; partNumber++ {
+		n, err := io.ReadFull(r, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		// an empty object is still uploaded as a single empty part
+		if n == 0 && partNumber > 1 {
+			break
+		}
+		query := url.Values{
+			"partNumber": {strconv.Itoa(partNumber)},
+			"uploadId":   {uploadID},
+		}
+		resp, doErr := w.doRequest(ctx, http.MethodPut, name, query, buf[:n])
+		if doErr != nil {
+			return doErr
+		}
+		complete.Parts = append(complete.Parts, completePart{PartNumber: partNumber, ETag: resp.header.Get("ETag")})
+		if n < len(buf) {
+			break
+		}
+	}
+
+	payload, err := xml.Marshal(complete)
+	if err != nil {
+		return err
+	}
+	body, err := w.do(ctx, http.MethodPost, name, url.Values{"uploadId": {uploadID}}, payload)
+	if err != nil {
+		return err
+	}
+	// completing an upload can fail after the response status was sent
+	failure := &s3Error{}
+	if xml.Unmarshal(body, failure) == nil {
+		return fmt.Errorf("%s: %s", failure.Code, failure.Message)
+	}
+	return nil
+}
+
+func (w *s3Writer) do(ctx context.Context, method, name string, query url.Values, payload []byte) ([]byte, error) {
+	resp, err := w.doRequest(ctx, method, name, query, payload)
+	if err != nil {
+		return nil, err
+	}
+	return resp.body, nil
+}
+
+type response struct {
+	header http.Header
+	body   []byte
+}
+
+func (w *s3Writer) doRequest(ctx context.Context, method, name string, query url.Values, payload []byte) (*response, error) {
+	u := *w.endpoint
+	u.Path = path.Join("/", u.Path, w.bucket, name)
+	u.RawQuery = canonicalQuery(query)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	signV4(req, payload, w.accessKeyID, w.secretAccessKey, w.region, w.now())
+	return send(w.client, req)
+}
+
+func send(client *http.Client, req *http.Request) (*response, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("%s %s failed with status %d: %s", req.Method, req.URL.Path, resp.StatusCode, string(body))
+	}
+	return &response{header: resp.Header, body: body}, nil
+}
+
+// signV4 adds the headers authenticating the request with AWS signature version 4
+func signV4(req *http.Request, payload []byte, accessKeyID, secretAccessKey, region string, now time.Time) {
+	payloadHash := sha256Hex(payload)
+	amzDate := now.UTC().Format(s3TimeFormat)
+	date := amzDate[:8]
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	canonicalHeaders := fmt.Sprintf("host:%s\nx-amz-content-sha256:%s\nx-amz-date:%s\n", req.URL.Host, payloadHash, amzDate)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		s3SignedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, region, "s3", "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{s3SigningAlgorithm, amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := []byte("AWS4" + secretAccessKey)
+	for _, part := range []string{date, region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgorithm, accessKeyID, scope, s3SignedHeaders, signature))
+}
+
+// canonicalQuery encodes the query sorted by key, escaping spaces as %20 as signing requires
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var params []string
+	for _, k := range keys {
+		for _, v := range query[k] {
+			params = append(params, queryEscape(k)+"="+queryEscape(v))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+func queryEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,337 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package archive
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	gzip "github.com/klauspost/pgzip"
+
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// ArchiveCommand is the argument making the exporter image archive volumes instead of serving them
+	ArchiveCommand = "archive"
+
+	// UploadTimeout bounds how long the upload of all the volumes may take
+	UploadTimeout = 24 * time.Hour
+
+	providerEnv       = "ARCHIVE_PROVIDER"
+	endpointEnv       = "ARCHIVE_ENDPOINT"
+	bucketEnv         = "ARCHIVE_BUCKET"
+	prefixEnv         = "ARCHIVE_PREFIX"
+	regionEnv         = "ARCHIVE_REGION"
+	credentialsDirEnv = "ARCHIVE_CREDENTIALS_DIR"
+
+	volumeNameEnvSuffix = "_ARCHIVE_NAME"
+	volumePathEnvSuffix = "_ARCHIVE_PATH"
+
+	accessKeyIDKey     = "accessKeyId"
+	secretAccessKeyKey = "secretAccessKey"
+	sasTokenKey        = "sasToken"
+
+	defaultS3Region  = "us-east-1"
+	defaultGCSRegion = "auto"
+
+	diskImageName = "disk.img"
+	rawExtension  = ".img"
+	tarExtension  = ".tar.gz"
+
+	// minPartSize is the size of the parts volumes are uploaded in, grown for large
+	// volumes so they fit in the maximum number of parts of the object storage
+	minPartSize = 64 * 1024 * 1024
+	maxParts    = 10000
+)
+
+// VolumeConfig is a volume to archive
+type VolumeConfig struct {
+	// Name is the name the volume is archived as
+	Name string
+	// Path is the block device or the mount point of the volume
+	Path string
+}
+
+// UploaderConfig holds the configuration of the volume uploader
+type UploaderConfig struct {
+	Provider exportv1.ObjectStorageProvider
+	Endpoint string
+	Bucket   string
+	Prefix   string
+	Region   string
+
+	Credentials map[string]string
+
+	Volumes []VolumeConfig
+}
+
+// objectWriter stores the content of a reader as a single object
+type objectWriter interface {
+	writeObject(ctx context.Context, name string, r io.Reader, partSize int64) error
+}
+
+// UploaderConfigFromEnv creates the uploader configuration from the environment of the archiver pod
+func UploaderConfigFromEnv(env map[string]string) (*UploaderConfig, error) {
+	config := &UploaderConfig{
+		Provider:    exportv1.ObjectStorageProvider(env[providerEnv]),
+		Endpoint:    env[endpointEnv],
+		Bucket:      env[bucketEnv],
+		Prefix:      env[prefixEnv],
+		Region:      env[regionEnv],
+		Credentials: map[string]string{},
+	}
+	if config.Endpoint == "" || config.Bucket == "" {
+		return nil, fmt.Errorf("archive destination incomplete")
+	}
+
+	if dir := env[credentialsDirEnv]; dir != "" {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			// secret volumes hold the keys as symlinks next to hidden data directories
+			if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+				continue
+			}
+			value, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+			if err != nil {
+				return nil, err
+			}
+			config.Credentials[entry.Name()] = strings.TrimSpace(string(value))
+		}
+	}
+
+	for k, v := range env {
+		if !strings.HasSuffix(k, volumePathEnvSuffix) {
+			continue
+		}
+		envPrefix := strings.TrimSuffix(k, volumePathEnvSuffix)
+		config.Volumes = append(config.Volumes, VolumeConfig{
+			Name: env[envPrefix+volumeNameEnvSuffix],
+			Path: v,
+		})
+	}
+	sort.Slice(config.Volumes, func(i, j int) bool {
+		return config.Volumes[i].Name < config.Volumes[j].Name
+	})
+
+	return config, nil
+}
+
+func newObjectWriter(config *UploaderConfig) (objectWriter, error) {
+	client := &http.Client{}
+	switch config.Provider {
+	case exportv1.ObjectStorageS3, exportv1.ObjectStorageGCS:
+		region := config.Region
+		if region == "" {
+			region = defaultS3Region
+			if config.Provider == exportv1.ObjectStorageGCS {
+				region = defaultGCSRegion
+			}
+		}
+		accessKeyID, secretAccessKey := config.Credentials[accessKeyIDKey], config.Credentials[secretAccessKeyKey]
+		if accessKeyID == "" || secretAccessKey == "" {
+			return nil, fmt.Errorf("credentials require %s and %s", accessKeyIDKey, secretAccessKeyKey)
+		}
+		return newS3Writer(client, config.Endpoint, config.Bucket, region, accessKeyID, secretAccessKey)
+	case exportv1.ObjectStorageAzureBlob:
+		sasToken := config.Credentials[sasTokenKey]
+		if sasToken == "" {
+			return nil, fmt.Errorf("credentials require %s", sasTokenKey)
+		}
+		return newAzureWriter(client, config.Endpoint, config.Bucket, sasToken)
+	default:
+		return nil, fmt.Errorf("unsupported object storage provider %q", config.Provider)
+	}
+}
+
+// Upload archives all the volumes of the configuration, returning the checksum of every written object
+func Upload(ctx context.Context, config *UploaderConfig) ([]exportv1.ArchivedVolume, error) {
+	writer, err := newObjectWriter(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []exportv1.ArchivedVolume
+	for _, volume := range config.Volumes {
+		archived, err := uploadVolume(ctx, writer, config.Prefix, volume)
+		if err != nil {
+			return nil, fmt.Errorf("failed to archive volume %s: %v", volume.Name, err)
+		}
+		log.Log.Infof("Archived volume %s as %s, %d bytes", volume.Name, archived.ObjectName, archived.Size)
+		result = append(result, *archived)
+	}
+	return result, nil
+}
+
+func uploadVolume(ctx context.Context, writer objectWriter, prefix string, volume VolumeConfig) (*exportv1.ArchivedVolume, error) {
+	reader, extension, size, err := openVolume(volume.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	objectName := path.Join(prefix, volume.Name+extension)
+	hash := sha256.New()
+	counter := &countingWriter{}
+	tee := io.TeeReader(reader, io.MultiWriter(hash, counter))
+	if err := writer.writeObject(ctx, objectName, tee, partSize(size)); err != nil {
+		return nil, err
+	}
+
+	return &exportv1.ArchivedVolume{
+		Name:       volume.Name,
+		ObjectName: objectName,
+		Size:       counter.n,
+		Checksum:   hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
+
+// openVolume returns the raw content of block volumes and of filesystem volumes holding a
+// disk image, and a gzipped tarball of the other filesystem volumes, with its size when known
+func openVolume(volumePath string) (io.ReadCloser, string, int64, error) {
+	info, err := os.Stat(volumePath)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	if info.IsDir() {
+		diskImage := filepath.Join(volumePath, diskImageName)
+		if _, err := os.Stat(diskImage); err != nil {
+			if !os.IsNotExist(err) {
+				return nil, "", 0, err
+			}
+			return newTarGzipReader(volumePath), tarExtension, -1, nil
+		}
+		volumePath = diskImage
+	}
+
+	f, err := os.Open(volumePath)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	// Stat reports no size for block devices, unlike seeking to their end
+	size, err := f.Seek(0, io.SeekEnd)
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		f.Close()
+		return nil, "", 0, err
+	}
+	return f, rawExtension, size, nil
+}
+
+func newTarGzipReader(dir string) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		zw := gzip.NewWriter(pw)
+		tw := tar.NewWriter(zw)
+		err := filepath.Walk(dir, func(file string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			return addToTar(tw, dir, file, info)
+		})
+		if err == nil {
+			err = tw.Close()
+		}
+		if err == nil {
+			err = zw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
+
+func addToTar(tw *tar.Writer, dir, file string, info os.FileInfo) error {
+	name, err := filepath.Rel(dir, file)
+	if err != nil || name == "." {
+		return err
+	}
+	link := ""
+	if info.Mode()&os.ModeSymlink != 0 {
+		if link, err = os.Readlink(file); err != nil {
+			return err
+		}
+	}
+	header, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return err
+	}
+	header.Name = filepath.ToSlash(name)
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+// partSize returns the size of the parts a volume of the given size is uploaded in
+func partSize(size int64) int64 {
+	if size/maxParts >= minPartSize {
+		return size/maxParts + 1
+	}
+	return minPartSize
+}
+
+// WriteResult records the archived volumes, or the error preventing it, as the
+// termination message of the archiver container
+func WriteResult(terminationMessagePath string, volumes []exportv1.ArchivedVolume, uploadErr error) error {
+	message := []byte(fmt.Sprintf("%v", uploadErr))
+	if uploadErr == nil {
+		var err error
+		if message, err = json.Marshal(volumes); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(terminationMessagePath, message, 0644)
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
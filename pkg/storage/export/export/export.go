@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path"
+	"slices"
 	"strings"
 	"time"
 
@@ -57,6 +58,7 @@ import (
 	instancetypefind "kubevirt.io/kubevirt/pkg/instancetype/find"
 	preferencefind "kubevirt.io/kubevirt/pkg/instancetype/preference/find"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/export/archive"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 	"kubevirt.io/kubevirt/pkg/storage/status"
 	"kubevirt.io/kubevirt/pkg/storage/types"
@@ -83,6 +85,9 @@ const (
 	podPendingReason   = "PodPending"
 	podReadyReason     = "PodReady"
 	podCompletedReason = "PodCompleted"
+	pushingReason      = "Pushing"
+	pushedReason       = "VolumesPushed"
+	pushFailedReason   = "PushFailed"
 
 	exportServiceLabel = "kubevirt.io.virt-export-service"
 
@@ -91,6 +96,10 @@ const (
 	blockVolumeMountPath = "/dev/export-volumes"
 	fileSystemMountPath  = "/export-volumes"
 	urlBasePath          = "/volumes"
+	ovaPath              = "/vm.ova"
+	// name of the volume the export server converts images in
+	scratchVolName   = "scratch"
+	scratchMountPath = "/scratch"
 
 	// annContentType is an annotation on a PVC indicating the content type. This is populated by CDI.
 	annContentType = "cdi.kubevirt.io/storage.contentType"
@@ -112,6 +121,8 @@ const (
 	serviceCreatedEvent                   = "ServiceCreated"
 	certParamsChangedEvent                = "CertificateParametersChanged"
 	exporterManifestConfigMapCreatedEvent = "DataManifestCreated"
+	volumesPushedEvent                    = "VolumesPushed"
+	volumesPushFailedEvent                = "VolumesPushFailed"
 
 	kvm = 107
 
@@ -132,6 +143,16 @@ const (
 
 	// ReadinessPath is the endpoint used to check the readiness probe
 	ReadinessPath = "/exportready"
+
+	// ChecksumCommand is the argument making the exporter image compute the checksums of the disk images
+	ChecksumCommand = "checksum"
+	// ChecksumTerminationMessagePath is where the checksum container reports the checksums of the disk images
+	ChecksumTerminationMessagePath = "/dev/termination-log"
+
+	checksumContainerName = "checksum"
+	checksumsVolName      = "checksums"
+	checksumsMountPath    = "/checksums"
+	checksumsFile         = checksumsMountPath + "/checksums.json"
 )
 
 // variable so can be overridden in tests
@@ -160,6 +181,14 @@ func rawGzipURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.img.gz", urlBasePath, pvc.Name))
 }
 
+func qcow2URI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/disk.qcow2", urlBasePath, pvc.Name))
+}
+
+func vmdkURI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/disk.vmdk", urlBasePath, pvc.Name))
+}
+
 func archiveURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.tar.gz", urlBasePath, pvc.Name))
 }
@@ -535,6 +564,10 @@ func (ctrl *VMExportController) updateVMExport(vmExport *exportv1.VirtualMachine
 		populateInitialVMExportStatus(vmExport)
 	}
 
+	if isPushFinished(vmExport) {
+		return 0, ctrl.cleanupPushedExport(vmExport)
+	}
+
 	if ctrl.isSourcePvc(&vmExport.Spec) {
 		return ctrl.handleSource(vmExport, service, ctrl.getPVCFromSourcePVC, ctrl.updateVMExportPvcStatus)
 	}
@@ -584,7 +617,7 @@ func (ctrl *VMExportController) manageExporterPod(vmExport *exportv1.VirtualMach
 		}
 	}
 	if pod != nil {
-		if pod.Status.Phase == corev1.PodPending {
+		if pod.Status.Phase == corev1.PodPending && !isPushMode(vmExport) {
 			if err := ctrl.createCertSecret(vmExport, pod); err != nil {
 				return nil, err
 			}
@@ -625,6 +658,11 @@ func (ctrl *VMExportController) checkPod(vmExport *exportv1.VirtualMachineExport
 		return nil
 	}
 
+	if isPushMode(vmExport) {
+		// The pusher pod is kept until its result is recorded, and needs no certificate
+		return nil
+	}
+
 	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
 		// The server died or completed, delete the pod.
 		return ctrl.deleteExporterPod(vmExport, pod, exporterPodFailedOrCompletedEvent, fmt.Sprintf("Exporter pod %s/%s is in phase %s", pod.Namespace, pod.Name, pod.Status.Phase))
@@ -926,6 +964,10 @@ func (ctrl *VMExportController) createExporterPod(vmExport *exportv1.VirtualMach
 }
 
 func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, pvcs []*corev1.PersistentVolumeClaim) (*corev1.Pod, error) {
+	if isPushMode(vmExport) {
+		return ctrl.createPusherPodManifest(vmExport, pvcs), nil
+	}
+
 	certParams, err := ctrl.getCertParams()
 	if err != nil {
 		return nil, err
@@ -952,31 +994,25 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
 	}
 	for i, pvc := range pvcs {
-		var mountPoint string
-		volumeName := ctrl.getExportPodVolumeName(pvc)
-		if types.IsPVCBlock(pvc.Spec.VolumeMode) {
-			mountPoint = fmt.Sprintf("%s/%s", blockVolumeMountPath, volumeName)
-			podManifest.Spec.Containers[0].VolumeDevices = append(podManifest.Spec.Containers[0].VolumeDevices, corev1.VolumeDevice{
-				Name:       volumeName,
-				DevicePath: mountPoint,
-			})
-		} else {
-			mountPoint = fmt.Sprintf("%s/%s", fileSystemMountPath, volumeName)
-			podManifest.Spec.Containers[0].VolumeMounts = append(podManifest.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
-				Name:      volumeName,
-				ReadOnly:  true,
-				MountPath: mountPoint,
-			})
-		}
+		mountPoint := ctrl.addExportedVolume(podManifest, pvc)
+		ctrl.addVolumeEnvironmentVariables(&podManifest.Spec.Containers[0], vmExport, pvc, i, mountPoint)
+	}
+
+	if len(vmExport.Spec.Formats) > 0 {
 		podManifest.Spec.Volumes = append(podManifest.Spec.Volumes, corev1.Volume{
-			Name: volumeName,
+			Name: scratchVolName,
 			VolumeSource: corev1.VolumeSource{
-				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-					ClaimName: pvc.Name,
-				},
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
 			},
 		})
-		ctrl.addVolumeEnvironmentVariables(&podManifest.Spec.Containers[0], pvc, i, mountPoint)
+		podManifest.Spec.Containers[0].VolumeMounts = append(podManifest.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      scratchVolName,
+			MountPath: scratchMountPath,
+		})
+		podManifest.Spec.Containers[0].Env = append(podManifest.Spec.Containers[0].Env, corev1.EnvVar{
+			Name:  "EXPORT_SCRATCH_PATH",
+			Value: scratchMountPath,
+		})
 	}
 
 	// Add token and certs ENV variables
@@ -1052,11 +1088,141 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 			if err := ctrl.createDataManifestAndAddToPod(vmExport, vm, podManifest, service); err != nil {
 				return nil, err
 			}
+			if hasExportFormat(vmExport, exportv1.Ova) {
+				podManifest.Spec.Containers[0].Env = append(podManifest.Spec.Containers[0].Env, corev1.EnvVar{
+					Name:  "EXPORT_VM_OVA_URI",
+					Value: ovaPath,
+				})
+			}
 		}
 	}
+
+	if vmExport.Spec.Checksums != nil && *vmExport.Spec.Checksums {
+		addChecksumInitContainer(podManifest)
+	}
 	return podManifest, nil
 }
 
+// addExportedVolume mounts the PVC in the export container of the pod, returning its mount point
+func (ctrl *VMExportController) addExportedVolume(podManifest *corev1.Pod, pvc *corev1.PersistentVolumeClaim) string {
+	var mountPoint string
+	volumeName := ctrl.getExportPodVolumeName(pvc)
+	if types.IsPVCBlock(pvc.Spec.VolumeMode) {
+		mountPoint = fmt.Sprintf("%s/%s", blockVolumeMountPath, volumeName)
+		podManifest.Spec.Containers[0].VolumeDevices = append(podManifest.Spec.Containers[0].VolumeDevices, corev1.VolumeDevice{
+			Name:       volumeName,
+			DevicePath: mountPoint,
+		})
+	} else {
+		mountPoint = fmt.Sprintf("%s/%s", fileSystemMountPath, volumeName)
+		podManifest.Spec.Containers[0].VolumeMounts = append(podManifest.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      volumeName,
+			ReadOnly:  true,
+			MountPath: mountPoint,
+		})
+	}
+	podManifest.Spec.Volumes = append(podManifest.Spec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: pvc.Name,
+			},
+		},
+	})
+	return mountPoint
+}
+
+// createPusherPodManifest creates an exporter pod writing the volumes to the destination of the export instead of serving them.
+// The objects are named after the PVCs, under the destination prefix, the namespace and the name of the export.
+func (ctrl *VMExportController) createPusherPodManifest(vmExport *exportv1.VirtualMachineExport, pvcs []*corev1.PersistentVolumeClaim) *corev1.Pod {
+	destination := *vmExport.Spec.Destination
+	podManifest := ctrl.ManifestRenderer.RenderExporterManifest(vmExport, exportPrefix)
+	podManifest.Labels = map[string]string{exportServiceLabel: ctrl.getExportLabelValue(vmExport)}
+	for key, value := range vmExport.Labels {
+		podManifest.Labels[key] = value
+	}
+	podManifest.Annotations = map[string]string{}
+	for key, value := range vmExport.Annotations {
+		podManifest.Annotations[key] = value
+	}
+	podManifest.Spec.SecurityContext = &corev1.PodSecurityContext{
+		RunAsNonRoot:   pointer.P(true),
+		FSGroup:        pointer.P(int64(kvm)),
+		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+
+	container := &podManifest.Spec.Containers[0]
+	archive.ConfigureArchiver(&podManifest.Spec, container, destination, path.Join(destination.Prefix, vmExport.Namespace, vmExport.Name))
+	for i, pvc := range pvcs {
+		mountPoint := ctrl.addExportedVolume(podManifest, pvc)
+		archive.AddArchivedVolume(container, i, pvc.Name, mountPoint)
+	}
+	return podManifest
+}
+
+// addChecksumInitContainer adds an init container computing the checksums of the disk images before the export server starts.
+// The init container shares the volumes and environment of the export server, and hands it the checksums through a shared volume.
+func addChecksumInitContainer(podManifest *corev1.Pod) {
+	podManifest.Spec.Volumes = append(podManifest.Spec.Volumes, corev1.Volume{
+		Name: checksumsVolName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	})
+	exportContainer := &podManifest.Spec.Containers[0]
+	exportContainer.VolumeMounts = append(exportContainer.VolumeMounts, corev1.VolumeMount{
+		Name:      checksumsVolName,
+		MountPath: checksumsMountPath,
+	})
+	exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
+		Name:  "EXPORT_CHECKSUMS_FILE",
+		Value: checksumsFile,
+	})
+
+	checksumContainer := exportContainer.DeepCopy()
+	checksumContainer.Name = checksumContainerName
+	checksumContainer.Args = []string{ChecksumCommand}
+	checksumContainer.ReadinessProbe = nil
+	checksumContainer.TerminationMessagePath = ChecksumTerminationMessagePath
+	checksumContainer.TerminationMessagePolicy = corev1.TerminationMessageFallbackToLogsOnError
+	podManifest.Spec.InitContainers = append(podManifest.Spec.InitContainers, *checksumContainer)
+}
+
+// getVolumeChecksums returns the checksums of the disk images reported by the checksum init container of the exporter pod
+func getVolumeChecksums(pvcs []*corev1.PersistentVolumeClaim, exporterPod *corev1.Pod, getVolumeName getExportVolumeName, vmExport *exportv1.VirtualMachineExport) []exportv1.ExportedVolumeChecksum {
+	var message string
+	for _, containerStatus := range exporterPod.Status.InitContainerStatuses {
+		if containerStatus.Name == checksumContainerName && containerStatus.State.Terminated != nil && containerStatus.State.Terminated.ExitCode == 0 {
+			message = containerStatus.State.Terminated.Message
+		}
+	}
+	if message == "" {
+		return nil
+	}
+
+	var reported []exportv1.ExportedVolumeChecksum
+	if err := json.Unmarshal([]byte(message), &reported); err != nil {
+		log.Log.Object(vmExport).Reason(err).Error("Failed to parse the checksums of the exported volumes")
+		return nil
+	}
+	checksums := make(map[string]exportv1.ExportedVolumeChecksum, len(reported))
+	for _, checksum := range reported {
+		checksums[checksum.Name] = checksum
+	}
+
+	var result []exportv1.ExportedVolumeChecksum
+	for _, pvc := range pvcs {
+		if pvc == nil {
+			continue
+		}
+		if checksum, ok := checksums[pvc.Name]; ok {
+			checksum.Name = getVolumeName(pvc, vmExport)
+			result = append(result, checksum)
+		}
+	}
+	return result
+}
+
 func (ctrl *VMExportController) createDataManifestAndAddToPod(vmExport *exportv1.VirtualMachineExport, vm *virtv1.VirtualMachine, podManifest *corev1.Pod, service *corev1.Service) error {
 	vmManifestConfigMap, err := ctrl.createDataManifestConfigMap(vmExport, vm, service)
 	if err != nil {
@@ -1183,7 +1349,7 @@ func (ctrl *VMExportController) getVmFromExport(vmExport *exportv1.VirtualMachin
 	return nil, nil
 }
 
-func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *corev1.Container, pvc *corev1.PersistentVolumeClaim, index int, mountPoint string) {
+func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *corev1.Container, vmExport *exportv1.VirtualMachineExport, pvc *corev1.PersistentVolumeClaim, index int, mountPoint string) {
 	exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
 		Name:  fmt.Sprintf("VOLUME%d_EXPORT_PATH", index),
 		Value: mountPoint,
@@ -1196,6 +1362,7 @@ func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *c
 			Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
 			Value: rawGzipURI(pvc),
 		})
+		addConvertedImageEnvironmentVariables(exportContainer, vmExport, pvc, index)
 	} else {
 		if ctrl.isKubevirtContentType(pvc) {
 			exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
@@ -1205,6 +1372,7 @@ func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *c
 				Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
 				Value: rawGzipURI(pvc),
 			})
+			addConvertedImageEnvironmentVariables(exportContainer, vmExport, pvc, index)
 		} else {
 			exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
 				Name:  fmt.Sprintf("VOLUME%d_EXPORT_ARCHIVE_URI", index),
@@ -1217,6 +1385,26 @@ func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *c
 	}
 }
 
+// addConvertedImageEnvironmentVariables adds the URIs of the images the raw disk image of the volume is converted to
+func addConvertedImageEnvironmentVariables(exportContainer *corev1.Container, vmExport *exportv1.VirtualMachineExport, pvc *corev1.PersistentVolumeClaim, index int) {
+	if hasExportFormat(vmExport, exportv1.Qcow2) {
+		exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_URI", index),
+			Value: qcow2URI(pvc),
+		})
+	}
+	if hasExportFormat(vmExport, exportv1.Vmdk) {
+		exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_VMDK_URI", index),
+			Value: vmdkURI(pvc),
+		})
+	}
+}
+
+func hasExportFormat(vmExport *exportv1.VirtualMachineExport, format exportv1.ExportVolumeFormat) bool {
+	return slices.Contains(vmExport.Spec.Formats, format)
+}
+
 func (ctrl *VMExportController) isKubevirtContentType(pvc *corev1.PersistentVolumeClaim) bool {
 	// Block volumes are assumed always KubevirtContentType
 	if types.IsPVCBlock(pvc.Spec.VolumeMode) {
@@ -1249,6 +1437,11 @@ func (ctrl *VMExportController) updateCommonVMExportStatusFields(vmExport, vmExp
 
 	vmExportCopy.Status.ServiceName = service.Name
 	vmExportCopy.Status.Links = &exportv1.VirtualMachineExportLinks{}
+	vmExportCopy.Status.VolumeChecksums = nil
+	if isPushMode(vmExport) {
+		ctrl.updatePushStatus(vmExport, vmExportCopy, exporterPod, sourceVolumes, getVolumeName)
+		return nil
+	}
 	if exporterPod == nil {
 		vmExportCopy.Status.Conditions = updateCondition(vmExportCopy.Status.Conditions, newReadyCondition(corev1.ConditionFalse, inUseReason, sourceVolumes.availableMessage))
 		vmExportCopy.Status.Phase = exportv1.Pending
@@ -1264,6 +1457,7 @@ func (ctrl *VMExportController) updateCommonVMExportStatusFields(vmExport, vmExp
 			if err != nil {
 				return err
 			}
+			vmExportCopy.Status.VolumeChecksums = getVolumeChecksums(sourceVolumes.volumes, exporterPod, getVolumeName, vmExport)
 		} else if exporterPod.Status.Phase == corev1.PodSucceeded {
 			vmExportCopy.Status.Conditions = updateCondition(vmExportCopy.Status.Conditions, newReadyCondition(corev1.ConditionFalse, podCompletedReason, ""))
 			vmExportCopy.Status.Phase = exportv1.Terminated
@@ -1279,6 +1473,85 @@ func (ctrl *VMExportController) updateCommonVMExportStatusFields(vmExport, vmExp
 	return nil
 }
 
+// updatePushStatus records the progress of the pusher pod, and the pushed volumes once it completed
+func (ctrl *VMExportController) updatePushStatus(vmExport, vmExportCopy *exportv1.VirtualMachineExport, pusherPod *corev1.Pod, sourceVolumes *sourceVolumes, getVolumeName getExportVolumeName) {
+	status := vmExportCopy.Status
+	status.Phase = exportv1.Pending
+	if pusherPod == nil {
+		status.Conditions = updateCondition(status.Conditions, newReadyCondition(corev1.ConditionFalse, inUseReason, sourceVolumes.availableMessage))
+		return
+	}
+
+	switch pusherPod.Status.Phase {
+	case corev1.PodSucceeded:
+		pushed, err := archive.ArchivedVolumes(pusherPod)
+		if err != nil {
+			ctrl.setPushFailed(vmExportCopy, err.Error())
+			return
+		}
+		names := make(map[string]string, len(sourceVolumes.volumes))
+		for _, pvc := range sourceVolumes.volumes {
+			if pvc != nil {
+				names[pvc.Name] = getVolumeName(pvc, vmExport)
+			}
+		}
+		status.PushedVolumes = nil
+		for _, volume := range pushed {
+			if name, ok := names[volume.Name]; ok {
+				volume.Name = name
+			}
+			status.PushedVolumes = append(status.PushedVolumes, volume)
+		}
+		status.Phase = exportv1.Terminated
+		status.Conditions = updateCondition(status.Conditions, newReadyCondition(corev1.ConditionFalse, pushedReason, "Volumes pushed"))
+		ctrl.Recorder.Eventf(vmExport, corev1.EventTypeNormal, volumesPushedEvent, "Pushed %d volumes to %s", len(pushed), vmExport.Spec.Destination.Endpoint)
+	case corev1.PodFailed:
+		ctrl.setPushFailed(vmExportCopy, archive.TerminationMessage(pusherPod))
+	case corev1.PodRunning:
+		status.Conditions = updateCondition(status.Conditions, newReadyCondition(corev1.ConditionFalse, pushingReason, "Pushing volumes"))
+	default:
+		status.Conditions = updateCondition(status.Conditions, newReadyCondition(corev1.ConditionFalse, podPendingReason, ""))
+	}
+}
+
+func (ctrl *VMExportController) setPushFailed(vmExport *exportv1.VirtualMachineExport, message string) {
+	vmExport.Status.Phase = exportv1.Terminated
+	vmExport.Status.Conditions = updateCondition(vmExport.Status.Conditions, newReadyCondition(corev1.ConditionFalse, pushFailedReason, message))
+	ctrl.Recorder.Eventf(vmExport, corev1.EventTypeWarning, volumesPushFailedEvent, "Failed to push volumes: %s", message)
+}
+
+// cleanupPushedExport deletes the pusher pod once the result of the push is recorded, and the export once its TTL expired
+func (ctrl *VMExportController) cleanupPushedExport(vmExport *exportv1.VirtualMachineExport) error {
+	if ttlExpiration := getExpirationTime(vmExport); !time.Now().Before(ttlExpiration) {
+		return ctrl.Client.VirtualMachineExport(vmExport.Namespace).Delete(context.Background(), vmExport.Name, metav1.DeleteOptions{})
+	}
+	pod, exists, err := ctrl.getExporterPod(vmExport)
+	if err != nil || !exists || pod.DeletionTimestamp != nil {
+		return err
+	}
+	if err := ctrl.Client.CoreV1().Pods(vmExport.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func isPushMode(vmExport *exportv1.VirtualMachineExport) bool {
+	return vmExport.Spec.Destination != nil
+}
+
+// isPushFinished returns true once the volumes of a push mode export were written, or failed to be
+func isPushFinished(vmExport *exportv1.VirtualMachineExport) bool {
+	if !isPushMode(vmExport) || vmExport.Status == nil || vmExport.Status.Phase != exportv1.Terminated {
+		return false
+	}
+	for _, condition := range vmExport.Status.Conditions {
+		if condition.Type == exportv1.ConditionReady {
+			return condition.Reason == pushedReason || condition.Reason == pushFailedReason
+		}
+	}
+	return false
+}
+
 func (ctrl *VMExportController) updateVMExportStatus(vmExport, vmExportCopy *exportv1.VirtualMachineExport) error {
 	if !equality.Semantic.DeepEqual(vmExport.Status, vmExportCopy.Status) {
 		if err := ctrl.statusUpdater.UpdateStatus(vmExportCopy); err != nil {
@@ -51,6 +51,7 @@ import (
 	"k8s.io/client-go/tools/record"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/export/archive"
 
 	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	framework "k8s.io/client-go/tools/cache/testing"
@@ -999,6 +1000,155 @@ var _ = Describe("Export controller", func() {
 		Entry("PVC name with same length as limit", strings.Repeat("a", validation.DNS1035LabelMaxLength)),
 	)
 
+	It("Should add a checksum init container when checksums are requested", func() {
+		testVMExport := createPVCVMExport()
+		testVMExport.Spec.Checksums = pointer.P(true)
+		testPVC := &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testPVCName,
+				Namespace: testNamespace,
+			},
+			Spec: k8sv1.PersistentVolumeClaimSpec{
+				VolumeMode: (*k8sv1.PersistentVolumeMode)(pointer.P(string(k8sv1.PersistentVolumeBlock))),
+			},
+		}
+		populateInitialVMExportStatus(testVMExport)
+		err := controller.handleVMExportToken(testVMExport, controller.getPVCFromSourcePVC)
+		Expect(err).ToNot(HaveOccurred())
+		service := &k8sv1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      controller.getExportServiceName(testVMExport),
+				Namespace: testNamespace,
+			},
+		}
+		pod, err := controller.createExporterPodManifest(testVMExport, service, []*k8sv1.PersistentVolumeClaim{testPVC})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+			Name: checksumsVolName,
+			VolumeSource: k8sv1.VolumeSource{
+				EmptyDir: &k8sv1.EmptyDirVolumeSource{},
+			},
+		}))
+		exportContainer := pod.Spec.Containers[0]
+		Expect(exportContainer.Env).To(ContainElement(k8sv1.EnvVar{Name: "EXPORT_CHECKSUMS_FILE", Value: checksumsFile}))
+		Expect(pod.Spec.InitContainers).To(HaveLen(1))
+		checksumContainer := pod.Spec.InitContainers[0]
+		Expect(checksumContainer.Name).To(Equal(checksumContainerName))
+		Expect(checksumContainer.Args).To(Equal([]string{ChecksumCommand}))
+		Expect(checksumContainer.ReadinessProbe).To(BeNil())
+		Expect(checksumContainer.TerminationMessagePath).To(Equal(ChecksumTerminationMessagePath))
+		Expect(checksumContainer.Env).To(Equal(exportContainer.Env))
+		Expect(checksumContainer.VolumeMounts).To(Equal(exportContainer.VolumeMounts))
+		Expect(checksumContainer.VolumeDevices).To(Equal(exportContainer.VolumeDevices))
+	})
+
+	It("Should create a pusher pod when the export has a destination", func() {
+		testVMExport := createPVCVMExport()
+		testVMExport.Spec.Destination = createTestDestination()
+		testPVC := &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testPVCName,
+				Namespace: testNamespace,
+			},
+			Spec: k8sv1.PersistentVolumeClaimSpec{
+				VolumeMode: (*k8sv1.PersistentVolumeMode)(pointer.P(string(k8sv1.PersistentVolumeBlock))),
+			},
+		}
+		populateInitialVMExportStatus(testVMExport)
+		service := &k8sv1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      controller.getExportServiceName(testVMExport),
+				Namespace: testNamespace,
+			},
+		}
+		pod, err := controller.createExporterPodManifest(testVMExport, service, []*k8sv1.PersistentVolumeClaim{testPVC})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Name).To(Equal(controller.getExportPodName(testVMExport)))
+		Expect(pod.Spec.InitContainers).To(BeEmpty())
+		container := pod.Spec.Containers[0]
+		Expect(container.Args).To(Equal([]string{archive.ArchiveCommand}))
+		Expect(container.ReadinessProbe).To(BeNil())
+		Expect(container.TerminationMessagePath).To(Equal(archive.TerminationMessagePath))
+		Expect(container.VolumeDevices).To(ContainElement(k8sv1.VolumeDevice{
+			Name:       testPVCName,
+			DevicePath: fmt.Sprintf("%s/%s", blockVolumeMountPath, testPVCName),
+		}))
+		Expect(container.Env).To(ContainElements(
+			k8sv1.EnvVar{Name: "ARCHIVE_ENDPOINT", Value: "https://s3.example.com"},
+			k8sv1.EnvVar{Name: "ARCHIVE_BUCKET", Value: "exports"},
+			k8sv1.EnvVar{Name: "ARCHIVE_PREFIX", Value: fmt.Sprintf("backups/%s/%s", testNamespace, testVMExport.Name)},
+			k8sv1.EnvVar{Name: "VOLUME0_ARCHIVE_NAME", Value: testPVCName},
+			k8sv1.EnvVar{Name: "VOLUME0_ARCHIVE_PATH", Value: fmt.Sprintf("%s/%s", blockVolumeMountPath, testPVCName)},
+		))
+		for _, env := range container.Env {
+			Expect(env.Name).ToNot(Equal("TOKEN_FILE"))
+		}
+		Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+			Name: "credentials",
+			VolumeSource: k8sv1.VolumeSource{
+				Secret: &k8sv1.SecretVolumeSource{
+					SecretName: "s3-credentials",
+				},
+			},
+		}))
+	})
+
+	It("Should not add a checksum init container by default", func() {
+		testVMExport := createPVCVMExport()
+		populateInitialVMExportStatus(testVMExport)
+		err := controller.handleVMExportToken(testVMExport, controller.getPVCFromSourcePVC)
+		Expect(err).ToNot(HaveOccurred())
+		service := &k8sv1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      controller.getExportServiceName(testVMExport),
+				Namespace: testNamespace,
+			},
+		}
+		pod, err := controller.createExporterPodManifest(testVMExport, service, []*k8sv1.PersistentVolumeClaim{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.InitContainers).To(BeEmpty())
+	})
+
+	It("Should add a scratch volume and the converted image URIs when formats are requested", func() {
+		testVMExport := createPVCVMExport()
+		testVMExport.Spec.Formats = []exportv1.ExportVolumeFormat{exportv1.Qcow2, exportv1.Vmdk}
+		testPVC := &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testPVCName,
+				Namespace: testNamespace,
+			},
+			Spec: k8sv1.PersistentVolumeClaimSpec{
+				VolumeMode: (*k8sv1.PersistentVolumeMode)(pointer.P(string(k8sv1.PersistentVolumeBlock))),
+			},
+		}
+		populateInitialVMExportStatus(testVMExport)
+		err := controller.handleVMExportToken(testVMExport, controller.getPVCFromSourcePVC)
+		Expect(err).ToNot(HaveOccurred())
+		service := &k8sv1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      controller.getExportServiceName(testVMExport),
+				Namespace: testNamespace,
+			},
+		}
+		pod, err := controller.createExporterPodManifest(testVMExport, service, []*k8sv1.PersistentVolumeClaim{testPVC})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+			Name: scratchVolName,
+			VolumeSource: k8sv1.VolumeSource{
+				EmptyDir: &k8sv1.EmptyDirVolumeSource{},
+			},
+		}))
+		Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(k8sv1.VolumeMount{
+			Name:      scratchVolName,
+			MountPath: scratchMountPath,
+		}))
+		Expect(pod.Spec.Containers[0].Env).To(ContainElements(
+			k8sv1.EnvVar{Name: "EXPORT_SCRATCH_PATH", Value: scratchMountPath},
+			k8sv1.EnvVar{Name: "VOLUME0_EXPORT_QCOW2_URI", Value: qcow2URI(testPVC)},
+			k8sv1.EnvVar{Name: "VOLUME0_EXPORT_VMDK_URI", Value: vmdkURI(testPVC)},
+		))
+	})
+
 	DescribeTable("service name should be sanitized", func(exportName, expectedServiceName string) {
 		var service *k8sv1.Service
 		testVMExport := createPVCVMExport()
@@ -1768,6 +1918,16 @@ func createBackendPVC(vmName string) *k8sv1.PersistentVolumeClaim {
 	}
 }
 
+func createTestDestination() *exportv1.ObjectStorageDestination {
+	return &exportv1.ObjectStorageDestination{
+		Provider:             exportv1.ObjectStorageS3,
+		Endpoint:             "https://s3.example.com",
+		Bucket:               "exports",
+		Prefix:               "backups",
+		CredentialsSecretRef: "s3-credentials",
+	}
+}
+
 func expectExporterCreate(k8sClient *k8sfake.Clientset, phase k8sv1.PodPhase) {
 	k8sClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
 		create, ok := action.(testing.CreateAction)
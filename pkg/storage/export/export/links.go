@@ -97,6 +97,9 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 			Url:  scheme + path.Join(hostAndBase, linkType, paths.SecretURI),
 		})
 	}
+	if paths.OvaURI != "" && exporterPod.Status.Phase == corev1.PodRunning {
+		exportLink.Ova = scheme + path.Join(hostAndBase, paths.OvaURI)
+	}
 
 	for _, pvc := range pvcs {
 		if pvc == nil || exporterPod.Status.Phase != corev1.PodRunning {
@@ -125,6 +128,18 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 				Url:    scheme + path.Join(hostAndBase, volumeInfo.RawGzURI),
 			})
 		}
+		if volumeInfo.Qcow2URI != "" {
+			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.Qcow2,
+				Url:    scheme + path.Join(hostAndBase, volumeInfo.Qcow2URI),
+			})
+		}
+		if volumeInfo.VmdkURI != "" {
+			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.Vmdk,
+				Url:    scheme + path.Join(hostAndBase, volumeInfo.VmdkURI),
+			})
+		}
 		if volumeInfo.DirURI != "" {
 			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
 				Format: exportv1.Dir,
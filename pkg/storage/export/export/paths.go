@@ -34,13 +34,20 @@ type VolumeInfo struct {
 	DirURI     string
 	RawURI     string
 	RawGzURI   string
+	Qcow2URI   string
+	VmdkURI    string
 }
 
 // ServerPaths contains static paths and per-volume paths
 type ServerPaths struct {
 	VMURI     string
 	SecretURI string
-	Volumes   []VolumeInfo
+	OvaURI    string
+	// ScratchPath is the directory the images are converted in
+	ScratchPath string
+	// ChecksumsFile holds the checksums of the disk images, computed before the server starts
+	ChecksumsFile string
+	Volumes       []VolumeInfo
 }
 
 // EnvironToMap converts the environment variables to a map
@@ -65,8 +72,11 @@ func ContainerEnvToMap(env []corev1.EnvVar) map[string]string {
 // CreateServerPaths creates a ServerPaths object from the environment variables
 func CreateServerPaths(env map[string]string) *ServerPaths {
 	result := &ServerPaths{
-		VMURI:     env["EXPORT_VM_DEF_URI"],
-		SecretURI: env["EXPORT_SECRET_DEF_URI"],
+		VMURI:         env["EXPORT_VM_DEF_URI"],
+		SecretURI:     env["EXPORT_SECRET_DEF_URI"],
+		OvaURI:        env["EXPORT_VM_OVA_URI"],
+		ScratchPath:   env["EXPORT_SCRATCH_PATH"],
+		ChecksumsFile: env["EXPORT_CHECKSUMS_FILE"],
 	}
 	for k, v := range env {
 		if strings.HasSuffix(k, "_EXPORT_PATH") {
@@ -77,6 +87,8 @@ func CreateServerPaths(env map[string]string) *ServerPaths {
 				DirURI:     env[envPrefix+"_EXPORT_DIR_URI"],
 				RawURI:     env[envPrefix+"_EXPORT_RAW_URI"],
 				RawGzURI:   env[envPrefix+"_EXPORT_RAW_GZIP_URI"],
+				Qcow2URI:   env[envPrefix+"_EXPORT_QCOW2_URI"],
+				VmdkURI:    env[envPrefix+"_EXPORT_VMDK_URI"],
 			}
 			result.Volumes = append(result.Volumes, vi)
 		}
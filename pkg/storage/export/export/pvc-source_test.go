@@ -50,6 +50,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
@@ -277,6 +278,166 @@ var _ = Describe("PVC source", func() {
 		Expect(service.Name).To(Equal(fmt.Sprintf("%s-%s", exportPrefix, testVMExport.Name)))
 	})
 
+	It("Should properly update VMExport status with converted image links when formats are requested", func() {
+		testVMExport := createPVCVMExport()
+		testVMExport.Spec.Formats = []exportv1.ExportVolumeFormat{exportv1.Qcow2, exportv1.Vmdk}
+		pvcInformer.GetStore().Add(createPVC(testPVCName, "kubevirt"))
+		expectExporterCreate(k8sClient, k8sv1.PodRunning)
+
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			Expect(vmExport.Status.Links).ToNot(BeNil())
+			Expect(vmExport.Status.Links.Internal).ToNot(BeNil())
+			Expect(vmExport.Status.Links.Internal.Volumes).To(HaveLen(1))
+			Expect(vmExport.Status.Links.Internal.Volumes[0].Formats).To(ContainElements(
+				exportv1.VirtualMachineExportVolumeFormat{
+					Format: exportv1.Qcow2,
+					Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.qcow2", controller.getExportServiceName(vmExport), testNamespace, testPVCName),
+				},
+				exportv1.VirtualMachineExportVolumeFormat{
+					Format: exportv1.Vmdk,
+					Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.vmdk", controller.getExportServiceName(vmExport), testNamespace, testPVCName),
+				},
+			))
+			return true, vmExport, nil
+		})
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+	})
+
+	DescribeTable("Should publish the checksums reported by the checksum container", func(exitCode int32, message string, expected []exportv1.ExportedVolumeChecksum) {
+		testVMExport := createPVCVMExport()
+		testVMExport.Spec.Checksums = pointer.P(true)
+		pvcInformer.GetStore().Add(createPVC(testPVCName, "kubevirt"))
+		k8sClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			create, ok := action.(testing.CreateAction)
+			Expect(ok).To(BeTrue())
+			exportPod, ok := create.GetObject().(*k8sv1.Pod)
+			Expect(ok).To(BeTrue())
+			exportPod.Status = k8sv1.PodStatus{
+				Phase: k8sv1.PodRunning,
+				InitContainerStatuses: []k8sv1.ContainerStatus{{
+					Name: checksumContainerName,
+					State: k8sv1.ContainerState{
+						Terminated: &k8sv1.ContainerStateTerminated{
+							ExitCode: exitCode,
+							Message:  message,
+						},
+					},
+				}},
+			}
+			return true, exportPod, nil
+		})
+
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			Expect(vmExport.Status.Phase).To(Equal(exportv1.Ready))
+			Expect(vmExport.Status.VolumeChecksums).To(Equal(expected))
+			return true, vmExport, nil
+		})
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+	},
+		Entry("with checksums", int32(0),
+			fmt.Sprintf(`[{"name":"%s","size":1024,"checksum":"abc"},{"name":"other","size":1,"checksum":"def"}]`, testPVCName),
+			[]exportv1.ExportedVolumeChecksum{{Name: testPVCName, Size: 1024, Checksum: "abc"}},
+		),
+		Entry("with invalid message", int32(0), "not json", nil),
+		Entry("with failed container", int32(1), "read failed", nil),
+	)
+
+	DescribeTable("Should record the result of pushing the volumes", func(phase k8sv1.PodPhase, message string, expectedReason string, expected []exportv1.ArchivedVolume) {
+		testVMExport := createPVCVMExport()
+		testVMExport.Spec.Destination = createTestDestination()
+		pvcInformer.GetStore().Add(createPVC(testPVCName, "kubevirt"))
+		k8sClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			create, ok := action.(testing.CreateAction)
+			Expect(ok).To(BeTrue())
+			exportPod, ok := create.GetObject().(*k8sv1.Pod)
+			Expect(ok).To(BeTrue())
+			exportPod.Status = k8sv1.PodStatus{
+				Phase: phase,
+				ContainerStatuses: []k8sv1.ContainerStatus{{
+					State: k8sv1.ContainerState{
+						Terminated: &k8sv1.ContainerStateTerminated{
+							Message: message,
+						},
+					},
+				}},
+			}
+			return true, exportPod, nil
+		})
+		k8sClient.Fake.PrependReactor("delete", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			Fail("the pusher pod should be kept until the result is recorded")
+			return true, nil, nil
+		})
+
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			Expect(vmExport.Status.Phase).To(Equal(exportv1.Terminated))
+			Expect(vmExport.Status.PushedVolumes).To(Equal(expected))
+			Expect(vmExport.Status.Links.Internal).To(BeNil())
+			Expect(vmExport.Status.Links.External).To(BeNil())
+			for _, condition := range vmExport.Status.Conditions {
+				if condition.Type == exportv1.ConditionReady {
+					Expect(condition.Reason).To(Equal(expectedReason))
+				}
+			}
+			Expect(isPushFinished(vmExport)).To(BeTrue())
+			return true, vmExport, nil
+		})
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+	},
+		Entry("with pushed volumes", k8sv1.PodSucceeded,
+			fmt.Sprintf(`[{"name":"%s","objectName":"prefix/%s.img","size":1024,"checksum":"abc"}]`, testPVCName, testPVCName),
+			pushedReason,
+			[]exportv1.ArchivedVolume{{Name: testPVCName, ObjectName: fmt.Sprintf("prefix/%s.img", testPVCName), Size: 1024, Checksum: "abc"}},
+		),
+		Entry("with invalid message", k8sv1.PodSucceeded, "not json", pushFailedReason, nil),
+		Entry("with failed push", k8sv1.PodFailed, "access denied", pushFailedReason, nil),
+	)
+
+	It("Should delete the pusher pod and not recreate it once the push finished", func() {
+		testVMExport := createPVCVMExport()
+		testVMExport.Spec.Destination = createTestDestination()
+		populateInitialVMExportStatus(testVMExport)
+		testVMExport.Status.Phase = exportv1.Terminated
+		testVMExport.Status.Conditions = updateCondition(testVMExport.Status.Conditions, newReadyCondition(k8sv1.ConditionFalse, pushedReason, ""))
+		pvcInformer.GetStore().Add(createPVC(testPVCName, "kubevirt"))
+		podInformer.GetStore().Add(&k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      controller.getExportPodName(testVMExport),
+				Namespace: testNamespace,
+			},
+			Status: k8sv1.PodStatus{
+				Phase: k8sv1.PodSucceeded,
+			},
+		})
+		k8sClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			Fail("the pusher pod should not be recreated")
+			return true, nil, nil
+		})
+		expectExporterDelete(k8sClient, controller.getExportPodName(testVMExport))
+
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+		Expect(k8sClient.Actions()).To(ContainElement(BeAssignableToTypeOf(testing.DeleteActionImpl{})))
+	})
+
 	It("Should properly update VMExport status with a valid token and no pvc, pending pod", func() {
 		testVMExport := createPVCVMExport()
 		expectExporterCreate(k8sClient, k8sv1.PodPending)
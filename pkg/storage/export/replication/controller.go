@@ -0,0 +1,575 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package replication
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	validation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/snapshot"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	// TerminationMessagePath is where the replicator container reports the replicated volumes
+	TerminationMessagePath = "/dev/termination-log"
+
+	replicatorPrefix = "virt-replicator"
+
+	tokenPath        = "/token"
+	tokenVolume      = "token"
+	tokenKey         = "token"
+	kubeconfigPath   = "/kubeconfig"
+	kubeconfigVolume = "kubeconfig"
+	kubeconfigKey    = "kubeconfig"
+
+	vmSnapshotIndex = "vmsnapshot"
+
+	// exportTTL keeps the export available for as long as the replicator may wait for the peer cluster
+	exportTTL = ReplicateTimeout
+
+	snapshotNotReadyReason = "SnapshotNotReady"
+	exportNotReadyReason   = "ExportNotReady"
+	inProgressReason       = "InProgress"
+	succeededReason        = "Succeeded"
+	failedReason           = "Failed"
+
+	exportCreatedEvent        = "VirtualMachineExportCreated"
+	replicatorPodCreatedEvent = "ReplicatorPodCreated"
+	snapshotReplicatedEvent   = "SnapshotReplicated"
+	snapshotReplicationFailed = "SnapshotReplicationFailed"
+)
+
+var replicationGVK = exportv1.SchemeGroupVersion.WithKind("VirtualMachineSnapshotReplication")
+
+var currentTime = func() *metav1.Time {
+	t := metav1.Now()
+	return &t
+}
+
+type manifestRenderer interface {
+	RenderSnapshotReplicatorManifest(replication *exportv1.VirtualMachineSnapshotReplication, namePrefix string) *corev1.Pod
+}
+
+// VMSnapshotReplicationController replicates VirtualMachineSnapshots to peer clusters, serving
+// the volumes through a VirtualMachineExport the peer cluster imports them from
+type VMSnapshotReplicationController struct {
+	Client kubecli.KubevirtClient
+
+	ManifestRenderer manifestRenderer
+
+	VMSnapshotReplicationInformer cache.SharedIndexInformer
+	VMSnapshotInformer            cache.SharedIndexInformer
+	VMExportInformer              cache.SharedIndexInformer
+	PodInformer                   cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	replicationQueue workqueue.TypedRateLimitingInterface[string]
+}
+
+// Init initializes the snapshot replication controller
+func (ctrl *VMSnapshotReplicationController) Init() error {
+	ctrl.replicationQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-export-vmsnapshotreplication"},
+	)
+
+	_, err := ctrl.VMSnapshotReplicationInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleReplication,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleReplication(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+	for _, informer := range []cache.SharedIndexInformer{ctrl.VMExportInformer, ctrl.PodInformer} {
+		_, err = informer.AddEventHandler(
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    ctrl.handleOwnedObject,
+				UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleOwnedObject(newObj) },
+				DeleteFunc: ctrl.handleOwnedObject,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = ctrl.VMSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshot(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshot,
+		},
+	)
+	return err
+}
+
+// Run the controller
+func (ctrl *VMSnapshotReplicationController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.replicationQueue.ShutDown()
+
+	log.Log.Info("Starting snapshot replication controller.")
+	defer log.Log.Info("Shutting down snapshot replication controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMSnapshotReplicationInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMExportInformer.HasSynced,
+		ctrl.PodInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.replicationWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMSnapshotReplicationController) replicationWorker() {
+	for ctrl.processReplicationWorkItem() {
+	}
+}
+
+func (ctrl *VMSnapshotReplicationController) processReplicationWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.replicationQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmSnapshotReplication worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMSnapshotReplicationInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		replication, ok := storeObj.(*exportv1.VirtualMachineSnapshotReplication)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+
+		return 0, ctrl.updateReplication(replication.DeepCopy())
+	})
+}
+
+func (ctrl *VMSnapshotReplicationController) handleReplication(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if replication, ok := obj.(*exportv1.VirtualMachineSnapshotReplication); ok {
+		key, err := cache.MetaNamespaceKeyFunc(replication)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", replication, err)
+			return
+		}
+		log.Log.V(3).Infof("enqueued %q for sync", key)
+		ctrl.replicationQueue.Add(key)
+	}
+}
+
+func (ctrl *VMSnapshotReplicationController) handleOwnedObject(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	o, ok := obj.(metav1.Object)
+	if !ok {
+		return
+	}
+	ownerRef := metav1.GetControllerOf(o)
+	if ownerRef == nil || ownerRef.Kind != replicationGVK.Kind || ownerRef.APIVersion != replicationGVK.GroupVersion().String() {
+		return
+	}
+	key := controller.NamespacedKey(o.GetNamespace(), ownerRef.Name)
+	log.Log.V(3).Infof("Adding VMSnapshotReplication due to owned object %s", key)
+	ctrl.replicationQueue.Add(key)
+}
+
+func (ctrl *VMSnapshotReplicationController) handleVMSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+	if !ok {
+		return
+	}
+	keys, err := ctrl.VMSnapshotReplicationInformer.GetIndexer().IndexKeys(vmSnapshotIndex, controller.NamespacedKey(vmSnapshot.Namespace, vmSnapshot.Name))
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	for _, key := range keys {
+		log.Log.V(3).Infof("Adding VMSnapshotReplication due to snapshot %s", key)
+		ctrl.replicationQueue.Add(key)
+	}
+}
+
+func (ctrl *VMSnapshotReplicationController) updateReplication(replication *exportv1.VirtualMachineSnapshotReplication) error {
+	log.Log.V(3).Infof("Updating VirtualMachineSnapshotReplication %s/%s", replication.Namespace, replication.Name)
+
+	if replication.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if isFinished(replication) {
+		return ctrl.cleanup(replication)
+	}
+
+	replicationCopy := replication.DeepCopy()
+	if replicationCopy.Status == nil {
+		replicationCopy.Status = &exportv1.VirtualMachineSnapshotReplicationStatus{
+			Phase: exportv1.SnapshotReplicationPending,
+		}
+	}
+
+	if err := ctrl.replicate(replicationCopy); err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(replication.Status, replicationCopy.Status) {
+		return nil
+	}
+	_, err := ctrl.Client.VirtualMachineSnapshotReplication(replicationCopy.Namespace).UpdateStatus(context.Background(), replicationCopy, metav1.UpdateOptions{})
+	return err
+}
+
+// replicate progresses the replication of the snapshot, recording it in the status of the replication
+func (ctrl *VMSnapshotReplicationController) replicate(replication *exportv1.VirtualMachineSnapshotReplication) error {
+	status := replication.Status
+	vmSnapshot, err := ctrl.getReadySnapshot(replication)
+	if err != nil {
+		return err
+	}
+	if vmSnapshot == nil {
+		status.Phase = exportv1.SnapshotReplicationPending
+		status.Conditions = updateCondition(status.Conditions, newReadyCondition(corev1.ConditionFalse, snapshotNotReadyReason,
+			fmt.Sprintf("VirtualMachineSnapshot %s is not ready to use", replication.Spec.VirtualMachineSnapshotName)))
+		return nil
+	}
+	status.VirtualMachineName = pointer.P(vmSnapshot.Spec.Source.Name)
+
+	pod, exists, err := ctrl.getReplicatorPod(replication)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		vmExport, err := ctrl.getOrCreateExport(replication)
+		if err != nil {
+			return err
+		}
+		status.VirtualMachineExportName = pointer.P(vmExport.Name)
+
+		link, message := getExternalLink(vmExport)
+		if link == nil {
+			status.Phase = exportv1.SnapshotReplicationExporting
+			status.Conditions = updateCondition(status.Conditions, newReadyCondition(corev1.ConditionFalse, exportNotReadyReason, message))
+			return nil
+		}
+
+		pod, err = ctrl.Client.CoreV1().Pods(replication.Namespace).Create(context.Background(), ctrl.createReplicatorPodManifest(replication, vmExport, link), metav1.CreateOptions{})
+		if err != nil {
+			return err
+		}
+		ctrl.Recorder.Eventf(replication, corev1.EventTypeNormal, replicatorPodCreatedEvent, "Created replicator pod %s/%s", pod.Namespace, pod.Name)
+	}
+
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		var volumes []exportv1.ReplicatedVolume
+		if err := json.Unmarshal([]byte(terminationMessage(pod)), &volumes); err != nil {
+			ctrl.setFailed(replication, fmt.Sprintf("failed to read the replicated volumes: %v", err))
+			return nil
+		}
+		status.Volumes = volumes
+		status.CompletionTime = currentTime()
+		status.Phase = exportv1.SnapshotReplicationSucceeded
+		status.Conditions = updateCondition(status.Conditions, newReadyCondition(corev1.ConditionTrue, succeededReason, "Snapshot replicated"))
+		ctrl.Recorder.Eventf(replication, corev1.EventTypeNormal, snapshotReplicatedEvent, "Replicated VirtualMachineSnapshot %s to the peer cluster", vmSnapshot.Name)
+	case corev1.PodFailed:
+		ctrl.setFailed(replication, terminationMessage(pod))
+	default:
+		status.Phase = exportv1.SnapshotReplicationReplicating
+		status.Conditions = updateCondition(status.Conditions, newReadyCondition(corev1.ConditionFalse, inProgressReason, "Peer cluster importing volumes"))
+	}
+	return nil
+}
+
+func (ctrl *VMSnapshotReplicationController) setFailed(replication *exportv1.VirtualMachineSnapshotReplication, message string) {
+	replication.Status.Phase = exportv1.SnapshotReplicationFailed
+	replication.Status.CompletionTime = currentTime()
+	replication.Status.Conditions = updateCondition(replication.Status.Conditions, newReadyCondition(corev1.ConditionFalse, failedReason, message))
+	ctrl.Recorder.Eventf(replication, corev1.EventTypeWarning, snapshotReplicationFailed, "Failed to replicate VirtualMachineSnapshot %s: %s", replication.Spec.VirtualMachineSnapshotName, message)
+}
+
+// getReadySnapshot returns the snapshot to replicate once it is ready to use
+func (ctrl *VMSnapshotReplicationController) getReadySnapshot(replication *exportv1.VirtualMachineSnapshotReplication) (*snapshotv1.VirtualMachineSnapshot, error) {
+	obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(controller.NamespacedKey(replication.Namespace, replication.Spec.VirtualMachineSnapshotName))
+	if err != nil || !exists {
+		return nil, err
+	}
+	vmSnapshot := obj.(*snapshotv1.VirtualMachineSnapshot)
+	if !snapshot.VmSnapshotReady(vmSnapshot) {
+		return nil, nil
+	}
+	return vmSnapshot, nil
+}
+
+func (ctrl *VMSnapshotReplicationController) getReplicatorPod(replication *exportv1.VirtualMachineSnapshotReplication) (*corev1.Pod, bool, error) {
+	obj, exists, err := ctrl.PodInformer.GetStore().GetByKey(controller.NamespacedKey(replication.Namespace, getReplicatorPodName(replication)))
+	if err != nil || !exists {
+		return nil, exists, err
+	}
+	return obj.(*corev1.Pod), true, nil
+}
+
+// getOrCreateExport returns the VirtualMachineExport serving the snapshot to the peer cluster
+func (ctrl *VMSnapshotReplicationController) getOrCreateExport(replication *exportv1.VirtualMachineSnapshotReplication) (*exportv1.VirtualMachineExport, error) {
+	obj, exists, err := ctrl.VMExportInformer.GetStore().GetByKey(controller.NamespacedKey(replication.Namespace, getExportName(replication)))
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return obj.(*exportv1.VirtualMachineExport), nil
+	}
+
+	vmExport := &exportv1.VirtualMachineExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getExportName(replication),
+			Namespace: replication.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(replication, replicationGVK),
+			},
+		},
+		Spec: exportv1.VirtualMachineExportSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(snapshotv1.SchemeGroupVersion.Group),
+				Kind:     "VirtualMachineSnapshot",
+				Name:     replication.Spec.VirtualMachineSnapshotName,
+			},
+			TTLDuration: &metav1.Duration{Duration: exportTTL},
+		},
+	}
+	vmExport, err = ctrl.Client.VirtualMachineExport(replication.Namespace).Create(context.Background(), vmExport, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ctrl.Recorder.Eventf(replication, corev1.EventTypeNormal, exportCreatedEvent, "Created VirtualMachineExport %s/%s", vmExport.Namespace, vmExport.Name)
+	return vmExport, nil
+}
+
+// getExternalLink returns the external link of the export once the peer cluster can import from it,
+// or a message telling what is missing
+func getExternalLink(vmExport *exportv1.VirtualMachineExport) (*exportv1.VirtualMachineExportLink, string) {
+	if vmExport.Status == nil || vmExport.Status.Phase != exportv1.Ready || vmExport.Status.TokenSecretRef == nil {
+		return nil, fmt.Sprintf("Waiting for VirtualMachineExport %s to be ready", vmExport.Name)
+	}
+	if vmExport.Status.Links == nil || vmExport.Status.Links.External == nil ||
+		getManifestURL(vmExport.Status.Links.External, exportv1.AllManifests) == "" ||
+		getManifestURL(vmExport.Status.Links.External, exportv1.AuthHeader) == "" {
+		return nil, fmt.Sprintf("VirtualMachineExport %s has no external link, an ingress or route is required", vmExport.Name)
+	}
+	return vmExport.Status.Links.External, ""
+}
+
+func getManifestURL(link *exportv1.VirtualMachineExportLink, manifestType exportv1.ExportManifestType) string {
+	for _, manifest := range link.Manifests {
+		if manifest.Type == manifestType {
+			return manifest.Url
+		}
+	}
+	return ""
+}
+
+func (ctrl *VMSnapshotReplicationController) createReplicatorPodManifest(replication *exportv1.VirtualMachineSnapshotReplication, vmExport *exportv1.VirtualMachineExport, link *exportv1.VirtualMachineExportLink) *corev1.Pod {
+	destination := replication.Spec.Destination
+	podManifest := ctrl.ManifestRenderer.RenderSnapshotReplicatorManifest(replication, replicatorPrefix)
+	podManifest.Spec.SecurityContext = &corev1.PodSecurityContext{
+		RunAsNonRoot:   pointer.P(true),
+		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+
+	namespace := destination.Namespace
+	if namespace == "" {
+		namespace = replication.Namespace
+	}
+	var storageClassName string
+	if destination.StorageClassName != nil {
+		storageClassName = *destination.StorageClassName
+	}
+
+	container := &podManifest.Spec.Containers[0]
+	container.Args = []string{ReplicateCommand}
+	container.TerminationMessagePath = TerminationMessagePath
+	container.TerminationMessagePolicy = corev1.TerminationMessageFallbackToLogsOnError
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  manifestURLEnv,
+		Value: getManifestURL(link, exportv1.AllManifests),
+	}, corev1.EnvVar{
+		Name:  secretURLEnv,
+		Value: getManifestURL(link, exportv1.AuthHeader),
+	}, corev1.EnvVar{
+		Name:  certEnv,
+		Value: link.Cert,
+	}, corev1.EnvVar{
+		Name:  tokenFileEnv,
+		Value: path.Join(tokenPath, tokenKey),
+	}, corev1.EnvVar{
+		Name:  kubeconfigEnv,
+		Value: path.Join(kubeconfigPath, kubeconfigKey),
+	}, corev1.EnvVar{
+		Name:  namespaceEnv,
+		Value: namespace,
+	}, corev1.EnvVar{
+		Name:  vmNameEnv,
+		Value: destination.VirtualMachineName,
+	}, corev1.EnvVar{
+		Name:  storageClassEnv,
+		Value: storageClassName,
+	})
+
+	podManifest.Spec.Volumes = append(podManifest.Spec.Volumes, corev1.Volume{
+		Name: tokenVolume,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: *vmExport.Status.TokenSecretRef,
+			},
+		},
+	}, corev1.Volume{
+		Name: kubeconfigVolume,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: destination.KubeconfigSecretRef,
+			},
+		},
+	})
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      tokenVolume,
+		ReadOnly:  true,
+		MountPath: tokenPath,
+	}, corev1.VolumeMount{
+		Name:      kubeconfigVolume,
+		ReadOnly:  true,
+		MountPath: kubeconfigPath,
+	})
+
+	return podManifest
+}
+
+// cleanup deletes the replicator pod and the export once the replication finished
+func (ctrl *VMSnapshotReplicationController) cleanup(replication *exportv1.VirtualMachineSnapshotReplication) error {
+	pod, exists, err := ctrl.getReplicatorPod(replication)
+	if err != nil {
+		return err
+	}
+	if exists && pod.DeletionTimestamp == nil {
+		if err := ctrl.Client.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	obj, exists, err := ctrl.VMExportInformer.GetStore().GetByKey(controller.NamespacedKey(replication.Namespace, getExportName(replication)))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	vmExport := obj.(*exportv1.VirtualMachineExport)
+	if vmExport.DeletionTimestamp != nil || !metav1.IsControlledBy(vmExport, replication) {
+		return nil
+	}
+	err = ctrl.Client.VirtualMachineExport(vmExport.Namespace).Delete(context.Background(), vmExport.Name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func isFinished(replication *exportv1.VirtualMachineSnapshotReplication) bool {
+	return replication.Status != nil &&
+		(replication.Status.Phase == exportv1.SnapshotReplicationSucceeded || replication.Status.Phase == exportv1.SnapshotReplicationFailed)
+}
+
+func getReplicatorPodName(replication *exportv1.VirtualMachineSnapshotReplication) string {
+	return naming.GetName(replicatorPrefix, replication.Name, validation.DNS1035LabelMaxLength)
+}
+
+func getExportName(replication *exportv1.VirtualMachineSnapshotReplication) string {
+	return naming.GetName(replication.Name, "replication", validation.DNS1035LabelMaxLength)
+}
+
+// terminationMessage returns the message the replicator container terminated with
+func terminationMessage(pod *corev1.Pod) string {
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.State.Terminated != nil {
+			return containerStatus.State.Terminated.Message
+		}
+	}
+	return ""
+}
+
+func newReadyCondition(status corev1.ConditionStatus, reason, message string) exportv1.Condition {
+	return exportv1.Condition{
+		Type:               exportv1.ConditionReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: *currentTime(),
+	}
+}
+
+func updateCondition(conditions []exportv1.Condition, c exportv1.Condition) []exportv1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == c.Type {
+			if conditions[i].Status != c.Status || conditions[i].Reason != c.Reason || conditions[i].Message != c.Message {
+				conditions[i] = c
+			}
+			return conditions
+		}
+	}
+	return append(conditions, c)
+}
@@ -0,0 +1,348 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package replication
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+)
+
+const (
+	testNamespace       = "default"
+	testReplicationName = "replication"
+	testSnapshotName    = "snapshot"
+	testExportName      = "replication-replication"
+	testTokenSecretName = "export-token"
+
+	testManifestURL = "https://export.example.com/api/export.kubevirt.io/v1beta1/namespaces/default/virtualmachineexports/replication-replication/external/manifests/all"
+	testSecretURL   = "https://export.example.com/api/export.kubevirt.io/v1beta1/namespaces/default/virtualmachineexports/replication-replication/external/manifests/secret"
+)
+
+var _ = Describe("Snapshot replication controller", func() {
+	var (
+		controller     *VMSnapshotReplicationController
+		k8sClient      *k8sfake.Clientset
+		kubevirtClient *kubevirtfake.Clientset
+		recorder       *record.FakeRecorder
+		timeStamp      = metav1.Now()
+	)
+
+	BeforeEach(func() {
+		currentTime = func() *metav1.Time {
+			return &timeStamp
+		}
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		replicationInformer, _ := testutils.NewFakeInformerWithIndexersFor(&exportv1.VirtualMachineSnapshotReplication{}, virtcontroller.GetVirtualMachineSnapshotReplicationInformerIndexers())
+		vmSnapshotInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+		vmExportInformer, _ := testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
+		podInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		rqInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
+		nsInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{})
+
+		k8sClient = k8sfake.NewSimpleClientset()
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		recorder = record.NewFakeRecorder(100)
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshotReplication(testNamespace).
+			Return(kubevirtClient.ExportV1beta1().VirtualMachineSnapshotReplications(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineExport(testNamespace).
+			Return(kubevirtClient.ExportV1beta1().VirtualMachineExports(testNamespace)).AnyTimes()
+
+		controller = &VMSnapshotReplicationController{
+			Client:                        virtClient,
+			ManifestRenderer:              services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, 107, "g", rqInformer.GetStore(), nsInformer.GetStore()),
+			VMSnapshotReplicationInformer: replicationInformer,
+			VMSnapshotInformer:            vmSnapshotInformer,
+			VMExportInformer:              vmExportInformer,
+			PodInformer:                   podInformer,
+			Recorder:                      recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		currentTime = func() *metav1.Time {
+			t := metav1.Now()
+			return &t
+		}
+	})
+
+	newReplication := func() *exportv1.VirtualMachineSnapshotReplication {
+		return &exportv1.VirtualMachineSnapshotReplication{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testReplicationName,
+				Namespace: testNamespace,
+				UID:       "replication-uid",
+			},
+			Spec: exportv1.VirtualMachineSnapshotReplicationSpec{
+				VirtualMachineSnapshotName: testSnapshotName,
+				Destination: exportv1.PeerClusterDestination{
+					KubeconfigSecretRef: "peer",
+					VirtualMachineName:  "vm-standby",
+					StorageClassName:    pointer.P("standby-storage"),
+				},
+			},
+		}
+	}
+
+	addReplication := func(replication *exportv1.VirtualMachineSnapshotReplication) {
+		Expect(controller.VMSnapshotReplicationInformer.GetStore().Add(replication)).To(Succeed())
+		_, err := kubevirtClient.ExportV1beta1().VirtualMachineSnapshotReplications(testNamespace).Create(context.Background(), replication, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addReadySnapshot := func() {
+		Expect(controller.VMSnapshotInformer.GetStore().Add(&snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: testSnapshotName, Namespace: testNamespace},
+			Spec: snapshotv1.VirtualMachineSnapshotSpec{
+				Source: k8sv1.TypedLocalObjectReference{Kind: "VirtualMachine", Name: "vm"},
+			},
+			Status: &snapshotv1.VirtualMachineSnapshotStatus{
+				Phase:      snapshotv1.Succeeded,
+				ReadyToUse: pointer.P(true),
+			},
+		})).To(Succeed())
+	}
+
+	readyExport := func(links *exportv1.VirtualMachineExportLinks) *exportv1.VirtualMachineExport {
+		replication := newReplication()
+		return &exportv1.VirtualMachineExport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            testExportName,
+				Namespace:       testNamespace,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(replication, replicationGVK)},
+			},
+			Status: &exportv1.VirtualMachineExportStatus{
+				Phase:          exportv1.Ready,
+				TokenSecretRef: pointer.P(testTokenSecretName),
+				Links:          links,
+			},
+		}
+	}
+
+	externalLinks := func() *exportv1.VirtualMachineExportLinks {
+		return &exportv1.VirtualMachineExportLinks{
+			External: &exportv1.VirtualMachineExportLink{
+				Cert: "cert",
+				Manifests: []exportv1.VirtualMachineExportManifest{
+					{Type: exportv1.AllManifests, Url: testManifestURL},
+					{Type: exportv1.AuthHeader, Url: testSecretURL},
+				},
+			},
+		}
+	}
+
+	getStatus := func() *exportv1.VirtualMachineSnapshotReplicationStatus {
+		replication, err := kubevirtClient.ExportV1beta1().VirtualMachineSnapshotReplications(testNamespace).Get(context.Background(), testReplicationName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return replication.Status
+	}
+
+	terminatedPod := func(phase k8sv1.PodPhase, message string) *k8sv1.Pod {
+		return &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: getReplicatorPodName(newReplication()), Namespace: testNamespace},
+			Status: k8sv1.PodStatus{
+				Phase: phase,
+				ContainerStatuses: []k8sv1.ContainerStatus{{
+					State: k8sv1.ContainerState{
+						Terminated: &k8sv1.ContainerStateTerminated{Message: message},
+					},
+				}},
+			},
+		}
+	}
+
+	It("should wait for the snapshot to be ready", func() {
+		replication := newReplication()
+		addReplication(replication)
+
+		Expect(controller.updateReplication(replication)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(exportv1.SnapshotReplicationPending))
+		Expect(status.Conditions).To(ConsistOf(newReadyCondition(k8sv1.ConditionFalse, snapshotNotReadyReason, "VirtualMachineSnapshot snapshot is not ready to use")))
+
+		exports, err := kubevirtClient.ExportV1beta1().VirtualMachineExports(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exports.Items).To(BeEmpty())
+	})
+
+	It("should export the snapshot", func() {
+		replication := newReplication()
+		addReplication(replication)
+		addReadySnapshot()
+
+		Expect(controller.updateReplication(replication)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(exportv1.SnapshotReplicationExporting))
+		Expect(status.VirtualMachineName).To(HaveValue(Equal("vm")))
+		Expect(status.VirtualMachineExportName).To(HaveValue(Equal(testExportName)))
+		testutils.ExpectEvent(recorder, exportCreatedEvent)
+
+		vmExport, err := kubevirtClient.ExportV1beta1().VirtualMachineExports(testNamespace).Get(context.Background(), testExportName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(metav1.IsControlledBy(vmExport, replication)).To(BeTrue())
+		Expect(vmExport.Spec.Source).To(Equal(k8sv1.TypedLocalObjectReference{
+			APIGroup: pointer.P(snapshotv1.SchemeGroupVersion.Group),
+			Kind:     "VirtualMachineSnapshot",
+			Name:     testSnapshotName,
+		}))
+	})
+
+	It("should wait for the external link of the export", func() {
+		replication := newReplication()
+		addReplication(replication)
+		addReadySnapshot()
+		Expect(controller.VMExportInformer.GetStore().Add(readyExport(&exportv1.VirtualMachineExportLinks{}))).To(Succeed())
+
+		Expect(controller.updateReplication(replication)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(exportv1.SnapshotReplicationExporting))
+		Expect(status.Conditions).To(ConsistOf(newReadyCondition(k8sv1.ConditionFalse, exportNotReadyReason,
+			"VirtualMachineExport replication-replication has no external link, an ingress or route is required")))
+
+		pods, err := k8sClient.CoreV1().Pods(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pods.Items).To(BeEmpty())
+	})
+
+	It("should start the replicator pod once the export is ready", func() {
+		replication := newReplication()
+		addReplication(replication)
+		addReadySnapshot()
+		Expect(controller.VMExportInformer.GetStore().Add(readyExport(externalLinks()))).To(Succeed())
+
+		Expect(controller.updateReplication(replication)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(exportv1.SnapshotReplicationReplicating))
+		testutils.ExpectEvent(recorder, replicatorPodCreatedEvent)
+
+		pod, err := k8sClient.CoreV1().Pods(testNamespace).Get(context.Background(), getReplicatorPodName(replication), metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(metav1.IsControlledBy(pod, replication)).To(BeTrue())
+		container := pod.Spec.Containers[0]
+		Expect(container.Args).To(Equal([]string{ReplicateCommand}))
+		Expect(container.TerminationMessagePath).To(Equal(TerminationMessagePath))
+		Expect(container.Env).To(ContainElements(
+			k8sv1.EnvVar{Name: manifestURLEnv, Value: testManifestURL},
+			k8sv1.EnvVar{Name: secretURLEnv, Value: testSecretURL},
+			k8sv1.EnvVar{Name: certEnv, Value: "cert"},
+			k8sv1.EnvVar{Name: tokenFileEnv, Value: "/token/token"},
+			k8sv1.EnvVar{Name: kubeconfigEnv, Value: "/kubeconfig/kubeconfig"},
+			k8sv1.EnvVar{Name: namespaceEnv, Value: testNamespace},
+			k8sv1.EnvVar{Name: vmNameEnv, Value: "vm-standby"},
+			k8sv1.EnvVar{Name: storageClassEnv, Value: "standby-storage"},
+		))
+		Expect(pod.Spec.Volumes).To(ContainElements(
+			k8sv1.Volume{Name: tokenVolume, VolumeSource: k8sv1.VolumeSource{
+				Secret: &k8sv1.SecretVolumeSource{SecretName: testTokenSecretName},
+			}},
+			k8sv1.Volume{Name: kubeconfigVolume, VolumeSource: k8sv1.VolumeSource{
+				Secret: &k8sv1.SecretVolumeSource{SecretName: "peer"},
+			}},
+		))
+	})
+
+	It("should record the replicated volumes when the replicator pod succeeds", func() {
+		replication := newReplication()
+		addReplication(replication)
+		addReadySnapshot()
+		Expect(controller.PodInformer.GetStore().Add(terminatedPod(k8sv1.PodSucceeded,
+			`[{"name":"rootdisk","dataVolumeName":"rootdisk-dv","progress":"100.0%"}]`))).To(Succeed())
+
+		Expect(controller.updateReplication(replication)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(exportv1.SnapshotReplicationSucceeded))
+		Expect(status.CompletionTime).To(Equal(&timeStamp))
+		Expect(status.Volumes).To(Equal([]exportv1.ReplicatedVolume{{
+			Name:           "rootdisk",
+			DataVolumeName: "rootdisk-dv",
+			Progress:       "100.0%",
+		}}))
+		Expect(status.Conditions).To(ConsistOf(newReadyCondition(k8sv1.ConditionTrue, succeededReason, "Snapshot replicated")))
+		testutils.ExpectEvent(recorder, snapshotReplicatedEvent)
+	})
+
+	It("should fail when the replicator pod fails", func() {
+		replication := newReplication()
+		addReplication(replication)
+		addReadySnapshot()
+		Expect(controller.PodInformer.GetStore().Add(terminatedPod(k8sv1.PodFailed, "DataVolume rootdisk-dv failed to import"))).To(Succeed())
+
+		Expect(controller.updateReplication(replication)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(exportv1.SnapshotReplicationFailed))
+		Expect(status.Conditions).To(ConsistOf(newReadyCondition(k8sv1.ConditionFalse, failedReason, "DataVolume rootdisk-dv failed to import")))
+		testutils.ExpectEvent(recorder, snapshotReplicationFailed)
+	})
+
+	It("should delete the replicator pod and the export once finished", func() {
+		replication := newReplication()
+		replication.Status = &exportv1.VirtualMachineSnapshotReplicationStatus{Phase: exportv1.SnapshotReplicationSucceeded}
+		pod := terminatedPod(k8sv1.PodSucceeded, "")
+		vmExport := readyExport(externalLinks())
+		Expect(controller.PodInformer.GetStore().Add(pod)).To(Succeed())
+		_, err := k8sClient.CoreV1().Pods(testNamespace).Create(context.Background(), pod, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(controller.VMExportInformer.GetStore().Add(vmExport)).To(Succeed())
+		_, err = kubevirtClient.ExportV1beta1().VirtualMachineExports(testNamespace).Create(context.Background(), vmExport, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(controller.updateReplication(replication)).To(Succeed())
+		pods, err := k8sClient.CoreV1().Pods(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pods.Items).To(BeEmpty())
+		exports, err := kubevirtClient.ExportV1beta1().VirtualMachineExports(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exports.Items).To(BeEmpty())
+	})
+
+	It("should enqueue the replications of a snapshot", func() {
+		replication := newReplication()
+		Expect(controller.VMSnapshotReplicationInformer.GetStore().Add(replication)).To(Succeed())
+		controller.handleVMSnapshot(&snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: testSnapshotName, Namespace: testNamespace},
+		})
+		Eventually(controller.replicationQueue.Len, time.Second).Should(Equal(1))
+		key, _ := controller.replicationQueue.Get()
+		Expect(key).To(Equal(testNamespace + "/" + testReplicationName))
+	})
+})
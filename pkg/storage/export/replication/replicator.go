@@ -0,0 +1,333 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package replication
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	// ReplicateCommand is the argument making the exporter image replicate a snapshot to a peer cluster instead of serving volumes
+	ReplicateCommand = "replicate"
+
+	// ReplicateTimeout bounds how long the peer cluster may take to import all the volumes
+	ReplicateTimeout = 24 * time.Hour
+
+	manifestURLEnv  = "REPLICATE_MANIFEST_URL"
+	secretURLEnv    = "REPLICATE_SECRET_URL"
+	certEnv         = "REPLICATE_CERT"
+	tokenFileEnv    = "REPLICATE_TOKEN_FILE"
+	kubeconfigEnv   = "REPLICATE_KUBECONFIG"
+	namespaceEnv    = "REPLICATE_NAMESPACE"
+	vmNameEnv       = "REPLICATE_VM_NAME"
+	storageClassEnv = "REPLICATE_STORAGE_CLASS"
+
+	tokenHeader = "x-kubevirt-export-token"
+)
+
+// pollInterval is how often the DataVolumes of the peer cluster are checked
+var pollInterval = 10 * time.Second
+
+// ReplicatorConfig holds the configuration of the snapshot replicator
+type ReplicatorConfig struct {
+	// ManifestURL is the external URL serving the manifests of the exported virtual machine
+	ManifestURL string
+	// SecretURL is the external URL serving the secret holding the export token header
+	SecretURL string
+	// Cert is the PEM encoded certificate the export server is verified with, the system roots when empty
+	Cert string
+	// Token is the token of the export
+	Token string
+	// Kubeconfig is the path of the kubeconfig of the peer cluster
+	Kubeconfig string
+
+	// Namespace is the namespace of the peer cluster the virtual machine is created in
+	Namespace string
+	// VirtualMachineName is the name of the created virtual machine, the exported one when empty
+	VirtualMachineName string
+	// StorageClassName is the storage class of the imported volumes, the default one when empty
+	StorageClassName string
+}
+
+// ReplicatorConfigFromEnv creates the replicator configuration from the environment of the replicator pod
+func ReplicatorConfigFromEnv(env map[string]string) (*ReplicatorConfig, error) {
+	config := &ReplicatorConfig{
+		ManifestURL:        env[manifestURLEnv],
+		SecretURL:          env[secretURLEnv],
+		Cert:               env[certEnv],
+		Kubeconfig:         env[kubeconfigEnv],
+		Namespace:          env[namespaceEnv],
+		VirtualMachineName: env[vmNameEnv],
+		StorageClassName:   env[storageClassEnv],
+	}
+	if config.ManifestURL == "" || config.SecretURL == "" || config.Kubeconfig == "" || config.Namespace == "" {
+		return nil, fmt.Errorf("replication configuration incomplete")
+	}
+
+	token, err := os.ReadFile(env[tokenFileEnv])
+	if err != nil {
+		return nil, err
+	}
+	config.Token = strings.TrimSpace(string(token))
+
+	return config, nil
+}
+
+// Replicate creates the exported virtual machine in the peer cluster and waits for the peer cluster
+// to import its volumes from the export server
+func Replicate(ctx context.Context, config *ReplicatorConfig, peerClient kubecli.KubevirtClient) ([]exportv1.ReplicatedVolume, error) {
+	httpClient, err := newHTTPClient(config.Cert)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []json.RawMessage
+	for _, url := range []string{config.SecretURL, config.ManifestURL} {
+		manifests, err := fetchManifests(ctx, httpClient, url, config.Token)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, manifests...)
+	}
+
+	vm, err := createResources(ctx, peerClient, config, items)
+	if err != nil {
+		return nil, err
+	}
+	log.Log.Infof("Created VirtualMachine %s/%s in the peer cluster", vm.Namespace, vm.Name)
+
+	return waitForDataVolumes(ctx, peerClient, vm)
+}
+
+// WriteResult reports the replicated volumes, or the replication error, in the termination message of the pod
+func WriteResult(terminationMessagePath string, volumes []exportv1.ReplicatedVolume, replicateErr error) error {
+	message := []byte(fmt.Sprintf("%v", replicateErr))
+	if replicateErr == nil {
+		var err error
+		if message, err = json.Marshal(volumes); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(terminationMessagePath, message, 0644)
+}
+
+func newHTTPClient(cert string) (*http.Client, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(cert)) {
+			return nil, fmt.Errorf("failed to parse the export server certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport}, nil
+}
+
+// fetchManifests returns the items of the manifest list served at the url
+func fetchManifests(ctx context.Context, httpClient *http.Client, url, token string) ([]json.RawMessage, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(tokenHeader, token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s returned %s", url, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	list := struct {
+		Items []json.RawMessage `json:"items"`
+	}{}
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// createResources creates the manifests in the peer cluster, returning the created virtual machine
+func createResources(ctx context.Context, peerClient kubecli.KubevirtClient, config *ReplicatorConfig, items []json.RawMessage) (*virtv1.VirtualMachine, error) {
+	var vm *virtv1.VirtualMachine
+	var dataVolumes []*cdiv1.DataVolume
+	for _, item := range items {
+		typeMeta := metav1.TypeMeta{}
+		if err := json.Unmarshal(item, &typeMeta); err != nil {
+			return nil, err
+		}
+		switch typeMeta.Kind {
+		case "Secret":
+			secret := &corev1.Secret{}
+			if err := json.Unmarshal(item, secret); err != nil {
+				return nil, err
+			}
+			if err := createOrUpdateSecret(ctx, peerClient, config.Namespace, secret); err != nil {
+				return nil, err
+			}
+		case "ConfigMap":
+			configMap := &corev1.ConfigMap{}
+			if err := json.Unmarshal(item, configMap); err != nil {
+				return nil, err
+			}
+			if err := createOrUpdateConfigMap(ctx, peerClient, config.Namespace, configMap); err != nil {
+				return nil, err
+			}
+		case virtv1.VirtualMachineGroupVersionKind.Kind:
+			vm = &virtv1.VirtualMachine{}
+			if err := json.Unmarshal(item, vm); err != nil {
+				return nil, err
+			}
+		case "DataVolume":
+			dataVolume := &cdiv1.DataVolume{}
+			if err := json.Unmarshal(item, dataVolume); err != nil {
+				return nil, err
+			}
+			dataVolumes = append(dataVolumes, dataVolume)
+		default:
+			log.Log.Warningf("Skipping manifest of unexpected kind %s", typeMeta.Kind)
+		}
+	}
+	if vm == nil {
+		return nil, fmt.Errorf("the export did not serve a VirtualMachine")
+	}
+
+	for _, dataVolume := range dataVolumes {
+		dataVolume.Namespace = config.Namespace
+		setStorageClass(&dataVolume.Spec, config.StorageClassName)
+		_, err := peerClient.CdiClient().CdiV1beta1().DataVolumes(config.Namespace).Create(ctx, dataVolume, metav1.CreateOptions{})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return nil, err
+		}
+	}
+
+	vm.Namespace = config.Namespace
+	if config.VirtualMachineName != "" {
+		vm.Name = config.VirtualMachineName
+	}
+	for i := range vm.Spec.DataVolumeTemplates {
+		setStorageClass(&vm.Spec.DataVolumeTemplates[i].Spec, config.StorageClassName)
+	}
+	// the replica is a standby copy, it is started when failing over to the peer cluster
+	vm.Spec.Running = nil
+	vm.Spec.RunStrategy = pointer.P(virtv1.RunStrategyHalted)
+
+	return peerClient.VirtualMachine(config.Namespace).Create(ctx, vm, metav1.CreateOptions{})
+}
+
+func createOrUpdateSecret(ctx context.Context, peerClient kubecli.KubevirtClient, namespace string, secret *corev1.Secret) error {
+	secret.Namespace = namespace
+	_, err := peerClient.CoreV1().Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		_, err = peerClient.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	}
+	return err
+}
+
+func createOrUpdateConfigMap(ctx context.Context, peerClient kubecli.KubevirtClient, namespace string, configMap *corev1.ConfigMap) error {
+	configMap.Namespace = namespace
+	_, err := peerClient.CoreV1().ConfigMaps(namespace).Create(ctx, configMap, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		_, err = peerClient.CoreV1().ConfigMaps(namespace).Update(ctx, configMap, metav1.UpdateOptions{})
+	}
+	return err
+}
+
+func setStorageClass(spec *cdiv1.DataVolumeSpec, storageClassName string) {
+	if storageClassName == "" {
+		return
+	}
+	if spec.Storage != nil {
+		spec.Storage.StorageClassName = pointer.P(storageClassName)
+	}
+	if spec.PVC != nil {
+		spec.PVC.StorageClassName = pointer.P(storageClassName)
+	}
+}
+
+// waitForDataVolumes waits until the DataVolumes of the virtual machine finished importing
+func waitForDataVolumes(ctx context.Context, peerClient kubecli.KubevirtClient, vm *virtv1.VirtualMachine) ([]exportv1.ReplicatedVolume, error) {
+	var volumes []exportv1.ReplicatedVolume
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		if volume.DataVolume != nil {
+			volumes = append(volumes, exportv1.ReplicatedVolume{
+				Name:           volume.Name,
+				DataVolumeName: volume.DataVolume.Name,
+			})
+		}
+	}
+
+	err := wait.PollUntilContextCancel(ctx, pollInterval, true, func(ctx context.Context) (bool, error) {
+		done := true
+		for i := range volumes {
+			dataVolume, err := peerClient.CdiClient().CdiV1beta1().DataVolumes(vm.Namespace).Get(ctx, volumes[i].DataVolumeName, metav1.GetOptions{})
+			if errors.IsNotFound(err) {
+				done = false
+				continue
+			}
+			if err != nil {
+				return false, err
+			}
+			volumes[i].Progress = string(dataVolume.Status.Progress)
+			switch dataVolume.Status.Phase {
+			case cdiv1.Succeeded:
+			case cdiv1.Failed:
+				return false, fmt.Errorf("DataVolume %s failed to import", dataVolume.Name)
+			default:
+				done = false
+			}
+		}
+		return done, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return volumes, nil
+}
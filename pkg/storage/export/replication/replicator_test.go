@@ -0,0 +1,264 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package replication
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Snapshot replicator", func() {
+	Context("configuration", func() {
+		var tokenFile string
+
+		BeforeEach(func() {
+			tokenFile = filepath.Join(GinkgoT().TempDir(), "token")
+			Expect(os.WriteFile(tokenFile, []byte("secret-token\n"), 0600)).To(Succeed())
+		})
+
+		newEnv := func() map[string]string {
+			return map[string]string{
+				manifestURLEnv:  "https://export/manifests/all",
+				secretURLEnv:    "https://export/manifests/secret",
+				certEnv:         "cert",
+				tokenFileEnv:    tokenFile,
+				kubeconfigEnv:   "/kubeconfig/kubeconfig",
+				namespaceEnv:    "standby",
+				vmNameEnv:       "vm-standby",
+				storageClassEnv: "standby-storage",
+			}
+		}
+
+		It("should read the configuration from the environment", func() {
+			config, err := ReplicatorConfigFromEnv(newEnv())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).To(Equal(&ReplicatorConfig{
+				ManifestURL:        "https://export/manifests/all",
+				SecretURL:          "https://export/manifests/secret",
+				Cert:               "cert",
+				Token:              "secret-token",
+				Kubeconfig:         "/kubeconfig/kubeconfig",
+				Namespace:          "standby",
+				VirtualMachineName: "vm-standby",
+				StorageClassName:   "standby-storage",
+			}))
+		})
+
+		DescribeTable("should fail when the environment is incomplete", func(name string) {
+			env := newEnv()
+			delete(env, name)
+			_, err := ReplicatorConfigFromEnv(env)
+			Expect(err).To(HaveOccurred())
+		},
+			Entry("without the manifest url", manifestURLEnv),
+			Entry("without the secret url", secretURLEnv),
+			Entry("without the token file", tokenFileEnv),
+			Entry("without the kubeconfig", kubeconfigEnv),
+			Entry("without the namespace", namespaceEnv),
+		)
+	})
+
+	Context("replication", func() {
+		const namespace = "standby"
+
+		var (
+			server         *httptest.Server
+			config         *ReplicatorConfig
+			peerClient     *kubecli.MockKubevirtClient
+			k8sClient      *k8sfake.Clientset
+			kubevirtClient *kubevirtfake.Clientset
+			cdiClient      *cdifake.Clientset
+			origInterval   time.Duration
+		)
+
+		toJSON := func(obj interface{}) json.RawMessage {
+			b, err := json.Marshal(obj)
+			Expect(err).ToNot(HaveOccurred())
+			return b
+		}
+
+		listHandler := func(items ...interface{}) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get(tokenHeader) != "token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				list := struct {
+					Items []json.RawMessage `json:"items"`
+				}{}
+				for _, item := range items {
+					list.Items = append(list.Items, toJSON(item))
+				}
+				Expect(json.NewEncoder(w).Encode(list)).To(Succeed())
+			}
+		}
+
+		exportedVM := func() *virtv1.VirtualMachine {
+			return &virtv1.VirtualMachine{
+				TypeMeta:   metav1.TypeMeta{Kind: virtv1.VirtualMachineGroupVersionKind.Kind, APIVersion: virtv1.GroupVersion.String()},
+				ObjectMeta: metav1.ObjectMeta{Name: "vm", Namespace: "default"},
+				Spec: virtv1.VirtualMachineSpec{
+					RunStrategy: pointer.P(virtv1.RunStrategyAlways),
+					DataVolumeTemplates: []virtv1.DataVolumeTemplateSpec{{
+						ObjectMeta: metav1.ObjectMeta{Name: "rootdisk-dv"},
+						Spec: cdiv1.DataVolumeSpec{
+							Source:  &cdiv1.DataVolumeSource{HTTP: &cdiv1.DataVolumeSourceHTTP{URL: "https://export/volumes/rootdisk/disk.img.gz"}},
+							Storage: &cdiv1.StorageSpec{},
+						},
+					}},
+					Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+						Spec: virtv1.VirtualMachineInstanceSpec{
+							Volumes: []virtv1.Volume{{
+								Name: "rootdisk",
+								VolumeSource: virtv1.VolumeSource{
+									DataVolume: &virtv1.DataVolumeSource{Name: "rootdisk-dv"},
+								},
+							}},
+						},
+					},
+				},
+			}
+		}
+
+		headerSecret := func() *k8sv1.Secret {
+			return &k8sv1.Secret{
+				TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "header-secret"},
+				StringData: map[string]string{"token": "x-kubevirt-export-token:token"},
+			}
+		}
+
+		importedDataVolume := func(phase cdiv1.DataVolumePhase) *cdiv1.DataVolume {
+			return &cdiv1.DataVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "rootdisk-dv", Namespace: namespace},
+				Status: cdiv1.DataVolumeStatus{
+					Phase:    phase,
+					Progress: "100.0%",
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			origInterval = pollInterval
+			pollInterval = 10 * time.Millisecond
+
+			mux := http.NewServeMux()
+			mux.Handle("/manifests/all", listHandler(exportedVM()))
+			mux.Handle("/manifests/secret", listHandler(headerSecret()))
+			server = httptest.NewTLSServer(mux)
+
+			config = &ReplicatorConfig{
+				ManifestURL:        server.URL + "/manifests/all",
+				SecretURL:          server.URL + "/manifests/secret",
+				Cert:               string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})),
+				Token:              "token",
+				Namespace:          namespace,
+				VirtualMachineName: "vm-standby",
+				StorageClassName:   "standby-storage",
+			}
+
+			ctrl := gomock.NewController(GinkgoT())
+			peerClient = kubecli.NewMockKubevirtClient(ctrl)
+			k8sClient = k8sfake.NewSimpleClientset()
+			kubevirtClient = kubevirtfake.NewSimpleClientset()
+			cdiClient = cdifake.NewSimpleClientset()
+			peerClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+			peerClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
+			peerClient.EXPECT().VirtualMachine(namespace).Return(kubevirtClient.KubevirtV1().VirtualMachines(namespace)).AnyTimes()
+		})
+
+		AfterEach(func() {
+			server.Close()
+			pollInterval = origInterval
+		})
+
+		It("should create a halted copy of the virtual machine in the peer cluster", func() {
+			_, err := cdiClient.CdiV1beta1().DataVolumes(namespace).Create(context.Background(), importedDataVolume(cdiv1.Succeeded), metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			volumes, err := Replicate(context.Background(), config, peerClient)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(volumes).To(Equal([]exportv1.ReplicatedVolume{{
+				Name:           "rootdisk",
+				DataVolumeName: "rootdisk-dv",
+				Progress:       "100.0%",
+			}}))
+
+			vm, err := kubevirtClient.KubevirtV1().VirtualMachines(namespace).Get(context.Background(), "vm-standby", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(virtv1.RunStrategyHalted)))
+			Expect(vm.Spec.DataVolumeTemplates[0].Spec.Storage.StorageClassName).To(HaveValue(Equal("standby-storage")))
+
+			secret, err := k8sClient.CoreV1().Secrets(namespace).Get(context.Background(), "header-secret", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secret.StringData).To(HaveKeyWithValue("token", "x-kubevirt-export-token:token"))
+		})
+
+		It("should fail when a volume fails to import", func() {
+			_, err := cdiClient.CdiV1beta1().DataVolumes(namespace).Create(context.Background(), importedDataVolume(cdiv1.Failed), metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = Replicate(context.Background(), config, peerClient)
+			Expect(err).To(MatchError("DataVolume rootdisk-dv failed to import"))
+		})
+
+		It("should fail when the export rejects the token", func() {
+			config.Token = "wrong"
+			_, err := Replicate(context.Background(), config, peerClient)
+			Expect(err).To(MatchError(ContainSubstring("401 Unauthorized")))
+		})
+
+		It("should write the replicated volumes or the error as termination message", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "termination-log")
+			volumes := []exportv1.ReplicatedVolume{{Name: "rootdisk", DataVolumeName: "rootdisk-dv", Progress: "100.0%"}}
+			Expect(WriteResult(path, volumes, nil)).To(Succeed())
+			message, err := os.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(message).To(MatchJSON(`[{"name":"rootdisk","dataVolumeName":"rootdisk-dv","progress":"100.0%"}]`))
+
+			Expect(WriteResult(path, nil, errors.New("import failed"))).To(Succeed())
+			message, err = os.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(message)).To(Equal("import failed"))
+		})
+	})
+})
@@ -0,0 +1,124 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/export"
+)
+
+// ComputeChecksums computes the size and SHA-256 checksum of the disk image of every volume exported as a raw image
+func ComputeChecksums(volumes []export.VolumeInfo) ([]exportv1.ExportedVolumeChecksum, error) {
+	var checksums []exportv1.ExportedVolumeChecksum
+	for _, vi := range volumes {
+		if vi.RawURI == "" {
+			continue
+		}
+		imagePath, err := getDiskImagePath(vi)
+		if err != nil {
+			return nil, err
+		}
+		log.Log.Infof("Computing checksum of %s", imagePath)
+		size, checksum, err := computeChecksum(imagePath)
+		if err != nil {
+			return nil, fmt.Errorf("error computing checksum of %s: %v", imagePath, err)
+		}
+		checksums = append(checksums, exportv1.ExportedVolumeChecksum{
+			Name:     filepath.Base(filepath.Clean(vi.Path)),
+			Size:     size,
+			Checksum: checksum,
+		})
+	}
+	return checksums, nil
+}
+
+func computeChecksum(imagePath string) (int64, string, error) {
+	f, err := os.Open(imagePath)
+	if err != nil {
+		return 0, "", err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	n, err := io.Copy(hash, f)
+	if err != nil {
+		return 0, "", err
+	}
+	return n, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// WriteChecksums hands the checksums to the export server through checksumsFile, and reports them, or the error
+// computing them, in the termination message
+func WriteChecksums(terminationMessagePath, checksumsFile string, checksums []exportv1.ExportedVolumeChecksum, checksumErr error) error {
+	if checksumErr != nil {
+		return os.WriteFile(terminationMessagePath, []byte(checksumErr.Error()), 0644)
+	}
+	message, err := json.Marshal(checksums)
+	if err != nil {
+		return err
+	}
+	if checksumsFile != "" {
+		if err := os.WriteFile(checksumsFile, message, 0644); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(terminationMessagePath, message, 0644)
+}
+
+// readChecksums returns the checksums of the disk images by volume name
+func readChecksums(checksumsFile string) map[string]string {
+	if checksumsFile == "" {
+		return nil
+	}
+	data, err := os.ReadFile(checksumsFile)
+	if err != nil {
+		log.Log.Reason(err).Errorf("error reading checksums from %s", checksumsFile)
+		return nil
+	}
+	var checksums []exportv1.ExportedVolumeChecksum
+	if err := json.Unmarshal(data, &checksums); err != nil {
+		log.Log.Reason(err).Errorf("error parsing checksums from %s", checksumsFile)
+		return nil
+	}
+	result := make(map[string]string, len(checksums))
+	for _, checksum := range checksums {
+		result[checksum.Name] = checksum.Checksum
+	}
+	return result
+}
+
+// etagHandler sets the checksum of the served image as entity tag, so interrupted downloads can be
+// resumed with conditional range requests
+func etagHandler(checksum string, nextHandler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", fmt.Sprintf("%q", checksum))
+		nextHandler.ServeHTTP(w, r)
+	})
+}
@@ -46,6 +46,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
 	"kubevirt.io/client-go/log"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
@@ -88,6 +89,8 @@ type ExportServerConfig struct {
 	GzipHandler        func(string) http.Handler
 	VmHandler          func([]export.VolumeInfo, func() (string, error), func() (*corev1.ConfigMap, error)) http.Handler
 	TokenSecretHandler func(TokenGetterFunc) http.Handler
+	ConvertHandler     func(string, string, exportv1.ExportVolumeFormat) http.Handler
+	OvaHandler         func([]export.VolumeInfo, string) http.Handler
 
 	PermissionChecker func(string) bool
 
@@ -103,6 +106,8 @@ type execReader struct {
 type exportServer struct {
 	ExportServerConfig
 	handler http.Handler
+	// checksums of the disk images by volume name
+	checksums map[string]string
 }
 
 func (er *execReader) Read(p []byte) (int, error) {
@@ -123,6 +128,7 @@ func (er *execReader) Close() error {
 
 func (s *exportServer) initHandler() {
 	mux := http.NewServeMux()
+	s.checksums = readChecksums(s.Paths.ChecksumsFile)
 	for _, vi := range s.Paths.Volumes {
 		if hasPermissions := s.PermissionChecker(vi.Path); !hasPermissions {
 			golog.Fatalf("unable to manipulate %s's contents, exiting", vi.Path)
@@ -140,6 +146,9 @@ func (s *exportServer) initHandler() {
 		mux.Handle(filepath.Join(internal, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
 		mux.Handle(filepath.Join(external, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
 	}
+	if s.Paths.OvaURI != "" {
+		mux.Handle(s.Paths.OvaURI, tokenChecker(s.TokenGetter, s.OvaHandler(s.Paths.Volumes, s.Paths.ScratchPath)))
+	}
 	// Readiness probe
 	mux.HandleFunc(export.ReadinessPath, s.readyHandler)
 
@@ -178,12 +187,23 @@ func (s *exportServer) getHandlerMap(vi export.VolumeInfo) map[string]http.Handl
 
 	if vi.RawURI != "" {
 		result[vi.RawURI] = s.FileHandler(p)
+		if checksum, ok := s.checksums[filepath.Base(filepath.Clean(vi.Path))]; ok {
+			result[vi.RawURI] = etagHandler(checksum, result[vi.RawURI])
+		}
 	}
 
 	if vi.RawGzURI != "" {
 		result[vi.RawGzURI] = s.GzipHandler(p)
 	}
 
+	if vi.Qcow2URI != "" {
+		result[vi.Qcow2URI] = s.ConvertHandler(p, s.Paths.ScratchPath, exportv1.Qcow2)
+	}
+
+	if vi.VmdkURI != "" {
+		result[vi.VmdkURI] = s.ConvertHandler(p, s.Paths.ScratchPath, exportv1.Vmdk)
+	}
+
 	return result
 }
 
@@ -251,6 +271,14 @@ func NewExportServer(config ExportServerConfig) service.Service {
 		es.TokenSecretHandler = secretHandler
 	}
 
+	if es.ConvertHandler == nil {
+		es.ConvertHandler = convertHandler
+	}
+
+	if es.OvaHandler == nil {
+		es.OvaHandler = ovaHandler
+	}
+
 	if es.TokenGetter == nil {
 		es.TokenGetter = func() (string, error) {
 			return getToken(es.TokenFile)
@@ -493,6 +521,8 @@ func archiveHandler(mountPoint string) http.Handler {
 		defer tarReader.Close()
 		gzipReader := pipeToGzip(tarReader)
 		defer gzipReader.Close()
+		// The archive is generated on the fly, so it cannot be served in ranges
+		w.Header().Set("Accept-Ranges", "none")
 		n, err := io.Copy(w, gzipReader)
 		if err != nil {
 			log.Log.Reason(err).Error("error writing response body")
@@ -562,6 +592,8 @@ func gzipHandler(filePath string) http.Handler {
 		defer f.Close()
 		gzipReader := pipeToGzip(f)
 		defer gzipReader.Close()
+		// The image is compressed on the fly, so it cannot be served in ranges
+		w.Header().Set("Accept-Ranges", "none")
 		n, err := io.Copy(w, gzipReader)
 		if err != nil {
 			log.Log.Reason(err).Error("error writing response body")
@@ -20,11 +20,16 @@
 package virtexportserver
 
 import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -34,6 +39,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
 	"kubevirt.io/client-go/log"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	"sigs.k8s.io/yaml"
@@ -69,6 +75,12 @@ func newTestServer(token string) *exportServer {
 		TokenSecretHandler: func(tgf TokenGetterFunc) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
+		ConvertHandler: func(string, string, exportv1.ExportVolumeFormat) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		OvaHandler: func([]export.VolumeInfo, string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
 		TokenGetter: func() (string, error) {
 			return token, nil
 		},
@@ -125,6 +137,16 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("vmdk URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", VmdkURI: "/volume/v1/disk.vmdk"},
+			"/volume/v1/disk.vmdk",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -180,6 +202,16 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("vmdk URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", VmdkURI: "/volume/v1/disk.vmdk"},
+			"/volume/v1/disk.vmdk",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -232,6 +264,16 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("vmdk URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", VmdkURI: "/volume/v1/disk.vmdk"},
+			"/volume/v1/disk.vmdk",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -283,6 +325,16 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("vmdk URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", VmdkURI: "/volume/v1/disk.vmdk"},
+			"/volume/v1/disk.vmdk",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -295,6 +347,27 @@ var _ = Describe("exportserver", func() {
 		),
 	)
 
+	DescribeTable("should handle OVA URI", func(token string, expectedStatus int) {
+		es := newTestServer("foo")
+		es.Paths = &export.ServerPaths{OvaURI: "/vm.ova"}
+		es.initHandler()
+
+		httpServer := httptest.NewServer(es.handler)
+		defer httpServer.Close()
+
+		client := http.Client{}
+		req, err := http.NewRequest("GET", httpServer.URL+"/vm.ova", nil)
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("x-kubevirt-export-token", token)
+		res, err := client.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(expectedStatus))
+	},
+		Entry("with valid token", "foo", http.StatusOK),
+		Entry("with bad token", "bar", http.StatusUnauthorized),
+	)
+
 	Context("Vm handler", func() {
 		var (
 			orgGetExportName       = getExportName
@@ -770,4 +843,268 @@ var _ = Describe("exportserver", func() {
 			verifySecret(string(list.Items[0].Raw))
 		})
 	})
+	Context("Convert handler", func() {
+		var (
+			orgConvertImage = convertImage
+			scratchDir      string
+			imagePath       string
+		)
+
+		BeforeEach(func() {
+			scratchDir = GinkgoT().TempDir()
+			imagePath = filepath.Join(GinkgoT().TempDir(), "disk.img")
+			Expect(os.WriteFile(imagePath, []byte("raw"), 0644)).To(Succeed())
+			convertImage = func(src, dst string, format exportv1.ExportVolumeFormat) error {
+				return os.WriteFile(dst, []byte(fmt.Sprintf("%s:%s", format, src)), 0644)
+			}
+		})
+
+		AfterEach(func() {
+			convertImage = orgConvertImage
+		})
+
+		DescribeTable("Convert handler should return error on non GET", func(verb string) {
+			req, err := http.NewRequest(verb, "https://test.blah.invalid/volume/v1/disk.qcow2", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			convertHandler(imagePath, scratchDir, exportv1.Qcow2).ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusBadRequest))
+		},
+			Entry("POST", "POST"),
+			Entry("PUT", "PUT"),
+			Entry("PATCH", "PATCH"),
+			Entry("DELETE", "DELETE"),
+		)
+
+		DescribeTable("Should return the converted image and clean up the scratch space", func(format exportv1.ExportVolumeFormat) {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/volume/v1/disk."+string(format), nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			convertHandler(imagePath, scratchDir, format).ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusOK))
+			Expect(resp.Body.String()).To(Equal(fmt.Sprintf("%s:%s", format, imagePath)))
+			entries, err := os.ReadDir(scratchDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		},
+			Entry("qcow2", exportv1.Qcow2),
+			Entry("vmdk", exportv1.Vmdk),
+		)
+
+		It("Should return 500 if the conversion fails", func() {
+			convertImage = func(string, string, exportv1.ExportVolumeFormat) error {
+				return fmt.Errorf("conversion failed")
+			}
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/volume/v1/disk.qcow2", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			convertHandler(imagePath, scratchDir, exportv1.Qcow2).ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusInternalServerError))
+		})
+	})
+
+	Context("OVA handler", func() {
+		var (
+			orgConvertImage  = convertImage
+			orgGetExpandedVM = getExpandedVM
+			scratchDir       string
+			volumes          []export.VolumeInfo
+		)
+
+		BeforeEach(func() {
+			scratchDir = GinkgoT().TempDir()
+			volumeDir := filepath.Join(GinkgoT().TempDir(), "volume1")
+			Expect(os.Mkdir(volumeDir, 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(volumeDir, "disk.img"), make([]byte, 4096), 0644)).To(Succeed())
+			volumes = []export.VolumeInfo{
+				{Path: volumeDir, RawURI: "/volume/volume1/disk.img"},
+				{Path: "/tmp", DirURI: "/volume/volume2/dir/"},
+			}
+			convertImage = func(src, dst string, format exportv1.ExportVolumeFormat) error {
+				return os.WriteFile(dst, []byte("vmdk"), 0644)
+			}
+			getExpandedVM = func() *virtv1.VirtualMachine {
+				return &virtv1.VirtualMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-vm",
+					},
+					Spec: virtv1.VirtualMachineSpec{
+						Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+							Spec: virtv1.VirtualMachineInstanceSpec{
+								Domain: virtv1.DomainSpec{
+									CPU: &virtv1.CPU{Sockets: 2, Cores: 2, Threads: 1},
+									Resources: virtv1.ResourceRequirements{
+										Requests: v1.ResourceList{
+											v1.ResourceMemory: resource.MustParse("2Gi"),
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+			}
+		})
+
+		AfterEach(func() {
+			convertImage = orgConvertImage
+			getExpandedVM = orgGetExpandedVM
+		})
+
+		DescribeTable("OVA handler should return error on non GET", func(verb string) {
+			req, err := http.NewRequest(verb, "https://test.blah.invalid/vm.ova", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			ovaHandler(volumes, scratchDir).ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusBadRequest))
+		},
+			Entry("POST", "POST"),
+			Entry("PUT", "PUT"),
+			Entry("PATCH", "PATCH"),
+			Entry("DELETE", "DELETE"),
+		)
+
+		It("Should return 500 if getExpandedVM returns nil", func() {
+			getExpandedVM = func() *virtv1.VirtualMachine {
+				return nil
+			}
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/vm.ova", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			ovaHandler(volumes, scratchDir).ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusInternalServerError))
+		})
+
+		It("Should return 500 if the conversion fails", func() {
+			convertImage = func(string, string, exportv1.ExportVolumeFormat) error {
+				return fmt.Errorf("conversion failed")
+			}
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/vm.ova", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			ovaHandler(volumes, scratchDir).ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusInternalServerError))
+		})
+
+		It("Should return an OVA with the OVF descriptor and the disks", func() {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/vm.ova", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			ovaHandler(volumes, scratchDir).ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusOK))
+			Expect(resp.Header().Get("Content-Disposition")).To(ContainSubstring("test-vm.ova"))
+
+			tr := tar.NewReader(resp.Body)
+			hdr, err := tr.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hdr.Name).To(Equal("test-vm.ovf"))
+			ovf, err := io.ReadAll(tr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(ovf)).To(ContainSubstring(`ovf:href="volume1.vmdk"`))
+			Expect(string(ovf)).To(ContainSubstring(`ovf:capacity="4096"`))
+			Expect(string(ovf)).To(ContainSubstring("4 virtual CPU(s)"))
+			Expect(string(ovf)).To(ContainSubstring("2048MB of memory"))
+
+			hdr, err = tr.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hdr.Name).To(Equal("volume1.vmdk"))
+			disk, err := io.ReadAll(tr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(disk)).To(Equal("vmdk"))
+
+			_, err = tr.Next()
+			Expect(err).To(MatchError(io.EOF))
+			entries, err := os.ReadDir(scratchDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+	})
+	Context("Checksums", func() {
+		var (
+			volumeDir string
+			content   = []byte("disk image content")
+		)
+
+		BeforeEach(func() {
+			volumeDir = filepath.Join(GinkgoT().TempDir(), "volume1")
+			Expect(os.Mkdir(volumeDir, 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(volumeDir, "disk.img"), content, 0644)).To(Succeed())
+		})
+
+		It("Should compute the checksums of the raw images", func() {
+			checksums, err := ComputeChecksums([]export.VolumeInfo{
+				{Path: volumeDir, RawURI: "/volume/volume1/disk.img"},
+				{Path: "/tmp", ArchiveURI: "/volume/volume2/disk.tar.gz"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			sum := sha256.Sum256(content)
+			Expect(checksums).To(ConsistOf(exportv1.ExportedVolumeChecksum{
+				Name:     "volume1",
+				Size:     int64(len(content)),
+				Checksum: hex.EncodeToString(sum[:]),
+			}))
+		})
+
+		It("Should return an error if the image cannot be read", func() {
+			_, err := ComputeChecksums([]export.VolumeInfo{
+				{Path: filepath.Join(volumeDir, "missing"), RawURI: "/volume/missing/disk.img"},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Should write the checksums to the checksums file and the termination message", func() {
+			dir := GinkgoT().TempDir()
+			terminationMessagePath := filepath.Join(dir, "termination-log")
+			checksumsFile := filepath.Join(dir, "checksums.json")
+			checksums := []exportv1.ExportedVolumeChecksum{{Name: "volume1", Size: 1, Checksum: "abc"}}
+			Expect(WriteChecksums(terminationMessagePath, checksumsFile, checksums, nil)).To(Succeed())
+			message, err := os.ReadFile(terminationMessagePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(message)).To(MatchJSON(`[{"name":"volume1","size":1,"checksum":"abc"}]`))
+			Expect(readChecksums(checksumsFile)).To(Equal(map[string]string{"volume1": "abc"}))
+		})
+
+		It("Should write the error to the termination message", func() {
+			dir := GinkgoT().TempDir()
+			terminationMessagePath := filepath.Join(dir, "termination-log")
+			checksumsFile := filepath.Join(dir, "checksums.json")
+			Expect(WriteChecksums(terminationMessagePath, checksumsFile, nil, fmt.Errorf("read failed"))).To(Succeed())
+			message, err := os.ReadFile(terminationMessagePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(message)).To(Equal("read failed"))
+			Expect(checksumsFile).ToNot(BeAnExistingFile())
+		})
+
+		DescribeTable("Should resume downloads of raw images", func(ifRange string, expectedStatus int, expectedBody string) {
+			checksumsFile := filepath.Join(GinkgoT().TempDir(), "checksums.json")
+			Expect(os.WriteFile(checksumsFile, []byte(`[{"name":"volume1","size":18,"checksum":"abc"}]`), 0644)).To(Succeed())
+			es := newTestServer("foo")
+			es.FileHandler = fileHandler
+			es.Paths = &export.ServerPaths{
+				ChecksumsFile: checksumsFile,
+				Volumes:       []export.VolumeInfo{{Path: volumeDir, RawURI: "/volume/volume1/disk.img"}},
+			}
+			es.initHandler()
+
+			httpServer := httptest.NewServer(es.handler)
+			defer httpServer.Close()
+
+			req, err := http.NewRequest("GET", httpServer.URL+"/volume/volume1/disk.img", nil)
+			Expect(err).ToNot(HaveOccurred())
+			req.Header.Set("x-kubevirt-export-token", "foo")
+			req.Header.Set("Range", "bytes=5-9")
+			req.Header.Set("If-Range", ifRange)
+			res, err := http.DefaultClient.Do(req)
+			Expect(err).ToNot(HaveOccurred())
+			defer res.Body.Close()
+			Expect(res.StatusCode).To(Equal(expectedStatus))
+			Expect(res.Header.Get("ETag")).To(Equal(`"abc"`))
+			out, err := io.ReadAll(res.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(Equal(expectedBody))
+		},
+			Entry("with matching entity tag", `"abc"`, http.StatusPartialContent, "image"),
+			Entry("with changed entity tag", `"def"`, http.StatusOK, "disk image content"),
+		)
+	})
 })
@@ -0,0 +1,437 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"archive/tar"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/export"
+)
+
+const (
+	ovfNamespace       = "http://schemas.dmtf.org/ovf/envelope/1"
+	rasdNamespace      = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ResourceAllocationSettingData"
+	vssdNamespace      = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_VirtualSystemSettingData"
+	streamOptimizedURI = "http://www.vmware.com/interfaces/specifications/vmdk.html#streamOptimized"
+
+	// CIM resource types of the virtual hardware items
+	resourceTypeCPU            = 3
+	resourceTypeMemory         = 4
+	resourceTypeSCSIController = 6
+	resourceTypeDisk           = 17
+)
+
+type ovfEnvelope struct {
+	XMLName       xml.Name         `xml:"Envelope"`
+	Xmlns         string           `xml:"xmlns,attr"`
+	XmlnsOvf      string           `xml:"xmlns:ovf,attr"`
+	XmlnsRasd     string           `xml:"xmlns:rasd,attr"`
+	XmlnsVssd     string           `xml:"xmlns:vssd,attr"`
+	References    []ovfFile        `xml:"References>File"`
+	DiskSection   ovfDiskSection   `xml:"DiskSection"`
+	VirtualSystem ovfVirtualSystem `xml:"VirtualSystem"`
+}
+
+type ovfFile struct {
+	ID   string `xml:"ovf:id,attr"`
+	Href string `xml:"ovf:href,attr"`
+	Size int64  `xml:"ovf:size,attr"`
+}
+
+type ovfDiskSection struct {
+	Info  string    `xml:"Info"`
+	Disks []ovfDisk `xml:"Disk"`
+}
+
+type ovfDisk struct {
+	DiskID                  string `xml:"ovf:diskId,attr"`
+	FileRef                 string `xml:"ovf:fileRef,attr"`
+	Capacity                int64  `xml:"ovf:capacity,attr"`
+	CapacityAllocationUnits string `xml:"ovf:capacityAllocationUnits,attr"`
+	Format                  string `xml:"ovf:format,attr"`
+}
+
+type ovfVirtualSystem struct {
+	ID                     string                    `xml:"ovf:id,attr"`
+	Info                   string                    `xml:"Info"`
+	Name                   string                    `xml:"Name"`
+	VirtualHardwareSection ovfVirtualHardwareSection `xml:"VirtualHardwareSection"`
+	OperatingSystemSection ovfOperatingSystemSection `xml:"OperatingSystemSection"`
+}
+
+type ovfOperatingSystemSection struct {
+	ID   int    `xml:"ovf:id,attr"`
+	Info string `xml:"Info"`
+}
+
+type ovfVirtualHardwareSection struct {
+	Info   string    `xml:"Info"`
+	System ovfSystem `xml:"System"`
+	Items  []ovfItem `xml:"Item"`
+}
+
+type ovfSystem struct {
+	ElementName       string `xml:"vssd:ElementName"`
+	InstanceID        int    `xml:"vssd:InstanceID"`
+	VirtualSystemType string `xml:"vssd:VirtualSystemType"`
+}
+
+type ovfItem struct {
+	AddressOnParent *int   `xml:"rasd:AddressOnParent,omitempty"`
+	AllocationUnits string `xml:"rasd:AllocationUnits,omitempty"`
+	ElementName     string `xml:"rasd:ElementName"`
+	HostResource    string `xml:"rasd:HostResource,omitempty"`
+	InstanceID      int    `xml:"rasd:InstanceID"`
+	Parent          *int   `xml:"rasd:Parent,omitempty"`
+	ResourceSubType string `xml:"rasd:ResourceSubType,omitempty"`
+	ResourceType    int    `xml:"rasd:ResourceType"`
+	VirtualQuantity int64  `xml:"rasd:VirtualQuantity,omitempty"`
+}
+
+// ovaDisk is a volume converted to a VMDK image for an OVA bundle
+type ovaDisk struct {
+	fileName string
+	filePath string
+	size     int64
+	capacity int64
+}
+
+// convertImage converts the raw disk image src into dst in the given format
+var convertImage = func(src, dst string, format exportv1.ExportVolumeFormat) error {
+	args := []string{"convert", "-f", "raw"}
+	switch format {
+	case exportv1.Qcow2:
+		args = append(args, "-O", "qcow2", "-c")
+	case exportv1.Vmdk:
+		args = append(args, "-O", "vmdk", "-o", "subformat=streamOptimized")
+	default:
+		return fmt.Errorf("unsupported image format %s", format)
+	}
+	args = append(args, src, dst)
+
+	out, err := exec.Command("/usr/bin/qemu-img", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("error converting %s to %s: %v: %s", src, format, err, string(out))
+	}
+	return nil
+}
+
+// getDiskImagePath returns the path of the raw disk image of a volume
+func getDiskImagePath(vi export.VolumeInfo) (string, error) {
+	fi, err := os.Stat(vi.Path)
+	if err != nil {
+		return "", err
+	}
+	if fi.IsDir() {
+		return path.Join(vi.Path, "disk.img"), nil
+	}
+	return vi.Path, nil
+}
+
+// getImageSize returns the size of an image file or block device
+func getImageSize(imagePath string) (int64, error) {
+	f, err := os.Open(imagePath)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	return f.Seek(0, io.SeekEnd)
+}
+
+func convertHandler(filePath, scratchPath string, format exportv1.ExportVolumeFormat) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		dir, err := os.MkdirTemp(scratchPath, "convert-")
+		if err != nil {
+			log.Log.Reason(err).Errorf("error creating scratch directory in %s", scratchPath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer os.RemoveAll(dir)
+
+		fileName := "disk." + string(format)
+		convertedPath := filepath.Join(dir, fileName)
+		if err := convertImage(filePath, convertedPath, format); err != nil {
+			log.Log.Reason(err).Errorf("error converting %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		f, err := os.Open(convertedPath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error opening %s", convertedPath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		http.ServeContent(w, req, fileName, time.Time{}, f)
+	})
+}
+
+func ovaHandler(volumes []export.VolumeInfo, scratchPath string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		vm := getExpandedVM()
+		if vm == nil {
+			log.Log.Error("error getting VM definition")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		dir, err := os.MkdirTemp(scratchPath, "ova-")
+		if err != nil {
+			log.Log.Reason(err).Errorf("error creating scratch directory in %s", scratchPath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer os.RemoveAll(dir)
+
+		disks, err := convertOvaDisks(volumes, dir)
+		if err != nil {
+			log.Log.Reason(err).Error("error converting disks of the OVA")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		ovf, err := generateOVF(vm, disks)
+		if err != nil {
+			log.Log.Reason(err).Error("error generating OVF descriptor")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-tar")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", vm.Name+".ova"))
+		if err := writeOva(w, vm.Name+".ovf", ovf, disks); err != nil {
+			log.Log.Reason(err).Error("error writing OVA")
+		}
+	})
+}
+
+// convertOvaDisks converts every volume exported as a disk image to a VMDK image in dir
+func convertOvaDisks(volumes []export.VolumeInfo, dir string) ([]ovaDisk, error) {
+	var disks []ovaDisk
+	for _, vi := range volumes {
+		if vi.RawURI == "" {
+			continue
+		}
+		imagePath, err := getDiskImagePath(vi)
+		if err != nil {
+			return nil, err
+		}
+		capacity, err := getImageSize(imagePath)
+		if err != nil {
+			return nil, err
+		}
+
+		fileName := filepath.Base(filepath.Clean(vi.Path)) + ".vmdk"
+		disk := ovaDisk{
+			fileName: fileName,
+			filePath: filepath.Join(dir, fileName),
+			capacity: capacity,
+		}
+		if err := convertImage(imagePath, disk.filePath, exportv1.Vmdk); err != nil {
+			return nil, err
+		}
+		fi, err := os.Stat(disk.filePath)
+		if err != nil {
+			return nil, err
+		}
+		disk.size = fi.Size()
+		disks = append(disks, disk)
+	}
+	return disks, nil
+}
+
+// writeOva writes the OVF descriptor, followed by the disks, as a tar archive
+func writeOva(w io.Writer, ovfName string, ovf []byte, disks []ovaDisk) error {
+	tw := tar.NewWriter(w)
+	if err := tw.WriteHeader(&tar.Header{Name: ovfName, Mode: 0644, Size: int64(len(ovf))}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(ovf); err != nil {
+		return err
+	}
+	for _, disk := range disks {
+		if err := writeOvaDisk(tw, disk); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+func writeOvaDisk(tw *tar.Writer, disk ovaDisk) error {
+	f, err := os.Open(disk.filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := tw.WriteHeader(&tar.Header{Name: disk.fileName, Mode: 0644, Size: disk.size}); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+// generateOVF generates an OVF descriptor of the VM, with its CPU, memory and disks
+func generateOVF(vm *virtv1.VirtualMachine, disks []ovaDisk) ([]byte, error) {
+	envelope := ovfEnvelope{
+		Xmlns:     ovfNamespace,
+		XmlnsOvf:  ovfNamespace,
+		XmlnsRasd: rasdNamespace,
+		XmlnsVssd: vssdNamespace,
+		DiskSection: ovfDiskSection{
+			Info: "Virtual disk information",
+		},
+		VirtualSystem: ovfVirtualSystem{
+			ID:   vm.Name,
+			Info: "A virtual machine",
+			Name: vm.Name,
+			OperatingSystemSection: ovfOperatingSystemSection{
+				// 1 is the CIM operating system type "Other"
+				ID:   1,
+				Info: "The kind of installed guest operating system",
+			},
+			VirtualHardwareSection: ovfVirtualHardwareSection{
+				Info: "Virtual hardware requirements",
+				System: ovfSystem{
+					ElementName:       "Virtual Hardware Family",
+					VirtualSystemType: "vmx-14",
+				},
+			},
+		},
+	}
+
+	hardware := &envelope.VirtualSystem.VirtualHardwareSection
+	instanceID := 1
+	cpus := getVMCPUCount(vm)
+	hardware.Items = append(hardware.Items, ovfItem{
+		AllocationUnits: "hertz * 10^6",
+		ElementName:     fmt.Sprintf("%d virtual CPU(s)", cpus),
+		InstanceID:      instanceID,
+		ResourceType:    resourceTypeCPU,
+		VirtualQuantity: cpus,
+	})
+	instanceID++
+	if memory := getVMMemory(vm); memory != nil {
+		memoryMiB := memory.Value() / (1024 * 1024)
+		hardware.Items = append(hardware.Items, ovfItem{
+			AllocationUnits: "byte * 2^20",
+			ElementName:     fmt.Sprintf("%dMB of memory", memoryMiB),
+			InstanceID:      instanceID,
+			ResourceType:    resourceTypeMemory,
+			VirtualQuantity: memoryMiB,
+		})
+		instanceID++
+	}
+
+	controllerID := instanceID
+	hardware.Items = append(hardware.Items, ovfItem{
+		ElementName:     "SCSI Controller 0",
+		InstanceID:      controllerID,
+		ResourceSubType: "lsilogic",
+		ResourceType:    resourceTypeSCSIController,
+	})
+	instanceID++
+
+	for i, disk := range disks {
+		fileID := fmt.Sprintf("file%d", i)
+		diskID := fmt.Sprintf("vmdisk%d", i)
+		envelope.References = append(envelope.References, ovfFile{
+			ID:   fileID,
+			Href: disk.fileName,
+			Size: disk.size,
+		})
+		envelope.DiskSection.Disks = append(envelope.DiskSection.Disks, ovfDisk{
+			DiskID:                  diskID,
+			FileRef:                 fileID,
+			Capacity:                disk.capacity,
+			CapacityAllocationUnits: "byte",
+			Format:                  streamOptimizedURI,
+		})
+		address := i
+		parent := controllerID
+		hardware.Items = append(hardware.Items, ovfItem{
+			AddressOnParent: &address,
+			ElementName:     fmt.Sprintf("Hard Disk %d", i+1),
+			HostResource:    "ovf:/disk/" + diskID,
+			InstanceID:      instanceID,
+			Parent:          &parent,
+			ResourceType:    resourceTypeDisk,
+		})
+		instanceID++
+	}
+
+	out, err := xml.MarshalIndent(envelope, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+func getVMCPUCount(vm *virtv1.VirtualMachine) int64 {
+	if vm.Spec.Template == nil || vm.Spec.Template.Spec.Domain.CPU == nil {
+		return 1
+	}
+	cpu := vm.Spec.Template.Spec.Domain.CPU
+	count := int64(1)
+	for _, n := range []uint32{cpu.Sockets, cpu.Cores, cpu.Threads} {
+		if n > 0 {
+			count *= int64(n)
+		}
+	}
+	return count
+}
+
+func getVMMemory(vm *virtv1.VirtualMachine) *resource.Quantity {
+	if vm.Spec.Template == nil {
+		return nil
+	}
+	domain := vm.Spec.Template.Spec.Domain
+	if domain.Memory != nil && domain.Memory.Guest != nil {
+		return domain.Memory.Guest
+	}
+	if memory, ok := domain.Resources.Requests[corev1.ResourceMemory]; ok {
+		return &memory
+	}
+	if memory, ok := domain.Resources.Limits[corev1.ResourceMemory]; ok {
+		return &memory
+	}
+	return nil
+}
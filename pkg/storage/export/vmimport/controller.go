@@ -0,0 +1,688 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmimport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	importv1 "kubevirt.io/api/vmimport/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	describerPrefix  = "virt-import"
+	diskServerPrefix = "virt-import-disks"
+
+	// diskServerLabel selects the disk server pod of an import
+	diskServerLabel = "import.kubevirt.io/virtualmachineimport-disks"
+
+	secretPath         = "/secret"
+	secretVolume       = "secret"
+	certPath           = "/cert"
+	certVolume         = "cert"
+	credentialsPath    = "/credentials"
+	credentialsVolume  = "credentials"
+	diskServerPortName = "http"
+
+	readingDescriptionReason = "ReadingDescription"
+	inProgressReason         = "InProgress"
+	succeededReason          = "Succeeded"
+	failedReason             = "Failed"
+
+	describerPodCreatedEvent = "DescriberPodCreated"
+	diskServerCreatedEvent   = "DiskServerCreated"
+	vmCreatedEvent           = "VirtualMachineCreated"
+	vmImportedEvent          = "VirtualMachineImported"
+	vmImportFailedEvent      = "VirtualMachineImportFailed"
+)
+
+var importGVK = importv1.SchemeGroupVersion.WithKind("VirtualMachineImport")
+
+var currentTime = func() *metav1.Time {
+	t := metav1.Now()
+	return &t
+}
+
+type manifestRenderer interface {
+	RenderImporterManifest(vmImport *importv1.VirtualMachineImport, namePrefix string) *corev1.Pod
+}
+
+// VMImportController imports virtual machines described by OVA bundles, OVF descriptors and libvirt
+// domain XMLs, creating an equivalent virtual machine whose disks CDI imports into DataVolumes
+type VMImportController struct {
+	Client kubecli.KubevirtClient
+
+	ManifestRenderer manifestRenderer
+
+	VMImportInformer   cache.SharedIndexInformer
+	VMInformer         cache.SharedIndexInformer
+	DataVolumeInformer cache.SharedIndexInformer
+	PodInformer        cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	importQueue workqueue.TypedRateLimitingInterface[string]
+}
+
+// Init initializes the import controller
+func (ctrl *VMImportController) Init() error {
+	ctrl.importQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-export-vmimport"},
+	)
+
+	_, err := ctrl.VMImportInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleImport,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleImport(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+	_, err = ctrl.PodInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleOwnedObject,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleOwnedObject(newObj) },
+			DeleteFunc: ctrl.handleOwnedObject,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	for _, informer := range []cache.SharedIndexInformer{ctrl.VMInformer, ctrl.DataVolumeInformer} {
+		_, err = informer.AddEventHandler(
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    ctrl.handleImportedObject,
+				UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleImportedObject(newObj) },
+				DeleteFunc: ctrl.handleImportedObject,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run the controller
+func (ctrl *VMImportController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.importQueue.ShutDown()
+
+	log.Log.Info("Starting vm import controller.")
+	defer log.Log.Info("Shutting down vm import controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMImportInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+		ctrl.DataVolumeInformer.HasSynced,
+		ctrl.PodInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.importWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMImportController) importWorker() {
+	for ctrl.processImportWorkItem() {
+	}
+}
+
+func (ctrl *VMImportController) processImportWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.importQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmImport worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMImportInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		vmImport, ok := storeObj.(*importv1.VirtualMachineImport)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+
+		return 0, ctrl.updateImport(vmImport.DeepCopy())
+	})
+}
+
+func (ctrl *VMImportController) handleImport(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmImport, ok := obj.(*importv1.VirtualMachineImport); ok {
+		key, err := cache.MetaNamespaceKeyFunc(vmImport)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", vmImport, err)
+			return
+		}
+		log.Log.V(3).Infof("enqueued %q for sync", key)
+		ctrl.importQueue.Add(key)
+	}
+}
+
+func (ctrl *VMImportController) handleOwnedObject(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	o, ok := obj.(metav1.Object)
+	if !ok {
+		return
+	}
+	ownerRef := metav1.GetControllerOf(o)
+	if ownerRef == nil || ownerRef.Kind != importGVK.Kind || ownerRef.APIVersion != importGVK.GroupVersion().String() {
+		return
+	}
+	key := controller.NamespacedKey(o.GetNamespace(), ownerRef.Name)
+	log.Log.V(3).Infof("Adding VMImport due to owned object %s", key)
+	ctrl.importQueue.Add(key)
+}
+
+// handleImportedObject enqueues the import which created a virtual machine or DataVolume. They are not
+// owned by the import, they outlive it.
+func (ctrl *VMImportController) handleImportedObject(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	o, ok := obj.(metav1.Object)
+	if !ok {
+		return
+	}
+	name, imported := o.GetAnnotations()[ImportAnnotation]
+	if !imported {
+		return
+	}
+	key := controller.NamespacedKey(o.GetNamespace(), name)
+	log.Log.V(3).Infof("Adding VMImport due to imported object %s", key)
+	ctrl.importQueue.Add(key)
+}
+
+func (ctrl *VMImportController) updateImport(vmImport *importv1.VirtualMachineImport) error {
+	log.Log.V(3).Infof("Updating VirtualMachineImport %s/%s", vmImport.Namespace, vmImport.Name)
+
+	if vmImport.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if isFinished(vmImport) {
+		return ctrl.cleanup(vmImport)
+	}
+
+	importCopy := vmImport.DeepCopy()
+	if importCopy.Status == nil {
+		importCopy.Status = &importv1.VirtualMachineImportStatus{
+			Phase: importv1.ImportPending,
+		}
+	}
+
+	if err := ctrl.importVirtualMachine(importCopy); err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(vmImport.Status, importCopy.Status) {
+		return nil
+	}
+	_, err := ctrl.Client.VirtualMachineImport(importCopy.Namespace).UpdateStatus(context.Background(), importCopy, metav1.UpdateOptions{})
+	return err
+}
+
+// importVirtualMachine progresses the import, recording it in the status of the import
+func (ctrl *VMImportController) importVirtualMachine(vmImport *importv1.VirtualMachineImport) error {
+	status := vmImport.Status
+	vm, exists, err := ctrl.getVirtualMachine(vmImport)
+	if err != nil {
+		return err
+	}
+	if exists && vm.Annotations[ImportAnnotation] != vmImport.Name {
+		ctrl.setFailed(vmImport, fmt.Sprintf("VirtualMachine %s already exists", vm.Name))
+		return nil
+	}
+
+	if !exists {
+		description, failure, err := ctrl.getDescription(vmImport)
+		if err != nil {
+			return err
+		}
+		if failure != "" {
+			ctrl.setFailed(vmImport, failure)
+			return nil
+		}
+		if description == nil {
+			status.Phase = importv1.ImportPending
+			status.Conditions = updateCondition(status.Conditions, newReadyCondition(corev1.ConditionFalse, readingDescriptionReason, "Reading the description of the virtual machine"))
+			return nil
+		}
+
+		if vmImport.Spec.Source.Format == importv1.ImportFormatOVA {
+			if err := ctrl.createDiskServer(vmImport); err != nil {
+				return err
+			}
+		}
+		vm, err = newVirtualMachine(vmImport, description, ctrl.getDiskSourceFunc(vmImport))
+		if err != nil {
+			ctrl.setFailed(vmImport, err.Error())
+			return nil
+		}
+		vm, err = ctrl.Client.VirtualMachine(vmImport.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+		if err != nil {
+			return err
+		}
+		ctrl.Recorder.Eventf(vmImport, corev1.EventTypeNormal, vmCreatedEvent, "Created VirtualMachine %s/%s", vm.Namespace, vm.Name)
+	}
+	status.VirtualMachineName = pointer.P(vm.Name)
+
+	disks, failed, done, err := ctrl.getDiskProgress(vm)
+	if err != nil {
+		return err
+	}
+	status.Disks = disks
+	switch {
+	case failed != "":
+		ctrl.setFailed(vmImport, fmt.Sprintf("DataVolume %s failed to import", failed))
+	case done:
+		status.CompletionTime = currentTime()
+		status.Phase = importv1.ImportSucceeded
+		status.Conditions = updateCondition(status.Conditions, newReadyCondition(corev1.ConditionTrue, succeededReason, "Virtual machine imported"))
+		ctrl.Recorder.Eventf(vmImport, corev1.EventTypeNormal, vmImportedEvent, "Imported VirtualMachine %s", vm.Name)
+	default:
+		status.Phase = importv1.ImportImporting
+		status.Conditions = updateCondition(status.Conditions, newReadyCondition(corev1.ConditionFalse, inProgressReason, "Importing disks"))
+	}
+	return nil
+}
+
+func (ctrl *VMImportController) setFailed(vmImport *importv1.VirtualMachineImport, message string) {
+	vmImport.Status.Phase = importv1.ImportFailed
+	vmImport.Status.CompletionTime = currentTime()
+	vmImport.Status.Conditions = updateCondition(vmImport.Status.Conditions, newReadyCondition(corev1.ConditionFalse, failedReason, message))
+	ctrl.Recorder.Eventf(vmImport, corev1.EventTypeWarning, vmImportFailedEvent, "Failed to import virtual machine: %s", message)
+}
+
+func (ctrl *VMImportController) getVirtualMachine(vmImport *importv1.VirtualMachineImport) (*virtv1.VirtualMachine, bool, error) {
+	obj, exists, err := ctrl.VMInformer.GetStore().GetByKey(controller.NamespacedKey(vmImport.Namespace, getVirtualMachineName(vmImport)))
+	if err != nil || !exists {
+		return nil, exists, err
+	}
+	return obj.(*virtv1.VirtualMachine), true, nil
+}
+
+// getDescription returns the description of the virtual machine once it was read, or the reason it
+// could not be read. Uploaded descriptions are read from their ConfigMap, the others by a describer pod.
+func (ctrl *VMImportController) getDescription(vmImport *importv1.VirtualMachineImport) (*Description, string, error) {
+	source := vmImport.Spec.Source
+	if source.Upload != nil {
+		configMap, err := ctrl.Client.CoreV1().ConfigMaps(vmImport.Namespace).Get(context.Background(), source.Upload.ConfigMapName, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return nil, fmt.Sprintf("ConfigMap %s does not exist", source.Upload.ConfigMapName), nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		data, found := configMap.Data[source.Upload.Key]
+		if !found {
+			return nil, fmt.Sprintf("ConfigMap %s has no key %s", configMap.Name, source.Upload.Key), nil
+		}
+		description, err := ParseDescription(source.Format, strings.NewReader(data))
+		if err != nil {
+			return nil, err.Error(), nil
+		}
+		return description, "", nil
+	}
+
+	obj, exists, err := ctrl.PodInformer.GetStore().GetByKey(controller.NamespacedKey(vmImport.Namespace, getDescriberPodName(vmImport)))
+	if err != nil {
+		return nil, "", err
+	}
+	if !exists {
+		pod, err := ctrl.Client.CoreV1().Pods(vmImport.Namespace).Create(context.Background(), ctrl.createDescriberPodManifest(vmImport), metav1.CreateOptions{})
+		if err != nil {
+			return nil, "", err
+		}
+		ctrl.Recorder.Eventf(vmImport, corev1.EventTypeNormal, describerPodCreatedEvent, "Created describer pod %s/%s", pod.Namespace, pod.Name)
+		return nil, "", nil
+	}
+
+	pod := obj.(*corev1.Pod)
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		description := &Description{}
+		if err := json.Unmarshal([]byte(terminationMessage(pod)), description); err != nil {
+			return nil, fmt.Sprintf("failed to read the description: %v", err), nil
+		}
+		return description, "", nil
+	case corev1.PodFailed:
+		return nil, terminationMessage(pod), nil
+	default:
+		return nil, "", nil
+	}
+}
+
+// getDiskSourceFunc returns how the disks of the description are imported. The disks of OVA bundles
+// are served by the disk server of the import, the disks referenced by other descriptions are imported
+// from the location of the description, or uploaded along with it.
+func (ctrl *VMImportController) getDiskSourceFunc(vmImport *importv1.VirtualMachineImport) diskSourceFunc {
+	source := vmImport.Spec.Source
+	return func(disk *DiskDescription) (*cdiv1.DataVolumeSource, error) {
+		switch {
+		case source.Upload != nil:
+			return &cdiv1.DataVolumeSource{Upload: &cdiv1.DataVolumeSourceUpload{}}, nil
+		case source.Format == importv1.ImportFormatOVA:
+			return &cdiv1.DataVolumeSource{
+				HTTP: &cdiv1.DataVolumeSourceHTTP{
+					URL: fmt.Sprintf("http://%s.%s.svc:%d%s%s",
+						getDiskServerName(vmImport), vmImport.Namespace, DiskServerPort, DisksPath, url.PathEscape(disk.File)),
+					SecretRef: getDiskServerName(vmImport),
+				},
+			}, nil
+		default:
+			diskURL, err := resolveDiskURL(source.HTTP.URL, disk.File)
+			if err != nil {
+				return nil, err
+			}
+			return &cdiv1.DataVolumeSource{
+				HTTP: &cdiv1.DataVolumeSourceHTTP{
+					URL:           diskURL,
+					SecretRef:     source.HTTP.SecretRef,
+					CertConfigMap: source.HTTP.CertConfigMap,
+				},
+			}, nil
+		}
+	}
+}
+
+// getDiskProgress returns the progress of the DataVolumes of the virtual machine, the first failed
+// DataVolume, and whether all of them were imported
+func (ctrl *VMImportController) getDiskProgress(vm *virtv1.VirtualMachine) ([]importv1.ImportedDisk, string, bool, error) {
+	disks := getImportedDisks(vm)
+	done := true
+	for i := range disks {
+		obj, exists, err := ctrl.DataVolumeInformer.GetStore().GetByKey(controller.NamespacedKey(vm.Namespace, disks[i].DataVolumeName))
+		if err != nil {
+			return nil, "", false, err
+		}
+		if !exists {
+			done = false
+			continue
+		}
+		dataVolume := obj.(*cdiv1.DataVolume)
+		disks[i].Progress = string(dataVolume.Status.Progress)
+		switch dataVolume.Status.Phase {
+		case cdiv1.Succeeded:
+		case cdiv1.Failed:
+			return disks, dataVolume.Name, false, nil
+		default:
+			done = false
+		}
+	}
+	return disks, "", done, nil
+}
+
+func (ctrl *VMImportController) createDescriberPodManifest(vmImport *importv1.VirtualMachineImport) *corev1.Pod {
+	podManifest := ctrl.newImporterPodManifest(vmImport, describerPrefix)
+	container := &podManifest.Spec.Containers[0]
+	container.Args = []string{DescribeCommand}
+	container.TerminationMessagePath = TerminationMessagePath
+	container.TerminationMessagePolicy = corev1.TerminationMessageFallbackToLogsOnError
+	return podManifest
+}
+
+// newImporterPodManifest renders a pod reading the source of the import
+func (ctrl *VMImportController) newImporterPodManifest(vmImport *importv1.VirtualMachineImport, namePrefix string) *corev1.Pod {
+	source := vmImport.Spec.Source
+	podManifest := ctrl.ManifestRenderer.RenderImporterManifest(vmImport, namePrefix)
+	podManifest.Spec.SecurityContext = &corev1.PodSecurityContext{
+		RunAsNonRoot:   pointer.P(true),
+		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+
+	container := &podManifest.Spec.Containers[0]
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  formatEnv,
+		Value: string(source.Format),
+	}, corev1.EnvVar{
+		Name:  urlEnv,
+		Value: source.HTTP.URL,
+	})
+	if source.HTTP.SecretRef != "" {
+		container.Env = append(container.Env, corev1.EnvVar{Name: secretDirEnv, Value: secretPath})
+		podManifest.Spec.Volumes = append(podManifest.Spec.Volumes, corev1.Volume{
+			Name: secretVolume,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: source.HTTP.SecretRef},
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      secretVolume,
+			ReadOnly:  true,
+			MountPath: secretPath,
+		})
+	}
+	if source.HTTP.CertConfigMap != "" {
+		container.Env = append(container.Env, corev1.EnvVar{Name: certDirEnv, Value: certPath})
+		podManifest.Spec.Volumes = append(podManifest.Spec.Volumes, corev1.Volume{
+			Name: certVolume,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: source.HTTP.CertConfigMap},
+				},
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      certVolume,
+			ReadOnly:  true,
+			MountPath: certPath,
+		})
+	}
+
+	return podManifest
+}
+
+// createDiskServer creates the pod serving the disks of the OVA bundle, the service CDI reaches it through
+// and the secret holding the credentials CDI authenticates with
+func (ctrl *VMImportController) createDiskServer(vmImport *importv1.VirtualMachineImport) error {
+	name := getDiskServerName(vmImport)
+	ownerRefs := []metav1.OwnerReference{*metav1.NewControllerRef(vmImport, importGVK)}
+
+	password, err := util.GenerateVMExportToken()
+	if err != nil {
+		return err
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       vmImport.Namespace,
+			OwnerReferences: ownerRefs,
+		},
+		StringData: map[string]string{
+			accessKeyIDKey: "cdi",
+			secretKeyKey:   password,
+		},
+	}
+	if _, err := ctrl.Client.CoreV1().Secrets(vmImport.Namespace).Create(context.Background(), secret, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       vmImport.Namespace,
+			OwnerReferences: ownerRefs,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				diskServerLabel: vmImport.Name,
+			},
+			Ports: []corev1.ServicePort{{
+				Name:     diskServerPortName,
+				Port:     DiskServerPort,
+				Protocol: corev1.ProtocolTCP,
+			}},
+		},
+	}
+	if _, err := ctrl.Client.CoreV1().Services(vmImport.Namespace).Create(context.Background(), service, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	_, exists, err := ctrl.PodInformer.GetStore().GetByKey(controller.NamespacedKey(vmImport.Namespace, name))
+	if err != nil || exists {
+		return err
+	}
+	podManifest := ctrl.newImporterPodManifest(vmImport, diskServerPrefix)
+	podManifest.Labels[diskServerLabel] = vmImport.Name
+	podManifest.Spec.RestartPolicy = corev1.RestartPolicyOnFailure
+	container := &podManifest.Spec.Containers[0]
+	container.Args = []string{ServeDisksCommand}
+	container.Ports = []corev1.ContainerPort{{
+		Name:          diskServerPortName,
+		ContainerPort: DiskServerPort,
+		Protocol:      corev1.ProtocolTCP,
+	}}
+	container.Env = append(container.Env, corev1.EnvVar{Name: credentialsDirEnv, Value: credentialsPath})
+	podManifest.Spec.Volumes = append(podManifest.Spec.Volumes, corev1.Volume{
+		Name: credentialsVolume,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: name},
+		},
+	})
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      credentialsVolume,
+		ReadOnly:  true,
+		MountPath: credentialsPath,
+	})
+
+	pod, err := ctrl.Client.CoreV1().Pods(vmImport.Namespace).Create(context.Background(), podManifest, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	if err == nil {
+		ctrl.Recorder.Eventf(vmImport, corev1.EventTypeNormal, diskServerCreatedEvent, "Created disk server pod %s/%s", pod.Namespace, pod.Name)
+	}
+	return nil
+}
+
+// cleanup deletes the describer pod and the disk server once the import finished
+func (ctrl *VMImportController) cleanup(vmImport *importv1.VirtualMachineImport) error {
+	for _, name := range []string{getDescriberPodName(vmImport), getDiskServerName(vmImport)} {
+		obj, exists, err := ctrl.PodInformer.GetStore().GetByKey(controller.NamespacedKey(vmImport.Namespace, name))
+		if err != nil {
+			return err
+		}
+		if !exists || obj.(*corev1.Pod).DeletionTimestamp != nil {
+			continue
+		}
+		if err := ctrl.Client.CoreV1().Pods(vmImport.Namespace).Delete(context.Background(), name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	if vmImport.Spec.Source.Format != importv1.ImportFormatOVA {
+		return nil
+	}
+	name := getDiskServerName(vmImport)
+	if err := ctrl.Client.CoreV1().Services(vmImport.Namespace).Delete(context.Background(), name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if err := ctrl.Client.CoreV1().Secrets(vmImport.Namespace).Delete(context.Background(), name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func isFinished(vmImport *importv1.VirtualMachineImport) bool {
+	return vmImport.Status != nil &&
+		(vmImport.Status.Phase == importv1.ImportSucceeded || vmImport.Status.Phase == importv1.ImportFailed)
+}
+
+func getDescriberPodName(vmImport *importv1.VirtualMachineImport) string {
+	return naming.GetName(describerPrefix, vmImport.Name, validation.DNS1035LabelMaxLength)
+}
+
+func getDiskServerName(vmImport *importv1.VirtualMachineImport) string {
+	return naming.GetName(diskServerPrefix, vmImport.Name, validation.DNS1035LabelMaxLength)
+}
+
+// terminationMessage returns the message the describer container terminated with
+func terminationMessage(pod *corev1.Pod) string {
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.State.Terminated != nil {
+			return containerStatus.State.Terminated.Message
+		}
+	}
+	return ""
+}
+
+func newReadyCondition(status corev1.ConditionStatus, reason, message string) exportv1.Condition {
+	return exportv1.Condition{
+		Type:               exportv1.ConditionReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: *currentTime(),
+	}
+}
+
+func updateCondition(conditions []exportv1.Condition, c exportv1.Condition) []exportv1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == c.Type {
+			if conditions[i].Status != c.Status || conditions[i].Reason != c.Reason || conditions[i].Message != c.Message {
+				conditions[i] = c
+			}
+			return conditions
+		}
+	}
+	return append(conditions, c)
+}
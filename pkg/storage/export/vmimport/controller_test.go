@@ -0,0 +1,391 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmimport
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	virtv1 "kubevirt.io/api/core/v1"
+	importv1 "kubevirt.io/api/vmimport/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+)
+
+const (
+	testNamespace  = "default"
+	testImportName = "legacy"
+	testVMName     = "legacy-vm"
+)
+
+var _ = Describe("VM import controller", func() {
+	var (
+		controller     *VMImportController
+		k8sClient      *k8sfake.Clientset
+		kubevirtClient *kubevirtfake.Clientset
+		recorder       *record.FakeRecorder
+		timeStamp      = metav1.Now()
+	)
+
+	BeforeEach(func() {
+		currentTime = func() *metav1.Time {
+			return &timeStamp
+		}
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		importInformer, _ := testutils.NewFakeInformerFor(&importv1.VirtualMachineImport{})
+		vmInformer, _ := testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
+		dataVolumeInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+		podInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		rqInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
+		nsInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{})
+
+		k8sClient = k8sfake.NewSimpleClientset()
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		recorder = record.NewFakeRecorder(100)
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachineImport(testNamespace).
+			Return(kubevirtClient.ImportV1beta1().VirtualMachineImports(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(testNamespace).
+			Return(kubevirtClient.KubevirtV1().VirtualMachines(testNamespace)).AnyTimes()
+
+		controller = &VMImportController{
+			Client:             virtClient,
+			ManifestRenderer:   services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, 107, "g", rqInformer.GetStore(), nsInformer.GetStore()),
+			VMImportInformer:   importInformer,
+			VMInformer:         vmInformer,
+			DataVolumeInformer: dataVolumeInformer,
+			PodInformer:        podInformer,
+			Recorder:           recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		currentTime = func() *metav1.Time {
+			t := metav1.Now()
+			return &t
+		}
+	})
+
+	newImport := func(format importv1.ImportSourceFormat) *importv1.VirtualMachineImport {
+		return &importv1.VirtualMachineImport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testImportName,
+				Namespace: testNamespace,
+				UID:       "import-uid",
+			},
+			Spec: importv1.VirtualMachineImportSpec{
+				Source: importv1.VirtualMachineImportSource{
+					Format: format,
+					HTTP: &importv1.ImportSourceHTTP{
+						URL:           "https://images.example.com/vms/legacy." + string(format),
+						SecretRef:     "images-credentials",
+						CertConfigMap: "images-ca",
+					},
+				},
+				VirtualMachineName: testVMName,
+			},
+		}
+	}
+
+	addImport := func(vmImport *importv1.VirtualMachineImport) {
+		Expect(controller.VMImportInformer.GetStore().Add(vmImport)).To(Succeed())
+		_, err := kubevirtClient.ImportV1beta1().VirtualMachineImports(testNamespace).Create(context.Background(), vmImport, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getStatus := func() *importv1.VirtualMachineImportStatus {
+		vmImport, err := kubevirtClient.ImportV1beta1().VirtualMachineImports(testNamespace).Get(context.Background(), testImportName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vmImport.Status
+	}
+
+	terminatedPod := func(phase k8sv1.PodPhase, message string) *k8sv1.Pod {
+		return &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: getDescriberPodName(newImport(importv1.ImportFormatOVF)), Namespace: testNamespace},
+			Status: k8sv1.PodStatus{
+				Phase: phase,
+				ContainerStatuses: []k8sv1.ContainerStatus{{
+					State: k8sv1.ContainerState{
+						Terminated: &k8sv1.ContainerStateTerminated{Message: message},
+					},
+				}},
+			},
+		}
+	}
+
+	describedPod := func() *k8sv1.Pod {
+		return terminatedPod(k8sv1.PodSucceeded, `{"name":"legacy-app","sockets":1,"cores":1,"threads":1,"memory":1073741824,`+
+			`"disks":[{"name":"vmdisk1","file":"disk1.vmdk","capacity":10737418240,"bus":"scsi"}]}`)
+	}
+
+	getVM := func() *virtv1.VirtualMachine {
+		vm, err := kubevirtClient.KubevirtV1().VirtualMachines(testNamespace).Get(context.Background(), testVMName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vm
+	}
+
+	importedVM := func() *virtv1.VirtualMachine {
+		vm, err := newVirtualMachine(newImport(importv1.ImportFormatOVF), &Description{
+			Memory: 1 << 30,
+			Disks:  []DiskDescription{{Name: "vmdisk1", File: "disk1.vmdk", Capacity: 10 << 30}},
+		}, func(*DiskDescription) (*cdiv1.DataVolumeSource, error) {
+			return &cdiv1.DataVolumeSource{Upload: &cdiv1.DataVolumeSourceUpload{}}, nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return vm
+	}
+
+	dataVolume := func(phase cdiv1.DataVolumePhase, progress string) *cdiv1.DataVolume {
+		return &cdiv1.DataVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "legacy-vm-disk0", Namespace: testNamespace},
+			Status: cdiv1.DataVolumeStatus{
+				Phase:    phase,
+				Progress: cdiv1.DataVolumeProgress(progress),
+			},
+		}
+	}
+
+	It("should start the describer pod", func() {
+		vmImport := newImport(importv1.ImportFormatOVF)
+		addImport(vmImport)
+
+		Expect(controller.updateImport(vmImport)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(importv1.ImportPending))
+		Expect(status.Conditions).To(ConsistOf(newReadyCondition(k8sv1.ConditionFalse, readingDescriptionReason, "Reading the description of the virtual machine")))
+		testutils.ExpectEvent(recorder, describerPodCreatedEvent)
+
+		pod, err := k8sClient.CoreV1().Pods(testNamespace).Get(context.Background(), getDescriberPodName(vmImport), metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(metav1.IsControlledBy(pod, vmImport)).To(BeTrue())
+		container := pod.Spec.Containers[0]
+		Expect(container.Args).To(Equal([]string{DescribeCommand}))
+		Expect(container.TerminationMessagePath).To(Equal(TerminationMessagePath))
+		Expect(container.Env).To(ContainElements(
+			k8sv1.EnvVar{Name: formatEnv, Value: "ovf"},
+			k8sv1.EnvVar{Name: urlEnv, Value: "https://images.example.com/vms/legacy.ovf"},
+			k8sv1.EnvVar{Name: secretDirEnv, Value: secretPath},
+			k8sv1.EnvVar{Name: certDirEnv, Value: certPath},
+		))
+		Expect(pod.Spec.Volumes).To(ContainElements(
+			k8sv1.Volume{Name: secretVolume, VolumeSource: k8sv1.VolumeSource{
+				Secret: &k8sv1.SecretVolumeSource{SecretName: "images-credentials"},
+			}},
+			k8sv1.Volume{Name: certVolume, VolumeSource: k8sv1.VolumeSource{
+				ConfigMap: &k8sv1.ConfigMapVolumeSource{LocalObjectReference: k8sv1.LocalObjectReference{Name: "images-ca"}},
+			}},
+		))
+	})
+
+	It("should create the virtual machine once the description was read", func() {
+		vmImport := newImport(importv1.ImportFormatOVF)
+		addImport(vmImport)
+		Expect(controller.PodInformer.GetStore().Add(describedPod())).To(Succeed())
+
+		Expect(controller.updateImport(vmImport)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(importv1.ImportImporting))
+		Expect(status.VirtualMachineName).To(HaveValue(Equal(testVMName)))
+		Expect(status.Disks).To(Equal([]importv1.ImportedDisk{{Name: "vmdisk1", DataVolumeName: "legacy-vm-disk0"}}))
+		testutils.ExpectEvent(recorder, vmCreatedEvent)
+
+		vm := getVM()
+		Expect(vm.Annotations).To(HaveKeyWithValue(ImportAnnotation, testImportName))
+		Expect(vm.Spec.DataVolumeTemplates[0].Spec.Source.HTTP).To(Equal(&cdiv1.DataVolumeSourceHTTP{
+			URL:           "https://images.example.com/vms/disk1.vmdk",
+			SecretRef:     "images-credentials",
+			CertConfigMap: "images-ca",
+		}))
+	})
+
+	It("should serve the disks of OVA bundles", func() {
+		vmImport := newImport(importv1.ImportFormatOVA)
+		addImport(vmImport)
+		Expect(controller.PodInformer.GetStore().Add(describedPod())).To(Succeed())
+
+		Expect(controller.updateImport(vmImport)).To(Succeed())
+		testutils.ExpectEvents(recorder, diskServerCreatedEvent, vmCreatedEvent)
+
+		name := getDiskServerName(vmImport)
+		secret, err := k8sClient.CoreV1().Secrets(testNamespace).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret.StringData).To(HaveKey(secretKeyKey))
+		service, err := k8sClient.CoreV1().Services(testNamespace).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(service.Spec.Selector).To(HaveKeyWithValue(diskServerLabel, testImportName))
+		pod, err := k8sClient.CoreV1().Pods(testNamespace).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Labels).To(HaveKeyWithValue(diskServerLabel, testImportName))
+		Expect(pod.Spec.Containers[0].Args).To(Equal([]string{ServeDisksCommand}))
+		Expect(pod.Spec.Containers[0].Env).To(ContainElement(k8sv1.EnvVar{Name: credentialsDirEnv, Value: credentialsPath}))
+
+		Expect(getVM().Spec.DataVolumeTemplates[0].Spec.Source.HTTP).To(Equal(&cdiv1.DataVolumeSourceHTTP{
+			URL:       "http://" + name + ".default.svc:8080/disks/disk1.vmdk",
+			SecretRef: name,
+		}))
+	})
+
+	It("should read uploaded descriptions from their ConfigMap", func() {
+		vmImport := newImport(importv1.ImportFormatLibvirt)
+		vmImport.Spec.Source.HTTP = nil
+		vmImport.Spec.Source.Upload = &importv1.ImportSourceUpload{ConfigMapName: "domain", Key: "domain.xml"}
+		addImport(vmImport)
+		_, err := k8sClient.CoreV1().ConfigMaps(testNamespace).Create(context.Background(), &k8sv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "domain", Namespace: testNamespace},
+			Data:       map[string]string{"domain.xml": testLibvirtXML},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(controller.updateImport(vmImport)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(importv1.ImportFailed))
+		Expect(status.Conditions).To(ConsistOf(newReadyCondition(k8sv1.ConditionFalse, failedReason,
+			"the size of disk vda is unknown, it has to be set by a disk mapping")))
+		testutils.ExpectEvent(recorder, vmImportFailedEvent)
+	})
+
+	It("should fail when the describer pod fails", func() {
+		vmImport := newImport(importv1.ImportFormatOVF)
+		addImport(vmImport)
+		Expect(controller.PodInformer.GetStore().Add(terminatedPod(k8sv1.PodFailed, "fetching returned 404 Not Found"))).To(Succeed())
+
+		Expect(controller.updateImport(vmImport)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(importv1.ImportFailed))
+		Expect(status.Conditions).To(ConsistOf(newReadyCondition(k8sv1.ConditionFalse, failedReason, "fetching returned 404 Not Found")))
+		testutils.ExpectEvent(recorder, vmImportFailedEvent)
+	})
+
+	It("should fail when another virtual machine has the name", func() {
+		vmImport := newImport(importv1.ImportFormatOVF)
+		addImport(vmImport)
+		Expect(controller.VMInformer.GetStore().Add(&virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: testVMName, Namespace: testNamespace},
+		})).To(Succeed())
+
+		Expect(controller.updateImport(vmImport)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(importv1.ImportFailed))
+		Expect(status.Conditions).To(ConsistOf(newReadyCondition(k8sv1.ConditionFalse, failedReason, "VirtualMachine legacy-vm already exists")))
+	})
+
+	It("should report the progress of the DataVolumes", func() {
+		vmImport := newImport(importv1.ImportFormatOVF)
+		addImport(vmImport)
+		Expect(controller.VMInformer.GetStore().Add(importedVM())).To(Succeed())
+		Expect(controller.DataVolumeInformer.GetStore().Add(dataVolume(cdiv1.ImportInProgress, "42.0%"))).To(Succeed())
+
+		Expect(controller.updateImport(vmImport)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(importv1.ImportImporting))
+		Expect(status.Disks).To(Equal([]importv1.ImportedDisk{{Name: "vmdisk1", DataVolumeName: "legacy-vm-disk0", Progress: "42.0%"}}))
+		Expect(status.Conditions).To(ConsistOf(newReadyCondition(k8sv1.ConditionFalse, inProgressReason, "Importing disks")))
+	})
+
+	It("should succeed once the DataVolumes were imported", func() {
+		vmImport := newImport(importv1.ImportFormatOVF)
+		addImport(vmImport)
+		Expect(controller.VMInformer.GetStore().Add(importedVM())).To(Succeed())
+		Expect(controller.DataVolumeInformer.GetStore().Add(dataVolume(cdiv1.Succeeded, "100.0%"))).To(Succeed())
+
+		Expect(controller.updateImport(vmImport)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(importv1.ImportSucceeded))
+		Expect(status.CompletionTime).To(Equal(&timeStamp))
+		Expect(status.Conditions).To(ConsistOf(newReadyCondition(k8sv1.ConditionTrue, succeededReason, "Virtual machine imported")))
+		testutils.ExpectEvent(recorder, vmImportedEvent)
+	})
+
+	It("should fail when a DataVolume fails", func() {
+		vmImport := newImport(importv1.ImportFormatOVF)
+		addImport(vmImport)
+		Expect(controller.VMInformer.GetStore().Add(importedVM())).To(Succeed())
+		Expect(controller.DataVolumeInformer.GetStore().Add(dataVolume(cdiv1.Failed, ""))).To(Succeed())
+
+		Expect(controller.updateImport(vmImport)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(importv1.ImportFailed))
+		Expect(status.Conditions).To(ConsistOf(newReadyCondition(k8sv1.ConditionFalse, failedReason, "DataVolume legacy-vm-disk0 failed to import")))
+	})
+
+	It("should delete the describer pod and the disk server once finished", func() {
+		vmImport := newImport(importv1.ImportFormatOVA)
+		vmImport.Status = &importv1.VirtualMachineImportStatus{Phase: importv1.ImportSucceeded}
+		name := getDiskServerName(vmImport)
+		for _, pod := range []*k8sv1.Pod{describedPod(), {ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace}}} {
+			Expect(controller.PodInformer.GetStore().Add(pod)).To(Succeed())
+			_, err := k8sClient.CoreV1().Pods(testNamespace).Create(context.Background(), pod, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+		_, err := k8sClient.CoreV1().Services(testNamespace).Create(context.Background(), &k8sv1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = k8sClient.CoreV1().Secrets(testNamespace).Create(context.Background(), &k8sv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(controller.updateImport(vmImport)).To(Succeed())
+		pods, err := k8sClient.CoreV1().Pods(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pods.Items).To(BeEmpty())
+		services, err := k8sClient.CoreV1().Services(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(services.Items).To(BeEmpty())
+		secrets, err := k8sClient.CoreV1().Secrets(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secrets.Items).To(BeEmpty())
+	})
+
+	It("should enqueue the import which created a DataVolume", func() {
+		controller.handleImportedObject(&cdiv1.DataVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "legacy-vm-disk0",
+				Namespace:   testNamespace,
+				Annotations: map[string]string{ImportAnnotation: testImportName},
+			},
+		})
+		Eventually(controller.importQueue.Len, time.Second).Should(Equal(1))
+		key, _ := controller.importQueue.Get()
+		Expect(key).To(Equal(testNamespace + "/" + testImportName))
+	})
+
+	It("should ignore DataVolumes not created by an import", func() {
+		controller.handleImportedObject(dataVolume(cdiv1.Succeeded, ""))
+		Consistently(controller.importQueue.Len, 100*time.Millisecond).Should(BeZero())
+	})
+})
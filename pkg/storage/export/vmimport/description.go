@@ -0,0 +1,407 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmimport
+
+import (
+	"archive/tar"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+
+	importv1 "kubevirt.io/api/vmimport/v1beta1"
+)
+
+const (
+	// source buses of the described disks
+	busIDE    = "ide"
+	busSATA   = "sata"
+	busSCSI   = "scsi"
+	busVirtio = "virtio"
+
+	// CIM resource types of the OVF virtual hardware items
+	ovfProcessor      = 3
+	ovfMemory         = 4
+	ovfIDEController  = 5
+	ovfSCSIController = 6
+	ovfEthernet       = 10
+	ovfDisk           = 17
+	ovfSATAController = 20
+
+	ovfDiskPrefix = "ovf:/disk/"
+	ovfFilePrefix = "ovf:/file/"
+)
+
+// Description is the virtual machine read from an OVF descriptor or a libvirt domain XML
+type Description struct {
+	Name       string                 `json:"name,omitempty"`
+	Sockets    uint32                 `json:"sockets,omitempty"`
+	Cores      uint32                 `json:"cores,omitempty"`
+	Threads    uint32                 `json:"threads,omitempty"`
+	Memory     int64                  `json:"memory,omitempty"`
+	EFI        bool                   `json:"efi,omitempty"`
+	Disks      []DiskDescription      `json:"disks,omitempty"`
+	Interfaces []InterfaceDescription `json:"interfaces,omitempty"`
+}
+
+// DiskDescription is a disk of a described virtual machine
+type DiskDescription struct {
+	// Name is the OVF disk id or the libvirt target device
+	Name string `json:"name"`
+	// File is the location of the disk image, relative to the description
+	File string `json:"file,omitempty"`
+	// Capacity is the virtual size of the disk in bytes, zero when the description does not record it
+	Capacity int64 `json:"capacity,omitempty"`
+	// Bus is the bus the disk is attached to in the source
+	Bus string `json:"bus,omitempty"`
+}
+
+// InterfaceDescription is a network interface of a described virtual machine
+type InterfaceDescription struct {
+	// Network is the OVF network name or the libvirt network or bridge name
+	Network    string `json:"network,omitempty"`
+	Model      string `json:"model,omitempty"`
+	MACAddress string `json:"macAddress,omitempty"`
+}
+
+// ParseDescription reads the virtual machine described in the given format. OVA bundles are read up to their OVF descriptor.
+func ParseDescription(format importv1.ImportSourceFormat, r io.Reader) (*Description, error) {
+	switch format {
+	case importv1.ImportFormatOVA:
+		return ReadOVA(r)
+	case importv1.ImportFormatOVF:
+		return ParseOVF(r)
+	case importv1.ImportFormatLibvirt:
+		return ParseLibvirtXML(r)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// ReadOVA reads the OVF descriptor of an OVA bundle, which the specification requires to be its first entry
+func ReadOVA(r io.Reader) (*Description, error) {
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("the OVA bundle holds no OVF descriptor")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag == tar.TypeReg && strings.EqualFold(path.Ext(header.Name), ".ovf") {
+			return ParseOVF(tr)
+		}
+	}
+}
+
+type ovfEnvelope struct {
+	References struct {
+		Files []struct {
+			ID   string `xml:"id,attr"`
+			Href string `xml:"href,attr"`
+		} `xml:"File"`
+	} `xml:"References"`
+	DiskSection struct {
+		Disks []struct {
+			DiskID                  string `xml:"diskId,attr"`
+			FileRef                 string `xml:"fileRef,attr"`
+			Capacity                string `xml:"capacity,attr"`
+			CapacityAllocationUnits string `xml:"capacityAllocationUnits,attr"`
+		} `xml:"Disk"`
+	} `xml:"DiskSection"`
+	VirtualSystem struct {
+		ID       string `xml:"id,attr"`
+		Name     string `xml:"Name"`
+		Hardware struct {
+			Items   []ovfItem `xml:"Item"`
+			Configs []struct {
+				Key   string `xml:"key,attr"`
+				Value string `xml:"value,attr"`
+			} `xml:"Config"`
+		} `xml:"VirtualHardwareSection"`
+	} `xml:"VirtualSystem"`
+}
+
+type ovfItem struct {
+	InstanceID      string `xml:"InstanceID"`
+	ResourceType    int    `xml:"ResourceType"`
+	ResourceSubType string `xml:"ResourceSubType"`
+	VirtualQuantity int64  `xml:"VirtualQuantity"`
+	AllocationUnits string `xml:"AllocationUnits"`
+	Parent          string `xml:"Parent"`
+	HostResource    string `xml:"HostResource"`
+	Connection      string `xml:"Connection"`
+	Address         string `xml:"Address"`
+}
+
+// ParseOVF reads the virtual machine described by an OVF descriptor
+func ParseOVF(r io.Reader) (*Description, error) {
+	envelope := &ovfEnvelope{}
+	if err := xml.NewDecoder(r).Decode(envelope); err != nil {
+		return nil, fmt.Errorf("failed to parse the OVF descriptor: %v", err)
+	}
+	system := &envelope.VirtualSystem
+
+	description := &Description{
+		Name:    system.Name,
+		Sockets: 1,
+		Cores:   1,
+		Threads: 1,
+	}
+	if description.Name == "" {
+		description.Name = system.ID
+	}
+	for _, config := range system.Hardware.Configs {
+		if config.Key == "firmware" && strings.EqualFold(config.Value, "efi") {
+			description.EFI = true
+		}
+	}
+
+	files := map[string]string{}
+	for _, file := range envelope.References.Files {
+		files[file.ID] = file.Href
+	}
+	controllers := map[string]string{}
+	for _, item := range system.Hardware.Items {
+		switch item.ResourceType {
+		case ovfIDEController:
+			controllers[item.InstanceID] = busIDE
+		case ovfSCSIController:
+			controllers[item.InstanceID] = busSCSI
+		case ovfSATAController:
+			controllers[item.InstanceID] = busSATA
+		}
+	}
+	diskBuses := map[string]string{}
+
+	for _, item := range system.Hardware.Items {
+		switch item.ResourceType {
+		case ovfProcessor:
+			if item.VirtualQuantity <= 0 || item.VirtualQuantity > math.MaxUint32 {
+				return nil, fmt.Errorf("invalid processor count %d", item.VirtualQuantity)
+			}
+			// each virtual processor is a socket unless the descriptor tells otherwise
+			description.Sockets = uint32(item.VirtualQuantity)
+		case ovfMemory:
+			units, err := parseAllocationUnits(item.AllocationUnits, 1<<20)
+			if err != nil {
+				return nil, err
+			}
+			description.Memory = item.VirtualQuantity * units
+		case ovfDisk:
+			if strings.HasPrefix(item.HostResource, ovfDiskPrefix) {
+				diskBuses[strings.TrimPrefix(item.HostResource, ovfDiskPrefix)] = controllers[item.Parent]
+			}
+		case ovfEthernet:
+			description.Interfaces = append(description.Interfaces, InterfaceDescription{
+				Network:    item.Connection,
+				Model:      item.ResourceSubType,
+				MACAddress: item.Address,
+			})
+		}
+	}
+
+	for _, disk := range envelope.DiskSection.Disks {
+		bus, attached := diskBuses[disk.DiskID]
+		if !attached {
+			continue
+		}
+		href, ok := files[disk.FileRef]
+		if !ok {
+			return nil, fmt.Errorf("disk %s references unknown file %q", disk.DiskID, disk.FileRef)
+		}
+		capacity, err := strconv.ParseInt(disk.Capacity, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid capacity of disk %s: %v", disk.DiskID, err)
+		}
+		units, err := parseAllocationUnits(disk.CapacityAllocationUnits, 1)
+		if err != nil {
+			return nil, err
+		}
+		description.Disks = append(description.Disks, DiskDescription{
+			Name:     disk.DiskID,
+			File:     href,
+			Capacity: capacity * units,
+			Bus:      bus,
+		})
+	}
+
+	return description, nil
+}
+
+// parseAllocationUnits returns the bytes of an OVF allocation unit, either a programmatic unit
+// such as "byte * 2^20" or a unit name such as "MegaBytes"
+func parseAllocationUnits(units string, defaultUnits int64) (int64, error) {
+	normalized := strings.ToLower(strings.ReplaceAll(units, " ", ""))
+	switch normalized {
+	case "":
+		return defaultUnits, nil
+	case "byte", "bytes":
+		return 1, nil
+	case "kilobytes", "kb":
+		return 1 << 10, nil
+	case "megabytes", "mb":
+		return 1 << 20, nil
+	case "gigabytes", "gb":
+		return 1 << 30, nil
+	case "terabytes", "tb":
+		return 1 << 40, nil
+	}
+	if exponent, found := strings.CutPrefix(normalized, "byte*2^"); found {
+		n, err := strconv.Atoi(exponent)
+		if err == nil && n >= 0 && n < 63 {
+			return 1 << n, nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported allocation units %q", units)
+}
+
+type libvirtDomain struct {
+	Name   string `xml:"name"`
+	Memory struct {
+		Unit  string `xml:"unit,attr"`
+		Value int64  `xml:",chardata"`
+	} `xml:"memory"`
+	VCPU int64 `xml:"vcpu"`
+	OS   struct {
+		Firmware string `xml:"firmware,attr"`
+		Loader   *struct {
+			Type string `xml:"type,attr"`
+		} `xml:"loader"`
+	} `xml:"os"`
+	CPU struct {
+		Topology *struct {
+			Sockets uint32 `xml:"sockets,attr"`
+			Cores   uint32 `xml:"cores,attr"`
+			Threads uint32 `xml:"threads,attr"`
+		} `xml:"topology"`
+	} `xml:"cpu"`
+	Devices struct {
+		Disks []struct {
+			Device string `xml:"device,attr"`
+			Source struct {
+				File string `xml:"file,attr"`
+			} `xml:"source"`
+			Target struct {
+				Dev string `xml:"dev,attr"`
+				Bus string `xml:"bus,attr"`
+			} `xml:"target"`
+		} `xml:"disk"`
+		Interfaces []struct {
+			MAC struct {
+				Address string `xml:"address,attr"`
+			} `xml:"mac"`
+			Source struct {
+				Network string `xml:"network,attr"`
+				Bridge  string `xml:"bridge,attr"`
+			} `xml:"source"`
+			Model struct {
+				Type string `xml:"type,attr"`
+			} `xml:"model"`
+		} `xml:"interface"`
+	} `xml:"devices"`
+}
+
+// ParseLibvirtXML reads the virtual machine described by a libvirt domain XML. CD-ROMs and floppies are
+// not imported.
+func ParseLibvirtXML(r io.Reader) (*Description, error) {
+	domain := &libvirtDomain{}
+	if err := xml.NewDecoder(r).Decode(domain); err != nil {
+		return nil, fmt.Errorf("failed to parse the libvirt domain XML: %v", err)
+	}
+
+	units, err := parseLibvirtUnit(domain.Memory.Unit)
+	if err != nil {
+		return nil, err
+	}
+	description := &Description{
+		Name:    domain.Name,
+		Memory:  domain.Memory.Value * units,
+		Sockets: 1,
+		Cores:   1,
+		Threads: 1,
+		EFI:     domain.OS.Firmware == "efi" || (domain.OS.Loader != nil && domain.OS.Loader.Type == "pflash"),
+	}
+	if topology := domain.CPU.Topology; topology != nil && topology.Sockets > 0 && topology.Cores > 0 && topology.Threads > 0 {
+		description.Sockets = topology.Sockets
+		description.Cores = topology.Cores
+		description.Threads = topology.Threads
+	} else if domain.VCPU > 0 && domain.VCPU <= math.MaxUint32 {
+		description.Sockets = uint32(domain.VCPU)
+	}
+
+	for _, disk := range domain.Devices.Disks {
+		if disk.Device != "" && disk.Device != "disk" {
+			continue
+		}
+		if disk.Source.File == "" {
+			return nil, fmt.Errorf("disk %s is not backed by a file", disk.Target.Dev)
+		}
+		description.Disks = append(description.Disks, DiskDescription{
+			Name: disk.Target.Dev,
+			File: disk.Source.File,
+			Bus:  disk.Target.Bus,
+		})
+	}
+
+	for _, iface := range domain.Devices.Interfaces {
+		network := iface.Source.Network
+		if network == "" {
+			network = iface.Source.Bridge
+		}
+		description.Interfaces = append(description.Interfaces, InterfaceDescription{
+			Network:    network,
+			Model:      iface.Model.Type,
+			MACAddress: iface.MAC.Address,
+		})
+	}
+
+	return description, nil
+}
+
+// parseLibvirtUnit returns the bytes of a libvirt memory unit, KiB when unset
+func parseLibvirtUnit(unit string) (int64, error) {
+	switch unit {
+	case "b", "bytes":
+		return 1, nil
+	case "KB":
+		return 1000, nil
+	case "", "k", "KiB":
+		return 1 << 10, nil
+	case "MB":
+		return 1000 * 1000, nil
+	case "M", "MiB":
+		return 1 << 20, nil
+	case "GB":
+		return 1000 * 1000 * 1000, nil
+	case "G", "GiB":
+		return 1 << 30, nil
+	case "TB":
+		return 1000 * 1000 * 1000 * 1000, nil
+	case "T", "TiB":
+		return 1 << 40, nil
+	default:
+		return 0, fmt.Errorf("unsupported memory unit %q", unit)
+	}
+}
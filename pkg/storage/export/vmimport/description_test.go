@@ -0,0 +1,218 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmimport
+
+import (
+	"archive/tar"
+	"bytes"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	importv1 "kubevirt.io/api/vmimport/v1beta1"
+)
+
+const testOVF = `<?xml version="1.0" encoding="UTF-8"?>
+<Envelope xmlns="http://schemas.dmtf.org/ovf/envelope/1"
+    xmlns:ovf="http://schemas.dmtf.org/ovf/envelope/1"
+    xmlns:rasd="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ResourceAllocationSettingData"
+    xmlns:vmw="http://www.vmware.com/schema/ovf">
+  <References>
+    <File ovf:id="file1" ovf:href="vm-disk1.vmdk"/>
+    <File ovf:id="file2" ovf:href="vm-disk2.vmdk"/>
+  </References>
+  <DiskSection>
+    <Disk ovf:diskId="vmdisk1" ovf:fileRef="file1" ovf:capacity="16" ovf:capacityAllocationUnits="byte * 2^30"/>
+    <Disk ovf:diskId="vmdisk2" ovf:fileRef="file2" ovf:capacity="1073741824"/>
+  </DiskSection>
+  <NetworkSection>
+    <Network ovf:name="VM Network"/>
+  </NetworkSection>
+  <VirtualSystem ovf:id="legacy">
+    <Name>legacy-app</Name>
+    <VirtualHardwareSection>
+      <Item>
+        <rasd:InstanceID>1</rasd:InstanceID>
+        <rasd:ResourceType>3</rasd:ResourceType>
+        <rasd:VirtualQuantity>2</rasd:VirtualQuantity>
+      </Item>
+      <Item>
+        <rasd:AllocationUnits>byte * 2^20</rasd:AllocationUnits>
+        <rasd:InstanceID>2</rasd:InstanceID>
+        <rasd:ResourceType>4</rasd:ResourceType>
+        <rasd:VirtualQuantity>4096</rasd:VirtualQuantity>
+      </Item>
+      <Item>
+        <rasd:InstanceID>3</rasd:InstanceID>
+        <rasd:ResourceSubType>lsilogic</rasd:ResourceSubType>
+        <rasd:ResourceType>6</rasd:ResourceType>
+      </Item>
+      <Item>
+        <rasd:InstanceID>4</rasd:InstanceID>
+        <rasd:ResourceType>5</rasd:ResourceType>
+      </Item>
+      <Item>
+        <rasd:HostResource>ovf:/disk/vmdisk1</rasd:HostResource>
+        <rasd:InstanceID>5</rasd:InstanceID>
+        <rasd:Parent>3</rasd:Parent>
+        <rasd:ResourceType>17</rasd:ResourceType>
+      </Item>
+      <Item>
+        <rasd:HostResource>ovf:/disk/vmdisk2</rasd:HostResource>
+        <rasd:InstanceID>6</rasd:InstanceID>
+        <rasd:Parent>4</rasd:Parent>
+        <rasd:ResourceType>17</rasd:ResourceType>
+      </Item>
+      <Item>
+        <rasd:Address>00:50:56:01:02:03</rasd:Address>
+        <rasd:Connection>VM Network</rasd:Connection>
+        <rasd:InstanceID>7</rasd:InstanceID>
+        <rasd:ResourceSubType>VmxNet3</rasd:ResourceSubType>
+        <rasd:ResourceType>10</rasd:ResourceType>
+      </Item>
+      <vmw:Config ovf:required="false" vmw:key="firmware" vmw:value="efi"/>
+    </VirtualHardwareSection>
+  </VirtualSystem>
+</Envelope>`
+
+const testLibvirtXML = `<domain type='kvm'>
+  <name>webserver</name>
+  <memory unit='GiB'>2</memory>
+  <vcpu placement='static'>4</vcpu>
+  <os firmware='efi'>
+    <type arch='x86_64' machine='q35'>hvm</type>
+  </os>
+  <cpu mode='host-passthrough'>
+    <topology sockets='1' dies='1' cores='2' threads='2'/>
+  </cpu>
+  <devices>
+    <disk type='file' device='disk'>
+      <driver name='qemu' type='qcow2'/>
+      <source file='/var/lib/libvirt/images/webserver.qcow2'/>
+      <target dev='vda' bus='virtio'/>
+    </disk>
+    <disk type='file' device='cdrom'>
+      <source file='/var/lib/libvirt/images/install.iso'/>
+      <target dev='sda' bus='sata'/>
+    </disk>
+    <interface type='network'>
+      <mac address='52:54:00:aa:bb:cc'/>
+      <source network='default'/>
+      <model type='virtio'/>
+    </interface>
+    <interface type='bridge'>
+      <source bridge='br0'/>
+      <model type='e1000e'/>
+    </interface>
+  </devices>
+</domain>`
+
+var _ = Describe("Import descriptions", func() {
+	expectedOVFDescription := func() *Description {
+		return &Description{
+			Name:    "legacy-app",
+			Sockets: 2,
+			Cores:   1,
+			Threads: 1,
+			Memory:  4096 << 20,
+			EFI:     true,
+			Disks: []DiskDescription{
+				{Name: "vmdisk1", File: "vm-disk1.vmdk", Capacity: 16 << 30, Bus: busSCSI},
+				{Name: "vmdisk2", File: "vm-disk2.vmdk", Capacity: 1 << 30, Bus: busIDE},
+			},
+			Interfaces: []InterfaceDescription{
+				{Network: "VM Network", Model: "VmxNet3", MACAddress: "00:50:56:01:02:03"},
+			},
+		}
+	}
+
+	It("should parse an OVF descriptor", func() {
+		description, err := ParseOVF(strings.NewReader(testOVF))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(description).To(Equal(expectedOVFDescription()))
+	})
+
+	It("should read the OVF descriptor of an OVA bundle", func() {
+		var bundle bytes.Buffer
+		tw := tar.NewWriter(&bundle)
+		Expect(tw.WriteHeader(&tar.Header{Name: "vm.ovf", Mode: 0644, Size: int64(len(testOVF)), Typeflag: tar.TypeReg})).To(Succeed())
+		_, err := tw.Write([]byte(testOVF))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tw.Close()).To(Succeed())
+
+		description, err := ParseDescription(importv1.ImportFormatOVA, &bundle)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(description).To(Equal(expectedOVFDescription()))
+	})
+
+	It("should fail on an OVA bundle without OVF descriptor", func() {
+		var bundle bytes.Buffer
+		tw := tar.NewWriter(&bundle)
+		Expect(tw.WriteHeader(&tar.Header{Name: "disk.vmdk", Mode: 0644, Typeflag: tar.TypeReg})).To(Succeed())
+		Expect(tw.Close()).To(Succeed())
+
+		_, err := ReadOVA(&bundle)
+		Expect(err).To(MatchError("the OVA bundle holds no OVF descriptor"))
+	})
+
+	It("should parse a libvirt domain XML", func() {
+		description, err := ParseDescription(importv1.ImportFormatLibvirt, strings.NewReader(testLibvirtXML))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(description).To(Equal(&Description{
+			Name:    "webserver",
+			Sockets: 1,
+			Cores:   2,
+			Threads: 2,
+			Memory:  2 << 30,
+			EFI:     true,
+			Disks: []DiskDescription{
+				{Name: "vda", File: "/var/lib/libvirt/images/webserver.qcow2", Bus: busVirtio},
+			},
+			Interfaces: []InterfaceDescription{
+				{Network: "default", Model: "virtio", MACAddress: "52:54:00:aa:bb:cc"},
+				{Network: "br0", Model: "e1000e"},
+			},
+		}))
+	})
+
+	It("should reject a libvirt disk not backed by a file", func() {
+		_, err := ParseLibvirtXML(strings.NewReader(`<domain><name>vm</name><memory>1024</memory><devices>
+			<disk type='block' device='disk'><source dev='/dev/sdb'/><target dev='vdb' bus='virtio'/></disk>
+			</devices></domain>`))
+		Expect(err).To(MatchError("disk vdb is not backed by a file"))
+	})
+
+	DescribeTable("should parse OVF allocation units", func(units string, expected int64) {
+		bytes, err := parseAllocationUnits(units, 1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bytes).To(Equal(expected))
+	},
+		Entry("default", "", int64(1)),
+		Entry("programmatic", "byte * 2^20", int64(1<<20)),
+		Entry("programmatic without spaces", "byte*2^30", int64(1<<30)),
+		Entry("named", "GigaBytes", int64(1<<30)),
+	)
+
+	It("should reject unsupported OVF allocation units", func() {
+		_, err := parseAllocationUnits("hertz * 10^6", 1)
+		Expect(err).To(MatchError(`unsupported allocation units "hertz * 10^6"`))
+	})
+})
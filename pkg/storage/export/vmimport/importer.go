@@ -0,0 +1,296 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmimport
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	importv1 "kubevirt.io/api/vmimport/v1beta1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// DescribeCommand is the argument making the exporter image read the description of an imported virtual machine
+	DescribeCommand = "describe-import"
+	// ServeDisksCommand is the argument making the exporter image serve the disks of an imported OVA bundle
+	ServeDisksCommand = "serve-import-disks"
+
+	// TerminationMessagePath is where the describing container reports the description
+	TerminationMessagePath = "/dev/termination-log"
+
+	// DisksPath is the path the disks of OVA bundles are served under
+	DisksPath = "/disks/"
+	// DiskServerPort is the port the disks of OVA bundles are served on
+	DiskServerPort = 8080
+
+	formatEnv         = "IMPORT_FORMAT"
+	urlEnv            = "IMPORT_URL"
+	secretDirEnv      = "IMPORT_SECRET_DIR"
+	certDirEnv        = "IMPORT_CERT_DIR"
+	credentialsDirEnv = "IMPORT_DISKS_CREDENTIALS_DIR"
+
+	// keys of the secrets holding the credentials of web servers, as CDI expects them
+	accessKeyIDKey = "accessKeyId"
+	secretKeyKey   = "secretKey"
+
+	describeTimeout = 10 * time.Minute
+)
+
+// ImporterConfig holds the configuration of the describing and disk serving commands
+type ImporterConfig struct {
+	// Format is the format of the source
+	Format importv1.ImportSourceFormat
+	// URL is the location of the source
+	URL string
+	// AccessKeyID and SecretKey are the credentials of the web server, none when empty
+	AccessKeyID string
+	SecretKey   string
+	// CACerts are the PEM encoded certificate authorities of the web server, the system roots when empty
+	CACerts []byte
+	// Username and Password are the credentials CDI serves the disks with
+	Username string
+	Password string
+}
+
+// ImporterConfigFromEnv creates the importer configuration from the environment of the importer pod
+func ImporterConfigFromEnv(env map[string]string) (*ImporterConfig, error) {
+	config := &ImporterConfig{
+		Format: importv1.ImportSourceFormat(env[formatEnv]),
+		URL:    env[urlEnv],
+	}
+	if config.Format == "" || config.URL == "" {
+		return nil, fmt.Errorf("import configuration incomplete")
+	}
+
+	var err error
+	if dir := env[secretDirEnv]; dir != "" {
+		if config.AccessKeyID, config.SecretKey, err = readCredentials(dir); err != nil {
+			return nil, err
+		}
+	}
+	if dir := env[credentialsDirEnv]; dir != "" {
+		if config.Username, config.Password, err = readCredentials(dir); err != nil {
+			return nil, err
+		}
+	}
+	if dir := env[certDirEnv]; dir != "" {
+		if config.CACerts, err = readCerts(dir); err != nil {
+			return nil, err
+		}
+	}
+
+	return config, nil
+}
+
+func readCredentials(dir string) (string, string, error) {
+	accessKeyID, err := os.ReadFile(filepath.Join(dir, accessKeyIDKey))
+	if err != nil {
+		return "", "", err
+	}
+	secretKey, err := os.ReadFile(filepath.Join(dir, secretKeyKey))
+	if err != nil {
+		return "", "", err
+	}
+	return strings.TrimSpace(string(accessKeyID)), strings.TrimSpace(string(secretKey)), nil
+}
+
+// readCerts concatenates the certificates of a mounted ConfigMap, skipping the hidden entries of the mount
+func readCerts(dir string) ([]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var certs []byte
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		cert, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert...)
+		certs = append(certs, '\n')
+	}
+	return certs, nil
+}
+
+// DescribeSource reads the description of the virtual machine from the web server
+func DescribeSource(config *ImporterConfig) (*Description, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), describeTimeout)
+	defer cancel()
+
+	body, err := openSource(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return ParseDescription(config.Format, body)
+}
+
+// WriteResult reports the description, or the error reading it, in the termination message of the pod
+func WriteResult(terminationMessagePath string, description *Description, describeErr error) error {
+	message := []byte(fmt.Sprintf("%v", describeErr))
+	if describeErr == nil {
+		var err error
+		if message, err = json.Marshal(description); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(terminationMessagePath, message, 0644)
+}
+
+func openSource(ctx context.Context, config *ImporterConfig) (io.ReadCloser, error) {
+	httpClient, err := newHTTPClient(config.CACerts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, config.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if config.AccessKeyID != "" || config.SecretKey != "" {
+		req.SetBasicAuth(config.AccessKeyID, config.SecretKey)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("fetching %s returned %s", config.URL, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+func newHTTPClient(caCerts []byte) (*http.Client, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(caCerts) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCerts) {
+			return nil, fmt.Errorf("failed to parse the certificate authorities of the web server")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport}, nil
+}
+
+// NewDiskServer returns the handler serving the disks of the OVA bundle to CDI. CDI cannot extract a
+// single entry of a tar archive, each request streams the bundle up to the requested disk. Range
+// requests are not supported, CDI downloads the disks to scratch space before converting them.
+func NewDiskServer(config *ImporterConfig) http.Handler {
+	return &diskServer{
+		config: config,
+		open: func(ctx context.Context) (io.ReadCloser, error) {
+			return openSource(ctx, config)
+		},
+	}
+}
+
+type diskServer struct {
+	config *ImporterConfig
+	open   func(ctx context.Context) (io.ReadCloser, error)
+}
+
+func (s *diskServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	username, password, ok := r.BasicAuth()
+	if !ok ||
+		subtle.ConstantTimeCompare([]byte(username), []byte(s.config.Username)) != 1 ||
+		subtle.ConstantTimeCompare([]byte(password), []byte(s.config.Password)) != 1 {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	name, found := strings.CutPrefix(r.URL.Path, DisksPath)
+	if !found || name == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	bundle, err := s.open(r.Context())
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to open the OVA bundle")
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer bundle.Close()
+
+	tr := tar.NewReader(bundle)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Log.Reason(err).Error("Failed to read the OVA bundle")
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		if header.Typeflag != tar.TypeReg || path.Clean(header.Name) != path.Clean(name) {
+			continue
+		}
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Length", strconv.FormatInt(header.Size, 10))
+		w.Header().Set("Accept-Ranges", "none")
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodHead {
+			return
+		}
+		if _, err := io.Copy(w, tr); err != nil {
+			log.Log.Reason(err).Errorf("Failed to serve disk %s", name)
+		}
+		return
+	}
+}
+
+// ServeDisks serves the disks of the OVA bundle until the server fails
+func ServeDisks(config *ImporterConfig) error {
+	mux := http.NewServeMux()
+	mux.Handle(DisksPath, NewDiskServer(config))
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", DiskServerPort),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return server.ListenAndServe()
+}
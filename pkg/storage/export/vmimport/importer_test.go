@@ -0,0 +1,190 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmimport
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	importv1 "kubevirt.io/api/vmimport/v1beta1"
+)
+
+var _ = Describe("Importer", func() {
+	Context("configuration", func() {
+		var tmpDir string
+
+		BeforeEach(func() {
+			tmpDir = GinkgoT().TempDir()
+		})
+
+		writeFile := func(dir, name, content string) {
+			Expect(os.MkdirAll(dir, 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, name), []byte(content), 0600)).To(Succeed())
+		}
+
+		It("should read the configuration from the environment", func() {
+			secretDir := filepath.Join(tmpDir, "secret")
+			writeFile(secretDir, accessKeyIDKey, "user\n")
+			writeFile(secretDir, secretKeyKey, "pass\n")
+			credentialsDir := filepath.Join(tmpDir, "credentials")
+			writeFile(credentialsDir, accessKeyIDKey, "cdi")
+			writeFile(credentialsDir, secretKeyKey, "token")
+			certDir := filepath.Join(tmpDir, "cert")
+			writeFile(certDir, "ca.pem", "cert")
+			writeFile(certDir, "..data", "ignored")
+
+			config, err := ImporterConfigFromEnv(map[string]string{
+				formatEnv:         "ova",
+				urlEnv:            "https://images.example.com/vm.ova",
+				secretDirEnv:      secretDir,
+				credentialsDirEnv: credentialsDir,
+				certDirEnv:        certDir,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).To(Equal(&ImporterConfig{
+				Format:      importv1.ImportFormatOVA,
+				URL:         "https://images.example.com/vm.ova",
+				AccessKeyID: "user",
+				SecretKey:   "pass",
+				CACerts:     []byte("cert\n"),
+				Username:    "cdi",
+				Password:    "token",
+			}))
+		})
+
+		It("should fail when the configuration is incomplete", func() {
+			_, err := ImporterConfigFromEnv(map[string]string{formatEnv: "ova"})
+			Expect(err).To(MatchError("import configuration incomplete"))
+		})
+
+		It("should report the description in the termination message", func() {
+			path := filepath.Join(tmpDir, "termination-log")
+			description := &Description{Name: "vm", Memory: 1 << 30}
+			Expect(WriteResult(path, description, nil)).To(Succeed())
+			content, err := os.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			read := &Description{}
+			Expect(json.Unmarshal(content, read)).To(Succeed())
+			Expect(read).To(Equal(description))
+
+			Expect(WriteResult(path, nil, fmt.Errorf("fetching failed"))).To(Succeed())
+			content, err = os.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(Equal("fetching failed"))
+		})
+	})
+
+	It("should read the description from the web server", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, pass, ok := r.BasicAuth()
+			if !ok || user != "user" || pass != "pass" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(testLibvirtXML))
+		}))
+		defer server.Close()
+
+		description, err := DescribeSource(&ImporterConfig{
+			Format:      importv1.ImportFormatLibvirt,
+			URL:         server.URL + "/webserver.xml",
+			AccessKeyID: "user",
+			SecretKey:   "pass",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(description.Name).To(Equal("webserver"))
+
+		_, err = DescribeSource(&ImporterConfig{Format: importv1.ImportFormatLibvirt, URL: server.URL + "/webserver.xml"})
+		Expect(err).To(MatchError(ContainSubstring("401 Unauthorized")))
+	})
+
+	Context("disk server", func() {
+		var handler http.Handler
+
+		BeforeEach(func() {
+			var bundle bytes.Buffer
+			tw := tar.NewWriter(&bundle)
+			for name, content := range map[string]string{"vm.ovf": testOVF, "vm-disk1.vmdk": "disk content"} {
+				Expect(tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg})).To(Succeed())
+				_, err := tw.Write([]byte(content))
+				Expect(err).ToNot(HaveOccurred())
+			}
+			Expect(tw.Close()).To(Succeed())
+
+			handler = &diskServer{
+				config: &ImporterConfig{Username: "cdi", Password: "token"},
+				open: func(context.Context) (io.ReadCloser, error) {
+					return io.NopCloser(bytes.NewReader(bundle.Bytes())), nil
+				},
+			}
+		})
+
+		request := func(method, path string, authenticated bool) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(method, path, nil)
+			if authenticated {
+				req.SetBasicAuth("cdi", "token")
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			return rr
+		}
+
+		It("should stream a disk of the bundle", func() {
+			rr := request(http.MethodGet, DisksPath+"vm-disk1.vmdk", true)
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			Expect(rr.Body.String()).To(Equal("disk content"))
+			Expect(rr.Header().Get("Content-Length")).To(Equal("12"))
+			Expect(rr.Header().Get("Accept-Ranges")).To(Equal("none"))
+		})
+
+		It("should answer HEAD requests without body", func() {
+			rr := request(http.MethodHead, DisksPath+"vm-disk1.vmdk", true)
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			Expect(rr.Header().Get("Content-Length")).To(Equal("12"))
+			Expect(rr.Body.Len()).To(BeZero())
+		})
+
+		It("should reject unauthenticated requests", func() {
+			rr := request(http.MethodGet, DisksPath+"vm-disk1.vmdk", false)
+			Expect(rr.Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("should not find disks missing from the bundle", func() {
+			rr := request(http.MethodGet, DisksPath+"vm-disk2.vmdk", true)
+			Expect(rr.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("should reject other methods", func() {
+			rr := request(http.MethodPost, DisksPath+"vm-disk1.vmdk", true)
+			Expect(rr.Code).To(Equal(http.StatusMethodNotAllowed))
+		})
+	})
+})
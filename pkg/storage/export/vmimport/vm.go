@@ -0,0 +1,295 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmimport
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	virtv1 "kubevirt.io/api/core/v1"
+	importv1 "kubevirt.io/api/vmimport/v1beta1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	// ImportAnnotation marks the virtual machines and DataVolumes created by a VirtualMachineImport
+	ImportAnnotation = "import.kubevirt.io/virtualmachineimport"
+	// ImportDiskAnnotation records the disk of the description a DataVolume is imported from
+	ImportDiskAnnotation = "import.kubevirt.io/virtualmachineimport-disk"
+)
+
+// supportedModels maps the interface models of the sources to the models KubeVirt supports,
+// the other models are replaced with virtio
+var supportedModels = map[string]string{
+	"e1000":   "e1000",
+	"e1000e":  "e1000e",
+	"rtl8139": "rtl8139",
+	"pcnet32": "pcnet",
+	"pcnet":   "pcnet",
+	"virtio":  virtv1.VirtIO,
+}
+
+// diskSourceFunc returns the DataVolume source a described disk is imported from
+type diskSourceFunc func(disk *DiskDescription) (*cdiv1.DataVolumeSource, error)
+
+func getVirtualMachineName(vmImport *importv1.VirtualMachineImport) string {
+	if vmImport.Spec.VirtualMachineName != "" {
+		return vmImport.Spec.VirtualMachineName
+	}
+	return vmImport.Name
+}
+
+// newVirtualMachine synthesizes the virtual machine equivalent to the description, importing its disks
+// into DataVolumes. The virtual machine is halted, it is started once the user reviewed it.
+func newVirtualMachine(vmImport *importv1.VirtualMachineImport, description *Description, diskSource diskSourceFunc) (*virtv1.VirtualMachine, error) {
+	if description.Memory <= 0 {
+		return nil, fmt.Errorf("the description does not set the memory of the virtual machine")
+	}
+
+	vm := &virtv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getVirtualMachineName(vmImport),
+			Namespace: vmImport.Namespace,
+			Annotations: map[string]string{
+				ImportAnnotation: vmImport.Name,
+			},
+		},
+		Spec: virtv1.VirtualMachineSpec{
+			RunStrategy: pointer.P(virtv1.RunStrategyHalted),
+			Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+				Spec: virtv1.VirtualMachineInstanceSpec{
+					Domain: virtv1.DomainSpec{
+						CPU: &virtv1.CPU{
+							Sockets: description.Sockets,
+							Cores:   description.Cores,
+							Threads: description.Threads,
+						},
+						Memory: &virtv1.Memory{
+							Guest: resource.NewQuantity(description.Memory, resource.BinarySI),
+						},
+					},
+				},
+			},
+		},
+	}
+	spec := &vm.Spec.Template.Spec
+	if description.EFI {
+		spec.Domain.Firmware = &virtv1.Firmware{
+			Bootloader: &virtv1.Bootloader{
+				EFI: &virtv1.EFI{SecureBoot: pointer.P(false)},
+			},
+		}
+	}
+
+	if err := addDisks(vm, vmImport, description, diskSource); err != nil {
+		return nil, err
+	}
+	if err := addInterfaces(spec, vmImport.Spec.NetworkMappings, description.Interfaces); err != nil {
+		return nil, err
+	}
+
+	return vm, nil
+}
+
+func addDisks(vm *virtv1.VirtualMachine, vmImport *importv1.VirtualMachineImport, description *Description, diskSource diskSourceFunc) error {
+	mappings := map[string]*importv1.ImportDiskMapping{}
+	for i := range vmImport.Spec.DiskMappings {
+		mappings[vmImport.Spec.DiskMappings[i].Source] = &vmImport.Spec.DiskMappings[i]
+	}
+
+	spec := &vm.Spec.Template.Spec
+	for i := range description.Disks {
+		disk := &description.Disks[i]
+		mapping := mappings[disk.Name]
+		if mapping == nil {
+			mapping = &importv1.ImportDiskMapping{}
+		}
+
+		var size resource.Quantity
+		switch {
+		case mapping.Size != nil:
+			size = *mapping.Size
+		case disk.Capacity > 0:
+			size = *resource.NewQuantity(disk.Capacity, resource.BinarySI)
+		default:
+			return fmt.Errorf("the size of disk %s is unknown, it has to be set by a disk mapping", disk.Name)
+		}
+		storageClassName := mapping.StorageClassName
+		if storageClassName == nil {
+			storageClassName = vmImport.Spec.StorageClassName
+		}
+		bus := mapping.Bus
+		if bus == "" {
+			bus = getDiskBus(disk.Bus)
+		}
+		source, err := diskSource(disk)
+		if err != nil {
+			return err
+		}
+
+		name := fmt.Sprintf("disk%d", i)
+		dataVolumeName := naming.GetName(vm.Name, name, validation.DNS1123SubdomainMaxLength)
+		vm.Spec.DataVolumeTemplates = append(vm.Spec.DataVolumeTemplates, virtv1.DataVolumeTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: dataVolumeName,
+				Annotations: map[string]string{
+					ImportAnnotation:     vmImport.Name,
+					ImportDiskAnnotation: disk.Name,
+				},
+			},
+			Spec: cdiv1.DataVolumeSpec{
+				Source: source,
+				Storage: &cdiv1.StorageSpec{
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceStorage: size,
+						},
+					},
+					StorageClassName: storageClassName,
+				},
+			},
+		})
+		spec.Domain.Devices.Disks = append(spec.Domain.Devices.Disks, virtv1.Disk{
+			Name: name,
+			DiskDevice: virtv1.DiskDevice{
+				Disk: &virtv1.DiskTarget{Bus: virtv1.DiskBus(bus)},
+			},
+		})
+		spec.Volumes = append(spec.Volumes, virtv1.Volume{
+			Name: name,
+			VolumeSource: virtv1.VolumeSource{
+				DataVolume: &virtv1.DataVolumeSource{Name: dataVolumeName},
+			},
+		})
+	}
+	return nil
+}
+
+// getImportedDisks returns the disks the virtual machine imports, in the order of the description
+func getImportedDisks(vm *virtv1.VirtualMachine) []importv1.ImportedDisk {
+	var disks []importv1.ImportedDisk
+	for _, template := range vm.Spec.DataVolumeTemplates {
+		if name, imported := template.Annotations[ImportDiskAnnotation]; imported {
+			disks = append(disks, importv1.ImportedDisk{
+				Name:           name,
+				DataVolumeName: template.Name,
+			})
+		}
+	}
+	return disks
+}
+
+// getDiskBus maps the bus of a source disk to a bus of the virtual machine. IDE is not supported
+// by KubeVirt, IDE disks are attached to SATA instead.
+func getDiskBus(sourceBus string) importv1.ImportDiskBus {
+	switch sourceBus {
+	case busIDE, busSATA:
+		return importv1.ImportDiskBusSATA
+	case busSCSI:
+		return importv1.ImportDiskBusSCSI
+	default:
+		return importv1.ImportDiskBusVirtio
+	}
+}
+
+// addInterfaces connects the interfaces to the networks they are mapped to. An interface without
+// mapping is connected to the pod network, unless another interface already is.
+func addInterfaces(spec *virtv1.VirtualMachineInstanceSpec, networkMappings []importv1.ImportNetworkMapping, interfaces []InterfaceDescription) error {
+	if len(interfaces) == 0 {
+		spec.Domain.Devices.AutoattachPodInterface = pointer.P(false)
+		return nil
+	}
+
+	mappings := map[string]*importv1.ImportNetworkMapping{}
+	podNetworkUsed := false
+	for i := range networkMappings {
+		mappings[networkMappings[i].Source] = &networkMappings[i]
+	}
+	for _, iface := range interfaces {
+		if mapping, mapped := mappings[iface.Network]; mapped && mapping.Pod != nil {
+			podNetworkUsed = true
+		}
+	}
+
+	podInterfaces := 0
+	for i, iface := range interfaces {
+		name := fmt.Sprintf("nic%d", i)
+		network := virtv1.Network{Name: name}
+		vmInterface := virtv1.Interface{
+			Name:       name,
+			Model:      getInterfaceModel(iface.Model),
+			MacAddress: iface.MACAddress,
+		}
+
+		mapping, mapped := mappings[iface.Network]
+		switch {
+		case mapped && mapping.MultusNetworkName != "":
+			network.Multus = &virtv1.MultusNetwork{NetworkName: mapping.MultusNetworkName}
+			vmInterface.Bridge = &virtv1.InterfaceBridge{}
+		case mapped || !podNetworkUsed:
+			podNetworkUsed = true
+			podInterfaces++
+			network.Pod = &virtv1.PodNetwork{}
+			vmInterface.Masquerade = &virtv1.InterfaceMasquerade{}
+		default:
+			return fmt.Errorf("network %q of interface %d is not mapped", iface.Network, i)
+		}
+		spec.Networks = append(spec.Networks, network)
+		spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, vmInterface)
+	}
+	if podInterfaces > 1 {
+		return fmt.Errorf("%d interfaces are mapped to the pod network, only one can be", podInterfaces)
+	}
+	return nil
+}
+
+func getInterfaceModel(sourceModel string) string {
+	if model, supported := supportedModels[strings.ToLower(sourceModel)]; supported {
+		return model
+	}
+	return virtv1.VirtIO
+}
+
+// resolveDiskURL returns the URL a disk referenced by a description served at descriptionURL is imported
+// from. Libvirt domain XMLs reference absolute paths on the source host, the disks are expected next to
+// the description.
+func resolveDiskURL(descriptionURL, file string) (string, error) {
+	base, err := url.Parse(descriptionURL)
+	if err != nil {
+		return "", err
+	}
+	if path.IsAbs(file) {
+		file = path.Base(file)
+	}
+	ref, err := url.Parse(file)
+	if err != nil {
+		return "", err
+	}
+	return base.ResolveReference(ref).String(), nil
+}
@@ -0,0 +1,231 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmimport
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	virtv1 "kubevirt.io/api/core/v1"
+	importv1 "kubevirt.io/api/vmimport/v1beta1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Imported virtual machine", func() {
+	newImport := func() *importv1.VirtualMachineImport {
+		return &importv1.VirtualMachineImport{
+			ObjectMeta: metav1.ObjectMeta{Name: "legacy", Namespace: "default"},
+			Spec: importv1.VirtualMachineImportSpec{
+				Source: importv1.VirtualMachineImportSource{
+					Format: importv1.ImportFormatOVF,
+					HTTP:   &importv1.ImportSourceHTTP{URL: "https://images.example.com/vms/legacy.ovf"},
+				},
+				StorageClassName: pointer.P("fast"),
+			},
+		}
+	}
+
+	newDescription := func() *Description {
+		return &Description{
+			Name:    "legacy-app",
+			Sockets: 2,
+			Cores:   1,
+			Threads: 1,
+			Memory:  4 << 30,
+			Disks: []DiskDescription{
+				{Name: "vmdisk1", File: "disk1.vmdk", Capacity: 16 << 30, Bus: busIDE},
+				{Name: "vmdisk2", File: "disk2.vmdk", Capacity: 1 << 30, Bus: busSCSI},
+			},
+			Interfaces: []InterfaceDescription{
+				{Network: "VM Network", Model: "E1000", MACAddress: "00:50:56:01:02:03"},
+			},
+		}
+	}
+
+	uploadSource := func(*DiskDescription) (*cdiv1.DataVolumeSource, error) {
+		return &cdiv1.DataVolumeSource{Upload: &cdiv1.DataVolumeSourceUpload{}}, nil
+	}
+
+	It("should synthesize a halted virtual machine", func() {
+		vm, err := newVirtualMachine(newImport(), newDescription(), uploadSource)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm.Name).To(Equal("legacy"))
+		Expect(vm.Annotations).To(HaveKeyWithValue(ImportAnnotation, "legacy"))
+		Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(virtv1.RunStrategyHalted)))
+
+		domain := vm.Spec.Template.Spec.Domain
+		Expect(domain.CPU).To(Equal(&virtv1.CPU{Sockets: 2, Cores: 1, Threads: 1}))
+		Expect(domain.Memory.Guest.Value()).To(Equal(int64(4 << 30)))
+		Expect(domain.Firmware).To(BeNil())
+	})
+
+	It("should boot EFI virtual machines with EFI", func() {
+		description := newDescription()
+		description.EFI = true
+		vm, err := newVirtualMachine(newImport(), description, uploadSource)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm.Spec.Template.Spec.Domain.Firmware.Bootloader.EFI).To(Equal(&virtv1.EFI{SecureBoot: pointer.P(false)}))
+	})
+
+	It("should import the disks into DataVolumes", func() {
+		vmImport := newImport()
+		size := resource.MustParse("20Gi")
+		vmImport.Spec.DiskMappings = []importv1.ImportDiskMapping{{
+			Source:           "vmdisk2",
+			Bus:              importv1.ImportDiskBusVirtio,
+			Size:             &size,
+			StorageClassName: pointer.P("slow"),
+		}}
+
+		vm, err := newVirtualMachine(vmImport, newDescription(), uploadSource)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(2))
+		dataVolume := vm.Spec.DataVolumeTemplates[0]
+		Expect(dataVolume.Name).To(Equal("legacy-disk0"))
+		Expect(dataVolume.Annotations).To(HaveKeyWithValue(ImportDiskAnnotation, "vmdisk1"))
+		Expect(dataVolume.Spec.Source.Upload).ToNot(BeNil())
+		Expect(dataVolume.Spec.Storage.Resources.Requests[corev1.ResourceStorage]).To(Equal(*resource.NewQuantity(16<<30, resource.BinarySI)))
+		Expect(dataVolume.Spec.Storage.StorageClassName).To(HaveValue(Equal("fast")))
+		dataVolume = vm.Spec.DataVolumeTemplates[1]
+		Expect(dataVolume.Spec.Storage.Resources.Requests[corev1.ResourceStorage]).To(Equal(size))
+		Expect(dataVolume.Spec.Storage.StorageClassName).To(HaveValue(Equal("slow")))
+
+		spec := vm.Spec.Template.Spec
+		Expect(spec.Domain.Devices.Disks).To(Equal([]virtv1.Disk{
+			{Name: "disk0", DiskDevice: virtv1.DiskDevice{Disk: &virtv1.DiskTarget{Bus: virtv1.DiskBusSATA}}},
+			{Name: "disk1", DiskDevice: virtv1.DiskDevice{Disk: &virtv1.DiskTarget{Bus: virtv1.DiskBusVirtio}}},
+		}))
+		Expect(spec.Volumes[1].DataVolume.Name).To(Equal("legacy-disk1"))
+		Expect(getImportedDisks(vm)).To(Equal([]importv1.ImportedDisk{
+			{Name: "vmdisk1", DataVolumeName: "legacy-disk0"},
+			{Name: "vmdisk2", DataVolumeName: "legacy-disk1"},
+		}))
+	})
+
+	It("should require the size of disks without capacity", func() {
+		description := newDescription()
+		description.Disks[0].Capacity = 0
+		_, err := newVirtualMachine(newImport(), description, uploadSource)
+		Expect(err).To(MatchError("the size of disk vmdisk1 is unknown, it has to be set by a disk mapping"))
+	})
+
+	It("should fail when the disk source fails", func() {
+		_, err := newVirtualMachine(newImport(), newDescription(), func(*DiskDescription) (*cdiv1.DataVolumeSource, error) {
+			return nil, fmt.Errorf("no source")
+		})
+		Expect(err).To(MatchError("no source"))
+	})
+
+	DescribeTable("should map disk buses", func(sourceBus string, expected importv1.ImportDiskBus) {
+		Expect(getDiskBus(sourceBus)).To(Equal(expected))
+	},
+		Entry("IDE to SATA", busIDE, importv1.ImportDiskBusSATA),
+		Entry("SATA to SATA", busSATA, importv1.ImportDiskBusSATA),
+		Entry("SCSI to SCSI", busSCSI, importv1.ImportDiskBusSCSI),
+		Entry("virtio to virtio", busVirtio, importv1.ImportDiskBusVirtio),
+		Entry("unknown to virtio", "", importv1.ImportDiskBusVirtio),
+	)
+
+	It("should connect an unmapped interface to the pod network", func() {
+		vm, err := newVirtualMachine(newImport(), newDescription(), uploadSource)
+		Expect(err).ToNot(HaveOccurred())
+		spec := vm.Spec.Template.Spec
+		Expect(spec.Networks).To(Equal([]virtv1.Network{{
+			Name:          "nic0",
+			NetworkSource: virtv1.NetworkSource{Pod: &virtv1.PodNetwork{}},
+		}}))
+		Expect(spec.Domain.Devices.Interfaces).To(Equal([]virtv1.Interface{{
+			Name:                   "nic0",
+			Model:                  "e1000",
+			MacAddress:             "00:50:56:01:02:03",
+			InterfaceBindingMethod: virtv1.InterfaceBindingMethod{Masquerade: &virtv1.InterfaceMasquerade{}},
+		}}))
+	})
+
+	It("should connect interfaces to the networks they are mapped to", func() {
+		vmImport := newImport()
+		vmImport.Spec.NetworkMappings = []importv1.ImportNetworkMapping{
+			{Source: "storage", Pod: &importv1.ImportPodNetwork{}},
+			{Source: "VM Network", MultusNetworkName: "default/vlan10"},
+		}
+		description := newDescription()
+		description.Interfaces = append(description.Interfaces, InterfaceDescription{Network: "storage", Model: "VmxNet3"})
+
+		vm, err := newVirtualMachine(vmImport, description, uploadSource)
+		Expect(err).ToNot(HaveOccurred())
+		spec := vm.Spec.Template.Spec
+		Expect(spec.Networks).To(Equal([]virtv1.Network{
+			{Name: "nic0", NetworkSource: virtv1.NetworkSource{Multus: &virtv1.MultusNetwork{NetworkName: "default/vlan10"}}},
+			{Name: "nic1", NetworkSource: virtv1.NetworkSource{Pod: &virtv1.PodNetwork{}}},
+		}))
+		Expect(spec.Domain.Devices.Interfaces[0].Bridge).ToNot(BeNil())
+		Expect(spec.Domain.Devices.Interfaces[1].Masquerade).ToNot(BeNil())
+		Expect(spec.Domain.Devices.Interfaces[1].Model).To(Equal(virtv1.VirtIO))
+	})
+
+	It("should fail when an unmapped interface cannot be connected to the pod network", func() {
+		vmImport := newImport()
+		vmImport.Spec.NetworkMappings = []importv1.ImportNetworkMapping{
+			{Source: "storage", Pod: &importv1.ImportPodNetwork{}},
+		}
+		description := newDescription()
+		description.Interfaces = append(description.Interfaces, InterfaceDescription{Network: "storage"})
+
+		_, err := newVirtualMachine(vmImport, description, uploadSource)
+		Expect(err).To(MatchError(`network "VM Network" of interface 0 is not mapped`))
+	})
+
+	It("should fail when several interfaces are mapped to the pod network", func() {
+		vmImport := newImport()
+		vmImport.Spec.NetworkMappings = []importv1.ImportNetworkMapping{
+			{Source: "VM Network", Pod: &importv1.ImportPodNetwork{}},
+		}
+		description := newDescription()
+		description.Interfaces = append(description.Interfaces, InterfaceDescription{Network: "VM Network"})
+
+		_, err := newVirtualMachine(vmImport, description, uploadSource)
+		Expect(err).To(MatchError("2 interfaces are mapped to the pod network, only one can be"))
+	})
+
+	It("should not attach the pod network to virtual machines without interfaces", func() {
+		description := newDescription()
+		description.Interfaces = nil
+		vm, err := newVirtualMachine(newImport(), description, uploadSource)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm.Spec.Template.Spec.Domain.Devices.AutoattachPodInterface).To(HaveValue(BeFalse()))
+	})
+
+	DescribeTable("should resolve disk URLs", func(file, expected string) {
+		diskURL, err := resolveDiskURL("https://images.example.com/vms/legacy.ovf", file)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(diskURL).To(Equal(expected))
+	},
+		Entry("relative to the description", "disk1.vmdk", "https://images.example.com/vms/disk1.vmdk"),
+		Entry("in a sub directory", "disks/disk1.vmdk", "https://images.example.com/vms/disks/disk1.vmdk"),
+		Entry("of libvirt absolute paths", "/var/lib/libvirt/images/vm.qcow2", "https://images.example.com/vms/vm.qcow2"),
+	)
+})
@@ -0,0 +1,148 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package freezehooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+)
+
+const defaultTimeoutSeconds int32 = 30
+
+// The error message of a failed hook travels from virt-launcher through
+// virt-handler and virt-api as plain text, this matches HookError.Error()
+var hookErrorRegex = regexp.MustCompile(`(PreFreeze|PostThaw) hook "([^"]+)" failed: (.*)`)
+
+// ExecFunc runs a command inside the guest
+type ExecFunc func(command string, args []string, timeoutSeconds int32) (string, error)
+
+// HookError is returned when a freeze hook fails
+type HookError struct {
+	Name string
+	Type snapshotv1.FreezeHookType
+	Err  error
+}
+
+func (e *HookError) Error() string {
+	return fmt.Sprintf("%s hook %q failed: %v", e.Type, e.Name, e.Err)
+}
+
+func (e *HookError) Unwrap() error {
+	return e.Err
+}
+
+// FromVMI returns the freeze hooks defined on the VMI
+func FromVMI(vmi *v1.VirtualMachineInstance) ([]snapshotv1.FreezeHook, error) {
+	value, ok := vmi.Annotations[snapshotv1.FreezeHooksAnnotation]
+	if !ok || value == "" {
+		return nil, nil
+	}
+
+	var hooks []snapshotv1.FreezeHook
+	if err := json.Unmarshal([]byte(value), &hooks); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %v", snapshotv1.FreezeHooksAnnotation, err)
+	}
+
+	for _, hook := range hooks {
+		if err := validate(hook); err != nil {
+			return nil, fmt.Errorf("invalid %s annotation: %v", snapshotv1.FreezeHooksAnnotation, err)
+		}
+	}
+
+	return hooks, nil
+}
+
+func validate(hook snapshotv1.FreezeHook) error {
+	if hook.Name == "" {
+		return fmt.Errorf("hook name is required")
+	}
+	if hook.Type != snapshotv1.PreFreezeHook && hook.Type != snapshotv1.PostThawHook {
+		return fmt.Errorf("hook %q has invalid type %q", hook.Name, hook.Type)
+	}
+	if len(hook.Command) == 0 {
+		return fmt.Errorf("hook %q has no command", hook.Name)
+	}
+	if hook.TimeoutSeconds != nil && *hook.TimeoutSeconds <= 0 {
+		return fmt.Errorf("hook %q timeoutSeconds must be positive", hook.Name)
+	}
+	return nil
+}
+
+// Run runs the hooks of the given type in order and stops at the first failure
+func Run(hooks []snapshotv1.FreezeHook, hookType snapshotv1.FreezeHookType, exec ExecFunc) error {
+	for _, hook := range hooks {
+		if hook.Type != hookType {
+			continue
+		}
+
+		timeout := defaultTimeoutSeconds
+		if hook.TimeoutSeconds != nil {
+			timeout = *hook.TimeoutSeconds
+		}
+
+		if _, err := exec(hook.Command[0], hook.Command[1:], timeout); err != nil {
+			return &HookError{Name: hook.Name, Type: hook.Type, Err: err}
+		}
+	}
+
+	return nil
+}
+
+// Results returns the outcome of the hooks of the given type from the error
+// returned by the freeze or thaw call. Hooks following a failed one did not
+// run and are left out. If the call failed for another reason nothing is
+// known about the hooks and nil is returned.
+func Results(hooks []snapshotv1.FreezeHook, hookType snapshotv1.FreezeHookType, err error) []snapshotv1.FreezeHookResult {
+	var failedName, failedMessage string
+	if err != nil {
+		match := hookErrorRegex.FindStringSubmatch(err.Error())
+		if match == nil || match[1] != string(hookType) {
+			return nil
+		}
+		failedName, failedMessage = match[2], match[3]
+	}
+
+	var results []snapshotv1.FreezeHookResult
+	for _, hook := range hooks {
+		if hook.Type != hookType {
+			continue
+		}
+
+		result := snapshotv1.FreezeHookResult{
+			Name:      hook.Name,
+			Type:      hook.Type,
+			Succeeded: hook.Name != failedName,
+		}
+		if !result.Succeeded {
+			result.Message = &failedMessage
+		}
+		results = append(results, result)
+
+		if !result.Succeeded {
+			break
+		}
+	}
+
+	return results
+}
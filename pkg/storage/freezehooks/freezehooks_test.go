@@ -0,0 +1,133 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package freezehooks_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/freezehooks"
+)
+
+var _ = Describe("Freeze hooks", func() {
+	hooks := []snapshotv1.FreezeHook{
+		{Name: "flush", Type: snapshotv1.PreFreezeHook, Command: []string{"/usr/bin/flush", "--all"}},
+		{Name: "lock", Type: snapshotv1.PreFreezeHook, Command: []string{"/usr/bin/lock"}, TimeoutSeconds: pointer.P(int32(5))},
+		{Name: "unlock", Type: snapshotv1.PostThawHook, Command: []string{"/usr/bin/unlock"}},
+	}
+
+	newVMI := func(annotation string) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{snapshotv1.FreezeHooksAnnotation: annotation},
+			},
+		}
+	}
+
+	Context("FromVMI", func() {
+		It("should return no hooks without the annotation", func() {
+			result, err := freezehooks.FromVMI(&v1.VirtualMachineInstance{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeEmpty())
+		})
+
+		It("should parse the hooks", func() {
+			result, err := freezehooks.FromVMI(newVMI(`[
+				{"name": "flush", "type": "PreFreeze", "command": ["/usr/bin/flush", "--all"]},
+				{"name": "lock", "type": "PreFreeze", "command": ["/usr/bin/lock"], "timeoutSeconds": 5},
+				{"name": "unlock", "type": "PostThaw", "command": ["/usr/bin/unlock"]}
+			]`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(hooks))
+		})
+
+		DescribeTable("should reject", func(annotation string) {
+			_, err := freezehooks.FromVMI(newVMI(annotation))
+			Expect(err).To(MatchError(ContainSubstring(snapshotv1.FreezeHooksAnnotation)))
+		},
+			Entry("invalid JSON", `{"name": "flush"`),
+			Entry("a missing name", `[{"type": "PreFreeze", "command": ["/usr/bin/flush"]}]`),
+			Entry("an unknown type", `[{"name": "flush", "type": "PreSnapshot", "command": ["/usr/bin/flush"]}]`),
+			Entry("a missing command", `[{"name": "flush", "type": "PreFreeze"}]`),
+			Entry("a zero timeout", `[{"name": "flush", "type": "PreFreeze", "command": ["/usr/bin/flush"], "timeoutSeconds": 0}]`),
+		)
+	})
+
+	Context("Run", func() {
+		type execCall struct {
+			command string
+			args    []string
+			timeout int32
+		}
+
+		It("should run the hooks of the given type in order", func() {
+			var calls []execCall
+			err := freezehooks.Run(hooks, snapshotv1.PreFreezeHook, func(command string, args []string, timeout int32) (string, error) {
+				calls = append(calls, execCall{command, args, timeout})
+				return "", nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(calls).To(Equal([]execCall{
+				{"/usr/bin/flush", []string{"--all"}, 30},
+				{"/usr/bin/lock", []string{}, 5},
+			}))
+		})
+
+		It("should stop at the first failing hook", func() {
+			var calls []string
+			err := freezehooks.Run(hooks, snapshotv1.PreFreezeHook, func(command string, _ []string, _ int32) (string, error) {
+				calls = append(calls, command)
+				return "", errors.New("exited with error code:1")
+			})
+			Expect(err).To(MatchError(`PreFreeze hook "flush" failed: exited with error code:1`))
+			Expect(calls).To(Equal([]string{"/usr/bin/flush"}))
+		})
+	})
+
+	Context("Results", func() {
+		It("should report all hooks of the type as succeeded without an error", func() {
+			Expect(freezehooks.Results(hooks, snapshotv1.PostThawHook, nil)).To(Equal([]snapshotv1.FreezeHookResult{
+				{Name: "unlock", Type: snapshotv1.PostThawHook, Succeeded: true},
+			}))
+		})
+
+		It("should report the failed hook from an error passed through the API", func() {
+			hookErr := &freezehooks.HookError{Name: "lock", Type: snapshotv1.PreFreezeHook, Err: errors.New("exited with error code:1")}
+			err := fmt.Errorf("Failed freezing vm testvm: server error. command Freeze failed: %s", hookErr.Error())
+			Expect(freezehooks.Results(hooks, snapshotv1.PreFreezeHook, err)).To(Equal([]snapshotv1.FreezeHookResult{
+				{Name: "flush", Type: snapshotv1.PreFreezeHook, Succeeded: true},
+				{Name: "lock", Type: snapshotv1.PreFreezeHook, Succeeded: false, Message: pointer.P("exited with error code:1")},
+			}))
+		})
+
+		It("should not report anything when the call failed for another reason", func() {
+			Expect(freezehooks.Results(hooks, snapshotv1.PreFreezeHook, errors.New("guest agent not connected"))).To(BeNil())
+		})
+	})
+})
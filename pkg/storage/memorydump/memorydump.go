@@ -75,7 +75,7 @@ func HandleRequest(client kubecli.KubevirtClient, vm *v1.VirtualMachine, vmi *v1
 		// When in state associating we want to add the memory dump pvc
 		// as a volume in the vm and in the vmi to trigger the mount
 		// to virt launcher and the memory dump
-		vm.Spec.Template.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vm.Spec.Template.Spec, vm.Status.MemoryDumpRequest.ClaimName)
+		vm.Spec.Template.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vm.Spec.Template.Spec, vm.Status.MemoryDumpRequest)
 		if _, exists := vmiVolumeMap[vm.Status.MemoryDumpRequest.ClaimName]; exists {
 			return nil
 		}
@@ -214,7 +214,7 @@ func generateVMIMemoryDumpVolumePatch(client kubecli.KubevirtClient, vmi *v1.Vir
 
 	vmiCopy := vmi.DeepCopy()
 	if addVolume {
-		vmiCopy.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vmiCopy.Spec, request.ClaimName)
+		vmiCopy.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vmiCopy.Spec, request)
 	} else {
 		vmiCopy.Spec = *RemoveMemoryDumpVolumeFromVMISpec(&vmiCopy.Spec, request.ClaimName)
 	}
@@ -235,9 +235,9 @@ func generateVMIMemoryDumpVolumePatch(client kubecli.KubevirtClient, vmi *v1.Vir
 	return err
 }
 
-func applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, claimName string) *v1.VirtualMachineInstanceSpec {
+func applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request *v1.VirtualMachineMemoryDumpRequest) *v1.VirtualMachineInstanceSpec {
 	for _, volume := range vmiSpec.Volumes {
-		if volume.Name == claimName {
+		if volume.Name == request.ClaimName {
 			return vmiSpec
 		}
 	}
@@ -245,14 +245,16 @@ func applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpe
 	memoryDumpVol := &v1.MemoryDumpVolumeSource{
 		PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
 			PersistentVolumeClaimVolumeSource: k8score.PersistentVolumeClaimVolumeSource{
-				ClaimName: claimName,
+				ClaimName: request.ClaimName,
 			},
 			Hotpluggable: true,
 		},
+		Format:  request.Format,
+		Content: request.Content,
 	}
 
 	newVolume := v1.Volume{
-		Name: claimName,
+		Name: request.ClaimName,
 	}
 	newVolume.VolumeSource.MemoryDump = memoryDumpVol
 
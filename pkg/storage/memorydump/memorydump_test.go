@@ -165,6 +165,42 @@ var _ = Describe("MemoryDump", func() {
 		})
 	})
 
+	It("should add the memory dump volume in the requested format", func() {
+		vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpAssociating)
+		vm.Spec.Template.Spec.Volumes = nil
+		vm.Status.MemoryDumpRequest.Format = v1.MemoryDumpFormatState
+
+		vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(HandleRequest(virtClient, vm, vmi, pvcStore)).To(Succeed())
+		Expect(vm.Spec.Template.Spec.Volumes).To(HaveLen(1))
+		Expect(vm.Spec.Template.Spec.Volumes[0].MemoryDump.Format).To(Equal(v1.MemoryDumpFormatState))
+
+		vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vmi.Spec.Volumes).To(HaveLen(1))
+		Expect(vmi.Spec.Volumes[0].MemoryDump.Hotpluggable).To(BeTrue())
+		Expect(vmi.Spec.Volumes[0].MemoryDump.Format).To(Equal(v1.MemoryDumpFormatState))
+	})
+
+	It("should add the memory dump volume with the requested content", func() {
+		vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpAssociating)
+		vm.Spec.Template.Spec.Volumes = nil
+		vm.Status.MemoryDumpRequest.Content = v1.MemoryDumpContentKernel
+
+		vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(HandleRequest(virtClient, vm, vmi, pvcStore)).To(Succeed())
+		Expect(vm.Spec.Template.Spec.Volumes).To(HaveLen(1))
+		Expect(vm.Spec.Template.Spec.Volumes[0].MemoryDump.Content).To(Equal(v1.MemoryDumpContentKernel))
+
+		vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vmi.Spec.Volumes[0].MemoryDump.Content).To(Equal(v1.MemoryDumpContentKernel))
+	})
+
 	DescribeTable("should remove memory dump volume from vmi volumes and update pvc annotation", func(phase v1.MemoryDumpPhase, expectedAnnotation string) {
 		vm, vmi := createVirtualMachineWithMemoryDump(phase)
 
@@ -0,0 +1,725 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	// snapshotGroupLabel is set on the VirtualMachineSnapshots taken of the members
+	// of a VirtualMachineSnapshotGroup and holds the name of the group
+	snapshotGroupLabel = "snapshot.kubevirt.io/snapshot-group"
+
+	// snapshotGroupSizeAnnotation holds the number of members of the group, so
+	// that a member does not pass the freeze barrier before all of them exist
+	snapshotGroupSizeAnnotation = "snapshot.kubevirt.io/snapshot-group-size"
+
+	// snapshotGroupRestoreLabel is set on the VirtualMachineRestores of the members
+	// of a VirtualMachineSnapshotGroupRestore and holds the name of the group restore
+	snapshotGroupRestoreLabel = "snapshot.kubevirt.io/snapshot-group-restore"
+
+	snapshotGroupMemberCreateEvent = "SuccessfulVirtualMachineSnapshotGroupMemberCreate"
+
+	snapshotGroupRestoreMemberCreateEvent = "SuccessfulVirtualMachineSnapshotGroupRestoreMemberCreate"
+
+	snapshotGroupNoMembersMessage = "No VirtualMachines match the selector"
+
+	snapshotGroupNotReadyMessage = "VirtualMachineSnapshotGroup is not ready"
+)
+
+// VMSnapshotGroupController snapshots sets of VMs selected by label as a unit
+// and restores them as a unit
+type VMSnapshotGroupController struct {
+	Client kubecli.KubevirtClient
+
+	VMSnapshotGroupInformer        cache.SharedIndexInformer
+	VMSnapshotGroupRestoreInformer cache.SharedIndexInformer
+	VMSnapshotInformer             cache.SharedIndexInformer
+	VMRestoreInformer              cache.SharedIndexInformer
+	VMInformer                     cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	vmSnapshotGroupQueue        workqueue.TypedRateLimitingInterface[string]
+	vmSnapshotGroupRestoreQueue workqueue.TypedRateLimitingInterface[string]
+}
+
+// Init initializes the snapshot group controller
+func (ctrl *VMSnapshotGroupController) Init() error {
+	ctrl.vmSnapshotGroupQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-snapshot-vmsnapshotgroup"},
+	)
+	ctrl.vmSnapshotGroupRestoreQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-snapshot-vmsnapshotgrouprestore"},
+	)
+
+	_, err := ctrl.VMSnapshotGroupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotGroup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotGroup(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotGroupRestoreInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotGroupRestore,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotGroupRestore(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshot(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshot,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMRestoreInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMRestore,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMRestore(newObj) },
+			DeleteFunc: ctrl.handleVMRestore,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run the controller
+func (ctrl *VMSnapshotGroupController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmSnapshotGroupQueue.ShutDown()
+	defer ctrl.vmSnapshotGroupRestoreQueue.ShutDown()
+
+	log.Log.Info("Starting snapshot group controller.")
+	defer log.Log.Info("Shutting down snapshot group controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMSnapshotGroupInformer.HasSynced,
+		ctrl.VMSnapshotGroupRestoreInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMRestoreInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmSnapshotGroupWorker, time.Second, stopCh)
+		go wait.Until(ctrl.vmSnapshotGroupRestoreWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMSnapshotGroupController) vmSnapshotGroupWorker() {
+	for ctrl.processVMSnapshotGroupWorkItem() {
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) vmSnapshotGroupRestoreWorker() {
+	for ctrl.processVMSnapshotGroupRestoreWorkItem() {
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) processVMSnapshotGroupWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmSnapshotGroupQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmSnapshotGroup worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMSnapshotGroupInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		group, ok := storeObj.(*snapshotv1.VirtualMachineSnapshotGroup)
+		if !ok {
+			return 0, fmt.Errorf(unexpectedResourceFmt, storeObj)
+		}
+
+		return ctrl.updateVMSnapshotGroup(group.DeepCopy())
+	})
+}
+
+func (ctrl *VMSnapshotGroupController) processVMSnapshotGroupRestoreWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmSnapshotGroupRestoreQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmSnapshotGroupRestore worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMSnapshotGroupRestoreInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		groupRestore, ok := storeObj.(*snapshotv1.VirtualMachineSnapshotGroupRestore)
+		if !ok {
+			return 0, fmt.Errorf(unexpectedResourceFmt, storeObj)
+		}
+
+		return ctrl.updateVMSnapshotGroupRestore(groupRestore.DeepCopy())
+	})
+}
+
+func (ctrl *VMSnapshotGroupController) handleVMSnapshotGroup(obj interface{}) {
+	if group, ok := obj.(*snapshotv1.VirtualMachineSnapshotGroup); ok {
+		objName, err := cache.MetaNamespaceKeyFunc(group)
+		if err != nil {
+			log.Log.Errorf(failedKeyFromObjectFmt, err, group)
+			return
+		}
+
+		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
+		ctrl.vmSnapshotGroupQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) handleVMSnapshotGroupRestore(obj interface{}) {
+	if groupRestore, ok := obj.(*snapshotv1.VirtualMachineSnapshotGroupRestore); ok {
+		objName, err := cache.MetaNamespaceKeyFunc(groupRestore)
+		if err != nil {
+			log.Log.Errorf(failedKeyFromObjectFmt, err, groupRestore)
+			return
+		}
+
+		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
+		ctrl.vmSnapshotGroupRestoreQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) handleVMSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot); ok {
+		groupName, ok := vmSnapshot.Labels[snapshotGroupLabel]
+		if !ok {
+			return
+		}
+
+		objName := cacheKeyFunc(vmSnapshot.Namespace, groupName)
+
+		log.Log.V(3).Infof("Handling VirtualMachineSnapshot %s/%s, Group %s", vmSnapshot.Namespace, vmSnapshot.Name, objName)
+		ctrl.vmSnapshotGroupQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) handleVMRestore(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmRestore, ok := obj.(*snapshotv1.VirtualMachineRestore); ok {
+		groupRestoreName, ok := vmRestore.Labels[snapshotGroupRestoreLabel]
+		if !ok {
+			return
+		}
+
+		objName := cacheKeyFunc(vmRestore.Namespace, groupRestoreName)
+
+		log.Log.V(3).Infof("Handling VirtualMachineRestore %s/%s, Group Restore %s", vmRestore.Namespace, vmRestore.Name, objName)
+		ctrl.vmSnapshotGroupRestoreQueue.Add(objName)
+	}
+}
+
+func vmSnapshotGroupFailed(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	return group.Status != nil && group.Status.Phase == snapshotv1.Failed
+}
+
+func vmSnapshotGroupSucceeded(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	return group.Status != nil && group.Status.Phase == snapshotv1.Succeeded
+}
+
+func vmSnapshotGroupProgressing(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	return !vmSnapshotGroupFailed(group) && !vmSnapshotGroupSucceeded(group)
+}
+
+func timeUntilGroupDeadline(group *snapshotv1.VirtualMachineSnapshotGroup) time.Duration {
+	failureDeadline := snapshotv1.DefaultFailureDeadline
+	if group.Spec.FailureDeadline != nil {
+		failureDeadline = group.Spec.FailureDeadline.Duration
+	}
+	// No Deadline set by user
+	if failureDeadline == 0 {
+		return failureDeadline
+	}
+	return time.Until(group.CreationTimestamp.Add(failureDeadline))
+}
+
+func vmSnapshotGroupDeadlineExceeded(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	if !vmSnapshotGroupProgressing(group) {
+		return false
+	}
+	return timeUntilGroupDeadline(group) < 0
+}
+
+func updateSnapshotGroupCondition(group *snapshotv1.VirtualMachineSnapshotGroup, c snapshotv1.Condition) {
+	group.Status.Conditions = updateCondition(group.Status.Conditions, c)
+}
+
+func updateSnapshotGroupRestoreCondition(groupRestore *snapshotv1.VirtualMachineSnapshotGroupRestore, c snapshotv1.Condition) {
+	groupRestore.Status.Conditions = updateCondition(groupRestore.Status.Conditions, c)
+}
+
+func (ctrl *VMSnapshotGroupController) updateVMSnapshotGroup(group *snapshotv1.VirtualMachineSnapshotGroup) (time.Duration, error) {
+	log.Log.Object(group).V(3).Infof("Updating VirtualMachineSnapshotGroup")
+
+	// The member snapshots are owned by the group and garbage collected with it
+	if group.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	groupOut := group.DeepCopy()
+	if groupOut.Status == nil {
+		groupOut.Status = &snapshotv1.VirtualMachineSnapshotGroupStatus{
+			ReadyToUse: pointer.P(false),
+		}
+	}
+
+	// The members are collected once, VirtualMachines matching the selector
+	// afterwards are not part of the group
+	if len(groupOut.Status.Members) == 0 && vmSnapshotGroupProgressing(groupOut) {
+		if err := ctrl.createSnapshotGroupMembers(groupOut); err != nil {
+			return 0, err
+		}
+	}
+
+	ctrl.updateSnapshotGroupStatus(groupOut)
+
+	if !equality.Semantic.DeepEqual(group.Status, groupOut.Status) {
+		_, err := ctrl.Client.VirtualMachineSnapshotGroup(groupOut.Namespace).UpdateStatus(context.Background(), groupOut, metav1.UpdateOptions{})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if !vmSnapshotGroupProgressing(groupOut) {
+		return 0, nil
+	}
+	if len(groupOut.Status.Members) == 0 {
+		return snapshotRetryInterval, nil
+	}
+
+	return timeUntilGroupDeadline(groupOut), nil
+}
+
+func (ctrl *VMSnapshotGroupController) createSnapshotGroupMembers(group *snapshotv1.VirtualMachineSnapshotGroup) error {
+	members, err := ctrl.selectedVMs(group)
+	if err != nil || len(members) == 0 {
+		return err
+	}
+
+	// All the member snapshots are created before any of them is processed
+	// further, the freeze barrier then lets their volumes be snapshotted in parallel
+	var groupMembers []snapshotv1.VirtualMachineSnapshotGroupMember
+	for _, vm := range members {
+		memberSnapshot := &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s", group.Name, vm.Name),
+				Namespace: group.Namespace,
+				Labels: map[string]string{
+					snapshotGroupLabel: group.Name,
+				},
+				Annotations: map[string]string{
+					snapshotGroupSizeAnnotation: strconv.Itoa(len(members)),
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(group, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineSnapshotGroup")),
+				},
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(core.GroupName),
+					Kind:     "VirtualMachine",
+					Name:     vm.Name,
+				},
+				DeletionPolicy:  group.Spec.DeletionPolicy,
+				FailureDeadline: group.Spec.FailureDeadline,
+			},
+		}
+
+		_, err := ctrl.Client.VirtualMachineSnapshot(group.Namespace).Create(context.Background(), memberSnapshot, metav1.CreateOptions{})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+		if err == nil {
+			ctrl.Recorder.Eventf(
+				group,
+				corev1.EventTypeNormal,
+				snapshotGroupMemberCreateEvent,
+				"Successfully created VirtualMachineSnapshot %s of VirtualMachine %s",
+				memberSnapshot.Name,
+				vm.Name,
+			)
+		}
+
+		groupMembers = append(groupMembers, snapshotv1.VirtualMachineSnapshotGroupMember{
+			VirtualMachineName:         vm.Name,
+			VirtualMachineSnapshotName: memberSnapshot.Name,
+		})
+	}
+
+	group.Status.Members = groupMembers
+
+	return nil
+}
+
+// selectedVMs returns the VirtualMachines matching the selector of the group, sorted by name
+func (ctrl *VMSnapshotGroupController) selectedVMs(group *snapshotv1.VirtualMachineSnapshotGroup) ([]*kubevirtv1.VirtualMachine, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&group.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []*kubevirtv1.VirtualMachine
+	err = cache.ListAllByNamespace(ctrl.VMInformer.GetIndexer(), group.Namespace, selector, func(obj interface{}) {
+		if vm, ok := obj.(*kubevirtv1.VirtualMachine); ok && vm.DeletionTimestamp == nil {
+			members = append(members, vm)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Name < members[j].Name
+	})
+
+	return members, nil
+}
+
+// updateSnapshotGroupStatus aggregates the status of the member snapshots: the
+// group succeeds once all of them succeeded and fails as soon as one fails
+func (ctrl *VMSnapshotGroupController) updateSnapshotGroupStatus(group *snapshotv1.VirtualMachineSnapshotGroup) {
+	status := group.Status
+	if vmSnapshotGroupFailed(group) {
+		return
+	}
+
+	var failed []string
+	var creationTime *metav1.Time
+	succeeded := len(status.Members) > 0
+	ready := succeeded
+	for _, groupMember := range status.Members {
+		obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(group.Namespace, groupMember.VirtualMachineSnapshotName))
+		if err != nil || !exists {
+			succeeded = false
+			ready = false
+			continue
+		}
+
+		member := obj.(*snapshotv1.VirtualMachineSnapshot)
+		switch {
+		case vmSnapshotFailed(member):
+			failed = append(failed, member.Name)
+		case vmSnapshotSucceeded(member):
+			if creationTime == nil || (member.Status.CreationTime != nil && creationTime.Before(member.Status.CreationTime)) {
+				creationTime = member.Status.CreationTime
+			}
+		default:
+			succeeded = false
+		}
+		if !VmSnapshotReady(member) {
+			ready = false
+		}
+	}
+	status.ReadyToUse = pointer.P(ready)
+
+	switch {
+	case len(failed) > 0:
+		status.Phase = snapshotv1.Failed
+		status.Error = &snapshotv1.Error{
+			Time:    currentTime(),
+			Message: pointer.P(fmt.Sprintf("VirtualMachineSnapshots of group members failed: %s", strings.Join(failed, ", "))),
+		}
+		updateSnapshotGroupCondition(group, newFailureCondition(corev1.ConditionTrue, *status.Error.Message))
+		updateSnapshotGroupCondition(group, newProgressingCondition(corev1.ConditionFalse, "Operation failed"))
+	case vmSnapshotGroupDeadlineExceeded(group) && !succeeded:
+		status.Phase = snapshotv1.Failed
+		updateSnapshotGroupCondition(group, newFailureCondition(corev1.ConditionTrue, vmSnapshotDeadlineExceededError))
+		updateSnapshotGroupCondition(group, newProgressingCondition(corev1.ConditionFalse, "Operation failed"))
+	case succeeded:
+		status.Phase = snapshotv1.Succeeded
+		status.CreationTime = creationTime
+		updateSnapshotGroupCondition(group, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+	case len(status.Members) == 0:
+		status.Phase = snapshotv1.InProgress
+		updateSnapshotGroupCondition(group, newProgressingCondition(corev1.ConditionFalse, snapshotGroupNoMembersMessage))
+	default:
+		status.Phase = snapshotv1.InProgress
+		updateSnapshotGroupCondition(group, newProgressingCondition(corev1.ConditionTrue, "Snapshotting VirtualMachineSnapshotGroup members"))
+	}
+
+	if ready {
+		updateSnapshotGroupCondition(group, newReadyCondition(corev1.ConditionTrue, "Ready"))
+	} else {
+		updateSnapshotGroupCondition(group, newReadyCondition(corev1.ConditionFalse, "Not ready"))
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) updateVMSnapshotGroupRestore(groupRestore *snapshotv1.VirtualMachineSnapshotGroupRestore) (time.Duration, error) {
+	log.Log.Object(groupRestore).V(3).Infof("Updating VirtualMachineSnapshotGroupRestore")
+
+	// The member restores are owned by the group restore and garbage collected with it
+	if groupRestore.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	groupRestoreOut := groupRestore.DeepCopy()
+	if groupRestoreOut.Status == nil {
+		groupRestoreOut.Status = &snapshotv1.VirtualMachineSnapshotGroupRestoreStatus{
+			Complete: pointer.P(false),
+		}
+	}
+
+	if vmSnapshotGroupRestoreProgressing(groupRestoreOut) && len(groupRestoreOut.Status.Members) == 0 {
+		obj, exists, err := ctrl.VMSnapshotGroupInformer.GetStore().GetByKey(cacheKeyFunc(groupRestore.Namespace, groupRestore.Spec.VirtualMachineSnapshotGroupName))
+		if err != nil {
+			return 0, err
+		}
+
+		if !exists || !vmSnapshotGroupSucceeded(obj.(*snapshotv1.VirtualMachineSnapshotGroup)) {
+			updateSnapshotGroupRestoreCondition(groupRestoreOut, newProgressingCondition(corev1.ConditionFalse, snapshotGroupNotReadyMessage))
+			updateSnapshotGroupRestoreCondition(groupRestoreOut, newReadyCondition(corev1.ConditionFalse, snapshotGroupNotReadyMessage))
+			return snapshotRetryInterval, ctrl.updateVMSnapshotGroupRestoreStatus(groupRestore, groupRestoreOut)
+		}
+
+		if err := ctrl.createSnapshotGroupRestoreMembers(groupRestoreOut, obj.(*snapshotv1.VirtualMachineSnapshotGroup)); err != nil {
+			return 0, err
+		}
+	}
+
+	ctrl.updateSnapshotGroupRestoreStatus(groupRestoreOut)
+
+	return 0, ctrl.updateVMSnapshotGroupRestoreStatus(groupRestore, groupRestoreOut)
+}
+
+func vmSnapshotGroupRestoreProgressing(groupRestore *snapshotv1.VirtualMachineSnapshotGroupRestore) bool {
+	complete := groupRestore.Status != nil && groupRestore.Status.Complete != nil && *groupRestore.Status.Complete
+	failed := groupRestore.Status != nil && hasConditionType(groupRestore.Status.Conditions, snapshotv1.ConditionFailure)
+	return !complete && !failed
+}
+
+func (ctrl *VMSnapshotGroupController) createSnapshotGroupRestoreMembers(groupRestore *snapshotv1.VirtualMachineSnapshotGroupRestore, group *snapshotv1.VirtualMachineSnapshotGroup) error {
+	var groupMembers []snapshotv1.VirtualMachineSnapshotGroupRestoreMember
+	for _, groupMember := range group.Status.Members {
+		memberRestore := &snapshotv1.VirtualMachineRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s", groupRestore.Name, groupMember.VirtualMachineName),
+				Namespace: groupRestore.Namespace,
+				Labels: map[string]string{
+					snapshotGroupRestoreLabel: groupRestore.Name,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(groupRestore, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineSnapshotGroupRestore")),
+				},
+			},
+			Spec: snapshotv1.VirtualMachineRestoreSpec{
+				Target: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(core.GroupName),
+					Kind:     "VirtualMachine",
+					Name:     groupMember.VirtualMachineName,
+				},
+				VirtualMachineSnapshotName: groupMember.VirtualMachineSnapshotName,
+			},
+		}
+
+		_, err := ctrl.Client.VirtualMachineRestore(groupRestore.Namespace).Create(context.Background(), memberRestore, metav1.CreateOptions{})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+		if err == nil {
+			ctrl.Recorder.Eventf(
+				groupRestore,
+				corev1.EventTypeNormal,
+				snapshotGroupRestoreMemberCreateEvent,
+				"Successfully created VirtualMachineRestore %s of VirtualMachine %s",
+				memberRestore.Name,
+				groupMember.VirtualMachineName,
+			)
+		}
+
+		groupMembers = append(groupMembers, snapshotv1.VirtualMachineSnapshotGroupRestoreMember{
+			VirtualMachineName:        groupMember.VirtualMachineName,
+			VirtualMachineRestoreName: memberRestore.Name,
+		})
+	}
+
+	groupRestore.Status.Members = groupMembers
+
+	return nil
+}
+
+// updateSnapshotGroupRestoreStatus aggregates the status of the member restores:
+// the group restore completes once all of them completed and fails as soon as one fails
+func (ctrl *VMSnapshotGroupController) updateSnapshotGroupRestoreStatus(groupRestore *snapshotv1.VirtualMachineSnapshotGroupRestore) {
+	status := groupRestore.Status
+	if len(status.Members) == 0 || !vmSnapshotGroupRestoreProgressing(groupRestore) {
+		return
+	}
+
+	var failed []string
+	var restoreTime *metav1.Time
+	complete := true
+	for _, groupMember := range status.Members {
+		obj, exists, err := ctrl.VMRestoreInformer.GetStore().GetByKey(cacheKeyFunc(groupRestore.Namespace, groupMember.VirtualMachineRestoreName))
+		if err != nil || !exists {
+			complete = false
+			continue
+		}
+
+		member := obj.(*snapshotv1.VirtualMachineRestore)
+		switch {
+		case vmRestoreFailed(member):
+			failed = append(failed, member.Name)
+		case vmRestoreCompleted(member):
+			if restoreTime == nil || (member.Status.RestoreTime != nil && restoreTime.Before(member.Status.RestoreTime)) {
+				restoreTime = member.Status.RestoreTime
+			}
+		default:
+			complete = false
+		}
+	}
+
+	switch {
+	case len(failed) > 0:
+		message := fmt.Sprintf("VirtualMachineRestores of group members failed: %s", strings.Join(failed, ", "))
+		updateSnapshotGroupRestoreCondition(groupRestore, newFailureCondition(corev1.ConditionTrue, message))
+		updateSnapshotGroupRestoreCondition(groupRestore, newProgressingCondition(corev1.ConditionFalse, "Operation failed"))
+		updateSnapshotGroupRestoreCondition(groupRestore, newReadyCondition(corev1.ConditionFalse, "Operation failed"))
+	case complete:
+		status.Complete = pointer.P(true)
+		status.RestoreTime = restoreTime
+		updateSnapshotGroupRestoreCondition(groupRestore, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+		updateSnapshotGroupRestoreCondition(groupRestore, newReadyCondition(corev1.ConditionTrue, "Operation complete"))
+	default:
+		updateSnapshotGroupRestoreCondition(groupRestore, newProgressingCondition(corev1.ConditionTrue, "Restoring VirtualMachineSnapshotGroup members"))
+		updateSnapshotGroupRestoreCondition(groupRestore, newReadyCondition(corev1.ConditionFalse, "Not ready"))
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) updateVMSnapshotGroupRestoreStatus(groupRestore, groupRestoreOut *snapshotv1.VirtualMachineSnapshotGroupRestore) error {
+	if equality.Semantic.DeepEqual(groupRestore.Status, groupRestoreOut.Status) {
+		return nil
+	}
+
+	_, err := ctrl.Client.VirtualMachineSnapshotGroupRestore(groupRestoreOut.Namespace).UpdateStatus(context.Background(), groupRestoreOut, metav1.UpdateOptions{})
+	return err
+}
+
+// snapshotGroupFrozen reports whether the volumes of a member of a
+// VirtualMachineSnapshotGroup may be snapshotted, which is once all the members
+// of the group exist and are frozen. Members which are not running or have no
+// guest agent have nothing to freeze, and members whose volumes were already
+// snapshotted do not hold back the others.
+func (ctrl *VMSnapshotController) snapshotGroupFrozen(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (bool, error) {
+	groupName, ok := vmSnapshot.Labels[snapshotGroupLabel]
+	if !ok {
+		return true, nil
+	}
+
+	size, err := strconv.Atoi(vmSnapshot.Annotations[snapshotGroupSizeAnnotation])
+	if err != nil {
+		return false, fmt.Errorf("invalid %s annotation: %v", snapshotGroupSizeAnnotation, err)
+	}
+
+	var siblings []*snapshotv1.VirtualMachineSnapshot
+	selector := labels.SelectorFromSet(labels.Set{snapshotGroupLabel: groupName})
+	err = cache.ListAllByNamespace(ctrl.VMSnapshotInformer.GetIndexer(), vmSnapshot.Namespace, selector, func(obj interface{}) {
+		if sibling, ok := obj.(*snapshotv1.VirtualMachineSnapshot); ok {
+			siblings = append(siblings, sibling)
+		}
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, sibling := range siblings {
+		if sibling.Name == vmSnapshot.Name || vmSnapshotFailed(sibling) {
+			continue
+		}
+
+		frozen, err := ctrl.snapshotGroupMemberFrozen(sibling)
+		if err != nil || !frozen {
+			return false, err
+		}
+	}
+
+	return len(siblings) >= size, nil
+}
+
+func (ctrl *VMSnapshotController) snapshotGroupMemberFrozen(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (bool, error) {
+	obj, exists, err := ctrl.VMSnapshotContentInformer.GetStore().GetByKey(cacheKeyFunc(vmSnapshot.Namespace, GetVMSnapshotContentName(vmSnapshot)))
+	if err != nil || !exists {
+		return false, err
+	}
+	if vmSnapshotContentCreated(obj.(*snapshotv1.VirtualMachineSnapshotContent)) {
+		return true, nil
+	}
+
+	source, err := ctrl.getSnapshotSource(vmSnapshot)
+	if err != nil || source == nil {
+		return false, err
+	}
+
+	return source.Locked() && (source.Frozen() || !source.GuestAgent()), nil
+}
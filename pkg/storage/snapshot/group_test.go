@@ -0,0 +1,451 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Snapshot group controller", func() {
+	const (
+		groupName        = "test-group"
+		groupRestoreName = "test-group-restore"
+	)
+
+	var (
+		controller             *VMSnapshotGroupController
+		groupInformer          cache.SharedIndexInformer
+		groupRestoreInformer   cache.SharedIndexInformer
+		vmSnapshotInformer     cache.SharedIndexInformer
+		vmRestoreInformer      cache.SharedIndexInformer
+		vmInformer             cache.SharedIndexInformer
+		recorder               *record.FakeRecorder
+		kubevirtClient         *kubevirtfake.Clientset
+		memberCreationTimes    []metav1.Time
+		memberRestoreTimes     []metav1.Time
+		selectedVMs, otherVMs  []string
+		expectedMemberSnapshot []string
+	)
+
+	createGroup := func() *snapshotv1.VirtualMachineSnapshotGroup {
+		return &snapshotv1.VirtualMachineSnapshotGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              groupName,
+				Namespace:         testNamespace,
+				UID:               "group-uid",
+				CreationTimestamp: metav1.Now(),
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotGroupSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "db"},
+				},
+			},
+		}
+	}
+
+	createGroupRestore := func() *snapshotv1.VirtualMachineSnapshotGroupRestore {
+		return &snapshotv1.VirtualMachineSnapshotGroupRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      groupRestoreName,
+				Namespace: testNamespace,
+				UID:       "group-restore-uid",
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotGroupRestoreSpec{
+				VirtualMachineSnapshotGroupName: groupName,
+			},
+		}
+	}
+
+	addVM := func(name string, vmLabels map[string]string) {
+		vm := createVirtualMachine(testNamespace, name)
+		vm.Labels = vmLabels
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+	}
+
+	addGroup := func(group *snapshotv1.VirtualMachineSnapshotGroup) {
+		Expect(groupInformer.GetStore().Add(group)).To(Succeed())
+		_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(testNamespace).Create(context.Background(), group, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addGroupRestore := func(groupRestore *snapshotv1.VirtualMachineSnapshotGroupRestore) {
+		Expect(groupRestoreInformer.GetStore().Add(groupRestore)).To(Succeed())
+		_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroupRestores(testNamespace).Create(context.Background(), groupRestore, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getGroup := func() *snapshotv1.VirtualMachineSnapshotGroup {
+		group, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(testNamespace).Get(context.Background(), groupName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return group
+	}
+
+	getGroupRestore := func() *snapshotv1.VirtualMachineSnapshotGroupRestore {
+		groupRestore, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroupRestores(testNamespace).Get(context.Background(), groupRestoreName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return groupRestore
+	}
+
+	// groupWithMembers returns a group whose member snapshots were created and
+	// are in the given phases
+	groupWithMembers := func(phases ...snapshotv1.VirtualMachineSnapshotPhase) *snapshotv1.VirtualMachineSnapshotGroup {
+		group := createGroup()
+		group.Status = &snapshotv1.VirtualMachineSnapshotGroupStatus{
+			Phase:      snapshotv1.InProgress,
+			ReadyToUse: pointer.P(false),
+		}
+		for i, phase := range phases {
+			vmName := selectedVMs[i]
+			member := createVirtualMachineSnapshot(testNamespace, fmt.Sprintf("%s-%s", groupName, vmName), vmName)
+			member.Labels = map[string]string{snapshotGroupLabel: groupName}
+			member.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+				Phase:        phase,
+				ReadyToUse:   pointer.P(phase == snapshotv1.Succeeded),
+				CreationTime: &memberCreationTimes[i],
+			}
+			Expect(vmSnapshotInformer.GetStore().Add(member)).To(Succeed())
+
+			group.Status.Members = append(group.Status.Members, snapshotv1.VirtualMachineSnapshotGroupMember{
+				VirtualMachineName:         vmName,
+				VirtualMachineSnapshotName: member.Name,
+			})
+		}
+		return group
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineRestore(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineRestores(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshotGroup(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshotGroupRestore(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroupRestores(testNamespace)).AnyTimes()
+
+		groupInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroup{})
+		groupRestoreInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroupRestore{})
+		vmSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+		vmRestoreInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+		vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		recorder = record.NewFakeRecorder(100)
+
+		controller = &VMSnapshotGroupController{
+			Client:                         virtClient,
+			VMSnapshotGroupInformer:        groupInformer,
+			VMSnapshotGroupRestoreInformer: groupRestoreInformer,
+			VMSnapshotInformer:             vmSnapshotInformer,
+			VMRestoreInformer:              vmRestoreInformer,
+			VMInformer:                     vmInformer,
+			Recorder:                       recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+
+		base := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+		memberCreationTimes = []metav1.Time{metav1.NewTime(base), metav1.NewTime(base.Add(time.Second))}
+		memberRestoreTimes = []metav1.Time{metav1.NewTime(base.Add(time.Hour)), metav1.NewTime(base.Add(time.Hour + time.Second))}
+
+		selectedVMs = []string{"db-0", "db-1"}
+		otherVMs = []string{"web-0"}
+		expectedMemberSnapshot = []string{groupName + "-db-0", groupName + "-db-1"}
+		// added out of order to verify that the members are sorted
+		addVM(selectedVMs[1], map[string]string{"app": "db"})
+		addVM(selectedVMs[0], map[string]string{"app": "db"})
+		addVM(otherVMs[0], map[string]string{"app": "web"})
+	})
+
+	Context("VirtualMachineSnapshotGroup", func() {
+		It("should snapshot the selected VMs", func() {
+			group := createGroup()
+			addGroup(group)
+
+			requeue, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeue).To(BeNumerically(">", 0))
+
+			snapshots, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(snapshots.Items).To(HaveLen(2))
+			for i, member := range snapshots.Items {
+				Expect(member.Name).To(Equal(expectedMemberSnapshot[i]))
+				Expect(member.Spec.Source.Name).To(Equal(selectedVMs[i]))
+				Expect(member.Labels).To(HaveKeyWithValue(snapshotGroupLabel, groupName))
+				Expect(member.Annotations).To(HaveKeyWithValue(snapshotGroupSizeAnnotation, "2"))
+				Expect(metav1.IsControlledBy(&member, group)).To(BeTrue())
+			}
+
+			updated := getGroup()
+			Expect(updated.Status.Phase).To(Equal(snapshotv1.InProgress))
+			Expect(updated.Status.Members).To(Equal([]snapshotv1.VirtualMachineSnapshotGroupMember{
+				{VirtualMachineName: selectedVMs[0], VirtualMachineSnapshotName: expectedMemberSnapshot[0]},
+				{VirtualMachineName: selectedVMs[1], VirtualMachineSnapshotName: expectedMemberSnapshot[1]},
+			}))
+			testutils.ExpectEvent(recorder, snapshotGroupMemberCreateEvent)
+		})
+
+		It("should wait for VMs matching the selector", func() {
+			group := createGroup()
+			group.Spec.Selector.MatchLabels["app"] = "cache"
+			addGroup(group)
+
+			requeue, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeue).To(Equal(snapshotRetryInterval))
+
+			updated := getGroup()
+			Expect(updated.Status.Phase).To(Equal(snapshotv1.InProgress))
+			Expect(updated.Status.Members).To(BeEmpty())
+			Expect(updated.Status.Conditions).To(ContainElement(And(
+				HaveField("Type", snapshotv1.ConditionProgressing),
+				HaveField("Reason", snapshotGroupNoMembersMessage),
+			)))
+		})
+
+		It("should succeed once all members succeeded", func() {
+			group := groupWithMembers(snapshotv1.Succeeded, snapshotv1.Succeeded)
+			addGroup(group)
+
+			requeue, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeue).To(BeZero())
+
+			updated := getGroup()
+			Expect(updated.Status.Phase).To(Equal(snapshotv1.Succeeded))
+			Expect(updated.Status.ReadyToUse).To(HaveValue(BeTrue()))
+			Expect(updated.Status.CreationTime).To(HaveValue(Equal(memberCreationTimes[1])))
+		})
+
+		It("should stay in progress while a member is in progress", func() {
+			group := groupWithMembers(snapshotv1.Succeeded, snapshotv1.InProgress)
+			addGroup(group)
+
+			_, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+
+			updated := getGroup()
+			Expect(updated.Status.Phase).To(Equal(snapshotv1.InProgress))
+			Expect(updated.Status.ReadyToUse).To(HaveValue(BeFalse()))
+		})
+
+		It("should fail as soon as a member failed", func() {
+			group := groupWithMembers(snapshotv1.Failed, snapshotv1.InProgress)
+			addGroup(group)
+
+			requeue, err := controller.updateVMSnapshotGroup(group)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeue).To(BeZero())
+
+			updated := getGroup()
+			Expect(updated.Status.Phase).To(Equal(snapshotv1.Failed))
+			Expect(updated.Status.Error).ToNot(BeNil())
+			Expect(*updated.Status.Error.Message).To(ContainSubstring(expectedMemberSnapshot[0]))
+		})
+	})
+
+	Context("VirtualMachineSnapshotGroupRestore", func() {
+		addMemberRestores := func(complete ...bool) {
+			for i, c := range complete {
+				memberRestore := &snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("%s-%s", groupRestoreName, selectedVMs[i]),
+						Namespace: testNamespace,
+						Labels:    map[string]string{snapshotGroupRestoreLabel: groupRestoreName},
+					},
+					Status: &snapshotv1.VirtualMachineRestoreStatus{
+						Complete:    pointer.P(c),
+						RestoreTime: &memberRestoreTimes[i],
+					},
+				}
+				Expect(vmRestoreInformer.GetStore().Add(memberRestore)).To(Succeed())
+			}
+		}
+
+		groupRestoreWithMembers := func() *snapshotv1.VirtualMachineSnapshotGroupRestore {
+			groupRestore := createGroupRestore()
+			groupRestore.Status = &snapshotv1.VirtualMachineSnapshotGroupRestoreStatus{
+				Complete: pointer.P(false),
+			}
+			for _, vmName := range selectedVMs {
+				groupRestore.Status.Members = append(groupRestore.Status.Members, snapshotv1.VirtualMachineSnapshotGroupRestoreMember{
+					VirtualMachineName:        vmName,
+					VirtualMachineRestoreName: fmt.Sprintf("%s-%s", groupRestoreName, vmName),
+				})
+			}
+			return groupRestore
+		}
+
+		It("should wait for the group to succeed", func() {
+			Expect(groupInformer.GetStore().Add(groupWithMembers(snapshotv1.Succeeded, snapshotv1.InProgress))).To(Succeed())
+			groupRestore := createGroupRestore()
+			addGroupRestore(groupRestore)
+
+			requeue, err := controller.updateVMSnapshotGroupRestore(groupRestore)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeue).To(Equal(snapshotRetryInterval))
+
+			restores, err := kubevirtClient.SnapshotV1beta1().VirtualMachineRestores(testNamespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restores.Items).To(BeEmpty())
+
+			updated := getGroupRestore()
+			Expect(updated.Status.Conditions).To(ContainElement(And(
+				HaveField("Type", snapshotv1.ConditionProgressing),
+				HaveField("Reason", snapshotGroupNotReadyMessage),
+			)))
+		})
+
+		It("should restore all the members of the group", func() {
+			group := groupWithMembers(snapshotv1.Succeeded, snapshotv1.Succeeded)
+			group.Status.Phase = snapshotv1.Succeeded
+			Expect(groupInformer.GetStore().Add(group)).To(Succeed())
+			groupRestore := createGroupRestore()
+			addGroupRestore(groupRestore)
+
+			_, err := controller.updateVMSnapshotGroupRestore(groupRestore)
+			Expect(err).ToNot(HaveOccurred())
+
+			restores, err := kubevirtClient.SnapshotV1beta1().VirtualMachineRestores(testNamespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restores.Items).To(HaveLen(2))
+			for i, member := range restores.Items {
+				Expect(member.Spec.Target.Name).To(Equal(selectedVMs[i]))
+				Expect(member.Spec.VirtualMachineSnapshotName).To(Equal(expectedMemberSnapshot[i]))
+				Expect(member.Labels).To(HaveKeyWithValue(snapshotGroupRestoreLabel, groupRestoreName))
+				Expect(metav1.IsControlledBy(&member, groupRestore)).To(BeTrue())
+			}
+
+			updated := getGroupRestore()
+			Expect(updated.Status.Members).To(HaveLen(2))
+			Expect(updated.Status.Complete).To(HaveValue(BeFalse()))
+			testutils.ExpectEvent(recorder, snapshotGroupRestoreMemberCreateEvent)
+		})
+
+		It("should complete once all member restores completed", func() {
+			addMemberRestores(true, true)
+			groupRestore := groupRestoreWithMembers()
+			addGroupRestore(groupRestore)
+
+			_, err := controller.updateVMSnapshotGroupRestore(groupRestore)
+			Expect(err).ToNot(HaveOccurred())
+
+			updated := getGroupRestore()
+			Expect(updated.Status.Complete).To(HaveValue(BeTrue()))
+			Expect(updated.Status.RestoreTime).To(HaveValue(Equal(memberRestoreTimes[1])))
+		})
+
+		It("should not complete while a member restore is in progress", func() {
+			addMemberRestores(true, false)
+			groupRestore := groupRestoreWithMembers()
+			addGroupRestore(groupRestore)
+
+			_, err := controller.updateVMSnapshotGroupRestore(groupRestore)
+			Expect(err).ToNot(HaveOccurred())
+
+			updated := getGroupRestore()
+			Expect(updated.Status.Complete).To(HaveValue(BeFalse()))
+			Expect(updated.Status.RestoreTime).To(BeNil())
+		})
+	})
+
+	Context("freeze barrier", func() {
+		var (
+			snapshotController *VMSnapshotController
+			contentInformer    cache.SharedIndexInformer
+		)
+
+		addMember := func(vmName string, contentCreated bool) *snapshotv1.VirtualMachineSnapshot {
+			member := createVirtualMachineSnapshot(testNamespace, fmt.Sprintf("%s-%s", groupName, vmName), vmName)
+			member.UID = types.UID("uid-" + member.Name)
+			member.Labels = map[string]string{snapshotGroupLabel: groupName}
+			member.Annotations = map[string]string{snapshotGroupSizeAnnotation: "2"}
+			Expect(vmSnapshotInformer.GetStore().Add(member)).To(Succeed())
+
+			content := &snapshotv1.VirtualMachineSnapshotContent{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      GetVMSnapshotContentName(member),
+					Namespace: testNamespace,
+				},
+			}
+			if contentCreated {
+				content.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+					CreationTime: pointer.P(metav1.Now()),
+				}
+			}
+			Expect(contentInformer.GetStore().Add(content)).To(Succeed())
+			return member
+		}
+
+		BeforeEach(func() {
+			contentInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+			snapshotController = &VMSnapshotController{
+				VMSnapshotInformer:        vmSnapshotInformer,
+				VMSnapshotContentInformer: contentInformer,
+				VMInformer:                vmInformer,
+			}
+		})
+
+		It("should not hold back snapshots which are not part of a group", func() {
+			vmSnapshot := createVirtualMachineSnapshot(testNamespace, "standalone", selectedVMs[0])
+
+			frozen, err := snapshotController.snapshotGroupFrozen(vmSnapshot)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(frozen).To(BeTrue())
+		})
+
+		It("should wait for all members to exist", func() {
+			member := addMember(selectedVMs[0], false)
+
+			frozen, err := snapshotController.snapshotGroupFrozen(member)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(frozen).To(BeFalse())
+		})
+
+		It("should not be held back by members which were already snapshotted", func() {
+			member := addMember(selectedVMs[0], false)
+			addMember(selectedVMs[1], true)
+
+			frozen, err := snapshotController.snapshotGroupFrozen(member)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(frozen).To(BeTrue())
+		})
+	})
+})
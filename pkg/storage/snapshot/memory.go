@@ -0,0 +1,221 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	// memoryVolumeName is the name of the volume the memory state is restored from
+	memoryVolumeName = "snapshot-memory"
+
+	memoryDumpRequestedEvent = "MemoryDumpRequested"
+)
+
+func includeMemory(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	return vmSnapshot.Spec.IncludeMemory != nil && *vmSnapshot.Spec.IncludeMemory
+}
+
+func isMemoryVolumeBackup(content *snapshotv1.VirtualMachineSnapshotContent, volumeBackup snapshotv1.VolumeBackup) bool {
+	memory := content.Spec.MemoryVolumeBackup
+	return memory != nil && memory.VolumeSnapshotName != nil && volumeBackup.VolumeSnapshotName != nil &&
+		*memory.VolumeSnapshotName == *volumeBackup.VolumeSnapshotName
+}
+
+func memoryPVCName(vmSnapshot *snapshotv1.VirtualMachineSnapshot) string {
+	return fmt.Sprintf("vmsnapshot-%s-memory", vmSnapshot.UID)
+}
+
+// createMemoryBackup creates the PVC the memory of the running VM is dumped to,
+// it uses the storage class of one of the snapshotted volumes so it can be snapshotted too
+func (ctrl *VMSnapshotController) createMemoryBackup(vmSnapshot *snapshotv1.VirtualMachineSnapshot, volumeBackups []snapshotv1.VolumeBackup) (*snapshotv1.VolumeBackup, error) {
+	var storageClassName *string
+	for _, vb := range volumeBackups {
+		if vb.VolumeSnapshotName != nil && vb.PersistentVolumeClaim.Spec.StorageClassName != nil {
+			storageClassName = vb.PersistentVolumeClaim.Spec.StorageClassName
+			break
+		}
+	}
+	if storageClassName == nil {
+		log.Log.Warningf("No snapshottable storage class to store the memory of %s/%s", vmSnapshot.Namespace, vmSnapshot.Name)
+		return nil, nil
+	}
+
+	vm, err := ctrl.getVM(vmSnapshot)
+	if err != nil || vm == nil {
+		return nil, err
+	}
+	vmi, exists, err := ctrl.getVMI(vm)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	size, err := storagetypes.GetSizeIncludingDefaultFSOverhead(util.CalcExpectedMemoryDumpSize(vmi))
+	if err != nil {
+		return nil, err
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      memoryPVCName(vmSnapshot),
+			Namespace: vmSnapshot.Namespace,
+			Labels: map[string]string{
+				snapshotSourceNameLabel:      vm.Name,
+				snapshotSourceNamespaceLabel: vm.Namespace,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vmSnapshot, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineSnapshot")),
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			StorageClassName: storageClassName,
+			VolumeMode:       pointer.P(corev1.PersistentVolumeFilesystem),
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: *size,
+				},
+			},
+		},
+	}
+
+	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return nil, err
+	}
+
+	return &snapshotv1.VolumeBackup{
+		VolumeName: memoryVolumeName,
+		PersistentVolumeClaim: snapshotv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pvc.Name,
+				Namespace: pvc.Namespace,
+			},
+			Spec: *pvc.Spec.DeepCopy(),
+		},
+		VolumeSnapshotName: pointer.P(pvc.Name),
+	}, nil
+}
+
+// dumpMemory requests the memory state of the source VM to be dumped to the memory PVC
+// and returns true once the dump completed
+func (ctrl *VMSnapshotController) dumpMemory(content *snapshotv1.VirtualMachineSnapshotContent, vmSnapshot *snapshotv1.VirtualMachineSnapshot) (bool, error) {
+	vm, err := ctrl.getVM(vmSnapshot)
+	if err != nil {
+		return false, err
+	}
+	if vm == nil {
+		return false, fmt.Errorf("unable to get snapshot source")
+	}
+
+	claimName := content.Spec.MemoryVolumeBackup.PersistentVolumeClaim.Name
+	request := vm.Status.MemoryDumpRequest
+	if request != nil && request.ClaimName == claimName {
+		switch request.Phase {
+		case kubevirtv1.MemoryDumpCompleted:
+			return true, nil
+		case kubevirtv1.MemoryDumpFailed:
+			msg := "memory dump failed"
+			if request.Message != "" {
+				msg = fmt.Sprintf("%s: %s", msg, request.Message)
+			}
+			return false, fmt.Errorf("%s", msg)
+		}
+		return false, nil
+	}
+	if request != nil {
+		if request.Phase == kubevirtv1.MemoryDumpDissociating {
+			// wait for the previous memory dump to be removed
+			return false, nil
+		}
+		if request.Phase != kubevirtv1.MemoryDumpCompleted && request.Phase != kubevirtv1.MemoryDumpFailed {
+			return false, fmt.Errorf("memory dump request for pvc [%s] already in progress", request.ClaimName)
+		}
+	}
+
+	vmCopy := vm.DeepCopy()
+	vmCopy.Status.MemoryDumpRequest = &kubevirtv1.VirtualMachineMemoryDumpRequest{
+		ClaimName: claimName,
+		Phase:     kubevirtv1.MemoryDumpAssociating,
+		Format:    kubevirtv1.MemoryDumpFormatState,
+	}
+	if _, err := ctrl.Client.VirtualMachine(vmCopy.Namespace).UpdateStatus(context.Background(), vmCopy, metav1.UpdateOptions{}); err != nil {
+		return false, err
+	}
+
+	ctrl.Recorder.Eventf(
+		content,
+		corev1.EventTypeNormal,
+		memoryDumpRequestedEvent,
+		"Requested memory dump of %s to %s",
+		vm.Name,
+		claimName,
+	)
+
+	return false, nil
+}
+
+// releaseMemoryBackup detaches the memory PVC from the source VM and deletes it,
+// its content is kept by the memory VolumeSnapshot
+func (ctrl *VMSnapshotController) releaseMemoryBackup(content *snapshotv1.VirtualMachineSnapshotContent, vmSnapshot *snapshotv1.VirtualMachineSnapshot) error {
+	claimName := content.Spec.MemoryVolumeBackup.PersistentVolumeClaim.Name
+
+	vm, err := ctrl.getVM(vmSnapshot)
+	if err != nil {
+		return err
+	}
+	if vm != nil && vm.Status.MemoryDumpRequest != nil &&
+		vm.Status.MemoryDumpRequest.ClaimName == claimName && !vm.Status.MemoryDumpRequest.Remove {
+		vmCopy := vm.DeepCopy()
+		vmCopy.Status.MemoryDumpRequest.Phase = kubevirtv1.MemoryDumpDissociating
+		vmCopy.Status.MemoryDumpRequest.Remove = true
+		if _, err := ctrl.Client.VirtualMachine(vmCopy.Namespace).UpdateStatus(context.Background(), vmCopy, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	obj, exists, err := ctrl.PVCInformer.GetStore().GetByKey(cacheKeyFunc(content.Namespace, claimName))
+	if err != nil || !exists {
+		return err
+	}
+	if obj.(*corev1.PersistentVolumeClaim).DeletionTimestamp != nil {
+		return nil
+	}
+	err = ctrl.Client.CoreV1().PersistentVolumeClaims(content.Namespace).Delete(context.Background(), claimName, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/pool"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	// poolSnapshotLabel is set on the VirtualMachineSnapshots taken of the members
+	// of a VirtualMachinePool and holds the name of the snapshot of the pool
+	poolSnapshotLabel = "snapshot.kubevirt.io/pool-snapshot"
+
+	poolMemberSnapshotCreateEvent = "SuccessfulVirtualMachinePoolMemberSnapshotCreate"
+
+	poolSnapshotNoMembersMessage = "Source does not exist or has no VirtualMachines"
+)
+
+func isPoolSnapshot(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	source := vmSnapshot.Spec.Source
+	return source.APIGroup != nil && *source.APIGroup == pool.GroupName && source.Kind == poolv1.VirtualMachinePoolKind
+}
+
+// vmPoolName returns the name of the VirtualMachinePool controlling the VM, if any
+func vmPoolName(vm *kubevirtv1.VirtualMachine) (string, bool) {
+	ref := metav1.GetControllerOf(vm)
+	if ref == nil || ref.Kind != poolv1.VirtualMachinePoolKind {
+		return "", false
+	}
+	return ref.Name, true
+}
+
+// updatePoolVMSnapshot snapshots a VirtualMachinePool as a set, by taking a
+// VirtualMachineSnapshot of each of its VirtualMachines, and aggregates their status
+func (ctrl *VMSnapshotController) updatePoolVMSnapshot(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachinePool snapshot %s/%s", vmSnapshot.Namespace, vmSnapshot.Name)
+
+	// The member snapshots are owned by the pool snapshot and garbage collected with it
+	if vmSnapshotDeleting(vmSnapshot) {
+		return 0, nil
+	}
+
+	vmSnapshotCpy := vmSnapshot.DeepCopy()
+	if vmSnapshotCpy.Status == nil {
+		vmSnapshotCpy.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+			ReadyToUse: pointer.P(false),
+		}
+	}
+
+	// The members are collected once, VirtualMachines joining the pool
+	// afterwards are not part of the set
+	if len(vmSnapshotCpy.Status.MemberSnapshots) == 0 && vmSnapshotProgressing(vmSnapshotCpy) {
+		if err := ctrl.createPoolMemberSnapshots(vmSnapshotCpy); err != nil {
+			return 0, err
+		}
+	}
+
+	ctrl.updatePoolSnapshotStatus(vmSnapshotCpy)
+
+	if !equality.Semantic.DeepEqual(vmSnapshot.Status, vmSnapshotCpy.Status) {
+		if err := ctrl.vmSnapshotStatusUpdater.UpdateStatus(vmSnapshotCpy); err != nil {
+			return 0, err
+		}
+	}
+
+	if !vmSnapshotProgressing(vmSnapshotCpy) {
+		return 0, nil
+	}
+	if len(vmSnapshotCpy.Status.MemberSnapshots) == 0 {
+		return snapshotRetryInterval, nil
+	}
+
+	return timeUntilDeadline(vmSnapshotCpy), nil
+}
+
+func (ctrl *VMSnapshotController) createPoolMemberSnapshots(vmSnapshot *snapshotv1.VirtualMachineSnapshot) error {
+	members, err := ctrl.poolMembers(vmSnapshot.Namespace, vmSnapshot.Spec.Source.Name)
+	if err != nil || len(members) == 0 {
+		return err
+	}
+
+	var names []string
+	for _, vm := range members {
+		memberSnapshot := &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%s", vmSnapshot.Name, vm.Name),
+				Namespace: vmSnapshot.Namespace,
+				Labels: map[string]string{
+					poolSnapshotLabel: vmSnapshot.Name,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(vmSnapshot, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineSnapshot")),
+				},
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(core.GroupName),
+					Kind:     "VirtualMachine",
+					Name:     vm.Name,
+				},
+				DeletionPolicy:  vmSnapshot.Spec.DeletionPolicy,
+				FailureDeadline: vmSnapshot.Spec.FailureDeadline,
+			},
+		}
+
+		_, err := ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Create(context.Background(), memberSnapshot, metav1.CreateOptions{})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+		if err == nil {
+			ctrl.Recorder.Eventf(
+				vmSnapshot,
+				corev1.EventTypeNormal,
+				poolMemberSnapshotCreateEvent,
+				"Successfully created VirtualMachineSnapshot %s of VirtualMachine %s",
+				memberSnapshot.Name,
+				vm.Name,
+			)
+		}
+
+		names = append(names, memberSnapshot.Name)
+	}
+
+	vmSnapshot.Status.SourceUID = pointer.P(metav1.GetControllerOf(members[0]).UID)
+	vmSnapshot.Status.MemberSnapshots = names
+
+	return nil
+}
+
+// poolMembers returns the VirtualMachines controlled by the pool, sorted by name
+func (ctrl *VMSnapshotController) poolMembers(namespace, poolName string) ([]*kubevirtv1.VirtualMachine, error) {
+	var members []*kubevirtv1.VirtualMachine
+	err := cache.ListAllByNamespace(ctrl.VMInformer.GetIndexer(), namespace, labels.Everything(), func(obj interface{}) {
+		vm, ok := obj.(*kubevirtv1.VirtualMachine)
+		if !ok || vm.DeletionTimestamp != nil {
+			return
+		}
+		if name, isMember := vmPoolName(vm); isMember && name == poolName {
+			members = append(members, vm)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Name < members[j].Name
+	})
+
+	return members, nil
+}
+
+// updatePoolSnapshotStatus aggregates the status of the member snapshots: the
+// pool snapshot succeeds once all of them succeeded and fails as soon as one fails
+func (ctrl *VMSnapshotController) updatePoolSnapshotStatus(vmSnapshot *snapshotv1.VirtualMachineSnapshot) {
+	status := vmSnapshot.Status
+	if vmSnapshotFailed(vmSnapshot) {
+		return
+	}
+
+	var failed []string
+	var creationTime *metav1.Time
+	succeeded := len(status.MemberSnapshots) > 0
+	ready := succeeded
+	for _, name := range status.MemberSnapshots {
+		obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(vmSnapshot.Namespace, name))
+		if err != nil || !exists {
+			succeeded = false
+			ready = false
+			continue
+		}
+
+		member := obj.(*snapshotv1.VirtualMachineSnapshot)
+		switch {
+		case vmSnapshotFailed(member):
+			failed = append(failed, name)
+		case vmSnapshotSucceeded(member):
+			if creationTime == nil || (member.Status.CreationTime != nil && creationTime.Before(member.Status.CreationTime)) {
+				creationTime = member.Status.CreationTime
+			}
+		default:
+			succeeded = false
+		}
+		if !VmSnapshotReady(member) {
+			ready = false
+		}
+	}
+	status.ReadyToUse = pointer.P(ready)
+
+	switch {
+	case len(failed) > 0:
+		status.Phase = snapshotv1.Failed
+		status.Error = &snapshotv1.Error{
+			Message: pointer.P(fmt.Sprintf("VirtualMachineSnapshots of pool members failed: %s", strings.Join(failed, ", "))),
+		}
+		updateSnapshotCondition(vmSnapshot, newFailureCondition(corev1.ConditionTrue, *status.Error.Message))
+		updateSnapshotCondition(vmSnapshot, newProgressingCondition(corev1.ConditionFalse, "Operation failed"))
+	case vmSnapshotDeadlineExceeded(vmSnapshot) && !succeeded:
+		status.Phase = snapshotv1.Failed
+		updateSnapshotCondition(vmSnapshot, newFailureCondition(corev1.ConditionTrue, vmSnapshotDeadlineExceededError))
+		updateSnapshotCondition(vmSnapshot, newProgressingCondition(corev1.ConditionFalse, "Operation failed"))
+	case succeeded:
+		status.Phase = snapshotv1.Succeeded
+		status.CreationTime = creationTime
+		updateSnapshotCondition(vmSnapshot, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+	case len(status.MemberSnapshots) == 0:
+		status.Phase = snapshotv1.InProgress
+		updateSnapshotCondition(vmSnapshot, newProgressingCondition(corev1.ConditionFalse, poolSnapshotNoMembersMessage))
+	default:
+		status.Phase = snapshotv1.InProgress
+		updateSnapshotCondition(vmSnapshot, newProgressingCondition(corev1.ConditionTrue, "Snapshotting VirtualMachinePool members"))
+	}
+
+	if ready {
+		updateSnapshotCondition(vmSnapshot, newReadyCondition(corev1.ConditionTrue, "Ready"))
+	} else {
+		updateSnapshotCondition(vmSnapshot, newReadyCondition(corev1.ConditionFalse, "Not ready"))
+	}
+}
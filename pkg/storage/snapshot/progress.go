@@ -0,0 +1,135 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+)
+
+const (
+	volumeSnapshotStalledEvent = "VolumeSnapshotStalled"
+
+	// volumeSnapshotStallTimeout is how long a VolumeSnapshot may take to
+	// become ready before it is reported as stalled
+	volumeSnapshotStallTimeout = 10 * time.Minute
+)
+
+// volumeSnapshotProgress returns the progress of the volume snapshots of the
+// content, together with the time until the next volume snapshot not ready
+// yet is considered stalled
+func (ctrl *VMSnapshotController) volumeSnapshotProgress(content *snapshotv1.VirtualMachineSnapshotContent) (*snapshotv1.VirtualMachineSnapshotProgress, time.Duration, error) {
+	if content == nil || content.Status == nil {
+		return nil, 0, nil
+	}
+
+	statuses := map[string]snapshotv1.VolumeSnapshotStatus{}
+	for _, vss := range content.Status.VolumeSnapshotStatus {
+		statuses[vss.VolumeSnapshotName] = vss
+	}
+
+	now := currentTime().Time
+	progress := &snapshotv1.VirtualMachineSnapshotProgress{}
+	var ready int32
+	var nextStall time.Duration
+	for _, volumeBackup := range content.Spec.VolumeBackups {
+		if volumeBackup.VolumeSnapshotName == nil {
+			continue
+		}
+
+		volumeProgress := snapshotv1.VolumeSnapshotProgress{
+			VolumeName:         volumeBackup.VolumeName,
+			VolumeSnapshotName: volumeBackup.VolumeSnapshotName,
+			Phase:              snapshotv1.VolumeSnapshotPhasePending,
+		}
+
+		vss, exists := statuses[*volumeBackup.VolumeSnapshotName]
+		switch {
+		case !exists:
+		case vss.Error != nil:
+			volumeProgress.Phase = snapshotv1.VolumeSnapshotPhaseFailed
+			volumeProgress.Message = vss.Error.Message
+		case vss.ReadyToUse != nil && *vss.ReadyToUse:
+			volumeProgress.Phase = snapshotv1.VolumeSnapshotPhaseReady
+			ready++
+		default:
+			volumeProgress.Phase = snapshotv1.VolumeSnapshotPhaseInProgress
+
+			volumeSnapshot, err := ctrl.GetVolumeSnapshot(content.Namespace, vss.VolumeSnapshotName)
+			if err != nil {
+				return nil, 0, err
+			}
+			if volumeSnapshot == nil {
+				break
+			}
+
+			untilStall := volumeSnapshotStallTimeout - now.Sub(volumeSnapshot.CreationTimestamp.Time)
+			if untilStall <= 0 {
+				volumeProgress.Phase = snapshotv1.VolumeSnapshotPhaseStalled
+			} else if nextStall == 0 || untilStall < nextStall {
+				nextStall = untilStall
+			}
+		}
+
+		progress.Volumes = append(progress.Volumes, volumeProgress)
+	}
+
+	if len(progress.Volumes) == 0 {
+		return nil, 0, nil
+	}
+
+	progress.Percentage = ready * 100 / int32(len(progress.Volumes))
+
+	return progress, nextStall, nil
+}
+
+// recordStalledVolumeSnapshots emits an event for every volume snapshot which
+// became stalled since the previous progress
+func (ctrl *VMSnapshotController) recordStalledVolumeSnapshots(vmSnapshot *snapshotv1.VirtualMachineSnapshot, oldProgress, newProgress *snapshotv1.VirtualMachineSnapshotProgress) {
+	if newProgress == nil {
+		return
+	}
+
+	stalled := map[string]bool{}
+	if oldProgress != nil {
+		for _, volumeProgress := range oldProgress.Volumes {
+			stalled[volumeProgress.VolumeName] = volumeProgress.Phase == snapshotv1.VolumeSnapshotPhaseStalled
+		}
+	}
+
+	for _, volumeProgress := range newProgress.Volumes {
+		if volumeProgress.Phase != snapshotv1.VolumeSnapshotPhaseStalled || stalled[volumeProgress.VolumeName] {
+			continue
+		}
+
+		ctrl.Recorder.Eventf(
+			vmSnapshot,
+			corev1.EventTypeWarning,
+			volumeSnapshotStalledEvent,
+			"VolumeSnapshot %s of volume %s is not ready after %s",
+			*volumeProgress.VolumeSnapshotName,
+			volumeProgress.VolumeName,
+			volumeSnapshotStallTimeout,
+		)
+	}
+}
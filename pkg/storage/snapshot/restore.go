@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"slices"
 	"strings"
 	"time"
 
@@ -41,6 +42,7 @@ import (
 	validation "k8s.io/apimachinery/pkg/util/validation"
 
 	kubevirtv1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/log"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
@@ -60,7 +62,7 @@ const (
 
 	vmRestoreFinalizer = "snapshot.kubevirt.io/vmrestore-protection"
 
-	populatedForPVCAnnotation = "cdi.kubevirt.io/storage.populatedFor"
+	PopulatedForPVCAnnotation = "cdi.kubevirt.io/storage.populatedFor"
 
 	lastRestoreAnnotation = "restore.kubevirt.io/lastRestoreUID"
 
@@ -72,6 +74,8 @@ const (
 
 	restoreCompleteEvent = "VirtualMachineRestoreComplete"
 
+	restoreDryRunCompleteEvent = "VirtualMachineRestoreDryRunComplete"
+
 	restoreErrorEvent = "VirtualMachineRestoreError"
 
 	restoreVMNotReadyEvent = "RestoreTargetNotReady"
@@ -88,6 +92,8 @@ const (
 
 	restoreFailedEvent           = "Operation failed"
 	errorRestoreToExistingTarget = "restore source and restore target are different but restore target already exists"
+
+	waitPoolPausedMessageFmt = "Waiting for VirtualMachinePool %s to be paused. Please pause the pool of the restore target to proceed with restore"
 )
 
 var (
@@ -218,6 +224,10 @@ func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.Virtual
 		return 0, nil
 	}
 
+	if isDryRun(vmRestoreOut) {
+		return 0, ctrl.reconcileDryRun(vmRestoreIn, vmRestoreOut, target)
+	}
+
 	if len(vmRestoreOut.OwnerReferences) == 0 {
 		target.Own(vmRestoreOut)
 	}
@@ -231,6 +241,19 @@ func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.Virtual
 		}
 	}
 
+	// The pool controller would revert a restored member to the pool template,
+	// the pool has to be paused for the duration of the restore
+	pool, err := ctrl.targetPool(target)
+	if err != nil {
+		return 0, ctrl.doUpdateError(vmRestoreIn, err)
+	}
+	if pool != nil && !pool.Spec.Paused {
+		reason := fmt.Sprintf(waitPoolPausedMessageFmt, pool.Name)
+		updateRestoreCondition(vmRestoreOut, newProgressingCondition(corev1.ConditionFalse, reason))
+		updateRestoreCondition(vmRestoreOut, newReadyCondition(corev1.ConditionFalse, reason))
+		return 0, ctrl.doUpdateStatus(vmRestoreIn, vmRestoreOut)
+	}
+
 	ready, err := target.Ready()
 	if err != nil {
 		logger.Reason(err).Error("Error checking target ready")
@@ -309,6 +332,141 @@ func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.Virtual
 	return 0, ctrl.doUpdateStatus(vmRestoreIn, vmRestoreOut)
 }
 
+// reconcileDryRun computes the changes the restore would apply to its target
+// and publishes them in the status, without modifying the target or any volume
+func (ctrl *VMRestoreController) reconcileDryRun(vmRestoreIn, vmRestoreOut *snapshotv1.VirtualMachineRestore, target restoreTarget) error {
+	vmSnapshot, err := ctrl.getVMSnapshot(vmRestoreOut)
+	if err != nil {
+		return ctrl.doUpdateError(vmRestoreIn, err)
+	}
+
+	if target.Exists() && !target.TargetRestored() && sourceAndTargetAreDifferent(target, vmSnapshot) {
+		return ctrl.doUpdateError(vmRestoreIn, fmt.Errorf(errorRestoreToExistingTarget))
+	}
+
+	content, err := ctrl.getSnapshotContent(vmSnapshot)
+	if err != nil {
+		return ctrl.doUpdateError(vmRestoreIn, err)
+	}
+
+	result, err := ctrl.computeDryRunResult(vmRestoreOut, target, content)
+	if err != nil {
+		log.Log.Object(vmRestoreOut).Reason(err).Error("Error computing dry run result")
+		return ctrl.doUpdateError(vmRestoreIn, err)
+	}
+
+	ctrl.Recorder.Eventf(
+		vmRestoreOut,
+		corev1.EventTypeNormal,
+		restoreDryRunCompleteEvent,
+		"Successfully completed dry run of VirtualMachineRestore %s",
+		vmRestoreOut.Name,
+	)
+
+	vmRestoreOut.Status.DryRunResult = result
+	vmRestoreOut.Status.Complete = pointer.P(true)
+	updateRestoreCondition(vmRestoreOut, newProgressingCondition(corev1.ConditionFalse, "Dry run complete"))
+	updateRestoreCondition(vmRestoreOut, newReadyCondition(corev1.ConditionTrue, "Dry run complete"))
+
+	return ctrl.doUpdateStatus(vmRestoreIn, vmRestoreOut)
+}
+
+func (ctrl *VMRestoreController) computeDryRunResult(vmRestore *snapshotv1.VirtualMachineRestore, target restoreTarget, content *snapshotv1.VirtualMachineSnapshotContent) (*snapshotv1.RestoreDryRunResult, error) {
+	snapshotVM := content.Spec.Source.VirtualMachine
+	if snapshotVM == nil {
+		return nil, fmt.Errorf("unexpected snapshot source")
+	}
+
+	noRestore, err := ctrl.volumesNotForRestore(content)
+	if err != nil {
+		return nil, err
+	}
+
+	currentClaims := map[string]string{}
+	currentVM := &kubevirtv1.VirtualMachine{}
+	if target.Exists() {
+		currentVM = target.VirtualMachine()
+		for _, volume := range currentVM.Spec.Template.Spec.Volumes {
+			if claimName := typesutil.PVCNameFromVirtVolume(&volume); claimName != "" {
+				currentClaims[volume.Name] = claimName
+			}
+		}
+	}
+
+	result := &snapshotv1.RestoreDryRunResult{
+		TargetExists: target.Exists(),
+	}
+	for _, vb := range content.Spec.VolumeBackups {
+		if noRestore.Has(vb.VolumeName) || !isVolumeSelectedForRestore(vmRestore, vb.VolumeName) {
+			continue
+		}
+		if vb.VolumeSnapshotName == nil {
+			return nil, fmt.Errorf("VolumeSnapshotName missing %+v", vb)
+		}
+		result.VolumeChanges = append(result.VolumeChanges, snapshotv1.VolumeRestoreChange{
+			VolumeName:         vb.VolumeName,
+			CurrentClaimName:   currentClaims[vb.VolumeName],
+			RestoreClaimName:   restorePVCName(vmRestore, vb.VolumeName, vb.PersistentVolumeClaim.Name),
+			VolumeSnapshotName: *vb.VolumeSnapshotName,
+		})
+	}
+
+	restoredVM := &kubevirtv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      vmRestore.Spec.Target.Name,
+			Namespace: vmRestore.Namespace,
+		},
+		Spec: *snapshotVM.Spec.DeepCopy(),
+	}
+	if snapshotVM.Name != restoredVM.Name && isTargetReset(vmRestore) {
+		var resetFrom *kubevirtv1.VirtualMachine
+		if target.Exists() {
+			resetFrom = currentVM
+		}
+		resetGuestIdentity(restoredVM, resetFrom)
+	}
+	setPoolRevisionLabel(restoredVM, currentVM)
+	if target.Exists() {
+		// the run strategy of an existing target is kept as is, unless the restore sets one
+		restoredVM.Spec.Running = currentVM.Spec.Running
+		restoredVM.Spec.RunStrategy = currentVM.Spec.RunStrategy
+		setRestoreRunStrategy(vmRestore, restoredVM)
+	} else {
+		setRestoreRunStrategy(vmRestore, restoredVM)
+		restoredVM, err = patchVM(restoredVM, vmRestore.Spec.Patches)
+		if err != nil {
+			return nil, fmt.Errorf("error patching VM %s: %v", restoredVM.Name, err)
+		}
+	}
+
+	specPatch, err := createSpecMergePatch(&currentVM.Spec, &restoredVM.Spec)
+	if err != nil {
+		return nil, err
+	}
+	result.SpecPatch = specPatch
+
+	return result, nil
+}
+
+func createSpecMergePatch(current, restored *kubevirtv1.VirtualMachineSpec) (string, error) {
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		return "", err
+	}
+	restoredJSON, err := json.Marshal(restored)
+	if err != nil {
+		return "", err
+	}
+	mergePatch, err := jsonpatch.CreateMergePatch(currentJSON, restoredJSON)
+	if err != nil {
+		return "", err
+	}
+	if string(mergePatch) == "{}" {
+		return "", nil
+	}
+	return string(mergePatch), nil
+}
+
 func (ctrl *VMRestoreController) doUpdateError(restore *snapshotv1.VirtualMachineRestore, err error) error {
 	if updateErr := ctrl.doUpdateErrorWithFailure(restore, err.Error(), false); updateErr != nil {
 		return updateErr
@@ -427,6 +585,15 @@ func (ctrl *VMRestoreController) stopTarget(vmRestore *snapshotv1.VirtualMachine
 	updateRestoreCondition(vmRestoreCpy, newProgressingCondition(corev1.ConditionFalse, stopTargetMessage))
 	updateRestoreCondition(vmRestoreCpy, newReadyCondition(corev1.ConditionFalse, stopTargetMessage))
 
+	// Remember how the target was running, to start it the same way once restored
+	if target.Exists() && vmRestoreCpy.Status.TargetRunStrategy == nil {
+		runStrategy, err := target.VirtualMachine().RunStrategy()
+		if err != nil {
+			return ctrl.doUpdateError(vmRestoreCpy, err)
+		}
+		vmRestoreCpy.Status.TargetRunStrategy = pointer.P(runStrategy)
+	}
+
 	// Stop the restore target
 	err := target.Stop()
 	if err != nil {
@@ -436,6 +603,26 @@ func (ctrl *VMRestoreController) stopTarget(vmRestore *snapshotv1.VirtualMachine
 	return ctrl.doUpdateStatus(vmRestore, vmRestoreCpy)
 }
 
+// targetPool returns the VirtualMachinePool controlling the existing restore target, if any
+func (ctrl *VMRestoreController) targetPool(target restoreTarget) (*poolv1.VirtualMachinePool, error) {
+	if !target.Exists() {
+		return nil, nil
+	}
+
+	vm := target.VirtualMachine()
+	poolName, isMember := vmPoolName(vm)
+	if !isMember {
+		return nil, nil
+	}
+
+	obj, exists, err := ctrl.VMPoolInformer.GetStore().GetByKey(cacheKeyFunc(vm.Namespace, poolName))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return obj.(*poolv1.VirtualMachinePool), nil
+}
+
 func vmRestoreTargetReadyGracePeriodExceeded(vmRestore *snapshotv1.VirtualMachineRestore) bool {
 	deadline := vmRestore.CreationTimestamp.Add(snapshotv1.DefaultGracePeriod)
 	return time.Until(deadline) < 0
@@ -452,9 +639,14 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 		return false, err
 	}
 
+	volumeBackups := content.Spec.VolumeBackups
+	if restoreMemory(vmRestore, content) {
+		volumeBackups = append(slices.Clone(volumeBackups), *content.Spec.MemoryVolumeBackup)
+	}
+
 	var restores []snapshotv1.VolumeRestore
-	for _, vb := range content.Spec.VolumeBackups {
-		if noRestore.Has(vb.VolumeName) {
+	for _, vb := range volumeBackups {
+		if noRestore.Has(vb.VolumeName) || !isVolumeSelectedForRestore(vmRestore, vb.VolumeName) {
 			continue
 		}
 
@@ -694,116 +886,96 @@ func (t *vmRestoreTarget) reconcileBackendVolume(snapshotVM *snapshotv1.VirtualM
 		return true, nil
 	}
 
-	// Retrieve only the backend volume
-	volumes, err := storageutils.GetVolumes(snapshotVM, t.controller.Client, storageutils.WithBackendVolume)
-	if err != nil {
-		// Not checking for ErrNoBackendPVC, simply returning
-		// error as backend PVC should exist now
-		return false, err
+	// The backend volume is named after the snapshotted VM, which can differ from the target
+	backendVolumeName := storageutils.BackendPVCVolumeName(snapshotVM.Name)
+
+	// The current backend volume is kept when it is not selected for restore
+	if !isVolumeSelectedForRestore(t.vmRestore, backendVolumeName) {
+		return true, nil
 	}
 
-	isRestorePVCUpdated := false
-	for _, volume := range volumes {
-		pvc, err := t.controller.getPVC(snapshotVM.Namespace, volume.VolumeSource.PersistentVolumeClaim.ClaimName)
-		if err != nil || pvc == nil {
-			return false, err
+	restorePVCName := ""
+	for _, vr := range t.vmRestore.Status.Restores {
+		if vr.VolumeName == backendVolumeName {
+			restorePVCName = vr.PersistentVolumeClaimName
 		}
+	}
+	if restorePVCName == "" {
+		// The persistent state was not captured by the snapshot, the target keeps its own
+		return true, nil
+	}
 
-		// Step 1: Remove backend label from the original backend PVC
-		updated, err := t.removeBackendLabelFromPVC(pvc, snapshotVM.Name)
-		if err != nil {
-			return false, err
+	// Step 1: Remove backend label from the PVCs currently holding the persistent state of the target
+	for _, obj := range t.controller.PVCInformer.GetStore().List() {
+		pvc := obj.(*corev1.PersistentVolumeClaim)
+		if pvc.Namespace != t.vmRestore.Namespace || pvc.Name == restorePVCName ||
+			pvc.Labels[backendstorage.PVCPrefix] != t.vmRestore.Spec.Target.Name {
+			continue
 		}
-
-		// Step 2: Update the restore PVC with backend labels
-		isRestorePVCUpdated, err = t.updateRestorePVCWithBackendLabel(pvc)
-		if err != nil {
+		if err := t.removeBackendLabelFromPVC(pvc); err != nil {
 			return false, err
 		}
-
-		isRestorePVCUpdated = updated || isRestorePVCUpdated
 	}
 
-	return isRestorePVCUpdated, nil
+	// Step 2: Update the restore PVC with backend labels
+	return t.updateRestorePVCWithBackendLabel(restorePVCName)
 }
 
-func (t *vmRestoreTarget) removeBackendLabelFromPVC(pvc *corev1.PersistentVolumeClaim, snapshotVMName string) (bool, error) {
-	if pvc.Labels == nil {
-		return false, nil
-	}
+func (t *vmRestoreTarget) removeBackendLabelFromPVC(pvc *corev1.PersistentVolumeClaim) error {
+	// Remove the backend label.
+	newLabels := getFilteredLabels(pvc.Labels)
+	// Adding this label to identify the original backend PVC and garbage-collect it.
+	newLabels[restoreCleanupBackendPVCLabel] = getCleanupLabelValue(t.vmRestore)
 
-	// Only remove label when the VM name is the same since the backend logic filters by VM name + label
-	if t.vmRestore.Spec.Target.Name == snapshotVMName {
-		for _, vr := range t.vmRestore.Status.Restores {
-			if vr.PersistentVolumeClaimName == pvc.Name {
-				log.Log.Object(t.vmRestore).V(3).Infof("Restore PVC %s updated with backend label", pvc.Name)
-				return true, nil
-			}
-		}
-
-		// Remove the backend label.
-		newLabels := getFilteredLabels(pvc.Labels)
-		// Adding this label to identify the original backend PVC and garbage-collect it.
-		newLabels[restoreCleanupBackendPVCLabel] = getCleanupLabelValue(t.vmRestore)
-
-		// Generate patch to remove the backend label
-		patchBytes, err := patch.New(
-			patch.WithTest("/metadata/labels", pvc.Labels),
-			patch.WithReplace("/metadata/labels", newLabels),
-		).GeneratePayload()
-		if err != nil {
-			return false, err
-		}
-
-		_, err = t.controller.Client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Patch(context.Background(), pvc.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
-		return false, err
+	// Generate patch to remove the backend label
+	patchBytes, err := patch.New(
+		patch.WithTest("/metadata/labels", pvc.Labels),
+		patch.WithReplace("/metadata/labels", newLabels),
+	).GeneratePayload()
+	if err != nil {
+		return err
 	}
 
-	return false, nil
+	_, err = t.controller.Client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Patch(context.Background(), pvc.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
 }
 
-func (t *vmRestoreTarget) updateRestorePVCWithBackendLabel(originalPVC *corev1.PersistentVolumeClaim) (bool, error) {
-	for _, vr := range t.vmRestore.Status.Restores {
-		if vr.VolumeName == storageutils.BackendPVCVolumeName(t.vmRestore.Spec.Target.Name) {
-			restorePVC, err := t.controller.getPVC(t.vmRestore.Namespace, vr.PersistentVolumeClaimName)
-			if err != nil {
-				return false, err
-			}
-
-			// This means the restore PVC is already updated
-			if restorePVC.Name == originalPVC.Name {
-				return true, nil
-			}
+func (t *vmRestoreTarget) updateRestorePVCWithBackendLabel(restorePVCName string) (bool, error) {
+	restorePVC, err := t.controller.getPVC(t.vmRestore.Namespace, restorePVCName)
+	if err != nil || restorePVC == nil {
+		return false, err
+	}
 
-			// Patch restore PVC with backend label
-			patchSet := patch.New()
-			if restorePVC.Labels == nil {
-				patchSet.AddOption(patch.WithAdd("/metadata/labels", map[string]string{
-					backendstorage.PVCPrefix: t.vmRestore.Spec.Target.Name,
-				}))
-			} else {
-				updatedLabels := make(map[string]string, len(restorePVC.Labels))
-				for k, v := range restorePVC.Labels {
-					updatedLabels[k] = v
-				}
-				updatedLabels[backendstorage.PVCPrefix] = t.vmRestore.Spec.Target.Name
+	// This means the restore PVC is already updated
+	if restorePVC.Labels[backendstorage.PVCPrefix] == t.vmRestore.Spec.Target.Name {
+		log.Log.Object(t.vmRestore).V(3).Infof("Restore PVC %s updated with backend label", restorePVC.Name)
+		return true, nil
+	}
 
-				patchSet.AddOption(
-					patch.WithTest("/metadata/labels", restorePVC.Labels),
-					patch.WithReplace("/metadata/labels", updatedLabels),
-				)
-			}
-			patchBytes, err := patchSet.GeneratePayload()
-			if err != nil {
-				return false, err
-			}
-			_, err = t.controller.Client.CoreV1().PersistentVolumeClaims(restorePVC.Namespace).Patch(context.Background(), restorePVC.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
-			if err != nil {
-				return false, err
-			}
+	// Patch restore PVC with backend label
+	patchSet := patch.New()
+	if restorePVC.Labels == nil {
+		patchSet.AddOption(patch.WithAdd("/metadata/labels", map[string]string{
+			backendstorage.PVCPrefix: t.vmRestore.Spec.Target.Name,
+		}))
+	} else {
+		updatedLabels := make(map[string]string, len(restorePVC.Labels))
+		for k, v := range restorePVC.Labels {
+			updatedLabels[k] = v
 		}
+		updatedLabels[backendstorage.PVCPrefix] = t.vmRestore.Spec.Target.Name
+
+		patchSet.AddOption(
+			patch.WithTest("/metadata/labels", restorePVC.Labels),
+			patch.WithReplace("/metadata/labels", updatedLabels),
+		)
 	}
-	return false, nil
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return false, err
+	}
+	_, err = t.controller.Client.CoreV1().PersistentVolumeClaims(restorePVC.Namespace).Patch(context.Background(), restorePVC.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return false, err
 }
 
 func getCleanupLabelValue(vmRestore *snapshotv1.VirtualMachineRestore) string {
@@ -883,6 +1055,8 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 	log.Log.Object(t.vmRestore).V(3).Info("generating restored VM spec")
 	var newTemplates = make([]kubevirtv1.DataVolumeTemplateSpec, len(snapshotVM.Spec.DataVolumeTemplates))
 	var newVolumes []kubevirtv1.Volume
+	var keptTemplates []kubevirtv1.DataVolumeTemplateSpec
+	droppedTemplates := sets.New[int]()
 
 	for i, t := range snapshotVM.Spec.DataVolumeTemplates {
 		t.DeepCopyInto(&newTemplates[i])
@@ -897,7 +1071,26 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 
 	for _, v := range volumes {
 		nv := v.DeepCopy()
-		if nv.DataVolume != nil || nv.PersistentVolumeClaim != nil {
+		if nv.MemoryDump != nil {
+			// don't restore memory dump volume in the new spec
+			continue
+		}
+		if !isVolumeSelectedForRestore(t.vmRestore, nv.Name) {
+			// Volumes which are not restored keep their current source in the target
+			if currentVolume := t.currentVolume(nv.Name); currentVolume != nil {
+				if nv.DataVolume != nil {
+					if templateIndex := findDVTemplateIndex(nv.DataVolume.Name, snapshotVM); templateIndex >= 0 {
+						droppedTemplates.Insert(templateIndex)
+					}
+				}
+				if currentVolume.DataVolume != nil {
+					if dvt := t.currentDataVolumeTemplate(currentVolume.DataVolume.Name); dvt != nil {
+						keptTemplates = append(keptTemplates, *dvt)
+					}
+				}
+				nv = currentVolume
+			}
+		} else if nv.DataVolume != nil || nv.PersistentVolumeClaim != nil {
 			for _, vr := range t.vmRestore.Status.Restores {
 				if vr.VolumeName != nv.Name {
 					continue
@@ -920,7 +1113,7 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 
 					nv.DataVolume.Name = *vr.DataVolumeName
 				} else {
-					// convert to PersistentVolumeClaim volume
+					// convert to PersistentVolumeClaim volume, keeping hotplugged volumes hotpluggable
 					nv = &kubevirtv1.Volume{
 						Name: nv.Name,
 						VolumeSource: kubevirtv1.VolumeSource{
@@ -928,30 +1121,44 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 								PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
 									ClaimName: vr.PersistentVolumeClaimName,
 								},
+								Hotpluggable: nv.DataVolume.Hotpluggable,
 							},
 						},
 					}
 				}
 			}
-		} else if nv.MemoryDump != nil {
-			// don't restore memory dump volume in the new spec
-			continue
 		}
 		newVolumes = append(newVolumes, *nv)
 	}
 
+	if memoryVolume := memoryRestoreVolume(t.vmRestore); memoryVolume != nil {
+		newVolumes = append(newVolumes, *memoryVolume)
+	}
+
+	if droppedTemplates.Len() > 0 || len(keptTemplates) > 0 {
+		var templates []kubevirtv1.DataVolumeTemplateSpec
+		for i, dvt := range newTemplates {
+			if !droppedTemplates.Has(i) {
+				templates = append(templates, dvt)
+			}
+		}
+		newTemplates = append(templates, keptTemplates...)
+	}
+
 	var newVM *kubevirtv1.VirtualMachine
 	if !t.Exists() {
 		newVM = &kubevirtv1.VirtualMachine{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:        t.vmRestore.Spec.Target.Name,
 				Namespace:   t.vmRestore.Namespace,
-				Labels:      snapshotVM.Labels,
+				Labels:      maps.Clone(snapshotVM.Labels),
 				Annotations: snapshotVM.Annotations,
 			},
 			Spec:   *snapshotVM.Spec.DeepCopy(),
 			Status: kubevirtv1.VirtualMachineStatus{},
 		}
+		// A new VM is not a member of the pool the snapshot source may have belonged to
+		delete(newVM.Labels, kubevirtv1.VirtualMachinePoolRevisionName)
 		if newVM.Spec.Running != nil {
 			newVM.Spec.Running = pointer.P(false)
 		} else {
@@ -974,16 +1181,51 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 		}
 	}
 
+	setRestoreRunStrategy(t.vmRestore, newVM)
+
 	newVM.Spec.DataVolumeTemplates = newTemplates
 	newVM.Spec.Template.Spec.Volumes = newVolumes
 	setLastRestoreAnnotation(t.vmRestore, newVM)
+	setPoolRevisionLabel(newVM, t.vm)
 	if snapshotVM.Name == newVM.Name {
 		setLegacyFirmwareUUID(newVM)
+	} else if isTargetReset(t.vmRestore) {
+		var currentVM *kubevirtv1.VirtualMachine
+		if t.Exists() {
+			currentVM = t.vm
+		}
+		resetGuestIdentity(newVM, currentVM)
 	}
 
 	return newVM, nil
 }
 
+// currentVolume returns a copy of the volume with the given name in the existing target, if any
+func (t *vmRestoreTarget) currentVolume(name string) *kubevirtv1.Volume {
+	if !t.Exists() || t.vm.Spec.Template == nil {
+		return nil
+	}
+	for _, v := range t.vm.Spec.Template.Spec.Volumes {
+		if v.Name == name {
+			return v.DeepCopy()
+		}
+	}
+	return nil
+}
+
+// currentDataVolumeTemplate returns a copy of the DataVolumeTemplate with the given name in the existing target, if any
+func (t *vmRestoreTarget) currentDataVolumeTemplate(name string) *kubevirtv1.DataVolumeTemplateSpec {
+	if !t.Exists() {
+		return nil
+	}
+	for _, dvt := range t.vm.Spec.DataVolumeTemplates {
+		if dvt.Name == name {
+			return dvt.DeepCopy()
+		}
+	}
+	return nil
+}
+
 func (t *vmRestoreTarget) reconcileSpec(restoredVM *kubevirtv1.VirtualMachine) (bool, error) {
 	log.Log.Object(t.vmRestore).V(3).Info("Reconcile new VM spec")
 
@@ -1027,11 +1269,11 @@ func findDVTemplateIndex(dvName string, vm *snapshotv1.VirtualMachine) int {
 
 func (t *vmRestoreTarget) updatePVCPopulatedForAnnotation(pvc *corev1.PersistentVolumeClaim, dvName string) error {
 	updatePVC := pvc.DeepCopy()
-	if updatePVC.Annotations[populatedForPVCAnnotation] != dvName {
+	if updatePVC.Annotations[PopulatedForPVCAnnotation] != dvName {
 		if updatePVC.Annotations == nil {
 			updatePVC.Annotations = make(map[string]string)
 		}
-		updatePVC.Annotations[populatedForPVCAnnotation] = dvName
+		updatePVC.Annotations[PopulatedForPVCAnnotation] = dvName
 		// datavolume will take ownership
 		updatePVC.OwnerReferences = nil
 		_, err := t.controller.Client.CoreV1().PersistentVolumeClaims(updatePVC.Namespace).Update(context.Background(), updatePVC, metav1.UpdateOptions{})
@@ -1217,7 +1459,7 @@ func (t *vmRestoreTarget) createDataVolume(restoredVM *kubevirtv1.VirtualMachine
 		return false, fmt.Errorf("when creating restore dv pvc %s/%s does not exist and should",
 			t.vmRestore.Namespace, dvt.Name)
 	}
-	if pvc.Annotations[populatedForPVCAnnotation] != dvt.Name || len(pvc.OwnerReferences) > 0 {
+	if pvc.Annotations[PopulatedForPVCAnnotation] != dvt.Name || len(pvc.OwnerReferences) > 0 {
 		return false, nil
 	}
 
@@ -1495,7 +1737,7 @@ func (ctrl *VMRestoreController) createRestorePVC(
 		}
 
 		// By setting this annotation, the CDI will set ownership of the PVC to the DV
-		pvc.Annotations[populatedForPVCAnnotation] = dvOwner
+		pvc.Annotations[PopulatedForPVCAnnotation] = dvOwner
 	} else { // PVC is owned by the VM
 		target.Own(pvc)
 	}
@@ -1647,6 +1889,9 @@ func getRestoreVolumeBackup(volName string, content *snapshotv1.VirtualMachineSn
 			return &vb, nil
 		}
 	}
+	if memory := content.Spec.MemoryVolumeBackup; memory != nil && memory.VolumeName == volName {
+		return memory.DeepCopy(), nil
+	}
 	return &snapshotv1.VolumeBackup{}, fmt.Errorf("volume backup for volume %s not found", volName)
 }
 
@@ -1684,6 +1929,87 @@ func applyVolumeRestoreOverride(restorePVC *corev1.PersistentVolumeClaim, volume
 	return nil
 }
 
+// isVolumeSelectedForRestore determines if a volume of the snapshot should be restored
+// All volumes are restored when no volume selection is specified
+func isVolumeSelectedForRestore(vmRestore *snapshotv1.VirtualMachineRestore, volumeName string) bool {
+	if len(vmRestore.Spec.Volumes) == 0 {
+		return true
+	}
+	return slices.Contains(vmRestore.Spec.Volumes, volumeName)
+}
+
+// restoreMemory determines if the restored VM should resume from the memory state of the snapshot,
+// which is only consistent when all of its volumes are restored as they were
+func restoreMemory(vmRestore *snapshotv1.VirtualMachineRestore, content *snapshotv1.VirtualMachineSnapshotContent) bool {
+	return content.Spec.MemoryVolumeBackup != nil &&
+		len(vmRestore.Spec.Volumes) == 0 &&
+		!isTargetReset(vmRestore) &&
+		!isDryRun(vmRestore)
+}
+
+// memoryRestoreVolume returns the volume the restored VM resumes its memory state from, if any
+func memoryRestoreVolume(vmRestore *snapshotv1.VirtualMachineRestore) *kubevirtv1.Volume {
+	for _, vr := range vmRestore.Status.Restores {
+		if vr.VolumeName != memoryVolumeName {
+			continue
+		}
+		return &kubevirtv1.Volume{
+			Name: memoryVolumeName,
+			VolumeSource: kubevirtv1.VolumeSource{
+				MemoryDump: &kubevirtv1.MemoryDumpVolumeSource{
+					PersistentVolumeClaimVolumeSource: kubevirtv1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: vr.PersistentVolumeClaimName,
+						},
+					},
+					Format: kubevirtv1.MemoryDumpFormatState,
+				},
+			},
+		}
+	}
+	return nil
+}
+
+// isDryRun determines if the restore should only report the changes it would apply
+func isDryRun(vmRestore *snapshotv1.VirtualMachineRestore) bool {
+	return vmRestore.Spec.DryRun != nil && *vmRestore.Spec.DryRun
+}
+
+// setRestoreRunStrategy overrides the run strategy of the restored VM with the one requested by the restore
+func setRestoreRunStrategy(vmRestore *snapshotv1.VirtualMachineRestore, vm *kubevirtv1.VirtualMachine) {
+	runStrategy := restoreRunStrategy(vmRestore)
+	if runStrategy == nil {
+		return
+	}
+	vm.Spec.Running = nil
+	vm.Spec.RunStrategy = runStrategy
+}
+
+// restoreRunStrategy returns the run strategy requested by the restore for its target, if any
+func restoreRunStrategy(vmRestore *snapshotv1.VirtualMachineRestore) *kubevirtv1.VirtualMachineRunStrategy {
+	if vmRestore.Spec.RunStrategy != nil {
+		return pointer.P(*vmRestore.Spec.RunStrategy)
+	}
+	if vmRestore.Spec.StartVirtualMachine == nil || !*vmRestore.Spec.StartVirtualMachine {
+		return nil
+	}
+
+	// strategies which would leave the VM powered off fall back to Always
+	if vmRestore.Status != nil && vmRestore.Status.TargetRunStrategy != nil {
+		switch runStrategy := *vmRestore.Status.TargetRunStrategy; runStrategy {
+		case kubevirtv1.RunStrategyHalted, kubevirtv1.RunStrategyManual:
+		default:
+			return pointer.P(runStrategy)
+		}
+	}
+	return pointer.P(kubevirtv1.RunStrategyAlways)
+}
+
+// isTargetReset determines if the guest identifiers of the snapshot should be regenerated for the target
+func isTargetReset(vmRestore *snapshotv1.VirtualMachineRestore) bool {
+	return vmRestore.Spec.TargetReset != nil && *vmRestore.Spec.TargetReset
+}
+
 // isVolumeRestorePolicyInPlace determines if the VolumeRestorePolicy is set to "InPlace"
 // If this is the case, we'll have to try to restore the volumes over the original ones, which means
 // deleting the original volumes first, if they already exist.
@@ -1721,6 +2047,63 @@ func (ctrl *VMRestoreController) prepopulateDataVolume(namespace, dataVolume, re
 	return err
 }
 
+// resetGuestIdentity replaces the firmware UUID and serial and the interface MAC addresses
+// copied from the snapshot with the ones of the current target. When there is no current
+// target they are cleared, so that the new VM gets its own firmware UUID, and with it its
+// own cloud-init instance-id, and its own MAC addresses.
+func resetGuestIdentity(vm, currentVM *kubevirtv1.VirtualMachine) {
+	if vm.Spec.Template == nil {
+		return
+	}
+
+	var currentFirmware *kubevirtv1.Firmware
+	currentMACs := map[string]string{}
+	if currentVM != nil && currentVM.Spec.Template != nil {
+		currentFirmware = currentVM.Spec.Template.Spec.Domain.Firmware
+		for _, iface := range currentVM.Spec.Template.Spec.Domain.Devices.Interfaces {
+			currentMACs[iface.Name] = iface.MacAddress
+		}
+	}
+
+	if firmware := vm.Spec.Template.Spec.Domain.Firmware; firmware != nil {
+		firmware.UUID = ""
+		firmware.Serial = ""
+		if currentFirmware != nil {
+			firmware.UUID = currentFirmware.UUID
+			firmware.Serial = currentFirmware.Serial
+		}
+	}
+
+	interfaces := vm.Spec.Template.Spec.Domain.Devices.Interfaces
+	for i := range interfaces {
+		interfaces[i].MacAddress = currentMACs[interfaces[i].Name]
+	}
+}
+
+// setPoolRevisionLabel keeps the pool revision of the current target in the restored
+// template, otherwise the pool controller considers the VMI of a restored pool member
+// outdated and replaces it. Without a current target the pool revision of the
+// snapshot source is dropped, as the new VM is not a member of its pool.
+func setPoolRevisionLabel(vm, currentVM *kubevirtv1.VirtualMachine) {
+	if vm.Spec.Template == nil {
+		return
+	}
+
+	var revision string
+	if currentVM != nil {
+		revision = currentVM.Labels[kubevirtv1.VirtualMachinePoolRevisionName]
+	}
+	if revision == "" {
+		delete(vm.Spec.Template.ObjectMeta.Labels, kubevirtv1.VirtualMachinePoolRevisionName)
+		return
+	}
+
+	if vm.Spec.Template.ObjectMeta.Labels == nil {
+		vm.Spec.Template.ObjectMeta.Labels = map[string]string{}
+	}
+	vm.Spec.Template.ObjectMeta.Labels[kubevirtv1.VirtualMachinePoolRevisionName] = revision
+}
+
 func setLegacyFirmwareUUID(vm *kubevirtv1.VirtualMachine) {
 	if vm.Spec.Template.Spec.Domain.Firmware == nil {
 		vm.Spec.Template.Spec.Domain.Firmware = &kubevirtv1.Firmware{}
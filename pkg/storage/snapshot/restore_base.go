@@ -24,6 +24,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
@@ -31,6 +32,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	kubevirtv1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
@@ -54,6 +56,7 @@ type VMRestoreController struct {
 	PVCInformer               cache.SharedIndexInformer
 	StorageClassInformer      cache.SharedIndexInformer
 	CRInformer                cache.SharedIndexInformer
+	VMPoolInformer            cache.SharedIndexInformer
 
 	VolumeSnapshotProvider VolumeSnapshotProvider
 
@@ -113,6 +116,15 @@ func (ctrl *VMRestoreController) Init() error {
 		return err
 	}
 
+	_, err = ctrl.VMPoolInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMPool(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
 	ctrl.VMRestoreStatusUpdater = status.NewVMRestoreStatusUpdater(ctrl.Client)
 	return nil
 }
@@ -134,6 +146,7 @@ func (ctrl *VMRestoreController) Run(threadiness int, stopCh <-chan struct{}) er
 		ctrl.VMIInformer.HasSynced,
 		ctrl.DataVolumeInformer.HasSynced,
 		ctrl.PVCInformer.HasSynced,
+		ctrl.VMPoolInformer.HasSynced,
 	) {
 		return fmt.Errorf("failed to wait for caches to sync")
 	}
@@ -241,3 +254,23 @@ func (ctrl *VMRestoreController) handleVM(obj interface{}) {
 		}
 	}
 }
+
+func (ctrl *VMRestoreController) handleVMPool(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	// restores into the members of a pool wait for the pool to be paused
+	if pool, ok := obj.(*poolv1.VirtualMachinePool); ok {
+		err := cache.ListAllByNamespace(ctrl.VMInformer.GetIndexer(), pool.Namespace, labels.Everything(), func(obj interface{}) {
+			if vm, ok := obj.(*kubevirtv1.VirtualMachine); ok {
+				if poolName, isMember := vmPoolName(vm); isMember && poolName == pool.Name {
+					ctrl.handleVM(vm)
+				}
+			}
+		})
+		if err != nil {
+			utilruntime.HandleError(err)
+		}
+	}
+}
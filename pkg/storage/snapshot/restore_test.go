@@ -47,6 +47,7 @@ import (
 	kubevirtv1 "kubevirt.io/api/core/v1"
 	instancetypeapi "kubevirt.io/api/instancetype"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
 	"kubevirt.io/client-go/kubecli"
@@ -57,6 +58,8 @@ import (
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/instancetype/revision"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
 	"kubevirt.io/kubevirt/pkg/testutils"
 )
 
@@ -285,6 +288,9 @@ var _ = Describe("Restore controller", func() {
 		var crInformer cache.SharedIndexInformer
 		var crSource *framework.FakeControllerSource
 
+		var vmPoolInformer cache.SharedIndexInformer
+		var vmPoolSource *framework.FakeControllerSource
+
 		var stop chan struct{}
 		var controller *VMRestoreController
 		var recorder *record.FakeRecorder
@@ -306,6 +312,7 @@ var _ = Describe("Restore controller", func() {
 			go dataVolumeInformer.Run(stop)
 			go storageClassInformer.Run(stop)
 			go crInformer.Run(stop)
+			go vmPoolInformer.Run(stop)
 			Expect(cache.WaitForCacheSync(
 				stop,
 				vmRestoreInformer.HasSynced,
@@ -317,6 +324,7 @@ var _ = Describe("Restore controller", func() {
 				dataVolumeInformer.HasSynced,
 				storageClassInformer.HasSynced,
 				crInformer.HasSynced,
+				vmPoolInformer.HasSynced,
 			)).To(BeTrue())
 		}
 
@@ -334,6 +342,7 @@ var _ = Describe("Restore controller", func() {
 			pvcInformer, pvcSource = testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
 			storageClassInformer, storageClassSource = testutils.NewFakeInformerFor(&storagev1.StorageClass{})
 			crInformer, crSource = testutils.NewFakeInformerWithIndexersFor(&appsv1.ControllerRevision{}, virtcontroller.GetControllerRevisionInformerIndexers())
+			vmPoolInformer, vmPoolSource = testutils.NewFakeInformerFor(&poolv1.VirtualMachinePool{})
 
 			recorder = record.NewFakeRecorder(100)
 			recorder.IncludeObject = true
@@ -355,6 +364,7 @@ var _ = Describe("Restore controller", func() {
 				Recorder:                  recorder,
 				VolumeSnapshotProvider:    fakeVolumeSnapshotProvider,
 				CRInformer:                crInformer,
+				VMPoolInformer:            vmPoolInformer,
 			}
 			controller.Init()
 
@@ -489,6 +499,50 @@ var _ = Describe("Restore controller", func() {
 				Expect(*updateStatusCalls).To(Equal(1))
 			})
 
+			It("should report the changes without modifying the target on dry run", func() {
+				r := createRestore()
+				r.Spec.DryRun = pointer.P(true)
+				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: pointer.P(false),
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineRestore"),
+						newReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineRestore"),
+					},
+				}
+				vm := createModifiedVM()
+				vmi := createVMI(vm)
+				rc := r.DeepCopy()
+				rc.ResourceVersion = "1"
+				rc.Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: pointer.P(true),
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionFalse, "Dry run complete"),
+						newReadyCondition(corev1.ConditionTrue, "Dry run complete"),
+					},
+					DryRunResult: &snapshotv1.RestoreDryRunResult{
+						TargetExists: true,
+						VolumeChanges: []snapshotv1.VolumeRestoreChange{
+							{
+								VolumeName:         diskName,
+								CurrentClaimName:   "alpine-dv",
+								RestoreClaimName:   "restore-uid-disk1",
+								VolumeSnapshotName: "vmsnapshot-snapshot-uid-volume-disk1",
+							},
+						},
+						SpecPatch: `{"template":{"spec":{"domain":{"resources":{"requests":{"requests.memory":"64M"}}}}}}`,
+					},
+				}
+				vmSource.Add(vm)
+				vmiSource.Add(vmi)
+				updateStatusCalls := expectVMRestoreUpdateStatus(kubevirtClient, rc)
+				updateVMStatusCalls := expectVMUpdateStatus(kubevirtClient, vm)
+				addVirtualMachineRestore(r)
+				controller.processVMRestoreWorkItem()
+				testutils.ExpectEvent(recorder, "VirtualMachineRestoreDryRunComplete")
+				Expect(*updateStatusCalls).To(Equal(1))
+				Expect(*updateVMStatusCalls).To(BeZero())
+			})
+
 			It("should update restore status, initializing conditions", func() {
 				r := createRestoreWithOwner()
 				r.Finalizers = nil
@@ -615,6 +669,46 @@ var _ = Describe("Restore controller", func() {
 				Expect(*updateStatusCalls).To(Equal(1))
 			})
 
+			DescribeTable("with a target in a VirtualMachinePool", func(paused bool, progressingStatus corev1.ConditionStatus, progressingReason, readyReason string, expectVolumeRestores bool) {
+				pool := &poolv1.VirtualMachinePool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "pool",
+						Namespace: testNamespace,
+						UID:       "pool-uid",
+					},
+					Spec: poolv1.VirtualMachinePoolSpec{
+						Paused: paused,
+					},
+				}
+				vmPoolSource.Add(pool)
+
+				r := createRestoreWithOwner()
+				vm := createRestoreInProgressVM()
+				vm.OwnerReferences = []metav1.OwnerReference{
+					*metav1.NewControllerRef(pool, poolv1.SchemeGroupVersion.WithKind(poolv1.VirtualMachinePoolKind)),
+				}
+				rc := r.DeepCopy()
+				rc.ResourceVersion = "1"
+				rc.Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: pointer.P(false),
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(progressingStatus, progressingReason),
+						newReadyCondition(corev1.ConditionFalse, readyReason),
+					},
+				}
+				if expectVolumeRestores {
+					addInitialVolumeRestores(rc)
+				}
+				vmSource.Add(vm)
+				updateStatusCalls := expectVMRestoreUpdateStatus(kubevirtClient, rc)
+				addVirtualMachineRestore(r)
+				controller.processVMRestoreWorkItem()
+				Expect(*updateStatusCalls).To(Equal(1))
+			},
+				Entry("should wait for the pool to be paused", false, corev1.ConditionFalse, "Waiting for VirtualMachinePool pool to be paused. Please pause the pool of the restore target to proceed with restore", "Waiting for VirtualMachinePool pool to be paused. Please pause the pool of the restore target to proceed with restore", false),
+				Entry("should proceed when the pool is paused", true, corev1.ConditionTrue, "Creating new PVCs", "Waiting for new PVCs", true),
+			)
+
 			It("should return error if volumesnapshot doesnt exist", func() {
 				r := createRestoreWithOwner()
 				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
@@ -852,6 +946,93 @@ var _ = Describe("Restore controller", func() {
 				Expect(*updateVMCalls).To(Equal(1))
 			})
 
+			It("should set the runstrategy requested by the restore", func() {
+				r := createRestoreWithOwner()
+				r.Spec.RunStrategy = pointer.P(kubevirtv1.RunStrategyAlways)
+				addVolumeRestores(r)
+				r.Status.DeletedDataVolumes = getDeletedDataVolumes(createModifiedVM())
+				for i := range r.Status.Restores {
+					r.Status.Restores[i].DataVolumeName = &r.Status.Restores[i].PersistentVolumeClaimName
+				}
+				ur := r.DeepCopy()
+				ur.ResourceVersion = "1"
+				ur.Status.Conditions = []snapshotv1.Condition{
+					newProgressingCondition(corev1.ConditionTrue, "Updating target spec"),
+					newReadyCondition(corev1.ConditionFalse, "Waiting for target update"),
+				}
+
+				vm := createSnapshotVM()
+				vm.Spec.RunStrategy = pointer.P(kubevirtv1.RunStrategyHalted)
+				vm.Status.RestoreInProgress = &vmRestoreName
+				vmSource.Add(vm)
+				uvm := vm.DeepCopy()
+				uvm.Annotations = map[string]string{lastRestoreAnnotation: "restore-uid"}
+				uvm.Spec.RunStrategy = pointer.P(kubevirtv1.RunStrategyAlways)
+				uvm.Spec.DataVolumeTemplates[0].Name = "restore-uid-disk1"
+				uvm.Spec.Template.Spec.Volumes[0].DataVolume.Name = "restore-uid-disk1"
+				setLegacyFirmwareUUID(uvm)
+				for _, pvc := range getRestorePVCs(r) {
+					pvc.Status.Phase = corev1.ClaimBound
+					addPVC(&pvc)
+				}
+				addVirtualMachineRestore(r)
+
+				updateVMCalls := expectVMUpdate(kubevirtClient, uvm)
+				pvcUpdateCalls := expectPVCUpdates(k8sClient, ur)
+				updateStatusCalls := expectVMRestoreUpdateStatus(kubevirtClient, ur)
+
+				controller.processVMRestoreWorkItem()
+				Expect(*pvcUpdateCalls).To(Equal(1))
+				Expect(*updateStatusCalls).To(Equal(1))
+				Expect(*updateVMCalls).To(Equal(1))
+			})
+
+			DescribeTable("should start the VM when requested by the restore", func(targetRunStrategy *kubevirtv1.VirtualMachineRunStrategy, expectedRunStrategy kubevirtv1.VirtualMachineRunStrategy) {
+				r := createRestoreWithOwner()
+				r.Spec.StartVirtualMachine = pointer.P(true)
+				r.Status.TargetRunStrategy = targetRunStrategy
+				addVolumeRestores(r)
+				r.Status.DeletedDataVolumes = getDeletedDataVolumes(createModifiedVM())
+				for i := range r.Status.Restores {
+					r.Status.Restores[i].DataVolumeName = &r.Status.Restores[i].PersistentVolumeClaimName
+				}
+				ur := r.DeepCopy()
+				ur.ResourceVersion = "1"
+				ur.Status.Conditions = []snapshotv1.Condition{
+					newProgressingCondition(corev1.ConditionTrue, "Updating target spec"),
+					newReadyCondition(corev1.ConditionFalse, "Waiting for target update"),
+				}
+
+				vm := createSnapshotVM()
+				vm.Spec.RunStrategy = pointer.P(kubevirtv1.RunStrategyHalted)
+				vm.Status.RestoreInProgress = &vmRestoreName
+				vmSource.Add(vm)
+				uvm := vm.DeepCopy()
+				uvm.Annotations = map[string]string{lastRestoreAnnotation: "restore-uid"}
+				uvm.Spec.RunStrategy = pointer.P(expectedRunStrategy)
+				uvm.Spec.DataVolumeTemplates[0].Name = "restore-uid-disk1"
+				uvm.Spec.Template.Spec.Volumes[0].DataVolume.Name = "restore-uid-disk1"
+				setLegacyFirmwareUUID(uvm)
+				for _, pvc := range getRestorePVCs(r) {
+					pvc.Status.Phase = corev1.ClaimBound
+					addPVC(&pvc)
+				}
+				addVirtualMachineRestore(r)
+
+				updateVMCalls := expectVMUpdate(kubevirtClient, uvm)
+				pvcUpdateCalls := expectPVCUpdates(k8sClient, ur)
+				updateStatusCalls := expectVMRestoreUpdateStatus(kubevirtClient, ur)
+
+				controller.processVMRestoreWorkItem()
+				Expect(*pvcUpdateCalls).To(Equal(1))
+				Expect(*updateStatusCalls).To(Equal(1))
+				Expect(*updateVMCalls).To(Equal(1))
+			},
+				Entry("with Always when the target was not running", nil, kubevirtv1.RunStrategyAlways),
+				Entry("with the run strategy the target had before", pointer.P(kubevirtv1.RunStrategyRerunOnFailure), kubevirtv1.RunStrategyRerunOnFailure),
+				Entry("with Always when the target was Manual", pointer.P(kubevirtv1.RunStrategyManual), kubevirtv1.RunStrategyAlways),
+			)
+
 			It("volume is set to be overwritten when volume restore policy is InPlace", func() {
 				r := createRestoreWithOwner()
 				r.Status.Conditions = []snapshotv1.Condition{
@@ -1523,7 +1704,7 @@ var _ = Describe("Restore controller", func() {
 						ObjectMeta: metav1.ObjectMeta{
 							Namespace:   testNamespace,
 							Name:        "restore-uid-disk1",
-							Annotations: map[string]string{populatedForPVCAnnotation: "restore-uid-disk1"},
+							Annotations: map[string]string{PopulatedForPVCAnnotation: "restore-uid-disk1"},
 						},
 						Spec: corev1.PersistentVolumeClaimSpec{
 							StorageClassName: &storageClassName,
@@ -1624,6 +1805,185 @@ var _ = Describe("Restore controller", func() {
 					Expect(err).ShouldNot(HaveOccurred())
 					Expect(res).To(BeTrue())
 				})
+				Context("with a subset of volumes selected", func() {
+					BeforeEach(func() {
+						r.Spec.Volumes = []string{"otherdisk"}
+					})
+
+					It("should not create VolumeRestores for volumes which are not selected", func() {
+						syncCaches(stop)
+						r.Status.Restores = nil
+						updated, err := controller.reconcileVolumeRestores(r, targetVM, s)
+						Expect(err).ShouldNot(HaveOccurred())
+						Expect(updated).To(BeFalse())
+						Expect(r.Status.Restores).To(BeEmpty())
+					})
+
+					It("should keep the current source of volumes which are not selected", func() {
+						vm.Spec.DataVolumeTemplates[0].Name = "current-dv"
+						vm.Spec.Template.Spec.Volumes[0].DataVolume.Name = "current-dv"
+						targetVM.UpdateTarget(vm)
+
+						restoredVM, err := targetVM.(*vmRestoreTarget).generateRestoredVMSpec(sc.Spec.Source.VirtualMachine)
+						Expect(err).ShouldNot(HaveOccurred())
+						Expect(restoredVM.Spec.Template.Spec.Volumes).To(Equal(vm.Spec.Template.Spec.Volumes))
+						Expect(restoredVM.Spec.DataVolumeTemplates).To(Equal(vm.Spec.DataVolumeTemplates))
+					})
+				})
+
+				Context("with the memory in the snapshot", func() {
+					BeforeEach(func() {
+						sc.Spec.MemoryVolumeBackup = &snapshotv1.VolumeBackup{
+							VolumeName: memoryVolumeName,
+							PersistentVolumeClaim: snapshotv1.PersistentVolumeClaim{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:      "vmsnapshot-snapshot-uid-memory",
+									Namespace: testNamespace,
+								},
+							},
+							VolumeSnapshotName: pointer.P("vmsnapshot-snapshot-uid-memory"),
+						}
+					})
+
+					It("should create a VolumeRestore for the memory", func() {
+						syncCaches(stop)
+						r.Status.Restores = nil
+						updated, err := controller.reconcileVolumeRestores(r, targetVM, s)
+						Expect(err).ShouldNot(HaveOccurred())
+						Expect(updated).To(BeTrue())
+						Expect(r.Status.Restores).To(ContainElement(snapshotv1.VolumeRestore{
+							VolumeName:                memoryVolumeName,
+							PersistentVolumeClaimName: "restore-uid-snapshot-memory",
+							VolumeSnapshotName:        "vmsnapshot-snapshot-uid-memory",
+						}))
+					})
+
+					It("should not restore the memory when the target is reset", func() {
+						syncCaches(stop)
+						r.Spec.TargetReset = pointer.P(true)
+						r.Status.Restores = nil
+						_, err := controller.reconcileVolumeRestores(r, targetVM, s)
+						Expect(err).ShouldNot(HaveOccurred())
+						for _, vr := range r.Status.Restores {
+							Expect(vr.VolumeName).ToNot(Equal(memoryVolumeName))
+						}
+					})
+
+					It("should resume the restored VM from the memory state", func() {
+						r.Status.Restores = append(r.Status.Restores, snapshotv1.VolumeRestore{
+							VolumeName:                memoryVolumeName,
+							PersistentVolumeClaimName: "restore-uid-snapshot-memory",
+							VolumeSnapshotName:        "vmsnapshot-snapshot-uid-memory",
+						})
+
+						restoredVM, err := targetVM.(*vmRestoreTarget).generateRestoredVMSpec(sc.Spec.Source.VirtualMachine)
+						Expect(err).ShouldNot(HaveOccurred())
+						Expect(restoredVM.Spec.Template.Spec.Volumes).To(ContainElement(kubevirtv1.Volume{
+							Name: memoryVolumeName,
+							VolumeSource: kubevirtv1.VolumeSource{
+								MemoryDump: &kubevirtv1.MemoryDumpVolumeSource{
+									PersistentVolumeClaimVolumeSource: kubevirtv1.PersistentVolumeClaimVolumeSource{
+										PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+											ClaimName: "restore-uid-snapshot-memory",
+										},
+									},
+									Format: kubevirtv1.MemoryDumpFormatState,
+								},
+							},
+						}))
+					})
+				})
+
+				Context("with the persistent state in the snapshot", func() {
+					const restorePVCName = "restore-uid-persistent-state"
+					var snapshotVM *snapshotv1.VirtualMachine
+
+					BeforeEach(func() {
+						snapshotVM = sc.Spec.Source.VirtualMachine.DeepCopy()
+						snapshotVM.Spec.Template.Spec.Domain.Devices.TPM = &kubevirtv1.TPMDevice{
+							Persistent: pointer.P(true),
+						}
+						r.Spec.Target.Name = newVMName
+						r.Status.Restores = append(r.Status.Restores, snapshotv1.VolumeRestore{
+							VolumeName:                storageutils.BackendPVCVolumeName(snapshotVM.Name),
+							PersistentVolumeClaimName: restorePVCName,
+						})
+					})
+
+					expectPVCLabelPatches := func(pvcs ...*corev1.PersistentVolumeClaim) map[string]map[string]string {
+						patchedLabels := map[string]map[string]string{}
+						k8sClient.Fake.PrependReactor("patch", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+							patch, ok := action.(testing.PatchAction)
+							Expect(ok).To(BeTrue())
+
+							for _, pvc := range pvcs {
+								if pvc.Name != patch.GetName() {
+									continue
+								}
+								patched := &corev1.PersistentVolumeClaim{}
+								Expect(applyPatch(patch.GetPatch(), pvc, patched)).To(Succeed())
+								patchedLabels[pvc.Name] = patched.Labels
+								return true, patched, nil
+							}
+							Fail(fmt.Sprintf("unexpected patch of PVC %s", patch.GetName()))
+							return true, nil, nil
+						})
+						return patchedLabels
+					}
+
+					It("should bind the restored persistent state to a different target VM", func() {
+						targetPVC := &corev1.PersistentVolumeClaim{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "persistent-state-for-new-vm-name-abcde",
+								Namespace: testNamespace,
+								Labels:    map[string]string{backendstorage.PVCPrefix: newVMName},
+							},
+						}
+						restorePVC := &corev1.PersistentVolumeClaim{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:        restorePVCName,
+								Namespace:   testNamespace,
+								Annotations: map[string]string{RestoreNameAnnotation: r.Name},
+							},
+						}
+						pvcSource.Add(targetPVC)
+						addPVC(restorePVC)
+						patchedLabels := expectPVCLabelPatches(targetPVC, restorePVC)
+
+						ready, err := targetVM.(*vmRestoreTarget).reconcileBackendVolume(snapshotVM)
+						Expect(err).ShouldNot(HaveOccurred())
+						Expect(ready).To(BeFalse())
+						Expect(patchedLabels).To(HaveKeyWithValue(targetPVC.Name, map[string]string{
+							restoreCleanupBackendPVCLabel: getCleanupLabelValue(r),
+						}))
+						Expect(patchedLabels).To(HaveKeyWithValue(restorePVCName, map[string]string{
+							backendstorage.PVCPrefix: newVMName,
+						}))
+					})
+
+					It("should be ready once the restored persistent state is bound to the target VM", func() {
+						addPVC(&corev1.PersistentVolumeClaim{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:        restorePVCName,
+								Namespace:   testNamespace,
+								Labels:      map[string]string{backendstorage.PVCPrefix: newVMName},
+								Annotations: map[string]string{RestoreNameAnnotation: r.Name},
+							},
+						})
+
+						ready, err := targetVM.(*vmRestoreTarget).reconcileBackendVolume(snapshotVM)
+						Expect(err).ShouldNot(HaveOccurred())
+						Expect(ready).To(BeTrue())
+					})
+
+					It("should keep the persistent state of the target when it was not captured", func() {
+						r.Status.Restores = r.Status.Restores[:len(r.Status.Restores)-1]
+
+						ready, err := targetVM.(*vmRestoreTarget).reconcileBackendVolume(snapshotVM)
+						Expect(err).ShouldNot(HaveOccurred())
+						Expect(ready).To(BeTrue())
+					})
+				})
 			})
 
 			Context("target VM is different than source VM", func() {
@@ -1789,6 +2149,62 @@ var _ = Describe("Restore controller", func() {
 						Expect(err).ShouldNot(HaveOccurred())
 						Expect(*createVMCalls).To(Equal(1))
 					})
+
+					It("with targetReset should clear the guest identifiers of the snapshot", func() {
+						r.Spec.TargetReset = pointer.P(true)
+						snapshotVM := sc.Spec.Source.VirtualMachine
+						snapshotVM.Spec.Template.Spec.Domain.Firmware = &kubevirtv1.Firmware{
+							UUID:   "8f0d3a1e-3c5b-4b0e-9d2a-7c1e5f6a8b9c",
+							Serial: "snapshot-serial",
+						}
+						snapshotVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = "00:00:5e:00:53:02"
+						vmSnapshotContentSource.Modify(sc)
+						Eventually(func() *kubevirtv1.Firmware {
+							obj, _, _ := vmSnapshotContentInformer.GetStore().GetByKey(cacheKeyFunc(sc.Namespace, sc.Name))
+							return obj.(*snapshotv1.VirtualMachineSnapshotContent).Spec.Source.VirtualMachine.Spec.Template.Spec.Domain.Firmware
+						}).ShouldNot(BeNil())
+
+						newVM := createVirtualMachine(testNamespace, r.Spec.Target.Name)
+						newVM.UID = newVMUID
+						newVM.Spec.DataVolumeTemplates[0].Name = restoreDVName(r, r.Status.Restores[0].VolumeName, "")
+						newVM.Spec.Template.Spec.Volumes[0].DataVolume.Name = restoreDVName(r, r.Status.Restores[0].VolumeName, "")
+						newVM.Annotations = map[string]string{lastRestoreAnnotation: "restore-uid"}
+						newVM.Spec.Template.Spec.Domain.Firmware = &kubevirtv1.Firmware{}
+						createVMCalls := expectVMCreate(kubevirtClient, newVM, newVMUID)
+
+						targetVM, err := controller.getTarget(r)
+						Expect(err).ShouldNot(HaveOccurred())
+						success, err := targetVM.Reconcile()
+						Expect(success).To(BeTrue())
+						Expect(err).ShouldNot(HaveOccurred())
+						Expect(*createVMCalls).To(Equal(1))
+					})
+
+					It("with targetReset should keep patched guest identifiers", func() {
+						r.Spec.TargetReset = pointer.P(true)
+						r.Spec.Patches = []string{changeMacAddressPatch}
+						sc.Spec.Source.VirtualMachine.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = "00:00:5e:00:53:02"
+						vmSnapshotContentSource.Modify(sc)
+						Eventually(func() string {
+							obj, _, _ := vmSnapshotContentInformer.GetStore().GetByKey(cacheKeyFunc(sc.Namespace, sc.Name))
+							return obj.(*snapshotv1.VirtualMachineSnapshotContent).Spec.Source.VirtualMachine.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress
+						}).ShouldNot(BeEmpty())
+
+						newVM := createVirtualMachine(testNamespace, r.Spec.Target.Name)
+						newVM.UID = newVMUID
+						newVM.Spec.DataVolumeTemplates[0].Name = restoreDVName(r, r.Status.Restores[0].VolumeName, "")
+						newVM.Spec.Template.Spec.Volumes[0].DataVolume.Name = restoreDVName(r, r.Status.Restores[0].VolumeName, "")
+						newVM.Annotations = map[string]string{lastRestoreAnnotation: "restore-uid"}
+						newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = newMacAddress
+						createVMCalls := expectVMCreate(kubevirtClient, newVM, newVMUID)
+
+						targetVM, err := controller.getTarget(r)
+						Expect(err).ShouldNot(HaveOccurred())
+						success, err := targetVM.Reconcile()
+						Expect(success).To(BeTrue())
+						Expect(err).ShouldNot(HaveOccurred())
+						Expect(*createVMCalls).To(Equal(1))
+					})
 				})
 
 				It("should update condition if deleted and failed to restore", func() {
@@ -1874,6 +2290,7 @@ var _ = Describe("Restore controller", func() {
 					r := createRestoreWithOwner()
 					r.Spec.TargetReadinessPolicy = pointer.P(snapshotv1.VirtualMachineRestoreStopTarget)
 					vm := createModifiedVM()
+					vm.Spec.RunStrategy = pointer.P(kubevirtv1.RunStrategyAlways)
 					vmi := createVMI(vm)
 					rc := r.DeepCopy()
 					rc.ResourceVersion = "1"
@@ -1883,6 +2300,7 @@ var _ = Describe("Restore controller", func() {
 							newProgressingCondition(corev1.ConditionFalse, "Automatically stopping restore target for restore operation"),
 							newReadyCondition(corev1.ConditionFalse, "Automatically stopping restore target for restore operation"),
 						},
+						TargetRunStrategy: pointer.P(kubevirtv1.RunStrategyAlways),
 					}
 					vmSource.Add(vm)
 					vmiSource.Add(vmi)
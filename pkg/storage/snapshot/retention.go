@@ -0,0 +1,137 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+)
+
+const vmSnapshotRetentionEvent = "VirtualMachineSnapshotRetention"
+
+// snapshotsExceedingRetention returns the snapshots which are not kept by the
+// retention policy, together with the time until the oldest kept snapshot
+// exceeds MaxAge. Snapshots in progress or being deleted are ignored.
+func snapshotsExceedingRetention(
+	retention *snapshotv1.SnapshotRetentionPolicy,
+	snapshots []*snapshotv1.VirtualMachineSnapshot,
+	now time.Time,
+) ([]*snapshotv1.VirtualMachineSnapshot, time.Duration) {
+	if retention == nil {
+		return nil, 0
+	}
+
+	var candidates []*snapshotv1.VirtualMachineSnapshot
+	for _, vmSnapshot := range snapshots {
+		if vmSnapshot.DeletionTimestamp == nil && !vmSnapshotInProgress(vmSnapshot) {
+			candidates = append(candidates, vmSnapshot)
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[j].CreationTimestamp.Before(&candidates[i].CreationTimestamp)
+	})
+
+	var exceeding []*snapshotv1.VirtualMachineSnapshot
+	var nextExpiry time.Duration
+	for i, vmSnapshot := range candidates {
+		age := now.Sub(vmSnapshot.CreationTimestamp.Time)
+		expired := retention.MaxAge != nil && age > retention.MaxAge.Duration
+		exceeded := retention.MaxCount != nil && i >= int(*retention.MaxCount)
+		if expired || exceeded {
+			exceeding = append(exceeding, vmSnapshot)
+			continue
+		}
+
+		if retention.MaxAge != nil {
+			nextExpiry = retention.MaxAge.Duration - age
+		}
+	}
+
+	return exceeding, nextExpiry
+}
+
+// enforceRetentionPolicy deletes the snapshots of the same source which are
+// older than vmSnapshot and not kept by its retention policy
+func (ctrl *VMSnapshotController) enforceRetentionPolicy(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (time.Duration, error) {
+	if vmSnapshot == nil || vmSnapshotDeleting(vmSnapshot) || !vmSnapshotSucceeded(vmSnapshot) {
+		return 0, nil
+	}
+
+	retention := vmSnapshot.Spec.RetentionPolicy
+	if retention == nil {
+		return 0, nil
+	}
+
+	key := fmt.Sprintf("%s/%s", vmSnapshot.Namespace, vmSnapshot.Spec.Source.Name)
+	objs, err := ctrl.VMSnapshotInformer.GetIndexer().ByIndex("vm", key)
+	if err != nil {
+		return 0, err
+	}
+
+	// Only snapshots taken before this one are subject to its policy,
+	// newer snapshots are governed by their own. Snapshots taken as part
+	// of a VirtualMachinePool snapshot are kept as long as the set
+	snapshots := []*snapshotv1.VirtualMachineSnapshot{vmSnapshot}
+	for _, obj := range objs {
+		s, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+		if !ok {
+			continue
+		}
+		if _, poolMember := s.Labels[poolSnapshotLabel]; poolMember {
+			continue
+		}
+		if s.CreationTimestamp.Before(&vmSnapshot.CreationTimestamp) {
+			snapshots = append(snapshots, s)
+		}
+	}
+
+	exceeding, nextExpiry := snapshotsExceedingRetention(retention, snapshots, currentTime().Time)
+	for _, s := range exceeding {
+		if s.Name == vmSnapshot.Name {
+			continue
+		}
+
+		log.Log.V(3).Infof("Deleting vmsnapshot %s/%s according to the retention policy of %s", s.Namespace, s.Name, vmSnapshot.Name)
+		err := ctrl.Client.VirtualMachineSnapshot(s.Namespace).Delete(context.Background(), s.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return 0, err
+		}
+
+		ctrl.Recorder.Eventf(
+			vmSnapshot,
+			corev1.EventTypeNormal,
+			vmSnapshotRetentionEvent,
+			"Deleted VirtualMachineSnapshot %s according to the retention policy",
+			s.Name,
+		)
+	}
+
+	return nextExpiry, nil
+}
@@ -0,0 +1,374 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	snapshotScheduleLabel = "snapshot.kubevirt.io/schedule"
+
+	snapshotScheduleCreateEvent = "SuccessfulVirtualMachineSnapshotScheduleCreate"
+
+	snapshotScheduleSkipEvent = "VirtualMachineSnapshotScheduleSkipped"
+
+	snapshotScheduleRetentionEvent = "VirtualMachineSnapshotScheduleRetention"
+
+	snapshotScheduleInvalidEvent = "InvalidVirtualMachineSnapshotSchedule"
+
+	// bounds the walk over the runs missed while virt-controller was down,
+	// the remaining ones are caught up on the following syncs
+	maxMissedSchedules = 100
+)
+
+// VMSnapshotScheduleController creates VirtualMachineSnapshots on a schedule
+// and prunes the ones falling out of the retention policy
+type VMSnapshotScheduleController struct {
+	Client kubecli.KubevirtClient
+
+	VMSnapshotScheduleInformer cache.SharedIndexInformer
+	VMSnapshotInformer         cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	vmSnapshotScheduleQueue workqueue.TypedRateLimitingInterface[string]
+}
+
+// Init initializes the snapshot schedule controller
+func (ctrl *VMSnapshotScheduleController) Init() error {
+	ctrl.vmSnapshotScheduleQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-snapshot-vmsnapshotschedule"},
+	)
+
+	_, err := ctrl.VMSnapshotScheduleInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotSchedule,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotSchedule(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshot(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshot,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run the controller
+func (ctrl *VMSnapshotScheduleController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmSnapshotScheduleQueue.ShutDown()
+
+	log.Log.Info("Starting snapshot schedule controller.")
+	defer log.Log.Info("Shutting down snapshot schedule controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMSnapshotScheduleInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmSnapshotScheduleWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMSnapshotScheduleController) vmSnapshotScheduleWorker() {
+	for ctrl.processVMSnapshotScheduleWorkItem() {
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) processVMSnapshotScheduleWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmSnapshotScheduleQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmSnapshotSchedule worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMSnapshotScheduleInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		schedule, ok := storeObj.(*snapshotv1.VirtualMachineSnapshotSchedule)
+		if !ok {
+			return 0, fmt.Errorf(unexpectedResourceFmt, storeObj)
+		}
+
+		return ctrl.updateVMSnapshotSchedule(schedule.DeepCopy())
+	})
+}
+
+func (ctrl *VMSnapshotScheduleController) handleVMSnapshotSchedule(obj interface{}) {
+	if schedule, ok := obj.(*snapshotv1.VirtualMachineSnapshotSchedule); ok {
+		objName, err := cache.MetaNamespaceKeyFunc(schedule)
+		if err != nil {
+			log.Log.Errorf(failedKeyFromObjectFmt, err, schedule)
+			return
+		}
+
+		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
+		ctrl.vmSnapshotScheduleQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) handleVMSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot); ok {
+		scheduleName, ok := vmSnapshot.Labels[snapshotScheduleLabel]
+		if !ok {
+			return
+		}
+
+		objName := cacheKeyFunc(vmSnapshot.Namespace, scheduleName)
+
+		log.Log.V(3).Infof("Handling VirtualMachineSnapshot %s/%s, Schedule %s", vmSnapshot.Namespace, vmSnapshot.Name, objName)
+		ctrl.vmSnapshotScheduleQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) updateVMSnapshotSchedule(schedule *snapshotv1.VirtualMachineSnapshotSchedule) (time.Duration, error) {
+	log.Log.Object(schedule).V(3).Infof("Updating VirtualMachineSnapshotSchedule")
+
+	if schedule.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	scheduleOut := schedule.DeepCopy()
+	if scheduleOut.Status == nil {
+		scheduleOut.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{}
+	}
+
+	cronSchedule, err := cron.ParseStandard(schedule.Spec.Schedule)
+	if err != nil {
+		ctrl.Recorder.Eventf(schedule, corev1.EventTypeWarning, snapshotScheduleInvalidEvent, "Invalid schedule %q: %v", schedule.Spec.Schedule, err)
+		message := fmt.Sprintf("invalid schedule: %v", err)
+		scheduleOut.Status.NextScheduleTime = nil
+		if scheduleOut.Status.Error == nil || scheduleOut.Status.Error.Message == nil || *scheduleOut.Status.Error.Message != message {
+			scheduleOut.Status.Error = &snapshotv1.Error{
+				Time:    currentTime(),
+				Message: pointer.P(message),
+			}
+		}
+		return 0, ctrl.updateVMSnapshotScheduleStatus(schedule, scheduleOut)
+	}
+
+	snapshots, err := ctrl.scheduledSnapshots(schedule)
+	if err != nil {
+		return 0, err
+	}
+
+	now := currentTime().Time
+	scheduledTime := mostRecentScheduleTime(schedule, cronSchedule, now)
+	if scheduledTime != nil && !isScheduleDisabled(schedule) {
+		if err := ctrl.createScheduledSnapshot(schedule, scheduleOut, snapshots, *scheduledTime); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := ctrl.enforceRetention(schedule, snapshots, now); err != nil {
+		return 0, err
+	}
+
+	next := cronSchedule.Next(now)
+	scheduleOut.Status.NextScheduleTime = &metav1.Time{Time: next}
+	scheduleOut.Status.Error = nil
+	if err := ctrl.updateVMSnapshotScheduleStatus(schedule, scheduleOut); err != nil {
+		return 0, err
+	}
+
+	return next.Sub(now), nil
+}
+
+// mostRecentScheduleTime returns the latest schedule time which passed since
+// the last snapshot was scheduled, or nil if no snapshot is due
+func mostRecentScheduleTime(schedule *snapshotv1.VirtualMachineSnapshotSchedule, cronSchedule cron.Schedule, now time.Time) *time.Time {
+	earliest := schedule.CreationTimestamp.Time
+	if schedule.Status != nil && schedule.Status.LastScheduleTime != nil {
+		earliest = schedule.Status.LastScheduleTime.Time
+	}
+
+	var scheduled *time.Time
+	for i, t := 0, cronSchedule.Next(earliest); i < maxMissedSchedules && !t.After(now); i, t = i+1, cronSchedule.Next(t) {
+		scheduled = pointer.P(t)
+	}
+
+	return scheduled
+}
+
+func isScheduleDisabled(schedule *snapshotv1.VirtualMachineSnapshotSchedule) bool {
+	return schedule.Spec.Disabled != nil && *schedule.Spec.Disabled
+}
+
+func (ctrl *VMSnapshotScheduleController) createScheduledSnapshot(
+	schedule, scheduleOut *snapshotv1.VirtualMachineSnapshotSchedule,
+	snapshots []*snapshotv1.VirtualMachineSnapshot,
+	scheduledTime time.Time,
+) error {
+	scheduleOut.Status.LastScheduleTime = &metav1.Time{Time: scheduledTime}
+
+	// Creating a snapshot while the previous one is still running would
+	// only fail or queue up behind it, skip this run instead
+	for _, vmSnapshot := range snapshots {
+		if vmSnapshotInProgress(vmSnapshot) {
+			ctrl.Recorder.Eventf(
+				schedule,
+				corev1.EventTypeNormal,
+				snapshotScheduleSkipEvent,
+				"Skipping scheduled snapshot, %s is still in progress",
+				vmSnapshot.Name,
+			)
+			return nil
+		}
+	}
+
+	vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", schedule.Name, scheduledTime.Unix()),
+			Namespace: schedule.Namespace,
+			Labels: map[string]string{
+				snapshotScheduleLabel: schedule.Name,
+			},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source:          schedule.Spec.Source,
+			FailureDeadline: schedule.Spec.FailureDeadline,
+		},
+	}
+
+	_, err := ctrl.Client.VirtualMachineSnapshot(schedule.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		scheduleOut.Status.LastSnapshotName = pointer.P(vmSnapshot.Name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	scheduleOut.Status.LastSnapshotName = pointer.P(vmSnapshot.Name)
+	ctrl.Recorder.Eventf(
+		schedule,
+		corev1.EventTypeNormal,
+		snapshotScheduleCreateEvent,
+		"Successfully created VirtualMachineSnapshot %s",
+		vmSnapshot.Name,
+	)
+
+	return nil
+}
+
+func vmSnapshotInProgress(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	if vmSnapshot.DeletionTimestamp != nil {
+		return false
+	}
+	if vmSnapshot.Status == nil {
+		return true
+	}
+	return vmSnapshot.Status.Phase != snapshotv1.Succeeded &&
+		vmSnapshot.Status.Phase != snapshotv1.Failed
+}
+
+func (ctrl *VMSnapshotScheduleController) enforceRetention(
+	schedule *snapshotv1.VirtualMachineSnapshotSchedule,
+	snapshots []*snapshotv1.VirtualMachineSnapshot,
+	now time.Time,
+) error {
+	exceeding, _ := snapshotsExceedingRetention(schedule.Spec.Retention, snapshots, now)
+	for _, vmSnapshot := range exceeding {
+		err := ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Delete(context.Background(), vmSnapshot.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+
+		ctrl.Recorder.Eventf(
+			schedule,
+			corev1.EventTypeNormal,
+			snapshotScheduleRetentionEvent,
+			"Deleted VirtualMachineSnapshot %s according to the retention policy",
+			vmSnapshot.Name,
+		)
+	}
+
+	return nil
+}
+
+func (ctrl *VMSnapshotScheduleController) scheduledSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule) ([]*snapshotv1.VirtualMachineSnapshot, error) {
+	selector := labels.SelectorFromSet(labels.Set{snapshotScheduleLabel: schedule.Name})
+
+	var snapshots []*snapshotv1.VirtualMachineSnapshot
+	err := cache.ListAllByNamespace(ctrl.VMSnapshotInformer.GetIndexer(), schedule.Namespace, selector, func(obj interface{}) {
+		if vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot); ok {
+			snapshots = append(snapshots, vmSnapshot)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+func (ctrl *VMSnapshotScheduleController) updateVMSnapshotScheduleStatus(schedule, scheduleOut *snapshotv1.VirtualMachineSnapshotSchedule) error {
+	if equality.Semantic.DeepEqual(schedule.Status, scheduleOut.Status) {
+		return nil
+	}
+
+	_, err := ctrl.Client.VirtualMachineSnapshotSchedule(scheduleOut.Namespace).UpdateStatus(context.Background(), scheduleOut, metav1.UpdateOptions{})
+	return err
+}
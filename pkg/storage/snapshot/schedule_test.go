@@ -0,0 +1,289 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Snapshot schedule controller", func() {
+	const (
+		scheduleName = "test-schedule"
+		everyHour    = "0 * * * *"
+	)
+
+	var (
+		// 10:30, half an hour after the last hourly run
+		now         = time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC)
+		lastRun     = time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+		previousRun = time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+		controller         *VMSnapshotScheduleController
+		scheduleInformer   cache.SharedIndexInformer
+		vmSnapshotInformer cache.SharedIndexInformer
+		recorder           *record.FakeRecorder
+		kubevirtClient     *kubevirtfake.Clientset
+	)
+
+	createSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              scheduleName,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.NewTime(previousRun.Add(-time.Minute)),
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: &vmAPIGroup,
+					Kind:     "VirtualMachine",
+					Name:     "testvm",
+				},
+				Schedule: everyHour,
+			},
+		}
+	}
+
+	createScheduledSnapshot := func(scheduled time.Time, phase snapshotv1.VirtualMachineSnapshotPhase) *snapshotv1.VirtualMachineSnapshot {
+		vmSnapshot := createVirtualMachineSnapshot(testNamespace, fmt.Sprintf("%s-%d", scheduleName, scheduled.Unix()), "testvm")
+		vmSnapshot.CreationTimestamp = metav1.NewTime(scheduled)
+		vmSnapshot.Labels = map[string]string{snapshotScheduleLabel: scheduleName}
+		vmSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+			Phase: phase,
+		}
+		return vmSnapshot
+	}
+
+	addSchedule := func(schedule *snapshotv1.VirtualMachineSnapshotSchedule) {
+		Expect(scheduleInformer.GetStore().Add(schedule)).To(Succeed())
+		_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(testNamespace).Create(context.Background(), schedule, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addSnapshot := func(vmSnapshot *snapshotv1.VirtualMachineSnapshot) {
+		Expect(vmSnapshotInformer.GetStore().Add(vmSnapshot)).To(Succeed())
+		_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		schedule, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(testNamespace).Get(context.Background(), scheduleName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return schedule
+	}
+
+	snapshotExists := func(name string) bool {
+		_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace).Get(context.Background(), name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return false
+		}
+		Expect(err).ToNot(HaveOccurred())
+		return true
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshotSchedule(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(testNamespace)).AnyTimes()
+
+		scheduleInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+		vmSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+		recorder = record.NewFakeRecorder(100)
+
+		controller = &VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: scheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			Recorder:                   recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+
+		originalCurrentTime := currentTime
+		currentTime = func() *metav1.Time {
+			return pointer.P(metav1.NewTime(now))
+		}
+		DeferCleanup(func() {
+			currentTime = originalCurrentTime
+		})
+	})
+
+	It("should create a snapshot for the most recent missed run", func() {
+		schedule := createSchedule()
+		addSchedule(schedule)
+
+		requeue, err := controller.updateVMSnapshotSchedule(schedule)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue).To(Equal(30 * time.Minute))
+
+		expectedName := fmt.Sprintf("%s-%d", scheduleName, lastRun.Unix())
+		Expect(snapshotExists(expectedName)).To(BeTrue())
+		Expect(snapshotExists(fmt.Sprintf("%s-%d", scheduleName, previousRun.Unix()))).To(BeFalse())
+
+		vmSnapshot, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace).Get(context.Background(), expectedName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vmSnapshot.Labels).To(HaveKeyWithValue(snapshotScheduleLabel, scheduleName))
+		Expect(vmSnapshot.Spec.Source).To(Equal(schedule.Spec.Source))
+
+		updated := getSchedule()
+		Expect(updated.Status.LastScheduleTime.Time).To(BeTemporally("==", lastRun))
+		Expect(updated.Status.LastSnapshotName).To(HaveValue(Equal(expectedName)))
+		Expect(updated.Status.NextScheduleTime.Time).To(BeTemporally("==", lastRun.Add(time.Hour)))
+		testutils.ExpectEvent(recorder, snapshotScheduleCreateEvent)
+	})
+
+	It("should not create a snapshot before the next run is due", func() {
+		schedule := createSchedule()
+		schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+			LastScheduleTime: pointer.P(metav1.NewTime(lastRun)),
+		}
+		addSchedule(schedule)
+
+		_, err := controller.updateVMSnapshotSchedule(schedule)
+		Expect(err).ToNot(HaveOccurred())
+
+		snapshots, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(snapshots.Items).To(BeEmpty())
+	})
+
+	It("should not create a snapshot when disabled", func() {
+		schedule := createSchedule()
+		schedule.Spec.Disabled = pointer.P(true)
+		addSchedule(schedule)
+
+		_, err := controller.updateVMSnapshotSchedule(schedule)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(snapshotExists(fmt.Sprintf("%s-%d", scheduleName, lastRun.Unix()))).To(BeFalse())
+		Expect(getSchedule().Status.LastScheduleTime).To(BeNil())
+	})
+
+	It("should skip the run while a previous snapshot is in progress", func() {
+		schedule := createSchedule()
+		addSchedule(schedule)
+		addSnapshot(createScheduledSnapshot(previousRun, snapshotv1.InProgress))
+
+		_, err := controller.updateVMSnapshotSchedule(schedule)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(snapshotExists(fmt.Sprintf("%s-%d", scheduleName, lastRun.Unix()))).To(BeFalse())
+		Expect(getSchedule().Status.LastScheduleTime.Time).To(BeTemporally("==", lastRun))
+		testutils.ExpectEvent(recorder, snapshotScheduleSkipEvent)
+	})
+
+	It("should report an invalid schedule", func() {
+		schedule := createSchedule()
+		schedule.Spec.Schedule = "not a schedule"
+		addSchedule(schedule)
+
+		requeue, err := controller.updateVMSnapshotSchedule(schedule)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue).To(BeZero())
+
+		updated := getSchedule()
+		Expect(updated.Status.Error).ToNot(BeNil())
+		Expect(*updated.Status.Error.Message).To(ContainSubstring("invalid schedule"))
+		Expect(updated.Status.NextScheduleTime).To(BeNil())
+		testutils.ExpectEvent(recorder, snapshotScheduleInvalidEvent)
+	})
+
+	Context("with a retention policy", func() {
+		var schedule *snapshotv1.VirtualMachineSnapshotSchedule
+
+		BeforeEach(func() {
+			schedule = createSchedule()
+			schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+				LastScheduleTime: pointer.P(metav1.NewTime(lastRun)),
+			}
+			for i := range 4 {
+				addSnapshot(createScheduledSnapshot(lastRun.Add(-time.Duration(i)*time.Hour), snapshotv1.Succeeded))
+			}
+		})
+
+		It("should keep only the most recent snapshots", func() {
+			schedule.Spec.Retention = &snapshotv1.SnapshotRetentionPolicy{
+				MaxCount: pointer.P(int32(2)),
+			}
+			addSchedule(schedule)
+
+			_, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(snapshotExists(fmt.Sprintf("%s-%d", scheduleName, lastRun.Unix()))).To(BeTrue())
+			Expect(snapshotExists(fmt.Sprintf("%s-%d", scheduleName, lastRun.Add(-time.Hour).Unix()))).To(BeTrue())
+			Expect(snapshotExists(fmt.Sprintf("%s-%d", scheduleName, lastRun.Add(-2*time.Hour).Unix()))).To(BeFalse())
+			Expect(snapshotExists(fmt.Sprintf("%s-%d", scheduleName, lastRun.Add(-3*time.Hour).Unix()))).To(BeFalse())
+			testutils.ExpectEvents(recorder, snapshotScheduleRetentionEvent, snapshotScheduleRetentionEvent)
+		})
+
+		It("should delete snapshots older than the max age", func() {
+			schedule.Spec.Retention = &snapshotv1.SnapshotRetentionPolicy{
+				MaxAge: &metav1.Duration{Duration: 2 * time.Hour},
+			}
+			addSchedule(schedule)
+
+			_, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(snapshotExists(fmt.Sprintf("%s-%d", scheduleName, lastRun.Unix()))).To(BeTrue())
+			Expect(snapshotExists(fmt.Sprintf("%s-%d", scheduleName, lastRun.Add(-time.Hour).Unix()))).To(BeTrue())
+			Expect(snapshotExists(fmt.Sprintf("%s-%d", scheduleName, lastRun.Add(-2*time.Hour).Unix()))).To(BeFalse())
+			Expect(snapshotExists(fmt.Sprintf("%s-%d", scheduleName, lastRun.Add(-3*time.Hour).Unix()))).To(BeFalse())
+		})
+
+		It("should not delete snapshots which are still in progress", func() {
+			schedule.Spec.Retention = &snapshotv1.SnapshotRetentionPolicy{
+				MaxCount: pointer.P(int32(1)),
+			}
+			addSchedule(schedule)
+			inProgress := createScheduledSnapshot(lastRun.Add(-5*time.Hour), snapshotv1.InProgress)
+			addSnapshot(inProgress)
+
+			_, err := controller.updateVMSnapshotSchedule(schedule)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(snapshotExists(inProgress.Name)).To(BeTrue())
+			Expect(snapshotExists(fmt.Sprintf("%s-%d", scheduleName, lastRun.Unix()))).To(BeTrue())
+			Expect(snapshotExists(fmt.Sprintf("%s-%d", scheduleName, lastRun.Add(-time.Hour).Unix()))).To(BeFalse())
+		})
+	})
+})
@@ -22,6 +22,7 @@ package snapshot
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -43,6 +44,8 @@ import (
 	"kubevirt.io/kubevirt/pkg/controller"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	"kubevirt.io/kubevirt/pkg/storage/freezehooks"
 	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
 )
 
@@ -63,6 +66,8 @@ const (
 
 	volumeSnapshotMissingEvent = "VolumeSnapshotMissing"
 
+	freezeHookFailedEvent = "FreezeHookFailed"
+
 	vmSnapshotDeadlineExceededError = "snapshot deadline exceeded"
 
 	snapshotRetryInterval = 5 * time.Second
@@ -165,6 +170,10 @@ func (ctrl *VMSnapshotController) updateVMSnapshot(vmSnapshot *snapshotv1.Virtua
 	log.Log.V(3).Infof("Updating VirtualMachineSnapshot %s/%s", vmSnapshot.Namespace, vmSnapshot.Name)
 	var retry time.Duration
 
+	if isPoolSnapshot(vmSnapshot) {
+		return ctrl.updatePoolVMSnapshot(vmSnapshot)
+	}
+
 	source, err := ctrl.getSnapshotSource(vmSnapshot)
 	if err != nil {
 		return 0, err
@@ -235,10 +244,13 @@ func (ctrl *VMSnapshotController) updateVMSnapshot(vmSnapshot *snapshotv1.Virtua
 		}
 	}
 
-	vmSnapshot, err = ctrl.updateSnapshotStatus(vmSnapshot, source)
+	vmSnapshot, stallRetry, err := ctrl.updateSnapshotStatus(vmSnapshot, source)
 	if err != nil {
 		return 0, err
 	}
+	if retry == 0 {
+		retry = stallRetry
+	}
 
 	if vmSnapshotDeleting(vmSnapshot) && canRemoveFinalizer {
 		vmSnapshot, err = ctrl.removeSnapshotFinalizer(vmSnapshot)
@@ -247,6 +259,14 @@ func (ctrl *VMSnapshotController) updateVMSnapshot(vmSnapshot *snapshotv1.Virtua
 		}
 	}
 
+	retentionRetry, err := ctrl.enforceRetentionPolicy(vmSnapshot)
+	if err != nil {
+		return 0, err
+	}
+	if retry == 0 {
+		retry = retentionRetry
+	}
+
 	if retry == 0 {
 		return timeUntilDeadline(vmSnapshot), nil
 	}
@@ -271,6 +291,39 @@ func (ctrl *VMSnapshotController) unfreezeSource(vmSnapshot *snapshotv1.VirtualM
 	return nil
 }
 
+// thawSource unfreezes the source once the snapshot is created and returns the
+// results of the post-thaw hooks. A failing post-thaw hook does not fail the
+// snapshot, the guest filesystems are thawed at that point.
+func (ctrl *VMSnapshotController) thawSource(content *snapshotv1.VirtualMachineSnapshotContent, vmSnapshot *snapshotv1.VirtualMachineSnapshot) ([]snapshotv1.FreezeHookResult, error) {
+	if vmSnapshot == nil {
+		return nil, nil
+	}
+	source, err := ctrl.getSnapshotSource(vmSnapshot)
+	if err != nil || source == nil {
+		return nil, err
+	}
+	if !source.Locked() || !source.GuestAgent() {
+		return nil, nil
+	}
+
+	err = source.Unfreeze()
+	results := freezehooks.Results(source.FreezeHooks(), snapshotv1.PostThawHook, err)
+	if err != nil {
+		if results == nil {
+			return nil, err
+		}
+		ctrl.Recorder.Eventf(
+			content,
+			corev1.EventTypeWarning,
+			freezeHookFailedEvent,
+			"Post-thaw hook failed: %v",
+			err,
+		)
+	}
+
+	return results, nil
+}
+
 func generateFinalizerPatch(test, replace []string) ([]byte, error) {
 	return patch.New(
 		patch.WithTest("/metadata/finalizers", test),
@@ -366,10 +419,19 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 
 	contentCreated := vmSnapshotContentCreated(content)
 
-	for _, volumeBackup := range content.Spec.VolumeBackups {
+	volumeBackups := content.Spec.VolumeBackups
+	if content.Spec.MemoryVolumeBackup != nil {
+		// the memory is dumped after the volumes were snapshotted and while the source is still frozen
+		volumeBackups = append(slices.Clone(volumeBackups), *content.Spec.MemoryVolumeBackup)
+	}
+
+	var memoryStatus *snapshotv1.VolumeSnapshotStatus
+	var retry time.Duration
+	for _, volumeBackup := range volumeBackups {
 		if volumeBackup.VolumeSnapshotName == nil {
 			continue
 		}
+		isMemory := isMemoryVolumeBackup(content, volumeBackup)
 
 		vsName := *volumeBackup.VolumeSnapshotName
 
@@ -415,9 +477,11 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 						Message: pointer.P(err.Error()),
 					}
 					contentCpy.Status.ReadyToUse = pointer.P(false)
+					contentCpy.Status.HookResults = freezehooks.Results(source.FreezeHooks(), snapshotv1.PreFreezeHook, err)
 					// Retry again in 5 seconds
 					return 5 * time.Second, ctrl.updateVmSnapshotContentStatus(content, contentCpy)
 				}
+				contentCpy.Status.HookResults = freezehooks.Results(source.FreezeHooks(), snapshotv1.PreFreezeHook, nil)
 
 				// assuming that VM is frozen once Freeze() returns
 				// which should be the case
@@ -425,6 +489,33 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 				// and only continue when source.Frozen() == true
 
 				didFreeze = true
+
+				// the members of a group are snapshotted only once all of them are frozen
+				groupFrozen, err := ctrl.snapshotGroupFrozen(vmSnapshot)
+				if err != nil {
+					return 0, err
+				}
+				if !groupFrozen {
+					return snapshotRetryInterval, ctrl.updateVmSnapshotContentStatus(content, contentCpy)
+				}
+			}
+
+			if isMemory {
+				dumped, err := ctrl.dumpMemory(content, vmSnapshot)
+				if err != nil {
+					contentCpy.Status.Error = &snapshotv1.Error{
+						Time:    currentTime(),
+						Message: pointer.P(err.Error()),
+					}
+					contentCpy.Status.ReadyToUse = pointer.P(false)
+					return snapshotRetryInterval, ctrl.updateVmSnapshotContentStatus(content, contentCpy)
+				}
+				if !dumped {
+					// keep the source frozen until the memory is dumped
+					memoryStatus = &snapshotv1.VolumeSnapshotStatus{VolumeSnapshotName: vsName}
+					retry = snapshotRetryInterval
+					continue
+				}
 			}
 
 			volumeSnapshot, err = ctrl.createVolumeSnapshot(content, volumeBackup)
@@ -443,6 +534,10 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 			vss.Error = translateError(volumeSnapshot.Status.Error)
 		}
 
+		if isMemory {
+			memoryStatus = &vss
+			continue
+		}
 		volumeSnapshotStatus = append(volumeSnapshotStatus, vss)
 	}
 
@@ -457,7 +552,11 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 		created, ready = false, false
 		errorMessage = fmt.Sprintf("VolumeSnapshots (%s) skipped because vm snapshot is deleted", strings.Join(skippedSnapshots, ","))
 	} else {
-		for _, vss := range volumeSnapshotStatus {
+		statuses := volumeSnapshotStatus
+		if memoryStatus != nil {
+			statuses = append(slices.Clone(statuses), *memoryStatus)
+		}
+		for _, vss := range statuses {
 			if vss.CreationTime == nil {
 				created = false
 			}
@@ -474,10 +573,11 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 	if created && contentCpy.Status.CreationTime == nil {
 		contentCpy.Status.CreationTime = currentTime()
 
-		err = ctrl.unfreezeSource(vmSnapshot)
+		results, err := ctrl.thawSource(content, vmSnapshot)
 		if err != nil {
 			return 0, err
 		}
+		contentCpy.Status.HookResults = append(contentCpy.Status.HookResults, results...)
 	}
 
 	if errorMessage != "" && !ready {
@@ -487,10 +587,16 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 		}
 	}
 
+	if ready && content.Spec.MemoryVolumeBackup != nil && vmSnapshot != nil {
+		if err := ctrl.releaseMemoryBackup(content, vmSnapshot); err != nil {
+			return 0, err
+		}
+	}
+
 	contentCpy.Status.ReadyToUse = &ready
 	contentCpy.Status.VolumeSnapshotStatus = volumeSnapshotStatus
 
-	return 0, ctrl.updateVmSnapshotContentStatus(content, contentCpy)
+	return retry, ctrl.updateVmSnapshotContentStatus(content, contentCpy)
 }
 
 func (ctrl *VMSnapshotController) updateVmSnapshotContentStatus(oldContent, newContent *snapshotv1.VirtualMachineSnapshotContent) error {
@@ -603,6 +709,10 @@ func (ctrl *VMSnapshotController) createContent(vmSnapshot *snapshotv1.VirtualMa
 	if err != nil {
 		return err
 	}
+	hotplugs, err := source.HotplugVolumes()
+	if err != nil {
+		return err
+	}
 	for volumeName, pvcName := range pvcs {
 		pvc, err := ctrl.getSnapshotPVC(vmSnapshot.Namespace, pvcName)
 		if err != nil {
@@ -623,10 +733,21 @@ func (ctrl *VMSnapshotController) createContent(vmSnapshot *snapshotv1.VirtualMa
 			},
 			VolumeSnapshotName: &volumeSnapshotName,
 		}
+		if hotplug, ok := hotplugs[volumeName]; ok {
+			vb.Hotplug = hotplug.DeepCopy()
+		}
 
 		volumeBackups = append(volumeBackups, vb)
 	}
 
+	var memoryVolumeBackup *snapshotv1.VolumeBackup
+	if includeMemory(vmSnapshot) && source.Online() {
+		memoryVolumeBackup, err = ctrl.createMemoryBackup(vmSnapshot, volumeBackups)
+		if err != nil {
+			return err
+		}
+	}
+
 	sourceSpec, err := source.Spec()
 	if err != nil {
 		return err
@@ -641,6 +762,7 @@ func (ctrl *VMSnapshotController) createContent(vmSnapshot *snapshotv1.VirtualMa
 			VirtualMachineSnapshotName: &vmSnapshot.Name,
 			Source:                     sourceSpec,
 			VolumeBackups:              volumeBackups,
+			MemoryVolumeBackup:         memoryVolumeBackup,
 		},
 	}
 
@@ -748,7 +870,7 @@ func (ctrl *VMSnapshotController) getVolumeSnapshotClassName(storageClassName st
 	return "", fmt.Errorf("%d matching VolumeSnapshotClasses for %s", len(matches), storageClassName)
 }
 
-func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.VirtualMachineSnapshot, source snapshotSource) (*snapshotv1.VirtualMachineSnapshot, error) {
+func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.VirtualMachineSnapshot, source snapshotSource) (*snapshotv1.VirtualMachineSnapshot, time.Duration, error) {
 	f := false
 	vmSnapshotCpy := vmSnapshot.DeepCopy()
 	if vmSnapshotCpy.Status == nil {
@@ -759,8 +881,14 @@ func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.Vi
 
 	content, err := ctrl.getContent(vmSnapshot)
 	if err != nil {
-		return vmSnapshot, err
+		return vmSnapshot, 0, err
+	}
+
+	progress, stallRetry, err := ctrl.volumeSnapshotProgress(content)
+	if err != nil {
+		return vmSnapshot, 0, err
 	}
+	vmSnapshotCpy.Status.Progress = progress
 
 	if source != nil {
 		uid := source.UID()
@@ -773,6 +901,7 @@ func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.Vi
 		vmSnapshotCpy.Status.CreationTime = content.Status.CreationTime
 		vmSnapshotCpy.Status.ReadyToUse = content.Status.ReadyToUse
 		vmSnapshotCpy.Status.Error = content.Status.Error
+		vmSnapshotCpy.Status.HookResults = content.Status.HookResults
 	}
 
 	// terminal phase 1 - failed
@@ -785,8 +914,9 @@ func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.Vi
 		vmSnapshotCpy.Status.Phase = snapshotv1.Succeeded
 		updateSnapshotCondition(vmSnapshotCpy, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
 		if err := ctrl.updateSnapshotSnapshotableVolumes(vmSnapshotCpy, content); err != nil {
-			return nil, err
+			return nil, 0, err
 		}
+		updatePersistentStateIndication(vmSnapshotCpy, content)
 		metrics.HandleSucceededVMSnapshot(vmSnapshotCpy)
 	} else {
 		vmSnapshotCpy.Status.Phase = snapshotv1.InProgress
@@ -820,12 +950,17 @@ func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.Vi
 
 	if !equality.Semantic.DeepEqual(vmSnapshot.Status, vmSnapshotCpy.Status) {
 		if err := ctrl.vmSnapshotStatusUpdater.UpdateStatus(vmSnapshotCpy); err != nil {
-			return nil, err
+			return nil, 0, err
+		}
+		var oldProgress *snapshotv1.VirtualMachineSnapshotProgress
+		if vmSnapshot.Status != nil {
+			oldProgress = vmSnapshot.Status.Progress
 		}
-		return vmSnapshotCpy, nil
+		ctrl.recordStalledVolumeSnapshots(vmSnapshotCpy, oldProgress, progress)
+		return vmSnapshotCpy, stallRetry, nil
 	}
 
-	return vmSnapshot, nil
+	return vmSnapshot, stallRetry, nil
 }
 
 func updateSnapshotIndications(snapshot *snapshotv1.VirtualMachineSnapshot, source snapshotSource) {
@@ -843,6 +978,10 @@ func updateSnapshotIndications(snapshot *snapshotv1.VirtualMachineSnapshot, sour
 		} else {
 			indications = sets.Insert(indications, snapshotv1.VMSnapshotNoGuestAgentIndication)
 		}
+
+		if includeMemory(snapshot) {
+			indications = sets.Insert(indications, snapshotv1.VMSnapshotMemoryIndication)
+		}
 		snapshot.Status.Indications = sets.List(indications)
 	}
 }
@@ -881,6 +1020,27 @@ func (ctrl *VMSnapshotController) updateSnapshotSnapshotableVolumes(snapshot *sn
 	return nil
 }
 
+// updatePersistentStateIndication indicates the persistent vTPM and EFI state of the VM is part of the snapshot
+func updatePersistentStateIndication(snapshot *snapshotv1.VirtualMachineSnapshot, content *snapshotv1.VirtualMachineSnapshotContent) {
+	if content == nil {
+		return
+	}
+	vm := content.Spec.Source.VirtualMachine
+	if vm == nil || vm.Spec.Template == nil || !backendstorage.IsBackendStorageNeededForVMI(&vm.Spec.Template.Spec) {
+		return
+	}
+
+	backendVolumeName := storageutils.BackendPVCVolumeName(vm.Name)
+	for _, volumeBackup := range content.Spec.VolumeBackups {
+		if volumeBackup.VolumeName == backendVolumeName {
+			indications := sets.New(snapshot.Status.Indications...)
+			indications = sets.Insert(indications, snapshotv1.VMSnapshotPersistentStateIndication)
+			snapshot.Status.Indications = sets.List(indications)
+			return
+		}
+	}
+}
+
 func (ctrl *VMSnapshotController) updateVolumeSnapshotStatuses(vm *kubevirtv1.VirtualMachine) error {
 	log.Log.V(3).Infof("Update volume snapshot status for VM [%s/%s]", vm.Namespace, vm.Name)
 
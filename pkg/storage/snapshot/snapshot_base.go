@@ -437,6 +437,11 @@ func (ctrl *VMSnapshotController) handleVMSnapshot(obj interface{}) {
 		}
 		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
 		ctrl.vmSnapshotQueue.Add(objName)
+
+		// the status of a pool snapshot is aggregated from its member snapshots
+		if poolSnapshotName, ok := vmSnapshot.Labels[poolSnapshotLabel]; ok {
+			ctrl.vmSnapshotQueue.Add(cacheKeyFunc(vmSnapshot.Namespace, poolSnapshotName))
+		}
 	}
 }
 
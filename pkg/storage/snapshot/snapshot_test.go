@@ -52,6 +52,8 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	instancetypeapi "kubevirt.io/api/instancetype"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/api/pool"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	k8ssnapshotfake "kubevirt.io/client-go/externalsnapshotter/fake"
 	"kubevirt.io/client-go/kubecli"
@@ -62,6 +64,8 @@ import (
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/instancetype/revision"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/util"
 )
@@ -200,9 +204,30 @@ var _ = Describe("Snapshot controlleer", func() {
 			ReadyToUse:   pointer.P(true),
 			CreationTime: timeFunc(),
 		}
+		for _, vb := range content.Spec.VolumeBackups {
+			content.Status.VolumeSnapshotStatus = append(content.Status.VolumeSnapshotStatus, snapshotv1.VolumeSnapshotStatus{
+				VolumeSnapshotName: *vb.VolumeSnapshotName,
+				CreationTime:       timeFunc(),
+				ReadyToUse:         pointer.P(true),
+			})
+		}
 		return content
 	}
 
+	createVMSnapshotProgress := func(percentage int32, phase snapshotv1.VolumeSnapshotProgressPhase) *snapshotv1.VirtualMachineSnapshotProgress {
+		content := createVMSnapshotContent()
+		return &snapshotv1.VirtualMachineSnapshotProgress{
+			Percentage: percentage,
+			Volumes: []snapshotv1.VolumeSnapshotProgress{
+				{
+					VolumeName:         diskName,
+					VolumeSnapshotName: content.Spec.VolumeBackups[0].VolumeSnapshotName,
+					Phase:              phase,
+				},
+			},
+		}
+	}
+
 	createVMSnapshotErrored := func() *snapshotv1.VirtualMachineSnapshot {
 		vms := createVMSnapshotInProgress()
 		errorMessage := "VolumeSnapshot vol1 error: error"
@@ -214,6 +239,7 @@ var _ = Describe("Snapshot controlleer", func() {
 			newReadyCondition(corev1.ConditionFalse, "Not ready"),
 		}
 		vms.Status.Error = content.Status.Error
+		vms.Status.Progress = createVMSnapshotProgress(0, snapshotv1.VolumeSnapshotPhasePending)
 		return vms
 	}
 
@@ -630,6 +656,224 @@ var _ = Describe("Snapshot controlleer", func() {
 				controller.processVMSnapshotWorkItem()
 			})
 
+			Context("with a retention policy", func() {
+				createOlderVMSnapshot := func(name, vmName string, age time.Duration) *snapshotv1.VirtualMachineSnapshot {
+					s := createVirtualMachineSnapshot(testNamespace, name, vmName)
+					s.UID = types.UID(name)
+					s.CreationTimestamp = metav1.NewTime(timeStamp.Add(-age))
+					s.Status = createVMSnapshotSuccess().Status
+					return s
+				}
+
+				It("should delete older snapshots of the same VM beyond maxCount", func() {
+					vmSnapshot := createVMSnapshotSuccess()
+					vmSnapshot.CreationTimestamp = timeStamp
+					vmSnapshot.Spec.RetentionPolicy = &snapshotv1.SnapshotRetentionPolicy{MaxCount: pointer.P(int32(2))}
+
+					addVirtualMachineSnapshot(vmSnapshot)
+
+					inProgress := createOlderVMSnapshot("in-progress", vmName, 3*time.Hour)
+					inProgress.Status = createVMSnapshotInProgress().Status
+					for _, s := range []*snapshotv1.VirtualMachineSnapshot{
+						createOlderVMSnapshot("older", vmName, time.Hour),
+						createOlderVMSnapshot("oldest", vmName, 2*time.Hour),
+						createOlderVMSnapshot("other-vm", "othervm", 4*time.Hour),
+						inProgress,
+					} {
+						Expect(vmSnapshotInformer.GetStore().Add(s)).To(Succeed())
+					}
+
+					deletes := expectVMSnapshotDelete(vmSnapshotClient, "oldest")
+					controller.processVMSnapshotWorkItem()
+					Expect(*deletes).To(Equal(1))
+					testutils.ExpectEvent(recorder, vmSnapshotRetentionEvent)
+				})
+
+				It("should delete older snapshots beyond maxAge and requeue until the next one expires", func() {
+					vmSnapshot := createVMSnapshotSuccess()
+					vmSnapshot.CreationTimestamp = timeStamp
+					vmSnapshot.Spec.RetentionPolicy = &snapshotv1.SnapshotRetentionPolicy{
+						MaxAge: &metav1.Duration{Duration: 90 * time.Minute},
+					}
+					Expect(vmSnapshotInformer.GetStore().Add(vmSnapshot)).To(Succeed())
+					Expect(vmSnapshotInformer.GetStore().Add(createOlderVMSnapshot("older", vmName, time.Hour))).To(Succeed())
+					Expect(vmSnapshotInformer.GetStore().Add(createOlderVMSnapshot("oldest", vmName, 2*time.Hour))).To(Succeed())
+
+					deletes := expectVMSnapshotDelete(vmSnapshotClient, "oldest")
+					retry, err := controller.updateVMSnapshot(vmSnapshot)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(retry).To(Equal(30 * time.Minute))
+					Expect(*deletes).To(Equal(1))
+					testutils.ExpectEvent(recorder, vmSnapshotRetentionEvent)
+				})
+
+				It("should not delete other snapshots while in progress", func() {
+					vmSnapshot := createVMSnapshotInProgress()
+					vmSnapshot.CreationTimestamp = timeStamp
+					vmSnapshot.Spec.RetentionPolicy = &snapshotv1.SnapshotRetentionPolicy{MaxCount: pointer.P(int32(1))}
+					Expect(vmSnapshotInformer.GetStore().Add(createOlderVMSnapshot("older", vmName, time.Hour))).To(Succeed())
+
+					retry, err := controller.enforceRetentionPolicy(vmSnapshot)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(retry).To(BeZero())
+				})
+			})
+
+			Context("with a VirtualMachinePool source", func() {
+				const poolName = "pool"
+				var poolUID types.UID = "pool-uid"
+
+				createPoolMember := func(name string) *v1.VirtualMachine {
+					vm := createVirtualMachine(testNamespace, name)
+					vm.OwnerReferences = []metav1.OwnerReference{{
+						APIVersion: poolv1.SchemeGroupVersion.String(),
+						Kind:       poolv1.VirtualMachinePoolKind,
+						Name:       poolName,
+						UID:        poolUID,
+						Controller: pointer.P(true),
+					}}
+					return vm
+				}
+
+				createPoolSnapshot := func() *snapshotv1.VirtualMachineSnapshot {
+					vmSnapshot := createVMSnapshot()
+					vmSnapshot.Spec.Source = corev1.TypedLocalObjectReference{
+						APIGroup: pointer.P(pool.GroupName),
+						Kind:     poolv1.VirtualMachinePoolKind,
+						Name:     poolName,
+					}
+					return vmSnapshot
+				}
+
+				createMemberSnapshot := func(poolSnapshot *snapshotv1.VirtualMachineSnapshot, vmName string, status *snapshotv1.VirtualMachineSnapshotStatus) *snapshotv1.VirtualMachineSnapshot {
+					member := createVirtualMachineSnapshot(testNamespace, poolSnapshot.Name+"-"+vmName, vmName)
+					member.Labels = map[string]string{poolSnapshotLabel: poolSnapshot.Name}
+					member.Status = status
+					return member
+				}
+
+				It("should create a VirtualMachineSnapshot for each member of the pool", func() {
+					vmSnapshot := createPoolSnapshot()
+					Expect(vmInformer.GetStore().Add(createPoolMember("vm-b"))).To(Succeed())
+					Expect(vmInformer.GetStore().Add(createPoolMember("vm-a"))).To(Succeed())
+					Expect(vmInformer.GetStore().Add(createVirtualMachine(testNamespace, "other"))).To(Succeed())
+
+					var created []string
+					vmSnapshotClient.Fake.PrependReactor("create", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						create, ok := action.(testing.CreateAction)
+						Expect(ok).To(BeTrue())
+
+						createObj := create.GetObject().(*snapshotv1.VirtualMachineSnapshot)
+						Expect(createObj.Labels).To(HaveKeyWithValue(poolSnapshotLabel, vmSnapshotName))
+						Expect(metav1.IsControlledBy(createObj, vmSnapshot)).To(BeTrue())
+						Expect(createObj.Spec.Source.Kind).To(Equal("VirtualMachine"))
+						Expect(createObj.Spec.FailureDeadline).To(Equal(vmSnapshot.Spec.FailureDeadline))
+						created = append(created, createObj.Spec.Source.Name)
+
+						return true, createObj, nil
+					})
+
+					expectedSnapshot := vmSnapshot.DeepCopy()
+					expectedSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+						ReadyToUse:      pointer.P(false),
+						SourceUID:       &poolUID,
+						Phase:           snapshotv1.InProgress,
+						MemberSnapshots: []string{vmSnapshotName + "-vm-a", vmSnapshotName + "-vm-b"},
+						Conditions: []snapshotv1.Condition{
+							newProgressingCondition(corev1.ConditionTrue, "Snapshotting VirtualMachinePool members"),
+							newReadyCondition(corev1.ConditionFalse, "Not ready"),
+						},
+					}
+					updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, expectedSnapshot)
+
+					_, err := controller.updateVMSnapshot(vmSnapshot)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(created).To(Equal([]string{"vm-a", "vm-b"}))
+					Expect(*updateStatusCalls).To(Equal(1))
+					testutils.ExpectEvents(recorder, poolMemberSnapshotCreateEvent, poolMemberSnapshotCreateEvent)
+				})
+
+				It("should wait for the pool to have members", func() {
+					vmSnapshot := createPoolSnapshot()
+
+					expectedSnapshot := vmSnapshot.DeepCopy()
+					expectedSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+						ReadyToUse: pointer.P(false),
+						Phase:      snapshotv1.InProgress,
+						Conditions: []snapshotv1.Condition{
+							newProgressingCondition(corev1.ConditionFalse, poolSnapshotNoMembersMessage),
+							newReadyCondition(corev1.ConditionFalse, "Not ready"),
+						},
+					}
+					updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, expectedSnapshot)
+
+					retry, err := controller.updateVMSnapshot(vmSnapshot)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(retry).To(Equal(snapshotRetryInterval))
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should succeed once all member snapshots succeeded", func() {
+					vmSnapshot := createPoolSnapshot()
+					vmSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+						ReadyToUse:      pointer.P(false),
+						SourceUID:       &poolUID,
+						Phase:           snapshotv1.InProgress,
+						MemberSnapshots: []string{vmSnapshotName + "-vm-a", vmSnapshotName + "-vm-b"},
+					}
+					for _, name := range []string{"vm-a", "vm-b"} {
+						member := createMemberSnapshot(vmSnapshot, name, createVMSnapshotSuccess().Status)
+						Expect(vmSnapshotInformer.GetStore().Add(member)).To(Succeed())
+					}
+
+					expectedSnapshot := vmSnapshot.DeepCopy()
+					expectedSnapshot.Status.ReadyToUse = pointer.P(true)
+					expectedSnapshot.Status.Phase = snapshotv1.Succeeded
+					expectedSnapshot.Status.CreationTime = timeFunc()
+					expectedSnapshot.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionFalse, "Operation complete"),
+						newReadyCondition(corev1.ConditionTrue, "Ready"),
+					}
+					updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, expectedSnapshot)
+
+					retry, err := controller.updateVMSnapshot(vmSnapshot)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(retry).To(BeZero())
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should fail when a member snapshot failed", func() {
+					vmSnapshot := createPoolSnapshot()
+					vmSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+						ReadyToUse:      pointer.P(false),
+						SourceUID:       &poolUID,
+						Phase:           snapshotv1.InProgress,
+						MemberSnapshots: []string{vmSnapshotName + "-vm-a", vmSnapshotName + "-vm-b"},
+					}
+					failedStatus := createVMSnapshotInProgress().Status
+					failedStatus.Phase = snapshotv1.Failed
+					Expect(vmSnapshotInformer.GetStore().Add(createMemberSnapshot(vmSnapshot, "vm-a", createVMSnapshotSuccess().Status))).To(Succeed())
+					Expect(vmSnapshotInformer.GetStore().Add(createMemberSnapshot(vmSnapshot, "vm-b", failedStatus))).To(Succeed())
+
+					expectedSnapshot := vmSnapshot.DeepCopy()
+					expectedSnapshot.Status.Phase = snapshotv1.Failed
+					expectedSnapshot.Status.Error = &snapshotv1.Error{
+						Message: pointer.P(fmt.Sprintf("VirtualMachineSnapshots of pool members failed: %s-vm-b", vmSnapshotName)),
+					}
+					expectedSnapshot.Status.Conditions = []snapshotv1.Condition{
+						newFailureCondition(corev1.ConditionTrue, *expectedSnapshot.Status.Error.Message),
+						newProgressingCondition(corev1.ConditionFalse, "Operation failed"),
+						newReadyCondition(corev1.ConditionFalse, "Not ready"),
+					}
+					updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, expectedSnapshot)
+
+					retry, err := controller.updateVMSnapshot(vmSnapshot)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(retry).To(BeZero())
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+			})
+
 			It("cleanup when VirtualMachineSnapshot is deleted", func() {
 				vmSnapshot := createVMSnapshotSuccess()
 				vmSnapshot.DeletionTimestamp = timeFunc()
@@ -667,6 +911,7 @@ var _ = Describe("Snapshot controlleer", func() {
 				content := createReadyVMSnapshotContent()
 
 				updatedSnapshot.Status.VirtualMachineSnapshotContentName = &content.Name
+				updatedSnapshot.Status.Progress = createVMSnapshotProgress(100, snapshotv1.VolumeSnapshotPhaseReady)
 
 				updatedSnapshot2 := updatedSnapshot.DeepCopy()
 				updatedSnapshot2.Finalizers = []string{}
@@ -1010,6 +1255,140 @@ var _ = Describe("Snapshot controlleer", func() {
 				Expect(*createCalls).To(Equal(1))
 			})
 
+			It("should capture volumes hotplugged to the vmi in online VirtualMachineSnapshotContent", func() {
+				vmSnapshot := createVMSnapshotInProgress()
+				vm := createLockedVM()
+				vm.Spec.RunStrategy = pointer.P(v1.RunStrategyAlways)
+
+				vmRevision := createVMRevision(vm)
+				crSource.Add(vmRevision)
+
+				vm.ObjectMeta.Annotations = map[string]string{}
+				vm.ObjectMeta.Generation = 2
+
+				hotplugVolume := v1.Volume{
+					Name: "disk2",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "test-pvc",
+							},
+							Hotpluggable: true,
+						},
+					},
+				}
+				hotplugDisk := v1.Disk{
+					Name: "disk2",
+					DiskDevice: v1.DiskDevice{
+						Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI},
+					},
+				}
+				vmi := createVMI(vm)
+				vmi.Status.VirtualMachineRevisionName = vmRevisionName
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, hotplugVolume)
+				vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, hotplugDisk)
+				vmiSource.Add(vmi)
+
+				snapshotVM := vm.DeepCopy()
+				snapshotVM.Spec.Template.Spec.Volumes = append(snapshotVM.Spec.Template.Spec.Volumes, hotplugVolume)
+				snapshotVM.Spec.Template.Spec.Domain.Devices.Disks = append(snapshotVM.Spec.Template.Spec.Domain.Devices.Disks, hotplugDisk)
+				expectedContent := createVirtualMachineSnapshotContent(vmSnapshot, snapshotVM, createPersistentVolumeClaims())
+				for i := range expectedContent.Spec.VolumeBackups {
+					if expectedContent.Spec.VolumeBackups[i].VolumeName == hotplugVolume.Name {
+						expectedContent.Spec.VolumeBackups[i].Hotplug = &snapshotv1.VolumeHotplug{
+							Origin: snapshotv1.HotplugVolumeOriginPersistentVolumeClaim,
+							Bus:    v1.DiskBusSCSI,
+						}
+					}
+				}
+
+				vmSource.Add(vm)
+				storageClass := createStorageClass()
+				storageClassSource.Add(storageClass)
+				volumeSnapshotClass := createVolumeSnapshotClasses()[0]
+				createCalls := expectVMSnapshotContentCreate(vmSnapshotClient, expectedContent)
+				vmSnapshotSource.Add(vmSnapshot)
+				addVolumeSnapshotClass(volumeSnapshotClass)
+
+				updatedSnapshot := vmSnapshot.DeepCopy()
+				updatedSnapshot.ResourceVersion = "1"
+				updatedSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+					SourceUID:  &vmUID,
+					ReadyToUse: pointer.P(false),
+					Phase:      snapshotv1.InProgress,
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Source locked and operation in progress"),
+						newReadyCondition(corev1.ConditionFalse, "Not ready"),
+					},
+					Indications: []snapshotv1.Indication{
+						snapshotv1.VMSnapshotNoGuestAgentIndication,
+						snapshotv1.VMSnapshotOnlineSnapshotIndication,
+					},
+				}
+				updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, updatedSnapshot)
+
+				controller.processVMSnapshotWorkItem()
+				testutils.ExpectEvent(recorder, "SuccessfulVirtualMachineSnapshotContentCreate")
+				Expect(*updateStatusCalls).To(Equal(1))
+				Expect(*createCalls).To(Equal(1))
+			})
+
+			It("should record persisted hotplugged volumes in VirtualMachineSnapshotContent", func() {
+				vmSnapshot := createVMSnapshotInProgress()
+				vm := createLockedVM()
+				vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, v1.Volume{
+					Name: "disk2",
+					VolumeSource: v1.VolumeSource{
+						DataVolume: &v1.DataVolumeSource{
+							Name:         "test-pvc",
+							Hotpluggable: true,
+						},
+					},
+				})
+				vm.Spec.Template.Spec.Domain.Devices.Disks = append(vm.Spec.Template.Spec.Domain.Devices.Disks, v1.Disk{
+					Name: "disk2",
+					DiskDevice: v1.DiskDevice{
+						Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI},
+					},
+				})
+				storageClass := createStorageClass()
+				volumeSnapshotClass := createVolumeSnapshotClasses()[0]
+				expectedContent := createVirtualMachineSnapshotContent(vmSnapshot, vm, createPersistentVolumeClaims())
+				for i := range expectedContent.Spec.VolumeBackups {
+					if expectedContent.Spec.VolumeBackups[i].VolumeName == "disk2" {
+						expectedContent.Spec.VolumeBackups[i].Hotplug = &snapshotv1.VolumeHotplug{
+							Origin:    snapshotv1.HotplugVolumeOriginDataVolume,
+							Bus:       v1.DiskBusSCSI,
+							Persisted: true,
+						}
+					}
+				}
+
+				vmSource.Add(vm)
+				storageClassSource.Add(storageClass)
+				createCalls := expectVMSnapshotContentCreate(vmSnapshotClient, expectedContent)
+				vmSnapshotSource.Add(vmSnapshot)
+				addVolumeSnapshotClass(volumeSnapshotClass)
+
+				updatedSnapshot := vmSnapshot.DeepCopy()
+				updatedSnapshot.ResourceVersion = "1"
+				updatedSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+					SourceUID:  &vmUID,
+					ReadyToUse: pointer.P(false),
+					Phase:      snapshotv1.InProgress,
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Source locked and operation in progress"),
+						newReadyCondition(corev1.ConditionFalse, "Not ready"),
+					},
+				}
+				updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, updatedSnapshot)
+
+				controller.processVMSnapshotWorkItem()
+				testutils.ExpectEvent(recorder, "SuccessfulVirtualMachineSnapshotContentCreate")
+				Expect(*updateStatusCalls).To(Equal(1))
+				Expect(*createCalls).To(Equal(1))
+			})
+
 			It("should create VirtualMachineSnapshotContent with memory dump", func() {
 				storageClass := createStorageClass()
 				volumeSnapshotClass := createVolumeSnapshotClasses()[0]
@@ -1124,6 +1503,7 @@ var _ = Describe("Snapshot controlleer", func() {
 				updatedSnapshot.Status.SnapshotVolumes = &snapshotv1.SnapshotVolumesLists{
 					IncludedVolumes: []string{diskName},
 				}
+				updatedSnapshot.Status.Progress = createVMSnapshotProgress(100, snapshotv1.VolumeSnapshotPhaseReady)
 
 				vm := createLockedVM()
 
@@ -1167,6 +1547,7 @@ var _ = Describe("Snapshot controlleer", func() {
 					IncludedVolumes: []string{diskName},
 					ExcludedVolumes: []string{"disk2"},
 				}
+				updatedSnapshot.Status.Progress = createVMSnapshotProgress(100, snapshotv1.VolumeSnapshotPhaseReady)
 
 				vmSource.Add(vm)
 				vmSnapshotContentSource.Add(vmSnapshotContent)
@@ -1176,6 +1557,83 @@ var _ = Describe("Snapshot controlleer", func() {
 				Expect(*updateStatusCalls).To(Equal(1))
 			})
 
+			DescribeTable("should report the progress of the volume snapshots", func(vss *snapshotv1.VolumeSnapshotStatus, age time.Duration, expectedPercentage int32, expectedPhase snapshotv1.VolumeSnapshotProgressPhase, expectedRetry time.Duration) {
+				content := createVMSnapshotContent()
+				content.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+					ReadyToUse: pointer.P(false),
+				}
+				if vss != nil {
+					vss.VolumeSnapshotName = *content.Spec.VolumeBackups[0].VolumeSnapshotName
+					content.Status.VolumeSnapshotStatus = []snapshotv1.VolumeSnapshotStatus{*vss}
+
+					vmSnapshotContentSource.Add(content)
+					volumeSnapshots := createVolumeSnapshots(content)
+					volumeSnapshots[0].CreationTimestamp = metav1.NewTime(timeStamp.Add(-age))
+					addVolumeSnapshot(&volumeSnapshots[0])
+				}
+
+				progress, retry, err := controller.volumeSnapshotProgress(content)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(progress.Percentage).To(Equal(expectedPercentage))
+				Expect(progress.Volumes).To(HaveLen(1))
+				Expect(progress.Volumes[0].VolumeName).To(Equal(diskName))
+				Expect(progress.Volumes[0].Phase).To(Equal(expectedPhase))
+				Expect(retry).To(Equal(expectedRetry))
+			},
+				Entry("pending without a VolumeSnapshot", nil, time.Duration(0), int32(0), snapshotv1.VolumeSnapshotPhasePending, time.Duration(0)),
+				Entry("in progress until the stall timeout",
+					&snapshotv1.VolumeSnapshotStatus{ReadyToUse: pointer.P(false)}, time.Minute,
+					int32(0), snapshotv1.VolumeSnapshotPhaseInProgress, volumeSnapshotStallTimeout-time.Minute),
+				Entry("stalled after the stall timeout",
+					&snapshotv1.VolumeSnapshotStatus{ReadyToUse: pointer.P(false)}, volumeSnapshotStallTimeout+time.Minute,
+					int32(0), snapshotv1.VolumeSnapshotPhaseStalled, time.Duration(0)),
+				Entry("ready",
+					&snapshotv1.VolumeSnapshotStatus{ReadyToUse: pointer.P(true)}, volumeSnapshotStallTimeout+time.Minute,
+					int32(100), snapshotv1.VolumeSnapshotPhaseReady, time.Duration(0)),
+				Entry("failed",
+					&snapshotv1.VolumeSnapshotStatus{ReadyToUse: pointer.P(false), Error: &snapshotv1.Error{Message: pointer.P("error")}}, time.Minute,
+					int32(0), snapshotv1.VolumeSnapshotPhaseFailed, time.Duration(0)),
+			)
+
+			It("should emit an event when a volume snapshot stalls", func() {
+				vm := createLockedVM()
+				vmSource.Add(vm)
+
+				vmSnapshotContent := createVMSnapshotContent()
+				vmSnapshotContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+					ReadyToUse: pointer.P(false),
+					VolumeSnapshotStatus: []snapshotv1.VolumeSnapshotStatus{
+						{
+							VolumeSnapshotName: *vmSnapshotContent.Spec.VolumeBackups[0].VolumeSnapshotName,
+							ReadyToUse:         pointer.P(false),
+						},
+					},
+				}
+				volumeSnapshots := createVolumeSnapshots(vmSnapshotContent)
+				volumeSnapshots[0].CreationTimestamp = metav1.NewTime(timeStamp.Add(-volumeSnapshotStallTimeout - time.Minute))
+				vmSnapshotContentSource.Add(vmSnapshotContent)
+				addVolumeSnapshot(&volumeSnapshots[0])
+
+				vmSnapshot := createVMSnapshotInProgress()
+				addVirtualMachineSnapshot(vmSnapshot)
+
+				updatedSnapshot := vmSnapshot.DeepCopy()
+				updatedSnapshot.ResourceVersion = "1"
+				updatedSnapshot.Status.VirtualMachineSnapshotContentName = &vmSnapshotContent.Name
+				updatedSnapshot.Status.Indications = nil
+				updatedSnapshot.Status.Conditions = []snapshotv1.Condition{
+					newProgressingCondition(corev1.ConditionTrue, "Source locked and operation in progress"),
+					newReadyCondition(corev1.ConditionFalse, "Not ready"),
+				}
+				updatedSnapshot.Status.Progress = createVMSnapshotProgress(0, snapshotv1.VolumeSnapshotPhaseStalled)
+
+				updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, updatedSnapshot)
+
+				controller.processVMSnapshotWorkItem()
+				Expect(*updateStatusCalls).To(Equal(1))
+				testutils.ExpectEvent(recorder, volumeSnapshotStalledEvent)
+			})
+
 			It("should update VirtualMachineSnapshot error when VirtualMachineSnapshotContent error", func() {
 				vmSnapshot := createVMSnapshotInProgress()
 				vm := createLockedVM()
@@ -1195,6 +1653,7 @@ var _ = Describe("Snapshot controlleer", func() {
 					newReadyCondition(corev1.ConditionFalse, "Not ready"),
 				}
 				updatedSnapshot.Status.Error = vmSnapshotContent.Status.Error
+				updatedSnapshot.Status.Progress = createVMSnapshotProgress(0, snapshotv1.VolumeSnapshotPhasePending)
 
 				updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, updatedSnapshot)
 
@@ -1484,6 +1943,62 @@ var _ = Describe("Snapshot controlleer", func() {
 				Expect(*updateStatusCalls).To(Equal(1))
 			})
 
+			It("should set hook results in content if a pre-freeze hook failed", func() {
+				storageClass := createStorageClass()
+				storageClassSource.Add(storageClass)
+
+				vmSnapshot := createVMSnapshotInProgress()
+				vmSnapshotSource.Add(vmSnapshot)
+
+				vmSnapshotContent := createVMSnapshotContent()
+				vmSnapshotContent.UID = contentUID
+				vmSnapshotContentSource.Add(vmSnapshotContent)
+
+				vm := createLockedVM()
+				vmSource.Add(vm)
+
+				vmi := createVMI(vm)
+				vmi.Annotations = map[string]string{
+					snapshotv1.FreezeHooksAnnotation: `[
+						{"name": "flush", "type": "PreFreeze", "command": ["/usr/bin/flush"]},
+						{"name": "lock", "type": "PreFreeze", "command": ["/usr/bin/lock"]},
+						{"name": "unlock", "type": "PostThaw", "command": ["/usr/bin/unlock"]}
+					]`,
+				}
+				agentCondition := v1.VirtualMachineInstanceCondition{
+					Type:          v1.VirtualMachineInstanceAgentConnected,
+					LastProbeTime: metav1.Now(),
+					Status:        corev1.ConditionTrue,
+				}
+				vmi.Status.Conditions = append(vmi.Status.Conditions, agentCondition)
+				vmiSource.Add(vmi)
+
+				updatedContent := vmSnapshotContent.DeepCopy()
+				updatedContent.ResourceVersion = "1"
+				errorMessage := `PreFreeze hook "lock" failed: exited with error code:1`
+				formatedErr := fmt.Sprintf("%s %s: %v", failedFreezeMsg, vm.Name, errorMessage)
+				updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+					ReadyToUse: pointer.P(false),
+					Error: &snapshotv1.Error{
+						Time:    timeFunc(),
+						Message: &formatedErr,
+					},
+					HookResults: []snapshotv1.FreezeHookResult{
+						{Name: "flush", Type: snapshotv1.PreFreezeHook, Succeeded: true},
+						{Name: "lock", Type: snapshotv1.PreFreezeHook, Succeeded: false, Message: pointer.P("exited with error code:1")},
+					},
+				}
+
+				volumeSnapshotClass := createVolumeSnapshotClasses()[0]
+				addVolumeSnapshotClass(volumeSnapshotClass)
+
+				vmiInterface.EXPECT().Freeze(context.Background(), vm.Name, 0*time.Second).Return(fmt.Errorf(errorMessage)).Times(1)
+				updateStatusCalls := expectVMSnapshotContentUpdateStatus(vmSnapshotClient, updatedContent)
+
+				controller.processVMSnapshotContentWorkItem()
+				Expect(*updateStatusCalls).To(Equal(1))
+			})
+
 			It("should set QuiesceFailed indication if error in content says failed freeze vm", func() {
 				vm := createLockedVM()
 				vmSource.Add(vm)
@@ -1517,6 +2032,7 @@ var _ = Describe("Snapshot controlleer", func() {
 					newReadyCondition(corev1.ConditionFalse, "Not ready"),
 				}
 				updatedSnapshot.Status.Error = vmSnapshotContent.Status.Error
+				updatedSnapshot.Status.Progress = createVMSnapshotProgress(0, snapshotv1.VolumeSnapshotPhasePending)
 
 				updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, updatedSnapshot)
 
@@ -1636,6 +2152,260 @@ var _ = Describe("Snapshot controlleer", func() {
 				Entry("ready", true),
 			)
 
+			Context("with the memory included", func() {
+				var vmi *v1.VirtualMachineInstance
+				var memoryPVC *corev1.PersistentVolumeClaim
+
+				createMemoryVMSnapshotContent := func() *snapshotv1.VirtualMachineSnapshotContent {
+					content := createVMSnapshotContent()
+					content.UID = contentUID
+					content.Spec.MemoryVolumeBackup = &snapshotv1.VolumeBackup{
+						VolumeName: memoryVolumeName,
+						PersistentVolumeClaim: snapshotv1.PersistentVolumeClaim{
+							ObjectMeta: memoryPVC.ObjectMeta,
+							Spec:       memoryPVC.Spec,
+						},
+						VolumeSnapshotName: pointer.P(memoryPVC.Name),
+					}
+					return content
+				}
+
+				BeforeEach(func() {
+					virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+					vm := createLockedVM()
+					vm.Spec.Template.Spec.Domain.Resources.Requests = corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("32Mi"),
+					}
+					vmi = createVMI(vm)
+
+					size, err := storagetypes.GetSizeIncludingDefaultFSOverhead(util.CalcExpectedMemoryDumpSize(vmi))
+					Expect(err).ToNot(HaveOccurred())
+					memoryPVC = &corev1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "vmsnapshot-" + vmSnapshotUID + "-memory",
+							Namespace: testNamespace,
+						},
+						Spec: corev1.PersistentVolumeClaimSpec{
+							AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+							StorageClassName: pointer.P(storageClassName),
+							VolumeMode:       pointer.P(corev1.PersistentVolumeFilesystem),
+							Resources: corev1.VolumeResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceStorage: *size,
+								},
+							},
+						},
+					}
+				})
+
+				It("should create the memory PVC with VirtualMachineSnapshotContent", func() {
+					vmSnapshot := createVMSnapshotInProgress()
+					vmSnapshot.Spec.IncludeMemory = pointer.P(true)
+					vm := createLockedVM()
+					vm.Spec.RunStrategy = pointer.P(v1.RunStrategyAlways)
+					vm.Spec.Template.Spec.Domain.Resources.Requests = vmi.Spec.Domain.Resources.Requests
+					vmRevision := createVMRevision(vm)
+					crSource.Add(vmRevision)
+					vmi.Status.VirtualMachineRevisionName = vmRevisionName
+					vmiSource.Add(vmi)
+					// the content source will have the vm revision
+					vm.ObjectMeta.Annotations = map[string]string{}
+					vm.ObjectMeta.Generation = 2
+					vmSource.Add(vm)
+					storageClassSource.Add(createStorageClass())
+
+					expectedContent := createMemoryVMSnapshotContent()
+					expectedContent.UID = ""
+					expectedContent.Spec.Source = createVirtualMachineSnapshotContent(vmSnapshot, vm, createPersistentVolumeClaims()).Spec.Source
+					createCalls := expectVMSnapshotContentCreate(vmSnapshotClient, expectedContent)
+
+					pvcCreates := 0
+					k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						pvc := action.(testing.CreateAction).GetObject().(*corev1.PersistentVolumeClaim)
+						Expect(pvc.Name).To(Equal(memoryPVC.Name))
+						Expect(pvc.Spec).To(Equal(memoryPVC.Spec))
+						Expect(pvc.Spec.Resources.Requests.Storage().Cmp(resource.MustParse("140Mi"))).To(BeZero())
+						Expect(pvc.OwnerReferences).To(HaveLen(1))
+						Expect(pvc.OwnerReferences[0].Name).To(Equal(vmSnapshot.Name))
+						pvcCreates++
+						return true, pvc, nil
+					})
+
+					updatedSnapshot := vmSnapshot.DeepCopy()
+					updatedSnapshot.ResourceVersion = "1"
+					updatedSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+						SourceUID:  &vmUID,
+						ReadyToUse: pointer.P(false),
+						Phase:      snapshotv1.InProgress,
+						Conditions: []snapshotv1.Condition{
+							newProgressingCondition(corev1.ConditionTrue, "Source locked and operation in progress"),
+							newReadyCondition(corev1.ConditionFalse, "Not ready"),
+						},
+						Indications: []snapshotv1.Indication{
+							snapshotv1.VMSnapshotMemoryIndication,
+							snapshotv1.VMSnapshotNoGuestAgentIndication,
+							snapshotv1.VMSnapshotOnlineSnapshotIndication,
+						},
+					}
+					updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, updatedSnapshot)
+
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(createVolumeSnapshotClasses()[0])
+
+					controller.processVMSnapshotWorkItem()
+					testutils.ExpectEvent(recorder, "SuccessfulVirtualMachineSnapshotContentCreate")
+					Expect(pvcCreates).To(Equal(1))
+					Expect(*createCalls).To(Equal(1))
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should request a memory dump after creating the VolumeSnapshots", func() {
+					vm := createLockedVM()
+					vmSnapshot := createVMSnapshotInProgress()
+					vmSnapshot.Spec.IncludeMemory = pointer.P(true)
+					volumeSnapshotClass := createVolumeSnapshotClasses()[0]
+					vmSnapshotContent := createMemoryVMSnapshotContent()
+
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: pointer.P(false),
+					}
+					for _, vb := range vmSnapshotContent.Spec.VolumeBackups {
+						updatedContent.Status.VolumeSnapshotStatus = append(updatedContent.Status.VolumeSnapshotStatus, snapshotv1.VolumeSnapshotStatus{
+							VolumeSnapshotName: *vb.VolumeSnapshotName,
+						})
+					}
+
+					vmSource.Add(vm)
+					storageClassSource.Add(createStorageClass())
+
+					vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any(), metav1.UpdateOptions{}).DoAndReturn(
+						func(_ context.Context, vm *v1.VirtualMachine, _ metav1.UpdateOptions) (*v1.VirtualMachine, error) {
+							Expect(vm.Status.MemoryDumpRequest).To(Equal(&v1.VirtualMachineMemoryDumpRequest{
+								ClaimName: memoryPVC.Name,
+								Phase:     v1.MemoryDumpAssociating,
+								Format:    v1.MemoryDumpFormatState,
+							}))
+							return vm, nil
+						})
+					snapshotCreates := expectVolumeSnapshotCreates(k8sSnapshotClient, volumeSnapshotClass.Name, vmSnapshotContent)
+					updateStatusCalls := expectVMSnapshotContentUpdateStatus(vmSnapshotClient, updatedContent)
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(volumeSnapshotClass)
+					controller.processVMSnapshotContentWorkItem()
+					testutils.ExpectEvent(recorder, "SuccessfulVolumeSnapshotCreate")
+					testutils.ExpectEvent(recorder, "MemoryDumpRequested")
+					Expect(*updateStatusCalls).To(Equal(1))
+					Expect(*snapshotCreates).To(Equal(1))
+				})
+
+				It("should create the memory VolumeSnapshot once the memory is dumped", func() {
+					vm := createLockedVM()
+					vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: memoryPVC.Name,
+						Phase:     v1.MemoryDumpCompleted,
+						Format:    v1.MemoryDumpFormatState,
+					}
+					vmSnapshot := createVMSnapshotInProgress()
+					vmSnapshot.Spec.IncludeMemory = pointer.P(true)
+					volumeSnapshotClass := createVolumeSnapshotClasses()[0]
+					vmSnapshotContent := createMemoryVMSnapshotContent()
+
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: pointer.P(false),
+					}
+
+					volumeSnapshots := createVolumeSnapshots(vmSnapshotContent)
+					for i := range volumeSnapshots {
+						volumeSnapshots[i].Status.CreationTime = timeFunc()
+						volumeSnapshotSource.Add(&volumeSnapshots[i])
+						updatedContent.Status.VolumeSnapshotStatus = append(updatedContent.Status.VolumeSnapshotStatus, snapshotv1.VolumeSnapshotStatus{
+							VolumeSnapshotName: volumeSnapshots[i].Name,
+							CreationTime:       timeFunc(),
+							ReadyToUse:         pointer.P(false),
+						})
+					}
+
+					vmSource.Add(vm)
+					storageClassSource.Add(createStorageClass())
+
+					memoryContent := vmSnapshotContent.DeepCopy()
+					memoryContent.Spec.VolumeBackups = nil
+					snapshotCreates := expectVolumeSnapshotCreates(k8sSnapshotClient, volumeSnapshotClass.Name, memoryContent)
+					updateStatusCalls := expectVMSnapshotContentUpdateStatus(vmSnapshotClient, updatedContent)
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(volumeSnapshotClass)
+					controller.processVMSnapshotContentWorkItem()
+					testutils.ExpectEvent(recorder, "SuccessfulVolumeSnapshotCreate")
+					Expect(*updateStatusCalls).To(Equal(1))
+					Expect(*snapshotCreates).To(Equal(1))
+				})
+
+				It("should release the memory PVC once VirtualMachineSnapshotContent is ready", func() {
+					vm := createLockedVM()
+					vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: memoryPVC.Name,
+						Phase:     v1.MemoryDumpCompleted,
+						Format:    v1.MemoryDumpFormatState,
+					}
+					vmSnapshot := createVMSnapshotInProgress()
+					vmSnapshot.Spec.IncludeMemory = pointer.P(true)
+					vmSnapshotContent := createMemoryVMSnapshotContent()
+
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse:   pointer.P(true),
+						CreationTime: timeFunc(),
+					}
+
+					memoryContent := vmSnapshotContent.DeepCopy()
+					memoryContent.Spec.VolumeBackups = append(memoryContent.Spec.VolumeBackups, *memoryContent.Spec.MemoryVolumeBackup)
+					volumeSnapshots := createVolumeSnapshots(memoryContent)
+					for i := range volumeSnapshots {
+						volumeSnapshots[i].Status.CreationTime = timeFunc()
+						volumeSnapshots[i].Status.ReadyToUse = pointer.P(true)
+						volumeSnapshotSource.Add(&volumeSnapshots[i])
+						if volumeSnapshots[i].Name == memoryPVC.Name {
+							continue
+						}
+						updatedContent.Status.VolumeSnapshotStatus = append(updatedContent.Status.VolumeSnapshotStatus, snapshotv1.VolumeSnapshotStatus{
+							VolumeSnapshotName: volumeSnapshots[i].Name,
+							CreationTime:       timeFunc(),
+							ReadyToUse:         pointer.P(true),
+						})
+					}
+
+					vmSource.Add(vm)
+					pvcSource.Add(memoryPVC)
+
+					vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any(), metav1.UpdateOptions{}).DoAndReturn(
+						func(_ context.Context, vm *v1.VirtualMachine, _ metav1.UpdateOptions) (*v1.VirtualMachine, error) {
+							Expect(vm.Status.MemoryDumpRequest.Phase).To(Equal(v1.MemoryDumpDissociating))
+							Expect(vm.Status.MemoryDumpRequest.Remove).To(BeTrue())
+							return vm, nil
+						})
+					pvcDeletes := 0
+					k8sClient.Fake.PrependReactor("delete", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						Expect(action.(testing.DeleteAction).GetName()).To(Equal(memoryPVC.Name))
+						pvcDeletes++
+						return true, nil, nil
+					})
+					updateStatusCalls := expectVMSnapshotContentUpdateStatus(vmSnapshotClient, updatedContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVirtualMachineSnapshotContent(vmSnapshotContent)
+					controller.processVMSnapshotContentWorkItem()
+					Expect(pvcDeletes).To(Equal(1))
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+			})
+
 			It("should update VirtualMachineSnapshotContent no snapshots", func() {
 				vmSnapshot := createVMSnapshotInProgress()
 				vmSnapshotContent := createVMSnapshotContent()
@@ -2475,6 +3245,27 @@ var _ = Describe("Snapshot controlleer", func() {
 			)
 		})
 	})
+	DescribeTable("should indicate the persistent state", func(persistent, captured bool, expected []snapshotv1.Indication) {
+		vmSnapshot := createVMSnapshotInProgress()
+		content := createVMSnapshotContent()
+		vm := content.Spec.Source.VirtualMachine
+		vm.Spec.Template.Spec.Domain.Devices.TPM = &v1.TPMDevice{
+			Persistent: pointer.P(persistent),
+		}
+		if captured {
+			content.Spec.VolumeBackups = append(content.Spec.VolumeBackups, snapshotv1.VolumeBackup{
+				VolumeName:         storageutils.BackendPVCVolumeName(vm.Name),
+				VolumeSnapshotName: pointer.P("vmsnapshot-persistent-state"),
+			})
+		}
+
+		updatePersistentStateIndication(vmSnapshot, content)
+		Expect(vmSnapshot.Status.Indications).To(Equal(expected))
+	},
+		Entry("when it is captured", true, true, []snapshotv1.Indication{snapshotv1.VMSnapshotPersistentStateIndication}),
+		Entry("not when it is not captured", true, false, nil),
+		Entry("not when the VM has none", false, false, nil),
+	)
 })
 
 func applyPatch(patch []byte, orig, patched interface{}) error {
@@ -2610,6 +3401,21 @@ func expectVMSnapshotContentDelete(client *kubevirtfake.Clientset, name string)
 	return &calls
 }
 
+func expectVMSnapshotDelete(client *kubevirtfake.Clientset, name string) *int {
+	calls := 0
+	client.Fake.PrependReactor("delete", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+		delete, ok := action.(testing.DeleteAction)
+		Expect(ok).To(BeTrue())
+
+		Expect(delete.GetName()).To(Equal(name))
+
+		calls++
+
+		return true, nil, nil
+	})
+	return &calls
+}
+
 func expectVolumeSnapshotCreates(
 	client *k8ssnapshotfake.Clientset,
 	voluemSnapshotClass string,
@@ -2622,6 +3428,9 @@ func expectVolumeSnapshotCreates(
 			volumeSnapshots[*volumeBackup.VolumeSnapshotName] = volumeBackup.PersistentVolumeClaim.Name
 		}
 	}
+	if memory := content.Spec.MemoryVolumeBackup; memory != nil && memory.VolumeSnapshotName != nil {
+		volumeSnapshots[*memory.VolumeSnapshotName] = memory.PersistentVolumeClaim.Name
+	}
 	client.Fake.PrependReactor("create", "volumesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
 		create, ok := action.(testing.CreateAction)
 		Expect(ok).To(BeTrue())
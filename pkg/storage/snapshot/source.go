@@ -43,6 +43,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/storage/freezehooks"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
 	utils "kubevirt.io/kubevirt/pkg/util"
@@ -73,16 +74,19 @@ type snapshotSource interface {
 	Frozen() bool
 	Freeze() error
 	Unfreeze() error
+	FreezeHooks() []snapshotv1.FreezeHook
 	Spec() (snapshotv1.SourceSpec, error)
 	PersistentVolumeClaims() (map[string]string, error)
+	HotplugVolumes() (map[string]snapshotv1.VolumeHotplug, error)
 }
 
 type sourceState struct {
-	online     bool
-	guestAgent bool
-	frozen     bool
-	locked     bool
-	lockMsg    string
+	online      bool
+	guestAgent  bool
+	frozen      bool
+	locked      bool
+	lockMsg     string
+	freezeHooks []snapshotv1.FreezeHook
 }
 
 type vmSnapshotSource struct {
@@ -112,12 +116,22 @@ func (s *vmSnapshotSource) UpdateSourceState() error {
 	}
 	frozen := exists && vmi.Status.FSFreezeStatus == launcherapi.FSFrozen
 
+	// Hooks are only run by virt-launcher when freezing through the guest agent
+	var hooks []snapshotv1.FreezeHook
+	if guestAgent {
+		hooks, err = freezehooks.FromVMI(vmi)
+		if err != nil {
+			log.Log.Warningf("Ignoring freeze hooks of vmi %s/%s: %v", vmi.Namespace, vmi.Name, err)
+		}
+	}
+
 	s.state = &sourceState{
-		online:     online,
-		guestAgent: guestAgent,
-		locked:     locked,
-		frozen:     frozen,
-		lockMsg:    lockMsg,
+		online:      online,
+		guestAgent:  guestAgent,
+		locked:      locked,
+		frozen:      frozen,
+		lockMsg:     lockMsg,
+		freezeHooks: hooks,
 	}
 
 	return nil
@@ -393,6 +407,17 @@ func (s *vmSnapshotSource) Spec() (snapshotv1.SourceSpec, error) {
 		vmCpy.Spec.Template.Spec.Volumes = s.vm.Spec.Template.Spec.Volumes
 		vmCpy.Spec.Template.Spec.Domain.Devices.Disks = s.vm.Spec.Template.Spec.Domain.Devices.Disks
 		vmCpy.Spec.DataVolumeTemplates = s.vm.Spec.DataVolumeTemplates
+
+		// Volumes only hotplugged to the VMI are captured as well, so that a restore
+		// attaches them again instead of dropping them
+		hotplugVolumes, hotplugDisks, err := s.vmiHotplugVolumes()
+		if err != nil {
+			return snapshotv1.SourceSpec{}, err
+		}
+		if len(hotplugVolumes) > 0 {
+			vmCpy.Spec.Template.Spec.Volumes = append(slices.Clone(vmCpy.Spec.Template.Spec.Volumes), hotplugVolumes...)
+			vmCpy.Spec.Template.Spec.Domain.Devices.Disks = append(slices.Clone(vmCpy.Spec.Template.Spec.Domain.Devices.Disks), hotplugDisks...)
+		}
 	} else {
 		vmCpy.ObjectMeta = metaObj
 		vmCpy.Spec = *s.vm.Spec.DeepCopy()
@@ -420,6 +445,10 @@ func (s *vmSnapshotSource) Frozen() bool {
 	return s.state.frozen
 }
 
+func (s *vmSnapshotSource) FreezeHooks() []snapshotv1.FreezeHook {
+	return s.state.freezeHooks
+}
+
 func (s *vmSnapshotSource) Freeze() error {
 	if !s.Locked() {
 		return fmt.Errorf("attempting to freeze unlocked VM")
@@ -472,7 +501,87 @@ func (s *vmSnapshotSource) PersistentVolumeClaims() (map[string]string, error) {
 	if err != nil {
 		return map[string]string{}, err
 	}
-	return storagetypes.GetPVCsFromVolumes(volumes), nil
+	hotplugVolumes, _, err := s.vmiHotplugVolumes()
+	if err != nil {
+		return map[string]string{}, err
+	}
+	return storagetypes.GetPVCsFromVolumes(append(volumes, hotplugVolumes...)), nil
+}
+
+// HotplugVolumes returns how the hotplugged volumes of the source are attached, by volume name
+func (s *vmSnapshotSource) HotplugVolumes() (map[string]snapshotv1.VolumeHotplug, error) {
+	hotplugs := map[string]snapshotv1.VolumeHotplug{}
+	vmSpec := s.vm.Spec.Template.Spec
+	addVolumeHotplugs(hotplugs, vmSpec.Volumes, vmSpec.Domain.Devices.Disks, true)
+
+	hotplugVolumes, hotplugDisks, err := s.vmiHotplugVolumes()
+	if err != nil {
+		return nil, err
+	}
+	addVolumeHotplugs(hotplugs, hotplugVolumes, hotplugDisks, false)
+
+	return hotplugs, nil
+}
+
+// vmiHotplugVolumes returns the volumes, and their disks, which are hotplugged to
+// the running VMI without being part of the VM spec
+func (s *vmSnapshotSource) vmiHotplugVolumes() ([]kubevirtv1.Volume, []kubevirtv1.Disk, error) {
+	if !s.Online() {
+		return nil, nil, nil
+	}
+
+	vmi, exists, err := s.controller.getVMI(s.vm)
+	if err != nil || !exists {
+		return nil, nil, err
+	}
+
+	vmVolumes := sets.NewString()
+	for _, volume := range s.vm.Spec.Template.Spec.Volumes {
+		vmVolumes.Insert(volume.Name)
+	}
+
+	var volumes []kubevirtv1.Volume
+	var disks []kubevirtv1.Disk
+	for _, volume := range vmi.Spec.Volumes {
+		if vmVolumes.Has(volume.Name) || !storagetypes.IsDeclarativeHotplugVolume(&volume) {
+			continue
+		}
+		volumes = append(volumes, *volume.DeepCopy())
+		for _, disk := range vmi.Spec.Domain.Devices.Disks {
+			if disk.Name == volume.Name {
+				disks = append(disks, *disk.DeepCopy())
+			}
+		}
+	}
+
+	return volumes, disks, nil
+}
+
+func addVolumeHotplugs(hotplugs map[string]snapshotv1.VolumeHotplug, volumes []kubevirtv1.Volume, disks []kubevirtv1.Disk, persisted bool) {
+	buses := map[string]kubevirtv1.DiskBus{}
+	for _, disk := range disks {
+		switch {
+		case disk.Disk != nil:
+			buses[disk.Name] = disk.Disk.Bus
+		case disk.LUN != nil:
+			buses[disk.Name] = disk.LUN.Bus
+		}
+	}
+
+	for _, volume := range volumes {
+		if !storagetypes.IsDeclarativeHotplugVolume(&volume) {
+			continue
+		}
+		origin := snapshotv1.HotplugVolumeOriginPersistentVolumeClaim
+		if volume.DataVolume != nil {
+			origin = snapshotv1.HotplugVolumeOriginDataVolume
+		}
+		hotplugs[volume.Name] = snapshotv1.VolumeHotplug{
+			Origin:    origin,
+			Bus:       buses[volume.Name],
+			Persisted: persisted,
+		}
+	}
 }
 
 func (s *vmSnapshotSource) pvcNames() (sets.String, error) {
@@ -0,0 +1,62 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vhostuserblk
+
+import (
+	"path/filepath"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// NodeSocketDir is the directory of the node in which vhost-user-blk targets expose their sockets
+	NodeSocketDir = "/var/run/vhost-user-blk"
+	socketDir     = "/var/run/kubevirt-private/vhost-user-blk"
+)
+
+// GetSocketPath returns the path at which the socket of the volume is mounted in the compute container
+func GetSocketPath(volumeName string) string {
+	return filepath.Join(socketDir, volumeName+".sock")
+}
+
+// GetNodeSocketPath returns the path of the socket on the node
+func GetNodeSocketPath(socketName string) string {
+	return filepath.Join(NodeSocketDir, socketName)
+}
+
+func HasVhostUserBlkVolumes(vmiSpec *v1.VirtualMachineInstanceSpec) bool {
+	for _, volume := range vmiSpec.Volumes {
+		if volume.VhostUserBlk != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// GetResourceNames returns the device plugin resources requested by the vhost-user-blk volumes, in the order of the volumes
+func GetResourceNames(vmiSpec *v1.VirtualMachineInstanceSpec) []string {
+	var names []string
+	for _, volume := range vmiSpec.Volumes {
+		if volume.VhostUserBlk != nil && volume.VhostUserBlk.ResourceName != "" {
+			names = append(names, volume.VhostUserBlk.ResourceName)
+		}
+	}
+	return names
+}
@@ -0,0 +1,536 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package volumemigration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	virtv1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	// VolumeMigrationAnnotation is set on the destination PersistentVolumeClaims to the name of the
+	// VolumeMigration which created them
+	VolumeMigrationAnnotation = "migrations.kubevirt.io/volumemigration"
+
+	readyConditionType = "Ready"
+
+	inProgressReason = "InProgress"
+	succeededReason  = "Succeeded"
+	failedReason     = "Failed"
+
+	claimCreatedEvent             = "PersistentVolumeClaimCreated"
+	volumesUpdatedEvent           = "VolumesUpdated"
+	volumeMigrationSucceededEvent = "VolumeMigrationSucceeded"
+	volumeMigrationFailedEvent    = "VolumeMigrationFailed"
+)
+
+var currentTime = func() *metav1.Time {
+	t := metav1.Now()
+	return &t
+}
+
+// VolumeMigrationController moves the volumes of running virtual machines to another storage class.
+// It provisions the destination PersistentVolumeClaims and points the virtual machine to them with the
+// Migration update volumes strategy, the volumes are then copied by a live migration of the VMI.
+type VolumeMigrationController struct {
+	Client kubecli.KubevirtClient
+
+	VolumeMigrationInformer cache.SharedIndexInformer
+	VMInformer              cache.SharedIndexInformer
+	VMIInformer             cache.SharedIndexInformer
+	PVCInformer             cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	volumeMigrationQueue workqueue.TypedRateLimitingInterface[string]
+}
+
+// Init initializes the volume migration controller
+func (ctrl *VolumeMigrationController) Init() error {
+	ctrl.volumeMigrationQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-volumemigration"},
+	)
+
+	_, err := ctrl.VolumeMigrationInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVolumeMigration,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVolumeMigration(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+	for _, informer := range []cache.SharedIndexInformer{ctrl.VMInformer, ctrl.VMIInformer} {
+		_, err = informer.AddEventHandler(
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    ctrl.handleVirtualMachineObject,
+				UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVirtualMachineObject(newObj) },
+				DeleteFunc: ctrl.handleVirtualMachineObject,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run the controller
+func (ctrl *VolumeMigrationController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.volumeMigrationQueue.ShutDown()
+
+	log.Log.Info("Starting volume migration controller.")
+	defer log.Log.Info("Shutting down volume migration controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VolumeMigrationInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+		ctrl.VMIInformer.HasSynced,
+		ctrl.PVCInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.volumeMigrationWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VolumeMigrationController) volumeMigrationWorker() {
+	for ctrl.processVolumeMigrationWorkItem() {
+	}
+}
+
+func (ctrl *VolumeMigrationController) processVolumeMigrationWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.volumeMigrationQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("volumeMigration worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VolumeMigrationInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		volumeMigration, ok := storeObj.(*migrationsv1.VolumeMigration)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+
+		return 0, ctrl.updateVolumeMigration(volumeMigration.DeepCopy())
+	})
+}
+
+func (ctrl *VolumeMigrationController) handleVolumeMigration(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if volumeMigration, ok := obj.(*migrationsv1.VolumeMigration); ok {
+		key, err := cache.MetaNamespaceKeyFunc(volumeMigration)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", volumeMigration, err)
+			return
+		}
+		log.Log.V(3).Infof("enqueued %q for sync", key)
+		ctrl.volumeMigrationQueue.Add(key)
+	}
+}
+
+// handleVirtualMachineObject enqueues the volume migrations of a virtual machine when the virtual
+// machine or its VMI change
+func (ctrl *VolumeMigrationController) handleVirtualMachineObject(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	o, ok := obj.(metav1.Object)
+	if !ok {
+		return
+	}
+	objs, err := ctrl.VolumeMigrationInformer.GetIndexer().ByIndex(cache.NamespaceIndex, o.GetNamespace())
+	if err != nil {
+		log.Log.Errorf("failed to list volume migrations of namespace %s: %v", o.GetNamespace(), err)
+		return
+	}
+	for _, obj := range objs {
+		volumeMigration, ok := obj.(*migrationsv1.VolumeMigration)
+		if !ok || volumeMigration.Spec.VirtualMachineName != o.GetName() || isFinished(volumeMigration) {
+			continue
+		}
+		key := controller.NamespacedKey(volumeMigration.Namespace, volumeMigration.Name)
+		log.Log.V(3).Infof("Adding VolumeMigration due to virtual machine %s/%s", o.GetNamespace(), o.GetName())
+		ctrl.volumeMigrationQueue.Add(key)
+	}
+}
+
+func (ctrl *VolumeMigrationController) updateVolumeMigration(volumeMigration *migrationsv1.VolumeMigration) error {
+	log.Log.V(3).Infof("Updating VolumeMigration %s/%s", volumeMigration.Namespace, volumeMigration.Name)
+
+	if volumeMigration.DeletionTimestamp != nil || isFinished(volumeMigration) {
+		return nil
+	}
+
+	volumeMigrationCopy := volumeMigration.DeepCopy()
+	if volumeMigrationCopy.Status == nil {
+		volumeMigrationCopy.Status = &migrationsv1.VolumeMigrationStatus{
+			Phase: migrationsv1.VolumeMigrationPending,
+		}
+	}
+
+	if err := ctrl.migrateVolumes(volumeMigrationCopy); err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(volumeMigration.Status, volumeMigrationCopy.Status) {
+		return nil
+	}
+	_, err := ctrl.Client.VolumeMigration(volumeMigrationCopy.Namespace).UpdateStatus(context.Background(), volumeMigrationCopy, metav1.UpdateOptions{})
+	return err
+}
+
+// migrateVolumes progresses the volume migration, recording it in the status of the volume migration
+func (ctrl *VolumeMigrationController) migrateVolumes(volumeMigration *migrationsv1.VolumeMigration) error {
+	status := volumeMigration.Status
+	vm, err := ctrl.getVirtualMachine(volumeMigration)
+	if err != nil {
+		return err
+	}
+	if vm == nil {
+		ctrl.setFailed(volumeMigration, fmt.Sprintf("VirtualMachine %s does not exist", volumeMigration.Spec.VirtualMachineName))
+		return nil
+	}
+	vmi, err := ctrl.getVirtualMachineInstance(volumeMigration)
+	if err != nil {
+		return err
+	}
+
+	if len(status.Volumes) == 0 {
+		if vmi == nil || !vmi.IsRunning() {
+			ctrl.setFailed(volumeMigration, fmt.Sprintf("VirtualMachine %s is not running", vm.Name))
+			return nil
+		}
+		volumes, failure, err := ctrl.getMigratedVolumes(volumeMigration, vm)
+		if err != nil {
+			return err
+		}
+		if failure != "" {
+			ctrl.setFailed(volumeMigration, failure)
+			return nil
+		}
+		if len(volumes) == 0 {
+			ctrl.setSucceeded(volumeMigration, "No volume needs to be migrated")
+			return nil
+		}
+		status.Volumes = volumes
+	}
+
+	if failure := getFailure(vm, vmi); failure != "" {
+		ctrl.setFailed(volumeMigration, failure)
+		return nil
+	}
+
+	for _, volume := range status.Volumes {
+		if err := ctrl.createDestinationClaim(volumeMigration, volume); err != nil {
+			return err
+		}
+	}
+
+	if err := ctrl.updateVirtualMachineVolumes(volumeMigration, vm); err != nil {
+		return err
+	}
+
+	if isMigrated(vmi, status.Volumes) {
+		ctrl.setSucceeded(volumeMigration, "Volumes migrated")
+		return nil
+	}
+	status.Phase = migrationsv1.VolumeMigrationInProgress
+	setReadyCondition(status, metav1.ConditionFalse, inProgressReason, "Migrating volumes")
+	return nil
+}
+
+// getMigratedVolumes returns the volumes of the virtual machine which are migrated, or the reason they
+// can not be migrated. Volumes already backed by the target storage class are skipped.
+func (ctrl *VolumeMigrationController) getMigratedVolumes(volumeMigration *migrationsv1.VolumeMigration, vm *virtv1.VirtualMachine) ([]migrationsv1.MigratedVolume, string, error) {
+	claims := map[string]string{}
+	for i := range vm.Spec.Template.Spec.Volumes {
+		volume := &vm.Spec.Template.Spec.Volumes[i]
+		if claim := storagetypes.PVCNameFromVirtVolume(volume); claim != "" {
+			claims[volume.Name] = claim
+		}
+	}
+
+	names := volumeMigration.Spec.Volumes
+	if len(names) == 0 {
+		for _, volume := range vm.Spec.Template.Spec.Volumes {
+			if _, exists := claims[volume.Name]; exists {
+				names = append(names, volume.Name)
+			}
+		}
+	}
+
+	var volumes []migrationsv1.MigratedVolume
+	for _, name := range names {
+		claim, exists := claims[name]
+		if !exists {
+			return nil, fmt.Sprintf("VirtualMachine %s has no volume %s backed by a PersistentVolumeClaim or a DataVolume", vm.Name, name), nil
+		}
+		pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(vm.Namespace, claim, ctrl.PVCInformer.GetStore())
+		if err != nil {
+			return nil, "", err
+		}
+		if pvc == nil {
+			return nil, fmt.Sprintf("PersistentVolumeClaim %s does not exist", claim), nil
+		}
+		if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName == volumeMigration.Spec.StorageClassName {
+			continue
+		}
+		volumes = append(volumes, migrationsv1.MigratedVolume{
+			Name:                 name,
+			SourceClaimName:      claim,
+			DestinationClaimName: getDestinationClaimName(volumeMigration, claim),
+		})
+	}
+	return volumes, "", nil
+}
+
+// createDestinationClaim creates the PersistentVolumeClaim a volume is migrated to. It has the same
+// access modes, volume mode and size as the source claim, on the target storage class.
+func (ctrl *VolumeMigrationController) createDestinationClaim(volumeMigration *migrationsv1.VolumeMigration, volume migrationsv1.MigratedVolume) error {
+	pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(volumeMigration.Namespace, volume.DestinationClaimName, ctrl.PVCInformer.GetStore())
+	if err != nil || pvc != nil {
+		return err
+	}
+	source, err := storagetypes.GetPersistentVolumeClaimFromCache(volumeMigration.Namespace, volume.SourceClaimName, ctrl.PVCInformer.GetStore())
+	if err != nil {
+		return err
+	}
+	if source == nil {
+		return fmt.Errorf("source PersistentVolumeClaim %s does not exist", volume.SourceClaimName)
+	}
+
+	pvc = &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      volume.DestinationClaimName,
+			Namespace: volumeMigration.Namespace,
+			Annotations: map[string]string{
+				VolumeMigrationAnnotation: volumeMigration.Name,
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      source.Spec.AccessModes,
+			VolumeMode:       source.Spec.VolumeMode,
+			StorageClassName: pointer.P(volumeMigration.Spec.StorageClassName),
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: getClaimSize(source),
+				},
+			},
+		},
+	}
+	pvc, err = ctrl.Client.CoreV1().PersistentVolumeClaims(volumeMigration.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	ctrl.Recorder.Eventf(volumeMigration, corev1.EventTypeNormal, claimCreatedEvent, "Created PersistentVolumeClaim %s/%s", pvc.Namespace, pvc.Name)
+	return nil
+}
+
+// updateVirtualMachineVolumes points the migrated volumes of the virtual machine to the destination
+// claims. The Migration update volumes strategy makes the VM controller copy the volumes with a live
+// migration.
+func (ctrl *VolumeMigrationController) updateVirtualMachineVolumes(volumeMigration *migrationsv1.VolumeMigration, vm *virtv1.VirtualMachine) error {
+	destinations := map[string]string{}
+	sources := map[string]struct{}{}
+	for _, volume := range volumeMigration.Status.Volumes {
+		destinations[volume.Name] = volume.DestinationClaimName
+		sources[volume.SourceClaimName] = struct{}{}
+	}
+
+	volumes := make([]virtv1.Volume, 0, len(vm.Spec.Template.Spec.Volumes))
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		if destination, exists := destinations[volume.Name]; exists {
+			volume = virtv1.Volume{
+				Name: volume.Name,
+				VolumeSource: virtv1.VolumeSource{
+					PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: destination,
+						},
+					},
+				},
+			}
+		}
+		volumes = append(volumes, volume)
+	}
+	if equality.Semantic.DeepEqual(vm.Spec.Template.Spec.Volumes, volumes) {
+		return nil
+	}
+
+	// The DataVolumes of the migrated volumes are no longer referenced by the virtual machine
+	var dataVolumeTemplates []virtv1.DataVolumeTemplateSpec
+	for _, template := range vm.Spec.DataVolumeTemplates {
+		if _, migrated := sources[template.Name]; !migrated {
+			dataVolumeTemplates = append(dataVolumeTemplates, template)
+		}
+	}
+
+	patchSet := patch.New(
+		patch.WithTest("/spec/template/spec/volumes", vm.Spec.Template.Spec.Volumes),
+		patch.WithReplace("/spec/template/spec/volumes", volumes),
+		patch.WithAdd("/spec/updateVolumesStrategy", virtv1.UpdateVolumesStrategyMigration),
+	)
+	if len(dataVolumeTemplates) != len(vm.Spec.DataVolumeTemplates) {
+		patchSet.AddOption(patch.WithReplace("/spec/dataVolumeTemplates", dataVolumeTemplates))
+	}
+	payload, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if _, err := ctrl.Client.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, payload, metav1.PatchOptions{}); err != nil {
+		return err
+	}
+	ctrl.Recorder.Eventf(volumeMigration, corev1.EventTypeNormal, volumesUpdatedEvent, "Updated the volumes of VirtualMachine %s", vm.Name)
+	return nil
+}
+
+func (ctrl *VolumeMigrationController) setSucceeded(volumeMigration *migrationsv1.VolumeMigration, message string) {
+	volumeMigration.Status.Phase = migrationsv1.VolumeMigrationSucceeded
+	volumeMigration.Status.CompletionTime = currentTime()
+	setReadyCondition(volumeMigration.Status, metav1.ConditionTrue, succeededReason, message)
+	ctrl.Recorder.Eventf(volumeMigration, corev1.EventTypeNormal, volumeMigrationSucceededEvent, "Migrated the volumes of VirtualMachine %s", volumeMigration.Spec.VirtualMachineName)
+}
+
+func (ctrl *VolumeMigrationController) setFailed(volumeMigration *migrationsv1.VolumeMigration, message string) {
+	volumeMigration.Status.Phase = migrationsv1.VolumeMigrationFailed
+	volumeMigration.Status.CompletionTime = currentTime()
+	setReadyCondition(volumeMigration.Status, metav1.ConditionFalse, failedReason, message)
+	ctrl.Recorder.Eventf(volumeMigration, corev1.EventTypeWarning, volumeMigrationFailedEvent, "Failed to migrate volumes: %s", message)
+}
+
+func (ctrl *VolumeMigrationController) getVirtualMachine(volumeMigration *migrationsv1.VolumeMigration) (*virtv1.VirtualMachine, error) {
+	obj, exists, err := ctrl.VMInformer.GetStore().GetByKey(controller.NamespacedKey(volumeMigration.Namespace, volumeMigration.Spec.VirtualMachineName))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*virtv1.VirtualMachine), nil
+}
+
+func (ctrl *VolumeMigrationController) getVirtualMachineInstance(volumeMigration *migrationsv1.VolumeMigration) (*virtv1.VirtualMachineInstance, error) {
+	obj, exists, err := ctrl.VMIInformer.GetStore().GetByKey(controller.NamespacedKey(volumeMigration.Namespace, volumeMigration.Spec.VirtualMachineName))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*virtv1.VirtualMachineInstance), nil
+}
+
+// getFailure returns why the volume migration of the virtual machine failed, if it did
+func getFailure(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) string {
+	if cond := controller.NewVirtualMachineConditionManager().GetCondition(vm, virtv1.VirtualMachineManualRecoveryRequired); cond != nil &&
+		cond.Status == corev1.ConditionTrue {
+		return fmt.Sprintf("VirtualMachine %s requires manual recovery: %s", vm.Name, cond.Reason)
+	}
+	if vmi == nil {
+		return fmt.Sprintf("VirtualMachineInstance %s does not exist", vm.Name)
+	}
+	if cond := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, virtv1.VirtualMachineInstanceVolumesChange); cond != nil &&
+		cond.Status == corev1.ConditionFalse {
+		return fmt.Sprintf("Volumes change failed: %s", cond.Message)
+	}
+	return ""
+}
+
+// isMigrated returns whether the VMI runs on the destination claims and no volume migration is pending
+func isMigrated(vmi *virtv1.VirtualMachineInstance, volumes []migrationsv1.MigratedVolume) bool {
+	if len(vmi.Status.MigratedVolumes) > 0 ||
+		controller.NewVirtualMachineInstanceConditionManager().HasCondition(vmi, virtv1.VirtualMachineInstanceVolumesChange) {
+		return false
+	}
+	vmiVolumes := storagetypes.GetVolumesByName(&vmi.Spec)
+	for _, volume := range volumes {
+		vmiVolume, exists := vmiVolumes[volume.Name]
+		if !exists || storagetypes.PVCNameFromVirtVolume(vmiVolume) != volume.DestinationClaimName {
+			return false
+		}
+	}
+	return true
+}
+
+func isFinished(volumeMigration *migrationsv1.VolumeMigration) bool {
+	return volumeMigration.Status != nil &&
+		(volumeMigration.Status.Phase == migrationsv1.VolumeMigrationSucceeded || volumeMigration.Status.Phase == migrationsv1.VolumeMigrationFailed)
+}
+
+func setReadyCondition(status *migrationsv1.VolumeMigrationStatus, conditionStatus metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:    readyConditionType,
+		Status:  conditionStatus,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+func getDestinationClaimName(volumeMigration *migrationsv1.VolumeMigration, claim string) string {
+	return naming.GetName(claim, string(volumeMigration.UID)[:min(len(volumeMigration.UID), 5)], validation.DNS1123SubdomainMaxLength)
+}
+
+// getClaimSize returns the size of the source claim, the provisioned capacity when it is larger than
+// the requested size
+func getClaimSize(pvc *corev1.PersistentVolumeClaim) resource.Quantity {
+	size := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if capacity, exists := pvc.Status.Capacity[corev1.ResourceStorage]; exists && capacity.Cmp(size) > 0 {
+		return capacity
+	}
+	return size
+}
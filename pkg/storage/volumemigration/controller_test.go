@@ -0,0 +1,423 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package volumemigration
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	virtv1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+const (
+	testNamespace           = "default"
+	testVolumeMigrationName = "move-to-fast"
+	testVMName              = "testvm"
+	testStorageClass        = "fast"
+)
+
+var _ = Describe("Volume migration controller", func() {
+	var (
+		controller     *VolumeMigrationController
+		k8sClient      *k8sfake.Clientset
+		kubevirtClient *kubevirtfake.Clientset
+		recorder       *record.FakeRecorder
+		timeStamp      = metav1.Now()
+	)
+
+	BeforeEach(func() {
+		currentTime = func() *metav1.Time {
+			return &timeStamp
+		}
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		volumeMigrationInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.VolumeMigration{})
+		vmInformer, _ := testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+
+		k8sClient = k8sfake.NewSimpleClientset()
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		recorder = record.NewFakeRecorder(100)
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VolumeMigration(testNamespace).
+			Return(kubevirtClient.MigrationsV1alpha1().VolumeMigrations(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(testNamespace).
+			Return(kubevirtClient.KubevirtV1().VirtualMachines(testNamespace)).AnyTimes()
+
+		controller = &VolumeMigrationController{
+			Client:                  virtClient,
+			VolumeMigrationInformer: volumeMigrationInformer,
+			VMInformer:              vmInformer,
+			VMIInformer:             vmiInformer,
+			PVCInformer:             pvcInformer,
+			Recorder:                recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		currentTime = func() *metav1.Time {
+			t := metav1.Now()
+			return &t
+		}
+	})
+
+	newVolumeMigration := func(volumes ...string) *migrationsv1.VolumeMigration {
+		return &migrationsv1.VolumeMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testVolumeMigrationName,
+				Namespace: testNamespace,
+				UID:       "abcdef-uid",
+			},
+			Spec: migrationsv1.VolumeMigrationSpec{
+				VirtualMachineName: testVMName,
+				StorageClassName:   testStorageClass,
+				Volumes:            volumes,
+			},
+		}
+	}
+
+	addVolumeMigration := func(volumeMigration *migrationsv1.VolumeMigration) {
+		Expect(controller.VolumeMigrationInformer.GetStore().Add(volumeMigration)).To(Succeed())
+		_, err := kubevirtClient.MigrationsV1alpha1().VolumeMigrations(testNamespace).Create(context.Background(), volumeMigration, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getVolumeMigration := func() *migrationsv1.VolumeMigration {
+		volumeMigration, err := kubevirtClient.MigrationsV1alpha1().VolumeMigrations(testNamespace).Get(context.Background(), testVolumeMigrationName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return volumeMigration
+	}
+
+	newVM := func() *virtv1.VirtualMachine {
+		return &virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: testVMName, Namespace: testNamespace},
+			Spec: virtv1.VirtualMachineSpec{
+				DataVolumeTemplates: []virtv1.DataVolumeTemplateSpec{{
+					ObjectMeta: metav1.ObjectMeta{Name: "rootdisk-dv"},
+				}},
+				Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+					Spec: virtv1.VirtualMachineInstanceSpec{
+						Volumes: []virtv1.Volume{
+							{
+								Name: "rootdisk",
+								VolumeSource: virtv1.VolumeSource{
+									DataVolume: &virtv1.DataVolumeSource{Name: "rootdisk-dv"},
+								},
+							},
+							{
+								Name: "datadisk",
+								VolumeSource: virtv1.VolumeSource{
+									PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+										PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "datadisk-pvc"},
+									},
+								},
+							},
+							{
+								Name: "cloudinit",
+								VolumeSource: virtv1.VolumeSource{
+									CloudInitNoCloud: &virtv1.CloudInitNoCloudSource{UserData: "#cloud-config"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	addVM := func(vm *virtv1.VirtualMachine) {
+		Expect(controller.VMInformer.GetStore().Add(vm)).To(Succeed())
+		_, err := kubevirtClient.KubevirtV1().VirtualMachines(testNamespace).Create(context.Background(), vm, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getVM := func() *virtv1.VirtualMachine {
+		vm, err := kubevirtClient.KubevirtV1().VirtualMachines(testNamespace).Get(context.Background(), testVMName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vm
+	}
+
+	newVMI := func(vm *virtv1.VirtualMachine) *virtv1.VirtualMachineInstance {
+		return &virtv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: testVMName, Namespace: testNamespace},
+			Spec:       *vm.Spec.Template.Spec.DeepCopy(),
+			Status: virtv1.VirtualMachineInstanceStatus{
+				Phase: virtv1.Running,
+			},
+		}
+	}
+
+	addPVC := func(name, storageClass string, size string) {
+		pvc := &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+			Spec: k8sv1.PersistentVolumeClaimSpec{
+				AccessModes:      []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteMany},
+				VolumeMode:       pointer.P(k8sv1.PersistentVolumeBlock),
+				StorageClassName: pointer.P(storageClass),
+				Resources: k8sv1.VolumeResourceRequirements{
+					Requests: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse(size)},
+				},
+			},
+			Status: k8sv1.PersistentVolumeClaimStatus{
+				Capacity: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("12Gi")},
+			},
+		}
+		Expect(controller.PVCInformer.GetStore().Add(pvc)).To(Succeed())
+	}
+
+	getPVC := func(name string) *k8sv1.PersistentVolumeClaim {
+		pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return pvc
+	}
+
+	expectReadyCondition := func(volumeMigration *migrationsv1.VolumeMigration, status metav1.ConditionStatus, reason string) {
+		Expect(volumeMigration.Status.Conditions).To(ConsistOf(
+			And(
+				HaveField("Type", readyConditionType),
+				HaveField("Status", status),
+				HaveField("Reason", reason),
+			),
+		))
+	}
+
+	It("should fail when the virtual machine does not exist", func() {
+		volumeMigration := newVolumeMigration()
+		addVolumeMigration(volumeMigration)
+
+		Expect(controller.updateVolumeMigration(volumeMigration)).To(Succeed())
+		volumeMigration = getVolumeMigration()
+		Expect(volumeMigration.Status.Phase).To(Equal(migrationsv1.VolumeMigrationFailed))
+		Expect(volumeMigration.Status.CompletionTime).To(Equal(&timeStamp))
+		expectReadyCondition(volumeMigration, metav1.ConditionFalse, failedReason)
+		testutils.ExpectEvent(recorder, volumeMigrationFailedEvent)
+	})
+
+	It("should fail when the virtual machine is not running", func() {
+		volumeMigration := newVolumeMigration()
+		addVolumeMigration(volumeMigration)
+		addVM(newVM())
+
+		Expect(controller.updateVolumeMigration(volumeMigration)).To(Succeed())
+		volumeMigration = getVolumeMigration()
+		Expect(volumeMigration.Status.Phase).To(Equal(migrationsv1.VolumeMigrationFailed))
+		Expect(volumeMigration.Status.Conditions[0].Message).To(ContainSubstring("is not running"))
+		testutils.ExpectEvent(recorder, volumeMigrationFailedEvent)
+	})
+
+	It("should fail when a volume is not backed by a claim", func() {
+		volumeMigration := newVolumeMigration("cloudinit")
+		addVolumeMigration(volumeMigration)
+		vm := newVM()
+		addVM(vm)
+		Expect(controller.VMIInformer.GetStore().Add(newVMI(vm))).To(Succeed())
+
+		Expect(controller.updateVolumeMigration(volumeMigration)).To(Succeed())
+		volumeMigration = getVolumeMigration()
+		Expect(volumeMigration.Status.Phase).To(Equal(migrationsv1.VolumeMigrationFailed))
+		Expect(volumeMigration.Status.Conditions[0].Message).To(ContainSubstring("has no volume cloudinit"))
+	})
+
+	It("should succeed when all the volumes are on the target storage class", func() {
+		volumeMigration := newVolumeMigration()
+		addVolumeMigration(volumeMigration)
+		vm := newVM()
+		addVM(vm)
+		Expect(controller.VMIInformer.GetStore().Add(newVMI(vm))).To(Succeed())
+		addPVC("rootdisk-dv", testStorageClass, "10Gi")
+		addPVC("datadisk-pvc", testStorageClass, "10Gi")
+
+		Expect(controller.updateVolumeMigration(volumeMigration)).To(Succeed())
+		volumeMigration = getVolumeMigration()
+		Expect(volumeMigration.Status.Phase).To(Equal(migrationsv1.VolumeMigrationSucceeded))
+		Expect(volumeMigration.Status.Volumes).To(BeEmpty())
+		expectReadyCondition(volumeMigration, metav1.ConditionTrue, succeededReason)
+		Expect(getVM().Spec.UpdateVolumesStrategy).To(BeNil())
+	})
+
+	It("should create the destination claims and update the virtual machine volumes", func() {
+		volumeMigration := newVolumeMigration()
+		addVolumeMigration(volumeMigration)
+		vm := newVM()
+		addVM(vm)
+		Expect(controller.VMIInformer.GetStore().Add(newVMI(vm))).To(Succeed())
+		addPVC("rootdisk-dv", "slow", "10Gi")
+		addPVC("datadisk-pvc", testStorageClass, "10Gi")
+
+		Expect(controller.updateVolumeMigration(volumeMigration)).To(Succeed())
+		volumeMigration = getVolumeMigration()
+		Expect(volumeMigration.Status.Phase).To(Equal(migrationsv1.VolumeMigrationInProgress))
+		expectReadyCondition(volumeMigration, metav1.ConditionFalse, inProgressReason)
+		Expect(volumeMigration.Status.Volumes).To(ConsistOf(migrationsv1.MigratedVolume{
+			Name:                 "rootdisk",
+			SourceClaimName:      "rootdisk-dv",
+			DestinationClaimName: "rootdisk-dv-abcde",
+		}))
+		testutils.ExpectEvents(recorder, claimCreatedEvent, volumesUpdatedEvent)
+
+		pvc := getPVC("rootdisk-dv-abcde")
+		Expect(pvc.Annotations).To(HaveKeyWithValue(VolumeMigrationAnnotation, testVolumeMigrationName))
+		Expect(pvc.Spec.StorageClassName).To(HaveValue(Equal(testStorageClass)))
+		Expect(pvc.Spec.AccessModes).To(ConsistOf(k8sv1.ReadWriteMany))
+		Expect(pvc.Spec.VolumeMode).To(HaveValue(Equal(k8sv1.PersistentVolumeBlock)))
+		Expect(pvc.Spec.Resources.Requests).To(HaveKeyWithValue(k8sv1.ResourceStorage, resource.MustParse("12Gi")))
+
+		updatedVM := getVM()
+		Expect(updatedVM.Spec.UpdateVolumesStrategy).To(HaveValue(Equal(virtv1.UpdateVolumesStrategyMigration)))
+		Expect(updatedVM.Spec.DataVolumeTemplates).To(BeEmpty())
+		Expect(updatedVM.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("rootdisk-dv-abcde"))
+		Expect(updatedVM.Spec.Template.Spec.Volumes[1]).To(Equal(vm.Spec.Template.Spec.Volumes[1]))
+		Expect(updatedVM.Spec.Template.Spec.Volumes[2]).To(Equal(vm.Spec.Template.Spec.Volumes[2]))
+	})
+
+	It("should only migrate the selected volumes", func() {
+		volumeMigration := newVolumeMigration("datadisk")
+		addVolumeMigration(volumeMigration)
+		vm := newVM()
+		addVM(vm)
+		Expect(controller.VMIInformer.GetStore().Add(newVMI(vm))).To(Succeed())
+		addPVC("rootdisk-dv", "slow", "10Gi")
+		addPVC("datadisk-pvc", "slow", "10Gi")
+
+		Expect(controller.updateVolumeMigration(volumeMigration)).To(Succeed())
+		volumeMigration = getVolumeMigration()
+		Expect(volumeMigration.Status.Volumes).To(ConsistOf(migrationsv1.MigratedVolume{
+			Name:                 "datadisk",
+			SourceClaimName:      "datadisk-pvc",
+			DestinationClaimName: "datadisk-pvc-abcde",
+		}))
+
+		updatedVM := getVM()
+		Expect(updatedVM.Spec.DataVolumeTemplates).To(Equal(vm.Spec.DataVolumeTemplates))
+		Expect(updatedVM.Spec.Template.Spec.Volumes[0]).To(Equal(vm.Spec.Template.Spec.Volumes[0]))
+		Expect(updatedVM.Spec.Template.Spec.Volumes[1].PersistentVolumeClaim.ClaimName).To(Equal("datadisk-pvc-abcde"))
+	})
+
+	Context("with the virtual machine volumes updated", func() {
+		var (
+			volumeMigration *migrationsv1.VolumeMigration
+			vm              *virtv1.VirtualMachine
+			vmi             *virtv1.VirtualMachineInstance
+		)
+
+		BeforeEach(func() {
+			volumeMigration = newVolumeMigration("datadisk")
+			volumeMigration.Status = &migrationsv1.VolumeMigrationStatus{
+				Phase: migrationsv1.VolumeMigrationInProgress,
+				Volumes: []migrationsv1.MigratedVolume{{
+					Name:                 "datadisk",
+					SourceClaimName:      "datadisk-pvc",
+					DestinationClaimName: "datadisk-pvc-abcde",
+				}},
+			}
+			addVolumeMigration(volumeMigration)
+			addPVC("datadisk-pvc", "slow", "10Gi")
+			addPVC("datadisk-pvc-abcde", testStorageClass, "12Gi")
+
+			vm = newVM()
+			vm.Spec.UpdateVolumesStrategy = pointer.P(virtv1.UpdateVolumesStrategyMigration)
+			vm.Spec.Template.Spec.Volumes[1].PersistentVolumeClaim.ClaimName = "datadisk-pvc-abcde"
+			addVM(vm)
+			vmi = newVMI(vm)
+		})
+
+		It("should wait for the volumes to be copied", func() {
+			vmi.Status.MigratedVolumes = []virtv1.StorageMigratedVolumeInfo{{VolumeName: "datadisk"}}
+			vmi.Status.Conditions = []virtv1.VirtualMachineInstanceCondition{{
+				Type:   virtv1.VirtualMachineInstanceVolumesChange,
+				Status: k8sv1.ConditionTrue,
+			}}
+			Expect(controller.VMIInformer.GetStore().Add(vmi)).To(Succeed())
+
+			Expect(controller.updateVolumeMigration(volumeMigration)).To(Succeed())
+			volumeMigration = getVolumeMigration()
+			Expect(volumeMigration.Status.Phase).To(Equal(migrationsv1.VolumeMigrationInProgress))
+			expectReadyCondition(volumeMigration, metav1.ConditionFalse, inProgressReason)
+		})
+
+		It("should succeed once the VMI uses the destination claims", func() {
+			Expect(controller.VMIInformer.GetStore().Add(vmi)).To(Succeed())
+
+			Expect(controller.updateVolumeMigration(volumeMigration)).To(Succeed())
+			volumeMigration = getVolumeMigration()
+			Expect(volumeMigration.Status.Phase).To(Equal(migrationsv1.VolumeMigrationSucceeded))
+			Expect(volumeMigration.Status.CompletionTime).To(Equal(&timeStamp))
+			expectReadyCondition(volumeMigration, metav1.ConditionTrue, succeededReason)
+			testutils.ExpectEvent(recorder, volumeMigrationSucceededEvent)
+		})
+
+		It("should fail when the volumes change failed", func() {
+			vmi.Status.Conditions = []virtv1.VirtualMachineInstanceCondition{{
+				Type:    virtv1.VirtualMachineInstanceVolumesChange,
+				Status:  k8sv1.ConditionFalse,
+				Message: "destination volume doesn't exist",
+			}}
+			Expect(controller.VMIInformer.GetStore().Add(vmi)).To(Succeed())
+
+			Expect(controller.updateVolumeMigration(volumeMigration)).To(Succeed())
+			volumeMigration = getVolumeMigration()
+			Expect(volumeMigration.Status.Phase).To(Equal(migrationsv1.VolumeMigrationFailed))
+			Expect(volumeMigration.Status.Conditions[0].Message).To(ContainSubstring("destination volume doesn't exist"))
+		})
+
+		It("should fail when the virtual machine requires manual recovery", func() {
+			vm.Status.Conditions = []virtv1.VirtualMachineCondition{{
+				Type:   virtv1.VirtualMachineManualRecoveryRequired,
+				Status: k8sv1.ConditionTrue,
+				Reason: "VMI was removed or was final during the volume migration",
+			}}
+			Expect(controller.VMInformer.GetStore().Update(vm)).To(Succeed())
+
+			Expect(controller.updateVolumeMigration(volumeMigration)).To(Succeed())
+			volumeMigration = getVolumeMigration()
+			Expect(volumeMigration.Status.Phase).To(Equal(migrationsv1.VolumeMigrationFailed))
+			Expect(volumeMigration.Status.Conditions[0].Message).To(ContainSubstring("requires manual recovery"))
+		})
+	})
+
+	It("should enqueue the volume migrations of a changed virtual machine", func() {
+		addVolumeMigration(newVolumeMigration())
+		other := newVolumeMigration()
+		other.Name = "other"
+		other.Spec.VirtualMachineName = "othervm"
+		Expect(controller.VolumeMigrationInformer.GetStore().Add(other)).To(Succeed())
+
+		controller.handleVirtualMachineObject(newVM())
+		Expect(controller.volumeMigrationQueue.Len()).To(Equal(1))
+		key, _ := controller.volumeMigrationQueue.Get()
+		Expect(key).To(Equal(testNamespace + "/" + testVolumeMigrationName))
+	})
+})
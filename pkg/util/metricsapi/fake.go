@@ -0,0 +1,53 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package metricsapi
+
+import (
+	"fmt"
+
+	virtv1 "kubevirt.io/api/core/v1"
+)
+
+// FakeClient is a Client for tests returning the usage set on it
+type FakeClient struct {
+	// VMIs is the usage of the VMIs by their name
+	VMIs map[string]*Usage
+	// Nodes is the usage of the nodes by their name
+	Nodes map[string]Usage
+}
+
+func NewFakeClient() *FakeClient {
+	return &FakeClient{
+		VMIs:  map[string]*Usage{},
+		Nodes: map[string]Usage{},
+	}
+}
+
+func (f *FakeClient) VMIUsage(vmi *virtv1.VirtualMachineInstance) (*Usage, error) {
+	usage, exists := f.VMIs[vmi.Name]
+	if !exists {
+		return nil, fmt.Errorf("no metrics available for %s", vmi.Name)
+	}
+	return usage, nil
+}
+
+func (f *FakeClient) NodeUsage() (map[string]Usage, error) {
+	return f.Nodes, nil
+}
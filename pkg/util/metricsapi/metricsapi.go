@@ -0,0 +1,161 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package metricsapi reads the resource usage of the nodes and of the
+// VirtualMachineInstances from the metrics.k8s.io API
+package metricsapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+const (
+	// Resolution is roughly how often the metrics API refreshes the usage,
+	// looking at it more often only returns the same values
+	Resolution = time.Minute
+
+	metricsPath = "/apis/metrics.k8s.io/v1beta1"
+
+	computeContainerName = "compute"
+)
+
+// Usage is the CPU and memory a node or a VirtualMachineInstance currently consumes
+type Usage struct {
+	CPU    resource.Quantity
+	Memory resource.Quantity
+}
+
+// Client provides the resource usage of the nodes and of the VirtualMachineInstances
+type Client interface {
+	// VMIUsage returns the usage of the compute container of the virt-launcher pod of the VMI
+	VMIUsage(vmi *virtv1.VirtualMachineInstance) (*Usage, error)
+	// NodeUsage returns the usage of all nodes by their name
+	NodeUsage() (map[string]Usage, error)
+}
+
+// The types below mirror the parts of the metrics.k8s.io PodMetrics and
+// NodeMetrics types which are read, the API is served by an aggregated
+// server and its client isn't vendored
+type podMetrics struct {
+	Containers []containerMetrics `json:"containers"`
+}
+
+type containerMetrics struct {
+	Name  string             `json:"name"`
+	Usage k8sv1.ResourceList `json:"usage"`
+}
+
+type podMetricsList struct {
+	Items []podMetrics `json:"items"`
+}
+
+type nodeMetrics struct {
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Usage k8sv1.ResourceList `json:"usage"`
+}
+
+type nodeMetricsList struct {
+	Items []nodeMetrics `json:"items"`
+}
+
+// NewClient returns a Client reading the usage from the metrics API
+func NewClient(client kubecli.KubevirtClient) Client {
+	return &metricsClient{client: client}
+}
+
+type metricsClient struct {
+	client kubecli.KubevirtClient
+}
+
+func (c *metricsClient) VMIUsage(vmi *virtv1.VirtualMachineInstance) (*Usage, error) {
+	list := &podMetricsList{}
+	err := c.list(list, fmt.Sprintf("%s=%s", virtv1.CreatedByLabel, vmi.UID), "namespaces", vmi.Namespace, "pods")
+	if err != nil {
+		return nil, err
+	}
+	return usageFromPodMetrics(list)
+}
+
+func (c *metricsClient) NodeUsage() (map[string]Usage, error) {
+	list := &nodeMetricsList{}
+	if err := c.list(list, "", "nodes"); err != nil {
+		return nil, err
+	}
+	return usageFromNodeMetrics(list), nil
+}
+
+func (c *metricsClient) list(into interface{}, labelSelector string, segments ...string) error {
+	request := c.client.CoreV1().RESTClient().Get().
+		AbsPath(append([]string{metricsPath}, segments...)...)
+	if labelSelector != "" {
+		request = request.Param("labelSelector", labelSelector)
+	}
+
+	result, err := request.DoRaw(context.Background())
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(result, into)
+}
+
+func usageFromPodMetrics(list *podMetricsList) (*Usage, error) {
+	usage := &Usage{
+		CPU:    *resource.NewMilliQuantity(0, resource.DecimalSI),
+		Memory: *resource.NewQuantity(0, resource.BinarySI),
+	}
+	found := false
+	for _, pod := range list.Items {
+		for _, container := range pod.Containers {
+			if container.Name != computeContainerName {
+				continue
+			}
+			found = true
+			usage.CPU.Add(*container.Usage.Cpu())
+			usage.Memory.Add(*container.Usage.Memory())
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("no metrics available for the compute container")
+	}
+
+	return usage, nil
+}
+
+func usageFromNodeMetrics(list *nodeMetricsList) map[string]Usage {
+	usage := make(map[string]Usage, len(list.Items))
+	for _, node := range list.Items {
+		usage[node.Metadata.Name] = Usage{
+			CPU:    *node.Usage.Cpu(),
+			Memory: *node.Usage.Memory(),
+		}
+	}
+	return usage
+}
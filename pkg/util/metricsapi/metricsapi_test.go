@@ -0,0 +1,74 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package metricsapi
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("Metrics API", func() {
+	Context("pod metrics", func() {
+		It("should sum the usage of the compute containers", func() {
+			list := &podMetricsList{}
+			Expect(json.Unmarshal([]byte(`{"items": [{"containers": [
+				{"name": "compute", "usage": {"cpu": "1500m", "memory": "1Gi"}},
+				{"name": "hotplug-disk", "usage": {"cpu": "2", "memory": "2Gi"}}
+			]}, {"containers": [
+				{"name": "compute", "usage": {"cpu": "500m", "memory": "512Mi"}}
+			]}]}`), list)).To(Succeed())
+
+			usage, err := usageFromPodMetrics(list)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usage.CPU.Cmp(resource.MustParse("2"))).To(BeZero())
+			Expect(usage.Memory.Cmp(resource.MustParse("1536Mi"))).To(BeZero())
+		})
+
+		It("should fail without a compute container", func() {
+			list := &podMetricsList{}
+			Expect(json.Unmarshal([]byte(`{"items": [{"containers": [
+				{"name": "hotplug-disk", "usage": {"cpu": "2", "memory": "2Gi"}}
+			]}]}`), list)).To(Succeed())
+
+			_, err := usageFromPodMetrics(list)
+			Expect(err).To(MatchError("no metrics available for the compute container"))
+		})
+	})
+
+	It("should return the usage of the nodes by their name", func() {
+		list := &nodeMetricsList{}
+		Expect(json.Unmarshal([]byte(`{"items": [
+			{"metadata": {"name": "node01"}, "usage": {"cpu": "3", "memory": "4Gi"}},
+			{"metadata": {"name": "node02"}, "usage": {"cpu": "250m", "memory": "1Gi"}}
+		]}`), list)).To(Succeed())
+
+		usage := usageFromNodeMetrics(list)
+		Expect(usage).To(HaveLen(2))
+		node01, node02 := usage["node01"], usage["node02"]
+		Expect(node01.CPU.Cmp(resource.MustParse("3"))).To(BeZero())
+		Expect(node01.Memory.Cmp(resource.MustParse("4Gi"))).To(BeZero())
+		Expect(node02.CPU.Cmp(resource.MustParse("250m"))).To(BeZero())
+		Expect(node02.Memory.Cmp(resource.MustParse("1Gi"))).To(BeZero())
+	})
+})
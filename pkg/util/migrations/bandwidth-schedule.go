@@ -0,0 +1,122 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migrations
+
+import (
+	"fmt"
+	"time"
+	_ "time/tzdata" // the controller image does not ship a zoneinfo database
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+)
+
+// ParseTimeOfDay parses a 24-hour HH:MM time and returns the minutes since midnight
+func ParseTimeOfDay(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// ParseWeekday parses the name of a day of the week, e.g. Monday
+func ParseWeekday(value string) (time.Weekday, error) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if day.String() == value {
+			return day, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid day of the week %q", value)
+}
+
+// ScheduledBandwidth returns the bandwidth of the first window of the schedule
+// that is active at the given time, or nil if no window is active.
+func ScheduledBandwidth(schedule *migrationsv1.BandwidthSchedule, now time.Time) (*resource.Quantity, error) {
+	if schedule == nil {
+		return nil, nil
+	}
+
+	location := time.UTC
+	if schedule.TimeZone != "" {
+		var err error
+		if location, err = time.LoadLocation(schedule.TimeZone); err != nil {
+			return nil, err
+		}
+	}
+	now = now.In(location)
+
+	for i := range schedule.Windows {
+		active, err := isWindowActive(&schedule.Windows[i], now)
+		if err != nil {
+			return nil, err
+		}
+		if active {
+			bandwidth := schedule.Windows[i].BandwidthPerMigration.DeepCopy()
+			return &bandwidth, nil
+		}
+	}
+	return nil, nil
+}
+
+func isWindowActive(window *migrationsv1.BandwidthWindow, now time.Time) (bool, error) {
+	start, err := ParseTimeOfDay(window.Start)
+	if err != nil {
+		return false, err
+	}
+	end, err := ParseTimeOfDay(window.End)
+	if err != nil {
+		return false, err
+	}
+	minutes := now.Hour()*60 + now.Minute()
+
+	switch {
+	case start == end:
+		return startsOn(window, now.Weekday())
+	case start < end:
+		if minutes < start || minutes >= end {
+			return false, nil
+		}
+		return startsOn(window, now.Weekday())
+	case minutes >= start:
+		return startsOn(window, now.Weekday())
+	case minutes < end:
+		// The window started on the previous day and spans midnight
+		return startsOn(window, (now.Weekday()+6)%7)
+	}
+	return false, nil
+}
+
+func startsOn(window *migrationsv1.BandwidthWindow, weekday time.Weekday) (bool, error) {
+	if len(window.Days) == 0 {
+		return true, nil
+	}
+	for _, value := range window.Days {
+		day, err := ParseWeekday(value)
+		if err != nil {
+			return false, err
+		}
+		if day == weekday {
+			return true, nil
+		}
+	}
+	return false, nil
+}
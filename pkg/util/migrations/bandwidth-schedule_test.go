@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migrations
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+)
+
+var _ = Describe("Bandwidth schedule", func() {
+	businessHours := migrationsv1.BandwidthWindow{
+		Start:                 "09:00",
+		End:                   "17:00",
+		Days:                  []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"},
+		BandwidthPerMigration: resource.MustParse("64Mi"),
+	}
+	nights := migrationsv1.BandwidthWindow{
+		Start:                 "22:00",
+		End:                   "06:00",
+		Days:                  []string{"Friday"},
+		BandwidthPerMigration: resource.MustParse("0"),
+	}
+	schedule := &migrationsv1.BandwidthSchedule{
+		Windows: []migrationsv1.BandwidthWindow{businessHours, nights},
+	}
+
+	// 2024-01-01 is a Monday
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, time.January, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	It("should return nil without a schedule", func() {
+		Expect(ScheduledBandwidth(nil, at(1, 12, 0))).To(BeNil())
+	})
+
+	DescribeTable("should pick the active window", func(now time.Time, expected *resource.Quantity) {
+		bandwidth, err := ScheduledBandwidth(schedule, now)
+		Expect(err).ToNot(HaveOccurred())
+		if expected == nil {
+			Expect(bandwidth).To(BeNil())
+			return
+		}
+		Expect(bandwidth).ToNot(BeNil())
+		Expect(bandwidth.Cmp(*expected)).To(Equal(0))
+	},
+		Entry("during business hours", at(1, 9, 0), &businessHours.BandwidthPerMigration),
+		Entry("at the end of business hours", at(1, 17, 0), nil),
+		Entry("during business hours on a weekend", at(6, 12, 0), nil),
+		Entry("on the night the window starts", at(5, 23, 30), &nights.BandwidthPerMigration),
+		Entry("after midnight of a window that started the previous day", at(6, 5, 59), &nights.BandwidthPerMigration),
+		Entry("after midnight of a window that did not start the previous day", at(5, 5, 59), nil),
+	)
+
+	It("should evaluate the windows in the configured time zone", func() {
+		newYork := &migrationsv1.BandwidthSchedule{
+			TimeZone: "America/New_York",
+			Windows:  []migrationsv1.BandwidthWindow{businessHours},
+		}
+		bandwidth, err := ScheduledBandwidth(newYork, at(1, 15, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bandwidth).ToNot(BeNil())
+
+		bandwidth, err = ScheduledBandwidth(newYork, at(1, 23, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bandwidth).To(BeNil())
+	})
+
+	It("should treat a window ending when it starts as the whole day", func() {
+		allDay := &migrationsv1.BandwidthSchedule{
+			Windows: []migrationsv1.BandwidthWindow{{Start: "00:00", End: "00:00", BandwidthPerMigration: resource.MustParse("1Gi")}},
+		}
+		Expect(ScheduledBandwidth(allDay, at(3, 12, 34))).ToNot(BeNil())
+	})
+
+	It("should fail on an invalid window", func() {
+		invalid := &migrationsv1.BandwidthSchedule{
+			Windows: []migrationsv1.BandwidthWindow{{Start: "9am", End: "17:00"}},
+		}
+		_, err := ScheduledBandwidth(invalid, at(1, 12, 0))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,63 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migrations
+
+import (
+	"fmt"
+	"slices"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// AdditionalNetworkInterfaceName returns the name of the virt-handler interface connected to
+// the additional migration network at the given index of MigrationConfiguration.AdditionalNetworks.
+// The migration network itself is connected through v1.MigrationInterfaceName.
+func AdditionalNetworkInterfaceName(index int) string {
+	return fmt.Sprintf("migration%d", index+1)
+}
+
+// IsMigrationNetworkConfigured returns true if network is the migration network or one of the
+// additional migration networks of the cluster configuration.
+func IsMigrationNetworkConfigured(config *v1.MigrationConfiguration, network string) bool {
+	if config == nil {
+		return false
+	}
+	if config.Network != nil && *config.Network == network {
+		return true
+	}
+	return slices.Contains(config.AdditionalNetworks, network)
+}
+
+// AdditionalNetworkInterfaceForMigration returns the name of the virt-handler interface connected
+// to the network selected for a migration, if it is one of the additional migration networks.
+// It returns false if the migration goes through the migration network or the pod network.
+func AdditionalNetworkInterfaceForMigration(config *v1.MigrationConfiguration, selected *string) (string, bool) {
+	if config == nil || selected == nil {
+		return "", false
+	}
+	if config.Network != nil && *config.Network == *selected {
+		return "", false
+	}
+	index := slices.Index(config.AdditionalNetworks, *selected)
+	if index < 0 {
+		return "", false
+	}
+	return AdditionalNetworkInterfaceName(index), true
+}
@@ -0,0 +1,57 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migrations
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Migration networks", func() {
+	config := &v1.MigrationConfiguration{
+		Network:            pointer.P("default"),
+		AdditionalNetworks: []string{"storage", "latency"},
+	}
+
+	DescribeTable("should tell if a network is configured", func(config *v1.MigrationConfiguration, network string, expected bool) {
+		Expect(IsMigrationNetworkConfigured(config, network)).To(Equal(expected))
+	},
+		Entry("with the migration network", config, "default", true),
+		Entry("with an additional migration network", config, "latency", true),
+		Entry("with an unknown network", config, "unknown", false),
+		Entry("without configuration", nil, "default", false),
+	)
+
+	DescribeTable("should return the interface of the selected network", func(selected *string, expectedInterface string, expectedAdditional bool) {
+		iface, isAdditional := AdditionalNetworkInterfaceForMigration(config, selected)
+		Expect(isAdditional).To(Equal(expectedAdditional))
+		Expect(iface).To(Equal(expectedInterface))
+	},
+		Entry("without selected network", nil, "", false),
+		Entry("with the migration network", pointer.P("default"), "", false),
+		Entry("with the first additional network", pointer.P("storage"), "migration1", true),
+		Entry("with the second additional network", pointer.P("latency"), "migration2", true),
+		Entry("with an unknown network", pointer.P("unknown"), "", false),
+	)
+})
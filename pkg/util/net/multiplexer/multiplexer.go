@@ -0,0 +1,369 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package multiplexer multiplexes TCP streams to several ports over a single connection.
+//
+// Every frame starts with a header of the stream id (uint32), the frame type (uint8) and the
+// length of the payload (uint32), in network byte order. The client opens a stream with an open
+// frame carrying the target port (uint16), both sides exchange data frames and close the stream
+// with a close frame, carrying an optional error message. There is no flow control per stream,
+// a stream which is not read blocks the session once its buffer is full.
+package multiplexer
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	frameOpen  byte = 1
+	frameData  byte = 2
+	frameClose byte = 3
+
+	headerSize = 9
+	// MaxPayloadSize is the maximum size of the payload of a single frame
+	MaxPayloadSize = 32 * 1024
+
+	streamBufferSize = 16
+)
+
+var (
+	ErrSessionClosed        = errors.New("multiplexer session closed")
+	errDeadlineNotSupported = errors.New("deadlines are not supported by multiplexed streams")
+)
+
+// DialFunc connects the streams opened by the client to the given port
+type DialFunc func(port int) (net.Conn, error)
+
+type Session struct {
+	conn net.Conn
+	dial DialFunc
+
+	writeLock sync.Mutex
+
+	lock    sync.Mutex
+	streams map[uint32]*stream
+	nextID  uint32
+	closed  bool
+}
+
+// NewClient returns a session which opens streams over conn
+func NewClient(conn net.Conn) *Session {
+	return newSession(conn, nil)
+}
+
+// NewServer returns a session which connects the streams opened by the client over conn with dial
+func NewServer(conn net.Conn, dial DialFunc) *Session {
+	return newSession(conn, dial)
+}
+
+func newSession(conn net.Conn, dial DialFunc) *Session {
+	return &Session{
+		conn:    conn,
+		dial:    dial,
+		streams: map[uint32]*stream{},
+	}
+}
+
+// Open opens a stream to the given port of the server, an error of the server to connect
+// the port is returned by the first Read of the stream
+func (s *Session) Open(port int) (net.Conn, error) {
+	if port < 1 || port > 65535 {
+		return nil, fmt.Errorf("invalid port %d", port)
+	}
+
+	s.lock.Lock()
+	if s.closed {
+		s.lock.Unlock()
+		return nil, ErrSessionClosed
+	}
+	s.nextID++
+	st := newStream(s, s.nextID)
+	s.streams[st.id] = st
+	s.lock.Unlock()
+
+	payload := make([]byte, 2)
+	binary.BigEndian.PutUint16(payload, uint16(port))
+	if err := s.writeFrame(st.id, frameOpen, payload); err != nil {
+		s.removeStream(st.id)
+		st.finish(err)
+		return nil, err
+	}
+	return st, nil
+}
+
+// Serve reads the frames of the session until the connection fails or the session is closed,
+// all streams are closed once it returns
+func (s *Session) Serve() error {
+	err := s.serve()
+	s.shutdown()
+	return err
+}
+
+// Close closes the connection of the session and all its streams
+func (s *Session) Close() error {
+	s.shutdown()
+	return nil
+}
+
+func (s *Session) serve() error {
+	for {
+		id, frameType, payload, err := s.readFrame()
+		if err != nil {
+			return err
+		}
+
+		switch frameType {
+		case frameOpen:
+			if s.dial == nil {
+				return fmt.Errorf("unexpected open frame for stream %d", id)
+			}
+			if len(payload) != 2 {
+				return fmt.Errorf("invalid open frame for stream %d", id)
+			}
+			st, err := s.addStream(id)
+			if err != nil {
+				return err
+			}
+			go s.connect(st, int(binary.BigEndian.Uint16(payload)))
+		case frameData:
+			if st := s.getStream(id); st != nil {
+				st.push(payload)
+			}
+		case frameClose:
+			if st := s.getStream(id); st != nil {
+				s.removeStream(id)
+				if len(payload) > 0 {
+					st.finish(errors.New(string(payload)))
+				} else {
+					st.finish(io.EOF)
+				}
+			}
+		default:
+			return fmt.Errorf("unknown frame type %d", frameType)
+		}
+	}
+}
+
+// connect relays the stream to the port on the server side
+func (s *Session) connect(st *stream, port int) {
+	conn, err := s.dial(port)
+	if err != nil {
+		s.removeStream(st.id)
+		if st.finish(err) {
+			s.writeFrame(st.id, frameClose, []byte(err.Error()))
+		}
+		return
+	}
+	defer conn.Close()
+	defer st.Close()
+
+	errs := make(chan error, 2)
+	go func() {
+		_, err := io.Copy(conn, st)
+		errs <- err
+	}()
+	go func() {
+		_, err := io.Copy(st, conn)
+		errs <- err
+	}()
+	<-errs
+}
+
+func (s *Session) shutdown() {
+	s.lock.Lock()
+	if s.closed {
+		s.lock.Unlock()
+		return
+	}
+	s.closed = true
+	streams := s.streams
+	s.streams = map[uint32]*stream{}
+	s.lock.Unlock()
+
+	s.conn.Close()
+	for _, st := range streams {
+		st.finish(ErrSessionClosed)
+	}
+}
+
+func (s *Session) addStream(id uint32) (*stream, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, exists := s.streams[id]; exists {
+		return nil, fmt.Errorf("stream %d is already open", id)
+	}
+	st := newStream(s, id)
+	s.streams[id] = st
+	return st, nil
+}
+
+func (s *Session) getStream(id uint32) *stream {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.streams[id]
+}
+
+func (s *Session) removeStream(id uint32) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.streams, id)
+}
+
+func (s *Session) writeFrame(id uint32, frameType byte, payload []byte) error {
+	frame := make([]byte, headerSize+len(payload))
+	binary.BigEndian.PutUint32(frame[0:4], id)
+	frame[4] = frameType
+	binary.BigEndian.PutUint32(frame[5:9], uint32(len(payload)))
+	copy(frame[headerSize:], payload)
+
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	_, err := s.conn.Write(frame)
+	return err
+}
+
+func (s *Session) readFrame() (id uint32, frameType byte, payload []byte, err error) {
+	header := make([]byte, headerSize)
+	if _, err = io.ReadFull(s.conn, header); err != nil {
+		return
+	}
+	id = binary.BigEndian.Uint32(header[0:4])
+	frameType = header[4]
+	length := binary.BigEndian.Uint32(header[5:9])
+	if length > MaxPayloadSize {
+		err = fmt.Errorf("frame of stream %d exceeds the maximum payload size: %d", id, length)
+		return
+	}
+	payload = make([]byte, length)
+	_, err = io.ReadFull(s.conn, payload)
+	return
+}
+
+type stream struct {
+	session  *Session
+	id       uint32
+	incoming chan []byte
+	pending  []byte
+
+	lock sync.Mutex
+	err  error
+	done chan struct{}
+}
+
+func newStream(session *Session, id uint32) *stream {
+	return &stream{
+		session:  session,
+		id:       id,
+		incoming: make(chan []byte, streamBufferSize),
+		done:     make(chan struct{}),
+	}
+}
+
+// finish marks the stream as done with the given error, it returns false if it was already done
+func (st *stream) finish(err error) bool {
+	st.lock.Lock()
+	defer st.lock.Unlock()
+	if st.err != nil {
+		return false
+	}
+	st.err = err
+	close(st.done)
+	return true
+}
+
+func (st *stream) doneErr() error {
+	st.lock.Lock()
+	defer st.lock.Unlock()
+	return st.err
+}
+
+func (st *stream) push(payload []byte) {
+	select {
+	case st.incoming <- payload:
+	case <-st.done:
+	}
+}
+
+func (st *stream) Read(p []byte) (int, error) {
+	if len(st.pending) == 0 {
+		select {
+		case st.pending = <-st.incoming:
+		case <-st.done:
+			// Return the data received before the stream was closed first
+			select {
+			case st.pending = <-st.incoming:
+			default:
+				return 0, st.doneErr()
+			}
+		}
+	}
+	n := copy(p, st.pending)
+	st.pending = st.pending[n:]
+	return n, nil
+}
+
+func (st *stream) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		if err := st.doneErr(); err != nil {
+			return written, err
+		}
+		n := min(len(p), MaxPayloadSize)
+		if err := st.session.writeFrame(st.id, frameData, p[:n]); err != nil {
+			return written, err
+		}
+		written += n
+		p = p[n:]
+	}
+	return written, nil
+}
+
+func (st *stream) Close() error {
+	if st.finish(net.ErrClosed) {
+		st.session.removeStream(st.id)
+		return st.session.writeFrame(st.id, frameClose, nil)
+	}
+	return nil
+}
+
+func (st *stream) LocalAddr() net.Addr {
+	return st.session.conn.LocalAddr()
+}
+
+func (st *stream) RemoteAddr() net.Addr {
+	return st.session.conn.RemoteAddr()
+}
+
+func (st *stream) SetDeadline(_ time.Time) error {
+	return errDeadlineNotSupported
+}
+
+func (st *stream) SetReadDeadline(_ time.Time) error {
+	return errDeadlineNotSupported
+}
+
+func (st *stream) SetWriteDeadline(_ time.Time) error {
+	return errDeadlineNotSupported
+}
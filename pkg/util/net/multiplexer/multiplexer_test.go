@@ -0,0 +1,184 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package multiplexer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Multiplexer", func() {
+	var (
+		clientConn, serverConn net.Conn
+		client, server         *Session
+		clientErr, serverErr   chan error
+	)
+
+	// echo answers every connection with the port followed by the data it receives
+	echo := func(port int) (net.Conn, error) {
+		local, remote := net.Pipe()
+		go func() {
+			defer remote.Close()
+			if _, err := fmt.Fprintf(remote, "%d:", port); err != nil {
+				return
+			}
+			io.Copy(remote, remote)
+		}()
+		return local, nil
+	}
+
+	start := func(dial DialFunc) {
+		clientConn, serverConn = net.Pipe()
+		client = NewClient(clientConn)
+		server = NewServer(serverConn, dial)
+		clientErr = make(chan error, 1)
+		serverErr = make(chan error, 1)
+		go func(session *Session, errs chan error) {
+			errs <- session.Serve()
+		}(server, serverErr)
+		go func(session *Session, errs chan error) {
+			errs <- session.Serve()
+		}(client, clientErr)
+		DeferCleanup(client.Close)
+		DeferCleanup(server.Close)
+	}
+
+	readN := func(conn net.Conn, n int) string {
+		buf := make([]byte, n)
+		_, err := io.ReadFull(conn, buf)
+		Expect(err).ToNot(HaveOccurred())
+		return string(buf)
+	}
+
+	It("should forward several streams over a single connection", func() {
+		start(echo)
+
+		first, err := client.Open(8080)
+		Expect(err).ToNot(HaveOccurred())
+		second, err := client.Open(22)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = second.Write([]byte("ssh"))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = first.Write([]byte("http"))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(readN(first, 9)).To(Equal("8080:http"))
+		Expect(readN(second, 6)).To(Equal("22:ssh"))
+	})
+
+	It("should split writes exceeding the maximum payload size", func() {
+		start(echo)
+
+		stream, err := client.Open(80)
+		Expect(err).ToNot(HaveOccurred())
+
+		data := bytes.Repeat([]byte("x"), 3*MaxPayloadSize+1)
+		go stream.Write(data)
+		Expect(readN(stream, 3)).To(Equal("80:"))
+		Expect(readN(stream, len(data))).To(Equal(string(data)))
+	})
+
+	It("should return the error of the server to connect the port", func() {
+		start(func(port int) (net.Conn, error) {
+			return nil, fmt.Errorf("connection refused on port %d", port)
+		})
+
+		stream, err := client.Open(443)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = stream.Read(make([]byte, 1))
+		Expect(err).To(MatchError("connection refused on port 443"))
+	})
+
+	It("should return EOF once the server closes the stream", func() {
+		start(func(port int) (net.Conn, error) {
+			local, remote := net.Pipe()
+			go func() {
+				remote.Write([]byte("bye"))
+				remote.Close()
+			}()
+			return local, nil
+		})
+
+		stream, err := client.Open(80)
+		Expect(err).ToNot(HaveOccurred())
+		data, err := io.ReadAll(stream)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal("bye"))
+	})
+
+	It("should close the streams when the session is closed", func() {
+		start(echo)
+
+		stream, err := client.Open(80)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(readN(stream, 3)).To(Equal("80:"))
+
+		Expect(client.Close()).To(Succeed())
+		_, err = stream.Read(make([]byte, 1))
+		Expect(err).To(MatchError(ErrSessionClosed))
+		_, err = client.Open(80)
+		Expect(err).To(MatchError(ErrSessionClosed))
+		Eventually(serverErr).Should(Receive(HaveOccurred()))
+	})
+
+	It("should refuse invalid ports", func() {
+		start(echo)
+
+		_, err := client.Open(0)
+		Expect(err).To(MatchError("invalid port 0"))
+		_, err = client.Open(65536)
+		Expect(err).To(MatchError("invalid port 65536"))
+	})
+
+	It("should fail on frames exceeding the maximum payload size", func() {
+		start(echo)
+
+		header := make([]byte, headerSize)
+		binary.BigEndian.PutUint32(header[0:4], 1)
+		header[4] = frameData
+		binary.BigEndian.PutUint32(header[5:9], MaxPayloadSize+1)
+		go clientConn.Write(header)
+
+		var err error
+		Eventually(serverErr).Should(Receive(&err))
+		Expect(err).To(MatchError(ContainSubstring("exceeds the maximum payload size")))
+	})
+
+	It("should refuse streams opened by the server", func() {
+		start(echo)
+
+		payload := make([]byte, 2)
+		binary.BigEndian.PutUint16(payload, 80)
+		go server.writeFrame(1, frameOpen, payload)
+
+		var err error
+		Eventually(clientErr).Should(Receive(&err))
+		Expect(err).To(MatchError("unexpected open frame for stream 1"))
+		_, err = client.Open(80)
+		Expect(err).To(MatchError(ErrSessionClosed))
+	})
+})
@@ -21,6 +21,7 @@ const (
 	VirtImageVolumeDir                        = "/var/run/kubevirt-image-volume"
 	VirtKernelBootVolumeDir                   = "/var/run/kubevirt-kernel-boot"
 	VirtPrivateDir                            = "/var/run/kubevirt-private"
+	VirtSessionRecordingsDir                  = "/var/run/kubevirt-session-recordings"
 	KubeletRoot                               = "/var/lib/kubelet"
 	KubeletPodsDir                            = KubeletRoot + "/pods"
 	HostRootMount                             = "/proc/1/root/"
@@ -91,6 +92,10 @@ func IsAutoAttachVSOCK(vmi *v1.VirtualMachineInstance) bool {
 	return vmi.Spec.Domain.Devices.AutoattachVSOCK != nil && *vmi.Spec.Domain.Devices.AutoattachVSOCK
 }
 
+func IsSPICEGraphics(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.Domain.Devices.Graphics != nil && vmi.Spec.Domain.Devices.Graphics.Type == v1.GraphicsTypeSPICE
+}
+
 func ResourceNameToEnvVar(prefix string, resourceName string) string {
 	varName := strings.ToUpper(resourceName)
 	varName = strings.Replace(varName, "/", "_", -1)
@@ -133,6 +138,8 @@ func AlignImageSizeTo1MiB(size int64, logger *log.FilteredLogger) int64 {
 
 }
 
+// SetDefaultVolumeDisk adds a disk for every volume without a disk or filesystem. Memory dump
+// volumes are never attached to the guest, so they do not get a disk.
 func SetDefaultVolumeDisk(spec *v1.VirtualMachineInstanceSpec) {
 	diskAndFilesystemNames := make(map[string]struct{})
 
@@ -145,6 +152,9 @@ func SetDefaultVolumeDisk(spec *v1.VirtualMachineInstanceSpec) {
 	}
 
 	for _, volume := range spec.Volumes {
+		if volume.MemoryDump != nil {
+			continue
+		}
 		if _, foundDisk := diskAndFilesystemNames[volume.Name]; !foundDisk {
 			spec.Domain.Devices.Disks = append(
 				spec.Domain.Devices.Disks,
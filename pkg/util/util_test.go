@@ -99,3 +99,16 @@ var _ = Describe("Host Device VMI Predicates", func() {
 		Expect(IsHostDevVMI(vmi)).To(BeTrue())
 	})
 })
+
+var _ = Describe("SetDefaultVolumeDisk", func() {
+	It("should add a disk for volumes without one, but not for memory dump volumes", func() {
+		spec := &v1.VirtualMachineInstanceSpec{
+			Volumes: []v1.Volume{
+				{Name: "disk0", VolumeSource: v1.VolumeSource{EmptyDisk: &v1.EmptyDiskSource{}}},
+				{Name: "state", VolumeSource: v1.VolumeSource{MemoryDump: &v1.MemoryDumpVolumeSource{}}},
+			},
+		}
+		SetDefaultVolumeDisk(spec)
+		Expect(spec.Domain.Devices.Disks).To(Equal([]v1.Disk{{Name: "disk0"}}))
+	})
+})
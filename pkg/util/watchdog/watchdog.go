@@ -0,0 +1,62 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package watchdog
+
+import (
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// Action returns the action of the watchdog device of the VMI, or an empty action if the VMI has no watchdog
+func Action(vmi *v1.VirtualMachineInstance) v1.WatchdogAction {
+	watchdog := vmi.Spec.Domain.Devices.Watchdog
+	if watchdog == nil {
+		return ""
+	}
+	var action v1.WatchdogAction
+	switch {
+	case watchdog.I6300ESB != nil:
+		action = watchdog.I6300ESB.Action
+	case watchdog.Diag288 != nil:
+		action = watchdog.Diag288.Action
+	}
+	if action == "" {
+		return v1.WatchdogActionReset
+	}
+	return action
+}
+
+// IsHandledByKubeVirt tells whether the action is taken by KubeVirt once the watchdog expired,
+// rather than by the hypervisor
+func IsHandledByKubeVirt(action v1.WatchdogAction) bool {
+	switch action {
+	case v1.WatchdogActionMigrate, v1.WatchdogActionSnapshotReset, v1.WatchdogActionAlert:
+		return true
+	}
+	return false
+}
+
+// LibvirtAction returns the action libvirt takes once the watchdog expired. Libvirt ignores the
+// watchdog for the actions taken by KubeVirt, it only reports the expiry.
+func LibvirtAction(action v1.WatchdogAction) string {
+	if IsHandledByKubeVirt(action) {
+		return "none"
+	}
+	return string(action)
+}
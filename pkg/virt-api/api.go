@@ -230,6 +230,8 @@ func (app *virtAPIApp) composeSubresources() {
 
 	var subwss []*restful.WebService
 
+	subresourceLimiter := rest.NewSubresourceLimiter(app.clusterConfig, app.authorizor)
+
 	for _, version := range v1.SubresourceGroupVersions {
 		subresourcesvmGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachines"}
 		subresourcesvmiGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachineinstances"}
@@ -265,6 +267,78 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("applychanges")).
+			To(subresourceApp.ApplyChangesVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.ApplyChangesOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"ApplyChanges").
+			Doc("Apply the staged changes of a VirtualMachine to its running VirtualMachineInstance.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("restore")).
+			To(subresourceApp.RestoreVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.RestoreOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Restore").
+			Doc("Restore a VirtualMachine from one of its VirtualMachineSnapshots.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("rescue")).
+			To(subresourceApp.RescueVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.RescueOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Rescue").
+			Doc("Restart a VirtualMachine from a rescue image with its disks attached.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		unrescueRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("unrescue")).
+			To(subresourceApp.UnrescueVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.UnrescueOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Unrescue").
+			Doc("Take a VirtualMachine out of rescue mode.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "")
+		unrescueRouteBuilder.ParameterNamed("body").Required(false)
+		subws.Route(unrescueRouteBuilder)
+
+		hibernateRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("hibernate")).
+			To(subresourceApp.HibernateVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.HibernateOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Hibernate").
+			Doc("Save the memory of a VirtualMachine to a PVC and stop it.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "")
+		hibernateRouteBuilder.ParameterNamed("body").Required(false)
+		subws.Route(hibernateRouteBuilder)
+
+		resumeRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("resume")).
+			To(subresourceApp.ResumeVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.ResumeOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Resume").
+			Doc("Start a hibernated VirtualMachine from its saved memory.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "")
+		resumeRouteBuilder.ParameterNamed("body").Required(false)
+		subws.Route(resumeRouteBuilder)
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("start")).
 			To(subresourceApp.StartVMRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -357,36 +431,54 @@ func (app *virtAPIApp) composeSubresources() {
 
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("console")).
 			To(subresourceApp.ConsoleRequestHandler).
+			Filter(subresourceLimiter.Filter("console")).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Operation(version.Version + "Console").
 			Doc("Open a websocket connection to a serial console on the specified VirtualMachineInstance."))
 
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("vnc")).
 			To(subresourceApp.VNCRequestHandler).
+			Filter(subresourceLimiter.Filter("vnc")).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Operation(version.Version + "VNC").
 			Doc("Open a websocket connection to connect to VNC on the specified VirtualMachineInstance."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("vnc/screenshot")).
 			To(subresourceApp.VNCScreenshotRequestHandler).
+			Filter(subresourceLimiter.Filter("vnc/screenshot")).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).Param(definitions.MoveCursorParam(subws)).
 			Operation(version.Version + "VNCScreenshot").
 			Doc("Get a PNG VNC screenshot of the specified VirtualMachineInstance."))
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("spice")).
+			To(subresourceApp.SPICERequestHandler).
+			Filter(subresourceLimiter.Filter("spice")).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version + "SPICE").
+			Doc("Open a websocket connection to connect to a SPICE channel on the specified VirtualMachineInstance."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("usbredir")).
 			To(subresourceApp.USBRedirRequestHandler).
+			Filter(subresourceLimiter.Filter("usbredir")).
 			Param(definitions.NamespaceParam(subws)).
 			Param(definitions.NameParam(subws)).
 			Operation(version.Version + "usbredir").
 			Doc("Open a websocket connection to connect to USB device on the specified VirtualMachineInstance."))
 
 		// VMI endpoint
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("portforward")).
+			To(subresourceApp.PortForwardMultiplexedRequestHandler(subresourceApp.FetchVirtualMachineInstance)).
+			Filter(subresourceLimiter.Filter("portforward")).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version + "vmi-PortForwardMultiplexed").
+			Doc("Open a websocket connection forwarding TCP traffic to several ports of the specified VirtualMachineInstance, multiplexed over the connection."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath).
 			To(subresourceApp.PortForwardRequestHandler(subresourceApp.FetchVirtualMachineInstance)).
+			Filter(subresourceLimiter.Filter("portforward")).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Param(definitions.PortForwardPortParameter(subws)).
 			Operation(version.Version + "vmi-PortForward").
 			Doc("Open a websocket connection forwarding traffic to the specified VirtualMachineInstance and port."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath + definitions.ProtocolPath).
 			To(subresourceApp.PortForwardRequestHandler(subresourceApp.FetchVirtualMachineInstance)).
+			Filter(subresourceLimiter.Filter("portforward")).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Param(definitions.PortForwardPortParameter(subws)).
 			Param(definitions.PortForwardProtocolParameter(subws)).
@@ -394,19 +486,35 @@ func (app *virtAPIApp) composeSubresources() {
 			Doc("Open a websocket connection forwarding traffic of the specified protocol (either tcp or udp) to the specified VirtualMachineInstance and port."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("vsock")).
 			To(subresourceApp.VSOCKRequestHandler).
+			Filter(subresourceLimiter.Filter("vsock")).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).Param(definitions.VSOCKPortParameter(subws)).Param(definitions.VSOCKTLSParameter(subws)).
 			Operation(version.Version + "VSOCK").
 			Doc("Open a websocket connection forwarding traffic to the specified VirtualMachineInstance and port via VSOCK."))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("memorydump")).
+			To(subresourceApp.MemoryDumpStreamRequestHandler).
+			Filter(subresourceLimiter.Filter("memorydump")).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).Param(definitions.MemoryDumpFormatParameter(subws)).Param(definitions.MemoryDumpContentParameter(subws)).
+			Operation(version.Version + "MemoryDumpStream").
+			Doc("Open a websocket connection streaming a memory dump of the specified VirtualMachineInstance."))
+
 		// VM endpoint
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR) + definitions.SubResourcePath("portforward")).
+			To(subresourceApp.PortForwardMultiplexedRequestHandler(subresourceApp.FetchVirtualMachineInstanceForVM)).
+			Filter(subresourceLimiter.Filter("portforward")).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version + "vm-PortForwardMultiplexed").
+			Doc("Open a websocket connection forwarding TCP traffic to several ports of the running VMI for the specified VirtualMachine, multiplexed over the connection."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath).
 			To(subresourceApp.PortForwardRequestHandler(subresourceApp.FetchVirtualMachineInstanceForVM)).
+			Filter(subresourceLimiter.Filter("portforward")).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Param(definitions.PortForwardPortParameter(subws)).
 			Operation(version.Version + "vm-PortForward").
 			Doc("Open a websocket connection forwarding traffic to the running VMI for the specified VirtualMachine and port."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath + definitions.ProtocolPath).
 			To(subresourceApp.PortForwardRequestHandler(subresourceApp.FetchVirtualMachineInstanceForVM)).
+			Filter(subresourceLimiter.Filter("portforward")).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Param(definitions.PortForwardPortParameter(subws)).
 			Param(definitions.PortForwardProtocolParameter(subws)).
@@ -484,6 +592,50 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("stats")).
+			To(subresourceApp.Stats).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Stats").
+			Doc("Get resource usage statistics of a running VirtualMachineInstance").
+			Writes(v1.VirtualMachineInstanceStats{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceStats{}))
+
+		subws.Route(subws.POST(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestosexec")).
+			To(subresourceApp.GuestOSExecHandler).
+			Consumes(mime.MIME_ANY).
+			Produces(restful.MIME_JSON).
+			Reads(v1.GuestOSExecOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"GuestOSExec").
+			Doc("Run a command on guest machine via guest agent").
+			Writes(v1.GuestOSExecResult{}).
+			Returns(http.StatusOK, "OK", v1.GuestOSExecResult{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.POST(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestfileread")).
+			To(subresourceApp.GuestFileReadHandler).
+			Consumes(mime.MIME_ANY).
+			Produces(restful.MIME_JSON).
+			Reads(v1.GuestFileReadOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"GuestFileRead").
+			Doc("Read a file on guest machine via guest agent").
+			Writes(v1.GuestFile{}).
+			Returns(http.StatusOK, "OK", v1.GuestFile{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestfilewrite")).
+			To(subresourceApp.GuestFileWriteHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.GuestFile{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"GuestFileWrite").
+			Doc("Write a file on guest machine via guest agent").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("objectgraph")).
 			To(subresourceApp.VMIObjectGraph).
 			Consumes(restful.MIME_JSON).
@@ -526,6 +678,46 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("iolimits")).
+			To(subresourceApp.VMISetIOLimitsRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.SetIOLimitsOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vmi-iolimits").
+			Doc("Update the IO limits of a disk of a running Virtual Machine Instance").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("repin")).
+			To(subresourceApp.VMIRepinVCPUsRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.RepinVCPUsOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vmi-repin").
+			Doc("Re-pin the vCPUs of a running Virtual Machine Instance with dedicated CPUs").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("addusbdevice")).
+			To(subresourceApp.VMIAddUSBDeviceRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.AddUSBDeviceOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vmi-addusbdevice").
+			Doc("Add a host USB device to a running Virtual Machine Instance").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("removeusbdevice")).
+			To(subresourceApp.VMIRemoveUSBDeviceRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.RemoveUSBDeviceOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vmi-removeusbdevice").
+			Doc("Removes a host USB device from a running Virtual Machine Instance").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMAddVolumeRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -627,6 +819,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/vnc",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/spice",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/console",
 						Namespaced: true,
@@ -675,6 +871,30 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachines/migrate",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachines/restore",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/rescue",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/unrescue",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/hibernate",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/resume",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/applychanges",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachines/expand-spec",
 						Namespaced: true,
@@ -695,6 +915,22 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/stats",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/guestosexec",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/guestfileread",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/guestfilewrite",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
@@ -703,6 +939,26 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/removevolume",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/iolimits",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/repin",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/addusbdevice",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/removeusbdevice",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/memorydump",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachinesinstances/objectgraph",
 						Namespaced: true,
@@ -930,6 +1186,15 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.VMPoolValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMPool(w, r, app.clusterConfig, app.kubeVirtServiceAccounts)
 	})
+	http.HandleFunc(components.VMAutoscalingPolicyValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMAutoscalingPolicies(w, r)
+	})
+	http.HandleFunc(components.VMResourceQuotaValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMResourceQuotas(w, r)
+	})
+	http.HandleFunc(components.VMDisruptionBudgetValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMDisruptionBudgets(w, r)
+	})
 	http.HandleFunc(components.VMIPresetValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMIPreset(w, r)
 	})
@@ -945,9 +1210,27 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.VMRestoreValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMRestores(w, r, app.clusterConfig, app.virtCli, informers)
 	})
+	http.HandleFunc(components.VMSnapshotScheduleValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMSnapshotSchedules(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMSnapshotGroupValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMSnapshotGroups(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMSnapshotGroupRestoreValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMSnapshotGroupRestores(w, r, app.clusterConfig)
+	})
 	http.HandleFunc(components.VMExportValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMExports(w, r, app.clusterConfig)
 	})
+	http.HandleFunc(components.VMSnapshotExportValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMSnapshotExports(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMSnapshotReplicationValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMSnapshotReplications(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMImportValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMImports(w, r, app.clusterConfig)
+	})
 	http.HandleFunc(components.VMInstancetypeValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVmInstancetypes(w, r)
 	})
@@ -960,6 +1243,12 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.VMClusterPreferenceValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVmClusterPreferences(w, r)
 	})
+	http.HandleFunc(components.VMDefaultValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVmDefaults(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMClusterDefaultValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVmClusterDefaults(w, r, app.clusterConfig)
+	})
 	http.HandleFunc(components.StatusValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeStatusValidation(w, r, app.clusterConfig, app.virtCli, informers, app.kubeVirtServiceAccounts)
 	})
@@ -969,6 +1258,9 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.MigrationPolicyCreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeMigrationPolicies(w, r)
 	})
+	http.HandleFunc(components.VolumeMigrationValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVolumeMigrations(w, r)
+	})
 	http.HandleFunc(components.VMCloneCreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVirtualMachineClones(w, r, app.clusterConfig, app.virtCli)
 	})
@@ -977,7 +1269,7 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 func (app *virtAPIApp) registerMutatingWebhook(informers *webhooks.Informers) {
 
 	http.HandleFunc(components.VMMutatePath, func(w http.ResponseWriter, r *http.Request) {
-		mutating_webhook.ServeVMs(w, r, app.clusterConfig, app.virtCli)
+		mutating_webhook.ServeVMs(w, r, app.clusterConfig, app.virtCli, informers)
 	})
 	http.HandleFunc(components.VMIMutatePath, func(w http.ResponseWriter, r *http.Request) {
 		mutating_webhook.ServeVMIs(w, r, app.clusterConfig, informers, app.kubeVirtServiceAccounts)
@@ -1120,6 +1412,9 @@ func (app *virtAPIApp) Run() {
 	vmiPresetInformer := kubeInformerFactory.VirtualMachinePreset()
 	vmRestoreInformer := kubeInformerFactory.VirtualMachineRestore()
 	namespaceInformer := kubeInformerFactory.Namespace()
+	vmResourceQuotaInformer := kubeInformerFactory.VMResourceQuota()
+	vmDefaultInformer := kubeInformerFactory.VirtualMachineDefault()
+	vmClusterDefaultInformer := kubeInformerFactory.VirtualMachineClusterDefault()
 
 	stopChan := make(chan struct{}, 1)
 	defer close(stopChan)
@@ -1154,10 +1449,13 @@ func (app *virtAPIApp) Run() {
 	kubeInformerFactory.WaitForCacheSync(stopChan)
 
 	webhookInformers := &webhooks.Informers{
-		VMIPresetInformer:  vmiPresetInformer,
-		VMRestoreInformer:  vmRestoreInformer,
-		DataSourceInformer: dataSourceInformer,
-		NamespaceInformer:  namespaceInformer,
+		VMIPresetInformer:        vmiPresetInformer,
+		VMRestoreInformer:        vmRestoreInformer,
+		DataSourceInformer:       dataSourceInformer,
+		NamespaceInformer:        namespaceInformer,
+		VMResourceQuotaInformer:  vmResourceQuotaInformer,
+		VMDefaultInformer:        vmDefaultInformer,
+		VMClusterDefaultInformer: vmClusterDefaultInformer,
 	}
 
 	// Build webhook subresources
@@ -1190,8 +1488,12 @@ func (app *virtAPIApp) configModificationCallback() {
 	}
 }
 
-// Update virt-api log verbosity on relevant config changes
+// Update virt-api log format and verbosity on relevant config changes
 func (app *virtAPIApp) shouldChangeLogVerbosity() {
+	if err := log.SetFormat(string(app.clusterConfig.GetLogFormat())); err != nil {
+		log.Log.Warningf("failed to update the log format: %v", err)
+	}
+	log.SetSubcomponentVerbosity(app.clusterConfig.GetSubcomponentVerbosity())
 	verbosity := app.clusterConfig.GetVirtAPIVerbosity(app.host)
 	log.Log.SetVerbosityLevel(int(verbosity))
 	log.Log.V(2).Infof("set log verbosity to %d", verbosity)
@@ -39,11 +39,14 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	autoscalingv1alpha1 "kubevirt.io/api/autoscaling/v1alpha1"
 	v1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
+	quotav1alpha1 "kubevirt.io/api/quota/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	importv1 "kubevirt.io/api/vmimport/v1beta1"
 
 	mime "kubevirt.io/kubevirt/pkg/rest"
 )
@@ -63,6 +66,9 @@ func ComposeAPIDefinitions() []*restful.WebService {
 		instancetypeApiServiceDefinitions,
 		migrationPoliciesApiServiceDefinitions,
 		poolApiServiceDefinitions,
+		autoscalingApiServiceDefinitions,
+		quotaApiServiceDefinitions,
+		importApiServiceDefinitions,
 		vmCloneDefinitions,
 	} {
 		result = append(result, f()...)
@@ -125,6 +131,9 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 	vmsGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshots")
 	vmscGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotcontents")
 	vmrGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinerestores")
+	vmssGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotschedules")
+	vmsgGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotgroups")
+	vmsgrGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotgrouprestores")
 
 	ws, err := groupVersionProxyBase(schema.GroupVersion{Group: snapshotv1.SchemeGroupVersion.Group, Version: snapshotv1.SchemeGroupVersion.Version})
 	if err != nil {
@@ -146,6 +155,21 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws, err = genericNamespacedResourceProxy(ws, vmssGVR, &snapshotv1.VirtualMachineSnapshotSchedule{}, "VirtualMachineSnapshotSchedule", &snapshotv1.VirtualMachineSnapshotScheduleList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, vmsgGVR, &snapshotv1.VirtualMachineSnapshotGroup{}, "VirtualMachineSnapshotGroup", &snapshotv1.VirtualMachineSnapshotGroupList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, vmsgrGVR, &snapshotv1.VirtualMachineSnapshotGroupRestore{}, "VirtualMachineSnapshotGroupRestore", &snapshotv1.VirtualMachineSnapshotGroupRestoreList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws2, err := resourceProxyAutodiscovery(vmsGVR)
 	if err != nil {
 		panic(err)
@@ -155,6 +179,8 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 
 func exportApiServiceDefinitions() []*restful.WebService {
 	exportsGVR := exportv1.SchemeGroupVersion.WithResource("virtualmachineexports")
+	snapshotExportsGVR := exportv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotexports")
+	snapshotReplicationsGVR := exportv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotreplications")
 
 	ws, err := groupVersionProxyBase(schema.GroupVersion{Group: exportv1.SchemeGroupVersion.Group, Version: exportv1.SchemeGroupVersion.Version})
 	if err != nil {
@@ -166,6 +192,16 @@ func exportApiServiceDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws, err = genericNamespacedResourceProxy(ws, snapshotExportsGVR, &exportv1.VirtualMachineSnapshotExport{}, "VirtualMachineSnapshotExport", &exportv1.VirtualMachineSnapshotExportList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, snapshotReplicationsGVR, &exportv1.VirtualMachineSnapshotReplication{}, "VirtualMachineSnapshotReplication", &exportv1.VirtualMachineSnapshotReplicationList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws2, err := resourceProxyAutodiscovery(exportsGVR)
 	if err != nil {
 		panic(err)
@@ -173,8 +209,33 @@ func exportApiServiceDefinitions() []*restful.WebService {
 	return []*restful.WebService{ws, ws2}
 }
 
+func importApiServiceDefinitions() []*restful.WebService {
+	importsGVR := importv1.SchemeGroupVersion.WithResource("virtualmachineimports")
+
+	ws, err := groupVersionProxyBase(importv1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, importsGVR, &importv1.VirtualMachineImport{}, "VirtualMachineImport", &importv1.VirtualMachineImportList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws2, err := resourceProxyAutodiscovery(importsGVR)
+	if err != nil {
+		panic(err)
+	}
+
+	return []*restful.WebService{ws, ws2}
+}
+
 func migrationPoliciesApiServiceDefinitions() []*restful.WebService {
 	mpGVR := migrationsv1.SchemeGroupVersion.WithResource(migrations.ResourceMigrationPolicies)
+	volumeMigrationGVR := migrationsv1.SchemeGroupVersion.WithResource(migrations.ResourceVolumeMigrations)
+	retryBudgetGVR := migrationsv1.SchemeGroupVersion.WithResource(migrations.ResourceMigrationRetryBudgets)
+	rebalancePolicyGVR := migrationsv1.SchemeGroupVersion.WithResource(migrations.ResourceMigrationRebalancePolicies)
+	disruptionBudgetGVR := migrationsv1.SchemeGroupVersion.WithResource(migrations.ResourceVMDisruptionBudgets)
 
 	ws, err := groupVersionProxyBase(schema.GroupVersion{Group: migrationsv1.SchemeGroupVersion.Group, Version: migrationsv1.SchemeGroupVersion.Version})
 	if err != nil {
@@ -186,6 +247,26 @@ func migrationPoliciesApiServiceDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws, err = genericNamespacedResourceProxy(ws, volumeMigrationGVR, &migrationsv1.VolumeMigration{}, migrationsv1.VolumeMigrationKind.Kind, &migrationsv1.VolumeMigrationList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, retryBudgetGVR, &migrationsv1.MigrationRetryBudget{}, migrationsv1.MigrationRetryBudgetKind.Kind, &migrationsv1.MigrationRetryBudgetList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericClusterResourceProxy(ws, rebalancePolicyGVR, &migrationsv1.MigrationRebalancePolicy{}, migrationsv1.MigrationRebalancePolicyKind.Kind, &migrationsv1.MigrationRebalancePolicyList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, disruptionBudgetGVR, &migrationsv1.VirtualMachineDisruptionBudget{}, migrationsv1.VirtualMachineDisruptionBudgetKind.Kind, &migrationsv1.VirtualMachineDisruptionBudgetList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws2, err := resourceProxyAutodiscovery(mpGVR)
 	if err != nil {
 		panic(err)
@@ -198,6 +279,8 @@ func instancetypeApiServiceDefinitions() []*restful.WebService {
 	clusterInstancetypeGVR := instancetypev1beta1.SchemeGroupVersion.WithResource(instancetype.ClusterPluralResourceName)
 	preferenceGVR := instancetypev1beta1.SchemeGroupVersion.WithResource(instancetype.PluralPreferenceResourceName)
 	clusterPreferenceGVR := instancetypev1beta1.SchemeGroupVersion.WithResource(instancetype.ClusterPluralPreferenceResourceName)
+	defaultGVR := instancetypev1beta1.SchemeGroupVersion.WithResource(instancetype.PluralDefaultResourceName)
+	clusterDefaultGVR := instancetypev1beta1.SchemeGroupVersion.WithResource(instancetype.ClusterPluralDefaultResourceName)
 
 	ws, err := groupVersionProxyBase(instancetypev1beta1.SchemeGroupVersion)
 	if err != nil {
@@ -224,6 +307,16 @@ func instancetypeApiServiceDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws, err = genericNamespacedResourceProxy(ws, defaultGVR, &instancetypev1beta1.VirtualMachineDefault{}, "VirtualMachineDefault", &instancetypev1beta1.VirtualMachineDefaultList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericClusterResourceProxy(ws, clusterDefaultGVR, &instancetypev1beta1.VirtualMachineClusterDefault{}, "VirtualMachineClusterDefault", &instancetypev1beta1.VirtualMachineClusterDefaultList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws2, err := resourceProxyAutodiscovery(instancetypeGVR)
 	if err != nil {
 		panic(err)
@@ -253,6 +346,48 @@ func poolApiServiceDefinitions() []*restful.WebService {
 	return []*restful.WebService{ws, ws2}
 }
 
+func autoscalingApiServiceDefinitions() []*restful.WebService {
+	autoscalingPolicyGVR := autoscalingv1alpha1.SchemeGroupVersion.WithResource("virtualmachineautoscalingpolicies")
+
+	ws, err := groupVersionProxyBase(autoscalingv1alpha1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, autoscalingPolicyGVR, &autoscalingv1alpha1.VirtualMachineAutoscalingPolicy{}, autoscalingv1alpha1.VirtualMachineAutoscalingPolicyKind, &autoscalingv1alpha1.VirtualMachineAutoscalingPolicyList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws2, err := resourceProxyAutodiscovery(autoscalingPolicyGVR)
+	if err != nil {
+		panic(err)
+	}
+
+	return []*restful.WebService{ws, ws2}
+}
+
+func quotaApiServiceDefinitions() []*restful.WebService {
+	resourceQuotaGVR := quotav1alpha1.SchemeGroupVersion.WithResource("virtualmachineresourcequotas")
+
+	ws, err := groupVersionProxyBase(quotav1alpha1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, resourceQuotaGVR, &quotav1alpha1.VirtualMachineResourceQuota{}, quotav1alpha1.VirtualMachineResourceQuotaKind, &quotav1alpha1.VirtualMachineResourceQuotaList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws2, err := resourceProxyAutodiscovery(resourceQuotaGVR)
+	if err != nil {
+		panic(err)
+	}
+
+	return []*restful.WebService{ws, ws2}
+}
+
 func vmCloneDefinitions() []*restful.WebService {
 	mpGVR := clone.SchemeGroupVersion.WithResource(clonebase.ResourceVMClonePlural)
 
@@ -668,6 +803,8 @@ const (
 	PortPath          = "/{port}"
 	ProtocolParamName = "protocol"
 	ProtocolPath      = "/{protocol}"
+	FormatParamName   = "format"
+	ContentParamName  = "content"
 )
 
 func PortForwardPortParameter(ws *restful.WebService) *restful.Parameter {
@@ -687,3 +824,11 @@ func VSOCKPortParameter(ws *restful.WebService) *restful.Parameter {
 func VSOCKTLSParameter(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter(TLSParamName, "Weather to request a TLS encrypted session from the VSOCK application.").DataType("boolean").Required(false)
 }
+
+func MemoryDumpFormatParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(FormatParamName, "The format of the memory dump: Raw, KdumpZlib, KdumpLzo or KdumpSnappy.").DataType("string").Required(false)
+}
+
+func MemoryDumpContentParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(ContentParamName, "The part of the guest memory which is dumped: Full or Kernel.").DataType("string").Required(false)
+}
@@ -19,8 +19,9 @@ func (app *SubresourceAPIApp) ConsoleRequestHandler(request *restful.Request, re
 
 	defer apimetrics.SetVMILastConnectionTimestamp(request.PathParameter("namespace"), request.PathParameter("name"))
 
-	streamer := NewRawStreamer(
-		app.FetchVirtualMachineInstance,
+	streamer := app.newSessionStreamer(
+		v1.ConsoleSessionRecording,
+		request,
 		validateVMIForConsole,
 		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 			return conn.ConsoleURI(vmi)
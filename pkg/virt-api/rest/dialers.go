@@ -9,7 +9,6 @@ import (
 
 	"github.com/emicklei/go-restful/v3"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
-	netutils "k8s.io/utils/net"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
@@ -53,6 +52,16 @@ func (n netDial) Dial(vmi *v1.VirtualMachineInstance) (*websocket.Conn, *k8serro
 }
 
 func (n netDial) DialUnderlying(vmi *v1.VirtualMachineInstance) (net.Conn, *k8serrors.StatusError) {
+	protocol := "tcp"
+	if protocolParam := n.request.PathParameter(definitions.ProtocolParamName); len(protocolParam) > 0 {
+		protocol = protocolParam
+	}
+
+	return dialVMI(vmi, n.request.PathParameter(definitions.PortParamName), protocol)
+}
+
+// dialVMI connects the port of the first interface of the VMI
+func dialVMI(vmi *v1.VirtualMachineInstance, port, protocol string) (net.Conn, *k8serrors.StatusError) {
 	logger := log.Log.Object(vmi)
 
 	targetIP, err := getTargetInterfaceIP(vmi)
@@ -61,20 +70,11 @@ func (n netDial) DialUnderlying(vmi *v1.VirtualMachineInstance) (net.Conn, *k8se
 		return nil, k8serrors.NewBadRequest(err.Error())
 	}
 
-	port := n.request.PathParameter(definitions.PortParamName)
 	if len(port) < 1 {
 		return nil, k8serrors.NewBadRequest("port must not be empty")
 	}
 
-	protocol := "tcp"
-	if protocolParam := n.request.PathParameter(definitions.ProtocolParamName); len(protocolParam) > 0 {
-		protocol = protocolParam
-	}
-
-	addr := fmt.Sprintf("%s:%s", targetIP, port)
-	if netutils.IsIPv6String(targetIP) {
-		addr = fmt.Sprintf("[%s]:%s", targetIP, port)
-	}
+	addr := net.JoinHostPort(targetIP, port)
 	conn, err := net.Dial(protocol, addr)
 	if err != nil {
 		logger.Reason(err).Errorf("Can't dial %s %s", protocol, addr)
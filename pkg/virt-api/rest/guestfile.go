@@ -0,0 +1,141 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+// GuestFileReadHandler returns the content of a file in the guest, read through the guest agent
+func (app *SubresourceAPIApp) GuestFileReadHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.GuestFileTransferEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, featuregate.GuestFileTransferGate)), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body: a path is required"), response)
+		return
+	}
+
+	opts := &v1.GuestFileReadOptions{}
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	if opts.Path == "" {
+		writeError(errors.NewBadRequest("Path is required"), response)
+		return
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.GuestFileReadURI(vmi)
+	}
+	vmi, url, conn, statusErr := app.prepareConnection(request, validateVMIGuestAgentConnected, getURL)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	log.Log.Object(vmi).With("user", request.Request.Header.Get(userHeader)).Infof("Reading file %s in the guest", opts.Path)
+	resp, err := conn.Post(url, io.NopCloser(bytes.NewReader(body)))
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	guestFile := v1.GuestFile{}
+	if err := json.Unmarshal([]byte(resp), &guestFile); err != nil {
+		log.Log.Reason(err).Error("error unmarshalling response")
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	response.WriteEntity(guestFile)
+}
+
+// GuestFileWriteHandler replaces the content of a file in the guest through the guest agent
+func (app *SubresourceAPIApp) GuestFileWriteHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.GuestFileTransferEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, featuregate.GuestFileTransferGate)), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body: a file is required"), response)
+		return
+	}
+
+	guestFile := &v1.GuestFile{}
+	if err := decodeBody(request, guestFile); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	if guestFile.Path == "" {
+		writeError(errors.NewBadRequest("Path is required"), response)
+		return
+	}
+	if len(guestFile.Content) > v1.GuestFileMaxSize {
+		writeError(errors.NewBadRequest(fmt.Sprintf("Content must not be larger than %d bytes", v1.GuestFileMaxSize)), response)
+		return
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.GuestFileWriteURI(vmi)
+	}
+	vmi, url, conn, statusErr := app.prepareConnection(request, validateVMIGuestAgentConnected, getURL)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	body, err := json.Marshal(guestFile)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	log.Log.Object(vmi).With("user", request.Request.Header.Get(userHeader)).Infof("Writing file %s in the guest", guestFile.Path)
+	if err := conn.Put(url, io.NopCloser(bytes.NewReader(body))); err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+}
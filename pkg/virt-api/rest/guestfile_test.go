@@ -0,0 +1,224 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Guest file subresources", func() {
+	const nodeName = "mynode"
+
+	var (
+		backend     *ghttp.Server
+		backendPort int
+		request     *restful.Request
+		response    *restful.Response
+		recorder    *httptest.ResponseRecorder
+		virtClient  *kubevirtfake.Clientset
+		app         *SubresourceAPIApp
+	)
+
+	newApp := func(featureGates ...string) *SubresourceAPIApp {
+		kv := &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt",
+				Namespace: "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{
+						FeatureGates: featureGates,
+					},
+				},
+			},
+			Status: v1.KubeVirtStatus{
+				Phase: v1.KubeVirtPhaseDeploying,
+			},
+		}
+		config, _, _ := testutils.NewFakeClusterConfigUsingKV(kv)
+
+		pod := &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "madeup-name",
+				Namespace: "kubevirt",
+				Labels:    map[string]string{v1.AppLabel: "virt-handler"},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName: nodeName,
+			},
+			Status: k8sv1.PodStatus{
+				Phase: k8sv1.PodRunning,
+				PodIP: strings.Split(backend.Addr(), ":")[0],
+			},
+		}
+
+		kubeClient := fake.NewSimpleClientset(pod)
+		mockVirtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		mockVirtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		mockVirtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+
+		return NewSubresourceAPIApp(mockVirtClient, backendPort, &tls.Config{InsecureSkipVerify: true}, config)
+	}
+
+	setBody := func(obj interface{}) {
+		body, err := json.Marshal(obj)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = &readCloserWrapper{bytes.NewReader(body)}
+	}
+
+	createVMI := func(statusOpts ...libvmistatus.Option) {
+		vmi := libvmi.New(
+			libvmi.WithName(testVMIName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmistatus.WithStatus(libvmistatus.New(append([]libvmistatus.Option{libvmistatus.WithNodeName(nodeName)}, statusOpts...)...)),
+		)
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.TODO(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	agentConnected := libvmistatus.WithCondition(v1.VirtualMachineInstanceCondition{
+		Type:   v1.VirtualMachineInstanceAgentConnected,
+		Status: k8sv1.ConditionTrue,
+	})
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		backend = ghttp.NewTLSServer()
+		var err error
+		backendPort, err = strconv.Atoi(strings.Split(backend.Addr(), ":")[1])
+		Expect(err).ToNot(HaveOccurred())
+
+		virtClient = kubevirtfake.NewSimpleClientset()
+		app = newApp(featuregate.GuestFileTransferGate)
+	})
+
+	AfterEach(func() {
+		backend.Close()
+	})
+
+	Context("read", func() {
+		It("should return the file read by virt-handler", func() {
+			createVMI(libvmistatus.WithPhase(v1.Running), agentConnected)
+			setBody(&v1.GuestFileReadOptions{Path: "/etc/hostname"})
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/namespaces/default/virtualmachineinstances/testvmi/guestfileread"),
+					ghttp.VerifyJSONRepresenting(v1.GuestFileReadOptions{Path: "/etc/hostname"}),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, v1.GuestFile{Path: "/etc/hostname", Content: []byte("testvmi\n")}),
+				),
+			)
+
+			app.GuestFileReadHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			guestFile := v1.GuestFile{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &guestFile)).To(Succeed())
+			Expect(guestFile.Content).To(Equal([]byte("testvmi\n")))
+		})
+
+		It("should fail without a path", func() {
+			setBody(&v1.GuestFileReadOptions{})
+
+			app.GuestFileReadHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+			Expect(recorder.Body.String()).To(ContainSubstring("Path is required"))
+		})
+
+		It("should fail when the guest agent is not connected", func() {
+			createVMI(libvmistatus.WithPhase(v1.Running))
+			setBody(&v1.GuestFileReadOptions{Path: "/etc/hostname"})
+
+			app.GuestFileReadHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusConflict))
+		})
+	})
+
+	Context("write", func() {
+		It("should send the file to virt-handler", func() {
+			createVMI(libvmistatus.WithPhase(v1.Running), agentConnected)
+			guestFile := v1.GuestFile{Path: "/etc/motd", Content: []byte("hello")}
+			setBody(&guestFile)
+			expectedBody, err := json.Marshal(guestFile)
+			Expect(err).ToNot(HaveOccurred())
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/guestfilewrite"),
+					ghttp.VerifyBody(expectedBody),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+
+			app.GuestFileWriteHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			Expect(backend.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("should fail when the content is too large", func() {
+			setBody(&v1.GuestFile{Path: "/etc/motd", Content: make([]byte, v1.GuestFileMaxSize+1)})
+
+			app.GuestFileWriteHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+			Expect(recorder.Body.String()).To(ContainSubstring("Content must not be larger than"))
+		})
+	})
+
+	DescribeTable("should fail when the feature gate is disabled", func(handler func(*SubresourceAPIApp, *restful.Request, *restful.Response)) {
+		app = newApp()
+		setBody(&v1.GuestFile{Path: "/etc/motd"})
+
+		handler(app, request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+		Expect(recorder.Body.String()).To(ContainSubstring(featuregate.GuestFileTransferGate))
+	},
+		Entry("on read", (*SubresourceAPIApp).GuestFileReadHandler),
+		Entry("on write", (*SubresourceAPIApp).GuestFileWriteHandler),
+	)
+})
@@ -0,0 +1,136 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const (
+	defaultGuestOSExecTimeoutSeconds = 10
+	maxGuestOSExecTimeoutSeconds     = 300
+
+	// guestOSExecResponseTimeout is the time virt-handler is given to respond on top of the command timeout
+	guestOSExecResponseTimeout = 10 * time.Second
+)
+
+// GuestOSExecHandler runs a command in the guest through the guest agent and returns its exit code, stdout and stderr
+func (app *SubresourceAPIApp) GuestOSExecHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.GuestOSExecEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, featuregate.GuestOSExecGate)), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body: a command is required"), response)
+		return
+	}
+
+	opts := &v1.GuestOSExecOptions{}
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	if err := validateGuestOSExecOptions(opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	vmi, statusErr := app.fetchAndValidateVirtualMachineInstance(request.PathParameter("namespace"), request.PathParameter("name"), validateVMIGuestAgentConnected)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	// The command may run longer than the default timeout of the requests to virt-handler
+	httpClient := *app.handlerHttpClient
+	httpClient.Timeout = time.Duration(*opts.TimeoutSeconds)*time.Second + guestOSExecResponseTimeout
+	conn := kubecli.NewVirtHandlerClient(app.virtCli, &httpClient).Port(app.consoleServerPort).ForNode(vmi.Status.NodeName)
+	url, err := conn.GuestOSExecURI(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Unable to retrieve target handler URL")
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	log.Log.Object(vmi).With("user", request.Request.Header.Get(userHeader)).Infof("Running command %s in the guest", opts.Command)
+	resp, err := conn.Post(url, io.NopCloser(bytes.NewReader(body)))
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	result := v1.GuestOSExecResult{}
+	if err := json.Unmarshal([]byte(resp), &result); err != nil {
+		log.Log.Reason(err).Error("error unmarshalling response")
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	response.WriteEntity(result)
+}
+
+// Validate a VMI for guest agent commands: Running and with the guest agent connected.
+func validateVMIGuestAgentConnected(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	if vmi.Status.Phase != v1.Running {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+	}
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiGuestAgentErr))
+	}
+	return nil
+}
+
+func validateGuestOSExecOptions(opts *v1.GuestOSExecOptions) *errors.StatusError {
+	if opts.Command == "" {
+		return errors.NewBadRequest("Command is required")
+	}
+	if opts.TimeoutSeconds == nil {
+		opts.TimeoutSeconds = pointer.P(int32(defaultGuestOSExecTimeoutSeconds))
+	}
+	if *opts.TimeoutSeconds < 1 || *opts.TimeoutSeconds > maxGuestOSExecTimeoutSeconds {
+		return errors.NewBadRequest(fmt.Sprintf("TimeoutSeconds must be between 1 and %d", maxGuestOSExecTimeoutSeconds))
+	}
+	return nil
+}
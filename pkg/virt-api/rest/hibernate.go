@@ -0,0 +1,201 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+const (
+	vmHibernatedErr    = "VM is hibernated, resume it instead"
+	vmNotHibernatedErr = "VM is not hibernated"
+)
+
+// HibernateVMRequestHandler saves the memory of a running VM to a PVC and stops its VMI, so the VM keeps
+// its state without holding the resources of a node. The VMI is paused right away, virt-controller takes
+// care of dumping its memory and stopping it.
+func (app *SubresourceAPIApp) HibernateVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	// the memory is dumped to a hotplugged volume
+	if !app.clusterConfig.DeclarativeHotplugVolumesEnabled() && !app.clusterConfig.HotplugVolumesEnabled() {
+		writeError(errors.NewBadRequest(hotplugVolumeNotEnabledError), response)
+		return
+	}
+
+	bodyStruct := &v1.HibernateOptions{}
+	if request.Request.Body != nil {
+		if err := decodeBody(request, bodyStruct); err != nil {
+			writeError(err, response)
+			return
+		}
+	}
+	dryRun := len(bodyStruct.DryRun) > 0 && bodyStruct.DryRun[0] == metav1.DryRunAll
+
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if vm.Status.Hibernation != nil {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("VM is already %s", strings.ToLower(string(vm.Status.Hibernation.Phase)))), response)
+		return
+	}
+	if vm.Status.MemoryDumpRequest != nil && !memorydump.HasCompleted(vm) {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("memory dump request for pvc [%s] already in progress", vm.Status.MemoryDumpRequest.ClaimName)), response)
+		return
+	}
+
+	vmi, statusErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if statusErr := validateHibernation(vmi); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	if !dryRun && !controller.NewVirtualMachineInstanceConditionManager().HasCondition(vmi, v1.VirtualMachineInstancePaused) {
+		url, conn, statusErr := app.getVirtHandlerFor(vmi, func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+			return conn.PauseURI(vmi)
+		})
+		if statusErr != nil {
+			writeError(statusErr, response)
+			return
+		}
+		if err := conn.Put(url, nil); err != nil {
+			writeError(errors.NewInternalError(fmt.Errorf("failed to pause the VMI: %v", err)), response)
+			return
+		}
+	}
+
+	hibernation := &v1.VirtualMachineHibernation{
+		Phase:              v1.HibernationHibernating,
+		ClaimName:          hibernationClaimName(vmi),
+		StorageClassName:   bodyStruct.StorageClassName,
+		LastTransitionTime: metav1.Now(),
+	}
+	if statusErr := app.patchVMHibernation(vm, bodyStruct.DryRun,
+		patch.WithTest("/status/hibernation", nil),
+		patch.WithAdd("/status/hibernation", hibernation),
+	); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// ResumeVMRequestHandler starts a hibernated VM again from the memory saved to its hibernation PVC
+func (app *SubresourceAPIApp) ResumeVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	bodyStruct := &v1.ResumeOptions{}
+	if request.Request.Body != nil {
+		if err := decodeBody(request, bodyStruct); err != nil {
+			writeError(err, response)
+			return
+		}
+	}
+
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if vm.Status.Hibernation == nil || vm.Status.Hibernation.Phase != v1.HibernationHibernated {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(vmNotHibernatedErr)), response)
+		return
+	}
+
+	hibernation := vm.Status.Hibernation.DeepCopy()
+	hibernation.Phase = v1.HibernationResuming
+	hibernation.LastTransitionTime = metav1.Now()
+	if statusErr := app.patchVMHibernation(vm, bodyStruct.DryRun,
+		patch.WithTest("/status/hibernation", vm.Status.Hibernation),
+		patch.WithReplace("/status/hibernation", hibernation),
+	); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// validateHibernation makes sure the memory of the VMI can be saved and restored on another node
+func validateHibernation(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	if vmi.Status.Phase != v1.Running {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmNotRunning))
+	}
+	if migrations.IsMigrating(vmi) {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is migrating"))
+	}
+	if vmi.Spec.LivenessProbe != nil {
+		return errors.NewForbidden(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("Hibernating VMIs with LivenessProbe is currently not supported"))
+	}
+	if len(vmi.Spec.Domain.Devices.HostDevices) > 0 || len(vmi.Spec.Domain.Devices.GPUs) > 0 {
+		return errors.NewForbidden(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("Hibernating VMIs with host devices or GPUs is not supported"))
+	}
+	return nil
+}
+
+// hibernationClaimName is unique for every VMI, so a VMI which was resumed from a hibernation PVC
+// can be hibernated again while the previous PVC is still in use. The claim name is the name of
+// the memory dump volume as well, so it does not contain the name of the VM to stay a valid volume name.
+func hibernationClaimName(vmi *v1.VirtualMachineInstance) string {
+	return fmt.Sprintf("hibernation-%s", vmi.UID)
+}
+
+func (app *SubresourceAPIApp) patchVMHibernation(vm *v1.VirtualMachine, dryRun []string, opts ...patch.PatchOption) *errors.StatusError {
+	patchBytes, err := patch.New(opts...).GeneratePayload()
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+
+	log.Log.Object(vm).V(4).Infof(patchingVMStatusFmt, string(patchBytes))
+	_, err = app.virtCli.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: dryRun})
+	if err != nil {
+		if strings.Contains(err.Error(), jsonpatchTestErr) {
+			return errors.NewConflict(v1.Resource("virtualmachine"), vm.Name, err)
+		}
+		return errors.NewInternalError(err)
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Hibernate Subresource api", func() {
+	var (
+		request   *restful.Request
+		response  *restful.Response
+		recorder  *httptest.ResponseRecorder
+		vmClient  *kubecli.MockVirtualMachineInterface
+		vmiClient *kubecli.MockVirtualMachineInstanceInterface
+		app       *SubresourceAPIApp
+	)
+
+	newVM := func(runStrategy v1.VirtualMachineRunStrategy) *v1.VirtualMachine {
+		vm := libvmi.NewVirtualMachine(
+			libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault)),
+			libvmi.WithRunStrategy(runStrategy),
+		)
+		vm.Name = testVMName
+		return vm
+	}
+
+	newHibernatedVM := func(runStrategy v1.VirtualMachineRunStrategy) *v1.VirtualMachine {
+		vm := newVM(runStrategy)
+		vm.Status.Hibernation = &v1.VirtualMachineHibernation{
+			Phase:     v1.HibernationHibernated,
+			ClaimName: "hibernation-vmi-uid",
+		}
+		return vm
+	}
+
+	// the VMI is paused already, so virt-handler is not called
+	newPausedVMI := func() *v1.VirtualMachineInstance {
+		vmi := libvmi.New(
+			libvmi.WithName(testVMName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithPhase(v1.Running),
+				libvmistatus.WithCondition(v1.VirtualMachineInstanceCondition{Type: v1.VirtualMachineInstancePaused, Status: k8sv1.ConditionTrue}),
+			)),
+		)
+		vmi.UID = "vmi-uid"
+		return vmi
+	}
+
+	newBody := func(opts interface{}) io.ReadCloser {
+		optsJson, _ := json.Marshal(opts)
+		return &readCloserWrapper{bytes.NewReader(optsJson)}
+	}
+
+	// expectPatchStatus returns the patch operations, the last transition time is not known in advance
+	expectPatchStatus := func(vm *v1.VirtualMachine) *[]map[string]interface{} {
+		ops := &[]map[string]interface{}{}
+		vmClient.EXPECT().PatchStatus(context.Background(), vm.Name, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ types.PatchType, body []byte, _ metav1.PatchOptions) (*v1.VirtualMachine, error) {
+				Expect(json.Unmarshal(body, ops)).To(Succeed())
+				return vm, nil
+			})
+		return ops
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: []string{featuregate.HotplugVolumesGate}},
+		})
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmClient = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmClient).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+		app = NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+	})
+
+	Context("hibernate", func() {
+		It("should request the hibernation of a running VM", func() {
+			vm := newVM(v1.RunStrategyAlways)
+			vmClient.EXPECT().Get(context.Background(), vm.Name, metav1.GetOptions{}).Return(vm, nil)
+			vmiClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(newPausedVMI(), nil)
+			ops := expectPatchStatus(vm)
+
+			request.Request.Body = newBody(&v1.HibernateOptions{StorageClassName: pointer.P("local")})
+			app.HibernateVMRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+			Expect(*ops).To(HaveLen(2))
+			Expect((*ops)[0]).To(Equal(map[string]interface{}{"op": "test", "path": "/status/hibernation", "value": nil}))
+			Expect((*ops)[1]).To(HaveKeyWithValue("op", "add"))
+			Expect((*ops)[1]).To(HaveKeyWithValue("value", And(
+				HaveKeyWithValue("phase", string(v1.HibernationHibernating)),
+				HaveKeyWithValue("claimName", "hibernation-vmi-uid"),
+				HaveKeyWithValue("storageClassName", "local"),
+			)))
+		})
+
+		DescribeTable("should reject the request", func(modifyVM func(vm *v1.VirtualMachine), modifyVMI func(vmi *v1.VirtualMachineInstance), expectedCode int) {
+			vm := newVM(v1.RunStrategyAlways)
+			modifyVM(vm)
+			vmi := newPausedVMI()
+			modifyVMI(vmi)
+			vmClient.EXPECT().Get(context.Background(), vm.Name, metav1.GetOptions{}).Return(vm, nil)
+			vmiClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(vmi, nil).MaxTimes(1)
+
+			app.HibernateVMRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(expectedCode))
+		},
+			Entry("if the VM is already hibernated", func(vm *v1.VirtualMachine) {
+				vm.Status.Hibernation = &v1.VirtualMachineHibernation{Phase: v1.HibernationHibernated}
+			}, func(*v1.VirtualMachineInstance) {}, http.StatusConflict),
+			Entry("while a memory dump is in progress", func(vm *v1.VirtualMachine) {
+				vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{ClaimName: "dump", Phase: v1.MemoryDumpInProgress}
+			}, func(*v1.VirtualMachineInstance) {}, http.StatusConflict),
+			Entry("if the VMI is not running", func(*v1.VirtualMachine) {}, func(vmi *v1.VirtualMachineInstance) {
+				vmi.Status.Phase = v1.Scheduling
+			}, http.StatusConflict),
+			Entry("if the VMI is migrating", func(*v1.VirtualMachine) {}, func(vmi *v1.VirtualMachineInstance) {
+				vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{StartTimestamp: pointer.P(metav1.Now())}
+			}, http.StatusConflict),
+			Entry("if the VMI has GPUs", func(*v1.VirtualMachine) {}, func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.Devices.GPUs = []v1.GPU{{Name: "gpu", DeviceName: "nvidia.com/gpu"}}
+			}, http.StatusForbidden),
+		)
+	})
+
+	Context("resume", func() {
+		It("should resume a hibernated VM", func() {
+			vm := newHibernatedVM(v1.RunStrategyAlways)
+			vmClient.EXPECT().Get(context.Background(), vm.Name, metav1.GetOptions{}).Return(vm, nil)
+			ops := expectPatchStatus(vm)
+
+			app.ResumeVMRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+			Expect(*ops).To(HaveLen(2))
+			Expect((*ops)[0]).To(HaveKeyWithValue("op", "test"))
+			Expect((*ops)[1]).To(HaveKeyWithValue("op", "replace"))
+			Expect((*ops)[1]).To(HaveKeyWithValue("value", HaveKeyWithValue("phase", string(v1.HibernationResuming))))
+		})
+
+		It("should fail if the VM is not hibernated", func() {
+			vm := newVM(v1.RunStrategyAlways)
+			vmClient.EXPECT().Get(context.Background(), vm.Name, metav1.GetOptions{}).Return(vm, nil)
+
+			app.ResumeVMRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusConflict))
+		})
+	})
+
+	It("should not start a hibernated VM", func() {
+		vm := newHibernatedVM(v1.RunStrategyManual)
+		vmClient.EXPECT().Get(context.Background(), vm.Name, metav1.GetOptions{}).Return(vm, nil)
+		vmiClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(nil, errors.NewNotFound(v1.Resource("virtualmachineinstance"), testVMName))
+
+		app.StartVMRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusConflict))
+	})
+
+	It("should request a hibernated manual VM to be stopped", func() {
+		vm := newHibernatedVM(v1.RunStrategyManual)
+		vmClient.EXPECT().Get(context.Background(), vm.Name, metav1.GetOptions{}).Return(vm, nil)
+		vmiClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(nil, errors.NewNotFound(v1.Resource("virtualmachineinstance"), testVMName)).MaxTimes(1)
+		ops := expectPatchStatus(vm)
+
+		app.StopVMRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		Expect(*ops).To(ContainElement(HaveKeyWithValue("value", []interface{}{map[string]interface{}{"action": string(v1.StopRequest)}})))
+	})
+})
@@ -0,0 +1,132 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	storageadmitters "kubevirt.io/kubevirt/pkg/storage/admitters"
+)
+
+// VMISetIOLimitsRequestHandler updates the IO limits of a disk of a running VMI
+func (app *SubresourceAPIApp) VMISetIOLimitsRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, a disk name is expected as the request body"), response)
+		return
+	}
+
+	opts := &v1.SetIOLimitsOptions{}
+	defer request.Request.Body.Close()
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	if opts.Disk == "" {
+		writeError(errors.NewBadRequest("SetIOLimitsOptions requires disk to be set"), response)
+		return
+	}
+	if causes := storageadmitters.ValidateDiskIOLimits(k8sfield.NewPath("ioLimits"), opts.IOLimits); len(causes) > 0 {
+		writeError(errors.NewBadRequest(causes[0].Message), response)
+		return
+	}
+
+	vmi, statErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	if !vmi.IsRunning() {
+		writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf(vmiNotRunning)), response)
+		return
+	}
+
+	diskIndex := -1
+	for i, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.Name == opts.Disk {
+			diskIndex = i
+			break
+		}
+	}
+	if diskIndex < 0 {
+		writeError(errors.NewNotFound(v1.Resource("disk"), opts.Disk), response)
+		return
+	}
+
+	if statErr := app.patchVMIDiskIOLimits(vmi, diskIndex, opts.IOLimits, opts.DryRun); statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (app *SubresourceAPIApp) patchVMIDiskIOLimits(vmi *v1.VirtualMachineInstance, diskIndex int, ioLimits *v1.DiskIOLimits, dryRun []string) *errors.StatusError {
+	disk := vmi.Spec.Domain.Devices.Disks[diskIndex]
+	diskPath := fmt.Sprintf("/spec/domain/devices/disks/%d", diskIndex)
+	ioLimitsPath := diskPath + "/ioLimits"
+
+	patchSet := patch.New(
+		patch.WithTest(diskPath+"/name", disk.Name),
+		patch.WithTest(ioLimitsPath, disk.IOLimits),
+	)
+	switch {
+	case disk.IOLimits == nil && ioLimits == nil:
+		return nil
+	case disk.IOLimits == nil:
+		patchSet.AddOption(patch.WithAdd(ioLimitsPath, ioLimits))
+	case ioLimits == nil:
+		patchSet.AddOption(patch.WithRemove(ioLimitsPath))
+	default:
+		patchSet.AddOption(patch.WithReplace(ioLimitsPath, ioLimits))
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+
+	log.Log.Object(vmi).V(4).Infof("Patching VMI: %s", string(patchBytes))
+	if _, err := app.virtCli.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: dryRun}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi: %v", err)
+		if errors.IsInvalid(err) {
+			if statErr, ok := err.(*errors.StatusError); ok {
+				return statErr
+			}
+		}
+		return errors.NewInternalError(fmt.Errorf("unable to patch vmi: %v", err))
+	}
+	return nil
+}
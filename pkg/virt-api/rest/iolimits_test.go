@@ -0,0 +1,173 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Set IO limits Subresource api", func() {
+	var (
+		request   *restful.Request
+		response  *restful.Response
+		recorder  *httptest.ResponseRecorder
+		vmiClient *kubecli.MockVirtualMachineInstanceInterface
+		app       *SubresourceAPIApp
+	)
+
+	newVMI := func(phase v1.VirtualMachineInstancePhase, ioLimits *v1.DiskIOLimits) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(
+			libvmi.WithName(testVMName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithContainerDisk("boot", "image"),
+			libvmi.WithContainerDisk("data", "image"),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(phase))),
+		)
+		vmi.Spec.Domain.Devices.Disks[1].IOLimits = ioLimits
+		return vmi
+	}
+
+	newBody := func(opts interface{}) io.ReadCloser {
+		optsJson, _ := json.Marshal(opts)
+		return &readCloserWrapper{bytes.NewReader(optsJson)}
+	}
+
+	expectPatch := func(vmi *v1.VirtualMachineInstance, expectedPatch string, dryRun []string) {
+		vmiClient.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ types.PatchType, body []byte, opts metav1.PatchOptions, _ ...string) (*v1.VirtualMachineInstance, error) {
+				Expect(string(body)).To(MatchJSON(expectedPatch))
+				Expect(opts.DryRun).To(Equal(dryRun))
+				return vmi, nil
+			})
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+		app = NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+	})
+
+	It("should add the IO limits to the disk", func() {
+		vmi := newVMI(v1.Running, nil)
+		vmiClient.EXPECT().Get(context.Background(), vmi.Name, metav1.GetOptions{}).Return(vmi, nil)
+		expectPatch(vmi, `[
+			{"op":"test","path":"/spec/domain/devices/disks/1/name","value":"data"},
+			{"op":"test","path":"/spec/domain/devices/disks/1/ioLimits","value":null},
+			{"op":"add","path":"/spec/domain/devices/disks/1/ioLimits","value":{"readIOPS":1000}}
+		]`, []string{metav1.DryRunAll})
+
+		request.Request.Body = newBody(&v1.SetIOLimitsOptions{
+			Disk:     "data",
+			IOLimits: &v1.DiskIOLimits{ReadIOPS: pointer.P(int64(1000))},
+			DryRun:   []string{metav1.DryRunAll},
+		})
+		app.VMISetIOLimitsRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+	})
+
+	It("should replace the IO limits of the disk", func() {
+		vmi := newVMI(v1.Running, &v1.DiskIOLimits{ReadIOPS: pointer.P(int64(1000))})
+		vmiClient.EXPECT().Get(context.Background(), vmi.Name, metav1.GetOptions{}).Return(vmi, nil)
+		expectPatch(vmi, `[
+			{"op":"test","path":"/spec/domain/devices/disks/1/name","value":"data"},
+			{"op":"test","path":"/spec/domain/devices/disks/1/ioLimits","value":{"readIOPS":1000}},
+			{"op":"replace","path":"/spec/domain/devices/disks/1/ioLimits","value":{"writeIOPS":500}}
+		]`, nil)
+
+		request.Request.Body = newBody(&v1.SetIOLimitsOptions{
+			Disk:     "data",
+			IOLimits: &v1.DiskIOLimits{WriteIOPS: pointer.P(int64(500))},
+		})
+		app.VMISetIOLimitsRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+	})
+
+	It("should remove the IO limits of the disk", func() {
+		vmi := newVMI(v1.Running, &v1.DiskIOLimits{ReadIOPS: pointer.P(int64(1000))})
+		vmiClient.EXPECT().Get(context.Background(), vmi.Name, metav1.GetOptions{}).Return(vmi, nil)
+		expectPatch(vmi, `[
+			{"op":"test","path":"/spec/domain/devices/disks/1/name","value":"data"},
+			{"op":"test","path":"/spec/domain/devices/disks/1/ioLimits","value":{"readIOPS":1000}},
+			{"op":"remove","path":"/spec/domain/devices/disks/1/ioLimits"}
+		]`, nil)
+
+		request.Request.Body = newBody(&v1.SetIOLimitsOptions{Disk: "data"})
+		app.VMISetIOLimitsRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+	})
+
+	DescribeTable("should reject an invalid request", func(opts *v1.SetIOLimitsOptions, expectedErr string) {
+		request.Request.Body = newBody(opts)
+		app.VMISetIOLimitsRequestHandler(request, response)
+		statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		Expect(statusErr.Error()).To(Equal(expectedErr))
+	},
+		Entry("without a disk", &v1.SetIOLimitsOptions{},
+			"SetIOLimitsOptions requires disk to be set"),
+		Entry("with invalid IO limits", &v1.SetIOLimitsOptions{Disk: "data", IOLimits: &v1.DiskIOLimits{ReadIOPS: pointer.P(int64(-1))}},
+			"ioLimits.readIOPS must not be negative"),
+	)
+
+	It("should fail when the disk does not exist", func() {
+		vmi := newVMI(v1.Running, nil)
+		vmiClient.EXPECT().Get(context.Background(), vmi.Name, metav1.GetOptions{}).Return(vmi, nil)
+		request.Request.Body = newBody(&v1.SetIOLimitsOptions{Disk: "missing"})
+		app.VMISetIOLimitsRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusNotFound)
+	})
+
+	It("should conflict when the VMI is not running", func() {
+		vmi := newVMI(v1.Scheduled, nil)
+		vmiClient.EXPECT().Get(context.Background(), vmi.Name, metav1.GetOptions{}).Return(vmi, nil)
+		request.Request.Body = newBody(&v1.SetIOLimitsOptions{Disk: "data"})
+		app.VMISetIOLimitsRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+	})
+})
@@ -35,6 +35,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 
@@ -67,6 +68,10 @@ func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, re
 		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(volumeMigrationManualRecoveryRequiredErr)), response)
 		return
 	}
+	if vm.Status.Hibernation != nil {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(vmHibernatedErr)), response)
+		return
+	}
 
 	startPaused := false
 	startChangeRequestData := make(map[string]string)
@@ -81,6 +86,17 @@ func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, re
 	if startPaused {
 		startChangeRequestData[v1.StartRequestDataPausedKey] = v1.StartRequestDataPausedTrue
 	}
+	if err := validateStartOnceOverrides(vm, bodyStruct); err != nil {
+		writeError(err, response)
+		return
+	}
+	hasStartOnceOverrides := bodyStruct.BootFromCDROMOnce != "" || bodyStruct.KernelArgsOnce != ""
+	if bodyStruct.BootFromCDROMOnce != "" {
+		startChangeRequestData[v1.StartRequestDataBootFromCDROMOnceKey] = bodyStruct.BootFromCDROMOnce
+	}
+	if bodyStruct.KernelArgsOnce != "" {
+		startChangeRequestData[v1.StartRequestDataKernelArgsOnceKey] = bodyStruct.KernelArgsOnce
+	}
 
 	var patchErr error
 
@@ -97,9 +113,9 @@ func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, re
 	switch runStrategy {
 	case v1.RunStrategyHalted:
 		pausedStartStrategy := v1.StartStrategyPaused
-		// Send start request if VM should start paused. virt-controller will update RunStrategy upon this request.
+		// Send start request if VM should start paused or with one-shot overrides. virt-controller will update RunStrategy upon this request.
 		// No need to send the request if StartStrategy is already set to Paused in VMI Spec.
-		if startPaused && (vm.Spec.Template == nil || vm.Spec.Template.Spec.StartStrategy != &pausedStartStrategy) {
+		if hasStartOnceOverrides || startPaused && (vm.Spec.Template == nil || vm.Spec.Template.Spec.StartStrategy != &pausedStartStrategy) {
 			patchBytes, err := getChangeRequestJson(vm, v1.VirtualMachineStateChangeRequest{
 				Action: v1.StartRequest,
 				Data:   startChangeRequestData,
@@ -162,6 +178,38 @@ func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, re
 	response.WriteHeader(http.StatusAccepted)
 }
 
+// validateStartOnceOverrides makes sure the one-shot boot overrides of a start request can be applied to the VM
+func validateStartOnceOverrides(vm *v1.VirtualMachine, options *v1.StartOptions) *errors.StatusError {
+	if options.BootFromCDROMOnce == "" && options.KernelArgsOnce == "" {
+		return nil
+	}
+	if vm.Spec.Template == nil {
+		return errors.NewBadRequest("boot overrides require a VM template")
+	}
+
+	if options.BootFromCDROMOnce != "" {
+		found := false
+		for _, disk := range vm.Spec.Template.Spec.Domain.Devices.Disks {
+			if disk.Name == options.BootFromCDROMOnce && disk.CDRom != nil {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.NewBadRequest(fmt.Sprintf("VM has no CD-ROM disk named %s", options.BootFromCDROMOnce))
+		}
+	}
+
+	if options.KernelArgsOnce != "" {
+		firmware := vm.Spec.Template.Spec.Domain.Firmware
+		if firmware == nil || firmware.KernelBoot == nil || firmware.KernelBoot.Container == nil {
+			return errors.NewBadRequest("kernel arguments can only be overridden for VMs with an external kernel")
+		}
+	}
+
+	return nil
+}
+
 func (app *SubresourceAPIApp) StopVMRequestHandler(request *restful.Request, response *restful.Response) {
 	// RunStrategyHalted         -> force stop if grace period in request is shorter than before, otherwise doesn't make sense
 	// RunStrategyManual         -> send stop request
@@ -244,6 +292,17 @@ func (app *SubresourceAPIApp) StopVMRequestHandler(request *restful.Request, res
 			return
 		}
 	case v1.RunStrategyRerunOnFailure, v1.RunStrategyManual:
+		if vm.Status.Hibernation != nil && vm.Status.Hibernation.Phase == v1.HibernationHibernated {
+			// there is no VMI to stop, ask virt-controller to discard the memory of the hibernated VM
+			patchBytes, err := getChangeRequestJson(vm, v1.VirtualMachineStateChangeRequest{Action: v1.StopRequest})
+			if err != nil {
+				writeError(errors.NewInternalError(err), response)
+				return
+			}
+			log.Log.Object(vm).V(4).Infof(patchingVMStatusFmt, string(patchBytes))
+			_, patchErr = app.virtCli.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: bodyStruct.DryRun})
+			break
+		}
 		if !hasVMI || vmi.IsFinal() {
 			writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(vmNotRunning)), response)
 			return
@@ -551,7 +610,7 @@ func (app *SubresourceAPIApp) MigrateVMRequestHandler(request *restful.Request,
 	}
 
 	createMigrationJob := func() *errors.StatusError {
-		_, err := app.virtCli.VirtualMachineInstanceMigration(namespace).Create(context.Background(), &v1.VirtualMachineInstanceMigration{
+		migration := &v1.VirtualMachineInstanceMigration{
 			ObjectMeta: metav1.ObjectMeta{
 				GenerateName: "kubevirt-migrate-vm-",
 			},
@@ -559,7 +618,11 @@ func (app *SubresourceAPIApp) MigrateVMRequestHandler(request *restful.Request,
 				VMIName:           name,
 				AddedNodeSelector: bodyStruct.AddedNodeSelector,
 			},
-		}, metav1.CreateOptions{DryRun: bodyStruct.DryRun})
+		}
+		if bodyStruct.Warm {
+			migration.Spec.Warm = &v1.WarmMigration{}
+		}
+		_, err := app.virtCli.VirtualMachineInstanceMigration(namespace).Create(context.Background(), migration, metav1.CreateOptions{DryRun: bodyStruct.DryRun})
 		if err != nil {
 			return errors.NewInternalError(err)
 		}
@@ -574,6 +637,156 @@ func (app *SubresourceAPIApp) MigrateVMRequestHandler(request *restful.Request,
 	response.WriteHeader(http.StatusAccepted)
 }
 
+// RestoreVMRequestHandler reverts a VM to one of its snapshots in a single request, by creating a
+// VirtualMachineRestore which stops the VM and, when requested, starts it once the restore completed
+func (app *SubresourceAPIApp) RestoreVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body"), response)
+		return
+	}
+	bodyStruct := &v1.RestoreOptions{}
+	if err := decodeBody(request, bodyStruct); err != nil {
+		writeError(err, response)
+		return
+	}
+	if bodyStruct.VirtualMachineSnapshotName == "" {
+		writeError(errors.NewBadRequest("Restore requires the VirtualMachineSnapshot name to be set"), response)
+		return
+	}
+
+	if _, err := app.fetchVirtualMachine(name, namespace); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	vmRestore := &snapshotv1.VirtualMachineRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "kubevirt-restore-vm-",
+		},
+		Spec: snapshotv1.VirtualMachineRestoreSpec{
+			Target: k8sv1.TypedLocalObjectReference{
+				APIGroup: pointer.P(v1.GroupVersion.Group),
+				Kind:     "VirtualMachine",
+				Name:     name,
+			},
+			VirtualMachineSnapshotName: bodyStruct.VirtualMachineSnapshotName,
+			TargetReadinessPolicy:      pointer.P(snapshotv1.VirtualMachineRestoreStopTarget),
+		},
+	}
+	if bodyStruct.Start {
+		vmRestore.Spec.StartVirtualMachine = pointer.P(true)
+	}
+
+	_, err := app.virtCli.VirtualMachineRestore(namespace).Create(context.Background(), vmRestore, metav1.CreateOptions{DryRun: bodyStruct.DryRun})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsInvalid(err) {
+			writeError(statusErr, response)
+			return
+		}
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// ApplyChangesVMRequestHandler applies the changes staged on a VM with the Staged change apply strategy,
+// either by restarting its VMI or by requesting the VM controller to hotplug them or live migrate the VMI
+func (app *SubresourceAPIApp) ApplyChangesVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body"), response)
+		return
+	}
+	bodyStruct := &v1.ApplyChangesOptions{}
+	if err := decodeBody(request, bodyStruct); err != nil {
+		writeError(err, response)
+		return
+	}
+	switch bodyStruct.Method {
+	case v1.ApplyChangesMethodRestart, v1.ApplyChangesMethodLiveMigrate, v1.ApplyChangesMethodHotplug:
+	default:
+		writeError(errors.NewBadRequest(fmt.Sprintf("unsupported apply method %q, must be one of %s, %s or %s", bodyStruct.Method,
+			v1.ApplyChangesMethodRestart, v1.ApplyChangesMethodLiveMigrate, v1.ApplyChangesMethodHotplug)), response)
+		return
+	}
+
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if vm.Spec.ChangeApplyStrategy == nil || *vm.Spec.ChangeApplyStrategy != v1.ChangeApplyStrategyStaged {
+		writeError(errors.NewBadRequest(fmt.Sprintf("VM does not use the %s change apply strategy", v1.ChangeApplyStrategyStaged)), response)
+		return
+	}
+	if vm.Status.StagedChanges == nil {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("VM has no staged changes")), response)
+		return
+	}
+
+	vmi, err := app.virtCli.VirtualMachineInstance(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			writeError(errors.NewInternalError(err), response)
+			return
+		}
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(vmNotRunning)), response)
+		return
+	}
+
+	var patchBytes []byte
+	if bodyStruct.Method == v1.ApplyChangesMethodRestart {
+		runStrategy, err := vm.RunStrategy()
+		if err != nil {
+			writeError(errors.NewInternalError(err), response)
+			return
+		}
+		if runStrategy == v1.RunStrategyHalted || runStrategy == v1.RunStrategyOnce {
+			writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("RunStategy %v does not support manual restart requests", runStrategy)), response)
+			return
+		}
+		patchBytes, err = getChangeRequestJson(vm,
+			v1.VirtualMachineStateChangeRequest{Action: v1.StopRequest, UID: &vmi.UID},
+			v1.VirtualMachineStateChangeRequest{Action: v1.StartRequest})
+		if err != nil {
+			writeError(errors.NewInternalError(err), response)
+			return
+		}
+	} else {
+		if vm.Status.StagedChanges.RestartRequired {
+			writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("staged changes require a restart")), response)
+			return
+		}
+		patchBytes, err = patch.New(
+			patch.WithTest("/status/stagedChanges", vm.Status.StagedChanges),
+			patch.WithAdd("/status/stagedChanges/applyMethod", bodyStruct.Method),
+		).GeneratePayload()
+		if err != nil {
+			writeError(errors.NewInternalError(err), response)
+			return
+		}
+	}
+
+	log.Log.Object(vm).V(4).Infof(patchingVMFmt, string(patchBytes))
+	_, err = app.virtCli.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: bodyStruct.DryRun})
+	if err != nil {
+		if strings.Contains(err.Error(), jsonpatchTestErr) {
+			writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, err), response)
+		} else {
+			writeError(errors.NewInternalError(err), response)
+		}
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
 func (app *SubresourceAPIApp) findPod(namespace string, vmi *v1.VirtualMachineInstance) (string, error) {
 	fieldSelector := fields.ParseSelectorOrDie("status.phase==" + string(k8sv1.PodRunning))
 	labelSelector, err := labels.Parse(fmt.Sprintf(v1.AppLabel + "=virt-launcher," + v1.CreatedByLabel + "=" + string(vmi.UID)))
@@ -618,6 +831,14 @@ func (app *SubresourceAPIApp) patchVMStatusStopped(vmi *v1.VirtualMachineInstanc
 }
 
 func getChangeRequestJson(vm *v1.VirtualMachine, changes ...v1.VirtualMachineStateChangeRequest) ([]byte, error) {
+	patchSet, err := getChangeRequestPatchSet(vm, changes...)
+	if err != nil {
+		return nil, err
+	}
+	return patchSet.GeneratePayload()
+}
+
+func getChangeRequestPatchSet(vm *v1.VirtualMachine, changes ...v1.VirtualMachineStateChangeRequest) (*patch.PatchSet, error) {
 	patchSet := patch.New()
 	// Special case: if there's no status field at all, add one.
 	newStatus := v1.VirtualMachineStatus{}
@@ -641,7 +862,7 @@ func getChangeRequestJson(vm *v1.VirtualMachine, changes ...v1.VirtualMachineSta
 		patchSet.AddOption(patch.WithRemove("/status/startFailure"))
 	}
 
-	return patchSet.GeneratePayload()
+	return patchSet, nil
 }
 
 func getRunningPatch(vm *v1.VirtualMachine, running bool) ([]byte, error) {
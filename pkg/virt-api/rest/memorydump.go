@@ -45,8 +45,43 @@ const (
 	pvcAccessModeErr          = "pvc access mode can't be read only"
 	pvcSizeErrFmt             = "pvc size [%s] should be bigger then [%s]"
 	memoryDumpNameConflictErr = "can't request memory dump for pvc [%s] while pvc [%s] is still associated as the memory dump pvc"
+	memoryDumpFormatErrFmt    = "unsupported memory dump format [%s]"
+	memoryDumpContentErrFmt   = "unsupported memory dump content [%s]"
+	memoryDumpKernelErr       = "kernel memory dumps only support the Raw format"
+	memoryDumpStreamStateErr  = "the State format can not be streamed"
 )
 
+// validateMemoryDumpOptions checks the format and content of a memory dump, kernel dumps are taken
+// through QEMU which only writes them in the Raw format
+func validateMemoryDumpOptions(format v1.MemoryDumpFormat, content v1.MemoryDumpContent) *errors.StatusError {
+	switch format {
+	case "", v1.MemoryDumpFormatRaw, v1.MemoryDumpFormatState,
+		v1.MemoryDumpFormatKdumpZlib, v1.MemoryDumpFormatKdumpLzo, v1.MemoryDumpFormatKdumpSnappy:
+	default:
+		return errors.NewBadRequest(fmt.Sprintf(memoryDumpFormatErrFmt, format))
+	}
+	switch content {
+	case "", v1.MemoryDumpContentFull:
+	case v1.MemoryDumpContentKernel:
+		if format != "" && format != v1.MemoryDumpFormatRaw {
+			return errors.NewBadRequest(memoryDumpKernelErr)
+		}
+	default:
+		return errors.NewBadRequest(fmt.Sprintf(memoryDumpContentErrFmt, content))
+	}
+	return nil
+}
+
+// isFullMemoryDump tells whether the dump is as large as the guest memory, compressed and kernel dumps
+// are smaller by an amount which is only known once they are taken
+func isFullMemoryDump(format v1.MemoryDumpFormat, content v1.MemoryDumpContent) bool {
+	switch format {
+	case v1.MemoryDumpFormatKdumpZlib, v1.MemoryDumpFormatKdumpLzo, v1.MemoryDumpFormatKdumpSnappy:
+		return false
+	}
+	return content != v1.MemoryDumpContentKernel
+}
+
 func (app *SubresourceAPIApp) fetchPersistentVolumeClaim(name string, namespace string) (*k8sv1.PersistentVolumeClaim, *errors.StatusError) {
 	pvc, err := app.virtCli.CoreV1().PersistentVolumeClaims(namespace).Get(context.TODO(), name, metav1.GetOptions{})
 	if err != nil {
@@ -69,7 +104,7 @@ func (app *SubresourceAPIApp) fetchCDIConfig() (*cdiv1.CDIConfig, *errors.Status
 	return cdiConfig, nil
 }
 
-func (app *SubresourceAPIApp) validateMemoryDumpClaim(vmi *v1.VirtualMachineInstance, claimName, namespace string) *errors.StatusError {
+func (app *SubresourceAPIApp) validateMemoryDumpClaim(vmi *v1.VirtualMachineInstance, claimName, namespace string, fullDump bool) *errors.StatusError {
 	pvc, err := app.fetchPersistentVolumeClaim(claimName, namespace)
 	if err != nil {
 		return err
@@ -82,6 +117,10 @@ func (app *SubresourceAPIApp) validateMemoryDumpClaim(vmi *v1.VirtualMachineInst
 		return errors.NewConflict(v1.Resource("persistentvolumeclaim"), claimName, fmt.Errorf(pvcAccessModeErr))
 	}
 
+	if !fullDump {
+		return nil
+	}
+
 	pvcSize := pvc.Spec.Resources.Requests.Storage()
 	scaledPvcSize := resource.NewScaledQuantity(pvcSize.ScaledValue(resource.Kilo), resource.Kilo)
 
@@ -109,6 +148,9 @@ func (app *SubresourceAPIApp) validateMemoryDumpClaim(vmi *v1.VirtualMachineInst
 }
 
 func (app *SubresourceAPIApp) validateMemoryDumpRequest(vm *v1.VirtualMachine, memoryDumpReq *v1.VirtualMachineMemoryDumpRequest) *errors.StatusError {
+	if statErr := validateMemoryDumpOptions(memoryDumpReq.Format, memoryDumpReq.Content); statErr != nil {
+		return statErr
+	}
 	if memoryDumpReq.ClaimName == "" && vm.Status.MemoryDumpRequest == nil {
 		return errors.NewBadRequest("Memory dump requires claim name to be set")
 	} else if vm.Status.MemoryDumpRequest != nil && memoryDumpReq.ClaimName != "" {
@@ -128,7 +170,7 @@ func (app *SubresourceAPIApp) validateMemoryDumpRequest(vm *v1.VirtualMachine, m
 		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vm.Name, fmt.Errorf(vmiNotRunning))
 	}
 
-	if statErr = app.validateMemoryDumpClaim(vmi, memoryDumpReq.ClaimName, vm.Namespace); statErr != nil {
+	if statErr = app.validateMemoryDumpClaim(vmi, memoryDumpReq.ClaimName, vm.Namespace, isFullMemoryDump(memoryDumpReq.Format, memoryDumpReq.Content)); statErr != nil {
 		return statErr
 	}
 
@@ -251,6 +251,23 @@ var _ = Describe("Memory dump Subresource api", func() {
 		Entry("VM with a memory dump request pvc size too small should fail", &v1.VirtualMachineMemoryDumpRequest{
 			ClaimName: testPVCName,
 		}, http.StatusConflict, true, true, createTestPVC("1Gi", fs, notReadOnly)),
+		Entry("VM with a compressed memory dump request to a pvc smaller than the guest memory should succeed", &v1.VirtualMachineMemoryDumpRequest{
+			ClaimName: testPVCName,
+			Format:    v1.MemoryDumpFormatKdumpZlib,
+		}, http.StatusAccepted, true, true, createTestPVC("1Gi", fs, notReadOnly)),
+		Entry("VM with a kernel memory dump request to a pvc smaller than the guest memory should succeed", &v1.VirtualMachineMemoryDumpRequest{
+			ClaimName: testPVCName,
+			Content:   v1.MemoryDumpContentKernel,
+		}, http.StatusAccepted, true, true, createTestPVC("1Gi", fs, notReadOnly)),
+		Entry("VM with a compressed kernel memory dump request should fail", &v1.VirtualMachineMemoryDumpRequest{
+			ClaimName: testPVCName,
+			Format:    v1.MemoryDumpFormatKdumpLzo,
+			Content:   v1.MemoryDumpContentKernel,
+		}, http.StatusBadRequest, true, true, createTestPVC("2Gi", fs, notReadOnly)),
+		Entry("VM with a memory dump request with an unknown format should fail", &v1.VirtualMachineMemoryDumpRequest{
+			ClaimName: testPVCName,
+			Format:    "Elf",
+		}, http.StatusBadRequest, true, true, createTestPVC("2Gi", fs, notReadOnly)),
 	)
 
 	DescribeTable("With memory dump request", func(memDumpReq, prevMemDumpReq *v1.VirtualMachineMemoryDumpRequest, statusCode int) {
@@ -0,0 +1,60 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+// MemoryDumpStreamRequestHandler streams a memory dump of the VMI to the client, without a volume to hold it.
+// The outcome of the dump is reported by the close message of the websocket.
+func (app *SubresourceAPIApp) MemoryDumpStreamRequestHandler(request *restful.Request, response *restful.Response) {
+	format := v1.MemoryDumpFormat(request.QueryParameter("format"))
+	content := v1.MemoryDumpContent(request.QueryParameter("content"))
+
+	streamer := NewRawStreamer(
+		app.FetchVirtualMachineInstance,
+		func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+			return validateVMIForMemoryDumpStream(vmi, format, content)
+		},
+		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+			return conn.MemoryDumpURI(vmi, string(format), string(content))
+		}),
+	)
+
+	streamer.Handle(request, response)
+}
+
+func validateVMIForMemoryDumpStream(vmi *v1.VirtualMachineInstance, format v1.MemoryDumpFormat, content v1.MemoryDumpContent) *errors.StatusError {
+	if format == v1.MemoryDumpFormatState {
+		return errors.NewBadRequest(memoryDumpStreamStateErr)
+	}
+	if statErr := validateMemoryDumpOptions(format, content); statErr != nil {
+		return statErr
+	}
+	if !vmi.IsRunning() {
+		return errors.NewBadRequest(vmiNotRunning)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+)
+
+var _ = Describe("Memory dump stream Subresource api", func() {
+	newVMI := func(phase v1.VirtualMachineInstancePhase) *v1.VirtualMachineInstance {
+		return libvmi.New(
+			libvmi.WithName(testVMIName),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(phase))),
+		)
+	}
+
+	DescribeTable("should validate memory dump stream requests", func(phase v1.VirtualMachineInstancePhase, format v1.MemoryDumpFormat, content v1.MemoryDumpContent, expectedMessage string) {
+		err := validateVMIForMemoryDumpStream(newVMI(phase), format, content)
+		if expectedMessage == "" {
+			Expect(err).To(BeNil())
+		} else {
+			Expect(err).To(MatchError(expectedMessage))
+		}
+	},
+		Entry("should accept a full dump", v1.Running, v1.MemoryDumpFormat(""), v1.MemoryDumpContent(""), ""),
+		Entry("should accept a compressed dump", v1.Running, v1.MemoryDumpFormatKdumpSnappy, v1.MemoryDumpContentFull, ""),
+		Entry("should accept a kernel dump", v1.Running, v1.MemoryDumpFormatRaw, v1.MemoryDumpContentKernel, ""),
+		Entry("should fail to stream the memory state", v1.Running, v1.MemoryDumpFormatState, v1.MemoryDumpContent(""), memoryDumpStreamStateErr),
+		Entry("should fail with a compressed kernel dump", v1.Running, v1.MemoryDumpFormatKdumpZlib, v1.MemoryDumpContentKernel, memoryDumpKernelErr),
+		Entry("should fail with an unknown content", v1.Running, v1.MemoryDumpFormatRaw, v1.MemoryDumpContent("User"), "unsupported memory dump content [User]"),
+		Entry("should fail if the VMI is not running", v1.Scheduling, v1.MemoryDumpFormatRaw, v1.MemoryDumpContentFull, vmiNotRunning),
+	)
+})
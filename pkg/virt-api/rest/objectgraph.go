@@ -351,6 +351,7 @@ func (og *ObjectGraph) addVolumeGraph(obj any, namespace string) ([]v1.ObjectGra
 					nodes = append(nodes, *node)
 				}
 			}
+			nodes = append(nodes, og.getCloudInitUserDataSourceNodes(volume.CloudInitNoCloud.UserDataSources, namespace)...)
 		case volume.CloudInitConfigDrive != nil:
 			if volume.CloudInitConfigDrive.UserDataSecretRef != nil {
 				node := og.newGraphNode(volume.CloudInitConfigDrive.UserDataSecretRef.Name, namespace, "secrets", nil, false)
@@ -364,11 +365,36 @@ func (og *ObjectGraph) addVolumeGraph(obj any, namespace string) ([]v1.ObjectGra
 					nodes = append(nodes, *node)
 				}
 			}
+			nodes = append(nodes, og.getCloudInitUserDataSourceNodes(volume.CloudInitConfigDrive.UserDataSources, namespace)...)
+		case volume.Ignition != nil:
+			if volume.Ignition.SecretRef != nil {
+				node := og.newGraphNode(volume.Ignition.SecretRef.Name, namespace, "secrets", nil, false)
+				if node != nil {
+					nodes = append(nodes, *node)
+				}
+			}
 		}
 	}
 	return nodes, err
 }
 
+func (og *ObjectGraph) getCloudInitUserDataSourceNodes(sources []v1.CloudInitUserDataSource, namespace string) []v1.ObjectGraphNode {
+	var nodes []v1.ObjectGraphNode
+	for _, source := range sources {
+		var node *v1.ObjectGraphNode
+		switch {
+		case source.SecretRef != nil:
+			node = og.newGraphNode(source.SecretRef.Name, namespace, "secrets", nil, false)
+		case source.ConfigMapRef != nil:
+			node = og.newGraphNode(source.ConfigMapRef.Name, namespace, "configmaps", nil, false)
+		}
+		if node != nil {
+			nodes = append(nodes, *node)
+		}
+	}
+	return nodes
+}
+
 func (og *ObjectGraph) getLauncherPodNode(name, namespace string) (*v1.ObjectGraphNode, error) {
 	pods, err := og.client.CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{
 		LabelSelector: fmt.Sprintf("%s=%s", v1.AppLabel, "virt-launcher"),
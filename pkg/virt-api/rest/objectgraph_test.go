@@ -379,6 +379,50 @@ var _ = Describe("Object Graph", func() {
 			Expect(childMap["vmi-root-pvc"]).To(Equal("PersistentVolumeClaim"))
 		})
 
+		It("should include cloud-init user data sources and Ignition secrets in the graph", func() {
+			vmi := &v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-vmi",
+					Namespace: "test-namespace",
+				},
+				Spec: v1.VirtualMachineInstanceSpec{
+					Volumes: []v1.Volume{
+						{
+							Name: "cloudinitdisk",
+							VolumeSource: v1.VolumeSource{
+								CloudInitNoCloud: &v1.CloudInitNoCloudSource{
+									UserDataSources: []v1.CloudInitUserDataSource{
+										{SecretRef: &k8sv1.LocalObjectReference{Name: "users-secret"}},
+										{ConfigMapRef: &k8sv1.LocalObjectReference{Name: "packages-configmap"}},
+									},
+								},
+							},
+						},
+						{
+							Name: "ignition",
+							VolumeSource: v1.VolumeSource{
+								Ignition: &v1.IgnitionSource{
+									SecretRef: &k8sv1.LocalObjectReference{Name: "ignition-secret"},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			graph := NewObjectGraph(kvClient, &v1.ObjectGraphOptions{})
+			graphNodes, err := graph.GetObjectGraph(vmi)
+			Expect(err).NotTo(HaveOccurred())
+
+			childMap := make(map[string]string)
+			for _, child := range graphNodes.Children {
+				childMap[child.ObjectReference.Name] = child.ObjectReference.Kind
+			}
+			Expect(childMap).To(HaveKeyWithValue("users-secret", "Secret"))
+			Expect(childMap).To(HaveKeyWithValue("packages-configmap", "ConfigMap"))
+			Expect(childMap).To(HaveKeyWithValue("ignition-secret", "Secret"))
+		})
+
 		It("should handle error when listing pods", func() {
 			vm.Status.Created = true
 			kubeClient.Fake.PrependReactor("list", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
@@ -1,15 +1,22 @@
 package rest
 
 import (
+	"context"
 	"fmt"
+	"net"
+	"strconv"
 
 	restful "github.com/emicklei/go-restful/v3"
 	"k8s.io/apimachinery/pkg/api/errors"
 
 	v1 "kubevirt.io/api/core/v1"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/controller"
 	apimetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
+	"kubevirt.io/kubevirt/pkg/util/net/multiplexer"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
 )
 
 func (app *SubresourceAPIApp) PortForwardRequestHandler(fetcher vmiFetcher) restful.RouteFunction {
@@ -29,6 +36,56 @@ func (app *SubresourceAPIApp) PortForwardRequestHandler(fetcher vmiFetcher) rest
 	}
 }
 
+// PortForwardMultiplexedRequestHandler forwards TCP streams to several ports of the VMI, multiplexed over a
+// single websocket connection
+func (app *SubresourceAPIApp) PortForwardMultiplexedRequestHandler(fetcher vmiFetcher) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		namespace := request.PathParameter(definitions.NamespaceParamName)
+		name := request.PathParameter(definitions.NameParamName)
+
+		activeTunnelMetric := apimetrics.NewActivePortForwardTunnel(namespace, name)
+		defer activeTunnelMetric.Dec()
+
+		defer apimetrics.SetVMILastConnectionTimestamp(namespace, name)
+
+		vmi, statusErr := fetcher(namespace, name)
+		if statusErr != nil {
+			writeError(statusErr, response)
+			return
+		}
+		if statusErr := validateVMIForPortForward(vmi); statusErr != nil {
+			writeError(statusErr, response)
+			return
+		}
+
+		clientConn, err := clientConnectionUpgrade(request, response)
+		if err != nil {
+			writeError(errors.NewBadRequest(err.Error()), response)
+			return
+		}
+
+		ctx, cancel := context.WithCancel(request.Request.Context())
+		defer cancel()
+		go keepAliveClientStream(ctx, clientConn, cancel)
+
+		session := multiplexer.NewServer(kvcorev1.NewWebsocketStreamer(clientConn, nil).AsConn(), func(port int) (net.Conn, error) {
+			conn, statusErr := dialVMI(vmi, strconv.Itoa(port), "tcp")
+			if statusErr != nil {
+				return nil, statusErr
+			}
+			return conn, nil
+		})
+		go func() {
+			<-ctx.Done()
+			session.Close()
+		}()
+
+		if err := session.Serve(); err != nil {
+			log.Log.Object(vmi).V(3).Infof("Multiplexed port-forward session closed: %v", err)
+		}
+	}
+}
+
 func validateVMIForPortForward(vmi *v1.VirtualMachineInstance) *errors.StatusError {
 	condManager := controller.NewVirtualMachineInstanceConditionManager()
 	if condManager.HasCondition(vmi, v1.VirtualMachineInstancePaused) {
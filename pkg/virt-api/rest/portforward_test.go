@@ -20,8 +20,11 @@
 package rest
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
@@ -39,8 +42,11 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/util/net/multiplexer"
 )
 
 var _ = Describe("PortForward Subresource api", func() {
@@ -127,4 +133,64 @@ var _ = Describe("PortForward Subresource api", func() {
 		app.PortForwardRequestHandler(app.FetchVirtualMachineInstance)(request, response)
 		ExpectStatusErrorWithCode(recorder, http.StatusInternalServerError)
 	})
+
+	Context("multiplexed", func() {
+		It("should fail if vmi is not found", func() {
+			request.PathParameters()["name"] = testVMIName
+			request.PathParameters()["namespace"] = metav1.NamespaceDefault
+
+			app.PortForwardMultiplexedRequestHandler(app.FetchVirtualMachineInstance)(request, response)
+			ExpectStatusErrorWithCode(recorder, http.StatusNotFound)
+		})
+
+		It("should forward the streams to the ports of the VMI", func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+			defer listener.Close()
+			go func() {
+				for {
+					conn, err := listener.Accept()
+					if err != nil {
+						return
+					}
+					go func() {
+						defer conn.Close()
+						io.Copy(conn, conn)
+					}()
+				}
+			}()
+
+			vmi := libvmi.New(libvmi.WithName(testVMIName), libvmi.WithNamespace(metav1.NamespaceDefault))
+			vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{IP: "127.0.0.1"}}
+			_, err = virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			srv, ws, _, err := testWebsocketDial(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				request := restful.NewRequest(r)
+				request.PathParameters()["name"] = testVMIName
+				request.PathParameters()["namespace"] = metav1.NamespaceDefault
+				app.PortForwardMultiplexedRequestHandler(app.FetchVirtualMachineInstance)(request, restful.NewResponse(rw))
+			}))
+			Expect(err).ToNot(HaveOccurred())
+			defer srv.Close()
+
+			session := multiplexer.NewClient(kvcorev1.NewWebsocketStreamer(ws, nil).AsConn())
+			defer session.Close()
+			go session.Serve()
+
+			port := listener.Addr().(*net.TCPAddr).Port
+			for _, message := range []string{"first", "second"} {
+				stream, err := session.Open(port)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = stream.Write([]byte(message))
+				Expect(err).ToNot(HaveOccurred())
+				data := make([]byte, len(message))
+				_, err = io.ReadFull(stream, data)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(data)).To(Equal(message))
+				Expect(stream.Close()).To(Succeed())
+			}
+		})
+	})
 })
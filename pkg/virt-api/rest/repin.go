@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+)
+
+// VMIRepinVCPUsRequestHandler requests to re-pin the vCPUs of a running VMI with dedicated CPUs
+func (app *SubresourceAPIApp) VMIRepinVCPUsRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, a vCPU pinning is expected as the request body"), response)
+		return
+	}
+
+	opts := &v1.RepinVCPUsOptions{}
+	defer request.Request.Body.Close()
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	if err := validateRepinVCPUsOptions(opts); err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	vmi, statErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	if !vmi.IsRunning() {
+		writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf(vmiNotRunning)), response)
+		return
+	}
+
+	if !vmi.IsCPUDedicated() || vmi.Status.VCPUPinning == nil {
+		writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf("the vCPUs of the VMI are not pinned")), response)
+		return
+	}
+
+	pinned := map[uint32]bool{}
+	for _, pin := range vmi.Status.VCPUPinning.VCPUs {
+		pinned[pin.VCPU] = true
+	}
+	for _, pin := range opts.Pinning {
+		if !pinned[pin.VCPU] {
+			writeError(errors.NewBadRequest(fmt.Sprintf("vCPU %d is not pinned", pin.VCPU)), response)
+			return
+		}
+	}
+
+	if statErr := app.patchVMIRequestedVCPUPinning(vmi, opts.Pinning, opts.DryRun); statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func validateRepinVCPUsOptions(opts *v1.RepinVCPUsOptions) error {
+	if len(opts.Pinning) == 0 {
+		return fmt.Errorf("RepinVCPUsOptions requires pinning to be set")
+	}
+	vcpus := map[uint32]bool{}
+	for _, pin := range opts.Pinning {
+		if vcpus[pin.VCPU] {
+			return fmt.Errorf("vCPU %d is pinned more than once", pin.VCPU)
+		}
+		vcpus[pin.VCPU] = true
+		if _, err := hardware.ParseCPUSetLine(pin.CPUSet, 50000); err != nil {
+			return fmt.Errorf("invalid cpuSet %q of vCPU %d: %v", pin.CPUSet, pin.VCPU, err)
+		}
+	}
+	return nil
+}
+
+func (app *SubresourceAPIApp) patchVMIRequestedVCPUPinning(vmi *v1.VirtualMachineInstance, pinning []v1.VCPUPin, dryRun []string) *errors.StatusError {
+	const requestedPath = "/status/vcpuPinning/requested"
+
+	patchSet := patch.New(patch.WithTest(requestedPath, vmi.Status.VCPUPinning.Requested))
+	if vmi.Status.VCPUPinning.Requested == nil {
+		patchSet.AddOption(patch.WithAdd(requestedPath, pinning))
+	} else {
+		patchSet.AddOption(patch.WithReplace(requestedPath, pinning))
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+
+	log.Log.Object(vmi).V(4).Infof("Patching VMI: %s", string(patchBytes))
+	if _, err := app.virtCli.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: dryRun}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi: %v", err)
+		if errors.IsInvalid(err) {
+			if statErr, ok := err.(*errors.StatusError); ok {
+				return statErr
+			}
+		}
+		return errors.NewInternalError(fmt.Errorf("unable to patch vmi: %v", err))
+	}
+	return nil
+}
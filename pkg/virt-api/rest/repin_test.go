@@ -0,0 +1,164 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Repin vCPUs Subresource api", func() {
+	var (
+		request   *restful.Request
+		response  *restful.Response
+		recorder  *httptest.ResponseRecorder
+		vmiClient *kubecli.MockVirtualMachineInstanceInterface
+		app       *SubresourceAPIApp
+	)
+
+	newVMI := func(phase v1.VirtualMachineInstancePhase, pinning *v1.VCPUPinningStatus) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(
+			libvmi.WithName(testVMName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithCPUCount(2, 1, 1),
+			libvmi.WithDedicatedCPUPlacement(),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(phase))),
+		)
+		vmi.Status.VCPUPinning = pinning
+		return vmi
+	}
+
+	pinnedVCPUs := func() *v1.VCPUPinningStatus {
+		return &v1.VCPUPinningStatus{VCPUs: []v1.VCPUPin{{VCPU: 0, CPUSet: "2"}, {VCPU: 1, CPUSet: "3"}}}
+	}
+
+	newBody := func(opts interface{}) io.ReadCloser {
+		optsJson, _ := json.Marshal(opts)
+		return &readCloserWrapper{bytes.NewReader(optsJson)}
+	}
+
+	expectPatch := func(vmi *v1.VirtualMachineInstance, expectedPatch string, dryRun []string) {
+		vmiClient.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ types.PatchType, body []byte, opts metav1.PatchOptions, _ ...string) (*v1.VirtualMachineInstance, error) {
+				Expect(string(body)).To(MatchJSON(expectedPatch))
+				Expect(opts.DryRun).To(Equal(dryRun))
+				return vmi, nil
+			})
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+		app = NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+	})
+
+	It("should request the pinning", func() {
+		vmi := newVMI(v1.Running, pinnedVCPUs())
+		vmiClient.EXPECT().Get(context.Background(), vmi.Name, metav1.GetOptions{}).Return(vmi, nil)
+		expectPatch(vmi, `[
+			{"op":"test","path":"/status/vcpuPinning/requested","value":null},
+			{"op":"add","path":"/status/vcpuPinning/requested","value":[{"vcpu":1,"cpuSet":"5"}]}
+		]`, []string{metav1.DryRunAll})
+
+		request.Request.Body = newBody(&v1.RepinVCPUsOptions{
+			Pinning: []v1.VCPUPin{{VCPU: 1, CPUSet: "5"}},
+			DryRun:  []string{metav1.DryRunAll},
+		})
+		app.VMIRepinVCPUsRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+	})
+
+	It("should replace a pending request", func() {
+		pinning := pinnedVCPUs()
+		pinning.Requested = []v1.VCPUPin{{VCPU: 1, CPUSet: "5"}}
+		vmi := newVMI(v1.Running, pinning)
+		vmiClient.EXPECT().Get(context.Background(), vmi.Name, metav1.GetOptions{}).Return(vmi, nil)
+		expectPatch(vmi, `[
+			{"op":"test","path":"/status/vcpuPinning/requested","value":[{"vcpu":1,"cpuSet":"5"}]},
+			{"op":"replace","path":"/status/vcpuPinning/requested","value":[{"vcpu":0,"cpuSet":"4-5"}]}
+		]`, nil)
+
+		request.Request.Body = newBody(&v1.RepinVCPUsOptions{Pinning: []v1.VCPUPin{{VCPU: 0, CPUSet: "4-5"}}})
+		app.VMIRepinVCPUsRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+	})
+
+	DescribeTable("should reject an invalid request", func(opts *v1.RepinVCPUsOptions, expectedErr string) {
+		request.Request.Body = newBody(opts)
+		app.VMIRepinVCPUsRequestHandler(request, response)
+		statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		Expect(statusErr.Error()).To(Equal(expectedErr))
+	},
+		Entry("without a pinning", &v1.RepinVCPUsOptions{},
+			"RepinVCPUsOptions requires pinning to be set"),
+		Entry("with a vCPU listed twice", &v1.RepinVCPUsOptions{Pinning: []v1.VCPUPin{{VCPU: 0, CPUSet: "2"}, {VCPU: 0, CPUSet: "3"}}},
+			"vCPU 0 is pinned more than once"),
+		Entry("with an invalid cpuSet", &v1.RepinVCPUsOptions{Pinning: []v1.VCPUPin{{VCPU: 0, CPUSet: "a"}}},
+			`invalid cpuSet "a" of vCPU 0: strconv.Atoi: parsing "a": invalid syntax`),
+	)
+
+	It("should reject an unknown vCPU", func() {
+		vmi := newVMI(v1.Running, pinnedVCPUs())
+		vmiClient.EXPECT().Get(context.Background(), vmi.Name, metav1.GetOptions{}).Return(vmi, nil)
+		request.Request.Body = newBody(&v1.RepinVCPUsOptions{Pinning: []v1.VCPUPin{{VCPU: 2, CPUSet: "5"}}})
+		app.VMIRepinVCPUsRequestHandler(request, response)
+		statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		Expect(statusErr.Error()).To(Equal("vCPU 2 is not pinned"))
+	})
+
+	DescribeTable("should conflict", func(phase v1.VirtualMachineInstancePhase, pinning *v1.VCPUPinningStatus) {
+		vmi := newVMI(phase, pinning)
+		vmiClient.EXPECT().Get(context.Background(), vmi.Name, metav1.GetOptions{}).Return(vmi, nil)
+		request.Request.Body = newBody(&v1.RepinVCPUsOptions{Pinning: []v1.VCPUPin{{VCPU: 0, CPUSet: "5"}}})
+		app.VMIRepinVCPUsRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+	},
+		Entry("when the VMI is not running", v1.Scheduled, pinnedVCPUs()),
+		Entry("when the vCPUs are not pinned", v1.Running, nil),
+	)
+})
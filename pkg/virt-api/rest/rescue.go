@@ -0,0 +1,178 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+)
+
+const (
+	vmInRescueErr    = "VM is already in rescue mode"
+	vmNotInRescueErr = "VM is not in rescue mode"
+)
+
+// RescueVMRequestHandler puts a VM into rescue mode. The VMI is (re)started from the rescue image,
+// with the disks of the VM attached behind it, until the VM is unrescued.
+func (app *SubresourceAPIApp) RescueVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body"), response)
+		return
+	}
+	bodyStruct := &v1.RescueOptions{}
+	if err := decodeBody(request, bodyStruct); err != nil {
+		writeError(err, response)
+		return
+	}
+	if bodyStruct.Image == "" {
+		writeError(errors.NewBadRequest("Rescue requires the image to be set"), response)
+		return
+	}
+
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if vm.Status.Rescue != nil {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(vmInRescueErr)), response)
+		return
+	}
+	if vm.Spec.Template != nil {
+		for _, volume := range vm.Spec.Template.Spec.Volumes {
+			if volume.Name == v1.VirtualMachineRescueVolumeName {
+				writeError(errors.NewBadRequest(fmt.Sprintf("VM already has a volume named %s", v1.VirtualMachineRescueVolumeName)), response)
+				return
+			}
+		}
+	}
+
+	runStrategy, err := vm.RunStrategy()
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	if runStrategy == v1.RunStrategyOnce {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("RunStategy %v does not support rescue requests", runStrategy)), response)
+		return
+	}
+
+	rescue := &v1.VirtualMachineRescue{
+		Image:    bodyStruct.Image,
+		ReadOnly: bodyStruct.ReadOnly,
+	}
+	if statusErr := app.patchVMRescue(vm, true, bodyStruct.DryRun, patch.WithAdd("/status/rescue", rescue)); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// UnrescueVMRequestHandler takes a VM out of rescue mode and restarts it from its own disks if it is running
+func (app *SubresourceAPIApp) UnrescueVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	bodyStruct := &v1.UnrescueOptions{}
+	if request.Request.Body != nil {
+		if err := decodeBody(request, bodyStruct); err != nil {
+			writeError(err, response)
+			return
+		}
+	}
+
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if vm.Status.Rescue == nil {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(vmNotInRescueErr)), response)
+		return
+	}
+
+	if statusErr := app.patchVMRescue(vm, false, bodyStruct.DryRun,
+		patch.WithTest("/status/rescue", vm.Status.Rescue),
+		patch.WithRemove("/status/rescue"),
+	); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// patchVMRescue patches the rescue status of the VM together with the state change requests which
+// restart a running VMI. If start is set, a stopped VM is started as well.
+func (app *SubresourceAPIApp) patchVMRescue(vm *v1.VirtualMachine, start bool, dryRun []string, opts ...patch.PatchOption) *errors.StatusError {
+	vmi, err := app.virtCli.VirtualMachineInstance(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return errors.NewInternalError(err)
+	}
+
+	var changes []v1.VirtualMachineStateChangeRequest
+	if err == nil && vmi.DeletionTimestamp == nil && !vmi.IsFinal() {
+		changes = append(changes, v1.VirtualMachineStateChangeRequest{Action: v1.StopRequest, UID: &vmi.UID})
+		start = true
+	}
+	if start {
+		changes = append(changes, v1.VirtualMachineStateChangeRequest{Action: v1.StartRequest})
+	}
+
+	patchSet := patch.New()
+	if len(changes) > 0 {
+		patchSet, err = getChangeRequestPatchSet(vm, changes...)
+		if err != nil {
+			return errors.NewConflict(v1.Resource("virtualmachine"), vm.Name, err)
+		}
+	}
+	patchSet.AddOption(opts...)
+
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+
+	log.Log.Object(vm).V(4).Infof(patchingVMFmt, string(patchBytes))
+	_, err = app.virtCli.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: dryRun})
+	if err != nil {
+		if strings.Contains(err.Error(), jsonpatchTestErr) {
+			return errors.NewConflict(v1.Resource("virtualmachine"), vm.Name, err)
+		}
+		return errors.NewInternalError(err)
+	}
+	return nil
+}
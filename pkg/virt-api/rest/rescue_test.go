@@ -0,0 +1,214 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Rescue Subresource api", func() {
+	var (
+		request   *restful.Request
+		response  *restful.Response
+		recorder  *httptest.ResponseRecorder
+		vmClient  *kubecli.MockVirtualMachineInterface
+		vmiClient *kubecli.MockVirtualMachineInstanceInterface
+		app       *SubresourceAPIApp
+	)
+
+	newVM := func(runStrategy v1.VirtualMachineRunStrategy) *v1.VirtualMachine {
+		vm := libvmi.NewVirtualMachine(
+			libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault), libvmi.WithContainerDisk("rootdisk", "quay.io/os:latest")),
+			libvmi.WithRunStrategy(runStrategy),
+		)
+		vm.Name = testVMName
+		vm.Status.PrintableStatus = v1.VirtualMachineStatusCrashLoopBackOff
+		return vm
+	}
+
+	newRunningVMI := func() *v1.VirtualMachineInstance {
+		vmi := libvmi.New(
+			libvmi.WithName(testVMName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Running))),
+		)
+		vmi.UID = "vmi-uid"
+		return vmi
+	}
+
+	newBody := func(opts interface{}) io.ReadCloser {
+		optsJson, _ := json.Marshal(opts)
+		return &readCloserWrapper{bytes.NewReader(optsJson)}
+	}
+
+	expectPatchStatus := func(vm *v1.VirtualMachine, expectedPatch string, dryRun []string) {
+		vmClient.EXPECT().PatchStatus(context.Background(), vm.Name, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ types.PatchType, body []byte, opts metav1.PatchOptions) (*v1.VirtualMachine, error) {
+				Expect(string(body)).To(MatchJSON(expectedPatch))
+				Expect(opts.DryRun).To(Equal(dryRun))
+				return vm, nil
+			})
+	}
+
+	expectVMI := func(vmi *v1.VirtualMachineInstance) {
+		if vmi == nil {
+			vmiClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).
+				Return(nil, errors.NewNotFound(v1.Resource("virtualmachineinstance"), testVMName))
+			return
+		}
+		vmiClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(vmi, nil)
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmClient = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmClient).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+		app = NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+	})
+
+	Context("rescue", func() {
+		It("should restart a running VM from the rescue image", func() {
+			vm := newVM(v1.RunStrategyAlways)
+			vmClient.EXPECT().Get(context.Background(), vm.Name, metav1.GetOptions{}).Return(vm, nil)
+			expectVMI(newRunningVMI())
+			expectPatchStatus(vm, `[
+				{"op":"test","path":"/status/stateChangeRequests","value":null},
+				{"op":"add","path":"/status/stateChangeRequests","value":[{"action":"Stop","uid":"vmi-uid"},{"action":"Start"}]},
+				{"op":"add","path":"/status/rescue","value":{"image":"quay.io/rescue:latest","readOnly":true}}
+			]`, []string{metav1.DryRunAll})
+
+			request.Request.Body = newBody(&v1.RescueOptions{
+				Image:    "quay.io/rescue:latest",
+				ReadOnly: true,
+				DryRun:   []string{metav1.DryRunAll},
+			})
+			app.RescueVMRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		It("should start a stopped VM from the rescue image", func() {
+			vm := newVM(v1.RunStrategyHalted)
+			vmClient.EXPECT().Get(context.Background(), vm.Name, metav1.GetOptions{}).Return(vm, nil)
+			expectVMI(nil)
+			expectPatchStatus(vm, `[
+				{"op":"test","path":"/status/stateChangeRequests","value":null},
+				{"op":"add","path":"/status/stateChangeRequests","value":[{"action":"Start"}]},
+				{"op":"add","path":"/status/rescue","value":{"image":"quay.io/rescue:latest"}}
+			]`, nil)
+
+			request.Request.Body = newBody(&v1.RescueOptions{Image: "quay.io/rescue:latest"})
+			app.RescueVMRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		DescribeTable("should reject the request", func(modify func(vm *v1.VirtualMachine), opts *v1.RescueOptions, expectedCode int) {
+			vm := newVM(v1.RunStrategyAlways)
+			modify(vm)
+			vmClient.EXPECT().Get(context.Background(), vm.Name, metav1.GetOptions{}).Return(vm, nil).MaxTimes(1)
+
+			request.Request.Body = newBody(opts)
+			app.RescueVMRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(expectedCode))
+		},
+			Entry("without an image", func(*v1.VirtualMachine) {}, &v1.RescueOptions{}, http.StatusBadRequest),
+			Entry("if the VM is already in rescue mode", func(vm *v1.VirtualMachine) {
+				vm.Status.Rescue = &v1.VirtualMachineRescue{Image: "quay.io/rescue:latest"}
+			}, &v1.RescueOptions{Image: "quay.io/rescue:latest"}, http.StatusConflict),
+			Entry("if the VM has a volume with the rescue volume name", func(vm *v1.VirtualMachine) {
+				vm.Spec.Template.Spec.Volumes[0].Name = v1.VirtualMachineRescueVolumeName
+			}, &v1.RescueOptions{Image: "quay.io/rescue:latest"}, http.StatusBadRequest),
+			Entry("with RunStrategy Once", func(vm *v1.VirtualMachine) {
+				vm.Spec.RunStrategy = pointer.P(v1.RunStrategyOnce)
+			}, &v1.RescueOptions{Image: "quay.io/rescue:latest"}, http.StatusConflict),
+		)
+	})
+
+	Context("unrescue", func() {
+		It("should restart a running VM from its own disks", func() {
+			vm := newVM(v1.RunStrategyAlways)
+			vm.Status.Rescue = &v1.VirtualMachineRescue{Image: "quay.io/rescue:latest"}
+			vmClient.EXPECT().Get(context.Background(), vm.Name, metav1.GetOptions{}).Return(vm, nil)
+			expectVMI(newRunningVMI())
+			expectPatchStatus(vm, `[
+				{"op":"test","path":"/status/stateChangeRequests","value":null},
+				{"op":"add","path":"/status/stateChangeRequests","value":[{"action":"Stop","uid":"vmi-uid"},{"action":"Start"}]},
+				{"op":"test","path":"/status/rescue","value":{"image":"quay.io/rescue:latest"}},
+				{"op":"remove","path":"/status/rescue"}
+			]`, nil)
+
+			app.UnrescueVMRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		It("should not start a stopped VM", func() {
+			vm := newVM(v1.RunStrategyHalted)
+			vm.Status.Rescue = &v1.VirtualMachineRescue{Image: "quay.io/rescue:latest"}
+			vmClient.EXPECT().Get(context.Background(), vm.Name, metav1.GetOptions{}).Return(vm, nil)
+			expectVMI(nil)
+			expectPatchStatus(vm, `[
+				{"op":"test","path":"/status/rescue","value":{"image":"quay.io/rescue:latest"}},
+				{"op":"remove","path":"/status/rescue"}
+			]`, nil)
+
+			app.UnrescueVMRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		It("should fail if the VM is not in rescue mode", func() {
+			vm := newVM(v1.RunStrategyAlways)
+			vmClient.EXPECT().Get(context.Background(), vm.Name, metav1.GetOptions{}).Return(vm, nil)
+
+			app.UnrescueVMRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusConflict))
+		})
+	})
+})
@@ -0,0 +1,220 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+)
+
+const (
+	sessionRecordingInput  = "i"
+	sessionRecordingOutput = "o"
+)
+
+// sessionRecordingHeader is the header of an asciicast v2 recording, extended with the details of the session
+type sessionRecordingHeader struct {
+	Version   int                      `json:"version"`
+	Width     int                      `json:"width"`
+	Height    int                      `json:"height"`
+	Timestamp int64                    `json:"timestamp"`
+	Title     string                   `json:"title"`
+	Session   v1.SessionRecordingType  `json:"session"`
+	Namespace string                   `json:"namespace"`
+	Name      string                   `json:"name"`
+	User      string                   `json:"user"`
+	Encoding  sessionRecordingEncoding `json:"encoding"`
+}
+
+type sessionRecordingEncoding string
+
+const (
+	sessionRecordingEncodingText   sessionRecordingEncoding = "text"
+	sessionRecordingEncodingBase64 sessionRecordingEncoding = "base64"
+)
+
+// sessionRecorder audits a console or VNC session and, if dir is set, records the data exchanged in it.
+// Recordings are asciicast v2 files, every chunk of data is an [elapsed seconds, "i" or "o", data] event.
+// The binary data of VNC sessions is base64 encoded.
+type sessionRecorder struct {
+	kind      v1.SessionRecordingType
+	namespace string
+	name      string
+	user      string
+	dir       string
+
+	lock    sync.Mutex
+	started time.Time
+	path    string
+	file    *os.File
+	encoder *json.Encoder
+}
+
+func newSessionRecorder(kind v1.SessionRecordingType, namespace, name, user, dir string) *sessionRecorder {
+	return &sessionRecorder{
+		kind:      kind,
+		namespace: namespace,
+		name:      name,
+		user:      user,
+		dir:       dir,
+	}
+}
+
+func (r *sessionRecorder) encoding() sessionRecordingEncoding {
+	if r.kind == v1.VNCSessionRecording {
+		return sessionRecordingEncodingBase64
+	}
+	return sessionRecordingEncodingText
+}
+
+// Start creates the recording of the session, a session which can not be recorded must not be established
+func (r *sessionRecorder) Start() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.started = time.Now()
+	if r.dir != "" {
+		// Names of Kubernetes objects never contain underscores
+		fileName := fmt.Sprintf("%s_%s_%s_%d.cast", r.namespace, r.name, strings.ToLower(string(r.kind)), r.started.UnixNano())
+		r.path = filepath.Join(r.dir, fileName)
+		file, err := os.OpenFile(r.path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0640)
+		if err != nil {
+			return fmt.Errorf("failed to create the session recording: %v", err)
+		}
+		r.file = file
+		r.encoder = json.NewEncoder(file)
+		header := sessionRecordingHeader{
+			Version:   2,
+			Width:     80,
+			Height:    24,
+			Timestamp: r.started.Unix(),
+			Title:     fmt.Sprintf("%s session of %s to %s/%s", r.kind, r.user, r.namespace, r.name),
+			Session:   r.kind,
+			Namespace: r.namespace,
+			Name:      r.name,
+			User:      r.user,
+			Encoding:  r.encoding(),
+		}
+		if err := r.encoder.Encode(header); err != nil {
+			file.Close()
+			return fmt.Errorf("failed to write the session recording: %v", err)
+		}
+	}
+
+	r.logger().Infof("%s session to VMI %s/%s started", r.kind, r.namespace, r.name)
+	return nil
+}
+
+// Stop closes the recording and audits the duration of the session
+func (r *sessionRecorder) Stop() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.file != nil {
+		if err := r.file.Close(); err != nil {
+			log.Log.Reason(err).Errorf("Failed to close the session recording %s", r.path)
+		}
+		r.file = nil
+		r.encoder = nil
+	}
+	r.logger().With("duration", time.Since(r.started).String()).Infof("%s session to VMI %s/%s ended", r.kind, r.namespace, r.name)
+}
+
+func (r *sessionRecorder) logger() *log.FilteredLogger {
+	logger := log.Log.With("user", r.user)
+	if r.path != "" {
+		logger = logger.With("recording", r.path)
+	}
+	return logger
+}
+
+func (r *sessionRecorder) record(eventType string, data []byte) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.encoder == nil {
+		return
+	}
+	var encoded string
+	if r.encoding() == sessionRecordingEncodingBase64 {
+		encoded = base64.StdEncoding.EncodeToString(data)
+	} else {
+		encoded = string(data)
+	}
+	event := []interface{}{time.Since(r.started).Seconds(), eventType, encoded}
+	if err := r.encoder.Encode(event); err != nil {
+		log.Log.Reason(err).Errorf("Failed to write the session recording %s, stopping the recording", r.path)
+		r.encoder = nil
+	}
+}
+
+// Input returns a writer recording the data sent by the client
+func (r *sessionRecorder) Input() *sessionRecordingWriter {
+	return &sessionRecordingWriter{recorder: r, eventType: sessionRecordingInput}
+}
+
+// Output returns a writer recording the data sent to the client
+func (r *sessionRecorder) Output() *sessionRecordingWriter {
+	return &sessionRecordingWriter{recorder: r, eventType: sessionRecordingOutput}
+}
+
+// sessionRecordingWriter never fails, a failing recording must not break the session it records
+type sessionRecordingWriter struct {
+	recorder  *sessionRecorder
+	eventType string
+}
+
+func (w *sessionRecordingWriter) Write(p []byte) (int, error) {
+	w.recorder.record(w.eventType, p)
+	return len(p), nil
+}
+
+// newSessionStreamer returns a streamer recording the session when enabled for its kind in the KubeVirt configuration
+func (app *SubresourceAPIApp) newSessionStreamer(kind v1.SessionRecordingType, request *restful.Request, validate validator, dial dialer) *Streamer {
+	if !app.clusterConfig.IsSessionRecordingEnabled(kind) {
+		return NewRawStreamer(app.FetchVirtualMachineInstance, validate, dial)
+	}
+
+	dir := ""
+	if app.clusterConfig.GetSessionRecordingClaimName() != "" {
+		dir = app.sessionRecordingsDir
+	}
+	recorder := newSessionRecorder(
+		kind,
+		request.PathParameter(definitions.NamespaceParamName),
+		request.PathParameter(definitions.NameParamName),
+		request.Request.Header.Get(userHeader),
+		dir,
+	)
+	return NewRecordingStreamer(app.FetchVirtualMachineInstance, validate, dial, recorder)
+}
@@ -0,0 +1,189 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorilla/websocket"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+)
+
+var _ = Describe("Session recording", func() {
+	const (
+		testNamespace, testName, testUser = "test-namespace", "test-name", "test-user"
+	)
+
+	var dir string
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	readRecording := func() (sessionRecordingHeader, [][]interface{}) {
+		files, err := filepath.Glob(filepath.Join(dir, "*.cast"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+		file, err := os.Open(files[0])
+		Expect(err).ToNot(HaveOccurred())
+		defer file.Close()
+
+		scanner := bufio.NewScanner(file)
+		Expect(scanner.Scan()).To(BeTrue())
+		var header sessionRecordingHeader
+		Expect(json.Unmarshal(scanner.Bytes(), &header)).To(Succeed())
+		var events [][]interface{}
+		for scanner.Scan() {
+			var event []interface{}
+			Expect(json.Unmarshal(scanner.Bytes(), &event)).To(Succeed())
+			Expect(event).To(HaveLen(3))
+			events = append(events, event)
+		}
+		Expect(scanner.Err()).ToNot(HaveOccurred())
+		return header, events
+	}
+
+	It("should record the console session as text", func() {
+		recorder := newSessionRecorder(v1.ConsoleSessionRecording, testNamespace, testName, testUser, dir)
+		Expect(recorder.Start()).To(Succeed())
+		_, err := recorder.Input().Write([]byte("ls\r"))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = recorder.Output().Write([]byte("file\r\n"))
+		Expect(err).ToNot(HaveOccurred())
+		recorder.Stop()
+
+		header, events := readRecording()
+		Expect(header.Version).To(Equal(2))
+		Expect(header.Session).To(Equal(v1.ConsoleSessionRecording))
+		Expect(header.Namespace).To(Equal(testNamespace))
+		Expect(header.Name).To(Equal(testName))
+		Expect(header.User).To(Equal(testUser))
+		Expect(header.Encoding).To(Equal(sessionRecordingEncodingText))
+		Expect(events).To(HaveLen(2))
+		Expect(events[0][1:]).To(Equal([]interface{}{"i", "ls\r"}))
+		Expect(events[1][1:]).To(Equal([]interface{}{"o", "file\r\n"}))
+	})
+
+	It("should record the VNC session base64 encoded", func() {
+		recorder := newSessionRecorder(v1.VNCSessionRecording, testNamespace, testName, testUser, dir)
+		Expect(recorder.Start()).To(Succeed())
+		_, err := recorder.Input().Write([]byte{0x04, 0x01, 0x00})
+		Expect(err).ToNot(HaveOccurred())
+		recorder.Stop()
+
+		header, events := readRecording()
+		Expect(header.Encoding).To(Equal(sessionRecordingEncodingBase64))
+		Expect(events).To(HaveLen(1))
+		Expect(events[0][1:]).To(Equal([]interface{}{"i", "BAEA"}))
+	})
+
+	It("should only audit the session without a directory", func() {
+		recorder := newSessionRecorder(v1.ConsoleSessionRecording, testNamespace, testName, testUser, "")
+		Expect(recorder.Start()).To(Succeed())
+		_, err := recorder.Input().Write([]byte("ls\r"))
+		Expect(err).ToNot(HaveOccurred())
+		recorder.Stop()
+
+		files, err := os.ReadDir(dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(BeEmpty())
+	})
+
+	It("should fail to start when the recording can not be created", func() {
+		recorder := newSessionRecorder(v1.ConsoleSessionRecording, testNamespace, testName, testUser, filepath.Join(dir, "missing"))
+		Expect(recorder.Start()).To(MatchError(ContainSubstring("failed to create the session recording")))
+	})
+
+	It("should record the messages relayed by the recording streamer", func() {
+		backend := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			conn, err := kvcorev1.NewUpgrader().Upgrade(rw, r, nil)
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			for {
+				msgType, data, err := conn.ReadMessage()
+				if err != nil {
+					return
+				}
+				if err := conn.WriteMessage(msgType, append([]byte("echo "), data...)); err != nil {
+					return
+				}
+			}
+		}))
+		defer backend.Close()
+
+		recorder := newSessionRecorder(v1.ConsoleSessionRecording, testNamespace, testName, testUser, dir)
+		streamer := NewRecordingStreamer(
+			func(_, _ string) (*v1.VirtualMachineInstance, *errors.StatusError) {
+				return &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: testName}}, nil
+			},
+			func(_ *v1.VirtualMachineInstance) *errors.StatusError {
+				return nil
+			},
+			mockDialer{
+				dial: func(_ *v1.VirtualMachineInstance) (*websocket.Conn, *errors.StatusError) {
+					conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(backend.URL, "http"), nil)
+					Expect(err).ToNot(HaveOccurred())
+					return conn, nil
+				},
+			},
+			recorder,
+		)
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		srv, ws, _, err := testWebsocketDial(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			defer wg.Done()
+			defer GinkgoRecover()
+			streamer.Handle(restful.NewRequest(r), restful.NewResponse(rw))
+		}))
+		Expect(err).ToNot(HaveOccurred())
+		defer srv.Close()
+
+		Expect(ws.WriteMessage(websocket.BinaryMessage, []byte("hello"))).To(Succeed())
+		Expect(ws.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+		_, data, err := ws.ReadMessage()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal("echo hello"))
+		ws.Close()
+		wg.Wait()
+
+		_, events := readRecording()
+		Expect(events).To(HaveLen(2))
+		Expect(events[0][1:]).To(Equal([]interface{}{"i", "hello"}))
+		Expect(events[1][1:]).To(Equal([]interface{}{"o", "echo hello"}))
+	})
+})
@@ -0,0 +1,64 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("SPICE Subresource api", func() {
+	newVMI := func(graphicsType v1.GraphicsType, autoattach *bool, phase v1.VirtualMachineInstancePhase) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(
+			libvmi.WithName(testVMIName),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(phase))),
+		)
+		vmi.Spec.Domain.Devices.Graphics = &v1.Graphics{Type: graphicsType}
+		vmi.Spec.Domain.Devices.AutoattachGraphicsDevice = autoattach
+		return vmi
+	}
+
+	DescribeTable("should validate SPICE requests", func(vmi *v1.VirtualMachineInstance, expectedMessage string) {
+		err := validateVMIForSPICE(vmi)
+		if expectedMessage == "" {
+			Expect(err).To(BeNil())
+		} else {
+			Expect(err).To(MatchError(expectedMessage))
+		}
+	},
+		Entry("should accept a running VMI with SPICE", newVMI(v1.GraphicsTypeSPICE, nil, v1.Running), ""),
+		Entry("should fail if there is no graphics device", newVMI(v1.GraphicsTypeSPICE, pointer.P(false), v1.Running),
+			"No graphics devices are present."),
+		Entry("should fail if the graphics device is VNC", newVMI(v1.GraphicsTypeVNC, nil, v1.Running),
+			"The graphics device is not of type SPICE, use VNC instead."),
+		Entry("should fail if the VMI is not running", newVMI(v1.GraphicsTypeSPICE, nil, v1.Scheduling), vmiNotRunning),
+	)
+
+	It("should refuse VNC requests to VMIs with SPICE", func() {
+		Expect(validateVMIForVNC(newVMI(v1.GraphicsTypeSPICE, nil, v1.Running))).To(
+			MatchError("The graphics device is of type SPICE, use SPICE instead."))
+	})
+})
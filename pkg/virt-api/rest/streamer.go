@@ -30,6 +30,7 @@ type dialer interface {
 type Streamer struct {
 	dialer          *DirectDialer
 	keepAliveClient func(ctx context.Context, conn *websocket.Conn, cancel func())
+	recorder        *sessionRecorder
 
 	streamToClient streamFunc
 	streamToServer streamFunc
@@ -70,16 +71,50 @@ func NewWebsocketStreamer(fetch vmiFetcher, validate validator, dial dialer) *St
 	}
 }
 
+// NewRecordingStreamer relays the payload of the websocket messages between the client and the server,
+// which allows the recorder to record the data exchanged in the session.
+func NewRecordingStreamer(fetch vmiFetcher, validate validator, dial dialer, recorder *sessionRecorder) *Streamer {
+	return &Streamer{
+		dialer:          NewDirectDialer(fetch, validate, dial),
+		keepAliveClient: keepAliveClientStream,
+		recorder:        recorder,
+		streamToServer: func(clientConn *websocket.Conn, serverConn net.Conn, result chan<- streamFuncResult) {
+			_, err := kvcorev1.CopyFrom(io.MultiWriter(serverConn, recorder.Input()), clientConn)
+			result <- err
+		},
+		streamToClient: func(clientConn *websocket.Conn, serverConn net.Conn, result chan<- streamFuncResult) {
+			_, err := kvcorev1.CopyTo(clientConn, io.TeeReader(serverConn, recorder.Output()))
+			result <- err
+		},
+	}
+}
+
 func (s *Streamer) Handle(request *restful.Request, response *restful.Response) error {
 	namespace := request.PathParameter(definitions.NamespaceParamName)
 	name := request.PathParameter(definitions.NameParamName)
-	serverConn, statusErr := s.dialer.DialUnderlying(namespace, name)
 
+	var serverConn net.Conn
+	var statusErr *errors.StatusError
+	if s.recorder != nil {
+		serverConn, statusErr = s.dialer.DialMessages(namespace, name)
+	} else {
+		serverConn, statusErr = s.dialer.DialUnderlying(namespace, name)
+	}
 	if statusErr != nil {
 		writeError(statusErr, response)
 		return statusErr
 	}
 
+	if s.recorder != nil {
+		if err := s.recorder.Start(); err != nil {
+			serverConn.Close()
+			statusErr = errors.NewInternalError(err)
+			writeError(statusErr, response)
+			return statusErr
+		}
+		defer s.recorder.Stop()
+	}
+
 	clientConn, err := clientConnectionUpgrade(request, response)
 	if err != nil {
 		writeError(errors.NewBadRequest(err.Error()), response)
@@ -180,6 +215,16 @@ func (d *DirectDialer) DialUnderlying(namespace, name string) (net.Conn, *errors
 	return d.dial.DialUnderlying(vmi)
 }
 
+// DialMessages returns a connection reading and writing the payload of the websocket messages of the server
+func (d *DirectDialer) DialMessages(namespace, name string) (net.Conn, *errors.StatusError) {
+	conn, err := d.Dial(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return kvcorev1.NewWebsocketStreamer(conn, nil).AsConn(), nil
+}
+
 func (d *DirectDialer) fetchAndValidateVMI(namespace, name string) (*v1.VirtualMachineInstance, *errors.StatusError) {
 	vmi, err := d.fetchVMI(namespace, name)
 	if err != nil {
@@ -51,8 +51,10 @@ import (
 	"k8s.io/client-go/testing"
 
 	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/api"
 	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
@@ -886,6 +888,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			migrateClient.EXPECT().Create(context.Background(), gomock.Any(), gomock.Any()).Do(
 				func(ctx context.Context, obj interface{}, opts k8smetav1.CreateOptions) {
 					Expect(opts.DryRun).To(BeEquivalentTo(migrateOptions.DryRun))
+					Expect(obj.(*v1.VirtualMachineInstanceMigration).Spec.Warm != nil).To(Equal(migrateOptions.Warm))
 				}).Return(&migration, nil)
 			app.MigrateVMRequestHandler(request, response)
 
@@ -894,6 +897,211 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		},
 			Entry("with default", &v1.MigrateOptions{}),
 			Entry("with dry-run option", &v1.MigrateOptions{DryRun: withDryRun()}),
+			Entry("with warm option", &v1.MigrateOptions{Warm: true}),
+		)
+	})
+
+	Context("Subresource api - RestoreVMRequestHandler", func() {
+		const testSnapshotName = "testsnapshot"
+
+		var restoreClient *kubevirtfake.Clientset
+
+		BeforeEach(func() {
+			request.PathParameters()["name"] = testVMName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+
+			restoreClient = kubevirtfake.NewSimpleClientset()
+			virtClient.EXPECT().VirtualMachineRestore(k8smetav1.NamespaceDefault).
+				Return(restoreClient.SnapshotV1beta1().VirtualMachineRestores(k8smetav1.NamespaceDefault)).AnyTimes()
+		})
+
+		setRestoreOptions := func(restoreOptions *v1.RestoreOptions) {
+			bytesRepresentation, _ := json.Marshal(restoreOptions)
+			request.Request.Body = io.NopCloser(bytes.NewReader(bytesRepresentation))
+		}
+
+		It("should fail without a body", func() {
+			app.RestoreVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("should fail without a VirtualMachineSnapshot name", func() {
+			setRestoreOptions(&v1.RestoreOptions{})
+
+			app.RestoreVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("should fail if VirtualMachine does not exist", func() {
+			setRestoreOptions(&v1.RestoreOptions{VirtualMachineSnapshotName: testSnapshotName})
+			vmClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(nil, errors.NewNotFound(v1.Resource("virtualmachine"), testVMName))
+
+			app.RestoreVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusNotFound)
+		})
+
+		It("should return the error of a rejected VirtualMachineRestore", func() {
+			setRestoreOptions(&v1.RestoreOptions{VirtualMachineSnapshotName: testSnapshotName})
+			vmClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(&v1.VirtualMachine{}, nil)
+			restoreClient.Fake.PrependReactor("create", "virtualmachinerestores", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				return true, nil, errors.NewInvalid(snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineRestore").GroupKind(), "", nil)
+			})
+
+			app.RestoreVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusUnprocessableEntity)
+		})
+
+		DescribeTable("should create a VirtualMachineRestore stopping the VirtualMachine according to options", func(restoreOptions *v1.RestoreOptions, expectedStart *bool) {
+			setRestoreOptions(restoreOptions)
+			vmClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(&v1.VirtualMachine{}, nil)
+
+			creates := 0
+			restoreClient.Fake.PrependReactor("create", "virtualmachinerestores", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				create, ok := action.(testing.CreateActionImpl)
+				Expect(ok).To(BeTrue())
+				Expect(create.GetCreateOptions().DryRun).To(Equal(restoreOptions.DryRun))
+
+				vmRestore := create.GetObject().(*snapshotv1.VirtualMachineRestore)
+				Expect(vmRestore.Spec.Target.Kind).To(Equal("VirtualMachine"))
+				Expect(vmRestore.Spec.Target.Name).To(Equal(testVMName))
+				Expect(vmRestore.Spec.VirtualMachineSnapshotName).To(Equal(testSnapshotName))
+				Expect(vmRestore.Spec.TargetReadinessPolicy).To(HaveValue(Equal(snapshotv1.VirtualMachineRestoreStopTarget)))
+				Expect(vmRestore.Spec.StartVirtualMachine).To(Equal(expectedStart))
+				creates++
+
+				return true, vmRestore, nil
+			})
+
+			app.RestoreVMRequestHandler(request, response)
+
+			Expect(response.Error()).ToNot(HaveOccurred())
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+			Expect(creates).To(Equal(1))
+		},
+			Entry("with default", &v1.RestoreOptions{VirtualMachineSnapshotName: testSnapshotName}, nil),
+			Entry("with start option", &v1.RestoreOptions{VirtualMachineSnapshotName: testSnapshotName, Start: true}, pointer.P(true)),
+			Entry("with dry-run option", &v1.RestoreOptions{VirtualMachineSnapshotName: testSnapshotName, DryRun: withDryRun()}, nil),
+		)
+	})
+
+	Context("Subresource api - ApplyChangesVMRequestHandler", func() {
+		var vm *v1.VirtualMachine
+
+		BeforeEach(func() {
+			request.PathParameters()["name"] = testVMName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+
+			vm = libvmi.NewVirtualMachine(libvmi.New(libvmi.WithName(testVMName)), libvmi.WithRunStrategy(v1.RunStrategyAlways))
+			vm.Spec.ChangeApplyStrategy = pointer.P(v1.ChangeApplyStrategyStaged)
+			vm.Status.StagedChanges = &v1.VirtualMachineStagedChanges{
+				Fields: []string{"spec.template.spec.domain.cpu.sockets"},
+			}
+		})
+
+		setApplyChangesOptions := func(applyChangesOptions *v1.ApplyChangesOptions) {
+			bytesRepresentation, _ := json.Marshal(applyChangesOptions)
+			request.Request.Body = io.NopCloser(bytes.NewReader(bytesRepresentation))
+		}
+
+		It("should fail without a body", func() {
+			app.ApplyChangesVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("should fail with an unsupported method", func() {
+			setApplyChangesOptions(&v1.ApplyChangesOptions{Method: "Reboot"})
+
+			app.ApplyChangesVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("should fail if the VirtualMachine does not stage its changes", func() {
+			setApplyChangesOptions(&v1.ApplyChangesOptions{Method: v1.ApplyChangesMethodRestart})
+			vm.Spec.ChangeApplyStrategy = nil
+			vmClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(vm, nil)
+
+			app.ApplyChangesVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("should fail if the VirtualMachine has no staged changes", func() {
+			setApplyChangesOptions(&v1.ApplyChangesOptions{Method: v1.ApplyChangesMethodRestart})
+			vm.Status.StagedChanges = nil
+			vmClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(vm, nil)
+
+			app.ApplyChangesVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("should fail if the VirtualMachine is not running", func() {
+			setApplyChangesOptions(&v1.ApplyChangesOptions{Method: v1.ApplyChangesMethodRestart})
+			vmClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(vm, nil)
+			vmiClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(nil, errors.NewNotFound(v1.Resource("virtualmachineinstance"), testVMName))
+
+			app.ApplyChangesVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		DescribeTable("should fail to apply changes requiring a restart without restarting", func(method v1.ApplyChangesMethod) {
+			setApplyChangesOptions(&v1.ApplyChangesOptions{Method: method})
+			vm.Status.StagedChanges.RestartRequired = true
+			vmClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(vm, nil)
+			vmiClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(libvmi.New(libvmi.WithName(testVMName)), nil)
+
+			app.ApplyChangesVMRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		},
+			Entry("with LiveMigrate", v1.ApplyChangesMethodLiveMigrate),
+			Entry("with Hotplug", v1.ApplyChangesMethodHotplug),
+		)
+
+		It("should restart the VirtualMachine with the Restart method", func() {
+			setApplyChangesOptions(&v1.ApplyChangesOptions{Method: v1.ApplyChangesMethodRestart, DryRun: withDryRun()})
+			vmi := libvmi.New(libvmi.WithName(testVMName))
+			vmi.UID = uuid.NewUUID()
+			vmClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(vm, nil)
+			vmiClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(vmi, nil)
+			vmClient.EXPECT().PatchStatus(context.Background(), testVMName, types.JSONPatchType, gomock.Any(), k8smetav1.PatchOptions{DryRun: withDryRun()}).DoAndReturn(
+				func(ctx context.Context, name string, patchType types.PatchType, body []byte, opts k8smetav1.PatchOptions) (*v1.VirtualMachine, error) {
+					Expect(string(body)).To(ContainSubstring(`"path":"/status/stateChangeRequests"`))
+					Expect(string(body)).To(ContainSubstring(string(vmi.UID)))
+					return vm, nil
+				})
+
+			app.ApplyChangesVMRequestHandler(request, response)
+
+			Expect(response.Error()).ToNot(HaveOccurred())
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		DescribeTable("should request the VM controller to apply the changes", func(method v1.ApplyChangesMethod) {
+			setApplyChangesOptions(&v1.ApplyChangesOptions{Method: method})
+			vmClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(vm, nil)
+			vmiClient.EXPECT().Get(context.Background(), testVMName, k8smetav1.GetOptions{}).Return(libvmi.New(libvmi.WithName(testVMName)), nil)
+			vmClient.EXPECT().PatchStatus(context.Background(), testVMName, types.JSONPatchType, gomock.Any(), k8smetav1.PatchOptions{}).DoAndReturn(
+				func(ctx context.Context, name string, patchType types.PatchType, body []byte, opts k8smetav1.PatchOptions) (*v1.VirtualMachine, error) {
+					Expect(string(body)).To(ContainSubstring(`{"op":"test","path":"/status/stagedChanges"`))
+					Expect(string(body)).To(ContainSubstring(fmt.Sprintf(`{"op":"add","path":"/status/stagedChanges/applyMethod","value":"%s"}`, method)))
+					return vm, nil
+				})
+
+			app.ApplyChangesVMRequestHandler(request, response)
+
+			Expect(response.Error()).ToNot(HaveOccurred())
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		},
+			Entry("with LiveMigrate", v1.ApplyChangesMethodLiveMigrate),
+			Entry("with Hotplug", v1.ApplyChangesMethodHotplug),
 		)
 	})
 
@@ -915,6 +1123,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("for GuestOSInfo", app.GuestOSInfo),
 			Entry("for UserList", app.UserList),
 			Entry("for Filesystem", app.FilesystemList),
+			Entry("for Stats", app.Stats),
 		)
 
 		DescribeTable("should fail when the VMI is not running", func(fn subRes) {
@@ -934,6 +1143,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("for GuestOSInfo", app.GuestOSInfo),
 			Entry("for UserList", app.UserList),
 			Entry("for FilesystemList", app.FilesystemList),
+			Entry("for Stats", app.Stats),
 		)
 
 		DescribeTable("should fail when VMI does not have agent connected", func(fn subRes) {
@@ -1426,6 +1636,68 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		)
 	})
 
+	Context("Subresource api - start with one-shot boot overrides", func() {
+		newVMWithTemplate := func() *v1.VirtualMachine {
+			vm := newVirtualMachineWithRunStrategy(v1.RunStrategyHalted)
+			vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{}
+			vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{
+				{Name: "rootdisk", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}}},
+				{Name: "installer", DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{}}},
+			}
+			vm.Spec.Template.Spec.Domain.Firmware = &v1.Firmware{
+				KernelBoot: &v1.KernelBoot{Container: &v1.KernelBootContainer{Image: "kernel", KernelPath: "/boot/vmlinuz"}},
+			}
+			return vm
+		}
+
+		BeforeEach(func() {
+			request.PathParameters()["name"] = testVMName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+		})
+
+		It("should send a start request with the overrides for a halted VM", func() {
+			vm := newVMWithTemplate()
+			bytesRepresentation, _ := json.Marshal(&v1.StartOptions{BootFromCDROMOnce: "installer", KernelArgsOnce: "single"})
+			request.Request.Body = io.NopCloser(bytes.NewReader(bytesRepresentation))
+
+			vmClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vm, nil)
+			vmiClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(nil, errors.NewNotFound(v1.Resource("virtualmachineinstance"), vm.Name))
+			vmClient.EXPECT().PatchStatus(context.Background(), vm.Name, types.JSONPatchType, gomock.Any(), k8smetav1.PatchOptions{}).DoAndReturn(
+				func(ctx context.Context, name string, patchType types.PatchType, body []byte, opts k8smetav1.PatchOptions) (*v1.VirtualMachine, error) {
+					Expect(string(body)).To(And(
+						ContainSubstring(`"bootFromCDROMOnce":"installer"`),
+						ContainSubstring(`"kernelArgsOnce":"single"`),
+					))
+					return vm, nil
+				})
+
+			app.StartVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		DescribeTable("should reject invalid overrides", func(startOptions *v1.StartOptions, modify func(*v1.VirtualMachine), msg string) {
+			vm := newVMWithTemplate()
+			modify(vm)
+			bytesRepresentation, _ := json.Marshal(startOptions)
+			request.Request.Body = io.NopCloser(bytes.NewReader(bytesRepresentation))
+
+			vmClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vm, nil)
+			vmiClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(nil, errors.NewNotFound(v1.Resource("virtualmachineinstance"), vm.Name))
+
+			app.StartVMRequestHandler(request, response)
+
+			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			Expect(statusErr.Error()).To(ContainSubstring(msg))
+		},
+			Entry("with unknown CD-ROM", &v1.StartOptions{BootFromCDROMOnce: "missing"}, func(*v1.VirtualMachine) {}, "VM has no CD-ROM disk named missing"),
+			Entry("with a disk which is not a CD-ROM", &v1.StartOptions{BootFromCDROMOnce: "rootdisk"}, func(*v1.VirtualMachine) {}, "VM has no CD-ROM disk named rootdisk"),
+			Entry("with kernel arguments but no external kernel", &v1.StartOptions{KernelArgsOnce: "single"}, func(vm *v1.VirtualMachine) {
+				vm.Spec.Template.Spec.Domain.Firmware = nil
+			}, "kernel arguments can only be overridden for VMs with an external kernel"),
+		)
+	})
+
 	AfterEach(func() {
 		backend.Close()
 	})
@@ -0,0 +1,246 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	apimetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// Reasons of the rejected connections, reported in the kubevirt_subresource_rejected_connections_total metric
+	rejectedUserConnections      = "user_connections"
+	rejectedNamespaceConnections = "namespace_connections"
+	rejectedUserRate             = "user_rate"
+
+	// rateLimiterIdleTimeout is the time after which the rate limiter of a user which did not connect is dropped
+	rateLimiterIdleTimeout = 10 * time.Minute
+)
+
+type connectionScope string
+
+const (
+	userScope      connectionScope = "user"
+	namespaceScope connectionScope = "namespace"
+)
+
+type connectionKey struct {
+	subresource string
+	scope       connectionScope
+	name        string
+}
+
+type userRateLimiter struct {
+	limiter  *rate.Limiter
+	perMin   uint32
+	lastSeen time.Time
+}
+
+// SubresourceLimiter limits the concurrent connections of the users and namespaces to the streaming subresources,
+// and the rate at which the users open them, as configured in the KubeVirt CR. The connections are only counted
+// by this virt-api replica.
+type SubresourceLimiter struct {
+	clusterConfig *virtconfig.ClusterConfig
+	authorizor    VirtApiAuthorizor
+	clock         clock.Clock
+
+	lock         sync.Mutex
+	connections  map[connectionKey]uint32
+	rateLimiters map[connectionKey]*userRateLimiter
+	lastPrune    time.Time
+}
+
+func NewSubresourceLimiter(clusterConfig *virtconfig.ClusterConfig, authorizor VirtApiAuthorizor) *SubresourceLimiter {
+	return newSubresourceLimiterWithClock(clusterConfig, authorizor, clock.RealClock{})
+}
+
+func newSubresourceLimiterWithClock(clusterConfig *virtconfig.ClusterConfig, authorizor VirtApiAuthorizor, clk clock.Clock) *SubresourceLimiter {
+	return &SubresourceLimiter{
+		clusterConfig: clusterConfig,
+		authorizor:    authorizor,
+		clock:         clk,
+		connections:   map[connectionKey]uint32{},
+		rateLimiters:  map[connectionKey]*userRateLimiter{},
+		lastPrune:     clk.Now(),
+	}
+}
+
+// Filter returns a route filter rejecting the connections to the subresource above its limits with a
+// 429 Too Many Requests, and releasing the connection once the request is served
+func (l *SubresourceLimiter) Filter(subresource string) restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		namespace := request.PathParameter(definitions.NamespaceParamName)
+		release, statusErr := l.acquire(subresource, namespace, request.Request.Header)
+		if statusErr != nil {
+			writeError(statusErr, response)
+			return
+		}
+		defer release()
+		chain.ProcessFilter(request, response)
+	}
+}
+
+func (l *SubresourceLimiter) getLimit(subresource string) *v1.SubresourceLimit {
+	config := l.clusterConfig.GetSubresourceLimits()
+	if config == nil {
+		return nil
+	}
+	for i := range config.Limits {
+		if config.Limits[i].Subresource == subresource {
+			return &config.Limits[i]
+		}
+	}
+	return nil
+}
+
+func (l *SubresourceLimiter) isExempt(header http.Header) bool {
+	config := l.clusterConfig.GetSubresourceLimits()
+	if config == nil || len(config.ExemptGroups) == 0 {
+		return false
+	}
+	for _, key := range l.authorizor.GetGroupHeaders() {
+		for _, group := range header[key] {
+			if slices.Contains(config.ExemptGroups, group) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (l *SubresourceLimiter) getUser(header http.Header) string {
+	for _, key := range l.authorizor.GetUserHeaders() {
+		if user, ok := header[key]; ok && len(user) > 0 {
+			return user[0]
+		}
+	}
+	return ""
+}
+
+// acquire counts a new connection of the user to the subresource of a VMI in the namespace, and returns a
+// function releasing it, or a 429 Too Many Requests error if the connection exceeds a limit
+func (l *SubresourceLimiter) acquire(subresource, namespace string, header http.Header) (func(), *errors.StatusError) {
+	limit := l.getLimit(subresource)
+	if limit == nil || l.isExempt(header) {
+		return func() {}, nil
+	}
+	userKey := connectionKey{subresource: subresource, scope: userScope, name: l.getUser(header)}
+	namespaceKey := connectionKey{subresource: subresource, scope: namespaceScope, name: namespace}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if limit.MaxConnectionsPerUser != nil && l.connections[userKey] >= *limit.MaxConnectionsPerUser {
+		return nil, l.reject(subresource, namespace, rejectedUserConnections,
+			fmt.Sprintf("the user reached the limit of %d %s connections", *limit.MaxConnectionsPerUser, subresource), 0)
+	}
+	if limit.MaxConnectionsPerNamespace != nil && l.connections[namespaceKey] >= *limit.MaxConnectionsPerNamespace {
+		return nil, l.reject(subresource, namespace, rejectedNamespaceConnections,
+			fmt.Sprintf("the namespace %s reached the limit of %d %s connections", namespace, *limit.MaxConnectionsPerNamespace, subresource), 0)
+	}
+	if limit.ConnectionsPerMinutePerUser != nil {
+		if delay, ok := l.reserve(userKey, *limit.ConnectionsPerMinutePerUser); !ok {
+			return nil, l.reject(subresource, namespace, rejectedUserRate,
+				fmt.Sprintf("the user reached the limit of %d %s connections per minute", *limit.ConnectionsPerMinutePerUser, subresource),
+				int(math.Ceil(delay.Seconds())))
+		}
+	}
+
+	l.connections[userKey]++
+	l.connections[namespaceKey]++
+	active := apimetrics.NewActiveSubresourceConnection(subresource)
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			active.Dec()
+			l.lock.Lock()
+			defer l.lock.Unlock()
+			l.release(userKey)
+			l.release(namespaceKey)
+		})
+	}, nil
+}
+
+func (l *SubresourceLimiter) release(key connectionKey) {
+	if l.connections[key] <= 1 {
+		delete(l.connections, key)
+		return
+	}
+	l.connections[key]--
+}
+
+// reserve takes a token of the rate limiter of the user, or returns the time after which a token is available
+func (l *SubresourceLimiter) reserve(key connectionKey, perMinute uint32) (time.Duration, bool) {
+	now := l.clock.Now()
+	l.pruneRateLimiters(now)
+
+	rateLimiter, exists := l.rateLimiters[key]
+	if !exists || rateLimiter.perMin != perMinute {
+		rateLimiter = &userRateLimiter{
+			limiter: rate.NewLimiter(rate.Limit(float64(perMinute)/60), int(perMinute)),
+			perMin:  perMinute,
+		}
+		l.rateLimiters[key] = rateLimiter
+	}
+	rateLimiter.lastSeen = now
+
+	reservation := rateLimiter.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return time.Minute, false
+	}
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return delay, false
+	}
+	return 0, true
+}
+
+// pruneRateLimiters drops the rate limiters of the users which did not connect recently
+func (l *SubresourceLimiter) pruneRateLimiters(now time.Time) {
+	if now.Sub(l.lastPrune) < rateLimiterIdleTimeout {
+		return
+	}
+	l.lastPrune = now
+	for key, rateLimiter := range l.rateLimiters {
+		if now.Sub(rateLimiter.lastSeen) >= rateLimiterIdleTimeout {
+			delete(l.rateLimiters, key)
+		}
+	}
+}
+
+func (l *SubresourceLimiter) reject(subresource, namespace, reason, message string, retryAfterSeconds int) *errors.StatusError {
+	apimetrics.IncRejectedSubresourceConnections(subresource, namespace, reason)
+	return errors.NewTooManyRequests(fmt.Sprintf("Too many connections to %s: %s", subresource, message), retryAfterSeconds)
+}
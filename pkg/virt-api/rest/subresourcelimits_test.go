@@ -0,0 +1,147 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	testclock "k8s.io/utils/clock/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Subresource limits", func() {
+	var (
+		fakeClock *testclock.FakeClock
+		limiter   *SubresourceLimiter
+	)
+
+	newLimiter := func(config *v1.SubresourceLimitsConfiguration) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			SubresourceLimits: config,
+		})
+		authorizor := NewMockVirtApiAuthorizor(gomock.NewController(GinkgoT()))
+		authorizor.EXPECT().GetUserHeaders().Return([]string{userHeader}).AnyTimes()
+		authorizor.EXPECT().GetGroupHeaders().Return([]string{groupHeader}).AnyTimes()
+		fakeClock = testclock.NewFakeClock(time.Now())
+		limiter = newSubresourceLimiterWithClock(clusterConfig, authorizor, fakeClock)
+	}
+
+	header := func(user string, groups ...string) http.Header {
+		return http.Header{userHeader: []string{user}, groupHeader: groups}
+	}
+
+	It("should not limit subresources without limits", func() {
+		newLimiter(&v1.SubresourceLimitsConfiguration{
+			Limits: []v1.SubresourceLimit{{Subresource: "console", MaxConnectionsPerUser: pointer.P(uint32(0))}},
+		})
+		_, err := limiter.acquire("vnc", "default", header("user"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should limit the concurrent connections of a user", func() {
+		newLimiter(&v1.SubresourceLimitsConfiguration{
+			Limits: []v1.SubresourceLimit{{Subresource: "vnc", MaxConnectionsPerUser: pointer.P(uint32(2))}},
+		})
+		release, err := limiter.acquire("vnc", "default", header("user"))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = limiter.acquire("vnc", "other", header("user"))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = limiter.acquire("vnc", "default", header("user"))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Status().Code).To(BeEquivalentTo(http.StatusTooManyRequests))
+
+		By("accepting the connections of other users and to other subresources")
+		_, err = limiter.acquire("vnc", "default", header("other-user"))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = limiter.acquire("console", "default", header("user"))
+		Expect(err).ToNot(HaveOccurred())
+
+		By("accepting a connection once another one is closed")
+		release()
+		release()
+		_, err = limiter.acquire("vnc", "default", header("user"))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = limiter.acquire("vnc", "default", header("user"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should limit the concurrent connections to a namespace", func() {
+		newLimiter(&v1.SubresourceLimitsConfiguration{
+			Limits: []v1.SubresourceLimit{{Subresource: "console", MaxConnectionsPerNamespace: pointer.P(uint32(1))}},
+		})
+		_, err := limiter.acquire("console", "default", header("user"))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = limiter.acquire("console", "default", header("other-user"))
+		Expect(err).To(HaveOccurred())
+		_, err = limiter.acquire("console", "other", header("other-user"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should limit the rate of the connections of a user", func() {
+		newLimiter(&v1.SubresourceLimitsConfiguration{
+			Limits: []v1.SubresourceLimit{{Subresource: "vnc/screenshot", ConnectionsPerMinutePerUser: pointer.P(uint32(2))}},
+		})
+		for range 2 {
+			release, err := limiter.acquire("vnc/screenshot", "default", header("user"))
+			Expect(err).ToNot(HaveOccurred())
+			release()
+		}
+		_, err := limiter.acquire("vnc/screenshot", "default", header("user"))
+		Expect(err).To(HaveOccurred())
+		Expect(err.ErrStatus.Details.RetryAfterSeconds).To(BeEquivalentTo(30))
+
+		By("accepting a connection once the rate allows it")
+		fakeClock.Step(30 * time.Second)
+		_, err = limiter.acquire("vnc/screenshot", "default", header("user"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should drop the rate limiters of idle users", func() {
+		newLimiter(&v1.SubresourceLimitsConfiguration{
+			Limits: []v1.SubresourceLimit{{Subresource: "vnc", ConnectionsPerMinutePerUser: pointer.P(uint32(1))}},
+		})
+		_, err := limiter.acquire("vnc", "default", header("user"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(limiter.rateLimiters).To(HaveLen(1))
+		fakeClock.Step(rateLimiterIdleTimeout)
+		_, err = limiter.acquire("vnc", "default", header("other-user"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(limiter.rateLimiters).To(HaveLen(1))
+	})
+
+	It("should not limit the members of exempt groups", func() {
+		newLimiter(&v1.SubresourceLimitsConfiguration{
+			Limits:       []v1.SubresourceLimit{{Subresource: "vnc", MaxConnectionsPerUser: pointer.P(uint32(0))}},
+			ExemptGroups: []string{"system:masters"},
+		})
+		_, err := limiter.acquire("vnc", "default", header("admin", "system:authenticated", "system:masters"))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = limiter.acquire("vnc", "default", header("user", "system:authenticated"))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,225 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	usbHotplugNotEnabledError = "Enable replugHostDevices in the migration configuration to use this API."
+	hostDevicesPath           = "/spec/domain/devices/hostDevices"
+)
+
+// VMIAddUSBDeviceRequestHandler hotplugs a host USB device into a running VMI
+func (app *SubresourceAPIApp) VMIAddUSBDeviceRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.usbHotplugEnabled() {
+		writeError(errors.NewBadRequest(usbHotplugNotEnabledError), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, a new name is expected as the request body"), response)
+		return
+	}
+
+	opts := &v1.AddUSBDeviceOptions{}
+	defer request.Request.Body.Close()
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("AddUSBDeviceOptions requires name to be set"), response)
+		return
+	} else if opts.DeviceName == "" {
+		writeError(errors.NewBadRequest("AddUSBDeviceOptions requires deviceName to be set"), response)
+		return
+	} else if !app.permittedUSBResource(opts.DeviceName) {
+		writeError(errors.NewBadRequest(fmt.Sprintf("%s is not a permitted USB host device", opts.DeviceName)), response)
+		return
+	}
+
+	vmi, statErr := app.fetchRunningVMIForUSBHotplug(namespace, name)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	for _, hostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		if hostDevice.Name == opts.Name {
+			writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name,
+				fmt.Errorf("unable to add USB device %s, a host device with that name already exists", opts.Name)), response)
+			return
+		}
+	}
+
+	hostDevices := append([]v1.HostDevice{}, vmi.Spec.Domain.Devices.HostDevices...)
+	hostDevices = append(hostDevices, v1.HostDevice{
+		Name:       opts.Name,
+		DeviceName: opts.DeviceName,
+	})
+
+	if statErr := app.patchVMIHostDevices(vmi, hostDevices, opts.DryRun); statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// VMIRemoveUSBDeviceRequestHandler hot-unplugs a host USB device from a running VMI
+func (app *SubresourceAPIApp) VMIRemoveUSBDeviceRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.usbHotplugEnabled() {
+		writeError(errors.NewBadRequest(usbHotplugNotEnabledError), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, a new name is expected as the request body"), response)
+		return
+	}
+
+	opts := &v1.RemoveUSBDeviceOptions{}
+	defer request.Request.Body.Close()
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("RemoveUSBDeviceOptions requires name to be set"), response)
+		return
+	}
+
+	vmi, statErr := app.fetchRunningVMIForUSBHotplug(namespace, name)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	var hostDevices []v1.HostDevice
+	found := false
+	for _, hostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		if hostDevice.Name != opts.Name {
+			hostDevices = append(hostDevices, hostDevice)
+			continue
+		}
+		if !app.permittedUSBResource(hostDevice.DeviceName) {
+			writeError(errors.NewBadRequest(fmt.Sprintf("host device %s is not a USB device", opts.Name)), response)
+			return
+		}
+		found = true
+	}
+	if !found {
+		writeError(errors.NewNotFound(v1.Resource("usbdevice"), opts.Name), response)
+		return
+	}
+
+	if statErr := app.patchVMIHostDevices(vmi, hostDevices, opts.DryRun); statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (app *SubresourceAPIApp) usbHotplugEnabled() bool {
+	replug := app.clusterConfig.GetMigrationConfiguration().ReplugHostDevices
+	return replug != nil && *replug
+}
+
+func (app *SubresourceAPIApp) permittedUSBResource(resourceName string) bool {
+	permittedHostDevices := app.clusterConfig.GetPermittedHostDevices()
+	if permittedHostDevices == nil {
+		return false
+	}
+	for _, usbHostDevice := range permittedHostDevices.USB {
+		if usbHostDevice.ResourceName == resourceName {
+			return true
+		}
+	}
+	return false
+}
+
+func (app *SubresourceAPIApp) fetchRunningVMIForUSBHotplug(namespace, name string) (*v1.VirtualMachineInstance, *errors.StatusError) {
+	vmi, statErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statErr != nil {
+		return nil, statErr
+	}
+
+	if !vmi.IsRunning() {
+		return nil, errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf(vmiNotRunning))
+	}
+
+	// The device plugin resources of a running pod can not change, the devices are
+	// therefore replugged into the guest by migrating it to a pod requesting them.
+	if !controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, v1.VirtualMachineInstanceIsMigratable, k8sv1.ConditionTrue) {
+		return nil, errors.NewConflict(v1.Resource("virtualmachineinstance"), name,
+			fmt.Errorf("USB hotplug requires the VMI to be live migratable"))
+	}
+	return vmi, nil
+}
+
+func (app *SubresourceAPIApp) patchVMIHostDevices(vmi *v1.VirtualMachineInstance, hostDevices []v1.HostDevice, dryRun []string) *errors.StatusError {
+	patchSet := patch.New(patch.WithTest(hostDevicesPath, vmi.Spec.Domain.Devices.HostDevices))
+	if len(vmi.Spec.Domain.Devices.HostDevices) > 0 {
+		patchSet.AddOption(patch.WithReplace(hostDevicesPath, hostDevices))
+	} else {
+		patchSet.AddOption(patch.WithAdd(hostDevicesPath, hostDevices))
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+
+	log.Log.Object(vmi).V(4).Infof("Patching VMI: %s", string(patchBytes))
+	if _, err := app.virtCli.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: dryRun}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi: %v", err)
+		if errors.IsInvalid(err) {
+			if statErr, ok := err.(*errors.StatusError); ok {
+				return statErr
+			}
+		}
+		return errors.NewInternalError(fmt.Errorf("unable to patch vmi: %v", err))
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Add/Remove USB Device Subresource api", func() {
+	const (
+		usbResourceName = "kubevirt.io/storage"
+		gpuResourceName = "nvidia.com/TU104GL_Tesla_T4"
+	)
+
+	var (
+		request   *restful.Request
+		response  *restful.Response
+		recorder  *httptest.ResponseRecorder
+		vmiClient *kubecli.MockVirtualMachineInstanceInterface
+		app       *SubresourceAPIApp
+	)
+
+	newApp := func(replugHostDevices bool) {
+		kv := &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt",
+				Namespace: "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{},
+					MigrationConfiguration: &v1.MigrationConfiguration{
+						ReplugHostDevices: pointer.P(replugHostDevices),
+					},
+					PermittedHostDevices: &v1.PermittedHostDevices{
+						USB: []v1.USBHostDevice{{ResourceName: usbResourceName}},
+					},
+				},
+			},
+			Status: v1.KubeVirtStatus{
+				Phase: v1.KubeVirtPhaseDeploying,
+			},
+		}
+		config, _, _ := testutils.NewFakeClusterConfigUsingKV(kv)
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+
+		app = NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+	}
+
+	newVMI := func(phase v1.VirtualMachineInstancePhase, migratable bool, hostDevices ...v1.HostDevice) *v1.VirtualMachineInstance {
+		status := k8sv1.ConditionFalse
+		if migratable {
+			status = k8sv1.ConditionTrue
+		}
+		vmi := libvmi.New(
+			libvmi.WithName(testVMName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithPhase(phase),
+				libvmistatus.WithCondition(v1.VirtualMachineInstanceCondition{
+					Type:   v1.VirtualMachineInstanceIsMigratable,
+					Status: status,
+				}),
+			)),
+		)
+		vmi.Spec.Domain.Devices.HostDevices = hostDevices
+		return vmi
+	}
+
+	newBody := func(opts interface{}) io.ReadCloser {
+		optsJson, _ := json.Marshal(opts)
+		return &readCloserWrapper{bytes.NewReader(optsJson)}
+	}
+
+	expectPatch := func(vmi *v1.VirtualMachineInstance, expectedPatch string, dryRun []string) {
+		vmiClient.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ types.PatchType, body []byte, opts metav1.PatchOptions, _ ...string) (*v1.VirtualMachineInstance, error) {
+				Expect(string(body)).To(MatchJSON(expectedPatch))
+				Expect(opts.DryRun).To(Equal(dryRun))
+				return vmi, nil
+			})
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		newApp(true)
+	})
+
+	Context("add", func() {
+		It("should append the USB device to the VMI host devices", func() {
+			vmi := newVMI(v1.Running, true, v1.HostDevice{Name: "gpu1", DeviceName: gpuResourceName})
+			vmiClient.EXPECT().Get(context.Background(), vmi.Name, metav1.GetOptions{}).Return(vmi, nil)
+			expectPatch(vmi, `[
+				{"op":"test","path":"/spec/domain/devices/hostDevices","value":[{"name":"gpu1","deviceName":"`+gpuResourceName+`"}]},
+				{"op":"replace","path":"/spec/domain/devices/hostDevices","value":[{"name":"gpu1","deviceName":"`+gpuResourceName+`"},{"name":"usb1","deviceName":"`+usbResourceName+`"}]}
+			]`, []string{metav1.DryRunAll})
+
+			request.Request.Body = newBody(&v1.AddUSBDeviceOptions{Name: "usb1", DeviceName: usbResourceName, DryRun: []string{metav1.DryRunAll}})
+			app.VMIAddUSBDeviceRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		It("should add the host devices list when the VMI has none", func() {
+			vmi := newVMI(v1.Running, true)
+			vmiClient.EXPECT().Get(context.Background(), vmi.Name, metav1.GetOptions{}).Return(vmi, nil)
+			expectPatch(vmi, `[
+				{"op":"test","path":"/spec/domain/devices/hostDevices","value":null},
+				{"op":"add","path":"/spec/domain/devices/hostDevices","value":[{"name":"usb1","deviceName":"`+usbResourceName+`"}]}
+			]`, nil)
+
+			request.Request.Body = newBody(&v1.AddUSBDeviceOptions{Name: "usb1", DeviceName: usbResourceName})
+			app.VMIAddUSBDeviceRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		It("should fail when replugHostDevices is disabled", func() {
+			newApp(false)
+			request.Request.Body = newBody(&v1.AddUSBDeviceOptions{Name: "usb1", DeviceName: usbResourceName})
+			app.VMIAddUSBDeviceRequestHandler(request, response)
+			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			Expect(statusErr.Error()).To(Equal(usbHotplugNotEnabledError))
+		})
+
+		DescribeTable("should reject an invalid request", func(opts *v1.AddUSBDeviceOptions, expectedErr string) {
+			request.Request.Body = newBody(opts)
+			app.VMIAddUSBDeviceRequestHandler(request, response)
+			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			Expect(statusErr.Error()).To(Equal(expectedErr))
+		},
+			Entry("without a name", &v1.AddUSBDeviceOptions{DeviceName: usbResourceName},
+				"AddUSBDeviceOptions requires name to be set"),
+			Entry("without a device name", &v1.AddUSBDeviceOptions{Name: "usb1"},
+				"AddUSBDeviceOptions requires deviceName to be set"),
+			Entry("with a device that is not a permitted USB device", &v1.AddUSBDeviceOptions{Name: "usb1", DeviceName: gpuResourceName},
+				gpuResourceName+" is not a permitted USB host device"),
+		)
+
+		DescribeTable("should conflict", func(vmi *v1.VirtualMachineInstance) {
+			vmiClient.EXPECT().Get(context.Background(), vmi.Name, metav1.GetOptions{}).Return(vmi, nil)
+			request.Request.Body = newBody(&v1.AddUSBDeviceOptions{Name: "usb1", DeviceName: usbResourceName})
+			app.VMIAddUSBDeviceRequestHandler(request, response)
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		},
+			Entry("when the VMI is not running", newVMI(v1.Scheduled, true)),
+			Entry("when the VMI is not live migratable", newVMI(v1.Running, false)),
+			Entry("when a host device with the same name exists", newVMI(v1.Running, true, v1.HostDevice{Name: "usb1", DeviceName: usbResourceName})),
+		)
+	})
+
+	Context("remove", func() {
+		It("should remove the USB device from the VMI host devices", func() {
+			vmi := newVMI(v1.Running, true,
+				v1.HostDevice{Name: "gpu1", DeviceName: gpuResourceName},
+				v1.HostDevice{Name: "usb1", DeviceName: usbResourceName},
+			)
+			vmiClient.EXPECT().Get(context.Background(), vmi.Name, metav1.GetOptions{}).Return(vmi, nil)
+			expectPatch(vmi, `[
+				{"op":"test","path":"/spec/domain/devices/hostDevices","value":[{"name":"gpu1","deviceName":"`+gpuResourceName+`"},{"name":"usb1","deviceName":"`+usbResourceName+`"}]},
+				{"op":"replace","path":"/spec/domain/devices/hostDevices","value":[{"name":"gpu1","deviceName":"`+gpuResourceName+`"}]}
+			]`, nil)
+
+			request.Request.Body = newBody(&v1.RemoveUSBDeviceOptions{Name: "usb1"})
+			app.VMIRemoveUSBDeviceRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		It("should fail when the device does not exist", func() {
+			vmi := newVMI(v1.Running, true)
+			vmiClient.EXPECT().Get(context.Background(), vmi.Name, metav1.GetOptions{}).Return(vmi, nil)
+			request.Request.Body = newBody(&v1.RemoveUSBDeviceOptions{Name: "usb1"})
+			app.VMIRemoveUSBDeviceRequestHandler(request, response)
+			ExpectStatusErrorWithCode(recorder, http.StatusNotFound)
+		})
+
+		It("should refuse to remove a host device that is not a USB device", func() {
+			vmi := newVMI(v1.Running, true, v1.HostDevice{Name: "gpu1", DeviceName: gpuResourceName})
+			vmiClient.EXPECT().Get(context.Background(), vmi.Name, metav1.GetOptions{}).Return(vmi, nil)
+			request.Request.Body = newBody(&v1.RemoveUSBDeviceOptions{Name: "gpu1"})
+			app.VMIRemoveUSBDeviceRequestHandler(request, response)
+			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			Expect(statusErr.Error()).To(Equal("host device gpu1 is not a USB device"))
+		})
+
+		It("should fail without a name", func() {
+			request.Request.Body = newBody(&v1.RemoveUSBDeviceOptions{})
+			app.VMIRemoveUSBDeviceRequestHandler(request, response)
+			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			Expect(statusErr.Error()).To(Equal("RemoveUSBDeviceOptions requires name to be set"))
+		})
+	})
+})
@@ -10,6 +10,7 @@ import (
 	"kubevirt.io/client-go/kubecli"
 
 	apimetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
+	"kubevirt.io/kubevirt/pkg/util"
 )
 
 func (app *SubresourceAPIApp) USBRedirRequestHandler(request *restful.Request, response *restful.Response) {
@@ -36,6 +37,9 @@ func validateVMIForUSBRedir(vmi *v1.VirtualMachineInstance) *errors.StatusError
 	if vmi.Spec.Domain.Devices.ClientPassthrough == nil {
 		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("Not configured with USB Redirection"))
 	}
+	if util.IsSPICEGraphics(vmi) {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("USB devices are redirected by the SPICE client"))
+	}
 	if !vmi.IsRunning() {
 		return errors.NewBadRequest(vmiNotRunning)
 	}
@@ -19,6 +19,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	apimetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
+	"kubevirt.io/kubevirt/pkg/util"
 
 	"github.com/mitchellh/go-vnc"
 )
@@ -29,8 +30,9 @@ func (app *SubresourceAPIApp) VNCRequestHandler(request *restful.Request, respon
 
 	defer apimetrics.SetVMILastConnectionTimestamp(request.PathParameter("namespace"), request.PathParameter("name"))
 
-	streamer := NewRawStreamer(
-		app.FetchVirtualMachineInstance,
+	streamer := app.newSessionStreamer(
+		v1.VNCSessionRecording,
+		request,
 		validateVMIForVNC,
 		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 			return conn.VNCURI(vmi)
@@ -165,6 +167,9 @@ func validateVMIForVNC(vmi *v1.VirtualMachineInstance) *errors.StatusError {
 		log.Log.Object(vmi).Reason(err).Error("Can't establish VNC connection.")
 		return errors.NewBadRequest(err.Error())
 	}
+	if util.IsSPICEGraphics(vmi) {
+		return errors.NewBadRequest("The graphics device is of type SPICE, use SPICE instead.")
+	}
 	if !vmi.IsRunning() {
 		return errors.NewBadRequest(vmiNotRunning)
 	}
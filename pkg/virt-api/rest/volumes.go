@@ -26,6 +26,7 @@ import (
 
 	"github.com/emicklei/go-restful/v3"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -92,15 +93,22 @@ func (app *SubresourceAPIApp) addVolumeRequestHandler(request *restful.Request,
 	if opts.Name == "" {
 		writeError(errors.NewBadRequest("AddVolumeOptions requires name to be set"), response)
 		return
-	} else if opts.Disk == nil {
-		writeError(errors.NewBadRequest("AddVolumeOptions requires disk to not be nil"), response)
+	} else if opts.Disk == nil && opts.Filesystem == nil {
+		writeError(errors.NewBadRequest("AddVolumeOptions requires disk or filesystem to not be nil"), response)
+		return
+	} else if opts.Disk != nil && opts.Filesystem != nil {
+		writeError(errors.NewBadRequest("AddVolumeOptions requires either disk or filesystem to be set, not both"), response)
 		return
 	} else if opts.VolumeSource == nil {
 		writeError(errors.NewBadRequest("AddVolumeOptions requires VolumeSource to not be nil"), response)
 		return
 	}
 
-	opts.Disk.Name = opts.Name
+	if opts.Filesystem != nil {
+		opts.Filesystem.Name = opts.Name
+	} else {
+		opts.Disk.Name = opts.Name
+	}
 	volumeRequest := v1.VirtualMachineVolumeRequest{
 		AddVolumeOptions: opts,
 	}
@@ -369,6 +377,17 @@ func generateVolumeRequestPatch(prefix string, vmiSpec *v1.VirtualMachineInstanc
 		patchSet.AddOption(patch.WithAdd(diskPath, vmiSpecCopy.Domain.Devices.Disks))
 	}
 
+	// Filesystems are only patched when a hotplugged filesystem is added or removed
+	if !equality.Semantic.DeepEqual(vmiSpec.Domain.Devices.Filesystems, vmiSpecCopy.Domain.Devices.Filesystems) {
+		filesystemPath := prefix + "/spec/domain/devices/filesystems"
+		patchSet.AddOption(patch.WithTest(filesystemPath, vmiSpec.Domain.Devices.Filesystems))
+		if len(vmiSpec.Domain.Devices.Filesystems) > 0 {
+			patchSet.AddOption(patch.WithReplace(filesystemPath, vmiSpecCopy.Domain.Devices.Filesystems))
+		} else {
+			patchSet.AddOption(patch.WithAdd(filesystemPath, vmiSpecCopy.Domain.Devices.Filesystems))
+		}
+	}
+
 	return patchSet.GeneratePayload()
 }
 
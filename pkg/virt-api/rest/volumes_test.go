@@ -239,6 +239,17 @@ var _ = Describe("Add/Remove Volume Subresource api", func() {
 				Name: "vol1",
 				Disk: &v1.Disk{},
 			}, nil, false, http.StatusBadRequest, true),
+			Entry("VMI with a valid add filesystem request", &v1.AddVolumeOptions{
+				Name:         "vol1",
+				Filesystem:   &v1.Filesystem{Virtiofs: &v1.FilesystemVirtiofs{}},
+				VolumeSource: &v1.HotplugVolumeSource{},
+			}, nil, false, http.StatusAccepted, true),
+			Entry("VMI with an invalid add volume request with both a disk and a filesystem", &v1.AddVolumeOptions{
+				Name:         "vol1",
+				Disk:         &v1.Disk{},
+				Filesystem:   &v1.Filesystem{Virtiofs: &v1.FilesystemVirtiofs{}},
+				VolumeSource: &v1.HotplugVolumeSource{},
+			}, nil, false, http.StatusBadRequest, true),
 			Entry("VM with a valid remove volume request", nil, &v1.RemoveVolumeOptions{
 				Name: "hotpluggedPVC",
 			}, true, http.StatusAccepted, true),
@@ -454,6 +465,43 @@ var _ = Describe("Add/Remove Volume Subresource api", func() {
 				patch.WithReplace("/spec/volumes", []v1.Volume{}),
 				patch.WithReplace("/spec/domain/devices/disks", []v1.Disk{}),
 			)),
+		Entry("add filesystem request",
+			&v1.VirtualMachineVolumeRequest{
+				AddVolumeOptions: &v1.AddVolumeOptions{
+					Name:         "vol1",
+					Filesystem:   &v1.Filesystem{Virtiofs: &v1.FilesystemVirtiofs{Cache: v1.VirtiofsCacheNever}},
+					VolumeSource: &v1.HotplugVolumeSource{},
+				},
+			},
+			patch.New(
+				patch.WithTest("/spec/volumes", []v1.Volume{{
+					Name: "existingvol",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "testpvcdiskclaim",
+						}},
+					},
+				}}),
+				patch.WithTest("/spec/domain/devices/disks", []v1.Disk{{Name: "existingvol"}}),
+				patch.WithReplace("/spec/volumes", []v1.Volume{
+					{
+						Name: "existingvol",
+						VolumeSource: v1.VolumeSource{
+							PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "testpvcdiskclaim",
+							}},
+						},
+					},
+					{Name: "vol1"},
+				}),
+				patch.WithReplace("/spec/domain/devices/disks", []v1.Disk{{Name: "existingvol"}}),
+				patch.WithTest("/spec/domain/devices/filesystems", nil),
+				patch.WithAdd("/spec/domain/devices/filesystems", []v1.Filesystem{{
+					Name:     "vol1",
+					Virtiofs: &v1.FilesystemVirtiofs{Cache: v1.VirtiofsCacheNever},
+				}}),
+			),
+		),
 	)
 
 	DescribeTable("Should generate expected vm patch (volume request)", func(volumeRequest *v1.VirtualMachineVolumeRequest, existingVolumeRequests []v1.VirtualMachineVolumeRequest, expectedPatchSet *patch.PatchSet, expectError bool) {
@@ -34,6 +34,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/defaults"
+	"kubevirt.io/kubevirt/pkg/instancetype/vmdefaults"
 	instancetypeVMWebhooks "kubevirt.io/kubevirt/pkg/instancetype/webhooks/vm"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
@@ -45,15 +46,21 @@ type instancetypeVMsMutator interface {
 	FindPreference(vm *v1.VirtualMachine) (*instancetypev1beta1.VirtualMachinePreferenceSpec, error)
 }
 
+type vmDefaultsFinder interface {
+	FindDefaults(vm *v1.VirtualMachine) ([]instancetypev1beta1.VirtualMachineDefaultSpec, error)
+}
+
 type VMsMutator struct {
 	ClusterConfig       *virtconfig.ClusterConfig
 	instancetypeMutator instancetypeVMsMutator
+	vmDefaultsFinder    vmDefaultsFinder
 }
 
-func NewVMsMutator(clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient) *VMsMutator {
+func NewVMsMutator(clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient, informers *webhooks.Informers) *VMsMutator {
 	return &VMsMutator{
 		ClusterConfig:       clusterConfig,
 		instancetypeMutator: instancetypeVMWebhooks.NewMutator(virtCli),
+		vmDefaultsFinder:    vmdefaults.NewFinder(informers.VMDefaultInformer.GetIndexer(), informers.VMClusterDefaultInformer.GetStore()),
 	}
 }
 
@@ -94,6 +101,17 @@ func (mutator *VMsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.
 	log.Log.Object(vm).V(4).Info("Apply defaults")
 
 	preferenceSpec, _ := mutator.instancetypeMutator.FindPreference(vm)
+
+	// VirtualMachineDefaults and VirtualMachineClusterDefaults are only applied on creation,
+	// changing them does not affect existing VMs
+	if ar.Request.Operation == admissionv1.Create {
+		defaultSpecs, err := mutator.vmDefaultsFinder.FindDefaults(vm)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(fmt.Errorf("failed to find VirtualMachineDefaults: %v", err))
+		}
+		vmdefaults.ApplyToVM(vm, defaultSpecs, preferenceSpec)
+	}
+
 	defaults.SetVirtualMachineDefaults(vm, mutator.ClusterConfig, preferenceSpec)
 
 	patchBytes, err := patch.New(
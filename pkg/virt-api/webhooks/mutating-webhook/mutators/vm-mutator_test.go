@@ -47,7 +47,9 @@ import (
 	instancetypeclientset "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/instancetype/vmdefaults"
 	instancetypeVMWebhooks "kubevirt.io/kubevirt/pkg/instancetype/webhooks/vm"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 )
 
@@ -62,6 +64,8 @@ var _ = Describe("VirtualMachine Mutator", func() {
 	var fakeClusterPreferenceClient instancetypeclientset.VirtualMachineClusterPreferenceInterface
 	var k8sClient *k8sfake.Clientset
 	var cdiClient *cdifake.Clientset
+	var vmDefaultInformer cache.SharedIndexInformer
+	var vmClusterDefaultInformer cache.SharedIndexInformer
 
 	machineTypeFromConfig := "pc-q35-3.0"
 	ignoreInferFromVolumeFailure := v1.IgnoreInferFromVolumeFailure
@@ -152,6 +156,11 @@ var _ = Describe("VirtualMachine Mutator", func() {
 		virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
 
 		mutator.instancetypeMutator = instancetypeVMWebhooks.NewMutator(virtClient)
+		vmDefaultInformer, _ = testutils.NewFakeInformerWithIndexersFor(&instancetypev1beta1.VirtualMachineDefault{}, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+		vmClusterDefaultInformer, _ = testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterDefault{})
+		mutator.vmDefaultsFinder = vmdefaults.NewFinder(vmDefaultInformer.GetIndexer(), vmClusterDefaultInformer.GetStore())
 	})
 
 	It("should allow VM being deleted without applying mutations", func() {
@@ -462,6 +471,68 @@ var _ = Describe("VirtualMachine Mutator", func() {
 		})
 	})
 
+	Context("VirtualMachineDefaults", func() {
+		const machineType = "pc-q35-defaults"
+
+		BeforeEach(func() {
+			Expect(vmDefaultInformer.GetStore().Add(&instancetypev1beta1.VirtualMachineDefault{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:      "defaults",
+					Namespace: vm.Namespace,
+				},
+				Spec: instancetypev1beta1.VirtualMachineDefaultSpec{
+					RunStrategy: pointer.P(v1.RunStrategyHalted),
+					MachineType: machineType,
+				},
+			})).To(Succeed())
+
+			Expect(vmClusterDefaultInformer.GetStore().Add(&instancetypev1beta1.VirtualMachineClusterDefault{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name: "cluster-defaults",
+				},
+				Spec: instancetypev1beta1.VirtualMachineDefaultSpec{
+					RunStrategy:       pointer.P(v1.RunStrategyAlways),
+					PodNetworkBinding: string(v1.MasqueradeInterface),
+					Devices: &instancetypev1beta1.DeviceDefaults{
+						Rng: &v1.Rng{},
+					},
+				},
+			})).To(Succeed())
+		})
+
+		It("should be applied on create, the namespaced defaults taking precedence", func() {
+			vmSpec, _ := getVMSpecMetaFromResponseCreate(rt.GOARCH)
+			Expect(vmSpec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyHalted)))
+			Expect(vmSpec.Template.Spec.Domain.Machine.Type).To(Equal(machineType))
+			Expect(vmSpec.Template.Spec.Domain.Devices.Rng).ToNot(BeNil())
+			Expect(vmSpec.Template.Spec.Domain.Devices.Interfaces).To(ConsistOf(*v1.DefaultMasqueradeNetworkInterface()))
+			Expect(vmSpec.Template.Spec.Networks).To(ConsistOf(*v1.DefaultPodNetwork()))
+		})
+
+		It("should not override the attributes set by the VM", func() {
+			vm.Spec.RunStrategy = pointer.P(v1.RunStrategyManual)
+			vm.Spec.Template.Spec.Domain.Machine = &v1.Machine{Type: "q35"}
+			vmSpec, _ := getVMSpecMetaFromResponseCreate(rt.GOARCH)
+			Expect(vmSpec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyManual)))
+			Expect(vmSpec.Template.Spec.Domain.Machine.Type).To(Equal("q35"))
+		})
+
+		It("should not be applied on update", func() {
+			oldVM := vm.DeepCopy()
+			oldVM.Spec.RunStrategy = pointer.P(v1.RunStrategyManual)
+			newVM := oldVM.DeepCopy()
+			newVM.Spec.RunStrategy = nil
+			resp := getResponseFromVMUpdate(oldVM, newVM)
+			Expect(resp.Allowed).To(BeTrue())
+
+			vmSpec := &v1.VirtualMachineSpec{}
+			patchOps := []patch.PatchOperation{{Value: vmSpec}}
+			Expect(json.Unmarshal(resp.Patch, &patchOps)).To(Succeed())
+			Expect(vmSpec.RunStrategy).To(BeNil())
+			Expect(vmSpec.Template.Spec.Domain.Devices.Rng).To(BeNil())
+		})
+	})
+
 	Context("on update", func() {
 		var oldVM, newVM *v1.VirtualMachine
 		BeforeEach(func() {
@@ -49,6 +49,9 @@ type VMIsMutator struct {
 
 const presetDeprecationWarning = "kubevirt.io/v1 VirtualMachineInstancePresets is now deprecated and will be removed in v2."
 
+// virtIODriversVolumeName is the name of the disk and volume of the VirtIO driver ISO
+const virtIODriversVolumeName = "virtio-drivers"
+
 func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
 	if !webhookutils.ValidateRequestResource(ar.Request.Resource, webhooks.VirtualMachineInstanceGroupVersionResource.Group, webhooks.VirtualMachineInstanceGroupVersionResource.Resource) {
 		err := fmt.Errorf("expect resource to be '%s'", webhooks.VirtualMachineInstanceGroupVersionResource.Resource)
@@ -79,6 +82,8 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 			}
 		}
 
+		attachVirtIODrivers(newVMI, mutator.ClusterConfig.GetVirtIODriversImage())
+
 		// Set VirtualMachineInstance defaults
 		log.Log.Object(newVMI).V(4).Info("Apply defaults")
 		if err = defaults.SetDefaultVirtualMachineInstance(mutator.ClusterConfig, newVMI); err != nil {
@@ -113,6 +118,8 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 			markAsNonroot(newVMI)
 		}
 
+		resolveGPUProfiles(newVMI)
+
 		patchSet.AddOption(
 			patch.WithReplace("/spec", newVMI.Spec),
 			patch.WithReplace("/metadata", newVMI.ObjectMeta),
@@ -158,6 +165,51 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 	return response
 }
 
+// attachVirtIODrivers attaches the VirtIO driver ISO as a CD-ROM to the VMIs requesting it,
+// unless the VMI already has a disk or volume with the same name
+func attachVirtIODrivers(vmi *v1.VirtualMachineInstance, image string) {
+	devices := &vmi.Spec.Domain.Devices
+	if image == "" || devices.AutoattachVirtIODrivers == nil || !*devices.AutoattachVirtIODrivers {
+		return
+	}
+	for _, disk := range devices.Disks {
+		if disk.Name == virtIODriversVolumeName {
+			return
+		}
+	}
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == virtIODriversVolumeName {
+			return
+		}
+	}
+
+	devices.Disks = append(devices.Disks, v1.Disk{
+		Name: virtIODriversVolumeName,
+		DiskDevice: v1.DiskDevice{
+			CDRom: &v1.CDRomTarget{},
+		},
+	})
+	vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+		Name: virtIODriversVolumeName,
+		VolumeSource: v1.VolumeSource{
+			ContainerDisk: &v1.ContainerDiskSource{
+				Image: image,
+			},
+		},
+	})
+}
+
 func markAsNonroot(vmi *v1.VirtualMachineInstance) {
 	vmi.Status.RuntimeUser = 107
 }
+
+// resolveGPUProfiles sets the device name of the GPUs requesting a mediated device profile
+// to the resource exposing the devices of the profile on the nodes it is defined for
+func resolveGPUProfiles(vmi *v1.VirtualMachineInstance) {
+	for i := range vmi.Spec.Domain.Devices.GPUs {
+		gpu := &vmi.Spec.Domain.Devices.GPUs[i]
+		if gpu.Profile != "" && gpu.DeviceName == "" {
+			gpu.DeviceName = virtconfig.MediatedDeviceProfileResourceName(gpu.Profile)
+		}
+	}
+}
@@ -669,6 +669,31 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		Expect(vmiSpec.Domain.Resources.Requests.Memory().String()).To(Equal("3072M"))
 	})
 
+	DescribeTable("should request the guest memory according to the memory overcommit class", func(class v1.MemoryOvercommitClass, expectedRequest string) {
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{
+						MemoryOvercommit: 150,
+					},
+				},
+			},
+		})
+
+		guestMemory := resource.MustParse("3072M")
+		vmi.Spec.Domain.Memory = &v1.Memory{Guest: &guestMemory, Overcommit: class}
+		_, vmiSpec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+		if expectedRequest == "" {
+			Expect(vmiSpec.Domain.Resources.Requests).ToNot(HaveKey(k8sv1.ResourceMemory))
+		} else {
+			Expect(vmiSpec.Domain.Resources.Requests.Memory().String()).To(Equal(expectedRequest))
+		}
+	},
+		Entry("Guaranteed ignoring the cluster wide memory overcommit", v1.MemoryOvercommitGuaranteed, "3072M"),
+		Entry("BurstableWithSwap applying the cluster wide memory overcommit", v1.MemoryOvercommitBurstableWithSwap, "2048M"),
+		Entry("BestEffort not requesting it", v1.MemoryOvercommitBestEffort, ""),
+	)
+
 	It("should not apply memory overcommit when memory-request and guest-memory are set", func() {
 		vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
 			k8sv1.ResourceMemory: resource.MustParse("512M"),
@@ -685,6 +710,60 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		Expect(vmiMeta.Finalizers).To(ContainElement(v1.VirtualMachineInstanceFinalizer))
 	})
 
+	It("should resolve the device name of GPUs requesting a mediated device profile", func() {
+		vmi.Spec.Domain.Devices.GPUs = []v1.GPU{
+			{Name: "gpu1", Profile: "small"},
+			{Name: "gpu2", DeviceName: "nvidia.com/GRID_T4-1Q"},
+		}
+		_, vmiSpec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+		Expect(vmiSpec.Domain.Devices.GPUs[0].DeviceName).To(Equal(v1.MediatedDeviceProfileResourcePrefix + "small"))
+		Expect(vmiSpec.Domain.Devices.GPUs[1].DeviceName).To(Equal("nvidia.com/GRID_T4-1Q"))
+	})
+
+	DescribeTable("should attach the VirtIO driver ISO", func(autoattach *bool, vmiAutoattach *bool, expectAttached bool) {
+		const image = "quay.io/kubevirt/virtio-container-disk:v1.7.0"
+		kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+		kvCR.Spec.Configuration.VirtIODrivers = &v1.VirtIODriversConfiguration{Autoattach: autoattach, Image: image}
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+		vmi.Spec.Domain.Devices.AutoattachVirtIODrivers = vmiAutoattach
+
+		_, vmiSpec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+		if !expectAttached {
+			Expect(vmiSpec.Volumes).ToNot(ContainElement(HaveField("Name", virtIODriversVolumeName)))
+			return
+		}
+		Expect(vmiSpec.Domain.Devices.Disks).To(ContainElement(SatisfyAll(
+			HaveField("Name", virtIODriversVolumeName),
+			HaveField("DiskDevice.CDRom", Not(BeNil())),
+		)))
+		Expect(vmiSpec.Volumes).To(ContainElement(SatisfyAll(
+			HaveField("Name", virtIODriversVolumeName),
+			HaveField("VolumeSource.ContainerDisk.Image", image),
+		)))
+	},
+		Entry("when enabled in the cluster and requested by the VMI", pointer.P(true), pointer.P(true), true),
+		Entry("not when not requested by the VMI", pointer.P(true), nil, false),
+		Entry("not when the VMI opts out", pointer.P(true), pointer.P(false), false),
+		Entry("not when disabled in the cluster", nil, pointer.P(true), false),
+	)
+
+	It("should not attach the VirtIO driver ISO when the VMI has a volume with the same name", func() {
+		kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+		kvCR.Spec.Configuration.VirtIODrivers = &v1.VirtIODriversConfiguration{Autoattach: pointer.P(true), Image: "virtio-container-disk"}
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+		vmi.Spec.Domain.Devices.AutoattachVirtIODrivers = pointer.P(true)
+		vmi.Spec.Volumes = []v1.Volume{{
+			Name: virtIODriversVolumeName,
+			VolumeSource: v1.VolumeSource{
+				ContainerDisk: &v1.ContainerDiskSource{Image: "custom-drivers"},
+			},
+		}}
+
+		_, vmiSpec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+		Expect(vmiSpec.Volumes).To(HaveLen(1))
+		Expect(vmiSpec.Volumes[0].ContainerDisk.Image).To(Equal("custom-drivers"))
+	})
+
 	It("should copy cpu limits to requests if only limits are set", func() {
 		vmi.Spec.Domain.Resources = v1.ResourceRequirements{
 			Requests: k8sv1.ResourceList{},
@@ -1202,6 +1281,49 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		}),
 	)
 
+	DescribeTable("ksmMergePolicy should be", func(clusterPolicy, vmiPolicy, expected v1.KSMMergePolicy) {
+		kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+		kvCR.Spec.Configuration.KSMConfiguration = &v1.KSMConfiguration{MergePolicy: clusterPolicy}
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+		vmi.Spec.Domain.Memory = &v1.Memory{KSMMergePolicy: vmiPolicy}
+
+		_, vmiSpec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+		Expect(vmiSpec.Domain.Memory.KSMMergePolicy).To(Equal(expected))
+	},
+		Entry("empty if nothing is set", v1.KSMMergePolicy(""), v1.KSMMergePolicy(""), v1.KSMMergePolicy("")),
+		Entry("the one set cluster-wide", v1.KSMMergePolicyDisabled, v1.KSMMergePolicy(""), v1.KSMMergePolicyDisabled),
+		Entry("the one set in the VMI if both cluster-wide and VMI are set", v1.KSMMergePolicyDisabled, v1.KSMMergePolicyAggressive, v1.KSMMergePolicyAggressive),
+	)
+
+	DescribeTable("ksmMergePolicy should follow the memory overcommit class", func(class v1.MemoryOvercommitClass, expected v1.KSMMergePolicy) {
+		kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+		kvCR.Spec.Configuration.KSMConfiguration = &v1.KSMConfiguration{MergePolicy: v1.KSMMergePolicyBalanced}
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+		vmi.Spec.Domain.Memory = &v1.Memory{Overcommit: class}
+
+		_, vmiSpec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+		Expect(vmiSpec.Domain.Memory.KSMMergePolicy).To(Equal(expected))
+	},
+		Entry("Disabled for Guaranteed", v1.MemoryOvercommitGuaranteed, v1.KSMMergePolicyDisabled),
+		Entry("the cluster-wide one for BurstableWithSwap", v1.MemoryOvercommitBurstableWithSwap, v1.KSMMergePolicyBalanced),
+		Entry("Aggressive for BestEffort", v1.MemoryOvercommitBestEffort, v1.KSMMergePolicyAggressive),
+	)
+
+	DescribeTable("priorityClassName should be", func(workloadClass, priorityClassName, expected string) {
+		kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+		kvCR.Spec.Configuration.WorkloadClasses = []v1.WorkloadClass{{Name: "batch", PriorityClassName: "low"}}
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+		vmi.Spec.WorkloadClass = workloadClass
+		vmi.Spec.PriorityClassName = priorityClassName
+
+		_, vmiSpec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+		Expect(vmiSpec.PriorityClassName).To(Equal(expected))
+	},
+		Entry("empty if no workload class is set", "", "", ""),
+		Entry("the one of the workload class", "batch", "", "low"),
+		Entry("the one set in the VMI", "batch", "high", "high"),
+	)
+
 	It("should set guest memory status on VMI creation", func() {
 		memory := resource.MustParse("128Mi")
 		vmi.Spec.Domain.Memory = &v1.Memory{
@@ -1347,6 +1469,18 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 				_, spec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
 				Expect(spec.Domain.CPU.MaxSockets).To(Equal(uint32(3)))
 			})
+
+			It("to not set max sockets when guest NUMA cells are defined", func() {
+				vmi.Spec.Domain.CPU = &v1.CPU{
+					Sockets: 2,
+					NUMA: &v1.NUMA{GuestCells: []v1.NUMAGuestCell{
+						{ID: 0, CPUs: "0", Memory: resource.MustParse("64Mi")},
+						{ID: 1, CPUs: "1", Memory: resource.MustParse("64Mi")},
+					}},
+				}
+				_, spec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+				Expect(spec.Domain.CPU.MaxSockets).To(BeZero())
+			})
 		})
 		Context("configure Memory hotplug", func() {
 			It("to keep VMI values of max guest when provided", func() {
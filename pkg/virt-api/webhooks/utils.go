@@ -25,8 +25,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
+	autoscalingv1 "kubevirt.io/api/autoscaling/v1alpha1"
 	v1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	quotav1 "kubevirt.io/api/quota/v1alpha1"
 )
 
 var Arch = runtime.GOARCH
@@ -61,6 +64,24 @@ var VirtualMachinePoolGroupVersionResource = metav1.GroupVersionResource{
 	Resource: "virtualmachinepools",
 }
 
+var VirtualMachineAutoscalingPolicyGroupVersionResource = metav1.GroupVersionResource{
+	Group:    autoscalingv1.SchemeGroupVersion.Group,
+	Version:  autoscalingv1.SchemeGroupVersion.Version,
+	Resource: "virtualmachineautoscalingpolicies",
+}
+
+var VirtualMachineResourceQuotaGroupVersionResource = metav1.GroupVersionResource{
+	Group:    quotav1.SchemeGroupVersion.Group,
+	Version:  quotav1.SchemeGroupVersion.Version,
+	Resource: "virtualmachineresourcequotas",
+}
+
+var VirtualMachineDisruptionBudgetGroupVersionResource = metav1.GroupVersionResource{
+	Group:    migrationsv1.SchemeGroupVersion.Group,
+	Version:  migrationsv1.SchemeGroupVersion.Version,
+	Resource: "virtualmachinedisruptionbudgets",
+}
+
 var MigrationGroupVersionResource = metav1.GroupVersionResource{
 	Group:    v1.VirtualMachineInstanceMigrationGroupVersionKind.Group,
 	Version:  v1.VirtualMachineInstanceMigrationGroupVersionKind.Version,
@@ -68,8 +89,11 @@ var MigrationGroupVersionResource = metav1.GroupVersionResource{
 }
 
 type Informers struct {
-	VMIPresetInformer  cache.SharedIndexInformer
-	VMRestoreInformer  cache.SharedIndexInformer
-	DataSourceInformer cache.SharedIndexInformer
-	NamespaceInformer  cache.SharedIndexInformer
+	VMIPresetInformer        cache.SharedIndexInformer
+	VMRestoreInformer        cache.SharedIndexInformer
+	DataSourceInformer       cache.SharedIndexInformer
+	NamespaceInformer        cache.SharedIndexInformer
+	VMResourceQuotaInformer  cache.SharedIndexInformer
+	VMDefaultInformer        cache.SharedIndexInformer
+	VMClusterDefaultInformer cache.SharedIndexInformer
 }
@@ -34,6 +34,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubevirt"
 
+	migrationsutil "kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -101,6 +102,7 @@ func (admitter *MigrationCreateAdmitter) Admit(ctx context.Context, ar *admissio
 	}
 
 	causes := ValidateVirtualMachineInstanceMigrationSpec(k8sfield.NewPath("spec"), &migration.Spec)
+	causes = append(causes, admitter.validateMigrationNetwork(k8sfield.NewPath("spec", "migration", "network"), migration.Spec.Migration)...)
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -154,6 +156,20 @@ func (admitter *MigrationCreateAdmitter) Admit(ctx context.Context, ar *admissio
 	return &reviewResponse
 }
 
+func (admitter *MigrationCreateAdmitter) validateMigrationNetwork(field *k8sfield.Path, overrides *v1.MigrationOverrides) []metav1.StatusCause {
+	if overrides == nil || overrides.Network == nil {
+		return nil
+	}
+	if migrationsutil.IsMigrationNetworkConfigured(admitter.clusterConfig.GetMigrationConfiguration(), *overrides.Network) {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("network %s is not a configured migration network", *overrides.Network),
+		Field:   field.String(),
+	}}
+}
+
 func getAdmissionReviewMigration(ar *admissionv1.AdmissionReview) (new *v1.VirtualMachineInstanceMigration, old *v1.VirtualMachineInstanceMigration, err error) {
 
 	if !webhookutils.ValidateRequestResource(ar.Request.Resource, webhooks.MigrationGroupVersionResource.Group, webhooks.MigrationGroupVersionResource.Resource) {
@@ -192,5 +208,117 @@ func ValidateVirtualMachineInstanceMigrationSpec(field *k8sfield.Path, spec *v1.
 		})
 	}
 
+	if spec.Migration != nil {
+		causes = append(causes, validateMigrationOverrides(field.Child("migration"), spec.Migration)...)
+	}
+
+	if spec.RetryPolicy != nil {
+		causes = append(causes, validateMigrationRetryPolicy(field.Child("retryPolicy"), spec)...)
+	}
+
+	if spec.Warm != nil && (spec.SendTo != nil || spec.Receive != nil) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "warm cannot be used together with sendTo or receive",
+			Field:   field.Child("warm").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateMigrationRetryPolicy(field *k8sfield.Path, spec *v1.VirtualMachineInstanceMigrationSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	policy := spec.RetryPolicy
+
+	if spec.SendTo != nil || spec.Receive != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "retryPolicy cannot be used together with sendTo or receive",
+			Field:   field.String(),
+		})
+	}
+	if policy.Backoff != nil && policy.Backoff.Duration <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "backoff must be positive",
+			Field:   field.Child("backoff").String(),
+		})
+	}
+	if policy.MaxBackoff != nil && policy.MaxBackoff.Duration <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxBackoff must be positive",
+			Field:   field.Child("maxBackoff").String(),
+		})
+	} else if policy.MaxBackoff != nil && policy.Backoff != nil && policy.MaxBackoff.Duration < policy.Backoff.Duration {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxBackoff must not be shorter than backoff",
+			Field:   field.Child("maxBackoff").String(),
+		})
+	}
+
+	return causes
+}
+
+// QEMU supports up to 255 multifd channels
+const maxParallelMigrationThreads = 255
+
+func validateMigrationOverrides(field *k8sfield.Path, overrides *v1.MigrationOverrides) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	parallel := overrides.ParallelMigrationThreads != nil
+	if parallel && (*overrides.ParallelMigrationThreads < 1 || *overrides.ParallelMigrationThreads > maxParallelMigrationThreads) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("parallelMigrationThreads must be between 1 and %d", maxParallelMigrationThreads),
+			Field:   field.Child("parallelMigrationThreads").String(),
+		})
+	}
+	if parallel && overrides.AllowPostCopy != nil && *overrides.AllowPostCopy {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "parallelMigrationThreads cannot be used together with allowPostCopy",
+			Field:   field.Child("parallelMigrationThreads").String(),
+		})
+	}
+
+	if overrides.BandwidthPerMigration != nil && overrides.BandwidthPerMigration.Sign() < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "bandwidthPerMigration must not be negative",
+			Field:   field.Child("bandwidthPerMigration").String(),
+		})
+	}
+
+	if overrides.Compression != nil {
+		compressionField := field.Child("compression").String()
+		switch *overrides.Compression {
+		case v1.MigrationCompressionXBZRLE:
+			if parallel {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("compression %s cannot be used together with parallelMigrationThreads", *overrides.Compression),
+					Field:   compressionField,
+				})
+			}
+		case v1.MigrationCompressionZlib, v1.MigrationCompressionZstd:
+			if !parallel {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("compression %s requires parallelMigrationThreads", *overrides.Compression),
+					Field:   compressionField,
+				})
+			}
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("unsupported compression %s", *overrides.Compression),
+				Field:   compressionField,
+			})
+		}
+	}
+
 	return causes
 }
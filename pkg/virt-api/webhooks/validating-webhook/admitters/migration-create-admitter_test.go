@@ -22,11 +22,13 @@ package admitters_test
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -34,6 +36,7 @@ import (
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks/validating-webhook/admitters"
@@ -218,6 +221,126 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 		)
 	})
 
+	Context("migration overrides", func() {
+		DescribeTable("should validate migration overrides", func(overrides *v1.MigrationOverrides, expectedField string) {
+			vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+			migration := createMigration(vmi.Namespace, testMigrationName, vmi.Name)
+			migration.Spec.Migration = overrides
+
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(vmi), config)
+			ar, err := newAdmissionReviewForVMIMCreation(migration)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+			if expectedField == "" {
+				Expect(resp.Allowed).To(BeTrue())
+				return
+			}
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("accept multifd with zstd compression",
+				&v1.MigrationOverrides{
+					ParallelMigrationThreads: pointer.P(uint32(4)),
+					BandwidthPerMigration:    pointer.P(resource.MustParse("1Gi")),
+					AllowAutoConverge:        pointer.P(true),
+					Compression:              pointer.P(v1.MigrationCompressionZstd),
+				}, ""),
+			Entry("accept post-copy with xbzrle compression",
+				&v1.MigrationOverrides{
+					AllowPostCopy: pointer.P(true),
+					Compression:   pointer.P(v1.MigrationCompressionXBZRLE),
+				}, ""),
+			Entry("reject zero parallel migration threads",
+				&v1.MigrationOverrides{ParallelMigrationThreads: pointer.P(uint32(0))},
+				"spec.migration.parallelMigrationThreads"),
+			Entry("reject too many parallel migration threads",
+				&v1.MigrationOverrides{ParallelMigrationThreads: pointer.P(uint32(256))},
+				"spec.migration.parallelMigrationThreads"),
+			Entry("reject parallel migration threads with post-copy",
+				&v1.MigrationOverrides{ParallelMigrationThreads: pointer.P(uint32(2)), AllowPostCopy: pointer.P(true)},
+				"spec.migration.parallelMigrationThreads"),
+			Entry("reject negative bandwidth",
+				&v1.MigrationOverrides{BandwidthPerMigration: pointer.P(resource.MustParse("-1Mi"))},
+				"spec.migration.bandwidthPerMigration"),
+			Entry("reject zstd compression without parallel migration threads",
+				&v1.MigrationOverrides{Compression: pointer.P(v1.MigrationCompressionZstd)},
+				"spec.migration.compression"),
+			Entry("reject xbzrle compression with parallel migration threads",
+				&v1.MigrationOverrides{ParallelMigrationThreads: pointer.P(uint32(2)), Compression: pointer.P(v1.MigrationCompressionXBZRLE)},
+				"spec.migration.compression"),
+		)
+
+		DescribeTable("should validate the migration network", func(network string, allowed bool) {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.MigrationConfiguration = &v1.MigrationConfiguration{
+				Network:            pointer.P("migration-net"),
+				AdditionalNetworks: []string{"fast-net"},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+			vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+			migration := createMigration(vmi.Namespace, testMigrationName, vmi.Name)
+			migration.Spec.Migration = &v1.MigrationOverrides{Network: pointer.P(network)}
+
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(vmi), config)
+			ar, err := newAdmissionReviewForVMIMCreation(migration)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(Equal(allowed))
+			if !allowed {
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.migration.network"))
+			}
+		},
+			Entry("accept the cluster migration network", "migration-net", true),
+			Entry("accept an additional migration network", "fast-net", true),
+			Entry("reject a network which is not a migration network", "other-net", false),
+		)
+	})
+
+	Context("retry policy", func() {
+		DescribeTable("should validate the retry policy", func(policy *v1.MigrationRetryPolicy, expectedField string) {
+			vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+			migration := createMigration(vmi.Namespace, testMigrationName, vmi.Name)
+			migration.Spec.RetryPolicy = policy
+
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(vmi), config)
+			ar, err := newAdmissionReviewForVMIMCreation(migration)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+			if expectedField == "" {
+				Expect(resp.Allowed).To(BeTrue())
+				return
+			}
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("accept retries with default backoff", &v1.MigrationRetryPolicy{MaxRetries: 3}, ""),
+			Entry("accept retries with backoff",
+				&v1.MigrationRetryPolicy{
+					MaxRetries: 3,
+					Backoff:    &metav1.Duration{Duration: 10 * time.Second},
+					MaxBackoff: &metav1.Duration{Duration: time.Minute},
+				}, ""),
+			Entry("reject zero backoff",
+				&v1.MigrationRetryPolicy{Backoff: &metav1.Duration{}},
+				"spec.retryPolicy.backoff"),
+			Entry("reject negative max backoff",
+				&v1.MigrationRetryPolicy{MaxBackoff: &metav1.Duration{Duration: -time.Second}},
+				"spec.retryPolicy.maxBackoff"),
+			Entry("reject max backoff shorter than backoff",
+				&v1.MigrationRetryPolicy{
+					Backoff:    &metav1.Duration{Duration: time.Minute},
+					MaxBackoff: &metav1.Duration{Duration: time.Second},
+				}, "spec.retryPolicy.maxBackoff"),
+		)
+	})
+
 	Context("feature gate", func() {
 		DescribeTable("should handle migration correctly based on featuregate", func(modifyMigration func(*v1.VirtualMachineInstanceMigration), featureGateEnabled, expectAllow bool) {
 			vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
@@ -273,6 +396,21 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 						MigrationID: "migrationID",
 					}
 				}, true, true),
+			Entry("reject warm vmim with sendTo if featuregate is enabled",
+				func(migration *v1.VirtualMachineInstanceMigration) {
+					migration.Spec.Warm = &v1.WarmMigration{}
+					migration.Spec.SendTo = &v1.VirtualMachineInstanceMigrationSource{
+						MigrationID: "migrationID",
+						ConnectURL:  "1.1.1.1:12345",
+					}
+				}, true, false),
+			Entry("reject warm vmim with receive if featuregate is enabled",
+				func(migration *v1.VirtualMachineInstanceMigration) {
+					migration.Spec.Warm = &v1.WarmMigration{}
+					migration.Spec.Receive = &v1.VirtualMachineInstanceMigrationTarget{
+						MigrationID: "migrationID",
+					}
+				}, true, false),
 			Entry("reject vmim with sendTo and receiver if migrationID does not match if featuregate is enabled",
 				func(migration *v1.VirtualMachineInstanceMigration) {
 					migration.Spec.SendTo = &v1.VirtualMachineInstanceMigrationSource{
@@ -63,6 +63,18 @@ func ensureSelectorLabelSafe(newMigration *v1.VirtualMachineInstanceMigration, o
 	return []metav1.StatusCause{}
 }
 
+// isWarmMigrationCutover returns true if the only change of the spec is the cutover request of a warm migration
+func isWarmMigrationCutover(newMigration *v1.VirtualMachineInstanceMigration, oldMigration *v1.VirtualMachineInstanceMigration) bool {
+	if oldMigration.Spec.Warm == nil || newMigration.Spec.Warm == nil ||
+		oldMigration.Spec.Warm.Cutover || !newMigration.Spec.Warm.Cutover {
+		return false
+	}
+
+	oldSpec := oldMigration.Spec.DeepCopy()
+	oldSpec.Warm.Cutover = true
+	return equality.Semantic.DeepEqual(newMigration.Spec, *oldSpec)
+}
+
 func (admitter *MigrationUpdateAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
 	// Get new migration from admission response
 	newMigration, oldMigration, err := getAdmissionReviewMigration(ar)
@@ -74,8 +86,8 @@ func (admitter *MigrationUpdateAdmitter) Admit(_ context.Context, ar *admissionv
 		return resp
 	}
 
-	// Reject Migration update if spec changed
-	if !equality.Semantic.DeepEqual(newMigration.Spec, oldMigration.Spec) {
+	// Reject Migration update if spec changed, unless the cutover of a warm migration is requested
+	if !equality.Semantic.DeepEqual(newMigration.Spec, oldMigration.Spec) && !isWarmMigrationCutover(newMigration, oldMigration) {
 		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 			{
 				Type:    metav1.CauseTypeFieldValueNotSupported,
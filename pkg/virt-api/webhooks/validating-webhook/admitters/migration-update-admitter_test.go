@@ -103,6 +103,35 @@ var _ = Describe("Validating MigrationUpdate Admitter", func() {
 		Expect(resp).To(Equal(allowedAdmissionResponse()))
 	})
 
+	DescribeTable("should handle the cutover of a warm migration", func(oldWarm, newWarm *v1.WarmMigration, expectAllowed bool) {
+		migration := &v1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "somemigration",
+				Namespace: "default",
+				UID:       "1234",
+			},
+			Spec: v1.VirtualMachineInstanceMigrationSpec{
+				VMIName: "testmigratevmiupdate-warm",
+				Warm:    oldWarm,
+			},
+		}
+
+		newMigration := migration.DeepCopy()
+		newMigration.Spec.Warm = newWarm
+
+		ar, err := newAdmissionReviewForVMIMUpdate(migration, newMigration)
+		Expect(err).ToNot(HaveOccurred())
+
+		admitter := &admitters.MigrationUpdateAdmitter{}
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(Equal(expectAllowed))
+	},
+		Entry("accept requesting the cutover", &v1.WarmMigration{}, &v1.WarmMigration{Cutover: true}, true),
+		Entry("reject withdrawing the cutover", &v1.WarmMigration{Cutover: true}, &v1.WarmMigration{}, false),
+		Entry("reject making a migration warm", nil, &v1.WarmMigration{Cutover: true}, false),
+		Entry("reject making a warm migration cold", &v1.WarmMigration{}, nil, false),
+	)
+
 	It("should reject Migration on update if labels include our selector and are removed", func() {
 		vmi := libvmi.New(libvmi.WithName("testmigratevmiupdate-labelsremoved"))
 
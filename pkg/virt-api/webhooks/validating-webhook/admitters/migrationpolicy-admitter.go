@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -33,6 +34,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	migrationsutil "kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 )
 
@@ -87,6 +89,10 @@ func (admitter *MigrationPolicyAdmitter) Admit(_ context.Context, ar *admissionv
 		}
 	}
 
+	if spec.BandwidthSchedule != nil {
+		causes = append(causes, validateBandwidthSchedule(sourceField.Child("bandwidthSchedule"), spec.BandwidthSchedule)...)
+	}
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -96,3 +102,61 @@ func (admitter *MigrationPolicyAdmitter) Admit(_ context.Context, ar *admissionv
 	}
 	return &reviewResponse
 }
+
+func validateBandwidthSchedule(field *k8sfield.Path, schedule *migrationsv1.BandwidthSchedule) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if schedule.TimeZone != "" {
+		if _, err := time.LoadLocation(schedule.TimeZone); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("unknown time zone %s", schedule.TimeZone),
+				Field:   field.Child("timeZone").String(),
+			})
+		}
+	}
+
+	if len(schedule.Windows) == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "at least one window is required",
+			Field:   field.Child("windows").String(),
+		})
+	}
+
+	for i, window := range schedule.Windows {
+		windowField := field.Child("windows").Index(i)
+		if _, err := migrationsutil.ParseTimeOfDay(window.Start); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: err.Error(),
+				Field:   windowField.Child("start").String(),
+			})
+		}
+		if _, err := migrationsutil.ParseTimeOfDay(window.End); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: err.Error(),
+				Field:   windowField.Child("end").String(),
+			})
+		}
+		for j, day := range window.Days {
+			if _, err := migrationsutil.ParseWeekday(day); err != nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: err.Error(),
+					Field:   windowField.Child("days").Index(j).String(),
+				})
+			}
+		}
+		if window.BandwidthPerMigration.Sign() < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must not be negative",
+				Field:   windowField.Child("bandwidthPerMigration").String(),
+			})
+		}
+	}
+
+	return causes
+}
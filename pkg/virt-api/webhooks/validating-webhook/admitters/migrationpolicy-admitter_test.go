@@ -64,6 +64,26 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 		Entry("negative CompletionTimeoutPerGiB",
 			migrationsv1.MigrationPolicySpec{CompletionTimeoutPerGiB: pointer.P(int64(-1))},
 		),
+
+		Entry("BandwidthSchedule without windows",
+			migrationsv1.MigrationPolicySpec{BandwidthSchedule: &migrationsv1.BandwidthSchedule{}},
+		),
+
+		Entry("BandwidthSchedule with unknown time zone",
+			newBandwidthSchedulePolicySpec("Mars/Olympus_Mons", "09:00", "17:00", "Monday"),
+		),
+
+		Entry("BandwidthSchedule with invalid window start",
+			newBandwidthSchedulePolicySpec("", "9am", "17:00", "Monday"),
+		),
+
+		Entry("BandwidthSchedule with invalid window end",
+			newBandwidthSchedulePolicySpec("", "09:00", "24:00", "Monday"),
+		),
+
+		Entry("BandwidthSchedule with invalid window day",
+			newBandwidthSchedulePolicySpec("", "09:00", "17:00", "Mon"),
+		),
 	)
 
 	DescribeTable("should accept migration policy with", func(policySpec migrationsv1.MigrationPolicySpec) {
@@ -93,9 +113,27 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 		Entry("empty spec",
 			migrationsv1.MigrationPolicySpec{},
 		),
+
+		Entry("BandwidthSchedule",
+			newBandwidthSchedulePolicySpec("Europe/Berlin", "22:00", "06:00", "Saturday"),
+		),
 	)
 })
 
+func newBandwidthSchedulePolicySpec(timeZone, start, end, day string) migrationsv1.MigrationPolicySpec {
+	return migrationsv1.MigrationPolicySpec{
+		BandwidthSchedule: &migrationsv1.BandwidthSchedule{
+			TimeZone: timeZone,
+			Windows: []migrationsv1.BandwidthWindow{{
+				Start:                 start,
+				End:                   end,
+				Days:                  []string{day},
+				BandwidthPerMigration: resource.MustParse("64Mi"),
+			}},
+		},
+	}
+}
+
 func createPolicyAdmissionReview(policy *migrationsv1.MigrationPolicy, namespace string) *admissionv1.AdmissionReview {
 	policyBytes, _ := json.Marshal(policy)
 
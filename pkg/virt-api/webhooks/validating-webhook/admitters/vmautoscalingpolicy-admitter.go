@@ -0,0 +1,169 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/robfig/cron/v3"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	autoscalingv1alpha1 "kubevirt.io/api/autoscaling/v1alpha1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+type VMAutoscalingPolicyAdmitter struct{}
+
+func (admitter *VMAutoscalingPolicyAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	gvr := webhooks.VirtualMachineAutoscalingPolicyGroupVersionResource
+	if ar.Request == nil {
+		err := fmt.Errorf("Empty request for virtual machine autoscaling policy validation")
+		return webhookutils.ToAdmissionResponseError(err)
+	} else if ar.Request.Resource != gvr {
+		err := fmt.Errorf("expect resource %+v, but got %+v", gvr, ar.Request.Resource)
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	gvk := schema.GroupVersionKind{
+		Group:   gvr.Group,
+		Version: gvr.Version,
+		Kind:    autoscalingv1alpha1.VirtualMachineAutoscalingPolicyKind,
+	}
+
+	if resp := webhookutils.ValidateSchema(gvk, ar.Request.Object.Raw); resp != nil {
+		return resp
+	}
+
+	policy := autoscalingv1alpha1.VirtualMachineAutoscalingPolicy{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, &policy); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	if causes := ValidateVMAutoscalingPolicySpec(k8sfield.NewPath("spec"), &policy.Spec); len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	return &admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+}
+
+func ValidateVMAutoscalingPolicySpec(field *k8sfield.Path, spec *autoscalingv1alpha1.VirtualMachineAutoscalingPolicySpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.Selector == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "missing selector.",
+			Field:   field.Child("selector").String(),
+		})
+	} else if _, err := metav1.LabelSelectorAsSelector(spec.Selector); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: err.Error(),
+			Field:   field.Child("selector").String(),
+		})
+	}
+
+	if spec.CPU == nil && spec.Memory == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "at least one of cpu and memory must be set.",
+			Field:   field.String(),
+		})
+	}
+
+	if spec.CPU != nil {
+		cpuField := field.Child("cpu")
+		if spec.CPU.MinSockets < 1 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "minSockets must be at least 1.",
+				Field:   cpuField.Child("minSockets").String(),
+			})
+		}
+		if spec.CPU.MaxSockets < spec.CPU.MinSockets {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "maxSockets must not be lower than minSockets.",
+				Field:   cpuField.Child("maxSockets").String(),
+			})
+		}
+		causes = append(causes, validateTargetUtilization(cpuField.Child("targetUtilizationPercentage"), spec.CPU.TargetUtilizationPercentage)...)
+	}
+
+	if spec.Memory != nil {
+		memoryField := field.Child("memory")
+		if spec.Memory.Min.Sign() <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "min must be positive.",
+				Field:   memoryField.Child("min").String(),
+			})
+		}
+		if spec.Memory.Max.Cmp(spec.Memory.Min) < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "max must not be lower than min.",
+				Field:   memoryField.Child("max").String(),
+			})
+		}
+		causes = append(causes, validateTargetUtilization(memoryField.Child("targetUtilizationPercentage"), spec.Memory.TargetUtilizationPercentage)...)
+	}
+
+	if spec.Cooldown != nil && spec.Cooldown.Duration < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "cooldown must not be negative.",
+			Field:   field.Child("cooldown").String(),
+		})
+	}
+
+	if spec.RestartFallback != nil {
+		if _, err := cron.ParseStandard(spec.RestartFallback.Schedule); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid schedule: %v", err),
+				Field:   field.Child("restartFallback", "schedule").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validateTargetUtilization(field *k8sfield.Path, target *int32) []metav1.StatusCause {
+	if target == nil || (*target > 0 && *target <= 100) {
+		return nil
+	}
+
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: "targetUtilizationPercentage must be between 1 and 100.",
+		Field:   field.String(),
+	}}
+}
@@ -0,0 +1,150 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	autoscalingv1alpha1 "kubevirt.io/api/autoscaling/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+var _ = Describe("Validating VirtualMachineAutoscalingPolicy Admitter", func() {
+	admitter := &VMAutoscalingPolicyAdmitter{}
+
+	newPolicy := func() *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy {
+		return &autoscalingv1alpha1.VirtualMachineAutoscalingPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "policy",
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: autoscalingv1alpha1.VirtualMachineAutoscalingPolicySpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "autoscaled"},
+				},
+				CPU: &autoscalingv1alpha1.VirtualMachineCPUAutoscaling{
+					MinSockets: 1,
+					MaxSockets: 4,
+				},
+				Memory: &autoscalingv1alpha1.VirtualMachineMemoryAutoscaling{
+					Min: resource.MustParse("1Gi"),
+					Max: resource.MustParse("4Gi"),
+				},
+			},
+		}
+	}
+
+	admit := func(policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy) *admissionv1.AdmissionResponse {
+		policyBytes, err := json.Marshal(policy)
+		Expect(err).ToNot(HaveOccurred())
+
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Resource: webhooks.VirtualMachineAutoscalingPolicyGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: policyBytes,
+				},
+			},
+		}
+		return admitter.Admit(context.Background(), ar)
+	}
+
+	It("should reject an unexpected resource", func() {
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Resource: webhooks.VirtualMachinePoolGroupVersionResource,
+			},
+		}
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("expect resource"))
+	})
+
+	It("should accept a valid policy", func() {
+		policy := newPolicy()
+		policy.Spec.Cooldown = &metav1.Duration{Duration: 10 * time.Minute}
+		policy.Spec.RestartFallback = &autoscalingv1alpha1.VirtualMachineAutoscalingRestartFallback{
+			Schedule: "0 2 * * *",
+		}
+
+		resp := admit(policy)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	DescribeTable("should reject an invalid policy", func(update func(*autoscalingv1alpha1.VirtualMachineAutoscalingPolicy), fields ...string) {
+		policy := newPolicy()
+		update(policy)
+
+		resp := admit(policy)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(len(fields)))
+		for i, field := range fields {
+			Expect(resp.Result.Details.Causes[i].Field).To(Equal(field))
+		}
+	},
+		Entry("without cpu and memory", func(policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy) {
+			policy.Spec.CPU = nil
+			policy.Spec.Memory = nil
+		}, "spec"),
+		Entry("with an invalid selector", func(policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy) {
+			policy.Spec.Selector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Bogus"}},
+			}
+		}, "spec.selector"),
+		Entry("with zero minSockets", func(policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy) {
+			policy.Spec.CPU.MinSockets = 0
+		}, "spec.cpu.minSockets"),
+		Entry("with maxSockets lower than minSockets", func(policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy) {
+			policy.Spec.CPU.MinSockets = 4
+			policy.Spec.CPU.MaxSockets = 2
+		}, "spec.cpu.maxSockets"),
+		Entry("with a cpu target utilization above 100", func(policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy) {
+			policy.Spec.CPU.TargetUtilizationPercentage = pointer.P(int32(150))
+		}, "spec.cpu.targetUtilizationPercentage"),
+		Entry("with zero min memory", func(policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy) {
+			policy.Spec.Memory.Min = resource.MustParse("0")
+		}, "spec.memory.min"),
+		Entry("with max memory lower than min memory", func(policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy) {
+			policy.Spec.Memory.Max = resource.MustParse("512Mi")
+		}, "spec.memory.max"),
+		Entry("with a memory target utilization of zero", func(policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy) {
+			policy.Spec.Memory.TargetUtilizationPercentage = pointer.P(int32(0))
+		}, "spec.memory.targetUtilizationPercentage"),
+		Entry("with a negative cooldown", func(policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy) {
+			policy.Spec.Cooldown = &metav1.Duration{Duration: -time.Minute}
+		}, "spec.cooldown"),
+		Entry("with an invalid restart schedule", func(policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy) {
+			policy.Spec.RestartFallback = &autoscalingv1alpha1.VirtualMachineAutoscalingRestartFallback{
+				Schedule: "not a schedule",
+			}
+		}, "spec.restartFallback.schedule"),
+	)
+})
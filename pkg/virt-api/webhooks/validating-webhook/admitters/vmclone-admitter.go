@@ -28,11 +28,18 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/link"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	clonebase "kubevirt.io/api/clone"
 	clone "kubevirt.io/api/clone/v1beta1"
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
@@ -104,6 +111,10 @@ func (admitter *VirtualMachineCloneAdmitter) Admit(ctx context.Context, ar *admi
 		causes = append(causes, newCauses...)
 	}
 
+	if newCauses := validateTargetNamespace(ctx, admitter.Client, vmClone, ar.Request.UserInfo); newCauses != nil {
+		causes = append(causes, newCauses...)
+	}
+
 	if newCauses := validateNewMacAddresses(vmClone); newCauses != nil {
 		causes = append(causes, newCauses...)
 	}
@@ -112,6 +123,14 @@ func (admitter *VirtualMachineCloneAdmitter) Admit(ctx context.Context, ar *admi
 		causes = append(causes, newCauses...)
 	}
 
+	if newCauses := validateCloneVolumes(ctx, admitter.Client, vmClone); newCauses != nil {
+		causes = append(causes, newCauses...)
+	}
+
+	if newCauses := validateCloneStorage(vmClone); newCauses != nil {
+		causes = append(causes, newCauses...)
+	}
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -235,7 +254,8 @@ func validateTarget(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 		target != nil &&
 		source.Kind == virtualMachineKind &&
 		target.Kind == virtualMachineKind &&
-		target.Name == source.Name {
+		target.Name == source.Name &&
+		(vmClone.Spec.TargetNamespace == "" || vmClone.Spec.TargetNamespace == vmClone.Namespace) {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
 			Message: "Target name cannot be equal to source name when both are VirtualMachines",
@@ -246,6 +266,61 @@ func validateTarget(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 	return causes
 }
 
+// validateTargetNamespace makes sure that the requesting user is allowed to create
+// VirtualMachines in the namespace the target is cloned to
+func validateTargetNamespace(ctx context.Context, client kubecli.KubevirtClient, vmClone *clone.VirtualMachineClone, userInfo authenticationv1.UserInfo) []metav1.StatusCause {
+	targetNamespace := vmClone.Spec.TargetNamespace
+	if targetNamespace == "" || targetNamespace == vmClone.Namespace {
+		return nil
+	}
+
+	targetNamespaceField := k8sfield.NewPath("spec").Child("targetNamespace").String()
+	if errs := validation.IsDNS1123Label(targetNamespace); len(errs) > 0 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("target namespace %s is invalid: %s", targetNamespace, strings.Join(errs, ", ")),
+			Field:   targetNamespaceField,
+		}}
+	}
+
+	extra := map[string]authv1.ExtraValue{}
+	for key, value := range userInfo.Extra {
+		extra[key] = authv1.ExtraValue(value)
+	}
+	sar := &authv1.SubjectAccessReview{
+		Spec: authv1.SubjectAccessReviewSpec{
+			User:   userInfo.Username,
+			Groups: userInfo.Groups,
+			UID:    userInfo.UID,
+			Extra:  extra,
+			ResourceAttributes: &authv1.ResourceAttributes{
+				Namespace: targetNamespace,
+				Verb:      "create",
+				Group:     v1.GroupVersion.Group,
+				Resource:  "virtualmachines",
+			},
+		},
+	}
+
+	response, err := client.AuthorizationV1().SubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+	if err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("cannot verify the permission to create VirtualMachines in target namespace %s: %v", targetNamespace, err),
+			Field:   targetNamespaceField,
+		}}
+	}
+	if !response.Status.Allowed {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("user %s is not allowed to create VirtualMachines in target namespace %s", userInfo.Username, targetNamespace),
+			Field:   targetNamespaceField,
+		}}
+	}
+
+	return nil
+}
+
 func validateNewMacAddresses(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
@@ -280,6 +355,214 @@ func validatePatches(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 	return causes
 }
 
+func validateCloneVolumes(ctx context.Context, client kubecli.KubevirtClient, vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
+	if len(vmClone.Spec.Volumes) == 0 {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	volumesField := k8sfield.NewPath("spec").Child("volumes")
+
+	volumeNames := map[string]struct{}{}
+	for i, volumeClone := range vmClone.Spec.Volumes {
+		if _, exists := volumeNames[volumeClone.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("volume %s is listed more than once", volumeClone.Name),
+				Field:   volumesField.Index(i).Child("name").String(),
+			})
+		}
+		volumeNames[volumeClone.Name] = struct{}{}
+
+		if volumeClone.Size == nil {
+			continue
+		}
+		if volumeClone.Exclude {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s cannot be both excluded and resized", volumeClone.Name),
+				Field:   volumesField.Index(i).Child("size").String(),
+			})
+		} else if volumeClone.Size.Sign() <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("size of volume %s must be positive", volumeClone.Name),
+				Field:   volumesField.Index(i).Child("size").String(),
+			})
+		}
+	}
+	if len(causes) > 0 || vmClone.Spec.Source == nil {
+		return causes
+	}
+
+	sourceVolumes, sourceSizes, err := getCloneSourceVolumes(ctx, client, vmClone)
+	if errors.IsNotFound(err) {
+		// The clone waits for the source to be created, its volumes are validated by the clone controller
+		return nil
+	} else if err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("cannot get volumes of source %s: %v", vmClone.Spec.Source.Name, err),
+			Field:   volumesField.String(),
+		}}
+	}
+
+	for i, volumeClone := range vmClone.Spec.Volumes {
+		sourceVolume := findVolume(sourceVolumes, volumeClone.Name)
+		if sourceVolume == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotFound,
+				Message: fmt.Sprintf("volume %s does not exist in source %s", volumeClone.Name, vmClone.Spec.Source.Name),
+				Field:   volumesField.Index(i).Child("name").String(),
+			})
+			continue
+		}
+
+		if volumeClone.Size == nil {
+			continue
+		}
+		if sourceVolume.PersistentVolumeClaim == nil && sourceVolume.DataVolume == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s cannot be resized, only PersistentVolumeClaim and DataVolume volumes can be resized", volumeClone.Name),
+				Field:   volumesField.Index(i).Child("size").String(),
+			})
+			continue
+		}
+		if sourceSize, exists := sourceSizes[volumeClone.Name]; exists && volumeClone.Size.Cmp(sourceSize) < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("size %s of volume %s is smaller than the size %s of the source volume", volumeClone.Size.String(), volumeClone.Name, sourceSize.String()),
+				Field:   volumesField.Index(i).Child("size").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+// validateCloneStorage makes sure that linked clones are only requested where the target can share the source volumes
+func validateCloneStorage(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
+	storage := vmClone.Spec.Storage
+	if storage == nil || storage.CloneStrategy == nil || *storage.CloneStrategy != clone.CloneStrategyLinked {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	cloneStrategyField := k8sfield.NewPath("spec").Child("storage").Child("cloneStrategy").String()
+
+	if vmClone.Spec.Source != nil && vmClone.Spec.Source.Kind != virtualMachineKind {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s clones are only supported for %s sources", clone.CloneStrategyLinked, virtualMachineKind),
+			Field:   cloneStrategyField,
+		})
+	}
+	if vmClone.Spec.TargetNamespace != "" && vmClone.Spec.TargetNamespace != vmClone.Namespace {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s clones cannot be created in another namespace", clone.CloneStrategyLinked),
+			Field:   cloneStrategyField,
+		})
+	}
+	for i, volumeClone := range vmClone.Spec.Volumes {
+		if volumeClone.Size != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s of a %s clone cannot be resized", volumeClone.Name, clone.CloneStrategyLinked),
+				Field:   k8sfield.NewPath("spec").Child("volumes").Index(i).Child("size").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+// getCloneSourceVolumes returns the volumes of the clone source, along with the storage size
+// requested by the PVCs backing the volumes which are resized by the clone.
+func getCloneSourceVolumes(ctx context.Context, client kubecli.KubevirtClient, vmClone *clone.VirtualMachineClone) ([]v1.Volume, map[string]resource.Quantity, error) {
+	source := vmClone.Spec.Source
+	sizes := map[string]resource.Quantity{}
+
+	switch source.Kind {
+	case virtualMachineKind:
+		vm, err := client.VirtualMachine(vmClone.Namespace).Get(ctx, source.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		if vm.Spec.Template == nil {
+			return nil, sizes, nil
+		}
+
+		volumes := vm.Spec.Template.Spec.Volumes
+		for _, volumeClone := range vmClone.Spec.Volumes {
+			if volumeClone.Size == nil {
+				continue
+			}
+			volume := findVolume(volumes, volumeClone.Name)
+			if volume == nil {
+				continue
+			}
+
+			var claimName string
+			switch {
+			case volume.PersistentVolumeClaim != nil:
+				claimName = volume.PersistentVolumeClaim.ClaimName
+			case volume.DataVolume != nil:
+				claimName = volume.DataVolume.Name
+			default:
+				continue
+			}
+
+			pvc, err := client.CoreV1().PersistentVolumeClaims(vmClone.Namespace).Get(ctx, claimName, metav1.GetOptions{})
+			if errors.IsNotFound(err) {
+				continue
+			} else if err != nil {
+				return nil, nil, err
+			}
+			if size, exists := pvc.Spec.Resources.Requests[k8sv1.ResourceStorage]; exists {
+				sizes[volumeClone.Name] = size
+			}
+		}
+		return volumes, sizes, nil
+
+	case virtualMachineSnapshotKind:
+		snapshot, err := client.VirtualMachineSnapshot(vmClone.Namespace).Get(ctx, source.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		if snapshot.Status == nil || snapshot.Status.VirtualMachineSnapshotContentName == nil {
+			return nil, nil, fmt.Errorf("snapshot %s has no content yet", snapshot.Name)
+		}
+
+		content, err := client.VirtualMachineSnapshotContent(vmClone.Namespace).Get(ctx, *snapshot.Status.VirtualMachineSnapshotContentName, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		if content.Spec.Source.VirtualMachine == nil || content.Spec.Source.VirtualMachine.Spec.Template == nil {
+			return nil, sizes, nil
+		}
+
+		for _, volumeBackup := range content.Spec.VolumeBackups {
+			if size, exists := volumeBackup.PersistentVolumeClaim.Spec.Resources.Requests[k8sv1.ResourceStorage]; exists {
+				sizes[volumeBackup.VolumeName] = size
+			}
+		}
+		return content.Spec.Source.VirtualMachine.Spec.Template.Spec.Volumes, sizes, nil
+	}
+
+	return nil, sizes, nil
+}
+
+func findVolume(volumes []v1.Volume, name string) *v1.Volume {
+	for i := range volumes {
+		if volumes[i].Name == name {
+			return &volumes[i]
+		}
+	}
+	return nil
+}
+
 func doesSliceContainStr(slice []string, str string) (isFound bool) {
 	for _, curSliceStr := range slice {
 		if curSliceStr == str {
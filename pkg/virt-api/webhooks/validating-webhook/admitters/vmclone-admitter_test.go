@@ -32,10 +32,15 @@ import (
 
 	"go.uber.org/mock/gomock"
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/rand"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 
 	clonebase "kubevirt.io/api/clone"
@@ -436,6 +441,232 @@ var _ = Describe("Validating VirtualMachineClone Admitter", func() {
 		})
 	})
 
+	Context("Target namespace", func() {
+		const targetNamespace = "tenant"
+
+		var (
+			k8sClient *k8sfake.Clientset
+			sar       *authv1.SubjectAccessReview
+		)
+
+		allowSAR := func(allowed bool) {
+			k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				sar = action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+				sar.Status.Allowed = allowed
+				return true, sar, nil
+			})
+		}
+
+		BeforeEach(func() {
+			sar = nil
+			k8sClient = k8sfake.NewSimpleClientset()
+			virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
+			vmClone.Spec.TargetNamespace = targetNamespace
+		})
+
+		It("should allow a target namespace in which the user can create VirtualMachines", func() {
+			allowSAR(true)
+			ar := createCloneAdmissionReview(vmClone)
+			ar.Request.UserInfo = authenticationv1.UserInfo{Username: "tenant-user", Groups: []string{"tenants"}}
+
+			resp := admitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(sar).ToNot(BeNil())
+			Expect(sar.Spec.User).To(Equal("tenant-user"))
+			Expect(sar.Spec.Groups).To(ConsistOf("tenants"))
+			Expect(sar.Spec.ResourceAttributes).To(Equal(&authv1.ResourceAttributes{
+				Namespace: targetNamespace,
+				Verb:      "create",
+				Group:     core.GroupName,
+				Resource:  "virtualmachines",
+			}))
+		})
+
+		It("should reject a target namespace in which the user cannot create VirtualMachines", func() {
+			allowSAR(false)
+			admitter.admitAndExpect(vmClone, false)
+		})
+
+		It("should reject an invalid target namespace", func() {
+			vmClone.Spec.TargetNamespace = "Not_A_Namespace"
+			admitter.admitAndExpect(vmClone, false)
+			Expect(sar).To(BeNil())
+		})
+
+		It("should not check permissions when the target namespace is the namespace of the clone", func() {
+			vmClone.Spec.TargetNamespace = vmClone.Namespace
+			admitter.admitAndExpect(vmClone, true)
+			Expect(sar).To(BeNil())
+		})
+
+		It("should allow the target to have the same name as the source VM", func() {
+			allowSAR(true)
+			vmClone.Spec.Target.Name = vmClone.Spec.Source.Name
+			admitter.admitAndExpect(vmClone, true)
+		})
+	})
+
+	Context("Volumes", func() {
+		var k8sClient *k8sfake.Clientset
+
+		newPVC := func(name, size string) *k8sv1.PersistentVolumeClaim {
+			return &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: k8sv1.PersistentVolumeClaimSpec{
+					Resources: k8sv1.VolumeResourceRequirements{
+						Requests: k8sv1.ResourceList{
+							k8sv1.ResourceStorage: resource.MustParse(size),
+						},
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			vm.Spec.Template.Spec.Volumes[0].DataVolume.Name = "dv"
+			vm.Spec.Template.Spec.Volumes[1].PersistentVolumeClaim.ClaimName = "pvc"
+			k8sClient = k8sfake.NewSimpleClientset(newPVC("dv", "5Gi"), newPVC("pvc", "1Gi"))
+			virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		})
+
+		It("should allow to exclude and resize volumes of the source VM", func() {
+			vmClone.Spec.Volumes = []clone.VirtualMachineCloneVolume{
+				{Name: "dvVol", Size: pointer.P(resource.MustParse("5Gi"))},
+				{Name: "pvcVol", Size: pointer.P(resource.MustParse("2Gi"))},
+				{Name: "containerDiskVol", Exclude: true},
+			}
+			admitter.admitAndExpect(vmClone, true)
+		})
+
+		It("should allow volumes when the source VM does not exist", func() {
+			vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+			virtClient = kubecli.NewMockKubevirtClient(ctrl)
+			virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+			vmInterface.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, k8serrors.NewNotFound(v1.Resource("virtualmachines"), vmClone.Spec.Source.Name))
+			admitter.Client = virtClient
+
+			vmClone.Spec.Volumes = []clone.VirtualMachineCloneVolume{{Name: "unknownVol", Exclude: true}}
+			admitter.admitAndExpect(vmClone, true)
+		})
+
+		DescribeTable("should reject", func(volumes []clone.VirtualMachineCloneVolume) {
+			vmClone.Spec.Volumes = volumes
+			admitter.admitAndExpect(vmClone, false)
+		},
+			Entry("a volume that does not exist in the source", []clone.VirtualMachineCloneVolume{
+				{Name: "unknownVol", Exclude: true},
+			}),
+			Entry("a volume listed more than once", []clone.VirtualMachineCloneVolume{
+				{Name: "pvcVol", Exclude: true},
+				{Name: "pvcVol", Size: pointer.P(resource.MustParse("2Gi"))},
+			}),
+			Entry("a volume which is both excluded and resized", []clone.VirtualMachineCloneVolume{
+				{Name: "pvcVol", Exclude: true, Size: pointer.P(resource.MustParse("2Gi"))},
+			}),
+			Entry("a volume which is resized to zero", []clone.VirtualMachineCloneVolume{
+				{Name: "pvcVol", Size: pointer.P(resource.MustParse("0"))},
+			}),
+			Entry("resizing a volume which is not backed by a PVC or DataVolume", []clone.VirtualMachineCloneVolume{
+				{Name: "containerDiskVol", Size: pointer.P(resource.MustParse("2Gi"))},
+			}),
+			Entry("shrinking a PVC volume", []clone.VirtualMachineCloneVolume{
+				{Name: "pvcVol", Size: pointer.P(resource.MustParse("512Mi"))},
+			}),
+			Entry("shrinking a DataVolume volume", []clone.VirtualMachineCloneVolume{
+				{Name: "dvVol", Size: pointer.P(resource.MustParse("4Gi"))},
+			}),
+		)
+
+		When("the source is a VirtualMachineSnapshot", func() {
+			BeforeEach(func() {
+				vmClone.Spec.Source.Kind = virtualMachineSnapshotKind
+
+				kubevirtClient.Fake.PrependReactor("get", "virtualmachinesnapshotcontents", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					contents := &snapshotv1.VirtualMachineSnapshotContent{
+						Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+							VirtualMachineSnapshotName: pointer.P("test-vm"),
+							Source: snapshotv1.SourceSpec{
+								VirtualMachine: &snapshotv1.VirtualMachine{
+									Spec: vm.Spec,
+								},
+							},
+							VolumeBackups: []snapshotv1.VolumeBackup{
+								{
+									VolumeName:            "pvcVol",
+									PersistentVolumeClaim: snapshotv1.PersistentVolumeClaim{Spec: newPVC("pvc", "3Gi").Spec},
+								},
+							},
+						},
+					}
+					return true, contents, nil
+				})
+			})
+
+			It("should allow to grow a volume of the snapshot", func() {
+				vmClone.Spec.Volumes = []clone.VirtualMachineCloneVolume{
+					{Name: "pvcVol", Size: pointer.P(resource.MustParse("3Gi"))},
+				}
+				admitter.admitAndExpect(vmClone, true)
+			})
+
+			It("should reject to shrink a volume of the snapshot", func() {
+				vmClone.Spec.Volumes = []clone.VirtualMachineCloneVolume{
+					{Name: "pvcVol", Size: pointer.P(resource.MustParse("2Gi"))},
+				}
+				admitter.admitAndExpect(vmClone, false)
+			})
+		})
+
+		When("the clone is linked", func() {
+			BeforeEach(func() {
+				vmClone.Spec.Storage = &clone.VirtualMachineCloneStorage{
+					CloneStrategy: pointer.P(clone.CloneStrategyLinked),
+				}
+			})
+
+			It("should allow to exclude volumes of the source VM", func() {
+				vmClone.Spec.Volumes = []clone.VirtualMachineCloneVolume{{Name: "containerDiskVol", Exclude: true}}
+				admitter.admitAndExpect(vmClone, true)
+			})
+
+			It("should reject to resize volumes", func() {
+				vmClone.Spec.Volumes = []clone.VirtualMachineCloneVolume{
+					{Name: "pvcVol", Size: pointer.P(resource.MustParse("2Gi"))},
+				}
+				admitter.admitAndExpect(vmClone, false)
+			})
+
+			It("should reject a VirtualMachineSnapshot source", func() {
+				vmClone.Spec.Source.Kind = virtualMachineSnapshotKind
+				kubevirtClient.Fake.PrependReactor("get", "virtualmachinesnapshotcontents", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					return true, &snapshotv1.VirtualMachineSnapshotContent{
+						Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+							VirtualMachineSnapshotName: pointer.P("test-vm"),
+							Source: snapshotv1.SourceSpec{
+								VirtualMachine: &snapshotv1.VirtualMachine{Spec: vm.Spec},
+							},
+						},
+					}, nil
+				})
+				admitter.admitAndExpect(vmClone, false)
+			})
+
+			It("should reject a target namespace", func() {
+				k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					sar := action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+					sar.Status.Allowed = true
+					return true, sar, nil
+				})
+				virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
+				vmClone.Spec.TargetNamespace = "tenant"
+				admitter.admitAndExpect(vmClone, false)
+			})
+		})
+	})
+
 })
 
 func createCloneAdmissionReview(vmClone *clone.VirtualMachineClone) *admissionv1.AdmissionReview {
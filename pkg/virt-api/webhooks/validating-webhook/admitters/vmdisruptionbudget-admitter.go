@@ -0,0 +1,111 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+type VMDisruptionBudgetAdmitter struct{}
+
+func (admitter *VMDisruptionBudgetAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	gvr := webhooks.VirtualMachineDisruptionBudgetGroupVersionResource
+	if ar.Request == nil {
+		err := fmt.Errorf("Empty request for virtual machine disruption budget validation")
+		return webhookutils.ToAdmissionResponseError(err)
+	} else if ar.Request.Resource != gvr {
+		err := fmt.Errorf("expect resource %+v, but got %+v", gvr, ar.Request.Resource)
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	if resp := webhookutils.ValidateSchema(migrationsv1.VirtualMachineDisruptionBudgetKind, ar.Request.Object.Raw); resp != nil {
+		return resp
+	}
+
+	budget := migrationsv1.VirtualMachineDisruptionBudget{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, &budget); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	if causes := ValidateVMDisruptionBudgetSpec(k8sfield.NewPath("spec"), &budget.Spec); len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	return &admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+}
+
+func ValidateVMDisruptionBudgetSpec(field *k8sfield.Path, spec *migrationsv1.VirtualMachineDisruptionBudgetSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.Selector == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "missing selector.",
+			Field:   field.Child("selector").String(),
+		})
+	} else if _, err := metav1.LabelSelectorAsSelector(spec.Selector); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: err.Error(),
+			Field:   field.Child("selector").String(),
+		})
+	}
+
+	if spec.MaxUnavailable != nil {
+		// Scaling against 100 validates the format and bounds percentages at 100%
+		value, err := intstr.GetScaledValueFromIntOrPercent(spec.MaxUnavailable, 100, true)
+		switch {
+		case err != nil:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid maxUnavailable: %v", err),
+				Field:   field.Child("maxUnavailable").String(),
+			})
+		case value < 0:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "maxUnavailable must not be negative.",
+				Field:   field.Child("maxUnavailable").String(),
+			})
+		case spec.MaxUnavailable.Type == intstr.String && value > 100:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "maxUnavailable must not be above 100%.",
+				Field:   field.Child("maxUnavailable").String(),
+			})
+		}
+	}
+
+	return causes
+}
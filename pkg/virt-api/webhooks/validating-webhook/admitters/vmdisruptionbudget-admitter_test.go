@@ -0,0 +1,122 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+var _ = Describe("Validating VirtualMachineDisruptionBudget Admitter", func() {
+	admitter := &VMDisruptionBudgetAdmitter{}
+
+	newBudget := func() *migrationsv1.VirtualMachineDisruptionBudget {
+		return &migrationsv1.VirtualMachineDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "budget",
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: migrationsv1.VirtualMachineDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "database"},
+				},
+			},
+		}
+	}
+
+	admit := func(budget *migrationsv1.VirtualMachineDisruptionBudget) *admissionv1.AdmissionResponse {
+		budgetBytes, err := json.Marshal(budget)
+		Expect(err).ToNot(HaveOccurred())
+
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Resource: webhooks.VirtualMachineDisruptionBudgetGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: budgetBytes,
+				},
+			},
+		}
+		return admitter.Admit(context.Background(), ar)
+	}
+
+	It("should reject an unexpected resource", func() {
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Resource: webhooks.VirtualMachinePoolGroupVersionResource,
+			},
+		}
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("expect resource"))
+	})
+
+	DescribeTable("should accept a valid budget", func(maxUnavailable *intstr.IntOrString) {
+		budget := newBudget()
+		budget.Spec.MaxUnavailable = maxUnavailable
+
+		resp := admit(budget)
+		Expect(resp.Allowed).To(BeTrue())
+	},
+		Entry("without maxUnavailable", nil),
+		Entry("with a number", pointer.P(intstr.FromInt32(2))),
+		Entry("with zero, blocking the disruptions", pointer.P(intstr.FromInt32(0))),
+		Entry("with a percentage", pointer.P(intstr.FromString("25%"))),
+	)
+
+	DescribeTable("should reject an invalid budget", func(update func(*migrationsv1.VirtualMachineDisruptionBudget), field string) {
+		budget := newBudget()
+		update(budget)
+
+		resp := admit(budget)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		Entry("without selector", func(budget *migrationsv1.VirtualMachineDisruptionBudget) {
+			budget.Spec.Selector = nil
+		}, "spec.selector"),
+		Entry("with an invalid selector", func(budget *migrationsv1.VirtualMachineDisruptionBudget) {
+			budget.Spec.Selector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Bogus"}},
+			}
+		}, "spec.selector"),
+		Entry("with a negative maxUnavailable", func(budget *migrationsv1.VirtualMachineDisruptionBudget) {
+			budget.Spec.MaxUnavailable = pointer.P(intstr.FromInt32(-1))
+		}, "spec.maxUnavailable"),
+		Entry("with a maxUnavailable which is no percentage", func(budget *migrationsv1.VirtualMachineDisruptionBudget) {
+			budget.Spec.MaxUnavailable = pointer.P(intstr.FromString("half"))
+		}, "spec.maxUnavailable"),
+		Entry("with a maxUnavailable above 100%", func(budget *migrationsv1.VirtualMachineDisruptionBudget) {
+			budget.Spec.MaxUnavailable = pointer.P(intstr.FromString("150%"))
+		}, "spec.maxUnavailable"),
+	)
+})
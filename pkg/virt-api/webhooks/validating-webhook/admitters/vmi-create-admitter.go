@@ -40,6 +40,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/admissionpolicy"
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
 	draadmitter "kubevirt.io/kubevirt/pkg/dra/admitter"
 	"kubevirt.io/kubevirt/pkg/hooks"
@@ -71,6 +72,18 @@ const (
 	maxDNSSearchListChars = 256
 )
 
+const hookSidecarsAnnotationDeprecationWarning = "the " + hooks.HookSidecarListAnnotationName + " annotation is deprecated, use spec.hooks instead"
+
+var validHookVersions = []v1.HookVersion{v1.HookVersionV1Alpha1, v1.HookVersionV1Alpha2, v1.HookVersionV1Alpha3, v1.HookVersionV1Alpha4}
+
+// validHookPoints maps the hook points to the oldest hook API version providing them
+var validHookPoints = map[v1.HookPoint]v1.HookVersion{
+	v1.OnDefineDomainHookPoint:      v1.HookVersionV1Alpha1,
+	v1.PreCloudInitIsoHookPoint:     v1.HookVersionV1Alpha2,
+	v1.PostMigrationTargetHookPoint: v1.HookVersionV1Alpha4,
+	v1.PreShutdownHookPoint:         v1.HookVersionV1Alpha4,
+}
+
 var validIOThreadsPolicies = []v1.IOThreadsPolicy{v1.IOThreadsPolicyShared, v1.IOThreadsPolicyAuto, v1.IOThreadsPolicySupplementalPool}
 var validCPUFeaturePolicies = map[string]*struct{}{"": nil, "force": nil, "require": nil, "optional": nil, "disable": nil, "forbid": nil}
 var validPanicDeviceModels = []v1.PanicDeviceModel{v1.Hyperv, v1.Isa, v1.Pvpanic}
@@ -133,23 +146,27 @@ func (admitter *VMICreateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
-	return &admissionv1.AdmissionResponse{
-		Allowed:  true,
-		Warnings: warnDeprecatedAPIs(&vmi.Spec, admitter.ClusterConfig),
+	// The VMIs created by the KubeVirt controllers are evaluated too, as their spec has the instancetype and
+	// preference of their VM applied
+	causes, policyWarnings := admissionpolicy.Evaluate(admitter.ClusterConfig.GetAdmissionPolicy(), admissionpolicy.VirtualMachineInstances, vmi, nil)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
 	}
-}
 
-func warnDeprecatedAPIs(spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []string {
-	var warnings []string
-	for _, fg := range config.GetConfig().DeveloperConfiguration.FeatureGates {
-		deprecatedFeature := featuregate.FeatureGateInfo(fg)
-		if deprecatedFeature != nil && deprecatedFeature.State == featuregate.Deprecated && deprecatedFeature.VmiSpecUsed != nil {
-			if used := deprecatedFeature.VmiSpecUsed(spec); used {
-				warnings = append(warnings, deprecatedFeature.Message)
-			}
-		}
+	// The VMIs created by the KubeVirt controllers were already warned about in their VM, VMIRS or pool
+	warnings := warnDeprecatedAPIs(&vmi.Spec, admitter.ClusterConfig)
+	if !isKubeVirtServiceAccount {
+		warnings = WarnVirtualMachineInstanceSpec(k8sfield.NewPath("spec"), &vmi.Spec, admitter.ClusterConfig)
+	}
+	warnings = append(warnings, policyWarnings...)
+	if vmi.Annotations[hooks.HookSidecarListAnnotationName] != "" {
+		warnings = append(warnings, hookSidecarsAnnotationDeprecationWarning)
+	}
+
+	return &admissionv1.AdmissionResponse{
+		Allowed:  true,
+		Warnings: warnings,
 	}
-	return warnings
 }
 
 func ValidateVirtualMachineInstancePerArch(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
@@ -184,6 +201,12 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateMemoryRequestsNegativeOrNull(field, spec)...)
 	causes = append(causes, validateMemoryLimitsNegativeOrNull(field, spec)...)
 	causes = append(causes, validateHugepagesMemoryRequests(field, spec)...)
+	causes = append(causes, validateMemoryBalloon(field, spec)...)
+	causes = append(causes, validateIdlePolicy(field, spec)...)
+	causes = append(causes, validateCrashDump(field, spec)...)
+	causes = append(causes, validateFreePageReporting(field, spec)...)
+	causes = append(causes, validateKSMMergePolicy(field, spec)...)
+	causes = append(causes, validateMemoryOvercommit(field, spec)...)
 	causes = append(causes, validateGuestMemoryLimit(field, spec, config)...)
 	causes = append(causes, validateEmulatedMachine(field, spec, config)...)
 	causes = append(causes, validateFirmwareACPI(field.Child("acpi"), spec)...)
@@ -200,6 +223,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateSpecAffinity(field, spec)...)
 	causes = append(causes, validateSpecTopologySpreadConstraints(field, spec)...)
 	causes = append(causes, validateArchitecture(field, spec, config)...)
+	causes = append(causes, validateWorkloadClass(field, spec, config)...)
 
 	netValidator := netadmitter.NewValidator(field, spec, config)
 	causes = append(causes, netValidator.Validate()...)
@@ -221,6 +245,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateDomainSpec(field.Child("domain"), &spec.Domain)...)
 	causes = append(causes, validateVolumes(field.Child("volumes"), spec.Volumes, config)...)
 	causes = append(causes, storageadmitters.ValidateContainerDisks(field, spec)...)
+	causes = append(causes, storageadmitters.ValidateVhostUserBlkVolumes(field, spec)...)
 
 	causes = append(causes, validateAccessCredentials(field.Child("accessCredentials"), spec.AccessCredentials, spec.Volumes)...)
 
@@ -230,6 +255,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validatePodDNSConfig(spec.DNSConfig, &spec.DNSPolicy, field.Child("dnsConfig"))...)
 	causes = append(causes, validateLiveMigration(field, spec, config)...)
 	causes = append(causes, validateMDEVRamFB(field, spec)...)
+	causes = append(causes, validateGPUProfiles(field, spec, config)...)
 	causes = append(causes, validateHostDevicesWithPassthroughEnabled(field, spec, config)...)
 	causes = append(causes, validateSoundDevices(field, spec)...)
 	causes = append(causes, validateLaunchSecurity(field, spec, config)...)
@@ -237,8 +263,11 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validatePersistentReservation(field, spec, config)...)
 	causes = append(causes, validateDownwardMetrics(field, spec, config)...)
 	causes = append(causes, validateFilesystemsWithVirtIOFSEnabled(field, spec, config)...)
+	causes = append(causes, validateVirtiofsTuning(field, spec)...)
 	causes = append(causes, validateVideoConfig(field, spec, config)...)
+	causes = append(causes, validateGraphics(field, spec)...)
 	causes = append(causes, validatePanicDevices(field, spec, config)...)
+	causes = append(causes, validateHooks(field, spec, config)...)
 
 	return causes
 }
@@ -275,6 +304,39 @@ func validateFilesystemsWithVirtIOFSEnabled(field *k8sfield.Path, spec *v1.Virtu
 	return causes
 }
 
+// maxVirtiofsThreadPoolSize bounds the worker threads a single virtiofsd may spawn
+const maxVirtiofsThreadPoolSize = 256
+
+func validateVirtiofsTuning(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	for idx, fs := range spec.Domain.Devices.Filesystems {
+		if fs.Virtiofs == nil {
+			continue
+		}
+		fsField := field.Child("domain", "devices", "filesystems").Index(idx).Child("virtiofs")
+
+		switch fs.Virtiofs.Cache {
+		case "", v1.VirtiofsCacheAuto, v1.VirtiofsCacheAlways, v1.VirtiofsCacheNever:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s '%s' is not supported, supported values are: %s, %s, %s",
+					fsField.Child("cache").String(), fs.Virtiofs.Cache, v1.VirtiofsCacheAuto, v1.VirtiofsCacheAlways, v1.VirtiofsCacheNever),
+				Field: fsField.Child("cache").String(),
+			})
+		}
+
+		if fs.Virtiofs.ThreadPoolSize != nil && *fs.Virtiofs.ThreadPoolSize > maxVirtiofsThreadPoolSize {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be greater than %d", fsField.Child("threadPoolSize").String(), maxVirtiofsThreadPoolSize),
+				Field:   fsField.Child("threadPoolSize").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
 func validateDownwardMetrics(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
@@ -305,7 +367,7 @@ func validateVirtualMachineInstanceSpecVolumeDisks(field *k8sfield.Path, spec *v
 
 	// Validate that volumes match disks and filesystems correctly
 	for idx, volume := range spec.Volumes {
-		if volume.MemoryDump != nil {
+		if volume.MemoryDump != nil || volume.Ignition != nil {
 			continue
 		}
 		if _, matchingDiskExists := diskAndFilesystemNames[volume.Name]; !matchingDiskExists {
@@ -520,6 +582,38 @@ func validateMDEVRamFB(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec
 	return causes
 }
 
+func validateGPUProfiles(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, gpu := range spec.Domain.Devices.GPUs {
+		if gpu.Profile == "" {
+			continue
+		}
+		profileField := field.Child("domain", "devices", "gpus").Index(idx).Child("profile")
+		if !config.HasMediatedDeviceProfile(gpu.Profile) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s refers to mediated device profile %s which is not defined in the KubeVirt CR", profileField.String(), gpu.Profile),
+				Field:   profileField.String(),
+			})
+		}
+		if gpu.DeviceName != "" && gpu.DeviceName != virtconfig.MediatedDeviceProfileResourceName(gpu.Profile) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s can't be set together with a different deviceName", profileField.String()),
+				Field:   profileField.String(),
+			})
+		}
+		if gpu.ClaimRequest != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s can't be set together with a claim request", profileField.String()),
+				Field:   profileField.String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateHostDevicesWithPassthroughEnabled(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.Devices.HostDevices != nil && !config.HostDevicesPassthroughEnabled() {
@@ -654,6 +748,15 @@ func validateBootOrder(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec
 			})
 		}
 
+		// Verify that Ignition volumes are not mapped to disks
+		if volumeExists && matchingVolume.Ignition != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s cannot be mapped to an Ignition volume, the Ignition config is passed through the firmware configuration device.", field.Child("domain", "devices", "disks").Index(idx).String()),
+				Field:   field.Child("domain", "devices", "disks").Index(idx).Child("name").String(),
+			})
+		}
+
 		// Verify that DownwardMetrics is mapped to disk
 		if volumeExists && matchingVolume.DownwardMetrics != nil {
 			if disk.Disk == nil {
@@ -766,9 +869,206 @@ func validateNUMA(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, con
 			})
 		}
 	}
+	if spec.Domain.CPU != nil && spec.Domain.CPU.NUMA != nil && len(spec.Domain.CPU.NUMA.GuestCells) > 0 {
+		causes = append(causes, validateNUMAGuestCells(field, spec)...)
+	}
+	return causes
+}
+
+func validateNUMAGuestCells(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	const (
+		localDistance  = 10
+		maxDistance    = 255
+		maxGuestVCPUs  = 512
+		invalidCPUsFmt = "%s '%s' is not a valid list of vCPUs"
+	)
+
+	var causes []metav1.StatusCause
+	cpu := spec.Domain.CPU
+	cells := cpu.NUMA.GuestCells
+	cellsField := field.Child("domain", "cpu", "numa", "guestCells")
+
+	if cpu.NUMA.GuestMappingPassthrough != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s and %s are mutually exclusive", cellsField.String(),
+				field.Child("domain", "cpu", "numa", "guestMappingPassthrough").String()),
+			Field: cellsField.String(),
+		})
+	}
+	if cpu.MaxSockets > cpu.Sockets {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is not supported with %s, the guest NUMA cells can't cover hotplugged vCPUs",
+				field.Child("domain", "cpu", "maxSockets").String(), cellsField.String()),
+			Field: field.Child("domain", "cpu", "maxSockets").String(),
+		})
+	}
+	if spec.Domain.Memory != nil && spec.Domain.Memory.MaxGuest != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is not supported with %s, the guest NUMA cells can't cover hotplugged memory",
+				field.Child("domain", "memory", "maxGuest").String(), cellsField.String()),
+			Field: field.Child("domain", "memory", "maxGuest").String(),
+		})
+	}
+
+	var pageSize int64
+	if spec.Domain.Memory != nil && spec.Domain.Memory.Hugepages != nil {
+		if quantity, err := resource.ParseQuantity(spec.Domain.Memory.Hugepages.PageSize); err == nil {
+			pageSize = quantity.Value()
+		}
+	}
+
+	// The number of vCPUs is unknown when the topology is not set in the template of a VM
+	vCPUs := int(hwutil.GetNumberOfVCPUs(cpu))
+	threads := int(max(cpu.Threads, 1))
+	cellCount := uint32(len(cells))
+	cellIDs := map[uint32]bool{}
+	vCPUCells := map[int]uint32{}
+	coreCells := map[int]uint32{}
+	splitCores := map[int]bool{}
+	totalMemory := resource.NewQuantity(0, resource.BinarySI)
+	for i, cell := range cells {
+		cellField := cellsField.Index(i)
+
+		if cell.ID >= cellCount {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s %d must be lower than the number of guest NUMA cells %d", cellField.Child("id").String(), cell.ID, cellCount),
+				Field:   cellField.Child("id").String(),
+			})
+		} else if cellIDs[cell.ID] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("%s %d is used by more than one guest NUMA cell", cellField.Child("id").String(), cell.ID),
+				Field:   cellField.Child("id").String(),
+			})
+		}
+		cellIDs[cell.ID] = true
+
+		limit := maxGuestVCPUs
+		if vCPUs > 0 {
+			limit = vCPUs
+		}
+		cellVCPUs, err := hwutil.ParseCPUSetLine(cell.CPUs, limit)
+		if err != nil || len(cellVCPUs) == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf(invalidCPUsFmt, cellField.Child("cpus").String(), cell.CPUs),
+				Field:   cellField.Child("cpus").String(),
+			})
+		}
+		for _, vCPU := range cellVCPUs {
+			if vCPU < 0 || (vCPUs > 0 && vCPU >= vCPUs) {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s contains the vCPU %d, which doesn't exist since the VMI has %d vCPUs", cellField.Child("cpus").String(), vCPU, vCPUs),
+					Field:   cellField.Child("cpus").String(),
+				})
+				continue
+			}
+			if otherCell, exists := vCPUCells[vCPU]; exists {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s contains the vCPU %d, which is already assigned to the guest NUMA cell %d", cellField.Child("cpus").String(), vCPU, otherCell),
+					Field:   cellField.Child("cpus").String(),
+				})
+				continue
+			}
+			vCPUCells[vCPU] = cell.ID
+
+			// The threads of a core are pinned to the threads of a single dedicated core of the node
+			core := vCPU / threads
+			if otherCell, exists := coreCells[core]; cpu.DedicatedCPUPlacement && exists && otherCell != cell.ID && !splitCores[core] {
+				causes = append(causes, metav1.StatusCause{
+					Type: metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s splits the threads of the core %d across the guest NUMA cells %d and %d, which is not allowed with %s",
+						cellField.Child("cpus").String(), core, otherCell, cell.ID, field.Child("domain", "cpu", "dedicatedCpuPlacement").String()),
+					Field: cellField.Child("cpus").String(),
+				})
+				splitCores[core] = true
+			}
+			coreCells[core] = cell.ID
+		}
+
+		if cell.Memory.Sign() <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than 0", cellField.Child("memory").String()),
+				Field:   cellField.Child("memory").String(),
+			})
+		} else if pageSize > 0 && cell.Memory.Value()%pageSize != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s %s must be a multiple of the hugepage size %s", cellField.Child("memory").String(),
+					cell.Memory.String(), spec.Domain.Memory.Hugepages.PageSize),
+				Field: cellField.Child("memory").String(),
+			})
+		}
+		totalMemory.Add(cell.Memory)
+
+		distanceCellIDs := map[uint32]bool{}
+		for j, distance := range cell.Distances {
+			distanceField := cellField.Child("distances").Index(j)
+			var message string
+			switch {
+			case distance.CellID >= cellCount:
+				message = fmt.Sprintf("%s %d is not the ID of a guest NUMA cell", distanceField.Child("cellID").String(), distance.CellID)
+			case distanceCellIDs[distance.CellID]:
+				message = fmt.Sprintf("%s %d is listed more than once", distanceField.Child("cellID").String(), distance.CellID)
+			case distance.Value < localDistance || distance.Value > maxDistance:
+				message = fmt.Sprintf("%s %d must be between %d and %d", distanceField.Child("value").String(), distance.Value, localDistance, maxDistance)
+			case distance.CellID == cell.ID && distance.Value != localDistance:
+				message = fmt.Sprintf("%s %d must be %d, the distance of a cell to itself", distanceField.Child("value").String(), distance.Value, localDistance)
+			case distance.CellID != cell.ID && distance.Value == localDistance:
+				message = fmt.Sprintf("%s must be greater than %d, the distance of a cell to itself", distanceField.Child("value").String(), localDistance)
+			}
+			if message != "" {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: message,
+					Field:   distanceField.String(),
+				})
+			}
+			distanceCellIDs[distance.CellID] = true
+		}
+	}
+
+	if vCPUs > 0 && len(vCPUCells) < vCPUs {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%d of the %d vCPUs are not assigned to any of the %s", vCPUs-len(vCPUCells), vCPUs, cellsField.String()),
+			Field:   cellsField.String(),
+		})
+	}
+
+	if guestMemory := guestMemoryOf(spec); guestMemory != nil && !guestMemory.IsZero() && guestMemory.Cmp(*totalMemory) != 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("the memory of the %s adds up to %s, it must be equal to the guest memory %s",
+				cellsField.String(), totalMemory.String(), guestMemory.String()),
+			Field: cellsField.String(),
+		})
+	}
+
 	return causes
 }
 
+// guestMemoryOf returns the memory visible to the guest, or nil when it is not set
+func guestMemoryOf(spec *v1.VirtualMachineInstanceSpec) *resource.Quantity {
+	if spec.Domain.Memory != nil && spec.Domain.Memory.Guest != nil {
+		return spec.Domain.Memory.Guest
+	}
+	if request, ok := spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; ok {
+		return &request
+	}
+	if limit, ok := spec.Domain.Resources.Limits[k8sv1.ResourceMemory]; ok {
+		return &limit
+	}
+	return nil
+}
+
 func validateThreadCountOnArchitecture(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	arch := spec.Architecture
@@ -1069,7 +1369,294 @@ func validateHugepagesMemoryRequests(field *k8sfield.Path, spec *v1.VirtualMachi
 			Field: field.Child("domain", "resources", "requests", "memory").String(),
 		})
 	}
+	causes = append(causes, validateHugepagesRegularMemory(field, spec, hugepagesSize)...)
+
+	return causes
+}
+
+func validateHugepagesRegularMemory(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, hugepagesSize resource.Quantity) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	regularMemory := spec.Domain.Memory.Hugepages.RegularMemory
+	if regularMemory == nil {
+		return causes
+	}
+	regularMemoryField := field.Child("domain", "memory", "hugepages", "regularMemory")
+	guestMemory := spec.Domain.Resources.Requests.Memory()
+	if spec.Domain.Memory.Guest != nil {
+		guestMemory = spec.Domain.Memory.Guest
+	}
+
+	switch {
+	case regularMemory.Sign() <= 0:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s '%s' must be greater than zero", regularMemoryField.String(), regularMemory.String()),
+			Field:   regularMemoryField.String(),
+		})
+	case regularMemory.Value()%hugepagesSize.Value() != 0:
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s '%s' is not a multiple of the page size %s '%s'",
+				regularMemoryField.String(),
+				regularMemory.String(),
+				field.Child("domain", "hugepages", "size").String(),
+				spec.Domain.Memory.Hugepages.PageSize,
+			),
+			Field: regularMemoryField.String(),
+		})
+	case regularMemory.Cmp(*guestMemory) >= 0:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s '%s' must be less than the guest memory '%s'", regularMemoryField.String(), regularMemory.String(), guestMemory.String()),
+			Field:   regularMemoryField.String(),
+		})
+	}
+
+	if spec.Domain.CPU != nil && spec.Domain.CPU.NUMA != nil &&
+		(spec.Domain.CPU.NUMA.GuestMappingPassthrough != nil || len(spec.Domain.CPU.NUMA.GuestCells) > 0) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s can't be combined with a guest NUMA topology", regularMemoryField.String()),
+			Field:   regularMemoryField.String(),
+		})
+	}
+
+	return causes
+}
+
+func validateMemoryBalloon(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.Memory == nil || spec.Domain.Memory.Balloon == nil {
+		return causes
+	}
+	balloon := spec.Domain.Memory.Balloon
+	balloonField := field.Child("domain", "memory", "balloon")
+
+	if autoattach := spec.Domain.Devices.AutoattachMemBalloon; autoattach != nil && !*autoattach {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires the memory balloon device, %s must not be false", balloonField.String(), field.Child("domain", "devices", "autoattachMemBalloon").String()),
+			Field:   balloonField.String(),
+		})
+	}
+	if balloon.Max != nil && balloon.Max.Sign() <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s '%s' must be greater than 0", balloonField.Child("max").String(), balloon.Max),
+			Field:   balloonField.Child("max").String(),
+		})
+	}
+	if balloon.Min != nil && balloon.Min.Sign() < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(valueMustBePositiveMessagePattern, balloonField.Child("min").String(), balloon.Min),
+			Field:   balloonField.Child("min").String(),
+		})
+	} else if balloon.Min != nil && balloon.Max != nil && balloon.Min.Cmp(*balloon.Max) > 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s '%s' is greater than %s '%s'", balloonField.Child("min").String(), balloon.Min,
+				balloonField.Child("max").String(), balloon.Max),
+			Field: balloonField.Child("min").String(),
+		})
+	}
+	if balloon.TargetFreePercentage != nil && *balloon.TargetFreePercentage >= 100 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be lower than 100", balloonField.Child("targetFreePercentage").String()),
+			Field:   balloonField.Child("targetFreePercentage").String(),
+		})
+	}
+	return causes
+}
+
+func validateIdlePolicy(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	policy := spec.IdlePolicy
+	if policy == nil {
+		return causes
+	}
+	policyField := field.Child("idlePolicy")
+
+	if policy.Timeout.Duration <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s '%s' must be greater than 0", policyField.Child("timeout").String(), policy.Timeout.Duration),
+			Field:   policyField.Child("timeout").String(),
+		})
+	}
+	if policy.CPUThresholdPercentage != nil && (*policy.CPUThresholdPercentage == 0 || *policy.CPUThresholdPercentage > 100) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be between 1 and 100", policyField.Child("cpuThresholdPercentage").String()),
+			Field:   policyField.Child("cpuThresholdPercentage").String(),
+		})
+	}
+	switch policy.Action {
+	case "", v1.IdleActionPause, v1.IdleActionStop:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s '%s' is not supported, must be %s or %s", policyField.Child("action").String(), policy.Action, v1.IdleActionPause, v1.IdleActionStop),
+			Field:   policyField.Child("action").String(),
+		})
+	}
+	return causes
+}
+
+func validateCrashDump(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	crashDump := spec.CrashDump
+	if crashDump == nil {
+		return causes
+	}
+	crashDumpField := field.Child("crashDump")
+
+	if len(spec.Domain.Devices.PanicDevices) == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires a panic device", crashDumpField.String()),
+			Field:   crashDumpField.String(),
+		})
+	}
+
+	var volume *v1.Volume
+	for i := range spec.Volumes {
+		if spec.Volumes[i].Name == crashDump.VolumeName {
+			volume = &spec.Volumes[i]
+		}
+	}
+	volumeNameField := crashDumpField.Child("volumeName")
+	if volume == nil || volume.MemoryDump == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s '%s' must reference a memory dump volume", volumeNameField.String(), crashDump.VolumeName),
+			Field:   volumeNameField.String(),
+		})
+	} else if volume.MemoryDump.Hotpluggable || volume.MemoryDump.Format == v1.MemoryDumpFormatState {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s '%s' must reference a memory dump volume which is not hotpluggable and does not use the %s format", volumeNameField.String(), crashDump.VolumeName, v1.MemoryDumpFormatState),
+			Field:   volumeNameField.String(),
+		})
+	}
+
+	if crashDump.MaxSize != nil && crashDump.MaxSize.Sign() <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than 0", crashDumpField.Child("maxSize").String()),
+			Field:   crashDumpField.Child("maxSize").String(),
+		})
+	}
+	if crashDump.MaxDumps != nil && *crashDump.MaxDumps < 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be at least 1", crashDumpField.Child("maxDumps").String()),
+			Field:   crashDumpField.Child("maxDumps").String(),
+		})
+	}
+	return causes
+}
+
+func validateFreePageReporting(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.Memory == nil || spec.Domain.Memory.FreePageReporting == nil || !*spec.Domain.Memory.FreePageReporting {
+		return causes
+	}
 
+	if autoattach := spec.Domain.Devices.AutoattachMemBalloon; autoattach != nil && !*autoattach {
+		fprField := field.Child("domain", "memory", "freePageReporting")
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires the memory balloon device, %s must not be false", fprField.String(), field.Child("domain", "devices", "autoattachMemBalloon").String()),
+			Field:   fprField.String(),
+		})
+	}
+	return causes
+}
+
+func validateKSMMergePolicy(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.Memory == nil {
+		return causes
+	}
+
+	switch spec.Domain.Memory.KSMMergePolicy {
+	case "", v1.KSMMergePolicyDisabled, v1.KSMMergePolicyConservative, v1.KSMMergePolicyBalanced, v1.KSMMergePolicyAggressive:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s '%s' is not supported, it must be one of %s, %s, %s or %s", field.Child("domain", "memory", "ksmMergePolicy").String(),
+				spec.Domain.Memory.KSMMergePolicy, v1.KSMMergePolicyDisabled, v1.KSMMergePolicyConservative, v1.KSMMergePolicyBalanced, v1.KSMMergePolicyAggressive),
+			Field: field.Child("domain", "memory", "ksmMergePolicy").String(),
+		})
+	}
+	return causes
+}
+
+func validateMemoryOvercommit(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.Memory == nil || spec.Domain.Memory.Overcommit == "" {
+		return causes
+	}
+
+	overcommitField := field.Child("domain", "memory", "overcommit")
+	class := spec.Domain.Memory.Overcommit
+	switch class {
+	case v1.MemoryOvercommitGuaranteed:
+		if policy := spec.Domain.Memory.KSMMergePolicy; policy != "" && policy != v1.KSMMergePolicyDisabled {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s %s keeps KSM from merging the guest memory, %s must be %s", overcommitField.String(), class, field.Child("domain", "memory", "ksmMergePolicy").String(), v1.KSMMergePolicyDisabled),
+				Field:   field.Child("domain", "memory", "ksmMergePolicy").String(),
+			})
+		}
+		if spec.Domain.Resources.OvercommitGuestOverhead {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s %s requests the memory overhead, %s must not be set", overcommitField.String(), class, field.Child("domain", "resources", "overcommitGuestOverhead").String()),
+				Field:   field.Child("domain", "resources", "overcommitGuestOverhead").String(),
+			})
+		}
+		if guest := spec.Domain.Memory.Guest; guest != nil {
+			if request, ok := spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; ok && request.Cmp(*guest) < 0 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s %s requests the whole guest memory, %s must not be less than %s", overcommitField.String(), class, field.Child("domain", "resources", "requests", "memory").String(), field.Child("domain", "memory", "guest").String()),
+					Field:   field.Child("domain", "resources", "requests", "memory").String(),
+				})
+			}
+		}
+	case v1.MemoryOvercommitBurstableWithSwap, v1.MemoryOvercommitBestEffort:
+		if spec.Domain.Memory.Hugepages != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s %s is not allowed with hugepages, which can't be swapped out", overcommitField.String(), class),
+				Field:   overcommitField.String(),
+			})
+		}
+		if spec.Domain.CPU != nil && spec.Domain.CPU.DedicatedCPUPlacement {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s %s is not allowed with dedicated CPUs, which require a pod of the Guaranteed QoS class", overcommitField.String(), class),
+				Field:   overcommitField.String(),
+			})
+		}
+		if _, ok := spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; ok && class == v1.MemoryOvercommitBestEffort {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s %s doesn't request the guest memory, %s must not be set", overcommitField.String(), class, field.Child("domain", "resources", "requests", "memory").String()),
+				Field:   field.Child("domain", "resources", "requests", "memory").String(),
+			})
+		}
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s '%s' is not supported, it must be one of %s, %s or %s", overcommitField.String(),
+				class, v1.MemoryOvercommitGuaranteed, v1.MemoryOvercommitBurstableWithSwap, v1.MemoryOvercommitBestEffort),
+			Field: overcommitField.String(),
+		})
+	}
 	return causes
 }
 
@@ -1170,6 +1757,31 @@ func validateArchitecture(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 	return causes
 }
 
+func validateWorkloadClass(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.WorkloadClass == "" {
+		return causes
+	}
+	workloadClass := config.GetWorkloadClass(spec.WorkloadClass)
+	if workloadClass == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotFound,
+			Message: fmt.Sprintf("%s '%s' is not one of the workloadClasses of the KubeVirt configuration", field.Child("workloadClass").String(), spec.WorkloadClass),
+			Field:   field.Child("workloadClass").String(),
+		})
+		return causes
+	}
+	if spec.PriorityClassName != "" && spec.PriorityClassName != workloadClass.PriorityClassName {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be empty or '%s', the priority class of %s '%s'", field.Child("priorityClassName").String(),
+				workloadClass.PriorityClassName, field.Child("workloadClass").String(), spec.WorkloadClass),
+			Field: field.Child("priorityClassName").String(),
+		})
+	}
+	return causes
+}
+
 func validateCPURealtime(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if !spec.Domain.CPU.DedicatedCPUPlacement {
@@ -1608,6 +2220,7 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 	serviceAccountVolumeCount := 0
 	downwardMetricVolumeCount := 0
 	memoryDumpVolumeCount := 0
+	ignitionVolumeCount := 0
 
 	for idx, volume := range volumes {
 		// verify name is unique
@@ -1636,6 +2249,10 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 		if volume.CloudInitConfigDrive != nil {
 			volumeSourceSetCount++
 		}
+		if volume.Ignition != nil {
+			ignitionVolumeCount++
+			volumeSourceSetCount++
+		}
 		if volume.ContainerDisk != nil {
 			volumeSourceSetCount++
 		}
@@ -1679,6 +2296,9 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 			memoryDumpVolumeCount++
 			volumeSourceSetCount++
 		}
+		if volume.VhostUserBlk != nil {
+			volumeSourceSetCount++
+		}
 
 		if volumeSourceSetCount != 1 {
 			causes = append(causes, metav1.StatusCause{
@@ -1692,6 +2312,7 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 		if volume.CloudInitNoCloud != nil || volume.CloudInitConfigDrive != nil {
 			var userDataSecretRef, networkDataSecretRef *k8sv1.LocalObjectReference
 			var dataSourceType, userData, userDataBase64, networkData, networkDataBase64 string
+			var userDataSources []v1.CloudInitUserDataSource
 			if volume.CloudInitNoCloud != nil {
 				dataSourceType = "cloudInitNoCloud"
 				userDataSecretRef = volume.CloudInitNoCloud.UserDataSecretRef
@@ -1700,6 +2321,7 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 				networkDataSecretRef = volume.CloudInitNoCloud.NetworkDataSecretRef
 				networkDataBase64 = volume.CloudInitNoCloud.NetworkDataBase64
 				networkData = volume.CloudInitNoCloud.NetworkData
+				userDataSources = volume.CloudInitNoCloud.UserDataSources
 			} else if volume.CloudInitConfigDrive != nil {
 				dataSourceType = "cloudInitConfigDrive"
 				userDataSecretRef = volume.CloudInitConfigDrive.UserDataSecretRef
@@ -1708,6 +2330,7 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 				networkDataSecretRef = volume.CloudInitConfigDrive.NetworkDataSecretRef
 				networkDataBase64 = volume.CloudInitConfigDrive.NetworkDataBase64
 				networkData = volume.CloudInitConfigDrive.NetworkData
+				userDataSources = volume.CloudInitConfigDrive.UserDataSources
 			}
 
 			userDataLen := 0
@@ -1787,7 +2410,9 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 				})
 			}
 
-			if userDataSourceCount == 0 && networkDataSourceCount == 0 {
+			causes = append(causes, validateCloudInitUserDataSources(field.Index(idx).Child(dataSourceType, "userDataSources"), userDataSources)...)
+
+			if userDataSourceCount == 0 && networkDataSourceCount == 0 && len(userDataSources) == 0 {
 				causes = append(causes, metav1.StatusCause{
 					Type:    metav1.CauseTypeFieldValueInvalid,
 					Message: fmt.Sprintf("%s must have at least one userdatasource or one networkdatasource set.", field.Index(idx).Child(dataSourceType).String()),
@@ -1804,6 +2429,22 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 			})
 		}
 
+		if volume.Ignition != nil {
+			causes = append(causes, validateIgnitionVolume(field.Index(idx).Child("ignition"), volume.Ignition, config)...)
+		}
+
+		if volume.Sysprep != nil {
+			causes = append(causes, validateSysprepVolume(field.Index(idx).Child("sysprep"), volume.Sysprep)...)
+		}
+
+		if volume.VhostUserBlk != nil && !config.VhostUserBlkEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.VhostUserBlkGate),
+				Field:   field.Index(idx).Child("vhostUserBlk").String(),
+			})
+		}
+
 		// validate HostDisk data
 		if hostDisk := volume.HostDisk; hostDisk != nil {
 			if !config.HostDiskEnabled() {
@@ -1892,7 +2533,91 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 			Field:   field.String(),
 		})
 	}
+	if ignitionVolumeCount > 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must have max one ignition volume set", field.String()),
+			Field:   field.String(),
+		})
+	}
+
+	return causes
+}
+
+func validateCloudInitUserDataSources(field *k8sfield.Path, sources []v1.CloudInitUserDataSource) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, source := range sources {
+		sourceCount := 0
+		if source.SecretRef != nil && source.SecretRef.Name != "" {
+			sourceCount++
+		}
+		if source.ConfigMapRef != nil && source.ConfigMapRef.Name != "" {
+			sourceCount++
+		}
+		if sourceCount != 1 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must have exactly one of secretRef or configMapRef set", field.Index(idx).String()),
+				Field:   field.Index(idx).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateIgnitionVolume(field *k8sfield.Path, source *v1.IgnitionSource, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if !config.IgnitionEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.IgnitionGate),
+			Field:   field.String(),
+		})
+	}
 
+	sourceCount := 0
+	if source.Data != "" {
+		sourceCount++
+	}
+	if source.SecretRef != nil && source.SecretRef.Name != "" {
+		sourceCount++
+	}
+	if sourceCount != 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must have exactly one of data or secretRef set", field.String()),
+			Field:   field.String(),
+		})
+	}
+	return causes
+}
+
+func validateSysprepVolume(field *k8sfield.Path, source *v1.SysprepSource) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if source.Namespace != "" {
+		if errs := validation.IsDNS1123Label(source.Namespace); len(errs) != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not a valid namespace: %s", field.Child("namespace").String(), strings.Join(errs, ", ")),
+				Field:   field.Child("namespace").String(),
+			})
+		}
+		// virt-controller is not allowed to read the Secrets of other namespaces
+		if source.Secret != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s can only be set for a configMap", field.Child("namespace").String()),
+				Field:   field.Child("namespace").String(),
+			})
+		}
+	}
+	if source.Template != nil && source.Template.ParametersSecretRef != nil && source.Template.ParametersSecretRef.Name == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must have a name", field.Child("template", "parametersSecretRef").String()),
+			Field:   field.Child("template", "parametersSecretRef", "name").String(),
+		})
+	}
 	return causes
 }
 
@@ -2062,6 +2787,35 @@ func validateVideoConfig(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSp
 	return causes
 }
 
+func validateGraphics(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	graphics := spec.Domain.Devices.Graphics
+	if graphics == nil {
+		return causes
+	}
+
+	switch graphics.Type {
+	case "", v1.GraphicsTypeVNC, v1.GraphicsTypeSPICE:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("graphics type '%s' is not supported, use %s or %s", graphics.Type, v1.GraphicsTypeVNC, v1.GraphicsTypeSPICE),
+			Field:   field.Child("domain", "devices", "graphics", "type").String(),
+		})
+	}
+
+	if spec.Domain.Devices.AutoattachGraphicsDevice != nil && !*spec.Domain.Devices.AutoattachGraphicsDevice {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Graphics configuration is not allowed when autoattachGraphicsDevice is set to false",
+			Field:   field.Child("domain", "devices", "graphics").String(),
+		})
+	}
+
+	return causes
+}
+
 func validatePanicDeviceModel(field *k8sfield.Path, model *v1.PanicDeviceModel) *metav1.StatusCause {
 	if model == nil {
 		return nil
@@ -2111,3 +2865,108 @@ func validatePanicDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 
 	return causes
 }
+
+func validateHooks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if len(spec.Hooks) == 0 {
+		return causes
+	}
+	if !config.SidecarEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "sidecar feature gate is not enabled in kubevirt-config",
+			Field:   field.Child("hooks").String(),
+		})
+		return causes
+	}
+
+	hookNames := map[string]struct{}{}
+	for idx, hook := range spec.Hooks {
+		hookField := field.Child("hooks").Index(idx)
+		causes = append(causes, validateHookName(hookField.Child("name"), hook.Name, hookNames)...)
+		hookNames[hook.Name] = struct{}{}
+
+		if hook.Image == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf(requiredFieldFmt, hookField.Child("image").String()),
+				Field:   hookField.Child("image").String(),
+			})
+		}
+
+		if hook.Version != "" && !slices.Contains(validHookVersions, hook.Version) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is not a supported hook version, supported versions: %v", hook.Version, validHookVersions),
+				Field:   hookField.Child("version").String(),
+			})
+		}
+
+		if len(hook.HookPoints) == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf(requiredFieldFmt, hookField.Child("hookPoints").String()),
+				Field:   hookField.Child("hookPoints").String(),
+			})
+		}
+		for pointIdx, hookPoint := range hook.HookPoints {
+			causes = append(causes, validateHookPoint(hookField.Child("hookPoints").Index(pointIdx), hookPoint, hook.Version)...)
+		}
+	}
+
+	return causes
+}
+
+func validateHookName(field *k8sfield.Path, name string, hookNames map[string]struct{}) []metav1.StatusCause {
+	if name == "" {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf(requiredFieldFmt, field.String()),
+			Field:   field.String(),
+		}}
+	}
+	if _, exists := hookNames[name]; exists {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueDuplicate,
+			Message: fmt.Sprintf("%s must be unique, hook %s is defined more than once", field.String(), name),
+			Field:   field.String(),
+		}}
+	}
+	if hooks.IsReservedSpecHookName(name) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not start with sidecar-, the prefix is reserved for the hook sidecars of the annotation", field.String()),
+			Field:   field.String(),
+		}}
+	}
+	// The name of the sidecar container has to be a DNS label
+	if errs := validation.IsDNS1123Label(hooks.SpecHookContainerName(name)); len(errs) != 0 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s does not conform to the kubernetes DNS_LABEL rules once prefixed with hook-: %s", field.String(), strings.Join(errs, ", ")),
+			Field:   field.String(),
+		}}
+	}
+	return nil
+}
+
+func validateHookPoint(field *k8sfield.Path, hookPoint v1.HookPoint, version v1.HookVersion) []metav1.StatusCause {
+	oldestVersion, supported := validHookPoints[hookPoint]
+	if !supported {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s is not a supported hook point", hookPoint),
+			Field:   field.String(),
+		}}
+	}
+	// The hook API versions are ordered, v1alpha1 < v1alpha2 < ...
+	if version != "" && slices.Contains(validHookVersions, version) &&
+		slices.Index(validHookVersions, version) < slices.Index(validHookVersions, oldestVersion) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("hook point %s requires hook version %s or newer", hookPoint, oldestVersion),
+			Field:   field.String(),
+		}}
+	}
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"runtime"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -41,6 +42,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/api"
 
+	"kubevirt.io/kubevirt/pkg/admissionpolicy"
 	"kubevirt.io/kubevirt/pkg/hooks"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	libvmici "kubevirt.io/kubevirt/pkg/libvmi/cloudinit"
@@ -478,6 +480,58 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				featuregate.SidecarGate,
 			),
 		)
+
+		It("should warn that the hook sidecars annotation is deprecated", func() {
+			enableFeatureGates(featuregate.SidecarGate)
+			vmi := newBaseVmi()
+			vmi.Annotations = map[string]string{hooks.HookSidecarListAnnotationName: "[{'image': 'fake-image'}]"}
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(resp.Warnings).To(ConsistOf(hookSidecarsAnnotationDeprecationWarning))
+		})
+
+		DescribeTable("should warn about a suboptimal spec", func(username string, expectedWarnings int) {
+			vmi := newBaseVmi(libvmi.WithAccessCredentialUserPassword("passwords"))
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.UserInfo = authv1.UserInfo{Username: username}
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(resp.Warnings).To(HaveLen(expectedWarnings))
+		},
+			Entry("from a user", "fake-account", 1),
+			Entry("not from the KubeVirt controllers", "system:serviceaccount:kubevirt:kubevirt-controller", 0),
+		)
+
+		DescribeTable("should reject a VMI which does not satisfy the admission policy", func(username string) {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.AdmissionPolicy = &v1.AdmissionPolicyConfiguration{
+				Rules: []v1.AdmissionPolicyRule{{
+					Name:       "cpus",
+					Resources:  []string{admissionpolicy.VirtualMachineInstances},
+					Expression: "object.spec.domain.cpu.cores <= 2",
+					Message:    "VMIs can have at most 2 cores",
+				}},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+			ar, err := newAdmissionReviewForVMICreation(newBaseVmi(libvmi.WithCPUCount(4, 1, 1)))
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.UserInfo = authv1.UserInfo{Username: username}
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ConsistOf(HaveField("Message", "VMIs can have at most 2 cores")))
+		},
+			Entry("from a user", "fake-account"),
+			Entry("from the KubeVirt controllers", "system:serviceaccount:kubevirt:kubevirt-controller"),
+		)
 	})
 
 	Context("with VirtualMachineInstance spec", func() {
@@ -645,6 +699,32 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Field).To(Equal("fake.domain.volumes[0].name"))
 		})
 
+		It("should accept an Ignition volume without a disk", func() {
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name:         "ignition",
+				VolumeSource: v1.VolumeSource{Ignition: &v1.IgnitionSource{Data: "{}"}},
+			})
+
+			causes := validateVirtualMachineInstanceSpecVolumeDisks(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject a disk referencing an Ignition volume", func() {
+			enableFeatureGates(featuregate.IgnitionGate)
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "ignition",
+			})
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name:         "ignition",
+				VolumeSource: v1.VolumeSource{Ignition: &v1.IgnitionSource{Data: "{}"}},
+			})
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.disks[0].name"))
+			Expect(causes[0].Message).To(ContainSubstring("cannot be mapped to an Ignition volume"))
+		})
+
 		It("should reject multiple disks referencing same volume", func() {
 			// verify two disks referencing the same volume are rejected
 			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
@@ -957,6 +1037,229 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(BeEmpty())
 		})
 
+		It("should accept a memory balloon policy", func() {
+			vmi.Spec.Domain.Memory = &v1.Memory{Balloon: &v1.MemoryBalloon{
+				Min:                  pointer.P(resource.MustParse("1Gi")),
+				Max:                  pointer.P(resource.MustParse("2Gi")),
+				TargetFreePercentage: pointer.P(uint32(30)),
+			}}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject an invalid memory balloon policy", func(balloon *v1.MemoryBalloon, autoattach *bool, expectedField string) {
+			vmi.Spec.Domain.Memory = &v1.Memory{Balloon: balloon}
+			vmi.Spec.Domain.Devices.AutoattachMemBalloon = autoattach
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("without a memory balloon device", &v1.MemoryBalloon{}, pointer.P(false), "fake.domain.memory.balloon"),
+			Entry("with a negative min", &v1.MemoryBalloon{Min: pointer.P(resource.MustParse("-1Gi"))}, nil, "fake.domain.memory.balloon.min"),
+			Entry("with a zero max", &v1.MemoryBalloon{Max: pointer.P(resource.MustParse("0"))}, nil, "fake.domain.memory.balloon.max"),
+			Entry("with a min greater than max", &v1.MemoryBalloon{
+				Min: pointer.P(resource.MustParse("2Gi")),
+				Max: pointer.P(resource.MustParse("1Gi")),
+			}, nil, "fake.domain.memory.balloon.min"),
+			Entry("with a target free percentage of 100", &v1.MemoryBalloon{TargetFreePercentage: pointer.P(uint32(100))}, nil, "fake.domain.memory.balloon.targetFreePercentage"),
+		)
+
+		It("should accept an idle policy", func() {
+			vmi.Spec.IdlePolicy = &v1.IdlePolicy{
+				Timeout:                metav1.Duration{Duration: time.Hour},
+				CPUThresholdPercentage: pointer.P(uint32(10)),
+				Action:                 v1.IdleActionStop,
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject an invalid idle policy", func(policy *v1.IdlePolicy, expectedField string) {
+			vmi.Spec.IdlePolicy = policy
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("without a timeout", &v1.IdlePolicy{}, "fake.idlePolicy.timeout"),
+			Entry("with a CPU threshold of 0", &v1.IdlePolicy{
+				Timeout:                metav1.Duration{Duration: time.Hour},
+				CPUThresholdPercentage: pointer.P(uint32(0)),
+			}, "fake.idlePolicy.cpuThresholdPercentage"),
+			Entry("with a CPU threshold above 100", &v1.IdlePolicy{
+				Timeout:                metav1.Duration{Duration: time.Hour},
+				CPUThresholdPercentage: pointer.P(uint32(101)),
+			}, "fake.idlePolicy.cpuThresholdPercentage"),
+			Entry("with an unknown action", &v1.IdlePolicy{
+				Timeout: metav1.Duration{Duration: time.Hour},
+				Action:  "Hibernate",
+			}, "fake.idlePolicy.action"),
+		)
+
+		Context("with a crash dump", func() {
+			newMemoryDumpVolume := func(hotpluggable bool, format v1.MemoryDumpFormat) v1.Volume {
+				return v1.Volume{
+					Name: "crash",
+					VolumeSource: v1.VolumeSource{
+						MemoryDump: &v1.MemoryDumpVolumeSource{
+							PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "crash-pvc"},
+								Hotpluggable:                      hotpluggable,
+							},
+							Format: format,
+						},
+					},
+				}
+			}
+
+			BeforeEach(func() {
+				enableFeatureGates(featuregate.PanicDevicesGate)
+				vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: pointer.P(v1.Pvpanic)}}
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, newMemoryDumpVolume(false, ""))
+			})
+
+			It("should be accepted", func() {
+				vmi.Spec.CrashDump = &v1.CrashDump{
+					VolumeName: "crash",
+					MaxSize:    pointer.P(resource.MustParse("8Gi")),
+					MaxDumps:   pointer.P(int32(3)),
+				}
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			DescribeTable("should be rejected", func(modify func(*v1.VirtualMachineInstanceSpec), expectedField string) {
+				vmi.Spec.CrashDump = &v1.CrashDump{VolumeName: "crash"}
+				modify(&vmi.Spec)
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			},
+				Entry("without a panic device", func(spec *v1.VirtualMachineInstanceSpec) {
+					spec.Domain.Devices.PanicDevices = nil
+				}, "fake.crashDump"),
+				Entry("with an unknown volume", func(spec *v1.VirtualMachineInstanceSpec) {
+					spec.CrashDump.VolumeName = "unknown"
+				}, "fake.crashDump.volumeName"),
+				Entry("with a hotpluggable volume", func(spec *v1.VirtualMachineInstanceSpec) {
+					spec.Volumes[len(spec.Volumes)-1] = newMemoryDumpVolume(true, "")
+				}, "fake.crashDump.volumeName"),
+				Entry("with a volume holding a memory state", func(spec *v1.VirtualMachineInstanceSpec) {
+					spec.Volumes[len(spec.Volumes)-1] = newMemoryDumpVolume(false, v1.MemoryDumpFormatState)
+				}, "fake.crashDump.volumeName"),
+				Entry("with a max size of 0", func(spec *v1.VirtualMachineInstanceSpec) {
+					spec.CrashDump.MaxSize = pointer.P(resource.MustParse("0"))
+				}, "fake.crashDump.maxSize"),
+				Entry("with no dumps kept", func(spec *v1.VirtualMachineInstanceSpec) {
+					spec.CrashDump.MaxDumps = pointer.P(int32(0))
+				}, "fake.crashDump.maxDumps"),
+			)
+		})
+
+		Context("with workload classes", func() {
+			BeforeEach(func() {
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.WorkloadClasses = []v1.WorkloadClass{{Name: "batch", PriorityClassName: "low"}}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+			})
+
+			DescribeTable("should accept", func(priorityClassName string) {
+				vmi.Spec.WorkloadClass = "batch"
+				vmi.Spec.PriorityClassName = priorityClassName
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			},
+				Entry("a known workload class", ""),
+				Entry("a known workload class with its priority class", "low"),
+			)
+
+			DescribeTable("should reject", func(workloadClass, priorityClassName, expectedField string) {
+				vmi.Spec.WorkloadClass = workloadClass
+				vmi.Spec.PriorityClassName = priorityClassName
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			},
+				Entry("an unknown workload class", "interactive", "", "fake.workloadClass"),
+				Entry("a priority class other than the one of the workload class", "batch", "high", "fake.priorityClassName"),
+			)
+		})
+
+		It("should accept free page reporting and a KSM merge policy", func() {
+			vmi.Spec.Domain.Memory = &v1.Memory{
+				FreePageReporting: pointer.P(true),
+				KSMMergePolicy:    v1.KSMMergePolicyAggressive,
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject free page reporting without a memory balloon device", func() {
+			vmi.Spec.Domain.Memory = &v1.Memory{FreePageReporting: pointer.P(true)}
+			vmi.Spec.Domain.Devices.AutoattachMemBalloon = pointer.P(false)
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.memory.freePageReporting"))
+		})
+
+		It("should reject an unknown KSM merge policy", func() {
+			vmi.Spec.Domain.Memory = &v1.Memory{KSMMergePolicy: "Eager"}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueNotSupported))
+			Expect(causes[0].Field).To(Equal("fake.domain.memory.ksmMergePolicy"))
+		})
+
+		DescribeTable("should accept the memory overcommit class", func(class v1.MemoryOvercommitClass) {
+			vmi.Spec.Domain.Resources.Requests = nil
+			vmi.Spec.Domain.Memory = &v1.Memory{Guest: pointer.P(resource.MustParse("1Gi")), Overcommit: class}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		},
+			Entry("Guaranteed", v1.MemoryOvercommitGuaranteed),
+			Entry("BurstableWithSwap", v1.MemoryOvercommitBurstableWithSwap),
+			Entry("BestEffort", v1.MemoryOvercommitBestEffort),
+		)
+
+		DescribeTable("should reject the memory overcommit class", func(class v1.MemoryOvercommitClass, mutate func(spec *v1.VirtualMachineInstanceSpec), expectedField string) {
+			vmi.Spec.Domain.Resources.Requests = nil
+			vmi.Spec.Domain.Memory = &v1.Memory{Guest: pointer.P(resource.MustParse("1Gi")), Overcommit: class}
+			mutate(&vmi.Spec)
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("when it is unknown", v1.MemoryOvercommitClass("Overcommitted"), func(*v1.VirtualMachineInstanceSpec) {}, "fake.domain.memory.overcommit"),
+			Entry("Guaranteed with a KSM merge policy", v1.MemoryOvercommitGuaranteed, func(spec *v1.VirtualMachineInstanceSpec) {
+				spec.Domain.Memory.KSMMergePolicy = v1.KSMMergePolicyBalanced
+			}, "fake.domain.memory.ksmMergePolicy"),
+			Entry("Guaranteed with an overcommitted guest overhead", v1.MemoryOvercommitGuaranteed, func(spec *v1.VirtualMachineInstanceSpec) {
+				spec.Domain.Resources.OvercommitGuestOverhead = true
+			}, "fake.domain.resources.overcommitGuestOverhead"),
+			Entry("Guaranteed requesting less than the guest memory", v1.MemoryOvercommitGuaranteed, func(spec *v1.VirtualMachineInstanceSpec) {
+				spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("512Mi")}
+			}, "fake.domain.resources.requests.memory"),
+			Entry("BurstableWithSwap with dedicated CPUs", v1.MemoryOvercommitBurstableWithSwap, func(spec *v1.VirtualMachineInstanceSpec) {
+				spec.Domain.CPU = &v1.CPU{DedicatedCPUPlacement: true, Cores: 2}
+				spec.Domain.Resources.Limits = k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("1Gi")}
+			}, "fake.domain.memory.overcommit"),
+			Entry("BestEffort requesting memory", v1.MemoryOvercommitBestEffort, func(spec *v1.VirtualMachineInstanceSpec) {
+				spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("512Mi")}
+			}, "fake.domain.resources.requests.memory"),
+		)
+
 		It("should reject not divisable by hugepages.size requests.memory", func() {
 			vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
 				k8sv1.ResourceMemory: resource.MustParse("65Mi"),
@@ -1012,6 +1315,37 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(BeEmpty())
 		})
 
+		DescribeTable("should validate the regular memory of hugepages", func(regularMemory string, numa *v1.NUMA, valid bool) {
+			quantity := resource.MustParse(regularMemory)
+			vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
+				k8sv1.ResourceMemory: resource.MustParse("64Mi"),
+			}
+			vmi.Spec.Domain.Memory = &v1.Memory{
+				Hugepages: &v1.Hugepages{
+					PageSize:      "2Mi",
+					RegularMemory: &quantity,
+				},
+			}
+			if numa != nil {
+				vmi.Spec.Domain.CPU = &v1.CPU{NUMA: numa}
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			if valid {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(ContainElement(HaveField("Field", "fake.domain.memory.hugepages.regularMemory")))
+			}
+		},
+			Entry("and accept a multiple of the page size", "16Mi", nil, true),
+			Entry("and reject a negative value", "-2Mi", nil, false),
+			Entry("and reject a value which is not a multiple of the page size", "3Mi", nil, false),
+			Entry("and reject the whole guest memory", "64Mi", nil, false),
+			Entry("and reject more than the guest memory", "128Mi", nil, false),
+			Entry("and reject a guest mapping passthrough", "16Mi", &v1.NUMA{GuestMappingPassthrough: &v1.NUMAGuestMappingPassthrough{}}, false),
+			Entry("and reject guest NUMA cells", "16Mi", &v1.NUMA{GuestCells: []v1.NUMAGuestCell{{}}}, false),
+		)
+
 		DescribeTable("should verify LUN is mapped to PVC volume",
 			func(volume *v1.Volume, expectedErrors int) {
 				vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
@@ -1217,6 +1551,42 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(BeEmpty())
 		})
 
+		DescribeTable("GPUs requesting a mediated device profile", func(gpu v1.GPU, expectedMessage string) {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.MediatedDevicesConfiguration = &v1.MediatedDevicesConfiguration{
+				NodeMediatedDeviceTypes: []v1.NodeMediatedDeviceTypesConfig{
+					{
+						NodeSelector: map[string]string{"gpu": "t4"},
+						Profiles: []v1.MediatedDeviceProfile{
+							{Name: "small", MediatedDeviceType: "nvidia-222"},
+						},
+					},
+				},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.GPUs = []v1.GPU{gpu}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			if expectedMessage == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(ContainElement(And(
+					HaveField("Field", "fake.domain.devices.gpus[0].profile"),
+					HaveField("Message", ContainSubstring(expectedMessage)),
+				)))
+			}
+		},
+			Entry("should accept a defined profile", v1.GPU{Name: "gpu1", Profile: "small"}, ""),
+			Entry("should accept a defined profile with its resolved device name",
+				v1.GPU{Name: "gpu1", Profile: "small", DeviceName: v1.MediatedDeviceProfileResourcePrefix + "small"}, ""),
+			Entry("should reject an undefined profile", v1.GPU{Name: "gpu1", Profile: "large"}, "is not defined in the KubeVirt CR"),
+			Entry("should reject a profile with a different device name",
+				v1.GPU{Name: "gpu1", Profile: "small", DeviceName: "example.org/deadbeef"}, "can't be set together with a different deviceName"),
+			Entry("should reject a profile with a claim request",
+				v1.GPU{Name: "gpu1", Profile: "small", ClaimRequest: &v1.ClaimRequest{ClaimName: pointer.P("claim")}}, "can't be set together with a claim request"),
+		)
+
 		DescribeTable("virtiofs filesystems using", func(featureGate string, shouldAllow bool, vmiOption libvmi.Option) {
 			if featureGate != "" {
 				enableFeatureGates(featureGate)
@@ -1245,6 +1615,28 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Entry("config map should be accepted when the deprecated feature gate is enabled", featuregate.VirtIOFSGate, true, libvmi.WithConfigMapFs("sharedconfigmap", "sharedconfigmap")),
 		)
 
+		DescribeTable("virtiofs tuning", func(virtiofs v1.FilesystemVirtiofs, expectedField string) {
+			enableFeatureGates(featuregate.VirtIOFSStorageVolumeGate)
+
+			vmi := libvmi.New(libvmi.WithFilesystemPVC("sharedtestdisk"))
+			vmi.Spec.Domain.Devices.Filesystems[0].Virtiofs = &virtiofs
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			}
+		},
+			Entry("should accept the defaults", v1.FilesystemVirtiofs{}, ""),
+			Entry("should accept a supported cache mode", v1.FilesystemVirtiofs{Cache: v1.VirtiofsCacheNever}, ""),
+			Entry("should reject an unsupported cache mode", v1.FilesystemVirtiofs{Cache: "metadata"}, "fake.domain.devices.filesystems[0].virtiofs.cache"),
+			Entry("should accept a thread pool size within the limit", v1.FilesystemVirtiofs{ThreadPoolSize: pointer.P(uint32(16))}, ""),
+			Entry("should reject a thread pool size above the limit",
+				v1.FilesystemVirtiofs{ThreadPoolSize: pointer.P(uint32(1024))}, "fake.domain.devices.filesystems[0].virtiofs.threadPoolSize"),
+		)
+
 		It("should reject host devices when feature gate is disabled", func() {
 			vmi := api.NewMinimalVMI("testvm")
 			vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{
@@ -1456,6 +1848,78 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			})
 		})
 
+		Context("with hooks defined", func() {
+			newHook := func(name string, hookPoints ...v1.HookPoint) v1.Hook {
+				return v1.Hook{Name: name, Image: "hook-image", HookPoints: hookPoints}
+			}
+
+			It("should fail when the sidecar feature gate is disabled", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Hooks = []v1.Hook{newHook("smbios", v1.OnDefineDomainHookPoint)}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.hooks"))
+				Expect(causes[0].Message).To(Equal("sidecar feature gate is not enabled in kubevirt-config"))
+			})
+
+			It("should allow valid hooks", func() {
+				enableFeatureGates(featuregate.SidecarGate)
+				vmi := api.NewMinimalVMI("testvm")
+				hook := newHook("backup-agent", v1.PostMigrationTargetHookPoint, v1.PreShutdownHookPoint)
+				hook.Version = v1.HookVersionV1Alpha4
+				vmi.Spec.Hooks = []v1.Hook{newHook("smbios", v1.OnDefineDomainHookPoint, v1.PreCloudInitIsoHookPoint), hook}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			DescribeTable("should reject", func(hooks []v1.Hook, expectedField, expectedMessage string) {
+				enableFeatureGates(featuregate.SidecarGate)
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Hooks = hooks
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+				Expect(causes[0].Message).To(ContainSubstring(expectedMessage))
+			},
+				Entry("a hook without name",
+					[]v1.Hook{newHook("", v1.OnDefineDomainHookPoint)},
+					"fake.hooks[0].name", "fake.hooks[0].name is a required field",
+				),
+				Entry("hooks with the same name",
+					[]v1.Hook{newHook("smbios", v1.OnDefineDomainHookPoint), newHook("smbios", v1.PreCloudInitIsoHookPoint)},
+					"fake.hooks[1].name", "hook smbios is defined more than once",
+				),
+				Entry("a hook name clashing with the annotation sidecars",
+					[]v1.Hook{newHook("sidecar-0", v1.OnDefineDomainHookPoint)},
+					"fake.hooks[0].name", "must not start with sidecar-",
+				),
+				Entry("a hook name which is not a DNS label",
+					[]v1.Hook{newHook("SMBIOS", v1.OnDefineDomainHookPoint)},
+					"fake.hooks[0].name", "does not conform to the kubernetes DNS_LABEL rules",
+				),
+				Entry("a hook without image",
+					[]v1.Hook{{Name: "smbios", HookPoints: []v1.HookPoint{v1.OnDefineDomainHookPoint}}},
+					"fake.hooks[0].image", "fake.hooks[0].image is a required field",
+				),
+				Entry("an unsupported hook version",
+					[]v1.Hook{{Name: "smbios", Image: "hook-image", Version: "v2", HookPoints: []v1.HookPoint{v1.OnDefineDomainHookPoint}}},
+					"fake.hooks[0].version", "v2 is not a supported hook version",
+				),
+				Entry("a hook without hook points",
+					[]v1.Hook{newHook("smbios")},
+					"fake.hooks[0].hookPoints", "fake.hooks[0].hookPoints is a required field",
+				),
+				Entry("an unsupported hook point",
+					[]v1.Hook{newHook("smbios", "PostStart")},
+					"fake.hooks[0].hookPoints[0]", "PostStart is not a supported hook point",
+				),
+				Entry("a hook point not provided by the hook version",
+					[]v1.Hook{{Name: "smbios", Image: "hook-image", Version: v1.HookVersionV1Alpha3, HookPoints: []v1.HookPoint{v1.OnDefineDomainHookPoint, v1.PreShutdownHookPoint}}},
+					"fake.hooks[0].hookPoints[1]", "hook point PreShutdown requires hook version v1alpha4 or newer",
+				),
+			)
+		})
+
 		Context("with kernel boot defined", func() {
 
 			createKernelBoot := func(kernelArgs, initrdPath, kernelPath, image string) *v1.KernelBoot {
@@ -1588,6 +2052,110 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(BeEmpty())
 		})
 
+		Context("with guest NUMA cells", func() {
+			BeforeEach(func() {
+				vmi.Spec.Domain.CPU.Cores = 4
+				vmi.Spec.Domain.Resources.Limits = k8sv1.ResourceList{
+					k8sv1.ResourceCPU: resource.MustParse("4"),
+				}
+				guest := resource.MustParse("4Gi")
+				vmi.Spec.Domain.Memory = &v1.Memory{Guest: &guest, Hugepages: &v1.Hugepages{PageSize: "2Mi"}}
+				vmi.Spec.Domain.CPU.NUMA = &v1.NUMA{GuestCells: []v1.NUMAGuestCell{
+					{
+						ID:        0,
+						CPUs:      "0-1",
+						Memory:    resource.MustParse("2Gi"),
+						Distances: []v1.NUMAGuestCellDistance{{CellID: 0, Value: 10}, {CellID: 1, Value: 20}},
+					},
+					{
+						ID:        1,
+						CPUs:      "2-3",
+						Memory:    resource.MustParse("2Gi"),
+						Distances: []v1.NUMAGuestCellDistance{{CellID: 0, Value: 20}, {CellID: 1, Value: 10}},
+					},
+				}}
+			})
+
+			It("should accept a valid topology", func() {
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should accept cells keeping the threads of a core together", func() {
+				vmi.Spec.Domain.CPU.Cores = 2
+				vmi.Spec.Domain.CPU.Threads = 2
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			DescribeTable("should reject", func(update func(vmi *v1.VirtualMachineInstance), field string) {
+				update(vmi)
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(ContainElement(HaveField("Field", field)))
+			},
+				Entry("guest mapping passthrough", func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.NUMA.GuestMappingPassthrough = &v1.NUMAGuestMappingPassthrough{}
+				}, "fake.domain.cpu.numa.guestCells"),
+				Entry("CPU hotplug", func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.Sockets = 1
+					vmi.Spec.Domain.CPU.MaxSockets = 2
+				}, "fake.domain.cpu.maxSockets"),
+				Entry("memory hotplug", func(vmi *v1.VirtualMachineInstance) {
+					maxGuest := resource.MustParse("8Gi")
+					vmi.Spec.Domain.Memory.MaxGuest = &maxGuest
+				}, "fake.domain.memory.maxGuest"),
+				Entry("a duplicate cell ID", func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.NUMA.GuestCells[1].ID = 0
+				}, "fake.domain.cpu.numa.guestCells[1].id"),
+				Entry("a cell ID out of range", func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.NUMA.GuestCells[1].ID = 2
+				}, "fake.domain.cpu.numa.guestCells[1].id"),
+				Entry("an invalid vCPU list", func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.NUMA.GuestCells[0].CPUs = "zero"
+				}, "fake.domain.cpu.numa.guestCells[0].cpus"),
+				Entry("a vCPU which doesn't exist", func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.NUMA.GuestCells[1].CPUs = "2-4"
+				}, "fake.domain.cpu.numa.guestCells[1].cpus"),
+				Entry("a vCPU assigned to two cells", func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.NUMA.GuestCells[1].CPUs = "1-3"
+				}, "fake.domain.cpu.numa.guestCells[1].cpus"),
+				Entry("an unassigned vCPU", func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.NUMA.GuestCells[1].CPUs = "2"
+				}, "fake.domain.cpu.numa.guestCells"),
+				Entry("the threads of a dedicated core split across cells", func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.Cores = 2
+					vmi.Spec.Domain.CPU.Threads = 2
+					vmi.Spec.Domain.CPU.NUMA.GuestCells[0].CPUs = "0-2"
+					vmi.Spec.Domain.CPU.NUMA.GuestCells[1].CPUs = "3"
+				}, "fake.domain.cpu.numa.guestCells[1].cpus"),
+				Entry("a cell without memory", func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.NUMA.GuestCells[1].Memory = resource.MustParse("0")
+				}, "fake.domain.cpu.numa.guestCells[1].memory"),
+				Entry("a cell memory which is not a multiple of the hugepage size", func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.NUMA.GuestCells[0].Memory = resource.MustParse("2047Mi")
+					vmi.Spec.Domain.CPU.NUMA.GuestCells[1].Memory = resource.MustParse("2049Mi")
+				}, "fake.domain.cpu.numa.guestCells[0].memory"),
+				Entry("cells not adding up to the guest memory", func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.NUMA.GuestCells[1].Memory = resource.MustParse("1Gi")
+				}, "fake.domain.cpu.numa.guestCells"),
+				Entry("a distance to a cell which doesn't exist", func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.NUMA.GuestCells[0].Distances[1].CellID = 2
+				}, "fake.domain.cpu.numa.guestCells[0].distances[1]"),
+				Entry("a duplicate distance", func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.NUMA.GuestCells[0].Distances[1].CellID = 0
+				}, "fake.domain.cpu.numa.guestCells[0].distances[1]"),
+				Entry("a distance out of range", func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.NUMA.GuestCells[0].Distances[1].Value = 300
+				}, "fake.domain.cpu.numa.guestCells[0].distances[1]"),
+				Entry("a local distance other than 10", func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.NUMA.GuestCells[0].Distances[0].Value = 20
+				}, "fake.domain.cpu.numa.guestCells[0].distances[0]"),
+				Entry("a remote distance of 10", func(vmi *v1.VirtualMachineInstance) {
+					vmi.Spec.Domain.CPU.NUMA.GuestCells[0].Distances[1].Value = 10
+				}, "fake.domain.cpu.numa.guestCells[0].distances[1]"),
+			)
+		})
+
 		It("should reject vmi with threads > 1 for arm64 arch", func() {
 			enableFeatureGates(featuregate.MultiArchitecture)
 			vmi.Spec.Domain.CPU.Threads = 2
@@ -2052,6 +2620,108 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Message).To(ContainSubstring("downwardMetrics disks are not allowed: DownwardMetrics feature gate is not enabled."))
 		})
 
+		It("should accept vhost-user-blk volumes if the feature gate is enabled", func() {
+			enableFeatureGates(featuregate.VhostUserBlkGate)
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "spdk-disk",
+				VolumeSource: v1.VolumeSource{
+					VhostUserBlk: &v1.VhostUserBlkVolumeSource{SocketName: "vhost.0"},
+				},
+			})
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject vhost-user-blk volumes if the feature gate is not enabled", func() {
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "spdk-disk",
+				VolumeSource: v1.VolumeSource{
+					VhostUserBlk: &v1.VhostUserBlkVolumeSource{SocketName: "vhost.0"},
+				},
+			})
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake[0].vhostUserBlk"))
+			Expect(causes[0].Message).To(Equal("VhostUserBlk feature gate is not enabled in kubevirt-config"))
+		})
+
+		DescribeTable("should validate cloud-init userDataSources", func(sources []v1.CloudInitUserDataSource, expectedCauses int) {
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "cloudinit",
+				VolumeSource: v1.VolumeSource{
+					CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserDataSources: sources},
+				},
+			})
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			Expect(causes).To(HaveLen(expectedCauses))
+			for _, cause := range causes {
+				Expect(cause.Field).To(Equal("fake[0].cloudInitNoCloud.userDataSources[1]"))
+				Expect(cause.Message).To(Equal("fake[0].cloudInitNoCloud.userDataSources[1] must have exactly one of secretRef or configMapRef set"))
+			}
+		},
+			Entry("accept secrets and configmaps", []v1.CloudInitUserDataSource{
+				{SecretRef: &k8sv1.LocalObjectReference{Name: "users"}},
+				{ConfigMapRef: &k8sv1.LocalObjectReference{Name: "packages"}},
+			}, 0),
+			Entry("reject a source without a reference", []v1.CloudInitUserDataSource{
+				{SecretRef: &k8sv1.LocalObjectReference{Name: "users"}},
+				{},
+			}, 1),
+			Entry("reject a source with both references", []v1.CloudInitUserDataSource{
+				{SecretRef: &k8sv1.LocalObjectReference{Name: "users"}},
+				{SecretRef: &k8sv1.LocalObjectReference{Name: "users"}, ConfigMapRef: &k8sv1.LocalObjectReference{Name: "packages"}},
+			}, 1),
+		)
+
+		DescribeTable("should validate Ignition volumes", func(enableGate bool, source *v1.IgnitionSource, expectedMessage string) {
+			if enableGate {
+				enableFeatureGates(featuregate.IgnitionGate)
+			}
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name:         "ignition",
+				VolumeSource: v1.VolumeSource{Ignition: source},
+			})
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			if expectedMessage == "" {
+				Expect(causes).To(BeEmpty())
+				return
+			}
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake[0].ignition"))
+			Expect(causes[0].Message).To(Equal(expectedMessage))
+		},
+			Entry("accept inline data", true, &v1.IgnitionSource{Data: "{}"}, ""),
+			Entry("accept a secret", true, &v1.IgnitionSource{SecretRef: &k8sv1.LocalObjectReference{Name: "ignition"}}, ""),
+			Entry("reject if the feature gate is not enabled", false, &v1.IgnitionSource{Data: "{}"},
+				"ExperimentalIgnitionSupport feature gate is not enabled in kubevirt-config"),
+			Entry("reject without a source", true, &v1.IgnitionSource{},
+				"fake[0].ignition must have exactly one of data or secretRef set"),
+			Entry("reject with both sources", true, &v1.IgnitionSource{Data: "{}", SecretRef: &k8sv1.LocalObjectReference{Name: "ignition"}},
+				"fake[0].ignition must have exactly one of data or secretRef set"),
+		)
+
+		It("should reject Ignition volumes if more than one exist", func() {
+			enableFeatureGates(featuregate.IgnitionGate)
+
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes,
+				v1.Volume{
+					Name:         "ignition",
+					VolumeSource: v1.VolumeSource{Ignition: &v1.IgnitionSource{Data: "{}"}},
+				},
+				v1.Volume{
+					Name:         "ignition1",
+					VolumeSource: v1.VolumeSource{Ignition: &v1.IgnitionSource{Data: "{}"}},
+				},
+			)
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(Equal("fake must have max one ignition volume set"))
+		})
+
 		It("should reject downwardMetrics volumes if more than one exist", func() {
 			enableFeatureGates(featuregate.DownwardMetricsFeatureGate)
 
@@ -2123,6 +2793,39 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(BeEmpty())
 		})
 
+		DescribeTable("should validate the namespace and template of sysprep volumes", func(sysprep *v1.SysprepSource, expectedField string) {
+			if sysprep.Secret == nil {
+				sysprep.ConfigMap = &k8sv1.LocalObjectReference{Name: "test-config"}
+			}
+			vmi := api.NewMinimalVMI("fake-vmi")
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name:         "sysprep",
+				VolumeSource: v1.VolumeSource{Sysprep: sysprep},
+			})
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(ConsistOf(HaveField("Field", expectedField)))
+			}
+		},
+			Entry("accept a template from another namespace", &v1.SysprepSource{
+				Namespace: "golden",
+				Template: &v1.SysprepTemplate{
+					ParametersSecretRef: &k8sv1.LocalObjectReference{Name: "license"},
+				},
+			}, ""),
+			Entry("reject an invalid namespace", &v1.SysprepSource{Namespace: "Golden_Templates"}, "fake[0].sysprep.namespace"),
+			Entry("reject a namespace for a secret", &v1.SysprepSource{
+				Namespace: "golden",
+				Secret:    &k8sv1.LocalObjectReference{Name: "test-secret"},
+			}, "fake[0].sysprep.namespace"),
+			Entry("reject a parameters secret without name", &v1.SysprepSource{
+				Template: &v1.SysprepTemplate{ParametersSecretRef: &k8sv1.LocalObjectReference{}},
+			}, "fake[0].sysprep.template.parametersSecretRef.name"),
+		)
+
 		It("should reject CloudInitNoCloud volume if either userData or networkData is missing", func() {
 			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
 				Name: "testdisk",
@@ -3463,6 +4166,25 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		)
 	})
 
+	DescribeTable("should validate the graphics device", func(graphics *v1.Graphics, autoattach *bool, expectedMessage string) {
+		vmi := libvmi.New()
+		vmi.Spec.Domain.Devices.Graphics = graphics
+		vmi.Spec.Domain.Devices.AutoattachGraphicsDevice = autoattach
+		causes := validateGraphics(k8sfield.NewPath("fake"), &vmi.Spec)
+		if expectedMessage == "" {
+			Expect(causes).To(BeEmpty())
+		} else {
+			Expect(causes).To(HaveExactElements(HaveField("Message", expectedMessage)))
+		}
+	},
+		Entry("accept no graphics", nil, nil, ""),
+		Entry("accept VNC", &v1.Graphics{Type: v1.GraphicsTypeVNC}, nil, ""),
+		Entry("accept SPICE", &v1.Graphics{Type: v1.GraphicsTypeSPICE}, pointer.P(true), ""),
+		Entry("reject an unknown type", &v1.Graphics{Type: "RDP"}, nil, "graphics type 'RDP' is not supported, use VNC or SPICE"),
+		Entry("reject graphics without a graphics device", &v1.Graphics{Type: v1.GraphicsTypeSPICE}, pointer.P(false),
+			"Graphics configuration is not allowed when autoattachGraphicsDevice is set to false"),
+	)
+
 	Context("with VideoConfig", func() {
 		var vmi *v1.VirtualMachineInstance
 		BeforeEach(func() {
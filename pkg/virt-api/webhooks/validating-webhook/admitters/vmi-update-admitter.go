@@ -21,6 +21,7 @@ package admitters
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
@@ -172,7 +173,7 @@ func admitHotplug(
 		return response
 	}
 
-	if response := admitHotplugMemory(oldVMI.Spec.Domain.Memory, newVMI.Spec.Domain.Memory); response != nil {
+	if response := admitHotplugMemory(oldVMI.Spec.Domain.Memory, newVMI.Spec.Domain.Memory, oldVMI.Status.Memory); response != nil {
 		return response
 	}
 
@@ -181,6 +182,8 @@ func admitHotplug(
 		oldVMI.Spec.Volumes,
 		newVMI.Spec.Domain.Devices.Disks,
 		oldVMI.Spec.Domain.Devices.Disks,
+		newVMI.Spec.Domain.Devices.Filesystems,
+		oldVMI.Spec.Domain.Devices.Filesystems,
 		oldVMI.Status.VolumeStatus,
 		newVMI,
 		clusterConfig)
@@ -198,10 +201,30 @@ func admitHotplugCPU(oldCPUTopology, newCPUTopology *v1.CPU) *admissionv1.Admiss
 		})
 	}
 
+	if newCPUTopology.Sockets < oldCPUTopology.Sockets {
+		// The vCPUs of the first socket are not hotpluggable
+		if newCPUTopology.Sockets < 1 {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "CPU topology sockets cannot be reduced below 1",
+				},
+			})
+		}
+		if newCPUTopology.DedicatedCPUPlacement {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "CPU topology sockets cannot be reduced with dedicated CPUs",
+				},
+			})
+		}
+	}
+
 	return nil
 }
 
-func admitHotplugMemory(oldMemory, newMemory *v1.Memory) *admissionv1.AdmissionResponse {
+func admitHotplugMemory(oldMemory, newMemory *v1.Memory, memoryStatus *v1.MemoryStatus) *admissionv1.AdmissionResponse {
 	if oldMemory == nil ||
 		oldMemory.MaxGuest == nil ||
 		newMemory == nil ||
@@ -218,6 +241,17 @@ func admitHotplugMemory(oldMemory, newMemory *v1.Memory) *admissionv1.AdmissionR
 		})
 	}
 
+	// Only the hotplugged memory can be unplugged, the guest keeps the memory it booted with
+	if newMemory.Guest != nil && memoryStatus != nil && memoryStatus.GuestAtBoot != nil &&
+		newMemory.Guest.Cmp(*memoryStatus.GuestAtBoot) < 0 {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Memory guest cannot be reduced below the memory the guest booted with (%s)", memoryStatus.GuestAtBoot.String()),
+			},
+		})
+	}
+
 	return nil
 }
 
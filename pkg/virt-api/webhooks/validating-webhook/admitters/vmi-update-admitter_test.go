@@ -484,7 +484,59 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			&v1.CPU{
 				MaxSockets: 8,
 			},
-			BeFalse()))
+			BeFalse()),
+		Entry("allow reduction of sockets",
+			&v1.CPU{Sockets: 4, MaxSockets: 8},
+			&v1.CPU{Sockets: 2, MaxSockets: 8},
+			BeTrue()),
+		Entry("deny reduction of sockets below 1",
+			&v1.CPU{Sockets: 4, MaxSockets: 8},
+			&v1.CPU{Sockets: 0, MaxSockets: 8},
+			BeFalse()),
+		Entry("deny reduction of sockets with dedicated CPUs",
+			&v1.CPU{Sockets: 4, MaxSockets: 8, DedicatedCPUPlacement: true},
+			&v1.CPU{Sockets: 2, MaxSockets: 8, DedicatedCPUPlacement: true},
+			BeFalse()),
+	)
+
+	DescribeTable("Updates in guest memory", func(newGuest string, expected types.GomegaMatcher) {
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.CPU = &v1.CPU{}
+		guest := resource.MustParse("2Gi")
+		guestAtBoot := resource.MustParse("1Gi")
+		maxGuest := resource.MustParse("4Gi")
+		vmi.Spec.Domain.Memory = &v1.Memory{
+			Guest:    &guest,
+			MaxGuest: &maxGuest,
+		}
+		vmi.Status.Memory = &v1.MemoryStatus{
+			GuestAtBoot: &guestAtBoot,
+		}
+		updateVmi := vmi.DeepCopy()
+		updatedGuest := resource.MustParse(newGuest)
+		updateVmi.Spec.Domain.Memory.Guest = &updatedGuest
+
+		newVMIBytes, _ := json.Marshal(&updateVmi)
+		oldVMIBytes, _ := json.Marshal(&vmi)
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				UserInfo: authv1.UserInfo{Username: "system:serviceaccount:kubevirt:" + components.ControllerServiceAccountName},
+				Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: newVMIBytes,
+				},
+				OldObject: runtime.RawExtension{
+					Raw: oldVMIBytes,
+				},
+				Operation: admissionv1.Update,
+			},
+		}
+		resp := vmiUpdateAdmitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(expected)
+	},
+		Entry("allow reduction to the memory at boot", "1Gi", BeTrue()),
+		Entry("deny reduction below the memory at boot", "512Mi", BeFalse()),
+	)
 
 	It("should reject updates to maxGuest", func() {
 		vmi := api.NewMinimalVMI("testvmi")
@@ -74,7 +74,7 @@ func (admitter *VMIRSAdmitter) Admit(_ context.Context, ar *admissionv1.Admissio
 
 	return &admissionv1.AdmissionResponse{
 		Allowed:  true,
-		Warnings: warnDeprecatedAPIs(&vmirs.Spec.Template.Spec, admitter.ClusterConfig),
+		Warnings: WarnVirtualMachineInstanceSpec(k8sfield.NewPath("spec", "template", "spec"), &vmirs.Spec.Template.Spec, admitter.ClusterConfig),
 	}
 }
 
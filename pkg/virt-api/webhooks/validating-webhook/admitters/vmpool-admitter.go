@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/netip"
 	"strconv"
 	"strings"
 
@@ -32,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
@@ -100,7 +102,7 @@ func (admitter *VMPoolAdmitter) Admit(_ context.Context, ar *admissionv1.Admissi
 
 	return &admissionv1.AdmissionResponse{
 		Allowed:  true,
-		Warnings: warnDeprecatedAPIs(&pool.Spec.VirtualMachineTemplate.Spec.Template.Spec, admitter.ClusterConfig),
+		Warnings: WarnVirtualMachineInstanceSpec(k8sfield.NewPath("spec", "virtualMachineTemplate", "spec", "template", "spec"), &pool.Spec.VirtualMachineTemplate.Spec.Template.Spec, admitter.ClusterConfig),
 	}
 }
 
@@ -169,6 +171,18 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 		}
 	}
 
+	if spec.Autoscaling != nil {
+		causes = append(causes, validateVMPoolAutoscaling(field.Child("autoscaling"), spec.Autoscaling)...)
+	}
+
+	if spec.UpdateStrategy != nil {
+		causes = append(causes, validateVMPoolUpdateStrategy(field, spec)...)
+	}
+
+	if spec.ReplicaTemplating != nil {
+		causes = append(causes, validateVMPoolReplicaTemplating(field.Child("replicaTemplating"), pool.Name, spec.ReplicaTemplating)...)
+	}
+
 	if ar.Request.Operation == admissionv1.Update {
 		oldPool := &poolv1.VirtualMachinePool{}
 		if err := json.Unmarshal(ar.Request.OldObject.Raw, oldPool); err != nil {
@@ -188,3 +202,236 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 	}
 	return causes
 }
+
+func validateVMPoolAutoscaling(field *k8sfield.Path, autoscaling *poolv1.VirtualMachinePoolAutoscaling) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	minReplicas := int32(1)
+	if autoscaling.MinReplicas != nil {
+		minReplicas = *autoscaling.MinReplicas
+	}
+	if minReplicas < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "minReplicas must not be negative",
+			Field:   field.Child("minReplicas").String(),
+		})
+	} else if autoscaling.MaxReplicas < minReplicas || autoscaling.MaxReplicas == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("maxReplicas must be greater than zero and not lower than minReplicas (%d)", minReplicas),
+			Field:   field.Child("maxReplicas").String(),
+		})
+	}
+
+	if len(autoscaling.Metrics) == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "at least one metric is required",
+			Field:   field.Child("metrics").String(),
+		})
+	}
+
+	for i, metric := range autoscaling.Metrics {
+		metricField := field.Child("metrics").Index(i)
+		switch metric.Type {
+		case poolv1.VirtualMachinePoolMetricCPU, poolv1.VirtualMachinePoolMetricMemory:
+			if metric.TargetAverageUtilization == nil || *metric.TargetAverageUtilization < 1 || *metric.TargetAverageUtilization > 100 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("targetAverageUtilization between 1 and 100 is required for the %s metric", metric.Type),
+					Field:   metricField.Child("targetAverageUtilization").String(),
+				})
+			}
+		case poolv1.VirtualMachinePoolMetricPrometheus:
+			if metric.Prometheus == nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueRequired,
+					Message: "prometheus is required for the Prometheus metric",
+					Field:   metricField.Child("prometheus").String(),
+				})
+				continue
+			}
+			if metric.Prometheus.Query == "" {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueRequired,
+					Message: "query is required",
+					Field:   metricField.Child("prometheus", "query").String(),
+				})
+			}
+			if metric.Prometheus.TargetAverageValue.Sign() <= 0 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "targetAverageValue must be greater than zero",
+					Field:   metricField.Child("prometheus", "targetAverageValue").String(),
+				})
+			}
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("unsupported metric type %q", metric.Type),
+				Field:   metricField.Child("type").String(),
+			})
+		}
+	}
+
+	if autoscaling.ScaleInStabilizationWindow != nil && autoscaling.ScaleInStabilizationWindow.Duration < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "scaleInStabilizationWindow must not be negative",
+			Field:   field.Child("scaleInStabilizationWindow").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateVMPoolUpdateStrategy(field *k8sfield.Path, spec *poolv1.VirtualMachinePoolSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	strategyField := field.Child("updateStrategy")
+	strategy := spec.UpdateStrategy
+	switch strategy.Type {
+	case "", poolv1.VirtualMachinePoolRollingUpdateStrategyType:
+	case poolv1.VirtualMachinePoolOpportunisticUpdateStrategyType:
+		if strategy.RollingUpdate != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("rollingUpdate is only allowed with the %s update strategy", poolv1.VirtualMachinePoolRollingUpdateStrategyType),
+				Field:   strategyField.Child("rollingUpdate").String(),
+			})
+		}
+		return causes
+	default:
+		return append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("update strategy %q is not supported, must be %s or %s", strategy.Type,
+				poolv1.VirtualMachinePoolRollingUpdateStrategyType, poolv1.VirtualMachinePoolOpportunisticUpdateStrategyType),
+			Field: strategyField.Child("type").String(),
+		})
+	}
+
+	rollingUpdate := strategy.RollingUpdate
+	if rollingUpdate == nil {
+		return causes
+	}
+	rollingUpdateField := strategyField.Child("rollingUpdate")
+
+	if rollingUpdate.MaxUnavailable != nil && spec.MaxUnavailable != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxUnavailable must not be set both in the spec and in the rolling update",
+			Field:   rollingUpdateField.Child("maxUnavailable").String(),
+		})
+	}
+
+	maxUnavailable, unavailableCauses := validateVMPoolIntOrPercent(rollingUpdateField.Child("maxUnavailable"), rollingUpdate.MaxUnavailable)
+	causes = append(causes, unavailableCauses...)
+	maxSurge, surgeCauses := validateVMPoolIntOrPercent(rollingUpdateField.Child("maxSurge"), rollingUpdate.MaxSurge)
+	causes = append(causes, surgeCauses...)
+
+	if rollingUpdate.MaxUnavailable != nil && maxUnavailable == 0 && maxSurge == 0 && len(causes) == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxUnavailable and maxSurge must not both be 0",
+			Field:   rollingUpdateField.String(),
+		})
+	}
+
+	return causes
+}
+
+// validateVMPoolIntOrPercent validates a non negative number or percentage and returns its value
+func validateVMPoolIntOrPercent(field *k8sfield.Path, value *intstr.IntOrString) (int, []metav1.StatusCause) {
+	if value == nil {
+		return 0, nil
+	}
+
+	if value.Type == intstr.Int {
+		if value.IntVal < 0 {
+			return 0, []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be negative", field.String()),
+				Field:   field.String(),
+			}}
+		}
+		return int(value.IntVal), nil
+	}
+
+	percentage, found := strings.CutSuffix(value.StrVal, "%")
+	val, err := strconv.Atoi(percentage)
+	if !found || err != nil || val < 0 || val > 100 {
+		return 0, []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s %q must be a number or a percentage between 0%% and 100%%", field.String(), value.StrVal),
+			Field:   field.String(),
+		}}
+	}
+	return val, nil
+}
+
+func validateVMPoolReplicaTemplating(field *k8sfield.Path, poolName string, templating *poolv1.VirtualMachinePoolReplicaTemplating) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if templating.Hostname != "" {
+		// Validate the hostname of the first replica, the index is the only part changing between replicas
+		hostname := strings.NewReplacer("$(POOL_INDEX)", "0", "$(VM_NAME)", poolName+"-0").Replace(templating.Hostname)
+		if errs := validation.IsDNS1123Label(hostname); len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("hostname %q of the replicas is not valid: %s", hostname, strings.Join(errs, ", ")),
+				Field:   field.Child("hostname").String(),
+			})
+		}
+	}
+
+	if staticIPs := templating.StaticIPs; staticIPs != nil {
+		start, err := netip.ParseAddr(staticIPs.Start)
+		if err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("start must be an IP address: %v", err),
+				Field:   field.Child("staticIPs", "start").String(),
+			})
+		} else if staticIPs.End != "" {
+			end, err := netip.ParseAddr(staticIPs.End)
+			if err != nil || end.Is4() != start.Is4() || end.Less(start) {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "end must be an IP address of the family of start, not lower than start",
+					Field:   field.Child("staticIPs", "end").String(),
+				})
+			}
+		}
+	}
+
+	causes = append(causes, validateVMPoolReplicaObjectTemplates(field.Child("secrets"), templating.Secrets)...)
+	causes = append(causes, validateVMPoolReplicaObjectTemplates(field.Child("configMaps"), templating.ConfigMaps)...)
+
+	return causes
+}
+
+func validateVMPoolReplicaObjectTemplates(field *k8sfield.Path, templates []poolv1.VirtualMachinePoolReplicaObjectTemplate) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	names := map[string]bool{}
+	for i, template := range templates {
+		nameField := field.Index(i).Child("name")
+		if errs := validation.IsDNS1123Subdomain(template.Name); len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("name %q is not valid: %s", template.Name, strings.Join(errs, ", ")),
+				Field:   nameField.String(),
+			})
+		} else if names[template.Name] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("name %q is used more than once", template.Name),
+				Field:   nameField.String(),
+			})
+		}
+		names[template.Name] = true
+	}
+
+	return causes
+}
@@ -22,10 +22,12 @@ package admitters
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -34,6 +36,7 @@ import (
 	virtv1 "kubevirt.io/api/core/v1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 )
@@ -245,4 +248,268 @@ var _ = Describe("Validating Pool Admitter", func() {
 		resp := poolAdmitter.Admit(context.Background(), ar)
 		Expect(resp.Allowed).To(BeTrue())
 	})
+
+	DescribeTable("should validate the autoscaling of the pool", func(autoscaling *poolv1.VirtualMachinePoolAutoscaling, causes ...string) {
+		pool := &poolv1.VirtualMachinePool{
+			Spec: poolv1.VirtualMachinePoolSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"match": "me"},
+				},
+				VirtualMachineTemplate: &poolv1.VirtualMachineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"match": "me"},
+					},
+					Spec: v1.VirtualMachineSpec{
+						RunStrategy: &always,
+						Template: newVirtualMachineBuilder().
+							WithDisk(v1.Disk{
+								Name: "testdisk",
+							}).
+							WithVolume(v1.Volume{
+								Name: "testdisk",
+								VolumeSource: v1.VolumeSource{
+									ContainerDisk: testutils.NewFakeContainerDiskSource(),
+								},
+							}).
+							BuildTemplate(),
+					},
+				},
+				Autoscaling: autoscaling,
+			},
+		}
+		poolBytes, _ := json.Marshal(&pool)
+
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Resource: webhooks.VirtualMachinePoolGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: poolBytes,
+				},
+			},
+		}
+
+		resp := poolAdmitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(Equal(len(causes) == 0))
+		if len(causes) > 0 {
+			Expect(resp.Result.Details.Causes).To(HaveLen(len(causes)))
+			for i, cause := range causes {
+				Expect(resp.Result.Details.Causes[i].Field).To(Equal(cause))
+			}
+		}
+	},
+		Entry("accept CPU and Prometheus metrics", &poolv1.VirtualMachinePoolAutoscaling{
+			MinReplicas: pointer.P(int32(2)),
+			MaxReplicas: 10,
+			Metrics: []poolv1.VirtualMachinePoolMetric{
+				{Type: poolv1.VirtualMachinePoolMetricCPU, TargetAverageUtilization: pointer.P(int32(70))},
+				{Type: poolv1.VirtualMachinePoolMetricPrometheus, Prometheus: &poolv1.VirtualMachinePoolPrometheusMetric{
+					Query:              "sum(rate(requests_total[5m]))",
+					TargetAverageValue: resource.MustParse("100"),
+				}},
+			},
+		}),
+		Entry("reject maxReplicas lower than minReplicas", &poolv1.VirtualMachinePoolAutoscaling{
+			MinReplicas: pointer.P(int32(5)),
+			MaxReplicas: 3,
+			Metrics: []poolv1.VirtualMachinePoolMetric{
+				{Type: poolv1.VirtualMachinePoolMetricMemory, TargetAverageUtilization: pointer.P(int32(70))},
+			},
+		}, "spec.autoscaling.maxReplicas"),
+		Entry("reject a negative minReplicas", &poolv1.VirtualMachinePoolAutoscaling{
+			MinReplicas: pointer.P(int32(-1)),
+			MaxReplicas: 3,
+			Metrics: []poolv1.VirtualMachinePoolMetric{
+				{Type: poolv1.VirtualMachinePoolMetricMemory, TargetAverageUtilization: pointer.P(int32(70))},
+			},
+		}, "spec.autoscaling.minReplicas"),
+		Entry("reject missing metrics", &poolv1.VirtualMachinePoolAutoscaling{
+			MaxReplicas: 3,
+		}, "spec.autoscaling.metrics"),
+		Entry("reject a CPU metric without target", &poolv1.VirtualMachinePoolAutoscaling{
+			MaxReplicas: 3,
+			Metrics: []poolv1.VirtualMachinePoolMetric{
+				{Type: poolv1.VirtualMachinePoolMetricCPU},
+			},
+		}, "spec.autoscaling.metrics[0].targetAverageUtilization"),
+		Entry("reject a Prometheus metric without query and target", &poolv1.VirtualMachinePoolAutoscaling{
+			MaxReplicas: 3,
+			Metrics: []poolv1.VirtualMachinePoolMetric{
+				{Type: poolv1.VirtualMachinePoolMetricPrometheus, Prometheus: &poolv1.VirtualMachinePoolPrometheusMetric{}},
+			},
+		}, "spec.autoscaling.metrics[0].prometheus.query", "spec.autoscaling.metrics[0].prometheus.targetAverageValue"),
+		Entry("reject a negative scaleInStabilizationWindow", &poolv1.VirtualMachinePoolAutoscaling{
+			MaxReplicas: 3,
+			Metrics: []poolv1.VirtualMachinePoolMetric{
+				{Type: poolv1.VirtualMachinePoolMetricCPU, TargetAverageUtilization: pointer.P(int32(70))},
+			},
+			ScaleInStabilizationWindow: &metav1.Duration{Duration: -time.Minute},
+		}, "spec.autoscaling.scaleInStabilizationWindow"),
+	)
+
+	DescribeTable("should validate the replica templating of the pool", func(templating *poolv1.VirtualMachinePoolReplicaTemplating, causes ...string) {
+		pool := &poolv1.VirtualMachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "web",
+			},
+			Spec: poolv1.VirtualMachinePoolSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"match": "me"},
+				},
+				VirtualMachineTemplate: &poolv1.VirtualMachineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"match": "me"},
+					},
+					Spec: v1.VirtualMachineSpec{
+						RunStrategy: &always,
+						Template: newVirtualMachineBuilder().
+							WithDisk(v1.Disk{
+								Name: "testdisk",
+							}).
+							WithVolume(v1.Volume{
+								Name: "testdisk",
+								VolumeSource: v1.VolumeSource{
+									ContainerDisk: testutils.NewFakeContainerDiskSource(),
+								},
+							}).
+							BuildTemplate(),
+					},
+				},
+				ReplicaTemplating: templating,
+			},
+		}
+		poolBytes, _ := json.Marshal(&pool)
+
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Resource: webhooks.VirtualMachinePoolGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: poolBytes,
+				},
+			},
+		}
+
+		resp := poolAdmitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(Equal(len(causes) == 0))
+		if len(causes) > 0 {
+			Expect(resp.Result.Details.Causes).To(HaveLen(len(causes)))
+			for i, cause := range causes {
+				Expect(resp.Result.Details.Causes[i].Field).To(Equal(cause))
+			}
+		}
+	},
+		Entry("accept a hostname, static IPs and objects", &poolv1.VirtualMachinePoolReplicaTemplating{
+			Hostname:   "$(VM_NAME)-host",
+			StaticIPs:  &poolv1.VirtualMachinePoolStaticIPs{Start: "10.0.0.10", End: "10.0.0.50"},
+			Secrets:    []poolv1.VirtualMachinePoolReplicaObjectTemplate{{Name: "credentials"}},
+			ConfigMaps: []poolv1.VirtualMachinePoolReplicaObjectTemplate{{Name: "config", Data: map[string]string{"index": "$(POOL_INDEX)"}}},
+		}),
+		Entry("reject an invalid hostname", &poolv1.VirtualMachinePoolReplicaTemplating{
+			Hostname: "web_$(POOL_INDEX)",
+		}, "spec.replicaTemplating.hostname"),
+		Entry("reject an invalid static IP start", &poolv1.VirtualMachinePoolReplicaTemplating{
+			StaticIPs: &poolv1.VirtualMachinePoolStaticIPs{Start: "10.0.0"},
+		}, "spec.replicaTemplating.staticIPs.start"),
+		Entry("reject a static IP end lower than start", &poolv1.VirtualMachinePoolReplicaTemplating{
+			StaticIPs: &poolv1.VirtualMachinePoolStaticIPs{Start: "10.0.0.10", End: "10.0.0.1"},
+		}, "spec.replicaTemplating.staticIPs.end"),
+		Entry("reject a static IP end of another family", &poolv1.VirtualMachinePoolReplicaTemplating{
+			StaticIPs: &poolv1.VirtualMachinePoolStaticIPs{Start: "10.0.0.10", End: "fd00::10"},
+		}, "spec.replicaTemplating.staticIPs.end"),
+		Entry("reject an invalid secret name", &poolv1.VirtualMachinePoolReplicaTemplating{
+			Secrets: []poolv1.VirtualMachinePoolReplicaObjectTemplate{{Name: "Credentials"}},
+		}, "spec.replicaTemplating.secrets[0].name"),
+		Entry("reject a duplicate configmap name", &poolv1.VirtualMachinePoolReplicaTemplating{
+			ConfigMaps: []poolv1.VirtualMachinePoolReplicaObjectTemplate{{Name: "config"}, {Name: "config"}},
+		}, "spec.replicaTemplating.configMaps[1].name"),
+	)
+
+	DescribeTable("should validate the update strategy of the pool", func(strategy *poolv1.VirtualMachinePoolUpdateStrategy, maxUnavailable *intstr.IntOrString, causes ...string) {
+		pool := &poolv1.VirtualMachinePool{
+			Spec: poolv1.VirtualMachinePoolSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"match": "me"},
+				},
+				VirtualMachineTemplate: &poolv1.VirtualMachineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"match": "me"},
+					},
+					Spec: v1.VirtualMachineSpec{
+						RunStrategy: &always,
+						Template: newVirtualMachineBuilder().
+							WithDisk(v1.Disk{
+								Name: "testdisk",
+							}).
+							WithVolume(v1.Volume{
+								Name: "testdisk",
+								VolumeSource: v1.VolumeSource{
+									ContainerDisk: testutils.NewFakeContainerDiskSource(),
+								},
+							}).
+							BuildTemplate(),
+					},
+				},
+				MaxUnavailable: maxUnavailable,
+				UpdateStrategy: strategy,
+			},
+		}
+		poolBytes, _ := json.Marshal(&pool)
+
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Resource: webhooks.VirtualMachinePoolGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: poolBytes,
+				},
+			},
+		}
+
+		resp := poolAdmitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(Equal(len(causes) == 0))
+		if len(causes) > 0 {
+			Expect(resp.Result.Details.Causes).To(HaveLen(len(causes)))
+			for i, cause := range causes {
+				Expect(resp.Result.Details.Causes[i].Field).To(Equal(cause))
+			}
+		}
+	},
+		Entry("accept a rolling update with maxSurge and live updates", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type: poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+				MaxSurge:       pointer.P(intstr.FromString("25%")),
+				LiveUpdate:     true,
+			},
+		}, nil),
+		Entry("accept the Opportunistic strategy", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type: poolv1.VirtualMachinePoolOpportunisticUpdateStrategyType,
+		}, nil),
+		Entry("reject an unknown strategy", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type: "Recreate",
+		}, nil, "spec.updateStrategy.type"),
+		Entry("reject a rolling update with the Opportunistic strategy", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type:          poolv1.VirtualMachinePoolOpportunisticUpdateStrategyType,
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{LiveUpdate: true},
+		}, nil, "spec.updateStrategy.rollingUpdate"),
+		Entry("reject maxUnavailable set twice", &poolv1.VirtualMachinePoolUpdateStrategy{
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: pointer.P(intstr.FromInt32(1)),
+			},
+		}, pointer.P(intstr.FromInt32(1)), "spec.updateStrategy.rollingUpdate.maxUnavailable"),
+		Entry("reject a negative maxSurge", &poolv1.VirtualMachinePoolUpdateStrategy{
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxSurge: pointer.P(intstr.FromInt32(-1)),
+			},
+		}, nil, "spec.updateStrategy.rollingUpdate.maxSurge"),
+		Entry("reject an invalid maxUnavailable percentage", &poolv1.VirtualMachinePoolUpdateStrategy{
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: pointer.P(intstr.FromString("150%")),
+			},
+		}, nil, "spec.updateStrategy.rollingUpdate.maxUnavailable"),
+		Entry("reject maxUnavailable and maxSurge both 0", &poolv1.VirtualMachinePoolUpdateStrategy{
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+				MaxSurge:       pointer.P(intstr.FromString("0%")),
+			},
+		}, nil, "spec.updateStrategy.rollingUpdate"),
+	)
 })
@@ -0,0 +1,124 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	quotav1 "kubevirt.io/api/quota/v1alpha1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	"kubevirt.io/kubevirt/pkg/vmquota"
+)
+
+type VMResourceQuotaAdmitter struct{}
+
+func (admitter *VMResourceQuotaAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	gvr := webhooks.VirtualMachineResourceQuotaGroupVersionResource
+	if ar.Request == nil {
+		err := fmt.Errorf("Empty request for virtual machine resource quota validation")
+		return webhookutils.ToAdmissionResponseError(err)
+	} else if ar.Request.Resource != gvr {
+		err := fmt.Errorf("expect resource %+v, but got %+v", gvr, ar.Request.Resource)
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	gvk := schema.GroupVersionKind{
+		Group:   gvr.Group,
+		Version: gvr.Version,
+		Kind:    quotav1.VirtualMachineResourceQuotaKind,
+	}
+
+	if resp := webhookutils.ValidateSchema(gvk, ar.Request.Object.Raw); resp != nil {
+		return resp
+	}
+
+	quota := quotav1.VirtualMachineResourceQuota{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, &quota); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	if causes := ValidateVMResourceQuotaSpec(k8sfield.NewPath("spec"), &quota.Spec); len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	return &admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+}
+
+func ValidateVMResourceQuotaSpec(field *k8sfield.Path, spec *quotav1.VirtualMachineResourceQuotaSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if len(spec.Hard) == 0 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "at least one hard limit must be set.",
+			Field:   field.Child("hard").String(),
+		}}
+	}
+
+	for _, name := range sortedResourceNames(spec.Hard) {
+		quantity := spec.Hard[name]
+		nameField := field.Child("hard").Key(string(name))
+		if !slices.Contains(vmquota.Resources, name) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("unsupported resource %s, supported resources are %v.", name, vmquota.Resources),
+				Field:   nameField.String(),
+			})
+			continue
+		}
+		if quantity.Sign() < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be negative.", name),
+				Field:   nameField.String(),
+			})
+		} else if name != quotav1.ResourceGuestMemory && quantity.MilliValue()%1000 != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be a whole number.", name),
+				Field:   nameField.String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func sortedResourceNames(list k8sv1.ResourceList) []k8sv1.ResourceName {
+	names := make([]k8sv1.ResourceName, 0, len(list))
+	for name := range list {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
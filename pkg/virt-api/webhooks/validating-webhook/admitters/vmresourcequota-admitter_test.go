@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	quotav1 "kubevirt.io/api/quota/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+var _ = Describe("Validating VirtualMachineResourceQuota Admitter", func() {
+	admitter := &VMResourceQuotaAdmitter{}
+
+	admit := func(hard k8sv1.ResourceList) *admissionv1.AdmissionResponse {
+		quota := &quotav1.VirtualMachineResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "quota",
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: quotav1.VirtualMachineResourceQuotaSpec{Hard: hard},
+		}
+		quotaBytes, err := json.Marshal(quota)
+		Expect(err).ToNot(HaveOccurred())
+
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Resource: webhooks.VirtualMachineResourceQuotaGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: quotaBytes,
+				},
+			},
+		}
+		return admitter.Admit(context.Background(), ar)
+	}
+
+	It("should reject an unexpected resource", func() {
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Resource: webhooks.VirtualMachinePoolGroupVersionResource,
+			},
+		}
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("expect resource"))
+	})
+
+	It("should accept limits on the guest vCPUs, memory and VM count", func() {
+		resp := admit(k8sv1.ResourceList{
+			quotav1.ResourceGuestCPU:        resource.MustParse("16"),
+			quotav1.ResourceGuestMemory:     resource.MustParse("64Gi"),
+			quotav1.ResourceVirtualMachines: resource.MustParse("10"),
+		})
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	DescribeTable("should reject", func(hard k8sv1.ResourceList, expectedField string) {
+		resp := admit(hard)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(ConsistOf(HaveField("Field", expectedField)))
+	},
+		Entry("a quota without limits", k8sv1.ResourceList{}, "spec.hard"),
+		Entry("a pod resource", k8sv1.ResourceList{
+			k8sv1.ResourceRequestsCPU: resource.MustParse("4"),
+		}, "spec.hard[requests.cpu]"),
+		Entry("a negative limit", k8sv1.ResourceList{
+			quotav1.ResourceGuestMemory: resource.MustParse("-1Gi"),
+		}, "spec.hard[guest.memory]"),
+		Entry("a fractional vCPU count", k8sv1.ResourceList{
+			quotav1.ResourceGuestCPU: resource.MustParse("1500m"),
+		}, "spec.hard[guest.cpu]"),
+	)
+})
@@ -23,18 +23,26 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
 
 	v1 "kubevirt.io/api/core/v1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	quotav1 "kubevirt.io/api/quota/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/admissionpolicy"
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/defaults"
 	"kubevirt.io/kubevirt/pkg/instancetype/conflict"
@@ -48,6 +56,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	"kubevirt.io/kubevirt/pkg/vmquota"
 )
 
 var validRunStrategies = []v1.VirtualMachineRunStrategy{v1.RunStrategyHalted, v1.RunStrategyManual, v1.RunStrategyAlways, v1.RunStrategyRerunOnFailure, v1.RunStrategyOnce}
@@ -68,6 +77,7 @@ type VMsAdmitter struct {
 	VirtClient              kubecli.KubevirtClient
 	DataSourceInformer      cache.SharedIndexInformer
 	NamespaceInformer       cache.SharedIndexInformer
+	ResourceQuotaInformer   cache.SharedIndexInformer
 	InstancetypeAdmitter    instancetypeVMsAdmitter
 	ClusterConfig           *virtconfig.ClusterConfig
 	KubeVirtServiceAccounts map[string]struct{}
@@ -78,6 +88,7 @@ func NewVMsAdmitter(clusterConfig *virtconfig.ClusterConfig, client kubecli.Kube
 		VirtClient:              client,
 		DataSourceInformer:      informers.DataSourceInformer,
 		NamespaceInformer:       informers.NamespaceInformer,
+		ResourceQuotaInformer:   informers.VMResourceQuotaInformer,
 		InstancetypeAdmitter:    instancetypeWebhooks.NewAdmitter(client),
 		ClusterConfig:           clusterConfig,
 		KubeVirtServiceAccounts: kubeVirtServiceAccounts,
@@ -167,12 +178,41 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	if ar.Request.Operation == admissionv1.Update {
+		causes, err = admitter.validateGPUHotplugCapacity(ctx, vmCopy)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		} else if len(causes) > 0 {
+			return webhookutils.ToAdmissionResponse(causes)
+		}
+	}
+
+	var policyWarnings []string
+	if !isKubeVirtServiceAccount {
+		causes, policyWarnings, err = admitter.admitPolicy(ar, &vm)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		} else if len(causes) > 0 {
+			return webhookutils.ToAdmissionResponse(causes)
+		}
+	}
+
 	isDryRun := ar.Request.DryRun != nil && *ar.Request.DryRun
+	if !isKubeVirtServiceAccount {
+		causes, err = admitter.admitResourceQuotas(ctx, ar, vmquota.Usage(&vm, instancetypeSpec), isDryRun)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		} else if len(causes) > 0 {
+			return webhookutils.ToAdmissionResponse(causes)
+		}
+	}
+
 	if !isDryRun && ar.Request.Operation == admissionv1.Create {
 		metrics.NewVMCreated(&vm)
 	}
 
-	warnings := warnDeprecatedAPIs(&vm.Spec.Template.Spec, admitter.ClusterConfig)
+	warnings := WarnVirtualMachineInstanceSpec(k8sfield.NewPath("spec", "template", "spec"), &vm.Spec.Template.Spec, admitter.ClusterConfig)
+	warnings = append(warnings, policyWarnings...)
 	if vm.Spec.Running != nil {
 		warnings = append(warnings, "spec.running is deprecated, please use spec.runStrategy instead.")
 	}
@@ -183,6 +223,105 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 	}
 }
 
+// admitPolicy evaluates the admission policy rules of the cluster against the VM as submitted by the client, before
+// its instancetype and preference are applied. The updates of the KubeVirt controllers are not evaluated, so that
+// a rule added later does not block the reconciliation of the existing VMs.
+func (admitter *VMsAdmitter) admitPolicy(ar *admissionv1.AdmissionReview, vm *v1.VirtualMachine) ([]metav1.StatusCause, []string, error) {
+	var oldVM runtime.Object
+	if ar.Request.Operation == admissionv1.Update {
+		old := &v1.VirtualMachine{}
+		if err := json.Unmarshal(ar.Request.OldObject.Raw, old); err != nil {
+			return nil, nil, err
+		}
+		oldVM = old
+	}
+	causes, warnings := admissionpolicy.Evaluate(admitter.ClusterConfig.GetAdmissionPolicy(), admissionpolicy.VirtualMachines, vm, oldVM)
+	return causes, warnings, nil
+}
+
+// admitResourceQuotas denies the VM when it takes a VirtualMachineResourceQuota of its namespace above one of its
+// hard limits, and charges the increase in the usage of the quotas otherwise. Charging on admission keeps concurrent
+// requests from all fitting into the same remaining quota, the controller later recomputes the exact usage.
+func (admitter *VMsAdmitter) admitResourceQuotas(ctx context.Context, ar *admissionv1.AdmissionReview, usage k8sv1.ResourceList, isDryRun bool) ([]metav1.StatusCause, error) {
+	if admitter.ResourceQuotaInformer == nil {
+		return nil, nil
+	}
+	objs, err := admitter.ResourceQuotaInformer.GetIndexer().ByIndex(cache.NamespaceIndex, ar.Request.Namespace)
+	if err != nil || len(objs) == 0 {
+		return nil, err
+	}
+
+	delta := usage
+	if ar.Request.Operation == admissionv1.Update {
+		oldVM := &v1.VirtualMachine{}
+		if err := json.Unmarshal(ar.Request.OldObject.Raw, oldVM); err != nil {
+			return nil, err
+		}
+		oldInstancetypeSpec, _, _ := admitter.InstancetypeAdmitter.ApplyToVM(oldVM)
+		delta = vmquota.Subtract(usage, vmquota.Usage(oldVM, oldInstancetypeSpec))
+	}
+
+	var quotas []*quotav1.VirtualMachineResourceQuota
+	for _, obj := range objs {
+		quota := obj.(*quotav1.VirtualMachineResourceQuota)
+		if len(vmquota.Increase(quota.Spec.Hard, delta)) == 0 {
+			continue
+		}
+		if causes := checkResourceQuota(quota, delta); len(causes) > 0 {
+			return causes, nil
+		}
+		quotas = append(quotas, quota)
+	}
+
+	if isDryRun {
+		return nil, nil
+	}
+
+	// The cached usage can lag behind the charges of the other virt-api instances,
+	// so the quotas are checked again against their latest version when charged
+	for _, cached := range quotas {
+		var causes []metav1.StatusCause
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			quota, err := admitter.VirtClient.VirtualMachineResourceQuota(cached.Namespace).Get(ctx, cached.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			if causes = checkResourceQuota(quota, delta); len(causes) > 0 {
+				return nil
+			}
+			quota.Status.Used = vmquota.Add(quota.Status.Used, vmquota.Increase(quota.Spec.Hard, delta))
+			_, err = admitter.VirtClient.VirtualMachineResourceQuota(quota.Namespace).UpdateStatus(ctx, quota, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil || len(causes) > 0 {
+			return causes, err
+		}
+	}
+
+	return nil, nil
+}
+
+func checkResourceQuota(quota *quotav1.VirtualMachineResourceQuota, delta k8sv1.ResourceList) []metav1.StatusCause {
+	if quota.Status.Hard == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("the usage of VirtualMachineResourceQuota %s is not computed yet, try again later.", quota.Name),
+		}}
+	}
+
+	increase := vmquota.Increase(quota.Spec.Hard, delta)
+	var causes []metav1.StatusCause
+	for _, name := range vmquota.Exceeded(quota.Spec.Hard, quota.Status.Used, increase) {
+		requested, used, hard := increase[name], quota.Status.Used[name], quota.Spec.Hard[name]
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("exceeded VirtualMachineResourceQuota %s: requested %s=%s, used %s=%s, limited %s=%s.",
+				quota.Name, name, requested.String(), name, used.String(), name, hard.String()),
+		})
+	}
+	return causes
+}
+
 func (admitter *VMsAdmitter) AdmitStatus(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
 	vm, _, err := webhookutils.GetVMFromAdmissionReview(ar)
 	if err != nil {
@@ -224,6 +363,81 @@ func ValidateVirtualMachineSpec(field *k8sfield.Path, spec *v1.VirtualMachineSpe
 	causes = append(causes, validateRunStrategy(field, spec, config)...)
 	causes = append(causes, validateLiveUpdateFeatures(field, spec, config)...)
 
+	if spec.PowerSchedule != nil {
+		causes = append(causes, validatePowerSchedule(field.Child("powerSchedule"), spec.PowerSchedule)...)
+	}
+
+	if spec.HighAvailability != nil {
+		causes = append(causes, validateHighAvailability(field.Child("highAvailability"), spec.HighAvailability, config)...)
+	}
+
+	return causes
+}
+
+func validateHighAvailability(field *k8sfield.Path, policy *v1.HighAvailabilityPolicy, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	if policy.FailoverTimeout != nil && policy.FailoverTimeout.Duration <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "failoverTimeout must be positive",
+			Field:   field.Child("failoverTimeout").String(),
+		})
+	}
+	if policy.Fencing != nil && *policy.Fencing == v1.HighAvailabilityFencingNone && !config.UnfencedFailoverEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("fencing %s is not allowed, %s feature gate is not enabled in kubevirt resource", v1.HighAvailabilityFencingNone, featuregate.UnfencedFailoverGate),
+			Field:   field.Child("fencing").String(),
+		})
+	}
+	return causes
+}
+
+func validatePowerSchedule(field *k8sfield.Path, schedule *v1.VirtualMachinePowerSchedule) (causes []metav1.StatusCause) {
+	if schedule.Start == "" && schedule.Stop == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "at least one of start and stop is required",
+			Field:   field.String(),
+		})
+	}
+
+	causes = append(causes, validatePowerScheduleExpression(field.Child("start"), schedule.Start)...)
+	causes = append(causes, validatePowerScheduleExpression(field.Child("stop"), schedule.Stop)...)
+
+	if schedule.TimeZone != "" {
+		if _, err := time.LoadLocation(schedule.TimeZone); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("unknown time zone %s", schedule.TimeZone),
+				Field:   field.Child("timeZone").String(),
+			})
+		}
+	}
+
+	for i, date := range schedule.Exceptions {
+		if _, err := time.Parse(time.DateOnly, date); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid date %q, must be in the YYYY-MM-DD format", date),
+				Field:   field.Child("exceptions").Index(i).String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validatePowerScheduleExpression(field *k8sfield.Path, expression string) (causes []metav1.StatusCause) {
+	if expression == "" {
+		return causes
+	}
+	if _, err := cron.ParseStandard(expression); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid cron expression %q: %v", expression, err),
+			Field:   field.String(),
+		})
+	}
 	return causes
 }
 
@@ -309,6 +523,76 @@ func validateLiveUpdateCPU(field *k8sfield.Path, domain *v1.DomainSpec) (causes
 	return causes
 }
 
+// validateGPUHotplugCapacity rejects GPUs added to a running VM when no node is able to
+// allocate them, since the VMI has to migrate to a node providing the new GPUs.
+func (admitter *VMsAdmitter) validateGPUHotplugCapacity(ctx context.Context, vm *v1.VirtualMachine) ([]metav1.StatusCause, error) {
+	replugHostDevices := admitter.ClusterConfig.GetMigrationConfiguration().ReplugHostDevices
+	if !vm.Status.Ready || !admitter.ClusterConfig.IsVMRolloutStrategyLiveUpdate() ||
+		replugHostDevices == nil || !*replugHostDevices {
+		return nil, nil
+	}
+
+	vmi, err := admitter.VirtClient.VirtualMachineInstance(vm.Namespace).Get(ctx, vm.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	allocatedGPUs := countGPUsByResourceName(vmi.Spec.Domain.Devices.GPUs)
+	var addedResources []string
+	requestedGPUs := countGPUsByResourceName(vm.Spec.Template.Spec.Domain.Devices.GPUs)
+	for resourceName, count := range requestedGPUs {
+		if count > allocatedGPUs[resourceName] {
+			addedResources = append(addedResources, resourceName)
+		}
+	}
+	if len(addedResources) == 0 {
+		return nil, nil
+	}
+
+	nodes, err := admitter.VirtClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var causes []metav1.StatusCause
+	for _, resourceName := range addedResources {
+		if !nodesHaveCapacity(nodes.Items, resourceName, requestedGPUs[resourceName]) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("no node has the capacity to allocate %d GPUs of resource %s", requestedGPUs[resourceName], resourceName),
+				Field:   k8sfield.NewPath("spec", "template", "spec", "domain", "devices", "gpus").String(),
+			})
+		}
+	}
+	return causes, nil
+}
+
+func countGPUsByResourceName(gpus []v1.GPU) map[string]int64 {
+	counts := map[string]int64{}
+	for _, gpu := range gpus {
+		if gpu.DeviceName != "" {
+			counts[gpu.DeviceName]++
+		} else if gpu.Profile != "" {
+			counts[virtconfig.MediatedDeviceProfileResourceName(gpu.Profile)]++
+		}
+	}
+	return counts
+}
+
+func nodesHaveCapacity(nodes []k8sv1.Node, resourceName string, count int64) bool {
+	for _, node := range nodes {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		if allocatable, exists := node.Status.Allocatable[k8sv1.ResourceName(resourceName)]; exists && allocatable.Value() >= count {
+			return true
+		}
+	}
+	return false
+}
+
 func (admitter *VMsAdmitter) validateVolumeRequests(ctx context.Context, vm *v1.VirtualMachine) ([]metav1.StatusCause, error) {
 	if len(vm.Status.VolumeRequests) == 0 {
 		return nil, nil
@@ -368,14 +652,26 @@ func (admitter *VMsAdmitter) validateVolumeRequests(ctx context.Context, vm *v1.
 				}}, nil
 			}
 
-			// Validate the disk is configured properly
-			invalidDiskStatusCause := storageadmitters.ValidateHotplugDiskConfiguration(
-				volumeRequest.AddVolumeOptions.Disk, name,
-				"AddVolume request",
-				k8sfield.NewPath("Status", "volumeRequests").String(),
-			)
-			if invalidDiskStatusCause != nil {
-				return invalidDiskStatusCause, nil
+			if volumeRequest.AddVolumeOptions.Filesystem != nil {
+				// Validate the filesystem is configured properly
+				invalidFilesystemStatusCause := storageadmitters.ValidateHotplugFilesystemConfiguration(
+					volumeRequest.AddVolumeOptions.Disk, volumeRequest.AddVolumeOptions.Filesystem, name,
+					"AddVolume request",
+					k8sfield.NewPath("Status", "volumeRequests").String(),
+				)
+				if invalidFilesystemStatusCause != nil {
+					return invalidFilesystemStatusCause, nil
+				}
+			} else {
+				// Validate the disk is configured properly
+				invalidDiskStatusCause := storageadmitters.ValidateHotplugDiskConfiguration(
+					volumeRequest.AddVolumeOptions.Disk, name,
+					"AddVolume request",
+					k8sfield.NewPath("Status", "volumeRequests").String(),
+				)
+				if invalidDiskStatusCause != nil {
+					return invalidDiskStatusCause, nil
+				}
 			}
 
 			newVolume := v1.Volume{
@@ -26,12 +26,14 @@ import (
 	"fmt"
 	rt "runtime"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authv1 "k8s.io/api/authentication/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -46,8 +48,10 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	quotav1 "kubevirt.io/api/quota/v1alpha1"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
+	"kubevirt.io/kubevirt/pkg/admissionpolicy"
 	instancetypeWebhooks "kubevirt.io/kubevirt/pkg/instancetype/webhooks/vm"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
@@ -1526,6 +1530,80 @@ var _ = Describe("Validating VM Admitter", func() {
 			)
 		})
 
+		Context("GPUs", func() {
+			const gpuResourceName = "nvidia.com/TU104GL_Tesla_T4"
+
+			newNode := func(name string, allocatable int64) *k8sv1.Node {
+				return &k8sv1.Node{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Status: k8sv1.NodeStatus{
+						Allocatable: k8sv1.ResourceList{
+							gpuResourceName: *resource.NewQuantity(allocatable, resource.DecimalSI),
+						},
+					},
+				}
+			}
+
+			admitVMUpdate := func(vm *v1.VirtualMachine) *admissionv1.AdmissionResponse {
+				vmBytes, err := json.Marshal(vm)
+				Expect(err).ToNot(HaveOccurred())
+				return vmsAdmitter.Admit(context.Background(), &admissionv1.AdmissionReview{
+					Request: &admissionv1.AdmissionRequest{
+						Resource:  webhooks.VirtualMachineGroupVersionResource,
+						Object:    runtime.RawExtension{Raw: vmBytes},
+						OldObject: runtime.RawExtension{Raw: vmBytes},
+						Operation: admissionv1.Update,
+					},
+				})
+			}
+
+			BeforeEach(func() {
+				kv := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+				kv.Spec.Configuration.MigrationConfiguration = &v1.MigrationConfiguration{
+					ReplugHostDevices: pointer.P(true),
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+
+				vm.Status.Ready = true
+				vm.Spec.Template.Spec.Domain.Devices.GPUs = []v1.GPU{
+					{Name: "gpu1", DeviceName: gpuResourceName},
+					{Name: "gpu2", DeviceName: gpuResourceName},
+				}
+
+				vmi := api.NewMinimalVMI("testvmi")
+				vmi.Spec.Domain.Devices.GPUs = []v1.GPU{{Name: "gpu1", DeviceName: gpuResourceName}}
+				virtClient.EXPECT().VirtualMachineInstance(gomock.Any()).Return(mockVMIClient)
+				mockVMIClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(vmi, nil)
+				virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+			})
+
+			AfterEach(func() {
+				kv := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+				kv.Spec.Configuration.MigrationConfiguration = nil
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+			})
+
+			It("should accept hotplugged GPUs when a node is able to allocate them", func() {
+				_, err := k8sClient.CoreV1().Nodes().Create(context.Background(), newNode("node01", 1), metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				_, err = k8sClient.CoreV1().Nodes().Create(context.Background(), newNode("node02", 2), metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(admitVMUpdate(vm).Allowed).To(BeTrue())
+			})
+
+			It("should reject hotplugged GPUs when no node is able to allocate them", func() {
+				_, err := k8sClient.CoreV1().Nodes().Create(context.Background(), newNode("node01", 1), metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				response := admitVMUpdate(vm)
+				Expect(response.Allowed).To(BeFalse())
+				Expect(response.Result.Details.Causes).To(HaveLen(1))
+				Expect(response.Result.Details.Causes[0].Field).To(Equal("spec.template.spec.domain.devices.gpus"))
+				Expect(response.Result.Details.Causes[0].Message).To(Equal("no node has the capacity to allocate 2 GPUs of resource " + gpuResourceName))
+			})
+		})
+
 	})
 
 	It("should raise a warning when Deprecated API is used", func() {
@@ -1616,6 +1694,297 @@ var _ = Describe("Validating VM Admitter", func() {
 			Entry("reject invalid runstrategy", v1.VirtualMachineRunStrategy("invalid"), "", false),
 		)
 	})
+
+	Context("power schedule", func() {
+		DescribeTable("validate should", func(schedule *v1.VirtualMachinePowerSchedule, expectedFields ...string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vm := &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy:   pointer.P(v1.RunStrategyHalted),
+					PowerSchedule: schedule,
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: vmi.Spec,
+					},
+				},
+			}
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(Equal(len(expectedFields) == 0))
+			var fields []string
+			if resp.Result != nil {
+				for _, cause := range resp.Result.Details.Causes {
+					fields = append(fields, cause.Field)
+				}
+			}
+			Expect(fields).To(ConsistOf(expectedFields))
+		},
+			Entry("accept a schedule with start and stop",
+				&v1.VirtualMachinePowerSchedule{
+					Start:      "0 8 * * 1-5",
+					Stop:       "0 19 * * 1-5",
+					TimeZone:   "Europe/Berlin",
+					Exceptions: []string{"2026-12-24", "2026-12-25"},
+				}),
+			Entry("accept a schedule with only a stop",
+				&v1.VirtualMachinePowerSchedule{Stop: "0 19 * * *"}),
+			Entry("reject a schedule without start and stop",
+				&v1.VirtualMachinePowerSchedule{TimeZone: "UTC"},
+				"spec.powerSchedule"),
+			Entry("reject invalid cron expressions",
+				&v1.VirtualMachinePowerSchedule{Start: "every morning", Stop: "0 25 * * *"},
+				"spec.powerSchedule.start", "spec.powerSchedule.stop"),
+			Entry("reject an unknown time zone",
+				&v1.VirtualMachinePowerSchedule{Stop: "0 19 * * *", TimeZone: "Mars/Olympus"},
+				"spec.powerSchedule.timeZone"),
+			Entry("reject invalid exception dates",
+				&v1.VirtualMachinePowerSchedule{Stop: "0 19 * * *", Exceptions: []string{"2026-12-24", "24.12.2026"}},
+				"spec.powerSchedule.exceptions[1]"),
+		)
+	})
+
+	Context("high availability", func() {
+		DescribeTable("validate should", func(policy *v1.HighAvailabilityPolicy, expectedFields ...string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vm := &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy:      pointer.P(v1.RunStrategyAlways),
+					HighAvailability: policy,
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: vmi.Spec,
+					},
+				},
+			}
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(Equal(len(expectedFields) == 0))
+			var fields []string
+			if resp.Result != nil {
+				for _, cause := range resp.Result.Details.Causes {
+					fields = append(fields, cause.Field)
+				}
+			}
+			Expect(fields).To(ConsistOf(expectedFields))
+		},
+			Entry("accept an empty policy", &v1.HighAvailabilityPolicy{}),
+			Entry("accept a policy with timeout and fencing",
+				&v1.HighAvailabilityPolicy{
+					FailoverTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+					Fencing:         pointer.P(v1.HighAvailabilityFencingOutOfServiceTaint),
+				}),
+			Entry("reject a zero timeout",
+				&v1.HighAvailabilityPolicy{FailoverTimeout: &metav1.Duration{}},
+				"spec.highAvailability.failoverTimeout"),
+			Entry("reject a negative timeout",
+				&v1.HighAvailabilityPolicy{FailoverTimeout: &metav1.Duration{Duration: -time.Minute}},
+				"spec.highAvailability.failoverTimeout"),
+			Entry("reject failing over without fencing",
+				&v1.HighAvailabilityPolicy{Fencing: pointer.P(v1.HighAvailabilityFencingNone)},
+				"spec.highAvailability.fencing"),
+		)
+
+		It("should accept failing over without fencing with the UnfencedFailover feature gate", func() {
+			enableFeatureGate(featuregate.UnfencedFailoverGate)
+			defer disableFeatureGates()
+
+			vmi := api.NewMinimalVMI("testvmi")
+			vm := &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy:      pointer.P(v1.RunStrategyAlways),
+					HighAvailability: &v1.HighAvailabilityPolicy{Fencing: pointer.P(v1.HighAvailabilityFencingNone)},
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: vmi.Spec,
+					},
+				},
+			}
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+	})
+
+	Context("admission policy", func() {
+		setAdmissionPolicy := func(mode v1.AdmissionPolicyMode) {
+			kv := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kv.Spec.Configuration.AdmissionPolicy = &v1.AdmissionPolicyConfiguration{
+				Rules: []v1.AdmissionPolicyRule{{
+					Name:       "preference",
+					Resources:  []string{admissionpolicy.VirtualMachines},
+					Expression: "has(object.spec.preference)",
+					Message:    "all VMs must set a preference",
+					Mode:       mode,
+				}},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+		}
+
+		AfterEach(func() {
+			kv := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kv.Spec.Configuration.AdmissionPolicy = nil
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+		})
+
+		It("should reject a VM which does not satisfy an enforced rule", func() {
+			setAdmissionPolicy(v1.AdmissionPolicyEnforce)
+			resp := admitVm(vmsAdmitter, libvmi.NewVirtualMachine(libvmi.New()))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ConsistOf(HaveField("Message", "all VMs must set a preference")))
+
+			resp = admitVm(vmsAdmitter, libvmi.NewVirtualMachine(libvmi.New(), libvmi.WithPreference("fedora")))
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should warn about a VM which does not satisfy a rule in the Warn mode", func() {
+			setAdmissionPolicy(v1.AdmissionPolicyWarn)
+			resp := admitVm(vmsAdmitter, libvmi.NewVirtualMachine(libvmi.New()))
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(resp.Warnings).To(ContainElement("all VMs must set a preference"))
+		})
+
+		It("should not evaluate the requests of the KubeVirt service accounts", func() {
+			setAdmissionPolicy(v1.AdmissionPolicyEnforce)
+			vmBytes, err := json.Marshal(libvmi.NewVirtualMachine(libvmi.New()))
+			Expect(err).ToNot(HaveOccurred())
+			resp := vmsAdmitter.Admit(context.Background(), &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource:  webhooks.VirtualMachineGroupVersionResource,
+					Object:    runtime.RawExtension{Raw: vmBytes},
+					Operation: admissionv1.Create,
+					UserInfo:  authv1.UserInfo{Username: "system:serviceaccount:kubevirt:kubevirt-controller"},
+				},
+			})
+			Expect(resp.Allowed).To(BeTrue())
+		})
+	})
+
+	Context("resource quota", func() {
+		const namespace = "quota-ns"
+
+		var quotaClient *fakeclientset.Clientset
+
+		newQuota := func(hard, used k8sv1.ResourceList) *quotav1.VirtualMachineResourceQuota {
+			return &quotav1.VirtualMachineResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: namespace},
+				Spec:       quotav1.VirtualMachineResourceQuotaSpec{Hard: hard},
+				Status:     quotav1.VirtualMachineResourceQuotaStatus{Hard: hard, Used: used},
+			}
+		}
+
+		addQuota := func(quota *quotav1.VirtualMachineResourceQuota) {
+			Expect(vmsAdmitter.ResourceQuotaInformer.GetStore().Add(quota)).To(Succeed())
+			_, err := quotaClient.QuotaV1alpha1().VirtualMachineResourceQuotas(namespace).Create(context.Background(), quota, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		getUsed := func() k8sv1.ResourceList {
+			quota, err := quotaClient.QuotaV1alpha1().VirtualMachineResourceQuotas(namespace).Get(context.Background(), "quota", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return quota.Status.Used
+		}
+
+		expectUsed := func(name k8sv1.ResourceName, expected string) {
+			used := getUsed()[name]
+			Expect(used.Cmp(resource.MustParse(expected))).To(BeZero(), "%s is %s", name, used.String())
+		}
+
+		admit := func(operation admissionv1.Operation, vm, oldVM *v1.VirtualMachine, dryRun bool) *admissionv1.AdmissionResponse {
+			vmBytes, err := json.Marshal(vm)
+			Expect(err).ToNot(HaveOccurred())
+			request := &admissionv1.AdmissionRequest{
+				Resource:  webhooks.VirtualMachineGroupVersionResource,
+				Namespace: namespace,
+				Object:    runtime.RawExtension{Raw: vmBytes},
+				Operation: operation,
+				DryRun:    pointer.P(dryRun),
+			}
+			if oldVM != nil {
+				oldVMBytes, err := json.Marshal(oldVM)
+				Expect(err).ToNot(HaveOccurred())
+				request.OldObject = runtime.RawExtension{Raw: oldVMBytes}
+			}
+			return vmsAdmitter.Admit(context.Background(), &admissionv1.AdmissionReview{Request: request})
+		}
+
+		newVM := func(cores uint32) *v1.VirtualMachine {
+			return libvmi.NewVirtualMachine(libvmi.New(
+				libvmi.WithNamespace(namespace),
+				libvmi.WithCPUCount(cores, 1, 1),
+				libvmi.WithGuestMemory("1Gi"),
+			))
+		}
+
+		BeforeEach(func() {
+			vmsAdmitter.ResourceQuotaInformer, _ = testutils.NewFakeInformerFor(&quotav1.VirtualMachineResourceQuota{})
+			quotaClient = fakeclientset.NewSimpleClientset()
+			virtClient.EXPECT().VirtualMachineResourceQuota(namespace).Return(quotaClient.QuotaV1alpha1().VirtualMachineResourceQuotas(namespace)).AnyTimes()
+		})
+
+		It("should admit a VM within the quota and charge its usage", func() {
+			addQuota(newQuota(k8sv1.ResourceList{
+				quotav1.ResourceGuestCPU:        resource.MustParse("4"),
+				quotav1.ResourceVirtualMachines: resource.MustParse("2"),
+			}, k8sv1.ResourceList{
+				quotav1.ResourceGuestCPU:        resource.MustParse("1"),
+				quotav1.ResourceVirtualMachines: resource.MustParse("1"),
+			}))
+
+			resp := admit(admissionv1.Create, newVM(2), nil, false)
+			Expect(resp.Allowed).To(BeTrue())
+
+			Expect(getUsed()).To(HaveLen(2))
+			expectUsed(quotav1.ResourceGuestCPU, "3")
+			expectUsed(quotav1.ResourceVirtualMachines, "2")
+		})
+
+		It("should reject a VM going above the quota", func() {
+			addQuota(newQuota(k8sv1.ResourceList{
+				quotav1.ResourceGuestMemory: resource.MustParse("2Gi"),
+			}, k8sv1.ResourceList{
+				quotav1.ResourceGuestMemory: resource.MustParse("1536Mi"),
+			}))
+
+			resp := admit(admissionv1.Create, newVM(1), nil, false)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ConsistOf(HaveField("Message",
+				"exceeded VirtualMachineResourceQuota quota: requested guest.memory=1Gi, used guest.memory=1536Mi, limited guest.memory=2Gi.")))
+			expectUsed(quotav1.ResourceGuestMemory, "1536Mi")
+		})
+
+		It("should not charge the usage of a dry run", func() {
+			addQuota(newQuota(k8sv1.ResourceList{
+				quotav1.ResourceVirtualMachines: resource.MustParse("2"),
+			}, k8sv1.ResourceList{}))
+
+			resp := admit(admissionv1.Create, newVM(1), nil, true)
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(getUsed()).To(BeEmpty())
+		})
+
+		It("should only consider the increase of an update", func() {
+			addQuota(newQuota(k8sv1.ResourceList{
+				quotav1.ResourceGuestCPU:        resource.MustParse("4"),
+				quotav1.ResourceVirtualMachines: resource.MustParse("1"),
+			}, k8sv1.ResourceList{
+				quotav1.ResourceGuestCPU:        resource.MustParse("2"),
+				quotav1.ResourceVirtualMachines: resource.MustParse("1"),
+			}))
+
+			resp := admit(admissionv1.Update, newVM(4), newVM(2), false)
+			Expect(resp.Allowed).To(BeTrue())
+			expectUsed(quotav1.ResourceGuestCPU, "4")
+
+			resp = admit(admissionv1.Update, newVM(5), newVM(4), false)
+			Expect(resp.Allowed).To(BeFalse())
+		})
+
+		It("should reject a VM while the usage of the quota is not computed", func() {
+			quota := newQuota(k8sv1.ResourceList{
+				quotav1.ResourceVirtualMachines: resource.MustParse("2"),
+			}, nil)
+			quota.Status = quotav1.VirtualMachineResourceQuotaStatus{}
+			addQuota(quota)
+
+			resp := admit(admissionv1.Create, newVM(1), nil, false)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ConsistOf(HaveField("Message", ContainSubstring("not computed yet"))))
+		})
+	})
 })
 
 func admitVm(admitter *VMsAdmitter, vm *v1.VirtualMachine) *admissionv1.AdmissionResponse {
@@ -0,0 +1,131 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	"kubevirt.io/api/migrations"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+)
+
+// VolumeMigrationAdmitter validates VolumeMigrations
+type VolumeMigrationAdmitter struct {
+}
+
+// NewVolumeMigrationAdmitter creates a VolumeMigrationAdmitter
+func NewVolumeMigrationAdmitter() *VolumeMigrationAdmitter {
+	return &VolumeMigrationAdmitter{}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VolumeMigrationAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != migrationsv1.VolumeMigrationKind.Group ||
+		ar.Request.Resource.Resource != migrations.ResourceVolumeMigrations {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	volumeMigration := &migrationsv1.VolumeMigration{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, volumeMigration); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case admissionv1.Create:
+		causes = validateVolumeMigrationSpec(k8sfield.NewPath("spec"), &volumeMigration.Spec)
+	case admissionv1.Update:
+		oldVolumeMigration := &migrationsv1.VolumeMigration{}
+		if err := json.Unmarshal(ar.Request.OldObject.Raw, oldVolumeMigration); err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !equality.Semantic.DeepEqual(volumeMigration.Spec, oldVolumeMigration.Spec) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "spec is immutable after creation",
+				Field:   k8sfield.NewPath("spec").String(),
+			})
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func validateVolumeMigrationSpec(field *k8sfield.Path, spec *migrationsv1.VolumeMigrationSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.VirtualMachineName == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "virtual machine name is required",
+			Field:   field.Child("virtualMachineName").String(),
+		})
+	}
+
+	if spec.StorageClassName == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "storage class name is required",
+			Field:   field.Child("storageClassName").String(),
+		})
+	}
+
+	seen := map[string]struct{}{}
+	for i, volume := range spec.Volumes {
+		if volume == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "volume name must not be empty",
+				Field:   field.Child("volumes").Index(i).String(),
+			})
+			continue
+		}
+		if _, exists := seen[volume]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("volume %s is listed more than once", volume),
+				Field:   field.Child("volumes").Index(i).String(),
+			})
+		}
+		seen[volume] = struct{}{}
+	}
+
+	return causes
+}
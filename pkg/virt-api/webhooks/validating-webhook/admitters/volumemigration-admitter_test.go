@@ -0,0 +1,139 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"kubevirt.io/api/migrations"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+)
+
+var _ = Describe("Validating VolumeMigration Admitter", func() {
+	var admitter *VolumeMigrationAdmitter
+
+	BeforeEach(func() {
+		admitter = NewVolumeMigrationAdmitter()
+	})
+
+	newVolumeMigration := func(spec migrationsv1.VolumeMigrationSpec) *migrationsv1.VolumeMigration {
+		return &migrationsv1.VolumeMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-volume-migration",
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: spec,
+		}
+	}
+
+	DescribeTable("should reject volume migration with", func(spec migrationsv1.VolumeMigrationSpec, field string) {
+		ar := createVolumeMigrationAdmissionReview(admissionv1.Create, newVolumeMigration(spec), nil)
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		Entry("missing virtual machine name",
+			migrationsv1.VolumeMigrationSpec{StorageClassName: "fast"},
+			"spec.virtualMachineName",
+		),
+		Entry("missing storage class name",
+			migrationsv1.VolumeMigrationSpec{VirtualMachineName: "vm"},
+			"spec.storageClassName",
+		),
+		Entry("empty volume name",
+			migrationsv1.VolumeMigrationSpec{VirtualMachineName: "vm", StorageClassName: "fast", Volumes: []string{""}},
+			"spec.volumes[0]",
+		),
+		Entry("duplicate volume name",
+			migrationsv1.VolumeMigrationSpec{VirtualMachineName: "vm", StorageClassName: "fast", Volumes: []string{"disk0", "disk0"}},
+			"spec.volumes[1]",
+		),
+	)
+
+	DescribeTable("should accept volume migration with", func(spec migrationsv1.VolumeMigrationSpec) {
+		ar := createVolumeMigrationAdmissionReview(admissionv1.Create, newVolumeMigration(spec), nil)
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeTrue())
+	},
+		Entry("all volumes",
+			migrationsv1.VolumeMigrationSpec{VirtualMachineName: "vm", StorageClassName: "fast"},
+		),
+		Entry("selected volumes",
+			migrationsv1.VolumeMigrationSpec{VirtualMachineName: "vm", StorageClassName: "fast", Volumes: []string{"disk0", "disk1"}},
+		),
+	)
+
+	It("should reject a spec update", func() {
+		oldVolumeMigration := newVolumeMigration(migrationsv1.VolumeMigrationSpec{VirtualMachineName: "vm", StorageClassName: "fast"})
+		volumeMigration := oldVolumeMigration.DeepCopy()
+		volumeMigration.Spec.StorageClassName = "slow"
+
+		ar := createVolumeMigrationAdmissionReview(admissionv1.Update, volumeMigration, oldVolumeMigration)
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+	})
+
+	It("should accept a metadata update", func() {
+		oldVolumeMigration := newVolumeMigration(migrationsv1.VolumeMigrationSpec{VirtualMachineName: "vm", StorageClassName: "fast"})
+		volumeMigration := oldVolumeMigration.DeepCopy()
+		volumeMigration.Labels = map[string]string{"key": "value"}
+
+		ar := createVolumeMigrationAdmissionReview(admissionv1.Update, volumeMigration, oldVolumeMigration)
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+})
+
+func createVolumeMigrationAdmissionReview(operation admissionv1.Operation, volumeMigration, oldVolumeMigration *migrationsv1.VolumeMigration) *admissionv1.AdmissionReview {
+	volumeMigrationBytes, _ := json.Marshal(volumeMigration)
+
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: operation,
+			Namespace: volumeMigration.Namespace,
+			Resource: metav1.GroupVersionResource{
+				Group:    migrationsv1.VolumeMigrationKind.Group,
+				Resource: migrations.ResourceVolumeMigrations,
+			},
+			Object: runtime.RawExtension{
+				Raw: volumeMigrationBytes,
+			},
+		},
+	}
+
+	if oldVolumeMigration != nil {
+		oldVolumeMigrationBytes, _ := json.Marshal(oldVolumeMigration)
+		ar.Request.OldObject = runtime.RawExtension{
+			Raw: oldVolumeMigrationBytes,
+		}
+	}
+
+	return ar
+}
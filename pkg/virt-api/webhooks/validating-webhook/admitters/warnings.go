@@ -0,0 +1,177 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"fmt"
+
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/storage/reservation"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+// SpecWarner returns warnings about a VMI spec which is admitted, but uses deprecated fields or a configuration
+// which is known to cause issues. The warnings are shown to the client, e.g. by kubectl.
+type SpecWarner func(*k8sfield.Path, *v1.VirtualMachineInstanceSpec, *virtconfig.ClusterConfig) []string
+
+var specWarners = []SpecWarner{
+	warnDeprecatedFeatures,
+	warnGuestAgentDependencies,
+	warnNonMigratableConfiguration,
+	warnEmulatedDevices,
+}
+
+// WarnVirtualMachineInstanceSpec returns the warnings of all the spec warners about a valid VMI spec
+func WarnVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []string {
+	var warnings []string
+	for _, warn := range specWarners {
+		warnings = append(warnings, warn(field, spec, config)...)
+	}
+	return warnings
+}
+
+// fieldWarning formats a warning about a field the way the Kubernetes API server does
+func fieldWarning(field *k8sfield.Path, format string, args ...interface{}) string {
+	return fmt.Sprintf("%s: %s", field.String(), fmt.Sprintf(format, args...))
+}
+
+// warnDeprecatedAPIs returns the deprecation messages of the deprecated feature gates used by the spec
+func warnDeprecatedAPIs(spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []string {
+	var warnings []string
+	for _, fg := range config.GetConfig().DeveloperConfiguration.FeatureGates {
+		deprecatedFeature := featuregate.FeatureGateInfo(fg)
+		if deprecatedFeature != nil && deprecatedFeature.State == featuregate.Deprecated && deprecatedFeature.VmiSpecUsed != nil {
+			if used := deprecatedFeature.VmiSpecUsed(spec); used {
+				warnings = append(warnings, deprecatedFeature.Message)
+			}
+		}
+	}
+	return warnings
+}
+
+func warnDeprecatedFeatures(_ *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []string {
+	return warnDeprecatedAPIs(spec, config)
+}
+
+// warnGuestAgentDependencies warns about the features which silently do not work without the qemu guest agent
+// running in the guest
+func warnGuestAgentDependencies(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, _ *virtconfig.ClusterConfig) []string {
+	var warnings []string
+	for idx, accessCredential := range spec.AccessCredentials {
+		credentialField := field.Child("accessCredentials").Index(idx)
+		if accessCredential.SSHPublicKey != nil && accessCredential.SSHPublicKey.PropagationMethod.QemuGuestAgent != nil {
+			warnings = append(warnings, fieldWarning(credentialField.Child("sshPublicKey", "propagationMethod", "qemuGuestAgent"),
+				"the keys are only propagated when the qemu guest agent runs in the guest"))
+		}
+		if accessCredential.UserPassword != nil && accessCredential.UserPassword.PropagationMethod.QemuGuestAgent != nil {
+			warnings = append(warnings, fieldWarning(credentialField.Child("userPassword", "propagationMethod", "qemuGuestAgent"),
+				"the passwords are only propagated when the qemu guest agent runs in the guest"))
+		}
+	}
+	warnings = append(warnings, warnGuestAgentProbe(field.Child("readinessProbe"), spec.ReadinessProbe)...)
+	warnings = append(warnings, warnGuestAgentProbe(field.Child("livenessProbe"), spec.LivenessProbe)...)
+	return warnings
+}
+
+func warnGuestAgentProbe(field *k8sfield.Path, probe *v1.Probe) []string {
+	if probe == nil {
+		return nil
+	}
+	var warnings []string
+	if probe.GuestAgentPing != nil {
+		warnings = append(warnings, fieldWarning(field.Child("guestAgentPing"),
+			"the probe fails unless the qemu guest agent runs in the guest"))
+	}
+	if probe.Exec != nil {
+		warnings = append(warnings, fieldWarning(field.Child("exec"),
+			"the command is executed by the qemu guest agent, the probe fails unless it runs in the guest"))
+	}
+	return warnings
+}
+
+// warnNonMigratableConfiguration warns about the devices which prevent the live migration of a VMI with the
+// LiveMigrate eviction strategy, as its eviction is blocked and the drain of its node never completes
+func warnNonMigratableConfiguration(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []string {
+	evictionStrategy := config.GetConfig().EvictionStrategy
+	if spec.EvictionStrategy != nil {
+		evictionStrategy = spec.EvictionStrategy
+	}
+	if evictionStrategy == nil || *evictionStrategy != v1.EvictionStrategyLiveMigrate {
+		return nil
+	}
+
+	const message = "the VirtualMachineInstance can not be live migrated with %s, the LiveMigrate eviction strategy blocks the drain of its node"
+	var warnings []string
+	if replug := config.GetMigrationConfiguration().ReplugHostDevices; replug == nil || !*replug {
+		if len(spec.Domain.Devices.HostDevices) > 0 {
+			warnings = append(warnings, fieldWarning(field.Child("domain", "devices", "hostDevices"), message, "host devices"))
+		}
+		if len(spec.Domain.Devices.GPUs) > 0 {
+			warnings = append(warnings, fieldWarning(field.Child("domain", "devices", "gpus"), message, "GPUs"))
+		}
+	}
+	for idx, volume := range spec.Volumes {
+		if volume.HostDisk != nil {
+			warnings = append(warnings, fieldWarning(field.Child("volumes").Index(idx).Child("hostDisk"), message, "a host disk"))
+		}
+	}
+	if spec.Domain.LaunchSecurity != nil {
+		warnings = append(warnings, fieldWarning(field.Child("domain", "launchSecurity"), message, "launch security"))
+	}
+	if features := spec.Domain.Features; features != nil && features.HypervPassthrough != nil &&
+		features.HypervPassthrough.Enabled != nil && *features.HypervPassthrough.Enabled {
+		warnings = append(warnings, fieldWarning(field.Child("domain", "features", "hypervPassthrough"), message, "hyperv passthrough"))
+	}
+	if reservation.HasVMISpecPersistentReservation(spec) {
+		warnings = append(warnings, fieldWarning(field.Child("domain", "devices", "disks"), message, "a SCSI persistent reservation"))
+	}
+	return warnings
+}
+
+var emulatedInterfaceModels = map[string]struct{}{
+	"e1000":    {},
+	"e1000e":   {},
+	"ne2k_pci": {},
+	"pcnet":    {},
+	"rtl8139":  {},
+}
+
+// warnEmulatedDevices warns about the disks and interfaces using fully emulated devices, which are much slower
+// than their virtio counterparts
+func warnEmulatedDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, _ *virtconfig.ClusterConfig) []string {
+	var warnings []string
+	for idx, disk := range spec.Domain.Devices.Disks {
+		if disk.Disk != nil && disk.Disk.Bus == v1.DiskBusSATA {
+			warnings = append(warnings, fieldWarning(field.Child("domain", "devices", "disks").Index(idx).Child("disk", "bus"),
+				"the sata bus is emulated and slower than the virtio bus, use it only for guests without virtio drivers"))
+		}
+	}
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if _, emulated := emulatedInterfaceModels[iface.Model]; emulated {
+			warnings = append(warnings, fieldWarning(field.Child("domain", "devices", "interfaces").Index(idx).Child("model"),
+				"the %s model is emulated and slower than the virtio model, use it only for guests without virtio drivers", iface.Model))
+		}
+	}
+	return warnings
+}
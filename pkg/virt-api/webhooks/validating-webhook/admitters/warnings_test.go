@@ -0,0 +1,98 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Spec warnings", func() {
+	var config *virtconfig.ClusterConfig
+
+	BeforeEach(func() {
+		config, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+	})
+
+	warn := func(vmi *v1.VirtualMachineInstance) []string {
+		return WarnVirtualMachineInstanceSpec(k8sfield.NewPath("spec"), &vmi.Spec, config)
+	}
+
+	It("should not warn about a virtio VMI", func() {
+		vmi := libvmi.New(
+			libvmi.WithContainerDisk("disk", "image"),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		)
+		Expect(warn(vmi)).To(BeEmpty())
+	})
+
+	It("should warn about the features relying on the guest agent", func() {
+		vmi := libvmi.New(
+			libvmi.WithAccessCredentialSSHPublicKey("keys", "user"),
+			libvmi.WithAccessCredentialUserPassword("passwords"),
+		)
+		vmi.Spec.ReadinessProbe = &v1.Probe{Handler: v1.Handler{GuestAgentPing: &v1.GuestAgentPing{}}}
+		Expect(warn(vmi)).To(ConsistOf(
+			HavePrefix("spec.accessCredentials[0].sshPublicKey.propagationMethod.qemuGuestAgent: "),
+			HavePrefix("spec.accessCredentials[1].userPassword.propagationMethod.qemuGuestAgent: "),
+			HavePrefix("spec.readinessProbe.guestAgentPing: "),
+		))
+	})
+
+	It("should warn about the emulated devices", func() {
+		vmi := libvmi.New(
+			libvmi.WithContainerDisk("disk", "image"),
+			libvmi.WithContainerSATADisk("sata", "image"),
+			libvmi.WithInterface(v1.Interface{Name: "default", Model: "e1000"}),
+		)
+		Expect(warn(vmi)).To(ConsistOf(
+			HavePrefix("spec.domain.devices.disks[1].disk.bus: the sata bus"),
+			HavePrefix("spec.domain.devices.interfaces[0].model: the e1000 model"),
+		))
+	})
+
+	DescribeTable("should warn about a non migratable configuration", func(evictionStrategy v1.EvictionStrategy, replugHostDevices bool, expectWarnings bool) {
+		config, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			MigrationConfiguration: &v1.MigrationConfiguration{ReplugHostDevices: pointer.P(replugHostDevices)},
+		})
+		vmi := libvmi.New(libvmi.WithEvictionStrategy(evictionStrategy))
+		vmi.Spec.Domain.Devices.GPUs = []v1.GPU{{Name: "gpu", DeviceName: "nvidia.com/gpu"}}
+		if expectWarnings {
+			Expect(warn(vmi)).To(ConsistOf(HavePrefix("spec.domain.devices.gpus: the VirtualMachineInstance can not be live migrated")))
+		} else {
+			Expect(warn(vmi)).To(BeEmpty())
+		}
+	},
+		Entry("with the LiveMigrate eviction strategy", v1.EvictionStrategyLiveMigrate, false, true),
+		Entry("not when the host devices are replugged", v1.EvictionStrategyLiveMigrate, true, false),
+		Entry("not with the LiveMigrateIfPossible eviction strategy", v1.EvictionStrategyLiveMigrateIfPossible, false, false),
+		Entry("not without an eviction strategy", v1.EvictionStrategyNone, false, false),
+	)
+})
@@ -22,9 +22,14 @@ package validating_webhook
 import (
 	"net/http"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
 	preferencewebhooks "kubevirt.io/kubevirt/pkg/instancetype/preference/webhooks"
+	vmdefaultswebhooks "kubevirt.io/kubevirt/pkg/instancetype/vmdefaults/webhooks"
 	instancetypewebhooks "kubevirt.io/kubevirt/pkg/instancetype/webhooks"
 	storageadmitters "kubevirt.io/kubevirt/pkg/storage/admitters"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
@@ -70,6 +75,18 @@ func ServeVMPool(resp http.ResponseWriter, req *http.Request, clusterConfig *vir
 	validating_webhooks.Serve(resp, req, &admitters.VMPoolAdmitter{ClusterConfig: clusterConfig, KubeVirtServiceAccounts: kubeVirtServiceAccounts})
 }
 
+func ServeVMAutoscalingPolicies(resp http.ResponseWriter, req *http.Request) {
+	validating_webhooks.Serve(resp, req, &admitters.VMAutoscalingPolicyAdmitter{})
+}
+
+func ServeVMResourceQuotas(resp http.ResponseWriter, req *http.Request) {
+	validating_webhooks.Serve(resp, req, &admitters.VMResourceQuotaAdmitter{})
+}
+
+func ServeVMDisruptionBudgets(resp http.ResponseWriter, req *http.Request) {
+	validating_webhooks.Serve(resp, req, &admitters.VMDisruptionBudgetAdmitter{})
+}
+
 func ServeVMIPreset(resp http.ResponseWriter, req *http.Request) {
 	validating_webhooks.Serve(resp, req, &admitters.VMIPresetAdmitter{})
 }
@@ -87,7 +104,35 @@ func ServeVMSnapshots(resp http.ResponseWriter, req *http.Request, clusterConfig
 }
 
 func ServeVMRestores(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient, informers *webhooks.Informers) {
-	validating_webhooks.Serve(resp, req, storageadmitters.NewVMRestoreAdmitter(clusterConfig, virtCli, informers.VMRestoreInformer))
+	// The restored VM is created by virt-controller, validate the patched spec the same way
+	validateVMSpec := func(field *k8sfield.Path, spec *v1.VirtualMachineSpec) []metav1.StatusCause {
+		return admitters.ValidateVirtualMachineSpec(field, spec, clusterConfig, true)
+	}
+	validating_webhooks.Serve(resp, req, storageadmitters.NewVMRestoreAdmitter(clusterConfig, virtCli, informers.VMRestoreInformer, validateVMSpec))
+}
+
+func ServeVMSnapshotSchedules(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
+	validating_webhooks.Serve(resp, req, storageadmitters.NewVMSnapshotScheduleAdmitter(clusterConfig))
+}
+
+func ServeVMSnapshotGroups(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
+	validating_webhooks.Serve(resp, req, storageadmitters.NewVMSnapshotGroupAdmitter(clusterConfig))
+}
+
+func ServeVMSnapshotGroupRestores(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
+	validating_webhooks.Serve(resp, req, storageadmitters.NewVMSnapshotGroupRestoreAdmitter(clusterConfig))
+}
+
+func ServeVMSnapshotExports(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
+	validating_webhooks.Serve(resp, req, storageadmitters.NewVMSnapshotExportAdmitter(clusterConfig))
+}
+
+func ServeVMSnapshotReplications(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
+	validating_webhooks.Serve(resp, req, storageadmitters.NewVMSnapshotReplicationAdmitter(clusterConfig))
+}
+
+func ServeVMImports(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
+	validating_webhooks.Serve(resp, req, storageadmitters.NewVMImportAdmitter(clusterConfig))
 }
 
 func ServeVMExports(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
@@ -110,6 +155,14 @@ func ServeVmClusterPreferences(resp http.ResponseWriter, req *http.Request) {
 	validating_webhooks.Serve(resp, req, &preferencewebhooks.ClusterPreferenceAdmitter{})
 }
 
+func ServeVmDefaults(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
+	validating_webhooks.Serve(resp, req, vmdefaultswebhooks.NewDefaultAdmitter(clusterConfig))
+}
+
+func ServeVmClusterDefaults(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
+	validating_webhooks.Serve(resp, req, vmdefaultswebhooks.NewClusterDefaultAdmitter(clusterConfig))
+}
+
 func ServeStatusValidation(resp http.ResponseWriter,
 	req *http.Request,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -130,6 +183,10 @@ func ServeMigrationPolicies(resp http.ResponseWriter, req *http.Request) {
 	validating_webhooks.Serve(resp, req, admitters.NewMigrationPolicyAdmitter())
 }
 
+func ServeVolumeMigrations(resp http.ResponseWriter, req *http.Request) {
+	validating_webhooks.Serve(resp, req, admitters.NewVolumeMigrationAdmitter())
+}
+
 func ServeVirtualMachineClones(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient) {
 	validating_webhooks.Serve(resp, req, admitters.NewVMCloneAdmitter(clusterConfig, virtCli))
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"strings"
 	"sync"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -653,6 +654,68 @@ var _ = Describe("test configuration", func() {
 			[]string{"nvidia-223", "nvidia-229"}),
 	)
 
+	Context("mdev profiles", func() {
+		var clusterConfig *virtconfig.ClusterConfig
+
+		BeforeEach(func() {
+			clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{
+				ObjectMeta: metav1.ObjectMeta{
+					ResourceVersion: rand.String(10),
+					Name:            "kubevirt",
+					Namespace:       "kubevirt",
+				},
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						MediatedDevicesConfiguration: &v1.MediatedDevicesConfiguration{
+							NodeMediatedDeviceTypes: []v1.NodeMediatedDeviceTypesConfig{
+								{
+									NodeSelector: map[string]string{"gpu": "t4"},
+									Profiles: []v1.MediatedDeviceProfile{
+										{Name: "small", MediatedDeviceType: "nvidia-222"},
+									},
+								},
+								{
+									NodeSelector: map[string]string{"gpu": "a100"},
+									MediatedDeviceTypes: []string{
+										"nvidia-700",
+									},
+									Profiles: []v1.MediatedDeviceProfile{
+										{Name: "small", MediatedDeviceType: "nvidia-699"},
+										{Name: "large", MediatedDeviceType: "nvidia-701"},
+									},
+								},
+							},
+						},
+					},
+				},
+				Status: v1.KubeVirtStatus{
+					Phase: v1.KubeVirtPhaseDeploying,
+				},
+			})
+		})
+
+		DescribeTable("should resolve the profiles of the node", func(nodeLabels map[string]string, expectedTypes []string, expectedProfiles map[string]string) {
+			node := &kubev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "testNode", Labels: nodeLabels}}
+
+			Expect(clusterConfig.GetDesiredMDEVTypes(node)).To(ConsistOf(expectedTypes))
+			Expect(clusterConfig.GetMediatedDeviceProfiles(node)).To(Equal(expectedProfiles))
+		},
+			Entry("with no matching selector", map[string]string{}, []string{}, map[string]string{}),
+			Entry("with a node providing a single profile", map[string]string{"gpu": "t4"},
+				[]string{"nvidia-222"},
+				map[string]string{"nvidia-222": "mdev.kubevirt.io/small"}),
+			Entry("with a node providing several profiles", map[string]string{"gpu": "a100"},
+				[]string{"nvidia-699", "nvidia-700", "nvidia-701"},
+				map[string]string{"nvidia-699": "mdev.kubevirt.io/small", "nvidia-701": "mdev.kubevirt.io/large"}),
+		)
+
+		It("should know the profiles of all nodes", func() {
+			Expect(clusterConfig.HasMediatedDeviceProfile("small")).To(BeTrue())
+			Expect(clusterConfig.HasMediatedDeviceProfile("large")).To(BeTrue())
+			Expect(clusterConfig.HasMediatedDeviceProfile("medium")).To(BeFalse())
+		})
+	})
+
 	DescribeTable("when kubevirt CR holds config", func(value v1.KubeVirtConfiguration, getPart func(*v1.KubeVirtConfiguration) interface{}, result string) {
 		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{
 			ObjectMeta: metav1.ObjectMeta{
@@ -783,4 +846,90 @@ var _ = Describe("test configuration", func() {
 		Entry("reference when InstancetypeConfiguration.ReferencePolicy is reference", &v1.InstancetypeConfiguration{ReferencePolicy: pointer.P(v1.Reference)}, v1.Reference),
 		Entry("expand InstancetypeConfiguration.ReferencePolicy is expand", &v1.InstancetypeConfiguration{ReferencePolicy: pointer.P(v1.Expand)}, v1.Expand),
 	)
+
+	DescribeTable("session recording", func(sessionRecording *v1.SessionRecordingConfiguration, consoleEnabled, vncEnabled bool, claimName string) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			SessionRecording: sessionRecording,
+		})
+		Expect(clusterConfig.IsSessionRecordingEnabled(v1.ConsoleSessionRecording)).To(Equal(consoleEnabled))
+		Expect(clusterConfig.IsSessionRecordingEnabled(v1.VNCSessionRecording)).To(Equal(vncEnabled))
+		Expect(clusterConfig.GetSessionRecordingClaimName()).To(Equal(claimName))
+	},
+		Entry("should be disabled when not configured", nil, false, false, ""),
+		Entry("should be enabled for the listed sessions",
+			&v1.SessionRecordingConfiguration{Sessions: []v1.SessionRecordingType{v1.VNCSessionRecording}}, false, true, ""),
+		Entry("should return the claim of the recordings",
+			&v1.SessionRecordingConfiguration{
+				Sessions:                  []v1.SessionRecordingType{v1.ConsoleSessionRecording, v1.VNCSessionRecording},
+				PersistentVolumeClaimName: "recordings",
+			}, true, true, "recordings"),
+	)
+
+	DescribeTable("metrics collection interval", func(metrics *v1.MetricsConfiguration, interval time.Duration) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			Metrics: metrics,
+		})
+		Expect(clusterConfig.GetMetricsCollectionInterval()).To(Equal(interval))
+	},
+		Entry("should collect on every scrape when not configured", nil, time.Duration(0)),
+		Entry("should collect on every scrape without interval", &v1.MetricsConfiguration{}, time.Duration(0)),
+		Entry("should return the configured interval",
+			&v1.MetricsConfiguration{CollectionInterval: &metav1.Duration{Duration: 30 * time.Second}}, 30*time.Second),
+	)
+
+	It("should return the performance degradation thresholds", func() {
+		thresholds := &v1.PerformanceDegradationConfiguration{
+			CPUStealPercentage: pointer.P(uint32(10)),
+			IOLatency:          &metav1.Duration{Duration: 50 * time.Millisecond},
+		}
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			PerformanceDegradation: thresholds,
+		})
+		Expect(clusterConfig.GetPerformanceDegradation()).To(Equal(thresholds))
+	})
+
+	DescribeTable("component verbosity", func(logging *v1.LoggingConfiguration, handlerVerbosity, apiVerbosity uint) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				LogVerbosity: &v1.LogVerbosity{VirtHandler: 3, VirtAPI: 3},
+			},
+			Logging: logging,
+		})
+		Expect(clusterConfig.GetVirtHandlerVerbosity("node01")).To(Equal(handlerVerbosity))
+		Expect(clusterConfig.GetVirtAPIVerbosity("")).To(Equal(apiVerbosity))
+	},
+		Entry("should use the developer configuration without logging configuration", nil, uint(3), uint(3)),
+		Entry("should prefer the logging configuration",
+			&v1.LoggingConfiguration{Verbosity: &v1.LogVerbosity{VirtHandler: 5}}, uint(5), uint(3)),
+		Entry("should prefer the node verbosity of the logging configuration",
+			&v1.LoggingConfiguration{Verbosity: &v1.LogVerbosity{VirtHandler: 5, NodeVerbosity: map[string]uint{"node01": 7}}}, uint(7), uint(3)),
+	)
+
+	It("should return the log format and the subcomponent verbosity", func() {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		Expect(clusterConfig.GetLogFormat()).To(Equal(v1.LogFormatJSON))
+		Expect(clusterConfig.GetSubcomponentVerbosity()).To(BeEmpty())
+
+		clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			Logging: &v1.LoggingConfiguration{
+				Format:                v1.LogFormatText,
+				SubcomponentVerbosity: map[string]uint{"migration": 6},
+			},
+		})
+		Expect(clusterConfig.GetLogFormat()).To(Equal(v1.LogFormatText))
+		Expect(clusterConfig.GetSubcomponentVerbosity()).To(Equal(map[string]int{"migration": 6}))
+	})
+
+	DescribeTable("workload classes", func(name string, expected *v1.WorkloadClass) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			WorkloadClasses: []v1.WorkloadClass{
+				{Name: "batch", PriorityClassName: "low", PreemptionAction: v1.WorkloadPreemptionHibernate},
+				{Name: "interactive", PriorityClassName: "high"},
+			},
+		})
+		Expect(clusterConfig.GetWorkloadClass(name)).To(Equal(expected))
+	},
+		Entry("should return the class with the given name", "interactive", &v1.WorkloadClass{Name: "interactive", PriorityClassName: "high"}),
+		Entry("should return nil for an unknown class", "unknown", nil),
+	)
 })
@@ -212,3 +212,19 @@ func (config *ClusterConfig) GPUsWithDRAGateEnabled() bool {
 func (config *ClusterConfig) HostDevicesWithDRAEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.HostDevicesWithDRAGate)
 }
+
+func (config *ClusterConfig) VhostUserBlkEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VhostUserBlkGate)
+}
+
+func (config *ClusterConfig) GuestOSExecEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.GuestOSExecGate)
+}
+
+func (config *ClusterConfig) GuestFileTransferEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.GuestFileTransferGate)
+}
+
+func (config *ClusterConfig) UnfencedFailoverEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.UnfencedFailoverGate)
+}
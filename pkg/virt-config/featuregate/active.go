@@ -124,6 +124,28 @@ const (
 	//
 	// PasstIPStackMigration enables seamless migration with passt network binding.
 	PasstIPStackMigration = "PasstIPStackMigration"
+
+	// Alpha: v1.7.0
+	//
+	// VhostUserBlk allows connecting disks to vhost-user-blk sockets exposed on the node by storage targets like SPDK.
+	VhostUserBlkGate = "VhostUserBlk"
+
+	// Alpha: v1.7.0
+	//
+	// GuestOSExec enables the guestosexec subresource, running commands in the guest through the guest agent.
+	GuestOSExecGate = "GuestOSExec"
+
+	// Alpha: v1.7.0
+	//
+	// GuestFileTransfer enables the guestfileread and guestfilewrite subresources, transferring small files
+	// to and from the guest through the guest agent.
+	GuestFileTransferGate = "GuestFileTransfer"
+
+	// Alpha: v1.7.0
+	//
+	// UnfencedFailover allows highly available VMs to fail over from lost nodes without fencing, with the None
+	// fencing of their high availability policy. Without fencing the guest may keep running on the lost node.
+	UnfencedFailoverGate = "UnfencedFailover"
 )
 
 func init() {
@@ -157,4 +179,8 @@ func init() {
 	RegisterFeatureGate(FeatureGate{Name: VideoConfig, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: PanicDevicesGate, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: PasstIPStackMigration, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VhostUserBlkGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: GuestOSExecGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: GuestFileTransferGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: UnfencedFailoverGate, State: Alpha})
 }
@@ -24,6 +24,9 @@ package virtconfig
 */
 
 import (
+	"slices"
+	"time"
+
 	"kubevirt.io/client-go/log"
 
 	k8sv1 "k8s.io/api/core/v1"
@@ -305,6 +308,9 @@ func (c *ClusterConfig) GetDesiredMDEVTypes(node *k8sv1.Node) []string {
 				for _, mdevType := range types {
 					mdevTypesMap[mdevType] = struct{}{}
 				}
+				for _, profile := range nodeConfig.Profiles {
+					mdevTypesMap[profile.MediatedDeviceType] = struct{}{}
+				}
 			}
 		}
 		if len(mdevTypesMap) != 0 {
@@ -322,6 +328,45 @@ func (c *ClusterConfig) GetDesiredMDEVTypes(node *k8sv1.Node) []string {
 	return mdevTypesConf.MediatedDeviceTypes
 }
 
+// GetMediatedDeviceProfiles returns the resource names of the mediated device profiles of the node, by mediated device type
+func (c *ClusterConfig) GetMediatedDeviceProfiles(node *k8sv1.Node) map[string]string {
+	profiles := map[string]string{}
+	mdevTypesConf := c.GetConfig().MediatedDevicesConfiguration
+	if mdevTypesConf == nil {
+		return profiles
+	}
+	for _, nodeConfig := range mdevTypesConf.NodeMediatedDeviceTypes {
+		if !canSelectNode(nodeConfig.NodeSelector, node) {
+			continue
+		}
+		for _, profile := range nodeConfig.Profiles {
+			profiles[profile.MediatedDeviceType] = MediatedDeviceProfileResourceName(profile.Name)
+		}
+	}
+	return profiles
+}
+
+// HasMediatedDeviceProfile returns whether a mediated device profile with the given name is defined for any node
+func (c *ClusterConfig) HasMediatedDeviceProfile(name string) bool {
+	mdevTypesConf := c.GetConfig().MediatedDevicesConfiguration
+	if mdevTypesConf == nil {
+		return false
+	}
+	for _, nodeConfig := range mdevTypesConf.NodeMediatedDeviceTypes {
+		for _, profile := range nodeConfig.Profiles {
+			if profile.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MediatedDeviceProfileResourceName returns the name of the resource exposing the devices of a mediated device profile
+func MediatedDeviceProfileResourceName(profile string) string {
+	return v1.MediatedDeviceProfileResourcePrefix + profile
+}
+
 type virtComponent int
 
 const (
@@ -335,8 +380,15 @@ const (
 
 // Gets the component verbosity. nodeName can be empty, then it's ignored.
 func (c *ClusterConfig) getComponentVerbosity(component virtComponent, nodeName string) uint {
-	logConf := c.GetConfig().DeveloperConfiguration.LogVerbosity
+	if logging := c.GetConfig().Logging; logging != nil && logging.Verbosity != nil {
+		if level := componentVerbosity(logging.Verbosity, component, nodeName); level != 0 {
+			return level
+		}
+	}
+	return componentVerbosity(c.GetConfig().DeveloperConfiguration.LogVerbosity, component, nodeName)
+}
 
+func componentVerbosity(logConf *v1.LogVerbosity, component virtComponent, nodeName string) uint {
 	if nodeName != "" {
 		if level := logConf.NodeVerbosity[nodeName]; level != 0 {
 			return level
@@ -357,7 +409,7 @@ func (c *ClusterConfig) getComponentVerbosity(component virtComponent, nodeName
 	case virtSynchronizationController:
 		return logConf.VirtSynchronizationController
 	default:
-		log.Log.Errorf("getComponentVerbosity called with an unknown virtComponent: %v", component)
+		log.Log.Errorf("componentVerbosity called with an unknown virtComponent: %v", component)
 		return 0
 	}
 }
@@ -386,6 +438,25 @@ func (c *ClusterConfig) GetVirtSynchronizationControllerVerbosity() uint {
 	return c.getComponentVerbosity(virtSynchronizationController, "")
 }
 
+// GetLogFormat returns the format of the logs of the components
+func (c *ClusterConfig) GetLogFormat() v1.LogFormat {
+	if logging := c.GetConfig().Logging; logging != nil && logging.Format != "" {
+		return logging.Format
+	}
+	return v1.LogFormatJSON
+}
+
+// GetSubcomponentVerbosity returns the verbosity of the subcomponents which have one
+func (c *ClusterConfig) GetSubcomponentVerbosity() map[string]int {
+	verbosity := map[string]int{}
+	if logging := c.GetConfig().Logging; logging != nil {
+		for subcomponent, level := range logging.SubcomponentVerbosity {
+			verbosity[subcomponent] = int(level)
+		}
+	}
+	return verbosity
+}
+
 // GetMinCPUModel return minimal cpu which is used in node-labeller
 func (c *ClusterConfig) GetMinCPUModel() string {
 	return c.GetConfig().MinCPUModel
@@ -428,6 +499,19 @@ func (c *ClusterConfig) GetKSMConfiguration() *v1.KSMConfiguration {
 	return c.GetConfig().KSMConfiguration
 }
 
+// GetKSMMergePolicy returns the KSM merge policy of the VMIs which do not set one
+func (c *ClusterConfig) GetKSMMergePolicy() v1.KSMMergePolicy {
+	ksmConfig := c.GetKSMConfiguration()
+	if ksmConfig == nil || ksmConfig.MergePolicy == "" {
+		return v1.KSMMergePolicyBalanced
+	}
+	return ksmConfig.MergePolicy
+}
+
+func (c *ClusterConfig) GetMemoryBalloon() *v1.MemoryBalloon {
+	return c.GetConfig().MemoryBalloon
+}
+
 func (c *ClusterConfig) GetMaximumCpuSockets() (numOfSockets uint32) {
 	liveConfig := c.GetConfig().LiveUpdateConfiguration
 	if liveConfig != nil && liveConfig.MaxCpuSockets != nil {
@@ -485,6 +569,85 @@ func (c *ClusterConfig) GetInstancetypeReferencePolicy() v1.InstancetypeReferenc
 	return v1.Reference
 }
 
+// GetPoolAutoscalingPrometheusURL returns the address of the Prometheus API used to autoscale VirtualMachinePools
+func (c *ClusterConfig) GetPoolAutoscalingPrometheusURL() string {
+	poolAutoscaling := c.GetConfig().PoolAutoscaling
+	if poolAutoscaling == nil {
+		return ""
+	}
+	return poolAutoscaling.PrometheusURL
+}
+
+// GetVirtIODriversImage returns the containerDisk image holding the VirtIO driver ISO if attaching it is enabled
+func (c *ClusterConfig) GetVirtIODriversImage() string {
+	virtIODrivers := c.GetConfig().VirtIODrivers
+	if virtIODrivers == nil || virtIODrivers.Autoattach == nil || !*virtIODrivers.Autoattach {
+		return ""
+	}
+	return virtIODrivers.Image
+}
+
+// GetSysprepTemplateNamespaces returns the namespaces which Sysprep volumes of any namespace may reference answer files from
+func (c *ClusterConfig) GetSysprepTemplateNamespaces() []string {
+	if sysprep := c.GetConfig().Sysprep; sysprep != nil {
+		return sysprep.TemplateNamespaces
+	}
+	return nil
+}
+
+// IsSessionRecordingEnabled returns true when sessions of the given kind are audited and recorded
+func (c *ClusterConfig) IsSessionRecordingEnabled(kind v1.SessionRecordingType) bool {
+	if sessionRecording := c.GetConfig().SessionRecording; sessionRecording != nil {
+		return slices.Contains(sessionRecording.Sessions, kind)
+	}
+	return false
+}
+
+// GetSessionRecordingClaimName returns the name of the PersistentVolumeClaim the sessions are recorded on
+func (c *ClusterConfig) GetSessionRecordingClaimName() string {
+	if sessionRecording := c.GetConfig().SessionRecording; sessionRecording != nil {
+		return sessionRecording.PersistentVolumeClaimName
+	}
+	return ""
+}
+
+// GetMetricsCollectionInterval returns the minimum interval between two collections of the domain stats
+func (c *ClusterConfig) GetMetricsCollectionInterval() time.Duration {
+	if metrics := c.GetConfig().Metrics; metrics != nil && metrics.CollectionInterval != nil {
+		return metrics.CollectionInterval.Duration
+	}
+	return 0
+}
+
+// GetPerformanceDegradation returns the thresholds of the performance conditions of the VMIs, or nil if they are not configured
+func (c *ClusterConfig) GetPerformanceDegradation() *v1.PerformanceDegradationConfiguration {
+	return c.GetConfig().PerformanceDegradation
+}
+
+// LimitableSubresources are the streaming subresources of virt-api whose connections can be limited
+var LimitableSubresources = []string{"console", "vnc", "vnc/screenshot", "spice", "usbredir", "portforward", "vsock", "memorydump"}
+
+// GetSubresourceLimits returns the limits of the connections to the streaming subresources, or nil if they are not limited
+func (c *ClusterConfig) GetSubresourceLimits() *v1.SubresourceLimitsConfiguration {
+	return c.GetConfig().SubresourceLimits
+}
+
+// GetAdmissionPolicy returns the rules the VirtualMachines and VirtualMachineInstances are validated with, or nil
+// if there are none
+func (c *ClusterConfig) GetAdmissionPolicy() *v1.AdmissionPolicyConfiguration {
+	return c.GetConfig().AdmissionPolicy
+}
+
+// GetWorkloadClass returns the workload class with the given name, or nil if it is not configured
+func (c *ClusterConfig) GetWorkloadClass(name string) *v1.WorkloadClass {
+	for _, workloadClass := range c.GetConfig().WorkloadClasses {
+		if workloadClass.Name == name {
+			return workloadClass.DeepCopy()
+		}
+	}
+	return nil
+}
+
 func (c *ClusterConfig) ClusterProfilerEnabled() bool {
 	return c.GetConfig().DeveloperConfiguration.ClusterProfiler ||
 		c.isFeatureGateDefined(featuregate.ClusterProfiler)
@@ -15,6 +15,7 @@ type NodeSelectorRenderer struct {
 	cpuFeatureLabels       []string
 	cpuModelLabel          string
 	machineTypeLabel       string
+	diskLabels             []string
 	hasDedicatedCPU        bool
 	hyperv                 bool
 	podNodeSelectors       map[string]string
@@ -68,6 +69,10 @@ func (nsr *NodeSelectorRenderer) Render() map[string]string {
 		nsr.enableSelectorLabel(cpuFeatureLabel)
 	}
 
+	for _, diskLabel := range nsr.diskLabels {
+		nsr.enableSelectorLabel(diskLabel)
+	}
+
 	if nsr.isManualTSCFrequencyRequired() {
 		nsr.enableSelectorLabel(topology.ToTSCSchedulableLabel(*nsr.tscFrequency))
 	}
@@ -144,6 +149,12 @@ func WithMachineType(machineType string) NodeSelectorRendererOption {
 	}
 }
 
+func WithDiskLabels(diskLabels ...string) NodeSelectorRendererOption {
+	return func(renderer *NodeSelectorRenderer) {
+		renderer.diskLabels = diskLabels
+	}
+}
+
 func WithTSCTimer(tscFrequency *int64) NodeSelectorRendererOption {
 	return func(renderer *NodeSelectorRenderer) {
 		renderer.tscFrequency = tscFrequency
@@ -175,6 +186,29 @@ func CPUFeatureLabelsFromCPUFeatures(vmi *v1.VirtualMachineInstance) []string {
 	return labels
 }
 
+// DiskLabelsFromDisks returns the node labels of the disk buses and IO modes
+// which are not supported by every node
+func DiskLabelsFromDisks(vmi *v1.VirtualMachineInstance) []string {
+	var nvme, ioUring bool
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.Disk != nil && disk.Disk.Bus == v1.DiskBusNVMe {
+			nvme = true
+		}
+		if disk.IO == v1.IOUring {
+			ioUring = true
+		}
+	}
+
+	var labels []string
+	if nvme {
+		labels = append(labels, v1.SupportedDiskBusLabel+string(v1.DiskBusNVMe))
+	}
+	if ioUring {
+		labels = append(labels, v1.SupportedDiskIOLabel+string(v1.IOUring))
+	}
+	return labels
+}
+
 func hypervNodeSelectors(vmiFeatures *v1.Features) map[string]string {
 	nodeSelectors := make(map[string]string)
 	if vmiFeatures == nil || vmiFeatures.Hyperv == nil {
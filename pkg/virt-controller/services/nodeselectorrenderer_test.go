@@ -92,6 +92,26 @@ var _ = Describe("Node Selector Renderer", func() {
 				})
 			})
 
+			When("disks with NVMe bus and io_uring are requested", func() {
+				BeforeEach(func() {
+					vmi := &v1.VirtualMachineInstance{}
+					vmi.Spec.Domain.Devices.Disks = []v1.Disk{
+						{Name: "disk0", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}},
+						{Name: "disk1", IO: v1.IOUring, DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusNVMe}}},
+					}
+					nsr = NewNodeSelectorRenderer(emptySelectors(), emptySelectors(), "", WithDiskLabels(DiskLabelsFromDisks(vmi)...))
+				})
+
+				It("requires the node to support the disk bus and IO mode", func() {
+					Expect(nsr.Render()).To(
+						Equal(map[string]string{
+							"kubevirt.io/schedulable":           "true",
+							"disk-bus.node.kubevirt.io/nvme":    "true",
+							"disk-io.node.kubevirt.io/io_uring": "true",
+						}))
+				})
+			})
+
 			When("architecture set on VMI", func() {
 
 				BeforeEach(func() {
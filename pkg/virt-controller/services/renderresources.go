@@ -223,6 +223,13 @@ func WithHugePages(vmMemory *v1.Memory, memoryOverhead resource.Quantity) Resour
 				hugepagesMemReq = vmMemory.Guest
 			}
 		}
+		// The regular memory of the guest is not backed by hugepages
+		regularMemory := hasRegularMemory(vmMemory)
+		if regularMemory {
+			hugepagesMem := hugepagesMemReq.DeepCopy()
+			hugepagesMemReq = &hugepagesMem
+			hugepagesMemReq.Sub(*vmMemory.Hugepages.RegularMemory)
+		}
 		renderer.calculatedRequests[hugepageType] = *hugepagesMemReq
 		renderer.calculatedLimits[hugepageType] = *hugepagesMemReq
 
@@ -243,6 +250,10 @@ func WithHugePages(vmMemory *v1.Memory, memoryOverhead resource.Quantity) Resour
 				limMemDiff.Sub(*vmMemory.Guest)
 			}
 		}
+		if regularMemory {
+			reqMemDiff.Add(*vmMemory.Hugepages.RegularMemory)
+			limMemDiff.Add(*vmMemory.Hugepages.RegularMemory)
+		}
 		// Set requested memory equals to overhead memory
 		reqMemDiff.Add(memoryOverhead)
 		renderer.vmRequests[k8sv1.ResourceMemory] = *reqMemDiff
@@ -253,6 +264,11 @@ func WithHugePages(vmMemory *v1.Memory, memoryOverhead resource.Quantity) Resour
 	}
 }
 
+func hasRegularMemory(vmMemory *v1.Memory) bool {
+	return vmMemory != nil && vmMemory.Hugepages != nil &&
+		vmMemory.Hugepages.RegularMemory != nil && !vmMemory.Hugepages.RegularMemory.IsZero()
+}
+
 func WithMemoryOverhead(guestResourceSpec v1.ResourceRequirements, memoryOverhead resource.Quantity) ResourceRendererOption {
 	return func(renderer *ResourceRenderer) {
 		memoryRequest := renderer.vmRequests[k8sv1.ResourceMemory]
@@ -352,6 +368,18 @@ func WithPersistentReservation() ResourceRendererOption {
 	}
 }
 
+// WithVhostUserBlkResources adds resource requests/limits for the vhost-user-blk sockets provided by device plugins.
+func WithVhostUserBlkResources(resourceNames []string) ResourceRendererOption {
+	return func(renderer *ResourceRenderer) {
+		resources := renderer.ResourceRequirements()
+		for _, resourceName := range resourceNames {
+			requestResource(&resources, resourceName)
+		}
+		copyResources(resources.Limits, renderer.calculatedLimits)
+		copyResources(resources.Requests, renderer.calculatedRequests)
+	}
+}
+
 func copyResources(srcResources, dstResources k8sv1.ResourceList) {
 	for key, value := range srcResources {
 		dstResources[key] = value
@@ -590,6 +618,10 @@ func validatePermittedHostDevices(spec *v1.VirtualMachineInstanceSpec, config *v
 		//TODO @alayp: add proper validation for DRA GPUs in beta
 		if !config.GPUsWithDRAGateEnabled() {
 			for _, hostDev := range spec.Domain.Devices.GPUs {
+				// GPUs requesting a mediated device profile are permitted by the profiles of the KubeVirt CR
+				if hostDev.Profile != "" {
+					continue
+				}
 				if _, exist := supportedHostDevicesMap[hostDev.DeviceName]; !exist {
 					errors = append(errors, fmt.Sprintf("GPU %s is not permitted in permittedHostDevices configuration", hostDev.DeviceName))
 				}
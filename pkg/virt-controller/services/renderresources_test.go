@@ -448,6 +448,17 @@ var _ = Describe("Resource pod spec renderer", func() {
 		}))
 	})
 
+	It("WithVhostUserBlkResources option requests each socket of a resource", func() {
+		resourceKey := kubev1.ResourceName("spdk.io/vhost-blk")
+		rr = NewResourceRenderer(nil, nil, WithVhostUserBlkResources([]string{"spdk.io/vhost-blk", "spdk.io/vhost-blk"}))
+		Expect(rr.Requests()).To(Equal(kubev1.ResourceList{
+			resourceKey: *resource.NewQuantity(2, resource.DecimalSI),
+		}))
+		Expect(rr.Limits()).To(Equal(kubev1.ResourceList{
+			resourceKey: *resource.NewQuantity(2, resource.DecimalSI),
+		}))
+	})
+
 	defaultRequest := func() kubev1.ResourceList {
 		return kubev1.ResourceList{
 			kubev1.ResourceCPU:    resource.MustParse("10m"),
@@ -20,6 +20,7 @@ import (
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/network/downwardapi"
 	"kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/storage/vhostuserblk"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
@@ -153,6 +154,12 @@ func withVMIVolumes(pvcStore cache.Store, vmiSpecVolumes []v1.Volume, vmiVolumeS
 				}
 			}
 
+			if volume.MemoryDump != nil && !volume.MemoryDump.Hotpluggable {
+				if err := renderer.handleMemoryDumpVolume(volume, pvcStore); err != nil {
+					return err
+				}
+			}
+
 			if volume.HostDisk != nil {
 				renderer.handleHostDisk(volume)
 			}
@@ -180,6 +187,14 @@ func withVMIVolumes(pvcStore cache.Store, vmiSpecVolumes []v1.Volume, vmiVolumeS
 			if volume.CloudInitConfigDrive != nil {
 				renderer.handleCloudInitConfigDrive(volume)
 			}
+
+			if volume.Ignition != nil {
+				renderer.handleIgnition(volume)
+			}
+
+			if volume.VhostUserBlk != nil && volume.VhostUserBlk.SocketName != "" {
+				renderer.handleVhostUserBlk(volume)
+			}
 		}
 		return nil
 	}
@@ -294,11 +309,16 @@ func (vr *VolumeRenderer) handleCloudInitConfigDrive(volume v1.Volume) {
 				ReadOnly:  true,
 			})
 		}
+		vr.handleCloudInitUserDataSources(volume.Name, volume.CloudInitConfigDrive.UserDataSources)
 	}
 }
 
 func (vr *VolumeRenderer) handleSysprep(volume v1.Volume) error {
-	if volume.Sysprep != nil {
+	if volume.Sysprep == nil {
+		return nil
+	}
+	// copies of ConfigMaps of other namespaces are mounted by withSysprepConfigMapCopies
+	if !config.SysprepNeedsCopy(vr.namespace, volume.Sysprep) {
 		var volumeSource k8sv1.VolumeSource
 		// attach a Secret or ConfigMap referenced by the user
 		volumeSource, err := sysprepVolumeSource(*volume.Sysprep)
@@ -315,9 +335,53 @@ func (vr *VolumeRenderer) handleSysprep(volume v1.Volume) error {
 			ReadOnly:  true,
 		})
 	}
+	if template := volume.Sysprep.Template; template != nil && template.ParametersSecretRef != nil {
+		parametersVolumeName := volume.Name + "-parameters"
+		vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
+			Name: parametersVolumeName,
+			VolumeSource: k8sv1.VolumeSource{
+				Secret: &k8sv1.SecretVolumeSource{
+					SecretName: template.ParametersSecretRef.Name,
+				},
+			},
+		})
+		vr.podVolumeMounts = append(vr.podVolumeMounts, k8sv1.VolumeMount{
+			Name:      parametersVolumeName,
+			MountPath: config.GetSysprepParametersPath(volume.Name),
+			ReadOnly:  true,
+		})
+	}
 	return nil
 }
 
+// withSysprepConfigMapCopies mounts the copies virt-controller made of the ConfigMaps
+// of other namespaces referenced by the Sysprep volumes.
+func withSysprepConfigMapCopies(vmi *v1.VirtualMachineInstance) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		for _, volume := range vmi.Spec.Volumes {
+			if volume.Sysprep == nil || !config.SysprepNeedsCopy(vmi.Namespace, volume.Sysprep) {
+				continue
+			}
+			renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+				Name: volume.Name,
+				VolumeSource: k8sv1.VolumeSource{
+					ConfigMap: &k8sv1.ConfigMapVolumeSource{
+						LocalObjectReference: k8sv1.LocalObjectReference{
+							Name: config.GetSysprepConfigMapCopyName(vmi.Name, volume.Name),
+						},
+					},
+				},
+			})
+			renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+				Name:      volume.Name,
+				MountPath: filepath.Join(config.SysprepSourceDir, volume.Name),
+				ReadOnly:  true,
+			})
+		}
+		return nil
+	}
+}
+
 func hotplugVolumes(vmiVolumeStatus []v1.VolumeStatus, vmiSpecVolumes []v1.Volume) map[string]struct{} {
 	hotplugVolumeSet := map[string]struct{}{}
 	for _, volumeStatus := range vmiVolumeStatus {
@@ -608,6 +672,23 @@ func (vr *VolumeRenderer) handlePVCVolume(volume v1.Volume, pvcStore cache.Store
 	return nil
 }
 
+// handleMemoryDumpVolume mounts a memory state the VMI resumes from when it starts
+func (vr *VolumeRenderer) handleMemoryDumpVolume(volume v1.Volume, pvcStore cache.Store) error {
+	claimName := volume.MemoryDump.ClaimName
+	if err := vr.addPVCToLaunchManifest(pvcStore, volume, claimName); err != nil {
+		return err
+	}
+	vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
+		Name: volume.Name,
+		VolumeSource: k8sv1.VolumeSource{
+			PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+				ClaimName: claimName,
+			},
+		},
+	})
+	return nil
+}
+
 func (vr *VolumeRenderer) handleEphemeralVolume(volume v1.Volume, pvcStore cache.Store) error {
 	claimName := volume.Ephemeral.PersistentVolumeClaim.ClaimName
 	if err := vr.addPVCToLaunchManifest(pvcStore, volume, claimName); err != nil {
@@ -663,6 +744,26 @@ func (vr *VolumeRenderer) handleHostDisk(volume v1.Volume) {
 	})
 }
 
+// handleVhostUserBlk mounts the socket a vhost-user-blk target exposes in the socket directory of the node.
+// Sockets of device plugin resources are mounted by the device plugin.
+func (vr *VolumeRenderer) handleVhostUserBlk(volume v1.Volume) {
+	hostPathType := k8sv1.HostPathSocket
+
+	vr.podVolumeMounts = append(vr.podVolumeMounts, k8sv1.VolumeMount{
+		Name:      volume.Name,
+		MountPath: vhostuserblk.GetSocketPath(volume.Name),
+	})
+	vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
+		Name: volume.Name,
+		VolumeSource: k8sv1.VolumeSource{
+			HostPath: &k8sv1.HostPathVolumeSource{
+				Path: vhostuserblk.GetNodeSocketPath(volume.VhostUserBlk.SocketName),
+				Type: &hostPathType,
+			},
+		},
+	})
+}
+
 func (vr *VolumeRenderer) addSecretVolume(volume v1.Volume) {
 	vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
 		Name: volume.Name,
@@ -811,6 +912,57 @@ func (vr *VolumeRenderer) handleCloudInitNoCloud(volume v1.Volume) {
 			ReadOnly:  true,
 		})
 	}
+	vr.handleCloudInitUserDataSources(volume.Name, volume.CloudInitNoCloud.UserDataSources)
+}
+
+// handleCloudInitUserDataSources attaches the Secrets and ConfigMaps whose userdata
+// is merged by virt-launcher into the userdata of the given cloud-init volume.
+func (vr *VolumeRenderer) handleCloudInitUserDataSources(cloudInitVolumeName string, sources []v1.CloudInitUserDataSource) {
+	for i, source := range sources {
+		var volumeSource k8sv1.VolumeSource
+		switch {
+		case source.SecretRef != nil:
+			volumeSource.Secret = &k8sv1.SecretVolumeSource{
+				SecretName: source.SecretRef.Name,
+			}
+		case source.ConfigMapRef != nil:
+			volumeSource.ConfigMap = &k8sv1.ConfigMapVolumeSource{
+				LocalObjectReference: *source.ConfigMapRef,
+			}
+		default:
+			continue
+		}
+
+		volumeName := fmt.Sprintf("%s-udata-%d", cloudInitVolumeName, i)
+		vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
+			Name:         volumeName,
+			VolumeSource: volumeSource,
+		})
+		vr.podVolumeMounts = append(vr.podVolumeMounts, k8sv1.VolumeMount{
+			Name:      volumeName,
+			MountPath: filepath.Join(config.SecretSourceDir, volumeName),
+			ReadOnly:  true,
+		})
+	}
+}
+
+func (vr *VolumeRenderer) handleIgnition(volume v1.Volume) {
+	if volume.Ignition.SecretRef == nil {
+		return
+	}
+	vr.podVolumes = append(vr.podVolumes, k8sv1.Volume{
+		Name: volume.Name,
+		VolumeSource: k8sv1.VolumeSource{
+			Secret: &k8sv1.SecretVolumeSource{
+				SecretName: volume.Ignition.SecretRef.Name,
+			},
+		},
+	})
+	vr.podVolumeMounts = append(vr.podVolumeMounts, k8sv1.VolumeMount{
+		Name:      volume.Name,
+		MountPath: filepath.Join(config.SecretSourceDir, volume.Name),
+		ReadOnly:  true,
+	})
 }
 
 func (vr *VolumeRenderer) handleDownwardMetrics(volume v1.Volume) {
@@ -155,6 +155,55 @@ var _ = Describe("Container spec renderer", func() {
 		})
 	})
 
+	Context("with vhost-user-blk volume option", func() {
+		const volumeName = "spdk-disk"
+
+		It("should mount the socket of the node", func() {
+			volume := v1.Volume{
+				Name: volumeName,
+				VolumeSource: v1.VolumeSource{
+					VhostUserBlk: &v1.VhostUserBlkVolumeSource{SocketName: "vhost.0"},
+				},
+			}
+			var err error
+			vsr, err = NewVolumeRenderer(false, namespace, ephemeralDisk, containerDisk, virtShareDir, withVMIVolumes(nil, []v1.Volume{volume}, nil))
+			Expect(err).NotTo(HaveOccurred())
+
+			hostPathType := k8sv1.HostPathSocket
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{
+						Name:      volumeName,
+						MountPath: "/var/run/kubevirt-private/vhost-user-blk/spdk-disk.sock"})))
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name: volumeName,
+						VolumeSource: k8sv1.VolumeSource{
+							HostPath: &k8sv1.HostPathVolumeSource{
+								Type: &hostPathType,
+								Path: "/var/run/vhost-user-blk/vhost.0",
+							}},
+					})))
+		})
+
+		It("should not mount sockets provided by a device plugin", func() {
+			volume := v1.Volume{
+				Name: volumeName,
+				VolumeSource: v1.VolumeSource{
+					VhostUserBlk: &v1.VhostUserBlkVolumeSource{ResourceName: "spdk.io/vhost-blk"},
+				},
+			}
+			var err error
+			vsr, err = NewVolumeRenderer(false, namespace, ephemeralDisk, containerDisk, virtShareDir, withVMIVolumes(nil, []v1.Volume{volume}, nil))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Mounts()).To(ConsistOf(defaultVolumeMounts()))
+			Expect(vsr.Volumes()).To(ConsistOf(defaultVolumes()))
+		})
+	})
+
 	Context("with CloudInitConfigDrive option", func() {
 		const (
 			cloudInitDriveName = "pepitos-drive"
@@ -235,6 +284,185 @@ var _ = Describe("Container spec renderer", func() {
 		})
 	})
 
+	Context("with CloudInitNoCloud user data sources option", func() {
+		It("should attach every user data source in its own directory", func() {
+			cloudInit := v1.Volume{
+				Name: "cloudinit",
+				VolumeSource: v1.VolumeSource{
+					CloudInitNoCloud: &v1.CloudInitNoCloudSource{
+						UserData: "#cloud-config",
+						UserDataSources: []v1.CloudInitUserDataSource{
+							{SecretRef: &k8sv1.LocalObjectReference{Name: "users"}},
+							{ConfigMapRef: &k8sv1.LocalObjectReference{Name: "packages"}},
+						},
+					},
+				},
+			}
+
+			var err error
+			vsr, err = NewVolumeRenderer(false, namespace, ephemeralDisk, containerDisk, virtShareDir, withVMIVolumes(nil, []v1.Volume{cloudInit}, nil))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{
+						Name:      "cloudinit-udata-0",
+						ReadOnly:  true,
+						MountPath: "/var/run/kubevirt-private/secret/cloudinit-udata-0",
+					}, k8sv1.VolumeMount{
+						Name:      "cloudinit-udata-1",
+						ReadOnly:  true,
+						MountPath: "/var/run/kubevirt-private/secret/cloudinit-udata-1",
+					})))
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name: "cloudinit-udata-0",
+						VolumeSource: k8sv1.VolumeSource{
+							Secret: &k8sv1.SecretVolumeSource{
+								SecretName: "users",
+							},
+						}}, k8sv1.Volume{
+						Name: "cloudinit-udata-1",
+						VolumeSource: k8sv1.VolumeSource{
+							ConfigMap: &k8sv1.ConfigMapVolumeSource{
+								LocalObjectReference: k8sv1.LocalObjectReference{Name: "packages"},
+							},
+						}})))
+		})
+	})
+
+	Context("with Ignition option", func() {
+		It("should attach the referenced secret", func() {
+			ignition := v1.Volume{
+				Name: "ignition",
+				VolumeSource: v1.VolumeSource{
+					Ignition: &v1.IgnitionSource{
+						SecretRef: &k8sv1.LocalObjectReference{Name: "ignition-config"},
+					},
+				},
+			}
+
+			var err error
+			vsr, err = NewVolumeRenderer(false, namespace, ephemeralDisk, containerDisk, virtShareDir, withVMIVolumes(nil, []v1.Volume{ignition}, nil))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{
+						Name:      "ignition",
+						ReadOnly:  true,
+						MountPath: "/var/run/kubevirt-private/secret/ignition",
+					})))
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name: "ignition",
+						VolumeSource: k8sv1.VolumeSource{
+							Secret: &k8sv1.SecretVolumeSource{
+								SecretName: "ignition-config",
+							},
+						}})))
+		})
+
+		It("should not attach anything for inline data", func() {
+			ignition := v1.Volume{
+				Name: "ignition",
+				VolumeSource: v1.VolumeSource{
+					Ignition: &v1.IgnitionSource{Data: `{"ignition":{"version":"3.4.0"}}`},
+				},
+			}
+
+			var err error
+			vsr, err = NewVolumeRenderer(false, namespace, ephemeralDisk, containerDisk, virtShareDir, withVMIVolumes(nil, []v1.Volume{ignition}, nil))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Mounts()).To(ConsistOf(defaultVolumeMounts()))
+			Expect(vsr.Volumes()).To(ConsistOf(defaultVolumes()))
+		})
+	})
+
+	Context("with Sysprep option", func() {
+		newSysprepVMI := func(sysprep *v1.SysprepSource) *v1.VirtualMachineInstance {
+			vmi := &v1.VirtualMachineInstance{}
+			vmi.Name = "testvmi"
+			vmi.Namespace = namespace
+			vmi.Spec.Volumes = []v1.Volume{{
+				Name:         "sysprep",
+				VolumeSource: v1.VolumeSource{Sysprep: sysprep},
+			}}
+			return vmi
+		}
+
+		It("should attach the copy of a ConfigMap of another namespace and the template parameters", func() {
+			vmi := newSysprepVMI(&v1.SysprepSource{
+				ConfigMap: &k8sv1.LocalObjectReference{Name: "golden-unattend"},
+				Namespace: "golden",
+				Template: &v1.SysprepTemplate{
+					ParametersSecretRef: &k8sv1.LocalObjectReference{Name: "license"},
+				},
+			})
+
+			var err error
+			vsr, err = NewVolumeRenderer(false, namespace, ephemeralDisk, containerDisk, virtShareDir,
+				withVMIVolumes(nil, vmi.Spec.Volumes, nil), withSysprepConfigMapCopies(vmi))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{
+						Name:      "sysprep",
+						ReadOnly:  true,
+						MountPath: "/var/run/kubevirt-private/sysprep/sysprep",
+					},
+					k8sv1.VolumeMount{
+						Name:      "sysprep-parameters",
+						ReadOnly:  true,
+						MountPath: "/var/run/kubevirt-private/sysprep-parameters/sysprep",
+					})))
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name: "sysprep",
+						VolumeSource: k8sv1.VolumeSource{
+							ConfigMap: &k8sv1.ConfigMapVolumeSource{
+								LocalObjectReference: k8sv1.LocalObjectReference{Name: "testvmi-sysprep-sysprep"},
+							},
+						}},
+					k8sv1.Volume{
+						Name: "sysprep-parameters",
+						VolumeSource: k8sv1.VolumeSource{
+							Secret: &k8sv1.SecretVolumeSource{
+								SecretName: "license",
+							},
+						}})))
+		})
+
+		It("should attach the referenced ConfigMap of the namespace of the VMI", func() {
+			vmi := newSysprepVMI(&v1.SysprepSource{
+				ConfigMap: &k8sv1.LocalObjectReference{Name: "unattend"},
+				Namespace: namespace,
+			})
+
+			var err error
+			vsr, err = NewVolumeRenderer(false, namespace, ephemeralDisk, containerDisk, virtShareDir,
+				withVMIVolumes(nil, vmi.Spec.Volumes, nil), withSysprepConfigMapCopies(vmi))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name: "sysprep",
+						VolumeSource: k8sv1.VolumeSource{
+							ConfigMap: &k8sv1.ConfigMapVolumeSource{
+								LocalObjectReference: k8sv1.LocalObjectReference{Name: "unattend"},
+							},
+						}})))
+		})
+	})
+
 	Context("with DataVolume option", func() {
 		const (
 			dataVolumeName = "dv1"
@@ -288,6 +516,70 @@ var _ = Describe("Container spec renderer", func() {
 		})
 	})
 
+	Context("with memory dump option", func() {
+		const (
+			memoryVolumeName = "memory"
+			memoryClaimName  = "memory-pvc"
+		)
+
+		var pvcStore cache.Store
+
+		BeforeEach(func() {
+			pvcStore = &cache.FakeCustomStore{
+				GetByKeyFunc: func(key string) (item interface{}, exists bool, err error) {
+					return &k8sv1.PersistentVolumeClaim{}, true, nil
+				},
+			}
+		})
+
+		memoryDumpVolume := func(hotpluggable bool) v1.Volume {
+			return v1.Volume{
+				Name: memoryVolumeName,
+				VolumeSource: v1.VolumeSource{MemoryDump: &v1.MemoryDumpVolumeSource{
+					PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: memoryClaimName,
+						},
+						Hotpluggable: hotpluggable,
+					},
+					Format: v1.MemoryDumpFormatState,
+				}},
+			}
+		}
+
+		It("should mount the memory state to resume from", func() {
+			var err error
+			vsr, err = NewVolumeRenderer(false, namespace, ephemeralDisk, containerDisk, virtShareDir, withVMIVolumes(pvcStore, []v1.Volume{memoryDumpVolume(false)}, nil))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{
+						Name:      memoryVolumeName,
+						MountPath: vmiDiskPath(memoryVolumeName),
+					})))
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name: memoryVolumeName,
+						VolumeSource: k8sv1.VolumeSource{
+							PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+								ClaimName: memoryClaimName,
+							},
+						},
+					})))
+		})
+
+		It("should not mount a hotplugged memory dump", func() {
+			var err error
+			vsr, err = NewVolumeRenderer(false, namespace, ephemeralDisk, containerDisk, virtShareDir, withVMIVolumes(pvcStore, []v1.Volume{memoryDumpVolume(true)}, nil))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Mounts()).To(ConsistOf(defaultVolumeMounts()))
+			Expect(vsr.Volumes()).To(ConsistOf(defaultVolumes()))
+		})
+	})
+
 	Context("with Downward API option", func() {
 		const (
 			downwardAPIVolumeName = "downward-then-upward"
@@ -39,6 +39,7 @@ import (
 	"k8s.io/kubectl/pkg/cmd/util/podcmd"
 	v1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
+	importv1 "kubevirt.io/api/vmimport/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 	"kubevirt.io/client-go/precond"
@@ -56,6 +57,7 @@ import (
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	"kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/storage/vhostuserblk"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/net/dns"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -102,6 +104,7 @@ const IntelVendorName = "Intel"
 const ENV_VAR_LIBVIRT_DEBUG_LOGS = "LIBVIRT_DEBUG_LOGS"
 const ENV_VAR_VIRTIOFSD_DEBUG_LOGS = "VIRTIOFSD_DEBUG_LOGS"
 const ENV_VAR_VIRT_LAUNCHER_LOG_VERBOSITY = "VIRT_LAUNCHER_LOG_VERBOSITY"
+const ENV_VAR_VIRT_LAUNCHER_LOG_FORMAT = "VIRT_LAUNCHER_LOG_FORMAT"
 const ENV_VAR_SHARED_FILESYSTEM_PATHS = "SHARED_FILESYSTEM_PATHS"
 
 const ENV_VAR_POD_NAME = "POD_NAME"
@@ -142,6 +145,9 @@ type TemplateService interface {
 	RenderHotplugAttachmentTriggerPodTemplate(volume *v1.Volume, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance, pvcName string, isBlock bool, tempPod bool) (*k8sv1.Pod, error)
 	RenderLaunchManifestNoVm(*v1.VirtualMachineInstance) (*k8sv1.Pod, error)
 	RenderExporterManifest(vmExport *exportv1.VirtualMachineExport, namePrefix string) *k8sv1.Pod
+	RenderSnapshotArchiverManifest(snapshotExport *exportv1.VirtualMachineSnapshotExport, namePrefix string) *k8sv1.Pod
+	RenderSnapshotReplicatorManifest(replication *exportv1.VirtualMachineSnapshotReplication, namePrefix string) *k8sv1.Pod
+	RenderImporterManifest(vmImport *importv1.VirtualMachineImport, namePrefix string) *k8sv1.Pod
 	GetLauncherImage() string
 	IsPPC64() bool
 }
@@ -469,6 +475,10 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		compute.Env = append(compute.Env, k8sv1.EnvVar{Name: ENV_VAR_VIRT_LAUNCHER_LOG_VERBOSITY, Value: verbosityStr})
 	}
 
+	if logFormat := t.clusterConfig.GetLogFormat(); logFormat != v1.LogFormatJSON {
+		compute.Env = append(compute.Env, k8sv1.EnvVar{Name: ENV_VAR_VIRT_LAUNCHER_LOG_FORMAT, Value: string(logFormat)})
+	}
+
 	if labelValue, ok := vmi.Labels[debugLogs]; (ok && strings.EqualFold(labelValue, "true")) || virtLauncherLogVerbosity > EXT_LOG_VERBOSITY_THRESHOLD {
 		compute.Env = append(compute.Env, k8sv1.EnvVar{Name: ENV_VAR_LIBVIRT_DEBUG_LOGS, Value: "1"})
 	}
@@ -506,8 +516,12 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 
 	var sidecarVolumes []k8sv1.Volume
 	for i, requestedHookSidecar := range requestedHookSidecarList {
+		containerName := requestedHookSidecar.ContainerName
+		if containerName == "" {
+			containerName = sidecarContainerName(i)
+		}
 		sidecarContainer := newSidecarContainerRenderer(
-			sidecarContainerName(i), vmi, sidecarResources(vmi, t.clusterConfig), requestedHookSidecar, userId).Render(requestedHookSidecar.Command)
+			containerName, vmi, sidecarResources(vmi, t.clusterConfig), requestedHookSidecar, userId).Render(requestedHookSidecar.Command)
 
 		if requestedHookSidecar.ConfigMap != nil {
 			cm, err := t.virtClient.CoreV1().ConfigMaps(vmi.Namespace).Get(context.TODO(), requestedHookSidecar.ConfigMap.Name, metav1.GetOptions{})
@@ -700,6 +714,10 @@ func (t *templateService) newNodeSelectorRenderer(vmi *v1.VirtualMachineInstance
 		opts = append(opts, WithMachineType(machineType))
 	}
 
+	if diskLabels := DiskLabelsFromDisks(vmi); len(diskLabels) > 0 {
+		opts = append(opts, WithDiskLabels(diskLabels...))
+	}
+
 	if topology.IsManualTSCFrequencyRequired(vmi) {
 		opts = append(opts, WithTSCTimer(vmi.Status.TopologyHints.TSCFrequency))
 	}
@@ -830,6 +848,7 @@ func (t *templateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, name
 		withVMIVolumes(t.persistentVolumeClaimStore, vmi.Spec.Volumes, vmi.Status.VolumeStatus),
 		withAccessCredentials(vmi.Spec.AccessCredentials),
 		withBackendStorage(vmi, backendStoragePVCName),
+		withSysprepConfigMapCopies(vmi),
 	}
 	if imageVolumeFeatureGateEnabled {
 		volumeOpts = append(volumeOpts, withImageVolumes(vmi))
@@ -1218,6 +1237,142 @@ func (t *templateService) RenderExporterManifest(vmExport *exportv1.VirtualMachi
 	return exporterPod
 }
 
+// RenderSnapshotArchiverManifest renders the pod archiving the volumes of a snapshot, which runs the exporter image
+func (t *templateService) RenderSnapshotArchiverManifest(snapshotExport *exportv1.VirtualMachineSnapshotExport, namePrefix string) *k8sv1.Pod {
+	return &k8sv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      naming.GetName(namePrefix, snapshotExport.Name, validation.DNS1035LabelMaxLength),
+			Namespace: snapshotExport.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(snapshotExport, schema.GroupVersionKind{
+					Group:   exportv1.SchemeGroupVersion.Group,
+					Version: exportv1.SchemeGroupVersion.Version,
+					Kind:    "VirtualMachineSnapshotExport",
+				}),
+			},
+			Labels: map[string]string{
+				v1.AppLabel: virtExporter,
+			},
+		},
+		Spec: k8sv1.PodSpec{
+			RestartPolicy: k8sv1.RestartPolicyNever,
+			Containers: []k8sv1.Container{
+				{
+					Name:            "archiver",
+					Image:           t.exporterImage,
+					ImagePullPolicy: t.clusterConfig.GetImagePullPolicy(),
+					Env: []k8sv1.EnvVar{
+						{
+							Name: "POD_NAME",
+							ValueFrom: &k8sv1.EnvVarSource{
+								FieldRef: &k8sv1.ObjectFieldSelector{
+									FieldPath: "metadata.name",
+								},
+							},
+						},
+					},
+					SecurityContext: &k8sv1.SecurityContext{
+						AllowPrivilegeEscalation: pointer.P(false),
+						Capabilities:             &k8sv1.Capabilities{Drop: []k8sv1.Capability{"ALL"}},
+					},
+					Resources: vmExportContainerResourceRequirements(t.clusterConfig),
+				},
+			},
+		},
+	}
+}
+
+// RenderSnapshotReplicatorManifest renders the pod replicating a snapshot to a peer cluster, which runs the exporter image
+func (t *templateService) RenderSnapshotReplicatorManifest(replication *exportv1.VirtualMachineSnapshotReplication, namePrefix string) *k8sv1.Pod {
+	return &k8sv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      naming.GetName(namePrefix, replication.Name, validation.DNS1035LabelMaxLength),
+			Namespace: replication.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(replication, schema.GroupVersionKind{
+					Group:   exportv1.SchemeGroupVersion.Group,
+					Version: exportv1.SchemeGroupVersion.Version,
+					Kind:    "VirtualMachineSnapshotReplication",
+				}),
+			},
+			Labels: map[string]string{
+				v1.AppLabel: virtExporter,
+			},
+		},
+		Spec: k8sv1.PodSpec{
+			RestartPolicy: k8sv1.RestartPolicyNever,
+			Containers: []k8sv1.Container{
+				{
+					Name:            "replicator",
+					Image:           t.exporterImage,
+					ImagePullPolicy: t.clusterConfig.GetImagePullPolicy(),
+					Env: []k8sv1.EnvVar{
+						{
+							Name: "POD_NAME",
+							ValueFrom: &k8sv1.EnvVarSource{
+								FieldRef: &k8sv1.ObjectFieldSelector{
+									FieldPath: "metadata.name",
+								},
+							},
+						},
+					},
+					SecurityContext: &k8sv1.SecurityContext{
+						AllowPrivilegeEscalation: pointer.P(false),
+						Capabilities:             &k8sv1.Capabilities{Drop: []k8sv1.Capability{"ALL"}},
+					},
+					Resources: vmExportContainerResourceRequirements(t.clusterConfig),
+				},
+			},
+		},
+	}
+}
+
+// RenderImporterManifest renders the pod reading the description, or serving the disks, of an imported
+// virtual machine, which runs the exporter image
+func (t *templateService) RenderImporterManifest(vmImport *importv1.VirtualMachineImport, namePrefix string) *k8sv1.Pod {
+	return &k8sv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      naming.GetName(namePrefix, vmImport.Name, validation.DNS1035LabelMaxLength),
+			Namespace: vmImport.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vmImport, schema.GroupVersionKind{
+					Group:   importv1.SchemeGroupVersion.Group,
+					Version: importv1.SchemeGroupVersion.Version,
+					Kind:    "VirtualMachineImport",
+				}),
+			},
+			Labels: map[string]string{
+				v1.AppLabel: virtExporter,
+			},
+		},
+		Spec: k8sv1.PodSpec{
+			RestartPolicy: k8sv1.RestartPolicyNever,
+			Containers: []k8sv1.Container{
+				{
+					Name:            "importer",
+					Image:           t.exporterImage,
+					ImagePullPolicy: t.clusterConfig.GetImagePullPolicy(),
+					Env: []k8sv1.EnvVar{
+						{
+							Name: "POD_NAME",
+							ValueFrom: &k8sv1.EnvVarSource{
+								FieldRef: &k8sv1.ObjectFieldSelector{
+									FieldPath: "metadata.name",
+								},
+							},
+						},
+					},
+					SecurityContext: &k8sv1.SecurityContext{
+						AllowPrivilegeEscalation: pointer.P(false),
+						Capabilities:             &k8sv1.Capabilities{Drop: []k8sv1.Capability{"ALL"}},
+					},
+					Resources: vmExportContainerResourceRequirements(t.clusterConfig),
+				},
+			},
+		},
+	}
+}
+
 func appendUniqueImagePullSecret(secrets []k8sv1.LocalObjectReference, newsecret k8sv1.LocalObjectReference) []k8sv1.LocalObjectReference {
 	for _, oldsecret := range secrets {
 		if oldsecret == newsecret {
@@ -1527,6 +1682,9 @@ func (t *templateService) VMIResourcePredicates(vmi *v1.VirtualMachineInstance,
 			}, WithHostDevicesDRA(vmi.Spec.Domain.Devices.HostDevices)),
 			NewVMIResourceRule(util.IsSEVVMI, WithSEV()),
 			NewVMIResourceRule(reservation.HasVMIPersistentReservation, WithPersistentReservation()),
+			NewVMIResourceRule(func(vmi *v1.VirtualMachineInstance) bool {
+				return len(vhostuserblk.GetResourceNames(&vmi.Spec)) > 0
+			}, WithVhostUserBlkResources(vhostuserblk.GetResourceNames(&vmi.Spec))),
 		},
 	}
 }
@@ -663,6 +663,30 @@ var _ = Describe("Template", func() {
 			Entry("not defined when debug annotation is off", "false", []string{"0", ""}),
 		)
 
+		DescribeTable("should set the log format of virt-launcher", func(logging *v1.LoggingConfiguration, expectedFormat string) {
+			config, kvStore, svc = configFactory(defaultArch)
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.Logging = logging
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+			vmi := v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: "default", UID: "1234"},
+			}
+			pod, err := svc.RenderLaunchManifest(&vmi)
+			Expect(err).ToNot(HaveOccurred())
+			logFormat := ""
+			for _, ev := range pod.Spec.Containers[0].Env {
+				if ev.Name == ENV_VAR_VIRT_LAUNCHER_LOG_FORMAT {
+					logFormat = ev.Value
+					break
+				}
+			}
+			Expect(logFormat).To(Equal(expectedFormat))
+		},
+			Entry("not with the default format", nil, ""),
+			Entry("with the text format", &v1.LoggingConfiguration{Format: v1.LogFormatText}, "text"),
+		)
+
 		Context("without debug log annotation", func() {
 			It("should NOT add the corresponding environment variable", func() {
 				config, kvStore, svc = configFactory(defaultArch)
@@ -2385,6 +2409,58 @@ var _ = Describe("Template", func() {
 				Entry("on amd64", "amd64", 282),
 				Entry("on arm64", "arm64", 416),
 			)
+			It("should request the regular memory of the guest as memory instead of hugepages", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				newVMI := func() *v1.VirtualMachineInstance {
+					return &v1.VirtualMachineInstance{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "testvmi",
+							Namespace: "default",
+							UID:       "1234",
+						},
+						Spec: v1.VirtualMachineInstanceSpec{
+							Domain: v1.DomainSpec{
+								Devices: v1.Devices{
+									DisableHotplug: true,
+								},
+								Memory: &v1.Memory{
+									Hugepages: &v1.Hugepages{
+										PageSize: "2Mi",
+									},
+								},
+								Resources: v1.ResourceRequirements{
+									Requests: k8sv1.ResourceList{
+										k8sv1.ResourceMemory: resource.MustParse("64Mi"),
+									},
+									Limits: k8sv1.ResourceList{
+										k8sv1.ResourceMemory: resource.MustParse("64Mi"),
+									},
+								},
+							},
+						},
+					}
+				}
+
+				hugepagesOnlyPod, err := svc.RenderLaunchManifest(newVMI())
+				Expect(err).ToNot(HaveOccurred())
+
+				vmi := newVMI()
+				regularMemory := resource.MustParse("16Mi")
+				vmi.Spec.Domain.Memory.Hugepages.RegularMemory = &regularMemory
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				expectedMemory := hugepagesOnlyPod.Spec.Containers[0].Resources.Requests.Memory().DeepCopy()
+				expectedMemory.Add(regularMemory)
+				Expect(pod.Spec.Containers[0].Resources.Requests.Memory().Value()).To(Equal(expectedMemory.Value()))
+				Expect(pod.Spec.Containers[0].Resources.Limits.Memory().Value()).To(Equal(expectedMemory.Value()))
+
+				hugepageType := k8sv1.ResourceName(k8sv1.ResourceHugePagesPrefix + "2Mi")
+				hugepagesRequest := pod.Spec.Containers[0].Resources.Requests[hugepageType]
+				hugepagesLimit := pod.Spec.Containers[0].Resources.Limits[hugepageType]
+				Expect(hugepagesRequest.Value()).To(Equal(int64(48 * 1024 * 1024)))
+				Expect(hugepagesLimit.Value()).To(Equal(int64(48 * 1024 * 1024)))
+			})
 		})
 
 		Context("with file mode pvc source", func() {
@@ -2912,6 +2988,61 @@ var _ = Describe("Template", func() {
 			}))
 		})
 
+		It("should name the sidecar containers of the spec hooks after the hooks", func() {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVWithCPUArch(kv, defaultArch)
+			svc = NewTemplateService("kubevirt/virt-launcher",
+				240,
+				"/var/run/kubevirt",
+				"/var/run/kubevirt-ephemeral-disks",
+				"/var/run/kubevirt/container-disks",
+				v1.HotplugDiskDir,
+				"pull-secret-1",
+				pvcCache,
+				virtClient,
+				config,
+				qemuGid,
+				"kubevirt/vmexport",
+				resourceQuotaStore,
+				namespaceStore,
+				WithSidecarCreator(testSidecarCreator),
+				WithSidecarCreator(func(vmi *v1.VirtualMachineInstance, _ *v1.KubeVirtConfiguration) (hooks.HookSidecarList, error) {
+					return hooks.SpecHookSidecarList(vmi), nil
+				}),
+				WithNetBindingPluginMemoryCalculator(&stubNetBindingPluginMemoryCalculator{}),
+			)
+			vmi := v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "testvmi", Namespace: "default", UID: "1234",
+				},
+				Spec: v1.VirtualMachineInstanceSpec{
+					Hooks: []v1.Hook{{
+						Name:       "smbios",
+						Image:      "smbios-hook:v1",
+						Args:       []string{"--version", "v1alpha4"},
+						HookPoints: []v1.HookPoint{v1.OnDefineDomainHookPoint},
+					}},
+				},
+			}
+			pod, err := svc.RenderLaunchManifest(&vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pod.Spec.Containers[0].Command).To(ContainElements("--hook-sidecars", "2"))
+			Expect(pod.Spec.Containers).To(HaveLen(3))
+			Expect(pod.Spec.Containers[1].Name).To(Equal("hook-sidecar-0"))
+			Expect(pod.Spec.Containers[2].Name).To(Equal("hook-smbios"))
+			Expect(pod.Spec.Containers[2].Image).To(Equal("smbios-hook:v1"))
+			Expect(pod.Spec.Containers[2].Args).To(Equal([]string{"--version", "v1alpha4"}))
+			Expect(pod.Spec.Containers[2].VolumeMounts[0]).To(Equal(k8sv1.VolumeMount{
+				Name:      hookSidecarSocks,
+				MountPath: hooks.HookSocketsSharedDirectory,
+				SubPath:   "hook-smbios",
+			}))
+			Expect(pod.Spec.Containers[2].Env).To(ContainElement(k8sv1.EnvVar{
+				Name:  hooks.ContainerNameEnvVar,
+				Value: "hook-smbios",
+			}))
+		})
+
 		Context("with pod networking", func() {
 			It("Should require tun device by default", func() {
 				config, kvStore, svc = configFactory(defaultArch)
@@ -16,19 +16,25 @@ import (
 )
 
 func generateVirtioFSContainers(vmi *v1.VirtualMachineInstance, image string, config *virtconfig.ClusterConfig) []k8sv1.Container {
-	passthroughFSVolumes := make(map[string]struct{})
+	passthroughFSVolumes := make(map[string]*v1.Filesystem)
 	for i := range vmi.Spec.Domain.Devices.Filesystems {
-		passthroughFSVolumes[vmi.Spec.Domain.Devices.Filesystems[i].Name] = struct{}{}
+		passthroughFSVolumes[vmi.Spec.Domain.Devices.Filesystems[i].Name] = &vmi.Spec.Domain.Devices.Filesystems[i]
 	}
 	if len(passthroughFSVolumes) == 0 {
 		return nil
 	}
 
+	// virtiofsd of hotplugged filesystems is started by virt-launcher once the volume is mounted
+	hotplugVolumesByName := hotplugVolumes(vmi.Status.VolumeStatus, vmi.Spec.Volumes)
+
 	containers := []k8sv1.Container{}
 	for _, volume := range vmi.Spec.Volumes {
-		if _, isPassthroughFSVolume := passthroughFSVolumes[volume.Name]; isPassthroughFSVolume {
+		if _, isHotplugVolume := hotplugVolumesByName[volume.Name]; isHotplugVolume {
+			continue
+		}
+		if fs, isPassthroughFSVolume := passthroughFSVolumes[volume.Name]; isPassthroughFSVolume {
 			resources := resourcesForVirtioFSContainer(vmi.IsCPUDedicated(), vmi.IsCPUDedicated() || vmi.WantsToHaveQOSGuaranteed(), config)
-			container := generateContainerFromVolume(&volume, image, resources)
+			container := generateContainerFromVolume(&volume, fs, image, resources)
 			containers = append(containers, container)
 
 		}
@@ -91,22 +97,9 @@ func virtioFSMountPoint(volume *v1.Volume) string {
 	return volumeMountPoint
 }
 
-func generateContainerFromVolume(volume *v1.Volume, image string, resources k8sv1.ResourceRequirements) k8sv1.Container {
-
-	socketPathArg := fmt.Sprintf("--socket-path=%s", virtiofs.VirtioFSSocketPath(volume.Name))
-	sourceArg := fmt.Sprintf("--shared-dir=%s", virtioFSMountPoint(volume))
-
-	args := []string{socketPathArg, sourceArg, "--sandbox=none", "--cache=auto"}
-
-	// If some files cannot be migrated, let's allow the migration to finish.
-	// Mark these files as invalid, the guest will not be able to access any such files,
-	// receiving only errors
-	args = append(args, "--migration-on-error=guest-error")
+func generateContainerFromVolume(volume *v1.Volume, fs *v1.Filesystem, image string, resources k8sv1.ResourceRequirements) k8sv1.Container {
 
-	// This mode look up its file references paths by reading the symlinks in /proc/self/fd,
-	// falling back to iterating through the shared directory (exhaustive search) to find those paths.
-	// This migration mode doesn't require any privileges.
-	args = append(args, "--migration-mode=find-paths")
+	args := virtiofs.VirtiofsdArgs(virtiofs.VirtioFSSocketPath(volume.Name), virtioFSMountPoint(volume), fs.Virtiofs)
 
 	volumeMounts := []k8sv1.VolumeMount{
 		// This is required to pass socket to compute
@@ -127,7 +120,7 @@ func generateContainerFromVolume(volume *v1.Volume, image string, resources k8sv
 		Name:            fmt.Sprintf("virtiofs-%s", volume.Name),
 		Image:           image,
 		ImagePullPolicy: k8sv1.PullIfNotPresent,
-		Command:         []string{"/usr/libexec/virtiofsd"},
+		Command:         []string{virtiofs.VirtiofsdPath},
 		Args:            args,
 		VolumeMounts:    volumeMounts,
 		Resources:       resources,
@@ -8,6 +8,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/api"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
@@ -93,4 +94,44 @@ var _ = Describe("virtiofs container", func() {
 		Expect(container[1].SecurityContext.RunAsNonRoot).To(HaveValue(BeTrue()))
 		Expect(container[1].SecurityContext.AllowPrivilegeEscalation).To(HaveValue(BeFalse()))
 	})
+
+	It("should apply the virtiofs tuning of the filesystem", func() {
+		vmi := api.NewMinimalVMI("testvm")
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+			Name: "sharedtestdisk",
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: testutils.NewFakePersistentVolumeSource(),
+			},
+		})
+		vmi.Spec.Domain.Devices.Filesystems = append(vmi.Spec.Domain.Devices.Filesystems, v1.Filesystem{
+			Name: "sharedtestdisk",
+			Virtiofs: &v1.FilesystemVirtiofs{
+				Cache:          v1.VirtiofsCacheNever,
+				ThreadPoolSize: pointer.P(uint32(8)),
+			},
+		})
+
+		container := generateVirtioFSContainers(vmi, "virtiofs-container", config)
+		Expect(container).To(HaveLen(1))
+		Expect(container[0].Args).To(ContainElements("--cache=never", "--thread-pool-size=8"))
+		Expect(container[0].Args).ToNot(ContainElement("--cache=auto"))
+	})
+
+	It("should not create containers for hotplugged filesystems", func() {
+		vmi := api.NewMinimalVMI("testvm")
+		pvcSource := testutils.NewFakePersistentVolumeSource()
+		pvcSource.Hotpluggable = true
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+			Name: "hotplugged",
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: pvcSource,
+			},
+		})
+		vmi.Spec.Domain.Devices.Filesystems = append(vmi.Spec.Domain.Devices.Filesystems, v1.Filesystem{
+			Name:     "hotplugged",
+			Virtiofs: &v1.FilesystemVirtiofs{},
+		})
+
+		Expect(generateVirtioFSContainers(vmi, "virtiofs-container", config)).To(BeEmpty())
+	})
 })
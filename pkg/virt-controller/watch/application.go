@@ -37,13 +37,17 @@ import (
 
 	clone "kubevirt.io/api/clone/v1beta1"
 
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/autoscaling"
 	clonecontroller "kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/migration"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/node"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/pool"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/rebalance"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/replicaset"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/resourcequota"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vm"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vmi"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/watchdog"
 
 	"github.com/emicklei/go-restful/v3"
 	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
@@ -64,6 +68,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/dra"
 
+	"kubevirt.io/kubevirt/pkg/util/metricsapi"
 	"kubevirt.io/kubevirt/pkg/util/ratelimiter"
 
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
@@ -83,17 +88,23 @@ import (
 	clusterutil "kubevirt.io/kubevirt/pkg/util/cluster"
 
 	instancetypecontroller "kubevirt.io/kubevirt/pkg/instancetype/controller/vm"
+	instancetypefind "kubevirt.io/kubevirt/pkg/instancetype/find"
 	clientmetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/common/client"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/service"
+	"kubevirt.io/kubevirt/pkg/storage/export/archive"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
+	"kubevirt.io/kubevirt/pkg/storage/export/replication"
+	"kubevirt.io/kubevirt/pkg/storage/export/vmimport"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
+	"kubevirt.io/kubevirt/pkg/storage/volumemigration"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/leaderelectionconfig"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/vmdisruptionbudget"
 	workloadupdater "kubevirt.io/kubevirt/pkg/virt-controller/watch/workload-updater"
 
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
@@ -169,15 +180,25 @@ type VirtControllerApp struct {
 
 	pdbInformer cache.SharedIndexInformer
 
+	vmDisruptionBudgetInformer cache.SharedIndexInformer
+
 	persistentVolumeClaimCache    cache.Store
 	persistentVolumeClaimInformer cache.SharedIndexInformer
 
+	configMapInformer cache.SharedIndexInformer
+
 	rsController *replicaset.Controller
 	rsInformer   cache.SharedIndexInformer
 
 	poolController *pool.Controller
 	poolInformer   cache.SharedIndexInformer
 
+	autoscalingController       *autoscaling.Controller
+	vmAutoscalingPolicyInformer cache.SharedIndexInformer
+
+	resourceQuotaController *resourcequota.Controller
+	vmResourceQuotaInformer cache.SharedIndexInformer
+
 	vmController *vm.Controller
 	vmInformer   cache.SharedIndexInformer
 
@@ -194,26 +215,47 @@ type VirtControllerApp struct {
 
 	workloadUpdateController *workloadupdater.WorkloadUpdateController
 
-	caExportConfigMapInformer    cache.SharedIndexInformer
-	exportRouteConfigMapInformer cache.SharedInformer
-	exportServiceInformer        cache.SharedIndexInformer
-	exportController             *export.VMExportController
-	snapshotController           *snapshot.VMSnapshotController
-	restoreController            *snapshot.VMRestoreController
-	vmExportInformer             cache.SharedIndexInformer
-	routeCache                   cache.Store
-	ingressCache                 cache.Store
-	unmanagedSecretInformer      cache.SharedIndexInformer
-	vmSnapshotInformer           cache.SharedIndexInformer
-	vmSnapshotContentInformer    cache.SharedIndexInformer
-	vmRestoreInformer            cache.SharedIndexInformer
-	storageClassInformer         cache.SharedIndexInformer
-	allPodInformer               cache.SharedIndexInformer
-	resourceQuotaInformer        cache.SharedIndexInformer
+	caExportConfigMapInformer      cache.SharedIndexInformer
+	exportRouteConfigMapInformer   cache.SharedInformer
+	exportServiceInformer          cache.SharedIndexInformer
+	exportController               *export.VMExportController
+	snapshotExportController       *archive.VMSnapshotExportController
+	snapshotReplicationController  *replication.VMSnapshotReplicationController
+	importController               *vmimport.VMImportController
+	snapshotController             *snapshot.VMSnapshotController
+	restoreController              *snapshot.VMRestoreController
+	snapshotScheduleController     *snapshot.VMSnapshotScheduleController
+	snapshotGroupController        *snapshot.VMSnapshotGroupController
+	vmExportInformer               cache.SharedIndexInformer
+	vmSnapshotExportInformer       cache.SharedIndexInformer
+	vmSnapshotReplicationInformer  cache.SharedIndexInformer
+	vmImportInformer               cache.SharedIndexInformer
+	routeCache                     cache.Store
+	ingressCache                   cache.Store
+	unmanagedSecretInformer        cache.SharedIndexInformer
+	vmSnapshotInformer             cache.SharedIndexInformer
+	vmSnapshotContentInformer      cache.SharedIndexInformer
+	vmRestoreInformer              cache.SharedIndexInformer
+	vmSnapshotScheduleInformer     cache.SharedIndexInformer
+	vmSnapshotGroupInformer        cache.SharedIndexInformer
+	vmSnapshotGroupRestoreInformer cache.SharedIndexInformer
+	storageClassInformer           cache.SharedIndexInformer
+	priorityClassInformer          cache.SharedIndexInformer
+	allPodInformer                 cache.SharedIndexInformer
+	resourceQuotaInformer          cache.SharedIndexInformer
 
 	crdInformer cache.SharedIndexInformer
 
-	migrationPolicyInformer cache.SharedIndexInformer
+	migrationPolicyInformer      cache.SharedIndexInformer
+	migrationRetryBudgetInformer cache.SharedIndexInformer
+
+	rebalanceController              *rebalance.Controller
+	migrationRebalancePolicyInformer cache.SharedIndexInformer
+
+	watchdogController *watchdog.Controller
+
+	volumeMigrationInformer   cache.SharedIndexInformer
+	volumeMigrationController *volumemigration.VolumeMigrationController
 
 	vmCloneInformer   cache.SharedIndexInformer
 	vmCloneController *clonecontroller.VMCloneController
@@ -225,19 +267,20 @@ type VirtControllerApp struct {
 
 	LeaderElection leaderelectionconfig.Configuration
 
-	launcherImage              string
-	exporterImage              string
-	launcherQemuTimeout        int
-	imagePullSecret            string
-	virtShareDir               string
-	ephemeralDiskDir           string
-	containerDiskDir           string
-	hotplugDiskDir             string
-	readyChan                  chan bool
-	kubevirtNamespace          string
-	host                       string
-	evacuationController       *evacuation.EvacuationController
-	disruptionBudgetController *disruptionbudget.DisruptionBudgetController
+	launcherImage                string
+	exporterImage                string
+	launcherQemuTimeout          int
+	imagePullSecret              string
+	virtShareDir                 string
+	ephemeralDiskDir             string
+	containerDiskDir             string
+	hotplugDiskDir               string
+	readyChan                    chan bool
+	kubevirtNamespace            string
+	host                         string
+	evacuationController         *evacuation.EvacuationController
+	disruptionBudgetController   *disruptionbudget.DisruptionBudgetController
+	vmDisruptionBudgetController *vmdisruptionbudget.Controller
 
 	ctx context.Context
 
@@ -249,21 +292,32 @@ type VirtControllerApp struct {
 	reInitChan chan string
 
 	// number of threads for each controller
-	nodeControllerThreads             int
-	vmiControllerThreads              int
-	draStatusControllerThreads        int
-	rsControllerThreads               int
-	poolControllerThreads             int
-	vmControllerThreads               int
-	migrationControllerThreads        int
-	evacuationControllerThreads       int
-	disruptionBudgetControllerThreads int
-	launcherSubGid                    int64
-	exportControllerThreads           int
-	snapshotControllerThreads         int
-	restoreControllerThreads          int
-	snapshotControllerResyncPeriod    time.Duration
-	cloneControllerThreads            int
+	nodeControllerThreads                int
+	vmiControllerThreads                 int
+	draStatusControllerThreads           int
+	rsControllerThreads                  int
+	poolControllerThreads                int
+	autoscalingControllerThreads         int
+	resourceQuotaControllerThreads       int
+	vmControllerThreads                  int
+	migrationControllerThreads           int
+	evacuationControllerThreads          int
+	rebalanceControllerThreads           int
+	watchdogControllerThreads            int
+	disruptionBudgetControllerThreads    int
+	vmDisruptionBudgetControllerThreads  int
+	launcherSubGid                       int64
+	exportControllerThreads              int
+	snapshotExportControllerThreads      int
+	snapshotReplicationControllerThreads int
+	importControllerThreads              int
+	volumeMigrationControllerThreads     int
+	snapshotControllerThreads            int
+	restoreControllerThreads             int
+	snapshotScheduleControllerThreads    int
+	snapshotGroupControllerThreads       int
+	snapshotControllerResyncPeriod       time.Duration
+	cloneControllerThreads               int
 
 	caConfigMapName          string
 	promCertFilePath         string
@@ -385,11 +439,16 @@ func Execute() {
 
 	app.rsInformer = app.informerFactory.VMIReplicaSet()
 	app.poolInformer = app.informerFactory.VMPool()
+	app.vmAutoscalingPolicyInformer = app.informerFactory.VMAutoscalingPolicy()
+	app.vmResourceQuotaInformer = app.informerFactory.VMResourceQuota()
 
 	app.persistentVolumeClaimInformer = app.informerFactory.PersistentVolumeClaim()
 	app.persistentVolumeClaimCache = app.persistentVolumeClaimInformer.GetStore()
 
+	app.configMapInformer = app.informerFactory.ConfigMap()
+
 	app.pdbInformer = app.informerFactory.K8SInformerFactory().Policy().V1().PodDisruptionBudgets().Informer()
+	app.vmDisruptionBudgetInformer = app.informerFactory.VMDisruptionBudget()
 
 	app.vmInformer = app.informerFactory.VirtualMachine()
 
@@ -398,10 +457,17 @@ func Execute() {
 	app.controllerRevisionInformer = app.informerFactory.ControllerRevision()
 
 	app.vmExportInformer = app.informerFactory.VirtualMachineExport()
+	app.vmSnapshotExportInformer = app.informerFactory.VirtualMachineSnapshotExport()
+	app.vmSnapshotReplicationInformer = app.informerFactory.VirtualMachineSnapshotReplication()
+	app.vmImportInformer = app.informerFactory.VirtualMachineImport()
 	app.vmSnapshotInformer = app.informerFactory.VirtualMachineSnapshot()
 	app.vmSnapshotContentInformer = app.informerFactory.VirtualMachineSnapshotContent()
 	app.vmRestoreInformer = app.informerFactory.VirtualMachineRestore()
+	app.vmSnapshotScheduleInformer = app.informerFactory.VirtualMachineSnapshotSchedule()
+	app.vmSnapshotGroupInformer = app.informerFactory.VirtualMachineSnapshotGroup()
+	app.vmSnapshotGroupRestoreInformer = app.informerFactory.VirtualMachineSnapshotGroupRestore()
 	app.storageClassInformer = app.informerFactory.StorageClass()
+	app.priorityClassInformer = app.informerFactory.PriorityClass()
 	app.caExportConfigMapInformer = app.informerFactory.KubeVirtExportCAConfigMap()
 	app.exportRouteConfigMapInformer = app.informerFactory.ExportRouteConfigMap()
 	app.unmanagedSecretInformer = app.informerFactory.UnmanagedSecrets()
@@ -441,6 +507,9 @@ func Execute() {
 	}
 	app.ingressCache = app.informerFactory.Ingress().GetStore()
 	app.migrationPolicyInformer = app.informerFactory.MigrationPolicy()
+	app.migrationRetryBudgetInformer = app.informerFactory.MigrationRetryBudget()
+	app.migrationRebalancePolicyInformer = app.informerFactory.MigrationRebalancePolicy()
+	app.volumeMigrationInformer = app.informerFactory.VolumeMigration()
 
 	app.vmCloneInformer = app.informerFactory.VirtualMachineClone()
 
@@ -479,12 +548,23 @@ func Execute() {
 	app.initCommon()
 	app.initReplicaSet()
 	app.initPool()
+	app.initAutoscalingController()
+	app.initResourceQuotaController()
 	app.initVirtualMachines()
 	app.initDisruptionBudgetController()
+	app.initVMDisruptionBudgetController()
 	app.initEvacuationController()
+	app.initRebalanceController()
+	app.initWatchdogController()
 	app.initSnapshotController()
 	app.initRestoreController()
+	app.initSnapshotScheduleController()
+	app.initSnapshotGroupController()
 	app.initExportController()
+	app.initSnapshotExportController()
+	app.initSnapshotReplicationController()
+	app.initImportController()
+	app.initVolumeMigrationController()
 	app.initWorkloadUpdaterController()
 	app.initCloneController()
 	go app.Run()
@@ -527,8 +607,12 @@ func (vca *VirtControllerApp) shouldChangeRateLimiter() {
 	log.Log.V(2).Infof("setting rate limiter to %v QPS and %v Burst", qps, burst)
 }
 
-// Update virt-controller log verbosity on relevant config changes
+// Update virt-controller log format and verbosity on relevant config changes
 func (vca *VirtControllerApp) shouldChangeLogVerbosity() {
+	if err := log.SetFormat(string(vca.clusterConfig.GetLogFormat())); err != nil {
+		log.Log.Warningf("failed to update the log format: %v", err)
+	}
+	log.SetSubcomponentVerbosity(vca.clusterConfig.GetSubcomponentVerbosity())
 	verbosity := vca.clusterConfig.GetVirtControllerVerbosity(vca.host)
 	if err := log.Log.SetVerbosityLevel(int(verbosity)); err != nil {
 		log.Log.Warningf("failed up update log verbosity to %d: %v", verbosity, err)
@@ -601,7 +685,22 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 		}
 
 		go vca.evacuationController.Run(vca.evacuationControllerThreads, stop)
+		go func() {
+			if err := vca.rebalanceController.Run(vca.rebalanceControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the migration rebalance controller: %v", err)
+			}
+		}()
+		go func() {
+			if err := vca.watchdogController.Run(vca.watchdogControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the watchdog controller: %v", err)
+			}
+		}()
 		go vca.disruptionBudgetController.Run(vca.disruptionBudgetControllerThreads, stop)
+		go func() {
+			if err := vca.vmDisruptionBudgetController.Run(vca.vmDisruptionBudgetControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the vm disruption budget controller: %v", err)
+			}
+		}()
 		go vca.nodeController.Run(vca.nodeControllerThreads, stop)
 		go vca.vmiController.Run(vca.vmiControllerThreads, stop)
 		if vca.isDRAEnabled {
@@ -609,6 +708,16 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 		}
 		go vca.rsController.Run(vca.rsControllerThreads, stop)
 		go vca.poolController.Run(vca.poolControllerThreads, stop)
+		go func() {
+			if err := vca.autoscalingController.Run(vca.autoscalingControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the vm autoscaling controller: %v", err)
+			}
+		}()
+		go func() {
+			if err := vca.resourceQuotaController.Run(vca.resourceQuotaControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the vm resource quota controller: %v", err)
+			}
+		}()
 		go vca.vmController.Run(vca.vmControllerThreads, stop)
 		go vca.migrationController.Run(vca.migrationControllerThreads, stop)
 		go func() {
@@ -621,11 +730,41 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 				log.Log.Warningf("error running the restore controller: %v", err)
 			}
 		}()
+		go func() {
+			if err := vca.snapshotScheduleController.Run(vca.snapshotScheduleControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the snapshot schedule controller: %v", err)
+			}
+		}()
+		go func() {
+			if err := vca.snapshotGroupController.Run(vca.snapshotGroupControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the snapshot group controller: %v", err)
+			}
+		}()
 		go func() {
 			if err := vca.exportController.Run(vca.exportControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the export controller: %v", err)
 			}
 		}()
+		go func() {
+			if err := vca.snapshotExportController.Run(vca.snapshotExportControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the snapshot export controller: %v", err)
+			}
+		}()
+		go func() {
+			if err := vca.snapshotReplicationController.Run(vca.snapshotReplicationControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the snapshot replication controller: %v", err)
+			}
+		}()
+		go func() {
+			if err := vca.importController.Run(vca.importControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the vm import controller: %v", err)
+			}
+		}()
+		go func() {
+			if err := vca.volumeMigrationController.Run(vca.volumeMigrationControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the volume migration controller: %v", err)
+			}
+		}()
 		go vca.workloadUpdateController.Run(stop)
 		go vca.nodeTopologyUpdater.Run(vca.nodeTopologyUpdatePeriod, stop)
 		go func() {
@@ -676,6 +815,10 @@ func (vca *VirtControllerApp) initCommon() {
 			func(vmi *v1.VirtualMachineInstance, _ *v1.KubeVirtConfiguration) (hooks.HookSidecarList, error) {
 				return hooks.UnmarshalHookSidecarList(vmi)
 			}),
+		services.WithSidecarCreator(
+			func(vmi *v1.VirtualMachineInstance, _ *v1.KubeVirtConfiguration) (hooks.HookSidecarList, error) {
+				return hooks.SpecHookSidecarList(vmi), nil
+			}),
 		services.WithSidecarCreator(netbinding.NetBindingPluginSidecarList),
 		services.WithNetBindingPluginMemoryCalculator(netbinding.MemoryCalculator{}),
 		services.WithAnnotationsGenerators(netAnnotationsGenerator, storageannotations.Generator{}),
@@ -697,6 +840,7 @@ func (vca *VirtControllerApp) initCommon() {
 		vca.storageProfileInformer,
 		vca.cdiInformer,
 		vca.cdiConfigInformer,
+		vca.configMapInformer,
 		vca.clusterConfig,
 		topologyHinter,
 		netAnnotationsGenerator,
@@ -724,7 +868,7 @@ func (vca *VirtControllerApp) initCommon() {
 	}
 
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "node-controller")
-	vca.nodeController, err = node.NewController(vca.clientSet, vca.nodeInformer, vca.vmiInformer, recorder)
+	vca.nodeController, err = node.NewController(vca.clientSet, vca.nodeInformer, vca.vmiInformer, vca.vmInformer, recorder, vca.clusterConfig)
 	if err != nil {
 		panic(err)
 	}
@@ -743,6 +887,7 @@ func (vca *VirtControllerApp) initCommon() {
 		vca.storageClassInformer,
 		vca.storageProfileInformer,
 		vca.migrationPolicyInformer,
+		vca.migrationRetryBudgetInformer,
 		vca.resourceQuotaInformer,
 		vca.kubeVirtInformer,
 		vca.vmiRecorder,
@@ -774,12 +919,46 @@ func (vca *VirtControllerApp) initPool() {
 		vca.poolInformer,
 		vca.controllerRevisionInformer,
 		recorder,
-		controller.BurstReplicas)
+		controller.BurstReplicas,
+		vca.clusterConfig,
+		metricsapi.NewClient(vca.clientSet))
 	if err != nil {
 		panic(err)
 	}
 }
 
+func (vca *VirtControllerApp) initAutoscalingController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "vm-autoscaling-controller")
+	vca.autoscalingController = &autoscaling.Controller{
+		Client:         vca.clientSet,
+		PolicyInformer: vca.vmAutoscalingPolicyInformer,
+		VMInformer:     vca.vmInformer,
+		VMIInformer:    vca.vmiInformer,
+		Metrics:        metricsapi.NewClient(vca.clientSet),
+		Recorder:       recorder,
+	}
+	if err := vca.autoscalingController.Init(); err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initResourceQuotaController() {
+	vca.resourceQuotaController = &resourcequota.Controller{
+		Client:        vca.clientSet,
+		QuotaInformer: vca.vmResourceQuotaInformer,
+		VMInformer:    vca.vmInformer,
+		InstancetypeFinder: instancetypefind.NewSpecFinder(
+			vca.instancetypeInformer.GetStore(),
+			vca.clusterInstancetypeInformer.GetStore(),
+			vca.controllerRevisionInformer.GetStore(),
+			vca.clientSet,
+		),
+	}
+	if err := vca.resourceQuotaController.Init(); err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initVirtualMachines() {
 	var err error
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "virtualmachine-controller")
@@ -792,6 +971,9 @@ func (vca *VirtControllerApp) initVirtualMachines() {
 		vca.namespaceStore,
 		vca.persistentVolumeClaimInformer,
 		vca.controllerRevisionInformer,
+		vca.kvPodInformer,
+		vca.nodeInformer,
+		vca.priorityClassInformer,
 		recorder,
 		vca.clientSet,
 		vca.clusterConfig,
@@ -813,6 +995,14 @@ func (vca *VirtControllerApp) initVirtualMachines() {
 	if err != nil {
 		panic(err)
 	}
+	if err := vca.vmController.AddInstancetypeEventHandlers(
+		vca.instancetypeInformer,
+		vca.clusterInstancetypeInformer,
+		vca.preferenceInformer,
+		vca.clusterPreferenceInformer,
+	); err != nil {
+		panic(err)
+	}
 }
 
 func (vca *VirtControllerApp) initDisruptionBudgetController() {
@@ -831,6 +1021,18 @@ func (vca *VirtControllerApp) initDisruptionBudgetController() {
 	}
 }
 
+func (vca *VirtControllerApp) initVMDisruptionBudgetController() {
+	vca.vmDisruptionBudgetController = &vmdisruptionbudget.Controller{
+		Client:            vca.clientSet,
+		BudgetInformer:    vca.vmDisruptionBudgetInformer,
+		VMIInformer:       vca.vmiInformer,
+		MigrationInformer: vca.migrationInformer,
+	}
+	if err := vca.vmDisruptionBudgetController.Init(); err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initWorkloadUpdaterController() {
 	var err error
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "workload-update-controller")
@@ -840,6 +1042,8 @@ func (vca *VirtControllerApp) initWorkloadUpdaterController() {
 		vca.kvPodInformer,
 		vca.migrationInformer,
 		vca.kubeVirtInformer,
+		vca.nodeInformer,
+		vca.vmDisruptionBudgetInformer,
 		recorder,
 		vca.clientSet,
 		vca.clusterConfig)
@@ -856,6 +1060,8 @@ func (vca *VirtControllerApp) initEvacuationController() {
 		vca.migrationInformer,
 		vca.nodeInformer,
 		vca.kvPodInformer,
+		vca.namespaceInformer,
+		vca.vmDisruptionBudgetInformer,
 		recorder,
 		vca.clientSet,
 		vca.clusterConfig,
@@ -865,6 +1071,35 @@ func (vca *VirtControllerApp) initEvacuationController() {
 	}
 }
 
+func (vca *VirtControllerApp) initRebalanceController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "migration-rebalance-controller")
+	vca.rebalanceController = &rebalance.Controller{
+		Client:            vca.clientSet,
+		PolicyInformer:    vca.migrationRebalancePolicyInformer,
+		NodeInformer:      vca.nodeInformer,
+		VMIInformer:       vca.vmiInformer,
+		MigrationInformer: vca.migrationInformer,
+		Metrics:           metricsapi.NewClient(vca.clientSet),
+		Recorder:          recorder,
+	}
+	if err := vca.rebalanceController.Init(); err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initWatchdogController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "watchdog-controller")
+	vca.watchdogController = &watchdog.Controller{
+		Client:            vca.clientSet,
+		VMIInformer:       vca.vmiInformer,
+		MigrationInformer: vca.migrationInformer,
+		Recorder:          recorder,
+	}
+	if err := vca.watchdogController.Init(); err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initSnapshotController() {
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "snapshot-controller")
 	vca.snapshotController = &snapshot.VMSnapshotController{
@@ -903,12 +1138,42 @@ func (vca *VirtControllerApp) initRestoreController() {
 		VolumeSnapshotProvider:    vca.snapshotController,
 		Recorder:                  recorder,
 		CRInformer:                vca.controllerRevisionInformer,
+		VMPoolInformer:            vca.poolInformer,
 	}
 	if err := vca.restoreController.Init(); err != nil {
 		panic(err)
 	}
 }
 
+func (vca *VirtControllerApp) initSnapshotScheduleController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "snapshot-schedule-controller")
+	vca.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+		Client:                     vca.clientSet,
+		VMSnapshotScheduleInformer: vca.vmSnapshotScheduleInformer,
+		VMSnapshotInformer:         vca.vmSnapshotInformer,
+		Recorder:                   recorder,
+	}
+	if err := vca.snapshotScheduleController.Init(); err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initSnapshotGroupController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "snapshot-group-controller")
+	vca.snapshotGroupController = &snapshot.VMSnapshotGroupController{
+		Client:                         vca.clientSet,
+		VMSnapshotGroupInformer:        vca.vmSnapshotGroupInformer,
+		VMSnapshotGroupRestoreInformer: vca.vmSnapshotGroupRestoreInformer,
+		VMSnapshotInformer:             vca.vmSnapshotInformer,
+		VMRestoreInformer:              vca.vmRestoreInformer,
+		VMInformer:                     vca.vmInformer,
+		Recorder:                       recorder,
+	}
+	if err := vca.snapshotGroupController.Init(); err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initExportController() {
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "export-controller")
 	vca.exportController = &export.VMExportController{
@@ -944,11 +1209,76 @@ func (vca *VirtControllerApp) initExportController() {
 	}
 }
 
+func (vca *VirtControllerApp) initSnapshotExportController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "snapshot-export-controller")
+	vca.snapshotExportController = &archive.VMSnapshotExportController{
+		Client:                    vca.clientSet,
+		ManifestRenderer:          vca.templateService,
+		VMSnapshotExportInformer:  vca.vmSnapshotExportInformer,
+		VMSnapshotInformer:        vca.vmSnapshotInformer,
+		VMSnapshotContentInformer: vca.vmSnapshotContentInformer,
+		PVCInformer:               vca.persistentVolumeClaimInformer,
+		PodInformer:               vca.allPodInformer,
+		VolumeSnapshotProvider:    vca.snapshotController,
+		Recorder:                  recorder,
+	}
+	if err := vca.snapshotExportController.Init(); err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initSnapshotReplicationController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "snapshot-replication-controller")
+	vca.snapshotReplicationController = &replication.VMSnapshotReplicationController{
+		Client:                        vca.clientSet,
+		ManifestRenderer:              vca.templateService,
+		VMSnapshotReplicationInformer: vca.vmSnapshotReplicationInformer,
+		VMSnapshotInformer:            vca.vmSnapshotInformer,
+		VMExportInformer:              vca.vmExportInformer,
+		PodInformer:                   vca.allPodInformer,
+		Recorder:                      recorder,
+	}
+	if err := vca.snapshotReplicationController.Init(); err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initImportController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "import-controller")
+	vca.importController = &vmimport.VMImportController{
+		Client:             vca.clientSet,
+		ManifestRenderer:   vca.templateService,
+		VMImportInformer:   vca.vmImportInformer,
+		VMInformer:         vca.vmInformer,
+		DataVolumeInformer: vca.dataVolumeInformer,
+		PodInformer:        vca.allPodInformer,
+		Recorder:           recorder,
+	}
+	if err := vca.importController.Init(); err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initVolumeMigrationController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "volume-migration-controller")
+	vca.volumeMigrationController = &volumemigration.VolumeMigrationController{
+		Client:                  vca.clientSet,
+		VolumeMigrationInformer: vca.volumeMigrationInformer,
+		VMInformer:              vca.vmInformer,
+		VMIInformer:             vca.vmiInformer,
+		PVCInformer:             vca.persistentVolumeClaimInformer,
+		Recorder:                recorder,
+	}
+	if err := vca.volumeMigrationController.Init(); err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initCloneController() {
 	var err error
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "clone-controller")
 	vca.vmCloneController, err = clonecontroller.NewVmCloneController(
-		vca.clientSet, vca.vmCloneInformer, vca.vmSnapshotInformer, vca.vmRestoreInformer, vca.vmInformer, vca.vmSnapshotContentInformer, vca.persistentVolumeClaimInformer, recorder,
+		vca.clientSet, vca.vmCloneInformer, vca.vmSnapshotInformer, vca.vmRestoreInformer, vca.vmInformer, vca.vmSnapshotContentInformer, vca.persistentVolumeClaimInformer, vca.storageProfileInformer, recorder,
 	)
 	if err != nil {
 		panic(err)
@@ -1025,6 +1355,12 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.poolControllerThreads, "pool-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for pool controller")
 
+	flag.IntVar(&vca.autoscalingControllerThreads, "autoscaling-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for vm autoscaling controller")
+
+	flag.IntVar(&vca.resourceQuotaControllerThreads, "resource-quota-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for vm resource quota controller")
+
 	flag.IntVar(&vca.vmControllerThreads, "vm-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for vm controller")
 
@@ -1034,9 +1370,18 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.evacuationControllerThreads, "evacuation-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for evacuation controller")
 
+	flag.IntVar(&vca.rebalanceControllerThreads, "rebalance-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for migration rebalance controller")
+
+	flag.IntVar(&vca.watchdogControllerThreads, "watchdog-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for watchdog controller")
+
 	flag.IntVar(&vca.disruptionBudgetControllerThreads, "disruption-budget-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for disruption budget controller")
 
+	flag.IntVar(&vca.vmDisruptionBudgetControllerThreads, "vm-disruption-budget-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for vm disruption budget controller")
+
 	flag.Int64Var(&vca.launcherSubGid, "launcher-subgid", defaultLauncherSubGid,
 		"ID of subgroup to virt-launcher")
 
@@ -1046,9 +1391,27 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.restoreControllerThreads, "restore-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for restore controller")
 
+	flag.IntVar(&vca.snapshotScheduleControllerThreads, "snapshot-schedule-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for snapshot schedule controller")
+
+	flag.IntVar(&vca.snapshotGroupControllerThreads, "snapshot-group-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for snapshot group controller")
+
 	flag.IntVar(&vca.exportControllerThreads, "export-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for virtual machine export controller")
 
+	flag.IntVar(&vca.snapshotExportControllerThreads, "snapshot-export-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for virtual machine snapshot export controller")
+
+	flag.IntVar(&vca.snapshotReplicationControllerThreads, "snapshot-replication-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for virtual machine snapshot replication controller")
+
+	flag.IntVar(&vca.importControllerThreads, "import-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for virtual machine import controller")
+
+	flag.IntVar(&vca.volumeMigrationControllerThreads, "volume-migration-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for volume migration controller")
+
 	flag.DurationVar(&vca.snapshotControllerResyncPeriod, "snapshot-controller-resync-period", defaultSnapshotControllerResyncPeriod,
 		"Number of goroutines to run for snapshot controller")
 
@@ -35,18 +35,23 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	autoscalingv1alpha1 "kubevirt.io/api/autoscaling/v1alpha1"
 	clone "kubevirt.io/api/clone/v1beta1"
 	v1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	quotav1 "kubevirt.io/api/quota/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	importv1 "kubevirt.io/api/vmimport/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
@@ -54,20 +59,29 @@ import (
 	instancetypecontroller "kubevirt.io/kubevirt/pkg/instancetype/controller/vm"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/rest"
+	"kubevirt.io/kubevirt/pkg/storage/export/archive"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
+	"kubevirt.io/kubevirt/pkg/storage/export/replication"
+	"kubevirt.io/kubevirt/pkg/storage/export/vmimport"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
+	"kubevirt.io/kubevirt/pkg/storage/volumemigration"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/autoscaling"
 	clonecontroller "kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/vmdisruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/migration"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/node"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/rebalance"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/replicaset"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/resourcequota"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vm"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vmi"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/watchdog"
 )
 
 func newValidGetRequest() *http.Request {
@@ -101,6 +115,8 @@ var _ = Describe("Application", func() {
 
 		pdbInformer, _ := testutils.NewFakeInformerFor(&policyv1.PodDisruptionBudget{})
 		migrationPolicyInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.MigrationPolicy{})
+		migrationRetryBudgetInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.MigrationRetryBudget{})
+		migrationRebalancePolicyInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.MigrationRebalancePolicy{})
 		podInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
 		resourceQuotaInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
 		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
@@ -113,9 +129,21 @@ var _ = Describe("Application", func() {
 		cdiConfigInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
 		rsInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstanceReplicaSet{})
 		storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
+		priorityClassInformer, _ := testutils.NewFakeInformerFor(&schedulingv1.PriorityClass{})
 		crdInformer, _ := testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
 		vmRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+		vmSnapshotScheduleInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+		vmSnapshotGroupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroup{})
+		vmSnapshotGroupRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroupRestore{})
+		vmPoolInformer, _ := testutils.NewFakeInformerFor(&poolv1.VirtualMachinePool{})
+		vmAutoscalingPolicyInformer, _ := testutils.NewFakeInformerFor(&autoscalingv1alpha1.VirtualMachineAutoscalingPolicy{})
+		vmResourceQuotaInformer, _ := testutils.NewFakeInformerFor(&quotav1.VirtualMachineResourceQuota{})
+		vmDisruptionBudgetInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.VirtualMachineDisruptionBudget{})
 		vmExportInformer, _ := testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
+		vmSnapshotExportInformer, _ := testutils.NewFakeInformerFor(&exportv1.VirtualMachineSnapshotExport{})
+		vmSnapshotReplicationInformer, _ := testutils.NewFakeInformerFor(&exportv1.VirtualMachineSnapshotReplication{})
+		vmImportInformer, _ := testutils.NewFakeInformerFor(&importv1.VirtualMachineImport{})
+		volumeMigrationInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.VolumeMigration{})
 		configMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
 		routeConfigMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
 		dvInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
@@ -133,9 +161,16 @@ var _ = Describe("Application", func() {
 		app.vmiInformer = vmiInformer
 		app.nodeTopologyUpdater = topologyUpdater
 		app.informerFactory = controller.NewKubeInformerFactory(nil, nil, nil, "test")
-		app.evacuationController, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, recorder, virtClient, config)
+		app.evacuationController, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, namespaceInformer, vmDisruptionBudgetInformer, recorder, virtClient, config)
 		app.disruptionBudgetController, _ = disruptionbudget.NewDisruptionBudgetController(vmiInformer, pdbInformer, podInformer, migrationInformer, recorder, virtClient)
-		app.nodeController, _ = node.NewController(virtClient, nodeInformer, vmiInformer, recorder)
+		app.vmDisruptionBudgetController = &vmdisruptionbudget.Controller{
+			Client:            virtClient,
+			BudgetInformer:    vmDisruptionBudgetInformer,
+			VMIInformer:       vmiInformer,
+			MigrationInformer: migrationInformer,
+		}
+		_ = app.vmDisruptionBudgetController.Init()
+		app.nodeController, _ = node.NewController(virtClient, nodeInformer, vmiInformer, vmInformer, recorder, config)
 		app.vmiController, _ = vmi.NewController(services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, qemuGid, "g", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
 			vmiInformer,
 			vmInformer,
@@ -149,6 +184,7 @@ var _ = Describe("Application", func() {
 			storageProfileInformer,
 			cdiInformer,
 			cdiConfigInformer,
+			configMapInformer,
 			config,
 			topology.NewTopologyHinter(&cache.FakeCustomStore{}, &cache.FakeCustomStore{}, nil),
 			nil,
@@ -166,6 +202,9 @@ var _ = Describe("Application", func() {
 			namespaceInformer.GetStore(),
 			pvcInformer,
 			crInformer,
+			podInformer,
+			nodeInformer,
+			priorityClassInformer,
 			recorder,
 			virtClient,
 			config,
@@ -182,6 +221,7 @@ var _ = Describe("Application", func() {
 			storageClassInformer,
 			storageProfileInformer,
 			migrationPolicyInformer,
+			migrationRetryBudgetInformer,
 			resourceQuotaInformer,
 			kvInformer,
 			recorder,
@@ -214,9 +254,57 @@ var _ = Describe("Application", func() {
 			PVCInformer:               pvcInformer,
 			StorageClassInformer:      storageClassInformer,
 			DataVolumeInformer:        dataVolumeInformer,
+			VMPoolInformer:            vmPoolInformer,
 			Recorder:                  recorder,
 		}
 		_ = app.restoreController.Init()
+		app.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: vmSnapshotScheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			Recorder:                   recorder,
+		}
+		_ = app.snapshotScheduleController.Init()
+		app.autoscalingController = &autoscaling.Controller{
+			Client:         virtClient,
+			PolicyInformer: vmAutoscalingPolicyInformer,
+			VMInformer:     vmInformer,
+			VMIInformer:    vmiInformer,
+			Recorder:       recorder,
+		}
+		_ = app.autoscalingController.Init()
+		app.resourceQuotaController = &resourcequota.Controller{
+			Client:        virtClient,
+			QuotaInformer: vmResourceQuotaInformer,
+			VMInformer:    vmInformer,
+		}
+		_ = app.resourceQuotaController.Init()
+		app.rebalanceController = &rebalance.Controller{
+			Client:            virtClient,
+			PolicyInformer:    migrationRebalancePolicyInformer,
+			NodeInformer:      nodeInformer,
+			VMIInformer:       vmiInformer,
+			MigrationInformer: migrationInformer,
+			Recorder:          recorder,
+		}
+		_ = app.rebalanceController.Init()
+		app.watchdogController = &watchdog.Controller{
+			Client:            virtClient,
+			VMIInformer:       vmiInformer,
+			MigrationInformer: migrationInformer,
+			Recorder:          recorder,
+		}
+		_ = app.watchdogController.Init()
+		app.snapshotGroupController = &snapshot.VMSnapshotGroupController{
+			Client:                         virtClient,
+			VMSnapshotGroupInformer:        vmSnapshotGroupInformer,
+			VMSnapshotGroupRestoreInformer: vmSnapshotGroupRestoreInformer,
+			VMSnapshotInformer:             vmSnapshotInformer,
+			VMRestoreInformer:              vmRestoreInformer,
+			VMInformer:                     vmInformer,
+			Recorder:                       recorder,
+		}
+		_ = app.snapshotGroupController.Init()
 		app.exportController = &export.VMExportController{
 			Client:                      virtClient,
 			ManifestRenderer:            services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, qemuGid, "g", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
@@ -242,6 +330,46 @@ var _ = Describe("Application", func() {
 			ControllerRevisionInformer:  controllerRevisionInformer,
 		}
 		_ = app.exportController.Init()
+		app.snapshotExportController = &archive.VMSnapshotExportController{
+			Client:                    virtClient,
+			ManifestRenderer:          services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, qemuGid, "g", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
+			VMSnapshotExportInformer:  vmSnapshotExportInformer,
+			VMSnapshotInformer:        vmSnapshotInformer,
+			VMSnapshotContentInformer: vmSnapshotContentInformer,
+			PVCInformer:               pvcInformer,
+			PodInformer:               podInformer,
+			Recorder:                  recorder,
+		}
+		_ = app.snapshotExportController.Init()
+		app.snapshotReplicationController = &replication.VMSnapshotReplicationController{
+			Client:                        virtClient,
+			ManifestRenderer:              services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, qemuGid, "g", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
+			VMSnapshotReplicationInformer: vmSnapshotReplicationInformer,
+			VMSnapshotInformer:            vmSnapshotInformer,
+			VMExportInformer:              vmExportInformer,
+			PodInformer:                   podInformer,
+			Recorder:                      recorder,
+		}
+		_ = app.snapshotReplicationController.Init()
+		app.importController = &vmimport.VMImportController{
+			Client:             virtClient,
+			ManifestRenderer:   services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, qemuGid, "g", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
+			VMImportInformer:   vmImportInformer,
+			VMInformer:         vmInformer,
+			DataVolumeInformer: dataVolumeInformer,
+			PodInformer:        podInformer,
+			Recorder:           recorder,
+		}
+		_ = app.importController.Init()
+		app.volumeMigrationController = &volumemigration.VolumeMigrationController{
+			Client:                  virtClient,
+			VolumeMigrationInformer: volumeMigrationInformer,
+			VMInformer:              vmInformer,
+			VMIInformer:             vmiInformer,
+			PVCInformer:             pvcInformer,
+			Recorder:                recorder,
+		}
+		_ = app.volumeMigrationController.Init()
 		app.persistentVolumeClaimInformer = pvcInformer
 		app.nodeInformer = nodeInformer
 		app.resourceQuotaInformer = resourceQuotaInformer
@@ -254,6 +382,7 @@ var _ = Describe("Application", func() {
 			vmInformer,
 			vmSnapshotContentInformer,
 			pvcInformer,
+			storageProfileInformer,
 			recorder,
 		)
 
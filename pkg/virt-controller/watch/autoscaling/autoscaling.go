@@ -0,0 +1,471 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	autoscalingv1alpha1 "kubevirt.io/api/autoscaling/v1alpha1"
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/metricsapi"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	resizeEvent = "SuccessfulVirtualMachineResize"
+
+	restartScheduledEvent = "VirtualMachineRestartScheduled"
+
+	restartEvent = "SuccessfulVirtualMachineRestart"
+
+	failedResizeEvent = "FailedVirtualMachineResize"
+
+	defaultTargetUtilizationPercentage = 70
+
+	defaultCooldown = 5 * time.Minute
+
+	// the usage of the VirtualMachines is looked at again once the metrics
+	// API may have refreshed it
+	resyncPeriod = metricsapi.Resolution
+)
+
+// memoryStep is the granularity the guest memory is resized with, it keeps
+// the resizes aligned for hotplug and avoids chasing small usage changes
+var memoryStep = resource.MustParse("128Mi")
+
+// Controller resizes the CPU sockets and the guest memory of the
+// VirtualMachines selected by a VirtualMachineAutoscalingPolicy
+type Controller struct {
+	Client kubecli.KubevirtClient
+
+	PolicyInformer cache.SharedIndexInformer
+	VMInformer     cache.SharedIndexInformer
+	VMIInformer    cache.SharedIndexInformer
+
+	Metrics  metricsapi.Client
+	Recorder record.EventRecorder
+
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+var currentTime = func() time.Time {
+	return time.Now()
+}
+
+// Init initializes the autoscaling controller
+func (c *Controller) Init() error {
+	c.queue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-vm-autoscaling"},
+	)
+
+	_, err := c.PolicyInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handlePolicy,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handlePolicy(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.VMInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleVM,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleVM(newObj) },
+			DeleteFunc: c.handleVM,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run the controller
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Log.Info("Starting vm autoscaling controller.")
+	defer log.Log.Info("Shutting down vm autoscaling controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		c.PolicyInformer.HasSynced,
+		c.VMInformer.HasSynced,
+		c.VMIInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (c *Controller) runWorker() {
+	for c.processWorkItem() {
+	}
+}
+
+func (c *Controller) processWorkItem() bool {
+	return watchutil.ProcessWorkItem(c.queue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vm autoscaling worker processing key [%s]", key)
+
+		storeObj, exists, err := c.PolicyInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		policy, ok := storeObj.(*autoscalingv1alpha1.VirtualMachineAutoscalingPolicy)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+
+		return c.sync(policy.DeepCopy())
+	})
+}
+
+func (c *Controller) handlePolicy(obj interface{}) {
+	if policy, ok := obj.(*autoscalingv1alpha1.VirtualMachineAutoscalingPolicy); ok {
+		key, err := controller.KeyFunc(policy)
+		if err != nil {
+			log.Log.Object(policy).Reason(err).Error("failed to extract key from policy")
+			return
+		}
+		c.queue.Add(key)
+	}
+}
+
+func (c *Controller) handleVM(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	vm, ok := obj.(*virtv1.VirtualMachine)
+	if !ok {
+		return
+	}
+
+	policies, err := c.PolicyInformer.GetIndexer().ByIndex(cache.NamespaceIndex, vm.Namespace)
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Error("failed to list autoscaling policies")
+		return
+	}
+
+	for _, obj := range policies {
+		policy := obj.(*autoscalingv1alpha1.VirtualMachineAutoscalingPolicy)
+		selector, err := metav1.LabelSelectorAsSelector(policy.Spec.Selector)
+		if err != nil || !selector.Matches(labels.Set(vm.Labels)) {
+			continue
+		}
+		c.handlePolicy(policy)
+	}
+}
+
+func (c *Controller) sync(policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy) (time.Duration, error) {
+	if policy.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(policy.Spec.Selector)
+	if err != nil {
+		return 0, err
+	}
+
+	var vms []*virtv1.VirtualMachine
+	err = cache.ListAllByNamespace(c.VMInformer.GetIndexer(), policy.Namespace, selector, func(obj interface{}) {
+		if vm, ok := obj.(*virtv1.VirtualMachine); ok {
+			vms = append(vms, vm)
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	previous := map[string]autoscalingv1alpha1.VirtualMachineAutoscalingStatus{}
+	for _, vmStatus := range policy.Status.VirtualMachines {
+		previous[vmStatus.Name] = vmStatus
+	}
+
+	policyOut := policy.DeepCopy()
+	policyOut.Status.VirtualMachines = nil
+
+	requeue := resyncPeriod
+	for _, vm := range vms {
+		vmStatus, ok := previous[vm.Name]
+		if !ok {
+			vmStatus = autoscalingv1alpha1.VirtualMachineAutoscalingStatus{Name: vm.Name}
+		}
+
+		vmRequeue, err := c.syncVM(policy, vm, &vmStatus)
+		if err != nil {
+			log.Log.Object(vm).Reason(err).Error("failed to autoscale VirtualMachine")
+			c.Recorder.Eventf(policy, k8sv1.EventTypeWarning, failedResizeEvent, "Failed to resize VirtualMachine %s: %v", vm.Name, err)
+		}
+		if vmRequeue > 0 && vmRequeue < requeue {
+			requeue = vmRequeue
+		}
+
+		policyOut.Status.VirtualMachines = append(policyOut.Status.VirtualMachines, vmStatus)
+	}
+
+	if err := c.updateStatus(policy, policyOut); err != nil {
+		return 0, err
+	}
+
+	return requeue, nil
+}
+
+// syncVM resizes a single VirtualMachine and records the outcome in vmStatus,
+// it returns when the VirtualMachine should be looked at again
+func (c *Controller) syncVM(policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy, vm *virtv1.VirtualMachine, vmStatus *autoscalingv1alpha1.VirtualMachineAutoscalingStatus) (time.Duration, error) {
+	now := currentTime()
+
+	// A resize that can't be hotplugged leaves the VM waiting for a restart,
+	// don't resize it any further until it was applied
+	if controller.NewVirtualMachineConditionManager().HasCondition(vm, virtv1.VirtualMachineRestartRequired) {
+		return c.restartVM(policy, vm, vmStatus, now)
+	}
+	vmStatus.RestartTime = nil
+
+	if vm.Spec.Instancetype != nil {
+		return 0, nil
+	}
+
+	obj, exists, err := c.VMIInformer.GetStore().GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+	if err != nil || !exists {
+		return 0, err
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	if vmi.Status.Phase != virtv1.Running || vmi.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	if vmStatus.LastScaleTime != nil {
+		if remaining := vmStatus.LastScaleTime.Add(cooldown(policy)).Sub(now); remaining > 0 {
+			return remaining, nil
+		}
+	}
+
+	usage, err := c.Metrics.VMIUsage(vmi)
+	if err != nil {
+		// metrics show up a while after the VMI started
+		log.Log.Object(vm).V(3).Reason(err).Info("no usage available for VirtualMachine")
+		return 0, nil
+	}
+
+	patchSet := patch.New()
+	template := &vm.Spec.Template.Spec
+	if policy.Spec.CPU != nil {
+		sockets := desiredSockets(policy.Spec.CPU, vmi, usage)
+		current := currentSockets(template)
+		if sockets != current {
+			addSocketsPatch(patchSet, template, sockets)
+			vmStatus.Sockets = sockets
+		}
+	}
+	if policy.Spec.Memory != nil && template.Domain.Memory != nil && template.Domain.Memory.Guest != nil {
+		memory := desiredMemory(policy.Spec.Memory, usage)
+		if memory.Cmp(*template.Domain.Memory.Guest) != 0 {
+			patchSet.AddOption(
+				patch.WithTest("/spec/template/spec/domain/memory/guest", template.Domain.Memory.Guest),
+				patch.WithReplace("/spec/template/spec/domain/memory/guest", memory),
+			)
+			vmStatus.Memory = &memory
+		}
+	}
+
+	if patchSet.IsEmpty() {
+		return 0, nil
+	}
+
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return 0, err
+	}
+	if _, err := c.Client.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		return 0, err
+	}
+
+	vmStatus.LastScaleTime = &metav1.Time{Time: now}
+	c.Recorder.Eventf(policy, k8sv1.EventTypeNormal, resizeEvent, "Resized VirtualMachine %s", vm.Name)
+
+	return cooldown(policy), nil
+}
+
+// restartVM restarts a VirtualMachine waiting for a restart at the next time
+// allowed by the restart fallback schedule
+func (c *Controller) restartVM(policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy, vm *virtv1.VirtualMachine, vmStatus *autoscalingv1alpha1.VirtualMachineAutoscalingStatus, now time.Time) (time.Duration, error) {
+	if policy.Spec.RestartFallback == nil {
+		vmStatus.RestartTime = nil
+		return 0, nil
+	}
+
+	if vmStatus.RestartTime == nil {
+		schedule, err := cron.ParseStandard(policy.Spec.RestartFallback.Schedule)
+		if err != nil {
+			return 0, err
+		}
+		vmStatus.RestartTime = &metav1.Time{Time: schedule.Next(now)}
+		c.Recorder.Eventf(policy, k8sv1.EventTypeNormal, restartScheduledEvent,
+			"Restart of VirtualMachine %s scheduled at %s", vm.Name, vmStatus.RestartTime.UTC().Format(time.RFC3339))
+	}
+
+	if remaining := vmStatus.RestartTime.Sub(now); remaining > 0 {
+		return remaining, nil
+	}
+
+	// a start or stop is already underway, it applies the resize as well
+	if len(vm.Status.StateChangeRequests) > 0 {
+		return 0, nil
+	}
+
+	obj, exists, err := c.VMIInformer.GetStore().GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+	if err != nil || !exists {
+		return 0, err
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+
+	patchBytes, err := patch.New(
+		patch.WithTest("/status/stateChangeRequests", vm.Status.StateChangeRequests),
+		patch.WithAdd("/status/stateChangeRequests", []virtv1.VirtualMachineStateChangeRequest{
+			{Action: virtv1.StopRequest, UID: &vmi.UID},
+			{Action: virtv1.StartRequest},
+		}),
+	).GeneratePayload()
+	if err != nil {
+		return 0, err
+	}
+	if _, err := c.Client.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		return 0, err
+	}
+
+	vmStatus.RestartTime = nil
+	c.Recorder.Eventf(policy, k8sv1.EventTypeNormal, restartEvent, "Restarted VirtualMachine %s to apply its resize", vm.Name)
+
+	return 0, nil
+}
+
+func (c *Controller) updateStatus(policy, policyOut *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy) error {
+	if equality.Semantic.DeepEqual(policy.Status, policyOut.Status) {
+		return nil
+	}
+
+	_, err := c.Client.VirtualMachineAutoscalingPolicy(policyOut.Namespace).UpdateStatus(context.Background(), policyOut, metav1.UpdateOptions{})
+	return err
+}
+
+func cooldown(policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy) time.Duration {
+	if policy.Spec.Cooldown != nil {
+		return policy.Spec.Cooldown.Duration
+	}
+	return defaultCooldown
+}
+
+func targetUtilization(percentage *int32) int64 {
+	if percentage != nil {
+		return int64(*percentage)
+	}
+	return defaultTargetUtilizationPercentage
+}
+
+func currentSockets(template *virtv1.VirtualMachineInstanceSpec) uint32 {
+	if template.Domain.CPU == nil || template.Domain.CPU.Sockets == 0 {
+		return 1
+	}
+	return template.Domain.CPU.Sockets
+}
+
+// desiredSockets returns the sockets needed to bring the CPU usage of the
+// VMI to the target utilization, within the bounds of the policy
+func desiredSockets(cpu *autoscalingv1alpha1.VirtualMachineCPUAutoscaling, vmi *virtv1.VirtualMachineInstance, usage *metricsapi.Usage) uint32 {
+	vcpusPerSocket := int64(1)
+	if vmiCPU := vmi.Spec.Domain.CPU; vmiCPU != nil {
+		vcpusPerSocket = int64(max(vmiCPU.Cores, 1) * max(vmiCPU.Threads, 1))
+	}
+
+	target := targetUtilization(cpu.TargetUtilizationPercentage)
+	vcpus := ceilDiv(usage.CPU.MilliValue()*100, target*1000)
+	sockets := uint32(ceilDiv(vcpus, vcpusPerSocket))
+
+	return min(max(sockets, cpu.MinSockets), cpu.MaxSockets)
+}
+
+// desiredMemory returns the guest memory needed to bring the memory usage of
+// the VMI to the target utilization, within the bounds of the policy
+func desiredMemory(memory *autoscalingv1alpha1.VirtualMachineMemoryAutoscaling, usage *metricsapi.Usage) resource.Quantity {
+	target := targetUtilization(memory.TargetUtilizationPercentage)
+	step := memoryStep.Value()
+	desired := ceilDiv(ceilDiv(usage.Memory.Value()*100, target), step) * step
+
+	quantity := resource.NewQuantity(desired, resource.BinarySI)
+	if quantity.Cmp(memory.Min) < 0 {
+		return memory.Min.DeepCopy()
+	}
+	if quantity.Cmp(memory.Max) > 0 {
+		return memory.Max.DeepCopy()
+	}
+	return *quantity
+}
+
+func addSocketsPatch(patchSet *patch.PatchSet, template *virtv1.VirtualMachineInstanceSpec, sockets uint32) {
+	switch {
+	case template.Domain.CPU == nil:
+		patchSet.AddOption(patch.WithAdd("/spec/template/spec/domain/cpu", virtv1.CPU{Sockets: sockets}))
+	case template.Domain.CPU.Sockets == 0:
+		patchSet.AddOption(patch.WithAdd("/spec/template/spec/domain/cpu/sockets", sockets))
+	default:
+		patchSet.AddOption(
+			patch.WithTest("/spec/template/spec/domain/cpu/sockets", template.Domain.CPU.Sockets),
+			patch.WithReplace("/spec/template/spec/domain/cpu/sockets", sockets),
+		)
+	}
+}
+
+func ceilDiv(a, b int64) int64 {
+	return (a + b - 1) / b
+}
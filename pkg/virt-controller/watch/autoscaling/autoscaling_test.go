@@ -0,0 +1,312 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package autoscaling
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	autoscalingv1alpha1 "kubevirt.io/api/autoscaling/v1alpha1"
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/util/metricsapi"
+)
+
+var _ = Describe("VM autoscaling controller", func() {
+	const (
+		policyName = "test-policy"
+		vmName     = "testvm"
+	)
+
+	var (
+		now = time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC)
+
+		controller     *Controller
+		policyInformer cache.SharedIndexInformer
+		vmInformer     cache.SharedIndexInformer
+		vmiInformer    cache.SharedIndexInformer
+		metrics        *metricsapi.FakeClient
+		recorder       *record.FakeRecorder
+		kubevirtClient *kubevirtfake.Clientset
+	)
+
+	newPolicy := func() *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy {
+		return &autoscalingv1alpha1.VirtualMachineAutoscalingPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      policyName,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: autoscalingv1alpha1.VirtualMachineAutoscalingPolicySpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "autoscaled"},
+				},
+				CPU: &autoscalingv1alpha1.VirtualMachineCPUAutoscaling{
+					MinSockets: 1,
+					MaxSockets: 8,
+				},
+				Memory: &autoscalingv1alpha1.VirtualMachineMemoryAutoscaling{
+					Min: resource.MustParse("1Gi"),
+					Max: resource.MustParse("8Gi"),
+				},
+			},
+		}
+	}
+
+	newVMI := func() *virtv1.VirtualMachineInstance {
+		return libvmi.New(
+			libvmi.WithName(vmName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithCPUCount(2, 1, 2),
+			libvmi.WithGuestMemory("2Gi"),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(virtv1.Running))),
+		)
+	}
+
+	addPolicy := func(policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy) {
+		Expect(policyInformer.GetStore().Add(policy)).To(Succeed())
+		_, err := kubevirtClient.AutoscalingV1alpha1().VirtualMachineAutoscalingPolicies(metav1.NamespaceDefault).Create(context.Background(), policy, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addVM := func(vm *virtv1.VirtualMachine) {
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		_, err := kubevirtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Create(context.Background(), vm, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addVMI := func(vmi *virtv1.VirtualMachineInstance) {
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+	}
+
+	getVM := func() *virtv1.VirtualMachine {
+		vm, err := kubevirtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.Background(), vmName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vm
+	}
+
+	getPolicy := func() *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy {
+		policy, err := kubevirtClient.AutoscalingV1alpha1().VirtualMachineAutoscalingPolicies(metav1.NamespaceDefault).Get(context.Background(), policyName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return policy
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).
+			Return(kubevirtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineAutoscalingPolicy(metav1.NamespaceDefault).
+			Return(kubevirtClient.AutoscalingV1alpha1().VirtualMachineAutoscalingPolicies(metav1.NamespaceDefault)).AnyTimes()
+
+		policyInformer, _ = testutils.NewFakeInformerFor(&autoscalingv1alpha1.VirtualMachineAutoscalingPolicy{})
+		vmInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		metrics = metricsapi.NewFakeClient()
+		recorder = record.NewFakeRecorder(100)
+
+		controller = &Controller{
+			Client:         virtClient,
+			PolicyInformer: policyInformer,
+			VMInformer:     vmInformer,
+			VMIInformer:    vmiInformer,
+			Metrics:        metrics,
+			Recorder:       recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+
+		originalCurrentTime := currentTime
+		currentTime = func() time.Time {
+			return now
+		}
+		DeferCleanup(func() {
+			currentTime = originalCurrentTime
+		})
+	})
+
+	Context("with a running VirtualMachine", func() {
+		var policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy
+
+		BeforeEach(func() {
+			vmi := newVMI()
+			addVMI(vmi)
+			addVM(libvmi.NewVirtualMachine(vmi, libvmi.WithLabels(map[string]string{"app": "autoscaled"})))
+			policy = newPolicy()
+		})
+
+		It("should scale up the CPU sockets and the memory towards the target utilization", func() {
+			addPolicy(policy)
+			// 3.5 cores and 3Gi used, 70% target on 2 cores per socket
+			metrics.VMIs[vmName] = &metricsapi.Usage{CPU: resource.MustParse("3500m"), Memory: resource.MustParse("3Gi")}
+
+			requeue, err := controller.sync(policy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeue).To(Equal(time.Minute))
+
+			vm := getVM()
+			Expect(vm.Spec.Template.Spec.Domain.CPU.Sockets).To(Equal(uint32(3)))
+			Expect(vm.Spec.Template.Spec.Domain.Memory.Guest.String()).To(Equal("4480Mi"))
+
+			status := getPolicy().Status.VirtualMachines
+			Expect(status).To(HaveLen(1))
+			Expect(status[0].Name).To(Equal(vmName))
+			Expect(status[0].Sockets).To(Equal(uint32(3)))
+			Expect(status[0].LastScaleTime.Time).To(BeTemporally("==", now))
+			testutils.ExpectEvent(recorder, resizeEvent)
+		})
+
+		It("should scale down within the bounds of the policy", func() {
+			addPolicy(policy)
+			metrics.VMIs[vmName] = &metricsapi.Usage{CPU: resource.MustParse("100m"), Memory: resource.MustParse("100Mi")}
+
+			_, err := controller.sync(policy)
+			Expect(err).ToNot(HaveOccurred())
+
+			vm := getVM()
+			Expect(vm.Spec.Template.Spec.Domain.CPU.Sockets).To(Equal(uint32(1)))
+			Expect(vm.Spec.Template.Spec.Domain.Memory.Guest.String()).To(Equal("1Gi"))
+		})
+
+		It("should not resize during the cooldown", func() {
+			policy.Status.VirtualMachines = []autoscalingv1alpha1.VirtualMachineAutoscalingStatus{{
+				Name:          vmName,
+				LastScaleTime: pointer.P(metav1.NewTime(now.Add(-time.Minute))),
+			}}
+			addPolicy(policy)
+			metrics.VMIs[vmName] = &metricsapi.Usage{CPU: resource.MustParse("8"), Memory: resource.MustParse("6Gi")}
+
+			requeue, err := controller.sync(policy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeue).To(Equal(time.Minute))
+
+			Expect(getVM().Spec.Template.Spec.Domain.CPU.Sockets).To(Equal(uint32(2)))
+		})
+
+		It("should not resize without metrics", func() {
+			addPolicy(policy)
+
+			_, err := controller.sync(policy)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(getVM().Spec.Template.Spec.Domain.CPU.Sockets).To(Equal(uint32(2)))
+		})
+	})
+
+	Context("with a VirtualMachine requiring a restart", func() {
+		var (
+			policy *autoscalingv1alpha1.VirtualMachineAutoscalingPolicy
+			vm     *virtv1.VirtualMachine
+		)
+
+		BeforeEach(func() {
+			vmi := newVMI()
+			vmi.UID = "vmi-uid"
+			addVMI(vmi)
+			vm = libvmi.NewVirtualMachine(vmi, libvmi.WithLabels(map[string]string{"app": "autoscaled"}))
+			vm.Status.Conditions = []virtv1.VirtualMachineCondition{{
+				Type:   virtv1.VirtualMachineRestartRequired,
+				Status: k8sv1.ConditionTrue,
+			}}
+			addVM(vm)
+
+			policy = newPolicy()
+			policy.Spec.RestartFallback = &autoscalingv1alpha1.VirtualMachineAutoscalingRestartFallback{
+				Schedule: "0 2 * * *",
+			}
+		})
+
+		It("should schedule the restart", func() {
+			addPolicy(policy)
+
+			requeue, err := controller.sync(policy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeue).To(Equal(time.Minute))
+
+			status := getPolicy().Status.VirtualMachines
+			Expect(status).To(HaveLen(1))
+			Expect(status[0].RestartTime.Time).To(BeTemporally("==", time.Date(2025, 1, 2, 2, 0, 0, 0, time.UTC)))
+			Expect(getVM().Status.StateChangeRequests).To(BeEmpty())
+			testutils.ExpectEvent(recorder, restartScheduledEvent)
+		})
+
+		It("should restart the VirtualMachine once the restart is due", func() {
+			policy.Status.VirtualMachines = []autoscalingv1alpha1.VirtualMachineAutoscalingStatus{{
+				Name:        vmName,
+				RestartTime: pointer.P(metav1.NewTime(now.Add(-time.Minute))),
+			}}
+			addPolicy(policy)
+
+			_, err := controller.sync(policy)
+			Expect(err).ToNot(HaveOccurred())
+
+			requests := getVM().Status.StateChangeRequests
+			Expect(requests).To(HaveLen(2))
+			Expect(requests[0].Action).To(Equal(virtv1.StopRequest))
+			Expect(requests[0].UID).To(HaveValue(BeEquivalentTo("vmi-uid")))
+			Expect(requests[1].Action).To(Equal(virtv1.StartRequest))
+			Expect(getPolicy().Status.VirtualMachines[0].RestartTime).To(BeNil())
+			testutils.ExpectEvent(recorder, restartEvent)
+		})
+
+		It("should leave the VirtualMachine alone without a restart fallback", func() {
+			policy.Spec.RestartFallback = nil
+			addPolicy(policy)
+			metrics.VMIs[vmName] = &metricsapi.Usage{CPU: resource.MustParse("8"), Memory: resource.MustParse("6Gi")}
+
+			_, err := controller.sync(policy)
+			Expect(err).ToNot(HaveOccurred())
+
+			vm := getVM()
+			Expect(vm.Spec.Template.Spec.Domain.CPU.Sockets).To(Equal(uint32(2)))
+			Expect(vm.Status.StateChangeRequests).To(BeEmpty())
+		})
+	})
+
+	It("should enqueue the policies selecting a VirtualMachine", func() {
+		policy := newPolicy()
+		addPolicy(policy)
+		other := newPolicy()
+		other.Name = "other"
+		other.Spec.Selector.MatchLabels = map[string]string{"app": "other"}
+		addPolicy(other)
+
+		controller.handleVM(libvmi.NewVirtualMachine(newVMI(), libvmi.WithLabels(map[string]string{"app": "autoscaled"})))
+
+		Expect(controller.queue.Len()).To(Equal(1))
+		key, _ := controller.queue.Get()
+		Expect(key).To(Equal(metav1.NamespaceDefault + "/" + policyName))
+	})
+})
@@ -21,6 +21,7 @@ package clone
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -28,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 
 	clone "kubevirt.io/api/clone/v1beta1"
@@ -63,6 +65,10 @@ type syncInfoType struct {
 	targetVMName    string
 	targetVMCreated bool
 	pvcBound        bool
+	// directFromSnapshot is set when the target VM is created from the snapshot content without a restore
+	directFromSnapshot bool
+	// linked is set when the target VM of a linked clone is created from the source VM without a snapshot
+	linked bool
 
 	event          Event
 	reason         string
@@ -96,14 +102,15 @@ func (ctrl *VMCloneController) execute(key string) error {
 	}
 
 	if vmClone.Status.Phase == clone.Succeeded {
-		_, vmExists, err := ctrl.vmStore.GetByKey(fmt.Sprintf("%s/%s", vmClone.Namespace, *vmClone.Status.TargetName))
+		targetNamespace := getTargetNamespace(vmClone)
+		_, vmExists, err := ctrl.vmStore.GetByKey(fmt.Sprintf("%s/%s", targetNamespace, *vmClone.Status.TargetName))
 		if err != nil {
 			return err
 		}
 
 		if !vmExists {
 			if vmClone.DeletionTimestamp == nil {
-				logger.V(3).Infof("Deleting vm clone for deleted vm %s/%s", vmClone.Namespace, *vmClone.Status.TargetName)
+				logger.V(3).Infof("Deleting vm clone for deleted vm %s/%s", targetNamespace, *vmClone.Status.TargetName)
 				return ctrl.client.VirtualMachineClone(vmClone.Namespace).Delete(context.Background(), vmClone.Name, v1.DeleteOptions{})
 			}
 			// nothing to process for a vm clone that's being deleted
@@ -206,6 +213,20 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 	switch vmClone.Status.Phase {
 	case clone.PhaseUnset, clone.SnapshotInProgress:
 
+		if vmCloneInfo.sourceType == sourceTypeVM && isLinkedClone(vmClone) {
+			// The instancetype and preference revisions of the source are owned by the source VM
+			if !cloneDirectlyFromSnapshot(vmCloneInfo.sourceVm) {
+				syncInfo.isCloneFailing = true
+				syncInfo.event = VolumeCloneInvalid
+				syncInfo.reason = fmt.Sprintf("clone %s cannot be linked, source VM %s has an instancetype or preference", vmClone.Name, vmCloneInfo.sourceVm.Name)
+				return syncInfo
+			}
+
+			syncInfo.linked = true
+			syncInfo.targetVMName = getTargetVMName(vmClone, vmCloneInfo.sourceVm.Name)
+			return syncInfo
+		}
+
 		if vmCloneInfo.sourceType == sourceTypeVM {
 			if vmClone.Status.SnapshotName == nil {
 				syncInfo = ctrl.createSnapshotFromVm(vmClone, vmCloneInfo.sourceVm, syncInfo)
@@ -218,6 +239,36 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 			return syncInfo
 		}
 
+		// Volumes can only be excluded or resized, and the target can only be created in another namespace,
+		// when the target VM is created from the snapshot content
+		if vmCloneInfo.sourceType == sourceTypeSnapshot || len(vmClone.Spec.Volumes) > 0 || isCrossNamespaceClone(vmClone) {
+			vm, err := ctrl.getVmFromSnapshot(vmCloneInfo.snapshot)
+			if err != nil {
+				syncInfo.setError(fmt.Errorf("cannot get VM manifest from snapshot: %v", err))
+				return syncInfo
+			}
+
+			if cloneDirectlyFromSnapshot(vm) {
+				// The target VM is created from the snapshot content once its name is stored in the status
+				syncInfo.directFromSnapshot = true
+				syncInfo.targetVMName = getTargetVMName(vmClone, vm.Name)
+				return syncInfo
+			}
+
+			if len(vmClone.Spec.Volumes) > 0 {
+				syncInfo.isCloneFailing = true
+				syncInfo.event = VolumeCloneInvalid
+				syncInfo.reason = fmt.Sprintf("volumes of clone %s cannot be customized, source VM %s has an instancetype or preference", vmClone.Name, vm.Name)
+				return syncInfo
+			}
+			if isCrossNamespaceClone(vmClone) {
+				syncInfo.isCloneFailing = true
+				syncInfo.event = TargetNamespaceInvalid
+				syncInfo.reason = fmt.Sprintf("clone %s cannot create its target in namespace %s, source VM %s has an instancetype or preference", vmClone.Name, vmClone.Spec.TargetNamespace, vm.Name)
+				return syncInfo
+			}
+		}
+
 		fallthrough
 
 	case clone.RestoreInProgress:
@@ -249,6 +300,37 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 
 	case clone.CreatingTargetVM:
 
+		if vmCloneInfo.sourceType == sourceTypeVM && isLinkedClone(vmClone) {
+			syncInfo = ctrl.createLinkedTargetVM(vmClone, vmCloneInfo.sourceVm, syncInfo)
+			if syncInfo.isFailingOrError() {
+				return syncInfo
+			}
+		} else if vmClone.Status.RestoreName == nil {
+			if vmCloneInfo.snapshot == nil {
+				vmCloneInfo.snapshot, syncInfo = ctrl.getSnapshot(vmCloneInfo.snapshotName, vmCloneInfo.vmClone.Namespace, syncInfo)
+				if syncInfo.isFailingOrError() {
+					return syncInfo
+				}
+			}
+
+			syncInfo = ctrl.createTargetVMFromSnapshot(vmClone, vmCloneInfo.snapshot, syncInfo)
+			if syncInfo.isFailingOrError() {
+				return syncInfo
+			}
+		} else {
+			if vmCloneInfo.snapshot == nil {
+				vmCloneInfo.snapshot, syncInfo = ctrl.getSnapshot(vmCloneInfo.snapshotName, vmCloneInfo.vmClone.Namespace, syncInfo)
+				if syncInfo.isFailingOrError() {
+					return syncInfo
+				}
+			}
+
+			syncInfo = ctrl.annotateRestoredVM(vmClone, vmCloneInfo.snapshot, syncInfo)
+			if syncInfo.isFailingOrError() {
+				return syncInfo
+			}
+		}
+
 		syncInfo = ctrl.verifyVmReady(vmClone, syncInfo)
 		if syncInfo.isFailingOrError() {
 			return syncInfo
@@ -275,6 +357,16 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 					return syncInfo
 				}
 			}
+		} else if vmCloneInfo.sourceType == sourceTypeVM && vmClone.Status.SnapshotName != nil {
+			syncInfo = ctrl.verifyTargetPVCsBound(vmClone, syncInfo)
+			if syncInfo.isFailingOrError() || !syncInfo.pvcBound {
+				return syncInfo
+			}
+
+			syncInfo = ctrl.cleanupSnapshot(vmClone, syncInfo)
+			if syncInfo.isFailingOrError() {
+				return syncInfo
+			}
 		}
 
 	default:
@@ -322,7 +414,9 @@ func (ctrl *VMCloneController) updateStatus(origClone *clone.VirtualMachineClone
 			vmClone.Status.SnapshotName = pointer.P(snapshotName)
 		}
 
-		if syncInfo.snapshotReady {
+		if syncInfo.directFromSnapshot || syncInfo.linked {
+			assignPhase(clone.CreatingTargetVM)
+		} else if syncInfo.snapshotReady {
 			assignPhase(clone.RestoreInProgress)
 		}
 	}
@@ -590,23 +684,80 @@ func (ctrl *VMCloneController) verifyRestoreReady(vmClone *clone.VirtualMachineC
 }
 
 func (ctrl *VMCloneController) verifyVmReady(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
-	targetVMInfo := vmClone.Spec.Target
+	var targetVMName string
+	if vmClone.Status.TargetName != nil {
+		targetVMName = *vmClone.Status.TargetName
+	} else {
+		targetVMName = vmClone.Spec.Target.Name
+	}
 
-	_, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMInfo.Name, vmClone.Namespace))
+	_, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMName, getTargetNamespace(vmClone)))
 	if !exists {
-		syncInfo.setError(fmt.Errorf("target VM %s is not created yet for clone %s", targetVMInfo.Name, vmClone.Name))
+		syncInfo.setError(fmt.Errorf("target VM %s is not created yet for clone %s", targetVMName, vmClone.Name))
 		return syncInfo
 	} else if err != nil {
-		syncInfo.setError(fmt.Errorf("error getting VM %s from cache for clone %s: %v", targetVMInfo.Name, vmClone.Name, err))
+		syncInfo.setError(fmt.Errorf("error getting VM %s from cache for clone %s: %v", targetVMName, vmClone.Name, err))
 		return syncInfo
 	}
 
-	ctrl.logAndRecord(vmClone, TargetVMCreated, fmt.Sprintf("created target VM %s for clone %s", targetVMInfo.Name, vmClone.Name))
+	ctrl.logAndRecord(vmClone, TargetVMCreated, fmt.Sprintf("created target VM %s for clone %s", targetVMName, vmClone.Name))
 	syncInfo.targetVMCreated = true
 
 	return syncInfo
 }
 
+// annotateRestoredVM stamps the target VM created by the restore with the provenance annotations
+func (ctrl *VMCloneController) annotateRestoredVM(vmClone *clone.VirtualMachineClone, snapshot *snapshotv1.VirtualMachineSnapshot, syncInfo syncInfoType) syncInfoType {
+	targetVMName := syncInfo.targetVMName
+	if targetVMName == "" {
+		if vmClone.Status.TargetName != nil {
+			targetVMName = *vmClone.Status.TargetName
+		} else {
+			targetVMName = vmClone.Spec.Target.Name
+		}
+	}
+
+	obj, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMName, vmClone.Namespace))
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("error getting VM %s from cache for clone %s: %v", targetVMName, vmClone.Name, err))
+		return syncInfo
+	} else if !exists {
+		// The missing target VM is reported when verifying it is ready
+		return syncInfo
+	}
+
+	targetVM := obj.(*k6tv1.VirtualMachine)
+	provenance := generateProvenance(vmClone, snapshot.Spec.Source.Name, snapshot.UID)
+	annotated := true
+	for key, value := range provenance {
+		if targetVM.Annotations[key] != value {
+			annotated = false
+			break
+		}
+	}
+	if annotated {
+		return syncInfo
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": provenance,
+		},
+	})
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("cannot marshal provenance annotations of clone %s: %v", vmClone.Name, err))
+		return syncInfo
+	}
+
+	_, err = ctrl.client.VirtualMachine(vmClone.Namespace).Patch(context.Background(), targetVMName, types.MergePatchType, payload, v1.PatchOptions{})
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("cannot annotate target VM %s for clone %s: %v", targetVMName, vmClone.Name, err))
+		return syncInfo
+	}
+
+	return syncInfo
+}
+
 func (ctrl *VMCloneController) verifyPVCBound(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
 	obj, exists, err := ctrl.restoreStore.GetByKey(getKey(*vmClone.Status.RestoreName, vmClone.Namespace))
 	if !exists {
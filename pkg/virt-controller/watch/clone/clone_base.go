@@ -42,6 +42,9 @@ const (
 	SourceDoesNotExist              Event = "SourceDoesNotExist"
 	SourceWithBackendStorageInvalid Event = "SourceVMWithBackendStorageInvalid"
 	VMVolumeSnapshotsInvalid        Event = "VMVolumeSnapshotsInvalid"
+	TargetVMExists                  Event = "TargetVMExists"
+	VolumeCloneInvalid              Event = "VolumeCloneInvalid"
+	TargetNamespaceInvalid          Event = "TargetNamespaceInvalid"
 )
 
 var (
@@ -61,13 +64,14 @@ type VMCloneController struct {
 	vmStore              cache.Store
 	snapshotContentStore cache.Store
 	pvcStore             cache.Store
+	storageProfileStore  cache.Store
 	recorder             record.EventRecorder
 
 	vmCloneQueue workqueue.TypedRateLimitingInterface[string]
 	hasSynced    func() bool
 }
 
-func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapshotInformer, restoreInformer, vmInformer, snapshotContentInformer, pvcInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*VMCloneController, error) {
+func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapshotInformer, restoreInformer, vmInformer, snapshotContentInformer, pvcInformer, storageProfileInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*VMCloneController, error) {
 	ctrl := VMCloneController{
 		client:               client,
 		vmCloneIndexer:       vmCloneInformer.GetIndexer(),
@@ -76,6 +80,7 @@ func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapsh
 		vmStore:              vmInformer.GetStore(),
 		snapshotContentStore: snapshotContentInformer.GetStore(),
 		pvcStore:             pvcInformer.GetStore(),
+		storageProfileStore:  storageProfileInformer.GetStore(),
 		recorder:             recorder,
 		vmCloneQueue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
 			workqueue.DefaultTypedControllerRateLimiter[string](),
@@ -85,7 +90,7 @@ func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapsh
 
 	ctrl.hasSynced = func() bool {
 		return vmCloneInformer.HasSynced() && snapshotInformer.HasSynced() && restoreInformer.HasSynced() &&
-			vmInformer.HasSynced() && snapshotInformer.HasSynced() && pvcInformer.HasSynced()
+			vmInformer.HasSynced() && snapshotInformer.HasSynced() && pvcInformer.HasSynced() && storageProfileInformer.HasSynced()
 	}
 
 	_, err := vmCloneInformer.AddEventHandler(
@@ -252,6 +257,17 @@ func (ctrl *VMCloneController) handlePVC(obj interface{}) {
 		return
 	}
 
+	if cloneName, exists := pvc.Annotations[cloneNameAnnotation]; exists {
+		if pvc.Status.Phase == k8scorev1.ClaimBound {
+			cloneNamespace := pvc.Namespace
+			if namespace, exists := pvc.Annotations[cloneNamespaceAnnotation]; exists {
+				cloneNamespace = namespace
+			}
+			ctrl.vmCloneQueue.AddRateLimited(getKey(cloneName, cloneNamespace))
+		}
+		return
+	}
+
 	var (
 		restoreName string
 		exists      bool
@@ -25,11 +25,13 @@ import (
 	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch"
+	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
@@ -40,8 +42,10 @@ import (
 	clone "kubevirt.io/api/clone/v1beta1"
 	virtv1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	k8ssnapshotfake "kubevirt.io/client-go/externalsnapshotter/fake"
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	kvcontroller "kubevirt.io/kubevirt/pkg/controller"
@@ -67,10 +71,12 @@ var _ = Describe("Clone", func() {
 		recorder   *record.FakeRecorder
 		mockQueue  *testutils.MockWorkQueue[string]
 
-		client    *kubevirtfake.Clientset
-		k8sClient *k8sfake.Clientset
-		sourceVM  *virtv1.VirtualMachine
-		vmClone   *clone.VirtualMachineClone
+		client            *kubevirtfake.Clientset
+		k8sClient         *k8sfake.Clientset
+		pvcClient         *k8sfake.Clientset
+		k8sSnapshotClient *k8ssnapshotfake.Clientset
+		sourceVM          *virtv1.VirtualMachine
+		vmClone           *clone.VirtualMachineClone
 	)
 
 	addVM := func(vm *virtv1.VirtualMachine) {
@@ -214,6 +220,7 @@ var _ = Describe("Clone", func() {
 		cloneInformer, _ := testutils.NewFakeInformerFor(&clone.VirtualMachineClone{})
 		snapshotContentInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
 		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		storageProfileInformer, _ := testutils.NewFakeInformerFor(&cdiv1.StorageProfile{})
 
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
@@ -226,6 +233,7 @@ var _ = Describe("Clone", func() {
 			vmInformer,
 			snapshotContentInformer,
 			pvcInformer,
+			storageProfileInformer,
 			recorder)
 		mockQueue = testutils.NewMockWorkQueue(controller.vmCloneQueue)
 		controller.vmCloneQueue = mockQueue
@@ -245,6 +253,14 @@ var _ = Describe("Clone", func() {
 			return true, nil, nil
 		})
 		virtClient.EXPECT().AppsV1().Return(k8sClient.AppsV1()).AnyTimes()
+
+		virtClient.EXPECT().VirtualMachine(gomock.Any()).DoAndReturn(func(namespace string) kubecli.VirtualMachineInterface {
+			return client.KubevirtV1().VirtualMachines(namespace)
+		}).AnyTimes()
+		pvcClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(pvcClient.CoreV1()).AnyTimes()
+		k8sSnapshotClient = k8ssnapshotfake.NewSimpleClientset()
+		virtClient.EXPECT().KubernetesSnapshotClient().Return(k8sSnapshotClient).AnyTimes()
 	})
 
 	sanityExecute := func() {
@@ -377,6 +393,70 @@ var _ = Describe("Clone", func() {
 					expectCloneBeInPhase(clone.RestoreInProgress)
 					expectRestoreExists()
 				})
+
+				It("and is ready with customized volumes - should move to creating the target VM without a restore", func() {
+					snapshotContent := createVirtualMachineSnapshotContent(sourceVM)
+
+					snapshot.Status.ReadyToUse = pointer.P(true)
+
+					vmClone.Spec.Volumes = []clone.VirtualMachineCloneVolume{{Name: "scratch", Exclude: true}}
+					vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+					vmClone.Status.Phase = clone.SnapshotInProgress
+
+					addVM(sourceVM)
+					addClone(vmClone)
+					addSnapshot(snapshot)
+					addSnapshotContent(snapshotContent)
+
+					sanityExecute()
+					expectEvent(SnapshotReady)
+					expectCloneBeInPhase(clone.CreatingTargetVM)
+					expectRestoreDoesNotExist()
+				})
+
+				It("and is ready with a target namespace for a VM with an instancetype - should fail", func() {
+					snapshotContent := createVirtualMachineSnapshotContent(sourceVM)
+					snapshotContent.Spec.Source.VirtualMachine.Spec.Instancetype = &virtv1.InstancetypeMatcher{Name: "instancetype"}
+
+					snapshot.Status.ReadyToUse = pointer.P(true)
+
+					vmClone.Spec.TargetNamespace = "tenant"
+					vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+					vmClone.Status.Phase = clone.SnapshotInProgress
+
+					addVM(sourceVM)
+					addClone(vmClone)
+					addSnapshot(snapshot)
+					addSnapshotContent(snapshotContent)
+
+					sanityExecute()
+					expectEvent(SnapshotReady)
+					expectEvent(TargetNamespaceInvalid)
+					expectCloneBeInPhase(clone.Failed)
+					expectRestoreDoesNotExist()
+				})
+
+				It("and is ready with customized volumes of a VM with an instancetype - should fail", func() {
+					snapshotContent := createVirtualMachineSnapshotContent(sourceVM)
+					snapshotContent.Spec.Source.VirtualMachine.Spec.Instancetype = &virtv1.InstancetypeMatcher{Name: "instancetype"}
+
+					snapshot.Status.ReadyToUse = pointer.P(true)
+
+					vmClone.Spec.Volumes = []clone.VirtualMachineCloneVolume{{Name: "scratch", Exclude: true}}
+					vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+					vmClone.Status.Phase = clone.SnapshotInProgress
+
+					addVM(sourceVM)
+					addClone(vmClone)
+					addSnapshot(snapshot)
+					addSnapshotContent(snapshotContent)
+
+					sanityExecute()
+					expectEvent(SnapshotReady)
+					expectEvent(VolumeCloneInvalid)
+					expectCloneBeInPhase(clone.Failed)
+					expectRestoreDoesNotExist()
+				})
 			})
 
 			When("restore is created", func() {
@@ -456,6 +536,8 @@ var _ = Describe("Clone", func() {
 				It("and the target VM ready should move to Succeeded phase", func() {
 					targetVM := sourceVM.DeepCopy()
 					targetVM.Name = vmClone.Spec.Target.Name
+					_, err := client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Create(context.TODO(), targetVM, metav1.CreateOptions{})
+					Expect(err).ToNot(HaveOccurred())
 
 					addVM(sourceVM)
 					addVM(targetVM)
@@ -468,6 +550,12 @@ var _ = Describe("Clone", func() {
 					expectCloneBeInPhase(clone.Succeeded)
 					expectSnapshotDoesNotExist()
 					expectRestoreDoesNotExist()
+
+					targetVM, err = client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.TODO(), targetVM.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(targetVM.Annotations).To(HaveKeyWithValue(clone.SourceVMNameAnnotation, sourceVM.Name))
+					Expect(targetVM.Annotations).To(HaveKeyWithValue(clone.SourceVMNamespaceAnnotation, sourceVM.Namespace))
+					Expect(targetVM.Annotations).To(HaveKeyWithValue(clone.SourceSnapshotUIDAnnotation, string(snapshot.UID)))
 				})
 			})
 
@@ -524,6 +612,60 @@ var _ = Describe("Clone", func() {
 				})
 			})
 
+			When("the target VM was created from the snapshot content and involves one or more PVCs", func() {
+				var pvc *k8sv1.PersistentVolumeClaim
+
+				BeforeEach(func() {
+					snapshot := createVirtualMachineSnapshot(sourceVM, createOwnerReference(vmClone))
+					snapshot.Status.ReadyToUse = pointer.P(true)
+
+					claimName := generatePVCName(vmClone.UID, "disk")
+					pvc = createPVC(sourceVM.Namespace, k8sv1.ClaimPending)
+					pvc.Name = claimName
+
+					vmClone.Spec.Volumes = []clone.VirtualMachineCloneVolume{{Name: "scratch", Exclude: true}}
+					vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+					vmClone.Status.Phase = clone.Succeeded
+					vmClone.Status.TargetName = pointer.P(vmClone.Spec.Target.Name)
+
+					targetVM := sourceVM.DeepCopy()
+					targetVM.Name = vmClone.Spec.Target.Name
+					targetVM.Spec.Template.Spec.Volumes = append(targetVM.Spec.Template.Spec.Volumes, virtv1.Volume{
+						Name: "disk",
+						VolumeSource: virtv1.VolumeSource{
+							PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+							},
+						},
+					})
+
+					addVM(sourceVM)
+					addVM(targetVM)
+					addClone(vmClone)
+					addSnapshot(snapshot)
+					addPVC(pvc)
+				})
+
+				It("if not all the PVCs are bound, nothing should happen", func() {
+					sanityExecute()
+					Expect(recorder.Events).To(BeEmpty())
+					expectCloneBeInPhase(clone.Succeeded)
+					expectSnapshotExists()
+				})
+
+				It("if all the PVCs are bound, the snapshot should be deleted", func() {
+					pvc.Status.Phase = k8sv1.ClaimBound
+					addPVC(pvc)
+					sanityExecute()
+					expectEvent(PVCBound)
+					expectSnapshotDoesNotExist()
+
+					updatedClone, err := client.CloneV1beta1().VirtualMachineClones(metav1.NamespaceDefault).Get(context.TODO(), vmClone.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(updatedClone.Status.SnapshotName).To(BeNil())
+				})
+			})
+
 			It("when snapshot is deleted before restore is ready - should fail", func() {
 				restore := createVirtualMachineRestore(sourceVM, testSnapshotName)
 				restore.Status.Complete = pointer.P(false)
@@ -617,6 +759,141 @@ var _ = Describe("Clone", func() {
 				})
 			})
 
+			Context("with linked clone strategy", func() {
+				const (
+					pvcVolumeName    = "pvc-volume"
+					dvVolumeName     = "dv-volume"
+					sourcePVCName    = "source-pvc"
+					sourceDVName     = "source-dv"
+					csiStorageClass  = "csi-clone-sc"
+					copyStorageClass = "copy-sc"
+				)
+
+				addStorageProfile := func(name string, strategy cdiv1.CDICloneStrategy) {
+					err := controller.storageProfileStore.Add(&cdiv1.StorageProfile{
+						ObjectMeta: metav1.ObjectMeta{Name: name},
+						Status:     cdiv1.StorageProfileStatus{CloneStrategy: &strategy},
+					})
+					Expect(err).ShouldNot(HaveOccurred())
+				}
+
+				createSourcePVC := func(name, storageClass string) *k8sv1.PersistentVolumeClaim {
+					pvc := createPVC(metav1.NamespaceDefault, k8sv1.ClaimBound)
+					pvc.Name = name
+					pvc.Spec.StorageClassName = pointer.P(storageClass)
+					pvc.Status.Capacity = k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("2Gi")}
+					return pvc
+				}
+
+				BeforeEach(func() {
+					sourceVM.Spec.Template.Spec.Volumes = append(sourceVM.Spec.Template.Spec.Volumes,
+						virtv1.Volume{
+							Name: pvcVolumeName,
+							VolumeSource: virtv1.VolumeSource{
+								PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+									PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: sourcePVCName},
+								},
+							},
+						},
+						virtv1.Volume{
+							Name: dvVolumeName,
+							VolumeSource: virtv1.VolumeSource{
+								DataVolume: &virtv1.DataVolumeSource{Name: sourceDVName},
+							},
+						},
+					)
+					sourceVM.Spec.DataVolumeTemplates = []virtv1.DataVolumeTemplateSpec{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: sourceDVName},
+							Spec:       cdiv1.DataVolumeSpec{Storage: &cdiv1.StorageSpec{}},
+						},
+					}
+					vmClone.Spec.Storage = &clone.VirtualMachineCloneStorage{
+						CloneStrategy: pointer.P(clone.CloneStrategyLinked),
+					}
+				})
+
+				It("should move to creating the target VM without a snapshot", func() {
+					addVM(sourceVM)
+					addClone(vmClone)
+
+					sanityExecute()
+					expectSnapshotDoesNotExist()
+					expectCloneBeInPhase(clone.CreatingTargetVM)
+				})
+
+				It("should fail if the source VM has an instancetype", func() {
+					sourceVM.Spec.Instancetype = &virtv1.InstancetypeMatcher{Name: "instancetype"}
+					addVM(sourceVM)
+					addClone(vmClone)
+
+					sanityExecute()
+					expectEvent(VolumeCloneInvalid)
+					expectSnapshotDoesNotExist()
+					expectCloneBeInPhase(clone.Failed)
+				})
+
+				It("should clone volumes through CSI where supported and back the others by the source PVC", func() {
+					addStorageProfile(csiStorageClass, cdiv1.CloneStrategyCsiClone)
+					addStorageProfile(copyStorageClass, cdiv1.CloneStrategyHostAssisted)
+					addPVC(createSourcePVC(sourcePVCName, csiStorageClass))
+					addPVC(createSourcePVC(sourceDVName, copyStorageClass))
+
+					vmClone.Status.Phase = clone.CreatingTargetVM
+					vmClone.Status.TargetName = pointer.P(vmClone.Spec.Target.Name)
+					addVM(sourceVM)
+					addClone(vmClone)
+
+					sanityExecute()
+					expectSnapshotDoesNotExist()
+					expectCloneBeInPhase(clone.CreatingTargetVM)
+
+					claimName := fmt.Sprintf("clone-%s-%s", testCloneUID, pvcVolumeName)
+					targetVM, err := client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.TODO(), vmClone.Spec.Target.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(targetVM.Annotations).To(HaveKeyWithValue(cloneUIDAnnotation, testCloneUID))
+					Expect(targetVM.Annotations).To(HaveKeyWithValue(clone.SourceVMNameAnnotation, sourceVM.Name))
+					Expect(targetVM.Annotations).ToNot(HaveKey(clone.SourceSnapshotUIDAnnotation))
+					Expect(targetVM.RunStrategy()).To(Equal(virtv1.RunStrategyHalted))
+					Expect(targetVM.Spec.DataVolumeTemplates).To(BeEmpty())
+					Expect(targetVM.Spec.Template.Spec.Volumes).To(ContainElements(
+						HaveField("PersistentVolumeClaim.ClaimName", claimName),
+						HaveField("Ephemeral.PersistentVolumeClaim", Equal(&k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: sourceDVName,
+							ReadOnly:  true,
+						})),
+					))
+
+					pvc, err := pvcClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Get(context.TODO(), claimName, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pvc.Spec.DataSource).To(Equal(&k8sv1.TypedLocalObjectReference{Kind: "PersistentVolumeClaim", Name: sourcePVCName}))
+					Expect(pvc.Spec.StorageClassName).To(HaveValue(Equal(csiStorageClass)))
+					Expect(pvc.Spec.Resources.Requests).To(HaveKeyWithValue(k8sv1.ResourceStorage, resource.MustParse("2Gi")))
+					Expect(pvc.OwnerReferences).To(ConsistOf(HaveField("Kind", "VirtualMachine")))
+
+					pvcs, err := pvcClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).List(context.TODO(), metav1.ListOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pvcs.Items).To(HaveLen(1))
+				})
+
+				It("should move to Succeeded phase once the target VM exists", func() {
+					addPVC(createSourcePVC(sourcePVCName, copyStorageClass))
+					addPVC(createSourcePVC(sourceDVName, copyStorageClass))
+
+					targetVM := sourceVM.DeepCopy()
+					targetVM.Name = vmClone.Spec.Target.Name
+					targetVM.Annotations = map[string]string{cloneUIDAnnotation: testCloneUID}
+					vmClone.Status.Phase = clone.CreatingTargetVM
+					vmClone.Status.TargetName = pointer.P(vmClone.Spec.Target.Name)
+					addVM(sourceVM)
+					addVM(targetVM)
+					addClone(vmClone)
+
+					sanityExecute()
+					expectEvent(TargetVMCreated)
+					expectCloneBeInPhase(clone.Succeeded)
+				})
+			})
 		})
 
 		Context("with source snapshot", func() {
@@ -707,11 +984,32 @@ var _ = Describe("Clone", func() {
 				expectCloneBeInPhase(clone.Failed)
 			})
 
-			It("when snapshot is ready - should update status and create restore", func() {
+			It("when snapshot is ready - should update status and move to creating the target VM without a restore", func() {
+				snapshot := createVirtualMachineSnapshot(sourceVM)
+				snapshot.Status.ReadyToUse = pointer.P(true)
+				setSnapshotSource(vmClone, snapshot.Name)
+				snapshotContent := createVirtualMachineSnapshotContent(sourceVM)
+
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addSnapshotContent(snapshotContent)
+
+				sanityExecute()
+				expectEvent(SnapshotReady)
+				expectCloneBeInPhase(clone.CreatingTargetVM)
+				expectRestoreDoesNotExist()
+
+				updatedClone, err := client.CloneV1beta1().VirtualMachineClones(metav1.NamespaceDefault).Get(context.TODO(), vmClone.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedClone.Status.TargetName).To(HaveValue(Equal(vmClone.Spec.Target.Name)))
+			})
+
+			It("when snapshot of a VM with an instancetype is ready - should update status and create restore", func() {
 				snapshot := createVirtualMachineSnapshot(sourceVM)
 				snapshot.Status.ReadyToUse = pointer.P(true)
 				setSnapshotSource(vmClone, snapshot.Name)
 				snapshotContent := createVirtualMachineSnapshotContent(sourceVM)
+				snapshotContent.Spec.Source.VirtualMachine.Spec.Instancetype = &virtv1.InstancetypeMatcher{Name: "instancetype"}
 
 				addClone(vmClone)
 				addSnapshot(snapshot)
@@ -728,6 +1026,7 @@ var _ = Describe("Clone", func() {
 				snapshot := createVirtualMachineSnapshot(sourceVM)
 				snapshot.Status.ReadyToUse = pointer.P(true)
 				snapshotContent := createVirtualMachineSnapshotContent(sourceVM)
+				snapshotContent.Spec.Source.VirtualMachine.Spec.Instancetype = &virtv1.InstancetypeMatcher{Name: "instancetype"}
 
 				setSnapshotSource(vmClone, snapshot.Name)
 
@@ -744,6 +1043,191 @@ var _ = Describe("Clone", func() {
 				sanityExecute()
 				expectCloneBeInPhase(clone.RestoreInProgress)
 			})
+
+			Context("when creating the target VM", func() {
+				const (
+					pvcVolumeName = "pvc-volume"
+					dvVolumeName  = "dv-volume"
+					sourcePVCName = "source-pvc"
+					sourceDVName  = "source-dv"
+				)
+
+				expectedClaimName := func(volumeName string) string {
+					return fmt.Sprintf("clone-%s-%s", testCloneUID, volumeName)
+				}
+
+				getPVC := func(name string) *k8sv1.PersistentVolumeClaim {
+					pvc, err := pvcClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Get(context.TODO(), name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					return pvc
+				}
+
+				BeforeEach(func() {
+					sourceVM.Spec.Template.Spec.Volumes = append(sourceVM.Spec.Template.Spec.Volumes,
+						virtv1.Volume{
+							Name: pvcVolumeName,
+							VolumeSource: virtv1.VolumeSource{
+								PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+									PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: sourcePVCName},
+								},
+							},
+						},
+						virtv1.Volume{
+							Name: dvVolumeName,
+							VolumeSource: virtv1.VolumeSource{
+								DataVolume: &virtv1.DataVolumeSource{Name: sourceDVName},
+							},
+						},
+					)
+					sourceVM.Spec.Template.Spec.Domain.Devices.Disks = append(sourceVM.Spec.Template.Spec.Domain.Devices.Disks,
+						virtv1.Disk{Name: pvcVolumeName},
+						virtv1.Disk{Name: dvVolumeName},
+					)
+					sourceVM.Spec.DataVolumeTemplates = []virtv1.DataVolumeTemplateSpec{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: sourceDVName},
+							Spec:       cdiv1.DataVolumeSpec{Storage: &cdiv1.StorageSpec{}},
+						},
+					}
+					sourceVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = "DE-AD-00-00-BE-AF"
+
+					snapshot := createVirtualMachineSnapshot(sourceVM)
+					snapshot.Status.ReadyToUse = pointer.P(true)
+					snapshotContent := createVirtualMachineSnapshotContent(sourceVM)
+					snapshotContent.Spec.VolumeBackups = []snapshotv1.VolumeBackup{
+						createVolumeBackup(pvcVolumeName, sourcePVCName),
+						createVolumeBackup(dvVolumeName, sourceDVName),
+					}
+					for _, volumeBackup := range snapshotContent.Spec.VolumeBackups {
+						_, err := k8sSnapshotClient.SnapshotV1().VolumeSnapshots(metav1.NamespaceDefault).Create(context.TODO(), &vsv1.VolumeSnapshot{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      *volumeBackup.VolumeSnapshotName,
+								Namespace: metav1.NamespaceDefault,
+							},
+						}, metav1.CreateOptions{})
+						Expect(err).ToNot(HaveOccurred())
+					}
+
+					setSnapshotSource(vmClone, snapshot.Name)
+					vmClone.Status.Phase = clone.CreatingTargetVM
+					vmClone.Status.TargetName = pointer.P(vmClone.Spec.Target.Name)
+
+					addSnapshot(snapshot)
+					addSnapshotContent(snapshotContent)
+				})
+
+				It("should create the target VM and its PVCs from the snapshot content", func() {
+					addClone(vmClone)
+
+					sanityExecute()
+					expectCloneBeInPhase(clone.CreatingTargetVM)
+					expectRestoreDoesNotExist()
+
+					targetVM, err := client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.TODO(), vmClone.Spec.Target.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(targetVM.Annotations).To(HaveKeyWithValue(cloneUIDAnnotation, testCloneUID))
+					Expect(targetVM.Annotations).To(HaveKeyWithValue(clone.SourceVMNameAnnotation, sourceVM.Name))
+					Expect(targetVM.Annotations).To(HaveKeyWithValue(clone.SourceSnapshotUIDAnnotation, "snapshot-UID"))
+					Expect(targetVM.RunStrategy()).To(Equal(virtv1.RunStrategyHalted))
+					Expect(targetVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(BeEmpty())
+					Expect(targetVM.Spec.DataVolumeTemplates).To(HaveLen(1))
+					Expect(targetVM.Spec.DataVolumeTemplates[0].Name).To(Equal(expectedClaimName(dvVolumeName)))
+					Expect(targetVM.Spec.Template.Spec.Volumes).To(ContainElements(
+						HaveField("PersistentVolumeClaim.ClaimName", expectedClaimName(pvcVolumeName)),
+						HaveField("DataVolume.Name", expectedClaimName(dvVolumeName)),
+					))
+
+					pvc := getPVC(expectedClaimName(pvcVolumeName))
+					Expect(pvc.Spec.DataSource).To(HaveValue(HaveField("Name", "vs-"+pvcVolumeName)))
+					Expect(pvc.OwnerReferences).To(ConsistOf(HaveField("Kind", "VirtualMachine")))
+
+					dvPVC := getPVC(expectedClaimName(dvVolumeName))
+					Expect(dvPVC.Spec.DataSource).To(HaveValue(HaveField("Name", "vs-"+dvVolumeName)))
+					Expect(dvPVC.Annotations).To(HaveKeyWithValue("cdi.kubevirt.io/storage.populatedFor", expectedClaimName(dvVolumeName)))
+					Expect(dvPVC.OwnerReferences).To(BeEmpty())
+				})
+
+				It("should create the target VM in the target namespace with DataVolumes cloning the volume snapshots", func() {
+					const targetNamespace = "tenant"
+					vmClone.Spec.TargetNamespace = targetNamespace
+					addClone(vmClone)
+
+					sanityExecute()
+					expectCloneBeInPhase(clone.CreatingTargetVM)
+
+					targetVM, err := client.KubevirtV1().VirtualMachines(targetNamespace).Get(context.TODO(), vmClone.Spec.Target.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(targetVM.Annotations).To(HaveKeyWithValue(clone.SourceVMNamespaceAnnotation, metav1.NamespaceDefault))
+					Expect(targetVM.Spec.Template.Spec.Volumes).To(ContainElements(
+						HaveField("DataVolume.Name", expectedClaimName(pvcVolumeName)),
+						HaveField("DataVolume.Name", expectedClaimName(dvVolumeName)),
+					))
+					Expect(targetVM.Spec.DataVolumeTemplates).To(HaveLen(2))
+					for _, volumeName := range []string{pvcVolumeName, dvVolumeName} {
+						Expect(targetVM.Spec.DataVolumeTemplates).To(ContainElement(And(
+							HaveField("Name", expectedClaimName(volumeName)),
+							HaveField("Spec.Source.Snapshot", Equal(&cdiv1.DataVolumeSourceSnapshot{
+								Namespace: metav1.NamespaceDefault,
+								Name:      "vs-" + volumeName,
+							})),
+						)))
+					}
+
+					pvcs, err := pvcClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pvcs.Items).To(BeEmpty())
+				})
+
+				It("should exclude and resize volumes of the target VM", func() {
+					size := resource.MustParse("10Gi")
+					vmClone.Spec.Volumes = []clone.VirtualMachineCloneVolume{
+						{Name: pvcVolumeName, Exclude: true},
+						{Name: dvVolumeName, Size: &size},
+					}
+					addClone(vmClone)
+
+					sanityExecute()
+					expectCloneBeInPhase(clone.CreatingTargetVM)
+
+					targetVM, err := client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.TODO(), vmClone.Spec.Target.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(targetVM.Spec.Template.Spec.Volumes).ToNot(ContainElement(HaveField("Name", pvcVolumeName)))
+					Expect(targetVM.Spec.Template.Spec.Domain.Devices.Disks).ToNot(ContainElement(HaveField("Name", pvcVolumeName)))
+					Expect(targetVM.Spec.Template.Spec.Domain.Devices.Disks).To(ContainElement(HaveField("Name", dvVolumeName)))
+					Expect(targetVM.Spec.DataVolumeTemplates).To(HaveLen(1))
+					Expect(targetVM.Spec.DataVolumeTemplates[0].Spec.Storage.Resources.Requests).To(HaveKeyWithValue(k8sv1.ResourceStorage, size))
+
+					_, err = pvcClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Get(context.TODO(), expectedClaimName(pvcVolumeName), metav1.GetOptions{})
+					Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+
+					dvPVC := getPVC(expectedClaimName(dvVolumeName))
+					Expect(dvPVC.Spec.Resources.Requests).To(HaveKeyWithValue(k8sv1.ResourceStorage, size))
+					Expect(dvPVC.Annotations).To(HaveKeyWithValue(cloneNameAnnotation, vmClone.Name))
+				})
+
+				It("should move to Succeeded phase once the target VM exists", func() {
+					targetVM := sourceVM.DeepCopy()
+					targetVM.Name = vmClone.Spec.Target.Name
+					targetVM.Annotations = map[string]string{cloneUIDAnnotation: testCloneUID}
+					addVM(targetVM)
+					addClone(vmClone)
+
+					sanityExecute()
+					expectEvent(TargetVMCreated)
+					expectCloneBeInPhase(clone.Succeeded)
+				})
+
+				It("should fail if the target VM was not created by the clone", func() {
+					targetVM := sourceVM.DeepCopy()
+					targetVM.Name = vmClone.Spec.Target.Name
+					addVM(targetVM)
+					addClone(vmClone)
+
+					sanityExecute()
+					expectEvent(TargetVMExists)
+					expectCloneBeInPhase(clone.Failed)
+				})
+			})
 		})
 	})
 
@@ -1207,6 +1691,21 @@ func createVirtualMachineRestore(vm *virtv1.VirtualMachine, snapshotName string,
 	}
 }
 
+func createVolumeBackup(volumeName, claimName string) snapshotv1.VolumeBackup {
+	return snapshotv1.VolumeBackup{
+		VolumeName: volumeName,
+		PersistentVolumeClaim: snapshotv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: claimName,
+			},
+			Spec: k8sv1.PersistentVolumeClaimSpec{
+				AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+			},
+		},
+		VolumeSnapshotName: pointer.P("vs-" + volumeName),
+	}
+}
+
 func createPVC(namespace string, phase k8sv1.PersistentVolumeClaimPhase) *k8sv1.PersistentVolumeClaim {
 	return &k8sv1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
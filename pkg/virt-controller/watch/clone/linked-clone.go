@@ -0,0 +1,283 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package clone
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clone "kubevirt.io/api/clone/v1beta1"
+	k6tv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+func isLinkedClone(vmClone *clone.VirtualMachineClone) bool {
+	return vmClone.Spec.Storage != nil && vmClone.Spec.Storage.CloneStrategy != nil &&
+		*vmClone.Spec.Storage.CloneStrategy == clone.CloneStrategyLinked
+}
+
+func getSourceClaimName(volume *k6tv1.Volume) string {
+	switch {
+	case volume.PersistentVolumeClaim != nil:
+		return volume.PersistentVolumeClaim.ClaimName
+	case volume.DataVolume != nil:
+		return volume.DataVolume.Name
+	}
+	return ""
+}
+
+// createLinkedTargetVM creates the target VM of a linked clone straight from the source VM, without a snapshot.
+// Every volume backed by a PVC is either cloned by the CSI driver or backed by a qcow2 overlay on top of the source PVC.
+func (ctrl *VMCloneController) createLinkedTargetVM(vmClone *clone.VirtualMachineClone, sourceVM *k6tv1.VirtualMachine, syncInfo syncInfoType) syncInfoType {
+	targetVMName := getTargetVMName(vmClone, sourceVM.Name)
+
+	sourcePVCs, err := ctrl.getSourcePVCs(vmClone, sourceVM)
+	if err != nil {
+		syncInfo.setError(err)
+		return syncInfo
+	}
+
+	csiCloneVolumes := map[string]bool{}
+	for volumeName, sourcePVC := range sourcePVCs {
+		supported, err := ctrl.supportsCSIClone(sourcePVC)
+		if err != nil {
+			syncInfo.setError(err)
+			return syncInfo
+		}
+		csiCloneVolumes[volumeName] = supported
+	}
+
+	targetVM, err := ctrl.getOrCreateLinkedTargetVM(vmClone, sourceVM, targetVMName, csiCloneVolumes)
+	if err != nil {
+		syncInfo.setError(err)
+		return syncInfo
+	}
+	if targetVM.Annotations[cloneUIDAnnotation] != string(vmClone.UID) {
+		syncInfo.isCloneFailing = true
+		syncInfo.event = TargetVMExists
+		syncInfo.reason = fmt.Sprintf("target VM %s already exists and was not created by clone %s", targetVMName, vmClone.Name)
+		return syncInfo
+	}
+
+	for volumeName, sourcePVC := range sourcePVCs {
+		if !csiCloneVolumes[volumeName] {
+			continue
+		}
+		if err := ctrl.createCSIClonePVC(vmClone, targetVM, sourcePVC, generatePVCName(vmClone.UID, volumeName)); err != nil {
+			syncInfo.setError(err)
+			return syncInfo
+		}
+	}
+
+	syncInfo.targetVMName = targetVMName
+	return syncInfo
+}
+
+// getSourcePVCs returns the PVCs backing the volumes of the source VM which are part of the clone, by volume name
+func (ctrl *VMCloneController) getSourcePVCs(vmClone *clone.VirtualMachineClone, sourceVM *k6tv1.VirtualMachine) (map[string]*corev1.PersistentVolumeClaim, error) {
+	sourcePVCs := map[string]*corev1.PersistentVolumeClaim{}
+	for i := range sourceVM.Spec.Template.Spec.Volumes {
+		volume := &sourceVM.Spec.Template.Spec.Volumes[i]
+		claimName := getSourceClaimName(volume)
+		if claimName == "" || isVolumeExcluded(vmClone, volume.Name) {
+			continue
+		}
+
+		obj, exists, err := ctrl.pvcStore.GetByKey(getKey(claimName, sourceVM.Namespace))
+		if err != nil {
+			return nil, fmt.Errorf("error getting PVC %s from cache for clone %s: %v", claimName, vmClone.Name, err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("PVC %s of volume %s is not created yet for clone %s", claimName, volume.Name, vmClone.Name)
+		}
+		sourcePVCs[volume.Name] = obj.(*corev1.PersistentVolumeClaim)
+	}
+
+	return sourcePVCs, nil
+}
+
+// supportsCSIClone returns true if the CDI StorageProfile of the storage class of the PVC reports
+// that the CSI driver clones volumes itself, which is expected to be copy-on-write.
+func (ctrl *VMCloneController) supportsCSIClone(pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return false, nil
+	}
+
+	obj, exists, err := ctrl.storageProfileStore.GetByKey(*pvc.Spec.StorageClassName)
+	if err != nil {
+		return false, fmt.Errorf("error getting StorageProfile %s from cache: %v", *pvc.Spec.StorageClassName, err)
+	}
+	if !exists {
+		return false, nil
+	}
+
+	storageProfile := obj.(*cdiv1.StorageProfile)
+	return storageProfile.Status.CloneStrategy != nil && *storageProfile.Status.CloneStrategy == cdiv1.CloneStrategyCsiClone, nil
+}
+
+func (ctrl *VMCloneController) getOrCreateLinkedTargetVM(vmClone *clone.VirtualMachineClone, sourceVM *k6tv1.VirtualMachine, targetVMName string, csiCloneVolumes map[string]bool) (*k6tv1.VirtualMachine, error) {
+	obj, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMName, vmClone.Namespace))
+	if err != nil {
+		return nil, fmt.Errorf("error getting VM %s from cache for clone %s: %v", targetVMName, vmClone.Name, err)
+	}
+	if exists {
+		return obj.(*k6tv1.VirtualMachine), nil
+	}
+
+	targetVM, err := generateLinkedTargetVM(vmClone, sourceVM, targetVMName, csiCloneVolumes)
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate target VM %s from VM %s for clone %s: %v", targetVMName, sourceVM.Name, vmClone.Name, err)
+	}
+
+	log.Log.Object(vmClone).Infof("creating linked target VM %s from VM %s for clone %s", targetVMName, sourceVM.Name, vmClone.Name)
+	createdVM, err := ctrl.client.VirtualMachine(vmClone.Namespace).Create(context.Background(), targetVM, metav1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		createdVM, err = ctrl.client.VirtualMachine(vmClone.Namespace).Get(context.Background(), targetVMName, metav1.GetOptions{})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed creating target VM %s for clone %s: %v", targetVMName, vmClone.Name, err)
+	}
+
+	return createdVM, nil
+}
+
+// generateLinkedTargetVM creates the target VM out of the source VM. Volumes listed in csiCloneVolumes are backed
+// by the PVCs cloned by the CSI driver, every other PVC backed volume becomes an ephemeral volume, for which
+// virt-launcher creates a qcow2 overlay with the source PVC as its read-only backing file.
+func generateLinkedTargetVM(vmClone *clone.VirtualMachineClone, sourceVM *k6tv1.VirtualMachine, targetVMName string, csiCloneVolumes map[string]bool) (*k6tv1.VirtualMachine, error) {
+	sourceVMCopy := &k6tv1.VirtualMachine{
+		ObjectMeta: *sourceVM.ObjectMeta.DeepCopy(),
+		Spec:       *sourceVM.Spec.DeepCopy(),
+	}
+
+	targetVM, err := generateHaltedTargetVM(vmClone, sourceVMCopy, targetVMName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var volumes []k6tv1.Volume
+	for _, volume := range targetVM.Spec.Template.Spec.Volumes {
+		if volume.MemoryDump != nil {
+			continue
+		}
+		if isVolumeExcluded(vmClone, volume.Name) {
+			excludeVolume(targetVM, volume)
+			continue
+		}
+
+		sourceClaimName := getSourceClaimName(&volume)
+		if sourceClaimName == "" {
+			volumes = append(volumes, volume)
+			continue
+		}
+
+		var hotpluggable bool
+		if volume.DataVolume != nil {
+			hotpluggable = volume.DataVolume.Hotpluggable
+			// The DataVolume stays owned by the source VM
+			if templateIndex := findDVTemplateIndex(volume.DataVolume.Name, targetVM); templateIndex >= 0 {
+				dvTemplates := targetVM.Spec.DataVolumeTemplates
+				targetVM.Spec.DataVolumeTemplates = append(dvTemplates[:templateIndex:templateIndex], dvTemplates[templateIndex+1:]...)
+			}
+		} else {
+			hotpluggable = volume.PersistentVolumeClaim.Hotpluggable
+		}
+
+		if csiCloneVolumes[volume.Name] {
+			volume.VolumeSource = k6tv1.VolumeSource{
+				PersistentVolumeClaim: &k6tv1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: generatePVCName(vmClone.UID, volume.Name),
+					},
+					Hotpluggable: hotpluggable,
+				},
+			}
+		} else {
+			volume.VolumeSource = k6tv1.VolumeSource{
+				Ephemeral: &k6tv1.EphemeralVolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: sourceClaimName,
+						ReadOnly:  true,
+					},
+				},
+			}
+		}
+		volumes = append(volumes, volume)
+	}
+	targetVM.Spec.Template.Spec.Volumes = volumes
+
+	return targetVM, nil
+}
+
+// createCSIClonePVC creates a PVC which is populated by the CSI driver from the source PVC
+func (ctrl *VMCloneController) createCSIClonePVC(vmClone *clone.VirtualMachineClone, targetVM *k6tv1.VirtualMachine, sourcePVC *corev1.PersistentVolumeClaim, claimName string) error {
+	_, exists, err := ctrl.pvcStore.GetByKey(getKey(claimName, vmClone.Namespace))
+	if err != nil {
+		return fmt.Errorf("error getting PVC %s from cache for clone %s: %v", claimName, vmClone.Name, err)
+	}
+	if exists {
+		return nil
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      claimName,
+			Namespace: vmClone.Namespace,
+			Annotations: map[string]string{
+				cloneNameAnnotation: vmClone.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(targetVM, k6tv1.VirtualMachineGroupVersionKind),
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      sourcePVC.Spec.AccessModes,
+			Resources:        *sourcePVC.Spec.Resources.DeepCopy(),
+			StorageClassName: sourcePVC.Spec.StorageClassName,
+			VolumeMode:       sourcePVC.Spec.VolumeMode,
+			DataSource: &corev1.TypedLocalObjectReference{
+				Kind: "PersistentVolumeClaim",
+				Name: sourcePVC.Name,
+			},
+		},
+	}
+	// A CSI clone cannot be smaller than its source
+	if capacity, ok := sourcePVC.Status.Capacity[corev1.ResourceStorage]; ok {
+		if pvc.Spec.Resources.Requests == nil {
+			pvc.Spec.Resources.Requests = corev1.ResourceList{}
+		}
+		if request, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; !ok || request.Cmp(capacity) < 0 {
+			pvc.Spec.Resources.Requests[corev1.ResourceStorage] = capacity
+		}
+	}
+
+	log.Log.Object(vmClone).Infof("creating PVC %s as CSI clone of PVC %s for clone %s", claimName, sourcePVC.Name, vmClone.Name)
+	_, err = ctrl.client.CoreV1().PersistentVolumeClaims(vmClone.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed creating PVC %s for clone %s: %v", claimName, vmClone.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,538 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package clone
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	clone "kubevirt.io/api/clone/v1beta1"
+	k6tv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	virtsnapshot "kubevirt.io/kubevirt/pkg/storage/snapshot"
+)
+
+const (
+	cloneUIDAnnotation       = "clone.kubevirt.io/clone-uid"
+	cloneNameAnnotation      = "clone.kubevirt.io/clone-name"
+	cloneNamespaceAnnotation = "clone.kubevirt.io/clone-namespace"
+)
+
+// cloneDirectlyFromSnapshot returns true if the target VM of a clone with a snapshot source can
+// be created from the snapshot content, without restoring the snapshot first.
+// The instancetype and preference revisions of the snapshot are only restored by a restore.
+func cloneDirectlyFromSnapshot(vm *k6tv1.VirtualMachine) bool {
+	return vm.Spec.Instancetype == nil && vm.Spec.Preference == nil
+}
+
+// isCrossNamespaceClone returns true if the target of the clone is created outside of the namespace of the clone.
+// Such a target can only be created from the snapshot content, a restore cannot target another namespace.
+func isCrossNamespaceClone(vmClone *clone.VirtualMachineClone) bool {
+	return getTargetNamespace(vmClone) != vmClone.Namespace
+}
+
+func getVolumeClone(vmClone *clone.VirtualMachineClone, volumeName string) *clone.VirtualMachineCloneVolume {
+	for i := range vmClone.Spec.Volumes {
+		if vmClone.Spec.Volumes[i].Name == volumeName {
+			return &vmClone.Spec.Volumes[i]
+		}
+	}
+	return nil
+}
+
+func isVolumeExcluded(vmClone *clone.VirtualMachineClone, volumeName string) bool {
+	volumeClone := getVolumeClone(vmClone, volumeName)
+	return volumeClone != nil && volumeClone.Exclude
+}
+
+func getVolumeSize(vmClone *clone.VirtualMachineClone, volumeName string) *resource.Quantity {
+	if volumeClone := getVolumeClone(vmClone, volumeName); volumeClone != nil {
+		return volumeClone.Size
+	}
+	return nil
+}
+
+func getTargetVMName(vmClone *clone.VirtualMachineClone, sourceVMName string) string {
+	if vmClone.Status.TargetName != nil {
+		return *vmClone.Status.TargetName
+	}
+	if vmClone.Spec.Target != nil && vmClone.Spec.Target.Name != "" {
+		return vmClone.Spec.Target.Name
+	}
+	return generateVMName(sourceVMName)
+}
+
+func (ctrl *VMCloneController) createTargetVMFromSnapshot(vmClone *clone.VirtualMachineClone, snapshot *snapshotv1.VirtualMachineSnapshot, syncInfo syncInfoType) syncInfoType {
+	content, err := ctrl.getSnapshotContent(snapshot)
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("cannot get snapshot content of snapshot %s for clone %s: %v", snapshot.Name, vmClone.Name, err))
+		return syncInfo
+	}
+
+	targetVMName := getTargetVMName(vmClone, content.Spec.Source.VirtualMachine.Name)
+	targetVM, err := ctrl.getOrCreateTargetVM(vmClone, snapshot, content, targetVMName)
+	if err != nil {
+		syncInfo.setError(err)
+		return syncInfo
+	}
+	if targetVM.Annotations[cloneUIDAnnotation] != string(vmClone.UID) {
+		syncInfo.isCloneFailing = true
+		syncInfo.event = TargetVMExists
+		syncInfo.reason = fmt.Sprintf("target VM %s already exists and was not created by clone %s", targetVMName, vmClone.Name)
+		return syncInfo
+	}
+
+	// The volumes of a target in another namespace are cloned by CDI through DataVolume templates
+	if !isCrossNamespaceClone(vmClone) {
+		if err := ctrl.createTargetPVCs(vmClone, content, targetVM); err != nil {
+			syncInfo.setError(err)
+			return syncInfo
+		}
+	}
+
+	syncInfo.targetVMName = targetVMName
+	return syncInfo
+}
+
+func (ctrl *VMCloneController) getOrCreateTargetVM(vmClone *clone.VirtualMachineClone, snapshot *snapshotv1.VirtualMachineSnapshot, content *snapshotv1.VirtualMachineSnapshotContent, targetVMName string) (*k6tv1.VirtualMachine, error) {
+	targetNamespace := getTargetNamespace(vmClone)
+	obj, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMName, targetNamespace))
+	if err != nil {
+		return nil, fmt.Errorf("error getting VM %s from cache for clone %s: %v", targetVMName, vmClone.Name, err)
+	}
+	if exists {
+		return obj.(*k6tv1.VirtualMachine), nil
+	}
+
+	targetVM, err := generateTargetVMFromSnapshot(vmClone, snapshot, content, targetVMName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate target VM %s from snapshot content %s for clone %s: %v", targetVMName, content.Name, vmClone.Name, err)
+	}
+
+	log.Log.Object(vmClone).Infof("creating target VM %s/%s from snapshot content %s for clone %s", targetNamespace, targetVMName, content.Name, vmClone.Name)
+	createdVM, err := ctrl.client.VirtualMachine(targetNamespace).Create(context.Background(), targetVM, metav1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		createdVM, err = ctrl.client.VirtualMachine(targetNamespace).Get(context.Background(), targetVMName, metav1.GetOptions{})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed creating target VM %s for clone %s: %v", targetVMName, vmClone.Name, err)
+	}
+
+	return createdVM, nil
+}
+
+// generateTargetVMFromSnapshot creates the target VM out of the VM stored in the snapshot content.
+// Its volumes are backed by PVCs which are populated from the volume snapshots of the content.
+func generateTargetVMFromSnapshot(vmClone *clone.VirtualMachineClone, snapshot *snapshotv1.VirtualMachineSnapshot, content *snapshotv1.VirtualMachineSnapshotContent, targetVMName string) (*k6tv1.VirtualMachine, error) {
+	snapshotVM := content.Spec.Source.VirtualMachine
+	sourceVM := &k6tv1.VirtualMachine{
+		ObjectMeta: *snapshotVM.ObjectMeta.DeepCopy(),
+		Spec:       *snapshotVM.Spec.DeepCopy(),
+	}
+
+	targetVM, err := generateHaltedTargetVM(vmClone, sourceVM, targetVMName, snapshot.UID)
+	if err != nil {
+		return nil, err
+	}
+
+	var volumes []k6tv1.Volume
+	for _, volume := range targetVM.Spec.Template.Spec.Volumes {
+		if volume.MemoryDump != nil {
+			continue
+		}
+		if isVolumeExcluded(vmClone, volume.Name) {
+			excludeVolume(targetVM, volume)
+			continue
+		}
+
+		claimName := generatePVCName(vmClone.UID, volume.Name)
+		if isCrossNamespaceClone(vmClone) && (volume.PersistentVolumeClaim != nil || volume.DataVolume != nil) {
+			dvTemplate, err := generateSnapshotDVTemplate(vmClone, content, volume.Name, claimName)
+			if err != nil {
+				return nil, err
+			}
+
+			var hotpluggable bool
+			if volume.DataVolume != nil {
+				hotpluggable = volume.DataVolume.Hotpluggable
+				if templateIndex := findDVTemplateIndex(volume.DataVolume.Name, targetVM); templateIndex >= 0 {
+					dvTemplates := targetVM.Spec.DataVolumeTemplates
+					targetVM.Spec.DataVolumeTemplates = append(dvTemplates[:templateIndex:templateIndex], dvTemplates[templateIndex+1:]...)
+				}
+			} else {
+				hotpluggable = volume.PersistentVolumeClaim.Hotpluggable
+			}
+			targetVM.Spec.DataVolumeTemplates = append(targetVM.Spec.DataVolumeTemplates, *dvTemplate)
+			volume.VolumeSource = k6tv1.VolumeSource{
+				DataVolume: &k6tv1.DataVolumeSource{
+					Name:         claimName,
+					Hotpluggable: hotpluggable,
+				},
+			}
+			volumes = append(volumes, volume)
+			continue
+		}
+
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			volume.PersistentVolumeClaim.ClaimName = claimName
+		case volume.DataVolume != nil:
+			if templateIndex := findDVTemplateIndex(volume.DataVolume.Name, targetVM); templateIndex >= 0 {
+				dvTemplate := &targetVM.Spec.DataVolumeTemplates[templateIndex]
+				dvTemplate.Name = claimName
+				if size := getVolumeSize(vmClone, volume.Name); size != nil {
+					resizeDVTemplate(dvTemplate, *size)
+				}
+				volume.DataVolume.Name = claimName
+				break
+			}
+			volume.VolumeSource = k6tv1.VolumeSource{
+				PersistentVolumeClaim: &k6tv1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: claimName,
+					},
+					Hotpluggable: volume.DataVolume.Hotpluggable,
+				},
+			}
+		}
+		volumes = append(volumes, volume)
+	}
+	targetVM.Spec.Template.Spec.Volumes = volumes
+
+	return targetVM, nil
+}
+
+// generateHaltedTargetVM applies the clone spec to a copy of the source VM and returns it, halted,
+// as the target VM. Its volumes still refer to the volumes of the source.
+func generateHaltedTargetVM(vmClone *clone.VirtualMachineClone, sourceVM *k6tv1.VirtualMachine, targetVMName string, snapshotUID types.UID) (*k6tv1.VirtualMachine, error) {
+	patches, err := generatePatches(sourceVM, &vmClone.Spec)
+	if err != nil {
+		return nil, err
+	}
+	patchedVM, err := patchVM(sourceVM, patches)
+	if err != nil {
+		return nil, err
+	}
+
+	targetVM := &k6tv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        targetVMName,
+			Namespace:   getTargetNamespace(vmClone),
+			Labels:      patchedVM.Labels,
+			Annotations: patchedVM.Annotations,
+		},
+		Spec: patchedVM.Spec,
+	}
+	// The target VM is not a member of the pool the source may have belonged to
+	delete(targetVM.Labels, k6tv1.VirtualMachinePoolRevisionName)
+	if targetVM.Annotations == nil {
+		targetVM.Annotations = map[string]string{}
+	}
+	targetVM.Annotations[cloneUIDAnnotation] = string(vmClone.UID)
+	for key, value := range generateProvenance(vmClone, sourceVM.Name, snapshotUID) {
+		targetVM.Annotations[key] = value
+	}
+
+	if targetVM.Spec.Running != nil {
+		targetVM.Spec.Running = pointer.P(false)
+	} else {
+		targetVM.Spec.RunStrategy = pointer.P(k6tv1.RunStrategyHalted)
+	}
+
+	return targetVM, nil
+}
+
+// generateSnapshotDVTemplate returns a DataVolume template which lets CDI clone the VolumeSnapshot of a volume
+// into the target namespace, since a PVC cannot be populated from a VolumeSnapshot of another namespace.
+func generateSnapshotDVTemplate(vmClone *clone.VirtualMachineClone, content *snapshotv1.VirtualMachineSnapshotContent, volumeName, claimName string) (*k6tv1.DataVolumeTemplateSpec, error) {
+	volumeBackup := getVolumeBackup(content, volumeName)
+	if volumeBackup == nil || volumeBackup.VolumeSnapshotName == nil {
+		return nil, fmt.Errorf(ErrVolumeNotBackedUp, volumeName, content.Name)
+	}
+
+	sourcePVCSpec := volumeBackup.PersistentVolumeClaim.Spec
+	resources := *sourcePVCSpec.Resources.DeepCopy()
+	if size := getVolumeSize(vmClone, volumeName); size != nil {
+		if resources.Requests == nil {
+			resources.Requests = corev1.ResourceList{}
+		}
+		resources.Requests[corev1.ResourceStorage] = *size
+	}
+
+	return &k6tv1.DataVolumeTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: claimName,
+			Annotations: map[string]string{
+				cloneNameAnnotation:      vmClone.Name,
+				cloneNamespaceAnnotation: vmClone.Namespace,
+			},
+		},
+		Spec: cdiv1.DataVolumeSpec{
+			Source: &cdiv1.DataVolumeSource{
+				Snapshot: &cdiv1.DataVolumeSourceSnapshot{
+					Namespace: content.Namespace,
+					Name:      *volumeBackup.VolumeSnapshotName,
+				},
+			},
+			Storage: &cdiv1.StorageSpec{
+				AccessModes:      sourcePVCSpec.AccessModes,
+				Resources:        resources,
+				StorageClassName: sourcePVCSpec.StorageClassName,
+				VolumeMode:       sourcePVCSpec.VolumeMode,
+			},
+		},
+	}, nil
+}
+
+// excludeVolume removes the disk or filesystem of an excluded volume, as well as
+// the DataVolume template backing it, from the target VM.
+func excludeVolume(targetVM *k6tv1.VirtualMachine, volume k6tv1.Volume) {
+	devices := &targetVM.Spec.Template.Spec.Domain.Devices
+
+	var disks []k6tv1.Disk
+	for _, disk := range devices.Disks {
+		if disk.Name != volume.Name {
+			disks = append(disks, disk)
+		}
+	}
+	devices.Disks = disks
+
+	var filesystems []k6tv1.Filesystem
+	for _, filesystem := range devices.Filesystems {
+		if filesystem.Name != volume.Name {
+			filesystems = append(filesystems, filesystem)
+		}
+	}
+	devices.Filesystems = filesystems
+
+	if volume.DataVolume == nil {
+		return
+	}
+	if templateIndex := findDVTemplateIndex(volume.DataVolume.Name, targetVM); templateIndex >= 0 {
+		dvTemplates := targetVM.Spec.DataVolumeTemplates
+		targetVM.Spec.DataVolumeTemplates = append(dvTemplates[:templateIndex:templateIndex], dvTemplates[templateIndex+1:]...)
+	}
+}
+
+func resizeDVTemplate(dvTemplate *k6tv1.DataVolumeTemplateSpec, size resource.Quantity) {
+	switch {
+	case dvTemplate.Spec.Storage != nil:
+		if dvTemplate.Spec.Storage.Resources.Requests == nil {
+			dvTemplate.Spec.Storage.Resources.Requests = corev1.ResourceList{}
+		}
+		dvTemplate.Spec.Storage.Resources.Requests[corev1.ResourceStorage] = size
+	case dvTemplate.Spec.PVC != nil:
+		if dvTemplate.Spec.PVC.Resources.Requests == nil {
+			dvTemplate.Spec.PVC.Resources.Requests = corev1.ResourceList{}
+		}
+		dvTemplate.Spec.PVC.Resources.Requests[corev1.ResourceStorage] = size
+	}
+}
+
+func (ctrl *VMCloneController) createTargetPVCs(vmClone *clone.VirtualMachineClone, content *snapshotv1.VirtualMachineSnapshotContent, targetVM *k6tv1.VirtualMachine) error {
+	for _, volume := range targetVM.Spec.Template.Spec.Volumes {
+		var claimName, dvOwner string
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			claimName = volume.PersistentVolumeClaim.ClaimName
+		case volume.DataVolume != nil:
+			claimName = volume.DataVolume.Name
+			dvOwner = volume.DataVolume.Name
+		default:
+			continue
+		}
+
+		_, exists, err := ctrl.pvcStore.GetByKey(getKey(claimName, vmClone.Namespace))
+		if err != nil {
+			return fmt.Errorf("error getting PVC %s from cache for clone %s: %v", claimName, vmClone.Name, err)
+		}
+		if exists {
+			continue
+		}
+
+		volumeBackup := getVolumeBackup(content, volume.Name)
+		if volumeBackup == nil {
+			return fmt.Errorf(ErrVolumeNotBackedUp, volume.Name, content.Name)
+		}
+
+		if err := ctrl.createTargetPVC(vmClone, targetVM, volumeBackup, claimName, dvOwner); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *VMCloneController) createTargetPVC(vmClone *clone.VirtualMachineClone, targetVM *k6tv1.VirtualMachine, volumeBackup *snapshotv1.VolumeBackup, claimName, dvOwner string) error {
+	if volumeBackup.VolumeSnapshotName == nil {
+		return fmt.Errorf("missing VolumeSnapshot name of volume %s for clone %s", volumeBackup.VolumeName, vmClone.Name)
+	}
+
+	volumeSnapshot, err := ctrl.client.KubernetesSnapshotClient().SnapshotV1().VolumeSnapshots(vmClone.Namespace).Get(context.Background(), *volumeBackup.VolumeSnapshotName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("cannot get VolumeSnapshot %s for clone %s: %v", *volumeBackup.VolumeSnapshotName, vmClone.Name, err)
+	}
+
+	pvc, err := virtsnapshot.CreateRestorePVCDef(claimName, volumeSnapshot, volumeBackup)
+	if err != nil {
+		return err
+	}
+	pvc.Namespace = vmClone.Namespace
+
+	if size := getVolumeSize(vmClone, volumeBackup.VolumeName); size != nil {
+		if pvc.Spec.Resources.Requests == nil {
+			pvc.Spec.Resources.Requests = corev1.ResourceList{}
+		}
+		// The restore size of the VolumeSnapshot is the lower bound of the PVC size
+		if currentSize, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; !ok || currentSize.Cmp(*size) < 0 {
+			pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *size
+		}
+	}
+
+	if pvc.Annotations == nil {
+		pvc.Annotations = map[string]string{}
+	}
+	pvc.Annotations[cloneNameAnnotation] = vmClone.Name
+
+	if dvOwner != "" {
+		// CDI adopts the PVC into the DataVolume of the target VM
+		pvc.Annotations[virtsnapshot.PopulatedForPVCAnnotation] = dvOwner
+	} else {
+		pvc.OwnerReferences = append(pvc.OwnerReferences, *metav1.NewControllerRef(targetVM, k6tv1.VirtualMachineGroupVersionKind))
+	}
+
+	log.Log.Object(vmClone).Infof("creating PVC %s from VolumeSnapshot %s for clone %s", claimName, *volumeBackup.VolumeSnapshotName, vmClone.Name)
+	_, err = ctrl.client.CoreV1().PersistentVolumeClaims(vmClone.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed creating PVC %s for clone %s: %v", claimName, vmClone.Name, err)
+	}
+
+	return nil
+}
+
+// verifyTargetPVCsBound checks that the PVCs created from the snapshot content are bound, after which
+// the snapshot taken of a source VM is no longer needed.
+func (ctrl *VMCloneController) verifyTargetPVCsBound(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
+	targetVMName := *vmClone.Status.TargetName
+	targetNamespace := getTargetNamespace(vmClone)
+	obj, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMName, targetNamespace))
+	if !exists {
+		syncInfo.setError(fmt.Errorf("target VM %s is not created yet for clone %s", targetVMName, vmClone.Name))
+		return syncInfo
+	} else if err != nil {
+		syncInfo.setError(fmt.Errorf("error getting VM %s from cache for clone %s: %v", targetVMName, vmClone.Name, err))
+		return syncInfo
+	}
+
+	targetVM := obj.(*k6tv1.VirtualMachine)
+	for _, volume := range targetVM.Spec.Template.Spec.Volumes {
+		var claimName string
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			claimName = volume.PersistentVolumeClaim.ClaimName
+		case volume.DataVolume != nil:
+			claimName = volume.DataVolume.Name
+		}
+		if claimName == "" || claimName != generatePVCName(vmClone.UID, volume.Name) {
+			continue
+		}
+
+		obj, exists, err = ctrl.pvcStore.GetByKey(getKey(claimName, targetNamespace))
+		if !exists {
+			syncInfo.setError(fmt.Errorf("PVC %s is not created yet for clone %s", claimName, vmClone.Name))
+			return syncInfo
+		} else if err != nil {
+			syncInfo.setError(fmt.Errorf("error getting PVC %s from cache for clone %s: %v", claimName, vmClone.Name, err))
+			return syncInfo
+		}
+
+		pvc := obj.(*corev1.PersistentVolumeClaim)
+		if pvc.Status.Phase != corev1.ClaimBound {
+			log.Log.Object(vmClone).V(defaultVerbosityLevel).Infof("pvc %s for clone %s is not bound yet", pvc.Name, vmClone.Name)
+			return syncInfo
+		}
+	}
+
+	ctrl.logAndRecord(vmClone, PVCBound, fmt.Sprintf("all PVC for clone %s are bound", vmClone.Name))
+	syncInfo.pvcBound = true
+
+	return syncInfo
+}
+
+func getVolumeBackup(content *snapshotv1.VirtualMachineSnapshotContent, volumeName string) *snapshotv1.VolumeBackup {
+	for i := range content.Spec.VolumeBackups {
+		if content.Spec.VolumeBackups[i].VolumeName == volumeName {
+			return &content.Spec.VolumeBackups[i]
+		}
+	}
+	return nil
+}
+
+func findDVTemplateIndex(dvName string, vm *k6tv1.VirtualMachine) int {
+	for i, dvt := range vm.Spec.DataVolumeTemplates {
+		if dvt.Name == dvName {
+			return i
+		}
+	}
+	return -1
+}
+
+func patchVM(vm *k6tv1.VirtualMachine, patches []string) (*k6tv1.VirtualMachine, error) {
+	if len(patches) == 0 {
+		return vm, nil
+	}
+
+	marshalledVM, err := json.Marshal(vm)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshall VM %s: %v", vm.Name, err)
+	}
+
+	jsonPatch := "[\n" + strings.Join(patches, ",\n") + "\n]"
+	patch, err := jsonpatch.DecodePatch([]byte(jsonPatch))
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode vm patches %s: %v", jsonPatch, err)
+	}
+
+	modifiedMarshalledVM, err := patch.Apply(marshalledVM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply patch for VM %s: %v", jsonPatch, err)
+	}
+
+	patchedVM := &k6tv1.VirtualMachine{}
+	if err := json.Unmarshal(modifiedMarshalledVM, patchedVM); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal modified marshalled vm %s: %v", string(modifiedMarshalledVM), err)
+	}
+
+	return patchedVM, nil
+}
@@ -34,6 +34,13 @@ func getKey(name, namespace string) string {
 	return fmt.Sprintf("%s/%s", namespace, name)
 }
 
+func getTargetNamespace(vmClone *clone.VirtualMachineClone) string {
+	if vmClone.Spec.TargetNamespace != "" {
+		return vmClone.Spec.TargetNamespace
+	}
+	return vmClone.Namespace
+}
+
 func generateNameWithRandomSuffix(names ...string) string {
 	const randomStringLength = 5
 
@@ -64,6 +71,10 @@ func generateRestoreName(vmCloneUID types.UID) string {
 	return fmt.Sprintf("tmp-restore-%s", string(vmCloneUID))
 }
 
+func generatePVCName(vmCloneUID types.UID, volumeName string) string {
+	return fmt.Sprintf("clone-%s-%s", string(vmCloneUID), volumeName)
+}
+
 func generateVMName(oldVMName string) string {
 	return generateNameWithRandomSuffix(oldVMName, "clone")
 }
@@ -25,6 +25,8 @@ import (
 	"regexp"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/types"
+
 	"kubevirt.io/client-go/log"
 
 	clone "kubevirt.io/api/clone/v1beta1"
@@ -152,6 +154,19 @@ func addRemovePatchesFromFilter(patchSet *patch.PatchSet, m map[string]string, f
 	}
 }
 
+// generateProvenance returns the annotations which track where the target was cloned from.
+// The snapshot UID is omitted for targets which are not created from a snapshot.
+func generateProvenance(vmClone *clone.VirtualMachineClone, sourceVMName string, snapshotUID types.UID) map[string]string {
+	provenance := map[string]string{
+		clone.SourceVMNameAnnotation:      sourceVMName,
+		clone.SourceVMNamespaceAnnotation: vmClone.Namespace,
+	}
+	if snapshotUID != "" {
+		provenance[clone.SourceSnapshotUIDAnnotation] = string(snapshotUID)
+	}
+	return provenance
+}
+
 func addFirmwareUUIDPatches(patchSet *patch.PatchSet, firmware *k6tv1.Firmware) {
 	if firmware == nil {
 		return
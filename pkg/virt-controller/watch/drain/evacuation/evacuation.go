@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -23,6 +27,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/vmdisruption"
 )
 
 const (
@@ -36,6 +41,10 @@ const (
 	FailedCreateVirtualMachineInstanceMigrationReason = "FailedCreate"
 	// SuccessfulCreateVirtualMachineInstanceMigrationReason is added in an event if creating a VirtualMachineInstanceMigration succeeded.
 	SuccessfulCreateVirtualMachineInstanceMigrationReason = "SuccessfulCreate"
+	// FailedDeleteVirtualMachineInstanceReason is added in an event if shutting down a non-migratable VirtualMachineInstance failed.
+	FailedDeleteVirtualMachineInstanceReason = "FailedDelete"
+	// SuccessfulDeleteVirtualMachineInstanceReason is added in an event if a non-migratable VirtualMachineInstance was shut down.
+	SuccessfulDeleteVirtualMachineInstanceReason = "SuccessfulDelete"
 )
 
 type EvacuationController struct {
@@ -47,6 +56,8 @@ type EvacuationController struct {
 	recorder              record.EventRecorder
 	migrationExpectations *controller.UIDTrackingControllerExpectations
 	nodeStore             cache.Store
+	namespaceStore        cache.Store
+	budgetIndexer         cache.Indexer
 	clusterConfig         *virtconfig.ClusterConfig
 	hasSynced             func() bool
 }
@@ -56,6 +67,8 @@ func NewEvacuationController(
 	migrationInformer cache.SharedIndexInformer,
 	nodeInformer cache.SharedIndexInformer,
 	vmiPodInformer cache.SharedIndexInformer,
+	namespaceInformer cache.SharedIndexInformer,
+	vmDisruptionBudgetInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -70,6 +83,8 @@ func NewEvacuationController(
 		migrationStore:        migrationInformer.GetStore(),
 		nodeStore:             nodeInformer.GetStore(),
 		vmiPodIndexer:         vmiPodInformer.GetIndexer(),
+		namespaceStore:        namespaceInformer.GetStore(),
+		budgetIndexer:         vmDisruptionBudgetInformer.GetIndexer(),
 		recorder:              recorder,
 		clientset:             clientset,
 		migrationExpectations: controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
@@ -77,7 +92,8 @@ func NewEvacuationController(
 	}
 
 	c.hasSynced = func() bool {
-		return vmiInformer.HasSynced() && vmiPodInformer.HasSynced() && migrationInformer.HasSynced() && nodeInformer.HasSynced()
+		return vmiInformer.HasSynced() && vmiPodInformer.HasSynced() && migrationInformer.HasSynced() && nodeInformer.HasSynced() && namespaceInformer.HasSynced() &&
+			vmDisruptionBudgetInformer.HasSynced()
 	}
 
 	_, err := vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -200,6 +216,9 @@ func (c *EvacuationController) addMigration(obj interface{}) {
 
 	// only observe the migration expectation if our controller created it
 	key, ok := migration.Annotations[virtv1.EvacuationMigrationAnnotation]
+	if !ok {
+		key, ok = migration.Annotations[virtv1.FailbackMigrationAnnotation]
+	}
 	if ok {
 		c.migrationExpectations.CreationObserved(key)
 		node = key
@@ -353,7 +372,18 @@ func (c *EvacuationController) execute(key string) error {
 
 	migrations := migrationutils.ListUnfinishedMigrations(c.migrationStore)
 
-	return c.sync(node, vmis, migrations)
+	if err := c.sync(node, vmis, migrations); err != nil {
+		return err
+	}
+	return c.syncFailback(node, c.drainTaint(), migrations)
+}
+
+// drainTaint returns the taint which triggers the evacuation of a node
+func (c *EvacuationController) drainTaint() *k8sv1.Taint {
+	return &k8sv1.Taint{
+		Key:    *c.clusterConfig.GetMigrationConfiguration().NodeDrainTaintKey,
+		Effect: k8sv1.TaintEffectNoSchedule,
+	}
 }
 
 func getMarkedForEvictionVMIs(vmis []*virtv1.VirtualMachineInstance) []*virtv1.VirtualMachineInstance {
@@ -384,11 +414,7 @@ func GenerateNewMigration(vmiName string, key string) *virtv1.VirtualMachineInst
 
 func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.VirtualMachineInstance, activeMigrations []*virtv1.VirtualMachineInstanceMigration) error {
 	// If the node has no drain taint, we have nothing to do
-	taintKey := *c.clusterConfig.GetMigrationConfiguration().NodeDrainTaintKey
-	taint := &k8sv1.Taint{
-		Key:    taintKey,
-		Effect: k8sv1.TaintEffectNoSchedule,
-	}
+	taint := c.drainTaint()
 
 	vmisToMigrate := vmisToMigrate(node, vmisOnNode, taint)
 	if len(vmisToMigrate) == 0 {
@@ -400,6 +426,25 @@ func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.Virtu
 		return nil
 	}
 
+	disruptions := vmdisruption.NewDisruptions(c.clientset, c.budgetIndexer)
+
+	nonMigrateable, toShutdown := filterShutdownFallbackVMIs(nonMigrateable)
+	allowedShutdowns, err := disruptions.AllowUpTo(toShutdown, len(toShutdown))
+	if err != nil {
+		return err
+	}
+	if len(allowedShutdowns) < len(toShutdown) {
+		// The disruption budgets of the VMIs will allow them later on
+		c.Queue.AddAfter(node.Name, 5*time.Second)
+	}
+	if err := c.shutdownVMIs(allowedShutdowns); err != nil {
+		return err
+	}
+	if len(migrationCandidates) == 0 && len(nonMigrateable) == 0 {
+		return nil
+	}
+	c.sortMigrationCandidates(migrationCandidates)
+
 	runningMigrations := migrationutils.FilterRunningMigrations(activeMigrations)
 	activeMigrationsFromThisSourceNode := c.numOfVMIMForThisSourceNode(vmisOnNode, runningMigrations)
 	maxParallelMigrationsPerOutboundNode :=
@@ -435,8 +480,18 @@ func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.Virtu
 		return nil
 	}
 
-	// TODO: should the order be randomized?
-	selectedCandidates := migrationCandidates[0:diff]
+	selectedCandidates, err := disruptions.AllowUpTo(migrationCandidates, diff)
+	if err != nil {
+		return err
+	}
+	if len(selectedCandidates) < diff {
+		// Some candidates are held back by their disruption budgets
+		c.Queue.AddAfter(node.Name, 5*time.Second)
+		diff = len(selectedCandidates)
+		if diff == 0 {
+			return nil
+		}
+	}
 
 	log.DefaultLogger().Infof("node: %v, migrations: %v, candidates: %v, selected: %v", node.Name, len(activeMigrations), len(migrationCandidates), len(selectedCandidates))
 
@@ -445,6 +500,7 @@ func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.Virtu
 
 	errChan := make(chan error, diff)
 
+	drained := isDrained(node, taint)
 	c.migrationExpectations.ExpectCreations(node.Name, diff)
 	for _, vmi := range selectedCandidates {
 		go func(vmi *virtv1.VirtualMachineInstance) {
@@ -458,6 +514,12 @@ func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.Virtu
 			} else {
 				c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, SuccessfulCreateVirtualMachineInstanceMigrationReason, "Created Migration %s", createdMigration.Name)
 			}
+			// only VMIs evacuated for a maintenance of the node are migrated back once it is over
+			if drained {
+				if err := c.markEvacuated(vmi, node.Name); err != nil {
+					errChan <- err
+				}
+			}
 		}(vmi)
 	}
 
@@ -471,6 +533,83 @@ func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.Virtu
 	return nil
 }
 
+// filterShutdownFallbackVMIs splits the non-migratable VMIs which opted in to be shut down
+// when they can't be evacuated from the others.
+func filterShutdownFallbackVMIs(vmis []*virtv1.VirtualMachineInstance) (nonMigrateable []*virtv1.VirtualMachineInstance, toShutdown []*virtv1.VirtualMachineInstance) {
+	for _, vmi := range vmis {
+		if vmi.Annotations[virtv1.EvacuationShutdownFallbackAnnotation] == "true" {
+			toShutdown = append(toShutdown, vmi)
+		} else {
+			nonMigrateable = append(nonMigrateable, vmi)
+		}
+	}
+	return nonMigrateable, toShutdown
+}
+
+func (c *EvacuationController) shutdownVMIs(vmis []*virtv1.VirtualMachineInstance) error {
+	var errs []error
+	for _, vmi := range vmis {
+		err := c.clientset.VirtualMachineInstance(vmi.Namespace).Delete(context.Background(), vmi.Name, v1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedDeleteVirtualMachineInstanceReason, "Error shutting down the non-migratable VirtualMachineInstance: %v", err)
+			errs = append(errs, err)
+			continue
+		}
+		c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, SuccessfulDeleteVirtualMachineInstanceReason, "Shut down the non-migratable VirtualMachineInstance to evacuate it")
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// sortMigrationCandidates orders the candidates according to the configured evacuation order.
+// Candidates which compare equal keep their order by namespace and name, to make sure
+// that consecutive syncs select the same candidates.
+func (c *EvacuationController) sortMigrationCandidates(vmis []*virtv1.VirtualMachineInstance) {
+	order := c.clusterConfig.GetMigrationConfiguration().EvacuationOrder
+
+	priorities := map[*virtv1.VirtualMachineInstance]int32{}
+	if order != nil && order.ByPriorityClass {
+		for _, vmi := range vmis {
+			priorities[vmi] = c.vmiPriority(vmi)
+		}
+	}
+	labelValues := map[*virtv1.VirtualMachineInstance]int64{}
+	if order != nil && order.ByLabel != "" {
+		for _, vmi := range vmis {
+			labelValues[vmi] = labelPriority(vmi, order.ByLabel)
+		}
+	}
+
+	sort.SliceStable(vmis, func(i, j int) bool {
+		if priorities[vmis[i]] != priorities[vmis[j]] {
+			return priorities[vmis[i]] > priorities[vmis[j]]
+		}
+		if labelValues[vmis[i]] != labelValues[vmis[j]] {
+			return labelValues[vmis[i]] > labelValues[vmis[j]]
+		}
+		if vmis[i].Namespace != vmis[j].Namespace {
+			return vmis[i].Namespace < vmis[j].Namespace
+		}
+		return vmis[i].Name < vmis[j].Name
+	})
+}
+
+// vmiPriority returns the priority resolved from the priority class of the VMI pod
+func (c *EvacuationController) vmiPriority(vmi *virtv1.VirtualMachineInstance) int32 {
+	pod, err := controller.CurrentVMIPod(vmi, c.vmiPodIndexer)
+	if err != nil || pod == nil || pod.Spec.Priority == nil {
+		return 0
+	}
+	return *pod.Spec.Priority
+}
+
+func labelPriority(vmi *virtv1.VirtualMachineInstance, key string) int64 {
+	value, err := strconv.ParseInt(vmi.Labels[key], 10, 64)
+	if err != nil {
+		return math.MinInt64
+	}
+	return value
+}
+
 func hasMigratedOnEviction(vmi *virtv1.VirtualMachineInstance) bool {
 	return vmi.Status.NodeName != vmi.Status.EvacuationNodeName
 }
@@ -9,14 +9,17 @@ import (
 	"go.uber.org/mock/gomock"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 
 	v1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 	"kubevirt.io/client-go/api"
 	"kubevirt.io/client-go/kubecli"
 
@@ -29,9 +32,10 @@ import (
 
 var _ = Describe("Evacuation", func() {
 	var (
-		virtClient *kubecli.MockKubevirtClient
-		recorder   *record.FakeRecorder
-		controller *EvacuationController
+		virtClient     *kubecli.MockKubevirtClient
+		recorder       *record.FakeRecorder
+		controller     *EvacuationController
+		budgetInformer cache.SharedIndexInformer
 	)
 
 	addNode := func(node *k8sv1.Node) {
@@ -46,6 +50,12 @@ var _ = Describe("Evacuation", func() {
 		ExpectWithOffset(1, err).ToNot(HaveOccurred())
 		ExpectWithOffset(1, migrationList.Items).To(HaveLen(1))
 	}
+	expectMigrationCreationFor := func(vmiName string) {
+		migrationList, err := virtClient.VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).List(context.TODO(), metav1.ListOptions{})
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		ExpectWithOffset(1, migrationList.Items).To(HaveLen(1))
+		ExpectWithOffset(1, migrationList.Items[0].Spec.VMIName).To(Equal(vmiName))
+	}
 	updateKV := func(func(kv *v1.KubeVirt)) { panic("Implement me") }
 
 	BeforeEach(func() {
@@ -58,10 +68,18 @@ var _ = Describe("Evacuation", func() {
 			"node": func(obj interface{}) (strings []string, e error) {
 				return []string{obj.(*v1.VirtualMachineInstance).Status.NodeName}, nil
 			},
+			"evacuationSourceNode": func(obj interface{}) ([]string, error) {
+				if sourceNode, exists := obj.(*v1.VirtualMachineInstance).Annotations[v1.EvacuationSourceNodeAnnotation]; exists {
+					return []string{sourceNode}, nil
+				}
+				return nil, nil
+			},
 		})
 		migrationInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstanceMigration{})
 		nodeInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Node{})
 		podInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		budgetInformer, _ = testutils.NewFakeInformerFor(&migrationsv1.VirtualMachineDisruptionBudget{})
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
 		config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
@@ -72,12 +90,14 @@ var _ = Describe("Evacuation", func() {
 			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
 		}
 
-		controller, _ = NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, recorder, virtClient, config)
+		controller, _ = NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, namespaceInformer, budgetInformer, recorder, virtClient, config)
 		mockQueue := testutils.NewMockWorkQueue(controller.Queue)
 		controller.Queue = mockQueue
 
 		// Set up mock client
 		virtClient.EXPECT().VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(k8sv1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().VirtualMachineInstances(k8sv1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineDisruptionBudget(k8sv1.NamespaceDefault).Return(fakeVirtClient.MigrationsV1alpha1().VirtualMachineDisruptionBudgets(k8sv1.NamespaceDefault)).AnyTimes()
 		kubeClient := fake.NewSimpleClientset()
 		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
 		virtClient.EXPECT().PolicyV1().Return(kubeClient.PolicyV1()).AnyTimes()
@@ -492,6 +512,332 @@ var _ = Describe("Evacuation", func() {
 		})
 	})
 
+	Context("evacuation order", func() {
+		var node *k8sv1.Node
+
+		addVMI := func(name string, priority int32, labels map[string]string) {
+			vmi := newVirtualMachineMarkedForEviction(name, node.Name)
+			vmi.UID = types.UID(name)
+			vmi.Labels = labels
+			pod := newPod(vmi, name+"-pod", k8sv1.PodRunning, true)
+			pod.Spec.NodeName = node.Name
+			pod.Spec.Priority = pointer.P(priority)
+			controller.vmiPodIndexer.Add(pod)
+			controller.vmiIndexer.Add(vmi)
+		}
+
+		BeforeEach(func() {
+			node = newNode("node01")
+			addNode(node)
+			enqueue(node)
+		})
+
+		DescribeTable("should migrate the first VMI", func(evacuationOrder *v1.EvacuationOrder, expectedVMI string) {
+			updateKV(func(kv *v1.KubeVirt) {
+				kv.Spec.Configuration.MigrationConfiguration = &v1.MigrationConfiguration{
+					ParallelOutboundMigrationsPerNode: pointer.P(uint32(1)),
+					EvacuationOrder:                   evacuationOrder,
+				}
+			})
+			addVMI("vmi-a", 10, map[string]string{"evacuation-priority": "1"})
+			addVMI("vmi-b", 1000, map[string]string{"evacuation-priority": "invalid"})
+			addVMI("vmi-c", 10, map[string]string{"evacuation-priority": "5"})
+			addVMI("vmi-d", 1000, map[string]string{"evacuation-priority": "2"})
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			expectMigrationCreationFor(expectedVMI)
+		},
+			Entry("by name without evacuation order", nil, "vmi-a"),
+			Entry("with the highest priority class", &v1.EvacuationOrder{ByPriorityClass: true}, "vmi-b"),
+			Entry("with the highest label value", &v1.EvacuationOrder{ByLabel: "evacuation-priority"}, "vmi-c"),
+			Entry("with the highest priority class and label value", &v1.EvacuationOrder{ByPriorityClass: true, ByLabel: "evacuation-priority"}, "vmi-d"),
+		)
+	})
+
+	Context("shutdown fallback", func() {
+		newNonMigratableVMI := func(name, nodeName string) *v1.VirtualMachineInstance {
+			vmi := newVirtualMachineMarkedForEviction(name, nodeName)
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionFalse,
+				},
+			}
+			return vmi
+		}
+
+		It("should shut down a non-migratable VMI marked for the fallback", func() {
+			node := newNode("foo")
+			addNode(node)
+			enqueue(node)
+			vmi := newNonMigratableVMI("testvm", node.Name)
+			vmi.Annotations = map[string]string{v1.EvacuationShutdownFallbackAnnotation: "true"}
+			controller.vmiIndexer.Add(vmi)
+			_, err := virtClient.VirtualMachineInstance(k8sv1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineInstanceReason)
+			_, err = virtClient.VirtualMachineInstance(k8sv1.NamespaceDefault).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should not shut down a non-migratable VMI which is not marked for the fallback", func() {
+			node := newNode("foo")
+			addNode(node)
+			enqueue(node)
+			vmi := newNonMigratableVMI("testvm", node.Name)
+			controller.vmiIndexer.Add(vmi)
+			_, err := virtClient.VirtualMachineInstance(k8sv1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, FailedCreateVirtualMachineInstanceMigrationReason)
+			_, err = virtClient.VirtualMachineInstance(k8sv1.NamespaceDefault).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should not shut down a migratable VMI marked for the fallback", func() {
+			node := newNode("foo")
+			addNode(node)
+			enqueue(node)
+			vmi := newVirtualMachineMarkedForEviction("testvm", node.Name)
+			vmi.Annotations = map[string]string{v1.EvacuationShutdownFallbackAnnotation: "true"}
+			controller.vmiIndexer.Add(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			expectMigrationCreation()
+		})
+	})
+
+	Context("disruption budgets", func() {
+		var node *k8sv1.Node
+
+		addBudget := func(disruptionsAllowed int32) {
+			budget := &migrationsv1.VirtualMachineDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "budget", Namespace: k8sv1.NamespaceDefault},
+				Spec: migrationsv1.VirtualMachineDisruptionBudgetSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				},
+				Status: migrationsv1.VirtualMachineDisruptionBudgetStatus{DisruptionsAllowed: disruptionsAllowed},
+			}
+			Expect(budgetInformer.GetStore().Add(budget)).To(Succeed())
+			_, err := virtClient.VirtualMachineDisruptionBudget(k8sv1.NamespaceDefault).Create(context.Background(), budget, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		addVMI := func(name string) *v1.VirtualMachineInstance {
+			vmi := newVirtualMachineMarkedForEviction(name, node.Name)
+			vmi.UID = types.UID(name)
+			vmi.Labels = map[string]string{"app": "db"}
+			controller.vmiIndexer.Add(vmi)
+			return vmi
+		}
+
+		BeforeEach(func() {
+			node = newNode("node01")
+			addNode(node)
+			enqueue(node)
+		})
+
+		It("should only migrate the VMIs allowed by their disruption budget", func() {
+			addBudget(1)
+			addVMI("vmi-a")
+			addVMI("vmi-b")
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			expectMigrationCreationFor("vmi-a")
+			Expect(controller.Queue.(*testutils.MockWorkQueue[string]).GetAddAfterEnqueueCount()).To(Equal(1))
+
+			budget, err := virtClient.VirtualMachineDisruptionBudget(k8sv1.NamespaceDefault).Get(context.Background(), "budget", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(budget.Status.DisruptionsAllowed).To(BeZero())
+			Expect(budget.Status.DisruptedVirtualMachineInstances).To(HaveKey("vmi-a"))
+		})
+
+		It("should not shut down a VMI its disruption budget does not allow", func() {
+			addBudget(0)
+			vmi := addVMI("testvm")
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{Type: v1.VirtualMachineInstanceIsMigratable, Status: k8sv1.ConditionFalse},
+			}
+			vmi.Annotations = map[string]string{v1.EvacuationShutdownFallbackAnnotation: "true"}
+			_, err := virtClient.VirtualMachineInstance(k8sv1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			sanityExecute()
+
+			Expect(controller.Queue.(*testutils.MockWorkQueue[string]).GetAddAfterEnqueueCount()).To(Equal(1))
+			_, err = virtClient.VirtualMachineInstance(k8sv1.NamespaceDefault).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("failback", func() {
+		newSchedulableNode := func(name string) *k8sv1.Node {
+			node := newNode(name)
+			node.Labels = map[string]string{
+				v1.NodeSchedulable:  "true",
+				k8sv1.LabelHostname: name + "-host",
+			}
+			return node
+		}
+
+		newEvacuatedVMI := func(name, nodeName, sourceNode string) *v1.VirtualMachineInstance {
+			vmi := newVirtualMachine(name, nodeName)
+			vmi.Status.Phase = v1.Running
+			vmi.Annotations = map[string]string{v1.EvacuationSourceNodeAnnotation: sourceNode}
+			controller.vmiIndexer.Add(vmi)
+			_, err := virtClient.VirtualMachineInstance(k8sv1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return vmi
+		}
+
+		expectNoMigration := func() {
+			migrationList, err := virtClient.VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).List(context.TODO(), metav1.ListOptions{})
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			ExpectWithOffset(1, migrationList.Items).To(BeEmpty())
+		}
+
+		It("should have expected values and annotations", func() {
+			migration := GenerateFailbackMigration("my-vmi", newSchedulableNode("somenode"))
+			Expect(migration.Spec.VMIName).To(Equal("my-vmi"))
+			Expect(migration.Annotations[v1.FailbackMigrationAnnotation]).To(Equal("somenode"))
+			Expect(migration.Spec.AddedNodeSelector).To(Equal(map[string]string{k8sv1.LabelHostname: "somenode-host"}))
+		})
+
+		It("should record the source node of a VMI evacuated from a cordoned node", func() {
+			node := newNode("testnode")
+			node.Spec.Unschedulable = true
+			addNode(node)
+			enqueue(node)
+			vmi := newVirtualMachineMarkedForEviction("testvm", node.Name)
+			controller.vmiIndexer.Add(vmi)
+			_, err := virtClient.VirtualMachineInstance(k8sv1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			expectMigrationCreation()
+			vmi, err = virtClient.VirtualMachineInstance(k8sv1.NamespaceDefault).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vmi.Annotations).To(HaveKeyWithValue(v1.EvacuationSourceNodeAnnotation, node.Name))
+		})
+
+		It("should not record the source node of a VMI evicted from a schedulable node", func() {
+			node := newSchedulableNode("testnode")
+			addNode(node)
+			enqueue(node)
+			vmi := newVirtualMachineMarkedForEviction("testvm", node.Name)
+			controller.vmiIndexer.Add(vmi)
+			_, err := virtClient.VirtualMachineInstance(k8sv1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			vmi, err = virtClient.VirtualMachineInstance(k8sv1.NamespaceDefault).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vmi.Annotations).ToNot(HaveKey(v1.EvacuationSourceNodeAnnotation))
+		})
+
+		It("should migrate an opted-in VMI back once the node is back", func() {
+			node := newSchedulableNode("testnode")
+			addNode(node)
+			enqueue(node)
+			vmi := newEvacuatedVMI("testvm", "othernode", node.Name)
+			vmi.Annotations[v1.EvacuationFailbackAnnotation] = "true"
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, SuccessfulCreateFailbackMigrationReason)
+			migrationList, err := virtClient.VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).List(context.TODO(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrationList.Items).To(HaveLen(1))
+			Expect(migrationList.Items[0].Spec.VMIName).To(Equal(vmi.Name))
+			Expect(migrationList.Items[0].Annotations).To(HaveKeyWithValue(v1.FailbackMigrationAnnotation, node.Name))
+			Expect(migrationList.Items[0].Spec.AddedNodeSelector).To(HaveKeyWithValue(k8sv1.LabelHostname, "testnode-host"))
+		})
+
+		DescribeTable("should respect the opt-in", func(vmiAnnotation *string, namespaceLabel *string, expectMigration bool) {
+			node := newSchedulableNode("testnode")
+			addNode(node)
+			enqueue(node)
+			vmi := newEvacuatedVMI("testvm", "othernode", node.Name)
+			if vmiAnnotation != nil {
+				vmi.Annotations[v1.EvacuationFailbackAnnotation] = *vmiAnnotation
+			}
+			namespace := &k8sv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: k8sv1.NamespaceDefault}}
+			if namespaceLabel != nil {
+				namespace.Labels = map[string]string{v1.EvacuationFailbackAnnotation: *namespaceLabel}
+			}
+			Expect(controller.namespaceStore.Add(namespace)).To(Succeed())
+
+			sanityExecute()
+
+			if expectMigration {
+				testutils.ExpectEvent(recorder, SuccessfulCreateFailbackMigrationReason)
+				expectMigrationCreationFor(vmi.Name)
+			} else {
+				expectNoMigration()
+			}
+		},
+			Entry("without annotation and label", nil, nil, false),
+			Entry("with the label of the namespace", nil, pointer.P("true"), true),
+			Entry("with the annotation of the VMI overriding the label of the namespace", pointer.P("false"), pointer.P("true"), false),
+			Entry("with the annotation of the VMI", pointer.P("true"), pointer.P("false"), true),
+		)
+
+		It("should not migrate a VMI back while the node is cordoned", func() {
+			node := newSchedulableNode("testnode")
+			node.Spec.Unschedulable = true
+			addNode(node)
+			enqueue(node)
+			vmi := newEvacuatedVMI("testvm", "othernode", node.Name)
+			vmi.Annotations[v1.EvacuationFailbackAnnotation] = "true"
+
+			sanityExecute()
+
+			expectNoMigration()
+		})
+
+		It("should not migrate a VMI back which is migrating", func() {
+			node := newSchedulableNode("testnode")
+			addNode(node)
+			enqueue(node)
+			vmi := newEvacuatedVMI("testvm", "othernode", node.Name)
+			vmi.Annotations[v1.EvacuationFailbackAnnotation] = "true"
+			controller.migrationStore.Add(newMigration("mig1", vmi.Name, v1.MigrationRunning))
+
+			sanityExecute()
+
+			expectNoMigration()
+		})
+
+		It("should clear the source node of a VMI which runs on it again", func() {
+			node := newSchedulableNode("testnode")
+			addNode(node)
+			enqueue(node)
+			vmi := newEvacuatedVMI("testvm", node.Name, node.Name)
+
+			sanityExecute()
+
+			expectNoMigration()
+			vmi, err := virtClient.VirtualMachineInstance(k8sv1.NamespaceDefault).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vmi.Annotations).ToNot(HaveKey(v1.EvacuationSourceNodeAnnotation))
+		})
+	})
+
 	AfterEach(func() {
 		// Ensure that we add checks for expected events to every test
 		Expect(recorder.Events).To(BeEmpty())
@@ -0,0 +1,208 @@
+package evacuation
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+const (
+	// FailedCreateFailbackMigrationReason is added in an event if creating a migration back to the source node failed.
+	FailedCreateFailbackMigrationReason = "FailedCreateFailbackMigration"
+	// SuccessfulCreateFailbackMigrationReason is added in an event if a migration back to the source node was created.
+	SuccessfulCreateFailbackMigrationReason = "SuccessfulCreateFailbackMigration"
+)
+
+var evacuationSourceNodePath = fmt.Sprintf("/metadata/annotations/%s", patch.EscapeJSONPointer(virtv1.EvacuationSourceNodeAnnotation))
+
+// isDrained tells whether the node is under maintenance, either cordoned or tainted with the drain taint
+func isDrained(node *k8sv1.Node, taint *k8sv1.Taint) bool {
+	return node.Spec.Unschedulable || nodeHasTaint(taint, node)
+}
+
+// isBackFromMaintenance tells whether VMIs can be scheduled to the node again
+func isBackFromMaintenance(node *k8sv1.Node, taint *k8sv1.Taint) bool {
+	return !isDrained(node, taint) && node.Labels[virtv1.NodeSchedulable] == "true"
+}
+
+// markEvacuated records the node the VMI is evacuated from, for the VMI to be migrated back once the node is back
+func (c *EvacuationController) markEvacuated(vmi *virtv1.VirtualMachineInstance, nodeName string) error {
+	if vmi.Annotations[virtv1.EvacuationSourceNodeAnnotation] == nodeName {
+		return nil
+	}
+	var patchSet *patch.PatchSet
+	if vmi.Annotations == nil {
+		patchSet = patch.New(patch.WithAdd("/metadata/annotations", map[string]string{virtv1.EvacuationSourceNodeAnnotation: nodeName}))
+	} else {
+		patchSet = patch.New(patch.WithAdd(evacuationSourceNodePath, nodeName))
+	}
+	if err := c.patchVMI(vmi, patchSet); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// clearEvacuated removes the source node of a VMI which runs on it again, or does not run anymore
+func (c *EvacuationController) clearEvacuated(vmi *virtv1.VirtualMachineInstance) error {
+	return c.patchVMI(vmi, patch.New(
+		patch.WithTest(evacuationSourceNodePath, vmi.Annotations[virtv1.EvacuationSourceNodeAnnotation]),
+		patch.WithRemove(evacuationSourceNodePath),
+	))
+}
+
+func (c *EvacuationController) patchVMI(vmi *virtv1.VirtualMachineInstance, patchSet *patch.PatchSet) error {
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	return err
+}
+
+// wantsFailback tells whether the VMI opted in to be migrated back to its source node, either by its own
+// annotation or by the label of its namespace
+func (c *EvacuationController) wantsFailback(vmi *virtv1.VirtualMachineInstance) bool {
+	if value, exists := vmi.Annotations[virtv1.EvacuationFailbackAnnotation]; exists {
+		return value == "true"
+	}
+	obj, exists, err := c.namespaceStore.GetByKey(vmi.Namespace)
+	if err != nil || !exists {
+		return false
+	}
+	return obj.(*k8sv1.Namespace).Labels[virtv1.EvacuationFailbackAnnotation] == "true"
+}
+
+// syncFailback migrates the VMIs evacuated from the node back to it once the node is back from maintenance.
+// VMIs which did not opt in keep the source node, so they are migrated back once they opt in.
+func (c *EvacuationController) syncFailback(node *k8sv1.Node, taint *k8sv1.Taint, activeMigrations []*virtv1.VirtualMachineInstanceMigration) error {
+	if !isBackFromMaintenance(node, taint) {
+		return nil
+	}
+
+	objs, err := c.vmiIndexer.ByIndex("evacuationSourceNode", node.Name)
+	if err != nil {
+		return fmt.Errorf("failed to list VMIs evacuated from node: %v", err)
+	}
+
+	lookup := map[string]bool{}
+	for _, migration := range activeMigrations {
+		lookup[migration.Namespace+"/"+migration.Spec.VMIName] = true
+	}
+
+	var errs []error
+	var candidates []*virtv1.VirtualMachineInstance
+	for _, obj := range objs {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		// already migrating
+		if lookup[vmi.Namespace+"/"+vmi.Name] || migrationutils.IsMigrating(vmi) {
+			continue
+		}
+		// back on the node, or shutting down
+		if vmi.Status.NodeName == node.Name || vmi.IsFinal() || vmi.DeletionTimestamp != nil {
+			if err := c.clearEvacuated(vmi); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if !vmi.IsRunning() || !c.wantsFailback(vmi) {
+			continue
+		}
+		if !controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceIsMigratable, k8sv1.ConditionTrue) {
+			continue
+		}
+		if controller.VMIActivePodsCount(vmi, c.vmiPodIndexer) > 1 {
+			// waiting on target/source pods from a previous migration to terminate
+			continue
+		}
+		candidates = append(candidates, vmi)
+	}
+	if len(errs) > 0 {
+		return utilerrors.NewAggregate(errs)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Namespace != candidates[j].Namespace {
+			return candidates[i].Namespace < candidates[j].Namespace
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+
+	// the migrations back to the node are limited like the ones off a node, unless an inbound limit is set
+	migrationConfig := c.clusterConfig.GetMigrationConfiguration()
+	maxParallelMigrationsPerTargetNode := *migrationConfig.ParallelOutboundMigrationsPerNode
+	if migrationConfig.ParallelInboundMigrationsPerNode != nil {
+		maxParallelMigrationsPerTargetNode = *migrationConfig.ParallelInboundMigrationsPerNode
+	}
+	runningMigrations := migrationutils.FilterRunningMigrations(activeMigrations)
+	freeSpotsPerCluster := int(*migrationConfig.ParallelMigrationsPerCluster) - len(runningMigrations)
+	freeSpotsPerThisTargetNode := int(maxParallelMigrationsPerTargetNode) - numOfFailbackMigrationsToNode(runningMigrations, node.Name)
+	freeSpots := int(math.Min(float64(freeSpotsPerCluster), float64(freeSpotsPerThisTargetNode)))
+	if freeSpots <= 0 {
+		c.Queue.AddAfter(node.Name, 5*time.Second)
+		return nil
+	}
+	if len(candidates) > freeSpots {
+		candidates = candidates[:freeSpots]
+	}
+
+	log.DefaultLogger().Infof("node: %v, migrating %v evacuated VMIs back", node.Name, len(candidates))
+
+	c.migrationExpectations.ExpectCreations(node.Name, len(candidates))
+	for _, vmi := range candidates {
+		createdMigration, err := c.clientset.VirtualMachineInstanceMigration(vmi.Namespace).Create(context.Background(), GenerateFailbackMigration(vmi.Name, node), v1.CreateOptions{})
+		if err != nil {
+			c.migrationExpectations.CreationObserved(node.Name)
+			c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedCreateFailbackMigrationReason, "Error creating a Migration back to node %s: %v", node.Name, err)
+			errs = append(errs, err)
+			continue
+		}
+		c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, SuccessfulCreateFailbackMigrationReason, "Created Migration %s back to node %s", createdMigration.Name, node.Name)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// GenerateFailbackMigration returns a migration of the VMI restricted to the given node
+func GenerateFailbackMigration(vmiName string, node *k8sv1.Node) *virtv1.VirtualMachineInstanceMigration {
+	hostname := node.Labels[k8sv1.LabelHostname]
+	if hostname == "" {
+		hostname = node.Name
+	}
+	return &virtv1.VirtualMachineInstanceMigration{
+		ObjectMeta: v1.ObjectMeta{
+			Annotations: map[string]string{
+				virtv1.FailbackMigrationAnnotation: node.Name,
+			},
+			GenerateName: "kubevirt-failback-",
+		},
+		Spec: virtv1.VirtualMachineInstanceMigrationSpec{
+			VMIName:           vmiName,
+			AddedNodeSelector: map[string]string{k8sv1.LabelHostname: hostname},
+		},
+	}
+}
+
+func numOfFailbackMigrationsToNode(migrations []*virtv1.VirtualMachineInstanceMigration, nodeName string) (count int) {
+	for _, migration := range migrations {
+		if migration.Annotations[virtv1.FailbackMigrationAnnotation] == nodeName {
+			count++
+		}
+	}
+	return count
+}
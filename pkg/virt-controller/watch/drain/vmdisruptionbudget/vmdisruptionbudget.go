@@ -0,0 +1,235 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmdisruptionbudget
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+	"kubevirt.io/kubevirt/pkg/vmdisruption"
+)
+
+// Controller computes the status of the VirtualMachineDisruptionBudgets from the
+// VirtualMachineInstances they select and the migrations of those
+type Controller struct {
+	Client kubecli.KubevirtClient
+
+	BudgetInformer    cache.SharedIndexInformer
+	VMIInformer       cache.SharedIndexInformer
+	MigrationInformer cache.SharedIndexInformer
+
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+// Init initializes the disruption budget controller
+func (c *Controller) Init() error {
+	c.queue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-vm-disruption-budget"},
+	)
+
+	_, err := c.BudgetInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleBudget,
+			UpdateFunc: func(_, newObj interface{}) { c.handleBudget(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.VMIInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleVMI,
+			UpdateFunc: c.updateVMI,
+			DeleteFunc: c.handleVMI,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.MigrationInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleMigration,
+			UpdateFunc: func(_, newObj interface{}) { c.handleMigration(newObj) },
+			DeleteFunc: c.handleMigration,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run the controller
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Log.Info("Starting vm disruption budget controller.")
+	defer log.Log.Info("Shutting down vm disruption budget controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		c.BudgetInformer.HasSynced,
+		c.VMIInformer.HasSynced,
+		c.MigrationInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (c *Controller) runWorker() {
+	for c.processWorkItem() {
+	}
+}
+
+func (c *Controller) processWorkItem() bool {
+	return watchutil.ProcessWorkItem(c.queue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vm disruption budget worker processing key [%s]", key)
+
+		storeObj, exists, err := c.BudgetInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		budget, ok := storeObj.(*migrationsv1.VirtualMachineDisruptionBudget)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+
+		return c.sync(budget.DeepCopy())
+	})
+}
+
+func (c *Controller) handleBudget(obj interface{}) {
+	if budget, ok := obj.(*migrationsv1.VirtualMachineDisruptionBudget); ok {
+		key, err := controller.KeyFunc(budget)
+		if err != nil {
+			log.Log.Object(budget).Reason(err).Error("failed to extract key from disruption budget")
+			return
+		}
+		c.queue.Add(key)
+	}
+}
+
+func (c *Controller) updateVMI(oldObj, newObj interface{}) {
+	// The labels of the VMI may have changed, both the old and the new budgets are affected
+	c.handleVMI(oldObj)
+	c.handleVMI(newObj)
+}
+
+func (c *Controller) handleVMI(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	vmi, ok := obj.(*virtv1.VirtualMachineInstance)
+	if !ok {
+		return
+	}
+
+	budgets, err := vmdisruption.BudgetsForVMI(c.BudgetInformer.GetIndexer(), vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("failed to list disruption budgets")
+		return
+	}
+
+	for _, budget := range budgets {
+		c.handleBudget(budget)
+	}
+}
+
+func (c *Controller) handleMigration(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	migration, ok := obj.(*virtv1.VirtualMachineInstanceMigration)
+	if !ok {
+		return
+	}
+
+	vmiObj, exists, err := c.VMIInformer.GetStore().GetByKey(controller.NamespacedKey(migration.Namespace, migration.Spec.VMIName))
+	if err != nil || !exists {
+		return
+	}
+	c.handleVMI(vmiObj)
+}
+
+func (c *Controller) sync(budget *migrationsv1.VirtualMachineDisruptionBudget) (time.Duration, error) {
+	vmiObjs, err := c.VMIInformer.GetIndexer().ByIndex(cache.NamespaceIndex, budget.Namespace)
+	if err != nil {
+		return 0, err
+	}
+	vmis := make([]*virtv1.VirtualMachineInstance, 0, len(vmiObjs))
+	for _, obj := range vmiObjs {
+		vmis = append(vmis, obj.(*virtv1.VirtualMachineInstance))
+	}
+
+	migrationObjs, err := c.MigrationInformer.GetIndexer().ByIndex(cache.NamespaceIndex, budget.Namespace)
+	if err != nil {
+		return 0, err
+	}
+	migrations := make([]*virtv1.VirtualMachineInstanceMigration, 0, len(migrationObjs))
+	for _, obj := range migrationObjs {
+		migrations = append(migrations, obj.(*virtv1.VirtualMachineInstanceMigration))
+	}
+
+	status, requeueAfter, err := vmdisruption.ComputeStatus(budget, vmis, vmdisruption.MigratingVMIs(migrations), time.Now())
+	if err != nil {
+		return 0, err
+	}
+	if equality.Semantic.DeepEqual(budget.Status, status) {
+		return requeueAfter, nil
+	}
+
+	// The update is based on the cached budget, it conflicts with the disruptions granted since
+	budget.Status = status
+	if _, err := c.Client.VirtualMachineDisruptionBudget(budget.Namespace).UpdateStatus(context.Background(), budget, metav1.UpdateOptions{}); err != nil {
+		return 0, err
+	}
+	return requeueAfter, nil
+}
@@ -0,0 +1,173 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmdisruptionbudget
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/vmdisruption"
+)
+
+var _ = Describe("VM disruption budget controller", func() {
+	const budgetName = "test-budget"
+
+	var (
+		controller        *Controller
+		budgetInformer    cache.SharedIndexInformer
+		vmiInformer       cache.SharedIndexInformer
+		migrationInformer cache.SharedIndexInformer
+		kubevirtClient    *kubevirtfake.Clientset
+	)
+
+	addBudget := func(maxUnavailable intstr.IntOrString) *migrationsv1.VirtualMachineDisruptionBudget {
+		budget := &migrationsv1.VirtualMachineDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       budgetName,
+				Namespace:  metav1.NamespaceDefault,
+				Generation: 1,
+			},
+			Spec: migrationsv1.VirtualMachineDisruptionBudgetSpec{
+				Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				MaxUnavailable: &maxUnavailable,
+			},
+		}
+		Expect(budgetInformer.GetStore().Add(budget)).To(Succeed())
+		_, err := kubevirtClient.MigrationsV1alpha1().VirtualMachineDisruptionBudgets(metav1.NamespaceDefault).Create(context.Background(), budget, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return budget
+	}
+
+	addVMI := func(name string, phase virtv1.VirtualMachineInstancePhase, opts ...libvmi.Option) {
+		opts = append([]libvmi.Option{
+			libvmi.WithName(name),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithLabel("app", "db"),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(phase))),
+		}, opts...)
+		Expect(vmiInformer.GetStore().Add(libvmi.New(opts...))).To(Succeed())
+	}
+
+	getStatus := func() migrationsv1.VirtualMachineDisruptionBudgetStatus {
+		budget, err := kubevirtClient.MigrationsV1alpha1().VirtualMachineDisruptionBudgets(metav1.NamespaceDefault).Get(context.Background(), budgetName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return budget.Status
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineDisruptionBudget(metav1.NamespaceDefault).
+			Return(kubevirtClient.MigrationsV1alpha1().VirtualMachineDisruptionBudgets(metav1.NamespaceDefault)).AnyTimes()
+
+		budgetInformer, _ = testutils.NewFakeInformerFor(&migrationsv1.VirtualMachineDisruptionBudget{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		migrationInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstanceMigration{})
+
+		controller = &Controller{
+			Client:            virtClient,
+			BudgetInformer:    budgetInformer,
+			VMIInformer:       vmiInformer,
+			MigrationInformer: migrationInformer,
+		}
+		Expect(controller.Init()).To(Succeed())
+	})
+
+	It("should report the disruptions allowed by the selected VMIs", func() {
+		budget := addBudget(intstr.FromInt32(2))
+		addVMI("db-1", virtv1.Running)
+		addVMI("db-2", virtv1.Running)
+		addVMI("db-3", virtv1.Scheduling)
+		addVMI("db-4", virtv1.Failed)
+		addVMI("web", virtv1.Scheduling, libvmi.WithLabel("app", "web"))
+
+		requeueAfter, err := controller.sync(budget.DeepCopy())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeueAfter).To(BeZero())
+
+		status := getStatus()
+		Expect(status.ObservedGeneration).To(BeEquivalentTo(1))
+		Expect(status.ExpectedVirtualMachineInstances).To(BeEquivalentTo(3))
+		Expect(status.CurrentHealthy).To(BeEquivalentTo(2))
+		Expect(status.DisruptionsAllowed).To(BeEquivalentTo(1))
+	})
+
+	It("should count the migrating VMIs as disrupted", func() {
+		budget := addBudget(intstr.FromInt32(1))
+		addVMI("db-1", virtv1.Running)
+		addVMI("db-2", virtv1.Running)
+		Expect(migrationInformer.GetStore().Add(&virtv1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{Name: "migration", Namespace: metav1.NamespaceDefault},
+			Spec:       virtv1.VirtualMachineInstanceMigrationSpec{VMIName: "db-1"},
+		})).To(Succeed())
+
+		_, err := controller.sync(budget.DeepCopy())
+		Expect(err).ToNot(HaveOccurred())
+
+		status := getStatus()
+		Expect(status.CurrentHealthy).To(BeEquivalentTo(1))
+		Expect(status.DisruptionsAllowed).To(BeZero())
+	})
+
+	It("should requeue the budget when a granted disruption times out", func() {
+		budget := addBudget(intstr.FromInt32(1))
+		budget.Status.DisruptedVirtualMachineInstances = map[string]metav1.Time{
+			"db-1": metav1.NewTime(time.Now()),
+		}
+		addVMI("db-1", virtv1.Running)
+
+		requeueAfter, err := controller.sync(budget.DeepCopy())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeueAfter).To(BeNumerically("~", vmdisruption.DisruptionTimeout, time.Second))
+
+		status := getStatus()
+		Expect(status.DisruptedVirtualMachineInstances).To(HaveKey("db-1"))
+		Expect(status.DisruptionsAllowed).To(BeZero())
+	})
+
+	It("should enqueue the budgets selecting a changed VMI", func() {
+		addBudget(intstr.FromInt32(1))
+		vmi := libvmi.New(libvmi.WithName("db"), libvmi.WithNamespace(metav1.NamespaceDefault), libvmi.WithLabel("app", "db"))
+		controller.handleVMI(vmi)
+		Expect(controller.queue.Len()).To(Equal(1))
+
+		other := libvmi.New(libvmi.WithName("web"), libvmi.WithNamespace(metav1.NamespaceDefault), libvmi.WithLabel("app", "web"))
+		controller.queue.Get()
+		controller.handleVMI(other)
+		Expect(controller.queue.Len()).To(BeZero())
+	})
+})
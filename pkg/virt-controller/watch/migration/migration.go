@@ -46,6 +46,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/util"
 
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -111,6 +112,7 @@ type Controller struct {
 	storageClassStore    cache.Store
 	storageProfileStore  cache.Store
 	migrationPolicyStore cache.Store
+	retryBudgetIndexer   cache.Indexer
 	kubevirtStore        cache.Store
 	resourceQuotaIndexer cache.Indexer
 	recorder             record.EventRecorder
@@ -138,6 +140,7 @@ func NewController(templateService services.TemplateService,
 	storageClassInformer cache.SharedIndexInformer,
 	storageProfileInformer cache.SharedIndexInformer,
 	migrationPolicyInformer cache.SharedIndexInformer,
+	retryBudgetInformer cache.SharedIndexInformer,
 	resourceQuotaInformer cache.SharedIndexInformer,
 	kubevirtInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
@@ -159,6 +162,7 @@ func NewController(templateService services.TemplateService,
 		storageProfileStore:  storageProfileInformer.GetStore(),
 		resourceQuotaIndexer: resourceQuotaInformer.GetIndexer(),
 		migrationPolicyStore: migrationPolicyInformer.GetStore(),
+		retryBudgetIndexer:   retryBudgetInformer.GetIndexer(),
 		kubevirtStore:        kubevirtInformer.GetStore(),
 		recorder:             recorder,
 		clientset:            clientset,
@@ -181,6 +185,7 @@ func NewController(templateService services.TemplateService,
 			storageClassInformer.HasSynced() &&
 			storageProfileInformer.HasSynced() &&
 			migrationPolicyInformer.HasSynced() &&
+			retryBudgetInformer.HasSynced() &&
 			pvcInformer.HasSynced() &&
 			nodeInformer.HasSynced()
 	}
@@ -332,7 +337,7 @@ func (c *Controller) execute(key string) error {
 		return nil
 	}
 	migration := obj.(*virtv1.VirtualMachineInstanceMigration)
-	logger := log.Log.Object(migration)
+	logger := log.Log.Subcomponent(log.SubcomponentMigration).Object(migration)
 
 	// this must be first step in execution. Writing the object
 	// when api version changes ensures our api stored version is updated.
@@ -385,6 +390,9 @@ func (c *Controller) execute(key string) error {
 	}
 
 	if migration.IsFinal() {
+		if err = c.handleMigrationRetry(key, migration, vmi); err != nil {
+			return err
+		}
 		err = c.garbageCollectFinalizedMigrations(vmi)
 		if err != nil {
 			return err
@@ -575,6 +583,15 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 		}
 	}
 
+	if !migrationCopy.IsFinal() && vmi != nil && vmi.Status.MigrationState != nil &&
+		vmi.Status.MigrationState.MigrationUID == migration.UID {
+		setMigrationProgress(migrationCopy, vmi.Status.MigrationState.Progress)
+	}
+
+	if migrationCopy.Spec.RetryPolicy != nil && migrationCopy.Status.Retry == nil {
+		migrationCopy.Status.Retry = newMigrationRetryStatus(migrationCopy)
+	}
+
 	controller.SetVMIMigrationPhaseTransitionTimestamp(migration, migrationCopy)
 	controller.SetSourcePod(migrationCopy, vmi, c.podIndexer)
 	if err := c.setSynchronizationAddressStatus(migrationCopy); err != nil {
@@ -597,6 +614,20 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 	return nil
 }
 
+// setMigrationProgress mirrors the progress reported on the VMI while the migration is running
+func setMigrationProgress(migration *virtv1.VirtualMachineInstanceMigration, progress *virtv1.MigrationProgress) {
+	if progress == nil {
+		if migration.Status.MigrationState != nil {
+			migration.Status.MigrationState.Progress = nil
+		}
+		return
+	}
+	if migration.Status.MigrationState == nil {
+		migration.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{}
+	}
+	migration.Status.MigrationState.Progress = progress.DeepCopy()
+}
+
 func (c *Controller) setSynchronizationAddressStatus(migration *virtv1.VirtualMachineInstanceMigration) error {
 	kvs := c.kubevirtStore.List()
 	if len(kvs) > 1 {
@@ -740,7 +771,7 @@ func (c *Controller) processMigrationPhase(
 		}
 		log.Log.Object(vmi).V(4).Infof("is migration completed: %t, uid %s", vmi.IsMigrationCompleted(), vmi.UID)
 		if vmi.Status.MigrationState.Completed &&
-			!vmiConditionManager.HasCondition(vmi, virtv1.VirtualMachineInstanceVCPUChange) &&
+			!vmiConditionManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceVCPUChange, k8sv1.ConditionTrue) &&
 			!vmiConditionManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceMemoryChange, k8sv1.ConditionTrue) &&
 			!vmiConditionManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceMigrationRequired, k8sv1.ConditionTrue) {
 			migrationCopy.Status.Phase = virtv1.MigrationSucceeded
@@ -830,7 +861,37 @@ func createDecentralizedMigrationPodAntiAffinity(templatePod *k8sv1.Pod, vmi *vi
 	)
 }
 
-func (c *Controller) createTargetPod(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, sourcePod *k8sv1.Pod) error {
+// excludeNodesFromMigrationTargetPod prevents the target pod from being scheduled to the given nodes
+func excludeNodesFromMigrationTargetPod(templatePod *k8sv1.Pod, nodes []string) {
+	if len(nodes) == 0 {
+		return
+	}
+	requirement := k8sv1.NodeSelectorRequirement{
+		Key:      "metadata.name",
+		Operator: k8sv1.NodeSelectorOpNotIn,
+		Values:   nodes,
+	}
+
+	if templatePod.Spec.Affinity == nil {
+		templatePod.Spec.Affinity = &k8sv1.Affinity{}
+	}
+	if templatePod.Spec.Affinity.NodeAffinity == nil {
+		templatePod.Spec.Affinity.NodeAffinity = &k8sv1.NodeAffinity{}
+	}
+	if templatePod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		templatePod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &k8sv1.NodeSelector{}
+	}
+	nodeSelector := templatePod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(nodeSelector.NodeSelectorTerms) == 0 {
+		nodeSelector.NodeSelectorTerms = []k8sv1.NodeSelectorTerm{{}}
+	}
+	// Node selector terms are ORed, the requirement has to be part of all of them
+	for i := range nodeSelector.NodeSelectorTerms {
+		nodeSelector.NodeSelectorTerms[i].MatchFields = append(nodeSelector.NodeSelectorTerms[i].MatchFields, requirement)
+	}
+}
+
+func (c *Controller) createTargetPod(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, sourcePod *k8sv1.Pod, excludedNodes []string) error {
 	if !c.pvcExpectations.SatisfiedExpectations(controller.MigrationKey(migration)) {
 		// Give time to the PVC informer to update itself
 		return nil
@@ -848,6 +909,7 @@ func (c *Controller) createTargetPod(migration *virtv1.VirtualMachineInstanceMig
 	} else {
 		createMigrationPodAntiAffinityRule(templatePod, vmi)
 	}
+	excludeNodesFromMigrationTargetPod(templatePod, excludedNodes)
 
 	nodeSelector := make(map[string]string)
 	maps.Copy(nodeSelector, migration.Spec.AddedNodeSelector)
@@ -1116,6 +1178,14 @@ func (c *Controller) handleTargetPodHandoff(migration *virtv1.VirtualMachineInst
 		vmiCopy.Status.MigrationState.MigrationConfiguration = clusterMigrationConfigs
 	}
 
+	applyMigrationOverrides(vmiCopy.Status.MigrationState, migration.Spec.Migration)
+	c.ensureMigrationNetworkConfigured(vmiCopy)
+
+	if migration.Spec.Warm != nil {
+		vmiCopy.Status.MigrationState.Warm = true
+		vmiCopy.Status.MigrationState.CutoverRequested = migration.Spec.Warm.Cutover
+	}
+
 	if controller.VMIHasHotplugCPU(vmi) && vmi.IsCPUDedicated() {
 		cpuLimitsCount, err := getTargetPodLimitsCount(pod)
 		if err != nil {
@@ -1183,6 +1253,32 @@ func (c *Controller) markMigrationAbortInVmiStatus(migration *virtv1.VirtualMach
 	return nil
 }
 
+func (c *Controller) markMigrationCutoverInVmiStatus(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi.Status.MigrationState.MigrationUID != migration.UID || vmi.Status.MigrationState.CutoverRequested {
+		return nil
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	vmiCopy.Status.MigrationState.CutoverRequested = true
+
+	patchBytes, err := patch.New(
+		patch.WithTest("/status/migrationState", vmi.Status.MigrationState),
+		patch.WithReplace("/status/migrationState", vmiCopy.Status.MigrationState),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	if err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedCutoverMigrationReason, "failed to request the cutover in the VMI status: %v", err)
+		return err
+	}
+	log.Log.Object(vmi).Infof("Signaled warm migration %s/%s to cut over.", migration.Namespace, migration.Name)
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, controller.SuccessfulCutoverMigrationReason, "Migration is ready to be cut over by virt-handler.")
+	return nil
+}
+
 func (c *Controller) handleTargetPodCreation(key string, migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, sourcePod *k8sv1.Pod) error {
 	c.migrationStartLock.Lock()
 	defer c.migrationStartLock.Unlock()
@@ -1215,7 +1311,7 @@ func (c *Controller) handleTargetPodCreation(key string, migration *virtv1.Virtu
 	outboundMigrations := c.outboundMigrationsOnNode(vmi.Status.NodeName, runningMigrations)
 	if outboundMigrations >= int(*c.clusterConfig.GetMigrationConfiguration().ParallelOutboundMigrationsPerNode) {
 		// Let's ensure that we only have two outbound migrations per node
-		// XXX: Make this configurable, think about inbound migration limit, bandwidth per migration, and so on.
+		// Inbound migrations are limited when creating the target pod, by keeping it away from nodes at their limit.
 		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because total running parallel outbound migrations on target node [%d] has hit outbound migrations per node limit.", vmi.Namespace, vmi.Name, outboundMigrations)
 		// The controller is busy with active migrations, mark ourselves as low priority to give more cycles to those
 		c.Queue.AddWithOpts(priorityqueue.AddOpts{Priority: lowPriority, After: 5 * time.Second}, key)
@@ -1229,7 +1325,7 @@ func (c *Controller) handleTargetPodCreation(key string, migration *virtv1.Virtu
 		if err != nil {
 			return err
 		}
-		return c.createTargetPod(migration, vmi, sourcePod)
+		return c.createTargetPod(migration, vmi, sourcePod, c.nodesAtInboundMigrationLimit(runningMigrations))
 	}
 	log.Log.Object(vmi).V(5).Info("target pod not created because vmi is not running and migration is not decentralized target migration")
 	return nil
@@ -1588,6 +1684,11 @@ func (c *Controller) sync(key string, migration *virtv1.VirtualMachineInstanceMi
 			if err != nil {
 				return err
 			}
+		} else if migration.Spec.Warm != nil && migration.Spec.Warm.Cutover && vmi.IsMigrationSynchronized(migration) {
+			err = c.markMigrationCutoverInVmiStatus(migration, vmi)
+			if err != nil {
+				return err
+			}
 		}
 	case virtv1.MigrationWaitingForSync:
 		// Waiting for sync, setup vmi migration target status
@@ -2085,6 +2186,55 @@ func (c *Controller) outboundMigrationsOnNode(node string, runningMigrations []*
 	return sum
 }
 
+// nodesAtInboundMigrationLimit returns the nodes which are the target of as many running
+// migrations as allowed by ParallelInboundMigrationsPerNode.
+func (c *Controller) nodesAtInboundMigrationLimit(runningMigrations []*virtv1.VirtualMachineInstanceMigration) []string {
+	limit := c.clusterConfig.GetMigrationConfiguration().ParallelInboundMigrationsPerNode
+	if limit == nil {
+		return nil
+	}
+
+	inboundMigrations := map[string]int{}
+	for _, migration := range runningMigrations {
+		if node := c.migrationTargetNode(migration); node != "" {
+			inboundMigrations[node]++
+		}
+	}
+
+	var nodes []string
+	for node, count := range inboundMigrations {
+		if count >= int(*limit) {
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
+// migrationTargetNode returns the node the migration target pod was scheduled to,
+// or an empty string if it wasn't scheduled yet.
+func (c *Controller) migrationTargetNode(migration *virtv1.VirtualMachineInstanceMigration) string {
+	key := controller.NamespacedKey(migration.Namespace, migration.Spec.VMIName)
+	obj, exists, err := c.vmiStore.GetByKey(key)
+	if err != nil || !exists {
+		return ""
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	if vmi.Status.MigrationState != nil && vmi.Status.MigrationState.MigrationUID == migration.UID && vmi.Status.MigrationState.TargetNode != "" {
+		return vmi.Status.MigrationState.TargetNode
+	}
+	pods, err := c.listMatchingTargetPods(migration, vmi)
+	if err != nil {
+		return ""
+	}
+	for _, pod := range pods {
+		if pod.Spec.NodeName != "" {
+			return pod.Spec.NodeName
+		}
+	}
+	return ""
+}
+
 // findRunningMigrations calculates how many migrations are running or in flight to be triggered to running
 // Migrations which are in running phase are added alongside with migrations which are still pending but
 // where we already see a target pod.
@@ -2268,6 +2418,15 @@ func (c *Controller) matchMigrationPolicy(vmi *virtv1.VirtualMachineInstance, cl
 		return err
 	}
 
+	scheduledBandwidth, err := migrationsutil.ScheduledBandwidth(matchedPolicy.Spec.BandwidthSchedule, time.Now())
+	if err != nil {
+		return err
+	}
+	if scheduledBandwidth != nil {
+		isUpdated = true
+		clusterMigrationConfiguration.BandwidthPerMigration = scheduledBandwidth
+	}
+
 	if isUpdated {
 		vmi.Status.MigrationState.MigrationPolicyName = &matchedPolicy.Name
 		vmi.Status.MigrationState.MigrationConfiguration = clusterMigrationConfiguration
@@ -2277,6 +2436,45 @@ func (c *Controller) matchMigrationPolicy(vmi *virtv1.VirtualMachineInstance, cl
 	return nil
 }
 
+// applyMigrationOverrides layers the per-migration overrides on top of the
+// cluster-wide or policy-matched migration configuration.
+func applyMigrationOverrides(migrationState *virtv1.VirtualMachineInstanceMigrationState, overrides *virtv1.MigrationOverrides) {
+	if overrides == nil {
+		return
+	}
+	migrationState.MigrationOverrides = overrides.DeepCopy()
+
+	migrationConfiguration := migrationState.MigrationConfiguration
+	if overrides.BandwidthPerMigration != nil {
+		migrationConfiguration.BandwidthPerMigration = pointer.P(overrides.BandwidthPerMigration.DeepCopy())
+	}
+	if overrides.AllowAutoConverge != nil {
+		migrationConfiguration.AllowAutoConverge = pointer.P(*overrides.AllowAutoConverge)
+	}
+	if overrides.AllowPostCopy != nil {
+		migrationConfiguration.AllowPostCopy = pointer.P(*overrides.AllowPostCopy)
+	}
+	if overrides.Network != nil {
+		migrationConfiguration.Network = pointer.P(*overrides.Network)
+	}
+}
+
+// ensureMigrationNetworkConfigured falls back to the migration network of the cluster, if the
+// network selected by the migration policy is not one of the configured migration networks.
+func (c *Controller) ensureMigrationNetworkConfigured(vmi *virtv1.VirtualMachineInstance) {
+	migrationConfiguration := vmi.Status.MigrationState.MigrationConfiguration
+	network := migrationConfiguration.Network
+	clusterMigrationConfiguration := c.clusterConfig.GetMigrationConfiguration()
+	if network == nil || migrationsutil.IsMigrationNetworkConfigured(clusterMigrationConfiguration, *network) {
+		return
+	}
+	log.Log.Object(vmi).Warningf("migration network %s is not configured, using the cluster migration network", *network)
+	migrationConfiguration.Network = nil
+	if clusterMigrationConfiguration.Network != nil {
+		migrationConfiguration.Network = pointer.P(*clusterMigrationConfiguration.Network)
+	}
+}
+
 func (c *Controller) isMigrationPolicyMatched(vmi *virtv1.VirtualMachineInstance) bool {
 	if vmi == nil {
 		return false
@@ -233,6 +233,7 @@ var _ = Describe("Migration watcher", func() {
 		resourceQuotaInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
 		namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
 		migrationPolicyInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.MigrationPolicy{})
+		retryBudgetInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.MigrationRetryBudget{})
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
 		nodeInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Node{})
@@ -252,6 +253,7 @@ var _ = Describe("Migration watcher", func() {
 			storageClassInformer,
 			storageProfileInformer,
 			migrationPolicyInformer,
+			retryBudgetInformer,
 			resourceQuotaInformer,
 			kubevirtInformer,
 			recorder,
@@ -351,6 +353,7 @@ var _ = Describe("Migration watcher", func() {
 			controller.vmiStore, controller.podIndexer, controller.migrationIndexer, controller.nodeStore,
 			controller.pvcStore, controller.migrationPolicyStore, controller.resourceQuotaIndexer,
 			controller.storageClassStore, controller.storageProfileStore, controller.kubevirtStore,
+			controller.retryBudgetIndexer,
 		}, Default)
 	}
 
@@ -1507,6 +1510,43 @@ var _ = Describe("Migration watcher", func() {
 			expectMigrationCompletedState(migration.Namespace, migration.Name)
 		})
 
+		It("should mirror the progress of a running migration", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			addNodeNameToVMI(vmi, "node02")
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationRunning)
+			targetPod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+			targetPod.Spec.NodeName = "node01"
+
+			progress := &virtv1.MigrationProgress{
+				Timestamp:                     pointer.P(metav1.Now()),
+				DataTotalBytes:                4096,
+				DataRemainingBytes:            1024,
+				MemoryDirtyRateBytesPerSecond: 512,
+				ExpectedDowntimeMilliseconds:  300,
+				Iterations:                    3,
+			}
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID:      migration.UID,
+				TargetNode:        "node01",
+				SourceNode:        "node02",
+				TargetNodeAddress: "10.10.10.10:1234",
+				StartTimestamp:    pointer.P(metav1.Now()),
+				Progress:          progress,
+			}
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+			addPod(targetPod)
+
+			sanityExecute()
+
+			updatedVMIM, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).Get(context.Background(), migration.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMIM.Status.Phase).To(Equal(virtv1.MigrationRunning))
+			Expect(updatedVMIM.Status.MigrationState).ToNot(BeNil())
+			Expect(updatedVMIM.Status.MigrationState.Progress).To(Equal(progress))
+		})
+
 		It("should not override the MigrationState of a completed migration when a new one is created", func() {
 			vmi := newVirtualMachine("testvmi", virtv1.Running)
 			addNodeNameToVMI(vmi, "node02")
@@ -1627,6 +1667,63 @@ var _ = Describe("Migration watcher", func() {
 			Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
 		})
 
+		It("should hand a warm migration over to the target virt-handler", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			addNodeNameToVMI(vmi, "node02")
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationScheduled)
+			migration.Spec.Warm = &virtv1.WarmMigration{}
+			targetPod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+			targetPod.Spec.NodeName = "node01"
+			targetPod.Status.ContainerStatuses = []k8sv1.ContainerStatus{{
+				Name: "compute", State: k8sv1.ContainerState{Running: &k8sv1.ContainerStateRunning{}},
+			}}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+			addPod(targetPod)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulHandOverPodReason)
+			expectVirtualMachineInstanceMigrationState(vmi.Namespace, vmi.Name, PointTo(MatchFields(IgnoreExtras, Fields{
+				"MigrationUID":     Equal(migration.UID),
+				"Warm":             BeTrue(),
+				"CutoverRequested": BeFalse(),
+			})))
+		})
+
+		It("should request the cutover of a warm migration", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			addNodeNameToVMI(vmi, "node02")
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationRunning)
+			migration.Spec.Warm = &virtv1.WarmMigration{Cutover: true}
+			targetPod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+			targetPod.Spec.NodeName = "node01"
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID:      migration.UID,
+				TargetNode:        "node01",
+				SourceNode:        "node02",
+				TargetNodeAddress: "10.10.10.10:1234",
+				StartTimestamp:    pointer.P(metav1.Now()),
+				Warm:              true,
+			}
+			controller.addHandOffKey(virtcontroller.MigrationKey(migration))
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+			addPod(targetPod)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCutoverMigrationReason)
+			expectVirtualMachineInstanceMigrationState(vmi.Namespace, vmi.Name, PointTo(MatchFields(IgnoreExtras, Fields{
+				"MigrationUID":     Equal(migration.UID),
+				"Warm":             BeTrue(),
+				"CutoverRequested": BeTrue(),
+			})))
+		})
+
 		It("should abort the migration", func() {
 			vmi := newVirtualMachine("testvmi", virtv1.Running)
 			addNodeNameToVMI(vmi, "node02")
@@ -1953,6 +2050,125 @@ var _ = Describe("Migration watcher", func() {
 				false,
 			),
 		)
+
+		DescribeTable("should apply the bandwidth schedule of the matched migration policy", func(days []string, expectedBandwidth string) {
+			vmi = newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationScheduled)
+
+			targetPod = newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+			targetPod.Spec.NodeName = "node01"
+			targetPod.Status.ContainerStatuses = []k8sv1.ContainerStatus{{
+				Name: "compute", State: k8sv1.ContainerState{Running: &k8sv1.ContainerStateRunning{}},
+			}}
+
+			migrationPolicy := generatePolicyAndAlignVMI(vmi)
+			migrationPolicy.Spec.BandwidthPerMigration = pointer.P(resource.MustParse("1Gi"))
+			migrationPolicy.Spec.BandwidthSchedule = &migrationsv1.BandwidthSchedule{
+				Windows: []migrationsv1.BandwidthWindow{{
+					Start:                 "00:00",
+					End:                   "00:00",
+					Days:                  days,
+					BandwidthPerMigration: resource.MustParse("64Mi"),
+				}},
+			}
+
+			addMigrationPolicies(*migrationPolicy)
+			addMigration(migration)
+			addPod(targetPod)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulHandOverPodReason)
+			expectedConfigs := getDefaultMigrationConfiguration()
+			expectedConfigs.BandwidthPerMigration = pointer.P(resource.MustParse(expectedBandwidth))
+			expectVirtualMachineInstanceMigrationConfiguration(vmi.Namespace, vmi.Name, getMigrationConfig(expectedConfigs))
+		},
+			Entry("when a window is active", nil, "64Mi"),
+			Entry("unless no window is active", []string{time.Now().Add(48 * time.Hour).Weekday().String()}, "1Gi"),
+		)
+
+		It("should apply migration overrides on top of the matched migration policy", func() {
+			vmi = newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationScheduled)
+			migration.Spec.Migration = &virtv1.MigrationOverrides{
+				ParallelMigrationThreads: pointer.P(uint32(4)),
+				BandwidthPerMigration:    pointer.P(resource.MustParse("128Mi")),
+				AllowAutoConverge:        pointer.P(true),
+				Compression:              pointer.P(virtv1.MigrationCompressionZstd),
+			}
+
+			targetPod = newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+			targetPod.Spec.NodeName = "node01"
+			targetPod.Status.ContainerStatuses = []k8sv1.ContainerStatus{{
+				Name: "compute", State: k8sv1.ContainerState{Running: &k8sv1.ContainerStateRunning{}},
+			}}
+
+			migrationPolicy := generatePolicyAndAlignVMI(vmi)
+			migrationPolicy.Spec.AllowAutoConverge = pointer.P(false)
+			migrationPolicy.Spec.CompletionTimeoutPerGiB = &stubNumber
+
+			addMigrationPolicies(*migrationPolicy)
+			addMigration(migration)
+			addPod(targetPod)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulHandOverPodReason)
+			expectedConfigs := getDefaultMigrationConfiguration()
+			expectedConfigs.CompletionTimeoutPerGiB = &stubNumber
+			expectedConfigs.AllowAutoConverge = pointer.P(true)
+			expectedConfigs.BandwidthPerMigration = pointer.P(resource.MustParse("128Mi"))
+			expectVirtualMachineInstanceMigrationConfiguration(vmi.Namespace, vmi.Name, getMigrationConfig(expectedConfigs))
+			expectVirtualMachineInstanceMigrationState(vmi.Namespace, vmi.Name, PointTo(MatchFields(IgnoreExtras, Fields{
+				"MigrationPolicyName": Equal(pointer.P(migrationPolicy.Name)),
+				"MigrationOverrides":  Equal(migration.Spec.Migration),
+			})))
+		})
+
+		DescribeTable("should select the migration network", func(policyNetwork, overrideNetwork, expectedNetwork *string) {
+			setConfig(&virtv1.KubeVirtConfiguration{
+				MigrationConfiguration: &virtv1.MigrationConfiguration{
+					Network:            pointer.P("migration-net"),
+					AdditionalNetworks: []string{"fast-net", "backup-net"},
+				},
+			})
+			vmi = newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationScheduled)
+			if overrideNetwork != nil {
+				migration.Spec.Migration = &virtv1.MigrationOverrides{Network: overrideNetwork}
+			}
+
+			targetPod = newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+			targetPod.Spec.NodeName = "node01"
+			targetPod.Status.ContainerStatuses = []k8sv1.ContainerStatus{{
+				Name: "compute", State: k8sv1.ContainerState{Running: &k8sv1.ContainerStateRunning{}},
+			}}
+
+			migrationPolicy := generatePolicyAndAlignVMI(vmi)
+			migrationPolicy.Spec.Network = policyNetwork
+
+			addMigrationPolicies(*migrationPolicy)
+			addMigration(migration)
+			addPod(targetPod)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulHandOverPodReason)
+			updatedVMI, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMI.Status.MigrationState.MigrationConfiguration.Network).To(Equal(expectedNetwork))
+		},
+			Entry("of the cluster by default", nil, nil, pointer.P("migration-net")),
+			Entry("of the matched migration policy", pointer.P("fast-net"), nil, pointer.P("fast-net")),
+			Entry("of the migration overrides", pointer.P("fast-net"), pointer.P("backup-net"), pointer.P("backup-net")),
+			Entry("of the cluster if the selected one is not configured", pointer.P("unknown-net"), nil, pointer.P("migration-net")),
+		)
 	})
 
 	Context("Migration of host-model VMI", func() {
@@ -2123,6 +2339,171 @@ var _ = Describe("Migration watcher", func() {
 		)
 	})
 
+	Context("Migration retry", func() {
+		var vmi *virtv1.VirtualMachineInstance
+
+		newFailedMigration := func(name string, failedAgo time.Duration) *virtv1.VirtualMachineInstanceMigration {
+			migration := newMigration(name, vmi.Name, virtv1.MigrationFailed)
+			migration.Spec.RetryPolicy = &virtv1.MigrationRetryPolicy{MaxRetries: 2}
+			migration.Status.Retry = newMigrationRetryStatus(migration)
+			migration.Status.PhaseTransitionTimestamps = []virtv1.VirtualMachineInstanceMigrationPhaseTransitionTimestamp{
+				{
+					Phase:                    virtv1.MigrationFailed,
+					PhaseTransitionTimestamp: metav1.NewTime(time.Now().Add(-failedAgo)),
+				},
+			}
+			return migration
+		}
+
+		getMigration := func(name string) *virtv1.VirtualMachineInstanceMigration {
+			migration, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).Get(context.Background(), name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return migration
+		}
+
+		expectNoRetryMigration := func(name string) {
+			_, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).Get(context.Background(), name, metav1.GetOptions{})
+			Expect(err).To(MatchError(k8serrors.IsNotFound, "IsNotFound"))
+		}
+
+		BeforeEach(func() {
+			vmi = newVirtualMachine("testvmi", virtv1.Running)
+			vmi.Labels["app"] = "test"
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+		})
+
+		It("should initialize the retry status of a migration with a retry policy", func() {
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationFailed)
+			migration.Spec.RetryPolicy = &virtv1.MigrationRetryPolicy{MaxRetries: 2}
+			addMigration(migration)
+
+			sanityExecute()
+
+			Expect(getMigration(migration.Name).Status.Retry).To(Equal(&virtv1.MigrationRetryStatus{
+				Attempt:          0,
+				FailureThreshold: 3,
+			}))
+		})
+
+		It("should create a new migration once the backoff expired", func() {
+			migration := newFailedMigration("testmigration", time.Minute)
+			setAnnotation(virtv1.EvacuationMigrationAnnotation, migration)
+			addMigration(migration)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, migrationRetryReason)
+			retry := getMigration("testmigration-retry-1")
+			Expect(retry.Spec).To(Equal(migration.Spec))
+			Expect(retry.Annotations).To(And(
+				HaveKeyWithValue(virtv1.MigrationRetryOfAnnotation, migration.Name),
+				HaveKeyWithValue(virtv1.MigrationRetryAttemptAnnotation, "1"),
+				HaveKey(virtv1.EvacuationMigrationAnnotation),
+			))
+			Expect(getMigration(migration.Name).Status.Retry.RetryMigrationName).To(Equal(retry.Name))
+		})
+
+		It("should name retries after the original migration", func() {
+			migration := newFailedMigration("testmigration-retry-1", time.Minute)
+			migration.Annotations[virtv1.MigrationRetryOfAnnotation] = "testmigration"
+			migration.Annotations[virtv1.MigrationRetryAttemptAnnotation] = "1"
+			migration.Status.Retry = newMigrationRetryStatus(migration)
+			addMigration(migration)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, migrationRetryReason)
+			retry := getMigration("testmigration-retry-2")
+			Expect(retry.Annotations).To(And(
+				HaveKeyWithValue(virtv1.MigrationRetryOfAnnotation, "testmigration"),
+				HaveKeyWithValue(virtv1.MigrationRetryAttemptAnnotation, "2"),
+			))
+		})
+
+		It("should not create a new migration before the backoff expired", func() {
+			migration := newFailedMigration("testmigration", time.Second)
+			addMigration(migration)
+
+			sanityExecute()
+
+			expectNoRetryMigration("testmigration-retry-1")
+			Expect(getMigration(migration.Name).Status.Retry.RetryMigrationName).To(BeEmpty())
+		})
+
+		It("should not retry once all retries are used", func() {
+			migration := newFailedMigration("testmigration-retry-2", time.Hour)
+			migration.Annotations[virtv1.MigrationRetryOfAnnotation] = "testmigration"
+			migration.Annotations[virtv1.MigrationRetryAttemptAnnotation] = "2"
+			migration.Status.Retry = newMigrationRetryStatus(migration)
+			addMigration(migration)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, migrationNotRetriedReason)
+			expectNoRetryMigration("testmigration-retry-3")
+			Expect(getMigration(migration.Name).Status.Retry.Message).To(Equal("migration failed 3 times"))
+		})
+
+		It("should not retry an aborted migration", func() {
+			migration := newFailedMigration("testmigration", time.Minute)
+			migration.Status.Conditions = []virtv1.VirtualMachineInstanceMigrationCondition{
+				{Type: virtv1.VirtualMachineInstanceMigrationAbortRequested, Status: k8sv1.ConditionTrue},
+			}
+			addMigration(migration)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, migrationNotRetriedReason)
+			expectNoRetryMigration("testmigration-retry-1")
+			Expect(getMigration(migration.Name).Status.Retry.Message).To(Equal("migration was aborted"))
+		})
+
+		DescribeTable("should respect migration retry budgets", func(selector *metav1.LabelSelector, maxFailures uint32, expectRetry bool) {
+			Expect(controller.retryBudgetIndexer.Add(&migrationsv1.MigrationRetryBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "budget", Namespace: k8sv1.NamespaceDefault},
+				Spec: migrationsv1.MigrationRetryBudgetSpec{
+					Selector:    selector,
+					MaxFailures: maxFailures,
+				},
+			})).To(Succeed())
+			oldMigration := newFailedMigration("oldmigration", 2*time.Hour)
+			Expect(controller.migrationIndexer.Add(oldMigration)).To(Succeed())
+			migration := newFailedMigration("testmigration", time.Minute)
+			addMigration(migration)
+
+			sanityExecute()
+
+			if expectRetry {
+				testutils.ExpectEvent(recorder, migrationRetryReason)
+				Expect(getMigration(migration.Name).Status.Retry.RetryMigrationName).To(Equal("testmigration-retry-1"))
+			} else {
+				testutils.ExpectEvent(recorder, migrationNotRetriedReason)
+				expectNoRetryMigration("testmigration-retry-1")
+				Expect(getMigration(migration.Name).Status.Retry.Message).To(Equal("migration retry budget budget is exhausted"))
+			}
+		},
+			Entry("retry when the budget is not exhausted", nil, uint32(1), true),
+			Entry("not retry when the budget is exhausted", nil, uint32(0), false),
+			Entry("not retry when the budget selecting the vmi is exhausted",
+				&metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}}, uint32(0), false),
+			Entry("retry when the exhausted budget does not select the vmi",
+				&metav1.LabelSelector{MatchLabels: map[string]string{"app": "other"}}, uint32(0), true),
+		)
+
+		DescribeTable("backoff", func(policy *virtv1.MigrationRetryPolicy, attempt uint32, expected time.Duration) {
+			Expect(migrationRetryBackoff(policy, attempt)).To(Equal(expected))
+		},
+			Entry("should default for the first retry", &virtv1.MigrationRetryPolicy{}, uint32(0), 20*time.Second),
+			Entry("should double with every retry", &virtv1.MigrationRetryPolicy{}, uint32(2), 80*time.Second),
+			Entry("should be capped", &virtv1.MigrationRetryPolicy{}, uint32(10), 5*time.Minute),
+			Entry("should use the policy", &virtv1.MigrationRetryPolicy{
+				Backoff:    &metav1.Duration{Duration: time.Minute},
+				MaxBackoff: &metav1.Duration{Duration: 3 * time.Minute},
+			}, uint32(2), 3*time.Minute),
+		)
+	})
+
 	Context("Descheduler annotations", func() {
 		var vmi *virtv1.VirtualMachineInstance
 
@@ -2252,6 +2633,108 @@ var _ = Describe("Migration watcher", func() {
 		})
 	})
 
+	Context("Migration target inbound limit", func() {
+		addInboundMigration := func(name, targetNode string) {
+			vmi := newVirtualMachine(name, virtv1.Running)
+			addNodeNameToVMI(vmi, "source-"+name)
+			migration := newMigration("migration-"+name, vmi.Name, virtv1.MigrationRunning)
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID: migration.UID,
+				TargetNode:   targetNode,
+			}
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+		}
+
+		getTargetPodExcludedNodes := func(vmi *virtv1.VirtualMachineInstance, migration *virtv1.VirtualMachineInstanceMigration) [][]k8sv1.NodeSelectorRequirement {
+			targetPod, err := getTargetPod(kubeClient, vmi.Namespace, vmi.UID, migration.UID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targetPod.Spec.Affinity).ToNot(BeNil())
+			Expect(targetPod.Spec.Affinity.NodeAffinity).ToNot(BeNil())
+			var matchFields [][]k8sv1.NodeSelectorRequirement
+			for _, term := range targetPod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+				matchFields = append(matchFields, term.MatchFields)
+			}
+			return matchFields
+		}
+
+		It("should keep the target pod away from nodes at the inbound migration limit", func() {
+			setConfig(&virtv1.KubeVirtConfiguration{
+				MigrationConfiguration: &virtv1.MigrationConfiguration{
+					ParallelInboundMigrationsPerNode: pointer.P(uint32(1)),
+				},
+			})
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			addInboundMigration("busy1", "node1")
+			addInboundMigration("busy2", "node2")
+
+			sanityExecute()
+
+			testutils.ExpectEvents(recorder, virtcontroller.SuccessfulCreatePodReason)
+			Expect(getTargetPodExcludedNodes(vmi, migration)).To(HaveEach(ConsistOf(k8sv1.NodeSelectorRequirement{
+				Key:      "metadata.name",
+				Operator: k8sv1.NodeSelectorOpNotIn,
+				Values:   []string{"node1", "node2"},
+			})))
+		})
+
+		It("should not restrict the target pod without inbound migration limit", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			addInboundMigration("busy1", "node1")
+
+			sanityExecute()
+
+			testutils.ExpectEvents(recorder, virtcontroller.SuccessfulCreatePodReason)
+			Expect(getTargetPodExcludedNodes(vmi, migration)).To(HaveEach(BeEmpty()))
+		})
+
+		It("should add the inbound limit to all node selector terms of the VMI", func() {
+			setConfig(&virtv1.KubeVirtConfiguration{
+				MigrationConfiguration: &virtv1.MigrationConfiguration{
+					ParallelInboundMigrationsPerNode: pointer.P(uint32(1)),
+				},
+			})
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			vmi.Spec.Affinity = &k8sv1.Affinity{
+				NodeAffinity: &k8sv1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+						NodeSelectorTerms: []k8sv1.NodeSelectorTerm{
+							{MatchExpressions: []k8sv1.NodeSelectorRequirement{{Key: "zone", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"a"}}}},
+							{MatchExpressions: []k8sv1.NodeSelectorRequirement{{Key: "zone", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"b"}}}},
+						},
+					},
+				},
+			}
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			addInboundMigration("busy1", "node1")
+
+			sanityExecute()
+
+			testutils.ExpectEvents(recorder, virtcontroller.SuccessfulCreatePodReason)
+			excludedNodes := getTargetPodExcludedNodes(vmi, migration)
+			Expect(excludedNodes).To(HaveLen(2))
+			Expect(excludedNodes).To(HaveEach(ConsistOf(k8sv1.NodeSelectorRequirement{
+				Key:      "metadata.name",
+				Operator: k8sv1.NodeSelectorOpNotIn,
+				Values:   []string{"node1"},
+			})))
+		})
+	})
+
 	Context("Priority queue", func() {
 		It("should properly re-enqueue pending migrations as low priority when no new migration can start", func() {
 			By("Creating 1 pending migration. It will be picked up by the call to Execute()")
@@ -0,0 +1,273 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	defaultMigrationRetryBackoff      = 20 * time.Second
+	defaultMigrationRetryMaxBackoff   = 5 * time.Minute
+	defaultMigrationRetryBudgetWindow = time.Hour
+
+	migrationRetryReason      = "MigrationRetry"
+	migrationNotRetriedReason = "MigrationNotRetried"
+)
+
+// migrationRetryAttempt returns the number of the attempt a migration is, 0 for the original migration
+func migrationRetryAttempt(migration *virtv1.VirtualMachineInstanceMigration) uint32 {
+	value, exists := migration.Annotations[virtv1.MigrationRetryAttemptAnnotation]
+	if !exists {
+		return 0
+	}
+	attempt, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0
+	}
+	return uint32(attempt)
+}
+
+func newMigrationRetryStatus(migration *virtv1.VirtualMachineInstanceMigration) *virtv1.MigrationRetryStatus {
+	return &virtv1.MigrationRetryStatus{
+		Attempt:          migrationRetryAttempt(migration),
+		FailureThreshold: migration.Spec.RetryPolicy.MaxRetries + 1,
+	}
+}
+
+func migrationRetryBackoff(policy *virtv1.MigrationRetryPolicy, attempt uint32) time.Duration {
+	backoff := defaultMigrationRetryBackoff
+	if policy.Backoff != nil {
+		backoff = policy.Backoff.Duration
+	}
+	maxBackoff := defaultMigrationRetryMaxBackoff
+	if policy.MaxBackoff != nil {
+		maxBackoff = policy.MaxBackoff.Duration
+	}
+
+	for i := uint32(0); i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxBackoff)
+}
+
+func getMigrationFailedTimestamp(migration *virtv1.VirtualMachineInstanceMigration) *metav1.Time {
+	for _, ts := range migration.Status.PhaseTransitionTimestamps {
+		if ts.Phase == virtv1.MigrationFailed {
+			return ts.PhaseTransitionTimestamp.DeepCopy()
+		}
+	}
+	return nil
+}
+
+// handleMigrationRetry creates a new migration for the VMI once the backoff of a failed
+// migration with a retry policy expired, unless the retries or the retry budget are exhausted.
+func (c *Controller) handleMigrationRetry(key string, migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if migration.Status.Phase != virtv1.MigrationFailed ||
+		migration.Spec.RetryPolicy == nil ||
+		migration.Status.Retry == nil ||
+		migration.DeletionTimestamp != nil {
+		return nil
+	}
+	if migration.Status.Retry.RetryMigrationName != "" || migration.Status.Retry.Message != "" {
+		return nil
+	}
+	failedTimestamp := getMigrationFailedTimestamp(migration)
+	if failedTimestamp == nil {
+		return nil
+	}
+
+	reason, err := c.migrationNotRetriedReason(migration, vmi)
+	if err != nil {
+		return err
+	}
+	if reason != "" {
+		return c.setMigrationRetryStatus(migration, "", reason)
+	}
+
+	attempt := migration.Status.Retry.Attempt
+	backoff := failedTimestamp.Add(migrationRetryBackoff(migration.Spec.RetryPolicy, attempt)).Sub(time.Now())
+	if backoff > 0 {
+		log.Log.Object(migration).V(4).Infof("migration will be retried in %v", backoff)
+		c.Queue.AddAfter(key, backoff)
+		return nil
+	}
+
+	retry := newRetryMigration(migration, attempt+1)
+	_, err = c.clientset.VirtualMachineInstanceMigration(migration.Namespace).Create(context.Background(), retry, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, migrationRetryReason, "Created migration %s to retry failed migration, attempt %d of %d", retry.Name, attempt+1, migration.Spec.RetryPolicy.MaxRetries)
+
+	return c.setMigrationRetryStatus(migration, retry.Name, "")
+}
+
+// migrationNotRetriedReason returns why a failed migration must not be retried, or an empty string if it may be
+func (c *Controller) migrationNotRetriedReason(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) (string, error) {
+	conditionManager := controller.NewVirtualMachineInstanceMigrationConditionManager()
+	if conditionManager.HasCondition(migration, virtv1.VirtualMachineInstanceMigrationAbortRequested) {
+		return "migration was aborted", nil
+	}
+	if vmi == nil || vmi.IsFinal() || !vmi.IsRunning() {
+		return "vmi is not running", nil
+	}
+	if migration.Status.Retry.Attempt >= migration.Spec.RetryPolicy.MaxRetries {
+		return fmt.Sprintf("migration failed %d times", migration.Status.Retry.Attempt+1), nil
+	}
+
+	migrations, err := c.listMigrationsMatchingVMI(vmi.Namespace, vmi.Name)
+	if err != nil {
+		return "", err
+	}
+	for _, m := range migrations {
+		if m.UID != migration.UID && !m.IsFinal() {
+			return fmt.Sprintf("migration %s of the vmi is in progress", m.Name), nil
+		}
+	}
+
+	budget, err := c.exhaustedMigrationRetryBudget(vmi)
+	if err != nil {
+		return "", err
+	}
+	if budget != nil {
+		return fmt.Sprintf("migration retry budget %s is exhausted", budget.Name), nil
+	}
+
+	return "", nil
+}
+
+// exhaustedMigrationRetryBudget returns the first budget selecting the VMI which counts
+// more failed migrations within its window than it tolerates
+func (c *Controller) exhaustedMigrationRetryBudget(vmi *virtv1.VirtualMachineInstance) (*v1alpha1.MigrationRetryBudget, error) {
+	objs, err := c.retryBudgetIndexer.ByIndex(cache.NamespaceIndex, vmi.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, obj := range objs {
+		budget := obj.(*v1alpha1.MigrationRetryBudget)
+		selector := labels.Everything()
+		if budget.Spec.Selector != nil {
+			selector, err = metav1.LabelSelectorAsSelector(budget.Spec.Selector)
+			if err != nil {
+				log.Log.Object(budget).Reason(err).Warning("invalid selector in migration retry budget")
+				continue
+			}
+		}
+		if !selector.Matches(labels.Set(vmi.Labels)) {
+			continue
+		}
+
+		failures, err := c.countFailedMigrations(vmi.Namespace, selector, budgetWindow(budget))
+		if err != nil {
+			return nil, err
+		}
+		if failures > int(budget.Spec.MaxFailures) {
+			return budget, nil
+		}
+	}
+	return nil, nil
+}
+
+func budgetWindow(budget *v1alpha1.MigrationRetryBudget) time.Duration {
+	if budget.Spec.Window != nil {
+		return budget.Spec.Window.Duration
+	}
+	return defaultMigrationRetryBudgetWindow
+}
+
+// countFailedMigrations counts the migrations of the VMIs matching the selector which failed within the window
+func (c *Controller) countFailedMigrations(namespace string, selector labels.Selector, window time.Duration) (int, error) {
+	since := time.Now().Add(-window)
+	migrations, err := c.filterMigrations(namespace, func(migration *virtv1.VirtualMachineInstanceMigration) bool {
+		if migration.Status.Phase != virtv1.MigrationFailed {
+			return false
+		}
+		failedTimestamp := getMigrationFailedTimestamp(migration)
+		if failedTimestamp == nil || failedTimestamp.Time.Before(since) {
+			return false
+		}
+		obj, exists, err := c.vmiStore.GetByKey(controller.NamespacedKey(namespace, migration.Spec.VMIName))
+		if err != nil || !exists {
+			return false
+		}
+		return selector.Matches(labels.Set(obj.(*virtv1.VirtualMachineInstance).Labels))
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(migrations), nil
+}
+
+func (c *Controller) setMigrationRetryStatus(migration *virtv1.VirtualMachineInstanceMigration, retryMigrationName, message string) error {
+	migrationCopy := migration.DeepCopy()
+	migrationCopy.Status.Retry.RetryMigrationName = retryMigrationName
+	migrationCopy.Status.Retry.Message = message
+	if message != "" {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, migrationNotRetriedReason, "Migration is not retried: %s", message)
+	}
+	_, err := c.clientset.VirtualMachineInstanceMigration(migrationCopy.Namespace).UpdateStatus(context.Background(), migrationCopy, metav1.UpdateOptions{})
+	return err
+}
+
+func newRetryMigration(migration *virtv1.VirtualMachineInstanceMigration, attempt uint32) *virtv1.VirtualMachineInstanceMigration {
+	originalName := migration.Name
+	if name, exists := migration.Annotations[virtv1.MigrationRetryOfAnnotation]; exists {
+		originalName = name
+	}
+
+	annotations := map[string]string{
+		virtv1.MigrationRetryOfAnnotation:      originalName,
+		virtv1.MigrationRetryAttemptAnnotation: strconv.FormatUint(uint64(attempt), 10),
+	}
+	for _, annotation := range []string{virtv1.EvacuationMigrationAnnotation, virtv1.WorkloadUpdateMigrationAnnotation} {
+		if value, exists := migration.Annotations[annotation]; exists {
+			annotations[annotation] = value
+		}
+	}
+
+	return &virtv1.VirtualMachineInstanceMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        naming.GetName(originalName, fmt.Sprintf("retry-%d", attempt), validation.DNS1123SubdomainMaxLength),
+			Namespace:   migration.Namespace,
+			Labels:      migration.Labels,
+			Annotations: annotations,
+		},
+		Spec: *migration.Spec.DeepCopy(),
+	}
+}
@@ -0,0 +1,209 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/lookup"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const (
+	// NodeLostReason is set as reason on VMIs which were failed over from a lost node.
+	NodeLostReason = "NodeLost"
+	// FailedOverReason is added in an event when a VMI was failed over from a lost node.
+	FailedOverReason = "FailedOver"
+	// WaitingForFencingReason is added in an event when a VMI can not be failed over
+	// before its lost node is confirmed to be fenced.
+	WaitingForFencingReason = "WaitingForFencing"
+
+	defaultFailoverTimeout = 1 * time.Minute
+	// maxFailovers is the number of failovers kept in the status of a VM
+	maxFailovers = 10
+)
+
+// nodeLostSince returns the time since which the node is not ready, or nil if the node is ready
+// or did not report its readiness yet
+func nodeLostSince(node *v1.Node) *metav1.Time {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type != v1.NodeReady {
+			continue
+		}
+		if condition.Status == v1.ConditionTrue {
+			return nil
+		}
+		lostSince := condition.LastTransitionTime
+		return &lostSince
+	}
+	return nil
+}
+
+func hasOutOfServiceTaint(node *v1.Node) bool {
+	for _, taint := range node.Spec.Taints {
+		if taint.Key == v1.TaintNodeOutOfService {
+			return true
+		}
+	}
+	return false
+}
+
+// failoverVMIs fails the VMIs of highly available VMs on a lost node, for them to be restarted
+// on another node according to the run strategy of their VM
+func (c *Controller) failoverVMIs(node *v1.Node, logger *log.FilteredLogger) error {
+	lostSince := nodeLostSince(node)
+	if lostSince == nil {
+		return nil
+	}
+
+	vmis, err := lookup.ActiveVirtualMachinesOnNode(c.clientset, node.Name)
+	if err != nil {
+		logger.Reason(err).Error("Failed fetching vmis for node")
+		return err
+	}
+
+	errs := []string{}
+	for _, vmi := range vmis {
+		if err := c.failoverVMI(node, lostSince, vmi, logger); err != nil {
+			errs = append(errs, fmt.Sprintf("failed to fail over vmi %s in namespace %s: %v", vmi.Name, vmi.Namespace, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%v", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func (c *Controller) failoverVMI(node *v1.Node, lostSince *metav1.Time, vmi *virtv1.VirtualMachineInstance, logger *log.FilteredLogger) error {
+	controllerRef := metav1.GetControllerOf(vmi)
+	if controllerRef == nil || controllerRef.Kind != virtv1.VirtualMachineGroupVersionKind.Kind {
+		return nil
+	}
+	obj, exists, err := c.vmStore.GetByKey(controller.NamespacedKey(vmi.Namespace, controllerRef.Name))
+	if err != nil {
+		return err
+	} else if !exists {
+		return nil
+	}
+	vm := obj.(*virtv1.VirtualMachine)
+	if vm.UID != controllerRef.UID || vm.Spec.HighAvailability == nil {
+		return nil
+	}
+
+	policy := vm.Spec.HighAvailability
+	timeout := defaultFailoverTimeout
+	if policy.FailoverTimeout != nil {
+		timeout = policy.FailoverTimeout.Duration
+	}
+	if wait := timeout - time.Since(lostSince.Time); wait > 0 {
+		c.Queue.AddAfter(node.Name, wait)
+		return nil
+	}
+
+	fencing := virtv1.HighAvailabilityFencingOutOfServiceTaint
+	if policy.Fencing != nil {
+		fencing = *policy.Fencing
+	}
+	// A node which is not ready may still be running the guest, which would then run twice once the VMI
+	// is started on another node. Failing over without fencing has to be allowed by the cluster admin.
+	if fencing == virtv1.HighAvailabilityFencingNone && !c.clusterConfig.UnfencedFailoverEnabled() {
+		c.recorder.Eventf(vmi, v1.EventTypeWarning, WaitingForFencingReason, "Node %s is lost, failing over without fencing requires the %s feature gate", node.Name, featuregate.UnfencedFailoverGate)
+		return nil
+	}
+	fenced := fencing == virtv1.HighAvailabilityFencingOutOfServiceTaint
+	if fenced && !hasOutOfServiceTaint(node) {
+		c.recorder.Eventf(vmi, v1.EventTypeWarning, WaitingForFencingReason, "Node %s is lost, waiting for it to be tainted with %s to fail over", node.Name, v1.TaintNodeOutOfService)
+		return nil
+	}
+
+	logger.V(2).Infof("Failing over vmi %s in namespace %s from lost node", vmi.Name, vmi.Namespace)
+	if err := c.recordFailover(vm, node.Name, lostSince, fenced); err != nil {
+		return err
+	}
+	if err := c.forceDeletePods(vmi); err != nil {
+		return err
+	}
+
+	patchBytes, err := patch.New(
+		patch.WithTest("/status/phase", vmi.Status.Phase),
+		patch.WithReplace("/status/phase", virtv1.Failed),
+		patch.WithAdd("/status/reason", NodeLostReason),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if _, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		return err
+	}
+
+	c.recorder.Eventf(vmi, v1.EventTypeNormal, FailedOverReason, "Node %s is lost, marking VMI as failed to restart it on another node", node.Name)
+	return nil
+}
+
+// recordFailover appends the failover to the status of the VM, unless it was already recorded
+func (c *Controller) recordFailover(vm *virtv1.VirtualMachine, nodeName string, lostSince *metav1.Time, fenced bool) error {
+	failovers := vm.Status.Failovers
+	if n := len(failovers); n > 0 && failovers[n-1].Node == nodeName && failovers[n-1].NodeLostTime.Equal(lostSince) {
+		return nil
+	}
+
+	newFailovers := append([]virtv1.VirtualMachineFailover{}, failovers...)
+	newFailovers = append(newFailovers, virtv1.VirtualMachineFailover{
+		Node:         nodeName,
+		NodeLostTime: *lostSince,
+		Time:         metav1.Now(),
+		Fenced:       fenced,
+	})
+	if len(newFailovers) > maxFailovers {
+		newFailovers = newFailovers[len(newFailovers)-maxFailovers:]
+	}
+
+	patchSet := patch.New()
+	if len(failovers) == 0 {
+		patchSet.AddOption(patch.WithAdd("/status/failovers", newFailovers))
+	} else {
+		patchSet.AddOption(
+			patch.WithTest("/status/failovers", failovers),
+			patch.WithReplace("/status/failovers", newFailovers),
+		)
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+// forceDeletePods deletes the pods of the VMI without waiting for the kubelet of the lost node
+// to confirm that their containers are stopped
+func (c *Controller) forceDeletePods(vmi *virtv1.VirtualMachineInstance) error {
+	pods, err := c.clientset.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: labels.Set{virtv1.CreatedByLabel: string(vmi.UID)}.String(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods.Items {
+		err := c.clientset.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{GracePeriodSeconds: pointer.P(int64(0))})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/util/lookup"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 const (
@@ -37,14 +38,16 @@ type Controller struct {
 	Queue            workqueue.TypedRateLimitingInterface[string]
 	nodeStore        cache.Store
 	vmiStore         cache.Store
+	vmStore          cache.Store
 	recorder         record.EventRecorder
+	clusterConfig    *virtconfig.ClusterConfig
 	heartBeatTimeout time.Duration
 	recheckInterval  time.Duration
 	hasSynced        func() bool
 }
 
 // NewController creates a new instance of the NodeController struct.
-func NewController(clientset kubecli.KubevirtClient, nodeInformer cache.SharedIndexInformer, vmiInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*Controller, error) {
+func NewController(clientset kubecli.KubevirtClient, nodeInformer cache.SharedIndexInformer, vmiInformer cache.SharedIndexInformer, vmInformer cache.SharedIndexInformer, recorder record.EventRecorder, clusterConfig *virtconfig.ClusterConfig) (*Controller, error) {
 	c := &Controller{
 		clientset: clientset,
 		Queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
@@ -53,13 +56,15 @@ func NewController(clientset kubecli.KubevirtClient, nodeInformer cache.SharedIn
 		),
 		nodeStore:        nodeInformer.GetStore(),
 		vmiStore:         vmiInformer.GetStore(),
+		vmStore:          vmInformer.GetStore(),
 		recorder:         recorder,
+		clusterConfig:    clusterConfig,
 		heartBeatTimeout: 5 * time.Minute,
 		recheckInterval:  1 * time.Minute,
 	}
 
 	c.hasSynced = func() bool {
-		return nodeInformer.HasSynced() && vmiInformer.HasSynced()
+		return nodeInformer.HasSynced() && vmiInformer.HasSynced() && vmInformer.HasSynced()
 	}
 
 	_, err := nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -210,6 +215,12 @@ func (c *Controller) execute(key string) error {
 		}
 	}
 
+	if node != nil {
+		if err := c.failoverVMIs(node, logger); err != nil {
+			return err
+		}
+	}
+
 	c.requeueIfExists(key, node)
 
 	return nil
@@ -28,7 +28,10 @@ import (
 	"kubevirt.io/client-go/testing"
 
 	controllertesting "kubevirt.io/kubevirt/pkg/controller/testing"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	watchtesting "kubevirt.io/kubevirt/pkg/virt-controller/watch/testing"
 )
 
@@ -40,6 +43,8 @@ var _ = Describe("Node controller with", func() {
 	var nodeInformer cache.SharedIndexInformer
 	var vmiSource *framework.FakeControllerSource
 	var vmiInformer cache.SharedIndexInformer
+	var vmInformer cache.SharedIndexInformer
+	var kvStore cache.Store
 	var stop chan struct{}
 	var controller *Controller
 	var recorder *record.FakeRecorder
@@ -62,10 +67,14 @@ var _ = Describe("Node controller with", func() {
 
 		nodeInformer, nodeSource = testutils.NewFakeInformerFor(&k8sv1.Node{})
 		vmiInformer, vmiSource = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
 
-		controller, _ = NewController(virtClient, nodeInformer, vmiInformer, recorder)
+		var config *virtconfig.ClusterConfig
+		config, _, kvStore = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+		controller, _ = NewController(virtClient, nodeInformer, vmiInformer, vmInformer, recorder, config)
 		// Wrap our workqueue to have a way to detect when we are done processing updates
 		mockQueue = testutils.NewMockWorkQueue(controller.Queue)
 		controller.Queue = mockQueue
@@ -118,7 +127,7 @@ var _ = Describe("Node controller with", func() {
 
 	sanityExecute := func() {
 		controllertesting.SanityExecute(controller, []cache.Store{
-			controller.vmiStore, controller.nodeStore,
+			controller.vmiStore, controller.nodeStore, controller.vmStore,
 		}, Default)
 	}
 
@@ -397,6 +406,169 @@ var _ = Describe("Node controller with", func() {
 		)
 	})
 
+	Context("highly available VMs", func() {
+		var node *k8sv1.Node
+		var vm *v1.VirtualMachine
+		var vmi *v1.VirtualMachineInstance
+		var deletedPods []string
+
+		BeforeEach(func() {
+			virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+
+			node = NewLostNode("testnode", 2*time.Minute)
+			vm, _ = watchtesting.DefaultVirtualMachine(true)
+			vm.Spec.HighAvailability = &v1.HighAvailabilityPolicy{}
+			vmi = watchtesting.NewRunningVirtualMachine("vmi", node)
+			vmi.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind)}
+
+			deletedPods = nil
+			kubeClient.Fake.PrependReactor("list", "pods", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+				return true, &k8sv1.PodList{Items: []k8sv1.Pod{*NewHealthyPodForVirtualMachine("launcher", vmi)}}, nil
+			})
+			kubeClient.Fake.PrependReactor("delete", "pods", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+				deleteAction := action.(k8stesting.DeleteActionImpl)
+				Expect(deleteAction.GetDeleteOptions().GracePeriodSeconds).To(HaveValue(BeZero()))
+				deletedPods = append(deletedPods, deleteAction.GetName())
+				return true, nil, nil
+			})
+		})
+
+		createVMAndVMI := func() {
+			_, err := fakeVirtClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(controller.vmStore.Add(vm)).To(Succeed())
+			addVMI(vmi)
+		}
+
+		fenceNode := func() {
+			node.Spec.Taints = []k8sv1.Taint{{Key: k8sv1.TaintNodeOutOfService, Effect: k8sv1.TaintEffectNoExecute}}
+		}
+
+		expectVMINotFailedOver := func() {
+			updatedVMI, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMI.Status.Phase).To(Equal(v1.Running))
+			Expect(deletedPods).To(BeEmpty())
+		}
+
+		expectVMIFailedOver := func(fenced bool) {
+			updatedVMI, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMI.Status.Phase).To(Equal(v1.Failed))
+			Expect(updatedVMI.Status.Reason).To(Equal(NodeLostReason))
+			Expect(deletedPods).To(ConsistOf("launcher"))
+
+			updatedVM, err := fakeVirtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVM.Status.Failovers).To(HaveLen(1))
+			Expect(updatedVM.Status.Failovers[0].Node).To(Equal(node.Name))
+			Expect(updatedVM.Status.Failovers[0].NodeLostTime.Equal(&node.Status.Conditions[0].LastTransitionTime)).To(BeTrue())
+			Expect(updatedVM.Status.Failovers[0].Fenced).To(Equal(fenced))
+		}
+
+		It("should fail over the VMI once the node is lost for the failover timeout", func() {
+			fenceNode()
+			createVMAndVMI()
+			addNode(node)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, FailedOverReason)
+			expectVMIFailedOver(true)
+		})
+
+		It("should not fail over the VMI before the failover timeout passed", func() {
+			vm.Spec.HighAvailability.FailoverTimeout = &metav1.Duration{Duration: 5 * time.Minute}
+			fenceNode()
+			createVMAndVMI()
+			addNode(node)
+
+			sanityExecute()
+			expectVMINotFailedOver()
+		})
+
+		It("should not fail over the VMI of a VM without high availability policy", func() {
+			vm.Spec.HighAvailability = nil
+			fenceNode()
+			createVMAndVMI()
+			addNode(node)
+
+			sanityExecute()
+			expectVMINotFailedOver()
+		})
+
+		It("should not fail over the VMI if the node is ready", func() {
+			node.Status.Conditions[0].Status = k8sv1.ConditionTrue
+			fenceNode()
+			createVMAndVMI()
+			addNode(node)
+
+			sanityExecute()
+			expectVMINotFailedOver()
+		})
+
+		DescribeTable("should wait for the node to be fenced with the out of service taint", func(fencing *v1.HighAvailabilityFencing) {
+			vm.Spec.HighAvailability.Fencing = fencing
+			createVMAndVMI()
+			addNode(node)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, WaitingForFencingReason)
+			expectVMINotFailedOver()
+
+			fenceNode()
+			modifyNode(node)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, FailedOverReason)
+			expectVMIFailedOver(true)
+		},
+			Entry("by default", nil),
+			Entry("with the OutOfServiceTaint fencing", pointer.P(v1.HighAvailabilityFencingOutOfServiceTaint)),
+		)
+
+		It("should not fail over the VMI without fencing if the UnfencedFailover feature gate is disabled", func() {
+			vm.Spec.HighAvailability.Fencing = pointer.P(v1.HighAvailabilityFencingNone)
+			createVMAndVMI()
+			addNode(node)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, WaitingForFencingReason)
+			expectVMINotFailedOver()
+		})
+
+		It("should fail over the VMI without fencing if the UnfencedFailover feature gate is enabled", func() {
+			kv := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kv.Spec.Configuration.DeveloperConfiguration = &v1.DeveloperConfiguration{
+				FeatureGates: []string{featuregate.UnfencedFailoverGate},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+
+			vm.Spec.HighAvailability.Fencing = pointer.P(v1.HighAvailabilityFencingNone)
+			createVMAndVMI()
+			addNode(node)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, FailedOverReason)
+			expectVMIFailedOver(false)
+		})
+
+		It("should not record the same failover twice", func() {
+			vm.Status.Failovers = []v1.VirtualMachineFailover{{
+				Node:         node.Name,
+				NodeLostTime: node.Status.Conditions[0].LastTransitionTime,
+				Time:         metav1.Now(),
+				Fenced:       true,
+			}}
+			fenceNode()
+			createVMAndVMI()
+			addNode(node)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, FailedOverReason)
+			expectVMIFailedOver(true)
+		})
+	})
+
 	AfterEach(func() {
 		close(stop)
 		// Ensure that we add checks for expected events to every test
@@ -419,6 +591,16 @@ func NewHealthyNode(nodeName string) *k8sv1.Node {
 	}
 }
 
+func NewLostNode(nodeName string, lostFor time.Duration) *k8sv1.Node {
+	node := NewHealthyNode(nodeName)
+	node.Status.Conditions = []k8sv1.NodeCondition{{
+		Type:               k8sv1.NodeReady,
+		Status:             k8sv1.ConditionUnknown,
+		LastTransitionTime: metav1.NewTime(time.Now().Add(-lostFor).Truncate(time.Second)),
+	}}
+	return node
+}
+
 func HealthVirtHandlerDS() *appv1.DaemonSet {
 	ds := newVirtHanderDS()
 	ds.Status = appv1.DaemonSetStatus{
@@ -0,0 +1,331 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pool
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	promapi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	k8score "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	FailedAutoscaleReason     = "FailedAutoscale"
+	SuccessfulAutoscaleReason = "SuccessfulAutoscale"
+
+	// autoscalingInterval is the period the metrics of autoscaled pools are read at
+	autoscalingInterval               = time.Minute
+	defaultScaleInStabilizationWindow = 5 * time.Minute
+	// autoscalingTolerance is the deviation from the target below which the replicas are kept
+	autoscalingTolerance   = 0.1
+	prometheusQueryTimeout = 30 * time.Second
+)
+
+var currentTime = func() time.Time {
+	return time.Now()
+}
+
+// PrometheusQuerier runs the queries of the Prometheus metrics of a pool
+type PrometheusQuerier interface {
+	Query(query string) (float64, error)
+}
+
+// NewPrometheusQuerier returns a PrometheusQuerier for the Prometheus API at address
+func NewPrometheusQuerier(address string) (PrometheusQuerier, error) {
+	client, err := promapi.NewClient(promapi.Config{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	return &prometheusQuerier{api: promv1.NewAPI(client)}, nil
+}
+
+type prometheusQuerier struct {
+	api promv1.API
+}
+
+// Query returns the sum of the values of the series returned by the query
+func (q *prometheusQuerier) Query(query string) (float64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), prometheusQueryTimeout)
+	defer cancel()
+
+	value, _, err := q.api.Query(ctx, query, currentTime())
+	if err != nil {
+		return 0, err
+	}
+
+	switch result := value.(type) {
+	case model.Vector:
+		sum := 0.0
+		for _, sample := range result {
+			sum += float64(sample.Value)
+		}
+		return sum, nil
+	case *model.Scalar:
+		return float64(result.Value), nil
+	default:
+		return 0, fmt.Errorf("unsupported result type %s of query %q", value.Type(), query)
+	}
+}
+
+// autoscale computes the replicas the metrics of the pool ask for and patches
+// the replicas of the pool when they differ. It returns the pool to continue
+// the reconciliation with and the new autoscaling status.
+func (c *Controller) autoscale(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (*poolv1.VirtualMachinePool, *poolv1.VirtualMachinePoolAutoscalingStatus, error) {
+	spec := pool.Spec.Autoscaling
+	status := &poolv1.VirtualMachinePoolAutoscalingStatus{}
+	if pool.Status.Autoscaling != nil {
+		status.LastScaleTime = pool.Status.Autoscaling.LastScaleTime
+	}
+
+	current := int32(1)
+	if pool.Spec.Replicas != nil {
+		current = *pool.Spec.Replicas
+	}
+
+	desired := int32(0)
+	for _, metric := range spec.Metrics {
+		replicas, metricStatus, err := c.replicasForMetric(vms, metric, current)
+		if err != nil {
+			return pool, nil, fmt.Errorf("failed to read the %s metric: %v", metric.Type, err)
+		}
+		status.CurrentMetrics = append(status.CurrentMetrics, *metricStatus)
+		desired = max(desired, replicas)
+	}
+
+	desired = min(max(desired, minReplicas(spec)), spec.MaxReplicas)
+	if desired < current && !scaleInStabilized(spec, status.LastScaleTime) {
+		desired = current
+	}
+	status.DesiredReplicas = desired
+
+	if desired == current {
+		return pool, status, nil
+	}
+
+	patchSet := patch.New()
+	if pool.Spec.Replicas == nil {
+		patchSet.AddOption(patch.WithAdd("/spec/replicas", desired))
+	} else {
+		patchSet.AddOption(
+			patch.WithTest("/spec/replicas", current),
+			patch.WithReplace("/spec/replicas", desired),
+		)
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return pool, nil, err
+	}
+
+	updatedPool, err := c.clientset.VirtualMachinePool(pool.Namespace).Patch(context.Background(), pool.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return pool, nil, fmt.Errorf("failed to scale the pool to %d replicas: %v", desired, err)
+	}
+
+	status.LastScaleTime = pointer.P(metav1.NewTime(currentTime()))
+	c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulAutoscaleReason, "Scaled pool from %d to %d replicas", current, desired)
+	log.Log.Object(pool).Infof("Autoscaled pool from %d to %d replicas", current, desired)
+
+	return updatedPool, status, nil
+}
+
+// replicasForMetric returns the replicas needed to bring the metric to its target
+func (c *Controller) replicasForMetric(vms []*virtv1.VirtualMachine, metric poolv1.VirtualMachinePoolMetric, current int32) (int32, *poolv1.VirtualMachinePoolMetricStatus, error) {
+	metricStatus := &poolv1.VirtualMachinePoolMetricStatus{Type: metric.Type}
+
+	switch metric.Type {
+	case poolv1.VirtualMachinePoolMetricCPU, poolv1.VirtualMachinePoolMetricMemory:
+		if metric.TargetAverageUtilization == nil {
+			return 0, nil, fmt.Errorf("targetAverageUtilization is not set")
+		}
+		utilization, measured, err := c.averageUtilization(vms, metric.Type)
+		if err != nil {
+			return 0, nil, err
+		}
+		if measured == 0 {
+			// Nothing is running yet, keep the replicas until there is something to measure
+			return current, metricStatus, nil
+		}
+		metricStatus.CurrentAverageUtilization = pointer.P(int32(math.Round(utilization)))
+		return desiredReplicas(measured, utilization/float64(*metric.TargetAverageUtilization), current), metricStatus, nil
+
+	case poolv1.VirtualMachinePoolMetricPrometheus:
+		if metric.Prometheus == nil {
+			return 0, nil, fmt.Errorf("prometheus is not set")
+		}
+		value, err := c.queryPrometheus(metric.Prometheus.Query)
+		if err != nil {
+			return 0, nil, err
+		}
+		target := metric.Prometheus.TargetAverageValue.AsApproximateFloat64()
+		if target <= 0 {
+			return 0, nil, fmt.Errorf("targetAverageValue must be greater than zero")
+		}
+		if current > 0 {
+			metricStatus.CurrentAverageValue = resource.NewMilliQuantity(int64(value*1000/float64(current)), resource.DecimalSI)
+		}
+		return desiredReplicas(current, value/(target*float64(max(current, 1))), current), metricStatus, nil
+
+	default:
+		return 0, nil, fmt.Errorf("unknown metric type %s", metric.Type)
+	}
+}
+
+// desiredReplicas scales the count by the usage ratio, keeping the current
+// replicas while the ratio is within the tolerance
+func desiredReplicas(count int32, ratio float64, current int32) int32 {
+	if math.Abs(ratio-1) <= autoscalingTolerance {
+		return current
+	}
+	return int32(math.Ceil(float64(max(count, 1)) * ratio))
+}
+
+// averageUtilization returns the average CPU or memory utilization, in
+// percent, of the running VMIs of the pool and the number of VMIs measured
+func (c *Controller) averageUtilization(vms []*virtv1.VirtualMachine, metricType poolv1.VirtualMachinePoolMetricType) (float64, int32, error) {
+	total := 0.0
+	measured := int32(0)
+	for _, vm := range vms {
+		vmi := c.runningVMI(vm)
+		if vmi == nil {
+			continue
+		}
+
+		usage, err := c.metrics.VMIUsage(vmi)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to get the usage of %s/%s: %v", vmi.Namespace, vmi.Name, err)
+		}
+
+		if metricType == poolv1.VirtualMachinePoolMetricCPU {
+			total += float64(usage.CPU.MilliValue()) * 100 / float64(vcpus(vmi)*1000)
+		} else {
+			memory := guestMemory(vmi)
+			if memory.IsZero() {
+				continue
+			}
+			total += float64(usage.Memory.Value()) * 100 / float64(memory.Value())
+		}
+		measured++
+	}
+
+	if measured == 0 {
+		return 0, 0, nil
+	}
+	return total / float64(measured), measured, nil
+}
+
+func (c *Controller) queryPrometheus(query string) (float64, error) {
+	address := c.clusterConfig.GetPoolAutoscalingPrometheusURL()
+	if address == "" {
+		return 0, fmt.Errorf("no Prometheus URL is configured in the KubeVirt CR")
+	}
+
+	querier, err := c.newPrometheusQuerier(address)
+	if err != nil {
+		return 0, err
+	}
+	return querier.Query(query)
+}
+
+func (c *Controller) runningVMI(vm *virtv1.VirtualMachine) *virtv1.VirtualMachineInstance {
+	obj, exists, err := c.vmiStore.GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+	if err != nil || !exists {
+		return nil
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	if vmi.DeletionTimestamp != nil || vmi.Status.Phase != virtv1.Running {
+		return nil
+	}
+	return vmi
+}
+
+func vcpus(vmi *virtv1.VirtualMachineInstance) int64 {
+	cpu := vmi.Spec.Domain.CPU
+	if cpu == nil {
+		return 1
+	}
+	return int64(max(cpu.Sockets, 1) * max(cpu.Cores, 1) * max(cpu.Threads, 1))
+}
+
+func guestMemory(vmi *virtv1.VirtualMachineInstance) resource.Quantity {
+	if memory := vmi.Spec.Domain.Memory; memory != nil && memory.Guest != nil {
+		return *memory.Guest
+	}
+	return *vmi.Spec.Domain.Resources.Requests.Memory()
+}
+
+func minReplicas(spec *poolv1.VirtualMachinePoolAutoscaling) int32 {
+	if spec.MinReplicas != nil {
+		return *spec.MinReplicas
+	}
+	return 1
+}
+
+func scaleInStabilized(spec *poolv1.VirtualMachinePoolAutoscaling, lastScaleTime *metav1.Time) bool {
+	if lastScaleTime == nil {
+		return true
+	}
+	window := defaultScaleInStabilizationWindow
+	if spec.ScaleInStabilizationWindow != nil {
+		window = spec.ScaleInStabilizationWindow.Duration
+	}
+	return currentTime().Sub(lastScaleTime.Time) >= window
+}
+
+// sortVMsByLoad orders the VMs which are not running first, followed by the
+// running ones from the lowest to the highest CPU usage
+func (c *Controller) sortVMsByLoad(vms []*virtv1.VirtualMachine) {
+	load := make(map[string]int64, len(vms))
+	for _, vm := range vms {
+		vmi := c.runningVMI(vm)
+		if vmi == nil {
+			load[vm.Name] = -1
+			continue
+		}
+		usage, err := c.metrics.VMIUsage(vmi)
+		if err != nil {
+			// Without metrics prefer the VM over the measured ones, it is likely still starting
+			log.Log.Object(vm).Reason(err).V(4).Info("Failed to get the usage of the VM for scale-in")
+			load[vm.Name] = 0
+			continue
+		}
+		load[vm.Name] = usage.CPU.MilliValue()
+	}
+
+	sort.SliceStable(vms, func(i, j int) bool {
+		return load[vms[i].Name] < load[vms[j].Name]
+	})
+}
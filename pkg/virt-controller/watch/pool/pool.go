@@ -38,7 +38,9 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/metricsapi"
 	traceUtils "kubevirt.io/kubevirt/pkg/util/trace"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
 )
 
@@ -54,6 +56,10 @@ type Controller struct {
 	expectations    *controller.UIDTrackingControllerExpectations
 	burstReplicas   uint
 	hasSynced       func() bool
+	clusterConfig   *virtconfig.ClusterConfig
+	metrics         metricsapi.Client
+
+	newPrometheusQuerier func(address string) (PrometheusQuerier, error)
 }
 
 const (
@@ -83,7 +89,9 @@ func NewController(clientset kubecli.KubevirtClient,
 	poolInformer cache.SharedIndexInformer,
 	revisionInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
-	burstReplicas uint) (*Controller, error) {
+	burstReplicas uint,
+	clusterConfig *virtconfig.ClusterConfig,
+	metrics metricsapi.Client) (*Controller, error) {
 	c := &Controller{
 		clientset: clientset,
 		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
@@ -97,6 +105,10 @@ func NewController(clientset kubecli.KubevirtClient,
 		recorder:        recorder,
 		expectations:    controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		burstReplicas:   burstReplicas,
+		clusterConfig:   clusterConfig,
+		metrics:         metrics,
+
+		newPrometheusQuerier: NewPrometheusQuerier,
 	}
 
 	c.hasSynced = func() bool {
@@ -479,7 +491,7 @@ func (c *Controller) calcDiff(pool *poolv1.VirtualMachinePool, vms []*virtv1.Vir
 		wantedReplicas = *pool.Spec.Replicas
 	}
 
-	return len(vms) - int(wantedReplicas)
+	return len(vms) - int(wantedReplicas) - c.surgeReplicas(pool, vms)
 }
 
 func filterDeletingVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
@@ -526,10 +538,12 @@ func resolveBasePolicy(scaleInStrategy *poolv1.VirtualMachinePoolScaleInStrategy
 	return *scaleInStrategy.Proactive.SelectionPolicy.BasePolicy
 }
 
-func sortVMsForDownscale(vms []*virtv1.VirtualMachine, basePolicy poolv1.VirtualMachinePoolBasePolicy) {
+func (c *Controller) sortVMsForDownscale(vms []*virtv1.VirtualMachine, basePolicy poolv1.VirtualMachinePoolBasePolicy) {
 	switch basePolicy {
 	case poolv1.VirtualMachinePoolBasePolicyDescendingOrder:
 		sortVMsByOrdinalDescending(vms)
+	case poolv1.VirtualMachinePoolBasePolicyLeastLoaded:
+		c.sortVMsByLoad(vms)
 	default:
 		sortVMsRandom(vms)
 	}
@@ -575,7 +589,7 @@ func (c *Controller) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virt
 	}
 
 	basePolicy := resolveBasePolicy(pool.Spec.ScaleInStrategy)
-	sortVMsForDownscale(elgibleVMs, basePolicy)
+	c.sortVMsForDownscale(elgibleVMs, basePolicy)
 
 	log.Log.Object(pool).Infof("Removing %d VMs from pool", count)
 
@@ -599,6 +613,10 @@ func (c *Controller) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virt
 			}
 			c.recorder.Eventf(pool, k8score.EventTypeNormal, common.SuccessfulDeleteVirtualMachineReason, "Deleted VM %s/%s with uid %v from pool", vm.Namespace, vm.Name, vm.ObjectMeta.UID)
 			log.Log.Object(pool).Infof("Deleted vm %s/%s from pool", vm.Namespace, vm.Name)
+
+			if err := c.deleteReplicaObjects(pool, vm); err != nil {
+				errChan <- err
+			}
 		}(i)
 	}
 
@@ -825,10 +843,23 @@ func (c *Controller) scaleOut(pool *poolv1.VirtualMachinePool, count int) error
 			vm.Labels = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels)
 			vm.Annotations = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Annotations)
 			vm.Spec = *indexVMSpec(&pool.Spec, index)
+			if err := applyReplicaTemplating(&vm.Spec, pool.Spec.ReplicaTemplating, name, index); err != nil {
+				c.expectations.CreationObserved(poolKey)
+				log.Log.Object(pool).Reason(err).Errorf("Failed to add vm %s/%s to pool", pool.Namespace, name)
+				errChan <- err
+				return
+			}
 			vm = injectPoolRevisionLabelsIntoVM(vm, revisionName)
 
 			vm.ObjectMeta.OwnerReferences = []metav1.OwnerReference{poolOwnerRef(pool)}
 
+			if err := c.createReplicaObjects(pool, name, index); err != nil {
+				c.expectations.CreationObserved(poolKey)
+				log.Log.Object(pool).Reason(err).Errorf("Failed to add vm %s/%s to pool", pool.Namespace, name)
+				errChan <- err
+				return
+			}
+
 			vm, err = c.clientset.VirtualMachine(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
 
 			if err != nil {
@@ -916,7 +947,7 @@ func (c *Controller) handleUnhealthyVMIs(pool *poolv1.VirtualMachinePool, vms []
 			if vmi.DeletionTimestamp != nil {
 				continue
 			}
-			updateType, err := c.isOutdatedVMI(vm, vmi)
+			updateType, err := c.isOutdatedVMI(pool, vm, vmi)
 			if err != nil {
 				return err
 			}
@@ -957,6 +988,10 @@ func (c *Controller) opportunisticUpdate(pool *poolv1.VirtualMachinePool, vmOutd
 			vmCopy.Labels = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels)
 			vmCopy.Annotations = maps.Clone(pool.Spec.VirtualMachineTemplate.ObjectMeta.Annotations)
 			vmCopy.Spec = *indexVMSpec(&pool.Spec, index)
+			if err := applyReplicaTemplating(&vmCopy.Spec, pool.Spec.ReplicaTemplating, vmCopy.Name, index); err != nil {
+				errChan <- err
+				return
+			}
 			vmCopy = injectPoolRevisionLabelsIntoVM(vmCopy, revisionName)
 
 			_, err = c.clientset.VirtualMachine(vmCopy.Namespace).Update(context.Background(), vmCopy, metav1.UpdateOptions{})
@@ -984,7 +1019,10 @@ func (c *Controller) opportunisticUpdate(pool *poolv1.VirtualMachinePool, vmOutd
 
 func calculateMaxUnavailableInt(pool *poolv1.VirtualMachinePool) (int, error) {
 	maxUnavailable := intstr.FromString("100%")
-	if pool.Spec.MaxUnavailable != nil {
+	rollingUpdate := rollingUpdateOf(pool)
+	if rollingUpdate != nil && rollingUpdate.MaxUnavailable != nil {
+		maxUnavailable = *rollingUpdate.MaxUnavailable
+	} else if pool.Spec.MaxUnavailable != nil {
 		maxUnavailable = *pool.Spec.MaxUnavailable
 	}
 
@@ -1004,14 +1042,138 @@ func calculateMaxUnavailableInt(pool *poolv1.VirtualMachinePool) (int, error) {
 		maxUnavailableInt = int(maxUnavailable.IntVal)
 	}
 
-	if maxUnavailableInt < 1 {
+	if maxUnavailableInt < 1 && calculateMaxSurgeInt(pool) == 0 {
+		// Without surge at least one VM has to be unavailable for the update to progress
 		maxUnavailableInt = 1
 	}
 
 	return maxUnavailableInt, nil
 }
 
-func (c *Controller) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedList []*virtv1.VirtualMachine) error {
+// rollingUpdateOf returns the rolling update parameters of the pool, nil when it
+// has none or is not updated with a rolling update
+func rollingUpdateOf(pool *poolv1.VirtualMachinePool) *poolv1.VirtualMachinePoolRollingUpdate {
+	if pool.Spec.UpdateStrategy == nil || isOpportunisticUpdate(pool) {
+		return nil
+	}
+	return pool.Spec.UpdateStrategy.RollingUpdate
+}
+
+func isOpportunisticUpdate(pool *poolv1.VirtualMachinePool) bool {
+	return pool.Spec.UpdateStrategy != nil && pool.Spec.UpdateStrategy.Type == poolv1.VirtualMachinePoolOpportunisticUpdateStrategyType
+}
+
+func calculateMaxSurgeInt(pool *poolv1.VirtualMachinePool) int {
+	rollingUpdate := rollingUpdateOf(pool)
+	if rollingUpdate == nil || rollingUpdate.MaxSurge == nil {
+		return 0
+	}
+
+	totalReplicas := int32(1)
+	if pool.Spec.Replicas != nil {
+		totalReplicas = *pool.Spec.Replicas
+	}
+
+	maxSurge, err := intstr.GetScaledValueFromIntOrPercent(rollingUpdate.MaxSurge, int(totalReplicas), true)
+	if err != nil || maxSurge < 0 {
+		return 0
+	}
+	return maxSurge
+}
+
+// surgeReplicas returns the number of VMs to run above the replicas of the pool,
+// which is the max surge while outdated VMs are left
+func (c *Controller) surgeReplicas(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
+	maxSurge := calculateMaxSurgeInt(pool)
+	if maxSurge == 0 {
+		return 0
+	}
+
+	for _, vm := range vms {
+		if vm.DeletionTimestamp == nil && !c.isUpdatedReplica(pool, vm) {
+			return maxSurge
+		}
+	}
+	return 0
+}
+
+func isLiveUpdateEnabled(pool *poolv1.VirtualMachinePool) bool {
+	rollingUpdate := rollingUpdateOf(pool)
+	return rollingUpdate != nil && rollingUpdate.LiveUpdate
+}
+
+func isRestartRequired(vm *virtv1.VirtualMachine) bool {
+	return controller.NewVirtualMachineConditionManager().HasCondition(vm, virtv1.VirtualMachineRestartRequired)
+}
+
+// isUpdatedReplica returns whether the VM and its VMI match the current template of the pool
+func (c *Controller) isUpdatedReplica(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) bool {
+	vmRevisionName, exists := vm.Labels[virtv1.VirtualMachinePoolRevisionName]
+	if !exists {
+		return false
+	}
+	vmPoolSpec, exists, err := c.getControllerRevision(vm.Namespace, vmRevisionName)
+	if err != nil || !exists || !equality.Semantic.DeepEqual(vmPoolSpec.VirtualMachineTemplate, pool.Spec.VirtualMachineTemplate) {
+		return false
+	}
+
+	obj, exists, err := c.vmiStore.GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+	if err != nil {
+		return false
+	} else if !exists {
+		// A VM which is not running starts with the current template
+		return true
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+
+	if isLiveUpdateEnabled(pool) && isRestartRequired(vm) {
+		return false
+	}
+
+	vmiRevisionName, exists := vmi.Labels[virtv1.VirtualMachinePoolRevisionName]
+	if !exists {
+		return false
+	} else if vmiRevisionName == vmRevisionName {
+		return true
+	}
+	vmiPoolSpec, exists, err := c.getControllerRevision(vm.Namespace, vmiRevisionName)
+	if err != nil || !exists {
+		return false
+	}
+	return equality.Semantic.DeepEqual(vmiPoolSpec.VirtualMachineTemplate.Spec.Template, vmPoolSpec.VirtualMachineTemplate.Spec.Template) &&
+		equality.Semantic.DeepEqual(vmiPoolSpec.VirtualMachineTemplate.Spec.DataVolumeTemplates, vmPoolSpec.VirtualMachineTemplate.Spec.DataVolumeTemplates)
+}
+
+// isLiveUpdatable returns whether the VMI templates only differ in fields the VM
+// controller applies to running VMIs through hotplug or live migration
+func (c *Controller) isLiveUpdatable(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine, current, expected *virtv1.VirtualMachineInstanceTemplateSpec) bool {
+	if !isLiveUpdateEnabled(pool) || !c.clusterConfig.IsVMRolloutStrategyLiveUpdate() || isRestartRequired(vm) {
+		return false
+	}
+	if current == nil || expected == nil {
+		return false
+	}
+
+	current = current.DeepCopy()
+	currentSpec := &current.Spec
+	expectedSpec := &expected.Spec
+	if currentSpec.Domain.CPU != nil && expectedSpec.Domain.CPU != nil {
+		currentSpec.Domain.CPU.Sockets = expectedSpec.Domain.CPU.Sockets
+	}
+	if expectedSpec.Domain.Memory != nil && expectedSpec.Domain.Memory.Guest != nil {
+		if currentSpec.Domain.Memory == nil {
+			currentSpec.Domain.Memory = &virtv1.Memory{}
+		}
+		currentSpec.Domain.Memory.Guest = expectedSpec.Domain.Memory.Guest
+	}
+	currentSpec.NodeSelector = expectedSpec.NodeSelector
+	currentSpec.Affinity = expectedSpec.Affinity
+	currentSpec.Tolerations = expectedSpec.Tolerations
+
+	return equality.Semantic.DeepEqual(current, expected)
+}
+
+func (c *Controller) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedList []*virtv1.VirtualMachine, surge int) error {
 	// Handle unhealthy VMIs first to rollover any changes to the VMI spec in case last update failed
 	if err := c.handleUnhealthyVMIs(pool, vmUpdatedList); err != nil {
 		return err
@@ -1026,7 +1188,8 @@ func (c *Controller) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedL
 		return err
 	}
 
-	maxUpdatable := maxUnavailableInt - unavailableCount
+	// The surge VMs keep the pool available while the same number of VMs are updated
+	maxUpdatable := maxUnavailableInt + surge - unavailableCount
 	for i := range vmUpdatedList {
 		if maxUpdatable <= 0 {
 			log.Log.V(4).Infof("Delaying proactive update for pool %s/%s - max unavailable (%d) reached", pool.Namespace, pool.Name, maxUnavailableInt)
@@ -1047,7 +1210,7 @@ func (c *Controller) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedL
 		}
 		vmi := obj.(*virtv1.VirtualMachineInstance)
 
-		updateType, err := c.isOutdatedVMI(vm, vmi)
+		updateType, err := c.isOutdatedVMI(pool, vm, vmi)
 		if err != nil {
 			return err
 		}
@@ -1058,7 +1221,9 @@ func (c *Controller) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedL
 		if err := c.handleResourceUpdate(pool, vm, vmi, updateType); err != nil {
 			return err
 		}
-		maxUpdatable--
+		if updateType != proactiveUpdateTypeLiveUpdate {
+			maxUpdatable--
+		}
 	}
 	return nil
 }
@@ -1074,9 +1239,11 @@ const (
 	proactiveUpdateTypeNone proactiveUpdateType = "no-update"
 	// VM needs to be deleted due to data volume changes
 	proactiveUpdateTypeVMDelete proactiveUpdateType = "vm-delete"
+	// VMI spec has changed in fields the VM controller applies to the running VMI, just needs revision label updated
+	proactiveUpdateTypeLiveUpdate proactiveUpdateType = "live-update"
 )
 
-func (c *Controller) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (proactiveUpdateType, error) {
+func (c *Controller) isOutdatedVMI(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (proactiveUpdateType, error) {
 	// This function compares the pool revision (pool spec at a specific point in time) synced
 	// to the VM vs the one used to create the VMI. By comparing the pool spec revisions between
 	// the VM and VMI we can determine if the VM has mutated in a way that should result
@@ -1097,6 +1264,9 @@ func (c *Controller) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.Virtua
 	// 4. If the expected VMI template specs from the revisions are not identical in name, but
 	//    are identical in DeepEquals, patch the VMI with the new revision name used on the vm.
 	// 5. If only the DataVolumeTemplates differ, the VM needs to be deleted to ensure proper data volume handling.
+	// 6. With live updates, VMI templates which only differ in live-updatable fields are applied by the VM
+	//    controller to the running VMI, so only the revision name is patched. VMs the VM controller could
+	//    not live update get the RestartRequired condition and are restarted.
 
 	vmRevisionName, exists := vm.Labels[virtv1.VirtualMachinePoolRevisionName]
 	if !exists {
@@ -1114,6 +1284,10 @@ func (c *Controller) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.Virtua
 	}
 
 	if vmRevisionName == vmiRevisionName {
+		if isLiveUpdateEnabled(pool) && isRestartRequired(vm) {
+			log.Log.Infof("Marking vmi %s/%s for update due to a change which could not be live updated", vm.Namespace, vm.Name)
+			return proactiveUpdateTypeRestart, nil
+		}
 		// no update required because revisions match
 		return proactiveUpdateTypeNone, nil
 	}
@@ -1154,6 +1328,10 @@ func (c *Controller) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.Virtua
 	// the VM and the revision used to create the VMI, then the VMI
 	// must be updated.
 	if !equality.Semantic.DeepEqual(currentVMITemplate, expectedVMITemplate) {
+		if c.isLiveUpdatable(pool, vm, currentVMITemplate, expectedVMITemplate) {
+			log.Log.Infof("Marking vmi %s/%s for live update", vm.Namespace, vm.Name)
+			return proactiveUpdateTypeLiveUpdate, nil
+		}
 		log.Log.Infof("Marking vmi %s/%s for update due out of sync spec", vm.Namespace, vm.Name)
 		return proactiveUpdateTypeRestart, nil
 	}
@@ -1267,9 +1445,17 @@ func (c *Controller) update(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virtu
 		return common.NewSyncError(fmt.Errorf("Error during VM update: %v", err), FailedUpdateReason), false
 	}
 
-	err = c.proactiveUpdate(pool, vmUpdatedList)
-	if err != nil {
-		return common.NewSyncError(fmt.Errorf("Error during VMI update: %v", err), FailedUpdateReason), false
+	if !isOpportunisticUpdate(pool) {
+		wantedReplicas := int32(1)
+		if pool.Spec.Replicas != nil {
+			wantedReplicas = *pool.Spec.Replicas
+		}
+		surge := max(len(vms)-int(wantedReplicas), 0)
+
+		err = c.proactiveUpdate(pool, vmUpdatedList, surge)
+		if err != nil {
+			return common.NewSyncError(fmt.Errorf("Error during VMI update: %v", err), FailedUpdateReason), false
+		}
 	}
 
 	vmUpdateStable := false
@@ -1305,7 +1491,7 @@ func (c *Controller) Execute() bool {
 	return true
 }
 
-func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, syncErr common.SyncError) error {
+func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, autoscalingStatus *poolv1.VirtualMachinePoolAutoscalingStatus, syncErr common.SyncError) error {
 
 	key, err := controller.KeyFunc(origPool)
 	if err != nil {
@@ -1356,6 +1542,16 @@ func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*vi
 
 	pool.Status.Replicas = int32(len(vms))
 	pool.Status.ReadyReplicas = int32(len(c.filterReadyVMs(vms)))
+	pool.Status.UpdatedReplicas = int32(len(filterVMs(vms, func(vm *virtv1.VirtualMachine) bool {
+		return c.isUpdatedReplica(pool, vm)
+	})))
+	pool.Status.OutdatedReplicas = pool.Status.Replicas - pool.Status.UpdatedReplicas
+
+	if autoscalingStatus != nil {
+		pool.Status.Autoscaling = autoscalingStatus
+	} else if pool.Spec.Autoscaling == nil {
+		pool.Status.Autoscaling = nil
+	}
 
 	if !equality.Semantic.DeepEqual(pool.Status, origPool.Status) || pool.Status.Replicas != pool.Status.ReadyReplicas {
 		_, err := c.clientset.VirtualMachinePool(pool.Namespace).UpdateStatus(context.Background(), pool, metav1.UpdateOptions{})
@@ -1421,6 +1617,17 @@ func (c *Controller) execute(key string) error {
 		return err
 	}
 
+	var autoscalingStatus *poolv1.VirtualMachinePoolAutoscalingStatus
+	if pool.Spec.Autoscaling != nil && !pool.Spec.Paused && pool.DeletionTimestamp == nil {
+		pool, autoscalingStatus, err = c.autoscale(pool, vms)
+		if err != nil {
+			logger.Reason(err).Error("Autoscaling the pool failed.")
+			c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedAutoscaleReason, err.Error())
+		}
+		// Read the metrics again, the pool has no events when only the load of its VMs changes
+		c.queue.AddAfter(key, autoscalingInterval)
+	}
+
 	needsSync := c.expectations.SatisfiedExpectations(key)
 	if needsSync && !pool.Spec.Paused && pool.DeletionTimestamp == nil {
 		scaleIsStable := false
@@ -1447,7 +1654,7 @@ func (c *Controller) execute(key string) error {
 		syncErr = c.pruneUnusedRevisions(pool, vms)
 	}
 
-	err = c.updateStatus(pool, vms, syncErr)
+	err = c.updateStatus(pool, vms, autoscalingStatus, syncErr)
 	if err != nil {
 		return err
 	}
@@ -1465,7 +1672,7 @@ func (c *Controller) handleResourceUpdate(pool *poolv1.VirtualMachinePool, vm *v
 	case proactiveUpdateTypeVMDelete:
 		err = c.clientset.VirtualMachine(vm.Namespace).Delete(context.Background(), vm.Name, metav1.DeleteOptions{PropagationPolicy: pointer.P(metav1.DeletePropagationForeground)})
 		log.Log.Object(pool).Infof("Proactive update of VM %s/%s by deleting VM due to data volume changes", vm.Namespace, vm.Name)
-	case proactiveUpdateTypePatchRevisionLabel:
+	case proactiveUpdateTypePatchRevisionLabel, proactiveUpdateTypeLiveUpdate:
 		patchSet := patch.New()
 		vmiLabels := maps.Clone(vmi.Labels)
 		if vmiLabels == nil {
@@ -21,6 +21,7 @@ package pool
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -28,6 +29,8 @@ import (
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -48,6 +51,7 @@ import (
 	controllertesting "kubevirt.io/kubevirt/pkg/controller/testing"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	testutils "kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/util/metricsapi"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
 	watchtesting "kubevirt.io/kubevirt/pkg/virt-controller/watch/testing"
 )
@@ -95,6 +99,7 @@ var _ = Describe("Pool", func() {
 		var mockQueue *testutils.MockWorkQueue[string]
 		var fakeVirtClient *kubevirtfake.Clientset
 		var k8sClient *k8sfake.Clientset
+		var metrics *metricsapi.FakeClient
 
 		addCR := func(cr *appsv1.ControllerRevision) {
 			controller.revisionIndexer.Add(cr)
@@ -138,13 +143,20 @@ var _ = Describe("Pool", func() {
 				},
 			})
 
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				PoolAutoscaling: &v1.PoolAutoscalingConfiguration{PrometheusURL: "http://prometheus.monitoring:9090"},
+			})
+			metrics = metricsapi.NewFakeClient()
+
 			controller, _ = NewController(virtClient,
 				vmiInformer,
 				vmInformer,
 				poolInformer,
 				crInformer,
 				recorder,
-				uint(10))
+				uint(10),
+				clusterConfig,
+				metrics)
 			// Wrap our workqueue to have a way to detect when we are done processing updates
 			mockQueue = testutils.NewMockWorkQueue(controller.queue)
 			controller.queue = mockQueue
@@ -168,6 +180,7 @@ var _ = Describe("Pool", func() {
 				return true, nil, nil
 			})
 			virtClient.EXPECT().AppsV1().Return(k8sClient.AppsV1()).AnyTimes()
+			virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
 		})
 
 		addPool := func(pool *poolv1.VirtualMachinePool) {
@@ -268,6 +281,7 @@ var _ = Describe("Pool", func() {
 			pool, vm := DefaultPool(1)
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.UpdatedReplicas = 1
 			poolRevision := createPoolRevision(pool)
 
 			pool.Generation = 123
@@ -311,6 +325,7 @@ var _ = Describe("Pool", func() {
 			pool, vm := DefaultPool(1)
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.OutdatedReplicas = 1
 
 			oldPoolRevision := createPoolRevision(pool)
 
@@ -351,6 +366,7 @@ var _ = Describe("Pool", func() {
 			vmi := createReadyVMI(vm, poolRevision)
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.UpdatedReplicas = 1
 			addPool(pool)
 			addVM(vm)
 			addVMI(vmi)
@@ -372,6 +388,7 @@ var _ = Describe("Pool", func() {
 			vmi := createReadyVMI(vm, poolRevision)
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.UpdatedReplicas = 1
 			addPool(pool)
 			addVM(vm)
 			addVMI(vmi)
@@ -730,6 +747,231 @@ var _ = Describe("Pool", func() {
 			}
 		})
 
+		Context("with autoscaling", func() {
+			var poolRevision *appsv1.ControllerRevision
+
+			newAutoscaledPool := func(replicas int32, metric poolv1.VirtualMachinePoolMetric) (*poolv1.VirtualMachinePool, []*v1.VirtualMachine) {
+				pool, vm := DefaultPool(replicas)
+				pool.Spec.Autoscaling = &poolv1.VirtualMachinePoolAutoscaling{
+					MaxReplicas: 10,
+					Metrics:     []poolv1.VirtualMachinePoolMetric{metric},
+				}
+				poolRevision = createPoolRevision(pool)
+				addPool(pool)
+				addCR(poolRevision)
+
+				var vms []*v1.VirtualMachine
+				for x := range replicas {
+					newVM := injectPoolRevisionLabelsIntoVM(vm.DeepCopy(), poolRevision.Name)
+					newVM.Name = fmt.Sprintf("%s-%d", pool.Name, x)
+					markVmAsReady(newVM)
+					addVM(newVM)
+					vms = append(vms, newVM)
+				}
+				return pool, vms
+			}
+
+			addRunningVMI := func(vm *v1.VirtualMachine, usage *metricsapi.Usage) {
+				vmi := createReadyVMI(vm, poolRevision)
+				vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("1Gi")}
+				addVMI(vmi)
+				metrics.VMIs[vmi.Name] = usage
+			}
+
+			expectReplicasPatch := func(pool *poolv1.VirtualMachinePool, replicas int32) {
+				fakeVirtClient.Fake.PrependReactor("patch", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					patchAction, ok := action.(k8stesting.PatchAction)
+					Expect(ok).To(BeTrue())
+					Expect(string(patchAction.GetPatch())).To(ContainSubstring(fmt.Sprintf(`{"op":"replace","path":"/spec/replicas","value":%d}`, replicas)))
+					patched := pool.DeepCopy()
+					patched.Spec.Replicas = pointer.P(replicas)
+					return true, patched, nil
+				})
+			}
+
+			expectStatusUpdate := func(validate func(status *poolv1.VirtualMachinePoolAutoscalingStatus)) {
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					updateObj := update.GetObject().(*poolv1.VirtualMachinePool)
+					Expect(updateObj.Status.Autoscaling).ToNot(BeNil())
+					validate(updateObj.Status.Autoscaling)
+					return true, update.GetObject(), nil
+				})
+			}
+
+			It("should scale out when the CPU utilization is above the target", func() {
+				pool, vms := newAutoscaledPool(2, poolv1.VirtualMachinePoolMetric{
+					Type:                     poolv1.VirtualMachinePoolMetricCPU,
+					TargetAverageUtilization: pointer.P(int32(50)),
+				})
+				for _, vm := range vms {
+					addRunningVMI(vm, &metricsapi.Usage{CPU: resource.MustParse("900m")})
+				}
+
+				expectReplicasPatch(pool, 4)
+				expectVMCreation(HavePrefix(fmt.Sprintf("%s-", pool.Name)))
+				expectStatusUpdate(func(status *poolv1.VirtualMachinePoolAutoscalingStatus) {
+					Expect(status.DesiredReplicas).To(Equal(int32(4)))
+					Expect(status.LastScaleTime).ToNot(BeNil())
+					Expect(status.CurrentMetrics).To(ConsistOf(poolv1.VirtualMachinePoolMetricStatus{
+						Type:                      poolv1.VirtualMachinePoolMetricCPU,
+						CurrentAverageUtilization: pointer.P(int32(90)),
+					}))
+				})
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, SuccessfulAutoscaleReason)
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(HaveLen(2))
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+			})
+
+			It("should keep the replicas while the memory utilization is within the tolerance", func() {
+				_, vms := newAutoscaledPool(2, poolv1.VirtualMachinePoolMetric{
+					Type:                     poolv1.VirtualMachinePoolMetricMemory,
+					TargetAverageUtilization: pointer.P(int32(50)),
+				})
+				for _, vm := range vms {
+					addRunningVMI(vm, &metricsapi.Usage{Memory: resource.MustParse("540Mi")})
+				}
+
+				expectStatusUpdate(func(status *poolv1.VirtualMachinePoolAutoscalingStatus) {
+					Expect(status.DesiredReplicas).To(Equal(int32(2)))
+					Expect(status.CurrentMetrics[0].CurrentAverageUtilization).To(HaveValue(Equal(int32(53))))
+				})
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachinepools")).To(BeEmpty())
+			})
+
+			It("should not scale in within the stabilization window", func() {
+				pool, vms := newAutoscaledPool(2, poolv1.VirtualMachinePoolMetric{
+					Type:                     poolv1.VirtualMachinePoolMetricCPU,
+					TargetAverageUtilization: pointer.P(int32(50)),
+				})
+				pool.Status.Autoscaling = &poolv1.VirtualMachinePoolAutoscalingStatus{
+					DesiredReplicas: 2,
+					LastScaleTime:   pointer.P(metav1.NewTime(time.Now().Add(-time.Minute))),
+				}
+				for _, vm := range vms {
+					addRunningVMI(vm, &metricsapi.Usage{CPU: resource.MustParse("100m")})
+				}
+
+				expectStatusUpdate(func(status *poolv1.VirtualMachinePoolAutoscalingStatus) {
+					Expect(status.DesiredReplicas).To(Equal(int32(2)))
+				})
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachinepools")).To(BeEmpty())
+			})
+
+			It("should scale in on a Prometheus query and remove the least loaded VMs first", func() {
+				pool, vms := newAutoscaledPool(3, poolv1.VirtualMachinePoolMetric{
+					Type: poolv1.VirtualMachinePoolMetricPrometheus,
+					Prometheus: &poolv1.VirtualMachinePoolPrometheusMetric{
+						Query:              "sum(rate(requests_total[5m]))",
+						TargetAverageValue: resource.MustParse("100"),
+					},
+				})
+				basePolicy := poolv1.VirtualMachinePoolBasePolicyLeastLoaded
+				pool.Spec.ScaleInStrategy = &poolv1.VirtualMachinePoolScaleInStrategy{
+					Proactive: &poolv1.VirtualMachinePoolProactiveScaleInStrategy{
+						SelectionPolicy: &poolv1.VirtualMachinePoolSelectionPolicy{
+							BasePolicy: &basePolicy,
+						},
+					},
+				}
+				addRunningVMI(vms[0], &metricsapi.Usage{CPU: resource.MustParse("800m")})
+				addRunningVMI(vms[1], &metricsapi.Usage{CPU: resource.MustParse("200m")})
+
+				controller.newPrometheusQuerier = func(address string) (PrometheusQuerier, error) {
+					Expect(address).To(Equal("http://prometheus.monitoring:9090"))
+					return fakePrometheusQuerier(150), nil
+				}
+
+				var deletedVMs []string
+				fakeVirtClient.Fake.PrependReactor("delete", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					deleteAction, ok := action.(k8stesting.DeleteAction)
+					Expect(ok).To(BeTrue())
+					deletedVMs = append(deletedVMs, deleteAction.GetName())
+					return true, nil, nil
+				})
+				expectReplicasPatch(pool, 2)
+				expectStatusUpdate(func(status *poolv1.VirtualMachinePoolAutoscalingStatus) {
+					Expect(status.DesiredReplicas).To(Equal(int32(2)))
+					Expect(status.CurrentMetrics[0].CurrentAverageValue.Cmp(resource.MustParse("50"))).To(BeZero())
+				})
+
+				sanityExecute()
+
+				Expect(deletedVMs).To(ConsistOf("my-pool-2"))
+				testutils.ExpectEvent(recorder, SuccessfulAutoscaleReason)
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+			})
+
+			It("should fail to autoscale when the metrics are not available", func() {
+				_, vms := newAutoscaledPool(1, poolv1.VirtualMachinePoolMetric{
+					Type:                     poolv1.VirtualMachinePoolMetricCPU,
+					TargetAverageUtilization: pointer.P(int32(50)),
+				})
+				addVMI(createReadyVMI(vms[0], poolRevision))
+
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					return true, update.GetObject(), nil
+				})
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, FailedAutoscaleReason)
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachinepools")).To(BeEmpty())
+			})
+		})
+
+		DescribeTable("should sort VMs for scale-in by their load", func(vmis map[string]*metricsapi.Usage, expected []string) {
+			pool, vm := DefaultPool(3)
+			revision := createPoolRevision(pool)
+			var vms []*v1.VirtualMachine
+			for x := range 3 {
+				newVM := vm.DeepCopy()
+				newVM.Name = fmt.Sprintf("%s-%d", pool.Name, x)
+				vms = append(vms, newVM)
+				if usage, exists := vmis[newVM.Name]; exists {
+					controller.vmiStore.Add(createReadyVMI(newVM, revision))
+					if usage != nil {
+						metrics.VMIs[newVM.Name] = usage
+					}
+				}
+			}
+
+			controller.sortVMsForDownscale(vms, poolv1.VirtualMachinePoolBasePolicyLeastLoaded)
+
+			var names []string
+			for _, vm := range vms {
+				names = append(names, vm.Name)
+			}
+			Expect(names).To(Equal(expected))
+		},
+			Entry("with all VMs running", map[string]*metricsapi.Usage{
+				"my-pool-0": {CPU: resource.MustParse("500m")},
+				"my-pool-1": {CPU: resource.MustParse("100m")},
+				"my-pool-2": {CPU: resource.MustParse("300m")},
+			}, []string{"my-pool-1", "my-pool-2", "my-pool-0"}),
+			Entry("with a VM not running", map[string]*metricsapi.Usage{
+				"my-pool-0": {CPU: resource.MustParse("500m")},
+				"my-pool-2": {CPU: resource.MustParse("300m")},
+			}, []string{"my-pool-1", "my-pool-2", "my-pool-0"}),
+			Entry("with a VM without metrics", map[string]*metricsapi.Usage{
+				"my-pool-0": {CPU: resource.MustParse("500m")},
+				"my-pool-1": {CPU: resource.MustParse("300m")},
+				"my-pool-2": nil,
+			}, []string{"my-pool-2", "my-pool-1", "my-pool-0"}),
+		)
+
 		DescribeTable("should respect name generation settings", func(appendIndex *bool) {
 			const (
 				cmName     = "configmap"
@@ -789,10 +1031,152 @@ var _ = Describe("Pool", func() {
 			Entry("append index if set to true", pointer.P(true)),
 		)
 
+		Context("with replica templating", func() {
+			const (
+				cmName     = "config"
+				secretName = "credentials"
+			)
+
+			newTemplatedPool := func(replicas int32) (*poolv1.VirtualMachinePool, *v1.VirtualMachine) {
+				pool, vm := DefaultPool(replicas)
+				pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Volumes = []v1.Volume{
+					{
+						Name: "cloudinit",
+						VolumeSource: v1.VolumeSource{
+							CloudInitNoCloud: &v1.CloudInitNoCloudSource{
+								UserData:    "#cloud-config\nfqdn: $(VM_NAME).example.com\n",
+								NetworkData: "address: $(STATIC_IP)/24\n",
+							},
+						},
+					},
+					{
+						Name: cmName,
+						VolumeSource: v1.VolumeSource{
+							ConfigMap: &v1.ConfigMapVolumeSource{
+								LocalObjectReference: k8sv1.LocalObjectReference{Name: cmName},
+							},
+						},
+					},
+					{
+						Name: secretName,
+						VolumeSource: v1.VolumeSource{
+							Secret: &v1.SecretVolumeSource{SecretName: secretName},
+						},
+					},
+				}
+				pool.Spec.ReplicaTemplating = &poolv1.VirtualMachinePoolReplicaTemplating{
+					Hostname:   "web-$(POOL_INDEX)",
+					StaticIPs:  &poolv1.VirtualMachinePoolStaticIPs{Start: "10.0.0.10"},
+					Secrets:    []poolv1.VirtualMachinePoolReplicaObjectTemplate{{Name: secretName, Data: map[string]string{"password": "secret-$(POOL_INDEX)"}}},
+					ConfigMaps: []poolv1.VirtualMachinePoolReplicaObjectTemplate{{Name: cmName, Data: map[string]string{"ip": "$(STATIC_IP)"}}},
+				}
+				return pool, vm
+			}
+
+			It("should create the VMs with their own hostname, cloud-init data, IP and objects", func() {
+				pool, _ := newTemplatedPool(1)
+				addPool(pool)
+
+				poolRevision := createPoolRevision(pool)
+				expectControllerRevisionCreation(poolRevision)
+				expectVMCreationWithValidation(Equal(pool.Name+"-0"), func(vm *v1.VirtualMachine) {
+					defer GinkgoRecover()
+					vmiSpec := vm.Spec.Template.Spec
+					Expect(vmiSpec.Hostname).To(Equal("web-0"))
+					Expect(vmiSpec.Volumes[0].CloudInitNoCloud.UserData).To(ContainSubstring("fqdn: " + pool.Name + "-0.example.com"))
+					Expect(vmiSpec.Volumes[0].CloudInitNoCloud.NetworkData).To(Equal("address: 10.0.0.10/24\n"))
+					Expect(vmiSpec.Volumes[1].ConfigMap.Name).To(Equal(cmName + "-0"))
+					Expect(vmiSpec.Volumes[2].Secret.SecretName).To(Equal(secretName + "-0"))
+					Expect(vm.Spec.Template.ObjectMeta.Annotations).To(HaveKeyWithValue(v1.VirtualMachinePoolStaticIPAnnotation, "10.0.0.10"))
+				})
+
+				k8sClient.Fake.PrependReactor("create", "secrets", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					secret := action.(k8stesting.CreateAction).GetObject().(*k8sv1.Secret)
+					Expect(secret.Name).To(Equal(secretName + "-0"))
+					Expect(secret.OwnerReferences).To(ConsistOf(poolOwnerRef(pool)))
+					Expect(secret.Data).To(HaveKeyWithValue("password", []byte("secret-0")))
+					return true, secret, nil
+				})
+				k8sClient.Fake.PrependReactor("create", "configmaps", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					configMap := action.(k8stesting.CreateAction).GetObject().(*k8sv1.ConfigMap)
+					Expect(configMap.Name).To(Equal(cmName + "-0"))
+					Expect(configMap.Data).To(HaveKeyWithValue("ip", "10.0.0.10"))
+					// Objects left over from an earlier replica with the same index are kept
+					return true, nil, k8serrors.NewAlreadyExists(k8sv1.Resource("configmaps"), configMap.Name)
+				})
+
+				sanityExecute()
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				Expect(testing.FilterActions(&k8sClient.Fake, "create", "secrets")).To(HaveLen(1))
+				Expect(testing.FilterActions(&k8sClient.Fake, "create", "configmaps")).To(HaveLen(1))
+			})
+
+			It("should not create VMs beyond the last static IP", func() {
+				pool, _ := newTemplatedPool(1)
+				pool.Spec.ReplicaTemplating.StaticIPs = &poolv1.VirtualMachinePoolStaticIPs{Start: "10.0.0.10", End: "10.0.0.9"}
+				addPool(pool)
+
+				poolRevision := createPoolRevision(pool)
+				expectControllerRevisionCreation(poolRevision)
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, action.(k8stesting.UpdateAction).GetObject(), nil
+				})
+
+				sanityExecute()
+				testutils.ExpectEvent(recorder, common.FailedCreateVirtualMachineReason)
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(BeEmpty())
+			})
+
+			It("should delete the objects of the removed VMs", func() {
+				pool, vm := newTemplatedPool(0)
+				addPool(pool)
+				vm.Name = pool.Name + "-1"
+				addVM(vm)
+
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, action.(k8stesting.UpdateAction).GetObject(), nil
+				})
+				fakeVirtClient.Fake.PrependReactor("delete", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, nil, nil
+				})
+				k8sClient.Fake.PrependReactor("delete", "secrets", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					Expect(action.(k8stesting.DeleteAction).GetName()).To(Equal(secretName + "-1"))
+					return true, nil, nil
+				})
+				k8sClient.Fake.PrependReactor("delete", "configmaps", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					name := action.(k8stesting.DeleteAction).GetName()
+					Expect(name).To(Equal(cmName + "-1"))
+					return true, nil, k8serrors.NewNotFound(k8sv1.Resource("configmaps"), name)
+				})
+
+				sanityExecute()
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				Expect(testing.FilterActions(&k8sClient.Fake, "delete", "secrets")).To(HaveLen(1))
+				Expect(testing.FilterActions(&k8sClient.Fake, "delete", "configmaps")).To(HaveLen(1))
+			})
+
+			DescribeTable("should allocate sequential static IPs", func(staticIPs *poolv1.VirtualMachinePoolStaticIPs, idx int, expected string) {
+				ip, err := staticIPForIndex(staticIPs, idx)
+				if expected == "" {
+					Expect(err).To(HaveOccurred())
+					return
+				}
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ip).To(Equal(expected))
+			},
+				Entry("for the first replica", &poolv1.VirtualMachinePoolStaticIPs{Start: "10.0.0.10"}, 0, "10.0.0.10"),
+				Entry("across an octet", &poolv1.VirtualMachinePoolStaticIPs{Start: "10.0.0.250"}, 10, "10.0.1.4"),
+				Entry("for IPv6", &poolv1.VirtualMachinePoolStaticIPs{Start: "fd00::ff"}, 2, "fd00::101"),
+				Entry("up to the end", &poolv1.VirtualMachinePoolStaticIPs{Start: "10.0.0.10", End: "10.0.0.12"}, 2, "10.0.0.12"),
+				Entry("not beyond the end", &poolv1.VirtualMachinePoolStaticIPs{Start: "10.0.0.10", End: "10.0.0.12"}, 3, ""),
+			)
+		})
+
 		It("should respect maxUnavailable limit during proactive updates", func() {
 			pool, vm := DefaultPool(4)
 			pool.Status.Replicas = 4
 			pool.Status.ReadyReplicas = 4
+			pool.Status.OutdatedReplicas = 4
 			maxUnavailable := intstr.FromString("25%")
 			pool.Spec.MaxUnavailable = &maxUnavailable
 
@@ -831,6 +1215,7 @@ var _ = Describe("Pool", func() {
 			pool, vm := DefaultPool(4)
 			pool.Status.Replicas = 4
 			pool.Status.ReadyReplicas = 4
+			pool.Status.OutdatedReplicas = 4
 			maxUnavailable := intstr.FromString("25%")
 			pool.Spec.MaxUnavailable = &maxUnavailable
 
@@ -943,6 +1328,197 @@ var _ = Describe("Pool", func() {
 			Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(HaveLen(1))
 			testutils.ExpectEvent(recorder, common.FailedUpdateVirtualMachineReason)
 		})
+
+		Context("with update strategies", func() {
+			addOutdatedVMs := func(pool *poolv1.VirtualMachinePool, vm *v1.VirtualMachine, count int, newPoolRevision, oldPoolRevision *appsv1.ControllerRevision) {
+				for i := range count {
+					vmCopy := vm.DeepCopy()
+					vmCopy.Name = fmt.Sprintf("%s-%d", pool.Name, i)
+					vmCopy = injectPoolRevisionLabelsIntoVM(vmCopy, newPoolRevision.Name)
+					markVmAsReady(vmCopy)
+					addVM(vmCopy)
+					addVMI(createReadyVMI(vmCopy, oldPoolRevision))
+				}
+			}
+
+			It("should create surge VMs while outdated VMs are left", func() {
+				pool, vm := DefaultPool(2)
+				pool.Status.Replicas = 2
+				pool.Status.ReadyReplicas = 2
+				pool.Status.OutdatedReplicas = 2
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					Type: poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+					RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+						MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+						MaxSurge:       pointer.P(intstr.FromInt32(1)),
+					},
+				}
+
+				oldPoolRevision := createPoolRevision(pool)
+				pool.Generation = 123
+				pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+				newPoolRevision := createPoolRevision(pool)
+
+				addPool(pool)
+				addOutdatedVMs(pool, vm, 2, newPoolRevision, oldPoolRevision)
+				addCR(oldPoolRevision)
+				addCR(newPoolRevision)
+
+				expectControllerRevisionCreation(newPoolRevision)
+				expectVMCreation(Equal(fmt.Sprintf("%s-2", pool.Name)))
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+			})
+
+			It("should restart as many VMs as surge VMs are running when maxUnavailable is 0", func() {
+				pool, vm := DefaultPool(2)
+				pool.Status.Replicas = 3
+				pool.Status.ReadyReplicas = 3
+				pool.Status.UpdatedReplicas = 1
+				pool.Status.OutdatedReplicas = 2
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					Type: poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+					RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+						MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+						MaxSurge:       pointer.P(intstr.FromInt32(1)),
+					},
+				}
+
+				oldPoolRevision := createPoolRevision(pool)
+				pool.Generation = 123
+				pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+				newPoolRevision := createPoolRevision(pool)
+
+				addPool(pool)
+				addOutdatedVMs(pool, vm, 2, newPoolRevision, oldPoolRevision)
+				surgeVM := vm.DeepCopy()
+				surgeVM.Name = fmt.Sprintf("%s-2", pool.Name)
+				surgeVM = injectPoolRevisionLabelsIntoVM(surgeVM, newPoolRevision.Name)
+				markVmAsReady(surgeVM)
+				addVM(surgeVM)
+				addVMI(createReadyVMI(surgeVM, newPoolRevision))
+				addCR(oldPoolRevision)
+				addCR(newPoolRevision)
+
+				expectControllerRevisionCreation(newPoolRevision)
+				fakeVirtClient.Fake.PrependReactor("delete", "virtualmachineinstances", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					deleted, ok := action.(k8stesting.DeleteAction)
+					Expect(ok).To(BeTrue())
+					Expect(deleted.GetName()).ToNot(Equal(surgeVM.Name))
+					return true, nil, nil
+				})
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(HaveLen(1))
+			})
+
+			It("should not restart outdated VMIs with the Opportunistic strategy", func() {
+				pool, vm := DefaultPool(2)
+				pool.Status.Replicas = 2
+				pool.Status.ReadyReplicas = 2
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					Type: poolv1.VirtualMachinePoolOpportunisticUpdateStrategyType,
+				}
+
+				oldPoolRevision := createPoolRevision(pool)
+				pool.Generation = 123
+				pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+				newPoolRevision := createPoolRevision(pool)
+
+				addPool(pool)
+				addOutdatedVMs(pool, vm, 2, newPoolRevision, oldPoolRevision)
+				addCR(oldPoolRevision)
+				addCR(newPoolRevision)
+
+				expectControllerRevisionCreation(newPoolRevision)
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					updateObj := update.GetObject().(*poolv1.VirtualMachinePool)
+					Expect(updateObj.Status.UpdatedReplicas).To(BeEquivalentTo(0))
+					Expect(updateObj.Status.OutdatedReplicas).To(BeEquivalentTo(2))
+					return true, update.GetObject(), nil
+				})
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "update", "virtualmachinepools")).To(HaveLen(1))
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+			})
+
+			It("should patch the revision of VMIs whose changes are live updated", func() {
+				pool, vm := DefaultPool(1)
+				pool.Status.Replicas = 1
+				pool.Status.ReadyReplicas = 1
+				pool.Status.OutdatedReplicas = 1
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					Type:          poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+					RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{LiveUpdate: true},
+				}
+				pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Domain.CPU = &v1.CPU{Sockets: 1}
+
+				oldPoolRevision := createPoolRevision(pool)
+				pool.Generation = 123
+				pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Domain.CPU = &v1.CPU{Sockets: 2}
+				newPoolRevision := createPoolRevision(pool)
+
+				addPool(pool)
+				addOutdatedVMs(pool, vm, 1, newPoolRevision, oldPoolRevision)
+				addCR(oldPoolRevision)
+				addCR(newPoolRevision)
+
+				expectControllerRevisionCreation(newPoolRevision)
+				fakeVirtClient.Fake.PrependReactor("patch", "virtualmachineinstances", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					patchAction, ok := action.(k8stesting.PatchAction)
+					Expect(ok).To(BeTrue())
+					Expect(string(patchAction.GetPatch())).To(ContainSubstring(newPoolRevision.Name))
+					return true, nil, nil
+				})
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "patch", "virtualmachineinstances")).To(HaveLen(1))
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+			})
+
+			It("should restart VMs requiring a restart after a live update", func() {
+				pool, vm := DefaultPool(1)
+				pool.Status.Replicas = 1
+				pool.Status.ReadyReplicas = 1
+				pool.Status.OutdatedReplicas = 1
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					Type:          poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+					RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{LiveUpdate: true},
+				}
+				poolRevision := createPoolRevision(pool)
+
+				vm.Name = fmt.Sprintf("%s-0", pool.Name)
+				vm = injectPoolRevisionLabelsIntoVM(vm, poolRevision.Name)
+				markVmAsReady(vm)
+				virtcontroller.NewVirtualMachineConditionManager().UpdateCondition(vm, &v1.VirtualMachineCondition{
+					Type:   v1.VirtualMachineRestartRequired,
+					Status: k8sv1.ConditionTrue,
+				})
+				addPool(pool)
+				addVM(vm)
+				addVMI(createReadyVMI(vm, poolRevision))
+				addCR(poolRevision)
+
+				fakeVirtClient.Fake.PrependReactor("delete", "virtualmachineinstances", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, nil, nil
+				})
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(HaveLen(1))
+			})
+		})
 	})
 })
 
@@ -971,6 +1547,12 @@ func PoolFromVM(name string, vm *v1.VirtualMachine, replicas int32) *poolv1.Virt
 	return pool
 }
 
+type fakePrometheusQuerier float64
+
+func (f fakePrometheusQuerier) Query(_ string) (float64, error) {
+	return float64(f), nil
+}
+
 func DefaultPool(replicas int32) (*poolv1.VirtualMachinePool, *v1.VirtualMachine) {
 	vmi := libvmi.New(
 		libvmi.WithNamespace(k8sv1.NamespaceDefault),
@@ -0,0 +1,239 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pool
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	k8score "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	poolIndexVariable = "$(POOL_INDEX)"
+	vmNameVariable    = "$(VM_NAME)"
+	staticIPVariable  = "$(STATIC_IP)"
+)
+
+// replicaVariables returns the replacer expanding the variables of the replica with the given index
+func replicaVariables(templating *poolv1.VirtualMachinePoolReplicaTemplating, name string, idx int) (*strings.Replacer, string, error) {
+	oldnew := []string{
+		poolIndexVariable, strconv.Itoa(idx),
+		vmNameVariable, name,
+	}
+
+	ip := ""
+	if templating.StaticIPs != nil {
+		var err error
+		ip, err = staticIPForIndex(templating.StaticIPs, idx)
+		if err != nil {
+			return nil, "", err
+		}
+		oldnew = append(oldnew, staticIPVariable, ip)
+	}
+
+	return strings.NewReplacer(oldnew...), ip, nil
+}
+
+// staticIPForIndex returns the address Start + idx of the static IPs
+func staticIPForIndex(staticIPs *poolv1.VirtualMachinePoolStaticIPs, idx int) (string, error) {
+	ip, err := netip.ParseAddr(staticIPs.Start)
+	if err != nil {
+		return "", fmt.Errorf("invalid static IP start address %q: %v", staticIPs.Start, err)
+	}
+
+	for i := 0; i < idx; i++ {
+		ip = ip.Next()
+		if !ip.IsValid() {
+			return "", fmt.Errorf("no static IP left for the replica with index %d", idx)
+		}
+	}
+
+	if staticIPs.End != "" {
+		end, err := netip.ParseAddr(staticIPs.End)
+		if err != nil {
+			return "", fmt.Errorf("invalid static IP end address %q: %v", staticIPs.End, err)
+		}
+		if ip.Compare(end) > 0 {
+			return "", fmt.Errorf("no static IP left for the replica with index %d", idx)
+		}
+	}
+
+	return ip.String(), nil
+}
+
+// applyReplicaTemplating expands the variables of the replica in the hostname and
+// in the cloud-init data of the VM spec, and points the volumes referencing the
+// generated Secrets and ConfigMaps at the ones of the replica.
+func applyReplicaTemplating(spec *virtv1.VirtualMachineSpec, templating *poolv1.VirtualMachinePoolReplicaTemplating, name string, idx int) error {
+	if templating == nil || spec.Template == nil {
+		return nil
+	}
+
+	replacer, ip, err := replicaVariables(templating, name, idx)
+	if err != nil {
+		return err
+	}
+
+	vmiSpec := &spec.Template.Spec
+	if templating.Hostname != "" {
+		vmiSpec.Hostname = replacer.Replace(templating.Hostname)
+	}
+
+	if ip != "" {
+		if spec.Template.ObjectMeta.Annotations == nil {
+			spec.Template.ObjectMeta.Annotations = map[string]string{}
+		}
+		spec.Template.ObjectMeta.Annotations[virtv1.VirtualMachinePoolStaticIPAnnotation] = ip
+	}
+
+	secrets := templateNames(templating.Secrets)
+	configMaps := templateNames(templating.ConfigMaps)
+	for i := range vmiSpec.Volumes {
+		volume := &vmiSpec.Volumes[i]
+		switch {
+		case volume.CloudInitNoCloud != nil:
+			volume.CloudInitNoCloud.UserData = replacer.Replace(volume.CloudInitNoCloud.UserData)
+			volume.CloudInitNoCloud.NetworkData = replacer.Replace(volume.CloudInitNoCloud.NetworkData)
+		case volume.CloudInitConfigDrive != nil:
+			volume.CloudInitConfigDrive.UserData = replacer.Replace(volume.CloudInitConfigDrive.UserData)
+			volume.CloudInitConfigDrive.NetworkData = replacer.Replace(volume.CloudInitConfigDrive.NetworkData)
+		case volume.Secret != nil && secrets[volume.Secret.SecretName]:
+			volume.Secret.SecretName = replicaObjectName(volume.Secret.SecretName, idx)
+		case volume.ConfigMap != nil && configMaps[volume.ConfigMap.Name]:
+			volume.ConfigMap.Name = replicaObjectName(volume.ConfigMap.Name, idx)
+		}
+	}
+
+	return nil
+}
+
+func templateNames(templates []poolv1.VirtualMachinePoolReplicaObjectTemplate) map[string]bool {
+	names := make(map[string]bool, len(templates))
+	for _, template := range templates {
+		names[template.Name] = true
+	}
+	return names
+}
+
+func replicaObjectName(name string, idx int) string {
+	return fmt.Sprintf("%s-%d", name, idx)
+}
+
+func expandData(data map[string]string, replacer *strings.Replacer) map[string]string {
+	expanded := make(map[string]string, len(data))
+	for key, value := range data {
+		expanded[key] = replacer.Replace(value)
+	}
+	return expanded
+}
+
+// createReplicaObjects creates the Secrets and ConfigMaps of the replica with
+// the given index. Objects which already exist are kept as they are.
+func (c *Controller) createReplicaObjects(pool *poolv1.VirtualMachinePool, name string, idx int) error {
+	templating := pool.Spec.ReplicaTemplating
+	if templating == nil || (len(templating.Secrets) == 0 && len(templating.ConfigMaps) == 0) {
+		return nil
+	}
+
+	replacer, _, err := replicaVariables(templating, name, idx)
+	if err != nil {
+		return err
+	}
+
+	for _, template := range templating.Secrets {
+		secret := &k8score.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            replicaObjectName(template.Name, idx),
+				Namespace:       pool.Namespace,
+				OwnerReferences: []metav1.OwnerReference{poolOwnerRef(pool)},
+			},
+			Type: k8score.SecretTypeOpaque,
+			Data: map[string][]byte{},
+		}
+		for key, value := range expandData(template.Data, replacer) {
+			secret.Data[key] = []byte(value)
+		}
+
+		_, err := c.clientset.CoreV1().Secrets(pool.Namespace).Create(context.Background(), secret, metav1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create secret %s/%s: %v", secret.Namespace, secret.Name, err)
+		}
+	}
+
+	for _, template := range templating.ConfigMaps {
+		configMap := &k8score.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            replicaObjectName(template.Name, idx),
+				Namespace:       pool.Namespace,
+				OwnerReferences: []metav1.OwnerReference{poolOwnerRef(pool)},
+			},
+			Data: expandData(template.Data, replacer),
+		}
+
+		_, err := c.clientset.CoreV1().ConfigMaps(pool.Namespace).Create(context.Background(), configMap, metav1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create configmap %s/%s: %v", configMap.Namespace, configMap.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteReplicaObjects deletes the Secrets and ConfigMaps of a removed replica
+func (c *Controller) deleteReplicaObjects(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) error {
+	templating := pool.Spec.ReplicaTemplating
+	if templating == nil || (len(templating.Secrets) == 0 && len(templating.ConfigMaps) == 0) {
+		return nil
+	}
+
+	idx, err := indexFromName(vm.Name)
+	if err != nil {
+		return err
+	}
+
+	for _, template := range templating.Secrets {
+		name := replicaObjectName(template.Name, idx)
+		err := c.clientset.CoreV1().Secrets(pool.Namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete secret %s/%s: %v", pool.Namespace, name, err)
+		}
+	}
+
+	for _, template := range templating.ConfigMaps {
+		name := replicaObjectName(template.Name, idx)
+		err := c.clientset.CoreV1().ConfigMaps(pool.Namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete configmap %s/%s: %v", pool.Namespace, name, err)
+		}
+	}
+
+	log.Log.Object(pool).V(4).Infof("Deleted the templated objects of vm %s/%s", vm.Namespace, vm.Name)
+	return nil
+}
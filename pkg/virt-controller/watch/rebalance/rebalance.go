@@ -0,0 +1,436 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rebalance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/metricsapi"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	migrationCreatedEvent = "SuccessfulCreateRebalanceMigration"
+
+	failedMigrationEvent = "FailedCreateRebalanceMigration"
+
+	defaultThresholdPercentage = 80
+
+	defaultMaxConcurrentMigrations = 1
+
+	defaultInterval = 5 * time.Minute
+
+	// the node utilization only changes with a refresh of the metrics API
+	resyncPeriod = metricsapi.Resolution
+
+	nodeIndex = "node"
+)
+
+// Controller live migrates VirtualMachineInstances off the nodes whose
+// utilization exceeds the thresholds of a MigrationRebalancePolicy
+type Controller struct {
+	Client kubecli.KubevirtClient
+
+	PolicyInformer    cache.SharedIndexInformer
+	NodeInformer      cache.SharedIndexInformer
+	VMIInformer       cache.SharedIndexInformer
+	MigrationInformer cache.SharedIndexInformer
+
+	Metrics  metricsapi.Client
+	Recorder record.EventRecorder
+
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+// nodeUtilization is the usage of a node in percent of its allocatable resources
+type nodeUtilization struct {
+	name     string
+	hostname string
+	cpu      int64
+	memory   int64
+}
+
+func (n nodeUtilization) load() int64 {
+	return max(n.cpu, n.memory)
+}
+
+var currentTime = func() time.Time {
+	return time.Now()
+}
+
+// Init initializes the rebalance controller
+func (c *Controller) Init() error {
+	c.queue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-migration-rebalance"},
+	)
+
+	_, err := c.PolicyInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handlePolicy,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handlePolicy(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.MigrationInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleMigration(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run the controller
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Log.Info("Starting migration rebalance controller.")
+	defer log.Log.Info("Shutting down migration rebalance controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		c.PolicyInformer.HasSynced,
+		c.NodeInformer.HasSynced,
+		c.VMIInformer.HasSynced,
+		c.MigrationInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (c *Controller) runWorker() {
+	for c.processWorkItem() {
+	}
+}
+
+func (c *Controller) processWorkItem() bool {
+	return watchutil.ProcessWorkItem(c.queue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("migration rebalance worker processing key [%s]", key)
+
+		storeObj, exists, err := c.PolicyInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		policy, ok := storeObj.(*migrationsv1.MigrationRebalancePolicy)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+
+		return c.sync(policy.DeepCopy())
+	})
+}
+
+func (c *Controller) handlePolicy(obj interface{}) {
+	if policy, ok := obj.(*migrationsv1.MigrationRebalancePolicy); ok {
+		key, err := controller.KeyFunc(policy)
+		if err != nil {
+			log.Log.Object(policy).Reason(err).Error("failed to extract key from policy")
+			return
+		}
+		c.queue.Add(key)
+	}
+}
+
+// handleMigration enqueues the policy which created a migration once the
+// migration finished, another migration may be started in its place
+func (c *Controller) handleMigration(obj interface{}) {
+	migration, ok := obj.(*virtv1.VirtualMachineInstanceMigration)
+	if !ok || !migration.IsFinal() {
+		return
+	}
+	if policyName, exists := migration.Labels[virtv1.RebalanceMigrationLabel]; exists {
+		c.queue.Add(policyName)
+	}
+}
+
+func (c *Controller) sync(policy *migrationsv1.MigrationRebalancePolicy) (time.Duration, error) {
+	if policy.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	hot, cold, err := c.utilization(policy)
+	if err != nil {
+		return 0, err
+	}
+
+	policyOut := policy.DeepCopy()
+	policyOut.Status.HotNodes = nil
+	for _, node := range hot {
+		policyOut.Status.HotNodes = append(policyOut.Status.HotNodes, node.name)
+	}
+	sort.Strings(policyOut.Status.HotNodes)
+
+	requeue, err := c.rebalance(policy, policyOut, hot, cold)
+	if err != nil {
+		log.Log.Object(policy).Reason(err).Error("failed to rebalance the nodes")
+		c.Recorder.Eventf(policy, k8sv1.EventTypeWarning, failedMigrationEvent, "Failed to create a migration: %v", err)
+	}
+
+	if err := c.updateStatus(policy, policyOut); err != nil {
+		return 0, err
+	}
+
+	return requeue, nil
+}
+
+// utilization returns the nodes selected by the policy above a threshold, the
+// hottest first, and the ones below both thresholds, the coldest first
+func (c *Controller) utilization(policy *migrationsv1.MigrationRebalancePolicy) (hot, cold []nodeUtilization, err error) {
+	selector := labels.Everything()
+	if policy.Spec.NodeSelector != nil {
+		selector, err = metav1.LabelSelectorAsSelector(policy.Spec.NodeSelector)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	usage, err := c.Metrics.NodeUsage()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read the node metrics: %v", err)
+	}
+
+	cpuThreshold := threshold(policy.Spec.CPUThresholdPercentage)
+	memoryThreshold := threshold(policy.Spec.MemoryThresholdPercentage)
+	for _, obj := range c.NodeInformer.GetStore().List() {
+		node := obj.(*k8sv1.Node)
+		if node.Spec.Unschedulable || node.Labels[virtv1.NodeSchedulable] != "true" || !selector.Matches(labels.Set(node.Labels)) {
+			continue
+		}
+		nodeUsage, exists := usage[node.Name]
+		if !exists {
+			// metrics show up a while after the node joined
+			continue
+		}
+		allocatableCPU := node.Status.Allocatable.Cpu().MilliValue()
+		allocatableMemory := node.Status.Allocatable.Memory().Value()
+		if allocatableCPU == 0 || allocatableMemory == 0 {
+			continue
+		}
+
+		utilization := nodeUtilization{
+			name:     node.Name,
+			hostname: node.Labels[k8sv1.LabelHostname],
+			cpu:      nodeUsage.CPU.MilliValue() * 100 / allocatableCPU,
+			memory:   nodeUsage.Memory.Value() * 100 / allocatableMemory,
+		}
+		if utilization.cpu > cpuThreshold || utilization.memory > memoryThreshold {
+			hot = append(hot, utilization)
+		} else {
+			cold = append(cold, utilization)
+		}
+	}
+
+	sort.SliceStable(hot, func(i, j int) bool { return hot[i].load() > hot[j].load() })
+	sort.SliceStable(cold, func(i, j int) bool { return cold[i].load() < cold[j].load() })
+	return hot, cold, nil
+}
+
+// rebalance starts a single migration off the hottest node to the coldest
+// node, if the rate limits of the policy allow it, and returns when the
+// nodes should be looked at again
+func (c *Controller) rebalance(policy, policyOut *migrationsv1.MigrationRebalancePolicy, hot, cold []nodeUtilization) (time.Duration, error) {
+	if len(hot) == 0 || len(cold) == 0 {
+		return resyncPeriod, nil
+	}
+
+	now := currentTime()
+	if policy.Status.LastMigrationTime != nil {
+		if remaining := policy.Status.LastMigrationTime.Add(interval(policy)).Sub(now); remaining > 0 {
+			return min(remaining, resyncPeriod), nil
+		}
+	}
+
+	inFlight, migratingVMIs := c.activeMigrations(policy)
+	if inFlight >= maxConcurrentMigrations(policy) {
+		log.Log.Object(policy).V(3).Infof("%d rebalance migrations in progress, waiting for them to finish", inFlight)
+		return resyncPeriod, nil
+	}
+
+	for _, node := range hot {
+		vmi, err := c.findVMI(policy, node.name, migratingVMIs)
+		if err != nil {
+			return resyncPeriod, err
+		}
+		if vmi == nil {
+			continue
+		}
+
+		migration, err := c.Client.VirtualMachineInstanceMigration(vmi.Namespace).Create(context.Background(), newMigration(policy, vmi, cold[0]), metav1.CreateOptions{})
+		if err != nil {
+			return resyncPeriod, err
+		}
+
+		policyOut.Status.LastMigrationTime = &metav1.Time{Time: now}
+		log.Log.Object(vmi).Infof("Migrating off node %s to node %s to rebalance the nodes", node.name, cold[0].name)
+		c.Recorder.Eventf(policy, k8sv1.EventTypeNormal, migrationCreatedEvent,
+			"Created migration %s/%s of VirtualMachineInstance %s off node %s", migration.Namespace, migration.Name, vmi.Name, node.name)
+		return min(interval(policy), resyncPeriod), nil
+	}
+
+	log.Log.Object(policy).V(3).Info("No VirtualMachineInstance can be migrated off the hot nodes")
+	return resyncPeriod, nil
+}
+
+// activeMigrations returns the number of unfinished migrations created by the
+// policy, and the keys of all the VMIs with an unfinished migration
+func (c *Controller) activeMigrations(policy *migrationsv1.MigrationRebalancePolicy) (uint32, map[string]bool) {
+	var inFlight uint32
+	migratingVMIs := map[string]bool{}
+	for _, obj := range c.MigrationInformer.GetStore().List() {
+		migration := obj.(*virtv1.VirtualMachineInstanceMigration)
+		if migration.IsFinal() {
+			continue
+		}
+		migratingVMIs[controller.NamespacedKey(migration.Namespace, migration.Spec.VMIName)] = true
+		if migration.Labels[virtv1.RebalanceMigrationLabel] == policy.Name {
+			inFlight++
+		}
+	}
+	return inFlight, migratingVMIs
+}
+
+// findVMI returns the migratable VMI on the node which is cheapest to migrate,
+// the one requesting the least memory
+func (c *Controller) findVMI(policy *migrationsv1.MigrationRebalancePolicy, nodeName string, migratingVMIs map[string]bool) (*virtv1.VirtualMachineInstance, error) {
+	objs, err := c.VMIInformer.GetIndexer().ByIndex(nodeIndex, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	var candidates []*virtv1.VirtualMachineInstance
+	for _, obj := range objs {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if !vmi.IsRunning() || vmi.DeletionTimestamp != nil || migrations.IsMigrating(vmi) ||
+			migratingVMIs[controller.NamespacedKey(vmi.Namespace, vmi.Name)] ||
+			!condManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceIsMigratable, k8sv1.ConditionTrue) ||
+			isExcluded(policy, vmi) {
+			continue
+		}
+		candidates = append(candidates, vmi)
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i].Spec.Domain.Resources.Requests.Memory(), candidates[j].Spec.Domain.Resources.Requests.Memory()
+		if cmp := a.Cmp(*b); cmp != 0 {
+			return cmp < 0
+		}
+		return controller.NamespacedKey(candidates[i].Namespace, candidates[i].Name) < controller.NamespacedKey(candidates[j].Namespace, candidates[j].Name)
+	})
+	return candidates[0], nil
+}
+
+func (c *Controller) updateStatus(policy, policyOut *migrationsv1.MigrationRebalancePolicy) error {
+	if equality.Semantic.DeepEqual(policy.Status, policyOut.Status) {
+		return nil
+	}
+
+	_, err := c.Client.MigrationRebalancePolicy().UpdateStatus(context.Background(), policyOut, metav1.UpdateOptions{})
+	return err
+}
+
+func newMigration(policy *migrationsv1.MigrationRebalancePolicy, vmi *virtv1.VirtualMachineInstance, target nodeUtilization) *virtv1.VirtualMachineInstanceMigration {
+	migration := &virtv1.VirtualMachineInstanceMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "kubevirt-rebalance-",
+			Namespace:    vmi.Namespace,
+			Labels: map[string]string{
+				virtv1.RebalanceMigrationLabel: policy.Name,
+			},
+		},
+		Spec: virtv1.VirtualMachineInstanceMigrationSpec{
+			VMIName: vmi.Name,
+		},
+	}
+	if target.hostname != "" {
+		migration.Spec.AddedNodeSelector = map[string]string{k8sv1.LabelHostname: target.hostname}
+	}
+	return migration
+}
+
+func isExcluded(policy *migrationsv1.MigrationRebalancePolicy, vmi *virtv1.VirtualMachineInstance) bool {
+	for _, key := range policy.Spec.ExcludeLabels {
+		if _, exists := vmi.Labels[key]; exists {
+			return true
+		}
+	}
+	return false
+}
+
+func threshold(percentage *uint32) int64 {
+	if percentage != nil {
+		return int64(*percentage)
+	}
+	return defaultThresholdPercentage
+}
+
+func maxConcurrentMigrations(policy *migrationsv1.MigrationRebalancePolicy) uint32 {
+	if policy.Spec.MaxConcurrentMigrations != nil {
+		return *policy.Spec.MaxConcurrentMigrations
+	}
+	return defaultMaxConcurrentMigrations
+}
+
+func interval(policy *migrationsv1.MigrationRebalancePolicy) time.Duration {
+	if policy.Spec.Interval != nil {
+		return policy.Spec.Interval.Duration
+	}
+	return defaultInterval
+}
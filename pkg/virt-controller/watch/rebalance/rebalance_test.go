@@ -0,0 +1,356 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rebalance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	kvcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/util/metricsapi"
+)
+
+var _ = Describe("Migration rebalance controller", func() {
+	const policyName = "test-policy"
+
+	var (
+		now = time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC)
+
+		controller        *Controller
+		policy            *migrationsv1.MigrationRebalancePolicy
+		nodeInformer      cache.SharedIndexInformer
+		vmiInformer       cache.SharedIndexInformer
+		migrationInformer cache.SharedIndexInformer
+		metrics           *metricsapi.FakeClient
+		recorder          *record.FakeRecorder
+		kubevirtClient    *kubevirtfake.Clientset
+		createdMigrations []*virtv1.VirtualMachineInstanceMigration
+	)
+
+	// addNode adds a schedulable node with 100 CPUs and 100Gi of memory, so
+	// that the usage is its utilization in percent
+	addNode := func(name string, cpuPercent, memoryPercent int) *k8sv1.Node {
+		node := &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					virtv1.NodeSchedulable: "true",
+					k8sv1.LabelHostname:    name,
+				},
+			},
+			Status: k8sv1.NodeStatus{
+				Allocatable: k8sv1.ResourceList{
+					k8sv1.ResourceCPU:    resource.MustParse("100"),
+					k8sv1.ResourceMemory: resource.MustParse("100Gi"),
+				},
+			},
+		}
+		Expect(nodeInformer.GetStore().Add(node)).To(Succeed())
+		metrics.Nodes[name] = metricsapi.Usage{
+			CPU:    resource.MustParse(fmt.Sprint(cpuPercent)),
+			Memory: resource.MustParse(fmt.Sprintf("%dGi", memoryPercent)),
+		}
+		return node
+	}
+
+	// addVMI adds a running, live migratable VMI on the node
+	addVMI := func(name, nodeName, memory string, opts ...libvmi.Option) *virtv1.VirtualMachineInstance {
+		opts = append([]libvmi.Option{
+			libvmi.WithName(name),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithMemoryRequest(memory),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithPhase(virtv1.Running),
+				libvmistatus.WithNodeName(nodeName),
+				libvmistatus.WithCondition(virtv1.VirtualMachineInstanceCondition{
+					Type:   virtv1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionTrue,
+				}),
+			)),
+		}, opts...)
+		vmi := libvmi.New(opts...)
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+		return vmi
+	}
+
+	addRunningMigration := func(name, vmiName string, labels map[string]string) {
+		Expect(migrationInformer.GetStore().Add(&virtv1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault, Labels: labels},
+			Spec:       virtv1.VirtualMachineInstanceMigrationSpec{VMIName: vmiName},
+			Status:     virtv1.VirtualMachineInstanceMigrationStatus{Phase: virtv1.MigrationRunning},
+		})).To(Succeed())
+	}
+
+	// sync stores the policy as it is and syncs it
+	sync := func() time.Duration {
+		_, err := kubevirtClient.MigrationsV1alpha1().MigrationRebalancePolicies().Create(context.Background(), policy, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		requeue, err := controller.sync(policy)
+		Expect(err).ToNot(HaveOccurred())
+		return requeue
+	}
+
+	syncedStatus := func() migrationsv1.MigrationRebalancePolicyStatus {
+		policy, err := kubevirtClient.MigrationsV1alpha1().MigrationRebalancePolicies().Get(context.Background(), policyName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return policy.Status
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineInstanceMigration(metav1.NamespaceDefault).
+			Return(kubevirtClient.KubevirtV1().VirtualMachineInstanceMigrations(metav1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().MigrationRebalancePolicy().
+			Return(kubevirtClient.MigrationsV1alpha1().MigrationRebalancePolicies()).AnyTimes()
+
+		createdMigrations = nil
+		kubevirtClient.PrependReactor("create", "virtualmachineinstancemigrations", func(action testing.Action) (bool, runtime.Object, error) {
+			migration := action.(testing.CreateAction).GetObject().(*virtv1.VirtualMachineInstanceMigration)
+			migration.Name = migration.GenerateName + "abcde"
+			createdMigrations = append(createdMigrations, migration)
+			return true, migration, nil
+		})
+
+		policyInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.MigrationRebalancePolicy{})
+		nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
+		vmiInformer, _ = testutils.NewFakeInformerWithIndexersFor(&virtv1.VirtualMachineInstance{}, kvcontroller.GetVMIInformerIndexers())
+		migrationInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstanceMigration{})
+		metrics = metricsapi.NewFakeClient()
+		recorder = record.NewFakeRecorder(100)
+
+		controller = &Controller{
+			Client:            virtClient,
+			PolicyInformer:    policyInformer,
+			NodeInformer:      nodeInformer,
+			VMIInformer:       vmiInformer,
+			MigrationInformer: migrationInformer,
+			Metrics:           metrics,
+			Recorder:          recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+
+		policy = &migrationsv1.MigrationRebalancePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: policyName},
+		}
+
+		originalCurrentTime := currentTime
+		currentTime = func() time.Time {
+			return now
+		}
+		DeferCleanup(func() {
+			currentTime = originalCurrentTime
+		})
+	})
+
+	Context("node utilization", func() {
+		BeforeEach(func() {
+			addNode("cold", 10, 10)
+		})
+
+		DescribeTable("should report a node as hot", func(cpuPercent, memoryPercent int, spec migrationsv1.MigrationRebalancePolicySpec) {
+			addNode("node01", cpuPercent, memoryPercent)
+			policy.Spec = spec
+
+			sync()
+			Expect(syncedStatus().HotNodes).To(ConsistOf("node01"))
+		},
+			Entry("above the default CPU threshold", 81, 10, migrationsv1.MigrationRebalancePolicySpec{}),
+			Entry("above the default memory threshold", 10, 81, migrationsv1.MigrationRebalancePolicySpec{}),
+			Entry("above the CPU threshold of the policy", 51, 10, migrationsv1.MigrationRebalancePolicySpec{
+				CPUThresholdPercentage: pointer.P(uint32(50)),
+			}),
+			Entry("above the memory threshold of the policy", 10, 51, migrationsv1.MigrationRebalancePolicySpec{
+				MemoryThresholdPercentage: pointer.P(uint32(50)),
+			}),
+		)
+
+		It("should not report a node at the thresholds as hot", func() {
+			addNode("node01", 80, 80)
+
+			sync()
+			Expect(syncedStatus().HotNodes).To(BeEmpty())
+		})
+
+		DescribeTable("should ignore a hot node", func(modifyNode func(*k8sv1.Node)) {
+			node := addNode("node01", 90, 10)
+			modifyNode(node)
+
+			sync()
+			Expect(syncedStatus().HotNodes).To(BeEmpty())
+		},
+			Entry("which is unschedulable", func(node *k8sv1.Node) {
+				node.Spec.Unschedulable = true
+			}),
+			Entry("which is not schedulable for VMIs", func(node *k8sv1.Node) {
+				node.Labels[virtv1.NodeSchedulable] = "false"
+			}),
+			Entry("without metrics", func(node *k8sv1.Node) {
+				delete(metrics.Nodes, node.Name)
+			}),
+		)
+
+		It("should only look at the selected nodes", func() {
+			addNode("node01", 90, 10)
+			policy.Spec.NodeSelector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{
+					Key:      k8sv1.LabelHostname,
+					Operator: metav1.LabelSelectorOpNotIn,
+					Values:   []string{"node01"},
+				}},
+			}
+
+			sync()
+			Expect(syncedStatus().HotNodes).To(BeEmpty())
+		})
+	})
+
+	Context("with a hot node", func() {
+		BeforeEach(func() {
+			addNode("hot", 90, 10)
+			addNode("warm", 50, 50)
+			addNode("cold", 20, 20)
+		})
+
+		It("should migrate the VMI requesting the least memory to the coldest node", func() {
+			addVMI("large", "hot", "4Gi")
+			addVMI("small", "hot", "1Gi")
+			addVMI("other", "warm", "512Mi")
+
+			Expect(sync()).To(Equal(metricsapi.Resolution))
+
+			Expect(createdMigrations).To(HaveLen(1))
+			Expect(createdMigrations[0].Spec.VMIName).To(Equal("small"))
+			Expect(createdMigrations[0].Spec.AddedNodeSelector).To(HaveKeyWithValue(k8sv1.LabelHostname, "cold"))
+			Expect(createdMigrations[0].Labels).To(HaveKeyWithValue(virtv1.RebalanceMigrationLabel, policyName))
+
+			status := syncedStatus()
+			Expect(status.HotNodes).To(ConsistOf("hot"))
+			Expect(status.LastMigrationTime.Time).To(BeTemporally("==", now))
+			testutils.ExpectEvent(recorder, migrationCreatedEvent)
+		})
+
+		It("should move on to the next hot node when no VMI can be migrated off the hottest", func() {
+			addNode("hotter", 95, 10)
+			addVMI("pinned", "hotter", "1Gi", libvmi.WithLabel("pinned", ""))
+			addVMI("testvmi", "hot", "1Gi")
+			policy.Spec.ExcludeLabels = []string{"pinned"}
+
+			sync()
+			Expect(createdMigrations).To(HaveLen(1))
+			Expect(createdMigrations[0].Spec.VMIName).To(Equal("testvmi"))
+		})
+
+		DescribeTable("should not migrate", func(modifyVMI func(*virtv1.VirtualMachineInstance)) {
+			modifyVMI(addVMI("testvmi", "hot", "1Gi"))
+			policy.Spec.ExcludeLabels = []string{"pinned"}
+
+			sync()
+			Expect(createdMigrations).To(BeEmpty())
+			Expect(syncedStatus().HotNodes).To(ConsistOf("hot"))
+		},
+			Entry("a VMI with an excluded label", func(vmi *virtv1.VirtualMachineInstance) {
+				vmi.Labels = map[string]string{"pinned": ""}
+			}),
+			Entry("a VMI which is not live migratable", func(vmi *virtv1.VirtualMachineInstance) {
+				vmi.Status.Conditions[0].Status = k8sv1.ConditionFalse
+			}),
+			Entry("a VMI which is not running", func(vmi *virtv1.VirtualMachineInstance) {
+				vmi.Status.Phase = virtv1.Scheduled
+			}),
+			Entry("a VMI with a pending migration", func(vmi *virtv1.VirtualMachineInstance) {
+				addRunningMigration("pending", vmi.Name, nil)
+			}),
+		)
+
+		It("should wait for the interval since the last migration", func() {
+			addVMI("testvmi", "hot", "1Gi")
+			policy.Status.LastMigrationTime = pointer.P(metav1.NewTime(now.Add(-270 * time.Second)))
+
+			Expect(sync()).To(Equal(30 * time.Second))
+			Expect(createdMigrations).To(BeEmpty())
+		})
+
+		It("should not exceed the concurrent migrations", func() {
+			addVMI("testvmi", "hot", "1Gi")
+			addRunningMigration("running", "other", map[string]string{virtv1.RebalanceMigrationLabel: policyName})
+
+			sync()
+			Expect(createdMigrations).To(BeEmpty())
+
+			policy.Spec.MaxConcurrentMigrations = pointer.P(uint32(2))
+			_, err := controller.sync(policy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(createdMigrations).To(HaveLen(1))
+		})
+	})
+
+	It("should not migrate without a node below the thresholds", func() {
+		addNode("hot", 90, 10)
+		addNode("other", 10, 85)
+		addVMI("testvmi", "hot", "1Gi")
+
+		sync()
+		Expect(createdMigrations).To(BeEmpty())
+		Expect(syncedStatus().HotNodes).To(ConsistOf("hot", "other"))
+	})
+
+	It("should enqueue the policy once one of its migrations finished", func() {
+		migration := &virtv1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "testmigration",
+				Namespace: metav1.NamespaceDefault,
+				Labels:    map[string]string{virtv1.RebalanceMigrationLabel: policyName},
+			},
+			Status: virtv1.VirtualMachineInstanceMigrationStatus{Phase: virtv1.MigrationRunning},
+		}
+		controller.handleMigration(migration)
+		Expect(controller.queue.Len()).To(BeZero())
+
+		migration.Status.Phase = virtv1.MigrationSucceeded
+		controller.handleMigration(migration)
+		Expect(controller.queue.Len()).To(Equal(1))
+		key, _ := controller.queue.Get()
+		Expect(key).To(Equal(policyName))
+	})
+})
@@ -0,0 +1,228 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package resourcequota
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	quotav1 "kubevirt.io/api/quota/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+	"kubevirt.io/kubevirt/pkg/vmquota"
+)
+
+// resyncPeriod is how often the usage is recomputed without any VM change,
+// it releases what was charged on admission for the requests which failed afterwards
+const resyncPeriod = 5 * time.Minute
+
+type instancetypeFinder interface {
+	Find(vm *virtv1.VirtualMachine) (*instancetypev1beta1.VirtualMachineInstancetypeSpec, error)
+}
+
+// Controller computes the usage reported in the status of the VirtualMachineResourceQuotas
+// from the VirtualMachines of their namespace
+type Controller struct {
+	Client kubecli.KubevirtClient
+
+	QuotaInformer cache.SharedIndexInformer
+	VMInformer    cache.SharedIndexInformer
+
+	InstancetypeFinder instancetypeFinder
+
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+// Init initializes the resource quota controller
+func (c *Controller) Init() error {
+	c.queue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-vm-resource-quota"},
+	)
+
+	_, err := c.QuotaInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleQuota,
+			UpdateFunc: c.updateQuota,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.VMInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleVM,
+			UpdateFunc: c.updateVM,
+			DeleteFunc: c.handleVM,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run the controller
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Log.Info("Starting vm resource quota controller.")
+	defer log.Log.Info("Shutting down vm resource quota controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		c.QuotaInformer.HasSynced,
+		c.VMInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (c *Controller) runWorker() {
+	for c.processWorkItem() {
+	}
+}
+
+func (c *Controller) processWorkItem() bool {
+	return watchutil.ProcessWorkItem(c.queue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vm resource quota worker processing key [%s]", key)
+
+		storeObj, exists, err := c.QuotaInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		quota, ok := storeObj.(*quotav1.VirtualMachineResourceQuota)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+
+		return c.sync(quota.DeepCopy())
+	})
+}
+
+func (c *Controller) handleQuota(obj interface{}) {
+	if quota, ok := obj.(*quotav1.VirtualMachineResourceQuota); ok {
+		key, err := controller.KeyFunc(quota)
+		if err != nil {
+			log.Log.Object(quota).Reason(err).Error("failed to extract key from resource quota")
+			return
+		}
+		c.queue.Add(key)
+	}
+}
+
+// updateQuota only reacts to the changes of the limits, the usage changes are mostly the
+// charges of virt-api which are recomputed on the VM changes following them
+func (c *Controller) updateQuota(oldObj, newObj interface{}) {
+	oldQuota, oldOk := oldObj.(*quotav1.VirtualMachineResourceQuota)
+	newQuota, newOk := newObj.(*quotav1.VirtualMachineResourceQuota)
+	if oldOk && newOk && equality.Semantic.DeepEqual(oldQuota.Spec, newQuota.Spec) &&
+		equality.Semantic.DeepEqual(newQuota.Spec.Hard, newQuota.Status.Hard) {
+		return
+	}
+	c.handleQuota(newObj)
+}
+
+func (c *Controller) updateVM(oldObj, newObj interface{}) {
+	oldVM, oldOk := oldObj.(*virtv1.VirtualMachine)
+	newVM, newOk := newObj.(*virtv1.VirtualMachine)
+	if oldOk && newOk && oldVM.Generation == newVM.Generation {
+		return
+	}
+	c.handleVM(newObj)
+}
+
+func (c *Controller) handleVM(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	vm, ok := obj.(*virtv1.VirtualMachine)
+	if !ok {
+		return
+	}
+
+	quotas, err := c.QuotaInformer.GetIndexer().ByIndex(cache.NamespaceIndex, vm.Namespace)
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Error("failed to list resource quotas")
+		return
+	}
+
+	for _, quota := range quotas {
+		c.handleQuota(quota)
+	}
+}
+
+func (c *Controller) sync(quota *quotav1.VirtualMachineResourceQuota) (time.Duration, error) {
+	vms, err := c.VMInformer.GetIndexer().ByIndex(cache.NamespaceIndex, quota.Namespace)
+	if err != nil {
+		return 0, err
+	}
+
+	used := vmquota.Mask(nil, quota.Spec.Hard)
+	for _, obj := range vms {
+		vm := obj.(*virtv1.VirtualMachine)
+		instancetypeSpec, err := c.InstancetypeFinder.Find(vm)
+		if err != nil {
+			// Charge the template, the admission of the VM already made sure it fits
+			log.Log.Object(vm).Reason(err).Warning("failed to find the instancetype of the VM, charging its template")
+		}
+		used = vmquota.Add(used, vmquota.Mask(vmquota.Usage(vm, instancetypeSpec), quota.Spec.Hard))
+	}
+
+	status := quotav1.VirtualMachineResourceQuotaStatus{
+		Hard: quota.Spec.Hard,
+		Used: used,
+	}
+	if equality.Semantic.DeepEqual(quota.Status, status) {
+		return resyncPeriod, nil
+	}
+
+	quota.Status = status
+	if _, err := c.Client.VirtualMachineResourceQuota(quota.Namespace).UpdateStatus(context.Background(), quota, metav1.UpdateOptions{}); err != nil {
+		return 0, err
+	}
+	return resyncPeriod, nil
+}
@@ -0,0 +1,173 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package resourcequota
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	quotav1 "kubevirt.io/api/quota/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+type fakeInstancetypeFinder map[string]*instancetypev1beta1.VirtualMachineInstancetypeSpec
+
+func (f fakeInstancetypeFinder) Find(vm *virtv1.VirtualMachine) (*instancetypev1beta1.VirtualMachineInstancetypeSpec, error) {
+	if vm.Spec.Instancetype == nil {
+		return nil, nil
+	}
+	if spec, ok := f[vm.Spec.Instancetype.Name]; ok {
+		return spec, nil
+	}
+	return nil, fmt.Errorf("instancetype %s not found", vm.Spec.Instancetype.Name)
+}
+
+var _ = Describe("VM resource quota controller", func() {
+	const quotaName = "test-quota"
+
+	var (
+		controller     *Controller
+		quotaInformer  cache.SharedIndexInformer
+		vmInformer     cache.SharedIndexInformer
+		kubevirtClient *kubevirtfake.Clientset
+	)
+
+	addQuota := func(hard k8sv1.ResourceList) *quotav1.VirtualMachineResourceQuota {
+		quota := &quotav1.VirtualMachineResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      quotaName,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: quotav1.VirtualMachineResourceQuotaSpec{Hard: hard},
+		}
+		Expect(quotaInformer.GetStore().Add(quota)).To(Succeed())
+		_, err := kubevirtClient.QuotaV1alpha1().VirtualMachineResourceQuotas(metav1.NamespaceDefault).Create(context.Background(), quota, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return quota
+	}
+
+	addVM := func(vm *virtv1.VirtualMachine) {
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+	}
+
+	getStatus := func() quotav1.VirtualMachineResourceQuotaStatus {
+		quota, err := kubevirtClient.QuotaV1alpha1().VirtualMachineResourceQuotas(metav1.NamespaceDefault).Get(context.Background(), quotaName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return quota.Status
+	}
+
+	newVM := func(name string, opts ...libvmi.Option) *virtv1.VirtualMachine {
+		opts = append(opts, libvmi.WithName(name), libvmi.WithNamespace(metav1.NamespaceDefault))
+		return libvmi.NewVirtualMachine(libvmi.New(opts...))
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineResourceQuota(metav1.NamespaceDefault).
+			Return(kubevirtClient.QuotaV1alpha1().VirtualMachineResourceQuotas(metav1.NamespaceDefault)).AnyTimes()
+
+		quotaInformer, _ = testutils.NewFakeInformerFor(&quotav1.VirtualMachineResourceQuota{})
+		vmInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
+
+		controller = &Controller{
+			Client:        virtClient,
+			QuotaInformer: quotaInformer,
+			VMInformer:    vmInformer,
+			InstancetypeFinder: fakeInstancetypeFinder{
+				"large": {
+					CPU:    instancetypev1beta1.CPUInstancetype{Guest: 8},
+					Memory: instancetypev1beta1.MemoryInstancetype{Guest: resource.MustParse("16Gi")},
+				},
+			},
+		}
+		Expect(controller.Init()).To(Succeed())
+	})
+
+	It("should report the usage of the VMs of the namespace", func() {
+		hard := k8sv1.ResourceList{
+			quotav1.ResourceGuestCPU:        resource.MustParse("32"),
+			quotav1.ResourceGuestMemory:     resource.MustParse("64Gi"),
+			quotav1.ResourceVirtualMachines: resource.MustParse("5"),
+		}
+		quota := addQuota(hard)
+		addVM(newVM("small", libvmi.WithCPUCount(2, 1, 1), libvmi.WithGuestMemory("2Gi")))
+		vm := newVM("large")
+		vm.Spec.Instancetype = &virtv1.InstancetypeMatcher{Name: "large"}
+		addVM(vm)
+		other := newVM("other", libvmi.WithCPUCount(4, 1, 1), libvmi.WithGuestMemory("4Gi"))
+		other.Namespace = "other"
+		addVM(other)
+
+		requeueAfter, err := controller.sync(quota)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeueAfter).To(Equal(resyncPeriod))
+
+		status := getStatus()
+		Expect(status.Hard).To(Equal(hard))
+		Expect(status.Used).To(HaveLen(3))
+		expectQuantity(status.Used, quotav1.ResourceGuestCPU, "10")
+		expectQuantity(status.Used, quotav1.ResourceGuestMemory, "18Gi")
+		expectQuantity(status.Used, quotav1.ResourceVirtualMachines, "2")
+	})
+
+	It("should only report the usage of the limited resources", func() {
+		quota := addQuota(k8sv1.ResourceList{
+			quotav1.ResourceVirtualMachines: resource.MustParse("5"),
+		})
+		addVM(newVM("small", libvmi.WithCPUCount(2, 1, 1), libvmi.WithGuestMemory("2Gi")))
+
+		_, err := controller.sync(quota)
+		Expect(err).ToNot(HaveOccurred())
+
+		status := getStatus()
+		Expect(status.Used).To(HaveLen(1))
+		expectQuantity(status.Used, quotav1.ResourceVirtualMachines, "1")
+	})
+
+	It("should enqueue the quotas of the namespace of a changed VM", func() {
+		addQuota(k8sv1.ResourceList{
+			quotav1.ResourceVirtualMachines: resource.MustParse("5"),
+		})
+		controller.handleVM(newVM("small"))
+		Expect(controller.queue.Len()).To(Equal(1))
+	})
+})
+
+func expectQuantity(list k8sv1.ResourceList, name k8sv1.ResourceName, expected string) {
+	quantity := list[name]
+	ExpectWithOffset(1, quantity.Cmp(resource.MustParse(expected))).To(BeZero(), "%s is %s", name, quantity.String())
+}
@@ -0,0 +1,362 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8score "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	// HibernatedReason is added in an event when the memory of a VM was saved and its VMI stopped
+	HibernatedReason = "Hibernated"
+	// ResumedReason is added in an event when a hibernated VM is running again from its saved memory
+	ResumedReason = "Resumed"
+	// HibernationFailedReason is added in an event when a VM could not be hibernated or resumed
+	HibernationFailedReason = "HibernationFailed"
+	// HibernationDiscardedReason is added in an event when a hibernated VM is stopped and its saved memory deleted
+	HibernationDiscardedReason = "HibernationDiscarded"
+
+	hibernationErrorReason = "HibernationError"
+
+	// hibernationPauseTimeout is how long the VMI may take to be paused before the hibernation fails
+	hibernationPauseTimeout = time.Minute
+)
+
+// syncHibernation drives the hibernation of a VM: the paused VMI dumps its memory to the hibernation PVC
+// and is stopped, and is started again from the PVC once the VM is resumed. handled is true while the
+// hibernation decides whether the VMI runs, so the run strategy must not be acted upon.
+func (c *Controller) syncHibernation(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (_ *virtv1.VirtualMachine, handled bool, err error) {
+	hibernation := vm.Status.Hibernation
+	if hibernation == nil {
+		return vm, false, nil
+	}
+
+	switch hibernation.Phase {
+	case virtv1.HibernationHibernating:
+		return c.syncHibernating(vm, vmi)
+	case virtv1.HibernationHibernated:
+		return c.syncHibernated(vm, vmi)
+	case virtv1.HibernationResuming:
+		return c.syncResuming(vm, vmi)
+	}
+	return vm, false, nil
+}
+
+func (c *Controller) syncHibernating(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachine, bool, error) {
+	hibernation := vm.Status.Hibernation
+	if vmi == nil || vmi.IsFinal() || vmi.DeletionTimestamp != nil || isHibernationStopRequested(vm) {
+		return c.failHibernation(vm, "the VMI stopped before its memory was saved")
+	}
+
+	paused := controller.NewVirtualMachineInstanceConditionManager().HasCondition(vmi, virtv1.VirtualMachineInstancePaused)
+	request := vm.Status.MemoryDumpRequest
+	if request == nil || request.ClaimName != hibernation.ClaimName {
+		if !paused {
+			if waited := time.Since(hibernation.LastTransitionTime.Time); waited < hibernationPauseTimeout {
+				vmKey, err := controller.KeyFunc(vm)
+				if err != nil {
+					return vm, true, err
+				}
+				c.Queue.AddAfter(vmKey, hibernationPauseTimeout-waited)
+				return vm, true, nil
+			}
+			return c.failHibernation(vm, "the VMI was not paused")
+		}
+		if request != nil && request.Phase != virtv1.MemoryDumpCompleted && request.Phase != virtv1.MemoryDumpFailed {
+			log.Log.Object(vm).V(3).Infof("Waiting for the memory dump to %s to finish before hibernating", request.ClaimName)
+			return vm, true, nil
+		}
+		if err := c.ensureHibernationClaim(vm, vmi); err != nil {
+			return vm, true, err
+		}
+		vm, err := c.patchHibernation(vm,
+			patch.WithTest("/status/hibernation", hibernation),
+			patch.WithAdd("/status/memoryDumpRequest", &virtv1.VirtualMachineMemoryDumpRequest{
+				ClaimName: hibernation.ClaimName,
+				Phase:     virtv1.MemoryDumpAssociating,
+				Format:    virtv1.MemoryDumpFormatState,
+			}),
+		)
+		return vm, true, err
+	}
+
+	switch request.Phase {
+	case virtv1.MemoryDumpFailed:
+		return c.failHibernation(vm, fmt.Sprintf("the memory dump failed: %s", request.Message))
+	case virtv1.MemoryDumpCompleted:
+		if !paused {
+			// the guest changed after its memory was saved
+			return c.failHibernation(vm, "the VMI was unpaused")
+		}
+	default:
+		return vm, true, nil
+	}
+
+	vm, err := c.stopVMI(vm, vmi)
+	if err != nil {
+		return vm, true, err
+	}
+	hibernated := hibernation.DeepCopy()
+	hibernated.Phase = virtv1.HibernationHibernated
+	hibernated.LastTransitionTime = metav1.Now()
+	removedRequest := request.DeepCopy()
+	removedRequest.Remove = true
+	vm, err = c.patchHibernation(vm,
+		patch.WithTest("/status/hibernation", hibernation),
+		patch.WithReplace("/status/hibernation", hibernated),
+		patch.WithReplace("/status/memoryDumpRequest", removedRequest),
+	)
+	if err != nil {
+		return vm, true, err
+	}
+	c.recorder.Eventf(vm, k8score.EventTypeNormal, HibernatedReason, "Saved the memory of the virtual machine to %s", hibernation.ClaimName)
+	return vm, true, nil
+}
+
+func (c *Controller) syncHibernated(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachine, bool, error) {
+	if vmi != nil {
+		vm, err := c.stopVMI(vm, vmi)
+		return vm, true, err
+	}
+	if isHibernationStopRequested(vm) {
+		vm, err := c.discardHibernation(vm)
+		return vm, false, err
+	}
+	return vm, true, nil
+}
+
+func (c *Controller) syncResuming(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachine, bool, error) {
+	hibernation := vm.Status.Hibernation
+	if vmi == nil {
+		if isHibernationStopRequested(vm) {
+			vm, err := c.discardHibernation(vm)
+			return vm, false, err
+		}
+		vm, err := c.startVMI(vm)
+		return vm, true, err
+	}
+	if !hasHibernationVolume(vmi) {
+		// the VMI which was hibernated is still terminating
+		vm, err := c.stopVMI(vm, vmi)
+		return vm, true, err
+	}
+	if hasStopRequestForVMI(vm, vmi) {
+		return vm, false, nil
+	}
+
+	if vmi.IsFinal() {
+		vm, err := c.stopVMI(vm, vmi)
+		if err != nil {
+			return vm, true, err
+		}
+		hibernated := hibernation.DeepCopy()
+		hibernated.Phase = virtv1.HibernationHibernated
+		hibernated.LastTransitionTime = metav1.Now()
+		vm, err = c.patchHibernation(vm,
+			patch.WithTest("/status/hibernation", hibernation),
+			patch.WithReplace("/status/hibernation", hibernated),
+		)
+		if err != nil {
+			return vm, true, err
+		}
+		c.recorder.Eventf(vm, k8score.EventTypeWarning, HibernationFailedReason, "Failed to resume the virtual machine from %s", hibernation.ClaimName)
+		return vm, true, nil
+	}
+	if !vmi.IsRunning() {
+		return vm, true, nil
+	}
+
+	// The memory state is consumed once the VMI runs, the PVC is kept until the VMI is gone
+	if err := c.patchHibernationClaimOwner(vm, metav1.NewControllerRef(vmi, virtv1.VirtualMachineInstanceGroupVersionKind)); err != nil {
+		return vm, true, err
+	}
+	vm, err := c.patchHibernation(vm,
+		patch.WithTest("/status/hibernation", hibernation),
+		patch.WithRemove("/status/hibernation"),
+	)
+	if err != nil {
+		return vm, true, err
+	}
+	c.recorder.Eventf(vm, k8score.EventTypeNormal, ResumedReason, "Resumed the virtual machine from %s", hibernation.ClaimName)
+	return vm, false, nil
+}
+
+// failHibernation gives up on hibernating the VM, the VMI is left paused if it is still running
+func (c *Controller) failHibernation(vm *virtv1.VirtualMachine, reason string) (*virtv1.VirtualMachine, bool, error) {
+	hibernation := vm.Status.Hibernation
+	opts := []patch.PatchOption{
+		patch.WithTest("/status/hibernation", hibernation),
+		patch.WithRemove("/status/hibernation"),
+	}
+	if request := vm.Status.MemoryDumpRequest; request != nil && request.ClaimName == hibernation.ClaimName && !request.Remove {
+		removedRequest := request.DeepCopy()
+		removedRequest.Remove = true
+		opts = append(opts, patch.WithReplace("/status/memoryDumpRequest", removedRequest))
+	}
+	vm, err := c.patchHibernation(vm, opts...)
+	if err != nil {
+		return vm, true, err
+	}
+	if err := c.deleteHibernationClaim(vm, hibernation.ClaimName); err != nil {
+		return vm, false, err
+	}
+	log.Log.Object(vm).Warningf("Failed to hibernate the VM: %s", reason)
+	c.recorder.Eventf(vm, k8score.EventTypeWarning, HibernationFailedReason, "Failed to hibernate the virtual machine, %s", reason)
+	return vm, false, nil
+}
+
+// discardHibernation deletes the saved memory of a hibernated VM which is stopped
+func (c *Controller) discardHibernation(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine, error) {
+	hibernation := vm.Status.Hibernation
+	vm, err := c.patchHibernation(vm,
+		patch.WithTest("/status/hibernation", hibernation),
+		patch.WithRemove("/status/hibernation"),
+	)
+	if err != nil {
+		return vm, err
+	}
+	if err := c.deleteHibernationClaim(vm, hibernation.ClaimName); err != nil {
+		return vm, err
+	}
+	c.recorder.Eventf(vm, k8score.EventTypeNormal, HibernationDiscardedReason, "Stopped the hibernated virtual machine, deleted its saved memory %s", hibernation.ClaimName)
+	return vm, nil
+}
+
+// isHibernationStopRequested tells whether the VM was stopped, which discards its saved memory
+func isHibernationStopRequested(vm *virtv1.VirtualMachine) bool {
+	if runStrategy, err := vm.RunStrategy(); err == nil && runStrategy == virtv1.RunStrategyHalted {
+		return true
+	}
+	return len(vm.Status.StateChangeRequests) > 0 && vm.Status.StateChangeRequests[0].Action == virtv1.StopRequest
+}
+
+func hasHibernationVolume(vmi *virtv1.VirtualMachineInstance) bool {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == virtv1.VirtualMachineHibernationVolumeName {
+			return true
+		}
+	}
+	return false
+}
+
+// applyHibernationState restores the VMI of a resuming VM from the memory saved to its hibernation PVC
+func applyHibernationState(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	hibernation := vm.Status.Hibernation
+	if hibernation == nil || hibernation.Phase != virtv1.HibernationResuming {
+		return
+	}
+
+	vmi.Spec.Volumes = append(vmi.Spec.Volumes, virtv1.Volume{
+		Name: virtv1.VirtualMachineHibernationVolumeName,
+		VolumeSource: virtv1.VolumeSource{
+			MemoryDump: &virtv1.MemoryDumpVolumeSource{
+				PersistentVolumeClaimVolumeSource: virtv1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: k8score.PersistentVolumeClaimVolumeSource{
+						ClaimName: hibernation.ClaimName,
+					},
+				},
+				Format: virtv1.MemoryDumpFormatState,
+			},
+		},
+	})
+}
+
+func (c *Controller) ensureHibernationClaim(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	hibernation := vm.Status.Hibernation
+	pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(vm.Namespace, hibernation.ClaimName, c.pvcStore)
+	if err != nil || pvc != nil {
+		return err
+	}
+
+	size, err := storagetypes.GetSizeIncludingDefaultFSOverhead(util.CalcExpectedMemoryDumpSize(vmi))
+	if err != nil {
+		return err
+	}
+	pvc = &k8score.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      hibernation.ClaimName,
+			Namespace: vm.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind),
+			},
+		},
+		Spec: k8score.PersistentVolumeClaimSpec{
+			AccessModes:      []k8score.PersistentVolumeAccessMode{k8score.ReadWriteOnce},
+			StorageClassName: hibernation.StorageClassName,
+			VolumeMode:       pointer.P(k8score.PersistentVolumeFilesystem),
+			Resources: k8score.VolumeResourceRequirements{
+				Requests: k8score.ResourceList{
+					k8score.ResourceStorage: *size,
+				},
+			},
+		},
+	}
+	_, err = c.clientset.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+func (c *Controller) deleteHibernationClaim(vm *virtv1.VirtualMachine, claimName string) error {
+	err := c.clientset.CoreV1().PersistentVolumeClaims(vm.Namespace).Delete(context.Background(), claimName, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (c *Controller) patchHibernationClaimOwner(vm *virtv1.VirtualMachine, owner *metav1.OwnerReference) error {
+	patchBytes, err := patch.New(patch.WithAdd("/metadata/ownerReferences", []metav1.OwnerReference{*owner})).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.CoreV1().PersistentVolumeClaims(vm.Namespace).Patch(context.Background(), vm.Status.Hibernation.ClaimName, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (c *Controller) patchHibernation(vm *virtv1.VirtualMachine, opts ...patch.PatchOption) (*virtv1.VirtualMachine, error) {
+	patchBytes, err := patch.New(opts...).GeneratePayload()
+	if err != nil {
+		return vm, err
+	}
+	patchedVM, err := c.clientset.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return vm, err
+	}
+	vm.Status = patchedVM.Status
+	return vm, nil
+}
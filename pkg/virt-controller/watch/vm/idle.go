@@ -0,0 +1,64 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	k8score "k8s.io/api/core/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	// IdleStopReason is added in an event when a VM is stopped by the idle policy of its VMI
+	IdleStopReason = "IdleStop"
+
+	idlePolicyErrorReason = "IdlePolicyError"
+)
+
+// syncIdlePolicy stops the VM once virt-handler reported its VMI as idle, if the idle policy of the VMI
+// asks for it. The VM is stopped like the stop subresource would, so it stays stopped until it is started again.
+func (c *Controller) syncIdlePolicy(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachine, error) {
+	if vmi == nil || vmi.DeletionTimestamp != nil || vmi.Spec.IdlePolicy == nil || vmi.Spec.IdlePolicy.Action != virtv1.IdleActionStop {
+		return vm, nil
+	}
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	if !condManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceIdle, k8score.ConditionTrue) {
+		return vm, nil
+	}
+
+	runStrategy, err := vm.RunStrategy()
+	if err != nil {
+		return vm, err
+	}
+	switch runStrategy {
+	case virtv1.RunStrategyAlways, virtv1.RunStrategyRerunOnFailure:
+		err = c.patchRunStrategy(vm, virtv1.RunStrategyHalted)
+	case virtv1.RunStrategyManual:
+		vm, err = c.stopVMI(vm, vmi)
+	default:
+		return vm, nil
+	}
+	if err != nil {
+		return vm, err
+	}
+	log.Log.Object(vm).Infof("Stopped the idle VMI with runStrategy: %s", runStrategy)
+	c.recorder.Eventf(vm, k8score.EventTypeNormal, IdleStopReason, "Stopped the virtual machine, its VMI was idle")
+	return vm, nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	instancetypeapi "kubevirt.io/api/instancetype"
+	"kubevirt.io/api/instancetype/v1beta1"
+)
+
+// AddInstancetypeEventHandlers enqueues the VMs referencing an instancetype or preference when its spec changes or
+// when a new generation of it is approved, so the pending updates in their status and the roll out stay current.
+func (c *Controller) AddInstancetypeEventHandlers(
+	instancetypeInformer, clusterInstancetypeInformer, preferenceInformer, clusterPreferenceInformer cache.SharedIndexInformer,
+) error {
+	for _, informer := range []cache.SharedIndexInformer{
+		instancetypeInformer, clusterInstancetypeInformer, preferenceInformer, clusterPreferenceInformer,
+	} {
+		if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: c.updateInstancetypeOrPreference,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Controller) updateInstancetypeOrPreference(old, cur interface{}) {
+	oldObj, ok := old.(metav1.Object)
+	if !ok {
+		return
+	}
+	curObj, ok := cur.(metav1.Object)
+	if !ok {
+		return
+	}
+	if oldObj.GetGeneration() == curObj.GetGeneration() &&
+		oldObj.GetAnnotations()[instancetypeapi.ApprovedGenerationAnnotation] ==
+			curObj.GetAnnotations()[instancetypeapi.ApprovedGenerationAnnotation] {
+		return
+	}
+
+	// Cluster wide resources have no namespace and are listed across all of them
+	objs, err := c.vmIndexer.ByIndex(cache.NamespaceIndex, curObj.GetNamespace())
+	if curObj.GetNamespace() == "" {
+		objs, err = c.vmIndexer.List(), nil
+	}
+	if err != nil {
+		return
+	}
+	for _, obj := range objs {
+		vm := obj.(*virtv1.VirtualMachine)
+		if referencesInstancetypeOrPreference(vm, cur) {
+			c.enqueueVm(vm)
+		}
+	}
+}
+
+func referencesInstancetypeOrPreference(vm *virtv1.VirtualMachine, obj interface{}) bool {
+	switch o := obj.(type) {
+	case *v1beta1.VirtualMachineInstancetype:
+		return vm.Spec.Instancetype != nil && vm.Spec.Instancetype.Name == o.Name &&
+			isKind(vm.Spec.Instancetype.Kind, false, instancetypeapi.SingularResourceName, instancetypeapi.PluralResourceName)
+	case *v1beta1.VirtualMachineClusterInstancetype:
+		return vm.Spec.Instancetype != nil && vm.Spec.Instancetype.Name == o.Name &&
+			isKind(vm.Spec.Instancetype.Kind, true, instancetypeapi.ClusterSingularResourceName, instancetypeapi.ClusterPluralResourceName)
+	case *v1beta1.VirtualMachinePreference:
+		return vm.Spec.Preference != nil && vm.Spec.Preference.Name == o.Name &&
+			isKind(vm.Spec.Preference.Kind, false, instancetypeapi.SingularPreferenceResourceName, instancetypeapi.PluralPreferenceResourceName)
+	case *v1beta1.VirtualMachineClusterPreference:
+		return vm.Spec.Preference != nil && vm.Spec.Preference.Name == o.Name &&
+			isKind(vm.Spec.Preference.Kind, true, instancetypeapi.ClusterSingularPreferenceResourceName, instancetypeapi.ClusterPluralPreferenceResourceName)
+	default:
+		return false
+	}
+}
+
+func isKind(kind string, isDefault bool, names ...string) bool {
+	if kind == "" {
+		return isDefault
+	}
+	for _, name := range names {
+		if strings.EqualFold(kind, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/instancetype/v1beta1"
+)
+
+var _ = Describe("Instancetype and preference references", func() {
+	const name = "medium"
+
+	DescribeTable("should match", func(instancetype *v1.InstancetypeMatcher, preference *v1.PreferenceMatcher, obj interface{}, expected bool) {
+		vm := &v1.VirtualMachine{
+			Spec: v1.VirtualMachineSpec{
+				Instancetype: instancetype,
+				Preference:   preference,
+			},
+		}
+		Expect(referencesInstancetypeOrPreference(vm, obj)).To(Equal(expected))
+	},
+		Entry("a cluster instancetype referenced without kind",
+			&v1.InstancetypeMatcher{Name: name}, nil,
+			&v1beta1.VirtualMachineClusterInstancetype{ObjectMeta: metav1.ObjectMeta{Name: name}}, true),
+		Entry("a namespaced instancetype referenced with its kind",
+			&v1.InstancetypeMatcher{Name: name, Kind: "VirtualMachineInstancetype"}, nil,
+			&v1beta1.VirtualMachineInstancetype{ObjectMeta: metav1.ObjectMeta{Name: name}}, true),
+		Entry("not a namespaced instancetype referenced without kind",
+			&v1.InstancetypeMatcher{Name: name}, nil,
+			&v1beta1.VirtualMachineInstancetype{ObjectMeta: metav1.ObjectMeta{Name: name}}, false),
+		Entry("not an instancetype with another name",
+			&v1.InstancetypeMatcher{Name: name}, nil,
+			&v1beta1.VirtualMachineClusterInstancetype{ObjectMeta: metav1.ObjectMeta{Name: "large"}}, false),
+		Entry("a cluster preference referenced without kind",
+			nil, &v1.PreferenceMatcher{Name: name},
+			&v1beta1.VirtualMachineClusterPreference{ObjectMeta: metav1.ObjectMeta{Name: name}}, true),
+		Entry("a namespaced preference referenced with its kind",
+			nil, &v1.PreferenceMatcher{Name: name, Kind: "virtualmachinepreference"},
+			&v1beta1.VirtualMachinePreference{ObjectMeta: metav1.ObjectMeta{Name: name}}, true),
+		Entry("not a preference without preference matcher",
+			&v1.InstancetypeMatcher{Name: name}, nil,
+			&v1beta1.VirtualMachineClusterPreference{ObjectMeta: metav1.ObjectMeta{Name: name}}, false),
+	)
+})
@@ -0,0 +1,203 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	"context"
+	"slices"
+	"time"
+	_ "time/tzdata" // the controller image does not ship a zoneinfo database
+
+	"github.com/robfig/cron/v3"
+	k8score "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	// PowerScheduleStartReason is added in an event when a VM is started according to its power schedule
+	PowerScheduleStartReason = "PowerScheduleStart"
+	// PowerScheduleStopReason is added in an event when a VM is stopped according to its power schedule
+	PowerScheduleStopReason = "PowerScheduleStop"
+	// PowerScheduleSkippedReason is added in an event when a scheduled start falls on an exception date
+	PowerScheduleSkippedReason = "PowerScheduleSkipped"
+
+	powerScheduleErrorReason = "PowerScheduleError"
+)
+
+var currentTime = func() time.Time {
+	return time.Now()
+}
+
+// syncPowerSchedule takes the action of the power schedule of the VM which is due, and requeues the
+// VM for the next scheduled action. Only scheduled transitions are acted upon, so a VM started or
+// stopped manually in between stays in that state until the next scheduled action.
+func (c *Controller) syncPowerSchedule(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachine, error) {
+	schedule := vm.Spec.PowerSchedule
+	if schedule == nil {
+		vm.Status.PowerSchedule = nil
+		return vm, nil
+	}
+	if vm.Status.PowerSchedule == nil {
+		vm.Status.PowerSchedule = &virtv1.VirtualMachinePowerScheduleStatus{}
+	}
+	status := vm.Status.PowerSchedule
+	now := currentTime()
+
+	location, err := powerScheduleLocation(schedule)
+	if err != nil {
+		return vm, err
+	}
+
+	if status.NextActionTime != nil && !now.Before(status.NextActionTime.Time) {
+		vm, err = c.takePowerScheduleAction(vm, vmi, status.NextAction, status.NextActionTime.In(location))
+		if err != nil {
+			return vm, err
+		}
+		status = vm.Status.PowerSchedule
+	}
+
+	action, next, err := nextPowerScheduleAction(schedule, now.In(location))
+	if err != nil {
+		return vm, err
+	}
+	if next.IsZero() {
+		status.NextAction = ""
+		status.NextActionTime = nil
+		return vm, nil
+	}
+	status.NextAction = action
+	status.NextActionTime = &metav1.Time{Time: next}
+
+	vmKey, err := controller.KeyFunc(vm)
+	if err != nil {
+		return vm, err
+	}
+	c.Queue.AddAfter(vmKey, next.Sub(now))
+	return vm, nil
+}
+
+func (c *Controller) takePowerScheduleAction(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, action virtv1.StateChangeRequestAction, scheduled time.Time) (*virtv1.VirtualMachine, error) {
+	date := scheduled.Format(time.DateOnly)
+	if action == virtv1.StartRequest && slices.Contains(vm.Spec.PowerSchedule.Exceptions, date) {
+		c.recorder.Eventf(vm, k8score.EventTypeNormal, PowerScheduleSkippedReason, "Skipped the scheduled start, %s is an exception of the power schedule", date)
+		return vm, nil
+	}
+
+	runStrategy, err := vm.RunStrategy()
+	if err != nil {
+		return vm, err
+	}
+
+	switch action {
+	case virtv1.StartRequest:
+		switch runStrategy {
+		case virtv1.RunStrategyHalted:
+			err = c.patchRunStrategy(vm, virtv1.RunStrategyAlways)
+		case virtv1.RunStrategyManual:
+			if vmi == nil && !hasStartRequest(vm) {
+				err = c.addStartRequest(vm)
+			}
+		}
+		if err != nil {
+			return vm, err
+		}
+		c.recorder.Eventf(vm, k8score.EventTypeNormal, PowerScheduleStartReason, "Started the virtual machine according to its power schedule")
+	case virtv1.StopRequest:
+		switch runStrategy {
+		case virtv1.RunStrategyAlways, virtv1.RunStrategyRerunOnFailure:
+			err = c.patchRunStrategy(vm, virtv1.RunStrategyHalted)
+		case virtv1.RunStrategyManual:
+			vm, err = c.stopVMI(vm, vmi)
+		}
+		if err != nil {
+			return vm, err
+		}
+		c.recorder.Eventf(vm, k8score.EventTypeNormal, PowerScheduleStopReason, "Stopped the virtual machine according to its power schedule")
+	default:
+		return vm, nil
+	}
+	log.Log.Object(vm).Infof("Took the scheduled %s action of the power schedule with runStrategy: %s", action, runStrategy)
+
+	vm.Status.PowerSchedule.LastAction = action
+	vm.Status.PowerSchedule.LastActionTime = &metav1.Time{Time: scheduled}
+	return vm, nil
+}
+
+// patchRunStrategy switches the VM between running and halted, like the start and stop subresources do
+func (c *Controller) patchRunStrategy(vm *virtv1.VirtualMachine, runStrategy virtv1.VirtualMachineRunStrategy) error {
+	patchSet := patch.New()
+	if vm.Spec.RunStrategy != nil {
+		patchSet.AddOption(
+			patch.WithTest("/spec/runStrategy", vm.Spec.RunStrategy),
+			patch.WithReplace("/spec/runStrategy", runStrategy),
+		)
+	} else {
+		patchSet.AddOption(
+			patch.WithTest("/spec/running", vm.Spec.Running),
+			patch.WithReplace("/spec/running", runStrategy != virtv1.RunStrategyHalted),
+		)
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+// nextPowerScheduleAction returns the first action of the power schedule after the given time,
+// or a zero time if there is none
+func nextPowerScheduleAction(schedule *virtv1.VirtualMachinePowerSchedule, now time.Time) (virtv1.StateChangeRequestAction, time.Time, error) {
+	var (
+		action virtv1.StateChangeRequestAction
+		next   time.Time
+	)
+	for _, entry := range []struct {
+		action     virtv1.StateChangeRequestAction
+		expression string
+	}{
+		{virtv1.StartRequest, schedule.Start},
+		{virtv1.StopRequest, schedule.Stop},
+	} {
+		if entry.expression == "" {
+			continue
+		}
+		cronSchedule, err := cron.ParseStandard(entry.expression)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		scheduled := cronSchedule.Next(now)
+		if !scheduled.IsZero() && (next.IsZero() || scheduled.Before(next)) {
+			action, next = entry.action, scheduled
+		}
+	}
+	return action, next, nil
+}
+
+func powerScheduleLocation(schedule *virtv1.VirtualMachinePowerSchedule) (*time.Location, error) {
+	if schedule.TimeZone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(schedule.TimeZone)
+}
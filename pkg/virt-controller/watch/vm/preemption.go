@@ -0,0 +1,383 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	k8score "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+const (
+	// PreemptedReason is added in an event when a VM is stopped or hibernated for a VM of a higher priority
+	PreemptedReason = "Preempted"
+	// PreemptedVMReason is added in an event when a VM stopped or hibernated a VM of a lower priority
+	PreemptedVMReason = "PreemptedVM"
+
+	preemptionErrorReason = "PreemptionError"
+
+	// preemptionDelay is how long the VMI has to be unschedulable before another VM is preempted for it
+	preemptionDelay = 30 * time.Second
+	// preemptionStopGracePeriod is how long a stopped VM counts as preempted while its VMI is still running,
+	// the VMI is only deleted some time after the stop request
+	preemptionStopGracePeriod = time.Minute
+)
+
+// syncPreemption clears the preemption of a VM once it is running again, and stops or hibernates a VM of a
+// lower priority if the VMI of the VM can not be scheduled. Only one VM is preempted for a VMI, the pod of the
+// preempted VM is deleted and the scheduler places the VMI on its node.
+func (c *Controller) syncPreemption(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachine, error) {
+	if vm.Status.Preemption != nil && !isPreempted(vm, vmi) {
+		patchedVM, err := c.patchPreemption(vm,
+			patch.WithTest("/status/preemption", vm.Status.Preemption),
+			patch.WithRemove("/status/preemption"),
+		)
+		if err != nil {
+			return vm, err
+		}
+		vm.Status = patchedVM.Status
+	}
+
+	if vmi == nil || vmi.Spec.PriorityClassName == "" || vmi.DeletionTimestamp != nil {
+		return vm, nil
+	}
+	condition := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, virtv1.VirtualMachineInstanceConditionType(k8score.PodScheduled))
+	if condition == nil || condition.Status != k8score.ConditionFalse || condition.Reason != k8score.PodReasonUnschedulable {
+		return vm, nil
+	}
+
+	vmKey, err := controller.KeyFunc(vm)
+	if err != nil {
+		return vm, err
+	}
+	if c.hasPreempted(vmKey) {
+		return vm, nil
+	}
+	if wait := preemptionDelay - time.Since(condition.LastTransitionTime.Time); wait > 0 {
+		c.Queue.AddAfter(vmKey, wait)
+		return vm, nil
+	}
+
+	pendingPod, err := controller.CurrentVMIPod(vmi, c.podIndexer)
+	if err != nil || pendingPod == nil {
+		return vm, err
+	}
+	priority, err := c.getPriority(vmi.Spec.PriorityClassName)
+	if err != nil {
+		return vm, err
+	}
+	victim, workloadClass, err := c.findPreemptionVictim(vmi, pendingPod, priority)
+	if err != nil || victim == nil {
+		return vm, err
+	}
+
+	switch workloadClass.PreemptionAction {
+	case virtv1.WorkloadPreemptionHibernate:
+		err = c.clientset.VirtualMachine(victim.Namespace).Hibernate(context.Background(), victim.Name, &virtv1.HibernateOptions{})
+	case virtv1.WorkloadPreemptionStop:
+		err = c.clientset.VirtualMachine(victim.Namespace).Stop(context.Background(), victim.Name, &virtv1.StopOptions{})
+	}
+	if err != nil {
+		return vm, fmt.Errorf("failed to preempt VM %s/%s: %v", victim.Namespace, victim.Name, err)
+	}
+	preemption := &virtv1.VirtualMachinePreemption{
+		Action:      workloadClass.PreemptionAction,
+		PreemptedBy: vmKey,
+		Time:        metav1.Now(),
+	}
+	if _, err := c.patchPreemption(victim,
+		patch.WithTest("/status/preemption", nil),
+		patch.WithAdd("/status/preemption", preemption),
+	); err != nil {
+		return vm, err
+	}
+
+	log.Log.Object(vm).Infof("Preempted VM %s/%s with action %s", victim.Namespace, victim.Name, workloadClass.PreemptionAction)
+	c.recorder.Eventf(victim, k8score.EventTypeWarning, PreemptedReason, "Preempted with action %s for virtual machine %s", workloadClass.PreemptionAction, vmKey)
+	c.recorder.Eventf(vm, k8score.EventTypeNormal, PreemptedVMReason, "Preempted virtual machine %s/%s with action %s, the VMI could not be scheduled", victim.Namespace, victim.Name, workloadClass.PreemptionAction)
+	return vm, nil
+}
+
+// isPreempted tells whether a preempted VM is still stopped or hibernated
+func isPreempted(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	switch vm.Status.Preemption.Action {
+	case virtv1.WorkloadPreemptionHibernate:
+		return vm.Status.Hibernation != nil
+	case virtv1.WorkloadPreemptionStop:
+		if time.Since(vm.Status.Preemption.Time.Time) < preemptionStopGracePeriod {
+			return true
+		}
+		return vmi == nil || vmi.DeletionTimestamp != nil || !vmi.IsRunning()
+	}
+	return false
+}
+
+// hasPreempted tells whether a VM was already preempted for the given VM
+func (c *Controller) hasPreempted(vmKey string) bool {
+	for _, obj := range c.vmIndexer.List() {
+		preemption := obj.(*virtv1.VirtualMachine).Status.Preemption
+		if preemption != nil && preemption.PreemptedBy == vmKey {
+			return true
+		}
+	}
+	return false
+}
+
+// findPreemptionVictim looks for a running VM of a lower priority which may be preempted, and whose node the
+// pending pod would fit on once the pod of the VM is deleted. The VMs of the lowest priority are preempted
+// first, and among them the most recently started ones.
+func (c *Controller) findPreemptionVictim(pendingVMI *virtv1.VirtualMachineInstance, pendingPod *k8score.Pod, priority int32) (*virtv1.VirtualMachine, *virtv1.WorkloadClass, error) {
+	type candidate struct {
+		vm            *virtv1.VirtualMachine
+		vmi           *virtv1.VirtualMachineInstance
+		workloadClass *virtv1.WorkloadClass
+		priority      int32
+	}
+
+	var candidates []candidate
+	for _, obj := range c.vmiIndexer.List() {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.Spec.WorkloadClass == "" || !vmi.IsRunning() || vmi.DeletionTimestamp != nil || migrations.IsMigrating(vmi) {
+			continue
+		}
+		workloadClass := c.clusterConfig.GetWorkloadClass(vmi.Spec.WorkloadClass)
+		if workloadClass == nil || workloadClass.PreemptionAction == "" {
+			continue
+		}
+		victimPriority, err := c.getPriority(vmi.Spec.PriorityClassName)
+		if err != nil {
+			return nil, nil, err
+		}
+		if victimPriority >= priority {
+			continue
+		}
+		owner := metav1.GetControllerOf(vmi)
+		if owner == nil || owner.Kind != virtv1.VirtualMachineGroupVersionKind.Kind {
+			continue
+		}
+		vmObj, exists, err := c.vmIndexer.GetByKey(controller.NamespacedKey(vmi.Namespace, owner.Name))
+		if err != nil {
+			return nil, nil, err
+		}
+		if !exists {
+			continue
+		}
+		vm := vmObj.(*virtv1.VirtualMachine)
+		if vm.UID != owner.UID || vm.Status.Hibernation != nil || vm.Status.Preemption != nil {
+			continue
+		}
+		fits, err := c.fitsAfterPreemption(pendingPod, vmi)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !fits {
+			continue
+		}
+		candidates = append(candidates, candidate{
+			vm:            vm,
+			vmi:           vmi,
+			workloadClass: workloadClass,
+			priority:      victimPriority,
+		})
+	}
+	if len(candidates) == 0 {
+		log.Log.Object(pendingVMI).V(3).Info("No VM of a lower priority can be preempted to make room for the VMI")
+		return nil, nil, nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.priority != b.priority {
+			return a.priority < b.priority
+		}
+		return a.vmi.CreationTimestamp.After(b.vmi.CreationTimestamp.Time)
+	})
+	victim := candidates[0]
+	return victim.vm, victim.workloadClass, nil
+}
+
+// fitsAfterPreemption tells whether the pending pod could be scheduled on the node of the given VMI once its
+// pod is deleted. The node has to match the node selector, the required node affinity and the taints the
+// pending pod tolerates, and the pod of the VMI has to release at least the resources the pending pod requests.
+func (c *Controller) fitsAfterPreemption(pendingPod *k8score.Pod, vmi *virtv1.VirtualMachineInstance) (bool, error) {
+	nodeObj, exists, err := c.nodeStore.GetByKey(vmi.Status.NodeName)
+	if err != nil || !exists {
+		return false, err
+	}
+	node := nodeObj.(*k8score.Node)
+	if !matchesNode(pendingPod, node) || !toleratesNode(pendingPod, node) {
+		return false, nil
+	}
+
+	pod, err := controller.CurrentVMIPod(vmi, c.podIndexer)
+	if err != nil || pod == nil {
+		return false, err
+	}
+	released := podRequests(pod)
+	for name, requested := range podRequests(pendingPod) {
+		if requested.IsZero() {
+			continue
+		}
+		if quantity, exists := released[name]; !exists || quantity.Cmp(requested) < 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// matchesNode tells whether a node matches the node selector and the required node affinity of a pod
+func matchesNode(pod *k8score.Pod, node *k8score.Node) bool {
+	if !labels.SelectorFromSet(pod.Spec.NodeSelector).Matches(labels.Set(node.Labels)) {
+		return false
+	}
+	affinity := pod.Spec.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return true
+	}
+	// the terms are ORed, the requirements of a term are ANDed
+	for _, term := range affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		if matchesNodeSelectorTerm(term, node) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesNodeSelectorTerm(term k8score.NodeSelectorTerm, node *k8score.Node) bool {
+	if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+		return false
+	}
+	for _, requirement := range term.MatchExpressions {
+		if !matchesNodeSelectorRequirement(requirement, labels.Set(node.Labels)) {
+			return false
+		}
+	}
+	for _, requirement := range term.MatchFields {
+		if requirement.Key != "metadata.name" || !matchesNodeSelectorRequirement(requirement, labels.Set{"metadata.name": node.Name}) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesNodeSelectorRequirement(requirement k8score.NodeSelectorRequirement, set labels.Set) bool {
+	var operator selection.Operator
+	switch requirement.Operator {
+	case k8score.NodeSelectorOpIn:
+		operator = selection.In
+	case k8score.NodeSelectorOpNotIn:
+		operator = selection.NotIn
+	case k8score.NodeSelectorOpExists:
+		operator = selection.Exists
+	case k8score.NodeSelectorOpDoesNotExist:
+		operator = selection.DoesNotExist
+	case k8score.NodeSelectorOpGt:
+		operator = selection.GreaterThan
+	case k8score.NodeSelectorOpLt:
+		operator = selection.LessThan
+	default:
+		return false
+	}
+	selector, err := labels.NewRequirement(requirement.Key, operator, requirement.Values)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(set)
+}
+
+// toleratesNode tells whether a pod tolerates the NoSchedule and NoExecute taints of a node
+func toleratesNode(pod *k8score.Pod, node *k8score.Node) bool {
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect == k8score.TaintEffectPreferNoSchedule {
+			continue
+		}
+		tolerated := false
+		for j := range pod.Spec.Tolerations {
+			if pod.Spec.Tolerations[j].ToleratesTaint(taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			return false
+		}
+	}
+	return true
+}
+
+// podRequests returns the resources requested by a pod, the init containers run one after another before the
+// containers, so only the largest request of an init container counts
+func podRequests(pod *k8score.Pod) k8score.ResourceList {
+	requests := k8score.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		addResources(requests, container.Resources.Requests)
+	}
+	for _, container := range pod.Spec.InitContainers {
+		for name, quantity := range container.Resources.Requests {
+			if current, exists := requests[name]; !exists || quantity.Cmp(current) > 0 {
+				requests[name] = quantity.DeepCopy()
+			}
+		}
+	}
+	addResources(requests, pod.Spec.Overhead)
+	return requests
+}
+
+func addResources(resources, added k8score.ResourceList) {
+	for name, quantity := range added {
+		current := resources[name]
+		current.Add(quantity)
+		resources[name] = current
+	}
+}
+
+// getPriority returns the value of a PriorityClass, VMIs without a PriorityClass have a priority of 0
+func (c *Controller) getPriority(priorityClassName string) (int32, error) {
+	if priorityClassName == "" {
+		return 0, nil
+	}
+	obj, exists, err := c.priorityClassStore.GetByKey(priorityClassName)
+	if err != nil || !exists {
+		return 0, err
+	}
+	return obj.(*schedulingv1.PriorityClass).Value, nil
+}
+
+// patchPreemption returns the patched VM, the given VM is left untouched as it may be the cached VM of a victim
+func (c *Controller) patchPreemption(vm *virtv1.VirtualMachine, opts ...patch.PatchOption) (*virtv1.VirtualMachine, error) {
+	patchBytes, err := patch.New(opts...).GeneratePayload()
+	if err != nil {
+		return nil, err
+	}
+	return c.clientset.VirtualMachine(vm.Namespace).PatchStatus(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+}
@@ -0,0 +1,213 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	k8score "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+const stagedFieldsPrefix = "spec.template.spec"
+
+func isChangeApplyStaged(vm *virtv1.VirtualMachine) bool {
+	return vm.Spec.ChangeApplyStrategy != nil && *vm.Spec.ChangeApplyStrategy == virtv1.ChangeApplyStrategyStaged
+}
+
+// isApplyingStagedChanges returns whether the staged changes were requested to be applied to the running VMI
+func isApplyingStagedChanges(vm *virtv1.VirtualMachine) bool {
+	if vm.Status.StagedChanges == nil {
+		return false
+	}
+	method := vm.Status.StagedChanges.ApplyMethod
+	return method == virtv1.ApplyChangesMethodHotplug || method == virtv1.ApplyChangesMethodLiveMigrate
+}
+
+// stageChanges records the template fields changed since the VMI was started in the VM status.
+// It returns whether any of the changes requires a restart.
+func (c *Controller) stageChanges(lastSeenVMSpec *virtv1.VirtualMachineSpec, vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	if lastSeenVMSpec == nil || vmi == nil {
+		vm.Status.StagedChanges = nil
+		return false
+	}
+
+	fields, err := c.changedTemplateFields(lastSeenVMSpec, vm, vmi)
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Error("Failed to compute the staged changes")
+		return false
+	}
+	if len(fields) == 0 {
+		vm.Status.StagedChanges = nil
+		return false
+	}
+
+	restartRequired := c.hasNonLiveUpdatableChanges(lastSeenVMSpec, vm, vmi)
+	applyMethod := virtv1.ApplyChangesMethod("")
+	if vm.Status.StagedChanges != nil && !restartRequired {
+		applyMethod = vm.Status.StagedChanges.ApplyMethod
+	}
+
+	vm.Status.StagedChanges = &virtv1.VirtualMachineStagedChanges{
+		Fields:          fields,
+		RestartRequired: restartRequired,
+		ApplyMethod:     applyMethod,
+	}
+	return restartRequired
+}
+
+// changedTemplateFields returns the paths of the template fields which differ between the
+// spec the VMI was started with and the current VM spec, ignoring the changes already applied to the VMI
+func (c *Controller) changedTemplateFields(lastSeenVMSpec *virtv1.VirtualMachineSpec, vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) ([]string, error) {
+	currentVM := vm.DeepCopy()
+	if err := c.instancetypeController.ApplyToVM(currentVM); err != nil {
+		return nil, err
+	}
+	lastSeenVM := &virtv1.VirtualMachine{
+		ObjectMeta: currentVM.DeepCopy().ObjectMeta,
+		Spec:       *lastSeenVMSpec.DeepCopy(),
+	}
+	if err := c.instancetypeController.ApplyToVM(lastSeenVM); err != nil {
+		return nil, err
+	}
+	if lastSeenVM.Spec.Template == nil || currentVM.Spec.Template == nil {
+		return nil, nil
+	}
+
+	// Changes which were hotplugged are part of the VMI spec and are not staged anymore
+	lastSeenSpec := &lastSeenVM.Spec.Template.Spec
+	currentSpec := &currentVM.Spec.Template.Spec
+	if currentSpec.Domain.CPU != nil && vmi.Spec.Domain.CPU != nil && lastSeenSpec.Domain.CPU != nil &&
+		currentSpec.Domain.CPU.Sockets == vmi.Spec.Domain.CPU.Sockets {
+		lastSeenSpec.Domain.CPU.Sockets = currentSpec.Domain.CPU.Sockets
+	}
+	if currentSpec.Domain.Memory != nil && vmi.Spec.Domain.Memory != nil &&
+		equality.Semantic.DeepEqual(currentSpec.Domain.Memory.Guest, vmi.Spec.Domain.Memory.Guest) {
+		if lastSeenSpec.Domain.Memory == nil {
+			lastSeenSpec.Domain.Memory = &virtv1.Memory{}
+		}
+		lastSeenSpec.Domain.Memory.Guest = currentSpec.Domain.Memory.Guest
+	}
+	if equality.Semantic.DeepEqual(currentSpec.NodeSelector, vmi.Spec.NodeSelector) {
+		lastSeenSpec.NodeSelector = currentSpec.NodeSelector
+	}
+	if equality.Semantic.DeepEqual(currentSpec.Affinity, vmi.Spec.Affinity) {
+		lastSeenSpec.Affinity = currentSpec.Affinity
+	}
+	if equality.Semantic.DeepEqual(currentSpec.Tolerations, vmi.Spec.Tolerations) {
+		lastSeenSpec.Tolerations = currentSpec.Tolerations
+	}
+	if equality.Semantic.DeepEqual(currentSpec.Volumes, vmi.Spec.Volumes) &&
+		equality.Semantic.DeepEqual(currentSpec.Domain.Devices.Disks, vmi.Spec.Domain.Devices.Disks) &&
+		equality.Semantic.DeepEqual(currentSpec.Domain.Devices.Filesystems, vmi.Spec.Domain.Devices.Filesystems) {
+		lastSeenSpec.Volumes = currentSpec.Volumes
+		lastSeenSpec.Domain.Devices.Disks = currentSpec.Domain.Devices.Disks
+		lastSeenSpec.Domain.Devices.Filesystems = currentSpec.Domain.Devices.Filesystems
+	}
+	if equality.Semantic.DeepEqual(currentSpec.Domain.Devices.GPUs, vmi.Spec.Domain.Devices.GPUs) {
+		lastSeenSpec.Domain.Devices.GPUs = currentSpec.Domain.Devices.GPUs
+	}
+
+	if equality.Semantic.DeepEqual(lastSeenSpec, currentSpec) {
+		return nil, nil
+	}
+
+	oldFields, err := toUnstructured(lastSeenSpec)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := toUnstructured(currentSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []string
+	diffFields(stagedFieldsPrefix, oldFields, newFields, &fields)
+	sort.Strings(fields)
+	return fields, nil
+}
+
+func toUnstructured(spec *virtv1.VirtualMachineInstanceSpec) (map[string]interface{}, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// diffFields appends the paths of the values which differ between the two objects
+func diffFields(path string, oldObj, newObj interface{}, fields *[]string) {
+	oldMap, oldIsMap := oldObj.(map[string]interface{})
+	newMap, newIsMap := newObj.(map[string]interface{})
+	if !oldIsMap || !newIsMap {
+		if !reflect.DeepEqual(oldObj, newObj) {
+			*fields = append(*fields, path)
+		}
+		return
+	}
+
+	for key, oldValue := range oldMap {
+		diffFields(path+"."+key, oldValue, newMap[key], fields)
+	}
+	for key, newValue := range newMap {
+		if _, exists := oldMap[key]; !exists {
+			diffFields(path+"."+key, nil, newValue, fields)
+		}
+	}
+}
+
+// applyStagedChanges starts applying the staged changes with the method requested through the applychanges subresource.
+// The changes themselves are pushed to the VMI by the regular live update flow.
+func (c *Controller) applyStagedChanges(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vm.Status.StagedChanges == nil {
+		return nil
+	}
+
+	method := vm.Status.StagedChanges.ApplyMethod
+	if method == virtv1.ApplyChangesMethodLiveMigrate && !migrations.IsMigrating(vmi) {
+		migration := &virtv1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "kubevirt-apply-changes-",
+			},
+			Spec: virtv1.VirtualMachineInstanceMigrationSpec{
+				VMIName: vmi.Name,
+			},
+		}
+		_, err := c.clientset.VirtualMachineInstanceMigration(vmi.Namespace).Create(context.Background(), migration, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to create the migration of vmi %s/%s: %v", vmi.Namespace, vmi.Name, err)
+		}
+	}
+
+	vm.Status.StagedChanges.ApplyMethod = ""
+	c.recorder.Eventf(vm, k8score.EventTypeNormal, StagedChangesAppliedReason, "Applied the staged changes with method %s", method)
+	return nil
+}
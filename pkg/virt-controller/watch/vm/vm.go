@@ -31,6 +31,7 @@ import (
 	"strings"
 	"time"
 
+	"kubevirt.io/kubevirt/pkg/instancetype/liveresize"
 	"kubevirt.io/kubevirt/pkg/instancetype/revision"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
@@ -111,19 +112,24 @@ const (
 	// SourcePVCNotAvailabe is added in an event when the source PVC of a valid
 	// clone Datavolume doesn't exist
 	SourcePVCNotAvailabe = "SourcePVCNotAvailabe"
+	// StagedChangesAppliedReason is added in an event when the staged changes
+	// of a VM are applied to its running VMI
+	StagedChangesAppliedReason = "StagedChangesApplied"
 )
 
 const (
-	hotplugVolumeErrorReason     = "HotPlugVolumeError"
-	hotplugCPUErrorReason        = "HotPlugCPUError"
-	failedUpdateErrorReason      = "FailedUpdateError"
-	failedCreateReason           = "FailedCreate"
-	vmiFailedDeleteReason        = "FailedDelete"
-	affinityChangeErrorReason    = "AffinityChangeError"
-	hotplugMemoryErrorReason     = "HotPlugMemoryError"
-	volumesUpdateErrorReason     = "VolumesUpdateError"
-	tolerationsChangeErrorReason = "TolerationsChangeError"
-	annotationsChangeErrorReason = "AnnotationsChangeError"
+	hotplugVolumeErrorReason      = "HotPlugVolumeError"
+	hotplugCPUErrorReason         = "HotPlugCPUError"
+	failedUpdateErrorReason       = "FailedUpdateError"
+	failedCreateReason            = "FailedCreate"
+	vmiFailedDeleteReason         = "FailedDelete"
+	affinityChangeErrorReason     = "AffinityChangeError"
+	hotplugMemoryErrorReason      = "HotPlugMemoryError"
+	hotplugGPUErrorReason         = "HotPlugGPUError"
+	volumesUpdateErrorReason      = "VolumesUpdateError"
+	tolerationsChangeErrorReason  = "TolerationsChangeError"
+	annotationsChangeErrorReason  = "AnnotationsChangeError"
+	applyStagedChangesErrorReason = "ApplyStagedChangesError"
 )
 
 const defaultMaxCrashLoopBackoffDelaySeconds = 300
@@ -135,6 +141,9 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 	namespaceStore cache.Store,
 	pvcInformer cache.SharedIndexInformer,
 	crInformer cache.SharedIndexInformer,
+	podInformer cache.SharedIndexInformer,
+	nodeInformer cache.SharedIndexInformer,
+	priorityClassInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -155,6 +164,9 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 		namespaceStore:         namespaceStore,
 		pvcStore:               pvcInformer.GetStore(),
 		crIndexer:              crInformer.GetIndexer(),
+		podIndexer:             podInformer.GetIndexer(),
+		nodeStore:              nodeInformer.GetStore(),
+		priorityClassStore:     priorityClassInformer.GetStore(),
 		instancetypeController: instancetypeController,
 		recorder:               recorder,
 		clientset:              clientset,
@@ -172,7 +184,8 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 	c.hasSynced = func() bool {
 		return vmiInformer.HasSynced() && vmInformer.HasSynced() &&
 			dataVolumeInformer.HasSynced() && dataSourceInformer.HasSynced() &&
-			pvcInformer.HasSynced() && crInformer.HasSynced()
+			pvcInformer.HasSynced() && crInformer.HasSynced() &&
+			podInformer.HasSynced() && nodeInformer.HasSynced() && priorityClassInformer.HasSynced()
 	}
 
 	_, err := vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -268,6 +281,9 @@ type Controller struct {
 	namespaceStore         cache.Store
 	pvcStore               cache.Store
 	crIndexer              cache.Indexer
+	podIndexer             cache.Indexer
+	nodeStore              cache.Store
+	priorityClassStore     cache.Store
 	instancetypeController instancetypeHandler
 	recorder               record.EventRecorder
 	expectations           *controller.UIDTrackingControllerExpectations
@@ -608,11 +624,15 @@ func (c *Controller) handleCPUChangeRequest(vm *virtv1.VirtualMachine, vmi *virt
 		return nil
 	}
 
+	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
+	if vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceVCPUChange, k8score.ConditionFalse) {
+		return c.rollbackCPUHotUnplug(vmCopyWithInstancetype, vm, vmi)
+	}
+
 	if vmCopyWithInstancetype.Spec.Template.Spec.Domain.CPU.Sockets == vmi.Spec.Domain.CPU.Sockets {
 		return nil
 	}
 
-	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
 	if vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceVCPUChange, k8score.ConditionTrue) {
 		return fmt.Errorf("another CPU hotplug is in progress")
 	}
@@ -629,8 +649,8 @@ func (c *Controller) handleCPUChangeRequest(vm *virtv1.VirtualMachine, vmi *virt
 		return nil
 	}
 
-	if vmCopyWithInstancetype.Spec.Template.Spec.Domain.CPU.Sockets < vmi.Spec.Domain.CPU.Sockets {
-		setRestartRequired(vm, "Reduction of CPU socket count requires a restart")
+	if vmCopyWithInstancetype.Spec.Template.Spec.Domain.CPU.Sockets < vmi.Spec.Domain.CPU.Sockets && vmi.IsCPUDedicated() {
+		setRestartRequired(vm, "Reduction of CPU socket count requires a restart for VMs with dedicated CPUs")
 		return nil
 	}
 
@@ -647,6 +667,29 @@ func (c *Controller) handleCPUChangeRequest(vm *virtv1.VirtualMachine, vmi *virt
 	return nil
 }
 
+// rollbackCPUHotUnplug restores the CPU topology of the VMI to the one of the guest, after the
+// guest refused to release vCPUs. The VM has to be restarted to apply the reduced topology.
+func (c *Controller) rollbackCPUHotUnplug(vmCopyWithInstancetype, vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi.Status.CurrentCPUTopology == nil {
+		return nil
+	}
+	currentSockets := vmi.Status.CurrentCPUTopology.Sockets
+
+	if vmi.Spec.Domain.CPU.Sockets != currentSockets {
+		vmRollback := vmCopyWithInstancetype.DeepCopy()
+		vmRollback.Spec.Template.Spec.Domain.CPU.Sockets = currentSockets
+		if err := c.VMICPUsPatch(vmRollback, vmi); err != nil {
+			log.Log.Object(vmi).Errorf("unable to roll back the cpu topology of the vmi: %v", err)
+			return err
+		}
+	}
+
+	if vmCopyWithInstancetype.Spec.Template.Spec.Domain.CPU.Sockets != currentSockets {
+		setRestartRequired(vm, "CPU sockets updated in template spec. CPU hot-unplug was refused by the guest")
+	}
+	return nil
+}
+
 func (c *Controller) VMNodeSelectorPatch(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	patchset := patch.New()
 	if vm.Spec.Template.Spec.NodeSelector != nil {
@@ -747,6 +790,74 @@ func (c *Controller) handleTolerationsChangeRequest(vm *virtv1.VirtualMachine, v
 	return nil
 }
 
+func (c *Controller) replugHostDevicesEnabled() bool {
+	replugHostDevices := c.clusterConfig.GetMigrationConfiguration().ReplugHostDevices
+	return replugHostDevices != nil && *replugHostDevices
+}
+
+// handleGPUChangeRequest applies the GPUs of the VM template to the running VMI.
+// The VMI controller then migrates the VMI to a pod requesting the new GPUs, which
+// are plugged into the guest on the target.
+func (c *Controller) handleGPUChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil || !c.replugHostDevicesEnabled() {
+		return nil
+	}
+
+	vmCopyWithInstancetype := vm.DeepCopy()
+	if err := c.instancetypeController.ApplyToVM(vmCopyWithInstancetype); err != nil {
+		return err
+	}
+
+	gpus := vmCopyWithInstancetype.Spec.Template.Spec.Domain.Devices.GPUs
+	if equality.Semantic.DeepEqual(gpus, vmi.Spec.Domain.Devices.GPUs) {
+		return nil
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("GPUs should not be changed during VMI migration")
+	}
+
+	for _, gpu := range gpus {
+		if gpu.ClaimRequest != nil {
+			setRestartRequired(vm, "GPUs updated in template spec. GPUs allocated through DRA can't be hotplugged")
+			return nil
+		}
+		if gpu.VirtualGPUOptions == nil || gpu.VirtualGPUOptions.Display == nil ||
+			gpu.VirtualGPUOptions.Display.Enabled == nil || *gpu.VirtualGPUOptions.Display.Enabled {
+			setRestartRequired(vm, "GPUs updated in template spec. GPUs with a display can't be hotplugged")
+			return nil
+		}
+	}
+
+	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
+	if !vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceIsMigratable, k8score.ConditionTrue) {
+		setRestartRequired(vm, "GPUs updated in template spec. GPU hotplug is only available for migratable VMs")
+		return nil
+	}
+	if !vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceAgentConnected, k8score.ConditionTrue) {
+		setRestartRequired(vm, "GPUs updated in template spec. GPU hotplug requires a connected guest agent")
+		return nil
+	}
+
+	const gpusPath = "/spec/domain/devices/gpus"
+	patchset := patch.New(patch.WithTest(gpusPath, vmi.Spec.Domain.Devices.GPUs))
+	if len(vmi.Spec.Domain.Devices.GPUs) > 0 {
+		patchset.AddOption(patch.WithReplace(gpusPath, gpus))
+	} else {
+		patchset.AddOption(patch.WithAdd(gpusPath, gpus))
+	}
+	generatedPatch, err := patchset.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, generatedPatch, metav1.PatchOptions{}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update GPUs: %v", err)
+		return err
+	}
+	return nil
+}
+
 func (c *Controller) handleAffinityChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if vmi == nil || vmi.DeletionTimestamp != nil {
 		return nil
@@ -1899,6 +2010,10 @@ func (c *Controller) setupVMIFromVM(vm *virtv1.VirtualMachine) *virtv1.VirtualMa
 		vmi.Spec.StartStrategy = &strategy
 	}
 
+	applyStartOnceOverrides(vm, vmi)
+	applyRescue(vm, vmi)
+	applyHibernationState(vm, vmi)
+
 	// prevent from retriggering memory dump after shutdown if memory dump is complete
 	if memorydump.HasCompleted(vm) {
 		vmi.Spec = *memorydump.RemoveMemoryDumpVolumeFromVMISpec(&vmi.Spec, vm.Status.MemoryDumpRequest.ClaimName)
@@ -1927,6 +2042,117 @@ func hasStartPausedRequest(vm *virtv1.VirtualMachine) bool {
 		pausedValue == virtv1.StartRequestDataPausedTrue
 }
 
+// applyStartOnceOverrides applies the one-shot boot overrides of the start request to the VMI.
+// They are not persisted in the VM, so the next start uses the VM template again.
+func applyStartOnceOverrides(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	if !hasStartRequest(vm) {
+		return
+	}
+	data := vm.Status.StateChangeRequests[0].Data
+
+	if cdrom, exists := data[virtv1.StartRequestDataBootFromCDROMOnceKey]; exists {
+		bootFromDiskFirst(vmi, cdrom)
+	}
+
+	if kernelArgs, exists := data[virtv1.StartRequestDataKernelArgsOnceKey]; exists {
+		firmware := vmi.Spec.Domain.Firmware
+		if firmware == nil || firmware.KernelBoot == nil {
+			log.Log.Object(vm).Warning("Ignoring kernel arguments override, the VM has no kernel boot firmware")
+			return
+		}
+		firmware.KernelBoot.KernelArgs = kernelArgs
+	}
+}
+
+// bootFromDiskFirst makes the named disk the first boot device, while keeping the remaining disks bootable
+func bootFromDiskFirst(vmi *virtv1.VirtualMachineInstance, diskName string) {
+	disks := vmi.Spec.Domain.Devices.Disks
+	target := -1
+	hasBootOrder := false
+	for i := range disks {
+		if disks[i].Name == diskName {
+			target = i
+		}
+		if disks[i].BootOrder != nil {
+			hasBootOrder = true
+		}
+	}
+	interfaces := vmi.Spec.Domain.Devices.Interfaces
+	for i := range interfaces {
+		if interfaces[i].BootOrder != nil {
+			hasBootOrder = true
+		}
+	}
+	if target < 0 {
+		log.Log.Object(vmi).Warningf("Ignoring boot override, the VM has no disk named %s", diskName)
+		return
+	}
+
+	if hasBootOrder {
+		// Move all devices with an explicit boot order behind the target disk
+		for i := range disks {
+			if i != target && disks[i].BootOrder != nil {
+				disks[i].BootOrder = pointer.P(*disks[i].BootOrder + 1)
+			}
+		}
+		for i := range interfaces {
+			if interfaces[i].BootOrder != nil {
+				interfaces[i].BootOrder = pointer.P(*interfaces[i].BootOrder + 1)
+			}
+		}
+	} else {
+		// Once a boot order is set, only devices with a boot order are bootable
+		order := uint(2)
+		for i := range disks {
+			if i != target {
+				disks[i].BootOrder = pointer.P(order)
+				order++
+			}
+		}
+	}
+	disks[target].BootOrder = pointer.P(uint(1))
+}
+
+// applyRescue boots the VMI from the rescue image of a VM in rescue mode, with the original
+// disks attached behind it, read-only if requested
+func applyRescue(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	rescue := vm.Status.Rescue
+	if rescue == nil {
+		return
+	}
+
+	if rescue.ReadOnly {
+		disks := vmi.Spec.Domain.Devices.Disks
+		for i := range disks {
+			switch {
+			case disks[i].LUN != nil:
+				disks[i].LUN.ReadOnly = true
+			case disks[i].CDRom != nil:
+				// CD-ROMs are always read-only
+			default:
+				if disks[i].Disk == nil {
+					disks[i].Disk = &virtv1.DiskTarget{}
+				}
+				disks[i].Disk.ReadOnly = true
+			}
+		}
+	}
+
+	vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, virtv1.Disk{
+		Name: virtv1.VirtualMachineRescueVolumeName,
+		DiskDevice: virtv1.DiskDevice{
+			Disk: &virtv1.DiskTarget{Bus: virtv1.DiskBusVirtio},
+		},
+	})
+	vmi.Spec.Volumes = append(vmi.Spec.Volumes, virtv1.Volume{
+		Name: virtv1.VirtualMachineRescueVolumeName,
+		VolumeSource: virtv1.VolumeSource{
+			ContainerDisk: &virtv1.ContainerDiskSource{Image: rescue.Image},
+		},
+	})
+	bootFromDiskFirst(vmi, virtv1.VirtualMachineRescueVolumeName)
+}
+
 func hasStartRequest(vm *virtv1.VirtualMachine) bool {
 	if len(vm.Status.StateChangeRequests) == 0 {
 		return false
@@ -2500,6 +2726,10 @@ func (c *Controller) updateStatus(vm, vmOrig *virtv1.VirtualMachine, vmi *virtv1
 
 	c.trimDoneVolumeRequests(vm)
 	memorydump.UpdateRequest(vm, vmi)
+	if vmi == nil {
+		// A stopped VM starts with all the changes
+		vm.Status.StagedChanges = nil
+	}
 
 	if c.isTrimFirstChangeRequestNeeded(vm, vmi) {
 		popStateChangeRequest(vm)
@@ -2546,6 +2776,8 @@ func (c *Controller) setPrintableStatus(vm *virtv1.VirtualMachine, vmi *virtv1.V
 		statusFunc func(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool
 	}{
 		{virtv1.VirtualMachineStatusTerminating, c.isVirtualMachineStatusTerminating},
+		{virtv1.VirtualMachineStatusHibernating, c.isVirtualMachineStatusHibernating},
+		{virtv1.VirtualMachineStatusHibernated, c.isVirtualMachineStatusHibernated},
 		{virtv1.VirtualMachineStatusStopping, c.isVirtualMachineStatusStopping},
 		{virtv1.VirtualMachineStatusMigrating, c.isVirtualMachineStatusMigrating},
 		{virtv1.VirtualMachineStatusPaused, c.isVirtualMachineStatusPaused},
@@ -2663,6 +2895,16 @@ func (c *Controller) isVirtualMachineStatusTerminating(vm *virtv1.VirtualMachine
 	return vm.ObjectMeta.DeletionTimestamp != nil
 }
 
+// isVirtualMachineStatusHibernating determines whether the VM status field should be set to "Hibernating".
+func (c *Controller) isVirtualMachineStatusHibernating(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	return vm.Status.Hibernation != nil && vm.Status.Hibernation.Phase == virtv1.HibernationHibernating
+}
+
+// isVirtualMachineStatusHibernated determines whether the VM status field should be set to "Hibernated".
+func (c *Controller) isVirtualMachineStatusHibernated(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	return vm.Status.Hibernation != nil && vm.Status.Hibernation.Phase == virtv1.HibernationHibernated
+}
+
 // isVirtualMachineStatusMigrating determines whether the VM status field should be set to "Migrating".
 func (c *Controller) isVirtualMachineStatusMigrating(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
 	return vmi != nil && migrations.IsMigrating(vmi)
@@ -2884,6 +3126,10 @@ func (c *Controller) trimDoneVolumeRequests(vm *virtv1.VirtualMachine) {
 	for _, disk := range vm.Spec.Template.Spec.Domain.Devices.Disks {
 		diskMap[disk.Name] = disk
 	}
+	filesystemMap := make(map[string]struct{})
+	for _, fs := range vm.Spec.Template.Spec.Domain.Devices.Filesystems {
+		filesystemMap[fs.Name] = struct{}{}
+	}
 
 	tmpVolRequests := vm.Status.VolumeRequests[:0]
 	for _, request := range vm.Status.VolumeRequests {
@@ -2903,10 +3149,13 @@ func (c *Controller) trimDoneVolumeRequests(vm *virtv1.VirtualMachine) {
 
 		_, volExists := volumeMap[volName]
 		_, diskExists := diskMap[volName]
+		_, filesystemExists := filesystemMap[volName]
+		// a volume is either plugged as a disk or as a filesystem
+		deviceExists := diskExists || filesystemExists
 
-		if added && volExists && diskExists {
+		if added && volExists && deviceExists {
 			removeRequest = true
-		} else if !added && !volExists && !diskExists {
+		} else if !added && !volExists && !deviceExists {
 			removeRequest = true
 		}
 
@@ -2985,6 +3234,39 @@ func validLiveUpdateDisks(oldVMSpec *virtv1.VirtualMachineSpec, vm *virtv1.Virtu
 	return true
 }
 
+func validLiveUpdateFilesystems(oldVMSpec *virtv1.VirtualMachineSpec, vm *virtv1.VirtualMachine) bool {
+	oldFilesystems := map[string]virtv1.Filesystem{}
+	for _, fs := range oldVMSpec.Template.Spec.Domain.Devices.Filesystems {
+		oldFilesystems[fs.Name] = fs
+	}
+	oldVols := storagetypes.GetVolumesByName(&oldVMSpec.Template.Spec)
+	vols := storagetypes.GetVolumesByName(&vm.Spec.Template.Spec)
+	// Evaluate if any filesystem has changed or has been added
+	for _, newFilesystem := range vm.Spec.Template.Spec.Domain.Devices.Filesystems {
+		newVolume, okNewVolume := vols[newFilesystem.Name]
+		oldFilesystem, okOldFilesystem := oldFilesystems[newFilesystem.Name]
+		switch {
+		// Changes for filesystems associated to a hotpluggable volume are valid
+		case okNewVolume && storagetypes.IsHotplugVolume(newVolume):
+			delete(oldFilesystems, newFilesystem.Name)
+		// The filesystem has been freshly added or has changed
+		case !okOldFilesystem || !equality.Semantic.DeepEqual(oldFilesystem, newFilesystem):
+			return false
+		default:
+			delete(oldFilesystems, newFilesystem.Name)
+		}
+	}
+	// Evaluate if any filesystems were removed and they were hotplugged volumes
+	for name := range oldFilesystems {
+		v, ok := oldVols[name]
+		if ok && !storagetypes.IsHotplugVolume(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func setRestartRequired(vm *virtv1.VirtualMachine, message string) {
 	vmConditions := controller.NewVirtualMachineConditionManager()
 	vmConditions.UpdateCondition(vm, &virtv1.VirtualMachineCondition{
@@ -2997,6 +3279,16 @@ func setRestartRequired(vm *virtv1.VirtualMachine, message string) {
 
 // addRestartRequiredIfNeeded adds the restartRequired condition to the VM if any non-live-updatable field was changed
 func (c *Controller) addRestartRequiredIfNeeded(lastSeenVMSpec *virtv1.VirtualMachineSpec, vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	if !c.hasNonLiveUpdatableChanges(lastSeenVMSpec, vm, vmi) {
+		return false
+	}
+
+	setRestartRequired(vm, "a non-live-updatable field was changed in the template spec")
+	return true
+}
+
+// hasNonLiveUpdatableChanges returns whether any non-live-updatable field was changed since the VMI was started
+func (c *Controller) hasNonLiveUpdatableChanges(lastSeenVMSpec *virtv1.VirtualMachineSpec, vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
 	if lastSeenVMSpec == nil {
 		return false
 	}
@@ -3025,6 +3317,9 @@ func (c *Controller) addRestartRequiredIfNeeded(lastSeenVMSpec *virtv1.VirtualMa
 		if validLiveUpdateDisks(&lastSeenVM.Spec, currentVM) {
 			lastSeenVM.Spec.Template.Spec.Domain.Devices.Disks = currentVM.Spec.Template.Spec.Domain.Devices.Disks
 		}
+		if validLiveUpdateFilesystems(&lastSeenVM.Spec, currentVM) {
+			lastSeenVM.Spec.Template.Spec.Domain.Devices.Filesystems = currentVM.Spec.Template.Spec.Domain.Devices.Filesystems
+		}
 		if lastSeenVM.Spec.Template.Spec.Domain.CPU != nil && currentVM.Spec.Template.Spec.Domain.CPU != nil {
 			lastSeenVM.Spec.Template.Spec.Domain.CPU.Sockets = currentVM.Spec.Template.Spec.Domain.CPU.Sockets
 		}
@@ -3036,9 +3331,18 @@ func (c *Controller) addRestartRequiredIfNeeded(lastSeenVMSpec *virtv1.VirtualMa
 			lastSeenVM.Spec.Template.Spec.Domain.Memory.Guest = currentVM.Spec.Template.Spec.Domain.Memory.Guest
 		}
 
+		if c.replugHostDevicesEnabled() {
+			lastSeenVM.Spec.Template.Spec.Domain.Devices.GPUs = currentVM.Spec.Template.Spec.Domain.Devices.GPUs
+		}
+
 		lastSeenVM.Spec.Template.Spec.NodeSelector = currentVM.Spec.Template.Spec.NodeSelector
 		lastSeenVM.Spec.Template.Spec.Affinity = currentVM.Spec.Template.Spec.Affinity
 		lastSeenVM.Spec.Template.Spec.Tolerations = currentVM.Spec.Template.Spec.Tolerations
+
+		// Switching to another instancetype is live-updatable as long as CPU and memory hotplug are able to cover the delta
+		if liveresize.HasInstancetypeChanged(lastSeenVMSpec, vm) {
+			liveresize.IgnoreHotpluggableChanges(&lastSeenVM.Spec.Template.Spec, &currentVM.Spec.Template.Spec, vmi)
+		}
 	} else {
 		// In the case live-updates aren't enable the volume set of the VM can be still changed by volume hotplugging.
 		// For imperative volume hotplug, first the VM status with the request AND the VMI spec are updated, then in the
@@ -3048,9 +3352,11 @@ func (c *Controller) addRestartRequiredIfNeeded(lastSeenVMSpec *virtv1.VirtualMa
 		// In case of a declarative update, the flow is the opposite, first we update the VM spec and then the VMI. Therefore, if
 		// the change was declarative, then the VMI would still not have the update.
 		if equality.Semantic.DeepEqual(currentVM.Spec.Template.Spec.Volumes, vmi.Spec.Volumes) &&
-			equality.Semantic.DeepEqual(currentVM.Spec.Template.Spec.Domain.Devices.Disks, vmi.Spec.Domain.Devices.Disks) {
+			equality.Semantic.DeepEqual(currentVM.Spec.Template.Spec.Domain.Devices.Disks, vmi.Spec.Domain.Devices.Disks) &&
+			equality.Semantic.DeepEqual(currentVM.Spec.Template.Spec.Domain.Devices.Filesystems, vmi.Spec.Domain.Devices.Filesystems) {
 			lastSeenVM.Spec.Template.Spec.Volumes = currentVM.Spec.Template.Spec.Volumes
 			lastSeenVM.Spec.Template.Spec.Domain.Devices.Disks = currentVM.Spec.Template.Spec.Domain.Devices.Disks
+			lastSeenVM.Spec.Template.Spec.Domain.Devices.Filesystems = currentVM.Spec.Template.Spec.Domain.Devices.Filesystems
 		}
 	}
 
@@ -3059,12 +3365,7 @@ func (c *Controller) addRestartRequiredIfNeeded(lastSeenVMSpec *virtv1.VirtualMa
 		lastSeenVM.Spec.Template.Spec.Networks = currentVM.Spec.Template.Spec.Networks
 	}
 
-	if !equality.Semantic.DeepEqual(lastSeenVM.Spec.Template.Spec, currentVM.Spec.Template.Spec) {
-		setRestartRequired(vm, "a non-live-updatable field was changed in the template spec")
-		return true
-	}
-
-	return false
+	return !equality.Semantic.DeepEqual(lastSeenVM.Spec.Template.Spec, currentVM.Spec.Template.Spec)
 }
 
 func (c *Controller) syncVMAnnotationsToVMI(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachineInstance, error) {
@@ -3209,13 +3510,41 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 		}
 	}
 
+	vm, err = c.syncPowerSchedule(vm, vmi)
+	if err != nil {
+		return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling the power schedule: %v", err), powerScheduleErrorReason), nil
+	}
+
+	vm, err = c.syncIdlePolicy(vm, vmi)
+	if err != nil {
+		return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling the idle policy: %v", err), idlePolicyErrorReason), nil
+	}
+
+	vm, err = c.syncPreemption(vm, vmi)
+	if err != nil {
+		return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling the preemption: %v", err), preemptionErrorReason), nil
+	}
+
+	vm, hibernationHandled, err := c.syncHibernation(vm, vmi)
+	if err != nil {
+		return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling the hibernation: %v", err), hibernationErrorReason), nil
+	}
+
 	origRunStrategy := vm.Spec.RunStrategy
-	vm, syncErr = c.syncRunStrategy(vm, vmi, runStrategy)
-	if syncErr != nil {
-		return vm, vmi, syncErr, nil
+	if !hibernationHandled {
+		vm, syncErr = c.syncRunStrategy(vm, vmi, runStrategy)
+		if syncErr != nil {
+			return vm, vmi, syncErr, nil
+		}
 	}
 
-	restartRequired := c.addRestartRequiredIfNeeded(startVMSpec, vm, vmi)
+	var restartRequired bool
+	if isChangeApplyStaged(vm) {
+		restartRequired = c.stageChanges(startVMSpec, vm, vmi)
+	} else {
+		vm.Status.StagedChanges = nil
+		restartRequired = c.addRestartRequiredIfNeeded(startVMSpec, vm, vmi)
+	}
 
 	// Must check satisfiedExpectations again here because a VMI can be created or
 	// deleted in the startStop function which impacts how we process
@@ -3226,7 +3555,10 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 	vmCopy := vm.DeepCopy()
 	vm.Spec.RunStrategy = origRunStrategy
 
-	if c.netSynchronizer != nil {
+	// Staged changes are only applied to the running VMI on request
+	changesStaged := isChangeApplyStaged(vm) && vmi != nil && !isApplyingStagedChanges(vm)
+
+	if c.netSynchronizer != nil && !changesStaged {
 		syncedVM, err := c.netSynchronizer.Sync(vmCopy, vmi)
 		if err != nil {
 			return vm, vmi, handleSynchronizerErr(err), nil
@@ -3261,7 +3593,7 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 	}
 
 	conditionManager := controller.NewVirtualMachineConditionManager()
-	if c.clusterConfig.IsVMRolloutStrategyLiveUpdate() && !restartRequired && !conditionManager.HasCondition(vm, virtv1.VirtualMachineRestartRequired) {
+	if c.clusterConfig.IsVMRolloutStrategyLiveUpdate() && !restartRequired && !changesStaged && !conditionManager.HasCondition(vm, virtv1.VirtualMachineRestartRequired) {
 		if err := c.handleCPUChangeRequest(vmCopy, vmi); err != nil {
 			return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling CPU change request: %v", err), hotplugCPUErrorReason), nil
 		}
@@ -3281,6 +3613,10 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 		if err := c.handleVolumeUpdateRequest(vmCopy, vmi); err != nil {
 			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling volumes update requests: %v", err), volumesUpdateErrorReason), nil
 		}
+
+		if err := c.handleGPUChangeRequest(vmCopy, vmi); err != nil {
+			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling GPU change request: %v", err), hotplugGPUErrorReason), nil
+		}
 	}
 
 	if !equality.Semantic.DeepEqual(vm.Spec, vmCopy.Spec) || !equality.Semantic.DeepEqual(vm.ObjectMeta, vmCopy.ObjectMeta) {
@@ -3293,6 +3629,12 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 		vm = vmCopy
 	}
 
+	if isChangeApplyStaged(vm) && isApplyingStagedChanges(vm) && !restartRequired {
+		if err := c.applyStagedChanges(vm, vmi); err != nil {
+			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while applying staged changes: %v", err), applyStagedChangesErrorReason), nil
+		}
+	}
+
 	return vm, vmi, nil, nil
 }
 
@@ -16,6 +16,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -105,6 +106,9 @@ var _ = Describe("VirtualMachine", func() {
 			vmInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachine{}, virtcontroller.GetVirtualMachineInformerIndexers())
 			pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
 			namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+			podInformer, _ := testutils.NewFakeInformerWithIndexersFor(&k8sv1.Pod{}, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			nodeInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Node{})
+			priorityClassInformer, _ := testutils.NewFakeInformerFor(&schedulingv1.PriorityClass{})
 
 			ns1 := &k8sv1.Namespace{
 				ObjectMeta: metav1.ObjectMeta{
@@ -143,6 +147,9 @@ var _ = Describe("VirtualMachine", func() {
 				namespaceInformer.GetStore(),
 				pvcInformer,
 				crInformer,
+				podInformer,
+				nodeInformer,
+				priorityClassInformer,
 				recorder,
 				virtClient,
 				config,
@@ -536,6 +543,43 @@ var _ = Describe("VirtualMachine", func() {
 				Entry("that is not running", false),
 			)
 
+			It("should hotplug a filesystem into a running vm", func() {
+				vm, vmi := watchtesting.DefaultVirtualMachine(true)
+				vm.Status.Created = true
+				vm.Status.Ready = true
+				vm.Status.VolumeRequests = []v1.VirtualMachineVolumeRequest{
+					{
+						AddVolumeOptions: &v1.AddVolumeOptions{
+							Name:         "vol1",
+							Filesystem:   &v1.Filesystem{Virtiofs: &v1.FilesystemVirtiofs{}},
+							VolumeSource: &v1.HotplugVolumeSource{},
+						},
+					},
+				}
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				watchtesting.MarkAsReady(vmi)
+				vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				controller.vmiIndexer.Add(vmi)
+				addVolumeReactor(virtFakeClient)
+
+				sanityExecute(vm)
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				Expect(vm.Spec.Template.Spec.Domain.Devices.Filesystems).To(ConsistOf(HaveField("Name", "vol1")))
+				Expect(vm.Spec.Template.Spec.Domain.Devices.Disks).To(BeEmpty())
+				Expect(vm.Status.VolumeRequests).To(BeEmpty())
+
+				vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(vmi.Spec.Domain.Devices.Filesystems).To(ConsistOf(HaveField("Name", "vol1")))
+			})
+
 			Context("add volume request", func() {
 				It("should not be cleared when hotplug fails on Running VM", func() {
 					By("Running VM")
@@ -2613,6 +2657,732 @@ var _ = Describe("VirtualMachine", func() {
 			Expect(string(vmi1.Spec.Domain.Firmware.UUID)).To(Equal(uid))
 		})
 
+		Context("with one-shot boot overrides in the start request", func() {
+			newVMWithStartRequest := func(data map[string]string) *v1.VirtualMachine {
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{
+					{Name: "rootdisk", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}}},
+					{Name: "datadisk", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}}},
+					{Name: "installer", DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{}}},
+				}
+				vm.Status.StateChangeRequests = []v1.VirtualMachineStateChangeRequest{{Action: v1.StartRequest, Data: data}}
+				return vm
+			}
+
+			bootOrders := func(vmi *v1.VirtualMachineInstance) map[string]*uint {
+				orders := map[string]*uint{}
+				for _, disk := range vmi.Spec.Domain.Devices.Disks {
+					orders[disk.Name] = disk.BootOrder
+				}
+				return orders
+			}
+
+			It("should boot from the CD-ROM first and keep the other disks bootable", func() {
+				vm := newVMWithStartRequest(map[string]string{v1.StartRequestDataBootFromCDROMOnceKey: "installer"})
+
+				vmi := controller.setupVMIFromVM(vm)
+				Expect(bootOrders(vmi)).To(Equal(map[string]*uint{
+					"installer": pointer.P(uint(1)),
+					"rootdisk":  pointer.P(uint(2)),
+					"datadisk":  pointer.P(uint(3)),
+				}))
+				Expect(vm.Spec.Template.Spec.Domain.Devices.Disks[2].BootOrder).To(BeNil(), "the VM template must not be changed")
+			})
+
+			It("should move an explicit boot order behind the CD-ROM", func() {
+				vm := newVMWithStartRequest(map[string]string{v1.StartRequestDataBootFromCDROMOnceKey: "installer"})
+				vm.Spec.Template.Spec.Domain.Devices.Disks[1].BootOrder = pointer.P(uint(1))
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default", BootOrder: pointer.P(uint(2))}}
+
+				vmi := controller.setupVMIFromVM(vm)
+				Expect(bootOrders(vmi)).To(Equal(map[string]*uint{
+					"installer": pointer.P(uint(1)),
+					"rootdisk":  nil,
+					"datadisk":  pointer.P(uint(2)),
+				}))
+				Expect(vmi.Spec.Domain.Devices.Interfaces[0].BootOrder).To(Equal(pointer.P(uint(3))))
+			})
+
+			It("should override the kernel arguments", func() {
+				vm := newVMWithStartRequest(map[string]string{v1.StartRequestDataKernelArgsOnceKey: "single"})
+				vm.Spec.Template.Spec.Domain.Firmware = &v1.Firmware{
+					KernelBoot: &v1.KernelBoot{KernelArgs: "quiet", Container: &v1.KernelBootContainer{Image: "kernel"}},
+				}
+
+				vmi := controller.setupVMIFromVM(vm)
+				Expect(vmi.Spec.Domain.Firmware.KernelBoot.KernelArgs).To(Equal("single"))
+				Expect(vm.Spec.Template.Spec.Domain.Firmware.KernelBoot.KernelArgs).To(Equal("quiet"))
+			})
+
+			It("should use the VM template without a start request", func() {
+				vm := newVMWithStartRequest(nil)
+				vm.Status.StateChangeRequests = nil
+
+				vmi := controller.setupVMIFromVM(vm)
+				Expect(bootOrders(vmi)).To(HaveEach(BeNil()))
+			})
+		})
+
+		Context("in rescue mode", func() {
+			newRescuedVM := func(readOnly bool) *v1.VirtualMachine {
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{
+					{Name: "rootdisk", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}}},
+					{Name: "datadisk"},
+					{Name: "lun", DiskDevice: v1.DiskDevice{LUN: &v1.LunTarget{}}},
+				}
+				vm.Status.Rescue = &v1.VirtualMachineRescue{Image: "quay.io/rescue:latest", ReadOnly: readOnly}
+				return vm
+			}
+
+			It("should boot from the rescue image with the original disks attached", func() {
+				vm := newRescuedVM(false)
+
+				vmi := controller.setupVMIFromVM(vm)
+				Expect(vmi.Spec.Volumes).To(ContainElement(v1.Volume{
+					Name: v1.VirtualMachineRescueVolumeName,
+					VolumeSource: v1.VolumeSource{
+						ContainerDisk: &v1.ContainerDiskSource{Image: "quay.io/rescue:latest"},
+					},
+				}))
+				disks := vmi.Spec.Domain.Devices.Disks
+				Expect(disks).To(HaveLen(4))
+				Expect(disks[3].Name).To(Equal(v1.VirtualMachineRescueVolumeName))
+				Expect(disks[3].BootOrder).To(Equal(pointer.P(uint(1))))
+				Expect(disks[0].BootOrder).To(Equal(pointer.P(uint(2))))
+				Expect(disks[0].Disk.ReadOnly).To(BeFalse())
+				Expect(vm.Spec.Template.Spec.Domain.Devices.Disks).To(HaveLen(3), "the VM template must not be changed")
+			})
+
+			It("should attach the original disks read-only if requested", func() {
+				vm := newRescuedVM(true)
+
+				vmi := controller.setupVMIFromVM(vm)
+				disks := vmi.Spec.Domain.Devices.Disks
+				Expect(disks[0].Disk.ReadOnly).To(BeTrue())
+				Expect(disks[1].Disk.ReadOnly).To(BeTrue())
+				Expect(disks[2].LUN.ReadOnly).To(BeTrue())
+				Expect(disks[3].Disk.ReadOnly).To(BeFalse())
+				Expect(vm.Spec.Template.Spec.Domain.Devices.Disks[0].Disk.ReadOnly).To(BeFalse(), "the VM template must not be changed")
+			})
+
+			It("should boot the VM template once the VM left rescue mode", func() {
+				vm := newRescuedVM(true)
+				vm.Status.Rescue = nil
+
+				vmi := controller.setupVMIFromVM(vm)
+				Expect(vmi.Spec.Domain.Devices.Disks).To(Equal(vm.Spec.Template.Spec.Domain.Devices.Disks))
+			})
+		})
+
+		Context("with a power schedule", func() {
+			// Monday, 2026-01-05 at 10:00 UTC
+			monday := time.Date(2026, time.January, 5, 10, 0, 0, 0, time.UTC)
+
+			setCurrentTime := func(now time.Time) {
+				origCurrentTime := currentTime
+				currentTime = func() time.Time { return now }
+				DeferCleanup(func() { currentTime = origCurrentTime })
+			}
+
+			newScheduledVM := func(started bool) *v1.VirtualMachine {
+				vm, _ := watchtesting.DefaultVirtualMachine(started)
+				vm.Spec.PowerSchedule = &v1.VirtualMachinePowerSchedule{
+					Start: "0 8 * * 1-5",
+					Stop:  "0 18 * * 1-5",
+				}
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				return vm
+			}
+
+			It("should compute the next scheduled action", func() {
+				setCurrentTime(monday)
+				vm := newScheduledVM(true)
+
+				vm, err := controller.syncPowerSchedule(vm, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Status.PowerSchedule.NextAction).To(Equal(v1.StopRequest))
+				Expect(vm.Status.PowerSchedule.NextActionTime.Time).To(Equal(monday.Add(8 * time.Hour)))
+				Expect(vm.Status.PowerSchedule.LastAction).To(BeEmpty())
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+			})
+
+			It("should compute the next scheduled action in the time zone of the schedule", func() {
+				setCurrentTime(monday)
+				vm := newScheduledVM(false)
+				vm.Spec.PowerSchedule.TimeZone = "America/New_York"
+
+				vm, err := controller.syncPowerSchedule(vm, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Status.PowerSchedule.NextAction).To(Equal(v1.StartRequest))
+				Expect(vm.Status.PowerSchedule.NextActionTime.UTC()).To(Equal(monday.Add(3 * time.Hour)))
+			})
+
+			It("should stop a running VM when the scheduled stop is due", func() {
+				evening := monday.Add(8 * time.Hour)
+				setCurrentTime(evening.Add(time.Second))
+				vm := newScheduledVM(true)
+				vm.Status.PowerSchedule = &v1.VirtualMachinePowerScheduleStatus{
+					NextAction:     v1.StopRequest,
+					NextActionTime: &metav1.Time{Time: evening},
+				}
+
+				vm, err := controller.syncPowerSchedule(vm, nil)
+				Expect(err).ToNot(HaveOccurred())
+				testutils.ExpectEvent(recorder, PowerScheduleStopReason)
+				Expect(vm.Status.PowerSchedule.LastAction).To(Equal(v1.StopRequest))
+				Expect(vm.Status.PowerSchedule.LastActionTime.Time).To(Equal(evening))
+				Expect(vm.Status.PowerSchedule.NextAction).To(Equal(v1.StartRequest))
+				Expect(vm.Status.PowerSchedule.NextActionTime.Time).To(Equal(monday.Add(22 * time.Hour)))
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyHalted)))
+			})
+
+			It("should start a halted VM when the scheduled start is due", func() {
+				morning := monday.Add(-2 * time.Hour)
+				setCurrentTime(morning)
+				vm := newScheduledVM(false)
+				vm.Status.PowerSchedule = &v1.VirtualMachinePowerScheduleStatus{
+					NextAction:     v1.StartRequest,
+					NextActionTime: &metav1.Time{Time: morning},
+				}
+
+				vm, err := controller.syncPowerSchedule(vm, nil)
+				Expect(err).ToNot(HaveOccurred())
+				testutils.ExpectEvent(recorder, PowerScheduleStartReason)
+				Expect(vm.Status.PowerSchedule.LastAction).To(Equal(v1.StartRequest))
+				Expect(vm.Status.PowerSchedule.NextAction).To(Equal(v1.StopRequest))
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyAlways)))
+			})
+
+			It("should skip a scheduled start on an exception date", func() {
+				morning := monday.Add(-2 * time.Hour)
+				setCurrentTime(morning)
+				vm := newScheduledVM(false)
+				vm.Spec.PowerSchedule.Exceptions = []string{"2026-01-05"}
+				vm.Status.PowerSchedule = &v1.VirtualMachinePowerScheduleStatus{
+					NextAction:     v1.StartRequest,
+					NextActionTime: &metav1.Time{Time: morning},
+				}
+
+				vm, err := controller.syncPowerSchedule(vm, nil)
+				Expect(err).ToNot(HaveOccurred())
+				testutils.ExpectEvent(recorder, PowerScheduleSkippedReason)
+				Expect(vm.Status.PowerSchedule.LastAction).To(BeEmpty())
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyHalted)))
+			})
+
+			It("should clear the status once the power schedule is removed", func() {
+				vm := newScheduledVM(true)
+				vm.Spec.PowerSchedule = nil
+				vm.Status.PowerSchedule = &v1.VirtualMachinePowerScheduleStatus{NextAction: v1.StopRequest}
+
+				vm, err := controller.syncPowerSchedule(vm, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Status.PowerSchedule).To(BeNil())
+			})
+		})
+
+		Context("with an idle policy", func() {
+			newIdleVM := func(runStrategy v1.VirtualMachineRunStrategy, action v1.IdleAction) (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+				vm, vmi := watchtesting.DefaultVirtualMachine(true)
+				vm.Spec.RunStrategy = &runStrategy
+				vmi.Spec.IdlePolicy = &v1.IdlePolicy{Timeout: metav1.Duration{Duration: time.Hour}, Action: action}
+				vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{Type: v1.VirtualMachineInstanceIdle, Status: k8sv1.ConditionTrue}}
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.TODO(), vmi, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				return vm, vmi
+			}
+
+			It("should halt a VM whose VMI is idle", func() {
+				vm, vmi := newIdleVM(v1.RunStrategyAlways, v1.IdleActionStop)
+
+				_, err := controller.syncIdlePolicy(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				testutils.ExpectEvent(recorder, IdleStopReason)
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyHalted)))
+			})
+
+			It("should stop the VMI of a manual VM which is idle", func() {
+				vm, vmi := newIdleVM(v1.RunStrategyManual, v1.IdleActionStop)
+
+				_, err := controller.syncIdlePolicy(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				testutils.ExpectEvent(recorder, IdleStopReason)
+
+				_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+				Expect(err).To(MatchError(ContainSubstring("not found")))
+			})
+
+			It("should leave pausing an idle VMI to virt-handler", func() {
+				vm, vmi := newIdleVM(v1.RunStrategyAlways, v1.IdleActionPause)
+
+				_, err := controller.syncIdlePolicy(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyAlways)))
+			})
+		})
+
+		Context("with a hibernation", func() {
+			const claimName = "hibernation-vmi-uid"
+
+			newHibernatingVM := func(phase v1.HibernationPhase, started bool) (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+				vm, vmi := watchtesting.DefaultVirtualMachine(started)
+				vmi.UID = "vmi-uid"
+				vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{Type: v1.VirtualMachineInstancePaused, Status: k8sv1.ConditionTrue}}
+				vm.Status.Hibernation = &v1.VirtualMachineHibernation{
+					Phase:              phase,
+					ClaimName:          claimName,
+					LastTransitionTime: metav1.Now(),
+				}
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				return vm, vmi
+			}
+
+			createVMI := func(vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstance {
+				vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.TODO(), vmi, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				return vmi
+			}
+
+			createClaim := func() {
+				pvc := &k8sv1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: claimName, Namespace: metav1.NamespaceDefault}}
+				_, err := k8sClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(context.TODO(), pvc, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			getClaim := func() (*k8sv1.PersistentVolumeClaim, error) {
+				return k8sClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Get(context.TODO(), claimName, metav1.GetOptions{})
+			}
+
+			It("should dump the memory of the paused VMI to a new PVC", func() {
+				vm, vmi := newHibernatingVM(v1.HibernationHibernating, true)
+
+				vm, handled, err := controller.syncHibernation(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(handled).To(BeTrue())
+				Expect(vm.Status.MemoryDumpRequest).To(Equal(&v1.VirtualMachineMemoryDumpRequest{
+					ClaimName: claimName,
+					Phase:     v1.MemoryDumpAssociating,
+					Format:    v1.MemoryDumpFormatState,
+				}))
+
+				pvc, err := getClaim()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pvc.OwnerReferences).To(HaveExactElements(*metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind)))
+				Expect(pvc.Spec.Resources.Requests).To(HaveKey(k8sv1.ResourceStorage))
+			})
+
+			It("should wait for the VMI to be paused", func() {
+				vm, vmi := newHibernatingVM(v1.HibernationHibernating, true)
+				vmi.Status.Conditions = nil
+
+				vm, handled, err := controller.syncHibernation(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(handled).To(BeTrue())
+				Expect(vm.Status.MemoryDumpRequest).To(BeNil())
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+			})
+
+			It("should fail if the VMI is not paused in time", func() {
+				vm, vmi := newHibernatingVM(v1.HibernationHibernating, true)
+				vm.Status.Hibernation.LastTransitionTime = metav1.NewTime(time.Now().Add(-2 * hibernationPauseTimeout))
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).UpdateStatus(context.TODO(), vm, metav1.UpdateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				vmi.Status.Conditions = nil
+
+				vm, handled, err := controller.syncHibernation(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(handled).To(BeFalse())
+				Expect(vm.Status.Hibernation).To(BeNil())
+				testutils.ExpectEvent(recorder, HibernationFailedReason)
+			})
+
+			It("should stop the VMI once its memory was dumped", func() {
+				vm, vmi := newHibernatingVM(v1.HibernationHibernating, true)
+				vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{ClaimName: claimName, Phase: v1.MemoryDumpCompleted, Format: v1.MemoryDumpFormatState}
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).UpdateStatus(context.TODO(), vm, metav1.UpdateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				vmi = createVMI(vmi)
+
+				vm, handled, err := controller.syncHibernation(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(handled).To(BeTrue())
+				Expect(vm.Status.Hibernation.Phase).To(Equal(v1.HibernationHibernated))
+				Expect(vm.Status.MemoryDumpRequest.Remove).To(BeTrue())
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				testutils.ExpectEvent(recorder, HibernatedReason)
+
+				_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+				Expect(err).To(MatchError(ContainSubstring("not found")))
+			})
+
+			It("should fail if the memory dump failed", func() {
+				vm, vmi := newHibernatingVM(v1.HibernationHibernating, true)
+				vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{ClaimName: claimName, Phase: v1.MemoryDumpFailed, Message: "no space left"}
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).UpdateStatus(context.TODO(), vm, metav1.UpdateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				createClaim()
+
+				vm, handled, err := controller.syncHibernation(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(handled).To(BeFalse())
+				Expect(vm.Status.Hibernation).To(BeNil())
+				Expect(vm.Status.MemoryDumpRequest.Remove).To(BeTrue())
+				testutils.ExpectEvent(recorder, HibernationFailedReason)
+				_, err = getClaim()
+				Expect(err).To(MatchError(ContainSubstring("not found")))
+			})
+
+			It("should keep a hibernated VM stopped", func() {
+				vm, _ := newHibernatingVM(v1.HibernationHibernated, true)
+
+				vm, handled, err := controller.syncHibernation(vm, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(handled).To(BeTrue())
+				Expect(vm.Status.Hibernation.Phase).To(Equal(v1.HibernationHibernated))
+			})
+
+			It("should discard the saved memory once a hibernated VM is stopped", func() {
+				vm, _ := newHibernatingVM(v1.HibernationHibernated, false)
+				createClaim()
+
+				vm, handled, err := controller.syncHibernation(vm, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(handled).To(BeFalse())
+				Expect(vm.Status.Hibernation).To(BeNil())
+				testutils.ExpectEvent(recorder, HibernationDiscardedReason)
+				_, err = getClaim()
+				Expect(err).To(MatchError(ContainSubstring("not found")))
+			})
+
+			It("should start a resuming VM from the hibernation PVC", func() {
+				vm, _ := newHibernatingVM(v1.HibernationResuming, true)
+
+				vmi := controller.setupVMIFromVM(vm)
+				Expect(vmi.Spec.Volumes).To(ContainElement(v1.Volume{
+					Name: v1.VirtualMachineHibernationVolumeName,
+					VolumeSource: v1.VolumeSource{
+						MemoryDump: &v1.MemoryDumpVolumeSource{
+							PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+							},
+							Format: v1.MemoryDumpFormatState,
+						},
+					},
+				}))
+				Expect(vm.Spec.Template.Spec.Volumes).ToNot(ContainElement(HaveField("Name", v1.VirtualMachineHibernationVolumeName)), "the VM template must not be changed")
+			})
+
+			It("should hand the hibernation PVC over to the VMI once it is running", func() {
+				vm, vmi := newHibernatingVM(v1.HibernationResuming, true)
+				applyHibernationState(vm, vmi)
+				createClaim()
+
+				vm, handled, err := controller.syncHibernation(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(handled).To(BeFalse())
+				Expect(vm.Status.Hibernation).To(BeNil())
+				testutils.ExpectEvent(recorder, ResumedReason)
+
+				pvc, err := getClaim()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pvc.OwnerReferences).To(HaveExactElements(*metav1.NewControllerRef(vmi, v1.VirtualMachineInstanceGroupVersionKind)))
+			})
+		})
+
+		Context("with workload classes", func() {
+			var subresourceRequests []string
+
+			newVM := func(name, workloadClass, priorityClassName string, memory string) (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+				vm, vmi := watchtesting.DefaultVirtualMachineWithNames(true, name, name)
+				vmi.UID = types.UID(name)
+				vmi.Spec.WorkloadClass = workloadClass
+				vmi.Spec.PriorityClassName = priorityClassName
+				vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse(memory)}
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				addVirtualMachine(vm)
+				return vm, vmi
+			}
+
+			newPod := func(vmi *v1.VirtualMachineInstance, memory string) *k8sv1.Pod {
+				pod := &k8sv1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "virt-launcher-" + vmi.Name,
+						Namespace:       vmi.Namespace,
+						OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(vmi, v1.VirtualMachineInstanceGroupVersionKind)},
+					},
+					Spec: k8sv1.PodSpec{
+						NodeName: vmi.Status.NodeName,
+						Containers: []k8sv1.Container{{
+							Name: "compute",
+							Resources: k8sv1.ResourceRequirements{
+								Requests: k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse(memory)},
+							},
+						}},
+					},
+				}
+				Expect(controller.podIndexer.Add(pod)).To(Succeed())
+				return pod
+			}
+
+			addVictim := func(name, workloadClass, memory string) *v1.VirtualMachine {
+				vm, vmi := newVM(name, workloadClass, "low", memory)
+				vmi.Status.NodeName = "node01"
+				Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+				newPod(vmi, memory)
+				return vm
+			}
+
+			newUnschedulableVM := func(unschedulableSince time.Time) (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+				vm, vmi := newVM("interactive", "interactive", "high", "1Gi")
+				vmi.Status.Phase = v1.Scheduling
+				vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+					Type:               v1.VirtualMachineInstanceConditionType(k8sv1.PodScheduled),
+					Status:             k8sv1.ConditionFalse,
+					Reason:             k8sv1.PodReasonUnschedulable,
+					LastTransitionTime: metav1.NewTime(unschedulableSince),
+				}}
+				newPod(vmi, "1Gi")
+				return vm, vmi
+			}
+
+			getPreemption := func(vm *v1.VirtualMachine) *v1.VirtualMachinePreemption {
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				return vm.Status.Preemption
+			}
+
+			BeforeEach(func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							WorkloadClasses: []v1.WorkloadClass{
+								{Name: "batch", PriorityClassName: "low", PreemptionAction: v1.WorkloadPreemptionHibernate},
+								{Name: "scratch", PriorityClassName: "low", PreemptionAction: v1.WorkloadPreemptionStop},
+								{Name: "service", PriorityClassName: "low"},
+								{Name: "interactive", PriorityClassName: "high"},
+							},
+						},
+					},
+				})
+				for name, value := range map[string]int32{"low": 100, "high": 1000} {
+					Expect(controller.priorityClassStore.Add(&schedulingv1.PriorityClass{
+						ObjectMeta: metav1.ObjectMeta{Name: name},
+						Value:      value,
+					})).To(Succeed())
+				}
+				Expect(controller.nodeStore.Add(&k8sv1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "node01",
+						Labels: map[string]string{"zone": "a"},
+					},
+				})).To(Succeed())
+
+				subresourceRequests = nil
+				virtFakeClient.PrependReactor("put", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					subresourceRequests = append(subresourceRequests, action.GetResource().Resource+"/"+action.GetSubresource()+"/"+action.(interface{ GetName() string }).GetName())
+					return true, nil, nil
+				})
+			})
+
+			DescribeTable("should preempt a VM of a lower priority once the VMI is unschedulable", func(workloadClass string, action v1.WorkloadPreemptionAction, expectedRequest string) {
+				victim := addVictim("victim", workloadClass, "1Gi")
+				vm, vmi := newUnschedulableVM(time.Now().Add(-time.Minute))
+
+				_, err := controller.syncPreemption(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(subresourceRequests).To(HaveExactElements(expectedRequest))
+				Expect(getPreemption(victim)).To(gstruct.PointTo(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+					"Action":      Equal(action),
+					"PreemptedBy": Equal("default/interactive"),
+				})))
+				testutils.ExpectEvents(recorder, PreemptedReason, PreemptedVMReason)
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(BeZero())
+			},
+				Entry("by hibernating it", "batch", v1.WorkloadPreemptionHibernate, "virtualmachines/hibernate/victim"),
+				Entry("by stopping it", "scratch", v1.WorkloadPreemptionStop, "virtualmachines/stop/victim"),
+			)
+
+			It("should wait for the VMI to be unschedulable for a while", func() {
+				addVictim("victim", "batch", "1Gi")
+				vm, vmi := newUnschedulableVM(time.Now())
+
+				_, err := controller.syncPreemption(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(subresourceRequests).To(BeEmpty())
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+			})
+
+			It("should not preempt another VM once a VM was preempted for the VMI", func() {
+				preempted := addVictim("preempted", "batch", "1Gi")
+				preempted.Status.Preemption = &v1.VirtualMachinePreemption{
+					Action:      v1.WorkloadPreemptionHibernate,
+					PreemptedBy: "default/interactive",
+					Time:        metav1.NewTime(time.Now().Add(-time.Hour)),
+				}
+				Expect(controller.vmIndexer.Update(preempted)).To(Succeed())
+				addVictim("victim", "batch", "1Gi")
+				vm, vmi := newUnschedulableVM(time.Now().Add(-time.Hour))
+
+				_, err := controller.syncPreemption(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(subresourceRequests).To(BeEmpty())
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(BeZero())
+			})
+
+			It("should only preempt a VM releasing enough resources for the unschedulable VMI", func() {
+				addVictim("small", "batch", "512Mi")
+				addVictim("large", "batch", "2Gi")
+				vm, vmi := newUnschedulableVM(time.Now().Add(-time.Minute))
+
+				_, err := controller.syncPreemption(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(subresourceRequests).To(HaveExactElements("virtualmachines/hibernate/large"))
+			})
+
+			It("should not preempt a VM releasing too little resources for the unschedulable VMI", func() {
+				addVictim("small", "batch", "512Mi")
+				vm, vmi := newUnschedulableVM(time.Now().Add(-time.Minute))
+
+				_, err := controller.syncPreemption(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(subresourceRequests).To(BeEmpty())
+			})
+
+			DescribeTable("should not preempt VMs on nodes the unschedulable VMI can not run on", func(updatePod func(*k8sv1.Pod), taints []k8sv1.Taint) {
+				addVictim("victim", "batch", "1Gi")
+				obj, _, err := controller.nodeStore.GetByKey("node01")
+				Expect(err).ToNot(HaveOccurred())
+				node := obj.(*k8sv1.Node)
+				node.Spec.Taints = taints
+				Expect(controller.nodeStore.Update(node)).To(Succeed())
+				vm, vmi := newUnschedulableVM(time.Now().Add(-time.Minute))
+				obj, _, err = controller.podIndexer.GetByKey("default/virt-launcher-interactive")
+				Expect(err).ToNot(HaveOccurred())
+				updatePod(obj.(*k8sv1.Pod))
+
+				_, err = controller.syncPreemption(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(subresourceRequests).To(BeEmpty())
+			},
+				Entry("with a node selector not matching the node", func(pod *k8sv1.Pod) {
+					pod.Spec.NodeSelector = map[string]string{"zone": "b"}
+				}, nil),
+				Entry("with a required node affinity not matching the node", func(pod *k8sv1.Pod) {
+					pod.Spec.Affinity = &k8sv1.Affinity{NodeAffinity: &k8sv1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+							NodeSelectorTerms: []k8sv1.NodeSelectorTerm{{
+								MatchExpressions: []k8sv1.NodeSelectorRequirement{{
+									Key:      "zone",
+									Operator: k8sv1.NodeSelectorOpNotIn,
+									Values:   []string{"a"},
+								}},
+							}},
+						},
+					}}
+				}, nil),
+				Entry("with a taint of the node not tolerated", func(_ *k8sv1.Pod) {},
+					[]k8sv1.Taint{{Key: "dedicated", Value: "gpu", Effect: k8sv1.TaintEffectNoSchedule}}),
+			)
+
+			It("should preempt VMs on nodes whose taints the unschedulable VMI tolerates", func() {
+				addVictim("victim", "batch", "1Gi")
+				obj, _, err := controller.nodeStore.GetByKey("node01")
+				Expect(err).ToNot(HaveOccurred())
+				node := obj.(*k8sv1.Node)
+				node.Spec.Taints = []k8sv1.Taint{{Key: "dedicated", Value: "gpu", Effect: k8sv1.TaintEffectNoSchedule}}
+				Expect(controller.nodeStore.Update(node)).To(Succeed())
+				vm, vmi := newUnschedulableVM(time.Now().Add(-time.Minute))
+				obj, _, err = controller.podIndexer.GetByKey("default/virt-launcher-interactive")
+				Expect(err).ToNot(HaveOccurred())
+				obj.(*k8sv1.Pod).Spec.Tolerations = []k8sv1.Toleration{{Key: "dedicated", Operator: k8sv1.TolerationOpExists}}
+
+				_, err = controller.syncPreemption(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(subresourceRequests).To(HaveExactElements("virtualmachines/hibernate/victim"))
+			})
+
+			It("should not preempt VMs whose class does not allow it", func() {
+				addVictim("victim", "service", "1Gi")
+				vm, vmi := newUnschedulableVM(time.Now().Add(-time.Minute))
+
+				_, err := controller.syncPreemption(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(subresourceRequests).To(BeEmpty())
+			})
+
+			It("should not preempt VMs of the same priority", func() {
+				_, victimVMI := newVM("victim", "interactive", "high", "1Gi")
+				Expect(controller.vmiIndexer.Add(victimVMI)).To(Succeed())
+				vm, vmi := newUnschedulableVM(time.Now().Add(-time.Minute))
+
+				_, err := controller.syncPreemption(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(subresourceRequests).To(BeEmpty())
+			})
+
+			It("should clear the preemption once the VM was resumed", func() {
+				vm, vmi := newVM("victim", "batch", "low", "1Gi")
+				vm.Status.Preemption = &v1.VirtualMachinePreemption{
+					Action:      v1.WorkloadPreemptionHibernate,
+					PreemptedBy: "default/interactive",
+					Time:        metav1.Now(),
+				}
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).UpdateStatus(context.TODO(), vm, metav1.UpdateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				vm, err = controller.syncPreemption(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vm.Status.Preemption).To(BeNil())
+				Expect(getPreemption(vm)).To(BeNil())
+			})
+
+			DescribeTable("should clear the preemption of a stopped VM once it runs again", func(preemptedSince time.Duration, phase v1.VirtualMachineInstancePhase, cleared bool) {
+				vm, vmi := newVM("victim", "scratch", "low", "1Gi")
+				vmi.Status.Phase = phase
+				vm.Status.Preemption = &v1.VirtualMachinePreemption{
+					Action:      v1.WorkloadPreemptionStop,
+					PreemptedBy: "default/interactive",
+					Time:        metav1.NewTime(time.Now().Add(-preemptedSince)),
+				}
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).UpdateStatus(context.TODO(), vm, metav1.UpdateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				vm, err = controller.syncPreemption(vm, vmi)
+				Expect(err).ToNot(HaveOccurred())
+				if cleared {
+					Expect(getPreemption(vm)).To(BeNil())
+				} else {
+					Expect(getPreemption(vm)).ToNot(BeNil())
+				}
+			},
+				Entry("not while the stop request is being handled", time.Second, v1.Running, false),
+				Entry("not while it is starting", time.Hour, v1.Scheduling, false),
+				Entry("once it is running", time.Hour, v1.Running, true),
+			)
+		})
+
 		It("should delete VirtualMachineInstance when stopped", func() {
 			vm, vmi := watchtesting.DefaultVirtualMachine(false)
 
@@ -4887,6 +5657,94 @@ var _ = Describe("VirtualMachine", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(vm).To(matcher.HaveConditionTrue(v1.VirtualMachineRestartRequired))
 				})
+
+				It("should patch VMI when CPU hot-unplug is requested", func() {
+					resources := v1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{
+							k8sv1.ResourceCPU: resource.MustParse("300m"),
+						},
+					}
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Resources = resources
+					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{
+						Sockets: 1,
+					}
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.CPU = &v1.CPU{
+						Sockets:    3,
+						MaxSockets: 4,
+					}
+					vmi.Spec.Domain.Resources = resources
+
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(controller.handleCPUChangeRequest(vm, vmi)).To(Succeed())
+
+					updatedVMI, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(updatedVMI.Spec.Domain.CPU.Sockets).To(Equal(uint32(1)))
+					Expect(updatedVMI.Spec.Domain.Resources.Requests.Cpu().Cmp(*vmi.Spec.Domain.Resources.Requests.Cpu())).To(Equal(-1))
+					Expect(vm.Status.Conditions).ToNot(ContainElement(HaveField("Type", v1.VirtualMachineRestartRequired)))
+				})
+
+				It("should require a restart to hot-unplug dedicated CPUs", func() {
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{
+						Sockets:               1,
+						DedicatedCPUPlacement: true,
+					}
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.CPU = &v1.CPU{
+						Sockets:               2,
+						MaxSockets:            4,
+						DedicatedCPUPlacement: true,
+					}
+
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(controller.handleCPUChangeRequest(vm, vmi)).To(Succeed())
+
+					Expect(vm).To(matcher.HaveConditionTrue(v1.VirtualMachineRestartRequired))
+					updatedVMI, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(updatedVMI.Spec.Domain.CPU.Sockets).To(Equal(uint32(2)))
+				})
+
+				It("should roll back the VMI when the guest refused the CPU hot-unplug", func() {
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{
+						Sockets: 1,
+					}
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.CPU = &v1.CPU{
+						Sockets:    1,
+						MaxSockets: 4,
+					}
+					vmi.Status.CurrentCPUTopology = &v1.CPUTopology{
+						Sockets: 3,
+						Cores:   1,
+						Threads: 1,
+					}
+					vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+						Type:   v1.VirtualMachineInstanceVCPUChange,
+						Status: k8sv1.ConditionFalse,
+					}}
+
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(controller.handleCPUChangeRequest(vm, vmi)).To(Succeed())
+
+					Expect(vm).To(matcher.HaveConditionTrue(v1.VirtualMachineRestartRequired))
+					updatedVMI, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(updatedVMI.Spec.Domain.CPU.Sockets).To(Equal(uint32(3)))
+				})
 			})
 
 			Context("Memory", func() {
@@ -5412,6 +6270,99 @@ var _ = Describe("VirtualMachine", func() {
 
 			})
 
+			Context("GPUs", func() {
+				const gpuResourceName = "nvidia.com/TU104GL_Tesla_T4"
+
+				newGPU := func(name string, display bool) v1.GPU {
+					return v1.GPU{
+						Name:       name,
+						DeviceName: gpuResourceName,
+						VirtualGPUOptions: &v1.VGPUOptions{
+							Display: &v1.VGPUDisplayOptions{Enabled: pointer.P(display)},
+						},
+					}
+				}
+
+				conditionStatus := func(status bool) k8sv1.ConditionStatus {
+					if status {
+						return k8sv1.ConditionTrue
+					}
+					return k8sv1.ConditionFalse
+				}
+
+				newRunningVMWithGPUs := func(vmGPUs, vmiGPUs []v1.GPU, migratable, agentConnected bool) (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+								MigrationConfiguration: &v1.MigrationConfiguration{
+									ReplugHostDevices: pointer.P(true),
+								},
+							},
+						},
+					})
+
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.GPUs = vmGPUs
+					vmi.Spec.Domain.Devices.GPUs = vmiGPUs
+
+					vmiCondManager := virtcontroller.NewVirtualMachineInstanceConditionManager()
+					vmiCondManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+						Type:   v1.VirtualMachineInstanceIsMigratable,
+						Status: conditionStatus(migratable),
+					})
+					vmiCondManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+						Type:   v1.VirtualMachineInstanceAgentConnected,
+						Status: conditionStatus(agentConnected),
+					})
+
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return vm, vmi
+				}
+
+				DescribeTable("should be live-updated", func(existingGPUs, updatedGPUs []v1.GPU) {
+					vm, vmi := newRunningVMWithGPUs(updatedGPUs, existingGPUs, true, true)
+
+					Expect(controller.handleGPUChangeRequest(vm, vmi)).To(Succeed())
+					Expect(vm.Status.Conditions).ToNot(ContainElement(HaveField("Type", v1.VirtualMachineRestartRequired)))
+
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.Domain.Devices.GPUs).To(Equal(updatedGPUs))
+				},
+					Entry("when adding a GPU to a VMI without GPUs", nil, []v1.GPU{newGPU("gpu1", false)}),
+					Entry("when adding a GPU", []v1.GPU{newGPU("gpu1", false)}, []v1.GPU{newGPU("gpu1", false), newGPU("gpu2", false)}),
+					Entry("when removing a GPU", []v1.GPU{newGPU("gpu1", false), newGPU("gpu2", false)}, []v1.GPU{newGPU("gpu1", false)}),
+				)
+
+				DescribeTable("should require a restart", func(gpu v1.GPU, migratable, agentConnected bool, expectedMessage string) {
+					vm, vmi := newRunningVMWithGPUs([]v1.GPU{gpu}, nil, migratable, agentConnected)
+
+					Expect(controller.handleGPUChangeRequest(vm, vmi)).To(Succeed())
+					Expect(vm).To(matcher.HaveConditionTrue(v1.VirtualMachineRestartRequired))
+					Expect(virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, v1.VirtualMachineRestartRequired).Message).
+						To(Equal(expectedMessage))
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+				},
+					Entry("when the GPU has a display", newGPU("gpu1", true), true, true,
+						"GPUs updated in template spec. GPUs with a display can't be hotplugged"),
+					Entry("when the VMI is not migratable", newGPU("gpu1", false), false, true,
+						"GPUs updated in template spec. GPU hotplug is only available for migratable VMs"),
+					Entry("when the guest agent is not connected", newGPU("gpu1", false), true, false,
+						"GPUs updated in template spec. GPU hotplug requires a connected guest agent"),
+				)
+
+				It("should not patch the VMI during a migration", func() {
+					vm, vmi := newRunningVMWithGPUs([]v1.GPU{newGPU("gpu1", false)}, nil, true, true)
+					vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+						StartTimestamp: pointer.P(metav1.Now()),
+					}
+
+					Expect(controller.handleGPUChangeRequest(vm, vmi)).To(MatchError("GPUs should not be changed during VMI migration"))
+				})
+			})
+
 			Context("Volumes", func() {
 				const (
 					diskName  = "disk0"
@@ -6000,6 +6951,127 @@ var _ = Describe("VirtualMachine", func() {
 			})
 		})
 
+		Context("Staged changes", func() {
+			var vm *v1.VirtualMachine
+			var vmi *v1.VirtualMachineInstance
+
+			BeforeEach(func() {
+				vm, _ = watchtesting.DefaultVirtualMachine(true)
+				vm.ObjectMeta.UID = types.UID(uuid.NewString())
+				vm.Generation = 1
+				vm.Spec.ChangeApplyStrategy = pointer.P(v1.ChangeApplyStrategyStaged)
+				vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{Sockets: 1, MaxSockets: 4}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							LiveUpdateConfiguration: &v1.LiveUpdateConfiguration{},
+							VMRolloutStrategy:       &liveUpdate,
+						},
+					},
+				})
+
+				vmi = controller.setupVMIFromVM(vm)
+				vmi.ObjectMeta.UID = vm.ObjectMeta.UID
+				watchtesting.MarkAsReady(vmi)
+				controller.vmiIndexer.Add(vmi)
+				_, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.TODO(), vmi, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				controller.crIndexer.Add(createVMRevision(vm))
+			})
+
+			createVM := func() {
+				var err error
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+			}
+
+			getVM := func() *v1.VirtualMachine {
+				updatedVM, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				return updatedVM
+			}
+
+			getVMISockets := func() uint32 {
+				updatedVMI, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				return updatedVMI.Spec.Domain.CPU.Sockets
+			}
+
+			It("should stage a non-live-updatable change without the RestartRequired condition", func() {
+				vm.Spec.Template.Spec.Hostname = "b"
+				createVM()
+
+				sanityExecute(vm)
+
+				vm = getVM()
+				Expect(vm.Status.StagedChanges).To(Equal(&v1.VirtualMachineStagedChanges{
+					Fields:          []string{"spec.template.spec.hostname"},
+					RestartRequired: true,
+				}))
+				Expect(virtcontroller.NewVirtualMachineConditionManager().HasCondition(vm, v1.VirtualMachineRestartRequired)).To(BeFalse())
+			})
+
+			It("should stage a live-updatable change without updating the VMI", func() {
+				vm.Spec.Template.Spec.Domain.CPU.Sockets = 2
+				createVM()
+
+				sanityExecute(vm)
+
+				Expect(getVM().Status.StagedChanges).To(Equal(&v1.VirtualMachineStagedChanges{
+					Fields: []string{"spec.template.spec.domain.cpu.sockets"},
+				}))
+				Expect(getVMISockets()).To(Equal(uint32(1)))
+			})
+
+			It("should hotplug the staged changes when requested", func() {
+				vm.Spec.Template.Spec.Domain.CPU.Sockets = 2
+				vm.Status.StagedChanges = &v1.VirtualMachineStagedChanges{
+					Fields:      []string{"spec.template.spec.domain.cpu.sockets"},
+					ApplyMethod: v1.ApplyChangesMethodHotplug,
+				}
+				createVM()
+
+				sanityExecute(vm)
+
+				Expect(getVMISockets()).To(Equal(uint32(2)))
+				Expect(getVM().Status.StagedChanges.ApplyMethod).To(BeEmpty())
+				testutils.ExpectEvent(recorder, StagedChangesAppliedReason)
+			})
+
+			It("should live migrate the VMI to apply the staged changes when requested", func() {
+				vm.Spec.Template.Spec.Domain.CPU.Sockets = 2
+				vm.Status.StagedChanges = &v1.VirtualMachineStagedChanges{
+					Fields:      []string{"spec.template.spec.domain.cpu.sockets"},
+					ApplyMethod: v1.ApplyChangesMethodLiveMigrate,
+				}
+				createVM()
+				virtClient.EXPECT().VirtualMachineInstanceMigration(vm.Namespace).Return(
+					virtFakeClient.KubevirtV1().VirtualMachineInstanceMigrations(vm.Namespace),
+				).Times(1)
+
+				sanityExecute(vm)
+
+				migrations, err := virtFakeClient.KubevirtV1().VirtualMachineInstanceMigrations(vm.Namespace).List(context.TODO(), metav1.ListOptions{})
+				Expect(err).To(Succeed())
+				Expect(migrations.Items).To(HaveLen(1))
+				Expect(migrations.Items[0].Spec.VMIName).To(Equal(vmi.Name))
+				testutils.ExpectEvent(recorder, StagedChangesAppliedReason)
+			})
+
+			It("should clear the staged changes when the VM does not stage its changes anymore", func() {
+				vm.Spec.ChangeApplyStrategy = nil
+				vm.Status.StagedChanges = &v1.VirtualMachineStagedChanges{
+					Fields: []string{"spec.template.spec.hostname"},
+				}
+				createVM()
+
+				sanityExecute(vm)
+
+				Expect(getVM().Status.StagedChanges).To(BeNil())
+			})
+		})
+
 		clearExpectations := func(vm *v1.VirtualMachine) {
 			//Clear all expectations
 			key, err := virtcontroller.KeyFunc(vm)
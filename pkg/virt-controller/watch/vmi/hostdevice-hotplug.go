@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmi
+
+import (
+	k8sv1 "k8s.io/api/core/v1"
+	virtv1 "kubevirt.io/api/core/v1"
+)
+
+// requireHostDeviceHotplug reports whether the GPUs and host devices of the VMI differ
+// from the ones requested by the compute container of its pod. Device plugin resources
+// of a running pod can not be changed, the VMI has to migrate to a pod requesting them.
+func (c *Controller) requireHostDeviceHotplug(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) bool {
+	if pod == nil {
+		return false
+	}
+
+	var computeContainer *k8sv1.Container
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == "compute" {
+			computeContainer = &pod.Spec.Containers[i]
+			break
+		}
+	}
+	if computeContainer == nil {
+		return false
+	}
+
+	requested := map[string]int64{}
+	for _, gpu := range vmi.Spec.Domain.Devices.GPUs {
+		if gpu.DeviceName != "" && gpu.ClaimRequest == nil {
+			requested[gpu.DeviceName]++
+		}
+	}
+	for _, hostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		if hostDevice.DeviceName != "" && hostDevice.ClaimRequest == nil {
+			requested[hostDevice.DeviceName]++
+		}
+	}
+
+	resourceNames := map[string]struct{}{}
+	for resourceName := range requested {
+		resourceNames[resourceName] = struct{}{}
+	}
+	// Removed devices are only noticed through the resources permitted in the cluster
+	if permittedHostDevices := c.clusterConfig.GetPermittedHostDevices(); permittedHostDevices != nil {
+		for _, pciHostDevice := range permittedHostDevices.PciHostDevices {
+			resourceNames[pciHostDevice.ResourceName] = struct{}{}
+		}
+		for _, mediatedHostDevice := range permittedHostDevices.MediatedDevices {
+			resourceNames[mediatedHostDevice.ResourceName] = struct{}{}
+		}
+		for _, usbHostDevice := range permittedHostDevices.USB {
+			resourceNames[usbHostDevice.ResourceName] = struct{}{}
+		}
+	}
+
+	for resourceName := range resourceNames {
+		allocated := int64(0)
+		if limit, exists := computeContainer.Resources.Limits[k8sv1.ResourceName(resourceName)]; exists {
+			allocated = limit.Value()
+		}
+		if requested[resourceName] != allocated {
+			return true
+		}
+	}
+	return false
+}
@@ -132,6 +132,10 @@ func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, da
 			return common.NewSyncError(fmt.Errorf("PVC pending"), controller.BackendStorageNotReadyReason), pod
 		}
 
+		if syncErr := c.syncSysprepVolumes(vmi); syncErr != nil {
+			return syncErr, pod
+		}
+
 		var templatePod *k8sv1.Pod
 		if isWaitForFirstConsumer {
 			log.Log.V(3).Object(vmi).Infof("Scheduling temporary pod for WaitForFirstConsumer DV")
@@ -443,7 +447,7 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 			c.syncVolumesUpdate(vmiCopy)
 		}
 
-		c.syncMigrationRequiredCondition(vmiCopy)
+		c.syncMigrationRequiredCondition(vmiCopy, pod)
 
 	case vmi.IsScheduled():
 		if !vmiPodExists {
@@ -1021,7 +1025,7 @@ func (c *Controller) syncMemoryHotplug(vmi *virtv1.VirtualMachineInstance) {
 	}
 }
 
-func (c *Controller) syncMigrationRequiredCondition(vmi *virtv1.VirtualMachineInstance) {
+func (c *Controller) syncMigrationRequiredCondition(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) {
 	const pendingMigrationReEvalPeriod = 10 * time.Second
 
 	if migrations.IsMigrating(vmi) {
@@ -1029,6 +1033,9 @@ func (c *Controller) syncMigrationRequiredCondition(vmi *virtv1.VirtualMachineIn
 	}
 
 	result := c.netMigrationEvaluator.Evaluate(vmi)
+	if c.requireHostDeviceHotplug(vmi, pod) {
+		result = k8sv1.ConditionTrue
+	}
 
 	cm := controller.NewVirtualMachineInstanceConditionManager()
 	existingCondition := cm.GetCondition(vmi, virtv1.VirtualMachineInstanceMigrationRequired)
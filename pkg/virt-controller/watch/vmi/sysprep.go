@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/config"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
+)
+
+// syncSysprepVolumes copies the ConfigMaps of other namespaces referenced by the Sysprep
+// volumes to the namespace of the VMI, for the virt-launcher pod to mount them.
+func (c *Controller) syncSysprepVolumes(vmi *virtv1.VirtualMachineInstance) common.SyncError {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Sysprep == nil || !config.SysprepNeedsCopy(vmi.Namespace, volume.Sysprep) {
+			continue
+		}
+		if err := c.copySysprepConfigMap(vmi, volume.Name, volume.Sysprep); err != nil {
+			c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, controller.FailedSysprepCopyReason, "Error copying the answer files of the Sysprep volume %s: %v", volume.Name, err)
+			return common.NewSyncError(fmt.Errorf("failed to copy the answer files of the Sysprep volume %s: %v", volume.Name, err), controller.FailedSysprepCopyReason)
+		}
+	}
+	return nil
+}
+
+func (c *Controller) copySysprepConfigMap(vmi *virtv1.VirtualMachineInstance, volumeName string, source *virtv1.SysprepSource) error {
+	if !slices.Contains(c.clusterConfig.GetSysprepTemplateNamespaces(), source.Namespace) {
+		return fmt.Errorf("namespace %s is not allowed as a source of Sysprep answer files", source.Namespace)
+	}
+	if source.ConfigMap == nil {
+		return fmt.Errorf("only ConfigMaps can be referenced from namespace %s", source.Namespace)
+	}
+	obj, exists, err := c.configMapStore.GetByKey(controller.NamespacedKey(source.Namespace, source.ConfigMap.Name))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("ConfigMap %s/%s not found", source.Namespace, source.ConfigMap.Name)
+	}
+	configMap := obj.(*k8sv1.ConfigMap)
+
+	configMapCopy := &k8sv1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      config.GetSysprepConfigMapCopyName(vmi.Name, volumeName),
+			Namespace: vmi.Namespace,
+			Labels: map[string]string{
+				virtv1.CreatedByLabel: string(vmi.UID),
+			},
+			OwnerReferences: []v1.OwnerReference{
+				*v1.NewControllerRef(vmi, virtv1.VirtualMachineInstanceGroupVersionKind),
+			},
+		},
+		Data:       configMap.Data,
+		BinaryData: configMap.BinaryData,
+	}
+	_, err = c.clientset.CoreV1().ConfigMaps(vmi.Namespace).Create(context.Background(), configMapCopy, v1.CreateOptions{})
+	if !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	// The copy may be left over from a previous VMI with the same name, but never
+	// overwrite a ConfigMap which was not created by KubeVirt
+	obj, exists, err = c.configMapStore.GetByKey(controller.NamespacedKey(vmi.Namespace, configMapCopy.Name))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("ConfigMap %s/%s already exists", vmi.Namespace, configMapCopy.Name)
+	}
+	existing := obj.(*k8sv1.ConfigMap)
+	if _, isCopy := existing.Labels[virtv1.CreatedByLabel]; !isCopy && !v1.IsControlledBy(existing, vmi) {
+		return fmt.Errorf("ConfigMap %s/%s already exists and is not managed by KubeVirt", vmi.Namespace, configMapCopy.Name)
+	}
+	configMapCopy.ResourceVersion = existing.ResourceVersion
+	_, err = c.clientset.CoreV1().ConfigMaps(vmi.Namespace).Update(context.Background(), configMapCopy, v1.UpdateOptions{})
+	return err
+}
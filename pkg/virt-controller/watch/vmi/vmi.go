@@ -65,6 +65,7 @@ func NewController(templateService services.TemplateService,
 	storageProfileInformer cache.SharedIndexInformer,
 	cdiInformer cache.SharedIndexInformer,
 	cdiConfigInformer cache.SharedIndexInformer,
+	configMapInformer cache.SharedIndexInformer,
 	clusterConfig *virtconfig.ClusterConfig,
 	topologyHinter topology.Hinter,
 	netAnnotationsGenerator annotationsGenerator,
@@ -92,6 +93,7 @@ func NewController(templateService services.TemplateService,
 		dataVolumeIndexer:       dataVolumeInformer.GetIndexer(),
 		cdiStore:                cdiInformer.GetStore(),
 		cdiConfigStore:          cdiConfigInformer.GetStore(),
+		configMapStore:          configMapInformer.GetStore(),
 		clusterConfig:           clusterConfig,
 		topologyHinter:          topologyHinter,
 		cidsMap:                 vsock.NewCIDsMap(),
@@ -105,7 +107,8 @@ func NewController(templateService services.TemplateService,
 	c.hasSynced = func() bool {
 		return vmInformer.HasSynced() && vmiInformer.HasSynced() && podInformer.HasSynced() &&
 			dataVolumeInformer.HasSynced() && cdiConfigInformer.HasSynced() && cdiInformer.HasSynced() &&
-			pvcInformer.HasSynced() && storageClassInformer.HasSynced() && storageProfileInformer.HasSynced()
+			pvcInformer.HasSynced() && storageClassInformer.HasSynced() && storageProfileInformer.HasSynced() &&
+			configMapInformer.HasSynced()
 	}
 
 	_, err := vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -198,6 +201,7 @@ type Controller struct {
 	dataVolumeIndexer       cache.Indexer
 	cdiStore                cache.Store
 	cdiConfigStore          cache.Store
+	configMapStore          cache.Store
 	clusterConfig           *virtconfig.ClusterConfig
 	cidsMap                 vsock.Allocator
 	backendStorage          *backendstorage.BackendStorage
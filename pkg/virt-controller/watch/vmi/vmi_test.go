@@ -78,6 +78,7 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 	var kubeClient *fake.Clientset
 	// We pass the store to backend storage and we don't have direct access
 	var storageClassStore, storageProfileStore cache.Store
+	var configMapStore cache.Store
 	var kvStore cache.Store
 
 	expectMatchingPodCreation := func(vmi *virtv1.VirtualMachineInstance, matchers ...gomegaTypes.GomegaMatcher) {
@@ -212,6 +213,8 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 		storageClassStore = storageClassInformer.GetStore()
 		cdiInformer, _ := testutils.NewFakeInformerFor(&cdiv1.CDIConfig{})
 		cdiConfigInformer, _ := testutils.NewFakeInformerFor(&cdiv1.CDIConfig{})
+		configMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
+		configMapStore = configMapInformer.GetStore()
 		rqInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
 		nsInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
 		var qemuGid int64 = 107
@@ -235,6 +238,7 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			storageProfileInformer,
 			cdiInformer,
 			cdiConfigInformer,
+			configMapInformer,
 			config,
 			topology.NewTopologyHinter(&cache.FakeCustomStore{}, &cache.FakeCustomStore{}, config),
 			stubNetworkAnnotationsGenerator{},
@@ -891,6 +895,151 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 		}))))
 	})
 
+	Context("On VirtualMachineInstance given with Sysprep answer files of another namespace", func() {
+		const goldenNamespace = "golden"
+
+		newSysprepVMI := func(sysprep *virtv1.SysprepSource) *virtv1.VirtualMachineInstance {
+			vmi := newPendingVirtualMachine("testvmi")
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, virtv1.Volume{
+				Name:         "sysprep",
+				VolumeSource: virtv1.VolumeSource{Sysprep: sysprep},
+			})
+			return vmi
+		}
+
+		addConfigMap := func(configMap *k8sv1.ConfigMap) {
+			_, err := kubeClient.CoreV1().ConfigMaps(configMap.Namespace).Create(context.Background(), configMap, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configMapStore.Add(configMap)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			addConfigMap(&k8sv1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "unattend", Namespace: goldenNamespace},
+				Data:       map[string]string{"autounattend.xml": "<ComputerName>{{ .Hostname }}</ComputerName>"},
+			})
+		})
+
+		allowGoldenNamespace := func() {
+			kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kvCR.Spec.Configuration.Sysprep = &virtv1.SysprepConfiguration{TemplateNamespaces: []string{goldenNamespace}}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+		}
+
+		It("should copy the ConfigMap of an allowed namespace before creating the pod", func() {
+			allowGoldenNamespace()
+			vmi := newSysprepVMI(&virtv1.SysprepSource{
+				ConfigMap: &k8sv1.LocalObjectReference{Name: "unattend"},
+				Namespace: goldenNamespace,
+				Template:  &virtv1.SysprepTemplate{},
+			})
+			addVirtualMachine(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, kvcontroller.SuccessfulCreatePodReason)
+			configMap, err := kubeClient.CoreV1().ConfigMaps(vmi.Namespace).Get(context.Background(), "testvmi-sysprep-sysprep", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configMap.OwnerReferences).To(ConsistOf(*metav1.NewControllerRef(vmi, virtv1.VirtualMachineInstanceGroupVersionKind)))
+			Expect(configMap.Data).To(HaveKeyWithValue("autounattend.xml", "<ComputerName>{{ .Hostname }}</ComputerName>"))
+			expectMatchingPodCreation(vmi, WithTransform(
+				func(pod *k8sv1.Pod) []k8sv1.Volume {
+					return pod.Spec.Volumes
+				},
+				ContainElement(k8sv1.Volume{
+					Name: "sysprep",
+					VolumeSource: k8sv1.VolumeSource{
+						ConfigMap: &k8sv1.ConfigMapVolumeSource{
+							LocalObjectReference: k8sv1.LocalObjectReference{Name: "testvmi-sysprep-sysprep"},
+						},
+					},
+				}),
+			))
+		})
+
+		It("should update a copy left over by a previous VMI with the same name", func() {
+			allowGoldenNamespace()
+			vmi := newSysprepVMI(&virtv1.SysprepSource{
+				ConfigMap: &k8sv1.LocalObjectReference{Name: "unattend"},
+				Namespace: goldenNamespace,
+				Template:  &virtv1.SysprepTemplate{},
+			})
+			addVirtualMachine(vmi)
+			addConfigMap(&k8sv1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "testvmi-sysprep-sysprep",
+					Namespace: vmi.Namespace,
+					Labels:    map[string]string{virtv1.CreatedByLabel: "previous-uid"},
+				},
+				Data: map[string]string{"autounattend.xml": "stale"},
+			})
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, kvcontroller.SuccessfulCreatePodReason)
+			configMap, err := kubeClient.CoreV1().ConfigMaps(vmi.Namespace).Get(context.Background(), "testvmi-sysprep-sysprep", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configMap.Labels).To(HaveKeyWithValue(virtv1.CreatedByLabel, string(vmi.UID)))
+			Expect(configMap.Data).To(HaveKeyWithValue("autounattend.xml", "<ComputerName>{{ .Hostname }}</ComputerName>"))
+		})
+
+		It("should not overwrite a ConfigMap which was not created by KubeVirt", func() {
+			allowGoldenNamespace()
+			vmi := newSysprepVMI(&virtv1.SysprepSource{
+				ConfigMap: &k8sv1.LocalObjectReference{Name: "unattend"},
+				Namespace: goldenNamespace,
+				Template:  &virtv1.SysprepTemplate{},
+			})
+			addVirtualMachine(vmi)
+			addConfigMap(&k8sv1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "testvmi-sysprep-sysprep", Namespace: vmi.Namespace},
+				Data:       map[string]string{"app.conf": "user data"},
+			})
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, kvcontroller.FailedSysprepCopyReason)
+			configMap, err := kubeClient.CoreV1().ConfigMaps(vmi.Namespace).Get(context.Background(), "testvmi-sysprep-sysprep", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configMap.Data).To(Equal(map[string]string{"app.conf": "user data"}))
+			pods, err := kubeClient.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pods.Items).To(BeEmpty())
+		})
+
+		DescribeTable("should not create the pod", func(allowNamespace bool, sysprep *virtv1.SysprepSource, expectedMessage string) {
+			if allowNamespace {
+				allowGoldenNamespace()
+			}
+			vmi := newSysprepVMI(sysprep)
+			addVirtualMachine(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, kvcontroller.FailedSysprepCopyReason)
+			expectVMIWithMatcherConditions(vmi.Namespace, vmi.Name, ContainElement(MatchFields(IgnoreExtras,
+				Fields{
+					"Type":    Equal(virtv1.VirtualMachineInstanceSynchronized),
+					"Status":  Equal(k8sv1.ConditionFalse),
+					"Reason":  Equal(kvcontroller.FailedSysprepCopyReason),
+					"Message": ContainSubstring(expectedMessage),
+				})),
+			)
+			pods, err := kubeClient.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pods.Items).To(BeEmpty())
+		},
+			Entry("if the namespace is not allowed", false, &virtv1.SysprepSource{
+				ConfigMap: &k8sv1.LocalObjectReference{Name: "unattend"},
+				Namespace: goldenNamespace,
+			}, "namespace golden is not allowed"),
+			Entry("if a Secret is referenced", true, &virtv1.SysprepSource{
+				Secret:    &k8sv1.LocalObjectReference{Name: "unattend"},
+				Namespace: goldenNamespace,
+			}, "only ConfigMaps can be referenced"),
+		)
+	})
+
 	Context("On valid VirtualMachineInstance given", func() {
 		It("should create a corresponding Pod on VirtualMachineInstance creation with proper annotation", func() {
 			vmi := newPendingVirtualMachine("testvmi")
@@ -3857,6 +4006,11 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 	})
 
 	Context("Automatic Migration Requirement", func() {
+		const (
+			usbResourceName = "kubevirt.io/storage"
+			gpuResourceName = "nvidia.com/TU104GL_Tesla_T4"
+		)
+
 		noConditionMatcher := Not(ContainElement(MatchFields(IgnoreExtras,
 			Fields{
 				"Type": Equal(virtv1.VirtualMachineInstanceMigrationRequired),
@@ -3957,6 +4111,60 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 				noConditionMatcher,
 			),
 		)
+
+		DescribeTable("Host device hotplug", func(gpus []virtv1.GPU, hostDevices []virtv1.HostDevice, podLimit int64, matcher gomegaTypes.GomegaMatcher) {
+			kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kvCR.Spec.Configuration.PermittedHostDevices = &virtv1.PermittedHostDevices{
+				USB: []virtv1.USBHostDevice{{ResourceName: usbResourceName}},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+
+			vmi := newPendingVirtualMachine("testvmi")
+			vmi.Status.Phase = virtv1.Running
+			vmi.Spec.Domain.Devices.GPUs = gpus
+			vmi.Spec.Domain.Devices.HostDevices = hostDevices
+
+			resourceName := usbResourceName
+			if len(gpus) > 0 {
+				resourceName = gpus[0].DeviceName
+			}
+			pod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			if podLimit > 0 {
+				pod.Spec.Containers = append(pod.Spec.Containers, k8sv1.Container{
+					Name: "compute",
+					Resources: k8sv1.ResourceRequirements{
+						Limits: k8sv1.ResourceList{
+							k8sv1.ResourceName(resourceName): *resource.NewQuantity(podLimit, resource.DecimalSI),
+						},
+					},
+				})
+			}
+
+			addVirtualMachine(vmi)
+			addPod(pod)
+			addActivePods(vmi, pod.UID, "")
+
+			controller.netMigrationEvaluator = stubMigrationEvaluator{result: k8sv1.ConditionUnknown}
+			sanityExecute()
+
+			expectVMIWithMatcherConditions(vmi.Namespace, vmi.Name, matcher)
+		},
+			Entry("should require a migration when a USB device was added", nil,
+				[]virtv1.HostDevice{{Name: "usb1", DeviceName: usbResourceName}, {Name: "usb2", DeviceName: usbResourceName}},
+				int64(1), trueConditionMatcher),
+			Entry("should require a migration when a USB device was removed", nil,
+				[]virtv1.HostDevice{{Name: "usb1", DeviceName: usbResourceName}},
+				int64(2), trueConditionMatcher),
+			Entry("should not require a migration when the pod requests the USB devices", nil,
+				[]virtv1.HostDevice{{Name: "usb1", DeviceName: usbResourceName}},
+				int64(1), noConditionMatcher),
+			Entry("should require a migration when a GPU was added",
+				[]virtv1.GPU{{Name: "gpu1", DeviceName: gpuResourceName}, {Name: "gpu2", DeviceName: gpuResourceName}}, nil,
+				int64(1), trueConditionMatcher),
+			Entry("should not require a migration when the pod requests the GPUs",
+				[]virtv1.GPU{{Name: "gpu1", DeviceName: gpuResourceName}}, nil,
+				int64(1), noConditionMatcher),
+		)
 	})
 })
 
@@ -0,0 +1,289 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+	watchdogutil "kubevirt.io/kubevirt/pkg/util/watchdog"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	// SuccessfulCreateWatchdogMigrationReason is added in an event when a migration was created for an expired watchdog
+	SuccessfulCreateWatchdogMigrationReason = "SuccessfulCreateWatchdogMigration"
+	// SuccessfulCreateWatchdogSnapshotReason is added in an event when a snapshot was created for an expired watchdog
+	SuccessfulCreateWatchdogSnapshotReason = "SuccessfulCreateWatchdogSnapshot"
+	// WatchdogResetReason is added in an event when the VMI was reset for an expired watchdog
+	WatchdogResetReason = "WatchdogReset"
+	// FailedWatchdogActionReason is added in an event when the action of an expired watchdog could not be taken
+	FailedWatchdogActionReason = "FailedWatchdogAction"
+
+	// how often the snapshot is looked at until it is done
+	snapshotPollInterval = 5 * time.Second
+)
+
+// Controller takes the actions of the expired watchdogs of VirtualMachineInstances which are handled
+// by KubeVirt, rather than by the hypervisor
+type Controller struct {
+	Client kubecli.KubevirtClient
+
+	VMIInformer       cache.SharedIndexInformer
+	MigrationInformer cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+// Init initializes the watchdog controller
+func (c *Controller) Init() error {
+	c.queue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-watchdog"},
+	)
+
+	_, err := c.VMIInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleVMI,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleVMI(newObj) },
+		},
+	)
+	return err
+}
+
+// Run the controller
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Log.Info("Starting watchdog controller.")
+	defer log.Log.Info("Shutting down watchdog controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		c.VMIInformer.HasSynced,
+		c.MigrationInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (c *Controller) runWorker() {
+	for c.processWorkItem() {
+	}
+}
+
+func (c *Controller) processWorkItem() bool {
+	return watchutil.ProcessWorkItem(c.queue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("watchdog worker processing key [%s]", key)
+
+		storeObj, exists, err := c.VMIInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		vmi, ok := storeObj.(*virtv1.VirtualMachineInstance)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+
+		return c.sync(vmi)
+	})
+}
+
+func (c *Controller) handleVMI(obj interface{}) {
+	vmi, ok := obj.(*virtv1.VirtualMachineInstance)
+	if !ok || pendingCondition(vmi) == nil {
+		return
+	}
+	key, err := controller.KeyFunc(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("failed to extract key from vmi")
+		return
+	}
+	c.queue.Add(key)
+}
+
+// pendingCondition returns the WatchdogExpired condition of the VMI if its action is yet to be taken
+func pendingCondition(vmi *virtv1.VirtualMachineInstance) *virtv1.VirtualMachineInstanceCondition {
+	condition := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, virtv1.VirtualMachineInstanceWatchdogExpired)
+	if condition == nil || condition.Status != k8sv1.ConditionTrue || condition.Reason != virtv1.VirtualMachineInstanceReasonWatchdogActionPending {
+		return nil
+	}
+	return condition
+}
+
+func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance) (time.Duration, error) {
+	condition := pendingCondition(vmi)
+	if condition == nil || vmi.IsFinal() || vmi.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	switch watchdogutil.Action(vmi) {
+	case virtv1.WatchdogActionMigrate:
+		if err := c.migrate(vmi); err != nil {
+			return 0, err
+		}
+	case virtv1.WatchdogActionSnapshotReset:
+		done, err := c.snapshotAndReset(vmi, condition)
+		if err != nil {
+			return 0, err
+		}
+		if !done {
+			return snapshotPollInterval, nil
+		}
+	}
+
+	return 0, c.markActionTaken(vmi)
+}
+
+// migrate live migrates the VMI off its node, unless it is migrating already
+func (c *Controller) migrate(vmi *virtv1.VirtualMachineInstance) error {
+	migrating, err := migrations.ActiveMigrationExistsForVMI(c.MigrationInformer.GetIndexer(), vmi)
+	if err != nil || migrating {
+		return err
+	}
+	if !controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceIsMigratable, k8sv1.ConditionTrue) {
+		c.Recorder.Event(vmi, k8sv1.EventTypeWarning, FailedWatchdogActionReason, "The watchdog expired, but the VMI is not live migratable")
+		return nil
+	}
+
+	migration := &virtv1.VirtualMachineInstanceMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "kubevirt-watchdog-",
+		},
+		Spec: virtv1.VirtualMachineInstanceMigrationSpec{
+			VMIName: vmi.Name,
+		},
+	}
+	createdMigration, err := c.Client.VirtualMachineInstanceMigration(vmi.Namespace).Create(context.Background(), migration, metav1.CreateOptions{})
+	if err != nil {
+		c.Recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedWatchdogActionReason, "Error creating a Migration for the expired watchdog: %v", err)
+		return err
+	}
+	c.Recorder.Eventf(vmi, k8sv1.EventTypeNormal, SuccessfulCreateWatchdogMigrationReason, "Created Migration %s for the expired watchdog", createdMigration.Name)
+	return nil
+}
+
+// snapshotAndReset snapshots the VM of the VMI, then resets the VMI once the snapshot is done, whether it
+// succeeded or not. VMIs without VM are reset right away.
+func (c *Controller) snapshotAndReset(vmi *virtv1.VirtualMachineInstance, condition *virtv1.VirtualMachineInstanceCondition) (bool, error) {
+	if controllerRef := metav1.GetControllerOf(vmi); controllerRef != nil && controllerRef.Kind == virtv1.VirtualMachineGroupVersionKind.Kind {
+		done, err := c.snapshot(vmi, controllerRef.Name, condition.LastTransitionTime)
+		if err != nil || !done {
+			return false, err
+		}
+	}
+
+	if err := c.Client.VirtualMachineInstance(vmi.Namespace).Reset(context.Background(), vmi.Name); err != nil {
+		c.Recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedWatchdogActionReason, "Error resetting the VMI for the expired watchdog: %v", err)
+		return false, err
+	}
+	c.Recorder.Event(vmi, k8sv1.EventTypeNormal, WatchdogResetReason, "Reset the VMI for the expired watchdog")
+	return true, nil
+}
+
+// snapshot creates the snapshot of the VM for the expiry of the watchdog, and tells whether it is done
+func (c *Controller) snapshot(vmi *virtv1.VirtualMachineInstance, vmName string, expired metav1.Time) (bool, error) {
+	name := SnapshotName(vmName, expired)
+	snapshot, err := c.Client.VirtualMachineSnapshot(vmi.Namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		snapshot = &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: vmi.Namespace,
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotSpec{
+				Source: k8sv1.TypedLocalObjectReference{
+					APIGroup: &virtv1.SchemeGroupVersion.Group,
+					Kind:     virtv1.VirtualMachineGroupVersionKind.Kind,
+					Name:     vmName,
+				},
+			},
+		}
+		if _, err := c.Client.VirtualMachineSnapshot(vmi.Namespace).Create(context.Background(), snapshot, metav1.CreateOptions{}); err != nil && !k8serrors.IsAlreadyExists(err) {
+			c.Recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedWatchdogActionReason, "Error creating a VirtualMachineSnapshot for the expired watchdog: %v", err)
+			return false, err
+		}
+		c.Recorder.Eventf(vmi, k8sv1.EventTypeNormal, SuccessfulCreateWatchdogSnapshotReason, "Created VirtualMachineSnapshot %s for the expired watchdog", name)
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	if snapshot.Status == nil {
+		return false, nil
+	}
+	return snapshot.Status.Phase == snapshotv1.Succeeded || snapshot.Status.Phase == snapshotv1.Failed, nil
+}
+
+// SnapshotName returns the name of the snapshot of the VM for the expiry of its watchdog
+func SnapshotName(vmName string, expired metav1.Time) string {
+	return fmt.Sprintf("%s-watchdog-%d", vmName, expired.Unix())
+}
+
+func (c *Controller) markActionTaken(vmi *virtv1.VirtualMachineInstance) error {
+	conditions := make([]virtv1.VirtualMachineInstanceCondition, 0, len(vmi.Status.Conditions))
+	for _, condition := range vmi.Status.Conditions {
+		if condition.Type == virtv1.VirtualMachineInstanceWatchdogExpired {
+			condition.Reason = virtv1.VirtualMachineInstanceReasonWatchdogActionTaken
+		}
+		conditions = append(conditions, condition)
+	}
+
+	patchBytes, err := patch.New(
+		patch.WithTest("/status/conditions", vmi.Status.Conditions),
+		patch.WithReplace("/status/conditions", conditions),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.Client.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
@@ -0,0 +1,244 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package watchdog
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Watchdog controller", func() {
+	const vmName = "testvm"
+
+	var (
+		expired = metav1.NewTime(time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC))
+
+		controller        *Controller
+		vmiInterface      *kubecli.MockVirtualMachineInstanceInterface
+		vmiInformer       cache.SharedIndexInformer
+		migrationInformer cache.SharedIndexInformer
+		recorder          *record.FakeRecorder
+		kubevirtClient    *kubevirtfake.Clientset
+		createdMigrations []*virtv1.VirtualMachineInstanceMigration
+	)
+
+	newVMI := func(action virtv1.WatchdogAction, reason string) *virtv1.VirtualMachineInstance {
+		vmi := libvmi.New(
+			libvmi.WithName("testvmi"),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithPhase(virtv1.Running),
+				libvmistatus.WithCondition(virtv1.VirtualMachineInstanceCondition{
+					Type:   virtv1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionTrue,
+				}),
+				libvmistatus.WithCondition(virtv1.VirtualMachineInstanceCondition{
+					Type:               virtv1.VirtualMachineInstanceWatchdogExpired,
+					Status:             k8sv1.ConditionTrue,
+					Reason:             reason,
+					LastTransitionTime: expired,
+				}),
+			)),
+		)
+		vmi.Spec.Domain.Devices.Watchdog = &virtv1.Watchdog{
+			Name: "watchdog",
+			WatchdogDevice: virtv1.WatchdogDevice{
+				I6300ESB: &virtv1.I6300ESBWatchdog{Action: action},
+			},
+		}
+		vmi.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: virtv1.GroupVersion.String(),
+			Kind:       virtv1.VirtualMachineGroupVersionKind.Kind,
+			Name:       vmName,
+			Controller: pointer.P(true),
+		}}
+		return vmi
+	}
+
+	expectActionTaken := func(vmi *virtv1.VirtualMachineInstance) {
+		vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), metav1.PatchOptions{}).
+			DoAndReturn(func(_ context.Context, _ string, _ types.PatchType, data []byte, _ metav1.PatchOptions, _ ...string) (*virtv1.VirtualMachineInstance, error) {
+				Expect(string(data)).To(ContainSubstring(`"reason":"` + virtv1.VirtualMachineInstanceReasonWatchdogActionTaken + `"`))
+				return vmi, nil
+			})
+	}
+
+	setSnapshotPhase := func(phase snapshotv1.VirtualMachineSnapshotPhase) {
+		snapshots := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault)
+		snapshot, err := snapshots.Get(context.Background(), SnapshotName(vmName, expired), metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		snapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{Phase: phase}
+		_, err = snapshots.Update(context.Background(), snapshot, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstanceMigration(metav1.NamespaceDefault).
+			Return(kubevirtClient.KubevirtV1().VirtualMachineInstanceMigrations(metav1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshot(metav1.NamespaceDefault).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault)).AnyTimes()
+
+		createdMigrations = nil
+		kubevirtClient.PrependReactor("create", "virtualmachineinstancemigrations", func(action testing.Action) (bool, runtime.Object, error) {
+			migration := action.(testing.CreateAction).GetObject().(*virtv1.VirtualMachineInstanceMigration)
+			migration.Name = migration.GenerateName + "abcde"
+			createdMigrations = append(createdMigrations, migration)
+			return true, migration, nil
+		})
+
+		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		migrationInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstanceMigration{})
+		recorder = record.NewFakeRecorder(100)
+
+		controller = &Controller{
+			Client:            virtClient,
+			VMIInformer:       vmiInformer,
+			MigrationInformer: migrationInformer,
+			Recorder:          recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+	})
+
+	Context("with the migrate action", func() {
+		It("should migrate the VMI", func() {
+			vmi := newVMI(virtv1.WatchdogActionMigrate, virtv1.VirtualMachineInstanceReasonWatchdogActionPending)
+			expectActionTaken(vmi)
+
+			requeue, err := controller.sync(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeue).To(BeZero())
+
+			Expect(createdMigrations).To(HaveLen(1))
+			Expect(createdMigrations[0].Spec.VMIName).To(Equal(vmi.Name))
+			testutils.ExpectEvent(recorder, SuccessfulCreateWatchdogMigrationReason)
+		})
+
+		It("should not migrate a VMI which is already migrating", func() {
+			vmi := newVMI(virtv1.WatchdogActionMigrate, virtv1.VirtualMachineInstanceReasonWatchdogActionPending)
+			Expect(migrationInformer.GetStore().Add(&virtv1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{Name: "migration", Namespace: metav1.NamespaceDefault},
+				Spec:       virtv1.VirtualMachineInstanceMigrationSpec{VMIName: vmi.Name},
+				Status:     virtv1.VirtualMachineInstanceMigrationStatus{Phase: virtv1.MigrationRunning},
+			})).To(Succeed())
+			expectActionTaken(vmi)
+
+			_, err := controller.sync(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(createdMigrations).To(BeEmpty())
+		})
+
+		It("should not migrate a VMI which is not live migratable", func() {
+			vmi := newVMI(virtv1.WatchdogActionMigrate, virtv1.VirtualMachineInstanceReasonWatchdogActionPending)
+			vmi.Status.Conditions[0].Status = k8sv1.ConditionFalse
+			expectActionTaken(vmi)
+
+			_, err := controller.sync(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(createdMigrations).To(BeEmpty())
+			testutils.ExpectEvent(recorder, FailedWatchdogActionReason)
+		})
+	})
+
+	Context("with the snapshot-reset action", func() {
+		It("should snapshot the VM, then reset the VMI once the snapshot is done", func() {
+			vmi := newVMI(virtv1.WatchdogActionSnapshotReset, virtv1.VirtualMachineInstanceReasonWatchdogActionPending)
+
+			requeue, err := controller.sync(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeue).To(Equal(snapshotPollInterval))
+			testutils.ExpectEvent(recorder, SuccessfulCreateWatchdogSnapshotReason)
+
+			snapshot, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault).
+				Get(context.Background(), SnapshotName(vmName, expired), metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(snapshot.Spec.Source.Kind).To(Equal(virtv1.VirtualMachineGroupVersionKind.Kind))
+			Expect(snapshot.Spec.Source.Name).To(Equal(vmName))
+
+			By("waiting while the snapshot is in progress")
+			setSnapshotPhase(snapshotv1.InProgress)
+			requeue, err = controller.sync(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeue).To(Equal(snapshotPollInterval))
+
+			By("resetting the VMI once the snapshot succeeded")
+			setSnapshotPhase(snapshotv1.Succeeded)
+			vmiInterface.EXPECT().Reset(context.Background(), vmi.Name).Return(nil)
+			expectActionTaken(vmi)
+			requeue, err = controller.sync(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeue).To(BeZero())
+			testutils.ExpectEvent(recorder, WatchdogResetReason)
+		})
+
+		It("should reset a VMI without VM right away", func() {
+			vmi := newVMI(virtv1.WatchdogActionSnapshotReset, virtv1.VirtualMachineInstanceReasonWatchdogActionPending)
+			vmi.OwnerReferences = nil
+			vmiInterface.EXPECT().Reset(context.Background(), vmi.Name).Return(nil)
+			expectActionTaken(vmi)
+
+			_, err := controller.sync(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			testutils.ExpectEvent(recorder, WatchdogResetReason)
+		})
+	})
+
+	It("should not act on a VMI whose watchdog action was taken", func() {
+		vmi := newVMI(virtv1.WatchdogActionMigrate, virtv1.VirtualMachineInstanceReasonWatchdogActionTaken)
+
+		_, err := controller.sync(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(createdMigrations).To(BeEmpty())
+	})
+
+	It("should only enqueue VMIs with a pending watchdog action", func() {
+		controller.handleVMI(newVMI(virtv1.WatchdogActionAlert, virtv1.VirtualMachineInstanceReasonWatchdogActionTaken))
+		Expect(controller.queue.Len()).To(BeZero())
+
+		controller.handleVMI(newVMI(virtv1.WatchdogActionMigrate, virtv1.VirtualMachineInstanceReasonWatchdogActionPending))
+		Expect(controller.queue.Len()).To(Equal(1))
+	})
+})
@@ -12,9 +12,12 @@ import (
 
 	k8sv1 "k8s.io/api/core/v1"
 	policy "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -31,6 +34,7 @@ import (
 	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	volumemig "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-migration"
+	"kubevirt.io/kubevirt/pkg/vmdisruption"
 )
 
 const (
@@ -68,6 +72,8 @@ type WorkloadUpdateController struct {
 	recorder              record.EventRecorder
 	migrationExpectations *controller.UIDTrackingControllerExpectations
 	kubeVirtStore         cache.Store
+	nodeStore             cache.Store
+	budgetIndexer         cache.Indexer
 	clusterConfig         *virtconfig.ClusterConfig
 	launcherImage         string
 
@@ -83,6 +89,19 @@ type updateData struct {
 	abortChangeVMIs        []*virtv1.VirtualMachineInstance
 
 	numActiveMigrations int
+
+	// numRunningVMIs is the number of running VMIs, the ones shutting down included
+	numRunningVMIs int
+	// numUpdatedVMIs is the number of running VMIs which are up to date
+	numUpdatedVMIs int
+	// numInProgressVMIs is the number of outdated VMIs which migrate or shut down
+	numInProgressVMIs int
+	// numPendingVMIs is the number of outdated VMIs whose update did not start yet
+	numPendingVMIs int
+	// numPendingCanaryVMIs is the number of the pending VMIs which run on canary nodes
+	numPendingCanaryVMIs int
+	// numOutdatedCanaryVMIs is the number of outdated VMIs which run on canary nodes
+	numOutdatedCanaryVMIs int
 }
 
 func NewWorkloadUpdateController(
@@ -91,6 +110,8 @@ func NewWorkloadUpdateController(
 	podInformer cache.SharedIndexInformer,
 	migrationInformer cache.SharedIndexInformer,
 	kubeVirtInformer cache.SharedIndexInformer,
+	nodeInformer cache.SharedIndexInformer,
+	vmDisruptionBudgetInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -110,13 +131,16 @@ func NewWorkloadUpdateController(
 		podIndexer:            podInformer.GetIndexer(),
 		migrationStore:        migrationInformer.GetStore(),
 		kubeVirtStore:         kubeVirtInformer.GetStore(),
+		nodeStore:             nodeInformer.GetStore(),
+		budgetIndexer:         vmDisruptionBudgetInformer.GetIndexer(),
 		recorder:              recorder,
 		clientset:             clientset,
 		launcherImage:         launcherImage,
 		migrationExpectations: controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		clusterConfig:         clusterConfig,
 		hasSynced: func() bool {
-			return migrationInformer.HasSynced() && vmiInformer.HasSynced() && podInformer.HasSynced() && kubeVirtInformer.HasSynced()
+			return migrationInformer.HasSynced() && vmiInformer.HasSynced() && podInformer.HasSynced() && kubeVirtInformer.HasSynced() &&
+				nodeInformer.HasSynced() && vmDisruptionBudgetInformer.HasSynced()
 		},
 	}
 
@@ -318,7 +342,7 @@ func (c *WorkloadUpdateController) isOutdated(vmi *virtv1.VirtualMachineInstance
 
 func isHotplugInProgress(vmi *virtv1.VirtualMachineInstance) bool {
 	condManager := controller.NewVirtualMachineInstanceConditionManager()
-	return condManager.HasCondition(vmi, virtv1.VirtualMachineInstanceVCPUChange) ||
+	return condManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceVCPUChange, k8sv1.ConditionTrue) ||
 		condManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceMemoryChange, k8sv1.ConditionTrue) ||
 		condManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceMigrationRequired, k8sv1.ConditionTrue)
 }
@@ -362,9 +386,31 @@ func (c *WorkloadUpdateController) shouldAbortMigration(vmi *virtv1.VirtualMachi
 	return numMig > 0
 }
 
-func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateData {
+// isOnCanaryNode returns whether the VMI runs on a node selected by the canary node selector
+func (c *WorkloadUpdateController) isOnCanaryNode(vmi *virtv1.VirtualMachineInstance, canarySelector labels.Selector) bool {
+	if canarySelector == nil || vmi.Status.NodeName == "" {
+		return false
+	}
+	obj, exists, err := c.nodeStore.GetByKey(vmi.Status.NodeName)
+	if err != nil || !exists {
+		return false
+	}
+	return canarySelector.Matches(labels.Set(obj.(*k8sv1.Node).Labels))
+}
+
+func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) (*updateData, error) {
 	data := &updateData{}
 
+	var canarySelector labels.Selector
+	if kv.Spec.WorkloadUpdateStrategy.CanaryNodeSelector != nil {
+		var err error
+		canarySelector, err = metav1.LabelSelectorAsSelector(kv.Spec.WorkloadUpdateStrategy.CanaryNodeSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid canary node selector: %v", err)
+		}
+	}
+	canaryVMIs := map[*virtv1.VirtualMachineInstance]bool{}
+
 	lookup := make(map[string]bool)
 
 	migrations := migrationutils.ListUnfinishedMigrations(c.migrationStore)
@@ -391,27 +437,49 @@ func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateDat
 	for _, obj := range objs {
 		vmi := obj.(*virtv1.VirtualMachineInstance)
 		switch {
-		case !vmi.IsRunning() || vmi.IsFinal() || vmi.DeletionTimestamp != nil:
+		case !vmi.IsRunning() || vmi.IsFinal():
+			continue
+		case vmi.DeletionTimestamp != nil:
 			// only consider running VMIs that aren't being shutdown
+			data.numRunningVMIs++
+			if c.isOutdated(vmi) {
+				data.numInProgressVMIs++
+			}
 			continue
 		case c.shouldAbortMigration(vmi) && !c.isOutdated(vmi):
+			data.numRunningVMIs++
+			data.numUpdatedVMIs++
 			data.abortChangeVMIs = append(data.abortChangeVMIs, vmi)
 			continue
 		case !c.isOutdated(vmi) && !c.doesRequireMigration(vmi):
+			data.numRunningVMIs++
+			data.numUpdatedVMIs++
 			continue
 		}
 
+		data.numRunningVMIs++
 		data.allOutdatedVMIs = append(data.allOutdatedVMIs, vmi)
+		canary := c.isOnCanaryNode(vmi, canarySelector)
+		if canary {
+			data.numOutdatedCanaryVMIs++
+		}
 
 		// don't consider VMIs with migrations inflight as migratable for our dataset
 		// while a migrating workload can still be counted towards
 		// the outDatedVMIs list, we don't want to add it to any
 		// of the lists that results in actions being performed on them
 		if migrationutils.IsMigrating(vmi) {
+			data.numInProgressVMIs++
 			continue
 		} else if exists := lookup[vmi.Namespace+"/"+vmi.Name]; exists {
+			data.numInProgressVMIs++
 			continue
 		}
+		data.numPendingVMIs++
+		if canary {
+			data.numPendingCanaryVMIs++
+			canaryVMIs[vmi] = true
+		}
 		volMig := false
 		errValid := volumemig.ValidateVolumesUpdateMigration(vmi, nil, vmi.Status.MigratedVolumes)
 		if len(vmi.Status.MigratedVolumes) > 0 && errValid == nil {
@@ -424,7 +492,47 @@ func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateDat
 		}
 	}
 
-	return data
+	// The VMIs of the other nodes wait until the canary nodes are updated
+	if data.numOutdatedCanaryVMIs > 0 {
+		data.migratableOutdatedVMIs = filterVMIs(data.migratableOutdatedVMIs, canaryVMIs)
+		data.evictOutdatedVMIs = filterVMIs(data.evictOutdatedVMIs, canaryVMIs)
+	}
+
+	return data, nil
+}
+
+func filterVMIs(vmis []*virtv1.VirtualMachineInstance, keep map[*virtv1.VirtualMachineInstance]bool) []*virtv1.VirtualMachineInstance {
+	var filtered []*virtv1.VirtualMachineInstance
+	for _, vmi := range vmis {
+		if keep[vmi] {
+			filtered = append(filtered, vmi)
+		}
+	}
+	return filtered
+}
+
+// workloadUpdateStatus returns the progress of the rollout, or nil when the automated workload updates are disabled
+func workloadUpdateStatus(kv *virtv1.KubeVirt, data *updateData) *virtv1.KubeVirtWorkloadUpdateStatus {
+	if len(kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods) == 0 {
+		return nil
+	}
+	status := &virtv1.KubeVirtWorkloadUpdateStatus{
+		UpdatedVirtualMachineInstances:       int32(data.numUpdatedVMIs),
+		InProgressVirtualMachineInstances:    int32(data.numInProgressVMIs),
+		PendingVirtualMachineInstances:       int32(data.numPendingVMIs),
+		PendingCanaryVirtualMachineInstances: int32(data.numPendingCanaryVMIs),
+	}
+	switch {
+	case len(data.allOutdatedVMIs) == 0 && data.numInProgressVMIs == 0:
+		status.Phase = virtv1.WorkloadUpdatePhaseCompleted
+	case kv.Spec.WorkloadUpdateStrategy.Paused:
+		status.Phase = virtv1.WorkloadUpdatePhasePaused
+	case data.numOutdatedCanaryVMIs > 0:
+		status.Phase = virtv1.WorkloadUpdatePhaseCanary
+	default:
+		status.Phase = virtv1.WorkloadUpdatePhaseProgressing
+	}
+	return status
 }
 
 func (c *WorkloadUpdateController) execute(key string) error {
@@ -458,7 +566,10 @@ func (c *WorkloadUpdateController) execute(key string) error {
 
 func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 
-	data := c.getUpdateData(kv)
+	data, err := c.getUpdateData(kv)
+	if err != nil {
+		return err
+	}
 
 	key, err := controller.KeyFunc(kv)
 	if err != nil {
@@ -467,12 +578,13 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 
 	metrics.SetOutdatedVirtualMachineInstanceWorkloads(len(data.allOutdatedVMIs))
 
+	patchSet := patch.New()
+
 	// update outdated workload count on kv
 	if kv.Status.OutdatedVirtualMachineInstanceWorkloads == nil || *kv.Status.OutdatedVirtualMachineInstanceWorkloads != len(data.allOutdatedVMIs) {
 		l := len(data.allOutdatedVMIs)
 		kvCopy := kv.DeepCopy()
 		kvCopy.Status.OutdatedVirtualMachineInstanceWorkloads = &l
-		patchSet := patch.New()
 		if kv.Status.OutdatedVirtualMachineInstanceWorkloads == nil {
 			patchSet.AddOption(patch.WithAdd("/status/outdatedVirtualMachineInstanceWorkloads", kvCopy.Status.OutdatedVirtualMachineInstanceWorkloads))
 		} else {
@@ -481,16 +593,42 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 				patch.WithReplace("/status/outdatedVirtualMachineInstanceWorkloads", kvCopy.Status.OutdatedVirtualMachineInstanceWorkloads),
 			)
 		}
+	}
+
+	// update the rollout progress on kv
+	if status := workloadUpdateStatus(kv, data); !equality.Semantic.DeepEqual(kv.Status.WorkloadUpdate, status) {
+		if kv.Status.WorkloadUpdate == nil {
+			patchSet.AddOption(patch.WithAdd("/status/workloadUpdate", status))
+		} else if status == nil {
+			patchSet.AddOption(
+				patch.WithTest("/status/workloadUpdate", kv.Status.WorkloadUpdate),
+				patch.WithRemove("/status/workloadUpdate"),
+			)
+		} else {
+			patchSet.AddOption(
+				patch.WithTest("/status/workloadUpdate", kv.Status.WorkloadUpdate),
+				patch.WithReplace("/status/workloadUpdate", status),
+			)
+		}
+	}
+
+	if !patchSet.IsEmpty() {
 		patchBytes, err := patchSet.GeneratePayload()
 		if err != nil {
 			return err
 		}
 		_, err = c.clientset.KubeVirt(kv.Namespace).PatchStatus(context.Background(), kv.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
 		if err != nil {
-			return fmt.Errorf("unable to patch kubevirt obj status to update the outdatedVirtualMachineInstanceWorkloads valued: %v", err)
+			return fmt.Errorf("unable to patch kubevirt obj status to update the workload update progress: %v", err)
 		}
 	}
 
+	// A paused rollout starts no further updates, unpausing it updates the KubeVirt CR which re-enqueues it
+	if kv.Spec.WorkloadUpdateStrategy.Paused {
+		data.migratableOutdatedVMIs = nil
+		data.evictOutdatedVMIs = nil
+	}
+
 	// Rather than enqueing based on VMI activity, we keep periodically poping the loop
 	// until all VMIs are updated. Watching all VMI activity is chatty for this controller
 	// when we don't need to be that efficent in how quickly the updates are being processed.
@@ -515,14 +653,14 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 		batchDeletionInterval = kv.Spec.WorkloadUpdateStrategy.BatchEvictionInterval.Duration
 	}
 
-	now := time.Now()
-
-	nextBatch := c.lastDeletionBatch.Add(batchDeletionInterval)
-	if now.After(nextBatch) && len(data.evictOutdatedVMIs) > 0 {
-		batchDeletionCount = int(math.Min(float64(batchDeletionCount), float64(len(data.evictOutdatedVMIs))))
-		c.lastDeletionBatch = now
-	} else {
-		batchDeletionCount = 0
+	// maxUnavailable bounds the VMIs updated at the same time, the ones in progress included
+	available := math.MaxInt
+	if maxUnavailable := kv.Spec.WorkloadUpdateStrategy.MaxUnavailable; maxUnavailable != nil {
+		value, err := intstr.GetScaledValueFromIntOrPercent(maxUnavailable, data.numRunningVMIs, true)
+		if err != nil {
+			return fmt.Errorf("invalid maxUnavailable of the workload update strategy: %v", err)
+		}
+		available = max(0, value-data.numInProgressVMIs)
 	}
 
 	// This is a best effort attempt at not creating a bunch of pending migrations
@@ -536,16 +674,32 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 	if maxNewMigrations < 0 {
 		maxNewMigrations = 0
 	}
+	maxNewMigrations = min(maxNewMigrations, available)
+
+	// The VMIs held back by their disruption budgets are retried on the periodic re-enqueue
+	disruptions := vmdisruption.NewDisruptions(c.clientset, c.budgetIndexer)
 
 	migrateCount := int(math.Min(float64(maxNewMigrations), float64(len(data.migratableOutdatedVMIs))))
-	var migrationCandidates []*virtv1.VirtualMachineInstance
-	if migrateCount > 0 {
-		migrationCandidates = data.migratableOutdatedVMIs[0:migrateCount]
+	migrationCandidates, err := disruptions.AllowUpTo(data.migratableOutdatedVMIs, migrateCount)
+	if err != nil {
+		return err
+	}
+	migrateCount = len(migrationCandidates)
+	available -= migrateCount
+
+	now := time.Now()
+
+	nextBatch := c.lastDeletionBatch.Add(batchDeletionInterval)
+	if now.After(nextBatch) && len(data.evictOutdatedVMIs) > 0 && available > 0 {
+		batchDeletionCount = min(batchDeletionCount, len(data.evictOutdatedVMIs), available)
+		c.lastDeletionBatch = now
+	} else {
+		batchDeletionCount = 0
 	}
 
-	var evictionCandidates []*virtv1.VirtualMachineInstance
-	if batchDeletionCount > 0 {
-		evictionCandidates = data.evictOutdatedVMIs[0:batchDeletionCount]
+	evictionCandidates, err := disruptions.AllowUpTo(data.evictOutdatedVMIs, batchDeletionCount)
+	if err != nil {
+		return err
 	}
 
 	wgLen := len(migrationCandidates) + len(evictionCandidates) + len(data.abortChangeVMIs)
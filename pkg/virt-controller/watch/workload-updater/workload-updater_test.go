@@ -14,12 +14,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 
 	v1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 	"kubevirt.io/client-go/testing"
@@ -40,7 +42,9 @@ var _ = Describe("Workload Updater", func() {
 		fakeVirtClient *kubevirtfake.Clientset
 		kubeClient     *fake.Clientset
 
-		controller *WorkloadUpdateController
+		controller     *WorkloadUpdateController
+		nodeInformer   cache.SharedIndexInformer
+		budgetInformer cache.SharedIndexInformer
 
 		expectedImage string
 	)
@@ -50,6 +54,11 @@ var _ = Describe("Workload Updater", func() {
 		Expect(err).To(Not(HaveOccurred()))
 		controller.kubeVirtStore.Add(kv)
 		controller.queue.Add(key)
+		// the controller patches the status of the KubeVirt CR
+		err = fakeVirtClient.Tracker().Add(kv)
+		if !k8serrors.IsAlreadyExists(err) {
+			Expect(err).ToNot(HaveOccurred())
+		}
 	}
 
 	shouldExpectMultiplePodEvictions := func(evictionCount *int) {
@@ -94,12 +103,15 @@ var _ = Describe("Workload Updater", func() {
 		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
 
 		kubeVirtInformer, _ := testutils.NewFakeInformerFor(&v1.KubeVirt{})
+		nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
+		budgetInformer, _ = testutils.NewFakeInformerFor(&migrationsv1.VirtualMachineDisruptionBudget{})
 
-		controller, _ = NewWorkloadUpdateController(expectedImage, vmiInformer, podInformer, migrationInformer, kubeVirtInformer, recorder, virtClient, config)
+		controller, _ = NewWorkloadUpdateController(expectedImage, vmiInformer, podInformer, migrationInformer, kubeVirtInformer, nodeInformer, budgetInformer, recorder, virtClient, config)
 
 		// Set up mock client
 		virtClient.EXPECT().VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault)).AnyTimes()
 		virtClient.EXPECT().KubeVirt(k8sv1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineDisruptionBudget(k8sv1.NamespaceDefault).Return(fakeVirtClient.MigrationsV1alpha1().VirtualMachineDisruptionBudgets(k8sv1.NamespaceDefault)).AnyTimes()
 		kubeClient = fake.NewSimpleClientset()
 		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
 		virtClient.EXPECT().PolicyV1().Return(kubeClient.PolicyV1()).AnyTimes()
@@ -193,8 +205,6 @@ var _ = Describe("Workload Updater", func() {
 			kv := newKubeVirt(0)
 			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate, v1.WorkloadUpdateMethodEvict}
 			addKubeVirt(kv)
-			_, err = fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Create(context.Background(), kv, metav1.CreateOptions{})
-			Expect(err).ToNot(HaveOccurred())
 
 			evictionCount := 0
 			shouldExpectMultiplePodEvictions(&evictionCount)
@@ -494,6 +504,205 @@ var _ = Describe("Workload Updater", func() {
 
 	})
 
+	Context("disruption budgets", func() {
+		BeforeEach(func() {
+			budget := &migrationsv1.VirtualMachineDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "budget", Namespace: k8sv1.NamespaceDefault},
+				Spec: migrationsv1.VirtualMachineDisruptionBudgetSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				},
+				Status: migrationsv1.VirtualMachineDisruptionBudgetStatus{DisruptionsAllowed: 1},
+			}
+			Expect(budgetInformer.GetStore().Add(budget)).To(Succeed())
+			_, err := fakeVirtClient.MigrationsV1alpha1().VirtualMachineDisruptionBudgets(k8sv1.NamespaceDefault).Create(context.Background(), budget, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		addVMIs := func(isMigratable bool) {
+			for i := 0; i < 3; i++ {
+				vmi := newVirtualMachineInstance(fmt.Sprintf("testvm-%d", i), isMigratable, "madeup")
+				vmi.Labels = map[string]string{"app": "db"}
+				controller.vmiStore.Add(vmi)
+				controller.podIndexer.Add(newLauncherPodForVMI(vmi))
+			}
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 3)
+		}
+
+		It("should only migrate the VMIs allowed by their disruption budget", func() {
+			addVMIs(true)
+			kv := newKubeVirt(3)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate}
+			addKubeVirt(kv)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			migrations, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrations.Items).To(HaveLen(1))
+		})
+
+		It("should only evict the VMIs allowed by their disruption budget", func() {
+			addVMIs(false)
+			kv := newKubeVirt(3)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodEvict}
+			addKubeVirt(kv)
+
+			evictionCount := 0
+			shouldExpectMultiplePodEvictions(&evictionCount)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulEvictVirtualMachineInstanceReason)
+			Expect(evictionCount).To(Equal(1))
+
+			budget, err := fakeVirtClient.MigrationsV1alpha1().VirtualMachineDisruptionBudgets(k8sv1.NamespaceDefault).Get(context.Background(), "budget", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(budget.Status.DisruptionsAllowed).To(BeZero())
+			Expect(budget.Status.DisruptedVirtualMachineInstances).To(HaveLen(1))
+		})
+	})
+
+	Context("rollout controls", func() {
+		addVMIs := func(count int, nodeName string, image string) {
+			for i := 0; i < count; i++ {
+				vmi := newVirtualMachineInstance(fmt.Sprintf("testvm-%s-%s-%d", nodeName, image, i), true, image)
+				vmi.Status.NodeName = nodeName
+				controller.vmiStore.Add(vmi)
+				controller.podIndexer.Add(newLauncherPodForVMI(vmi))
+			}
+		}
+
+		newLiveMigrateKubeVirt := func(expectedNumOutdated int) *v1.KubeVirt {
+			kv := newKubeVirt(expectedNumOutdated)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate}
+			return kv
+		}
+
+		expectMigrations := func(count int) []v1.VirtualMachineInstanceMigration {
+			migrations, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrations.Items).To(HaveLen(count))
+			for range migrations.Items {
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			}
+			return migrations.Items
+		}
+
+		expectWorkloadUpdateStatus := func(status *v1.KubeVirtWorkloadUpdateStatus) {
+			kv, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Get(context.Background(), "test", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kv.Status.WorkloadUpdate).To(Equal(status))
+		}
+
+		It("should not start updates while paused", func() {
+			addVMIs(3, "node01", "madeup")
+			addVMIs(2, "node01", expectedImage)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 5)
+			kv := newLiveMigrateKubeVirt(3)
+			kv.Spec.WorkloadUpdateStrategy.Paused = true
+			addKubeVirt(kv)
+
+			sanityExecute()
+			expectMigrations(0)
+			expectWorkloadUpdateStatus(&v1.KubeVirtWorkloadUpdateStatus{
+				Phase:                          v1.WorkloadUpdatePhasePaused,
+				UpdatedVirtualMachineInstances: 2,
+				PendingVirtualMachineInstances: 3,
+			})
+		})
+
+		DescribeTable("should limit the VMIs updated at the same time to maxUnavailable", func(maxUnavailable intstr.IntOrString, expectedMigrations int) {
+			addVMIs(8, "node01", "madeup")
+			addVMIs(12, "node01", expectedImage)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 20)
+			controller.migrationStore.Add(newMigration("vmim-running", "testvm-node01-madeup-0", v1.MigrationRunning))
+			kv := newLiveMigrateKubeVirt(8)
+			kv.Spec.WorkloadUpdateStrategy.MaxUnavailable = &maxUnavailable
+			addKubeVirt(kv)
+
+			sanityExecute()
+			expectMigrations(expectedMigrations)
+			expectWorkloadUpdateStatus(&v1.KubeVirtWorkloadUpdateStatus{
+				Phase:                             v1.WorkloadUpdatePhaseProgressing,
+				UpdatedVirtualMachineInstances:    12,
+				InProgressVirtualMachineInstances: 1,
+				PendingVirtualMachineInstances:    7,
+			})
+		},
+			Entry("with a number", intstr.FromInt32(3), 2),
+			Entry("with a percentage rounded up", intstr.FromString("11%"), 2),
+			Entry("with the VMIs in progress exceeding it", intstr.FromInt32(1), 0),
+			Entry("with a limit above the parallel migrations", intstr.FromString("100%"), int(virtconfig.ParallelMigrationsPerClusterDefault)-1),
+		)
+
+		It("should only update the VMIs of the canary nodes until they are up to date", func() {
+			Expect(nodeInformer.GetStore().Add(&k8sv1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "canary01", Labels: map[string]string{"canary": "true"}},
+			})).To(Succeed())
+			Expect(nodeInformer.GetStore().Add(&k8sv1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node01"},
+			})).To(Succeed())
+			addVMIs(2, "canary01", "madeup")
+			addVMIs(3, "node01", "madeup")
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 5)
+			kv := newLiveMigrateKubeVirt(5)
+			kv.Spec.WorkloadUpdateStrategy.CanaryNodeSelector = &metav1.LabelSelector{
+				MatchLabels: map[string]string{"canary": "true"},
+			}
+			addKubeVirt(kv)
+
+			sanityExecute()
+			for _, migration := range expectMigrations(2) {
+				Expect(migration.Spec.VMIName).To(HavePrefix("testvm-canary01"))
+			}
+			expectWorkloadUpdateStatus(&v1.KubeVirtWorkloadUpdateStatus{
+				Phase:                                v1.WorkloadUpdatePhaseCanary,
+				PendingVirtualMachineInstances:       5,
+				PendingCanaryVirtualMachineInstances: 2,
+			})
+		})
+
+		It("should update the VMIs of the other nodes once the canary nodes are up to date", func() {
+			Expect(nodeInformer.GetStore().Add(&k8sv1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "canary01", Labels: map[string]string{"canary": "true"}},
+			})).To(Succeed())
+			addVMIs(2, "canary01", expectedImage)
+			addVMIs(3, "node01", "madeup")
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 5)
+			kv := newLiveMigrateKubeVirt(3)
+			kv.Spec.WorkloadUpdateStrategy.CanaryNodeSelector = &metav1.LabelSelector{
+				MatchLabels: map[string]string{"canary": "true"},
+			}
+			addKubeVirt(kv)
+
+			sanityExecute()
+			expectMigrations(3)
+			expectWorkloadUpdateStatus(&v1.KubeVirtWorkloadUpdateStatus{
+				Phase:                          v1.WorkloadUpdatePhaseProgressing,
+				UpdatedVirtualMachineInstances: 2,
+				PendingVirtualMachineInstances: 3,
+			})
+		})
+
+		It("should report a completed rollout when all VMIs are up to date", func() {
+			addVMIs(3, "node01", expectedImage)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 3)
+			kv := newLiveMigrateKubeVirt(0)
+			kv.Status.WorkloadUpdate = &v1.KubeVirtWorkloadUpdateStatus{
+				Phase:                             v1.WorkloadUpdatePhaseProgressing,
+				UpdatedVirtualMachineInstances:    2,
+				InProgressVirtualMachineInstances: 1,
+			}
+			addKubeVirt(kv)
+
+			sanityExecute()
+			expectMigrations(0)
+			expectWorkloadUpdateStatus(&v1.KubeVirtWorkloadUpdateStatus{
+				Phase:                          v1.WorkloadUpdatePhaseCompleted,
+				UpdatedVirtualMachineInstances: 3,
+			})
+		})
+	})
+
 	Context("LiveUpdate features", func() {
 		It("VMI needs to be migrated when memory hotplug is requested", func() {
 			condition := v1.VirtualMachineInstanceCondition{
@@ -562,8 +771,6 @@ var _ = Describe("Workload Updater", func() {
 			kv := newKubeVirt(0)
 			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate}
 			addKubeVirt(kv)
-			_, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Create(context.Background(), kv, metav1.CreateOptions{})
-			Expect(err).ToNot(HaveOccurred())
 		})
 
 		DescribeTable("should delete the migration", func(phase v1.VirtualMachineInstanceMigrationPhase) {
@@ -70,6 +70,9 @@ type Manager interface {
 
 	// Get list of threads attached to cgroup
 	GetCgroupThreads() ([]int, error)
+
+	// SetMemorySwap allows or prevents swapping out the memory of the cgroup
+	SetMemorySwap(allowed bool) error
 }
 
 // This is here so that mockgen would create a mock out of it. That way we would have a mocked runc manager.
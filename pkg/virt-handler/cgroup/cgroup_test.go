@@ -1,6 +1,9 @@
 package cgroup
 
 import (
+	"os"
+	"path/filepath"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	runc_cgroups "github.com/opencontainers/runc/libcontainer/cgroups"
@@ -175,4 +178,22 @@ var _ = Describe("cgroup manager", func() {
 			},
 		),
 	)
+
+	DescribeTable("ensure that memory swap is configured on v2", func(allowed bool, expectedSwapMax string) {
+		runc_cgroups.TestMode = true
+		DeferCleanup(func() { runc_cgroups.TestMode = false })
+
+		v2DirPath = GinkgoT().TempDir()
+		manager, err := newMockManager(V2)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(manager.SetMemorySwap(allowed)).To(Succeed())
+
+		swapMax, err := os.ReadFile(filepath.Join(v2DirPath, "memory.swap.max"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(swapMax)).To(Equal(expectedSwapMax))
+	},
+		Entry("when swap is allowed", true, "max"),
+		Entry("when swap is not allowed", false, "0"),
+	)
 })
@@ -22,6 +22,9 @@ import (
 	cgroupconsts "kubevirt.io/kubevirt/pkg/virt-handler/cgroup/constants"
 )
 
+// defaultSwappiness is the default swappiness of the kernel
+const defaultSwappiness = "60"
+
 type v1Manager struct {
 	runc_cgroups.Manager
 	controllerPaths          map[string]string
@@ -205,3 +208,19 @@ func (v *v1Manager) GetCgroupThreads() ([]int, error) {
 func (v *v1Manager) SetCpuSet(subcgroup string, cpulist []int) error {
 	return setCpuSetHelper(v, subcgroup, cpulist)
 }
+
+func (v *v1Manager) SetMemorySwap(allowed bool) error {
+	subSysPath, err := v.GetBasePathToHostSubsystem("memory")
+	if err != nil {
+		return err
+	}
+
+	// cgroup v1 has no swap limit independent of the memory limit, the swappiness
+	// of the cgroup is used instead.
+	swappiness := "0"
+	if allowed {
+		swappiness = defaultSwappiness
+	}
+
+	return runc_cgroups.WriteFile(subSysPath, "memory.swappiness", swappiness)
+}
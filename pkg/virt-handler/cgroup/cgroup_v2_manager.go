@@ -162,3 +162,12 @@ func (v *v2Manager) GetCgroupThreads() ([]int, error) {
 func (v *v2Manager) SetCpuSet(subcgroup string, cpulist []int) error {
 	return setCpuSetHelper(v, subcgroup, cpulist)
 }
+
+func (v *v2Manager) SetMemorySwap(allowed bool) error {
+	swapMax := "0"
+	if allowed {
+		swapMax = "max"
+	}
+
+	return runc_cgroups.WriteFile(v.dirPath, "memory.swap.max", swapMax)
+}
@@ -78,6 +78,10 @@ type MigrationOptions struct {
 	AllowPostCopy            bool
 	ParallelMigrationThreads *uint
 	AllowWorkloadDisruption  bool
+	Compression              string
+	Warm                     bool
+	Cutover                  bool
+	ReplugHostDevices        bool
 }
 
 type LauncherClient interface {
@@ -103,10 +107,14 @@ type LauncherClient interface {
 	GetUsers() (v1.VirtualMachineInstanceGuestOSUserList, error)
 	GetFilesystems() (v1.VirtualMachineInstanceFileSystemList, error)
 	Exec(string, string, []string, int32) (int, string, error)
+	GuestOSExec(string, string, []string, int32) (*v1.GuestOSExecResult, error)
+	GuestFileRead(string, string, int64) ([]byte, error)
+	GuestFileWrite(string, string, []byte) error
 	Ping() error
 	GuestPing(string, int32) error
 	Close()
 	VirtualMachineMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
+	VirtualMachineMemoryDumpStream(vmi *v1.VirtualMachineInstance, dumpPath string, options *v1.MemoryDumpStreamOptions) error
 	GetQemuVersion() (string, error)
 	SyncVirtualMachineCPUs(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 	GetSEVInfo() (*v1.SEVPlatformInfo, error)
@@ -446,6 +454,29 @@ func (c *VirtLauncherClient) VirtualMachineMemoryDump(vmi *v1.VirtualMachineInst
 	return err
 }
 
+// VirtualMachineMemoryDumpStream asks virt-launcher to dump the memory of the guest to a fifo at dumpPath.
+// It blocks until the dump is done, the dump is only bounded by the reader of the fifo.
+func (c *VirtLauncherClient) VirtualMachineMemoryDumpStream(vmi *v1.VirtualMachineInstance, dumpPath string, options *v1.MemoryDumpStreamOptions) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.MemoryDumpRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		DumpPath: dumpPath,
+		Format:   string(options.Format),
+		Content:  string(options.Content),
+		Stream:   true,
+	}
+
+	response, err := c.v1client.VirtualMachineMemoryDump(context.Background(), request)
+	err = handleError(err, "Memorydump", response)
+	return err
+}
+
 func (c *VirtLauncherClient) SoftRebootVirtualMachine(vmi *v1.VirtualMachineInstance) error {
 	return c.genericSendVMICmd("SoftReboot", c.v1client.SoftRebootVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
 }
@@ -746,6 +777,66 @@ func (c *VirtLauncherClient) Exec(domainName, command string, args []string, tim
 	return exitCode, stdOut, err
 }
 
+// GuestOSExec runs the command with args on the guest on behalf of a user and returns its exit code, stdout and stderr
+func (c *VirtLauncherClient) GuestOSExec(domainName, command string, args []string, timeoutSeconds int32) (*v1.GuestOSExecResult, error) {
+	request := &cmdv1.ExecRequest{
+		DomainName:     domainName,
+		Command:        command,
+		Args:           args,
+		TimeoutSeconds: timeoutSeconds,
+	}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		// we give the context a bit more time as the timeout should kick
+		// on the actual execution
+		time.Duration(timeoutSeconds)*time.Second+shortTimeout,
+	)
+	defer cancel()
+
+	resp, err := c.v1client.GuestOSExec(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GuestOSExecResult{
+		ExitCode: resp.ExitCode,
+		Stdout:   resp.StdOut,
+		Stderr:   resp.StdErr,
+	}, nil
+}
+
+func (c *VirtLauncherClient) GuestFileRead(domainName, path string, maxSize int64) ([]byte, error) {
+	request := &cmdv1.GuestFileRequest{
+		DomainName: domainName,
+		Path:       path,
+		MaxSize:    maxSize,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+
+	resp, err := c.v1client.GuestFileRead(ctx, request)
+	if err = handleError(err, "GuestFileRead", resp.GetResponse()); err != nil {
+		return nil, err
+	}
+
+	return resp.GetContent(), nil
+}
+
+func (c *VirtLauncherClient) GuestFileWrite(domainName, path string, content []byte) error {
+	request := &cmdv1.GuestFileRequest{
+		DomainName: domainName,
+		Path:       path,
+		Content:    content,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+
+	resp, err := c.v1client.GuestFileWrite(ctx, request)
+
+	return handleError(err, "GuestFileWrite", resp)
+}
+
 func (c *VirtLauncherClient) GuestPing(domainName string, timeoutSeconds int32) error {
 	request := &cmdv1.GuestPingRequest{
 		DomainName:     domainName,
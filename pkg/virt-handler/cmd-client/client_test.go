@@ -213,6 +213,67 @@ var _ = Describe("Virt remote commands", func() {
 				})
 				client.Exec(testDomainName, testCommand, testArgs, testTimeoutSeconds)
 			})
+			It("returns the result of cmdclient.GuestOSExec", func() {
+				mockCmdClient.EXPECT().GuestOSExec(gomock.Any(), &cmdv1.ExecRequest{
+					DomainName:     testDomainName,
+					Command:        testCommand,
+					Args:           testArgs,
+					TimeoutSeconds: testTimeoutSeconds,
+				}).Times(1).Return(&cmdv1.ExecResponse{
+					Response: &cmdv1.Response{Success: true},
+					ExitCode: 1,
+					StdOut:   testStdOut,
+					StdErr:   "stdErr",
+				}, nil)
+				result, err := client.GuestOSExec(testDomainName, testCommand, testArgs, testTimeoutSeconds)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.ExitCode).To(BeEquivalentTo(1))
+				Expect(result.Stdout).To(Equal(testStdOut))
+				Expect(result.Stderr).To(Equal("stdErr"))
+			})
+			It("returns cmdclient.GuestOSExec errors", func() {
+				mockCmdClient.EXPECT().GuestOSExec(gomock.Any(), gomock.Any()).Times(1).Return(&cmdv1.ExecResponse{}, testClientErr)
+				_, err := client.GuestOSExec(testDomainName, testCommand, testArgs, testTimeoutSeconds)
+				Expect(err).To(HaveOccurred())
+			})
+			It("returns the content of cmdclient.GuestFileRead", func() {
+				mockCmdClient.EXPECT().GuestFileRead(gomock.Any(), &cmdv1.GuestFileRequest{
+					DomainName: testDomainName,
+					Path:       "/etc/hostname",
+					MaxSize:    1024,
+				}).Times(1).Return(&cmdv1.GuestFileResponse{
+					Response: &cmdv1.Response{Success: true},
+					Content:  []byte("content"),
+				}, nil)
+				content, err := client.GuestFileRead(testDomainName, "/etc/hostname", 1024)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(content).To(Equal([]byte("content")))
+			})
+			It("returns cmdclient.GuestFileWrite failures", func() {
+				mockCmdClient.EXPECT().GuestFileWrite(gomock.Any(), &cmdv1.GuestFileRequest{
+					DomainName: testDomainName,
+					Path:       "/etc/hostname",
+					Content:    []byte("content"),
+				}).Times(1).Return(&cmdv1.Response{Success: false, Message: "permission denied"}, nil)
+				err := client.GuestFileWrite(testDomainName, "/etc/hostname", []byte("content"))
+				Expect(err).To(MatchError(ContainSubstring("permission denied")))
+			})
+			It("requests a streamed memory dump without deadline", func() {
+				mockCmdClient.EXPECT().VirtualMachineMemoryDump(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx context.Context, request *cmdv1.MemoryDumpRequest, _ ...grpc.CallOption) (*cmdv1.Response, error) {
+					_, ok := ctx.Deadline()
+					Expect(ok).To(BeFalse())
+					Expect(request.DumpPath).To(Equal("/dump"))
+					Expect(request.Format).To(Equal(string(v1.MemoryDumpFormatKdumpZlib)))
+					Expect(request.Content).To(Equal(string(v1.MemoryDumpContentFull)))
+					Expect(request.Stream).To(BeTrue())
+					return &cmdv1.Response{Success: true}, nil
+				})
+				err := client.VirtualMachineMemoryDumpStream(&v1.VirtualMachineInstance{}, "/dump", &v1.MemoryDumpStreamOptions{
+					Format:  v1.MemoryDumpFormatKdumpZlib,
+					Content: v1.MemoryDumpContentFull,
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
 			It("calls cmdclient.GuestPing", func() {
 				expectGuestPing().Times(1)
 				client.GuestPing(testDomainName, testTimeoutSeconds)
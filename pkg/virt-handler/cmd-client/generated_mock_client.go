@@ -265,6 +265,50 @@ func (mr *MockLauncherClientMockRecorder) GetUsers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockLauncherClient)(nil).GetUsers))
 }
 
+// GuestFileRead mocks base method.
+func (m *MockLauncherClient) GuestFileRead(arg0, arg1 string, arg2 int64) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileRead", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileRead indicates an expected call of GuestFileRead.
+func (mr *MockLauncherClientMockRecorder) GuestFileRead(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileRead", reflect.TypeOf((*MockLauncherClient)(nil).GuestFileRead), arg0, arg1, arg2)
+}
+
+// GuestFileWrite mocks base method.
+func (m *MockLauncherClient) GuestFileWrite(arg0, arg1 string, arg2 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileWrite", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestFileWrite indicates an expected call of GuestFileWrite.
+func (mr *MockLauncherClientMockRecorder) GuestFileWrite(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileWrite", reflect.TypeOf((*MockLauncherClient)(nil).GuestFileWrite), arg0, arg1, arg2)
+}
+
+// GuestOSExec mocks base method.
+func (m *MockLauncherClient) GuestOSExec(arg0, arg1 string, arg2 []string, arg3 int32) (*v1.GuestOSExecResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestOSExec", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*v1.GuestOSExecResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestOSExec indicates an expected call of GuestOSExec.
+func (mr *MockLauncherClientMockRecorder) GuestOSExec(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestOSExec", reflect.TypeOf((*MockLauncherClient)(nil).GuestOSExec), arg0, arg1, arg2, arg3)
+}
+
 // GuestPing mocks base method.
 func (m *MockLauncherClient) GuestPing(arg0 string, arg1 int32) error {
 	m.ctrl.T.Helper()
@@ -516,3 +560,17 @@ func (mr *MockLauncherClientMockRecorder) VirtualMachineMemoryDump(vmi, dumpPath
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineMemoryDump", reflect.TypeOf((*MockLauncherClient)(nil).VirtualMachineMemoryDump), vmi, dumpPath)
 }
+
+// VirtualMachineMemoryDumpStream mocks base method.
+func (m *MockLauncherClient) VirtualMachineMemoryDumpStream(vmi *v1.VirtualMachineInstance, dumpPath string, options *v1.MemoryDumpStreamOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineMemoryDumpStream", vmi, dumpPath, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VirtualMachineMemoryDumpStream indicates an expected call of VirtualMachineMemoryDumpStream.
+func (mr *MockLauncherClientMockRecorder) VirtualMachineMemoryDumpStream(vmi, dumpPath, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineMemoryDumpStream", reflect.TypeOf((*MockLauncherClient)(nil).VirtualMachineMemoryDumpStream), vmi, dumpPath, options)
+}
@@ -39,6 +39,7 @@ import (
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/safepath"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
@@ -76,6 +77,8 @@ const (
 	VMIAbortingMigration = "VirtualMachineInstance is aborting migration."
 	//VMIMigrating in the reason set when the VMI is migrating
 	VMIMigrating = "VirtualMachineInstance is migrating."
+	//VMIMigrationCutover is the reason set when the cutover of a warm migration is requested
+	VMIMigrationCutover = "VirtualMachineInstance warm migration is cutting over."
 	//VMIMigrationTargetPrepared is the reason set when the migration target has been prepared
 	VMIMigrationTargetPrepared = "VirtualMachineInstance Migration Target Prepared."
 	//VMIStopping is the reason set when the VMI is stopping
@@ -87,6 +90,9 @@ const (
 
 	// MemoryHotplugFailedReason is the reason set when the VM cannot hotplug memory
 	memoryHotplugFailedReason = "Memory Hotplug Failed"
+
+	// cpuHotUnplugFailedReason is the reason set when the guest refuses to release vCPUs
+	cpuHotUnplugFailedReason = "CPU Hot-Unplug Failed"
 )
 
 type netconf interface {
@@ -237,7 +243,17 @@ func (c *BaseController) configureSEVDeviceOwnership(vmi *v1.VirtualMachineInsta
 }
 
 func (c *BaseController) configureVirtioFS(vmi *v1.VirtualMachineInstance, isolationRes isolation.IsolationResult) error {
+	hotplugVolumes := map[string]struct{}{}
+	for i := range vmi.Spec.Volumes {
+		if storagetypes.IsHotplugVolume(&vmi.Spec.Volumes[i]) {
+			hotplugVolumes[vmi.Spec.Volumes[i].Name] = struct{}{}
+		}
+	}
 	for _, fs := range vmi.Spec.Domain.Devices.Filesystems {
+		if _, isHotplug := hotplugVolumes[fs.Name]; isHotplug {
+			// virt-launcher owns the sockets of hotplugged filesystems
+			continue
+		}
 		socketPath, err := isolation.SafeJoin(isolationRes, virtiofs.VirtioFSSocketPath(fs.Name))
 		if err != nil {
 			return err
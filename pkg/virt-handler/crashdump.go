@@ -0,0 +1,127 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"path/filepath"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// isGuestPanicked tells whether the guest of a VMI with a panic device crashed. The domain is kept
+// crashed if a crash dump is requested, otherwise it is destroyed right away.
+func isGuestPanicked(vmi *v1.VirtualMachineInstance, domain *api.Domain) bool {
+	if domain == nil || len(vmi.Spec.Domain.Devices.PanicDevices) == 0 {
+		return false
+	}
+	return domain.Status.Status == api.Crashed ||
+		(domain.Status.Status == api.Shutoff && domain.Status.Reason == api.ReasonCrashed)
+}
+
+// isCrashDumpInProgress tells whether the memory of the crashed guest is yet to be dumped, the VMI is
+// kept running until then
+func isCrashDumpInProgress(vmi *v1.VirtualMachineInstance, domain *api.Domain) bool {
+	if vmi.Spec.CrashDump == nil || domain == nil || domain.Status.Status != api.Crashed {
+		return false
+	}
+	condition := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, v1.VirtualMachineInstanceGuestPanicked)
+	return condition == nil || condition.Reason == v1.VirtualMachineInstanceReasonCrashDumpInProgress
+}
+
+// crashDumpTargetFile returns the file the memory of the guest is dumped to, named after the time the guest panicked
+func crashDumpTargetFile(vmiName, volName string, panicked metav1.Time) string {
+	return fmt.Sprintf("%s-%s-%s.memory.dump", vmiName, volName, panicked.Format("20060102-150405"))
+}
+
+func crashDumpPath(vmi *v1.VirtualMachineInstance, panicked metav1.Time) string {
+	volName := vmi.Spec.CrashDump.VolumeName
+	return filepath.Join(hostdisk.GetMountedHostDiskDir(volName), crashDumpTargetFile(vmi.Name, volName, panicked))
+}
+
+// updateGuestPanickedCondition reports that the guest panicked, and the progress of its crash dump
+func (c *VirtualMachineController) updateGuestPanickedCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceGuestPanicked)
+	if condition == nil {
+		if !isGuestPanicked(vmi, domain) {
+			return
+		}
+		reason := v1.VirtualMachineInstanceReasonGuestPanicked
+		message := "The guest panicked"
+		if isCrashDumpInProgress(vmi, domain) {
+			reason = v1.VirtualMachineInstanceReasonCrashDumpInProgress
+			message = fmt.Sprintf("The guest panicked, dumping its memory to volume %s", vmi.Spec.CrashDump.VolumeName)
+		}
+		vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+			Type:               v1.VirtualMachineInstanceGuestPanicked,
+			Status:             k8sv1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             reason,
+			Message:            message,
+		})
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, string(v1.VirtualMachineInstanceGuestPanicked), message)
+		return
+	}
+
+	if condition.Reason != v1.VirtualMachineInstanceReasonCrashDumpInProgress || domain == nil {
+		return
+	}
+	memoryDumpMetadata := domain.Spec.Metadata.KubeVirt.MemoryDump
+	targetFile := crashDumpTargetFile(vmi.Name, vmi.Spec.CrashDump.VolumeName, condition.LastTransitionTime)
+	if memoryDumpMetadata == nil || memoryDumpMetadata.FileName != targetFile || !memoryDumpMetadata.Completed {
+		return
+	}
+
+	if memoryDumpMetadata.Failed {
+		condition.Reason = v1.VirtualMachineInstanceReasonCrashDumpFailed
+		condition.Message = memoryDumpMetadata.FailureReason
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.VirtualMachineInstanceReasonCrashDumpFailed, memoryDumpMetadata.FailureReason)
+	} else {
+		condition.Reason = v1.VirtualMachineInstanceReasonCrashDumpCompleted
+		condition.Message = fmt.Sprintf("The memory of the guest was dumped to %s in volume %s", targetFile, vmi.Spec.CrashDump.VolumeName)
+		c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.VirtualMachineInstanceReasonCrashDumpCompleted, condition.Message)
+	}
+	condManager.UpdateCondition(vmi, condition)
+}
+
+// getCrashDump asks virt-launcher to dump the memory of the panicked guest, the dump is only taken once
+func (c *VirtualMachineController) getCrashDump(vmi *v1.VirtualMachineInstance) error {
+	condition := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, v1.VirtualMachineInstanceGuestPanicked)
+	if vmi.Spec.CrashDump == nil || condition == nil || condition.Reason != v1.VirtualMachineInstanceReasonCrashDumpInProgress {
+		return nil
+	}
+	client, err := c.launcherClients.GetVerifiedLauncherClient(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to get the crash dump: %v", err)
+	}
+
+	c.logger.V(3).Object(vmi).Info("sending crash dump command")
+	if err := client.VirtualMachineMemoryDump(vmi, crashDumpPath(vmi, condition.LastTransitionTime)); err != nil {
+		return fmt.Errorf("failed to get the crash dump: %v", err)
+	}
+	return nil
+}
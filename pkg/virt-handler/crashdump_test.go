@@ -0,0 +1,174 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	launcherclients "kubevirt.io/kubevirt/pkg/virt-handler/launcher-clients"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Crash dump", func() {
+	var (
+		controller  *VirtualMachineController
+		client      *cmdclient.MockLauncherClient
+		recorder    *record.FakeRecorder
+		condManager *virtcontroller.VirtualMachineInstanceConditionManager
+	)
+
+	newVMI := func(crashDump *v1.CrashDump) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(libvmi.WithName("testvmi"))
+		vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: pointer.P(v1.Pvpanic)}}
+		vmi.Spec.CrashDump = crashDump
+		return vmi
+	}
+
+	newDomain := func(status api.LifeCycle, reason api.StateChangeReason) *api.Domain {
+		domain := api.NewMinimalDomain("testvmi")
+		domain.Status.Status = status
+		domain.Status.Reason = reason
+		return domain
+	}
+
+	panickedCondition := func(vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstanceCondition {
+		return condManager.GetCondition(vmi, v1.VirtualMachineInstanceGuestPanicked)
+	}
+
+	BeforeEach(func() {
+		client = cmdclient.NewMockLauncherClient(gomock.NewController(GinkgoT()))
+		recorder = record.NewFakeRecorder(10)
+		condManager = virtcontroller.NewVirtualMachineInstanceConditionManager()
+		controller = &VirtualMachineController{
+			BaseController: &BaseController{
+				logger:          log.Log,
+				recorder:        recorder,
+				queue:           workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]()),
+				launcherClients: &launcherclients.MockLauncherClientManager{Client: client},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		controller.queue.ShutDown()
+	})
+
+	It("should report a panicked guest without crash dump", func() {
+		vmi := newVMI(nil)
+		domain := newDomain(api.Shutoff, api.ReasonCrashed)
+
+		controller.updateGuestPanickedCondition(vmi, domain, condManager)
+		Expect(panickedCondition(vmi)).ToNot(BeNil())
+		Expect(panickedCondition(vmi).Reason).To(Equal(v1.VirtualMachineInstanceReasonGuestPanicked))
+		Expect(isCrashDumpInProgress(vmi, domain)).To(BeFalse())
+		testutils.ExpectEvent(recorder, string(v1.VirtualMachineInstanceGuestPanicked))
+	})
+
+	It("should not report a crashed guest without panic device", func() {
+		vmi := newVMI(nil)
+		vmi.Spec.Domain.Devices.PanicDevices = nil
+
+		controller.updateGuestPanickedCondition(vmi, newDomain(api.Shutoff, api.ReasonCrashed), condManager)
+		Expect(panickedCondition(vmi)).To(BeNil())
+	})
+
+	It("should not report a running guest", func() {
+		vmi := newVMI(nil)
+
+		controller.updateGuestPanickedCondition(vmi, newDomain(api.Running, api.ReasonUnknown), condManager)
+		Expect(panickedCondition(vmi)).To(BeNil())
+	})
+
+	Context("with a crash dump", func() {
+		var (
+			vmi    *v1.VirtualMachineInstance
+			domain *api.Domain
+		)
+
+		BeforeEach(func() {
+			vmi = newVMI(&v1.CrashDump{VolumeName: "crash"})
+			domain = newDomain(api.Crashed, api.ReasonPanicked)
+			Expect(isCrashDumpInProgress(vmi, domain)).To(BeTrue())
+
+			controller.updateGuestPanickedCondition(vmi, domain, condManager)
+			Expect(panickedCondition(vmi).Reason).To(Equal(v1.VirtualMachineInstanceReasonCrashDumpInProgress))
+			testutils.ExpectEvent(recorder, string(v1.VirtualMachineInstanceGuestPanicked))
+		})
+
+		It("should dump the memory of the guest to the crash dump volume", func() {
+			panicked := panickedCondition(vmi).LastTransitionTime
+			client.EXPECT().VirtualMachineMemoryDump(vmi, gomock.Any()).DoAndReturn(func(_ *v1.VirtualMachineInstance, dumpPath string) error {
+				Expect(filepath.Base(dumpPath)).To(Equal(crashDumpTargetFile(vmi.Name, "crash", panicked)))
+				return nil
+			})
+			Expect(controller.getCrashDump(vmi)).To(Succeed())
+			Expect(isCrashDumpInProgress(vmi, domain)).To(BeTrue())
+
+			domain.Spec.Metadata.KubeVirt.MemoryDump = &api.MemoryDumpMetadata{
+				FileName:  crashDumpTargetFile(vmi.Name, "crash", panicked),
+				Completed: true,
+			}
+			controller.updateGuestPanickedCondition(vmi, domain, condManager)
+			Expect(panickedCondition(vmi).Reason).To(Equal(v1.VirtualMachineInstanceReasonCrashDumpCompleted))
+			Expect(panickedCondition(vmi).Status).To(Equal(k8sv1.ConditionTrue))
+			Expect(isCrashDumpInProgress(vmi, domain)).To(BeFalse())
+			testutils.ExpectEvent(recorder, v1.VirtualMachineInstanceReasonCrashDumpCompleted)
+		})
+
+		It("should report a failed crash dump", func() {
+			domain.Spec.Metadata.KubeVirt.MemoryDump = &api.MemoryDumpMetadata{
+				FileName:      crashDumpTargetFile(vmi.Name, "crash", panickedCondition(vmi).LastTransitionTime),
+				Completed:     true,
+				Failed:        true,
+				FailureReason: "guest memory 2Gi exceeds the crash dump max size 1Gi",
+			}
+			controller.updateGuestPanickedCondition(vmi, domain, condManager)
+			Expect(panickedCondition(vmi).Reason).To(Equal(v1.VirtualMachineInstanceReasonCrashDumpFailed))
+			Expect(panickedCondition(vmi).Message).To(ContainSubstring("exceeds the crash dump max size"))
+			Expect(isCrashDumpInProgress(vmi, domain)).To(BeFalse())
+			testutils.ExpectEvent(recorder, v1.VirtualMachineInstanceReasonCrashDumpFailed)
+		})
+
+		It("should ignore the metadata of another memory dump", func() {
+			domain.Spec.Metadata.KubeVirt.MemoryDump = &api.MemoryDumpMetadata{
+				FileName:  "testvmi-hotplug-20260101-000000.memory.dump",
+				Completed: true,
+			}
+			controller.updateGuestPanickedCondition(vmi, domain, condManager)
+			Expect(panickedCondition(vmi).Reason).To(Equal(v1.VirtualMachineInstanceReasonCrashDumpInProgress))
+		})
+	})
+})
@@ -30,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8scli "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
@@ -133,6 +134,8 @@ type DeviceController struct {
 	virtConfig          *virtconfig.ClusterConfig
 	stop                chan struct{}
 	mdevTypesManager    *MDEVTypesManager
+	mdevProfiles        map[string]string
+	mdevProfilesMutex   sync.Mutex
 	clientset           k8scli.CoreV1Interface
 }
 
@@ -202,7 +205,8 @@ func (c *DeviceController) updatePermittedHostDevicePlugins() []Device {
 
 	hostDevs := c.virtConfig.GetPermittedHostDevices()
 	if hostDevs == nil {
-		return permittedDevices
+		// The devices of the mediated device profiles don't need to be permitted
+		hostDevs = &v1.PermittedHostDevices{}
 	}
 
 	if len(hostDevs.PciHostDevices) != 0 {
@@ -223,18 +227,19 @@ func (c *DeviceController) updatePermittedHostDevicePlugins() []Device {
 			permittedDevices = append(permittedDevices, NewPCIDevicePlugin(pciDevices, pciResourceName))
 		}
 	}
-	if len(hostDevs.MediatedDevices) != 0 {
-		supportedMdevsMap := make(map[string]string)
-		for _, supportedMdev := range hostDevs.MediatedDevices {
-			log.Log.V(4).Infof("Permitted mediated device in the cluster, ID: %s, resourceName: %s",
-				supportedMdev.MDEVNameSelector,
-				supportedMdev.ResourceName)
-			// do not add a device plugin for this resource if it's being provided via an external device plugin
-			if !supportedMdev.ExternalResourceProvider {
-				selector := removeSelectorSpaces(supportedMdev.MDEVNameSelector)
-				supportedMdevsMap[selector] = supportedMdev.ResourceName
-			}
+	// The permitted mediated devices take precedence over the profiles selecting the same type
+	supportedMdevsMap := c.getMediatedDeviceProfiles()
+	for _, supportedMdev := range hostDevs.MediatedDevices {
+		log.Log.V(4).Infof("Permitted mediated device in the cluster, ID: %s, resourceName: %s",
+			supportedMdev.MDEVNameSelector,
+			supportedMdev.ResourceName)
+		// do not add a device plugin for this resource if it's being provided via an external device plugin
+		if !supportedMdev.ExternalResourceProvider {
+			selector := removeSelectorSpaces(supportedMdev.MDEVNameSelector)
+			supportedMdevsMap[selector] = supportedMdev.ResourceName
 		}
+	}
+	if len(supportedMdevsMap) != 0 {
 		for mdevTypeName, mdevUUIDs := range discoverPermittedHostMediatedDevices(supportedMdevsMap) {
 			mdevResourceName := supportedMdevsMap[mdevTypeName]
 			log.Log.V(4).Infof("Discovered mediated device on the node, type: %s, resourceName: %s", mdevTypeName, mdevResourceName)
@@ -302,6 +307,23 @@ func (c *DeviceController) getExternallyProvidedMdevs() map[string]struct{} {
 	return externalMdevResourcesMap
 }
 
+// getMediatedDeviceProfiles returns the resource names of the mediated device profiles of the node, by mediated device type
+func (c *DeviceController) getMediatedDeviceProfiles() map[string]string {
+	c.mdevProfilesMutex.Lock()
+	defer c.mdevProfilesMutex.Unlock()
+	profiles := make(map[string]string, len(c.mdevProfiles))
+	for mdevType, resourceName := range c.mdevProfiles {
+		profiles[removeSelectorSpaces(mdevType)] = resourceName
+	}
+	return profiles
+}
+
+func (c *DeviceController) setMediatedDeviceProfiles(profiles map[string]string) {
+	c.mdevProfilesMutex.Lock()
+	defer c.mdevProfilesMutex.Unlock()
+	c.mdevProfiles = profiles
+}
+
 func (c *DeviceController) refreshMediatedDeviceTypes() bool {
 	// the handling of mediated device is disabled
 	if c.virtConfig.MediatedDevicesHandlingDisabled() {
@@ -314,6 +336,7 @@ func (c *DeviceController) refreshMediatedDeviceTypes() bool {
 		return false
 	}
 	externallyProvidedMdevMap := c.getExternallyProvidedMdevs()
+	c.setMediatedDeviceProfiles(c.virtConfig.GetMediatedDeviceProfiles(node))
 
 	nodeDesiredMdevTypesList := c.virtConfig.GetDesiredMDEVTypes(node)
 	requiresDevicePluginsUpdate, err := c.mdevTypesManager.updateMDEVTypesConfiguration(nodeDesiredMdevTypesList, externallyProvidedMdevMap)
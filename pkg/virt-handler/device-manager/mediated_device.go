@@ -209,6 +209,14 @@ func discoverPermittedHostMediatedDevices(supportedMdevsMap map[string]string) m
 			log.DefaultLogger().Reason(err).Errorf("failed read type name for mdev: %s", info.Name())
 			continue
 		}
+		if _, supported := supportedMdevsMap[mdevTypeName]; !supported {
+			// mediated device profiles may select the type by ID
+			if mdevTypeID, err := getMdevTypeID(info.Name()); err == nil {
+				if _, supported := supportedMdevsMap[mdevTypeID]; supported {
+					mdevTypeName = mdevTypeID
+				}
+			}
+		}
 		if _, supported := supportedMdevsMap[mdevTypeName]; supported {
 
 			mdev := &MDEV{
@@ -326,6 +334,15 @@ func (dpi *MediatedDevicePlugin) HealthCheck() error {
 	}
 }
 
+func getMdevTypeID(mdevUUID string) (string, error) {
+	// #nosec No risk for path injection. Path is composed from static base  "mdevBasePath" and static components
+	originFile, err := os.Readlink(filepath.Join(mdevBasePath, mdevUUID, "mdev_type"))
+	if err != nil {
+		return "", err
+	}
+	return filepath.Base(originFile), nil
+}
+
 func getMdevTypeName(mdevUUID string) (string, error) {
 	// #nosec No risk for path injection. Path is composed from static base  "mdevBasePath" and static components
 	rawName, err := os.ReadFile(filepath.Join(mdevBasePath, mdevUUID, "mdev_type/name"))
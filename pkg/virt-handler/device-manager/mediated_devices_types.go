@@ -280,8 +280,10 @@ func removeUndesiredMDEVs(desiredTypesMap map[string]struct{}) {
 	}
 	for _, file := range files {
 		if shouldRemoveMDEV(file.Name(), desiredTypesMap) {
-			err = handler.RemoveMDEVType(file.Name())
-			log.Log.Reason(err).Warningf("failed to remove mdev type: %s", file.Name())
+			// mdevs still used by a VMI can't be removed, their removal is retried on the next refresh
+			if err := handler.RemoveMDEVType(file.Name()); err != nil {
+				log.Log.Reason(err).Warningf("failed to remove mdev type: %s, it may still be in use", file.Name())
+			}
 		}
 	}
 }
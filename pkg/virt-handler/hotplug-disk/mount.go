@@ -171,6 +171,11 @@ func NewVolumeMounter(mountStateDir string, kubeletPodsDir string, host string)
 	}
 }
 
+// hotplugLog returns the logger of the hotplug subcomponent
+func hotplugLog() *log.FilteredLogger {
+	return log.Log.Subcomponent(log.SubcomponentHotplug)
+}
+
 func (m *volumeMounter) deleteMountTargetRecord(vmi *v1.VirtualMachineInstance) error {
 	if string(vmi.UID) == "" {
 		return fmt.Errorf(unableFindHotplugMountedDir)
@@ -282,7 +287,7 @@ func (m *volumeMounter) mountHotplugVolume(
 	mountDirectory bool,
 	cgroupManager cgroup.Manager,
 ) error {
-	logger := log.DefaultLogger()
+	logger := hotplugLog()
 	logger.V(4).Infof("Hotplug check volume name: %s", volumeName)
 	if sourceUID != "" {
 		if m.isBlockVolume(&vmi.Status, volumeName) {
@@ -318,10 +323,7 @@ func (m *volumeMounter) mountFromPod(vmi *v1.VirtualMachineInstance, sourceUID t
 			// Skip non hotplug volumes
 			continue
 		}
-		mountDirectory := false
-		if volumeStatus.MemoryDumpVolume != nil {
-			mountDirectory = true
-		}
+		mountDirectory := m.isDirectoryMounted(vmi, volumeStatus.Name)
 		if sourceUID == "" {
 			sourceUID = volumeStatus.HotplugVolume.AttachPodUID
 		}
@@ -332,8 +334,15 @@ func (m *volumeMounter) mountFromPod(vmi *v1.VirtualMachineInstance, sourceUID t
 	return nil
 }
 
-func (m *volumeMounter) isDirectoryMounted(vmiStatus *v1.VirtualMachineInstanceStatus, volumeName string) bool {
-	for _, status := range vmiStatus.VolumeStatus {
+// isDirectoryMounted returns true if the volume is mounted as a directory instead of a disk image,
+// which is the case of memory dumps and of volumes shared through virtiofs.
+func (m *volumeMounter) isDirectoryMounted(vmi *v1.VirtualMachineInstance, volumeName string) bool {
+	for _, fs := range vmi.Spec.Domain.Devices.Filesystems {
+		if fs.Name == volumeName {
+			return true
+		}
+	}
+	for _, status := range vmi.Status.VolumeStatus {
 		if status.Name == volumeName {
 			return status.MemoryDumpVolume != nil
 		}
@@ -399,7 +408,7 @@ func (m *volumeMounter) mountBlockHotplugVolume(
 		if err := m.createBlockDeviceFile(targetPath, volume, dev, permissions); err != nil && !os.IsExist(err) {
 			return err
 		}
-		log.DefaultLogger().V(1).Infof("successfully created block device %v", volume)
+		hotplugLog().V(1).Infof("successfully created block device %v", volume)
 	} else if err != nil {
 		return err
 	}
@@ -473,9 +482,9 @@ func (m *volumeMounter) updateBlockMajorMinor(dev uint64, allow bool, cgroupMana
 	})
 
 	if err != nil {
-		log.Log.Errorf("cgroup %s had failed to set device rule. error: %v. rule: %+v", cgroupManager.GetCgroupVersion(), err, *deviceRule)
+		hotplugLog().Errorf("cgroup %s had failed to set device rule. error: %v. rule: %+v", cgroupManager.GetCgroupVersion(), err, *deviceRule)
 	} else {
-		log.Log.Infof("cgroup %s device rule is set successfully. rule: %+v", cgroupManager.GetCgroupVersion(), *deviceRule)
+		hotplugLog().Infof("cgroup %s device rule is set successfully. rule: %+v", cgroupManager.GetCgroupVersion(), *deviceRule)
 	}
 
 	return err
@@ -513,7 +522,7 @@ func (m *volumeMounter) mountFileSystemHotplugVolume(vmi *v1.VirtualMachineInsta
 	if !isMounted {
 		sourcePath, err := m.getSourcePodFilePath(sourceUID, vmi, volume)
 		if err != nil {
-			log.DefaultLogger().V(3).Infof("Error getting source path: %v", err)
+			hotplugLog().V(3).Infof("Error getting source path: %v", err)
 			// We are eating the error to avoid spamming the log with errors, it might take a while for the volume
 			// to get mounted on the node, and this will error until the volume is mounted.
 			return nil
@@ -530,7 +539,7 @@ func (m *volumeMounter) mountFileSystemHotplugVolume(vmi *v1.VirtualMachineInsta
 		if out, err := mountCommand(sourcePath, target); err != nil {
 			return fmt.Errorf("failed to bindmount hotplug volume source from %v to %v: %v : %v", sourcePath, target, string(out), err)
 		}
-		log.DefaultLogger().V(1).Infof("successfully mounted %v", volume)
+		hotplugLog().V(1).Infof("successfully mounted %v", volume)
 	}
 
 	return m.ownershipManager.SetFileOwnership(target)
@@ -652,7 +661,7 @@ func (m *volumeMounter) Unmount(vmi *v1.VirtualMachineInstance, cgroupManager cg
 					// already unmounted or never mounted
 					continue
 				}
-			} else if m.isDirectoryMounted(&vmi.Status, volume.Name) {
+			} else if m.isDirectoryMounted(vmi, volume.Name) {
 				path, err = m.hotplugDiskManager.GetFileSystemDirectoryTargetPathFromHostView(virtlauncherUID, volume.Name, false)
 				if errors.Is(err, os.ErrNotExist) {
 					// already unmounted or never mounted
@@ -691,7 +700,7 @@ func (m *volumeMounter) Unmount(vmi *v1.VirtualMachineInstance, cgroupManager cg
 				} else if err := m.unmountFileSystemHotplugVolumes(diskPath); err != nil {
 					return err
 				}
-				log.Log.Object(vmi).V(3).Infof("Unmounted hotplug volume path %s", diskPath)
+				hotplugLog().Object(vmi).V(3).Infof("Unmounted hotplug volume path %s", diskPath)
 			} else {
 				newRecord.MountTargetEntries = append(newRecord.MountTargetEntries, vmiMountTargetEntry{
 					TargetFile: unsafepath.UnsafeAbsolute(diskPath.Raw()),
@@ -743,7 +752,7 @@ func (m *volumeMounter) unmountBlockHotplugVolumes(diskPath *safepath.Path, cgro
 // UnmountAll unmounts all hotplug disks of a given VMI.
 func (m *volumeMounter) UnmountAll(vmi *v1.VirtualMachineInstance, cgroupManager cgroup.Manager) error {
 	if vmi.UID != "" {
-		logger := log.DefaultLogger().Object(vmi)
+		logger := hotplugLog().Object(vmi)
 		logger.Info("Cleaning up remaining hotplug volumes")
 		record, err := m.getMountTargetRecord(vmi)
 		if err != nil {
@@ -811,7 +820,7 @@ func (m *volumeMounter) IsMounted(vmi *v1.VirtualMachineInstance, volume string,
 		isBlockExists, _ := isBlockDevice(deviceName)
 		return isBlockExists, nil
 	}
-	if m.isDirectoryMounted(&vmi.Status, volume) {
+	if m.isDirectoryMounted(vmi, volume) {
 		path, err := safepath.JoinNoFollow(targetPath, volume)
 		if err != nil {
 			if errors.Is(err, os.ErrNotExist) {
@@ -0,0 +1,204 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+const (
+	idlePolicyInterval      = 30 * time.Second
+	idleCPUThresholdDefault = 5
+
+	// IdleReason is added in an event when the guest has been idle for longer than the timeout of the idle policy
+	IdleReason = "Idle"
+	// IdlePausedReason is added in an event when a VMI is paused by its idle policy
+	IdlePausedReason = "IdlePaused"
+)
+
+// idleSample is the vCPU time of a guest at the last check of its idle policy
+type idleSample struct {
+	vcpuTime  uint64
+	timestamp time.Time
+	// idleSince is when the guest was first seen idle, it is zero while the guest is busy
+	idleSince time.Time
+	// pausedAt is when the idle policy paused the VMI
+	pausedAt time.Time
+}
+
+func idleAction(policy *v1.IdlePolicy) v1.IdleAction {
+	if policy.Action == "" {
+		return v1.IdleActionPause
+	}
+	return policy.Action
+}
+
+func idleCPUThreshold(policy *v1.IdlePolicy) float64 {
+	if policy.CPUThresholdPercentage == nil {
+		return idleCPUThresholdDefault
+	}
+	return float64(*policy.CPUThresholdPercentage)
+}
+
+// guestVCPUTime sums up the time the guest spent on its vCPUs, in nanoseconds
+func guestVCPUTime(domainStats *stats.DomainStats) (vcpuTime uint64, vcpus int) {
+	for _, vcpu := range domainStats.Vcpu {
+		if vcpu.TimeSet {
+			vcpuTime += vcpu.Time
+			vcpus++
+		}
+	}
+	return vcpuTime, vcpus
+}
+
+// cpuUsagePercentage returns how much of its vCPUs the guest used between two samples, in percent
+func cpuUsagePercentage(prev, cur uint64, elapsed time.Duration, vcpus int) float64 {
+	if cur < prev || elapsed <= 0 || vcpus == 0 {
+		return 100
+	}
+	return float64(cur-prev) * 100 / (float64(elapsed.Nanoseconds()) * float64(vcpus))
+}
+
+func (c *VirtualMachineController) loadIdleSample(vmi *v1.VirtualMachineInstance) *idleSample {
+	sample, ok := c.idleSamples.Load(vmi.UID)
+	if !ok {
+		return nil
+	}
+	return sample.(*idleSample)
+}
+
+// isVMIPausedByIdlePolicy tells whether the idle policy of the VMI requested it to be paused
+func (c *VirtualMachineController) isVMIPausedByIdlePolicy(vmi *v1.VirtualMachineInstance) bool {
+	sample := c.loadIdleSample(vmi)
+	return sample != nil && !sample.pausedAt.IsZero()
+}
+
+// reconcileIdlePolicy samples the vCPU usage of a running VMI with an idle policy and sets the Idle condition
+// once the guest has been idle for longer than the timeout. VMIs with the Pause action are paused right away,
+// the Stop action is taken by virt-controller.
+func (c *VirtualMachineController) reconcileIdlePolicy(vmi *v1.VirtualMachineInstance) {
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	policy := vmi.Spec.IdlePolicy
+	if policy == nil {
+		c.idleSamples.Delete(vmi.UID)
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceIdle)
+		return
+	}
+	c.queue.AddAfter(controller.VirtualMachineInstanceKey(vmi), idlePolicyInterval)
+
+	sample := c.loadIdleSample(vmi)
+	// A paused guest does not use its vCPUs, its idleness is only evaluated again once it is resumed
+	if paused := condManager.GetCondition(vmi, v1.VirtualMachineInstancePaused); paused != nil {
+		if paused.Reason != v1.VirtualMachineInstanceReasonPausedByIdlePolicy {
+			c.idleSamples.Delete(vmi.UID)
+		} else if sample == nil || sample.pausedAt.IsZero() {
+			c.idleSamples.Store(vmi.UID, &idleSample{pausedAt: paused.LastTransitionTime.Time})
+		}
+		return
+	}
+	if sample != nil && !sample.pausedAt.IsZero() {
+		if time.Since(sample.pausedAt) < idlePolicyInterval {
+			// The domain is not paused yet
+			return
+		}
+		c.logger.Object(vmi).Info("The VMI was resumed after it was paused by its idle policy")
+		c.idleSamples.Delete(vmi.UID)
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceIdle)
+		return
+	}
+	if migrations.IsMigrating(vmi) {
+		c.idleSamples.Delete(vmi.UID)
+		return
+	}
+
+	client, err := c.launcherClients.GetLauncherClient(vmi)
+	if err != nil {
+		c.logger.Object(vmi).Reason(err).Warning("failed to connect to the launcher to reconcile the idle policy")
+		return
+	}
+	domainStats, exists, err := client.GetDomainStats()
+	if err != nil || !exists || domainStats == nil {
+		c.logger.Object(vmi).Reason(err).V(3).Info("no vCPU stats available for the idle policy")
+		return
+	}
+	vcpuTime, vcpus := guestVCPUTime(domainStats)
+	now := time.Now()
+	next := &idleSample{vcpuTime: vcpuTime, timestamp: now}
+	defer c.idleSamples.Store(vmi.UID, next)
+	if sample == nil {
+		return
+	}
+
+	next.idleSince = sample.idleSince
+	idle := cpuUsagePercentage(sample.vcpuTime, vcpuTime, now.Sub(sample.timestamp), vcpus) < idleCPUThreshold(policy)
+	if idle && !policy.IgnoreUserSessions && condManager.HasConditionWithStatus(vmi, v1.VirtualMachineInstanceAgentConnected, k8sv1.ConditionTrue) {
+		users, err := client.GetUsers()
+		if err != nil {
+			c.logger.Object(vmi).Reason(err).V(3).Info("failed to get the users logged in to the guest for the idle policy")
+			return
+		}
+		idle = len(users.Items) == 0
+	}
+	if !idle {
+		next.idleSince = time.Time{}
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceIdle)
+		return
+	}
+
+	if next.idleSince.IsZero() {
+		next.idleSince = sample.timestamp
+	}
+	if now.Sub(next.idleSince) < policy.Timeout.Duration {
+		return
+	}
+
+	if !condManager.HasConditionWithStatus(vmi, v1.VirtualMachineInstanceIdle, k8sv1.ConditionTrue) {
+		message := fmt.Sprintf("The guest has been idle since %s", next.idleSince.UTC().Format(time.RFC3339))
+		condManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+			Type:               v1.VirtualMachineInstanceIdle,
+			Status:             k8sv1.ConditionTrue,
+			LastProbeTime:      metav1.NewTime(now),
+			LastTransitionTime: metav1.NewTime(now),
+			Reason:             v1.VirtualMachineInstanceReasonIdleTimeoutExceeded,
+			Message:            message,
+		})
+		c.recorder.Event(vmi, k8sv1.EventTypeNormal, IdleReason, message)
+	}
+
+	if idleAction(policy) != v1.IdleActionPause {
+		return
+	}
+	if err := client.PauseVirtualMachine(vmi); err != nil {
+		c.logger.Object(vmi).Reason(err).Warning("failed to pause the idle VMI")
+		return
+	}
+	next.pausedAt = now
+	c.recorder.Event(vmi, k8sv1.EventTypeNormal, IdlePausedReason, "Paused the VMI according to its idle policy")
+}
@@ -0,0 +1,209 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	launcherclients "kubevirt.io/kubevirt/pkg/virt-handler/launcher-clients"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("Idle policy", func() {
+	vcpuStats := func(times ...uint64) *stats.DomainStats {
+		domainStats := &stats.DomainStats{}
+		for _, t := range times {
+			domainStats.Vcpu = append(domainStats.Vcpu, stats.DomainStatsVcpu{TimeSet: true, Time: t})
+		}
+		return domainStats
+	}
+
+	DescribeTable("should calculate the vCPU usage", func(prev, cur uint64, vcpus int, expected float64) {
+		Expect(cpuUsagePercentage(prev, cur, 10*time.Second, vcpus)).To(BeNumerically("~", expected, 0.01))
+	},
+		Entry("of a single vCPU", uint64(0), uint64(time.Second), 1, 10.0),
+		Entry("across all vCPUs", uint64(0), uint64(time.Second), 4, 2.5),
+		Entry("as busy without vCPUs", uint64(0), uint64(0), 0, 100.0),
+		Entry("as busy when the vCPU time went backwards", uint64(time.Second), uint64(0), 1, 100.0),
+	)
+
+	It("should sum up the time of the vCPUs", func() {
+		vcpuTime, vcpus := guestVCPUTime(vcpuStats(1, 2, 3))
+		Expect(vcpuTime).To(Equal(uint64(6)))
+		Expect(vcpus).To(Equal(3))
+	})
+
+	Context("reconcile", func() {
+		var (
+			controller *VirtualMachineController
+			client     *cmdclient.MockLauncherClient
+			recorder   *record.FakeRecorder
+		)
+
+		newVMI := func(policy *v1.IdlePolicy) *v1.VirtualMachineInstance {
+			vmi := libvmi.New()
+			vmi.UID = "vmi-uid"
+			vmi.Spec.IdlePolicy = policy
+			return vmi
+		}
+
+		// storeSample records a sample taken 30 seconds ago, the guest being idle since idleSince
+		storeSample := func(vmi *v1.VirtualMachineInstance, vcpuTime uint64, idleSince time.Time) {
+			controller.idleSamples.Store(vmi.UID, &idleSample{
+				vcpuTime:  vcpuTime,
+				timestamp: time.Now().Add(-idlePolicyInterval),
+				idleSince: idleSince,
+			})
+		}
+
+		hasIdleCondition := func(vmi *v1.VirtualMachineInstance) bool {
+			return virtcontroller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, v1.VirtualMachineInstanceIdle, k8sv1.ConditionTrue)
+		}
+
+		BeforeEach(func() {
+			client = cmdclient.NewMockLauncherClient(gomock.NewController(GinkgoT()))
+			recorder = record.NewFakeRecorder(10)
+			controller = &VirtualMachineController{
+				BaseController: &BaseController{
+					logger:          log.Log,
+					recorder:        recorder,
+					queue:           workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]()),
+					launcherClients: &launcherclients.MockLauncherClientManager{Client: client},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			controller.queue.ShutDown()
+		})
+
+		It("should only take a first sample of a new VMI", func() {
+			vmi := newVMI(&v1.IdlePolicy{Timeout: metav1.Duration{Duration: time.Minute}})
+			client.EXPECT().GetDomainStats().Return(vcpuStats(100), true, nil)
+
+			controller.reconcileIdlePolicy(vmi)
+			Expect(controller.loadIdleSample(vmi).vcpuTime).To(Equal(uint64(100)))
+			Expect(hasIdleCondition(vmi)).To(BeFalse())
+		})
+
+		It("should not act on a guest which is idle for less than the timeout", func() {
+			vmi := newVMI(&v1.IdlePolicy{Timeout: metav1.Duration{Duration: time.Hour}})
+			storeSample(vmi, 0, time.Time{})
+			client.EXPECT().GetDomainStats().Return(vcpuStats(uint64(time.Millisecond)), true, nil)
+
+			controller.reconcileIdlePolicy(vmi)
+			Expect(controller.loadIdleSample(vmi).idleSince).ToNot(BeZero())
+			Expect(hasIdleCondition(vmi)).To(BeFalse())
+		})
+
+		It("should pause a guest which is idle for longer than the timeout", func() {
+			vmi := newVMI(&v1.IdlePolicy{Timeout: metav1.Duration{Duration: time.Minute}})
+			storeSample(vmi, 0, time.Now().Add(-time.Hour))
+			client.EXPECT().GetDomainStats().Return(vcpuStats(uint64(time.Millisecond)), true, nil)
+			client.EXPECT().PauseVirtualMachine(vmi).Return(nil)
+
+			controller.reconcileIdlePolicy(vmi)
+			Expect(hasIdleCondition(vmi)).To(BeTrue())
+			testutils.ExpectEvent(recorder, IdleReason)
+			testutils.ExpectEvent(recorder, IdlePausedReason)
+			Expect(controller.isVMIPausedByIdlePolicy(vmi)).To(BeTrue())
+		})
+
+		It("should leave stopping an idle guest to virt-controller", func() {
+			vmi := newVMI(&v1.IdlePolicy{Timeout: metav1.Duration{Duration: time.Minute}, Action: v1.IdleActionStop})
+			storeSample(vmi, 0, time.Now().Add(-time.Hour))
+			client.EXPECT().GetDomainStats().Return(vcpuStats(uint64(time.Millisecond)), true, nil)
+
+			controller.reconcileIdlePolicy(vmi)
+			Expect(hasIdleCondition(vmi)).To(BeTrue())
+			testutils.ExpectEvent(recorder, IdleReason)
+			Expect(controller.isVMIPausedByIdlePolicy(vmi)).To(BeFalse())
+		})
+
+		It("should not consider a guest with a higher vCPU usage than the threshold idle", func() {
+			vmi := newVMI(&v1.IdlePolicy{Timeout: metav1.Duration{Duration: time.Minute}, CPUThresholdPercentage: pointer.P(uint32(10))})
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{Type: v1.VirtualMachineInstanceIdle, Status: k8sv1.ConditionTrue}}
+			storeSample(vmi, 0, time.Now().Add(-time.Hour))
+			client.EXPECT().GetDomainStats().Return(vcpuStats(uint64(5*time.Second)), true, nil)
+
+			controller.reconcileIdlePolicy(vmi)
+			Expect(hasIdleCondition(vmi)).To(BeFalse())
+			Expect(controller.loadIdleSample(vmi).idleSince).To(BeZero())
+		})
+
+		It("should not consider a guest with logged in users idle", func() {
+			vmi := newVMI(&v1.IdlePolicy{Timeout: metav1.Duration{Duration: time.Minute}})
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{Type: v1.VirtualMachineInstanceAgentConnected, Status: k8sv1.ConditionTrue}}
+			storeSample(vmi, 0, time.Now().Add(-time.Hour))
+			client.EXPECT().GetDomainStats().Return(vcpuStats(0), true, nil)
+			client.EXPECT().GetUsers().Return(v1.VirtualMachineInstanceGuestOSUserList{
+				Items: []v1.VirtualMachineInstanceGuestOSUser{{UserName: "user"}},
+			}, nil)
+
+			controller.reconcileIdlePolicy(vmi)
+			Expect(hasIdleCondition(vmi)).To(BeFalse())
+		})
+
+		It("should clear the idle condition once a VMI paused by the idle policy is resumed", func() {
+			vmi := newVMI(&v1.IdlePolicy{Timeout: metav1.Duration{Duration: time.Minute}})
+			pausedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{Type: v1.VirtualMachineInstanceIdle, Status: k8sv1.ConditionTrue},
+				{Type: v1.VirtualMachineInstancePaused, Status: k8sv1.ConditionTrue, Reason: v1.VirtualMachineInstanceReasonPausedByIdlePolicy, LastTransitionTime: pausedAt},
+			}
+
+			controller.reconcileIdlePolicy(vmi)
+			Expect(controller.isVMIPausedByIdlePolicy(vmi)).To(BeTrue())
+			Expect(hasIdleCondition(vmi)).To(BeTrue())
+
+			virtcontroller.NewVirtualMachineInstanceConditionManager().RemoveCondition(vmi, v1.VirtualMachineInstancePaused)
+			controller.reconcileIdlePolicy(vmi)
+			Expect(controller.loadIdleSample(vmi)).To(BeNil())
+			Expect(hasIdleCondition(vmi)).To(BeFalse())
+		})
+
+		It("should clear the idle condition once the policy is removed", func() {
+			vmi := newVMI(nil)
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{Type: v1.VirtualMachineInstanceIdle, Status: k8sv1.ConditionTrue}}
+			storeSample(vmi, 0, time.Time{})
+
+			controller.reconcileIdlePolicy(vmi)
+			Expect(controller.loadIdleSample(vmi)).To(BeNil())
+			Expect(hasIdleCondition(vmi)).To(BeFalse())
+		})
+	})
+})
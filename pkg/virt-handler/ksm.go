@@ -29,6 +29,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"kubevirt.io/kubevirt/tools/cache"
 
@@ -37,9 +38,11 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	k8sv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	k8scache "k8s.io/client-go/tools/cache"
 	kubevirtv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
@@ -51,18 +54,31 @@ const (
 	nPagesInitDefault      = 100
 	sleepMsBaselineDefault = 100 // 10ms in oVirt seemed really low
 	freePercentDefault     = 0.2
+
+	// KSMUpdateInterval is how often virt-handler retunes KSM on its node
+	KSMUpdateInterval = 1 * time.Minute
 )
 
+// ksmMergePolicyRank orders the merge policies from the least to the most aggressive
+var ksmMergePolicyRank = map[kubevirtv1.KSMMergePolicy]int{
+	kubevirtv1.KSMMergePolicyDisabled:     0,
+	kubevirtv1.KSMMergePolicyConservative: 1,
+	kubevirtv1.KSMMergePolicyBalanced:     2,
+	kubevirtv1.KSMMergePolicyAggressive:   3,
+}
+
 var (
 	// These are vars so they can be changed by the unit tests
 
 	// In some environments, sysfs is mounted read-only even for privileged
 	// containers: https://github.com/containerd/containerd/issues/8445.
 	// Use the path from the host filesystem.
-	ksmBasePath  = "/proc/1/root/sys/kernel/mm/ksm/"
-	ksmRunPath   = ksmBasePath + "run"
-	ksmSleepPath = ksmBasePath + "sleep_millisecs"
-	ksmPagesPath = ksmBasePath + "pages_to_scan"
+	ksmBasePath    = "/proc/1/root/sys/kernel/mm/ksm/"
+	ksmRunPath     = ksmBasePath + "run"
+	ksmSleepPath   = ksmBasePath + "sleep_millisecs"
+	ksmPagesPath   = ksmBasePath + "pages_to_scan"
+	ksmSharedPath  = ksmBasePath + "pages_shared"
+	ksmSharingPath = ksmBasePath + "pages_sharing"
 
 	memInfoPath = "/proc/meminfo"
 )
@@ -120,15 +136,66 @@ func getKsmPages() (int, error) {
 	return pages, nil
 }
 
+func getKsmStat(path string) (uint64, error) {
+	statBytes, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(strings.TrimSpace(string(statBytes)), 10, 64)
+}
+
+// ksmMergePolicyForNode returns the most aggressive merge policy among the VMIs running on the node,
+// the VMIs which do not set one use the cluster wide policy
+func ksmMergePolicyForNode(vmiStore k8scache.Store, clusterConfig *virtconfig.ClusterConfig) kubevirtv1.KSMMergePolicy {
+	clusterPolicy := clusterConfig.GetKSMMergePolicy()
+	if vmiStore == nil {
+		return clusterPolicy
+	}
+
+	var policy kubevirtv1.KSMMergePolicy
+	for _, obj := range vmiStore.List() {
+		vmi, ok := obj.(*kubevirtv1.VirtualMachineInstance)
+		if !ok || vmi.IsFinal() {
+			continue
+		}
+		vmiPolicy := clusterPolicy
+		if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.KSMMergePolicy != "" {
+			vmiPolicy = vmi.Spec.Domain.Memory.KSMMergePolicy
+		}
+		if policy == "" || ksmMergePolicyRank[vmiPolicy] > ksmMergePolicyRank[policy] {
+			policy = vmiPolicy
+		}
+	}
+	if policy == "" {
+		return clusterPolicy
+	}
+	return policy
+}
+
+// ksmDefaultsForPolicy scales how fast KSM speeds up, how many pages it scans at most and under which
+// share of available memory it starts with the aggressiveness of the merge policy
+func ksmDefaultsForPolicy(policy kubevirtv1.KSMMergePolicy) (pagesBoost, nPagesMax int, freePercent float32) {
+	switch policy {
+	case kubevirtv1.KSMMergePolicyConservative:
+		return pagesBoostDefault / 2, nPagesMaxDefault / 2, freePercentDefault / 2
+	case kubevirtv1.KSMMergePolicyAggressive:
+		return pagesBoostDefault * 2, nPagesMaxDefault * 2, freePercentDefault * 2
+	default:
+		return pagesBoostDefault, nPagesMaxDefault, freePercentDefault
+	}
+}
+
 // Inspired from https://github.com/oVirt/mom/blob/master/doc/ksm.rules
-func calculateNewRunSleepAndPages(node *v1.Node, running bool) (ksmState, error) {
-	pagesBoost := getIntParam(node, kubevirtv1.KSMPagesBoostOverride, pagesBoostDefault, 0, math.MaxInt)
+func calculateNewRunSleepAndPages(node *v1.Node, running bool, policy kubevirtv1.KSMMergePolicy) (ksmState, error) {
+	pagesBoostPolicy, nPagesMaxPolicy, freePercentPolicy := ksmDefaultsForPolicy(policy)
+	pagesBoost := getIntParam(node, kubevirtv1.KSMPagesBoostOverride, pagesBoostPolicy, 0, math.MaxInt)
 	pagesDecay := getIntParam(node, kubevirtv1.KSMPagesDecayOverride, pagesDecayDefault, math.MinInt, 0)
 	nPagesMin := getIntParam(node, kubevirtv1.KSMPagesMinOverride, nPagesMinDefault, 0, math.MaxInt)
-	nPagesMax := getIntParam(node, kubevirtv1.KSMPagesMaxOverride, nPagesMaxDefault, nPagesMin, math.MaxInt)
+	nPagesMax := getIntParam(node, kubevirtv1.KSMPagesMaxOverride, nPagesMaxPolicy, nPagesMin, math.MaxInt)
 	nPagesInit := getIntParam(node, kubevirtv1.KSMPagesInitOverride, nPagesInitDefault, nPagesMin, nPagesMax)
 	sleepMsBaseline := uint64(getIntParam(node, kubevirtv1.KSMSleepMsBaselineOverride, sleepMsBaselineDefault, 1, math.MaxInt))
-	freePercent := getFloatParam(node, kubevirtv1.KSMFreePercentOverride, freePercentDefault, 0, 1)
+	freePercent := getFloatParam(node, kubevirtv1.KSMFreePercentOverride, freePercentPolicy, 0, 1)
 	ksm := ksmState{running: running}
 	total, available, err := getTotalAndAvailableMem()
 	if err != nil {
@@ -254,7 +321,9 @@ func getIntParam(node *v1.Node, param string, defaultValue, lowerBound, upperBou
 // will set the outcome value to the n.KSM struct
 // If the node labels match the selector terms, the ksm will be enabled.
 // Empty Selector will enable ksm for every node
-func handleKSM(nodeName string, client k8sv1.CoreV1Interface, clusterConfig *virtconfig.ClusterConfig) (ksmLabelValue, ksmEnabledByUs, needsUpdate bool) {
+// The ksm is tuned with the most aggressive merge policy of the VMIs running on the node, and stopped
+// if none of them lets KSM merge its memory
+func handleKSM(nodeName string, client k8sv1.CoreV1Interface, clusterConfig *virtconfig.ClusterConfig, vmiStore k8scache.Store) (ksmLabelValue, ksmEnabledByUs, needsUpdate bool) {
 	available, enabled := loadKSM()
 	if !available {
 		return
@@ -306,10 +375,16 @@ func handleKSM(nodeName string, client k8sv1.CoreV1Interface, clusterConfig *vir
 	ksmLabelValue = true
 	needsUpdate = true
 
-	ksm, err := calculateNewRunSleepAndPages(node, enabled)
-	if err != nil {
-		log.DefaultLogger().Reason(err).Errorf("An error occurred while calculating the new KSM values")
-		return
+	policy := ksmMergePolicyForNode(vmiStore, clusterConfig)
+	defer reportKSMStats(nodeName, policy)
+
+	ksm := ksmState{}
+	if policy != kubevirtv1.KSMMergePolicyDisabled {
+		ksm, err = calculateNewRunSleepAndPages(node, enabled, policy)
+		if err != nil {
+			log.DefaultLogger().Reason(err).Errorf("An error occurred while calculating the new KSM values")
+			return
+		}
 	}
 
 	err = writeKsmValuesToFiles(ksm)
@@ -323,8 +398,23 @@ func handleKSM(nodeName string, client k8sv1.CoreV1Interface, clusterConfig *vir
 	return
 }
 
-func HandleKSMUpdate(nodeName string, client k8sv1.CoreV1Interface, clusterConfig *virtconfig.ClusterConfig, forceUpdate bool) {
-	ksmEnabled, ksmEnabledByUs, needsUpdate := handleKSM(nodeName, client, clusterConfig)
+func reportKSMStats(nodeName string, policy kubevirtv1.KSMMergePolicy) {
+	pagesShared, err := getKsmStat(ksmSharedPath)
+	if err != nil {
+		log.DefaultLogger().Reason(err).V(4).Info("Unable to read the KSM shared pages")
+		return
+	}
+	pagesSharing, err := getKsmStat(ksmSharingPath)
+	if err != nil {
+		log.DefaultLogger().Reason(err).V(4).Info("Unable to read the KSM sharing pages")
+		return
+	}
+
+	metrics.ReportKSMStats(nodeName, string(policy), pagesShared, pagesSharing, uint64(os.Getpagesize()))
+}
+
+func HandleKSMUpdate(nodeName string, client k8sv1.CoreV1Interface, clusterConfig *virtconfig.ClusterConfig, vmiStore k8scache.Store, forceUpdate bool) {
+	ksmEnabled, ksmEnabledByUs, needsUpdate := handleKSM(nodeName, client, clusterConfig, vmiStore)
 	if !forceUpdate && !needsUpdate {
 		return
 	}
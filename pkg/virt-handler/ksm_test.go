@@ -26,6 +26,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 
@@ -38,9 +39,11 @@ import (
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
+	k8scache "k8s.io/client-go/tools/cache"
 
 	gomegatypes "github.com/onsi/gomega/types"
 
+	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -130,7 +133,7 @@ var _ = Describe("KSM", func() {
 			}
 			fakeClient := fake.NewSimpleClientset(node)
 			clusterConfig := generateClusterConfig(featuregate.CPUManager)
-			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, true)
+			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, true)
 			createCustomMemInfo(false)
 
 			node, err := fakeClient.CoreV1().Nodes().Get(context.TODO(), testNodeName, metav1.GetOptions{})
@@ -140,7 +143,7 @@ var _ = Describe("KSM", func() {
 			err = os.WriteFile(filepath.Join(fakeSysKSMDir, "run"), []byte("1\n"), 0644)
 			Expect(err).ToNot(HaveOccurred())
 
-			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, false)
+			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, false)
 			node, err = fakeClient.CoreV1().Nodes().Get(context.TODO(), testNodeName, metav1.GetOptions{})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(node.Labels).To(HaveKeyWithValue(kubevirtv1.KSMEnabledLabel, "false"))
@@ -191,7 +194,7 @@ var _ = Describe("KSM", func() {
 				},
 			}
 			fakeClient := fake.NewSimpleClientset(node)
-			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, true)
+			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, true)
 
 			node, err := fakeClient.CoreV1().Nodes().Get(context.TODO(), testNodeName, metav1.GetOptions{})
 			Expect(err).ToNot(HaveOccurred())
@@ -217,10 +220,10 @@ var _ = Describe("KSM", func() {
 			fakeClient := fake.NewSimpleClientset(node)
 			err := os.WriteFile(filepath.Join(fakeSysKSMDir, "run"), []byte(initialKsmValue), 0644)
 			Expect(err).ToNot(HaveOccurred())
-			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, true)
+			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, true)
 
 			createCustomMemInfo(true)
-			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, false)
+			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, false)
 
 			node, err = fakeClient.CoreV1().Nodes().Get(context.TODO(), testNodeName, metav1.GetOptions{})
 			Expect(err).ToNot(HaveOccurred())
@@ -268,43 +271,43 @@ var _ = Describe("KSM", func() {
 			}
 			fakeClient := fake.NewSimpleClientset(node)
 			createCustomMemInfo(false)
-			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, true)
+			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, true)
 
 			By("running a first HandleKSMUpdate and expecting no change")
-			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, false)
+			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, false)
 			expectKSMState(expected)
 
 			By("inducing memory pressure and expecting KSM to start running")
 			createCustomMemInfo(true)
-			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, false)
+			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, false)
 			expected.running = true
 			expectKSMState(expected)
 
 			By("expecting the number of pages to scan to increase every HandleKSMUpdate up to max value")
-			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, false)
+			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, false)
 			expected.pages = nPagesInitDefault + pagesBoostDefault
 			expectKSMState(expected)
-			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, false)
-			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, false)
-			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, false)
+			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, false)
+			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, false)
+			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, false)
 			expected.pages = nPagesMaxDefault
 			expectKSMState(expected)
 
 			By("cancelling memory pressure and expecting more sleep and a decay of the number of pages to scan")
 			createCustomMemInfo(false)
-			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, false)
+			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, false)
 			expected.pages = nPagesMaxDefault + pagesDecayDefault
 			expected.sleep = sleepMsBaselineDefault * (16 * 1024 * 1024) / (memTotal - memAvailableNoPressure)
 			expectKSMState(expected)
 			for i := 0; i < 15; i++ {
-				HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, false)
+				HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, false)
 			}
 			expected.pages = nPagesMaxDefault + 16*pagesDecayDefault
 			expectKSMState(expected)
 
 			By("expecting KSM to stop running after enough time without memory pressure")
 			for i := 0; i < 30; i++ {
-				HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, false)
+				HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, false)
 			}
 			expected.running = false
 			expectKSMState(expected)
@@ -334,17 +337,17 @@ var _ = Describe("KSM", func() {
 			}
 			fakeClient := fake.NewSimpleClientset(node)
 			createCustomMemInfo(false)
-			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, true)
+			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, true)
 
 			By("running a first HandleKSMUpdate and expecting the right values")
 			expectKSMState(expected)
 
 			By("expecting the number of pages to scan to increase every HandleKSMUpdate up to max value")
-			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, false)
+			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, false)
 			expected.pages = 166 + 123
 			expectKSMState(expected)
 			for i := 0; i < 5; i++ {
-				HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, false)
+				HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, false)
 			}
 			expected.pages = 789
 			expectKSMState(expected)
@@ -353,15 +356,90 @@ var _ = Describe("KSM", func() {
 			data := []byte(fmt.Sprintf(`{"metadata": { "annotations": {"%s": "%s"}}}`, kubevirtv1.KSMFreePercentOverride, "0.1"))
 			_, err := fakeClient.CoreV1().Nodes().Patch(context.Background(), testNodeName, types.StrategicMergePatchType, data, metav1.PatchOptions{})
 			Expect(err).NotTo(HaveOccurred())
-			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, false)
+			HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, false)
 			expected.pages = 789 - 50
 			expectKSMState(expected)
 			for i := 0; i < 16; i++ {
-				HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, false)
+				HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, nil, false)
 			}
 			expected.running = false
 			expectKSMState(expected)
 		})
+
+		Context("with merge policies", func() {
+			var vmiStore k8scache.Store
+
+			newVMI := func(policy kubevirtv1.KSMMergePolicy) *kubevirtv1.VirtualMachineInstance {
+				vmi := libvmi.New(libvmi.WithNamespace("default"))
+				vmi.Name = "testvmi-" + strings.ToLower(string(policy))
+				vmi.Spec.Domain.Memory = &kubevirtv1.Memory{KSMMergePolicy: policy}
+				return vmi
+			}
+
+			BeforeEach(func() {
+				vmiStore = k8scache.NewStore(k8scache.MetaNamespaceKeyFunc)
+			})
+
+			DescribeTable("should pick the node policy", func(clusterPolicy kubevirtv1.KSMMergePolicy, vmiPolicies []kubevirtv1.KSMMergePolicy, expected kubevirtv1.KSMMergePolicy) {
+				kv.Spec.Configuration.KSMConfiguration.MergePolicy = clusterPolicy
+				clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKV(kv)
+				for _, policy := range vmiPolicies {
+					Expect(vmiStore.Add(newVMI(policy))).To(Succeed())
+				}
+
+				Expect(ksmMergePolicyForNode(vmiStore, clusterConfig)).To(Equal(expected))
+			},
+				Entry("as Balanced without VMIs and cluster policy", kubevirtv1.KSMMergePolicy(""), nil, kubevirtv1.KSMMergePolicyBalanced),
+				Entry("as the cluster policy without VMIs", kubevirtv1.KSMMergePolicyAggressive, nil, kubevirtv1.KSMMergePolicyAggressive),
+				Entry("as the most aggressive VMI policy", kubevirtv1.KSMMergePolicy(""),
+					[]kubevirtv1.KSMMergePolicy{kubevirtv1.KSMMergePolicyConservative, kubevirtv1.KSMMergePolicyAggressive, kubevirtv1.KSMMergePolicyDisabled},
+					kubevirtv1.KSMMergePolicyAggressive),
+				Entry("as the cluster policy for VMIs which do not set one", kubevirtv1.KSMMergePolicyConservative,
+					[]kubevirtv1.KSMMergePolicy{kubevirtv1.KSMMergePolicyDisabled, ""},
+					kubevirtv1.KSMMergePolicyConservative),
+				Entry("as Disabled when no VMI lets KSM merge its memory", kubevirtv1.KSMMergePolicyAggressive,
+					[]kubevirtv1.KSMMergePolicy{kubevirtv1.KSMMergePolicyDisabled},
+					kubevirtv1.KSMMergePolicyDisabled),
+			)
+
+			It("should scan more pages with the Aggressive policy", func() {
+				node := &v1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   testNodeName,
+						Labels: map[string]string{"test_label": "true"},
+					},
+				}
+				Expect(vmiStore.Add(newVMI(kubevirtv1.KSMMergePolicyAggressive))).To(Succeed())
+				fakeClient := fake.NewSimpleClientset(node)
+				createCustomMemInfo(true)
+				HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, vmiStore, true)
+				HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, vmiStore, false)
+
+				expectKSMState(ksmState{
+					running: true,
+					sleep:   sleepMsBaselineDefault * (16 * 1024 * 1024) / (memTotal - memAvailablePressure),
+					pages:   nPagesInitDefault + 2*pagesBoostDefault,
+				})
+			})
+
+			It("should not run KSM when no VMI lets it merge its memory", func() {
+				node := &v1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   testNodeName,
+						Labels: map[string]string{"test_label": "true"},
+					},
+				}
+				Expect(vmiStore.Add(newVMI(kubevirtv1.KSMMergePolicyDisabled))).To(Succeed())
+				fakeClient := fake.NewSimpleClientset(node)
+				createCustomMemInfo(true)
+				HandleKSMUpdate(testNodeName, fakeClient.CoreV1(), clusterConfig, vmiStore, true)
+
+				expectKSMState(ksmState{running: false})
+				node, err := fakeClient.CoreV1().Nodes().Get(context.TODO(), testNodeName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(node.Labels).To(HaveKeyWithValue(kubevirtv1.KSMEnabledLabel, "true"))
+			})
+		})
 	})
 })
 
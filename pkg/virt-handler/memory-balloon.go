@@ -0,0 +1,173 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+const (
+	memoryBalloonPolicyInterval    = 30 * time.Second
+	memoryBalloonTargetFreeDefault = 20
+	// The balloon leaves at least half of the guest memory to the guest by default
+	memoryBalloonMinDivisor = 2
+	// Changes below 5% of the guest memory are not applied
+	memoryBalloonChangeDivisor = 20
+)
+
+// memoryBalloonPolicy merges the VMI balloon policy into the cluster wide one, fields set on the VMI win
+func memoryBalloonPolicy(vmi *v1.VirtualMachineInstance, clusterPolicy *v1.MemoryBalloon) *v1.MemoryBalloon {
+	var vmiPolicy *v1.MemoryBalloon
+	if vmi.Spec.Domain.Memory != nil {
+		vmiPolicy = vmi.Spec.Domain.Memory.Balloon
+	}
+	if vmiPolicy == nil && clusterPolicy == nil {
+		return nil
+	}
+
+	policy := &v1.MemoryBalloon{}
+	if clusterPolicy != nil {
+		policy = clusterPolicy.DeepCopy()
+	}
+	if vmiPolicy != nil {
+		if vmiPolicy.Min != nil {
+			policy.Min = vmiPolicy.Min
+		}
+		if vmiPolicy.Max != nil {
+			policy.Max = vmiPolicy.Max
+		}
+		if vmiPolicy.TargetFreePercentage != nil {
+			policy.TargetFreePercentage = vmiPolicy.TargetFreePercentage
+		}
+	}
+	return policy
+}
+
+func memoryBalloonAttached(vmi *v1.VirtualMachineInstance) bool {
+	autoattach := vmi.Spec.Domain.Devices.AutoattachMemBalloon
+	return autoattach == nil || *autoattach
+}
+
+// calculateMemoryBalloonTarget returns how much memory the balloon should give to the guest so that
+// the configured share of it stays available, within the min and max of the policy.
+// The guest memory stats are reported by the balloon driver in KiB.
+func calculateMemoryBalloonTarget(policy *v1.MemoryBalloon, guestMemory int64, requested *resource.Quantity, memStats *stats.DomainStatsMemory) (*resource.Quantity, bool) {
+	if memStats == nil || !memStats.ActualBalloonSet || !memStats.UsableSet {
+		return nil, false
+	}
+
+	maxMemory := guestMemory
+	if policy.Max != nil && policy.Max.Value() < maxMemory {
+		maxMemory = policy.Max.Value()
+	}
+	minMemory := maxMemory / memoryBalloonMinDivisor
+	if policy.Min != nil {
+		minMemory = policy.Min.Value()
+	}
+	if minMemory > maxMemory {
+		minMemory = maxMemory
+	}
+	targetFree := int64(memoryBalloonTargetFreeDefault)
+	if policy.TargetFreePercentage != nil && *policy.TargetFreePercentage < 100 {
+		targetFree = int64(*policy.TargetFreePercentage)
+	}
+
+	actual := int64(memStats.ActualBalloon) * 1024
+	usable := int64(memStats.Usable) * 1024
+	used := actual - usable
+	if used < 0 {
+		used = 0
+	}
+
+	target := used * 100 / (100 - targetFree)
+	if target < minMemory {
+		target = minMemory
+	}
+	if target > maxMemory {
+		target = maxMemory
+	}
+
+	// Avoid resizing the balloon on every small fluctuation of the guest memory usage
+	if requested != nil {
+		delta := target - requested.Value()
+		if delta < 0 {
+			delta = -delta
+		}
+		if delta < maxMemory/memoryBalloonChangeDivisor && requested.Value() >= minMemory && requested.Value() <= maxMemory {
+			return requested, true
+		}
+	}
+	return resource.NewQuantity(target, resource.BinarySI), true
+}
+
+// reconcileMemoryBalloon updates the balloon target of a running VMI based on the memory usage reported by the guest.
+// The launcher applies the target on the next sync of the domain.
+func (c *VirtualMachineController) reconcileMemoryBalloon(vmi *v1.VirtualMachineInstance) {
+	memoryStatus := vmi.Status.Memory
+	if memoryStatus == nil || memoryStatus.GuestRequested == nil {
+		return
+	}
+
+	policy := memoryBalloonPolicy(vmi, c.clusterConfig.GetMemoryBalloon())
+	if policy == nil || !memoryBalloonAttached(vmi) {
+		// Give the whole guest memory back before letting go of the balloon
+		if memoryStatus.BalloonRequested != nil && memoryStatus.BalloonRequested.Cmp(*memoryStatus.GuestRequested) < 0 {
+			memoryStatus.BalloonRequested = pointer.P(memoryStatus.GuestRequested.DeepCopy())
+			c.queue.AddAfter(controller.VirtualMachineInstanceKey(vmi), memoryBalloonPolicyInterval)
+		} else {
+			memoryStatus.BalloonRequested = nil
+			memoryStatus.BalloonActual = nil
+		}
+		return
+	}
+	c.queue.AddAfter(controller.VirtualMachineInstanceKey(vmi), memoryBalloonPolicyInterval)
+
+	// Memory hotplug and migrations need the whole guest memory, release the balloon until they are done
+	if migrations.IsMigrating(vmi) || memoryStatus.GuestCurrent == nil || !memoryStatus.GuestCurrent.Equal(*memoryStatus.GuestRequested) {
+		memoryStatus.BalloonRequested = pointer.P(memoryStatus.GuestRequested.DeepCopy())
+		return
+	}
+
+	client, err := c.launcherClients.GetLauncherClient(vmi)
+	if err != nil {
+		c.logger.Object(vmi).Reason(err).Warning("failed to connect to the launcher to reconcile the memory balloon")
+		return
+	}
+	domainStats, exists, err := client.GetDomainStats()
+	if err != nil || !exists || domainStats == nil {
+		c.logger.Object(vmi).Reason(err).V(3).Info("no guest memory stats available for the memory balloon policy")
+		return
+	}
+
+	target, ok := calculateMemoryBalloonTarget(policy, memoryStatus.GuestRequested.Value(), memoryStatus.BalloonRequested, domainStats.Memory)
+	if !ok {
+		return
+	}
+	memoryStatus.BalloonRequested = target
+}
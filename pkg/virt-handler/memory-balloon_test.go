@@ -0,0 +1,170 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	launcherclients "kubevirt.io/kubevirt/pkg/virt-handler/launcher-clients"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("Memory balloon policy", func() {
+	const (
+		gib = int64(1024 * 1024 * 1024)
+		kib = uint64(1024)
+	)
+
+	memStats := func(actual, usable int64) *stats.DomainStatsMemory {
+		return &stats.DomainStatsMemory{
+			ActualBalloonSet: true,
+			ActualBalloon:    uint64(actual) / kib,
+			UsableSet:        true,
+			Usable:           uint64(usable) / kib,
+		}
+	}
+
+	quantity := func(value int64) *resource.Quantity {
+		return resource.NewQuantity(value, resource.BinarySI)
+	}
+
+	Context("policy", func() {
+		It("should be nil without a cluster or VMI policy", func() {
+			Expect(memoryBalloonPolicy(libvmi.New(), nil)).To(BeNil())
+		})
+
+		It("should let VMI fields override the cluster policy", func() {
+			vmi := libvmi.New(libvmi.WithGuestMemory("4Gi"))
+			vmi.Spec.Domain.Memory.Balloon = &v1.MemoryBalloon{Min: quantity(2 * gib)}
+			clusterPolicy := &v1.MemoryBalloon{Min: quantity(gib), TargetFreePercentage: pointer.P(uint32(30))}
+
+			policy := memoryBalloonPolicy(vmi, clusterPolicy)
+			Expect(policy.Min.Value()).To(Equal(2 * gib))
+			Expect(policy.Max).To(BeNil())
+			Expect(*policy.TargetFreePercentage).To(Equal(uint32(30)))
+			Expect(clusterPolicy.Min.Value()).To(Equal(gib))
+		})
+	})
+
+	DescribeTable("should calculate the balloon target", func(policy *v1.MemoryBalloon, requested *resource.Quantity, memoryStats *stats.DomainStatsMemory, expected int64) {
+		target, ok := calculateMemoryBalloonTarget(policy, 8*gib, requested, memoryStats)
+		Expect(ok).To(BeTrue())
+		Expect(target.Value()).To(Equal(expected))
+	},
+		Entry("keeping the default share of the memory free", &v1.MemoryBalloon{}, nil, memStats(8*gib, 3*gib), 25*gib/4),
+		Entry("keeping the configured share of the memory free", &v1.MemoryBalloon{TargetFreePercentage: pointer.P(uint32(50))}, nil, memStats(8*gib, 5*gib), 6*gib),
+		Entry("not going below half of the guest memory by default", &v1.MemoryBalloon{}, nil, memStats(8*gib, 7*gib), 4*gib),
+		Entry("not going below the configured min", &v1.MemoryBalloon{Min: quantity(5 * gib)}, nil, memStats(8*gib, 7*gib), 5*gib),
+		Entry("not going above the configured max", &v1.MemoryBalloon{Max: quantity(6 * gib)}, nil, memStats(8*gib, 0), 6*gib),
+		Entry("not going above the guest memory", &v1.MemoryBalloon{Max: quantity(16 * gib)}, nil, memStats(8*gib, 0), 8*gib),
+		Entry("keeping the requested target on small changes", &v1.MemoryBalloon{}, quantity(6*gib), memStats(6*gib, gib), 6*gib),
+		Entry("changing the requested target on large changes", &v1.MemoryBalloon{}, quantity(6*gib), memStats(6*gib, 0), 15*gib/2),
+	)
+
+	It("should not calculate a target without balloon stats", func() {
+		_, ok := calculateMemoryBalloonTarget(&v1.MemoryBalloon{}, 8*gib, nil, &stats.DomainStatsMemory{})
+		Expect(ok).To(BeFalse())
+	})
+
+	Context("reconcile", func() {
+		var (
+			controller *VirtualMachineController
+			client     *cmdclient.MockLauncherClient
+		)
+
+		newVMI := func(balloon *v1.MemoryBalloon) *v1.VirtualMachineInstance {
+			vmi := libvmi.New(libvmi.WithGuestMemory("8Gi"))
+			vmi.Spec.Domain.Memory.Balloon = balloon
+			vmi.Status.Memory = &v1.MemoryStatus{
+				GuestAtBoot:    quantity(8 * gib),
+				GuestCurrent:   quantity(8 * gib),
+				GuestRequested: quantity(8 * gib),
+			}
+			return vmi
+		}
+
+		BeforeEach(func() {
+			client = cmdclient.NewMockLauncherClient(gomock.NewController(GinkgoT()))
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			controller = &VirtualMachineController{
+				BaseController: &BaseController{
+					logger:          log.Log,
+					clusterConfig:   config,
+					queue:           workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]()),
+					launcherClients: &launcherclients.MockLauncherClientManager{Client: client},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			controller.queue.ShutDown()
+		})
+
+		It("should request the calculated target", func() {
+			vmi := newVMI(&v1.MemoryBalloon{})
+			client.EXPECT().GetDomainStats().Return(&stats.DomainStats{Memory: memStats(8*gib, 3*gib)}, true, nil)
+
+			controller.reconcileMemoryBalloon(vmi)
+			Expect(vmi.Status.Memory.BalloonRequested.Value()).To(Equal(25 * gib / 4))
+		})
+
+		It("should release the balloon while memory is being hotplugged", func() {
+			vmi := newVMI(&v1.MemoryBalloon{})
+			vmi.Status.Memory.GuestRequested = quantity(10 * gib)
+			vmi.Status.Memory.BalloonRequested = quantity(6 * gib)
+
+			controller.reconcileMemoryBalloon(vmi)
+			Expect(vmi.Status.Memory.BalloonRequested.Value()).To(Equal(10 * gib))
+		})
+
+		It("should release the balloon and then stop managing it when the policy is removed", func() {
+			vmi := newVMI(nil)
+			vmi.Status.Memory.BalloonRequested = quantity(6 * gib)
+			vmi.Status.Memory.BalloonActual = quantity(6 * gib)
+
+			controller.reconcileMemoryBalloon(vmi)
+			Expect(vmi.Status.Memory.BalloonRequested.Value()).To(Equal(8 * gib))
+
+			controller.reconcileMemoryBalloon(vmi)
+			Expect(vmi.Status.Memory.BalloonRequested).To(BeNil())
+			Expect(vmi.Status.Memory.BalloonActual).To(BeNil())
+		})
+
+		It("should not manage the balloon when it is not attached", func() {
+			vmi := newVMI(&v1.MemoryBalloon{})
+			vmi.Spec.Domain.Devices.AutoattachMemBalloon = pointer.P(false)
+
+			controller.reconcileMemoryBalloon(vmi)
+			Expect(vmi.Status.Memory.BalloonRequested).To(BeNil())
+		})
+	})
+})
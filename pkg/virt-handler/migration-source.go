@@ -81,7 +81,7 @@ func NewMigrationSourceController(
 		workqueue.DefaultTypedControllerRateLimiter[string](),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-handler-source"},
 	)
-	logger := log.Log.With("controller", "migration-source")
+	logger := log.Log.With("controller", "migration-source").Subcomponent(log.SubcomponentMigration)
 
 	baseCtrl, err := NewBaseController(
 		logger,
@@ -202,6 +202,24 @@ func (c *MigrationSourceController) setMigrationProgressStatus(vmi *v1.VirtualMa
 	}
 
 	vmi.Status.MigrationState.Mode = migrationMetadata.Mode
+	vmi.Status.MigrationState.CutoverTimestamp = migrationMetadata.CutoverTimestamp
+	vmi.Status.MigrationState.Progress = migrationProgress(migrationMetadata.Progress)
+}
+
+func migrationProgress(progress *api.MigrationProgressMetadata) *v1.MigrationProgress {
+	if progress == nil {
+		return nil
+	}
+	return &v1.MigrationProgress{
+		Timestamp:                        progress.Timestamp,
+		DataTotalBytes:                   int64(progress.DataTotal),
+		DataProcessedBytes:               int64(progress.DataProcessed),
+		DataRemainingBytes:               int64(progress.DataRemaining),
+		MemoryDirtyRateBytesPerSecond:    int64(progress.MemoryDirtyRate),
+		MemoryTransferRateBytesPerSecond: int64(progress.MemoryBps),
+		ExpectedDowntimeMilliseconds:     int64(progress.ExpectedDowntime),
+		Iterations:                       int64(progress.Iterations),
+	}
 }
 
 func (c *MigrationSourceController) updateStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
@@ -469,6 +487,9 @@ func (c *MigrationSourceController) migrateVMI(vmi *v1.VirtualMachineInstance, d
 	}
 
 	if isMigrationInProgress(vmi, domain) {
+		if isWarmMigrationCutoverPending(vmi, domain) {
+			return c.requestWarmMigrationCutover(vmi, client)
+		}
 		// we already started this migration, no need to rerun this
 		c.logger.Object(vmi).V(4).Infof("migration %s has already been started", vmi.Status.MigrationState.MigrationUID)
 		return nil
@@ -483,22 +504,7 @@ func (c *MigrationSourceController) migrateVMI(vmi *v1.VirtualMachineInstance, d
 		return fmt.Errorf("failed to handle migration proxy: %v", err)
 	}
 
-	migrationConfiguration := vmi.Status.MigrationState.MigrationConfiguration
-	if migrationConfiguration == nil {
-		migrationConfiguration = c.clusterConfig.GetMigrationConfiguration()
-	}
-
-	options := &cmdclient.MigrationOptions{
-		Bandwidth:               *migrationConfiguration.BandwidthPerMigration,
-		ProgressTimeout:         *migrationConfiguration.ProgressTimeout,
-		CompletionTimeoutPerGiB: *migrationConfiguration.CompletionTimeoutPerGiB,
-		UnsafeMigration:         *migrationConfiguration.UnsafeMigrationOverride,
-		AllowAutoConverge:       *migrationConfiguration.AllowAutoConverge,
-		AllowPostCopy:           *migrationConfiguration.AllowPostCopy,
-		AllowWorkloadDisruption: *migrationConfiguration.AllowWorkloadDisruption,
-	}
-
-	configureParallelMigrationThreads(options, vmi)
+	options := c.migrationOptions(vmi)
 
 	marshalledOptions, err := json.Marshal(options)
 	if err != nil {
@@ -527,6 +533,62 @@ func (c *MigrationSourceController) migrateVMI(vmi *v1.VirtualMachineInstance, d
 	return nil
 }
 
+func (c *MigrationSourceController) migrationOptions(vmi *v1.VirtualMachineInstance) *cmdclient.MigrationOptions {
+	migrationConfiguration := vmi.Status.MigrationState.MigrationConfiguration
+	if migrationConfiguration == nil {
+		migrationConfiguration = c.clusterConfig.GetMigrationConfiguration()
+	}
+
+	options := &cmdclient.MigrationOptions{
+		Bandwidth:               *migrationConfiguration.BandwidthPerMigration,
+		ProgressTimeout:         *migrationConfiguration.ProgressTimeout,
+		CompletionTimeoutPerGiB: *migrationConfiguration.CompletionTimeoutPerGiB,
+		UnsafeMigration:         *migrationConfiguration.UnsafeMigrationOverride,
+		AllowAutoConverge:       *migrationConfiguration.AllowAutoConverge,
+		AllowPostCopy:           *migrationConfiguration.AllowPostCopy,
+		AllowWorkloadDisruption: *migrationConfiguration.AllowWorkloadDisruption,
+		Warm:                    vmi.Status.MigrationState.Warm,
+	}
+
+	if migrationConfiguration.ReplugHostDevices != nil {
+		options.ReplugHostDevices = *migrationConfiguration.ReplugHostDevices
+	}
+
+	configureParallelMigrationThreads(options, vmi)
+	applyMigrationOverrides(options, vmi.Status.MigrationState.MigrationOverrides)
+
+	return options
+}
+
+// isWarmMigrationCutoverPending returns true if the cutover of a running warm migration
+// was requested but not yet handed over to virt-launcher
+func isWarmMigrationCutoverPending(vmi *v1.VirtualMachineInstance, domain *api.Domain) bool {
+	migrationState := vmi.Status.MigrationState
+	if !migrationState.Warm || !migrationState.CutoverRequested {
+		return false
+	}
+	migrationMetadata := domain.Spec.Metadata.KubeVirt.Migration
+	return migrationMetadata != nil &&
+		migrationMetadata.UID == migrationState.MigrationUID &&
+		migrationMetadata.CutoverTimestamp == nil
+}
+
+func (c *MigrationSourceController) requestWarmMigrationCutover(vmi *v1.VirtualMachineInstance, client cmdclient.LauncherClient) error {
+	options := c.migrationOptions(vmi)
+	options.Cutover = true
+
+	vmiCopy := vmi.DeepCopy()
+	if err := hostdisk.ReplacePVCByHostDisk(vmiCopy); err != nil {
+		return err
+	}
+
+	if err := client.MigrateVirtualMachine(vmiCopy, options); err != nil {
+		return err
+	}
+	c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.Migrating.String(), VMIMigrationCutover)
+	return nil
+}
+
 func isMigrationDone(state *v1.VirtualMachineInstanceMigrationState) bool {
 	return state == nil || (state.EndTimestamp != nil && (state.Completed || state.Failed))
 }
@@ -612,3 +674,17 @@ func configureParallelMigrationThreads(options *cmdclient.MigrationOptions, vm *
 
 	options.ParallelMigrationThreads = pointer.P(parallelMultifdMigrationThreads)
 }
+
+// applyMigrationOverrides applies the overrides that are not part of the
+// migration configuration, as requested on the VirtualMachineInstanceMigration.
+func applyMigrationOverrides(options *cmdclient.MigrationOptions, overrides *v1.MigrationOverrides) {
+	if overrides == nil {
+		return
+	}
+	if overrides.ParallelMigrationThreads != nil {
+		options.ParallelMigrationThreads = pointer.P(uint(*overrides.ParallelMigrationThreads))
+	}
+	if overrides.Compression != nil {
+		options.Compression = string(*overrides.Compression)
+	}
+}
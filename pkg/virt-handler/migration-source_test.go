@@ -307,6 +307,39 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 				d.Spec.Metadata.KubeVirt.Migration.AbortStatus)))
 		})
 
+		It("should relay the migration progress from the metadata", func() {
+			d := newDomainMigrationKubevirtMetadata("1234", nil, false, false, v1.MigrationPreCopy)
+			timestamp := metav1.NewTime(time.Now())
+			d.Spec.Metadata.KubeVirt.Migration.Progress = &api.MigrationProgressMetadata{
+				Timestamp:        &timestamp,
+				DataTotal:        4096,
+				DataProcessed:    1024,
+				DataRemaining:    3072,
+				MemoryDirtyRate:  512,
+				MemoryBps:        256,
+				ExpectedDowntime: 300,
+				Iterations:       5,
+			}
+			vmi := libvmi.New(libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithMigrationState(v1.VirtualMachineInstanceMigrationState{
+					MigrationUID:      "1234",
+					SourceNode:        host,
+					TargetNodeAddress: "othernode",
+				}), libvmistatus.WithNodeName(host)),
+			))
+			controller.setMigrationProgressStatus(vmi, d)
+			Expect(vmi.Status.MigrationState.Progress).To(Equal(&v1.MigrationProgress{
+				Timestamp:                        &timestamp,
+				DataTotalBytes:                   4096,
+				DataProcessedBytes:               1024,
+				DataRemainingBytes:               3072,
+				MemoryDirtyRateBytesPerSecond:    512,
+				MemoryTransferRateBytesPerSecond: 256,
+				ExpectedDowntimeMilliseconds:     300,
+				Iterations:                       5,
+			}))
+		})
+
 		It("should send an event if the migration failed", func() {
 			d := newDomainMigrationKubevirtMetadata("1234", pointer.P(metav1.NewTime(time.Now())),
 				true, true, v1.MigrationPreCopy)
@@ -500,6 +533,113 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 				Entry("if CPU is limited", false, k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("4")}),
 				Entry("if post-copy is enabled", true, k8sv1.ResourceList{}),
 			)
+
+			It("should apply the migration overrides even if CPU is limited", func() {
+				vmi.Spec.Domain.Resources.Limits = k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("4")}
+				vmi.Status.MigrationState.MigrationOverrides = &v1.MigrationOverrides{
+					ParallelMigrationThreads: pointer.P(uint32(2)),
+					Compression:              pointer.P(v1.MigrationCompressionZstd),
+				}
+
+				client.EXPECT().MigrateVirtualMachine(gomock.Any(), gomock.Any()).Do(func(_ *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) {
+					Expect(options.ParallelMigrationThreads).To(HaveValue(Equal(uint(2))))
+					Expect(options.Compression).To(Equal("zstd"))
+				}).Times(1).Return(nil)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, VMIMigrating)
+			})
+
+			It("should replug the host devices if configured", func() {
+				migrationConfiguration := controller.clusterConfig.GetMigrationConfiguration().DeepCopy()
+				migrationConfiguration.ReplugHostDevices = pointer.P(true)
+				vmi.Status.MigrationState.MigrationConfiguration = migrationConfiguration
+
+				client.EXPECT().MigrateVirtualMachine(gomock.Any(), gomock.Any()).Do(func(_ *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) {
+					Expect(options.ReplugHostDevices).To(BeTrue())
+				}).Times(1).Return(nil)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, VMIMigrating)
+			})
+		})
+	})
+
+	Context("Warm migration", func() {
+		var (
+			vmi    *v1.VirtualMachineInstance
+			domain *api.Domain
+		)
+
+		BeforeEach(func() {
+			vmi = api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.Status.Phase = v1.Running
+			vmi.Status.NodeName = host
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				TargetNode:                     "othernode",
+				TargetNodeAddress:              "127.0.0.1:12345",
+				SourceNode:                     host,
+				MigrationUID:                   "123",
+				TargetDirectMigrationNodePorts: map[string]int{"49152": 12132},
+				Warm:                           true,
+			}
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			domain = api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+		})
+
+		It("should start the migration as warm migration", func() {
+			addVMI(vmi, domain)
+
+			client.EXPECT().MigrateVirtualMachine(gomock.Any(), gomock.Any()).Do(func(_ *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) {
+				Expect(options.Warm).To(BeTrue())
+				Expect(options.Cutover).To(BeFalse())
+			}).Times(1).Return(nil)
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, VMIMigrating)
+		})
+
+		DescribeTable("when the migration is running", func(cutoverRequested bool, cutoverTimestamp *metav1.Time, expectCutover bool) {
+			vmi.Status.MigrationState.CutoverRequested = cutoverRequested
+			now := metav1.Now()
+			domain.Spec.Metadata.KubeVirt.Migration = &api.MigrationMetadata{
+				UID:              "123",
+				StartTimestamp:   &now,
+				CutoverTimestamp: cutoverTimestamp,
+			}
+			addVMI(vmi, domain)
+
+			if expectCutover {
+				client.EXPECT().MigrateVirtualMachine(gomock.Any(), gomock.Any()).Do(func(_ *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) {
+					Expect(options.Warm).To(BeTrue())
+					Expect(options.Cutover).To(BeTrue())
+				}).Times(1).Return(nil)
+			}
+
+			controller.Execute()
+			if expectCutover {
+				testutils.ExpectEvent(recorder, VMIMigrationCutover)
+			}
+		},
+			Entry("should request the cutover once it was requested", true, nil, true),
+			Entry("should not request the cutover before it was requested", false, nil, false),
+			Entry("should not request the cutover twice", true, pointer.P(metav1.Now()), false),
+		)
+
+		It("should relay the cutover timestamp from the metadata", func() {
+			now := metav1.Now()
+			cutoverTimestamp := metav1.NewTime(time.Now())
+			domain.Spec.Metadata.KubeVirt.Migration = &api.MigrationMetadata{
+				UID:              "123",
+				StartTimestamp:   &now,
+				CutoverTimestamp: &cutoverTimestamp,
+			}
+
+			controller.setMigrationProgressStatus(vmi, domain)
+			Expect(vmi.Status.MigrationState.CutoverTimestamp).To(Equal(&cutoverTimestamp))
 		})
 	})
 
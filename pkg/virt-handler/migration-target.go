@@ -80,6 +80,7 @@ type MigrationTargetController struct {
 	containerDiskMounter             containerdisk.Mounter
 	hotplugVolumeMounter             hotplugvolume.VolumeMounter
 	migrationIpAddress               string
+	findInterfaceIP                  func(name string) (string, error)
 	netBindingPluginMemoryCalculator netBindingPluginMemoryCalculator
 	netConf                          netconf
 	passtRepairHandler               passtRepairTargetHandler
@@ -110,7 +111,7 @@ func NewMigrationTargetController(
 		workqueue.DefaultTypedControllerRateLimiter[string](),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-handler-target"},
 	)
-	logger := log.Log.With("controller", "migration-target")
+	logger := log.Log.With("controller", "migration-target").Subcomponent(log.SubcomponentMigration)
 
 	baseCtrl, err := NewBaseController(
 		logger,
@@ -147,6 +148,7 @@ func NewMigrationTargetController(
 		containerDiskMounter:             containerdisk.NewMounter(podIsolationDetector, containerDiskState, clusterConfig),
 		hotplugVolumeMounter:             hotplugvolume.NewVolumeMounter(hotplugState, kubeletPodsDir, host),
 		migrationIpAddress:               migrationIpAddress,
+		findInterfaceIP:                  findInterfaceIP,
 		netBindingPluginMemoryCalculator: netBindingPluginMemoryCalculator,
 		netConf:                          netConf,
 		passtRepairHandler:               passtRepairHandler,
@@ -198,6 +200,8 @@ func (c *MigrationTargetController) ackMigrationCompletion(vmi *v1.VirtualMachin
 	vmi.Status.NodeName = c.host
 	// clean the evacuation node name since have already migrated to a new node
 	vmi.Status.EvacuationNodeName = ""
+	// the vCPUs are pinned from scratch on the target node, drop the pinning of the source node
+	vmi.Status.VCPUPinning = nil
 	// update the vmi migrationTransport to indicate that the next migration should use unix URI
 	// new workloads will set the migrationTransport on creation, however legacy workloads
 	// can make the switch only after the first migration
@@ -270,23 +274,28 @@ func (c *MigrationTargetController) updateStatus(vmi *v1.VirtualMachineInstance,
 		return nil
 	}
 
+	targetAddress, err := c.migrationTargetAddress(vmi)
+	if err != nil {
+		return err
+	}
+
 	hostAddress := ""
 	// advertise target address
 	if vmi.Status.MigrationState != nil {
 		hostAddress = vmi.Status.MigrationState.TargetNodeAddress
 	}
-	if hostAddress != c.migrationIpAddress {
+	if hostAddress != targetAddress {
 		portsList := make([]string, 0, len(destSrcPortsMap))
 
 		for k := range destSrcPortsMap {
 			portsList = append(portsList, k)
 		}
 		portsStrList := strings.Trim(strings.Join(strings.Fields(fmt.Sprint(portsList)), ","), "[]")
-		c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.PreparingTarget.String(), fmt.Sprintf("Migration Target is listening at %s, on ports: %s", c.migrationIpAddress, portsStrList))
-		vmi.Status.MigrationState.TargetNodeAddress = c.migrationIpAddress
+		c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.PreparingTarget.String(), fmt.Sprintf("Migration Target is listening at %s, on ports: %s", targetAddress, portsStrList))
+		vmi.Status.MigrationState.TargetNodeAddress = targetAddress
 		vmi.Status.MigrationState.TargetDirectMigrationNodePorts = destSrcPortsMap
 		if vmi.Status.MigrationState.TargetState != nil {
-			vmi.Status.MigrationState.TargetState.NodeAddress = pointer.P(c.migrationIpAddress)
+			vmi.Status.MigrationState.TargetState.NodeAddress = pointer.P(targetAddress)
 			vmi.Status.MigrationState.TargetState.DirectMigrationNodePorts = destSrcPortsMap
 		}
 	}
@@ -307,6 +316,26 @@ func (c *MigrationTargetController) updateStatus(vmi *v1.VirtualMachineInstance,
 	return nil
 }
 
+// migrationTargetAddress returns the address of this node on the network selected for the migration
+func (c *MigrationTargetController) migrationTargetAddress(vmi *v1.VirtualMachineInstance) (string, error) {
+	migrationConfiguration := vmi.Status.MigrationState.MigrationConfiguration
+	if migrationConfiguration == nil {
+		return c.migrationIpAddress, nil
+	}
+	ifaceName, isAdditionalNetwork := migrations.AdditionalNetworkInterfaceForMigration(c.clusterConfig.GetMigrationConfiguration(), migrationConfiguration.Network)
+	if !isAdditionalNetwork {
+		return c.migrationIpAddress, nil
+	}
+	ip, err := c.findInterfaceIP(ifaceName)
+	if err != nil {
+		return "", err
+	}
+	if ip == "" {
+		return "", fmt.Errorf("virt-handler is not connected to the migration network %s", *migrationConfiguration.Network)
+	}
+	return ip, nil
+}
+
 func (c *MigrationTargetController) Run(threadiness int, stopCh chan struct{}) {
 	defer c.queue.ShutDown()
 	c.logger.Info("Starting virt-handler target controller.")
@@ -833,13 +862,17 @@ func (c *MigrationTargetController) reportTargetTopologyForMigratingVMI(vmi *v1.
 func (c *MigrationTargetController) hotplugCPU(vmi *v1.VirtualMachineInstance, client cmdclient.LauncherClient) error {
 	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
 
+	// A failed hot-unplug keeps the condition with status False, so that the
+	// VM controller rolls the VMI back instead of retrying the unplug.
 	removeVMIVCPUChangeConditionAndLabel := func() {
 		delete(vmi.Labels, v1.VirtualMachinePodCPULimitsLabel)
-		vmiConditions.RemoveCondition(vmi, v1.VirtualMachineInstanceVCPUChange)
+		if vmiConditions.HasConditionWithStatus(vmi, v1.VirtualMachineInstanceVCPUChange, k8sv1.ConditionTrue) {
+			vmiConditions.RemoveCondition(vmi, v1.VirtualMachineInstanceVCPUChange)
+		}
 	}
 	defer removeVMIVCPUChangeConditionAndLabel()
 
-	if !vmiConditions.HasCondition(vmi, v1.VirtualMachineInstanceVCPUChange) {
+	if !vmiConditions.HasConditionWithStatus(vmi, v1.VirtualMachineInstanceVCPUChange, k8sv1.ConditionTrue) {
 		return nil
 	}
 
@@ -868,6 +901,15 @@ func (c *MigrationTargetController) hotplugCPU(vmi *v1.VirtualMachineInstance, c
 		c.clusterConfig)
 
 	if err := client.SyncVirtualMachineCPUs(vmi, options); err != nil {
+		if isCPUHotUnplug(vmi) {
+			// mark hot-unplug as failed
+			vmiConditions.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+				Type:    v1.VirtualMachineInstanceVCPUChange,
+				Status:  k8sv1.ConditionFalse,
+				Reason:  cpuHotUnplugFailedReason,
+				Message: fmt.Sprintf("the guest refused to release vCPUs: %v", err),
+			})
+		}
 		return err
 	}
 
@@ -882,6 +924,19 @@ func (c *MigrationTargetController) hotplugCPU(vmi *v1.VirtualMachineInstance, c
 	return nil
 }
 
+// isCPUHotUnplug returns true if the VMI requests fewer vCPUs than the guest currently has
+func isCPUHotUnplug(vmi *v1.VirtualMachineInstance) bool {
+	if vmi.Status.CurrentCPUTopology == nil {
+		return false
+	}
+	currentCPU := &v1.CPU{
+		Sockets: vmi.Status.CurrentCPUTopology.Sockets,
+		Cores:   vmi.Status.CurrentCPUTopology.Cores,
+		Threads: vmi.Status.CurrentCPUTopology.Threads,
+	}
+	return hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU) < hardware.GetNumberOfVCPUs(currentCPU)
+}
+
 func (c *MigrationTargetController) hotplugMemory(vmi *v1.VirtualMachineInstance, client cmdclient.LauncherClient) error {
 	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
 
@@ -467,6 +467,7 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 		controller.host = "othernode"
 		nowTimeStamp := metav1.Now()
 		startTimestamp := metav1.NewTime(nowTimeStamp.Add(-1 * time.Minute))
+		vmi.Status.VCPUPinning = &v1.VCPUPinningStatus{VCPUs: []v1.VCPUPin{{VCPU: 0, CPUSet: "2"}}}
 		vmi.Status.Interfaces = make([]v1.VirtualMachineInstanceNetworkInterface, 0)
 		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
 			TargetNode:                     "othernode",
@@ -500,6 +501,7 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 		Expect(updatedVMI.Status.LauncherContainerImageVersion).To(BeEmpty())
 		Expect(updatedVMI.Status.NodeName).To(Equal("othernode"))
 		Expect(updatedVMI.Status.EvacuationNodeName).To(BeEmpty())
+		Expect(updatedVMI.Status.VCPUPinning).To(BeNil())
 		Expect(updatedVMI.Status.MigrationState.Completed).To(BeFalse())
 		Expect(updatedVMI.Status.MigrationTransport).To(Equal(v1.MigrationTransportUnix))
 		Expect(updatedVMI.Status.Interfaces).To(BeEmpty())
@@ -625,6 +627,33 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 		})))
 	})
 
+	It("should set VCPUChange condition to False if the guest refuses the CPU hot-unplug", func() {
+		conditionManager := virtcontroller.NewVirtualMachineInstanceConditionManager()
+
+		vmi := api2.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.CPU = &v1.CPU{
+			Sockets: 1,
+			Cores:   1,
+			Threads: 1,
+		}
+		vmi.Status.CurrentCPUTopology = &v1.CPUTopology{
+			Sockets: 2,
+			Cores:   1,
+			Threads: 1,
+		}
+		conditionManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+			Type:   v1.VirtualMachineInstanceVCPUChange,
+			Status: k8sv1.ConditionTrue,
+		})
+
+		client.EXPECT().SyncVirtualMachineCPUs(vmi, gomock.Any()).Return(fmt.Errorf("vcpu unplug request timed out"))
+
+		Expect(controller.hotplugCPU(vmi, client)).ToNot(Succeed())
+
+		Expect(conditionManager.HasConditionWithStatusAndReason(vmi, v1.VirtualMachineInstanceVCPUChange, k8sv1.ConditionFalse, "CPU Hot-Unplug Failed")).To(BeTrue())
+		Expect(vmi.Status.CurrentCPUTopology.Sockets).To(Equal(uint32(2)))
+	})
+
 	It("migration should be marked as completed after finalization", func() {
 		vmi := api2.NewMinimalVMI("testvmi")
 		vmi.UID = vmiTestUUID
@@ -688,6 +717,46 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 		client.EXPECT().SignalTargetPodCleanup(vmi)
 		sanityExecute()
 	})
+
+	Context("migration target address", func() {
+		BeforeEach(func() {
+			controller.migrationIpAddress = "10.0.0.1"
+			controller.clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				MigrationConfiguration: &v1.MigrationConfiguration{
+					Network:            pointer.P("migration-net"),
+					AdditionalNetworks: []string{"fast-net"},
+				},
+			})
+			controller.findInterfaceIP = func(name string) (string, error) {
+				if name == "migration1" {
+					return "10.1.0.1", nil
+				}
+				return "", nil
+			}
+		})
+
+		DescribeTable("should advertise the address", func(network *string, expectedAddress string) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigrationConfiguration: &v1.MigrationConfiguration{Network: network},
+			}
+			Expect(controller.migrationTargetAddress(vmi)).To(Equal(expectedAddress))
+		},
+			Entry("of the default migration network", nil, "10.0.0.1"),
+			Entry("of the cluster migration network", pointer.P("migration-net"), "10.0.0.1"),
+			Entry("of the selected additional migration network", pointer.P("fast-net"), "10.1.0.1"),
+		)
+
+		It("should fail if virt-handler is not connected to the selected migration network", func() {
+			controller.findInterfaceIP = func(string) (string, error) { return "", nil }
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigrationConfiguration: &v1.MigrationConfiguration{Network: pointer.P("fast-net")},
+			}
+			_, err := controller.migrationTargetAddress(vmi)
+			Expect(err).To(MatchError(ContainSubstring("not connected to the migration network fast-net")))
+		})
+	})
 })
 
 type stubTargetPasstRepairHandler struct {
@@ -28,13 +28,26 @@ import (
 
 // FindMigrationIP looks for dedicated migration network migration0. If found, sets migration IP to it
 func FindMigrationIP(migrationIp string) (string, error) {
-	ief, err := net.InterfaceByName(v1.MigrationInterfaceName)
+	ip, err := findInterfaceIP(v1.MigrationInterfaceName)
 	if err != nil {
+		return migrationIp, err
+	}
+	if ip == "" {
 		return migrationIp, nil
 	}
+	return ip, nil
+}
+
+// findInterfaceIP returns the global unicast IP of the given interface, or an empty string if the
+// interface doesn't exist.
+func findInterfaceIP(name string) (string, error) {
+	ief, err := net.InterfaceByName(name)
+	if err != nil {
+		return "", nil
+	}
 	addrs, err := ief.Addrs()
 	if err != nil { // get addresses
-		return migrationIp, fmt.Errorf("%s present but doesn't have an IP", v1.MigrationInterfaceName)
+		return "", fmt.Errorf("%s present but doesn't have an IP", name)
 	}
 	for _, addr := range addrs {
 		if !addr.(*net.IPNet).IP.IsGlobalUnicast() {
@@ -47,5 +60,5 @@ func FindMigrationIP(migrationIp string) (string, error) {
 		}
 	}
 
-	return migrationIp, fmt.Errorf("no IP found on %s", v1.MigrationInterfaceName)
+	return "", fmt.Errorf("no IP found on %s", name)
 }
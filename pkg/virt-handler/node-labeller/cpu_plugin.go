@@ -119,6 +119,12 @@ func (n *NodeLabeller) loadDomCapabilities() error {
 
 	n.hostCapabilities.items = usableModels
 	n.SEV = hostDomCapabilities.SEV
+	n.diskBuses = nil
+	for _, enum := range hostDomCapabilities.Disk.Enum {
+		if enum.Name == "bus" {
+			n.diskBuses = enum.Value
+		}
+	}
 	n.SecureExecution = hostDomCapabilities.SecureExecution
 
 	return nil
@@ -35,6 +35,7 @@ type HostDomCapabilities struct {
 	CPU             CPU                          `xml:"cpu"`
 	SEV             SEVConfiguration             `xml:"features>sev"`
 	SecureExecution SecureExecutionConfiguration `xml:"features>s390-pv"`
+	Disk            DiskCapabilities             `xml:"devices>disk"`
 }
 
 // CPU represents slice of cpu modes
@@ -94,6 +95,17 @@ type SEVConfiguration struct {
 	SupportedES     string `xml:"-"`
 }
 
+// DiskCapabilities represents the supported values of the disk device attributes
+type DiskCapabilities struct {
+	Supported string `xml:"supported,attr"`
+	Enum      []Enum `xml:"enum"`
+}
+
+type Enum struct {
+	Name  string   `xml:"name,attr"`
+	Value []string `xml:"value"`
+}
+
 type SecureExecutionConfiguration struct {
 	Supported string `xml:"supported,attr"`
 }
@@ -22,11 +22,13 @@ package nodelabeller
 import (
 	"context"
 	"fmt"
+	"os"
 	"os/exec"
 	"runtime"
 	"strings"
 	"time"
 
+	"golang.org/x/sys/unix"
 	"k8s.io/client-go/tools/record"
 	"libvirt.org/go/libvirtxml"
 
@@ -58,6 +60,8 @@ var nodeLabellerLabels = []string{
 	kubevirtv1.HostModelRequiredFeaturesLabel,
 	kubevirtv1.NodeHostModelIsObsoleteLabel,
 	kubevirtv1.SupportedMachineTypeLabel,
+	kubevirtv1.SupportedDiskBusLabel,
+	kubevirtv1.SupportedDiskIOLabel,
 }
 
 // NodeLabeller struct holds information needed to run node-labeller
@@ -76,6 +80,7 @@ type NodeLabeller struct {
 	domCapabilitiesFileName string
 	cpuCounter              *libvirtxml.CapsHostCPUCounter
 	supportedMachines       []libvirtxml.CapsGuestMachine
+	diskBuses               []string
 	hostCPUModel            hostCPUModel
 	SEV                     SEVConfiguration
 	SecureExecution         SecureExecutionConfiguration
@@ -254,6 +259,14 @@ func (n *NodeLabeller) prepareLabels(node *v1.Node) map[string]string {
 		newLabels[labelKey] = "true"
 	}
 
+	for _, bus := range n.diskBuses {
+		newLabels[kubevirtv1.SupportedDiskBusLabel+bus] = "true"
+	}
+
+	if isIOUringSupported() {
+		newLabels[kubevirtv1.SupportedDiskIOLabel+string(kubevirtv1.IOUring)] = "true"
+	}
+
 	for _, key := range n.hypervFeatures.items {
 		newLabels[kubevirtv1.HypervLabel+key] = "true"
 	}
@@ -336,6 +349,20 @@ func isNodeRealtimeCapable() (bool, error) {
 	return fmt.Sprintf("%s = -1", kernelSchedRealtimeRuntimeInMicrosecods) == st, nil
 }
 
+const ioUringDisabledSysctl = "/proc/sys/kernel/io_uring_disabled"
+
+// isIOUringSupported checks if QEMU can use io_uring on the node. io_uring can be missing from the kernel,
+// disabled for unprivileged processes through the `kernel.io_uring_disabled` sysctl or blocked by seccomp.
+func isIOUringSupported() bool {
+	if disabled, err := os.ReadFile(ioUringDisabledSysctl); err == nil && strings.TrimSpace(string(disabled)) != "0" {
+		return false
+	}
+	// Without parameters io_uring_setup fails with EFAULT when io_uring is available,
+	// and with ENOSYS or EPERM otherwise.
+	_, _, errno := unix.Syscall(unix.SYS_IO_URING_SETUP, 0, 0, 0)
+	return errno == unix.EFAULT
+}
+
 func isNodeLabellerLabel(label string) bool {
 	for _, prefix := range nodeLabellerLabels {
 		if strings.HasPrefix(label, prefix) {
@@ -131,6 +131,31 @@ var _ = Describe("Node-labeller ", func() {
 		node := retrieveNode(kubeClient)
 		Expect(node.Labels).To(HaveKey(v1.SupportedMachineTypeLabel + "testmachine"))
 	})
+	It("should add supported disk bus labels", func() {
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(SatisfyAll(
+			HaveKeyWithValue(v1.SupportedDiskBusLabel+"nvme", "true"),
+			HaveKeyWithValue(v1.SupportedDiskBusLabel+"virtio", "true"),
+		))
+	})
+
+	It("should remove disk bus labels not supported anymore", func() {
+		node := retrieveNode(kubeClient)
+		node.Labels[v1.SupportedDiskBusLabel+"ide"] = "true"
+		node, err := kubeClient.CoreV1().Nodes().Update(context.TODO(), node, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(node.Labels).To(HaveKey(v1.SupportedDiskBusLabel + "ide"))
+
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node = retrieveNode(kubeClient)
+		Expect(node.Labels).ToNot(HaveKey(v1.SupportedDiskBusLabel + "ide"))
+	})
+
 	It("should add host cpu required features", func() {
 		res := nlController.execute()
 		Expect(res).To(BeTrue())
@@ -0,0 +1,225 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+const (
+	performanceInterval = 30 * time.Second
+
+	// PerformanceRecoveredReason is added in an event when a degraded performance condition of a VMI is cleared
+	PerformanceRecoveredReason = "PerformanceRecovered"
+)
+
+// performanceSample holds the cumulative vCPU and disk counters of a guest at the last check of its performance
+type performanceSample struct {
+	vcpuDelay uint64
+	vcpuWait  uint64
+	vcpus     int
+	ioTime    uint64
+	ioReqs    uint64
+	timestamp time.Time
+}
+
+func newPerformanceSample(domainStats *stats.DomainStats, timestamp time.Time) *performanceSample {
+	sample := &performanceSample{timestamp: timestamp}
+	for _, vcpu := range domainStats.Vcpu {
+		if vcpu.DelaySet {
+			sample.vcpuDelay += vcpu.Delay
+		}
+		if vcpu.WaitSet {
+			sample.vcpuWait += vcpu.Wait
+		}
+		sample.vcpus++
+	}
+	for _, block := range domainStats.Block {
+		if block.RdTimesSet && block.RdReqsSet {
+			sample.ioTime += block.RdTimes
+			sample.ioReqs += block.RdReqs
+		}
+		if block.WrTimesSet && block.WrReqsSet {
+			sample.ioTime += block.WrTimes
+			sample.ioReqs += block.WrReqs
+		}
+		if block.FlTimesSet && block.FlReqsSet {
+			sample.ioTime += block.FlTimes
+			sample.ioReqs += block.FlReqs
+		}
+	}
+	return sample
+}
+
+// vcpuPercentage returns the share of the time of the vCPUs a counter grew by between two samples, in percent
+func vcpuPercentage(prev, cur uint64, elapsed time.Duration, vcpus int) float64 {
+	if cur < prev || elapsed <= 0 || vcpus == 0 {
+		return 0
+	}
+	return float64(cur-prev) * 100 / (float64(elapsed.Nanoseconds()) * float64(vcpus))
+}
+
+// ioLatency returns the average latency of the disk requests completed between two samples
+func ioLatency(prev, cur *performanceSample) time.Duration {
+	if cur.ioReqs <= prev.ioReqs || cur.ioTime < prev.ioTime {
+		return 0
+	}
+	return time.Duration((cur.ioTime - prev.ioTime) / (cur.ioReqs - prev.ioReqs))
+}
+
+// cpuDegradation returns the reason and message of the CPUDegraded condition, or an empty reason if the CPU of
+// the guest is not degraded. Steal time is reported first, as it is caused by the host and not by the guest.
+func cpuDegradation(thresholds *v1.PerformanceDegradationConfiguration, prev, cur *performanceSample) (string, string) {
+	elapsed := cur.timestamp.Sub(prev.timestamp)
+	if thresholds.CPUStealPercentage != nil {
+		steal := vcpuPercentage(prev.vcpuDelay, cur.vcpuDelay, elapsed, cur.vcpus)
+		if steal > float64(*thresholds.CPUStealPercentage) {
+			return v1.VirtualMachineInstanceReasonHighCPUSteal,
+				fmt.Sprintf("The vCPUs waited for a host CPU %.1f%% of the time, above the threshold of %d%%", steal, *thresholds.CPUStealPercentage)
+		}
+	}
+	if thresholds.VCPUWaitPercentage != nil {
+		wait := vcpuPercentage(prev.vcpuWait, cur.vcpuWait, elapsed, cur.vcpus)
+		if wait > float64(*thresholds.VCPUWaitPercentage) {
+			return v1.VirtualMachineInstanceReasonHighVCPUWait,
+				fmt.Sprintf("The vCPUs waited on I/O %.1f%% of the time, above the threshold of %d%%", wait, *thresholds.VCPUWaitPercentage)
+		}
+	}
+	return "", ""
+}
+
+// ioDegradation returns the reason and message of the IODegraded condition, or an empty reason if the IO of the
+// guest is not degraded
+func ioDegradation(thresholds *v1.PerformanceDegradationConfiguration, prev, cur *performanceSample) (string, string) {
+	if thresholds.IOLatency == nil {
+		return "", ""
+	}
+	latency := ioLatency(prev, cur)
+	if latency <= thresholds.IOLatency.Duration {
+		return "", ""
+	}
+	return v1.VirtualMachineInstanceReasonHighIOLatency,
+		fmt.Sprintf("The average latency of the disk requests was %s, above the threshold of %s", latency, thresholds.IOLatency.Duration)
+}
+
+// migrationDegradation returns the reason and message of the MigrationDegraded condition, or an empty reason if
+// the last migration of the VMI did not exceed the downtime threshold
+func migrationDegradation(thresholds *v1.PerformanceDegradationConfiguration, vmi *v1.VirtualMachineInstance) (string, string) {
+	state := vmi.Status.MigrationState
+	if thresholds.MigrationDowntime == nil || state == nil || !state.Completed || state.Failed || state.Progress == nil {
+		return "", ""
+	}
+	downtime := time.Duration(state.Progress.ExpectedDowntimeMilliseconds) * time.Millisecond
+	if downtime <= thresholds.MigrationDowntime.Duration {
+		return "", ""
+	}
+	return v1.VirtualMachineInstanceReasonHighMigrationDowntime,
+		fmt.Sprintf("The last migration had a downtime of %s, above the threshold of %s", downtime, thresholds.MigrationDowntime.Duration)
+}
+
+// updatePerformanceCondition sets the condition with a Warning event when the guest is degraded, and removes it
+// with a Normal event once the guest recovered
+func (c *VirtualMachineController) updatePerformanceCondition(vmi *v1.VirtualMachineInstance, conditionType v1.VirtualMachineInstanceConditionType, reason, message string) {
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	condition := condManager.GetCondition(vmi, conditionType)
+	if reason == "" {
+		if condition != nil {
+			condManager.RemoveCondition(vmi, conditionType)
+			c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, PerformanceRecoveredReason, "The %s condition of the VMI cleared", conditionType)
+		}
+		return
+	}
+	if condition != nil && condition.Reason == reason {
+		return
+	}
+	now := metav1.Now()
+	condManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+		Type:               conditionType,
+		Status:             k8sv1.ConditionTrue,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+		Reason:             reason,
+		Message:            message,
+	})
+	c.recorder.Event(vmi, k8sv1.EventTypeWarning, reason, message)
+}
+
+// reconcilePerformanceConditions evaluates the vCPU steal and wait time, the disk latency and the downtime of the
+// last migration of a running VMI against the thresholds of the cluster, and reports a degraded performance in the
+// CPUDegraded, IODegraded and MigrationDegraded conditions of the VMI.
+func (c *VirtualMachineController) reconcilePerformanceConditions(vmi *v1.VirtualMachineInstance) {
+	thresholds := c.clusterConfig.GetPerformanceDegradation()
+	if thresholds == nil {
+		c.performanceSamples.Delete(vmi.UID)
+		condManager := controller.NewVirtualMachineInstanceConditionManager()
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceCPUDegraded)
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceIODegraded)
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceMigrationDegraded)
+		return
+	}
+
+	reason, message := migrationDegradation(thresholds, vmi)
+	c.updatePerformanceCondition(vmi, v1.VirtualMachineInstanceMigrationDegraded, reason, message)
+
+	if thresholds.CPUStealPercentage == nil && thresholds.VCPUWaitPercentage == nil && thresholds.IOLatency == nil {
+		c.performanceSamples.Delete(vmi.UID)
+		c.updatePerformanceCondition(vmi, v1.VirtualMachineInstanceCPUDegraded, "", "")
+		c.updatePerformanceCondition(vmi, v1.VirtualMachineInstanceIODegraded, "", "")
+		return
+	}
+	c.queue.AddAfter(controller.VirtualMachineInstanceKey(vmi), performanceInterval)
+	// The counters of the domain restart on the target, the guest is sampled again once it is migrated
+	if migrations.IsMigrating(vmi) {
+		c.performanceSamples.Delete(vmi.UID)
+		return
+	}
+
+	client, err := c.launcherClients.GetLauncherClient(vmi)
+	if err != nil {
+		c.logger.Object(vmi).Reason(err).Warning("failed to connect to the launcher to reconcile the performance conditions")
+		return
+	}
+	domainStats, exists, err := client.GetDomainStats()
+	if err != nil || !exists || domainStats == nil {
+		c.logger.Object(vmi).Reason(err).V(3).Info("no domain stats available for the performance conditions")
+		return
+	}
+	next := newPerformanceSample(domainStats, time.Now())
+	prev, ok := c.performanceSamples.Swap(vmi.UID, next)
+	if !ok {
+		return
+	}
+	sample := prev.(*performanceSample)
+
+	reason, message = cpuDegradation(thresholds, sample, next)
+	c.updatePerformanceCondition(vmi, v1.VirtualMachineInstanceCPUDegraded, reason, message)
+	reason, message = ioDegradation(thresholds, sample, next)
+	c.updatePerformanceCondition(vmi, v1.VirtualMachineInstanceIODegraded, reason, message)
+}
@@ -0,0 +1,214 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	launcherclients "kubevirt.io/kubevirt/pkg/virt-handler/launcher-clients"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("Performance conditions", func() {
+	// domainStats returns the stats of a guest with a single vCPU and disk
+	domainStats := func(delay, wait, ioTime, ioReqs uint64) *stats.DomainStats {
+		return &stats.DomainStats{
+			Vcpu: []stats.DomainStatsVcpu{{DelaySet: true, Delay: delay, WaitSet: true, Wait: wait}},
+			Block: []stats.DomainStatsBlock{{
+				RdTimesSet: true, RdTimes: ioTime,
+				RdReqsSet: true, RdReqs: ioReqs,
+			}},
+		}
+	}
+
+	It("should calculate the average latency of the disk requests", func() {
+		prev := newPerformanceSample(domainStats(0, 0, uint64(time.Second), 100), time.Now())
+		cur := newPerformanceSample(domainStats(0, 0, uint64(3*time.Second), 200), time.Now())
+		Expect(ioLatency(prev, cur)).To(Equal(20 * time.Millisecond))
+		Expect(ioLatency(cur, cur)).To(BeZero())
+	})
+
+	DescribeTable("should report the degradation of the CPU", func(delay, wait uint64, expectedReason string) {
+		thresholds := &v1.PerformanceDegradationConfiguration{
+			CPUStealPercentage: pointer.P(uint32(10)),
+			VCPUWaitPercentage: pointer.P(uint32(20)),
+		}
+		now := time.Now()
+		prev := newPerformanceSample(domainStats(0, 0, 0, 0), now.Add(-10*time.Second))
+		cur := newPerformanceSample(domainStats(delay, wait, 0, 0), now)
+		reason, _ := cpuDegradation(thresholds, prev, cur)
+		Expect(reason).To(Equal(expectedReason))
+	},
+		Entry("not below the thresholds", uint64(time.Second), uint64(2*time.Second), ""),
+		Entry("with steal time above its threshold", uint64(2*time.Second), uint64(0), v1.VirtualMachineInstanceReasonHighCPUSteal),
+		Entry("with wait time above its threshold", uint64(0), uint64(3*time.Second), v1.VirtualMachineInstanceReasonHighVCPUWait),
+		Entry("with steal time first when both are above their thresholds", uint64(2*time.Second), uint64(3*time.Second), v1.VirtualMachineInstanceReasonHighCPUSteal),
+	)
+
+	DescribeTable("should report the degradation of the migration", func(state *v1.VirtualMachineInstanceMigrationState, expectedReason string) {
+		thresholds := &v1.PerformanceDegradationConfiguration{MigrationDowntime: &metav1.Duration{Duration: time.Second}}
+		vmi := libvmi.New()
+		vmi.Status.MigrationState = state
+		reason, _ := migrationDegradation(thresholds, vmi)
+		Expect(reason).To(Equal(expectedReason))
+	},
+		Entry("not without a migration", nil, ""),
+		Entry("not while migrating", &v1.VirtualMachineInstanceMigrationState{
+			Progress: &v1.MigrationProgress{ExpectedDowntimeMilliseconds: 2000},
+		}, ""),
+		Entry("not for a failed migration", &v1.VirtualMachineInstanceMigrationState{
+			Completed: true, Failed: true, Progress: &v1.MigrationProgress{ExpectedDowntimeMilliseconds: 2000},
+		}, ""),
+		Entry("not with a downtime below the threshold", &v1.VirtualMachineInstanceMigrationState{
+			Completed: true, Progress: &v1.MigrationProgress{ExpectedDowntimeMilliseconds: 500},
+		}, ""),
+		Entry("with a downtime above the threshold", &v1.VirtualMachineInstanceMigrationState{
+			Completed: true, Progress: &v1.MigrationProgress{ExpectedDowntimeMilliseconds: 2000},
+		}, v1.VirtualMachineInstanceReasonHighMigrationDowntime),
+	)
+
+	Context("reconcile", func() {
+		var (
+			controller *VirtualMachineController
+			client     *cmdclient.MockLauncherClient
+			recorder   *record.FakeRecorder
+			vmi        *v1.VirtualMachineInstance
+		)
+
+		newController := func(thresholds *v1.PerformanceDegradationConfiguration) {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				PerformanceDegradation: thresholds,
+			})
+			controller = &VirtualMachineController{
+				BaseController: &BaseController{
+					logger:          log.Log,
+					recorder:        recorder,
+					queue:           workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]()),
+					clusterConfig:   clusterConfig,
+					launcherClients: &launcherclients.MockLauncherClientManager{Client: client},
+				},
+			}
+		}
+
+		// storeSample records the stats of the guest taken 30 seconds ago
+		storeSample := func(domainStats *stats.DomainStats) {
+			controller.performanceSamples.Store(vmi.UID, newPerformanceSample(domainStats, time.Now().Add(-performanceInterval)))
+		}
+
+		hasCondition := func(conditionType v1.VirtualMachineInstanceConditionType) bool {
+			return virtcontroller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, conditionType, k8sv1.ConditionTrue)
+		}
+
+		BeforeEach(func() {
+			client = cmdclient.NewMockLauncherClient(gomock.NewController(GinkgoT()))
+			recorder = record.NewFakeRecorder(10)
+			vmi = libvmi.New()
+			vmi.UID = "vmi-uid"
+		})
+
+		AfterEach(func() {
+			controller.queue.ShutDown()
+		})
+
+		It("should only take a first sample of a new VMI", func() {
+			newController(&v1.PerformanceDegradationConfiguration{CPUStealPercentage: pointer.P(uint32(10))})
+			client.EXPECT().GetDomainStats().Return(domainStats(uint64(time.Hour), 0, 0, 0), true, nil)
+			controller.reconcilePerformanceConditions(vmi)
+			_, exists := controller.performanceSamples.Load(vmi.UID)
+			Expect(exists).To(BeTrue())
+			Expect(vmi.Status.Conditions).To(BeEmpty())
+		})
+
+		It("should set the CPUDegraded condition with an event when the steal time is above the threshold", func() {
+			newController(&v1.PerformanceDegradationConfiguration{CPUStealPercentage: pointer.P(uint32(10))})
+			storeSample(domainStats(0, 0, 0, 0))
+			client.EXPECT().GetDomainStats().Return(domainStats(uint64(15*time.Second), 0, 0, 0), true, nil)
+			controller.reconcilePerformanceConditions(vmi)
+			Expect(hasCondition(v1.VirtualMachineInstanceCPUDegraded)).To(BeTrue())
+			Expect(hasCondition(v1.VirtualMachineInstanceIODegraded)).To(BeFalse())
+			testutils.ExpectEvent(recorder, v1.VirtualMachineInstanceReasonHighCPUSteal)
+		})
+
+		It("should set the IODegraded condition when the latency is above the threshold", func() {
+			newController(&v1.PerformanceDegradationConfiguration{IOLatency: &metav1.Duration{Duration: 10 * time.Millisecond}})
+			storeSample(domainStats(0, 0, 0, 0))
+			client.EXPECT().GetDomainStats().Return(domainStats(0, 0, uint64(5*time.Second), 100), true, nil)
+			controller.reconcilePerformanceConditions(vmi)
+			Expect(hasCondition(v1.VirtualMachineInstanceIODegraded)).To(BeTrue())
+			testutils.ExpectEvent(recorder, v1.VirtualMachineInstanceReasonHighIOLatency)
+		})
+
+		It("should remove the condition with an event once the guest recovered", func() {
+			newController(&v1.PerformanceDegradationConfiguration{CPUStealPercentage: pointer.P(uint32(10))})
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:   v1.VirtualMachineInstanceCPUDegraded,
+				Status: k8sv1.ConditionTrue,
+				Reason: v1.VirtualMachineInstanceReasonHighCPUSteal,
+			}}
+			storeSample(domainStats(0, 0, 0, 0))
+			client.EXPECT().GetDomainStats().Return(domainStats(uint64(time.Second), 0, 0, 0), true, nil)
+			controller.reconcilePerformanceConditions(vmi)
+			Expect(hasCondition(v1.VirtualMachineInstanceCPUDegraded)).To(BeFalse())
+			testutils.ExpectEvent(recorder, PerformanceRecoveredReason)
+		})
+
+		It("should not sample a migrating VMI", func() {
+			newController(&v1.PerformanceDegradationConfiguration{CPUStealPercentage: pointer.P(uint32(10))})
+			storeSample(domainStats(0, 0, 0, 0))
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				StartTimestamp: pointer.P(metav1.Now()),
+			}
+			controller.reconcilePerformanceConditions(vmi)
+			_, exists := controller.performanceSamples.Load(vmi.UID)
+			Expect(exists).To(BeFalse())
+		})
+
+		It("should remove the conditions when the thresholds are not configured", func() {
+			newController(nil)
+			storeSample(domainStats(0, 0, 0, 0))
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{Type: v1.VirtualMachineInstanceCPUDegraded, Status: k8sv1.ConditionTrue},
+				{Type: v1.VirtualMachineInstanceIODegraded, Status: k8sv1.ConditionTrue},
+				{Type: v1.VirtualMachineInstanceMigrationDegraded, Status: k8sv1.ConditionTrue},
+			}
+			controller.reconcilePerformanceConditions(vmi)
+			Expect(vmi.Status.Conditions).To(BeEmpty())
+			_, exists := controller.performanceSamples.Load(vmi.UID)
+			Expect(exists).To(BeFalse())
+		})
+	})
+})
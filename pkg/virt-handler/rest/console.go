@@ -42,6 +42,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/util"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 )
 
@@ -55,6 +56,9 @@ type ConsoleHandler struct {
 	usbredir             map[types.UID]UsbredirHandlerVMI
 	usbredirLock         *sync.Mutex
 	certManager          certificate.Manager
+	serialMuxes          map[types.UID]*serialConsoleMux
+	serialConnections    *consoleConnections
+	vncConnections       *consoleConnections
 }
 
 type UsbredirHandlerVMI struct {
@@ -72,6 +76,9 @@ func NewConsoleHandler(podIsolationDetector isolation.PodIsolationDetector, vmiS
 		vmiStore:             vmiStore,
 		usbredir:             make(map[types.UID]UsbredirHandlerVMI),
 		certManager:          certManager,
+		serialMuxes:          make(map[types.UID]*serialConsoleMux),
+		serialConnections:    newConsoleConnections(),
+		vncConnections:       newConsoleConnections(),
 	}
 }
 
@@ -145,6 +152,7 @@ func (t *ConsoleHandler) VNCHandler(request *restful.Request, response *restful.
 		response.WriteError(code, err)
 		return
 	}
+	resumeIdleVMI(vmi)
 	unixSocketPath, err := t.getUnixSocketPath(vmi, "virt-vnc")
 	if err != nil {
 		log.Log.Object(vmi).Reason(err).Error("Failed finding unix socket for VNC console")
@@ -152,11 +160,41 @@ func (t *ConsoleHandler) VNCHandler(request *restful.Request, response *restful.
 		return
 	}
 	uid := vmi.GetUID()
+	if sharing := vmi.Spec.Domain.Devices.ConsoleSharing; isConsoleShared(sharing) {
+		readOnly, err := t.vncConnections.acquire(uid, sharing)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Error("Refusing VNC connection")
+			response.WriteError(http.StatusServiceUnavailable, err)
+			return
+		}
+		defer t.vncConnections.release(uid, readOnly)
+		t.stream(vmi, request, response, sharedVNCDialer(vmi, unixSocketPath, readOnly), make(chan struct{}))
+		return
+	}
 	stopChn := newStopChan(uid, t.vncLock, t.vncStopChans)
 	defer deleteStopChan(uid, stopChn, t.vncLock, t.vncStopChans)
 	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), stopChn)
 }
 
+// SPICEHandler proxies a single connection to SPICE. SPICE clients open a connection per channel
+// (main, display, inputs, ...), so the connections of a VMI do not replace each other.
+func (t *ConsoleHandler) SPICEHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, t.vmiStore)
+	if err != nil || vmi == nil {
+		log.Log.Reason(err).Error(failedRetrieveVMI)
+		response.WriteError(code, err)
+		return
+	}
+	resumeIdleVMI(vmi)
+	unixSocketPath, err := t.getUnixSocketPath(vmi, "virt-spice")
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed finding unix socket for SPICE")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), make(chan struct{}))
+}
+
 func (t *ConsoleHandler) SerialHandler(request *restful.Request, response *restful.Response) {
 	vmi, code, err := getVMI(request, t.vmiStore)
 	if err != nil || vmi == nil {
@@ -164,6 +202,7 @@ func (t *ConsoleHandler) SerialHandler(request *restful.Request, response *restf
 		response.WriteError(code, err)
 		return
 	}
+	resumeIdleVMI(vmi)
 	unixSocketPath, err := t.getUnixSocketPath(vmi, "virt-serial0")
 	if err != nil {
 		log.Log.Object(vmi).Reason(err).Error("Failed finding unix socket for serial console")
@@ -171,11 +210,98 @@ func (t *ConsoleHandler) SerialHandler(request *restful.Request, response *restf
 		return
 	}
 	uid := vmi.GetUID()
+	if sharing := vmi.Spec.Domain.Devices.ConsoleSharing; isConsoleShared(sharing) {
+		readOnly, err := t.serialConnections.acquire(uid, sharing)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Error("Refusing serial console connection")
+			response.WriteError(http.StatusServiceUnavailable, err)
+			return
+		}
+		defer t.serialConnections.release(uid, readOnly)
+		t.stream(vmi, request, response, t.sharedSerialDialer(vmi, unixSocketPath, readOnly), make(chan struct{}))
+		return
+	}
 	stopCh := newStopChan(uid, t.serialLock, t.serialStopChans)
 	defer deleteStopChan(uid, stopCh, t.serialLock, t.serialStopChans)
 	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), stopCh)
 }
 
+// resumeIdleVMI resumes a VMI which was paused by its idle policy, so that connecting to it wakes it up
+func resumeIdleVMI(vmi *v1.VirtualMachineInstance) {
+	if !isPausedByIdlePolicy(vmi) {
+		return
+	}
+	sockFile, err := cmdclient.FindSocket(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedDetectCmdClient)
+		return
+	}
+	client, err := cmdclient.NewClient(sockFile)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedConnectCmdClient)
+		return
+	}
+	defer client.Close()
+	if err := client.UnpauseVirtualMachine(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to resume the idle VMI")
+		return
+	}
+	log.Log.Object(vmi).Info("Resumed the idle VMI on a console connection")
+}
+
+func isPausedByIdlePolicy(vmi *v1.VirtualMachineInstance) bool {
+	for _, cond := range vmi.Status.Conditions {
+		if cond.Type == v1.VirtualMachineInstancePaused {
+			return cond.Reason == v1.VirtualMachineInstanceReasonPausedByIdlePolicy
+		}
+	}
+	return false
+}
+
+// sharedSerialDialer attaches to the connection of the serial console shared by all clients, connecting it if needed
+func (t *ConsoleHandler) sharedSerialDialer(vmi *v1.VirtualMachineInstance, unixSocketPath string, readOnly bool) func() (net.Conn, error) {
+	return func() (net.Conn, error) {
+		uid := vmi.GetUID()
+		t.serialLock.Lock()
+		defer t.serialLock.Unlock()
+
+		if mux, exists := t.serialMuxes[uid]; exists {
+			if conn, ok := mux.attach(readOnly); ok {
+				return conn, nil
+			}
+		}
+		conn, err := unixSocketDialer(vmi, unixSocketPath)()
+		if err != nil {
+			return nil, err
+		}
+		var mux *serialConsoleMux
+		mux = newSerialConsoleMux(conn, func() {
+			t.serialLock.Lock()
+			defer t.serialLock.Unlock()
+			if t.serialMuxes[uid] == mux {
+				delete(t.serialMuxes, uid)
+			}
+		})
+		t.serialMuxes[uid] = mux
+		clientConn, ok := mux.attach(readOnly)
+		if !ok {
+			return nil, fmt.Errorf("serial console %s closed", unixSocketPath)
+		}
+		return clientConn, nil
+	}
+}
+
+// sharedVNCDialer connects a client to VNC in shared mode, QEMU serves several clients on the VNC socket
+func sharedVNCDialer(vmi *v1.VirtualMachineInstance, unixSocketPath string, readOnly bool) func() (net.Conn, error) {
+	return func() (net.Conn, error) {
+		conn, err := unixSocketDialer(vmi, unixSocketPath)()
+		if err != nil {
+			return nil, err
+		}
+		return newVNCClientFilter(conn, readOnly), nil
+	}
+}
+
 func (t *ConsoleHandler) VSOCKHandler(request *restful.Request, response *restful.Response) {
 	vmi, code, err := getVMI(request, t.vmiStore)
 	if err != nil || vmi == nil {
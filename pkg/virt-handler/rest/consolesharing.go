@@ -0,0 +1,384 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	serialConsoleWriteTimeout = 5 * time.Second
+	serialConsoleBufferSize   = 32 * 1024
+	vncMaxClientMessageSize   = 1024 * 1024
+)
+
+func isConsoleShared(sharing *v1.ConsoleSharing) bool {
+	return sharing != nil && sharing.Policy != "" && sharing.Policy != v1.ConsoleSharingExclusive
+}
+
+func consoleMaxConnections(sharing *v1.ConsoleSharing) int {
+	if sharing.MaxConnections == nil {
+		return v1.DefaultConsoleMaxConnections
+	}
+	return int(*sharing.MaxConnections)
+}
+
+type consoleConnectionCount struct {
+	total      int
+	fullAccess int
+}
+
+// consoleConnections counts the shared connections to the serial console or to VNC of the VMIs
+type consoleConnections struct {
+	lock        sync.Mutex
+	connections map[types.UID]*consoleConnectionCount
+}
+
+func newConsoleConnections() *consoleConnections {
+	return &consoleConnections{
+		connections: make(map[types.UID]*consoleConnectionCount),
+	}
+}
+
+// acquire admits a connection according to the sharing policy and returns whether it is read-only
+func (c *consoleConnections) acquire(uid types.UID, sharing *v1.ConsoleSharing) (bool, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	count, exists := c.connections[uid]
+	if !exists {
+		count = &consoleConnectionCount{}
+		c.connections[uid] = count
+	}
+	if maxConnections := consoleMaxConnections(sharing); count.total >= maxConnections {
+		return false, fmt.Errorf("the maximum of %d concurrent connections is reached", maxConnections)
+	}
+
+	count.total++
+	if sharing.Policy == v1.ConsoleSharingReadOnly && count.fullAccess > 0 {
+		return true, nil
+	}
+	count.fullAccess++
+	return false, nil
+}
+
+func (c *consoleConnections) release(uid types.UID, readOnly bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	count, exists := c.connections[uid]
+	if !exists {
+		return
+	}
+	count.total--
+	if !readOnly {
+		count.fullAccess--
+	}
+	if count.total <= 0 {
+		delete(c.connections, uid)
+	}
+}
+
+// serialConsoleMux shares the single connection QEMU accepts on a serial console socket between several clients
+type serialConsoleMux struct {
+	conn    net.Conn
+	onClose func()
+
+	lock    sync.Mutex
+	closed  bool
+	clients map[net.Conn]struct{}
+}
+
+func newSerialConsoleMux(conn net.Conn, onClose func()) *serialConsoleMux {
+	mux := &serialConsoleMux{
+		conn:    conn,
+		onClose: onClose,
+		clients: make(map[net.Conn]struct{}),
+	}
+	go mux.broadcastOutput()
+	return mux
+}
+
+// attach returns the connection of a new client, or false if the serial console is already closed
+func (m *serialConsoleMux) attach(readOnly bool) (net.Conn, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.closed {
+		return nil, false
+	}
+	clientConn, muxConn := net.Pipe()
+	m.clients[muxConn] = struct{}{}
+	go m.forwardInput(muxConn, readOnly)
+	return clientConn, true
+}
+
+// forwardInput writes the input of a client to the serial console, the input of read-only clients is discarded
+func (m *serialConsoleMux) forwardInput(client net.Conn, readOnly bool) {
+	dst := io.Writer(m.conn)
+	if readOnly {
+		dst = io.Discard
+	}
+	_, _ = io.Copy(dst, client)
+	m.detach(client)
+}
+
+func (m *serialConsoleMux) detach(client net.Conn) {
+	client.Close()
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.clients, client)
+	if len(m.clients) == 0 && !m.closed {
+		m.closed = true
+		m.conn.Close()
+	}
+}
+
+// broadcastOutput writes the output of the serial console to all clients, clients which do not keep up are disconnected
+func (m *serialConsoleMux) broadcastOutput() {
+	buf := make([]byte, serialConsoleBufferSize)
+	for {
+		n, err := m.conn.Read(buf)
+		if n > 0 {
+			m.lock.Lock()
+			for client := range m.clients {
+				_ = client.SetWriteDeadline(time.Now().Add(serialConsoleWriteTimeout))
+				if _, writeErr := client.Write(buf[:n]); writeErr != nil {
+					log.Log.Reason(writeErr).Warning("Disconnecting a serial console client")
+					client.Close()
+				}
+			}
+			m.lock.Unlock()
+		}
+		if err != nil {
+			break
+		}
+	}
+
+	m.lock.Lock()
+	m.closed = true
+	m.conn.Close()
+	for client := range m.clients {
+		client.Close()
+	}
+	m.lock.Unlock()
+	m.onClose()
+}
+
+type vncClientState int
+
+const (
+	vncClientProtocolVersion vncClientState = iota
+	vncClientSecurityType
+	vncClientAuthResponse
+	vncClientInit
+	vncClientMessages
+)
+
+const (
+	vncProtocolVersionLength = 12
+	vncAuthResponseLength    = 16
+
+	vncSecurityTypeNone = 1
+	vncSecurityTypeAuth = 2
+
+	vncSetPixelFormat           = 0
+	vncSetEncodings             = 2
+	vncFramebufferUpdateRequest = 3
+	vncKeyEvent                 = 4
+	vncPointerEvent             = 5
+	vncClientCutText            = 6
+	vncEnableContinuousUpdates  = 150
+	vncClientFence              = 248
+	vncQEMUClientMessage        = 255
+
+	vncQEMUExtendedKeyEvent = 0
+	vncQEMUAudio            = 1
+	vncQEMUAudioSetFormat   = 2
+)
+
+// vncClientFilter forwards the messages of a VNC client to the server. The client is always connected in shared
+// mode, so that it does not disconnect the other clients, and the input events of read-only clients are dropped.
+type vncClientFilter struct {
+	net.Conn
+	readOnly bool
+
+	state vncClientState
+	buf   []byte
+}
+
+func newVNCClientFilter(conn net.Conn, readOnly bool) *vncClientFilter {
+	return &vncClientFilter{
+		Conn:     conn,
+		readOnly: readOnly,
+	}
+}
+
+func (f *vncClientFilter) Write(p []byte) (int, error) {
+	f.buf = append(f.buf, p...)
+	for {
+		length, forward, err := f.next()
+		if err != nil {
+			return 0, err
+		}
+		if length == 0 {
+			break
+		}
+		if forward {
+			if _, err := f.Conn.Write(f.buf[:length]); err != nil {
+				return 0, err
+			}
+		}
+		f.buf = f.buf[length:]
+	}
+	if len(f.buf) > vncMaxClientMessageSize {
+		return 0, fmt.Errorf("VNC client message exceeds %d bytes", vncMaxClientMessageSize)
+	}
+	return len(p), nil
+}
+
+// next returns the length of the next complete message in the buffer and whether it is forwarded,
+// the length is zero when the message is not complete yet
+func (f *vncClientFilter) next() (int, bool, error) {
+	switch f.state {
+	case vncClientProtocolVersion:
+		if len(f.buf) < vncProtocolVersionLength {
+			return 0, false, nil
+		}
+		// Clients of RFB 3.3 do not choose the security type, the server does
+		if string(f.buf[:vncProtocolVersionLength]) == "RFB 003.003\n" {
+			f.state = vncClientInit
+		} else {
+			f.state = vncClientSecurityType
+		}
+		return vncProtocolVersionLength, true, nil
+	case vncClientSecurityType:
+		if len(f.buf) < 1 {
+			return 0, false, nil
+		}
+		switch f.buf[0] {
+		case vncSecurityTypeNone:
+			f.state = vncClientInit
+		case vncSecurityTypeAuth:
+			f.state = vncClientAuthResponse
+		default:
+			return 0, false, fmt.Errorf("unsupported VNC security type %d", f.buf[0])
+		}
+		return 1, true, nil
+	case vncClientAuthResponse:
+		if len(f.buf) < vncAuthResponseLength {
+			return 0, false, nil
+		}
+		f.state = vncClientInit
+		return vncAuthResponseLength, true, nil
+	case vncClientInit:
+		if len(f.buf) < 1 {
+			return 0, false, nil
+		}
+		// Set the shared-flag, an exclusive client would disconnect all other clients
+		f.buf[0] = 1
+		f.state = vncClientMessages
+		return 1, true, nil
+	}
+
+	length, input, err := vncClientMessageLength(f.buf)
+	if err != nil || length == 0 {
+		return 0, false, err
+	}
+	return length, !(input && f.readOnly), nil
+}
+
+// vncClientMessageLength returns the length of the client message at the start of buf and whether it is an input event
+func vncClientMessageLength(buf []byte) (int, bool, error) {
+	if len(buf) < 1 {
+		return 0, false, nil
+	}
+
+	var length int
+	input := false
+	switch buf[0] {
+	case vncSetPixelFormat:
+		length = 20
+	case vncSetEncodings:
+		if len(buf) < 4 {
+			return 0, false, nil
+		}
+		length = 4 + 4*int(binary.BigEndian.Uint16(buf[2:4]))
+	case vncFramebufferUpdateRequest, vncEnableContinuousUpdates:
+		length = 10
+	case vncKeyEvent:
+		length = 8
+		input = true
+	case vncPointerEvent:
+		length = 6
+		input = true
+	case vncClientCutText:
+		if len(buf) < 8 {
+			return 0, false, nil
+		}
+		length = 8 + int(binary.BigEndian.Uint32(buf[4:8]))
+		input = true
+	case vncClientFence:
+		if len(buf) < 9 {
+			return 0, false, nil
+		}
+		length = 9 + int(buf[8])
+	case vncQEMUClientMessage:
+		if len(buf) < 2 {
+			return 0, false, nil
+		}
+		switch buf[1] {
+		case vncQEMUExtendedKeyEvent:
+			length = 12
+			input = true
+		case vncQEMUAudio:
+			if len(buf) < 4 {
+				return 0, false, nil
+			}
+			length = 4
+			if binary.BigEndian.Uint16(buf[2:4]) == vncQEMUAudioSetFormat {
+				length = 10
+			}
+		default:
+			return 0, false, fmt.Errorf("unsupported QEMU VNC client message %d", buf[1])
+		}
+	default:
+		return 0, false, fmt.Errorf("unsupported VNC client message %d", buf[0])
+	}
+
+	if length > vncMaxClientMessageSize {
+		return 0, false, fmt.Errorf("VNC client message exceeds %d bytes", vncMaxClientMessageSize)
+	}
+	if len(buf) < length {
+		return 0, false, nil
+	}
+	return length, input, nil
+}
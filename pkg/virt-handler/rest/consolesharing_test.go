@@ -0,0 +1,199 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Console sharing", func() {
+	const uid = types.UID("test-uid")
+
+	Context("connections", func() {
+		It("should give the first connection full access and the following ones read-only access", func() {
+			connections := newConsoleConnections()
+			sharing := &v1.ConsoleSharing{Policy: v1.ConsoleSharingReadOnly}
+
+			readOnly, err := connections.acquire(uid, sharing)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readOnly).To(BeFalse())
+			readOnly, err = connections.acquire(uid, sharing)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readOnly).To(BeTrue())
+
+			connections.release(uid, false)
+			readOnly, err = connections.acquire(uid, sharing)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readOnly).To(BeFalse())
+		})
+
+		It("should give all connections full access", func() {
+			connections := newConsoleConnections()
+			sharing := &v1.ConsoleSharing{Policy: v1.ConsoleSharingFull}
+
+			for range 2 {
+				readOnly, err := connections.acquire(uid, sharing)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(readOnly).To(BeFalse())
+			}
+		})
+
+		It("should refuse connections above the maximum", func() {
+			connections := newConsoleConnections()
+			sharing := &v1.ConsoleSharing{Policy: v1.ConsoleSharingFull, MaxConnections: pointer.P(uint32(2))}
+
+			for range 2 {
+				_, err := connections.acquire(uid, sharing)
+				Expect(err).ToNot(HaveOccurred())
+			}
+			_, err := connections.acquire(uid, sharing)
+			Expect(err).To(MatchError("the maximum of 2 concurrent connections is reached"))
+
+			connections.release(uid, false)
+			_, err = connections.acquire(uid, sharing)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("VNC client filter", func() {
+		var (
+			server *bytes.Buffer
+			filter *vncClientFilter
+		)
+
+		handshake := []byte("RFB 003.008\n\x01\x00")
+		sharedHandshake := []byte("RFB 003.008\n\x01\x01")
+		framebufferUpdateRequest := []byte{3, 0, 0, 0, 0, 0, 0, 4, 0, 3}
+		keyEvent := []byte{4, 1, 0, 0, 0, 0, 0, 0x61}
+		pointerEvent := []byte{5, 1, 0, 10, 0, 10}
+
+		newFilter := func(readOnly bool) {
+			server = &bytes.Buffer{}
+			filter = newVNCClientFilter(&bufferConn{buf: server}, readOnly)
+		}
+
+		write := func(data ...[]byte) {
+			for _, d := range data {
+				n, err := filter.Write(d)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(n).To(Equal(len(d)))
+			}
+		}
+
+		It("should connect the client in shared mode", func() {
+			newFilter(false)
+			write(handshake)
+			Expect(server.Bytes()).To(Equal(sharedHandshake))
+		})
+
+		It("should forward the input of clients with full access", func() {
+			newFilter(false)
+			write(handshake, framebufferUpdateRequest, keyEvent, pointerEvent)
+			Expect(server.Bytes()).To(Equal(bytes.Join([][]byte{sharedHandshake, framebufferUpdateRequest, keyEvent, pointerEvent}, nil)))
+		})
+
+		It("should drop the input of read-only clients", func() {
+			newFilter(true)
+			write(handshake, keyEvent, framebufferUpdateRequest, pointerEvent)
+			Expect(server.Bytes()).To(Equal(bytes.Join([][]byte{sharedHandshake, framebufferUpdateRequest}, nil)))
+		})
+
+		It("should reassemble messages split across writes", func() {
+			newFilter(true)
+			stream := bytes.Join([][]byte{handshake, keyEvent, framebufferUpdateRequest}, nil)
+			for i := range stream {
+				write(stream[i : i+1])
+			}
+			Expect(server.Bytes()).To(Equal(bytes.Join([][]byte{sharedHandshake, framebufferUpdateRequest}, nil)))
+		})
+
+		It("should fail on unknown client messages", func() {
+			newFilter(false)
+			write(handshake)
+			_, err := filter.Write([]byte{42})
+			Expect(err).To(MatchError("unsupported VNC client message 42"))
+		})
+	})
+
+	Context("serial console mux", func() {
+		It("should broadcast the output and only forward the input of clients with full access", func() {
+			console, qemu := net.Pipe()
+			closed := make(chan struct{})
+			mux := newSerialConsoleMux(console, func() { close(closed) })
+
+			fullAccess, ok := mux.attach(false)
+			Expect(ok).To(BeTrue())
+			observer, ok := mux.attach(true)
+			Expect(ok).To(BeTrue())
+
+			_, err := observer.Write([]byte("ignored"))
+			Expect(err).ToNot(HaveOccurred())
+			go func() {
+				_, _ = fullAccess.Write([]byte("ls\r"))
+			}()
+			Expect(qemu.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+			input := make([]byte, 3)
+			_, err = io.ReadFull(qemu, input)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(input)).To(Equal("ls\r"))
+
+			go func() {
+				_, _ = qemu.Write([]byte("file"))
+			}()
+			outputs := make(chan string, 2)
+			for _, client := range []net.Conn{fullAccess, observer} {
+				go func() {
+					output := make([]byte, 4)
+					_ = client.SetReadDeadline(time.Now().Add(5 * time.Second))
+					_, _ = io.ReadFull(client, output)
+					outputs <- string(output)
+				}()
+			}
+			Eventually(outputs).WithTimeout(5 * time.Second).Should(Receive(Equal("file")))
+			Eventually(outputs).WithTimeout(5 * time.Second).Should(Receive(Equal("file")))
+
+			fullAccess.Close()
+			observer.Close()
+			Eventually(closed).WithTimeout(5 * time.Second).Should(BeClosed())
+			_, ok = mux.attach(false)
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
+
+type bufferConn struct {
+	net.Conn
+	buf *bytes.Buffer
+}
+
+func (c *bufferConn) Write(p []byte) (int, error) {
+	return c.buf.Write(p)
+}
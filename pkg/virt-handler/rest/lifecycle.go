@@ -34,6 +34,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 const (
@@ -237,6 +238,137 @@ func (lh *LifecycleHandler) GetFilesystems(request *restful.Request, response *r
 	response.WriteEntity(fsList)
 }
 
+func (lh *LifecycleHandler) GuestOSExecHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	opts := &v1.GuestOSExecOptions{}
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Error("No command in guest os exec request")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve the command"))
+		return
+	}
+
+	defer request.Request.Body.Close()
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		log.Log.Object(vmi).Reason(err).Error("Failed to unmarshal guest os exec request")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to unmarshal the command"))
+		return
+	}
+
+	if opts.Command == "" || opts.TimeoutSeconds == nil {
+		log.Log.Object(vmi).Error("Command or timeout in guest os exec request is not set")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("Command or timeout in guest os exec request is not set"))
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Running command %s in the guest", opts.Command)
+
+	result, err := client.GuestOSExec(api.VMINamespaceKeyFunc(vmi), opts.Command, opts.Args, *opts.TimeoutSeconds)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to run command in the guest")
+		lh.recorder.Eventf(vmi, k8sv1.EventTypeWarning, "GuestOSExecFailed", "Failed to run command %s in the guest: %s", opts.Command, err.Error())
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, "GuestOSExec", "Command %s ran in the guest and exited with code %d", opts.Command, result.ExitCode)
+	response.WriteEntity(result)
+}
+
+func (lh *LifecycleHandler) GuestFileReadHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	opts := &v1.GuestFileReadOptions{}
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Error("No path in guest file read request")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve the path"))
+		return
+	}
+
+	defer request.Request.Body.Close()
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		log.Log.Object(vmi).Reason(err).Error("Failed to unmarshal guest file read request")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to unmarshal the path"))
+		return
+	}
+
+	if opts.Path == "" {
+		log.Log.Object(vmi).Error("Path in guest file read request is not set")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("Path in guest file read request is not set"))
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Reading file %s in the guest", opts.Path)
+
+	content, err := client.GuestFileRead(api.VMINamespaceKeyFunc(vmi), opts.Path, v1.GuestFileMaxSize)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to read file %s in the guest", opts.Path)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(v1.GuestFile{
+		Path:    opts.Path,
+		Content: content,
+	})
+}
+
+func (lh *LifecycleHandler) GuestFileWriteHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	guestFile := &v1.GuestFile{}
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Error("No file in guest file write request")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve the file"))
+		return
+	}
+
+	defer request.Request.Body.Close()
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(guestFile)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		log.Log.Object(vmi).Reason(err).Error("Failed to unmarshal guest file write request")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to unmarshal the file"))
+		return
+	}
+
+	if guestFile.Path == "" || len(guestFile.Content) > v1.GuestFileMaxSize {
+		log.Log.Object(vmi).Error("Path in guest file write request is not set or the content is too large")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("Path in guest file write request is not set or the content is too large"))
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Writing file %s in the guest", guestFile.Path)
+
+	if err := client.GuestFileWrite(api.VMINamespaceKeyFunc(vmi), guestFile.Path, guestFile.Content); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to write file %s in the guest", guestFile.Path)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, "GuestFileWritten", "File %s was written in the guest", guestFile.Path)
+	response.WriteHeader(http.StatusOK)
+}
+
 func (lh *LifecycleHandler) getVMILauncherClient(request *restful.Request, response *restful.Response) (*v1.VirtualMachineInstance, cmdclient.LauncherClient, error) {
 	vmi, code, err := getVMI(request, lh.vmiStore)
 	if err != nil {
@@ -0,0 +1,148 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/gorilla/websocket"
+
+	v1 "kubevirt.io/api/core/v1"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+)
+
+const (
+	memoryDumpStreamInterval = 100 * time.Millisecond
+	memoryDumpStreamTimeout  = 1 * time.Minute
+
+	// maxCloseReasonLength keeps the close message within the 125 bytes of a websocket control frame
+	maxCloseReasonLength = 120
+)
+
+// MemoryDumpStreamHandler streams a dump of the guest memory to the client. virt-launcher dumps the
+// memory to a fifo in its private directory, which is copied to the websocket until the dump is done.
+// The outcome of the dump is reported in the close message of the websocket.
+func (t *ConsoleHandler) MemoryDumpStreamHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, t.vmiStore)
+	if err != nil || vmi == nil {
+		log.Log.Reason(err).Error(failedRetrieveVMI)
+		response.WriteError(code, err)
+		return
+	}
+	options := &v1.MemoryDumpStreamOptions{
+		Format:  v1.MemoryDumpFormat(request.QueryParameter("format")),
+		Content: v1.MemoryDumpContent(request.QueryParameter("content")),
+	}
+
+	sockFile, err := cmdclient.FindSocket(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedDetectCmdClient)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	client, err := cmdclient.NewClient(sockFile)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedConnectCmdClient)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	defer client.Close()
+
+	streamName := fmt.Sprintf("virt-memory-dump-%d", time.Now().UnixNano())
+	dumpErr := make(chan error, 1)
+	go func() {
+		dumpErr <- client.VirtualMachineMemoryDumpStream(vmi, filepath.Join(util.VirtPrivateDir, string(vmi.GetUID()), streamName), options)
+	}()
+
+	stream, err := t.openMemoryDumpStream(vmi, streamName, dumpErr)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to open the memory dump stream")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	defer stream.Close()
+
+	upgrader := kvcorev1.NewUpgrader()
+	clientSocket, err := upgrader.Upgrade(response.ResponseWriter, request.Request, nil)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to upgrade client websocket connection")
+		return
+	}
+	defer clientSocket.Close()
+
+	log.Log.Object(vmi).Infof("Streaming memory dump, format %q, content %q", options.Format, options.Content)
+	written, copyErr := kvcorev1.CopyTo(clientSocket, stream)
+	if copyErr != nil {
+		// The client went away, closing the fifo fails the dump in virt-launcher
+		stream.Close()
+	}
+	err = <-dumpErr
+	if err == nil {
+		err = copyErr
+	}
+
+	closeMessage := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to stream the memory dump")
+		reason := err.Error()
+		if len(reason) > maxCloseReasonLength {
+			reason = reason[:maxCloseReasonLength]
+		}
+		closeMessage = websocket.FormatCloseMessage(websocket.CloseInternalServerErr, reason)
+	} else {
+		log.Log.Object(vmi).Infof("Streamed memory dump of %d bytes", written)
+	}
+	clientSocket.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(memoryDumpStreamInterval))
+}
+
+// openMemoryDumpStream opens the fifo virt-launcher dumps the memory to, once it is created. It fails
+// early if virt-launcher refused the dump.
+func (t *ConsoleHandler) openMemoryDumpStream(vmi *v1.VirtualMachineInstance, streamName string, dumpErr chan error) (*os.File, error) {
+	ticker := time.NewTicker(memoryDumpStreamInterval)
+	defer ticker.Stop()
+	timeout := time.After(memoryDumpStreamTimeout)
+	for {
+		select {
+		case err := <-dumpErr:
+			if err == nil {
+				err = fmt.Errorf("the memory dump completed before it was streamed")
+			}
+			return nil, err
+		case <-timeout:
+			return nil, fmt.Errorf("timed out waiting for the memory dump stream")
+		case <-ticker.C:
+			streamPath, err := t.getUnixSocketPath(vmi, streamName)
+			if err != nil {
+				continue
+			}
+			// Opening blocks until virt-launcher opens the fifo for writing
+			return os.Open(streamPath)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("Memory dump stream", func() {
+	It("should fail when virt-launcher refuses the dump", func() {
+		dumpErr := make(chan error, 1)
+		dumpErr <- errors.New("a memory dump is already in progress")
+
+		_, err := (&ConsoleHandler{}).openMemoryDumpStream(&v1.VirtualMachineInstance{}, "virt-memory-dump-1", dumpErr)
+		Expect(err).To(MatchError("a memory dump is already in progress"))
+	})
+
+	It("should fail when virt-launcher completes the dump without streaming it", func() {
+		dumpErr := make(chan error, 1)
+		dumpErr <- nil
+
+		_, err := (&ConsoleHandler{}).openMemoryDumpStream(&v1.VirtualMachineInstance{}, "virt-memory-dump-1", dumpErr)
+		Expect(err).To(MatchError("the memory dump completed before it was streamed"))
+	})
+})
@@ -0,0 +1,122 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+const kibibyte = 1024
+
+func (lh *LifecycleHandler) GetStats(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	domainStats, exists, err := client.GetDomainStats()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get domain stats")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	if !exists || domainStats == nil {
+		response.WriteError(http.StatusNotFound, fmt.Errorf("no stats available for VMI %s", vmi.Name))
+		return
+	}
+
+	response.WriteEntity(convertDomainStats(domainStats, time.Now()))
+}
+
+// convertDomainStats returns the stats of the domain reported by virt-launcher in the format of the API
+func convertDomainStats(domainStats *stats.DomainStats, timestamp time.Time) *v1.VirtualMachineInstanceStats {
+	vmiStats := &v1.VirtualMachineInstanceStats{
+		Timestamp: metav1.NewTime(timestamp),
+		CPU: v1.VirtualMachineInstanceCPUStats{
+			VCPUs: int64(domainStats.NrVirtCpu),
+		},
+	}
+
+	if domainStats.Cpu != nil && domainStats.Cpu.TimeSet {
+		vmiStats.CPU.TimeNanoseconds = int64(domainStats.Cpu.Time)
+	}
+
+	if mem := domainStats.Memory; mem != nil {
+		if mem.TotalSet {
+			vmiStats.Memory.DomainBytes = int64(mem.Total * kibibyte)
+		}
+		if mem.RSSSet {
+			vmiStats.Memory.ResidentBytes = int64(mem.RSS * kibibyte)
+		}
+		if mem.AvailableSet {
+			vmiStats.Memory.AvailableBytes = int64(mem.Available * kibibyte)
+		}
+		if mem.UnusedSet {
+			vmiStats.Memory.UnusedBytes = int64(mem.Unused * kibibyte)
+		}
+	}
+
+	for _, block := range domainStats.Block {
+		if !block.NameSet {
+			continue
+		}
+		name := block.Name
+		if block.Alias != "" {
+			name = block.Alias
+		}
+		vmiStats.Storage = append(vmiStats.Storage, v1.VirtualMachineInstanceStorageStats{
+			Name:          name,
+			ReadBytes:     int64(block.RdBytes),
+			WriteBytes:    int64(block.WrBytes),
+			ReadRequests:  int64(block.RdReqs),
+			WriteRequests: int64(block.WrReqs),
+		})
+	}
+
+	for _, net := range domainStats.Net {
+		if !net.NameSet {
+			continue
+		}
+		name := net.Name
+		if net.AliasSet {
+			name = net.Alias
+		}
+		vmiStats.Network = append(vmiStats.Network, v1.VirtualMachineInstanceNetworkStats{
+			Name:            name,
+			ReceiveBytes:    int64(net.RxBytes),
+			TransmitBytes:   int64(net.TxBytes),
+			ReceivePackets:  int64(net.RxPkts),
+			TransmitPackets: int64(net.TxPkts),
+		})
+	}
+
+	return vmiStats
+}
@@ -0,0 +1,93 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("Stats", func() {
+	now := time.Unix(1000, 0)
+
+	It("should convert the domain stats", func() {
+		domainStats := &stats.DomainStats{
+			NrVirtCpu: 2,
+			Cpu: &stats.DomainStatsCPU{
+				TimeSet: true,
+				Time:    3000000000,
+			},
+			Memory: &stats.DomainStatsMemory{
+				TotalSet:     true,
+				Total:        1024,
+				RSSSet:       true,
+				RSS:          512,
+				AvailableSet: true,
+				Available:    1000,
+				UnusedSet:    true,
+				Unused:       200,
+			},
+			Block: []stats.DomainStatsBlock{
+				{NameSet: true, Name: "vda", Alias: "rootdisk", RdBytes: 10, WrBytes: 20, RdReqs: 1, WrReqs: 2},
+				{NameSet: true, Name: "vdb", RdBytes: 30},
+			},
+			Net: []stats.DomainStatsNet{
+				{NameSet: true, Name: "tap0", AliasSet: true, Alias: "default", RxBytes: 100, TxBytes: 200, RxPkts: 3, TxPkts: 4},
+			},
+		}
+
+		Expect(convertDomainStats(domainStats, now)).To(Equal(&v1.VirtualMachineInstanceStats{
+			Timestamp: metav1.NewTime(now),
+			CPU:       v1.VirtualMachineInstanceCPUStats{VCPUs: 2, TimeNanoseconds: 3000000000},
+			Memory: v1.VirtualMachineInstanceMemoryStats{
+				DomainBytes:    1024 * 1024,
+				ResidentBytes:  512 * 1024,
+				AvailableBytes: 1000 * 1024,
+				UnusedBytes:    200 * 1024,
+			},
+			Storage: []v1.VirtualMachineInstanceStorageStats{
+				{Name: "rootdisk", ReadBytes: 10, WriteBytes: 20, ReadRequests: 1, WriteRequests: 2},
+				{Name: "vdb", ReadBytes: 30},
+			},
+			Network: []v1.VirtualMachineInstanceNetworkStats{
+				{Name: "default", ReceiveBytes: 100, TransmitBytes: 200, ReceivePackets: 3, TransmitPackets: 4},
+			},
+		}))
+	})
+
+	It("should skip devices without name and stats which are not set", func() {
+		domainStats := &stats.DomainStats{
+			Block: []stats.DomainStatsBlock{{RdBytes: 10}},
+			Net:   []stats.DomainStatsNet{{RxBytes: 10}},
+		}
+
+		Expect(convertDomainStats(domainStats, now)).To(Equal(&v1.VirtualMachineInstanceStats{
+			Timestamp: metav1.NewTime(now),
+		}))
+	})
+})
@@ -30,6 +30,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/mitchellh/go-ps"
@@ -68,6 +69,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/hardware"
 	"kubevirt.io/kubevirt/pkg/util/migrations"
+	"kubevirt.io/kubevirt/pkg/util/watchdog"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 	virtcache "kubevirt.io/kubevirt/pkg/virt-handler/cache"
@@ -83,6 +85,7 @@ import (
 	multipathmonitor "kubevirt.io/kubevirt/pkg/virt-handler/multipath-monitor"
 	"kubevirt.io/kubevirt/pkg/virt-handler/selinux"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
 type netstat interface {
@@ -113,6 +116,10 @@ type VirtualMachineController struct {
 	vmiExpectations          *controller.UIDTrackingControllerExpectations
 	vmiGlobalStore           cache.Store
 	multipathSocketMonitor   *multipathmonitor.MultipathSocketMonitor
+	// idleSamples holds the last idleSample of the VMIs with an idle policy, by UID
+	idleSamples sync.Map
+	// performanceSamples holds the last performanceSample of the VMIs, by UID
+	performanceSamples sync.Map
 }
 
 var getCgroupManager = func(vmi *v1.VirtualMachineInstance, host string) (cgroup.Manager, error) {
@@ -620,6 +627,12 @@ func (c *VirtualMachineController) updateVolumeStatusesFromDomain(vmi *v1.Virtua
 				diskDeviceMap[disk.Alias.GetName()] = disk.Target.Device
 			}
 		}
+		// hotplugged filesystems are exposed to the guest through their mount tag
+		for _, fs := range domain.Spec.Devices.Filesystems {
+			if fs.Source != nil && fs.Target != nil && fs.Source.Socket == virtiofs.HotplugVirtioFSSocketPath(fs.Target.Dir) {
+				diskDeviceMap[fs.Target.Dir] = fs.Target.Dir
+			}
+		}
 	}
 	specVolumeMap := make(map[string]v1.Volume)
 	for _, volume := range vmi.Spec.Volumes {
@@ -673,6 +686,23 @@ func (c *VirtualMachineController) updateGuestInfoFromDomain(vmi *v1.VirtualMach
 	vmi.Status.GuestOSInfo.ID = domain.Status.OSInfo.Id
 }
 
+// updateGuestDrivers reports the drivers of the guest devices, the guest agent only reports them for Windows guests
+func (c *VirtualMachineController) updateGuestDrivers(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || domain.Status.GuestDrivers == nil {
+		return
+	}
+
+	drivers := make([]v1.VirtualMachineInstanceGuestDriver, 0, len(domain.Status.GuestDrivers))
+	for _, driver := range domain.Status.GuestDrivers {
+		drivers = append(drivers, v1.VirtualMachineInstanceGuestDriver{
+			Name:    driver.Name,
+			Version: driver.Version,
+			Date:    driver.Date,
+		})
+	}
+	vmi.Status.GuestDrivers = drivers
+}
+
 func (c *VirtualMachineController) updateAccessCredentialConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
 
 	if domain == nil || domain.Spec.Metadata.KubeVirt.AccessCredential == nil {
@@ -833,6 +863,33 @@ func (c *VirtualMachineController) updatePausedConditions(vmi *v1.VirtualMachine
 	}
 }
 
+// updateWatchdogConditions reports the latest expiry of the watchdog of the guest. The action is pending
+// until it is taken by virt-controller if it is taken by KubeVirt, rather than by the hypervisor.
+func (c *VirtualMachineController) updateWatchdogConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.Watchdog == nil || domain.Spec.Metadata.KubeVirt.Watchdog.ExpiredTimestamp == nil {
+		return
+	}
+	expired := domain.Spec.Metadata.KubeVirt.Watchdog.ExpiredTimestamp
+	if condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceWatchdogExpired); condition != nil && !condition.LastTransitionTime.Before(expired) {
+		return
+	}
+
+	action := watchdog.Action(vmi)
+	reason := v1.VirtualMachineInstanceReasonWatchdogActionTaken
+	if watchdog.IsHandledByKubeVirt(action) && action != v1.WatchdogActionAlert {
+		reason = v1.VirtualMachineInstanceReasonWatchdogActionPending
+	}
+	condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceWatchdogExpired)
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceWatchdogExpired,
+		Status:             k8sv1.ConditionTrue,
+		LastTransitionTime: *expired,
+		Reason:             reason,
+		Message:            fmt.Sprintf("The watchdog expired, action %s", action),
+	})
+	c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, string(v1.VirtualMachineInstanceWatchdogExpired), "The watchdog of the guest expired, action %s", action)
+}
+
 func dumpTargetFile(vmiName, volName string) string {
 	targetFileName := fmt.Sprintf("%s-%s-%s.memory.dump", vmiName, volName, time.Now().Format("20060102-150405"))
 	return targetFileName
@@ -1006,10 +1063,48 @@ func (c *VirtualMachineController) updateMemoryInfo(vmi *v1.VirtualMachineInstan
 		vmi.Status.Memory = &v1.MemoryStatus{}
 	}
 	currentGuest := parseLibvirtQuantity(int64(domain.Spec.CurrentMemory.Value), domain.Spec.CurrentMemory.Unit)
+	if memoryStatus := vmi.Status.Memory; memoryStatus.BalloonRequested != nil {
+		memoryStatus.BalloonActual = currentGuest
+		// An inflated balloon lowers the current memory of the domain without unplugging it from the guest
+		if memoryStatus.GuestRequested != nil && memoryStatus.BalloonRequested.Cmp(*memoryStatus.GuestRequested) < 0 {
+			return nil
+		}
+	}
 	vmi.Status.Memory.GuestCurrent = currentGuest
 	return nil
 }
 
+func (c *VirtualMachineController) updateVCPUPinning(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || !vmi.IsCPUDedicated() || domain.Spec.CPUTune == nil || len(domain.Spec.CPUTune.VCPUPin) == 0 {
+		return
+	}
+	if vmi.Status.VCPUPinning == nil {
+		vmi.Status.VCPUPinning = &v1.VCPUPinningStatus{}
+	}
+	pinningStatus := vmi.Status.VCPUPinning
+
+	pinningStatus.VCPUs = make([]v1.VCPUPin, 0, len(domain.Spec.CPUTune.VCPUPin))
+	currentCPUs := map[uint32][]int{}
+	for _, vcpupin := range domain.Spec.CPUTune.VCPUPin {
+		pinningStatus.VCPUs = append(pinningStatus.VCPUs, v1.VCPUPin{VCPU: vcpupin.VCPU, CPUSet: vcpupin.CPUSet})
+		currentCPUs[vcpupin.VCPU] = parseSortedCPUSet(vcpupin.CPUSet)
+	}
+
+	// The re-pinning request is done once the domain reports the requested pinning
+	for _, pin := range pinningStatus.Requested {
+		if !equality.Semantic.DeepEqual(currentCPUs[pin.VCPU], parseSortedCPUSet(pin.CPUSet)) {
+			return
+		}
+	}
+	pinningStatus.Requested = nil
+}
+
+func parseSortedCPUSet(cpuSet string) []int {
+	cpus, _ := hardware.ParseCPUSetLine(cpuSet, 50000)
+	sort.Ints(cpus)
+	return cpus
+}
+
 func (c *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	c.updateIsoSizeStatus(vmi)
 	err := c.updateSELinuxContext(vmi)
@@ -1017,12 +1112,14 @@ func (c *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 		c.logger.Reason(err).Errorf("couldn't find the SELinux context for %s", vmi.Name)
 	}
 	c.updateGuestInfoFromDomain(vmi, domain)
+	c.updateGuestDrivers(vmi, domain)
 	c.updateVolumeStatusesFromDomain(vmi, domain)
 	c.updateFSFreezeStatus(vmi, domain)
 	c.updateMachineType(vmi, domain)
 	if err = c.updateMemoryInfo(vmi, domain); err != nil {
 		return err
 	}
+	c.updateVCPUPinning(vmi, domain)
 	err = c.netStat.UpdateStatus(vmi, domain)
 	return err
 }
@@ -1035,6 +1132,8 @@ func (c *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineIns
 		return err
 	}
 	c.updatePausedConditions(vmi, domain, condManager)
+	c.updateWatchdogConditions(vmi, domain, condManager)
+	c.updateGuestPanickedCondition(vmi, domain, condManager)
 
 	return nil
 }
@@ -1148,13 +1247,17 @@ func (c *VirtualMachineController) calculatePausedCondition(vmi *v1.VirtualMachi
 		})
 	case api.ReasonPausedUser:
 		c.logger.Object(vmi).V(3).Info("Adding paused condition")
+		reason, message := "PausedByUser", "VMI was paused by user"
+		if c.isVMIPausedByIdlePolicy(vmi) {
+			reason, message = v1.VirtualMachineInstanceReasonPausedByIdlePolicy, "VMI was paused by its idle policy"
+		}
 		vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
 			Type:               v1.VirtualMachineInstancePaused,
 			Status:             k8sv1.ConditionTrue,
 			LastProbeTime:      now,
 			LastTransitionTime: now,
-			Reason:             "PausedByUser",
-			Message:            "VMI was paused by user",
+			Reason:             reason,
+			Message:            message,
 		})
 	case api.ReasonPausedIOError:
 		c.logger.Object(vmi).V(3).Info("Adding paused condition")
@@ -1192,8 +1295,8 @@ func (c *VirtualMachineController) calculateLiveMigrationCondition(vmi *v1.Virtu
 		return newNonMigratableCondition(err.Error(), v1.VirtualMachineInstanceReasonCPUModeNotMigratable), isBlockMigration
 	}
 
-	if vmiContainsPCIHostDevice(vmi) {
-		return newNonMigratableCondition("VMI uses a PCI host devices", v1.VirtualMachineInstanceReasonHostDeviceNotMigratable), isBlockMigration
+	if err := c.checkHostDevicesForMigration(vmi); err != nil {
+		return newNonMigratableCondition(err.Error(), v1.VirtualMachineInstanceReasonHostDeviceNotMigratable), isBlockMigration
 	}
 
 	if util.IsSEVVMI(vmi) {
@@ -1230,6 +1333,47 @@ func vmiContainsPCIHostDevice(vmi *v1.VirtualMachineInstance) bool {
 	return len(vmi.Spec.Domain.Devices.HostDevices) > 0 || len(vmi.Spec.Domain.Devices.GPUs) > 0
 }
 
+// checkHostDevicesForMigration returns an error if the VMI uses host devices which can't be
+// unplugged before the migration and plugged again on the target
+func (c *VirtualMachineController) checkHostDevicesForMigration(vmi *v1.VirtualMachineInstance) error {
+	if !vmiContainsPCIHostDevice(vmi) {
+		return nil
+	}
+
+	replugHostDevices := c.clusterConfig.GetMigrationConfiguration().ReplugHostDevices
+	if replugHostDevices == nil || !*replugHostDevices {
+		return fmt.Errorf("VMI uses a PCI host devices")
+	}
+
+	for _, gpu := range vmi.Spec.Domain.Devices.GPUs {
+		if drautil.IsGPUDRA(gpu) {
+			return fmt.Errorf("VMI uses a DRA GPU")
+		}
+		if !isGPUDisplayDisabled(gpu) {
+			return fmt.Errorf("VMI uses a GPU with a display")
+		}
+	}
+	for _, hostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		if drautil.IsHostDeviceDRA(hostDevice) {
+			return fmt.Errorf("VMI uses a DRA host device")
+		}
+	}
+
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	if !condManager.HasConditionWithStatus(vmi, v1.VirtualMachineInstanceAgentConnected, k8sv1.ConditionTrue) {
+		return fmt.Errorf("VMI uses host devices and its guest agent is not connected")
+	}
+
+	return nil
+}
+
+func isGPUDisplayDisabled(gpu v1.GPU) bool {
+	return gpu.VirtualGPUOptions != nil &&
+		gpu.VirtualGPUOptions.Display != nil &&
+		gpu.VirtualGPUOptions.Display.Enabled != nil &&
+		!*gpu.VirtualGPUOptions.Display.Enabled
+}
+
 type multipleNonMigratableCondition struct {
 	reasons []string
 	msgs    []string
@@ -1283,8 +1427,8 @@ func (c *VirtualMachineController) calculateLiveStorageMigrationCondition(vmi *v
 		multiCond.addNonMigratableCondition(v1.VirtualMachineInstanceReasonCPUModeNotMigratable, err.Error())
 	}
 
-	if vmiContainsPCIHostDevice(vmi) {
-		multiCond.addNonMigratableCondition(v1.VirtualMachineInstanceReasonHostDeviceNotMigratable, "VMI uses a PCI host devices")
+	if err := c.checkHostDevicesForMigration(vmi); err != nil {
+		multiCond.addNonMigratableCondition(v1.VirtualMachineInstanceReasonHostDeviceNotMigratable, err.Error())
 	}
 
 	if util.IsSEVVMI(vmi) {
@@ -1519,6 +1663,8 @@ func (c *VirtualMachineController) processVmCleanup(vmi *v1.VirtualMachineInstan
 	c.teardownNetwork(vmi)
 
 	c.sriovHotplugExecutorPool.Delete(vmi.UID)
+	c.idleSamples.Delete(vmi.UID)
+	c.performanceSamples.Delete(vmi.UID)
 
 	// Watch dog file and command client must be the last things removed here
 	c.launcherClients.CloseLauncherClient(vmi)
@@ -1731,6 +1877,14 @@ func (c *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachi
 	// Some combinations of disks makes the VMI no suitable for live migration.
 	// A relevant error will be returned in this case.
 	for _, volume := range vmi.Spec.Volumes {
+		if _, ok := filesystems[volume.Name]; ok && storagetypes.IsHotplugVolume(&volume) {
+			// The virtiofsd of hotplugged filesystems runs in virt-launcher and isn't started on the target
+			return true, fmt.Errorf("cannot migrate VMI with hotplugged virtiofs filesystem %s", volume.Name)
+		}
+		if volume.VhostUserBlk != nil {
+			// The storage behind the socket is local to the vhost-user-blk target of the node
+			return true, fmt.Errorf("cannot migrate VMI with vhost-user-blk volume %s", volume.Name)
+		}
 		volSrc := volume.VolumeSource
 		if volSrc.PersistentVolumeClaim != nil || volSrc.DataVolume != nil {
 			var claimName string
@@ -1981,6 +2135,14 @@ func (c *VirtualMachineController) handleRunningVMI(vmi *v1.VirtualMachineInstan
 		return err
 	}
 
+	if err := c.getCrashDump(vmi); err != nil {
+		return err
+	}
+
+	c.reconcileMemoryBalloon(vmi)
+	c.reconcileIdlePolicy(vmi)
+	c.reconcilePerformanceConditions(vmi)
+
 	isolationRes, err := c.podIsolationDetector.Detect(vmi)
 	if err != nil {
 		return fmt.Errorf(failedDetectIsolationFmt, err)
@@ -2113,6 +2275,13 @@ func (c *VirtualMachineController) handleHousekeeping(vmi *v1.VirtualMachineInst
 			return err
 		}
 	}
+	if hasMemoryOvercommitClass(vmi) && !vmi.IsRunning() && !vmi.IsFinal() {
+		swapAllowed := vmi.Spec.Domain.Memory.Overcommit != v1.MemoryOvercommitGuaranteed
+		c.logger.V(3).Object(vmi).Infof("Configuring memory swap, allowed: %t", swapAllowed)
+		if err := cgroupManager.SetMemorySwap(swapAllowed); err != nil {
+			return fmt.Errorf("failed to configure memory swap: %v", err)
+		}
+	}
 	if !domainExists {
 		c.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.Created.String(), VMIDefined)
 	}
@@ -2126,6 +2295,10 @@ func (c *VirtualMachineController) handleHousekeeping(vmi *v1.VirtualMachineInst
 	return nil
 }
 
+func hasMemoryOvercommitClass(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Overcommit != ""
+}
+
 func (c *VirtualMachineController) getPreallocatedVolumes(vmi *v1.VirtualMachineInstance) []string {
 	var preallocatedVolumes []string
 	for _, volumeStatus := range vmi.Status.VolumeStatus {
@@ -2302,6 +2475,9 @@ func (c *VirtualMachineController) calculateVmPhaseForStatusReason(domain *api.D
 		case api.Shutoff, api.Crashed:
 			switch domain.Status.Reason {
 			case api.ReasonCrashed, api.ReasonPanicked:
+				if isCrashDumpInProgress(vmi, domain) {
+					return v1.Running, nil
+				}
 				return v1.Failed, nil
 			case api.ReasonDestroyed:
 				if isACPIEnabled(vmi, domain) {
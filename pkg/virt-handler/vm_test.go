@@ -544,6 +544,26 @@ var _ = Describe("VirtualMachineInstance", func() {
 			testutils.ExpectEvent(recorder, VMIDefined)
 		})
 
+		DescribeTable("should configure the memory swap of the overcommit class when creating the Domain", func(class v1.MemoryOvercommitClass, swapAllowed bool) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+			vmi.Spec.Domain.Memory = &v1.Memory{Overcommit: class}
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			createVMI(vmi)
+			mockCgroupManager.EXPECT().SetMemorySwap(swapAllowed).Return(nil)
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil)
+			sanityExecute()
+			testutils.ExpectEvent(recorder, VMIDefined)
+		},
+			Entry("Guaranteed", v1.MemoryOvercommitGuaranteed, false),
+			Entry("BurstableWithSwap", v1.MemoryOvercommitBurstableWithSwap, true),
+			Entry("BestEffort", v1.MemoryOvercommitBestEffort, true),
+		)
+
 		It("should update the qemu machine type on the VMI status", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -2092,6 +2112,21 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(blockMigrate).To(BeTrue())
 			Expect(err).To(Equal(fmt.Errorf("cannot migrate VMI with non-shared HostDisk")))
 		})
+		It("should not be allowed to live-migrate a VMI with a vhost-user-blk volume", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "spdk-disk",
+					VolumeSource: v1.VolumeSource{
+						VhostUserBlk: &v1.VhostUserBlkVolumeSource{SocketName: "vhost.0"},
+					},
+				},
+			}
+
+			blockMigrate, err := controller.checkVolumesForMigration(vmi)
+			Expect(blockMigrate).To(BeTrue())
+			Expect(err).To(MatchError("cannot migrate VMI with vhost-user-blk volume spdk-disk"))
+		})
 		DescribeTable("with host model", func(hostCpuModel string) {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeHostModel}
@@ -2244,6 +2279,45 @@ var _ = Describe("VirtualMachineInstance", func() {
 				Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
 				Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonHostDeviceNotMigratable))
 			})
+
+			Context("when host devices are replugged", func() {
+				displayDisabled := &v1.VGPUOptions{Display: &v1.VGPUDisplayOptions{Enabled: pointer.P(false)}}
+
+				BeforeEach(func() {
+					kv := &v1.KubeVirtConfiguration{
+						MigrationConfiguration: &v1.MigrationConfiguration{
+							ReplugHostDevices: pointer.P(true),
+						},
+					}
+					config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(kv)
+					controller.clusterConfig = config
+				})
+
+				DescribeTable("should calculate the live migration condition", func(gpus []v1.GPU, agentConnected bool, expectedStatus k8sv1.ConditionStatus) {
+					vmi := api2.NewMinimalVMI("testvmi")
+					vmi.Spec.Domain.Devices.GPUs = gpus
+					vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: "name1", DeviceName: "dev1"}}
+					if agentConnected {
+						vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+							Type:   v1.VirtualMachineInstanceAgentConnected,
+							Status: k8sv1.ConditionTrue,
+						}}
+					}
+
+					condition, _ := controller.calculateLiveMigrationCondition(vmi)
+					Expect(condition.Type).To(Equal(v1.VirtualMachineInstanceIsMigratable))
+					Expect(condition.Status).To(Equal(expectedStatus))
+					if expectedStatus == k8sv1.ConditionFalse {
+						Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonHostDeviceNotMigratable))
+					}
+				},
+					Entry("allow host devices if the guest agent is connected", nil, true, k8sv1.ConditionTrue),
+					Entry("allow GPUs without display", []v1.GPU{{Name: "gpu1", DeviceName: "dev1", VirtualGPUOptions: displayDisabled}}, true, k8sv1.ConditionTrue),
+					Entry("reject host devices if the guest agent is not connected", nil, false, k8sv1.ConditionFalse),
+					Entry("reject GPUs with display", []v1.GPU{{Name: "gpu1", DeviceName: "dev1"}}, true, k8sv1.ConditionFalse),
+					Entry("reject DRA GPUs", []v1.GPU{{Name: "gpu1", ClaimRequest: &v1.ClaimRequest{}, VirtualGPUOptions: displayDisabled}}, true, k8sv1.ConditionFalse),
+				)
+			})
 		})
 
 		It("should not be allowed to live-migrate if the VMI uses SEV", func() {
@@ -2587,6 +2661,59 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(updatedVMI.Status.Memory.GuestCurrent).To(Equal(pointer.P(resource.MustParse("512Ki"))))
 		})
+
+		DescribeTable("should update the vCPU pinning in VMI status", func(requested, expectedRequested []v1.VCPUPin) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.CPU = &v1.CPU{DedicatedCPUPlacement: true}
+			vmi.Status.VCPUPinning = &v1.VCPUPinningStatus{Requested: requested}
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.CPUTune = &api.CPUTune{VCPUPin: []api.CPUTuneVCPUPin{
+				{VCPU: 0, CPUSet: "2"},
+				{VCPU: 1, CPUSet: "4-5"},
+			}}
+
+			controller.updateVCPUPinning(vmi, domain)
+			Expect(vmi.Status.VCPUPinning.VCPUs).To(Equal([]v1.VCPUPin{{VCPU: 0, CPUSet: "2"}, {VCPU: 1, CPUSet: "4-5"}}))
+			Expect(vmi.Status.VCPUPinning.Requested).To(Equal(expectedRequested))
+		},
+			Entry("without a re-pinning request", nil, nil),
+			Entry("and clear a fulfilled re-pinning request", []v1.VCPUPin{{VCPU: 1, CPUSet: "5,4"}}, nil),
+			Entry("and keep a pending re-pinning request",
+				[]v1.VCPUPin{{VCPU: 0, CPUSet: "3"}, {VCPU: 1, CPUSet: "4-5"}},
+				[]v1.VCPUPin{{VCPU: 0, CPUSet: "3"}, {VCPU: 1, CPUSet: "4-5"}}),
+		)
+
+		It("should not report the vCPU pinning without dedicated CPUs", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.CPUTune = &api.CPUTune{VCPUPin: []api.CPUTuneVCPUPin{{VCPU: 0, CPUSet: "2"}}}
+
+			controller.updateVCPUPinning(vmi, domain)
+			Expect(vmi.Status.VCPUPinning).To(BeNil())
+		})
+
+		It("should report the guest drivers", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.GuestDrivers = []api.GuestDriver{
+				{Name: "Red Hat VirtIO SCSI controller", Version: "100.95.104.26200", Date: "2024-05-28"},
+			}
+
+			controller.updateGuestDrivers(vmi, domain)
+			Expect(vmi.Status.GuestDrivers).To(Equal([]v1.VirtualMachineInstanceGuestDriver{
+				{Name: "Red Hat VirtIO SCSI controller", Version: "100.95.104.26200", Date: "2024-05-28"},
+			}))
+		})
+
+		It("should keep the guest drivers when the guest agent didn't report them", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Status.GuestDrivers = []v1.VirtualMachineInstanceGuestDriver{{Name: "Red Hat VirtIO SCSI controller"}}
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+
+			controller.updateGuestDrivers(vmi, domain)
+			Expect(vmi.Status.GuestDrivers).To(HaveLen(1))
+		})
 	})
 
 	Context("VirtualMachineInstance controller gets informed about disk information", func() {
@@ -2875,6 +3002,72 @@ var _ = Describe("VirtualMachineInstance", func() {
 		)
 	})
 
+	Context("watchdog conditions", func() {
+		var expired metav1.Time
+
+		BeforeEach(func() {
+			expired = metav1.NewTime(time.Now().Truncate(time.Second))
+		})
+
+		newDomainWithExpiredWatchdog := func(expired metav1.Time) *api.Domain {
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.Metadata.KubeVirt.Watchdog = &api.WatchdogMetadata{ExpiredTimestamp: &expired}
+			return domain
+		}
+
+		DescribeTable("should report the expiry of the watchdog", func(action v1.WatchdogAction, expectedReason string) {
+			vmi := libvmi.New(libvmi.WithWatchdog(action, "amd64"))
+
+			controller.updateWatchdogConditions(vmi, newDomainWithExpiredWatchdog(expired), virtcontroller.NewVirtualMachineInstanceConditionManager())
+
+			Expect(vmi.Status.Conditions).To(ConsistOf(v1.VirtualMachineInstanceCondition{
+				Type:               v1.VirtualMachineInstanceWatchdogExpired,
+				Status:             k8sv1.ConditionTrue,
+				LastTransitionTime: expired,
+				Reason:             expectedReason,
+				Message:            fmt.Sprintf("The watchdog expired, action %s", action),
+			}))
+			testutils.ExpectEvent(recorder, string(v1.VirtualMachineInstanceWatchdogExpired))
+		},
+			Entry("with the action pending for migrate", v1.WatchdogActionMigrate, v1.VirtualMachineInstanceReasonWatchdogActionPending),
+			Entry("with the action pending for snapshot-reset", v1.WatchdogActionSnapshotReset, v1.VirtualMachineInstanceReasonWatchdogActionPending),
+			Entry("with the action taken for alert", v1.WatchdogActionAlert, v1.VirtualMachineInstanceReasonWatchdogActionTaken),
+			Entry("with the action taken for reset", v1.WatchdogActionReset, v1.VirtualMachineInstanceReasonWatchdogActionTaken),
+		)
+
+		It("should not report the same expiry twice", func() {
+			vmi := libvmi.New(libvmi.WithWatchdog(v1.WatchdogActionMigrate, "amd64"))
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:               v1.VirtualMachineInstanceWatchdogExpired,
+				Status:             k8sv1.ConditionTrue,
+				LastTransitionTime: expired,
+				Reason:             v1.VirtualMachineInstanceReasonWatchdogActionTaken,
+			}}
+
+			controller.updateWatchdogConditions(vmi, newDomainWithExpiredWatchdog(expired), virtcontroller.NewVirtualMachineInstanceConditionManager())
+
+			Expect(vmi.Status.Conditions).To(HaveLen(1))
+			Expect(vmi.Status.Conditions[0].Reason).To(Equal(v1.VirtualMachineInstanceReasonWatchdogActionTaken))
+		})
+
+		It("should report a later expiry", func() {
+			vmi := libvmi.New(libvmi.WithWatchdog(v1.WatchdogActionMigrate, "amd64"))
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:               v1.VirtualMachineInstanceWatchdogExpired,
+				Status:             k8sv1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(expired.Add(-time.Hour)),
+				Reason:             v1.VirtualMachineInstanceReasonWatchdogActionTaken,
+			}}
+
+			controller.updateWatchdogConditions(vmi, newDomainWithExpiredWatchdog(expired), virtcontroller.NewVirtualMachineInstanceConditionManager())
+
+			Expect(vmi.Status.Conditions).To(HaveLen(1))
+			Expect(vmi.Status.Conditions[0].LastTransitionTime).To(Equal(expired))
+			Expect(vmi.Status.Conditions[0].Reason).To(Equal(v1.VirtualMachineInstanceReasonWatchdogActionPending))
+			testutils.ExpectEvent(recorder, string(v1.VirtualMachineInstanceWatchdogExpired))
+		})
+	})
+
 	Context("on post-copy migration failure", func() {
 		It("should fail the VMI", func() {
 			By("Creating a migrating VMI with a domain in failed post-copy migration state")
@@ -260,7 +260,7 @@ func (e *eventCaller) updateStatus(status *api.DomainStatus) {
 
 func (e *eventCaller) eventCallback(c cli.Connection, domain *api.Domain, libvirtEvent libvirtEvent, client *Notifier, events chan watch.Event,
 	interfaceStatus []api.InterfaceStatus, osInfo *api.GuestOSInfo, vmi *v1.VirtualMachineInstance, fsFreezeStatus *api.FSFreeze,
-	metadataCache *metadata.Cache) {
+	guestDrivers []api.GuestDriver, metadataCache *metadata.Cache) {
 
 	d, err := c.LookupDomainByName(util.DomainFromNamespaceName(domain.ObjectMeta.Namespace, domain.ObjectMeta.Name))
 	if err != nil {
@@ -383,6 +383,10 @@ func (e *eventCaller) eventCallback(c cli.Connection, domain *api.Domain, libvir
 			domain.Status.FSFreezeStatus = *fsFreezeStatus
 		}
 
+		if guestDrivers != nil {
+			domain.Status.GuestDrivers = guestDrivers
+		}
+
 		err := client.SendDomainEvent(watch.Event{Type: watch.Modified, Object: domain})
 		if err != nil {
 			log.Log.Reason(err).Error("Could not send domain notify event.")
@@ -446,6 +450,7 @@ func (n *Notifier) StartDomainNotifier(
 		var interfaceStatuses []api.InterfaceStatus
 		var guestOsInfo *api.GuestOSInfo
 		var fsFreezeStatus *api.FSFreeze
+		var guestDrivers []api.GuestDriver
 		var eventCaller eventCaller
 
 		for {
@@ -453,7 +458,7 @@ func (n *Notifier) StartDomainNotifier(
 			case event := <-eventChan:
 				metadataCache.ResetNotification()
 				domainCache = util.NewDomainFromName(event.Domain, vmi.UID)
-				eventCaller.eventCallback(domainConn, domainCache, event, n, deleteNotificationSent, interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, metadataCache)
+				eventCaller.eventCallback(domainConn, domainCache, event, n, deleteNotificationSent, interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, guestDrivers, metadataCache)
 				log.Log.Infof("Domain name event: %v", domainCache.Spec.Name)
 				if event.AgentEvent != nil {
 					if event.AgentEvent.State == libvirt.CONNECT_DOMAIN_EVENT_AGENT_LIFECYCLE_STATE_CONNECTED {
@@ -467,9 +472,10 @@ func (n *Notifier) StartDomainNotifier(
 				interfaceStatuses = agentUpdate.DomainInfo.Interfaces
 				guestOsInfo = agentUpdate.DomainInfo.OSInfo
 				fsFreezeStatus = agentUpdate.DomainInfo.FSFreezeStatus
+				guestDrivers = agentUpdate.DomainInfo.GuestDrivers
 
 				eventCaller.eventCallback(domainConn, domainCache, libvirtEvent{}, n, deleteNotificationSent,
-					interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, metadataCache)
+					interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, guestDrivers, metadataCache)
 			case <-reconnectChan:
 				n.SendDomainEvent(newWatchEventError(fmt.Errorf("Libvirt reconnect, domain %s", domainName)))
 
@@ -491,6 +497,7 @@ func (n *Notifier) StartDomainNotifier(
 						guestOsInfo,
 						vmi,
 						fsFreezeStatus,
+						guestDrivers,
 						metadataCache,
 					)
 				}
@@ -553,6 +560,29 @@ func (n *Notifier) StartDomainNotifier(
 		}
 	}
 
+	domainEventTunableCallback := func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventTunable) {
+		log.Log.Infof("Domain tunable event received")
+		name, err := d.GetName()
+		if err != nil {
+			log.Log.Reason(err).Info(cantDetermineLibvirtDomainName)
+		}
+
+		select {
+		case eventChan <- libvirtEvent{Domain: name}:
+		default:
+			log.Log.Infof(libvirtEventChannelFull)
+		}
+	}
+
+	domainEventWatchdogCallback := func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventWatchdog) {
+		log.Log.Infof("Domain watchdog event with action %d received", event.Action)
+		// the expiry is reported to virt-handler with the domain metadata, storing it
+		// triggers the notification of the domain
+		metadataCache.Watchdog.Store(api.WatchdogMetadata{
+			ExpiredTimestamp: pointer.P(metav1.Now()),
+		})
+	}
+
 	err := domainConn.DomainEventLifecycleRegister(domainEventLifecycleCallback)
 	if err != nil {
 		log.Log.Reason(err).Errorf("failed to register event callback with libvirt")
@@ -574,6 +604,16 @@ func (n *Notifier) StartDomainNotifier(
 		log.Log.Reason(err).Errorf("failed to register memory device size change event callback with libvirt")
 		return err
 	}
+	err = domainConn.DomainEventTunableRegister(domainEventTunableCallback)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to register tunable event callback with libvirt")
+		return err
+	}
+	err = domainConn.DomainEventWatchdogRegister(domainEventWatchdogCallback)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to register watchdog event callback with libvirt")
+		return err
+	}
 
 	agentEventLifecycleCallback := func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventAgentLifecycle) {
 		log.Log.Infof("GuestAgentLifecycle event state %d with reason %d received", event.State, event.Reason)
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
+	"time"
 
 	"kubevirt.io/client-go/log"
 
@@ -62,6 +64,14 @@ type AgentInfo struct {
 	SupportedCommands []v1.GuestAgentCommandInfo `json:"supported_commands,omitempty"`
 }
 
+// Device of the guest, only reported by the agent on Windows guests
+type Device struct {
+	DriverName    string `json:"driver-name,omitempty"`
+	DriverVersion string `json:"driver-version,omitempty"`
+	// DriverDate is the date of the driver in nanoseconds since the epoch
+	DriverDate int64 `json:"driver-date,omitempty"`
+}
+
 // parseFSFreezeStatus from the agent response
 func ParseFSFreezeStatus(agentReply string) (api.FSFreeze, error) {
 	response := stripAgentStringResponse(agentReply)
@@ -128,3 +138,43 @@ func parseAgent(agentReply string) (AgentInfo, error) {
 
 	return gaInfo, nil
 }
+
+// parseDrivers gets the drivers used by the guest devices from the agent response
+func parseDrivers(agentReply string) ([]api.GuestDriver, error) {
+	devices := []Device{}
+	response := stripAgentResponse(agentReply)
+
+	err := json.Unmarshal([]byte(response), &devices)
+	if err != nil {
+		return []api.GuestDriver{}, err
+	}
+
+	drivers := []api.GuestDriver{}
+	seen := map[api.GuestDriver]bool{}
+	for _, device := range devices {
+		if device.DriverName == "" {
+			continue
+		}
+		driver := api.GuestDriver{
+			Name:    device.DriverName,
+			Version: device.DriverVersion,
+		}
+		if device.DriverDate != 0 {
+			driver.Date = time.Unix(0, device.DriverDate).UTC().Format(time.DateOnly)
+		}
+		// Several devices usually share the same driver
+		if !seen[driver] {
+			seen[driver] = true
+			drivers = append(drivers, driver)
+		}
+	}
+
+	sort.Slice(drivers, func(i, j int) bool {
+		if drivers[i].Name != drivers[j].Name {
+			return drivers[i].Name < drivers[j].Name
+		}
+		return drivers[i].Version < drivers[j].Version
+	})
+
+	return drivers, nil
+}
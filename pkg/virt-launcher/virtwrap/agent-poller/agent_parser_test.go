@@ -102,5 +102,39 @@ var _ = Describe("Qemu agent poller", func() {
 			}
 			Expect(parseFilesystem(jsonInput)).To(Equal(expectedFilesystem))
 		})
+
+		It("should parse the drivers of the devices", func() {
+			jsonInput := `{
+                "return":[
+                    {
+                        "driver-name":"Red Hat VirtIO SCSI controller",
+                        "driver-version":"100.95.104.26200",
+                        "driver-date":1716854400000000000,
+                        "id":{"type":"pci","vendor-id":6900,"device-id":4162}
+                    },
+                    {
+                        "driver-name":"Red Hat VirtIO Ethernet Adapter",
+                        "driver-version":"100.95.104.26200",
+                        "driver-date":1716854400000000000,
+                        "id":{"type":"pci","vendor-id":6900,"device-id":4161}
+                    },
+                    {
+                        "driver-name":"Red Hat VirtIO SCSI controller",
+                        "driver-version":"100.95.104.26200",
+                        "driver-date":1716854400000000000,
+                        "id":{"type":"pci","vendor-id":6900,"device-id":4162}
+                    },
+                    {
+                        "id":{"type":"pci","vendor-id":32902,"device-id":10528}
+                    }
+                ]
+            }`
+
+			expectedDrivers := []api.GuestDriver{
+				{Name: "Red Hat VirtIO Ethernet Adapter", Version: "100.95.104.26200", Date: "2024-05-28"},
+				{Name: "Red Hat VirtIO SCSI controller", Version: "100.95.104.26200", Date: "2024-05-28"},
+			}
+			Expect(parseDrivers(jsonInput)).To(Equal(expectedDrivers))
+		})
 	})
 })
@@ -43,6 +43,7 @@ const (
 	GetFilesystem     AgentCommand = "guest-get-fsinfo"
 	GetAgent          AgentCommand = "guest-info"
 	GetFSFreezeStatus AgentCommand = "guest-fsfreeze-status"
+	GetDevices        AgentCommand = "guest-get-devices"
 
 	pollInitialInterval = 10 * time.Second
 )
@@ -81,10 +82,11 @@ func (s *AsyncAgentStore) Store(key, value any) {
 	if updated {
 		domainInfo := api.DomainGuestInfo{}
 		switch key {
-		case libvirt.DOMAIN_GUEST_INFO_OS, libvirt.DOMAIN_GUEST_INFO_INTERFACES, GetFSFreezeStatus:
+		case libvirt.DOMAIN_GUEST_INFO_OS, libvirt.DOMAIN_GUEST_INFO_INTERFACES, GetFSFreezeStatus, GetDevices:
 			domainInfo.OSInfo = s.GetGuestOSInfo()
 			domainInfo.Interfaces = s.GetInterfaceStatus()
 			domainInfo.FSFreezeStatus = s.GetFSFreezeStatus()
+			domainInfo.GuestDrivers = s.GetGuestDrivers()
 		}
 
 		s.AgentUpdated <- AgentUpdatedEvent{
@@ -168,6 +170,16 @@ func (s *AsyncAgentStore) GetFSFreezeStatus() *api.FSFreeze {
 	return &fsfreezeStatus
 }
 
+// GetGuestDrivers returns the drivers used by the guest devices
+func (s *AsyncAgentStore) GetGuestDrivers() []api.GuestDriver {
+	data, ok := s.store.Load(GetDevices)
+	if !ok {
+		return nil
+	}
+
+	return data.([]api.GuestDriver)
+}
+
 // GetFS returns the filesystem list limited to the limit set
 // set limit to -1 to return the whole list
 func (s *AsyncAgentStore) GetFS(limit int) []api.Filesystem {
@@ -285,7 +297,7 @@ func CreatePoller(
 			// Polling for QEMU agent commands
 			{
 				CallTick:      qemuAgentVersionInterval,
-				AgentCommands: []AgentCommand{GetAgent},
+				AgentCommands: []AgentCommand{GetAgent, GetDevices},
 			},
 			{
 				CallTick:      qemuAgentFileInterval,
@@ -385,6 +397,13 @@ func executeAgentCommands(commands []AgentCommand, agentPoller *AgentPoller) {
 				continue
 			}
 			agentPoller.agentStore.Store(GetAgent, agent)
+		case GetDevices:
+			drivers, err := parseDrivers(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent devices %s", err.Error())
+				continue
+			}
+			agentPoller.agentStore.Store(GetDevices, drivers)
 		}
 	}
 }
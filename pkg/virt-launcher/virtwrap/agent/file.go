@@ -0,0 +1,117 @@
+package agent
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+// guestFileChunkSize is the amount of bytes read from or written to a guest file per guest agent command
+const guestFileChunkSize = 64 * 1024
+
+type fileOpenReturn struct {
+	Return int `json:"return"`
+}
+
+type fileReadReturn struct {
+	Return fileReadReturnData `json:"return"`
+}
+type fileReadReturnData struct {
+	Count  int    `json:"count"`
+	BufB64 string `json:"buf-b64"`
+	EOF    bool   `json:"eof"`
+}
+
+type fileWriteReturn struct {
+	Return fileWriteReturnData `json:"return"`
+}
+type fileWriteReturnData struct {
+	Count int `json:"count"`
+}
+
+// GuestFileRead returns the content of the file at path in the guest, it fails if the file is larger than maxSize
+func GuestFileRead(virConn cli.Connection, domName string, path string, maxSize int64) ([]byte, error) {
+	handle, err := guestFileOpen(virConn, domName, path, "r")
+	if err != nil {
+		return nil, err
+	}
+	defer guestFileClose(virConn, domName, handle)
+
+	var content []byte
+	for {
+		cmdRead := fmt.Sprintf(`{"execute": "guest-file-read", "arguments": { "handle": %d, "count": %d } }`, handle, guestFileChunkSize)
+		output, err := virConn.QemuAgentCommand(cmdRead, domName)
+		if err != nil {
+			return nil, err
+		}
+		readRes := &fileReadReturn{}
+		if err := json.Unmarshal([]byte(output), readRes); err != nil {
+			return nil, err
+		}
+		chunk, err := base64.StdEncoding.DecodeString(readRes.Return.BufB64)
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(content)+len(chunk)) > maxSize {
+			return nil, fmt.Errorf("file %s is larger than %d bytes", path, maxSize)
+		}
+		content = append(content, chunk...)
+		if readRes.Return.EOF || readRes.Return.Count == 0 {
+			return content, nil
+		}
+	}
+}
+
+// GuestFileWrite replaces the content of the file at path in the guest, the file is created if it does not exist
+func GuestFileWrite(virConn cli.Connection, domName string, path string, content []byte) error {
+	handle, err := guestFileOpen(virConn, domName, path, "w")
+	if err != nil {
+		return err
+	}
+	defer guestFileClose(virConn, domName, handle)
+
+	for len(content) > 0 {
+		chunk := content
+		if len(chunk) > guestFileChunkSize {
+			chunk = chunk[:guestFileChunkSize]
+		}
+		cmdWrite := fmt.Sprintf(`{"execute": "guest-file-write", "arguments": { "handle": %d, "buf-b64": "%s" } }`, handle, base64.StdEncoding.EncodeToString(chunk))
+		output, err := virConn.QemuAgentCommand(cmdWrite, domName)
+		if err != nil {
+			return err
+		}
+		writeRes := &fileWriteReturn{}
+		if err := json.Unmarshal([]byte(output), writeRes); err != nil {
+			return err
+		}
+		if writeRes.Return.Count <= 0 {
+			return fmt.Errorf("no bytes written to file %s: %s", path, output)
+		}
+		// the count is reported by the guest and can't be trusted
+		if writeRes.Return.Count > len(chunk) {
+			return fmt.Errorf("guest agent reported %d bytes written to file %s, more than the %d bytes sent", writeRes.Return.Count, path, len(chunk))
+		}
+		content = content[writeRes.Return.Count:]
+	}
+	return nil
+}
+
+func guestFileOpen(virConn cli.Connection, domName string, path string, mode string) (int, error) {
+	cmdOpen := fmt.Sprintf(`{"execute": "guest-file-open", "arguments": { "path": %s, "mode": %s } }`, quote(path), quote(mode))
+	output, err := virConn.QemuAgentCommand(cmdOpen, domName)
+	if err != nil {
+		return 0, err
+	}
+	openRes := &fileOpenReturn{}
+	if err := json.Unmarshal([]byte(output), openRes); err != nil {
+		return 0, err
+	}
+	return openRes.Return, nil
+}
+
+func guestFileClose(virConn cli.Connection, domName string, handle int) {
+	cmdClose := fmt.Sprintf(`{"execute": "guest-file-close", "arguments": { "handle": %d } }`, handle)
+	_, _ = virConn.QemuAgentCommand(cmdClose, domName)
+}
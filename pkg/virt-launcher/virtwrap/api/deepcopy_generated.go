@@ -214,6 +214,22 @@ func (in *BlockIO) DeepCopy() *BlockIO {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockIOTune) DeepCopyInto(out *BlockIOTune) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockIOTune.
+func (in *BlockIOTune) DeepCopy() *BlockIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Boot) DeepCopyInto(out *Boot) {
 	*out = *in
@@ -788,7 +804,9 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 	if in.Redirs != nil {
 		in, out := &in.Redirs, &out.Redirs
 		*out = make([]RedirectedDevice, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.SoundCards != nil {
 		in, out := &in.SoundCards, &out.SoundCards
@@ -885,6 +903,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(Shareable)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(BlockIOTune)
+		**out = **in
+	}
 	return
 }
 
@@ -987,6 +1010,27 @@ func (in *DiskIOThreads) DeepCopy() *DiskIOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskReconnect) DeepCopyInto(out *DiskReconnect) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(uint)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskReconnect.
+func (in *DiskReconnect) DeepCopy() *DiskReconnect {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskReconnect)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskSecret) DeepCopyInto(out *DiskSecret) {
 	*out = *in
@@ -1021,6 +1065,11 @@ func (in *DiskSource) DeepCopyInto(out *DiskSource) {
 		*out = make([]Slice, len(*in))
 		copy(*out, *in)
 	}
+	if in.Reconnect != nil {
+		in, out := &in.Reconnect, &out.Reconnect
+		*out = new(DiskReconnect)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1114,6 +1163,11 @@ func (in *DomainGuestInfo) DeepCopyInto(out *DomainGuestInfo) {
 		*out = new(FSFreeze)
 		**out = **in
 	}
+	if in.GuestDrivers != nil {
+		in, out := &in.GuestDrivers, &out.GuestDrivers
+		*out = make([]GuestDriver, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1264,6 +1318,11 @@ func (in *DomainStatus) DeepCopyInto(out *DomainStatus) {
 	}
 	out.OSInfo = in.OSInfo
 	out.FSFreezeStatus = in.FSFreezeStatus
+	if in.GuestDrivers != nil {
+		in, out := &in.GuestDrivers, &out.GuestDrivers
+		*out = make([]GuestDriver, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1853,6 +1912,22 @@ func (in *GraphicsListen) DeepCopy() *GraphicsListen {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestDriver) DeepCopyInto(out *GuestDriver) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestDriver.
+func (in *GuestDriver) DeepCopy() *GuestDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GuestOSInfo) DeepCopyInto(out *GuestOSInfo) {
 	*out = *in
@@ -2121,6 +2196,16 @@ func (in *InterfaceDriver) DeepCopyInto(out *InterfaceDriver) {
 		*out = new(uint)
 		**out = **in
 	}
+	if in.RxQueueSize != nil {
+		in, out := &in.RxQueueSize, &out.RxQueueSize
+		*out = new(uint)
+		**out = **in
+	}
+	if in.TxQueueSize != nil {
+		in, out := &in.TxQueueSize, &out.TxQueueSize
+		*out = new(uint)
+		**out = **in
+	}
 	return
 }
 
@@ -2252,6 +2337,11 @@ func (in *KubeVirtMetadata) DeepCopyInto(out *KubeVirtMetadata) {
 		*out = new(MemoryDumpMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Watchdog != nil {
+		in, out := &in.Watchdog, &out.Watchdog
+		*out = new(WatchdogMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2654,6 +2744,15 @@ func (in *MigrationMetadata) DeepCopyInto(out *MigrationMetadata) {
 		in, out := &in.EndTimestamp, &out.EndTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(MigrationProgressMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CutoverTimestamp != nil {
+		in, out := &in.CutoverTimestamp, &out.CutoverTimestamp
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -2667,6 +2766,26 @@ func (in *MigrationMetadata) DeepCopy() *MigrationMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationProgressMetadata) DeepCopyInto(out *MigrationProgressMetadata) {
+	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationProgressMetadata.
+func (in *MigrationProgressMetadata) DeepCopy() *MigrationProgressMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationProgressMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Model) DeepCopyInto(out *Model) {
 	*out = *in
@@ -2689,7 +2808,9 @@ func (in *NUMA) DeepCopyInto(out *NUMA) {
 	if in.Cells != nil {
 		in, out := &in.Cells, &out.Cells
 		*out = make([]NUMACell, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -2707,6 +2828,11 @@ func (in *NUMA) DeepCopy() *NUMA {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NUMACell) DeepCopyInto(out *NUMACell) {
 	*out = *in
+	if in.Distances != nil {
+		in, out := &in.Distances, &out.Distances
+		*out = new(NUMACellDistances)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2720,6 +2846,43 @@ func (in *NUMACell) DeepCopy() *NUMACell {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMACellDistances) DeepCopyInto(out *NUMACellDistances) {
+	*out = *in
+	if in.Siblings != nil {
+		in, out := &in.Siblings, &out.Siblings
+		*out = make([]NUMACellSibling, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMACellDistances.
+func (in *NUMACellDistances) DeepCopy() *NUMACellDistances {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMACellDistances)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMACellSibling) DeepCopyInto(out *NUMACellSibling) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMACellSibling.
+func (in *NUMACellSibling) DeepCopy() *NUMACellSibling {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMACellSibling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NUMATune) DeepCopyInto(out *NUMATune) {
 	*out = *in
@@ -2935,7 +3098,11 @@ func (in *ReadOnly) DeepCopy() *ReadOnly {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedirectedDevice) DeepCopyInto(out *RedirectedDevice) {
 	*out = *in
-	out.Source = in.Source
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(RedirectedDeviceSource)
+		**out = **in
+	}
 	return
 }
 
@@ -3640,3 +3807,23 @@ func (in *Watchdog) DeepCopy() *Watchdog {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WatchdogMetadata) DeepCopyInto(out *WatchdogMetadata) {
+	*out = *in
+	if in.ExpiredTimestamp != nil {
+		in, out := &in.ExpiredTimestamp, &out.ExpiredTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WatchdogMetadata.
+func (in *WatchdogMetadata) DeepCopy() *WatchdogMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(WatchdogMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
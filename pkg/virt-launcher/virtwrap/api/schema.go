@@ -117,6 +117,7 @@ type DomainStatus struct {
 	Interfaces     []InterfaceStatus
 	OSInfo         GuestOSInfo
 	FSFreezeStatus FSFreeze
+	GuestDrivers   []GuestDriver
 }
 
 type DomainSysInfo struct {
@@ -157,6 +158,12 @@ type FSFreeze struct {
 	Status string
 }
 
+type GuestDriver struct {
+	Name    string
+	Version string
+	Date    string
+}
+
 type FSDisk struct {
 	Serial  string
 	BusType string
@@ -182,6 +189,7 @@ type DomainGuestInfo struct {
 	Interfaces     []InterfaceStatus
 	OSInfo         *GuestOSInfo
 	FSFreezeStatus *FSFreeze
+	GuestDrivers   []GuestDriver
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -208,6 +216,7 @@ type DomainSpec struct {
 	SysInfo        *SysInfo        `xml:"sysinfo,omitempty"`
 	Devices        Devices         `xml:"devices"`
 	Clock          *Clock          `xml:"clock,omitempty"`
+	OnCrash        string          `xml:"on_crash,omitempty"`
 	Resource       *Resource       `xml:"resource,omitempty"`
 	QEMUCmd        *Commandline    `xml:"qemu:commandline,omitempty"`
 	Metadata       Metadata        `xml:"metadata,omitempty"`
@@ -286,11 +295,21 @@ type NUMA struct {
 }
 
 type NUMACell struct {
-	ID           string `xml:"id,attr"`
-	CPUs         string `xml:"cpus,attr"`
-	Memory       uint64 `xml:"memory,attr,omitempty"`
-	Unit         string `xml:"unit,attr,omitempty"`
-	MemoryAccess string `xml:"memAccess,attr,omitempty"`
+	ID           string             `xml:"id,attr"`
+	CPUs         string             `xml:"cpus,attr,omitempty"`
+	Memory       uint64             `xml:"memory,attr,omitempty"`
+	Unit         string             `xml:"unit,attr,omitempty"`
+	MemoryAccess string             `xml:"memAccess,attr,omitempty"`
+	Distances    *NUMACellDistances `xml:"distances,omitempty"`
+}
+
+type NUMACellDistances struct {
+	Siblings []NUMACellSibling `xml:"sibling"`
+}
+
+type NUMACellSibling struct {
+	ID    uint32 `xml:"id,attr"`
+	Value uint32 `xml:"value,attr"`
 }
 
 type CPUFeature struct {
@@ -390,6 +409,7 @@ type KubeVirtMetadata struct {
 	Migration        *MigrationMetadata        `xml:"migration,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
 	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	Watchdog         *WatchdogMetadata         `xml:"watchdog,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -406,14 +426,32 @@ type MemoryDumpMetadata struct {
 	FailureReason  string       `xml:"failureReason,omitempty"`
 }
 
+type WatchdogMetadata struct {
+	ExpiredTimestamp *metav1.Time `xml:"expiredTimestamp,omitempty"`
+}
+
 type MigrationMetadata struct {
-	UID            types.UID        `xml:"uid,omitempty"`
-	StartTimestamp *metav1.Time     `xml:"startTimestamp,omitempty"`
-	EndTimestamp   *metav1.Time     `xml:"endTimestamp,omitempty"`
-	Failed         bool             `xml:"failed,omitempty"`
-	FailureReason  string           `xml:"failureReason,omitempty"`
-	AbortStatus    string           `xml:"abortStatus,omitempty"`
-	Mode           v1.MigrationMode `xml:"mode,omitempty"`
+	UID            types.UID                  `xml:"uid,omitempty"`
+	StartTimestamp *metav1.Time               `xml:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time               `xml:"endTimestamp,omitempty"`
+	Failed         bool                       `xml:"failed,omitempty"`
+	FailureReason  string                     `xml:"failureReason,omitempty"`
+	AbortStatus    string                     `xml:"abortStatus,omitempty"`
+	Mode           v1.MigrationMode           `xml:"mode,omitempty"`
+	Progress       *MigrationProgressMetadata `xml:"progress,omitempty"`
+	// CutoverTimestamp is set once the cutover of a warm migration was handed over to the launcher
+	CutoverTimestamp *metav1.Time `xml:"cutoverTimestamp,omitempty"`
+}
+
+type MigrationProgressMetadata struct {
+	Timestamp        *metav1.Time `xml:"timestamp,omitempty"`
+	DataTotal        uint64       `xml:"dataTotal,omitempty"`
+	DataProcessed    uint64       `xml:"dataProcessed,omitempty"`
+	DataRemaining    uint64       `xml:"dataRemaining,omitempty"`
+	MemoryDirtyRate  uint64       `xml:"memoryDirtyRate,omitempty"`
+	MemoryBps        uint64       `xml:"memoryBps,omitempty"`
+	ExpectedDowntime uint64       `xml:"expectedDowntime,omitempty"`
+	Iterations       uint64       `xml:"iterations,omitempty"`
 }
 
 type GracePeriodMetadata struct {
@@ -556,9 +594,9 @@ type TPMBackend struct {
 // RedirectedDevice describes a device to be redirected
 // See: https://libvirt.org/formatdomain.html#redirected-devices
 type RedirectedDevice struct {
-	Type   string                 `xml:"type,attr"`
-	Bus    string                 `xml:"bus,attr"`
-	Source RedirectedDeviceSource `xml:"source"`
+	Type   string                  `xml:"type,attr"`
+	Bus    string                  `xml:"bus,attr"`
+	Source *RedirectedDeviceSource `xml:"source,omitempty"`
 }
 
 type RedirectedDeviceSource struct {
@@ -689,6 +727,7 @@ type Disk struct {
 	Capacity           *int64        `xml:"capacity,omitempty"`
 	ExpandDisksEnabled bool          `xml:"expandDisksEnabled,omitempty"`
 	Shareable          *Shareable    `xml:"shareable,omitempty"`
+	IOTune             *BlockIOTune  `xml:"iotune,omitempty"`
 }
 
 type DiskAuth struct {
@@ -713,6 +752,14 @@ type DiskSource struct {
 	Host          *DiskSourceHost `xml:"host,omitempty"`
 	Reservations  *Reservations   `xml:"reservations,omitempty"`
 	Slices        []Slice         `xml:"slices,omitempty"`
+	Type          string          `xml:"type,attr,omitempty"`
+	Path          string          `xml:"path,attr,omitempty"`
+	Reconnect     *DiskReconnect  `xml:"reconnect,omitempty"`
+}
+
+type DiskReconnect struct {
+	Enabled string `xml:"enabled,attr"`
+	Timeout *uint  `xml:"timeout,attr,omitempty"`
 }
 
 type DiskTarget struct {
@@ -762,6 +809,21 @@ type BlockIO struct {
 	PhysicalBlockSize uint `xml:"physical_block_size,attr,omitempty"`
 }
 
+type BlockIOTune struct {
+	ReadBytesSec           uint64 `xml:"read_bytes_sec,omitempty"`
+	WriteBytesSec          uint64 `xml:"write_bytes_sec,omitempty"`
+	ReadIopsSec            uint64 `xml:"read_iops_sec,omitempty"`
+	WriteIopsSec           uint64 `xml:"write_iops_sec,omitempty"`
+	ReadBytesSecMax        uint64 `xml:"read_bytes_sec_max,omitempty"`
+	WriteBytesSecMax       uint64 `xml:"write_bytes_sec_max,omitempty"`
+	ReadIopsSecMax         uint64 `xml:"read_iops_sec_max,omitempty"`
+	WriteIopsSecMax        uint64 `xml:"write_iops_sec_max,omitempty"`
+	ReadBytesSecMaxLength  uint64 `xml:"read_bytes_sec_max_length,omitempty"`
+	WriteBytesSecMaxLength uint64 `xml:"write_bytes_sec_max_length,omitempty"`
+	ReadIopsSecMaxLength   uint64 `xml:"read_iops_sec_max_length,omitempty"`
+	WriteIopsSecMaxLength  uint64 `xml:"write_iops_sec_max_length,omitempty"`
+}
+
 type Reservations struct {
 	Managed            string              `xml:"managed,attr,omitempty"`
 	SourceReservations *SourceReservations `xml:"source,omitempty"`
@@ -870,9 +932,11 @@ type ACPI struct {
 }
 
 type InterfaceDriver struct {
-	Name   string `xml:"name,attr"`
-	Queues *uint  `xml:"queues,attr,omitempty"`
-	IOMMU  string `xml:"iommu,attr,omitempty"`
+	Name        string `xml:"name,attr"`
+	Queues      *uint  `xml:"queues,attr,omitempty"`
+	RxQueueSize *uint  `xml:"rx_queue_size,attr,omitempty"`
+	TxQueueSize *uint  `xml:"tx_queue_size,attr,omitempty"`
+	IOMMU       string `xml:"iommu,attr,omitempty"`
 }
 
 type LinkState struct {
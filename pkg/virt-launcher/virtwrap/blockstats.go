@@ -0,0 +1,128 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"libvirt.org/go/libvirt"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+// latencyHistogramBoundaries are the boundaries of the latency histograms of the disks in nanoseconds,
+// from 100µs to 1s. They are kept few to bound the number of series exported per disk.
+var latencyHistogramBoundaries = []uint64{
+	100000, 500000, 1000000, 5000000, 10000000, 50000000, 100000000, 500000000, 1000000000,
+}
+
+type latencyHistogram struct {
+	Boundaries []uint64 `json:"boundaries"`
+	Bins       []uint64 `json:"bins"`
+}
+
+type queryBlockstatsResponse struct {
+	Return []struct {
+		Qdev  string `json:"qdev"`
+		Stats struct {
+			RdLatencyHistogram    *latencyHistogram `json:"rd_latency_histogram"`
+			WrLatencyHistogram    *latencyHistogram `json:"wr_latency_histogram"`
+			FlushLatencyHistogram *latencyHistogram `json:"flush_latency_histogram"`
+		} `json:"stats"`
+	} `json:"return"`
+}
+
+// qdevUserAlias returns the user alias of the disk a QEMU device path belongs to, like disk0 for
+// /machine/peripheral/ua-disk0/virtio-backend
+func qdevUserAlias(qdev string) string {
+	for _, name := range strings.Split(qdev, "/") {
+		if alias, found := strings.CutPrefix(name, api.UserAliasPrefix); found {
+			return alias
+		}
+	}
+	return ""
+}
+
+func toLatencyHistogram(histogram *latencyHistogram) *stats.DomainStatsLatencyHistogram {
+	if histogram == nil {
+		return nil
+	}
+	return &stats.DomainStatsLatencyHistogram{
+		Boundaries: histogram.Boundaries,
+		Bins:       histogram.Bins,
+	}
+}
+
+func enableLatencyHistograms(dom cli.VirDomain, qdev string) error {
+	boundaries, err := json.Marshal(latencyHistogramBoundaries)
+	if err != nil {
+		return err
+	}
+	cmd := fmt.Sprintf(`{"execute":"block-latency-histogram-set","arguments":{"id":%q,"boundaries":%s}}`, qdev, boundaries)
+	_, err = dom.QemuMonitorCommand(cmd, libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT)
+	return err
+}
+
+// collectLatencyHistograms adds the latency histograms of the disks, which libvirt does not report, to the
+// domain stats. QEMU only collects the histograms once they are enabled, which is done on the first
+// collection, so that they are reported from the next one.
+func (l *LibvirtDomainManager) collectLatencyHistograms(domStats *stats.DomainStats) error {
+	dom, err := l.virConn.LookupDomainByName(domStats.Name)
+	if err != nil {
+		return err
+	}
+	defer dom.Free()
+
+	out, err := dom.QemuMonitorCommand(`{"execute":"query-blockstats"}`, libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT)
+	if err != nil {
+		return err
+	}
+	response := queryBlockstatsResponse{}
+	if err := json.Unmarshal([]byte(out), &response); err != nil {
+		return err
+	}
+
+	blockStats := make(map[string]int, len(domStats.Block))
+	for i, block := range domStats.Block {
+		if block.Alias != "" {
+			blockStats[block.Alias] = i
+		}
+	}
+	for _, device := range response.Return {
+		i, exists := blockStats[qdevUserAlias(device.Qdev)]
+		if !exists {
+			continue
+		}
+		if device.Stats.RdLatencyHistogram == nil {
+			if err := enableLatencyHistograms(dom, device.Qdev); err != nil {
+				return fmt.Errorf("failed to enable the latency histograms of %s: %v", device.Qdev, err)
+			}
+			continue
+		}
+		domStats.Block[i].RdLatencyHistogram = toLatencyHistogram(device.Stats.RdLatencyHistogram)
+		domStats.Block[i].WrLatencyHistogram = toLatencyHistogram(device.Stats.WrLatencyHistogram)
+		domStats.Block[i].FlLatencyHistogram = toLatencyHistogram(device.Stats.FlushLatencyHistogram)
+	}
+	return nil
+}
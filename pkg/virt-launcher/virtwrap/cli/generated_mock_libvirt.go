@@ -10,6 +10,7 @@
 package cli
 
 import (
+	os "os"
 	reflect "reflect"
 	time "time"
 
@@ -158,6 +159,48 @@ func (mr *MockConnectionMockRecorder) DomainEventMemoryDeviceSizeChangeRegister(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventMemoryDeviceSizeChangeRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventMemoryDeviceSizeChangeRegister), callback)
 }
 
+// DomainEventTunableRegister mocks base method.
+func (m *MockConnection) DomainEventTunableRegister(callback libvirt.DomainEventTunableCallback) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainEventTunableRegister", callback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DomainEventTunableRegister indicates an expected call of DomainEventTunableRegister.
+func (mr *MockConnectionMockRecorder) DomainEventTunableRegister(callback any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventTunableRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventTunableRegister), callback)
+}
+
+// DomainEventWatchdogRegister mocks base method.
+func (m *MockConnection) DomainEventWatchdogRegister(callback libvirt.DomainEventWatchdogCallback) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainEventWatchdogRegister", callback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DomainEventWatchdogRegister indicates an expected call of DomainEventWatchdogRegister.
+func (mr *MockConnectionMockRecorder) DomainEventWatchdogRegister(callback any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventWatchdogRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventWatchdogRegister), callback)
+}
+
+// DomainRestoreFlags mocks base method.
+func (m *MockConnection) DomainRestoreFlags(srcFile, xml string, flags libvirt.DomainSaveRestoreFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainRestoreFlags", srcFile, xml, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DomainRestoreFlags indicates an expected call of DomainRestoreFlags.
+func (mr *MockConnectionMockRecorder) DomainRestoreFlags(srcFile, xml, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainRestoreFlags", reflect.TypeOf((*MockConnection)(nil).DomainRestoreFlags), srcFile, xml, flags)
+}
+
 // GetAllDomainStats mocks base method.
 func (m *MockConnection) GetAllDomainStats(statsTypes libvirt.DomainStatsTypes, flags libvirt.ConnectGetAllDomainStatsFlags) ([]libvirt.DomainStats, error) {
 	m.ctrl.T.Helper()
@@ -425,6 +468,20 @@ func (mr *MockVirDomainMockRecorder) AbortJob() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortJob", reflect.TypeOf((*MockVirDomain)(nil).AbortJob))
 }
 
+// AddIOThread mocks base method.
+func (m *MockVirDomain) AddIOThread(id uint, flags libvirt.DomainModificationImpact) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddIOThread", id, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddIOThread indicates an expected call of AddIOThread.
+func (mr *MockVirDomainMockRecorder) AddIOThread(id, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIOThread", reflect.TypeOf((*MockVirDomain)(nil).AddIOThread), id, flags)
+}
+
 // AttachDeviceFlags mocks base method.
 func (m *MockVirDomain) AttachDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error {
 	m.ctrl.T.Helper()
@@ -481,6 +538,21 @@ func (mr *MockVirDomainMockRecorder) CoreDumpWithFormat(to, format, flags any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CoreDumpWithFormat", reflect.TypeOf((*MockVirDomain)(nil).CoreDumpWithFormat), to, format, flags)
 }
 
+// CreateSnapshotXML mocks base method.
+func (m *MockVirDomain) CreateSnapshotXML(xml string, flags libvirt.DomainSnapshotCreateFlags) (*libvirt.DomainSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshotXML", xml, flags)
+	ret0, _ := ret[0].(*libvirt.DomainSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshotXML indicates an expected call of CreateSnapshotXML.
+func (mr *MockVirDomainMockRecorder) CreateSnapshotXML(xml, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotXML", reflect.TypeOf((*MockVirDomain)(nil).CreateSnapshotXML), xml, flags)
+}
+
 // CreateWithFlags mocks base method.
 func (m *MockVirDomain) CreateWithFlags(flags libvirt.DomainCreateFlags) error {
 	m.ctrl.T.Helper()
@@ -731,6 +803,35 @@ func (mr *MockVirDomainMockRecorder) MemoryStats(nrStats, flags any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemoryStats", reflect.TypeOf((*MockVirDomain)(nil).MemoryStats), nrStats, flags)
 }
 
+// MigrateGetMaxDowntime mocks base method.
+func (m *MockVirDomain) MigrateGetMaxDowntime(flags uint32) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateGetMaxDowntime", flags)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateGetMaxDowntime indicates an expected call of MigrateGetMaxDowntime.
+func (mr *MockVirDomainMockRecorder) MigrateGetMaxDowntime(flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateGetMaxDowntime", reflect.TypeOf((*MockVirDomain)(nil).MigrateGetMaxDowntime), flags)
+}
+
+// MigrateSetMaxDowntime mocks base method.
+func (m *MockVirDomain) MigrateSetMaxDowntime(downtime uint64, flags uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateSetMaxDowntime", downtime, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MigrateSetMaxDowntime indicates an expected call of MigrateSetMaxDowntime.
+func (mr *MockVirDomainMockRecorder) MigrateSetMaxDowntime(downtime, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSetMaxDowntime", reflect.TypeOf((*MockVirDomain)(nil).MigrateSetMaxDowntime), downtime, flags)
+}
+
 // MigrateStartPostCopy mocks base method.
 func (m *MockVirDomain) MigrateStartPostCopy(flags uint32) error {
 	m.ctrl.T.Helper()
@@ -787,6 +888,37 @@ func (mr *MockVirDomainMockRecorder) PinVcpuFlags(vcpu, cpuMap, flags any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinVcpuFlags", reflect.TypeOf((*MockVirDomain)(nil).PinVcpuFlags), vcpu, cpuMap, flags)
 }
 
+// QemuMonitorCommand mocks base method.
+func (m *MockVirDomain) QemuMonitorCommand(command string, flags libvirt.DomainQemuMonitorCommandFlags) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QemuMonitorCommand", command, flags)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QemuMonitorCommand indicates an expected call of QemuMonitorCommand.
+func (mr *MockVirDomainMockRecorder) QemuMonitorCommand(command, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QemuMonitorCommand", reflect.TypeOf((*MockVirDomain)(nil).QemuMonitorCommand), command, flags)
+}
+
+// QemuMonitorCommandWithFiles mocks base method.
+func (m *MockVirDomain) QemuMonitorCommandWithFiles(command string, infiles []os.File, flags libvirt.DomainQemuMonitorCommandFlags) (string, []*os.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QemuMonitorCommandWithFiles", command, infiles, flags)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]*os.File)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// QemuMonitorCommandWithFiles indicates an expected call of QemuMonitorCommandWithFiles.
+func (mr *MockVirDomainMockRecorder) QemuMonitorCommandWithFiles(command, infiles, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QemuMonitorCommandWithFiles", reflect.TypeOf((*MockVirDomain)(nil).QemuMonitorCommandWithFiles), command, infiles, flags)
+}
+
 // Reboot mocks base method.
 func (m *MockVirDomain) Reboot(flags libvirt.DomainRebootFlagValues) error {
 	m.ctrl.T.Helper()
@@ -829,6 +961,20 @@ func (mr *MockVirDomainMockRecorder) Resume() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockVirDomain)(nil).Resume))
 }
 
+// SetBlockIoTune mocks base method.
+func (m *MockVirDomain) SetBlockIoTune(disk string, params *libvirt.DomainBlockIoTuneParameters, flags libvirt.DomainModificationImpact) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBlockIoTune", disk, params, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBlockIoTune indicates an expected call of SetBlockIoTune.
+func (mr *MockVirDomainMockRecorder) SetBlockIoTune(disk, params, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlockIoTune", reflect.TypeOf((*MockVirDomain)(nil).SetBlockIoTune), disk, params, flags)
+}
+
 // SetLaunchSecurityState mocks base method.
 func (m *MockVirDomain) SetLaunchSecurityState(params *libvirt.DomainLaunchSecurityStateParameters, flags uint32) error {
 	m.ctrl.T.Helper()
@@ -843,6 +989,20 @@ func (mr *MockVirDomainMockRecorder) SetLaunchSecurityState(params, flags any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLaunchSecurityState", reflect.TypeOf((*MockVirDomain)(nil).SetLaunchSecurityState), params, flags)
 }
 
+// SetMemoryFlags mocks base method.
+func (m *MockVirDomain) SetMemoryFlags(memory uint64, flags libvirt.DomainMemoryModFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMemoryFlags", memory, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMemoryFlags indicates an expected call of SetMemoryFlags.
+func (mr *MockVirDomainMockRecorder) SetMemoryFlags(memory, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMemoryFlags", reflect.TypeOf((*MockVirDomain)(nil).SetMemoryFlags), memory, flags)
+}
+
 // SetTime mocks base method.
 func (m *MockVirDomain) SetTime(secs int64, nsecs uint, flags libvirt.DomainSetTimeFlags) error {
 	m.ctrl.T.Helper()
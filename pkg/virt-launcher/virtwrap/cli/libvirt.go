@@ -26,6 +26,7 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
+	"os"
 	"sync"
 	"time"
 
@@ -47,6 +48,7 @@ const ConnectionInterval = 500 * time.Millisecond
 type Connection interface {
 	LookupDomainByName(name string) (VirDomain, error)
 	DomainDefineXML(xml string) (VirDomain, error)
+	DomainRestoreFlags(srcFile, xml string, flags libvirt.DomainSaveRestoreFlags) error
 	Close() (int, error)
 	DomainEventLifecycleRegister(callback libvirt.DomainEventLifecycleCallback) error
 	DomainEventDeviceAddedRegister(callback libvirt.DomainEventDeviceAddedCallback) error
@@ -54,6 +56,8 @@ type Connection interface {
 	AgentEventLifecycleRegister(callback libvirt.DomainEventAgentLifecycleCallback) error
 	VolatileDomainEventDeviceRemovedRegister(domain VirDomain, callback libvirt.DomainEventDeviceRemovedCallback) (int, error)
 	DomainEventMemoryDeviceSizeChangeRegister(callback libvirt.DomainEventMemoryDeviceSizeChangeCallback) error
+	DomainEventTunableRegister(callback libvirt.DomainEventTunableCallback) error
+	DomainEventWatchdogRegister(callback libvirt.DomainEventWatchdogCallback) error
 	DomainEventDeregister(registrationID int) error
 	ListAllDomains(flags libvirt.ConnectListAllDomainsFlags) ([]VirDomain, error)
 	SetReconnectChan(reconnect chan bool)
@@ -94,6 +98,8 @@ type LibvirtConnection struct {
 	domainEventMigrationIterationCallbacks      []libvirt.DomainEventMigrationIterationCallback
 	agentEventCallbacks                         []libvirt.DomainEventAgentLifecycleCallback
 	domainDeviceMemoryDeviceSizeChangeCallbacks []libvirt.DomainEventMemoryDeviceSizeChangeCallback
+	domainTunableEventCallbacks                 []libvirt.DomainEventTunableCallback
+	domainWatchdogEventCallbacks                []libvirt.DomainEventWatchdogCallback
 }
 
 func (s *VirStream) Write(p []byte) (n int, err error) {
@@ -197,6 +203,28 @@ func (l *LibvirtConnection) DomainEventMemoryDeviceSizeChangeRegister(callback l
 	return
 }
 
+func (l *LibvirtConnection) DomainEventTunableRegister(callback libvirt.DomainEventTunableCallback) (err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+
+	l.domainTunableEventCallbacks = append(l.domainTunableEventCallbacks, callback)
+	_, err = l.Connect.DomainEventTunableRegister(nil, callback)
+	l.checkConnectionLost(err)
+	return
+}
+
+func (l *LibvirtConnection) DomainEventWatchdogRegister(callback libvirt.DomainEventWatchdogCallback) (err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+
+	l.domainWatchdogEventCallbacks = append(l.domainWatchdogEventCallbacks, callback)
+	_, err = l.Connect.DomainEventWatchdogRegister(nil, callback)
+	l.checkConnectionLost(err)
+	return
+}
+
 func (l *LibvirtConnection) DomainEventDeregister(registrationID int) error {
 	return l.Connect.DomainEventDeregister(registrationID)
 }
@@ -221,6 +249,16 @@ func (l *LibvirtConnection) DomainDefineXML(xml string) (dom VirDomain, err erro
 	return
 }
 
+func (l *LibvirtConnection) DomainRestoreFlags(srcFile, xml string, flags libvirt.DomainSaveRestoreFlags) (err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+
+	err = l.Connect.DomainRestoreFlags(srcFile, xml, flags)
+	l.checkConnectionLost(err)
+	return
+}
+
 func (l *LibvirtConnection) ListAllDomains(flags libvirt.ConnectListAllDomainsFlags) ([]VirDomain, error) {
 	if err := l.reconnectIfNecessary(); err != nil {
 		return nil, err
@@ -541,6 +579,14 @@ func (l *LibvirtConnection) reconnectIfNecessary() (err error) {
 			log.Log.Info("Re-registered domain memory device size change callback")
 			_, err = l.Connect.DomainEventMemoryDeviceSizeChangeRegister(nil, callback)
 		}
+		for _, callback := range l.domainTunableEventCallbacks {
+			log.Log.Info("Re-registered domain tunable callback")
+			_, err = l.Connect.DomainEventTunableRegister(nil, callback)
+		}
+		for _, callback := range l.domainWatchdogEventCallbacks {
+			log.Log.Info("Re-registered domain watchdog callback")
+			_, err = l.Connect.DomainEventWatchdogRegister(nil, callback)
+		}
 
 		log.Log.Error("Re-registered domain and agent callbacks for new connection")
 
@@ -601,6 +647,8 @@ type VirDomain interface {
 	GetXMLDesc(flags libvirt.DomainXMLFlags) (string, error)
 	MigrateToURI3(string, *libvirt.DomainMigrateParameters, libvirt.DomainMigrateFlags) error
 	MigrateStartPostCopy(flags uint32) error
+	MigrateSetMaxDowntime(downtime uint64, flags uint32) error
+	MigrateGetMaxDowntime(flags uint32) (uint64, error)
 	MemoryStats(nrStats uint32, flags uint32) ([]libvirt.DomainMemoryStat, error)
 	GetJobStats(flags libvirt.DomainGetJobStatsFlags) (*libvirt.DomainJobInfo, error)
 	GetJobInfo() (*libvirt.DomainJobInfo, error)
@@ -612,9 +660,15 @@ type VirDomain interface {
 	AbortJob() error
 	Free() error
 	CoreDumpWithFormat(to string, format libvirt.DomainCoreDumpFormat, flags libvirt.DomainCoreDumpFlags) error
+	QemuMonitorCommand(command string, flags libvirt.DomainQemuMonitorCommandFlags) (string, error)
+	QemuMonitorCommandWithFiles(command string, infiles []os.File, flags libvirt.DomainQemuMonitorCommandFlags) (string, []*os.File, error)
+	CreateSnapshotXML(xml string, flags libvirt.DomainSnapshotCreateFlags) (*libvirt.DomainSnapshot, error)
 	PinVcpuFlags(vcpu uint, cpuMap []bool, flags libvirt.DomainModificationImpact) error
 	PinEmulator(cpumap []bool, flags libvirt.DomainModificationImpact) error
 	SetVcpusFlags(vcpu uint, flags libvirt.DomainVcpuFlags) error
+	SetMemoryFlags(memory uint64, flags libvirt.DomainMemoryModFlags) error
+	SetBlockIoTune(disk string, params *libvirt.DomainBlockIoTuneParameters, flags libvirt.DomainModificationImpact) error
+	AddIOThread(id uint, flags libvirt.DomainModificationImpact) error
 	GetLaunchSecurityInfo(flags uint32) (*libvirt.DomainLaunchSecurityParameters, error)
 	SetLaunchSecurityState(params *libvirt.DomainLaunchSecurityStateParameters, flags uint32) error
 	FSFreeze(mounts []string, flags uint32) error
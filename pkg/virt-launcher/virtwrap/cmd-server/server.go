@@ -249,6 +249,19 @@ func (l *Launcher) VirtualMachineMemoryDump(_ context.Context, request *cmdv1.Me
 		return response, nil
 	}
 
+	if request.Stream {
+		options := &v1.MemoryDumpStreamOptions{
+			Format:  v1.MemoryDumpFormat(request.Format),
+			Content: v1.MemoryDumpContent(request.Content),
+		}
+		if err := l.domainManager.StreamMemoryDump(vmi, request.DumpPath, options); err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("Failed to stream vmi memory dump")
+			response.Success = false
+			response.Message = getErrorMessage(err)
+		}
+		return response, nil
+	}
+
 	if err := l.domainManager.MemoryDump(vmi, request.DumpPath); err != nil {
 		log.Log.Object(vmi).Reason(err).Errorf("Failed to Dump vmi memory")
 		response.Success = false
@@ -601,6 +614,61 @@ func (l *Launcher) Exec(ctx context.Context, request *cmdv1.ExecRequest) (*cmdv1
 	return resp, nil
 }
 
+// GuestOSExec runs the provided command on behalf of a user and returns its exit code, stdout and stderr
+func (l *Launcher) GuestOSExec(_ context.Context, request *cmdv1.ExecRequest) (*cmdv1.ExecResponse, error) {
+	resp := &cmdv1.ExecResponse{
+		Response: &cmdv1.Response{
+			Success: true,
+		},
+	}
+
+	stdOut, stdErr, err := l.domainManager.GuestOSExec(request.DomainName, request.Command, request.Args, request.TimeoutSeconds)
+	resp.StdOut = stdOut
+	resp.StdErr = stdErr
+
+	exitCode := agent.ExecExitCode{}
+	if err != nil && !errors.As(err, &exitCode) {
+		resp.Response.Success = false
+		resp.Response.Message = err.Error()
+		return resp, err
+	}
+	resp.ExitCode = int32(exitCode.ExitCode)
+
+	return resp, nil
+}
+
+func (l *Launcher) GuestFileRead(_ context.Context, request *cmdv1.GuestFileRequest) (*cmdv1.GuestFileResponse, error) {
+	resp := &cmdv1.GuestFileResponse{
+		Response: &cmdv1.Response{
+			Success: true,
+		},
+	}
+
+	content, err := l.domainManager.GuestFileRead(request.DomainName, request.Path, request.MaxSize)
+	if err != nil {
+		resp.Response.Success = false
+		resp.Response.Message = err.Error()
+		return resp, err
+	}
+	resp.Content = content
+
+	return resp, nil
+}
+
+func (l *Launcher) GuestFileWrite(_ context.Context, request *cmdv1.GuestFileRequest) (*cmdv1.Response, error) {
+	resp := &cmdv1.Response{
+		Success: true,
+	}
+
+	if err := l.domainManager.GuestFileWrite(request.DomainName, request.Path, request.Content); err != nil {
+		resp.Success = false
+		resp.Message = err.Error()
+		return resp, err
+	}
+
+	return resp, nil
+}
+
 func (l *Launcher) GuestPing(ctx context.Context, request *cmdv1.GuestPingRequest) (*cmdv1.GuestPingResponse, error) {
 	resp := &cmdv1.GuestPingResponse{
 		Response: &cmdv1.Response{
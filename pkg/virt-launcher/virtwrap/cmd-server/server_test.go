@@ -134,6 +134,22 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should stream the memory dump", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			dumpPath := "/var/run/kubevirt-private/uid/virt-memory-dump-1"
+			options := &v1.MemoryDumpStreamOptions{Format: v1.MemoryDumpFormatKdumpSnappy, Content: v1.MemoryDumpContentFull}
+			domainManager.EXPECT().StreamMemoryDump(vmi, dumpPath, options)
+			Expect(client.VirtualMachineMemoryDumpStream(vmi, dumpPath, options)).To(Succeed())
+		})
+
+		It("should report a failed memory dump stream", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			dumpPath := "/var/run/kubevirt-private/uid/virt-memory-dump-1"
+			domainManager.EXPECT().StreamMemoryDump(vmi, dumpPath, gomock.Any()).Return(errors.New("a memory dump is already in progress"))
+			err := client.VirtualMachineMemoryDumpStream(vmi, dumpPath, &v1.MemoryDumpStreamOptions{})
+			Expect(err).To(MatchError(ContainSubstring("a memory dump is already in progress")))
+		})
+
 		It("should pause a vmi", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
 			domainManager.EXPECT().PauseVMI(vmi)
@@ -422,6 +438,46 @@ var _ = Describe("Virt remote commands", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(resp.Response.Success).To(BeTrue())
 			})
+			It("returns exit code, stdOut and stdErr of guest os exec", func() {
+				domainManager.EXPECT().GuestOSExec(testDomainName, testCommand, testArgs, testTimeoutSeconds).
+					Times(1).Return(testStdOut, "stdErr", agent.ExecExitCode{ExitCode: 2})
+				resp, err := server.GuestOSExec(context.TODO(), execRequest())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Response.Success).To(BeTrue())
+				Expect(resp.ExitCode).To(BeEquivalentTo(2))
+				Expect(resp.StdOut).To(Equal(testStdOut))
+				Expect(resp.StdErr).To(Equal("stdErr"))
+			})
+			It("returns guest os exec errors in the response", func() {
+				domainManager.EXPECT().GuestOSExec(testDomainName, testCommand, testArgs, testTimeoutSeconds).
+					Times(1).Return("", "", testExecErr)
+				resp, err := server.GuestOSExec(context.TODO(), execRequest())
+				Expect(err).To(HaveOccurred())
+				Expect(resp.Response.Success).To(BeFalse())
+				Expect(resp.Response.Message).To(Equal(testExecErr.Error()))
+			})
+			It("returns the content of guest files", func() {
+				domainManager.EXPECT().GuestFileRead(testDomainName, "/etc/hostname", int64(1024)).Times(1).Return([]byte("content"), nil)
+				resp, err := server.GuestFileRead(context.TODO(), &cmdv1.GuestFileRequest{
+					DomainName: testDomainName,
+					Path:       "/etc/hostname",
+					MaxSize:    1024,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Response.Success).To(BeTrue())
+				Expect(resp.Content).To(Equal([]byte("content")))
+			})
+			It("returns guest file write errors in the response", func() {
+				domainManager.EXPECT().GuestFileWrite(testDomainName, "/etc/hostname", []byte("content")).Times(1).Return(testExecErr)
+				resp, err := server.GuestFileWrite(context.TODO(), &cmdv1.GuestFileRequest{
+					DomainName: testDomainName,
+					Path:       "/etc/hostname",
+					Content:    []byte("content"),
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(resp.Success).To(BeFalse())
+				Expect(resp.Message).To(Equal(testExecErr.Error()))
+			})
 			It("should call guest ping", func() {
 				expectGuestPing().Times(1)
 				server.GuestPing(context.TODO(), guestPingRequest())
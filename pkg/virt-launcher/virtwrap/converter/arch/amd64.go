@@ -24,6 +24,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/util"
+	watchdogutil "kubevirt.io/kubevirt/pkg/util/watchdog"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/launchsecurity"
@@ -124,7 +125,7 @@ func (converterAMD64) ConvertWatchdog(source *v1.Watchdog, watchdog *api.Watchdo
 	watchdog.Alias = api.NewUserDefinedAlias(source.Name)
 	if source.I6300ESB != nil {
 		watchdog.Model = "i6300esb"
-		watchdog.Action = string(source.I6300ESB.Action)
+		watchdog.Action = watchdogutil.LibvirtAction(source.I6300ESB.Action)
 		return nil
 	}
 	return fmt.Errorf("watchdog %s can't be mapped, no watchdog type specified", source.Name)
@@ -22,6 +22,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
+	watchdogutil "kubevirt.io/kubevirt/pkg/util/watchdog"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -101,7 +102,7 @@ func (converterS390X) ConvertWatchdog(source *v1.Watchdog, watchdog *api.Watchdo
 	watchdog.Alias = api.NewUserDefinedAlias(source.Name)
 	if source.Diag288 != nil {
 		watchdog.Model = "diag288"
-		watchdog.Action = string(source.Diag288.Action)
+		watchdog.Action = watchdogutil.LibvirtAction(source.Diag288.Action)
 		return nil
 	}
 	return fmt.Errorf("watchdog %s can't be mapped, no watchdog type specified", source.Name)
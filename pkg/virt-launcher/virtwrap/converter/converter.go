@@ -56,6 +56,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/storage/vhostuserblk"
 	"kubevirt.io/kubevirt/pkg/tpm"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
@@ -72,6 +73,9 @@ const (
 	bootMenuTimeoutMS          = uint(10000)
 	multiQueueMaxQueues        = uint32(256)
 	QEMUSeaBiosDebugPipe       = "/var/run/kubevirt-private/QEMUSeaBiosDebugPipe"
+	nvmePrefix                 = "nvme"
+
+	vhostUserBlkReconnectTimeout = 10
 )
 
 type deviceNamer struct {
@@ -113,6 +117,7 @@ type ConverterContext struct {
 	BochsForEFIGuests               bool
 	SerialConsoleLog                bool
 	DomainAttachmentByInterfaceName map[string]string
+	VhostUserBlkSockets             map[string]string
 }
 
 func assignDiskToSCSIController(disk *api.Disk, unit int) {
@@ -205,6 +210,7 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 		disk.Driver.Queues = numQueues
 	}
 	disk.Alias = api.NewUserDefinedAlias(diskDevice.Name)
+	disk.IOTune = Convert_v1_DiskIOLimits_To_api_BlockIOTune(diskDevice.IOLimits)
 	if diskDevice.BootOrder != nil {
 		disk.BootOrder = &api.BootOrder{Order: *diskDevice.BootOrder}
 	}
@@ -382,6 +388,9 @@ func SetDriverCacheMode(disk *api.Disk, directIOChecker DirectIOChecker) error {
 	// handle empty cdrom
 	case disk.Device == "cdrom":
 		return nil
+	// the caching of vhost-user disks is up to the target
+	case disk.Type == "vhostuser":
+		return nil
 	default:
 		return fmt.Errorf("unable to set a driver cache mode, disk is neither a block device nor a file")
 	}
@@ -497,7 +506,7 @@ func makeDeviceName(diskName string, bus v1.DiskBus, prefixMap map[string]device
 	deviceNamer := prefixMap[prefix]
 	if name, ok := deviceNamer.getExistingVolumeValue(diskName); ok {
 		for i := 0; i < 26*26*26; i++ {
-			calculatedName := FormatDeviceName(prefix, i)
+			calculatedName := formatDeviceName(prefix, i)
 			if calculatedName == name {
 				return name, i
 			}
@@ -507,7 +516,7 @@ func makeDeviceName(diskName string, bus v1.DiskBus, prefixMap map[string]device
 	}
 	// Name not found yet, generate next new one.
 	for i := 0; i < 26*26*26; i++ {
-		name := FormatDeviceName(prefix, i)
+		name := formatDeviceName(prefix, i)
 		if _, ok := deviceNamer.getExistingTargetValue(name); !ok {
 			deviceNamer.existingNameMap[diskName] = name
 			deviceNamer.usedDeviceMap[name] = diskName
@@ -518,6 +527,15 @@ func makeDeviceName(diskName string, bus v1.DiskBus, prefixMap map[string]device
 }
 
 // port of http://elixir.free-electrons.com/linux/v4.15/source/drivers/scsi/sd.c#L3211
+// formatDeviceName names NVMe disks like the namespaces of the emulated
+// controller, e.g. nvme0n1, and the other disks like FormatDeviceName.
+func formatDeviceName(prefix string, index int) string {
+	if prefix == nvmePrefix {
+		return fmt.Sprintf("%s0n%d", nvmePrefix, index+1)
+	}
+	return FormatDeviceName(prefix, index)
+}
+
 func FormatDeviceName(prefix string, index int) string {
 	base := int('z' - 'a' + 1)
 	name := ""
@@ -596,6 +614,9 @@ func Convert_v1_Volume_To_api_Disk(source *v1.Volume, disk *api.Disk, c *Convert
 	if source.DownwardMetrics != nil {
 		return Convert_v1_DownwardMetricSource_To_api_Disk(disk, c)
 	}
+	if source.VhostUserBlk != nil {
+		return Convert_v1_VhostUserBlkSource_To_api_Disk(source.Name, source.VhostUserBlk, disk, c)
+	}
 
 	return fmt.Errorf("disk %s references an unsupported source", disk.Alias.GetName())
 }
@@ -750,6 +771,39 @@ func Convert_v1_HostDisk_To_api_Disk(volumeName string, path string, disk *api.D
 	return nil
 }
 
+// Convert_v1_VhostUserBlkSource_To_api_Disk connects the disk to the socket of a vhost-user-blk target.
+// QEMU reconnects to the socket when the target restarts.
+func Convert_v1_VhostUserBlkSource_To_api_Disk(volumeName string, source *v1.VhostUserBlkVolumeSource, disk *api.Disk, c *ConverterContext) error {
+	if disk.Device != "disk" {
+		return fmt.Errorf("device %s is of type %s. Only disks can use a vhost-user-blk volume", disk.Alias.GetName(), disk.Device)
+	}
+
+	socketPath := vhostuserblk.GetSocketPath(volumeName)
+	if source.ResourceName != "" {
+		path, ok := c.VhostUserBlkSockets[volumeName]
+		if !ok {
+			return fmt.Errorf("no vhost-user-blk socket of resource %s allocated for disk %s", source.ResourceName, disk.Alias.GetName())
+		}
+		socketPath = path
+	}
+
+	disk.Type = "vhostuser"
+	disk.Source.Type = "unix"
+	disk.Source.Path = socketPath
+	disk.Source.Reconnect = &api.DiskReconnect{
+		Enabled: "yes",
+		Timeout: pointer.P(uint(vhostUserBlkReconnectTimeout)),
+	}
+	// libvirt rejects the block layer settings for vhost-user disks
+	disk.Driver.Type = "raw"
+	disk.Driver.Cache = ""
+	disk.Driver.IO = ""
+	disk.Driver.Discard = ""
+	disk.Driver.ErrorPolicy = ""
+	disk.IOTune = nil
+	return nil
+}
+
 func Convert_v1_SysprepSource_To_api_Disk(volumeName string, disk *api.Disk) error {
 	if disk.Type == "lun" {
 		return fmt.Errorf(deviceTypeNotCompatibleFmt, disk.Alias.GetName())
@@ -900,11 +954,19 @@ func Convert_v1_Usbredir_To_api_Usbredir(vmi *v1.VirtualMachineInstance, domainD
 	redirectDevices := make([]api.RedirectedDevice, v1.UsbClientPassthroughMaxNumberOf)
 
 	for i := 0; i < v1.UsbClientPassthroughMaxNumberOf; i++ {
+		// With SPICE the devices are redirected by the SPICE client instead of virtctl usbredir
+		if util.IsSPICEGraphics(vmi) {
+			redirectDevices[i] = api.RedirectedDevice{
+				Type: "spicevmc",
+				Bus:  "usb",
+			}
+			continue
+		}
 		path := fmt.Sprintf("/var/run/kubevirt-private/%s/virt-usbredir-%d", vmi.ObjectMeta.UID, i)
 		redirectDevices[i] = api.RedirectedDevice{
 			Type: "unix",
 			Bus:  "usb",
-			Source: api.RedirectedDeviceSource{
+			Source: &api.RedirectedDeviceSource{
 				Mode: "bind",
 				Path: path,
 			},
@@ -1463,6 +1525,12 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		domainVCPUTopologyForHotplug(vmi, domain)
 	}
 
+	if vcpu.HasGuestNUMACells(vmi) {
+		if domain.Spec.CPU.NUMA, err = vcpu.GuestNUMATopology(vmi); err != nil {
+			return err
+		}
+	}
+
 	kvmPath := "/dev/kvm"
 	if _, err := os.Stat(kvmPath); errors.Is(err, os.ErrNotExist) {
 		if c.AllowEmulation {
@@ -1567,9 +1635,14 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		if val := vmi.Annotations[v1.MemfdMemoryBackend]; val != "false" {
 			isMemfdRequired = true
 		}
+		if hasRegularMemory(vmi) {
+			if err := setupMixedPageSizeMemory(vmi, domain); err != nil {
+				return err
+			}
+		}
 	}
-	// virtiofs require shared access
-	if util.IsVMIVirtiofsEnabled(vmi) {
+	// virtiofs and vhost-user-blk require shared access
+	if util.IsVMIVirtiofsEnabled(vmi) || vhostuserblk.HasVhostUserBlkVolumes(&vmi.Spec) {
 		if domain.Spec.MemoryBacking == nil {
 			domain.Spec.MemoryBacking = &api.MemoryBacking{}
 		}
@@ -1599,6 +1672,14 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		}
 	}
 
+	// Keep KSM from merging the guest memory
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.KSMMergePolicy == v1.KSMMergePolicyDisabled {
+		if domain.Spec.MemoryBacking == nil {
+			domain.Spec.MemoryBacking = &api.MemoryBacking{}
+		}
+		domain.Spec.MemoryBacking.NoSharePages = &api.NoSharePages{}
+	}
+
 	volumeIndices := map[string]int{}
 	volumes := map[string]*v1.Volume{}
 	for i, volume := range vmi.Spec.Volumes {
@@ -1670,9 +1751,13 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		}
 	}
 	// Handle virtioFS
-	domain.Spec.Devices.Filesystems = append(domain.Spec.Devices.Filesystems, convertFileSystems(vmi.Spec.Domain.Devices.Filesystems)...)
+	domain.Spec.Devices.Filesystems = append(domain.Spec.Devices.Filesystems, convertFileSystems(vmi.Spec.Domain.Devices.Filesystems, c.HotplugVolumes)...)
 
 	domain.Spec.Devices.PanicDevices = append(domain.Spec.Devices.PanicDevices, convertPanicDevices(vmi.Spec.Domain.Devices.PanicDevices)...)
+	if vmi.Spec.CrashDump != nil {
+		// keep the crashed domain until its memory is dumped
+		domain.Spec.OnCrash = "preserve"
+	}
 
 	Convert_v1_Sound_To_api_Sound(vmi, &domain.Spec.Devices, c)
 
@@ -1731,6 +1816,15 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers, scsiController)
 	}
 
+	if needsNVMeController(vmi) {
+		domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers,
+			api.Controller{
+				Type:  "nvme",
+				Index: "0",
+			},
+		)
+	}
+
 	if c.Architecture.SupportPCIHole64Disabling() && shouldDisablePCIHole64(vmi) {
 		domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers,
 			api.Controller{
@@ -1899,14 +1993,34 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			}
 			domain.Spec.Devices.Video = []api.Video{video}
 		}
-		domain.Spec.Devices.Graphics = []api.Graphics{
-			{
-				Listen: &api.GraphicsListen{
-					Type:   "socket",
-					Socket: fmt.Sprintf("/var/run/kubevirt-private/%s/virt-vnc", vmi.ObjectMeta.UID),
+		if util.IsSPICEGraphics(vmi) {
+			domain.Spec.Devices.Graphics = []api.Graphics{
+				{
+					Listen: &api.GraphicsListen{
+						Type:   "socket",
+						Socket: fmt.Sprintf("/var/run/kubevirt-private/%s/virt-spice", vmi.ObjectMeta.UID),
+					},
+					Type: "spice",
 				},
-				Type: "vnc",
-			},
+			}
+			// The SPICE agent channel enables the clipboard sharing and the resizing of the display by the client
+			domain.Spec.Devices.Channels = append(domain.Spec.Devices.Channels, api.Channel{
+				Type: "spicevmc",
+				Target: &api.ChannelTarget{
+					Name: "com.redhat.spice.0",
+					Type: v1.VirtIO,
+				},
+			})
+		} else {
+			domain.Spec.Devices.Graphics = []api.Graphics{
+				{
+					Listen: &api.GraphicsListen{
+						Type:   "socket",
+						Socket: fmt.Sprintf("/var/run/kubevirt-private/%s/virt-vnc", vmi.ObjectMeta.UID),
+					},
+					Type: "vnc",
+				},
+			}
 		}
 	}
 
@@ -1919,7 +2033,7 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 
 	// Add Ignition Command Line if present
 	ignitiondata := vmi.Annotations[v1.IgnitionAnnotation]
-	if ignitiondata != "" && strings.Contains(ignitiondata, "ignition") {
+	if (ignitiondata != "" && strings.Contains(ignitiondata, "ignition")) || ignition.GetIgnitionVolume(vmi) != nil {
 		initializeQEMUCmdAndQEMUArg(domain)
 		domain.Spec.QEMUCmd.QEMUArg = append(domain.Spec.QEMUCmd.QEMUArg, api.Arg{Value: "-fw_cfg"})
 		ignitionpath := fmt.Sprintf("%s/%s", ignition.GetDomainBasePath(c.VirtualMachine.Name, c.VirtualMachine.Namespace), ignition.IgnitionFile)
@@ -2022,6 +2136,15 @@ func needsSCSIController(vmi *v1.VirtualMachineInstance) bool {
 	return !vmi.Spec.Domain.Devices.DisableHotplug
 }
 
+func needsNVMeController(vmi *v1.VirtualMachineInstance) bool {
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if getBusFromDisk(disk) == v1.DiskBusNVMe {
+			return true
+		}
+	}
+	return false
+}
+
 func shouldDisablePCIHole64(vmi *v1.VirtualMachineInstance) bool {
 	if val, ok := vmi.Annotations[v1.DisablePCIHole64]; ok {
 		return strings.EqualFold(val, "true")
@@ -2048,6 +2171,8 @@ func getPrefixFromBus(bus v1.DiskBus) string {
 		return "vd"
 	case v1.DiskBusSATA, v1.DiskBusSCSI, v1.DiskBusUSB:
 		return "sd"
+	case v1.DiskBusNVMe:
+		return nvmePrefix
 	default:
 		log.Log.Errorf("Unrecognized bus '%s'", bus)
 		return ""
@@ -51,6 +51,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
 	"kubevirt.io/kubevirt/pkg/ephemeral-disk/fake"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	"kubevirt.io/kubevirt/pkg/ignition"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/os/disk"
 	"kubevirt.io/kubevirt/pkg/pointer"
@@ -61,6 +62,7 @@ import (
 	archconverter "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/arch"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
 	sev "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/launchsecurity"
+	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
 var (
@@ -191,6 +193,23 @@ var _ = Describe("Converter", func() {
 					Action: "reset",
 				},
 			),
+
+			Entry("amd64 with an action taken by KubeVirt",
+				"amd64",
+				&v1.Watchdog{
+					Name: "mywatchdog",
+					WatchdogDevice: v1.WatchdogDevice{
+						I6300ESB: &v1.I6300ESBWatchdog{
+							Action: v1.WatchdogActionMigrate,
+						},
+					},
+				},
+				&api.Watchdog{
+					Alias:  api.NewUserDefinedAlias("mywatchdog"),
+					Model:  "i6300esb",
+					Action: "none",
+				},
+			),
 		)
 		DescribeTable("should fail to convert watchdog for unsupported or invalid architectures",
 			func(arch string, input *v1.Watchdog, expectedErrMsg string) {
@@ -813,6 +832,14 @@ var _ = Describe("Converter", func() {
 			vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: pointer.P(v1.Hyperv)}}
 			xml := vmiToDomainXML(vmi, c)
 			Expect(xml).To(ContainSubstring(`<panic model="hyperv"></panic>`))
+			Expect(xml).ToNot(ContainSubstring(`<on_crash>`))
+		})
+
+		It("should preserve the crashed domain if a crash dump is requested", func() {
+			vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: pointer.P(v1.Pvpanic)}}
+			vmi.Spec.CrashDump = &v1.CrashDump{VolumeName: "crash-dumps"}
+			xml := vmiToDomainXML(vmi, c)
+			Expect(xml).To(ContainSubstring(`<on_crash>preserve</on_crash>`))
 		})
 
 		DescribeTable("should be converted to a libvirt Domain with vmi defaults set", func(arch string, domain string) {
@@ -1103,6 +1130,20 @@ var _ = Describe("Converter", func() {
 			Entry("should be nil for arm64", arm64, BeNil()),
 		)
 
+		DescribeTable("KSM merge policy", func(policy v1.KSMMergePolicy, matcher types.GomegaMatcher) {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			if vmi.Spec.Domain.Memory == nil {
+				vmi.Spec.Domain.Memory = &v1.Memory{}
+			}
+			vmi.Spec.Domain.Memory.KSMMergePolicy = policy
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.MemoryBacking).To(matcher)
+		},
+			Entry("should let KSM merge the memory by default", v1.KSMMergePolicy(""), BeNil()),
+			Entry("should let KSM merge the memory with the Aggressive policy", v1.KSMMergePolicyAggressive, BeNil()),
+			Entry("should keep KSM from merging the memory with the Disabled policy", v1.KSMMergePolicyDisabled, Equal(&api.MemoryBacking{NoSharePages: &api.NoSharePages{}})),
+		)
+
 		Context("when downwardMetrics are exposed via virtio-serial", func() {
 			It("should set socket options", func() {
 				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
@@ -1192,6 +1233,53 @@ var _ = Describe("Converter", func() {
 			Expect(reserv.SourceReservations.Mode).To(Equal("client"))
 		})
 
+		It("should connect vhost-user-blk disks with shared memory", func() {
+			name := "spdk-disk"
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{{
+				Name:       name,
+				DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}},
+			}}
+			vmi.Spec.Volumes = []v1.Volume{{
+				Name: name,
+				VolumeSource: v1.VolumeSource{
+					VhostUserBlk: &v1.VhostUserBlkVolumeSource{SocketName: "vhost.0"},
+				},
+			}}
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(domainSpec.Devices.Disks[0].Type).To(Equal("vhostuser"))
+			Expect(domainSpec.Devices.Disks[0].Source.Path).To(Equal("/var/run/kubevirt-private/vhost-user-blk/spdk-disk.sock"))
+			Expect(domainSpec.MemoryBacking.Access.Mode).To(Equal("shared"))
+			Expect(domainSpec.MemoryBacking.Source.Type).To(Equal("memfd"))
+		})
+
+		It("should define the guest NUMA cells", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.CPU = &v1.CPU{Cores: 2, NUMA: &v1.NUMA{GuestCells: []v1.NUMAGuestCell{
+				{
+					ID:        0,
+					CPUs:      "0",
+					Memory:    resource.MustParse("4Mi"),
+					Distances: []v1.NUMAGuestCellDistance{{CellID: 0, Value: 10}, {CellID: 1, Value: 20}},
+				},
+				{ID: 1, CPUs: "1", Memory: resource.MustParse("4Mi")},
+			}}}
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(domainSpec.CPU.NUMA).To(Equal(&api.NUMA{Cells: []api.NUMACell{
+				{
+					ID:     "0",
+					CPUs:   "0",
+					Memory: 4 * 1024 * 1024,
+					Unit:   "b",
+					Distances: &api.NUMACellDistances{Siblings: []api.NUMACellSibling{
+						{ID: 0, Value: 10},
+						{ID: 1, Value: 20},
+					}},
+				},
+				{ID: "1", CPUs: "1", Memory: 4 * 1024 * 1024, Unit: "b"},
+			}}))
+		})
+
 		It("should allow CD-ROM with no volume", func() {
 			name := "empty-cdrom"
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
@@ -1437,6 +1525,16 @@ var _ = Describe("Converter", func() {
 			Entry("should be disabled on s390x", s390x, "none"),
 		)
 
+		It("should redirect the usb devices through the SPICE client when SPICE is requested", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.ClientPassthrough = &v1.ClientPassthroughDevices{}
+			vmi.Spec.Domain.Devices.Graphics = &v1.Graphics{Type: v1.GraphicsTypeSPICE}
+			c.Architecture = archconverter.NewConverter(amd64)
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Redirs).To(HaveLen(v1.UsbClientPassthroughMaxNumberOf))
+			Expect(domain.Spec.Devices.Redirs).To(HaveEach(api.RedirectedDevice{Type: "spicevmc", Bus: "usb"}))
+		})
+
 		It("should not enable usb redirection when numberOfDevices == 0", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.ClientPassthrough = nil
@@ -1544,6 +1642,42 @@ var _ = Describe("Converter", func() {
 			Expect(domainSpec.Memory.Unit).To(Equal("b"))
 		})
 
+		It("should back only the memory of the first NUMA cell with hugepages when regular memory is requested", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			regularMemory := resource.MustParse("2Mi")
+			vmi.Spec.Domain.Memory = &v1.Memory{
+				Hugepages: &v1.Hugepages{
+					PageSize:      "2Mi",
+					RegularMemory: &regularMemory,
+				},
+			}
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(domainSpec.MemoryBacking.HugePages.HugePage).To(Equal([]api.HugePage{
+				{Size: "2048", Unit: "KiB", NodeSet: "0"},
+			}))
+			Expect(domainSpec.CPU.NUMA).ToNot(BeNil())
+			Expect(domainSpec.CPU.NUMA.Cells).To(HaveLen(2))
+			Expect(domainSpec.CPU.NUMA.Cells[0].ID).To(Equal("0"))
+			Expect(domainSpec.CPU.NUMA.Cells[0].CPUs).To(Equal(fmt.Sprintf("0-%d", domainSpec.VCPU.CPUs-1)))
+			Expect(domainSpec.CPU.NUMA.Cells[0].Memory).To(Equal(uint64(6144)))
+			Expect(domainSpec.CPU.NUMA.Cells[1].ID).To(Equal("1"))
+			Expect(domainSpec.CPU.NUMA.Cells[1].CPUs).To(BeEmpty())
+			Expect(domainSpec.CPU.NUMA.Cells[1].Memory).To(Equal(uint64(2048)))
+		})
+
+		It("should fail to convert when the regular memory is not less than the guest memory", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			regularMemory := resource.MustParse("8Mi")
+			vmi.Spec.Domain.Memory = &v1.Memory{
+				Hugepages: &v1.Hugepages{
+					PageSize:      "2Mi",
+					RegularMemory: &regularMemory,
+				},
+			}
+			domain := &api.Domain{}
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c)).ToNot(Succeed())
+		})
+
 		It("should use guest memory instead of requested memory if present", func() {
 			guestMemory := resource.MustParse("123Mi")
 			vmi.Spec.Domain.Memory = &v1.Memory{
@@ -1568,6 +1702,20 @@ var _ = Describe("Converter", func() {
 			Expect(domainSpec.Devices.Rng).ToNot(BeNil())
 		})
 
+		It("should pass the config of an Ignition volume through the firmware configuration device", func() {
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name:         "ignition",
+				VolumeSource: v1.VolumeSource{Ignition: &v1.IgnitionSource{Data: `{"ignition":{"version":"3.4.0"}}`}},
+			})
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.QEMUCmd).ToNot(BeNil())
+			Expect(domain.Spec.QEMUCmd.QEMUArg).To(ContainElements(
+				api.Arg{Value: "-fw_cfg"},
+				api.Arg{Value: fmt.Sprintf("name=opt/com.coreos/config,file=%s/%s",
+					ignition.GetDomainBasePath(c.VirtualMachine.Name, c.VirtualMachine.Namespace), ignition.IgnitionFile)},
+			))
+		})
+
 		DescribeTable("Validate that QEMU SeaBios debug logs are ",
 			func(toDefineVerbosityEnvVariable bool, virtLauncherLogVerbosity int, shouldEnableDebugLogs bool) {
 
@@ -1964,6 +2112,39 @@ var _ = Describe("Converter", func() {
 		},
 			MultiArchEntry(""),
 		)
+
+		It("should have one spice with the spice agent channel when SPICE is requested", func() {
+			vmi := v1.VirtualMachineInstance{
+				ObjectMeta: k8smeta.ObjectMeta{
+					Name:      "testvmi",
+					Namespace: "default",
+					UID:       "1234",
+				},
+				Spec: v1.VirtualMachineInstanceSpec{
+					Domain: v1.DomainSpec{
+						Devices: v1.Devices{
+							Graphics: &v1.Graphics{Type: v1.GraphicsTypeSPICE},
+						},
+					},
+				},
+			}
+
+			domain := vmiToDomain(&vmi, &ConverterContext{Architecture: archconverter.NewConverter(amd64), AllowEmulation: true})
+			Expect(domain.Spec.Devices.Graphics).To(HaveExactElements(api.Graphics{
+				Type: "spice",
+				Listen: &api.GraphicsListen{
+					Type:   "socket",
+					Socket: "/var/run/kubevirt-private/1234/virt-spice",
+				},
+			}))
+			Expect(domain.Spec.Devices.Channels).To(ContainElement(api.Channel{
+				Type: "spicevmc",
+				Target: &api.ChannelTarget{
+					Name: "com.redhat.spice.0",
+					Type: v1.VirtIO,
+				},
+			}))
+		})
 	})
 
 	Context("HyperV", func() {
@@ -2771,6 +2952,39 @@ var _ = Describe("Converter", func() {
 		})
 
 	})
+
+	Context("virtio-net queue sizes", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = &v1.VirtualMachineInstance{
+				ObjectMeta: k8smeta.ObjectMeta{
+					Name:      "testvmi",
+					Namespace: "mynamespace",
+				},
+			}
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Domain.Devices.Interfaces[0].RxQueueSize = pointer.P(uint32(1024))
+			vmi.Spec.Domain.Devices.Interfaces[0].TxQueueSize = pointer.P(uint32(512))
+		})
+
+		It("should set the queue sizes on virtio devices", func() {
+			domain := vmiToDomain(vmi, &ConverterContext{Architecture: archconverter.NewConverter(runtime.GOARCH), AllowEmulation: true})
+			Expect(domain.Spec.Devices.Interfaces[0].Driver).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Interfaces[0].Driver.Name).To(Equal("vhost"))
+			Expect(domain.Spec.Devices.Interfaces[0].Driver.RxQueueSize).To(HaveValue(Equal(uint(1024))))
+			Expect(domain.Spec.Devices.Interfaces[0].Driver.TxQueueSize).To(HaveValue(Equal(uint(512))))
+		})
+
+		It("should not set the queue sizes on non-virtio devices", func() {
+			vmi.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+			domain := vmiToDomain(vmi, &ConverterContext{Architecture: archconverter.NewConverter(runtime.GOARCH), AllowEmulation: true})
+			Expect(domain.Spec.Devices.Interfaces[0].Driver).To(BeNil())
+		})
+	})
+
 	Context("Realtime", func() {
 		var vmi *v1.VirtualMachineInstance
 		var rtContext *ConverterContext
@@ -3173,6 +3387,23 @@ var _ = Describe("Converter", func() {
 				Expect(domain.Spec.Devices.Controllers).To(HaveLen(2))
 			})
 
+			It("should add an NVMe controller and namespaces for NVMe disks", func() {
+				vmi.Spec.Domain.Devices.Disks = []v1.Disk{
+					{Name: "disk0", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusNVMe}}},
+					{Name: "disk1", IO: v1.IOUring, DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusNVMe}}},
+				}
+				vmi.Spec.Volumes = []v1.Volume{
+					{Name: "disk0", VolumeSource: v1.VolumeSource{EmptyDisk: &v1.EmptyDiskSource{Capacity: resource.MustParse("1Gi")}}},
+					{Name: "disk1", VolumeSource: v1.VolumeSource{EmptyDisk: &v1.EmptyDiskSource{Capacity: resource.MustParse("1Gi")}}},
+				}
+				domain := vmiToDomain(vmi, c)
+				Expect(domain.Spec.Devices.Controllers).To(ContainElement(api.Controller{Type: "nvme", Index: "0"}))
+				Expect(domain.Spec.Devices.Disks).To(HaveLen(2))
+				Expect(domain.Spec.Devices.Disks[0].Target).To(Equal(api.DiskTarget{Bus: v1.DiskBusNVMe, Device: "nvme0n1"}))
+				Expect(domain.Spec.Devices.Disks[1].Target).To(Equal(api.DiskTarget{Bus: v1.DiskBusNVMe, Device: "nvme0n2"}))
+				Expect(domain.Spec.Devices.Disks[1].Driver.IO).To(Equal(v1.IOUring))
+			})
+
 			DescribeTable("should convert",
 				func(converterFunc ConverterFunc, volumeName string, isBlockMode bool, ignoreDiscard bool) {
 					expectedDisk := &api.Disk{}
@@ -3278,6 +3509,40 @@ var _ = Describe("Converter", func() {
 				Expect(domain.Spec.Memory.Value).To(Equal(uint64(guestMemory.Value())))
 			})
 		})
+
+		Context("filesystem", func() {
+			filesystems := []v1.Filesystem{
+				{Name: "shared", Virtiofs: &v1.FilesystemVirtiofs{}},
+				{Name: "hotplugged", Virtiofs: &v1.FilesystemVirtiofs{}},
+			}
+
+			DescribeTable("should only convert a hotplugged filesystem once it is mounted", func(phase v1.VolumePhase, expectedTargets ...string) {
+				hotplugVolumes := map[string]v1.VolumeStatus{
+					"hotplugged": {Name: "hotplugged", Phase: phase},
+				}
+
+				domainFilesystems := convertFileSystems(filesystems, hotplugVolumes)
+				Expect(domainFilesystems).To(HaveLen(len(expectedTargets)))
+				for i, target := range expectedTargets {
+					Expect(domainFilesystems[i].Target.Dir).To(Equal(target))
+				}
+			},
+				Entry("when pending", v1.HotplugVolumeAttachedToNode, "shared"),
+				Entry("when mounted", v1.HotplugVolumeMounted, "shared", "hotplugged"),
+				Entry("when ready", v1.VolumeReady, "shared", "hotplugged"),
+			)
+
+			It("should serve a hotplugged filesystem through the virt-launcher socket", func() {
+				hotplugVolumes := map[string]v1.VolumeStatus{
+					"hotplugged": {Name: "hotplugged", Phase: v1.HotplugVolumeMounted},
+				}
+
+				domainFilesystems := convertFileSystems(filesystems, hotplugVolumes)
+				Expect(domainFilesystems).To(HaveLen(2))
+				Expect(domainFilesystems[0].Source.Socket).To(Equal(virtiofs.VirtioFSSocketPath("shared")))
+				Expect(domainFilesystems[1].Source.Socket).To(Equal(virtiofs.HotplugVirtioFSSocketPath("hotplugged")))
+			})
+		})
 	})
 
 	Context("with AMD SEV LaunchSecurity", func() {
@@ -3687,6 +3952,88 @@ var _ = Describe("Converter", func() {
 	})
 })
 
+var _ = Describe("disk IO limits", func() {
+	It("should not set iotune without IO limits", func() {
+		Expect(Convert_v1_DiskIOLimits_To_api_BlockIOTune(nil)).To(BeNil())
+	})
+
+	It("should convert the IO limits to iotune", func() {
+		limits := &v1.DiskIOLimits{
+			ReadIOPS:       pointer.P(int64(1000)),
+			WriteIOPS:      pointer.P(int64(500)),
+			ReadBandwidth:  pointer.P(resource.MustParse("100Mi")),
+			WriteBandwidth: pointer.P(resource.MustParse("50M")),
+			Burst: &v1.DiskIOBurst{
+				ReadIOPS:       pointer.P(int64(2000)),
+				WriteBandwidth: pointer.P(resource.MustParse("100M")),
+				LengthSeconds:  pointer.P(int64(30)),
+			},
+		}
+		Expect(Convert_v1_DiskIOLimits_To_api_BlockIOTune(limits)).To(Equal(&api.BlockIOTune{
+			ReadIopsSec:            1000,
+			WriteIopsSec:           500,
+			ReadBytesSec:           100 * 1024 * 1024,
+			WriteBytesSec:          50 * 1000 * 1000,
+			ReadIopsSecMax:         2000,
+			ReadIopsSecMaxLength:   30,
+			WriteBytesSecMax:       100 * 1000 * 1000,
+			WriteBytesSecMaxLength: 30,
+		}))
+	})
+
+	It("should add iotune to the disk", func() {
+		v1Disk := &v1.Disk{
+			Name:     "mydisk",
+			IOLimits: &v1.DiskIOLimits{WriteIOPS: pointer.P(int64(100))},
+			DiskDevice: v1.DiskDevice{
+				Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio},
+			},
+		}
+		apiDisk := &api.Disk{}
+		c := &ConverterContext{Architecture: archconverter.NewConverter(runtime.GOARCH)}
+		Expect(Convert_v1_Disk_To_api_Disk(c, v1Disk, apiDisk, map[string]deviceNamer{}, nil, map[string]v1.VolumeStatus{})).To(Succeed())
+		Expect(apiDisk.IOTune).To(Equal(&api.BlockIOTune{WriteIopsSec: 100}))
+	})
+})
+
+var _ = Describe("vhost-user-blk disks", func() {
+	It("should connect the disk to the socket of the volume", func() {
+		disk := &api.Disk{
+			Device: "disk",
+			Driver: &api.DiskDriver{Name: "qemu", Discard: "unmap", ErrorPolicy: v1.DiskErrorPolicyStop},
+		}
+		source := &v1.VhostUserBlkVolumeSource{SocketName: "vhost.0"}
+		Expect(Convert_v1_VhostUserBlkSource_To_api_Disk("spdk-disk", source, disk, &ConverterContext{})).To(Succeed())
+		Expect(disk.Type).To(Equal("vhostuser"))
+		Expect(disk.Driver).To(Equal(&api.DiskDriver{Name: "qemu", Type: "raw"}))
+		Expect(disk.Source).To(Equal(api.DiskSource{
+			Type:      "unix",
+			Path:      "/var/run/kubevirt-private/vhost-user-blk/spdk-disk.sock",
+			Reconnect: &api.DiskReconnect{Enabled: "yes", Timeout: pointer.P(uint(10))},
+		}))
+	})
+
+	It("should use the socket allocated by the device plugin", func() {
+		disk := &api.Disk{Device: "disk", Driver: &api.DiskDriver{}}
+		source := &v1.VhostUserBlkVolumeSource{ResourceName: "spdk.io/vhost-blk"}
+		c := &ConverterContext{VhostUserBlkSockets: map[string]string{"spdk-disk": "/var/tmp/vhost-blk/vhost.3"}}
+		Expect(Convert_v1_VhostUserBlkSource_To_api_Disk("spdk-disk", source, disk, c)).To(Succeed())
+		Expect(disk.Source.Path).To(Equal("/var/tmp/vhost-blk/vhost.3"))
+	})
+
+	It("should fail without an allocated socket", func() {
+		disk := &api.Disk{Device: "disk", Driver: &api.DiskDriver{}, Alias: api.NewUserDefinedAlias("spdk-disk")}
+		source := &v1.VhostUserBlkVolumeSource{ResourceName: "spdk.io/vhost-blk"}
+		Expect(Convert_v1_VhostUserBlkSource_To_api_Disk("spdk-disk", source, disk, &ConverterContext{})).ToNot(Succeed())
+	})
+
+	It("should fail for a CD-ROM", func() {
+		disk := &api.Disk{Device: "cdrom", Driver: &api.DiskDriver{}, Alias: api.NewUserDefinedAlias("spdk-disk")}
+		source := &v1.VhostUserBlkVolumeSource{SocketName: "vhost.0"}
+		Expect(Convert_v1_VhostUserBlkSource_To_api_Disk("spdk-disk", source, disk, &ConverterContext{})).ToNot(Succeed())
+	})
+})
+
 var _ = Describe("disk device naming", func() {
 	It("format device name should return correct value", func() {
 		res := FormatDeviceName("sd", 0)
@@ -3727,6 +4074,13 @@ var _ = Describe("disk device naming", func() {
 		res, index = makeDeviceName("something", "scsi", prefixMap)
 		Expect(res).To(Equal("sda"))
 		Expect(index).To(Equal(0))
+		By("Verifying NVMe disks are named as namespaces")
+		res, index = makeDeviceName("something", v1.DiskBusNVMe, prefixMap)
+		Expect(res).To(Equal("nvme0n1"))
+		Expect(index).To(Equal(0))
+		res, index = makeDeviceName("something_else", v1.DiskBusNVMe, prefixMap)
+		Expect(res).To(Equal("nvme0n2"))
+		Expect(index).To(Equal(1))
 	})
 })
 
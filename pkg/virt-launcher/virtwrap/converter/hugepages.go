@@ -0,0 +1,80 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package converter
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
+)
+
+// hasRegularMemory returns true if a part of the guest memory is backed by regular pages instead of hugepages
+func hasRegularMemory(vmi *v1.VirtualMachineInstance) bool {
+	memory := vmi.Spec.Domain.Memory
+	return memory != nil && memory.Hugepages != nil &&
+		memory.Hugepages.RegularMemory != nil && !memory.Hugepages.RegularMemory.IsZero()
+}
+
+// setupMixedPageSizeMemory splits the guest memory into two NUMA cells when only a part of it is backed by hugepages,
+// since libvirt selects the backing of the memory per cell. The first cell holds the vCPUs and the memory backed by
+// hugepages, which is also where memory is hotplugged to. The second cell only holds the memory backed by regular pages.
+func setupMixedPageSizeMemory(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
+	hugepages := vmi.Spec.Domain.Memory.Hugepages
+	pageSize, err := resource.ParseQuantity(hugepages.PageSize)
+	if err != nil {
+		return fmt.Errorf("could not parse hugepage size %s: %v", hugepages.PageSize, err)
+	}
+
+	hugepagesMemory := vcpu.GetVirtualMemory(vmi).DeepCopy()
+	hugepagesMemory.Sub(*hugepages.RegularMemory)
+	if hugepagesMemory.Sign() <= 0 {
+		return fmt.Errorf("the regular memory %s must be less than the guest memory %s", hugepages.RegularMemory.String(), vcpu.GetVirtualMemory(vmi).String())
+	}
+
+	domain.Spec.CPU.NUMA = &api.NUMA{
+		Cells: []api.NUMACell{
+			{
+				ID:     "0",
+				CPUs:   fmt.Sprintf("0-%d", domain.Spec.VCPU.CPUs-1),
+				Memory: uint64(hugepagesMemory.Value() / int64(1024)),
+				Unit:   "KiB",
+			},
+			{
+				ID:     "1",
+				Memory: uint64(hugepages.RegularMemory.Value() / int64(1024)),
+				Unit:   "KiB",
+			},
+		},
+	}
+	domain.Spec.MemoryBacking.HugePages.HugePage = []api.HugePage{
+		{
+			Size:    strconv.FormatInt(pageSize.Value()/int64(1024), 10),
+			Unit:    "KiB",
+			NodeSet: "0",
+		},
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package converter
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// Convert_v1_DiskIOLimits_To_api_BlockIOTune converts the IO limits of a disk to the libvirt iotune element.
+// Unset limits are left at 0, which libvirt treats as unlimited.
+func Convert_v1_DiskIOLimits_To_api_BlockIOTune(limits *v1.DiskIOLimits) *api.BlockIOTune {
+	if limits == nil {
+		return nil
+	}
+
+	iotune := &api.BlockIOTune{
+		ReadIopsSec:   int64ToUint64(limits.ReadIOPS),
+		WriteIopsSec:  int64ToUint64(limits.WriteIOPS),
+		ReadBytesSec:  quantityToUint64(limits.ReadBandwidth),
+		WriteBytesSec: quantityToUint64(limits.WriteBandwidth),
+	}
+
+	if burst := limits.Burst; burst != nil {
+		iotune.ReadIopsSecMax = int64ToUint64(burst.ReadIOPS)
+		iotune.WriteIopsSecMax = int64ToUint64(burst.WriteIOPS)
+		iotune.ReadBytesSecMax = quantityToUint64(burst.ReadBandwidth)
+		iotune.WriteBytesSecMax = quantityToUint64(burst.WriteBandwidth)
+
+		// libvirt only accepts a burst length along with the burst limit
+		if length := int64ToUint64(burst.LengthSeconds); length > 0 {
+			if iotune.ReadIopsSecMax > 0 {
+				iotune.ReadIopsSecMaxLength = length
+			}
+			if iotune.WriteIopsSecMax > 0 {
+				iotune.WriteIopsSecMaxLength = length
+			}
+			if iotune.ReadBytesSecMax > 0 {
+				iotune.ReadBytesSecMaxLength = length
+			}
+			if iotune.WriteBytesSecMax > 0 {
+				iotune.WriteBytesSecMaxLength = length
+			}
+		}
+	}
+
+	return iotune
+}
+
+func int64ToUint64(value *int64) uint64 {
+	if value == nil || *value < 0 {
+		return 0
+	}
+	return uint64(*value)
+}
+
+func quantityToUint64(value *resource.Quantity) uint64 {
+	if value == nil || value.Sign() < 0 {
+		return 0
+	}
+	return uint64(value.Value())
+}
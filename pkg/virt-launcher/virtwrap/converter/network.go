@@ -64,6 +64,14 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, c *ConverterContext)
 			domainIface.Driver = &api.InterfaceDriver{Name: "vhost", Queues: &queueCount}
 		}
 
+		if ifaceType == v1.VirtIO && (iface.RxQueueSize != nil || iface.TxQueueSize != nil) {
+			if domainIface.Driver == nil {
+				domainIface.Driver = &api.InterfaceDriver{Name: "vhost"}
+			}
+			domainIface.Driver.RxQueueSize = toUintPointer(iface.RxQueueSize)
+			domainIface.Driver.TxQueueSize = toUintPointer(iface.TxQueueSize)
+		}
+
 		// Add a pciAddress if specified
 		if iface.PciAddress != "" {
 			addr, err := device.NewPciAddressField(iface.PciAddress)
@@ -148,6 +156,14 @@ func NetworkQueuesCapacity(vmi *v1.VirtualMachineInstance) uint32 {
 	return queueNumber
 }
 
+func toUintPointer(value *uint32) *uint {
+	if value == nil {
+		return nil
+	}
+	converted := uint(*value)
+	return &converted
+}
+
 func isTrue(networkInterfaceMultiQueue *bool) bool {
 	return (networkInterfaceMultiQueue != nil) && (*networkInterfaceMultiQueue)
 }
@@ -188,3 +188,97 @@ var _ = Describe("NumaPlacement", func() {
 		})
 	})
 })
+
+var _ = Describe("Guest NUMA cells", func() {
+
+	var givenSpec *api.DomainSpec
+	var givenVMI *v1.VirtualMachineInstance
+	var givenTopology *cmdv1.Topology
+
+	BeforeEach(func() {
+		givenSpec = &api.DomainSpec{
+			CPUTune: &api.CPUTune{
+				VCPUPin: []api.CPUTuneVCPUPin{
+					{VCPU: 0, CPUSet: "10"},
+					{VCPU: 1, CPUSet: "20"},
+					{VCPU: 2, CPUSet: "30"},
+					{VCPU: 3, CPUSet: "50"},
+				},
+			},
+			MemoryBacking: &api.MemoryBacking{
+				HugePages: &api.HugePages{},
+			},
+		}
+		givenTopology = &cmdv1.Topology{
+			NumaCells: []*cmdv1.Cell{
+				{Id: 0, Cpus: []*cmdv1.CPU{{Id: 10}, {Id: 20}}},
+				{Id: 4, Cpus: []*cmdv1.CPU{{Id: 30}}},
+				{Id: 5, Cpus: []*cmdv1.CPU{{Id: 50}}},
+			},
+		}
+		givenVMI = &v1.VirtualMachineInstance{}
+		givenVMI.Spec.Domain.Memory = &v1.Memory{Hugepages: &v1.Hugepages{PageSize: "2Mi"}}
+		givenVMI.Spec.Domain.CPU = &v1.CPU{NUMA: &v1.NUMA{GuestCells: []v1.NUMAGuestCell{
+			{
+				ID:        0,
+				CPUs:      "0-1",
+				Memory:    resource.MustParse("32Mi"),
+				Distances: []v1.NUMAGuestCellDistance{{CellID: 0, Value: 10}, {CellID: 1, Value: 21}},
+			},
+			{
+				ID:     1,
+				CPUs:   "2-3",
+				Memory: resource.MustParse("64Mi"),
+			},
+		}}}
+	})
+
+	It("should convert the cells to the domain NUMA topology", func() {
+		numa, err := GuestNUMATopology(givenVMI)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(numa).To(Equal(&api.NUMA{Cells: []api.NUMACell{
+			{
+				ID:     "0",
+				CPUs:   "0-1",
+				Memory: 32 * 1024 * 1024,
+				Unit:   "b",
+				Distances: &api.NUMACellDistances{Siblings: []api.NUMACellSibling{
+					{ID: 0, Value: 10},
+					{ID: 1, Value: 21},
+				}},
+			},
+			{ID: "1", CPUs: "2-3", Memory: 64 * 1024 * 1024, Unit: "b"},
+		}}))
+	})
+
+	It("should bind the memory of the cells to the host numa nodes of their pinned vCPUs", func() {
+		Expect(guestNUMACellsMapping(givenVMI, givenSpec, givenTopology)).To(Succeed())
+		Expect(givenSpec.NUMATune).To(Equal(&api.NUMATune{
+			Memory: api.NumaTuneMemory{Mode: "strict", NodeSet: "0,4,5"},
+			MemNodes: []api.MemNode{
+				{CellID: 0, Mode: "strict", NodeSet: "0"},
+				{CellID: 1, Mode: "strict", NodeSet: "4,5"},
+			},
+		}))
+	})
+
+	It("should not bind the memory without hugepages", func() {
+		givenVMI.Spec.Domain.Memory.Hugepages = nil
+		givenSpec.MemoryBacking = nil
+		Expect(guestNUMACellsMapping(givenVMI, givenSpec, givenTopology)).To(Succeed())
+		Expect(givenSpec.NUMATune).To(BeNil())
+	})
+
+	DescribeTable("should do nothing", func(givenTopology *cmdv1.Topology) {
+		Expect(guestNUMACellsMapping(givenVMI, givenSpec, givenTopology)).To(Succeed())
+		Expect(givenSpec.NUMATune).To(BeNil())
+	},
+		Entry("if no topology is provided", nil),
+		Entry("if no numa cells are reported", &cmdv1.Topology{NumaCells: nil}),
+	)
+
+	It("should detect invalid cpu pinning", func() {
+		givenSpec.CPUTune.VCPUPin = append(givenSpec.CPUTune.VCPUPin, api.CPUTuneVCPUPin{VCPU: 4, CPUSet: "40"})
+		Expect(guestNUMACellsMapping(givenVMI, givenSpec, givenTopology)).ToNot(Succeed())
+	})
+})
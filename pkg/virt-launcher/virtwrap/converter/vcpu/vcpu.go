@@ -2,6 +2,7 @@ package vcpu
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -18,6 +19,9 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
+// maxVCPUs is the upper bound of vCPUs of a domain
+const maxVCPUs = 512
+
 type VCPUPool interface {
 	FitCores() (tune *api.CPUTune, err error)
 	FitThread() (thread uint32, err error)
@@ -359,6 +363,38 @@ func isNumaPassthrough(vmi *v12.VirtualMachineInstance) bool {
 	return vmi.Spec.Domain.CPU.NUMA != nil && vmi.Spec.Domain.CPU.NUMA.GuestMappingPassthrough != nil
 }
 
+func HasGuestNUMACells(vmi *v12.VirtualMachineInstance) bool {
+	return vmi.Spec.Domain.CPU != nil && vmi.Spec.Domain.CPU.NUMA != nil && len(vmi.Spec.Domain.CPU.NUMA.GuestCells) > 0
+}
+
+// GuestNUMATopology converts the guest NUMA cells explicitly defined in the VMI spec to the NUMA topology of the domain.
+func GuestNUMATopology(vmi *v12.VirtualMachineInstance) (*api.NUMA, error) {
+	numa := &api.NUMA{}
+	for _, cell := range vmi.Spec.Domain.CPU.NUMA.GuestCells {
+		memory, err := QuantityToByte(cell.Memory)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert the memory of guest NUMA cell %d: %v", cell.ID, err)
+		}
+		domainCell := api.NUMACell{
+			ID:     strconv.Itoa(int(cell.ID)),
+			CPUs:   cell.CPUs,
+			Memory: memory.Value,
+			Unit:   memory.Unit,
+		}
+		if len(cell.Distances) > 0 {
+			domainCell.Distances = &api.NUMACellDistances{}
+			for _, distance := range cell.Distances {
+				domainCell.Distances.Siblings = append(domainCell.Distances.Siblings, api.NUMACellSibling{
+					ID:    distance.CellID,
+					Value: distance.Value,
+				})
+			}
+		}
+		numa.Cells = append(numa.Cells, domainCell)
+	}
+	return numa, nil
+}
+
 func appendDomainEmulatorThreadPin(domain *api.Domain, cpuSet string) {
 	emulatorThreads := api.CPUEmulatorPin{
 		CPUSet: cpuSet,
@@ -524,6 +560,11 @@ func AdjustDomainForTopologyAndCPUSet(domain *api.Domain, vmi *v12.VirtualMachin
 			log.Log.Reason(err).Error("failed to calculate passed through NUMA topology.")
 			return err
 		}
+	} else if HasGuestNUMACells(vmi) {
+		if err := guestNUMACellsMapping(vmi, &domain.Spec, topology); err != nil {
+			log.Log.Reason(err).Error("failed to map the guest NUMA cells to the host NUMA nodes.")
+			return err
+		}
 	}
 
 	return nil
@@ -675,6 +716,79 @@ func numaMapping(vmi *v12.VirtualMachineInstance, domain *api.DomainSpec, topolo
 	return nil
 }
 
+// guestNUMACellsMapping binds the memory of each guest NUMA cell to the host numa nodes of the pCPUs its vCPUs are
+// pinned to. Like for the passed through topology, the memory is only bound when it is backed by hugepages.
+func guestNUMACellsMapping(vmi *v12.VirtualMachineInstance, domain *api.DomainSpec, topology *v1.Topology) error {
+	if topology == nil || len(topology.NumaCells) == 0 {
+		return nil
+	}
+	_, _, hugepagesEnabled, err := hugePagesInfo(vmi, domain)
+	if err != nil {
+		return fmt.Errorf("failed to determine if hugepages are enabled: %v", err)
+	} else if !hugepagesEnabled {
+		return nil
+	}
+
+	cpumap := cpuToCell(topology)
+	vcpuToHostCell := map[uint32]uint32{}
+	for _, tune := range domain.CPUTune.VCPUPin {
+		cpu, err := strconv.ParseInt(tune.CPUSet, 10, 32)
+		if err != nil {
+			return fmt.Errorf("expected only full cpu to be mapped, but got %v: %v", tune.CPUSet, err)
+		}
+		hostCell, exists := cpumap[uint32(cpu)]
+		if !exists {
+			return fmt.Errorf("vcpu %v is mapped to a not existing host cpu set %v", tune.VCPU, tune.CPUSet)
+		}
+		vcpuToHostCell[tune.VCPU] = hostCell.Id
+	}
+
+	domain.NUMATune = &api.NUMATune{
+		Memory: api.NumaTuneMemory{
+			Mode: "strict",
+		},
+	}
+	var involvedHostCellIDs []uint32
+	for _, cell := range vmi.Spec.Domain.CPU.NUMA.GuestCells {
+		vcpus, err := hardware.ParseCPUSetLine(cell.CPUs, maxVCPUs)
+		if err != nil {
+			return fmt.Errorf("failed to parse the vCPUs of guest NUMA cell %d: %v", cell.ID, err)
+		}
+		var hostCellIDs []uint32
+		for _, vcpu := range vcpus {
+			if hostCellID, exists := vcpuToHostCell[uint32(vcpu)]; exists && !slices.Contains(hostCellIDs, hostCellID) {
+				hostCellIDs = append(hostCellIDs, hostCellID)
+			}
+		}
+		if len(hostCellIDs) == 0 {
+			continue
+		}
+		slices.Sort(hostCellIDs)
+		domain.NUMATune.MemNodes = append(domain.NUMATune.MemNodes, api.MemNode{
+			CellID:  cell.ID,
+			Mode:    "strict",
+			NodeSet: formatNodeSet(hostCellIDs),
+		})
+		for _, hostCellID := range hostCellIDs {
+			if !slices.Contains(involvedHostCellIDs, hostCellID) {
+				involvedHostCellIDs = append(involvedHostCellIDs, hostCellID)
+			}
+		}
+	}
+	slices.Sort(involvedHostCellIDs)
+	domain.NUMATune.Memory.NodeSet = formatNodeSet(involvedHostCellIDs)
+
+	return nil
+}
+
+func formatNodeSet(cellIDs []uint32) string {
+	var nodes []string
+	for _, id := range cellIDs {
+		nodes = append(nodes, strconv.Itoa(int(id)))
+	}
+	return strings.Join(nodes, ",")
+}
+
 func hugePagesInfo(vmi *v12.VirtualMachineInstance, domain *api.DomainSpec) (size uint64, unit string, enabled bool, err error) {
 	if domain.MemoryBacking != nil && domain.MemoryBacking.HugePages != nil {
 		if vmi.Spec.Domain.Memory.Hugepages != nil {
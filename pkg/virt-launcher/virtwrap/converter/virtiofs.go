@@ -7,13 +7,22 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
-func convertFileSystems(fileSystems []v1.Filesystem) []api.FilesystemDevice {
+func convertFileSystems(fileSystems []v1.Filesystem, hotplugVolumes map[string]v1.VolumeStatus) []api.FilesystemDevice {
 	domainFileSystems := []api.FilesystemDevice{}
 	for _, fs := range fileSystems {
 		if fs.Virtiofs == nil {
 			continue
 		}
 
+		socketPath := virtiofs.VirtioFSSocketPath(fs.Name)
+		if hpStatus, isHotplug := hotplugVolumes[fs.Name]; isHotplug {
+			// Hotplugged filesystems are served by virt-launcher once the volume is mounted
+			if hpStatus.Phase != v1.HotplugVolumeMounted && hpStatus.Phase != v1.VolumeReady {
+				continue
+			}
+			socketPath = virtiofs.HotplugVirtioFSSocketPath(fs.Name)
+		}
+
 		domainFileSystems = append(domainFileSystems,
 			api.FilesystemDevice{
 				Type:       "mount",
@@ -23,7 +32,7 @@ func convertFileSystems(fileSystems []v1.Filesystem) []api.FilesystemDevice {
 					Queue: "1024",
 				},
 				Source: &api.FilesystemSource{
-					Socket: virtiofs.VirtioFSSocketPath(fs.Name),
+					Socket: socketPath,
 				},
 				Target: &api.FilesystemTarget{
 					Dir: fs.Name,
@@ -0,0 +1,62 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
+)
+
+// crashDumpFor returns the crash dump configuration of the VMI if the memory is dumped to its crash dump volume
+func crashDumpFor(vmi *v1.VirtualMachineInstance, dumpPath string) *v1.CrashDump {
+	crashDump := vmi.Spec.CrashDump
+	if crashDump == nil || !strings.HasPrefix(filepath.Base(dumpPath), fmt.Sprintf("%s-%s-", vmi.Name, crashDump.VolumeName)) {
+		return nil
+	}
+	return crashDump
+}
+
+// memoryDumpsToKeep returns the number of previous memory dumps kept in the volume the memory is dumped to.
+// Crash dumps keep up to MaxDumps dumps, including the new one, other memory dumps replace the previous ones.
+func memoryDumpsToKeep(vmi *v1.VirtualMachineInstance, dumpPath string) int {
+	crashDump := crashDumpFor(vmi, dumpPath)
+	if crashDump == nil || crashDump.MaxDumps == nil || *crashDump.MaxDumps < 1 {
+		return 0
+	}
+	return int(*crashDump.MaxDumps) - 1
+}
+
+// checkCrashDumpSize fails if the memory of the guest is larger than the max size of its crash dumps
+func checkCrashDumpSize(vmi *v1.VirtualMachineInstance, dumpPath string) error {
+	crashDump := crashDumpFor(vmi, dumpPath)
+	if crashDump == nil || crashDump.MaxSize == nil {
+		return nil
+	}
+	memory := vcpu.GetVirtualMemory(vmi)
+	if memory.Cmp(*crashDump.MaxSize) > 0 {
+		return fmt.Errorf("guest memory %s exceeds the crash dump max size %s", memory.String(), crashDump.MaxSize.String())
+	}
+	return nil
+}
@@ -40,6 +40,19 @@ func CreateHostDevices(vmiHostDevices []v1.HostDevice) ([]api.HostDevice, error)
 		NewPCIAddressPool(vmiHostDevices), NewMDEVAddressPool(vmiHostDevices), NewUSBAddressPool(vmiHostDevices))
 }
 
+// GetHostDevicesToAttach returns the generic host-devices of the VMI which are not attached to the domain,
+// e.g. after they were unplugged to migrate the VMI.
+func GetHostDevicesToAttach(vmiHostDevices []v1.HostDevice, domainSpec *api.DomainSpec) ([]api.HostDevice, error) {
+	hostDevices, err := CreateHostDevices(vmiHostDevices)
+	if err != nil {
+		return nil, err
+	}
+	attachedHostDevices := hostdevice.FilterHostDevicesByAlias(domainSpec.Devices.HostDevices, AliasPrefix)
+	attachedHostDevices = append(attachedHostDevices, hostdevice.FilterHostDevicesByAlias(domainSpec.Devices.HostDevices, hostdevice.USBAliasPrefix)...)
+
+	return hostdevice.DifferenceHostDevicesByAlias(hostDevices, attachedHostDevices), nil
+}
+
 func CreateHostDevicesFromPools(vmiHostDevices []v1.HostDevice, pciAddressPool, mdevAddressPool, usbAddressPool hostdevice.AddressPooler) ([]api.HostDevice, error) {
 	pciPool := hostdevice.NewBestEffortAddressPool(pciAddressPool)
 	mdevPool := hostdevice.NewBestEffortAddressPool(mdevAddressPool)
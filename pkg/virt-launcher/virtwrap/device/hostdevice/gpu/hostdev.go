@@ -39,6 +39,18 @@ func CreateHostDevices(vmiGPUs []v1.GPU) ([]api.HostDevice, error) {
 	return CreateHostDevicesFromPools(vmiGPUs, NewPCIAddressPool(vmiGPUs), NewMDEVAddressPool(vmiGPUs))
 }
 
+// GetHostDevicesToAttach returns the GPU host-devices of the VMI which are not attached to the domain,
+// e.g. after they were unplugged to migrate the VMI.
+func GetHostDevicesToAttach(vmiGPUs []v1.GPU, domainSpec *api.DomainSpec) ([]api.HostDevice, error) {
+	hostDevices, err := CreateHostDevices(vmiGPUs)
+	if err != nil {
+		return nil, err
+	}
+	attachedHostDevices := hostdevice.FilterHostDevicesByAlias(domainSpec.Devices.HostDevices, AliasPrefix)
+
+	return hostdevice.DifferenceHostDevicesByAlias(hostDevices, attachedHostDevices), nil
+}
+
 func CreateHostDevicesFromPools(vmiGPUs []v1.GPU, pciAddressPool, mdevAddressPool hostdevice.AddressPooler) ([]api.HostDevice, error) {
 	pciPool := hostdevice.NewBestEffortAddressPool(pciAddressPool)
 	mdevPool := hostdevice.NewBestEffortAddressPool(mdevAddressPool)
@@ -186,6 +186,28 @@ var _ = Describe("GPU HostDevice", func() {
 		Expect(gpu.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.GPUs, pciPool, mdevPool)).
 			To(Equal([]api.HostDevice{expectHostDevice1}))
 	})
+
+	It("returns the GPUs which are not attached to the domain", func() {
+		vmi.Spec.Domain.Devices.GPUs = []v1.GPU{
+			{DeviceName: gpuResource0, Name: gpuName0},
+			{DeviceName: gpuResource1, Name: gpuName1},
+		}
+		env := []envData{
+			newResourceEnv(v1.PCIResourcePrefix, envGPUResource0, gpuPCIAddress0),
+			newResourceEnv(v1.PCIResourcePrefix, envGPUResource1, gpuPCIAddress1),
+		}
+		domainSpec := &api.DomainSpec{}
+		domainSpec.Devices.HostDevices = []api.HostDevice{
+			{Alias: api.NewUserDefinedAlias(gpu.AliasPrefix + gpuName0)},
+		}
+
+		withEnvironmentContext(env, func() {
+			hostDevices, err := gpu.GetHostDevicesToAttach(vmi.Spec.Domain.Devices.GPUs, domainSpec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hostDevices).To(HaveLen(1))
+			Expect(hostDevices[0].Alias.GetName()).To(Equal(gpu.AliasPrefix + gpuName1))
+		})
+	})
 })
 
 type stubAddressPool struct {
@@ -30,7 +30,10 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
 )
 
-const failedCreateHostDeviceFmt = "failed to create hostdevice for %s: %v"
+const (
+	failedCreateHostDeviceFmt = "failed to create hostdevice for %s: %v"
+	USBAliasPrefix            = "usb-host-"
+)
 
 type HostDeviceMetaData struct {
 	AliasPrefix       string
@@ -180,7 +183,7 @@ func createUSBHostDevice(device HostDeviceMetaData, usbAddress string) (*api.Hos
 	return &api.HostDevice{
 		Type:  api.HostDeviceUSB,
 		Mode:  "subsystem",
-		Alias: api.NewUserDefinedAlias("usb-host-" + device.Name),
+		Alias: api.NewUserDefinedAlias(USBAliasPrefix + device.Name),
 		Source: api.HostDeviceSource{
 			Address: &api.Address{
 				Bus:    bus,
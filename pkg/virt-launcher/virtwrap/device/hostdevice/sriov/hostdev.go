@@ -42,7 +42,7 @@ import (
 
 func CreateHostDevices(vmi *v1.VirtualMachineInstance) ([]api.HostDevice, error) {
 	SRIOVInterfaces := vmispec.FilterInterfacesSpec(vmi.Spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
-		if iface.SRIOV == nil {
+		if iface.SRIOV == nil || iface.State == v1.InterfaceStateAbsent {
 			return false
 		}
 		ifaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, iface.Name)
@@ -82,6 +82,21 @@ var _ = Describe("SRIOV HostDevice", func() {
 			Expect(sriov.CreateHostDevices(vmi)).To(BeEmpty())
 		})
 
+		It("creates no device given hot-unplugged SRIOV interface", func() {
+			iface := newSRIOVInterface("test")
+			iface.State = v1.InterfaceStateAbsent
+			vmi := &v1.VirtualMachineInstance{}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+			vmi.Status = v1.VirtualMachineInstanceStatus{
+				Interfaces: []v1.VirtualMachineInstanceNetworkInterface{{
+					Name:       "test",
+					InfoSource: vmispec.InfoSourceMultusStatus,
+				}},
+			}
+
+			Expect(sriov.CreateHostDevices(vmi)).To(BeEmpty())
+		})
+
 		It("fails to create device given no available host PCI", func() {
 			iface := newSRIOVInterface("test")
 			vmi := &v1.VirtualMachineInstance{}
@@ -248,6 +248,51 @@ func (mr *MockDomainManagerMockRecorder) GetUsers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockDomainManager)(nil).GetUsers))
 }
 
+// GuestFileRead mocks base method.
+func (m *MockDomainManager) GuestFileRead(arg0, arg1 string, arg2 int64) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileRead", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileRead indicates an expected call of GuestFileRead.
+func (mr *MockDomainManagerMockRecorder) GuestFileRead(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileRead", reflect.TypeOf((*MockDomainManager)(nil).GuestFileRead), arg0, arg1, arg2)
+}
+
+// GuestFileWrite mocks base method.
+func (m *MockDomainManager) GuestFileWrite(arg0, arg1 string, arg2 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileWrite", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestFileWrite indicates an expected call of GuestFileWrite.
+func (mr *MockDomainManagerMockRecorder) GuestFileWrite(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileWrite", reflect.TypeOf((*MockDomainManager)(nil).GuestFileWrite), arg0, arg1, arg2)
+}
+
+// GuestOSExec mocks base method.
+func (m *MockDomainManager) GuestOSExec(arg0, arg1 string, arg2 []string, arg3 int32) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestOSExec", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GuestOSExec indicates an expected call of GuestOSExec.
+func (mr *MockDomainManagerMockRecorder) GuestOSExec(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestOSExec", reflect.TypeOf((*MockDomainManager)(nil).GuestOSExec), arg0, arg1, arg2, arg3)
+}
+
 // GuestPing mocks base method.
 func (m *MockDomainManager) GuestPing(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -443,6 +488,20 @@ func (mr *MockDomainManagerMockRecorder) SoftRebootVMI(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftRebootVMI", reflect.TypeOf((*MockDomainManager)(nil).SoftRebootVMI), arg0)
 }
 
+// StreamMemoryDump mocks base method.
+func (m *MockDomainManager) StreamMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string, options *v1.MemoryDumpStreamOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamMemoryDump", vmi, dumpPath, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamMemoryDump indicates an expected call of StreamMemoryDump.
+func (mr *MockDomainManagerMockRecorder) StreamMemoryDump(vmi, dumpPath, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamMemoryDump", reflect.TypeOf((*MockDomainManager)(nil).StreamMemoryDump), vmi, dumpPath, options)
+}
+
 // SyncVMI mocks base method.
 func (m *MockDomainManager) SyncVMI(arg0 *v1.VirtualMachineInstance, arg1 bool, arg2 *v10.VirtualMachineOptions) (*api.DomainSpec, error) {
 	m.ctrl.T.Helper()
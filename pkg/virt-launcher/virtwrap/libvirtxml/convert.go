@@ -62,11 +62,26 @@ func ConvertKubeVirtNUMACellToDomainDomainCell(cell []api.NUMACell) ([]libvirtxm
 			Memory:    uint(c.Memory),
 			Unit:      c.Unit,
 			MemAccess: c.MemoryAccess,
+			Distances: convertKubeVirtNUMACellDistancesToDomainCellDistances(c.Distances),
 		})
 	}
 	return ret, nil
 }
 
+func convertKubeVirtNUMACellDistancesToDomainCellDistances(distances *api.NUMACellDistances) *libvirtxml.DomainCellDistances {
+	if distances == nil {
+		return nil
+	}
+	ret := &libvirtxml.DomainCellDistances{}
+	for _, sibling := range distances.Siblings {
+		ret.Siblings = append(ret.Siblings, libvirtxml.DomainCellSibling{
+			ID:    uint(sibling.ID),
+			Value: uint(sibling.Value),
+		})
+	}
+	return ret
+}
+
 func ConvertKubeVirtNUMAToDomainNUMA(numa *api.NUMA) (*libvirtxml.DomainNuma, error) {
 	if numa == nil {
 		return nil, nil
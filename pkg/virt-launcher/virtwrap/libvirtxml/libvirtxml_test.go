@@ -205,6 +205,11 @@ var _ = Describe("Convert KubeVirt domain types to Libvirtxml", func() {
 			Entry("empty", []api.NUMACell{}, []libvirtxml.DomainCell{}, ""),
 			Entry("error parsing the ID", []api.NUMACell{{ID: "wrongid"}}, nil, "invalid syntax"),
 			Entry("set all the field", []api.NUMACell{cell}, []libvirtxml.DomainCell{dcell}, ""),
+			Entry("with distances", []api.NUMACell{{ID: "123", CPUs: "1", Distances: &api.NUMACellDistances{
+				Siblings: []api.NUMACellSibling{{ID: 123, Value: 10}, {ID: 124, Value: 20}},
+			}}}, []libvirtxml.DomainCell{{ID: &id, CPUs: "1", Distances: &libvirtxml.DomainCellDistances{
+				Siblings: []libvirtxml.DomainCellSibling{{ID: 123, Value: 10}, {ID: 124, Value: 20}},
+			}}}, ""),
 		)
 
 		DescribeTable("ConvertKubeVirtNUMAToDomainNUMA", func(v *api.NUMA, expected *libvirtxml.DomainNuma) {
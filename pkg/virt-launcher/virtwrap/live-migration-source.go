@@ -38,6 +38,7 @@ import (
 
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
+	"kubevirt.io/kubevirt/pkg/network/deviceinfo"
 	osdisk "kubevirt.io/kubevirt/pkg/os/disk"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	virtutil "kubevirt.io/kubevirt/pkg/util"
@@ -51,7 +52,8 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice"
-	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/sriov"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/generic"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/gpu"
 	domainerrors "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/errors"
 	convxml "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/libvirtxml"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
@@ -65,6 +67,10 @@ const (
 	monitorSleepPeriodMS = 400
 	monitorLogPeriodMS   = 4000
 	monitorLogInterval   = monitorLogPeriodMS / monitorSleepPeriodMS
+
+	// defaultMigrationMaxDowntimeMS is the downtime QEMU allows by default for the final
+	// iteration of a precopy migration, restored when the cutover of a warm migration starts
+	defaultMigrationMaxDowntimeMS = 300
 )
 
 type migrationDisks struct {
@@ -88,6 +94,10 @@ type migrationMonitor struct {
 	progressTimeout          int64
 	acceptableCompletionTime int64
 	migrationFailedWithError error
+
+	// warmHeld is set while a warm migration is kept from converging before its cutover
+	warmHeld        bool
+	warmMaxDowntime uint64
 }
 
 type inflightMigrationAborted struct {
@@ -116,12 +126,15 @@ func generateMigrationFlags(isBlockMigration, migratePaused bool, options *cmdcl
 	if shouldConfigureParallel, _ := shouldConfigureParallelMigration(options); shouldConfigureParallel {
 		migrateFlags |= libvirt.MIGRATE_PARALLEL
 	}
+	if options.Compression != "" {
+		migrateFlags |= libvirt.MIGRATE_COMPRESSED
+	}
 
 	return migrateFlags
 
 }
 
-func hotUnplugHostDevices(virConn cli.Connection, dom cli.VirDomain) error {
+func hotUnplugHostDevices(virConn cli.Connection, dom cli.VirDomain, replugHostDevices bool) error {
 	domainSpec, err := util.GetDomainSpecWithFlags(dom, 0)
 	if err != nil {
 		return err
@@ -134,13 +147,25 @@ func hotUnplugHostDevices(virConn cli.Connection, dom cli.VirDomain) error {
 
 	if domainEvent := cli.NewDomainEventDeviceRemoved(virConn, dom, callback, eventChan); domainEvent != nil {
 		const waitForDetachTimeout = 30 * time.Second
-		err := sriov.SafelyDetachHostDevices(domainSpec, domainEvent, dom, waitForDetachTimeout)
+		err := hostdevice.SafelyDetachHostDevices(hostDevicesToUnplug(domainSpec, replugHostDevices), domainEvent, dom, waitForDetachTimeout)
 		if err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// hostDevicesToUnplug returns the host devices which have to be unplugged before the migration.
+// SR-IOV devices are always unplugged, GPUs and generic host devices only when they are replugged on the target.
+func hostDevicesToUnplug(domainSpec *api.DomainSpec, replugHostDevices bool) []api.HostDevice {
+	hostDevices := hostdevice.FilterHostDevicesByAlias(domainSpec.Devices.HostDevices, deviceinfo.SRIOVAliasPrefix)
+	if replugHostDevices {
+		hostDevices = append(hostDevices, hostdevice.FilterHostDevicesByAlias(domainSpec.Devices.HostDevices, gpu.AliasPrefix)...)
+		hostDevices = append(hostDevices, hostdevice.FilterHostDevicesByAlias(domainSpec.Devices.HostDevices, generic.AliasPrefix)...)
+		hostDevices = append(hostDevices, hostdevice.FilterHostDevicesByAlias(domainSpec.Devices.HostDevices, hostdevice.USBAliasPrefix)...)
+	}
+	return hostDevices
+}
 func generateDomainForTargetCPUSetAndTopology(vmi *v1.VirtualMachineInstance, domSpec *api.DomainSpec) (*api.Domain, error) {
 	var targetTopology cmdv1.Topology
 	targetNodeCPUSet := vmi.Status.MigrationState.TargetCPUSet
@@ -342,6 +367,9 @@ func (l *LibvirtDomainManager) startMigration(vmi *v1.VirtualMachineInstance, op
 		return err
 	}
 	if inProgress {
+		if options.Warm && options.Cutover {
+			l.requestMigrationCutover()
+		}
 		return nil
 	}
 
@@ -378,6 +406,17 @@ func (l *LibvirtDomainManager) initializeMigrationMetadata(vmi *v1.VirtualMachin
 	return false, nil
 }
 
+// requestMigrationCutover records the cutover request of a warm migration in the metadata,
+// which is picked up by the migration monitor
+func (l *LibvirtDomainManager) requestMigrationCutover() {
+	l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, _ bool) {
+		if migrationMetadata.CutoverTimestamp == nil {
+			migrationMetadata.CutoverTimestamp = pointer.P(metav1.Now())
+		}
+	})
+	log.Log.V(4).Infof("Migration cutover requested in metadata: %s", l.metadataCache.Migration.String())
+}
+
 func (l *LibvirtDomainManager) cancelMigration(vmi *v1.VirtualMachineInstance) error {
 	migration, _ := l.metadataCache.Migration.Load()
 	if migration.EndTimestamp != nil || migration.Failed || migration.StartTimestamp == nil {
@@ -476,6 +515,54 @@ func (m *migrationMonitor) isPausedMigration() bool {
 	return migration.Mode == v1.MigrationPaused
 }
 
+func (m *migrationMonitor) isCutoverRequested() bool {
+	migration, _ := m.l.metadataCache.Migration.Load()
+	return migration.CutoverTimestamp != nil
+}
+
+// holdWarmMigration keeps a warm migration in its synchronization phase until the cutover is
+// requested. With a maximum downtime of zero the precopy never converges, while the disks and
+// the memory keep being copied to the target. Returns true as long as the migration is held.
+func (m *migrationMonitor) holdWarmMigration(dom cli.VirDomain) bool {
+	if !m.options.Warm {
+		return false
+	}
+	logger := log.Log.Object(m.vmi)
+
+	if !m.isCutoverRequested() {
+		if m.warmHeld {
+			return true
+		}
+		downtime, err := dom.MigrateGetMaxDowntime(0)
+		if err != nil {
+			logger.Reason(err).Warning("failed to get the maximum migration downtime, using the default")
+			downtime = defaultMigrationMaxDowntimeMS
+		}
+		if err := dom.MigrateSetMaxDowntime(0, 0); err != nil {
+			logger.Reason(err).Error("failed to hold the warm migration")
+			return false
+		}
+		logger.Info("Holding the warm migration until its cutover is requested")
+		m.warmMaxDowntime = downtime
+		m.warmHeld = true
+		return true
+	}
+
+	if m.warmHeld {
+		if err := dom.MigrateSetMaxDowntime(m.warmMaxDowntime, 0); err != nil {
+			logger.Reason(err).Error("failed to start the cutover of the warm migration, will retry")
+			return true
+		}
+		logger.Info("Starting the cutover of the warm migration")
+		m.warmHeld = false
+		// the timeouts only apply to the cutover
+		m.start = time.Now().UTC().UnixNano()
+		m.lastProgressUpdate = m.start
+		m.progressWatermark = 0
+	}
+	return false
+}
+
 func (m *migrationMonitor) shouldTriggerTimeout(elapsed int64) bool {
 	if m.acceptableCompletionTime == 0 {
 		return false
@@ -557,6 +644,10 @@ func (m *migrationMonitor) processInflightMigration(dom cli.VirDomain, stats *li
 	}
 	m.progressWatermark = m.remainingData
 
+	if m.holdWarmMigration(dom) {
+		return nil
+	}
+
 	switch {
 	case m.isMigrationPostCopy():
 		// Currently, there is nothing for us to track when in Post Copy mode.
@@ -712,6 +803,7 @@ func (m *migrationMonitor) startMonitor() {
 			logInterval++
 			if logInterval%monitorLogInterval == 0 {
 				logMigrationInfo(logger, string(migrationUID), stats)
+				m.l.updateVMIMigrationProgress(m.l.migrateInfoStats)
 			}
 		case libvirt.DOMAIN_JOB_NONE:
 			completedJobInfo = m.determineNonRunningMigrationStatus(dom)
@@ -847,6 +939,8 @@ func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance,
 		ParallelConnections:    parallelMigrationThreads,
 		DestName:               generateDomainName(vmi),
 		DestNameSet:            true,
+		Compression:            options.Compression,
+		CompressionSet:         options.Compression != "",
 	}
 
 	copyDisks := getDiskTargetsForMigration(dom, vmi)
@@ -1054,7 +1148,7 @@ func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, opt
 		l.domainModifyLock.Lock()
 		defer l.domainModifyLock.Unlock()
 
-		if err := prepareDomainForMigration(l.virConn, dom); err != nil {
+		if err := prepareDomainForMigration(l.virConn, dom, options); err != nil {
 			return fmt.Errorf("error encountered during preparing domain for migration: %v", err)
 		}
 		domSpec, err := l.getDomainSpec(dom)
@@ -1096,8 +1190,8 @@ func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, opt
 
 // prepareDomainForMigration perform necessary operation
 // on the source domain just before migration
-func prepareDomainForMigration(virtConn cli.Connection, domain cli.VirDomain) error {
-	return hotUnplugHostDevices(virtConn, domain)
+func prepareDomainForMigration(virtConn cli.Connection, domain cli.VirDomain, options *cmdclient.MigrationOptions) error {
+	return hotUnplugHostDevices(virtConn, domain, options.ReplugHostDevices)
 }
 
 func shouldImmediatelyFailMigration(vmi *v1.VirtualMachineInstance) bool {
@@ -1134,6 +1228,12 @@ func (l *LibvirtDomainManager) migrate(vmi *v1.VirtualMachineInstance, options *
 	if err != nil {
 		log.Log.Object(vmi).Reason(err).Error(liveMigrationFailed)
 		migrationErrorChan <- err
+		if options.ReplugHostDevices {
+			// the VMI stays on the source, give it back the devices unplugged for the migration
+			if err := l.replugHostDevices(vmi); err != nil {
+				log.Log.Object(vmi).Reason(err).Error("failed to replug host devices after the failed migration")
+			}
+		}
 		return
 	}
 
@@ -1147,6 +1247,39 @@ func (l *LibvirtDomainManager) updateVMIMigrationMode(mode v1.MigrationMode) {
 	log.Log.V(4).Infof("Migration mode set in metadata: %s", l.metadataCache.Migration.String())
 }
 
+// updateVMIMigrationProgress publishes the statistics of the running migration in the metadata,
+// which relays them to the migration state of the VMI
+func (l *LibvirtDomainManager) updateVMIMigrationProgress(info *stats.DomainJobInfo) {
+	progress := &api.MigrationProgressMetadata{
+		Timestamp: pointer.P(metav1.Now()),
+	}
+	if info.DataTotalSet {
+		progress.DataTotal = info.DataTotal
+	}
+	if info.DataProcessedSet {
+		progress.DataProcessed = info.DataProcessed
+	}
+	if info.DataRemainingSet {
+		progress.DataRemaining = info.DataRemaining
+	}
+	if info.MemDirtyRateSet {
+		progress.MemoryDirtyRate = info.MemDirtyRate
+	}
+	if info.MemoryBpsSet {
+		progress.MemoryBps = info.MemoryBps
+	}
+	if info.DowntimeSet {
+		progress.ExpectedDowntime = info.Downtime
+	}
+	if info.MemIterationSet {
+		progress.Iterations = info.MemIteration
+	}
+
+	l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, _ bool) {
+		migrationMetadata.Progress = progress
+	})
+}
+
 func shouldConfigureParallelMigration(options *cmdclient.MigrationOptions) (shouldConfigure bool, threadsCount int) {
 	if options == nil {
 		return
@@ -25,6 +25,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
 	"k8s.io/apimachinery/pkg/types"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -32,9 +33,15 @@ import (
 	"kubevirt.io/kubevirt/pkg/ephemeral-disk/fake"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/network/deviceinfo"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/generic"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/gpu"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/errors"
 )
 
@@ -142,6 +149,82 @@ var _ = Describe("Live migration source", func() {
 		)
 	})
 
+	Context("Warm migration", func() {
+		var mockDomain *cli.MockVirDomain
+		var monitor *migrationMonitor
+
+		BeforeEach(func() {
+			vmi := &v1.VirtualMachineInstance{
+				Status: v1.VirtualMachineInstanceStatus{
+					MigrationState: &v1.VirtualMachineInstanceMigrationState{
+						MigrationUID: types.UID(fmt.Sprintf("%v", GinkgoRandomSeed())),
+						Warm:         true,
+					},
+				},
+			}
+
+			ctrl := gomock.NewController(GinkgoT())
+			mockDomain = cli.NewMockVirDomain(ctrl)
+			libvirtDomainManager = &LibvirtDomainManager{
+				metadataCache: metadata.NewCache(),
+			}
+			libvirtDomainManager.initializeMigrationMetadata(vmi, v1.MigrationPreCopy)
+			monitor = &migrationMonitor{
+				l:       libvirtDomainManager,
+				vmi:     vmi,
+				options: &cmdclient.MigrationOptions{Warm: true},
+			}
+		})
+
+		It("should hold the migration until the cutover is requested", func() {
+			mockDomain.EXPECT().MigrateGetMaxDowntime(uint32(0)).Return(uint64(500), nil)
+			mockDomain.EXPECT().MigrateSetMaxDowntime(uint64(0), uint32(0)).Return(nil)
+			Expect(monitor.holdWarmMigration(mockDomain)).To(BeTrue())
+			Expect(monitor.holdWarmMigration(mockDomain)).To(BeTrue())
+
+			libvirtDomainManager.requestMigrationCutover()
+			mockDomain.EXPECT().MigrateSetMaxDowntime(uint64(500), uint32(0)).Return(nil)
+			Expect(monitor.holdWarmMigration(mockDomain)).To(BeFalse())
+			Expect(monitor.holdWarmMigration(mockDomain)).To(BeFalse())
+		})
+
+		It("should record the cutover request only once", func() {
+			libvirtDomainManager.requestMigrationCutover()
+			migrationMetadata, _ := libvirtDomainManager.metadataCache.Migration.Load()
+			Expect(migrationMetadata.CutoverTimestamp).ToNot(BeNil())
+			cutoverTimestamp := migrationMetadata.CutoverTimestamp.DeepCopy()
+
+			libvirtDomainManager.requestMigrationCutover()
+			migrationMetadata, _ = libvirtDomainManager.metadataCache.Migration.Load()
+			Expect(migrationMetadata.CutoverTimestamp).To(Equal(cutoverTimestamp))
+		})
+
+		It("should not hold a cold migration", func() {
+			monitor.options.Warm = false
+			Expect(monitor.holdWarmMigration(mockDomain)).To(BeFalse())
+		})
+	})
+
+	DescribeTable("hostDevicesToUnplug", func(replugHostDevices bool, expectedAliases ...string) {
+		domainSpec := &api.DomainSpec{}
+		domainSpec.Devices.HostDevices = []api.HostDevice{
+			{Alias: api.NewUserDefinedAlias(deviceinfo.SRIOVAliasPrefix + "net1")},
+			{Alias: api.NewUserDefinedAlias(gpu.AliasPrefix + "gpu1")},
+			{Alias: api.NewUserDefinedAlias(generic.AliasPrefix + "dev1")},
+			{Alias: api.NewUserDefinedAlias(hostdevice.USBAliasPrefix + "usb1")},
+		}
+
+		var aliases []string
+		for _, hostDevice := range hostDevicesToUnplug(domainSpec, replugHostDevices) {
+			aliases = append(aliases, hostDevice.Alias.GetName())
+		}
+		Expect(aliases).To(ConsistOf(expectedAliases))
+	},
+		Entry("should only unplug SR-IOV devices by default", false, deviceinfo.SRIOVAliasPrefix+"net1"),
+		Entry("should unplug GPUs, host devices and USB devices when they are replugged", true,
+			deviceinfo.SRIOVAliasPrefix+"net1", gpu.AliasPrefix+"gpu1", generic.AliasPrefix+"dev1", hostdevice.USBAliasPrefix+"usb1"),
+	)
+
 	Context("classifyVolumesForMigration", func() {
 		It("should classify shared volumes to migrated when they are part of the migrated volumes set", func() {
 			const vol = "vol"
@@ -49,7 +49,18 @@ func (l *LibvirtDomainManager) finalizeMigrationTarget(vmi *v1.VirtualMachineIns
 		}
 	}
 
+	if len(vmi.Spec.Domain.Devices.GPUs) > 0 || len(vmi.Spec.Domain.Devices.HostDevices) > 0 {
+		// the devices were unplugged on the source to migrate the VMI, plug their equivalent on the target
+		if err := l.replugHostDevices(vmi); err != nil {
+			log.Log.Object(vmi).Reason(err).Error("failed to replug host devices on the migration target")
+		}
+	}
+
 	l.setGuestTime(vmi)
+
+	if err := hooks.GetManager().PostMigrationTarget(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("executing custom postMigrationTarget hooks failed")
+	}
 	return nil
 }
 
@@ -36,9 +36,11 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -53,6 +55,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/log"
 
 	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
@@ -73,7 +76,9 @@ import (
 	osdisk "kubevirt.io/kubevirt/pkg/os/disk"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/storage/freezehooks"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/storage/vhostuserblk"
 	"kubevirt.io/kubevirt/pkg/tpm"
 	"kubevirt.io/kubevirt/pkg/unsafepath"
 	kutil "kubevirt.io/kubevirt/pkg/util"
@@ -151,8 +156,12 @@ type DomainManager interface {
 	InterfacesStatus() []api.InterfaceStatus
 	GetGuestOSInfo() *api.GuestOSInfo
 	Exec(string, string, []string, int32) (string, error)
+	GuestOSExec(string, string, []string, int32) (string, string, error)
+	GuestFileRead(string, string, int64) ([]byte, error)
+	GuestFileWrite(string, string, []byte) error
 	GuestPing(string) error
 	MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
+	StreamMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string, options *v1.MemoryDumpStreamOptions) error
 	GetQemuVersion() (string, error)
 	UpdateVCPUs(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 	GetSEVInfo() (*v1.SEVPlatformInfo, error)
@@ -192,11 +201,16 @@ type LibvirtDomainManager struct {
 
 	metadataCache             *metadata.Cache
 	domainStatsCache          *virtcache.TimeDefinedCache[*stats.DomainStats]
+	deviceMetricsDisabled     atomic.Bool
 	domainDirtyRateStatsCache *virtcache.TimeDefinedCache[*stats.DomainStatsDirtyRate]
 
 	cpuSetGetter                  func() ([]int, error)
 	imageVolumeFeatureGateEnabled bool
 	setTimeOnce                   sync.Once
+
+	// virtiofsd instances of hotplugged filesystems, guarded by domainModifyLock
+	virtiofsdProcesses map[string]virtiofsdProcess
+	startVirtiofsd     virtiofsdStartFunc
 }
 
 type pausedVMIs struct {
@@ -251,6 +265,8 @@ func newLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralD
 		cpuSetGetter:                  cpuSetGetter,
 		setTimeOnce:                   sync.Once{},
 		imageVolumeFeatureGateEnabled: imageVolumeEnabled,
+		virtiofsdProcesses:            map[string]virtiofsdProcess{},
+		startVirtiofsd:                startVirtiofsd,
 	}
 
 	manager.hotplugHostDevicesInProgress = make(chan struct{}, maxConcurrentHotplugHostDevices)
@@ -626,10 +642,58 @@ func (l *LibvirtDomainManager) hotPlugHostDevices(vmi *v1.VirtualMachineInstance
 	return nil
 }
 
+// replugHostDevices attaches the GPUs and generic host-devices of the VMI which are missing in the domain,
+// after they were unplugged to migrate it
+func (l *LibvirtDomainManager) replugHostDevices(vmi *v1.VirtualMachineInstance) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	const errMsgPrefix = "failed to replug host-devices"
+
+	domainName := api.VMINamespaceKeyFunc(vmi)
+	domain, err := l.virConn.LookupDomainByName(domainName)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+	defer domain.Free()
+
+	domainSpec, err := util.GetDomainSpecWithFlags(domain, 0)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	gpuHostDevices, err := gpu.GetHostDevicesToAttach(vmi.Spec.Domain.Devices.GPUs, domainSpec)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+	genericHostDevices, err := generic.GetHostDevicesToAttach(vmi.Spec.Domain.Devices.HostDevices, domainSpec)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	if err := hostdevice.AttachHostDevices(domain, append(gpuHostDevices, genericHostDevices...)); err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	return nil
+}
+
 func (l *LibvirtDomainManager) Exec(domainName, command string, args []string, timeoutSeconds int32) (string, error) {
 	return agent.GuestExec(l.virConn, domainName, command, args, timeoutSeconds)
 }
 
+func (l *LibvirtDomainManager) GuestOSExec(domainName, command string, args []string, timeoutSeconds int32) (string, string, error) {
+	return agent.GuestExecWithStdErr(l.virConn, domainName, command, args, timeoutSeconds)
+}
+
+func (l *LibvirtDomainManager) GuestFileRead(domainName, path string, maxSize int64) ([]byte, error) {
+	return agent.GuestFileRead(l.virConn, domainName, path, maxSize)
+}
+
+func (l *LibvirtDomainManager) GuestFileWrite(domainName, path string, content []byte) error {
+	return agent.GuestFileWrite(l.virConn, domainName, path, content)
+}
+
 func (l *LibvirtDomainManager) GuestPing(domainName string) error {
 	pingCmd := `{"execute":"guest-ping"}`
 	_, err := l.virConn.QemuAgentCommand(pingCmd, domainName)
@@ -782,10 +846,13 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 	}
 
 	// generate ignition data
-	ignitionData := ignition.GetIgnitionSource(vmi)
+	ignitionData, err := ignition.GetIgnitionSource(vmi, config.SecretSourceDir)
+	if err != nil {
+		return domain, err
+	}
 	if ignitionData != "" {
 
-		err := ignition.GenerateIgnitionLocalData(vmi, vmi.Namespace)
+		err := ignition.GenerateIgnitionLocalData(vmi, vmi.Namespace, ignitionData)
 		if err != nil {
 			return domain, err
 		}
@@ -1120,15 +1187,51 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 			return nil, err
 		}
 		c.GPUHostDevices = append(c.GPUHostDevices, gpuDRAHostDevices...)
+
+		vhostUserBlkSockets, err := allocateVhostUserBlkSockets(vmi)
+		if err != nil {
+			return nil, err
+		}
+		c.VhostUserBlkSockets = vhostUserBlkSockets
 	}
 
 	return c, nil
 }
 
+// allocateVhostUserBlkSockets assigns the sockets allocated by the device plugins to the vhost-user-blk volumes requesting them
+func allocateVhostUserBlkSockets(vmi *v1.VirtualMachineInstance) (map[string]string, error) {
+	resourceNames := vhostuserblk.GetResourceNames(&vmi.Spec)
+	if len(resourceNames) == 0 {
+		return nil, nil
+	}
+
+	pool := hostdevice.NewAddressPool(v1.VhostUserBlkResourcePrefix, resourceNames)
+	sockets := map[string]string{}
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.VhostUserBlk == nil || volume.VhostUserBlk.ResourceName == "" {
+			continue
+		}
+		socket, err := pool.Pop(volume.VhostUserBlk.ResourceName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to allocate a vhost-user-blk socket for volume %s: %v", volume.Name, err)
+		}
+		sockets[volume.Name] = socket
+	}
+	return sockets, nil
+}
+
 func isFreePageReportingEnabled(clusterFreePageReportingDisabled bool, vmi *v1.VirtualMachineInstance) bool {
+	if (vmi.Spec.Domain.Devices.AutoattachMemBalloon != nil && *vmi.Spec.Domain.Devices.AutoattachMemBalloon == false) ||
+		vmi.IsHighPerformanceVMI() {
+		return false
+	}
+
+	// The VMI setting overrides the cluster wide one
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.FreePageReporting != nil {
+		return *vmi.Spec.Domain.Memory.FreePageReporting
+	}
+
 	if clusterFreePageReportingDisabled ||
-		(vmi.Spec.Domain.Devices.AutoattachMemBalloon != nil && *vmi.Spec.Domain.Devices.AutoattachMemBalloon == false) ||
-		vmi.IsHighPerformanceVMI() ||
 		vmi.GetAnnotations()[v1.FreePageReportingDisabledAnnotation] == "true" {
 		return false
 	}
@@ -1148,6 +1251,8 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 
 	domain := &api.Domain{}
 
+	l.deviceMetricsDisabled.Store(vmi.Annotations[v1.DeviceMetricsDisabledAnnotation] == "true")
+
 	if l.imageVolumeFeatureGateEnabled {
 		err := l.linkImageVolumeFilePaths(vmi)
 		if err != nil {
@@ -1170,6 +1275,11 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 	// Set defaults which are not coming from the cluster
 	api.NewDefaulter(c.Architecture.GetArchitecture()).SetObjectDefaults_Domain(domain)
 
+	if err := l.startHotplugVirtiofsd(vmi, domain); err != nil {
+		logger.Reason(err).Error("failed to start virtiofsd for hotplugged filesystems")
+		return nil, err
+	}
+
 	dom, err := l.lookupOrCreateVirDomain(domain, vmi, options)
 	if err != nil {
 		return nil, err
@@ -1208,10 +1318,26 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 		return nil, err
 	}
 
+	if err := l.syncFilesystems(domain, oldSpec, dom, vmi); err != nil {
+		return nil, err
+	}
+
+	if err := l.syncIOTune(domain, oldSpec, dom, vmi); err != nil {
+		return nil, err
+	}
+
 	if err := l.syncNetwork(domain, oldSpec, dom, vmi, options); err != nil {
 		return nil, err
 	}
 
+	if err := l.syncMemoryBalloon(oldSpec, dom, vmi); err != nil {
+		return nil, err
+	}
+
+	if err := l.syncVCPUPinning(oldSpec, dom, vmi); err != nil {
+		return nil, err
+	}
+
 	// TODO: check if VirtualMachineInstance Spec and Domain Spec are equal or if we have to sync
 	return oldSpec, nil
 }
@@ -1257,6 +1383,11 @@ func (l *LibvirtDomainManager) syncDisks(
 		if err != nil {
 			return err
 		}
+		err = setHotplugDiskIOThread(dom, spec, &attachDisk, vmi)
+		if err != nil {
+			logger.Reason(err).Error("adding IOThread for attached disk failed")
+			return err
+		}
 
 		attachBytes, err := xml.Marshal(attachDisk)
 		if err != nil {
@@ -1345,6 +1476,159 @@ func (l *LibvirtDomainManager) syncNetwork(
 	return nil
 }
 
+// syncIOTune applies changed IO limits of disks which are attached to the running domain
+func (l *LibvirtDomainManager) syncIOTune(domain *api.Domain, oldSpec *api.DomainSpec, dom cli.VirDomain, vmi *v1.VirtualMachineInstance) error {
+	if !vmi.IsRunning() {
+		return nil
+	}
+
+	for _, newDisk := range domain.Spec.Devices.Disks {
+		if newDisk.Alias == nil {
+			continue
+		}
+		for _, oldDisk := range oldSpec.Devices.Disks {
+			if oldDisk.Alias == nil || oldDisk.Alias.GetName() != newDisk.Alias.GetName() {
+				continue
+			}
+			if equalIOTune(oldDisk.IOTune, newDisk.IOTune) {
+				break
+			}
+
+			log.Log.Object(vmi).V(3).Infof("Setting the IO limits of disk %s", newDisk.Alias.GetName())
+			params := blockIoTuneParameters(newDisk.IOTune)
+			if err := dom.SetBlockIoTune(oldDisk.Target.Device, params, libvirt.DOMAIN_AFFECT_LIVE|libvirt.DOMAIN_AFFECT_CONFIG); err != nil {
+				log.Log.Object(vmi).Reason(err).Errorf("setting the IO limits of disk %s failed", newDisk.Alias.GetName())
+				return err
+			}
+			break
+		}
+	}
+	return nil
+}
+
+func equalIOTune(a, b *api.BlockIOTune) bool {
+	if a == nil {
+		a = &api.BlockIOTune{}
+	}
+	if b == nil {
+		b = &api.BlockIOTune{}
+	}
+	return *a == *b
+}
+
+// blockIoTuneParameters sets all read and write limits, so that removed limits are reset to 0, which is unlimited
+func blockIoTuneParameters(iotune *api.BlockIOTune) *libvirt.DomainBlockIoTuneParameters {
+	if iotune == nil {
+		iotune = &api.BlockIOTune{}
+	}
+	return &libvirt.DomainBlockIoTuneParameters{
+		ReadBytesSecSet:           true,
+		ReadBytesSec:              iotune.ReadBytesSec,
+		WriteBytesSecSet:          true,
+		WriteBytesSec:             iotune.WriteBytesSec,
+		ReadIopsSecSet:            true,
+		ReadIopsSec:               iotune.ReadIopsSec,
+		WriteIopsSecSet:           true,
+		WriteIopsSec:              iotune.WriteIopsSec,
+		ReadBytesSecMaxSet:        true,
+		ReadBytesSecMax:           iotune.ReadBytesSecMax,
+		WriteBytesSecMaxSet:       true,
+		WriteBytesSecMax:          iotune.WriteBytesSecMax,
+		ReadIopsSecMaxSet:         true,
+		ReadIopsSecMax:            iotune.ReadIopsSecMax,
+		WriteIopsSecMaxSet:        true,
+		WriteIopsSecMax:           iotune.WriteIopsSecMax,
+		ReadBytesSecMaxLengthSet:  iotune.ReadBytesSecMaxLength > 0,
+		ReadBytesSecMaxLength:     iotune.ReadBytesSecMaxLength,
+		WriteBytesSecMaxLengthSet: iotune.WriteBytesSecMaxLength > 0,
+		WriteBytesSecMaxLength:    iotune.WriteBytesSecMaxLength,
+		ReadIopsSecMaxLengthSet:   iotune.ReadIopsSecMaxLength > 0,
+		ReadIopsSecMaxLength:      iotune.ReadIopsSecMaxLength,
+		WriteIopsSecMaxLengthSet:  iotune.WriteIopsSecMaxLength > 0,
+		WriteIopsSecMaxLength:     iotune.WriteIopsSecMaxLength,
+	}
+}
+
+// syncMemoryBalloon sets the balloon target requested by the memory balloon policy of virt-handler
+func (l *LibvirtDomainManager) syncMemoryBalloon(oldSpec *api.DomainSpec, dom cli.VirDomain, vmi *v1.VirtualMachineInstance) error {
+	if !vmi.IsRunning() || vmi.Status.Memory == nil || vmi.Status.Memory.BalloonRequested == nil {
+		return nil
+	}
+
+	requestedKiB := uint64(vmi.Status.Memory.BalloonRequested.Value() / 1024)
+	// libvirt always reports the live current memory in KiB
+	if current := oldSpec.CurrentMemory; current != nil && current.Unit == "KiB" && current.Value == requestedKiB {
+		return nil
+	}
+
+	log.Log.Object(vmi).V(3).Infof("Setting the memory balloon target to %d KiB", requestedKiB)
+	if err := dom.SetMemoryFlags(requestedKiB, libvirt.DOMAIN_MEM_LIVE); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("setting the memory balloon target failed")
+		return err
+	}
+	return nil
+}
+
+// syncVCPUPinning re-pins the vCPUs as requested through the repin subresource
+func (l *LibvirtDomainManager) syncVCPUPinning(oldSpec *api.DomainSpec, dom cli.VirDomain, vmi *v1.VirtualMachineInstance) error {
+	if !vmi.IsRunning() || !vmi.IsCPUDedicated() || vmi.Status.VCPUPinning == nil || len(vmi.Status.VCPUPinning.Requested) == 0 {
+		return nil
+	}
+
+	currentPinning := map[uint32]string{}
+	if oldSpec.CPUTune != nil {
+		for _, vcpupin := range oldSpec.CPUTune.VCPUPin {
+			currentPinning[vcpupin.VCPU] = vcpupin.CPUSet
+		}
+	}
+
+	podCPUSet, err := l.cpuSetGetter()
+	if err != nil {
+		return fmt.Errorf("failed to read pod cpuset: %v", err)
+	}
+	podCPUs := map[int]bool{}
+	for _, cpu := range podCPUSet {
+		podCPUs[cpu] = true
+	}
+
+	cpuMaps := map[uint32][]bool{}
+	for _, pin := range vmi.Status.VCPUPinning.Requested {
+		if _, exists := currentPinning[pin.VCPU]; !exists {
+			return fmt.Errorf("failed to re-pin vCPU %d: the vCPU is not pinned", pin.VCPU)
+		}
+		if currentPinning[pin.VCPU] == pin.CPUSet {
+			continue
+		}
+		cpus, err := hw_utils.ParseCPUSetLine(pin.CPUSet, 50000)
+		if err != nil {
+			return fmt.Errorf("failed to re-pin vCPU %d: %v", pin.VCPU, err)
+		}
+		for _, cpu := range cpus {
+			if !podCPUs[cpu] {
+				return fmt.Errorf("failed to re-pin vCPU %d: CPU %d is not assigned to the pod", pin.VCPU, cpu)
+			}
+		}
+		cpuMap := make([]bool, maxSlice(cpus)+1)
+		for _, cpu := range cpus {
+			cpuMap[cpu] = true
+		}
+		cpuMaps[pin.VCPU] = cpuMap
+	}
+
+	for _, pin := range vmi.Status.VCPUPinning.Requested {
+		cpuMap, exists := cpuMaps[pin.VCPU]
+		if !exists {
+			continue
+		}
+		log.Log.Object(vmi).V(3).Infof("Re-pinning vCPU %d to CPUs %s", pin.VCPU, pin.CPUSet)
+		if err := dom.PinVcpuFlags(uint(pin.VCPU), cpuMap, libvirt.DOMAIN_AFFECT_LIVE); err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("re-pinning vCPU %d failed", pin.VCPU)
+			return err
+		}
+	}
+	return nil
+}
+
 func (l *LibvirtDomainManager) startDomain(
 	vmi *v1.VirtualMachineInstance,
 	dom cli.VirDomain,
@@ -1354,6 +1638,14 @@ func (l *LibvirtDomainManager) startDomain(
 		return err
 	}
 
+	stateFile, err := memoryStateFile(vmi)
+	if err != nil {
+		return err
+	}
+	if stateFile != "" {
+		return l.restoreMemoryState(vmi, dom, stateFile)
+	}
+
 	createFlags := getDomainCreateFlags(vmi)
 	if err := dom.CreateWithFlags(createFlags); err != nil {
 		logger.Reason(err).
@@ -1495,6 +1787,47 @@ func getDetachedDisks(oldDisks, newDisks []api.Disk) []api.Disk {
 	return res
 }
 
+// setHotplugDiskIOThread adds a new IOThread to the domain for a hotplugged disk requesting a
+// dedicated one, since a running domain only has the IOThreads it was started with.
+func setHotplugDiskIOThread(dom cli.VirDomain, spec *api.DomainSpec, disk *api.Disk, vmi *v1.VirtualMachineInstance) error {
+	if disk.Driver == nil || disk.Driver.IOThread == nil {
+		return nil
+	}
+
+	var ioThreads uint
+	if spec.IOThreads != nil {
+		ioThreads = spec.IOThreads.IOThreads
+	}
+
+	if !hasDedicatedIOThread(vmi, disk.Alias.GetName()) {
+		if *disk.Driver.IOThread > ioThreads {
+			// The IOThread doesn't exist in the running domain
+			disk.Driver.IOThread = nil
+		}
+		return nil
+	}
+
+	ioThread := ioThreads + 1
+	if err := dom.AddIOThread(ioThread, affectDomainLiveAndConfigLibvirtFlags); err != nil {
+		return err
+	}
+	if spec.IOThreads == nil {
+		spec.IOThreads = &api.IOThreads{}
+	}
+	spec.IOThreads.IOThreads = ioThread
+	disk.Driver.IOThread = pointer.P(ioThread)
+	return nil
+}
+
+func hasDedicatedIOThread(vmi *v1.VirtualMachineInstance, diskName string) bool {
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.Name == diskName {
+			return disk.DedicatedIOThread != nil && *disk.DedicatedIOThread
+		}
+	}
+	return false
+}
+
 func getAttachedDisks(oldDisks, newDisks []api.Disk) []api.Disk {
 	oldDiskMap := make(map[string]api.Disk)
 	for _, disk := range oldDisks {
@@ -1634,18 +1967,27 @@ func (l *LibvirtDomainManager) getDomainSpec(dom cli.VirDomain) (*api.DomainSpec
 	return domainSpec, err
 }
 
-func removePreviousMemoryDump(dir string) {
+// removePreviousMemoryDump removes the memory dumps in dir but the latest keep ones. The dumps
+// are named after the time they were taken, so they are listed oldest first.
+func removePreviousMemoryDump(dir string, keep int) {
 	files, err := os.ReadDir(dir)
 	if err != nil {
 		log.Log.Reason(err).Errorf("failed to remove older memory dumps")
 		return
 	}
+	var dumps []string
 	for _, file := range files {
 		if strings.Contains(file.Name(), "memory.dump") {
-			err = os.Remove(filepath.Join(dir, file.Name()))
-			if err != nil {
-				log.Log.Reason(err).Errorf("failed to remove older memory dumps")
-			}
+			dumps = append(dumps, file.Name())
+		}
+	}
+	if len(dumps) <= keep {
+		return
+	}
+	for _, dump := range dumps[:len(dumps)-keep] {
+		err = os.Remove(filepath.Join(dir, dump))
+		if err != nil {
+			log.Log.Reason(err).Errorf("failed to remove older memory dumps")
 		}
 	}
 }
@@ -1681,13 +2023,17 @@ func (l *LibvirtDomainManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPa
 		return err
 	}
 	defer dom.Free()
+	if err := checkCrashDumpSize(vmi, dumpPath); err != nil {
+		l.setMemoryDumpResult(true, fmt.Sprintf("%s: %s", failedDomainMemoryDump, err))
+		return err
+	}
 	// keep trying to do memory dump even if remove previous one failed
-	removePreviousMemoryDump(filepath.Dir(dumpPath))
+	removePreviousMemoryDump(filepath.Dir(dumpPath), memoryDumpsToKeep(vmi, dumpPath))
 
 	logger.Infof("Starting memory dump")
 	failed := false
 	reason := ""
-	err = dom.CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	err = dumpMemory(dom, dumpPath, memoryDumpFormat(vmi, dumpPath), memoryDumpContent(vmi, dumpPath))
 	if err != nil {
 		failed = true
 		reason = fmt.Sprintf("%s: %s", failedDomainMemoryDump, err)
@@ -1887,6 +2233,11 @@ func (l *LibvirtDomainManager) FreezeVMI(vmi *v1.VirtualMachineInstance, unfreez
 		}
 	}
 
+	freezeHooks, err := freezehooks.FromVMI(vmi)
+	if err != nil {
+		return err
+	}
+
 	domain, err := l.virConn.LookupDomainByName(domainName)
 	if err != nil {
 		log.Log.Errorf("Domain lookup failed: %v", err)
@@ -1894,8 +2245,15 @@ func (l *LibvirtDomainManager) FreezeVMI(vmi *v1.VirtualMachineInstance, unfreez
 	}
 	defer domain.Free()
 
+	if err := freezehooks.Run(freezeHooks, snapshotv1.PreFreezeHook, l.guestExecFunc(domainName)); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to run pre-freeze hook")
+		l.runPostThawHooksAfterFailedFreeze(vmi, freezeHooks, domainName)
+		return err
+	}
+
 	if err := domain.FSFreeze(nil, 0); err != nil {
 		log.Log.Errorf("Failed to freeze vmi, %s", err.Error())
+		l.runPostThawHooksAfterFailedFreeze(vmi, freezeHooks, domainName)
 		return err
 	}
 
@@ -1928,9 +2286,35 @@ func (l *LibvirtDomainManager) UnfreezeVMI(vmi *v1.VirtualMachineInstance) error
 		log.Log.Errorf("Failed to unfreeze vmi, %s", err.Error())
 		return err
 	}
+
+	freezeHooks, err := freezehooks.FromVMI(vmi)
+	if err != nil {
+		return err
+	}
+	if err := freezehooks.Run(freezeHooks, snapshotv1.PostThawHook, l.guestExecFunc(domainName)); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to run post-thaw hook")
+		return err
+	}
 	return nil
 }
 
+// runPostThawHooksAfterFailedFreeze undoes the pre-freeze hooks which ran before the freeze failed. The unfreeze
+// returns early for a thawed filesystem, so it would never run the post-thaw hooks.
+func (l *LibvirtDomainManager) runPostThawHooksAfterFailedFreeze(vmi *v1.VirtualMachineInstance, hooks []snapshotv1.FreezeHook, domainName string) {
+	if !slices.ContainsFunc(hooks, func(hook snapshotv1.FreezeHook) bool { return hook.Type == snapshotv1.PreFreezeHook }) {
+		return
+	}
+	if err := freezehooks.Run(hooks, snapshotv1.PostThawHook, l.guestExecFunc(domainName)); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to run post-thaw hook after a failed freeze")
+	}
+}
+
+func (l *LibvirtDomainManager) guestExecFunc(domainName string) freezehooks.ExecFunc {
+	return func(command string, args []string, timeoutSeconds int32) (string, error) {
+		return l.Exec(domainName, command, args, timeoutSeconds)
+	}
+}
+
 func (l *LibvirtDomainManager) ResetVMI(vmi *v1.VirtualMachineInstance) error {
 	domName := api.VMINamespaceKeyFunc(vmi)
 	dom, err := l.virConn.LookupDomainByName(domName)
@@ -2010,6 +2394,13 @@ func (l *LibvirtDomainManager) SignalShutdownVMI(vmi *v1.VirtualMachineInstance)
 	}
 
 	if domState == libvirt.DOMAIN_RUNNING || domState == libvirt.DOMAIN_PAUSED {
+		// The shutdown may be signalled repeatedly, the hooks are only called on the first signal
+		if gracePeriod, _ := l.metadataCache.GracePeriod.Load(); gracePeriod.DeletionTimestamp == nil {
+			if err := hooks.GetManager().PreShutdown(vmi); err != nil {
+				log.Log.Object(vmi).Reason(err).Error("executing custom preShutdown hooks failed")
+			}
+		}
+
 		err = dom.ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_DEFAULT)
 		if err != nil {
 			log.Log.Object(vmi).Reason(err).Error("Signalling graceful shutdown failed.")
@@ -2198,7 +2589,17 @@ func (l *LibvirtDomainManager) getDomainStats() ([]*stats.DomainStats, error) {
 	statsTypes := libvirt.DOMAIN_STATS_BALLOON | libvirt.DOMAIN_STATS_CPU_TOTAL | libvirt.DOMAIN_STATS_VCPU | libvirt.DOMAIN_STATS_INTERFACE | libvirt.DOMAIN_STATS_BLOCK | libvirt.DOMAIN_STATS_DIRTYRATE
 	flags := libvirt.CONNECT_GET_ALL_DOMAINS_STATS_RUNNING | libvirt.CONNECT_GET_ALL_DOMAINS_STATS_PAUSED
 
-	return l.virConn.GetDomainStats(statsTypes, l.migrateInfoStats, flags)
+	list, err := l.virConn.GetDomainStats(statsTypes, l.migrateInfoStats, flags)
+	if err != nil || l.deviceMetricsDisabled.Load() {
+		return list, err
+	}
+
+	for _, domStats := range list {
+		if err := l.collectLatencyHistograms(domStats); err != nil {
+			log.Log.Reason(err).Warningf("failed to collect the latency histograms of the disks of %s", domStats.Name)
+		}
+	}
+	return list, nil
 }
 
 func (l *LibvirtDomainManager) getDomainDirtyRateStats(calculationDuration time.Duration) ([]*stats.DomainStatsDirtyRate, error) {
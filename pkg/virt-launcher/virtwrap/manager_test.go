@@ -25,7 +25,9 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -44,6 +46,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	api2 "kubevirt.io/client-go/api"
 
 	cloudinit "kubevirt.io/kubevirt/pkg/cloud-init"
@@ -608,6 +611,157 @@ var _ = Describe("Manager", func() {
 
 			Expect(manager.FreezeVMI(vmi, 0)).To(Succeed())
 		})
+		Context("with freeze hooks", func() {
+			const (
+				guestExecFlush  = `{"execute": "guest-exec", "arguments": { "path": "/usr/bin/flush", "arg": [ "--all" ], "capture-output":true } }`
+				guestExecResume = `{"execute": "guest-exec", "arguments": { "path": "/usr/bin/resume", "arg": [  ], "capture-output":true } }`
+				guestExecStatus = `{"execute": "guest-exec-status", "arguments": { "pid": 1 } }`
+				guestExecPid    = `{"return":{"pid":1}}`
+			)
+
+			newVMIWithHooks := func() *v1.VirtualMachineInstance {
+				vmi := newVMI(testNamespace, testVmName)
+				vmi.Annotations = map[string]string{
+					snapshotv1.FreezeHooksAnnotation: `[
+						{"name": "flush", "type": "PreFreeze", "command": ["/usr/bin/flush", "--all"]},
+						{"name": "resume", "type": "PostThaw", "command": ["/usr/bin/resume"]}
+					]`,
+				}
+				return vmi
+			}
+
+			It("should run pre-freeze hooks before freezing", func() {
+				vmi := newVMIWithHooks()
+
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GetFSFreezeStatus)+`"}`, testDomainName).Return(expectedThawedOutput, nil)
+				mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).Return(mockLibvirt.VirtDomain, nil).Times(1)
+				mockLibvirt.DomainEXPECT().Free().Times(1)
+				gomock.InOrder(
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestExecFlush, testDomainName).Return(guestExecPid, nil),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestExecStatus, testDomainName).Return(`{"return":{"exited":true,"exitcode":0,"out-data":""}}`, nil),
+					mockLibvirt.DomainEXPECT().FSFreeze(nil, uint32(0)).Times(1),
+				)
+
+				manager, _ := newLibvirtDomainManagerDefault()
+
+				Expect(manager.FreezeVMI(vmi, 0)).To(Succeed())
+			})
+
+			It("should not freeze and run post-thaw hooks when a pre-freeze hook fails", func() {
+				vmi := newVMIWithHooks()
+
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GetFSFreezeStatus)+`"}`, testDomainName).Return(expectedThawedOutput, nil)
+				mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).Return(mockLibvirt.VirtDomain, nil).Times(1)
+				mockLibvirt.DomainEXPECT().Free().Times(1)
+				gomock.InOrder(
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestExecFlush, testDomainName).Return(guestExecPid, nil),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestExecStatus, testDomainName).Return(`{"return":{"exited":true,"exitcode":1,"out-data":""}}`, nil),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestExecResume, testDomainName).Return(guestExecPid, nil),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestExecStatus, testDomainName).Return(`{"return":{"exited":true,"exitcode":0,"out-data":""}}`, nil),
+				)
+				mockLibvirt.DomainEXPECT().FSFreeze(gomock.Any(), gomock.Any()).Times(0)
+
+				manager, _ := newLibvirtDomainManagerDefault()
+
+				Expect(manager.FreezeVMI(vmi, 0)).To(MatchError(ContainSubstring(`PreFreeze hook "flush" failed`)))
+			})
+
+			It("should run post-thaw hooks when the freeze fails after the pre-freeze hooks", func() {
+				vmi := newVMIWithHooks()
+
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GetFSFreezeStatus)+`"}`, testDomainName).Return(expectedThawedOutput, nil)
+				mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).Return(mockLibvirt.VirtDomain, nil).Times(1)
+				mockLibvirt.DomainEXPECT().Free().Times(1)
+				gomock.InOrder(
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestExecFlush, testDomainName).Return(guestExecPid, nil),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestExecStatus, testDomainName).Return(`{"return":{"exited":true,"exitcode":0,"out-data":""}}`, nil),
+					mockLibvirt.DomainEXPECT().FSFreeze(nil, uint32(0)).Return(libvirt.Error{Code: libvirt.ERR_AGENT_UNRESPONSIVE}),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestExecResume, testDomainName).Return(guestExecPid, nil),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestExecStatus, testDomainName).Return(`{"return":{"exited":true,"exitcode":0,"out-data":""}}`, nil),
+				)
+
+				manager, _ := newLibvirtDomainManagerDefault()
+
+				Expect(manager.FreezeVMI(vmi, 0)).ToNot(Succeed())
+			})
+
+			It("should run post-thaw hooks after unfreezing", func() {
+				vmi := newVMIWithHooks()
+
+				mockLibvirt.ConnectionEXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GetFSFreezeStatus)+`"}`, testDomainName).Return(expectedFrozenOutput, nil)
+				mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).Return(mockLibvirt.VirtDomain, nil).Times(1)
+				mockLibvirt.DomainEXPECT().Free().Times(1)
+				gomock.InOrder(
+					mockLibvirt.DomainEXPECT().FSThaw(nil, uint32(0)).Times(1),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestExecResume, testDomainName).Return(guestExecPid, nil),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestExecStatus, testDomainName).Return(`{"return":{"exited":true,"exitcode":0,"out-data":""}}`, nil),
+				)
+
+				manager, _ := newLibvirtDomainManagerDefault()
+
+				Expect(manager.UnfreezeVMI(vmi)).To(Succeed())
+			})
+		})
+		Context("with guest files", func() {
+			const (
+				guestFileOpenRead  = `{"execute": "guest-file-open", "arguments": { "path": "/etc/hostname", "mode": "r" } }`
+				guestFileOpenWrite = `{"execute": "guest-file-open", "arguments": { "path": "/etc/hostname", "mode": "w" } }`
+				guestFileRead      = `{"execute": "guest-file-read", "arguments": { "handle": 1000, "count": 65536 } }`
+				guestFileClose     = `{"execute": "guest-file-close", "arguments": { "handle": 1000 } }`
+				guestFileHandle    = `{"return":1000}`
+			)
+
+			It("should read a file in chunks until EOF", func() {
+				gomock.InOrder(
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestFileOpenRead, testDomainName).Return(guestFileHandle, nil),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestFileRead, testDomainName).Return(`{"return":{"count":4,"buf-b64":"dGVzdA==","eof":false}}`, nil),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestFileRead, testDomainName).Return(`{"return":{"count":4,"buf-b64":"dm1pCg==","eof":true}}`, nil),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestFileClose, testDomainName).Return(`{"return":{}}`, nil),
+				)
+
+				manager, _ := newLibvirtDomainManagerDefault()
+
+				Expect(manager.GuestFileRead(testDomainName, "/etc/hostname", 1024)).To(Equal([]byte("testvmi\n")))
+			})
+
+			It("should fail to read a file larger than the limit", func() {
+				gomock.InOrder(
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestFileOpenRead, testDomainName).Return(guestFileHandle, nil),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestFileRead, testDomainName).Return(`{"return":{"count":4,"buf-b64":"dGVzdA==","eof":false}}`, nil),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestFileClose, testDomainName).Return(`{"return":{}}`, nil),
+				)
+
+				manager, _ := newLibvirtDomainManagerDefault()
+
+				_, err := manager.GuestFileRead(testDomainName, "/etc/hostname", 3)
+				Expect(err).To(MatchError("file /etc/hostname is larger than 3 bytes"))
+			})
+
+			It("should write a file", func() {
+				gomock.InOrder(
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestFileOpenWrite, testDomainName).Return(guestFileHandle, nil),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(`{"execute": "guest-file-write", "arguments": { "handle": 1000, "buf-b64": "dGVzdHZtaQo=" } }`, testDomainName).Return(`{"return":{"count":8,"eof":false}}`, nil),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestFileClose, testDomainName).Return(`{"return":{}}`, nil),
+				)
+
+				manager, _ := newLibvirtDomainManagerDefault()
+
+				Expect(manager.GuestFileWrite(testDomainName, "/etc/hostname", []byte("testvmi\n"))).To(Succeed())
+			})
+
+			It("should fail when the guest reports more bytes written than sent", func() {
+				gomock.InOrder(
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestFileOpenWrite, testDomainName).Return(guestFileHandle, nil),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(`{"execute": "guest-file-write", "arguments": { "handle": 1000, "buf-b64": "dGVzdHZtaQo=" } }`, testDomainName).Return(`{"return":{"count":9,"eof":false}}`, nil),
+					mockLibvirt.ConnectionEXPECT().QemuAgentCommand(guestFileClose, testDomainName).Return(`{"return":{}}`, nil),
+				)
+
+				manager, _ := newLibvirtDomainManagerDefault()
+
+				Expect(manager.GuestFileWrite(testDomainName, "/etc/hostname", []byte("testvmi\n"))).To(
+					MatchError("guest agent reported 9 bytes written to file /etc/hostname, more than the 8 bytes sent"))
+			})
+		})
 		It("should fail freeze a VirtualMachineInstance during migration", func() {
 			vmi := newVMI(testNamespace, testVmName)
 			now := metav1.Now()
@@ -717,6 +871,183 @@ var _ = Describe("Manager", func() {
 				return memoryDump.Failed
 			}, 5*time.Second).Should(BeTrue(), "failed memory dump result wasn't set")
 		})
+		It("should save the memory state when the memory dump volume requests it", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "vol1",
+				VolumeSource: v1.VolumeSource{
+					MemoryDump: &v1.MemoryDumpVolumeSource{
+						PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "memory-pvc",
+							},
+						},
+						Format: v1.MemoryDumpFormatState,
+					},
+				},
+			})
+			dumpPath := fmt.Sprintf("/test/dump/path/%s-vol1-20260101-000000.memory.dump", testVmName)
+
+			domainSpec := expectedDomainFor(vmi)
+			domainSpec.Devices.Disks = append(domainSpec.Devices.Disks, api.Disk{
+				Target: api.DiskTarget{Device: "vda"},
+			})
+			domainXML, err := xml.MarshalIndent(domainSpec, "", "\t")
+			Expect(err).NotTo(HaveOccurred())
+
+			mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(domainXML), nil)
+			mockLibvirt.DomainEXPECT().CreateSnapshotXML(gomock.Any(), libvirt.DOMAIN_SNAPSHOT_CREATE_LIVE|libvirt.DOMAIN_SNAPSHOT_CREATE_NO_METADATA).DoAndReturn(
+				func(snapshotXML string, _ libvirt.DomainSnapshotCreateFlags) (*libvirt.DomainSnapshot, error) {
+					Expect(snapshotXML).To(ContainSubstring(dumpPath))
+					Expect(snapshotXML).To(ContainSubstring(`<disk name="vda" snapshot="no">`))
+					return nil, nil
+				})
+
+			manager, _ := newLibvirtDomainManagerDefault()
+
+			Expect(manager.MemoryDump(vmi, dumpPath)).To(Succeed())
+			Eventually(func() bool {
+				memoryDump, _ := metadataCache.MemoryDump.Load()
+				return memoryDump.Completed
+			}, 5*time.Second, 2).Should(BeTrue())
+		})
+		It("should keep the latest crash dumps", func() {
+			dumpDir := GinkgoT().TempDir()
+			for _, timestamp := range []string{"20260101-000000", "20260102-000000", "20260103-000000"} {
+				Expect(os.WriteFile(filepath.Join(dumpDir, fmt.Sprintf("%s-crash-%s.memory.dump", testVmName, timestamp)), nil, 0644)).To(Succeed())
+			}
+			dumpPath := filepath.Join(dumpDir, fmt.Sprintf("%s-crash-20260104-000000.memory.dump", testVmName))
+
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.CrashDump = &v1.CrashDump{VolumeName: "crash", MaxDumps: virtpointer.P(int32(2))}
+
+			mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockLibvirt.DomainEXPECT().CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY).Return(nil)
+
+			manager, _ := newLibvirtDomainManagerDefault()
+
+			Expect(manager.MemoryDump(vmi, dumpPath)).To(Succeed())
+			Eventually(func() bool {
+				memoryDump, _ := metadataCache.MemoryDump.Load()
+				return memoryDump.Completed
+			}, 5*time.Second, 2).Should(BeTrue())
+
+			files, err := os.ReadDir(dumpDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+			Expect(files[0].Name()).To(ContainSubstring("20260103-000000"))
+		})
+		It("should not dump the memory of a guest larger than the crash dump max size", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("2Gi")}
+			vmi.Spec.CrashDump = &v1.CrashDump{VolumeName: "crash", MaxSize: virtpointer.P(resource.MustParse("1Gi"))}
+			dumpPath := fmt.Sprintf("/test/dump/path/%s-crash-20260101-000000.memory.dump", testVmName)
+
+			mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+
+			manager, _ := newLibvirtDomainManagerDefault()
+
+			Expect(manager.MemoryDump(vmi, dumpPath)).To(Succeed())
+			Eventually(func() bool {
+				memoryDump, _ := metadataCache.MemoryDump.Load()
+				return memoryDump.Failed
+			}, 5*time.Second).Should(BeTrue())
+			memoryDump, _ := metadataCache.MemoryDump.Load()
+			Expect(memoryDump.FailureReason).To(ContainSubstring("exceeds the crash dump max size"))
+		})
+		Context("with the memory dump options of the volume", func() {
+			newMemoryDumpVMI := func(format v1.MemoryDumpFormat, content v1.MemoryDumpContent) *v1.VirtualMachineInstance {
+				vmi := newVMI(testNamespace, testVmName)
+				vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+					Name: "vol1",
+					VolumeSource: v1.VolumeSource{
+						MemoryDump: &v1.MemoryDumpVolumeSource{
+							Format:  format,
+							Content: content,
+						},
+					},
+				})
+				return vmi
+			}
+
+			expectCompleted := func() {
+				Eventually(func() bool {
+					memoryDump, _ := metadataCache.MemoryDump.Load()
+					return memoryDump.Completed && !memoryDump.Failed
+				}, 5*time.Second, 2).Should(BeTrue())
+			}
+
+			DescribeTable("should dump the memory in the kdump format", func(format v1.MemoryDumpFormat, coreDumpFormat libvirt.DomainCoreDumpFormat) {
+				vmi := newMemoryDumpVMI(format, v1.MemoryDumpContentFull)
+				dumpPath := fmt.Sprintf("/test/dump/path/%s-vol1-20260101-000000.memory.dump", testVmName)
+
+				mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+				mockLibvirt.DomainEXPECT().CoreDumpWithFormat(dumpPath, coreDumpFormat, libvirt.DUMP_MEMORY_ONLY).Return(nil)
+
+				manager, _ := newLibvirtDomainManagerDefault()
+
+				Expect(manager.MemoryDump(vmi, dumpPath)).To(Succeed())
+				expectCompleted()
+			},
+				Entry("zlib", v1.MemoryDumpFormatKdumpZlib, libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_ZLIB),
+				Entry("lzo", v1.MemoryDumpFormatKdumpLzo, libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_LZO),
+				Entry("snappy", v1.MemoryDumpFormatKdumpSnappy, libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_SNAPPY),
+			)
+
+			It("should only dump the kernel memory through the QEMU monitor", func() {
+				vmi := newMemoryDumpVMI(v1.MemoryDumpFormatRaw, v1.MemoryDumpContentKernel)
+				dumpPath := filepath.Join(GinkgoT().TempDir(), fmt.Sprintf("%s-vol1-20260101-000000.memory.dump", testVmName))
+
+				mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+				mockLibvirt.DomainEXPECT().QemuMonitorCommandWithFiles(`{"execute":"getfd","arguments":{"fdname":"kubevirt-memory-dump"}}`, gomock.Len(1), libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT).Return("{}", nil, nil)
+				mockLibvirt.DomainEXPECT().QemuMonitorCommand(`{"execute":"dump-guest-memory","arguments":{"paging":true,"detach":true,"protocol":"fd:kubevirt-memory-dump"}}`, libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT).Return("{}", nil)
+				mockLibvirt.DomainEXPECT().QemuMonitorCommand(`{"execute":"query-dump"}`, libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT).Return(`{"return":{"status":"completed"}}`, nil)
+
+				manager, _ := newLibvirtDomainManagerDefault()
+
+				Expect(manager.MemoryDump(vmi, dumpPath)).To(Succeed())
+				expectCompleted()
+				Expect(dumpPath).To(BeAnExistingFile())
+			})
+		})
+		Context("streaming the memory dump", func() {
+			It("should stream the dump through a fifo", func() {
+				vmi := newVMI(testNamespace, testVmName)
+				dumpPath := filepath.Join(GinkgoT().TempDir(), "virt-memory-dump-1")
+
+				mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+				mockLibvirt.DomainEXPECT().CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_LZO, libvirt.DUMP_MEMORY_ONLY).DoAndReturn(
+					func(path string, _ libvirt.DomainCoreDumpFormat, _ libvirt.DomainCoreDumpFlags) error {
+						return os.WriteFile(path, []byte("memory"), 0600)
+					})
+
+				received := make(chan []byte, 1)
+				go func() {
+					defer GinkgoRecover()
+					Eventually(dumpPath).Should(BeAnExistingFile())
+					stream, err := os.Open(dumpPath)
+					Expect(err).ToNot(HaveOccurred())
+					defer stream.Close()
+					data, err := io.ReadAll(stream)
+					Expect(err).ToNot(HaveOccurred())
+					received <- data
+				}()
+
+				manager, _ := newLibvirtDomainManagerDefault()
+
+				Expect(manager.StreamMemoryDump(vmi, dumpPath, &v1.MemoryDumpStreamOptions{Format: v1.MemoryDumpFormatKdumpLzo})).To(Succeed())
+				Eventually(received).Should(Receive(Equal([]byte("memory"))))
+				Expect(dumpPath).ToNot(BeAnExistingFile())
+			})
+
+			It("should refuse to stream the memory state", func() {
+				manager, _ := newLibvirtDomainManagerDefault()
+
+				err := manager.StreamMemoryDump(newVMI(testNamespace, testVmName), "/test/dump/path", &v1.MemoryDumpStreamOptions{Format: v1.MemoryDumpFormatState})
+				Expect(err).To(MatchError("the State format can not be streamed"))
+			})
+		})
 		It("should pause a VirtualMachineInstance", func() {
 			vmi := newVMI(testNamespace, testVmName)
 
@@ -1589,6 +1920,10 @@ var _ = Describe("Manager", func() {
 			Entry("disabled if vmi is requesting DedicatedCPU", nil, false, &v1.CPU{
 				DedicatedCPUPlacement: true}, "false", "off"),
 			Entry("disabled if vmi has the disable free page reporting annotation", nil, false, nil, "true", "off"),
+			Entry("enabled if vmi enables it while it is disabled at cluster level", &v1.Memory{FreePageReporting: virtpointer.P(true)}, true, nil, "false", "on"),
+			Entry("disabled if vmi disables it", &v1.Memory{FreePageReporting: virtpointer.P(false)}, false, nil, "false", "off"),
+			Entry("disabled if vmi enables it while requesting DedicatedCPU", &v1.Memory{FreePageReporting: virtpointer.P(true)}, false, &v1.CPU{
+				DedicatedCPUPlacement: true}, "false", "off"),
 		)
 
 		It("should return SEV platform info", func() {
@@ -2324,6 +2659,9 @@ var _ = Describe("Manager", func() {
 				AllowAutoConverge: migrationType == "autoConverge",
 				AllowPostCopy:     migrationType == "postCopy",
 			}
+			if migrationType == "compressed" {
+				options.Compression = string(v1.MigrationCompressionXBZRLE)
+			}
 
 			shouldConfigureParallel, parallelMigrationThreads := shouldConfigureParallelMigration(options)
 			if shouldConfigureParallel {
@@ -2350,6 +2688,9 @@ var _ = Describe("Manager", func() {
 			if shouldConfigureParallel {
 				expectedMigrateFlags |= libvirt.MIGRATE_PARALLEL
 			}
+			if migrationType == "compressed" {
+				expectedMigrateFlags |= libvirt.MIGRATE_COMPRESSED
+			}
 			Expect(flags).To(Equal(expectedMigrateFlags), "libvirt migration flags are not set as expected")
 		},
 		Entry("with block migration", "block"),
@@ -2358,6 +2699,7 @@ var _ = Describe("Manager", func() {
 		Entry("migration auto converge", "autoConverge"),
 		Entry("migration using postcopy", "postCopy"),
 		Entry("migration of paused vmi", "paused"),
+		Entry("compressed migration", "compressed"),
 	)
 
 	DescribeTable("on successful list all domains",
@@ -2405,10 +2747,12 @@ var _ = Describe("Manager", func() {
 				flags = libvirt.CONNECT_GET_ALL_DOMAINS_STATS_RUNNING | libvirt.CONNECT_GET_ALL_DOMAINS_STATS_PAUSED
 			)
 			fakeDomainStats := []*stats.DomainStats{
-				{},
+				{Name: testDomainName},
 			}
 
 			mockLibvirt.ConnectionEXPECT().GetDomainStats(domainStats, gomock.Any(), flags).Return(fakeDomainStats, nil)
+			mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockLibvirt.DomainEXPECT().QemuMonitorCommand(`{"execute":"query-blockstats"}`, libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT).Return(`{"return":[]}`, nil)
 
 			manager, _ := newLibvirtDomainManagerDefault()
 			domStats, err := manager.GetDomainStats()
@@ -2418,6 +2762,72 @@ var _ = Describe("Manager", func() {
 		})
 	})
 
+	Context("disk latency histograms", func() {
+		const (
+			statsTypes = libvirt.DOMAIN_STATS_BALLOON |
+				libvirt.DOMAIN_STATS_CPU_TOTAL |
+				libvirt.DOMAIN_STATS_VCPU |
+				libvirt.DOMAIN_STATS_INTERFACE |
+				libvirt.DOMAIN_STATS_BLOCK |
+				libvirt.DOMAIN_STATS_DIRTYRATE
+			flags = libvirt.CONNECT_GET_ALL_DOMAINS_STATS_RUNNING | libvirt.CONNECT_GET_ALL_DOMAINS_STATS_PAUSED
+		)
+
+		var fakeDomainStats []*stats.DomainStats
+
+		BeforeEach(func() {
+			fakeDomainStats = []*stats.DomainStats{{
+				Name: testDomainName,
+				Block: []stats.DomainStatsBlock{
+					{Name: "vda", Alias: "rootdisk"},
+					{Name: "vdb", Alias: "datadisk"},
+				},
+			}}
+			mockLibvirt.ConnectionEXPECT().GetDomainStats(statsTypes, gomock.Any(), flags).Return(fakeDomainStats, nil)
+		})
+
+		It("should enable the histograms of the disks which do not have one yet", func() {
+			mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockLibvirt.DomainEXPECT().QemuMonitorCommand(`{"execute":"query-blockstats"}`, libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT).Return(`{"return":[
+				{"qdev":"/machine/peripheral/ua-rootdisk/virtio-backend","stats":{
+					"rd_latency_histogram":{"boundaries":[100000],"bins":[3,1]},
+					"wr_latency_histogram":{"boundaries":[100000],"bins":[2,0]},
+					"flush_latency_histogram":{"boundaries":[100000],"bins":[1,1]}}},
+				{"qdev":"/machine/peripheral/ua-datadisk/virtio-backend","stats":{}},
+				{"qdev":"/machine/peripheral/cdrom/virtio-backend","stats":{}}]}`, nil)
+			mockLibvirt.DomainEXPECT().QemuMonitorCommand(`{"execute":"block-latency-histogram-set","arguments":{"id":"/machine/peripheral/ua-datadisk/virtio-backend","boundaries":[100000,500000,1000000,5000000,10000000,50000000,100000000,500000000,1000000000]}}`, libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT).Return("{}", nil)
+
+			manager, _ := newLibvirtDomainManagerDefault()
+			domStats, err := manager.GetDomainStats()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(domStats.Block[0].RdLatencyHistogram).To(Equal(&stats.DomainStatsLatencyHistogram{Boundaries: []uint64{100000}, Bins: []uint64{3, 1}}))
+			Expect(domStats.Block[0].WrLatencyHistogram).To(Equal(&stats.DomainStatsLatencyHistogram{Boundaries: []uint64{100000}, Bins: []uint64{2, 0}}))
+			Expect(domStats.Block[0].FlLatencyHistogram).To(Equal(&stats.DomainStatsLatencyHistogram{Boundaries: []uint64{100000}, Bins: []uint64{1, 1}}))
+			Expect(domStats.Block[1].RdLatencyHistogram).To(BeNil())
+		})
+
+		It("should still return the domain stats when the histograms can not be collected", func() {
+			mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockLibvirt.DomainEXPECT().QemuMonitorCommand(`{"execute":"query-blockstats"}`, libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT).Return("", errors.New("monitor unavailable"))
+
+			manager, _ := newLibvirtDomainManagerDefault()
+			domStats, err := manager.GetDomainStats()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domStats.Block).To(HaveLen(2))
+			Expect(domStats.Block[0].RdLatencyHistogram).To(BeNil())
+		})
+
+		It("should not collect the histograms of VMIs opting out from the device metrics", func() {
+			manager, _ := newLibvirtDomainManagerDefault()
+			manager.(*LibvirtDomainManager).deviceMetricsDisabled.Store(true)
+
+			domStats, err := manager.GetDomainStats()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domStats.Block[0].RdLatencyHistogram).To(BeNil())
+		})
+	})
+
 	Context("on failed GetDomainSpecWithRuntimeInfo", func() {
 		It("should fall back to returning domain spec without runtime info", func() {
 			manager, _ := newLibvirtDomainManagerDefault()
@@ -3210,6 +3620,236 @@ var _ = Describe("getUpdatedDisks", func() {
 	)
 })
 
+var _ = Describe("setHotplugDiskIOThread", func() {
+	var mockDomain *cli.MockVirDomain
+
+	BeforeEach(func() {
+		mockDomain = cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+	})
+
+	newVMI := func(dedicatedIOThread bool) *v1.VirtualMachineInstance {
+		vmi := api2.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.Devices.Disks = []v1.Disk{{
+			Name:              "hotplug",
+			DedicatedIOThread: virtpointer.P(dedicatedIOThread),
+		}}
+		return vmi
+	}
+
+	newDisk := func(ioThread uint) *api.Disk {
+		return &api.Disk{
+			Alias:  api.NewUserDefinedAlias("hotplug"),
+			Driver: &api.DiskDriver{IOThread: virtpointer.P(ioThread)},
+		}
+	}
+
+	It("should add a new IOThread for a disk with a dedicated IOThread", func() {
+		spec := &api.DomainSpec{IOThreads: &api.IOThreads{IOThreads: 2}}
+		disk := newDisk(2)
+		mockDomain.EXPECT().AddIOThread(uint(3), affectDomainLiveAndConfigLibvirtFlags)
+
+		Expect(setHotplugDiskIOThread(mockDomain, spec, disk, newVMI(true))).To(Succeed())
+		Expect(disk.Driver.IOThread).To(HaveValue(Equal(uint(3))))
+		Expect(spec.IOThreads.IOThreads).To(Equal(uint(3)))
+	})
+
+	It("should add the first IOThread of a domain started without IOThreads", func() {
+		spec := &api.DomainSpec{}
+		disk := newDisk(2)
+		mockDomain.EXPECT().AddIOThread(uint(1), affectDomainLiveAndConfigLibvirtFlags)
+
+		Expect(setHotplugDiskIOThread(mockDomain, spec, disk, newVMI(true))).To(Succeed())
+		Expect(disk.Driver.IOThread).To(HaveValue(Equal(uint(1))))
+	})
+
+	It("should fail if the IOThread can't be added", func() {
+		spec := &api.DomainSpec{}
+		mockDomain.EXPECT().AddIOThread(uint(1), affectDomainLiveAndConfigLibvirtFlags).Return(fmt.Errorf("error"))
+
+		Expect(setHotplugDiskIOThread(mockDomain, spec, newDisk(1), newVMI(true))).ToNot(Succeed())
+	})
+
+	DescribeTable("should keep shared IOThreads", func(ioThreads uint, expectedIOThread *uint) {
+		spec := &api.DomainSpec{IOThreads: &api.IOThreads{IOThreads: ioThreads}}
+		disk := newDisk(1)
+
+		Expect(setHotplugDiskIOThread(mockDomain, spec, disk, newVMI(false))).To(Succeed())
+		Expect(disk.Driver.IOThread).To(Equal(expectedIOThread))
+	},
+		Entry("existing in the domain", uint(1), virtpointer.P(uint(1))),
+		Entry("unless missing from the domain", uint(0), nil),
+	)
+})
+
+var _ = Describe("syncMemoryBalloon", func() {
+	var (
+		mockDomain *cli.MockVirDomain
+		manager    *LibvirtDomainManager
+	)
+
+	BeforeEach(func() {
+		mockDomain = cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		manager = &LibvirtDomainManager{}
+	})
+
+	newVMI := func(balloonRequested string) *v1.VirtualMachineInstance {
+		vmi := api2.NewMinimalVMI("testvmi")
+		vmi.Status.Phase = v1.Running
+		vmi.Status.Memory = &v1.MemoryStatus{}
+		if balloonRequested != "" {
+			vmi.Status.Memory.BalloonRequested = virtpointer.P(resource.MustParse(balloonRequested))
+		}
+		return vmi
+	}
+
+	newSpec := func(currentKiB uint64) *api.DomainSpec {
+		return &api.DomainSpec{CurrentMemory: &api.Memory{Value: currentKiB, Unit: "KiB"}}
+	}
+
+	It("should set the requested balloon target", func() {
+		mockDomain.EXPECT().SetMemoryFlags(uint64(2*1024*1024), libvirt.DOMAIN_MEM_LIVE)
+		Expect(manager.syncMemoryBalloon(newSpec(4*1024*1024), mockDomain, newVMI("2Gi"))).To(Succeed())
+	})
+
+	It("should fail if the balloon target can't be set", func() {
+		mockDomain.EXPECT().SetMemoryFlags(uint64(2*1024*1024), libvirt.DOMAIN_MEM_LIVE).Return(fmt.Errorf("error"))
+		Expect(manager.syncMemoryBalloon(newSpec(4*1024*1024), mockDomain, newVMI("2Gi"))).ToNot(Succeed())
+	})
+
+	DescribeTable("should not touch the balloon", func(spec *api.DomainSpec, vmi *v1.VirtualMachineInstance) {
+		Expect(manager.syncMemoryBalloon(spec, mockDomain, vmi)).To(Succeed())
+	},
+		Entry("without a requested target", newSpec(4*1024*1024), newVMI("")),
+		Entry("when the current memory matches the target", newSpec(2*1024*1024), newVMI("2Gi")),
+	)
+})
+
+var _ = Describe("syncVCPUPinning", func() {
+	var (
+		mockDomain *cli.MockVirDomain
+		manager    *LibvirtDomainManager
+	)
+
+	BeforeEach(func() {
+		mockDomain = cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		manager = &LibvirtDomainManager{
+			cpuSetGetter: func() ([]int, error) { return []int{2, 3, 4, 5}, nil },
+		}
+	})
+
+	newVMI := func(requested ...v1.VCPUPin) *v1.VirtualMachineInstance {
+		vmi := api2.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.CPU = &v1.CPU{DedicatedCPUPlacement: true}
+		vmi.Status.Phase = v1.Running
+		vmi.Status.VCPUPinning = &v1.VCPUPinningStatus{Requested: requested}
+		return vmi
+	}
+
+	newSpec := func() *api.DomainSpec {
+		return &api.DomainSpec{CPUTune: &api.CPUTune{VCPUPin: []api.CPUTuneVCPUPin{
+			{VCPU: 0, CPUSet: "2"},
+			{VCPU: 1, CPUSet: "3"},
+		}}}
+	}
+
+	It("should re-pin the requested vCPUs", func() {
+		mockDomain.EXPECT().PinVcpuFlags(uint(1), []bool{false, false, false, false, true, true}, libvirt.DOMAIN_AFFECT_LIVE)
+		Expect(manager.syncVCPUPinning(newSpec(), mockDomain, newVMI(v1.VCPUPin{VCPU: 0, CPUSet: "2"}, v1.VCPUPin{VCPU: 1, CPUSet: "4-5"}))).To(Succeed())
+	})
+
+	It("should fail if the vCPU can't be pinned", func() {
+		mockDomain.EXPECT().PinVcpuFlags(uint(1), gomock.Any(), libvirt.DOMAIN_AFFECT_LIVE).Return(fmt.Errorf("error"))
+		Expect(manager.syncVCPUPinning(newSpec(), mockDomain, newVMI(v1.VCPUPin{VCPU: 1, CPUSet: "4"}))).ToNot(Succeed())
+	})
+
+	DescribeTable("should reject the requested pinning", func(pin v1.VCPUPin, expectedErr string) {
+		Expect(manager.syncVCPUPinning(newSpec(), mockDomain, newVMI(pin))).To(MatchError(expectedErr))
+	},
+		Entry("with a CPU outside of the pod cpuset", v1.VCPUPin{VCPU: 1, CPUSet: "4-6"},
+			"failed to re-pin vCPU 1: CPU 6 is not assigned to the pod"),
+		Entry("with an unknown vCPU", v1.VCPUPin{VCPU: 2, CPUSet: "4"},
+			"failed to re-pin vCPU 2: the vCPU is not pinned"),
+	)
+
+	It("should not touch the pinning without a request", func() {
+		Expect(manager.syncVCPUPinning(newSpec(), mockDomain, newVMI())).To(Succeed())
+	})
+})
+
+var _ = Describe("syncIOTune", func() {
+	var (
+		mockDomain *cli.MockVirDomain
+		manager    *LibvirtDomainManager
+		vmi        *v1.VirtualMachineInstance
+	)
+
+	BeforeEach(func() {
+		mockDomain = cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		manager = &LibvirtDomainManager{}
+		vmi = api2.NewMinimalVMI("testvmi")
+		vmi.Status.Phase = v1.Running
+	})
+
+	newDomain := func(iotune *api.BlockIOTune) *api.Domain {
+		return &api.Domain{Spec: api.DomainSpec{Devices: api.Devices{Disks: []api.Disk{{
+			Alias:  api.NewUserDefinedAlias("disk0"),
+			Target: api.DiskTarget{Device: "vda"},
+			IOTune: iotune,
+		}}}}}
+	}
+
+	It("should set changed IO limits", func() {
+		oldDomain := newDomain(&api.BlockIOTune{ReadIopsSec: 100})
+		domain := newDomain(&api.BlockIOTune{ReadIopsSec: 200, WriteIopsSecMax: 400, WriteIopsSecMaxLength: 10})
+
+		mockDomain.EXPECT().SetBlockIoTune("vda", gomock.Any(), libvirt.DOMAIN_AFFECT_LIVE|libvirt.DOMAIN_AFFECT_CONFIG).DoAndReturn(
+			func(_ string, params *libvirt.DomainBlockIoTuneParameters, _ libvirt.DomainModificationImpact) error {
+				Expect(params.ReadIopsSecSet).To(BeTrue())
+				Expect(params.ReadIopsSec).To(Equal(uint64(200)))
+				Expect(params.WriteBytesSecSet).To(BeTrue())
+				Expect(params.WriteBytesSec).To(BeZero())
+				Expect(params.WriteIopsSecMax).To(Equal(uint64(400)))
+				Expect(params.WriteIopsSecMaxLengthSet).To(BeTrue())
+				Expect(params.WriteIopsSecMaxLength).To(Equal(uint64(10)))
+				Expect(params.ReadIopsSecMaxLengthSet).To(BeFalse())
+				return nil
+			})
+		Expect(manager.syncIOTune(domain, &oldDomain.Spec, mockDomain, vmi)).To(Succeed())
+	})
+
+	It("should reset removed IO limits", func() {
+		oldDomain := newDomain(&api.BlockIOTune{ReadIopsSec: 100})
+
+		mockDomain.EXPECT().SetBlockIoTune("vda", &libvirt.DomainBlockIoTuneParameters{
+			ReadBytesSecSet:     true,
+			WriteBytesSecSet:    true,
+			ReadIopsSecSet:      true,
+			WriteIopsSecSet:     true,
+			ReadBytesSecMaxSet:  true,
+			WriteBytesSecMaxSet: true,
+			ReadIopsSecMaxSet:   true,
+			WriteIopsSecMaxSet:  true,
+		}, libvirt.DOMAIN_AFFECT_LIVE|libvirt.DOMAIN_AFFECT_CONFIG)
+		Expect(manager.syncIOTune(newDomain(nil), &oldDomain.Spec, mockDomain, vmi)).To(Succeed())
+	})
+
+	It("should fail if the IO limits can't be set", func() {
+		oldDomain := newDomain(nil)
+
+		mockDomain.EXPECT().SetBlockIoTune("vda", gomock.Any(), gomock.Any()).Return(fmt.Errorf("error"))
+		Expect(manager.syncIOTune(newDomain(&api.BlockIOTune{ReadIopsSec: 100}), &oldDomain.Spec, mockDomain, vmi)).ToNot(Succeed())
+	})
+
+	It("should not touch unchanged IO limits", func() {
+		oldDomain := newDomain(&api.BlockIOTune{ReadIopsSec: 100})
+		Expect(manager.syncIOTune(newDomain(&api.BlockIOTune{ReadIopsSec: 100}), &oldDomain.Spec, mockDomain, vmi)).To(Succeed())
+	})
+
+	It("should not touch disks missing from the domain", func() {
+		Expect(manager.syncIOTune(newDomain(&api.BlockIOTune{ReadIopsSec: 100}), &api.DomainSpec{}, mockDomain, vmi)).To(Succeed())
+	})
+})
+
 var _ = Describe("migratableDomXML", func() {
 	var ctrl *gomock.Controller
 	var mockLibvirt *testing.Libvirt
@@ -0,0 +1,181 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+	"time"
+
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+const (
+	kernelMemoryDumpFdName       = "kubevirt-memory-dump"
+	kernelMemoryDumpPollInterval = 1 * time.Second
+	kernelMemoryDumpTimeout      = 1 * time.Hour
+
+	memoryDumpStreamReaderInterval = 100 * time.Millisecond
+	memoryDumpStreamReaderTimeout  = 1 * time.Minute
+)
+
+type queryDumpResponse struct {
+	Return struct {
+		Status string `json:"status"`
+	} `json:"return"`
+}
+
+// memoryDumpContent returns the content requested by the memory dump volume the dump is written to
+func memoryDumpContent(vmi *v1.VirtualMachineInstance, dumpPath string) v1.MemoryDumpContent {
+	if volume := memoryDumpVolume(vmi, dumpPath); volume != nil {
+		return volume.Content
+	}
+	return v1.MemoryDumpContentFull
+}
+
+func coreDumpFormat(format v1.MemoryDumpFormat) libvirt.DomainCoreDumpFormat {
+	switch format {
+	case v1.MemoryDumpFormatKdumpZlib:
+		return libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_ZLIB
+	case v1.MemoryDumpFormatKdumpLzo:
+		return libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_LZO
+	case v1.MemoryDumpFormatKdumpSnappy:
+		return libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_SNAPPY
+	default:
+		return libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW
+	}
+}
+
+// dumpMemory writes the memory of the guest to dumpPath, in the requested format and content
+func dumpMemory(dom cli.VirDomain, dumpPath string, format v1.MemoryDumpFormat, content v1.MemoryDumpContent) error {
+	switch {
+	case format == v1.MemoryDumpFormatState:
+		return saveMemoryState(dom, dumpPath)
+	case content == v1.MemoryDumpContentKernel:
+		return dumpKernelMemory(dom, dumpPath)
+	default:
+		return dom.CoreDumpWithFormat(dumpPath, coreDumpFormat(format), libvirt.DUMP_MEMORY_ONLY)
+	}
+}
+
+// dumpKernelMemory only dumps the memory mapped by the page tables of the guest kernel. libvirt can not
+// filter the memory it dumps, so the dump is taken through the QEMU monitor.
+func dumpKernelMemory(dom cli.VirDomain, dumpPath string) error {
+	file, err := os.OpenFile(dumpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	getfd := fmt.Sprintf(`{"execute":"getfd","arguments":{"fdname":%q}}`, kernelMemoryDumpFdName)
+	if _, _, err := dom.QemuMonitorCommandWithFiles(getfd, []os.File{*file}, libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT); err != nil {
+		return fmt.Errorf("failed to pass the dump file to QEMU: %v", err)
+	}
+	dump := fmt.Sprintf(`{"execute":"dump-guest-memory","arguments":{"paging":true,"detach":true,"protocol":"fd:%s"}}`, kernelMemoryDumpFdName)
+	if _, err := dom.QemuMonitorCommand(dump, libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT); err != nil {
+		return err
+	}
+
+	return virtwait.PollImmediately(kernelMemoryDumpPollInterval, kernelMemoryDumpTimeout, func(_ context.Context) (bool, error) {
+		out, err := dom.QemuMonitorCommand(`{"execute":"query-dump"}`, libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT)
+		if err != nil {
+			return false, err
+		}
+		response := queryDumpResponse{}
+		if err := json.Unmarshal([]byte(out), &response); err != nil {
+			return false, err
+		}
+		switch response.Return.Status {
+		case "completed":
+			return true, nil
+		case "failed":
+			return false, fmt.Errorf("QEMU failed to dump the kernel memory")
+		}
+		return false, nil
+	})
+}
+
+// openMemoryDumpStream waits for virt-handler to open the fifo the dump is streamed through. The fifo is kept
+// open for writing until the dump is done, so that the reader does not see the end of the stream in between.
+func openMemoryDumpStream(dumpPath string) (*os.File, error) {
+	var stream *os.File
+	err := virtwait.PollImmediately(memoryDumpStreamReaderInterval, memoryDumpStreamReaderTimeout, func(_ context.Context) (bool, error) {
+		var err error
+		stream, err = os.OpenFile(dumpPath, os.O_WRONLY|syscall.O_NONBLOCK, 0)
+		if errors.Is(err, syscall.ENXIO) {
+			return false, nil
+		}
+		return err == nil, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("no reader for the memory dump stream: %v", err)
+	}
+	return stream, nil
+}
+
+// StreamMemoryDump dumps the memory of the guest to a fifo created at dumpPath, which virt-handler reads
+// to stream the dump to the client. It returns once the dump is done.
+func (l *LibvirtDomainManager) StreamMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string, options *v1.MemoryDumpStreamOptions) error {
+	if options.Format == v1.MemoryDumpFormatState {
+		return fmt.Errorf("the %s format can not be streamed", v1.MemoryDumpFormatState)
+	}
+
+	select {
+	case l.memoryDumpInProgress <- struct{}{}:
+	default:
+		return fmt.Errorf("a memory dump is already in progress")
+	}
+	defer func() { <-l.memoryDumpInProgress }()
+
+	if err := syscall.Mkfifo(dumpPath, 0600); err != nil {
+		return fmt.Errorf("failed to create the memory dump stream: %v", err)
+	}
+	defer os.Remove(dumpPath)
+
+	stream, err := openMemoryDumpStream(dumpPath)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	dom, err := l.virConn.LookupDomainByName(api.VMINamespaceKeyFunc(vmi))
+	if err != nil {
+		return err
+	}
+	defer dom.Free()
+
+	log.Log.Object(vmi).Infof("Streaming memory dump")
+	if err := dumpMemory(dom, dumpPath, options.Format, options.Content); err != nil {
+		return fmt.Errorf("%s: %v", failedDomainMemoryDump, err)
+	}
+	log.Log.Object(vmi).Infof("Completed memory dump stream successfully")
+	return nil
+}
@@ -0,0 +1,186 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
+	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
+)
+
+const (
+	memoryStateFileSuffix = ".memory.dump"
+
+	memoryStateThawInterval = 5 * time.Second
+	memoryStateThawTimeout  = 5 * time.Minute
+)
+
+type memoryStateSnapshotTarget struct {
+	Snapshot string `xml:"snapshot,attr"`
+	File     string `xml:"file,attr,omitempty"`
+}
+
+type memoryStateSnapshotDisk struct {
+	Name     string `xml:"name,attr"`
+	Snapshot string `xml:"snapshot,attr"`
+}
+
+type memoryStateSnapshot struct {
+	XMLName xml.Name                  `xml:"domainsnapshot"`
+	Memory  memoryStateSnapshotTarget `xml:"memory"`
+	Disks   []memoryStateSnapshotDisk `xml:"disks>disk"`
+}
+
+// memoryDumpVolume returns the memory dump volume the dump is written to
+func memoryDumpVolume(vmi *v1.VirtualMachineInstance, dumpPath string) *v1.MemoryDumpVolumeSource {
+	fileName := filepath.Base(dumpPath)
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.MemoryDump == nil {
+			continue
+		}
+		if strings.HasPrefix(fileName, fmt.Sprintf("%s-%s-", vmi.Name, volume.Name)) {
+			return volume.MemoryDump
+		}
+	}
+	return nil
+}
+
+// memoryDumpFormat returns the format requested by the memory dump volume the dump is written to
+func memoryDumpFormat(vmi *v1.VirtualMachineInstance, dumpPath string) v1.MemoryDumpFormat {
+	if volume := memoryDumpVolume(vmi, dumpPath); volume != nil {
+		return volume.Format
+	}
+	return v1.MemoryDumpFormatRaw
+}
+
+// saveMemoryState writes the memory state of the running domain to dumpPath
+// without snapshotting its disks, the domain keeps running
+func saveMemoryState(dom cli.VirDomain, dumpPath string) error {
+	spec, err := util.GetDomainSpecWithFlags(dom, 0)
+	if err != nil {
+		return err
+	}
+
+	snapshot := memoryStateSnapshot{
+		Memory: memoryStateSnapshotTarget{
+			Snapshot: "external",
+			File:     dumpPath,
+		},
+	}
+	for _, disk := range spec.Devices.Disks {
+		snapshot.Disks = append(snapshot.Disks, memoryStateSnapshotDisk{
+			Name:     disk.Target.Device,
+			Snapshot: "no",
+		})
+	}
+	snapshotXML, err := xml.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	domainSnapshot, err := dom.CreateSnapshotXML(string(snapshotXML), libvirt.DOMAIN_SNAPSHOT_CREATE_LIVE|libvirt.DOMAIN_SNAPSHOT_CREATE_NO_METADATA)
+	if err != nil {
+		return err
+	}
+	if domainSnapshot != nil {
+		return domainSnapshot.Free()
+	}
+	return nil
+}
+
+// memoryStateFile returns the memory state the VMI should resume from, if any
+func memoryStateFile(vmi *v1.VirtualMachineInstance) (string, error) {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.MemoryDump == nil || volume.MemoryDump.Hotpluggable || volume.MemoryDump.Format != v1.MemoryDumpFormatState {
+			continue
+		}
+
+		dir := hostdisk.GetMountedHostDiskDir(volume.Name)
+		files, err := os.ReadDir(dir)
+		if err != nil {
+			return "", err
+		}
+		for _, file := range files {
+			if strings.HasSuffix(file.Name(), memoryStateFileSuffix) {
+				return filepath.Join(dir, file.Name()), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// restoreMemoryState starts the domain from the memory state, the state is consumed
+// so the VMI boots normally the next time it is started
+func (l *LibvirtDomainManager) restoreMemoryState(vmi *v1.VirtualMachineInstance, dom cli.VirDomain, stateFile string) error {
+	logger := log.Log.Object(vmi)
+
+	domainXML, err := dom.GetXMLDesc(libvirt.DOMAIN_XML_SECURE)
+	if err != nil {
+		return err
+	}
+
+	flags := libvirt.DOMAIN_SAVE_RUNNING
+	if vmi.ShouldStartPaused() {
+		flags = libvirt.DOMAIN_SAVE_PAUSED
+	}
+	if err := l.virConn.DomainRestoreFlags(stateFile, domainXML, flags); err != nil {
+		logger.Reason(err).Errorf("Failed to resume VirtualMachineInstance from memory state %s.", stateFile)
+		return err
+	}
+	logger.Infof("Domain resumed from memory state %s.", stateFile)
+
+	if err := os.Remove(stateFile); err != nil {
+		logger.Reason(err).Warningf("Failed to remove memory state %s", stateFile)
+	}
+	if vmi.ShouldStartPaused() {
+		l.paused.add(vmi.UID)
+	}
+
+	// the memory state was saved while the guest filesystems were frozen
+	go l.thawRestoredMemoryState(vmi)
+	return nil
+}
+
+func (l *LibvirtDomainManager) thawRestoredMemoryState(vmi *v1.VirtualMachineInstance) {
+	err := virtwait.PollImmediately(memoryStateThawInterval, memoryStateThawTimeout, func(_ context.Context) (bool, error) {
+		if err := l.UnfreezeVMI(vmi); err != nil {
+			log.Log.Object(vmi).V(3).Infof("Unable to thaw the filesystems after resuming from memory state: %v", err)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to thaw the filesystems after resuming from memory state")
+	}
+}
@@ -149,6 +149,18 @@ type DomainStatsBlock struct {
 	Capacity        uint64
 	PhysicalSet     bool
 	Physical        uint64
+	// extra stats
+	RdLatencyHistogram *DomainStatsLatencyHistogram
+	WrLatencyHistogram *DomainStatsLatencyHistogram
+	FlLatencyHistogram *DomainStatsLatencyHistogram
+}
+
+// DomainStatsLatencyHistogram is the latency histogram of the requests to a disk, as collected by QEMU.
+// Bins[i] counts the requests with a latency between Boundaries[i-1] and Boundaries[i] nanoseconds,
+// the last bin counts the requests above the last boundary.
+type DomainStatsLatencyHistogram struct {
+	Boundaries []uint64
+	Bins       []uint64
 }
 
 // mimic existing structs, but data is taken from
@@ -191,6 +203,10 @@ type DomainJobInfo struct {
 	DataRemaining    uint64
 	MemDirtyRateSet  bool
 	MemDirtyRate     uint64
+	DowntimeSet      bool
+	Downtime         uint64
+	MemIterationSet  bool
+	MemIteration     uint64
 }
 
 type DomainStatsDirtyRate struct {
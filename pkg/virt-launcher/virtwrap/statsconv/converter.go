@@ -233,6 +233,10 @@ func Convert_libvirt_DomainJobInfo_To_stats_DomainJobInfo(info *libvirt.DomainJo
 		DataRemaining:    info.DataRemaining,
 		MemDirtyRateSet:  info.MemDirtyRateSet && info.MemPageSizeSet,
 		MemDirtyRate:     info.MemDirtyRate * info.MemPageSize,
+		DowntimeSet:      info.DowntimeSet,
+		Downtime:         info.Downtime,
+		MemIterationSet:  info.MemIterationSet,
+		MemIteration:     info.MemIteration,
 	}
 }
 
@@ -156,7 +156,10 @@ var Testdataexpected = `{
        "WrReqs": 9949, 
        "WrReqsSet": true, 
        "WrTimes": 1374368654, 
-       "WrTimesSet": true
+       "WrTimesSet": true,
+       "RdLatencyHistogram": null,
+       "WrLatencyHistogram": null,
+       "FlLatencyHistogram": null
      }
    ], 
    "Cpu": {
@@ -201,7 +204,11 @@ var Testdataexpected = `{
      "MemDirtyRate": 0,
      "MemDirtyRateSet": false,
      "MemoryBpsSet": false,
-     "MemoryBps": 0
+     "MemoryBps": 0,
+     "DowntimeSet": false,
+     "Downtime": 0,
+     "MemIterationSet": false,
+     "MemIteration": 0
    },
    "Name": "testName", 
    "Net": [
@@ -0,0 +1,255 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virtiofs"
+)
+
+const virtiofsdSocketTimeout = 10 * time.Second
+
+// virtiofsdProcess is a virtiofsd instance serving a hotplugged filesystem
+type virtiofsdProcess interface {
+	Stop() error
+}
+
+type virtiofsdStartFunc func(socketPath, sharedDir string, fs *v1.FilesystemVirtiofs) (virtiofsdProcess, error)
+
+type execVirtiofsd struct {
+	cmd    *exec.Cmd
+	exited chan struct{}
+}
+
+func (p *execVirtiofsd) Stop() error {
+	select {
+	case <-p.exited:
+		return nil
+	default:
+	}
+	if err := p.cmd.Process.Kill(); err != nil {
+		return err
+	}
+	<-p.exited
+	return nil
+}
+
+// startVirtiofsd starts virtiofsd in the compute container, since the filesystems
+// hotplugged into a running VMI have no virtiofs container of their own.
+func startVirtiofsd(socketPath, sharedDir string, fs *v1.FilesystemVirtiofs) (virtiofsdProcess, error) {
+	if err := os.MkdirAll(filepath.Dir(socketPath), 0750); err != nil {
+		return nil, err
+	}
+	// virtiofsd refuses to start on the socket of a previous instance
+	if err := os.Remove(socketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	cmd := exec.Command(virtiofs.VirtiofsdPath, virtiofs.VirtiofsdArgs(socketPath, sharedDir, fs)...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start virtiofsd for %s: %v", sharedDir, err)
+	}
+
+	p := &execVirtiofsd{cmd: cmd, exited: make(chan struct{})}
+	go func() {
+		_ = cmd.Wait()
+		close(p.exited)
+	}()
+
+	if err := waitForVirtiofsdSocket(socketPath, p.exited); err != nil {
+		_ = p.Stop()
+		return nil, err
+	}
+	if err := diskutils.DefaultOwnershipManager.UnsafeSetFileOwnership(socketPath); err != nil {
+		_ = p.Stop()
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func waitForVirtiofsdSocket(socketPath string, exited chan struct{}) error {
+	timeout := time.After(virtiofsdSocketTimeout)
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		if _, err := os.Stat(socketPath); err == nil {
+			return nil
+		}
+		select {
+		case <-exited:
+			return fmt.Errorf("virtiofsd exited before creating socket %s", socketPath)
+		case <-timeout:
+			return fmt.Errorf("timed out waiting for virtiofsd socket %s", socketPath)
+		case <-ticker.C:
+		}
+	}
+}
+
+func isHotplugFilesystem(fs api.FilesystemDevice) bool {
+	return fs.Source != nil && fs.Target != nil && fs.Source.Socket == virtiofs.HotplugVirtioFSSocketPath(fs.Target.Dir)
+}
+
+// startHotplugVirtiofsd starts virtiofsd for the hotplugged filesystems of the domain
+// which aren't served yet. It has to run before the filesystems are attached.
+// Must be called with domainModifyLock held.
+func (l *LibvirtDomainManager) startHotplugVirtiofsd(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
+	vmiFilesystems := map[string]*v1.Filesystem{}
+	for i := range vmi.Spec.Domain.Devices.Filesystems {
+		vmiFilesystems[vmi.Spec.Domain.Devices.Filesystems[i].Name] = &vmi.Spec.Domain.Devices.Filesystems[i]
+	}
+
+	for _, fs := range domain.Spec.Devices.Filesystems {
+		if !isHotplugFilesystem(fs) {
+			continue
+		}
+		name := fs.Target.Dir
+		if _, running := l.virtiofsdProcesses[name]; running {
+			continue
+		}
+		vmiFilesystem, exists := vmiFilesystems[name]
+		if !exists {
+			continue
+		}
+
+		log.Log.Object(vmi).V(1).Infof("Starting virtiofsd for hotplugged filesystem %s", name)
+		p, err := l.startVirtiofsd(fs.Source.Socket, hotplugdisk.GetVolumeMountDir(name), vmiFilesystem.Virtiofs)
+		if err != nil {
+			return err
+		}
+		l.virtiofsdProcesses[name] = p
+	}
+
+	return nil
+}
+
+// syncFilesystems attaches and detaches the hotplugged filesystems of the running domain.
+// Must be called with domainModifyLock held.
+func (l *LibvirtDomainManager) syncFilesystems(
+	domain *api.Domain,
+	spec *api.DomainSpec,
+	dom cli.VirDomain,
+	vmi *v1.VirtualMachineInstance,
+) error {
+	logger := log.Log.Object(vmi)
+
+	for _, detachFilesystem := range getDetachedFilesystems(spec.Devices.Filesystems, domain.Spec.Devices.Filesystems) {
+		logger.V(1).Infof("Detaching filesystem %s", detachFilesystem.Target.Dir)
+		detachBytes, err := xml.Marshal(detachFilesystem)
+		if err != nil {
+			logger.Reason(err).Error("marshalling detached filesystem failed")
+			return err
+		}
+		err = dom.DetachDeviceFlags(strings.ToLower(string(detachBytes)), affectDeviceLiveAndConfigLibvirtFlags)
+		if err != nil {
+			logger.Reason(err).Error("detaching filesystem")
+			return err
+		}
+	}
+
+	for _, attachFilesystem := range getAttachedFilesystems(spec.Devices.Filesystems, domain.Spec.Devices.Filesystems) {
+		logger.V(1).Infof("Attaching filesystem %s", attachFilesystem.Target.Dir)
+		attachBytes, err := xml.Marshal(attachFilesystem)
+		if err != nil {
+			logger.Reason(err).Error("marshalling attached filesystem failed")
+			return err
+		}
+		err = dom.AttachDeviceFlags(strings.ToLower(string(attachBytes)), affectDeviceLiveAndConfigLibvirtFlags)
+		if err != nil {
+			logger.Reason(err).Error("attaching filesystem")
+			return err
+		}
+	}
+
+	desired := map[string]struct{}{}
+	for _, fs := range domain.Spec.Devices.Filesystems {
+		if isHotplugFilesystem(fs) {
+			desired[fs.Target.Dir] = struct{}{}
+		}
+	}
+	for name, p := range l.virtiofsdProcesses {
+		if _, exists := desired[name]; exists {
+			continue
+		}
+		logger.V(1).Infof("Stopping virtiofsd of unplugged filesystem %s", name)
+		if err := p.Stop(); err != nil {
+			logger.Reason(err).Errorf("failed to stop virtiofsd of filesystem %s", name)
+			return err
+		}
+		delete(l.virtiofsdProcesses, name)
+	}
+
+	return nil
+}
+
+func getDetachedFilesystems(oldFilesystems, newFilesystems []api.FilesystemDevice) []api.FilesystemDevice {
+	newFilesystemMap := make(map[string]struct{})
+	for _, fs := range newFilesystems {
+		if fs.Target != nil {
+			newFilesystemMap[fs.Target.Dir] = struct{}{}
+		}
+	}
+	res := make([]api.FilesystemDevice, 0)
+	for _, oldFilesystem := range oldFilesystems {
+		if !isHotplugFilesystem(oldFilesystem) {
+			continue
+		}
+		if _, ok := newFilesystemMap[oldFilesystem.Target.Dir]; !ok {
+			res = append(res, oldFilesystem)
+		}
+	}
+	return res
+}
+
+func getAttachedFilesystems(oldFilesystems, newFilesystems []api.FilesystemDevice) []api.FilesystemDevice {
+	oldFilesystemMap := make(map[string]struct{})
+	for _, fs := range oldFilesystems {
+		if fs.Target != nil {
+			oldFilesystemMap[fs.Target.Dir] = struct{}{}
+		}
+	}
+	res := make([]api.FilesystemDevice, 0)
+	for _, newFilesystem := range newFilesystems {
+		if !isHotplugFilesystem(newFilesystem) {
+			continue
+		}
+		if _, ok := oldFilesystemMap[newFilesystem.Target.Dir]; !ok {
+			res = append(res, newFilesystem)
+		}
+	}
+	return res
+}
@@ -478,6 +478,10 @@ func (app *VirtOperatorApp) prepareCertManagers() {
 }
 
 func (app *VirtOperatorApp) shouldChangeLogVerbosity() {
+	if err := log.SetFormat(string(app.clusterConfig.GetLogFormat())); err != nil {
+		log.Log.Warningf("failed to update the log format: %v", err)
+	}
+	log.SetSubcomponentVerbosity(app.clusterConfig.GetSubcomponentVerbosity())
 	verbosity := app.clusterConfig.GetVirtOperatorVerbosity(app.host)
 	if err := log.Log.SetVerbosityLevel(int(verbosity)); err != nil {
 		log.Log.Warningf("failed up update log verbosity to %d: %v", verbosity, err)
@@ -88,8 +88,8 @@ const (
 
 	NAMESPACE = "kubevirt-test"
 
-	resourceCount = 85
-	patchCount    = 53
+	resourceCount = 99
+	patchCount    = 67
 	updateCount   = 33
 )
 
@@ -1242,9 +1242,11 @@ func (k *KubeVirtTestData) addAllWithExclusionMap(config *util.KubeVirtDeploymen
 		components.NewVirtualMachineSnapshotCrd, components.NewVirtualMachineSnapshotContentCrd,
 		components.NewVirtualMachineExportCrd,
 		components.NewVirtualMachineRestoreCrd, components.NewVirtualMachineInstancetypeCrd,
-		components.NewVirtualMachineClusterInstancetypeCrd, components.NewVirtualMachinePoolCrd,
-		components.NewMigrationPolicyCrd, components.NewVirtualMachinePreferenceCrd,
-		components.NewVirtualMachineClusterPreferenceCrd, components.NewVirtualMachineCloneCrd,
+		components.NewVirtualMachineClusterInstancetypeCrd, components.NewVirtualMachinePoolCrd, components.NewVirtualMachineAutoscalingPolicyCrd, components.NewVirtualMachineResourceQuotaCrd, components.NewVirtualMachineDisruptionBudgetCrd,
+		components.NewMigrationPolicyCrd, components.NewVolumeMigrationCrd, components.NewMigrationRetryBudgetCrd, components.NewMigrationRebalancePolicyCrd, components.NewVirtualMachinePreferenceCrd,
+		components.NewVirtualMachineClusterPreferenceCrd, components.NewVirtualMachineDefaultCrd, components.NewVirtualMachineClusterDefaultCrd, components.NewVirtualMachineCloneCrd,
+		components.NewVirtualMachineSnapshotScheduleCrd, components.NewVirtualMachineSnapshotExportCrd, components.NewVirtualMachineSnapshotReplicationCrd, components.NewVirtualMachineImportCrd,
+		components.NewVirtualMachineSnapshotGroupCrd, components.NewVirtualMachineSnapshotGroupRestoreCrd,
 	}
 	for _, f := range functions {
 		crd, err := f()
@@ -2453,7 +2455,7 @@ var _ = Describe("KubeVirt Operator", func() {
 			Expect(kvTestData.controller.stores.ClusterRoleBindingCache.List()).To(HaveLen(8))
 			Expect(kvTestData.controller.stores.RoleCache.List()).To(HaveLen(6))
 			Expect(kvTestData.controller.stores.RoleBindingCache.List()).To(HaveLen(6))
-			Expect(kvTestData.controller.stores.OperatorCrdCache.List()).To(HaveLen(16))
+			Expect(kvTestData.controller.stores.OperatorCrdCache.List()).To(HaveLen(30))
 			Expect(kvTestData.controller.stores.ServiceCache.List()).To(HaveLen(4))
 			Expect(kvTestData.controller.stores.DeploymentCache.List()).To(HaveLen(1))
 			Expect(kvTestData.controller.stores.DaemonSetCache.List()).To(BeEmpty())
@@ -3503,6 +3505,7 @@ func getDefaultVirtHandlerDaemonSet(namespace string, config *util.KubeVirtDeplo
 		config.GetImagePullPolicy(),
 		config.GetImagePullSecrets(),
 		nil,
+		nil,
 		config.GetVerbosity(),
 		config.GetExtraEnv(),
 		false)
@@ -3,6 +3,7 @@ package apply
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/openshift/library-go/pkg/operator/resource/resourcemerge"
@@ -18,6 +19,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	kutil "kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/placement"
 	"kubevirt.io/kubevirt/pkg/virt-operator/util"
@@ -25,6 +27,7 @@ import (
 
 const (
 	failedUpdateDaemonSetReason = "FailedUpdate"
+	sessionRecordingsVolumeName = "session-recordings"
 )
 
 var (
@@ -70,6 +73,12 @@ func (r *Reconciler) syncDeployment(origDeployment *appsv1.Deployment) (*appsv1.
 		}
 	}
 
+	if deployment.Name == components.VirtAPIName {
+		if err := setSessionRecordingVolume(r.clientset, kv, deployment); err != nil {
+			return nil, err
+		}
+	}
+
 	obj, exists, _ := r.stores.DeploymentCache.Get(deployment)
 	if !exists {
 		r.expectations.Deployment.RaiseExpectations(r.kvKey, 1, 0)
@@ -327,6 +336,39 @@ func setMaxDevices(kv *v1.KubeVirt, vh *appsv1.DaemonSet) {
 		fmt.Sprintf("%d", *kv.Spec.Configuration.VirtualMachineInstancesPerNode))
 }
 
+func setSessionRecordingVolume(clientset kubecli.KubevirtClient, kv *v1.KubeVirt, virtAPI *appsv1.Deployment) error {
+	sessionRecording := kv.Spec.Configuration.SessionRecording
+	if sessionRecording == nil || sessionRecording.PersistentVolumeClaimName == "" {
+		return nil
+	}
+
+	// The claim is shared by all the replicas of virt-api, which may run on different nodes
+	if virtAPI.Spec.Replicas != nil && *virtAPI.Spec.Replicas > 1 {
+		pvc, err := clientset.CoreV1().PersistentVolumeClaims(kv.Namespace).Get(context.Background(), sessionRecording.PersistentVolumeClaimName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("unable to get session recording claim %s: %v", sessionRecording.PersistentVolumeClaimName, err)
+		}
+		if !slices.Contains(pvc.Spec.AccessModes, corev1.ReadWriteMany) {
+			return fmt.Errorf("session recording claim %s has to support %s with %d virt-api replicas", pvc.Name, corev1.ReadWriteMany, *virtAPI.Spec.Replicas)
+		}
+	}
+
+	pod := &virtAPI.Spec.Template.Spec
+	pod.Volumes = append(pod.Volumes, corev1.Volume{
+		Name: sessionRecordingsVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: sessionRecording.PersistentVolumeClaimName,
+			},
+		},
+	})
+	pod.Containers[0].VolumeMounts = append(pod.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      sessionRecordingsVolumeName,
+		MountPath: kutil.VirtSessionRecordingsDir,
+	})
+	return nil
+}
+
 func (r *Reconciler) syncPodDisruptionBudgetForDeployment(deployment *appsv1.Deployment) error {
 	kv := r.kv
 	podDisruptionBudget := components.NewPodDisruptionBudgetForDeployment(deployment)
@@ -50,6 +50,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	kutil "kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/rbac"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/placement"
@@ -345,6 +346,7 @@ var _ = Describe("Apply Apps", func() {
 				virtHandlerConfig.GetImagePullPolicy(),
 				virtHandlerConfig.GetImagePullSecrets(),
 				nil,
+				nil,
 				virtHandlerConfig.GetVerbosity(),
 				virtHandlerConfig.GetExtraEnv(),
 				false)
@@ -1279,6 +1281,86 @@ var _ = Describe("Apply Apps", func() {
 			Expect(updatedDeploy.Annotations).ToNot(HaveKey(fakeAnnotation))
 		})
 
+		createRecordingsClaim := func(accessMode corev1.PersistentVolumeAccessMode) {
+			_, err := dpClient.CoreV1().PersistentVolumeClaims(Namespace).Create(context.TODO(), &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "recordings", Namespace: Namespace},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes: []corev1.PersistentVolumeAccessMode{accessMode},
+				},
+			}, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		It("should mount the session recording claim into virt-api", func() {
+			kv.Spec.Configuration.SessionRecording = &v1.SessionRecordingConfiguration{
+				Sessions:                  []v1.SessionRecordingType{v1.ConsoleSessionRecording},
+				PersistentVolumeClaimName: "recordings",
+			}
+			createRecordingsClaim(corev1.ReadWriteMany)
+			r := &Reconciler{
+				clientset:    clientset,
+				kv:           kv,
+				expectations: &util.Expectations{},
+				stores:       stores,
+			}
+
+			updatedDeployment, err := r.syncDeployment(virtAPIDeployment)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedDeployment.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name: sessionRecordingsVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "recordings"},
+				},
+			}))
+			Expect(updatedDeployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:      sessionRecordingsVolumeName,
+				MountPath: kutil.VirtSessionRecordingsDir,
+			}))
+		})
+
+		It("should refuse a session recording claim which does not support ReadWriteMany with several virt-api replicas", func() {
+			kv.Spec.Configuration.SessionRecording = &v1.SessionRecordingConfiguration{
+				Sessions:                  []v1.SessionRecordingType{v1.ConsoleSessionRecording},
+				PersistentVolumeClaimName: "recordings",
+			}
+			createRecordingsClaim(corev1.ReadWriteOnce)
+			createFakeNodes(dpClient, 2)
+			r := &Reconciler{
+				clientset:    clientset,
+				kv:           kv,
+				expectations: &util.Expectations{},
+				stores:       stores,
+			}
+
+			_, err := r.syncDeployment(virtAPIDeployment)
+			Expect(err).To(MatchError(ContainSubstring("session recording claim recordings has to support ReadWriteMany")))
+		})
+
+		It("should not mount the session recording claim into other deployments", func() {
+			kv.Spec.Configuration.SessionRecording = &v1.SessionRecordingConfiguration{
+				PersistentVolumeClaimName: "recordings",
+			}
+			kv.Status.Generations = []v1.GenerationStatus{{
+				Group:          "apps",
+				Resource:       "deployments",
+				Namespace:      strategyDeployment.Namespace,
+				Name:           strategyDeployment.Name,
+				LastGeneration: cachedDeployment.Generation - 1,
+			}}
+			r := &Reconciler{
+				clientset:    clientset,
+				kv:           kv,
+				expectations: &util.Expectations{},
+				stores:       stores,
+			}
+
+			updatedDeployment, err := r.syncDeployment(strategyDeployment)
+			Expect(err).ToNot(HaveOccurred())
+			for _, volume := range updatedDeployment.Spec.Template.Spec.Volumes {
+				Expect(volume.Name).ToNot(Equal(sessionRecordingsVolumeName))
+			}
+		})
+
 		DescribeTable("should calculate correct replicas for deployments based on node count", func(nodesCount int, expectedReplicas int) {
 			createFakeNodes(dpClient, nodesCount)
 
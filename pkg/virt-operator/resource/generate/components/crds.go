@@ -37,6 +37,7 @@ import (
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	autoscalingv1alpha1 "kubevirt.io/api/autoscaling/v1alpha1"
 	virtv1 "kubevirt.io/api/core/v1"
 	exportv1alpha1 "kubevirt.io/api/export/v1alpha1"
 	exportv1beta1 "kubevirt.io/api/export/v1beta1"
@@ -44,8 +45,10 @@ import (
 	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	quotav1alpha1 "kubevirt.io/api/quota/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
 	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
+	importv1beta1 "kubevirt.io/api/vmimport/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
 )
@@ -57,18 +60,30 @@ const (
 )
 
 var (
-	VIRTUALMACHINE                   = "virtualmachines." + virtv1.VirtualMachineInstanceGroupVersionKind.Group
-	VIRTUALMACHINEINSTANCE           = "virtualmachineinstances." + virtv1.VirtualMachineInstanceGroupVersionKind.Group
-	VIRTUALMACHINEINSTANCEPRESET     = "virtualmachineinstancepresets." + virtv1.VirtualMachineInstancePresetGroupVersionKind.Group
-	VIRTUALMACHINEINSTANCEREPLICASET = "virtualmachineinstancereplicasets." + virtv1.VirtualMachineInstanceReplicaSetGroupVersionKind.Group
-	VIRTUALMACHINEINSTANCEMIGRATION  = "virtualmachineinstancemigrations." + virtv1.VirtualMachineInstanceMigrationGroupVersionKind.Group
-	KUBEVIRT                         = "kubevirts." + virtv1.KubeVirtGroupVersionKind.Group
-	VIRTUALMACHINEPOOL               = "virtualmachinepools." + poolv1.SchemeGroupVersion.Group
-	VIRTUALMACHINESNAPSHOT           = "virtualmachinesnapshots." + snapshotv1beta1.SchemeGroupVersion.Group
-	VIRTUALMACHINESNAPSHOTCONTENT    = "virtualmachinesnapshotcontents." + snapshotv1beta1.SchemeGroupVersion.Group
-	VIRTUALMACHINEEXPORT             = "virtualmachineexports." + exportv1beta1.SchemeGroupVersion.Group
-	MIGRATIONPOLICY                  = "migrationpolicies." + migrationsv1.MigrationPolicyKind.Group
-	VIRTUALMACHINECLONE              = "virtualmachineclones." + clone.GroupName
+	VIRTUALMACHINE                     = "virtualmachines." + virtv1.VirtualMachineInstanceGroupVersionKind.Group
+	VIRTUALMACHINEINSTANCE             = "virtualmachineinstances." + virtv1.VirtualMachineInstanceGroupVersionKind.Group
+	VIRTUALMACHINEINSTANCEPRESET       = "virtualmachineinstancepresets." + virtv1.VirtualMachineInstancePresetGroupVersionKind.Group
+	VIRTUALMACHINEINSTANCEREPLICASET   = "virtualmachineinstancereplicasets." + virtv1.VirtualMachineInstanceReplicaSetGroupVersionKind.Group
+	VIRTUALMACHINEINSTANCEMIGRATION    = "virtualmachineinstancemigrations." + virtv1.VirtualMachineInstanceMigrationGroupVersionKind.Group
+	KUBEVIRT                           = "kubevirts." + virtv1.KubeVirtGroupVersionKind.Group
+	VIRTUALMACHINEPOOL                 = "virtualmachinepools." + poolv1.SchemeGroupVersion.Group
+	VIRTUALMACHINEAUTOSCALINGPOLICY    = "virtualmachineautoscalingpolicies." + autoscalingv1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINERESOURCEQUOTA        = "virtualmachineresourcequotas." + quotav1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINEDISRUPTIONBUDGET     = "virtualmachinedisruptionbudgets." + migrationsv1.VirtualMachineDisruptionBudgetKind.Group
+	VIRTUALMACHINESNAPSHOT             = "virtualmachinesnapshots." + snapshotv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTCONTENT      = "virtualmachinesnapshotcontents." + snapshotv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTSCHEDULE     = "virtualmachinesnapshotschedules." + snapshotv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTGROUP        = "virtualmachinesnapshotgroups." + snapshotv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTGROUPRESTORE = "virtualmachinesnapshotgrouprestores." + snapshotv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINEEXPORT               = "virtualmachineexports." + exportv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTEXPORT       = "virtualmachinesnapshotexports." + exportv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTREPLICATION  = "virtualmachinesnapshotreplications." + exportv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINEIMPORT               = "virtualmachineimports." + importv1beta1.SchemeGroupVersion.Group
+	MIGRATIONPOLICY                    = "migrationpolicies." + migrationsv1.MigrationPolicyKind.Group
+	VOLUMEMIGRATION                    = "volumemigrations." + migrationsv1.VolumeMigrationKind.Group
+	MIGRATIONRETRYBUDGET               = "migrationretrybudgets." + migrationsv1.MigrationRetryBudgetKind.Group
+	MIGRATIONREBALANCEPOLICY           = "migrationrebalancepolicies." + migrationsv1.MigrationRebalancePolicyKind.Group
+	VIRTUALMACHINECLONE                = "virtualmachineclones." + clone.GroupName
 )
 
 func addFieldsToVersion(version *extv1.CustomResourceDefinitionVersion, fields ...interface{}) error {
@@ -444,6 +459,141 @@ func NewVirtualMachinePoolCrd() (*extv1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewVirtualMachineAutoscalingPolicyCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEAUTOSCALINGPOLICY
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: autoscalingv1alpha1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    autoscalingv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachineautoscalingpolicies",
+			Singular:   "virtualmachineautoscalingpolicy",
+			Kind:       autoscalingv1alpha1.VirtualMachineAutoscalingPolicyKind,
+			ShortNames: []string{"vmap", "vmaps"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+
+	err := addFieldsToAllVersions(crd,
+		[]extv1.CustomResourceColumnDefinition{
+			{Name: "MinSockets", Type: "integer", JSONPath: ".spec.cpu.minSockets"},
+			{Name: "MaxSockets", Type: "integer", JSONPath: ".spec.cpu.maxSockets"},
+			{Name: "MinMemory", Type: "string", JSONPath: ".spec.memory.min"},
+			{Name: "MaxMemory", Type: "string", JSONPath: ".spec.memory.max"},
+			{Name: "Age", Type: "date", JSONPath: creationTimestampJSONPath},
+		}, &extv1.CustomResourceSubresources{
+			Status: &extv1.CustomResourceSubresourceStatus{},
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineResourceQuotaCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINERESOURCEQUOTA
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: quotav1alpha1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    quotav1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachineresourcequotas",
+			Singular:   "virtualmachineresourcequota",
+			Kind:       quotav1alpha1.VirtualMachineResourceQuotaKind,
+			ShortNames: []string{"vmquota", "vmquotas"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+
+	err := addFieldsToAllVersions(crd,
+		[]extv1.CustomResourceColumnDefinition{
+			{Name: "UsedCPU", Type: "string", JSONPath: ".status.used.guest\\.cpu"},
+			{Name: "HardCPU", Type: "string", JSONPath: ".status.hard.guest\\.cpu"},
+			{Name: "UsedMemory", Type: "string", JSONPath: ".status.used.guest\\.memory"},
+			{Name: "HardMemory", Type: "string", JSONPath: ".status.hard.guest\\.memory"},
+			{Name: "Age", Type: "date", JSONPath: creationTimestampJSONPath},
+		}, &extv1.CustomResourceSubresources{
+			Status: &extv1.CustomResourceSubresourceStatus{},
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineDisruptionBudgetCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEDISRUPTIONBUDGET
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: migrationsv1.VirtualMachineDisruptionBudgetKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    migrationsv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     migrations.ResourceVMDisruptionBudgets,
+			Singular:   "virtualmachinedisruptionbudget",
+			Kind:       migrationsv1.VirtualMachineDisruptionBudgetKind.Kind,
+			ShortNames: []string{"vmdb", "vmdbs"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+
+	err := addFieldsToAllVersions(crd,
+		[]extv1.CustomResourceColumnDefinition{
+			{Name: "MaxUnavailable", Type: "string", JSONPath: ".spec.maxUnavailable"},
+			{Name: "Expected", Type: "integer", JSONPath: ".status.expectedVirtualMachineInstances"},
+			{Name: "Healthy", Type: "integer", JSONPath: ".status.currentHealthy"},
+			{Name: "Allowed", Type: "integer", JSONPath: ".status.disruptionsAllowed"},
+			{Name: "Age", Type: "date", JSONPath: creationTimestampJSONPath},
+		}, &extv1.CustomResourceSubresources{
+			Status: &extv1.CustomResourceSubresourceStatus{},
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineSnapshotCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
@@ -607,6 +757,144 @@ func NewVirtualMachineRestoreCrd() (*extv1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewVirtualMachineSnapshotScheduleCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESNAPSHOTSCHEDULE
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1beta1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1beta1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotschedules",
+			Singular:   "virtualmachinesnapshotschedule",
+			Kind:       "VirtualMachineSnapshotSchedule",
+			ShortNames: []string{"vmsnapshotschedule", "vmsnapshotschedules"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "SourceKind", Type: "string", JSONPath: ".spec.source.kind"},
+		{Name: "SourceName", Type: "string", JSONPath: ".spec.source.name"},
+		{Name: "Schedule", Type: "string", JSONPath: ".spec.schedule"},
+		{Name: "LastScheduleTime", Type: "date", JSONPath: ".status.lastScheduleTime"},
+		{Name: "Error", Type: "string", JSONPath: errorMessageJSONPath},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineSnapshotGroupCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESNAPSHOTGROUP
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1beta1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1beta1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotgroups",
+			Singular:   "virtualmachinesnapshotgroup",
+			Kind:       "VirtualMachineSnapshotGroup",
+			ShortNames: []string{"vmsnapshotgroup", "vmsnapshotgroups"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Phase", Type: "string", JSONPath: phaseJSONPath},
+		{Name: "ReadyToUse", Type: "boolean", JSONPath: ".status.readyToUse"},
+		{Name: "CreationTime", Type: "date", JSONPath: ".status.creationTime"},
+		{Name: "Error", Type: "string", JSONPath: errorMessageJSONPath},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineSnapshotGroupRestoreCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESNAPSHOTGROUPRESTORE
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1beta1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1beta1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotgrouprestores",
+			Singular:   "virtualmachinesnapshotgrouprestore",
+			Kind:       "VirtualMachineSnapshotGroupRestore",
+			ShortNames: []string{"vmsnapshotgrouprestore", "vmsnapshotgrouprestores"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "SnapshotGroupName", Type: "string", JSONPath: ".spec.virtualMachineSnapshotGroupName"},
+		{Name: "Complete", Type: "boolean", JSONPath: ".status.complete"},
+		{Name: "RestoreTime", Type: "date", JSONPath: ".status.restoreTime"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineExportCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
@@ -660,6 +948,139 @@ func NewVirtualMachineExportCrd() (*extv1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewVirtualMachineSnapshotExportCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESNAPSHOTEXPORT
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: exportv1beta1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    exportv1beta1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotexports",
+			Singular:   "virtualmachinesnapshotexport",
+			Kind:       "VirtualMachineSnapshotExport",
+			ShortNames: []string{"vmsnapshotexport", "vmsnapshotexports"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "SnapshotName", Type: "string", JSONPath: ".spec.virtualMachineSnapshotName"},
+		{Name: "Provider", Type: "string", JSONPath: ".spec.destination.provider"},
+		{Name: "Phase", Type: "string", JSONPath: phaseJSONPath},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineSnapshotReplicationCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESNAPSHOTREPLICATION
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: exportv1beta1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    exportv1beta1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotreplications",
+			Singular:   "virtualmachinesnapshotreplication",
+			Kind:       "VirtualMachineSnapshotReplication",
+			ShortNames: []string{"vmsnapshotreplication", "vmsnapshotreplications"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "SnapshotName", Type: "string", JSONPath: ".spec.virtualMachineSnapshotName"},
+		{Name: "Phase", Type: "string", JSONPath: phaseJSONPath},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineImportCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEIMPORT
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: importv1beta1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    importv1beta1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachineimports",
+			Singular:   "virtualmachineimport",
+			Kind:       "VirtualMachineImport",
+			ShortNames: []string{"vmimport", "vmimports"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Format", Type: "string", JSONPath: ".spec.source.format"},
+		{Name: "Phase", Type: "string", JSONPath: phaseJSONPath},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineInstancetypeCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
@@ -826,6 +1247,64 @@ func NewVirtualMachineClusterPreferenceCrd() (*extv1.CustomResourceDefinition, e
 	return crd, nil
 }
 
+func NewVirtualMachineDefaultCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.Name = "virtualmachinedefaults." + instancetypev1beta1.SchemeGroupVersion.Group
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: instancetypev1beta1.SchemeGroupVersion.Group,
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     instancetype.PluralDefaultResourceName,
+			Singular:   instancetype.SingularDefaultResourceName,
+			ShortNames: []string{"vmdefault", "vmdefaults"},
+			Kind:       "VirtualMachineDefault",
+		},
+		Scope: extv1.NamespaceScoped,
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Versions: []extv1.CustomResourceDefinitionVersion{{
+			Name:    instancetypev1beta1.SchemeGroupVersion.Version,
+			Served:  true,
+			Storage: true,
+		}},
+	}
+
+	if err := patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineClusterDefaultCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.Name = "virtualmachineclusterdefaults." + instancetypev1beta1.SchemeGroupVersion.Group
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: instancetypev1beta1.SchemeGroupVersion.Group,
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     instancetype.ClusterPluralDefaultResourceName,
+			Singular:   instancetype.ClusterSingularDefaultResourceName,
+			ShortNames: []string{"vmcdefault", "vmcdefaults"},
+			Kind:       "VirtualMachineClusterDefault",
+		},
+		Scope: extv1.ClusterScoped,
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Versions: []extv1.CustomResourceDefinitionVersion{{
+			Name:    instancetypev1beta1.SchemeGroupVersion.Version,
+			Served:  true,
+			Storage: true,
+		}},
+	}
+
+	if err := patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewMigrationPolicyCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
@@ -860,6 +1339,126 @@ func NewMigrationPolicyCrd() (*extv1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewVolumeMigrationCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VOLUMEMIGRATION
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: migrationsv1.VolumeMigrationKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    migrationsv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.NamespaceScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     migrations.ResourceVolumeMigrations,
+			Singular:   "volumemigration",
+			Kind:       migrationsv1.VolumeMigrationKind.Kind,
+			ShortNames: []string{"volmig", "volmigs"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, &extv1.CustomResourceSubresources{
+		Status: &extv1.CustomResourceSubresourceStatus{},
+	}, []extv1.CustomResourceColumnDefinition{
+		{Name: "VirtualMachine", Type: "string", JSONPath: ".spec.virtualMachineName"},
+		{Name: "StorageClass", Type: "string", JSONPath: ".spec.storageClassName"},
+		{Name: "Phase", Type: "string", JSONPath: phaseJSONPath},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewMigrationRetryBudgetCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = MIGRATIONRETRYBUDGET
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: migrationsv1.MigrationRetryBudgetKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    migrationsv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.NamespaceScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     migrations.ResourceMigrationRetryBudgets,
+			Singular:   "migrationretrybudget",
+			Kind:       migrationsv1.MigrationRetryBudgetKind.Kind,
+			ShortNames: []string{"mrb", "mrbs"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "MaxFailures", Type: "integer", JSONPath: ".spec.maxFailures"},
+		{Name: "Window", Type: "string", JSONPath: ".spec.window"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewMigrationRebalancePolicyCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = MIGRATIONREBALANCEPOLICY
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: migrationsv1.MigrationRebalancePolicyKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    migrationsv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.ClusterScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     migrations.ResourceMigrationRebalancePolicies,
+			Singular:   "migrationrebalancepolicy",
+			Kind:       migrationsv1.MigrationRebalancePolicyKind.Kind,
+			ShortNames: []string{"mrp", "mrps"},
+		},
+	}
+	err := addFieldsToAllVersions(crd, &extv1.CustomResourceSubresources{
+		Status: &extv1.CustomResourceSubresourceStatus{},
+	}, []extv1.CustomResourceColumnDefinition{
+		{Name: "CPUThreshold", Type: "integer", JSONPath: ".spec.cpuThresholdPercentage"},
+		{Name: "MemoryThreshold", Type: "integer", JSONPath: ".spec.memoryThresholdPercentage"},
+		{Name: "LastMigration", Type: "date", JSONPath: ".status.lastMigrationTime"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineCloneCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
@@ -11,14 +11,20 @@ import (
 
 	k8sv1 "k8s.io/api/core/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/util/jsonpath"
 
+	autoscalingv1alpha1 "kubevirt.io/api/autoscaling/v1alpha1"
 	clonev1beta1 "kubevirt.io/api/clone/v1beta1"
 	v1 "kubevirt.io/api/core/v1"
 	exportv1beta1 "kubevirt.io/api/export/v1beta1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	quotav1alpha1 "kubevirt.io/api/quota/v1alpha1"
 	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
+	importv1 "kubevirt.io/api/vmimport/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
 )
@@ -40,16 +46,30 @@ var _ = Describe("CRDs", func() {
 		Entry("for VirtualMachineInstanceMigration", NewVirtualMachineInstanceMigrationCrd),
 		Entry("for KubeVirt", NewKubeVirtCrd),
 		Entry("for VirtualMachinePool", NewVirtualMachinePoolCrd),
+		Entry("for VirtualMachineAutoscalingPolicy", NewVirtualMachineAutoscalingPolicyCrd),
+		Entry("for VirtualMachineResourceQuota", NewVirtualMachineResourceQuotaCrd),
+		Entry("for VirtualMachineDisruptionBudget", NewVirtualMachineDisruptionBudgetCrd),
 		Entry("for VirtualMachineSnapshot", NewVirtualMachineSnapshotCrd),
 		Entry("for VirtualMachineSnapshotContent", NewVirtualMachineSnapshotContentCrd),
 		Entry("for VirtualMachineRestore", NewVirtualMachineRestoreCrd),
+		Entry("for VirtualMachineSnapshotSchedule", NewVirtualMachineSnapshotScheduleCrd),
+		Entry("for VirtualMachineSnapshotGroup", NewVirtualMachineSnapshotGroupCrd),
+		Entry("for VirtualMachineSnapshotGroupRestore", NewVirtualMachineSnapshotGroupRestoreCrd),
 		Entry("for VirtualMachineExport", NewVirtualMachineExportCrd),
+		Entry("for VirtualMachineSnapshotExport", NewVirtualMachineSnapshotExportCrd),
+		Entry("for VirtualMachineSnapshotReplication", NewVirtualMachineSnapshotReplicationCrd),
+		Entry("for VirtualMachineImport", NewVirtualMachineImportCrd),
 		Entry("for VirtualMachineInstancetype", NewVirtualMachineInstancetypeCrd),
 		Entry("for VirtualMachineClusterInstancetype", NewVirtualMachineClusterInstancetypeCrd),
 		Entry("for VirtualMachinePreference", NewVirtualMachinePreferenceCrd),
 		Entry("for VirtualMachineClusterPreference", NewVirtualMachineClusterPreferenceCrd),
+		Entry("for VirtualMachineDefault", NewVirtualMachineDefaultCrd),
+		Entry("for VirtualMachineClusterDefault", NewVirtualMachineClusterDefaultCrd),
 		Entry("for VirtualMachineClone", NewVirtualMachineCloneCrd),
 		Entry("for MigrationPolicy", NewMigrationPolicyCrd),
+		Entry("for VolumeMigration", NewVolumeMigrationCrd),
+		Entry("for MigrationRetryBudget", NewMigrationRetryBudgetCrd),
+		Entry("for MigrationRebalancePolicy", NewMigrationRebalancePolicyCrd),
 	)
 
 	It("DataVolumeTemplates should have nullable a XPreserveUnknownFields on metadata", func() {
@@ -133,16 +153,30 @@ var _ = Describe("CRDs", func() {
 		Entry("for VirtualMachineInstanceMigration", NewVirtualMachineInstanceMigrationCrd, "Phase", "VMI"),
 		Entry("for KubeVirt", NewKubeVirtCrd, "Age", "Phase"),
 		Entry("for VirtualMachinePool", NewVirtualMachinePoolCrd, "Desired", "Current", "Ready", "Age"),
+		Entry("for VirtualMachineAutoscalingPolicy", NewVirtualMachineAutoscalingPolicyCrd, "MinSockets", "MaxSockets", "MinMemory", "MaxMemory", "Age"),
+		Entry("for VirtualMachineResourceQuota", NewVirtualMachineResourceQuotaCrd, "UsedCPU", "HardCPU", "UsedMemory", "HardMemory", "Age"),
+		Entry("for VirtualMachineDisruptionBudget", NewVirtualMachineDisruptionBudgetCrd, "MaxUnavailable", "Expected", "Healthy", "Allowed", "Age"),
 		Entry("for VirtualMachineSnapshot", NewVirtualMachineSnapshotCrd, "SourceKind", "SourceName", "Phase", "ReadyToUse", "CreationTime", "Error"),
 		Entry("for VirtualMachineSnapshotContent", NewVirtualMachineSnapshotContentCrd, "ReadyToUse", "CreationTime", "Error"),
 		Entry("for VirtualMachineRestore", NewVirtualMachineRestoreCrd, "TargetKind", "TargetName", "Complete", "RestoreTime"),
+		Entry("for VirtualMachineSnapshotSchedule", NewVirtualMachineSnapshotScheduleCrd, "SourceKind", "SourceName", "Schedule", "LastScheduleTime", "Error"),
+		Entry("for VirtualMachineSnapshotGroup", NewVirtualMachineSnapshotGroupCrd, "Phase", "ReadyToUse", "CreationTime", "Error"),
+		Entry("for VirtualMachineSnapshotGroupRestore", NewVirtualMachineSnapshotGroupRestoreCrd, "SnapshotGroupName", "Complete", "RestoreTime"),
 		Entry("for VirtualMachineExport", NewVirtualMachineExportCrd, "SourceKind", "SourceName", "Phase"),
+		Entry("for VirtualMachineSnapshotExport", NewVirtualMachineSnapshotExportCrd, "SnapshotName", "Provider", "Phase"),
+		Entry("for VirtualMachineSnapshotReplication", NewVirtualMachineSnapshotReplicationCrd, "SnapshotName", "Phase"),
+		Entry("for VirtualMachineImport", NewVirtualMachineImportCrd, "Format", "Phase"),
 		Entry("for VirtualMachineInstancetype", NewVirtualMachineInstancetypeCrd),
 		Entry("for VirtualMachineClusterInstancetype", NewVirtualMachineClusterInstancetypeCrd),
 		Entry("for VirtualMachinePreference", NewVirtualMachinePreferenceCrd),
 		Entry("for VirtualMachineClusterPreference", NewVirtualMachineClusterPreferenceCrd),
+		Entry("for VirtualMachineDefault", NewVirtualMachineDefaultCrd),
+		Entry("for VirtualMachineClusterDefault", NewVirtualMachineClusterDefaultCrd),
 		Entry("for VirtualMachineClone", NewVirtualMachineCloneCrd, "Phase", "SourceVirtualMachine", "TargetVirtualMachine"),
 		Entry("for MigrationPolicy", NewMigrationPolicyCrd),
+		Entry("for VolumeMigration", NewVolumeMigrationCrd, "VirtualMachine", "StorageClass", "Phase"),
+		Entry("for MigrationRetryBudget", NewMigrationRetryBudgetCrd, "MaxFailures", "Window"),
+		Entry("for MigrationRebalancePolicy", NewMigrationRebalancePolicyCrd, "CPUThreshold", "MemoryThreshold", "LastMigration"),
 	)
 
 	DescribeTable("Additional printer columns map to expected value", func(crdFunc func() (*extv1.CustomResourceDefinition, error), obj any, expected ...string) {
@@ -256,6 +290,58 @@ var _ = Describe("CRDs", func() {
 			},
 			"2", "4", "5", timestamp,
 		),
+		Entry("for VirtualMachineAutoscalingPolicy", NewVirtualMachineAutoscalingPolicyCrd,
+			autoscalingv1alpha1.VirtualMachineAutoscalingPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: createTime(),
+				},
+				Spec: autoscalingv1alpha1.VirtualMachineAutoscalingPolicySpec{
+					CPU: &autoscalingv1alpha1.VirtualMachineCPUAutoscaling{
+						MinSockets: 1,
+						MaxSockets: 4,
+					},
+					Memory: &autoscalingv1alpha1.VirtualMachineMemoryAutoscaling{
+						Min: resource.MustParse("1Gi"),
+						Max: resource.MustParse("8Gi"),
+					},
+				},
+			},
+			"1", "4", "1Gi", "8Gi", timestamp,
+		),
+		Entry("for VirtualMachineResourceQuota", NewVirtualMachineResourceQuotaCrd,
+			quotav1alpha1.VirtualMachineResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: createTime(),
+				},
+				Status: quotav1alpha1.VirtualMachineResourceQuotaStatus{
+					Hard: k8sv1.ResourceList{
+						quotav1alpha1.ResourceGuestCPU:    resource.MustParse("16"),
+						quotav1alpha1.ResourceGuestMemory: resource.MustParse("64Gi"),
+					},
+					Used: k8sv1.ResourceList{
+						quotav1alpha1.ResourceGuestCPU:    resource.MustParse("4"),
+						quotav1alpha1.ResourceGuestMemory: resource.MustParse("8Gi"),
+					},
+				},
+			},
+			"4", "16", "8Gi", "64Gi", timestamp,
+		),
+		Entry("for VirtualMachineDisruptionBudget", NewVirtualMachineDisruptionBudgetCrd,
+			migrationsv1.VirtualMachineDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: createTime(),
+				},
+				Spec: migrationsv1.VirtualMachineDisruptionBudgetSpec{
+					MaxUnavailable: pointer.P(intstr.FromString("25%")),
+				},
+				Status: migrationsv1.VirtualMachineDisruptionBudgetStatus{
+					ExpectedVirtualMachineInstances: 8,
+					CurrentHealthy:                  7,
+					DisruptionsAllowed:              1,
+				},
+			},
+			"25%", "8", "7", "1", timestamp,
+		),
 		Entry("for VirtualMachineSnapshot", NewVirtualMachineSnapshotCrd,
 			snapshotv1beta1.VirtualMachineSnapshot{
 				Spec: snapshotv1beta1.VirtualMachineSnapshotSpec{
@@ -302,6 +388,49 @@ var _ = Describe("CRDs", func() {
 			},
 			"VirtualMachine", "test-vm", "false", timestamp,
 		),
+		Entry("for VirtualMachineSnapshotSchedule", NewVirtualMachineSnapshotScheduleCrd,
+			snapshotv1beta1.VirtualMachineSnapshotSchedule{
+				Spec: snapshotv1beta1.VirtualMachineSnapshotScheduleSpec{
+					Source: k8sv1.TypedLocalObjectReference{
+						Kind: "VirtualMachine",
+						Name: "test-vm",
+					},
+					Schedule: "0 * * * *",
+				},
+				Status: &snapshotv1beta1.VirtualMachineSnapshotScheduleStatus{
+					LastScheduleTime: pointer.P(createTime()),
+					Error: &snapshotv1beta1.Error{
+						Message: pointer.P("test-error"),
+					},
+				},
+			},
+			"VirtualMachine", "test-vm", "0 * * * *", timestamp, "test-error",
+		),
+		Entry("for VirtualMachineSnapshotGroup", NewVirtualMachineSnapshotGroupCrd,
+			snapshotv1beta1.VirtualMachineSnapshotGroup{
+				Status: &snapshotv1beta1.VirtualMachineSnapshotGroupStatus{
+					Phase:        snapshotv1beta1.Succeeded,
+					ReadyToUse:   pointer.P(true),
+					CreationTime: pointer.P(createTime()),
+					Error: &snapshotv1beta1.Error{
+						Message: pointer.P("test-error"),
+					},
+				},
+			},
+			"Succeeded", "true", timestamp, "test-error",
+		),
+		Entry("for VirtualMachineSnapshotGroupRestore", NewVirtualMachineSnapshotGroupRestoreCrd,
+			snapshotv1beta1.VirtualMachineSnapshotGroupRestore{
+				Spec: snapshotv1beta1.VirtualMachineSnapshotGroupRestoreSpec{
+					VirtualMachineSnapshotGroupName: "test-group",
+				},
+				Status: &snapshotv1beta1.VirtualMachineSnapshotGroupRestoreStatus{
+					Complete:    pointer.P(false),
+					RestoreTime: pointer.P(createTime()),
+				},
+			},
+			"test-group", "false", timestamp,
+		),
 		Entry("for VirtualMachineExport", NewVirtualMachineExportCrd,
 			exportv1beta1.VirtualMachineExport{
 				Spec: exportv1beta1.VirtualMachineExportSpec{
@@ -316,6 +445,77 @@ var _ = Describe("CRDs", func() {
 			},
 			"VirtualMachine", "test-vm", "Ready",
 		),
+		Entry("for VirtualMachineSnapshotExport", NewVirtualMachineSnapshotExportCrd,
+			exportv1beta1.VirtualMachineSnapshotExport{
+				Spec: exportv1beta1.VirtualMachineSnapshotExportSpec{
+					VirtualMachineSnapshotName: "test-snapshot",
+					Destination: exportv1beta1.ObjectStorageDestination{
+						Provider: exportv1beta1.ObjectStorageS3,
+					},
+				},
+				Status: &exportv1beta1.VirtualMachineSnapshotExportStatus{
+					Phase: exportv1beta1.SnapshotExportSucceeded,
+				},
+			},
+			"test-snapshot", "S3", "Succeeded",
+		),
+		Entry("for VirtualMachineSnapshotReplication", NewVirtualMachineSnapshotReplicationCrd,
+			exportv1beta1.VirtualMachineSnapshotReplication{
+				Spec: exportv1beta1.VirtualMachineSnapshotReplicationSpec{
+					VirtualMachineSnapshotName: "test-snapshot",
+				},
+				Status: &exportv1beta1.VirtualMachineSnapshotReplicationStatus{
+					Phase: exportv1beta1.SnapshotReplicationReplicating,
+				},
+			},
+			"test-snapshot", "Replicating",
+		),
+		Entry("for VirtualMachineImport", NewVirtualMachineImportCrd,
+			importv1.VirtualMachineImport{
+				Spec: importv1.VirtualMachineImportSpec{
+					Source: importv1.VirtualMachineImportSource{
+						Format: importv1.ImportFormatOVA,
+					},
+				},
+				Status: &importv1.VirtualMachineImportStatus{
+					Phase: importv1.ImportImporting,
+				},
+			},
+			"ova", "Importing",
+		),
+		Entry("for VolumeMigration", NewVolumeMigrationCrd,
+			migrationsv1.VolumeMigration{
+				Spec: migrationsv1.VolumeMigrationSpec{
+					VirtualMachineName: "test-vm",
+					StorageClassName:   "test-sc",
+				},
+				Status: &migrationsv1.VolumeMigrationStatus{
+					Phase: migrationsv1.VolumeMigrationInProgress,
+				},
+			},
+			"test-vm", "test-sc", "InProgress",
+		),
+		Entry("for MigrationRetryBudget", NewMigrationRetryBudgetCrd,
+			migrationsv1.MigrationRetryBudget{
+				Spec: migrationsv1.MigrationRetryBudgetSpec{
+					MaxFailures: 3,
+					Window:      &metav1.Duration{Duration: time.Hour},
+				},
+			},
+			"3", "1h0m0s",
+		),
+		Entry("for MigrationRebalancePolicy", NewMigrationRebalancePolicyCrd,
+			migrationsv1.MigrationRebalancePolicy{
+				Spec: migrationsv1.MigrationRebalancePolicySpec{
+					CPUThresholdPercentage:    pointer.P(uint32(70)),
+					MemoryThresholdPercentage: pointer.P(uint32(90)),
+				},
+				Status: migrationsv1.MigrationRebalancePolicyStatus{
+					LastMigrationTime: pointer.P(createTime()),
+				},
+			},
+			"70", "90", timestamp,
+		),
 		Entry("for VirtualMachineClone", NewVirtualMachineCloneCrd,
 			clonev1beta1.VirtualMachineClone{
 				Spec: clonev1beta1.VirtualMachineCloneSpec{
@@ -0,0 +1,27 @@
+package components
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("DaemonSets", func() {
+	DescribeTable("should attach virt-handler to the migration networks", func(migrationNetwork *string, additionalMigrationNetworks []string, expectedNetworks string) {
+		daemonSet := NewHandlerDaemonSet("mynamespace", "repository", "", "v1", "", "", "", "", "", "", "", "", "", "", corev1.PullIfNotPresent, nil, migrationNetwork, additionalMigrationNetworks, "2", nil, false)
+		if expectedNetworks == "" {
+			Expect(daemonSet.Spec.Template.Annotations).ToNot(HaveKey(networkv1.NetworkAttachmentAnnot))
+		} else {
+			Expect(daemonSet.Spec.Template.Annotations).To(HaveKeyWithValue(networkv1.NetworkAttachmentAnnot, expectedNetworks))
+		}
+	},
+		Entry("without migration network", nil, nil, ""),
+		Entry("with the migration network", pointer.P("migration"), nil, "migration@migration0"),
+		Entry("with additional migration networks", pointer.P("migration"), []string{"ns/storage", "latency"}, "migration@migration0,ns/storage@migration1,latency@migration2"),
+		Entry("with additional migration networks only", nil, []string{"storage"}, "storage@migration1"),
+	)
+})
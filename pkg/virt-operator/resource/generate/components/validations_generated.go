@@ -704,6 +704,52 @@ var CRDsValidation map[string]string = map[string]string{
                 by node pressures, but would mean that fewer VMs could be scheduled to a node.
                 If not set, the default is 1.
               type: string
+            admissionPolicy:
+              description: AdmissionPolicy holds the CEL rules the VirtualMachines
+                and VirtualMachineInstances are validated with on admission
+              nullable: true
+              properties:
+                rules:
+                  description: Rules are the rules the objects are validated with
+                  items:
+                    description: AdmissionPolicyRule is a CEL expression the objects
+                      of the resources must satisfy
+                    properties:
+                      expression:
+                        description: |-
+                          Expression is a CEL expression evaluating to true when the object satisfies the rule. The object is
+                          available as the object variable, and its previous version on updates as the oldObject variable, which
+                          is null on creations. A rule whose expression can not be evaluated is not satisfied.
+                        type: string
+                      message:
+                        description: Message is shown to the client when the object
+                          does not satisfy the rule
+                        type: string
+                      mode:
+                        description: |-
+                          Mode is Enforce to deny the objects which do not satisfy the rule, or Warn to only warn the client about
+                          them. Defaults to Enforce.
+                        type: string
+                      name:
+                        description: Name identifies the rule
+                        type: string
+                      resources:
+                        description: 'Resources are the resources the rule applies
+                          to: virtualmachines or virtualmachineinstances'
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: set
+                    required:
+                    - expression
+                    - name
+                    - resources
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
+              type: object
             apiConfiguration:
               description: |-
                 ReloadableComponentConfiguration holds all generic k8s configuration options which can
@@ -1057,6 +1103,11 @@ var CRDsValidation map[string]string = map[string]string{
               description: KSMConfiguration holds the information regarding the enabling
                 the KSM in the nodes (if available).
               properties:
+                mergePolicy:
+                  description: |-
+                    MergePolicy is the KSM merge policy of the VMIs which do not set one.
+                    Defaults to Balanced.
+                  type: string
                 nodeLabelSelector:
                   description: |-
                     NodeLabelSelector is a selector that filters in which nodes the KSM will be enabled.
@@ -1136,6 +1187,47 @@ var CRDsValidation map[string]string = map[string]string{
                   format: int32
                   type: integer
               type: object
+            logging:
+              description: Logging configures the format and the verbosity of the
+                logs of the KubeVirt components
+              nullable: true
+              properties:
+                format:
+                  description: Format is the format of the logs, json or text. Defaults
+                    to json.
+                  type: string
+                subcomponentVerbosity:
+                  additionalProperties:
+                    type: integer
+                  description: |-
+                    SubcomponentVerbosity sets the verbosity of subcomponents, like migration or hotplug, in all the
+                    components. Subcomponents without a verbosity use the verbosity of their component.
+                  type: object
+                verbosity:
+                  description: |-
+                    Verbosity sets the verbosity of the components. It takes precedence over the log verbosity of the
+                    developer configuration for the components it sets.
+                  properties:
+                    nodeVerbosity:
+                      additionalProperties:
+                        type: integer
+                      description: NodeVerbosity represents a map of nodes with a
+                        specific verbosity level
+                      type: object
+                    virtAPI:
+                      type: integer
+                    virtController:
+                      type: integer
+                    virtHandler:
+                      type: integer
+                    virtLauncher:
+                      type: integer
+                    virtOperator:
+                      type: integer
+                    virtSynchronizationController:
+                      type: integer
+                  type: object
+              type: object
             machineType:
               description: Deprecated. Use architectureConfiguration instead.
               type: string
@@ -1179,6 +1271,30 @@ var CRDsValidation map[string]string = map[string]string{
                           Selector which must match a node's labels for the vmi to be scheduled on that node.
                           More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
                         type: object
+                      profiles:
+                        description: |-
+                          Profiles maps the mediated device profiles requested by VMs to the
+                          mediated device types providing them on the selected nodes.
+                          The types of the profiles are created on the nodes as well.
+                        items:
+                          description: MediatedDeviceProfile maps an alias requested
+                            by VMs to a mediated device type.
+                          properties:
+                            mediatedDeviceType:
+                              description: MediatedDeviceType is the ID or the name
+                                of the mediated device type providing the profile.
+                              type: string
+                            name:
+                              description: |-
+                                Name is the alias of the profile, requested by VMs in spec.domain.devices.gpus[].profile.
+                                The devices of the profile are exposed as the mdev.kubevirt.io/<name> resource.
+                              type: string
+                          required:
+                          - mediatedDeviceType
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-type: atomic
                     required:
                     - nodeSelector
                     type: object
@@ -1188,12 +1304,62 @@ var CRDsValidation map[string]string = map[string]string{
             memBalloonStatsPeriod:
               format: int32
               type: integer
+            memoryBalloon:
+              description: |-
+                MemoryBalloon lets virt-handler reclaim unused memory of all guests through their memory balloon.
+                VMIs can override it in spec.domain.memory.balloon.
+              properties:
+                max:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    Max is the largest amount of memory the balloon gives to the guest.
+                    Defaults to the guest memory and can not exceed it.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                min:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    Min is the least amount of memory the balloon leaves to the guest.
+                    Defaults to half of the guest memory.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                targetFreePercentage:
+                  description: |-
+                    TargetFreePercentage is the share of the memory given to the guest which should remain
+                    available to it. Defaults to 20.
+                  format: int32
+                  type: integer
+              type: object
+            metrics:
+              description: Metrics configures the metrics of the VirtualMachineInstances
+                exported by virt-handler
+              nullable: true
+              properties:
+                collectionInterval:
+                  description: |-
+                    CollectionInterval is the minimum interval between two collections of the domain stats of the
+                    VirtualMachineInstances by virt-handler. Scrapes within the interval are served from the previous
+                    collection. The domain stats are collected on every scrape when it is not set.
+                  type: string
+              type: object
             migrations:
               description: |-
                 MigrationConfiguration holds migration options.
                 Can be overridden for specific groups of VMs though migration policies.
                 Visit https://kubevirt.io/user-guide/operations/migration_policies/ for more information.
               properties:
+                additionalNetworks:
+                  description: |-
+                    AdditionalNetworks are the names of further CNI networks for live migrations. A migration or
+                    its migration policy can select one of them, or the network above, through its network field.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
                 allowAutoConverge:
                   description: |-
                     AllowAutoConverge allows the platform to compromise performance/availability of VMIs to
@@ -1234,6 +1400,22 @@ var CRDsValidation map[string]string = map[string]string{
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
                     provided by KubeVirt. This is usually a bad idea. Defaults to false
                   type: boolean
+                evacuationOrder:
+                  description: |-
+                    EvacuationOrder defines the order in which VMIs are migrated off a node being drained.
+                    Defaults to no particular order
+                  properties:
+                    byLabel:
+                      description: |-
+                        ByLabel is the key of a VMI label holding an integer. VMIs with a higher value are evacuated
+                        first, VMIs without the label or with a value which is not an integer last.
+                      type: string
+                    byPriorityClass:
+                      description: |-
+                        ByPriorityClass evacuates VMIs with a higher priority, as resolved from the priority class
+                        of their virt-launcher pod, first.
+                      type: boolean
+                  type: object
                 matchSELinuxLevelOnMigration:
                   description: |-
                     By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
@@ -1251,6 +1433,13 @@ var CRDsValidation map[string]string = map[string]string{
                     NodeDrainTaintKey defines the taint key that indicates a node should be drained.
                     Note: this option relies on the deprecated node taint feature. Default: kubevirt.io/drain
                   type: string
+                parallelInboundMigrationsPerNode:
+                  description: |-
+                    ParallelInboundMigrationsPerNode is the maximum number of concurrent incoming live migrations
+                    allowed per node. Migration target pods are not scheduled to nodes which reached this limit.
+                    Defaults to no limit
+                  format: int32
+                  type: integer
                 parallelMigrationsPerCluster:
                   description: |-
                     ParallelMigrationsPerCluster is the total number of concurrent live migrations
@@ -1270,6 +1459,13 @@ var CRDsValidation map[string]string = map[string]string{
                     then considered stuck and therefore cancelled. Defaults to 150
                   format: int64
                   type: integer
+                replugHostDevices:
+                  description: |-
+                    ReplugHostDevices allows VMIs with GPUs and host devices to live migrate. The devices are unplugged
+                    from the guest before the migration starts, and equivalent devices are plugged into it on the target.
+                    The guest agent of such VMIs must be connected, and GPUs must have their display disabled.
+                    Defaults to false
+                  type: boolean
                 unsafeMigrationOverride:
                   description: |-
                     UnsafeMigrationOverride allows live migrations to occur even if the compatibility check
@@ -1370,6 +1566,33 @@ var CRDsValidation map[string]string = map[string]string{
             ovmfPath:
               description: Deprecated. Use architectureConfiguration instead.
               type: string
+            performanceDegradation:
+              description: |-
+                PerformanceDegradation configures the thresholds above which virt-handler reports a degraded performance
+                of the VirtualMachineInstances in their conditions
+              nullable: true
+              properties:
+                cpuStealPercentage:
+                  description: |-
+                    CPUStealPercentage is the share of the time the vCPUs wait for a host CPU, in percent, above which
+                    the CPU of the guest is degraded
+                  format: int32
+                  type: integer
+                ioLatency:
+                  description: IOLatency is the average latency of the disk requests,
+                    above which the IO of the guest is degraded
+                  type: string
+                migrationDowntime:
+                  description: MigrationDowntime is the downtime of a migration, above
+                    which the migration of the guest is degraded
+                  type: string
+                vcpuWaitPercentage:
+                  description: |-
+                    VCPUWaitPercentage is the share of the time the vCPUs wait on I/O, in percent, above which the CPU
+                    of the guest is degraded
+                  format: int32
+                  type: integer
+              type: object
             permittedHostDevices:
               description: PermittedHostDevices holds information about devices allowed
                 for passthrough
@@ -1448,6 +1671,15 @@ var CRDsValidation map[string]string = map[string]string{
                   type: array
                   x-kubernetes-list-type: atomic
               type: object
+            poolAutoscaling:
+              description: PoolAutoscaling configures the autoscaling of VirtualMachinePools
+              nullable: true
+              properties:
+                prometheusURL:
+                  description: PrometheusURL is the address of the Prometheus API queried
+                    by the Prometheus metrics of VirtualMachinePools
+                  type: string
+              type: object
             seccompConfiguration:
               description: SeccompConfiguration holds Seccomp configuration for Kubevirt
                 components
@@ -1469,6 +1701,25 @@ var CRDsValidation map[string]string = map[string]string{
               type: object
             selinuxLauncherType:
               type: string
+            sessionRecording:
+              description: SessionRecording configures the recording and auditing
+                of console and VNC sessions by virt-api
+              nullable: true
+              properties:
+                persistentVolumeClaimName:
+                  description: |-
+                    PersistentVolumeClaimName is the name of a PersistentVolumeClaim in the namespace of KubeVirt, which is
+                    mounted into virt-api to store the recordings. It has to support ReadWriteMany when virt-api runs more
+                    than one replica. Sessions are only audited when it is not set.
+                  type: string
+                sessions:
+                  description: Sessions lists the kinds of sessions which are audited
+                    and recorded, Console and/or VNC.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+              type: object
             smbios:
               properties:
                 family:
@@ -1482,6 +1733,54 @@ var CRDsValidation map[string]string = map[string]string{
                 version:
                   type: string
               type: object
+            subresourceLimits:
+              description: SubresourceLimits limits the connections of the clients
+                to the streaming subresources of virt-api
+              nullable: true
+              properties:
+                exemptGroups:
+                  description: ExemptGroups are the groups whose members are not limited,
+                    like the cluster administrators
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+                limits:
+                  description: Limits holds the limits of the subresources. Subresources
+                    without limits are not limited.
+                  items:
+                    description: SubresourceLimit holds the limits of the connections
+                      to a subresource
+                    properties:
+                      connectionsPerMinutePerUser:
+                        description: ConnectionsPerMinutePerUser is the number of
+                          connections a user can open to the subresource per minute
+                        format: int32
+                        type: integer
+                      maxConnectionsPerNamespace:
+                        description: |-
+                          MaxConnectionsPerNamespace is the number of concurrent connections to the subresource of the
+                          VirtualMachineInstances of a namespace
+                        format: int32
+                        type: integer
+                      maxConnectionsPerUser:
+                        description: MaxConnectionsPerUser is the number of concurrent
+                          connections of a user to the subresource
+                        format: int32
+                        type: integer
+                      subresource:
+                        description: |-
+                          Subresource is the subresource which is limited: console, vnc, vnc/screenshot, spice, usbredir,
+                          portforward, vsock or memorydump
+                        type: string
+                    required:
+                    - subresource
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - subresource
+                  x-kubernetes-list-type: map
+              type: object
             supportContainerResources:
               description: SupportContainerResources specifies the resource requirements
                 for various types of supporting containers such as container disks/virtiofs/sidecars
@@ -1538,6 +1837,19 @@ var CRDsValidation map[string]string = map[string]string{
               items:
                 type: string
               type: array
+            sysprep:
+              description: Sysprep configures the sources of the Sysprep answer files
+              nullable: true
+              properties:
+                templateNamespaces:
+                  description: |-
+                    TemplateNamespaces lists the namespaces, e.g. of golden templates, which VirtualMachineInstances
+                    of any namespace may reference Sysprep answer files from.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+              type: object
             tlsConfiguration:
               description: TLSConfiguration holds TLS options
               properties:
@@ -1562,6 +1874,21 @@ var CRDsValidation map[string]string = map[string]string{
                   - VersionTLS13
                   type: string
               type: object
+            virtIODrivers:
+              description: VirtIODrivers configures the VirtIO driver ISO attached
+                to Windows guests
+              nullable: true
+              properties:
+                autoattach:
+                  description: |-
+                    Autoattach enables attaching the VirtIO driver ISO to the VirtualMachineInstances which request it,
+                    either with autoattachVirtIODrivers or through their preference. Defaults to false.
+                  type: boolean
+                image:
+                  description: Image is the containerDisk image holding the VirtIO
+                    driver ISO
+                  type: string
+              type: object
             virtualMachineInstancesPerNode:
               type: integer
             virtualMachineOptions:
@@ -1627,6 +1954,38 @@ var CRDsValidation map[string]string = map[string]string{
                       type: object
                   type: object
               type: object
+            workloadClasses:
+              description: |-
+                WorkloadClasses map VirtualMachineInstances to a priority, and configure how they are
+                preempted by VirtualMachineInstances of a higher priority which can not be scheduled
+              items:
+                description: WorkloadClass is a class of VirtualMachineInstances sharing
+                  a priority
+                properties:
+                  name:
+                    description: Name of the class, which VirtualMachineInstances
+                      reference in spec.workloadClass
+                    type: string
+                  preemptionAction:
+                    description: |-
+                      PreemptionAction is taken on running VirtualMachineInstances of the class to make room for
+                      VirtualMachineInstances of a higher priority. They are not preempted if it is not set.
+                    enum:
+                    - Stop
+                    - Hibernate
+                    type: string
+                  priorityClassName:
+                    description: PriorityClassName is the PriorityClass of the virt-launcher
+                      pods of the class
+                    type: string
+                required:
+                - name
+                - priorityClassName
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
           type: object
         customizeComponents:
           properties:
@@ -2764,6 +3123,70 @@ var CRDsValidation map[string]string = map[string]string{
 
                 Defaults to 10
               type: integer
+            canaryNodeSelector:
+              description: |-
+                CanaryNodeSelector selects the nodes whose VMIs are updated first. The VMIs
+                of the other nodes are only updated once no VMI of the selected nodes is outdated.
+              properties:
+                matchExpressions:
+                  description: matchExpressions is a list of label selector requirements.
+                    The requirements are ANDed.
+                  items:
+                    description: |-
+                      A label selector requirement is a selector that contains values, a key, and an operator that
+                      relates the key and values.
+                    properties:
+                      key:
+                        description: key is the label key that the selector applies to.
+                        type: string
+                      operator:
+                        description: |-
+                          operator represents a key's relationship to a set of values.
+                          Valid operators are In, NotIn, Exists and DoesNotExist.
+                        type: string
+                      values:
+                        description: |-
+                          values is an array of string values. If the operator is In or NotIn,
+                          the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                          the values array must be empty. This array is replaced during a strategic
+                          merge patch.
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                    required:
+                    - key
+                    - operator
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                matchLabels:
+                  additionalProperties:
+                    type: string
+                  description: |-
+                    matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                    map is equivalent to an element of matchExpressions, whose key field is "key", the
+                    operator is "In", and the values array contains only "value". The requirements are ANDed.
+                  type: object
+              type: object
+              x-kubernetes-map-type: atomic
+            maxUnavailable:
+              anyOf:
+              - type: integer
+              - type: string
+              description: |-
+                MaxUnavailable is the number or percentage of the running VMIs which can be
+                updated at the same time, the VMIs migrating or shutting down for the update
+                included. Percentages are rounded up. It limits both the migrations and the
+                batch evictions.
+
+                Defaults to no limit besides the migration and eviction settings
+              x-kubernetes-int-or-string: true
+            paused:
+              description: |-
+                Paused stops the automated workload updates from migrating or evicting any
+                further VMIs. The migrations and evictions in progress are not interrupted.
+              type: boolean
             workloadUpdateMethods:
               description: |-
                 WorkloadUpdateMethods defines the methods that can be used to disrupt workloads
@@ -3874,6 +4297,39 @@ var CRDsValidation map[string]string = map[string]string{
           type: string
         targetKubeVirtVersion:
           type: string
+        workloadUpdate:
+          description: WorkloadUpdate reports the progress of the automated workload
+            updates
+          properties:
+            inProgressVirtualMachineInstances:
+              description: |-
+                InProgressVirtualMachineInstances is the number of outdated VMIs which are migrating
+                or shutting down
+              format: int32
+              type: integer
+            pendingCanaryVirtualMachineInstances:
+              description: PendingCanaryVirtualMachineInstances is the number of
+                the pending VMIs which run on canary nodes
+              format: int32
+              type: integer
+            pendingVirtualMachineInstances:
+              description: PendingVirtualMachineInstances is the number of outdated
+                VMIs whose update did not start yet
+              format: int32
+              type: integer
+            phase:
+              description: Phase is the phase of the workload updates
+              type: string
+            updatedVirtualMachineInstances:
+              description: UpdatedVirtualMachineInstances is the number of running
+                VMIs which are up to date
+              format: int32
+              type: integer
+          required:
+          - inProgressVirtualMachineInstances
+          - pendingVirtualMachineInstances
+          - updatedVirtualMachineInstances
+          type: object
       type: object
   required:
   - spec
@@ -3914,10 +4370,62 @@ var CRDsValidation map[string]string = map[string]string{
           - type: string
           pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
           x-kubernetes-int-or-string: true
-        completionTimeoutPerGiB:
-          format: int64
-          type: integer
-        selectors:
+        bandwidthSchedule:
+          description: |-
+            BandwidthSchedule overrides bandwidthPerMigration while one of its windows is active
+            at the time the migration is configured.
+          properties:
+            timeZone:
+              description: TimeZone is the IANA time zone name in which the windows
+                are evaluated. Defaults to UTC.
+              type: string
+            windows:
+              description: Windows are evaluated in order and the first active window
+                is applied.
+              items:
+                properties:
+                  bandwidthPerMigration:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: BandwidthPerMigration to apply while the window is
+                      active. Zero means unlimited.
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  days:
+                    description: Days of the week (e.g. Monday) on which the window
+                      starts. Defaults to every day.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: set
+                  end:
+                    description: |-
+                      End of the window in 24-hour HH:MM format. If end is before start, the window spans midnight.
+                      If end equals start, the window lasts the whole day.
+                    type: string
+                  start:
+                    description: Start of the window in 24-hour HH:MM format.
+                    type: string
+                required:
+                - bandwidthPerMigration
+                - end
+                - start
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          required:
+          - windows
+          type: object
+        completionTimeoutPerGiB:
+          format: int64
+          type: integer
+        network:
+          description: |-
+            Network is the name of the CNI network the migrations go through. It has to be the migration
+            network or one of the additional migration networks of the cluster configuration.
+          type: string
+        selectors:
           properties:
             namespaceSelector:
               additionalProperties:
@@ -3937,6 +4445,221 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"migrationrebalancepolicy": `openAPIV3Schema:
+  description: |-
+    MigrationRebalancePolicy live migrates virtual machine instances off nodes whose utilization exceeds
+    the thresholds of the policy, to nodes below them, keeping the load of the nodes balanced
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: MigrationRebalancePolicySpec is the spec for a MigrationRebalancePolicy
+        resource
+      properties:
+        cpuThresholdPercentage:
+          description: |-
+            CPUThresholdPercentage is the CPU usage of a node, in percent of its allocatable CPU,
+            above which virtual machine instances are migrated off the node. Defaults to 80.
+          format: int32
+          type: integer
+        excludeLabels:
+          description: ExcludeLabels are label keys of virtual machine instances
+            which are never migrated by the policy
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: set
+        interval:
+          description: Interval is the minimum time between two migrations started
+            by the policy. Defaults to 5m.
+          type: string
+        maxConcurrentMigrations:
+          description: |-
+            MaxConcurrentMigrations is the number of migrations started by the policy which may be in progress
+            at the same time. Defaults to 1.
+          format: int32
+          type: integer
+        memoryThresholdPercentage:
+          description: |-
+            MemoryThresholdPercentage is the memory usage of a node, in percent of its allocatable memory,
+            above which virtual machine instances are migrated off the node. Defaults to 80.
+          format: int32
+          type: integer
+        nodeSelector:
+          description: |-
+            NodeSelector selects the nodes which are rebalanced by their labels.
+            All the nodes are selected when empty.
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
+      type: object
+    status:
+      description: MigrationRebalancePolicyStatus is the status for a MigrationRebalancePolicy
+        resource
+      properties:
+        hotNodes:
+          description: HotNodes are the selected nodes whose utilization exceeds
+            a threshold of the policy
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: set
+        lastMigrationTime:
+          description: LastMigrationTime is the time the policy last started a
+            migration
+          format: date-time
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"migrationretrybudget": `openAPIV3Schema:
+  description: |-
+    MigrationRetryBudget limits how many failed migrations of the selected virtual machine instances
+    are retried within a time window, like a PodDisruptionBudget limits evictions. Once more migrations
+    failed within the window, the migrations are not retried anymore.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: MigrationRetryBudgetSpec is the spec for a MigrationRetryBudget
+        resource
+      properties:
+        maxFailures:
+          description: |-
+            MaxFailures is the number of failed migrations of the selected virtual machine instances
+            which are tolerated within the window
+          format: int32
+          type: integer
+        selector:
+          description: |-
+            Selector selects the virtual machine instances the budget applies to by their labels.
+            All the virtual machine instances of the namespace are selected when empty.
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
+        window:
+          description: Window is the period failed migrations are counted over. Defaults
+            to 1h.
+          type: string
+      required:
+      - maxFailures
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachine": `openAPIV3Schema:
   description: |-
@@ -3966,6 +4689,15 @@ var CRDsValidation map[string]string = map[string]string{
     spec:
       description: Spec contains the specification of VirtualMachineInstance created
       properties:
+        changeApplyStrategy:
+          description: |-
+            ChangeApplyStrategy defines when the changes of the template are applied to the running VMI.
+            With Staged, the changes are listed in status.stagedChanges and only applied on the next restart
+            or through the applychanges subresource. Defaults to Immediate.
+          enum:
+          - Immediate
+          - Staged
+          type: string
         dataVolumeTemplates:
           description: |-
             dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.
@@ -4600,6 +5332,25 @@ var CRDsValidation map[string]string = map[string]string{
             - spec
             type: object
           type: array
+        highAvailability:
+          description: HighAvailability defines how the VMI of the VirtualMachine
+            is recovered from a failure of its node
+          properties:
+            failoverTimeout:
+              description: |-
+                FailoverTimeout is how long the node of the VMI has to be lost before the VMI is failed over
+                to another node. Defaults to 1m.
+              type: string
+            fencing:
+              description: |-
+                Fencing defines how the lost node has to be confirmed to be fenced before the VMI is failed over.
+                With OutOfServiceTaint, the default, a fencing agent has to taint the node with node.kubernetes.io/out-of-service.
+                With None, the VMI is failed over once the timeout passed, which requires the UnfencedFailover feature gate.
+              enum:
+              - None
+              - OutOfServiceTaint
+              type: string
+          type: object
         instancetype:
           description: InstancetypeMatcher references a instancetype that is used
             to fill fields in Template
@@ -4632,6 +5383,30 @@ var CRDsValidation map[string]string = map[string]string{
                 captured the first time the instancetype is applied to the VirtualMachineInstance.
               type: string
           type: object
+        powerSchedule:
+          description: PowerSchedule defines the times the VirtualMachine is started
+            and stopped by the VM controller
+          properties:
+            exceptions:
+              description: Exceptions are the dates, in the YYYY-MM-DD format, on
+                which the scheduled starts are skipped, e.g. holidays
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            start:
+              description: Start is a cron expression in the standard five field format
+                of the times the VirtualMachine is started
+              type: string
+            stop:
+              description: Stop is a cron expression in the standard five field format
+                of the times the VirtualMachine is stopped
+              type: string
+            timeZone:
+              description: TimeZone is the IANA name of the time zone the schedule
+                is evaluated in. Defaults to UTC.
+              type: string
+          type: object
         preference:
           description: PreferenceMatcher references a set of preference that is used
             to fill fields in Template
@@ -5723,6 +6498,33 @@ var CRDsValidation map[string]string = map[string]string{
                     attempting to run. Defaults to the compiled architecture of the
                     KubeVirt components
                   type: string
+                crashDump:
+                  description: |-
+                    CrashDump dumps the memory of the guest to a volume when the guest panics, for offline analysis.
+                    Requires a panic device.
+                  properties:
+                    maxDumps:
+                      description: |-
+                        MaxDumps is the number of crash dumps kept in the volume, the oldest ones are removed first.
+                        Defaults to 1.
+                      format: int32
+                      type: integer
+                    maxSize:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: MaxSize is the largest guest memory which is dumped.
+                        The memory of larger guests is not dumped.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    volumeName:
+                      description: |-
+                        VolumeName is the name of the memory dump volume the memory of the guest is dumped to.
+                        The volume must not be hotpluggable, and must use the Raw format.
+                      type: string
+                  required:
+                  - volumeName
+                  type: object
                 dnsConfig:
                   description: |-
                     Specifies the DNS parameters of a pod.
@@ -5954,29 +6756,87 @@ var CRDsValidation map[string]string = map[string]string{
                           description: NUMA allows specifying settings for the guest
                             NUMA topology
                           properties:
-                            guestMappingPassthrough:
-                              description: |-
-                                GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
-                                The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
-                              type: object
-                          type: object
-                        realtime:
-                          description: Realtime instructs the virt-launcher to tune
-                            the VMI for lower latency, optional for real time workloads
-                          properties:
-                            mask:
+                            guestCells:
                               description: |-
-                                Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
-                                Example: "0-3,^1","0,2,3","2-3"
-                              type: string
-                          type: object
-                        sockets:
-                          description: |-
-                            Sockets specifies the number of sockets inside the vmi.
-                            Must be a value greater or equal 1.
-                          format: int32
-                          type: integer
-                        threads:
+                                GuestCells defines an explicit guest NUMA topology. Each vCPU has to be assigned to exactly one cell,
+                                and the memory of the cells has to add up to the guest memory.
+                                Can't be combined with GuestMappingPassthrough.
+                              items:
+                                description: NUMAGuestCell defines a NUMA cell of
+                                  the guest.
+                                properties:
+                                  cpus:
+                                    description: CPUs is the list of the vCPUs of
+                                      the cell, e.g. "0-3,8".
+                                    type: string
+                                  distances:
+                                    description: |-
+                                      Distances lists the distances from this cell to the other cells.
+                                      The distances which are not listed are picked by the hypervisor.
+                                    items:
+                                      description: NUMAGuestCellDistance defines the
+                                        distance from a NUMA cell of the guest to
+                                        another one.
+                                      properties:
+                                        cellID:
+                                          description: CellID is the ID of the other
+                                            cell.
+                                          format: int32
+                                          type: integer
+                                        value:
+                                          description: Value is the distance, between
+                                            10 and 255. 10 is the distance of a cell
+                                            to itself.
+                                          format: int32
+                                          type: integer
+                                      required:
+                                      - cellID
+                                      - value
+                                      type: object
+                                    type: array
+                                  id:
+                                    description: ID of the cell. The IDs of the cells
+                                      have to range from 0 to the number of cells
+                                      minus one.
+                                    format: int32
+                                    type: integer
+                                  memory:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: Memory is the amount of guest memory
+                                      of the cell.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                required:
+                                - id
+                                - cpus
+                                - memory
+                                type: object
+                              type: array
+                            guestMappingPassthrough:
+                              description: |-
+                                GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
+                                The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
+                              type: object
+                          type: object
+                        realtime:
+                          description: Realtime instructs the virt-launcher to tune
+                            the VMI for lower latency, optional for real time workloads
+                          properties:
+                            mask:
+                              description: |-
+                                Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
+                                Example: "0-3,^1","0,2,3","2-3"
+                              type: string
+                          type: object
+                        sockets:
+                          description: |-
+                            Sockets specifies the number of sockets inside the vmi.
+                            Must be a value greater or equal 1.
+                          format: int32
+                          type: integer
+                        threads:
                           description: |-
                             Threads specifies the number of threads inside the vmi.
                             Must be a value greater or equal 1.
@@ -6017,6 +6877,11 @@ var CRDsValidation map[string]string = map[string]string{
                             Whether to attach the VSOCK CID to the VM or not.
                             VSOCK access will be available if set to true. Defaults to false.
                           type: boolean
+                        autoattachVirtIODrivers:
+                          description: |-
+                            Whether to attach the VirtIO driver ISO as a CD-ROM, to install the drivers in Windows guests.
+                            Requires the VirtIO drivers autoattach to be enabled in the cluster config. Defaults to false.
+                          type: boolean
                         blockMultiQueue:
                           description: |-
                             Whether or not to enable virtio multi-queue for block devices.
@@ -6026,6 +6891,28 @@ var CRDsValidation map[string]string = map[string]string{
                           description: To configure and access client devices such
                             as redirecting USB
                           type: object
+                        consoleSharing:
+                          description: |-
+                            ConsoleSharing configures how many clients can be connected to the serial console and to VNC at the same time.
+                            Defaults to a single exclusive connection, which is disconnected by a new connection.
+                          properties:
+                            maxConnections:
+                              description: |-
+                                MaxConnections limits the number of concurrent connections of the shared policies to the serial console
+                                and to VNC each, further connections are refused. Defaults to 4.
+                              format: int32
+                              maximum: 16
+                              minimum: 1
+                              type: integer
+                            policy:
+                              description: Policy defines how the connections are shared, Exclusive,
+                                SharedReadOnly or SharedFull. Defaults to Exclusive.
+                              enum:
+                              - Exclusive
+                              - SharedReadOnly
+                              - SharedFull
+                              type: string
+                          type: object
                         disableHotplug:
                           description: DisableHotplug disabled the ability to hotplug
                             disks.
@@ -6110,7 +6997,7 @@ var CRDsValidation map[string]string = map[string]string{
                                   bus:
                                     description: |-
                                       Bus indicates the type of disk device to emulate.
-                                      supported values: virtio, sata, scsi, usb.
+                                      supported values: virtio, sata, scsi, usb, nvme.
                                     type: string
                                   pciAddress:
                                     description: 'If specified, the virtual disk will
@@ -6130,8 +7017,77 @@ var CRDsValidation map[string]string = map[string]string{
                               io:
                                 description: |-
                                   IO specifies which QEMU disk IO mode should be used.
-                                  Supported values are: native, default, threads.
+                                  Supported values are: native, default, threads, io_uring.
                                 type: string
+                              ioLimits:
+                                description: |-
+                                  IOLimits throttles the IO operations and bandwidth of the disk.
+                                  They can be updated on a running VMI through the iolimits subresource.
+                                properties:
+                                  burst:
+                                    description: Burst allows the disk to exceed its
+                                      limits for a short time.
+                                    properties:
+                                      lengthSeconds:
+                                        description: |-
+                                          LengthSeconds is for how long the disk may burst.
+                                          Defaults to 1.
+                                        format: int64
+                                        type: integer
+                                      readBandwidth:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: ReadBandwidth is the bytes read
+                                          per second the disk may burst to.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      readIOPS:
+                                        description: ReadIOPS is the read operations
+                                          per second the disk may burst to.
+                                        format: int64
+                                        type: integer
+                                      writeBandwidth:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: WriteBandwidth is the bytes written
+                                          per second the disk may burst to.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      writeIOPS:
+                                        description: WriteIOPS is the write operations
+                                          per second the disk may burst to.
+                                        format: int64
+                                        type: integer
+                                    type: object
+                                  readBandwidth:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: ReadBandwidth limits the bytes read
+                                      per second, e.g. 100Mi.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  readIOPS:
+                                    description: ReadIOPS limits the read operations
+                                      per second.
+                                    format: int64
+                                    type: integer
+                                  writeBandwidth:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: WriteBandwidth limits the bytes written
+                                      per second, e.g. 100Mi.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  writeIOPS:
+                                    description: WriteIOPS limits the write operations
+                                      per second.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -6185,6 +7141,22 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                               virtiofs:
                                 description: Virtiofs is supported
+                                properties:
+                                  cache:
+                                    description: |-
+                                      Cache specifies the caching mode of virtiofsd.
+                                      Supported values are:
+                                      auto: metadata and paths are cached with a timeout, data is cached until the file is closed.
+                                      always: metadata, data and paths are cached indefinitely.
+                                      never: nothing is cached, changes on the host are seen immediately by the guest.
+                                      Defaults to auto.
+                                    type: string
+                                  threadPoolSize:
+                                    description: |-
+                                      ThreadPoolSize is the maximum number of worker threads virtiofsd uses
+                                      to serve requests. Defaults to the virtiofsd default.
+                                    format: int32
+                                    type: integer
                                 type: object
                             required:
                             - name
@@ -6209,6 +7181,12 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: Name of the GPU device as exposed by
                                   a device plugin
                                 type: string
+                              profile:
+                                description: |-
+                                  Profile is the name of a mediated device profile of the KubeVirt CR.
+                                  It is resolved on each node to the mediated device type the profile maps to.
+                                  Can't be set together with a different deviceName.
+                                type: string
                               requestName:
                                 description: |-
                                   RequestName needs to be provided from resourceClaim.spec.devices.requests[].name where this
@@ -6246,6 +7224,19 @@ var CRDsValidation map[string]string = map[string]string{
                             type: object
                           type: array
                           x-kubernetes-list-type: atomic
+                        graphics:
+                          description: Graphics defines the protocol of the auto-attached
+                            graphics device.
+                          properties:
+                            type:
+                              description: |-
+                                Type of the graphics device, VNC or SPICE. SPICE additionally allows the client to play the audio
+                                of the sound device and to redirect USB devices when clientPassthrough is set. Defaults to VNC.
+                              enum:
+                              - VNC
+                              - SPICE
+                              type: string
+                          type: object
                         hostDevices:
                           description: Whether to attach a host device to the vmi.
                           items:
@@ -6441,6 +7432,12 @@ var CRDsValidation map[string]string = map[string]string{
                                   - port
                                   type: object
                                 type: array
+                              rxQueueSize:
+                                description: |-
+                                  RxQueueSize sets the size of the receive virtqueue of the interface.
+                                  Must be a power of 2 between 256 and 1024 and is only supported by the virtio model.
+                                format: int32
+                                type: integer
                               slirp:
                                 description: |-
                                   DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -6464,6 +7461,12 @@ var CRDsValidation map[string]string = map[string]string{
                                   address and its tag will be provided to the guest
                                   via config drive
                                 type: string
+                              txQueueSize:
+                                description: |-
+                                  TxQueueSize sets the size of the transmit virtqueue of the interface.
+                                  Must be a power of 2 between 256 and 1024 and is only supported by the virtio model.
+                                format: int32
+                                type: integer
                             required:
                             - name
                             type: object
@@ -6555,7 +7558,8 @@ var CRDsValidation map[string]string = map[string]string{
                               properties:
                                 action:
                                   description: |-
-                                    The action to take. Valid values are poweroff, reset, shutdown.
+                                    The action to take. Valid values are poweroff, reset, shutdown, migrate, snapshot-reset, alert.
+                                    The migrate, snapshot-reset and alert actions are taken by KubeVirt rather than by the hypervisor.
                                     Defaults to reset.
                                   type: string
                               type: object
@@ -6564,7 +7568,8 @@ var CRDsValidation map[string]string = map[string]string{
                               properties:
                                 action:
                                   description: |-
-                                    The action to take. Valid values are poweroff, reset, shutdown.
+                                    The action to take. Valid values are poweroff, reset, shutdown, migrate, snapshot-reset, alert.
+                                    The migrate, snapshot-reset and alert actions are taken by KubeVirt rather than by the hypervisor.
                                     Defaults to reset.
                                   type: string
                               type: object
@@ -6974,6 +7979,41 @@ var CRDsValidation map[string]string = map[string]string{
                     memory:
                       description: Memory allow specifying the VMI memory features.
                       properties:
+                        balloon:
+                          description: |-
+                            Balloon lets virt-handler reclaim unused guest memory through the memory balloon.
+                            Fields which are set override the cluster wide memoryBalloon configuration.
+                          properties:
+                            max:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                Max is the largest amount of memory the balloon gives to the guest.
+                                Defaults to the guest memory and can not exceed it.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            min:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                Min is the least amount of memory the balloon leaves to the guest.
+                                Defaults to half of the guest memory.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            targetFreePercentage:
+                              description: |-
+                                TargetFreePercentage is the share of the memory given to the guest which should remain
+                                available to it. Defaults to 20.
+                              format: int32
+                              type: integer
+                          type: object
+                        freePageReporting:
+                          description: |-
+                            FreePageReporting lets the guest report its free memory pages to the host so that they can be reclaimed.
+                            Defaults to the cluster wide setting. It is never enabled for VMIs requesting dedicated CPUs, hugepages or realtime.
+                          type: boolean
                         guest:
                           anyOf:
                           - type: integer
@@ -6992,7 +8032,22 @@ var CRDsValidation map[string]string = map[string]string{
                               description: PageSize specifies the hugepage size, for
                                 x86_64 architecture valid values are 1Gi and 2Mi.
                               type: string
+                            regularMemory:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                RegularMemory is the amount of the guest memory which is backed by regular pages instead of hugepages.
+                                The rest of the guest memory, including the memory hotplugged at runtime, is backed by hugepages.
+                                It must be a multiple of the page size and less than the guest memory.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
                           type: object
+                        ksmMergePolicy:
+                          description: |-
+                            KSMMergePolicy sets how aggressively the kernel samepage merging of the node merges the guest memory.
+                            Defaults to the mergePolicy of the cluster wide KSM configuration.
+                          type: string
                         maxGuest:
                           anyOf:
                           - type: integer
@@ -7002,6 +8057,11 @@ var CRDsValidation map[string]string = map[string]string{
                             The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
                           pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                           x-kubernetes-int-or-string: true
+                        overcommit:
+                          description: |-
+                            Overcommit is the memory overcommit class of the VirtualMachineInstance. It controls how much memory
+                            the pod requests, whether the guest memory can be swapped out and how KSM merges it.
+                          type: string
                       type: object
                     resources:
                       description: Resources describes the Compute Resources required
@@ -7048,11 +8108,96 @@ var CRDsValidation map[string]string = map[string]string{
                     - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                     - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                   type: string
+                hooks:
+                  description: |-
+                    Hooks are sidecar containers called by virt-launcher on the lifecycle points of the VMI,
+                    for example to adjust the domain before it is defined.
+                    Requires the Sidecar feature gate.
+                  items:
+                    description: Hook is a sidecar container called by virt-launcher
+                      on the lifecycle points of the VMI.
+                    properties:
+                      args:
+                        description: Arguments to the entrypoint. The image's CMD
+                          is used if this is not provided.
+                        items:
+                          type: string
+                        type: array
+                      command:
+                        description: Entrypoint of the sidecar container. The image's
+                          ENTRYPOINT is used if this is not provided.
+                        items:
+                          type: string
+                        type: array
+                      hookPoints:
+                        description: HookPoints the hook is called on. Hook points
+                          exposed by the hook but not listed here are not called.
+                        items:
+                          description: HookPoint is a lifecycle point of the VMI a
+                            hook can be called on.
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: set
+                      image:
+                        description: Image of the sidecar container serving the hook.
+                        type: string
+                      imagePullPolicy:
+                        description: |-
+                          Image pull policy.
+                          One of Always, Never, IfNotPresent.
+                          Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+                        type: string
+                      name:
+                        description: Name of the hook, unique within the VMI. The
+                          sidecar container is named hook-<name>.
+                        type: string
+                      version:
+                        description: |-
+                          Version of the hook API virt-launcher uses to call the hook.
+                          Defaults to the newest version exposed by the hook.
+                        type: string
+                    required:
+                    - hookPoints
+                    - image
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
                 hostname:
                   description: |-
                     Specifies the hostname of the vmi
                     If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                   type: string
+                idlePolicy:
+                  description: IdlePolicy pauses or stops the VMI once the guest has
+                    been idle for a while.
+                  properties:
+                    action:
+                      description: Action taken once the guest is idle. Defaults to
+                        Pause.
+                      enum:
+                      - Pause
+                      - Stop
+                      type: string
+                    cpuThresholdPercentage:
+                      description: |-
+                        CPUThresholdPercentage is the usage of the vCPUs of the VMI, in percent, below which the
+                        guest is idle. Defaults to 5.
+                      format: int32
+                      type: integer
+                    ignoreUserSessions:
+                      description: IgnoreUserSessions considers the guest idle even
+                        if users are logged in to it.
+                      type: boolean
+                    timeout:
+                      description: Timeout is how long the guest has to be idle before
+                        the action is taken.
+                      type: string
+                  required:
+                  - timeout
+                  type: object
                 livenessProbe:
                   description: |-
                     Periodic probe of VirtualMachineInstance liveness.
@@ -7745,6 +8890,49 @@ var CRDsValidation map[string]string = map[string]string{
                             description: UserDataBase64 contains config drive cloud-init
                               userdata as a base64 encoded string.
                             type: string
+                          userDataSources:
+                            description: |-
+                              UserDataSources references additional Secrets and ConfigMaps containing config drive userdata.
+                              Their userdata is merged, in the listed order, after the userdata of this source into a single
+                              multi-part MIME userdata.
+                            items:
+                              description: |-
+                                CloudInitUserDataSource references a Secret or ConfigMap containing cloud-init userdata
+                                in its userdata or userData key. Only one of its members may be specified.
+                              properties:
+                                configMapRef:
+                                  description: ConfigMapRef references a k8s configmap
+                                    that contains userdata.
+                                  properties:
+                                    name:
+                                      default: ""
+                                      description: |-
+                                        Name of the referent.
+                                        This field is effectively required, but due to backwards compatibility is
+                                        allowed to be empty. Instances of this type with an empty value here are
+                                        almost certainly wrong.
+                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      type: string
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                                secretRef:
+                                  description: SecretRef references a k8s secret that
+                                    contains userdata.
+                                  properties:
+                                    name:
+                                      default: ""
+                                      description: |-
+                                        Name of the referent.
+                                        This field is effectively required, but due to backwards compatibility is
+                                        allowed to be empty. Instances of this type with an empty value here are
+                                        almost certainly wrong.
+                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      type: string
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
                         type: object
                       cloudInitNoCloud:
                         description: |-
@@ -7798,6 +8986,49 @@ var CRDsValidation map[string]string = map[string]string{
                             description: UserDataBase64 contains NoCloud cloud-init
                               userdata as a base64 encoded string.
                             type: string
+                          userDataSources:
+                            description: |-
+                              UserDataSources references additional Secrets and ConfigMaps containing NoCloud userdata.
+                              Their userdata is merged, in the listed order, after the userdata of this source into a single
+                              multi-part MIME userdata.
+                            items:
+                              description: |-
+                                CloudInitUserDataSource references a Secret or ConfigMap containing cloud-init userdata
+                                in its userdata or userData key. Only one of its members may be specified.
+                              properties:
+                                configMapRef:
+                                  description: ConfigMapRef references a k8s configmap
+                                    that contains userdata.
+                                  properties:
+                                    name:
+                                      default: ""
+                                      description: |-
+                                        Name of the referent.
+                                        This field is effectively required, but due to backwards compatibility is
+                                        allowed to be empty. Instances of this type with an empty value here are
+                                        almost certainly wrong.
+                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      type: string
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                                secretRef:
+                                  description: SecretRef references a k8s secret that
+                                    contains userdata.
+                                  properties:
+                                    name:
+                                      default: ""
+                                      description: |-
+                                        Name of the referent.
+                                        This field is effectively required, but due to backwards compatibility is
+                                        allowed to be empty. Instances of this type with an empty value here are
+                                        almost certainly wrong.
+                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      type: string
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
                         type: object
                       configMap:
                         description: |-
@@ -8022,6 +9253,32 @@ var CRDsValidation map[string]string = map[string]string{
                         - path
                         - type
                         type: object
+                      ignition:
+                        description: |-
+                          Ignition represents an Ignition config for CoreOS-style guests.
+                          The config is passed to the guest through the QEMU firmware configuration device, no disk is needed for this volume.
+                          There can only be one volume of this type!
+                          More info: https://coreos.github.io/ignition/
+                        properties:
+                          data:
+                            description: Data contains the inline Ignition config.
+                            type: string
+                          secretRef:
+                            description: SecretRef references a k8s secret that contains
+                              the Ignition config in its userdata or userData key.
+                            properties:
+                              name:
+                                default: ""
+                                description: |-
+                                  Name of the referent.
+                                  This field is effectively required, but due to backwards compatibility is
+                                  allowed to be empty. Instances of this type with an empty value here are
+                                  almost certainly wrong.
+                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                type: string
+                            type: object
+                            x-kubernetes-map-type: atomic
+                        type: object
                       memoryDump:
                         description: MemoryDump is attached to the virt launcher and
                           is populated with a memory dump of the vmi
@@ -8031,6 +9288,17 @@ var CRDsValidation map[string]string = map[string]string{
                               claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                               More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                             type: string
+                          content:
+                            description: |-
+                              Content is the part of the guest memory which is dumped, Full or Kernel.
+                              Defaults to Full
+                            type: string
+                          format:
+                            description: |-
+                              Format is the format the memory is dumped in. When the volume is not
+                              hotpluggable and holds a memory state, the VMI is resumed from it on start.
+                              Defaults to Raw
+                            type: string
                           hotpluggable:
                             description: Hotpluggable indicates whether the volume
                               can be hotplugged and hotunplugged.
@@ -8124,6 +9392,11 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                             type: object
                             x-kubernetes-map-type: atomic
+                          namespace:
+                            description: |-
+                              Namespace of the referenced ConfigMap. Defaults to the namespace of the VirtualMachineInstance.
+                              Other namespaces must be listed in the sysprep templateNamespaces of the KubeVirt configuration.
+                            type: string
                           secret:
                             description: Secret references a k8s Secret that contains
                               Sysprep answer file named autounattend.xml that should
@@ -8140,20 +9413,63 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                             type: object
                             x-kubernetes-map-type: atomic
-                        type: object
-                    required:
-                    - name
-                    type: object
-                  maxItems: 256
-                  type: array
-              required:
-              - domain
-              type: object
-          type: object
-        updateVolumesStrategy:
-          description: UpdateVolumesStrategy is the strategy to apply on volumes updates
-          type: string
-      required:
+                          template:
+                            description: Template enables rendering the answer files
+                              as Go templates, with the values of the VirtualMachineInstance.
+                            properties:
+                              parametersSecretRef:
+                                description: |-
+                                  ParametersSecretRef references a k8s Secret in the namespace of the VirtualMachineInstance,
+                                  whose keys are exposed to the templates as .Parameters, e.g. a license key.
+                                properties:
+                                  name:
+                                    default: ""
+                                    description: |-
+                                      Name of the referent.
+                                      This field is effectively required, but due to backwards compatibility is
+                                      allowed to be empty. Instances of this type with an empty value here are
+                                      almost certainly wrong.
+                                      More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                    type: string
+                                type: object
+                                x-kubernetes-map-type: atomic
+                            type: object
+                        type: object
+                      vhostUserBlk:
+                        description: |-
+                          VhostUserBlk connects the disk to a vhost-user-blk socket exposed on the node by a storage target like SPDK,
+                          bypassing the block layer of the host kernel.
+                        properties:
+                          resourceName:
+                            description: |-
+                              ResourceName is the name of the device plugin resource providing the socket.
+                              The device plugin passes the path of the allocated socket to the pod through the
+                              VHOST_USER_BLK_RESOURCE_<resource name> environment variable.
+                            type: string
+                          socketName:
+                            description: SocketName is the name of the socket in the
+                              vhost-user-blk socket directory of the node, /var/run/vhost-user-blk.
+                            type: string
+                        type: object
+                    required:
+                    - name
+                    type: object
+                  maxItems: 256
+                  type: array
+                workloadClass:
+                  description: |-
+                    WorkloadClass is the name of one of the workloadClasses of the KubeVirt configuration.
+                    The VMI gets the priority of the class, and may be preempted by VMIs of a higher
+                    priority if the class allows it.
+                  type: string
+              required:
+              - domain
+              type: object
+          type: object
+        updateVolumesStrategy:
+          description: UpdateVolumesStrategy is the strategy to apply on volumes updates
+          type: string
+      required:
       - template
       type: object
     status:
@@ -8200,6 +9516,61 @@ var CRDsValidation map[string]string = map[string]string{
             updated through an Update() before ObservedGeneration in Status.
           format: int64
           type: integer
+        failovers:
+          description: Failovers are the latest failovers of the VM from lost nodes,
+            the oldest first
+          items:
+            description: VirtualMachineFailover represents a failover of the VMI of
+              a VirtualMachine from a lost node
+            properties:
+              fenced:
+                description: Fenced indicates that a fencing agent confirmed the node
+                  to be fenced
+                type: boolean
+              node:
+                description: Node is the lost node the VMI was failed over from
+                type: string
+              nodeLostTime:
+                description: NodeLostTime is the time the node was detected as lost
+                format: date-time
+                type: string
+              time:
+                description: Time is the time the VMI was failed over
+                format: date-time
+                type: string
+            required:
+            - node
+            - nodeLostTime
+            - time
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        hibernation:
+          description: |-
+            Hibernation is set while the memory of the VM is saved to a PVC, from the
+            hibernate request until the VM is resumed
+          nullable: true
+          properties:
+            claimName:
+              description: ClaimName is the name of the PVC the memory of the VM is
+                saved to
+              type: string
+            lastTransitionTime:
+              description: LastTransitionTime is the time the hibernation entered
+                its phase
+              format: date-time
+              nullable: true
+              type: string
+            phase:
+              description: Phase is the phase of the hibernation
+              type: string
+            storageClassName:
+              description: StorageClassName is the storage class of the PVC
+              type: string
+          required:
+          - phase
+          - claimName
+          type: object
         instancetypeRef:
           description: InstancetypeRef captures the state of any referenced instance
             type from the VirtualMachine
@@ -8228,6 +9599,24 @@ var CRDsValidation map[string]string = map[string]string{
             name:
               description: Name is the name of resource
               type: string
+            pendingUpdate:
+              description: |-
+                PendingUpdate lists the changes of the resource not yet captured by the ControllerRevision.
+                The VirtualMachine is moved to a new ControllerRevision once the update is approved on the resource.
+              properties:
+                changedFields:
+                  description: ChangedFields lists the paths of the spec fields differing
+                    between the resource and the ControllerRevision
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+                observedGeneration:
+                  description: ObservedGeneration is the generation of the resource the
+                    changes were computed against
+                  format: int64
+                  type: integer
+              type: object
           type: object
         memoryDumpRequest:
           description: |-
@@ -8239,6 +9628,10 @@ var CRDsValidation map[string]string = map[string]string{
               description: ClaimName is the name of the pvc that will contain the
                 memory dump
               type: string
+            content:
+              description: Content is the part of the guest memory which is dumped,
+                defaults to Full
+              type: string
             endTimestamp:
               description: EndTimestamp represents the time the memory dump was completed
               format: date-time
@@ -8246,6 +9639,10 @@ var CRDsValidation map[string]string = map[string]string{
             fileName:
               description: FileName represents the name of the output file
               type: string
+            format:
+              description: Format is the format the memory is dumped in, defaults
+                to Raw
+              type: string
             message:
               description: Message is a detailed message about failure of the memory
                 dump
@@ -8270,6 +9667,49 @@ var CRDsValidation map[string]string = map[string]string{
             started.
           format: int64
           type: integer
+        powerSchedule:
+          description: PowerSchedule represents the state of the power schedule of
+            the VM
+          nullable: true
+          properties:
+            lastAction:
+              description: LastAction is the last action taken according to the power
+                schedule
+              type: string
+            lastActionTime:
+              description: LastActionTime is the scheduled time of the last action
+              format: date-time
+              type: string
+            nextAction:
+              description: NextAction is the next action of the power schedule
+              type: string
+            nextActionTime:
+              description: NextActionTime is the scheduled time of the next action
+              format: date-time
+              type: string
+          type: object
+        preemption:
+          description: |-
+            Preemption is set while the VM is paused or hibernated to make room for a VM
+            of a higher priority
+          nullable: true
+          properties:
+            action:
+              description: Action is what was done to the VM, Stop or Hibernate
+              type: string
+            preemptedBy:
+              description: PreemptedBy is the namespace and name of the VM which could
+                not be scheduled
+              type: string
+            time:
+              description: Time is when the VM was preempted
+              format: date-time
+              nullable: true
+              type: string
+          required:
+          - action
+          - preemptedBy
+          type: object
         preferenceRef:
           description: PreferenceRef captures the state of any referenced preference
             from the VirtualMachine
@@ -8298,6 +9738,24 @@ var CRDsValidation map[string]string = map[string]string{
             name:
               description: Name is the name of resource
               type: string
+            pendingUpdate:
+              description: |-
+                PendingUpdate lists the changes of the resource not yet captured by the ControllerRevision.
+                The VirtualMachine is moved to a new ControllerRevision once the update is approved on the resource.
+              properties:
+                changedFields:
+                  description: ChangedFields lists the paths of the spec fields differing
+                    between the resource and the ControllerRevision
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+                observedGeneration:
+                  description: ObservedGeneration is the generation of the resource the
+                    changes were computed against
+                  format: int64
+                  type: integer
+              type: object
           type: object
         printableStatus:
           default: Stopped
@@ -8307,6 +9765,23 @@ var CRDsValidation map[string]string = map[string]string{
         ready:
           description: Ready indicates if the virtual machine is running and ready
           type: boolean
+        rescue:
+          description: |-
+            Rescue is set while the VM is in rescue mode, the VMI boots from the
+            rescue image with the volumes of the VM attached
+          nullable: true
+          properties:
+            image:
+              description: Image is the containerDisk image the VMI boots from in
+                rescue mode
+              type: string
+            readOnly:
+              description: ReadOnly indicates that the disks of the VM are attached
+                read-only
+              type: boolean
+          required:
+          - image
+          type: object
         restoreInProgress:
           description: RestoreInProgress is the name of the VirtualMachineRestore
             currently executing
@@ -8320,6 +9795,27 @@ var CRDsValidation map[string]string = map[string]string{
           description: SnapshotInProgress is the name of the VirtualMachineSnapshot
             currently executing
           type: string
+        stagedChanges:
+          description: |-
+            StagedChanges lists the changes of the template which are not applied to the running VMI yet,
+            when the VM uses the Staged change apply strategy
+          nullable: true
+          properties:
+            applyMethod:
+              description: ApplyMethod is the method of the pending request to apply
+                the changes
+              type: string
+            fields:
+              description: Fields are the paths of the changed fields of the template
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            restartRequired:
+              description: RestartRequired indicates that the changes can only be
+                applied by restarting the VM
+              type: boolean
+          type: object
         startFailure:
           description: |-
             StartFailure tracks consecutive VMI startup failures for the purposes of
@@ -8373,8 +9869,9 @@ var CRDsValidation map[string]string = map[string]string{
                   within this field specify how to add the volume
                 properties:
                   disk:
-                    description: Disk represents the hotplug disk that will be plugged
-                      into the running VMI
+                    description: |-
+                      Disk represents the hotplug disk that will be plugged into the running VMI.
+                      Either Disk or Filesystem has to be set.
                     properties:
                       blockSize:
                         description: If specified, the virtual disk will be presented
@@ -8450,7 +9947,7 @@ var CRDsValidation map[string]string = map[string]string{
                           bus:
                             description: |-
                               Bus indicates the type of disk device to emulate.
-                              supported values: virtio, sata, scsi, usb.
+                              supported values: virtio, sata, scsi, usb, nvme.
                             type: string
                           pciAddress:
                             description: 'If specified, the virtual disk will be placed
@@ -8470,8 +9967,76 @@ var CRDsValidation map[string]string = map[string]string{
                       io:
                         description: |-
                           IO specifies which QEMU disk IO mode should be used.
-                          Supported values are: native, default, threads.
+                          Supported values are: native, default, threads, io_uring.
                         type: string
+                      ioLimits:
+                        description: |-
+                          IOLimits throttles the IO operations and bandwidth of the disk.
+                          They can be updated on a running VMI through the iolimits subresource.
+                        properties:
+                          burst:
+                            description: Burst allows the disk to exceed its limits
+                              for a short time.
+                            properties:
+                              lengthSeconds:
+                                description: |-
+                                  LengthSeconds is for how long the disk may burst.
+                                  Defaults to 1.
+                                format: int64
+                                type: integer
+                              readBandwidth:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: ReadBandwidth is the bytes read per second
+                                  the disk may burst to.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              readIOPS:
+                                description: ReadIOPS is the read operations per second
+                                  the disk may burst to.
+                                format: int64
+                                type: integer
+                              writeBandwidth:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: WriteBandwidth is the bytes written per
+                                  second the disk may burst to.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              writeIOPS:
+                                description: WriteIOPS is the write operations per
+                                  second the disk may burst to.
+                                format: int64
+                                type: integer
+                            type: object
+                          readBandwidth:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBandwidth limits the bytes read per second,
+                              e.g. 100Mi.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readIOPS:
+                            description: ReadIOPS limits the read operations per second.
+                            format: int64
+                            type: integer
+                          writeBandwidth:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBandwidth limits the bytes written per
+                              second, e.g. 100Mi.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeIOPS:
+                            description: WriteIOPS limits the write operations per
+                              second.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -8519,6 +10084,37 @@ var CRDsValidation map[string]string = map[string]string{
                       type: string
                     type: array
                     x-kubernetes-list-type: atomic
+                  filesystem:
+                    description: |-
+                      Filesystem represents the virtiofs filesystem that will be plugged into
+                      the running VMI. Either Disk or Filesystem has to be set.
+                    properties:
+                      name:
+                        description: Name is the device name
+                        type: string
+                      virtiofs:
+                        description: Virtiofs is supported
+                        properties:
+                          cache:
+                            description: |-
+                              Cache specifies the caching mode of virtiofsd.
+                              Supported values are:
+                              auto: metadata and paths are cached with a timeout, data is cached until the file is closed.
+                              always: metadata, data and paths are cached indefinitely.
+                              never: nothing is cached, changes on the host are seen immediately by the guest.
+                              Defaults to auto.
+                            type: string
+                          threadPoolSize:
+                            description: |-
+                              ThreadPoolSize is the maximum number of worker threads virtiofsd uses
+                              to serve requests. Defaults to the virtiofsd default.
+                            format: int32
+                            type: integer
+                        type: object
+                    required:
+                    - name
+                    - virtiofs
+                    type: object
                   name:
                     description: |-
                       Name represents the name that will be used to map the
@@ -8570,7 +10166,6 @@ var CRDsValidation map[string]string = map[string]string{
                         type: object
                     type: object
                 required:
-                - disk
                 - name
                 - volumeSource
                 type: object
@@ -8753,8 +10348,12 @@ var CRDsValidation map[string]string = map[string]string{
   - spec
   type: object
 `,
-	"virtualmachineclone": `openAPIV3Schema:
-  description: VirtualMachineClone is a CRD that clones one VM into another.
+	"virtualmachineautoscalingpolicy": `openAPIV3Schema:
+  description: |-
+    VirtualMachineAutoscalingPolicy resizes the CPU sockets and the guest memory of the selected
+    VirtualMachines towards a target utilization, within the bounds set by the user.
+    The resize goes through CPU and memory hotplug. When a resize can't be hotplugged,
+    the VirtualMachines can optionally be restarted on a schedule to apply it.
   properties:
     apiVersion:
       description: |-
@@ -8775,86 +10374,282 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     spec:
       properties:
-        annotationFilters:
-          description: |-
-            Example use: "!some/key*".
-            For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
-          items:
-            type: string
-          type: array
-          x-kubernetes-list-type: atomic
-        labelFilters:
-          description: |-
-            Example use: "!some/key*".
-            For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
-          items:
-            type: string
-          type: array
-          x-kubernetes-list-type: atomic
-        newMacAddresses:
-          additionalProperties:
-            type: string
-          description: |-
-            NewMacAddresses manually sets that target interfaces' mac addresses. The key is the interface name and the
-            value is the new mac address. If this field is not specified, a new MAC address will
-            be generated automatically, as for any interface that is not included in this map.
-          type: object
-        newSMBiosSerial:
-          description: |-
-            NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, a new serial will
-            be generated automatically.
+        cooldown:
+          description: Cooldown is the minimum time between two resizes of a VirtualMachine.
+            Defaults to 5m.
           type: string
-        patches:
-          description: |-
-            Patches holds JSON patches to apply to target. Patches should fit the target's Kind.
-            Example: '{"op": "add", "path": "/spec/template/metadata/labels/example", "value": "new-label"}'
-          items:
-            type: string
-          type: array
-          x-kubernetes-list-type: atomic
-        source:
-          description: |-
-            Source is the object that would be cloned. Currently supported source types are:
-            VirtualMachine of kubevirt.io API group,
-            VirtualMachineSnapshot of snapshot.kubevirt.io API group
+        cpu:
+          description: CPU sets the bounds the CPU sockets are resized within.
           properties:
-            apiGroup:
+            maxSockets:
+              description: MaxSockets is the highest number of CPU sockets a VirtualMachine
+                is resized to.
+              format: int32
+              type: integer
+            minSockets:
+              description: MinSockets is the lowest number of CPU sockets a VirtualMachine
+                is resized to.
+              format: int32
+              type: integer
+            targetUtilizationPercentage:
               description: |-
-                APIGroup is the group for the resource being referenced.
-                If APIGroup is not specified, the specified Kind must be in the core API group.
-                For any other third-party types, APIGroup is required.
-              type: string
-            kind:
-              description: Kind is the type of resource being referenced
-              type: string
-            name:
-              description: Name is the name of resource being referenced
-              type: string
+                TargetUtilizationPercentage is the CPU usage, in percent of the vCPUs, the sockets are resized towards.
+                Defaults to 70.
+              format: int32
+              type: integer
           required:
-          - kind
-          - name
+          - maxSockets
+          - minSockets
           type: object
-          x-kubernetes-map-type: atomic
-        target:
-          description: |-
-            Target is the outcome of the cloning process.
-            Currently supported source types are:
-            - VirtualMachine of kubevirt.io API group
-            - Empty (nil).
-            If the target is not provided, the target type would default to VirtualMachine and a random
-            name would be generated for the target. The target's name can be viewed by
-            inspecting status "TargetName" field below.
+        memory:
+          description: Memory sets the bounds the guest memory is resized within.
           properties:
-            apiGroup:
+            max:
+              anyOf:
+              - type: integer
+              - type: string
+              description: Max is the highest guest memory a VirtualMachine is resized
+                to.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            min:
+              anyOf:
+              - type: integer
+              - type: string
+              description: Min is the lowest guest memory a VirtualMachine is resized
+                to.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            targetUtilizationPercentage:
               description: |-
-                APIGroup is the group for the resource being referenced.
-                If APIGroup is not specified, the specified Kind must be in the core API group.
-                For any other third-party types, APIGroup is required.
-              type: string
-            kind:
-              description: Kind is the type of resource being referenced
-              type: string
-            name:
+                TargetUtilizationPercentage is the memory usage, in percent of the guest memory, the memory is resized towards.
+                Defaults to 70.
+              format: int32
+              type: integer
+          required:
+          - max
+          - min
+          type: object
+        restartFallback:
+          description: |-
+            RestartFallback restarts the VirtualMachines which can't be resized through hotplug.
+            The VirtualMachines are left as they are when not set.
+          properties:
+            schedule:
+              description: Schedule is the cron expression of the times a VirtualMachine
+                may be restarted at.
+              type: string
+          required:
+          - schedule
+          type: object
+        selector:
+          description: Selector selects the VirtualMachines of the namespace the policy
+            applies to by their labels.
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
+      required:
+      - selector
+      type: object
+    status:
+      properties:
+        virtualMachines:
+          description: VirtualMachines holds the state of the VirtualMachines the
+            policy applies to.
+          items:
+            properties:
+              lastScaleTime:
+                description: LastScaleTime is the time the VirtualMachine was last
+                  resized at.
+                format: date-time
+                nullable: true
+                type: string
+              memory:
+                anyOf:
+                - type: integer
+                - type: string
+                description: Memory is the guest memory the VirtualMachine was last
+                  resized to.
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+              name:
+                description: Name is the name of the VirtualMachine.
+                type: string
+              restartTime:
+                description: |-
+                  RestartTime is the time the VirtualMachine is restarted at to apply a resize
+                  which can't be hotplugged.
+                format: date-time
+                nullable: true
+                type: string
+              sockets:
+                description: Sockets is the number of CPU sockets the VirtualMachine
+                  was last resized to.
+                format: int32
+                type: integer
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineclone": `openAPIV3Schema:
+  description: VirtualMachineClone is a CRD that clones one VM into another.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        annotationFilters:
+          description: |-
+            Example use: "!some/key*".
+            For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        labelFilters:
+          description: |-
+            Example use: "!some/key*".
+            For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        newMacAddresses:
+          additionalProperties:
+            type: string
+          description: |-
+            NewMacAddresses manually sets that target interfaces' mac addresses. The key is the interface name and the
+            value is the new mac address. If this field is not specified, a new MAC address will
+            be generated automatically, as for any interface that is not included in this map.
+          type: object
+        newSMBiosSerial:
+          description: |-
+            NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, a new serial will
+            be generated automatically.
+          type: string
+        patches:
+          description: |-
+            Patches holds JSON patches to apply to target. Patches should fit the target's Kind.
+            Example: '{"op": "add", "path": "/spec/template/metadata/labels/example", "value": "new-label"}'
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        source:
+          description: |-
+            Source is the object that would be cloned. Currently supported source types are:
+            VirtualMachine of kubevirt.io API group,
+            VirtualMachineSnapshot of snapshot.kubevirt.io API group
+          properties:
+            apiGroup:
+              description: |-
+                APIGroup is the group for the resource being referenced.
+                If APIGroup is not specified, the specified Kind must be in the core API group.
+                For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+          x-kubernetes-map-type: atomic
+        storage:
+          description: Storage defines how the volumes of the target are provisioned.
+          properties:
+            cloneStrategy:
+              description: CloneStrategy selects how the volumes of the source are
+                cloned. Defaults to Full.
+              enum:
+              - Full
+              - Linked
+              type: string
+          type: object
+        target:
+          description: |-
+            Target is the outcome of the cloning process.
+            Currently supported source types are:
+            - VirtualMachine of kubevirt.io API group
+            - Empty (nil).
+            If the target is not provided, the target type would default to VirtualMachine and a random
+            name would be generated for the target. The target's name can be viewed by
+            inspecting status "TargetName" field below.
+          properties:
+            apiGroup:
+              description: |-
+                APIGroup is the group for the resource being referenced.
+                If APIGroup is not specified, the specified Kind must be in the core API group.
+                For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
               description: Name is the name of resource being referenced
               type: string
           required:
@@ -8862,6 +10657,13 @@ var CRDsValidation map[string]string = map[string]string{
           - name
           type: object
           x-kubernetes-map-type: atomic
+        targetNamespace:
+          description: |-
+            TargetNamespace is the namespace in which the target is created. If not provided, the target
+            is created in the namespace of the clone. Creating the target in another namespace requires
+            the permission to create VirtualMachines in it, and the volumes of the target are cloned by
+            DataVolumes which have to be authorized to clone from the namespace of the clone.
+          type: string
         template:
           description: For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
           properties:
@@ -8882,6 +10684,38 @@ var CRDsValidation map[string]string = map[string]string{
               type: array
               x-kubernetes-list-type: atomic
           type: object
+        volumes:
+          description: |-
+            Volumes customizes how individual volumes of the source are cloned. Volumes that are not
+            listed are cloned as is, at their original size.
+          items:
+            description: VirtualMachineCloneVolume customizes the clone of a single
+              source volume.
+            properties:
+              exclude:
+                description: Exclude drops the volume, along with its disk, from the
+                  target.
+                type: boolean
+              name:
+                description: Name is the name of the volume in the source.
+                type: string
+              size:
+                anyOf:
+                - type: integer
+                - type: string
+                description: |-
+                  Size overrides the requested storage size of the cloned volume. Only volumes backed by a
+                  PersistentVolumeClaim or a DataVolume can be resized and the size must not be smaller than
+                  the size of the source volume.
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - name
+          x-kubernetes-list-type: map
       required:
       - source
       type: object
@@ -8934,9 +10768,9 @@ var CRDsValidation map[string]string = map[string]string{
   - spec
   type: object
 `,
-	"virtualmachineclusterinstancetype": `openAPIV3Schema:
-  description: VirtualMachineClusterInstancetype is a cluster scoped version of VirtualMachineInstancetype
-    resource.
+	"virtualmachineclusterdefault": `openAPIV3Schema:
+  description: VirtualMachineClusterDefault is a cluster scoped version of VirtualMachineDefault
+    resource, applied to the VirtualMachines of all namespaces.
   properties:
     apiVersion:
       description: |-
@@ -8956,51 +10790,249 @@ var CRDsValidation map[string]string = map[string]string{
     metadata:
       type: object
     spec:
-      description: Required spec describing the instancetype
+      description: Required spec describing the defaults
       properties:
-        annotations:
-          additionalProperties:
-            type: string
-          description: Optionally defines the required Annotations to be used by the
-            instance type and applied to the VirtualMachineInstance
-          type: object
-        cpu:
-          description: Required CPU related attributes of the instancetype.
+        devices:
+          description: Devices optionally defines defaults for the devices of the
+            VirtualMachines.
           properties:
-            dedicatedCPUPlacement:
-              description: |-
-                DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node
-                with enough dedicated pCPUs and pin the vCPUs to it.
+            autoattachGraphicsDevice:
+              description: AutoattachGraphicsDevice optionally defines the value of
+                AutoattachGraphicsDevice
               type: boolean
-            guest:
-              description: |-
-                Required number of vCPUs to expose to the guest.
-
-                The resulting CPU topology being derived from the optional PreferredCPUTopology attribute of CPUPreferences that itself defaults to PreferSockets.
-              format: int32
-              type: integer
-            isolateEmulatorThread:
-              description: |-
-                IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place
-                the emulator thread on it.
+            autoattachMemBalloon:
+              description: AutoattachMemBalloon optionally defines the value of AutoattachMemBalloon
               type: boolean
-            maxSockets:
-              description: MaxSockets specifies the maximum amount of sockets that
-                can be hotplugged
-              format: int32
-              type: integer
-            model:
-              description: |-
-                Model specifies the CPU model inside the VMI.
-                List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
-                It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
-                and "host-model" to get CPU closest to the node one.
-                Defaults to host-model.
-              type: string
-            numa:
-              description: NUMA allows specifying settings for the guest NUMA topology
+            autoattachSerialConsole:
+              description: AutoattachSerialConsole optionally defines the value of
+                AutoattachSerialConsole
+              type: boolean
+            autoattachVSOCK:
+              description: AutoattachVSOCK optionally defines the value of AutoattachVSOCK,
+                e.g. to attach the vsock device used by guest agents
+              type: boolean
+            rng:
+              description: Rng optionally attaches a random number generator device.
+              type: object
+          type: object
+        firmware:
+          description: Firmware optionally defines defaults for the firmware of the
+            VirtualMachines.
+          properties:
+            bootloader:
+              description: Bootloader optionally defines the bootloader, BIOS or EFI,
+                of the VirtualMachines.
               properties:
-                guestMappingPassthrough:
+                bios:
+                  description: If set (default), BIOS will be used.
+                  properties:
+                    useSerial:
+                      description: If set, the BIOS output will be transmitted over
+                        serial
+                      type: boolean
+                  type: object
+                efi:
+                  description: If set, EFI will be used instead of BIOS.
+                  properties:
+                    persistent:
+                      description: |-
+                        If set to true, Persistent will persist the EFI NVRAM across reboots.
+                        Defaults to false
+                      type: boolean
+                    secureBoot:
+                      description: |-
+                        If set, SecureBoot will be enabled and the OVMF roms will be swapped for
+                        SecureBoot-enabled ones.
+                        Requires SMM to be enabled.
+                        Defaults to true
+                      type: boolean
+                  type: object
+              type: object
+          type: object
+        machineType:
+          description: MachineType optionally defines the machine type of the VirtualMachines.
+          type: string
+        podNetworkBinding:
+          description: |-
+            PodNetworkBinding optionally defines the binding of the interface attached to the pod network of the
+            VirtualMachines without interfaces and networks.
+            Supported values are bridge, masquerade and the name of a network binding plugin registered in the KubeVirt CR.
+          type: string
+        runStrategy:
+          description: RunStrategy optionally defines the RunStrategy of the VirtualMachines
+            setting neither runStrategy nor running.
+          type: string
+        selector:
+          description: |-
+            Selector optionally restricts the defaults to the VirtualMachines with matching labels.
+            The defaults apply to all VirtualMachines when it is not set.
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineclusterinstancetype": `openAPIV3Schema:
+  description: VirtualMachineClusterInstancetype is a cluster scoped version of VirtualMachineInstancetype
+    resource.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: Required spec describing the instancetype
+      properties:
+        annotations:
+          additionalProperties:
+            type: string
+          description: Optionally defines the required Annotations to be used by the
+            instance type and applied to the VirtualMachineInstance
+          type: object
+        cpu:
+          description: Required CPU related attributes of the instancetype.
+          properties:
+            dedicatedCPUPlacement:
+              description: |-
+                DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node
+                with enough dedicated pCPUs and pin the vCPUs to it.
+              type: boolean
+            guest:
+              description: |-
+                Required number of vCPUs to expose to the guest.
+
+                The resulting CPU topology being derived from the optional PreferredCPUTopology attribute of CPUPreferences that itself defaults to PreferSockets.
+              format: int32
+              type: integer
+            isolateEmulatorThread:
+              description: |-
+                IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place
+                the emulator thread on it.
+              type: boolean
+            maxSockets:
+              description: MaxSockets specifies the maximum amount of sockets that
+                can be hotplugged
+              format: int32
+              type: integer
+            model:
+              description: |-
+                Model specifies the CPU model inside the VMI.
+                List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
+                It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
+                and "host-model" to get CPU closest to the node one.
+                Defaults to host-model.
+              type: string
+            numa:
+              description: NUMA allows specifying settings for the guest NUMA topology
+              properties:
+                guestCells:
+                  description: |-
+                    GuestCells defines an explicit guest NUMA topology. Each vCPU has to be assigned to exactly one cell,
+                    and the memory of the cells has to add up to the guest memory.
+                    Can't be combined with GuestMappingPassthrough.
+                  items:
+                    description: NUMAGuestCell defines a NUMA cell of the guest.
+                    properties:
+                      cpus:
+                        description: CPUs is the list of the vCPUs of the cell, e.g.
+                          "0-3,8".
+                        type: string
+                      distances:
+                        description: |-
+                          Distances lists the distances from this cell to the other cells.
+                          The distances which are not listed are picked by the hypervisor.
+                        items:
+                          description: NUMAGuestCellDistance defines the distance
+                            from a NUMA cell of the guest to another one.
+                          properties:
+                            cellID:
+                              description: CellID is the ID of the other cell.
+                              format: int32
+                              type: integer
+                            value:
+                              description: Value is the distance, between 10 and 255.
+                                10 is the distance of a cell to itself.
+                              format: int32
+                              type: integer
+                          required:
+                          - cellID
+                          - value
+                          type: object
+                        type: array
+                      id:
+                        description: ID of the cell. The IDs of the cells have to
+                          range from 0 to the number of cells minus one.
+                        format: int32
+                        type: integer
+                      memory:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Memory is the amount of guest memory of the cell.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    required:
+                    - id
+                    - cpus
+                    - memory
+                    type: object
+                  type: array
+                guestMappingPassthrough:
                   description: |-
                     GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
                     The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
@@ -9034,6 +11066,12 @@ var CRDsValidation map[string]string = map[string]string{
               name:
                 description: Name of the GPU device as exposed by a device plugin
                 type: string
+              profile:
+                description: |-
+                  Profile is the name of a mediated device profile of the KubeVirt CR.
+                  It is resolved on each node to the mediated device type the profile maps to.
+                  Can't be set together with a different deviceName.
+                type: string
               requestName:
                 description: |-
                   RequestName needs to be provided from resourceClaim.spec.devices.requests[].name where this
@@ -9132,6 +11170,41 @@ var CRDsValidation map[string]string = map[string]string{
         memory:
           description: Required Memory related attributes of the instancetype.
           properties:
+            balloon:
+              description: |-
+                Balloon lets virt-handler reclaim unused guest memory through the memory balloon.
+                Fields which are set override the cluster wide memoryBalloon configuration.
+              properties:
+                max:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    Max is the largest amount of memory the balloon gives to the guest.
+                    Defaults to the guest memory and can not exceed it.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                min:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    Min is the least amount of memory the balloon leaves to the guest.
+                    Defaults to half of the guest memory.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                targetFreePercentage:
+                  description: |-
+                    TargetFreePercentage is the share of the memory given to the guest which should remain
+                    available to it. Defaults to 20.
+                  format: int32
+                  type: integer
+              type: object
+            freePageReporting:
+              description: |-
+                FreePageReporting lets the guest report its free memory pages to the host so that they can be reclaimed.
+                Defaults to the cluster wide setting. It is never enabled for VMIs requesting dedicated CPUs, hugepages or realtime.
+              type: boolean
             guest:
               anyOf:
               - type: integer
@@ -9148,7 +11221,22 @@ var CRDsValidation map[string]string = map[string]string{
                   description: PageSize specifies the hugepage size, for x86_64 architecture
                     valid values are 1Gi and 2Mi.
                   type: string
+                regularMemory:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    RegularMemory is the amount of the guest memory which is backed by regular pages instead of hugepages.
+                    The rest of the guest memory, including the memory hotplugged at runtime, is backed by hugepages.
+                    It must be a multiple of the page size and less than the guest memory.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
               type: object
+            ksmMergePolicy:
+              description: |-
+                KSMMergePolicy sets how aggressively the kernel samepage merging of the node merges the guest memory.
+                Defaults to the mergePolicy of the cluster wide KSM configuration.
+              type: string
             maxGuest:
               anyOf:
               - type: integer
@@ -9158,6 +11246,11 @@ var CRDsValidation map[string]string = map[string]string{
                 The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
               pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
               x-kubernetes-int-or-string: true
+            overcommit:
+              description: |-
+                Overcommit is the memory overcommit class of the VirtualMachineInstance. It controls how much memory
+                the pod requests, whether the guest memory can be swapped out and how KSM merges it.
+              type: string
             overcommitPercent:
               description: |-
                 OvercommitPercent is the percentage of the guest memory which will be overcommitted.
@@ -9399,6 +11492,10 @@ var CRDsValidation map[string]string = map[string]string{
               description: PreferredAutoattachSerialConsole optionally defines the
                 preferred value of AutoattachSerialConsole
               type: boolean
+            preferredAutoattachVirtIODrivers:
+              description: PreferredAutoattachVirtIODrivers optionally defines the
+                preferred value of AutoattachVirtIODrivers
+              type: boolean
             preferredBlockMultiQueue:
               description: PreferredBlockMultiQueue optionally enables the vhost multiqueue
                 feature for virtio disks.
@@ -9453,6 +11550,10 @@ var CRDsValidation map[string]string = map[string]string{
               description: PreferredIo optionally defines the QEMU disk IO mode to
                 be used by Disk devices.
               type: string
+            preferredIOThreadsPolicy:
+              description: PreferredIOThreadsPolicy optionally defines the preferred
+                IOThreadsPolicy to be used by the VirtualMachine.
+              type: string
             preferredInputBus:
               description: PreferredInputBus optionally defines the preferred bus
                 for Input devices.
@@ -9469,6 +11570,16 @@ var CRDsValidation map[string]string = map[string]string{
               description: PreferredInterfaceModel optionally defines the preferred
                 model to be used by Interface devices.
               type: string
+            preferredInterfaceRxQueueSize:
+              description: PreferredInterfaceRxQueueSize optionally defines the preferred
+                receive queue size of virtio Interface devices.
+              format: int32
+              type: integer
+            preferredInterfaceTxQueueSize:
+              description: PreferredInterfaceTxQueueSize optionally defines the preferred
+                transmit queue size of virtio Interface devices.
+              format: int32
+              type: integer
             preferredLunBus:
               description: PreferredLunBus optionally defines the preferred bus for
                 Lun Disk devices.
@@ -9872,8 +11983,9 @@ var CRDsValidation map[string]string = map[string]string{
   - spec
   type: object
 `,
-	"virtualmachineexport": `openAPIV3Schema:
-  description: VirtualMachineExport defines the operation of exporting a VM source
+	"virtualmachinedefault": `openAPIV3Schema:
+  description: VirtualMachineDefault resource contains defaults applied to the VirtualMachines
+    created in its namespace.
   properties:
     apiVersion:
       description: |-
@@ -9893,52 +12005,376 @@ var CRDsValidation map[string]string = map[string]string{
     metadata:
       type: object
     spec:
-      description: VirtualMachineExportSpec is the spec for a VirtualMachineExport
-        resource
+      description: Required spec describing the defaults
       properties:
-        source:
-          description: |-
-            TypedLocalObjectReference contains enough information to let you locate the
-            typed referenced object inside the same namespace.
+        devices:
+          description: Devices optionally defines defaults for the devices of the
+            VirtualMachines.
           properties:
-            apiGroup:
-              description: |-
-                APIGroup is the group for the resource being referenced.
-                If APIGroup is not specified, the specified Kind must be in the core API group.
-                For any other third-party types, APIGroup is required.
-              type: string
-            kind:
-              description: Kind is the type of resource being referenced
-              type: string
-            name:
-              description: Name is the name of resource being referenced
-              type: string
-          required:
-          - kind
-          - name
+            autoattachGraphicsDevice:
+              description: AutoattachGraphicsDevice optionally defines the value of
+                AutoattachGraphicsDevice
+              type: boolean
+            autoattachMemBalloon:
+              description: AutoattachMemBalloon optionally defines the value of AutoattachMemBalloon
+              type: boolean
+            autoattachSerialConsole:
+              description: AutoattachSerialConsole optionally defines the value of
+                AutoattachSerialConsole
+              type: boolean
+            autoattachVSOCK:
+              description: AutoattachVSOCK optionally defines the value of AutoattachVSOCK,
+                e.g. to attach the vsock device used by guest agents
+              type: boolean
+            rng:
+              description: Rng optionally attaches a random number generator device.
+              type: object
           type: object
-          x-kubernetes-map-type: atomic
-        tokenSecretRef:
-          description: TokenSecretRef is the name of the custom-defined secret that
-            contains the token used by the export server pod
+        firmware:
+          description: Firmware optionally defines defaults for the firmware of the
+            VirtualMachines.
+          properties:
+            bootloader:
+              description: Bootloader optionally defines the bootloader, BIOS or EFI,
+                of the VirtualMachines.
+              properties:
+                bios:
+                  description: If set (default), BIOS will be used.
+                  properties:
+                    useSerial:
+                      description: If set, the BIOS output will be transmitted over
+                        serial
+                      type: boolean
+                  type: object
+                efi:
+                  description: If set, EFI will be used instead of BIOS.
+                  properties:
+                    persistent:
+                      description: |-
+                        If set to true, Persistent will persist the EFI NVRAM across reboots.
+                        Defaults to false
+                      type: boolean
+                    secureBoot:
+                      description: |-
+                        If set, SecureBoot will be enabled and the OVMF roms will be swapped for
+                        SecureBoot-enabled ones.
+                        Requires SMM to be enabled.
+                        Defaults to true
+                      type: boolean
+                  type: object
+              type: object
+          type: object
+        machineType:
+          description: MachineType optionally defines the machine type of the VirtualMachines.
           type: string
-        ttlDuration:
+        podNetworkBinding:
           description: |-
-            ttlDuration limits the lifetime of an export
-            If this field is set, after this duration has passed from counting from CreationTimestamp,
-            the export is eligible to be automatically deleted.
-            If this field is omitted, a reasonable default is applied.
+            PodNetworkBinding optionally defines the binding of the interface attached to the pod network of the
+            VirtualMachines without interfaces and networks.
+            Supported values are bridge, masquerade and the name of a network binding plugin registered in the KubeVirt CR.
           type: string
-      required:
-      - source
-      type: object
-    status:
-      description: VirtualMachineExportStatus is the status for a VirtualMachineExport
-        resource
-      properties:
-        conditions:
-          items:
-            description: Condition defines conditions
+        runStrategy:
+          description: RunStrategy optionally defines the RunStrategy of the VirtualMachines
+            setting neither runStrategy nor running.
+          type: string
+        selector:
+          description: |-
+            Selector optionally restricts the defaults to the VirtualMachines with matching labels.
+            The defaults apply to all VirtualMachines when it is not set.
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinedisruptionbudget": `openAPIV3Schema:
+  description: |-
+    VirtualMachineDisruptionBudget limits the number of VirtualMachineInstances of a group which
+    KubeVirt disrupts at the same time through voluntary disruptions, the migrations and shutdowns
+    of the node evacuations and of the workload updates.
+    Unlike a PodDisruptionBudget on the virt-launcher pods, a VirtualMachineInstance which is live
+    migrated is accounted as disrupted, and the budget is consulted before the disruption starts.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        maxUnavailable:
+          anyOf:
+          - type: integer
+          - type: string
+          description: |-
+            MaxUnavailable is the number or percentage of the selected VirtualMachineInstances which can be
+            disrupted or unavailable at the same time. Percentages are rounded up. Defaults to 1.
+          x-kubernetes-int-or-string: true
+        selector:
+          description: Selector selects the VirtualMachineInstances of the namespace
+            the budget applies to by their labels.
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
+      required:
+      - selector
+      type: object
+    status:
+      properties:
+        currentHealthy:
+          description: CurrentHealthy is the number of selected VirtualMachineInstances
+            which run and are not disrupted.
+          format: int32
+          type: integer
+        disruptedVirtualMachineInstances:
+          additionalProperties:
+            format: date-time
+            type: string
+          description: |-
+            DisruptedVirtualMachineInstances are the VirtualMachineInstances whose disruption was granted
+            but is not observed yet, with the time it was granted at. An entry is removed once the
+            disruption is observed, or after two minutes when it doesn't happen.
+          type: object
+        disruptionsAllowed:
+          description: DisruptionsAllowed is the number of selected VirtualMachineInstances
+            which can be disrupted now.
+          format: int32
+          type: integer
+        expectedVirtualMachineInstances:
+          description: ExpectedVirtualMachineInstances is the number of VirtualMachineInstances
+            selected by the budget.
+          format: int32
+          type: integer
+        observedGeneration:
+          description: ObservedGeneration is the generation of the budget the status
+            was computed for.
+          format: int64
+          type: integer
+      required:
+      - currentHealthy
+      - disruptionsAllowed
+      - expectedVirtualMachineInstances
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineexport": `openAPIV3Schema:
+  description: VirtualMachineExport defines the operation of exporting a VM source
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineExportSpec is the spec for a VirtualMachineExport
+        resource
+      properties:
+        checksums:
+          description: |-
+            Checksums makes the export server compute the size and SHA-256 checksum of every exported
+            disk image before serving the volumes, and publishes them in the status so downloads can be verified.
+          type: boolean
+        destination:
+          description: |-
+            Destination makes the export server push the volumes to an object storage, using multipart uploads,
+            instead of serving them over HTTPS. The export terminates once all the volumes are written.
+          properties:
+            bucket:
+              description: Bucket is the bucket, or the container for AzureBlob, the
+                volumes are written to
+              type: string
+            credentialsSecretRef:
+              description: |-
+                CredentialsSecretRef is the name of the secret holding the credentials of the object storage.
+                S3 and GCS use the accessKeyId and secretAccessKey keys, GCS expecting HMAC keys, while
+                AzureBlob uses a shared access signature in the sasToken key
+              type: string
+            endpoint:
+              description: |-
+                Endpoint is the URL of the object storage service, for example https://s3.us-east-1.amazonaws.com,
+                https://storage.googleapis.com or https://myaccount.blob.core.windows.net
+              type: string
+            prefix:
+              description: Prefix is prepended to the names of the archived objects
+              type: string
+            provider:
+              description: Provider is the kind of object storage
+              type: string
+            region:
+              description: Region is the region S3 and GCS requests are signed for,
+                us-east-1 for S3 and auto for GCS when unset
+              type: string
+          required:
+          - bucket
+          - credentialsSecretRef
+          - endpoint
+          - provider
+          type: object
+        formats:
+          description: |-
+            Formats lists the formats the export server converts the exported images to, in addition to the
+            raw and gzip formats. Supported formats are qcow2, vmdk and ova. The ova format bundles the VM
+            and is only offered for VirtualMachine and VirtualMachineSnapshot sources.
+            Images are converted on download, which requires scratch space in the export server pod.
+          items:
+            enum:
+            - qcow2
+            - vmdk
+            - ova
+            type: string
+          type: array
+          x-kubernetes-list-type: set
+        source:
+          description: |-
+            TypedLocalObjectReference contains enough information to let you locate the
+            typed referenced object inside the same namespace.
+          properties:
+            apiGroup:
+              description: |-
+                APIGroup is the group for the resource being referenced.
+                If APIGroup is not specified, the specified Kind must be in the core API group.
+                For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+          x-kubernetes-map-type: atomic
+        tokenSecretRef:
+          description: TokenSecretRef is the name of the custom-defined secret that
+            contains the token used by the export server pod
+          type: string
+        ttlDuration:
+          description: |-
+            ttlDuration limits the lifetime of an export
+            If this field is set, after this duration has passed from counting from CreationTimestamp,
+            the export is eligible to be automatically deleted.
+            If this field is omitted, a reasonable default is applied.
+          type: string
+      required:
+      - source
+      type: object
+    status:
+      description: VirtualMachineExportStatus is the status for a VirtualMachineExport
+        resource
+      properties:
+        conditions:
+          items:
+            description: Condition defines conditions
             properties:
               lastProbeTime:
                 format: date-time
@@ -9996,6 +12432,9 @@ var CRDsValidation map[string]string = map[string]string{
                   x-kubernetes-list-map-keys:
                   - type
                   x-kubernetes-list-type: map
+                ova:
+                  description: Ova is the url of the OVA bundle of the exported VM
+                  type: string
                 volumes:
                   description: Volumes is a list of available volumes to export
                   items:
@@ -10065,6 +12504,9 @@ var CRDsValidation map[string]string = map[string]string{
                   x-kubernetes-list-map-keys:
                   - type
                   x-kubernetes-list-type: map
+                ova:
+                  description: Ova is the url of the OVA bundle of the exported VM
+                  type: string
                 volumes:
                   description: Volumes is a list of available volumes to export
                   items:
@@ -10109,6 +12551,38 @@ var CRDsValidation map[string]string = map[string]string{
         phase:
           description: VirtualMachineExportPhase is the current phase of the VirtualMachineExport
           type: string
+        pushedVolumes:
+          description: PushedVolumes lists the volumes written to the destination
+            object storage
+          items:
+            description: ArchivedVolume describes a volume written to the object storage
+            properties:
+              checksum:
+                description: Checksum is the hex encoded SHA-256 checksum of the object
+                  content
+                type: string
+              name:
+                description: Name is the name of the volume in the snapshotted virtual
+                  machine, or of the exported volume
+                type: string
+              objectName:
+                description: ObjectName is the name of the object holding the volume
+                  data
+                type: string
+              size:
+                description: Size is the number of bytes written
+                format: int64
+                type: integer
+            required:
+            - checksum
+            - name
+            - objectName
+            - size
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - name
+          x-kubernetes-list-type: map
         serviceName:
           description: |-
             ServiceName is the name of the service created associated with the Virtual Machine export. It will be used to
@@ -10130,11 +12604,259 @@ var CRDsValidation map[string]string = map[string]string{
             a VirtualMachineSnapshot. This is mainly to easily identify the source VirtualMachine in case of a
             VirtualMachineSnapshot
           type: string
-      type: object
-  required:
-  - spec
-  type: object
-`,
+        volumeChecksums:
+          description: VolumeChecksums lists the size and checksum of the exported
+            disk images
+          items:
+            description: ExportedVolumeChecksum is the size and checksum of an exported
+              disk image
+            properties:
+              checksum:
+                description: Checksum is the hex encoded SHA-256 checksum of the disk
+                  image
+                type: string
+              name:
+                description: Name is the name of the exported volume
+                type: string
+              size:
+                description: Size is the number of bytes of the disk image
+                format: int64
+                type: integer
+            required:
+            - checksum
+            - name
+            - size
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - name
+          x-kubernetes-list-type: map
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineimport": `openAPIV3Schema:
+  description: |-
+    VirtualMachineImport defines the operation of importing a virtual machine described by
+    an OVA/OVF bundle or a libvirt domain XML
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineImportSpec is the spec for a VirtualMachineImport
+        resource
+      properties:
+        diskMappings:
+          description: DiskMappings overrides how the disks of the source are imported
+          items:
+            description: ImportDiskMapping overrides how a disk of the source is imported
+            properties:
+              bus:
+                description: Bus is the bus the disk is attached to, the bus of the
+                  disk in the source when unset
+                enum:
+                - virtio
+                - sata
+                - scsi
+                type: string
+              size:
+                anyOf:
+                - type: integer
+                - type: string
+                description: |-
+                  Size is the size of the imported disk, the capacity of the disk in the source when unset.
+                  It is required for libvirt domain XMLs, which do not record the capacity of the disks.
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+              source:
+                description: Source is the name of the disk in the description, the
+                  OVF disk id or the libvirt target device
+                type: string
+              storageClassName:
+                description: StorageClassName is the storage class of the imported
+                  disk, the storage class of the import when unset
+                type: string
+            required:
+            - source
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        networkMappings:
+          description: |-
+            NetworkMappings maps the networks of the source to networks of the virtual machine.
+            An interface connected to a network without mapping is connected to the pod network,
+            unless another interface already is.
+          items:
+            description: |-
+              ImportNetworkMapping maps a network of the source to a network of the virtual machine.
+              Exactly one of Pod and MultusNetworkName must be set.
+            properties:
+              multusNetworkName:
+                description: MultusNetworkName connects the interfaces to a Multus
+                  network
+                type: string
+              pod:
+                description: Pod connects the interfaces to the pod network
+                type: object
+              source:
+                description: |-
+                  Source is the name of the network in the description, the OVF network name or
+                  the libvirt network or bridge name
+                type: string
+            required:
+            - source
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        source:
+          description: Source is where the description of the virtual machine and
+            its disks are read from
+          properties:
+            format:
+              description: Format is the format of the description of the virtual
+                machine
+              enum:
+              - ova
+              - ovf
+              - libvirt
+              type: string
+            http:
+              description: HTTP imports the description, and the disks it references,
+                from a web server
+              properties:
+                certConfigMap:
+                  description: CertConfigMap is the name of the ConfigMap holding
+                    the certificate authority of the web server
+                  type: string
+                secretRef:
+                  description: |-
+                    SecretRef is the name of the secret holding, in its accessKeyId and secretKey keys,
+                    the credentials of the web server
+                  type: string
+                url:
+                  description: |-
+                    URL is the location of the OVA bundle, OVF descriptor or libvirt domain XML.
+                    The disks referenced by OVF descriptors and libvirt domain XMLs are imported from
+                    the same location as the description.
+                  type: string
+              required:
+              - url
+              type: object
+            upload:
+              description: |-
+                Upload reads the description from a ConfigMap, and creates upload DataVolumes the disks
+                are uploaded to. OVA bundles cannot be uploaded, their content has to be uploaded instead.
+              properties:
+                configMapName:
+                  description: ConfigMapName is the name of the ConfigMap holding
+                    the OVF descriptor or libvirt domain XML
+                  type: string
+                key:
+                  description: Key is the key of the description in the ConfigMap
+                  type: string
+              required:
+              - configMapName
+              - key
+              type: object
+          required:
+          - format
+          type: object
+        storageClassName:
+          description: StorageClassName is the storage class of the imported disks,
+            the default storage class when unset
+          type: string
+        virtualMachineName:
+          description: VirtualMachineName is the name of the imported virtual machine,
+            the name of the import when unset
+          type: string
+      required:
+      - source
+      type: object
+    status:
+      description: VirtualMachineImportStatus is the status for a VirtualMachineImport
+        resource
+      properties:
+        completionTime:
+          description: CompletionTime is the time the virtual machine was imported
+          format: date-time
+          type: string
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        disks:
+          description: Disks lists the imported disks
+          items:
+            description: ImportedDisk describes a disk imported into a DataVolume
+            properties:
+              dataVolumeName:
+                description: DataVolumeName is the name of the DataVolume the disk
+                  is imported into, or uploaded to
+                type: string
+              name:
+                description: Name is the name of the disk in the description
+                type: string
+              progress:
+                description: Progress is the import progress reported by the DataVolume
+                type: string
+            required:
+            - dataVolumeName
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - name
+          x-kubernetes-list-type: map
+        phase:
+          description: VirtualMachineImportPhase is the current phase of the VirtualMachineImport
+          type: string
+        virtualMachineName:
+          description: VirtualMachineName is the name of the imported virtual machine
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
+`,
 	"virtualmachineinstance": `openAPIV3Schema:
   description: VirtualMachineInstance is *the* VirtualMachineInstance Definition.
     It represents a virtual machine in the runtime environment of kubernetes.
@@ -11183,6 +13905,33 @@ var CRDsValidation map[string]string = map[string]string{
           description: Specifies the architecture of the vm guest you are attempting
             to run. Defaults to the compiled architecture of the KubeVirt components
           type: string
+        crashDump:
+          description: |-
+            CrashDump dumps the memory of the guest to a volume when the guest panics, for offline analysis.
+            Requires a panic device.
+          properties:
+            maxDumps:
+              description: |-
+                MaxDumps is the number of crash dumps kept in the volume, the oldest ones are removed first.
+                Defaults to 1.
+              format: int32
+              type: integer
+            maxSize:
+              anyOf:
+              - type: integer
+              - type: string
+              description: MaxSize is the largest guest memory which is dumped. The
+                memory of larger guests is not dumped.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            volumeName:
+              description: |-
+                VolumeName is the name of the memory dump volume the memory of the guest is dumped to.
+                The volume must not be hotpluggable, and must use the Raw format.
+              type: string
+          required:
+          - volumeName
+          type: object
         dnsConfig:
           description: |-
             Specifies the DNS parameters of a pod.
@@ -11410,6 +14159,59 @@ var CRDsValidation map[string]string = map[string]string{
                   description: NUMA allows specifying settings for the guest NUMA
                     topology
                   properties:
+                    guestCells:
+                      description: |-
+                        GuestCells defines an explicit guest NUMA topology. Each vCPU has to be assigned to exactly one cell,
+                        and the memory of the cells has to add up to the guest memory.
+                        Can't be combined with GuestMappingPassthrough.
+                      items:
+                        description: NUMAGuestCell defines a NUMA cell of the guest.
+                        properties:
+                          cpus:
+                            description: CPUs is the list of the vCPUs of the cell,
+                              e.g. "0-3,8".
+                            type: string
+                          distances:
+                            description: |-
+                              Distances lists the distances from this cell to the other cells.
+                              The distances which are not listed are picked by the hypervisor.
+                            items:
+                              description: NUMAGuestCellDistance defines the distance
+                                from a NUMA cell of the guest to another one.
+                              properties:
+                                cellID:
+                                  description: CellID is the ID of the other cell.
+                                  format: int32
+                                  type: integer
+                                value:
+                                  description: Value is the distance, between 10 and
+                                    255. 10 is the distance of a cell to itself.
+                                  format: int32
+                                  type: integer
+                              required:
+                              - cellID
+                              - value
+                              type: object
+                            type: array
+                          id:
+                            description: ID of the cell. The IDs of the cells have
+                              to range from 0 to the number of cells minus one.
+                            format: int32
+                            type: integer
+                          memory:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: Memory is the amount of guest memory of the
+                              cell.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                        required:
+                        - id
+                        - cpus
+                        - memory
+                        type: object
+                      type: array
                     guestMappingPassthrough:
                       description: |-
                         GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
@@ -11472,6 +14274,11 @@ var CRDsValidation map[string]string = map[string]string{
                     Whether to attach the VSOCK CID to the VM or not.
                     VSOCK access will be available if set to true. Defaults to false.
                   type: boolean
+                autoattachVirtIODrivers:
+                  description: |-
+                    Whether to attach the VirtIO driver ISO as a CD-ROM, to install the drivers in Windows guests.
+                    Requires the VirtIO drivers autoattach to be enabled in the cluster config. Defaults to false.
+                  type: boolean
                 blockMultiQueue:
                   description: |-
                     Whether or not to enable virtio multi-queue for block devices.
@@ -11481,6 +14288,28 @@ var CRDsValidation map[string]string = map[string]string{
                   description: To configure and access client devices such as redirecting
                     USB
                   type: object
+                consoleSharing:
+                  description: |-
+                    ConsoleSharing configures how many clients can be connected to the serial console and to VNC at the same time.
+                    Defaults to a single exclusive connection, which is disconnected by a new connection.
+                  properties:
+                    maxConnections:
+                      description: |-
+                        MaxConnections limits the number of concurrent connections of the shared policies to the serial console
+                        and to VNC each, further connections are refused. Defaults to 4.
+                      format: int32
+                      maximum: 16
+                      minimum: 1
+                      type: integer
+                    policy:
+                      description: Policy defines how the connections are shared, Exclusive,
+                        SharedReadOnly or SharedFull. Defaults to Exclusive.
+                      enum:
+                      - Exclusive
+                      - SharedReadOnly
+                      - SharedFull
+                      type: string
+                  type: object
                 disableHotplug:
                   description: DisableHotplug disabled the ability to hotplug disks.
                   type: boolean
@@ -11563,7 +14392,7 @@ var CRDsValidation map[string]string = map[string]string{
                           bus:
                             description: |-
                               Bus indicates the type of disk device to emulate.
-                              supported values: virtio, sata, scsi, usb.
+                              supported values: virtio, sata, scsi, usb, nvme.
                             type: string
                           pciAddress:
                             description: 'If specified, the virtual disk will be placed
@@ -11583,8 +14412,76 @@ var CRDsValidation map[string]string = map[string]string{
                       io:
                         description: |-
                           IO specifies which QEMU disk IO mode should be used.
-                          Supported values are: native, default, threads.
+                          Supported values are: native, default, threads, io_uring.
                         type: string
+                      ioLimits:
+                        description: |-
+                          IOLimits throttles the IO operations and bandwidth of the disk.
+                          They can be updated on a running VMI through the iolimits subresource.
+                        properties:
+                          burst:
+                            description: Burst allows the disk to exceed its limits
+                              for a short time.
+                            properties:
+                              lengthSeconds:
+                                description: |-
+                                  LengthSeconds is for how long the disk may burst.
+                                  Defaults to 1.
+                                format: int64
+                                type: integer
+                              readBandwidth:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: ReadBandwidth is the bytes read per second
+                                  the disk may burst to.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              readIOPS:
+                                description: ReadIOPS is the read operations per second
+                                  the disk may burst to.
+                                format: int64
+                                type: integer
+                              writeBandwidth:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: WriteBandwidth is the bytes written per
+                                  second the disk may burst to.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              writeIOPS:
+                                description: WriteIOPS is the write operations per
+                                  second the disk may burst to.
+                                format: int64
+                                type: integer
+                            type: object
+                          readBandwidth:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBandwidth limits the bytes read per second,
+                              e.g. 100Mi.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readIOPS:
+                            description: ReadIOPS limits the read operations per second.
+                            format: int64
+                            type: integer
+                          writeBandwidth:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBandwidth limits the bytes written per
+                              second, e.g. 100Mi.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeIOPS:
+                            description: WriteIOPS limits the write operations per
+                              second.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -11637,6 +14534,22 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                       virtiofs:
                         description: Virtiofs is supported
+                        properties:
+                          cache:
+                            description: |-
+                              Cache specifies the caching mode of virtiofsd.
+                              Supported values are:
+                              auto: metadata and paths are cached with a timeout, data is cached until the file is closed.
+                              always: metadata, data and paths are cached indefinitely.
+                              never: nothing is cached, changes on the host are seen immediately by the guest.
+                              Defaults to auto.
+                            type: string
+                          threadPoolSize:
+                            description: |-
+                              ThreadPoolSize is the maximum number of worker threads virtiofsd uses
+                              to serve requests. Defaults to the virtiofsd default.
+                            format: int32
+                            type: integer
                         type: object
                     required:
                     - name
@@ -11661,6 +14574,12 @@ var CRDsValidation map[string]string = map[string]string{
                         description: Name of the GPU device as exposed by a device
                           plugin
                         type: string
+                      profile:
+                        description: |-
+                          Profile is the name of a mediated device profile of the KubeVirt CR.
+                          It is resolved on each node to the mediated device type the profile maps to.
+                          Can't be set together with a different deviceName.
+                        type: string
                       requestName:
                         description: |-
                           RequestName needs to be provided from resourceClaim.spec.devices.requests[].name where this
@@ -11697,6 +14616,19 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                   type: array
                   x-kubernetes-list-type: atomic
+                graphics:
+                  description: Graphics defines the protocol of the auto-attached
+                    graphics device.
+                  properties:
+                    type:
+                      description: |-
+                        Type of the graphics device, VNC or SPICE. SPICE additionally allows the client to play the audio
+                        of the sound device and to redirect USB devices when clientPassthrough is set. Defaults to VNC.
+                      enum:
+                      - VNC
+                      - SPICE
+                      type: string
+                  type: object
                 hostDevices:
                   description: Whether to attach a host device to the vmi.
                   items:
@@ -11891,6 +14823,12 @@ var CRDsValidation map[string]string = map[string]string{
                           - port
                           type: object
                         type: array
+                      rxQueueSize:
+                        description: |-
+                          RxQueueSize sets the size of the receive virtqueue of the interface.
+                          Must be a power of 2 between 256 and 1024 and is only supported by the virtio model.
+                        format: int32
+                        type: integer
                       slirp:
                         description: |-
                           DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -11913,6 +14851,12 @@ var CRDsValidation map[string]string = map[string]string{
                         description: If specified, the virtual network interface address
                           and its tag will be provided to the guest via config drive
                         type: string
+                      txQueueSize:
+                        description: |-
+                          TxQueueSize sets the size of the transmit virtqueue of the interface.
+                          Must be a power of 2 between 256 and 1024 and is only supported by the virtio model.
+                        format: int32
+                        type: integer
                     required:
                     - name
                     type: object
@@ -12002,7 +14946,8 @@ var CRDsValidation map[string]string = map[string]string{
                       properties:
                         action:
                           description: |-
-                            The action to take. Valid values are poweroff, reset, shutdown.
+                            The action to take. Valid values are poweroff, reset, shutdown, migrate, snapshot-reset, alert.
+                            The migrate, snapshot-reset and alert actions are taken by KubeVirt rather than by the hypervisor.
                             Defaults to reset.
                           type: string
                       type: object
@@ -12011,7 +14956,8 @@ var CRDsValidation map[string]string = map[string]string{
                       properties:
                         action:
                           description: |-
-                            The action to take. Valid values are poweroff, reset, shutdown.
+                            The action to take. Valid values are poweroff, reset, shutdown, migrate, snapshot-reset, alert.
+                            The migrate, snapshot-reset and alert actions are taken by KubeVirt rather than by the hypervisor.
                             Defaults to reset.
                           type: string
                       type: object
@@ -12414,25 +15360,75 @@ var CRDsValidation map[string]string = map[string]string{
             memory:
               description: Memory allow specifying the VMI memory features.
               properties:
-                guest:
-                  anyOf:
-                  - type: integer
-                  - type: string
+                balloon:
                   description: |-
-                    Guest allows to specifying the amount of memory which is visible inside the Guest OS.
-                    The Guest must lie between Requests and Limits from the resources section.
-                    Defaults to the requested memory in the resources section if not specified.
-                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                  x-kubernetes-int-or-string: true
-                hugepages:
-                  description: Hugepages allow to use hugepages for the VirtualMachineInstance
-                    instead of regular memory.
+                    Balloon lets virt-handler reclaim unused guest memory through the memory balloon.
+                    Fields which are set override the cluster wide memoryBalloon configuration.
                   properties:
-                    pageSize:
+                    max:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        Max is the largest amount of memory the balloon gives to the guest.
+                        Defaults to the guest memory and can not exceed it.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    min:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        Min is the least amount of memory the balloon leaves to the guest.
+                        Defaults to half of the guest memory.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    targetFreePercentage:
+                      description: |-
+                        TargetFreePercentage is the share of the memory given to the guest which should remain
+                        available to it. Defaults to 20.
+                      format: int32
+                      type: integer
+                  type: object
+                freePageReporting:
+                  description: |-
+                    FreePageReporting lets the guest report its free memory pages to the host so that they can be reclaimed.
+                    Defaults to the cluster wide setting. It is never enabled for VMIs requesting dedicated CPUs, hugepages or realtime.
+                  type: boolean
+                guest:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    Guest allows to specifying the amount of memory which is visible inside the Guest OS.
+                    The Guest must lie between Requests and Limits from the resources section.
+                    Defaults to the requested memory in the resources section if not specified.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                hugepages:
+                  description: Hugepages allow to use hugepages for the VirtualMachineInstance
+                    instead of regular memory.
+                  properties:
+                    pageSize:
                       description: PageSize specifies the hugepage size, for x86_64
                         architecture valid values are 1Gi and 2Mi.
                       type: string
+                    regularMemory:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        RegularMemory is the amount of the guest memory which is backed by regular pages instead of hugepages.
+                        The rest of the guest memory, including the memory hotplugged at runtime, is backed by hugepages.
+                        It must be a multiple of the page size and less than the guest memory.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
                   type: object
+                ksmMergePolicy:
+                  description: |-
+                    KSMMergePolicy sets how aggressively the kernel samepage merging of the node merges the guest memory.
+                    Defaults to the mergePolicy of the cluster wide KSM configuration.
+                  type: string
                 maxGuest:
                   anyOf:
                   - type: integer
@@ -12442,6 +15438,11 @@ var CRDsValidation map[string]string = map[string]string{
                     The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
                   pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                   x-kubernetes-int-or-string: true
+                overcommit:
+                  description: |-
+                    Overcommit is the memory overcommit class of the VirtualMachineInstance. It controls how much memory
+                    the pod requests, whether the guest memory can be swapped out and how KSM merges it.
+                  type: string
               type: object
             resources:
               description: Resources describes the Compute Resources required by this
@@ -12488,11 +15489,95 @@ var CRDsValidation map[string]string = map[string]string{
             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
             - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
           type: string
+        hooks:
+          description: |-
+            Hooks are sidecar containers called by virt-launcher on the lifecycle points of the VMI,
+            for example to adjust the domain before it is defined.
+            Requires the Sidecar feature gate.
+          items:
+            description: Hook is a sidecar container called by virt-launcher on the
+              lifecycle points of the VMI.
+            properties:
+              args:
+                description: Arguments to the entrypoint. The image's CMD is used
+                  if this is not provided.
+                items:
+                  type: string
+                type: array
+              command:
+                description: Entrypoint of the sidecar container. The image's ENTRYPOINT
+                  is used if this is not provided.
+                items:
+                  type: string
+                type: array
+              hookPoints:
+                description: HookPoints the hook is called on. Hook points exposed
+                  by the hook but not listed here are not called.
+                items:
+                  description: HookPoint is a lifecycle point of the VMI a hook can
+                    be called on.
+                  type: string
+                type: array
+                x-kubernetes-list-type: set
+              image:
+                description: Image of the sidecar container serving the hook.
+                type: string
+              imagePullPolicy:
+                description: |-
+                  Image pull policy.
+                  One of Always, Never, IfNotPresent.
+                  Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+                type: string
+              name:
+                description: Name of the hook, unique within the VMI. The sidecar
+                  container is named hook-<name>.
+                type: string
+              version:
+                description: |-
+                  Version of the hook API virt-launcher uses to call the hook.
+                  Defaults to the newest version exposed by the hook.
+                type: string
+            required:
+            - hookPoints
+            - image
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - name
+          x-kubernetes-list-type: map
         hostname:
           description: |-
             Specifies the hostname of the vmi
             If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
           type: string
+        idlePolicy:
+          description: IdlePolicy pauses or stops the VMI once the guest has been
+            idle for a while.
+          properties:
+            action:
+              description: Action taken once the guest is idle. Defaults to Pause.
+              enum:
+              - Pause
+              - Stop
+              type: string
+            cpuThresholdPercentage:
+              description: |-
+                CPUThresholdPercentage is the usage of the vCPUs of the VMI, in percent, below which the
+                guest is idle. Defaults to 5.
+              format: int32
+              type: integer
+            ignoreUserSessions:
+              description: IgnoreUserSessions considers the guest idle even if users
+                are logged in to it.
+              type: boolean
+            timeout:
+              description: Timeout is how long the guest has to be idle before the
+                action is taken.
+              type: string
+          required:
+          - timeout
+          type: object
         livenessProbe:
           description: |-
             Periodic probe of VirtualMachineInstance liveness.
@@ -13183,6 +16268,49 @@ var CRDsValidation map[string]string = map[string]string{
                     description: UserDataBase64 contains config drive cloud-init userdata
                       as a base64 encoded string.
                     type: string
+                  userDataSources:
+                    description: |-
+                      UserDataSources references additional Secrets and ConfigMaps containing config drive userdata.
+                      Their userdata is merged, in the listed order, after the userdata of this source into a single
+                      multi-part MIME userdata.
+                    items:
+                      description: |-
+                        CloudInitUserDataSource references a Secret or ConfigMap containing cloud-init userdata
+                        in its userdata or userData key. Only one of its members may be specified.
+                      properties:
+                        configMapRef:
+                          description: ConfigMapRef references a k8s configmap that
+                            contains userdata.
+                          properties:
+                            name:
+                              default: ""
+                              description: |-
+                                Name of the referent.
+                                This field is effectively required, but due to backwards compatibility is
+                                allowed to be empty. Instances of this type with an empty value here are
+                                almost certainly wrong.
+                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                              type: string
+                          type: object
+                          x-kubernetes-map-type: atomic
+                        secretRef:
+                          description: SecretRef references a k8s secret that contains
+                            userdata.
+                          properties:
+                            name:
+                              default: ""
+                              description: |-
+                                Name of the referent.
+                                This field is effectively required, but due to backwards compatibility is
+                                allowed to be empty. Instances of this type with an empty value here are
+                                almost certainly wrong.
+                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                              type: string
+                          type: object
+                          x-kubernetes-map-type: atomic
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
                 type: object
               cloudInitNoCloud:
                 description: |-
@@ -13234,6 +16362,49 @@ var CRDsValidation map[string]string = map[string]string{
                     description: UserDataBase64 contains NoCloud cloud-init userdata
                       as a base64 encoded string.
                     type: string
+                  userDataSources:
+                    description: |-
+                      UserDataSources references additional Secrets and ConfigMaps containing NoCloud userdata.
+                      Their userdata is merged, in the listed order, after the userdata of this source into a single
+                      multi-part MIME userdata.
+                    items:
+                      description: |-
+                        CloudInitUserDataSource references a Secret or ConfigMap containing cloud-init userdata
+                        in its userdata or userData key. Only one of its members may be specified.
+                      properties:
+                        configMapRef:
+                          description: ConfigMapRef references a k8s configmap that
+                            contains userdata.
+                          properties:
+                            name:
+                              default: ""
+                              description: |-
+                                Name of the referent.
+                                This field is effectively required, but due to backwards compatibility is
+                                allowed to be empty. Instances of this type with an empty value here are
+                                almost certainly wrong.
+                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                              type: string
+                          type: object
+                          x-kubernetes-map-type: atomic
+                        secretRef:
+                          description: SecretRef references a k8s secret that contains
+                            userdata.
+                          properties:
+                            name:
+                              default: ""
+                              description: |-
+                                Name of the referent.
+                                This field is effectively required, but due to backwards compatibility is
+                                allowed to be empty. Instances of this type with an empty value here are
+                                almost certainly wrong.
+                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                              type: string
+                          type: object
+                          x-kubernetes-map-type: atomic
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
                 type: object
               configMap:
                 description: |-
@@ -13451,6 +16622,32 @@ var CRDsValidation map[string]string = map[string]string{
                 - path
                 - type
                 type: object
+              ignition:
+                description: |-
+                  Ignition represents an Ignition config for CoreOS-style guests.
+                  The config is passed to the guest through the QEMU firmware configuration device, no disk is needed for this volume.
+                  There can only be one volume of this type!
+                  More info: https://coreos.github.io/ignition/
+                properties:
+                  data:
+                    description: Data contains the inline Ignition config.
+                    type: string
+                  secretRef:
+                    description: SecretRef references a k8s secret that contains the
+                      Ignition config in its userdata or userData key.
+                    properties:
+                      name:
+                        default: ""
+                        description: |-
+                          Name of the referent.
+                          This field is effectively required, but due to backwards compatibility is
+                          allowed to be empty. Instances of this type with an empty value here are
+                          almost certainly wrong.
+                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                        type: string
+                    type: object
+                    x-kubernetes-map-type: atomic
+                type: object
               memoryDump:
                 description: MemoryDump is attached to the virt launcher and is populated
                   with a memory dump of the vmi
@@ -13460,6 +16657,17 @@ var CRDsValidation map[string]string = map[string]string{
                       claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                       More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                     type: string
+                  content:
+                    description: |-
+                      Content is the part of the guest memory which is dumped, Full or Kernel.
+                      Defaults to Full
+                    type: string
+                  format:
+                    description: |-
+                      Format is the format the memory is dumped in. When the volume is not
+                      hotpluggable and holds a memory state, the VMI is resumed from it on start.
+                      Defaults to Raw
+                    type: string
                   hotpluggable:
                     description: Hotpluggable indicates whether the volume can be
                       hotplugged and hotunplugged.
@@ -13552,6 +16760,11 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                     type: object
                     x-kubernetes-map-type: atomic
+                  namespace:
+                    description: |-
+                      Namespace of the referenced ConfigMap. Defaults to the namespace of the VirtualMachineInstance.
+                      Other namespaces must be listed in the sysprep templateNamespaces of the KubeVirt configuration.
+                    type: string
                   secret:
                     description: Secret references a k8s Secret that contains Sysprep
                       answer file named autounattend.xml that should be attached as
@@ -13568,12 +16781,55 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                     type: object
                     x-kubernetes-map-type: atomic
+                  template:
+                    description: Template enables rendering the answer files as Go
+                      templates, with the values of the VirtualMachineInstance.
+                    properties:
+                      parametersSecretRef:
+                        description: |-
+                          ParametersSecretRef references a k8s Secret in the namespace of the VirtualMachineInstance,
+                          whose keys are exposed to the templates as .Parameters, e.g. a license key.
+                        properties:
+                          name:
+                            default: ""
+                            description: |-
+                              Name of the referent.
+                              This field is effectively required, but due to backwards compatibility is
+                              allowed to be empty. Instances of this type with an empty value here are
+                              almost certainly wrong.
+                              More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                            type: string
+                        type: object
+                        x-kubernetes-map-type: atomic
+                    type: object
+                type: object
+              vhostUserBlk:
+                description: |-
+                  VhostUserBlk connects the disk to a vhost-user-blk socket exposed on the node by a storage target like SPDK,
+                  bypassing the block layer of the host kernel.
+                properties:
+                  resourceName:
+                    description: |-
+                      ResourceName is the name of the device plugin resource providing the socket.
+                      The device plugin passes the path of the allocated socket to the pod through the
+                      VHOST_USER_BLK_RESOURCE_<resource name> environment variable.
+                    type: string
+                  socketName:
+                    description: SocketName is the name of the socket in the vhost-user-blk
+                      socket directory of the node, /var/run/vhost-user-blk.
+                    type: string
                 type: object
             required:
             - name
             type: object
           maxItems: 256
           type: array
+        workloadClass:
+          description: |-
+            WorkloadClass is the name of one of the workloadClasses of the KubeVirt configuration.
+            The VMI gets the priority of the class, and may be preempted by VMIs of a higher
+            priority if the class allows it.
+          type: string
       required:
       - domain
       type: object
@@ -13744,6 +17000,28 @@ var CRDsValidation map[string]string = map[string]string{
             It will be set to "frozen" if the request was made, or unset otherwise.
             This does not reflect the actual state of the guest filesystem.
           type: string
+        guestDrivers:
+          description: |-
+            GuestDrivers lists the drivers of the guest devices, as reported by the guest agent.
+            It is only reported for Windows guests.
+          items:
+            description: VirtualMachineInstanceGuestDriver describes a driver used
+              by the devices of the guest
+            properties:
+              date:
+                description: Date of the driver, in the YYYY-MM-DD format
+                type: string
+              name:
+                description: Name of the driver
+                type: string
+              version:
+                description: Version of the driver
+                type: string
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         guestOSInfo:
           description: Guest OS Information
           properties:
@@ -13851,6 +17129,22 @@ var CRDsValidation map[string]string = map[string]string{
           description: Memory shows various informations about the VirtualMachine
             memory.
           properties:
+            balloonActual:
+              anyOf:
+              - type: integer
+              - type: string
+              description: |-
+                BalloonActual specifies how much memory the memory balloon currently gives to the VirtualMachine.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            balloonRequested:
+              anyOf:
+              - type: integer
+              - type: string
+              description: |-
+                BalloonRequested specifies how much memory the memory balloon policy requested for the VirtualMachine.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
             guestAtBoot:
               anyOf:
               - type: integer
@@ -14007,6 +17301,16 @@ var CRDsValidation map[string]string = map[string]string{
             completed:
               description: Indicates the migration completed
               type: boolean
+            cutoverRequested:
+              description: Indicates that the cutover of a warm migration has been
+                requested
+              type: boolean
+            cutoverTimestamp:
+              description: The time the source node started the cutover of a warm
+                migration
+              format: date-time
+              nullable: true
+              type: string
             endTimestamp:
               description: The time the migration action ended
               format: date-time
@@ -14021,6 +17325,14 @@ var CRDsValidation map[string]string = map[string]string{
             migrationConfiguration:
               description: Migration configurations to apply
               properties:
+                additionalNetworks:
+                  description: |-
+                    AdditionalNetworks are the names of further CNI networks for live migrations. A migration or
+                    its migration policy can select one of them, or the network above, through its network field.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
                 allowAutoConverge:
                   description: |-
                     AllowAutoConverge allows the platform to compromise performance/availability of VMIs to
@@ -14061,6 +17373,22 @@ var CRDsValidation map[string]string = map[string]string{
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
                     provided by KubeVirt. This is usually a bad idea. Defaults to false
                   type: boolean
+                evacuationOrder:
+                  description: |-
+                    EvacuationOrder defines the order in which VMIs are migrated off a node being drained.
+                    Defaults to no particular order
+                  properties:
+                    byLabel:
+                      description: |-
+                        ByLabel is the key of a VMI label holding an integer. VMIs with a higher value are evacuated
+                        first, VMIs without the label or with a value which is not an integer last.
+                      type: string
+                    byPriorityClass:
+                      description: |-
+                        ByPriorityClass evacuates VMIs with a higher priority, as resolved from the priority class
+                        of their virt-launcher pod, first.
+                      type: boolean
+                  type: object
                 matchSELinuxLevelOnMigration:
                   description: |-
                     By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
@@ -14078,6 +17406,13 @@ var CRDsValidation map[string]string = map[string]string{
                     NodeDrainTaintKey defines the taint key that indicates a node should be drained.
                     Note: this option relies on the deprecated node taint feature. Default: kubevirt.io/drain
                   type: string
+                parallelInboundMigrationsPerNode:
+                  description: |-
+                    ParallelInboundMigrationsPerNode is the maximum number of concurrent incoming live migrations
+                    allowed per node. Migration target pods are not scheduled to nodes which reached this limit.
+                    Defaults to no limit
+                  format: int32
+                  type: integer
                 parallelMigrationsPerCluster:
                   description: |-
                     ParallelMigrationsPerCluster is the total number of concurrent live migrations
@@ -14097,6 +17432,13 @@ var CRDsValidation map[string]string = map[string]string{
                     then considered stuck and therefore cancelled. Defaults to 150
                   format: int64
                   type: integer
+                replugHostDevices:
+                  description: |-
+                    ReplugHostDevices allows VMIs with GPUs and host devices to live migrate. The devices are unplugged
+                    from the guest before the migration starts, and equivalent devices are plugged into it on the target.
+                    The guest agent of such VMIs must be connected, and GPUs must have their display disabled.
+                    Defaults to false
+                  type: boolean
                 unsafeMigrationOverride:
                   description: |-
                     UnsafeMigrationOverride allows live migrations to occur even if the compatibility check
@@ -14106,6 +17448,46 @@ var CRDsValidation map[string]string = map[string]string{
             migrationNetworkType:
               description: The type of migration network, either 'pod' or 'migration'
               type: string
+            migrationOverrides:
+              description: Migration overrides requested on the VirtualMachineInstanceMigration
+              properties:
+                allowAutoConverge:
+                  description: AllowAutoConverge allows the migration to throttle
+                    the guest CPUs so that it converges.
+                  type: boolean
+                allowPostCopy:
+                  description: AllowPostCopy allows the migration to switch to post-copy
+                    mode if it does not converge.
+                  type: boolean
+                bandwidthPerMigration:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    BandwidthPerMigration limits the amount of network bandwidth this migration can use.
+                    Zero means unlimited.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                compression:
+                  description: Compression is the method used to compress guest memory
+                    during the migration.
+                  enum:
+                  - xbzrle
+                  - zlib
+                  - zstd
+                  type: string
+                network:
+                  description: |-
+                    Network is the name of the CNI network this migration goes through. It has to be the
+                    migration network or one of the additional migration networks of the cluster configuration.
+                  type: string
+                parallelMigrationThreads:
+                  description: |-
+                    ParallelMigrationThreads is the number of parallel (multifd) connections used to transfer
+                    guest memory. Cannot be combined with allowPostCopy.
+                  format: int32
+                  type: integer
+              type: object
             migrationPolicyName:
               description: Name of the migration policy. If string is empty, no policy
                 is matched
@@ -14118,6 +17500,48 @@ var CRDsValidation map[string]string = map[string]string{
               description: Lets us know if the vmi is currently running pre or post
                 copy migration
               type: string
+            progress:
+              description: Progress reports the transfer statistics of the running
+                migration
+              properties:
+                dataProcessedBytes:
+                  description: The amount of guest data already transferred, in bytes
+                  format: int64
+                  type: integer
+                dataRemainingBytes:
+                  description: The amount of guest data which is left to transfer,
+                    in bytes
+                  format: int64
+                  type: integer
+                dataTotalBytes:
+                  description: The total amount of guest data to be migrated, in bytes
+                  format: int64
+                  type: integer
+                expectedDowntimeMilliseconds:
+                  description: The downtime the guest is expected to suffer when switching
+                    over to the target, in milliseconds
+                  format: int64
+                  type: integer
+                iterations:
+                  description: The number of passes over the guest memory so far
+                  format: int64
+                  type: integer
+                memoryDirtyRateBytesPerSecond:
+                  description: The rate at which the guest dirties its memory, in
+                    bytes per second
+                  format: int64
+                  type: integer
+                memoryTransferRateBytesPerSecond:
+                  description: The rate at which the guest memory is transferred,
+                    in bytes per second
+                  format: int64
+                  type: integer
+                timestamp:
+                  description: The time the statistics were collected
+                  format: date-time
+                  nullable: true
+                  type: string
+              type: object
             sourceNode:
               description: The source node that the VMI originated on
               type: string
@@ -14290,6 +17714,10 @@ var CRDsValidation map[string]string = map[string]string{
                     virtual machine instance
                   type: string
               type: object
+            warm:
+              description: Indicates that the migration waits for a cutover request
+                before switching over to the target
+              type: boolean
           type: object
         migrationTransport:
           description: This represents the migration transport
@@ -14348,11 +17776,58 @@ var CRDsValidation map[string]string = map[string]string{
               format: int64
               type: integer
           type: object
-        virtualMachineRevisionName:
+        vcpuPinning:
           description: |-
-            VirtualMachineRevisionName is used to get the vm revision of the vmi when doing
-            an online vm snapshot
-          type: string
+            VCPUPinning shows the host CPUs the vCPUs of the VirtualMachineInstance are pinned to.
+            It is only reported for VirtualMachineInstances with dedicated CPUs.
+          properties:
+            requested:
+              description: |-
+                Requested lists the host CPUs the vCPUs were requested to be re-pinned to.
+                It is cleared once the vCPUs are re-pinned.
+              items:
+                description: VCPUPin pins a vCPU to a set of host CPUs
+                properties:
+                  cpuSet:
+                    description: CPUSet is the set of host CPUs, in the cpuset list
+                      format, e.g. 2-3,6
+                    type: string
+                  vcpu:
+                    description: VCPU is the index of the vCPU
+                    format: int32
+                    type: integer
+                required:
+                - vcpu
+                - cpuSet
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            vcpus:
+              description: VCPUs lists the host CPUs each vCPU is currently pinned
+                to
+              items:
+                description: VCPUPin pins a vCPU to a set of host CPUs
+                properties:
+                  cpuSet:
+                    description: CPUSet is the set of host CPUs, in the cpuset list
+                      format, e.g. 2-3,6
+                    type: string
+                  vcpu:
+                    description: VCPU is the index of the vCPU
+                    format: int32
+                    type: integer
+                required:
+                - vcpu
+                - cpuSet
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
+        virtualMachineRevisionName:
+          description: |-
+            VirtualMachineRevisionName is used to get the vm revision of the vmi when doing
+            an online vm snapshot
+          type: string
         volumeStatus:
           description: VolumeStatus contains the statuses of all the volumes
           items:
@@ -14520,6 +17995,48 @@ var CRDsValidation map[string]string = map[string]string{
             are going to be preserved to ensure that addedNodeSelector
             can only restrict but not bypass constraints already set on the VM object.
           type: object
+        migration:
+          description: |-
+            Migration holds tuning overrides for this migration only. Values set here take
+            precedence over the cluster-wide migration configuration and any matched migration policy.
+          properties:
+            allowAutoConverge:
+              description: AllowAutoConverge allows the migration to throttle the
+                guest CPUs so that it converges.
+              type: boolean
+            allowPostCopy:
+              description: AllowPostCopy allows the migration to switch to post-copy
+                mode if it does not converge.
+              type: boolean
+            bandwidthPerMigration:
+              anyOf:
+              - type: integer
+              - type: string
+              description: |-
+                BandwidthPerMigration limits the amount of network bandwidth this migration can use.
+                Zero means unlimited.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            compression:
+              description: Compression is the method used to compress guest memory
+                during the migration.
+              enum:
+              - xbzrle
+              - zlib
+              - zstd
+              type: string
+            network:
+              description: |-
+                Network is the name of the CNI network this migration goes through. It has to be the
+                migration network or one of the additional migration networks of the cluster configuration.
+              type: string
+            parallelMigrationThreads:
+              description: |-
+                ParallelMigrationThreads is the number of parallel (multifd) connections used to transfer
+                guest memory. Cannot be combined with allowPostCopy.
+              format: int32
+              type: integer
+          type: object
         receive:
           description: If receieve is specified, this VirtualMachineInstanceMigration
             will be considered the target
@@ -14530,6 +18047,28 @@ var CRDsValidation map[string]string = map[string]string{
           required:
           - migrationID
           type: object
+        retryPolicy:
+          description: |-
+            RetryPolicy makes the migration controller retry the migration when it fails,
+            by creating a new migration for the same VMI.
+          properties:
+            backoff:
+              description: |-
+                Backoff is the delay before the first retry. It doubles with every retry.
+                Defaults to 20s.
+              type: string
+            maxBackoff:
+              description: MaxBackoff caps the delay between two retries. Defaults
+                to 5m.
+              type: string
+            maxRetries:
+              description: MaxRetries is the number of times a failed migration is
+                retried.
+              format: int32
+              type: integer
+          required:
+          - maxRetries
+          type: object
         sendTo:
           description: If sendTo is specified, this VirtualMachineInstanceMigration
             will be considered the source
@@ -14548,6 +18087,18 @@ var CRDsValidation map[string]string = map[string]string{
           description: The name of the VMI to perform the migration on. VMI must exist
             in the migration objects namespace
           type: string
+        warm:
+          description: |-
+            Warm makes the migration copy the non-shared disks to the target in the background
+            and hold off the switch over to the target until a cutover is requested.
+          properties:
+            cutover:
+              description: |-
+                Cutover requests the switch over to the target. The guest memory and the last disk changes
+                are transferred once the disks are in sync. It can be set after the migration was created,
+                but not unset.
+              type: boolean
+          type: object
       type: object
     status:
       description: VirtualMachineInstanceMigration reprents information pertaining
@@ -14589,6 +18140,16 @@ var CRDsValidation map[string]string = map[string]string{
             completed:
               description: Indicates the migration completed
               type: boolean
+            cutoverRequested:
+              description: Indicates that the cutover of a warm migration has been
+                requested
+              type: boolean
+            cutoverTimestamp:
+              description: The time the source node started the cutover of a warm
+                migration
+              format: date-time
+              nullable: true
+              type: string
             endTimestamp:
               description: The time the migration action ended
               format: date-time
@@ -14603,6 +18164,14 @@ var CRDsValidation map[string]string = map[string]string{
             migrationConfiguration:
               description: Migration configurations to apply
               properties:
+                additionalNetworks:
+                  description: |-
+                    AdditionalNetworks are the names of further CNI networks for live migrations. A migration or
+                    its migration policy can select one of them, or the network above, through its network field.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
                 allowAutoConverge:
                   description: |-
                     AllowAutoConverge allows the platform to compromise performance/availability of VMIs to
@@ -14643,6 +18212,22 @@ var CRDsValidation map[string]string = map[string]string{
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
                     provided by KubeVirt. This is usually a bad idea. Defaults to false
                   type: boolean
+                evacuationOrder:
+                  description: |-
+                    EvacuationOrder defines the order in which VMIs are migrated off a node being drained.
+                    Defaults to no particular order
+                  properties:
+                    byLabel:
+                      description: |-
+                        ByLabel is the key of a VMI label holding an integer. VMIs with a higher value are evacuated
+                        first, VMIs without the label or with a value which is not an integer last.
+                      type: string
+                    byPriorityClass:
+                      description: |-
+                        ByPriorityClass evacuates VMIs with a higher priority, as resolved from the priority class
+                        of their virt-launcher pod, first.
+                      type: boolean
+                  type: object
                 matchSELinuxLevelOnMigration:
                   description: |-
                     By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
@@ -14660,6 +18245,13 @@ var CRDsValidation map[string]string = map[string]string{
                     NodeDrainTaintKey defines the taint key that indicates a node should be drained.
                     Note: this option relies on the deprecated node taint feature. Default: kubevirt.io/drain
                   type: string
+                parallelInboundMigrationsPerNode:
+                  description: |-
+                    ParallelInboundMigrationsPerNode is the maximum number of concurrent incoming live migrations
+                    allowed per node. Migration target pods are not scheduled to nodes which reached this limit.
+                    Defaults to no limit
+                  format: int32
+                  type: integer
                 parallelMigrationsPerCluster:
                   description: |-
                     ParallelMigrationsPerCluster is the total number of concurrent live migrations
@@ -14679,6 +18271,13 @@ var CRDsValidation map[string]string = map[string]string{
                     then considered stuck and therefore cancelled. Defaults to 150
                   format: int64
                   type: integer
+                replugHostDevices:
+                  description: |-
+                    ReplugHostDevices allows VMIs with GPUs and host devices to live migrate. The devices are unplugged
+                    from the guest before the migration starts, and equivalent devices are plugged into it on the target.
+                    The guest agent of such VMIs must be connected, and GPUs must have their display disabled.
+                    Defaults to false
+                  type: boolean
                 unsafeMigrationOverride:
                   description: |-
                     UnsafeMigrationOverride allows live migrations to occur even if the compatibility check
@@ -14688,6 +18287,46 @@ var CRDsValidation map[string]string = map[string]string{
             migrationNetworkType:
               description: The type of migration network, either 'pod' or 'migration'
               type: string
+            migrationOverrides:
+              description: Migration overrides requested on the VirtualMachineInstanceMigration
+              properties:
+                allowAutoConverge:
+                  description: AllowAutoConverge allows the migration to throttle
+                    the guest CPUs so that it converges.
+                  type: boolean
+                allowPostCopy:
+                  description: AllowPostCopy allows the migration to switch to post-copy
+                    mode if it does not converge.
+                  type: boolean
+                bandwidthPerMigration:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    BandwidthPerMigration limits the amount of network bandwidth this migration can use.
+                    Zero means unlimited.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                compression:
+                  description: Compression is the method used to compress guest memory
+                    during the migration.
+                  enum:
+                  - xbzrle
+                  - zlib
+                  - zstd
+                  type: string
+                network:
+                  description: |-
+                    Network is the name of the CNI network this migration goes through. It has to be the
+                    migration network or one of the additional migration networks of the cluster configuration.
+                  type: string
+                parallelMigrationThreads:
+                  description: |-
+                    ParallelMigrationThreads is the number of parallel (multifd) connections used to transfer
+                    guest memory. Cannot be combined with allowPostCopy.
+                  format: int32
+                  type: integer
+              type: object
             migrationPolicyName:
               description: Name of the migration policy. If string is empty, no policy
                 is matched
@@ -14700,6 +18339,48 @@ var CRDsValidation map[string]string = map[string]string{
               description: Lets us know if the vmi is currently running pre or post
                 copy migration
               type: string
+            progress:
+              description: Progress reports the transfer statistics of the running
+                migration
+              properties:
+                dataProcessedBytes:
+                  description: The amount of guest data already transferred, in bytes
+                  format: int64
+                  type: integer
+                dataRemainingBytes:
+                  description: The amount of guest data which is left to transfer,
+                    in bytes
+                  format: int64
+                  type: integer
+                dataTotalBytes:
+                  description: The total amount of guest data to be migrated, in bytes
+                  format: int64
+                  type: integer
+                expectedDowntimeMilliseconds:
+                  description: The downtime the guest is expected to suffer when switching
+                    over to the target, in milliseconds
+                  format: int64
+                  type: integer
+                iterations:
+                  description: The number of passes over the guest memory so far
+                  format: int64
+                  type: integer
+                memoryDirtyRateBytesPerSecond:
+                  description: The rate at which the guest dirties its memory, in
+                    bytes per second
+                  format: int64
+                  type: integer
+                memoryTransferRateBytesPerSecond:
+                  description: The rate at which the guest memory is transferred,
+                    in bytes per second
+                  format: int64
+                  type: integer
+                timestamp:
+                  description: The time the statistics were collected
+                  format: date-time
+                  nullable: true
+                  type: string
+              type: object
             sourceNode:
               description: The source node that the VMI originated on
               type: string
@@ -14872,6 +18553,10 @@ var CRDsValidation map[string]string = map[string]string{
                     virtual machine instance
                   type: string
               type: object
+            warm:
+              description: Indicates that the migration waits for a cutover request
+                before switching over to the target
+              type: boolean
           type: object
         phase:
           description: VirtualMachineInstanceMigrationPhase is a label for the condition
@@ -14897,6 +18582,31 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        retry:
+          description: Retry reports the progress of the retries of a migration with
+            a retry policy
+          properties:
+            attempt:
+              description: Attempt is the number of the attempt this migration is,
+                the original migration being attempt 0
+              format: int32
+              type: integer
+            failureThreshold:
+              description: FailureThreshold is the number of failed attempts after
+                which the migration is not retried anymore
+              format: int32
+              type: integer
+            message:
+              description: Message explains why the migration is not retried
+              type: string
+            retryMigrationName:
+              description: RetryMigrationName is the name of the migration created
+                to retry this one
+              type: string
+          required:
+          - attempt
+          - failureThreshold
+          type: object
         synchronizationAddresses:
           description: |-
             The synchronization addresses one can use to connect to the synchronization controller, includes the port, if multiple
@@ -15109,6 +18819,59 @@ var CRDsValidation map[string]string = map[string]string{
                   description: NUMA allows specifying settings for the guest NUMA
                     topology
                   properties:
+                    guestCells:
+                      description: |-
+                        GuestCells defines an explicit guest NUMA topology. Each vCPU has to be assigned to exactly one cell,
+                        and the memory of the cells has to add up to the guest memory.
+                        Can't be combined with GuestMappingPassthrough.
+                      items:
+                        description: NUMAGuestCell defines a NUMA cell of the guest.
+                        properties:
+                          cpus:
+                            description: CPUs is the list of the vCPUs of the cell,
+                              e.g. "0-3,8".
+                            type: string
+                          distances:
+                            description: |-
+                              Distances lists the distances from this cell to the other cells.
+                              The distances which are not listed are picked by the hypervisor.
+                            items:
+                              description: NUMAGuestCellDistance defines the distance
+                                from a NUMA cell of the guest to another one.
+                              properties:
+                                cellID:
+                                  description: CellID is the ID of the other cell.
+                                  format: int32
+                                  type: integer
+                                value:
+                                  description: Value is the distance, between 10 and
+                                    255. 10 is the distance of a cell to itself.
+                                  format: int32
+                                  type: integer
+                              required:
+                              - cellID
+                              - value
+                              type: object
+                            type: array
+                          id:
+                            description: ID of the cell. The IDs of the cells have
+                              to range from 0 to the number of cells minus one.
+                            format: int32
+                            type: integer
+                          memory:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: Memory is the amount of guest memory of the
+                              cell.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                        required:
+                        - id
+                        - cpus
+                        - memory
+                        type: object
+                      type: array
                     guestMappingPassthrough:
                       description: |-
                         GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
@@ -15171,6 +18934,11 @@ var CRDsValidation map[string]string = map[string]string{
                     Whether to attach the VSOCK CID to the VM or not.
                     VSOCK access will be available if set to true. Defaults to false.
                   type: boolean
+                autoattachVirtIODrivers:
+                  description: |-
+                    Whether to attach the VirtIO driver ISO as a CD-ROM, to install the drivers in Windows guests.
+                    Requires the VirtIO drivers autoattach to be enabled in the cluster config. Defaults to false.
+                  type: boolean
                 blockMultiQueue:
                   description: |-
                     Whether or not to enable virtio multi-queue for block devices.
@@ -15180,6 +18948,28 @@ var CRDsValidation map[string]string = map[string]string{
                   description: To configure and access client devices such as redirecting
                     USB
                   type: object
+                consoleSharing:
+                  description: |-
+                    ConsoleSharing configures how many clients can be connected to the serial console and to VNC at the same time.
+                    Defaults to a single exclusive connection, which is disconnected by a new connection.
+                  properties:
+                    maxConnections:
+                      description: |-
+                        MaxConnections limits the number of concurrent connections of the shared policies to the serial console
+                        and to VNC each, further connections are refused. Defaults to 4.
+                      format: int32
+                      maximum: 16
+                      minimum: 1
+                      type: integer
+                    policy:
+                      description: Policy defines how the connections are shared, Exclusive,
+                        SharedReadOnly or SharedFull. Defaults to Exclusive.
+                      enum:
+                      - Exclusive
+                      - SharedReadOnly
+                      - SharedFull
+                      type: string
+                  type: object
                 disableHotplug:
                   description: DisableHotplug disabled the ability to hotplug disks.
                   type: boolean
@@ -15262,7 +19052,7 @@ var CRDsValidation map[string]string = map[string]string{
                           bus:
                             description: |-
                               Bus indicates the type of disk device to emulate.
-                              supported values: virtio, sata, scsi, usb.
+                              supported values: virtio, sata, scsi, usb, nvme.
                             type: string
                           pciAddress:
                             description: 'If specified, the virtual disk will be placed
@@ -15282,8 +19072,76 @@ var CRDsValidation map[string]string = map[string]string{
                       io:
                         description: |-
                           IO specifies which QEMU disk IO mode should be used.
-                          Supported values are: native, default, threads.
+                          Supported values are: native, default, threads, io_uring.
                         type: string
+                      ioLimits:
+                        description: |-
+                          IOLimits throttles the IO operations and bandwidth of the disk.
+                          They can be updated on a running VMI through the iolimits subresource.
+                        properties:
+                          burst:
+                            description: Burst allows the disk to exceed its limits
+                              for a short time.
+                            properties:
+                              lengthSeconds:
+                                description: |-
+                                  LengthSeconds is for how long the disk may burst.
+                                  Defaults to 1.
+                                format: int64
+                                type: integer
+                              readBandwidth:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: ReadBandwidth is the bytes read per second
+                                  the disk may burst to.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              readIOPS:
+                                description: ReadIOPS is the read operations per second
+                                  the disk may burst to.
+                                format: int64
+                                type: integer
+                              writeBandwidth:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: WriteBandwidth is the bytes written per
+                                  second the disk may burst to.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              writeIOPS:
+                                description: WriteIOPS is the write operations per
+                                  second the disk may burst to.
+                                format: int64
+                                type: integer
+                            type: object
+                          readBandwidth:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBandwidth limits the bytes read per second,
+                              e.g. 100Mi.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readIOPS:
+                            description: ReadIOPS limits the read operations per second.
+                            format: int64
+                            type: integer
+                          writeBandwidth:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBandwidth limits the bytes written per
+                              second, e.g. 100Mi.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeIOPS:
+                            description: WriteIOPS limits the write operations per
+                              second.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -15336,6 +19194,22 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                       virtiofs:
                         description: Virtiofs is supported
+                        properties:
+                          cache:
+                            description: |-
+                              Cache specifies the caching mode of virtiofsd.
+                              Supported values are:
+                              auto: metadata and paths are cached with a timeout, data is cached until the file is closed.
+                              always: metadata, data and paths are cached indefinitely.
+                              never: nothing is cached, changes on the host are seen immediately by the guest.
+                              Defaults to auto.
+                            type: string
+                          threadPoolSize:
+                            description: |-
+                              ThreadPoolSize is the maximum number of worker threads virtiofsd uses
+                              to serve requests. Defaults to the virtiofsd default.
+                            format: int32
+                            type: integer
                         type: object
                     required:
                     - name
@@ -15360,6 +19234,12 @@ var CRDsValidation map[string]string = map[string]string{
                         description: Name of the GPU device as exposed by a device
                           plugin
                         type: string
+                      profile:
+                        description: |-
+                          Profile is the name of a mediated device profile of the KubeVirt CR.
+                          It is resolved on each node to the mediated device type the profile maps to.
+                          Can't be set together with a different deviceName.
+                        type: string
                       requestName:
                         description: |-
                           RequestName needs to be provided from resourceClaim.spec.devices.requests[].name where this
@@ -15396,6 +19276,19 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                   type: array
                   x-kubernetes-list-type: atomic
+                graphics:
+                  description: Graphics defines the protocol of the auto-attached
+                    graphics device.
+                  properties:
+                    type:
+                      description: |-
+                        Type of the graphics device, VNC or SPICE. SPICE additionally allows the client to play the audio
+                        of the sound device and to redirect USB devices when clientPassthrough is set. Defaults to VNC.
+                      enum:
+                      - VNC
+                      - SPICE
+                      type: string
+                  type: object
                 hostDevices:
                   description: Whether to attach a host device to the vmi.
                   items:
@@ -15590,6 +19483,12 @@ var CRDsValidation map[string]string = map[string]string{
                           - port
                           type: object
                         type: array
+                      rxQueueSize:
+                        description: |-
+                          RxQueueSize sets the size of the receive virtqueue of the interface.
+                          Must be a power of 2 between 256 and 1024 and is only supported by the virtio model.
+                        format: int32
+                        type: integer
                       slirp:
                         description: |-
                           DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -15612,6 +19511,12 @@ var CRDsValidation map[string]string = map[string]string{
                         description: If specified, the virtual network interface address
                           and its tag will be provided to the guest via config drive
                         type: string
+                      txQueueSize:
+                        description: |-
+                          TxQueueSize sets the size of the transmit virtqueue of the interface.
+                          Must be a power of 2 between 256 and 1024 and is only supported by the virtio model.
+                        format: int32
+                        type: integer
                     required:
                     - name
                     type: object
@@ -15701,7 +19606,8 @@ var CRDsValidation map[string]string = map[string]string{
                       properties:
                         action:
                           description: |-
-                            The action to take. Valid values are poweroff, reset, shutdown.
+                            The action to take. Valid values are poweroff, reset, shutdown, migrate, snapshot-reset, alert.
+                            The migrate, snapshot-reset and alert actions are taken by KubeVirt rather than by the hypervisor.
                             Defaults to reset.
                           type: string
                       type: object
@@ -15710,7 +19616,8 @@ var CRDsValidation map[string]string = map[string]string{
                       properties:
                         action:
                           description: |-
-                            The action to take. Valid values are poweroff, reset, shutdown.
+                            The action to take. Valid values are poweroff, reset, shutdown, migrate, snapshot-reset, alert.
+                            The migrate, snapshot-reset and alert actions are taken by KubeVirt rather than by the hypervisor.
                             Defaults to reset.
                           type: string
                       type: object
@@ -16113,6 +20020,41 @@ var CRDsValidation map[string]string = map[string]string{
             memory:
               description: Memory allow specifying the VMI memory features.
               properties:
+                balloon:
+                  description: |-
+                    Balloon lets virt-handler reclaim unused guest memory through the memory balloon.
+                    Fields which are set override the cluster wide memoryBalloon configuration.
+                  properties:
+                    max:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        Max is the largest amount of memory the balloon gives to the guest.
+                        Defaults to the guest memory and can not exceed it.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    min:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        Min is the least amount of memory the balloon leaves to the guest.
+                        Defaults to half of the guest memory.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    targetFreePercentage:
+                      description: |-
+                        TargetFreePercentage is the share of the memory given to the guest which should remain
+                        available to it. Defaults to 20.
+                      format: int32
+                      type: integer
+                  type: object
+                freePageReporting:
+                  description: |-
+                    FreePageReporting lets the guest report its free memory pages to the host so that they can be reclaimed.
+                    Defaults to the cluster wide setting. It is never enabled for VMIs requesting dedicated CPUs, hugepages or realtime.
+                  type: boolean
                 guest:
                   anyOf:
                   - type: integer
@@ -16131,7 +20073,22 @@ var CRDsValidation map[string]string = map[string]string{
                       description: PageSize specifies the hugepage size, for x86_64
                         architecture valid values are 1Gi and 2Mi.
                       type: string
+                    regularMemory:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        RegularMemory is the amount of the guest memory which is backed by regular pages instead of hugepages.
+                        The rest of the guest memory, including the memory hotplugged at runtime, is backed by hugepages.
+                        It must be a multiple of the page size and less than the guest memory.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
                   type: object
+                ksmMergePolicy:
+                  description: |-
+                    KSMMergePolicy sets how aggressively the kernel samepage merging of the node merges the guest memory.
+                    Defaults to the mergePolicy of the cluster wide KSM configuration.
+                  type: string
                 maxGuest:
                   anyOf:
                   - type: integer
@@ -16141,6 +20098,11 @@ var CRDsValidation map[string]string = map[string]string{
                     The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
                   pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                   x-kubernetes-int-or-string: true
+                overcommit:
+                  description: |-
+                    Overcommit is the memory overcommit class of the VirtualMachineInstance. It controls how much memory
+                    the pod requests, whether the guest memory can be swapped out and how KSM merges it.
+                  type: string
               type: object
             resources:
               description: Resources describes the Compute Resources required by this
@@ -17359,6 +21321,33 @@ var CRDsValidation map[string]string = map[string]string{
                     attempting to run. Defaults to the compiled architecture of the
                     KubeVirt components
                   type: string
+                crashDump:
+                  description: |-
+                    CrashDump dumps the memory of the guest to a volume when the guest panics, for offline analysis.
+                    Requires a panic device.
+                  properties:
+                    maxDumps:
+                      description: |-
+                        MaxDumps is the number of crash dumps kept in the volume, the oldest ones are removed first.
+                        Defaults to 1.
+                      format: int32
+                      type: integer
+                    maxSize:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: MaxSize is the largest guest memory which is dumped.
+                        The memory of larger guests is not dumped.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    volumeName:
+                      description: |-
+                        VolumeName is the name of the memory dump volume the memory of the guest is dumped to.
+                        The volume must not be hotpluggable, and must use the Raw format.
+                      type: string
+                  required:
+                  - volumeName
+                  type: object
                 dnsConfig:
                   description: |-
                     Specifies the DNS parameters of a pod.
@@ -17590,11 +21579,69 @@ var CRDsValidation map[string]string = map[string]string{
                           description: NUMA allows specifying settings for the guest
                             NUMA topology
                           properties:
-                            guestMappingPassthrough:
+                            guestCells:
                               description: |-
-                                GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
-                                The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
-                              type: object
+                                GuestCells defines an explicit guest NUMA topology. Each vCPU has to be assigned to exactly one cell,
+                                and the memory of the cells has to add up to the guest memory.
+                                Can't be combined with GuestMappingPassthrough.
+                              items:
+                                description: NUMAGuestCell defines a NUMA cell of
+                                  the guest.
+                                properties:
+                                  cpus:
+                                    description: CPUs is the list of the vCPUs of
+                                      the cell, e.g. "0-3,8".
+                                    type: string
+                                  distances:
+                                    description: |-
+                                      Distances lists the distances from this cell to the other cells.
+                                      The distances which are not listed are picked by the hypervisor.
+                                    items:
+                                      description: NUMAGuestCellDistance defines the
+                                        distance from a NUMA cell of the guest to
+                                        another one.
+                                      properties:
+                                        cellID:
+                                          description: CellID is the ID of the other
+                                            cell.
+                                          format: int32
+                                          type: integer
+                                        value:
+                                          description: Value is the distance, between
+                                            10 and 255. 10 is the distance of a cell
+                                            to itself.
+                                          format: int32
+                                          type: integer
+                                      required:
+                                      - cellID
+                                      - value
+                                      type: object
+                                    type: array
+                                  id:
+                                    description: ID of the cell. The IDs of the cells
+                                      have to range from 0 to the number of cells
+                                      minus one.
+                                    format: int32
+                                    type: integer
+                                  memory:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: Memory is the amount of guest memory
+                                      of the cell.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                required:
+                                - id
+                                - cpus
+                                - memory
+                                type: object
+                              type: array
+                            guestMappingPassthrough:
+                              description: |-
+                                GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
+                                The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
+                              type: object
                           type: object
                         realtime:
                           description: Realtime instructs the virt-launcher to tune
@@ -17653,6 +21700,11 @@ var CRDsValidation map[string]string = map[string]string{
                             Whether to attach the VSOCK CID to the VM or not.
                             VSOCK access will be available if set to true. Defaults to false.
                           type: boolean
+                        autoattachVirtIODrivers:
+                          description: |-
+                            Whether to attach the VirtIO driver ISO as a CD-ROM, to install the drivers in Windows guests.
+                            Requires the VirtIO drivers autoattach to be enabled in the cluster config. Defaults to false.
+                          type: boolean
                         blockMultiQueue:
                           description: |-
                             Whether or not to enable virtio multi-queue for block devices.
@@ -17662,6 +21714,28 @@ var CRDsValidation map[string]string = map[string]string{
                           description: To configure and access client devices such
                             as redirecting USB
                           type: object
+                        consoleSharing:
+                          description: |-
+                            ConsoleSharing configures how many clients can be connected to the serial console and to VNC at the same time.
+                            Defaults to a single exclusive connection, which is disconnected by a new connection.
+                          properties:
+                            maxConnections:
+                              description: |-
+                                MaxConnections limits the number of concurrent connections of the shared policies to the serial console
+                                and to VNC each, further connections are refused. Defaults to 4.
+                              format: int32
+                              maximum: 16
+                              minimum: 1
+                              type: integer
+                            policy:
+                              description: Policy defines how the connections are shared, Exclusive,
+                                SharedReadOnly or SharedFull. Defaults to Exclusive.
+                              enum:
+                              - Exclusive
+                              - SharedReadOnly
+                              - SharedFull
+                              type: string
+                          type: object
                         disableHotplug:
                           description: DisableHotplug disabled the ability to hotplug
                             disks.
@@ -17746,7 +21820,7 @@ var CRDsValidation map[string]string = map[string]string{
                                   bus:
                                     description: |-
                                       Bus indicates the type of disk device to emulate.
-                                      supported values: virtio, sata, scsi, usb.
+                                      supported values: virtio, sata, scsi, usb, nvme.
                                     type: string
                                   pciAddress:
                                     description: 'If specified, the virtual disk will
@@ -17766,8 +21840,77 @@ var CRDsValidation map[string]string = map[string]string{
                               io:
                                 description: |-
                                   IO specifies which QEMU disk IO mode should be used.
-                                  Supported values are: native, default, threads.
+                                  Supported values are: native, default, threads, io_uring.
                                 type: string
+                              ioLimits:
+                                description: |-
+                                  IOLimits throttles the IO operations and bandwidth of the disk.
+                                  They can be updated on a running VMI through the iolimits subresource.
+                                properties:
+                                  burst:
+                                    description: Burst allows the disk to exceed its
+                                      limits for a short time.
+                                    properties:
+                                      lengthSeconds:
+                                        description: |-
+                                          LengthSeconds is for how long the disk may burst.
+                                          Defaults to 1.
+                                        format: int64
+                                        type: integer
+                                      readBandwidth:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: ReadBandwidth is the bytes read
+                                          per second the disk may burst to.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      readIOPS:
+                                        description: ReadIOPS is the read operations
+                                          per second the disk may burst to.
+                                        format: int64
+                                        type: integer
+                                      writeBandwidth:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: WriteBandwidth is the bytes written
+                                          per second the disk may burst to.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      writeIOPS:
+                                        description: WriteIOPS is the write operations
+                                          per second the disk may burst to.
+                                        format: int64
+                                        type: integer
+                                    type: object
+                                  readBandwidth:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: ReadBandwidth limits the bytes read
+                                      per second, e.g. 100Mi.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  readIOPS:
+                                    description: ReadIOPS limits the read operations
+                                      per second.
+                                    format: int64
+                                    type: integer
+                                  writeBandwidth:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: WriteBandwidth limits the bytes written
+                                      per second, e.g. 100Mi.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  writeIOPS:
+                                    description: WriteIOPS limits the write operations
+                                      per second.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -17821,6 +21964,22 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                               virtiofs:
                                 description: Virtiofs is supported
+                                properties:
+                                  cache:
+                                    description: |-
+                                      Cache specifies the caching mode of virtiofsd.
+                                      Supported values are:
+                                      auto: metadata and paths are cached with a timeout, data is cached until the file is closed.
+                                      always: metadata, data and paths are cached indefinitely.
+                                      never: nothing is cached, changes on the host are seen immediately by the guest.
+                                      Defaults to auto.
+                                    type: string
+                                  threadPoolSize:
+                                    description: |-
+                                      ThreadPoolSize is the maximum number of worker threads virtiofsd uses
+                                      to serve requests. Defaults to the virtiofsd default.
+                                    format: int32
+                                    type: integer
                                 type: object
                             required:
                             - name
@@ -17845,6 +22004,12 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: Name of the GPU device as exposed by
                                   a device plugin
                                 type: string
+                              profile:
+                                description: |-
+                                  Profile is the name of a mediated device profile of the KubeVirt CR.
+                                  It is resolved on each node to the mediated device type the profile maps to.
+                                  Can't be set together with a different deviceName.
+                                type: string
                               requestName:
                                 description: |-
                                   RequestName needs to be provided from resourceClaim.spec.devices.requests[].name where this
@@ -17882,6 +22047,19 @@ var CRDsValidation map[string]string = map[string]string{
                             type: object
                           type: array
                           x-kubernetes-list-type: atomic
+                        graphics:
+                          description: Graphics defines the protocol of the auto-attached
+                            graphics device.
+                          properties:
+                            type:
+                              description: |-
+                                Type of the graphics device, VNC or SPICE. SPICE additionally allows the client to play the audio
+                                of the sound device and to redirect USB devices when clientPassthrough is set. Defaults to VNC.
+                              enum:
+                              - VNC
+                              - SPICE
+                              type: string
+                          type: object
                         hostDevices:
                           description: Whether to attach a host device to the vmi.
                           items:
@@ -18077,6 +22255,12 @@ var CRDsValidation map[string]string = map[string]string{
                                   - port
                                   type: object
                                 type: array
+                              rxQueueSize:
+                                description: |-
+                                  RxQueueSize sets the size of the receive virtqueue of the interface.
+                                  Must be a power of 2 between 256 and 1024 and is only supported by the virtio model.
+                                format: int32
+                                type: integer
                               slirp:
                                 description: |-
                                   DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -18100,6 +22284,12 @@ var CRDsValidation map[string]string = map[string]string{
                                   address and its tag will be provided to the guest
                                   via config drive
                                 type: string
+                              txQueueSize:
+                                description: |-
+                                  TxQueueSize sets the size of the transmit virtqueue of the interface.
+                                  Must be a power of 2 between 256 and 1024 and is only supported by the virtio model.
+                                format: int32
+                                type: integer
                             required:
                             - name
                             type: object
@@ -18191,7 +22381,8 @@ var CRDsValidation map[string]string = map[string]string{
                               properties:
                                 action:
                                   description: |-
-                                    The action to take. Valid values are poweroff, reset, shutdown.
+                                    The action to take. Valid values are poweroff, reset, shutdown, migrate, snapshot-reset, alert.
+                                    The migrate, snapshot-reset and alert actions are taken by KubeVirt rather than by the hypervisor.
                                     Defaults to reset.
                                   type: string
                               type: object
@@ -18200,7 +22391,8 @@ var CRDsValidation map[string]string = map[string]string{
                               properties:
                                 action:
                                   description: |-
-                                    The action to take. Valid values are poweroff, reset, shutdown.
+                                    The action to take. Valid values are poweroff, reset, shutdown, migrate, snapshot-reset, alert.
+                                    The migrate, snapshot-reset and alert actions are taken by KubeVirt rather than by the hypervisor.
                                     Defaults to reset.
                                   type: string
                               type: object
@@ -18610,6 +22802,41 @@ var CRDsValidation map[string]string = map[string]string{
                     memory:
                       description: Memory allow specifying the VMI memory features.
                       properties:
+                        balloon:
+                          description: |-
+                            Balloon lets virt-handler reclaim unused guest memory through the memory balloon.
+                            Fields which are set override the cluster wide memoryBalloon configuration.
+                          properties:
+                            max:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                Max is the largest amount of memory the balloon gives to the guest.
+                                Defaults to the guest memory and can not exceed it.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            min:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                Min is the least amount of memory the balloon leaves to the guest.
+                                Defaults to half of the guest memory.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            targetFreePercentage:
+                              description: |-
+                                TargetFreePercentage is the share of the memory given to the guest which should remain
+                                available to it. Defaults to 20.
+                              format: int32
+                              type: integer
+                          type: object
+                        freePageReporting:
+                          description: |-
+                            FreePageReporting lets the guest report its free memory pages to the host so that they can be reclaimed.
+                            Defaults to the cluster wide setting. It is never enabled for VMIs requesting dedicated CPUs, hugepages or realtime.
+                          type: boolean
                         guest:
                           anyOf:
                           - type: integer
@@ -18628,7 +22855,22 @@ var CRDsValidation map[string]string = map[string]string{
                               description: PageSize specifies the hugepage size, for
                                 x86_64 architecture valid values are 1Gi and 2Mi.
                               type: string
+                            regularMemory:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                RegularMemory is the amount of the guest memory which is backed by regular pages instead of hugepages.
+                                The rest of the guest memory, including the memory hotplugged at runtime, is backed by hugepages.
+                                It must be a multiple of the page size and less than the guest memory.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
                           type: object
+                        ksmMergePolicy:
+                          description: |-
+                            KSMMergePolicy sets how aggressively the kernel samepage merging of the node merges the guest memory.
+                            Defaults to the mergePolicy of the cluster wide KSM configuration.
+                          type: string
                         maxGuest:
                           anyOf:
                           - type: integer
@@ -18638,6 +22880,11 @@ var CRDsValidation map[string]string = map[string]string{
                             The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
                           pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                           x-kubernetes-int-or-string: true
+                        overcommit:
+                          description: |-
+                            Overcommit is the memory overcommit class of the VirtualMachineInstance. It controls how much memory
+                            the pod requests, whether the guest memory can be swapped out and how KSM merges it.
+                          type: string
                       type: object
                     resources:
                       description: Resources describes the Compute Resources required
@@ -18684,11 +22931,96 @@ var CRDsValidation map[string]string = map[string]string{
                     - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                     - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                   type: string
+                hooks:
+                  description: |-
+                    Hooks are sidecar containers called by virt-launcher on the lifecycle points of the VMI,
+                    for example to adjust the domain before it is defined.
+                    Requires the Sidecar feature gate.
+                  items:
+                    description: Hook is a sidecar container called by virt-launcher
+                      on the lifecycle points of the VMI.
+                    properties:
+                      args:
+                        description: Arguments to the entrypoint. The image's CMD
+                          is used if this is not provided.
+                        items:
+                          type: string
+                        type: array
+                      command:
+                        description: Entrypoint of the sidecar container. The image's
+                          ENTRYPOINT is used if this is not provided.
+                        items:
+                          type: string
+                        type: array
+                      hookPoints:
+                        description: HookPoints the hook is called on. Hook points
+                          exposed by the hook but not listed here are not called.
+                        items:
+                          description: HookPoint is a lifecycle point of the VMI a
+                            hook can be called on.
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: set
+                      image:
+                        description: Image of the sidecar container serving the hook.
+                        type: string
+                      imagePullPolicy:
+                        description: |-
+                          Image pull policy.
+                          One of Always, Never, IfNotPresent.
+                          Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+                        type: string
+                      name:
+                        description: Name of the hook, unique within the VMI. The
+                          sidecar container is named hook-<name>.
+                        type: string
+                      version:
+                        description: |-
+                          Version of the hook API virt-launcher uses to call the hook.
+                          Defaults to the newest version exposed by the hook.
+                        type: string
+                    required:
+                    - hookPoints
+                    - image
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
                 hostname:
                   description: |-
                     Specifies the hostname of the vmi
                     If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                   type: string
+                idlePolicy:
+                  description: IdlePolicy pauses or stops the VMI once the guest has
+                    been idle for a while.
+                  properties:
+                    action:
+                      description: Action taken once the guest is idle. Defaults to
+                        Pause.
+                      enum:
+                      - Pause
+                      - Stop
+                      type: string
+                    cpuThresholdPercentage:
+                      description: |-
+                        CPUThresholdPercentage is the usage of the vCPUs of the VMI, in percent, below which the
+                        guest is idle. Defaults to 5.
+                      format: int32
+                      type: integer
+                    ignoreUserSessions:
+                      description: IgnoreUserSessions considers the guest idle even
+                        if users are logged in to it.
+                      type: boolean
+                    timeout:
+                      description: Timeout is how long the guest has to be idle before
+                        the action is taken.
+                      type: string
+                  required:
+                  - timeout
+                  type: object
                 livenessProbe:
                   description: |-
                     Periodic probe of VirtualMachineInstance liveness.
@@ -19381,6 +23713,49 @@ var CRDsValidation map[string]string = map[string]string{
                             description: UserDataBase64 contains config drive cloud-init
                               userdata as a base64 encoded string.
                             type: string
+                          userDataSources:
+                            description: |-
+                              UserDataSources references additional Secrets and ConfigMaps containing config drive userdata.
+                              Their userdata is merged, in the listed order, after the userdata of this source into a single
+                              multi-part MIME userdata.
+                            items:
+                              description: |-
+                                CloudInitUserDataSource references a Secret or ConfigMap containing cloud-init userdata
+                                in its userdata or userData key. Only one of its members may be specified.
+                              properties:
+                                configMapRef:
+                                  description: ConfigMapRef references a k8s configmap
+                                    that contains userdata.
+                                  properties:
+                                    name:
+                                      default: ""
+                                      description: |-
+                                        Name of the referent.
+                                        This field is effectively required, but due to backwards compatibility is
+                                        allowed to be empty. Instances of this type with an empty value here are
+                                        almost certainly wrong.
+                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      type: string
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                                secretRef:
+                                  description: SecretRef references a k8s secret that
+                                    contains userdata.
+                                  properties:
+                                    name:
+                                      default: ""
+                                      description: |-
+                                        Name of the referent.
+                                        This field is effectively required, but due to backwards compatibility is
+                                        allowed to be empty. Instances of this type with an empty value here are
+                                        almost certainly wrong.
+                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      type: string
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
                         type: object
                       cloudInitNoCloud:
                         description: |-
@@ -19434,6 +23809,49 @@ var CRDsValidation map[string]string = map[string]string{
                             description: UserDataBase64 contains NoCloud cloud-init
                               userdata as a base64 encoded string.
                             type: string
+                          userDataSources:
+                            description: |-
+                              UserDataSources references additional Secrets and ConfigMaps containing NoCloud userdata.
+                              Their userdata is merged, in the listed order, after the userdata of this source into a single
+                              multi-part MIME userdata.
+                            items:
+                              description: |-
+                                CloudInitUserDataSource references a Secret or ConfigMap containing cloud-init userdata
+                                in its userdata or userData key. Only one of its members may be specified.
+                              properties:
+                                configMapRef:
+                                  description: ConfigMapRef references a k8s configmap
+                                    that contains userdata.
+                                  properties:
+                                    name:
+                                      default: ""
+                                      description: |-
+                                        Name of the referent.
+                                        This field is effectively required, but due to backwards compatibility is
+                                        allowed to be empty. Instances of this type with an empty value here are
+                                        almost certainly wrong.
+                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      type: string
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                                secretRef:
+                                  description: SecretRef references a k8s secret that
+                                    contains userdata.
+                                  properties:
+                                    name:
+                                      default: ""
+                                      description: |-
+                                        Name of the referent.
+                                        This field is effectively required, but due to backwards compatibility is
+                                        allowed to be empty. Instances of this type with an empty value here are
+                                        almost certainly wrong.
+                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                      type: string
+                                  type: object
+                                  x-kubernetes-map-type: atomic
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
                         type: object
                       configMap:
                         description: |-
@@ -19658,6 +24076,32 @@ var CRDsValidation map[string]string = map[string]string{
                         - path
                         - type
                         type: object
+                      ignition:
+                        description: |-
+                          Ignition represents an Ignition config for CoreOS-style guests.
+                          The config is passed to the guest through the QEMU firmware configuration device, no disk is needed for this volume.
+                          There can only be one volume of this type!
+                          More info: https://coreos.github.io/ignition/
+                        properties:
+                          data:
+                            description: Data contains the inline Ignition config.
+                            type: string
+                          secretRef:
+                            description: SecretRef references a k8s secret that contains
+                              the Ignition config in its userdata or userData key.
+                            properties:
+                              name:
+                                default: ""
+                                description: |-
+                                  Name of the referent.
+                                  This field is effectively required, but due to backwards compatibility is
+                                  allowed to be empty. Instances of this type with an empty value here are
+                                  almost certainly wrong.
+                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                type: string
+                            type: object
+                            x-kubernetes-map-type: atomic
+                        type: object
                       memoryDump:
                         description: MemoryDump is attached to the virt launcher and
                           is populated with a memory dump of the vmi
@@ -19667,6 +24111,17 @@ var CRDsValidation map[string]string = map[string]string{
                               claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                               More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                             type: string
+                          content:
+                            description: |-
+                              Content is the part of the guest memory which is dumped, Full or Kernel.
+                              Defaults to Full
+                            type: string
+                          format:
+                            description: |-
+                              Format is the format the memory is dumped in. When the volume is not
+                              hotpluggable and holds a memory state, the VMI is resumed from it on start.
+                              Defaults to Raw
+                            type: string
                           hotpluggable:
                             description: Hotpluggable indicates whether the volume
                               can be hotplugged and hotunplugged.
@@ -19760,6 +24215,11 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                             type: object
                             x-kubernetes-map-type: atomic
+                          namespace:
+                            description: |-
+                              Namespace of the referenced ConfigMap. Defaults to the namespace of the VirtualMachineInstance.
+                              Other namespaces must be listed in the sysprep templateNamespaces of the KubeVirt configuration.
+                            type: string
                           secret:
                             description: Secret references a k8s Secret that contains
                               Sysprep answer file named autounattend.xml that should
@@ -19776,12 +24236,55 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                             type: object
                             x-kubernetes-map-type: atomic
+                          template:
+                            description: Template enables rendering the answer files
+                              as Go templates, with the values of the VirtualMachineInstance.
+                            properties:
+                              parametersSecretRef:
+                                description: |-
+                                  ParametersSecretRef references a k8s Secret in the namespace of the VirtualMachineInstance,
+                                  whose keys are exposed to the templates as .Parameters, e.g. a license key.
+                                properties:
+                                  name:
+                                    default: ""
+                                    description: |-
+                                      Name of the referent.
+                                      This field is effectively required, but due to backwards compatibility is
+                                      allowed to be empty. Instances of this type with an empty value here are
+                                      almost certainly wrong.
+                                      More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                    type: string
+                                type: object
+                                x-kubernetes-map-type: atomic
+                            type: object
+                        type: object
+                      vhostUserBlk:
+                        description: |-
+                          VhostUserBlk connects the disk to a vhost-user-blk socket exposed on the node by a storage target like SPDK,
+                          bypassing the block layer of the host kernel.
+                        properties:
+                          resourceName:
+                            description: |-
+                              ResourceName is the name of the device plugin resource providing the socket.
+                              The device plugin passes the path of the allocated socket to the pod through the
+                              VHOST_USER_BLK_RESOURCE_<resource name> environment variable.
+                            type: string
+                          socketName:
+                            description: SocketName is the name of the socket in the
+                              vhost-user-blk socket directory of the node, /var/run/vhost-user-blk.
+                            type: string
                         type: object
                     required:
                     - name
                     type: object
                   maxItems: 256
                   type: array
+                workloadClass:
+                  description: |-
+                    WorkloadClass is the name of one of the workloadClasses of the KubeVirt configuration.
+                    The VMI gets the priority of the class, and may be preempted by VMIs of a higher
+                    priority if the class allows it.
+                  type: string
               required:
               - domain
               type: object
@@ -19904,16 +24407,68 @@ var CRDsValidation map[string]string = map[string]string{
             numa:
               description: NUMA allows specifying settings for the guest NUMA topology
               properties:
-                guestMappingPassthrough:
+                guestCells:
                   description: |-
-                    GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
-                    The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
-                  type: object
-              type: object
-            realtime:
-              description: Realtime instructs the virt-launcher to tune the VMI for
-                lower latency, optional for real time workloads
-              properties:
+                    GuestCells defines an explicit guest NUMA topology. Each vCPU has to be assigned to exactly one cell,
+                    and the memory of the cells has to add up to the guest memory.
+                    Can't be combined with GuestMappingPassthrough.
+                  items:
+                    description: NUMAGuestCell defines a NUMA cell of the guest.
+                    properties:
+                      cpus:
+                        description: CPUs is the list of the vCPUs of the cell, e.g.
+                          "0-3,8".
+                        type: string
+                      distances:
+                        description: |-
+                          Distances lists the distances from this cell to the other cells.
+                          The distances which are not listed are picked by the hypervisor.
+                        items:
+                          description: NUMAGuestCellDistance defines the distance
+                            from a NUMA cell of the guest to another one.
+                          properties:
+                            cellID:
+                              description: CellID is the ID of the other cell.
+                              format: int32
+                              type: integer
+                            value:
+                              description: Value is the distance, between 10 and 255.
+                                10 is the distance of a cell to itself.
+                              format: int32
+                              type: integer
+                          required:
+                          - cellID
+                          - value
+                          type: object
+                        type: array
+                      id:
+                        description: ID of the cell. The IDs of the cells have to
+                          range from 0 to the number of cells minus one.
+                        format: int32
+                        type: integer
+                      memory:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Memory is the amount of guest memory of the cell.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    required:
+                    - id
+                    - cpus
+                    - memory
+                    type: object
+                  type: array
+                guestMappingPassthrough:
+                  description: |-
+                    GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
+                    The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
+                  type: object
+              type: object
+            realtime:
+              description: Realtime instructs the virt-launcher to tune the VMI for
+                lower latency, optional for real time workloads
+              properties:
                 mask:
                   description: |-
                     Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
@@ -19938,6 +24493,12 @@ var CRDsValidation map[string]string = map[string]string{
               name:
                 description: Name of the GPU device as exposed by a device plugin
                 type: string
+              profile:
+                description: |-
+                  Profile is the name of a mediated device profile of the KubeVirt CR.
+                  It is resolved on each node to the mediated device type the profile maps to.
+                  Can't be set together with a different deviceName.
+                type: string
               requestName:
                 description: |-
                   RequestName needs to be provided from resourceClaim.spec.devices.requests[].name where this
@@ -20036,6 +24597,41 @@ var CRDsValidation map[string]string = map[string]string{
         memory:
           description: Required Memory related attributes of the instancetype.
           properties:
+            balloon:
+              description: |-
+                Balloon lets virt-handler reclaim unused guest memory through the memory balloon.
+                Fields which are set override the cluster wide memoryBalloon configuration.
+              properties:
+                max:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    Max is the largest amount of memory the balloon gives to the guest.
+                    Defaults to the guest memory and can not exceed it.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                min:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    Min is the least amount of memory the balloon leaves to the guest.
+                    Defaults to half of the guest memory.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                targetFreePercentage:
+                  description: |-
+                    TargetFreePercentage is the share of the memory given to the guest which should remain
+                    available to it. Defaults to 20.
+                  format: int32
+                  type: integer
+              type: object
+            freePageReporting:
+              description: |-
+                FreePageReporting lets the guest report its free memory pages to the host so that they can be reclaimed.
+                Defaults to the cluster wide setting. It is never enabled for VMIs requesting dedicated CPUs, hugepages or realtime.
+              type: boolean
             guest:
               anyOf:
               - type: integer
@@ -20052,7 +24648,22 @@ var CRDsValidation map[string]string = map[string]string{
                   description: PageSize specifies the hugepage size, for x86_64 architecture
                     valid values are 1Gi and 2Mi.
                   type: string
+                regularMemory:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    RegularMemory is the amount of the guest memory which is backed by regular pages instead of hugepages.
+                    The rest of the guest memory, including the memory hotplugged at runtime, is backed by hugepages.
+                    It must be a multiple of the page size and less than the guest memory.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
               type: object
+            ksmMergePolicy:
+              description: |-
+                KSMMergePolicy sets how aggressively the kernel samepage merging of the node merges the guest memory.
+                Defaults to the mergePolicy of the cluster wide KSM configuration.
+              type: string
             maxGuest:
               anyOf:
               - type: integer
@@ -20062,6 +24673,11 @@ var CRDsValidation map[string]string = map[string]string{
                 The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
               pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
               x-kubernetes-int-or-string: true
+            overcommit:
+              description: |-
+                Overcommit is the memory overcommit class of the VirtualMachineInstance. It controls how much memory
+                the pod requests, whether the guest memory can be swapped out and how KSM merges it.
+              type: string
             overcommitPercent:
               description: |-
                 OvercommitPercent is the percentage of the guest memory which will be overcommitted.
@@ -20124,6 +24740,77 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     spec:
       properties:
+        autoscaling:
+          description: |-
+            Autoscaling scales the replicas of the pool on the load of its VirtualMachines.
+            The pool controller manages the replicas when it is set.
+          properties:
+            maxReplicas:
+              description: MaxReplicas is the highest number of replicas the pool
+                is scaled to.
+              format: int32
+              type: integer
+            metrics:
+              description: |-
+                Metrics are the targets the replicas are scaled towards. The pool is scaled
+                to the highest number of replicas any of them asks for.
+              items:
+                description: VirtualMachinePoolMetric is a target the replicas of
+                  a pool are scaled towards
+                properties:
+                  prometheus:
+                    description: Prometheus is the query the pool is scaled on. Required
+                      for the Prometheus type.
+                    properties:
+                      query:
+                        description: |-
+                          Query is a PromQL query returning the total load of the pool. The values of all
+                          returned series are summed up.
+                        type: string
+                      targetAverageValue:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: TargetAverageValue is the value of the query
+                          per replica the pool is scaled towards.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    required:
+                    - query
+                    - targetAverageValue
+                    type: object
+                  targetAverageUtilization:
+                    description: |-
+                      TargetAverageUtilization is the average utilization, in percent of the vCPUs or of the guest memory,
+                      of the running VirtualMachines. Required for the CPU and Memory types.
+                    format: int32
+                    type: integer
+                  type:
+                    description: Type is the type of the metric [CPU|Memory|Prometheus]
+                    enum:
+                    - CPU
+                    - Memory
+                    - Prometheus
+                    type: string
+                required:
+                - type
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            minReplicas:
+              description: MinReplicas is the lowest number of replicas the pool is
+                scaled to. Defaults to 1.
+              format: int32
+              type: integer
+            scaleInStabilizationWindow:
+              description: |-
+                ScaleInStabilizationWindow is the time to wait after the last scale before
+                scaling the pool in. Defaults to 5m.
+              type: string
+          required:
+          - maxReplicas
+          - metrics
+          type: object
         maxUnavailable:
           anyOf:
           - type: integer
@@ -20143,6 +24830,76 @@ var CRDsValidation map[string]string = map[string]string{
         paused:
           description: Indicates that the pool is paused.
           type: boolean
+        replicaTemplating:
+          description: |-
+            ReplicaTemplating makes the replicas of the pool unique, by expanding per-replica
+            variables in their hostname and cloud-init data and by generating Secrets and
+            ConfigMaps for each of them.
+          properties:
+            configMaps:
+              description: |-
+                ConfigMaps are generated for each replica and named <name>-<index>. ConfigMap volumes
+                of the VirtualMachine template referencing <name> use the ConfigMap of their replica.
+              items:
+                description: VirtualMachinePoolReplicaObjectTemplate is a Secret or
+                  ConfigMap generated for each replica of a pool
+                properties:
+                  data:
+                    additionalProperties:
+                      type: string
+                    description: Data is the content of the object. The variables
+                      are expanded in its values.
+                    type: object
+                  name:
+                    description: Name is the base name of the object.
+                    type: string
+                required:
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            hostname:
+              description: Hostname is the hostname of the replicas, e.g. "web-$(POOL_INDEX)".
+              type: string
+            secrets:
+              description: |-
+                Secrets are generated for each replica and named <name>-<index>. Secret volumes
+                of the VirtualMachine template referencing <name> use the Secret of their replica.
+              items:
+                description: VirtualMachinePoolReplicaObjectTemplate is a Secret or
+                  ConfigMap generated for each replica of a pool
+                properties:
+                  data:
+                    additionalProperties:
+                      type: string
+                    description: Data is the content of the object. The variables
+                      are expanded in its values.
+                    type: object
+                  name:
+                    description: Name is the base name of the object.
+                    type: string
+                required:
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            staticIPs:
+              description: StaticIPs allocates sequential IP addresses to the replicas.
+              properties:
+                end:
+                  description: |-
+                    End is the highest IP address which can be allocated. Replicas whose address
+                    would be above it are not created.
+                  type: string
+                start:
+                  description: |-
+                    Start is the IP address of the replica with index 0, the replica with index N
+                    gets the address Start + N.
+                  type: string
+              required:
+              - start
+              type: object
+          type: object
         replicas:
           description: |-
             Number of desired pods. This is a pointer to distinguish between explicit
@@ -20163,10 +24920,13 @@ var CRDsValidation map[string]string = map[string]string{
                     Defaults to "Random" base policy when no SelectionPolicy is configured
                   properties:
                     basePolicy:
-                      description: BasePolicy is a catch-all policy [Random|DescendingOrder]
+                      description: |-
+                        BasePolicy is a catch-all policy [Random|DescendingOrder|LeastLoaded]
+                        LeastLoaded removes the VMs which are not running first, then the ones using the least CPU.
                       enum:
                       - Random
                       - DescendingOrder
+                      - LeastLoaded
                       type: string
                   type: object
               type: object
@@ -20218,6 +24978,46 @@ var CRDsValidation map[string]string = map[string]string{
               type: object
           type: object
           x-kubernetes-map-type: atomic
+        updateStrategy:
+          description: |-
+            UpdateStrategy is how the VirtualMachines of the pool are updated when its template
+            changes. Defaults to a RollingUpdate.
+          properties:
+            rollingUpdate:
+              description: RollingUpdate holds the parameters of the RollingUpdate
+                type.
+              properties:
+                liveUpdate:
+                  description: |-
+                    LiveUpdate applies the changes which can be hotplugged or live migrated to the
+                    running VMIs instead of restarting them. Requires the LiveUpdate VM rollout
+                    strategy of the cluster.
+                  type: boolean
+                maxSurge:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    MaxSurge is the number or percentage of VirtualMachines which can be created above
+                    the replicas of the pool during the update. Percentages are rounded up. Defaults to 0.
+                  x-kubernetes-int-or-string: true
+                maxUnavailable:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    MaxUnavailable is the number or percentage of VirtualMachines which can be unavailable
+                    during the update. Defaults to spec.maxUnavailable, which can't be set together with it.
+                  x-kubernetes-int-or-string: true
+              type: object
+            type:
+              description: Type is the type of the update strategy [RollingUpdate|Opportunistic].
+                Defaults to RollingUpdate.
+              enum:
+              - RollingUpdate
+              - Opportunistic
+              type: string
+          type: object
         virtualMachineTemplate:
           description: Template describes the VM that will be created.
           properties:
@@ -20228,6 +25028,15 @@ var CRDsValidation map[string]string = map[string]string{
             spec:
               description: VirtualMachineSpec contains the VirtualMachine specification.
               properties:
+                changeApplyStrategy:
+                  description: |-
+                    ChangeApplyStrategy defines when the changes of the template are applied to the running VMI.
+                    With Staged, the changes are listed in status.stagedChanges and only applied on the next restart
+                    or through the applychanges subresource. Defaults to Immediate.
+                  enum:
+                  - Immediate
+                  - Staged
+                  type: string
                 dataVolumeTemplates:
                   description: |-
                     dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.
@@ -20882,6 +25691,25 @@ var CRDsValidation map[string]string = map[string]string{
                     - spec
                     type: object
                   type: array
+                highAvailability:
+                  description: HighAvailability defines how the VMI of the VirtualMachine
+                    is recovered from a failure of its node
+                  properties:
+                    failoverTimeout:
+                      description: |-
+                        FailoverTimeout is how long the node of the VMI has to be lost before the VMI is failed over
+                        to another node. Defaults to 1m.
+                      type: string
+                    fencing:
+                      description: |-
+                        Fencing defines how the lost node has to be confirmed to be fenced before the VMI is failed over.
+                        With OutOfServiceTaint, the default, a fencing agent has to taint the node with node.kubernetes.io/out-of-service.
+                        With None, the VMI is failed over once the timeout passed, which requires the UnfencedFailover feature gate.
+                      enum:
+                      - None
+                      - OutOfServiceTaint
+                      type: string
+                  type: object
                 instancetype:
                   description: InstancetypeMatcher references a instancetype that
                     is used to fill fields in Template
@@ -20915,6 +25743,30 @@ var CRDsValidation map[string]string = map[string]string{
                         captured the first time the instancetype is applied to the VirtualMachineInstance.
                       type: string
                   type: object
+                powerSchedule:
+                  description: PowerSchedule defines the times the VirtualMachine
+                    is started and stopped by the VM controller
+                  properties:
+                    exceptions:
+                      description: Exceptions are the dates, in the YYYY-MM-DD format,
+                        on which the scheduled starts are skipped, e.g. holidays
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    start:
+                      description: Start is a cron expression in the standard five
+                        field format of the times the VirtualMachine is started
+                      type: string
+                    stop:
+                      description: Stop is a cron expression in the standard five
+                        field format of the times the VirtualMachine is stopped
+                      type: string
+                    timeZone:
+                      description: TimeZone is the IANA name of the time zone the
+                        schedule is evaluated in. Defaults to UTC.
+                      type: string
+                  type: object
                 preference:
                   description: PreferenceMatcher references a set of preference that
                     is used to fill fields in Template
@@ -22015,6 +26867,33 @@ var CRDsValidation map[string]string = map[string]string{
                             you are attempting to run. Defaults to the compiled architecture
                             of the KubeVirt components
                           type: string
+                        crashDump:
+                          description: |-
+                            CrashDump dumps the memory of the guest to a volume when the guest panics, for offline analysis.
+                            Requires a panic device.
+                          properties:
+                            maxDumps:
+                              description: |-
+                                MaxDumps is the number of crash dumps kept in the volume, the oldest ones are removed first.
+                                Defaults to 1.
+                              format: int32
+                              type: integer
+                            maxSize:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: MaxSize is the largest guest memory which
+                                is dumped. The memory of larger guests is not dumped.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            volumeName:
+                              description: |-
+                                VolumeName is the name of the memory dump volume the memory of the guest is dumped to.
+                                The volume must not be hotpluggable, and must use the Raw format.
+                              type: string
+                          required:
+                          - volumeName
+                          type: object
                         dnsConfig:
                           description: |-
                             Specifies the DNS parameters of a pod.
@@ -22250,6 +27129,64 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: NUMA allows specifying settings for
                                     the guest NUMA topology
                                   properties:
+                                    guestCells:
+                                      description: |-
+                                        GuestCells defines an explicit guest NUMA topology. Each vCPU has to be assigned to exactly one cell,
+                                        and the memory of the cells has to add up to the guest memory.
+                                        Can't be combined with GuestMappingPassthrough.
+                                      items:
+                                        description: NUMAGuestCell defines a NUMA
+                                          cell of the guest.
+                                        properties:
+                                          cpus:
+                                            description: CPUs is the list of the vCPUs
+                                              of the cell, e.g. "0-3,8".
+                                            type: string
+                                          distances:
+                                            description: |-
+                                              Distances lists the distances from this cell to the other cells.
+                                              The distances which are not listed are picked by the hypervisor.
+                                            items:
+                                              description: NUMAGuestCellDistance defines
+                                                the distance from a NUMA cell of the
+                                                guest to another one.
+                                              properties:
+                                                cellID:
+                                                  description: CellID is the ID of
+                                                    the other cell.
+                                                  format: int32
+                                                  type: integer
+                                                value:
+                                                  description: Value is the distance,
+                                                    between 10 and 255. 10 is the
+                                                    distance of a cell to itself.
+                                                  format: int32
+                                                  type: integer
+                                              required:
+                                              - cellID
+                                              - value
+                                              type: object
+                                            type: array
+                                          id:
+                                            description: ID of the cell. The IDs of
+                                              the cells have to range from 0 to the
+                                              number of cells minus one.
+                                            format: int32
+                                            type: integer
+                                          memory:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: Memory is the amount of guest
+                                              memory of the cell.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                        required:
+                                        - id
+                                        - cpus
+                                        - memory
+                                        type: object
+                                      type: array
                                     guestMappingPassthrough:
                                       description: |-
                                         GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
@@ -22314,6 +27251,11 @@ var CRDsValidation map[string]string = map[string]string{
                                     Whether to attach the VSOCK CID to the VM or not.
                                     VSOCK access will be available if set to true. Defaults to false.
                                   type: boolean
+                                autoattachVirtIODrivers:
+                                  description: |-
+                                    Whether to attach the VirtIO driver ISO as a CD-ROM, to install the drivers in Windows guests.
+                                    Requires the VirtIO drivers autoattach to be enabled in the cluster config. Defaults to false.
+                                  type: boolean
                                 blockMultiQueue:
                                   description: |-
                                     Whether or not to enable virtio multi-queue for block devices.
@@ -22323,6 +27265,28 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: To configure and access client devices
                                     such as redirecting USB
                                   type: object
+                                consoleSharing:
+                                  description: |-
+                                    ConsoleSharing configures how many clients can be connected to the serial console and to VNC at the same time.
+                                    Defaults to a single exclusive connection, which is disconnected by a new connection.
+                                  properties:
+                                    maxConnections:
+                                      description: |-
+                                        MaxConnections limits the number of concurrent connections of the shared policies to the serial console
+                                        and to VNC each, further connections are refused. Defaults to 4.
+                                      format: int32
+                                      maximum: 16
+                                      minimum: 1
+                                      type: integer
+                                    policy:
+                                      description: Policy defines how the connections are shared, Exclusive,
+                                        SharedReadOnly or SharedFull. Defaults to Exclusive.
+                                      enum:
+                                      - Exclusive
+                                      - SharedReadOnly
+                                      - SharedFull
+                                      type: string
+                                  type: object
                                 disableHotplug:
                                   description: DisableHotplug disabled the ability
                                     to hotplug disks.
@@ -22410,7 +27374,7 @@ var CRDsValidation map[string]string = map[string]string{
                                           bus:
                                             description: |-
                                               Bus indicates the type of disk device to emulate.
-                                              supported values: virtio, sata, scsi, usb.
+                                              supported values: virtio, sata, scsi, usb, nvme.
                                             type: string
                                           pciAddress:
                                             description: 'If specified, the virtual
@@ -22431,8 +27395,81 @@ var CRDsValidation map[string]string = map[string]string{
                                       io:
                                         description: |-
                                           IO specifies which QEMU disk IO mode should be used.
-                                          Supported values are: native, default, threads.
+                                          Supported values are: native, default, threads, io_uring.
                                         type: string
+                                      ioLimits:
+                                        description: |-
+                                          IOLimits throttles the IO operations and bandwidth of the disk.
+                                          They can be updated on a running VMI through the iolimits subresource.
+                                        properties:
+                                          burst:
+                                            description: Burst allows the disk to
+                                              exceed its limits for a short time.
+                                            properties:
+                                              lengthSeconds:
+                                                description: |-
+                                                  LengthSeconds is for how long the disk may burst.
+                                                  Defaults to 1.
+                                                format: int64
+                                                type: integer
+                                              readBandwidth:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: ReadBandwidth is the
+                                                  bytes read per second the disk may
+                                                  burst to.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              readIOPS:
+                                                description: ReadIOPS is the read
+                                                  operations per second the disk may
+                                                  burst to.
+                                                format: int64
+                                                type: integer
+                                              writeBandwidth:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: WriteBandwidth is the
+                                                  bytes written per second the disk
+                                                  may burst to.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              writeIOPS:
+                                                description: WriteIOPS is the write
+                                                  operations per second the disk may
+                                                  burst to.
+                                                format: int64
+                                                type: integer
+                                            type: object
+                                          readBandwidth:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: ReadBandwidth limits the
+                                              bytes read per second, e.g. 100Mi.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          readIOPS:
+                                            description: ReadIOPS limits the read
+                                              operations per second.
+                                            format: int64
+                                            type: integer
+                                          writeBandwidth:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: WriteBandwidth limits the
+                                              bytes written per second, e.g. 100Mi.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          writeIOPS:
+                                            description: WriteIOPS limits the write
+                                              operations per second.
+                                            format: int64
+                                            type: integer
+                                        type: object
                                       lun:
                                         description: Attach a volume as a LUN to the
                                           vmi.
@@ -22489,6 +27526,22 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: string
                                       virtiofs:
                                         description: Virtiofs is supported
+                                        properties:
+                                          cache:
+                                            description: |-
+                                              Cache specifies the caching mode of virtiofsd.
+                                              Supported values are:
+                                              auto: metadata and paths are cached with a timeout, data is cached until the file is closed.
+                                              always: metadata, data and paths are cached indefinitely.
+                                              never: nothing is cached, changes on the host are seen immediately by the guest.
+                                              Defaults to auto.
+                                            type: string
+                                          threadPoolSize:
+                                            description: |-
+                                              ThreadPoolSize is the maximum number of worker threads virtiofsd uses
+                                              to serve requests. Defaults to the virtiofsd default.
+                                            format: int32
+                                            type: integer
                                         type: object
                                     required:
                                     - name
@@ -22514,6 +27567,12 @@ var CRDsValidation map[string]string = map[string]string{
                                         description: Name of the GPU device as exposed
                                           by a device plugin
                                         type: string
+                                      profile:
+                                        description: |-
+                                          Profile is the name of a mediated device profile of the KubeVirt CR.
+                                          It is resolved on each node to the mediated device type the profile maps to.
+                                          Can't be set together with a different deviceName.
+                                        type: string
                                       requestName:
                                         description: |-
                                           RequestName needs to be provided from resourceClaim.spec.devices.requests[].name where this
@@ -22551,6 +27610,19 @@ var CRDsValidation map[string]string = map[string]string{
                                     type: object
                                   type: array
                                   x-kubernetes-list-type: atomic
+                                graphics:
+                                  description: Graphics defines the protocol of the
+                                    auto-attached graphics device.
+                                  properties:
+                                    type:
+                                      description: |-
+                                        Type of the graphics device, VNC or SPICE. SPICE additionally allows the client to play the audio
+                                        of the sound device and to redirect USB devices when clientPassthrough is set. Defaults to VNC.
+                                      enum:
+                                      - VNC
+                                      - SPICE
+                                      type: string
+                                  type: object
                                 hostDevices:
                                   description: Whether to attach a host device to
                                     the vmi.
@@ -22752,6 +27824,12 @@ var CRDsValidation map[string]string = map[string]string{
                                           - port
                                           type: object
                                         type: array
+                                      rxQueueSize:
+                                        description: |-
+                                          RxQueueSize sets the size of the receive virtqueue of the interface.
+                                          Must be a power of 2 between 256 and 1024 and is only supported by the virtio model.
+                                        format: int32
+                                        type: integer
                                       slirp:
                                         description: |-
                                           DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -22776,6 +27854,12 @@ var CRDsValidation map[string]string = map[string]string{
                                           interface address and its tag will be provided
                                           to the guest via config drive
                                         type: string
+                                      txQueueSize:
+                                        description: |-
+                                          TxQueueSize sets the size of the transmit virtqueue of the interface.
+                                          Must be a power of 2 between 256 and 1024 and is only supported by the virtio model.
+                                        format: int32
+                                        type: integer
                                     required:
                                     - name
                                     type: object
@@ -22869,7 +27953,8 @@ var CRDsValidation map[string]string = map[string]string{
                                       properties:
                                         action:
                                           description: |-
-                                            The action to take. Valid values are poweroff, reset, shutdown.
+                                            The action to take. Valid values are poweroff, reset, shutdown, migrate, snapshot-reset, alert.
+                                            The migrate, snapshot-reset and alert actions are taken by KubeVirt rather than by the hypervisor.
                                             Defaults to reset.
                                           type: string
                                       type: object
@@ -22878,7 +27963,8 @@ var CRDsValidation map[string]string = map[string]string{
                                       properties:
                                         action:
                                           description: |-
-                                            The action to take. Valid values are poweroff, reset, shutdown.
+                                            The action to take. Valid values are poweroff, reset, shutdown, migrate, snapshot-reset, alert.
+                                            The migrate, snapshot-reset and alert actions are taken by KubeVirt rather than by the hypervisor.
                                             Defaults to reset.
                                           type: string
                                       type: object
@@ -23295,27 +28381,77 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Memory allow specifying the VMI memory
                                 features.
                               properties:
-                                guest:
-                                  anyOf:
-                                  - type: integer
-                                  - type: string
+                                balloon:
                                   description: |-
-                                    Guest allows to specifying the amount of memory which is visible inside the Guest OS.
-                                    The Guest must lie between Requests and Limits from the resources section.
-                                    Defaults to the requested memory in the resources section if not specified.
-                                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                                  x-kubernetes-int-or-string: true
-                                hugepages:
-                                  description: Hugepages allow to use hugepages for
-                                    the VirtualMachineInstance instead of regular
-                                    memory.
+                                    Balloon lets virt-handler reclaim unused guest memory through the memory balloon.
+                                    Fields which are set override the cluster wide memoryBalloon configuration.
+                                  properties:
+                                    max:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: |-
+                                        Max is the largest amount of memory the balloon gives to the guest.
+                                        Defaults to the guest memory and can not exceed it.
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
+                                    min:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: |-
+                                        Min is the least amount of memory the balloon leaves to the guest.
+                                        Defaults to half of the guest memory.
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
+                                    targetFreePercentage:
+                                      description: |-
+                                        TargetFreePercentage is the share of the memory given to the guest which should remain
+                                        available to it. Defaults to 20.
+                                      format: int32
+                                      type: integer
+                                  type: object
+                                freePageReporting:
+                                  description: |-
+                                    FreePageReporting lets the guest report its free memory pages to the host so that they can be reclaimed.
+                                    Defaults to the cluster wide setting. It is never enabled for VMIs requesting dedicated CPUs, hugepages or realtime.
+                                  type: boolean
+                                guest:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: |-
+                                    Guest allows to specifying the amount of memory which is visible inside the Guest OS.
+                                    The Guest must lie between Requests and Limits from the resources section.
+                                    Defaults to the requested memory in the resources section if not specified.
+                                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                  x-kubernetes-int-or-string: true
+                                hugepages:
+                                  description: Hugepages allow to use hugepages for
+                                    the VirtualMachineInstance instead of regular
+                                    memory.
                                   properties:
                                     pageSize:
                                       description: PageSize specifies the hugepage
                                         size, for x86_64 architecture valid values
                                         are 1Gi and 2Mi.
                                       type: string
+                                    regularMemory:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: |-
+                                        RegularMemory is the amount of the guest memory which is backed by regular pages instead of hugepages.
+                                        The rest of the guest memory, including the memory hotplugged at runtime, is backed by hugepages.
+                                        It must be a multiple of the page size and less than the guest memory.
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
                                   type: object
+                                ksmMergePolicy:
+                                  description: |-
+                                    KSMMergePolicy sets how aggressively the kernel samepage merging of the node merges the guest memory.
+                                    Defaults to the mergePolicy of the cluster wide KSM configuration.
+                                  type: string
                                 maxGuest:
                                   anyOf:
                                   - type: integer
@@ -23325,6 +28461,11 @@ var CRDsValidation map[string]string = map[string]string{
                                     The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
                                   pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                                   x-kubernetes-int-or-string: true
+                                overcommit:
+                                  description: |-
+                                    Overcommit is the memory overcommit class of the VirtualMachineInstance. It controls how much memory
+                                    the pod requests, whether the guest memory can be swapped out and how KSM merges it.
+                                  type: string
                               type: object
                             resources:
                               description: Resources describes the Compute Resources
@@ -23371,11 +28512,98 @@ var CRDsValidation map[string]string = map[string]string{
                             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                             - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                           type: string
+                        hooks:
+                          description: |-
+                            Hooks are sidecar containers called by virt-launcher on the lifecycle points of the VMI,
+                            for example to adjust the domain before it is defined.
+                            Requires the Sidecar feature gate.
+                          items:
+                            description: Hook is a sidecar container called by virt-launcher
+                              on the lifecycle points of the VMI.
+                            properties:
+                              args:
+                                description: Arguments to the entrypoint. The image's
+                                  CMD is used if this is not provided.
+                                items:
+                                  type: string
+                                type: array
+                              command:
+                                description: Entrypoint of the sidecar container.
+                                  The image's ENTRYPOINT is used if this is not provided.
+                                items:
+                                  type: string
+                                type: array
+                              hookPoints:
+                                description: HookPoints the hook is called on. Hook
+                                  points exposed by the hook but not listed here are
+                                  not called.
+                                items:
+                                  description: HookPoint is a lifecycle point of the
+                                    VMI a hook can be called on.
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: set
+                              image:
+                                description: Image of the sidecar container serving
+                                  the hook.
+                                type: string
+                              imagePullPolicy:
+                                description: |-
+                                  Image pull policy.
+                                  One of Always, Never, IfNotPresent.
+                                  Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+                                type: string
+                              name:
+                                description: Name of the hook, unique within the VMI.
+                                  The sidecar container is named hook-<name>.
+                                type: string
+                              version:
+                                description: |-
+                                  Version of the hook API virt-launcher uses to call the hook.
+                                  Defaults to the newest version exposed by the hook.
+                                type: string
+                            required:
+                            - hookPoints
+                            - image
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-map-keys:
+                          - name
+                          x-kubernetes-list-type: map
                         hostname:
                           description: |-
                             Specifies the hostname of the vmi
                             If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                           type: string
+                        idlePolicy:
+                          description: IdlePolicy pauses or stops the VMI once the
+                            guest has been idle for a while.
+                          properties:
+                            action:
+                              description: Action taken once the guest is idle. Defaults
+                                to Pause.
+                              enum:
+                              - Pause
+                              - Stop
+                              type: string
+                            cpuThresholdPercentage:
+                              description: |-
+                                CPUThresholdPercentage is the usage of the vCPUs of the VMI, in percent, below which the
+                                guest is idle. Defaults to 5.
+                              format: int32
+                              type: integer
+                            ignoreUserSessions:
+                              description: IgnoreUserSessions considers the guest
+                                idle even if users are logged in to it.
+                              type: boolean
+                            timeout:
+                              description: Timeout is how long the guest has to be
+                                idle before the action is taken.
+                              type: string
+                          required:
+                          - timeout
+                          type: object
                         livenessProbe:
                           description: |-
                             Periodic probe of VirtualMachineInstance liveness.
@@ -24071,6 +29299,49 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: UserDataBase64 contains config drive
                                       cloud-init userdata as a base64 encoded string.
                                     type: string
+                                  userDataSources:
+                                    description: |-
+                                      UserDataSources references additional Secrets and ConfigMaps containing config drive userdata.
+                                      Their userdata is merged, in the listed order, after the userdata of this source into a single
+                                      multi-part MIME userdata.
+                                    items:
+                                      description: |-
+                                        CloudInitUserDataSource references a Secret or ConfigMap containing cloud-init userdata
+                                        in its userdata or userData key. Only one of its members may be specified.
+                                      properties:
+                                        configMapRef:
+                                          description: ConfigMapRef references a k8s
+                                            configmap that contains userdata.
+                                          properties:
+                                            name:
+                                              default: ""
+                                              description: |-
+                                                Name of the referent.
+                                                This field is effectively required, but due to backwards compatibility is
+                                                allowed to be empty. Instances of this type with an empty value here are
+                                                almost certainly wrong.
+                                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                              type: string
+                                          type: object
+                                          x-kubernetes-map-type: atomic
+                                        secretRef:
+                                          description: SecretRef references a k8s
+                                            secret that contains userdata.
+                                          properties:
+                                            name:
+                                              default: ""
+                                              description: |-
+                                                Name of the referent.
+                                                This field is effectively required, but due to backwards compatibility is
+                                                allowed to be empty. Instances of this type with an empty value here are
+                                                almost certainly wrong.
+                                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                              type: string
+                                          type: object
+                                          x-kubernetes-map-type: atomic
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
                                 type: object
                               cloudInitNoCloud:
                                 description: |-
@@ -24124,6 +29395,49 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: UserDataBase64 contains NoCloud cloud-init
                                       userdata as a base64 encoded string.
                                     type: string
+                                  userDataSources:
+                                    description: |-
+                                      UserDataSources references additional Secrets and ConfigMaps containing NoCloud userdata.
+                                      Their userdata is merged, in the listed order, after the userdata of this source into a single
+                                      multi-part MIME userdata.
+                                    items:
+                                      description: |-
+                                        CloudInitUserDataSource references a Secret or ConfigMap containing cloud-init userdata
+                                        in its userdata or userData key. Only one of its members may be specified.
+                                      properties:
+                                        configMapRef:
+                                          description: ConfigMapRef references a k8s
+                                            configmap that contains userdata.
+                                          properties:
+                                            name:
+                                              default: ""
+                                              description: |-
+                                                Name of the referent.
+                                                This field is effectively required, but due to backwards compatibility is
+                                                allowed to be empty. Instances of this type with an empty value here are
+                                                almost certainly wrong.
+                                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                              type: string
+                                          type: object
+                                          x-kubernetes-map-type: atomic
+                                        secretRef:
+                                          description: SecretRef references a k8s
+                                            secret that contains userdata.
+                                          properties:
+                                            name:
+                                              default: ""
+                                              description: |-
+                                                Name of the referent.
+                                                This field is effectively required, but due to backwards compatibility is
+                                                allowed to be empty. Instances of this type with an empty value here are
+                                                almost certainly wrong.
+                                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                              type: string
+                                          type: object
+                                          x-kubernetes-map-type: atomic
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
                                 type: object
                               configMap:
                                 description: |-
@@ -24354,6 +29668,34 @@ var CRDsValidation map[string]string = map[string]string{
                                 - path
                                 - type
                                 type: object
+                              ignition:
+                                description: |-
+                                  Ignition represents an Ignition config for CoreOS-style guests.
+                                  The config is passed to the guest through the QEMU firmware configuration device, no disk is needed for this volume.
+                                  There can only be one volume of this type!
+                                  More info: https://coreos.github.io/ignition/
+                                properties:
+                                  data:
+                                    description: Data contains the inline Ignition
+                                      config.
+                                    type: string
+                                  secretRef:
+                                    description: SecretRef references a k8s secret
+                                      that contains the Ignition config in its userdata
+                                      or userData key.
+                                    properties:
+                                      name:
+                                        default: ""
+                                        description: |-
+                                          Name of the referent.
+                                          This field is effectively required, but due to backwards compatibility is
+                                          allowed to be empty. Instances of this type with an empty value here are
+                                          almost certainly wrong.
+                                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                        type: string
+                                    type: object
+                                    x-kubernetes-map-type: atomic
+                                type: object
                               memoryDump:
                                 description: MemoryDump is attached to the virt launcher
                                   and is populated with a memory dump of the vmi
@@ -24363,6 +29705,17 @@ var CRDsValidation map[string]string = map[string]string{
                                       claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                                       More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                                     type: string
+                                  content:
+                                    description: |-
+                                      Content is the part of the guest memory which is dumped, Full or Kernel.
+                                      Defaults to Full
+                                    type: string
+                                  format:
+                                    description: |-
+                                      Format is the format the memory is dumped in. When the volume is not
+                                      hotpluggable and holds a memory state, the VMI is resumed from it on start.
+                                      Defaults to Raw
+                                    type: string
                                   hotpluggable:
                                     description: Hotpluggable indicates whether the
                                       volume can be hotplugged and hotunplugged.
@@ -24456,6 +29809,11 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: string
                                     type: object
                                     x-kubernetes-map-type: atomic
+                                  namespace:
+                                    description: |-
+                                      Namespace of the referenced ConfigMap. Defaults to the namespace of the VirtualMachineInstance.
+                                      Other namespaces must be listed in the sysprep templateNamespaces of the KubeVirt configuration.
+                                    type: string
                                   secret:
                                     description: Secret references a k8s Secret that
                                       contains Sysprep answer file named autounattend.xml
@@ -24472,12 +29830,57 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: string
                                     type: object
                                     x-kubernetes-map-type: atomic
+                                  template:
+                                    description: Template enables rendering the answer
+                                      files as Go templates, with the values of the
+                                      VirtualMachineInstance.
+                                    properties:
+                                      parametersSecretRef:
+                                        description: |-
+                                          ParametersSecretRef references a k8s Secret in the namespace of the VirtualMachineInstance,
+                                          whose keys are exposed to the templates as .Parameters, e.g. a license key.
+                                        properties:
+                                          name:
+                                            default: ""
+                                            description: |-
+                                              Name of the referent.
+                                              This field is effectively required, but due to backwards compatibility is
+                                              allowed to be empty. Instances of this type with an empty value here are
+                                              almost certainly wrong.
+                                              More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                            type: string
+                                        type: object
+                                        x-kubernetes-map-type: atomic
+                                    type: object
+                                type: object
+                              vhostUserBlk:
+                                description: |-
+                                  VhostUserBlk connects the disk to a vhost-user-blk socket exposed on the node by a storage target like SPDK,
+                                  bypassing the block layer of the host kernel.
+                                properties:
+                                  resourceName:
+                                    description: |-
+                                      ResourceName is the name of the device plugin resource providing the socket.
+                                      The device plugin passes the path of the allocated socket to the pod through the
+                                      VHOST_USER_BLK_RESOURCE_<resource name> environment variable.
+                                    type: string
+                                  socketName:
+                                    description: SocketName is the name of the socket
+                                      in the vhost-user-blk socket directory of the
+                                      node, /var/run/vhost-user-blk.
+                                    type: string
                                 type: object
                             required:
                             - name
                             type: object
                           maxItems: 256
                           type: array
+                        workloadClass:
+                          description: |-
+                            WorkloadClass is the name of one of the workloadClasses of the KubeVirt configuration.
+                            The VMI gets the priority of the class, and may be preempted by VMIs of a higher
+                            priority if the class allows it.
+                          type: string
                       required:
                       - domain
                       type: object
@@ -24496,6 +29899,50 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     status:
       properties:
+        autoscaling:
+          description: Autoscaling is the state of the autoscaling of the pool.
+          properties:
+            currentMetrics:
+              description: CurrentMetrics holds the last values read for the metrics
+                of the pool.
+              items:
+                properties:
+                  currentAverageUtilization:
+                    description: |-
+                      CurrentAverageUtilization is the average utilization of the running VirtualMachines,
+                      in percent, for the CPU and Memory types.
+                    format: int32
+                    type: integer
+                  currentAverageValue:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: CurrentAverageValue is the value of the query per
+                      replica, for the Prometheus type.
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  type:
+                    description: Type is the type of the metric.
+                    type: string
+                required:
+                - type
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            desiredReplicas:
+              description: DesiredReplicas is the number of replicas the autoscaling
+                last asked for.
+              format: int32
+              type: integer
+            lastScaleTime:
+              description: LastScaleTime is the time the pool was last scaled at by
+                the autoscaling.
+              format: date-time
+              nullable: true
+              type: string
+          required:
+          - desiredReplicas
+          type: object
         conditions:
           items:
             properties:
@@ -24525,12 +29972,22 @@ var CRDsValidation map[string]string = map[string]string{
           description: Canonical form of the label selector for HPA which consumes
             it through the scale subresource.
           type: string
+        outdatedReplicas:
+          description: OutdatedReplicas is the number of VirtualMachines whose VM
+            or VMI do not match the template of the pool yet.
+          format: int32
+          type: integer
         readyReplicas:
           format: int32
           type: integer
         replicas:
           format: int32
           type: integer
+        updatedReplicas:
+          description: UpdatedReplicas is the number of VirtualMachines whose VM and
+            VMI match the template of the pool.
+          format: int32
+          type: integer
       type: object
   required:
   - spec
@@ -24739,6 +30196,10 @@ var CRDsValidation map[string]string = map[string]string{
               description: PreferredAutoattachSerialConsole optionally defines the
                 preferred value of AutoattachSerialConsole
               type: boolean
+            preferredAutoattachVirtIODrivers:
+              description: PreferredAutoattachVirtIODrivers optionally defines the
+                preferred value of AutoattachVirtIODrivers
+              type: boolean
             preferredBlockMultiQueue:
               description: PreferredBlockMultiQueue optionally enables the vhost multiqueue
                 feature for virtio disks.
@@ -24793,6 +30254,10 @@ var CRDsValidation map[string]string = map[string]string{
               description: PreferredIo optionally defines the QEMU disk IO mode to
                 be used by Disk devices.
               type: string
+            preferredIOThreadsPolicy:
+              description: PreferredIOThreadsPolicy optionally defines the preferred
+                IOThreadsPolicy to be used by the VirtualMachine.
+              type: string
             preferredInputBus:
               description: PreferredInputBus optionally defines the preferred bus
                 for Input devices.
@@ -24809,6 +30274,16 @@ var CRDsValidation map[string]string = map[string]string{
               description: PreferredInterfaceModel optionally defines the preferred
                 model to be used by Interface devices.
               type: string
+            preferredInterfaceRxQueueSize:
+              description: PreferredInterfaceRxQueueSize optionally defines the preferred
+                receive queue size of virtio Interface devices.
+              format: int32
+              type: integer
+            preferredInterfaceTxQueueSize:
+              description: PreferredInterfaceTxQueueSize optionally defines the preferred
+                transmit queue size of virtio Interface devices.
+              format: int32
+              type: integer
             preferredLunBus:
               description: PreferredLunBus optionally defines the preferred bus for
                 Lun Disk devices.
@@ -25211,6 +30686,74 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachineresourcequota": `openAPIV3Schema:
+  description: |-
+    VirtualMachineResourceQuota limits the guest resources the VirtualMachines of a namespace
+    may claim in total. Unlike a ResourceQuota, which is enforced on the requests of the
+    virt-launcher pods, the limits are expressed in guest vCPUs, guest memory and number of
+    VirtualMachines, independent of the overhead of the pods.
+    Every VirtualMachine of the namespace is accounted for, whether it runs or not.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        hard:
+          additionalProperties:
+            anyOf:
+            - type: integer
+            - type: string
+            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+            x-kubernetes-int-or-string: true
+          description: |-
+            Hard is the set of enforced limits for each named resource.
+            Supported resources are guest.cpu, guest.memory and virtualmachines.
+          type: object
+      required:
+      - hard
+      type: object
+    status:
+      properties:
+        hard:
+          additionalProperties:
+            anyOf:
+            - type: integer
+            - type: string
+            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+            x-kubernetes-int-or-string: true
+          description: Hard is the set of enforced limits the usage was last
+            observed against.
+          type: object
+        used:
+          additionalProperties:
+            anyOf:
+            - type: integer
+            - type: string
+            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+            x-kubernetes-int-or-string: true
+          description: Used is the current usage of the VirtualMachines of the
+            namespace.
+          type: object
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachinerestore": `openAPIV3Schema:
   description: VirtualMachineRestore defines the operation of restoring a VM
@@ -25236,6 +30779,11 @@ var CRDsValidation map[string]string = map[string]string{
       description: VirtualMachineRestoreSpec is the spec for a VirtualMachineRestore
         resource
       properties:
+        dryRun:
+          description: |-
+            DryRun makes the restore compute the changes it would apply to the target
+            and report them in the status, without modifying the target or its volumes
+          type: boolean
         patches:
           description: |-
             If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be
@@ -25246,6 +30794,17 @@ var CRDsValidation map[string]string = map[string]string{
             type: string
           type: array
           x-kubernetes-list-type: atomic
+        runStrategy:
+          description: |-
+            RunStrategy is set on the target once restored. An existing target keeps
+            its run strategy and a new one is created halted when unset
+          type: string
+        startVirtualMachine:
+          description: |-
+            StartVirtualMachine starts the target once restored, with the run strategy it had
+            before the restore stopped it, or Always when it was not running.
+            Cannot be combined with RunStrategy
+          type: boolean
         target:
           description: initially only VirtualMachine type supported
           properties:
@@ -25271,6 +30830,13 @@ var CRDsValidation map[string]string = map[string]string{
             TargetReadinessPolicy defines how to handle the restore in case
             the target is not ready
           type: string
+        targetReset:
+          description: |-
+            TargetReset regenerates the guest identifiers copied from the snapshot when
+            restoring into a VirtualMachine other than the snapshot source: the firmware
+            UUID and serial, which the cloud-init instance-id is derived from, and the
+            MAC addresses of the interfaces. Patches are applied afterwards
+          type: boolean
         virtualMachineSnapshotName:
           type: string
         volumeRestoreOverrides:
@@ -25300,6 +30866,14 @@ var CRDsValidation map[string]string = map[string]string{
           description: VolumeRestorePolicy defines how to handle the restore of snapshotted
             volumes
           type: string
+        volumes:
+          description: |-
+            Volumes limits the restore to the listed volumes of the snapshot. Volumes which are
+            not listed keep their current source in the target. All volumes are restored when empty
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: set
       required:
       - target
       - virtualMachineSnapshotName
@@ -25342,11 +30916,50 @@ var CRDsValidation map[string]string = map[string]string{
             type: string
           type: array
           x-kubernetes-list-type: set
-        restoreTime:
-          format: date-time
-          type: string
-        restores:
-          items:
+        dryRunResult:
+          description: DryRunResult holds the changes computed by a dry run restore
+          properties:
+            specPatch:
+              description: SpecPatch is a JSON merge patch turning the current target
+                spec into the restored spec
+              type: string
+            targetExists:
+              description: |-
+                TargetExists is true when the restore would update an existing target
+                instead of creating a new one
+              type: boolean
+            volumeChanges:
+              items:
+                description: VolumeRestoreChange describes how a single volume would
+                  be restored
+                properties:
+                  currentClaimName:
+                    description: CurrentClaimName is the claim currently backing the
+                      volume in the target, if any
+                    type: string
+                  restoreClaimName:
+                    description: RestoreClaimName is the claim the volume would be
+                      restored into
+                    type: string
+                  volumeName:
+                    type: string
+                  volumeSnapshotName:
+                    type: string
+                required:
+                - restoreClaimName
+                - volumeName
+                - volumeSnapshotName
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          required:
+          - targetExists
+          type: object
+        restoreTime:
+          format: date-time
+          type: string
+        restores:
+          items:
             description: VolumeRestore contains the data needed to restore a PVC
             properties:
               dataVolumeName:
@@ -25364,6 +30977,10 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        targetRunStrategy:
+          description: TargetRunStrategy is the run strategy the target had before
+            the restore stopped it
+          type: string
       type: object
   required:
   - spec
@@ -25405,6 +31022,26 @@ var CRDsValidation map[string]string = map[string]string{
             as failed.
             Defaults to DefaultFailureDeadline - 5min
           type: string
+        includeMemory:
+          description: |-
+            IncludeMemory also captures the memory state of a running VirtualMachine
+            in a dedicated volume, which a restore resumes the guest from.
+            Requires volume hotplug to be enabled, ignored when the VirtualMachine is not running
+          type: boolean
+        retentionPolicy:
+          description: |-
+            RetentionPolicy prunes older snapshots of the same source once
+            this snapshot succeeded. Snapshots which are still in progress
+            are never pruned.
+          properties:
+            maxAge:
+              description: MaxAge is the time after its creation a snapshot is deleted
+              type: string
+            maxCount:
+              description: MaxCount is the number of most recent snapshots to keep
+              format: int32
+              type: integer
+          type: object
         source:
           description: |-
             TypedLocalObjectReference contains enough information to let you locate the
@@ -25474,6 +31111,28 @@ var CRDsValidation map[string]string = map[string]string{
               format: date-time
               type: string
           type: object
+        hookResults:
+          description: HookResults reports the outcome of the freeze hooks run for
+            the snapshot
+          items:
+            description: FreezeHookResult is the outcome of a single freeze hook
+            properties:
+              message:
+                type: string
+              name:
+                type: string
+              succeeded:
+                type: boolean
+              type:
+                description: FreezeHookType defines when a freeze hook is run
+                type: string
+            required:
+            - name
+            - succeeded
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         indications:
           items:
             description: Indication is a way to indicate the state of the vm when
@@ -25481,9 +31140,49 @@ var CRDsValidation map[string]string = map[string]string{
             type: string
           type: array
           x-kubernetes-list-type: set
+        memberSnapshots:
+          description: |-
+            MemberSnapshots are the names of the VirtualMachineSnapshots taken of the
+            VirtualMachines of a VirtualMachinePool source
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
         phase:
           description: VirtualMachineSnapshotPhase is the current phase of the VirtualMachineSnapshot
           type: string
+        progress:
+          description: Progress reports the progress of the snapshots of the individual
+            volumes
+          properties:
+            percentage:
+              description: Percentage of the volume snapshots which are ready to use
+              format: int32
+              type: integer
+            volumes:
+              items:
+                description: VolumeSnapshotProgress is the progress of the snapshot
+                  of a single volume
+                properties:
+                  message:
+                    type: string
+                  phase:
+                    description: VolumeSnapshotProgressPhase is the phase of the snapshot
+                      of a single volume
+                    type: string
+                  volumeName:
+                    type: string
+                  volumeSnapshotName:
+                    type: string
+                required:
+                - phase
+                - volumeName
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          required:
+          - percentage
+          type: object
         readyToUse:
           type: boolean
         snapshotVolumes:
@@ -25538,6 +31237,245 @@ var CRDsValidation map[string]string = map[string]string{
       description: VirtualMachineSnapshotContentSpec is the spec for a VirtualMachineSnapshotContent
         resource
       properties:
+        memoryVolumeBackup:
+          description: MemoryVolumeBackup is the backup of the volume holding the
+            memory state of the VirtualMachine
+          properties:
+            hotplug:
+              description: Hotplug is set when the volume was hotplugged to the VirtualMachine
+              properties:
+                bus:
+                  description: Bus is the bus of the disk the volume was attached
+                    with
+                  type: string
+                origin:
+                  description: Origin is the kind of volume source the volume was
+                    hotplugged from
+                  type: string
+                persisted:
+                  description: |-
+                    Persisted is false when the volume was only hotplugged to the running
+                    VirtualMachineInstance and was not part of the VirtualMachine spec
+                  type: boolean
+              required:
+              - origin
+              type: object
+            persistentVolumeClaim:
+              properties:
+                metadata:
+                  description: |-
+                    Standard object's metadata.
+                    More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+                  type: object
+                  x-kubernetes-preserve-unknown-fields: true
+                spec:
+                  description: |-
+                    Spec defines the desired characteristics of a volume requested by a pod author.
+                    More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                  properties:
+                    accessModes:
+                      description: |-
+                        accessModes contains the desired access modes the volume should have.
+                        More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    dataSource:
+                      description: |-
+                        dataSource field can be used to specify either:
+                        * An existing VolumeSnapshot object (snapshot.storage.k8s.io/VolumeSnapshot)
+                        * An existing PVC (PersistentVolumeClaim)
+                        If the provisioner or an external controller can support the specified data source,
+                        it will create a new volume based on the contents of the specified data source.
+                        When the AnyVolumeDataSource feature gate is enabled, dataSource contents will be copied to dataSourceRef,
+                        and dataSourceRef contents will be copied to dataSource when dataSourceRef.namespace is not specified.
+                        If the namespace is specified, then dataSourceRef will not be copied to dataSource.
+                      properties:
+                        apiGroup:
+                          description: |-
+                            APIGroup is the group for the resource being referenced.
+                            If APIGroup is not specified, the specified Kind must be in the core API group.
+                            For any other third-party types, APIGroup is required.
+                          type: string
+                        kind:
+                          description: Kind is the type of resource being referenced
+                          type: string
+                        name:
+                          description: Name is the name of resource being referenced
+                          type: string
+                      required:
+                      - kind
+                      - name
+                      type: object
+                      x-kubernetes-map-type: atomic
+                    dataSourceRef:
+                      description: |-
+                        dataSourceRef specifies the object from which to populate the volume with data, if a non-empty
+                        volume is desired. This may be any object from a non-empty API group (non
+                        core object) or a PersistentVolumeClaim object.
+                        When this field is specified, volume binding will only succeed if the type of
+                        the specified object matches some installed volume populator or dynamic
+                        provisioner.
+                        This field will replace the functionality of the dataSource field and as such
+                        if both fields are non-empty, they must have the same value. For backwards
+                        compatibility, when namespace isn't specified in dataSourceRef,
+                        both fields (dataSource and dataSourceRef) will be set to the same
+                        value automatically if one of them is empty and the other is non-empty.
+                        When namespace is specified in dataSourceRef,
+                        dataSource isn't set to the same value and must be empty.
+                        There are three important differences between dataSource and dataSourceRef:
+                        * While dataSource only allows two specific types of objects, dataSourceRef
+                          allows any non-core object, as well as PersistentVolumeClaim objects.
+                        * While dataSource ignores disallowed values (dropping them), dataSourceRef
+                          preserves all values, and generates an error if a disallowed value is
+                          specified.
+                        * While dataSource only allows local objects, dataSourceRef allows objects
+                          in any namespaces.
+                        (Beta) Using this field requires the AnyVolumeDataSource feature gate to be enabled.
+                        (Alpha) Using the namespace field of dataSourceRef requires the CrossNamespaceVolumeDataSource feature gate to be enabled.
+                      properties:
+                        apiGroup:
+                          description: |-
+                            APIGroup is the group for the resource being referenced.
+                            If APIGroup is not specified, the specified Kind must be in the core API group.
+                            For any other third-party types, APIGroup is required.
+                          type: string
+                        kind:
+                          description: Kind is the type of resource being referenced
+                          type: string
+                        name:
+                          description: Name is the name of resource being referenced
+                          type: string
+                        namespace:
+                          description: |-
+                            Namespace is the namespace of resource being referenced
+                            Note that when a namespace is specified, a gateway.networking.k8s.io/ReferenceGrant object is required in the referent namespace to allow that namespace's owner to accept the reference. See the ReferenceGrant documentation for details.
+                            (Alpha) This field requires the CrossNamespaceVolumeDataSource feature gate to be enabled.
+                          type: string
+                      required:
+                      - kind
+                      - name
+                      type: object
+                    resources:
+                      description: |-
+                        resources represents the minimum resources the volume should have.
+                        If RecoverVolumeExpansionFailure feature is enabled users are allowed to specify resource requirements
+                        that are lower than previous value but must still be higher than capacity recorded in the
+                        status field of the claim.
+                        More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources
+                      properties:
+                        limits:
+                          additionalProperties:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          description: |-
+                            Limits describes the maximum amount of compute resources allowed.
+                            More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                          type: object
+                        requests:
+                          additionalProperties:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          description: |-
+                            Requests describes the minimum amount of compute resources required.
+                            If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                            otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                            More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                          type: object
+                      type: object
+                    selector:
+                      description: selector is a label query over volumes to consider
+                        for binding.
+                      properties:
+                        matchExpressions:
+                          description: matchExpressions is a list of label selector
+                            requirements. The requirements are ANDed.
+                          items:
+                            description: |-
+                              A label selector requirement is a selector that contains values, a key, and an operator that
+                              relates the key and values.
+                            properties:
+                              key:
+                                description: key is the label key that the selector
+                                  applies to.
+                                type: string
+                              operator:
+                                description: |-
+                                  operator represents a key's relationship to a set of values.
+                                  Valid operators are In, NotIn, Exists and DoesNotExist.
+                                type: string
+                              values:
+                                description: |-
+                                  values is an array of string values. If the operator is In or NotIn,
+                                  the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                  the values array must be empty. This array is replaced during a strategic
+                                  merge patch.
+                                items:
+                                  type: string
+                                type: array
+                                x-kubernetes-list-type: atomic
+                            required:
+                            - key
+                            - operator
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        matchLabels:
+                          additionalProperties:
+                            type: string
+                          description: |-
+                            matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                            map is equivalent to an element of matchExpressions, whose key field is "key", the
+                            operator is "In", and the values array contains only "value". The requirements are ANDed.
+                          type: object
+                      type: object
+                      x-kubernetes-map-type: atomic
+                    storageClassName:
+                      description: |-
+                        storageClassName is the name of the StorageClass required by the claim.
+                        More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#class-1
+                      type: string
+                    volumeAttributesClassName:
+                      description: |-
+                        volumeAttributesClassName may be used to set the VolumeAttributesClass used by this claim.
+                        If specified, the CSI driver will create or update the volume with the attributes defined
+                        in the corresponding VolumeAttributesClass. This has a different purpose than storageClassName,
+                        it can be changed after the claim is created. An empty string value means that no VolumeAttributesClass
+                        will be applied to the claim but it's not allowed to reset this field to empty string once it is set.
+                        If unspecified and the PersistentVolumeClaim is unbound, the default VolumeAttributesClass
+                        will be set by the persistentvolume controller if it exists.
+                        If the resource referred to by volumeAttributesClass does not exist, this PersistentVolumeClaim will be
+                        set to a Pending state, as reflected by the modifyVolumeStatus field, until such as a resource
+                        exists.
+                        More info: https://kubernetes.io/docs/concepts/storage/volume-attributes-classes/
+                        (Beta) Using this field requires the VolumeAttributesClass feature gate to be enabled (off by default).
+                      type: string
+                    volumeMode:
+                      description: |-
+                        volumeMode defines what type of volume is required by the claim.
+                        Value of Filesystem is implied when not included in claim spec.
+                      type: string
+                    volumeName:
+                      description: volumeName is the binding reference to the PersistentVolume
+                        backing this claim.
+                      type: string
+                  type: object
+              type: object
+            volumeName:
+              type: string
+            volumeSnapshotName:
+              type: string
+          required:
+          - persistentVolumeClaim
+          - volumeName
+          type: object
         source:
           description: SourceSpec contains the appropriate spec for the resource being
             snapshotted
@@ -25551,6 +31489,15 @@ var CRDsValidation map[string]string = map[string]string{
                 spec:
                   description: VirtualMachineSpec contains the VirtualMachine specification.
                   properties:
+                    changeApplyStrategy:
+                      description: |-
+                        ChangeApplyStrategy defines when the changes of the template are applied to the running VMI.
+                        With Staged, the changes are listed in status.stagedChanges and only applied on the next restart
+                        or through the applychanges subresource. Defaults to Immediate.
+                      enum:
+                      - Immediate
+                      - Staged
+                      type: string
                     dataVolumeTemplates:
                       description: |-
                         dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.
@@ -26216,6 +32163,25 @@ var CRDsValidation map[string]string = map[string]string{
                         - spec
                         type: object
                       type: array
+                    highAvailability:
+                      description: HighAvailability defines how the VMI of the VirtualMachine
+                        is recovered from a failure of its node
+                      properties:
+                        failoverTimeout:
+                          description: |-
+                            FailoverTimeout is how long the node of the VMI has to be lost before the VMI is failed over
+                            to another node. Defaults to 1m.
+                          type: string
+                        fencing:
+                          description: |-
+                            Fencing defines how the lost node has to be confirmed to be fenced before the VMI is failed over.
+                            With OutOfServiceTaint, the default, a fencing agent has to taint the node with node.kubernetes.io/out-of-service.
+                            With None, the VMI is failed over once the timeout passed, which requires the UnfencedFailover feature gate.
+                          enum:
+                          - None
+                          - OutOfServiceTaint
+                          type: string
+                      type: object
                     instancetype:
                       description: InstancetypeMatcher references a instancetype that
                         is used to fill fields in Template
@@ -26249,6 +32215,31 @@ var CRDsValidation map[string]string = map[string]string{
                             captured the first time the instancetype is applied to the VirtualMachineInstance.
                           type: string
                       type: object
+                    powerSchedule:
+                      description: PowerSchedule defines the times the VirtualMachine
+                        is started and stopped by the VM controller
+                      properties:
+                        exceptions:
+                          description: Exceptions are the dates, in the YYYY-MM-DD
+                            format, on which the scheduled starts are skipped, e.g.
+                            holidays
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        start:
+                          description: Start is a cron expression in the standard
+                            five field format of the times the VirtualMachine is started
+                          type: string
+                        stop:
+                          description: Stop is a cron expression in the standard five
+                            field format of the times the VirtualMachine is stopped
+                          type: string
+                        timeZone:
+                          description: TimeZone is the IANA name of the time zone
+                            the schedule is evaluated in. Defaults to UTC.
+                          type: string
+                      type: object
                     preference:
                       description: PreferenceMatcher references a set of preference
                         that is used to fill fields in Template
@@ -27364,6 +33355,34 @@ var CRDsValidation map[string]string = map[string]string{
                                 you are attempting to run. Defaults to the compiled
                                 architecture of the KubeVirt components
                               type: string
+                            crashDump:
+                              description: |-
+                                CrashDump dumps the memory of the guest to a volume when the guest panics, for offline analysis.
+                                Requires a panic device.
+                              properties:
+                                maxDumps:
+                                  description: |-
+                                    MaxDumps is the number of crash dumps kept in the volume, the oldest ones are removed first.
+                                    Defaults to 1.
+                                  format: int32
+                                  type: integer
+                                maxSize:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: MaxSize is the largest guest memory
+                                    which is dumped. The memory of larger guests is
+                                    not dumped.
+                                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                  x-kubernetes-int-or-string: true
+                                volumeName:
+                                  description: |-
+                                    VolumeName is the name of the memory dump volume the memory of the guest is dumped to.
+                                    The volume must not be hotpluggable, and must use the Raw format.
+                                  type: string
+                              required:
+                              - volumeName
+                              type: object
                             dnsConfig:
                               description: |-
                                 Specifies the DNS parameters of a pod.
@@ -27600,6 +33619,65 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: NUMA allows specifying settings
                                         for the guest NUMA topology
                                       properties:
+                                        guestCells:
+                                          description: |-
+                                            GuestCells defines an explicit guest NUMA topology. Each vCPU has to be assigned to exactly one cell,
+                                            and the memory of the cells has to add up to the guest memory.
+                                            Can't be combined with GuestMappingPassthrough.
+                                          items:
+                                            description: NUMAGuestCell defines a NUMA
+                                              cell of the guest.
+                                            properties:
+                                              cpus:
+                                                description: CPUs is the list of the
+                                                  vCPUs of the cell, e.g. "0-3,8".
+                                                type: string
+                                              distances:
+                                                description: |-
+                                                  Distances lists the distances from this cell to the other cells.
+                                                  The distances which are not listed are picked by the hypervisor.
+                                                items:
+                                                  description: NUMAGuestCellDistance
+                                                    defines the distance from a NUMA
+                                                    cell of the guest to another one.
+                                                  properties:
+                                                    cellID:
+                                                      description: CellID is the ID
+                                                        of the other cell.
+                                                      format: int32
+                                                      type: integer
+                                                    value:
+                                                      description: Value is the distance,
+                                                        between 10 and 255. 10 is
+                                                        the distance of a cell to
+                                                        itself.
+                                                      format: int32
+                                                      type: integer
+                                                  required:
+                                                  - cellID
+                                                  - value
+                                                  type: object
+                                                type: array
+                                              id:
+                                                description: ID of the cell. The IDs
+                                                  of the cells have to range from
+                                                  0 to the number of cells minus one.
+                                                format: int32
+                                                type: integer
+                                              memory:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Memory is the amount
+                                                  of guest memory of the cell.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - id
+                                            - cpus
+                                            - memory
+                                            type: object
+                                          type: array
                                         guestMappingPassthrough:
                                           description: |-
                                             GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.
@@ -27664,6 +33742,11 @@ var CRDsValidation map[string]string = map[string]string{
                                         Whether to attach the VSOCK CID to the VM or not.
                                         VSOCK access will be available if set to true. Defaults to false.
                                       type: boolean
+                                    autoattachVirtIODrivers:
+                                      description: |-
+                                        Whether to attach the VirtIO driver ISO as a CD-ROM, to install the drivers in Windows guests.
+                                        Requires the VirtIO drivers autoattach to be enabled in the cluster config. Defaults to false.
+                                      type: boolean
                                     blockMultiQueue:
                                       description: |-
                                         Whether or not to enable virtio multi-queue for block devices.
@@ -27673,6 +33756,29 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: To configure and access client
                                         devices such as redirecting USB
                                       type: object
+                                    consoleSharing:
+                                      description: |-
+                                        ConsoleSharing configures how many clients can be connected to the serial console and to VNC at the same time.
+                                        Defaults to a single exclusive connection, which is disconnected by a new connection.
+                                      properties:
+                                        maxConnections:
+                                          description: |-
+                                            MaxConnections limits the number of concurrent connections of the shared policies to the serial console
+                                            and to VNC each, further connections are refused. Defaults to 4.
+                                          format: int32
+                                          maximum: 16
+                                          minimum: 1
+                                          type: integer
+                                        policy:
+                                          description: Policy defines how the
+                                            connections are shared, Exclusive, SharedReadOnly or SharedFull.
+                                            Defaults to Exclusive.
+                                          enum:
+                                          - Exclusive
+                                          - SharedReadOnly
+                                          - SharedFull
+                                          type: string
+                                      type: object
                                     disableHotplug:
                                       description: DisableHotplug disabled the ability
                                         to hotplug disks.
@@ -27761,7 +33867,7 @@ var CRDsValidation map[string]string = map[string]string{
                                               bus:
                                                 description: |-
                                                   Bus indicates the type of disk device to emulate.
-                                                  supported values: virtio, sata, scsi, usb.
+                                                  supported values: virtio, sata, scsi, usb, nvme.
                                                 type: string
                                               pciAddress:
                                                 description: 'If specified, the virtual
@@ -27783,8 +33889,84 @@ var CRDsValidation map[string]string = map[string]string{
                                           io:
                                             description: |-
                                               IO specifies which QEMU disk IO mode should be used.
-                                              Supported values are: native, default, threads.
+                                              Supported values are: native, default, threads, io_uring.
                                             type: string
+                                          ioLimits:
+                                            description: |-
+                                              IOLimits throttles the IO operations and bandwidth of the disk.
+                                              They can be updated on a running VMI through the iolimits subresource.
+                                            properties:
+                                              burst:
+                                                description: Burst allows the disk
+                                                  to exceed its limits for a short
+                                                  time.
+                                                properties:
+                                                  lengthSeconds:
+                                                    description: |-
+                                                      LengthSeconds is for how long the disk may burst.
+                                                      Defaults to 1.
+                                                    format: int64
+                                                    type: integer
+                                                  readBandwidth:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: ReadBandwidth is
+                                                      the bytes read per second the
+                                                      disk may burst to.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  readIOPS:
+                                                    description: ReadIOPS is the read
+                                                      operations per second the disk
+                                                      may burst to.
+                                                    format: int64
+                                                    type: integer
+                                                  writeBandwidth:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: WriteBandwidth is
+                                                      the bytes written per second
+                                                      the disk may burst to.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  writeIOPS:
+                                                    description: WriteIOPS is the
+                                                      write operations per second
+                                                      the disk may burst to.
+                                                    format: int64
+                                                    type: integer
+                                                type: object
+                                              readBandwidth:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: ReadBandwidth limits
+                                                  the bytes read per second, e.g.
+                                                  100Mi.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              readIOPS:
+                                                description: ReadIOPS limits the read
+                                                  operations per second.
+                                                format: int64
+                                                type: integer
+                                              writeBandwidth:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: WriteBandwidth limits
+                                                  the bytes written per second, e.g.
+                                                  100Mi.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              writeIOPS:
+                                                description: WriteIOPS limits the
+                                                  write operations per second.
+                                                format: int64
+                                                type: integer
+                                            type: object
                                           lun:
                                             description: Attach a volume as a LUN
                                               to the vmi.
@@ -27844,6 +34026,22 @@ var CRDsValidation map[string]string = map[string]string{
                                             type: string
                                           virtiofs:
                                             description: Virtiofs is supported
+                                            properties:
+                                              cache:
+                                                description: |-
+                                                  Cache specifies the caching mode of virtiofsd.
+                                                  Supported values are:
+                                                  auto: metadata and paths are cached with a timeout, data is cached until the file is closed.
+                                                  always: metadata, data and paths are cached indefinitely.
+                                                  never: nothing is cached, changes on the host are seen immediately by the guest.
+                                                  Defaults to auto.
+                                                type: string
+                                              threadPoolSize:
+                                                description: |-
+                                                  ThreadPoolSize is the maximum number of worker threads virtiofsd uses
+                                                  to serve requests. Defaults to the virtiofsd default.
+                                                format: int32
+                                                type: integer
                                             type: object
                                         required:
                                         - name
@@ -27869,6 +34067,12 @@ var CRDsValidation map[string]string = map[string]string{
                                             description: Name of the GPU device as
                                               exposed by a device plugin
                                             type: string
+                                          profile:
+                                            description: |-
+                                              Profile is the name of a mediated device profile of the KubeVirt CR.
+                                              It is resolved on each node to the mediated device type the profile maps to.
+                                              Can't be set together with a different deviceName.
+                                            type: string
                                           requestName:
                                             description: |-
                                               RequestName needs to be provided from resourceClaim.spec.devices.requests[].name where this
@@ -27907,6 +34111,19 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: object
                                       type: array
                                       x-kubernetes-list-type: atomic
+                                    graphics:
+                                      description: Graphics defines the protocol of
+                                        the auto-attached graphics device.
+                                      properties:
+                                        type:
+                                          description: |-
+                                            Type of the graphics device, VNC or SPICE. SPICE additionally allows the client to play the audio
+                                            of the sound device and to redirect USB devices when clientPassthrough is set. Defaults to VNC.
+                                          enum:
+                                          - VNC
+                                          - SPICE
+                                          type: string
+                                      type: object
                                     hostDevices:
                                       description: Whether to attach a host device
                                         to the vmi.
@@ -28109,6 +34326,12 @@ var CRDsValidation map[string]string = map[string]string{
                                               - port
                                               type: object
                                             type: array
+                                          rxQueueSize:
+                                            description: |-
+                                              RxQueueSize sets the size of the receive virtqueue of the interface.
+                                              Must be a power of 2 between 256 and 1024 and is only supported by the virtio model.
+                                            format: int32
+                                            type: integer
                                           slirp:
                                             description: |-
                                               DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -28134,6 +34357,12 @@ var CRDsValidation map[string]string = map[string]string{
                                               will be provided to the guest via config
                                               drive
                                             type: string
+                                          txQueueSize:
+                                            description: |-
+                                              TxQueueSize sets the size of the transmit virtqueue of the interface.
+                                              Must be a power of 2 between 256 and 1024 and is only supported by the virtio model.
+                                            format: int32
+                                            type: integer
                                         required:
                                         - name
                                         type: object
@@ -28227,7 +34456,8 @@ var CRDsValidation map[string]string = map[string]string{
                                           properties:
                                             action:
                                               description: |-
-                                                The action to take. Valid values are poweroff, reset, shutdown.
+                                                The action to take. Valid values are poweroff, reset, shutdown, migrate, snapshot-reset, alert.
+                                                The migrate, snapshot-reset and alert actions are taken by KubeVirt rather than by the hypervisor.
                                                 Defaults to reset.
                                               type: string
                                           type: object
@@ -28236,7 +34466,8 @@ var CRDsValidation map[string]string = map[string]string{
                                           properties:
                                             action:
                                               description: |-
-                                                The action to take. Valid values are poweroff, reset, shutdown.
+                                                The action to take. Valid values are poweroff, reset, shutdown, migrate, snapshot-reset, alert.
+                                                The migrate, snapshot-reset and alert actions are taken by KubeVirt rather than by the hypervisor.
                                                 Defaults to reset.
                                               type: string
                                           type: object
@@ -28654,6 +34885,41 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Memory allow specifying the VMI memory
                                     features.
                                   properties:
+                                    balloon:
+                                      description: |-
+                                        Balloon lets virt-handler reclaim unused guest memory through the memory balloon.
+                                        Fields which are set override the cluster wide memoryBalloon configuration.
+                                      properties:
+                                        max:
+                                          anyOf:
+                                          - type: integer
+                                          - type: string
+                                          description: |-
+                                            Max is the largest amount of memory the balloon gives to the guest.
+                                            Defaults to the guest memory and can not exceed it.
+                                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                          x-kubernetes-int-or-string: true
+                                        min:
+                                          anyOf:
+                                          - type: integer
+                                          - type: string
+                                          description: |-
+                                            Min is the least amount of memory the balloon leaves to the guest.
+                                            Defaults to half of the guest memory.
+                                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                          x-kubernetes-int-or-string: true
+                                        targetFreePercentage:
+                                          description: |-
+                                            TargetFreePercentage is the share of the memory given to the guest which should remain
+                                            available to it. Defaults to 20.
+                                          format: int32
+                                          type: integer
+                                      type: object
+                                    freePageReporting:
+                                      description: |-
+                                        FreePageReporting lets the guest report its free memory pages to the host so that they can be reclaimed.
+                                        Defaults to the cluster wide setting. It is never enabled for VMIs requesting dedicated CPUs, hugepages or realtime.
+                                      type: boolean
                                     guest:
                                       anyOf:
                                       - type: integer
@@ -28674,7 +34940,22 @@ var CRDsValidation map[string]string = map[string]string{
                                             size, for x86_64 architecture valid values
                                             are 1Gi and 2Mi.
                                           type: string
+                                        regularMemory:
+                                          anyOf:
+                                          - type: integer
+                                          - type: string
+                                          description: |-
+                                            RegularMemory is the amount of the guest memory which is backed by regular pages instead of hugepages.
+                                            The rest of the guest memory, including the memory hotplugged at runtime, is backed by hugepages.
+                                            It must be a multiple of the page size and less than the guest memory.
+                                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                          x-kubernetes-int-or-string: true
                                       type: object
+                                    ksmMergePolicy:
+                                      description: |-
+                                        KSMMergePolicy sets how aggressively the kernel samepage merging of the node merges the guest memory.
+                                        Defaults to the mergePolicy of the cluster wide KSM configuration.
+                                      type: string
                                     maxGuest:
                                       anyOf:
                                       - type: integer
@@ -28684,6 +34965,11 @@ var CRDsValidation map[string]string = map[string]string{
                                         The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
                                       pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                                       x-kubernetes-int-or-string: true
+                                    overcommit:
+                                      description: |-
+                                        Overcommit is the memory overcommit class of the VirtualMachineInstance. It controls how much memory
+                                        the pod requests, whether the guest memory can be swapped out and how KSM merges it.
+                                      type: string
                                   type: object
                                 resources:
                                   description: Resources describes the Compute Resources
@@ -28730,11 +35016,99 @@ var CRDsValidation map[string]string = map[string]string{
                                 - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                                 - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                               type: string
+                            hooks:
+                              description: |-
+                                Hooks are sidecar containers called by virt-launcher on the lifecycle points of the VMI,
+                                for example to adjust the domain before it is defined.
+                                Requires the Sidecar feature gate.
+                              items:
+                                description: Hook is a sidecar container called by
+                                  virt-launcher on the lifecycle points of the VMI.
+                                properties:
+                                  args:
+                                    description: Arguments to the entrypoint. The
+                                      image's CMD is used if this is not provided.
+                                    items:
+                                      type: string
+                                    type: array
+                                  command:
+                                    description: Entrypoint of the sidecar container.
+                                      The image's ENTRYPOINT is used if this is not
+                                      provided.
+                                    items:
+                                      type: string
+                                    type: array
+                                  hookPoints:
+                                    description: HookPoints the hook is called on.
+                                      Hook points exposed by the hook but not listed
+                                      here are not called.
+                                    items:
+                                      description: HookPoint is a lifecycle point
+                                        of the VMI a hook can be called on.
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: set
+                                  image:
+                                    description: Image of the sidecar container serving
+                                      the hook.
+                                    type: string
+                                  imagePullPolicy:
+                                    description: |-
+                                      Image pull policy.
+                                      One of Always, Never, IfNotPresent.
+                                      Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+                                    type: string
+                                  name:
+                                    description: Name of the hook, unique within the
+                                      VMI. The sidecar container is named hook-<name>.
+                                    type: string
+                                  version:
+                                    description: |-
+                                      Version of the hook API virt-launcher uses to call the hook.
+                                      Defaults to the newest version exposed by the hook.
+                                    type: string
+                                required:
+                                - hookPoints
+                                - image
+                                - name
+                                type: object
+                              type: array
+                              x-kubernetes-list-map-keys:
+                              - name
+                              x-kubernetes-list-type: map
                             hostname:
                               description: |-
                                 Specifies the hostname of the vmi
                                 If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                               type: string
+                            idlePolicy:
+                              description: IdlePolicy pauses or stops the VMI once
+                                the guest has been idle for a while.
+                              properties:
+                                action:
+                                  description: Action taken once the guest is idle.
+                                    Defaults to Pause.
+                                  enum:
+                                  - Pause
+                                  - Stop
+                                  type: string
+                                cpuThresholdPercentage:
+                                  description: |-
+                                    CPUThresholdPercentage is the usage of the vCPUs of the VMI, in percent, below which the
+                                    guest is idle. Defaults to 5.
+                                  format: int32
+                                  type: integer
+                                ignoreUserSessions:
+                                  description: IgnoreUserSessions considers the guest
+                                    idle even if users are logged in to it.
+                                  type: boolean
+                                timeout:
+                                  description: Timeout is how long the guest has to
+                                    be idle before the action is taken.
+                                  type: string
+                              required:
+                              - timeout
+                              type: object
                             livenessProbe:
                               description: |-
                                 Periodic probe of VirtualMachineInstance liveness.
@@ -29437,6 +35811,49 @@ var CRDsValidation map[string]string = map[string]string{
                                           drive cloud-init userdata as a base64 encoded
                                           string.
                                         type: string
+                                      userDataSources:
+                                        description: |-
+                                          UserDataSources references additional Secrets and ConfigMaps containing config drive userdata.
+                                          Their userdata is merged, in the listed order, after the userdata of this source into a single
+                                          multi-part MIME userdata.
+                                        items:
+                                          description: |-
+                                            CloudInitUserDataSource references a Secret or ConfigMap containing cloud-init userdata
+                                            in its userdata or userData key. Only one of its members may be specified.
+                                          properties:
+                                            configMapRef:
+                                              description: ConfigMapRef references
+                                                a k8s configmap that contains userdata.
+                                              properties:
+                                                name:
+                                                  default: ""
+                                                  description: |-
+                                                    Name of the referent.
+                                                    This field is effectively required, but due to backwards compatibility is
+                                                    allowed to be empty. Instances of this type with an empty value here are
+                                                    almost certainly wrong.
+                                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                  type: string
+                                              type: object
+                                              x-kubernetes-map-type: atomic
+                                            secretRef:
+                                              description: SecretRef references a
+                                                k8s secret that contains userdata.
+                                              properties:
+                                                name:
+                                                  default: ""
+                                                  description: |-
+                                                    Name of the referent.
+                                                    This field is effectively required, but due to backwards compatibility is
+                                                    allowed to be empty. Instances of this type with an empty value here are
+                                                    almost certainly wrong.
+                                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                  type: string
+                                              type: object
+                                              x-kubernetes-map-type: atomic
+                                          type: object
+                                        type: array
+                                        x-kubernetes-list-type: atomic
                                     type: object
                                   cloudInitNoCloud:
                                     description: |-
@@ -29492,6 +35909,49 @@ var CRDsValidation map[string]string = map[string]string{
                                           cloud-init userdata as a base64 encoded
                                           string.
                                         type: string
+                                      userDataSources:
+                                        description: |-
+                                          UserDataSources references additional Secrets and ConfigMaps containing NoCloud userdata.
+                                          Their userdata is merged, in the listed order, after the userdata of this source into a single
+                                          multi-part MIME userdata.
+                                        items:
+                                          description: |-
+                                            CloudInitUserDataSource references a Secret or ConfigMap containing cloud-init userdata
+                                            in its userdata or userData key. Only one of its members may be specified.
+                                          properties:
+                                            configMapRef:
+                                              description: ConfigMapRef references
+                                                a k8s configmap that contains userdata.
+                                              properties:
+                                                name:
+                                                  default: ""
+                                                  description: |-
+                                                    Name of the referent.
+                                                    This field is effectively required, but due to backwards compatibility is
+                                                    allowed to be empty. Instances of this type with an empty value here are
+                                                    almost certainly wrong.
+                                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                  type: string
+                                              type: object
+                                              x-kubernetes-map-type: atomic
+                                            secretRef:
+                                              description: SecretRef references a
+                                                k8s secret that contains userdata.
+                                              properties:
+                                                name:
+                                                  default: ""
+                                                  description: |-
+                                                    Name of the referent.
+                                                    This field is effectively required, but due to backwards compatibility is
+                                                    allowed to be empty. Instances of this type with an empty value here are
+                                                    almost certainly wrong.
+                                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                  type: string
+                                              type: object
+                                              x-kubernetes-map-type: atomic
+                                          type: object
+                                        type: array
+                                        x-kubernetes-list-type: atomic
                                     type: object
                                   configMap:
                                     description: |-
@@ -29723,6 +36183,34 @@ var CRDsValidation map[string]string = map[string]string{
                                     - path
                                     - type
                                     type: object
+                                  ignition:
+                                    description: |-
+                                      Ignition represents an Ignition config for CoreOS-style guests.
+                                      The config is passed to the guest through the QEMU firmware configuration device, no disk is needed for this volume.
+                                      There can only be one volume of this type!
+                                      More info: https://coreos.github.io/ignition/
+                                    properties:
+                                      data:
+                                        description: Data contains the inline Ignition
+                                          config.
+                                        type: string
+                                      secretRef:
+                                        description: SecretRef references a k8s secret
+                                          that contains the Ignition config in its
+                                          userdata or userData key.
+                                        properties:
+                                          name:
+                                            default: ""
+                                            description: |-
+                                              Name of the referent.
+                                              This field is effectively required, but due to backwards compatibility is
+                                              allowed to be empty. Instances of this type with an empty value here are
+                                              almost certainly wrong.
+                                              More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                            type: string
+                                        type: object
+                                        x-kubernetes-map-type: atomic
+                                    type: object
                                   memoryDump:
                                     description: MemoryDump is attached to the virt
                                       launcher and is populated with a memory dump
@@ -29733,6 +36221,17 @@ var CRDsValidation map[string]string = map[string]string{
                                           claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                                           More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                                         type: string
+                                      content:
+                                        description: |-
+                                          Content is the part of the guest memory which is dumped, Full or Kernel.
+                                          Defaults to Full
+                                        type: string
+                                      format:
+                                        description: |-
+                                          Format is the format the memory is dumped in. When the volume is not
+                                          hotpluggable and holds a memory state, the VMI is resumed from it on start.
+                                          Defaults to Raw
+                                        type: string
                                       hotpluggable:
                                         description: Hotpluggable indicates whether
                                           the volume can be hotplugged and hotunplugged.
@@ -29827,6 +36326,11 @@ var CRDsValidation map[string]string = map[string]string{
                                             type: string
                                         type: object
                                         x-kubernetes-map-type: atomic
+                                      namespace:
+                                        description: |-
+                                          Namespace of the referenced ConfigMap. Defaults to the namespace of the VirtualMachineInstance.
+                                          Other namespaces must be listed in the sysprep templateNamespaces of the KubeVirt configuration.
+                                        type: string
                                       secret:
                                         description: Secret references a k8s Secret
                                           that contains Sysprep answer file named
@@ -29844,12 +36348,57 @@ var CRDsValidation map[string]string = map[string]string{
                                             type: string
                                         type: object
                                         x-kubernetes-map-type: atomic
+                                      template:
+                                        description: Template enables rendering the
+                                          answer files as Go templates, with the values
+                                          of the VirtualMachineInstance.
+                                        properties:
+                                          parametersSecretRef:
+                                            description: |-
+                                              ParametersSecretRef references a k8s Secret in the namespace of the VirtualMachineInstance,
+                                              whose keys are exposed to the templates as .Parameters, e.g. a license key.
+                                            properties:
+                                              name:
+                                                default: ""
+                                                description: |-
+                                                  Name of the referent.
+                                                  This field is effectively required, but due to backwards compatibility is
+                                                  allowed to be empty. Instances of this type with an empty value here are
+                                                  almost certainly wrong.
+                                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                type: string
+                                            type: object
+                                            x-kubernetes-map-type: atomic
+                                        type: object
+                                    type: object
+                                  vhostUserBlk:
+                                    description: |-
+                                      VhostUserBlk connects the disk to a vhost-user-blk socket exposed on the node by a storage target like SPDK,
+                                      bypassing the block layer of the host kernel.
+                                    properties:
+                                      resourceName:
+                                        description: |-
+                                          ResourceName is the name of the device plugin resource providing the socket.
+                                          The device plugin passes the path of the allocated socket to the pod through the
+                                          VHOST_USER_BLK_RESOURCE_<resource name> environment variable.
+                                        type: string
+                                      socketName:
+                                        description: SocketName is the name of the
+                                          socket in the vhost-user-blk socket directory
+                                          of the node, /var/run/vhost-user-blk.
+                                        type: string
                                     type: object
                                 required:
                                 - name
                                 type: object
                               maxItems: 256
                               type: array
+                            workloadClass:
+                              description: |-
+                                WorkloadClass is the name of one of the workloadClasses of the KubeVirt configuration.
+                                The VMI gets the priority of the class, and may be preempted by VMIs of a higher
+                                priority if the class allows it.
+                              type: string
                           required:
                           - domain
                           type: object
@@ -29907,6 +36456,64 @@ var CRDsValidation map[string]string = map[string]string{
                         updated through an Update() before ObservedGeneration in Status.
                       format: int64
                       type: integer
+                    failovers:
+                      description: Failovers are the latest failovers of the VM from
+                        lost nodes, the oldest first
+                      items:
+                        description: VirtualMachineFailover represents a failover
+                          of the VMI of a VirtualMachine from a lost node
+                        properties:
+                          fenced:
+                            description: Fenced indicates that a fencing agent confirmed
+                              the node to be fenced
+                            type: boolean
+                          node:
+                            description: Node is the lost node the VMI was failed
+                              over from
+                            type: string
+                          nodeLostTime:
+                            description: NodeLostTime is the time the node was detected
+                              as lost
+                            format: date-time
+                            type: string
+                          time:
+                            description: Time is the time the VMI was failed over
+                            format: date-time
+                            type: string
+                        required:
+                        - node
+                        - nodeLostTime
+                        - time
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    hibernation:
+                      description: |-
+                        Hibernation is set while the memory of the VM is saved to a PVC, from the
+                        hibernate request until the VM is resumed
+                      nullable: true
+                      properties:
+                        claimName:
+                          description: ClaimName is the name of the PVC the memory
+                            of the VM is saved to
+                          type: string
+                        lastTransitionTime:
+                          description: LastTransitionTime is the time the hibernation
+                            entered its phase
+                          format: date-time
+                          nullable: true
+                          type: string
+                        phase:
+                          description: Phase is the phase of the hibernation
+                          type: string
+                        storageClassName:
+                          description: StorageClassName is the storage class of the
+                            PVC
+                          type: string
+                      required:
+                      - phase
+                      - claimName
+                      type: object
                     instancetypeRef:
                       description: InstancetypeRef captures the state of any referenced
                         instance type from the VirtualMachine
@@ -29935,6 +36542,24 @@ var CRDsValidation map[string]string = map[string]string{
                         name:
                           description: Name is the name of resource
                           type: string
+                        pendingUpdate:
+                          description: |-
+                            PendingUpdate lists the changes of the resource not yet captured by the ControllerRevision.
+                            The VirtualMachine is moved to a new ControllerRevision once the update is approved on the resource.
+                          properties:
+                            changedFields:
+                              description: ChangedFields lists the paths of the spec fields differing
+                                between the resource and the ControllerRevision
+                              items:
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            observedGeneration:
+                              description: ObservedGeneration is the generation of the resource the
+                                changes were computed against
+                              format: int64
+                              type: integer
+                          type: object
                       type: object
                     memoryDumpRequest:
                       description: |-
@@ -29946,6 +36571,10 @@ var CRDsValidation map[string]string = map[string]string{
                           description: ClaimName is the name of the pvc that will
                             contain the memory dump
                           type: string
+                        content:
+                          description: Content is the part of the guest memory which
+                            is dumped, defaults to Full
+                          type: string
                         endTimestamp:
                           description: EndTimestamp represents the time the memory
                             dump was completed
@@ -29955,6 +36584,10 @@ var CRDsValidation map[string]string = map[string]string{
                           description: FileName represents the name of the output
                             file
                           type: string
+                        format:
+                          description: Format is the format the memory is dumped in,
+                            defaults to Raw
+                          type: string
                         message:
                           description: Message is a detailed message about failure
                             of the memory dump
@@ -29980,6 +36613,53 @@ var CRDsValidation map[string]string = map[string]string{
                         the vmi when started.
                       format: int64
                       type: integer
+                    powerSchedule:
+                      description: PowerSchedule represents the state of the power
+                        schedule of the VM
+                      nullable: true
+                      properties:
+                        lastAction:
+                          description: LastAction is the last action taken according
+                            to the power schedule
+                          type: string
+                        lastActionTime:
+                          description: LastActionTime is the scheduled time of the
+                            last action
+                          format: date-time
+                          type: string
+                        nextAction:
+                          description: NextAction is the next action of the power
+                            schedule
+                          type: string
+                        nextActionTime:
+                          description: NextActionTime is the scheduled time of the
+                            next action
+                          format: date-time
+                          type: string
+                      type: object
+                    preemption:
+                      description: |-
+                        Preemption is set while the VM is paused or hibernated to make room for a VM
+                        of a higher priority
+                      nullable: true
+                      properties:
+                        action:
+                          description: Action is what was done to the VM, Pause or
+                            Hibernate
+                          type: string
+                        preemptedBy:
+                          description: PreemptedBy is the namespace and name of the
+                            VM which could not be scheduled
+                          type: string
+                        time:
+                          description: Time is when the VM was preempted
+                          format: date-time
+                          nullable: true
+                          type: string
+                      required:
+                      - action
+                      - preemptedBy
+                      type: object
                     preferenceRef:
                       description: PreferenceRef captures the state of any referenced
                         preference from the VirtualMachine
@@ -30008,6 +36688,24 @@ var CRDsValidation map[string]string = map[string]string{
                         name:
                           description: Name is the name of resource
                           type: string
+                        pendingUpdate:
+                          description: |-
+                            PendingUpdate lists the changes of the resource not yet captured by the ControllerRevision.
+                            The VirtualMachine is moved to a new ControllerRevision once the update is approved on the resource.
+                          properties:
+                            changedFields:
+                              description: ChangedFields lists the paths of the spec fields differing
+                                between the resource and the ControllerRevision
+                              items:
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            observedGeneration:
+                              description: ObservedGeneration is the generation of the resource the
+                                changes were computed against
+                              format: int64
+                              type: integer
+                          type: object
                       type: object
                     printableStatus:
                       default: Stopped
@@ -30018,6 +36716,23 @@ var CRDsValidation map[string]string = map[string]string{
                       description: Ready indicates if the virtual machine is running
                         and ready
                       type: boolean
+                    rescue:
+                      description: |-
+                        Rescue is set while the VM is in rescue mode, the VMI boots from the
+                        rescue image with the volumes of the VM attached
+                      nullable: true
+                      properties:
+                        image:
+                          description: Image is the containerDisk image the VMI boots
+                            from in rescue mode
+                          type: string
+                        readOnly:
+                          description: ReadOnly indicates that the disks of the VM
+                            are attached read-only
+                          type: boolean
+                      required:
+                      - image
+                      type: object
                     restoreInProgress:
                       description: RestoreInProgress is the name of the VirtualMachineRestore
                         currently executing
@@ -30031,6 +36746,28 @@ var CRDsValidation map[string]string = map[string]string{
                       description: SnapshotInProgress is the name of the VirtualMachineSnapshot
                         currently executing
                       type: string
+                    stagedChanges:
+                      description: |-
+                        StagedChanges lists the changes of the template which are not applied to the running VMI yet,
+                        when the VM uses the Staged change apply strategy
+                      nullable: true
+                      properties:
+                        applyMethod:
+                          description: ApplyMethod is the method of the pending request
+                            to apply the changes
+                          type: string
+                        fields:
+                          description: Fields are the paths of the changed fields
+                            of the template
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        restartRequired:
+                          description: RestartRequired indicates that the changes
+                            can only be applied by restarting the VM
+                          type: boolean
+                      type: object
                     startFailure:
                       description: |-
                         StartFailure tracks consecutive VMI startup failures for the purposes of
@@ -30086,8 +36823,9 @@ var CRDsValidation map[string]string = map[string]string{
                               within this field specify how to add the volume
                             properties:
                               disk:
-                                description: Disk represents the hotplug disk that
-                                  will be plugged into the running VMI
+                                description: |-
+                                  Disk represents the hotplug disk that will be plugged into the running VMI.
+                                  Either Disk or Filesystem has to be set.
                                 properties:
                                   blockSize:
                                     description: If specified, the virtual disk will
@@ -30167,7 +36905,7 @@ var CRDsValidation map[string]string = map[string]string{
                                       bus:
                                         description: |-
                                           Bus indicates the type of disk device to emulate.
-                                          supported values: virtio, sata, scsi, usb.
+                                          supported values: virtio, sata, scsi, usb, nvme.
                                         type: string
                                       pciAddress:
                                         description: 'If specified, the virtual disk
@@ -30188,21 +36926,91 @@ var CRDsValidation map[string]string = map[string]string{
                                   io:
                                     description: |-
                                       IO specifies which QEMU disk IO mode should be used.
-                                      Supported values are: native, default, threads.
+                                      Supported values are: native, default, threads, io_uring.
                                     type: string
-                                  lun:
-                                    description: Attach a volume as a LUN to the vmi.
+                                  ioLimits:
+                                    description: |-
+                                      IOLimits throttles the IO operations and bandwidth of the disk.
+                                      They can be updated on a running VMI through the iolimits subresource.
                                     properties:
-                                      bus:
-                                        description: |-
-                                          Bus indicates the type of disk device to emulate.
-                                          supported values: virtio, sata, scsi.
-                                        type: string
-                                      readonly:
-                                        description: |-
-                                          ReadOnly.
-                                          Defaults to false.
-                                        type: boolean
+                                      burst:
+                                        description: Burst allows the disk to exceed
+                                          its limits for a short time.
+                                        properties:
+                                          lengthSeconds:
+                                            description: |-
+                                              LengthSeconds is for how long the disk may burst.
+                                              Defaults to 1.
+                                            format: int64
+                                            type: integer
+                                          readBandwidth:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: ReadBandwidth is the bytes
+                                              read per second the disk may burst to.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          readIOPS:
+                                            description: ReadIOPS is the read operations
+                                              per second the disk may burst to.
+                                            format: int64
+                                            type: integer
+                                          writeBandwidth:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: WriteBandwidth is the bytes
+                                              written per second the disk may burst
+                                              to.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          writeIOPS:
+                                            description: WriteIOPS is the write operations
+                                              per second the disk may burst to.
+                                            format: int64
+                                            type: integer
+                                        type: object
+                                      readBandwidth:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: ReadBandwidth limits the bytes
+                                          read per second, e.g. 100Mi.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      readIOPS:
+                                        description: ReadIOPS limits the read operations
+                                          per second.
+                                        format: int64
+                                        type: integer
+                                      writeBandwidth:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: WriteBandwidth limits the bytes
+                                          written per second, e.g. 100Mi.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      writeIOPS:
+                                        description: WriteIOPS limits the write operations
+                                          per second.
+                                        format: int64
+                                        type: integer
+                                    type: object
+                                  lun:
+                                    description: Attach a volume as a LUN to the vmi.
+                                    properties:
+                                      bus:
+                                        description: |-
+                                          Bus indicates the type of disk device to emulate.
+                                          supported values: virtio, sata, scsi.
+                                        type: string
+                                      readonly:
+                                        description: |-
+                                          ReadOnly.
+                                          Defaults to false.
+                                        type: boolean
                                       reservation:
                                         description: Reservation indicates if the
                                           disk needs to support the persistent reservation
@@ -30239,6 +37047,37 @@ var CRDsValidation map[string]string = map[string]string{
                                   type: string
                                 type: array
                                 x-kubernetes-list-type: atomic
+                              filesystem:
+                                description: |-
+                                  Filesystem represents the virtiofs filesystem that will be plugged into
+                                  the running VMI. Either Disk or Filesystem has to be set.
+                                properties:
+                                  name:
+                                    description: Name is the device name
+                                    type: string
+                                  virtiofs:
+                                    description: Virtiofs is supported
+                                    properties:
+                                      cache:
+                                        description: |-
+                                          Cache specifies the caching mode of virtiofsd.
+                                          Supported values are:
+                                          auto: metadata and paths are cached with a timeout, data is cached until the file is closed.
+                                          always: metadata, data and paths are cached indefinitely.
+                                          never: nothing is cached, changes on the host are seen immediately by the guest.
+                                          Defaults to auto.
+                                        type: string
+                                      threadPoolSize:
+                                        description: |-
+                                          ThreadPoolSize is the maximum number of worker threads virtiofsd uses
+                                          to serve requests. Defaults to the virtiofsd default.
+                                        format: int32
+                                        type: integer
+                                    type: object
+                                required:
+                                - name
+                                - virtiofs
+                                type: object
                               name:
                                 description: |-
                                   Name represents the name that will be used to map the
@@ -30290,7 +37129,6 @@ var CRDsValidation map[string]string = map[string]string{
                                     type: object
                                 type: object
                             required:
-                            - disk
                             - name
                             - volumeSource
                             type: object
@@ -30481,6 +37319,26 @@ var CRDsValidation map[string]string = map[string]string{
           items:
             description: VolumeBackup contains the data neeed to restore a PVC
             properties:
+              hotplug:
+                description: Hotplug is set when the volume was hotplugged to the
+                  VirtualMachine
+                properties:
+                  bus:
+                    description: Bus is the bus of the disk the volume was attached
+                      with
+                    type: string
+                  origin:
+                    description: Origin is the kind of volume source the volume was
+                      hotplugged from
+                    type: string
+                  persisted:
+                    description: |-
+                      Persisted is false when the volume was only hotplugged to the running
+                      VirtualMachineInstance and was not part of the VirtualMachine spec
+                    type: boolean
+                required:
+                - origin
+                type: object
               persistentVolumeClaim:
                 properties:
                   metadata:
@@ -30719,6 +37577,26 @@ var CRDsValidation map[string]string = map[string]string{
               format: date-time
               type: string
           type: object
+        hookResults:
+          items:
+            description: FreezeHookResult is the outcome of a single freeze hook
+            properties:
+              message:
+                type: string
+              name:
+                type: string
+              succeeded:
+                type: boolean
+              type:
+                description: FreezeHookType defines when a freeze hook is run
+                type: string
+            required:
+            - name
+            - succeeded
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         readyToUse:
           type: boolean
         volumeSnapshotStatus:
@@ -30751,5 +37629,785 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinesnapshotexport": `openAPIV3Schema:
+  description: |-
+    VirtualMachineSnapshotExport defines the operation of archiving the volumes of a
+    VirtualMachineSnapshot to an object storage
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotExportSpec is the spec for a VirtualMachineSnapshotExport
+        resource
+      properties:
+        destination:
+          description: Destination is the object storage the volumes are archived
+            to
+          properties:
+            bucket:
+              description: Bucket is the bucket, or the container for AzureBlob, the
+                volumes are written to
+              type: string
+            credentialsSecretRef:
+              description: |-
+                CredentialsSecretRef is the name of the secret holding the credentials of the object storage.
+                S3 and GCS use the accessKeyId and secretAccessKey keys, GCS expecting HMAC keys, while
+                AzureBlob uses a shared access signature in the sasToken key
+              type: string
+            endpoint:
+              description: |-
+                Endpoint is the URL of the object storage service, for example https://s3.us-east-1.amazonaws.com,
+                https://storage.googleapis.com or https://myaccount.blob.core.windows.net
+              type: string
+            prefix:
+              description: Prefix is prepended to the names of the archived objects
+              type: string
+            provider:
+              description: Provider is the kind of object storage
+              type: string
+            region:
+              description: Region is the region S3 and GCS requests are signed for,
+                us-east-1 for S3 and auto for GCS when unset
+              type: string
+          required:
+          - bucket
+          - credentialsSecretRef
+          - endpoint
+          - provider
+          type: object
+        virtualMachineSnapshotName:
+          description: VirtualMachineSnapshotName is the name of the VirtualMachineSnapshot
+            whose volumes are archived
+          type: string
+      required:
+      - destination
+      - virtualMachineSnapshotName
+      type: object
+    status:
+      description: VirtualMachineSnapshotExportStatus is the status for a VirtualMachineSnapshotExport
+        resource
+      properties:
+        completionTime:
+          description: CompletionTime is the time the volumes were archived
+          format: date-time
+          type: string
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        phase:
+          description: VirtualMachineSnapshotExportPhase is the current phase of the
+            VirtualMachineSnapshotExport
+          type: string
+        virtualMachineName:
+          description: VirtualMachineName is the name of the virtual machine the snapshot
+            was taken of
+          type: string
+        volumes:
+          description: Volumes lists the archived volumes
+          items:
+            description: ArchivedVolume describes a volume written to the object storage
+            properties:
+              checksum:
+                description: Checksum is the hex encoded SHA-256 checksum of the object
+                  content
+                type: string
+              name:
+                description: Name is the name of the volume in the snapshotted virtual
+                  machine, or of the exported volume
+                type: string
+              objectName:
+                description: ObjectName is the name of the object holding the volume
+                  data
+                type: string
+              size:
+                description: Size is the number of bytes written
+                format: int64
+                type: integer
+            required:
+            - checksum
+            - name
+            - objectName
+            - size
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - name
+          x-kubernetes-list-type: map
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinesnapshotgroup": `openAPIV3Schema:
+  description: VirtualMachineSnapshotGroup defines the snapshotting of a set of VMs
+    at the same point in time
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup
+        resource
+      properties:
+        deletionPolicy:
+          description: DeletionPolicy is passed on to the VirtualMachineSnapshots
+            of the members
+          type: string
+        failureDeadline:
+          description: |-
+            This time represents the number of seconds we permit the group snapshot
+            to take. In case we pass this deadline we mark it as failed.
+          type: string
+        selector:
+          description: Selector selects the VMs of the namespace which are snapshotted
+            together
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
+      required:
+      - selector
+      type: object
+    status:
+      description: VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup
+        resource
+      properties:
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        creationTime:
+          description: CreationTime is the time the volumes of the last member were
+            snapshotted
+          format: date-time
+          nullable: true
+          type: string
+        error:
+          description: Error is the last error encountered during the snapshot/restore
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        members:
+          description: Members are the VirtualMachineSnapshots taken of the selected
+            VMs
+          items:
+            description: VirtualMachineSnapshotGroupMember is the VirtualMachineSnapshot
+              of a VM of the group
+            properties:
+              virtualMachineName:
+                type: string
+              virtualMachineSnapshotName:
+                type: string
+            required:
+            - virtualMachineName
+            - virtualMachineSnapshotName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        phase:
+          description: VirtualMachineSnapshotPhase is the current phase of the VirtualMachineSnapshot
+          type: string
+        readyToUse:
+          type: boolean
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinesnapshotgrouprestore": `openAPIV3Schema:
+  description: VirtualMachineSnapshotGroupRestore defines the restore of all the members
+    of a VirtualMachineSnapshotGroup
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotGroupRestoreSpec is the spec for a VirtualMachineSnapshotGroupRestore
+        resource
+      properties:
+        virtualMachineSnapshotGroupName:
+          type: string
+      required:
+      - virtualMachineSnapshotGroupName
+      type: object
+    status:
+      description: VirtualMachineSnapshotGroupRestoreStatus is the status for a VirtualMachineSnapshotGroupRestore
+        resource
+      properties:
+        complete:
+          type: boolean
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        members:
+          description: Members are the VirtualMachineRestores of the members of the
+            group
+          items:
+            description: VirtualMachineSnapshotGroupRestoreMember is the VirtualMachineRestore
+              of a VM of the group
+            properties:
+              virtualMachineName:
+                type: string
+              virtualMachineRestoreName:
+                type: string
+            required:
+            - virtualMachineName
+            - virtualMachineRestoreName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        restoreTime:
+          description: RestoreTime is the time the last member was restored
+          format: date-time
+          nullable: true
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinesnapshotreplication": `openAPIV3Schema:
+  description: |-
+    VirtualMachineSnapshotReplication defines the operation of replicating a
+    VirtualMachineSnapshot to a peer cluster
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotReplicationSpec is the spec for a VirtualMachineSnapshotReplication
+        resource
+      properties:
+        destination:
+          description: Destination is the peer cluster the snapshot is replicated
+            to
+          properties:
+            kubeconfigSecretRef:
+              description: |-
+                KubeconfigSecretRef is the name of the secret holding, in its kubeconfig key,
+                the kubeconfig used to access the peer cluster
+              type: string
+            namespace:
+              description: |-
+                Namespace is the namespace of the peer cluster the virtual machine is created in,
+                the namespace of the replication when unset
+              type: string
+            storageClassName:
+              description: |-
+                StorageClassName is the storage class of the volumes created in the peer cluster,
+                the default storage class of the peer cluster when unset
+              type: string
+            virtualMachineName:
+              description: |-
+                VirtualMachineName is the name of the virtual machine created in the peer cluster,
+                the name of the snapshotted virtual machine when unset
+              type: string
+          required:
+          - kubeconfigSecretRef
+          type: object
+        virtualMachineSnapshotName:
+          description: VirtualMachineSnapshotName is the name of the VirtualMachineSnapshot
+            to replicate
+          type: string
+      required:
+      - destination
+      - virtualMachineSnapshotName
+      type: object
+    status:
+      description: VirtualMachineSnapshotReplicationStatus is the status for a VirtualMachineSnapshotReplication
+        resource
+      properties:
+        completionTime:
+          description: CompletionTime is the time the snapshot was replicated
+          format: date-time
+          type: string
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        phase:
+          description: VirtualMachineSnapshotReplicationPhase is the current phase
+            of the VirtualMachineSnapshotReplication
+          type: string
+        virtualMachineExportName:
+          description: VirtualMachineExportName is the name of the VirtualMachineExport
+            serving the volumes to the peer cluster
+          type: string
+        virtualMachineName:
+          description: VirtualMachineName is the name of the virtual machine the snapshot
+            was taken of
+          type: string
+        volumes:
+          description: Volumes lists the volumes imported by the peer cluster
+          items:
+            description: ReplicatedVolume describes a volume imported by the peer
+              cluster
+            properties:
+              dataVolumeName:
+                description: DataVolumeName is the name of the DataVolume importing
+                  the volume in the peer cluster
+                type: string
+              name:
+                description: Name is the name of the volume in the snapshotted virtual
+                  machine
+                type: string
+              progress:
+                description: Progress is the import progress reported by the DataVolume
+                type: string
+            required:
+            - dataVolumeName
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - name
+          x-kubernetes-list-type: map
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinesnapshotschedule": `openAPIV3Schema:
+  description: VirtualMachineSnapshotSchedule defines the periodic snapshotting of
+    a VM
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule
+        resource
+      properties:
+        disabled:
+          description: Disabled stops the creation of new snapshots without removing
+            the schedule
+          type: boolean
+        failureDeadline:
+          description: FailureDeadline is passed on to the created VirtualMachineSnapshots
+          type: string
+        retention:
+          description: Retention defines which of the snapshots created by the schedule
+            are kept
+          properties:
+            maxAge:
+              description: MaxAge is the time after its creation a snapshot is deleted
+              type: string
+            maxCount:
+              description: MaxCount is the number of most recent snapshots to keep
+              format: int32
+              type: integer
+          type: object
+        schedule:
+          description: |-
+            Schedule is a cron expression in the standard five field format
+            defining when snapshots are taken
+          type: string
+        source:
+          description: |-
+            TypedLocalObjectReference contains enough information to let you locate the
+            typed referenced object inside the same namespace.
+          properties:
+            apiGroup:
+              description: |-
+                APIGroup is the group for the resource being referenced.
+                If APIGroup is not specified, the specified Kind must be in the core API group.
+                For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+          x-kubernetes-map-type: atomic
+      required:
+      - schedule
+      - source
+      type: object
+    status:
+      description: VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule
+        resource
+      properties:
+        error:
+          description: Error is the last error encountered during the snapshot/restore
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        lastScheduleTime:
+          format: date-time
+          nullable: true
+          type: string
+        lastSnapshotName:
+          type: string
+        nextScheduleTime:
+          format: date-time
+          nullable: true
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"volumemigration": `openAPIV3Schema:
+  description: |-
+    VolumeMigration moves the volumes of a running virtual machine to another storage class,
+    without stopping the virtual machine
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VolumeMigrationSpec is the spec for a VolumeMigration resource
+      properties:
+        storageClassName:
+          description: StorageClassName is the storage class of the PersistentVolumeClaims
+            the volumes are migrated to
+          type: string
+        virtualMachineName:
+          description: VirtualMachineName is the name of the virtual machine whose
+            volumes are migrated
+          type: string
+        volumes:
+          description: |-
+            Volumes are the names of the volumes to migrate. All the volumes backed by a PersistentVolumeClaim
+            or a DataVolume are migrated when empty.
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: set
+      required:
+      - storageClassName
+      - virtualMachineName
+      type: object
+    status:
+      description: VolumeMigrationStatus is the status for a VolumeMigration resource
+      properties:
+        completionTime:
+          description: CompletionTime is the time the migration succeeded or failed
+          format: date-time
+          type: string
+        conditions:
+          items:
+            description: Condition contains details for one aspect of the current
+              state of this API Resource.
+            properties:
+              lastTransitionTime:
+                description: |-
+                  lastTransitionTime is the last time the condition transitioned from one status to another.
+                  This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+                format: date-time
+                type: string
+              message:
+                description: |-
+                  message is a human readable message indicating details about the transition.
+                  This may be an empty string.
+                maxLength: 32768
+                type: string
+              observedGeneration:
+                description: |-
+                  observedGeneration represents the .metadata.generation that the condition was set based upon.
+                  For instance, if .metadata.generation is currently 12, but the .status.conditions[x].observedGeneration is 9, the condition is out of date
+                  with respect to the current state of the instance.
+                format: int64
+                minimum: 0
+                type: integer
+              reason:
+                description: |-
+                  reason contains a programmatic identifier indicating the reason for the condition's last transition.
+                  Producers of specific condition types may define expected values and meanings for this field,
+                  and whether the values are considered a guaranteed API.
+                  The value should be a CamelCase string.
+                  This field may not be empty.
+                maxLength: 1024
+                minLength: 1
+                pattern: ^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$
+                type: string
+              status:
+                description: status of the condition, one of True, False, Unknown.
+                enum:
+                - "True"
+                - "False"
+                - Unknown
+                type: string
+              type:
+                description: type of condition in CamelCase or in foo.example.com/CamelCase.
+                maxLength: 316
+                pattern: ^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$
+                type: string
+            required:
+            - lastTransitionTime
+            - message
+            - reason
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - type
+          x-kubernetes-list-type: map
+        phase:
+          description: VolumeMigrationPhase is the current phase of a VolumeMigration
+          type: string
+        volumes:
+          description: Volumes lists the migrated volumes
+          items:
+            description: MigratedVolume describes the PersistentVolumeClaims a volume
+              is migrated between
+            properties:
+              destinationClaimName:
+                description: DestinationClaimName is the name of the PersistentVolumeClaim
+                  the volume is migrated to
+                type: string
+              name:
+                description: Name is the name of the volume in the virtual machine
+                type: string
+              sourceClaimName:
+                description: SourceClaimName is the name of the PersistentVolumeClaim
+                  the volume is migrated from
+                type: string
+            required:
+            - destinationClaimName
+            - name
+            - sourceClaimName
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - name
+          x-kubernetes-list-type: map
+      type: object
+  required:
+  - spec
+  type: object
 `,
 }
@@ -20,13 +20,16 @@ import (
 
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 
+	autoscalingv1alpha1 "kubevirt.io/api/autoscaling/v1alpha1"
 	virtv1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
 	instancetypev1alpha1 "kubevirt.io/api/instancetype/v1alpha1"
 	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	quotav1alpha1 "kubevirt.io/api/quota/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	importv1 "kubevirt.io/api/vmimport/v1beta1"
 )
 
 var sideEffectNone = admissionregistrationv1.SideEffectClassNone
@@ -297,19 +300,31 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 	vmPath := VMValidatePath
 	vmirsPath := VMIRSValidatePath
 	vmpoolPath := VMPoolValidatePath
+	vmAutoscalingPolicyPath := VMAutoscalingPolicyValidatePath
+	vmResourceQuotaPath := VMResourceQuotaValidatePath
+	vmDisruptionBudgetPath := VMDisruptionBudgetValidatePath
 	vmipresetPath := VMIPresetValidatePath
 	migrationCreatePath := MigrationCreateValidatePath
 	migrationUpdatePath := MigrationUpdateValidatePath
 	vmSnapshotValidatePath := VMSnapshotValidatePath
 	vmRestoreValidatePath := VMRestoreValidatePath
+	vmSnapshotScheduleValidatePath := VMSnapshotScheduleValidatePath
+	vmSnapshotGroupValidatePath := VMSnapshotGroupValidatePath
+	vmSnapshotGroupRestoreValidatePath := VMSnapshotGroupRestoreValidatePath
 	vmExportValidatePath := VMExportValidatePath
+	vmSnapshotExportValidatePath := VMSnapshotExportValidatePath
+	vmSnapshotReplicationValidatePath := VMSnapshotReplicationValidatePath
+	vmImportValidatePath := VMImportValidatePath
 	VmInstancetypeValidatePath := VMInstancetypeValidatePath
 	VmClusterInstancetypeValidatePath := VMClusterInstancetypeValidatePath
 	vmPreferenceValidatePath := VMPreferenceValidatePath
 	vmClusterPreferenceValidatePath := VMClusterPreferenceValidatePath
+	vmDefaultValidatePath := VMDefaultValidatePath
+	vmClusterDefaultValidatePath := VMClusterDefaultValidatePath
 	launcherEvictionValidatePath := LauncherEvictionValidatePath
 	statusValidatePath := StatusValidatePath
 	migrationPolicyCreateValidatePath := MigrationPolicyCreateValidatePath
+	volumeMigrationValidatePath := VolumeMigrationValidatePath
 	vmCloneCreateValidatePath := VMCloneCreateValidatePath
 	failurePolicy := admissionregistrationv1.Fail
 
@@ -414,7 +429,7 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 				AdmissionReviewVersions: []string{"v1", "v1beta1"},
 				FailurePolicy:           &failurePolicy,
 				TimeoutSeconds:          &defaultTimeoutSeconds,
-				SideEffects:             &sideEffectNone,
+				SideEffects:             &sideEffectNoneOnDryRun,
 				Rules: []admissionregistrationv1.RuleWithOperations{{
 					Operations: []admissionregistrationv1.OperationType{
 						admissionregistrationv1.Create,
@@ -484,6 +499,81 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 					},
 				},
 			},
+			{
+				Name:                    "virtualmachineautoscalingpolicy-validator.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{autoscalingv1alpha1.SchemeGroupVersion.Group},
+						APIVersions: []string{autoscalingv1alpha1.SchemeGroupVersion.Version},
+						Resources:   []string{"virtualmachineautoscalingpolicies"},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmAutoscalingPolicyPath,
+					},
+				},
+			},
+			{
+				Name:                    "virtualmachineresourcequota-validator.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{quotav1alpha1.SchemeGroupVersion.Group},
+						APIVersions: []string{quotav1alpha1.SchemeGroupVersion.Version},
+						Resources:   []string{"virtualmachineresourcequotas"},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmResourceQuotaPath,
+					},
+				},
+			},
+			{
+				Name:                    "virtualmachinedisruptionbudget-validator.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{migrationsv1.SchemeGroupVersion.Group},
+						APIVersions: []string{migrationsv1.SchemeGroupVersion.Version},
+						Resources:   []string{migrations.ResourceVMDisruptionBudgets},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmDisruptionBudgetPath,
+					},
+				},
+			},
 			{
 				Name:                    "virtualmachinepreset-validator.kubevirt.io",
 				AdmissionReviewVersions: []string{"v1", "v1beta1"},
@@ -607,6 +697,81 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 					},
 				},
 			},
+			{
+				Name:                    "virtualmachinesnapshotschedule-validator.snapshot.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				SideEffects:             &sideEffectNone,
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{snapshotv1.SchemeGroupVersion.Group},
+						APIVersions: []string{snapshotv1.SchemeGroupVersion.Version},
+						Resources:   []string{"virtualmachinesnapshotschedules"},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmSnapshotScheduleValidatePath,
+					},
+				},
+			},
+			{
+				Name:                    "virtualmachinesnapshotgroup-validator.snapshot.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				SideEffects:             &sideEffectNone,
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{snapshotv1.SchemeGroupVersion.Group},
+						APIVersions: []string{snapshotv1.SchemeGroupVersion.Version},
+						Resources:   []string{"virtualmachinesnapshotgroups"},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmSnapshotGroupValidatePath,
+					},
+				},
+			},
+			{
+				Name:                    "virtualmachinesnapshotgrouprestore-validator.snapshot.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				SideEffects:             &sideEffectNone,
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{snapshotv1.SchemeGroupVersion.Group},
+						APIVersions: []string{snapshotv1.SchemeGroupVersion.Version},
+						Resources:   []string{"virtualmachinesnapshotgrouprestores"},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmSnapshotGroupRestoreValidatePath,
+					},
+				},
+			},
 			{
 				Name:                    "virtualmachineexport-validator.export.kubevirt.io",
 				AdmissionReviewVersions: []string{"v1", "v1beta1"},
@@ -632,6 +797,81 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 					},
 				},
 			},
+			{
+				Name:                    "virtualmachinesnapshotexport-validator.export.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{exportv1.SchemeGroupVersion.Group},
+						APIVersions: []string{exportv1.SchemeGroupVersion.Version},
+						Resources:   []string{"virtualmachinesnapshotexports"},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmSnapshotExportValidatePath,
+					},
+				},
+			},
+			{
+				Name:                    "virtualmachinesnapshotreplication-validator.export.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{exportv1.SchemeGroupVersion.Group},
+						APIVersions: []string{exportv1.SchemeGroupVersion.Version},
+						Resources:   []string{"virtualmachinesnapshotreplications"},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmSnapshotReplicationValidatePath,
+					},
+				},
+			},
+			{
+				Name:                    "virtualmachineimport-validator.import.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{importv1.SchemeGroupVersion.Group},
+						APIVersions: []string{importv1.SchemeGroupVersion.Version},
+						Resources:   []string{"virtualmachineimports"},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmImportValidatePath,
+					},
+				},
+			},
 			{
 				Name:                    "virtualmachineinstancetype-validator.instancetype.kubevirt.io",
 				AdmissionReviewVersions: []string{"v1", "v1beta1"},
@@ -748,6 +988,56 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 					},
 				},
 			},
+			{
+				Name:                    "virtualmachinedefault-validator.instancetype.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{instancetypev1beta1.SchemeGroupVersion.Group},
+						APIVersions: []string{instancetypev1beta1.SchemeGroupVersion.Version},
+						Resources:   []string{instancetype.PluralDefaultResourceName},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmDefaultValidatePath,
+					},
+				},
+			},
+			{
+				Name:                    "virtualmachineclusterdefault-validator.instancetype.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{instancetypev1beta1.SchemeGroupVersion.Group},
+						APIVersions: []string{instancetypev1beta1.SchemeGroupVersion.Version},
+						Resources:   []string{instancetype.ClusterPluralDefaultResourceName},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmClusterDefaultValidatePath,
+					},
+				},
+			},
 			{
 				Name:                    "kubevirt-crd-status-validator.kubevirt.io",
 				AdmissionReviewVersions: []string{"v1", "v1beta1"},
@@ -802,6 +1092,31 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 					},
 				},
 			},
+			{
+				Name:                    "volumemigration-validator.migrations.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1", "v1beta1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{migrationsv1.SchemeGroupVersion.Group},
+						APIVersions: []string{migrationsv1.SchemeGroupVersion.Version},
+						Resources:   []string{migrations.ResourceVolumeMigrations},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &volumeMigrationValidatePath,
+					},
+				},
+			},
 			{
 				Name:                    "vm-clone-validator.kubevirt.io",
 				AdmissionReviewVersions: []string{"v1", "v1beta1"},
@@ -845,6 +1160,12 @@ const VMIRSValidatePath = "/virtualmachinereplicaset-validate"
 
 const VMPoolValidatePath = "/virtualmachinepool-validate"
 
+const VMAutoscalingPolicyValidatePath = "/virtualmachineautoscalingpolicies-validate"
+
+const VMResourceQuotaValidatePath = "/virtualmachineresourcequotas-validate"
+
+const VMDisruptionBudgetValidatePath = "/virtualmachinedisruptionbudgets-validate"
+
 const VMIPresetValidatePath = "/vmipreset-validate"
 
 const MigrationCreateValidatePath = "/migration-validate-create"
@@ -881,8 +1202,20 @@ const VMSnapshotValidatePath = "/virtualmachinesnapshots-validate"
 
 const VMRestoreValidatePath = "/virtualmachinerestores-validate"
 
+const VMSnapshotScheduleValidatePath = "/virtualmachinesnapshotschedules-validate"
+
+const VMSnapshotGroupValidatePath = "/virtualmachinesnapshotgroups-validate"
+
+const VMSnapshotGroupRestoreValidatePath = "/virtualmachinesnapshotgrouprestores-validate"
+
 const VMExportValidatePath = "/virtualmachineexports-validate"
 
+const VMSnapshotExportValidatePath = "/virtualmachinesnapshotexports-validate"
+
+const VMSnapshotReplicationValidatePath = "/virtualmachinesnapshotreplications-validate"
+
+const VMImportValidatePath = "/virtualmachineimports-validate"
+
 const VMInstancetypeValidatePath = "/virtualmachineinstancetypes-validate"
 
 const VMClusterInstancetypeValidatePath = "/virtualmachineclusterinstancetypes-validate"
@@ -891,12 +1224,18 @@ const VMPreferenceValidatePath = "/virtualmachinepreferences-validate"
 
 const VMClusterPreferenceValidatePath = "/virtualmachineclusterpreferences-validate"
 
+const VMDefaultValidatePath = "/virtualmachinedefaults-validate"
+
+const VMClusterDefaultValidatePath = "/virtualmachineclusterdefaults-validate"
+
 const StatusValidatePath = "/status-validate"
 
 const LauncherEvictionValidatePath = "/launcher-eviction-validate"
 
 const MigrationPolicyCreateValidatePath = "/migration-policy-validate-create"
 
+const VolumeMigrationValidatePath = "/volumemigrations-validate"
+
 const VMCloneCreateValidatePath = "/vm-clone-validate-create"
 
 const VMCloneCreateMutatePath = "/vm-clone-mutate-create"
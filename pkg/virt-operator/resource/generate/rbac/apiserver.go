@@ -113,6 +113,17 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"list",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"nodes",
+				},
+				Verbs: []string{
+					"list",
+				},
+			},
 			{
 				APIGroups: []string{
 					GroupName,
@@ -207,6 +218,17 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"snapshot.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachinerestores",
+				},
+				Verbs: []string{
+					"create",
+				},
+			},
 			{
 				APIGroups: []string{
 					"cdi.kubevirt.io",
@@ -241,6 +263,8 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					instancetype.ClusterPluralResourceName,
 					instancetype.PluralPreferenceResourceName,
 					instancetype.ClusterPluralPreferenceResourceName,
+					instancetype.PluralDefaultResourceName,
+					instancetype.ClusterPluralDefaultResourceName,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -257,6 +281,28 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"quota.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineresourcequotas",
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"quota.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineresourcequotas/status",
+				},
+				Verbs: []string{
+					"update",
+				},
+			},
 			{
 				APIGroups: []string{
 					"apps",
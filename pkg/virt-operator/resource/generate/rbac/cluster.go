@@ -23,10 +23,13 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"kubevirt.io/api/autoscaling"
 	"kubevirt.io/api/clone"
 	"kubevirt.io/api/export"
 	"kubevirt.io/api/pool"
+	"kubevirt.io/api/quota"
 	"kubevirt.io/api/snapshot"
+	"kubevirt.io/api/vmimport"
 
 	"kubevirt.io/api/instancetype"
 
@@ -39,21 +42,29 @@ const (
 	defaultClusterRoleName          = "kubevirt.io:default"
 	instancetypeViewClusterRoleName = "instancetype.kubevirt.io:view"
 
-	apiVersion            = "version"
-	apiGuestFs            = "guestfs"
-	apiExpandVmSpec       = "expand-vm-spec"
-	apiKubevirts          = "kubevirts"
-	apiVM                 = "virtualmachines"
-	apiVMInstances        = "virtualmachineinstances"
-	apiVMIPresets         = "virtualmachineinstancepresets"
-	apiVMIReplicasets     = "virtualmachineinstancereplicasets"
-	apiVMIMigrations      = "virtualmachineinstancemigrations"
-	apiVMSnapshots        = "virtualmachinesnapshots"
-	apiVMSnapshotContents = "virtualmachinesnapshotcontents"
-	apiVMRestores         = "virtualmachinerestores"
-	apiVMExports          = "virtualmachineexports"
-	apiVMClones           = "virtualmachineclones"
-	apiVMPools            = "virtualmachinepools"
+	apiVersion                 = "version"
+	apiGuestFs                 = "guestfs"
+	apiExpandVmSpec            = "expand-vm-spec"
+	apiKubevirts               = "kubevirts"
+	apiVM                      = "virtualmachines"
+	apiVMInstances             = "virtualmachineinstances"
+	apiVMIPresets              = "virtualmachineinstancepresets"
+	apiVMIReplicasets          = "virtualmachineinstancereplicasets"
+	apiVMIMigrations           = "virtualmachineinstancemigrations"
+	apiVMSnapshots             = "virtualmachinesnapshots"
+	apiVMSnapshotContents      = "virtualmachinesnapshotcontents"
+	apiVMRestores              = "virtualmachinerestores"
+	apiVMSnapshotSchedules     = "virtualmachinesnapshotschedules"
+	apiVMSnapshotGroups        = "virtualmachinesnapshotgroups"
+	apiVMSnapshotGroupRestores = "virtualmachinesnapshotgrouprestores"
+	apiVMExports               = "virtualmachineexports"
+	apiVMSnapshotExports       = "virtualmachinesnapshotexports"
+	apiVMSnapshotReplications  = "virtualmachinesnapshotreplications"
+	apiVMImports               = "virtualmachineimports"
+	apiVMClones                = "virtualmachineclones"
+	apiVMPools                 = "virtualmachinepools"
+	apiVMAutoscalingPolicies   = "virtualmachineautoscalingpolicies"
+	apiVMResourceQuotas        = "virtualmachineresourcequotas"
 
 	apiVMExpandSpec   = "virtualmachines/expand-spec"
 	apiVMPortForward  = "virtualmachines/portforward"
@@ -63,17 +74,28 @@ const (
 	apiVMAddVolume    = "virtualmachines/addvolume"
 	apiVMRemoveVolume = "virtualmachines/removevolume"
 	apiVMMigrate      = "virtualmachines/migrate"
+	apiVMRestore      = "virtualmachines/restore"
+	apiVMApplyChanges = "virtualmachines/applychanges"
+	apiVMRescue       = "virtualmachines/rescue"
+	apiVMUnrescue     = "virtualmachines/unrescue"
+	apiVMHibernate    = "virtualmachines/hibernate"
+	apiVMResume       = "virtualmachines/resume"
 	apiVMMemoryDump   = "virtualmachines/memorydump"
 	apiVMObjectGraph  = "virtualmachines/objectgraph"
 
 	apiVMInstancesConsole                   = "virtualmachineinstances/console"
 	apiVMInstancesVNC                       = "virtualmachineinstances/vnc"
 	apiVMInstancesVNCScreenshot             = "virtualmachineinstances/vnc/screenshot"
+	apiVMInstancesSPICE                     = "virtualmachineinstances/spice"
 	apiVMInstancesPortForward               = "virtualmachineinstances/portforward"
 	apiVMInstancesPause                     = "virtualmachineinstances/pause"
 	apiVMInstancesUnpause                   = "virtualmachineinstances/unpause"
 	apiVMInstancesAddVolume                 = "virtualmachineinstances/addvolume"
 	apiVMInstancesRemoveVolume              = "virtualmachineinstances/removevolume"
+	apiVMInstancesAddUSBDevice              = "virtualmachineinstances/addusbdevice"
+	apiVMInstancesRemoveUSBDevice           = "virtualmachineinstances/removeusbdevice"
+	apiVMInstancesIOLimits                  = "virtualmachineinstances/iolimits"
+	apiVMInstancesRepin                     = "virtualmachineinstances/repin"
 	apiVMInstancesFreeze                    = "virtualmachineinstances/freeze"
 	apiVMInstancesUnfreeze                  = "virtualmachineinstances/unfreeze"
 	apiVMInstancesSoftReboot                = "virtualmachineinstances/softreboot"
@@ -81,12 +103,17 @@ const (
 	apiVMInstancesGuestOSInfo               = "virtualmachineinstances/guestosinfo"
 	apiVMInstancesFileSysList               = "virtualmachineinstances/filesystemlist"
 	apiVMInstancesUserList                  = "virtualmachineinstances/userlist"
+	apiVMInstancesStats                     = "virtualmachineinstances/stats"
+	apiVMInstancesGuestOSExec               = "virtualmachineinstances/guestosexec"
+	apiVMInstancesGuestFileRead             = "virtualmachineinstances/guestfileread"
+	apiVMInstancesGuestFileWrite            = "virtualmachineinstances/guestfilewrite"
 	apiVMInstancesSEVFetchCertChain         = "virtualmachineinstances/sev/fetchcertchain"
 	apiVMInstancesSEVQueryLaunchMeasurement = "virtualmachineinstances/sev/querylaunchmeasurement"
 	apiVMInstancesSEVSetupSession           = "virtualmachineinstances/sev/setupsession"
 	apiVMInstancesSEVInjectLaunchSecret     = "virtualmachineinstances/sev/injectlaunchsecret"
 	apiVMInstancesUSBRedir                  = "virtualmachineinstances/usbredir"
 	apiVMInstancesObjectGraph               = "virtualmachineinstances/objectgraph"
+	apiVMInstancesMemoryDump                = "virtualmachineinstances/memorydump"
 )
 
 func GetAllCluster() []runtime.Object {
@@ -198,10 +225,13 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesConsole,
 					apiVMInstancesVNC,
 					apiVMInstancesVNCScreenshot,
+					apiVMInstancesSPICE,
+					apiVMInstancesMemoryDump,
 					apiVMInstancesPortForward,
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesStats,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
@@ -221,17 +251,34 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUnpause,
 					apiVMInstancesAddVolume,
 					apiVMInstancesRemoveVolume,
+					apiVMInstancesAddUSBDevice,
+					apiVMInstancesRemoveUSBDevice,
+					apiVMInstancesIOLimits,
+					apiVMInstancesRepin,
 					apiVMInstancesFreeze,
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
 					apiVMInstancesReset,
 					apiVMInstancesSEVSetupSession,
 					apiVMInstancesSEVInjectLaunchSecret,
+					apiVMInstancesGuestFileWrite,
 				},
 				Verbs: []string{
 					"update",
 				},
 			},
+			{
+				APIGroups: []string{
+					virtv1.SubresourceGroupName,
+				},
+				Resources: []string{
+					apiVMInstancesGuestOSExec,
+					apiVMInstancesGuestFileRead,
+				},
+				Verbs: []string{
+					"create",
+				},
+			},
 			{
 				APIGroups: []string{
 					virtv1.SubresourceGroupName,
@@ -255,6 +302,12 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMAddVolume,
 					apiVMRemoveVolume,
 					apiVMMemoryDump,
+					apiVMRestore,
+					apiVMApplyChanges,
+					apiVMRescue,
+					apiVMUnrescue,
+					apiVMHibernate,
+					apiVMResume,
 				},
 				Verbs: []string{
 					"update",
@@ -304,6 +357,9 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMSnapshots,
 					apiVMSnapshotContents,
 					apiVMRestores,
+					apiVMSnapshotSchedules,
+					apiVMSnapshotGroups,
+					apiVMSnapshotGroupRestores,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
@@ -315,6 +371,19 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					apiVMExports,
+					apiVMSnapshotExports,
+					apiVMSnapshotReplications,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
+			{
+				APIGroups: []string{
+					vmimport.GroupName,
+				},
+				Resources: []string{
+					apiVMImports,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
@@ -340,6 +409,8 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					instancetype.ClusterPluralResourceName,
 					instancetype.PluralPreferenceResourceName,
 					instancetype.ClusterPluralPreferenceResourceName,
+					instancetype.PluralDefaultResourceName,
+					instancetype.ClusterPluralDefaultResourceName,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
@@ -356,17 +427,53 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
 				},
 			},
+			{
+				APIGroups: []string{
+					autoscaling.GroupName,
+				},
+				Resources: []string{
+					apiVMAutoscalingPolicies,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
+			{
+				APIGroups: []string{
+					quota.GroupName,
+				},
+				Resources: []string{
+					apiVMResourceQuotas,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					migrations.GroupName,
 				},
 				Resources: []string{
 					migrations.ResourceMigrationPolicies,
+					migrations.ResourceMigrationRebalancePolicies,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					migrations.GroupName,
+				},
+				Resources: []string{
+					migrations.ResourceVolumeMigrations,
+					migrations.ResourceMigrationRetryBudgets,
+					migrations.ResourceVMDisruptionBudgets,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
 		},
 	}
 }
@@ -393,10 +500,13 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesConsole,
 					apiVMInstancesVNC,
 					apiVMInstancesVNCScreenshot,
+					apiVMInstancesSPICE,
+					apiVMInstancesMemoryDump,
 					apiVMInstancesPortForward,
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesStats,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
@@ -416,17 +526,34 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUnpause,
 					apiVMInstancesAddVolume,
 					apiVMInstancesRemoveVolume,
+					apiVMInstancesAddUSBDevice,
+					apiVMInstancesRemoveUSBDevice,
+					apiVMInstancesIOLimits,
+					apiVMInstancesRepin,
 					apiVMInstancesFreeze,
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
 					apiVMInstancesReset,
 					apiVMInstancesSEVSetupSession,
 					apiVMInstancesSEVInjectLaunchSecret,
+					apiVMInstancesGuestFileWrite,
 				},
 				Verbs: []string{
 					"update",
 				},
 			},
+			{
+				APIGroups: []string{
+					virtv1.SubresourceGroupName,
+				},
+				Resources: []string{
+					apiVMInstancesGuestOSExec,
+					apiVMInstancesGuestFileRead,
+				},
+				Verbs: []string{
+					"create",
+				},
+			},
 			{
 				APIGroups: []string{
 					virtv1.SubresourceGroupName,
@@ -450,6 +577,12 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMAddVolume,
 					apiVMRemoveVolume,
 					apiVMMemoryDump,
+					apiVMRestore,
+					apiVMApplyChanges,
+					apiVMRescue,
+					apiVMUnrescue,
+					apiVMHibernate,
+					apiVMResume,
 				},
 				Verbs: []string{
 					"update",
@@ -499,6 +632,9 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMSnapshots,
 					apiVMSnapshotContents,
 					apiVMRestores,
+					apiVMSnapshotSchedules,
+					apiVMSnapshotGroups,
+					apiVMSnapshotGroupRestores,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch",
@@ -510,6 +646,19 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					apiVMExports,
+					apiVMSnapshotExports,
+					apiVMSnapshotReplications,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					vmimport.GroupName,
+				},
+				Resources: []string{
+					apiVMImports,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch",
@@ -535,6 +684,8 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					instancetype.ClusterPluralResourceName,
 					instancetype.PluralPreferenceResourceName,
 					instancetype.ClusterPluralPreferenceResourceName,
+					instancetype.PluralDefaultResourceName,
+					instancetype.ClusterPluralDefaultResourceName,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch",
@@ -551,6 +702,28 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"get", "delete", "create", "update", "patch", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					autoscaling.GroupName,
+				},
+				Resources: []string{
+					apiVMAutoscalingPolicies,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					quota.GroupName,
+				},
+				Resources: []string{
+					apiVMResourceQuotas,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					GroupName,
@@ -568,11 +741,25 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					migrations.ResourceMigrationPolicies,
+					migrations.ResourceMigrationRebalancePolicies,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					migrations.GroupName,
+				},
+				Resources: []string{
+					migrations.ResourceVolumeMigrations,
+					migrations.ResourceMigrationRetryBudgets,
+					migrations.ResourceVMDisruptionBudgets,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -650,6 +837,7 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesStats,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMObjectGraph,
@@ -693,6 +881,9 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					apiVMSnapshots,
 					apiVMSnapshotContents,
 					apiVMRestores,
+					apiVMSnapshotSchedules,
+					apiVMSnapshotGroups,
+					apiVMSnapshotGroupRestores,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -704,6 +895,19 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					apiVMExports,
+					apiVMSnapshotExports,
+					apiVMSnapshotReplications,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					vmimport.GroupName,
+				},
+				Resources: []string{
+					apiVMImports,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -729,6 +933,8 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					instancetype.ClusterPluralResourceName,
 					instancetype.PluralPreferenceResourceName,
 					instancetype.ClusterPluralPreferenceResourceName,
+					instancetype.PluralDefaultResourceName,
+					instancetype.ClusterPluralDefaultResourceName,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -745,12 +951,48 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					autoscaling.GroupName,
+				},
+				Resources: []string{
+					apiVMAutoscalingPolicies,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					quota.GroupName,
+				},
+				Resources: []string{
+					apiVMResourceQuotas,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					migrations.GroupName,
 				},
 				Resources: []string{
 					migrations.ResourceMigrationPolicies,
+					migrations.ResourceMigrationRebalancePolicies,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					migrations.GroupName,
+				},
+				Resources: []string{
+					migrations.ResourceVolumeMigrations,
+					migrations.ResourceMigrationRetryBudgets,
+					migrations.ResourceVMDisruptionBudgets,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -779,6 +1021,7 @@ func newInstancetypeViewClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					instancetype.ClusterPluralResourceName,
 					instancetype.ClusterPluralPreferenceResourceName,
+					instancetype.ClusterPluralDefaultResourceName,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -225,7 +225,7 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"secrets",
 				},
 				Verbs: []string{
-					"create",
+					"create", "delete",
 				},
 			},
 			{
@@ -323,6 +323,12 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinesnapshotcontents/finalizers",
 					"virtualmachinerestores",
 					"virtualmachinerestores/status",
+					"virtualmachinesnapshotschedules",
+					"virtualmachinesnapshotschedules/status",
+					"virtualmachinesnapshotgroups",
+					"virtualmachinesnapshotgroups/status",
+					"virtualmachinesnapshotgrouprestores",
+					"virtualmachinesnapshotgrouprestores/status",
 				},
 				Verbs: []string{
 					"get", "list", "watch", "create", "update", "delete", "patch",
@@ -336,6 +342,22 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachineexports",
 					"virtualmachineexports/status",
 					"virtualmachineexports/finalizers",
+					"virtualmachinesnapshotexports",
+					"virtualmachinesnapshotexports/status",
+					"virtualmachinesnapshotreplications",
+					"virtualmachinesnapshotreplications/status",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "create", "update", "delete", "patch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"import.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineimports",
+					"virtualmachineimports/status",
 				},
 				Verbs: []string{
 					"get", "list", "watch", "create", "update", "delete", "patch",
@@ -362,6 +384,50 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get",
 				},
 			},
+			{
+				APIGroups: []string{
+					"autoscaling.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineautoscalingpolicies",
+					"virtualmachineautoscalingpolicies/status",
+				},
+				Verbs: []string{
+					"watch",
+					"list",
+					"get",
+					"update",
+					"patch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"quota.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineresourcequotas",
+					"virtualmachineresourcequotas/status",
+				},
+				Verbs: []string{
+					"watch",
+					"list",
+					"get",
+					"update",
+					"patch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"metrics.k8s.io",
+				},
+				Resources: []string{
+					"pods",
+					"nodes",
+				},
+				Verbs: []string{
+					"get", "list",
+				},
+			},
 			{
 				APIGroups: []string{
 					"kubevirt.io",
@@ -392,6 +458,7 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					"virtualmachines/stop",
+					"virtualmachines/hibernate",
 					"virtualmachineinstances/addvolume",
 					"virtualmachineinstances/removevolume",
 					"virtualmachineinstances/freeze",
@@ -491,6 +558,18 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"scheduling.k8s.io",
+				},
+				Resources: []string{
+					"priorityclasses",
+				},
+				Verbs: []string{
+					"list",
+					"watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"instancetype.kubevirt.io",
@@ -511,11 +590,28 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					migrations.ResourceMigrationPolicies,
+					migrations.ResourceMigrationRetryBudgets,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					migrations.GroupName,
+				},
+				Resources: []string{
+					migrations.ResourceVolumeMigrations,
+					migrations.ResourceVolumeMigrations + "/status",
+					migrations.ResourceMigrationRebalancePolicies,
+					migrations.ResourceMigrationRebalancePolicies + "/status",
+					migrations.ResourceVMDisruptionBudgets,
+					migrations.ResourceVMDisruptionBudgets + "/status",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "update", "patch",
+				},
+			},
 			{
 				APIGroups: []string{
 					clone.GroupName,
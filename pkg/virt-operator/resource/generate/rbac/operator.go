@@ -548,6 +548,17 @@ func NewOperatorRole(namespace string) *rbacv1.Role {
 					"get", "list", "watch", "delete", "update", "create", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"persistentvolumeclaims",
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
 		},
 	}
 	operatorRole.Rules = append(operatorRole.Rules, getKubeVirtComponentsRules()...)
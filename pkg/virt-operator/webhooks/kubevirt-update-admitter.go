@@ -23,7 +23,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 
 	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
@@ -38,11 +40,14 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/admissionpolicy"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
@@ -107,10 +112,43 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 
 	}
 
+	if newKV.Spec.Configuration.MediatedDevicesConfiguration != nil {
+		results = append(results,
+			validateMediatedDeviceProfiles(field.NewPath("spec", "configuration", "mediatedDevicesConfiguration"), newKV.Spec.Configuration.MediatedDevicesConfiguration)...)
+	}
+
+	if newKV.Spec.Configuration.VirtIODrivers != nil {
+		results = append(results,
+			validateVirtIODrivers(field.NewPath("spec", "configuration", "virtIODrivers"), newKV.Spec.Configuration.VirtIODrivers)...)
+	}
+
+	if newKV.Spec.Configuration.PerformanceDegradation != nil {
+		results = append(results,
+			validatePerformanceDegradation(field.NewPath("spec", "configuration", "performanceDegradation"), newKV.Spec.Configuration.PerformanceDegradation)...)
+	}
+
+	if newKV.Spec.Configuration.Logging != nil {
+		results = append(results,
+			validateLogging(field.NewPath("spec", "configuration", "logging"), newKV.Spec.Configuration.Logging)...)
+	}
+
+	if newKV.Spec.Configuration.SubresourceLimits != nil {
+		results = append(results,
+			validateSubresourceLimits(field.NewPath("spec", "configuration", "subresourceLimits"), newKV.Spec.Configuration.SubresourceLimits)...)
+	}
+
+	if newKV.Spec.Configuration.AdmissionPolicy != nil {
+		results = append(results,
+			validateAdmissionPolicy(field.NewPath("spec", "configuration", "admissionPolicy"), newKV.Spec.Configuration.AdmissionPolicy)...)
+	}
+
 	if newKV.Spec.Infra != nil {
 		results = append(results, validateInfraReplicas(newKV.Spec.Infra.Replicas)...)
 	}
 
+	results = append(results,
+		validateWorkloadUpdateStrategy(field.NewPath("spec", "workloadUpdateStrategy"), &newKV.Spec.WorkloadUpdateStrategy)...)
+
 	response := validating_webhooks.NewAdmissionResponse(results)
 
 	if featureGatesChanged(&currKV.Spec, &newKV.Spec) {
@@ -309,6 +347,196 @@ func validateSeccompConfiguration(field *field.Path, seccompConf *v1.SeccompConf
 
 }
 
+func validateMediatedDeviceProfiles(field *field.Path, mdevConf *v1.MediatedDevicesConfiguration) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+	for i, nodeConf := range mdevConf.NodeMediatedDeviceTypes {
+		names := map[string]bool{}
+		for j, profile := range nodeConf.Profiles {
+			profileField := field.Child("nodeMediatedDeviceTypes").Index(i).Child("profiles").Index(j)
+			nameField := profileField.Child("name")
+			for _, msg := range validation.IsDNS1123Label(profile.Name) {
+				statuses = append(statuses, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   nameField.String(),
+					Message: fmt.Sprintf("%s is invalid: %s", nameField.String(), msg),
+				})
+			}
+			if names[profile.Name] {
+				statuses = append(statuses, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueDuplicate,
+					Field:   nameField.String(),
+					Message: fmt.Sprintf("%s %s is defined more than once", nameField.String(), profile.Name),
+				})
+			}
+			names[profile.Name] = true
+			if profile.MediatedDeviceType == "" {
+				typeField := profileField.Child("mediatedDeviceType")
+				statuses = append(statuses, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueRequired,
+					Field:   typeField.String(),
+					Message: fmt.Sprintf("%s needs to be set", typeField.String()),
+				})
+			}
+		}
+	}
+	return statuses
+}
+
+func validateVirtIODrivers(field *field.Path, virtIODrivers *v1.VirtIODriversConfiguration) []metav1.StatusCause {
+	if virtIODrivers.Autoattach == nil || !*virtIODrivers.Autoattach || virtIODrivers.Image != "" {
+		return nil
+	}
+	imageField := field.Child("image")
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueRequired,
+		Field:   imageField.String(),
+		Message: fmt.Sprintf("%s needs to be set to autoattach the VirtIO drivers", imageField.String()),
+	}}
+}
+
+func validatePerformanceDegradation(field *field.Path, performanceDegradation *v1.PerformanceDegradationConfiguration) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	invalid := func(name, message string) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child(name).String(),
+			Message: fmt.Sprintf("%s %s", field.Child(name).String(), message),
+		})
+	}
+
+	if percentage := performanceDegradation.CPUStealPercentage; percentage != nil && *percentage > 100 {
+		invalid("cpuStealPercentage", "must not be above 100")
+	}
+	if percentage := performanceDegradation.VCPUWaitPercentage; percentage != nil && *percentage > 100 {
+		invalid("vcpuWaitPercentage", "must not be above 100")
+	}
+	if duration := performanceDegradation.IOLatency; duration != nil && duration.Duration <= 0 {
+		invalid("ioLatency", "must be positive")
+	}
+	if duration := performanceDegradation.MigrationDowntime; duration != nil && duration.Duration <= 0 {
+		invalid("migrationDowntime", "must be positive")
+	}
+	return causes
+}
+
+func validateLogging(field *field.Path, logging *v1.LoggingConfiguration) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	switch logging.Format {
+	case "", v1.LogFormatJSON, v1.LogFormatText:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Field:   field.Child("format").String(),
+			Message: fmt.Sprintf("%s must be %s or %s", field.Child("format").String(), v1.LogFormatJSON, v1.LogFormatText),
+		})
+	}
+	for subcomponent := range logging.SubcomponentVerbosity {
+		switch subcomponent {
+		case log.SubcomponentMigration, log.SubcomponentHotplug:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:  metav1.CauseTypeFieldValueNotSupported,
+				Field: field.Child("subcomponentVerbosity").Key(subcomponent).String(),
+				Message: fmt.Sprintf("%s is not a known subcomponent, supported subcomponents are %s and %s",
+					subcomponent, log.SubcomponentMigration, log.SubcomponentHotplug),
+			})
+		}
+	}
+	return causes
+}
+
+func validateSubresourceLimits(field *field.Path, subresourceLimits *v1.SubresourceLimitsConfiguration) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for i, limit := range subresourceLimits.Limits {
+		if !slices.Contains(virtconfig.LimitableSubresources, limit.Subresource) {
+			causes = append(causes, metav1.StatusCause{
+				Type:  metav1.CauseTypeFieldValueNotSupported,
+				Field: field.Child("limits").Index(i).Child("subresource").String(),
+				Message: fmt.Sprintf("%s can not be limited, supported subresources are %s",
+					limit.Subresource, strings.Join(virtconfig.LimitableSubresources, ", ")),
+			})
+		}
+		if limit.ConnectionsPerMinutePerUser != nil && *limit.ConnectionsPerMinutePerUser == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field.Child("limits").Index(i).Child("connectionsPerMinutePerUser").String(),
+				Message: fmt.Sprintf("%s must be positive", field.Child("limits").Index(i).Child("connectionsPerMinutePerUser").String()),
+			})
+		}
+	}
+	return causes
+}
+
+func validateWorkloadUpdateStrategy(field *field.Path, strategy *v1.KubeVirtWorkloadUpdateStrategy) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	invalid := func(name, message string) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child(name).String(),
+			Message: fmt.Sprintf("%s %s", field.Child(name).String(), message),
+		})
+	}
+
+	if strategy.MaxUnavailable != nil {
+		// Scaling 100% of 100 VMIs keeps percentages above 100% detectable
+		value, err := intstr.GetScaledValueFromIntOrPercent(strategy.MaxUnavailable, 100, true)
+		switch {
+		case err != nil:
+			invalid("maxUnavailable", "must be an integer or a percentage")
+		case value <= 0:
+			invalid("maxUnavailable", "must be positive, set paused to stop the rollout")
+		case strategy.MaxUnavailable.Type == intstr.String && value > 100:
+			invalid("maxUnavailable", "must not be above 100%")
+		}
+	}
+	if strategy.CanaryNodeSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(strategy.CanaryNodeSelector); err != nil {
+			invalid("canaryNodeSelector", fmt.Sprintf("is invalid: %v", err))
+		}
+	}
+	return causes
+}
+
+func validateAdmissionPolicy(field *field.Path, admissionPolicy *v1.AdmissionPolicyConfiguration) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for i, rule := range admissionPolicy.Rules {
+		ruleField := field.Child("rules").Index(i)
+		if len(rule.Resources) == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   ruleField.Child("resources").String(),
+				Message: fmt.Sprintf("%s must contain at least one resource", ruleField.Child("resources").String()),
+			})
+		}
+		for j, resource := range rule.Resources {
+			if !slices.Contains(admissionpolicy.Resources, resource) {
+				causes = append(causes, metav1.StatusCause{
+					Type:  metav1.CauseTypeFieldValueNotSupported,
+					Field: ruleField.Child("resources").Index(j).String(),
+					Message: fmt.Sprintf("%s is not supported, supported resources are %s",
+						resource, strings.Join(admissionpolicy.Resources, ", ")),
+				})
+			}
+		}
+		if rule.Mode != "" && rule.Mode != v1.AdmissionPolicyEnforce && rule.Mode != v1.AdmissionPolicyWarn {
+			causes = append(causes, metav1.StatusCause{
+				Type:  metav1.CauseTypeFieldValueNotSupported,
+				Field: ruleField.Child("mode").String(),
+				Message: fmt.Sprintf("%s is not supported, supported modes are %s and %s",
+					rule.Mode, v1.AdmissionPolicyEnforce, v1.AdmissionPolicyWarn),
+			})
+		}
+		if _, err := admissionpolicy.Compile(rule.Expression); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   ruleField.Child("expression").String(),
+				Message: fmt.Sprintf("%s is not a valid CEL expression: %v", ruleField.Child("expression").String(), err),
+			})
+		}
+	}
+	return causes
+}
+
 func validateWorkloadPlacement(ctx context.Context, namespace string, placementConfig *v1.NodePlacement, client kubecli.KubevirtClient) []metav1.StatusCause {
 	statuses := []metav1.StatusCause{}
 
@@ -24,12 +24,14 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -68,6 +70,146 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		}, []string{vmProfileField.Child("customProfile", "runtimeDefaultProfile").String(), vmProfileField.Child("customProfile", "localhostProfile").String()}),
 	)
 
+	DescribeTable("validatePerformanceDegradation", func(performanceDegradation *v1.PerformanceDegradationConfiguration, expectedFields []string) {
+		causes := validatePerformanceDegradation(test, performanceDegradation)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept valid thresholds", &v1.PerformanceDegradationConfiguration{
+			CPUStealPercentage: pointer.P(uint32(10)),
+			VCPUWaitPercentage: pointer.P(uint32(100)),
+			IOLatency:          &metav1.Duration{Duration: 50 * time.Millisecond},
+			MigrationDowntime:  &metav1.Duration{Duration: time.Second},
+		}, nil),
+		Entry("reject percentages above 100", &v1.PerformanceDegradationConfiguration{
+			CPUStealPercentage: pointer.P(uint32(101)),
+			VCPUWaitPercentage: pointer.P(uint32(200)),
+		}, []string{test.Child("cpuStealPercentage").String(), test.Child("vcpuWaitPercentage").String()}),
+		Entry("reject durations which are not positive", &v1.PerformanceDegradationConfiguration{
+			IOLatency:         &metav1.Duration{},
+			MigrationDowntime: &metav1.Duration{Duration: -time.Second},
+		}, []string{test.Child("ioLatency").String(), test.Child("migrationDowntime").String()}),
+	)
+
+	DescribeTable("validateLogging", func(logging *v1.LoggingConfiguration, expectedFields []string) {
+		causes := validateLogging(test, logging)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept a valid configuration", &v1.LoggingConfiguration{
+			Format:                v1.LogFormatText,
+			Verbosity:             &v1.LogVerbosity{VirtHandler: 4},
+			SubcomponentVerbosity: map[string]uint{"migration": 6, "hotplug": 4},
+		}, nil),
+		Entry("reject an unknown format", &v1.LoggingConfiguration{Format: "xml"}, []string{test.Child("format").String()}),
+		Entry("reject an unknown subcomponent", &v1.LoggingConfiguration{
+			SubcomponentVerbosity: map[string]uint{"network": 6},
+		}, []string{test.Child("subcomponentVerbosity").Key("network").String()}),
+	)
+
+	DescribeTable("validateSubresourceLimits", func(subresourceLimits *v1.SubresourceLimitsConfiguration, expectedFields []string) {
+		causes := validateSubresourceLimits(test, subresourceLimits)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept valid limits", &v1.SubresourceLimitsConfiguration{
+			Limits: []v1.SubresourceLimit{
+				{Subresource: "vnc", MaxConnectionsPerUser: pointer.P(uint32(5)), ConnectionsPerMinutePerUser: pointer.P(uint32(30))},
+				{Subresource: "console", MaxConnectionsPerNamespace: pointer.P(uint32(0))},
+			},
+			ExemptGroups: []string{"system:masters"},
+		}, nil),
+		Entry("reject an unknown subresource", &v1.SubresourceLimitsConfiguration{
+			Limits: []v1.SubresourceLimit{{Subresource: "start"}},
+		}, []string{test.Child("limits").Index(0).Child("subresource").String()}),
+		Entry("reject a rate of zero", &v1.SubresourceLimitsConfiguration{
+			Limits: []v1.SubresourceLimit{{Subresource: "vnc", ConnectionsPerMinutePerUser: pointer.P(uint32(0))}},
+		}, []string{test.Child("limits").Index(0).Child("connectionsPerMinutePerUser").String()}),
+	)
+
+	DescribeTable("validateAdmissionPolicy", func(rule v1.AdmissionPolicyRule, expectedFields []string) {
+		causes := validateAdmissionPolicy(test, &v1.AdmissionPolicyConfiguration{Rules: []v1.AdmissionPolicyRule{rule}})
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept a valid rule", v1.AdmissionPolicyRule{
+			Name:       "preference",
+			Resources:  []string{"virtualmachines"},
+			Expression: "has(object.spec.preference)",
+			Mode:       v1.AdmissionPolicyWarn,
+		}, nil),
+		Entry("reject a rule without resources", v1.AdmissionPolicyRule{
+			Name:       "preference",
+			Expression: "true",
+		}, []string{test.Child("rules").Index(0).Child("resources").String()}),
+		Entry("reject an unknown resource", v1.AdmissionPolicyRule{
+			Name:       "preference",
+			Resources:  []string{"virtualmachines", "pods"},
+			Expression: "true",
+		}, []string{test.Child("rules").Index(0).Child("resources").Index(1).String()}),
+		Entry("reject an unknown mode", v1.AdmissionPolicyRule{
+			Name:       "preference",
+			Resources:  []string{"virtualmachines"},
+			Expression: "true",
+			Mode:       "Audit",
+		}, []string{test.Child("rules").Index(0).Child("mode").String()}),
+		Entry("reject an invalid expression", v1.AdmissionPolicyRule{
+			Name:       "preference",
+			Resources:  []string{"virtualmachines"},
+			Expression: "has(object.spec.preference",
+		}, []string{test.Child("rules").Index(0).Child("expression").String()}),
+		Entry("reject an expression which does not evaluate to a bool", v1.AdmissionPolicyRule{
+			Name:       "preference",
+			Resources:  []string{"virtualmachines"},
+			Expression: "'preference'",
+		}, []string{test.Child("rules").Index(0).Child("expression").String()}),
+	)
+
+	DescribeTable("validateWorkloadUpdateStrategy", func(strategy *v1.KubeVirtWorkloadUpdateStrategy, expectedFields []string) {
+		causes := validateWorkloadUpdateStrategy(test, strategy)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept an empty strategy", &v1.KubeVirtWorkloadUpdateStrategy{}, nil),
+		Entry("accept valid rollout controls", &v1.KubeVirtWorkloadUpdateStrategy{
+			MaxUnavailable: pointer.P(intstr.FromString("25%")),
+			CanaryNodeSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"canary": "true"},
+			},
+			Paused: true,
+		}, nil),
+		Entry("accept a number of VMIs", &v1.KubeVirtWorkloadUpdateStrategy{
+			MaxUnavailable: pointer.P(intstr.FromInt32(200)),
+		}, nil),
+		Entry("reject a maxUnavailable of zero", &v1.KubeVirtWorkloadUpdateStrategy{
+			MaxUnavailable: pointer.P(intstr.FromString("0%")),
+		}, []string{test.Child("maxUnavailable").String()}),
+		Entry("reject a negative maxUnavailable", &v1.KubeVirtWorkloadUpdateStrategy{
+			MaxUnavailable: pointer.P(intstr.FromInt32(-1)),
+		}, []string{test.Child("maxUnavailable").String()}),
+		Entry("reject a maxUnavailable above 100%", &v1.KubeVirtWorkloadUpdateStrategy{
+			MaxUnavailable: pointer.P(intstr.FromString("150%")),
+		}, []string{test.Child("maxUnavailable").String()}),
+		Entry("reject a maxUnavailable which is not a percentage", &v1.KubeVirtWorkloadUpdateStrategy{
+			MaxUnavailable: pointer.P(intstr.FromString("half")),
+		}, []string{test.Child("maxUnavailable").String()}),
+		Entry("reject an invalid canaryNodeSelector", &v1.KubeVirtWorkloadUpdateStrategy{
+			CanaryNodeSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "canary", Operator: "Has"}},
+			},
+		}, []string{test.Child("canaryNodeSelector").String()}),
+	)
+
 	DescribeTable("test validateCustomizeComponents", func(cc v1.CustomizeComponents, expectedCauses int) {
 		causes := validateCustomizeComponents(cc)
 		Expect(causes).To(HaveLen(expectedCauses))
@@ -280,6 +422,86 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 			Entry("should not warn if configuration nil", warnNotExpected, nil),
 		)
 
+		DescribeTable("mediated device profiles", func(profiles []v1.MediatedDeviceProfile, expectedField string) {
+			kvObject := v1.KubeVirt{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						MediatedDevicesConfiguration: &v1.MediatedDevicesConfiguration{
+							NodeMediatedDeviceTypes: []v1.NodeMediatedDeviceTypesConfig{
+								{
+									NodeSelector:        map[string]string{"gpu": "a"},
+									MediatedDeviceTypes: []string{},
+									Profiles:            profiles,
+								},
+							},
+						},
+					},
+				},
+			}
+
+			response := admit(context.Background(), kvObject)
+			Expect(response).NotTo(BeNil())
+			if expectedField == "" {
+				Expect(response.Allowed).To(BeTrue())
+			} else {
+				Expect(response.Allowed).To(BeFalse())
+				Expect(response.Result.Details.Causes).To(HaveLen(1))
+				Expect(response.Result.Details.Causes[0].Field).To(Equal(expectedField))
+			}
+		},
+			Entry("should accept valid profiles", []v1.MediatedDeviceProfile{
+				{Name: "small", MediatedDeviceType: "nvidia-222"},
+				{Name: "large", MediatedDeviceType: "nvidia-224"},
+			}, ""),
+			Entry("should reject an invalid name", []v1.MediatedDeviceProfile{
+				{Name: "Small_GPU", MediatedDeviceType: "nvidia-222"},
+			}, "spec.configuration.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[0].profiles[0].name"),
+			Entry("should reject a duplicated name", []v1.MediatedDeviceProfile{
+				{Name: "small", MediatedDeviceType: "nvidia-222"},
+				{Name: "small", MediatedDeviceType: "nvidia-223"},
+			}, "spec.configuration.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[0].profiles[1].name"),
+			Entry("should reject a missing mediated device type", []v1.MediatedDeviceProfile{
+				{Name: "small"},
+			}, "spec.configuration.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[0].profiles[0].mediatedDeviceType"),
+		)
+
+		DescribeTable("VirtIO drivers", func(virtIODrivers *v1.VirtIODriversConfiguration, expectedField string) {
+			kvObject := v1.KubeVirt{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						VirtIODrivers: virtIODrivers,
+					},
+				},
+			}
+
+			response := admit(context.Background(), kvObject)
+			Expect(response).NotTo(BeNil())
+			if expectedField == "" {
+				Expect(response.Allowed).To(BeTrue())
+			} else {
+				Expect(response.Allowed).To(BeFalse())
+				Expect(response.Result.Details.Causes).To(HaveLen(1))
+				Expect(response.Result.Details.Causes[0].Field).To(Equal(expectedField))
+			}
+		},
+			Entry("should accept autoattach with an image", &v1.VirtIODriversConfiguration{
+				Autoattach: pointer.P(true),
+				Image:      "quay.io/kubevirt/virtio-container-disk:v1.7.0",
+			}, ""),
+			Entry("should accept an image without autoattach", &v1.VirtIODriversConfiguration{
+				Image: "quay.io/kubevirt/virtio-container-disk:v1.7.0",
+			}, ""),
+			Entry("should reject autoattach without an image", &v1.VirtIODriversConfiguration{
+				Autoattach: pointer.P(true),
+			}, "spec.configuration.virtIODrivers.image"),
+		)
+
 		DescribeTable("should raise warning when a deprecated feature-gate is enabled", func(featureGate, expectedWarning string) {
 			kv := v1.KubeVirt{}
 			kvBytes, err := json.Marshal(kv)
@@ -0,0 +1,120 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+type question struct {
+	flag     string
+	text     string
+	repeated bool
+	// skip allows to leave out questions which do not apply because of previous answers
+	skip func(*cobra.Command) bool
+}
+
+var questions = []question{
+	{flag: NameFlag, text: "Name of the VM (empty for a random name)"},
+	{flag: RunStrategyFlag, text: "RunStrategy of the VM (Always, Manual, Halted, Once, RerunOnFailure)"},
+	{flag: InstancetypeFlag, text: "Instance Type of the VM (empty to infer it from the boot volume)",
+		skip: changed(MemoryFlag, InferInstancetypeFlag, InferInstancetypeFromFlag)},
+	{flag: MemoryFlag, text: "Memory of the VM (empty for the default)",
+		skip: changed(InstancetypeFlag, InferInstancetypeFlag, InferInstancetypeFromFlag)},
+	{flag: PreferenceFlag, text: "Preference of the VM (empty to infer it from the boot volume)",
+		skip: changed(InferPreferenceFlag, InferPreferenceFromFlag)},
+	{flag: ContainerdiskVolumeFlag, text: "Containerdisk volume, e.g. src:my.registry/my-image:my-tag", repeated: true},
+	{flag: VolumeImportFlag, text: "Imported volume, e.g. type:ds,src:my-ns/my-ds,size:30Gi,storageclass:my-sc", repeated: true},
+	{flag: PvcVolumeFlag, text: "PVC volume, e.g. src:my-pvc", repeated: true},
+	{flag: NetworkFlag, text: "Secondary network, e.g. network:my-ns/my-net,binding:bridge", repeated: true},
+	{flag: GPUFlag, text: "GPU, e.g. devicename:nvidia.com/GP102GL_Tesla_P40", repeated: true},
+	{flag: HostDeviceFlag, text: "Host device, e.g. devicename:vendor.com/device", repeated: true},
+	{flag: TolerationFlag, text: "Toleration, e.g. key:dedicated,value:vms,effect:NoSchedule", repeated: true},
+	{flag: NodeAffinityFlag, text: "Node affinity, e.g. key:topology.kubernetes.io/zone,values:zone-a;zone-b", repeated: true},
+	{flag: UserFlag, text: "User in the cloud-init user data", skip: changed(CloudInitUserDataFlag)},
+	{flag: SSHKeyFlag, text: "SSH authorized key in the cloud-init user data", repeated: true, skip: changed(CloudInitUserDataFlag)},
+}
+
+func changed(flags ...string) func(*cobra.Command) bool {
+	return func(cmd *cobra.Command) bool {
+		for _, flag := range flags {
+			if cmd.Flags().Changed(flag) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// prompt asks for the options of the VM which were not provided on the command line.
+// The questions are written to stderr to keep the manifest on stdout clean.
+func (c *createVM) prompt(cmd *cobra.Command) error {
+	reader := bufio.NewReader(cmd.InOrStdin())
+	out := cmd.ErrOrStderr()
+
+	fmt.Fprintln(out, "Answer the questions to create the VM, leave an answer empty to skip it.")
+	for _, q := range questions {
+		if cmd.Flags().Changed(q.flag) || (q.skip != nil && q.skip(cmd)) {
+			continue
+		}
+
+		for {
+			if q.repeated {
+				fmt.Fprintf(out, "%s (empty to continue): ", q.text)
+			} else {
+				fmt.Fprintf(out, "%s: ", q.text)
+			}
+
+			answer, err := readAnswer(reader)
+			if err != nil {
+				return err
+			}
+			if answer == "" {
+				break
+			}
+
+			if err := cmd.Flags().Set(q.flag, answer); err != nil {
+				return fmt.Errorf("invalid answer for --%s: %w", q.flag, err)
+			}
+
+			if !q.repeated {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// readAnswer returns the next line of the input, the end of the input is treated as an empty answer
+func readAnswer(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	return strings.TrimSpace(line), nil
+}
@@ -40,27 +40,30 @@ type sysprepVolumeSource struct {
 }
 
 type dataVolumeSource struct {
-	Name      string             `param:"name"`
-	Source    string             `param:"src"`
-	Size      *resource.Quantity `param:"size"`
-	Type      string             `param:"type"`
-	BootOrder *uint              `param:"bootorder"`
+	Name         string             `param:"name"`
+	Source       string             `param:"src"`
+	Size         *resource.Quantity `param:"size"`
+	StorageClass string             `param:"storageclass"`
+	Type         string             `param:"type"`
+	BootOrder    *uint              `param:"bootorder"`
 }
 
 type dataVolumeSourceBlank struct {
-	Size      *resource.Quantity `param:"size"`
-	Type      string             `param:"type"`
-	Name      string             `param:"name"`
-	BootOrder *uint              `param:"bootorder"`
+	Size         *resource.Quantity `param:"size"`
+	StorageClass string             `param:"storageclass"`
+	Type         string             `param:"type"`
+	Name         string             `param:"name"`
+	BootOrder    *uint              `param:"bootorder"`
 }
 
 type dataVolumeSourceGcs struct {
-	SecretRef string             `param:"secretref"`
-	URL       string             `param:"url"`
-	Size      *resource.Quantity `param:"size"`
-	Type      string             `param:"type"`
-	Name      string             `param:"name"`
-	BootOrder *uint              `param:"bootorder"`
+	SecretRef    string             `param:"secretref"`
+	URL          string             `param:"url"`
+	Size         *resource.Quantity `param:"size"`
+	StorageClass string             `param:"storageclass"`
+	Type         string             `param:"type"`
+	Name         string             `param:"name"`
+	BootOrder    *uint              `param:"bootorder"`
 }
 
 type dataVolumeSourceHTTP struct {
@@ -70,6 +73,7 @@ type dataVolumeSourceHTTP struct {
 	SecretRef          string             `param:"secretref"`
 	URL                string             `param:"url"`
 	Size               *resource.Quantity `param:"size"`
+	StorageClass       string             `param:"storageclass"`
 	Type               string             `param:"type"`
 	Name               string             `param:"name"`
 	BootOrder          *uint              `param:"bootorder"`
@@ -81,6 +85,7 @@ type dataVolumeSourceImageIO struct {
 	SecretRef     string             `param:"secretref"`
 	URL           string             `param:"url"`
 	Size          *resource.Quantity `param:"size"`
+	StorageClass  string             `param:"storageclass"`
 	Type          string             `param:"type"`
 	Name          string             `param:"name"`
 	BootOrder     *uint              `param:"bootorder"`
@@ -93,6 +98,7 @@ type dataVolumeSourceRegistry struct {
 	SecretRef     string             `param:"secretref"`
 	URL           string             `param:"url"`
 	Size          *resource.Quantity `param:"size"`
+	StorageClass  string             `param:"storageclass"`
 	Type          string             `param:"type"`
 	Name          string             `param:"name"`
 	BootOrder     *uint              `param:"bootorder"`
@@ -103,6 +109,7 @@ type dataVolumeSourceS3 struct {
 	SecretRef     string             `param:"secretref"`
 	URL           string             `param:"url"`
 	Size          *resource.Quantity `param:"size"`
+	StorageClass  string             `param:"storageclass"`
 	Type          string             `param:"type"`
 	Name          string             `param:"name"`
 	BootOrder     *uint              `param:"bootorder"`
@@ -116,7 +123,32 @@ type dataVolumeSourceVDDK struct {
 	URL          string             `param:"url"`
 	UUID         string             `param:"uuid"`
 	Size         *resource.Quantity `param:"size"`
+	StorageClass string             `param:"storageclass"`
 	Type         string             `param:"type"`
 	Name         string             `param:"name"`
 	BootOrder    *uint              `param:"bootorder"`
 }
+
+type networkSource struct {
+	Name    string `param:"name"`
+	Network string `param:"network"`
+	Binding string `param:"binding"`
+}
+
+type deviceSource struct {
+	Name       string `param:"name"`
+	DeviceName string `param:"devicename"`
+}
+
+type tolerationSource struct {
+	Key      string `param:"key"`
+	Operator string `param:"operator"`
+	Value    string `param:"value"`
+	Effect   string `param:"effect"`
+}
+
+type nodeAffinitySource struct {
+	Key      string   `param:"key"`
+	Operator string   `param:"operator"`
+	Values   []string `param:"values"`
+}
@@ -73,6 +73,14 @@ const (
 	CloudInitUserDataFlag    = "cloud-init-user-data"
 	CloudInitNetworkDataFlag = "cloud-init-network-data"
 
+	NetworkFlag      = "network"
+	GPUFlag          = "gpu"
+	HostDeviceFlag   = "host-device"
+	TolerationFlag   = "toleration"
+	NodeAffinityFlag = "node-affinity"
+
+	InteractiveFlag = "interactive"
+
 	// Deprecated flags
 	DataSourceVolumeFlag = "volume-datasource"
 	ClonePvcVolumeFlag   = "volume-clone-pvc"
@@ -92,6 +100,9 @@ const (
 	accessCredTypePassword = "password"
 	accessCredMethodGA     = "ga"
 
+	bindingBridge = "bridge"
+	bindingSRIOV  = "sriov"
+
 	blank    = "blank"
 	gcs      = "gcs"
 	http     = "http"
@@ -138,6 +149,13 @@ type createVM struct {
 	cloudInitUserData    string
 	cloudInitNetworkData string
 
+	networks     []string
+	gpus         []string
+	hostDevices  []string
+	tolerations  []string
+	nodeAffinity []string
+	interactive  bool
+
 	// Deprecated fields
 	dataSourceVolumes []string
 	clonePvcVolumes   []string
@@ -170,6 +188,11 @@ var flags = []string{
 	VolumeImportFlag,
 	SysprepVolumeFlag,
 	AccessCredFlag,
+	NetworkFlag,
+	GPUFlag,
+	HostDeviceFlag,
+	TolerationFlag,
+	NodeAffinityFlag,
 }
 
 var volumeImportOptions = map[string]func(string) (*cdiv1.DataVolumeSpec, *uint, error){
@@ -285,6 +308,28 @@ func NewCommand() *cobra.Command {
 		"Specify the base64 encoded cloud-init user data of the VM.")
 	cmd.Flags().StringVar(&c.cloudInitNetworkData, CloudInitNetworkDataFlag, c.cloudInitNetworkData,
 		"Specify the base64 encoded cloud-init network data of the VM.")
+
+	cmd.Flags().StringArrayVar(&c.networks, NetworkFlag, c.networks,
+		fmt.Sprintf("Specify a secondary Multus network to connect the VM to. Can be provided multiple times.\n"+
+			"The VM stays connected to the pod network with masquerade binding.\n"+
+			"Supported parameters: %s\n"+
+			"Supported bindings: %s, %s", params.Supported(networkSource{}), bindingBridge, bindingSRIOV))
+	cmd.Flags().StringArrayVar(&c.gpus, GPUFlag, c.gpus,
+		fmt.Sprintf("Specify a GPU to be assigned to the VM. Can be provided multiple times.\n"+
+			"Supported parameters: %s", params.Supported(deviceSource{})))
+	cmd.Flags().StringArrayVar(&c.hostDevices, HostDeviceFlag, c.hostDevices,
+		fmt.Sprintf("Specify a host device to be assigned to the VM. Can be provided multiple times.\n"+
+			"Supported parameters: %s", params.Supported(deviceSource{})))
+	cmd.Flags().StringArrayVar(&c.tolerations, TolerationFlag, c.tolerations,
+		fmt.Sprintf("Specify a toleration of the VM. Can be provided multiple times.\n"+
+			"Supported parameters: %s", params.Supported(tolerationSource{})))
+	cmd.Flags().StringArrayVar(&c.nodeAffinity, NodeAffinityFlag, c.nodeAffinity,
+		fmt.Sprintf("Specify a node selector requirement the node running the VM has to match. Can be provided multiple times.\n"+
+			"Multiple values are separated by semicolons.\n"+
+			"Supported parameters: %s", params.Supported(nodeAffinitySource{})))
+
+	cmd.Flags().BoolVar(&c.interactive, InteractiveFlag, c.interactive,
+		"Prompt for the options of the VM instead of reading them from flags only. Flags provided on the command line are used as given.")
 	cmd.MarkFlagsMutuallyExclusive(CloudInitUserDataFlag, UserFlag)
 	cmd.MarkFlagsMutuallyExclusive(CloudInitUserDataFlag, PasswordFileFlag)
 	cmd.MarkFlagsMutuallyExclusive(CloudInitUserDataFlag, SSHKeyFlag)
@@ -388,6 +433,12 @@ func isCloudInitSourceEmpty(src *v1.VolumeSource) bool {
 }
 
 func (c *createVM) run(cmd *cobra.Command, _ []string) error {
+	if c.interactive {
+		if err := c.prompt(cmd); err != nil {
+			return err
+		}
+	}
+
 	if err := c.setDefaults(cmd); err != nil {
 		return err
 	}
@@ -462,6 +513,11 @@ func (c *createVM) optFns() map[string]func(*v1.VirtualMachine) error {
 		VolumeImportFlag:        c.withImportedVolume,
 		SysprepVolumeFlag:       c.withSysprepVolume,
 		AccessCredFlag:          c.withAccessCredential,
+		NetworkFlag:             c.withNetwork,
+		GPUFlag:                 c.withGPU,
+		HostDeviceFlag:          c.withHostDevice,
+		TolerationFlag:          c.withToleration,
+		NodeAffinityFlag:        c.withNodeAffinity,
 	}
 }
 
@@ -537,7 +593,22 @@ func (c *createVM) usage() string {
   {{ProgramName}} create vm --access-cred=type:password,src:my-pws
 
   # Create a manifest for a VirtualMachine with a Containerdisk and a Sysprep volume (source ConfigMap needs to exist)
-  {{ProgramName}} create vm --memory=1Gi --volume-containerdisk=src:my.registry/my-image:my-tag --volume-sysprep=src:my-cm`
+  {{ProgramName}} create vm --memory=1Gi --volume-containerdisk=src:my.registry/my-image:my-tag --volume-sysprep=src:my-cm
+
+  # Create a manifest for a VirtualMachine with a blank volume of a specified StorageClass
+  {{ProgramName}} create vm --volume-import=type:ds,src:my-ds --volume-import=type:blank,size:50Gi,storageclass:my-sc
+
+  # Create a manifest for a VirtualMachine connected to a secondary Multus network and an SR-IOV network
+  {{ProgramName}} create vm --network=network:my-ns/my-bridge-net --network=name:fast,network:my-sriov-net,binding:sriov
+
+  # Create a manifest for a VirtualMachine with a GPU and a host device
+  {{ProgramName}} create vm --gpu=devicename:nvidia.com/GP102GL_Tesla_P40 --host-device=name:nic,devicename:vendor.com/nic
+
+  # Create a manifest for a VirtualMachine tolerating a taint and running on nodes of a zone only
+  {{ProgramName}} create vm --toleration=key:dedicated,value:vms,effect:NoSchedule --node-affinity=key:topology.kubernetes.io/zone,values:zone-a;zone-b
+
+  # Create a manifest for a VirtualMachine by answering prompts
+  {{ProgramName}} create vm --interactive`
 }
 
 func (c *createVM) newVM() (*v1.VirtualMachine, error) {
@@ -1080,7 +1151,12 @@ func (c *createVM) withImportedVolume(vm *v1.VirtualMachine) error {
 			name = "imported-volume-" + rand.String(randSuffixLength)
 		}
 
-		if err := createDataVolume(spec, size, name, vm); err != nil {
+		storageClass, err := params.GetParamByName("storageclass", volume)
+		if err != nil && !errors.Is(err, params.NotFoundError{Name: "storageclass"}) {
+			return params.FlagErr(VolumeImportFlag, "%w", err)
+		}
+
+		if err := createDataVolume(spec, size, storageClass, name, vm); err != nil {
 			return err
 		}
 
@@ -1361,7 +1437,7 @@ func withVolumeSourceRefDataSource(paramStr string) (*cdiv1.DataVolumeSpec, *uin
 	return spec, src.BootOrder, nil
 }
 
-func createDataVolume(spec *cdiv1.DataVolumeSpec, size, name string, vm *v1.VirtualMachine) error {
+func createDataVolume(spec *cdiv1.DataVolumeSpec, size, storageClass, name string, vm *v1.VirtualMachine) error {
 	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
 		return params.FlagErr(VolumeImportFlag, "invalid name \"%s\": %s", name, strings.Join(errs, ","))
 	}
@@ -1386,6 +1462,10 @@ func createDataVolume(spec *cdiv1.DataVolumeSpec, size, name string, vm *v1.Virt
 		}
 	}
 
+	if storageClass != "" {
+		dvt.Spec.Storage.StorageClassName = &storageClass
+	}
+
 	vm.Spec.DataVolumeTemplates = append(vm.Spec.DataVolumeTemplates, dvt)
 	vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, v1.Volume{
 		Name: name,
@@ -1552,6 +1632,244 @@ func (c *createVM) withAccessCredentialPassword(src *accessCredential) (*v1.Acce
 	}, nil
 }
 
+func (c *createVM) withNetwork(vm *v1.VirtualMachine) error {
+	spec := &vm.Spec.Template.Spec
+	for _, network := range c.networks {
+		src := networkSource{}
+		if err := params.Map(NetworkFlag, network, &src); err != nil {
+			return err
+		}
+
+		if src.Network == "" {
+			return params.FlagErr(NetworkFlag, "network must be specified")
+		}
+
+		_, name, err := params.SplitPrefixedName(src.Network)
+		if err != nil {
+			return params.FlagErr(NetworkFlag, "network invalid: %w", err)
+		}
+
+		if src.Name == "" {
+			src.Name = name
+		}
+
+		if errs := validation.IsDNS1123Label(src.Name); len(errs) > 0 {
+			return params.FlagErr(NetworkFlag, "invalid name \"%s\": %s", src.Name, strings.Join(errs, ","))
+		}
+
+		iface := v1.Interface{Name: src.Name}
+		switch strings.ToLower(src.Binding) {
+		case bindingBridge, "":
+			iface.Bridge = &v1.InterfaceBridge{}
+		case bindingSRIOV:
+			iface.SRIOV = &v1.InterfaceSRIOV{}
+		default:
+			return params.FlagErr(NetworkFlag, "invalid binding \"%s\", supported values are: %s, %s",
+				src.Binding, bindingBridge, bindingSRIOV)
+		}
+
+		// Keep the VM connected to the pod network, it is only added by default if no network is specified
+		if len(spec.Networks) == 0 {
+			spec.Networks = append(spec.Networks, *v1.DefaultPodNetwork())
+			spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, *v1.DefaultMasqueradeNetworkInterface())
+		}
+
+		for _, n := range spec.Networks {
+			if n.Name == src.Name {
+				return params.FlagErr(NetworkFlag, "there is already a network with name \"%s\"", src.Name)
+			}
+		}
+
+		spec.Networks = append(spec.Networks, v1.Network{
+			Name: src.Name,
+			NetworkSource: v1.NetworkSource{
+				Multus: &v1.MultusNetwork{
+					NetworkName: src.Network,
+				},
+			},
+		})
+		spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, iface)
+	}
+
+	return nil
+}
+
+func (c *createVM) withGPU(vm *v1.VirtualMachine) error {
+	for i, gpu := range c.gpus {
+		src, err := parseDeviceSource(GPUFlag, gpu, fmt.Sprintf("gpu-%d", i))
+		if err != nil {
+			return err
+		}
+
+		for _, g := range vm.Spec.Template.Spec.Domain.Devices.GPUs {
+			if g.Name == src.Name {
+				return params.FlagErr(GPUFlag, "there is already a gpu with name \"%s\"", src.Name)
+			}
+		}
+
+		vm.Spec.Template.Spec.Domain.Devices.GPUs = append(vm.Spec.Template.Spec.Domain.Devices.GPUs, v1.GPU{
+			Name:       src.Name,
+			DeviceName: src.DeviceName,
+		})
+	}
+
+	return nil
+}
+
+func (c *createVM) withHostDevice(vm *v1.VirtualMachine) error {
+	for i, hostDevice := range c.hostDevices {
+		src, err := parseDeviceSource(HostDeviceFlag, hostDevice, fmt.Sprintf("hostdevice-%d", i))
+		if err != nil {
+			return err
+		}
+
+		for _, d := range vm.Spec.Template.Spec.Domain.Devices.HostDevices {
+			if d.Name == src.Name {
+				return params.FlagErr(HostDeviceFlag, "there is already a host device with name \"%s\"", src.Name)
+			}
+		}
+
+		vm.Spec.Template.Spec.Domain.Devices.HostDevices = append(vm.Spec.Template.Spec.Domain.Devices.HostDevices, v1.HostDevice{
+			Name:       src.Name,
+			DeviceName: src.DeviceName,
+		})
+	}
+
+	return nil
+}
+
+func parseDeviceSource(flag, paramStr, defaultName string) (*deviceSource, error) {
+	src := &deviceSource{}
+	if err := params.Map(flag, paramStr, src); err != nil {
+		return nil, err
+	}
+
+	if src.DeviceName == "" {
+		return nil, params.FlagErr(flag, "devicename must be specified")
+	}
+
+	if src.Name == "" {
+		src.Name = defaultName
+	}
+
+	if errs := validation.IsDNS1123Label(src.Name); len(errs) > 0 {
+		return nil, params.FlagErr(flag, "invalid name \"%s\": %s", src.Name, strings.Join(errs, ","))
+	}
+
+	return src, nil
+}
+
+func (c *createVM) withToleration(vm *v1.VirtualMachine) error {
+	for _, toleration := range c.tolerations {
+		src := tolerationSource{}
+		if err := params.Map(TolerationFlag, toleration, &src); err != nil {
+			return err
+		}
+
+		operator := k8sv1.TolerationOpEqual
+		switch {
+		case src.Operator == "" && src.Value == "":
+			operator = k8sv1.TolerationOpExists
+		case strings.EqualFold(src.Operator, string(k8sv1.TolerationOpEqual)):
+		case strings.EqualFold(src.Operator, string(k8sv1.TolerationOpExists)):
+			operator = k8sv1.TolerationOpExists
+		case src.Operator != "":
+			return params.FlagErr(TolerationFlag, "invalid operator \"%s\", supported values are: %s, %s",
+				src.Operator, k8sv1.TolerationOpEqual, k8sv1.TolerationOpExists)
+		}
+
+		if operator == k8sv1.TolerationOpExists && src.Value != "" {
+			return params.FlagErr(TolerationFlag, "value must be empty with operator %s", k8sv1.TolerationOpExists)
+		}
+
+		if src.Key == "" && operator != k8sv1.TolerationOpExists {
+			return params.FlagErr(TolerationFlag, "key must be specified with operator %s", k8sv1.TolerationOpEqual)
+		}
+
+		effect := k8sv1.TaintEffect(src.Effect)
+		switch effect {
+		case "", k8sv1.TaintEffectNoSchedule, k8sv1.TaintEffectPreferNoSchedule, k8sv1.TaintEffectNoExecute:
+		default:
+			return params.FlagErr(TolerationFlag, "invalid effect \"%s\", supported values are: %s, %s, %s",
+				src.Effect, k8sv1.TaintEffectNoSchedule, k8sv1.TaintEffectPreferNoSchedule, k8sv1.TaintEffectNoExecute)
+		}
+
+		vm.Spec.Template.Spec.Tolerations = append(vm.Spec.Template.Spec.Tolerations, k8sv1.Toleration{
+			Key:      src.Key,
+			Operator: operator,
+			Value:    src.Value,
+			Effect:   effect,
+		})
+	}
+
+	return nil
+}
+
+func (c *createVM) withNodeAffinity(vm *v1.VirtualMachine) error {
+	operators := []k8sv1.NodeSelectorOperator{
+		k8sv1.NodeSelectorOpIn,
+		k8sv1.NodeSelectorOpNotIn,
+		k8sv1.NodeSelectorOpExists,
+		k8sv1.NodeSelectorOpDoesNotExist,
+	}
+
+	var requirements []k8sv1.NodeSelectorRequirement
+	for _, nodeAffinity := range c.nodeAffinity {
+		src := nodeAffinitySource{}
+		if err := params.Map(NodeAffinityFlag, nodeAffinity, &src); err != nil {
+			return err
+		}
+
+		if src.Key == "" {
+			return params.FlagErr(NodeAffinityFlag, "key must be specified")
+		}
+
+		operator := k8sv1.NodeSelectorOpIn
+		if src.Operator != "" {
+			operator = ""
+			for _, op := range operators {
+				if strings.EqualFold(string(op), src.Operator) {
+					operator = op
+				}
+			}
+			if operator == "" {
+				return params.FlagErr(NodeAffinityFlag, "invalid operator \"%s\", supported values are: %s, %s, %s, %s",
+					src.Operator, k8sv1.NodeSelectorOpIn, k8sv1.NodeSelectorOpNotIn, k8sv1.NodeSelectorOpExists, k8sv1.NodeSelectorOpDoesNotExist)
+			}
+		}
+
+		switch operator {
+		case k8sv1.NodeSelectorOpIn, k8sv1.NodeSelectorOpNotIn:
+			if len(src.Values) == 0 {
+				return params.FlagErr(NodeAffinityFlag, "values must be specified with operator %s", operator)
+			}
+		default:
+			if len(src.Values) > 0 {
+				return params.FlagErr(NodeAffinityFlag, "values must be empty with operator %s", operator)
+			}
+		}
+
+		requirements = append(requirements, k8sv1.NodeSelectorRequirement{
+			Key:      src.Key,
+			Operator: operator,
+			Values:   src.Values,
+		})
+	}
+
+	// All requirements are part of a single term, so the node has to match all of them
+	vm.Spec.Template.Spec.Affinity = &k8sv1.Affinity{
+		NodeAffinity: &k8sv1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+				NodeSelectorTerms: []k8sv1.NodeSelectorTerm{
+					{MatchExpressions: requirements},
+				},
+			},
+		},
+	}
+
+	return nil
+}
+
 // Deprecated optFns
 
 func (c *createVM) withDataSourceVolume(_ *v1.VirtualMachine) error {
@@ -2,8 +2,10 @@
 package vm_test
 
 import (
+	"bytes"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -24,6 +26,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl"
 	"kubevirt.io/kubevirt/pkg/virtctl/create"
 	. "kubevirt.io/kubevirt/pkg/virtctl/create/vm"
 	"kubevirt.io/kubevirt/pkg/virtctl/testing"
@@ -1183,6 +1186,142 @@ chpasswd: { expire: False }`
 			Entry("explicit type and method", "type:password,src:my-pws,method:ga"),
 		)
 
+		It("VM with imported volume with storage class", func() {
+			out, err := runCmd(setFlag(VolumeImportFlag, "type:blank,size:10Gi,name:my-blank,storageclass:my-sc"))
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(1))
+			Expect(vm.Spec.DataVolumeTemplates[0].Spec.Storage.StorageClassName).To(PointTo(Equal("my-sc")))
+			Expect(vm.Spec.DataVolumeTemplates[0].Spec.Storage.Resources.Requests).To(HaveKeyWithValue(k8sv1.ResourceStorage, resource.MustParse("10Gi")))
+		})
+
+		DescribeTable("VM with secondary network", func(params, name string, iface v1.Interface) {
+			out, err := runCmd(setFlag(NetworkFlag, params))
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Spec.Template.Spec.Networks).To(Equal([]v1.Network{
+				*v1.DefaultPodNetwork(),
+				{
+					Name: name,
+					NetworkSource: v1.NetworkSource{
+						Multus: &v1.MultusNetwork{NetworkName: "my-ns/my-net"},
+					},
+				},
+			}))
+			Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces).To(Equal([]v1.Interface{
+				*v1.DefaultMasqueradeNetworkInterface(),
+				iface,
+			}))
+		},
+			Entry("with default name and binding", "network:my-ns/my-net", "my-net",
+				v1.Interface{Name: "my-net", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}}),
+			Entry("with name and bridge binding", "name:secondary,network:my-ns/my-net,binding:bridge", "secondary",
+				v1.Interface{Name: "secondary", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}}),
+			Entry("with sriov binding", "name:fast,network:my-ns/my-net,binding:sriov", "fast",
+				v1.Interface{Name: "fast", InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}}),
+		)
+
+		It("VM with multiple secondary networks", func() {
+			out, err := runCmd(
+				setFlag(NetworkFlag, "network:net-a"),
+				setFlag(NetworkFlag, "network:net-b,binding:sriov"),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Spec.Template.Spec.Networks).To(HaveLen(3))
+			Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces).To(HaveLen(3))
+			Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces[2].SRIOV).ToNot(BeNil())
+		})
+
+		It("VM with GPUs and host devices", func() {
+			out, err := runCmd(
+				setFlag(GPUFlag, "devicename:nvidia.com/GP102GL_Tesla_P40"),
+				setFlag(GPUFlag, "name:my-gpu,devicename:nvidia.com/GP102GL_Tesla_P40"),
+				setFlag(HostDeviceFlag, "name:nic,devicename:vendor.com/nic"),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Spec.Template.Spec.Domain.Devices.GPUs).To(Equal([]v1.GPU{
+				{Name: "gpu-0", DeviceName: "nvidia.com/GP102GL_Tesla_P40"},
+				{Name: "my-gpu", DeviceName: "nvidia.com/GP102GL_Tesla_P40"},
+			}))
+			Expect(vm.Spec.Template.Spec.Domain.Devices.HostDevices).To(Equal([]v1.HostDevice{
+				{Name: "nic", DeviceName: "vendor.com/nic"},
+			}))
+		})
+
+		DescribeTable("VM with toleration", func(params string, toleration k8sv1.Toleration) {
+			out, err := runCmd(setFlag(TolerationFlag, params))
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Spec.Template.Spec.Tolerations).To(ConsistOf(toleration))
+		},
+			Entry("with key and value", "key:dedicated,value:vms,effect:NoSchedule",
+				k8sv1.Toleration{Key: "dedicated", Operator: k8sv1.TolerationOpEqual, Value: "vms", Effect: k8sv1.TaintEffectNoSchedule}),
+			Entry("with key only", "key:dedicated",
+				k8sv1.Toleration{Key: "dedicated", Operator: k8sv1.TolerationOpExists}),
+			Entry("with explicit operator", "key:dedicated,operator:exists,effect:NoExecute",
+				k8sv1.Toleration{Key: "dedicated", Operator: k8sv1.TolerationOpExists, Effect: k8sv1.TaintEffectNoExecute}),
+		)
+
+		It("VM with node affinity", func() {
+			out, err := runCmd(
+				setFlag(NodeAffinityFlag, "key:topology.kubernetes.io/zone,values:zone-a;zone-b"),
+				setFlag(NodeAffinityFlag, "key:gpu,operator:Exists"),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(Equal(
+				[]k8sv1.NodeSelectorTerm{{
+					MatchExpressions: []k8sv1.NodeSelectorRequirement{
+						{Key: "topology.kubernetes.io/zone", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"zone-a", "zone-b"}},
+						{Key: "gpu", Operator: k8sv1.NodeSelectorOpExists},
+					},
+				}},
+			))
+		})
+
+		It("VM created interactively", func() {
+			answers := strings.Join([]string{
+				"my-vm",                         // name
+				"",                              // run strategy
+				"u1.medium",                     // instancetype
+				"",                              // preference
+				"",                              // containerdisk volumes
+				"type:ds,src:my-ns/my-ds",       // first imported volume
+				"type:blank,size:1Gi,name:data", // second imported volume
+				"",                              // end of imported volumes
+				"",                              // pvc volumes
+				"network:my-net,binding:sriov",  // first network
+				"",                              // end of networks
+			}, "\n")
+
+			out, err := runInteractiveCmd(answers, setFlag(GPUFlag, "devicename:nvidia.com/GP102GL_Tesla_P40"))
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := decodeVM(out)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vm.Name).To(Equal("my-vm"))
+			Expect(vm.Spec.Instancetype).To(PointTo(MatchFields(IgnoreExtras, Fields{"Name": Equal("u1.medium")})))
+			Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(2))
+			Expect(vm.Spec.DataVolumeTemplates[1].Name).To(Equal("data"))
+			Expect(vm.Spec.Template.Spec.Networks).To(HaveLen(2))
+			Expect(vm.Spec.Template.Spec.Domain.Devices.GPUs).To(HaveLen(1))
+			Expect(vm.Spec.Template.Spec.Tolerations).To(BeEmpty())
+		})
+
 		It("Complex example", func() {
 			const (
 				vmName                       = "my-vm"
@@ -1849,6 +1988,58 @@ chpasswd: { expire: False }`
 			Entry("User with type password and method ga (explicit)", "type:password,src:my-src,method:ga,user:myuser", userNotAllowedError),
 		)
 
+		DescribeTable("Invalid parameters to NetworkFlag", func(errMsg string, flags ...string) {
+			out, err := runCmd(flags...)
+			Expect(err).To(MatchError("failed to parse \"--network\" flag: " + errMsg))
+			Expect(out).To(BeEmpty())
+		},
+			Entry("Unknown param", paramsUnknownError, setFlag(NetworkFlag, "test:test")),
+			Entry("Missing network", "network must be specified", setFlag(NetworkFlag, "name:my-net")),
+			Entry("Invalid name", nameDotsError, setFlag(NetworkFlag, "name:name.with.dot,network:my-net")),
+			Entry("Invalid binding", "invalid binding \"madeup\", supported values are: bridge, sriov", setFlag(NetworkFlag, "network:my-net,binding:madeup")),
+			Entry("Duplicate name", "there is already a network with name \"my-net\"", setFlag(NetworkFlag, "network:my-net"), setFlag(NetworkFlag, "network:other/my-net")),
+			Entry("Name of the pod network", "there is already a network with name \"default\"", setFlag(NetworkFlag, "network:default")),
+		)
+
+		DescribeTable("Invalid parameters to GPUFlag and HostDeviceFlag", func(flag, params, errMsg string) {
+			out, err := runCmd(setFlag(flag, params))
+			Expect(err).To(MatchError(fmt.Sprintf("failed to parse \"--%s\" flag: %s", flag, errMsg)))
+			Expect(out).To(BeEmpty())
+		},
+			Entry("Missing devicename of gpu", GPUFlag, "name:my-gpu", "devicename must be specified"),
+			Entry("Invalid name of gpu", GPUFlag, "name:NOTALLOWED,devicename:vendor.com/gpu", nameUpperCaseError),
+			Entry("Missing devicename of host device", HostDeviceFlag, "name:my-dev", "devicename must be specified"),
+			Entry("Unknown param of host device", HostDeviceFlag, "test:test", paramsUnknownError),
+		)
+
+		DescribeTable("Invalid parameters to TolerationFlag", func(params, errMsg string) {
+			out, err := runCmd(setFlag(TolerationFlag, params))
+			Expect(err).To(MatchError("failed to parse \"--toleration\" flag: " + errMsg))
+			Expect(out).To(BeEmpty())
+		},
+			Entry("Invalid operator", "key:k,operator:madeup", "invalid operator \"madeup\", supported values are: Equal, Exists"),
+			Entry("Value with operator Exists", "key:k,value:v,operator:Exists", "value must be empty with operator Exists"),
+			Entry("Missing key with operator Equal", "value:v", "key must be specified with operator Equal"),
+			Entry("Invalid effect", "key:k,effect:madeup", "invalid effect \"madeup\", supported values are: NoSchedule, PreferNoSchedule, NoExecute"),
+		)
+
+		DescribeTable("Invalid parameters to NodeAffinityFlag", func(params, errMsg string) {
+			out, err := runCmd(setFlag(NodeAffinityFlag, params))
+			Expect(err).To(MatchError("failed to parse \"--node-affinity\" flag: " + errMsg))
+			Expect(out).To(BeEmpty())
+		},
+			Entry("Missing key", "values:a", "key must be specified"),
+			Entry("Invalid operator", "key:k,operator:madeup", "invalid operator \"madeup\", supported values are: In, NotIn, Exists, DoesNotExist"),
+			Entry("Missing values with operator In", "key:k", "values must be specified with operator In"),
+			Entry("Values with operator Exists", "key:k,operator:Exists,values:a", "values must be empty with operator Exists"),
+		)
+
+		It("Invalid answer in interactive mode", func() {
+			out, err := runInteractiveCmd("my-vm\nAlways\n\nnot-a-quantity\n")
+			Expect(err).To(MatchError(ContainSubstring("failed to parse \"--memory\" flag")))
+			Expect(out).To(BeEmpty())
+		})
+
 		DescribeTable("Cloud-init source type mismatch with AccessCredFlag", func(params, cloudInit, errMsg string) {
 			out, err := runCmd(
 				setFlag(AccessCredFlag, params),
@@ -1878,6 +2069,17 @@ func runCmd(extraArgs ...string) ([]byte, error) {
 	return testing.NewRepeatableVirtctlCommandWithOut(args...)()
 }
 
+func runInteractiveCmd(answers string, extraArgs ...string) ([]byte, error) {
+	out := &bytes.Buffer{}
+	cmd := virtctl.NewVirtctlCommand()
+	cmd.SetArgs(append([]string{create.CREATE, "vm", "--" + InteractiveFlag}, extraArgs...))
+	cmd.SetIn(strings.NewReader(answers))
+	cmd.SetOut(out)
+	cmd.SetErr(io.Discard)
+	err := cmd.Execute()
+	return out.Bytes(), err
+}
+
 func decodeVM(bytes []byte) (*v1.VirtualMachine, error) {
 	decoded, err := runtime.Decode(generatedscheme.Codecs.UniversalDeserializer(), bytes)
 	if err != nil {
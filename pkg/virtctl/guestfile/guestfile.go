@@ -0,0 +1,143 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestfile
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+// stdio is the local path reading from stdin or writing to stdout
+const stdio = "-"
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "guestfile",
+		Short: "Transfer small files to and from a virtual machine instance through the guest agent.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Print(cmd.UsageString())
+		},
+	}
+
+	cmd.AddCommand(newCopyCommand())
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newCopyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cp (VMI):(PATH) (LOCALPATH) | (LOCALPATH) (VMI):(PATH)",
+		Short:   "Copy a file to or from a virtual machine instance through the guest agent.",
+		Long:    fmt.Sprintf("Copy a file of at most %d bytes to or from a virtual machine instance through the guest agent. Requires the GuestFileTransfer feature gate.", v1.GuestFileMaxSize),
+		Example: usage(),
+		Args:    cobra.ExactArgs(2),
+		RunE:    copyRun,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # Copy /etc/hosts of the virtual machine instance 'myvmi' to the local file 'hosts':
+  {{ProgramName}} guestfile cp myvmi:/etc/hosts hosts
+
+  # Copy the local file 'motd' to /etc/motd in the virtual machine instance 'myvmi':
+  {{ProgramName}} guestfile cp motd myvmi:/etc/motd
+
+  # Print /etc/os-release of the virtual machine instance 'myvmi':
+  {{ProgramName}} guestfile cp myvmi:/etc/os-release -`
+}
+
+func copyRun(cmd *cobra.Command, args []string) error {
+	srcVMI, srcPath, srcRemote := parseTarget(args[0])
+	dstVMI, dstPath, dstRemote := parseTarget(args[1])
+	if srcRemote == dstRemote {
+		return fmt.Errorf("exactly one of source and destination must be a path in a virtual machine instance, like myvmi:/etc/hosts")
+	}
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	if srcRemote {
+		return copyFromGuest(cmd, virtClient, namespace, srcVMI, srcPath, dstPath)
+	}
+	return copyToGuest(cmd, virtClient, namespace, srcPath, dstVMI, dstPath)
+}
+
+func copyFromGuest(cmd *cobra.Command, virtClient kubecli.KubevirtClient, namespace, vmiName, guestPath, localPath string) error {
+	guestFile, err := virtClient.VirtualMachineInstance(namespace).GuestFileRead(context.Background(), vmiName, &v1.GuestFileReadOptions{Path: guestPath})
+	if err != nil {
+		return fmt.Errorf("Error reading file %s of VirtualMachineInstance %s, %v", guestPath, vmiName, err)
+	}
+
+	if localPath == stdio {
+		_, err = cmd.OutOrStdout().Write(guestFile.Content)
+		return err
+	}
+	return os.WriteFile(localPath, guestFile.Content, 0o644)
+}
+
+func copyToGuest(cmd *cobra.Command, virtClient kubecli.KubevirtClient, namespace, localPath, vmiName, guestPath string) error {
+	var content []byte
+	var err error
+	if localPath == stdio {
+		content, err = io.ReadAll(io.LimitReader(cmd.InOrStdin(), v1.GuestFileMaxSize+1))
+	} else {
+		content, err = os.ReadFile(localPath)
+	}
+	if err != nil {
+		return err
+	}
+	if len(content) > v1.GuestFileMaxSize {
+		return fmt.Errorf("%s is larger than %d bytes", localPath, v1.GuestFileMaxSize)
+	}
+
+	guestFile := &v1.GuestFile{
+		Path:    guestPath,
+		Content: content,
+	}
+	if err := virtClient.VirtualMachineInstance(namespace).GuestFileWrite(context.Background(), vmiName, guestFile); err != nil {
+		return fmt.Errorf("Error writing file %s of VirtualMachineInstance %s, %v", guestPath, vmiName, err)
+	}
+	return nil
+}
+
+// parseTarget splits VMI:PATH into the VMI name and the path in the guest, anything else is a local path
+func parseTarget(target string) (string, string, bool) {
+	idx := strings.Index(target, ":")
+	if idx <= 0 || strings.Contains(target[:idx], "/") {
+		return "", target, false
+	}
+	return target[:idx], target[idx+1:], true
+}
@@ -0,0 +1,106 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestfile_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Guest file command", func() {
+	const vmiName = "testvmi"
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().
+			VirtualMachineInstance(k8smetav1.NamespaceDefault).
+			Return(vmiInterface).
+			AnyTimes()
+	})
+
+	It("should copy a file from the guest", func() {
+		localPath := filepath.Join(GinkgoT().TempDir(), "hosts")
+		vmiInterface.EXPECT().GuestFileRead(context.Background(), vmiName, &v1.GuestFileReadOptions{Path: "/etc/hosts"}).
+			Return(v1.GuestFile{Path: "/etc/hosts", Content: []byte("127.0.0.1 localhost\n")}, nil)
+
+		cmd := testing.NewRepeatableVirtctlCommand("guestfile", "cp", vmiName+":/etc/hosts", localPath)
+		Expect(cmd()).To(Succeed())
+		Expect(os.ReadFile(localPath)).To(Equal([]byte("127.0.0.1 localhost\n")))
+	})
+
+	It("should print a file from the guest", func() {
+		vmiInterface.EXPECT().GuestFileRead(context.Background(), vmiName, &v1.GuestFileReadOptions{Path: "/etc/hostname"}).
+			Return(v1.GuestFile{Path: "/etc/hostname", Content: []byte("testvmi\n")}, nil)
+
+		cmd := testing.NewRepeatableVirtctlCommandWithOut("guestfile", "cp", vmiName+":/etc/hostname", "-")
+		Expect(cmd()).To(Equal([]byte("testvmi\n")))
+	})
+
+	It("should copy a file to the guest", func() {
+		localPath := filepath.Join(GinkgoT().TempDir(), "motd")
+		Expect(os.WriteFile(localPath, []byte("hello"), 0o644)).To(Succeed())
+		vmiInterface.EXPECT().GuestFileWrite(context.Background(), vmiName, &v1.GuestFile{Path: "/etc/motd", Content: []byte("hello")}).
+			Return(nil)
+
+		cmd := testing.NewRepeatableVirtctlCommand("guestfile", "cp", localPath, vmiName+":/etc/motd")
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should fail to copy a file larger than the limit to the guest", func() {
+		localPath := filepath.Join(GinkgoT().TempDir(), "large")
+		Expect(os.WriteFile(localPath, make([]byte, v1.GuestFileMaxSize+1), 0o644)).To(Succeed())
+
+		cmd := testing.NewRepeatableVirtctlCommand("guestfile", "cp", localPath, vmiName+":/tmp/large")
+		Expect(cmd()).To(MatchError(fmt.Sprintf("%s is larger than %d bytes", localPath, v1.GuestFileMaxSize)))
+	})
+
+	It("should fail when the guest file cannot be read", func() {
+		vmiInterface.EXPECT().GuestFileRead(context.Background(), vmiName, &v1.GuestFileReadOptions{Path: "/etc/shadow"}).
+			Return(v1.GuestFile{}, fmt.Errorf("permission denied"))
+
+		cmd := testing.NewRepeatableVirtctlCommand("guestfile", "cp", vmiName+":/etc/shadow", "-")
+		Expect(cmd()).To(MatchError("Error reading file /etc/shadow of VirtualMachineInstance testvmi, permission denied"))
+	})
+
+	DescribeTable("should fail unless exactly one side is in the guest", func(src, dst string) {
+		cmd := testing.NewRepeatableVirtctlCommand("guestfile", "cp", src, dst)
+		Expect(cmd()).To(MatchError(ContainSubstring("exactly one of source and destination")))
+	},
+		Entry("with two local paths", "./a", "b"),
+		Entry("with two guest paths", vmiName+":/a", vmiName+":/b"),
+	)
+})
@@ -22,6 +22,7 @@ package memorydump
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 
 	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
@@ -51,6 +53,10 @@ const (
 	FormatFlag       = "format"
 	LocalPortFlag    = "local-port"
 	OutputFileFlag   = "output"
+	DumpFormatFlag   = "dump-format"
+	ContentFlag      = "content"
+
+	stdoutOutput = "-"
 
 	configName         = "config"
 	filesystemOverhead = v1.Percent("0.055")
@@ -69,6 +75,8 @@ var (
 	storageClass string
 	accessMode   string
 	outputFile   string
+	dumpFormat   string
+	content      string
 )
 
 type command struct{}
@@ -94,6 +102,15 @@ func usageMemoryDump() string {
 
   #Remove the association of the memory dump pvc (to be able to dump to another pvc).
   {{ProgramName}} memory-dump remove myvm
+
+  #Dump the memory used by the guest kernel of 'myvm' to an existing pvc called 'memoryvolume'.
+  {{ProgramName}} memory-dump get myvm --claim-name=memoryvolume --content=Kernel
+
+  #Stream a zlib compressed kdump of 'myvm' to the given output file, without a pvc.
+  {{ProgramName}} memory-dump stream myvm --dump-format=KdumpZlib --output=memoryDump.kdump
+
+  #Stream a memory dump of 'myvm' to stdout, to upload it to an object store.
+  {{ProgramName}} memory-dump stream myvm --output=- | aws s3 cp - s3://dumps/myvm.dump
   `
 	return usage
 }
@@ -102,8 +119,8 @@ func usageMemoryDump() string {
 func NewMemoryDumpCommand() *cobra.Command {
 	c := command{}
 	cmd := &cobra.Command{
-		Use:     "memory-dump get/download/remove (VM)",
-		Short:   "Dump the memory of a running VM to a pvc",
+		Use:     "memory-dump get/download/remove/stream (VM)",
+		Short:   "Dump the memory of a running VM to a pvc, or stream it",
 		Example: usageMemoryDump(),
 		Args:    cobra.ExactArgs(2),
 		RunE:    c.run,
@@ -116,7 +133,9 @@ func NewMemoryDumpCommand() *cobra.Command {
 	cmd.Flags().StringVar(&localPort, LocalPortFlag, "0", "Specify port for port-forward")
 	cmd.Flags().StringVar(&storageClass, StorageClassFlag, "", "The storage class for the PVC.")
 	cmd.Flags().StringVar(&accessMode, AccessModeFlag, "", "The access mode for the PVC.")
-	cmd.Flags().StringVar(&outputFile, OutputFileFlag, "", "Specifies the output path of the memory dump to be downloaded.")
+	cmd.Flags().StringVar(&outputFile, OutputFileFlag, "", "Specifies the output path of the memory dump to be downloaded or streamed, '-' streams to stdout.")
+	cmd.Flags().StringVar(&dumpFormat, DumpFormatFlag, "", "The format of the memory dump: Raw, KdumpZlib, KdumpLzo or KdumpSnappy. Defaults to Raw.")
+	cmd.Flags().StringVar(&content, ContentFlag, "", "The part of the guest memory which is dumped: Full or Kernel, which requires the Raw format. Defaults to Full.")
 
 	return cmd
 }
@@ -135,6 +154,8 @@ func (c *command) run(cmd *cobra.Command, args []string) error {
 		return downloadMemoryDump(namespace, vmName, virtClient)
 	case "remove":
 		return removeMemoryDump(namespace, vmName, virtClient)
+	case "stream":
+		return streamMemoryDump(namespace, vmName, virtClient)
 	default:
 		return fmt.Errorf("invalid action type %s", args[0])
 	}
@@ -265,6 +286,8 @@ func createPVCforMemoryDump(namespace, vmName, claimName string, virtClient kube
 func createMemoryDump(namespace, vmName, claimName string, virtClient kubecli.KubevirtClient) error {
 	memoryDumpRequest := &v1.VirtualMachineMemoryDumpRequest{
 		ClaimName: claimName,
+		Format:    v1.MemoryDumpFormat(dumpFormat),
+		Content:   v1.MemoryDumpContent(content),
 	}
 
 	err := virtClient.VirtualMachine(namespace).MemoryDump(context.Background(), vmName, memoryDumpRequest)
@@ -383,6 +406,42 @@ func removeMemoryDump(namespace, vmName string, virtClient kubecli.KubevirtClien
 	return nil
 }
 
+// streamMemoryDump streams a memory dump of the running VM to the output file, the dump is not kept in a pvc
+func streamMemoryDump(namespace, vmName string, virtClient kubecli.KubevirtClient) error {
+	if outputFile == "" {
+		return fmt.Errorf("missing outputFile to stream the memory dump")
+	}
+
+	options := &v1.MemoryDumpStreamOptions{
+		Format:  v1.MemoryDumpFormat(dumpFormat),
+		Content: v1.MemoryDumpContent(content),
+	}
+	stream, err := virtClient.VirtualMachineInstance(namespace).MemoryDumpStream(vmName, options)
+	if err != nil {
+		return fmt.Errorf("error streaming vm memory, %v", err)
+	}
+
+	output := io.Writer(os.Stdout)
+	if outputFile != stdoutOutput {
+		file, err := os.Create(outputFile)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		output = file
+	}
+
+	// Nothing is sent to the VM, the input is kept open until the whole dump is received
+	input, _ := io.Pipe()
+	if err := stream.Stream(kvcorev1.StreamOptions{In: input, Out: output}); err != nil {
+		return fmt.Errorf("error streaming vm memory, %v", err)
+	}
+	if outputFile != stdoutOutput {
+		fmt.Printf("Successfully streamed memory dump of VM %s to %s\n", vmName, outputFile)
+	}
+	return nil
+}
+
 func getVMExportName(vmName, claimName string) string {
 	return fmt.Sprintf("export-%s-%s", vmName, claimName)
 }
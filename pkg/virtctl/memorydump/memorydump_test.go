@@ -155,6 +155,7 @@ var _ = Describe("MemoryDump", func() {
 		Entry("memorydump wrong action arg", "invalid action type create", "create", vmName),
 		Entry("memorydump name, invalid extra parameter", "unknown flag", "testvm", setFlag(memorydump.ClaimNameFlag, pvcName), "--invalid=test"),
 		Entry("memorydump download missing outputFile", "missing outputFile", "download", "testvm", setFlag(memorydump.ClaimNameFlag, pvcName)),
+		Entry("memorydump stream missing outputFile", "missing outputFile", "stream", "testvm", setFlag(memorydump.DumpFormatFlag, string(v1.MemoryDumpFormatKdumpZlib))),
 	)
 
 	It("should call memory dump subresource", func() {
@@ -166,6 +167,22 @@ var _ = Describe("MemoryDump", func() {
 		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "memorydump")).To(HaveLen(1))
 	})
 
+	It("should call memory dump subresource with dump format and content", func() {
+		virtClient.PrependReactor("put", "virtualmachines/memorydump", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+			request := action.(kvtesting.PutAction[*v1.VirtualMachineMemoryDumpRequest]).GetOptions()
+			Expect(request.Format).To(Equal(v1.MemoryDumpFormatRaw))
+			Expect(request.Content).To(Equal(v1.MemoryDumpContentKernel))
+			return true, nil, nil
+		})
+		err := runGetCmd(
+			setFlag(memorydump.ClaimNameFlag, pvcName),
+			setFlag(memorydump.DumpFormatFlag, string(v1.MemoryDumpFormatRaw)),
+			setFlag(memorydump.ContentFlag, string(v1.MemoryDumpContentKernel)),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "memorydump")).To(HaveLen(1))
+	})
+
 	It("should call memory dump subresource without claim-name no create", func() {
 		expectVMEndpointMemoryDump("")
 		Expect(runGetCmd()).To(Succeed())
@@ -0,0 +1,109 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package portforward
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util/net/multiplexer"
+)
+
+// runMultiplexed forwards the TCP ports and the connections of the SOCKS proxy over a single websocket connection
+func (o *PortForward) runMultiplexed(kind, namespace, name string, ports []forwardedPort) error {
+	for _, port := range ports {
+		if port.protocol != protocolTCP {
+			return fmt.Errorf("only TCP ports can be multiplexed, %s/%d is not supported", port.protocol, port.local)
+		}
+	}
+
+	stream, err := o.resource.PortForwardMultiplexed(name)
+	if err != nil {
+		return fmt.Errorf("can't access %s/%s.%s: %v", kind, name, namespace, err)
+	}
+	session := multiplexer.NewClient(stream.AsConn())
+	defer session.Close()
+
+	for _, port := range ports {
+		listener, err := o.listenTCP(port.local)
+		if err != nil {
+			return err
+		}
+		defer listener.Close()
+		log.Log.Infof("forwarding tcp %s:%d to %d", o.address, port.local, port.remote)
+		go acceptMultiplexed(listener, session, port)
+	}
+
+	if socksPort != 0 {
+		listener, err := o.listenTCP(socksPort)
+		if err != nil {
+			return err
+		}
+		defer listener.Close()
+		log.Log.Infof("serving SOCKS5 on %s:%d", o.address, socksPort)
+		go serveSOCKS(listener, session)
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- session.Serve()
+	}()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	select {
+	case <-interrupt:
+		return nil
+	case err := <-errChan:
+		return fmt.Errorf("connection to %s/%s.%s closed: %v", kind, name, namespace, err)
+	}
+}
+
+func (o *PortForward) listenTCP(port int) (net.Listener, error) {
+	return net.ListenTCP(protocolTCP, &net.TCPAddr{
+		IP:   o.address.IP,
+		Zone: o.address.Zone,
+		Port: port,
+	})
+}
+
+func acceptMultiplexed(listener net.Listener, session *multiplexer.Session, port forwardedPort) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			handleConnectionError(err, port)
+			return
+		}
+		log.Log.Infof("opening new multiplexed tcp stream to %d", port.remote)
+		remote, err := session.Open(port.remote)
+		if err != nil {
+			log.Log.Errorf("can't open a stream to %d: %v", port.remote, err)
+			conn.Close()
+			return
+		}
+		go handleConnection(conn, remote, port)
+	}
+}
@@ -39,6 +39,8 @@ import (
 const (
 	forwardToStdioFlag = "stdio"
 	addressFlag        = "address"
+	multiplexFlag      = "multiplex"
+	socksFlag          = "socks"
 
 	vm  = "vm"
 	vmi = "vmi"
@@ -47,6 +49,8 @@ const (
 var (
 	forwardToStdio bool
 	address        string = "127.0.0.1"
+	multiplex      bool
+	socksPort      int
 )
 
 func NewCommand() *cobra.Command {
@@ -58,8 +62,12 @@ func NewCommand() *cobra.Command {
 		Long:    usage(),
 		Example: examples(),
 		Args: func(cmd *cobra.Command, args []string) error {
-			if n := len(args); n < 2 {
-				log.Log.Errorf("fatal: Number of input parameters is incorrect, portforward requires at least 2 arg(s), received %d", n)
+			minArgs := 2
+			if socksPort != 0 {
+				minArgs = 1
+			}
+			if n := len(args); n < minArgs {
+				log.Log.Errorf("fatal: Number of input parameters is incorrect, portforward requires at least %d arg(s), received %d", minArgs, n)
 				// always write to stderr on failures to ensure they get printed in stdio mode
 				cmd.SetOut(os.Stderr)
 				cmd.Help()
@@ -73,6 +81,12 @@ func NewCommand() *cobra.Command {
 		fmt.Sprintf("--%s=true: Set this to true to forward the tunnel to stdout/stdin; Only works with a single port", forwardToStdioFlag))
 	cmd.Flags().StringVar(&address, addressFlag, address,
 		fmt.Sprintf("--%s=: Set this to the address the local ports should be opened on", addressFlag))
+	cmd.Flags().BoolVar(&multiplex, multiplexFlag, multiplex,
+		fmt.Sprintf("--%s=true: Set this to true to forward all TCP ports over a single websocket connection", multiplexFlag))
+	cmd.Flags().IntVar(&socksPort, socksFlag, socksPort,
+		fmt.Sprintf("--%s=1080: Serve a SOCKS5 proxy on the given local port, which forwards connections to any requested port of the target over a single websocket connection; Implies --%s", socksFlag, multiplexFlag))
+	cmd.MarkFlagsMutuallyExclusive(forwardToStdioFlag, multiplexFlag)
+	cmd.MarkFlagsMutuallyExclusive(forwardToStdioFlag, socksFlag)
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -110,6 +124,10 @@ func (o *PortForward) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if multiplex || socksPort != 0 {
+		return o.runMultiplexed(kind, namespace, name, ports)
+	}
+
 	if err := o.startPortForwards(kind, namespace, name, ports); err != nil {
 		return err
 	}
@@ -208,6 +226,10 @@ Protocol supports TCP (default) and UDP.
 
 Portforwards get established over the Kubernetes control-plane using websocket streams.
 Usage can be restricted by the cluster administrator through the /portforward subresource.
+
+By default every connection uses its own websocket stream. With --multiplex the connections to all TCP ports
+share a single websocket stream. --socks serves a SOCKS5 proxy, which forwards the connections to the requested
+port of the target over a single websocket stream, regardless of the requested host.
 `
 }
 
@@ -227,6 +249,12 @@ func examples() string {
   # Forward the local port 8080 to the vm port in mynamespace
   {{ProgramName}} port-forward vm/testvm/mynamespace 8080
 
+  # Forward the local ports 8080 and 2222 to the vmi ports 80 and 22 over a single websocket stream:
+  {{ProgramName}} port-forward --multiplex vmi/testvmi 8080:80 2222:22
+
+  # Serve a SOCKS5 proxy on the local port 1080, forwarding connections to any port of the vmi:
+  {{ProgramName}} port-forward --socks=1080 vmi/testvmi
+
   # Note: {{ProgramName}} port-forward sends all traffic over the Kubernetes API Server. 
   # This means any traffic will add additional pressure to the control plane.
   # For continous traffic intensive connections, consider using a dedicated Kubernetes Service.`
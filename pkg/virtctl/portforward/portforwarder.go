@@ -16,6 +16,7 @@ type portForwarder struct {
 
 type portforwardableResource interface {
 	PortForward(name string, port int, protocol string) (kvcorev1.StreamInterface, error)
+	PortForwardMultiplexed(name string) (kvcorev1.StreamInterface, error)
 }
 
 func (p *portForwarder) startForwarding(address *net.IPAddr, port forwardedPort) error {
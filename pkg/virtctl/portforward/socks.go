@@ -0,0 +1,147 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package portforward
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util/net/multiplexer"
+)
+
+const (
+	socksVersion = 5
+
+	socksMethodNoAuth       = 0
+	socksMethodNoAcceptable = 0xff
+
+	socksCommandConnect = 1
+
+	socksAddressIPv4   = 1
+	socksAddressDomain = 3
+	socksAddressIPv6   = 4
+
+	socksReplySucceeded           = 0
+	socksReplyCommandNotSupported = 7
+	socksReplyAddressNotSupported = 8
+)
+
+// serveSOCKS serves a SOCKS5 proxy, which forwards CONNECT requests to the requested port of the
+// target. The requested host is ignored, all connections go to the target.
+func serveSOCKS(listener net.Listener, session *multiplexer.Session) {
+	local := listener.Addr().(*net.TCPAddr).Port
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			handleConnectionError(err, forwardedPort{local: local})
+			return
+		}
+		go handleSOCKSConnection(conn, session, local)
+	}
+}
+
+func handleSOCKSConnection(conn net.Conn, session *multiplexer.Session, local int) {
+	port, err := socksHandshake(conn)
+	if err != nil {
+		log.Log.Errorf("SOCKS handshake failed: %v", err)
+		conn.Close()
+		return
+	}
+	log.Log.Infof("opening new SOCKS tcp stream to %d", port)
+	remote, err := session.Open(port)
+	if err != nil {
+		log.Log.Errorf("can't open a stream to %d: %v", port, err)
+		conn.Close()
+		return
+	}
+	handleConnection(conn, remote, forwardedPort{local: local, remote: port, protocol: protocolTCP})
+}
+
+// socksHandshake accepts a CONNECT request without authentication and returns the requested port
+func socksHandshake(conn io.ReadWriter) (int, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return 0, err
+	}
+	if header[0] != socksVersion {
+		return 0, fmt.Errorf("unsupported SOCKS version %d", header[0])
+	}
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return 0, err
+	}
+	if !slices.Contains(methods, socksMethodNoAuth) {
+		conn.Write([]byte{socksVersion, socksMethodNoAcceptable})
+		return 0, errors.New("only SOCKS without authentication is supported")
+	}
+	if _, err := conn.Write([]byte{socksVersion, socksMethodNoAuth}); err != nil {
+		return 0, err
+	}
+
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return 0, err
+	}
+	if request[0] != socksVersion {
+		return 0, fmt.Errorf("unsupported SOCKS version %d", request[0])
+	}
+
+	var addressLength int
+	switch request[3] {
+	case socksAddressIPv4:
+		addressLength = net.IPv4len
+	case socksAddressIPv6:
+		addressLength = net.IPv6len
+	case socksAddressDomain:
+		length := make([]byte, 1)
+		if _, err := io.ReadFull(conn, length); err != nil {
+			return 0, err
+		}
+		addressLength = int(length[0])
+	default:
+		socksReply(conn, socksReplyAddressNotSupported)
+		return 0, fmt.Errorf("unsupported SOCKS address type %d", request[3])
+	}
+	// The address and the port
+	destination := make([]byte, addressLength+2)
+	if _, err := io.ReadFull(conn, destination); err != nil {
+		return 0, err
+	}
+
+	if request[1] != socksCommandConnect {
+		socksReply(conn, socksReplyCommandNotSupported)
+		return 0, fmt.Errorf("unsupported SOCKS command %d", request[1])
+	}
+	if err := socksReply(conn, socksReplySucceeded); err != nil {
+		return 0, err
+	}
+	return int(destination[addressLength])<<8 | int(destination[addressLength+1]), nil
+}
+
+// socksReply replies to the request, the bound address is not known and left empty
+func socksReply(conn io.Writer, reply byte) error {
+	_, err := conn.Write([]byte{socksVersion, reply, 0, socksAddressIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
@@ -0,0 +1,95 @@
+package portforward
+
+import (
+	"fmt"
+	"io"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/util/net/multiplexer"
+)
+
+var _ = Describe("SOCKS", func() {
+	handshake := func(request []byte) (port int, response []byte, err error) {
+		client, server := net.Pipe()
+		defer client.Close()
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			defer server.Close()
+			port, err = socksHandshake(server)
+		}()
+		go client.Write(request)
+		response, _ = io.ReadAll(client)
+		<-done
+		return
+	}
+
+	DescribeTable("should accept CONNECT requests", func(address []byte) {
+		request := append([]byte{socksVersion, 1, socksMethodNoAuth, socksVersion, socksCommandConnect, 0}, address...)
+		port, response, err := handshake(append(request, 0x1f, 0x90))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(port).To(Equal(8080))
+		Expect(response).To(Equal([]byte{socksVersion, socksMethodNoAuth, socksVersion, socksReplySucceeded, 0, socksAddressIPv4, 0, 0, 0, 0, 0, 0}))
+	},
+		Entry("with an IPv4 address", []byte{socksAddressIPv4, 10, 0, 0, 1}),
+		Entry("with an IPv6 address", append([]byte{socksAddressIPv6}, net.IPv6loopback...)),
+		Entry("with a domain name", append([]byte{socksAddressDomain, 7}, []byte("testvmi")...)),
+	)
+
+	It("should refuse authentication", func() {
+		_, response, err := handshake([]byte{socksVersion, 1, 2})
+		Expect(err).To(MatchError("only SOCKS without authentication is supported"))
+		Expect(response).To(Equal([]byte{socksVersion, socksMethodNoAcceptable}))
+	})
+
+	It("should refuse other commands than CONNECT", func() {
+		_, response, err := handshake([]byte{socksVersion, 1, socksMethodNoAuth, socksVersion, 2, 0, socksAddressIPv4, 10, 0, 0, 1, 0, 80})
+		Expect(err).To(MatchError("unsupported SOCKS command 2"))
+		Expect(response[3]).To(Equal(byte(socksReplyCommandNotSupported)))
+	})
+
+	It("should refuse SOCKS4", func() {
+		_, _, err := handshake([]byte{4, 1, 0, 80, 10, 0, 0, 1, 0})
+		Expect(err).To(MatchError("unsupported SOCKS version 4"))
+	})
+
+	It("should forward connections to the requested port over the multiplexed connection", func() {
+		clientConn, serverConn := net.Pipe()
+		session := multiplexer.NewClient(clientConn)
+		server := multiplexer.NewServer(serverConn, func(port int) (net.Conn, error) {
+			local, remote := net.Pipe()
+			go func() {
+				defer remote.Close()
+				fmt.Fprintf(remote, "hello from %d", port)
+			}()
+			return local, nil
+		})
+		go session.Serve()
+		go server.Serve()
+		DeferCleanup(session.Close)
+		DeferCleanup(server.Close)
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(listener.Close)
+		go serveSOCKS(listener, session)
+
+		conn, err := net.Dial("tcp", listener.Addr().String())
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+		_, err = conn.Write([]byte{socksVersion, 1, socksMethodNoAuth, socksVersion, socksCommandConnect, 0, socksAddressDomain, 7, 't', 'e', 's', 't', 'v', 'm', 'i', 0, 22})
+		Expect(err).ToNot(HaveOccurred())
+
+		response := make([]byte, 12)
+		_, err = io.ReadFull(conn, response)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response[3]).To(Equal(byte(socksReplySucceeded)))
+
+		data, err := io.ReadAll(conn)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal("hello from 22"))
+	})
+})
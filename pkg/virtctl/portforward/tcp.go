@@ -37,13 +37,13 @@ func (p *portForwarder) waitForConnection(listener net.Listener, port forwardedP
 			log.Log.Errorf("can't access %s/%s.%s: %v", p.kind, p.name, p.namespace, err)
 			return
 		}
-		go p.handleConnection(conn, stream.AsConn(), port)
+		go handleConnection(conn, stream.AsConn(), port)
 	}
 }
 
 // handleConnection copies data between the local connection and the stream to
 // the remote server.
-func (p *portForwarder) handleConnection(local, remote net.Conn, port forwardedPort) {
+func handleConnection(local, remote net.Conn, port forwardedPort) {
 	log.Log.Infof("handling tcp connection for %d", port.local)
 	errs := make(chan error)
 	go func() {
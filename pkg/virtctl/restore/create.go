@@ -0,0 +1,179 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package restore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+
+	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	nameArg     = "name"
+	snapshotArg = "snapshot"
+	volumesArg  = "volumes"
+	waitArg     = "wait"
+	timeoutArg  = "timeout"
+
+	defaultTimeout = 5 * time.Minute
+	pollInterval   = 2 * time.Second
+)
+
+type createCommand struct {
+	name         string
+	snapshotName string
+	volumes      []string
+	wait         bool
+	timeout      time.Duration
+}
+
+func newCreateCommand() *cobra.Command {
+	c := createCommand{}
+	cmd := &cobra.Command{
+		Use:     "create (VM)",
+		Short:   "Create a VirtualMachineRestore restoring a virtual machine from one of its snapshots.",
+		Example: usageCreate(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.run,
+	}
+	cmd.Flags().StringVar(&c.name, nameArg, "", "Name of the VirtualMachineRestore, generated from the virtual machine name if not set")
+	cmd.Flags().StringVar(&c.snapshotName, snapshotArg, "", "Name of the VirtualMachineSnapshot to restore the virtual machine from")
+	cmd.MarkFlagRequired(snapshotArg)
+	cmd.Flags().StringSliceVar(&c.volumes, volumesArg, nil, "Comma separated list of the volumes to restore, all volumes of the snapshot are restored if not set")
+	cmd.Flags().BoolVar(&c.wait, waitArg, false, "If set, wait for the restore to complete")
+	cmd.Flags().DurationVar(&c.timeout, timeoutArg, defaultTimeout, "How long to wait for the restore to complete")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageCreate() string {
+	return `  # Restore the virtual machine 'myvm' from the snapshot 'mysnapshot':
+  {{ProgramName}} restore create myvm --snapshot=mysnapshot
+
+  # Restore only the volumes 'rootdisk' and 'datadisk' of the virtual machine 'myvm' and wait for the restore to complete:
+  {{ProgramName}} restore create myvm --snapshot=mysnapshot --volumes=rootdisk,datadisk --wait`
+}
+
+func (c *createCommand) run(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	restore := c.newRestore(vmName)
+	restore, err = virtClient.VirtualMachineRestore(namespace).Create(cmd.Context(), restore, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error creating VirtualMachineRestore of VirtualMachine %s: %v", vmName, err)
+	}
+
+	cmd.Printf("VirtualMachineRestore %s of VirtualMachine %s from snapshot %s was created\n", restore.Name, vmName, c.snapshotName)
+
+	if !c.wait {
+		return nil
+	}
+
+	return waitForRestoreComplete(cmd, virtClient, namespace, restore.Name, c.timeout)
+}
+
+func (c *createCommand) newRestore(vmName string) *snapshotv1.VirtualMachineRestore {
+	restore := &snapshotv1.VirtualMachineRestore{
+		Spec: snapshotv1.VirtualMachineRestoreSpec{
+			Target: corev1.TypedLocalObjectReference{
+				APIGroup: &v1.SchemeGroupVersion.Group,
+				Kind:     v1.VirtualMachineGroupVersionKind.Kind,
+				Name:     vmName,
+			},
+			VirtualMachineSnapshotName: c.snapshotName,
+			Volumes:                    c.volumes,
+		},
+	}
+
+	if c.name != "" {
+		restore.Name = c.name
+	} else {
+		restore.GenerateName = fmt.Sprintf("%s-restore-", vmName)
+	}
+
+	return restore
+}
+
+func waitForRestoreComplete(cmd *cobra.Command, virtClient kubecli.KubevirtClient, namespace, name string, timeout time.Duration) error {
+	err := virtwait.PollImmediately(pollInterval, timeout, func(ctx context.Context) (bool, error) {
+		restore, err := virtClient.VirtualMachineRestore(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		if restore.Status == nil {
+			cmd.Printf("Waiting for VirtualMachineRestore %s to be processed...\n", name)
+			return false, nil
+		}
+
+		if cond := findCondition(restore.Status.Conditions, snapshotv1.ConditionFailure); cond != nil && cond.Status == corev1.ConditionTrue {
+			return false, fmt.Errorf("VirtualMachineRestore %s failed: %s", name, cond.Reason)
+		}
+
+		if restore.Status.Complete == nil || !*restore.Status.Complete {
+			cmd.Printf("Waiting for VirtualMachineRestore %s to complete%s...\n", name, restoreProgress(restore))
+			return false, nil
+		}
+
+		cmd.Printf("VirtualMachineRestore %s completed\n", name)
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for VirtualMachineRestore %s: %v", name, err)
+	}
+
+	return nil
+}
+
+func restoreProgress(restore *snapshotv1.VirtualMachineRestore) string {
+	cond := findCondition(restore.Status.Conditions, snapshotv1.ConditionProgressing)
+	if cond == nil || cond.Reason == "" {
+		return ""
+	}
+	return fmt.Sprintf(", current state: %s", strings.TrimSuffix(cond.Reason, "."))
+}
+
+func findCondition(conditions []snapshotv1.Condition, conditionType snapshotv1.ConditionType) *snapshotv1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
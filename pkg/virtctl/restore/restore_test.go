@@ -0,0 +1,177 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package restore_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Restore subcommands", func() {
+	const (
+		vmName       = "testvm"
+		snapshotName = "testsnapshot"
+		restoreName  = "testrestore"
+	)
+
+	var virtClient *kubevirtfake.Clientset
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		virtClient = kubevirtfake.NewSimpleClientset()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineRestore(metav1.NamespaceDefault).
+			Return(virtClient.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault)).AnyTimes()
+	})
+
+	newRestore := func(complete bool, conditions ...snapshotv1.Condition) *snapshotv1.VirtualMachineRestore {
+		return &snapshotv1.VirtualMachineRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      restoreName,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: snapshotv1.VirtualMachineRestoreSpec{
+				Target: corev1.TypedLocalObjectReference{
+					APIGroup: &v1.SchemeGroupVersion.Group,
+					Kind:     v1.VirtualMachineGroupVersionKind.Kind,
+					Name:     vmName,
+				},
+				VirtualMachineSnapshotName: snapshotName,
+			},
+			Status: &snapshotv1.VirtualMachineRestoreStatus{
+				Complete:   pointer.P(complete),
+				Conditions: conditions,
+			},
+		}
+	}
+
+	getRestore := func() *snapshotv1.VirtualMachineRestore {
+		restore, err := virtClient.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault).Get(context.Background(), restoreName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return restore
+	}
+
+	Context("create", func() {
+		It("should fail without a snapshot", func() {
+			cmd := testing.NewRepeatableVirtctlCommand("restore", "create", vmName)
+			Expect(cmd()).To(MatchError(ContainSubstring(`required flag(s) "snapshot" not set`)))
+		})
+
+		It("should create a restore of the virtual machine", func() {
+			cmd := testing.NewRepeatableVirtctlCommand("restore", "create", vmName, "--snapshot", snapshotName, "--name", restoreName)
+			Expect(cmd()).To(Succeed())
+
+			restore := getRestore()
+			Expect(restore.Spec.Target.Kind).To(Equal(v1.VirtualMachineGroupVersionKind.Kind))
+			Expect(restore.Spec.Target.Name).To(Equal(vmName))
+			Expect(restore.Spec.VirtualMachineSnapshotName).To(Equal(snapshotName))
+			Expect(restore.Spec.Volumes).To(BeEmpty())
+		})
+
+		It("should generate the name of the restore if not set", func() {
+			virtClient.PrependReactor("create", "virtualmachinerestores", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				restore := action.(k8stesting.CreateAction).GetObject().(*snapshotv1.VirtualMachineRestore)
+				Expect(restore.Name).To(BeEmpty())
+				Expect(restore.GenerateName).To(Equal(vmName + "-restore-"))
+				return true, restore, nil
+			})
+
+			cmd := testing.NewRepeatableVirtctlCommand("restore", "create", vmName, "--snapshot", snapshotName)
+			Expect(cmd()).To(Succeed())
+		})
+
+		It("should restrict the restore to the selected volumes", func() {
+			cmd := testing.NewRepeatableVirtctlCommand("restore", "create", vmName,
+				"--snapshot", snapshotName,
+				"--name", restoreName,
+				"--volumes", "rootdisk,datadisk",
+			)
+			Expect(cmd()).To(Succeed())
+
+			Expect(getRestore().Spec.Volumes).To(Equal([]string{"rootdisk", "datadisk"}))
+		})
+
+		It("should wait for the restore to complete", func() {
+			virtClient.PrependReactor("get", "virtualmachinerestores", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, newRestore(true), nil
+			})
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("restore", "create", vmName, "--snapshot", snapshotName, "--name", restoreName, "--wait")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("VirtualMachineRestore testrestore completed"))
+		})
+
+		It("should fail waiting for a failed restore", func() {
+			virtClient.PrependReactor("get", "virtualmachinerestores", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, newRestore(false, snapshotv1.Condition{
+					Type:   snapshotv1.ConditionFailure,
+					Status: corev1.ConditionTrue,
+					Reason: "snapshot not ready",
+				}), nil
+			})
+
+			cmd := testing.NewRepeatableVirtctlCommand("restore", "create", vmName, "--snapshot", snapshotName, "--name", restoreName, "--wait")
+			Expect(cmd()).To(MatchError(ContainSubstring("VirtualMachineRestore testrestore failed: snapshot not ready")))
+		})
+	})
+
+	Context("status", func() {
+		It("should show the status of the restore", func() {
+			restore := newRestore(true, snapshotv1.Condition{
+				Type:   snapshotv1.ConditionReady,
+				Status: corev1.ConditionTrue,
+				Reason: "Operation complete",
+			})
+			restore.Spec.Volumes = []string{"rootdisk"}
+			_, err := virtClient.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault).Create(context.Background(), restore, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("restore", "status", restoreName)()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("Target:    VirtualMachine/testvm"))
+			Expect(string(out)).To(ContainSubstring("Snapshot:  testsnapshot"))
+			Expect(string(out)).To(ContainSubstring("Volumes:   rootdisk"))
+			Expect(string(out)).To(ContainSubstring("Complete:  true"))
+			Expect(string(out)).To(ContainSubstring("Condition: Ready=True Operation complete"))
+		})
+
+		It("should fail for a missing restore", func() {
+			cmd := testing.NewRepeatableVirtctlCommand("restore", "status", restoreName)
+			Expect(cmd()).To(MatchError(ContainSubstring("error getting VirtualMachineRestore testrestore")))
+		})
+	})
+})
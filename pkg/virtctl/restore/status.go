@@ -0,0 +1,86 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package restore
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+func newStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "status (RESTORE)",
+		Short:   "Show the status of a VirtualMachineRestore.",
+		Example: usageStatus(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    runStatus,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageStatus() string {
+	return `  # Show the status of the restore 'myrestore':
+  {{ProgramName}} restore status myrestore`
+}
+
+func runStatus(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	restore, err := virtClient.VirtualMachineRestore(namespace).Get(cmd.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting VirtualMachineRestore %s: %v", name, err)
+	}
+
+	cmd.Printf("Name:      %s\n", restore.Name)
+	cmd.Printf("Target:    %s/%s\n", restore.Spec.Target.Kind, restore.Spec.Target.Name)
+	cmd.Printf("Snapshot:  %s\n", restore.Spec.VirtualMachineSnapshotName)
+	if len(restore.Spec.Volumes) > 0 {
+		cmd.Printf("Volumes:   %s\n", strings.Join(restore.Spec.Volumes, ","))
+	}
+
+	if restore.Status == nil {
+		cmd.Println("Complete:  false")
+		return nil
+	}
+
+	cmd.Printf("Complete:  %t\n", restore.Status.Complete != nil && *restore.Status.Complete)
+	if restore.Status.RestoreTime != nil {
+		cmd.Printf("Restored:  %s\n", restore.Status.RestoreTime.UTC().Format(time.RFC3339))
+	}
+	for _, cond := range restore.Status.Conditions {
+		cmd.Printf("Condition: %s=%s %s\n", cond.Type, cond.Status, cond.Reason)
+	}
+
+	return nil
+}
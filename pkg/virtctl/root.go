@@ -23,6 +23,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/create"
 	"kubevirt.io/kubevirt/pkg/virtctl/credentials"
 	"kubevirt.io/kubevirt/pkg/virtctl/expose"
+	"kubevirt.io/kubevirt/pkg/virtctl/guestfile"
 	"kubevirt.io/kubevirt/pkg/virtctl/guestfs"
 	"kubevirt.io/kubevirt/pkg/virtctl/imageupload"
 	"kubevirt.io/kubevirt/pkg/virtctl/memorydump"
@@ -30,10 +31,14 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/pause"
 	"kubevirt.io/kubevirt/pkg/virtctl/portforward"
 	"kubevirt.io/kubevirt/pkg/virtctl/reset"
+	"kubevirt.io/kubevirt/pkg/virtctl/restore"
 	"kubevirt.io/kubevirt/pkg/virtctl/scp"
+	"kubevirt.io/kubevirt/pkg/virtctl/snapshot"
 	"kubevirt.io/kubevirt/pkg/virtctl/softreboot"
+	"kubevirt.io/kubevirt/pkg/virtctl/spice"
 	"kubevirt.io/kubevirt/pkg/virtctl/ssh"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+	"kubevirt.io/kubevirt/pkg/virtctl/top"
 	"kubevirt.io/kubevirt/pkg/virtctl/unpause"
 	"kubevirt.io/kubevirt/pkg/virtctl/usbredir"
 	"kubevirt.io/kubevirt/pkg/virtctl/version"
@@ -106,21 +111,33 @@ func NewVirtctlCommandFn() *cobra.Command {
 		console.NewCommand(),
 		usbredir.NewCommand(),
 		vnc.NewCommand(),
+		spice.NewCommand(),
 		scp.NewCommand(),
 		ssh.NewCommand(),
 		portforward.NewCommand(),
 		vm.NewStartCommand(),
 		vm.NewStopCommand(),
 		vm.NewRestartCommand(),
+		vm.NewRescueCommand(),
+		vm.NewUnrescueCommand(),
+		vm.NewHibernateCommand(),
+		vm.NewResumeCommand(),
 		vm.NewMigrateCommand(),
 		vm.NewMigrateCancelCommand(),
+		vm.NewApplyChangesCommand(),
+		restore.NewCommand(),
 		vm.NewGuestOsInfoCommand(),
+		vm.NewGuestExecCommand(),
+		guestfile.NewCommand(),
 		vm.NewUserListCommand(),
 		vm.NewFSListCommand(),
 		vm.NewAddVolumeCommand(),
 		vm.NewRemoveVolumeCommand(),
+		vm.NewAddUSBDeviceCommand(),
+		vm.NewRemoveUSBDeviceCommand(),
 		vm.NewExpandCommand(),
 		memorydump.NewMemoryDumpCommand(),
+		snapshot.NewCommand(),
 		pause.NewCommand(),
 		unpause.NewCommand(),
 		softreboot.NewSoftRebootCommand(),
@@ -134,6 +151,7 @@ func NewVirtctlCommandFn() *cobra.Command {
 		credentials.NewCommand(),
 		adm.NewCommand(),
 		objectgraph.NewCommand(),
+		top.NewCommand(),
 		optionsCmd,
 	)
 
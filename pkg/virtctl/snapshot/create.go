@@ -0,0 +1,190 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+
+	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	deletionPolicyArg  = "deletion-policy"
+	failureDeadlineArg = "failure-deadline"
+
+	defaultTimeout = 5 * time.Minute
+	pollInterval   = 2 * time.Second
+)
+
+type createCommand struct {
+	name            string
+	deletionPolicy  string
+	failureDeadline time.Duration
+	wait            bool
+	timeout         time.Duration
+}
+
+func newCreateCommand() *cobra.Command {
+	c := createCommand{}
+	cmd := &cobra.Command{
+		Use:     "create (VM)",
+		Short:   "Create a snapshot of a virtual machine.",
+		Example: usageCreate(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.run,
+	}
+	cmd.Flags().StringVar(&c.name, nameArg, "", "Name of the VirtualMachineSnapshot, generated from the virtual machine name if not set")
+	cmd.Flags().StringVar(&c.deletionPolicy, deletionPolicyArg, "", "What to do with the snapshot content when the snapshot is deleted, one of Delete or Retain")
+	cmd.Flags().DurationVar(&c.failureDeadline, failureDeadlineArg, 0, "Time after which the snapshot is marked as failed if it did not succeed, the cluster default is used if not set")
+	cmd.Flags().BoolVar(&c.wait, waitArg, false, "If set, wait for the snapshot to become ready to use")
+	cmd.Flags().DurationVar(&c.timeout, timeoutArg, defaultTimeout, "How long to wait for the snapshot to become ready to use")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageCreate() string {
+	return `  # Create a snapshot of the virtual machine 'myvm':
+  {{ProgramName}} snapshot create myvm
+
+  # Create a snapshot called 'mysnapshot' of the virtual machine 'myvm' and wait until it is ready to use:
+  {{ProgramName}} snapshot create myvm --name=mysnapshot --wait
+
+  # Create a snapshot of the virtual machine 'myvm' whose content is kept when the snapshot is deleted:
+  {{ProgramName}} snapshot create myvm --deletion-policy=Retain`
+}
+
+func (c *createCommand) run(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := c.newSnapshot(vmName)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err = virtClient.VirtualMachineSnapshot(namespace).Create(cmd.Context(), snapshot, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error creating VirtualMachineSnapshot of VirtualMachine %s: %v", vmName, err)
+	}
+
+	cmd.Printf("VirtualMachineSnapshot %s of VirtualMachine %s was created\n", snapshot.Name, vmName)
+
+	if !c.wait {
+		return nil
+	}
+
+	return waitForSnapshotReady(cmd, virtClient, namespace, snapshot.Name, c.timeout)
+}
+
+func (c *createCommand) newSnapshot(vmName string) (*snapshotv1.VirtualMachineSnapshot, error) {
+	snapshot := &snapshotv1.VirtualMachineSnapshot{
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: &v1.SchemeGroupVersion.Group,
+				Kind:     v1.VirtualMachineGroupVersionKind.Kind,
+				Name:     vmName,
+			},
+		},
+	}
+
+	if c.name != "" {
+		snapshot.Name = c.name
+	} else {
+		snapshot.GenerateName = fmt.Sprintf("%s-snapshot-", vmName)
+	}
+
+	if c.deletionPolicy != "" {
+		policy := snapshotv1.DeletionPolicy(c.deletionPolicy)
+		if policy != snapshotv1.VirtualMachineSnapshotContentDelete && policy != snapshotv1.VirtualMachineSnapshotContentRetain {
+			return nil, fmt.Errorf("invalid deletion policy %q, must be one of %s or %s",
+				c.deletionPolicy, snapshotv1.VirtualMachineSnapshotContentDelete, snapshotv1.VirtualMachineSnapshotContentRetain)
+		}
+		snapshot.Spec.DeletionPolicy = &policy
+	}
+
+	if c.failureDeadline > 0 {
+		snapshot.Spec.FailureDeadline = &metav1.Duration{Duration: c.failureDeadline}
+	}
+
+	return snapshot, nil
+}
+
+func waitForSnapshotReady(cmd *cobra.Command, virtClient kubecli.KubevirtClient, namespace, name string, timeout time.Duration) error {
+	err := virtwait.PollImmediately(pollInterval, timeout, func(ctx context.Context) (bool, error) {
+		snapshot, err := virtClient.VirtualMachineSnapshot(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		if snapshot.Status == nil {
+			cmd.Printf("Waiting for VirtualMachineSnapshot %s to be processed...\n", name)
+			return false, nil
+		}
+
+		if snapshot.Status.Phase == snapshotv1.Failed {
+			return false, fmt.Errorf("VirtualMachineSnapshot %s failed: %s", name, snapshotErrorMessage(snapshot))
+		}
+
+		if snapshot.Status.ReadyToUse == nil || !*snapshot.Status.ReadyToUse {
+			cmd.Printf("Waiting for VirtualMachineSnapshot %s to become ready, current phase: %s%s...\n",
+				name, snapshot.Status.Phase, snapshotProgress(snapshot))
+			return false, nil
+		}
+
+		cmd.Printf("VirtualMachineSnapshot %s is ready to use\n", name)
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for VirtualMachineSnapshot %s: %v", name, err)
+	}
+
+	return nil
+}
+
+func snapshotProgress(snapshot *snapshotv1.VirtualMachineSnapshot) string {
+	if snapshot.Status.Progress == nil {
+		return ""
+	}
+	return fmt.Sprintf(" (%d%%)", snapshot.Status.Progress.Percentage)
+}
+
+func snapshotErrorMessage(snapshot *snapshotv1.VirtualMachineSnapshot) string {
+	if snapshot.Status.Error == nil || snapshot.Status.Error.Message == nil {
+		return "unknown error"
+	}
+	return *snapshot.Status.Error.Message
+}
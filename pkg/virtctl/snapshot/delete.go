@@ -0,0 +1,64 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+func newDeleteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete (SNAPSHOT)",
+		Short:   "Delete a snapshot of a virtual machine.",
+		Example: usageDelete(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    runDelete,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageDelete() string {
+	return `  # Delete the snapshot 'mysnapshot':
+  {{ProgramName}} snapshot delete mysnapshot`
+}
+
+func runDelete(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	if err := virtClient.VirtualMachineSnapshot(namespace).Delete(cmd.Context(), name, metav1.DeleteOptions{}); err != nil {
+		return fmt.Errorf("error deleting VirtualMachineSnapshot %s: %v", name, err)
+	}
+
+	cmd.Printf("VirtualMachineSnapshot %s was deleted\n", name)
+	return nil
+}
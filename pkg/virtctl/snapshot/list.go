@@ -0,0 +1,103 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+func newListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list [VM]",
+		Short:   "List the snapshots of virtual machines.",
+		Example: usageList(),
+		Args:    cobra.MaximumNArgs(1),
+		RunE:    runList,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageList() string {
+	return `  # List all snapshots in the namespace:
+  {{ProgramName}} snapshot list
+
+  # List the snapshots of the virtual machine 'myvm':
+  {{ProgramName}} snapshot list myvm`
+}
+
+func runList(cmd *cobra.Command, args []string) error {
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := virtClient.VirtualMachineSnapshot(namespace).List(cmd.Context(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing VirtualMachineSnapshots: %v", err)
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSOURCE\tPHASE\tREADY\tCREATIONTIME")
+	for _, snapshot := range snapshots.Items {
+		if len(args) == 1 && snapshot.Spec.Source.Name != args[0] {
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s/%s\t%s\t%t\t%s\n",
+			snapshot.Name,
+			snapshot.Spec.Source.Kind,
+			snapshot.Spec.Source.Name,
+			snapshotPhase(&snapshot),
+			snapshotReady(&snapshot),
+			snapshotCreationTime(&snapshot),
+		)
+	}
+
+	return w.Flush()
+}
+
+func snapshotPhase(snapshot *snapshotv1.VirtualMachineSnapshot) snapshotv1.VirtualMachineSnapshotPhase {
+	if snapshot.Status == nil || snapshot.Status.Phase == snapshotv1.PhaseUnset {
+		return snapshotv1.Unknown
+	}
+	return snapshot.Status.Phase
+}
+
+func snapshotReady(snapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	return snapshot.Status != nil && snapshot.Status.ReadyToUse != nil && *snapshot.Status.ReadyToUse
+}
+
+func snapshotCreationTime(snapshot *snapshotv1.VirtualMachineSnapshot) string {
+	if snapshot.Status == nil || snapshot.Status.CreationTime == nil {
+		return "<none>"
+	}
+	return snapshot.Status.CreationTime.UTC().Format(time.RFC3339)
+}
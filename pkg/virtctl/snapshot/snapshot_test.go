@@ -0,0 +1,201 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Snapshot command", func() {
+	const (
+		vmName       = "testvm"
+		snapshotName = "testsnapshot"
+	)
+
+	var virtClient *kubevirtfake.Clientset
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		virtClient = kubevirtfake.NewSimpleClientset()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineSnapshot(metav1.NamespaceDefault).
+			Return(virtClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault)).AnyTimes()
+	})
+
+	newSnapshot := func(name, vmName string, ready bool) *snapshotv1.VirtualMachineSnapshot {
+		return &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: &v1.SchemeGroupVersion.Group,
+					Kind:     v1.VirtualMachineGroupVersionKind.Kind,
+					Name:     vmName,
+				},
+			},
+			Status: &snapshotv1.VirtualMachineSnapshotStatus{
+				Phase:      snapshotv1.Succeeded,
+				ReadyToUse: pointer.P(ready),
+			},
+		}
+	}
+
+	getSnapshot := func(name string) *snapshotv1.VirtualMachineSnapshot {
+		snapshot, err := virtClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return snapshot
+	}
+
+	Context("create", func() {
+		It("should fail with missing input parameters", func() {
+			cmd := testing.NewRepeatableVirtctlCommand("snapshot", "create")
+			Expect(cmd()).To(MatchError("accepts 1 arg(s), received 0"))
+		})
+
+		It("should create a snapshot of the virtual machine", func() {
+			cmd := testing.NewRepeatableVirtctlCommand("snapshot", "create", vmName, "--name", snapshotName)
+			Expect(cmd()).To(Succeed())
+
+			snapshot := getSnapshot(snapshotName)
+			Expect(snapshot.Spec.Source.Kind).To(Equal(v1.VirtualMachineGroupVersionKind.Kind))
+			Expect(snapshot.Spec.Source.Name).To(Equal(vmName))
+			Expect(snapshot.Spec.DeletionPolicy).To(BeNil())
+			Expect(snapshot.Spec.FailureDeadline).To(BeNil())
+		})
+
+		It("should generate the name of the snapshot if not set", func() {
+			virtClient.PrependReactor("create", "virtualmachinesnapshots", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				snapshot := action.(k8stesting.CreateAction).GetObject().(*snapshotv1.VirtualMachineSnapshot)
+				Expect(snapshot.Name).To(BeEmpty())
+				Expect(snapshot.GenerateName).To(Equal(vmName + "-snapshot-"))
+				return true, snapshot, nil
+			})
+
+			cmd := testing.NewRepeatableVirtctlCommand("snapshot", "create", vmName)
+			Expect(cmd()).To(Succeed())
+		})
+
+		It("should pass the deletion policy and the failure deadline", func() {
+			cmd := testing.NewRepeatableVirtctlCommand("snapshot", "create", vmName,
+				"--name", snapshotName,
+				"--deletion-policy", "Retain",
+				"--failure-deadline", "2m",
+			)
+			Expect(cmd()).To(Succeed())
+
+			snapshot := getSnapshot(snapshotName)
+			Expect(snapshot.Spec.DeletionPolicy).To(HaveValue(Equal(snapshotv1.VirtualMachineSnapshotContentRetain)))
+			Expect(snapshot.Spec.FailureDeadline).To(HaveValue(Equal(metav1.Duration{Duration: 2 * time.Minute})))
+		})
+
+		It("should reject an invalid deletion policy", func() {
+			cmd := testing.NewRepeatableVirtctlCommand("snapshot", "create", vmName, "--deletion-policy", "Keep")
+			Expect(cmd()).To(MatchError(ContainSubstring(`invalid deletion policy "Keep"`)))
+		})
+
+		It("should wait for the snapshot to become ready to use", func() {
+			virtClient.PrependReactor("get", "virtualmachinesnapshots", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, newSnapshot(snapshotName, vmName, true), nil
+			})
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("snapshot", "create", vmName, "--name", snapshotName, "--wait")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("VirtualMachineSnapshot testsnapshot is ready to use"))
+		})
+
+		It("should fail waiting for a failed snapshot", func() {
+			virtClient.PrependReactor("get", "virtualmachinesnapshots", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				snapshot := newSnapshot(snapshotName, vmName, false)
+				snapshot.Status.Phase = snapshotv1.Failed
+				snapshot.Status.Error = &snapshotv1.Error{Message: pointer.P("deadline exceeded")}
+				return true, snapshot, nil
+			})
+
+			cmd := testing.NewRepeatableVirtctlCommand("snapshot", "create", vmName, "--name", snapshotName, "--wait")
+			Expect(cmd()).To(MatchError(ContainSubstring("VirtualMachineSnapshot testsnapshot failed: deadline exceeded")))
+		})
+	})
+
+	Context("list", func() {
+		BeforeEach(func() {
+			for _, snapshot := range []*snapshotv1.VirtualMachineSnapshot{
+				newSnapshot("snapshot-a", vmName, true),
+				newSnapshot("snapshot-b", "othervm", false),
+			} {
+				_, err := virtClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault).Create(context.Background(), snapshot, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("should list all snapshots", func() {
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("snapshot", "list")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("NAME"))
+			Expect(string(out)).To(MatchRegexp(`snapshot-a\s+VirtualMachine/testvm\s+Succeeded\s+true`))
+			Expect(string(out)).To(MatchRegexp(`snapshot-b\s+VirtualMachine/othervm\s+Succeeded\s+false`))
+		})
+
+		It("should list the snapshots of a virtual machine", func() {
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("snapshot", "list", vmName)()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("snapshot-a"))
+			Expect(string(out)).ToNot(ContainSubstring("snapshot-b"))
+		})
+	})
+
+	Context("delete", func() {
+		It("should delete the snapshot", func() {
+			_, err := virtClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault).Create(context.Background(), newSnapshot(snapshotName, vmName, true), metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			cmd := testing.NewRepeatableVirtctlCommand("snapshot", "delete", snapshotName)
+			Expect(cmd()).To(Succeed())
+
+			_, err = virtClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault).Get(context.Background(), snapshotName, metav1.GetOptions{})
+			Expect(err).To(MatchError(ContainSubstring("not found")))
+		})
+
+		It("should fail deleting a missing snapshot", func() {
+			cmd := testing.NewRepeatableVirtctlCommand("snapshot", "delete", snapshotName)
+			Expect(cmd()).To(MatchError(ContainSubstring("error deleting VirtualMachineSnapshot testsnapshot")))
+		})
+	})
+})
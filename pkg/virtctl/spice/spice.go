@@ -0,0 +1,232 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package spice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"kubevirt.io/client-go/kubecli"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	remoteViewer     = "remote-viewer"
+	localhostAddress = "127.0.0.1"
+)
+
+type SPICE struct {
+	address   string
+	port      int
+	proxyOnly bool
+	vvFile    string
+}
+
+func NewCommand() *cobra.Command {
+	c := SPICE{address: localhostAddress}
+	cmd := &cobra.Command{
+		Use:   "spice (VMI)",
+		Short: "Open a SPICE connection to a virtual machine instance.",
+		Long: `Open a SPICE connection to a virtual machine instance with a SPICE graphics device.
+A local proxy forwards every connection of the SPICE client to the virtual machine instance, and a .vv connection file
+for the proxy is passed to remote-viewer.`,
+		Example: usage(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.Run,
+	}
+	cmd.Flags().StringVar(&c.address, "address", c.address, "--address=127.0.0.1: Setting this will change the listening address of the proxy, it is only used with --proxy-only or --vv-file. Example: --address=0.0.0.0 will make the proxy listen on all interfaces.")
+	cmd.Flags().IntVar(&c.port, "port", c.port, "--port=0: Assigning a port value to this will try to run the proxy on the given port if the port is accessible; If unassigned, the proxy will run on a random port")
+	cmd.Flags().BoolVar(&c.proxyOnly, "proxy-only", c.proxyOnly, "--proxy-only=false: Setting this true will run only the virtctl spice proxy and show the port where SPICE clients can connect")
+	cmd.Flags().StringVar(&c.vvFile, "vv-file", c.vvFile, "--vv-file=console.vv: Write the .vv connection file of the proxy to the given path instead of starting remote-viewer")
+	cmd.MarkFlagsMutuallyExclusive("proxy-only", "vv-file")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # Connect to 'testvmi' via remote-viewer:
+  {{ProgramName}} spice testvmi
+
+  # Write the connection file 'testvmi.vv' to connect to 'testvmi' with any SPICE client:
+  {{ProgramName}} spice testvmi --vv-file=testvmi.vv`
+}
+
+func (c *SPICE) Run(cmd *cobra.Command, args []string) error {
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+
+	virtCli, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	vmi := args[0]
+
+	// Open the first channel upfront, to fail early if the VMI can not be accessed
+	stream, err := virtCli.VirtualMachineInstance(namespace).SPICE(vmi)
+	if err != nil {
+		return fmt.Errorf("can't access VMI %s: %s", vmi, err.Error())
+	}
+
+	if !c.proxyOnly && c.vvFile == "" {
+		c.address = localhostAddress
+	}
+	ln, err := net.Listen("tcp", net.JoinHostPort(c.address, strconv.Itoa(c.port)))
+	if err != nil {
+		return fmt.Errorf("can't listen on %s: %s", c.address, err.Error())
+	}
+	defer ln.Close()
+	port := ln.Addr().(*net.TCPAddr).Port
+
+	errChan := make(chan error, 2)
+	go serve(ln, stream, virtCli, namespace, vmi, errChan)
+
+	switch {
+	case c.proxyOnly:
+		optionString, err := json.Marshal(struct {
+			Port int `json:"port"`
+		}{port})
+		if err != nil {
+			return fmt.Errorf("error encountered: %s", err.Error())
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(optionString))
+	case c.vvFile != "":
+		if err := os.WriteFile(c.vvFile, connectionFile(c.address, port, vmi), 0o600); err != nil {
+			return fmt.Errorf("can't write the connection file: %s", err.Error())
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Connect with the connection file %s, press Ctrl+C to stop the proxy\n", c.vvFile)
+	default:
+		go runRemoteViewer(ctx, port, vmi, errChan)
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	select {
+	case err = <-errChan:
+	case <-interrupt:
+		cancel()
+	case <-ctx.Done():
+	}
+
+	if err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, net.ErrClosed) {
+		return fmt.Errorf("error encountered: %s", err.Error())
+	}
+	return nil
+}
+
+// serve forwards every connection of the SPICE client, each SPICE channel uses its own connection
+func serve(ln net.Listener, stream kvcorev1.StreamInterface, virtCli kubecli.KubevirtClient, namespace, vmi string, errChan chan error) {
+	first := true
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			errChan <- err
+			return
+		}
+		if first {
+			templates.PrintWarningForPausedVMI(virtCli, vmi, namespace)
+			first = false
+		} else {
+			stream, err = virtCli.VirtualMachineInstance(namespace).SPICE(vmi)
+			if err != nil {
+				log.Log.Reason(err).Errorf("Failed to open a SPICE channel to VMI %s", vmi)
+				conn.Close()
+				continue
+			}
+		}
+		go forward(conn, stream)
+	}
+}
+
+func forward(conn net.Conn, stream kvcorev1.StreamInterface) {
+	defer conn.Close()
+	err := stream.Stream(kvcorev1.StreamOptions{
+		In:  conn,
+		Out: conn,
+	})
+	if err != nil {
+		log.Log.V(2).Infof("SPICE channel closed: %v", err)
+	}
+}
+
+func runRemoteViewer(ctx context.Context, port int, vmi string, errChan chan error) {
+	file, err := os.CreateTemp("", "virtctl-spice-*.vv")
+	if err != nil {
+		errChan <- err
+		return
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(connectionFile(localhostAddress, port, vmi)); err != nil {
+		file.Close()
+		errChan <- err
+		return
+	}
+	if err := file.Close(); err != nil {
+		errChan <- err
+		return
+	}
+
+	args := []string{file.Name()}
+	if log.Log.Verbosity(4) {
+		args = append(args, "--debug")
+	}
+	log.Log.V(4).Infof("Executing commandline: '%s %v'", remoteViewer, args)
+	// #nosec No risk for attacker injection. args include the path of the temporary connection file only
+	output, err := exec.CommandContext(ctx, remoteViewer, args...).CombinedOutput()
+	if err != nil {
+		log.Log.Errorf("%s execution failed: %v, output: %v", remoteViewer, err, string(output))
+	} else {
+		log.Log.V(2).Infof("%v output: %v", remoteViewer, string(output))
+	}
+	errChan <- err
+}
+
+// connectionFile returns a virt-viewer connection file, see https://gitlab.com/virt-viewer/virt-viewer/-/blob/master/man/remote-viewer.pod
+func connectionFile(address string, port int, vmi string) []byte {
+	host := address
+	if ip := net.ParseIP(address); ip != nil && ip.IsUnspecified() {
+		host = localhostAddress
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "[virt-viewer]")
+	fmt.Fprintln(&b, "type=spice")
+	fmt.Fprintf(&b, "host=%s\n", host)
+	fmt.Fprintf(&b, "port=%d\n", port)
+	fmt.Fprintf(&b, "title=%s - Press %%s to release the cursor\n", vmi)
+	fmt.Fprintln(&b, "release-cursor=shift+f12")
+	return b.Bytes()
+}
@@ -0,0 +1,41 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package spice
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SPICE command", func() {
+	DescribeTable("should generate the connection file", func(address, expectedHost string) {
+		Expect(string(connectionFile(address, 5900, "testvmi"))).To(Equal(`[virt-viewer]
+type=spice
+host=` + expectedHost + `
+port=5900
+title=testvmi - Press %s to release the cursor
+release-cursor=shift+f12
+`))
+	},
+		Entry("for the listening address", "192.168.0.10", "192.168.0.10"),
+		Entry("for localhost when listening on all IPv4 interfaces", "0.0.0.0", "127.0.0.1"),
+		Entry("for localhost when listening on all IPv6 interfaces", "::", "127.0.0.1"),
+	)
+})
@@ -0,0 +1,240 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ssh
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/virtctl/credentials/common"
+)
+
+const (
+	injectedKeyPrefix  = "virtctl-injected-key-"
+	injectedKeyComment = "virtctl-injected-key"
+
+	injectKeyTimeout       = 2 * time.Minute
+	injectKeyRetryInterval = 2 * time.Second
+)
+
+// AcceptsKey checks whether the guest accepts the key of the signer for the user, it can be replaced in tests
+var AcceptsKey = func(client kubecli.KubevirtClient, kind, namespace, name string, options *SSHOptions, signer ssh.Signer) bool {
+	var (
+		stream kvcorev1.StreamInterface
+		err    error
+	)
+	if kind == "vm" {
+		stream, err = client.VirtualMachine(namespace).PortForward(name, options.SSHPort, "tcp")
+	} else {
+		stream, err = client.VirtualMachineInstance(namespace).PortForward(name, options.SSHPort, "tcp")
+	}
+	if err != nil {
+		log.Log.V(3).Infof("Can't access %s %s: %v", kind, name, err)
+		return false
+	}
+
+	conn := stream.AsConn()
+	defer conn.Close()
+	sshConn, _, _, err := ssh.NewClientConn(conn,
+		fmt.Sprintf("%s/%s.%s:%d", kind, name, namespace, options.SSHPort),
+		&ssh.ClientConfig{
+			User: options.SSHUsername,
+			Auth: []ssh.AuthMethod{ssh.PublicKeys(signer)},
+			// Only the authentication is probed, the host key is checked by the following session
+			HostKeyCallback: ssh.InsecureIgnoreHostKey(), // #nosec G106
+		},
+	)
+	if err != nil {
+		log.Log.V(3).Infof("The injected key is not accepted yet: %v", err)
+		return false
+	}
+	sshConn.Close()
+	return true
+}
+
+// InjectKey generates an ephemeral key pair for the session and adds its public key to a secret, which the guest agent
+// propagates to the user. Once the guest accepts the key, the private key is used as identity file. The returned
+// function removes the key from the secret and the identity file again, it is also called on interrupts.
+func InjectKey(ctx context.Context, client kubecli.KubevirtClient, kind, namespace, name string, options *SSHOptions) (func(), error) {
+	if options.SSHUsername == "" {
+		return nil, errors.New("a username is required to inject a key")
+	}
+
+	vmi, err := client.VirtualMachineInstance(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("can't get VMI %s: %w", name, err)
+	}
+	secretName, err := findGuestAgentSecret(vmi, options.SSHUsername)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, privateKey, authorizedKey, err := generateKey()
+	if err != nil {
+		return nil, err
+	}
+	identityFile, err := writeIdentityFile(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	keyName := common.RandomWithPrefix(injectedKeyPrefix)
+	if err := addKeyToSecret(ctx, client, namespace, secretName, keyName, authorizedKey); err != nil {
+		os.Remove(identityFile)
+		return nil, err
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	var once sync.Once
+	cleanup := func() {
+		once.Do(func() {
+			signal.Stop(interrupt)
+			if err := removeKeyFromSecret(context.Background(), client, namespace, secretName, keyName); err != nil {
+				log.Log.Errorf("Failed to remove the injected key %s from secret %s: %v", keyName, secretName, err)
+			}
+			os.Remove(identityFile)
+		})
+	}
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		if _, received := <-interrupt; received {
+			cleanup()
+			os.Exit(1)
+		}
+	}()
+
+	err = wait.PollUntilContextTimeout(ctx, injectKeyRetryInterval, injectKeyTimeout, true, func(_ context.Context) (bool, error) {
+		return AcceptsKey(client, kind, namespace, name, options, signer), nil
+	})
+	if err != nil {
+		cleanup()
+		return nil, fmt.Errorf("the injected key was not accepted by %s %s within %v, is the guest agent connected? %w", kind, name, injectKeyTimeout, err)
+	}
+
+	options.IdentityFilePath = identityFile
+	options.IdentityFilePathProvided = true
+	return cleanup, nil
+}
+
+// findGuestAgentSecret returns the secret of the access credential propagating SSH keys to the user with the guest
+// agent. Keys propagated with cloud-init are applied at boot only, they can't be injected for a session.
+func findGuestAgentSecret(vmi *v1.VirtualMachineInstance, user string) (string, error) {
+	secrets := common.GetSSHSecretsForUser(vmi.Spec.AccessCredentials, user)
+	if len(secrets) > 0 {
+		return secrets[0], nil
+	}
+
+	for _, credential := range vmi.Spec.AccessCredentials {
+		if credential.SSHPublicKey != nil &&
+			(credential.SSHPublicKey.PropagationMethod.NoCloud != nil || credential.SSHPublicKey.PropagationMethod.ConfigDrive != nil) {
+			return "", fmt.Errorf("the SSH keys of VMI %s are propagated with cloud-init, which applies them at boot only; "+
+				"injecting a key requires an access credential propagating SSH keys to user %s with the guest agent", vmi.Name, user)
+		}
+	}
+	return "", fmt.Errorf("VMI %s has no access credential propagating SSH keys to user %s with the guest agent", vmi.Name, user)
+}
+
+func generateKey() (signer ssh.Signer, privateKey []byte, authorizedKey string, err error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	signer, err = ssh.NewSignerFromKey(key)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	block, err := ssh.MarshalPrivateKey(key, injectedKeyComment)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	authorizedKey = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey()))) + " " + injectedKeyComment
+	return signer, pem.EncodeToMemory(block), authorizedKey, nil
+}
+
+func writeIdentityFile(privateKey []byte) (string, error) {
+	file, err := os.CreateTemp("", "virtctl-ssh-key-*")
+	if err != nil {
+		return "", err
+	}
+	_, err = file.Write(privateKey)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+func addKeyToSecret(ctx context.Context, client kubecli.KubevirtClient, namespace, secretName, keyName, authorizedKey string) error {
+	keyPath := "/data/" + keyName
+	addKeyPatch, err := patch.New(patch.WithAdd(keyPath, []byte(authorizedKey))).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if _, err = client.CoreV1().Secrets(namespace).Patch(ctx, secretName, types.JSONPatchType, addKeyPatch, metav1.PatchOptions{}); err == nil {
+		return nil
+	}
+
+	// If it fails, the /data may be nil. Try a patch that adds the /data field
+	fullPatch, err := patch.New(
+		patch.WithTest("/data", nil),
+		patch.WithAdd("/data", map[string][]byte{}),
+		patch.WithAdd(keyPath, []byte(authorizedKey)),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if _, err = client.CoreV1().Secrets(namespace).Patch(ctx, secretName, types.JSONPatchType, fullPatch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("error patching secret \"%s\": %w", secretName, err)
+	}
+	return nil
+}
+
+func removeKeyFromSecret(ctx context.Context, client kubecli.KubevirtClient, namespace, secretName, keyName string) error {
+	removeKeyPatch, err := patch.New(patch.WithRemove("/data/" + keyName)).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = client.CoreV1().Secrets(namespace).Patch(ctx, secretName, types.JSONPatchType, removeKeyPatch, metav1.PatchOptions{})
+	return err
+}
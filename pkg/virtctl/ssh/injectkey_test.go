@@ -0,0 +1,161 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ssh_test
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	gossh "golang.org/x/crypto/ssh"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virtctl/ssh"
+)
+
+var _ = Describe("Inject key", func() {
+	const (
+		vmiName    = "testvmi"
+		secretName = "test-secret"
+		userName   = "jdoe"
+	)
+
+	var (
+		kubeClient *fake.Clientset
+		virtClient *kubevirtfake.Clientset
+		client     kubecli.KubevirtClient
+		options    ssh.SSHOptions
+		accepted   [][]byte
+	)
+
+	BeforeEach(func() {
+		kubeClient = fake.NewSimpleClientset(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: metav1.NamespaceDefault},
+			Data:       map[string][]byte{"key": []byte("ssh-ed25519 AAAA existing")},
+		})
+		virtClient = kubevirtfake.NewSimpleClientset()
+
+		ctrl := gomock.NewController(GinkgoT())
+		mockClient := kubecli.NewMockKubevirtClient(ctrl)
+		mockClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+		mockClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		client = mockClient
+
+		options = ssh.DefaultSSHOptions()
+		options.SSHUsername = userName
+
+		accepted = nil
+		acceptsKey := ssh.AcceptsKey
+		ssh.AcceptsKey = func(_ kubecli.KubevirtClient, _, _, _ string, _ *ssh.SSHOptions, signer gossh.Signer) bool {
+			accepted = append(accepted, signer.PublicKey().Marshal())
+			return true
+		}
+		DeferCleanup(func() {
+			ssh.AcceptsKey = acceptsKey
+		})
+	})
+
+	createVMI := func(opts ...libvmi.Option) {
+		vmi := libvmi.New(append(opts, libvmi.WithNamespace(metav1.NamespaceDefault), libvmi.WithName(vmiName))...)
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getSecretData := func() map[string][]byte {
+		secret, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.Background(), secretName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return secret.Data
+	}
+
+	It("should inject an ephemeral key for the session and remove it afterwards", func() {
+		createVMI(libvmi.WithAccessCredentialSSHPublicKey(secretName, userName))
+
+		cleanup, err := ssh.InjectKey(context.Background(), client, "vmi", metav1.NamespaceDefault, vmiName, &options)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(options.IdentityFilePathProvided).To(BeTrue())
+
+		privateKey, err := os.ReadFile(options.IdentityFilePath)
+		Expect(err).ToNot(HaveOccurred())
+		signer, err := gossh.ParsePrivateKey(privateKey)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(accepted).To(Equal([][]byte{signer.PublicKey().Marshal()}))
+
+		data := getSecretData()
+		Expect(data).To(HaveLen(2))
+		Expect(data).To(HaveKeyWithValue("key", []byte("ssh-ed25519 AAAA existing")))
+		var injected []byte
+		for name, value := range data {
+			if name != "key" {
+				Expect(name).To(HavePrefix("virtctl-injected-key-"))
+				injected = value
+			}
+		}
+		publicKey, _, _, _, err := gossh.ParseAuthorizedKey(injected)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(publicKey.Marshal()).To(Equal(signer.PublicKey().Marshal()))
+
+		cleanup()
+		Expect(getSecretData()).To(Equal(map[string][]byte{"key": []byte("ssh-ed25519 AAAA existing")}))
+		Expect(options.IdentityFilePath).ToNot(BeAnExistingFile())
+	})
+
+	It("should fail without an access credential for the user", func() {
+		createVMI(libvmi.WithAccessCredentialSSHPublicKey(secretName, "other"))
+
+		_, err := ssh.InjectKey(context.Background(), client, "vmi", metav1.NamespaceDefault, vmiName, &options)
+		Expect(err).To(MatchError("VMI testvmi has no access credential propagating SSH keys to user jdoe with the guest agent"))
+		Expect(getSecretData()).To(HaveLen(1))
+	})
+
+	It("should fail if the keys are propagated with cloud-init", func() {
+		createVMI(func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.AccessCredentials = []v1.AccessCredential{{
+				SSHPublicKey: &v1.SSHPublicKeyAccessCredential{
+					Source: v1.SSHPublicKeyAccessCredentialSource{
+						Secret: &v1.AccessCredentialSecretSource{SecretName: secretName},
+					},
+					PropagationMethod: v1.SSHPublicKeyAccessCredentialPropagationMethod{
+						NoCloud: &v1.NoCloudSSHPublicKeyAccessCredentialPropagation{},
+					},
+				},
+			}}
+		})
+
+		_, err := ssh.InjectKey(context.Background(), client, "vmi", metav1.NamespaceDefault, vmiName, &options)
+		Expect(err).To(MatchError(ContainSubstring("propagated with cloud-init, which applies them at boot only")))
+	})
+
+	It("should require a username", func() {
+		options.SSHUsername = ""
+		_, err := ssh.InjectKey(context.Background(), client, "vmi", metav1.NamespaceDefault, vmiName, &options)
+		Expect(err).To(MatchError("a username is required to inject a key"))
+	})
+})
@@ -43,6 +43,7 @@ const (
 	knownHostsFilePathFlag                          = "known-hosts"
 	commandToExecute, commandToExecuteShort         = "command", "c"
 	additionalOpts, additionalOptsShort             = "local-ssh-opts", "t"
+	injectKeyFlag                                   = "inject-key"
 )
 
 func NewCommand() *cobra.Command {
@@ -75,6 +76,8 @@ func AddCommandlineArgs(flagset *pflag.FlagSet, opts *SSHOptions) {
 		fmt.Sprintf("--%s=/home/jdoe/.ssh/kubevirt_known_hosts: Set the path to the known_hosts file.", knownHostsFilePathFlag))
 	flagset.IntVarP(&opts.SSHPort, portFlag, portFlagShort, opts.SSHPort,
 		fmt.Sprintf(`--%s=22: Specify a port on the VM to send SSH traffic to`, portFlag))
+	flagset.BoolVar(&opts.InjectKey, injectKeyFlag, opts.InjectKey,
+		fmt.Sprintf("--%s=true: Inject an ephemeral SSH key for the session with the guest agent and authenticate with it; Requires an access credential propagating SSH keys to the user with the guest agent, the key is removed again at the end of the session", injectKeyFlag))
 
 	addAdditionalCommandlineArgs(flagset, opts)
 }
@@ -117,6 +120,7 @@ type SSHOptions struct {
 	AdditionalSSHLocalOptions []string
 	WrapLocalSSH              bool
 	LocalClientName           string
+	InjectKey                 bool
 }
 
 func (o *SSH) Run(cmd *cobra.Command, args []string) error {
@@ -133,6 +137,13 @@ func (o *SSH) Run(cmd *cobra.Command, args []string) error {
 	if cmd.Flags().Changed(wrapLocalSSHFlag) {
 		cmd.PrintErrln("The --local-ssh flag is deprecated and now defaults to true.")
 	}
+	if o.options.InjectKey {
+		cleanup, err := InjectKey(cmd.Context(), client, kind, namespace, name, &o.options)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+	}
 	if o.options.WrapLocalSSH {
 		clientArgs := o.buildSSHTarget(kind, namespace, name)
 		return RunLocalClient(kind, namespace, name, &o.options, clientArgs)
@@ -167,10 +178,14 @@ func usage() string {
   {{ProgramName}} ssh jdoe@vm/testvm/mynamespace [--%s]
 
   # Specify a username and namespace:
-  {{ProgramName}} ssh --namespace=mynamespace --%s=jdoe vmi/testvmi`,
+  {{ProgramName}} ssh --namespace=mynamespace --%s=jdoe vmi/testvmi
+
+  # Connect to 'testvmi' with an ephemeral key injected by the guest agent:
+  {{ProgramName}} ssh jdoe@vmi/testvmi --%s`,
 		IdentityFilePathFlag,
 		IdentityFilePathFlag,
 		usernameFlag,
+		injectKeyFlag,
 	) + additionalUsage()
 }
 
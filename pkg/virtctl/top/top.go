@@ -0,0 +1,405 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package top
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_TOP = "top"
+
+	allNamespacesFlag = "all-namespaces"
+	intervalFlag      = "interval"
+	aggregateFlag     = "aggregate"
+	sortByFlag        = "sort-by"
+	watchFlag         = "watch"
+
+	aggregateNode      = "node"
+	aggregateNamespace = "namespace"
+
+	sortByCPU    = "cpu"
+	sortByMemory = "memory"
+
+	defaultInterval = 5 * time.Second
+)
+
+type top struct {
+	allNamespaces bool
+	interval      time.Duration
+	aggregate     string
+	sortBy        string
+	watch         bool
+}
+
+// usage holds the resource usage of a VMI, or of a group of VMIs, computed
+// from two stats samples.
+type usage struct {
+	namespace string
+	name      string
+	node      string
+	vmis      int
+
+	vcpus      int64
+	milliCores int64
+	memory     int64
+
+	readRate     float64
+	writeRate    float64
+	receiveRate  float64
+	transmitRate float64
+}
+
+type sample struct {
+	vmi   *v1.VirtualMachineInstance
+	stats v1.VirtualMachineInstanceStats
+}
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   COMMAND_TOP,
+		Short: "Display resource usage of virtual machine instances.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Print(cmd.UsageString())
+		},
+	}
+
+	cmd.AddCommand(newVMICommand())
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newVMICommand() *cobra.Command {
+	t := top{}
+	cmd := &cobra.Command{
+		Use:     "vmi [NAME]",
+		Short:   "Display CPU, memory, storage and network usage of running virtual machine instances.",
+		Example: usageVMI(),
+		Args:    cobra.MaximumNArgs(1),
+		RunE:    t.run,
+	}
+
+	cmd.Flags().BoolVarP(&t.allNamespaces, allNamespacesFlag, "A", false, "If present, list the resource usage of the VMIs across all namespaces.")
+	cmd.Flags().DurationVar(&t.interval, intervalFlag, defaultInterval, "Interval between the two stats samples used to compute the usage rates.")
+	cmd.Flags().StringVar(&t.aggregate, aggregateFlag, "", fmt.Sprintf("Aggregate the resource usage per %s or %s.", aggregateNode, aggregateNamespace))
+	cmd.Flags().StringVar(&t.sortBy, sortByFlag, "", fmt.Sprintf("Sort the output by %s or %s usage.", sortByCPU, sortByMemory))
+	cmd.Flags().BoolVarP(&t.watch, watchFlag, "w", false, "Keep refreshing the resource usage every interval.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageVMI() string {
+	return `  # Show the resource usage of all running VMIs in the namespace:
+  {{ProgramName}} top vmi
+
+  # Show the resource usage of the VMI 'myvmi':
+  {{ProgramName}} top vmi myvmi
+
+  # Show the resource usage aggregated per node across all namespaces, sorted by CPU usage:
+  {{ProgramName}} top vmi --all-namespaces --aggregate=node --sort-by=cpu
+
+  # Keep refreshing the resource usage every 10 seconds:
+  {{ProgramName}} top vmi --watch --interval=10s`
+}
+
+func (t *top) validate(args []string) error {
+	if t.interval <= 0 {
+		return fmt.Errorf("--%s must be greater than zero", intervalFlag)
+	}
+	if t.aggregate != "" && t.aggregate != aggregateNode && t.aggregate != aggregateNamespace {
+		return fmt.Errorf("invalid --%s value %q, must be %s or %s", aggregateFlag, t.aggregate, aggregateNode, aggregateNamespace)
+	}
+	if t.sortBy != "" && t.sortBy != sortByCPU && t.sortBy != sortByMemory {
+		return fmt.Errorf("invalid --%s value %q, must be %s or %s", sortByFlag, t.sortBy, sortByCPU, sortByMemory)
+	}
+	if len(args) == 1 && t.allNamespaces {
+		return fmt.Errorf("a VMI name cannot be specified together with --%s", allNamespacesFlag)
+	}
+	return nil
+}
+
+func (t *top) run(cmd *cobra.Command, args []string) error {
+	if err := t.validate(args); err != nil {
+		return err
+	}
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+	if t.allNamespaces {
+		namespace = metav1.NamespaceAll
+	}
+
+	ctx := cmd.Context()
+	prev, err := t.sample(ctx, cmd.ErrOrStderr(), virtClient, namespace, args)
+	if err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(t.interval):
+		}
+
+		cur, err := t.sample(ctx, cmd.ErrOrStderr(), virtClient, namespace, args)
+		if err != nil {
+			return err
+		}
+		if err := t.print(cmd.OutOrStdout(), computeUsage(prev, cur)); err != nil {
+			return err
+		}
+		if !t.watch {
+			return nil
+		}
+		prev = cur
+		fmt.Fprintln(cmd.OutOrStdout())
+	}
+}
+
+// sample fetches the stats of the requested VMIs, keyed by namespace/name
+func (t *top) sample(ctx context.Context, errOut io.Writer, virtClient kubecli.KubevirtClient, namespace string, args []string) (map[string]sample, error) {
+	samples := map[string]sample{}
+
+	if len(args) == 1 {
+		vmi, err := virtClient.VirtualMachineInstance(namespace).Get(ctx, args[0], metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting VirtualMachineInstance %s: %v", args[0], err)
+		}
+		if vmi.Status.Phase != v1.Running {
+			return nil, fmt.Errorf("VirtualMachineInstance %s is not running", args[0])
+		}
+		stats, err := virtClient.VirtualMachineInstance(namespace).Stats(ctx, vmi.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error getting stats of VirtualMachineInstance %s: %v", vmi.Name, err)
+		}
+		samples[key(vmi)] = sample{vmi: vmi, stats: stats}
+		return samples, nil
+	}
+
+	vmis, err := virtClient.VirtualMachineInstance(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing VirtualMachineInstances: %v", err)
+	}
+	for i := range vmis.Items {
+		vmi := &vmis.Items[i]
+		if vmi.Status.Phase != v1.Running {
+			continue
+		}
+		stats, err := virtClient.VirtualMachineInstance(vmi.Namespace).Stats(ctx, vmi.Name)
+		if err != nil {
+			// The VMI may have stopped in the meantime, do not fail the whole view
+			fmt.Fprintf(errOut, "skipping VirtualMachineInstance %s: %v\n", key(vmi), err)
+			continue
+		}
+		samples[key(vmi)] = sample{vmi: vmi, stats: stats}
+	}
+	return samples, nil
+}
+
+func key(vmi *v1.VirtualMachineInstance) string {
+	return vmi.Namespace + "/" + vmi.Name
+}
+
+// computeUsage calculates the usage of every VMI present in both samples
+func computeUsage(prev, cur map[string]sample) []usage {
+	var usages []usage
+	for k, c := range cur {
+		p, exists := prev[k]
+		if !exists {
+			continue
+		}
+		elapsed := c.stats.Timestamp.Sub(p.stats.Timestamp.Time).Seconds()
+
+		u := usage{
+			namespace: c.vmi.Namespace,
+			name:      c.vmi.Name,
+			node:      c.vmi.Status.NodeName,
+			vmis:      1,
+			vcpus:     c.stats.CPU.VCPUs,
+			memory:    c.stats.Memory.ResidentBytes,
+		}
+		if elapsed > 0 {
+			cpuTime := float64(c.stats.CPU.TimeNanoseconds - p.stats.CPU.TimeNanoseconds)
+			u.milliCores = int64(cpuTime / float64(time.Millisecond) / elapsed)
+			u.readRate, u.writeRate = storageRates(p.stats.Storage, c.stats.Storage, elapsed)
+			u.receiveRate, u.transmitRate = networkRates(p.stats.Network, c.stats.Network, elapsed)
+		}
+		usages = append(usages, u)
+	}
+
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].namespace != usages[j].namespace {
+			return usages[i].namespace < usages[j].namespace
+		}
+		return usages[i].name < usages[j].name
+	})
+	return usages
+}
+
+func storageRates(prev, cur []v1.VirtualMachineInstanceStorageStats, elapsed float64) (float64, float64) {
+	prevByName := map[string]v1.VirtualMachineInstanceStorageStats{}
+	for _, s := range prev {
+		prevByName[s.Name] = s
+	}
+	var read, write int64
+	for _, c := range cur {
+		p, exists := prevByName[c.Name]
+		if !exists {
+			continue
+		}
+		read += c.ReadBytes - p.ReadBytes
+		write += c.WriteBytes - p.WriteBytes
+	}
+	return float64(read) / elapsed, float64(write) / elapsed
+}
+
+func networkRates(prev, cur []v1.VirtualMachineInstanceNetworkStats, elapsed float64) (float64, float64) {
+	prevByName := map[string]v1.VirtualMachineInstanceNetworkStats{}
+	for _, n := range prev {
+		prevByName[n.Name] = n
+	}
+	var receive, transmit int64
+	for _, c := range cur {
+		p, exists := prevByName[c.Name]
+		if !exists {
+			continue
+		}
+		receive += c.ReceiveBytes - p.ReceiveBytes
+		transmit += c.TransmitBytes - p.TransmitBytes
+	}
+	return float64(receive) / elapsed, float64(transmit) / elapsed
+}
+
+// aggregateUsage sums up the usage of the VMIs per node or namespace
+func aggregateUsage(usages []usage, by string) []usage {
+	groups := map[string]*usage{}
+	var keys []string
+	for _, u := range usages {
+		groupKey := u.namespace
+		if by == aggregateNode {
+			groupKey = u.node
+		}
+		g, exists := groups[groupKey]
+		if !exists {
+			g = &usage{name: groupKey}
+			groups[groupKey] = g
+			keys = append(keys, groupKey)
+		}
+		g.vmis++
+		g.vcpus += u.vcpus
+		g.milliCores += u.milliCores
+		g.memory += u.memory
+		g.readRate += u.readRate
+		g.writeRate += u.writeRate
+		g.receiveRate += u.receiveRate
+		g.transmitRate += u.transmitRate
+	}
+
+	sort.Strings(keys)
+	aggregated := make([]usage, 0, len(keys))
+	for _, k := range keys {
+		aggregated = append(aggregated, *groups[k])
+	}
+	return aggregated
+}
+
+func (t *top) print(out io.Writer, usages []usage) error {
+	if t.aggregate != "" {
+		usages = aggregateUsage(usages, t.aggregate)
+	}
+	switch t.sortBy {
+	case sortByCPU:
+		sort.SliceStable(usages, func(i, j int) bool { return usages[i].milliCores > usages[j].milliCores })
+	case sortByMemory:
+		sort.SliceStable(usages, func(i, j int) bool { return usages[i].memory > usages[j].memory })
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	switch {
+	case t.aggregate == aggregateNode:
+		fmt.Fprint(w, "NODE\tVMIS\t")
+	case t.aggregate == aggregateNamespace:
+		fmt.Fprint(w, "NAMESPACE\tVMIS\t")
+	case t.allNamespaces:
+		fmt.Fprint(w, "NAMESPACE\tNAME\tNODE\t")
+	default:
+		fmt.Fprint(w, "NAME\tNODE\t")
+	}
+	fmt.Fprintln(w, "CPU(cores)\tCPU%\tMEMORY(bytes)\tDISK READ\tDISK WRITE\tNET RX\tNET TX")
+
+	for _, u := range usages {
+		switch {
+		case t.aggregate != "":
+			fmt.Fprintf(w, "%s\t%d\t", u.name, u.vmis)
+		case t.allNamespaces:
+			fmt.Fprintf(w, "%s\t%s\t%s\t", u.namespace, u.name, u.node)
+		default:
+			fmt.Fprintf(w, "%s\t%s\t", u.name, u.node)
+		}
+		fmt.Fprintf(w, "%dm\t%s\t%dMi\t%s\t%s\t%s\t%s\n",
+			u.milliCores,
+			cpuPercentage(u),
+			u.memory/(1024*1024),
+			formatRate(u.readRate),
+			formatRate(u.writeRate),
+			formatRate(u.receiveRate),
+			formatRate(u.transmitRate),
+		)
+	}
+	return w.Flush()
+}
+
+// cpuPercentage returns the CPU usage relative to the available vCPUs
+func cpuPercentage(u usage) string {
+	if u.vcpus == 0 {
+		return "<unknown>"
+	}
+	return fmt.Sprintf("%d%%", u.milliCores*100/(u.vcpus*1000))
+}
+
+func formatRate(bytesPerSecond float64) string {
+	units := []string{"B/s", "KiB/s", "MiB/s", "GiB/s"}
+	i := 0
+	for ; bytesPerSecond >= 1024 && i < len(units)-1; i++ {
+		bytesPerSecond /= 1024
+	}
+	if i == 0 {
+		return fmt.Sprintf("%.0f%s", bytesPerSecond, units[i])
+	}
+	return fmt.Sprintf("%.1f%s", bytesPerSecond, units[i])
+}
@@ -0,0 +1,175 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package top_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Top command", func() {
+	const (
+		otherNamespace = "other"
+		mib            = 1024 * 1024
+	)
+
+	var (
+		virtClient *kubevirtfake.Clientset
+		stats      map[string][]v1.VirtualMachineInstanceStats
+	)
+
+	start := time.Unix(1000, 0)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		virtClient = kubevirtfake.NewSimpleClientset()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(gomock.Any()).
+			DoAndReturn(func(namespace string) kubecli.VirtualMachineInstanceInterface {
+				return virtClient.KubevirtV1().VirtualMachineInstances(namespace)
+			}).AnyTimes()
+
+		stats = map[string][]v1.VirtualMachineInstanceStats{}
+		virtClient.PrependReactor("get", "virtualmachineinstances", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			get, ok := action.(k8stesting.GetAction)
+			Expect(ok).To(BeTrue())
+			if get.GetSubresource() != "stats" {
+				return false, nil, nil
+			}
+			samples := stats[get.GetName()]
+			Expect(samples).ToNot(BeEmpty(), "unexpected stats request for %s", get.GetName())
+			stats[get.GetName()] = samples[1:]
+			return true, &samples[0], nil
+		})
+	})
+
+	createVMI := func(namespace, name, node string, phase v1.VirtualMachineInstancePhase) {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Status:     v1.VirtualMachineInstanceStatus{Phase: phase, NodeName: node},
+		}
+		Expect(virtClient.Tracker().Add(vmi)).To(Succeed())
+	}
+
+	// newStats returns two samples taken one second apart, using the given
+	// CPU time, memory and rates per second
+	newStats := func(vcpus int64, cpuTime time.Duration, memory, storageRate, networkRate int64) []v1.VirtualMachineInstanceStats {
+		first := v1.VirtualMachineInstanceStats{
+			Timestamp: metav1.NewTime(start),
+			CPU:       v1.VirtualMachineInstanceCPUStats{VCPUs: vcpus, TimeNanoseconds: int64(time.Hour)},
+			Memory:    v1.VirtualMachineInstanceMemoryStats{ResidentBytes: memory},
+			Storage:   []v1.VirtualMachineInstanceStorageStats{{Name: "rootdisk", ReadBytes: 100, WriteBytes: 100}},
+			Network:   []v1.VirtualMachineInstanceNetworkStats{{Name: "default", ReceiveBytes: 100, TransmitBytes: 100}},
+		}
+		second := first
+		second.Timestamp = metav1.NewTime(start.Add(time.Second))
+		second.CPU.TimeNanoseconds += int64(cpuTime)
+		second.Storage = []v1.VirtualMachineInstanceStorageStats{{Name: "rootdisk", ReadBytes: 100 + storageRate, WriteBytes: 100 + 2*storageRate}}
+		second.Network = []v1.VirtualMachineInstanceNetworkStats{{Name: "default", ReceiveBytes: 100 + networkRate, TransmitBytes: 100 + 2*networkRate}}
+		return []v1.VirtualMachineInstanceStats{first, second}
+	}
+
+	run := func(args ...string) (string, error) {
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(append([]string{"top", "vmi", "--interval", "1ms"}, args...)...)()
+		return string(out), err
+	}
+
+	DescribeTable("should reject invalid flags", func(expectedErr string, args ...string) {
+		_, err := run(args...)
+		Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("with non-positive interval", "--interval must be greater than zero", "--interval", "0s"),
+		Entry("with invalid aggregate", `invalid --aggregate value "pod"`, "--aggregate", "pod"),
+		Entry("with invalid sort-by", `invalid --sort-by value "disk"`, "--sort-by", "disk"),
+		Entry("with name and all namespaces", "cannot be specified together with --all-namespaces", "myvmi", "-A"),
+	)
+
+	It("should fail when the VMI is not running", func() {
+		createVMI(metav1.NamespaceDefault, "myvmi", "node01", v1.Scheduled)
+		_, err := run("myvmi")
+		Expect(err).To(MatchError("VirtualMachineInstance myvmi is not running"))
+	})
+
+	It("should show the usage of a single VMI", func() {
+		createVMI(metav1.NamespaceDefault, "myvmi", "node01", v1.Running)
+		stats["myvmi"] = newStats(2, 500*time.Millisecond, 512*mib, 2048, 100)
+
+		out, err := run("myvmi")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(Equal(
+			"NAME    NODE     CPU(cores)   CPU%   MEMORY(bytes)   DISK READ   DISK WRITE   NET RX   NET TX\n" +
+				"myvmi   node01   500m         25%    512Mi           2.0KiB/s    4.0KiB/s     100B/s   200B/s\n",
+		))
+	})
+
+	It("should list running VMIs in all namespaces sorted by memory", func() {
+		createVMI(metav1.NamespaceDefault, "vmi-a", "node01", v1.Running)
+		createVMI(otherNamespace, "vmi-b", "node02", v1.Running)
+		createVMI(otherNamespace, "vmi-c", "node02", v1.Pending)
+		stats["vmi-a"] = newStats(1, 100*time.Millisecond, 256*mib, 0, 0)
+		stats["vmi-b"] = newStats(4, 2*time.Second, 1024*mib, 0, 0)
+
+		out, err := run("-A", "--sort-by", "memory")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(Equal(
+			"NAMESPACE   NAME    NODE     CPU(cores)   CPU%   MEMORY(bytes)   DISK READ   DISK WRITE   NET RX   NET TX\n" +
+				"other       vmi-b   node02   2000m        50%    1024Mi          0B/s        0B/s         0B/s     0B/s\n" +
+				"default     vmi-a   node01   100m         10%    256Mi           0B/s        0B/s         0B/s     0B/s\n",
+		))
+	})
+
+	DescribeTable("should aggregate the usage", func(aggregate, expected string) {
+		createVMI(metav1.NamespaceDefault, "vmi-a", "node01", v1.Running)
+		createVMI(metav1.NamespaceDefault, "vmi-b", "node02", v1.Running)
+		createVMI(otherNamespace, "vmi-c", "node02", v1.Running)
+		stats["vmi-a"] = newStats(1, 500*time.Millisecond, 256*mib, 1024, 0)
+		stats["vmi-b"] = newStats(1, 500*time.Millisecond, 256*mib, 1024, 0)
+		stats["vmi-c"] = newStats(2, time.Second, 512*mib, 1024, 0)
+
+		out, err := run("-A", "--aggregate", aggregate, "--sort-by", "cpu")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(Equal(expected))
+	},
+		Entry("per node", "node",
+			"NODE     VMIS   CPU(cores)   CPU%   MEMORY(bytes)   DISK READ   DISK WRITE   NET RX   NET TX\n"+
+				"node02   2      1500m        50%    768Mi           2.0KiB/s    4.0KiB/s     0B/s     0B/s\n"+
+				"node01   1      500m         50%    256Mi           1.0KiB/s    2.0KiB/s     0B/s     0B/s\n",
+		),
+		Entry("per namespace", "namespace",
+			"NAMESPACE   VMIS   CPU(cores)   CPU%   MEMORY(bytes)   DISK READ   DISK WRITE   NET RX   NET TX\n"+
+				"default     2      1000m        50%    512Mi           2.0KiB/s    4.0KiB/s     0B/s     0B/s\n"+
+				"other       1      1000m        50%    512Mi           1.0KiB/s    2.0KiB/s     0B/s     0B/s\n",
+		),
+	)
+})
@@ -32,6 +32,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
@@ -41,6 +42,7 @@ const (
 	cacheArg        = "cache"
 	diskTypeArg     = "disk-type"
 	busTypeArg      = "bus"
+	ioThreadArg     = "dedicated-io-thread"
 	concurrentError = "the server rejected our request due to an error in our request"
 	maxRetries      = 15
 )
@@ -50,6 +52,7 @@ var (
 	cache    string
 	diskType string
 	busType  string
+	ioThread bool
 )
 
 func NewAddVolumeCommand() *cobra.Command {
@@ -69,6 +72,7 @@ func NewAddVolumeCommand() *cobra.Command {
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
 	cmd.Flags().StringVar(&diskType, diskTypeArg, "disk", "specifies disk type to be hotplugged (disk/lun). Disk by default.")
 	cmd.Flags().StringVar(&busType, busTypeArg, string(v1.DiskBusSCSI), fmt.Sprintf("specifies disk bus. %s by default.", v1.DiskBusSCSI))
+	cmd.Flags().BoolVar(&ioThread, ioThreadArg, false, fmt.Sprintf("if set, the disk gets its own IO thread. Requires the %s bus.", v1.DiskBusVirtio))
 
 	return cmd
 }
@@ -85,6 +89,9 @@ func usageAddVolume() string {
 
   #Dynamically attach a volume with 'none' cache attribute to a running VM.
   {{ProgramName}} addvolume fedora-dv --volume-name=example-dv --cache=none
+
+  #Dynamically attach a volume on the virtio bus with a dedicated IO thread to a running VM.
+  {{ProgramName}} addvolume fedora-dv --volume-name=example-dv --bus=virtio --dedicated-io-thread
   `
 }
 
@@ -160,6 +167,13 @@ func addVolume(vmiName, volumeName, namespace string, virtClient kubecli.Kubevir
 		return fmt.Errorf("Invalid disk type '%s'. Only LUN and Disk are supported.", diskType)
 	}
 
+	if ioThread {
+		if hotplugRequest.Disk.Disk == nil || bus != v1.DiskBusVirtio {
+			return fmt.Errorf("A dedicated IO thread is only supported for disks on the '%s' bus.", v1.DiskBusVirtio)
+		}
+		hotplugRequest.Disk.DedicatedIOThread = pointer.P(true)
+	}
+
 	if serial != "" {
 		hotplugRequest.Disk.Serial = serial
 	} else {
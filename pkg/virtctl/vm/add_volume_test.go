@@ -205,6 +205,7 @@ var _ = Describe("Add volume command", func() {
 				Entry("cache writethrough", "--cache=writethrough", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteThrough)),
 				Entry("cache writeback", "--cache=writeback", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteBack)),
 				Entry("virtio bus", "--bus=virtio", verifyDiskSerial(volumeName), verifyBus(v1.DiskBusVirtio)),
+				Entry("dedicated IO thread", "--bus=virtio --dedicated-io-thread", verifyDiskSerial(volumeName), verifyBus(v1.DiskBusVirtio), verifyDedicatedIOThread),
 			)
 
 			DescribeTable("should call VM endpoint with persist and", func(arg string, verifyFns ...verifyFn) {
@@ -222,6 +223,7 @@ var _ = Describe("Add volume command", func() {
 				Entry("cache writethrough", "--cache=writethrough", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteThrough)),
 				Entry("cache writeback", "--cache=writeback", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteBack)),
 				Entry("virtio bus", "--bus=virtio", verifyDiskSerial(volumeName), verifyBus(v1.DiskBusVirtio)),
+				Entry("dedicated IO thread", "--bus=virtio --dedicated-io-thread", verifyDiskSerial(volumeName), verifyBus(v1.DiskBusVirtio), verifyDedicatedIOThread),
 			)
 
 			It("should fail immediately on non concurrent error", func() {
@@ -259,6 +261,14 @@ var _ = Describe("Add volume command", func() {
 				Entry("without persist", false),
 				Entry("with persist", true),
 			)
+
+			DescribeTable("should fail addvolume with a dedicated IO thread and", func(arg string) {
+				Expect(runCmd(false, arg)).To(
+					MatchError(ContainSubstring("A dedicated IO thread is only supported for disks on the 'virtio' bus.")))
+			},
+				Entry("scsi bus", "--dedicated-io-thread"),
+				Entry("LUN disk", "--disk-type=lun --dedicated-io-thread"),
+			)
 		})
 
 		Context("with PVC", func() {
@@ -291,6 +301,7 @@ var _ = Describe("Add volume command", func() {
 				Entry("cache writethrough", "--cache=writethrough", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteThrough)),
 				Entry("cache writeback", "--cache=writeback", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteBack)),
 				Entry("virtio bus", "--bus=virtio", verifyDiskSerial(volumeName), verifyBus(v1.DiskBusVirtio)),
+				Entry("dedicated IO thread", "--bus=virtio --dedicated-io-thread", verifyDiskSerial(volumeName), verifyBus(v1.DiskBusVirtio), verifyDedicatedIOThread),
 			)
 
 			DescribeTable("should call VM endpoint with persist and", func(arg string, verifyFns ...verifyFn) {
@@ -308,6 +319,7 @@ var _ = Describe("Add volume command", func() {
 				Entry("cache writethrough", "--cache=writethrough", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteThrough)),
 				Entry("cache writeback", "--cache=writeback", verifyDiskSerial(volumeName), verifyCache(v1.CacheWriteBack)),
 				Entry("virtio bus", "--bus=virtio", verifyDiskSerial(volumeName), verifyBus(v1.DiskBusVirtio)),
+				Entry("dedicated IO thread", "--bus=virtio --dedicated-io-thread", verifyDiskSerial(volumeName), verifyBus(v1.DiskBusVirtio), verifyDedicatedIOThread),
 			)
 		})
 	})
@@ -356,3 +368,7 @@ func verifyBus(bus v1.DiskBus) verifyFn {
 		Expect(volumeOptions.Disk.Disk.Bus).To(Equal(bus))
 	}
 }
+
+func verifyDedicatedIOThread(volumeOptions *v1.AddVolumeOptions) {
+	Expect(volumeOptions.Disk.DedicatedIOThread).To(HaveValue(BeTrue()))
+}
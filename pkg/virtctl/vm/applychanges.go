@@ -0,0 +1,89 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_APPLYCHANGES = "applychanges"
+
+	applyMethodArg = "method"
+)
+
+type applyChangesCommand struct {
+	method string
+}
+
+func NewApplyChangesCommand() *cobra.Command {
+	c := applyChangesCommand{}
+	cmd := &cobra.Command{
+		Use:     "applychanges (VM)",
+		Short:   "Apply the staged changes of a virtual machine.",
+		Long:    "Apply the staged changes of a virtual machine using the Staged change apply strategy to its running instance.",
+		Example: usageApplyChanges(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.applyChangesRun,
+	}
+	cmd.Flags().StringVar(&c.method, applyMethodArg, string(v1.ApplyChangesMethodRestart), "how the changes are applied, one of Restart, LiveMigrate or Hotplug")
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageApplyChanges() string {
+	return `  # Apply the staged changes of a virtual machine called 'myvm' by restarting it:
+  {{ProgramName}} applychanges myvm
+
+  # Apply the staged changes of a virtual machine called 'myvm' without restarting it:
+  {{ProgramName}} applychanges myvm --method=Hotplug`
+}
+
+func (c *applyChangesCommand) applyChangesRun(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	options := &v1.ApplyChangesOptions{
+		Method: v1.ApplyChangesMethod(c.method),
+		DryRun: setDryRunOption(dryRun),
+	}
+
+	err = virtClient.VirtualMachine(namespace).ApplyChanges(context.Background(), vmName, options)
+	if err != nil {
+		return fmt.Errorf("error applying the staged changes of VirtualMachine: %v", err)
+	}
+
+	fmt.Printf("The staged changes of VM %s were scheduled to be applied with method %s\n", vmName, c.method)
+
+	return nil
+}
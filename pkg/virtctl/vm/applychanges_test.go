@@ -0,0 +1,79 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Apply changes command", func() {
+	const vmName = "testvm"
+
+	var vmInterface *kubecli.MockVirtualMachineInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+	})
+
+	It("should fail with missing input parameters", func() {
+		cmd := testing.NewRepeatableVirtctlCommand("applychanges")
+		Expect(cmd()).To(MatchError("accepts 1 arg(s), received 0"))
+	})
+
+	It("should return the error of the apply changes request", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+		vmInterface.EXPECT().ApplyChanges(context.Background(), vmName, gomock.Any()).Return(errors.New("apply failed")).Times(1)
+
+		cmd := testing.NewRepeatableVirtctlCommand("applychanges", vmName)
+		Expect(cmd()).To(MatchError("error applying the staged changes of VirtualMachine: apply failed"))
+	})
+
+	DescribeTable("should apply the staged changes of a vm according to options", func(expectedOptions *v1.ApplyChangesOptions, extraArgs ...string) {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+		vmInterface.EXPECT().ApplyChanges(context.Background(), vmName, expectedOptions).Return(nil).Times(1)
+
+		args := append([]string{"applychanges", vmName}, extraArgs...)
+		Expect(testing.NewRepeatableVirtctlCommand(args...)()).To(Succeed())
+	},
+		Entry("with default",
+			&v1.ApplyChangesOptions{Method: v1.ApplyChangesMethodRestart}),
+		Entry("with method option",
+			&v1.ApplyChangesOptions{Method: v1.ApplyChangesMethodLiveMigrate},
+			"--method", "LiveMigrate"),
+		Entry("with dry-run option",
+			&v1.ApplyChangesOptions{Method: v1.ApplyChangesMethodRestart, DryRun: []string{k8smetav1.DryRunAll}},
+			"--dry-run"),
+	)
+})
@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/client-go/kubecli"
+)
+
+const (
+	selectorArg      = "selector"
+	allNamespacesArg = "all-namespaces"
+	concurrencyArg   = "concurrency"
+
+	defaultConcurrency = 5
+)
+
+// batchOptions allow to run a lifecycle command against all VMs matching a
+// label selector instead of a single named VM.
+type batchOptions struct {
+	selector      string
+	allNamespaces bool
+	concurrency   int
+}
+
+type batchFailure struct {
+	namespace string
+	name      string
+	err       error
+}
+
+func addBatchFlags(cmd *cobra.Command, b *batchOptions) {
+	cmd.Flags().StringVarP(&b.selector, selectorArg, "l", "", "--selector=app=foo: act on all virtual machines matching the label selector instead of a single named virtual machine.")
+	cmd.Flags().BoolVarP(&b.allNamespaces, allNamespacesArg, "A", false, "--all-namespaces=false: used with --selector, match the virtual machines across all namespaces.")
+	cmd.Flags().IntVar(&b.concurrency, concurrencyArg, defaultConcurrency, "--concurrency=5: used with --selector, how many virtual machines are processed in parallel.")
+}
+
+func (b *batchOptions) enabled() bool {
+	return b.selector != ""
+}
+
+// validateArgs requires either a single VM name or a label selector
+func (b *batchOptions) validateArgs(cmd *cobra.Command, args []string) error {
+	if !b.enabled() {
+		if b.allNamespaces {
+			return fmt.Errorf("--%s can only be used together with --%s", allNamespacesArg, selectorArg)
+		}
+		return cobra.ExactArgs(1)(cmd, args)
+	}
+	if len(args) > 0 {
+		return fmt.Errorf("a VM name cannot be specified together with --%s", selectorArg)
+	}
+	if b.concurrency < 1 {
+		return fmt.Errorf("--%s must be greater than zero", concurrencyArg)
+	}
+	return nil
+}
+
+// run calls action for every VM matching the selector, reports the progress
+// and fails with a summary if the action failed for any of them.
+func (b *batchOptions) run(cmd *cobra.Command, virtClient kubecli.KubevirtClient, namespace, command, done string, action func(namespace, name string) error) error {
+	if b.allNamespaces {
+		namespace = metav1.NamespaceAll
+	}
+
+	vms, err := virtClient.VirtualMachine(namespace).List(cmd.Context(), metav1.ListOptions{LabelSelector: b.selector})
+	if err != nil {
+		return fmt.Errorf("error listing VirtualMachines: %v", err)
+	}
+	total := len(vms.Items)
+	if total == 0 {
+		cmd.Printf("No VMs match the selector %s\n", b.selector)
+		return nil
+	}
+
+	var (
+		lock      sync.Mutex
+		wg        sync.WaitGroup
+		completed int
+		failures  []batchFailure
+	)
+	sem := make(chan struct{}, b.concurrency)
+	for i := range vms.Items {
+		vm := &vms.Items[i]
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := action(vm.Namespace, vm.Name)
+
+			lock.Lock()
+			defer lock.Unlock()
+			completed++
+			if err != nil {
+				failures = append(failures, batchFailure{namespace: vm.Namespace, name: vm.Name, err: err})
+				cmd.Printf("[%d/%d] VM %s/%s failed to %s: %v\n", completed, total, vm.Namespace, vm.Name, command, err)
+				return
+			}
+			cmd.Printf("[%d/%d] VM %s/%s %s\n", completed, total, vm.Namespace, vm.Name, done)
+		}()
+	}
+	wg.Wait()
+
+	if len(failures) == 0 {
+		cmd.Printf("All %d VMs were processed\n", total)
+		return nil
+	}
+
+	cmd.Printf("%d of %d VMs failed to %s:\n", len(failures), total, command)
+	for _, f := range failures {
+		cmd.Printf("  %s/%s: %v\n", f.namespace, f.name, f.err)
+	}
+	return fmt.Errorf("failed to %s %d of %d VMs", command, len(failures), total)
+}
@@ -0,0 +1,144 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Batch lifecycle commands", func() {
+	const selector = "app=foo"
+
+	var vmInterface *kubecli.MockVirtualMachineInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+	})
+
+	newVMList := func(namespace string, names ...string) *v1.VirtualMachineList {
+		list := &v1.VirtualMachineList{}
+		for _, name := range names {
+			vm := kubecli.NewMinimalVM(name)
+			vm.Namespace = namespace
+			list.Items = append(list.Items, *vm)
+		}
+		return list
+	}
+
+	DescribeTable("should reject invalid arguments", func(expectedErr string, args ...string) {
+		cmd := testing.NewRepeatableVirtctlCommand(args...)
+		Expect(cmd()).To(MatchError(expectedErr))
+	},
+		Entry("with name and selector", "a VM name cannot be specified together with --selector", "stop", "testvm", "-l", selector),
+		Entry("with all namespaces but no selector", "--all-namespaces can only be used together with --selector", "start", "testvm", "--all-namespaces"),
+		Entry("with invalid concurrency", "--concurrency must be greater than zero", "restart", "-l", selector, "--concurrency", "0"),
+	)
+
+	It("should report when no VM matches the selector", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+		vmInterface.EXPECT().List(gomock.Any(), k8smetav1.ListOptions{LabelSelector: selector}).Return(&v1.VirtualMachineList{}, nil).Times(1)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("stop", "-l", selector)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal("No VMs match the selector app=foo\n"))
+	})
+
+	It("should stop all matching VMs", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(4)
+		vmInterface.EXPECT().List(gomock.Any(), k8smetav1.ListOptions{LabelSelector: selector}).
+			Return(newVMList(k8smetav1.NamespaceDefault, "vm1", "vm2", "vm3"), nil).Times(1)
+		for _, name := range []string{"vm1", "vm2", "vm3"} {
+			vmInterface.EXPECT().Stop(context.Background(), name, &v1.StopOptions{}).Return(nil).Times(1)
+		}
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("stop", "-l", selector, "--concurrency", "2")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(And(
+			ContainSubstring("VM default/vm1 was scheduled to stop\n"),
+			ContainSubstring("VM default/vm2 was scheduled to stop\n"),
+			ContainSubstring("VM default/vm3 was scheduled to stop\n"),
+			ContainSubstring("[3/3] "),
+			HaveSuffix("All 3 VMs were processed\n"),
+		))
+	})
+
+	It("should start the matching VMs across all namespaces and summarize failures", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceAll).Return(vmInterface).Times(1)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine("ns1").Return(vmInterface).Times(1)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine("ns2").Return(vmInterface).Times(1)
+
+		list := newVMList("ns1", "vm1")
+		list.Items = append(list.Items, newVMList("ns2", "vm2").Items...)
+		vmInterface.EXPECT().List(gomock.Any(), k8smetav1.ListOptions{LabelSelector: selector}).Return(list, nil).Times(1)
+		vmInterface.EXPECT().Start(context.Background(), "vm1", &v1.StartOptions{}).Return(nil).Times(1)
+		vmInterface.EXPECT().Start(context.Background(), "vm2", &v1.StartOptions{}).Return(fmt.Errorf("boom")).Times(1)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("start", "-l", selector, "-A", "--concurrency", "1")()
+		Expect(err).To(MatchError("failed to start 1 of 2 VMs"))
+		Expect(string(out)).To(Equal(
+			"[1/2] VM ns1/vm1 was scheduled to start\n" +
+				"[2/2] VM ns2/vm2 failed to start: Error starting VirtualMachine boom\n" +
+				"1 of 2 VMs failed to start:\n" +
+				"  ns2/vm2: Error starting VirtualMachine boom\n",
+		))
+	})
+
+	It("should restart all matching VMs", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(2)
+		vmInterface.EXPECT().List(gomock.Any(), k8smetav1.ListOptions{LabelSelector: selector}).
+			Return(newVMList(k8smetav1.NamespaceDefault, "vm1"), nil).Times(1)
+		vmInterface.EXPECT().Restart(context.Background(), "vm1", &v1.RestartOptions{}).Return(nil).Times(1)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("restart", "-l", selector)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal("[1/1] VM default/vm1 was scheduled to restart\nAll 1 VMs were processed\n"))
+	})
+
+	It("should migrate all matching VMs", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(3)
+		vmInterface.EXPECT().List(gomock.Any(), k8smetav1.ListOptions{LabelSelector: selector}).
+			Return(newVMList(k8smetav1.NamespaceDefault, "vm1", "vm2"), nil).Times(1)
+		vmInterface.EXPECT().Migrate(context.Background(), "vm1", &v1.MigrateOptions{}).Return(nil).Times(1)
+		vmInterface.EXPECT().Migrate(context.Background(), "vm2", &v1.MigrateOptions{}).Return(nil).Times(1)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("migrate", "-l", selector, "--concurrency", "1")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal(
+			"[1/2] VM default/vm1 was scheduled to migrate\n" +
+				"[2/2] VM default/vm2 was scheduled to migrate\n" +
+				"All 2 VMs were processed\n",
+		))
+	})
+})
@@ -50,6 +50,7 @@ var (
 
 type Command struct {
 	command string
+	batch   batchOptions
 }
 
 func usage(cmd string) string {
@@ -60,6 +61,10 @@ func usage(cmd string) string {
 	return fmt.Sprintf("  # %s a virtual machine called 'myvm':\n  {{ProgramName}} %s myvm", strings.Title(cmd), cmd)
 }
 
+func batchUsage(cmd string) string {
+	return usage(cmd) + fmt.Sprintf("\n\n  # %s all virtual machines labeled app=foo in all namespaces, 10 at a time:\n  {{ProgramName}} %s -l app=foo --all-namespaces --concurrency 10", strings.Title(cmd), cmd)
+}
+
 func setDryRunOption(dryRun bool) []string {
 	if dryRun {
 		fmt.Printf("Dry Run execution\n")
@@ -0,0 +1,91 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_GUESTEXEC = "guestexec"
+
+	guestExecTimeoutArg = "timeout"
+)
+
+type guestExecCommand struct {
+	timeoutSeconds int32
+}
+
+func NewGuestExecCommand() *cobra.Command {
+	c := guestExecCommand{}
+	cmd := &cobra.Command{
+		Use:     "guestexec (VMI) -- COMMAND [ARGS...]",
+		Short:   "Run a command in the guest through the guest agent.",
+		Long:    "Run a command in the guest through the guest agent and print its output once it exited. Requires the GuestOSExec feature gate.",
+		Example: usageGuestExec(),
+		Args:    cobra.MinimumNArgs(2),
+		RunE:    c.guestExecRun,
+	}
+	cmd.Flags().Int32Var(&c.timeoutSeconds, guestExecTimeoutArg, 10, "seconds to wait for the command to exit, at most 300")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageGuestExec() string {
+	return `  # Show the mounted filesystems of the virtual machine instance 'myvmi':
+  {{ProgramName}} guestexec myvmi -- df -h
+
+  # Run a script which needs up to a minute to complete:
+  {{ProgramName}} guestexec myvmi --timeout=60 -- /usr/local/bin/backup.sh`
+}
+
+func (c *guestExecCommand) guestExecRun(cmd *cobra.Command, args []string) error {
+	vmiName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	options := &v1.GuestOSExecOptions{
+		Command:        args[1],
+		Args:           args[2:],
+		TimeoutSeconds: &c.timeoutSeconds,
+	}
+	result, err := virtClient.VirtualMachineInstance(namespace).GuestOSExec(context.Background(), vmiName, options)
+	if err != nil {
+		return fmt.Errorf("Error running command in VirtualMachineInstance %s, %v", vmiName, err)
+	}
+
+	fmt.Fprint(cmd.OutOrStdout(), result.Stdout)
+	fmt.Fprint(cmd.ErrOrStderr(), result.Stderr)
+	if result.ExitCode != 0 {
+		return fmt.Errorf("command %s exited with code %d", options.Command, result.ExitCode)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Guest exec command", func() {
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var ctrl *gomock.Controller
+	const vmiName = "testvmi"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+	})
+
+	expectGuestOSExec := func(opts *v1.GuestOSExecOptions, result v1.GuestOSExecResult, err error) {
+		kubecli.MockKubevirtClientInstance.
+			EXPECT().
+			VirtualMachineInstance(k8smetav1.NamespaceDefault).
+			Return(vmiInterface).
+			Times(1)
+		vmiInterface.EXPECT().GuestOSExec(context.Background(), vmiName, opts).Return(result, err).Times(1)
+	}
+
+	It("should fail without a command", func() {
+		cmd := testing.NewRepeatableVirtctlCommand("guestexec", vmiName)
+		Expect(cmd()).To(MatchError("requires at least 2 arg(s), only received 1"))
+	})
+
+	It("should run the command with its args and timeout", func() {
+		expectGuestOSExec(&v1.GuestOSExecOptions{
+			Command:        "df",
+			Args:           []string{"-h", "/"},
+			TimeoutSeconds: pointer.P(int32(60)),
+		}, v1.GuestOSExecResult{Stdout: "output"}, nil)
+
+		cmd := testing.NewRepeatableVirtctlCommand("guestexec", vmiName, "--timeout=60", "--", "df", "-h", "/")
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should fail when the command exits with a non zero code", func() {
+		expectGuestOSExec(&v1.GuestOSExecOptions{
+			Command:        "false",
+			Args:           []string{},
+			TimeoutSeconds: pointer.P(int32(10)),
+		}, v1.GuestOSExecResult{ExitCode: 1}, nil)
+
+		cmd := testing.NewRepeatableVirtctlCommand("guestexec", vmiName, "--", "false")
+		Expect(cmd()).To(MatchError("command false exited with code 1"))
+	})
+
+	It("should fail when the request fails", func() {
+		expectGuestOSExec(&v1.GuestOSExecOptions{
+			Command:        "ls",
+			Args:           []string{},
+			TimeoutSeconds: pointer.P(int32(10)),
+		}, v1.GuestOSExecResult{}, fmt.Errorf("VMI does not have guest agent connected"))
+
+		cmd := testing.NewRepeatableVirtctlCommand("guestexec", vmiName, "--", "ls")
+		Expect(cmd()).To(MatchError("Error running command in VirtualMachineInstance testvmi, VMI does not have guest agent connected"))
+	})
+})
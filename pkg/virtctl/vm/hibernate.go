@@ -0,0 +1,130 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_HIBERNATE = "hibernate"
+	COMMAND_RESUME    = "resume"
+
+	storageClassArg = "storage-class"
+)
+
+type hibernateCommand struct {
+	storageClass string
+}
+
+func NewHibernateCommand() *cobra.Command {
+	c := hibernateCommand{}
+	cmd := &cobra.Command{
+		Use:   "hibernate (VM)",
+		Short: "Save the memory of a virtual machine to a PVC and stop it.",
+		Long: `Pause a running virtual machine, save its memory to a PVC and stop it, so it does not hold the resources of a node.
+The virtual machine continues where it left off once it is resumed.`,
+		Example: usageHibernate(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.hibernateRun,
+	}
+	cmd.Flags().StringVar(&c.storageClass, storageClassArg, "", "The storage class of the PVC the memory is saved to, the default storage class is used if not set.")
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func NewResumeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "resume (VM)",
+		Short:   "Resume a hibernated virtual machine.",
+		Long:    "Start a hibernated virtual machine again from the memory saved when it was hibernated.",
+		Example: usageResume(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    resumeRun,
+	}
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageHibernate() string {
+	return `  # Hibernate a virtual machine called 'myvm':
+  {{ProgramName}} hibernate myvm
+
+  # Hibernate a virtual machine called 'myvm', saving its memory to a PVC of the storage class 'fast':
+  {{ProgramName}} hibernate myvm --storage-class=fast`
+}
+
+func usageResume() string {
+	return `  # Resume a hibernated virtual machine called 'myvm':
+  {{ProgramName}} resume myvm`
+}
+
+func (c *hibernateCommand) hibernateRun(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	options := &v1.HibernateOptions{DryRun: setDryRunOption(dryRun)}
+	if c.storageClass != "" {
+		options.StorageClassName = pointer.P(c.storageClass)
+	}
+
+	err = virtClient.VirtualMachine(namespace).Hibernate(context.Background(), vmName, options)
+	if err != nil {
+		return fmt.Errorf("error hibernating VirtualMachine: %v", err)
+	}
+
+	fmt.Printf("VM %s was scheduled to hibernate\n", vmName)
+
+	return nil
+}
+
+func resumeRun(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	options := &v1.ResumeOptions{DryRun: setDryRunOption(dryRun)}
+	err = virtClient.VirtualMachine(namespace).Resume(context.Background(), vmName, options)
+	if err != nil {
+		return fmt.Errorf("error resuming VirtualMachine: %v", err)
+	}
+
+	fmt.Printf("VM %s was scheduled to resume\n", vmName)
+
+	return nil
+}
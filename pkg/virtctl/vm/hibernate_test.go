@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Hibernate command", func() {
+	const vmName = "testvm"
+
+	var vmInterface *kubecli.MockVirtualMachineInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+	})
+
+	Context("hibernate", func() {
+		It("should fail with missing input parameters", func() {
+			cmd := testing.NewRepeatableVirtctlCommand("hibernate")
+			Expect(cmd()).To(MatchError("accepts 1 arg(s), received 0"))
+		})
+
+		It("should return the error of the hibernate request", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().Hibernate(context.Background(), vmName, gomock.Any()).Return(errors.New("hibernate failed")).Times(1)
+
+			cmd := testing.NewRepeatableVirtctlCommand("hibernate", vmName)
+			Expect(cmd()).To(MatchError("error hibernating VirtualMachine: hibernate failed"))
+		})
+
+		DescribeTable("should hibernate a vm according to options", func(expectedHibernateOptions *v1.HibernateOptions, extraArgs ...string) {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().Hibernate(context.Background(), vmName, expectedHibernateOptions).Return(nil).Times(1)
+
+			args := append([]string{"hibernate", vmName}, extraArgs...)
+			Expect(testing.NewRepeatableVirtctlCommand(args...)()).To(Succeed())
+		},
+			Entry("with default",
+				&v1.HibernateOptions{}),
+			Entry("with storage-class option",
+				&v1.HibernateOptions{StorageClassName: pointer.P("fast")},
+				"--storage-class", "fast"),
+			Entry("with dry-run option",
+				&v1.HibernateOptions{DryRun: []string{k8smetav1.DryRunAll}},
+				"--dry-run"),
+		)
+	})
+
+	Context("resume", func() {
+		It("should fail with missing input parameters", func() {
+			cmd := testing.NewRepeatableVirtctlCommand("resume")
+			Expect(cmd()).To(MatchError("accepts 1 arg(s), received 0"))
+		})
+
+		It("should return the error of the resume request", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().Resume(context.Background(), vmName, gomock.Any()).Return(errors.New("resume failed")).Times(1)
+
+			cmd := testing.NewRepeatableVirtctlCommand("resume", vmName)
+			Expect(cmd()).To(MatchError("error resuming VirtualMachine: resume failed"))
+		})
+
+		It("should resume a vm", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().Resume(context.Background(), vmName, &v1.ResumeOptions{}).Return(nil).Times(1)
+
+			Expect(testing.NewRepeatableVirtctlCommand("resume", vmName)()).To(Succeed())
+		})
+	})
+})
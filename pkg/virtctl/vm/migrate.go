@@ -22,41 +22,67 @@ package vm
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
 
 const COMMAND_MIGRATE = "migrate"
 
+const (
+	waitArg    = "wait"
+	timeoutArg = "timeout"
+
+	defaultMigrationWaitTimeout = 30 * time.Minute
+	migrationPollInterval       = 2 * time.Second
+)
+
 type migrateCommand struct {
 	command           string
 	addedNodeSelector map[string]string
+	warm              bool
+	cutover           bool
+	wait              bool
+	timeout           time.Duration
+	batch             batchOptions
 }
 
 func NewMigrateCommand() *cobra.Command {
 	c := migrateCommand{command: COMMAND_MIGRATE}
 	cmd := &cobra.Command{
-		Use:     "migrate (VM)",
+		Use:     "migrate (VM | --selector SELECTOR)",
 		Short:   "Migrate a virtual machine.",
-		Example: usage(COMMAND_MIGRATE),
-		Args:    cobra.ExactArgs(1),
+		Example: batchUsage(COMMAND_MIGRATE),
+		Args:    c.batch.validateArgs,
 		RunE:    c.migrateRun,
 	}
 
 	cmd.Flags().StringToStringVar(&c.addedNodeSelector, "addedNodeSelector", nil, "--addedNodeSelector=key=value1,key2=value2: configure an additional node selector for the one-off migration attempt. AddedNodeSelector can only restrict constraints already set on the VM. By default the scheduler is responsible for finding the best Node, which is the recommended way of migrating VMs.")
+	cmd.Flags().BoolVar(&c.warm, "warm", false, "--warm=true: keep copying the disks and the memory of the VM to the target until the cutover is requested with --cutover. Useful for VMs with large non-shared disks.")
+	cmd.Flags().BoolVar(&c.cutover, "cutover", false, "--cutover=true: request the cutover of the running warm migration of the VM.")
+	cmd.Flags().BoolVar(&c.wait, waitArg, false, "--wait=true: wait for the migration to complete and report its progress. Fails if the migration fails.")
+	cmd.Flags().DurationVar(&c.timeout, timeoutArg, defaultMigrationWaitTimeout, "--timeout=1h: how long to wait for the migration to complete, used with --wait.")
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	addBatchFlags(cmd, &c.batch)
+	cmd.MarkFlagsMutuallyExclusive(waitArg, dryRunArg)
+	cmd.MarkFlagsMutuallyExclusive("cutover", "warm")
+	cmd.MarkFlagsMutuallyExclusive("cutover", "addedNodeSelector")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
 
 func (c *migrateCommand) migrateRun(cmd *cobra.Command, args []string) error {
-	vmiName := args[0]
-
 	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
 	if err != nil {
 		return err
@@ -64,9 +90,52 @@ func (c *migrateCommand) migrateRun(cmd *cobra.Command, args []string) error {
 
 	dryRunOption := setDryRunOption(dryRun)
 
+	if c.batch.enabled() {
+		done := "was scheduled to " + c.command
+		switch {
+		case c.wait:
+			done = "was migrated"
+		case c.cutover:
+			done = "was requested to cut over"
+		}
+		return c.batch.run(cmd, virtClient, namespace, c.command, done, func(namespace, name string) error {
+			return c.migrateVM(cmd, virtClient, namespace, name, dryRunOption)
+		})
+	}
+
+	return c.migrateVM(cmd, virtClient, namespace, args[0], dryRunOption)
+}
+
+// migrateVM migrates or cuts over the migration of a single VM, and waits for
+// the migration to complete if requested.
+func (c *migrateCommand) migrateVM(cmd *cobra.Command, virtClient kubecli.KubevirtClient, namespace, vmiName string, dryRunOption []string) error {
+	var err error
+	if c.cutover {
+		if err := cutoverWarmMigration(virtClient, namespace, vmiName, dryRunOption); err != nil {
+			return err
+		}
+		if !c.batch.enabled() {
+			fmt.Printf("Cutover of the migration of VM %s was requested\n", vmiName)
+		}
+		if c.wait {
+			return waitForMigration(cmd, virtClient, namespace, vmiName, nil, c.timeout)
+		}
+		return nil
+	}
+
+	// Remember the previous migrations of the VM, to tell the new one apart
+	var previousMigrations map[types.UID]bool
+	if c.wait {
+		previousMigrations, err = listMigrationUIDs(virtClient, namespace, vmiName)
+		if err != nil {
+			return err
+		}
+	}
+
 	options := &v1.MigrateOptions{
 		DryRun:            dryRunOption,
 		AddedNodeSelector: c.addedNodeSelector,
+		Warm:              c.warm,
 	}
 
 	err = virtClient.VirtualMachine(namespace).Migrate(context.Background(), vmiName, options)
@@ -74,7 +143,127 @@ func (c *migrateCommand) migrateRun(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("Error migrating VirtualMachine %v", err)
 	}
 
-	fmt.Printf("VM %s was scheduled to %s\n", vmiName, c.command)
+	if !c.batch.enabled() {
+		fmt.Printf("VM %s was scheduled to %s\n", vmiName, c.command)
+	}
+
+	if c.wait {
+		return waitForMigration(cmd, virtClient, namespace, vmiName, previousMigrations, c.timeout)
+	}
+
+	return nil
+}
+
+func listMigrationUIDs(virtClient kubecli.KubevirtClient, namespace, vmiName string) (map[types.UID]bool, error) {
+	migrations, err := virtClient.VirtualMachineInstanceMigration(namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s==%s", v1.MigrationSelectorLabel, vmiName)})
+	if err != nil {
+		return nil, fmt.Errorf("Error fetching virtual machine instance migration list  %v", err)
+	}
+	uids := map[types.UID]bool{}
+	for _, mig := range migrations.Items {
+		uids[mig.UID] = true
+	}
+	return uids, nil
+}
+
+// waitForMigration waits for the most recent migration of the VM, which is not part of
+// previousMigrations, to complete and reports its progress while it runs.
+func waitForMigration(cmd *cobra.Command, virtClient kubecli.KubevirtClient, namespace, vmiName string, previousMigrations map[types.UID]bool, timeout time.Duration) error {
+	lastStatus := ""
+	err := virtwait.PollImmediately(migrationPollInterval, timeout, func(ctx context.Context) (bool, error) {
+		migrations, err := virtClient.VirtualMachineInstanceMigration(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s==%s", v1.MigrationSelectorLabel, vmiName)})
+		if err != nil {
+			return false, err
+		}
+
+		var migration *v1.VirtualMachineInstanceMigration
+		for i := range migrations.Items {
+			mig := &migrations.Items[i]
+			if previousMigrations[mig.UID] {
+				continue
+			}
+			if migration == nil || migration.CreationTimestamp.Before(&mig.CreationTimestamp) {
+				migration = mig
+			}
+		}
+		if migration == nil {
+			cmd.Printf("Waiting for the migration of VM %s to be created...\n", vmiName)
+			return false, nil
+		}
+
+		switch migration.Status.Phase {
+		case v1.MigrationSucceeded:
+			cmd.Printf("Migration %s of VM %s succeeded\n", migration.Name, vmiName)
+			return true, nil
+		case v1.MigrationFailed:
+			return false, fmt.Errorf("Migration %s of VM %s failed%s", migration.Name, vmiName, migrationFailureReason(migration))
+		}
+
+		status := fmt.Sprintf("Migration %s of VM %s, current phase: %s%s", migration.Name, vmiName, migration.Status.Phase, migrationProgress(migration))
+		if status != lastStatus {
+			cmd.Println(status)
+			lastStatus = status
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for the migration of VM %s: %v", vmiName, err)
+	}
 
 	return nil
 }
+
+func migrationFailureReason(migration *v1.VirtualMachineInstanceMigration) string {
+	if migration.Status.MigrationState == nil || migration.Status.MigrationState.FailureReason == "" {
+		return ""
+	}
+	return ": " + migration.Status.MigrationState.FailureReason
+}
+
+func migrationProgress(migration *v1.VirtualMachineInstanceMigration) string {
+	if migration.Status.MigrationState == nil || migration.Status.MigrationState.Progress == nil {
+		return ""
+	}
+	progress := migration.Status.MigrationState.Progress
+	return fmt.Sprintf(", transferred: %s of %s, remaining: %s, dirty rate: %s/s, iteration: %d",
+		formatBytes(progress.DataProcessedBytes), formatBytes(progress.DataTotalBytes),
+		formatBytes(progress.DataRemainingBytes), formatBytes(progress.MemoryDirtyRateBytesPerSecond),
+		progress.Iterations)
+}
+
+func formatBytes(bytes int64) string {
+	return resource.NewQuantity(bytes, resource.BinarySI).String()
+}
+
+func cutoverWarmMigration(virtClient kubecli.KubevirtClient, namespace, vmiName string, dryRunOption []string) error {
+	labelselector := fmt.Sprintf("%s==%s", v1.MigrationSelectorLabel, vmiName)
+	migrations, err := virtClient.VirtualMachineInstanceMigration(namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: labelselector})
+	if err != nil {
+		return fmt.Errorf("Error fetching virtual machine instance migration list  %v", err)
+	}
+
+	for _, mig := range migrations.Items {
+		if mig.IsFinal() {
+			continue
+		}
+		if mig.Spec.Warm == nil {
+			return fmt.Errorf("Migration %s of VirtualMachine %s is not a warm migration", mig.Name, vmiName)
+		}
+
+		payload, err := patch.New(patch.WithAdd("/spec/warm/cutover", true)).GeneratePayload()
+		if err != nil {
+			return err
+		}
+		_, err = virtClient.VirtualMachineInstanceMigration(namespace).Patch(context.Background(), mig.Name, types.JSONPatchType, payload, metav1.PatchOptions{DryRun: dryRunOption})
+		if err != nil {
+			return fmt.Errorf("Error requesting the cutover of migration %s of VirtualMachine %s: %v", mig.Name, vmiName, err)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("Found no migration to cut over for %s", vmiName)
+}
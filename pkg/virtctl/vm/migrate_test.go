@@ -21,16 +21,19 @@ package vm_test
 
 import (
 	"context"
+	"fmt"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/virtctl/testing"
 )
 
@@ -82,6 +85,11 @@ var _ = Describe("Migrate command", func() {
 				AddedNodeSelector: map[string]string{"key1": "value1", "key2": "value2"},
 				DryRun:            []string{k8smetav1.DryRunAll}},
 			"--dry-run", "--addedNodeSelector", "key1=value1,key2=value2"),
+		Entry(
+			"with warm option",
+			&v1.MigrateOptions{
+				Warm: true},
+			"--warm"),
 		Entry(
 			"with repeated addedNodeSelector",
 			&v1.MigrateOptions{
@@ -104,4 +112,143 @@ var _ = Describe("Migrate command", func() {
 			"--addedNodeSelector", "key1,key2"),
 	)
 
+	Context("wait", func() {
+		var migrationInterface *kubecli.MockVirtualMachineInstanceMigrationInterface
+		var previousMigration *v1.VirtualMachineInstanceMigration
+		var migration *v1.VirtualMachineInstanceMigration
+		var listOptions k8smetav1.ListOptions
+
+		expectMigrationLists := func(migrations ...*v1.VirtualMachineInstanceMigration) {
+			var calls []any
+			calls = append(calls, migrationInterface.EXPECT().List(gomock.Any(), listOptions).Return(&v1.VirtualMachineInstanceMigrationList{
+				Items: []v1.VirtualMachineInstanceMigration{*previousMigration},
+			}, nil))
+			for _, mig := range migrations {
+				calls = append(calls, migrationInterface.EXPECT().List(gomock.Any(), listOptions).Return(&v1.VirtualMachineInstanceMigrationList{
+					Items: []v1.VirtualMachineInstanceMigration{*previousMigration, *mig},
+				}, nil))
+			}
+			gomock.InOrder(calls...)
+		}
+
+		BeforeEach(func() {
+			migrationInterface = kubecli.NewMockVirtualMachineInstanceMigrationInterface(ctrl)
+			listOptions = k8smetav1.ListOptions{LabelSelector: fmt.Sprintf("%s==%s", v1.MigrationSelectorLabel, vmName)}
+			kubecli.MockKubevirtClientInstance.EXPECT().
+				VirtualMachineInstanceMigration(k8smetav1.NamespaceDefault).
+				Return(migrationInterface).AnyTimes()
+
+			previousMigration = kubecli.NewMinimalMigration("previous")
+			previousMigration.UID = "previous-uid"
+			previousMigration.Status.Phase = v1.MigrationSucceeded
+			migration = kubecli.NewMinimalMigration("current")
+			migration.UID = "current-uid"
+
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().Migrate(context.Background(), vmName, &v1.MigrateOptions{}).Return(nil).Times(1)
+		})
+
+		It("should succeed once the migration succeeded", func() {
+			running := migration.DeepCopy()
+			running.Status.Phase = v1.MigrationRunning
+			running.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				Progress: &v1.MigrationProgress{
+					DataTotalBytes:                4 * 1024 * 1024 * 1024,
+					DataProcessedBytes:            1024 * 1024 * 1024,
+					DataRemainingBytes:            3 * 1024 * 1024 * 1024,
+					MemoryDirtyRateBytesPerSecond: 10 * 1024 * 1024,
+					Iterations:                    2,
+				},
+			}
+			migration.Status.Phase = v1.MigrationSucceeded
+			expectMigrationLists(running, migration)
+
+			cmd := testing.NewRepeatableVirtctlCommandWithOut("migrate", vmName, "--wait")
+			out, err := cmd()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("current phase: Running, transferred: 1Gi of 4Gi, remaining: 3Gi, dirty rate: 10Mi/s, iteration: 2"))
+			Expect(string(out)).To(ContainSubstring("Migration current of VM testvm succeeded"))
+		})
+
+		It("should fail with the failure reason once the migration failed", func() {
+			migration.Status.Phase = v1.MigrationFailed
+			migration.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				FailureReason: "target pod unschedulable",
+			}
+			expectMigrationLists(migration)
+
+			err := testing.NewRepeatableVirtctlCommand("migrate", vmName, "--wait")()
+			Expect(err).To(MatchError(ContainSubstring("Migration current of VM testvm failed: target pod unschedulable")))
+		})
+
+		It("should fail when the timeout expires", func() {
+			migration.Status.Phase = v1.MigrationRunning
+			migrationInterface.EXPECT().List(gomock.Any(), listOptions).Return(&v1.VirtualMachineInstanceMigrationList{
+				Items: []v1.VirtualMachineInstanceMigration{*migration},
+			}, nil).AnyTimes()
+
+			err := testing.NewRepeatableVirtctlCommand("migrate", vmName, "--wait", "--timeout", "1s")()
+			Expect(err).To(MatchError(ContainSubstring("error waiting for the migration of VM testvm")))
+		})
+	})
+
+	It("should not combine wait and dry-run", func() {
+		err := testing.NewRepeatableVirtctlCommand("migrate", vmName, "--wait", "--dry-run")()
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("cutover", func() {
+		var migrationInterface *kubecli.MockVirtualMachineInstanceMigrationInterface
+		var migration *v1.VirtualMachineInstanceMigration
+		var listOptions k8smetav1.ListOptions
+
+		BeforeEach(func() {
+			migrationInterface = kubecli.NewMockVirtualMachineInstanceMigrationInterface(ctrl)
+			migration = kubecli.NewMinimalMigration(fmt.Sprintf("%s-%s", vmName, "migration"))
+			migration.Status.Phase = v1.MigrationRunning
+			listOptions = k8smetav1.ListOptions{LabelSelector: fmt.Sprintf("%s==%s", v1.MigrationSelectorLabel, vmName)}
+			kubecli.MockKubevirtClientInstance.EXPECT().
+				VirtualMachineInstanceMigration(k8smetav1.NamespaceDefault).
+				Return(migrationInterface).AnyTimes()
+		})
+
+		It("should request the cutover of the warm migration", func() {
+			migration.Spec.Warm = &v1.WarmMigration{}
+			migrationInterface.EXPECT().List(context.Background(), listOptions).Return(&v1.VirtualMachineInstanceMigrationList{
+				Items: []v1.VirtualMachineInstanceMigration{*migration},
+			}, nil).Times(1)
+
+			payload, err := patch.New(patch.WithAdd("/spec/warm/cutover", true)).GeneratePayload()
+			Expect(err).ToNot(HaveOccurred())
+			migrationInterface.EXPECT().Patch(context.Background(), migration.Name, types.JSONPatchType, payload, k8smetav1.PatchOptions{}).Return(migration, nil).Times(1)
+
+			Expect(testing.NewRepeatableVirtctlCommand("migrate", vmName, "--cutover")()).To(Succeed())
+		})
+
+		It("should fail if the migration is not warm", func() {
+			migrationInterface.EXPECT().List(context.Background(), listOptions).Return(&v1.VirtualMachineInstanceMigrationList{
+				Items: []v1.VirtualMachineInstanceMigration{*migration},
+			}, nil).Times(1)
+
+			err := testing.NewRepeatableVirtctlCommand("migrate", vmName, "--cutover")()
+			Expect(err).To(MatchError(ContainSubstring("is not a warm migration")))
+		})
+
+		It("should fail if no active migration is found", func() {
+			migration.Spec.Warm = &v1.WarmMigration{}
+			migration.Status.Phase = v1.MigrationSucceeded
+			migrationInterface.EXPECT().List(context.Background(), listOptions).Return(&v1.VirtualMachineInstanceMigrationList{
+				Items: []v1.VirtualMachineInstanceMigration{*migration},
+			}, nil).Times(1)
+
+			err := testing.NewRepeatableVirtctlCommand("migrate", vmName, "--cutover")()
+			Expect(err).To(MatchError(ContainSubstring("Found no migration to cut over")))
+		})
+
+		It("should not be combined with warm", func() {
+			err := testing.NewRepeatableVirtctlCommand("migrate", vmName, "--cutover", "--warm")()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 })
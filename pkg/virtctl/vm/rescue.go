@@ -0,0 +1,134 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_RESCUE   = "rescue"
+	COMMAND_UNRESCUE = "unrescue"
+
+	imageArg    = "image"
+	readOnlyArg = "read-only"
+)
+
+type rescueCommand struct {
+	image    string
+	readOnly bool
+}
+
+func NewRescueCommand() *cobra.Command {
+	c := rescueCommand{}
+	cmd := &cobra.Command{
+		Use:   "rescue (VM)",
+		Short: "Restart a virtual machine from a rescue image.",
+		Long: `Restart a virtual machine from a rescue containerDisk image, with the disks of the virtual machine attached behind it.
+The virtual machine stays in rescue mode until it is unrescued.`,
+		Example: usageRescue(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.rescueRun,
+	}
+	cmd.Flags().StringVar(&c.image, imageArg, "", "containerDisk image to boot the virtual machine from")
+	cmd.MarkFlagRequired(imageArg)
+	cmd.Flags().BoolVar(&c.readOnly, readOnlyArg, false, "--read-only=false: if true, the disks of the virtual machine are attached read-only.")
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func NewUnrescueCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unrescue (VM)",
+		Short:   "Take a virtual machine out of rescue mode.",
+		Long:    "Take a virtual machine out of rescue mode. A running virtual machine is restarted from its own disks.",
+		Example: usageUnrescue(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    unrescueRun,
+	}
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageRescue() string {
+	return `  # Restart a virtual machine called 'myvm' from the rescue image 'quay.io/containerdisks/fedora:latest':
+  {{ProgramName}} rescue myvm --image=quay.io/containerdisks/fedora:latest
+
+  # Restart a virtual machine called 'myvm' from a rescue image with its disks attached read-only:
+  {{ProgramName}} rescue myvm --image=quay.io/containerdisks/fedora:latest --read-only`
+}
+
+func usageUnrescue() string {
+	return `  # Take a virtual machine called 'myvm' out of rescue mode:
+  {{ProgramName}} unrescue myvm`
+}
+
+func (c *rescueCommand) rescueRun(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	options := &v1.RescueOptions{
+		Image:    c.image,
+		ReadOnly: c.readOnly,
+		DryRun:   setDryRunOption(dryRun),
+	}
+
+	err = virtClient.VirtualMachine(namespace).Rescue(context.Background(), vmName, options)
+	if err != nil {
+		return fmt.Errorf("error rescuing VirtualMachine: %v", err)
+	}
+
+	fmt.Printf("VM %s was scheduled to boot from rescue image %s\n", vmName, c.image)
+
+	return nil
+}
+
+func unrescueRun(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	options := &v1.UnrescueOptions{DryRun: setDryRunOption(dryRun)}
+	err = virtClient.VirtualMachine(namespace).Unrescue(context.Background(), vmName, options)
+	if err != nil {
+		return fmt.Errorf("error unrescuing VirtualMachine: %v", err)
+	}
+
+	fmt.Printf("VM %s was scheduled to leave rescue mode\n", vmName)
+
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Rescue command", func() {
+	const (
+		vmName = "testvm"
+		image  = "quay.io/rescue:latest"
+	)
+
+	var vmInterface *kubecli.MockVirtualMachineInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+	})
+
+	Context("rescue", func() {
+		It("should fail without an image", func() {
+			cmd := testing.NewRepeatableVirtctlCommand("rescue", vmName)
+			Expect(cmd()).To(MatchError(ContainSubstring(`required flag(s) "image" not set`)))
+		})
+
+		It("should return the error of the rescue request", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().Rescue(context.Background(), vmName, gomock.Any()).Return(errors.New("rescue failed")).Times(1)
+
+			cmd := testing.NewRepeatableVirtctlCommand("rescue", vmName, "--image", image)
+			Expect(cmd()).To(MatchError("error rescuing VirtualMachine: rescue failed"))
+		})
+
+		DescribeTable("should rescue a vm according to options", func(expectedRescueOptions *v1.RescueOptions, extraArgs ...string) {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().Rescue(context.Background(), vmName, expectedRescueOptions).Return(nil).Times(1)
+
+			args := append([]string{"rescue", vmName, "--image", image}, extraArgs...)
+			Expect(testing.NewRepeatableVirtctlCommand(args...)()).To(Succeed())
+		},
+			Entry("with default",
+				&v1.RescueOptions{Image: image}),
+			Entry("with read-only option",
+				&v1.RescueOptions{Image: image, ReadOnly: true},
+				"--read-only"),
+			Entry("with dry-run option",
+				&v1.RescueOptions{Image: image, DryRun: []string{k8smetav1.DryRunAll}},
+				"--dry-run"),
+		)
+	})
+
+	Context("unrescue", func() {
+		It("should fail with missing input parameters", func() {
+			cmd := testing.NewRepeatableVirtctlCommand("unrescue")
+			Expect(cmd()).To(MatchError("accepts 1 arg(s), received 0"))
+		})
+
+		It("should return the error of the unrescue request", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().Unrescue(context.Background(), vmName, gomock.Any()).Return(errors.New("unrescue failed")).Times(1)
+
+			cmd := testing.NewRepeatableVirtctlCommand("unrescue", vmName)
+			Expect(cmd()).To(MatchError("error unrescuing VirtualMachine: unrescue failed"))
+		})
+
+		It("should unrescue a vm", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().Unrescue(context.Background(), vmName, &v1.UnrescueOptions{}).Return(nil).Times(1)
+
+			Expect(testing.NewRepeatableVirtctlCommand("unrescue", vmName)()).To(Succeed())
+		})
+	})
+})
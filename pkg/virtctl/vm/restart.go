@@ -36,21 +36,21 @@ const COMMAND_RESTART = "restart"
 func NewRestartCommand() *cobra.Command {
 	c := Command{command: COMMAND_RESTART}
 	cmd := &cobra.Command{
-		Use:     "restart (VM)",
+		Use:     "restart (VM | --selector SELECTOR)",
 		Short:   "Restart a virtual machine.",
-		Example: usage(COMMAND_RESTART),
-		Args:    cobra.ExactArgs(1),
+		Example: batchUsage(COMMAND_RESTART),
+		Args:    c.batch.validateArgs,
 		RunE:    c.restartRun,
 	}
 	cmd.Flags().BoolVar(&forceRestart, forceArg, false, "--force=false: Only used when grace-period=0. If true, immediately remove VMI pod from API and bypass graceful deletion. Note that immediate deletion of some resources may result in inconsistency or data loss and requires confirmation.")
 	cmd.Flags().Int64Var(&gracePeriod, gracePeriodArg, -1, "--grace-period=-1: Period of time in seconds given to the VMI to terminate gracefully. Can only be set to 0 when --force is true (force deletion). Currently only setting 0 is supported.")
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	addBatchFlags(cmd, &c.batch)
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
 
 func (o *Command) restartRun(cmd *cobra.Command, args []string) error {
-	vmiName := args[0]
 	errorFmt := "error restarting VirtualMachine: %v"
 
 	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
@@ -71,9 +71,20 @@ func (o *Command) restartRun(cmd *cobra.Command, args []string) error {
 		errorFmt = "error force restarting VirtualMachine: %v"
 	}
 
-	err = virtClient.VirtualMachine(namespace).Restart(context.Background(), vmiName, restartOpts)
-	if err != nil {
-		return fmt.Errorf(errorFmt, err)
+	restart := func(namespace, name string) error {
+		if err := virtClient.VirtualMachine(namespace).Restart(context.Background(), name, restartOpts); err != nil {
+			return fmt.Errorf(errorFmt, err)
+		}
+		return nil
+	}
+
+	if o.batch.enabled() {
+		return o.batch.run(cmd, virtClient, namespace, o.command, "was scheduled to "+o.command, restart)
+	}
+
+	vmiName := args[0]
+	if err := restart(namespace, vmiName); err != nil {
+		return err
 	}
 
 	fmt.Printf("VM %s was scheduled to %s\n", vmiName, o.command)
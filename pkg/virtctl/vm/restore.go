@@ -0,0 +1,94 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_RESTORE = "restore"
+
+	snapshotArg = "snapshot"
+	startArg    = "start"
+)
+
+type restoreCommand struct {
+	snapshotName string
+	start        bool
+}
+
+func NewRestoreCommand() *cobra.Command {
+	c := restoreCommand{}
+	cmd := &cobra.Command{
+		Use:     "restore (VM)",
+		Short:   "Restore a virtual machine from one of its snapshots.",
+		Long:    "Restore a virtual machine from one of its snapshots. The virtual machine is stopped for the restore.",
+		Example: usageRestore(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.restoreRun,
+	}
+	cmd.Flags().StringVar(&c.snapshotName, snapshotArg, "", "name of the VirtualMachineSnapshot to restore the virtual machine from")
+	cmd.MarkFlagRequired(snapshotArg)
+	cmd.Flags().BoolVar(&c.start, startArg, false, "--start=false: if true, the virtual machine is started once the restore completed.")
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageRestore() string {
+	return `  # Restore a virtual machine called 'myvm' from the snapshot 'mysnapshot':
+  {{ProgramName}} restore myvm --snapshot=mysnapshot
+
+  # Restore a virtual machine called 'myvm' from the snapshot 'mysnapshot' and start it once restored:
+  {{ProgramName}} restore myvm --snapshot=mysnapshot --start`
+}
+
+func (c *restoreCommand) restoreRun(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	options := &v1.RestoreOptions{
+		VirtualMachineSnapshotName: c.snapshotName,
+		Start:                      c.start,
+		DryRun:                     setDryRunOption(dryRun),
+	}
+
+	err = virtClient.VirtualMachine(namespace).Restore(context.Background(), vmName, options)
+	if err != nil {
+		return fmt.Errorf("error restoring VirtualMachine: %v", err)
+	}
+
+	fmt.Printf("VM %s was scheduled to be restored from snapshot %s\n", vmName, c.snapshotName)
+
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Restore command", func() {
+	const (
+		vmName       = "testvm"
+		snapshotName = "testsnapshot"
+	)
+
+	var vmInterface *kubecli.MockVirtualMachineInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+	})
+
+	It("should fail with missing input parameters", func() {
+		cmd := testing.NewRepeatableVirtctlCommand("restore", "--snapshot", snapshotName)
+		err := cmd()
+		Expect(err).To(HaveOccurred())
+		Expect(err).Should(MatchError("accepts 1 arg(s), received 0"))
+	})
+
+	It("should fail without a snapshot", func() {
+		cmd := testing.NewRepeatableVirtctlCommand("restore", vmName)
+		Expect(cmd()).To(MatchError(ContainSubstring(`required flag(s) "snapshot" not set`)))
+	})
+
+	It("should return the error of the restore request", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+		vmInterface.EXPECT().Restore(context.Background(), vmName, gomock.Any()).Return(errors.New("restore failed")).Times(1)
+
+		cmd := testing.NewRepeatableVirtctlCommand("restore", vmName, "--snapshot", snapshotName)
+		Expect(cmd()).To(MatchError("error restoring VirtualMachine: restore failed"))
+	})
+
+	DescribeTable("should restore a vm according to options", func(expectedRestoreOptions *v1.RestoreOptions, extraArgs ...string) {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+		vmInterface.EXPECT().Restore(context.Background(), vmName, expectedRestoreOptions).Return(nil).Times(1)
+
+		args := append([]string{"restore", vmName, "--snapshot", snapshotName}, extraArgs...)
+		Expect(testing.NewRepeatableVirtctlCommand(args...)()).To(Succeed())
+	},
+		Entry("with default",
+			&v1.RestoreOptions{VirtualMachineSnapshotName: snapshotName}),
+		Entry("with start option",
+			&v1.RestoreOptions{VirtualMachineSnapshotName: snapshotName, Start: true},
+			"--start"),
+		Entry("with dry-run option",
+			&v1.RestoreOptions{VirtualMachineSnapshotName: snapshotName, DryRun: []string{k8smetav1.DryRunAll}},
+			"--dry-run"),
+	)
+})
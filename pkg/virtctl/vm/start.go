@@ -32,32 +32,37 @@ import (
 )
 
 const (
-	COMMAND_START = "start"
-	pausedArg     = "paused"
+	COMMAND_START        = "start"
+	pausedArg            = "paused"
+	bootFromCDROMOnceArg = "boot-from-cdrom-once"
+	kernelArgsOnceArg    = "kernel-args-once"
 )
 
 var (
-	startPaused bool
+	startPaused       bool
+	bootFromCDROMOnce string
+	kernelArgsOnce    string
 )
 
 func NewStartCommand() *cobra.Command {
 	c := Command{command: COMMAND_START}
 	cmd := &cobra.Command{
-		Use:     "start (VM)",
+		Use:     "start (VM | --selector SELECTOR)",
 		Short:   "Start a virtual machine.",
-		Example: usage(COMMAND_START),
-		Args:    cobra.ExactArgs(1),
+		Example: batchUsage(COMMAND_START),
+		Args:    c.batch.validateArgs,
 		RunE:    c.startRun,
 	}
 	cmd.Flags().BoolVar(&startPaused, pausedArg, false, "--paused=false: If set to true, start virtual machine in paused state")
+	cmd.Flags().StringVar(&bootFromCDROMOnce, bootFromCDROMOnceArg, "", "--boot-from-cdrom-once=cdrom: Boot from the named CD-ROM disk for this start only. The next start uses the boot order of the VM again.")
+	cmd.Flags().StringVar(&kernelArgsOnce, kernelArgsOnceArg, "", "--kernel-args-once='console=ttyS0 single': Override the kernel arguments of the kernel boot firmware for this start only. The next start uses the kernel arguments of the VM again.")
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	addBatchFlags(cmd, &c.batch)
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
 
 func (o *Command) startRun(cmd *cobra.Command, args []string) error {
-	vmiName := args[0]
-
 	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
 	if err != nil {
 		return err
@@ -65,9 +70,26 @@ func (o *Command) startRun(cmd *cobra.Command, args []string) error {
 
 	dryRunOption := setDryRunOption(dryRun)
 
-	err = virtClient.VirtualMachine(namespace).Start(context.Background(), vmiName, &v1.StartOptions{Paused: startPaused, DryRun: dryRunOption})
-	if err != nil {
-		return fmt.Errorf("Error starting VirtualMachine %v", err)
+	start := func(namespace, name string) error {
+		err := virtClient.VirtualMachine(namespace).Start(context.Background(), name, &v1.StartOptions{
+			Paused:            startPaused,
+			BootFromCDROMOnce: bootFromCDROMOnce,
+			KernelArgsOnce:    kernelArgsOnce,
+			DryRun:            dryRunOption,
+		})
+		if err != nil {
+			return fmt.Errorf("Error starting VirtualMachine %v", err)
+		}
+		return nil
+	}
+
+	if o.batch.enabled() {
+		return o.batch.run(cmd, virtClient, namespace, o.command, "was scheduled to "+o.command, start)
+	}
+
+	vmiName := args[0]
+	if err := start(namespace, vmiName); err != nil {
+		return err
 	}
 
 	fmt.Printf("VM %s was scheduled to %s\n", vmiName, o.command)
@@ -113,4 +113,15 @@ var _ = Describe("Start command", func() {
 		})
 	})
 
+	It("should pass one-shot boot overrides", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+		vmInterface.EXPECT().Start(context.Background(), vmName, &v1.StartOptions{
+			BootFromCDROMOnce: "installer",
+			KernelArgsOnce:    "console=ttyS0 single",
+		}).Return(nil).Times(1)
+
+		cmd := testing.NewRepeatableVirtctlCommand("start", vmName, "--boot-from-cdrom-once", "installer", "--kernel-args-once", "console=ttyS0 single")
+		Expect(cmd()).To(Succeed())
+	})
+
 })
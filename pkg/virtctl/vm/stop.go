@@ -36,22 +36,22 @@ const COMMAND_STOP = "stop"
 func NewStopCommand() *cobra.Command {
 	c := Command{command: COMMAND_STOP}
 	cmd := &cobra.Command{
-		Use:     "stop (VM)",
+		Use:     "stop (VM | --selector SELECTOR)",
 		Short:   "Stop a virtual machine.",
-		Example: usage(COMMAND_STOP),
-		Args:    cobra.ExactArgs(1),
+		Example: batchUsage(COMMAND_STOP),
+		Args:    c.batch.validateArgs,
 		RunE:    c.stopRun,
 	}
 
 	cmd.Flags().BoolVar(&forceRestart, forceArg, false, "--force=false: Only used when grace-period=0. If true, immediately remove VMI pod from API and bypass graceful deletion. Note that immediate deletion of some resources may result in inconsistency or data loss and requires confirmation.")
 	cmd.Flags().Int64Var(&gracePeriod, gracePeriodArg, -1, "--grace-period=-1: Period of time in seconds given to the VMI to terminate gracefully. Can only be set to 0 when --force is true (force deletion). Currently only setting 0 is supported.")
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	addBatchFlags(cmd, &c.batch)
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
 
 func (o *Command) stopRun(cmd *cobra.Command, args []string) error {
-	vmiName := args[0]
 	errorFmt := "error stopping VirtualMachine %v"
 
 	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
@@ -72,9 +72,20 @@ func (o *Command) stopRun(cmd *cobra.Command, args []string) error {
 		errorFmt = "error force stopping VirtualMachine: %v"
 	}
 
-	err = virtClient.VirtualMachine(namespace).Stop(context.Background(), vmiName, stopOpts)
-	if err != nil {
-		return fmt.Errorf(errorFmt, err)
+	stop := func(namespace, name string) error {
+		if err := virtClient.VirtualMachine(namespace).Stop(context.Background(), name, stopOpts); err != nil {
+			return fmt.Errorf(errorFmt, err)
+		}
+		return nil
+	}
+
+	if o.batch.enabled() {
+		return o.batch.run(cmd, virtClient, namespace, o.command, "was scheduled to "+o.command, stop)
+	}
+
+	vmiName := args[0]
+	if err := stop(namespace, vmiName); err != nil {
+		return err
 	}
 
 	fmt.Printf("VM %s was scheduled to %s\n", vmiName, o.command)
@@ -0,0 +1,124 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	usbNameArg       = "name"
+	usbDeviceNameArg = "device-name"
+)
+
+var (
+	usbName       string
+	usbDeviceName string
+)
+
+func NewAddUSBDeviceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "addusbdevice VMI",
+		Short:   "add a host USB device to a running VM",
+		Example: usageAddUSBDevice(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    addUSBDeviceRun,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	cmd.Flags().StringVar(&usbName, usbNameArg, "", "name used in the hostDevices section of the spec")
+	cmd.MarkFlagRequired(usbNameArg)
+	cmd.Flags().StringVar(&usbDeviceName, usbDeviceNameArg, "", "resource name of the USB device, as permitted in the KubeVirt configuration")
+	cmd.MarkFlagRequired(usbDeviceNameArg)
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	return cmd
+}
+
+func NewRemoveUSBDeviceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "removeusbdevice VMI",
+		Short:   "remove a host USB device from a running VM",
+		Example: usageRemoveUSBDevice(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    removeUSBDeviceRun,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	cmd.Flags().StringVar(&usbName, usbNameArg, "", "name used in the hostDevices section of the spec")
+	cmd.MarkFlagRequired(usbNameArg)
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	return cmd
+}
+
+func usageAddUSBDevice() string {
+	return `  #Dynamically attach the USB device claimed by the kubevirt.io/storage resource to a running VM.
+  {{ProgramName}} addusbdevice fedora-vm --name=usb-storage --device-name=kubevirt.io/storage
+  `
+}
+
+func usageRemoveUSBDevice() string {
+	return `  #Remove a USB device that was dynamically attached to a running VM.
+  {{ProgramName}} removeusbdevice fedora-vm --name=usb-storage
+  `
+}
+
+func addUSBDeviceRun(cmd *cobra.Command, args []string) error {
+	vmiName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	err = virtClient.VirtualMachineInstance(namespace).AddUSBDevice(cmd.Context(), vmiName, &v1.AddUSBDeviceOptions{
+		Name:       usbName,
+		DeviceName: usbDeviceName,
+		DryRun:     setDryRunOption(dryRun),
+	})
+	if err != nil {
+		return fmt.Errorf("error adding USB device, %v", err)
+	}
+	cmd.Printf("Successfully submitted add USB device request to VM %s for device %s\n", vmiName, usbName)
+	return nil
+}
+
+func removeUSBDeviceRun(cmd *cobra.Command, args []string) error {
+	vmiName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	err = virtClient.VirtualMachineInstance(namespace).RemoveUSBDevice(cmd.Context(), vmiName, &v1.RemoveUSBDeviceOptions{
+		Name:   usbName,
+		DryRun: setDryRunOption(dryRun),
+	})
+	if err != nil {
+		return fmt.Errorf("error removing USB device, %v", err)
+	}
+	cmd.Printf("Successfully submitted remove USB device request to VM %s for device %s\n", vmiName, usbName)
+	return nil
+}
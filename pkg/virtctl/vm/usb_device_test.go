@@ -0,0 +1,127 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	kvtesting "kubevirt.io/client-go/testing"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("USB device commands", func() {
+	const (
+		vmiName       = "testvmi"
+		usbName       = "usb-storage"
+		usbDeviceName = "kubevirt.io/storage"
+	)
+
+	var virtClient *kubevirtfake.Clientset
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		virtClient = kubevirtfake.NewSimpleClientset()
+	})
+
+	expectVMIClient := func() {
+		kubecli.MockKubevirtClientInstance.
+			EXPECT().
+			VirtualMachineInstance(metav1.NamespaceDefault).
+			Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).
+			Times(1)
+	}
+
+	expectedDryRun := func(dryRun bool) []string {
+		if dryRun {
+			return []string{metav1.DryRunAll}
+		}
+		return nil
+	}
+
+	DescribeTable("should fail with missing required or invalid parameters", func(expected string, args ...string) {
+		cmd := testing.NewRepeatableVirtctlCommand(args...)
+		Expect(cmd()).To(MatchError(ContainSubstring(expected)))
+	},
+		Entry("addusbdevice with no args", "accepts 1 arg(s), received 0", "addusbdevice"),
+		Entry("addusbdevice without name", "required flag(s)", "addusbdevice", vmiName, "--device-name="+usbDeviceName),
+		Entry("addusbdevice without device-name", "required flag(s)", "addusbdevice", vmiName, "--name="+usbName),
+		Entry("removeusbdevice with no args", "accepts 1 arg(s), received 0", "removeusbdevice"),
+		Entry("removeusbdevice without name", "required flag(s)", "removeusbdevice", vmiName),
+	)
+
+	DescribeTable("addusbdevice should call the VMI endpoint", func(dryRun bool, extraArgs ...string) {
+		expectVMIClient()
+		virtClient.PrependReactor("put", "virtualmachineinstances/addusbdevice", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			opts := action.(kvtesting.PutAction[*v1.AddUSBDeviceOptions]).GetOptions()
+			Expect(opts.Name).To(Equal(usbName))
+			Expect(opts.DeviceName).To(Equal(usbDeviceName))
+			Expect(opts.DryRun).To(Equal(expectedDryRun(dryRun)))
+			return true, nil, nil
+		})
+
+		args := append([]string{"addusbdevice", vmiName, "--name=" + usbName, "--device-name=" + usbDeviceName}, extraArgs...)
+		Expect(testing.NewRepeatableVirtctlCommand(args...)()).To(Succeed())
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachineinstances", "addusbdevice")).To(HaveLen(1))
+	},
+		Entry("without dry-run", false),
+		Entry("with dry-run", true, "--dry-run"),
+	)
+
+	DescribeTable("removeusbdevice should call the VMI endpoint", func(dryRun bool, extraArgs ...string) {
+		expectVMIClient()
+		virtClient.PrependReactor("put", "virtualmachineinstances/removeusbdevice", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			opts := action.(kvtesting.PutAction[*v1.RemoveUSBDeviceOptions]).GetOptions()
+			Expect(opts.Name).To(Equal(usbName))
+			Expect(opts.DryRun).To(Equal(expectedDryRun(dryRun)))
+			return true, nil, nil
+		})
+
+		args := append([]string{"removeusbdevice", vmiName, "--name=" + usbName}, extraArgs...)
+		Expect(testing.NewRepeatableVirtctlCommand(args...)()).To(Succeed())
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachineinstances", "removeusbdevice")).To(HaveLen(1))
+	},
+		Entry("without dry-run", false),
+		Entry("with dry-run", true, "--dry-run"),
+	)
+
+	It("should report an error returned by the server", func() {
+		expectVMIClient()
+		virtClient.PrependReactor("put", "virtualmachineinstances/addusbdevice", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.New("replugHostDevices is disabled")
+		})
+
+		cmd := testing.NewRepeatableVirtctlCommand("addusbdevice", vmiName, "--name="+usbName, "--device-name="+usbDeviceName)
+		Expect(cmd()).To(MatchError(ContainSubstring("error adding USB device, replugHostDevices is disabled")))
+	})
+})
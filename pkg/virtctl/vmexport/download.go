@@ -0,0 +1,314 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmexport
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/cheggaaa/pb/v3"
+
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+// volumeBarTemplate is the template of the progress bars shown when downloading all the volumes of an export
+const volumeBarTemplate = `{{ string . "volume" }} {{ counters . }} {{ bar . }} {{ percent . }} {{ speed . }} {{ rtime . "ETA %s" }}`
+
+// exportedVolume is a volume of a VirtualMachineExport along with the URL it is downloaded from
+type exportedVolume struct {
+	name       string
+	url        string
+	format     exportv1.ExportVolumeFormat
+	decompress bool
+	// checksum is the size and checksum of the raw disk image published by the export, if any
+	checksum *exportv1.ExportedVolumeChecksum
+}
+
+// newExportedVolume selects the URL a volume is downloaded from, preferring the compressed formats.
+// It returns nil when the volume has no downloadable format.
+func newExportedVolume(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, exportVolume exportv1.VirtualMachineExportVolume) (*exportedVolume, error) {
+	var selected *exportv1.VirtualMachineExportVolumeFormat
+	for i := range exportVolume.Formats {
+		format := &exportVolume.Formats[i]
+		if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz {
+			selected = format
+			break
+		}
+		if format.Format == exportv1.KubeVirtRaw && selected == nil {
+			selected = format
+		}
+	}
+	if selected == nil {
+		return nil, nil
+	}
+
+	downloadUrl, err := replaceUrlWithServiceUrl(selected.Url, vmeInfo)
+	if err != nil {
+		return nil, err
+	}
+	volume := &exportedVolume{
+		name:   exportVolume.Name,
+		url:    downloadUrl,
+		format: selected.Format,
+		// No need to decompress file if format is not gzip
+		decompress: vmeInfo.Decompress && selected.Format != exportv1.KubeVirtRaw,
+	}
+	if selected.Format != exportv1.ArchiveGz && vmexport.Status != nil {
+		for i := range vmexport.Status.VolumeChecksums {
+			if vmexport.Status.VolumeChecksums[i].Name == exportVolume.Name {
+				volume.checksum = &vmexport.Status.VolumeChecksums[i]
+			}
+		}
+	}
+	return volume, nil
+}
+
+// fileName returns the name of the file the volume is written to when downloading all the volumes
+func (v *exportedVolume) fileName() string {
+	switch {
+	case v.format == exportv1.ArchiveGz && v.decompress:
+		return v.name + ".tar"
+	case v.format == exportv1.ArchiveGz:
+		return v.name + ".tar.gz"
+	case v.format == exportv1.KubeVirtGz && !v.decompress:
+		return v.name + ".img.gz"
+	default:
+		return v.name + ".img"
+	}
+}
+
+// getExportedVolumes returns all the downloadable volumes of the export
+func getExportedVolumes(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) ([]*exportedVolume, error) {
+	links := getExportLinks(vmexport, vmeInfo)
+	if links == nil || len(links.Volumes) <= 0 {
+		return nil, fmt.Errorf("unable to access the volume info from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
+	}
+	var volumes []*exportedVolume
+	for _, exportVolume := range links.Volumes {
+		volume, err := newExportedVolume(vmexport, vmeInfo, exportVolume)
+		if err != nil {
+			return nil, err
+		}
+		if volume == nil {
+			return nil, fmt.Errorf("unable to get a valid URL for volume '%s' from '%s/%s' VirtualMachineExport", exportVolume.Name, vmexport.Namespace, vmexport.Name)
+		}
+		volumes = append(volumes, volume)
+	}
+	return volumes, nil
+}
+
+// downloadAllVolumes downloads the volumes of the export concurrently into the output directory, showing the progress of every volume.
+// Volumes downloaded by a previous attempt are skipped.
+func downloadAllVolumes(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (bool, error) {
+	volumes, err := getExportedVolumes(vmexport, vmeInfo)
+	if err != nil {
+		return false, err
+	}
+
+	var pending []*exportedVolume
+	var bars []*pb.ProgressBar
+	for _, volume := range volumes {
+		if vmeInfo.downloadedVolumes[volume.name] {
+			continue
+		}
+		bar := pb.ProgressBarTemplate(volumeBarTemplate).New(0)
+		bar.Set("volume", volume.name)
+		pending = append(pending, volume)
+		bars = append(bars, bar)
+	}
+
+	// The pool redraws all the bars in place, which requires a terminal
+	if pool, err := pb.StartPool(bars...); err == nil {
+		defer pool.Stop()
+	} else {
+		for _, bar := range bars {
+			bar.Start()
+		}
+	}
+
+	var (
+		wg         sync.WaitGroup
+		mutex      sync.Mutex
+		succeeded  = true
+		errs       []error
+		concurrent = make(chan struct{}, vmeInfo.Parallel)
+	)
+	for i, volume := range pending {
+		wg.Add(1)
+		go func(volume *exportedVolume, bar *pb.ProgressBar) {
+			defer wg.Done()
+			concurrent <- struct{}{}
+			defer func() { <-concurrent }()
+
+			ok, err := downloadVolumeToFile(client, vmexport, vmeInfo, volume, bar)
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to download volume %s: %w", volume.name, err))
+			} else if !ok {
+				succeeded = false
+			} else {
+				vmeInfo.downloadedVolumes[volume.name] = true
+			}
+		}(volume, bars[i])
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return false, errors.Join(errs...)
+	}
+	if succeeded {
+		printToOutput("Downloaded %d volumes to %s succesfully\n", len(volumes), vmeInfo.OutputDir)
+	}
+	return succeeded, nil
+}
+
+// downloadVolumeToFile downloads a volume into its file in the output directory, which is removed when the download fails
+func downloadVolumeToFile(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, volume *exportedVolume, bar *pb.ProgressBar) (succeeded bool, err error) {
+	defer bar.Finish()
+
+	resp, err := HandleHTTPGetRequestFn(client, vmexport, volume.url, vmeInfo.Insecure, vmeInfo.ServiceURL, nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	// Check server response
+	if resp.StatusCode != http.StatusOK {
+		printToOutput("Bad status downloading volume %s: %s\n", volume.name, resp.Status)
+		return false, nil
+	}
+
+	path := filepath.Join(vmeInfo.OutputDir, volume.fileName())
+	output, err := os.Create(path)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		util.CloseIOAndCheckErr(output, &err)
+		if err != nil {
+			os.Remove(path)
+		}
+	}()
+
+	if resp.ContentLength > 0 {
+		bar.SetTotal(resp.ContentLength)
+	}
+	if err := copyVolume(output, bar.NewProxyReader(resp.Body), volume); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// copyVolume copies the downloaded volume to the output, decompressing it when requested, and verifies
+// the checksum of the disk image when the export published it
+func copyVolume(output io.Writer, body io.Reader, volume *exportedVolume) error {
+	rd := body
+	if volume.decompress {
+		// Create a new gzip reader
+		gzipReader, err := gzip.NewReader(body)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		rd = gzipReader
+	}
+
+	if volume.checksum == nil {
+		_, err := io.Copy(output, rd)
+		return err
+	}
+
+	verifier := newChecksumVerifier(volume.checksum)
+	if volume.format == exportv1.KubeVirtGz && !volume.decompress {
+		// The image is kept compressed, decompress a copy of the stream to checksum the disk image
+		if err := copyAndVerifyCompressed(output, rd, verifier); err != nil {
+			return err
+		}
+	} else if _, err := io.Copy(io.MultiWriter(output, verifier), rd); err != nil {
+		return err
+	}
+	return verifier.verify(volume.name)
+}
+
+// copyAndVerifyCompressed copies the compressed stream to the output while feeding the decompressed disk image to the verifier
+func copyAndVerifyCompressed(output io.Writer, rd io.Reader, verifier *checksumVerifier) error {
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		gzipReader, err := gzip.NewReader(pr)
+		if err == nil {
+			_, err = io.Copy(verifier, gzipReader)
+		}
+		// Keep consuming the stream so the copy to the output completes
+		_, _ = io.Copy(io.Discard, pr)
+		done <- err
+	}()
+
+	_, err := io.Copy(io.MultiWriter(output, pw), rd)
+	pw.CloseWithError(err)
+	verifyErr := <-done
+	if err != nil {
+		return err
+	}
+	return verifyErr
+}
+
+// checksumVerifier computes the size and SHA-256 checksum of the disk image written to it
+type checksumVerifier struct {
+	expected *exportv1.ExportedVolumeChecksum
+	hash     hash.Hash
+	size     int64
+}
+
+func newChecksumVerifier(expected *exportv1.ExportedVolumeChecksum) *checksumVerifier {
+	return &checksumVerifier{
+		expected: expected,
+		hash:     sha256.New(),
+	}
+}
+
+func (v *checksumVerifier) Write(p []byte) (int, error) {
+	v.size += int64(len(p))
+	return v.hash.Write(p)
+}
+
+func (v *checksumVerifier) verify(volumeName string) error {
+	if v.size != v.expected.Size {
+		return fmt.Errorf("size of volume %s is %d bytes, expected %d", volumeName, v.size, v.expected.Size)
+	}
+	if checksum := hex.EncodeToString(v.hash.Sum(nil)); checksum != v.expected.Checksum {
+		return fmt.Errorf("checksum of volume %s is %s, expected %s", volumeName, checksum, v.expected.Checksum)
+	}
+	printToOutput("Verified checksum of volume %s\n", volumeName)
+	return nil
+}
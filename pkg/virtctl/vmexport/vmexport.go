@@ -20,7 +20,6 @@
 package vmexport
 
 import (
-	"compress/gzip"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
@@ -84,6 +83,8 @@ const (
 	LABELS_FLAG            = "--labels"
 	ANNOTATIONS_FLAG       = "--annotations"
 	READINESS_TIMEOUT_FLAG = "--readiness-timeout"
+	OUTPUT_DIR_FLAG        = "--output-dir"
+	PARALLEL_FLAG          = "--parallel"
 
 	// Possible output format for manifests
 	OUTPUT_FORMAT_JSON = "json"
@@ -101,6 +102,8 @@ const (
 	processingWaitInterval = 2 * time.Second
 	// DefaultProcessingWaitTotal is the default maximum time used to wait for a virtualMachineExport to be ready
 	DefaultProcessingWaitTotal = 2 * time.Minute
+	// DefaultParallelDownloads is the default maximum number of volumes downloaded at the same time
+	DefaultParallelDownloads = 4
 
 	// exportTokenHeader is the http header used to download the exported volume using the secret token
 	exportTokenHeader = "x-kubevirt-export-token"
@@ -147,6 +150,8 @@ var (
 	resourceLabels       []string
 	resourceAnnotations  []string
 	readinessTimeout     string
+	outputDir            string
+	parallelDownloads    int
 )
 
 type VMExportInfo struct {
@@ -172,6 +177,11 @@ type VMExportInfo struct {
 	ReadinessTimeout time.Duration
 	Labels           map[string]string
 	Annotations      map[string]string
+	OutputDir        string
+	Parallel         int
+
+	// downloadedVolumes keeps the volumes already written to OutputDir, so retries only fetch the others
+	downloadedVolumes map[string]bool
 }
 
 type command struct {
@@ -212,6 +222,9 @@ func usage() string {
 	# Download a volume from an already existing VirtualMachineExport (--volume is optional when only one volume is available)
 	{{ProgramName}} vmexport download vm1-export --volume=volume1 --output=disk.img.gz
 
+	# Download all the volumes of an already existing VirtualMachineExport concurrently into a directory, decompressing them
+	{{ProgramName}} vmexport download vm1-export --output-dir=vm1-disks --format=raw
+
 	# Download a volume as before but through local port 5410
 	{{ProgramName}} vmexport download vm1-export --volume=volume1 --output=disk.img.gz --port-forward --local-port=5410
 
@@ -261,6 +274,9 @@ func NewVirtualMachineExportCommand() *cobra.Command {
 	cmd.Flags().StringSliceVar(&resourceLabels, "labels", nil, "Specify custom labels to VM export object and its associated pod")
 	cmd.Flags().StringSliceVar(&resourceAnnotations, "annotations", nil, "Specify custom annotations to VM export object and its associated pod")
 	cmd.Flags().StringVar(&readinessTimeout, "readiness-timeout", "", "Specify maximum wait for VM export object to be ready")
+	cmd.Flags().StringVar(&outputDir, "output-dir", "", "Downloads all the volumes of the export concurrently into the given directory, one file per volume.")
+	cmd.Flags().IntVar(&parallelDownloads, "parallel", DefaultParallelDownloads, "When used with --output-dir, the maximum number of volumes downloaded at the same time.")
+	cmd.MarkFlagsMutuallyExclusive("output", "output-dir")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 
 	return cmd
@@ -333,8 +349,16 @@ func (c *command) parseExportArguments(args []string, vmeInfo *VMExportInfo) err
 
 func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 	vmeInfo.ExportSource = getExportSource()
-	// User wants the output in a file, create
-	if outputFile != "" && outputFile != "-" {
+	// User wants every volume in its own file, create the directory holding them
+	if outputDir != "" {
+		if err := os.MkdirAll(outputDir, 0755); err != nil {
+			return err
+		}
+		vmeInfo.OutputDir = outputDir
+		vmeInfo.Parallel = parallelDownloads
+		vmeInfo.downloadedVolumes = map[string]bool{}
+	} else if outputFile != "" && outputFile != "-" {
+		// User wants the output in a file, create
 		vmeInfo.OutputFile = outputFile
 		output, err := os.Create(vmeInfo.OutputFile)
 		if err != nil {
@@ -537,6 +561,11 @@ func downloadVirtualMachineExport(client kubecli.KubevirtClient, vmeInfo *VMExpo
 		return getVirtualMachineManifest(client, vmexport, vmeInfo)
 	}
 
+	// Download all the exported volumes
+	if vmeInfo.OutputDir != "" {
+		return downloadAllVolumes(client, vmexport, vmeInfo)
+	}
+
 	// Download the exported volume
 	return downloadVolume(client, vmexport, vmeInfo)
 }
@@ -584,12 +613,13 @@ func getVirtualMachineManifest(client kubecli.KubevirtClient, vmexport *exportv1
 // downloadVolume handles the process of downloading the requested volume from a VirtualMachineExport
 func downloadVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (bool, error) {
 	// Extract the URL from the vmexport
-	downloadUrl, err := GetUrlFromVirtualMachineExport(vmexport, vmeInfo)
+	volume, err := getDownloadVolume(vmexport, vmeInfo)
 	if err != nil {
 		return false, err
 	}
+	vmeInfo.Decompress = volume.decompress
 
-	resp, err := HandleHTTPGetRequestFn(client, vmexport, downloadUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, nil)
+	resp, err := HandleHTTPGetRequestFn(client, vmexport, volume.url, vmeInfo.Insecure, vmeInfo.ServiceURL, nil)
 	if err != nil {
 		return false, err
 	}
@@ -602,7 +632,7 @@ func downloadVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMac
 	}
 
 	// Lastly, copy the file to the expected output
-	if err := copyFileWithProgressBar(vmeInfo.OutputWriter, resp, vmeInfo.Decompress); err != nil {
+	if err := copyFileWithProgressBar(vmeInfo.OutputWriter, resp, volume); err != nil {
 		return false, err
 	}
 
@@ -631,54 +661,48 @@ func replaceUrlWithServiceUrl(manifestUrl string, vmeInfo *VMExportInfo) (string
 
 // GetUrlFromVirtualMachineExport inspects the VirtualMachineExport status to fetch the extected URL
 func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (string, error) {
-	var (
-		downloadUrl string
-		err         error
-		format      exportv1.VirtualMachineExportVolumeFormat
-		links       *exportv1.VirtualMachineExportLink
-	)
-
-	if vmeInfo.ServiceURL == "" && vmexport.Status.Links != nil && vmexport.Status.Links.External != nil {
-		links = vmexport.Status.Links.External
-	} else if vmexport.Status.Links != nil && vmexport.Status.Links.Internal != nil {
-		links = vmexport.Status.Links.Internal
+	volume, err := getDownloadVolume(vmexport, vmeInfo)
+	if err != nil {
+		return "", err
 	}
+	vmeInfo.Decompress = volume.decompress
+	return volume.url, nil
+}
+
+// getDownloadVolume returns the volume requested with the --volume flag, or the only volume of the export
+func getDownloadVolume(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (*exportedVolume, error) {
+	links := getExportLinks(vmexport, vmeInfo)
 	if links == nil || len(links.Volumes) <= 0 {
-		return "", fmt.Errorf("unable to access the volume info from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
+		return nil, fmt.Errorf("unable to access the volume info from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
 	}
 	volumeNumber := len(links.Volumes)
 	if volumeNumber > 1 && vmeInfo.VolumeName == "" {
-		return "", fmt.Errorf("detected more than one downloadable volume in '%s/%s' VirtualMachineExport: Select the expected volume using the --volume flag", vmexport.Namespace, vmexport.Name)
+		return nil, fmt.Errorf("detected more than one downloadable volume in '%s/%s' VirtualMachineExport: Select the expected volume using the --volume flag", vmexport.Namespace, vmexport.Name)
 	}
 	for _, exportVolume := range links.Volumes {
 		// Access the requested volume
 		if volumeNumber == 1 || exportVolume.Name == vmeInfo.VolumeName {
-			for _, format = range exportVolume.Formats {
-				if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz || format.Format == exportv1.KubeVirtRaw {
-					downloadUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo)
-					if err != nil {
-						return "", err
-					}
-				}
-				// By default, we always attempt to find and get the compressed file URL,
-				// so we only break the loop when one is found.
-				if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz {
-					break
-				}
+			volume, err := newExportedVolume(vmexport, vmeInfo, exportVolume)
+			if err != nil {
+				return nil, err
+			}
+			if volume != nil {
+				return volume, nil
 			}
 		}
 	}
 
-	// No need to decompress file if format is not gzip
-	if format.Format == exportv1.KubeVirtRaw {
-		vmeInfo.Decompress = false
-	}
+	return nil, fmt.Errorf("unable to get a valid URL from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
+}
 
-	if downloadUrl == "" {
-		return "", fmt.Errorf("unable to get a valid URL from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
+// getExportLinks returns the external links of the export, or the internal ones when a service URL is used
+func getExportLinks(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) *exportv1.VirtualMachineExportLink {
+	if vmeInfo.ServiceURL == "" && vmexport.Status.Links != nil && vmexport.Status.Links.External != nil {
+		return vmexport.Status.Links.External
+	} else if vmexport.Status.Links != nil && vmexport.Status.Links.Internal != nil {
+		return vmexport.Status.Links.Internal
 	}
-
-	return downloadUrl, nil
+	return nil
 }
 
 // GetManifestUrlsFromVirtualMachineExport retrieves the manifest URLs from VirtualMachineExport status
@@ -795,30 +819,19 @@ func GetHTTPClient(transport *http.Transport, insecure bool) *http.Client {
 }
 
 // copyFileWithProgressBar serves as a wrapper to copy the file with a progress bar
-func copyFileWithProgressBar(output io.Writer, resp *http.Response, decompress bool) error {
-	var rd io.Reader
+func copyFileWithProgressBar(output io.Writer, resp *http.Response, volume *exportedVolume) error {
 	barTemplate := fmt.Sprintf(`{{ "Downloading file:" }} {{counters . }} {{ cycle . %s }} {{speed . }}`, progressBarCycle)
 
 	// start bar based on our template
 	bar := pb.ProgressBarTemplate(barTemplate).Start(0)
 	defer bar.Finish()
 	barRd := bar.NewProxyReader(resp.Body)
-	rd = barRd
 	bar.Start()
 
-	if decompress {
-		// Create a new gzip reader
-		gzipReader, err := gzip.NewReader(barRd)
-		if err != nil {
-			return err
-		}
-		defer gzipReader.Close()
-		rd = gzipReader
+	if volume.decompress {
 		printToOutput("Decompressing image:\n")
 	}
-
-	_, err := io.Copy(output, rd)
-	return err
+	return copyVolume(output, barRd, volume)
 }
 
 // getOrCreateTokenSecret obtains a token secret to be used along with the virtualMachineExport
@@ -938,6 +951,9 @@ func handleCreateFlags() error {
 	if downloadRetries != 0 {
 		return fmt.Errorf(ErrIncompatibleFlag, RETRY_FLAG, CREATE)
 	}
+	if outputDir != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, OUTPUT_DIR_FLAG, CREATE)
+	}
 
 	return nil
 }
@@ -978,6 +994,9 @@ func handleDeleteFlags() error {
 	if downloadRetries != 0 {
 		return fmt.Errorf(ErrIncompatibleFlag, RETRY_FLAG, DELETE)
 	}
+	if outputDir != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, OUTPUT_DIR_FLAG, DELETE)
+	}
 	if readinessTimeout != "" {
 		return fmt.Errorf(ErrIncompatibleFlag, READINESS_TIMEOUT_FLAG, DELETE)
 	}
@@ -1027,7 +1046,18 @@ func handleDownloadFlags() error {
 			return fmt.Errorf(ErrIncompatibleFlag, PVC_FLAG, MANIFEST_FLAG)
 		}
 	}
-	if !exportManifest && outputFile == "" {
+	if outputDir != "" {
+		if volumeName != "" {
+			return fmt.Errorf(ErrIncompatibleFlag, VOLUME_FLAG, OUTPUT_DIR_FLAG)
+		}
+		if exportManifest {
+			return fmt.Errorf(ErrIncompatibleFlag, MANIFEST_FLAG, OUTPUT_DIR_FLAG)
+		}
+		if parallelDownloads < 1 {
+			return fmt.Errorf(ErrInvalidValue, PARALLEL_FLAG, "positive integers")
+		}
+	}
+	if !exportManifest && outputFile == "" && outputDir == "" {
 		return fmt.Errorf("warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file", OUTPUT_FLAG, OUTPUT_FLAG)
 	}
 
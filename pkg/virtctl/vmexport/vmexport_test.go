@@ -20,8 +20,12 @@
 package vmexport_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	cryptorand "crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -30,6 +34,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -366,6 +371,7 @@ var _ = Describe("vmexport", func() {
 		},
 			Entry("Multiple target types", "if any flags in the group [vm snapshot pvc] are set none of the others can be; [pvc snapshot vm] were all set", runCreateCmd, setFlag(vmexport.PVC_FLAG, "test"), setFlag(vmexport.VM_FLAG, "test2"), setFlag(vmexport.SNAPSHOT_FLAG, "test3")),
 			Entry("Retain and delte vmexport", "if any flags in the group [keep-vme delete-vme] are set none of the others can be; [delete-vme keep-vme] were all set", runDownloadCmd, vmexport.DELETE_FLAG, vmexport.KEEP_FLAG),
+			Entry("Output file and directory", "if any flags in the group [output output-dir] are set none of the others can be; [output output-dir] were all set", runDownloadCmd, setFlag(vmexport.OUTPUT_FLAG, "disk.img"), setFlag(vmexport.OUTPUT_DIR_FLAG, "disks")),
 		)
 
 		DescribeTable("Invalid arguments/flags", func(expected string, runFn func(args ...string) error, args ...string) {
@@ -384,6 +390,10 @@ var _ = Describe("vmexport", func() {
 			Entry("Using 'manifest' with invalid output_format_flag", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.OUTPUT_FORMAT_FLAG, "json/yaml"), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.OUTPUT_FORMAT_FLAG, "invalid")),
 			Entry("Using 'port-forward' with invalid port", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.LOCAL_PORT_FLAG, "valid port numbers"), runDownloadCmd, vmexport.PORT_FORWARD_FLAG, setFlag(vmexport.LOCAL_PORT_FLAG, "test")),
 			Entry("Using 'format' with invalid download format", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.FORMAT_FLAG, "gzip/raw"), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, "test")),
+			Entry("Using 'output-dir' with volume flag", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.VOLUME_FLAG, vmexport.OUTPUT_DIR_FLAG), runDownloadCmd, setFlag(vmexport.OUTPUT_DIR_FLAG, "disks"), setFlag(vmexport.VOLUME_FLAG, "volume")),
+			Entry("Using 'output-dir' with manifest flag", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.MANIFEST_FLAG, vmexport.OUTPUT_DIR_FLAG), runDownloadCmd, setFlag(vmexport.OUTPUT_DIR_FLAG, "disks"), vmexport.MANIFEST_FLAG),
+			Entry("Using 'output-dir' with invalid parallel value", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.PARALLEL_FLAG, "positive integers"), runDownloadCmd, setFlag(vmexport.OUTPUT_DIR_FLAG, "disks"), setFlag(vmexport.PARALLEL_FLAG, "0")),
+			Entry("Using 'create' with output-dir flag", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.OUTPUT_DIR_FLAG, vmexport.CREATE), runCreateCmd, setFlag(vmexport.PVC_FLAG, "test"), setFlag(vmexport.OUTPUT_DIR_FLAG, "disks")),
 			Entry("Downloading volume without specifying output", fmt.Sprintf("warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file", vmexport.OUTPUT_FLAG, vmexport.OUTPUT_FLAG), runDownloadCmd),
 		)
 	})
@@ -920,6 +930,175 @@ var _ = Describe("vmexport", func() {
 		})
 	})
 
+	Context("Download all volumes", func() {
+		var (
+			outputDir      string
+			rawData        map[string][]byte
+			compressedData map[string][]byte
+		)
+
+		gzipData := func(data []byte) []byte {
+			var buf bytes.Buffer
+			zw := gzip.NewWriter(&buf)
+			_, err := zw.Write(data)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(zw.Close()).To(Succeed())
+			return buf.Bytes()
+		}
+
+		checksumOf := func(name string) exportv1.ExportedVolumeChecksum {
+			sum := sha256.Sum256(rawData[name])
+			return exportv1.ExportedVolumeChecksum{
+				Name:     name,
+				Size:     int64(len(rawData[name])),
+				Checksum: hex.EncodeToString(sum[:]),
+			}
+		}
+
+		createExport := func(checksums ...exportv1.ExportedVolumeChecksum) {
+			var volumes []exportv1.VirtualMachineExportVolume
+			for _, name := range []string{"disk1", "disk2"} {
+				volumes = append(volumes, exportv1.VirtualMachineExportVolume{
+					Name: name,
+					Formats: []exportv1.VirtualMachineExportVolumeFormat{{
+						Format: exportv1.KubeVirtRaw,
+						Url:    server.URL + "/raw/" + name,
+					}, {
+						Format: exportv1.KubeVirtGz,
+						Url:    server.URL + "/gz/" + name,
+					}},
+				})
+			}
+			vme.Status = vmeStatusReady(volumes)
+			vme.Status.VolumeChecksums = checksums
+			_, err := virtClient.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault).Create(context.Background(), vme, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Create(context.Background(), secret, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			outputDir = filepath.Join(GinkgoT().TempDir(), "disks")
+			rawData = map[string][]byte{}
+			compressedData = map[string][]byte{}
+			for _, name := range []string{"disk1", "disk2"} {
+				data := make([]byte, 1024)
+				_, err := cryptorand.Read(data)
+				Expect(err).ToNot(HaveOccurred())
+				rawData[name] = data
+				compressedData[name] = gzipData(data)
+			}
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				name := filepath.Base(r.URL.Path)
+				Expect(r.URL.Path).To(Equal("/gz/" + name))
+				_, err := w.Write(compressedData[name])
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		DescribeTable("should download every volume into its own file", func(extraArgs []string, fileName func(string) string, expectedData func(string) []byte) {
+			createExport(checksumOf("disk1"), checksumOf("disk2"))
+
+			args := append([]string{setFlag(vmexport.OUTPUT_DIR_FLAG, outputDir), vmexport.INSECURE_FLAG}, extraArgs...)
+			Expect(runDownloadCmd(args...)).To(Succeed())
+
+			for _, name := range []string{"disk1", "disk2"} {
+				data, err := os.ReadFile(filepath.Join(outputDir, fileName(name)))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(data).To(Equal(expectedData(name)))
+			}
+		},
+			Entry("compressed",
+				nil,
+				func(name string) string { return name + ".img.gz" },
+				func(name string) []byte { return compressedData[name] },
+			),
+			Entry("decompressed",
+				[]string{setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_FORMAT)},
+				func(name string) string { return name + ".img" },
+				func(name string) []byte { return rawData[name] },
+			),
+			Entry("one volume at a time",
+				[]string{setFlag(vmexport.PARALLEL_FLAG, "1")},
+				func(name string) string { return name + ".img.gz" },
+				func(name string) []byte { return compressedData[name] },
+			),
+		)
+
+		DescribeTable("should fail and remove the volume when the checksum does not match", func(extraArgs ...string) {
+			badChecksum := checksumOf("disk2")
+			badChecksum.Checksum = strings.Repeat("0", 64)
+			createExport(checksumOf("disk1"), badChecksum)
+
+			args := append([]string{setFlag(vmexport.OUTPUT_DIR_FLAG, outputDir), vmexport.INSECURE_FLAG}, extraArgs...)
+			err := runDownloadCmd(args...)
+			Expect(err).To(MatchError(ContainSubstring("checksum of volume disk2")))
+
+			files, err := os.ReadDir(outputDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+			Expect(files[0].Name()).To(HavePrefix("disk1"))
+		},
+			Entry("compressed"),
+			Entry("decompressed", setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_FORMAT)),
+		)
+
+		It("should fail when the size does not match", func() {
+			badChecksum := checksumOf("disk1")
+			badChecksum.Size++
+			createExport(badChecksum)
+
+			err := runDownloadCmd(setFlag(vmexport.OUTPUT_DIR_FLAG, outputDir), vmexport.INSECURE_FLAG)
+			Expect(err).To(MatchError(ContainSubstring("size of volume disk1")))
+		})
+
+		It("should only retry the volumes that failed to download", func() {
+			var mutex sync.Mutex
+			requests := map[string]int{}
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				name := filepath.Base(r.URL.Path)
+				mutex.Lock()
+				requests[name]++
+				count := requests[name]
+				mutex.Unlock()
+				if name == "disk2" && count == 1 {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				_, err := w.Write(compressedData[name])
+				Expect(err).ToNot(HaveOccurred())
+			})
+			createExport()
+
+			err := runDownloadCmd(
+				setFlag(vmexport.OUTPUT_DIR_FLAG, outputDir),
+				setFlag(vmexport.RETRY_FLAG, "1"),
+				vmexport.INSECURE_FLAG,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests).To(Equal(map[string]int{"disk1": 1, "disk2": 2}))
+			data, err := os.ReadFile(filepath.Join(outputDir, "disk2.img.gz"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal(compressedData["disk2"]))
+		})
+
+		It("should verify the checksum of a single downloaded volume", func() {
+			badChecksum := checksumOf("disk1")
+			badChecksum.Checksum = strings.Repeat("0", 64)
+			createExport(badChecksum)
+
+			err := runDownloadCmd(
+				setFlag(vmexport.VOLUME_FLAG, "disk1"),
+				setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				vmexport.INSECURE_FLAG,
+			)
+			Expect(err).To(MatchError(ContainSubstring("checksum of volume disk1")))
+		})
+	})
+
 	Context("getUrlFromVirtualMachineExport", func() {
 		It("Should get compressed URL even when there's multiple URLs", func() {
 			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
@@ -23,14 +23,58 @@ import (
 	"fmt"
 	"path/filepath"
 
+	v1 "kubevirt.io/api/core/v1"
+
 	"kubevirt.io/kubevirt/pkg/util"
 )
 
+const VirtiofsdPath = "/usr/libexec/virtiofsd"
+
 // This is empty dir
 var VirtioFSContainers = "virtiofs-containers"
 var VirtioFSContainersMountBaseDir = filepath.Join(util.VirtShareDir, VirtioFSContainers)
 
+// Sockets of the virtiofsd instances virt-launcher starts for hotplugged filesystems
+var VirtioFSHotplugSocketDir = filepath.Join(util.VirtPrivateDir, "virtiofs-hotplug")
+
 func VirtioFSSocketPath(volumeName string) string {
 	socketName := fmt.Sprintf("%s.sock", volumeName)
 	return filepath.Join(VirtioFSContainersMountBaseDir, socketName)
 }
+
+func HotplugVirtioFSSocketPath(volumeName string) string {
+	socketName := fmt.Sprintf("%s.sock", volumeName)
+	return filepath.Join(VirtioFSHotplugSocketDir, socketName)
+}
+
+// VirtiofsdArgs returns the virtiofsd arguments sharing sharedDir over socketPath
+// with the tuning of the filesystem applied.
+func VirtiofsdArgs(socketPath, sharedDir string, fs *v1.FilesystemVirtiofs) []string {
+	cache := v1.VirtiofsCacheAuto
+	if fs != nil && fs.Cache != "" {
+		cache = fs.Cache
+	}
+
+	args := []string{
+		fmt.Sprintf("--socket-path=%s", socketPath),
+		fmt.Sprintf("--shared-dir=%s", sharedDir),
+		"--sandbox=none",
+		fmt.Sprintf("--cache=%s", cache),
+	}
+
+	if fs != nil && fs.ThreadPoolSize != nil {
+		args = append(args, fmt.Sprintf("--thread-pool-size=%d", *fs.ThreadPoolSize))
+	}
+
+	// If some files cannot be migrated, let's allow the migration to finish.
+	// Mark these files as invalid, the guest will not be able to access any such files,
+	// receiving only errors
+	args = append(args, "--migration-on-error=guest-error")
+
+	// This mode look up its file references paths by reading the symlinks in /proc/self/fd,
+	// falling back to iterating through the shared directory (exhaustive search) to find those paths.
+	// This migration mode doesn't require any privileges.
+	args = append(args, "--migration-mode=find-paths")
+
+	return args
+}
@@ -0,0 +1,237 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmdisruption
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+// DisruptionTimeout is how long a granted disruption is accounted for before it is observed.
+// It covers the delay until the informers see the migration or the shutdown which was granted.
+const DisruptionTimeout = 2 * time.Minute
+
+const defaultMaxUnavailable = 1
+
+// Selects returns whether the budget applies to the VMI
+func Selects(budget *migrationsv1.VirtualMachineDisruptionBudget, vmi *virtv1.VirtualMachineInstance) bool {
+	if budget.Namespace != vmi.Namespace || budget.Spec.Selector == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(budget.Spec.Selector)
+	if err != nil {
+		log.Log.Object(budget).Reason(err).Error("invalid selector of the disruption budget")
+		return false
+	}
+	return !selector.Empty() && selector.Matches(labels.Set(vmi.Labels))
+}
+
+// BudgetsForVMI returns the budgets of the indexer which apply to the VMI
+func BudgetsForVMI(budgetIndexer cache.Indexer, vmi *virtv1.VirtualMachineInstance) ([]*migrationsv1.VirtualMachineDisruptionBudget, error) {
+	objs, err := budgetIndexer.ByIndex(cache.NamespaceIndex, vmi.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var budgets []*migrationsv1.VirtualMachineDisruptionBudget
+	for _, obj := range objs {
+		budget := obj.(*migrationsv1.VirtualMachineDisruptionBudget)
+		if Selects(budget, vmi) {
+			budgets = append(budgets, budget)
+		}
+	}
+	return budgets, nil
+}
+
+// IsDisrupted returns whether the VMI does not run, shuts down or migrates.
+// migrating holds the keys of the VMIs with an unfinished migration.
+func IsDisrupted(vmi *virtv1.VirtualMachineInstance, migrating map[string]bool) bool {
+	return !vmi.IsRunning() || vmi.DeletionTimestamp != nil ||
+		migrationutils.IsMigrating(vmi) || migrating[controller.NamespacedKey(vmi.Namespace, vmi.Name)]
+}
+
+// MigratingVMIs returns the keys of the VMIs the migrations are for
+func MigratingVMIs(migrations []*virtv1.VirtualMachineInstanceMigration) map[string]bool {
+	migrating := map[string]bool{}
+	for _, migration := range migrations {
+		if !migration.IsFinal() {
+			migrating[controller.NamespacedKey(migration.Namespace, migration.Spec.VMIName)] = true
+		}
+	}
+	return migrating
+}
+
+// MaxUnavailable returns the number of the expected VMIs which can be unavailable at the same time
+func MaxUnavailable(budget *migrationsv1.VirtualMachineDisruptionBudget, expected int) (int, error) {
+	if budget.Spec.MaxUnavailable == nil {
+		return defaultMaxUnavailable, nil
+	}
+	return intstr.GetScaledValueFromIntOrPercent(budget.Spec.MaxUnavailable, expected, true)
+}
+
+// ComputeStatus computes the status of the budget from the VMIs of its namespace and the
+// unfinished migrations. The granted disruptions which are not observed yet are kept until
+// they time out, the returned duration is when the first of them does.
+func ComputeStatus(budget *migrationsv1.VirtualMachineDisruptionBudget, vmis []*virtv1.VirtualMachineInstance, migrating map[string]bool, now time.Time) (migrationsv1.VirtualMachineDisruptionBudgetStatus, time.Duration, error) {
+	expected := map[string]bool{}
+	disrupted := 0
+	for _, vmi := range vmis {
+		if vmi.IsFinal() || !Selects(budget, vmi) {
+			continue
+		}
+		expected[vmi.Name] = IsDisrupted(vmi, migrating)
+		if expected[vmi.Name] {
+			disrupted++
+		}
+	}
+
+	var requeueAfter time.Duration
+	granted := map[string]metav1.Time{}
+	for name, grantTime := range budget.Status.DisruptedVirtualMachineInstances {
+		isDisrupted, exists := expected[name]
+		if !exists || isDisrupted {
+			// The disruption happened
+			continue
+		}
+		expiry := grantTime.Add(DisruptionTimeout).Sub(now)
+		if expiry <= 0 {
+			log.Log.Object(budget).V(2).Infof("disruption of VMI %s was granted but did not happen", name)
+			continue
+		}
+		granted[name] = grantTime
+		if requeueAfter == 0 || expiry < requeueAfter {
+			requeueAfter = expiry
+		}
+	}
+
+	maxUnavailable, err := MaxUnavailable(budget, len(expected))
+	if err != nil {
+		return migrationsv1.VirtualMachineDisruptionBudgetStatus{}, 0, err
+	}
+
+	status := migrationsv1.VirtualMachineDisruptionBudgetStatus{
+		ObservedGeneration:              budget.Generation,
+		ExpectedVirtualMachineInstances: int32(len(expected)),
+		CurrentHealthy:                  int32(len(expected) - disrupted),
+		DisruptionsAllowed:              int32(max(0, maxUnavailable-disrupted-len(granted))),
+	}
+	if len(granted) > 0 {
+		status.DisruptedVirtualMachineInstances = granted
+	}
+	return status, requeueAfter, nil
+}
+
+// Disruptions grants the disruptions of VMIs against the budgets which apply to them.
+// It is meant to be used for a single sync, the budgets it updates are reused so that
+// several VMIs of a budget can be granted before the informer observes the update.
+type Disruptions struct {
+	client        kubecli.KubevirtClient
+	budgetIndexer cache.Indexer
+	updated       map[string]*migrationsv1.VirtualMachineDisruptionBudget
+}
+
+func NewDisruptions(client kubecli.KubevirtClient, budgetIndexer cache.Indexer) *Disruptions {
+	return &Disruptions{
+		client:        client,
+		budgetIndexer: budgetIndexer,
+		updated:       map[string]*migrationsv1.VirtualMachineDisruptionBudget{},
+	}
+}
+
+// Allow returns whether the VMI can be disrupted now, and records the disruption in the
+// budget which applies to it. A VMI selected by more than one budget is never disrupted,
+// like a pod with more than one PodDisruptionBudget is never evicted.
+func (d *Disruptions) Allow(vmi *virtv1.VirtualMachineInstance) (bool, error) {
+	budgets, err := BudgetsForVMI(d.budgetIndexer, vmi)
+	if err != nil {
+		return false, err
+	}
+	switch len(budgets) {
+	case 0:
+		return true, nil
+	case 1:
+	default:
+		log.Log.Object(vmi).Warningf("VMI is selected by %d disruption budgets, it can't be disrupted", len(budgets))
+		return false, nil
+	}
+
+	budget := budgets[0]
+	key := controller.NamespacedKey(budget.Namespace, budget.Name)
+	if updated, exists := d.updated[key]; exists {
+		budget = updated
+	}
+
+	if _, granted := budget.Status.DisruptedVirtualMachineInstances[vmi.Name]; granted {
+		return true, nil
+	}
+	if budget.Status.ObservedGeneration < budget.Generation || budget.Status.DisruptionsAllowed <= 0 {
+		return false, nil
+	}
+
+	budget = budget.DeepCopy()
+	budget.Status.DisruptionsAllowed--
+	if budget.Status.DisruptedVirtualMachineInstances == nil {
+		budget.Status.DisruptedVirtualMachineInstances = map[string]metav1.Time{}
+	}
+	budget.Status.DisruptedVirtualMachineInstances[vmi.Name] = metav1.Now()
+
+	updated, err := d.client.VirtualMachineDisruptionBudget(budget.Namespace).UpdateStatus(context.Background(), budget, metav1.UpdateOptions{})
+	if errors.IsConflict(err) {
+		// Someone else changed the budget since it was cached, the next sync will see it
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to record the disruption of VMI %s in budget %s: %v", vmi.Name, budget.Name, err)
+	}
+	d.updated[key] = updated
+	return true, nil
+}
+
+// AllowUpTo returns up to limit of the VMIs, in order, whose disruption is allowed
+func (d *Disruptions) AllowUpTo(vmis []*virtv1.VirtualMachineInstance, limit int) ([]*virtv1.VirtualMachineInstance, error) {
+	var allowed []*virtv1.VirtualMachineInstance
+	for _, vmi := range vmis {
+		if len(allowed) >= limit {
+			break
+		}
+		ok, err := d.Allow(vmi)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			allowed = append(allowed, vmi)
+		}
+	}
+	return allowed, nil
+}
@@ -0,0 +1,235 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmdisruption_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/vmdisruption"
+)
+
+var _ = Describe("VirtualMachine disruption budget", func() {
+	newBudget := func(name string, maxUnavailable *intstr.IntOrString) *migrationsv1.VirtualMachineDisruptionBudget {
+		return &migrationsv1.VirtualMachineDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  metav1.NamespaceDefault,
+				Generation: 1,
+			},
+			Spec: migrationsv1.VirtualMachineDisruptionBudgetSpec{
+				Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				MaxUnavailable: maxUnavailable,
+			},
+		}
+	}
+
+	newVMI := func(name string, phase virtv1.VirtualMachineInstancePhase, opts ...libvmi.Option) *virtv1.VirtualMachineInstance {
+		opts = append([]libvmi.Option{
+			libvmi.WithName(name),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithLabel("app", "db"),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(phase))),
+		}, opts...)
+		return libvmi.New(opts...)
+	}
+
+	Context("Selects", func() {
+		It("should select the VMIs of the namespace matching the selector", func() {
+			budget := newBudget("budget", nil)
+			Expect(vmdisruption.Selects(budget, newVMI("db", virtv1.Running))).To(BeTrue())
+
+			other := newVMI("other", virtv1.Running)
+			other.Namespace = "other"
+			Expect(vmdisruption.Selects(budget, other)).To(BeFalse())
+
+			web := newVMI("web", virtv1.Running, libvmi.WithLabel("app", "web"))
+			Expect(vmdisruption.Selects(budget, web)).To(BeFalse())
+		})
+
+		It("should not select any VMI with an empty selector", func() {
+			budget := newBudget("budget", nil)
+			budget.Spec.Selector = &metav1.LabelSelector{}
+			Expect(vmdisruption.Selects(budget, newVMI("db", virtv1.Running))).To(BeFalse())
+		})
+	})
+
+	Context("ComputeStatus", func() {
+		now := time.Now()
+
+		DescribeTable("should allow the disruptions left by maxUnavailable", func(maxUnavailable *intstr.IntOrString, expectedAllowed int) {
+			vmis := []*virtv1.VirtualMachineInstance{
+				newVMI("db-1", virtv1.Running),
+				newVMI("db-2", virtv1.Running),
+				newVMI("db-3", virtv1.Running),
+				newVMI("db-4", virtv1.Scheduling),
+				newVMI("db-5", virtv1.Succeeded),
+			}
+			status, requeueAfter, err := vmdisruption.ComputeStatus(newBudget("budget", maxUnavailable), vmis, nil, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeueAfter).To(BeZero())
+			Expect(status.ObservedGeneration).To(BeEquivalentTo(1))
+			Expect(status.ExpectedVirtualMachineInstances).To(BeEquivalentTo(4))
+			Expect(status.CurrentHealthy).To(BeEquivalentTo(3))
+			Expect(status.DisruptionsAllowed).To(BeEquivalentTo(expectedAllowed))
+		},
+			Entry("by default", nil, 0),
+			Entry("with an integer", intOrStr(intstr.FromInt32(3)), 2),
+			Entry("with a percentage rounded up", intOrStr(intstr.FromString("60%")), 2),
+			Entry("with fewer than the disrupted VMIs", intOrStr(intstr.FromInt32(0)), 0),
+		)
+
+		It("should count the VMIs with an unfinished migration as disrupted", func() {
+			vmis := []*virtv1.VirtualMachineInstance{
+				newVMI("db-1", virtv1.Running),
+				newVMI("db-2", virtv1.Running),
+			}
+			migrating := vmdisruption.MigratingVMIs([]*virtv1.VirtualMachineInstanceMigration{{
+				ObjectMeta: metav1.ObjectMeta{Name: "migration", Namespace: metav1.NamespaceDefault},
+				Spec:       virtv1.VirtualMachineInstanceMigrationSpec{VMIName: "db-1"},
+			}})
+			status, _, err := vmdisruption.ComputeStatus(newBudget("budget", intOrStr(intstr.FromInt32(1))), vmis, migrating, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.CurrentHealthy).To(BeEquivalentTo(1))
+			Expect(status.DisruptionsAllowed).To(BeZero())
+		})
+
+		It("should keep the granted disruptions until they are observed or time out", func() {
+			budget := newBudget("budget", intOrStr(intstr.FromInt32(2)))
+			budget.Status.DisruptedVirtualMachineInstances = map[string]metav1.Time{
+				"pending":  metav1.NewTime(now.Add(-time.Minute)),
+				"expired":  metav1.NewTime(now.Add(-vmdisruption.DisruptionTimeout)),
+				"observed": metav1.NewTime(now),
+				"deleted":  metav1.NewTime(now),
+			}
+			vmis := []*virtv1.VirtualMachineInstance{
+				newVMI("pending", virtv1.Running),
+				newVMI("expired", virtv1.Running),
+				newVMI("observed", virtv1.Scheduled),
+			}
+			status, requeueAfter, err := vmdisruption.ComputeStatus(budget, vmis, nil, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.DisruptedVirtualMachineInstances).To(HaveLen(1))
+			Expect(status.DisruptedVirtualMachineInstances).To(HaveKey("pending"))
+			Expect(requeueAfter).To(Equal(vmdisruption.DisruptionTimeout - time.Minute))
+			Expect(status.CurrentHealthy).To(BeEquivalentTo(2))
+			Expect(status.DisruptionsAllowed).To(BeZero())
+		})
+	})
+
+	Context("Allow", func() {
+		var (
+			budgetInformer cache.SharedIndexInformer
+			kubevirtClient *kubevirtfake.Clientset
+			disruptions    *vmdisruption.Disruptions
+		)
+
+		addBudget := func(budget *migrationsv1.VirtualMachineDisruptionBudget) {
+			budget.Status.ObservedGeneration = budget.Generation
+			Expect(budgetInformer.GetStore().Add(budget)).To(Succeed())
+			_, err := kubevirtClient.MigrationsV1alpha1().VirtualMachineDisruptionBudgets(metav1.NamespaceDefault).Create(context.Background(), budget, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		getStatus := func(name string) migrationsv1.VirtualMachineDisruptionBudgetStatus {
+			budget, err := kubevirtClient.MigrationsV1alpha1().VirtualMachineDisruptionBudgets(metav1.NamespaceDefault).Get(context.Background(), name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return budget.Status
+		}
+
+		BeforeEach(func() {
+			ctrl := gomock.NewController(GinkgoT())
+			virtClient := kubecli.NewMockKubevirtClient(ctrl)
+			kubevirtClient = kubevirtfake.NewSimpleClientset()
+			virtClient.EXPECT().VirtualMachineDisruptionBudget(metav1.NamespaceDefault).
+				Return(kubevirtClient.MigrationsV1alpha1().VirtualMachineDisruptionBudgets(metav1.NamespaceDefault)).AnyTimes()
+
+			budgetInformer, _ = testutils.NewFakeInformerFor(&migrationsv1.VirtualMachineDisruptionBudget{})
+			disruptions = vmdisruption.NewDisruptions(virtClient, budgetInformer.GetIndexer())
+		})
+
+		It("should allow the disruption of VMIs without a budget", func() {
+			Expect(disruptions.Allow(newVMI("db", virtv1.Running))).To(BeTrue())
+		})
+
+		It("should grant the disruptions allowed by the budget and record them", func() {
+			budget := newBudget("budget", intOrStr(intstr.FromInt32(2)))
+			budget.Status.DisruptionsAllowed = 2
+			addBudget(budget)
+
+			Expect(disruptions.Allow(newVMI("db-1", virtv1.Running))).To(BeTrue())
+			Expect(disruptions.Allow(newVMI("db-2", virtv1.Running))).To(BeTrue())
+			Expect(disruptions.Allow(newVMI("db-3", virtv1.Running))).To(BeFalse())
+
+			status := getStatus("budget")
+			Expect(status.DisruptionsAllowed).To(BeZero())
+			Expect(status.DisruptedVirtualMachineInstances).To(HaveKey("db-1"))
+			Expect(status.DisruptedVirtualMachineInstances).To(HaveKey("db-2"))
+		})
+
+		It("should allow an already granted disruption again", func() {
+			budget := newBudget("budget", nil)
+			budget.Status.DisruptedVirtualMachineInstances = map[string]metav1.Time{"db": metav1.Now()}
+			addBudget(budget)
+
+			Expect(disruptions.Allow(newVMI("db", virtv1.Running))).To(BeTrue())
+		})
+
+		It("should not allow disruptions before the budget status is computed", func() {
+			budget := newBudget("budget", nil)
+			budget.Status.DisruptionsAllowed = 1
+			addBudget(budget)
+			budget.Generation = 2
+			Expect(budgetInformer.GetStore().Update(budget)).To(Succeed())
+
+			Expect(disruptions.Allow(newVMI("db", virtv1.Running))).To(BeFalse())
+		})
+
+		It("should not allow the disruption of VMIs selected by more than one budget", func() {
+			for _, name := range []string{"first", "second"} {
+				budget := newBudget(name, nil)
+				budget.Status.DisruptionsAllowed = 1
+				addBudget(budget)
+			}
+
+			Expect(disruptions.Allow(newVMI("db", virtv1.Running))).To(BeFalse())
+		})
+	})
+})
+
+func intOrStr(value intstr.IntOrString) *intstr.IntOrString {
+	return &value
+}
@@ -0,0 +1,173 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmquota
+
+import (
+	"sort"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	quotav1 "kubevirt.io/api/quota/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+)
+
+// Resources are the resources a VirtualMachineResourceQuota can limit
+var Resources = []k8sv1.ResourceName{
+	quotav1.ResourceGuestCPU,
+	quotav1.ResourceGuestMemory,
+	quotav1.ResourceVirtualMachines,
+}
+
+// Usage returns what the VirtualMachine is charged in a VirtualMachineResourceQuota.
+// The guest vCPUs and memory of the instancetype, when there is one, take precedence
+// over the ones of the template. A VirtualMachine is charged whether it runs or not.
+func Usage(vm *v1.VirtualMachine, instancetypeSpec *instancetypev1beta1.VirtualMachineInstancetypeSpec) k8sv1.ResourceList {
+	usage := k8sv1.ResourceList{
+		quotav1.ResourceVirtualMachines: *resource.NewQuantity(1, resource.DecimalSI),
+	}
+
+	var spec *v1.VirtualMachineInstanceSpec
+	if vm.Spec.Template != nil {
+		spec = &vm.Spec.Template.Spec
+	}
+
+	usage[quotav1.ResourceGuestCPU] = *resource.NewQuantity(guestCPUs(spec, instancetypeSpec), resource.DecimalSI)
+	if memory := guestMemory(spec, instancetypeSpec); memory != nil {
+		usage[quotav1.ResourceGuestMemory] = memory.DeepCopy()
+	}
+
+	return usage
+}
+
+func guestCPUs(spec *v1.VirtualMachineInstanceSpec, instancetypeSpec *instancetypev1beta1.VirtualMachineInstancetypeSpec) int64 {
+	if instancetypeSpec != nil && instancetypeSpec.CPU.Guest > 0 {
+		return int64(instancetypeSpec.CPU.Guest)
+	}
+	if spec == nil {
+		return 1
+	}
+
+	if spec.Domain.CPU != nil {
+		if vcpus := hardware.GetNumberOfVCPUs(spec.Domain.CPU); vcpus > 0 {
+			return vcpus
+		}
+	}
+
+	// Without a topology the vCPUs follow the CPU limits or requests, rounded up
+	if cpu, ok := spec.Domain.Resources.Limits[k8sv1.ResourceCPU]; ok {
+		return max(1, (cpu.MilliValue()+999)/1000)
+	}
+	if cpu, ok := spec.Domain.Resources.Requests[k8sv1.ResourceCPU]; ok {
+		return max(1, (cpu.MilliValue()+999)/1000)
+	}
+	return 1
+}
+
+func guestMemory(spec *v1.VirtualMachineInstanceSpec, instancetypeSpec *instancetypev1beta1.VirtualMachineInstancetypeSpec) *resource.Quantity {
+	if instancetypeSpec != nil {
+		return &instancetypeSpec.Memory.Guest
+	}
+	if spec == nil {
+		return nil
+	}
+
+	if spec.Domain.Memory != nil && spec.Domain.Memory.Guest != nil {
+		return spec.Domain.Memory.Guest
+	}
+	if memory, ok := spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; ok {
+		return &memory
+	}
+	if memory, ok := spec.Domain.Resources.Limits[k8sv1.ResourceMemory]; ok {
+		return &memory
+	}
+	return nil
+}
+
+// Add returns the sum of a and b
+func Add(a, b k8sv1.ResourceList) k8sv1.ResourceList {
+	sum := a.DeepCopy()
+	if sum == nil {
+		sum = k8sv1.ResourceList{}
+	}
+	for name, quantity := range b {
+		value := sum[name]
+		value.Add(quantity)
+		sum[name] = value
+	}
+	return sum
+}
+
+// Subtract returns the difference of a and b
+func Subtract(a, b k8sv1.ResourceList) k8sv1.ResourceList {
+	difference := a.DeepCopy()
+	if difference == nil {
+		difference = k8sv1.ResourceList{}
+	}
+	for name, quantity := range b {
+		value := difference[name]
+		value.Sub(quantity)
+		difference[name] = value
+	}
+	return difference
+}
+
+// Increase returns the resources of delta limited in hard which grow, the ones which shrink
+// are released by the controller once the change is persisted
+func Increase(hard, delta k8sv1.ResourceList) k8sv1.ResourceList {
+	increase := k8sv1.ResourceList{}
+	for name, quantity := range delta {
+		if _, limited := hard[name]; limited && quantity.Sign() > 0 {
+			increase[name] = quantity.DeepCopy()
+		}
+	}
+	return increase
+}
+
+// Exceeded returns the resources, sorted by name, for which adding the increase to the usage goes above hard
+func Exceeded(hard, used, increase k8sv1.ResourceList) []k8sv1.ResourceName {
+	var exceeded []k8sv1.ResourceName
+	for name, quantity := range increase {
+		limit, limited := hard[name]
+		if !limited {
+			continue
+		}
+		value := used[name]
+		value.Add(quantity)
+		if value.Cmp(limit) > 0 {
+			exceeded = append(exceeded, name)
+		}
+	}
+	sort.Slice(exceeded, func(i, j int) bool { return exceeded[i] < exceeded[j] })
+	return exceeded
+}
+
+// Mask returns the resources of list which are limited in hard
+func Mask(list, hard k8sv1.ResourceList) k8sv1.ResourceList {
+	masked := k8sv1.ResourceList{}
+	for name := range hard {
+		value := list[name]
+		masked[name] = value.DeepCopy()
+	}
+	return masked
+}
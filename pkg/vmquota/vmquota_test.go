@@ -0,0 +1,120 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmquota_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	quotav1 "kubevirt.io/api/quota/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/vmquota"
+)
+
+var _ = Describe("VirtualMachine resource quota", func() {
+	Context("Usage", func() {
+		DescribeTable("should charge the guest vCPUs and memory of the VM", func(cpu libvmi.Option, expectedCPU int, expectedMemory string) {
+			usage := vmquota.Usage(libvmi.NewVirtualMachine(libvmi.New(cpu, libvmi.WithGuestMemory(expectedMemory))), nil)
+			Expect(usage).To(HaveKeyWithValue(quotav1.ResourceVirtualMachines, *resource.NewQuantity(1, resource.DecimalSI)))
+			Expect(usage).To(HaveKeyWithValue(quotav1.ResourceGuestCPU, *resource.NewQuantity(int64(expectedCPU), resource.DecimalSI)))
+			Expect(usage).To(HaveKeyWithValue(quotav1.ResourceGuestMemory, resource.MustParse(expectedMemory)))
+		},
+			Entry("from the CPU topology and the guest memory", libvmi.WithCPUCount(2, 2, 2), 8, "1Gi"),
+			Entry("from the CPU limits rounded up", libvmi.WithCPULimit("1500m"), 2, "1Gi"),
+			Entry("from the CPU requests rounded up", libvmi.WithCPURequest("200m"), 1, "1Gi"),
+		)
+
+		It("should charge the memory requests without a guest memory", func() {
+			usage := vmquota.Usage(libvmi.NewVirtualMachine(libvmi.New(libvmi.WithMemoryRequest("512Mi"))), nil)
+			Expect(usage).To(HaveKeyWithValue(quotav1.ResourceGuestCPU, *resource.NewQuantity(1, resource.DecimalSI)))
+			Expect(usage).To(HaveKeyWithValue(quotav1.ResourceGuestMemory, resource.MustParse("512Mi")))
+		})
+
+		It("should charge the guest vCPUs and memory of the instancetype", func() {
+			vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithCPUCount(1, 1, 1)), libvmi.WithInstancetype("large"))
+			instancetypeSpec := &instancetypev1beta1.VirtualMachineInstancetypeSpec{
+				CPU:    instancetypev1beta1.CPUInstancetype{Guest: 4},
+				Memory: instancetypev1beta1.MemoryInstancetype{Guest: resource.MustParse("8Gi")},
+			}
+
+			usage := vmquota.Usage(vm, instancetypeSpec)
+			Expect(usage).To(HaveKeyWithValue(quotav1.ResourceGuestCPU, *resource.NewQuantity(4, resource.DecimalSI)))
+			Expect(usage).To(HaveKeyWithValue(quotav1.ResourceGuestMemory, resource.MustParse("8Gi")))
+		})
+	})
+
+	Context("Exceeded", func() {
+		hard := k8sv1.ResourceList{
+			quotav1.ResourceGuestCPU:        resource.MustParse("8"),
+			quotav1.ResourceVirtualMachines: resource.MustParse("2"),
+		}
+
+		It("should only consider the increase of the limited resources", func() {
+			delta := k8sv1.ResourceList{
+				quotav1.ResourceGuestCPU:        resource.MustParse("-2"),
+				quotav1.ResourceGuestMemory:     resource.MustParse("1Gi"),
+				quotav1.ResourceVirtualMachines: resource.MustParse("1"),
+			}
+			Expect(vmquota.Increase(hard, delta)).To(Equal(k8sv1.ResourceList{
+				quotav1.ResourceVirtualMachines: resource.MustParse("1"),
+			}))
+		})
+
+		It("should return the resources going above the hard limits", func() {
+			used := k8sv1.ResourceList{
+				quotav1.ResourceGuestCPU:        resource.MustParse("6"),
+				quotav1.ResourceVirtualMachines: resource.MustParse("1"),
+			}
+			Expect(vmquota.Exceeded(hard, used, k8sv1.ResourceList{
+				quotav1.ResourceGuestCPU:        resource.MustParse("2"),
+				quotav1.ResourceVirtualMachines: resource.MustParse("1"),
+			})).To(BeEmpty())
+			Expect(vmquota.Exceeded(hard, used, k8sv1.ResourceList{
+				quotav1.ResourceGuestCPU:        resource.MustParse("4"),
+				quotav1.ResourceVirtualMachines: resource.MustParse("2"),
+			})).To(Equal([]k8sv1.ResourceName{quotav1.ResourceGuestCPU, quotav1.ResourceVirtualMachines}))
+		})
+	})
+
+	It("should add and subtract resource lists", func() {
+		a := k8sv1.ResourceList{quotav1.ResourceGuestCPU: resource.MustParse("4")}
+		b := k8sv1.ResourceList{
+			quotav1.ResourceGuestCPU:    resource.MustParse("2"),
+			quotav1.ResourceGuestMemory: resource.MustParse("1Gi"),
+		}
+
+		sum := vmquota.Add(a, b)
+		cpu := sum[quotav1.ResourceGuestCPU]
+		Expect(cpu.Value()).To(BeEquivalentTo(6))
+		memory := sum[quotav1.ResourceGuestMemory]
+		Expect(memory.Cmp(resource.MustParse("1Gi"))).To(BeZero())
+
+		difference := vmquota.Subtract(sum, b)
+		cpu = difference[quotav1.ResourceGuestCPU]
+		Expect(cpu.Value()).To(BeEquivalentTo(4))
+		memory = difference[quotav1.ResourceGuestMemory]
+		Expect(memory.IsZero()).To(BeTrue())
+	})
+})
@@ -0,0 +1,144 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const VirtualMachineAutoscalingPolicyKind = "VirtualMachineAutoscalingPolicy"
+
+// VirtualMachineAutoscalingPolicy resizes the CPU sockets and the guest memory of the selected
+// VirtualMachines towards a target utilization, within the bounds set by the user.
+// The resize goes through CPU and memory hotplug. When a resize can't be hotplugged,
+// the VirtualMachines can optionally be restarted on a schedule to apply it.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+type VirtualMachineAutoscalingPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineAutoscalingPolicySpec   `json:"spec" valid:"required"`
+	Status VirtualMachineAutoscalingPolicyStatus `json:"status,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachineAutoscalingPolicySpec struct {
+	// Selector selects the VirtualMachines of the namespace the policy applies to by their labels.
+	Selector *metav1.LabelSelector `json:"selector" valid:"required"`
+
+	// CPU sets the bounds the CPU sockets are resized within.
+	// +optional
+	CPU *VirtualMachineCPUAutoscaling `json:"cpu,omitempty"`
+
+	// Memory sets the bounds the guest memory is resized within.
+	// +optional
+	Memory *VirtualMachineMemoryAutoscaling `json:"memory,omitempty"`
+
+	// Cooldown is the minimum time between two resizes of a VirtualMachine. Defaults to 5m.
+	// +optional
+	Cooldown *metav1.Duration `json:"cooldown,omitempty"`
+
+	// RestartFallback restarts the VirtualMachines which can't be resized through hotplug.
+	// The VirtualMachines are left as they are when not set.
+	// +optional
+	RestartFallback *VirtualMachineAutoscalingRestartFallback `json:"restartFallback,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachineCPUAutoscaling struct {
+	// MinSockets is the lowest number of CPU sockets a VirtualMachine is resized to.
+	MinSockets uint32 `json:"minSockets"`
+
+	// MaxSockets is the highest number of CPU sockets a VirtualMachine is resized to.
+	MaxSockets uint32 `json:"maxSockets"`
+
+	// TargetUtilizationPercentage is the CPU usage, in percent of the vCPUs, the sockets are resized towards.
+	// Defaults to 70.
+	// +optional
+	TargetUtilizationPercentage *int32 `json:"targetUtilizationPercentage,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachineMemoryAutoscaling struct {
+	// Min is the lowest guest memory a VirtualMachine is resized to.
+	Min resource.Quantity `json:"min"`
+
+	// Max is the highest guest memory a VirtualMachine is resized to.
+	Max resource.Quantity `json:"max"`
+
+	// TargetUtilizationPercentage is the memory usage, in percent of the guest memory, the memory is resized towards.
+	// Defaults to 70.
+	// +optional
+	TargetUtilizationPercentage *int32 `json:"targetUtilizationPercentage,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachineAutoscalingRestartFallback struct {
+	// Schedule is the cron expression of the times a VirtualMachine may be restarted at.
+	Schedule string `json:"schedule"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachineAutoscalingPolicyStatus struct {
+	// VirtualMachines holds the state of the VirtualMachines the policy applies to.
+	// +listType=atomic
+	// +optional
+	VirtualMachines []VirtualMachineAutoscalingStatus `json:"virtualMachines,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachineAutoscalingStatus struct {
+	// Name is the name of the VirtualMachine.
+	Name string `json:"name"`
+
+	// Sockets is the number of CPU sockets the VirtualMachine was last resized to.
+	// +optional
+	Sockets uint32 `json:"sockets,omitempty"`
+
+	// Memory is the guest memory the VirtualMachine was last resized to.
+	// +optional
+	Memory *resource.Quantity `json:"memory,omitempty"`
+
+	// LastScaleTime is the time the VirtualMachine was last resized at.
+	// +optional
+	// +nullable
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+
+	// RestartTime is the time the VirtualMachine is restarted at to apply a resize
+	// which can't be hotplugged.
+	// +optional
+	// +nullable
+	RestartTime *metav1.Time `json:"restartTime,omitempty"`
+}
+
+// VirtualMachineAutoscalingPolicyList is a list of VirtualMachineAutoscalingPolicy resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+type VirtualMachineAutoscalingPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []VirtualMachineAutoscalingPolicy `json:"items"`
+}
@@ -148,6 +148,18 @@ func (in *VirtualMachineCloneSpec) DeepCopyInto(out *VirtualMachineCloneSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VirtualMachineCloneVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(VirtualMachineCloneStorage)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -203,6 +215,27 @@ func (in *VirtualMachineCloneStatus) DeepCopy() *VirtualMachineCloneStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCloneStorage) DeepCopyInto(out *VirtualMachineCloneStorage) {
+	*out = *in
+	if in.CloneStrategy != nil {
+		in, out := &in.CloneStrategy, &out.CloneStrategy
+		*out = new(VirtualMachineCloneStrategy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCloneStorage.
+func (in *VirtualMachineCloneStorage) DeepCopy() *VirtualMachineCloneStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCloneStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineCloneTemplateFilters) DeepCopyInto(out *VirtualMachineCloneTemplateFilters) {
 	*out = *in
@@ -228,3 +261,24 @@ func (in *VirtualMachineCloneTemplateFilters) DeepCopy() *VirtualMachineCloneTem
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCloneVolume) DeepCopyInto(out *VirtualMachineCloneVolume) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCloneVolume.
+func (in *VirtualMachineCloneVolume) DeepCopy() *VirtualMachineCloneVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCloneVolume)
+	in.DeepCopyInto(out)
+	return out
+}
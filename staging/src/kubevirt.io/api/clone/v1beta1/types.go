@@ -21,6 +21,7 @@ package v1beta1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -66,6 +67,13 @@ type VirtualMachineCloneSpec struct {
 	// +optional
 	Target *corev1.TypedLocalObjectReference `json:"target,omitempty"`
 
+	// TargetNamespace is the namespace in which the target is created. If not provided, the target
+	// is created in the namespace of the clone. Creating the target in another namespace requires
+	// the permission to create VirtualMachines in it, and the volumes of the target are cloned by
+	// DataVolumes which have to be authorized to clone from the namespace of the clone.
+	// +optional
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+
 	// Example use: "!some/key*".
 	// For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
 	// +optional
@@ -93,8 +101,62 @@ type VirtualMachineCloneSpec struct {
 	// +optional
 	// +listType=atomic
 	Patches []string `json:"patches,omitempty"`
+	// Volumes customizes how individual volumes of the source are cloned. Volumes that are not
+	// listed are cloned as is, at their original size.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Volumes []VirtualMachineCloneVolume `json:"volumes,omitempty"`
+	// Storage defines how the volumes of the target are provisioned.
+	// +optional
+	Storage *VirtualMachineCloneStorage `json:"storage,omitempty"`
+}
+
+// VirtualMachineCloneStorage defines how the volumes of the target are provisioned.
+type VirtualMachineCloneStorage struct {
+	// CloneStrategy selects how the volumes of the source are cloned. Defaults to Full.
+	// +optional
+	// +kubebuilder:validation:Enum=Full;Linked
+	CloneStrategy *VirtualMachineCloneStrategy `json:"cloneStrategy,omitempty"`
+}
+
+type VirtualMachineCloneStrategy string
+
+const (
+	// CloneStrategyFull clones the volumes of the source through a snapshot into independent copies.
+	CloneStrategyFull VirtualMachineCloneStrategy = "Full"
+	// CloneStrategyLinked creates copy-on-write children of the volumes of the source. Volumes whose
+	// storage class is cloned by its CSI driver (the "csi-clone" strategy of the CDI StorageProfile)
+	// are cloned into new PVCs, all other volumes are backed by a qcow2 overlay managed by virt-launcher
+	// on top of the source PVC, which is discarded when the target VMI stops.
+	// Linked clones are only supported for VirtualMachine sources in the namespace of the clone and
+	// rely on the source volumes for as long as the target exists.
+	CloneStrategyLinked VirtualMachineCloneStrategy = "Linked"
+)
+
+// VirtualMachineCloneVolume customizes the clone of a single source volume.
+type VirtualMachineCloneVolume struct {
+	// Name is the name of the volume in the source.
+	Name string `json:"name"`
+	// Exclude drops the volume, along with its disk, from the target.
+	// +optional
+	Exclude bool `json:"exclude,omitempty"`
+	// Size overrides the requested storage size of the cloned volume. Only volumes backed by a
+	// PersistentVolumeClaim or a DataVolume can be resized and the size must not be smaller than
+	// the size of the source volume.
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty"`
 }
 
+const (
+	// SourceVMNameAnnotation is set on the target VM and holds the name of the cloned VM
+	SourceVMNameAnnotation = "clone.kubevirt.io/source-vm-name"
+	// SourceVMNamespaceAnnotation is set on the target VM and holds the namespace of the cloned VM
+	SourceVMNamespaceAnnotation = "clone.kubevirt.io/source-vm-namespace"
+	// SourceSnapshotUIDAnnotation is set on the target VM and holds the UID of the snapshot the target was created from
+	SourceSnapshotUIDAnnotation = "clone.kubevirt.io/source-snapshot-uid"
+)
+
 type VirtualMachineClonePhase string
 
 const (
@@ -19,12 +19,31 @@ func (VirtualMachineCloneSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"source":            "Source is the object that would be cloned. Currently supported source types are:\nVirtualMachine of kubevirt.io API group,\nVirtualMachineSnapshot of snapshot.kubevirt.io API group",
 		"target":            "Target is the outcome of the cloning process.\nCurrently supported source types are:\n- VirtualMachine of kubevirt.io API group\n- Empty (nil).\nIf the target is not provided, the target type would default to VirtualMachine and a random\nname would be generated for the target. The target's name can be viewed by\ninspecting status \"TargetName\" field below.\n+optional",
+		"targetNamespace":   "TargetNamespace is the namespace in which the target is created. If not provided, the target\nis created in the namespace of the clone. Creating the target in another namespace requires\nthe permission to create VirtualMachines in it, and the volumes of the target are cloned by\nDataVolumes which have to be authorized to clone from the namespace of the clone.\n+optional",
 		"annotationFilters": "Example use: \"!some/key*\".\nFor a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.\n+optional\n+listType=atomic",
 		"labelFilters":      "Example use: \"!some/key*\".\nFor a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.\n+optional\n+listType=atomic",
 		"template":          "For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.\n+optional",
 		"newMacAddresses":   "NewMacAddresses manually sets that target interfaces' mac addresses. The key is the interface name and the\nvalue is the new mac address. If this field is not specified, a new MAC address will\nbe generated automatically, as for any interface that is not included in this map.\n+optional",
 		"newSMBiosSerial":   "NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, a new serial will\nbe generated automatically.\n+optional",
 		"patches":           "Patches holds JSON patches to apply to target. Patches should fit the target's Kind.\nExample: '{\"op\": \"add\", \"path\": \"/spec/template/metadata/labels/example\", \"value\": \"new-label\"}'\n+optional\n+listType=atomic",
+		"volumes":           "Volumes customizes how individual volumes of the source are cloned. Volumes that are not\nlisted are cloned as is, at their original size.\n+optional\n+listType=map\n+listMapKey=name",
+		"storage":           "Storage defines how the volumes of the target are provisioned.\n+optional",
+	}
+}
+
+func (VirtualMachineCloneStorage) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "VirtualMachineCloneStorage defines how the volumes of the target are provisioned.",
+		"cloneStrategy": "CloneStrategy selects how the volumes of the source are cloned. Defaults to Full.\n+optional\n+kubebuilder:validation:Enum=Full;Linked",
+	}
+}
+
+func (VirtualMachineCloneVolume) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "VirtualMachineCloneVolume customizes the clone of a single source volume.",
+		"name":    "Name is the name of the volume in the source.",
+		"exclude": "Exclude drops the volume, along with its disk, from the target.\n+optional",
+		"size":    "Size overrides the requested storage size of the cloned volume. Only volumes backed by a\nPersistentVolumeClaim or a DataVolume can be resized and the size must not be smaller than\nthe size of the source volume.\n+optional",
 	}
 }
 
@@ -28,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	types "k8s.io/apimachinery/pkg/types"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -88,6 +89,27 @@ func (in *AccessCredentialSecretSource) DeepCopy() *AccessCredentialSecretSource
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddUSBDeviceOptions) DeepCopyInto(out *AddUSBDeviceOptions) {
+	*out = *in
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddUSBDeviceOptions.
+func (in *AddUSBDeviceOptions) DeepCopy() *AddUSBDeviceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AddUSBDeviceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddVolumeOptions) DeepCopyInto(out *AddVolumeOptions) {
 	*out = *in
@@ -96,6 +118,11 @@ func (in *AddVolumeOptions) DeepCopyInto(out *AddVolumeOptions) {
 		*out = new(Disk)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Filesystem != nil {
+		in, out := &in.Filesystem, &out.Filesystem
+		*out = new(Filesystem)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VolumeSource != nil {
 		in, out := &in.VolumeSource, &out.VolumeSource
 		*out = new(HotplugVolumeSource)
@@ -119,6 +146,72 @@ func (in *AddVolumeOptions) DeepCopy() *AddVolumeOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionPolicyConfiguration) DeepCopyInto(out *AdmissionPolicyConfiguration) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AdmissionPolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionPolicyConfiguration.
+func (in *AdmissionPolicyConfiguration) DeepCopy() *AdmissionPolicyConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionPolicyConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionPolicyRule) DeepCopyInto(out *AdmissionPolicyRule) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionPolicyRule.
+func (in *AdmissionPolicyRule) DeepCopy() *AdmissionPolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionPolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplyChangesOptions) DeepCopyInto(out *ApplyChangesOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplyChangesOptions.
+func (in *ApplyChangesOptions) DeepCopy() *ApplyChangesOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplyChangesOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArchConfiguration) DeepCopyInto(out *ArchConfiguration) {
 	*out = *in
@@ -515,6 +608,13 @@ func (in *CloudInitConfigDriveSource) DeepCopyInto(out *CloudInitConfigDriveSour
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.UserDataSources != nil {
+		in, out := &in.UserDataSources, &out.UserDataSources
+		*out = make([]CloudInitUserDataSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -541,6 +641,13 @@ func (in *CloudInitNoCloudSource) DeepCopyInto(out *CloudInitNoCloudSource) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.UserDataSources != nil {
+		in, out := &in.UserDataSources, &out.UserDataSources
+		*out = make([]CloudInitUserDataSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -554,6 +661,32 @@ func (in *CloudInitNoCloudSource) DeepCopy() *CloudInitNoCloudSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudInitUserDataSource) DeepCopyInto(out *CloudInitUserDataSource) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInitUserDataSource.
+func (in *CloudInitUserDataSource) DeepCopy() *CloudInitUserDataSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudInitUserDataSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterProfilerRequest) DeepCopyInto(out *ClusterProfilerRequest) {
 	*out = *in
@@ -678,6 +811,27 @@ func (in *ConfigMapVolumeSource) DeepCopy() *ConfigMapVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleSharing) DeepCopyInto(out *ConsoleSharing) {
+	*out = *in
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleSharing.
+func (in *ConsoleSharing) DeepCopy() *ConsoleSharing {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleSharing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerDiskInfo) DeepCopyInto(out *ContainerDiskInfo) {
 	*out = *in
@@ -726,6 +880,32 @@ func (in *ControllerRevisionRef) DeepCopy() *ControllerRevisionRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrashDump) DeepCopyInto(out *CrashDump) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxDumps != nil {
+		in, out := &in.MaxDumps, &out.MaxDumps
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrashDump.
+func (in *CrashDump) DeepCopy() *CrashDump {
+	if in == nil {
+		return nil
+	}
+	out := new(CrashDump)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomBlockSize) DeepCopyInto(out *CustomBlockSize) {
 	*out = *in
@@ -1169,6 +1349,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AutoattachVirtIODrivers != nil {
+		in, out := &in.AutoattachVirtIODrivers, &out.AutoattachVirtIODrivers
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Rng != nil {
 		in, out := &in.Rng, &out.Rng
 		*out = new(Rng)
@@ -1222,6 +1407,16 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(ClientPassthroughDevices)
 		**out = **in
 	}
+	if in.ConsoleSharing != nil {
+		in, out := &in.ConsoleSharing, &out.ConsoleSharing
+		*out = new(ConsoleSharing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Graphics != nil {
+		in, out := &in.Graphics, &out.Graphics
+		*out = new(Graphics)
+		**out = **in
+	}
 	if in.Sound != nil {
 		in, out := &in.Sound, &out.Sound
 		*out = new(SoundDevice)
@@ -1327,6 +1522,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(DiskErrorPolicy)
 		**out = **in
 	}
+	if in.IOLimits != nil {
+		in, out := &in.IOLimits, &out.IOLimits
+		*out = new(DiskIOLimits)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1371,6 +1571,88 @@ func (in *DiskDevice) DeepCopy() *DiskDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOBurst) DeepCopyInto(out *DiskIOBurst) {
+	*out = *in
+	if in.ReadIOPS != nil {
+		in, out := &in.ReadIOPS, &out.ReadIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WriteIOPS != nil {
+		in, out := &in.WriteIOPS, &out.WriteIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadBandwidth != nil {
+		in, out := &in.ReadBandwidth, &out.ReadBandwidth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.WriteBandwidth != nil {
+		in, out := &in.WriteBandwidth, &out.WriteBandwidth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LengthSeconds != nil {
+		in, out := &in.LengthSeconds, &out.LengthSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOBurst.
+func (in *DiskIOBurst) DeepCopy() *DiskIOBurst {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOBurst)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOLimits) DeepCopyInto(out *DiskIOLimits) {
+	*out = *in
+	if in.ReadIOPS != nil {
+		in, out := &in.ReadIOPS, &out.ReadIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WriteIOPS != nil {
+		in, out := &in.WriteIOPS, &out.WriteIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadBandwidth != nil {
+		in, out := &in.ReadBandwidth, &out.ReadBandwidth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.WriteBandwidth != nil {
+		in, out := &in.WriteBandwidth, &out.WriteBandwidth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(DiskIOBurst)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOLimits.
+func (in *DiskIOLimits) DeepCopy() *DiskIOLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskIOThreads) DeepCopyInto(out *DiskIOThreads) {
 	*out = *in
@@ -1640,6 +1922,22 @@ func (in *EphemeralVolumeSource) DeepCopy() *EphemeralVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvacuationOrder) DeepCopyInto(out *EvacuationOrder) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvacuationOrder.
+func (in *EvacuationOrder) DeepCopy() *EvacuationOrder {
+	if in == nil {
+		return nil
+	}
+	out := new(EvacuationOrder)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeatureAPIC) DeepCopyInto(out *FeatureAPIC) {
 	*out = *in
@@ -1884,7 +2182,7 @@ func (in *Filesystem) DeepCopyInto(out *Filesystem) {
 	if in.Virtiofs != nil {
 		in, out := &in.Virtiofs, &out.Virtiofs
 		*out = new(FilesystemVirtiofs)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -1902,6 +2200,11 @@ func (in *Filesystem) DeepCopy() *Filesystem {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilesystemVirtiofs) DeepCopyInto(out *FilesystemVirtiofs) {
 	*out = *in
+	if in.ThreadPoolSize != nil {
+		in, out := &in.ThreadPoolSize, &out.ThreadPoolSize
+		*out = new(uint32)
+		**out = **in
+	}
 	return
 }
 
@@ -2046,6 +2349,22 @@ func (in *GenerationStatus) DeepCopy() *GenerationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Graphics) DeepCopyInto(out *Graphics) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Graphics.
+func (in *Graphics) DeepCopy() *Graphics {
+	if in == nil {
+		return nil
+	}
+	out := new(Graphics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GuestAgentCommandInfo) DeepCopyInto(out *GuestAgentCommandInfo) {
 	*out = *in
@@ -2079,49 +2398,146 @@ func (in *GuestAgentPing) DeepCopy() *GuestAgentPing {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
+func (in *GuestFile) DeepCopyInto(out *GuestFile) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HPETTimer.
-func (in *HPETTimer) DeepCopy() *HPETTimer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestFile.
+func (in *GuestFile) DeepCopy() *GuestFile {
 	if in == nil {
 		return nil
 	}
-	out := new(HPETTimer)
+	out := new(GuestFile)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GuestFile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Handler) DeepCopyInto(out *Handler) {
+func (in *GuestFileReadOptions) DeepCopyInto(out *GuestFileReadOptions) {
 	*out = *in
-	if in.Exec != nil {
-		in, out := &in.Exec, &out.Exec
-		*out = new(corev1.ExecAction)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.GuestAgentPing != nil {
-		in, out := &in.GuestAgentPing, &out.GuestAgentPing
-		*out = new(GuestAgentPing)
-		**out = **in
-	}
-	if in.HTTPGet != nil {
-		in, out := &in.HTTPGet, &out.HTTPGet
-		*out = new(corev1.HTTPGetAction)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TCPSocket != nil {
-		in, out := &in.TCPSocket, &out.TCPSocket
-		*out = new(corev1.TCPSocketAction)
-		**out = **in
-	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestFileReadOptions.
+func (in *GuestFileReadOptions) DeepCopy() *GuestFileReadOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestFileReadOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestOSExecOptions) DeepCopyInto(out *GuestOSExecOptions) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestOSExecOptions.
+func (in *GuestOSExecOptions) DeepCopy() *GuestOSExecOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestOSExecOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestOSExecResult) DeepCopyInto(out *GuestOSExecResult) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestOSExecResult.
+func (in *GuestOSExecResult) DeepCopy() *GuestOSExecResult {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestOSExecResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GuestOSExecResult) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HPETTimer.
+func (in *HPETTimer) DeepCopy() *HPETTimer {
+	if in == nil {
+		return nil
+	}
+	out := new(HPETTimer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Handler) DeepCopyInto(out *Handler) {
+	*out = *in
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(corev1.ExecAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestAgentPing != nil {
+		in, out := &in.GuestAgentPing, &out.GuestAgentPing
+		*out = new(GuestAgentPing)
+		**out = **in
+	}
+	if in.HTTPGet != nil {
+		in, out := &in.HTTPGet, &out.HTTPGet
+		*out = new(corev1.HTTPGetAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TCPSocket != nil {
+		in, out := &in.TCPSocket, &out.TCPSocket
+		*out = new(corev1.TCPSocketAction)
+		**out = **in
+	}
 	return
 }
 
@@ -2135,6 +2551,90 @@ func (in *Handler) DeepCopy() *Handler {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernateOptions) DeepCopyInto(out *HibernateOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernateOptions.
+func (in *HibernateOptions) DeepCopy() *HibernateOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernateOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HighAvailabilityPolicy) DeepCopyInto(out *HighAvailabilityPolicy) {
+	*out = *in
+	if in.FailoverTimeout != nil {
+		in, out := &in.FailoverTimeout, &out.FailoverTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Fencing != nil {
+		in, out := &in.Fencing, &out.Fencing
+		*out = new(HighAvailabilityFencing)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HighAvailabilityPolicy.
+func (in *HighAvailabilityPolicy) DeepCopy() *HighAvailabilityPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HighAvailabilityPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hook) DeepCopyInto(out *Hook) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HookPoints != nil {
+		in, out := &in.HookPoints, &out.HookPoints
+		*out = make([]HookPoint, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hook.
+func (in *Hook) DeepCopy() *Hook {
+	if in == nil {
+		return nil
+	}
+	out := new(Hook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostDevice) DeepCopyInto(out *HostDevice) {
 	*out = *in
@@ -2223,6 +2723,11 @@ func (in *HotplugVolumeStatus) DeepCopy() *HotplugVolumeStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Hugepages) DeepCopyInto(out *Hugepages) {
 	*out = *in
+	if in.RegularMemory != nil {
+		in, out := &in.RegularMemory, &out.RegularMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
@@ -2294,6 +2799,49 @@ func (in *I6300ESBWatchdog) DeepCopy() *I6300ESBWatchdog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdlePolicy) DeepCopyInto(out *IdlePolicy) {
+	*out = *in
+	out.Timeout = in.Timeout
+	if in.CPUThresholdPercentage != nil {
+		in, out := &in.CPUThresholdPercentage, &out.CPUThresholdPercentage
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdlePolicy.
+func (in *IdlePolicy) DeepCopy() *IdlePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(IdlePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IgnitionSource) DeepCopyInto(out *IgnitionSource) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnitionSource.
+func (in *IgnitionSource) DeepCopy() *IgnitionSource {
+	if in == nil {
+		return nil
+	}
+	out := new(IgnitionSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InitrdInfo) DeepCopyInto(out *InitrdInfo) {
 	*out = *in
@@ -2368,6 +2916,27 @@ func (in *InstancetypeMatcher) DeepCopy() *InstancetypeMatcher {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstancetypeStatusPendingUpdate) DeepCopyInto(out *InstancetypeStatusPendingUpdate) {
+	*out = *in
+	if in.ChangedFields != nil {
+		in, out := &in.ChangedFields, &out.ChangedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstancetypeStatusPendingUpdate.
+func (in *InstancetypeStatusPendingUpdate) DeepCopy() *InstancetypeStatusPendingUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(InstancetypeStatusPendingUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstancetypeStatusRef) DeepCopyInto(out *InstancetypeStatusRef) {
 	*out = *in
@@ -2381,6 +2950,11 @@ func (in *InstancetypeStatusRef) DeepCopyInto(out *InstancetypeStatusRef) {
 		*out = new(InferFromVolumeFailurePolicy)
 		**out = **in
 	}
+	if in.PendingUpdate != nil {
+		in, out := &in.PendingUpdate, &out.PendingUpdate
+		*out = new(InstancetypeStatusPendingUpdate)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2418,6 +2992,16 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = new(DHCPOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RxQueueSize != nil {
+		in, out := &in.RxQueueSize, &out.RxQueueSize
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.TxQueueSize != nil {
+		in, out := &in.TxQueueSize, &out.TxQueueSize
+		*out = new(uint32)
+		**out = **in
+	}
 	return
 }
 
@@ -2882,6 +3466,11 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(KSMConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MemoryBalloon != nil {
+		in, out := &in.MemoryBalloon, &out.MemoryBalloon
+		*out = new(MemoryBalloon)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AutoCPULimitNamespaceLabelSelector != nil {
 		in, out := &in.AutoCPULimitNamespaceLabelSelector, &out.AutoCPULimitNamespaceLabelSelector
 		*out = new(metav1.LabelSelector)
@@ -2907,6 +3496,56 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(InstancetypeConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PoolAutoscaling != nil {
+		in, out := &in.PoolAutoscaling, &out.PoolAutoscaling
+		*out = new(PoolAutoscalingConfiguration)
+		**out = **in
+	}
+	if in.VirtIODrivers != nil {
+		in, out := &in.VirtIODrivers, &out.VirtIODrivers
+		*out = new(VirtIODriversConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sysprep != nil {
+		in, out := &in.Sysprep, &out.Sysprep
+		*out = new(SysprepConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SessionRecording != nil {
+		in, out := &in.SessionRecording, &out.SessionRecording
+		*out = new(SessionRecordingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadClasses != nil {
+		in, out := &in.WorkloadClasses, &out.WorkloadClasses
+		*out = make([]WorkloadClass, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(MetricsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PerformanceDegradation != nil {
+		in, out := &in.PerformanceDegradation, &out.PerformanceDegradation
+		*out = new(PerformanceDegradationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubresourceLimits != nil {
+		in, out := &in.SubresourceLimits, &out.SubresourceLimits
+		*out = new(SubresourceLimitsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdmissionPolicy != nil {
+		in, out := &in.AdmissionPolicy, &out.AdmissionPolicy
+		*out = new(AdmissionPolicyConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3059,6 +3698,11 @@ func (in *KubeVirtStatus) DeepCopyInto(out *KubeVirtStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.WorkloadUpdate != nil {
+		in, out := &in.WorkloadUpdate, &out.WorkloadUpdate
+		*out = new(KubeVirtWorkloadUpdateStatus)
+		**out = **in
+	}
 	return
 }
 
@@ -3072,6 +3716,22 @@ func (in *KubeVirtStatus) DeepCopy() *KubeVirtStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtWorkloadUpdateStatus) DeepCopyInto(out *KubeVirtWorkloadUpdateStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtWorkloadUpdateStatus.
+func (in *KubeVirtWorkloadUpdateStatus) DeepCopy() *KubeVirtWorkloadUpdateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtWorkloadUpdateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeVirtWorkloadUpdateStrategy) DeepCopyInto(out *KubeVirtWorkloadUpdateStrategy) {
 	*out = *in
@@ -3090,6 +3750,16 @@ func (in *KubeVirtWorkloadUpdateStrategy) DeepCopyInto(out *KubeVirtWorkloadUpda
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.CanaryNodeSelector != nil {
+		in, out := &in.CanaryNodeSelector, &out.CanaryNodeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3174,14 +3844,42 @@ func (in *LogVerbosity) DeepCopy() *LogVerbosity {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LunTarget) DeepCopyInto(out *LunTarget) {
+func (in *LoggingConfiguration) DeepCopyInto(out *LoggingConfiguration) {
 	*out = *in
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LunTarget.
-func (in *LunTarget) DeepCopy() *LunTarget {
-	if in == nil {
+	if in.Verbosity != nil {
+		in, out := &in.Verbosity, &out.Verbosity
+		*out = new(LogVerbosity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubcomponentVerbosity != nil {
+		in, out := &in.SubcomponentVerbosity, &out.SubcomponentVerbosity
+		*out = make(map[string]uint, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingConfiguration.
+func (in *LoggingConfiguration) DeepCopy() *LoggingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LunTarget) DeepCopyInto(out *LunTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LunTarget.
+func (in *LunTarget) DeepCopy() *LunTarget {
+	if in == nil {
 		return nil
 	}
 	out := new(LunTarget)
@@ -3205,6 +3903,22 @@ func (in *Machine) DeepCopy() *Machine {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediatedDeviceProfile) DeepCopyInto(out *MediatedDeviceProfile) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediatedDeviceProfile.
+func (in *MediatedDeviceProfile) DeepCopy() *MediatedDeviceProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(MediatedDeviceProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MediatedDevicesConfiguration) DeepCopyInto(out *MediatedDevicesConfiguration) {
 	*out = *in
@@ -3260,7 +3974,7 @@ func (in *Memory) DeepCopyInto(out *Memory) {
 	if in.Hugepages != nil {
 		in, out := &in.Hugepages, &out.Hugepages
 		*out = new(Hugepages)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Guest != nil {
 		in, out := &in.Guest, &out.Guest
@@ -3272,6 +3986,16 @@ func (in *Memory) DeepCopyInto(out *Memory) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.Balloon != nil {
+		in, out := &in.Balloon, &out.Balloon
+		*out = new(MemoryBalloon)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FreePageReporting != nil {
+		in, out := &in.FreePageReporting, &out.FreePageReporting
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -3285,6 +4009,53 @@ func (in *Memory) DeepCopy() *Memory {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryBalloon) DeepCopyInto(out *MemoryBalloon) {
+	*out = *in
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TargetFreePercentage != nil {
+		in, out := &in.TargetFreePercentage, &out.TargetFreePercentage
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryBalloon.
+func (in *MemoryBalloon) DeepCopy() *MemoryBalloon {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryBalloon)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryDumpStreamOptions) DeepCopyInto(out *MemoryDumpStreamOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryDumpStreamOptions.
+func (in *MemoryDumpStreamOptions) DeepCopy() *MemoryDumpStreamOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryDumpStreamOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemoryDumpVolumeSource) DeepCopyInto(out *MemoryDumpVolumeSource) {
 	*out = *in
@@ -3320,6 +4091,16 @@ func (in *MemoryStatus) DeepCopyInto(out *MemoryStatus) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.BalloonRequested != nil {
+		in, out := &in.BalloonRequested, &out.BalloonRequested
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.BalloonActual != nil {
+		in, out := &in.BalloonActual, &out.BalloonActual
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
@@ -3333,6 +4114,27 @@ func (in *MemoryStatus) DeepCopy() *MemoryStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsConfiguration) DeepCopyInto(out *MetricsConfiguration) {
+	*out = *in
+	if in.CollectionInterval != nil {
+		in, out := &in.CollectionInterval, &out.CollectionInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsConfiguration.
+func (in *MetricsConfiguration) DeepCopy() *MetricsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrateOptions) DeepCopyInto(out *MigrateOptions) {
 	*out = *in
@@ -3375,6 +4177,11 @@ func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.ParallelInboundMigrationsPerNode != nil {
+		in, out := &in.ParallelInboundMigrationsPerNode, &out.ParallelInboundMigrationsPerNode
+		*out = new(uint32)
+		**out = **in
+	}
 	if in.ParallelMigrationsPerCluster != nil {
 		in, out := &in.ParallelMigrationsPerCluster, &out.ParallelMigrationsPerCluster
 		*out = new(uint32)
@@ -3425,11 +4232,26 @@ func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AdditionalNetworks != nil {
+		in, out := &in.AdditionalNetworks, &out.AdditionalNetworks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.MatchSELinuxLevelOnMigration != nil {
 		in, out := &in.MatchSELinuxLevelOnMigration, &out.MatchSELinuxLevelOnMigration
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ReplugHostDevices != nil {
+		in, out := &in.ReplugHostDevices, &out.ReplugHostDevices
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EvacuationOrder != nil {
+		in, out := &in.EvacuationOrder, &out.EvacuationOrder
+		*out = new(EvacuationOrder)
+		**out = **in
+	}
 	return
 }
 
@@ -3443,6 +4265,114 @@ func (in *MigrationConfiguration) DeepCopy() *MigrationConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationOverrides) DeepCopyInto(out *MigrationOverrides) {
+	*out = *in
+	if in.ParallelMigrationThreads != nil {
+		in, out := &in.ParallelMigrationThreads, &out.ParallelMigrationThreads
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.BandwidthPerMigration != nil {
+		in, out := &in.BandwidthPerMigration, &out.BandwidthPerMigration
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.AllowAutoConverge != nil {
+		in, out := &in.AllowAutoConverge, &out.AllowAutoConverge
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowPostCopy != nil {
+		in, out := &in.AllowPostCopy, &out.AllowPostCopy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(MigrationCompression)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationOverrides.
+func (in *MigrationOverrides) DeepCopy() *MigrationOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationProgress) DeepCopyInto(out *MigrationProgress) {
+	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationProgress.
+func (in *MigrationProgress) DeepCopy() *MigrationProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationRetryPolicy) DeepCopyInto(out *MigrationRetryPolicy) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationRetryPolicy.
+func (in *MigrationRetryPolicy) DeepCopy() *MigrationRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationRetryStatus) DeepCopyInto(out *MigrationRetryStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationRetryStatus.
+func (in *MigrationRetryStatus) DeepCopy() *MigrationRetryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationRetryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultusNetwork) DeepCopyInto(out *MultusNetwork) {
 	*out = *in
@@ -3467,6 +4397,13 @@ func (in *NUMA) DeepCopyInto(out *NUMA) {
 		*out = new(NUMAGuestMappingPassthrough)
 		**out = **in
 	}
+	if in.GuestCells != nil {
+		in, out := &in.GuestCells, &out.GuestCells
+		*out = make([]NUMAGuestCell, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3480,6 +4417,44 @@ func (in *NUMA) DeepCopy() *NUMA {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMAGuestCell) DeepCopyInto(out *NUMAGuestCell) {
+	*out = *in
+	out.Memory = in.Memory.DeepCopy()
+	if in.Distances != nil {
+		in, out := &in.Distances, &out.Distances
+		*out = make([]NUMAGuestCellDistance, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMAGuestCell.
+func (in *NUMAGuestCell) DeepCopy() *NUMAGuestCell {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMAGuestCell)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMAGuestCellDistance) DeepCopyInto(out *NUMAGuestCellDistance) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMAGuestCellDistance.
+func (in *NUMAGuestCellDistance) DeepCopy() *NUMAGuestCellDistance {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMAGuestCellDistance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NUMAGuestMappingPassthrough) DeepCopyInto(out *NUMAGuestMappingPassthrough) {
 	*out = *in
@@ -3608,6 +4583,11 @@ func (in *NodeMediatedDeviceTypesConfig) DeepCopyInto(out *NodeMediatedDeviceTyp
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]MediatedDeviceProfile, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3807,6 +4787,42 @@ func (in *PciHostDevice) DeepCopy() *PciHostDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PerformanceDegradationConfiguration) DeepCopyInto(out *PerformanceDegradationConfiguration) {
+	*out = *in
+	if in.CPUStealPercentage != nil {
+		in, out := &in.CPUStealPercentage, &out.CPUStealPercentage
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.VCPUWaitPercentage != nil {
+		in, out := &in.VCPUWaitPercentage, &out.VCPUWaitPercentage
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.IOLatency != nil {
+		in, out := &in.IOLatency, &out.IOLatency
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MigrationDowntime != nil {
+		in, out := &in.MigrationDowntime, &out.MigrationDowntime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PerformanceDegradationConfiguration.
+func (in *PerformanceDegradationConfiguration) DeepCopy() *PerformanceDegradationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PerformanceDegradationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PermittedHostDevices) DeepCopyInto(out *PermittedHostDevices) {
 	*out = *in
@@ -3934,6 +4950,22 @@ func (in *PodNetwork) DeepCopy() *PodNetwork {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolAutoscalingConfiguration) DeepCopyInto(out *PoolAutoscalingConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolAutoscalingConfiguration.
+func (in *PoolAutoscalingConfiguration) DeepCopy() *PoolAutoscalingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolAutoscalingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Port) DeepCopyInto(out *Port) {
 	*out = *in
@@ -4156,6 +5188,27 @@ func (in *ReloadableComponentConfiguration) DeepCopy() *ReloadableComponentConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoveUSBDeviceOptions) DeepCopyInto(out *RemoveUSBDeviceOptions) {
+	*out = *in
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoveUSBDeviceOptions.
+func (in *RemoveUSBDeviceOptions) DeepCopy() *RemoveUSBDeviceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoveUSBDeviceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoveVolumeOptions) DeepCopyInto(out *RemoveVolumeOptions) {
 	*out = *in
@@ -4177,6 +5230,54 @@ func (in *RemoveVolumeOptions) DeepCopy() *RemoveVolumeOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepinVCPUsOptions) DeepCopyInto(out *RepinVCPUsOptions) {
+	*out = *in
+	if in.Pinning != nil {
+		in, out := &in.Pinning, &out.Pinning
+		*out = make([]VCPUPin, len(*in))
+		copy(*out, *in)
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepinVCPUsOptions.
+func (in *RepinVCPUsOptions) DeepCopy() *RepinVCPUsOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RepinVCPUsOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RescueOptions) DeepCopyInto(out *RescueOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RescueOptions.
+func (in *RescueOptions) DeepCopy() *RescueOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RescueOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceRequirements) DeepCopyInto(out *ResourceRequirements) {
 	*out = *in
@@ -4264,6 +5365,50 @@ func (in *RestartOptions) DeepCopy() *RestartOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreOptions) DeepCopyInto(out *RestoreOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreOptions.
+func (in *RestoreOptions) DeepCopy() *RestoreOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResumeOptions) DeepCopyInto(out *ResumeOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResumeOptions.
+func (in *ResumeOptions) DeepCopy() *ResumeOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ResumeOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Rng) DeepCopyInto(out *Rng) {
 	*out = *in
@@ -4585,6 +5730,53 @@ func (in *ServiceAccountVolumeSource) DeepCopy() *ServiceAccountVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SessionRecordingConfiguration) DeepCopyInto(out *SessionRecordingConfiguration) {
+	*out = *in
+	if in.Sessions != nil {
+		in, out := &in.Sessions, &out.Sessions
+		*out = make([]SessionRecordingType, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionRecordingConfiguration.
+func (in *SessionRecordingConfiguration) DeepCopy() *SessionRecordingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SessionRecordingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetIOLimitsOptions) DeepCopyInto(out *SetIOLimitsOptions) {
+	*out = *in
+	if in.IOLimits != nil {
+		in, out := &in.IOLimits, &out.IOLimits
+		*out = new(DiskIOLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetIOLimitsOptions.
+func (in *SetIOLimitsOptions) DeepCopy() *SetIOLimitsOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SetIOLimitsOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SoundDevice) DeepCopyInto(out *SoundDevice) {
 	*out = *in
@@ -4676,6 +5868,65 @@ func (in *StorageMigratedVolumeInfo) DeepCopy() *StorageMigratedVolumeInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubresourceLimit) DeepCopyInto(out *SubresourceLimit) {
+	*out = *in
+	if in.MaxConnectionsPerUser != nil {
+		in, out := &in.MaxConnectionsPerUser, &out.MaxConnectionsPerUser
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxConnectionsPerNamespace != nil {
+		in, out := &in.MaxConnectionsPerNamespace, &out.MaxConnectionsPerNamespace
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ConnectionsPerMinutePerUser != nil {
+		in, out := &in.ConnectionsPerMinutePerUser, &out.ConnectionsPerMinutePerUser
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubresourceLimit.
+func (in *SubresourceLimit) DeepCopy() *SubresourceLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(SubresourceLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubresourceLimitsConfiguration) DeepCopyInto(out *SubresourceLimitsConfiguration) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make([]SubresourceLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExemptGroups != nil {
+		in, out := &in.ExemptGroups, &out.ExemptGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubresourceLimitsConfiguration.
+func (in *SubresourceLimitsConfiguration) DeepCopy() *SubresourceLimitsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SubresourceLimitsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SupportContainerResources) DeepCopyInto(out *SupportContainerResources) {
 	*out = *in
@@ -4719,6 +5970,27 @@ func (in *SyNICTimer) DeepCopy() *SyNICTimer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SysprepConfiguration) DeepCopyInto(out *SysprepConfiguration) {
+	*out = *in
+	if in.TemplateNamespaces != nil {
+		in, out := &in.TemplateNamespaces, &out.TemplateNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SysprepConfiguration.
+func (in *SysprepConfiguration) DeepCopy() *SysprepConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SysprepConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SysprepSource) DeepCopyInto(out *SysprepSource) {
 	*out = *in
@@ -4732,6 +6004,11 @@ func (in *SysprepSource) DeepCopyInto(out *SysprepSource) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(SysprepTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4745,6 +6022,27 @@ func (in *SysprepSource) DeepCopy() *SysprepSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SysprepTemplate) DeepCopyInto(out *SysprepTemplate) {
+	*out = *in
+	if in.ParametersSecretRef != nil {
+		in, out := &in.ParametersSecretRef, &out.ParametersSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SysprepTemplate.
+func (in *SysprepTemplate) DeepCopy() *SysprepTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(SysprepTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSConfiguration) DeepCopyInto(out *TLSConfiguration) {
 	*out = *in
@@ -4929,6 +6227,28 @@ func (in *UnpauseOptions) DeepCopy() *UnpauseOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnrescueOptions) DeepCopyInto(out *UnrescueOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnrescueOptions.
+func (in *UnrescueOptions) DeepCopy() *UnrescueOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(UnrescueOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserPasswordAccessCredential) DeepCopyInto(out *UserPasswordAccessCredential) {
 	*out = *in
@@ -4989,6 +6309,48 @@ func (in *UserPasswordAccessCredentialSource) DeepCopy() *UserPasswordAccessCred
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VCPUPin) DeepCopyInto(out *VCPUPin) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VCPUPin.
+func (in *VCPUPin) DeepCopy() *VCPUPin {
+	if in == nil {
+		return nil
+	}
+	out := new(VCPUPin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VCPUPinningStatus) DeepCopyInto(out *VCPUPinningStatus) {
+	*out = *in
+	if in.VCPUs != nil {
+		in, out := &in.VCPUs, &out.VCPUs
+		*out = make([]VCPUPin, len(*in))
+		copy(*out, *in)
+	}
+	if in.Requested != nil {
+		in, out := &in.Requested, &out.Requested
+		*out = make([]VCPUPin, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VCPUPinningStatus.
+func (in *VCPUPinningStatus) DeepCopy() *VCPUPinningStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VCPUPinningStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VGPUDisplayOptions) DeepCopyInto(out *VGPUDisplayOptions) {
 	*out = *in
@@ -5073,6 +6435,22 @@ func (in *VSOCKOptions) DeepCopy() *VSOCKOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VhostUserBlkVolumeSource) DeepCopyInto(out *VhostUserBlkVolumeSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VhostUserBlkVolumeSource.
+func (in *VhostUserBlkVolumeSource) DeepCopy() *VhostUserBlkVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VhostUserBlkVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VideoDevice) DeepCopyInto(out *VideoDevice) {
 	*out = *in
@@ -5089,6 +6467,27 @@ func (in *VideoDevice) DeepCopy() *VideoDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtIODriversConfiguration) DeepCopyInto(out *VirtIODriversConfiguration) {
+	*out = *in
+	if in.Autoattach != nil {
+		in, out := &in.Autoattach, &out.Autoattach
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtIODriversConfiguration.
+func (in *VirtIODriversConfiguration) DeepCopy() *VirtIODriversConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtIODriversConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachine) DeepCopyInto(out *VirtualMachine) {
 	*out = *in
@@ -5099,38 +6498,78 @@ func (in *VirtualMachine) DeepCopyInto(out *VirtualMachine) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachine.
-func (in *VirtualMachine) DeepCopy() *VirtualMachine {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachine.
+func (in *VirtualMachine) DeepCopy() *VirtualMachine {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachine) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCondition) DeepCopyInto(out *VirtualMachineCondition) {
+	*out = *in
+	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCondition.
+func (in *VirtualMachineCondition) DeepCopy() *VirtualMachineCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineFailover) DeepCopyInto(out *VirtualMachineFailover) {
+	*out = *in
+	in.NodeLostTime.DeepCopyInto(&out.NodeLostTime)
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineFailover.
+func (in *VirtualMachineFailover) DeepCopy() *VirtualMachineFailover {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachine)
+	out := new(VirtualMachineFailover)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VirtualMachine) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineCondition) DeepCopyInto(out *VirtualMachineCondition) {
+func (in *VirtualMachineHibernation) DeepCopyInto(out *VirtualMachineHibernation) {
 	*out = *in
-	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
 	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCondition.
-func (in *VirtualMachineCondition) DeepCopy() *VirtualMachineCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineHibernation.
+func (in *VirtualMachineHibernation) DeepCopy() *VirtualMachineHibernation {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineCondition)
+	out := new(VirtualMachineHibernation)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -5163,6 +6602,22 @@ func (in *VirtualMachineInstance) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceCPUStats) DeepCopyInto(out *VirtualMachineInstanceCPUStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceCPUStats.
+func (in *VirtualMachineInstanceCPUStats) DeepCopy() *VirtualMachineInstanceCPUStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceCPUStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceCommonMigrationState) DeepCopyInto(out *VirtualMachineInstanceCommonMigrationState) {
 	*out = *in
@@ -5352,6 +6807,22 @@ func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestDriver) DeepCopyInto(out *VirtualMachineInstanceGuestDriver) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestDriver.
+func (in *VirtualMachineInstanceGuestDriver) DeepCopy() *VirtualMachineInstanceGuestDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceGuestOSInfo) DeepCopyInto(out *VirtualMachineInstanceGuestOSInfo) {
 	*out = *in
@@ -5448,6 +6919,22 @@ func (in *VirtualMachineInstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMemoryStats) DeepCopyInto(out *VirtualMachineInstanceMemoryStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMemoryStats.
+func (in *VirtualMachineInstanceMemoryStats) DeepCopy() *VirtualMachineInstanceMemoryStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMemoryStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigration) DeepCopyInto(out *VirtualMachineInstanceMigration) {
 	*out = *in
@@ -5604,6 +7091,21 @@ func (in *VirtualMachineInstanceMigrationSpec) DeepCopyInto(out *VirtualMachineI
 		*out = new(VirtualMachineInstanceMigrationTarget)
 		**out = **in
 	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(MigrationOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(MigrationRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Warm != nil {
+		in, out := &in.Warm, &out.Warm
+		*out = new(WarmMigration)
+		**out = **in
+	}
 	return
 }
 
@@ -5649,6 +7151,11 @@ func (in *VirtualMachineInstanceMigrationState) DeepCopyInto(out *VirtualMachine
 		*out = new(MigrationConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MigrationOverrides != nil {
+		in, out := &in.MigrationOverrides, &out.MigrationOverrides
+		*out = new(MigrationOverrides)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TargetCPUSet != nil {
 		in, out := &in.TargetCPUSet, &out.TargetCPUSet
 		*out = make([]int, len(*in))
@@ -5664,6 +7171,15 @@ func (in *VirtualMachineInstanceMigrationState) DeepCopyInto(out *VirtualMachine
 		*out = new(VirtualMachineInstanceMigrationTargetState)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(MigrationProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CutoverTimestamp != nil {
+		in, out := &in.CutoverTimestamp, &out.CutoverTimestamp
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -5704,6 +7220,11 @@ func (in *VirtualMachineInstanceMigrationStatus) DeepCopyInto(out *VirtualMachin
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(MigrationRetryStatus)
+		**out = **in
+	}
 	return
 }
 
@@ -5802,6 +7323,22 @@ func (in *VirtualMachineInstanceNetworkInterface) DeepCopy() *VirtualMachineInst
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceNetworkStats) DeepCopyInto(out *VirtualMachineInstanceNetworkStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceNetworkStats.
+func (in *VirtualMachineInstanceNetworkStats) DeepCopy() *VirtualMachineInstanceNetworkStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceNetworkStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstancePhaseTransitionTimestamp) DeepCopyInto(out *VirtualMachineInstancePhaseTransitionTimestamp) {
 	*out = *in
@@ -6143,6 +7680,23 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]Hook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IdlePolicy != nil {
+		in, out := &in.IdlePolicy, &out.IdlePolicy
+		*out = new(IdlePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CrashDump != nil {
+		in, out := &in.CrashDump, &out.CrashDump
+		*out = new(CrashDump)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6156,6 +7710,44 @@ func (in *VirtualMachineInstanceSpec) DeepCopy() *VirtualMachineInstanceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceStats) DeepCopyInto(out *VirtualMachineInstanceStats) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	out.CPU = in.CPU
+	out.Memory = in.Memory
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = make([]VirtualMachineInstanceStorageStats, len(*in))
+		copy(*out, *in)
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = make([]VirtualMachineInstanceNetworkStats, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceStats.
+func (in *VirtualMachineInstanceStats) DeepCopy() *VirtualMachineInstanceStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceStats) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstanceStatus) {
 	*out = *in
@@ -6247,6 +7839,16 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		*out = new(DeviceStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VCPUPinning != nil {
+		in, out := &in.VCPUPinning, &out.VCPUPinning
+		*out = new(VCPUPinningStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestDrivers != nil {
+		in, out := &in.GuestDrivers, &out.GuestDrivers
+		*out = make([]VirtualMachineInstanceGuestDriver, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -6260,6 +7862,22 @@ func (in *VirtualMachineInstanceStatus) DeepCopy() *VirtualMachineInstanceStatus
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceStorageStats) DeepCopyInto(out *VirtualMachineInstanceStorageStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceStorageStats.
+func (in *VirtualMachineInstanceStorageStats) DeepCopy() *VirtualMachineInstanceStorageStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceStorageStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceTemplateSpec) DeepCopyInto(out *VirtualMachineInstanceTemplateSpec) {
 	*out = *in
@@ -6366,6 +7984,84 @@ func (in *VirtualMachineOptions) DeepCopy() *VirtualMachineOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePowerSchedule) DeepCopyInto(out *VirtualMachinePowerSchedule) {
+	*out = *in
+	if in.Exceptions != nil {
+		in, out := &in.Exceptions, &out.Exceptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePowerSchedule.
+func (in *VirtualMachinePowerSchedule) DeepCopy() *VirtualMachinePowerSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePowerSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePowerScheduleStatus) DeepCopyInto(out *VirtualMachinePowerScheduleStatus) {
+	*out = *in
+	if in.LastActionTime != nil {
+		in, out := &in.LastActionTime, &out.LastActionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextActionTime != nil {
+		in, out := &in.NextActionTime, &out.NextActionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePowerScheduleStatus.
+func (in *VirtualMachinePowerScheduleStatus) DeepCopy() *VirtualMachinePowerScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePowerScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePreemption) DeepCopyInto(out *VirtualMachinePreemption) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePreemption.
+func (in *VirtualMachinePreemption) DeepCopy() *VirtualMachinePreemption {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePreemption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineRescue) DeepCopyInto(out *VirtualMachineRescue) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineRescue.
+func (in *VirtualMachineRescue) DeepCopy() *VirtualMachineRescue {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineRescue)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 	*out = *in
@@ -6406,6 +8102,21 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 		*out = new(UpdateVolumesStrategy)
 		**out = **in
 	}
+	if in.ChangeApplyStrategy != nil {
+		in, out := &in.ChangeApplyStrategy, &out.ChangeApplyStrategy
+		*out = new(ChangeApplyStrategy)
+		**out = **in
+	}
+	if in.PowerSchedule != nil {
+		in, out := &in.PowerSchedule, &out.PowerSchedule
+		*out = new(VirtualMachinePowerSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HighAvailability != nil {
+		in, out := &in.HighAvailability, &out.HighAvailability
+		*out = new(HighAvailabilityPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6419,6 +8130,27 @@ func (in *VirtualMachineSpec) DeepCopy() *VirtualMachineSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineStagedChanges) DeepCopyInto(out *VirtualMachineStagedChanges) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStagedChanges.
+func (in *VirtualMachineStagedChanges) DeepCopy() *VirtualMachineStagedChanges {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineStagedChanges)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineStartFailure) DeepCopyInto(out *VirtualMachineStartFailure) {
 	*out = *in
@@ -6531,6 +8263,38 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 		*out = new(InstancetypeStatusRef)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StagedChanges != nil {
+		in, out := &in.StagedChanges, &out.StagedChanges
+		*out = new(VirtualMachineStagedChanges)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rescue != nil {
+		in, out := &in.Rescue, &out.Rescue
+		*out = new(VirtualMachineRescue)
+		**out = **in
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(VirtualMachineHibernation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preemption != nil {
+		in, out := &in.Preemption, &out.Preemption
+		*out = new(VirtualMachinePreemption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PowerSchedule != nil {
+		in, out := &in.PowerSchedule, &out.PowerSchedule
+		*out = new(VirtualMachinePowerScheduleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Failovers != nil {
+		in, out := &in.Failovers, &out.Failovers
+		*out = make([]VirtualMachineFailover, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -6654,6 +8418,11 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 		*out = new(SysprepSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Ignition != nil {
+		in, out := &in.Ignition, &out.Ignition
+		*out = new(IgnitionSource)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ContainerDisk != nil {
 		in, out := &in.ContainerDisk, &out.ContainerDisk
 		*out = new(ContainerDiskSource)
@@ -6704,6 +8473,11 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 		*out = new(MemoryDumpVolumeSource)
 		**out = **in
 	}
+	if in.VhostUserBlk != nil {
+		in, out := &in.VhostUserBlk, &out.VhostUserBlk
+		*out = new(VhostUserBlkVolumeSource)
+		**out = **in
+	}
 	return
 }
 
@@ -6774,6 +8548,22 @@ func (in *VolumeUpdateState) DeepCopy() *VolumeUpdateState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarmMigration) DeepCopyInto(out *WarmMigration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarmMigration.
+func (in *WarmMigration) DeepCopy() *WarmMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(WarmMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Watchdog) DeepCopyInto(out *Watchdog) {
 	*out = *in
@@ -6816,3 +8606,19 @@ func (in *WatchdogDevice) DeepCopy() *WatchdogDevice {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadClass) DeepCopyInto(out *WorkloadClass) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadClass.
+func (in *WorkloadClass) DeepCopy() *WorkloadClass {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadClass)
+	in.DeepCopyInto(out)
+	return out
+}
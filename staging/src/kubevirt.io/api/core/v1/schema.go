@@ -75,6 +75,19 @@ type HostDisk struct {
 	Shared *bool `json:"shared,omitempty"`
 }
 
+// VhostUserBlkVolumeSource represents the vhost-user-blk socket of a storage target on the node.
+// Exactly one of SocketName and ResourceName must be set.
+type VhostUserBlkVolumeSource struct {
+	// SocketName is the name of the socket in the vhost-user-blk socket directory of the node, /var/run/vhost-user-blk.
+	// +optional
+	SocketName string `json:"socketName,omitempty"`
+	// ResourceName is the name of the device plugin resource providing the socket.
+	// The device plugin passes the path of the allocated socket to the pod through the
+	// VHOST_USER_BLK_RESOURCE_<resource name> environment variable.
+	// +optional
+	ResourceName string `json:"resourceName,omitempty"`
+}
+
 // ConfigMapVolumeSource adapts a ConfigMap into a volume.
 // More info: https://kubernetes.io/docs/concepts/storage/volumes/#configmap
 type ConfigMapVolumeSource struct {
@@ -136,6 +149,23 @@ type SysprepSource struct {
 	// ConfigMap references a ConfigMap that contains Sysprep answer file named autounattend.xml that should be attached as disk of CDROM type.
 	// + optional
 	ConfigMap *v1.LocalObjectReference `json:"configMap,omitempty"`
+	// Namespace of the referenced ConfigMap. Defaults to the namespace of the VirtualMachineInstance.
+	// Other namespaces must be listed in the sysprep templateNamespaces of the KubeVirt configuration.
+	// + optional
+	Namespace string `json:"namespace,omitempty"`
+	// Template enables rendering the answer files as Go templates, with the values of the VirtualMachineInstance.
+	// + optional
+	Template *SysprepTemplate `json:"template,omitempty"`
+}
+
+// SysprepTemplate configures the rendering of Sysprep answer file templates.
+// The templates can reference .Name, .Namespace and .Hostname of the VirtualMachineInstance,
+// and the .Parameters read from ParametersSecretRef.
+type SysprepTemplate struct {
+	// ParametersSecretRef references a k8s Secret in the namespace of the VirtualMachineInstance,
+	// whose keys are exposed to the templates as .Parameters, e.g. a license key.
+	// + optional
+	ParametersSecretRef *v1.LocalObjectReference `json:"parametersSecretRef,omitempty"`
 }
 
 // Represents a cloud-init nocloud user data source.
@@ -159,6 +189,12 @@ type CloudInitNoCloudSource struct {
 	// NetworkData contains NoCloud inline cloud-init networkdata.
 	// + optional
 	NetworkData string `json:"networkData,omitempty"`
+	// UserDataSources references additional Secrets and ConfigMaps containing NoCloud userdata.
+	// Their userdata is merged, in the listed order, after the userdata of this source into a single
+	// multi-part MIME userdata.
+	// +optional
+	// +listType=atomic
+	UserDataSources []CloudInitUserDataSource `json:"userDataSources,omitempty"`
 }
 
 // Represents a cloud-init config drive user data source.
@@ -182,6 +218,36 @@ type CloudInitConfigDriveSource struct {
 	// NetworkData contains config drive inline cloud-init networkdata.
 	// + optional
 	NetworkData string `json:"networkData,omitempty"`
+	// UserDataSources references additional Secrets and ConfigMaps containing config drive userdata.
+	// Their userdata is merged, in the listed order, after the userdata of this source into a single
+	// multi-part MIME userdata.
+	// +optional
+	// +listType=atomic
+	UserDataSources []CloudInitUserDataSource `json:"userDataSources,omitempty"`
+}
+
+// CloudInitUserDataSource references a Secret or ConfigMap containing cloud-init userdata
+// in its userdata or userData key. Only one of its members may be specified.
+type CloudInitUserDataSource struct {
+	// SecretRef references a k8s secret that contains userdata.
+	// +optional
+	SecretRef *v1.LocalObjectReference `json:"secretRef,omitempty"`
+	// ConfigMapRef references a k8s configmap that contains userdata.
+	// +optional
+	ConfigMapRef *v1.LocalObjectReference `json:"configMapRef,omitempty"`
+}
+
+// IgnitionSource represents an Ignition config for CoreOS-style guests.
+// The config is passed to the guest through the QEMU firmware configuration device.
+// Only one of its members may be specified.
+// More info: https://coreos.github.io/ignition/
+type IgnitionSource struct {
+	// Data contains the inline Ignition config.
+	// +optional
+	Data string `json:"data,omitempty"`
+	// SecretRef references a k8s secret that contains the Ignition config in its userdata or userData key.
+	// +optional
+	SecretRef *v1.LocalObjectReference `json:"secretRef,omitempty"`
 }
 
 type DomainSpec struct {
@@ -371,6 +437,33 @@ type NUMA struct {
 	// The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
 	// +optional
 	GuestMappingPassthrough *NUMAGuestMappingPassthrough `json:"guestMappingPassthrough,omitempty"`
+	// GuestCells defines an explicit guest NUMA topology. Each vCPU has to be assigned to exactly one cell,
+	// and the memory of the cells has to add up to the guest memory.
+	// Can't be combined with GuestMappingPassthrough.
+	// +optional
+	GuestCells []NUMAGuestCell `json:"guestCells,omitempty"`
+}
+
+// NUMAGuestCell defines a NUMA cell of the guest.
+type NUMAGuestCell struct {
+	// ID of the cell. The IDs of the cells have to range from 0 to the number of cells minus one.
+	ID uint32 `json:"id"`
+	// CPUs is the list of the vCPUs of the cell, e.g. "0-3,8".
+	CPUs string `json:"cpus"`
+	// Memory is the amount of guest memory of the cell.
+	Memory resource.Quantity `json:"memory"`
+	// Distances lists the distances from this cell to the other cells.
+	// The distances which are not listed are picked by the hypervisor.
+	// +optional
+	Distances []NUMAGuestCellDistance `json:"distances,omitempty"`
+}
+
+// NUMAGuestCellDistance defines the distance from a NUMA cell of the guest to another one.
+type NUMAGuestCellDistance struct {
+	// CellID is the ID of the other cell.
+	CellID uint32 `json:"cellID"`
+	// Value is the distance, between 10 and 255. 10 is the distance of a cell to itself.
+	Value uint32 `json:"value"`
 }
 
 // CPUFeature allows specifying a CPU feature.
@@ -401,6 +494,39 @@ type Memory struct {
 	// MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.
 	// The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
 	MaxGuest *resource.Quantity `json:"maxGuest,omitempty"`
+	// Balloon lets virt-handler reclaim unused guest memory through the memory balloon.
+	// Fields which are set override the cluster wide memoryBalloon configuration.
+	// +optional
+	Balloon *MemoryBalloon `json:"balloon,omitempty"`
+	// FreePageReporting lets the guest report its free memory pages to the host so that they can be reclaimed.
+	// Defaults to the cluster wide setting. It is never enabled for VMIs requesting dedicated CPUs, hugepages or realtime.
+	// +optional
+	FreePageReporting *bool `json:"freePageReporting,omitempty"`
+	// KSMMergePolicy sets how aggressively the kernel samepage merging of the node merges the guest memory.
+	// Defaults to the mergePolicy of the cluster wide KSM configuration.
+	// +optional
+	KSMMergePolicy KSMMergePolicy `json:"ksmMergePolicy,omitempty"`
+	// Overcommit is the memory overcommit class of the VirtualMachineInstance. It controls how much memory
+	// the pod requests, whether the guest memory can be swapped out and how KSM merges it.
+	// +optional
+	Overcommit MemoryOvercommitClass `json:"overcommit,omitempty"`
+}
+
+// MemoryBalloon configures how the memory balloon of a guest is inflated and deflated
+// based on the memory usage reported by the guest.
+type MemoryBalloon struct {
+	// Min is the least amount of memory the balloon leaves to the guest.
+	// Defaults to half of the guest memory.
+	// +optional
+	Min *resource.Quantity `json:"min,omitempty"`
+	// Max is the largest amount of memory the balloon gives to the guest.
+	// Defaults to the guest memory and can not exceed it.
+	// +optional
+	Max *resource.Quantity `json:"max,omitempty"`
+	// TargetFreePercentage is the share of the memory given to the guest which should remain
+	// available to it. Defaults to 20.
+	// +optional
+	TargetFreePercentage *uint32 `json:"targetFreePercentage,omitempty"`
 }
 
 type MemoryStatus struct {
@@ -413,12 +539,23 @@ type MemoryStatus struct {
 	// GuestRequested specifies how much memory was requested (hotplug) for the VirtualMachine.
 	// +optional
 	GuestRequested *resource.Quantity `json:"guestRequested,omitempty"`
+	// BalloonRequested specifies how much memory the memory balloon policy requested for the VirtualMachine.
+	// +optional
+	BalloonRequested *resource.Quantity `json:"balloonRequested,omitempty"`
+	// BalloonActual specifies how much memory the memory balloon currently gives to the VirtualMachine.
+	// +optional
+	BalloonActual *resource.Quantity `json:"balloonActual,omitempty"`
 }
 
 // Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.
 type Hugepages struct {
 	// PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
 	PageSize string `json:"pageSize,omitempty"`
+	// RegularMemory is the amount of the guest memory which is backed by regular pages instead of hugepages.
+	// The rest of the guest memory, including the memory hotplugged at runtime, is backed by hugepages.
+	// It must be a multiple of the page size and less than the guest memory.
+	// +optional
+	RegularMemory *resource.Quantity `json:"regularMemory,omitempty"`
 }
 
 type Machine struct {
@@ -495,6 +632,10 @@ type Devices struct {
 	// Whether to attach the VSOCK CID to the VM or not.
 	// VSOCK access will be available if set to true. Defaults to false.
 	AutoattachVSOCK *bool `json:"autoattachVSOCK,omitempty"`
+	// Whether to attach the VirtIO driver ISO as a CD-ROM, to install the drivers in Windows guests.
+	// Requires the VirtIO drivers autoattach to be enabled in the cluster config. Defaults to false.
+	// +optional
+	AutoattachVirtIODrivers *bool `json:"autoattachVirtIODrivers,omitempty"`
 	// Whether to have random number generator from host
 	// +optional
 	Rng *Rng `json:"rng,omitempty"`
@@ -527,6 +668,13 @@ type Devices struct {
 	// To configure and access client devices such as redirecting USB
 	// +optional
 	ClientPassthrough *ClientPassthroughDevices `json:"clientPassthrough,omitempty"`
+	// ConsoleSharing configures how many clients can be connected to the serial console and to VNC at the same time.
+	// Defaults to a single exclusive connection, which is disconnected by a new connection.
+	// +optional
+	ConsoleSharing *ConsoleSharing `json:"consoleSharing,omitempty"`
+	// Graphics defines the protocol of the auto-attached graphics device.
+	// +optional
+	Graphics *Graphics `json:"graphics,omitempty"`
 	// Whether to emulate a sound device.
 	// +optional
 	Sound *SoundDevice `json:"sound,omitempty"`
@@ -553,6 +701,50 @@ const (
 	UsbClientPassthroughMaxNumberOf = 4
 )
 
+// ConsoleSharingPolicy defines how the connections to the serial console and to VNC are shared.
+type ConsoleSharingPolicy string
+
+const (
+	// ConsoleSharingExclusive allows a single connection, a new connection disconnects the current one.
+	ConsoleSharingExclusive ConsoleSharingPolicy = "Exclusive"
+	// ConsoleSharingReadOnly gives the first connection full access and connects the following ones as read-only observers.
+	ConsoleSharingReadOnly ConsoleSharingPolicy = "SharedReadOnly"
+	// ConsoleSharingFull gives all the connections full access.
+	ConsoleSharingFull ConsoleSharingPolicy = "SharedFull"
+)
+
+// DefaultConsoleMaxConnections is the default limit of concurrent connections of the shared policies.
+const DefaultConsoleMaxConnections = 4
+
+type ConsoleSharing struct {
+	// Policy defines how the connections are shared, Exclusive, SharedReadOnly or SharedFull. Defaults to Exclusive.
+	// +optional
+	// +kubebuilder:validation:Enum=Exclusive;SharedReadOnly;SharedFull
+	Policy ConsoleSharingPolicy `json:"policy,omitempty"`
+	// MaxConnections limits the number of concurrent connections of the shared policies to the serial console
+	// and to VNC each, further connections are refused. Defaults to 4.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16
+	MaxConnections *uint32 `json:"maxConnections,omitempty"`
+}
+
+// GraphicsType is the remote display protocol of the graphics device.
+type GraphicsType string
+
+const (
+	GraphicsTypeVNC   GraphicsType = "VNC"
+	GraphicsTypeSPICE GraphicsType = "SPICE"
+)
+
+type Graphics struct {
+	// Type of the graphics device, VNC or SPICE. SPICE additionally allows the client to play the audio
+	// of the sound device and to redirect USB devices when clientPassthrough is set. Defaults to VNC.
+	// +optional
+	// +kubebuilder:validation:Enum=VNC;SPICE
+	Type GraphicsType `json:"type,omitempty"`
+}
+
 // Represents the user's configuration to emulate sound cards in the VMI.
 type SoundDevice struct {
 	// User's defined name for this sound device
@@ -612,7 +804,28 @@ type Filesystem struct {
 	Virtiofs *FilesystemVirtiofs `json:"virtiofs"`
 }
 
-type FilesystemVirtiofs struct{}
+type FilesystemVirtiofs struct {
+	// Cache specifies the caching mode of virtiofsd.
+	// Supported values are:
+	// auto: metadata and paths are cached with a timeout, data is cached until the file is closed.
+	// always: metadata, data and paths are cached indefinitely.
+	// never: nothing is cached, changes on the host are seen immediately by the guest.
+	// Defaults to auto.
+	// +optional
+	Cache VirtiofsCacheMode `json:"cache,omitempty"`
+	// ThreadPoolSize is the maximum number of worker threads virtiofsd uses
+	// to serve requests. Defaults to the virtiofsd default.
+	// +optional
+	ThreadPoolSize *uint32 `json:"threadPoolSize,omitempty"`
+}
+
+type VirtiofsCacheMode string
+
+const (
+	VirtiofsCacheAuto   VirtiofsCacheMode = "auto"
+	VirtiofsCacheAlways VirtiofsCacheMode = "always"
+	VirtiofsCacheNever  VirtiofsCacheMode = "never"
+)
 
 type DownwardMetrics struct{}
 
@@ -621,6 +834,11 @@ type GPU struct {
 	Name string `json:"name"`
 	// DeviceName is the name of the device provisioned by device-plugins
 	DeviceName string `json:"deviceName,omitempty"`
+	// Profile is the name of a mediated device profile of the KubeVirt CR.
+	// It is resolved on each node to the mediated device type the profile maps to.
+	// Can't be set together with a different deviceName.
+	// +optional
+	Profile string `json:"profile,omitempty"`
 	// ClaimRequest provides the ClaimName from vmi.spec.resourceClaims[].name and
 	// requestName from resourceClaim.spec.devices.requests[].name
 	// This field should only be configured if one of the feature-gates GPUsWithDRA or HostDevicesWithDRA is enabled.
@@ -710,7 +928,7 @@ type Disk struct {
 	// +optional
 	Cache DriverCache `json:"cache,omitempty"`
 	// IO specifies which QEMU disk IO mode should be used.
-	// Supported values are: native, default, threads.
+	// Supported values are: native, default, threads, io_uring.
 	// +optional
 	IO DriverIO `json:"io,omitempty"`
 	// If specified, disk address and its tag will be provided to the guest via config drive metadata
@@ -725,6 +943,50 @@ type Disk struct {
 	// If specified, it can change the default error policy (stop) for the disk
 	// +optional
 	ErrorPolicy *DiskErrorPolicy `json:"errorPolicy,omitempty"`
+	// IOLimits throttles the IO operations and bandwidth of the disk.
+	// They can be updated on a running VMI through the iolimits subresource.
+	// +optional
+	IOLimits *DiskIOLimits `json:"ioLimits,omitempty"`
+}
+
+// DiskIOLimits represents the IOPS and bandwidth limits of a disk.
+type DiskIOLimits struct {
+	// ReadIOPS limits the read operations per second.
+	// +optional
+	ReadIOPS *int64 `json:"readIOPS,omitempty"`
+	// WriteIOPS limits the write operations per second.
+	// +optional
+	WriteIOPS *int64 `json:"writeIOPS,omitempty"`
+	// ReadBandwidth limits the bytes read per second, e.g. 100Mi.
+	// +optional
+	ReadBandwidth *resource.Quantity `json:"readBandwidth,omitempty"`
+	// WriteBandwidth limits the bytes written per second, e.g. 100Mi.
+	// +optional
+	WriteBandwidth *resource.Quantity `json:"writeBandwidth,omitempty"`
+	// Burst allows the disk to exceed its limits for a short time.
+	// +optional
+	Burst *DiskIOBurst `json:"burst,omitempty"`
+}
+
+// DiskIOBurst represents the limits a disk may burst to. Each burst limit
+// requires the matching limit of DiskIOLimits and must be greater than it.
+type DiskIOBurst struct {
+	// ReadIOPS is the read operations per second the disk may burst to.
+	// +optional
+	ReadIOPS *int64 `json:"readIOPS,omitempty"`
+	// WriteIOPS is the write operations per second the disk may burst to.
+	// +optional
+	WriteIOPS *int64 `json:"writeIOPS,omitempty"`
+	// ReadBandwidth is the bytes read per second the disk may burst to.
+	// +optional
+	ReadBandwidth *resource.Quantity `json:"readBandwidth,omitempty"`
+	// WriteBandwidth is the bytes written per second the disk may burst to.
+	// +optional
+	WriteBandwidth *resource.Quantity `json:"writeBandwidth,omitempty"`
+	// LengthSeconds is for how long the disk may burst.
+	// Defaults to 1.
+	// +optional
+	LengthSeconds *int64 `json:"lengthSeconds,omitempty"`
 }
 
 // CustomBlockSize represents the desired logical and physical block size for a VM disk.
@@ -758,11 +1020,12 @@ const (
 	DiskBusSATA   DiskBus = "sata"
 	DiskBusVirtio DiskBus = VirtIO
 	DiskBusUSB    DiskBus = "usb"
+	DiskBusNVMe   DiskBus = "nvme"
 )
 
 type DiskTarget struct {
 	// Bus indicates the type of disk device to emulate.
-	// supported values: virtio, sata, scsi, usb.
+	// supported values: virtio, sata, scsi, usb, nvme.
 	Bus DiskBus `json:"bus,omitempty"`
 	// ReadOnly.
 	// Defaults to false.
@@ -870,6 +1133,12 @@ type VolumeSource struct {
 	// Represents a Sysprep volume source.
 	// +optional
 	Sysprep *SysprepSource `json:"sysprep,omitempty"`
+	// Ignition represents an Ignition config for CoreOS-style guests.
+	// The config is passed to the guest through the QEMU firmware configuration device, no disk is needed for this volume.
+	// There can only be one volume of this type!
+	// More info: https://coreos.github.io/ignition/
+	// +optional
+	Ignition *IgnitionSource `json:"ignition,omitempty"`
 	// ContainerDisk references a docker image, embedding a qcow or raw disk.
 	// More info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html
 	// +optional
@@ -906,6 +1175,10 @@ type VolumeSource struct {
 	DownwardMetrics *DownwardMetricsVolumeSource `json:"downwardMetrics,omitempty"`
 	// MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi
 	MemoryDump *MemoryDumpVolumeSource `json:"memoryDump,omitempty"`
+	// VhostUserBlk connects the disk to a vhost-user-blk socket exposed on the node by a storage target like SPDK,
+	// bypassing the block layer of the host kernel.
+	// +optional
+	VhostUserBlk *VhostUserBlkVolumeSource `json:"vhostUserBlk,omitempty"`
 }
 
 // HotplugVolumeSource Represents the source of a volume to mount which are capable
@@ -946,8 +1219,44 @@ type MemoryDumpVolumeSource struct {
 	// Directly attached to the virt launcher
 	// +optional
 	PersistentVolumeClaimVolumeSource `json:",inline"`
+	// Format is the format the memory is dumped in. When the volume is not
+	// hotpluggable and holds a memory state, the VMI is resumed from it on start.
+	// Defaults to Raw
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
+	// Content is the part of the guest memory which is dumped, Full or Kernel.
+	// Defaults to Full
+	// +optional
+	Content MemoryDumpContent `json:"content,omitempty"`
 }
 
+// MemoryDumpFormat is the format the memory of the guest is dumped in
+type MemoryDumpFormat string
+
+const (
+	// MemoryDumpFormatRaw dumps the memory of the guest as a raw core dump, for analysis
+	MemoryDumpFormatRaw MemoryDumpFormat = "Raw"
+	// MemoryDumpFormatState saves the memory and device state of the guest, which it can be resumed from
+	MemoryDumpFormatState MemoryDumpFormat = "State"
+	// MemoryDumpFormatKdumpZlib dumps the memory of the guest in the kdump format, compressed with zlib
+	MemoryDumpFormatKdumpZlib MemoryDumpFormat = "KdumpZlib"
+	// MemoryDumpFormatKdumpLzo dumps the memory of the guest in the kdump format, compressed with lzo
+	MemoryDumpFormatKdumpLzo MemoryDumpFormat = "KdumpLzo"
+	// MemoryDumpFormatKdumpSnappy dumps the memory of the guest in the kdump format, compressed with snappy
+	MemoryDumpFormatKdumpSnappy MemoryDumpFormat = "KdumpSnappy"
+)
+
+// MemoryDumpContent is the part of the guest memory which is dumped
+type MemoryDumpContent string
+
+const (
+	// MemoryDumpContentFull dumps the whole memory of the guest
+	MemoryDumpContentFull MemoryDumpContent = "Full"
+	// MemoryDumpContentKernel only dumps the memory mapped by the page tables of the guest kernel,
+	// it requires the Raw format
+	MemoryDumpContentKernel MemoryDumpContent = "Kernel"
+)
+
 type EphemeralVolumeSource struct {
 	// PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
 	// Directly attached to the vmi via qemu.
@@ -1276,6 +1585,12 @@ const (
 	WatchdogActionReset WatchdogAction = "reset"
 	// WatchdogActionShutdown will shutdown the vmi if the watchdog gets triggered.
 	WatchdogActionShutdown WatchdogAction = "shutdown"
+	// WatchdogActionMigrate will live migrate the vmi if the watchdog gets triggered.
+	WatchdogActionMigrate WatchdogAction = "migrate"
+	// WatchdogActionSnapshotReset will snapshot the VirtualMachine of the vmi, then reset the vmi, if the watchdog gets triggered.
+	WatchdogActionSnapshotReset WatchdogAction = "snapshot-reset"
+	// WatchdogActionAlert will only set the WatchdogExpired condition on the vmi if the watchdog gets triggered.
+	WatchdogActionAlert WatchdogAction = "alert"
 )
 
 // Named watchdog device.
@@ -1301,14 +1616,16 @@ type WatchdogDevice struct {
 
 // i6300esb watchdog device.
 type I6300ESBWatchdog struct {
-	// The action to take. Valid values are poweroff, reset, shutdown.
+	// The action to take. Valid values are poweroff, reset, shutdown, migrate, snapshot-reset, alert.
+	// The migrate, snapshot-reset and alert actions are taken by KubeVirt rather than by the hypervisor.
 	// Defaults to reset.
 	Action WatchdogAction `json:"action,omitempty"`
 }
 
 // diag288 watchdog device.
 type Diag288Watchdog struct {
-	// The action to take. Valid values are poweroff, reset, shutdown.
+	// The action to take. Valid values are poweroff, reset, shutdown, migrate, snapshot-reset, alert.
+	// The migrate, snapshot-reset and alert actions are taken by KubeVirt rather than by the hypervisor.
 	// Defaults to reset.
 	Action WatchdogAction `json:"action,omitempty"`
 }
@@ -1361,6 +1678,14 @@ type Interface struct {
 	// Empty value functions as `up`.
 	// +optional
 	State InterfaceState `json:"state,omitempty"`
+	// RxQueueSize sets the size of the receive virtqueue of the interface.
+	// Must be a power of 2 between 256 and 1024 and is only supported by the virtio model.
+	// +optional
+	RxQueueSize *uint32 `json:"rxQueueSize,omitempty"`
+	// TxQueueSize sets the size of the transmit virtqueue of the interface.
+	// Must be a power of 2 between 256 and 1024 and is only supported by the virtio model.
+	// +optional
+	TxQueueSize *uint32 `json:"txQueueSize,omitempty"`
 }
 
 type InterfaceState string
@@ -1675,3 +2000,56 @@ type DiskIOThreads struct {
 	// +optional
 	SupplementalPoolThreadCount *uint32 `json:"supplementalPoolThreadCount,omitempty"`
 }
+
+// Hook is a sidecar container called by virt-launcher on the lifecycle points of the VMI.
+type Hook struct {
+	// Name of the hook, unique within the VMI. The sidecar container is named hook-<name>.
+	Name string `json:"name"`
+	// Image of the sidecar container serving the hook.
+	Image string `json:"image"`
+	// Image pull policy.
+	// One of Always, Never, IfNotPresent.
+	// Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+	// +optional
+	ImagePullPolicy v1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// Entrypoint of the sidecar container. The image's ENTRYPOINT is used if this is not provided.
+	// +optional
+	Command []string `json:"command,omitempty"`
+	// Arguments to the entrypoint. The image's CMD is used if this is not provided.
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// Version of the hook API virt-launcher uses to call the hook.
+	// Defaults to the newest version exposed by the hook.
+	// +optional
+	Version HookVersion `json:"version,omitempty"`
+	// HookPoints the hook is called on. Hook points exposed by the hook but not listed here are not called.
+	// +listType=set
+	HookPoints []HookPoint `json:"hookPoints"`
+}
+
+// HookVersion is a version of the hook API.
+type HookVersion string
+
+const (
+	HookVersionV1Alpha1 HookVersion = "v1alpha1"
+	HookVersionV1Alpha2 HookVersion = "v1alpha2"
+	HookVersionV1Alpha3 HookVersion = "v1alpha3"
+	// HookVersionV1Alpha4 streams the progress of long running hooks back to virt-launcher.
+	HookVersionV1Alpha4 HookVersion = "v1alpha4"
+)
+
+// HookPoint is a lifecycle point of the VMI a hook can be called on.
+type HookPoint string
+
+const (
+	// OnDefineDomainHookPoint is called with the domain XML before the domain is defined.
+	OnDefineDomainHookPoint HookPoint = "OnDefineDomain"
+	// PreCloudInitIsoHookPoint is called with the cloud-init data before the cloud-init ISO is created.
+	PreCloudInitIsoHookPoint HookPoint = "PreCloudInitIso"
+	// PostMigrationTargetHookPoint is called on the migration target once the VMI has been migrated.
+	// Requires version v1alpha4.
+	PostMigrationTargetHookPoint HookPoint = "PostMigrationTarget"
+	// PreShutdownHookPoint is called before the guest is asked to shut down.
+	// Requires version v1alpha4.
+	PreShutdownHookPoint HookPoint = "PreShutdown"
+)
@@ -12,6 +12,14 @@ func (HostDisk) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VhostUserBlkVolumeSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "VhostUserBlkVolumeSource represents the vhost-user-blk socket of a storage target on the node.\nExactly one of SocketName and ResourceName must be set.",
+		"socketName":   "SocketName is the name of the socket in the vhost-user-blk socket directory of the node, /var/run/vhost-user-blk.\n+optional",
+		"resourceName": "ResourceName is the name of the device plugin resource providing the socket.\nThe device plugin passes the path of the allocated socket to the pod through the\nVHOST_USER_BLK_RESOURCE_<resource name> environment variable.\n+optional",
+	}
+}
+
 func (ConfigMapVolumeSource) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":            "ConfigMapVolumeSource adapts a ConfigMap into a volume.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes/#configmap",
@@ -55,6 +63,15 @@ func (SysprepSource) SwaggerDoc() map[string]string {
 		"":          "Represents a Sysprep volume source.",
 		"secret":    "Secret references a k8s Secret that contains Sysprep answer file named autounattend.xml that should be attached as disk of CDROM type.\n+ optional",
 		"configMap": "ConfigMap references a ConfigMap that contains Sysprep answer file named autounattend.xml that should be attached as disk of CDROM type.\n+ optional",
+		"namespace": "Namespace of the referenced ConfigMap. Defaults to the namespace of the VirtualMachineInstance.\nOther namespaces must be listed in the sysprep templateNamespaces of the KubeVirt configuration.\n+ optional",
+		"template":  "Template enables rendering the answer files as Go templates, with the values of the VirtualMachineInstance.\n+ optional",
+	}
+}
+
+func (SysprepTemplate) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "SysprepTemplate configures the rendering of Sysprep answer file templates.\nThe templates can reference .Name, .Namespace and .Hostname of the VirtualMachineInstance,\nand the .Parameters read from ParametersSecretRef.",
+		"parametersSecretRef": "ParametersSecretRef references a k8s Secret in the namespace of the VirtualMachineInstance,\nwhose keys are exposed to the templates as .Parameters, e.g. a license key.\n+ optional",
 	}
 }
 
@@ -67,6 +84,7 @@ func (CloudInitNoCloudSource) SwaggerDoc() map[string]string {
 		"networkDataSecretRef": "NetworkDataSecretRef references a k8s secret that contains NoCloud networkdata.\n+ optional",
 		"networkDataBase64":    "NetworkDataBase64 contains NoCloud cloud-init networkdata as a base64 encoded string.\n+ optional",
 		"networkData":          "NetworkData contains NoCloud inline cloud-init networkdata.\n+ optional",
+		"userDataSources":      "UserDataSources references additional Secrets and ConfigMaps containing NoCloud userdata.\nTheir userdata is merged, in the listed order, after the userdata of this source into a single\nmulti-part MIME userdata.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -79,6 +97,23 @@ func (CloudInitConfigDriveSource) SwaggerDoc() map[string]string {
 		"networkDataSecretRef": "NetworkDataSecretRef references a k8s secret that contains config drive networkdata.\n+ optional",
 		"networkDataBase64":    "NetworkDataBase64 contains config drive cloud-init networkdata as a base64 encoded string.\n+ optional",
 		"networkData":          "NetworkData contains config drive inline cloud-init networkdata.\n+ optional",
+		"userDataSources":      "UserDataSources references additional Secrets and ConfigMaps containing config drive userdata.\nTheir userdata is merged, in the listed order, after the userdata of this source into a single\nmulti-part MIME userdata.\n+optional\n+listType=atomic",
+	}
+}
+
+func (CloudInitUserDataSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "CloudInitUserDataSource references a Secret or ConfigMap containing cloud-init userdata\nin its userdata or userData key. Only one of its members may be specified.",
+		"secretRef":    "SecretRef references a k8s secret that contains userdata.\n+optional",
+		"configMapRef": "ConfigMapRef references a k8s configmap that contains userdata.\n+optional",
+	}
+}
+
+func (IgnitionSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "IgnitionSource represents an Ignition config for CoreOS-style guests.\nThe config is passed to the guest through the QEMU firmware configuration device.\nOnly one of its members may be specified.\nMore info: https://coreos.github.io/ignition/",
+		"data":      "Data contains the inline Ignition config.\n+optional",
+		"secretRef": "SecretRef references a k8s secret that contains the Ignition config in its userdata or userData key.\n+optional",
 	}
 }
 
@@ -187,6 +222,25 @@ func (NUMAGuestMappingPassthrough) SwaggerDoc() map[string]string {
 func (NUMA) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"guestMappingPassthrough": "GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.\nThe created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.\n+optional",
+		"guestCells":              "GuestCells defines an explicit guest NUMA topology. Each vCPU has to be assigned to exactly one cell,\nand the memory of the cells has to add up to the guest memory.\nCan't be combined with GuestMappingPassthrough.\n+optional",
+	}
+}
+
+func (NUMAGuestCell) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "NUMAGuestCell defines a NUMA cell of the guest.",
+		"id":        "ID of the cell. The IDs of the cells have to range from 0 to the number of cells minus one.",
+		"cpus":      "CPUs is the list of the vCPUs of the cell, e.g. \"0-3,8\".",
+		"memory":    "Memory is the amount of guest memory of the cell.",
+		"distances": "Distances lists the distances from this cell to the other cells.\nThe distances which are not listed are picked by the hypervisor.\n+optional",
+	}
+}
+
+func (NUMAGuestCellDistance) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "NUMAGuestCellDistance defines the distance from a NUMA cell of the guest to another one.",
+		"cellID": "CellID is the ID of the other cell.",
+		"value":  "Value is the distance, between 10 and 255. 10 is the distance of a cell to itself.",
 	}
 }
 
@@ -200,25 +254,41 @@ func (CPUFeature) SwaggerDoc() map[string]string {
 
 func (Memory) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":          "Memory allows specifying the VirtualMachineInstance memory features.",
-		"hugepages": "Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.\n+optional",
-		"guest":     "Guest allows to specifying the amount of memory which is visible inside the Guest OS.\nThe Guest must lie between Requests and Limits from the resources section.\nDefaults to the requested memory in the resources section if not specified.\n+ optional",
-		"maxGuest":  "MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.\nThe delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.",
+		"":                  "Memory allows specifying the VirtualMachineInstance memory features.",
+		"hugepages":         "Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.\n+optional",
+		"guest":             "Guest allows to specifying the amount of memory which is visible inside the Guest OS.\nThe Guest must lie between Requests and Limits from the resources section.\nDefaults to the requested memory in the resources section if not specified.\n+ optional",
+		"maxGuest":          "MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.\nThe delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.",
+		"balloon":           "Balloon lets virt-handler reclaim unused guest memory through the memory balloon.\nFields which are set override the cluster wide memoryBalloon configuration.\n+optional",
+		"freePageReporting": "FreePageReporting lets the guest report its free memory pages to the host so that they can be reclaimed.\nDefaults to the cluster wide setting. It is never enabled for VMIs requesting dedicated CPUs, hugepages or realtime.\n+optional",
+		"ksmMergePolicy":    "KSMMergePolicy sets how aggressively the kernel samepage merging of the node merges the guest memory.\nDefaults to the mergePolicy of the cluster wide KSM configuration.\n+optional",
+		"overcommit":        "Overcommit is the memory overcommit class of the VirtualMachineInstance. It controls how much memory\nthe pod requests, whether the guest memory can be swapped out and how KSM merges it.\n+optional",
+	}
+}
+
+func (MemoryBalloon) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "MemoryBalloon configures how the memory balloon of a guest is inflated and deflated\nbased on the memory usage reported by the guest.",
+		"min":                  "Min is the least amount of memory the balloon leaves to the guest.\nDefaults to half of the guest memory.\n+optional",
+		"max":                  "Max is the largest amount of memory the balloon gives to the guest.\nDefaults to the guest memory and can not exceed it.\n+optional",
+		"targetFreePercentage": "TargetFreePercentage is the share of the memory given to the guest which should remain\navailable to it. Defaults to 20.\n+optional",
 	}
 }
 
 func (MemoryStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"guestAtBoot":    "GuestAtBoot specifies with how much memory the VirtualMachine intiallly booted with.\n+optional",
-		"guestCurrent":   "GuestCurrent specifies how much memory is currently available for the VirtualMachine.\n+optional",
-		"guestRequested": "GuestRequested specifies how much memory was requested (hotplug) for the VirtualMachine.\n+optional",
+		"guestAtBoot":      "GuestAtBoot specifies with how much memory the VirtualMachine intiallly booted with.\n+optional",
+		"guestCurrent":     "GuestCurrent specifies how much memory is currently available for the VirtualMachine.\n+optional",
+		"guestRequested":   "GuestRequested specifies how much memory was requested (hotplug) for the VirtualMachine.\n+optional",
+		"balloonRequested": "BalloonRequested specifies how much memory the memory balloon policy requested for the VirtualMachine.\n+optional",
+		"balloonActual":    "BalloonActual specifies how much memory the memory balloon currently gives to the VirtualMachine.\n+optional",
 	}
 }
 
 func (Hugepages) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":         "Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.",
-		"pageSize": "PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.",
+		"":              "Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.",
+		"pageSize":      "PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.",
+		"regularMemory": "RegularMemory is the amount of the guest memory which is backed by regular pages instead of hugepages.\nThe rest of the guest memory, including the memory hotplugged at runtime, is backed by hugepages.\nIt must be a multiple of the page size and less than the guest memory.\n+optional",
 	}
 }
 
@@ -260,6 +330,7 @@ func (Devices) SwaggerDoc() map[string]string {
 		"autoattachMemBalloon":       "Whether to attach the Memory balloon device with default period.\nPeriod can be adjusted in virt-config.\nDefaults to true.\n+optional",
 		"autoattachInputDevice":      "Whether to attach an Input Device.\nDefaults to false.\n+optional",
 		"autoattachVSOCK":            "Whether to attach the VSOCK CID to the VM or not.\nVSOCK access will be available if set to true. Defaults to false.",
+		"autoattachVirtIODrivers":    "Whether to attach the VirtIO driver ISO as a CD-ROM, to install the drivers in Windows guests.\nRequires the VirtIO drivers autoattach to be enabled in the cluster config. Defaults to false.\n+optional",
 		"rng":                        "Whether to have random number generator from host\n+optional",
 		"blockMultiQueue":            "Whether or not to enable virtio multi-queue for block devices.\nDefaults to false.\n+optional",
 		"networkInterfaceMultiqueue": "If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.\n+optional",
@@ -269,6 +340,8 @@ func (Devices) SwaggerDoc() map[string]string {
 		"filesystems":                "Filesystems describes filesystem which is connected to the vmi.\n+optional\n+listType=atomic",
 		"hostDevices":                "Whether to attach a host device to the vmi.\n+optional\n+listType=atomic",
 		"clientPassthrough":          "To configure and access client devices such as redirecting USB\n+optional",
+		"consoleSharing":             "ConsoleSharing configures how many clients can be connected to the serial console and to VNC at the same time.\nDefaults to a single exclusive connection, which is disconnected by a new connection.\n+optional",
+		"graphics":                   "Graphics defines the protocol of the auto-attached graphics device.\n+optional",
 		"sound":                      "Whether to emulate a sound device.\n+optional",
 		"tpm":                        "Whether to emulate a TPM device.\n+optional",
 		"video":                      "Video describes the video device configuration for the vmi.\n+optional",
@@ -281,6 +354,19 @@ func (ClientPassthroughDevices) SwaggerDoc() map[string]string {
 	}
 }
 
+func (ConsoleSharing) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"policy":         "Policy defines how the connections are shared, Exclusive, SharedReadOnly or SharedFull. Defaults to Exclusive.\n+optional\n+kubebuilder:validation:Enum=Exclusive;SharedReadOnly;SharedFull",
+		"maxConnections": "MaxConnections limits the number of concurrent connections of the shared policies to the serial console\nand to VNC each, further connections are refused. Defaults to 4.\n+optional\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=16",
+	}
+}
+
+func (Graphics) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"type": "Type of the graphics device, VNC or SPICE. SPICE additionally allows the client to play the audio\nof the sound device and to redirect USB devices when clientPassthrough is set. Defaults to VNC.\n+optional\n+kubebuilder:validation:Enum=VNC;SPICE",
+	}
+}
+
 func (SoundDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":      "Represents the user's configuration to emulate sound cards in the VMI.",
@@ -318,7 +404,10 @@ func (Filesystem) SwaggerDoc() map[string]string {
 }
 
 func (FilesystemVirtiofs) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"cache":          "Cache specifies the caching mode of virtiofsd.\nSupported values are:\nauto: metadata and paths are cached with a timeout, data is cached until the file is closed.\nalways: metadata, data and paths are cached indefinitely.\nnever: nothing is cached, changes on the host are seen immediately by the guest.\nDefaults to auto.\n+optional",
+		"threadPoolSize": "ThreadPoolSize is the maximum number of worker threads virtiofsd uses\nto serve requests. Defaults to the virtiofsd default.\n+optional",
+	}
 }
 
 func (DownwardMetrics) SwaggerDoc() map[string]string {
@@ -329,6 +418,7 @@ func (GPU) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"name":       "Name of the GPU device as exposed by a device plugin",
 		"deviceName": "DeviceName is the name of the device provisioned by device-plugins",
+		"profile":    "Profile is the name of a mediated device profile of the KubeVirt CR.\nIt is resolved on each node to the mediated device type the profile maps to.\nCan't be set together with a different deviceName.\n+optional",
 		"tag":        "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
 	}
 }
@@ -371,11 +461,34 @@ func (Disk) SwaggerDoc() map[string]string {
 		"serial":            "Serial provides the ability to specify a serial number for the disk device.\n+optional",
 		"dedicatedIOThread": "dedicatedIOThread indicates this disk should have an exclusive IO Thread.\nEnabling this implies useIOThreads = true.\nDefaults to false.\n+optional",
 		"cache":             "Cache specifies which kvm disk cache mode should be used.\nSupported values are:\nnone: Guest I/O not cached on the host, but may be kept in a disk cache.\nwritethrough: Guest I/O cached on the host but written through to the physical medium. Slowest but with most guarantees.\nwriteback: Guest I/O cached on the host.\nDefaults to none if the storage supports O_DIRECT, otherwise writethrough.\n+optional",
-		"io":                "IO specifies which QEMU disk IO mode should be used.\nSupported values are: native, default, threads.\n+optional",
+		"io":                "IO specifies which QEMU disk IO mode should be used.\nSupported values are: native, default, threads, io_uring.\n+optional",
 		"tag":               "If specified, disk address and its tag will be provided to the guest via config drive metadata\n+optional",
 		"blockSize":         "If specified, the virtual disk will be presented with the given block sizes.\n+optional",
 		"shareable":         "If specified the disk is made sharable and multiple write from different VMs are permitted\n+optional",
 		"errorPolicy":       "If specified, it can change the default error policy (stop) for the disk\n+optional",
+		"ioLimits":          "IOLimits throttles the IO operations and bandwidth of the disk.\nThey can be updated on a running VMI through the iolimits subresource.\n+optional",
+	}
+}
+
+func (DiskIOLimits) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "DiskIOLimits represents the IOPS and bandwidth limits of a disk.",
+		"readIOPS":       "ReadIOPS limits the read operations per second.\n+optional",
+		"writeIOPS":      "WriteIOPS limits the write operations per second.\n+optional",
+		"readBandwidth":  "ReadBandwidth limits the bytes read per second, e.g. 100Mi.\n+optional",
+		"writeBandwidth": "WriteBandwidth limits the bytes written per second, e.g. 100Mi.\n+optional",
+		"burst":          "Burst allows the disk to exceed its limits for a short time.\n+optional",
+	}
+}
+
+func (DiskIOBurst) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "DiskIOBurst represents the limits a disk may burst to. Each burst limit\nrequires the matching limit of DiskIOLimits and must be greater than it.",
+		"readIOPS":       "ReadIOPS is the read operations per second the disk may burst to.\n+optional",
+		"writeIOPS":      "WriteIOPS is the write operations per second the disk may burst to.\n+optional",
+		"readBandwidth":  "ReadBandwidth is the bytes read per second the disk may burst to.\n+optional",
+		"writeBandwidth": "WriteBandwidth is the bytes written per second the disk may burst to.\n+optional",
+		"lengthSeconds":  "LengthSeconds is for how long the disk may burst.\nDefaults to 1.\n+optional",
 	}
 }
 
@@ -402,7 +515,7 @@ func (DiskDevice) SwaggerDoc() map[string]string {
 
 func (DiskTarget) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"bus":        "Bus indicates the type of disk device to emulate.\nsupported values: virtio, sata, scsi, usb.",
+		"bus":        "Bus indicates the type of disk device to emulate.\nsupported values: virtio, sata, scsi, usb, nvme.",
 		"readonly":   "ReadOnly.\nDefaults to false.",
 		"pciAddress": "If specified, the virtual disk will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10\n+optional",
 	}
@@ -464,6 +577,7 @@ func (VolumeSource) SwaggerDoc() map[string]string {
 		"cloudInitNoCloud":      "CloudInitNoCloud represents a cloud-init NoCloud user-data source.\nThe NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.\nMore info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html\n+optional",
 		"cloudInitConfigDrive":  "CloudInitConfigDrive represents a cloud-init Config Drive user-data source.\nThe Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.\nMore info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html\n+optional",
 		"sysprep":               "Represents a Sysprep volume source.\n+optional",
+		"ignition":              "Ignition represents an Ignition config for CoreOS-style guests.\nThe config is passed to the guest through the QEMU firmware configuration device, no disk is needed for this volume.\nThere can only be one volume of this type!\nMore info: https://coreos.github.io/ignition/\n+optional",
 		"containerDisk":         "ContainerDisk references a docker image, embedding a qcow or raw disk.\nMore info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html\n+optional",
 		"ephemeral":             "Ephemeral is a special volume source that \"wraps\" specified source and provides copy-on-write image on top of it.\n+optional",
 		"emptyDisk":             "EmptyDisk represents a temporary disk which shares the vmis lifecycle.\nMore info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html\n+optional",
@@ -474,6 +588,7 @@ func (VolumeSource) SwaggerDoc() map[string]string {
 		"serviceAccount":        "ServiceAccountVolumeSource represents a reference to a service account.\nThere can only be one volume of this type!\nMore info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/\n+optional",
 		"downwardMetrics":       "DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest\nmetrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.",
 		"memoryDump":            "MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi",
+		"vhostUserBlk":          "VhostUserBlk connects the disk to a vhost-user-blk socket exposed on the node by a storage target like SPDK,\nbypassing the block layer of the host kernel.\n+optional",
 	}
 }
 
@@ -500,7 +615,10 @@ func (PersistentVolumeClaimVolumeSource) SwaggerDoc() map[string]string {
 }
 
 func (MemoryDumpVolumeSource) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"format":  "Format is the format the memory is dumped in. When the volume is not\nhotpluggable and holds a memory state, the VMI is resumed from it on start.\nDefaults to Raw\n+optional",
+		"content": "Content is the part of the guest memory which is dumped, Full or Kernel.\nDefaults to Full\n+optional",
+	}
 }
 
 func (EphemeralVolumeSource) SwaggerDoc() map[string]string {
@@ -687,14 +805,14 @@ func (WatchdogDevice) SwaggerDoc() map[string]string {
 func (I6300ESBWatchdog) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "i6300esb watchdog device.",
-		"action": "The action to take. Valid values are poweroff, reset, shutdown.\nDefaults to reset.",
+		"action": "The action to take. Valid values are poweroff, reset, shutdown, migrate, snapshot-reset, alert.\nThe migrate, snapshot-reset and alert actions are taken by KubeVirt rather than by the hypervisor.\nDefaults to reset.",
 	}
 }
 
 func (Diag288Watchdog) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "diag288 watchdog device.",
-		"action": "The action to take. Valid values are poweroff, reset, shutdown.\nDefaults to reset.",
+		"action": "The action to take. Valid values are poweroff, reset, shutdown, migrate, snapshot-reset, alert.\nThe migrate, snapshot-reset and alert actions are taken by KubeVirt rather than by the hypervisor.\nDefaults to reset.",
 	}
 }
 
@@ -711,6 +829,8 @@ func (Interface) SwaggerDoc() map[string]string {
 		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
 		"acpiIndex":   "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
 		"state":       "State represents the requested operational state of the interface.\nThe supported values are:\n`absent`, expressing a request to remove the interface.\n`down`, expressing a request to set the link down.\n`up`, expressing a request to set the link up.\nEmpty value functions as `up`.\n+optional",
+		"rxQueueSize": "RxQueueSize sets the size of the receive virtqueue of the interface.\nMust be a power of 2 between 256 and 1024 and is only supported by the virtio model.\n+optional",
+		"txQueueSize": "TxQueueSize sets the size of the transmit virtqueue of the interface.\nMust be a power of 2 between 256 and 1024 and is only supported by the virtio model.\n+optional",
 	}
 }
 
@@ -927,3 +1047,16 @@ func (DiskIOThreads) SwaggerDoc() map[string]string {
 		"supplementalPoolThreadCount": "SupplementalPoolThreadCount specifies how many iothreads are allocated for the supplementalPool policy.\n+optional",
 	}
 }
+
+func (Hook) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "Hook is a sidecar container called by virt-launcher on the lifecycle points of the VMI.",
+		"name":            "Name of the hook, unique within the VMI. The sidecar container is named hook-<name>.",
+		"image":           "Image of the sidecar container serving the hook.",
+		"imagePullPolicy": "Image pull policy.\nOne of Always, Never, IfNotPresent.\nDefaults to Always if :latest tag is specified, or IfNotPresent otherwise.\n+optional",
+		"command":         "Entrypoint of the sidecar container. The image's ENTRYPOINT is used if this is not provided.\n+optional",
+		"args":            "Arguments to the entrypoint. The image's CMD is used if this is not provided.\n+optional",
+		"version":         "Version of the hook API virt-launcher uses to call the hook.\nDefaults to the newest version exposed by the hook.\n+optional",
+		"hookPoints":      "HookPoints the hook is called on. Hook points exposed by the hook but not listed here are not called.\n+listType=set",
+	}
+}
@@ -32,6 +32,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
@@ -86,6 +87,12 @@ type VirtualMachineInstanceSpec struct {
 	// +optional
 	PriorityClassName string `json:"priorityClassName,omitempty"`
 
+	// WorkloadClass is the name of one of the workloadClasses of the KubeVirt configuration.
+	// The VMI gets the priority of the class, and may be preempted by VMIs of a higher
+	// priority if the class allows it.
+	// +optional
+	WorkloadClass string `json:"workloadClass,omitempty"`
+
 	// Specification of the desired behavior of the VirtualMachineInstance on the host.
 	Domain DomainSpec `json:"domain"`
 	// NodeSelector is a selector which must be true for the vmi to fit on a node.
@@ -186,6 +193,20 @@ type VirtualMachineInstanceSpec struct {
 	// +listMapKey=name
 	// +optional
 	ResourceClaims []k8sv1.PodResourceClaim `json:"resourceClaims,omitempty"`
+	// Hooks are sidecar containers called by virt-launcher on the lifecycle points of the VMI,
+	// for example to adjust the domain before it is defined.
+	// Requires the Sidecar feature gate.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Hooks []Hook `json:"hooks,omitempty"`
+	// IdlePolicy pauses or stops the VMI once the guest has been idle for a while.
+	// +optional
+	IdlePolicy *IdlePolicy `json:"idlePolicy,omitempty"`
+	// CrashDump dumps the memory of the guest to a volume when the guest panics, for offline analysis.
+	// Requires a panic device.
+	// +optional
+	CrashDump *CrashDump `json:"crashDump,omitempty"`
 }
 
 func (vmiSpec *VirtualMachineInstanceSpec) UnmarshalJSON(data []byte) error {
@@ -208,6 +229,50 @@ func (vmiSpec *VirtualMachineInstanceSpec) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// IdlePolicy pauses or stops a VMI once the guest has been idle for a while. The guest is idle
+// while the usage of its vCPUs stays below the threshold and, unless ignored, no user is logged in
+// according to the guest agent.
+type IdlePolicy struct {
+	// Timeout is how long the guest has to be idle before the action is taken.
+	Timeout metav1.Duration `json:"timeout"`
+	// CPUThresholdPercentage is the usage of the vCPUs of the VMI, in percent, below which the
+	// guest is idle. Defaults to 5.
+	// +optional
+	CPUThresholdPercentage *uint32 `json:"cpuThresholdPercentage,omitempty"`
+	// IgnoreUserSessions considers the guest idle even if users are logged in to it.
+	// +optional
+	IgnoreUserSessions bool `json:"ignoreUserSessions,omitempty"`
+	// Action taken once the guest is idle. Defaults to Pause.
+	// +kubebuilder:validation:Enum=Pause;Stop
+	// +optional
+	Action IdleAction `json:"action,omitempty"`
+}
+
+// IdleAction is the action taken on an idle VMI.
+type IdleAction string
+
+const (
+	// IdleActionPause pauses the VMI. Connecting to its console or VNC resumes it.
+	IdleActionPause IdleAction = "Pause"
+	// IdleActionStop stops the VMI. If it belongs to a VM, the VM is stopped.
+	IdleActionStop IdleAction = "Stop"
+)
+
+// CrashDump configures the memory dump taken when the guest panics. The crashed guest is kept
+// until its memory is dumped, then the VMI fails.
+type CrashDump struct {
+	// VolumeName is the name of the memory dump volume the memory of the guest is dumped to.
+	// The volume must not be hotpluggable, and must use the Raw format.
+	VolumeName string `json:"volumeName"`
+	// MaxSize is the largest guest memory which is dumped. The memory of larger guests is not dumped.
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+	// MaxDumps is the number of crash dumps kept in the volume, the oldest ones are removed first.
+	// Defaults to 1.
+	// +optional
+	MaxDumps *int32 `json:"maxDumps,omitempty"`
+}
+
 // VirtualMachineInstancePhaseTransitionTimestamp gives a timestamp in relation to when a phase is set on a vmi
 type VirtualMachineInstancePhaseTransitionTimestamp struct {
 	// Phase is the status of the VirtualMachineInstance in kubernetes world. It is not the VirtualMachineInstance status, but partially correlates to it.
@@ -322,6 +387,36 @@ type VirtualMachineInstanceStatus struct {
 	// This feature is in alpha.
 	// +optional
 	DeviceStatus *DeviceStatus `json:"deviceStatus,omitempty"`
+	// VCPUPinning shows the host CPUs the vCPUs of the VirtualMachineInstance are pinned to.
+	// It is only reported for VirtualMachineInstances with dedicated CPUs.
+	// +optional
+	VCPUPinning *VCPUPinningStatus `json:"vcpuPinning,omitempty"`
+	// GuestDrivers lists the drivers of the guest devices, as reported by the guest agent.
+	// It is only reported for Windows guests.
+	// +optional
+	// +listType=atomic
+	GuestDrivers []VirtualMachineInstanceGuestDriver `json:"guestDrivers,omitempty"`
+}
+
+// VCPUPinningStatus has the information about the pinning of the vCPUs to host CPUs
+type VCPUPinningStatus struct {
+	// VCPUs lists the host CPUs each vCPU is currently pinned to
+	// +listType=atomic
+	// +optional
+	VCPUs []VCPUPin `json:"vcpus,omitempty"`
+	// Requested lists the host CPUs the vCPUs were requested to be re-pinned to.
+	// It is cleared once the vCPUs are re-pinned.
+	// +listType=atomic
+	// +optional
+	Requested []VCPUPin `json:"requested,omitempty"`
+}
+
+// VCPUPin pins a vCPU to a set of host CPUs
+type VCPUPin struct {
+	// VCPU is the index of the vCPU
+	VCPU uint32 `json:"vcpu"`
+	// CPUSet is the set of host CPUs, in the cpuset list format, e.g. 2-3,6
+	CPUSet string `json:"cpuSet"`
 }
 
 // DeviceStatus has the information of all devices allocated spec.domain.devices
@@ -719,6 +814,24 @@ const (
 
 	// VirtualMachineInstanceMigrationRequired Indicates that an automatic migration is required
 	VirtualMachineInstanceMigrationRequired VirtualMachineInstanceConditionType = "MigrationRequired"
+
+	// Indicates that the guest has been idle for longer than the timeout of the idle policy of the VMI
+	VirtualMachineInstanceIdle VirtualMachineInstanceConditionType = "Idle"
+
+	// Indicates that the watchdog of the guest expired
+	VirtualMachineInstanceWatchdogExpired VirtualMachineInstanceConditionType = "WatchdogExpired"
+
+	// Indicates that the guest panicked
+	VirtualMachineInstanceGuestPanicked VirtualMachineInstanceConditionType = "GuestPanicked"
+
+	// Indicates that the vCPUs of the guest wait for a host CPU or on I/O for longer than the configured thresholds
+	VirtualMachineInstanceCPUDegraded VirtualMachineInstanceConditionType = "CPUDegraded"
+
+	// Indicates that the disk requests of the guest take longer than the configured threshold
+	VirtualMachineInstanceIODegraded VirtualMachineInstanceConditionType = "IODegraded"
+
+	// Indicates that the last migration stopped the guest for longer than the configured threshold
+	VirtualMachineInstanceMigrationDegraded VirtualMachineInstanceConditionType = "MigrationDegraded"
 )
 
 // These are valid reasons for VMI conditions.
@@ -759,6 +872,34 @@ const (
 
 	// Indicates that automatic migration is pending
 	VirtualMachineInstanceReasonAutoMigrationPending = "AutoMigrationPending"
+
+	// Reason means that the guest has been idle for longer than the timeout of the idle policy
+	VirtualMachineInstanceReasonIdleTimeoutExceeded = "IdleTimeoutExceeded"
+	// Reason means that the VMI was paused by its idle policy
+	VirtualMachineInstanceReasonPausedByIdlePolicy = "PausedByIdlePolicy"
+
+	// Reason means that the action of the expired watchdog is yet to be taken by KubeVirt
+	VirtualMachineInstanceReasonWatchdogActionPending = "WatchdogActionPending"
+	// Reason means that the action of the expired watchdog was taken
+	VirtualMachineInstanceReasonWatchdogActionTaken = "WatchdogActionTaken"
+
+	// Reason means that the guest panicked and no crash dump is configured
+	VirtualMachineInstanceReasonGuestPanicked = "GuestPanicked"
+	// Reason means that the memory of the panicked guest is being dumped
+	VirtualMachineInstanceReasonCrashDumpInProgress = "CrashDumpInProgress"
+	// Reason means that the memory of the panicked guest was dumped
+	VirtualMachineInstanceReasonCrashDumpCompleted = "CrashDumpCompleted"
+	// Reason means that the memory of the panicked guest could not be dumped
+	VirtualMachineInstanceReasonCrashDumpFailed = "CrashDumpFailed"
+
+	// Reason means that the vCPUs of the guest wait for a host CPU for longer than the configured threshold
+	VirtualMachineInstanceReasonHighCPUSteal = "HighCPUSteal"
+	// Reason means that the vCPUs of the guest wait on I/O for longer than the configured threshold
+	VirtualMachineInstanceReasonHighVCPUWait = "HighVCPUWait"
+	// Reason means that the average latency of the disk requests is above the configured threshold
+	VirtualMachineInstanceReasonHighIOLatency = "HighIOLatency"
+	// Reason means that the downtime of the last migration is above the configured threshold
+	VirtualMachineInstanceReasonHighMigrationDowntime = "HighMigrationDowntime"
 )
 
 const (
@@ -878,6 +1019,18 @@ type VirtualMachineInstanceGuestOSInfo struct {
 	ID string `json:"id,omitempty"`
 }
 
+// VirtualMachineInstanceGuestDriver describes a driver used by the devices of the guest
+type VirtualMachineInstanceGuestDriver struct {
+	// Name of the driver
+	Name string `json:"name"`
+	// Version of the driver
+	// +optional
+	Version string `json:"version,omitempty"`
+	// Date of the driver, in the YYYY-MM-DD format
+	// +optional
+	Date string `json:"date,omitempty"`
+}
+
 // +k8s:openapi-gen=true
 type MigrationNetworkType string
 
@@ -992,6 +1145,8 @@ type VirtualMachineInstanceMigrationState struct {
 	MigrationPolicyName *string `json:"migrationPolicyName,omitempty"`
 	// Migration configurations to apply
 	MigrationConfiguration *MigrationConfiguration `json:"migrationConfiguration,omitempty"`
+	// Migration overrides requested on the VirtualMachineInstanceMigration
+	MigrationOverrides *MigrationOverrides `json:"migrationOverrides,omitempty"`
 	// If the VMI requires dedicated CPUs, this field will
 	// hold the dedicated CPU set on the target node
 	// +listType=atomic
@@ -1010,6 +1165,40 @@ type VirtualMachineInstanceMigrationState struct {
 	TargetState *VirtualMachineInstanceMigrationTargetState `json:"targetState,omitempty"`
 	// The type of migration network, either 'pod' or 'migration'
 	MigrationNetworkType MigrationNetworkType `json:"migrationNetworkType,omitempty"`
+	// Progress reports the transfer statistics of the running migration
+	// +optional
+	Progress *MigrationProgress `json:"progress,omitempty"`
+	// Indicates that the migration waits for a cutover request before switching over to the target
+	Warm bool `json:"warm,omitempty"`
+	// Indicates that the cutover of a warm migration has been requested
+	CutoverRequested bool `json:"cutoverRequested,omitempty"`
+	// The time the source node started the cutover of a warm migration
+	// +nullable
+	CutoverTimestamp *metav1.Time `json:"cutoverTimestamp,omitempty"`
+}
+
+// MigrationProgress holds the statistics of a running migration as reported by the hypervisor.
+// They help to understand why a migration does not converge.
+//
+// +k8s:openapi-gen=true
+type MigrationProgress struct {
+	// The time the statistics were collected
+	// +nullable
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+	// The total amount of guest data to be migrated, in bytes
+	DataTotalBytes int64 `json:"dataTotalBytes,omitempty"`
+	// The amount of guest data already transferred, in bytes
+	DataProcessedBytes int64 `json:"dataProcessedBytes,omitempty"`
+	// The amount of guest data which is left to transfer, in bytes
+	DataRemainingBytes int64 `json:"dataRemainingBytes,omitempty"`
+	// The rate at which the guest dirties its memory, in bytes per second
+	MemoryDirtyRateBytesPerSecond int64 `json:"memoryDirtyRateBytesPerSecond,omitempty"`
+	// The rate at which the guest memory is transferred, in bytes per second
+	MemoryTransferRateBytesPerSecond int64 `json:"memoryTransferRateBytesPerSecond,omitempty"`
+	// The downtime the guest is expected to suffer when switching over to the target, in milliseconds
+	ExpectedDowntimeMilliseconds int64 `json:"expectedDowntimeMilliseconds,omitempty"`
+	// The number of passes over the guest memory so far
+	Iterations int64 `json:"iterations,omitempty"`
 }
 
 type MigrationAbortStatus string
@@ -1135,6 +1324,29 @@ const (
 	// This annotation indicates that a migration is the result of an
 	// automated workload update
 	WorkloadUpdateMigrationAnnotation string = "kubevirt.io/workloadUpdateMigration"
+	// This annotation indicates that a migration retries a failed migration.
+	// Its value is the name of the migration which was retried first.
+	MigrationRetryOfAnnotation string = "kubevirt.io/migrationRetryOf"
+	// This annotation holds the number of the attempt a retry migration is.
+	MigrationRetryAttemptAnnotation string = "kubevirt.io/migrationRetryAttempt"
+	// This label indicates that a migration was created by a MigrationRebalancePolicy
+	// to move load off a node. Its value is the name of the policy.
+	RebalanceMigrationLabel string = "kubevirt.io/rebalancePolicy"
+	// This annotation allows the evacuation controller to shut a VirtualMachineInstance
+	// down when it has to be evacuated from a node but can't be live migrated.
+	// Only the value "true" enables the fallback.
+	EvacuationShutdownFallbackAnnotation string = "kubevirt.io/evacuationShutdownFallback"
+	// This annotation holds the name of the node a VirtualMachineInstance was evacuated
+	// from while the node was drained. Set by the evacuation controller.
+	EvacuationSourceNodeAnnotation string = "kubevirt.io/evacuationSourceNode"
+	// This annotation on a VirtualMachineInstance, or label on a namespace, allows the
+	// evacuation controller to live migrate evacuated VirtualMachineInstances back to
+	// their source node once it is schedulable again. Only the value "true" enables the
+	// failback, the annotation of the VirtualMachineInstance takes precedence.
+	EvacuationFailbackAnnotation string = "kubevirt.io/evacuationFailback"
+	// This annotation indicates that a migration moves an evacuated VirtualMachineInstance
+	// back to its source node. Its value is the name of the node.
+	FailbackMigrationAnnotation string = "kubevirt.io/failbackMigration"
 	// This annotation indicates to abort any migration due to an automated
 	// workload update. It should only be used for testing purposes.
 	WorkloadUpdateMigrationAbortionAnnotation string = "kubevirt.io/testWorkloadUpdateMigrationAbortion"
@@ -1213,6 +1425,10 @@ const (
 	CPUModelVendorLabel = "cpu-vendor.node.kubevirt.io/"
 	// This label represents supported machine type on the node
 	SupportedMachineTypeLabel = "machine-type.node.kubevirt.io/"
+	// This label represents supported disk bus on the node
+	SupportedDiskBusLabel = "disk-bus.node.kubevirt.io/"
+	// This label represents supported disk IO mode on the node
+	SupportedDiskIOLabel = "disk-io.node.kubevirt.io/"
 
 	VirtIO = "virtio"
 
@@ -1301,6 +1517,10 @@ const (
 	// originated from.
 	VirtualMachinePoolRevisionName string = "kubevirt.io/vm-pool-revision-name"
 
+	// VirtualMachinePoolStaticIPAnnotation holds the IP address allocated to a vmpool replica
+	// by the static IP templating of its pool.
+	VirtualMachinePoolStaticIPAnnotation string = "kubevirt.io/vm-pool-static-ip"
+
 	// VirtualMachineNameLabel is the name of the Virtual Machine
 	VirtualMachineNameLabel string = "vm.kubevirt.io/name"
 
@@ -1327,6 +1547,11 @@ const (
 	// in which freePageReporting is always disabled.
 	FreePageReportingDisabledAnnotation string = "kubevirt.io/free-page-reporting-disabled"
 
+	// DeviceMetricsDisabledAnnotation indicates if the vmi opts out from the per-disk, per-interface
+	// and per-filesystem metrics exported by virt-handler, to bound the cardinality of the metrics
+	// of vmis with many devices. The metrics of the whole vmi are still exported.
+	DeviceMetricsDisabledAnnotation string = "kubevirt.io/device-metrics-disabled"
+
 	// VirtualMachinePodCPULimitsLabel indicates VMI pod CPU resource limits
 	VirtualMachinePodCPULimitsLabel string = "kubevirt.io/vmi-pod-cpu-resource-limits"
 	// VirtualMachinePodMemoryRequestsLabel indicates VMI pod Memory resource requests
@@ -1632,6 +1857,82 @@ type VirtualMachineInstanceMigrationSpec struct {
 	SendTo *VirtualMachineInstanceMigrationSource `json:"sendTo,omitempty"`
 	// If receieve is specified, this VirtualMachineInstanceMigration will be considered the target
 	Receive *VirtualMachineInstanceMigrationTarget `json:"receive,omitempty"`
+
+	// Migration holds tuning overrides for this migration only. Values set here take
+	// precedence over the cluster-wide migration configuration and any matched migration policy.
+	// +optional
+	Migration *MigrationOverrides `json:"migration,omitempty"`
+
+	// RetryPolicy makes the migration controller retry the migration when it fails,
+	// by creating a new migration for the same VMI.
+	// +optional
+	RetryPolicy *MigrationRetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Warm makes the migration copy the non-shared disks to the target in the background
+	// and hold off the switch over to the target until a cutover is requested.
+	// +optional
+	Warm *WarmMigration `json:"warm,omitempty"`
+}
+
+// WarmMigration configures a migration which only cuts over to the target on request.
+type WarmMigration struct {
+	// Cutover requests the switch over to the target. The guest memory and the last disk changes
+	// are transferred once the disks are in sync. It can be set after the migration was created,
+	// but not unset.
+	// +optional
+	Cutover bool `json:"cutover,omitempty"`
+}
+
+// MigrationRetryPolicy describes how a failed migration is retried.
+type MigrationRetryPolicy struct {
+	// MaxRetries is the number of times a failed migration is retried.
+	MaxRetries uint32 `json:"maxRetries"`
+	// Backoff is the delay before the first retry. It doubles with every retry.
+	// Defaults to 20s.
+	// +optional
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+	// MaxBackoff caps the delay between two retries. Defaults to 5m.
+	// +optional
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+}
+
+// MigrationCompression is the compression method used to transfer guest memory.
+type MigrationCompression string
+
+const (
+	// MigrationCompressionXBZRLE compresses memory pages that are re-sent because they were dirtied.
+	// It cannot be used together with parallel migration threads.
+	MigrationCompressionXBZRLE MigrationCompression = "xbzrle"
+	// MigrationCompressionZlib compresses each multifd channel with zlib. Requires parallelMigrationThreads.
+	MigrationCompressionZlib MigrationCompression = "zlib"
+	// MigrationCompressionZstd compresses each multifd channel with zstd. Requires parallelMigrationThreads.
+	MigrationCompressionZstd MigrationCompression = "zstd"
+)
+
+// MigrationOverrides tunes a single live migration.
+type MigrationOverrides struct {
+	// ParallelMigrationThreads is the number of parallel (multifd) connections used to transfer
+	// guest memory. Cannot be combined with allowPostCopy.
+	// +optional
+	ParallelMigrationThreads *uint32 `json:"parallelMigrationThreads,omitempty"`
+	// BandwidthPerMigration limits the amount of network bandwidth this migration can use.
+	// Zero means unlimited.
+	// +optional
+	BandwidthPerMigration *resource.Quantity `json:"bandwidthPerMigration,omitempty"`
+	// AllowAutoConverge allows the migration to throttle the guest CPUs so that it converges.
+	// +optional
+	AllowAutoConverge *bool `json:"allowAutoConverge,omitempty"`
+	// AllowPostCopy allows the migration to switch to post-copy mode if it does not converge.
+	// +optional
+	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
+	// Compression is the method used to compress guest memory during the migration.
+	// +kubebuilder:validation:Enum=xbzrle;zlib;zstd
+	// +optional
+	Compression *MigrationCompression `json:"compression,omitempty"`
+	// Network is the name of the CNI network this migration goes through. It has to be the
+	// migration network or one of the additional migration networks of the cluster configuration.
+	// +optional
+	Network *string `json:"network,omitempty"`
 }
 
 type VirtualMachineInstanceMigrationSource struct {
@@ -1669,6 +1970,23 @@ type VirtualMachineInstanceMigrationStatus struct {
 	// +optional
 	// +listType=atomic
 	SynchronizationAddresses []string `json:"synchronizationAddresses,omitempty" optional:"true"`
+	// Retry reports the progress of the retries of a migration with a retry policy
+	// +optional
+	Retry *MigrationRetryStatus `json:"retry,omitempty"`
+}
+
+// MigrationRetryStatus reports the progress of the retries of a migration.
+type MigrationRetryStatus struct {
+	// Attempt is the number of the attempt this migration is, the original migration being attempt 0
+	Attempt uint32 `json:"attempt"`
+	// FailureThreshold is the number of failed attempts after which the migration is not retried anymore
+	FailureThreshold uint32 `json:"failureThreshold"`
+	// RetryMigrationName is the name of the migration created to retry this one
+	// +optional
+	RetryMigrationName string `json:"retryMigrationName,omitempty"`
+	// Message explains why the migration is not retried
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // VirtualMachineInstanceMigrationPhase is a label for the condition of a VirtualMachineInstanceMigration at the current time.
@@ -1846,6 +2164,15 @@ const (
 	UpdateVolumesStrategyReplacement UpdateVolumesStrategy = "Replacement"
 )
 
+type ChangeApplyStrategy string
+
+const (
+	// ChangeApplyStrategyImmediate applies the changes of the template to the running VMI as soon as possible
+	ChangeApplyStrategyImmediate ChangeApplyStrategy = "Immediate"
+	// ChangeApplyStrategyStaged stages the changes of the template until the VM is restarted or the changes are applied
+	ChangeApplyStrategyStaged ChangeApplyStrategy = "Staged"
+)
+
 // VirtualMachineSpec describes how the proper VirtualMachine
 // should look like
 type VirtualMachineSpec struct {
@@ -1873,6 +2200,91 @@ type VirtualMachineSpec struct {
 
 	// UpdateVolumesStrategy is the strategy to apply on volumes updates
 	UpdateVolumesStrategy *UpdateVolumesStrategy `json:"updateVolumesStrategy,omitempty"`
+
+	// ChangeApplyStrategy defines when the changes of the template are applied to the running VMI.
+	// With Staged, the changes are listed in status.stagedChanges and only applied on the next restart
+	// or through the applychanges subresource. Defaults to Immediate.
+	// +kubebuilder:validation:Enum=Immediate;Staged
+	// +optional
+	ChangeApplyStrategy *ChangeApplyStrategy `json:"changeApplyStrategy,omitempty"`
+
+	// PowerSchedule defines the times the VirtualMachine is started and stopped by the VM controller
+	// +optional
+	PowerSchedule *VirtualMachinePowerSchedule `json:"powerSchedule,omitempty"`
+
+	// HighAvailability defines how the VMI of the VirtualMachine is recovered from a failure of its node
+	// +optional
+	HighAvailability *HighAvailabilityPolicy `json:"highAvailability,omitempty"`
+}
+
+// HighAvailabilityPolicy defines how the VMI of a VirtualMachine is recovered from a failure of its node.
+// The VMI is failed, to be restarted on another node according to the run strategy of the VirtualMachine.
+type HighAvailabilityPolicy struct {
+	// FailoverTimeout is how long the node of the VMI has to be lost before the VMI is failed over
+	// to another node. Defaults to 1m.
+	// +optional
+	FailoverTimeout *metav1.Duration `json:"failoverTimeout,omitempty"`
+	// Fencing defines how the lost node has to be confirmed to be fenced before the VMI is failed over.
+	// With OutOfServiceTaint, the default, a fencing agent has to taint the node with node.kubernetes.io/out-of-service.
+	// With None, the VMI is failed over once the timeout passed, which requires the UnfencedFailover feature gate.
+	// +kubebuilder:validation:Enum=None;OutOfServiceTaint
+	// +optional
+	Fencing *HighAvailabilityFencing `json:"fencing,omitempty"`
+}
+
+type HighAvailabilityFencing string
+
+const (
+	// HighAvailabilityFencingNone fails the VMI over without confirmation that the node is fenced
+	HighAvailabilityFencingNone HighAvailabilityFencing = "None"
+	// HighAvailabilityFencingOutOfServiceTaint waits for a fencing agent to taint the node as out of service
+	HighAvailabilityFencingOutOfServiceTaint HighAvailabilityFencing = "OutOfServiceTaint"
+)
+
+// VirtualMachineFailover represents a failover of the VMI of a VirtualMachine from a lost node
+type VirtualMachineFailover struct {
+	// Node is the lost node the VMI was failed over from
+	Node string `json:"node"`
+	// NodeLostTime is the time the node was detected as lost
+	NodeLostTime metav1.Time `json:"nodeLostTime"`
+	// Time is the time the VMI was failed over
+	Time metav1.Time `json:"time"`
+	// Fenced indicates that a fencing agent confirmed the node to be fenced
+	// +optional
+	Fenced bool `json:"fenced,omitempty"`
+}
+
+// VirtualMachinePowerSchedule defines the times a VirtualMachine is started and stopped
+type VirtualMachinePowerSchedule struct {
+	// Start is a cron expression in the standard five field format of the times the VirtualMachine is started
+	// +optional
+	Start string `json:"start,omitempty"`
+	// Stop is a cron expression in the standard five field format of the times the VirtualMachine is stopped
+	// +optional
+	Stop string `json:"stop,omitempty"`
+	// TimeZone is the IANA name of the time zone the schedule is evaluated in. Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// Exceptions are the dates, in the YYYY-MM-DD format, on which the scheduled starts are skipped, e.g. holidays
+	// +optional
+	// +listType=atomic
+	Exceptions []string `json:"exceptions,omitempty"`
+}
+
+// VirtualMachinePowerScheduleStatus represents the state of the power schedule of a VirtualMachine
+type VirtualMachinePowerScheduleStatus struct {
+	// LastAction is the last action taken according to the power schedule
+	// +optional
+	LastAction StateChangeRequestAction `json:"lastAction,omitempty"`
+	// LastActionTime is the scheduled time of the last action
+	// +optional
+	LastActionTime *metav1.Time `json:"lastActionTime,omitempty"`
+	// NextAction is the next action of the power schedule
+	// +optional
+	NextAction StateChangeRequestAction `json:"nextAction,omitempty"`
+	// NextActionTime is the scheduled time of the next action
+	// +optional
+	NextActionTime *metav1.Time `json:"nextActionTime,omitempty"`
 }
 
 // StateChangeRequestType represents the existing state change requests that are possible
@@ -1933,6 +2345,12 @@ const (
 	// VirtualMachineStatusWaitingForReceiver indicates that this virtual machine is a receiver VM and
 	// migration should start next.
 	VirtualMachineStatusWaitingForReceiver VirtualMachinePrintableStatus = "WaitingForReceiver"
+	// VirtualMachineStatusHibernating indicates that the memory of the virtual machine is being saved
+	// to a PVC before its VMI is stopped.
+	VirtualMachineStatusHibernating VirtualMachinePrintableStatus = "Hibernating"
+	// VirtualMachineStatusHibernated indicates that the memory of the virtual machine is saved to a PVC
+	// and that it is restored when the virtual machine is resumed.
+	VirtualMachineStatusHibernated VirtualMachinePrintableStatus = "Hibernated"
 )
 
 // VirtualMachineStartFailure tracks VMIs which failed to transition successfully
@@ -2012,8 +2430,66 @@ type VirtualMachineStatus struct {
 	//+nullable
 	//+optional
 	PreferenceRef *InstancetypeStatusRef `json:"preferenceRef,omitempty"`
+
+	// StagedChanges lists the changes of the template which are not applied to the running VMI yet,
+	// when the VM uses the Staged change apply strategy
+	// +nullable
+	// +optional
+	StagedChanges *VirtualMachineStagedChanges `json:"stagedChanges,omitempty"`
+
+	// Rescue is set while the VM is in rescue mode, the VMI boots from the
+	// rescue image with the volumes of the VM attached
+	// +nullable
+	// +optional
+	Rescue *VirtualMachineRescue `json:"rescue,omitempty"`
+
+	// Hibernation is set while the memory of the VM is saved to a PVC, from the
+	// hibernate request until the VM is resumed
+	// +nullable
+	// +optional
+	Hibernation *VirtualMachineHibernation `json:"hibernation,omitempty"`
+
+	// Preemption is set while the VM is paused or hibernated to make room for a VM
+	// of a higher priority
+	// +nullable
+	// +optional
+	Preemption *VirtualMachinePreemption `json:"preemption,omitempty"`
+
+	// PowerSchedule represents the state of the power schedule of the VM
+	// +nullable
+	// +optional
+	PowerSchedule *VirtualMachinePowerScheduleStatus `json:"powerSchedule,omitempty"`
+
+	// Failovers are the latest failovers of the VM from lost nodes, the oldest first
+	// +optional
+	// +listType=atomic
+	Failovers []VirtualMachineFailover `json:"failovers,omitempty"`
+}
+
+// VirtualMachineStagedChanges lists the changes of the template staged for the running VMI
+type VirtualMachineStagedChanges struct {
+	// Fields are the paths of the changed fields of the template
+	// +listType=atomic
+	Fields []string `json:"fields,omitempty"`
+	// RestartRequired indicates that the changes can only be applied by restarting the VM
+	// +optional
+	RestartRequired bool `json:"restartRequired,omitempty"`
+	// ApplyMethod is the method of the pending request to apply the changes
+	// +optional
+	ApplyMethod ApplyChangesMethod `json:"applyMethod,omitempty"`
 }
 
+type ApplyChangesMethod string
+
+const (
+	// ApplyChangesMethodRestart applies the staged changes by restarting the VM
+	ApplyChangesMethodRestart ApplyChangesMethod = "Restart"
+	// ApplyChangesMethodLiveMigrate applies the staged changes to the running VMI and live migrates it
+	ApplyChangesMethodLiveMigrate ApplyChangesMethod = "LiveMigrate"
+	// ApplyChangesMethodHotplug applies the staged changes to the running VMI in place
+	ApplyChangesMethodHotplug ApplyChangesMethod = "Hotplug"
+)
+
 type ControllerRevisionRef struct {
 	// Name of the ControllerRevision
 	Name string `json:"name,omitempty"`
@@ -2039,6 +2515,23 @@ type InstancetypeStatusRef struct {
 	//
 	// +optional
 	InferFromVolumeFailurePolicy *InferFromVolumeFailurePolicy `json:"inferFromVolumeFailurePolicy,omitempty"`
+
+	// PendingUpdate lists the changes of the resource not yet captured by the ControllerRevision.
+	// The VirtualMachine is moved to a new ControllerRevision once the update is approved on the resource.
+	//
+	// +optional
+	PendingUpdate *InstancetypeStatusPendingUpdate `json:"pendingUpdate,omitempty"`
+}
+
+type InstancetypeStatusPendingUpdate struct {
+	// ObservedGeneration is the generation of the resource the changes were computed against
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// ChangedFields lists the paths of the spec fields differing between the resource and the ControllerRevision
+	//
+	// +listType=atomic
+	// +optional
+	ChangedFields []string `json:"changedFields,omitempty"`
 }
 
 type VolumeUpdateState struct {
@@ -2154,6 +2647,9 @@ const (
 	// IONative - Kernel native I/O tasks (AIO) offer a better performance but can block the VM if the file is not fully
 	// allocated so this method recommended only when the backing file/disk/etc is fully preallocated.
 	IONative DriverIO = "native"
+	// IOUring - Linux io_uring based I/O tasks, which submit and complete requests with fewer system calls than native
+	// AIO and don't require O_DIRECT. Requires a host kernel and container runtime which allow io_uring.
+	IOUring DriverIO = "io_uring"
 )
 
 // Handler defines a specific action that should be taken
@@ -2304,6 +2800,62 @@ type KubeVirtWorkloadUpdateStrategy struct {
 	//
 	// +optional
 	BatchEvictionInterval *metav1.Duration `json:"batchEvictionInterval,omitempty"`
+
+	// MaxUnavailable is the number or percentage of the running VMIs which can be
+	// updated at the same time, the VMIs migrating or shutting down for the update
+	// included. Percentages are rounded up. It limits both the migrations and the
+	// batch evictions.
+	//
+	// Defaults to no limit besides the migration and eviction settings
+	//
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// CanaryNodeSelector selects the nodes whose VMIs are updated first. The VMIs
+	// of the other nodes are only updated once no VMI of the selected nodes is outdated.
+	//
+	// +optional
+	CanaryNodeSelector *metav1.LabelSelector `json:"canaryNodeSelector,omitempty"`
+
+	// Paused stops the automated workload updates from migrating or evicting any
+	// further VMIs. The migrations and evictions in progress are not interrupted.
+	//
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// WorkloadUpdatePhase is the phase of the automated workload updates
+type WorkloadUpdatePhase string
+
+const (
+	// WorkloadUpdatePhaseCompleted means that no VMI is outdated
+	WorkloadUpdatePhaseCompleted WorkloadUpdatePhase = "Completed"
+	// WorkloadUpdatePhaseCanary means that the VMIs of the canary nodes are updated
+	WorkloadUpdatePhaseCanary WorkloadUpdatePhase = "Canary"
+	// WorkloadUpdatePhaseProgressing means that the outdated VMIs are updated
+	WorkloadUpdatePhaseProgressing WorkloadUpdatePhase = "Progressing"
+	// WorkloadUpdatePhasePaused means that VMIs are outdated but the updates are paused
+	WorkloadUpdatePhasePaused WorkloadUpdatePhase = "Paused"
+)
+
+// KubeVirtWorkloadUpdateStatus reports the progress of the automated workload updates
+type KubeVirtWorkloadUpdateStatus struct {
+	// Phase is the phase of the workload updates
+	Phase WorkloadUpdatePhase `json:"phase,omitempty"`
+
+	// UpdatedVirtualMachineInstances is the number of running VMIs which are up to date
+	UpdatedVirtualMachineInstances int32 `json:"updatedVirtualMachineInstances"`
+
+	// InProgressVirtualMachineInstances is the number of outdated VMIs which are migrating
+	// or shutting down
+	InProgressVirtualMachineInstances int32 `json:"inProgressVirtualMachineInstances"`
+
+	// PendingVirtualMachineInstances is the number of outdated VMIs whose update did not start yet
+	PendingVirtualMachineInstances int32 `json:"pendingVirtualMachineInstances"`
+
+	// PendingCanaryVirtualMachineInstances is the number of the pending VMIs which run on canary nodes
+	// +optional
+	PendingCanaryVirtualMachineInstances int32 `json:"pendingCanaryVirtualMachineInstances,omitempty"`
 }
 
 type KubeVirtSpec struct {
@@ -2459,6 +3011,9 @@ type KubeVirtStatus struct {
 	// +optional
 	// +listType=atomic
 	SynchronizationAddresses []string `json:"synchronizationAddresses,omitempty" optional:"true"`
+	// WorkloadUpdate reports the progress of the automated workload updates
+	// +optional
+	WorkloadUpdate *KubeVirtWorkloadUpdateStatus `json:"workloadUpdate,omitempty" optional:"true"`
 }
 
 // KubeVirtPhase is a label for the phase of a KubeVirt deployment at the current time.
@@ -2537,6 +3092,74 @@ type RestartOptions struct {
 	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,2,rep,name=dryRun"`
 }
 
+// RescueOptions may be provided on rescue request.
+type RescueOptions struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Image is the containerDisk image the VM boots from in rescue mode.
+	Image string `json:"image" protobuf:"bytes,1,opt,name=image"`
+
+	// ReadOnly attaches the disks of the VM read-only in rescue mode.
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty" protobuf:"varint,2,opt,name=readOnly"`
+
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,3,rep,name=dryRun"`
+}
+
+// UnrescueOptions may be provided on unrescue request.
+type UnrescueOptions struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,1,rep,name=dryRun"`
+}
+
+// HibernateOptions may be provided on hibernate request.
+type HibernateOptions struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// StorageClassName is the storage class of the PVC the memory of the VM is saved to.
+	// The default storage class is used if it is not set.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty" protobuf:"bytes,1,opt,name=storageClassName"`
+
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,2,rep,name=dryRun"`
+}
+
+// ResumeOptions may be provided on resume request.
+type ResumeOptions struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,1,rep,name=dryRun"`
+}
+
 // StartOptions may be provided on start request.
 type StartOptions struct {
 	metav1.TypeMeta `json:",inline"`
@@ -2544,6 +3167,15 @@ type StartOptions struct {
 	// Indicates that VM will be started in paused state.
 	// +optional
 	Paused bool `json:"paused,omitempty" protobuf:"varint,7,opt,name=paused"`
+	// BootFromCDROMOnce is the name of a CD-ROM disk the VM boots from for this
+	// start only. The boot order of the VM spec is used again on the next start.
+	// +optional
+	BootFromCDROMOnce string `json:"bootFromCDROMOnce,omitempty" protobuf:"bytes,8,opt,name=bootFromCDROMOnce"`
+	// KernelArgsOnce overrides the kernel arguments of the kernel boot firmware
+	// for this start only. The kernel arguments of the VM spec are used again
+	// on the next start.
+	// +optional
+	KernelArgsOnce string `json:"kernelArgsOnce,omitempty" protobuf:"bytes,9,opt,name=kernelArgsOnce"`
 	// When present, indicates that modifications should not be
 	// persisted. An invalid or unrecognized dryRun directive will
 	// result in an error response and no further processing of the
@@ -2583,8 +3215,10 @@ type UnpauseOptions struct {
 }
 
 const (
-	StartRequestDataPausedKey  string = "paused"
-	StartRequestDataPausedTrue string = "true"
+	StartRequestDataPausedKey            string = "paused"
+	StartRequestDataPausedTrue           string = "true"
+	StartRequestDataBootFromCDROMOnceKey string = "bootFromCDROMOnce"
+	StartRequestDataKernelArgsOnceKey    string = "kernelArgsOnce"
 )
 
 // StopOptions may be provided when deleting an API object.
@@ -2604,6 +3238,22 @@ type StopOptions struct {
 	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,2,rep,name=dryRun"`
 }
 
+// ApplyChangesOptions may be provided on applychanges request.
+type ApplyChangesOptions struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Method used to apply the staged changes, one of Restart, LiveMigrate or Hotplug
+	Method ApplyChangesMethod `json:"method"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
 // MigrateOptions may be provided on migrate request.
 type MigrateOptions struct {
 	metav1.TypeMeta `json:",inline"`
@@ -2624,6 +3274,31 @@ type MigrateOptions struct {
 	// can only restrict but not bypass constraints already set on the VM object.
 	// +optional
 	AddedNodeSelector map[string]string `json:"addedNodeSelector,omitempty"`
+
+	// Warm requests a warm migration, which copies the disks in the background
+	// and only switches over to the target once a cutover is requested.
+	// +optional
+	Warm bool `json:"warm,omitempty"`
+}
+
+// RestoreOptions may be provided on restore request.
+type RestoreOptions struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// VirtualMachineSnapshotName is the name of the VirtualMachineSnapshot to restore the VM from
+	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName"`
+	// Start indicates that the VM is started once the restore completed, with the
+	// run strategy it had before. The VM is stopped for the restore and stays stopped otherwise
+	// +optional
+	Start bool `json:"start,omitempty"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
 }
 
 // VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent
@@ -2706,6 +3381,81 @@ type VirtualMachineInstanceFileSystem struct {
 	Disk           []VirtualMachineInstanceFileSystemDisk `json:"disk,omitempty"`
 }
 
+// VirtualMachineInstanceStats represents the resource usage of a VirtualMachineInstance.
+// The counters are cumulative since the start of the VirtualMachineInstance, rates are
+// calculated from the difference of two samples.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceStats struct {
+	metav1.TypeMeta `json:",inline"`
+	// Timestamp is the time the stats were retrieved from the node.
+	Timestamp metav1.Time `json:"timestamp"`
+	// CPU is the CPU usage of the VirtualMachineInstance.
+	CPU VirtualMachineInstanceCPUStats `json:"cpu"`
+	// Memory is the memory usage of the VirtualMachineInstance.
+	Memory VirtualMachineInstanceMemoryStats `json:"memory"`
+	// Storage is the IO of the disks of the VirtualMachineInstance.
+	// +optional
+	// +listType=atomic
+	Storage []VirtualMachineInstanceStorageStats `json:"storage,omitempty"`
+	// Network is the IO of the interfaces of the VirtualMachineInstance.
+	// +optional
+	// +listType=atomic
+	Network []VirtualMachineInstanceNetworkStats `json:"network,omitempty"`
+}
+
+// VirtualMachineInstanceCPUStats represents the CPU usage of a VirtualMachineInstance.
+type VirtualMachineInstanceCPUStats struct {
+	// VCPUs is the number of vCPUs of the VirtualMachineInstance.
+	VCPUs int64 `json:"vcpus"`
+	// TimeNanoseconds is the CPU time consumed by the VirtualMachineInstance.
+	TimeNanoseconds int64 `json:"timeNanoseconds"`
+}
+
+// VirtualMachineInstanceMemoryStats represents the memory usage of a VirtualMachineInstance.
+type VirtualMachineInstanceMemoryStats struct {
+	// DomainBytes is the memory assigned to the guest.
+	// +optional
+	DomainBytes int64 `json:"domainBytes,omitempty"`
+	// ResidentBytes is the memory used by the VirtualMachineInstance on the node.
+	// +optional
+	ResidentBytes int64 `json:"residentBytes,omitempty"`
+	// AvailableBytes is the memory usable by the guest, as reported by the memory balloon.
+	// +optional
+	AvailableBytes int64 `json:"availableBytes,omitempty"`
+	// UnusedBytes is the memory left unused by the guest, as reported by the memory balloon.
+	// +optional
+	UnusedBytes int64 `json:"unusedBytes,omitempty"`
+}
+
+// VirtualMachineInstanceStorageStats represents the IO of a disk of a VirtualMachineInstance.
+type VirtualMachineInstanceStorageStats struct {
+	// Name of the disk.
+	Name string `json:"name"`
+	// ReadBytes is the number of bytes read from the disk.
+	ReadBytes int64 `json:"readBytes"`
+	// WriteBytes is the number of bytes written to the disk.
+	WriteBytes int64 `json:"writeBytes"`
+	// ReadRequests is the number of read requests to the disk.
+	ReadRequests int64 `json:"readRequests"`
+	// WriteRequests is the number of write requests to the disk.
+	WriteRequests int64 `json:"writeRequests"`
+}
+
+// VirtualMachineInstanceNetworkStats represents the IO of an interface of a VirtualMachineInstance.
+type VirtualMachineInstanceNetworkStats struct {
+	// Name of the interface.
+	Name string `json:"name"`
+	// ReceiveBytes is the number of bytes received by the interface.
+	ReceiveBytes int64 `json:"receiveBytes"`
+	// TransmitBytes is the number of bytes transmitted by the interface.
+	TransmitBytes int64 `json:"transmitBytes"`
+	// ReceivePackets is the number of packets received by the interface.
+	ReceivePackets int64 `json:"receivePackets"`
+	// TransmitPackets is the number of packets transmitted by the interface.
+	TransmitPackets int64 `json:"transmitPackets"`
+}
+
 // FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command
 type FreezeUnfreezeTimeout struct {
 	UnfreezeTimeout *metav1.Duration `json:"unfreezeTimeout"`
@@ -2729,11 +3479,70 @@ type VirtualMachineMemoryDumpRequest struct {
 	// FileName represents the name of the output file
 	// +optional
 	FileName *string `json:"fileName,omitempty"`
+	// Format is the format the memory is dumped in, defaults to Raw
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
+	// Content is the part of the guest memory which is dumped, defaults to Full
+	// +optional
+	Content MemoryDumpContent `json:"content,omitempty"`
 	// Message is a detailed message about failure of the memory dump
 	// +optional
 	Message string `json:"message,omitempty"`
 }
 
+// VirtualMachineRescue represents the rescue mode of a VM
+type VirtualMachineRescue struct {
+	// Image is the containerDisk image the VMI boots from in rescue mode
+	Image string `json:"image"`
+	// ReadOnly indicates that the disks of the VM are attached read-only
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
+}
+
+// VirtualMachineRescueVolumeName is the name of the disk and volume the rescue image is attached as
+const VirtualMachineRescueVolumeName = "rescue"
+
+// VirtualMachineHibernation represents the hibernation of a VM
+type VirtualMachineHibernation struct {
+	// Phase is the phase of the hibernation
+	Phase HibernationPhase `json:"phase"`
+	// ClaimName is the name of the PVC the memory of the VM is saved to
+	ClaimName string `json:"claimName"`
+	// StorageClassName is the storage class of the PVC
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// LastTransitionTime is the time the hibernation entered its phase
+	// +optional
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+type HibernationPhase string
+
+const (
+	// The memory of the VM is being saved
+	HibernationHibernating HibernationPhase = "Hibernating"
+	// The memory of the VM is saved and its VMI is stopped
+	HibernationHibernated HibernationPhase = "Hibernated"
+	// The VMI is started again with the saved memory
+	HibernationResuming HibernationPhase = "Resuming"
+)
+
+// VirtualMachineHibernationVolumeName is the name of the volume the hibernation PVC is attached to the VMI as
+const VirtualMachineHibernationVolumeName = "hibernation-state"
+
+// VirtualMachinePreemption represents the preemption of a VM by a VM of a higher priority
+type VirtualMachinePreemption struct {
+	// Action is what was done to the VM, Stop or Hibernate
+	Action WorkloadPreemptionAction `json:"action"`
+	// PreemptedBy is the namespace and name of the VM which could not be scheduled
+	PreemptedBy string `json:"preemptedBy"`
+	// Time is when the VM was preempted
+	// +optional
+	// +nullable
+	Time metav1.Time `json:"time,omitempty"`
+}
+
 type MemoryDumpPhase string
 
 const (
@@ -2757,8 +3566,14 @@ type AddVolumeOptions struct {
 	// disk to the corresponding volume. This overrides any name
 	// set inside the Disk struct itself.
 	Name string `json:"name"`
-	// Disk represents the hotplug disk that will be plugged into the running VMI
-	Disk *Disk `json:"disk"`
+	// Disk represents the hotplug disk that will be plugged into the running VMI.
+	// Either Disk or Filesystem has to be set.
+	// +optional
+	Disk *Disk `json:"disk,omitempty"`
+	// Filesystem represents the virtiofs filesystem that will be plugged into
+	// the running VMI. Either Disk or Filesystem has to be set.
+	// +optional
+	Filesystem *Filesystem `json:"filesystem,omitempty"`
 	// VolumeSource represents the source of the volume to map to the disk.
 	VolumeSource *HotplugVolumeSource `json:"volumeSource"`
 	// When present, indicates that modifications should not be
@@ -2780,6 +3595,17 @@ type VSOCKOptions struct {
 	UseTLS     *bool  `json:"useTLS,omitempty"`
 }
 
+// MemoryDumpStreamOptions are the options of a memory dump streamed to the client
+type MemoryDumpStreamOptions struct {
+	// Format is the format the memory is dumped in, defaults to Raw.
+	// The State format can not be streamed.
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
+	// Content is the part of the guest memory which is dumped, defaults to Full
+	// +optional
+	Content MemoryDumpContent `json:"content,omitempty"`
+}
+
 // RemoveVolumeOptions is provided when dynamically hot unplugging volume and disk
 type RemoveVolumeOptions struct {
 	// Name represents the name that maps to both the disk and volume that
@@ -2795,6 +3621,70 @@ type RemoveVolumeOptions struct {
 	DryRun []string `json:"dryRun,omitempty"`
 }
 
+// RepinVCPUsOptions is provided when re-pinning the vCPUs of a running VMI
+type RepinVCPUsOptions struct {
+	// Pinning lists the host CPUs the vCPUs are re-pinned to. vCPUs which are not listed keep their pinning.
+	// +listType=atomic
+	Pinning []VCPUPin `json:"pinning"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
+// SetIOLimitsOptions is provided when updating the IO limits of a disk of a running VMI
+type SetIOLimitsOptions struct {
+	// Disk is the name of the disk whose IO limits are updated
+	Disk string `json:"disk"`
+	// IOLimits replace the IO limits of the disk. The disk isn't throttled anymore when unset.
+	// +optional
+	IOLimits *DiskIOLimits `json:"ioLimits,omitempty"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
+// AddUSBDeviceOptions is provided when dynamically hot plugging a USB host device
+type AddUSBDeviceOptions struct {
+	// Name represents the name that will be used to map the
+	// USB device in the VMI spec
+	Name string `json:"name"`
+	// DeviceName is the resource name of the USB host device, as
+	// listed in the permitted host devices configuration
+	DeviceName string `json:"deviceName"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
+// RemoveUSBDeviceOptions is provided when dynamically hot unplugging a USB host device
+type RemoveUSBDeviceOptions struct {
+	// Name represents the name of the USB device that should be removed
+	Name string `json:"name"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
 type TokenBucketRateLimiter struct {
 	// QPS indicates the maximum QPS to the apiserver from this client.
 	// If it's zero, the component default will be used
@@ -2880,6 +3770,11 @@ type KubeVirtConfiguration struct {
 	// KSMConfiguration holds the information regarding the enabling the KSM in the nodes (if available).
 	KSMConfiguration *KSMConfiguration `json:"ksmConfiguration,omitempty"`
 
+	// MemoryBalloon lets virt-handler reclaim unused memory of all guests through their memory balloon.
+	// VMIs can override it in spec.domain.memory.balloon.
+	// +optional
+	MemoryBalloon *MemoryBalloon `json:"memoryBalloon,omitempty"`
+
 	// When set, AutoCPULimitNamespaceLabelSelector will set a CPU limit on virt-launcher for VMIs running inside
 	// namespaces that match the label selector.
 	// The CPU limit will equal the number of requested vCPUs.
@@ -2901,6 +3796,253 @@ type KubeVirtConfiguration struct {
 	// Instancetype configuration
 	// +nullable
 	Instancetype *InstancetypeConfiguration `json:"instancetype,omitempty"`
+
+	// PoolAutoscaling configures the autoscaling of VirtualMachinePools
+	// +nullable
+	PoolAutoscaling *PoolAutoscalingConfiguration `json:"poolAutoscaling,omitempty"`
+
+	// VirtIODrivers configures the VirtIO driver ISO attached to Windows guests
+	// +nullable
+	VirtIODrivers *VirtIODriversConfiguration `json:"virtIODrivers,omitempty"`
+
+	// Sysprep configures the sources of the Sysprep answer files
+	// +nullable
+	Sysprep *SysprepConfiguration `json:"sysprep,omitempty"`
+
+	// SessionRecording configures the recording and auditing of console and VNC sessions by virt-api
+	// +nullable
+	SessionRecording *SessionRecordingConfiguration `json:"sessionRecording,omitempty"`
+
+	// WorkloadClasses map VirtualMachineInstances to a priority, and configure how they are
+	// preempted by VirtualMachineInstances of a higher priority which can not be scheduled
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	WorkloadClasses []WorkloadClass `json:"workloadClasses,omitempty"`
+
+	// Metrics configures the metrics of the VirtualMachineInstances exported by virt-handler
+	// +nullable
+	Metrics *MetricsConfiguration `json:"metrics,omitempty"`
+
+	// PerformanceDegradation configures the thresholds above which virt-handler reports a degraded performance
+	// of the VirtualMachineInstances in their conditions
+	// +nullable
+	PerformanceDegradation *PerformanceDegradationConfiguration `json:"performanceDegradation,omitempty"`
+
+	// Logging configures the format and the verbosity of the logs of the KubeVirt components
+	// +nullable
+	Logging *LoggingConfiguration `json:"logging,omitempty"`
+
+	// SubresourceLimits limits the connections of the clients to the streaming subresources of virt-api
+	// +nullable
+	SubresourceLimits *SubresourceLimitsConfiguration `json:"subresourceLimits,omitempty"`
+
+	// AdmissionPolicy holds the CEL rules the VirtualMachines and VirtualMachineInstances are validated with on admission
+	// +nullable
+	AdmissionPolicy *AdmissionPolicyConfiguration `json:"admissionPolicy,omitempty"`
+}
+
+// AdmissionPolicyConfiguration holds the rules of the cluster administrators for the VirtualMachines and
+// VirtualMachineInstances, evaluated by virt-api when the objects are created or updated
+type AdmissionPolicyConfiguration struct {
+	// Rules are the rules the objects are validated with
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Rules []AdmissionPolicyRule `json:"rules,omitempty"`
+}
+
+// AdmissionPolicyRule is a CEL expression the objects of the resources must satisfy
+type AdmissionPolicyRule struct {
+	// Name identifies the rule
+	Name string `json:"name"`
+
+	// Resources are the resources the rule applies to: virtualmachines or virtualmachineinstances
+	// +listType=set
+	Resources []string `json:"resources"`
+
+	// Expression is a CEL expression evaluating to true when the object satisfies the rule. The object is
+	// available as the object variable, and its previous version on updates as the oldObject variable, which
+	// is null on creations. A rule whose expression can not be evaluated is not satisfied.
+	Expression string `json:"expression"`
+
+	// Message is shown to the client when the object does not satisfy the rule
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Mode is Enforce to deny the objects which do not satisfy the rule, or Warn to only warn the client about
+	// them. Defaults to Enforce.
+	// +optional
+	Mode AdmissionPolicyMode `json:"mode,omitempty"`
+}
+
+// AdmissionPolicyMode is the action taken for the objects which do not satisfy an admission policy rule
+type AdmissionPolicyMode string
+
+const (
+	// AdmissionPolicyEnforce denies the objects which do not satisfy the rule
+	AdmissionPolicyEnforce AdmissionPolicyMode = "Enforce"
+	// AdmissionPolicyWarn admits the objects which do not satisfy the rule with a warning
+	AdmissionPolicyWarn AdmissionPolicyMode = "Warn"
+)
+
+// SubresourceLimitsConfiguration holds the limits of the connections to the streaming subresources, like vnc and
+// console. The limits are enforced by every virt-api replica on the connections it serves.
+type SubresourceLimitsConfiguration struct {
+	// Limits holds the limits of the subresources. Subresources without limits are not limited.
+	// +optional
+	// +listType=map
+	// +listMapKey=subresource
+	Limits []SubresourceLimit `json:"limits,omitempty"`
+
+	// ExemptGroups are the groups whose members are not limited, like the cluster administrators
+	// +optional
+	// +listType=set
+	ExemptGroups []string `json:"exemptGroups,omitempty"`
+}
+
+// SubresourceLimit holds the limits of the connections to a subresource
+type SubresourceLimit struct {
+	// Subresource is the subresource which is limited: console, vnc, vnc/screenshot, spice, usbredir,
+	// portforward, vsock or memorydump
+	Subresource string `json:"subresource"`
+
+	// MaxConnectionsPerUser is the number of concurrent connections of a user to the subresource
+	// +optional
+	MaxConnectionsPerUser *uint32 `json:"maxConnectionsPerUser,omitempty"`
+
+	// MaxConnectionsPerNamespace is the number of concurrent connections to the subresource of the
+	// VirtualMachineInstances of a namespace
+	// +optional
+	MaxConnectionsPerNamespace *uint32 `json:"maxConnectionsPerNamespace,omitempty"`
+
+	// ConnectionsPerMinutePerUser is the number of connections a user can open to the subresource per minute
+	// +optional
+	ConnectionsPerMinutePerUser *uint32 `json:"connectionsPerMinutePerUser,omitempty"`
+}
+
+// LogFormat is the format of the logs of the KubeVirt components
+type LogFormat string
+
+const (
+	// LogFormatJSON logs every entry as a JSON object
+	LogFormatJSON LogFormat = "json"
+	// LogFormatText logs every entry as a line of key=value pairs
+	LogFormatText LogFormat = "text"
+)
+
+// LoggingConfiguration holds the format and the verbosity of the logs of the KubeVirt components. Changes are
+// applied by the running components, except virt-launcher which applies them to the new VirtualMachineInstances.
+type LoggingConfiguration struct {
+	// Format is the format of the logs, json or text. Defaults to json.
+	// +optional
+	Format LogFormat `json:"format,omitempty"`
+
+	// Verbosity sets the verbosity of the components. It takes precedence over the log verbosity of the
+	// developer configuration for the components it sets.
+	// +optional
+	Verbosity *LogVerbosity `json:"verbosity,omitempty"`
+
+	// SubcomponentVerbosity sets the verbosity of subcomponents, like migration or hotplug, in all the
+	// components. Subcomponents without a verbosity use the verbosity of their component.
+	// +optional
+	SubcomponentVerbosity map[string]uint `json:"subcomponentVerbosity,omitempty"`
+}
+
+// PerformanceDegradationConfiguration holds the thresholds of the CPUDegraded, IODegraded and MigrationDegraded
+// conditions of the VirtualMachineInstances. A condition is not reported when its thresholds are not set.
+type PerformanceDegradationConfiguration struct {
+	// CPUStealPercentage is the share of the time the vCPUs wait for a host CPU, in percent, above which
+	// the CPU of the guest is degraded
+	// +optional
+	CPUStealPercentage *uint32 `json:"cpuStealPercentage,omitempty"`
+
+	// VCPUWaitPercentage is the share of the time the vCPUs wait on I/O, in percent, above which the CPU
+	// of the guest is degraded
+	// +optional
+	VCPUWaitPercentage *uint32 `json:"vcpuWaitPercentage,omitempty"`
+
+	// IOLatency is the average latency of the disk requests, above which the IO of the guest is degraded
+	// +optional
+	IOLatency *metav1.Duration `json:"ioLatency,omitempty"`
+
+	// MigrationDowntime is the downtime of a migration, above which the migration of the guest is degraded
+	// +optional
+	MigrationDowntime *metav1.Duration `json:"migrationDowntime,omitempty"`
+}
+
+type MetricsConfiguration struct {
+	// CollectionInterval is the minimum interval between two collections of the domain stats of the
+	// VirtualMachineInstances by virt-handler. Scrapes within the interval are served from the previous
+	// collection. The domain stats are collected on every scrape when it is not set.
+	// +optional
+	CollectionInterval *metav1.Duration `json:"collectionInterval,omitempty"`
+}
+
+// WorkloadClass is a class of VirtualMachineInstances sharing a priority
+type WorkloadClass struct {
+	// Name of the class, which VirtualMachineInstances reference in spec.workloadClass
+	Name string `json:"name"`
+	// PriorityClassName is the PriorityClass of the virt-launcher pods of the class
+	PriorityClassName string `json:"priorityClassName"`
+	// PreemptionAction is taken on running VirtualMachineInstances of the class to make room for
+	// VirtualMachineInstances of a higher priority. They are not preempted if it is not set.
+	// +optional
+	// +kubebuilder:validation:Enum=Stop;Hibernate
+	PreemptionAction WorkloadPreemptionAction `json:"preemptionAction,omitempty"`
+}
+
+type WorkloadPreemptionAction string
+
+const (
+	// WorkloadPreemptionStop stops the VirtualMachine, its pod is deleted
+	WorkloadPreemptionStop WorkloadPreemptionAction = "Stop"
+	// WorkloadPreemptionHibernate hibernates the VirtualMachine, its pod is deleted
+	WorkloadPreemptionHibernate WorkloadPreemptionAction = "Hibernate"
+)
+
+type SessionRecordingType string
+
+const (
+	ConsoleSessionRecording SessionRecordingType = "Console"
+	VNCSessionRecording     SessionRecordingType = "VNC"
+)
+
+type SessionRecordingConfiguration struct {
+	// Sessions lists the kinds of sessions which are audited and recorded, Console and/or VNC.
+	// +optional
+	// +listType=set
+	Sessions []SessionRecordingType `json:"sessions,omitempty"`
+
+	// PersistentVolumeClaimName is the name of a PersistentVolumeClaim in the namespace of KubeVirt, which is
+	// mounted into virt-api to store the recordings. It has to support ReadWriteMany when virt-api runs more
+	// than one replica. Sessions are only audited when it is not set.
+	// +optional
+	PersistentVolumeClaimName string `json:"persistentVolumeClaimName,omitempty"`
+}
+
+type SysprepConfiguration struct {
+	// TemplateNamespaces lists the namespaces, e.g. of golden templates, which VirtualMachineInstances
+	// of any namespace may reference Sysprep answer files from.
+	// +optional
+	// +listType=set
+	TemplateNamespaces []string `json:"templateNamespaces,omitempty"`
+}
+
+type VirtIODriversConfiguration struct {
+	// Autoattach enables attaching the VirtIO driver ISO to the VirtualMachineInstances which request it,
+	// either with autoattachVirtIODrivers or through their preference. Defaults to false.
+	// +optional
+	Autoattach *bool `json:"autoattach,omitempty"`
+	// Image is the containerDisk image holding the VirtIO driver ISO
+	// +optional
+	Image string `json:"image,omitempty"`
+}
+
+type PoolAutoscalingConfiguration struct {
+	// PrometheusURL is the address of the Prometheus API queried by the Prometheus metrics of VirtualMachinePools
+	// +optional
+	PrometheusURL string `json:"prometheusURL,omitempty"`
 }
 
 type InstancetypeConfiguration struct {
@@ -3057,6 +4199,10 @@ type MigrationConfiguration struct {
 	// ParallelOutboundMigrationsPerNode is the maximum number of concurrent outgoing live migrations
 	// allowed per node. Defaults to 2
 	ParallelOutboundMigrationsPerNode *uint32 `json:"parallelOutboundMigrationsPerNode,omitempty"`
+	// ParallelInboundMigrationsPerNode is the maximum number of concurrent incoming live migrations
+	// allowed per node. Migration target pods are not scheduled to nodes which reached this limit.
+	// Defaults to no limit
+	ParallelInboundMigrationsPerNode *uint32 `json:"parallelInboundMigrationsPerNode,omitempty"`
 	// ParallelMigrationsPerCluster is the total number of concurrent live migrations
 	// allowed cluster-wide. Defaults to 5
 	ParallelMigrationsPerCluster *uint32 `json:"parallelMigrationsPerCluster,omitempty"`
@@ -3093,11 +4239,36 @@ type MigrationConfiguration struct {
 	// Network is the name of the CNI network to use for live migrations. By default, migrations go
 	// through the pod network.
 	Network *string `json:"network,omitempty"`
+	// AdditionalNetworks are the names of further CNI networks for live migrations. A migration or
+	// its migration policy can select one of them, or the network above, through its network field.
+	// +listType=atomic
+	AdditionalNetworks []string `json:"additionalNetworks,omitempty"`
 	// By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
 	// When set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.
 	// That will ensure the target virt-launcher doesn't share categories with another pod on the node.
 	// However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
 	MatchSELinuxLevelOnMigration *bool `json:"matchSELinuxLevelOnMigration,omitempty"`
+	// ReplugHostDevices allows VMIs with GPUs and host devices to live migrate. The devices are unplugged
+	// from the guest before the migration starts, and equivalent devices are plugged into it on the target.
+	// The guest agent of such VMIs must be connected, and GPUs must have their display disabled.
+	// Defaults to false
+	ReplugHostDevices *bool `json:"replugHostDevices,omitempty"`
+	// EvacuationOrder defines the order in which VMIs are migrated off a node being drained.
+	// Defaults to no particular order
+	EvacuationOrder *EvacuationOrder `json:"evacuationOrder,omitempty"`
+}
+
+// EvacuationOrder defines the order in which VMIs are migrated off a node being drained.
+// When both criteria are set, VMIs are ordered by priority class first and by label second.
+type EvacuationOrder struct {
+	// ByPriorityClass evacuates VMIs with a higher priority, as resolved from the priority class
+	// of their virt-launcher pod, first.
+	// +optional
+	ByPriorityClass bool `json:"byPriorityClass,omitempty"`
+	// ByLabel is the key of a VMI label holding an integer. VMIs with a higher value are evacuated
+	// first, VMIs without the label or with a value which is not an integer last.
+	// +optional
+	ByLabel string `json:"byLabel,omitempty"`
 }
 
 // DiskVerification holds container disks verification limits
@@ -3159,11 +4330,15 @@ type LogVerbosity struct {
 }
 
 const (
-	PCIResourcePrefix  = "PCI_RESOURCE"
-	MDevResourcePrefix = "MDEV_PCI_RESOURCE"
-	USBResourcePrefix  = "USB_RESOURCE"
+	PCIResourcePrefix          = "PCI_RESOURCE"
+	MDevResourcePrefix         = "MDEV_PCI_RESOURCE"
+	USBResourcePrefix          = "USB_RESOURCE"
+	VhostUserBlkResourcePrefix = "VHOST_USER_BLK_RESOURCE"
 )
 
+// MediatedDeviceProfileResourcePrefix is the prefix of the resources exposing the devices of the mediated device profiles
+const MediatedDeviceProfileResourcePrefix = "mdev.kubevirt.io/"
+
 // PermittedHostDevices holds information about devices allowed for passthrough
 type PermittedHostDevices struct {
 	// +listType=atomic
@@ -3238,6 +4413,21 @@ type NodeMediatedDeviceTypesConfig struct {
 	// +optional
 	// +listType=atomic
 	MediatedDeviceTypes []string `json:"mediatedDeviceTypes"`
+	// Profiles maps the mediated device profiles requested by VMs to the
+	// mediated device types providing them on the selected nodes.
+	// The types of the profiles are created on the nodes as well.
+	// +optional
+	// +listType=atomic
+	Profiles []MediatedDeviceProfile `json:"profiles,omitempty"`
+}
+
+// MediatedDeviceProfile maps an alias requested by VMs to a mediated device type.
+type MediatedDeviceProfile struct {
+	// Name is the alias of the profile, requested by VMs in spec.domain.devices.gpus[].profile.
+	// The devices of the profile are exposed as the mdev.kubevirt.io/<name> resource.
+	Name string `json:"name"`
+	// MediatedDeviceType is the ID or the name of the mediated device type providing the profile.
+	MediatedDeviceType string `json:"mediatedDeviceType"`
 }
 
 // KSMConfiguration holds information about KSM.
@@ -3247,8 +4437,40 @@ type KSMConfiguration struct {
 	// Empty NodeLabelSelector will enable ksm for every node.
 	// +optional
 	NodeLabelSelector *metav1.LabelSelector `json:"nodeLabelSelector,omitempty"`
+	// MergePolicy is the KSM merge policy of the VMIs which do not set one.
+	// Defaults to Balanced.
+	// +optional
+	MergePolicy KSMMergePolicy `json:"mergePolicy,omitempty"`
 }
 
+// KSMMergePolicy sets how aggressively KSM merges the memory of a VMI
+type KSMMergePolicy string
+
+const (
+	// KSMMergePolicyDisabled prevents KSM from merging the memory of the VMI
+	KSMMergePolicyDisabled KSMMergePolicy = "Disabled"
+	// KSMMergePolicyConservative lets KSM scan fewer pages and only under high memory pressure
+	KSMMergePolicyConservative KSMMergePolicy = "Conservative"
+	// KSMMergePolicyBalanced is the default KSM tuning
+	KSMMergePolicyBalanced KSMMergePolicy = "Balanced"
+	// KSMMergePolicyAggressive lets KSM scan more pages and start under lower memory pressure
+	KSMMergePolicyAggressive KSMMergePolicy = "Aggressive"
+)
+
+// MemoryOvercommitClass sets how far the memory of a VMI can be overcommitted
+type MemoryOvercommitClass string
+
+const (
+	// MemoryOvercommitGuaranteed requests the whole guest memory and keeps it from being swapped out or merged by KSM
+	MemoryOvercommitGuaranteed MemoryOvercommitClass = "Guaranteed"
+	// MemoryOvercommitBurstableWithSwap requests the guest memory reduced by the cluster wide memoryOvercommit
+	// and lets the guest memory be swapped out
+	MemoryOvercommitBurstableWithSwap MemoryOvercommitClass = "BurstableWithSwap"
+	// MemoryOvercommitBestEffort only requests the memory overhead, lets the guest memory be swapped out and
+	// be merged aggressively by KSM
+	MemoryOvercommitBestEffort MemoryOvercommitClass = "BestEffort"
+)
+
 // NetworkConfiguration holds network options
 type NetworkConfiguration struct {
 	NetworkInterface string `json:"defaultNetworkInterface,omitempty"`
@@ -3545,6 +4767,54 @@ type SEVSecretOptions struct {
 	Secret string `json:"secret,omitempty"`
 }
 
+// GuestOSExecOptions is provided when running a command in the guest through the guest agent.
+type GuestOSExecOptions struct {
+	// Command is the path of the executable to run in the guest.
+	Command string `json:"command"`
+	// Args are passed to the command.
+	// +optional
+	// +listType=atomic
+	Args []string `json:"args,omitempty"`
+	// TimeoutSeconds is the time the command is given to exit.
+	// Defaults to 10 seconds, must be between 1 and 300 seconds.
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// GuestOSExecResult contains the outcome of a command run in the guest.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type GuestOSExecResult struct {
+	metav1.TypeMeta `json:",inline"`
+	// ExitCode of the command.
+	ExitCode int32 `json:"exitCode"`
+	// Stdout of the command.
+	Stdout string `json:"stdout,omitempty"`
+	// Stderr of the command.
+	Stderr string `json:"stderr,omitempty"`
+}
+
+// GuestFileMaxSize is the maximum size in bytes of a file transferred to or from the guest.
+const GuestFileMaxSize = 1024 * 1024
+
+// GuestFileReadOptions is provided when reading a file in the guest through the guest agent.
+type GuestFileReadOptions struct {
+	// Path is the absolute path of the file in the guest.
+	Path string `json:"path"`
+}
+
+// GuestFile is a file in the guest, read or written through the guest agent.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type GuestFile struct {
+	metav1.TypeMeta `json:",inline"`
+	// Path is the absolute path of the file in the guest.
+	Path string `json:"path"`
+	// Content of the file, at most 1MiB.
+	// +optional
+	Content []byte `json:"content,omitempty"`
+}
+
 // ObjectGraphNode represents an individual node in the graph.
 //
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
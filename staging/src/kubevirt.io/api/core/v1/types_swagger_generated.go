@@ -20,6 +20,7 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                              "VirtualMachineInstanceSpec is a description of a VirtualMachineInstance.",
 		"priorityClassName":             "If specified, indicates the pod's priority.\nIf not specified, the pod priority will be default or zero if there is no\ndefault.\n+optional",
+		"workloadClass":                 "WorkloadClass is the name of one of the workloadClasses of the KubeVirt configuration.\nThe VMI gets the priority of the class, and may be preempted by VMIs of a higher\npriority if the class allows it.\n+optional",
 		"domain":                        "Specification of the desired behavior of the VirtualMachineInstance on the host.",
 		"nodeSelector":                  "NodeSelector is a selector which must be true for the vmi to fit on a node.\nSelector which must match a node's labels for the vmi to be scheduled on that node.\nMore info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/\n+optional",
 		"affinity":                      "If affinity is specifies, obey all the affinity rules",
@@ -40,6 +41,28 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 		"accessCredentials":             "Specifies a set of public keys to inject into the vm guest\n+listType=atomic\n+optional\n+kubebuilder:validation:MaxItems:=256",
 		"architecture":                  "Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components",
 		"resourceClaims":                "ResourceClaims define which ResourceClaims must be allocated\nand reserved before the VMI, hence virt-launcher pod is allowed to start. The resources\nwill be made available to the domain which consumes them\nby name.\n\nThis is an alpha field and requires enabling the\nDynamicResourceAllocation feature gate in kubernetes\n https://kubernetes.io/docs/concepts/scheduling-eviction/dynamic-resource-allocation/\nThis field should only be configured if one of the feature-gates GPUsWithDRA or HostDevicesWithDRA is enabled.\nThis feature is in alpha.\n\n+listType=map\n+listMapKey=name\n+optional",
+		"hooks":                         "Hooks are sidecar containers called by virt-launcher on the lifecycle points of the VMI,\nfor example to adjust the domain before it is defined.\nRequires the Sidecar feature gate.\n+listType=map\n+listMapKey=name\n+optional",
+		"idlePolicy":                    "IdlePolicy pauses or stops the VMI once the guest has been idle for a while.\n+optional",
+		"crashDump":                     "CrashDump dumps the memory of the guest to a volume when the guest panics, for offline analysis.\nRequires a panic device.\n+optional",
+	}
+}
+
+func (IdlePolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                       "IdlePolicy pauses or stops a VMI once the guest has been idle for a while. The guest is idle\nwhile the usage of its vCPUs stays below the threshold and, unless ignored, no user is logged in\naccording to the guest agent.",
+		"timeout":                "Timeout is how long the guest has to be idle before the action is taken.",
+		"cpuThresholdPercentage": "CPUThresholdPercentage is the usage of the vCPUs of the VMI, in percent, below which the\nguest is idle. Defaults to 5.\n+optional",
+		"ignoreUserSessions":     "IgnoreUserSessions considers the guest idle even if users are logged in to it.\n+optional",
+		"action":                 "Action taken once the guest is idle. Defaults to Pause.\n+kubebuilder:validation:Enum=Pause;Stop\n+optional",
+	}
+}
+
+func (CrashDump) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "CrashDump configures the memory dump taken when the guest panics. The crashed guest is kept\nuntil its memory is dumped, then the VMI fails.",
+		"volumeName": "VolumeName is the name of the memory dump volume the memory of the guest is dumped to.\nThe volume must not be hotpluggable, and must use the Raw format.",
+		"maxSize":    "MaxSize is the largest guest memory which is dumped. The memory of larger guests is not dumped.\n+optional",
+		"maxDumps":   "MaxDumps is the number of crash dumps kept in the volume, the oldest ones are removed first.\nDefaults to 1.\n+optional",
 	}
 }
 
@@ -85,6 +108,24 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"memory":                        "Memory shows various informations about the VirtualMachine memory.\n+optional",
 		"migratedVolumes":               "MigratedVolumes lists the source and destination volumes during the volume migration\n+listType=atomic\n+optional",
 		"deviceStatus":                  "DeviceStatus reflects the state of devices requested in spec.domain.devices. This is an optional field available\nonly when DRA feature gate is enabled\nThis field will only be populated if one of the feature-gates GPUsWithDRA or HostDevicesWithDRA is enabled.\nThis feature is in alpha.\n+optional",
+		"vcpuPinning":                   "VCPUPinning shows the host CPUs the vCPUs of the VirtualMachineInstance are pinned to.\nIt is only reported for VirtualMachineInstances with dedicated CPUs.\n+optional",
+		"guestDrivers":                  "GuestDrivers lists the drivers of the guest devices, as reported by the guest agent.\nIt is only reported for Windows guests.\n+optional\n+listType=atomic",
+	}
+}
+
+func (VCPUPinningStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "VCPUPinningStatus has the information about the pinning of the vCPUs to host CPUs",
+		"vcpus":     "VCPUs lists the host CPUs each vCPU is currently pinned to\n+listType=atomic\n+optional",
+		"requested": "Requested lists the host CPUs the vCPUs were requested to be re-pinned to.\nIt is cleared once the vCPUs are re-pinned.\n+listType=atomic\n+optional",
+	}
+}
+
+func (VCPUPin) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VCPUPin pins a vCPU to a set of host CPUs",
+		"vcpu":   "VCPU is the index of the vCPU",
+		"cpuSet": "CPUSet is the set of host CPUs, in the cpuset list format, e.g. 2-3,6",
 	}
 }
 
@@ -246,6 +287,15 @@ func (VirtualMachineInstanceGuestOSInfo) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstanceGuestDriver) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "VirtualMachineInstanceGuestDriver describes a driver used by the devices of the guest",
+		"name":    "Name of the driver",
+		"version": "Version of the driver\n+optional",
+		"date":    "Date of the driver, in the YYYY-MM-DD format\n+optional",
+	}
+}
+
 func (VirtualMachineInstanceCommonMigrationState) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"node":                      "The source node that the VMI originated on",
@@ -302,6 +352,7 @@ func (VirtualMachineInstanceMigrationState) SwaggerDoc() map[string]string {
 		"mode":                           "Lets us know if the vmi is currently running pre or post copy migration",
 		"migrationPolicyName":            "Name of the migration policy. If string is empty, no policy is matched",
 		"migrationConfiguration":         "Migration configurations to apply",
+		"migrationOverrides":             "Migration overrides requested on the VirtualMachineInstanceMigration",
 		"targetCPUSet":                   "If the VMI requires dedicated CPUs, this field will\nhold the dedicated CPU set on the target node\n+listType=atomic",
 		"targetNodeTopology":             "If the VMI requires dedicated CPUs, this field will\nhold the numa topology on the target node",
 		"sourcePersistentStatePVCName":   "If the VMI being migrated uses persistent features (backend-storage), its source PVC name is saved here",
@@ -309,6 +360,24 @@ func (VirtualMachineInstanceMigrationState) SwaggerDoc() map[string]string {
 		"sourceState":                    "SourceState contains migration state managed by the source virt handler",
 		"targetState":                    "TargetState contains migration state managed by the target virt handler",
 		"migrationNetworkType":           "The type of migration network, either 'pod' or 'migration'",
+		"progress":                       "Progress reports the transfer statistics of the running migration\n+optional",
+		"warm":                           "Indicates that the migration waits for a cutover request before switching over to the target",
+		"cutoverRequested":               "Indicates that the cutover of a warm migration has been requested",
+		"cutoverTimestamp":               "The time the source node started the cutover of a warm migration\n+nullable",
+	}
+}
+
+func (MigrationProgress) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                                 "MigrationProgress holds the statistics of a running migration as reported by the hypervisor.\nThey help to understand why a migration does not converge.\n\n+k8s:openapi-gen=true",
+		"timestamp":                        "The time the statistics were collected\n+nullable",
+		"dataTotalBytes":                   "The total amount of guest data to be migrated, in bytes",
+		"dataProcessedBytes":               "The amount of guest data already transferred, in bytes",
+		"dataRemainingBytes":               "The amount of guest data which is left to transfer, in bytes",
+		"memoryDirtyRateBytesPerSecond":    "The rate at which the guest dirties its memory, in bytes per second",
+		"memoryTransferRateBytesPerSecond": "The rate at which the guest memory is transferred, in bytes per second",
+		"expectedDowntimeMilliseconds":     "The downtime the guest is expected to suffer when switching over to the target, in milliseconds",
+		"iterations":                       "The number of passes over the guest memory so far",
 	}
 }
 
@@ -392,6 +461,37 @@ func (VirtualMachineInstanceMigrationSpec) SwaggerDoc() map[string]string {
 		"addedNodeSelector": "AddedNodeSelector is an additional selector that can be used to\ncomplement a NodeSelector or NodeAffinity as set on the VM\nto restrict the set of allowed target nodes for a migration.\nIn case of key collisions, values set on the VM objects\nare going to be preserved to ensure that addedNodeSelector\ncan only restrict but not bypass constraints already set on the VM object.\n+optional",
 		"sendTo":            "If sendTo is specified, this VirtualMachineInstanceMigration will be considered the source",
 		"receive":           "If receieve is specified, this VirtualMachineInstanceMigration will be considered the target",
+		"migration":         "Migration holds tuning overrides for this migration only. Values set here take\nprecedence over the cluster-wide migration configuration and any matched migration policy.\n+optional",
+		"retryPolicy":       "RetryPolicy makes the migration controller retry the migration when it fails,\nby creating a new migration for the same VMI.\n+optional",
+		"warm":              "Warm makes the migration copy the non-shared disks to the target in the background\nand hold off the switch over to the target until a cutover is requested.\n+optional",
+	}
+}
+
+func (WarmMigration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "WarmMigration configures a migration which only cuts over to the target on request.",
+		"cutover": "Cutover requests the switch over to the target. The guest memory and the last disk changes\nare transferred once the disks are in sync. It can be set after the migration was created,\nbut not unset.\n+optional",
+	}
+}
+
+func (MigrationRetryPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "MigrationRetryPolicy describes how a failed migration is retried.",
+		"maxRetries": "MaxRetries is the number of times a failed migration is retried.",
+		"backoff":    "Backoff is the delay before the first retry. It doubles with every retry.\nDefaults to 20s.\n+optional",
+		"maxBackoff": "MaxBackoff caps the delay between two retries. Defaults to 5m.\n+optional",
+	}
+}
+
+func (MigrationOverrides) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                         "MigrationOverrides tunes a single live migration.",
+		"parallelMigrationThreads": "ParallelMigrationThreads is the number of parallel (multifd) connections used to transfer\nguest memory. Cannot be combined with allowPostCopy.\n+optional",
+		"bandwidthPerMigration":    "BandwidthPerMigration limits the amount of network bandwidth this migration can use.\nZero means unlimited.\n+optional",
+		"allowAutoConverge":        "AllowAutoConverge allows the migration to throttle the guest CPUs so that it converges.\n+optional",
+		"allowPostCopy":            "AllowPostCopy allows the migration to switch to post-copy mode if it does not converge.\n+optional",
+		"compression":              "Compression is the method used to compress guest memory during the migration.\n+kubebuilder:validation:Enum=xbzrle;zlib;zstd\n+optional",
+		"network":                  "Network is the name of the CNI network this migration goes through. It has to be the\nmigration network or one of the additional migration networks of the cluster configuration.\n+optional",
 	}
 }
 
@@ -422,6 +522,17 @@ func (VirtualMachineInstanceMigrationStatus) SwaggerDoc() map[string]string {
 		"phaseTransitionTimestamps": "PhaseTransitionTimestamp is the timestamp of when the last phase change occurred\n+listType=atomic\n+optional",
 		"migrationState":            "Represents the status of a live migration",
 		"synchronizationAddresses":  "The synchronization addresses one can use to connect to the synchronization controller, includes the port, if multiple\naddresses are available, the first one is reported in the synchronizationAddress field.\n+optional\n+listType=atomic",
+		"retry":                     "Retry reports the progress of the retries of a migration with a retry policy\n+optional",
+	}
+}
+
+func (MigrationRetryStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "MigrationRetryStatus reports the progress of the retries of a migration.",
+		"attempt":            "Attempt is the number of the attempt this migration is, the original migration being attempt 0",
+		"failureThreshold":   "FailureThreshold is the number of failed attempts after which the migration is not retried anymore",
+		"retryMigrationName": "RetryMigrationName is the name of the migration created to retry this one\n+optional",
+		"message":            "Message explains why the migration is not retried\n+optional",
 	}
 }
 
@@ -469,6 +580,47 @@ func (VirtualMachineSpec) SwaggerDoc() map[string]string {
 		"template":              "Template is the direct specification of VirtualMachineInstance",
 		"dataVolumeTemplates":   "dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.\nDataVolumes in this list are dynamically created for the VirtualMachine and are tied to the VirtualMachine's life-cycle.",
 		"updateVolumesStrategy": "UpdateVolumesStrategy is the strategy to apply on volumes updates",
+		"changeApplyStrategy":   "ChangeApplyStrategy defines when the changes of the template are applied to the running VMI.\nWith Staged, the changes are listed in status.stagedChanges and only applied on the next restart\nor through the applychanges subresource. Defaults to Immediate.\n+kubebuilder:validation:Enum=Immediate;Staged\n+optional",
+		"powerSchedule":         "PowerSchedule defines the times the VirtualMachine is started and stopped by the VM controller\n+optional",
+		"highAvailability":      "HighAvailability defines how the VMI of the VirtualMachine is recovered from a failure of its node\n+optional",
+	}
+}
+
+func (HighAvailabilityPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "HighAvailabilityPolicy defines how the VMI of a VirtualMachine is recovered from a failure of its node.\nThe VMI is failed, to be restarted on another node according to the run strategy of the VirtualMachine.",
+		"failoverTimeout": "FailoverTimeout is how long the node of the VMI has to be lost before the VMI is failed over\nto another node. Defaults to 1m.\n+optional",
+		"fencing":         "Fencing defines how the lost node has to be confirmed to be fenced before the VMI is failed over.\nWith OutOfServiceTaint, the default, a fencing agent has to taint the node with node.kubernetes.io/out-of-service.\nWith None, the VMI is failed over once the timeout passed, which requires the UnfencedFailover feature gate.\n+kubebuilder:validation:Enum=None;OutOfServiceTaint\n+optional",
+	}
+}
+
+func (VirtualMachineFailover) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "VirtualMachineFailover represents a failover of the VMI of a VirtualMachine from a lost node",
+		"node":         "Node is the lost node the VMI was failed over from",
+		"nodeLostTime": "NodeLostTime is the time the node was detected as lost",
+		"time":         "Time is the time the VMI was failed over",
+		"fenced":       "Fenced indicates that a fencing agent confirmed the node to be fenced\n+optional",
+	}
+}
+
+func (VirtualMachinePowerSchedule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VirtualMachinePowerSchedule defines the times a VirtualMachine is started and stopped",
+		"start":      "Start is a cron expression in the standard five field format of the times the VirtualMachine is started\n+optional",
+		"stop":       "Stop is a cron expression in the standard five field format of the times the VirtualMachine is stopped\n+optional",
+		"timeZone":   "TimeZone is the IANA name of the time zone the schedule is evaluated in. Defaults to UTC.\n+optional",
+		"exceptions": "Exceptions are the dates, in the YYYY-MM-DD format, on which the scheduled starts are skipped, e.g. holidays\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachinePowerScheduleStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachinePowerScheduleStatus represents the state of the power schedule of a VirtualMachine",
+		"lastAction":     "LastAction is the last action taken according to the power schedule\n+optional",
+		"lastActionTime": "LastActionTime is the scheduled time of the last action\n+optional",
+		"nextAction":     "NextAction is the next action of the power schedule\n+optional",
+		"nextActionTime": "NextActionTime is the scheduled time of the next action\n+optional",
 	}
 }
 
@@ -498,6 +650,21 @@ func (VirtualMachineStatus) SwaggerDoc() map[string]string {
 		"volumeUpdateState":      "VolumeUpdateState contains the information about the volumes set\nupdates related to the volumeUpdateStrategy",
 		"instancetypeRef":        "InstancetypeRef captures the state of any referenced instance type from the VirtualMachine\n+nullable\n+optional",
 		"preferenceRef":          "PreferenceRef captures the state of any referenced preference from the VirtualMachine\n+nullable\n+optional",
+		"stagedChanges":          "StagedChanges lists the changes of the template which are not applied to the running VMI yet,\nwhen the VM uses the Staged change apply strategy\n+nullable\n+optional",
+		"rescue":                 "Rescue is set while the VM is in rescue mode, the VMI boots from the\nrescue image with the volumes of the VM attached\n+nullable\n+optional",
+		"hibernation":            "Hibernation is set while the memory of the VM is saved to a PVC, from the\nhibernate request until the VM is resumed\n+nullable\n+optional",
+		"preemption":             "Preemption is set while the VM is paused or hibernated to make room for a VM\nof a higher priority\n+nullable\n+optional",
+		"powerSchedule":          "PowerSchedule represents the state of the power schedule of the VM\n+nullable\n+optional",
+		"failovers":              "Failovers are the latest failovers of the VM from lost nodes, the oldest first\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineStagedChanges) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineStagedChanges lists the changes of the template staged for the running VMI",
+		"fields":          "Fields are the paths of the changed fields of the template\n+listType=atomic",
+		"restartRequired": "RestartRequired indicates that the changes can only be applied by restarting the VM\n+optional",
+		"applyMethod":     "ApplyMethod is the method of the pending request to apply the changes\n+optional",
 	}
 }
 
@@ -514,6 +681,14 @@ func (InstancetypeStatusRef) SwaggerDoc() map[string]string {
 		"controllerRevisionRef":        "ControllerRef specifies the ControllerRevision storing a copy of the object captured\nwhen it is first seen by the VirtualMachine controller",
 		"inferFromVolume":              "InferFromVolume lists the name of a volume that should be used to infer or discover the resource\n\n+optional",
 		"inferFromVolumeFailurePolicy": "InferFromVolumeFailurePolicy controls what should happen on failure when inferring the resource\n\n+optional",
+		"pendingUpdate":                "PendingUpdate lists the changes of the resource not yet captured by the ControllerRevision.\nThe VirtualMachine is moved to a new ControllerRevision once the update is approved on the resource.\n\n+optional",
+	}
+}
+
+func (InstancetypeStatusPendingUpdate) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"observedGeneration": "ObservedGeneration is the generation of the resource the changes were computed against",
+		"changedFields":      "ChangedFields lists the paths of the spec fields differing between the resource and the ControllerRevision\n\n+listType=atomic\n+optional",
 	}
 }
 
@@ -621,6 +796,20 @@ func (KubeVirtWorkloadUpdateStrategy) SwaggerDoc() map[string]string {
 		"workloadUpdateMethods": "WorkloadUpdateMethods defines the methods that can be used to disrupt workloads\nduring automated workload updates.\nWhen multiple methods are present, the least disruptive method takes\nprecedence over more disruptive methods. For example if both LiveMigrate and Shutdown\nmethods are listed, only VMs which are not live migratable will be restarted/shutdown\n\nAn empty list defaults to no automated workload updating\n\n+listType=atomic\n+optional",
 		"batchEvictionSize":     "BatchEvictionSize Represents the number of VMIs that can be forced updated per\nthe BatchShutdownInteral interval\n\nDefaults to 10\n\n+optional",
 		"batchEvictionInterval": "BatchEvictionInterval Represents the interval to wait before issuing the next\nbatch of shutdowns\n\nDefaults to 1 minute\n\n+optional",
+		"maxUnavailable":        "MaxUnavailable is the number or percentage of the running VMIs which can be\nupdated at the same time, the VMIs migrating or shutting down for the update\nincluded. Percentages are rounded up. It limits both the migrations and the\nbatch evictions.\n\nDefaults to no limit besides the migration and eviction settings\n\n+optional",
+		"canaryNodeSelector":    "CanaryNodeSelector selects the nodes whose VMIs are updated first. The VMIs\nof the other nodes are only updated once no VMI of the selected nodes is outdated.\n\n+optional",
+		"paused":                "Paused stops the automated workload updates from migrating or evicting any\nfurther VMIs. The migrations and evictions in progress are not interrupted.\n\n+optional",
+	}
+}
+
+func (KubeVirtWorkloadUpdateStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                                     "KubeVirtWorkloadUpdateStatus reports the progress of the automated workload updates",
+		"phase":                                "Phase is the phase of the workload updates",
+		"updatedVirtualMachineInstances":       "UpdatedVirtualMachineInstances is the number of running VMIs which are up to date",
+		"inProgressVirtualMachineInstances":    "InProgressVirtualMachineInstances is the number of outdated VMIs which are migrating\nor shutting down",
+		"pendingVirtualMachineInstances":       "PendingVirtualMachineInstances is the number of outdated VMIs whose update did not start yet",
+		"pendingCanaryVirtualMachineInstances": "PendingCanaryVirtualMachineInstances is the number of the pending VMIs which run on canary nodes\n+optional",
 	}
 }
 
@@ -682,6 +871,7 @@ func (KubeVirtStatus) SwaggerDoc() map[string]string {
 		"":                         "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
 		"generations":              "+listType=atomic",
 		"synchronizationAddresses": "+optional\n+listType=atomic",
+		"workloadUpdate":           "WorkloadUpdate reports the progress of the automated workload updates\n+optional",
 	}
 }
 
@@ -701,14 +891,47 @@ func (RestartOptions) SwaggerDoc() map[string]string {
 	}
 }
 
-func (StartOptions) SwaggerDoc() map[string]string {
+func (RescueOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "RescueOptions may be provided on rescue request.",
+		"image":    "Image is the containerDisk image the VM boots from in rescue mode.",
+		"readOnly": "ReadOnly attaches the disks of the VM read-only in rescue mode.\n+optional",
+		"dryRun":   "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (UnrescueOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "UnrescueOptions may be provided on unrescue request.",
+		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (HibernateOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":       "StartOptions may be provided on start request.",
-		"paused": "Indicates that VM will be started in paused state.\n+optional",
+		"":                 "HibernateOptions may be provided on hibernate request.",
+		"storageClassName": "StorageClassName is the storage class of the PVC the memory of the VM is saved to.\nThe default storage class is used if it is not set.\n+optional",
+		"dryRun":           "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (ResumeOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "ResumeOptions may be provided on resume request.",
 		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
 	}
 }
 
+func (StartOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "StartOptions may be provided on start request.",
+		"paused":            "Indicates that VM will be started in paused state.\n+optional",
+		"bootFromCDROMOnce": "BootFromCDROMOnce is the name of a CD-ROM disk the VM boots from for this\nstart only. The boot order of the VM spec is used again on the next start.\n+optional",
+		"kernelArgsOnce":    "KernelArgsOnce overrides the kernel arguments of the kernel boot firmware\nfor this start only. The kernel arguments of the VM spec are used again\non the next start.\n+optional",
+		"dryRun":            "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
 func (PauseOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "PauseOptions may be provided on pause request.",
@@ -731,11 +954,29 @@ func (StopOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (ApplyChangesOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "ApplyChangesOptions may be provided on applychanges request.",
+		"method": "Method used to apply the staged changes, one of Restart, LiveMigrate or Hotplug",
+		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
 func (MigrateOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                  "MigrateOptions may be provided on migrate request.",
 		"dryRun":            "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
 		"addedNodeSelector": "AddedNodeSelector is an additional selector that can be used to\ncomplement a NodeSelector or NodeAffinity as set on the VM\nto restrict the set of allowed target nodes for a migration.\nIn case of key collisions, values set on the VM objects\nare going to be preserved to ensure that addedNodeSelector\ncan only restrict but not bypass constraints already set on the VM object.\n+optional",
+		"warm":              "Warm requests a warm migration, which copies the disks in the background\nand only switches over to the target once a cutover is requested.\n+optional",
+	}
+}
+
+func (RestoreOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                           "RestoreOptions may be provided on restore request.",
+		"virtualMachineSnapshotName": "VirtualMachineSnapshotName is the name of the VirtualMachineSnapshot to restore the VM from",
+		"start":                      "Start indicates that the VM is started once the restore completed, with the\nrun strategy it had before. The VM is stopped for the restore and stays stopped otherwise\n+optional",
+		"dryRun":                     "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
 	}
 }
 
@@ -796,6 +1037,57 @@ func (VirtualMachineInstanceFileSystem) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstanceStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "VirtualMachineInstanceStats represents the resource usage of a VirtualMachineInstance.\nThe counters are cumulative since the start of the VirtualMachineInstance, rates are\ncalculated from the difference of two samples.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"timestamp": "Timestamp is the time the stats were retrieved from the node.",
+		"cpu":       "CPU is the CPU usage of the VirtualMachineInstance.",
+		"memory":    "Memory is the memory usage of the VirtualMachineInstance.",
+		"storage":   "Storage is the IO of the disks of the VirtualMachineInstance.\n+optional\n+listType=atomic",
+		"network":   "Network is the IO of the interfaces of the VirtualMachineInstance.\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineInstanceCPUStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineInstanceCPUStats represents the CPU usage of a VirtualMachineInstance.",
+		"vcpus":           "VCPUs is the number of vCPUs of the VirtualMachineInstance.",
+		"timeNanoseconds": "TimeNanoseconds is the CPU time consumed by the VirtualMachineInstance.",
+	}
+}
+
+func (VirtualMachineInstanceMemoryStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineInstanceMemoryStats represents the memory usage of a VirtualMachineInstance.",
+		"domainBytes":    "DomainBytes is the memory assigned to the guest.\n+optional",
+		"residentBytes":  "ResidentBytes is the memory used by the VirtualMachineInstance on the node.\n+optional",
+		"availableBytes": "AvailableBytes is the memory usable by the guest, as reported by the memory balloon.\n+optional",
+		"unusedBytes":    "UnusedBytes is the memory left unused by the guest, as reported by the memory balloon.\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceStorageStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "VirtualMachineInstanceStorageStats represents the IO of a disk of a VirtualMachineInstance.",
+		"name":          "Name of the disk.",
+		"readBytes":     "ReadBytes is the number of bytes read from the disk.",
+		"writeBytes":    "WriteBytes is the number of bytes written to the disk.",
+		"readRequests":  "ReadRequests is the number of read requests to the disk.",
+		"writeRequests": "WriteRequests is the number of write requests to the disk.",
+	}
+}
+
+func (VirtualMachineInstanceNetworkStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineInstanceNetworkStats represents the IO of an interface of a VirtualMachineInstance.",
+		"name":            "Name of the interface.",
+		"receiveBytes":    "ReceiveBytes is the number of bytes received by the interface.",
+		"transmitBytes":   "TransmitBytes is the number of bytes transmitted by the interface.",
+		"receivePackets":  "ReceivePackets is the number of packets received by the interface.",
+		"transmitPackets": "TransmitPackets is the number of packets transmitted by the interface.",
+	}
+}
+
 func (FreezeUnfreezeTimeout) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command",
@@ -811,15 +1103,45 @@ func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 		"startTimestamp": "StartTimestamp represents the time the memory dump started\n+optional",
 		"endTimestamp":   "EndTimestamp represents the time the memory dump was completed\n+optional",
 		"fileName":       "FileName represents the name of the output file\n+optional",
+		"format":         "Format is the format the memory is dumped in, defaults to Raw\n+optional",
+		"content":        "Content is the part of the guest memory which is dumped, defaults to Full\n+optional",
 		"message":        "Message is a detailed message about failure of the memory dump\n+optional",
 	}
 }
 
+func (VirtualMachineRescue) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "VirtualMachineRescue represents the rescue mode of a VM",
+		"image":    "Image is the containerDisk image the VMI boots from in rescue mode",
+		"readOnly": "ReadOnly indicates that the disks of the VM are attached read-only\n+optional",
+	}
+}
+
+func (VirtualMachineHibernation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "VirtualMachineHibernation represents the hibernation of a VM",
+		"phase":              "Phase is the phase of the hibernation",
+		"claimName":          "ClaimName is the name of the PVC the memory of the VM is saved to",
+		"storageClassName":   "StorageClassName is the storage class of the PVC\n+optional",
+		"lastTransitionTime": "LastTransitionTime is the time the hibernation entered its phase\n+optional\n+nullable",
+	}
+}
+
+func (VirtualMachinePreemption) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachinePreemption represents the preemption of a VM by a VM of a higher priority",
+		"action":      "Action is what was done to the VM, Stop or Hibernate",
+		"preemptedBy": "PreemptedBy is the namespace and name of the VM which could not be scheduled",
+		"time":        "Time is when the VM was preempted\n+optional\n+nullable",
+	}
+}
+
 func (AddVolumeOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":             "AddVolumeOptions is provided when dynamically hot plugging a volume and disk",
 		"name":         "Name represents the name that will be used to map the\ndisk to the corresponding volume. This overrides any name\nset inside the Disk struct itself.",
-		"disk":         "Disk represents the hotplug disk that will be plugged into the running VMI",
+		"disk":         "Disk represents the hotplug disk that will be plugged into the running VMI.\nEither Disk or Filesystem has to be set.\n+optional",
+		"filesystem":   "Filesystem represents the virtiofs filesystem that will be plugged into\nthe running VMI. Either Disk or Filesystem has to be set.\n+optional",
 		"volumeSource": "VolumeSource represents the source of the volume to map to the disk.",
 		"dryRun":       "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
 	}
@@ -833,6 +1155,14 @@ func (VSOCKOptions) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (MemoryDumpStreamOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "MemoryDumpStreamOptions are the options of a memory dump streamed to the client",
+		"format":  "Format is the format the memory is dumped in, defaults to Raw.\nThe State format can not be streamed.\n+optional",
+		"content": "Content is the part of the guest memory which is dumped, defaults to Full\n+optional",
+	}
+}
+
 func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "RemoveVolumeOptions is provided when dynamically hot unplugging volume and disk",
@@ -841,6 +1171,40 @@ func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (RepinVCPUsOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "RepinVCPUsOptions is provided when re-pinning the vCPUs of a running VMI",
+		"pinning": "Pinning lists the host CPUs the vCPUs are re-pinned to. vCPUs which are not listed keep their pinning.\n+listType=atomic",
+		"dryRun":  "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (SetIOLimitsOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "SetIOLimitsOptions is provided when updating the IO limits of a disk of a running VMI",
+		"disk":     "Disk is the name of the disk whose IO limits are updated",
+		"ioLimits": "IOLimits replace the IO limits of the disk. The disk isn't throttled anymore when unset.\n+optional",
+		"dryRun":   "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (AddUSBDeviceOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "AddUSBDeviceOptions is provided when dynamically hot plugging a USB host device",
+		"name":       "Name represents the name that will be used to map the\nUSB device in the VMI spec",
+		"deviceName": "DeviceName is the resource name of the USB host device, as\nlisted in the permitted host devices configuration",
+		"dryRun":     "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (RemoveUSBDeviceOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "RemoveUSBDeviceOptions is provided when dynamically hot unplugging a USB host device",
+		"name":   "Name represents the name of the USB device that should be removed",
+		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
 func (TokenBucketRateLimiter) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"qps":   "QPS indicates the maximum QPS to the apiserver from this client.\nIf it's zero, the component default will be used",
@@ -878,11 +1242,118 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"supportedGuestAgentVersions":        "deprecated",
 		"vmStateStorageClass":                "VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM.",
 		"ksmConfiguration":                   "KSMConfiguration holds the information regarding the enabling the KSM in the nodes (if available).",
+		"memoryBalloon":                      "MemoryBalloon lets virt-handler reclaim unused memory of all guests through their memory balloon.\nVMIs can override it in spec.domain.memory.balloon.\n+optional",
 		"autoCPULimitNamespaceLabelSelector": "When set, AutoCPULimitNamespaceLabelSelector will set a CPU limit on virt-launcher for VMIs running inside\nnamespaces that match the label selector.\nThe CPU limit will equal the number of requested vCPUs.\nThis setting does not apply to VMIs with dedicated CPUs.",
 		"liveUpdateConfiguration":            "LiveUpdateConfiguration holds defaults for live update features",
 		"vmRolloutStrategy":                  "VMRolloutStrategy defines how live-updatable fields, like CPU sockets, memory,\ntolerations, and affinity, are propagated from a VM to its VMI.\n+nullable\n+kubebuilder:validation:Enum=Stage;LiveUpdate",
 		"commonInstancetypesDeployment":      "CommonInstancetypesDeployment controls the deployment of common-instancetypes resources\n+nullable",
 		"instancetype":                       "Instancetype configuration\n+nullable",
+		"poolAutoscaling":                    "PoolAutoscaling configures the autoscaling of VirtualMachinePools\n+nullable",
+		"virtIODrivers":                      "VirtIODrivers configures the VirtIO driver ISO attached to Windows guests\n+nullable",
+		"sysprep":                            "Sysprep configures the sources of the Sysprep answer files\n+nullable",
+		"sessionRecording":                   "SessionRecording configures the recording and auditing of console and VNC sessions by virt-api\n+nullable",
+		"workloadClasses":                    "WorkloadClasses map VirtualMachineInstances to a priority, and configure how they are\npreempted by VirtualMachineInstances of a higher priority which can not be scheduled\n+optional\n+listType=map\n+listMapKey=name",
+		"metrics":                            "Metrics configures the metrics of the VirtualMachineInstances exported by virt-handler\n+nullable",
+		"performanceDegradation":             "PerformanceDegradation configures the thresholds above which virt-handler reports a degraded performance\nof the VirtualMachineInstances in their conditions\n+nullable",
+		"logging":                            "Logging configures the format and the verbosity of the logs of the KubeVirt components\n+nullable",
+		"subresourceLimits":                  "SubresourceLimits limits the connections of the clients to the streaming subresources of virt-api\n+nullable",
+		"admissionPolicy":                    "AdmissionPolicy holds the CEL rules the VirtualMachines and VirtualMachineInstances are validated with on admission\n+nullable",
+	}
+}
+
+func (PerformanceDegradationConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "PerformanceDegradationConfiguration holds the thresholds of the CPUDegraded, IODegraded and MigrationDegraded\nconditions of the VirtualMachineInstances. A condition is not reported when its thresholds are not set.",
+		"cpuStealPercentage": "CPUStealPercentage is the share of the time the vCPUs wait for a host CPU, in percent, above which\nthe CPU of the guest is degraded\n+optional",
+		"vcpuWaitPercentage": "VCPUWaitPercentage is the share of the time the vCPUs wait on I/O, in percent, above which the CPU\nof the guest is degraded\n+optional",
+		"ioLatency":          "IOLatency is the average latency of the disk requests, above which the IO of the guest is degraded\n+optional",
+		"migrationDowntime":  "MigrationDowntime is the downtime of a migration, above which the migration of the guest is degraded\n+optional",
+	}
+}
+
+func (LoggingConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "LoggingConfiguration holds the format and the verbosity of the logs of the KubeVirt components. Changes are\napplied by the running components, except virt-launcher which applies them to the new VirtualMachineInstances.",
+		"format":                "Format is the format of the logs, json or text. Defaults to json.\n+optional",
+		"verbosity":             "Verbosity sets the verbosity of the components. It takes precedence over the log verbosity of the\ndeveloper configuration for the components it sets.\n+optional",
+		"subcomponentVerbosity": "SubcomponentVerbosity sets the verbosity of subcomponents, like migration or hotplug, in all the\ncomponents. Subcomponents without a verbosity use the verbosity of their component.\n+optional",
+	}
+}
+
+func (AdmissionPolicyConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "AdmissionPolicyConfiguration holds the rules of the cluster administrators for the VirtualMachines and\nVirtualMachineInstances, evaluated by virt-api when the objects are created or updated",
+		"rules": "Rules are the rules the objects are validated with\n+optional\n+listType=map\n+listMapKey=name",
+	}
+}
+
+func (AdmissionPolicyRule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "AdmissionPolicyRule is a CEL expression the objects of the resources must satisfy",
+		"name":       "Name identifies the rule",
+		"resources":  "Resources are the resources the rule applies to: virtualmachines or virtualmachineinstances\n+listType=set",
+		"expression": "Expression is a CEL expression evaluating to true when the object satisfies the rule. The object is\navailable as the object variable, and its previous version on updates as the oldObject variable, which\nis null on creations. A rule whose expression can not be evaluated is not satisfied.",
+		"message":    "Message is shown to the client when the object does not satisfy the rule\n+optional",
+		"mode":       "Mode is Enforce to deny the objects which do not satisfy the rule, or Warn to only warn the client about\nthem. Defaults to Enforce.\n+optional",
+	}
+}
+
+func (SubresourceLimitsConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "SubresourceLimitsConfiguration holds the limits of the connections to the streaming subresources, like vnc and\nconsole. The limits are enforced by every virt-api replica on the connections it serves.",
+		"limits":       "Limits holds the limits of the subresources. Subresources without limits are not limited.\n+optional\n+listType=map\n+listMapKey=subresource",
+		"exemptGroups": "ExemptGroups are the groups whose members are not limited, like the cluster administrators\n+optional\n+listType=set",
+	}
+}
+
+func (SubresourceLimit) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                            "SubresourceLimit holds the limits of the connections to a subresource",
+		"subresource":                 "Subresource is the subresource which is limited: console, vnc, vnc/screenshot, spice, usbredir,\nportforward, vsock or memorydump",
+		"maxConnectionsPerUser":       "MaxConnectionsPerUser is the number of concurrent connections of a user to the subresource\n+optional",
+		"maxConnectionsPerNamespace":  "MaxConnectionsPerNamespace is the number of concurrent connections to the subresource of the\nVirtualMachineInstances of a namespace\n+optional",
+		"connectionsPerMinutePerUser": "ConnectionsPerMinutePerUser is the number of connections a user can open to the subresource per minute\n+optional",
+	}
+}
+
+func (MetricsConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"collectionInterval": "CollectionInterval is the minimum interval between two collections of the domain stats of the\nVirtualMachineInstances by virt-handler. Scrapes within the interval are served from the previous\ncollection. The domain stats are collected on every scrape when it is not set.\n+optional",
+	}
+}
+
+func (PoolAutoscalingConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"prometheusURL": "PrometheusURL is the address of the Prometheus API queried by the Prometheus metrics of VirtualMachinePools\n+optional",
+	}
+}
+
+func (VirtIODriversConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"autoattach": "Autoattach enables attaching the VirtIO driver ISO to the VirtualMachineInstances which request it,\neither with autoattachVirtIODrivers or through their preference. Defaults to false.\n+optional",
+		"image":      "Image is the containerDisk image holding the VirtIO driver ISO\n+optional",
+	}
+}
+
+func (WorkloadClass) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "WorkloadClass is a class of VirtualMachineInstances sharing a priority",
+		"name":              "Name of the class, which VirtualMachineInstances reference in spec.workloadClass",
+		"priorityClassName": "PriorityClassName is the PriorityClass of the virt-launcher pods of the class",
+		"preemptionAction":  "PreemptionAction is taken on running VirtualMachineInstances of the class to make room for\nVirtualMachineInstances of a higher priority. They are not preempted if it is not set.\n+optional\n+kubebuilder:validation:Enum=Stop;Hibernate",
+	}
+}
+
+func (SessionRecordingConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"sessions":                  "Sessions lists the kinds of sessions which are audited and recorded, Console and/or VNC.\n+optional\n+listType=set",
+		"persistentVolumeClaimName": "PersistentVolumeClaimName is the name of a PersistentVolumeClaim in the namespace of KubeVirt, which is\nmounted into virt-api to store the recordings. It has to support ReadWriteMany when virt-api runs more\nthan one replica. Sessions are only audited when it is not set.\n+optional",
+	}
+}
+
+func (SysprepConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"templateNamespaces": "TemplateNamespaces lists the namespaces, e.g. of golden templates, which VirtualMachineInstances\nof any namespace may reference Sysprep answer files from.\n+optional\n+listType=set",
 	}
 }
 
@@ -964,6 +1435,7 @@ func (MigrationConfiguration) SwaggerDoc() map[string]string {
 		"":                                  "MigrationConfiguration holds migration options.\nCan be overridden for specific groups of VMs though migration policies.\nVisit https://kubevirt.io/user-guide/operations/migration_policies/ for more information.",
 		"nodeDrainTaintKey":                 "NodeDrainTaintKey defines the taint key that indicates a node should be drained.\nNote: this option relies on the deprecated node taint feature. Default: kubevirt.io/drain",
 		"parallelOutboundMigrationsPerNode": "ParallelOutboundMigrationsPerNode is the maximum number of concurrent outgoing live migrations\nallowed per node. Defaults to 2",
+		"parallelInboundMigrationsPerNode":  "ParallelInboundMigrationsPerNode is the maximum number of concurrent incoming live migrations\nallowed per node. Migration target pods are not scheduled to nodes which reached this limit.\nDefaults to no limit",
 		"parallelMigrationsPerCluster":      "ParallelMigrationsPerCluster is the total number of concurrent live migrations\nallowed cluster-wide. Defaults to 5",
 		"allowAutoConverge":                 "AllowAutoConverge allows the platform to compromise performance/availability of VMIs to\nguarantee successful VMI live migrations. Defaults to false",
 		"bandwidthPerMigration":             "BandwidthPerMigration limits the amount of network bandwidth live migrations are allowed to use.\nThe value is in quantity per second. Defaults to 0 (no limit)",
@@ -974,7 +1446,18 @@ func (MigrationConfiguration) SwaggerDoc() map[string]string {
 		"allowWorkloadDisruption":           "AllowWorkloadDisruption indicates that the migration shouldn't be\ncanceled after acceptableCompletionTime is exceeded. Instead, if\npermitted, migration will be switched to post-copy or the VMI will be\npaused to allow the migration to complete",
 		"disableTLS":                        "When set to true, DisableTLS will disable the additional layer of live migration encryption\nprovided by KubeVirt. This is usually a bad idea. Defaults to false",
 		"network":                           "Network is the name of the CNI network to use for live migrations. By default, migrations go\nthrough the pod network.",
+		"additionalNetworks":                "AdditionalNetworks are the names of further CNI networks for live migrations. A migration or\nits migration policy can select one of them, or the network above, through its network field.\n+listType=atomic",
 		"matchSELinuxLevelOnMigration":      "By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.\nWhen set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.\nThat will ensure the target virt-launcher doesn't share categories with another pod on the node.\nHowever, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.",
+		"replugHostDevices":                 "ReplugHostDevices allows VMIs with GPUs and host devices to live migrate. The devices are unplugged\nfrom the guest before the migration starts, and equivalent devices are plugged into it on the target.\nThe guest agent of such VMIs must be connected, and GPUs must have their display disabled.\nDefaults to false",
+		"evacuationOrder":                   "EvacuationOrder defines the order in which VMIs are migrated off a node being drained.\nDefaults to no particular order",
+	}
+}
+
+func (EvacuationOrder) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "EvacuationOrder defines the order in which VMIs are migrated off a node being drained.\nWhen both criteria are set, VMIs are ordered by priority class first and by label second.",
+		"byPriorityClass": "ByPriorityClass evacuates VMIs with a higher priority, as resolved from the priority class\nof their virt-launcher pod, first.\n+optional",
+		"byLabel":         "ByLabel is the key of a VMI label holding an integer. VMIs with a higher value are evacuated\nfirst, VMIs without the label or with a value which is not an integer last.\n+optional",
 	}
 }
 
@@ -1057,6 +1540,15 @@ func (NodeMediatedDeviceTypesConfig) SwaggerDoc() map[string]string {
 		"nodeSelector":         "NodeSelector is a selector which must be true for the vmi to fit on a node.\nSelector which must match a node's labels for the vmi to be scheduled on that node.\nMore info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
 		"mediatedDevicesTypes": "Deprecated. Use mediatedDeviceTypes instead.\n+optional\n+listType=atomic",
 		"mediatedDeviceTypes":  "+optional\n+listType=atomic",
+		"profiles":             "Profiles maps the mediated device profiles requested by VMs to the\nmediated device types providing them on the selected nodes.\nThe types of the profiles are created on the nodes as well.\n+optional\n+listType=atomic",
+	}
+}
+
+func (MediatedDeviceProfile) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "MediatedDeviceProfile maps an alias requested by VMs to a mediated device type.",
+		"name":               "Name is the alias of the profile, requested by VMs in spec.domain.devices.gpus[].profile.\nThe devices of the profile are exposed as the mdev.kubevirt.io/<name> resource.",
+		"mediatedDeviceType": "MediatedDeviceType is the ID or the name of the mediated device type providing the profile.",
 	}
 }
 
@@ -1064,6 +1556,7 @@ func (KSMConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                  "KSMConfiguration holds information about KSM.\n+k8s:openapi-gen=true",
 		"nodeLabelSelector": "NodeLabelSelector is a selector that filters in which nodes the KSM will be enabled.\nEmpty NodeLabelSelector will enable ksm for every node.\n+optional",
+		"mergePolicy":       "MergePolicy is the KSM merge policy of the VMIs which do not set one.\nDefaults to Balanced.\n+optional",
 	}
 }
 
@@ -1183,6 +1676,39 @@ func (SEVSecretOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (GuestOSExecOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "GuestOSExecOptions is provided when running a command in the guest through the guest agent.",
+		"command":        "Command is the path of the executable to run in the guest.",
+		"args":           "Args are passed to the command.\n+optional\n+listType=atomic",
+		"timeoutSeconds": "TimeoutSeconds is the time the command is given to exit.\nDefaults to 10 seconds, must be between 1 and 300 seconds.\n+optional",
+	}
+}
+
+func (GuestOSExecResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "GuestOSExecResult contains the outcome of a command run in the guest.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"exitCode": "ExitCode of the command.",
+		"stdout":   "Stdout of the command.",
+		"stderr":   "Stderr of the command.",
+	}
+}
+
+func (GuestFileReadOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "GuestFileReadOptions is provided when reading a file in the guest through the guest agent.",
+		"path": "Path is the absolute path of the file in the guest.",
+	}
+}
+
+func (GuestFile) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "GuestFile is a file in the guest, read or written through the guest agent.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"path":    "Path is the absolute path of the file in the guest.",
+		"content": "Content of the file, at most 1MiB.\n+optional",
+	}
+}
+
 func (ObjectGraphNode) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":         "ObjectGraphNode represents an individual node in the graph.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
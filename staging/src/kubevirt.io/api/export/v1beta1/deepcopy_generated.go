@@ -28,6 +28,22 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchivedVolume) DeepCopyInto(out *ArchivedVolume) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchivedVolume.
+func (in *ArchivedVolume) DeepCopy() *ArchivedVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchivedVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -46,6 +62,75 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportedVolumeChecksum) DeepCopyInto(out *ExportedVolumeChecksum) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportedVolumeChecksum.
+func (in *ExportedVolumeChecksum) DeepCopy() *ExportedVolumeChecksum {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportedVolumeChecksum)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageDestination) DeepCopyInto(out *ObjectStorageDestination) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageDestination.
+func (in *ObjectStorageDestination) DeepCopy() *ObjectStorageDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PeerClusterDestination) DeepCopyInto(out *PeerClusterDestination) {
+	*out = *in
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerClusterDestination.
+func (in *PeerClusterDestination) DeepCopy() *PeerClusterDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(PeerClusterDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicatedVolume) DeepCopyInto(out *ReplicatedVolume) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicatedVolume.
+func (in *ReplicatedVolume) DeepCopy() *ReplicatedVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicatedVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineExport) DeepCopyInto(out *VirtualMachineExport) {
 	*out = *in
@@ -195,6 +280,21 @@ func (in *VirtualMachineExportSpec) DeepCopyInto(out *VirtualMachineExportSpec)
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Formats != nil {
+		in, out := &in.Formats, &out.Formats
+		*out = make([]ExportVolumeFormat, len(*in))
+		copy(*out, *in)
+	}
+	if in.Checksums != nil {
+		in, out := &in.Checksums, &out.Checksums
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(ObjectStorageDestination)
+		**out = **in
+	}
 	return
 }
 
@@ -230,6 +330,16 @@ func (in *VirtualMachineExportStatus) DeepCopyInto(out *VirtualMachineExportStat
 		*out = new(string)
 		**out = **in
 	}
+	if in.VolumeChecksums != nil {
+		in, out := &in.VolumeChecksums, &out.VolumeChecksums
+		*out = make([]ExportedVolumeChecksum, len(*in))
+		copy(*out, *in)
+	}
+	if in.PushedVolumes != nil {
+		in, out := &in.PushedVolumes, &out.PushedVolumes
+		*out = make([]ArchivedVolume, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]Condition, len(*in))
@@ -286,3 +396,246 @@ func (in *VirtualMachineExportVolumeFormat) DeepCopy() *VirtualMachineExportVolu
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotExport) DeepCopyInto(out *VirtualMachineSnapshotExport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(VirtualMachineSnapshotExportStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotExport.
+func (in *VirtualMachineSnapshotExport) DeepCopy() *VirtualMachineSnapshotExport {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSnapshotExport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotExportList) DeepCopyInto(out *VirtualMachineSnapshotExportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineSnapshotExport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotExportList.
+func (in *VirtualMachineSnapshotExportList) DeepCopy() *VirtualMachineSnapshotExportList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotExportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSnapshotExportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotExportSpec) DeepCopyInto(out *VirtualMachineSnapshotExportSpec) {
+	*out = *in
+	out.Destination = in.Destination
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotExportSpec.
+func (in *VirtualMachineSnapshotExportSpec) DeepCopy() *VirtualMachineSnapshotExportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotExportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotExportStatus) DeepCopyInto(out *VirtualMachineSnapshotExportStatus) {
+	*out = *in
+	if in.VirtualMachineName != nil {
+		in, out := &in.VirtualMachineName, &out.VirtualMachineName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]ArchivedVolume, len(*in))
+		copy(*out, *in)
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotExportStatus.
+func (in *VirtualMachineSnapshotExportStatus) DeepCopy() *VirtualMachineSnapshotExportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotExportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotReplication) DeepCopyInto(out *VirtualMachineSnapshotReplication) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(VirtualMachineSnapshotReplicationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotReplication.
+func (in *VirtualMachineSnapshotReplication) DeepCopy() *VirtualMachineSnapshotReplication {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotReplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSnapshotReplication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotReplicationList) DeepCopyInto(out *VirtualMachineSnapshotReplicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineSnapshotReplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotReplicationList.
+func (in *VirtualMachineSnapshotReplicationList) DeepCopy() *VirtualMachineSnapshotReplicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotReplicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSnapshotReplicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotReplicationSpec) DeepCopyInto(out *VirtualMachineSnapshotReplicationSpec) {
+	*out = *in
+	in.Destination.DeepCopyInto(&out.Destination)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotReplicationSpec.
+func (in *VirtualMachineSnapshotReplicationSpec) DeepCopy() *VirtualMachineSnapshotReplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotReplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSnapshotReplicationStatus) DeepCopyInto(out *VirtualMachineSnapshotReplicationStatus) {
+	*out = *in
+	if in.VirtualMachineName != nil {
+		in, out := &in.VirtualMachineName, &out.VirtualMachineName
+		*out = new(string)
+		**out = **in
+	}
+	if in.VirtualMachineExportName != nil {
+		in, out := &in.VirtualMachineExportName, &out.VirtualMachineExportName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]ReplicatedVolume, len(*in))
+		copy(*out, *in)
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSnapshotReplicationStatus.
+func (in *VirtualMachineSnapshotReplicationStatus) DeepCopy() *VirtualMachineSnapshotReplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSnapshotReplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -52,6 +52,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&VirtualMachineExport{},
 		&VirtualMachineExportList{},
+		&VirtualMachineSnapshotExport{},
+		&VirtualMachineSnapshotExportList{},
+		&VirtualMachineSnapshotReplication{},
+		&VirtualMachineSnapshotReplicationList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -67,6 +67,25 @@ type VirtualMachineExportSpec struct {
 	// If this field is omitted, a reasonable default is applied.
 	// +optional
 	TTLDuration *metav1.Duration `json:"ttlDuration,omitempty"`
+
+	// Formats lists the formats the export server converts the exported images to, in addition to the
+	// raw and gzip formats. Supported formats are qcow2, vmdk and ova. The ova format bundles the VM
+	// and is only offered for VirtualMachine and VirtualMachineSnapshot sources.
+	// Images are converted on download, which requires scratch space in the export server pod.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:items:Enum=qcow2;vmdk;ova
+	Formats []ExportVolumeFormat `json:"formats,omitempty"`
+
+	// Checksums makes the export server compute the size and SHA-256 checksum of every exported
+	// disk image before serving the volumes, and publishes them in the status so downloads can be verified.
+	// +optional
+	Checksums *bool `json:"checksums,omitempty"`
+
+	// Destination makes the export server push the volumes to an object storage, using multipart uploads,
+	// instead of serving them over HTTPS. The export terminates once all the volumes are written.
+	// +optional
+	Destination *ObjectStorageDestination `json:"destination,omitempty"`
 }
 
 // VirtualMachineExportPhase is the current phase of the VirtualMachineExport
@@ -110,11 +129,35 @@ type VirtualMachineExportStatus struct {
 	// VirtualMachineSnapshot
 	VirtualMachineName *string `json:"virtualMachineName,omitempty"`
 
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// VolumeChecksums lists the size and checksum of the exported disk images
+	VolumeChecksums []ExportedVolumeChecksum `json:"volumeChecksums,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// PushedVolumes lists the volumes written to the destination object storage
+	PushedVolumes []ArchivedVolume `json:"pushedVolumes,omitempty"`
+
 	// +optional
 	// +listType=atomic
 	Conditions []Condition `json:"conditions,omitempty"`
 }
 
+// ExportedVolumeChecksum is the size and checksum of an exported disk image
+type ExportedVolumeChecksum struct {
+	// Name is the name of the exported volume
+	Name string `json:"name"`
+
+	// Size is the number of bytes of the disk image
+	Size int64 `json:"size"`
+
+	// Checksum is the hex encoded SHA-256 checksum of the disk image
+	Checksum string `json:"checksum"`
+}
+
 // VirtualMachineExportLinks contains the links that point the exported VM resources
 type VirtualMachineExportLinks struct {
 	// +optional
@@ -139,6 +182,10 @@ type VirtualMachineExportLink struct {
 	// +listMapKey=type
 	// +optional
 	Manifests []VirtualMachineExportManifest `json:"manifests,omitempty"`
+
+	// Ova is the url of the OVA bundle of the exported VM
+	// +optional
+	Ova string `json:"ova,omitempty"`
 }
 
 // VirtualMachineExportManifest contains the type and URL of the exported manifest
@@ -180,6 +227,12 @@ const (
 	Dir ExportVolumeFormat = "dir"
 	// ArchiveGz is a tarred and gzipped version of the root of a PersistentVolumeClaim
 	ArchiveGz ExportVolumeFormat = "tar.gz"
+	// Qcow2 is the volume converted to a compressed qcow2 image
+	Qcow2 ExportVolumeFormat = "qcow2"
+	// Vmdk is the volume converted to a stream optimized VMDK image
+	Vmdk ExportVolumeFormat = "vmdk"
+	// Ova is an OVA bundle of the VM, containing an OVF descriptor and the volumes converted to VMDK images
+	Ova ExportVolumeFormat = "ova"
 )
 
 // VirtualMachineExportVolumeFormat contains the format type and URL to get the volume in that format
@@ -222,3 +275,237 @@ type Condition struct {
 	// +optional
 	Message string `json:"message,omitempty"`
 }
+
+// VirtualMachineSnapshotExport defines the operation of archiving the volumes of a
+// VirtualMachineSnapshot to an object storage
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotExport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotExportSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotExportStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotExportList is a list of VirtualMachineSnapshotExport resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotExportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	// +listType=atomic
+	Items []VirtualMachineSnapshotExport `json:"items"`
+}
+
+// VirtualMachineSnapshotExportSpec is the spec for a VirtualMachineSnapshotExport resource
+type VirtualMachineSnapshotExportSpec struct {
+	// VirtualMachineSnapshotName is the name of the VirtualMachineSnapshot whose volumes are archived
+	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName"`
+
+	// Destination is the object storage the volumes are archived to
+	Destination ObjectStorageDestination `json:"destination"`
+}
+
+// ObjectStorageProvider is the kind of object storage a snapshot is archived to
+type ObjectStorageProvider string
+
+const (
+	// ObjectStorageS3 is an Amazon S3 compatible object storage
+	ObjectStorageS3 ObjectStorageProvider = "S3"
+	// ObjectStorageGCS is Google Cloud Storage, accessed through its S3 interoperable XML API
+	ObjectStorageGCS ObjectStorageProvider = "GCS"
+	// ObjectStorageAzureBlob is Azure Blob Storage
+	ObjectStorageAzureBlob ObjectStorageProvider = "AzureBlob"
+)
+
+// ObjectStorageDestination describes the object storage volumes are archived or pushed to
+type ObjectStorageDestination struct {
+	// Provider is the kind of object storage
+	Provider ObjectStorageProvider `json:"provider"`
+
+	// Endpoint is the URL of the object storage service, for example https://s3.us-east-1.amazonaws.com,
+	// https://storage.googleapis.com or https://myaccount.blob.core.windows.net
+	Endpoint string `json:"endpoint"`
+
+	// Bucket is the bucket, or the container for AzureBlob, the volumes are written to
+	Bucket string `json:"bucket"`
+
+	// Prefix is prepended to the names of the archived objects
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Region is the region S3 and GCS requests are signed for, us-east-1 for S3 and auto for GCS when unset
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// CredentialsSecretRef is the name of the secret holding the credentials of the object storage.
+	// S3 and GCS use the accessKeyId and secretAccessKey keys, GCS expecting HMAC keys, while
+	// AzureBlob uses a shared access signature in the sasToken key
+	CredentialsSecretRef string `json:"credentialsSecretRef"`
+}
+
+// VirtualMachineSnapshotExportPhase is the current phase of the VirtualMachineSnapshotExport
+type VirtualMachineSnapshotExportPhase string
+
+const (
+	// SnapshotExportPending means the volumes are not being archived yet
+	SnapshotExportPending VirtualMachineSnapshotExportPhase = "Pending"
+	// SnapshotExportInProgress means the volumes are being archived
+	SnapshotExportInProgress VirtualMachineSnapshotExportPhase = "InProgress"
+	// SnapshotExportSucceeded means all the volumes were archived
+	SnapshotExportSucceeded VirtualMachineSnapshotExportPhase = "Succeeded"
+	// SnapshotExportFailed means the volumes could not be archived
+	SnapshotExportFailed VirtualMachineSnapshotExportPhase = "Failed"
+)
+
+// VirtualMachineSnapshotExportStatus is the status for a VirtualMachineSnapshotExport resource
+type VirtualMachineSnapshotExportStatus struct {
+	// +optional
+	Phase VirtualMachineSnapshotExportPhase `json:"phase,omitempty"`
+
+	// +optional
+	// VirtualMachineName is the name of the virtual machine the snapshot was taken of
+	VirtualMachineName *string `json:"virtualMachineName,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// Volumes lists the archived volumes
+	Volumes []ArchivedVolume `json:"volumes,omitempty"`
+
+	// +optional
+	// CompletionTime is the time the volumes were archived
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// ArchivedVolume describes a volume written to the object storage
+type ArchivedVolume struct {
+	// Name is the name of the volume in the snapshotted virtual machine, or of the exported volume
+	Name string `json:"name"`
+
+	// ObjectName is the name of the object holding the volume data
+	ObjectName string `json:"objectName"`
+
+	// Size is the number of bytes written
+	Size int64 `json:"size"`
+
+	// Checksum is the hex encoded SHA-256 checksum of the object content
+	Checksum string `json:"checksum"`
+}
+
+// VirtualMachineSnapshotReplication defines the operation of replicating a
+// VirtualMachineSnapshot to a peer cluster
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotReplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotReplicationSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotReplicationStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotReplicationList is a list of VirtualMachineSnapshotReplication resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotReplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	// +listType=atomic
+	Items []VirtualMachineSnapshotReplication `json:"items"`
+}
+
+// VirtualMachineSnapshotReplicationSpec is the spec for a VirtualMachineSnapshotReplication resource
+type VirtualMachineSnapshotReplicationSpec struct {
+	// VirtualMachineSnapshotName is the name of the VirtualMachineSnapshot to replicate
+	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName"`
+
+	// Destination is the peer cluster the snapshot is replicated to
+	Destination PeerClusterDestination `json:"destination"`
+}
+
+// PeerClusterDestination describes the peer cluster a snapshot is replicated to
+type PeerClusterDestination struct {
+	// KubeconfigSecretRef is the name of the secret holding, in its kubeconfig key,
+	// the kubeconfig used to access the peer cluster
+	KubeconfigSecretRef string `json:"kubeconfigSecretRef"`
+
+	// Namespace is the namespace of the peer cluster the virtual machine is created in,
+	// the namespace of the replication when unset
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// VirtualMachineName is the name of the virtual machine created in the peer cluster,
+	// the name of the snapshotted virtual machine when unset
+	// +optional
+	VirtualMachineName string `json:"virtualMachineName,omitempty"`
+
+	// StorageClassName is the storage class of the volumes created in the peer cluster,
+	// the default storage class of the peer cluster when unset
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+}
+
+// VirtualMachineSnapshotReplicationPhase is the current phase of the VirtualMachineSnapshotReplication
+type VirtualMachineSnapshotReplicationPhase string
+
+const (
+	// SnapshotReplicationPending means the snapshot is not being replicated yet
+	SnapshotReplicationPending VirtualMachineSnapshotReplicationPhase = "Pending"
+	// SnapshotReplicationExporting means the snapshot is being exported from the local cluster
+	SnapshotReplicationExporting VirtualMachineSnapshotReplicationPhase = "Exporting"
+	// SnapshotReplicationReplicating means the peer cluster is importing the volumes of the snapshot
+	SnapshotReplicationReplicating VirtualMachineSnapshotReplicationPhase = "Replicating"
+	// SnapshotReplicationSucceeded means the snapshot was replicated to the peer cluster
+	SnapshotReplicationSucceeded VirtualMachineSnapshotReplicationPhase = "Succeeded"
+	// SnapshotReplicationFailed means the snapshot could not be replicated
+	SnapshotReplicationFailed VirtualMachineSnapshotReplicationPhase = "Failed"
+)
+
+// VirtualMachineSnapshotReplicationStatus is the status for a VirtualMachineSnapshotReplication resource
+type VirtualMachineSnapshotReplicationStatus struct {
+	// +optional
+	Phase VirtualMachineSnapshotReplicationPhase `json:"phase,omitempty"`
+
+	// +optional
+	// VirtualMachineName is the name of the virtual machine the snapshot was taken of
+	VirtualMachineName *string `json:"virtualMachineName,omitempty"`
+
+	// +optional
+	// VirtualMachineExportName is the name of the VirtualMachineExport serving the volumes to the peer cluster
+	VirtualMachineExportName *string `json:"virtualMachineExportName,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// Volumes lists the volumes imported by the peer cluster
+	Volumes []ReplicatedVolume `json:"volumes,omitempty"`
+
+	// +optional
+	// CompletionTime is the time the snapshot was replicated
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// ReplicatedVolume describes a volume imported by the peer cluster
+type ReplicatedVolume struct {
+	// Name is the name of the volume in the snapshotted virtual machine
+	Name string `json:"name"`
+
+	// DataVolumeName is the name of the DataVolume importing the volume in the peer cluster
+	DataVolumeName string `json:"dataVolumeName"`
+
+	// +optional
+	// Progress is the import progress reported by the DataVolume
+	Progress string `json:"progress,omitempty"`
+}
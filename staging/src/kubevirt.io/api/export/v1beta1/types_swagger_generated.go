@@ -21,6 +21,9 @@ func (VirtualMachineExportSpec) SwaggerDoc() map[string]string {
 		"":               "VirtualMachineExportSpec is the spec for a VirtualMachineExport resource",
 		"tokenSecretRef": "+optional\nTokenSecretRef is the name of the custom-defined secret that contains the token used by the export server pod",
 		"ttlDuration":    "ttlDuration limits the lifetime of an export\nIf this field is set, after this duration has passed from counting from CreationTimestamp,\nthe export is eligible to be automatically deleted.\nIf this field is omitted, a reasonable default is applied.\n+optional",
+		"formats":        "Formats lists the formats the export server converts the exported images to, in addition to the\nraw and gzip formats. Supported formats are qcow2, vmdk and ova. The ova format bundles the VM\nand is only offered for VirtualMachine and VirtualMachineSnapshot sources.\nImages are converted on download, which requires scratch space in the export server pod.\n+optional\n+listType=set\n+kubebuilder:validation:items:Enum=qcow2;vmdk;ova",
+		"checksums":      "Checksums makes the export server compute the size and SHA-256 checksum of every exported\ndisk image before serving the volumes, and publishes them in the status so downloads can be verified.\n+optional",
+		"destination":    "Destination makes the export server push the volumes to an object storage, using multipart uploads,\ninstead of serving them over HTTPS. The export terminates once all the volumes are written.\n+optional",
 	}
 }
 
@@ -33,10 +36,21 @@ func (VirtualMachineExportStatus) SwaggerDoc() map[string]string {
 		"ttlExpirationTime":  "The time at which the VM Export will be completely removed according to specified TTL\nFormula is CreationTimestamp + TTL",
 		"serviceName":        "+optional\nServiceName is the name of the service created associated with the Virtual Machine export. It will be used to\ncreate the internal URLs for downloading the images",
 		"virtualMachineName": "+optional\nVirtualMachineName shows the name of the source virtual machine if the source is either a VirtualMachine or\na VirtualMachineSnapshot. This is mainly to easily identify the source VirtualMachine in case of a\nVirtualMachineSnapshot",
+		"volumeChecksums":    "+optional\n+listType=map\n+listMapKey=name\nVolumeChecksums lists the size and checksum of the exported disk images",
+		"pushedVolumes":      "+optional\n+listType=map\n+listMapKey=name\nPushedVolumes lists the volumes written to the destination object storage",
 		"conditions":         "+optional\n+listType=atomic",
 	}
 }
 
+func (ExportedVolumeChecksum) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "ExportedVolumeChecksum is the size and checksum of an exported disk image",
+		"name":     "Name is the name of the exported volume",
+		"size":     "Size is the number of bytes of the disk image",
+		"checksum": "Checksum is the hex encoded SHA-256 checksum of the disk image",
+	}
+}
+
 func (VirtualMachineExportLinks) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":         "VirtualMachineExportLinks contains the links that point the exported VM resources",
@@ -51,6 +65,7 @@ func (VirtualMachineExportLink) SwaggerDoc() map[string]string {
 		"cert":      "Cert is the public CA certificate base64 encoded",
 		"volumes":   "Volumes is a list of available volumes to export\n+listType=map\n+listMapKey=name\n+optional",
 		"manifests": "Manifests is a list of available manifests for the export\n+listType=map\n+listMapKey=type\n+optional",
+		"ova":       "Ova is the url of the OVA bundle of the exported VM\n+optional",
 	}
 }
 
@@ -87,3 +102,111 @@ func (Condition) SwaggerDoc() map[string]string {
 		"message":            "+optional",
 	}
 }
+
+func (VirtualMachineSnapshotExport) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotExport defines the operation of archiving the volumes of a\nVirtualMachineSnapshot to an object storage\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotExportList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "VirtualMachineSnapshotExportList is a list of VirtualMachineSnapshotExport resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"items": "+listType=atomic",
+	}
+}
+
+func (VirtualMachineSnapshotExportSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                           "VirtualMachineSnapshotExportSpec is the spec for a VirtualMachineSnapshotExport resource",
+		"virtualMachineSnapshotName": "VirtualMachineSnapshotName is the name of the VirtualMachineSnapshot whose volumes are archived",
+		"destination":                "Destination is the object storage the volumes are archived to",
+	}
+}
+
+func (ObjectStorageDestination) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "ObjectStorageDestination describes the object storage volumes are archived or pushed to",
+		"provider":             "Provider is the kind of object storage",
+		"endpoint":             "Endpoint is the URL of the object storage service, for example https://s3.us-east-1.amazonaws.com,\nhttps://storage.googleapis.com or https://myaccount.blob.core.windows.net",
+		"bucket":               "Bucket is the bucket, or the container for AzureBlob, the volumes are written to",
+		"prefix":               "Prefix is prepended to the names of the archived objects\n+optional",
+		"region":               "Region is the region S3 and GCS requests are signed for, us-east-1 for S3 and auto for GCS when unset\n+optional",
+		"credentialsSecretRef": "CredentialsSecretRef is the name of the secret holding the credentials of the object storage.\nS3 and GCS use the accessKeyId and secretAccessKey keys, GCS expecting HMAC keys, while\nAzureBlob uses a shared access signature in the sasToken key",
+	}
+}
+
+func (VirtualMachineSnapshotExportStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "VirtualMachineSnapshotExportStatus is the status for a VirtualMachineSnapshotExport resource",
+		"phase":              "+optional",
+		"virtualMachineName": "+optional\nVirtualMachineName is the name of the virtual machine the snapshot was taken of",
+		"volumes":            "+optional\n+listType=map\n+listMapKey=name\nVolumes lists the archived volumes",
+		"completionTime":     "+optional\nCompletionTime is the time the volumes were archived",
+		"conditions":         "+optional\n+listType=atomic",
+	}
+}
+
+func (ArchivedVolume) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "ArchivedVolume describes a volume written to the object storage",
+		"name":       "Name is the name of the volume in the snapshotted virtual machine, or of the exported volume",
+		"objectName": "ObjectName is the name of the object holding the volume data",
+		"size":       "Size is the number of bytes written",
+		"checksum":   "Checksum is the hex encoded SHA-256 checksum of the object content",
+	}
+}
+
+func (VirtualMachineSnapshotReplication) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotReplication defines the operation of replicating a\nVirtualMachineSnapshot to a peer cluster\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotReplicationList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "VirtualMachineSnapshotReplicationList is a list of VirtualMachineSnapshotReplication resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"items": "+listType=atomic",
+	}
+}
+
+func (VirtualMachineSnapshotReplicationSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                           "VirtualMachineSnapshotReplicationSpec is the spec for a VirtualMachineSnapshotReplication resource",
+		"virtualMachineSnapshotName": "VirtualMachineSnapshotName is the name of the VirtualMachineSnapshot to replicate",
+		"destination":                "Destination is the peer cluster the snapshot is replicated to",
+	}
+}
+
+func (PeerClusterDestination) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "PeerClusterDestination describes the peer cluster a snapshot is replicated to",
+		"kubeconfigSecretRef": "KubeconfigSecretRef is the name of the secret holding, in its kubeconfig key,\nthe kubeconfig used to access the peer cluster",
+		"namespace":           "Namespace is the namespace of the peer cluster the virtual machine is created in,\nthe namespace of the replication when unset\n+optional",
+		"virtualMachineName":  "VirtualMachineName is the name of the virtual machine created in the peer cluster,\nthe name of the snapshotted virtual machine when unset\n+optional",
+		"storageClassName":    "StorageClassName is the storage class of the volumes created in the peer cluster,\nthe default storage class of the peer cluster when unset\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotReplicationStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                         "VirtualMachineSnapshotReplicationStatus is the status for a VirtualMachineSnapshotReplication resource",
+		"phase":                    "+optional",
+		"virtualMachineName":       "+optional\nVirtualMachineName is the name of the virtual machine the snapshot was taken of",
+		"virtualMachineExportName": "+optional\nVirtualMachineExportName is the name of the VirtualMachineExport serving the volumes to the peer cluster",
+		"volumes":                  "+optional\n+listType=map\n+listMapKey=name\nVolumes lists the volumes imported by the peer cluster",
+		"completionTime":           "+optional\nCompletionTime is the time the snapshot was replicated",
+		"conditions":               "+optional\n+listType=atomic",
+	}
+}
+
+func (ReplicatedVolume) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "ReplicatedVolume describes a volume imported by the peer cluster",
+		"name":           "Name is the name of the volume in the snapshotted virtual machine",
+		"dataVolumeName": "DataVolumeName is the name of the DataVolume importing the volume in the peer cluster",
+		"progress":       "+optional\nProgress is the import progress reported by the DataVolume",
+	}
+}
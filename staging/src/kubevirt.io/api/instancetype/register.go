@@ -37,6 +37,12 @@ const (
 
 	ClusterSingularPreferenceResourceName = "virtualmachineclusterpreference"
 	ClusterPluralPreferenceResourceName   = ClusterSingularPreferenceResourceName + "s"
+
+	SingularDefaultResourceName = "virtualmachinedefault"
+	PluralDefaultResourceName   = SingularDefaultResourceName + "s"
+
+	ClusterSingularDefaultResourceName = "virtualmachineclusterdefault"
+	ClusterPluralDefaultResourceName   = ClusterSingularDefaultResourceName + "s"
 )
 
 const (
@@ -53,3 +59,7 @@ const (
 	ControllerRevisionObjectUIDLabel        = "instancetype.kubevirt.io/object-uid"
 	ControllerRevisionObjectVersionLabel    = "instancetype.kubevirt.io/object-version"
 )
+
+// ApprovedGenerationAnnotation is set on an instancetype or preference to the generation VirtualMachines referencing
+// an older ControllerRevision of it should be moved to
+const ApprovedGenerationAnnotation = "instancetype.kubevirt.io/approved-generation"
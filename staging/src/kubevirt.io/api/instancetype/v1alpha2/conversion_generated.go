@@ -331,6 +331,10 @@ func autoConvert_v1beta1_DevicePreferences_To_v1alpha2_DevicePreferences(in *v1b
 	out.PreferredTPM = (*corev1.TPMDevice)(unsafe.Pointer(in.PreferredTPM))
 	// WARNING: in.PreferredInterfaceMasquerade requires manual conversion: does not exist in peer-type
 	// WARNING: in.PreferredPanicDeviceModel requires manual conversion: does not exist in peer-type
+	// WARNING: in.PreferredAutoattachVirtIODrivers requires manual conversion: does not exist in peer-type
+	// WARNING: in.PreferredIOThreadsPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.PreferredInterfaceRxQueueSize requires manual conversion: does not exist in peer-type
+	// WARNING: in.PreferredInterfaceTxQueueSize requires manual conversion: does not exist in peer-type
 	return nil
 }
 
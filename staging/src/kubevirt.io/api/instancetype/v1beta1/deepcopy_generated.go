@@ -24,8 +24,9 @@ Copyright The KubeVirt Authors.
 package v1beta1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
-	v1 "kubevirt.io/api/core/v1"
+	corev1 "kubevirt.io/api/core/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -43,7 +44,7 @@ func (in *CPUInstancetype) DeepCopyInto(out *CPUInstancetype) {
 	}
 	if in.NUMA != nil {
 		in, out := &in.NUMA, &out.NUMA
-		*out = new(v1.NUMA)
+		*out = new(corev1.NUMA)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.IsolateEmulatorThread != nil {
@@ -53,7 +54,7 @@ func (in *CPUInstancetype) DeepCopyInto(out *CPUInstancetype) {
 	}
 	if in.Realtime != nil {
 		in, out := &in.Realtime, &out.Realtime
-		*out = new(v1.Realtime)
+		*out = new(corev1.Realtime)
 		**out = **in
 	}
 	if in.MaxSockets != nil {
@@ -105,7 +106,7 @@ func (in *CPUPreferences) DeepCopyInto(out *CPUPreferences) {
 	}
 	if in.PreferredCPUFeatures != nil {
 		in, out := &in.PreferredCPUFeatures, &out.PreferredCPUFeatures
-		*out = make([]v1.CPUFeature, len(*in))
+		*out = make([]corev1.CPUFeature, len(*in))
 		copy(*out, *in)
 	}
 	return
@@ -126,12 +127,12 @@ func (in *ClockPreferences) DeepCopyInto(out *ClockPreferences) {
 	*out = *in
 	if in.PreferredClockOffset != nil {
 		in, out := &in.PreferredClockOffset, &out.PreferredClockOffset
-		*out = new(v1.ClockOffset)
+		*out = new(corev1.ClockOffset)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PreferredTimer != nil {
 		in, out := &in.PreferredTimer, &out.PreferredTimer
-		*out = new(v1.Timer)
+		*out = new(corev1.Timer)
 		(*in).DeepCopyInto(*out)
 	}
 	return
@@ -147,6 +148,47 @@ func (in *ClockPreferences) DeepCopy() *ClockPreferences {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceDefaults) DeepCopyInto(out *DeviceDefaults) {
+	*out = *in
+	if in.Rng != nil {
+		in, out := &in.Rng, &out.Rng
+		*out = new(corev1.Rng)
+		**out = **in
+	}
+	if in.AutoattachGraphicsDevice != nil {
+		in, out := &in.AutoattachGraphicsDevice, &out.AutoattachGraphicsDevice
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoattachSerialConsole != nil {
+		in, out := &in.AutoattachSerialConsole, &out.AutoattachSerialConsole
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoattachMemBalloon != nil {
+		in, out := &in.AutoattachMemBalloon, &out.AutoattachMemBalloon
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoattachVSOCK != nil {
+		in, out := &in.AutoattachVSOCK, &out.AutoattachVSOCK
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceDefaults.
+func (in *DeviceDefaults) DeepCopy() *DeviceDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DevicePreferences) DeepCopyInto(out *DevicePreferences) {
 	*out = *in
@@ -182,7 +224,7 @@ func (in *DevicePreferences) DeepCopyInto(out *DevicePreferences) {
 	}
 	if in.PreferredVirtualGPUOptions != nil {
 		in, out := &in.PreferredVirtualGPUOptions, &out.PreferredVirtualGPUOptions
-		*out = new(v1.VGPUOptions)
+		*out = new(corev1.VGPUOptions)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PreferredUseVirtioTransitional != nil {
@@ -197,12 +239,12 @@ func (in *DevicePreferences) DeepCopyInto(out *DevicePreferences) {
 	}
 	if in.PreferredDiskBlockSize != nil {
 		in, out := &in.PreferredDiskBlockSize, &out.PreferredDiskBlockSize
-		*out = new(v1.BlockSize)
+		*out = new(corev1.BlockSize)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PreferredRng != nil {
 		in, out := &in.PreferredRng, &out.PreferredRng
-		*out = new(v1.Rng)
+		*out = new(corev1.Rng)
 		**out = **in
 	}
 	if in.PreferredBlockMultiQueue != nil {
@@ -217,17 +259,37 @@ func (in *DevicePreferences) DeepCopyInto(out *DevicePreferences) {
 	}
 	if in.PreferredTPM != nil {
 		in, out := &in.PreferredTPM, &out.PreferredTPM
-		*out = new(v1.TPMDevice)
+		*out = new(corev1.TPMDevice)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PreferredInterfaceMasquerade != nil {
 		in, out := &in.PreferredInterfaceMasquerade, &out.PreferredInterfaceMasquerade
-		*out = new(v1.InterfaceMasquerade)
+		*out = new(corev1.InterfaceMasquerade)
 		**out = **in
 	}
 	if in.PreferredPanicDeviceModel != nil {
 		in, out := &in.PreferredPanicDeviceModel, &out.PreferredPanicDeviceModel
-		*out = new(v1.PanicDeviceModel)
+		*out = new(corev1.PanicDeviceModel)
+		**out = **in
+	}
+	if in.PreferredAutoattachVirtIODrivers != nil {
+		in, out := &in.PreferredAutoattachVirtIODrivers, &out.PreferredAutoattachVirtIODrivers
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PreferredIOThreadsPolicy != nil {
+		in, out := &in.PreferredIOThreadsPolicy, &out.PreferredIOThreadsPolicy
+		*out = new(corev1.IOThreadsPolicy)
+		**out = **in
+	}
+	if in.PreferredInterfaceRxQueueSize != nil {
+		in, out := &in.PreferredInterfaceRxQueueSize, &out.PreferredInterfaceRxQueueSize
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.PreferredInterfaceTxQueueSize != nil {
+		in, out := &in.PreferredInterfaceTxQueueSize, &out.PreferredInterfaceTxQueueSize
+		*out = new(uint32)
 		**out = **in
 	}
 	return
@@ -248,32 +310,32 @@ func (in *FeaturePreferences) DeepCopyInto(out *FeaturePreferences) {
 	*out = *in
 	if in.PreferredAcpi != nil {
 		in, out := &in.PreferredAcpi, &out.PreferredAcpi
-		*out = new(v1.FeatureState)
+		*out = new(corev1.FeatureState)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PreferredApic != nil {
 		in, out := &in.PreferredApic, &out.PreferredApic
-		*out = new(v1.FeatureAPIC)
+		*out = new(corev1.FeatureAPIC)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PreferredHyperv != nil {
 		in, out := &in.PreferredHyperv, &out.PreferredHyperv
-		*out = new(v1.FeatureHyperv)
+		*out = new(corev1.FeatureHyperv)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PreferredKvm != nil {
 		in, out := &in.PreferredKvm, &out.PreferredKvm
-		*out = new(v1.FeatureKVM)
+		*out = new(corev1.FeatureKVM)
 		**out = **in
 	}
 	if in.PreferredPvspinlock != nil {
 		in, out := &in.PreferredPvspinlock, &out.PreferredPvspinlock
-		*out = new(v1.FeatureState)
+		*out = new(corev1.FeatureState)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PreferredSmm != nil {
 		in, out := &in.PreferredSmm, &out.PreferredSmm
-		*out = new(v1.FeatureState)
+		*out = new(corev1.FeatureState)
 		(*in).DeepCopyInto(*out)
 	}
 	return
@@ -289,6 +351,27 @@ func (in *FeaturePreferences) DeepCopy() *FeaturePreferences {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirmwareDefaults) DeepCopyInto(out *FirmwareDefaults) {
+	*out = *in
+	if in.Bootloader != nil {
+		in, out := &in.Bootloader, &out.Bootloader
+		*out = new(corev1.Bootloader)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirmwareDefaults.
+func (in *FirmwareDefaults) DeepCopy() *FirmwareDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(FirmwareDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FirmwarePreferences) DeepCopyInto(out *FirmwarePreferences) {
 	*out = *in
@@ -314,7 +397,7 @@ func (in *FirmwarePreferences) DeepCopyInto(out *FirmwarePreferences) {
 	}
 	if in.PreferredEfi != nil {
 		in, out := &in.PreferredEfi, &out.PreferredEfi
-		*out = new(v1.EFI)
+		*out = new(corev1.EFI)
 		(*in).DeepCopyInto(*out)
 	}
 	return
@@ -352,8 +435,8 @@ func (in *MemoryInstancetype) DeepCopyInto(out *MemoryInstancetype) {
 	out.Guest = in.Guest.DeepCopy()
 	if in.Hugepages != nil {
 		in, out := &in.Hugepages, &out.Hugepages
-		*out = new(v1.Hugepages)
-		**out = **in
+		*out = new(corev1.Hugepages)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.MaxGuest != nil {
 		in, out := &in.MaxGuest, &out.MaxGuest
@@ -442,6 +525,66 @@ func (in *SpreadOptions) DeepCopy() *SpreadOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineClusterDefault) DeepCopyInto(out *VirtualMachineClusterDefault) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClusterDefault.
+func (in *VirtualMachineClusterDefault) DeepCopy() *VirtualMachineClusterDefault {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineClusterDefault)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineClusterDefault) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineClusterDefaultList) DeepCopyInto(out *VirtualMachineClusterDefaultList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineClusterDefault, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClusterDefaultList.
+func (in *VirtualMachineClusterDefaultList) DeepCopy() *VirtualMachineClusterDefaultList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineClusterDefaultList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineClusterDefaultList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineClusterInstancetype) DeepCopyInto(out *VirtualMachineClusterInstancetype) {
 	*out = *in
@@ -562,6 +705,102 @@ func (in *VirtualMachineClusterPreferenceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDefault) DeepCopyInto(out *VirtualMachineDefault) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDefault.
+func (in *VirtualMachineDefault) DeepCopy() *VirtualMachineDefault {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDefault)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineDefault) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDefaultList) DeepCopyInto(out *VirtualMachineDefaultList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineDefault, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDefaultList.
+func (in *VirtualMachineDefaultList) DeepCopy() *VirtualMachineDefaultList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDefaultList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineDefaultList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDefaultSpec) DeepCopyInto(out *VirtualMachineDefaultSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RunStrategy != nil {
+		in, out := &in.RunStrategy, &out.RunStrategy
+		*out = new(corev1.VirtualMachineRunStrategy)
+		**out = **in
+	}
+	if in.Firmware != nil {
+		in, out := &in.Firmware, &out.Firmware
+		*out = new(FirmwareDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = new(DeviceDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDefaultSpec.
+func (in *VirtualMachineDefaultSpec) DeepCopy() *VirtualMachineDefaultSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDefaultSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstancetype) DeepCopyInto(out *VirtualMachineInstancetype) {
 	*out = *in
@@ -636,26 +875,26 @@ func (in *VirtualMachineInstancetypeSpec) DeepCopyInto(out *VirtualMachineInstan
 	in.Memory.DeepCopyInto(&out.Memory)
 	if in.GPUs != nil {
 		in, out := &in.GPUs, &out.GPUs
-		*out = make([]v1.GPU, len(*in))
+		*out = make([]corev1.GPU, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.HostDevices != nil {
 		in, out := &in.HostDevices, &out.HostDevices
-		*out = make([]v1.HostDevice, len(*in))
+		*out = make([]corev1.HostDevice, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.IOThreadsPolicy != nil {
 		in, out := &in.IOThreadsPolicy, &out.IOThreadsPolicy
-		*out = new(v1.IOThreadsPolicy)
+		*out = new(corev1.IOThreadsPolicy)
 		**out = **in
 	}
 	if in.LaunchSecurity != nil {
 		in, out := &in.LaunchSecurity, &out.LaunchSecurity
-		*out = new(v1.LaunchSecurity)
+		*out = new(corev1.LaunchSecurity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Annotations != nil {
@@ -58,6 +58,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&VirtualMachinePreferenceList{},
 		&VirtualMachineClusterPreference{},
 		&VirtualMachineClusterPreferenceList{},
+		&VirtualMachineDefault{},
+		&VirtualMachineDefaultList{},
+		&VirtualMachineClusterDefault{},
+		&VirtualMachineClusterDefaultList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
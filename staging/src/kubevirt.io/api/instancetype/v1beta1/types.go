@@ -524,6 +524,26 @@ type DevicePreferences struct {
 	//
 	// +optional
 	PreferredPanicDeviceModel *v1.PanicDeviceModel `json:"preferredPanicDeviceModel,omitempty"`
+
+	// PreferredAutoattachVirtIODrivers optionally defines the preferred value of AutoattachVirtIODrivers
+	//
+	// +optional
+	PreferredAutoattachVirtIODrivers *bool `json:"preferredAutoattachVirtIODrivers,omitempty"`
+
+	// PreferredIOThreadsPolicy optionally defines the preferred IOThreadsPolicy to be used by the VirtualMachine.
+	//
+	// +optional
+	PreferredIOThreadsPolicy *v1.IOThreadsPolicy `json:"preferredIOThreadsPolicy,omitempty"`
+
+	// PreferredInterfaceRxQueueSize optionally defines the preferred receive queue size of virtio Interface devices.
+	//
+	// +optional
+	PreferredInterfaceRxQueueSize *uint32 `json:"preferredInterfaceRxQueueSize,omitempty"`
+
+	// PreferredInterfaceTxQueueSize optionally defines the preferred transmit queue size of virtio Interface devices.
+	//
+	// +optional
+	PreferredInterfaceTxQueueSize *uint32 `json:"preferredInterfaceTxQueueSize,omitempty"`
 }
 
 // FeaturePreferences contains various optional defaults for Features.
@@ -642,3 +662,123 @@ type MemoryPreferenceRequirement struct {
 	// Minimal amount of memory required by the preference.
 	Guest resource.Quantity `json:"guest"`
 }
+
+// VirtualMachineDefault resource contains defaults applied to the VirtualMachines created in its namespace.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+type VirtualMachineDefault struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Required spec describing the defaults
+	Spec VirtualMachineDefaultSpec `json:"spec"`
+}
+
+// VirtualMachineDefaultList is a list of VirtualMachineDefault resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineDefaultList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineDefault `json:"items"`
+}
+
+// VirtualMachineClusterDefault is a cluster scoped version of VirtualMachineDefault resource, applied to the VirtualMachines of all namespaces.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+// +genclient:nonNamespaced
+type VirtualMachineClusterDefault struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Required spec describing the defaults
+	Spec VirtualMachineDefaultSpec `json:"spec"`
+}
+
+// VirtualMachineClusterDefaultList is a list of VirtualMachineClusterDefault resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineClusterDefaultList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineClusterDefault `json:"items"`
+}
+
+// VirtualMachineDefaultSpec is a description of the VirtualMachineDefault or VirtualMachineClusterDefault.
+//
+// The defaults are applied when a VirtualMachine is created, only to the attributes the VirtualMachine and its preference do not set.
+type VirtualMachineDefaultSpec struct {
+
+	// Selector optionally restricts the defaults to the VirtualMachines with matching labels.
+	// The defaults apply to all VirtualMachines when it is not set.
+	//
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// RunStrategy optionally defines the RunStrategy of the VirtualMachines setting neither runStrategy nor running.
+	//
+	// +optional
+	RunStrategy *v1.VirtualMachineRunStrategy `json:"runStrategy,omitempty"`
+
+	// PodNetworkBinding optionally defines the binding of the interface attached to the pod network of the
+	// VirtualMachines without interfaces and networks.
+	// Supported values are bridge, masquerade and the name of a network binding plugin registered in the KubeVirt CR.
+	//
+	// +optional
+	PodNetworkBinding string `json:"podNetworkBinding,omitempty"`
+
+	// MachineType optionally defines the machine type of the VirtualMachines.
+	//
+	// +optional
+	MachineType string `json:"machineType,omitempty"`
+
+	// Firmware optionally defines defaults for the firmware of the VirtualMachines.
+	//
+	// +optional
+	Firmware *FirmwareDefaults `json:"firmware,omitempty"`
+
+	// Devices optionally defines defaults for the devices of the VirtualMachines.
+	//
+	// +optional
+	Devices *DeviceDefaults `json:"devices,omitempty"`
+}
+
+// FirmwareDefaults contains various optional defaults for Firmware.
+type FirmwareDefaults struct {
+
+	// Bootloader optionally defines the bootloader, BIOS or EFI, of the VirtualMachines.
+	//
+	// +optional
+	Bootloader *v1.Bootloader `json:"bootloader,omitempty"`
+}
+
+// DeviceDefaults contains various optional defaults for Devices.
+type DeviceDefaults struct {
+
+	// Rng optionally attaches a random number generator device.
+	//
+	// +optional
+	Rng *v1.Rng `json:"rng,omitempty"`
+
+	// AutoattachGraphicsDevice optionally defines the value of AutoattachGraphicsDevice
+	//
+	// +optional
+	AutoattachGraphicsDevice *bool `json:"autoattachGraphicsDevice,omitempty"`
+
+	// AutoattachSerialConsole optionally defines the value of AutoattachSerialConsole
+	//
+	// +optional
+	AutoattachSerialConsole *bool `json:"autoattachSerialConsole,omitempty"`
+
+	// AutoattachMemBalloon optionally defines the value of AutoattachMemBalloon
+	//
+	// +optional
+	AutoattachMemBalloon *bool `json:"autoattachMemBalloon,omitempty"`
+
+	// AutoattachVSOCK optionally defines the value of AutoattachVSOCK, e.g. to attach the vsock device used by guest agents
+	//
+	// +optional
+	AutoattachVSOCK *bool `json:"autoattachVSOCK,omitempty"`
+}
@@ -162,6 +162,10 @@ func (DevicePreferences) SwaggerDoc() map[string]string {
 		"preferredTPM":                        "PreferredTPM optionally defines the preferred TPM device to be used.\n\n+optional",
 		"preferredInterfaceMasquerade":        "PreferredInterfaceMasquerade optionally defines the preferred masquerade configuration to use with each network interface.\n\n+optional",
 		"preferredPanicDeviceModel":           "PreferredPanicDeviceModel optionally defines the preferred panic device model to use with panic devices.\n\n+optional",
+		"preferredAutoattachVirtIODrivers":    "PreferredAutoattachVirtIODrivers optionally defines the preferred value of AutoattachVirtIODrivers\n\n+optional",
+		"preferredIOThreadsPolicy":            "PreferredIOThreadsPolicy optionally defines the preferred IOThreadsPolicy to be used by the VirtualMachine.\n\n+optional",
+		"preferredInterfaceRxQueueSize":       "PreferredInterfaceRxQueueSize optionally defines the preferred receive queue size of virtio Interface devices.\n\n+optional",
+		"preferredInterfaceTxQueueSize":       "PreferredInterfaceTxQueueSize optionally defines the preferred transmit queue size of virtio Interface devices.\n\n+optional",
 	}
 }
 
@@ -221,3 +225,59 @@ func (MemoryPreferenceRequirement) SwaggerDoc() map[string]string {
 		"guest": "Minimal amount of memory required by the preference.",
 	}
 }
+
+func (VirtualMachineDefault) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "VirtualMachineDefault resource contains defaults applied to the VirtualMachines created in its namespace.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+genclient",
+		"spec": "Required spec describing the defaults",
+	}
+}
+
+func (VirtualMachineDefaultList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineDefaultList is a list of VirtualMachineDefault resources.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineClusterDefault) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "VirtualMachineClusterDefault is a cluster scoped version of VirtualMachineDefault resource, applied to the VirtualMachines of all namespaces.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+genclient\n+genclient:nonNamespaced",
+		"spec": "Required spec describing the defaults",
+	}
+}
+
+func (VirtualMachineClusterDefaultList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineClusterDefaultList is a list of VirtualMachineClusterDefault resources.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineDefaultSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "VirtualMachineDefaultSpec is a description of the VirtualMachineDefault or VirtualMachineClusterDefault.\n\nThe defaults are applied when a VirtualMachine is created, only to the attributes the VirtualMachine and its preference do not set.",
+		"selector":          "Selector optionally restricts the defaults to the VirtualMachines with matching labels.\nThe defaults apply to all VirtualMachines when it is not set.\n\n+optional",
+		"runStrategy":       "RunStrategy optionally defines the RunStrategy of the VirtualMachines setting neither runStrategy nor running.\n\n+optional",
+		"podNetworkBinding": "PodNetworkBinding optionally defines the binding of the interface attached to the pod network of the\nVirtualMachines without interfaces and networks.\nSupported values are bridge, masquerade and the name of a network binding plugin registered in the KubeVirt CR.\n\n+optional",
+		"machineType":       "MachineType optionally defines the machine type of the VirtualMachines.\n\n+optional",
+		"firmware":          "Firmware optionally defines defaults for the firmware of the VirtualMachines.\n\n+optional",
+		"devices":           "Devices optionally defines defaults for the devices of the VirtualMachines.\n\n+optional",
+	}
+}
+
+func (FirmwareDefaults) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "FirmwareDefaults contains various optional defaults for Firmware.",
+		"bootloader": "Bootloader optionally defines the bootloader, BIOS or EFI, of the VirtualMachines.\n\n+optional",
+	}
+}
+
+func (DeviceDefaults) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                         "DeviceDefaults contains various optional defaults for Devices.",
+		"rng":                      "Rng optionally attaches a random number generator device.\n\n+optional",
+		"autoattachGraphicsDevice": "AutoattachGraphicsDevice optionally defines the value of AutoattachGraphicsDevice\n\n+optional",
+		"autoattachSerialConsole":  "AutoattachSerialConsole optionally defines the value of AutoattachSerialConsole\n\n+optional",
+		"autoattachMemBalloon":     "AutoattachMemBalloon optionally defines the value of AutoattachMemBalloon\n\n+optional",
+		"autoattachVSOCK":          "AutoattachVSOCK optionally defines the value of AutoattachVSOCK, e.g. to attach the vsock device used by guest agents\n\n+optional",
+	}
+}
@@ -24,5 +24,9 @@ const (
 	GroupName = "migrations.kubevirt.io"
 	Version   = "v1alpha1"
 
-	ResourceMigrationPolicies = "migrationpolicies"
+	ResourceMigrationPolicies          = "migrationpolicies"
+	ResourceVolumeMigrations           = "volumemigrations"
+	ResourceMigrationRetryBudgets      = "migrationretrybudgets"
+	ResourceMigrationRebalancePolicies = "migrationrebalancepolicies"
+	ResourceVMDisruptionBudgets        = "virtualmachinedisruptionbudgets"
 )
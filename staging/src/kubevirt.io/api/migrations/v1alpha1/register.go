@@ -35,8 +35,16 @@ var (
 	GroupVersion = schema.GroupVersion{Group: migrations.GroupName, Version: migrations.Version}
 
 	// GroupVersionKind
-	MigrationPolicyKind     = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "MigrationPolicy"}
-	MigrationPolicyListKind = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "MigrationPolicyList"}
+	MigrationPolicyKind                    = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "MigrationPolicy"}
+	MigrationPolicyListKind                = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "MigrationPolicyList"}
+	VolumeMigrationKind                    = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "VolumeMigration"}
+	VolumeMigrationListKind                = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "VolumeMigrationList"}
+	MigrationRetryBudgetKind               = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "MigrationRetryBudget"}
+	MigrationRetryBudgetListKind           = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "MigrationRetryBudgetList"}
+	MigrationRebalancePolicyKind           = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "MigrationRebalancePolicy"}
+	MigrationRebalancePolicyListKind       = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "MigrationRebalancePolicyList"}
+	VirtualMachineDisruptionBudgetKind     = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "VirtualMachineDisruptionBudget"}
+	VirtualMachineDisruptionBudgetListKind = schema.GroupVersionKind{Group: migrations.GroupName, Version: migrations.Version, Kind: "VirtualMachineDisruptionBudgetList"}
 )
 
 // Kind takes an unqualified kind and returns back a Group qualified GroupKind
@@ -60,7 +68,15 @@ var (
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&MigrationPolicy{},
-		&MigrationPolicyList{})
+		&MigrationPolicyList{},
+		&VolumeMigration{},
+		&VolumeMigrationList{},
+		&MigrationRetryBudget{},
+		&MigrationRetryBudgetList{},
+		&MigrationRebalancePolicy{},
+		&MigrationRebalancePolicyList{},
+		&VirtualMachineDisruptionBudget{},
+		&VirtualMachineDisruptionBudgetList{})
 
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
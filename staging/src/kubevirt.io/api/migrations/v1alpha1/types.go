@@ -22,6 +22,7 @@ package v1alpha1
 import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	k6tv1 "kubevirt.io/api/core/v1"
 )
@@ -53,6 +54,37 @@ type MigrationPolicySpec struct {
 	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
 	//+optional
 	AllowWorkloadDisruption *bool `json:"allowWorkloadDisruption,omitempty"`
+	// BandwidthSchedule overrides bandwidthPerMigration while one of its windows is active
+	// at the time the migration is configured.
+	//+optional
+	BandwidthSchedule *BandwidthSchedule `json:"bandwidthSchedule,omitempty"`
+	// Network is the name of the CNI network the migrations go through. It has to be the migration
+	// network or one of the additional migration networks of the cluster configuration.
+	//+optional
+	Network *string `json:"network,omitempty"`
+}
+
+type BandwidthSchedule struct {
+	// TimeZone is the IANA time zone name in which the windows are evaluated. Defaults to UTC.
+	//+optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// Windows are evaluated in order and the first active window is applied.
+	// +listType=atomic
+	Windows []BandwidthWindow `json:"windows"`
+}
+
+type BandwidthWindow struct {
+	// Start of the window in 24-hour HH:MM format.
+	Start string `json:"start"`
+	// End of the window in 24-hour HH:MM format. If end is before start, the window spans midnight.
+	// If end equals start, the window lasts the whole day.
+	End string `json:"end"`
+	// Days of the week (e.g. Monday) on which the window starts. Defaults to every day.
+	// +listType=set
+	//+optional
+	Days []string `json:"days,omitempty"`
+	// BandwidthPerMigration to apply while the window is active. Zero means unlimited.
+	BandwidthPerMigration resource.Quantity `json:"bandwidthPerMigration"`
 }
 
 type LabelSelector map[string]string
@@ -109,6 +141,263 @@ func (m *MigrationPolicy) GetMigrationConfByPolicy(clusterMigrationConfiguration
 		// value of AllowPostCopy, if not explicitly set
 		*clusterMigrationConfigurations.AllowWorkloadDisruption = *policySpec.AllowPostCopy
 	}
+	if policySpec.Network != nil {
+		changed = true
+		network := *policySpec.Network
+		clusterMigrationConfigurations.Network = &network
+	}
 
 	return changed, nil
 }
+
+// VolumeMigration moves the volumes of a running virtual machine to another storage class,
+// without stopping the virtual machine
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+type VolumeMigration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              VolumeMigrationSpec `json:"spec" valid:"required"`
+	// +optional
+	Status *VolumeMigrationStatus `json:"status,omitempty"`
+}
+
+// VolumeMigrationSpec is the spec for a VolumeMigration resource
+type VolumeMigrationSpec struct {
+	// VirtualMachineName is the name of the virtual machine whose volumes are migrated
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	// StorageClassName is the storage class of the PersistentVolumeClaims the volumes are migrated to
+	StorageClassName string `json:"storageClassName"`
+
+	// +optional
+	// +listType=set
+	// Volumes are the names of the volumes to migrate. All the volumes backed by a PersistentVolumeClaim
+	// or a DataVolume are migrated when empty.
+	Volumes []string `json:"volumes,omitempty"`
+}
+
+// VolumeMigrationPhase is the current phase of a VolumeMigration
+type VolumeMigrationPhase string
+
+const (
+	VolumeMigrationPending    VolumeMigrationPhase = "Pending"
+	VolumeMigrationInProgress VolumeMigrationPhase = "InProgress"
+	VolumeMigrationSucceeded  VolumeMigrationPhase = "Succeeded"
+	VolumeMigrationFailed     VolumeMigrationPhase = "Failed"
+)
+
+// VolumeMigrationStatus is the status for a VolumeMigration resource
+type VolumeMigrationStatus struct {
+	// +optional
+	Phase VolumeMigrationPhase `json:"phase,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// Volumes lists the migrated volumes
+	Volumes []MigratedVolume `json:"volumes,omitempty"`
+
+	// +optional
+	// CompletionTime is the time the migration succeeded or failed
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// MigratedVolume describes the PersistentVolumeClaims a volume is migrated between
+type MigratedVolume struct {
+	// Name is the name of the volume in the virtual machine
+	Name string `json:"name"`
+	// SourceClaimName is the name of the PersistentVolumeClaim the volume is migrated from
+	SourceClaimName string `json:"sourceClaimName"`
+	// DestinationClaimName is the name of the PersistentVolumeClaim the volume is migrated to
+	DestinationClaimName string `json:"destinationClaimName"`
+}
+
+// VolumeMigrationList is a list of VolumeMigration resources
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VolumeMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []VolumeMigration `json:"items"`
+}
+
+// MigrationRetryBudget limits how many failed migrations of the selected virtual machine instances
+// are retried within a time window, like a PodDisruptionBudget limits evictions. Once more migrations
+// failed within the window, the migrations are not retried anymore.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+type MigrationRetryBudget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              MigrationRetryBudgetSpec `json:"spec" valid:"required"`
+}
+
+// MigrationRetryBudgetSpec is the spec for a MigrationRetryBudget resource
+type MigrationRetryBudgetSpec struct {
+	// Selector selects the virtual machine instances the budget applies to by their labels.
+	// All the virtual machine instances of the namespace are selected when empty.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// MaxFailures is the number of failed migrations of the selected virtual machine instances
+	// which are tolerated within the window
+	MaxFailures uint32 `json:"maxFailures"`
+
+	// Window is the period failed migrations are counted over. Defaults to 1h.
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty"`
+}
+
+// MigrationRetryBudgetList is a list of MigrationRetryBudget resources
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type MigrationRetryBudgetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []MigrationRetryBudget `json:"items"`
+}
+
+// MigrationRebalancePolicy live migrates virtual machine instances off nodes whose utilization exceeds
+// the thresholds of the policy, to nodes below them, keeping the load of the nodes balanced
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+// +genclient:nonNamespaced
+type MigrationRebalancePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              MigrationRebalancePolicySpec `json:"spec" valid:"required"`
+	// +optional
+	Status MigrationRebalancePolicyStatus `json:"status,omitempty"`
+}
+
+// MigrationRebalancePolicySpec is the spec for a MigrationRebalancePolicy resource
+type MigrationRebalancePolicySpec struct {
+	// NodeSelector selects the nodes which are rebalanced by their labels.
+	// All the nodes are selected when empty.
+	// +optional
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+
+	// CPUThresholdPercentage is the CPU usage of a node, in percent of its allocatable CPU,
+	// above which virtual machine instances are migrated off the node. Defaults to 80.
+	// +optional
+	CPUThresholdPercentage *uint32 `json:"cpuThresholdPercentage,omitempty"`
+
+	// MemoryThresholdPercentage is the memory usage of a node, in percent of its allocatable memory,
+	// above which virtual machine instances are migrated off the node. Defaults to 80.
+	// +optional
+	MemoryThresholdPercentage *uint32 `json:"memoryThresholdPercentage,omitempty"`
+
+	// ExcludeLabels are label keys of virtual machine instances which are never migrated by the policy
+	// +optional
+	// +listType=set
+	ExcludeLabels []string `json:"excludeLabels,omitempty"`
+
+	// MaxConcurrentMigrations is the number of migrations started by the policy which may be in progress
+	// at the same time. Defaults to 1.
+	// +optional
+	MaxConcurrentMigrations *uint32 `json:"maxConcurrentMigrations,omitempty"`
+
+	// Interval is the minimum time between two migrations started by the policy. Defaults to 5m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// MigrationRebalancePolicyStatus is the status for a MigrationRebalancePolicy resource
+type MigrationRebalancePolicyStatus struct {
+	// HotNodes are the selected nodes whose utilization exceeds a threshold of the policy
+	// +optional
+	// +listType=set
+	HotNodes []string `json:"hotNodes,omitempty"`
+
+	// LastMigrationTime is the time the policy last started a migration
+	// +optional
+	LastMigrationTime *metav1.Time `json:"lastMigrationTime,omitempty"`
+}
+
+// MigrationRebalancePolicyList is a list of MigrationRebalancePolicy resources
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type MigrationRebalancePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []MigrationRebalancePolicy `json:"items"`
+}
+
+// VirtualMachineDisruptionBudget limits the number of VirtualMachineInstances of a group which
+// KubeVirt disrupts at the same time through voluntary disruptions, the migrations and shutdowns
+// of the node evacuations and of the workload updates.
+// Unlike a PodDisruptionBudget on the virt-launcher pods, a VirtualMachineInstance which is live
+// migrated is accounted as disrupted, and the budget is consulted before the disruption starts.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+type VirtualMachineDisruptionBudget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineDisruptionBudgetSpec   `json:"spec" valid:"required"`
+	Status VirtualMachineDisruptionBudgetStatus `json:"status,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachineDisruptionBudgetSpec struct {
+	// Selector selects the VirtualMachineInstances of the namespace the budget applies to by their labels.
+	Selector *metav1.LabelSelector `json:"selector" valid:"required"`
+
+	// MaxUnavailable is the number or percentage of the selected VirtualMachineInstances which can be
+	// disrupted or unavailable at the same time. Percentages are rounded up. Defaults to 1.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachineDisruptionBudgetStatus struct {
+	// ObservedGeneration is the generation of the budget the status was computed for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// ExpectedVirtualMachineInstances is the number of VirtualMachineInstances selected by the budget.
+	ExpectedVirtualMachineInstances int32 `json:"expectedVirtualMachineInstances"`
+
+	// CurrentHealthy is the number of selected VirtualMachineInstances which run and are not disrupted.
+	CurrentHealthy int32 `json:"currentHealthy"`
+
+	// DisruptionsAllowed is the number of selected VirtualMachineInstances which can be disrupted now.
+	DisruptionsAllowed int32 `json:"disruptionsAllowed"`
+
+	// DisruptedVirtualMachineInstances are the VirtualMachineInstances whose disruption was granted
+	// but is not observed yet, with the time it was granted at. An entry is removed once the
+	// disruption is observed, or after two minutes when it doesn't happen.
+	// +optional
+	DisruptedVirtualMachineInstances map[string]metav1.Time `json:"disruptedVirtualMachineInstances,omitempty"`
+}
+
+// VirtualMachineDisruptionBudgetList is a list of VirtualMachineDisruptionBudget resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+type VirtualMachineDisruptionBudgetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []VirtualMachineDisruptionBudget `json:"items"`
+}
@@ -16,6 +16,24 @@ func (MigrationPolicySpec) SwaggerDoc() map[string]string {
 		"completionTimeoutPerGiB": "+optional",
 		"allowPostCopy":           "+optional",
 		"allowWorkloadDisruption": "+optional",
+		"bandwidthSchedule":       "BandwidthSchedule overrides bandwidthPerMigration while one of its windows is active\nat the time the migration is configured.\n+optional",
+		"network":                 "Network is the name of the CNI network the migrations go through. It has to be the migration\nnetwork or one of the additional migration networks of the cluster configuration.\n+optional",
+	}
+}
+
+func (BandwidthSchedule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"timeZone": "TimeZone is the IANA time zone name in which the windows are evaluated. Defaults to UTC.\n+optional",
+		"windows":  "Windows are evaluated in order and the first active window is applied.\n+listType=atomic",
+	}
+}
+
+func (BandwidthWindow) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"start":                 "Start of the window in 24-hour HH:MM format.",
+		"end":                   "End of the window in 24-hour HH:MM format. If end is before start, the window spans midnight.\nIf end equals start, the window lasts the whole day.",
+		"days":                  "Days of the week (e.g. Monday) on which the window starts. Defaults to every day.\n+listType=set\n+optional",
+		"bandwidthPerMigration": "BandwidthPerMigration to apply while the window is active. Zero means unlimited.",
 	}
 }
 
@@ -36,3 +54,133 @@ func (MigrationPolicyList) SwaggerDoc() map[string]string {
 		"items": "+listType=atomic",
 	}
 }
+
+func (VolumeMigration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VolumeMigration moves the volumes of a running virtual machine to another storage class,\nwithout stopping the virtual machine\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true\n+genclient",
+		"status": "+optional",
+	}
+}
+
+func (VolumeMigrationSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "VolumeMigrationSpec is the spec for a VolumeMigration resource",
+		"virtualMachineName": "VirtualMachineName is the name of the virtual machine whose volumes are migrated",
+		"storageClassName":   "StorageClassName is the storage class of the PersistentVolumeClaims the volumes are migrated to",
+		"volumes":            "+optional\n+listType=set\nVolumes are the names of the volumes to migrate. All the volumes backed by a PersistentVolumeClaim\nor a DataVolume are migrated when empty.",
+	}
+}
+
+func (VolumeMigrationStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VolumeMigrationStatus is the status for a VolumeMigration resource",
+		"phase":          "+optional",
+		"volumes":        "+optional\n+listType=map\n+listMapKey=name\nVolumes lists the migrated volumes",
+		"completionTime": "+optional\nCompletionTime is the time the migration succeeded or failed",
+		"conditions":     "+optional\n+listType=map\n+listMapKey=type",
+	}
+}
+
+func (MigratedVolume) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "MigratedVolume describes the PersistentVolumeClaims a volume is migrated between",
+		"name":                 "Name is the name of the volume in the virtual machine",
+		"sourceClaimName":      "SourceClaimName is the name of the PersistentVolumeClaim the volume is migrated from",
+		"destinationClaimName": "DestinationClaimName is the name of the PersistentVolumeClaim the volume is migrated to",
+	}
+}
+
+func (VolumeMigrationList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "VolumeMigrationList is a list of VolumeMigration resources\n\n+k8s:openapi-gen=true\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"items": "+listType=atomic",
+	}
+}
+
+func (MigrationRetryBudget) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "MigrationRetryBudget limits how many failed migrations of the selected virtual machine instances\nare retried within a time window, like a PodDisruptionBudget limits evictions. Once more migrations\nfailed within the window, the migrations are not retried anymore.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true\n+genclient",
+	}
+}
+
+func (MigrationRetryBudgetSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "MigrationRetryBudgetSpec is the spec for a MigrationRetryBudget resource",
+		"selector":    "Selector selects the virtual machine instances the budget applies to by their labels.\nAll the virtual machine instances of the namespace are selected when empty.\n+optional",
+		"maxFailures": "MaxFailures is the number of failed migrations of the selected virtual machine instances\nwhich are tolerated within the window",
+		"window":      "Window is the period failed migrations are counted over. Defaults to 1h.\n+optional",
+	}
+}
+
+func (MigrationRetryBudgetList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "MigrationRetryBudgetList is a list of MigrationRetryBudget resources\n\n+k8s:openapi-gen=true\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"items": "+listType=atomic",
+	}
+}
+
+func (MigrationRebalancePolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "MigrationRebalancePolicy live migrates virtual machine instances off nodes whose utilization exceeds\nthe thresholds of the policy, to nodes below them, keeping the load of the nodes balanced\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true\n+genclient\n+genclient:nonNamespaced",
+		"status": "+optional",
+	}
+}
+
+func (MigrationRebalancePolicySpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "MigrationRebalancePolicySpec is the spec for a MigrationRebalancePolicy resource",
+		"nodeSelector":              "NodeSelector selects the nodes which are rebalanced by their labels.\nAll the nodes are selected when empty.\n+optional",
+		"cpuThresholdPercentage":    "CPUThresholdPercentage is the CPU usage of a node, in percent of its allocatable CPU,\nabove which virtual machine instances are migrated off the node. Defaults to 80.\n+optional",
+		"memoryThresholdPercentage": "MemoryThresholdPercentage is the memory usage of a node, in percent of its allocatable memory,\nabove which virtual machine instances are migrated off the node. Defaults to 80.\n+optional",
+		"excludeLabels":             "ExcludeLabels are label keys of virtual machine instances which are never migrated by the policy\n+optional\n+listType=set",
+		"maxConcurrentMigrations":   "MaxConcurrentMigrations is the number of migrations started by the policy which may be in progress\nat the same time. Defaults to 1.\n+optional",
+		"interval":                  "Interval is the minimum time between two migrations started by the policy. Defaults to 5m.\n+optional",
+	}
+}
+
+func (MigrationRebalancePolicyStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "MigrationRebalancePolicyStatus is the status for a MigrationRebalancePolicy resource",
+		"hotNodes":          "HotNodes are the selected nodes whose utilization exceeds a threshold of the policy\n+optional\n+listType=set",
+		"lastMigrationTime": "LastMigrationTime is the time the policy last started a migration\n+optional",
+	}
+}
+
+func (MigrationRebalancePolicyList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "MigrationRebalancePolicyList is a list of MigrationRebalancePolicy resources\n\n+k8s:openapi-gen=true\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"items": "+listType=atomic",
+	}
+}
+
+func (VirtualMachineDisruptionBudget) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineDisruptionBudget limits the number of VirtualMachineInstances of a group which\nKubeVirt disrupts at the same time through voluntary disruptions, the migrations and shutdowns\nof the node evacuations and of the workload updates.\nUnlike a PodDisruptionBudget on the virt-launcher pods, a VirtualMachineInstance which is live\nmigrated is accounted as disrupted, and the budget is consulted before the disruption starts.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true\n+genclient",
+	}
+}
+
+func (VirtualMachineDisruptionBudgetSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "+k8s:openapi-gen=true",
+		"selector":       "Selector selects the VirtualMachineInstances of the namespace the budget applies to by their labels.",
+		"maxUnavailable": "MaxUnavailable is the number or percentage of the selected VirtualMachineInstances which can be\ndisrupted or unavailable at the same time. Percentages are rounded up. Defaults to 1.\n+optional",
+	}
+}
+
+func (VirtualMachineDisruptionBudgetStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                                 "+k8s:openapi-gen=true",
+		"observedGeneration":               "ObservedGeneration is the generation of the budget the status was computed for.\n+optional",
+		"expectedVirtualMachineInstances":  "ExpectedVirtualMachineInstances is the number of VirtualMachineInstances selected by the budget.",
+		"currentHealthy":                   "CurrentHealthy is the number of selected VirtualMachineInstances which run and are not disrupted.",
+		"disruptionsAllowed":               "DisruptionsAllowed is the number of selected VirtualMachineInstances which can be disrupted now.",
+		"disruptedVirtualMachineInstances": "DisruptedVirtualMachineInstances are the VirtualMachineInstances whose disruption was granted\nbut is not observed yet, with the time it was granted at. An entry is removed once the\ndisruption is observed, or after two minutes when it doesn't happen.\n+optional",
+	}
+}
+
+func (VirtualMachineDisruptionBudgetList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "VirtualMachineDisruptionBudgetList is a list of VirtualMachineDisruptionBudget resources.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true",
+		"items": "+listType=atomic",
+	}
+}
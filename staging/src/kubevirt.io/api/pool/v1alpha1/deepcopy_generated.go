@@ -57,6 +57,66 @@ func (in *VirtualMachinePool) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolAutoscaling) DeepCopyInto(out *VirtualMachinePoolAutoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]VirtualMachinePoolMetric, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ScaleInStabilizationWindow != nil {
+		in, out := &in.ScaleInStabilizationWindow, &out.ScaleInStabilizationWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolAutoscaling.
+func (in *VirtualMachinePoolAutoscaling) DeepCopy() *VirtualMachinePoolAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolAutoscalingStatus) DeepCopyInto(out *VirtualMachinePoolAutoscalingStatus) {
+	*out = *in
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CurrentMetrics != nil {
+		in, out := &in.CurrentMetrics, &out.CurrentMetrics
+		*out = make([]VirtualMachinePoolMetricStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolAutoscalingStatus.
+func (in *VirtualMachinePoolAutoscalingStatus) DeepCopy() *VirtualMachinePoolAutoscalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolAutoscalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachinePoolCondition) DeepCopyInto(out *VirtualMachinePoolCondition) {
 	*out = *in
@@ -108,6 +168,58 @@ func (in *VirtualMachinePoolList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolMetric) DeepCopyInto(out *VirtualMachinePoolMetric) {
+	*out = *in
+	if in.TargetAverageUtilization != nil {
+		in, out := &in.TargetAverageUtilization, &out.TargetAverageUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(VirtualMachinePoolPrometheusMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolMetric.
+func (in *VirtualMachinePoolMetric) DeepCopy() *VirtualMachinePoolMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolMetricStatus) DeepCopyInto(out *VirtualMachinePoolMetricStatus) {
+	*out = *in
+	if in.CurrentAverageUtilization != nil {
+		in, out := &in.CurrentAverageUtilization, &out.CurrentAverageUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CurrentAverageValue != nil {
+		in, out := &in.CurrentAverageValue, &out.CurrentAverageValue
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolMetricStatus.
+func (in *VirtualMachinePoolMetricStatus) DeepCopy() *VirtualMachinePoolMetricStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolMetricStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachinePoolNameGeneration) DeepCopyInto(out *VirtualMachinePoolNameGeneration) {
 	*out = *in
@@ -155,6 +267,107 @@ func (in *VirtualMachinePoolProactiveScaleInStrategy) DeepCopy() *VirtualMachine
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolPrometheusMetric) DeepCopyInto(out *VirtualMachinePoolPrometheusMetric) {
+	*out = *in
+	out.TargetAverageValue = in.TargetAverageValue.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolPrometheusMetric.
+func (in *VirtualMachinePoolPrometheusMetric) DeepCopy() *VirtualMachinePoolPrometheusMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolPrometheusMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolReplicaObjectTemplate) DeepCopyInto(out *VirtualMachinePoolReplicaObjectTemplate) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolReplicaObjectTemplate.
+func (in *VirtualMachinePoolReplicaObjectTemplate) DeepCopy() *VirtualMachinePoolReplicaObjectTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolReplicaObjectTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolReplicaTemplating) DeepCopyInto(out *VirtualMachinePoolReplicaTemplating) {
+	*out = *in
+	if in.StaticIPs != nil {
+		in, out := &in.StaticIPs, &out.StaticIPs
+		*out = new(VirtualMachinePoolStaticIPs)
+		**out = **in
+	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]VirtualMachinePoolReplicaObjectTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConfigMaps != nil {
+		in, out := &in.ConfigMaps, &out.ConfigMaps
+		*out = make([]VirtualMachinePoolReplicaObjectTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolReplicaTemplating.
+func (in *VirtualMachinePoolReplicaTemplating) DeepCopy() *VirtualMachinePoolReplicaTemplating {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolReplicaTemplating)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolRollingUpdate) DeepCopyInto(out *VirtualMachinePoolRollingUpdate) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolRollingUpdate.
+func (in *VirtualMachinePoolRollingUpdate) DeepCopy() *VirtualMachinePoolRollingUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolRollingUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachinePoolScaleInStrategy) DeepCopyInto(out *VirtualMachinePoolScaleInStrategy) {
 	*out = *in
@@ -230,6 +443,21 @@ func (in *VirtualMachinePoolSpec) DeepCopyInto(out *VirtualMachinePoolSpec) {
 		*out = new(VirtualMachinePoolScaleInStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(VirtualMachinePoolAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicaTemplating != nil {
+		in, out := &in.ReplicaTemplating, &out.ReplicaTemplating
+		*out = new(VirtualMachinePoolReplicaTemplating)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(VirtualMachinePoolUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -243,6 +471,22 @@ func (in *VirtualMachinePoolSpec) DeepCopy() *VirtualMachinePoolSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolStaticIPs) DeepCopyInto(out *VirtualMachinePoolStaticIPs) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolStaticIPs.
+func (in *VirtualMachinePoolStaticIPs) DeepCopy() *VirtualMachinePoolStaticIPs {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolStaticIPs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachinePoolStatus) DeepCopyInto(out *VirtualMachinePoolStatus) {
 	*out = *in
@@ -253,6 +497,11 @@ func (in *VirtualMachinePoolStatus) DeepCopyInto(out *VirtualMachinePoolStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(VirtualMachinePoolAutoscalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -266,6 +515,27 @@ func (in *VirtualMachinePoolStatus) DeepCopy() *VirtualMachinePoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolUpdateStrategy) DeepCopyInto(out *VirtualMachinePoolUpdateStrategy) {
+	*out = *in
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(VirtualMachinePoolRollingUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolUpdateStrategy.
+func (in *VirtualMachinePoolUpdateStrategy) DeepCopy() *VirtualMachinePoolUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineTemplateSpec) DeepCopyInto(out *VirtualMachineTemplateSpec) {
 	*out = *in
@@ -21,6 +21,7 @@ package v1alpha1
 
 import (
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 
@@ -35,6 +36,21 @@ const (
 	// Base selection policies
 	VirtualMachinePoolBasePolicyRandom          VirtualMachinePoolBasePolicy = "Random"
 	VirtualMachinePoolBasePolicyDescendingOrder VirtualMachinePoolBasePolicy = "DescendingOrder"
+	VirtualMachinePoolBasePolicyLeastLoaded     VirtualMachinePoolBasePolicy = "LeastLoaded"
+)
+
+const (
+	// Metric types the pool can be autoscaled on
+	VirtualMachinePoolMetricCPU        VirtualMachinePoolMetricType = "CPU"
+	VirtualMachinePoolMetricMemory     VirtualMachinePoolMetricType = "Memory"
+	VirtualMachinePoolMetricPrometheus VirtualMachinePoolMetricType = "Prometheus"
+)
+
+const (
+	// RollingUpdate restarts the VMIs of the outdated VirtualMachines, a limited number at a time
+	VirtualMachinePoolRollingUpdateStrategyType VirtualMachinePoolUpdateStrategyType = "RollingUpdate"
+	// Opportunistic only updates the VirtualMachines, their VMIs get the changes on their next restart
+	VirtualMachinePoolOpportunisticUpdateStrategyType VirtualMachinePoolUpdateStrategyType = "Opportunistic"
 )
 
 // VirtualMachinePool resource contains a VirtualMachine configuration
@@ -91,11 +107,21 @@ type VirtualMachinePoolStatus struct {
 
 	ReadyReplicas int32 `json:"readyReplicas,omitempty" optional:"true"`
 
+	// UpdatedReplicas is the number of VirtualMachines whose VM and VMI match the template of the pool.
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty" optional:"true"`
+
+	// OutdatedReplicas is the number of VirtualMachines whose VM or VMI do not match the template of the pool yet.
+	OutdatedReplicas int32 `json:"outdatedReplicas,omitempty" optional:"true"`
+
 	// +listType=atomic
 	Conditions []VirtualMachinePoolCondition `json:"conditions,omitempty" optional:"true"`
 
 	// Canonical form of the label selector for HPA which consumes it through the scale subresource.
 	LabelSelector string `json:"labelSelector,omitempty"`
+
+	// Autoscaling is the state of the autoscaling of the pool.
+	// +optional
+	Autoscaling *VirtualMachinePoolAutoscalingStatus `json:"autoscaling,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -127,6 +153,192 @@ type VirtualMachinePoolSpec struct {
 	// ScaleInStrategy specifies how the VMPool controller manages scaling in VMs within a VMPool
 	// +optional
 	ScaleInStrategy *VirtualMachinePoolScaleInStrategy `json:"scaleInStrategy,omitempty"`
+
+	// Autoscaling scales the replicas of the pool on the load of its VirtualMachines.
+	// The pool controller manages the replicas when it is set.
+	// +optional
+	Autoscaling *VirtualMachinePoolAutoscaling `json:"autoscaling,omitempty"`
+
+	// ReplicaTemplating makes the replicas of the pool unique, by expanding per-replica
+	// variables in their hostname and cloud-init data and by generating Secrets and
+	// ConfigMaps for each of them.
+	// +optional
+	ReplicaTemplating *VirtualMachinePoolReplicaTemplating `json:"replicaTemplating,omitempty"`
+
+	// UpdateStrategy is how the VirtualMachines of the pool are updated when its template
+	// changes. Defaults to a RollingUpdate.
+	// +optional
+	UpdateStrategy *VirtualMachinePoolUpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateStrategyType string
+
+// VirtualMachinePoolUpdateStrategy is how the VirtualMachines of a pool are updated
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateStrategy struct {
+	// Type is the type of the update strategy [RollingUpdate|Opportunistic]. Defaults to RollingUpdate.
+	// +kubebuilder:validation:Enum=RollingUpdate;Opportunistic
+	// +optional
+	Type VirtualMachinePoolUpdateStrategyType `json:"type,omitempty"`
+
+	// RollingUpdate holds the parameters of the RollingUpdate type.
+	// +optional
+	RollingUpdate *VirtualMachinePoolRollingUpdate `json:"rollingUpdate,omitempty"`
+}
+
+// VirtualMachinePoolRollingUpdate are the parameters of a rolling update of a pool
+// +k8s:openapi-gen=true
+type VirtualMachinePoolRollingUpdate struct {
+	// MaxUnavailable is the number or percentage of VirtualMachines which can be unavailable
+	// during the update. Defaults to spec.maxUnavailable, which can't be set together with it.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// MaxSurge is the number or percentage of VirtualMachines which can be created above
+	// the replicas of the pool during the update. Percentages are rounded up. Defaults to 0.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// LiveUpdate applies the changes which can be hotplugged or live migrated to the
+	// running VMIs instead of restarting them. Requires the LiveUpdate VM rollout
+	// strategy of the cluster.
+	// +optional
+	LiveUpdate bool `json:"liveUpdate,omitempty"`
+}
+
+// VirtualMachinePoolReplicaTemplating holds the per-replica templates of a pool.
+// The variables $(POOL_INDEX), $(VM_NAME) and $(STATIC_IP) are expanded in the
+// hostname, in the inline cloud-init user and network data of the VirtualMachine
+// template and in the data of the generated Secrets and ConfigMaps.
+// +k8s:openapi-gen=true
+type VirtualMachinePoolReplicaTemplating struct {
+	// Hostname is the hostname of the replicas, e.g. "web-$(POOL_INDEX)".
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+
+	// StaticIPs allocates sequential IP addresses to the replicas.
+	// +optional
+	StaticIPs *VirtualMachinePoolStaticIPs `json:"staticIPs,omitempty"`
+
+	// Secrets are generated for each replica and named <name>-<index>. Secret volumes
+	// of the VirtualMachine template referencing <name> use the Secret of their replica.
+	// +listType=atomic
+	// +optional
+	Secrets []VirtualMachinePoolReplicaObjectTemplate `json:"secrets,omitempty"`
+
+	// ConfigMaps are generated for each replica and named <name>-<index>. ConfigMap volumes
+	// of the VirtualMachine template referencing <name> use the ConfigMap of their replica.
+	// +listType=atomic
+	// +optional
+	ConfigMaps []VirtualMachinePoolReplicaObjectTemplate `json:"configMaps,omitempty"`
+}
+
+// VirtualMachinePoolStaticIPs allocates an IP address to each replica of a pool
+// +k8s:openapi-gen=true
+type VirtualMachinePoolStaticIPs struct {
+	// Start is the IP address of the replica with index 0, the replica with index N
+	// gets the address Start + N.
+	Start string `json:"start"`
+
+	// End is the highest IP address which can be allocated. Replicas whose address
+	// would be above it are not created.
+	// +optional
+	End string `json:"end,omitempty"`
+}
+
+// VirtualMachinePoolReplicaObjectTemplate is a Secret or ConfigMap generated for each replica of a pool
+// +k8s:openapi-gen=true
+type VirtualMachinePoolReplicaObjectTemplate struct {
+	// Name is the base name of the object.
+	Name string `json:"name"`
+
+	// Data is the content of the object. The variables are expanded in its values.
+	// +optional
+	Data map[string]string `json:"data,omitempty"`
+}
+
+// VirtualMachinePoolAutoscaling scales the replicas of a pool towards the targets of its metrics
+// +k8s:openapi-gen=true
+type VirtualMachinePoolAutoscaling struct {
+	// MinReplicas is the lowest number of replicas the pool is scaled to. Defaults to 1.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the highest number of replicas the pool is scaled to.
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// Metrics are the targets the replicas are scaled towards. The pool is scaled
+	// to the highest number of replicas any of them asks for.
+	// +listType=atomic
+	Metrics []VirtualMachinePoolMetric `json:"metrics"`
+
+	// ScaleInStabilizationWindow is the time to wait after the last scale before
+	// scaling the pool in. Defaults to 5m.
+	// +optional
+	ScaleInStabilizationWindow *metav1.Duration `json:"scaleInStabilizationWindow,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolMetricType string
+
+// VirtualMachinePoolMetric is a target the replicas of a pool are scaled towards
+// +k8s:openapi-gen=true
+type VirtualMachinePoolMetric struct {
+	// Type is the type of the metric [CPU|Memory|Prometheus]
+	// +kubebuilder:validation:Enum=CPU;Memory;Prometheus
+	Type VirtualMachinePoolMetricType `json:"type"`
+
+	// TargetAverageUtilization is the average utilization, in percent of the vCPUs or of the guest memory,
+	// of the running VirtualMachines. Required for the CPU and Memory types.
+	// +optional
+	TargetAverageUtilization *int32 `json:"targetAverageUtilization,omitempty"`
+
+	// Prometheus is the query the pool is scaled on. Required for the Prometheus type.
+	// +optional
+	Prometheus *VirtualMachinePoolPrometheusMetric `json:"prometheus,omitempty"`
+}
+
+// VirtualMachinePoolPrometheusMetric scales a pool on the result of a Prometheus query
+// +k8s:openapi-gen=true
+type VirtualMachinePoolPrometheusMetric struct {
+	// Query is a PromQL query returning the total load of the pool. The values of all
+	// returned series are summed up.
+	Query string `json:"query"`
+
+	// TargetAverageValue is the value of the query per replica the pool is scaled towards.
+	TargetAverageValue resource.Quantity `json:"targetAverageValue"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolAutoscalingStatus struct {
+	// DesiredReplicas is the number of replicas the autoscaling last asked for.
+	DesiredReplicas int32 `json:"desiredReplicas"`
+
+	// LastScaleTime is the time the pool was last scaled at by the autoscaling.
+	// +optional
+	// +nullable
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+
+	// CurrentMetrics holds the last values read for the metrics of the pool.
+	// +listType=atomic
+	// +optional
+	CurrentMetrics []VirtualMachinePoolMetricStatus `json:"currentMetrics,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolMetricStatus struct {
+	// Type is the type of the metric.
+	Type VirtualMachinePoolMetricType `json:"type"`
+
+	// CurrentAverageUtilization is the average utilization of the running VirtualMachines,
+	// in percent, for the CPU and Memory types.
+	// +optional
+	CurrentAverageUtilization *int32 `json:"currentAverageUtilization,omitempty"`
+
+	// CurrentAverageValue is the value of the query per replica, for the Prometheus type.
+	// +optional
+	CurrentAverageValue *resource.Quantity `json:"currentAverageValue,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -165,9 +377,10 @@ type VirtualMachinePoolProactiveScaleInStrategy struct {
 // VirtualMachinePoolSelectionPolicy defines the priority in which VM instances are selected for scale-in
 // +k8s:openapi-gen=true
 type VirtualMachinePoolSelectionPolicy struct {
-	// BasePolicy is a catch-all policy [Random|DescendingOrder]
+	// BasePolicy is a catch-all policy [Random|DescendingOrder|LeastLoaded]
+	// LeastLoaded removes the VMs which are not running first, then the ones using the least CPU.
 	// +optional
-	// +kubebuilder:validation:Enum=Random;DescendingOrder
+	// +kubebuilder:validation:Enum=Random;DescendingOrder;LeastLoaded
 	BasePolicy *VirtualMachinePoolBasePolicy `json:"basePolicy,omitempty"`
 }
 
@@ -26,9 +26,12 @@ func (VirtualMachinePoolCondition) SwaggerDoc() map[string]string {
 
 func (VirtualMachinePoolStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":              "+k8s:openapi-gen=true",
-		"conditions":    "+listType=atomic",
-		"labelSelector": "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"":                 "+k8s:openapi-gen=true",
+		"updatedReplicas":  "UpdatedReplicas is the number of VirtualMachines whose VM and VMI match the template of the pool.",
+		"outdatedReplicas": "OutdatedReplicas is the number of VirtualMachines whose VM or VMI do not match the template of the pool yet.",
+		"conditions":       "+listType=atomic",
+		"labelSelector":    "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"autoscaling":      "Autoscaling is the state of the autoscaling of the pool.\n+optional",
 	}
 }
 
@@ -42,6 +45,97 @@ func (VirtualMachinePoolSpec) SwaggerDoc() map[string]string {
 		"nameGeneration":         "Options for the name generation in a pool.\n+optional",
 		"maxUnavailable":         "(Defaults to 100%) Integer or string pointer, that when set represents either a percentage or number of VMs in a pool that can be unavailable (ready condition false) at a time during automated update.\n+optional",
 		"scaleInStrategy":        "ScaleInStrategy specifies how the VMPool controller manages scaling in VMs within a VMPool\n+optional",
+		"autoscaling":            "Autoscaling scales the replicas of the pool on the load of its VirtualMachines.\nThe pool controller manages the replicas when it is set.\n+optional",
+		"replicaTemplating":      "ReplicaTemplating makes the replicas of the pool unique, by expanding per-replica\nvariables in their hostname and cloud-init data and by generating Secrets and\nConfigMaps for each of them.\n+optional",
+		"updateStrategy":         "UpdateStrategy is how the VirtualMachines of the pool are updated when its template\nchanges. Defaults to a RollingUpdate.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolReplicaTemplating) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VirtualMachinePoolReplicaTemplating holds the per-replica templates of a pool.\nThe variables $(POOL_INDEX), $(VM_NAME) and $(STATIC_IP) are expanded in the\nhostname, in the inline cloud-init user and network data of the VirtualMachine\ntemplate and in the data of the generated Secrets and ConfigMaps.\n+k8s:openapi-gen=true",
+		"hostname":   "Hostname is the hostname of the replicas, e.g. \"web-$(POOL_INDEX)\".\n+optional",
+		"staticIPs":  "StaticIPs allocates sequential IP addresses to the replicas.\n+optional",
+		"secrets":    "Secrets are generated for each replica and named <name>-<index>. Secret volumes\nof the VirtualMachine template referencing <name> use the Secret of their replica.\n+listType=atomic\n+optional",
+		"configMaps": "ConfigMaps are generated for each replica and named <name>-<index>. ConfigMap volumes\nof the VirtualMachine template referencing <name> use the ConfigMap of their replica.\n+listType=atomic\n+optional",
+	}
+}
+
+func (VirtualMachinePoolStaticIPs) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "VirtualMachinePoolStaticIPs allocates an IP address to each replica of a pool\n+k8s:openapi-gen=true",
+		"start": "Start is the IP address of the replica with index 0, the replica with index N\ngets the address Start + N.",
+		"end":   "End is the highest IP address which can be allocated. Replicas whose address\nwould be above it are not created.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolReplicaObjectTemplate) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "VirtualMachinePoolReplicaObjectTemplate is a Secret or ConfigMap generated for each replica of a pool\n+k8s:openapi-gen=true",
+		"name": "Name is the base name of the object.",
+		"data": "Data is the content of the object. The variables are expanded in its values.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolUpdateStrategy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "VirtualMachinePoolUpdateStrategy is how the VirtualMachines of a pool are updated\n+k8s:openapi-gen=true",
+		"type":          "Type is the type of the update strategy [RollingUpdate|Opportunistic]. Defaults to RollingUpdate.\n+kubebuilder:validation:Enum=RollingUpdate;Opportunistic\n+optional",
+		"rollingUpdate": "RollingUpdate holds the parameters of the RollingUpdate type.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolRollingUpdate) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachinePoolRollingUpdate are the parameters of a rolling update of a pool\n+k8s:openapi-gen=true",
+		"maxUnavailable": "MaxUnavailable is the number or percentage of VirtualMachines which can be unavailable\nduring the update. Defaults to spec.maxUnavailable, which can't be set together with it.\n+optional",
+		"maxSurge":       "MaxSurge is the number or percentage of VirtualMachines which can be created above\nthe replicas of the pool during the update. Percentages are rounded up. Defaults to 0.\n+optional",
+		"liveUpdate":     "LiveUpdate applies the changes which can be hotplugged or live migrated to the\nrunning VMIs instead of restarting them. Requires the LiveUpdate VM rollout\nstrategy of the cluster.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolAutoscaling) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                           "VirtualMachinePoolAutoscaling scales the replicas of a pool towards the targets of its metrics\n+k8s:openapi-gen=true",
+		"minReplicas":                "MinReplicas is the lowest number of replicas the pool is scaled to. Defaults to 1.\n+optional",
+		"maxReplicas":                "MaxReplicas is the highest number of replicas the pool is scaled to.",
+		"metrics":                    "Metrics are the targets the replicas are scaled towards. The pool is scaled\nto the highest number of replicas any of them asks for.\n+listType=atomic",
+		"scaleInStabilizationWindow": "ScaleInStabilizationWindow is the time to wait after the last scale before\nscaling the pool in. Defaults to 5m.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolMetric) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                         "VirtualMachinePoolMetric is a target the replicas of a pool are scaled towards\n+k8s:openapi-gen=true",
+		"type":                     "Type is the type of the metric [CPU|Memory|Prometheus]\n+kubebuilder:validation:Enum=CPU;Memory;Prometheus",
+		"targetAverageUtilization": "TargetAverageUtilization is the average utilization, in percent of the vCPUs or of the guest memory,\nof the running VirtualMachines. Required for the CPU and Memory types.\n+optional",
+		"prometheus":               "Prometheus is the query the pool is scaled on. Required for the Prometheus type.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolPrometheusMetric) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "VirtualMachinePoolPrometheusMetric scales a pool on the result of a Prometheus query\n+k8s:openapi-gen=true",
+		"query":              "Query is a PromQL query returning the total load of the pool. The values of all\nreturned series are summed up.",
+		"targetAverageValue": "TargetAverageValue is the value of the query per replica the pool is scaled towards.",
+	}
+}
+
+func (VirtualMachinePoolAutoscalingStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "+k8s:openapi-gen=true",
+		"desiredReplicas": "DesiredReplicas is the number of replicas the autoscaling last asked for.",
+		"lastScaleTime":   "LastScaleTime is the time the pool was last scaled at by the autoscaling.\n+optional\n+nullable",
+		"currentMetrics":  "CurrentMetrics holds the last values read for the metrics of the pool.\n+listType=atomic\n+optional",
+	}
+}
+
+func (VirtualMachinePoolMetricStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "+k8s:openapi-gen=true",
+		"type":                      "Type is the type of the metric.",
+		"currentAverageUtilization": "CurrentAverageUtilization is the average utilization of the running VirtualMachines,\nin percent, for the CPU and Memory types.\n+optional",
+		"currentAverageValue":       "CurrentAverageValue is the value of the query per replica, for the Prometheus type.\n+optional",
 	}
 }
 
@@ -74,6 +168,6 @@ func (VirtualMachinePoolProactiveScaleInStrategy) SwaggerDoc() map[string]string
 func (VirtualMachinePoolSelectionPolicy) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":           "VirtualMachinePoolSelectionPolicy defines the priority in which VM instances are selected for scale-in\n+k8s:openapi-gen=true",
-		"basePolicy": "BasePolicy is a catch-all policy [Random|DescendingOrder]\n+optional\n+kubebuilder:validation:Enum=Random;DescendingOrder",
+		"basePolicy": "BasePolicy is a catch-all policy [Random|DescendingOrder|LeastLoaded]\nLeastLoaded removes the VMs which are not running first, then the ones using the least CPU.\n+optional\n+kubebuilder:validation:Enum=Random;DescendingOrder;LeastLoaded",
 	}
 }
@@ -0,0 +1,82 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const VirtualMachineResourceQuotaKind = "VirtualMachineResourceQuota"
+
+const (
+	// ResourceGuestCPU is the number of guest vCPUs of the VirtualMachines
+	ResourceGuestCPU k8sv1.ResourceName = "guest.cpu"
+	// ResourceGuestMemory is the guest memory of the VirtualMachines
+	ResourceGuestMemory k8sv1.ResourceName = "guest.memory"
+	// ResourceVirtualMachines is the number of VirtualMachines
+	ResourceVirtualMachines k8sv1.ResourceName = "virtualmachines"
+)
+
+// VirtualMachineResourceQuota limits the guest resources the VirtualMachines of a namespace
+// may claim in total. Unlike a ResourceQuota, which is enforced on the requests of the
+// virt-launcher pods, the limits are expressed in guest vCPUs, guest memory and number of
+// VirtualMachines, independent of the overhead of the pods.
+// Every VirtualMachine of the namespace is accounted for, whether it runs or not.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+type VirtualMachineResourceQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineResourceQuotaSpec   `json:"spec" valid:"required"`
+	Status VirtualMachineResourceQuotaStatus `json:"status,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachineResourceQuotaSpec struct {
+	// Hard is the set of enforced limits for each named resource.
+	// Supported resources are guest.cpu, guest.memory and virtualmachines.
+	Hard k8sv1.ResourceList `json:"hard"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachineResourceQuotaStatus struct {
+	// Hard is the set of enforced limits the usage was last observed against.
+	// +optional
+	Hard k8sv1.ResourceList `json:"hard,omitempty"`
+
+	// Used is the current usage of the VirtualMachines of the namespace.
+	// +optional
+	Used k8sv1.ResourceList `json:"used,omitempty"`
+}
+
+// VirtualMachineResourceQuotaList is a list of VirtualMachineResourceQuota resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+type VirtualMachineResourceQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []VirtualMachineResourceQuota `json:"items"`
+}
@@ -72,16 +72,30 @@ type VirtualMachineSnapshotSpec struct {
 	// Defaults to DefaultFailureDeadline - 5min
 	// +optional
 	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+
+	// RetentionPolicy prunes older snapshots of the same source once
+	// this snapshot succeeded. Snapshots which are still in progress
+	// are never pruned.
+	// +optional
+	RetentionPolicy *SnapshotRetentionPolicy `json:"retentionPolicy,omitempty"`
+
+	// IncludeMemory also captures the memory state of a running VirtualMachine
+	// in a dedicated volume, which a restore resumes the guest from.
+	// Requires volume hotplug to be enabled, ignored when the VirtualMachine is not running
+	// +optional
+	IncludeMemory *bool `json:"includeMemory,omitempty"`
 }
 
 // Indication is a way to indicate the state of the vm when taking the snapshot
 type Indication string
 
 const (
-	VMSnapshotOnlineSnapshotIndication Indication = "Online"
-	VMSnapshotNoGuestAgentIndication   Indication = "NoGuestAgent"
-	VMSnapshotGuestAgentIndication     Indication = "GuestAgent"
-	VMSnapshotQuiesceFailedIndication  Indication = "QuiesceFailed"
+	VMSnapshotOnlineSnapshotIndication  Indication = "Online"
+	VMSnapshotNoGuestAgentIndication    Indication = "NoGuestAgent"
+	VMSnapshotGuestAgentIndication      Indication = "GuestAgent"
+	VMSnapshotQuiesceFailedIndication   Indication = "QuiesceFailed"
+	VMSnapshotMemoryIndication          Indication = "Memory"
+	VMSnapshotPersistentStateIndication Indication = "PersistentState"
 )
 
 // VirtualMachineSnapshotPhase is the current phase of the VirtualMachineSnapshot
@@ -127,6 +141,110 @@ type VirtualMachineSnapshotStatus struct {
 
 	// +optional
 	SnapshotVolumes *SnapshotVolumesLists `json:"snapshotVolumes,omitempty"`
+
+	// HookResults reports the outcome of the freeze hooks run for the snapshot
+	// +optional
+	// +listType=atomic
+	HookResults []FreezeHookResult `json:"hookResults,omitempty"`
+
+	// Progress reports the progress of the snapshots of the individual volumes
+	// +optional
+	Progress *VirtualMachineSnapshotProgress `json:"progress,omitempty"`
+
+	// MemberSnapshots are the names of the VirtualMachineSnapshots taken of the
+	// VirtualMachines of a VirtualMachinePool source
+	// +optional
+	// +listType=atomic
+	MemberSnapshots []string `json:"memberSnapshots,omitempty"`
+}
+
+// VirtualMachineSnapshotProgress is the progress of the volume snapshots of a
+// VirtualMachineSnapshot
+type VirtualMachineSnapshotProgress struct {
+	// Percentage of the volume snapshots which are ready to use
+	Percentage int32 `json:"percentage"`
+
+	// +optional
+	// +listType=atomic
+	Volumes []VolumeSnapshotProgress `json:"volumes,omitempty"`
+}
+
+// VolumeSnapshotProgressPhase is the phase of the snapshot of a single volume
+type VolumeSnapshotProgressPhase string
+
+const (
+	// VolumeSnapshotPhasePending means the VolumeSnapshot was not created yet
+	VolumeSnapshotPhasePending VolumeSnapshotProgressPhase = "Pending"
+
+	// VolumeSnapshotPhaseInProgress means the VolumeSnapshot exists but is not ready to use
+	VolumeSnapshotPhaseInProgress VolumeSnapshotProgressPhase = "InProgress"
+
+	// VolumeSnapshotPhaseStalled means the VolumeSnapshot did not become ready in time
+	VolumeSnapshotPhaseStalled VolumeSnapshotProgressPhase = "Stalled"
+
+	// VolumeSnapshotPhaseReady means the VolumeSnapshot is ready to use
+	VolumeSnapshotPhaseReady VolumeSnapshotProgressPhase = "Ready"
+
+	// VolumeSnapshotPhaseFailed means the VolumeSnapshot reported an error
+	VolumeSnapshotPhaseFailed VolumeSnapshotProgressPhase = "Failed"
+)
+
+// VolumeSnapshotProgress is the progress of the snapshot of a single volume
+type VolumeSnapshotProgress struct {
+	VolumeName string `json:"volumeName"`
+
+	// +optional
+	VolumeSnapshotName *string `json:"volumeSnapshotName,omitempty"`
+
+	Phase VolumeSnapshotProgressPhase `json:"phase"`
+
+	// +optional
+	Message *string `json:"message,omitempty"`
+}
+
+// FreezeHooksAnnotation holds a JSON list of FreezeHooks. Set on the
+// VirtualMachineInstance, usually through the VirtualMachine template, the hooks
+// are run inside the guest through the guest agent around the filesystem freeze
+// of an online snapshot.
+const FreezeHooksAnnotation = "snapshot.kubevirt.io/freeze-hooks"
+
+// FreezeHookType defines when a freeze hook is run
+type FreezeHookType string
+
+const (
+	// PreFreezeHook is run before the guest filesystems are frozen,
+	// a failure aborts the freeze
+	PreFreezeHook FreezeHookType = "PreFreeze"
+
+	// PostThawHook is run after the guest filesystems are thawed
+	PostThawHook FreezeHookType = "PostThaw"
+)
+
+// FreezeHook is a command run inside the guest around the filesystem freeze
+type FreezeHook struct {
+	Name string `json:"name"`
+
+	Type FreezeHookType `json:"type"`
+
+	// Command is the path of the executable in the guest followed by its arguments
+	// +listType=atomic
+	Command []string `json:"command"`
+
+	// TimeoutSeconds is the time the command is allowed to run, defaults to 30
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// FreezeHookResult is the outcome of a single freeze hook
+type FreezeHookResult struct {
+	Name string `json:"name"`
+
+	Type FreezeHookType `json:"type"`
+
+	Succeeded bool `json:"succeeded"`
+
+	// +optional
+	Message *string `json:"message,omitempty"`
 }
 
 // SnapshotVolumesLists includes the list of volumes which were included in the snapshot and volumes which were excluded from the snapshot
@@ -215,6 +333,10 @@ type VirtualMachineSnapshotContentSpec struct {
 	// +optional
 	// +listType=atomic
 	VolumeBackups []VolumeBackup `json:"volumeBackups,omitempty"`
+
+	// MemoryVolumeBackup is the backup of the volume holding the memory state of the VirtualMachine
+	// +optional
+	MemoryVolumeBackup *VolumeBackup `json:"memoryVolumeBackup,omitempty"`
 }
 
 type VirtualMachine struct {
@@ -255,6 +377,33 @@ type VolumeBackup struct {
 
 	// +optional
 	VolumeSnapshotName *string `json:"volumeSnapshotName,omitempty"`
+
+	// Hotplug is set when the volume was hotplugged to the VirtualMachine
+	// +optional
+	Hotplug *VolumeHotplug `json:"hotplug,omitempty"`
+}
+
+// HotplugVolumeOrigin is the kind of volume source a volume was hotplugged from
+type HotplugVolumeOrigin string
+
+const (
+	HotplugVolumeOriginDataVolume            HotplugVolumeOrigin = "DataVolume"
+	HotplugVolumeOriginPersistentVolumeClaim HotplugVolumeOrigin = "PersistentVolumeClaim"
+)
+
+// VolumeHotplug contains the data needed to restore the hotplug attachment of a volume
+type VolumeHotplug struct {
+	// Origin is the kind of volume source the volume was hotplugged from
+	Origin HotplugVolumeOrigin `json:"origin"`
+
+	// Bus is the bus of the disk the volume was attached with
+	// +optional
+	Bus v1.DiskBus `json:"bus,omitempty"`
+
+	// Persisted is false when the volume was only hotplugged to the running
+	// VirtualMachineInstance and was not part of the VirtualMachine spec
+	// +optional
+	Persisted bool `json:"persisted,omitempty"`
 }
 
 // VirtualMachineSnapshotContentStatus is the status for a VirtualMachineSnapshotStatus resource
@@ -272,6 +421,10 @@ type VirtualMachineSnapshotContentStatus struct {
 	// +optional
 	// +listType=atomic
 	VolumeSnapshotStatus []VolumeSnapshotStatus `json:"volumeSnapshotStatus,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	HookResults []FreezeHookResult `json:"hookResults,omitempty"`
 }
 
 // VirtualMachineSnapshotContentList is a list of VirtualMachineSnapshot resources
@@ -376,6 +529,35 @@ type VirtualMachineRestoreSpec struct {
 	// +optional
 	// +listType=atomic
 	Patches []string `json:"patches,omitempty"`
+
+	// Volumes limits the restore to the listed volumes of the snapshot. Volumes which are
+	// not listed keep their current source in the target. All volumes are restored when empty
+	// +optional
+	// +listType=set
+	Volumes []string `json:"volumes,omitempty"`
+
+	// DryRun makes the restore compute the changes it would apply to the target
+	// and report them in the status, without modifying the target or its volumes
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// TargetReset regenerates the guest identifiers copied from the snapshot when
+	// restoring into a VirtualMachine other than the snapshot source: the firmware
+	// UUID and serial, which the cloud-init instance-id is derived from, and the
+	// MAC addresses of the interfaces. Patches are applied afterwards
+	// +optional
+	TargetReset *bool `json:"targetReset,omitempty"`
+
+	// RunStrategy is set on the target once restored. An existing target keeps
+	// its run strategy and a new one is created halted when unset
+	// +optional
+	RunStrategy *v1.VirtualMachineRunStrategy `json:"runStrategy,omitempty"`
+
+	// StartVirtualMachine starts the target once restored, with the run strategy it had
+	// before the restore stopped it, or Always when it was not running.
+	// Cannot be combined with RunStrategy
+	// +optional
+	StartVirtualMachine *bool `json:"startVirtualMachine,omitempty"`
 }
 
 // VirtualMachineRestoreStatus is the status for a VirtualMachineRestore resource
@@ -397,6 +579,43 @@ type VirtualMachineRestoreStatus struct {
 	// +optional
 	// +listType=atomic
 	Conditions []Condition `json:"conditions,omitempty"`
+
+	// DryRunResult holds the changes computed by a dry run restore
+	// +optional
+	DryRunResult *RestoreDryRunResult `json:"dryRunResult,omitempty"`
+
+	// TargetRunStrategy is the run strategy the target had before the restore stopped it
+	// +optional
+	TargetRunStrategy *v1.VirtualMachineRunStrategy `json:"targetRunStrategy,omitempty"`
+}
+
+// RestoreDryRunResult describes the changes a restore would apply to its target
+type RestoreDryRunResult struct {
+	// TargetExists is true when the restore would update an existing target
+	// instead of creating a new one
+	TargetExists bool `json:"targetExists"`
+
+	// +optional
+	// +listType=atomic
+	VolumeChanges []VolumeRestoreChange `json:"volumeChanges,omitempty"`
+
+	// SpecPatch is a JSON merge patch turning the current target spec into the restored spec
+	// +optional
+	SpecPatch string `json:"specPatch,omitempty"`
+}
+
+// VolumeRestoreChange describes how a single volume would be restored
+type VolumeRestoreChange struct {
+	VolumeName string `json:"volumeName"`
+
+	// CurrentClaimName is the claim currently backing the volume in the target, if any
+	// +optional
+	CurrentClaimName string `json:"currentClaimName,omitempty"`
+
+	// RestoreClaimName is the claim the volume would be restored into
+	RestoreClaimName string `json:"restoreClaimName"`
+
+	VolumeSnapshotName string `json:"volumeSnapshotName"`
 }
 
 // VolumeRestore contains the data needed to restore a PVC
@@ -430,3 +649,198 @@ type VirtualMachineRestoreList struct {
 
 	Items []VirtualMachineRestore `json:"items"`
 }
+
+// VirtualMachineSnapshotSchedule defines the periodic snapshotting of a VM
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotScheduleSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleSpec struct {
+	Source corev1.TypedLocalObjectReference `json:"source"`
+
+	// Schedule is a cron expression in the standard five field format
+	// defining when snapshots are taken
+	Schedule string `json:"schedule"`
+
+	// Disabled stops the creation of new snapshots without removing the schedule
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// Retention defines which of the snapshots created by the schedule are kept
+	// +optional
+	Retention *SnapshotRetentionPolicy `json:"retention,omitempty"`
+
+	// FailureDeadline is passed on to the created VirtualMachineSnapshots
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// SnapshotRetentionPolicy defines how long snapshots are kept
+type SnapshotRetentionPolicy struct {
+	// MaxCount is the number of most recent snapshots to keep
+	// +optional
+	MaxCount *int32 `json:"maxCount,omitempty"`
+
+	// MaxAge is the time after its creation a snapshot is deleted
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleStatus struct {
+	// +optional
+	// +nullable
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// +optional
+	LastSnapshotName *string `json:"lastSnapshotName,omitempty"`
+
+	// +optional
+	// +nullable
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// +optional
+	Error *Error `json:"error,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotSchedule `json:"items"`
+}
+
+// VirtualMachineSnapshotGroup defines the snapshotting of a set of VMs at the same point in time
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotGroupSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotGroupStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup resource
+type VirtualMachineSnapshotGroupSpec struct {
+	// Selector selects the VMs of the namespace which are snapshotted together
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// DeletionPolicy is passed on to the VirtualMachineSnapshots of the members
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// This time represents the number of seconds we permit the group snapshot
+	// to take. In case we pass this deadline we mark it as failed.
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup resource
+type VirtualMachineSnapshotGroupStatus struct {
+	// +optional
+	Phase VirtualMachineSnapshotPhase `json:"phase,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+
+	// +optional
+	ReadyToUse *bool `json:"readyToUse,omitempty"`
+
+	// CreationTime is the time the volumes of the last member were snapshotted
+	// +optional
+	// +nullable
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// +optional
+	Error *Error `json:"error,omitempty"`
+
+	// Members are the VirtualMachineSnapshots taken of the selected VMs
+	// +optional
+	// +listType=atomic
+	Members []VirtualMachineSnapshotGroupMember `json:"members,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupMember is the VirtualMachineSnapshot of a VM of the group
+type VirtualMachineSnapshotGroupMember struct {
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName"`
+}
+
+// VirtualMachineSnapshotGroupList is a list of VirtualMachineSnapshotGroup resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotGroup `json:"items"`
+}
+
+// VirtualMachineSnapshotGroupRestore defines the restore of all the members of a VirtualMachineSnapshotGroup
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGroupRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotGroupRestoreSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotGroupRestoreStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupRestoreSpec is the spec for a VirtualMachineSnapshotGroupRestore resource
+type VirtualMachineSnapshotGroupRestoreSpec struct {
+	VirtualMachineSnapshotGroupName string `json:"virtualMachineSnapshotGroupName"`
+}
+
+// VirtualMachineSnapshotGroupRestoreStatus is the status for a VirtualMachineSnapshotGroupRestore resource
+type VirtualMachineSnapshotGroupRestoreStatus struct {
+	// +optional
+	Complete *bool `json:"complete,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+
+	// RestoreTime is the time the last member was restored
+	// +optional
+	// +nullable
+	RestoreTime *metav1.Time `json:"restoreTime,omitempty"`
+
+	// Members are the VirtualMachineRestores of the members of the group
+	// +optional
+	// +listType=atomic
+	Members []VirtualMachineSnapshotGroupRestoreMember `json:"members,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupRestoreMember is the VirtualMachineRestore of a VM of the group
+type VirtualMachineSnapshotGroupRestoreMember struct {
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	VirtualMachineRestoreName string `json:"virtualMachineRestoreName"`
+}
+
+// VirtualMachineSnapshotGroupRestoreList is a list of VirtualMachineSnapshotGroupRestore resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGroupRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotGroupRestore `json:"items"`
+}
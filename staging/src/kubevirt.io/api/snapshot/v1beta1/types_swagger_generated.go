@@ -14,6 +14,8 @@ func (VirtualMachineSnapshotSpec) SwaggerDoc() map[string]string {
 		"":                "VirtualMachineSnapshotSpec is the spec for a VirtualMachineSnapshot resource",
 		"deletionPolicy":  "+optional",
 		"failureDeadline": "This time represents the number of seconds we permit the vm snapshot\nto take. In case we pass this deadline we mark this snapshot\nas failed.\nDefaults to DefaultFailureDeadline - 5min\n+optional",
+		"retentionPolicy": "RetentionPolicy prunes older snapshots of the same source once\nthis snapshot succeeded. Snapshots which are still in progress\nare never pruned.\n+optional",
+		"includeMemory":   "IncludeMemory also captures the memory state of a running VirtualMachine\nin a dedicated volume, which a restore resumes the guest from.\nRequires volume hotplug to be enabled, ignored when the VirtualMachine is not running\n+optional",
 	}
 }
 
@@ -29,6 +31,40 @@ func (VirtualMachineSnapshotStatus) SwaggerDoc() map[string]string {
 		"conditions":                        "+optional\n+listType=atomic",
 		"indications":                       "+optional\n+listType=set",
 		"snapshotVolumes":                   "+optional",
+		"hookResults":                       "HookResults reports the outcome of the freeze hooks run for the snapshot\n+optional\n+listType=atomic",
+		"progress":                          "Progress reports the progress of the snapshots of the individual volumes\n+optional",
+		"memberSnapshots":                   "MemberSnapshots are the names of the VirtualMachineSnapshots taken of the\nVirtualMachines of a VirtualMachinePool source\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineSnapshotProgress) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VirtualMachineSnapshotProgress is the progress of the volume snapshots of a\nVirtualMachineSnapshot",
+		"percentage": "Percentage of the volume snapshots which are ready to use",
+		"volumes":    "+optional\n+listType=atomic",
+	}
+}
+
+func (VolumeSnapshotProgress) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "VolumeSnapshotProgress is the progress of the snapshot of a single volume",
+		"volumeSnapshotName": "+optional",
+		"message":            "+optional",
+	}
+}
+
+func (FreezeHook) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "FreezeHook is a command run inside the guest around the filesystem freeze",
+		"command":        "Command is the path of the executable in the guest followed by its arguments\n+listType=atomic",
+		"timeoutSeconds": "TimeoutSeconds is the time the command is allowed to run, defaults to 30\n+optional",
+	}
+}
+
+func (FreezeHookResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "FreezeHookResult is the outcome of a single freeze hook",
+		"message": "+optional",
 	}
 }
 
@@ -73,8 +109,9 @@ func (VirtualMachineSnapshotContent) SwaggerDoc() map[string]string {
 
 func (VirtualMachineSnapshotContentSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":              "VirtualMachineSnapshotContentSpec is the spec for a VirtualMachineSnapshotContent resource",
-		"volumeBackups": "+optional\n+listType=atomic",
+		"":                   "VirtualMachineSnapshotContentSpec is the spec for a VirtualMachineSnapshotContent resource",
+		"volumeBackups":      "+optional\n+listType=atomic",
+		"memoryVolumeBackup": "MemoryVolumeBackup is the backup of the volume holding the memory state of the VirtualMachine\n+optional",
 	}
 }
 
@@ -102,6 +139,16 @@ func (VolumeBackup) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                   "VolumeBackup contains the data neeed to restore a PVC",
 		"volumeSnapshotName": "+optional",
+		"hotplug":            "Hotplug is set when the volume was hotplugged to the VirtualMachine\n+optional",
+	}
+}
+
+func (VolumeHotplug) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "VolumeHotplug contains the data needed to restore the hotplug attachment of a volume",
+		"origin":    "Origin is the kind of volume source the volume was hotplugged from",
+		"bus":       "Bus is the bus of the disk the volume was attached with\n+optional",
+		"persisted": "Persisted is false when the volume was only hotplugged to the running\nVirtualMachineInstance and was not part of the VirtualMachine spec\n+optional",
 	}
 }
 
@@ -112,6 +159,7 @@ func (VirtualMachineSnapshotContentStatus) SwaggerDoc() map[string]string {
 		"readyToUse":           "+optional",
 		"error":                "+optional",
 		"volumeSnapshotStatus": "+optional\n+listType=atomic",
+		"hookResults":          "+optional\n+listType=atomic",
 	}
 }
 
@@ -145,6 +193,11 @@ func (VirtualMachineRestoreSpec) SwaggerDoc() map[string]string {
 		"volumeRestorePolicy":    "+optional",
 		"volumeRestoreOverrides": "VolumeRestoreOverrides gives the option to change properties of each restored volume\nFor example, specifying the name of the restored volume, or adding labels/annotations to it\n+optional\n+listType=atomic",
 		"patches":                "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"volumes":                "Volumes limits the restore to the listed volumes of the snapshot. Volumes which are\nnot listed keep their current source in the target. All volumes are restored when empty\n+optional\n+listType=set",
+		"dryRun":                 "DryRun makes the restore compute the changes it would apply to the target\nand report them in the status, without modifying the target or its volumes\n+optional",
+		"targetReset":            "TargetReset regenerates the guest identifiers copied from the snapshot when\nrestoring into a VirtualMachine other than the snapshot source: the firmware\nUUID and serial, which the cloud-init instance-id is derived from, and the\nMAC addresses of the interfaces. Patches are applied afterwards\n+optional",
+		"runStrategy":            "RunStrategy is set on the target once restored. An existing target keeps\nits run strategy and a new one is created halted when unset\n+optional",
+		"startVirtualMachine":    "StartVirtualMachine starts the target once restored, with the run strategy it had\nbefore the restore stopped it, or Always when it was not running.\nCannot be combined with RunStrategy\n+optional",
 	}
 }
 
@@ -156,6 +209,25 @@ func (VirtualMachineRestoreStatus) SwaggerDoc() map[string]string {
 		"deletedDataVolumes": "+optional\n+listType=set",
 		"complete":           "+optional",
 		"conditions":         "+optional\n+listType=atomic",
+		"dryRunResult":       "DryRunResult holds the changes computed by a dry run restore\n+optional",
+		"targetRunStrategy":  "TargetRunStrategy is the run strategy the target had before the restore stopped it\n+optional",
+	}
+}
+
+func (RestoreDryRunResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "RestoreDryRunResult describes the changes a restore would apply to its target",
+		"targetExists":  "TargetExists is true when the restore would update an existing target\ninstead of creating a new one",
+		"volumeChanges": "+optional\n+listType=atomic",
+		"specPatch":     "SpecPatch is a JSON merge patch turning the current target spec into the restored spec\n+optional",
+	}
+}
+
+func (VolumeRestoreChange) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VolumeRestoreChange describes how a single volume would be restored",
+		"currentClaimName": "CurrentClaimName is the claim currently backing the volume in the target, if any\n+optional",
+		"restoreClaimName": "RestoreClaimName is the claim the volume would be restored into",
 	}
 }
 
@@ -180,3 +252,119 @@ func (VirtualMachineRestoreList) SwaggerDoc() map[string]string {
 		"": "VirtualMachineRestoreList is a list of VirtualMachineRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
 	}
 }
+
+func (VirtualMachineSnapshotSchedule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotSchedule defines the periodic snapshotting of a VM\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource",
+		"schedule":        "Schedule is a cron expression in the standard five field format\ndefining when snapshots are taken",
+		"disabled":        "Disabled stops the creation of new snapshots without removing the schedule\n+optional",
+		"retention":       "Retention defines which of the snapshots created by the schedule are kept\n+optional",
+		"failureDeadline": "FailureDeadline is passed on to the created VirtualMachineSnapshots\n+optional",
+	}
+}
+
+func (SnapshotRetentionPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "SnapshotRetentionPolicy defines how long snapshots are kept",
+		"maxCount": "MaxCount is the number of most recent snapshots to keep\n+optional",
+		"maxAge":   "MaxAge is the time after its creation a snapshot is deleted\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource",
+		"lastScheduleTime": "+optional\n+nullable",
+		"lastSnapshotName": "+optional",
+		"nextScheduleTime": "+optional\n+nullable",
+		"error":            "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineSnapshotGroup) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotGroup defines the snapshotting of a set of VMs at the same point in time\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup resource",
+		"selector":        "Selector selects the VMs of the namespace which are snapshotted together",
+		"deletionPolicy":  "DeletionPolicy is passed on to the VirtualMachineSnapshots of the members\n+optional",
+		"failureDeadline": "This time represents the number of seconds we permit the group snapshot\nto take. In case we pass this deadline we mark it as failed.\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup resource",
+		"phase":        "+optional",
+		"conditions":   "+optional\n+listType=atomic",
+		"readyToUse":   "+optional",
+		"creationTime": "CreationTime is the time the volumes of the last member were snapshotted\n+optional\n+nullable",
+		"error":        "+optional",
+		"members":      "Members are the VirtualMachineSnapshots taken of the selected VMs\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineSnapshotGroupMember) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotGroupMember is the VirtualMachineSnapshot of a VM of the group",
+	}
+}
+
+func (VirtualMachineSnapshotGroupList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotGroupList is a list of VirtualMachineSnapshotGroup resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineSnapshotGroupRestore) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotGroupRestore defines the restore of all the members of a VirtualMachineSnapshotGroup\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupRestoreSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotGroupRestoreSpec is the spec for a VirtualMachineSnapshotGroupRestore resource",
+	}
+}
+
+func (VirtualMachineSnapshotGroupRestoreStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineSnapshotGroupRestoreStatus is the status for a VirtualMachineSnapshotGroupRestore resource",
+		"complete":    "+optional",
+		"conditions":  "+optional\n+listType=atomic",
+		"restoreTime": "RestoreTime is the time the last member was restored\n+optional\n+nullable",
+		"members":     "Members are the VirtualMachineRestores of the members of the group\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineSnapshotGroupRestoreMember) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotGroupRestoreMember is the VirtualMachineRestore of a VM of the group",
+	}
+}
+
+func (VirtualMachineSnapshotGroupRestoreList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotGroupRestoreList is a list of VirtualMachineSnapshotGroupRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
@@ -0,0 +1,230 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	exportv1beta1 "kubevirt.io/api/export/v1beta1"
+)
+
+// VirtualMachineImport defines the operation of importing a virtual machine described by
+// an OVA/OVF bundle or a libvirt domain XML
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineImport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineImportSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineImportStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineImportList is a list of VirtualMachineImport resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineImportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	// +listType=atomic
+	Items []VirtualMachineImport `json:"items"`
+}
+
+// VirtualMachineImportSpec is the spec for a VirtualMachineImport resource
+type VirtualMachineImportSpec struct {
+	// Source is where the description of the virtual machine and its disks are read from
+	Source VirtualMachineImportSource `json:"source"`
+
+	// VirtualMachineName is the name of the imported virtual machine, the name of the import when unset
+	// +optional
+	VirtualMachineName string `json:"virtualMachineName,omitempty"`
+
+	// StorageClassName is the storage class of the imported disks, the default storage class when unset
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// NetworkMappings maps the networks of the source to networks of the virtual machine.
+	// An interface connected to a network without mapping is connected to the pod network,
+	// unless another interface already is.
+	// +optional
+	// +listType=atomic
+	NetworkMappings []ImportNetworkMapping `json:"networkMappings,omitempty"`
+
+	// DiskMappings overrides how the disks of the source are imported
+	// +optional
+	// +listType=atomic
+	DiskMappings []ImportDiskMapping `json:"diskMappings,omitempty"`
+}
+
+// ImportSourceFormat is the format of the description of an imported virtual machine
+type ImportSourceFormat string
+
+const (
+	// ImportFormatOVA is an OVA bundle, a tar archive holding an OVF descriptor and the disks it references
+	ImportFormatOVA ImportSourceFormat = "ova"
+	// ImportFormatOVF is an OVF descriptor
+	ImportFormatOVF ImportSourceFormat = "ovf"
+	// ImportFormatLibvirt is a libvirt domain XML
+	ImportFormatLibvirt ImportSourceFormat = "libvirt"
+)
+
+// VirtualMachineImportSource describes where an imported virtual machine is read from.
+// Exactly one of HTTP and Upload must be set.
+type VirtualMachineImportSource struct {
+	// Format is the format of the description of the virtual machine
+	// +kubebuilder:validation:Enum=ova;ovf;libvirt
+	Format ImportSourceFormat `json:"format"`
+
+	// HTTP imports the description, and the disks it references, from a web server
+	// +optional
+	HTTP *ImportSourceHTTP `json:"http,omitempty"`
+
+	// Upload reads the description from a ConfigMap, and creates upload DataVolumes the disks
+	// are uploaded to. OVA bundles cannot be uploaded, their content has to be uploaded instead.
+	// +optional
+	Upload *ImportSourceUpload `json:"upload,omitempty"`
+}
+
+// ImportSourceHTTP describes a description served by a web server
+type ImportSourceHTTP struct {
+	// URL is the location of the OVA bundle, OVF descriptor or libvirt domain XML.
+	// The disks referenced by OVF descriptors and libvirt domain XMLs are imported from
+	// the same location as the description.
+	URL string `json:"url"`
+
+	// SecretRef is the name of the secret holding, in its accessKeyId and secretKey keys,
+	// the credentials of the web server
+	// +optional
+	SecretRef string `json:"secretRef,omitempty"`
+
+	// CertConfigMap is the name of the ConfigMap holding the certificate authority of the web server
+	// +optional
+	CertConfigMap string `json:"certConfigMap,omitempty"`
+}
+
+// ImportSourceUpload describes a description held by a ConfigMap
+type ImportSourceUpload struct {
+	// ConfigMapName is the name of the ConfigMap holding the OVF descriptor or libvirt domain XML
+	ConfigMapName string `json:"configMapName"`
+
+	// Key is the key of the description in the ConfigMap
+	Key string `json:"key"`
+}
+
+// ImportNetworkMapping maps a network of the source to a network of the virtual machine.
+// Exactly one of Pod and MultusNetworkName must be set.
+type ImportNetworkMapping struct {
+	// Source is the name of the network in the description, the OVF network name or
+	// the libvirt network or bridge name
+	Source string `json:"source"`
+
+	// Pod connects the interfaces to the pod network
+	// +optional
+	Pod *ImportPodNetwork `json:"pod,omitempty"`
+
+	// MultusNetworkName connects the interfaces to a Multus network
+	// +optional
+	MultusNetworkName string `json:"multusNetworkName,omitempty"`
+}
+
+// ImportPodNetwork connects interfaces to the pod network
+type ImportPodNetwork struct{}
+
+// ImportDiskBus is the bus an imported disk is attached to
+type ImportDiskBus string
+
+const (
+	ImportDiskBusVirtio ImportDiskBus = "virtio"
+	ImportDiskBusSATA   ImportDiskBus = "sata"
+	ImportDiskBusSCSI   ImportDiskBus = "scsi"
+)
+
+// ImportDiskMapping overrides how a disk of the source is imported
+type ImportDiskMapping struct {
+	// Source is the name of the disk in the description, the OVF disk id or the libvirt target device
+	Source string `json:"source"`
+
+	// Bus is the bus the disk is attached to, the bus of the disk in the source when unset
+	// +optional
+	// +kubebuilder:validation:Enum=virtio;sata;scsi
+	Bus ImportDiskBus `json:"bus,omitempty"`
+
+	// Size is the size of the imported disk, the capacity of the disk in the source when unset.
+	// It is required for libvirt domain XMLs, which do not record the capacity of the disks.
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty"`
+
+	// StorageClassName is the storage class of the imported disk, the storage class of the import when unset
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+}
+
+// VirtualMachineImportPhase is the current phase of the VirtualMachineImport
+type VirtualMachineImportPhase string
+
+const (
+	// ImportPending means the description of the virtual machine is being read
+	ImportPending VirtualMachineImportPhase = "Pending"
+	// ImportImporting means the virtual machine was created and its disks are being imported
+	ImportImporting VirtualMachineImportPhase = "Importing"
+	// ImportSucceeded means the virtual machine and its disks were imported
+	ImportSucceeded VirtualMachineImportPhase = "Succeeded"
+	// ImportFailed means the virtual machine could not be imported
+	ImportFailed VirtualMachineImportPhase = "Failed"
+)
+
+// VirtualMachineImportStatus is the status for a VirtualMachineImport resource
+type VirtualMachineImportStatus struct {
+	// +optional
+	Phase VirtualMachineImportPhase `json:"phase,omitempty"`
+
+	// +optional
+	// VirtualMachineName is the name of the imported virtual machine
+	VirtualMachineName *string `json:"virtualMachineName,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// Disks lists the imported disks
+	Disks []ImportedDisk `json:"disks,omitempty"`
+
+	// +optional
+	// CompletionTime is the time the virtual machine was imported
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []exportv1beta1.Condition `json:"conditions,omitempty"`
+}
+
+// ImportedDisk describes a disk imported into a DataVolume
+type ImportedDisk struct {
+	// Name is the name of the disk in the description
+	Name string `json:"name"`
+
+	// DataVolumeName is the name of the DataVolume the disk is imported into, or uploaded to
+	DataVolumeName string `json:"dataVolumeName"`
+
+	// +optional
+	// Progress is the import progress reported by the DataVolume
+	Progress string `json:"progress,omitempty"`
+}
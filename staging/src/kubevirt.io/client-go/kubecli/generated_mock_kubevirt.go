@@ -83,13 +83,16 @@ import (
 	containerizeddataimporter "kubevirt.io/client-go/containerizeddataimporter"
 	externalsnapshotter "kubevirt.io/client-go/externalsnapshotter"
 	kubevirt "kubevirt.io/client-go/kubevirt"
+	v1alpha111 "kubevirt.io/client-go/kubevirt/typed/autoscaling/v1alpha1"
 	v1beta117 "kubevirt.io/client-go/kubevirt/typed/clone/v1beta1"
 	v122 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 	v1beta118 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1"
 	v1beta119 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
 	v1alpha19 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
 	v1alpha110 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1"
+	v1alpha112 "kubevirt.io/client-go/kubevirt/typed/quota/v1alpha1"
 	v1beta120 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1"
+	v1beta121 "kubevirt.io/client-go/kubevirt/typed/vmimport/v1beta1"
 	networkattachmentdefinitionclient "kubevirt.io/client-go/networkattachmentdefinitionclient"
 	prometheusoperator "kubevirt.io/client-go/prometheusoperator"
 	version "kubevirt.io/client-go/version"
@@ -791,6 +794,34 @@ func (mr *MockKubevirtClientMockRecorder) MigrationPolicyClient() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrationPolicyClient", reflect.TypeOf((*MockKubevirtClient)(nil).MigrationPolicyClient))
 }
 
+// MigrationRebalancePolicy mocks base method.
+func (m *MockKubevirtClient) MigrationRebalancePolicy() v1alpha19.MigrationRebalancePolicyInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrationRebalancePolicy")
+	ret0, _ := ret[0].(v1alpha19.MigrationRebalancePolicyInterface)
+	return ret0
+}
+
+// MigrationRebalancePolicy indicates an expected call of MigrationRebalancePolicy.
+func (mr *MockKubevirtClientMockRecorder) MigrationRebalancePolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrationRebalancePolicy", reflect.TypeOf((*MockKubevirtClient)(nil).MigrationRebalancePolicy))
+}
+
+// MigrationRetryBudget mocks base method.
+func (m *MockKubevirtClient) MigrationRetryBudget(namespace string) v1alpha19.MigrationRetryBudgetInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrationRetryBudget", namespace)
+	ret0, _ := ret[0].(v1alpha19.MigrationRetryBudgetInterface)
+	return ret0
+}
+
+// MigrationRetryBudget indicates an expected call of MigrationRetryBudget.
+func (mr *MockKubevirtClientMockRecorder) MigrationRetryBudget(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrationRetryBudget", reflect.TypeOf((*MockKubevirtClient)(nil).MigrationRetryBudget), namespace)
+}
+
 // NetworkClient mocks base method.
 func (m *MockKubevirtClient) NetworkClient() networkattachmentdefinitionclient.Interface {
 	m.ctrl.T.Helper()
@@ -1198,6 +1229,20 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachine(namespace any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachine", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachine), namespace)
 }
 
+// VirtualMachineAutoscalingPolicy mocks base method.
+func (m *MockKubevirtClient) VirtualMachineAutoscalingPolicy(namespace string) v1alpha111.VirtualMachineAutoscalingPolicyInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineAutoscalingPolicy", namespace)
+	ret0, _ := ret[0].(v1alpha111.VirtualMachineAutoscalingPolicyInterface)
+	return ret0
+}
+
+// VirtualMachineAutoscalingPolicy indicates an expected call of VirtualMachineAutoscalingPolicy.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineAutoscalingPolicy(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineAutoscalingPolicy", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineAutoscalingPolicy), namespace)
+}
+
 // VirtualMachineClone mocks base method.
 func (m *MockKubevirtClient) VirtualMachineClone(namespace string) v1beta117.VirtualMachineCloneInterface {
 	m.ctrl.T.Helper()
@@ -1212,6 +1257,20 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineClone(namespace any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineClone", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineClone), namespace)
 }
 
+// VirtualMachineClusterDefault mocks base method.
+func (m *MockKubevirtClient) VirtualMachineClusterDefault() v1beta119.VirtualMachineClusterDefaultInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineClusterDefault")
+	ret0, _ := ret[0].(v1beta119.VirtualMachineClusterDefaultInterface)
+	return ret0
+}
+
+// VirtualMachineClusterDefault indicates an expected call of VirtualMachineClusterDefault.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineClusterDefault() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineClusterDefault", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineClusterDefault))
+}
+
 // VirtualMachineClusterInstancetype mocks base method.
 func (m *MockKubevirtClient) VirtualMachineClusterInstancetype() v1beta119.VirtualMachineClusterInstancetypeInterface {
 	m.ctrl.T.Helper()
@@ -1240,6 +1299,34 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineClusterPreference() *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineClusterPreference", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineClusterPreference))
 }
 
+// VirtualMachineDefault mocks base method.
+func (m *MockKubevirtClient) VirtualMachineDefault(namespace string) v1beta119.VirtualMachineDefaultInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineDefault", namespace)
+	ret0, _ := ret[0].(v1beta119.VirtualMachineDefaultInterface)
+	return ret0
+}
+
+// VirtualMachineDefault indicates an expected call of VirtualMachineDefault.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineDefault(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineDefault", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineDefault), namespace)
+}
+
+// VirtualMachineDisruptionBudget mocks base method.
+func (m *MockKubevirtClient) VirtualMachineDisruptionBudget(namespace string) v1alpha19.VirtualMachineDisruptionBudgetInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineDisruptionBudget", namespace)
+	ret0, _ := ret[0].(v1alpha19.VirtualMachineDisruptionBudgetInterface)
+	return ret0
+}
+
+// VirtualMachineDisruptionBudget indicates an expected call of VirtualMachineDisruptionBudget.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineDisruptionBudget(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineDisruptionBudget", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineDisruptionBudget), namespace)
+}
+
 // VirtualMachineExport mocks base method.
 func (m *MockKubevirtClient) VirtualMachineExport(namespace string) v1beta118.VirtualMachineExportInterface {
 	m.ctrl.T.Helper()
@@ -1254,6 +1341,20 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineExport(namespace any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineExport", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineExport), namespace)
 }
 
+// VirtualMachineImport mocks base method.
+func (m *MockKubevirtClient) VirtualMachineImport(namespace string) v1beta121.VirtualMachineImportInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineImport", namespace)
+	ret0, _ := ret[0].(v1beta121.VirtualMachineImportInterface)
+	return ret0
+}
+
+// VirtualMachineImport indicates an expected call of VirtualMachineImport.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineImport(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineImport", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineImport), namespace)
+}
+
 // VirtualMachineInstance mocks base method.
 func (m *MockKubevirtClient) VirtualMachineInstance(namespace string) VirtualMachineInstanceInterface {
 	m.ctrl.T.Helper()
@@ -1338,6 +1439,20 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachinePreference(namespace any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachinePreference", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachinePreference), namespace)
 }
 
+// VirtualMachineResourceQuota mocks base method.
+func (m *MockKubevirtClient) VirtualMachineResourceQuota(namespace string) v1alpha112.VirtualMachineResourceQuotaInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineResourceQuota", namespace)
+	ret0, _ := ret[0].(v1alpha112.VirtualMachineResourceQuotaInterface)
+	return ret0
+}
+
+// VirtualMachineResourceQuota indicates an expected call of VirtualMachineResourceQuota.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineResourceQuota(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineResourceQuota", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineResourceQuota), namespace)
+}
+
 // VirtualMachineRestore mocks base method.
 func (m *MockKubevirtClient) VirtualMachineRestore(namespace string) v1beta120.VirtualMachineRestoreInterface {
 	m.ctrl.T.Helper()
@@ -1380,6 +1495,90 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshotContent(namespac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineSnapshotContent", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineSnapshotContent), namespace)
 }
 
+// VirtualMachineSnapshotExport mocks base method.
+func (m *MockKubevirtClient) VirtualMachineSnapshotExport(namespace string) v1beta118.VirtualMachineSnapshotExportInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineSnapshotExport", namespace)
+	ret0, _ := ret[0].(v1beta118.VirtualMachineSnapshotExportInterface)
+	return ret0
+}
+
+// VirtualMachineSnapshotExport indicates an expected call of VirtualMachineSnapshotExport.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshotExport(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineSnapshotExport", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineSnapshotExport), namespace)
+}
+
+// VirtualMachineSnapshotGroup mocks base method.
+func (m *MockKubevirtClient) VirtualMachineSnapshotGroup(namespace string) v1beta120.VirtualMachineSnapshotGroupInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineSnapshotGroup", namespace)
+	ret0, _ := ret[0].(v1beta120.VirtualMachineSnapshotGroupInterface)
+	return ret0
+}
+
+// VirtualMachineSnapshotGroup indicates an expected call of VirtualMachineSnapshotGroup.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshotGroup(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineSnapshotGroup", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineSnapshotGroup), namespace)
+}
+
+// VirtualMachineSnapshotGroupRestore mocks base method.
+func (m *MockKubevirtClient) VirtualMachineSnapshotGroupRestore(namespace string) v1beta120.VirtualMachineSnapshotGroupRestoreInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineSnapshotGroupRestore", namespace)
+	ret0, _ := ret[0].(v1beta120.VirtualMachineSnapshotGroupRestoreInterface)
+	return ret0
+}
+
+// VirtualMachineSnapshotGroupRestore indicates an expected call of VirtualMachineSnapshotGroupRestore.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshotGroupRestore(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineSnapshotGroupRestore", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineSnapshotGroupRestore), namespace)
+}
+
+// VirtualMachineSnapshotReplication mocks base method.
+func (m *MockKubevirtClient) VirtualMachineSnapshotReplication(namespace string) v1beta118.VirtualMachineSnapshotReplicationInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineSnapshotReplication", namespace)
+	ret0, _ := ret[0].(v1beta118.VirtualMachineSnapshotReplicationInterface)
+	return ret0
+}
+
+// VirtualMachineSnapshotReplication indicates an expected call of VirtualMachineSnapshotReplication.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshotReplication(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineSnapshotReplication", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineSnapshotReplication), namespace)
+}
+
+// VirtualMachineSnapshotSchedule mocks base method.
+func (m *MockKubevirtClient) VirtualMachineSnapshotSchedule(namespace string) v1beta120.VirtualMachineSnapshotScheduleInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineSnapshotSchedule", namespace)
+	ret0, _ := ret[0].(v1beta120.VirtualMachineSnapshotScheduleInterface)
+	return ret0
+}
+
+// VirtualMachineSnapshotSchedule indicates an expected call of VirtualMachineSnapshotSchedule.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshotSchedule(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineSnapshotSchedule", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineSnapshotSchedule), namespace)
+}
+
+// VolumeMigration mocks base method.
+func (m *MockKubevirtClient) VolumeMigration(namespace string) v1alpha19.VolumeMigrationInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeMigration", namespace)
+	ret0, _ := ret[0].(v1alpha19.VolumeMigrationInterface)
+	return ret0
+}
+
+// VolumeMigration indicates an expected call of VolumeMigration.
+func (mr *MockKubevirtClientMockRecorder) VolumeMigration(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeMigration", reflect.TypeOf((*MockKubevirtClient)(nil).VolumeMigration), namespace)
+}
+
 // MockVirtualMachineInstanceInterface is a mock of VirtualMachineInstanceInterface interface.
 type MockVirtualMachineInstanceInterface struct {
 	ctrl     *gomock.Controller
@@ -1404,6 +1603,20 @@ func (m *MockVirtualMachineInstanceInterface) EXPECT() *MockVirtualMachineInstan
 	return m.recorder
 }
 
+// AddUSBDevice mocks base method.
+func (m *MockVirtualMachineInstanceInterface) AddUSBDevice(ctx context.Context, name string, addUSBDeviceOptions *v121.AddUSBDeviceOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUSBDevice", ctx, name, addUSBDeviceOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUSBDevice indicates an expected call of AddUSBDevice.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) AddUSBDevice(ctx, name, addUSBDeviceOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUSBDevice", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).AddUSBDevice), ctx, name, addUSBDeviceOptions)
+}
+
 // AddVolume mocks base method.
 func (m *MockVirtualMachineInstanceInterface) AddVolume(ctx context.Context, name string, addVolumeOptions *v121.AddVolumeOptions) error {
 	m.ctrl.T.Helper()
@@ -1505,6 +1718,50 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Get(ctx, name, opts a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Get), ctx, name, opts)
 }
 
+// GuestFileRead mocks base method.
+func (m *MockVirtualMachineInstanceInterface) GuestFileRead(ctx context.Context, name string, guestFileReadOptions *v121.GuestFileReadOptions) (v121.GuestFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileRead", ctx, name, guestFileReadOptions)
+	ret0, _ := ret[0].(v121.GuestFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestFileRead indicates an expected call of GuestFileRead.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) GuestFileRead(ctx, name, guestFileReadOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileRead", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).GuestFileRead), ctx, name, guestFileReadOptions)
+}
+
+// GuestFileWrite mocks base method.
+func (m *MockVirtualMachineInstanceInterface) GuestFileWrite(ctx context.Context, name string, guestFile *v121.GuestFile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestFileWrite", ctx, name, guestFile)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GuestFileWrite indicates an expected call of GuestFileWrite.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) GuestFileWrite(ctx, name, guestFile any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestFileWrite", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).GuestFileWrite), ctx, name, guestFile)
+}
+
+// GuestOSExec mocks base method.
+func (m *MockVirtualMachineInstanceInterface) GuestOSExec(ctx context.Context, name string, guestOSExecOptions *v121.GuestOSExecOptions) (v121.GuestOSExecResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GuestOSExec", ctx, name, guestOSExecOptions)
+	ret0, _ := ret[0].(v121.GuestOSExecResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GuestOSExec indicates an expected call of GuestOSExec.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) GuestOSExec(ctx, name, guestOSExecOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestOSExec", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).GuestOSExec), ctx, name, guestOSExecOptions)
+}
+
 // GuestOsInfo mocks base method.
 func (m *MockVirtualMachineInstanceInterface) GuestOsInfo(ctx context.Context, name string) (v121.VirtualMachineInstanceGuestAgentInfo, error) {
 	m.ctrl.T.Helper()
@@ -1535,6 +1792,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) List(ctx, opts any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).List), ctx, opts)
 }
 
+// MemoryDumpStream mocks base method.
+func (m *MockVirtualMachineInstanceInterface) MemoryDumpStream(name string, options *v121.MemoryDumpStreamOptions) (v122.StreamInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MemoryDumpStream", name, options)
+	ret0, _ := ret[0].(v122.StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MemoryDumpStream indicates an expected call of MemoryDumpStream.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) MemoryDumpStream(name, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemoryDumpStream", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).MemoryDumpStream), name, options)
+}
+
 // ObjectGraph mocks base method.
 func (m *MockVirtualMachineInstanceInterface) ObjectGraph(ctx context.Context, name string, objectGraphOptions *v121.ObjectGraphOptions) (v121.ObjectGraphNode, error) {
 	m.ctrl.T.Helper()
@@ -1599,6 +1871,35 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) PortForward(name, por
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForward", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).PortForward), name, port, protocol)
 }
 
+// PortForwardMultiplexed mocks base method.
+func (m *MockVirtualMachineInstanceInterface) PortForwardMultiplexed(name string) (v122.StreamInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PortForwardMultiplexed", name)
+	ret0, _ := ret[0].(v122.StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PortForwardMultiplexed indicates an expected call of PortForwardMultiplexed.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) PortForwardMultiplexed(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForwardMultiplexed", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).PortForwardMultiplexed), name)
+}
+
+// RemoveUSBDevice mocks base method.
+func (m *MockVirtualMachineInstanceInterface) RemoveUSBDevice(ctx context.Context, name string, removeUSBDeviceOptions *v121.RemoveUSBDeviceOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUSBDevice", ctx, name, removeUSBDeviceOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUSBDevice indicates an expected call of RemoveUSBDevice.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) RemoveUSBDevice(ctx, name, removeUSBDeviceOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUSBDevice", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).RemoveUSBDevice), ctx, name, removeUSBDeviceOptions)
+}
+
 // RemoveVolume mocks base method.
 func (m *MockVirtualMachineInstanceInterface) RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v121.RemoveVolumeOptions) error {
 	m.ctrl.T.Helper()
@@ -1613,6 +1914,20 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) RemoveVolume(ctx, nam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVolume", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).RemoveVolume), ctx, name, removeVolumeOptions)
 }
 
+// RepinVCPUs mocks base method.
+func (m *MockVirtualMachineInstanceInterface) RepinVCPUs(ctx context.Context, name string, repinVCPUsOptions *v121.RepinVCPUsOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepinVCPUs", ctx, name, repinVCPUsOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RepinVCPUs indicates an expected call of RepinVCPUs.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) RepinVCPUs(ctx, name, repinVCPUsOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepinVCPUs", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).RepinVCPUs), ctx, name, repinVCPUsOptions)
+}
+
 // Reset mocks base method.
 func (m *MockVirtualMachineInstanceInterface) Reset(ctx context.Context, name string) error {
 	m.ctrl.T.Helper()
@@ -1685,6 +2000,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) SEVSetupSession(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SEVSetupSession", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).SEVSetupSession), ctx, name, sevSessionOptions)
 }
 
+// SPICE mocks base method.
+func (m *MockVirtualMachineInstanceInterface) SPICE(name string) (v122.StreamInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SPICE", name)
+	ret0, _ := ret[0].(v122.StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SPICE indicates an expected call of SPICE.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) SPICE(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SPICE", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).SPICE), name)
+}
+
 // Screenshot mocks base method.
 func (m *MockVirtualMachineInstanceInterface) Screenshot(ctx context.Context, name string, options *v121.ScreenshotOptions) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -1715,6 +2045,20 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) SerialConsole(name, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerialConsole", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).SerialConsole), name, options)
 }
 
+// SetIOLimits mocks base method.
+func (m *MockVirtualMachineInstanceInterface) SetIOLimits(ctx context.Context, name string, setIOLimitsOptions *v121.SetIOLimitsOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIOLimits", ctx, name, setIOLimitsOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetIOLimits indicates an expected call of SetIOLimits.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) SetIOLimits(ctx, name, setIOLimitsOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIOLimits", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).SetIOLimits), ctx, name, setIOLimitsOptions)
+}
+
 // SoftReboot mocks base method.
 func (m *MockVirtualMachineInstanceInterface) SoftReboot(ctx context.Context, name string) error {
 	m.ctrl.T.Helper()
@@ -1729,6 +2073,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) SoftReboot(ctx, name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftReboot", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).SoftReboot), ctx, name)
 }
 
+// Stats mocks base method.
+func (m *MockVirtualMachineInstanceInterface) Stats(ctx context.Context, name string) (v121.VirtualMachineInstanceStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", ctx, name)
+	ret0, _ := ret[0].(v121.VirtualMachineInstanceStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Stats(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Stats), ctx, name)
+}
+
 // USBRedir mocks base method.
 func (m *MockVirtualMachineInstanceInterface) USBRedir(vmiName string) (v122.StreamInterface, error) {
 	m.ctrl.T.Helper()
@@ -2239,6 +2598,20 @@ func (mr *MockVirtualMachineInterfaceMockRecorder) AddVolume(ctx, name, addVolum
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVolume", reflect.TypeOf((*MockVirtualMachineInterface)(nil).AddVolume), ctx, name, addVolumeOptions)
 }
 
+// ApplyChanges mocks base method.
+func (m *MockVirtualMachineInterface) ApplyChanges(ctx context.Context, name string, applyChangesOptions *v121.ApplyChangesOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyChanges", ctx, name, applyChangesOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyChanges indicates an expected call of ApplyChanges.
+func (mr *MockVirtualMachineInterfaceMockRecorder) ApplyChanges(ctx, name, applyChangesOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyChanges", reflect.TypeOf((*MockVirtualMachineInterface)(nil).ApplyChanges), ctx, name, applyChangesOptions)
+}
+
 // Create mocks base method.
 func (m *MockVirtualMachineInterface) Create(ctx context.Context, virtualMachine *v121.VirtualMachine, opts v12.CreateOptions) (*v121.VirtualMachine, error) {
 	m.ctrl.T.Helper()
@@ -2312,6 +2685,20 @@ func (mr *MockVirtualMachineInterfaceMockRecorder) GetWithExpandedSpec(ctx, name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithExpandedSpec", reflect.TypeOf((*MockVirtualMachineInterface)(nil).GetWithExpandedSpec), ctx, name)
 }
 
+// Hibernate mocks base method.
+func (m *MockVirtualMachineInterface) Hibernate(ctx context.Context, name string, hibernateOptions *v121.HibernateOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hibernate", ctx, name, hibernateOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Hibernate indicates an expected call of Hibernate.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Hibernate(ctx, name, hibernateOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hibernate", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Hibernate), ctx, name, hibernateOptions)
+}
+
 // List mocks base method.
 func (m *MockVirtualMachineInterface) List(ctx context.Context, opts v12.ListOptions) (*v121.VirtualMachineList, error) {
 	m.ctrl.T.Helper()
@@ -2420,6 +2807,21 @@ func (mr *MockVirtualMachineInterfaceMockRecorder) PortForward(name, port, proto
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForward", reflect.TypeOf((*MockVirtualMachineInterface)(nil).PortForward), name, port, protocol)
 }
 
+// PortForwardMultiplexed mocks base method.
+func (m *MockVirtualMachineInterface) PortForwardMultiplexed(name string) (v122.StreamInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PortForwardMultiplexed", name)
+	ret0, _ := ret[0].(v122.StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PortForwardMultiplexed indicates an expected call of PortForwardMultiplexed.
+func (mr *MockVirtualMachineInterfaceMockRecorder) PortForwardMultiplexed(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForwardMultiplexed", reflect.TypeOf((*MockVirtualMachineInterface)(nil).PortForwardMultiplexed), name)
+}
+
 // RemoveMemoryDump mocks base method.
 func (m *MockVirtualMachineInterface) RemoveMemoryDump(ctx context.Context, name string) error {
 	m.ctrl.T.Helper()
@@ -2448,6 +2850,20 @@ func (mr *MockVirtualMachineInterfaceMockRecorder) RemoveVolume(ctx, name, remov
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVolume", reflect.TypeOf((*MockVirtualMachineInterface)(nil).RemoveVolume), ctx, name, removeVolumeOptions)
 }
 
+// Rescue mocks base method.
+func (m *MockVirtualMachineInterface) Rescue(ctx context.Context, name string, rescueOptions *v121.RescueOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rescue", ctx, name, rescueOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rescue indicates an expected call of Rescue.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Rescue(ctx, name, rescueOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rescue", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Rescue), ctx, name, rescueOptions)
+}
+
 // Restart mocks base method.
 func (m *MockVirtualMachineInterface) Restart(ctx context.Context, name string, restartOptions *v121.RestartOptions) error {
 	m.ctrl.T.Helper()
@@ -2462,6 +2878,34 @@ func (mr *MockVirtualMachineInterfaceMockRecorder) Restart(ctx, name, restartOpt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restart", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Restart), ctx, name, restartOptions)
 }
 
+// Restore mocks base method.
+func (m *MockVirtualMachineInterface) Restore(ctx context.Context, name string, restoreOptions *v121.RestoreOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, name, restoreOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Restore(ctx, name, restoreOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Restore), ctx, name, restoreOptions)
+}
+
+// Resume mocks base method.
+func (m *MockVirtualMachineInterface) Resume(ctx context.Context, name string, resumeOptions *v121.ResumeOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", ctx, name, resumeOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Resume(ctx, name, resumeOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Resume), ctx, name, resumeOptions)
+}
+
 // Start mocks base method.
 func (m *MockVirtualMachineInterface) Start(ctx context.Context, name string, startOptions *v121.StartOptions) error {
 	m.ctrl.T.Helper()
@@ -2490,6 +2934,20 @@ func (mr *MockVirtualMachineInterfaceMockRecorder) Stop(ctx, name, stopOptions a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Stop), ctx, name, stopOptions)
 }
 
+// Unrescue mocks base method.
+func (m *MockVirtualMachineInterface) Unrescue(ctx context.Context, name string, unrescueOptions *v121.UnrescueOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unrescue", ctx, name, unrescueOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unrescue indicates an expected call of Unrescue.
+func (mr *MockVirtualMachineInterfaceMockRecorder) Unrescue(ctx, name, unrescueOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unrescue", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Unrescue), ctx, name, unrescueOptions)
+}
+
 // Update mocks base method.
 func (m *MockVirtualMachineInterface) Update(ctx context.Context, virtualMachine *v121.VirtualMachine, opts v12.UpdateOptions) (*v121.VirtualMachine, error) {
 	m.ctrl.T.Helper()
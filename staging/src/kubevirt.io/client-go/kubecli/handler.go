@@ -21,7 +21,9 @@ const (
 	consoleTemplateURI        = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/console"
 	usbredirTemplateURI       = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/usbredir"
 	vncTemplateURI            = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vnc"
+	spiceTemplateURI          = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/spice"
 	vsockTemplateURI          = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vsock"
+	memoryDumpTemplateURI     = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/memorydump"
 	pauseTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/pause"
 	unpauseTemplateURI        = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unpause"
 	freezeTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/freeze"
@@ -31,6 +33,10 @@ const (
 	guestInfoTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestosinfo"
 	userListTemplateURI       = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/userlist"
 	filesystemListTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/filesystemlist"
+	guestOSExecTemplateURI    = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestosexec"
+	statsTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/stats"
+	guestFileReadTemplateURI  = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestfileread"
+	guestFileWriteTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestfilewrite"
 
 	sevFetchCertChainTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/fetchcertchain"
 	sevQueryLaunchMeasurementTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/querylaunchmeasurement"
@@ -57,7 +63,9 @@ type VirtHandlerConn interface {
 	ConsoleURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	USBRedirURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VNCURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	SPICEURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VSOCKURI(vmi *virtv1.VirtualMachineInstance, port string, tls string) (string, error)
+	MemoryDumpURI(vmi *virtv1.VirtualMachineInstance, format string, content string) (string, error)
 	PauseURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UnpauseURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error)
@@ -70,9 +78,14 @@ type VirtHandlerConn interface {
 	Pod() (pod *v1.Pod, err error)
 	Put(url string, body io.ReadCloser) error
 	Get(url string) (string, error)
+	Post(url string, body io.ReadCloser) (string, error)
 	GuestInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UserListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FilesystemListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	GuestOSExecURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	StatsURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	GuestFileReadURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	GuestFileWriteURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 }
 
 type virtHandler struct {
@@ -187,6 +200,10 @@ func (v *virtHandlerConn) VNCURI(vmi *virtv1.VirtualMachineInstance) (string, er
 	return v.formatURI(vncTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) SPICEURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(spiceTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) VSOCKURI(vmi *virtv1.VirtualMachineInstance, port string, tls string) (string, error) {
 	baseURI, err := v.formatURI(vsockTemplateURI, vmi)
 	if err != nil {
@@ -195,6 +212,14 @@ func (v *virtHandlerConn) VSOCKURI(vmi *virtv1.VirtualMachineInstance, port stri
 	return fmt.Sprintf("%s?port=%s&tls=%s", baseURI, port, tls), nil
 }
 
+func (v *virtHandlerConn) MemoryDumpURI(vmi *virtv1.VirtualMachineInstance, format string, content string) (string, error) {
+	baseURI, err := v.formatURI(memoryDumpTemplateURI, vmi)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s?format=%s&content=%s", baseURI, format, content), nil
+}
+
 func (v *virtHandlerConn) FreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(freezeTemplateURI, vmi)
 }
@@ -279,6 +304,17 @@ func (v *virtHandlerConn) Get(url string) (string, error) {
 	return response, nil
 }
 
+func (v *virtHandlerConn) Post(url string, body io.ReadCloser) (string, error) {
+	req, err := http.NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Add("Accept", "application/json")
+	req.Header.Add("Content-Type", "application/json")
+	return v.doRequest(req)
+}
+
 func (v *virtHandlerConn) GuestInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(guestInfoTemplateURI, vmi)
 }
@@ -298,6 +334,22 @@ func (v *virtHandlerConn) FilesystemListURI(vmi *virtv1.VirtualMachineInstance)
 	return v.formatURI(filesystemListTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) GuestOSExecURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(guestOSExecTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) StatsURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(statsTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) GuestFileReadURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(guestFileReadTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) GuestFileWriteURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(guestFileWriteTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(sevFetchCertChainTemplateURI, vmi)
 }
@@ -43,12 +43,15 @@ import (
 	cdiclient "kubevirt.io/client-go/containerizeddataimporter"
 	k8ssnapshotclient "kubevirt.io/client-go/externalsnapshotter"
 	generatedclient "kubevirt.io/client-go/kubevirt"
+	autoscalingv1 "kubevirt.io/client-go/kubevirt/typed/autoscaling/v1alpha1"
 	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 	exportv1 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
 	migrationsv1 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
 	poolv1 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1"
+	quotav1 "kubevirt.io/client-go/kubevirt/typed/quota/v1alpha1"
 	snapshotv1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1"
+	importv1 "kubevirt.io/client-go/kubevirt/typed/vmimport/v1beta1"
 	networkclient "kubevirt.io/client-go/networkattachmentdefinitionclient"
 	promclient "kubevirt.io/client-go/prometheusoperator"
 	"kubevirt.io/client-go/version"
@@ -59,18 +62,32 @@ type KubevirtClient interface {
 	VirtualMachineInstanceMigration(namespace string) VirtualMachineInstanceMigrationInterface
 	ReplicaSet(namespace string) ReplicaSetInterface
 	VirtualMachinePool(namespace string) poolv1.VirtualMachinePoolInterface
+	VirtualMachineAutoscalingPolicy(namespace string) autoscalingv1.VirtualMachineAutoscalingPolicyInterface
+	VirtualMachineResourceQuota(namespace string) quotav1.VirtualMachineResourceQuotaInterface
+	VirtualMachineDisruptionBudget(namespace string) migrationsv1.VirtualMachineDisruptionBudgetInterface
 	VirtualMachine(namespace string) VirtualMachineInterface
 	KubeVirt(namespace string) KubeVirtInterface
 	VirtualMachineInstancePreset(namespace string) VirtualMachineInstancePresetInterface
 	VirtualMachineSnapshot(namespace string) snapshotv1.VirtualMachineSnapshotInterface
 	VirtualMachineSnapshotContent(namespace string) snapshotv1.VirtualMachineSnapshotContentInterface
 	VirtualMachineRestore(namespace string) snapshotv1.VirtualMachineRestoreInterface
+	VirtualMachineSnapshotSchedule(namespace string) snapshotv1.VirtualMachineSnapshotScheduleInterface
+	VirtualMachineSnapshotGroup(namespace string) snapshotv1.VirtualMachineSnapshotGroupInterface
+	VirtualMachineSnapshotGroupRestore(namespace string) snapshotv1.VirtualMachineSnapshotGroupRestoreInterface
 	VirtualMachineExport(namespace string) exportv1.VirtualMachineExportInterface
+	VirtualMachineSnapshotExport(namespace string) exportv1.VirtualMachineSnapshotExportInterface
+	VirtualMachineSnapshotReplication(namespace string) exportv1.VirtualMachineSnapshotReplicationInterface
+	VirtualMachineImport(namespace string) importv1.VirtualMachineImportInterface
 	VirtualMachineInstancetype(namespace string) instancetypev1beta1.VirtualMachineInstancetypeInterface
 	VirtualMachineClusterInstancetype() instancetypev1beta1.VirtualMachineClusterInstancetypeInterface
 	VirtualMachinePreference(namespace string) instancetypev1beta1.VirtualMachinePreferenceInterface
 	VirtualMachineClusterPreference() instancetypev1beta1.VirtualMachineClusterPreferenceInterface
+	VirtualMachineDefault(namespace string) instancetypev1beta1.VirtualMachineDefaultInterface
+	VirtualMachineClusterDefault() instancetypev1beta1.VirtualMachineClusterDefaultInterface
 	MigrationPolicy() migrationsv1.MigrationPolicyInterface
+	VolumeMigration(namespace string) migrationsv1.VolumeMigrationInterface
+	MigrationRetryBudget(namespace string) migrationsv1.MigrationRetryBudgetInterface
+	MigrationRebalancePolicy() migrationsv1.MigrationRebalancePolicyInterface
 	ExpandSpec(namespace string) ExpandSpecInterface
 	ServerVersion() ServerVersionInterface
 	VirtualMachineClone(namespace string) clone.VirtualMachineCloneInterface
@@ -171,6 +188,18 @@ func (k kubevirtClient) VirtualMachinePool(namespace string) poolv1.VirtualMachi
 	return k.generatedKubeVirtClient.PoolV1alpha1().VirtualMachinePools(namespace)
 }
 
+func (k kubevirtClient) VirtualMachineAutoscalingPolicy(namespace string) autoscalingv1.VirtualMachineAutoscalingPolicyInterface {
+	return k.generatedKubeVirtClient.AutoscalingV1alpha1().VirtualMachineAutoscalingPolicies(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineResourceQuota(namespace string) quotav1.VirtualMachineResourceQuotaInterface {
+	return k.generatedKubeVirtClient.QuotaV1alpha1().VirtualMachineResourceQuotas(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineDisruptionBudget(namespace string) migrationsv1.VirtualMachineDisruptionBudgetInterface {
+	return k.generatedKubeVirtClient.MigrationsV1alpha1().VirtualMachineDisruptionBudgets(namespace)
+}
+
 func (k kubevirtClient) VirtualMachineSnapshot(namespace string) snapshotv1.VirtualMachineSnapshotInterface {
 	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshots(namespace)
 }
@@ -183,10 +212,34 @@ func (k kubevirtClient) VirtualMachineRestore(namespace string) snapshotv1.Virtu
 	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineRestores(namespace)
 }
 
+func (k kubevirtClient) VirtualMachineSnapshotSchedule(namespace string) snapshotv1.VirtualMachineSnapshotScheduleInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineSnapshotGroup(namespace string) snapshotv1.VirtualMachineSnapshotGroupInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineSnapshotGroupRestore(namespace string) snapshotv1.VirtualMachineSnapshotGroupRestoreInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroupRestores(namespace)
+}
+
 func (k kubevirtClient) VirtualMachineExport(namespace string) exportv1.VirtualMachineExportInterface {
 	return k.generatedKubeVirtClient.ExportV1beta1().VirtualMachineExports(namespace)
 }
 
+func (k kubevirtClient) VirtualMachineSnapshotExport(namespace string) exportv1.VirtualMachineSnapshotExportInterface {
+	return k.generatedKubeVirtClient.ExportV1beta1().VirtualMachineSnapshotExports(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineSnapshotReplication(namespace string) exportv1.VirtualMachineSnapshotReplicationInterface {
+	return k.generatedKubeVirtClient.ExportV1beta1().VirtualMachineSnapshotReplications(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineImport(namespace string) importv1.VirtualMachineImportInterface {
+	return k.generatedKubeVirtClient.ImportV1beta1().VirtualMachineImports(namespace)
+}
+
 func (k kubevirtClient) VirtualMachineInstancetype(namespace string) instancetypev1beta1.VirtualMachineInstancetypeInterface {
 	return k.generatedKubeVirtClient.InstancetypeV1beta1().VirtualMachineInstancetypes(namespace)
 }
@@ -203,6 +256,14 @@ func (k kubevirtClient) VirtualMachineClusterPreference() instancetypev1beta1.Vi
 	return k.generatedKubeVirtClient.InstancetypeV1beta1().VirtualMachineClusterPreferences()
 }
 
+func (k kubevirtClient) VirtualMachineDefault(namespace string) instancetypev1beta1.VirtualMachineDefaultInterface {
+	return k.generatedKubeVirtClient.InstancetypeV1beta1().VirtualMachineDefaults(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineClusterDefault() instancetypev1beta1.VirtualMachineClusterDefaultInterface {
+	return k.generatedKubeVirtClient.InstancetypeV1beta1().VirtualMachineClusterDefaults()
+}
+
 func (k kubevirtClient) KubernetesSnapshotClient() k8ssnapshotclient.Interface {
 	return k.snapshotClient
 }
@@ -215,6 +276,18 @@ func (k kubevirtClient) MigrationPolicy() migrationsv1.MigrationPolicyInterface
 	return k.generatedKubeVirtClient.MigrationsV1alpha1().MigrationPolicies()
 }
 
+func (k kubevirtClient) VolumeMigration(namespace string) migrationsv1.VolumeMigrationInterface {
+	return k.generatedKubeVirtClient.MigrationsV1alpha1().VolumeMigrations(namespace)
+}
+
+func (k kubevirtClient) MigrationRetryBudget(namespace string) migrationsv1.MigrationRetryBudgetInterface {
+	return k.generatedKubeVirtClient.MigrationsV1alpha1().MigrationRetryBudgets(namespace)
+}
+
+func (k kubevirtClient) MigrationRebalancePolicy() migrationsv1.MigrationRebalancePolicyInterface {
+	return k.generatedKubeVirtClient.MigrationsV1alpha1().MigrationRebalancePolicies()
+}
+
 func (k kubevirtClient) MigrationPolicyClient() *migrationsv1.MigrationsV1alpha1Client {
 	return k.migrationsClient
 }
@@ -101,3 +101,7 @@ func (v *vm) UpdateStatus(ctx context.Context, vmi *v1.VirtualMachine, opts k8sm
 func (v *vm) PortForward(name string, port int, protocol string) (kvcorev1.StreamInterface, error) {
 	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, buildPortForwardResourcePath(port, protocol), url.Values{})
 }
+
+func (v *vm) PortForwardMultiplexed(name string) (kvcorev1.StreamInterface, error) {
+	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "portforward", url.Values{})
+}
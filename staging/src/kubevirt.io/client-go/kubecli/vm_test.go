@@ -247,6 +247,40 @@ var _ = Describe("Kubevirt VirtualMachine Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should restore a VirtualMachine", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", path.Join(proxyPath, subVMPath, "restore")),
+			ghttp.RespondWithJSONEncoded(http.StatusAccepted, nil),
+		))
+		err = client.VirtualMachine(k8sv1.NamespaceDefault).Restore(context.Background(), "testvm", &virtv1.RestoreOptions{VirtualMachineSnapshotName: "testsnapshot", Start: true})
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
+	DescribeTable("should apply the staged changes of a VirtualMachine", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", path.Join(proxyPath, subVMPath, "applychanges")),
+			ghttp.RespondWithJSONEncoded(http.StatusAccepted, nil),
+		))
+		err = client.VirtualMachine(k8sv1.NamespaceDefault).ApplyChanges(context.Background(), "testvm", &virtv1.ApplyChangesOptions{Method: virtv1.ApplyChangesMethodHotplug})
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
 	AfterEach(func() {
 		server.Close()
 	})
@@ -67,10 +67,18 @@ func (v *vmis) VNC(name string) (kvcorev1.StreamInterface, error) {
 	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "vnc", url.Values{})
 }
 
+func (v *vmis) SPICE(name string) (kvcorev1.StreamInterface, error) {
+	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "spice", url.Values{})
+}
+
 func (v *vmis) PortForward(name string, port int, protocol string) (kvcorev1.StreamInterface, error) {
 	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, buildPortForwardResourcePath(port, protocol), url.Values{})
 }
 
+func (v *vmis) PortForwardMultiplexed(name string) (kvcorev1.StreamInterface, error) {
+	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "portforward", url.Values{})
+}
+
 func buildPortForwardResourcePath(port int, protocol string) string {
 	resource := strings.Builder{}
 	resource.WriteString("portforward/")
@@ -179,3 +187,12 @@ func (v *vmis) VSOCK(name string, options *v1.VSOCKOptions) (kvcorev1.StreamInte
 	queryParams.Add("tls", strconv.FormatBool(useTLS))
 	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "vsock", queryParams)
 }
+
+func (v *vmis) MemoryDumpStream(name string, options *v1.MemoryDumpStreamOptions) (kvcorev1.StreamInterface, error) {
+	queryParams := url.Values{}
+	if options != nil {
+		queryParams.Add("format", string(options.Format))
+		queryParams.Add("content", string(options.Content))
+	}
+	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "memorydump", queryParams)
+}
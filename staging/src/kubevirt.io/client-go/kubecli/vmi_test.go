@@ -449,6 +449,37 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should fetch Stats from VirtualMachineInstance via subresource", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		stats := v1.VirtualMachineInstanceStats{
+			Timestamp: k8smetav1.Unix(1000, 0),
+			CPU: v1.VirtualMachineInstanceCPUStats{
+				VCPUs:           2,
+				TimeNanoseconds: 1000000,
+			},
+			Storage: []v1.VirtualMachineInstanceStorageStats{
+				{
+					Name:      "disk0",
+					ReadBytes: 4096,
+				},
+			},
+		}
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, subVMIPath, "stats")),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, stats),
+		))
+		fetchedStats, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).Stats(context.Background(), "testvm")
+
+		Expect(err).ToNot(HaveOccurred(), "should fetch stats normally")
+		Expect(fetchedStats).To(Equal(stats), "fetched stats should be the same as passed in")
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
 	DescribeTable("should fetch SEV platform info via subresource", func(proxyPath string) {
 		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
 		Expect(err).ToNot(HaveOccurred())
@@ -530,6 +561,40 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should set the IO limits of a disk of a VirtualMachineInstance", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", path.Join(proxyPath, subVMIPath, "iolimits")),
+			ghttp.RespondWithJSONEncoded(http.StatusAccepted, nil),
+		))
+		err = client.VirtualMachineInstance(k8sv1.NamespaceDefault).SetIOLimits(context.Background(), "testvm", &v1.SetIOLimitsOptions{Disk: "disk0"})
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
+	DescribeTable("should request to re-pin the vCPUs of a VirtualMachineInstance", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", path.Join(proxyPath, subVMIPath, "repin")),
+			ghttp.RespondWithJSONEncoded(http.StatusAccepted, nil),
+		))
+		err = client.VirtualMachineInstance(k8sv1.NamespaceDefault).RepinVCPUs(context.Background(), "testvm", &v1.RepinVCPUsOptions{Pinning: []v1.VCPUPin{{VCPU: 0, CPUSet: "2"}}})
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
 	AfterEach(func() {
 		server.Close()
 	})
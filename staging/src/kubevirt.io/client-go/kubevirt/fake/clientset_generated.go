@@ -27,6 +27,8 @@ import (
 	fakediscovery "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/testing"
 	clientset "kubevirt.io/client-go/kubevirt"
+	autoscalingv1alpha1 "kubevirt.io/client-go/kubevirt/typed/autoscaling/v1alpha1"
+	fakeautoscalingv1alpha1 "kubevirt.io/client-go/kubevirt/typed/autoscaling/v1alpha1/fake"
 	clonev1alpha1 "kubevirt.io/client-go/kubevirt/typed/clone/v1alpha1"
 	fakeclonev1alpha1 "kubevirt.io/client-go/kubevirt/typed/clone/v1alpha1/fake"
 	clonev1beta1 "kubevirt.io/client-go/kubevirt/typed/clone/v1beta1"
@@ -47,10 +49,14 @@ import (
 	fakemigrationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1/fake"
 	poolv1alpha1 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1"
 	fakepoolv1alpha1 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1/fake"
+	quotav1alpha1 "kubevirt.io/client-go/kubevirt/typed/quota/v1alpha1"
+	fakequotav1alpha1 "kubevirt.io/client-go/kubevirt/typed/quota/v1alpha1/fake"
 	snapshotv1alpha1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1alpha1"
 	fakesnapshotv1alpha1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1alpha1/fake"
 	snapshotv1beta1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1"
 	fakesnapshotv1beta1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1/fake"
+	importv1beta1 "kubevirt.io/client-go/kubevirt/typed/vmimport/v1beta1"
+	fakeimportv1beta1 "kubevirt.io/client-go/kubevirt/typed/vmimport/v1beta1/fake"
 )
 
 // NewSimpleClientset returns a clientset that will respond with the provided objects.
@@ -107,6 +113,11 @@ var (
 	_ testing.FakeClient  = &Clientset{}
 )
 
+// AutoscalingV1alpha1 retrieves the AutoscalingV1alpha1Client
+func (c *Clientset) AutoscalingV1alpha1() autoscalingv1alpha1.AutoscalingV1alpha1Interface {
+	return &fakeautoscalingv1alpha1.FakeAutoscalingV1alpha1{Fake: &c.Fake}
+}
+
 // CloneV1alpha1 retrieves the CloneV1alpha1Client
 func (c *Clientset) CloneV1alpha1() clonev1alpha1.CloneV1alpha1Interface {
 	return &fakeclonev1alpha1.FakeCloneV1alpha1{Fake: &c.Fake}
@@ -132,6 +143,11 @@ func (c *Clientset) ExportV1beta1() exportv1beta1.ExportV1beta1Interface {
 	return &fakeexportv1beta1.FakeExportV1beta1{Fake: &c.Fake}
 }
 
+// ImportV1beta1 retrieves the ImportV1beta1Client
+func (c *Clientset) ImportV1beta1() importv1beta1.ImportV1beta1Interface {
+	return &fakeimportv1beta1.FakeImportV1beta1{Fake: &c.Fake}
+}
+
 // InstancetypeV1alpha1 retrieves the InstancetypeV1alpha1Client
 func (c *Clientset) InstancetypeV1alpha1() instancetypev1alpha1.InstancetypeV1alpha1Interface {
 	return &fakeinstancetypev1alpha1.FakeInstancetypeV1alpha1{Fake: &c.Fake}
@@ -157,6 +173,11 @@ func (c *Clientset) PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface {
 	return &fakepoolv1alpha1.FakePoolV1alpha1{Fake: &c.Fake}
 }
 
+// QuotaV1alpha1 retrieves the QuotaV1alpha1Client
+func (c *Clientset) QuotaV1alpha1() quotav1alpha1.QuotaV1alpha1Interface {
+	return &fakequotav1alpha1.FakeQuotaV1alpha1{Fake: &c.Fake}
+}
+
 // SnapshotV1alpha1 retrieves the SnapshotV1alpha1Client
 func (c *Clientset) SnapshotV1alpha1() snapshotv1alpha1.SnapshotV1alpha1Interface {
 	return &fakesnapshotv1alpha1.FakeSnapshotV1alpha1{Fake: &c.Fake}
@@ -26,6 +26,7 @@ import (
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	serializer "k8s.io/apimachinery/pkg/runtime/serializer"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	autoscalingv1alpha1 "kubevirt.io/api/autoscaling/v1alpha1"
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 	clonev1beta1 "kubevirt.io/api/clone/v1beta1"
 	kubevirtv1 "kubevirt.io/api/core/v1"
@@ -36,24 +37,29 @@ import (
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
 	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
+	quotav1alpha1 "kubevirt.io/api/quota/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
 	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
+	importv1beta1 "kubevirt.io/api/vmimport/v1beta1"
 )
 
 var scheme = runtime.NewScheme()
 var codecs = serializer.NewCodecFactory(scheme)
 
 var localSchemeBuilder = runtime.SchemeBuilder{
+	autoscalingv1alpha1.AddToScheme,
 	clonev1alpha1.AddToScheme,
 	clonev1beta1.AddToScheme,
 	kubevirtv1.AddToScheme,
 	exportv1alpha1.AddToScheme,
 	exportv1beta1.AddToScheme,
+	importv1beta1.AddToScheme,
 	instancetypev1alpha1.AddToScheme,
 	instancetypev1alpha2.AddToScheme,
 	instancetypev1beta1.AddToScheme,
 	migrationsv1alpha1.AddToScheme,
 	poolv1alpha1.AddToScheme,
+	quotav1alpha1.AddToScheme,
 	snapshotv1alpha1.AddToScheme,
 	snapshotv1beta1.AddToScheme,
 }
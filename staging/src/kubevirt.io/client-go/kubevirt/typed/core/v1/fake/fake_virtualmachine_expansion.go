@@ -52,6 +52,34 @@ func (c *FakeVirtualMachines) Restart(ctx context.Context, name string, restartO
 	return err
 }
 
+func (c *FakeVirtualMachines) Rescue(ctx context.Context, name string, rescueOptions *v1.RescueOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "rescue", name, rescueOptions), nil)
+
+	return err
+}
+
+func (c *FakeVirtualMachines) Unrescue(ctx context.Context, name string, unrescueOptions *v1.UnrescueOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "unrescue", name, unrescueOptions), nil)
+
+	return err
+}
+
+func (c *FakeVirtualMachines) Hibernate(ctx context.Context, name string, hibernateOptions *v1.HibernateOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "hibernate", name, hibernateOptions), nil)
+
+	return err
+}
+
+func (c *FakeVirtualMachines) Resume(ctx context.Context, name string, resumeOptions *v1.ResumeOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "resume", name, resumeOptions), nil)
+
+	return err
+}
+
 func (c *FakeVirtualMachines) Start(ctx context.Context, name string, startOptions *v1.StartOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "start", name, startOptions), nil)
@@ -73,6 +101,20 @@ func (c *FakeVirtualMachines) Migrate(ctx context.Context, name string, migrateO
 	return err
 }
 
+func (c *FakeVirtualMachines) Restore(ctx context.Context, name string, restoreOptions *v1.RestoreOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "restore", name, restoreOptions), nil)
+
+	return err
+}
+
+func (c *FakeVirtualMachines) ApplyChanges(ctx context.Context, name string, applyChangesOptions *v1.ApplyChangesOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "applychanges", name, applyChangesOptions), nil)
+
+	return err
+}
+
 func (c *FakeVirtualMachines) MemoryDump(ctx context.Context, name string, memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "memorydump", name, memoryDumpRequest), nil)
@@ -105,6 +147,10 @@ func (c *FakeVirtualMachines) PortForward(name string, port int, protocol string
 	return nil, nil
 }
 
+func (c *FakeVirtualMachines) PortForwardMultiplexed(name string) (kubevirtv1.StreamInterface, error) {
+	return nil, nil
+}
+
 func (c *FakeVirtualMachines) ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error) {
 	obj, err := c.Fake.
 		Invokes(fake2.NewGetSubresourceAction(virtualmachinesResource, c.ns, "objectgraph", name, objectGraphOptions), nil)
@@ -42,6 +42,10 @@ func (c *FakeVirtualMachineInstances) VNC(name string) (kvcorev1.StreamInterface
 	return nil, nil
 }
 
+func (c *FakeVirtualMachineInstances) SPICE(name string) (kvcorev1.StreamInterface, error) {
+	return nil, nil
+}
+
 func (c *FakeVirtualMachineInstances) Screenshot(ctx context.Context, name string, options *v1.ScreenshotOptions) ([]byte, error) {
 	return nil, nil
 }
@@ -50,6 +54,10 @@ func (c *FakeVirtualMachineInstances) PortForward(name string, port int, protoco
 	return nil, nil
 }
 
+func (c *FakeVirtualMachineInstances) PortForwardMultiplexed(name string) (kvcorev1.StreamInterface, error) {
+	return nil, nil
+}
+
 func (c *FakeVirtualMachineInstances) Pause(ctx context.Context, name string, pauseOptions *v1.PauseOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "pause", name, pauseOptions), nil)
@@ -114,6 +122,16 @@ func (c *FakeVirtualMachineInstances) FilesystemList(ctx context.Context, name s
 	return v1.VirtualMachineInstanceFileSystemList{}, err
 }
 
+func (c *FakeVirtualMachineInstances) Stats(ctx context.Context, name string) (v1.VirtualMachineInstanceStats, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "stats", name), &v1.VirtualMachineInstanceStats{})
+
+	if obj == nil {
+		return v1.VirtualMachineInstanceStats{}, err
+	}
+	return *obj.(*v1.VirtualMachineInstanceStats), err
+}
+
 func (c *FakeVirtualMachineInstances) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "addvolume", name, addVolumeOptions), nil)
@@ -128,10 +146,42 @@ func (c *FakeVirtualMachineInstances) RemoveVolume(ctx context.Context, name str
 	return err
 }
 
+func (c *FakeVirtualMachineInstances) AddUSBDevice(ctx context.Context, name string, addUSBDeviceOptions *v1.AddUSBDeviceOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "addusbdevice", name, addUSBDeviceOptions), nil)
+
+	return err
+}
+
+func (c *FakeVirtualMachineInstances) RemoveUSBDevice(ctx context.Context, name string, removeUSBDeviceOptions *v1.RemoveUSBDeviceOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "removeusbdevice", name, removeUSBDeviceOptions), nil)
+
+	return err
+}
+
+func (c *FakeVirtualMachineInstances) SetIOLimits(ctx context.Context, name string, setIOLimitsOptions *v1.SetIOLimitsOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "iolimits", name, setIOLimitsOptions), nil)
+
+	return err
+}
+
+func (c *FakeVirtualMachineInstances) RepinVCPUs(ctx context.Context, name string, repinVCPUsOptions *v1.RepinVCPUsOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "repin", name, repinVCPUsOptions), nil)
+
+	return err
+}
+
 func (c *FakeVirtualMachineInstances) VSOCK(name string, options *v1.VSOCKOptions) (kvcorev1.StreamInterface, error) {
 	return nil, nil
 }
 
+func (c *FakeVirtualMachineInstances) MemoryDumpStream(name string, options *v1.MemoryDumpStreamOptions) (kvcorev1.StreamInterface, error) {
+	return nil, nil
+}
+
 func (c *FakeVirtualMachineInstances) SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error) {
 	_, err := c.Fake.
 		Invokes(testing.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "sev/fetchcertchain", name), &v1.SEVPlatformInfo{})
@@ -160,6 +210,33 @@ func (c *FakeVirtualMachineInstances) SEVInjectLaunchSecret(ctx context.Context,
 	return err
 }
 
+func (c *FakeVirtualMachineInstances) GuestOSExec(ctx context.Context, name string, guestOSExecOptions *v1.GuestOSExecOptions) (v1.GuestOSExecResult, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateSubresourceAction(virtualmachineinstancesResource, name, "guestosexec", c.ns, nil), &v1.GuestOSExecResult{})
+
+	if obj == nil {
+		return v1.GuestOSExecResult{}, err
+	}
+	return *obj.(*v1.GuestOSExecResult), err
+}
+
+func (c *FakeVirtualMachineInstances) GuestFileRead(ctx context.Context, name string, guestFileReadOptions *v1.GuestFileReadOptions) (v1.GuestFile, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateSubresourceAction(virtualmachineinstancesResource, name, "guestfileread", c.ns, nil), &v1.GuestFile{})
+
+	if obj == nil {
+		return v1.GuestFile{}, err
+	}
+	return *obj.(*v1.GuestFile), err
+}
+
+func (c *FakeVirtualMachineInstances) GuestFileWrite(ctx context.Context, name string, guestFile *v1.GuestFile) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "guestfilewrite", name, guestFile), nil)
+
+	return err
+}
+
 func (c *FakeVirtualMachineInstances) ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error) {
 	obj, err := c.Fake.
 		Invokes(fake2.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "objectgraph", name, objectGraphOptions), nil)
@@ -38,12 +38,19 @@ type VirtualMachineExpansion interface {
 	GetWithExpandedSpec(ctx context.Context, name string) (*v1.VirtualMachine, error)
 	PatchStatus(ctx context.Context, name string, pt types.PatchType, data []byte, patchOptions metav1.PatchOptions) (*v1.VirtualMachine, error)
 	Restart(ctx context.Context, name string, restartOptions *v1.RestartOptions) error
+	Rescue(ctx context.Context, name string, rescueOptions *v1.RescueOptions) error
+	Unrescue(ctx context.Context, name string, unrescueOptions *v1.UnrescueOptions) error
+	Hibernate(ctx context.Context, name string, hibernateOptions *v1.HibernateOptions) error
+	Resume(ctx context.Context, name string, resumeOptions *v1.ResumeOptions) error
 	Start(ctx context.Context, name string, startOptions *v1.StartOptions) error
 	Stop(ctx context.Context, name string, stopOptions *v1.StopOptions) error
 	Migrate(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) error
+	Restore(ctx context.Context, name string, restoreOptions *v1.RestoreOptions) error
+	ApplyChanges(ctx context.Context, name string, applyChangesOptions *v1.ApplyChangesOptions) error
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
+	PortForwardMultiplexed(name string) (StreamInterface, error)
 	MemoryDump(ctx context.Context, name string, memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) error
 	RemoveMemoryDump(ctx context.Context, name string) error
 	ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error)
@@ -83,6 +90,70 @@ func (c *virtualMachines) Restart(ctx context.Context, name string, restartOptio
 		Error()
 }
 
+func (c *virtualMachines) Rescue(ctx context.Context, name string, rescueOptions *v1.RescueOptions) error {
+	body, err := json.Marshal(rescueOptions)
+	if err != nil {
+		return fmt.Errorf(cannotMarshalJSONErrFmt, err)
+	}
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("rescue").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachines) Unrescue(ctx context.Context, name string, unrescueOptions *v1.UnrescueOptions) error {
+	body, err := json.Marshal(unrescueOptions)
+	if err != nil {
+		return fmt.Errorf(cannotMarshalJSONErrFmt, err)
+	}
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("unrescue").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachines) Hibernate(ctx context.Context, name string, hibernateOptions *v1.HibernateOptions) error {
+	body, err := json.Marshal(hibernateOptions)
+	if err != nil {
+		return fmt.Errorf(cannotMarshalJSONErrFmt, err)
+	}
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("hibernate").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachines) Resume(ctx context.Context, name string, resumeOptions *v1.ResumeOptions) error {
+	body, err := json.Marshal(resumeOptions)
+	if err != nil {
+		return fmt.Errorf(cannotMarshalJSONErrFmt, err)
+	}
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("resume").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachines) Start(ctx context.Context, name string, startOptions *v1.StartOptions) error {
 	optsJson, err := json.Marshal(startOptions)
 	if err != nil {
@@ -131,6 +202,38 @@ func (c *virtualMachines) Migrate(ctx context.Context, name string, migrateOptio
 		Error()
 }
 
+func (c *virtualMachines) Restore(ctx context.Context, name string, restoreOptions *v1.RestoreOptions) error {
+	optsJson, err := json.Marshal(restoreOptions)
+	if err != nil {
+		return err
+	}
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("restore").
+		Body(optsJson).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachines) ApplyChanges(ctx context.Context, name string, applyChangesOptions *v1.ApplyChangesOptions) error {
+	optsJson, err := json.Marshal(applyChangesOptions)
+	if err != nil {
+		return err
+	}
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("applychanges").
+		Body(optsJson).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachines) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	body, err := json.Marshal(addVolumeOptions)
 	if err != nil {
@@ -171,6 +274,12 @@ func (c *virtualMachines) PortForward(name string, port int, protocol string) (S
 	return nil, fmt.Errorf("PortForward is not implemented yet in generated client")
 }
 
+func (c *virtualMachines) PortForwardMultiplexed(name string) (StreamInterface, error) {
+	// TODO not implemented yet
+	//  requires clientConfig
+	return nil, fmt.Errorf("PortForwardMultiplexed is not implemented yet in generated client")
+}
+
 func (c *virtualMachines) MemoryDump(ctx context.Context, name string, memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) error {
 	body, err := json.Marshal(memoryDumpRequest)
 	if err != nil {
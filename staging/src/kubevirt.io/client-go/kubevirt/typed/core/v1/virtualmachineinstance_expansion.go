@@ -41,8 +41,10 @@ type VirtualMachineInstanceExpansion interface {
 	SerialConsole(name string, options *SerialConsoleOptions) (StreamInterface, error)
 	USBRedir(vmiName string) (StreamInterface, error)
 	VNC(name string) (StreamInterface, error)
+	SPICE(name string) (StreamInterface, error)
 	Screenshot(ctx context.Context, name string, options *v1.ScreenshotOptions) ([]byte, error)
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
+	PortForwardMultiplexed(name string) (StreamInterface, error)
 	Pause(ctx context.Context, name string, pauseOptions *v1.PauseOptions) error
 	Unpause(ctx context.Context, name string, unpauseOptions *v1.UnpauseOptions) error
 	Freeze(ctx context.Context, name string, unfreezeTimeout time.Duration) error
@@ -52,10 +54,19 @@ type VirtualMachineInstanceExpansion interface {
 	GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error)
 	UserList(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestOSUserList, error)
 	FilesystemList(ctx context.Context, name string) (v1.VirtualMachineInstanceFileSystemList, error)
+	Stats(ctx context.Context, name string) (v1.VirtualMachineInstanceStats, error)
+	GuestOSExec(ctx context.Context, name string, guestOSExecOptions *v1.GuestOSExecOptions) (v1.GuestOSExecResult, error)
+	GuestFileRead(ctx context.Context, name string, guestFileReadOptions *v1.GuestFileReadOptions) (v1.GuestFile, error)
+	GuestFileWrite(ctx context.Context, name string, guestFile *v1.GuestFile) error
 	ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error)
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
+	AddUSBDevice(ctx context.Context, name string, addUSBDeviceOptions *v1.AddUSBDeviceOptions) error
+	RemoveUSBDevice(ctx context.Context, name string, removeUSBDeviceOptions *v1.RemoveUSBDeviceOptions) error
+	SetIOLimits(ctx context.Context, name string, setIOLimitsOptions *v1.SetIOLimitsOptions) error
+	RepinVCPUs(ctx context.Context, name string, repinVCPUsOptions *v1.RepinVCPUsOptions) error
 	VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error)
+	MemoryDumpStream(name string, options *v1.MemoryDumpStreamOptions) (StreamInterface, error)
 	SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error)
 	SEVQueryLaunchMeasurement(ctx context.Context, name string) (v1.SEVMeasurementInfo, error)
 	SEVSetupSession(ctx context.Context, name string, sevSessionOptions *v1.SEVSessionOptions) error
@@ -80,6 +91,12 @@ func (c *virtualMachineInstances) VNC(name string) (StreamInterface, error) {
 	return nil, fmt.Errorf("VNC is not implemented yet in generated client")
 }
 
+func (c *virtualMachineInstances) SPICE(name string) (StreamInterface, error) {
+	// TODO not implemented yet
+	//  requires clientConfig
+	return nil, fmt.Errorf("SPICE is not implemented yet in generated client")
+}
+
 func (c *virtualMachineInstances) Screenshot(ctx context.Context, name string, options *v1.ScreenshotOptions) ([]byte, error) {
 	moveCursor := "false"
 	if options.MoveCursor == true {
@@ -108,6 +125,12 @@ func (c *virtualMachineInstances) PortForward(name string, port int, protocol st
 	return nil, fmt.Errorf("PortForward is not implemented yet in generated client")
 }
 
+func (c *virtualMachineInstances) PortForwardMultiplexed(name string) (StreamInterface, error) {
+	// TODO not implemented yet
+	//  requires clientConfig
+	return nil, fmt.Errorf("PortForwardMultiplexed is not implemented yet in generated client")
+}
+
 func (c *virtualMachineInstances) Pause(ctx context.Context, name string, pauseOptions *v1.PauseOptions) error {
 	body, err := json.Marshal(pauseOptions)
 	if err != nil {
@@ -276,6 +299,78 @@ func (c *virtualMachineInstances) FilesystemList(ctx context.Context, name strin
 	return fsList, err
 }
 
+func (c *virtualMachineInstances) Stats(ctx context.Context, name string) (v1.VirtualMachineInstanceStats, error) {
+	stats := v1.VirtualMachineInstanceStats{}
+	err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("stats").
+		Do(ctx).
+		Into(&stats)
+
+	return stats, err
+}
+
+func (c *virtualMachineInstances) GuestOSExec(ctx context.Context, name string, guestOSExecOptions *v1.GuestOSExecOptions) (v1.GuestOSExecResult, error) {
+	result := v1.GuestOSExecResult{}
+
+	body, err := json.Marshal(guestOSExecOptions)
+	if err != nil {
+		return result, err
+	}
+
+	err = c.GetClient().Post().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestosexec").
+		Body(body).
+		Do(ctx).
+		Into(&result)
+
+	return result, err
+}
+
+func (c *virtualMachineInstances) GuestFileRead(ctx context.Context, name string, guestFileReadOptions *v1.GuestFileReadOptions) (v1.GuestFile, error) {
+	guestFile := v1.GuestFile{}
+
+	body, err := json.Marshal(guestFileReadOptions)
+	if err != nil {
+		return guestFile, err
+	}
+
+	err = c.GetClient().Post().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestfileread").
+		Body(body).
+		Do(ctx).
+		Into(&guestFile)
+
+	return guestFile, err
+}
+
+func (c *virtualMachineInstances) GuestFileWrite(ctx context.Context, name string, guestFile *v1.GuestFile) error {
+	body, err := json.Marshal(guestFile)
+	if err != nil {
+		return fmt.Errorf("cannot Marshal to json: %s", err)
+	}
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestfilewrite").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachineInstances) ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error) {
 	objectGraph := v1.ObjectGraphNode{}
 
@@ -331,12 +426,86 @@ func (c *virtualMachineInstances) RemoveVolume(ctx context.Context, name string,
 		Error()
 }
 
+func (c *virtualMachineInstances) AddUSBDevice(ctx context.Context, name string, addUSBDeviceOptions *v1.AddUSBDeviceOptions) error {
+	body, err := json.Marshal(addUSBDeviceOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("addusbdevice").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachineInstances) RemoveUSBDevice(ctx context.Context, name string, removeUSBDeviceOptions *v1.RemoveUSBDeviceOptions) error {
+	body, err := json.Marshal(removeUSBDeviceOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("removeusbdevice").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachineInstances) SetIOLimits(ctx context.Context, name string, setIOLimitsOptions *v1.SetIOLimitsOptions) error {
+	body, err := json.Marshal(setIOLimitsOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("iolimits").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachineInstances) RepinVCPUs(ctx context.Context, name string, repinVCPUsOptions *v1.RepinVCPUsOptions) error {
+	body, err := json.Marshal(repinVCPUsOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("repin").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachineInstances) VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error) {
 	// TODO not implemented yet
 	//  requires clientConfig
 	return nil, fmt.Errorf("VSOCK is not implemented yet in generated client")
 }
 
+func (c *virtualMachineInstances) MemoryDumpStream(name string, options *v1.MemoryDumpStreamOptions) (StreamInterface, error) {
+	// TODO not implemented yet
+	//  requires clientConfig
+	return nil, fmt.Errorf("MemoryDumpStream is not implemented yet in generated client")
+}
+
 func (c *virtualMachineInstances) SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error) {
 	sevPlatformInfo := v1.SEVPlatformInfo{}
 	err := c.GetClient().Get().
@@ -31,6 +31,8 @@ import (
 type ExportV1beta1Interface interface {
 	RESTClient() rest.Interface
 	VirtualMachineExportsGetter
+	VirtualMachineSnapshotExportsGetter
+	VirtualMachineSnapshotReplicationsGetter
 }
 
 // ExportV1beta1Client is used to interact with features provided by the export.kubevirt.io group.
@@ -42,6 +44,14 @@ func (c *ExportV1beta1Client) VirtualMachineExports(namespace string) VirtualMac
 	return newVirtualMachineExports(c, namespace)
 }
 
+func (c *ExportV1beta1Client) VirtualMachineSnapshotExports(namespace string) VirtualMachineSnapshotExportInterface {
+	return newVirtualMachineSnapshotExports(c, namespace)
+}
+
+func (c *ExportV1beta1Client) VirtualMachineSnapshotReplications(namespace string) VirtualMachineSnapshotReplicationInterface {
+	return newVirtualMachineSnapshotReplications(c, namespace)
+}
+
 // NewForConfig creates a new ExportV1beta1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
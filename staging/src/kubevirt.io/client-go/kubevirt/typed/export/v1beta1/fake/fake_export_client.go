@@ -34,6 +34,14 @@ func (c *FakeExportV1beta1) VirtualMachineExports(namespace string) v1beta1.Virt
 	return &FakeVirtualMachineExports{c, namespace}
 }
 
+func (c *FakeExportV1beta1) VirtualMachineSnapshotExports(namespace string) v1beta1.VirtualMachineSnapshotExportInterface {
+	return &FakeVirtualMachineSnapshotExports{c, namespace}
+}
+
+func (c *FakeExportV1beta1) VirtualMachineSnapshotReplications(namespace string) v1beta1.VirtualMachineSnapshotReplicationInterface {
+	return &FakeVirtualMachineSnapshotReplications{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeExportV1beta1) RESTClient() rest.Interface {
@@ -21,3 +21,7 @@ Copyright The KubeVirt Authors.
 package v1beta1
 
 type VirtualMachineExportExpansion interface{}
+
+type VirtualMachineSnapshotExportExpansion interface{}
+
+type VirtualMachineSnapshotReplicationExpansion interface{}
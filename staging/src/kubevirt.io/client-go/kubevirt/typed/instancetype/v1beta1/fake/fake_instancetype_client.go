@@ -30,6 +30,10 @@ type FakeInstancetypeV1beta1 struct {
 	*testing.Fake
 }
 
+func (c *FakeInstancetypeV1beta1) VirtualMachineClusterDefaults() v1beta1.VirtualMachineClusterDefaultInterface {
+	return &FakeVirtualMachineClusterDefaults{c}
+}
+
 func (c *FakeInstancetypeV1beta1) VirtualMachineClusterInstancetypes() v1beta1.VirtualMachineClusterInstancetypeInterface {
 	return &FakeVirtualMachineClusterInstancetypes{c}
 }
@@ -38,6 +42,10 @@ func (c *FakeInstancetypeV1beta1) VirtualMachineClusterPreferences() v1beta1.Vir
 	return &FakeVirtualMachineClusterPreferences{c}
 }
 
+func (c *FakeInstancetypeV1beta1) VirtualMachineDefaults(namespace string) v1beta1.VirtualMachineDefaultInterface {
+	return &FakeVirtualMachineDefaults{c, namespace}
+}
+
 func (c *FakeInstancetypeV1beta1) VirtualMachineInstancetypes(namespace string) v1beta1.VirtualMachineInstancetypeInterface {
 	return &FakeVirtualMachineInstancetypes{c, namespace}
 }
@@ -20,10 +20,14 @@ Copyright The KubeVirt Authors.
 
 package v1beta1
 
+type VirtualMachineClusterDefaultExpansion interface{}
+
 type VirtualMachineClusterInstancetypeExpansion interface{}
 
 type VirtualMachineClusterPreferenceExpansion interface{}
 
+type VirtualMachineDefaultExpansion interface{}
+
 type VirtualMachineInstancetypeExpansion interface{}
 
 type VirtualMachinePreferenceExpansion interface{}
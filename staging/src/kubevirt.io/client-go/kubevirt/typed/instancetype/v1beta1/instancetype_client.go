@@ -30,8 +30,10 @@ import (
 
 type InstancetypeV1beta1Interface interface {
 	RESTClient() rest.Interface
+	VirtualMachineClusterDefaultsGetter
 	VirtualMachineClusterInstancetypesGetter
 	VirtualMachineClusterPreferencesGetter
+	VirtualMachineDefaultsGetter
 	VirtualMachineInstancetypesGetter
 	VirtualMachinePreferencesGetter
 }
@@ -41,6 +43,10 @@ type InstancetypeV1beta1Client struct {
 	restClient rest.Interface
 }
 
+func (c *InstancetypeV1beta1Client) VirtualMachineClusterDefaults() VirtualMachineClusterDefaultInterface {
+	return newVirtualMachineClusterDefaults(c)
+}
+
 func (c *InstancetypeV1beta1Client) VirtualMachineClusterInstancetypes() VirtualMachineClusterInstancetypeInterface {
 	return newVirtualMachineClusterInstancetypes(c)
 }
@@ -49,6 +55,10 @@ func (c *InstancetypeV1beta1Client) VirtualMachineClusterPreferences() VirtualMa
 	return newVirtualMachineClusterPreferences(c)
 }
 
+func (c *InstancetypeV1beta1Client) VirtualMachineDefaults(namespace string) VirtualMachineDefaultInterface {
+	return newVirtualMachineDefaults(c, namespace)
+}
+
 func (c *InstancetypeV1beta1Client) VirtualMachineInstancetypes(namespace string) VirtualMachineInstancetypeInterface {
 	return newVirtualMachineInstancetypes(c, namespace)
 }
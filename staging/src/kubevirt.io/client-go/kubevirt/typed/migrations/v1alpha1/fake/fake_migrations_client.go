@@ -34,6 +34,22 @@ func (c *FakeMigrationsV1alpha1) MigrationPolicies() v1alpha1.MigrationPolicyInt
 	return &FakeMigrationPolicies{c}
 }
 
+func (c *FakeMigrationsV1alpha1) MigrationRebalancePolicies() v1alpha1.MigrationRebalancePolicyInterface {
+	return &FakeMigrationRebalancePolicies{c}
+}
+
+func (c *FakeMigrationsV1alpha1) MigrationRetryBudgets(namespace string) v1alpha1.MigrationRetryBudgetInterface {
+	return &FakeMigrationRetryBudgets{c, namespace}
+}
+
+func (c *FakeMigrationsV1alpha1) VirtualMachineDisruptionBudgets(namespace string) v1alpha1.VirtualMachineDisruptionBudgetInterface {
+	return &FakeVirtualMachineDisruptionBudgets{c, namespace}
+}
+
+func (c *FakeMigrationsV1alpha1) VolumeMigrations(namespace string) v1alpha1.VolumeMigrationInterface {
+	return &FakeVolumeMigrations{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeMigrationsV1alpha1) RESTClient() rest.Interface {
@@ -21,3 +21,11 @@ Copyright The KubeVirt Authors.
 package v1alpha1
 
 type MigrationPolicyExpansion interface{}
+
+type MigrationRebalancePolicyExpansion interface{}
+
+type MigrationRetryBudgetExpansion interface{}
+
+type VirtualMachineDisruptionBudgetExpansion interface{}
+
+type VolumeMigrationExpansion interface{}
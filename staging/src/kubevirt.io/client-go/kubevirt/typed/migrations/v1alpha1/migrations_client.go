@@ -31,6 +31,10 @@ import (
 type MigrationsV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	MigrationPoliciesGetter
+	MigrationRebalancePoliciesGetter
+	MigrationRetryBudgetsGetter
+	VirtualMachineDisruptionBudgetsGetter
+	VolumeMigrationsGetter
 }
 
 // MigrationsV1alpha1Client is used to interact with features provided by the migrations.kubevirt.io group.
@@ -42,6 +46,22 @@ func (c *MigrationsV1alpha1Client) MigrationPolicies() MigrationPolicyInterface
 	return newMigrationPolicies(c)
 }
 
+func (c *MigrationsV1alpha1Client) MigrationRebalancePolicies() MigrationRebalancePolicyInterface {
+	return newMigrationRebalancePolicies(c)
+}
+
+func (c *MigrationsV1alpha1Client) MigrationRetryBudgets(namespace string) MigrationRetryBudgetInterface {
+	return newMigrationRetryBudgets(c, namespace)
+}
+
+func (c *MigrationsV1alpha1Client) VirtualMachineDisruptionBudgets(namespace string) VirtualMachineDisruptionBudgetInterface {
+	return newVirtualMachineDisruptionBudgets(c, namespace)
+}
+
+func (c *MigrationsV1alpha1Client) VolumeMigrations(namespace string) VolumeMigrationInterface {
+	return newVolumeMigrations(c, namespace)
+}
+
 // NewForConfig creates a new MigrationsV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
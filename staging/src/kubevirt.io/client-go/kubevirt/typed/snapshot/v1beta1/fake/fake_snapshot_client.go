@@ -42,6 +42,18 @@ func (c *FakeSnapshotV1beta1) VirtualMachineSnapshotContents(namespace string) v
 	return &FakeVirtualMachineSnapshotContents{c, namespace}
 }
 
+func (c *FakeSnapshotV1beta1) VirtualMachineSnapshotGroups(namespace string) v1beta1.VirtualMachineSnapshotGroupInterface {
+	return &FakeVirtualMachineSnapshotGroups{c, namespace}
+}
+
+func (c *FakeSnapshotV1beta1) VirtualMachineSnapshotGroupRestores(namespace string) v1beta1.VirtualMachineSnapshotGroupRestoreInterface {
+	return &FakeVirtualMachineSnapshotGroupRestores{c, namespace}
+}
+
+func (c *FakeSnapshotV1beta1) VirtualMachineSnapshotSchedules(namespace string) v1beta1.VirtualMachineSnapshotScheduleInterface {
+	return &FakeVirtualMachineSnapshotSchedules{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeSnapshotV1beta1) RESTClient() rest.Interface {
@@ -25,3 +25,9 @@ type VirtualMachineRestoreExpansion interface{}
 type VirtualMachineSnapshotExpansion interface{}
 
 type VirtualMachineSnapshotContentExpansion interface{}
+
+type VirtualMachineSnapshotGroupExpansion interface{}
+
+type VirtualMachineSnapshotGroupRestoreExpansion interface{}
+
+type VirtualMachineSnapshotScheduleExpansion interface{}
@@ -33,6 +33,9 @@ type SnapshotV1beta1Interface interface {
 	VirtualMachineRestoresGetter
 	VirtualMachineSnapshotsGetter
 	VirtualMachineSnapshotContentsGetter
+	VirtualMachineSnapshotGroupsGetter
+	VirtualMachineSnapshotGroupRestoresGetter
+	VirtualMachineSnapshotSchedulesGetter
 }
 
 // SnapshotV1beta1Client is used to interact with features provided by the snapshot.kubevirt.io group.
@@ -52,6 +55,18 @@ func (c *SnapshotV1beta1Client) VirtualMachineSnapshotContents(namespace string)
 	return newVirtualMachineSnapshotContents(c, namespace)
 }
 
+func (c *SnapshotV1beta1Client) VirtualMachineSnapshotGroups(namespace string) VirtualMachineSnapshotGroupInterface {
+	return newVirtualMachineSnapshotGroups(c, namespace)
+}
+
+func (c *SnapshotV1beta1Client) VirtualMachineSnapshotGroupRestores(namespace string) VirtualMachineSnapshotGroupRestoreInterface {
+	return newVirtualMachineSnapshotGroupRestores(c, namespace)
+}
+
+func (c *SnapshotV1beta1Client) VirtualMachineSnapshotSchedules(namespace string) VirtualMachineSnapshotScheduleInterface {
+	return newVirtualMachineSnapshotSchedules(c, namespace)
+}
+
 // NewForConfig creates a new SnapshotV1beta1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
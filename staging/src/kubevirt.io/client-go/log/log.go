@@ -31,6 +31,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	klog "github.com/go-kit/log"
@@ -59,8 +60,28 @@ var LogLevelNames = map[LogLevel]string{
 	FATAL:   "fatal",
 }
 
+const (
+	// FormatJSON logs every entry as a JSON object, it is the default format
+	FormatJSON = "json"
+	// FormatText logs every entry as a logfmt line of key=value pairs
+	FormatText = "text"
+)
+
+const (
+	// SubcomponentMigration is the subcomponent of the logs about live migrations
+	SubcomponentMigration = "migration"
+	// SubcomponentHotplug is the subcomponent of the logs about hotplugged volumes
+	SubcomponentHotplug = "hotplug"
+)
+
 var lock sync.Mutex
 
+var (
+	textFormat atomic.Bool
+	// subcomponentVerbosity holds the verbosity of the subcomponents, as a map[string]int
+	subcomponentVerbosity atomic.Value
+)
+
 type LoggableObject interface {
 	metav1.ObjectMetaAccessor
 	k8sruntime.Object
@@ -77,6 +98,7 @@ type FilteredLogger struct {
 	currentLogLevel       LogLevel
 	verbosityLevel        int
 	currentVerbosityLevel int
+	subcomponent          string
 	err                   error
 }
 
@@ -113,6 +135,55 @@ func MakeLogger(logger klog.Logger) *FilteredLogger {
 	}
 }
 
+// formatLogger writes the log entries in the format set by SetFormat
+type formatLogger struct {
+	json klog.Logger
+	text klog.Logger
+}
+
+func newFormatLogger(w io.Writer) klog.Logger {
+	return &formatLogger{
+		json: klog.NewJSONLogger(w),
+		text: klog.NewLogfmtLogger(w),
+	}
+}
+
+func (f *formatLogger) Log(params ...interface{}) error {
+	if textFormat.Load() {
+		return f.text.Log(params...)
+	}
+	return f.json.Log(params...)
+}
+
+// SetFormat sets the format of the log entries of all the loggers, FormatJSON or FormatText
+func SetFormat(format string) error {
+	switch format {
+	case "", FormatJSON:
+		textFormat.Store(false)
+	case FormatText:
+		textFormat.Store(true)
+	default:
+		return fmt.Errorf("log format %q does not exist", format)
+	}
+	return nil
+}
+
+// SetSubcomponentVerbosity sets the verbosity of the loggers of the subcomponents. The loggers of the
+// subcomponents without a verbosity use the verbosity of their component.
+func SetSubcomponentVerbosity(levels map[string]int) {
+	verbosity := make(map[string]int, len(levels))
+	for subcomponent, level := range levels {
+		verbosity[subcomponent] = level
+	}
+	subcomponentVerbosity.Store(verbosity)
+}
+
+func getSubcomponentVerbosity(subcomponent string) (int, bool) {
+	verbosity, _ := subcomponentVerbosity.Load().(map[string]int)
+	level, ok := verbosity[subcomponent]
+	return level, ok
+}
+
 type NullLogger struct{}
 
 func (n NullLogger) Log(params ...interface{}) error { return nil }
@@ -126,7 +197,7 @@ func createLogger(component string) {
 	defer lock.Unlock()
 	_, ok := loggers[component]
 	if ok == false {
-		logger := klog.NewJSONLogger(os.Stderr)
+		logger := newFormatLogger(os.Stderr)
 		log := MakeLogger(logger)
 		log.component = component
 		loggers[component] = log
@@ -148,7 +219,7 @@ func DefaultLogger() *FilteredLogger {
 // SetIOWriter is meant to be used for testing. "log" and "glog" logs are sent to /dev/nil.
 // KubeVirt related log messages will be sent to this writer
 func (l *FilteredLogger) SetIOWriter(w io.Writer) {
-	l.logger = klog.NewJSONLogger(w)
+	l.logger = newFormatLogger(w)
 	goflag.CommandLine.Set("logtostderr", "false")
 }
 
@@ -181,9 +252,15 @@ func (l FilteredLogger) log(skipFrames int, params ...interface{}) error {
 	// messages should be logged if any of these conditions are met:
 	// The log filtering level is info and verbosity checks match
 	// The log message priority is warning or higher
+	verbosityLevel := l.verbosityLevel
+	if l.subcomponent != "" {
+		if level, ok := getSubcomponentVerbosity(l.subcomponent); ok {
+			verbosityLevel = level
+		}
+	}
 	if l.currentLogLevel >= WARNING || (l.filterLevel == INFO &&
 		(l.currentLogLevel == l.filterLevel) &&
-		(l.currentVerbosityLevel <= l.verbosityLevel)) {
+		(l.currentVerbosityLevel <= verbosityLevel)) {
 		now := time.Now().UTC()
 		_, fileName, lineNumber, _ := runtime.Caller(skipFrames)
 		logParams := make([]interface{}, 0, 8)
@@ -259,6 +336,14 @@ func (l FilteredLogger) With(obj ...interface{}) *FilteredLogger {
 	return &l
 }
 
+// Subcomponent returns a logger of a subcomponent of the component, like SubcomponentMigration. Its
+// entries carry the subcomponent, and are filtered with the verbosity of the subcomponent when it is set.
+func (l FilteredLogger) Subcomponent(subcomponent string) *FilteredLogger {
+	l.subcomponent = subcomponent
+	l.logger = klog.With(l.logger, "subcomponent", subcomponent)
+	return &l
+}
+
 func (l *FilteredLogger) with(obj ...interface{}) *FilteredLogger {
 	l.logger = klog.With(l.logger, obj...)
 	return l
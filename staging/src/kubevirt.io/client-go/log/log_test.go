@@ -20,6 +20,7 @@
 package log
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"path/filepath"
@@ -384,3 +385,45 @@ func TestErrWithMsgf(t *testing.T) {
 	assert(t, logEntry[11].(string) == "test", "Logged line did not contain message")
 	tearDown()
 }
+
+func TestSubcomponentVerbosity(t *testing.T) {
+	setUp()
+	log := MakeLogger(MockLogger{}).Subcomponent(SubcomponentMigration)
+
+	log.Log("msg", "test")
+	logEntry := logParams[0].([]interface{})
+	assert(t, logEntry[8].(string) == "subcomponent", "Logged line did not contain the subcomponent")
+	assert(t, logEntry[9].(string) == SubcomponentMigration, "Logged line referenced wrong subcomponent")
+
+	logCalled = false
+	log.V(4).Log("msg", "test")
+	assert(t, !logCalled, "Log entry (V=4) should not have been recorded with the verbosity of the component")
+
+	SetSubcomponentVerbosity(map[string]int{SubcomponentMigration: 4})
+	defer SetSubcomponentVerbosity(nil)
+	log.V(4).Log("msg", "test")
+	assert(t, logCalled, "Log entry (V=4) should have been recorded with the verbosity of the subcomponent")
+
+	logCalled = false
+	MakeLogger(MockLogger{}).Subcomponent(SubcomponentHotplug).V(4).Log("msg", "test")
+	assert(t, !logCalled, "Log entry (V=4) of another subcomponent should not have been recorded")
+	tearDown()
+}
+
+func TestFormat(t *testing.T) {
+	setUp()
+	buffer := &bytes.Buffer{}
+	log := MakeLogger(newFormatLogger(buffer))
+
+	log.Log("msg", "test")
+	assert(t, strings.HasPrefix(buffer.String(), "{"), "Logged line should be a JSON object by default")
+
+	assert(t, SetFormat(FormatText) == nil, "Unable to set the text format")
+	defer SetFormat(FormatJSON)
+	buffer.Reset()
+	log.Log("msg", "test")
+	assert(t, strings.HasPrefix(buffer.String(), "level=info"), "Logged line should be in the text format")
+
+	assert(t, SetFormat("xml") != nil, "Unknown formats should not have been allowed")
+	tearDown()
+}
@@ -78,6 +78,13 @@ func allowUpdateFor(roles ...string) rights {
 	}
 }
 
+func allowCreateFor(roles ...string) rights {
+	return rights{
+		Roles:  roles,
+		Create: true,
+	}
+}
+
 func allowGetFor(roles ...string) rights {
 	return rights{
 		Roles: roles,
@@ -373,6 +380,22 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 				"virtualmachines", "restart",
 				allowUpdateFor("admin", "edit"),
 				denyAllFor("view", "migrate", "default")),
+			Entry("on vm rescue",
+				"virtualmachines", "rescue",
+				allowUpdateFor("admin", "edit"),
+				denyAllFor("view", "migrate", "default")),
+			Entry("on vm unrescue",
+				"virtualmachines", "unrescue",
+				allowUpdateFor("admin", "edit"),
+				denyAllFor("view", "migrate", "default")),
+			Entry("on vm hibernate",
+				"virtualmachines", "hibernate",
+				allowUpdateFor("admin", "edit"),
+				denyAllFor("view", "migrate", "default")),
+			Entry("on vm resume",
+				"virtualmachines", "resume",
+				allowUpdateFor("admin", "edit"),
+				denyAllFor("view", "migrate", "default")),
 			Entry("on vm expand-spec",
 				"virtualmachines", "expand-spec",
 				allowGetFor("admin", "edit", "view"),
@@ -397,6 +420,22 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 				"virtualmachineinstances", "filesystemlist",
 				allowGetFor("admin", "edit", "view"),
 				denyAllFor("migrate", "default")),
+			Entry("on vmi stats",
+				"virtualmachineinstances", "stats",
+				allowGetFor("admin", "edit", "view"),
+				denyAllFor("migrate", "default")),
+			Entry("on vmi guestosexec",
+				"virtualmachineinstances", "guestosexec",
+				allowCreateFor("admin", "edit"),
+				denyAllFor("view", "migrate", "default")),
+			Entry("on vmi guestfileread",
+				"virtualmachineinstances", "guestfileread",
+				allowCreateFor("admin", "edit"),
+				denyAllFor("view", "migrate", "default")),
+			Entry("on vmi guestfilewrite",
+				"virtualmachineinstances", "guestfilewrite",
+				allowUpdateFor("admin", "edit"),
+				denyAllFor("view", "migrate", "default")),
 			Entry("on vmi addvolume",
 				"virtualmachineinstances", "addvolume",
 				allowUpdateFor("admin", "edit"),
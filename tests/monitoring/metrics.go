@@ -90,6 +90,8 @@ var _ = Describe("[sig-monitoring]Metrics", decorators.SigMonitoring, func() {
 			"kubevirt_vmi_migration_dirty_memory_rate_bytes":                     true,
 			"kubevirt_vmi_migration_disk_transfer_rate_bytes":                    true,
 			"kubevirt_vmi_migration_data_total_bytes":                            true,
+			"kubevirt_vmi_migration_expected_downtime_seconds":                   true,
+			"kubevirt_vmi_migration_memory_iterations_total":                     true,
 			"kubevirt_vmi_migration_start_time_seconds":                          true,
 			"kubevirt_vmi_migration_end_time_seconds":                            true,
 
@@ -113,7 +115,7 @@ var _ = Describe("[sig-monitoring]Metrics", decorators.SigMonitoring, func() {
 			err = virtcontroller.RegisterLeaderMetrics()
 			Expect(err).ToNot(HaveOccurred())
 
-			err = virthandler.SetupMetrics("", "", 0, nil, nil)
+			err = virthandler.SetupMetrics("", "", 0, nil, nil, nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			for _, metric := range operatormetrics.ListMetrics() {